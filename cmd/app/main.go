@@ -1,36 +1,111 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"expvar"
+	"flag"
 	"fmt"
 	"log"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
 	"delivery/cmd"
+	"delivery/cmd/config"
+	grpcin "delivery/internal/adapters/in/grpc"
+	httpin "delivery/internal/adapters/in/http"
+	"delivery/internal/adapters/out/blobstore"
+	"delivery/internal/adapters/out/kafka"
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/adapters/out/postgres/blobrepo"
 	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/adapters/out/postgres/earningsrepo"
 	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/adapters/out/postgres/outboxrepo"
+	"delivery/internal/adapters/out/postgres/pickuprepo"
+	"delivery/internal/adapters/out/postgres/relayrepo"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/ports"
 	"delivery/internal/generated/servers"
+	"delivery/internal/generated/servers/deliverypb"
+	"delivery/internal/jobs"
 	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/metrics"
+	"delivery/internal/pkg/tenant"
+	"delivery/internal/pkg/tracing"
 
-	"github.com/joho/godotenv"
 	"github.com/labstack/echo/v4"
 	_ "github.com/lib/pq"
 	echoSwagger "github.com/swaggo/echo-swagger"
 	"github.com/swaggo/swag"
+	"google.golang.org/grpc"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
 
+// tracingServiceName is the service name the spans of the application are exported with.
+const tracingServiceName = "delivery"
+
 func main() {
 	// Register swagger documentation
 	swag.Register("swagger", &swaggerSpec{})
 
-	configs := getConfigs()
+	command, args, err := cmd.ParseCommand(os.Args[1:])
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	switch command {
+	case cmd.RunCommand:
+		runService(composeWorker, composeServer)
+	case cmd.ServeCommand:
+		runService(composeServer)
+	case cmd.WorkerCommand:
+		runService(composeWorker, composeProbes)
+	case cmd.CatalogCommand:
+		// Build command: needs neither configuration nor a database
+		printMessageCatalog()
+	case cmd.ReplayOutboxCommand:
+		runAdmin(func(env environment) { runReplayOutbox(env.app, env.configs, args) })
+	case cmd.DataFixCommand:
+		runAdmin(func(env environment) { runDataFix(env.app, args) })
+	case cmd.BackfillProjectionCommand:
+		runAdmin(func(env environment) { runBackfillProjection(env.app, args) })
+	case cmd.CopyToStagingCommand:
+		runAdmin(func(env environment) { runCopyToStaging(env.app, env.configs, args) })
+	}
+}
+
+// environment is what the service and the admin commands run on: the configuration, the
+// composition root built on the migrated database and the lifecycle stopping the service.
+type environment struct {
+	configs      config.Config
+	app          cmd.CompositionRoot
+	lifecycle    *cmd.Lifecycle
+	flushTracing func()
+}
+
+// mustSetUp loads the configuration, sets up tracing and migrates the database the composition
+// root is built on. The caller flushes the spans with flushTracing on exit.
+func mustSetUp() environment {
+	configs, err := config.Load()
+	if err != nil {
+		log.Fatalf("configuration: %v", err)
+	}
+	if configs.Profile != "" {
+		log.Printf("Configuration profile %q applied", configs.Profile)
+	}
+
+	flushTracing := mustSetupTracing(configs)
 
 	connectionString, err := makeConnectionString(
 		configs.DBHost,
@@ -51,70 +126,511 @@ func main() {
 		configs.DBSslMode)
 	gormDB := mustGormOpen(connectionString)
 	mustAutoMigrate(gormDB)
+	mustApplyTenancyPolicies(gormDB, configs.DefaultTenantID)
+	mustApplySyntheticDataMarkers(gormDB)
+	mustApplyOrderArchival(gormDB)
+	mustRegisterStatementTimeoutErrors(gormDB)
+	mustUseQueryMetrics(gormDB)
+	mustUseQueryTracing(gormDB)
 
 	logger := slog.Default()
+	lifecycle := cmd.NewLifecycle(configs.ShutdownTimeout, logger)
+	lifecycle.OnShutdown("database", closeDatabase(gormDB))
+	mustEnableShadowWrites(gormDB, configs.ShadowWrites, logger)
 	app := cmd.NewCompositionRoot(
 		configs,
 		gormDB,
 		logger,
 	)
 
-	// Start background jobs
-	jobManager := app.CreateJobManager()
+	return environment{
+		configs:      configs,
+		app:          app,
+		lifecycle:    lifecycle,
+		flushTracing: flushTracing,
+	}
+}
+
+// runService starts the components of the tiers composed by compose, in order, and runs them
+// until the process is asked to stop. Requests and job ticks in progress finish before their
+// connections are closed.
+func runService(compose ...func(env environment)) {
+	env := mustSetUp()
+	defer env.flushTracing()
+
+	for _, composeTier := range compose {
+		composeTier(env)
+	}
+
+	if err := env.lifecycle.Run(context.Background()); err != nil {
+		log.Printf("shutdown: %v", err)
+	}
+}
+
+// runAdmin runs an admin command instead of the service.
+func runAdmin(run func(env environment)) {
+	env := mustSetUp()
+	defer env.flushTracing()
+
+	run(env)
+}
+
+// composeWorker starts the background jobs, resuming from the state of the previous instance
+// if there is one, and the producer the outbox relay publishes with.
+func composeWorker(env environment) {
+	publisher := mustConnectOutboxPublisher(env.configs)
+	if publisher != nil {
+		env.lifecycle.OnShutdown("kafka producer", func(context.Context) error {
+			return publisher.Close()
+		})
+	}
+
+	jobManager := env.app.CreateJobManager(outboxPublisher(publisher))
+	importDispatcherState(jobManager, env.configs.DispatcherStateFile)
 	if startErr := jobManager.StartAll(); startErr != nil {
 		log.Fatal("Failed to start jobs:", startErr)
 	}
+	env.lifecycle.OnShutdown("background jobs", func(ctx context.Context) error {
+		stopErr := jobManager.StopAll(ctx)
+		exportDispatcherState(jobManager, env.configs.DispatcherStateFile)
+		return stopErr
+	})
+}
+
+// composeServer serves the HTTP and gRPC APIs and invalidates the tracking views cached by
+// this instance when orders change.
+func composeServer(env environment) {
+	if trackingCacheJob := env.app.CreateTrackingCacheInvalidationJob(); trackingCacheJob != nil {
+		if startErr := trackingCacheJob.Start(); startErr != nil {
+			log.Fatal("Failed to start tracking cache invalidation:", startErr)
+		}
+		env.lifecycle.OnShutdown("tracking cache invalidation", func(ctx context.Context) error {
+			return jobs.StopJobs(ctx, trackingCacheJob)
+		})
+	}
+
+	if stopGRPC := startGRPCServer(env.app, env.configs.GRPCPort); stopGRPC != nil {
+		env.lifecycle.OnShutdown("gRPC server", stopGRPC)
+	}
+	env.lifecycle.OnShutdown("HTTP server", startWebServer(env.app, env.configs.HTTPPort, true).Shutdown)
+}
+
+// composeProbes serves only the health, metrics and diagnostics endpoints, so a worker process
+// can still be probed and triaged.
+func composeProbes(env environment) {
+	env.lifecycle.OnShutdown("HTTP server", startWebServer(env.app, env.configs.HTTPPort, false).Shutdown)
+}
+
+// mustSetupTracing exports spans to the configured OTLP collector. Without a collector spans are
+// not recorded, but trace context is still passed on to Kafka messages.
+// Returns a function flushing the spans not exported yet within the shutdown timeout, to be called on exit.
+func mustSetupTracing(configs config.Config) func() {
+	shutdown, err := tracing.Setup(tracing.Config{
+		Endpoint:    configs.TracingOTLPEndpoint,
+		ServiceName: tracingServiceName,
+		SampleRatio: configs.TracingSampleRatio,
+	})
+	if err != nil {
+		log.Fatalf("tracing: %v", err)
+	}
+	if configs.TracingOTLPEndpoint == "" {
+		log.Printf("TRACING_OTLP_ENDPOINT is not set, spans are not exported")
+	}
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), configs.ShutdownTimeout)
+		defer cancel()
+		if shutdownErr := shutdown(ctx); shutdownErr != nil {
+			log.Printf("flushing spans: %v", shutdownErr)
+		}
+	}
+}
+
+// mustConnectOutboxPublisher connects the producer the outbox relay publishes with.
+// Returns nil when no Kafka broker is configured, which leaves the outbox unpublished, except for
+// the courier availability events when the availability webhook is configured.
+func mustConnectOutboxPublisher(configs config.Config) *kafka.OutboxPublisher {
+	if configs.KafkaHost == "" {
+		log.Printf("KAFKA_HOST is not set, outbox events are not published to Kafka")
+		return nil
+	}
+
+	publisher, err := kafka.NewOutboxPublisher(strings.Split(configs.KafkaHost, ","), configs.KafkaOrderChangedTopic)
+	if err != nil {
+		log.Fatalf("connection to kafka: %v", err)
+	}
+	return publisher
+}
+
+// outboxPublisher keeps a missing producer a nil interface, so the job manager sees it as absent.
+func outboxPublisher(publisher *kafka.OutboxPublisher) ports.EventPublisher {
+	if publisher == nil {
+		return nil
+	}
+	return publisher
+}
+
+// printMessageCatalog writes the message catalog to stdout as indented JSON.
+//
+// Usage:
+//
+//	go run ./cmd/app catalog > api/catalog/catalog.json
+func printMessageCatalog() {
+	messageCatalog, err := cmd.MessageCatalog()
+	if err != nil {
+		log.Fatalf("invalid message catalog: %v", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(messageCatalog); err != nil {
+		log.Fatal(err.Error())
+	}
+}
+
+// importDispatcherState warms the jobs up with the state exported by the previous instance.
+// Missing or stale state is not an error: the jobs then start cold.
+func importDispatcherState(jobManager *jobs.JobManager, path string) {
+	if path == "" {
+		return
+	}
+
+	state, err := jobs.LoadDispatcherState(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return
+	}
+	if err != nil {
+		log.Printf("Dispatcher state not imported: %v", err)
+		return
+	}
+
+	if err = jobManager.ImportDispatcherState(state, time.Now()); err != nil {
+		log.Printf("Dispatcher state not imported: %v", err)
+	}
+}
+
+// exportDispatcherState saves the jobs' state for the next instance.
+func exportDispatcherState(jobManager *jobs.JobManager, path string) {
+	if path == "" {
+		return
+	}
+
+	if err := jobs.SaveDispatcherState(path, jobManager.ExportDispatcherState(time.Now())); err != nil {
+		log.Printf("Dispatcher state not exported: %v", err)
+		return
+	}
+	log.Printf("Dispatcher state exported to %s", path)
+}
+
+// runReplayOutbox republishes outbox events to Kafka starting from the given timestamp.
+//
+// Usage:
+//
+//	app replay-outbox -since 2025-01-01T00:00:00Z [-batch 500] [-rate 200]
+func runReplayOutbox(app cmd.CompositionRoot, configs config.Config, args []string) {
+	flags := flag.NewFlagSet("replay-outbox", flag.ExitOnError)
+	since := flags.String("since", "", "replay events occurred at or after this RFC3339 timestamp")
+	batchSize := flags.Int("batch", 500, "number of events loaded from the outbox at once")
+	rate := flags.Int("rate", 200, "maximum events published per second (0 disables throttling)")
+	if err := flags.Parse(args); err != nil {
+		log.Fatal(err.Error())
+	}
+
+	sinceTime, err := time.Parse(time.RFC3339, *since)
+	if err != nil {
+		log.Fatalf("invalid -since value: %v", err)
+	}
+
+	replayCommand, err := commands.NewReplayOutboxCommand(sinceTime, *batchSize, *rate)
+	if err != nil {
+		log.Fatalf("invalid replay parameters: %v", err)
+	}
+
+	publisher, err := kafka.NewOutboxPublisher(strings.Split(configs.KafkaHost, ","), configs.KafkaOrderChangedTopic)
+	if err != nil {
+		log.Fatalf("connection to kafka: %v", err)
+	}
+	defer func() {
+		if err = publisher.Close(); err != nil {
+			log.Printf("closing kafka producer: %v", err)
+		}
+	}()
+
+	handler := app.CreateReplayOutboxCommandHandler(publisher)
+	report, err := handler.Handle(context.Background(), replayCommand)
+	if err != nil {
+		log.Printf("Replay stopped after %d events (resume from %s): %v",
+			report.Published, report.LastOccurredAt.Format(time.RFC3339Nano), err)
+		return
+	}
 
-	startWebServer(app, configs.HTTPPort)
+	log.Printf("Replay finished: %d events published", report.Published)
 }
 
-func getConfigs() cmd.Config {
-	config := cmd.Config{
-		HTTPPort:                  goDotEnvVariable("HTTP_PORT"),
-		DBHost:                    goDotEnvVariable("DB_HOST"),
-		DBPort:                    goDotEnvVariable("DB_PORT"),
-		DBUser:                    goDotEnvVariable("DB_USER"),
-		DBPassword:                goDotEnvVariable("DB_PASSWORD"),
-		DBName:                    goDotEnvVariable("DB_NAME"),
-		DBSslMode:                 goDotEnvVariable("DB_SSLMODE"),
-		GeoServiceGrpcHost:        goDotEnvVariable("GEO_SERVICE_GRPC_HOST"),
-		KafkaHost:                 goDotEnvVariable("KAFKA_HOST"),
-		KafkaConsumerGroup:        goDotEnvVariable("KAFKA_CONSUMER_GROUP"),
-		KafkaBasketConfirmedTopic: goDotEnvVariable("KAFKA_BASKET_CONFIRMED_TOPIC"),
-		KafkaOrderChangedTopic:    goDotEnvVariable("KAFKA_ORDER_CHANGED_TOPIC"),
+// runDataFix lists registered data fixes or applies/reverts one of them.
+//
+// Usage:
+//
+//	app data-fix                                      # list fixes
+//	app data-fix -name clear-orphaned-storage-places  # dry run
+//	app data-fix -name clear-orphaned-storage-places -dry-run=false
+//	app data-fix -name clear-orphaned-storage-places -revert -dry-run=false
+func runDataFix(app cmd.CompositionRoot, args []string) {
+	flags := flag.NewFlagSet("data-fix", flag.ExitOnError)
+	name := flags.String("name", "", "data fix to run; lists available fixes when empty")
+	revert := flags.Bool("revert", false, "revert the latest execution instead of applying")
+	dryRun := flags.Bool("dry-run", true, "run inside a transaction that is rolled back")
+	if err := flags.Parse(args); err != nil {
+		log.Fatal(err.Error())
+	}
+
+	runner, err := app.CreateDataFixRunner()
+	if err != nil {
+		log.Fatalf("invalid data fix registry: %v", err)
+	}
+
+	if *name == "" {
+		for _, fix := range runner.Fixes() {
+			log.Printf("%s: %s", fix.Name(), fix.Description())
+		}
+		return
+	}
+
+	run := runner.Apply
+	if *revert {
+		run = runner.Revert
 	}
-	return config
+
+	report, err := run(context.Background(), *name, *dryRun)
+	if err != nil {
+		log.Fatalf("data fix %s failed: %v", *name, err)
+	}
+
+	log.Printf("Data fix %s finished: %d rows affected (dry run: %t)", report.Name, report.AffectedRows, report.DryRun)
 }
 
-func goDotEnvVariable(key string) string {
-	err := godotenv.Load(".env")
+// runBackfillProjection lists registered projections with their progress or backfills one of
+// them from the change log of a tenant. An interrupted backfill resumes where it stopped.
+//
+// Usage:
+//
+//	app backfill-projection                                   # list projections
+//	app backfill-projection -name orders-per-day [-tenant acme] [-batch 500] [-rate 1000]
+//	app backfill-projection -name orders-per-day -restart     # rebuild from scratch
+func runBackfillProjection(app cmd.CompositionRoot, args []string) {
+	flags := flag.NewFlagSet("backfill-projection", flag.ExitOnError)
+	name := flags.String("name", "", "projection to backfill; lists available projections when empty")
+	tenantID := flags.String("tenant", "", "tenant whose change log is projected (default tenant when empty)")
+	batchSize := flags.Int("batch", 500, "number of changes projected in one transaction")
+	rate := flags.Int("rate", 1000, "maximum changes projected per second (0 disables throttling)")
+	restart := flags.Bool("restart", false, "reset the projection and backfill it from the first change")
+	if err := flags.Parse(args); err != nil {
+		log.Fatal(err.Error())
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if *tenantID != "" {
+		id, err := tenant.NewID(*tenantID)
+		if err != nil {
+			log.Fatalf("invalid -tenant value: %v", err)
+		}
+		ctx = tenant.WithID(ctx, id)
+	}
+
+	runner, err := app.CreateProjectionBackfillRunner()
 	if err != nil {
-		log.Fatalf("Error loading .env file")
+		log.Fatalf("invalid projection registry: %v", err)
+	}
+
+	if *name == "" {
+		for _, projection := range runner.Projections() {
+			progress, progressErr := runner.Progress(ctx, projection.Name())
+			if progressErr != nil {
+				log.Fatalf("projection %s: %v", projection.Name(), progressErr)
+			}
+			log.Printf("%s: %s (change %d of %d)",
+				projection.Name(), projection.Description(), progress.Cursor, progress.Head)
+		}
+		return
 	}
-	return os.Getenv(key)
+
+	progress, err := runner.Backfill(ctx, *name, postgres_adapter.BackfillOptions{
+		BatchSize:    *batchSize,
+		MaxPerSecond: *rate,
+		Restart:      *restart,
+	})
+	if err != nil {
+		log.Fatalf("Backfill of %s stopped at change %d of %d (rerun to resume): %v",
+			*name, progress.Cursor, progress.Head, err)
+	}
+
+	log.Printf("Backfill of %s finished: %d changes projected, up to change %d",
+		*name, progress.Projected, progress.Cursor)
 }
 
-func startWebServer(app cmd.CompositionRoot, port string) {
+// runCopyToStaging replaces the data of a tenant in the configured database, which must be
+// a staging database, with an anonymized copy of its data in the STAGING_SOURCE_DSN database.
+// Personal data is pseudonymized with PSEUDONYMIZATION_KEY, so repeated copies agree.
+//
+// Usage:
+//
+//	app copy-to-staging [-tenant acme] [-batch 500]
+func runCopyToStaging(app cmd.CompositionRoot, configs config.Config, args []string) {
+	flags := flag.NewFlagSet("copy-to-staging", flag.ExitOnError)
+	tenantID := flags.String("tenant", "", "tenant whose data is copied (default tenant when empty)")
+	batchSize := flags.Int("batch", 500, "number of rows inserted at once")
+	if err := flags.Parse(args); err != nil {
+		log.Fatal(err.Error())
+	}
+
+	if configs.StagingSourceDSN == "" {
+		log.Fatal("STAGING_SOURCE_DSN is not set")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if *tenantID != "" {
+		id, err := tenant.NewID(*tenantID)
+		if err != nil {
+			log.Fatalf("invalid -tenant value: %v", err)
+		}
+		ctx = tenant.WithID(ctx, id)
+	}
+
+	copier, err := app.CreateStagingCopier(mustGormOpen(configs.StagingSourceDSN))
+	if err != nil {
+		log.Fatalf("invalid pseudonymization key: %v", err)
+	}
+
+	report, err := copier.Copy(ctx, *batchSize)
+	if err != nil {
+		log.Fatalf("Copy to staging failed, staging data is unchanged: %v", err)
+	}
+
+	var rows int64
+	for _, table := range report.Tables {
+		rows += table.Rows
+	}
+	log.Printf("Copy to staging finished: %d rows in %d tables", rows, len(report.Tables))
+}
+
+// startWebServer serves the API in the background; the returned server's Shutdown stops it gracefully.
+// Without serveAPI only the health, metrics and diagnostics endpoints are served, so a worker
+// process can still be probed and triaged.
+func startWebServer(app cmd.CompositionRoot, port string, serveAPI bool) *echo.Echo {
 	e := echo.New()
+	e.Use(httpin.RequestIDMiddleware)
+	e.Use(httpin.TracingMiddleware)
+	e.Use(httpin.RequestLoggingMiddleware(app.Logger()))
+	e.Use(httpin.RequestMetricsMiddleware)
+	e.Use(httpin.ProblemMiddleware)
+	e.Use(httpin.TenantMiddleware(app.TenantCredentials()))
+	e.Use(httpin.SyntheticDataMiddleware)
+	e.Use(httpin.APIUsageMiddleware(app.CreateMeterAPIUsageCommandHandler()))
+	e.Use(httpin.ErrorRecorderMiddleware(app.RecentErrors()))
 
 	// Health check endpoint
 	e.GET("/health", func(c echo.Context) error {
 		return c.String(http.StatusOK, "Healthy")
 	})
 
-	// Swagger UI endpoint
-	e.GET("/swagger/*", echoSwagger.WrapHandler)
+	// Runtime metrics (expvar), including the adaptive job frequency and statement timeouts
+	e.GET("/debug/vars", echo.WrapHandler(expvar.Handler()))
 
-	// Create HTTP server with all dependencies
-	httpServer := app.CreateHTTPServer()
+	// Request, command, dispatch and database metrics in the Prometheus text format
+	e.GET("/metrics", echo.WrapHandler(metrics.Handler()))
 
-	// Register API routes
-	servers.RegisterHandlers(e, httpServer)
+	// Recent errors, build info, config fingerprint and dependency statuses for incident triage
+	e.GET("/admin/diagnostics", httpin.DiagnosticsHandler(app.CreateDiagnosticsReporter()))
+
+	// Commands, queries and integration events described by their Go types
+	messageCatalog, err := cmd.MessageCatalog()
+	if err != nil {
+		log.Fatalf("invalid message catalog: %v", err)
+	}
+	e.GET("/admin/catalog", httpin.CatalogHandler(messageCatalog))
 
 	log.Printf("Starting HTTP server on port %s", port)
-	log.Printf("Swagger UI available at: http://localhost:%s/swagger/index.html", port)
-	log.Printf("OpenAPI spec available at: http://localhost:%s/swagger/doc.json", port)
-	e.Logger.Fatal(e.Start(fmt.Sprintf("0.0.0.0:%s", port)))
+	if serveAPI {
+		// Swagger UI endpoint
+		e.GET("/swagger/*", echoSwagger.WrapHandler)
+
+		// Create HTTP server with all dependencies
+		httpServer := app.CreateHTTPServer()
+
+		// Register API routes
+		servers.RegisterHandlers(e, httpServer)
+
+		// Uploads to the local blob storage, authorized by the signature of the issued URL
+		if receiver := app.LocalBlobReceiver(); receiver != nil {
+			e.PUT(blobstore.LocalUploadPath+"*", echo.WrapHandler(receiver))
+		}
+
+		log.Printf("Swagger UI available at: http://localhost:%s/swagger/index.html", port)
+		log.Printf("OpenAPI spec available at: http://localhost:%s/swagger/doc.json", port)
+	}
+	go func() {
+		if err := e.Start(fmt.Sprintf("0.0.0.0:%s", port)); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			e.Logger.Fatal(err)
+		}
+	}()
+	return e
+}
+
+// startGRPCServer serves the gRPC API on port next to the HTTP API in the background.
+// The returned function stops the server gracefully, closing the calls still running once its
+// context is done. It is nil when no port is set.
+func startGRPCServer(app cmd.CompositionRoot, port string) cmd.ShutdownFunc {
+	if port == "" {
+		return nil
+	}
+
+	var listenConfig net.ListenConfig
+	listener, err := listenConfig.Listen(context.Background(), "tcp", fmt.Sprintf("0.0.0.0:%s", port))
+	if err != nil {
+		log.Fatalf("gRPC server: %v", err)
+	}
+
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		grpcin.TenantInterceptor(app.TenantCredentials()), grpcin.SyntheticDataInterceptor,
+	))
+	deliverypb.RegisterDeliveryServiceServer(server, app.CreateGRPCServer())
+
+	log.Printf("Starting gRPC server on port %s", port)
+	go func() {
+		if serveErr := server.Serve(listener); serveErr != nil {
+			log.Printf("gRPC server: %v", serveErr)
+		}
+	}()
+
+	return func(ctx context.Context) error {
+		graceful := make(chan struct{})
+		go func() {
+			server.GracefulStop()
+			close(graceful)
+		}()
+		select {
+		case <-graceful:
+			return nil
+		case <-ctx.Done():
+			server.Stop()
+			return fmt.Errorf("timed out, closed remaining calls: %w", ctx.Err())
+		}
+	}
+}
+
+// closeDatabase closes the connection pool once the components using it have stopped.
+func closeDatabase(db *gorm.DB) cmd.ShutdownFunc {
+	return func(context.Context) error {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return err
+		}
+		return sqlDB.Close()
+	}
 }
 
 func makeConnectionString(
@@ -181,23 +697,23 @@ func createDBIfNotExists(
 ) {
 	dsn, err := makeConnectionString(host, port, user, password, "postgres", sslMode)
 	if err != nil {
-		log.Fatalf("Ошибка подключения к PostgreSQL: %v", err)
+		log.Fatalf("connection to postgres: %v", err)
 	}
 	db, err := sql.Open("postgres", dsn)
 	if err != nil {
-		log.Fatalf("Ошибка подключения к PostgreSQL: %v", err)
+		log.Fatalf("connection to postgres: %v", err)
 	}
 
 	defer func() {
 		if err = db.Close(); err != nil {
-			log.Printf("ошибка при закрытии db: %v", err)
+			log.Printf("closing db: %v", err)
 		}
 	}()
 
-	// Создаём базу данных, если её нет
+	// Create the database if it does not exist yet
 	_, err = db.Exec(fmt.Sprintf("CREATE DATABASE %s", dbName))
 	if err != nil {
-		log.Printf("Ошибка создания БД (возможно, уже существует): %v", err)
+		log.Printf("creating database (it may already exist): %v", err)
 	}
 }
 
@@ -223,17 +739,269 @@ func mustGormOpen(connectionString string) *gorm.DB {
 func mustAutoMigrate(db *gorm.DB) {
 	err := db.AutoMigrate(&courierrepo.CourierDTO{})
 	if err != nil {
-		log.Fatalf("Ошибка миграции: %v", err)
+		log.Fatalf("migration: %v", err)
 	}
 
 	err = db.AutoMigrate(&courierrepo.StoragePlaceDTO{})
 	if err != nil {
-		log.Fatalf("Ошибка миграции: %v", err)
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&courierrepo.MaintenanceWindowDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&courierrepo.AbsenceDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&courierrepo.ScheduleWindowDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
 	}
 
 	err = db.AutoMigrate(&orderrepo.OrderDTO{})
 	if err != nil {
-		log.Fatalf("Ошибка миграции: %v", err)
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&orderrepo.OrderMessageDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&orderrepo.OrderItemDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&orderrepo.OrderPaymentTransitionDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&orderrepo.ArchivedOrderDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&pickuprepo.PickupSlotDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&pickuprepo.PickupSlotBookingDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&earningsrepo.EntryDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&relayrepo.HandoverDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.AssignmentExplanationDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.AssignmentScoreFactorDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.AnnouncementDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.AnnouncementDeliveryDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.MicrozoneDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.APIUsageDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.CourierDeviceReadingDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.SurgeToggleDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.SLATargetDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.SLAComplianceDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.MatchingRuleDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.CourierLocationPointDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.CourierDailyTrackDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.VerificationAttemptDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&outboxrepo.OutboxMessageDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.ChangeLogDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.CourierAvailabilityDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.DataFixExecutionDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.ProjectionCheckpointDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.OrderHistoryEntryDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.PersonalDataErasureDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.BulkCancellationDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.OrderEscalationDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.CourierDeliveryDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.CourierBadgeDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.BlacklistEntryDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&postgres_adapter.ResolvedAddressDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+
+	err = db.AutoMigrate(&blobrepo.UploadDTO{})
+	if err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+}
+
+func mustApplyTenancyPolicies(db *gorm.DB, defaultTenantID string) {
+	defaultTenant, err := tenant.NewID(defaultTenantID)
+	if err != nil {
+		log.Fatalf("default tenant: %v", err)
+	}
+
+	if err = postgres_adapter.ApplyTenancyPolicies(db, defaultTenant); err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+}
+
+func mustApplySyntheticDataMarkers(db *gorm.DB) {
+	if err := postgres_adapter.ApplySyntheticDataMarkers(db); err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+}
+
+func mustApplyOrderArchival(db *gorm.DB) {
+	if err := postgres_adapter.ApplyOrderArchival(db); err != nil {
+		log.Fatalf("migration: %v", err)
+	}
+}
+
+func mustRegisterStatementTimeoutErrors(db *gorm.DB) {
+	if err := postgres_adapter.RegisterStatementTimeoutErrors(db); err != nil {
+		log.Fatalf("statement timeouts: %v", err)
+	}
+}
+
+func mustUseQueryMetrics(db *gorm.DB) {
+	if err := db.Use(postgres_adapter.QueryMetrics{}); err != nil {
+		log.Fatalf("query metrics: %v", err)
+	}
+}
+
+func mustUseQueryTracing(db *gorm.DB) {
+	if err := db.Use(postgres_adapter.QueryTracing{}); err != nil {
+		log.Fatalf("query tracing: %v", err)
+	}
+}
+
+// mustEnableShadowWrites writes the configured shadow columns of hot tables together with
+// their columns and compares them on every read. An empty configuration turns shadow writes off.
+func mustEnableShadowWrites(db *gorm.DB, spec string, logger *slog.Logger) {
+	columns, err := postgres_adapter.ParseShadowColumns(spec)
+	if err != nil {
+		log.Fatalf("shadow writes: %v", err)
+	}
+
+	if err = postgres_adapter.ApplyShadowWrites(db, columns); err != nil {
+		log.Fatalf("shadow writes: %v", err)
+	}
+	if err = postgres_adapter.RegisterShadowReadComparison(db, columns, logger); err != nil {
+		log.Fatalf("shadow writes: %v", err)
 	}
 }
 
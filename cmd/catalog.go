@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"delivery/internal/adapters/out/postgres/outboxrepo"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/pkg/catalog"
+)
+
+// MessageCatalog describes every command, query and integration event of the service.
+// It needs no configuration or connections, so it can be generated at build time as well as served.
+// Every new handler must be listed here; the catalog test fails for handlers that are missing.
+func MessageCatalog() (catalog.Catalog, error) {
+	commandMessages, err := catalog.Handlers(
+		new(commands.AddCourierStorageCommandHandler),
+		new(commands.ApproveOrderReviewCommandHandler),
+		new(commands.ArchiveCompletedOrdersCommandHandler),
+		new(commands.AssignCourierCommandHandler),
+		new(commands.BatchCreateCouriersCommandHandler),
+		new(commands.BroadcastAnnouncementCommandHandler),
+		new(commands.BulkCancelOrdersCommandHandler),
+		new(commands.CancelCourierAbsenceCommandHandler),
+		new(commands.CancelCourierMaintenanceCommandHandler),
+		new(commands.CancelOrderCommandHandler),
+		new(commands.ChangePickupSlotCapacityCommandHandler),
+		new(commands.ChangeSurgeModeCommandHandler),
+		new(commands.ClearCourierReviewFlagCommandHandler),
+		new(commands.ClearCourierScheduleCommandHandler),
+		new(commands.CheckFleetCapacityCommandHandler),
+		new(commands.ComputeSLAComplianceCommandHandler),
+		new(commands.ConfirmOrderHandoverCommandHandler),
+		new(commands.CreateCourierCommandHandler),
+		new(commands.CreateOrderCommandHandler),
+		new(commands.CreatePickupSlotCommandHandler),
+		new(commands.DeactivateCourierCommandHandler),
+		new(commands.ErasePersonalDataCommandHandler),
+		new(commands.EscalateStaleUrgentOrdersCommandHandler),
+		new(commands.HandOverAbsentCourierOrdersCommandHandler),
+		new(commands.HandOverShiftEndOrdersCommandHandler),
+		new(commands.ImportCourierLocationsCommandHandler),
+		new(commands.ImportOrdersCommandHandler),
+		new(commands.IssueBlobUploadCommandHandler),
+		new(commands.MergeCouriersCommandHandler),
+		new(commands.MeterAPIUsageCommandHandler),
+		new(commands.MoveCouriersCommandHandler),
+		new(commands.ObserveSurgeDemandCommandHandler),
+		new(commands.PlanCourierAbsenceCommandHandler),
+		new(commands.PostOrderMessageCommandHandler),
+		new(commands.PurgeOrphanedBlobsCommandHandler),
+		new(commands.PurgeSyntheticDataCommandHandler),
+		new(commands.RateOrderCommandHandler),
+		new(commands.RecalculateETACommandHandler),
+		new(commands.RecomputeMicrozonesCommandHandler),
+		new(commands.RecordDeviceTelemetryCommandHandler),
+		new(commands.RelayOutboxCommandHandler),
+		new(commands.ReleaseOrderBatchesCommandHandler),
+		new(commands.ReplaceMatchingRulesCommandHandler),
+		new(commands.ReplaceSLATargetsCommandHandler),
+		new(commands.ReplayOutboxCommandHandler),
+		new(commands.RescheduleCourierMaintenanceCommandHandler),
+		new(commands.ScheduleCourierMaintenanceCommandHandler),
+		new(commands.SetCourierBreakCommandHandler),
+		new(commands.SetCourierInsuranceCommandHandler),
+		new(commands.SetCourierScheduleCommandHandler),
+		new(commands.SetCourierShiftCommandHandler),
+		new(commands.SetRolloutPercentageCommandHandler),
+		new(commands.SetStoragePlaceMaintenanceCommandHandler),
+		new(commands.ShareOrderTrackingCommandHandler),
+		new(commands.TipOrderCommandHandler),
+		new(commands.TransferOrderCommandHandler),
+		new(commands.UnassignInactiveCouriersCommandHandler),
+		new(commands.UpdateCourierLocationCommandHandler),
+		new(commands.UpdateCourierProfileCommandHandler),
+		new(commands.UpdateOrderPaymentCommandHandler),
+		new(commands.UpdateProjectionsCommandHandler),
+	)
+	if err != nil {
+		return catalog.Catalog{}, err
+	}
+
+	queryMessages, err := catalog.Handlers(
+		new(queries.GetAllCouriersQueryHandler),
+		new(queries.GetAnnouncementsQueryHandler),
+		new(queries.GetAPIUsageQueryHandler),
+		new(queries.GetAssignmentExplanationQueryHandler),
+		new(queries.GetBadgeRulesQueryHandler),
+		new(queries.GetChangesQueryHandler),
+		new(queries.GetCourierAvailabilityQueryHandler),
+		new(queries.GetCourierQueryHandler),
+		new(queries.GetCourierMaintenanceWindowsQueryHandler),
+		new(queries.GetCourierStatsQueryHandler),
+		new(queries.GetCourierWorkingHoursQueryHandler),
+		new(queries.GetDeviceHealthQueryHandler),
+		new(queries.GetFleetWhatIfQueryHandler),
+		new(queries.GetFreeCouriersQueryHandler),
+		new(queries.GetMatchingRulesQueryHandler),
+		new(queries.GetMicrozonesQueryHandler),
+		new(queries.GetOrderByExternalReferenceQueryHandler),
+		new(queries.GetOrderETAQueryHandler),
+		new(queries.GetOrderHistoryQueryHandler),
+		new(queries.GetOrderStateQueryHandler),
+		new(queries.GetOrderThreadQueryHandler),
+		new(queries.GetOrdersPageQueryHandler),
+		new(queries.GetOrdersUnderReviewQueryHandler),
+		new(queries.GetPayoutExportQueryHandler),
+		new(queries.GetPickupSlotsQueryHandler),
+		new(queries.GetSLAReportQueryHandler),
+		new(queries.GetSLATargetsQueryHandler),
+		new(queries.GetSLOStatusQueryHandler),
+		new(queries.GetSharedTrackingQueryHandler),
+		new(queries.GetSurgeModeQueryHandler),
+		new(queries.GetUncompletedOrdersQueryHandler),
+	)
+	if err != nil {
+		return catalog.Catalog{}, err
+	}
+
+	return catalog.Catalog{
+		Commands: commandMessages,
+		Queries:  queryMessages,
+		Events:   catalog.Events(outboxrepo.EventPayloads()),
+	}, nil
+}
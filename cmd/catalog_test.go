@@ -0,0 +1,86 @@
+package cmd_test
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"delivery/cmd"
+	"delivery/internal/pkg/catalog"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// catalogFile is the committed message catalog, regenerated with `go run ./cmd/app catalog`.
+const catalogFile = "../api/catalog/catalog.json"
+
+func TestMessageCatalog_MatchesCommittedCatalog(t *testing.T) {
+	messageCatalog, err := cmd.MessageCatalog()
+	require.NoError(t, err)
+
+	generated, err := json.MarshalIndent(messageCatalog, "", "  ")
+	require.NoError(t, err)
+	committed, err := os.ReadFile(catalogFile)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, string(committed), string(generated),
+		"message types changed: regenerate %s with `go run ./cmd/app catalog > api/catalog/catalog.json` "+
+			"and update the OpenAPI spec if the API contract changed", catalogFile)
+}
+
+func TestMessageCatalog_ListsEveryHandler(t *testing.T) {
+	messageCatalog, err := cmd.MessageCatalog()
+	require.NoError(t, err)
+
+	for dir, messages := range map[string][]string{
+		"../internal/core/application/usecases/commands": messageNames(messageCatalog.Commands),
+		"../internal/core/application/usecases/queries":  messageNames(messageCatalog.Queries),
+	} {
+		handled := handledMessages(t, dir)
+		require.NotEmpty(t, handled, dir)
+		assert.ElementsMatch(t, handled, messages, "handlers in %s are missing from cmd.MessageCatalog", dir)
+	}
+}
+
+// handledMessages returns the message types that handlers in the package directory handle.
+func handledMessages(t *testing.T, dir string) []string {
+	t.Helper()
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	require.NoError(t, err)
+
+	var messages []string
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+
+		parsed, parseErr := parser.ParseFile(fset, file, nil, parser.SkipObjectResolution)
+		require.NoError(t, parseErr)
+
+		for _, decl := range parsed.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Name.Name != "Handle" || fn.Recv == nil || len(fn.Type.Params.List) != 2 {
+				continue
+			}
+			if message, isIdent := fn.Type.Params.List[1].Type.(*ast.Ident); isIdent {
+				messages = append(messages, message.Name)
+			}
+		}
+	}
+	return messages
+}
+
+func messageNames(messages []catalog.Message) []string {
+	names := make([]string, len(messages))
+	for i, message := range messages {
+		names[i] = message.Name
+	}
+	return names
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"delivery/internal/pkg/errs"
+)
+
+// Command is what the application does, named by its first argument.
+type Command string
+
+const (
+	// RunCommand serves the API and runs the background jobs in one process. It is the
+	// command run without arguments.
+	RunCommand Command = ""
+
+	// ServeCommand and WorkerCommand run a single tier of the service, so the API and the
+	// background jobs can be scaled independently.
+	ServeCommand  Command = "serve"
+	WorkerCommand Command = "worker"
+
+	// CatalogCommand prints the message catalog committed as api/catalog/catalog.json.
+	CatalogCommand Command = "catalog"
+
+	// ReplayOutboxCommand, DataFixCommand, BackfillProjectionCommand and CopyToStagingCommand
+	// are admin commands run instead of the service, taking their own flags.
+	ReplayOutboxCommand       Command = "replay-outbox"
+	DataFixCommand            Command = "data-fix"
+	BackfillProjectionCommand Command = "backfill-projection"
+	CopyToStagingCommand      Command = "copy-to-staging"
+)
+
+// knownCommands lists the commands in the order they are suggested, with whether they take flags.
+var knownCommands = []struct {
+	command  Command
+	hasFlags bool
+}{
+	{ServeCommand, false},
+	{WorkerCommand, false},
+	{CatalogCommand, false},
+	{ReplayOutboxCommand, true},
+	{DataFixCommand, true},
+	{BackfillProjectionCommand, true},
+	{CopyToStagingCommand, true},
+}
+
+// ParseCommand returns the command named by the first of args, the arguments without the
+// program name, and the arguments following it, which are left to the command to parse.
+// Returns an error if the command is unknown or takes no arguments but is given some.
+//
+// Example:
+//
+//	command, args, err := ParseCommand([]string{"data-fix", "-name", "clear-review-flags"})
+//	// command == DataFixCommand, args == []string{"-name", "clear-review-flags"}
+func ParseCommand(args []string) (Command, []string, error) {
+	if len(args) == 0 {
+		return RunCommand, nil, nil
+	}
+
+	name, rest := Command(args[0]), args[1:]
+	for _, known := range knownCommands {
+		if known.command != name {
+			continue
+		}
+		if !known.hasFlags && len(rest) > 0 {
+			return "", nil, errs.NewValueIsInvalidErrorWithCause(
+				"command",
+				fmt.Errorf("%s takes no arguments, got %q", name, strings.Join(rest, " ")),
+			)
+		}
+		return name, rest, nil
+	}
+
+	names := make([]string, 0, len(knownCommands))
+	for _, known := range knownCommands {
+		names = append(names, string(known.command))
+	}
+	return "", nil, errs.NewValueIsInvalidErrorWithCause(
+		"command",
+		fmt.Errorf("unknown command %q, expected one of %s", name, strings.Join(names, ", ")),
+	)
+}
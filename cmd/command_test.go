@@ -0,0 +1,72 @@
+package cmd_test
+
+import (
+	"testing"
+
+	"delivery/cmd"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCommand(t *testing.T) {
+	t.Run("should run both tiers without arguments", func(t *testing.T) {
+		command, args, err := cmd.ParseCommand(nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, cmd.RunCommand, command)
+		assert.Empty(t, args)
+	})
+
+	t.Run("should parse the commands taking no arguments", func(t *testing.T) {
+		for _, expected := range []cmd.Command{cmd.ServeCommand, cmd.WorkerCommand, cmd.CatalogCommand} {
+			command, args, err := cmd.ParseCommand([]string{string(expected)})
+
+			require.NoError(t, err)
+			assert.Equal(t, expected, command)
+			assert.Empty(t, args)
+		}
+	})
+
+	t.Run("should leave the flags of admin commands to the command", func(t *testing.T) {
+		for _, expected := range []cmd.Command{
+			cmd.ReplayOutboxCommand, cmd.DataFixCommand, cmd.BackfillProjectionCommand, cmd.CopyToStagingCommand,
+		} {
+			command, args, err := cmd.ParseCommand([]string{string(expected), "-tenant", "acme"})
+
+			require.NoError(t, err)
+			assert.Equal(t, expected, command)
+			assert.Equal(t, []string{"-tenant", "acme"}, args)
+		}
+	})
+
+	t.Run("should accept admin commands without flags", func(t *testing.T) {
+		command, args, err := cmd.ParseCommand([]string{"data-fix"})
+
+		require.NoError(t, err)
+		assert.Equal(t, cmd.DataFixCommand, command)
+		assert.Empty(t, args)
+	})
+
+	t.Run("should refuse arguments to commands taking none", func(t *testing.T) {
+		_, _, err := cmd.ParseCommand([]string{"worker", "-since", "2025-01-01T00:00:00Z"})
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+		assert.Contains(t, err.Error(), "worker takes no arguments")
+	})
+
+	t.Run("should refuse an unknown command", func(t *testing.T) {
+		_, _, err := cmd.ParseCommand([]string{"replay"})
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+		assert.Contains(t, err.Error(), `unknown command "replay"`)
+		assert.Contains(t, err.Error(), "replay-outbox")
+	})
+
+	t.Run("should refuse a flag in place of the command", func(t *testing.T) {
+		_, _, err := cmd.ParseCommand([]string{"-serve"})
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+}
@@ -1,28 +1,172 @@
 package cmd
 
 import (
+	"context"
+	"delivery/cmd/config"
+	grpcin "delivery/internal/adapters/in/grpc"
 	"delivery/internal/adapters/in/http"
+	"delivery/internal/adapters/out/alerthook"
+	"delivery/internal/adapters/out/availabilityhook"
+	"delivery/internal/adapters/out/blobstore"
+	"delivery/internal/adapters/out/fraudservice"
+	"delivery/internal/adapters/out/geo"
+	"delivery/internal/adapters/out/identityservice"
 	"delivery/internal/adapters/out/postgres"
+	"delivery/internal/adapters/out/postgres/blobrepo"
+	"delivery/internal/adapters/out/postgres/outboxrepo"
+	"delivery/internal/adapters/out/readcache"
 	"delivery/internal/core/application/usecases/commands"
 	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/badge"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/device"
+	"delivery/internal/core/domain/model/escalation"
+	"delivery/internal/core/domain/model/identity"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/microzone"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/slo"
+	"delivery/internal/core/domain/model/surge"
+	"delivery/internal/core/domain/model/usage"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/core/ports"
 	"delivery/internal/jobs"
+	"delivery/internal/pkg/diagnostics"
+	"delivery/internal/pkg/pseudonym"
+	"delivery/internal/pkg/querycost"
+	"delivery/internal/pkg/requestid"
+	"delivery/internal/pkg/rollout"
+	"delivery/internal/pkg/tenant"
+	"errors"
 	"log/slog"
+	"math"
+	"net"
+	"net/url"
+	"time"
 
 	"gorm.io/gorm"
 )
 
+const (
+	// blobStorageTimeout bounds every request to the S3 blob storage.
+	blobStorageTimeout = 10 * time.Second
+
+	// recentErrorsCapacity is the number of recent handler and job errors kept for diagnostics.
+	recentErrorsCapacity = 100
+
+	// dependencyCheckTimeout bounds every dependency check of the diagnostics endpoint.
+	dependencyCheckTimeout = 2 * time.Second
+
+	// bestFitDispatchFlag names the rollout of the best-fit dispatch strategy in the admin API.
+	bestFitDispatchFlag = "best-fit-dispatch"
+)
+
 type CompositionRoot struct {
+	config     config.Config
 	gormDB     *gorm.DB
 	uowFactory postgres.GormUnitOfWorkFactory
 	logger     *slog.Logger
+
+	// bestFitDispatch is shared by the assignment job and the admin API,
+	// so runtime changes apply to the running dispatcher.
+	bestFitDispatch *rollout.Flag
+	rollouts        *rollout.Registry
+
+	// workingHours is the daily working hours limit enforced on shifts and assignments.
+	workingHours courier.WorkingHoursLimit
+
+	// maintenanceWarning is how long before a maintenance window the courier is warned and not dispatched.
+	maintenanceWarning time.Duration
+
+	// batchingWindow is the period economy orders accumulate over before they enter dispatch.
+	batchingWindow order.BatchingWindow
+
+	// insuranceThreshold is the declared value from which orders are insured.
+	insuranceThreshold order.InsuranceThreshold
+
+	// microzoneClustering is the density dense-demand microzones are computed with.
+	microzoneClustering microzone.Clustering
+
+	// apiQuota is the monthly quota of every partner client of the API.
+	apiQuota usage.Quota
+
+	// deviceHealth decides which courier devices are fit for receiving orders.
+	deviceHealth device.HealthPolicy
+
+	// identityAttempts limits how often couriers may fail identity verification on shift start.
+	identityAttempts identity.AttemptLimit
+
+	// routeDeviation decides when orders are flagged because their courier strayed from the route.
+	routeDeviation order.RouteDeviationPolicy
+
+	// surgePolicy decides when the surge mode activates by itself and how it relaxes dispatch.
+	surgePolicy surge.Policy
+
+	// escalationPolicy escalates urgent orders waiting for a courier; nil when disabled.
+	escalationPolicy *escalation.Policy
+
+	// badgeRules are the badges couriers earn, awarded by the courier badges projection.
+	badgeRules []badge.Rule
+
+	// pickupSlots makes assignments book warehouse pickup slots.
+	pickupSlots bool
+
+	// shiftEndHandover drains couriers approaching the working hours limit and hands over
+	// the orders they cannot deliver before it.
+	shiftEndHandover bool
+
+	// dispatchDegradation is shared by the assignment handler and the assignment job, which switches
+	// the dispatcher to greedy mode under load; nil when disabled.
+	dispatchDegradation *commands.DispatchDegradation
+
+	// blobStorage keeps uploaded files; nil when no backend is configured.
+	blobStorage ports.BlobStorage
+
+	// localBlobStorage receives the uploads itself when the local backend is configured.
+	localBlobStorage *blobstore.LocalStorage
+
+	// recentErrors keeps the errors logged by handlers and jobs for the diagnostics endpoint.
+	recentErrors *diagnostics.ErrorRing
+
+	// trackingCache keeps the public tracking views of this instance; nil when disabled.
+	trackingCache *queries.SharedTrackingCache
 }
 
-func NewCompositionRoot(_ Config, gormDB *gorm.DB, logger *slog.Logger) CompositionRoot {
-	return CompositionRoot{
-		gormDB:     gormDB,
-		uowFactory: *postgres.NewGormUnitOfWorkFactory(gormDB),
-		logger:     logger,
+func NewCompositionRoot(configs config.Config, gormDB *gorm.DB, logger *slog.Logger) CompositionRoot {
+	recentErrors := diagnostics.NewErrorRing(recentErrorsCapacity)
+	c := CompositionRoot{
+		config:       configs,
+		gormDB:       gormDB,
+		logger:       slog.New(diagnostics.NewHandler(requestid.NewLogHandler(logger.Handler()), recentErrors)),
+		recentErrors: recentErrors,
 	}
+
+	// The grid goes first: the policies below may create locations
+	_ = kernel.SetGrid(c.deliveryGrid())
+
+	commandDB := querycost.WithBudget(gormDB, querycost.CommandWorkload, c.config.CommandStatementTimeout)
+	c.uowFactory = *postgres.NewGormUnitOfWorkFactory(commandDB)
+
+	c.bestFitDispatch, _ = rollout.NewFlag(bestFitDispatchFlag, c.bestFitDispatchRollout())
+	c.rollouts = rollout.NewRegistry(c.bestFitDispatch)
+	c.workingHours = c.workingHoursLimit()
+	c.maintenanceWarning = c.config.MaintenanceWarningBefore
+	c.batchingWindow = c.economyBatchingWindow()
+	c.insuranceThreshold = c.orderInsuranceThreshold()
+	c.microzoneClustering = c.microzoneDensity()
+	c.apiQuota = c.apiMonthlyQuota()
+	c.deviceHealth = c.deviceHealthPolicy()
+	c.identityAttempts = c.identityAttemptLimit()
+	c.routeDeviation = c.routeDeviationPolicy()
+	c.surgePolicy = c.surgeModePolicy()
+	c.escalationPolicy = c.urgentOrderEscalationPolicy()
+	c.badgeRules = c.courierBadgeRules()
+	c.pickupSlots = c.config.PickupSlotsEnabled
+	c.shiftEndHandover = c.config.ShiftEndHandoverEnabled
+	c.dispatchDegradation = c.dispatchDegradationThresholds()
+	c.configureBlobStorage()
+	c.trackingCache = c.sharedTrackingCache()
+	return c
 }
 
 func (c *CompositionRoot) CreateAddCourierStorageCommandHandler() commands.AddCourierStorageCommandHandler {
@@ -32,6 +176,178 @@ func (c *CompositionRoot) CreateAddCourierStorageCommandHandler() commands.AddCo
 	return commands.NewAddCourierStorageCommandHandler(f)
 }
 
+func (c *CompositionRoot) CreateSetStoragePlaceMaintenanceCommandHandler() commands.SetStoragePlaceMaintenanceCommandHandler {
+	var f commands.CourierUoWFactory = FuncCourierUoWFactory(func() commands.CourierUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewSetStoragePlaceMaintenanceCommandHandler(f)
+}
+
+func (c *CompositionRoot) CreateUpdateCourierProfileCommandHandler() commands.UpdateCourierProfileCommandHandler {
+	var f commands.CourierUoWFactory = FuncCourierUoWFactory(func() commands.CourierUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewUpdateCourierProfileCommandHandler(f)
+}
+
+func (c *CompositionRoot) CreateSetCourierShiftCommandHandler() commands.SetCourierShiftCommandHandler {
+	var f commands.CourierUoWFactory = FuncCourierUoWFactory(func() commands.CourierUoW {
+		return c.uowFactory.Create()
+	})
+	handler := commands.NewSetCourierShiftCommandHandler(f, c.workingHours)
+	if c.config.IdentityServiceURL != "" {
+		handler = handler.WithIdentityVerification(
+			identityservice.NewClient(c.config.IdentityServiceURL, c.config.IdentityServiceTimeout, c.logger),
+			postgres.NewGormVerificationAttemptRepository(c.gormDB),
+			c.identityAttempts,
+			outboxrepo.NewIdentityEventRecorder(outboxrepo.NewGormOutboxRepository(c.gormDB), c.logger),
+		)
+	}
+	return handler
+}
+
+func (c *CompositionRoot) CreateScheduleCourierMaintenanceCommandHandler() commands.ScheduleCourierMaintenanceCommandHandler {
+	var f commands.CourierUoWFactory = FuncCourierUoWFactory(func() commands.CourierUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewScheduleCourierMaintenanceCommandHandler(f)
+}
+
+func (c *CompositionRoot) CreateRescheduleCourierMaintenanceCommandHandler() commands.RescheduleCourierMaintenanceCommandHandler {
+	var f commands.CourierUoWFactory = FuncCourierUoWFactory(func() commands.CourierUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewRescheduleCourierMaintenanceCommandHandler(f)
+}
+
+func (c *CompositionRoot) CreateCancelCourierMaintenanceCommandHandler() commands.CancelCourierMaintenanceCommandHandler {
+	var f commands.CourierUoWFactory = FuncCourierUoWFactory(func() commands.CourierUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewCancelCourierMaintenanceCommandHandler(f)
+}
+
+func (c *CompositionRoot) CreateSetCourierScheduleCommandHandler() commands.SetCourierScheduleCommandHandler {
+	var f commands.CourierUoWFactory = FuncCourierUoWFactory(func() commands.CourierUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewSetCourierScheduleCommandHandler(f)
+}
+
+func (c *CompositionRoot) CreateClearCourierScheduleCommandHandler() commands.ClearCourierScheduleCommandHandler {
+	var f commands.CourierUoWFactory = FuncCourierUoWFactory(func() commands.CourierUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewClearCourierScheduleCommandHandler(f)
+}
+
+func (c *CompositionRoot) CreateClearCourierReviewFlagCommandHandler() commands.ClearCourierReviewFlagCommandHandler {
+	var f commands.CourierUoWFactory = FuncCourierUoWFactory(func() commands.CourierUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewClearCourierReviewFlagCommandHandler(f)
+}
+
+func (c *CompositionRoot) CreateSetCourierBreakCommandHandler() commands.SetCourierBreakCommandHandler {
+	var f commands.CourierUoWFactory = FuncCourierUoWFactory(func() commands.CourierUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewSetCourierBreakCommandHandler(f)
+}
+
+func (c *CompositionRoot) CreatePlanCourierAbsenceCommandHandler() commands.PlanCourierAbsenceCommandHandler {
+	var f commands.CourierUoWFactory = FuncCourierUoWFactory(func() commands.CourierUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewPlanCourierAbsenceCommandHandler(f)
+}
+
+func (c *CompositionRoot) CreateCancelCourierAbsenceCommandHandler() commands.CancelCourierAbsenceCommandHandler {
+	var f commands.CourierUoWFactory = FuncCourierUoWFactory(func() commands.CourierUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewCancelCourierAbsenceCommandHandler(f)
+}
+
+func (c *CompositionRoot) CreateSetCourierInsuranceCommandHandler() commands.SetCourierInsuranceCommandHandler {
+	var f commands.CourierUoWFactory = FuncCourierUoWFactory(func() commands.CourierUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewSetCourierInsuranceCommandHandler(f)
+}
+
+func (c *CompositionRoot) CreateConfirmOrderHandoverCommandHandler() commands.ConfirmOrderHandoverCommandHandler {
+	var f commands.UoWFactory = FuncUoWFactory(func() commands.UoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewConfirmOrderHandoverCommandHandler(f)
+}
+
+func (c *CompositionRoot) CreateCancelOrderCommandHandler() commands.CancelOrderCommandHandler {
+	var f commands.UoWFactory = FuncUoWFactory(func() commands.UoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewCancelOrderCommandHandler(f)
+}
+
+func (c *CompositionRoot) CreateBulkCancelOrdersCommandHandler() commands.BulkCancelOrdersCommandHandler {
+	var f commands.BulkCancellationUoWFactory = FuncBulkCancellationUoWFactory(func() commands.BulkCancellationUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewBulkCancelOrdersCommandHandler(f)
+}
+
+func (c *CompositionRoot) CreatePurgeSyntheticDataCommandHandler() commands.PurgeSyntheticDataCommandHandler {
+	return commands.NewPurgeSyntheticDataCommandHandler(postgres.NewGormSyntheticDataJanitor(c.gormDB))
+}
+
+func (c *CompositionRoot) CreateRecomputeMicrozonesCommandHandler() commands.RecomputeMicrozonesCommandHandler {
+	return commands.NewRecomputeMicrozonesCommandHandler(
+		postgres.NewGormDeliveryHistoryReader(c.gormDB),
+		postgres.NewGormMicrozoneRepository(c.gormDB),
+		c.microzoneClustering,
+	)
+}
+
+func (c *CompositionRoot) CreateReplaceSLATargetsCommandHandler() commands.ReplaceSLATargetsCommandHandler {
+	return commands.NewReplaceSLATargetsCommandHandler(postgres.NewGormSLARepository(c.gormDB))
+}
+
+func (c *CompositionRoot) CreateReplaceMatchingRulesCommandHandler() commands.ReplaceMatchingRulesCommandHandler {
+	return commands.NewReplaceMatchingRulesCommandHandler(postgres.NewGormMatchingRuleRepository(c.gormDB))
+}
+
+func (c *CompositionRoot) CreateComputeSLAComplianceCommandHandler() commands.ComputeSLAComplianceCommandHandler {
+	return commands.NewComputeSLAComplianceCommandHandler(
+		postgres.NewGormDeliveryHistoryReader(c.gormDB),
+		postgres.NewGormSLARepository(c.gormDB),
+	)
+}
+
+func (c *CompositionRoot) CreateMeterAPIUsageCommandHandler() commands.MeterAPIUsageCommandHandler {
+	return commands.NewMeterAPIUsageCommandHandler(postgres.NewGormUsageLedger(c.gormDB), c.apiQuota)
+}
+
+func (c *CompositionRoot) CreateRecordDeviceTelemetryCommandHandler() commands.RecordDeviceTelemetryCommandHandler {
+	recorder := outboxrepo.NewDeviceHealthEventRecorder(outboxrepo.NewGormOutboxRepository(c.gormDB), c.logger)
+	return commands.NewRecordDeviceTelemetryCommandHandler(postgres.NewGormDeviceTelemetryRepository(c.gormDB), c.deviceHealth, recorder)
+}
+
+func (c *CompositionRoot) CreateImportCourierLocationsCommandHandler() commands.ImportCourierLocationsCommandHandler {
+	return commands.NewImportCourierLocationsCommandHandler(postgres.NewGormCourierTrackRepository(c.gormDB))
+}
+
+func (c *CompositionRoot) CreateUpdateCourierLocationCommandHandler() commands.UpdateCourierLocationCommandHandler {
+	var f commands.CourierUoWFactory = FuncCourierUoWFactory(func() commands.CourierUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewUpdateCourierLocationCommandHandler(f, postgres.NewGormCourierTrackRepository(c.gormDB))
+}
+
+func (c *CompositionRoot) CreateSetRolloutPercentageCommandHandler() commands.SetRolloutPercentageCommandHandler {
+	return commands.NewSetRolloutPercentageCommandHandler(c.rollouts)
+}
+
 func (c *CompositionRoot) CreateCreateCourierCommandHandler() commands.CreateCourierCommandHandler {
 	var f commands.CourierUoWFactory = FuncCourierUoWFactory(func() commands.CourierUoW {
 		return c.uowFactory.Create()
@@ -39,70 +355,1492 @@ func (c *CompositionRoot) CreateCreateCourierCommandHandler() commands.CreateCou
 	return commands.NewCreateCourierCommandHandler(f)
 }
 
+func (c *CompositionRoot) CreateBatchCreateCouriersCommandHandler() commands.BatchCreateCouriersCommandHandler {
+	var f commands.CourierUoWFactory = FuncCourierUoWFactory(func() commands.CourierUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewBatchCreateCouriersCommandHandler(f)
+}
+
 func (c *CompositionRoot) CreateCreateOrderCommandHandler() commands.CreateOrderCommandHandler {
 	var f commands.OrderUoWFactory = FuncOrderUoWFactory(func() commands.OrderUoW {
 		return c.uowFactory.Create()
 	})
-	return commands.NewCreateOrderCommandHandler(f)
+	return commands.NewCreateOrderCommandHandler(f, c.fraudCheckers()...).
+		WithGeoLocator(c.geoLocator()).
+		WithBatchingWindow(c.batchingWindow).
+		WithInsuranceThreshold(c.insuranceThreshold)
+}
+
+func (c *CompositionRoot) CreateReleaseOrderBatchesCommandHandler() commands.ReleaseOrderBatchesCommandHandler {
+	var f commands.OrderUoWFactory = FuncOrderUoWFactory(func() commands.OrderUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewReleaseOrderBatchesCommandHandler(f)
+}
+
+// CreateEscalateStaleUrgentOrdersCommandHandler returns nil when no escalation tiers are configured.
+func (c *CompositionRoot) CreateEscalateStaleUrgentOrdersCommandHandler() *commands.EscalateStaleUrgentOrdersCommandHandler {
+	if c.escalationPolicy == nil {
+		return nil
+	}
+
+	var f commands.OrderUoWFactory = FuncOrderUoWFactory(func() commands.OrderUoW {
+		return c.uowFactory.Create()
+	})
+	handler := commands.NewEscalateStaleUrgentOrdersCommandHandler(
+		f, postgres.NewGormOrderEscalationRepository(c.gormDB), *c.escalationPolicy, c.escalationAlert(),
+	)
+	return &handler
+}
+
+func (c *CompositionRoot) CreateArchiveCompletedOrdersCommandHandler() commands.ArchiveCompletedOrdersCommandHandler {
+	var f commands.OrderUoWFactory = FuncOrderUoWFactory(func() commands.OrderUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewArchiveCompletedOrdersCommandHandler(f)
+}
+
+// fraudCheckers lists the checks every incoming order passes, in order.
+// The local blacklist always runs; the external service is consulted only when configured.
+func (c *CompositionRoot) fraudCheckers() []ports.FraudChecker {
+	checkers := []ports.FraudChecker{
+		postgres.NewGormBlacklistFraudChecker(c.gormDB),
+	}
+
+	if c.config.FraudServiceURL != "" {
+		checkers = append(checkers, fraudservice.NewClient(c.config.FraudServiceURL, c.config.FraudServiceTimeout, c.logger))
+	}
+	return checkers
+}
+
+// geoLocator resolves order addresses, falling back to the addresses resolved before
+// when the geo service fails. The random locator stands in for the geo service until
+// its gRPC client is wired in.
+func (c *CompositionRoot) geoLocator() ports.GeoLocator {
+	return geo.NewFallbackLocator(geo.NewRandomLocator(), postgres.NewGormResolvedAddressCache(c.gormDB), c.logger)
+}
+
+func (c *CompositionRoot) CreateImportOrdersCommandHandler() commands.ImportOrdersCommandHandler {
+	var f commands.OrderUoWFactory = FuncOrderUoWFactory(func() commands.OrderUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewImportOrdersCommandHandler(
+		f,
+		c.geoLocator(),
+		commands.DefaultImportBatchSize,
+		c.fraudCheckers()...,
+	)
+}
+
+func (c *CompositionRoot) CreateApproveOrderReviewCommandHandler() commands.ApproveOrderReviewCommandHandler {
+	var f commands.OrderUoWFactory = FuncOrderUoWFactory(func() commands.OrderUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewApproveOrderReviewCommandHandler(f)
+}
+
+func (c *CompositionRoot) CreateRecalculateETACommandHandler() commands.RecalculateETACommandHandler {
+	var f commands.UoWFactory = FuncUoWFactory(func() commands.UoW {
+		return c.uowFactory.Create()
+	})
+	handler := commands.NewRecalculateETACommandHandler(f)
+	if c.pickupSlots {
+		handler = handler.WithPickupSlots(jobs.CourierMovementInterval)
+	}
+	return handler
 }
 
 func (c *CompositionRoot) CreateMoveCouriersCommandHandler() commands.MoveCouriersCommandHandler {
 	var f commands.UoWFactory = FuncUoWFactory(func() commands.UoW {
 		return c.uowFactory.Create()
 	})
-	return commands.NewMoveCouriersCommandHandler(f)
+	recorder := outboxrepo.NewRouteDeviationEventRecorder(outboxrepo.NewGormOutboxRepository(c.gormDB), c.logger)
+	handler := commands.NewMoveCouriersCommandHandler(f).WithRouteDeviation(c.routeDeviation, recorder)
+	if c.pickupSlots {
+		handler = handler.WithPickupSlots()
+	}
+	return handler
 }
 
 func (c *CompositionRoot) CreateAssignCourierCommandHandler() commands.AssignCourierCommandHandler {
 	var f commands.UoWFactory = FuncUoWFactory(func() commands.UoW {
 		return c.uowFactory.Create()
 	})
-	return commands.NewAssignCourierCommandHandler(f)
+
+	experiment, err := commands.NewDispatchExperiment(
+		c.bestFitDispatch,
+		services.BestFitStrategy{},
+		dispatchComparisonLogger{logger: c.logger},
+	)
+	var handler commands.AssignCourierCommandHandler
+	if err != nil {
+		c.logger.WarnContext(context.Background(), "Dispatch experiment disabled", "error", err)
+		handler = commands.NewAssignCourierCommandHandler(f, c.dispatchPostProcessors()...)
+	} else {
+		handler = commands.NewAssignCourierCommandHandlerWithExperiment(f, experiment, c.dispatchPostProcessors()...)
+	}
+
+	handler = handler.WithWorkingHoursLimit(c.workingHours).WithMaintenanceWarning(c.maintenanceWarning)
+	handler = handler.WithDispatchStrategy(c.dispatchStrategy())
+	if c.config.DispatchBatchSize > 1 {
+		handler = handler.WithBatchDispatch(c.config.DispatchBatchSize)
+	}
+	if c.pickupSlots {
+		handler = handler.WithPickupSlots()
+	}
+	if c.shiftEndHandover {
+		handler = handler.WithShiftEndDrain()
+	}
+	if c.dispatchDegradation != nil {
+		handler = handler.WithDegradation(c.dispatchDegradation)
+	}
+	if c.escalationPolicy != nil {
+		handler = handler.WithEscalation(postgres.NewGormOrderEscalationRepository(c.gormDB), *c.escalationPolicy)
+	}
+	return handler.
+		WithDeviceHealth(postgres.NewGormDeviceTelemetryRepository(c.gormDB), c.deviceHealth).
+		WithSurge(postgres.NewGormSurgeRepository(c.gormDB), c.surgePolicy).
+		WithMatchingRules(postgres.NewGormMatchingRuleRepository(c.gormDB))
 }
 
-func (c *CompositionRoot) CreateGetAllCouriersQueryHandler() queries.GetAllCouriersQueryHandler {
-	return queries.NewGetAllCouriersQueryHandler(c.gormDB)
+// CreateHandOverShiftEndOrdersCommandHandler returns nil when the shift end handover is disabled.
+func (c *CompositionRoot) CreateHandOverShiftEndOrdersCommandHandler() *commands.HandOverShiftEndOrdersCommandHandler {
+	if !c.shiftEndHandover {
+		return nil
+	}
+
+	var f commands.UoWFactory = FuncUoWFactory(func() commands.UoW {
+		return c.uowFactory.Create()
+	})
+	handler := commands.NewHandOverShiftEndOrdersCommandHandler(f, c.workingHours, c.dispatchPostProcessors()...)
+	return &handler
 }
 
-func (c *CompositionRoot) CreateGetUncompletedOrdersQueryHandler() queries.GetUncompletedOrdersQueryHandler {
-	return queries.NewGetUncompletedOrdersQueryHandler(c.gormDB)
+func (c *CompositionRoot) CreateHandOverAbsentCourierOrdersCommandHandler() commands.HandOverAbsentCourierOrdersCommandHandler {
+	var f commands.UoWFactory = FuncUoWFactory(func() commands.UoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewHandOverAbsentCourierOrdersCommandHandler(f, c.dispatchPostProcessors()...)
 }
 
-func (c *CompositionRoot) CreateHTTPServer() *http.Server {
-	createCourierHandler := c.CreateCreateCourierCommandHandler()
-	createOrderHandler := c.CreateCreateOrderCommandHandler()
-	getAllCouriersHandler := c.CreateGetAllCouriersQueryHandler()
-	getUncompletedOrdersHandler := c.CreateGetUncompletedOrdersQueryHandler()
+// dispatchPostProcessors lists the extensions that run on every courier assignment, in order.
+// Register notification, quota or fraud-check processors here.
+func (c *CompositionRoot) dispatchPostProcessors() []commands.DispatchPostProcessor {
+	return []commands.DispatchPostProcessor{
+		dispatchAssignmentLogger{logger: c.logger},
+		postgres.NewGormAssignmentExplanationRecorder(c.gormDB, c.logger),
+		commands.NewMicrozoneAnnotator(postgres.NewGormMicrozoneRepository(c.gormDB)),
+	}
+}
 
-	return http.NewServer(
-		createCourierHandler,
-		createOrderHandler,
-		getAllCouriersHandler,
-		getUncompletedOrdersHandler,
-	)
+func (c *CompositionRoot) CreateUnassignInactiveCouriersCommandHandler() commands.UnassignInactiveCouriersCommandHandler {
+	var f commands.UoWFactory = FuncUoWFactory(func() commands.UoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewUnassignInactiveCouriersCommandHandler(f)
 }
 
-func (c *CompositionRoot) CreateJobManager() *jobs.JobManager {
-	moveCouriersHandler := c.CreateMoveCouriersCommandHandler()
-	assignCourierHandler := c.CreateAssignCourierCommandHandler()
+func (c *CompositionRoot) CreateDeactivateCourierCommandHandler() commands.DeactivateCourierCommandHandler {
+	var f commands.UoWFactory = FuncUoWFactory(func() commands.UoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewDeactivateCourierCommandHandler(f, c.dispatchPostProcessors()...)
+}
+
+func (c *CompositionRoot) CreatePostOrderMessageCommandHandler() commands.PostOrderMessageCommandHandler {
+	var f commands.OrderUoWFactory = FuncOrderUoWFactory(func() commands.OrderUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewPostOrderMessageCommandHandler(f)
+}
 
-	return jobs.NewJobManager(moveCouriersHandler, assignCourierHandler, c.logger)
+func (c *CompositionRoot) CreateShareOrderTrackingCommandHandler() commands.ShareOrderTrackingCommandHandler {
+	var f commands.OrderUoWFactory = FuncOrderUoWFactory(func() commands.OrderUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewShareOrderTrackingCommandHandler(f)
 }
 
-type FuncCourierUoWFactory func() commands.CourierUoW
+func (c *CompositionRoot) CreateBroadcastAnnouncementCommandHandler() commands.BroadcastAnnouncementCommandHandler {
+	var f commands.CourierUoWFactory = FuncCourierUoWFactory(func() commands.CourierUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewBroadcastAnnouncementCommandHandler(
+		f,
+		postgres.NewGormAnnouncementRepository(c.gormDB),
+		outboxrepo.NewOutboxCourierNotifier(outboxrepo.NewGormOutboxRepository(c.gormDB)),
+	)
+}
 
-func (f FuncCourierUoWFactory) Create() commands.CourierUoW {
-	return f()
+func (c *CompositionRoot) CreateChangeSurgeModeCommandHandler() commands.ChangeSurgeModeCommandHandler {
+	var f commands.CourierUoWFactory = FuncCourierUoWFactory(func() commands.CourierUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewChangeSurgeModeCommandHandler(
+		postgres.NewGormSurgeRepository(c.gormDB),
+		postgres.NewGormFleetLoadReader(c.gormDB),
+		c.surgePolicy,
+		f,
+		outboxrepo.NewOutboxCourierNotifier(outboxrepo.NewGormOutboxRepository(c.gormDB)),
+	)
 }
 
-type FuncOrderUoWFactory func() commands.OrderUoW
+func (c *CompositionRoot) CreateObserveSurgeDemandCommandHandler() commands.ObserveSurgeDemandCommandHandler {
+	var f commands.CourierUoWFactory = FuncCourierUoWFactory(func() commands.CourierUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewObserveSurgeDemandCommandHandler(
+		postgres.NewGormSurgeRepository(c.gormDB),
+		postgres.NewGormFleetLoadReader(c.gormDB),
+		c.surgePolicy,
+		f,
+		outboxrepo.NewOutboxCourierNotifier(outboxrepo.NewGormOutboxRepository(c.gormDB)),
+	)
+}
 
-func (f FuncOrderUoWFactory) Create() commands.OrderUoW {
-	return f()
+func (c *CompositionRoot) CreateReplayOutboxCommandHandler(
+	publisher ports.EventPublisher,
+) commands.ReplayOutboxCommandHandler {
+	return commands.NewReplayOutboxCommandHandler(
+		outboxrepo.NewGormOutboxRepository(c.gormDB),
+		publisher,
+		replayProgressLogger{logger: c.logger},
+	)
 }
 
-type FuncUoWFactory func() commands.UoW
+// CreateRelayOutboxCommandHandler returns nil when neither a publisher is given nor an availability
+// webhook is configured.
+func (c *CompositionRoot) CreateRelayOutboxCommandHandler(
+	publisher ports.EventPublisher,
+) *commands.RelayOutboxCommandHandler {
+	publisher = c.availabilityWebhook(publisher)
+	if publisher == nil {
+		return nil
+	}
 
-func (f FuncUoWFactory) Create() commands.UoW {
-	return f()
+	handler := commands.NewRelayOutboxCommandHandler(outboxrepo.NewGormOutboxRepository(c.gormDB), publisher)
+	return &handler
+}
+
+// CreateDataFixRunner registers all administrative data fixes.
+// New fixes are added to the list below.
+func (c *CompositionRoot) CreateDataFixRunner() (*postgres.DataFixRunner, error) {
+	return postgres.NewDataFixRunner(
+		&c.uowFactory,
+		c.logger,
+		postgres.NewClearOrphanedStoragePlacesFix(),
+	)
+}
+
+// CreateProjectionBackfillRunner registers all read-model projections that can be backfilled
+// from the change log. New projections are added to the list below.
+func (c *CompositionRoot) CreateProjectionBackfillRunner() (*postgres.ProjectionBackfillRunner, error) {
+	return postgres.NewProjectionBackfillRunner(
+		c.gormDB,
+		c.logger,
+		postgres.NewOrderHistoryProjection(),
+		postgres.NewCourierBadgesProjection(c.badgeRules),
+	)
+}
+
+// CreateUpdateProjectionsCommandHandler keeps the projections of CreateProjectionBackfillRunner up to date.
+// Returns nil, disabling the updates, if the projections cannot be registered.
+func (c *CompositionRoot) CreateUpdateProjectionsCommandHandler() *commands.UpdateProjectionsCommandHandler {
+	runner, err := c.CreateProjectionBackfillRunner()
+	if err != nil {
+		c.logger.ErrorContext(context.Background(), "Invalid projection registry, projections are not updated",
+			"error", err)
+		return nil
+	}
+
+	handler := commands.NewUpdateProjectionsCommandHandler(runner)
+	return &handler
+}
+
+// CreateStagingCopier copies anonymized data from the source database into the service's own
+// database, which must be a staging database. Fails without a valid pseudonymization key.
+func (c *CompositionRoot) CreateStagingCopier(source *gorm.DB) (*postgres.StagingCopier, error) {
+	pseudonyms, err := pseudonym.New(c.config.PseudonymizationKey)
+	if err != nil {
+		return nil, err
+	}
+	return postgres.NewStagingCopier(source, c.gormDB, pseudonyms, c.logger), nil
+}
+
+func (c *CompositionRoot) CreateCheckFleetCapacityCommandHandler() commands.CheckFleetCapacityCommandHandler {
+	return commands.NewCheckFleetCapacityCommandHandler(
+		postgres.NewGormFleetLoadReader(c.gormDB),
+		c.fleetCapacityBreaker(),
+		c.fleetCapacityOverflowMode(),
+		outboxrepo.NewFleetCapacityEventRecorder(outboxrepo.NewGormOutboxRepository(c.gormDB), c.logger),
+	)
+}
+
+func (c *CompositionRoot) CreateCreatePickupSlotCommandHandler() commands.CreatePickupSlotCommandHandler {
+	var f commands.PickupSlotUoWFactory = FuncPickupSlotUoWFactory(func() commands.PickupSlotUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewCreatePickupSlotCommandHandler(f)
+}
+
+func (c *CompositionRoot) CreateChangePickupSlotCapacityCommandHandler() commands.ChangePickupSlotCapacityCommandHandler {
+	var f commands.PickupSlotUoWFactory = FuncPickupSlotUoWFactory(func() commands.PickupSlotUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewChangePickupSlotCapacityCommandHandler(f)
+}
+
+func (c *CompositionRoot) CreateTipOrderCommandHandler() commands.TipOrderCommandHandler {
+	var f commands.TipUoWFactory = FuncTipUoWFactory(func() commands.TipUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewTipOrderCommandHandler(f)
+}
+
+func (c *CompositionRoot) CreateRateOrderCommandHandler() commands.RateOrderCommandHandler {
+	var f commands.OrderUoWFactory = FuncOrderUoWFactory(func() commands.OrderUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewRateOrderCommandHandler(f)
+}
+
+func (c *CompositionRoot) CreateTransferOrderCommandHandler() commands.TransferOrderCommandHandler {
+	var f commands.TransferUoWFactory = FuncTransferUoWFactory(func() commands.TransferUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewTransferOrderCommandHandler(f)
+}
+
+func (c *CompositionRoot) CreateMergeCouriersCommandHandler() commands.MergeCouriersCommandHandler {
+	var f commands.MergeUoWFactory = FuncMergeUoWFactory(func() commands.MergeUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewMergeCouriersCommandHandler(f)
+}
+
+func (c *CompositionRoot) CreateErasePersonalDataCommandHandler() commands.ErasePersonalDataCommandHandler {
+	var f commands.ErasureUoWFactory = FuncErasureUoWFactory(func() commands.ErasureUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewErasePersonalDataCommandHandler(f)
+}
+
+// CreateIssueBlobUploadCommandHandler returns nil when no blob storage is configured.
+func (c *CompositionRoot) CreateIssueBlobUploadCommandHandler() *commands.IssueBlobUploadCommandHandler {
+	if c.blobStorage == nil {
+		return nil
+	}
+
+	handler := commands.NewIssueBlobUploadCommandHandler(blobrepo.NewGormUploadRepository(c.gormDB), c.blobStorage)
+	return &handler
+}
+
+// CreatePurgeOrphanedBlobsCommandHandler returns nil when no blob storage is configured.
+func (c *CompositionRoot) CreatePurgeOrphanedBlobsCommandHandler() *commands.PurgeOrphanedBlobsCommandHandler {
+	if c.blobStorage == nil {
+		return nil
+	}
+
+	handler := commands.NewPurgeOrphanedBlobsCommandHandler(blobrepo.NewGormUploadRepository(c.gormDB), c.blobStorage)
+	return &handler
+}
+
+// LocalBlobReceiver returns the handler receiving uploads to the local blob storage,
+// nil unless the local backend is configured.
+func (c *CompositionRoot) LocalBlobReceiver() *blobstore.LocalStorage {
+	return c.localBlobStorage
+}
+
+func (c *CompositionRoot) CreateUpdateOrderPaymentCommandHandler() commands.UpdateOrderPaymentCommandHandler {
+	var f commands.OrderUoWFactory = FuncOrderUoWFactory(func() commands.OrderUoW {
+		return c.uowFactory.Create()
+	})
+	return commands.NewUpdateOrderPaymentCommandHandler(f)
+}
+
+func (c *CompositionRoot) CreateGetAllCouriersQueryHandler() queries.GetAllCouriersQueryHandler {
+	return queries.NewGetAllCouriersQueryHandler(c.queryDB())
+}
+
+func (c *CompositionRoot) CreateGetCourierQueryHandler() queries.GetCourierQueryHandler {
+	return queries.NewGetCourierQueryHandler(c.queryDB())
+}
+
+func (c *CompositionRoot) CreateGetFreeCouriersQueryHandler() queries.GetFreeCouriersQueryHandler {
+	return queries.NewGetFreeCouriersQueryHandler(c.queryDB())
+}
+
+func (c *CompositionRoot) CreateGetUncompletedOrdersQueryHandler() queries.GetUncompletedOrdersQueryHandler {
+	return queries.NewGetUncompletedOrdersQueryHandler(c.queryDB())
+}
+
+func (c *CompositionRoot) CreateGetOrdersPageQueryHandler() queries.GetOrdersPageQueryHandler {
+	return queries.NewGetOrdersPageQueryHandler(c.queryDB())
+}
+
+func (c *CompositionRoot) CreateGetOrderThreadQueryHandler() queries.GetOrderThreadQueryHandler {
+	return queries.NewGetOrderThreadQueryHandler(c.queryDB())
+}
+
+func (c *CompositionRoot) CreateGetOrdersUnderReviewQueryHandler() queries.GetOrdersUnderReviewQueryHandler {
+	return queries.NewGetOrdersUnderReviewQueryHandler(c.queryDB())
+}
+
+func (c *CompositionRoot) CreateGetSharedTrackingQueryHandler() queries.GetSharedTrackingQueryHandler {
+	return queries.NewGetSharedTrackingQueryHandler(c.queryDB(), c.trackingCache)
+}
+
+// sharedTrackingCache builds the cache of public tracking views in memory of the instance.
+// Returns nil when TRACKING_CACHE_TTL is zero, which disables the cache. To share the cache
+// between instances, pass a ports.ReadCache backed by Redis instead of the memory cache.
+func (c *CompositionRoot) sharedTrackingCache() *queries.SharedTrackingCache {
+	if c.config.TrackingCacheTTL <= 0 {
+		return nil
+	}
+
+	memory, err := readcache.NewMemoryCache(c.config.TrackingCacheSize)
+	if err != nil {
+		c.logger.WarnContext(context.Background(), "Invalid tracking cache size, using default",
+			"value", c.config.TrackingCacheSize,
+			"default", config.Default().TrackingCacheSize)
+		memory, _ = readcache.NewMemoryCache(config.Default().TrackingCacheSize)
+	}
+	cache, _ := queries.NewSharedTrackingCache(memory, c.config.TrackingCacheTTL)
+	return cache
+}
+
+// CreateTrackingCacheInvalidationJob wires the job dropping cached tracking views on order events.
+// Returns nil when the tracking cache is disabled.
+func (c *CompositionRoot) CreateTrackingCacheInvalidationJob() *jobs.TrackingCacheInvalidationJob {
+	if c.trackingCache == nil {
+		return nil
+	}
+	return jobs.NewTrackingCacheInvalidationJob(
+		outboxrepo.NewGormOutboxRepository(c.gormDB),
+		c.trackingCache,
+		c.logger,
+	)
+}
+
+func (c *CompositionRoot) CreateGetCourierWorkingHoursQueryHandler() queries.GetCourierWorkingHoursQueryHandler {
+	return queries.NewGetCourierWorkingHoursQueryHandler(c.queryDB(), c.workingHours)
+}
+
+func (c *CompositionRoot) CreateGetAssignmentExplanationQueryHandler() queries.GetAssignmentExplanationQueryHandler {
+	return queries.NewGetAssignmentExplanationQueryHandler(c.queryDB())
+}
+
+func (c *CompositionRoot) CreateGetAnnouncementsQueryHandler() queries.GetAnnouncementsQueryHandler {
+	return queries.NewGetAnnouncementsQueryHandler(c.queryDB())
+}
+
+func (c *CompositionRoot) CreateGetPickupSlotsQueryHandler() queries.GetPickupSlotsQueryHandler {
+	return queries.NewGetPickupSlotsQueryHandler(c.queryDB())
+}
+
+func (c *CompositionRoot) CreateGetPayoutExportQueryHandler() queries.GetPayoutExportQueryHandler {
+	return queries.NewGetPayoutExportQueryHandler(c.queryDB())
+}
+
+func (c *CompositionRoot) CreateGetCourierMaintenanceWindowsQueryHandler() queries.GetCourierMaintenanceWindowsQueryHandler {
+	return queries.NewGetCourierMaintenanceWindowsQueryHandler(c.queryDB(), c.maintenanceWarning)
+}
+
+func (c *CompositionRoot) CreateGetChangesQueryHandler() queries.GetChangesQueryHandler {
+	return queries.NewGetChangesQueryHandler(c.queryDB())
+}
+
+func (c *CompositionRoot) CreateGetCourierAvailabilityQueryHandler() queries.GetCourierAvailabilityQueryHandler {
+	return queries.NewGetCourierAvailabilityQueryHandler(c.queryDB())
+}
+
+func (c *CompositionRoot) CreateGetMicrozonesQueryHandler() queries.GetMicrozonesQueryHandler {
+	return queries.NewGetMicrozonesQueryHandler(c.queryDB())
+}
+
+func (c *CompositionRoot) CreateGetSLATargetsQueryHandler() queries.GetSLATargetsQueryHandler {
+	return queries.NewGetSLATargetsQueryHandler(c.queryDB())
+}
+
+func (c *CompositionRoot) CreateGetMatchingRulesQueryHandler() queries.GetMatchingRulesQueryHandler {
+	return queries.NewGetMatchingRulesQueryHandler(c.queryDB())
+}
+
+func (c *CompositionRoot) CreateGetCourierStatsQueryHandler() queries.GetCourierStatsQueryHandler {
+	return queries.NewGetCourierStatsQueryHandler(c.queryDB())
+}
+
+func (c *CompositionRoot) CreateGetBadgeRulesQueryHandler() queries.GetBadgeRulesQueryHandler {
+	return queries.NewGetBadgeRulesQueryHandler(c.badgeRules)
+}
+
+func (c *CompositionRoot) CreateGetSLAReportQueryHandler() queries.GetSLAReportQueryHandler {
+	return queries.NewGetSLAReportQueryHandler(c.queryDB())
+}
+
+func (c *CompositionRoot) CreateGetFleetWhatIfQueryHandler() queries.GetFleetWhatIfQueryHandler {
+	return queries.NewGetFleetWhatIfQueryHandler(c.queryDB())
+}
+
+func (c *CompositionRoot) CreateGetAPIUsageQueryHandler() queries.GetAPIUsageQueryHandler {
+	return queries.NewGetAPIUsageQueryHandler(c.queryDB(), c.apiQuota)
+}
+
+func (c *CompositionRoot) CreateGetDeviceHealthQueryHandler() queries.GetDeviceHealthQueryHandler {
+	return queries.NewGetDeviceHealthQueryHandler(c.queryDB(), c.deviceHealth)
+}
+
+func (c *CompositionRoot) CreateGetOrderByExternalReferenceQueryHandler() queries.GetOrderByExternalReferenceQueryHandler {
+	return queries.NewGetOrderByExternalReferenceQueryHandler(c.queryDB())
+}
+
+func (c *CompositionRoot) CreateGetOrderETAQueryHandler() queries.GetOrderETAQueryHandler {
+	return queries.NewGetOrderETAQueryHandler(c.queryDB(), jobs.CourierMovementInterval)
+}
+
+func (c *CompositionRoot) CreateGetOrderHistoryQueryHandler() queries.GetOrderHistoryQueryHandler {
+	return queries.NewGetOrderHistoryQueryHandler(c.queryDB())
+}
+
+func (c *CompositionRoot) CreateGetOrderStateQueryHandler() queries.GetOrderStateQueryHandler {
+	return queries.NewGetOrderStateQueryHandler(c.queryDB())
+}
+
+func (c *CompositionRoot) CreateGetSurgeModeQueryHandler() queries.GetSurgeModeQueryHandler {
+	return queries.NewGetSurgeModeQueryHandler(c.queryDB())
+}
+
+func (c *CompositionRoot) CreateGetSLOStatusQueryHandler() queries.GetSLOStatusQueryHandler {
+	return queries.NewGetSLOStatusQueryHandler(c.queryDB(), c.config.SLOWindow, c.sloObjectives()...)
+}
+
+// queryDB limits the statements of query handlers to the query statement timeout,
+// so a runaway read model cannot starve the transactional workload.
+func (c *CompositionRoot) queryDB() *gorm.DB {
+	return querycost.WithBudget(c.gormDB, querycost.QueryWorkload, c.config.QueryStatementTimeout)
+}
+
+func (c *CompositionRoot) CreateHTTPServer() *http.Server {
+	createCourierHandler := c.CreateCreateCourierCommandHandler()
+	createOrderHandler := c.CreateCreateOrderCommandHandler()
+	getAllCouriersHandler := c.CreateGetAllCouriersQueryHandler()
+	getUncompletedOrdersHandler := c.CreateGetUncompletedOrdersQueryHandler()
+	setStoragePlaceMaintenanceHandler := c.CreateSetStoragePlaceMaintenanceCommandHandler()
+	postOrderMessageHandler := c.CreatePostOrderMessageCommandHandler()
+	getOrderThreadHandler := c.CreateGetOrderThreadQueryHandler()
+	checkFleetCapacityHandler := c.CreateCheckFleetCapacityCommandHandler()
+	shareOrderTrackingHandler := c.CreateShareOrderTrackingCommandHandler()
+	getSharedTrackingHandler := c.CreateGetSharedTrackingQueryHandler()
+	deactivateCourierHandler := c.CreateDeactivateCourierCommandHandler()
+	approveOrderReviewHandler := c.CreateApproveOrderReviewCommandHandler()
+	getOrdersUnderReviewHandler := c.CreateGetOrdersUnderReviewQueryHandler()
+	recalculateETAHandler := c.CreateRecalculateETACommandHandler()
+	updateCourierProfileHandler := c.CreateUpdateCourierProfileCommandHandler()
+	purgeSyntheticDataHandler := c.CreatePurgeSyntheticDataCommandHandler()
+	setRolloutPercentageHandler := c.CreateSetRolloutPercentageCommandHandler()
+	importOrdersHandler := c.CreateImportOrdersCommandHandler()
+	setCourierShiftHandler := c.CreateSetCourierShiftCommandHandler()
+	getCourierWorkingHoursHandler := c.CreateGetCourierWorkingHoursQueryHandler()
+	getAssignmentExplanationHandler := c.CreateGetAssignmentExplanationQueryHandler()
+	broadcastAnnouncementHandler := c.CreateBroadcastAnnouncementCommandHandler()
+	getAnnouncementsHandler := c.CreateGetAnnouncementsQueryHandler()
+	createPickupSlotHandler := c.CreateCreatePickupSlotCommandHandler()
+	changePickupSlotCapacityHandler := c.CreateChangePickupSlotCapacityCommandHandler()
+	getPickupSlotsHandler := c.CreateGetPickupSlotsQueryHandler()
+	tipOrderHandler := c.CreateTipOrderCommandHandler()
+	getPayoutExportHandler := c.CreateGetPayoutExportQueryHandler()
+	updateOrderPaymentHandler := c.CreateUpdateOrderPaymentCommandHandler()
+	scheduleCourierMaintenanceHandler := c.CreateScheduleCourierMaintenanceCommandHandler()
+	rescheduleCourierMaintenanceHandler := c.CreateRescheduleCourierMaintenanceCommandHandler()
+	cancelCourierMaintenanceHandler := c.CreateCancelCourierMaintenanceCommandHandler()
+	getCourierMaintenanceWindowsHandler := c.CreateGetCourierMaintenanceWindowsQueryHandler()
+	getChangesHandler := c.CreateGetChangesQueryHandler()
+	planCourierAbsenceHandler := c.CreatePlanCourierAbsenceCommandHandler()
+	cancelCourierAbsenceHandler := c.CreateCancelCourierAbsenceCommandHandler()
+	setCourierInsuranceHandler := c.CreateSetCourierInsuranceCommandHandler()
+	confirmOrderHandoverHandler := c.CreateConfirmOrderHandoverCommandHandler()
+	recomputeMicrozonesHandler := c.CreateRecomputeMicrozonesCommandHandler()
+	getMicrozonesHandler := c.CreateGetMicrozonesQueryHandler()
+	meterAPIUsageHandler := c.CreateMeterAPIUsageCommandHandler()
+	getAPIUsageHandler := c.CreateGetAPIUsageQueryHandler()
+	recordDeviceTelemetryHandler := c.CreateRecordDeviceTelemetryCommandHandler()
+	getDeviceHealthHandler := c.CreateGetDeviceHealthQueryHandler()
+	getOrderByExternalReferenceHandler := c.CreateGetOrderByExternalReferenceQueryHandler()
+	changeSurgeModeHandler := c.CreateChangeSurgeModeCommandHandler()
+	getSurgeModeHandler := c.CreateGetSurgeModeQueryHandler()
+	replaceSLATargetsHandler := c.CreateReplaceSLATargetsCommandHandler()
+	computeSLAComplianceHandler := c.CreateComputeSLAComplianceCommandHandler()
+	getSLATargetsHandler := c.CreateGetSLATargetsQueryHandler()
+	getSLAReportHandler := c.CreateGetSLAReportQueryHandler()
+	importCourierLocationsHandler := c.CreateImportCourierLocationsCommandHandler()
+	getFleetWhatIfHandler := c.CreateGetFleetWhatIfQueryHandler()
+	transferOrderHandler := c.CreateTransferOrderCommandHandler()
+	getOrdersPageHandler := c.CreateGetOrdersPageQueryHandler()
+	mergeCouriersHandler := c.CreateMergeCouriersCommandHandler()
+	getOrderETAHandler := c.CreateGetOrderETAQueryHandler()
+	cancelOrderHandler := c.CreateCancelOrderCommandHandler()
+	issueBlobUploadHandler := c.CreateIssueBlobUploadCommandHandler()
+	getSLOStatusHandler := c.CreateGetSLOStatusQueryHandler()
+	setCourierScheduleHandler := c.CreateSetCourierScheduleCommandHandler()
+	clearCourierScheduleHandler := c.CreateClearCourierScheduleCommandHandler()
+	getCourierAvailabilityHandler := c.CreateGetCourierAvailabilityQueryHandler()
+	replaceMatchingRulesHandler := c.CreateReplaceMatchingRulesCommandHandler()
+	getMatchingRulesHandler := c.CreateGetMatchingRulesQueryHandler()
+	getOrderHistoryHandler := c.CreateGetOrderHistoryQueryHandler()
+	getOrderStateHandler := c.CreateGetOrderStateQueryHandler()
+	erasePersonalDataHandler := c.CreateErasePersonalDataCommandHandler()
+	batchCreateCouriersHandler := c.CreateBatchCreateCouriersCommandHandler()
+	updateCourierLocationHandler := c.CreateUpdateCourierLocationCommandHandler()
+	clearCourierReviewFlagHandler := c.CreateClearCourierReviewFlagCommandHandler()
+	setCourierBreakHandler := c.CreateSetCourierBreakCommandHandler()
+	bulkCancelOrdersHandler := c.CreateBulkCancelOrdersCommandHandler()
+	rateOrderHandler := c.CreateRateOrderCommandHandler()
+	getCourierStatsHandler := c.CreateGetCourierStatsQueryHandler()
+	getBadgeRulesHandler := c.CreateGetBadgeRulesQueryHandler()
+
+	return http.NewServer(
+		createCourierHandler,
+		createOrderHandler,
+		getAllCouriersHandler,
+		getUncompletedOrdersHandler,
+		setStoragePlaceMaintenanceHandler,
+		postOrderMessageHandler,
+		getOrderThreadHandler,
+		checkFleetCapacityHandler,
+		shareOrderTrackingHandler,
+		getSharedTrackingHandler,
+		deactivateCourierHandler,
+		approveOrderReviewHandler,
+		getOrdersUnderReviewHandler,
+		recalculateETAHandler,
+		updateCourierProfileHandler,
+		purgeSyntheticDataHandler,
+		setRolloutPercentageHandler,
+		importOrdersHandler,
+		setCourierShiftHandler,
+		getCourierWorkingHoursHandler,
+		getAssignmentExplanationHandler,
+		broadcastAnnouncementHandler,
+		getAnnouncementsHandler,
+		createPickupSlotHandler,
+		changePickupSlotCapacityHandler,
+		getPickupSlotsHandler,
+		tipOrderHandler,
+		getPayoutExportHandler,
+		updateOrderPaymentHandler,
+		c.config.PaymentWebhookSecret,
+		scheduleCourierMaintenanceHandler,
+		rescheduleCourierMaintenanceHandler,
+		cancelCourierMaintenanceHandler,
+		getCourierMaintenanceWindowsHandler,
+		getChangesHandler,
+		planCourierAbsenceHandler,
+		cancelCourierAbsenceHandler,
+		setCourierInsuranceHandler,
+		confirmOrderHandoverHandler,
+		recomputeMicrozonesHandler,
+		getMicrozonesHandler,
+		meterAPIUsageHandler,
+		getAPIUsageHandler,
+		recordDeviceTelemetryHandler,
+		getDeviceHealthHandler,
+		getOrderByExternalReferenceHandler,
+		changeSurgeModeHandler,
+		getSurgeModeHandler,
+		replaceSLATargetsHandler,
+		computeSLAComplianceHandler,
+		getSLATargetsHandler,
+		getSLAReportHandler,
+		importCourierLocationsHandler,
+		getFleetWhatIfHandler,
+		transferOrderHandler,
+		getOrdersPageHandler,
+		mergeCouriersHandler,
+		getOrderETAHandler,
+		cancelOrderHandler,
+		issueBlobUploadHandler,
+		getSLOStatusHandler,
+		setCourierScheduleHandler,
+		clearCourierScheduleHandler,
+		getCourierAvailabilityHandler,
+		replaceMatchingRulesHandler,
+		getMatchingRulesHandler,
+		getOrderHistoryHandler,
+		getOrderStateHandler,
+		erasePersonalDataHandler,
+		batchCreateCouriersHandler,
+		updateCourierLocationHandler,
+		clearCourierReviewFlagHandler,
+		setCourierBreakHandler,
+		bulkCancelOrdersHandler,
+		rateOrderHandler,
+		getCourierStatsHandler,
+		getBadgeRulesHandler,
+	)
+}
+
+// CreateGRPCServer wires the gRPC API to the same handlers as the HTTP API.
+func (c *CompositionRoot) CreateGRPCServer() *grpcin.Server {
+	return grpcin.NewServer(
+		c.CreateCreateCourierCommandHandler(),
+		c.CreateGetCourierQueryHandler(),
+		c.CreateGetFreeCouriersQueryHandler(),
+		c.CreateGetUncompletedOrdersQueryHandler(),
+		c.CreateGetOrderByExternalReferenceQueryHandler(),
+	)
+}
+
+// Logger returns the logger of handlers and jobs, which records errors in the ring of recent
+// errors and adds the request ID to records logged with a request's context.
+func (c *CompositionRoot) Logger() *slog.Logger {
+	return c.logger
+}
+
+// RecentErrors returns the ring of recent handler and job errors, so request failures
+// can be recorded next to the errors logged through the composition root's logger.
+func (c *CompositionRoot) RecentErrors() *diagnostics.ErrorRing {
+	return c.recentErrors
+}
+
+// TenantCredentials returns the tokens the HTTP and gRPC APIs authenticate tenants with.
+// TENANT_TOKENS is validated at startup; should it still be invalid, no tenant is authenticated
+// and requests can only act for the default tenant.
+func (c *CompositionRoot) TenantCredentials() tenant.Credentials {
+	credentials, err := tenant.ParseCredentials(c.config.TenantTokens)
+	if err != nil {
+		c.logger.WarnContext(context.Background(), "Invalid tenant tokens, only the default tenant is served",
+			"error", err)
+	}
+	return credentials
+}
+
+func (c *CompositionRoot) CreateDiagnosticsReporter() *diagnostics.Reporter {
+	return diagnostics.NewReporter(
+		diagnostics.ReadBuildInfo(),
+		diagnostics.ConfigFingerprint(c.config),
+		c.recentErrors,
+		dependencyCheckTimeout,
+		c.diagnosedDependencies()...,
+	)
+}
+
+// diagnosedDependencies lists the services checked by the diagnostics endpoint.
+// The fraud and identity services are only checked when configured; a check only dials them.
+func (c *CompositionRoot) diagnosedDependencies() []diagnostics.Dependency {
+	dependencies := []diagnostics.Dependency{
+		{Name: "postgres", Check: func(ctx context.Context) error {
+			db, err := c.gormDB.DB()
+			if err != nil {
+				return err
+			}
+			return db.PingContext(ctx)
+		}},
+	}
+
+	if c.config.FraudServiceURL != "" {
+		dependencies = append(dependencies, serviceDependency("fraud-service", c.config.FraudServiceURL))
+	}
+	if c.config.IdentityServiceURL != "" {
+		dependencies = append(dependencies, serviceDependency("identity-service", c.config.IdentityServiceURL))
+	}
+	return dependencies
+}
+
+// serviceDependency checks an HTTP service by dialing its address.
+func serviceDependency(name, serviceURL string) diagnostics.Dependency {
+	return diagnostics.Dependency{Name: name, Check: func(ctx context.Context) error {
+		address, err := dialAddress(serviceURL)
+		if err != nil {
+			return err
+		}
+		var dialer net.Dialer
+		conn, err := dialer.DialContext(ctx, "tcp", address)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}}
+}
+
+// dialAddress returns the host:port of a service URL, using the default port of its scheme if none is given.
+func dialAddress(serviceURL string) (string, error) {
+	parsed, err := url.Parse(serviceURL)
+	if err != nil {
+		return "", err
+	}
+	port := parsed.Port()
+	if port == "" {
+		port = "80"
+		if parsed.Scheme == "https" {
+			port = "443"
+		}
+	}
+	return net.JoinHostPort(parsed.Hostname(), port), nil
+}
+
+// CreateJobManager wires the background jobs. A nil publisher disables the outbox relay.
+func (c *CompositionRoot) CreateJobManager(publisher ports.EventPublisher) *jobs.JobManager {
+	moveCouriersHandler := c.CreateMoveCouriersCommandHandler()
+	assignCourierHandler := c.CreateAssignCourierCommandHandler()
+	unassignInactiveCouriersHandler := c.CreateUnassignInactiveCouriersCommandHandler()
+
+	return jobs.NewJobManager(
+		moveCouriersHandler,
+		assignCourierHandler,
+		unassignInactiveCouriersHandler,
+		c.config.CourierInactivityThresholdTicks,
+		postgres.NewGormFleetLoadReader(c.gormDB),
+		c.assignmentJobTickBounds(),
+		c.dispatchDegradation,
+		c.CreateReleaseOrderBatchesCommandHandler(),
+		c.CreateHandOverAbsentCourierOrdersCommandHandler(),
+		c.CreateRecomputeMicrozonesCommandHandler(),
+		c.CreateObserveSurgeDemandCommandHandler(),
+		c.CreateComputeSLAComplianceCommandHandler(),
+		c.CreateGetSLOStatusQueryHandler(),
+		c.sloAlert(),
+		c.CreatePurgeSyntheticDataCommandHandler(),
+		c.config.SyntheticDataTTL,
+		c.CreateHandOverShiftEndOrdersCommandHandler(),
+		c.CreateRelayOutboxCommandHandler(publisher),
+		c.CreatePurgeOrphanedBlobsCommandHandler(),
+		c.orphanedBlobTTL(),
+		c.CreateUpdateProjectionsCommandHandler(),
+		c.CreateArchiveCompletedOrdersCommandHandler(),
+		c.config.OrderArchiveAfterDays,
+		c.CreateBulkCancelOrdersCommandHandler(),
+		c.config.OrderCreatedTimeout,
+		c.orderCreatedTimeouts(),
+		c.CreateEscalateStaleUrgentOrdersCommandHandler(),
+		c.jobStallThreshold(),
+		c.jobStallAlert(),
+		c.jobTenants(),
+		c.logger,
+	)
+}
+
+// jobTenants lists the tenants the background jobs act for: the default tenant followed by TENANTS.
+// Both are validated at startup; should they still be invalid, the jobs act for the default tenant only.
+func (c *CompositionRoot) jobTenants() jobs.Tenants {
+	others, err := tenant.ParseIDs(c.config.Tenants)
+	if err == nil {
+		var tenants jobs.Tenants
+		if tenants, err = jobs.NewTenants(tenant.ID(c.config.DefaultTenantID), others...); err == nil {
+			return tenants
+		}
+	}
+
+	c.logger.WarnContext(context.Background(), "Invalid tenants, jobs act for the default tenant only",
+		"error", err)
+	return jobs.Tenants{}
+}
+
+// orderCreatedTimeouts returns the order timeouts of ORDER_CREATED_TIMEOUTS overriding ORDER_CREATED_TIMEOUT.
+// They are validated at startup; should they still be invalid, every tenant uses ORDER_CREATED_TIMEOUT.
+func (c *CompositionRoot) orderCreatedTimeouts() tenant.Durations {
+	timeouts, err := tenant.ParseDurations(c.config.OrderCreatedTimeouts)
+	if err != nil {
+		c.logger.WarnContext(context.Background(), "Invalid order timeouts of tenants, using ORDER_CREATED_TIMEOUT",
+			"error", err)
+		return tenant.Durations{}
+	}
+	return timeouts
+}
+
+// jobStallThreshold builds the stall threshold of background jobs from the configured factor,
+// falling back to the default when the factor is lower than 2.
+func (c *CompositionRoot) jobStallThreshold() jobs.StallThreshold {
+	threshold, err := jobs.NewStallThreshold(c.config.JobStallFactor)
+	if err == nil {
+		return threshold
+	}
+
+	defaults := config.Default()
+	c.logger.WarnContext(context.Background(), "Invalid job stall factor, using default",
+		"value", c.config.JobStallFactor,
+		"default", defaults.JobStallFactor)
+	threshold, _ = jobs.NewStallThreshold(defaults.JobStallFactor)
+	return threshold
+}
+
+// jobStallAlert is notified when a stalled background job is restarted.
+// Stalls are always logged with a goroutine dump and counted in the "job_restarts" expvar;
+// return a paging integration here to alert on them as well.
+//
+//nolint:ireturn // the alert hook is optional and interchangeable
+func (c *CompositionRoot) jobStallAlert() jobs.StallAlert {
+	return nil
+}
+
+// sloObjectives builds the assignment and delivery latency objectives from the configured thresholds,
+// goal and alert burn rate, falling back to the defaults when they are rejected.
+func (c *CompositionRoot) sloObjectives() []slo.Objective {
+	assignment, assignmentErr := slo.NewObjective(
+		slo.AssignmentStage, c.config.SLOAssignmentThreshold, c.config.SLOGoal, c.config.SLOBurnRateAlert,
+	)
+	delivery, deliveryErr := slo.NewObjective(
+		slo.DeliveryStage, c.config.SLODeliveryThreshold, c.config.SLOGoal, c.config.SLOBurnRateAlert,
+	)
+	if assignmentErr == nil && deliveryErr == nil {
+		return []slo.Objective{assignment, delivery}
+	}
+
+	defaults := config.Default()
+	c.logger.WarnContext(context.Background(), "Invalid SLO objectives, using defaults",
+		"assignment_threshold", c.config.SLOAssignmentThreshold.String(),
+		"delivery_threshold", c.config.SLODeliveryThreshold.String(),
+		"goal", c.config.SLOGoal,
+		"burn_rate_alert", c.config.SLOBurnRateAlert,
+		"error", errors.Join(assignmentErr, deliveryErr))
+	assignment, _ = slo.NewObjective(
+		slo.AssignmentStage, defaults.SLOAssignmentThreshold, defaults.SLOGoal, defaults.SLOBurnRateAlert,
+	)
+	delivery, _ = slo.NewObjective(
+		slo.DeliveryStage, defaults.SLODeliveryThreshold, defaults.SLOGoal, defaults.SLOBurnRateAlert,
+	)
+	return []slo.Objective{assignment, delivery}
+}
+
+// sloAlert posts the stages burning their error budget to the configured alert webhook.
+// Returns nil, leaving the burns to the log and the metrics, when no webhook is configured.
+//
+//nolint:ireturn // the alert hook is optional and interchangeable
+func (c *CompositionRoot) sloAlert() ports.SLOAlert {
+	if c.config.SLOAlertWebhookURL == "" {
+		return nil
+	}
+	return alerthook.NewClient(c.config.SLOAlertWebhookURL, c.config.SLOAlertWebhookTimeout, c.logger)
+}
+
+// escalationAlert posts the urgent orders reaching an escalation tier to the alert webhook of the SLO alerts,
+// which reaches ops. Returns nil, leaving the escalations to the log and the metrics, when no webhook is configured.
+//
+//nolint:ireturn // the alert hook is optional and interchangeable
+func (c *CompositionRoot) escalationAlert() ports.OrderEscalationAlert {
+	if c.config.SLOAlertWebhookURL == "" {
+		return nil
+	}
+	return alerthook.NewClient(c.config.SLOAlertWebhookURL, c.config.SLOAlertWebhookTimeout, c.logger)
+}
+
+// availabilityWebhook posts courier availability events to the configured webhook before passing
+// every event on to next. Returns next unchanged when no webhook is configured.
+//
+//nolint:ireturn // the webhook is optional and decorates any publisher
+func (c *CompositionRoot) availabilityWebhook(next ports.EventPublisher) ports.EventPublisher {
+	if c.config.AvailabilityWebhookURL == "" {
+		return next
+	}
+	return availabilityhook.NewPublisher(
+		c.config.AvailabilityWebhookURL, c.config.AvailabilityWebhookTimeout, next, c.logger,
+	)
+}
+
+// assignmentJobTickBounds builds the bounds of the adaptive assignment job from the configured
+// intervals, falling back to the defaults when the minimum exceeds the maximum.
+func (c *CompositionRoot) assignmentJobTickBounds() jobs.TickBounds {
+	bounds, err := jobs.NewTickBounds(c.config.AssignmentJobMinInterval, c.config.AssignmentJobMaxInterval)
+	if err == nil {
+		return bounds
+	}
+
+	defaults := config.Default()
+	c.logger.WarnContext(context.Background(), "Invalid assignment job intervals, using defaults",
+		"min", c.config.AssignmentJobMinInterval.String(),
+		"max", c.config.AssignmentJobMaxInterval.String(),
+		"default_min", defaults.AssignmentJobMinInterval.String(),
+		"default_max", defaults.AssignmentJobMaxInterval.String())
+	bounds, _ = jobs.NewTickBounds(defaults.AssignmentJobMinInterval, defaults.AssignmentJobMaxInterval)
+	return bounds
+}
+
+// fleetCapacityBreaker builds the capacity breaker from the configured ratio,
+// falling back to the default when the breaker rejects the ratio.
+func (c *CompositionRoot) fleetCapacityBreaker() services.CapacityBreaker {
+	breaker, err := services.NewCapacityBreaker(c.config.FleetCapacityMaxRatio)
+	if err == nil {
+		return breaker
+	}
+
+	defaults := config.Default()
+	c.logger.WarnContext(context.Background(), "Invalid fleet capacity ratio, using default",
+		"value", c.config.FleetCapacityMaxRatio,
+		"default", defaults.FleetCapacityMaxRatio)
+	breaker, _ = services.NewCapacityBreaker(defaults.FleetCapacityMaxRatio)
+	return breaker
+}
+
+// fleetCapacityOverflowMode parses the configured overflow mode, rejecting orders by default.
+func (c *CompositionRoot) fleetCapacityOverflowMode() commands.CapacityOverflowMode {
+	mode := commands.CapacityOverflowMode(c.config.FleetCapacityOverflowMode)
+	if err := mode.Validate(); err != nil {
+		c.logger.WarnContext(context.Background(), "Invalid fleet capacity overflow mode, using default",
+			"value", c.config.FleetCapacityOverflowMode,
+			"default", commands.RejectOnCapacityOverflow)
+		return commands.RejectOnCapacityOverflow
+	}
+	return mode
+}
+
+// configureBlobStorage creates the configured blob storage backend. Uploads stay disabled
+// when no backend is configured or its configuration is invalid.
+func (c *CompositionRoot) configureBlobStorage() {
+	switch c.config.BlobStorageBackend {
+	case "":
+		return
+	case "local":
+		storage, err := blobstore.NewLocalStorage(
+			c.config.BlobStorageDir,
+			c.config.BlobStoragePublicURL,
+			[]byte(c.config.BlobStorageSigningKey),
+		)
+		if err != nil {
+			c.logger.WarnContext(context.Background(), "Invalid local blob storage, uploads disabled", "error", err)
+			return
+		}
+		c.blobStorage, c.localBlobStorage = storage, storage
+	case "s3":
+		storage, err := blobstore.NewS3Storage(blobstore.S3Config{
+			Endpoint:        c.config.BlobStorageS3Endpoint,
+			Region:          c.config.BlobStorageS3Region,
+			Bucket:          c.config.BlobStorageS3Bucket,
+			AccessKeyID:     c.config.BlobStorageS3AccessKey,
+			SecretAccessKey: c.config.BlobStorageS3SecretKey,
+		}, blobStorageTimeout)
+		if err != nil {
+			c.logger.WarnContext(context.Background(), "Invalid S3 blob storage, uploads disabled", "error", err)
+			return
+		}
+		c.blobStorage = storage
+	default:
+		c.logger.WarnContext(context.Background(), "Unknown blob storage backend, uploads disabled",
+			"value", c.config.BlobStorageBackend)
+	}
+}
+
+// orphanedBlobTTL returns how long an upload may stay unattached before the janitor removes it,
+// falling back to the default when the TTL is not longer than an upload URL is valid.
+func (c *CompositionRoot) orphanedBlobTTL() time.Duration {
+	if c.config.OrphanedBlobTTL > commands.UploadURLValidity {
+		return c.config.OrphanedBlobTTL
+	}
+
+	defaults := config.Default()
+	c.logger.WarnContext(context.Background(), "Invalid orphaned blob TTL, using default",
+		"value", c.config.OrphanedBlobTTL.String(),
+		"default", defaults.OrphanedBlobTTL.String())
+	return defaults.OrphanedBlobTTL
+}
+
+// bestFitDispatchRollout builds the share of orders dispatched with the best-fit strategy,
+// falling back to zero, which keeps every order on the default strategy, when it exceeds 100.
+func (c *CompositionRoot) bestFitDispatchRollout() rollout.Percentage {
+	percentage, err := rollout.NewPercentage(c.config.BestFitDispatchRollout)
+	if err == nil {
+		return percentage
+	}
+
+	c.logger.WarnContext(context.Background(), "Invalid best-fit dispatch rollout, using default",
+		"value", c.config.BestFitDispatchRollout,
+		"default", 0)
+	return 0
+}
+
+// dispatchStrategy builds the strategy the assignment job ranks couriers with, selected by name.
+// Without a name, or with an unknown one, the weights decide: weights that consider the load of
+// the courier select the weighted strategy, others the fastest delivery.
+//
+//nolint:ireturn // strategies are interchangeable by design
+func (c *CompositionRoot) dispatchStrategy() services.DispatchStrategy {
+	weights := c.dispatchWeights()
+	fallback := services.DispatchStrategy(services.FastestDeliveryStrategy{})
+	if weights.ConsidersLoad() {
+		fallback = services.NewWeightedStrategy(weights)
+	}
+	if c.config.DispatchStrategy == "" {
+		return fallback
+	}
+
+	strategies := []services.DispatchStrategy{
+		services.FastestDeliveryStrategy{},
+		services.BestFitStrategy{},
+		services.GreedyNearestStrategy{},
+		services.LeastLoadedStrategy{},
+		services.NewWeightedStrategy(weights),
+	}
+	for _, strategy := range strategies {
+		if strategy.Name() == c.config.DispatchStrategy {
+			return strategy
+		}
+	}
+
+	c.logger.WarnContext(context.Background(), "Unknown dispatch strategy, using default",
+		"value", c.config.DispatchStrategy,
+		"default", fallback.Name())
+	return fallback
+}
+
+// dispatchWeights builds the weights of the dispatch score from the configuration, falling back
+// to ranking by travel time alone when the weights are all zero.
+func (c *CompositionRoot) dispatchWeights() services.DispatchWeights {
+	weights, err := services.NewDispatchWeights(
+		c.config.DispatchTravelTimeWeight,
+		c.config.DispatchOccupiedPlacesWeight,
+		c.config.DispatchRemainingVolumeWeight,
+	)
+	if err == nil {
+		return weights
+	}
+
+	defaults := config.Default()
+	c.logger.WarnContext(context.Background(), "Invalid dispatch weights, using default",
+		"error", err,
+		"default_travel_time", defaults.DispatchTravelTimeWeight)
+	weights, _ = services.NewDispatchWeights(
+		defaults.DispatchTravelTimeWeight,
+		defaults.DispatchOccupiedPlacesWeight,
+		defaults.DispatchRemainingVolumeWeight,
+	)
+	return weights
+}
+
+// workingHoursLimit builds the daily working hours limit of couriers and its warning period,
+// falling back to the defaults when the warning period does not fit into the limit.
+func (c *CompositionRoot) workingHoursLimit() courier.WorkingHoursLimit {
+	limit, err := courier.NewWorkingHoursLimit(c.config.MaxDailyWorkingHours, c.config.WorkingHoursWarningBefore)
+	if err == nil {
+		return limit
+	}
+
+	defaults := config.Default()
+	c.logger.WarnContext(context.Background(), "Invalid working hours limit, using default",
+		"daily", c.config.MaxDailyWorkingHours.String(),
+		"warning_before", c.config.WorkingHoursWarningBefore.String(),
+		"default_daily", defaults.MaxDailyWorkingHours.String(),
+		"default_warning_before", defaults.WorkingHoursWarningBefore.String())
+	limit, _ = courier.NewWorkingHoursLimit(defaults.MaxDailyWorkingHours, defaults.WorkingHoursWarningBefore)
+	return limit
+}
+
+// economyBatchingWindow builds how long economy orders accumulate before they enter dispatch,
+// falling back to the default when the window is rejected.
+func (c *CompositionRoot) economyBatchingWindow() order.BatchingWindow {
+	window, err := order.NewBatchingWindow(c.config.EconomyBatchingWindow)
+	if err == nil {
+		return window
+	}
+
+	defaults := config.Default()
+	c.logger.WarnContext(context.Background(), "Invalid economy batching window, using default",
+		"value", c.config.EconomyBatchingWindow.String(),
+		"default", defaults.EconomyBatchingWindow.String())
+	window, _ = order.NewBatchingWindow(defaults.EconomyBatchingWindow)
+	return window
+}
+
+// orderInsuranceThreshold builds the declared value from which orders are insured,
+// falling back to the default when the threshold is rejected.
+func (c *CompositionRoot) orderInsuranceThreshold() order.InsuranceThreshold {
+	threshold, err := order.NewInsuranceThreshold(c.config.InsuranceThreshold)
+	if err == nil {
+		return threshold
+	}
+
+	defaults := config.Default()
+	c.logger.WarnContext(context.Background(), "Invalid insurance threshold, using default",
+		"value", c.config.InsuranceThreshold,
+		"default", defaults.InsuranceThreshold)
+	threshold, _ = order.NewInsuranceThreshold(defaults.InsuranceThreshold)
+	return threshold
+}
+
+// microzoneDensity builds the radius and the minimum number of deliveries dense-demand microzones
+// are clustered with, falling back to the defaults when the clustering rejects them.
+func (c *CompositionRoot) microzoneDensity() microzone.Clustering {
+	clustering, err := microzone.NewClustering(c.config.MicrozoneRadius, c.config.MicrozoneMinDeliveries)
+	if err == nil {
+		return clustering
+	}
+
+	defaults := config.Default()
+	c.logger.WarnContext(context.Background(), "Invalid microzone density, using defaults",
+		"radius", c.config.MicrozoneRadius,
+		"min_deliveries", c.config.MicrozoneMinDeliveries,
+		"default_radius", defaults.MicrozoneRadius,
+		"default_min_deliveries", defaults.MicrozoneMinDeliveries)
+	clustering, _ = microzone.NewClustering(defaults.MicrozoneRadius, defaults.MicrozoneMinDeliveries)
+	return clustering
+}
+
+// apiMonthlyQuota builds the monthly request, order and webhook quotas of partner clients.
+// A zero quota leaves the metric unlimited.
+func (c *CompositionRoot) apiMonthlyQuota() usage.Quota {
+	quota, err := usage.NewQuota(
+		c.config.APIMonthlyRequestQuota,
+		c.config.APIMonthlyOrderQuota,
+		c.config.APIMonthlyWebhookQuota,
+	)
+	if err != nil {
+		c.logger.WarnContext(context.Background(), "Invalid API quotas, leaving them unlimited", "error", err)
+		quota, _ = usage.NewQuota(0, 0, 0)
+	}
+	return quota
+}
+
+// deviceHealthPolicy builds the battery level below which courier devices have a low battery and
+// the window of telemetry readings, falling back to the defaults when the policy rejects them.
+func (c *CompositionRoot) deviceHealthPolicy() device.HealthPolicy {
+	policy, err := device.NewHealthPolicy(c.config.DeviceMinBattery, c.config.DeviceTelemetryWindow)
+	if err == nil {
+		return policy
+	}
+
+	defaults := config.Default()
+	c.logger.WarnContext(context.Background(), "Invalid device health policy, using defaults",
+		"min_battery", c.config.DeviceMinBattery,
+		"window", c.config.DeviceTelemetryWindow.String(),
+		"default_min_battery", defaults.DeviceMinBattery,
+		"default_window", defaults.DeviceTelemetryWindow.String())
+	policy, _ = device.NewHealthPolicy(defaults.DeviceMinBattery, defaults.DeviceTelemetryWindow)
+	return policy
+}
+
+// deliveryGrid builds the delivery grid of the configured width and height, whose coordinates
+// start at 1, falling back to the default grid when the size does not fit the coordinates.
+func (c *CompositionRoot) deliveryGrid() kernel.Bounds {
+	width, height := c.config.GridWidth, c.config.GridHeight
+	if width <= math.MaxInt8 && height <= math.MaxInt8 {
+		minX, minY := kernel.DefaultBounds().MinX(), kernel.DefaultBounds().MinY()
+		maxX, maxY := minX+kernel.Coordinate(width-1), minY+kernel.Coordinate(height-1)
+		if bounds, err := kernel.NewBounds(minX, minY, maxX, maxY); err == nil {
+			return bounds
+		}
+	}
+
+	c.logger.WarnContext(context.Background(), "Invalid delivery grid, using default",
+		"width", width,
+		"height", height,
+		"default", kernel.DefaultBounds().String())
+	return kernel.DefaultBounds()
+}
+
+// identityAttemptLimit builds how many failed identity verifications couriers may make and within
+// which window, falling back to the defaults when the limit rejects them.
+func (c *CompositionRoot) identityAttemptLimit() identity.AttemptLimit {
+	limit, err := identity.NewAttemptLimit(c.config.IdentityMaxFailedAttempts, c.config.IdentityAttemptWindow)
+	if err == nil {
+		return limit
+	}
+
+	defaults := config.Default()
+	c.logger.WarnContext(context.Background(), "Invalid identity attempt limit, using defaults",
+		"max_failures", c.config.IdentityMaxFailedAttempts,
+		"window", c.config.IdentityAttemptWindow.String(),
+		"default_max_failures", defaults.IdentityMaxFailedAttempts,
+		"default_window", defaults.IdentityAttemptWindow.String())
+	limit, _ = identity.NewAttemptLimit(defaults.IdentityMaxFailedAttempts, defaults.IdentityAttemptWindow)
+	return limit
+}
+
+// routeDeviationPolicy builds how many cells couriers may stray from the route to their order and
+// on how many consecutive ticks, falling back to the defaults when the policy rejects them.
+func (c *CompositionRoot) routeDeviationPolicy() order.RouteDeviationPolicy {
+	policy, err := order.NewRouteDeviationPolicy(c.config.RouteDeviationMaxCells, c.config.RouteDeviationTicks)
+	if err == nil {
+		return policy
+	}
+
+	defaults := config.Default()
+	c.logger.WarnContext(context.Background(), "Invalid route deviation policy, using defaults",
+		"max_cells", c.config.RouteDeviationMaxCells,
+		"ticks", c.config.RouteDeviationTicks,
+		"default_max_cells", defaults.RouteDeviationMaxCells,
+		"default_ticks", defaults.RouteDeviationTicks)
+	policy, _ = order.NewRouteDeviationPolicy(defaults.RouteDeviationMaxCells, defaults.RouteDeviationTicks)
+	return policy
+}
+
+// surgeModePolicy builds the backlog of queued orders that activates the surge mode and the
+// maintenance warning during it, falling back to the defaults when the policy rejects them.
+func (c *CompositionRoot) surgeModePolicy() surge.Policy {
+	policy, err := surge.NewPolicy(c.config.SurgeBacklog, c.config.SurgeMaintenanceWarning)
+	if err == nil {
+		return policy
+	}
+
+	defaults := config.Default()
+	c.logger.WarnContext(context.Background(), "Invalid surge policy, using defaults",
+		"backlog", c.config.SurgeBacklog,
+		"maintenance_warning", c.config.SurgeMaintenanceWarning.String(),
+		"default_backlog", defaults.SurgeBacklog,
+		"default_maintenance_warning", defaults.SurgeMaintenanceWarning.String())
+	policy, _ = surge.NewPolicy(defaults.SurgeBacklog, defaults.SurgeMaintenanceWarning)
+	return policy
+}
+
+// urgentOrderEscalationPolicy builds the escalation tiers of urgent orders waiting for a courier.
+// Returns nil, disabling the escalation, when no tiers are configured. The tiers are validated at
+// startup; should they still be invalid, the escalation is disabled as well.
+func (c *CompositionRoot) urgentOrderEscalationPolicy() *escalation.Policy {
+	if c.config.UrgentOrderEscalationTiers == "" {
+		return nil
+	}
+
+	policy, err := escalation.ParsePolicy(c.config.UrgentOrderEscalationTiers)
+	if err != nil {
+		c.logger.WarnContext(context.Background(), "Invalid urgent order escalation tiers, escalation disabled",
+			"tiers", c.config.UrgentOrderEscalationTiers,
+			"error", err)
+		return nil
+	}
+	return &policy
+}
+
+// courierBadgeRules builds the rules couriers earn badges by. The rules are validated at startup;
+// should they still be invalid, no badges are awarded while deliveries and ratings are still counted.
+func (c *CompositionRoot) courierBadgeRules() []badge.Rule {
+	rules, err := badge.ParseRules(c.config.CourierBadgeRules)
+	if err != nil {
+		c.logger.WarnContext(context.Background(), "Invalid courier badge rules, no badges are awarded",
+			"rules", c.config.CourierBadgeRules,
+			"error", err)
+		return nil
+	}
+	return rules
+}
+
+// dispatchDegradationThresholds builds the assignment latency and the order backlog that switch
+// the dispatcher to greedy mode, falling back to the defaults when the thresholds are rejected.
+// Setting both thresholds to zero disables the degradation.
+func (c *CompositionRoot) dispatchDegradationThresholds() *commands.DispatchDegradation {
+	latency, backlog := c.config.DispatchDegradationLatency, c.config.DispatchDegradationBacklog
+	if latency == 0 && backlog == 0 {
+		return nil
+	}
+	degradation, err := commands.NewDispatchDegradation(latency, backlog)
+	if err == nil {
+		return degradation
+	}
+
+	defaults := config.Default()
+	c.logger.WarnContext(context.Background(), "Invalid dispatch degradation thresholds, using defaults",
+		"latency", latency.String(),
+		"backlog", backlog,
+		"default_latency", defaults.DispatchDegradationLatency.String(),
+		"default_backlog", defaults.DispatchDegradationBacklog)
+	degradation, _ = commands.NewDispatchDegradation(
+		defaults.DispatchDegradationLatency,
+		defaults.DispatchDegradationBacklog,
+	)
+	return degradation
+}
+
+type FuncCourierUoWFactory func() commands.CourierUoW
+
+func (f FuncCourierUoWFactory) Create() commands.CourierUoW {
+	return f()
+}
+
+type FuncPickupSlotUoWFactory func() commands.PickupSlotUoW
+
+func (f FuncPickupSlotUoWFactory) Create() commands.PickupSlotUoW {
+	return f()
+}
+
+type FuncTipUoWFactory func() commands.TipUoW
+
+func (f FuncTipUoWFactory) Create() commands.TipUoW {
+	return f()
+}
+
+type FuncTransferUoWFactory func() commands.TransferUoW
+
+func (f FuncTransferUoWFactory) Create() commands.TransferUoW {
+	return f()
+}
+
+type FuncMergeUoWFactory func() commands.MergeUoW
+
+func (f FuncMergeUoWFactory) Create() commands.MergeUoW {
+	return f()
+}
+
+type FuncErasureUoWFactory func() commands.ErasureUoW
+
+func (f FuncErasureUoWFactory) Create() commands.ErasureUoW {
+	return f()
+}
+
+type FuncBulkCancellationUoWFactory func() commands.BulkCancellationUoW
+
+func (f FuncBulkCancellationUoWFactory) Create() commands.BulkCancellationUoW {
+	return f()
+}
+
+type FuncOrderUoWFactory func() commands.OrderUoW
+
+func (f FuncOrderUoWFactory) Create() commands.OrderUoW {
+	return f()
+}
+
+type FuncUoWFactory func() commands.UoW
+
+func (f FuncUoWFactory) Create() commands.UoW {
+	return f()
+}
+
+// dispatchAssignmentLogger records committed courier assignments in the application log.
+type dispatchAssignmentLogger struct {
+	logger *slog.Logger
+}
+
+func (l dispatchAssignmentLogger) ProcessAssignment(_ context.Context, _ *commands.DispatchAssignment) error {
+	return nil
+}
+
+func (l dispatchAssignmentLogger) AssignmentCommitted(ctx context.Context, assignment commands.DispatchAssignment) {
+	l.logger.InfoContext(ctx, "courier assigned",
+		"order_id", assignment.Order.ID().String(),
+		"courier_id", assignment.Courier.ID().String(),
+		"annotations", assignment.Annotations(),
+	)
+}
+
+// dispatchComparisonLogger records the shadow scoring of the dispatch experiment in the application log.
+type dispatchComparisonLogger struct {
+	logger *slog.Logger
+}
+
+func (l dispatchComparisonLogger) DispatchCompared(ctx context.Context, comparison commands.DispatchComparison) {
+	l.logger.InfoContext(ctx, "dispatch strategies compared",
+		"order_id", comparison.OrderID.String(),
+		"applied", comparison.Applied,
+		dispatchChoiceGroup("baseline", comparison.Baseline),
+		dispatchChoiceGroup("candidate", comparison.Candidate),
+	)
+}
+
+// dispatchChoiceGroup groups the outcome of one strategy for the comparison log record.
+func dispatchChoiceGroup(key string, choice commands.DispatchChoice) slog.Attr {
+	attrs := []any{"strategy", choice.Strategy, "score", choice.Score}
+	if choice.Courier != nil {
+		attrs = append(attrs, "courier_id", choice.Courier.String())
+	}
+	if choice.Err != nil {
+		attrs = append(attrs, "error", choice.Err.Error())
+	}
+	return slog.Group(key, attrs...)
+}
+
+// replayProgressLogger reports outbox replay progress to the application log.
+type replayProgressLogger struct {
+	logger *slog.Logger
+}
+
+func (l replayProgressLogger) ReportProgress(ctx context.Context, report commands.ReplayOutboxReport) {
+	l.logger.InfoContext(ctx, "outbox replay progress",
+		"published", report.Published,
+		"last_occurred_at", report.LastOccurredAt,
+	)
 }
@@ -0,0 +1,579 @@
+// Package config loads the configuration of the service from the environment.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"delivery/internal/core/domain/model/badge"
+	"delivery/internal/core/domain/model/escalation"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tenant"
+
+	"github.com/joho/godotenv"
+)
+
+// envFile is the optional file of the working directory the environment is complemented with.
+const envFile = ".env"
+
+// DefaultProfilesDir is the directory of the deployment profiles unless PROFILES_DIR names another.
+const DefaultProfilesDir = "configs/profiles"
+
+// profileName restricts profile names to file names within the profiles directory.
+var profileName = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// Config is the configuration of the service. Every setting has a default, so only the
+// database credentials have to be configured; the zero value of an optional setting,
+// such as an empty URL or a zero quota, leaves the feature disabled.
+//
+// A deployment profile, selected with PROFILE, replaces the defaults of a city deployment:
+// its grid and zone sizes, tariffs and job cadences. Profiles are files of variables named
+// <profile>.env in the profiles directory, and every variable of the environment overrides
+// the profile, so one binary serves every city.
+type Config struct {
+	// Profile is the name of the deployment profile; empty when the defaults are used.
+	Profile string
+
+	HTTPPort                  string
+	GRPCPort                  string
+	ShutdownTimeout           time.Duration
+	DBHost                    string
+	DBPort                    string
+	DBUser                    string
+	DBPassword                string
+	DBName                    string
+	DBSslMode                 string
+	GeoServiceGrpcHost        string
+	KafkaHost                 string
+	KafkaConsumerGroup        string
+	KafkaBasketConfirmedTopic string
+	KafkaOrderChangedTopic    string
+
+	CourierInactivityThresholdTicks int
+	FleetCapacityMaxRatio           float64
+	FleetCapacityOverflowMode       string
+	AssignmentJobMinInterval        time.Duration
+	AssignmentJobMaxInterval        time.Duration
+	FraudServiceURL                 string
+	FraudServiceTimeout             time.Duration
+	IdentityServiceURL              string
+	IdentityServiceTimeout          time.Duration
+	IdentityMaxFailedAttempts       int
+	IdentityAttemptWindow           time.Duration
+	DefaultTenantID                 string
+	Tenants                         string
+	TenantTokens                    string
+	DispatcherStateFile             string
+	SyntheticDataTTL                time.Duration
+	QueryStatementTimeout           time.Duration
+	CommandStatementTimeout         time.Duration
+	BestFitDispatchRollout          int
+	DispatchTravelTimeWeight        float64
+	DispatchOccupiedPlacesWeight    float64
+	DispatchRemainingVolumeWeight   float64
+	DispatchStrategy                string
+	DispatchBatchSize               int
+	MaxDailyWorkingHours            time.Duration
+	WorkingHoursWarningBefore       time.Duration
+	JobStallFactor                  int
+	PickupSlotsEnabled              bool
+	PaymentWebhookSecret            string
+	MaintenanceWarningBefore        time.Duration
+	ShiftEndHandoverEnabled         bool
+	EconomyBatchingWindow           time.Duration
+	DispatchDegradationLatency      time.Duration
+	DispatchDegradationBacklog      int
+	InsuranceThreshold              int
+	MicrozoneRadius                 int
+	MicrozoneMinDeliveries          int
+	APIMonthlyRequestQuota          int
+	APIMonthlyOrderQuota            int
+	APIMonthlyWebhookQuota          int
+	DeviceMinBattery                int
+	DeviceTelemetryWindow           time.Duration
+	StagingSourceDSN                string
+	PseudonymizationKey             string
+	RouteDeviationMaxCells          int
+	RouteDeviationTicks             int
+	SurgeBacklog                    int
+	SurgeMaintenanceWarning         time.Duration
+	SLOWindow                       time.Duration
+	SLOAssignmentThreshold          time.Duration
+	SLODeliveryThreshold            time.Duration
+	SLOGoal                         float64
+	SLOBurnRateAlert                float64
+	SLOAlertWebhookURL              string
+	SLOAlertWebhookTimeout          time.Duration
+	AvailabilityWebhookURL          string
+	AvailabilityWebhookTimeout      time.Duration
+	ShadowWrites                    string
+	GridWidth                       int
+	GridHeight                      int
+	BlobStorageBackend              string
+	BlobStorageDir                  string
+	BlobStoragePublicURL            string
+	BlobStorageSigningKey           string
+	BlobStorageS3Endpoint           string
+	BlobStorageS3Region             string
+	BlobStorageS3Bucket             string
+	BlobStorageS3AccessKey          string
+	BlobStorageS3SecretKey          string
+	OrphanedBlobTTL                 time.Duration
+	OrderArchiveAfterDays           int
+	OrderCreatedTimeout             time.Duration
+	OrderCreatedTimeouts            string
+	UrgentOrderEscalationTiers      string
+	CourierBadgeRules               string
+	TrackingCacheTTL                time.Duration
+	TrackingCacheSize               int
+	TracingOTLPEndpoint             string
+	TracingSampleRatio              float64
+}
+
+// Default returns the configuration used for every variable that is missing or empty.
+func Default() Config {
+	return Config{
+		HTTPPort:                  "8082",
+		ShutdownTimeout:           10 * time.Second,
+		DBPort:                    "5432",
+		DBSslMode:                 "disable",
+		KafkaConsumerGroup:        "delivery-service-group",
+		KafkaBasketConfirmedTopic: "basket.confirmed",
+		KafkaOrderChangedTopic:    "order.status.changed",
+
+		CourierInactivityThresholdTicks: 30,
+		FleetCapacityMaxRatio:           10,
+		FleetCapacityOverflowMode:       "reject",
+		AssignmentJobMinInterval:        200 * time.Millisecond,
+		AssignmentJobMaxInterval:        2 * time.Second,
+		FraudServiceTimeout:             500 * time.Millisecond,
+		IdentityServiceTimeout:          2 * time.Second,
+		IdentityMaxFailedAttempts:       5,
+		IdentityAttemptWindow:           15 * time.Minute,
+		DefaultTenantID:                 "default",
+		QueryStatementTimeout:           5 * time.Second,
+		CommandStatementTimeout:         10 * time.Second,
+		DispatchTravelTimeWeight:        1,
+		DispatchBatchSize:               1,
+		MaxDailyWorkingHours:            8 * time.Hour,
+		WorkingHoursWarningBefore:       30 * time.Minute,
+		JobStallFactor:                  3,
+		MaintenanceWarningBefore:        time.Hour,
+		EconomyBatchingWindow:           30 * time.Minute,
+		DispatchDegradationLatency:      2 * time.Second,
+		DispatchDegradationBacklog:      500,
+		InsuranceThreshold:              100_000,
+		MicrozoneRadius:                 1,
+		MicrozoneMinDeliveries:          50,
+		DeviceMinBattery:                15,
+		DeviceTelemetryWindow:           10 * time.Minute,
+		RouteDeviationMaxCells:          2,
+		RouteDeviationTicks:             3,
+		SurgeBacklog:                    200,
+		SurgeMaintenanceWarning:         15 * time.Minute,
+		SLOWindow:                       time.Hour,
+		SLOAssignmentThreshold:          5 * time.Minute,
+		SLODeliveryThreshold:            45 * time.Minute,
+		SLOGoal:                         95,
+		SLOBurnRateAlert:                2,
+		SLOAlertWebhookTimeout:          5 * time.Second,
+		AvailabilityWebhookTimeout:      5 * time.Second,
+		GridWidth:                       10,
+		GridHeight:                      10,
+		BlobStorageDir:                  "./data/blobs",
+		OrphanedBlobTTL:                 24 * time.Hour,
+		CourierBadgeRules:               "hundred-deliveries=deliveries:100,five-star-week=five-star-week:5",
+		TrackingCacheTTL:                5 * time.Second,
+		TrackingCacheSize:               10000,
+		TracingSampleRatio:              1,
+	}
+}
+
+// Load reads the configuration once at startup. The .env file of the working directory is
+// optional: variables of the environment and of the deployment profile take precedence over it,
+// so containers can be configured with environment variables alone and a local .env file
+// listing every variable does not mask the profile.
+// Returns a *errs.ValidationErrors naming every invalid variable.
+func Load() (Config, error) {
+	local, err := godotenv.Read(envFile)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return Config{}, fmt.Errorf("load %s: %w", envFile, err)
+	}
+
+	return parseLayers(os.LookupEnv, local)
+}
+
+// Parse builds the configuration from the variables returned by lookup, taking the value of
+// the deployment profile and then the default of every variable that is missing or empty.
+// Returns a *errs.ValidationErrors naming every variable that is malformed or out of range,
+// and PROFILE when the profile does not exist or sets variables the service does not read.
+func Parse(lookup func(key string) (string, bool)) (Config, error) {
+	return parseLayers(lookup, nil)
+}
+
+// parseLayers builds the configuration like Parse, taking the local variables before the defaults.
+func parseLayers(lookup func(key string) (string, bool), local map[string]string) (Config, error) {
+	d := Default()
+	p := parser{lookup: lookup, local: local, read: make(map[string]bool)}
+	p.loadProfile(p.string("PROFILE", ""), p.string("PROFILES_DIR", DefaultProfilesDir))
+
+	config := Config{
+		Profile: p.profileName,
+
+		HTTPPort:                  p.string("HTTP_PORT", d.HTTPPort),
+		GRPCPort:                  p.string("GRPC_PORT", d.GRPCPort),
+		ShutdownTimeout:           p.duration("SHUTDOWN_TIMEOUT", d.ShutdownTimeout),
+		DBHost:                    p.string("DB_HOST", d.DBHost),
+		DBPort:                    p.string("DB_PORT", d.DBPort),
+		DBUser:                    p.string("DB_USER", d.DBUser),
+		DBPassword:                p.string("DB_PASSWORD", d.DBPassword),
+		DBName:                    p.string("DB_NAME", d.DBName),
+		DBSslMode:                 p.string("DB_SSLMODE", d.DBSslMode),
+		GeoServiceGrpcHost:        p.string("GEO_SERVICE_GRPC_HOST", d.GeoServiceGrpcHost),
+		KafkaHost:                 p.string("KAFKA_HOST", d.KafkaHost),
+		KafkaConsumerGroup:        p.string("KAFKA_CONSUMER_GROUP", d.KafkaConsumerGroup),
+		KafkaBasketConfirmedTopic: p.string("KAFKA_BASKET_CONFIRMED_TOPIC", d.KafkaBasketConfirmedTopic),
+		KafkaOrderChangedTopic:    p.string("KAFKA_ORDER_CHANGED_TOPIC", d.KafkaOrderChangedTopic),
+
+		CourierInactivityThresholdTicks: p.int("COURIER_INACTIVITY_THRESHOLD_TICKS", d.CourierInactivityThresholdTicks),
+		FleetCapacityMaxRatio:           p.float("FLEET_CAPACITY_MAX_RATIO", d.FleetCapacityMaxRatio),
+		FleetCapacityOverflowMode:       p.string("FLEET_CAPACITY_OVERFLOW_MODE", d.FleetCapacityOverflowMode),
+		AssignmentJobMinInterval:        p.duration("ASSIGNMENT_JOB_MIN_INTERVAL", d.AssignmentJobMinInterval),
+		AssignmentJobMaxInterval:        p.duration("ASSIGNMENT_JOB_MAX_INTERVAL", d.AssignmentJobMaxInterval),
+		FraudServiceURL:                 p.string("FRAUD_SERVICE_URL", d.FraudServiceURL),
+		FraudServiceTimeout:             p.duration("FRAUD_SERVICE_TIMEOUT", d.FraudServiceTimeout),
+		IdentityServiceURL:              p.string("IDENTITY_SERVICE_URL", d.IdentityServiceURL),
+		IdentityServiceTimeout:          p.duration("IDENTITY_SERVICE_TIMEOUT", d.IdentityServiceTimeout),
+		IdentityMaxFailedAttempts:       p.int("IDENTITY_MAX_FAILED_ATTEMPTS", d.IdentityMaxFailedAttempts),
+		IdentityAttemptWindow:           p.duration("IDENTITY_ATTEMPT_WINDOW", d.IdentityAttemptWindow),
+		DefaultTenantID:                 p.string("DEFAULT_TENANT_ID", d.DefaultTenantID),
+		Tenants:                         p.string("TENANTS", d.Tenants),
+		TenantTokens:                    p.string("TENANT_TOKENS", d.TenantTokens),
+		DispatcherStateFile:             p.string("DISPATCHER_STATE_FILE", d.DispatcherStateFile),
+		SyntheticDataTTL:                p.duration("SYNTHETIC_DATA_TTL", d.SyntheticDataTTL),
+		QueryStatementTimeout:           p.duration("QUERY_STATEMENT_TIMEOUT", d.QueryStatementTimeout),
+		CommandStatementTimeout:         p.duration("COMMAND_STATEMENT_TIMEOUT", d.CommandStatementTimeout),
+		BestFitDispatchRollout:          p.int("BEST_FIT_DISPATCH_ROLLOUT", d.BestFitDispatchRollout),
+		DispatchTravelTimeWeight:        p.float("DISPATCH_TRAVEL_TIME_WEIGHT", d.DispatchTravelTimeWeight),
+		DispatchOccupiedPlacesWeight:    p.float("DISPATCH_OCCUPIED_PLACES_WEIGHT", d.DispatchOccupiedPlacesWeight),
+		DispatchRemainingVolumeWeight:   p.float("DISPATCH_REMAINING_VOLUME_WEIGHT", d.DispatchRemainingVolumeWeight),
+		DispatchStrategy:                p.string("DISPATCH_STRATEGY", d.DispatchStrategy),
+		DispatchBatchSize:               p.int("DISPATCH_BATCH_SIZE", d.DispatchBatchSize),
+		MaxDailyWorkingHours:            p.duration("MAX_DAILY_WORKING_HOURS", d.MaxDailyWorkingHours),
+		WorkingHoursWarningBefore:       p.duration("WORKING_HOURS_WARNING_BEFORE", d.WorkingHoursWarningBefore),
+		JobStallFactor:                  p.int("JOB_STALL_FACTOR", d.JobStallFactor),
+		PickupSlotsEnabled:              p.bool("PICKUP_SLOTS_ENABLED", d.PickupSlotsEnabled),
+		PaymentWebhookSecret:            p.string("PAYMENT_WEBHOOK_SECRET", d.PaymentWebhookSecret),
+		MaintenanceWarningBefore:        p.duration("MAINTENANCE_WARNING_BEFORE", d.MaintenanceWarningBefore),
+		ShiftEndHandoverEnabled:         p.bool("SHIFT_END_HANDOVER_ENABLED", d.ShiftEndHandoverEnabled),
+		EconomyBatchingWindow:           p.duration("ECONOMY_BATCHING_WINDOW", d.EconomyBatchingWindow),
+		DispatchDegradationLatency:      p.duration("DISPATCH_DEGRADATION_LATENCY", d.DispatchDegradationLatency),
+		DispatchDegradationBacklog:      p.int("DISPATCH_DEGRADATION_BACKLOG", d.DispatchDegradationBacklog),
+		InsuranceThreshold:              p.int("INSURANCE_THRESHOLD", d.InsuranceThreshold),
+		MicrozoneRadius:                 p.int("MICROZONE_RADIUS", d.MicrozoneRadius),
+		MicrozoneMinDeliveries:          p.int("MICROZONE_MIN_DELIVERIES", d.MicrozoneMinDeliveries),
+		APIMonthlyRequestQuota:          p.int("API_MONTHLY_REQUEST_QUOTA", d.APIMonthlyRequestQuota),
+		APIMonthlyOrderQuota:            p.int("API_MONTHLY_ORDER_QUOTA", d.APIMonthlyOrderQuota),
+		APIMonthlyWebhookQuota:          p.int("API_MONTHLY_WEBHOOK_QUOTA", d.APIMonthlyWebhookQuota),
+		DeviceMinBattery:                p.int("DEVICE_MIN_BATTERY", d.DeviceMinBattery),
+		DeviceTelemetryWindow:           p.duration("DEVICE_TELEMETRY_WINDOW", d.DeviceTelemetryWindow),
+		StagingSourceDSN:                p.string("STAGING_SOURCE_DSN", d.StagingSourceDSN),
+		PseudonymizationKey:             p.string("PSEUDONYMIZATION_KEY", d.PseudonymizationKey),
+		RouteDeviationMaxCells:          p.int("ROUTE_DEVIATION_MAX_CELLS", d.RouteDeviationMaxCells),
+		RouteDeviationTicks:             p.int("ROUTE_DEVIATION_TICKS", d.RouteDeviationTicks),
+		SurgeBacklog:                    p.int("SURGE_BACKLOG", d.SurgeBacklog),
+		SurgeMaintenanceWarning:         p.duration("SURGE_MAINTENANCE_WARNING", d.SurgeMaintenanceWarning),
+		SLOWindow:                       p.duration("SLO_WINDOW", d.SLOWindow),
+		SLOAssignmentThreshold:          p.duration("SLO_ASSIGNMENT_THRESHOLD", d.SLOAssignmentThreshold),
+		SLODeliveryThreshold:            p.duration("SLO_DELIVERY_THRESHOLD", d.SLODeliveryThreshold),
+		SLOGoal:                         p.float("SLO_GOAL", d.SLOGoal),
+		SLOBurnRateAlert:                p.float("SLO_BURN_RATE_ALERT", d.SLOBurnRateAlert),
+		SLOAlertWebhookURL:              p.string("SLO_ALERT_WEBHOOK_URL", d.SLOAlertWebhookURL),
+		SLOAlertWebhookTimeout:          p.duration("SLO_ALERT_WEBHOOK_TIMEOUT", d.SLOAlertWebhookTimeout),
+		AvailabilityWebhookURL:          p.string("AVAILABILITY_WEBHOOK_URL", d.AvailabilityWebhookURL),
+		AvailabilityWebhookTimeout:      p.duration("AVAILABILITY_WEBHOOK_TIMEOUT", d.AvailabilityWebhookTimeout),
+		ShadowWrites:                    p.string("SHADOW_WRITES", d.ShadowWrites),
+		GridWidth:                       p.int("GRID_WIDTH", d.GridWidth),
+		GridHeight:                      p.int("GRID_HEIGHT", d.GridHeight),
+		BlobStorageBackend:              p.string("BLOB_STORAGE_BACKEND", d.BlobStorageBackend),
+		BlobStorageDir:                  p.string("BLOB_STORAGE_DIR", d.BlobStorageDir),
+		BlobStoragePublicURL:            p.string("BLOB_STORAGE_PUBLIC_URL", d.BlobStoragePublicURL),
+		BlobStorageSigningKey:           p.string("BLOB_STORAGE_SIGNING_KEY", d.BlobStorageSigningKey),
+		BlobStorageS3Endpoint:           p.string("BLOB_STORAGE_S3_ENDPOINT", d.BlobStorageS3Endpoint),
+		BlobStorageS3Region:             p.string("BLOB_STORAGE_S3_REGION", d.BlobStorageS3Region),
+		BlobStorageS3Bucket:             p.string("BLOB_STORAGE_S3_BUCKET", d.BlobStorageS3Bucket),
+		BlobStorageS3AccessKey:          p.string("BLOB_STORAGE_S3_ACCESS_KEY", d.BlobStorageS3AccessKey),
+		BlobStorageS3SecretKey:          p.string("BLOB_STORAGE_S3_SECRET_KEY", d.BlobStorageS3SecretKey),
+		OrphanedBlobTTL:                 p.duration("ORPHANED_BLOB_TTL", d.OrphanedBlobTTL),
+		OrderArchiveAfterDays:           p.int("ORDER_ARCHIVE_AFTER_DAYS", d.OrderArchiveAfterDays),
+		OrderCreatedTimeout:             p.duration("ORDER_CREATED_TIMEOUT", d.OrderCreatedTimeout),
+		OrderCreatedTimeouts:            p.string("ORDER_CREATED_TIMEOUTS", d.OrderCreatedTimeouts),
+		UrgentOrderEscalationTiers:      p.string("URGENT_ORDER_ESCALATION_TIERS", d.UrgentOrderEscalationTiers),
+		CourierBadgeRules:               p.string("COURIER_BADGE_RULES", d.CourierBadgeRules),
+		TrackingCacheTTL:                p.duration("TRACKING_CACHE_TTL", d.TrackingCacheTTL),
+		TrackingCacheSize:               p.int("TRACKING_CACHE_SIZE", d.TrackingCacheSize),
+		TracingOTLPEndpoint:             p.string("TRACING_OTLP_ENDPOINT", d.TracingOTLPEndpoint),
+		TracingSampleRatio:              p.float("TRACING_SAMPLE_RATIO", d.TracingSampleRatio),
+	}
+
+	p.unknownProfileVariables()
+	p.required("DB_HOST", config.DBHost)
+	p.required("DB_USER", config.DBUser)
+	p.required("DB_NAME", config.DBName)
+
+	positive(&p, "SHUTDOWN_TIMEOUT", config.ShutdownTimeout)
+	positive(&p, "COURIER_INACTIVITY_THRESHOLD_TICKS", config.CourierInactivityThresholdTicks)
+	positive(&p, "FLEET_CAPACITY_MAX_RATIO", config.FleetCapacityMaxRatio)
+	positive(&p, "ASSIGNMENT_JOB_MIN_INTERVAL", config.AssignmentJobMinInterval)
+	positive(&p, "ASSIGNMENT_JOB_MAX_INTERVAL", config.AssignmentJobMaxInterval)
+	positive(&p, "FRAUD_SERVICE_TIMEOUT", config.FraudServiceTimeout)
+	positive(&p, "IDENTITY_SERVICE_TIMEOUT", config.IdentityServiceTimeout)
+	positive(&p, "IDENTITY_MAX_FAILED_ATTEMPTS", config.IdentityMaxFailedAttempts)
+	positive(&p, "IDENTITY_ATTEMPT_WINDOW", config.IdentityAttemptWindow)
+	tenantTokens(&p, config)
+	nonNegative(&p, "SYNTHETIC_DATA_TTL", config.SyntheticDataTTL)
+	positive(&p, "QUERY_STATEMENT_TIMEOUT", config.QueryStatementTimeout)
+	positive(&p, "COMMAND_STATEMENT_TIMEOUT", config.CommandStatementTimeout)
+	nonNegative(&p, "BEST_FIT_DISPATCH_ROLLOUT", config.BestFitDispatchRollout)
+	nonNegative(&p, "DISPATCH_TRAVEL_TIME_WEIGHT", config.DispatchTravelTimeWeight)
+	nonNegative(&p, "DISPATCH_OCCUPIED_PLACES_WEIGHT", config.DispatchOccupiedPlacesWeight)
+	nonNegative(&p, "DISPATCH_REMAINING_VOLUME_WEIGHT", config.DispatchRemainingVolumeWeight)
+	positive(&p, "DISPATCH_BATCH_SIZE", config.DispatchBatchSize)
+	positive(&p, "MAX_DAILY_WORKING_HOURS", config.MaxDailyWorkingHours)
+	nonNegative(&p, "WORKING_HOURS_WARNING_BEFORE", config.WorkingHoursWarningBefore)
+	positive(&p, "JOB_STALL_FACTOR", config.JobStallFactor)
+	nonNegative(&p, "MAINTENANCE_WARNING_BEFORE", config.MaintenanceWarningBefore)
+	positive(&p, "ECONOMY_BATCHING_WINDOW", config.EconomyBatchingWindow)
+	nonNegative(&p, "DISPATCH_DEGRADATION_LATENCY", config.DispatchDegradationLatency)
+	nonNegative(&p, "DISPATCH_DEGRADATION_BACKLOG", config.DispatchDegradationBacklog)
+	positive(&p, "INSURANCE_THRESHOLD", config.InsuranceThreshold)
+	positive(&p, "MICROZONE_RADIUS", config.MicrozoneRadius)
+	positive(&p, "MICROZONE_MIN_DELIVERIES", config.MicrozoneMinDeliveries)
+	nonNegative(&p, "API_MONTHLY_REQUEST_QUOTA", config.APIMonthlyRequestQuota)
+	nonNegative(&p, "API_MONTHLY_ORDER_QUOTA", config.APIMonthlyOrderQuota)
+	nonNegative(&p, "API_MONTHLY_WEBHOOK_QUOTA", config.APIMonthlyWebhookQuota)
+	nonNegative(&p, "DEVICE_MIN_BATTERY", config.DeviceMinBattery)
+	positive(&p, "DEVICE_TELEMETRY_WINDOW", config.DeviceTelemetryWindow)
+	positive(&p, "ROUTE_DEVIATION_MAX_CELLS", config.RouteDeviationMaxCells)
+	positive(&p, "ROUTE_DEVIATION_TICKS", config.RouteDeviationTicks)
+	positive(&p, "SURGE_BACKLOG", config.SurgeBacklog)
+	nonNegative(&p, "SURGE_MAINTENANCE_WARNING", config.SurgeMaintenanceWarning)
+	positive(&p, "SLO_WINDOW", config.SLOWindow)
+	positive(&p, "SLO_ASSIGNMENT_THRESHOLD", config.SLOAssignmentThreshold)
+	positive(&p, "SLO_DELIVERY_THRESHOLD", config.SLODeliveryThreshold)
+	if config.SLOGoal <= 0 || config.SLOGoal >= 100 {
+		// A goal of 100% leaves no error budget to burn
+		p.validation.Add("SLO_GOAL", errs.NewValueIsInvalidErrorWithCause(
+			"SLO_GOAL", fmt.Errorf("%v is not above 0 and below 100", config.SLOGoal),
+		))
+	}
+	positive(&p, "SLO_BURN_RATE_ALERT", config.SLOBurnRateAlert)
+	positive(&p, "SLO_ALERT_WEBHOOK_TIMEOUT", config.SLOAlertWebhookTimeout)
+	positive(&p, "AVAILABILITY_WEBHOOK_TIMEOUT", config.AvailabilityWebhookTimeout)
+	positive(&p, "GRID_WIDTH", config.GridWidth)
+	positive(&p, "GRID_HEIGHT", config.GridHeight)
+	positive(&p, "ORPHANED_BLOB_TTL", config.OrphanedBlobTTL)
+	nonNegative(&p, "ORDER_ARCHIVE_AFTER_DAYS", config.OrderArchiveAfterDays)
+	nonNegative(&p, "ORDER_CREATED_TIMEOUT", config.OrderCreatedTimeout)
+	orderCreatedTimeouts(&p, config)
+	if config.UrgentOrderEscalationTiers != "" {
+		if _, err := escalation.ParsePolicy(config.UrgentOrderEscalationTiers); err != nil {
+			p.validation.Add("URGENT_ORDER_ESCALATION_TIERS", errs.NewValueIsInvalidErrorWithCause(
+				"URGENT_ORDER_ESCALATION_TIERS", err,
+			))
+		}
+	}
+	if _, err := badge.ParseRules(config.CourierBadgeRules); err != nil {
+		p.validation.Add("COURIER_BADGE_RULES", errs.NewValueIsInvalidErrorWithCause("COURIER_BADGE_RULES", err))
+	}
+	nonNegative(&p, "TRACKING_CACHE_TTL", config.TrackingCacheTTL)
+	positive(&p, "TRACKING_CACHE_SIZE", config.TrackingCacheSize)
+	share(&p, "TRACING_SAMPLE_RATIO", config.TracingSampleRatio)
+
+	if err := p.validation.Err(); err != nil {
+		return Config{}, err
+	}
+	return config, nil
+}
+
+// tenantTokens validates TENANTS and TENANT_TOKENS. Every tenant holding a token must be served
+// by the background jobs, or its orders would be accepted but never dispatched.
+func tenantTokens(p *parser, config Config) {
+	tenants, err := tenant.ParseIDs(config.Tenants)
+	if err != nil {
+		p.validation.Add("TENANTS", errs.NewValueIsInvalidErrorWithCause("TENANTS", err))
+		return
+	}
+
+	credentials, err := tenant.ParseCredentials(config.TenantTokens)
+	if err != nil {
+		// The error names the malformed tenant, never the token
+		p.validation.Add("TENANT_TOKENS", errs.NewValueIsInvalidErrorWithCause("TENANT_TOKENS", err))
+		return
+	}
+	for _, id := range credentials.Tenants() {
+		if id.String() != config.DefaultTenantID && !slices.Contains(tenants, id) {
+			p.validation.Add("TENANT_TOKENS", errs.NewValueIsInvalidErrorWithCause(
+				"TENANT_TOKENS", fmt.Errorf("tenant %q is not listed in TENANTS", id),
+			))
+		}
+	}
+}
+
+// orderCreatedTimeouts validates ORDER_CREATED_TIMEOUTS. Overrides of tenants the background jobs
+// do not serve would never apply, so they are rejected as likely typos.
+func orderCreatedTimeouts(p *parser, config Config) {
+	timeouts, err := tenant.ParseDurations(config.OrderCreatedTimeouts)
+	if err != nil {
+		p.validation.Add("ORDER_CREATED_TIMEOUTS", errs.NewValueIsInvalidErrorWithCause("ORDER_CREATED_TIMEOUTS", err))
+		return
+	}
+
+	tenants, err := tenant.ParseIDs(config.Tenants)
+	if err != nil {
+		// TENANTS is reported by tenantTokens
+		return
+	}
+	for _, id := range timeouts.Tenants() {
+		if id.String() != config.DefaultTenantID && !slices.Contains(tenants, id) {
+			p.validation.Add("ORDER_CREATED_TIMEOUTS", errs.NewValueIsInvalidErrorWithCause(
+				"ORDER_CREATED_TIMEOUTS", fmt.Errorf("tenant %q is not listed in TENANTS", id),
+			))
+		}
+	}
+}
+
+// parser reads typed variables, collecting the errors of all of them.
+type parser struct {
+	lookup      func(key string) (string, bool)
+	profileName string
+	profile     map[string]string
+	local       map[string]string
+	read        map[string]bool
+	validation  errs.ValidationErrors
+}
+
+// value returns the first non-empty value of the variable in the environment, the profile and
+// the local variables, reporting whether it is set to a non-empty value.
+func (p *parser) value(key string) (string, bool) {
+	p.read[key] = true
+	if value, ok := p.lookup(key); ok && value != "" {
+		return value, true
+	}
+	if value := p.profile[key]; value != "" {
+		return value, true
+	}
+	value := p.local[key]
+	return value, value != ""
+}
+
+// loadProfile reads the variables of the named profile from dir; no name selects no profile.
+func (p *parser) loadProfile(name, dir string) {
+	if name == "" {
+		return
+	}
+	if !profileName.MatchString(name) {
+		p.validation.Add("PROFILE", errs.NewValueIsInvalidErrorWithCause(
+			"PROFILE", fmt.Errorf("%q is not a profile name of lowercase letters, digits and dashes", name),
+		))
+		return
+	}
+
+	profile, err := godotenv.Read(filepath.Join(dir, name+".env"))
+	if errors.Is(err, fs.ErrNotExist) {
+		err = fmt.Errorf("profile %q is not found in %s", name, dir)
+	}
+	if err != nil {
+		p.validation.Add("PROFILE", errs.NewValueIsInvalidErrorWithCause("PROFILE", err))
+		return
+	}
+	p.profileName, p.profile = name, profile
+}
+
+// unknownProfileVariables rejects profile variables the service does not read, which are most likely
+// misspelt, and variables selecting the profile, which a profile cannot change.
+func (p *parser) unknownProfileVariables() {
+	for _, key := range slices.Sorted(maps.Keys(p.profile)) {
+		if !p.read[key] || key == "PROFILE" || key == "PROFILES_DIR" {
+			p.validation.Add("PROFILE", errs.NewValueIsInvalidErrorWithCause(
+				"PROFILE", fmt.Errorf("profile %q sets unsupported variable %s", p.profileName, key),
+			))
+		}
+	}
+}
+
+func (p *parser) string(key string, fallback string) string {
+	if value, ok := p.value(key); ok {
+		return value
+	}
+	return fallback
+}
+
+func (p *parser) int(key string, fallback int) int {
+	return parse(p, key, fallback, strconv.Atoi)
+}
+
+func (p *parser) float(key string, fallback float64) float64 {
+	return parse(p, key, fallback, func(value string) (float64, error) {
+		return strconv.ParseFloat(value, 64)
+	})
+}
+
+func (p *parser) duration(key string, fallback time.Duration) time.Duration {
+	return parse(p, key, fallback, time.ParseDuration)
+}
+
+func (p *parser) bool(key string, fallback bool) bool {
+	return parse(p, key, fallback, strconv.ParseBool)
+}
+
+func (p *parser) required(key string, value string) {
+	if value == "" {
+		p.validation.Add(key, errs.NewValueIsRequiredError(key))
+	}
+}
+
+func parse[T any](p *parser, key string, fallback T, parseValue func(string) (T, error)) T {
+	value, ok := p.value(key)
+	if !ok {
+		return fallback
+	}
+
+	parsed, err := parseValue(value)
+	if err != nil {
+		p.validation.Add(key, errs.NewValueIsInvalidErrorWithCause(key, err))
+		return fallback
+	}
+	return parsed
+}
+
+type number interface {
+	~int | ~float64 | ~int64
+}
+
+func positive[T number](p *parser, key string, value T) {
+	if value <= 0 {
+		p.validation.Add(key, errs.NewValueIsInvalidErrorWithCause(key, fmt.Errorf("%v is not positive", value)))
+	}
+}
+
+func nonNegative[T number](p *parser, key string, value T) {
+	if value < 0 {
+		p.validation.Add(key, errs.NewValueIsInvalidErrorWithCause(key, fmt.Errorf("%v is negative", value)))
+	}
+}
+
+// share validates a fraction of a whole, from 0 to 1.
+func share(p *parser, key string, value float64) {
+	if value < 0 || value > 1 {
+		p.validation.Add(key, errs.NewValueIsOutOfRangeError(key, value, 0, 1))
+	}
+}
@@ -0,0 +1,281 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"delivery/cmd/config"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func lookup(variables map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		value, ok := variables[key]
+		return value, ok
+	}
+}
+
+func database() map[string]string {
+	return map[string]string{
+		"DB_HOST": "localhost",
+		"DB_USER": "username",
+		"DB_NAME": "delivery",
+	}
+}
+
+func TestParse_Defaults(t *testing.T) {
+	variables := database()
+	variables["FRAUD_SERVICE_URL"] = ""
+	variables["ASSIGNMENT_JOB_MAX_INTERVAL"] = ""
+
+	cfg, err := config.Parse(lookup(variables))
+
+	require.NoError(t, err)
+	expected := config.Default()
+	expected.DBHost, expected.DBUser, expected.DBName = "localhost", "username", "delivery"
+	assert.Equal(t, expected, cfg)
+}
+
+func TestParse_TypedValues(t *testing.T) {
+	variables := database()
+	variables["HTTP_PORT"] = "9090"
+	variables["COURIER_INACTIVITY_THRESHOLD_TICKS"] = "45"
+	variables["FLEET_CAPACITY_MAX_RATIO"] = "2.5"
+	variables["ASSIGNMENT_JOB_MIN_INTERVAL"] = "1s"
+	variables["PICKUP_SLOTS_ENABLED"] = "true"
+	variables["SYNTHETIC_DATA_TTL"] = "72h"
+	variables["ORDER_ARCHIVE_AFTER_DAYS"] = "90"
+
+	cfg, err := config.Parse(lookup(variables))
+
+	require.NoError(t, err)
+	assert.Equal(t, "9090", cfg.HTTPPort)
+	assert.Equal(t, 45, cfg.CourierInactivityThresholdTicks)
+	assert.InDelta(t, 2.5, cfg.FleetCapacityMaxRatio, 0)
+	assert.Equal(t, time.Second, cfg.AssignmentJobMinInterval)
+	assert.True(t, cfg.PickupSlotsEnabled)
+	assert.Equal(t, 72*time.Hour, cfg.SyntheticDataTTL)
+	assert.Equal(t, 90, cfg.OrderArchiveAfterDays)
+}
+
+func TestParse_InvalidValues(t *testing.T) {
+	variables := database()
+	delete(variables, "DB_HOST")
+	variables["JOB_STALL_FACTOR"] = "three"
+	variables["QUERY_STATEMENT_TIMEOUT"] = "5"
+	variables["SHIFT_END_HANDOVER_ENABLED"] = "maybe"
+	variables["API_MONTHLY_ORDER_QUOTA"] = "-1"
+	variables["ECONOMY_BATCHING_WINDOW"] = "0s"
+	variables["TRACING_SAMPLE_RATIO"] = "1.5"
+	variables["DISPATCH_BATCH_SIZE"] = "0"
+	variables["SLO_GOAL"] = "100"
+	variables["SHUTDOWN_TIMEOUT"] = "-5s"
+	variables["AVAILABILITY_WEBHOOK_TIMEOUT"] = "0s"
+	variables["TENANTS"] = "acme,Globex"
+
+	_, err := config.Parse(lookup(variables))
+
+	require.ErrorIs(t, err, errs.ErrValidationFailed)
+	require.ErrorIs(t, err, errs.ErrValueIsRequired)
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	var validation *errs.ValidationErrors
+	require.ErrorAs(t, err, &validation)
+	fields := make([]string, 0, len(validation.Fields))
+	for _, field := range validation.Fields {
+		fields = append(fields, field.Field)
+	}
+	assert.ElementsMatch(t, []string{
+		"DB_HOST",
+		"JOB_STALL_FACTOR",
+		"QUERY_STATEMENT_TIMEOUT",
+		"SHIFT_END_HANDOVER_ENABLED",
+		"API_MONTHLY_ORDER_QUOTA",
+		"ECONOMY_BATCHING_WINDOW",
+		"TRACING_SAMPLE_RATIO",
+		"DISPATCH_BATCH_SIZE",
+		"SLO_GOAL",
+		"SHUTDOWN_TIMEOUT",
+		"AVAILABILITY_WEBHOOK_TIMEOUT",
+		"TENANTS",
+	}, fields)
+}
+
+func TestParse_TenantTokens(t *testing.T) {
+	variables := database()
+	variables["TENANTS"] = "acme"
+	variables["TENANT_TOKENS"] = "acme:acme-token-0123456789,default:default-token-0123456789"
+
+	cfg, err := config.Parse(lookup(variables))
+	require.NoError(t, err)
+	assert.Equal(t, "acme", cfg.Tenants)
+
+	variables["TENANT_TOKENS"] = "globex:globex-token-0123456789"
+	_, err = config.Parse(lookup(variables))
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	assert.Contains(t, err.Error(), "TENANT_TOKENS")
+	assert.NotContains(t, err.Error(), "globex-token", "the token must not leak into the error")
+}
+
+func TestParse_OrderCreatedTimeouts(t *testing.T) {
+	variables := database()
+	variables["TENANTS"] = "acme"
+	variables["ORDER_CREATED_TIMEOUT"] = "24h"
+	variables["ORDER_CREATED_TIMEOUTS"] = "acme:12h,default:48h"
+
+	cfg, err := config.Parse(lookup(variables))
+	require.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, cfg.OrderCreatedTimeout)
+	assert.Equal(t, "acme:12h,default:48h", cfg.OrderCreatedTimeouts)
+
+	variables["ORDER_CREATED_TIMEOUTS"] = "globex:12h"
+	_, err = config.Parse(lookup(variables))
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	assert.Contains(t, err.Error(), "ORDER_CREATED_TIMEOUTS")
+
+	variables["ORDER_CREATED_TIMEOUTS"] = "acme:soon"
+	_, err = config.Parse(lookup(variables))
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	assert.Contains(t, err.Error(), "ORDER_CREATED_TIMEOUTS")
+}
+
+func TestParse_UrgentOrderEscalationTiers(t *testing.T) {
+	variables := database()
+	variables["URGENT_ORDER_ESCALATION_TIERS"] = "10m,30m:matching-rules"
+
+	cfg, err := config.Parse(lookup(variables))
+	require.NoError(t, err)
+	assert.Equal(t, "10m,30m:matching-rules", cfg.UrgentOrderEscalationTiers)
+
+	variables["URGENT_ORDER_ESCALATION_TIERS"] = "30m,10m"
+	_, err = config.Parse(lookup(variables))
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	assert.Contains(t, err.Error(), "URGENT_ORDER_ESCALATION_TIERS")
+
+	variables["URGENT_ORDER_ESCALATION_TIERS"] = "10m:radius"
+	_, err = config.Parse(lookup(variables))
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	assert.Contains(t, err.Error(), "URGENT_ORDER_ESCALATION_TIERS")
+}
+
+func TestParse_CourierBadgeRules(t *testing.T) {
+	variables := database()
+
+	cfg, err := config.Parse(lookup(variables))
+	require.NoError(t, err)
+	assert.Equal(t, "hundred-deliveries=deliveries:100,five-star-week=five-star-week:5", cfg.CourierBadgeRules)
+
+	variables["COURIER_BADGE_RULES"] = "first-delivery=deliveries:1"
+	cfg, err = config.Parse(lookup(variables))
+	require.NoError(t, err)
+	assert.Equal(t, "first-delivery=deliveries:1", cfg.CourierBadgeRules)
+
+	variables["COURIER_BADGE_RULES"] = "first-delivery=distance:1"
+	_, err = config.Parse(lookup(variables))
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	assert.Contains(t, err.Error(), "COURIER_BADGE_RULES")
+}
+
+func TestLoad_WithoutEnvFile(t *testing.T) {
+	t.Chdir(t.TempDir())
+	t.Setenv("DB_HOST", "postgres")
+	t.Setenv("DB_USER", "delivery")
+	t.Setenv("DB_NAME", "delivery")
+	t.Setenv("COMMAND_STATEMENT_TIMEOUT", "3s")
+
+	cfg, err := config.Load()
+
+	require.NoError(t, err)
+	assert.Equal(t, "postgres", cfg.DBHost)
+	assert.Equal(t, 3*time.Second, cfg.CommandStatementTimeout)
+}
+
+func writeProfile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".env"), []byte(content), 0o600))
+}
+
+func TestParse_Profile(t *testing.T) {
+	dir := t.TempDir()
+	writeProfile(t, dir, "kazan", "GRID_WIDTH=\"15\"\nGRID_HEIGHT=\"15\"\nECONOMY_BATCHING_WINDOW=\"45m\"\n")
+	variables := database()
+	variables["PROFILE"] = "kazan"
+	variables["PROFILES_DIR"] = dir
+	variables["GRID_HEIGHT"] = "12"
+
+	cfg, err := config.Parse(lookup(variables))
+
+	require.NoError(t, err)
+	assert.Equal(t, "kazan", cfg.Profile)
+	assert.Equal(t, 15, cfg.GridWidth)
+	assert.Equal(t, 12, cfg.GridHeight, "the environment overrides the profile")
+	assert.Equal(t, 45*time.Minute, cfg.EconomyBatchingWindow)
+	assert.Equal(t, config.Default().SurgeBacklog, cfg.SurgeBacklog, "the profile keeps other defaults")
+}
+
+func TestParse_InvalidProfile(t *testing.T) {
+	dir := t.TempDir()
+	writeProfile(t, dir, "typo", "GRID_WIDHT=\"15\"\nPROFILE=\"other\"\n")
+	writeProfile(t, dir, "invalid", "GRID_WIDTH=\"-1\"\n")
+
+	for name, profile := range map[string]string{
+		"missing":     "atlantis",
+		"path":        "../kazan",
+		"unsupported": "typo",
+		"invalid":     "invalid",
+	} {
+		t.Run(name, func(t *testing.T) {
+			variables := database()
+			variables["PROFILE"] = profile
+			variables["PROFILES_DIR"] = dir
+
+			_, err := config.Parse(lookup(variables))
+
+			require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+		})
+	}
+}
+
+func TestParse_ShippedProfiles(t *testing.T) {
+	profiles, err := filepath.Glob("../../" + config.DefaultProfilesDir + "/*.env")
+	require.NoError(t, err)
+	require.NotEmpty(t, profiles)
+
+	for _, path := range profiles {
+		name := strings.TrimSuffix(filepath.Base(path), ".env")
+		t.Run(name, func(t *testing.T) {
+			variables := database()
+			variables["PROFILE"] = name
+			variables["PROFILES_DIR"] = filepath.Dir(path)
+
+			cfg, err := config.Parse(lookup(variables))
+
+			require.NoError(t, err)
+			assert.Equal(t, name, cfg.Profile)
+		})
+	}
+}
+
+func TestLoad_ProfileOverridesEnvFile(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+	writeProfile(t, dir, "moscow", "GRID_WIDTH=\"40\"\nGRID_HEIGHT=\"40\"\n")
+	envFile := "PROFILE=\"moscow\"\nDB_HOST=\"localhost\"\nDB_USER=\"delivery\"\nDB_NAME=\"delivery\"\n" +
+		"GRID_WIDTH=\"10\"\nGRID_HEIGHT=\"10\"\nSURGE_BACKLOG=\"300\"\n"
+	require.NoError(t, os.WriteFile(".env", []byte(envFile), 0o600))
+	t.Setenv("PROFILES_DIR", dir)
+	t.Setenv("GRID_HEIGHT", "30")
+
+	cfg, err := config.Load()
+
+	require.NoError(t, err)
+	assert.Equal(t, "moscow", cfg.Profile)
+	assert.Equal(t, 40, cfg.GridWidth, "the profile overrides the .env file")
+	assert.Equal(t, 30, cfg.GridHeight, "the environment overrides the profile")
+	assert.Equal(t, 300, cfg.SurgeBacklog, "the .env file overrides the defaults")
+}
@@ -0,0 +1,320 @@
+// Command devstack runs the whole service locally with one command: it starts PostgreSQL and Kafka
+// in containers, launches the app against them and seeds demo couriers and orders through the API.
+// The containers are removed when devstack stops, so every run starts from an empty database.
+//
+// The app migrates the database on startup, as in every other environment. It is launched with
+// "go run ./cmd/app" unless -app names another command, such as a hot-reload tool, which then
+// restarts the app on changes while the containers keep running. With -app "" nothing is launched:
+// devstack waits for an app started by the developer, for example from an IDE with -env-file.
+//
+// Usage:
+//
+//	go run ./cmd/devstack
+//	go run ./cmd/devstack -app "air" -env-file .env.devstack
+//	go run ./cmd/devstack -app "" -env-file .env.devstack -seed=false
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"maps"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	// postgresImage is the PostgreSQL image of the stack, the one the integration tests run.
+	postgresImage = "postgres:15-alpine"
+
+	// kafkaImage is a single-node Kafka in KRaft mode, which needs no ZooKeeper.
+	kafkaImage = "apache/kafka:3.8.0"
+
+	dbUser     = "delivery"
+	dbPassword = "delivery"
+	dbName     = "delivery"
+
+	// containerStartupTimeout bounds the start of each container, including the image pull.
+	containerStartupTimeout = 2 * time.Minute
+
+	// appStartupTimeout bounds how long the app may take to answer on /health; the first
+	// "go run" compiles the whole module.
+	appStartupTimeout = 3 * time.Minute
+
+	// appStopTimeout is how long the app may take to shut down before it is killed.
+	appStopTimeout = 15 * time.Second
+)
+
+func main() {
+	appCommand := flag.String("app", "go run ./cmd/app", "command launching the app; empty to start it yourself")
+	httpPort := flag.Int("http-port", 8082, "HTTP port of the app")
+	grpcPort := flag.Int("grpc-port", 8083, "gRPC port of the app")
+	kafkaPort := flag.Int("kafka-port", 19092, "host port Kafka is published and advertised on")
+	seed := flag.Bool("seed", true, "create demo couriers and orders once the app is up")
+	envFile := flag.String("env-file", "", "file the environment of the app is written to, in .env format")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, stackOptions{
+		appCommand: *appCommand,
+		httpPort:   *httpPort,
+		grpcPort:   *grpcPort,
+		kafkaPort:  *kafkaPort,
+		seed:       *seed,
+		envFile:    *envFile,
+	}); err != nil {
+		log.Fatalf("devstack: %v", err)
+	}
+}
+
+type stackOptions struct {
+	appCommand string
+	httpPort   int
+	grpcPort   int
+	kafkaPort  int
+	seed       bool
+	envFile    string
+}
+
+// run starts the stack and blocks until ctx is canceled or the app exits.
+func run(ctx context.Context, options stackOptions) (err error) {
+	log.Printf("Starting PostgreSQL (%s)", postgresImage)
+	db, err := startPostgres(ctx)
+	if err != nil {
+		return fmt.Errorf("start postgres: %w", err)
+	}
+	defer func() { err = errors.Join(err, terminate(db)) }()
+
+	log.Printf("Starting Kafka (%s)", kafkaImage)
+	broker, err := startKafka(ctx, options.kafkaPort)
+	if err != nil {
+		return fmt.Errorf("start kafka: %w", err)
+	}
+	defer func() { err = errors.Join(err, terminate(broker)) }()
+
+	env, err := appEnvironment(ctx, db, options)
+	if err != nil {
+		return err
+	}
+	if options.envFile != "" {
+		if err = writeEnvFile(options.envFile, env); err != nil {
+			return fmt.Errorf("write %s: %w", options.envFile, err)
+		}
+		log.Printf("App environment written to %s", options.envFile)
+	}
+
+	var exited <-chan error
+	if options.appCommand != "" {
+		log.Printf("Launching the app: %s", options.appCommand)
+		app, launchErr := launchApp(options.appCommand, env)
+		if launchErr != nil {
+			return fmt.Errorf("launch app: %w", launchErr)
+		}
+		defer stopApp(app)
+		exited = waitApp(app)
+	} else {
+		log.Printf("Start the app with the environment of %s, devstack waits for it", envFileOrStdout(options.envFile))
+		if options.envFile == "" {
+			printEnv(env)
+		}
+	}
+
+	baseURL := fmt.Sprintf("http://localhost:%d", options.httpPort)
+	if err = waitHealthy(ctx, baseURL, exited); err != nil {
+		return err
+	}
+	log.Printf("App is up on %s (Swagger UI: %s/swagger/index.html)", baseURL, baseURL)
+
+	if options.seed {
+		if err = seedDemoData(ctx, baseURL); err != nil {
+			return fmt.Errorf("seed demo data: %w", err)
+		}
+	}
+
+	log.Printf("Stack is running, press Ctrl+C to stop it and remove the containers")
+	select {
+	case <-ctx.Done():
+		return nil
+	case exitErr := <-exited:
+		return fmt.Errorf("app exited: %w", exitErr)
+	}
+}
+
+func startPostgres(ctx context.Context) (*postgres.PostgresContainer, error) {
+	return postgres.Run(ctx,
+		postgresImage,
+		postgres.WithDatabase(dbName),
+		postgres.WithUsername(dbUser),
+		postgres.WithPassword(dbPassword),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(containerStartupTimeout),
+		),
+	)
+}
+
+// startKafka starts a single-node broker. Kafka hands its advertised address to the clients, so the
+// broker is published on a fixed host port it can advertise instead of a random one.
+func startKafka(ctx context.Context, hostPort int) (testcontainers.Container, error) {
+	return testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        kafkaImage,
+			ExposedPorts: []string{fmt.Sprintf("%d:9092/tcp", hostPort)},
+			Env: map[string]string{
+				"KAFKA_NODE_ID":                                  "1",
+				"KAFKA_PROCESS_ROLES":                            "broker,controller",
+				"KAFKA_LISTENERS":                                "PLAINTEXT://:9092,CONTROLLER://:9093",
+				"KAFKA_ADVERTISED_LISTENERS":                     fmt.Sprintf("PLAINTEXT://localhost:%d", hostPort),
+				"KAFKA_CONTROLLER_LISTENER_NAMES":                "CONTROLLER",
+				"KAFKA_LISTENER_SECURITY_PROTOCOL_MAP":           "CONTROLLER:PLAINTEXT,PLAINTEXT:PLAINTEXT",
+				"KAFKA_CONTROLLER_QUORUM_VOTERS":                 "1@localhost:9093",
+				"KAFKA_OFFSETS_TOPIC_REPLICATION_FACTOR":         "1",
+				"KAFKA_TRANSACTION_STATE_LOG_REPLICATION_FACTOR": "1",
+				"KAFKA_TRANSACTION_STATE_LOG_MIN_ISR":            "1",
+				"KAFKA_AUTO_CREATE_TOPICS_ENABLE":                "true",
+			},
+			WaitingFor: wait.ForLog("Kafka Server started").WithStartupTimeout(containerStartupTimeout),
+		},
+		Started: true,
+	})
+}
+
+// terminate removes the container; it runs on shutdown, after ctx is canceled.
+func terminate(container testcontainers.Container) error {
+	return container.Terminate(context.Background())
+}
+
+// appEnvironment configures the app for the stack. Settings favor restarting the app often:
+// the dispatcher state survives restarts and tracking views are never served stale.
+func appEnvironment(ctx context.Context, db *postgres.PostgresContainer, options stackOptions) (map[string]string, error) {
+	host, err := db.Host(ctx)
+	if err != nil {
+		return nil, err
+	}
+	port, err := db.MappedPort(ctx, "5432/tcp")
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]string{
+		"HTTP_PORT":             fmt.Sprint(options.httpPort),
+		"GRPC_PORT":             fmt.Sprint(options.grpcPort),
+		"DB_HOST":               host,
+		"DB_PORT":               port.Port(),
+		"DB_USER":               dbUser,
+		"DB_PASSWORD":           dbPassword,
+		"DB_NAME":               dbName,
+		"DB_SSLMODE":            "disable",
+		"KAFKA_HOST":            fmt.Sprintf("localhost:%d", options.kafkaPort),
+		"DISPATCHER_STATE_FILE": filepath.Join(os.TempDir(), "delivery-devstack-dispatcher.json"),
+		"TRACKING_CACHE_TTL":    "0",
+	}, nil
+}
+
+// writeEnvFile writes the environment in the format config.Load reads .env files in.
+func writeEnvFile(path string, env map[string]string) error {
+	var content strings.Builder
+	for _, name := range slices.Sorted(maps.Keys(env)) {
+		fmt.Fprintf(&content, "%s=%q\n", name, env[name])
+	}
+	return os.WriteFile(path, []byte(content.String()), 0o600)
+}
+
+func printEnv(env map[string]string) {
+	for _, name := range slices.Sorted(maps.Keys(env)) {
+		fmt.Printf("export %s=%q\n", name, env[name])
+	}
+}
+
+func envFileOrStdout(envFile string) string {
+	if envFile == "" {
+		return "the exports below"
+	}
+	return envFile
+}
+
+// launchApp starts the app command with the stack environment. Environment variables take
+// precedence over the .env file, so the stack settings override those of the working directory.
+func launchApp(command string, env map[string]string) (*exec.Cmd, error) {
+	fields := strings.Fields(command)
+	app := exec.Command(fields[0], fields[1:]...)
+	app.Stdout = os.Stdout
+	app.Stderr = os.Stderr
+	app.Env = os.Environ()
+	for name, value := range env {
+		app.Env = append(app.Env, name+"="+value)
+	}
+	// The app gets its own process group, so stopping it also stops what "go run" started
+	app.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	return app, app.Start()
+}
+
+func waitApp(app *exec.Cmd) <-chan error {
+	exited := make(chan error, 1)
+	go func() { exited <- app.Wait() }()
+	return exited
+}
+
+// stopApp interrupts the process group of the app, so it shuts down gracefully, and kills it
+// if it does not exit within appStopTimeout.
+func stopApp(app *exec.Cmd) {
+	group := -app.Process.Pid
+	if err := syscall.Kill(group, syscall.SIGINT); err != nil {
+		return
+	}
+
+	deadline := time.Now().Add(appStopTimeout)
+	for time.Now().Before(deadline) {
+		if err := syscall.Kill(group, 0); err != nil {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	log.Printf("App did not stop within %s, killing it", appStopTimeout)
+	_ = syscall.Kill(group, syscall.SIGKILL)
+}
+
+// waitHealthy polls /health until the app answers, the app exits or appStartupTimeout elapses.
+func waitHealthy(ctx context.Context, baseURL string, exited <-chan error) error {
+	ctx, cancel := context.WithTimeout(ctx, appStartupTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/health", nil)
+		if err != nil {
+			return err
+		}
+		if response, getErr := http.DefaultClient.Do(request); getErr == nil {
+			_ = response.Body.Close()
+			if response.StatusCode == http.StatusOK {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("app is not healthy on %s: %w", baseURL, ctx.Err())
+		case exitErr := <-exited:
+			return fmt.Errorf("app exited before it was healthy: %w", exitErr)
+		case <-ticker.C:
+		}
+	}
+}
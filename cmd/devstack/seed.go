@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"delivery/internal/generated/servers"
+)
+
+// demoCouriers are created on shift, so the assignment job dispatches the demo orders to them.
+// Their external IDs make the seed safe to repeat against an app started by the developer.
+var demoCouriers = []servers.NewCourier{
+	{Name: "Иван", Speed: 1, ExternalId: ptr("devstack-1")},
+	{Name: "Мария", Speed: 2, ExternalId: ptr("devstack-2")},
+	{Name: "Олег", Speed: 2, ExternalId: ptr("devstack-3")},
+	{Name: "Анна", Speed: 3, ExternalId: ptr("devstack-4")},
+}
+
+var demoStreets = []string{"Тверская", "Арбат", "Мясницкая", "Покровка", "Никольская", "Ильинка"}
+
+// demoOrderCount is the number of demo orders, more than the couriers can carry at once,
+// so some of them wait in the dispatch queue.
+const demoOrderCount = 12
+
+// seedDemoData creates the demo couriers and orders through the public API, so they pass
+// the same validation and raise the same events as the data of a real client.
+func seedDemoData(ctx context.Context, baseURL string) error {
+	client := seedClient{baseURL: baseURL}
+
+	for _, newCourier := range demoCouriers {
+		var created servers.Courier
+		if err := client.send(ctx, http.MethodPost, "/api/v1/couriers", newCourier, &created); err != nil {
+			return fmt.Errorf("create courier %s: %w", newCourier.Name, err)
+		}
+		shift := servers.CourierShift{OnShift: true}
+		if err := client.send(ctx, http.MethodPut, "/api/v1/couriers/"+created.Id.String()+"/shift", shift, nil); err != nil {
+			return fmt.Errorf("start shift of courier %s: %w", newCourier.Name, err)
+		}
+	}
+
+	for i := range demoOrderCount {
+		newOrder := servers.NewOrder{
+			Street: demoStreets[i%len(demoStreets)],
+			Items: []servers.OrderItem{
+				{Sku: fmt.Sprintf("DEMO-%d", i%4+1), Quantity: i%3 + 1, UnitVolume: 2},
+			},
+		}
+		if err := client.send(ctx, http.MethodPost, "/api/v1/orders", newOrder, nil); err != nil {
+			return fmt.Errorf("create order %d: %w", i+1, err)
+		}
+	}
+
+	log.Printf("Seeded %d couriers on shift and %d orders", len(demoCouriers), demoOrderCount)
+	return nil
+}
+
+type seedClient struct {
+	baseURL string
+}
+
+// send sends body as JSON and decodes the response into result unless result is nil.
+func (c seedClient) send(ctx context.Context, method, path string, body, result any) error {
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bytes.NewReader(encoded))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	if response.StatusCode >= http.StatusBadRequest {
+		detail, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return fmt.Errorf("%s %s: %s: %s", method, path, response.Status, bytes.TrimSpace(detail))
+	}
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(response.Body).Decode(result)
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// ShutdownFunc stops a component of the service. It should give up once ctx is done,
+// releasing what it still holds.
+type ShutdownFunc func(ctx context.Context) error
+
+// shutdownHook is a component stopped by the lifecycle.
+type shutdownHook struct {
+	name string
+	stop ShutdownFunc
+}
+
+// Lifecycle runs the service until it is asked to stop, then shuts its components down in the
+// reverse order of their registration, like deferred calls: a component registered when it is
+// started is stopped before the components it was started on. All components share one timeout,
+// so an orchestrator killing the process after a grace period finds it already stopped.
+//
+// Example:
+//
+//	lifecycle := NewLifecycle(configs.ShutdownTimeout, logger)
+//	lifecycle.OnShutdown("database", closeDatabase)
+//	lifecycle.OnShutdown("HTTP server", e.Shutdown)
+//
+//	// Blocks until SIGINT or SIGTERM, then stops the HTTP server and closes the database
+//	if err := lifecycle.Run(context.Background()); err != nil {
+//	    log.Printf("shutdown: %v", err)
+//	}
+type Lifecycle struct {
+	timeout time.Duration
+	hooks   []shutdownHook
+	logger  *slog.Logger
+}
+
+// NewLifecycle creates a lifecycle giving its components timeout to stop.
+func NewLifecycle(timeout time.Duration, logger *slog.Logger) *Lifecycle {
+	return &Lifecycle{
+		timeout: timeout,
+		logger:  logger.With("component", "lifecycle"),
+	}
+}
+
+// OnShutdown registers a component to stop on shutdown under a name used in logs and errors.
+func (l *Lifecycle) OnShutdown(name string, stop ShutdownFunc) {
+	l.hooks = append(l.hooks, shutdownHook{name: name, stop: stop})
+}
+
+// Run blocks until ctx is done or the process receives SIGINT or SIGTERM, then shuts the service down.
+// Once shutting down, a second signal terminates the process right away.
+// Returns the errors of the components that failed to stop, see Shutdown.
+func (l *Lifecycle) Run(ctx context.Context) error {
+	signalCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	<-signalCtx.Done()
+	stop()
+
+	return l.Shutdown(context.WithoutCancel(ctx))
+}
+
+// Shutdown stops every registered component, the last registered first, within the timeout.
+// A component failing to stop does not keep the others running: all of them are stopped,
+// and the errors are returned joined, each prefixed with the name of its component.
+func (l *Lifecycle) Shutdown(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, l.timeout)
+	defer cancel()
+
+	l.logger.InfoContext(ctx, "Shutting down", "timeout", l.timeout.String())
+
+	var failures []error
+	for i := len(l.hooks) - 1; i >= 0; i-- {
+		hook := l.hooks[i]
+
+		started := time.Now()
+		if err := hook.stop(ctx); err != nil {
+			l.logger.ErrorContext(ctx, "Component failed to stop", "component_name", hook.name, "error", err)
+			failures = append(failures, fmt.Errorf("%s: %w", hook.name, err))
+			continue
+		}
+		l.logger.InfoContext(ctx, "Component stopped",
+			"component_name", hook.name,
+			"duration", time.Since(started).String())
+	}
+
+	return errors.Join(failures...)
+}
@@ -0,0 +1,80 @@
+package cmd_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"delivery/cmd"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLifecycle_Shutdown(t *testing.T) {
+	t.Run("should stop the components in the reverse order of registration", func(t *testing.T) {
+		lifecycle := cmd.NewLifecycle(time.Second, slog.Default())
+		var stopped []string
+		for _, name := range []string{"database", "jobs", "HTTP server"} {
+			lifecycle.OnShutdown(name, func(context.Context) error {
+				stopped = append(stopped, name)
+				return nil
+			})
+		}
+
+		err := lifecycle.Shutdown(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"HTTP server", "jobs", "database"}, stopped)
+	})
+
+	t.Run("should stop the other components when one fails", func(t *testing.T) {
+		lifecycle := cmd.NewLifecycle(time.Second, slog.Default())
+		boom := errors.New("boom")
+		databaseClosed := false
+		lifecycle.OnShutdown("database", func(context.Context) error {
+			databaseClosed = true
+			return nil
+		})
+		lifecycle.OnShutdown("kafka producer", func(context.Context) error {
+			return boom
+		})
+
+		err := lifecycle.Shutdown(context.Background())
+
+		require.ErrorIs(t, err, boom)
+		assert.Contains(t, err.Error(), "kafka producer")
+		assert.True(t, databaseClosed)
+	})
+
+	t.Run("should give the components the shutdown timeout", func(t *testing.T) {
+		lifecycle := cmd.NewLifecycle(10*time.Millisecond, slog.Default())
+		lifecycle.OnShutdown("HTTP server", func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+
+		err := lifecycle.Shutdown(context.Background())
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestLifecycle_Run(t *testing.T) {
+	lifecycle := cmd.NewLifecycle(time.Second, slog.Default())
+	stopped := false
+	lifecycle.OnShutdown("HTTP server", func(ctx context.Context) error {
+		stopped = true
+		return ctx.Err()
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// The components are given the timeout even though the context of the run is done
+	err := lifecycle.Run(ctx)
+
+	require.NoError(t, err)
+	assert.True(t, stopped)
+}
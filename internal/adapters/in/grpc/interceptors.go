@@ -0,0 +1,99 @@
+package grpc
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"delivery/internal/pkg/synthetic"
+	"delivery/internal/pkg/tenant"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// tenantMetadata names the tenant a call acts for, like the X-Tenant-ID header of the HTTP API.
+	tenantMetadata = "x-tenant-id"
+
+	// authorizationMetadata carries the tenant's token as "Bearer <token>", like the
+	// Authorization header of the HTTP API.
+	authorizationMetadata = "authorization"
+
+	// syntheticDataMetadata flags calls whose data is synthetic test data, like the
+	// X-Synthetic-Data header of the HTTP API.
+	syntheticDataMetadata = "x-synthetic-data"
+)
+
+// TenantInterceptor binds every call to the tenant that authenticated it with its token in the
+// "authorization: Bearer <token>" metadata, like TenantMiddleware of the HTTP API. The x-tenant-id
+// metadata is optional and must name the authenticated tenant; calls naming a tenant without a
+// token fail with Unauthenticated and calls naming another tenant with PermissionDenied.
+// Calls with neither act for the default tenant.
+func TenantInterceptor(credentials tenant.Credentials) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		named := firstMetadata(ctx, tenantMetadata)
+		if named != "" {
+			if err := tenant.ID(named).Validate(); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "%s: %v", tenantMetadata, err)
+			}
+		}
+
+		authorization := firstMetadata(ctx, authorizationMetadata)
+		if authorization == "" {
+			if named == "" {
+				return handler(ctx, request)
+			}
+			return nil, status.Errorf(codes.Unauthenticated, "tenant %s requires its token in the %s metadata",
+				named, authorizationMetadata)
+		}
+
+		token, found := strings.CutPrefix(authorization, "Bearer ")
+		id, ok := credentials.Authenticate(token)
+		if !found || !ok {
+			return nil, status.Error(codes.Unauthenticated, "tenant token is invalid")
+		}
+		if named != "" && tenant.ID(named) != id {
+			return nil, status.Errorf(codes.PermissionDenied, "the token does not authenticate tenant %s", named)
+		}
+		return handler(tenant.WithID(ctx, id), request)
+	}
+}
+
+// SyntheticDataInterceptor marks calls sent with "x-synthetic-data: true", so couriers they
+// create are recorded as test data and purged by the janitor once expired.
+func SyntheticDataInterceptor(
+	ctx context.Context,
+	request any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	value := firstMetadata(ctx, syntheticDataMetadata)
+	if value == "" {
+		return handler(ctx, request)
+	}
+
+	marked, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %q is not a boolean", syntheticDataMetadata, value)
+	}
+	if !marked {
+		return handler(ctx, request)
+	}
+	return handler(synthetic.WithMarker(ctx), request)
+}
+
+// firstMetadata returns the first value of the incoming metadata key, empty if it is missing.
+func firstMetadata(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,112 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"delivery/internal/pkg/synthetic"
+	"delivery/internal/pkg/tenant"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// capture returns a handler that records the context it was called with.
+func capture(called *context.Context) func(context.Context, any) (any, error) {
+	return func(ctx context.Context, request any) (any, error) {
+		*called = ctx
+		return request, nil
+	}
+}
+
+func TestTenantInterceptor(t *testing.T) {
+	credentials, err := tenant.ParseCredentials("acme:acme-token-0123456789,globex:globex-token-0123456789")
+	require.NoError(t, err)
+	interceptor := TenantInterceptor(credentials)
+
+	call := func(pairs ...string) (context.Context, error) {
+		var called context.Context
+		ctx := context.Background()
+		if len(pairs) > 0 {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(pairs...))
+		}
+		_, err := interceptor(ctx, nil, nil, capture(&called))
+		return called, err
+	}
+
+	t.Run("binds the call to the tenant of the token", func(t *testing.T) {
+		called, err := call(tenantMetadata, "acme", authorizationMetadata, "Bearer acme-token-0123456789")
+
+		require.NoError(t, err)
+		id, ok := tenant.FromContext(called)
+		require.True(t, ok)
+		assert.Equal(t, "acme", id.String())
+	})
+
+	t.Run("acts for the default tenant without metadata", func(t *testing.T) {
+		called, err := call()
+
+		require.NoError(t, err)
+		_, ok := tenant.FromContext(called)
+		assert.False(t, ok)
+	})
+
+	t.Run("refuses a tenant named without its token", func(t *testing.T) {
+		called, err := call(tenantMetadata, "acme")
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Nil(t, called)
+	})
+
+	t.Run("refuses an unknown token", func(t *testing.T) {
+		called, err := call(authorizationMetadata, "Bearer forged-token-0123456789")
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Nil(t, called)
+	})
+
+	t.Run("refuses a tenant the token does not authenticate", func(t *testing.T) {
+		called, err := call(tenantMetadata, "acme", authorizationMetadata, "Bearer globex-token-0123456789")
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Nil(t, called)
+	})
+
+	t.Run("rejects a malformed tenant", func(t *testing.T) {
+		called, err := call(tenantMetadata, "Not A Tenant!")
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, called)
+	})
+}
+
+func TestSyntheticDataInterceptor(t *testing.T) {
+	call := func(t *testing.T, value string) (context.Context, error) {
+		t.Helper()
+		var called context.Context
+		ctx := context.Background()
+		if value != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(syntheticDataMetadata, value))
+		}
+		_, err := SyntheticDataInterceptor(ctx, nil, nil, capture(&called))
+		return called, err
+	}
+
+	called, err := call(t, "true")
+	require.NoError(t, err)
+	assert.True(t, synthetic.IsMarked(called))
+
+	called, err = call(t, "false")
+	require.NoError(t, err)
+	assert.False(t, synthetic.IsMarked(called))
+
+	called, err = call(t, "")
+	require.NoError(t, err)
+	assert.False(t, synthetic.IsMarked(called))
+
+	_, err = call(t, "maybe")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
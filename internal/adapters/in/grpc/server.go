@@ -0,0 +1,266 @@
+// Package grpc exposes courier management and order queries over gRPC for internal services.
+// It is a second driving adapter next to the HTTP API: requests are translated into the same
+// commands and queries, and their errors into gRPC status codes.
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/generated/servers/deliverypb"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/i18n"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server implements deliverypb.DeliveryServiceServer on top of the application handlers.
+type Server struct {
+	deliverypb.UnimplementedDeliveryServiceServer
+
+	createCourierHandler               commands.CreateCourierCommandHandler
+	getCourierHandler                  queries.GetCourierQueryHandler
+	getFreeCouriersHandler             queries.GetFreeCouriersQueryHandler
+	getUncompletedOrdersHandler        queries.GetUncompletedOrdersQueryHandler
+	getOrderByExternalReferenceHandler queries.GetOrderByExternalReferenceQueryHandler
+}
+
+// NewServer creates a gRPC server with the given handlers.
+func NewServer(
+	createCourierHandler commands.CreateCourierCommandHandler,
+	getCourierHandler queries.GetCourierQueryHandler,
+	getFreeCouriersHandler queries.GetFreeCouriersQueryHandler,
+	getUncompletedOrdersHandler queries.GetUncompletedOrdersQueryHandler,
+	getOrderByExternalReferenceHandler queries.GetOrderByExternalReferenceQueryHandler,
+) *Server {
+	return &Server{
+		createCourierHandler:               createCourierHandler,
+		getCourierHandler:                  getCourierHandler,
+		getFreeCouriersHandler:             getFreeCouriersHandler,
+		getUncompletedOrdersHandler:        getUncompletedOrdersHandler,
+		getOrderByExternalReferenceHandler: getOrderByExternalReferenceHandler,
+	}
+}
+
+// CreateCourier registers a courier at a random location of the grid, like the HTTP API.
+func (s *Server) CreateCourier(
+	ctx context.Context,
+	request *deliverypb.CreateCourierRequest,
+) (*deliverypb.CreateCourierResponse, error) {
+	language := i18n.Russian
+	if request.GetLanguage() != "" {
+		language = i18n.Language(request.GetLanguage())
+	}
+
+	location, err := kernel.NewRandomLocation()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var cmd commands.CreateCourierCommand
+	if request.GetExternalId() != "" {
+		cmd, err = commands.NewCreateCourierCommandWithExternalID(
+			request.GetName(), int(request.GetSpeed()), location, language, request.GetExternalId(),
+		)
+	} else {
+		cmd, err = commands.NewCreateCourierCommandWithLanguage(
+			request.GetName(), int(request.GetSpeed()), location, language,
+		)
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	registration, err := s.createCourierHandler.Handle(ctx, cmd)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &deliverypb.CreateCourierResponse{
+		Courier:  toProtoCourier(registration.Courier),
+		Existing: registration.Existing,
+	}, nil
+}
+
+// GetCourier returns a courier with their status and storage places.
+func (s *Server) GetCourier(ctx context.Context, request *deliverypb.GetCourierRequest) (*deliverypb.Courier, error) {
+	courierID, err := kernel.UUIDFromString(request.GetCourierId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "courier_id: %v", err)
+	}
+
+	query, err := queries.NewGetCourierQuery(courierID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	c, err := s.getCourierHandler.Handle(ctx, query)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &deliverypb.Courier{
+		Id:            c.ID.String(),
+		Name:          c.Name,
+		Speed:         int32(c.Speed), //nolint:gosec // validated by the domain
+		Location:      toProtoLocation(c.Location),
+		Status:        c.Status.String(),
+		Insured:       c.Insured,
+		StoragePlaces: make([]*deliverypb.StoragePlace, 0, len(c.StoragePlaces)),
+	}
+	if c.ExternalID != nil {
+		response.ExternalId = *c.ExternalID
+	}
+	for _, place := range c.StoragePlaces {
+		protoPlace := &deliverypb.StoragePlace{
+			Id:           place.ID.String(),
+			Name:         place.Name,
+			TotalVolume:  int32(place.TotalVolume), //nolint:gosec // validated by the domain
+			OutOfService: place.OutOfService,
+		}
+		if place.OrderID != nil {
+			protoPlace.OrderId = place.OrderID.String()
+		}
+		response.StoragePlaces = append(response.StoragePlaces, protoPlace)
+	}
+	return response, nil
+}
+
+// ListFreeCouriers returns the couriers who can take an order right now.
+func (s *Server) ListFreeCouriers(
+	ctx context.Context,
+	_ *deliverypb.ListFreeCouriersRequest,
+) (*deliverypb.ListFreeCouriersResponse, error) {
+	couriers, err := s.getFreeCouriersHandler.Handle(ctx, queries.NewGetFreeCouriersQuery())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &deliverypb.ListFreeCouriersResponse{
+		Couriers: make([]*deliverypb.FreeCourier, len(couriers)),
+	}
+	for i, c := range couriers {
+		response.Couriers[i] = &deliverypb.FreeCourier{
+			Id:       c.ID.String(),
+			Name:     c.Name,
+			Speed:    int32(c.Speed), //nolint:gosec // validated by the domain
+			Location: toProtoLocation(c.Location),
+		}
+	}
+	return response, nil
+}
+
+// ListUncompletedOrders returns the orders that are not delivered yet.
+func (s *Server) ListUncompletedOrders(
+	ctx context.Context,
+	_ *deliverypb.ListUncompletedOrdersRequest,
+) (*deliverypb.ListUncompletedOrdersResponse, error) {
+	orders, err := s.getUncompletedOrdersHandler.Handle(ctx, queries.NewGetUncompletedOrdersQuery())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &deliverypb.ListUncompletedOrdersResponse{
+		Orders: make([]*deliverypb.Order, len(orders)),
+	}
+	for i, o := range orders {
+		response.Orders[i] = &deliverypb.Order{
+			Id:                o.ID.String(),
+			Location:          toProtoLocation(o.Location),
+			Volume:            int32(o.Volume), //nolint:gosec // validated by the domain
+			DeliveryTier:      o.DeliveryTier.String(),
+			InsuranceRequired: o.InsuranceRequired,
+		}
+	}
+	return response, nil
+}
+
+// GetOrderByExternalReference returns the order that fulfils a marketplace order.
+func (s *Server) GetOrderByExternalReference(
+	ctx context.Context,
+	request *deliverypb.GetOrderByExternalReferenceRequest,
+) (*deliverypb.LinkedOrder, error) {
+	query, err := queries.NewGetOrderByExternalReferenceQuery(request.GetMarketplace(), request.GetExternalOrderId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	linked, err := s.getOrderByExternalReferenceHandler.Handle(ctx, query)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &deliverypb.LinkedOrder{
+		Id:              linked.ID.String(),
+		Status:          linked.Status.String(),
+		Marketplace:     linked.Marketplace,
+		ExternalOrderId: linked.ExternalOrderID,
+		DeepLink:        linked.DeepLink,
+	}
+	if linked.CourierID != nil {
+		response.CourierId = linked.CourierID.String()
+	}
+	return response, nil
+}
+
+// toProtoCourier maps a courier aggregate to the gRPC representation.
+func toProtoCourier(c *courier.Courier) *deliverypb.Courier {
+	response := &deliverypb.Courier{
+		Id:            c.ID().String(),
+		Name:          c.Name(),
+		Speed:         int32(c.Speed()), //nolint:gosec // validated by the domain
+		Location:      toProtoLocation(c.Location()),
+		Status:        c.Status().String(),
+		Insured:       c.IsInsured(),
+		StoragePlaces: make([]*deliverypb.StoragePlace, 0, len(c.StoragePlaces())),
+	}
+	if c.ExternalID() != nil {
+		response.ExternalId = c.ExternalID().String()
+	}
+
+	for _, place := range c.StoragePlaces() {
+		protoPlace := &deliverypb.StoragePlace{
+			Id:           place.ID().String(),
+			Name:         place.Name(),
+			TotalVolume:  int32(place.TotalVolume()), //nolint:gosec // validated by the domain
+			OutOfService: place.IsOutOfService(),
+		}
+		if place.OrderID() != nil {
+			protoPlace.OrderId = place.OrderID().String()
+		}
+		response.StoragePlaces = append(response.StoragePlaces, protoPlace)
+	}
+	return response
+}
+
+// toProtoLocation maps a location of the grid to the gRPC representation.
+func toProtoLocation(location kernel.Location) *deliverypb.Location {
+	return &deliverypb.Location{X: int32(location.X()), Y: int32(location.Y())}
+}
+
+// toStatus translates an application error into a gRPC status: invalid input, missing objects,
+// conflicting writes and statement timeouts get their own codes, anything else is internal.
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, errs.ErrValidationFailed),
+		errors.Is(err, errs.ErrValueIsRequired),
+		errors.Is(err, errs.ErrValueIsInvalid),
+		errors.Is(err, errs.ErrValueIsOutOfRange):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, errs.ErrObjectNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, errs.ErrConcurrencyConflict):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, errs.ErrQueryTimeout), errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/generated/servers/deliverypb"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"validation", errs.JoinFields(errs.Field("name", errs.NewValueIsRequiredError("name"))), codes.InvalidArgument},
+		{"out of range", errs.NewValueIsOutOfRangeError("speed", 0, 1, 10), codes.InvalidArgument},
+		{"not found", errs.NewObjectNotFoundError("courier", kernel.NewUUID()), codes.NotFound},
+		{"conflict", errs.NewConcurrencyConflictError("courier", kernel.NewUUID(), 3), codes.Aborted},
+		{"timeout", errs.NewQueryTimeoutError("query"), codes.DeadlineExceeded},
+		{"canceled", context.Canceled, codes.Canceled},
+		{"other", errors.New("connection refused"), codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, status.Code(toStatus(tt.err)))
+		})
+	}
+}
+
+func TestServer_RejectsInvalidRequestsBeforeHandlers(t *testing.T) {
+	// The handlers have no database: an invalid request must not reach them
+	server := &Server{}
+	ctx := context.Background()
+
+	_, err := server.GetCourier(ctx, &deliverypb.GetCourierRequest{CourierId: "not-a-uuid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.GetCourier(ctx, &deliverypb.GetCourierRequest{CourierId: "00000000-0000-0000-0000-000000000000"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.CreateCourier(ctx, &deliverypb.CreateCourierRequest{Name: "", Speed: 2})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "name")
+
+	_, err = server.CreateCourier(ctx, &deliverypb.CreateCourierRequest{Name: "Alice", Speed: 2, Language: "de"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.GetOrderByExternalReference(ctx, &deliverypb.GetOrderByExternalReferenceRequest{Marketplace: "Ozon"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
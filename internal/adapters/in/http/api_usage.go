@@ -0,0 +1,93 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/usage"
+	"delivery/internal/pkg/i18n"
+
+	"github.com/labstack/echo/v4"
+)
+
+// apiKeyHeader names the API key of the partner client sending a request.
+const apiKeyHeader = "X-API-Key"
+
+// ownUsagePath is the route clients check their usage on; it stays reachable once the request
+// quota is used up, so clients can see when it resets.
+const ownUsagePath = "/api/v1/usage"
+
+type apiClientKey struct{}
+
+// APIUsageMiddleware identifies partner clients by the X-API-Key header and accounts every
+// request they send against their monthly request quota. Requests past the quota are refused
+// with 429 Too Many Requests. Requests without the header are neither identified nor accounted.
+func APIUsageMiddleware(meter commands.MeterAPIUsageCommandHandler) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			value := ctx.Request().Header.Get(apiKeyHeader)
+			if value == "" {
+				return next(ctx)
+			}
+
+			client, err := usage.NewClient(value)
+			if err != nil {
+				return respondError(ctx, http.StatusBadRequest, i18n.InvalidAPIKey, err.Error())
+			}
+
+			request := ctx.Request()
+			ctx.SetRequest(request.WithContext(context.WithValue(request.Context(), apiClientKey{}, client)))
+
+			if ctx.Path() != ownUsagePath {
+				cmd, cmdErr := commands.NewMeterAPIUsageCommand(client, usage.Requests, 1)
+				if cmdErr != nil {
+					return respondError(ctx, http.StatusInternalServerError, i18n.FailedToMeterAPIUsage)
+				}
+				if err = meter.Handle(ctx.Request().Context(), cmd); err != nil {
+					return respondMeteringError(ctx, err)
+				}
+			}
+
+			return next(ctx)
+		}
+	}
+}
+
+// apiClientFromContext returns the partner client identified by APIUsageMiddleware.
+func apiClientFromContext(ctx context.Context) (usage.Client, bool) {
+	client, ok := ctx.Value(apiClientKey{}).(usage.Client)
+	return client, ok
+}
+
+// meterAPIUsage accounts amount of the metric to the partner client sending the request.
+// Requests of unidentified clients are not accounted.
+func (s *Server) meterAPIUsage(ctx echo.Context, metric usage.Metric, amount int) error {
+	client, ok := apiClientFromContext(ctx.Request().Context())
+	if !ok {
+		return nil
+	}
+
+	cmd, err := commands.NewMeterAPIUsageCommand(client, metric, amount)
+	if err != nil {
+		return err
+	}
+	return s.meterAPIUsageHandler.Handle(ctx.Request().Context(), cmd)
+}
+
+// respondMeteringError writes 429 Too Many Requests telling which quota is used up and when
+// it resets, with a Retry-After header, or 500 if the usage could not be accounted.
+func respondMeteringError(ctx echo.Context, err error) error {
+	var exceeded *usage.QuotaExceededError
+	if !errors.As(err, &exceeded) {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToMeterAPIUsage)
+	}
+
+	retryAfter := max(int(time.Until(exceeded.ResetsAt).Seconds()), 1)
+	ctx.Response().Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	return respondError(ctx, http.StatusTooManyRequests, i18n.APIQuotaExceeded,
+		exceeded.Metric.String(), exceeded.Limit, exceeded.ResetsAt.Format(time.RFC3339))
+}
@@ -0,0 +1,121 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/usage"
+	"delivery/internal/generated/servers"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryUsageLedger is an in-memory ports.UsageLedger.
+type memoryUsageLedger map[string]int
+
+func (l memoryUsageLedger) key(client usage.Client, period usage.Period, metric usage.Metric) string {
+	return client.Fingerprint() + "/" + period.String() + "/" + metric.String()
+}
+
+func (l memoryUsageLedger) Used(
+	_ context.Context, client usage.Client, period usage.Period, metric usage.Metric,
+) (int, error) {
+	return l[l.key(client, period, metric)], nil
+}
+
+func (l memoryUsageLedger) Add(
+	_ context.Context, client usage.Client, period usage.Period, metric usage.Metric, amount int,
+) error {
+	l[l.key(client, period, metric)] += amount
+	return nil
+}
+
+func TestAPIUsageMiddleware(t *testing.T) {
+	serve := func(ledger memoryUsageLedger, path, apiKey string) (*httptest.ResponseRecorder, *usage.Client) {
+		quota, _ := usage.NewQuota(2, 0, 0)
+		var identified *usage.Client
+		handler := func(ctx echo.Context) error {
+			if client, ok := apiClientFromContext(ctx.Request().Context()); ok {
+				identified = &client
+			}
+			return ctx.NoContent(http.StatusNoContent)
+		}
+
+		e := echo.New()
+		e.Use(APIUsageMiddleware(commands.NewMeterAPIUsageCommandHandler(ledger, quota)))
+		e.GET("/api/v1/orders/active", handler)
+		e.GET(ownUsagePath, handler)
+
+		request := httptest.NewRequest(http.MethodGet, path, nil)
+		if apiKey != "" {
+			request.Header.Set(apiKeyHeader, apiKey)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, request)
+		return rec, identified
+	}
+
+	t.Run("should identify and account clients", func(t *testing.T) {
+		ledger := memoryUsageLedger{}
+
+		rec, identified := serve(ledger, "/api/v1/orders/active", "partner-secret")
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		require.NotNil(t, identified)
+		client, _ := usage.NewClient("partner-secret")
+		assert.Equal(t, client.Fingerprint(), identified.Fingerprint())
+		assert.Equal(t, 1, ledger[ledger.key(client, usage.PeriodOf(time.Now()), usage.Requests)])
+	})
+
+	t.Run("should refuse requests past the quota", func(t *testing.T) {
+		ledger := memoryUsageLedger{}
+		serve(ledger, "/api/v1/orders/active", "partner-secret")
+		serve(ledger, "/api/v1/orders/active", "partner-secret")
+
+		rec, _ := serve(ledger, "/api/v1/orders/active", "partner-secret")
+
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		retryAfter, err := strconv.Atoi(rec.Header().Get("Retry-After"))
+		require.NoError(t, err)
+		assert.Positive(t, retryAfter)
+		var body servers.Error
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Contains(t, body.Message, "Monthly requests quota of 2 is used up")
+	})
+
+	t.Run("should keep own usage reachable past the quota", func(t *testing.T) {
+		ledger := memoryUsageLedger{}
+		serve(ledger, "/api/v1/orders/active", "partner-secret")
+		serve(ledger, "/api/v1/orders/active", "partner-secret")
+
+		rec, identified := serve(ledger, ownUsagePath, "partner-secret")
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.NotNil(t, identified)
+	})
+
+	t.Run("should pass requests without API key", func(t *testing.T) {
+		ledger := memoryUsageLedger{}
+
+		rec, identified := serve(ledger, "/api/v1/orders/active", "")
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Nil(t, identified)
+		assert.Empty(t, ledger)
+	})
+
+	t.Run("should reject malformed API keys", func(t *testing.T) {
+		rec, _ := serve(memoryUsageLedger{}, "/api/v1/orders/active", strings.Repeat("k", 300))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
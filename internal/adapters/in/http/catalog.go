@@ -0,0 +1,17 @@
+package http
+
+import (
+	"net/http"
+
+	"delivery/internal/pkg/catalog"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CatalogHandler serves GET /admin/catalog - the commands, queries and integration events
+// of the service as described by their Go types.
+func CatalogHandler(messages catalog.Catalog) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		return ctx.JSON(http.StatusOK, messages)
+	}
+}
@@ -0,0 +1,114 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"strconv"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/generated/servers"
+	"delivery/internal/pkg/errs"
+)
+
+// maxCourierImportSize limits courier batches to 1 MiB, well above MaxImportedCouriers rows.
+const maxCourierImportSize = 1 << 20
+
+// Columns of a CSV courier batch, matched like the columns of an order upload.
+const (
+	nameColumn       = "name"
+	speedColumn      = "speed"
+	languageColumn   = "language"
+	externalIDColumn = "externalid"
+)
+
+// decodeCourierImport reads the courier rows of a JSON or CSV batch, chosen by the content type.
+// Rows of a JSON array are numbered from 1; rows of a CSV file are numbered by their line in the
+// file, so clients can match the report to their spreadsheet. Blank CSV rows are skipped.
+func decodeCourierImport(contentType string, src io.Reader) ([]commands.CourierImportRow, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, errs.NewValueIsInvalidErrorWithCause("content type", err)
+	}
+
+	if mediaType != "application/json" && mediaType != "text/csv" {
+		return nil, errs.NewValueIsInvalidErrorWithCause(
+			"content type",
+			fmt.Errorf("%q is not application/json or text/csv", mediaType),
+		)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(src, maxCourierImportSize+1))
+	if err != nil {
+		return nil, errs.NewValueIsInvalidErrorWithCause("body", err)
+	}
+	if len(body) > maxCourierImportSize {
+		return nil, errs.NewValueIsInvalidErrorWithCause(
+			"body",
+			fmt.Errorf("body is larger than %d bytes", maxCourierImportSize),
+		)
+	}
+
+	if mediaType == "application/json" {
+		return decodeCourierImportJSON(bytes.NewReader(body))
+	}
+	return decodeCourierImportCSV(bytes.NewReader(body))
+}
+
+func decodeCourierImportJSON(src io.Reader) ([]commands.CourierImportRow, error) {
+	var batch []servers.CourierImportRow
+	if err := json.NewDecoder(src).Decode(&batch); err != nil {
+		return nil, errs.NewValueIsInvalidErrorWithCause("body", err)
+	}
+
+	rows := make([]commands.CourierImportRow, len(batch))
+	for i, row := range batch {
+		rows[i] = commands.CourierImportRow{
+			Line:  i + 1,
+			Name:  row.Name,
+			Speed: strconv.Itoa(row.Speed),
+		}
+		if row.Language != nil {
+			rows[i].Language = string(*row.Language)
+		}
+		if row.ExternalId != nil {
+			rows[i].ExternalID = *row.ExternalId
+		}
+	}
+	return rows, nil
+}
+
+func decodeCourierImportCSV(src io.Reader) ([]commands.CourierImportRow, error) {
+	records, lines, err := readCSV(src)
+	if err != nil {
+		return nil, errs.NewValueIsInvalidErrorWithCause("body", err)
+	}
+
+	if len(records) == 0 {
+		return nil, errs.NewValueIsRequiredError("header row")
+	}
+
+	columns, err := uploadColumns(records[0], nameColumn, speedColumn)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]commands.CourierImportRow, 0, len(records)-1)
+	for i, record := range records[1:] {
+		if isBlankRecord(record) {
+			continue
+		}
+
+		rows = append(rows, commands.CourierImportRow{
+			Line:       lines[i+1],
+			Name:       cell(record, columns, nameColumn),
+			Speed:      cell(record, columns, speedColumn),
+			Language:   cell(record, columns, languageColumn),
+			ExternalID: cell(record, columns, externalIDColumn),
+		})
+	}
+
+	return rows, nil
+}
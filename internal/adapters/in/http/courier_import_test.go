@@ -0,0 +1,69 @@
+package http
+
+import (
+	"strings"
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeCourierImport_JSON(t *testing.T) {
+	body := `[{"name":"John Doe","speed":2,"externalId":"HR-1"},{"name":"Jane Roe","speed":3,"language":"en"}]`
+
+	rows, err := decodeCourierImport("application/json; charset=utf-8", strings.NewReader(body))
+
+	require.NoError(t, err)
+	assert.Equal(t, []commands.CourierImportRow{
+		{Line: 1, Name: "John Doe", Speed: "2", ExternalID: "HR-1"},
+		{Line: 2, Name: "Jane Roe", Speed: "3", Language: "en"},
+	}, rows)
+}
+
+func TestDecodeCourierImport_CSV(t *testing.T) {
+	body := "Name,Speed,Language,external_id\n" +
+		"John Doe,2,,HR-1\n" +
+		"\n" +
+		"\"Roe, Jane\",3,en,\n"
+
+	rows, err := decodeCourierImport("text/csv", strings.NewReader(body))
+
+	require.NoError(t, err)
+	assert.Equal(t, []commands.CourierImportRow{
+		{Line: 2, Name: "John Doe", Speed: "2", ExternalID: "HR-1"},
+		{Line: 4, Name: "Roe, Jane", Speed: "3", Language: "en"},
+	}, rows)
+}
+
+func TestDecodeCourierImport_Errors(t *testing.T) {
+	t.Run("should reject unsupported content types", func(t *testing.T) {
+		_, err := decodeCourierImport("text/plain", strings.NewReader("name,speed\n"))
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should require name and speed columns", func(t *testing.T) {
+		_, err := decodeCourierImport("text/csv", strings.NewReader("courier\nJohn Doe\n"))
+
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+		require.ErrorContains(t, err, "name column")
+		require.ErrorContains(t, err, "speed column")
+	})
+
+	t.Run("should reject malformed JSON", func(t *testing.T) {
+		_, err := decodeCourierImport("application/json", strings.NewReader(`{"name":"John Doe"}`))
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should reject oversized bodies", func(t *testing.T) {
+		body := "name,speed\n" + strings.Repeat("x", maxCourierImportSize)
+
+		_, err := decodeCourierImport("text/csv", strings.NewReader(body))
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+}
@@ -0,0 +1,41 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"delivery/internal/pkg/diagnostics"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ErrorRecorderMiddleware records requests that fail with a server error in the ring of recent
+// errors shown on the diagnostics endpoint. The source is the route pattern rather than the
+// requested path, so identifiers and tracking tokens never end up in the ring.
+func ErrorRecorderMiddleware(ring *diagnostics.ErrorRing) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			err := next(ctx)
+
+			status := responseStatus(ctx, err)
+			if status < http.StatusInternalServerError {
+				return err
+			}
+
+			message := http.StatusText(status)
+			if err != nil {
+				message = err.Error()
+			}
+			ring.Record(time.Now(), ctx.Request().Method+" "+ctx.Path(), message)
+			return err
+		}
+	}
+}
+
+// DiagnosticsHandler serves GET /admin/diagnostics - recent errors, build information,
+// configuration fingerprint and dependency statuses for incident triage.
+func DiagnosticsHandler(reporter *diagnostics.Reporter) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		return ctx.JSON(http.StatusOK, reporter.Report(ctx.Request().Context()))
+	}
+}
@@ -0,0 +1,55 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"delivery/internal/pkg/diagnostics"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorRecorderMiddleware(t *testing.T) {
+	serve := func(t *testing.T, handler echo.HandlerFunc) []diagnostics.ErrorEntry {
+		t.Helper()
+		ring := diagnostics.NewErrorRing(10)
+		e := echo.New()
+		e.Use(ErrorRecorderMiddleware(ring))
+		e.GET("/api/v1/tracking/:trackingToken", handler)
+
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/tracking/secret-token", nil))
+		return ring.Recent()
+	}
+
+	t.Run("should record server errors by route", func(t *testing.T) {
+		recent := serve(t, func(ctx echo.Context) error {
+			return ctx.NoContent(http.StatusInternalServerError)
+		})
+
+		require.Len(t, recent, 1)
+		assert.Equal(t, "GET /api/v1/tracking/:trackingToken", recent[0].Source)
+		assert.Equal(t, "Internal Server Error", recent[0].Message)
+	})
+
+	t.Run("should record returned errors", func(t *testing.T) {
+		recent := serve(t, func(echo.Context) error {
+			return errors.New("connection refused")
+		})
+
+		require.Len(t, recent, 1)
+		assert.Equal(t, "connection refused", recent[0].Message)
+	})
+
+	t.Run("should skip client errors", func(t *testing.T) {
+		recent := serve(t, func(ctx echo.Context) error {
+			return ctx.NoContent(http.StatusNotFound)
+		})
+
+		assert.Empty(t, recent)
+	})
+}
@@ -0,0 +1,238 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"delivery/internal/pkg/i18n"
+
+	"github.com/labstack/echo/v4"
+)
+
+// fieldSelection is a parsed "fields" query parameter: the JSON fields of an object to keep,
+// each with the selection of its nested fields or nil to keep the nested value whole.
+// "id,storagePlaces(id,orderId)" keeps the id and, of every storage place, its id and order.
+type fieldSelection map[string]fieldSelection
+
+// respondWithFields writes response as JSON trimmed to the fields requested by mobile clients
+// on slow networks. Without a fields parameter the whole response is written. Unknown fields
+// and nested selections of plain values are rejected before anything is written.
+func respondWithFields(ctx echo.Context, status int, response any, fields *string) error {
+	if fields == nil || strings.TrimSpace(*fields) == "" {
+		return ctx.JSON(status, response)
+	}
+
+	selection, err := parseFieldSelection(*fields)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidFieldSelection, err.Error())
+	}
+	if err = selection.validate(reflect.TypeOf(response), ""); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidFieldSelection, err.Error())
+	}
+
+	trimmed, err := selection.apply(response)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(status, trimmed)
+}
+
+// parseFieldSelection parses a comma-separated list of field names with nested
+// selections in parentheses, for example "id,name,storagePlaces(id,orderId)".
+func parseFieldSelection(expression string) (fieldSelection, error) {
+	parser := fieldParser{input: []rune(expression)}
+
+	selection, err := parser.parseList()
+	if err != nil {
+		return nil, err
+	}
+	if parser.pos < len(parser.input) {
+		return nil, fmt.Errorf("unexpected %q at position %d", parser.input[parser.pos], parser.pos+1)
+	}
+	return selection, nil
+}
+
+type fieldParser struct {
+	input []rune
+	pos   int
+}
+
+func (p *fieldParser) parseList() (fieldSelection, error) {
+	selection := fieldSelection{}
+	for {
+		name := p.parseName()
+		if name == "" {
+			return nil, fmt.Errorf("field name expected at position %d", p.pos+1)
+		}
+
+		var nested fieldSelection
+		if p.peek() == '(' {
+			p.pos++
+			var err error
+			if nested, err = p.parseList(); err != nil {
+				return nil, err
+			}
+			if p.peek() != ')' {
+				return nil, fmt.Errorf("missing ) for field %q", name)
+			}
+			p.pos++
+		}
+		selection.merge(name, nested)
+
+		if p.peek() != ',' {
+			return selection, nil
+		}
+		p.pos++
+	}
+}
+
+func (p *fieldParser) parseName() string {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.input) && (unicode.IsLetter(p.input[p.pos]) || unicode.IsDigit(p.input[p.pos]) || p.input[p.pos] == '_') {
+		p.pos++
+	}
+	name := string(p.input[start:p.pos])
+	p.skipSpaces()
+	return name
+}
+
+func (p *fieldParser) skipSpaces() {
+	for p.pos < len(p.input) && unicode.IsSpace(p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *fieldParser) peek() rune {
+	if p.pos >= len(p.input) {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+// merge adds a field to the selection. A field selected both whole and with nested
+// fields is kept whole; nested selections of a field selected twice are combined.
+func (s fieldSelection) merge(name string, nested fieldSelection) {
+	existing, ok := s[name]
+	switch {
+	case !ok:
+		s[name] = nested
+	case existing == nil || nested == nil:
+		s[name] = nil
+	default:
+		for field, selection := range nested {
+			existing.merge(field, selection)
+		}
+	}
+}
+
+// validate checks that every selected field is a JSON field of the type t,
+// looking through pointers, slices and arrays of objects.
+func (s fieldSelection) validate(t reflect.Type, path string) error {
+	t = elementType(t)
+	if t.Kind() != reflect.Struct {
+		return fmt.Errorf("field %q has no nested fields", path)
+	}
+
+	fields := jsonFields(t)
+	for name, nested := range s {
+		fieldPath := name
+		if path != "" {
+			fieldPath = path + "." + name
+		}
+
+		fieldType, ok := fields[name]
+		if !ok {
+			return fmt.Errorf("unknown field %q", fieldPath)
+		}
+		if nested != nil {
+			if err := nested.validate(fieldType, fieldPath); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// apply encodes the response and drops the fields that are not selected.
+func (s fieldSelection) apply(response any) (any, error) {
+	encoded, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	var value any
+	if err = decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return s.trim(value), nil
+}
+
+func (s fieldSelection) trim(value any) any {
+	switch v := value.(type) {
+	case []any:
+		for i, element := range v {
+			v[i] = s.trim(element)
+		}
+		return v
+	case map[string]any:
+		for name, field := range v {
+			nested, ok := s[name]
+			switch {
+			case !ok:
+				delete(v, name)
+			case nested != nil:
+				v[name] = nested.trim(field)
+			}
+		}
+		return v
+	default:
+		return value
+	}
+}
+
+// elementType looks through pointers, slices and arrays of objects to the object type.
+// Arrays of bytes, such as UUIDs, are plain values.
+func elementType(t reflect.Type) reflect.Type {
+	for {
+		switch t.Kind() {
+		case reflect.Pointer:
+			t = t.Elem()
+		case reflect.Slice, reflect.Array:
+			if t.Elem().Kind() == reflect.Uint8 {
+				return t
+			}
+			t = t.Elem()
+		default:
+			return t
+		}
+	}
+}
+
+// jsonFields maps the JSON names of the exported fields of a struct to their types.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type, t.NumField())
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+	return fields
+}
@@ -0,0 +1,91 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"delivery/internal/generated/servers"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFieldSelection(t *testing.T) {
+	t.Run("should parse nested selections", func(t *testing.T) {
+		selection, err := parseFieldSelection(" id, storagePlaces( id ,orderId ),location(x)")
+
+		require.NoError(t, err)
+		assert.Equal(t, fieldSelection{
+			"id":            nil,
+			"storagePlaces": {"id": nil, "orderId": nil},
+			"location":      {"x": nil},
+		}, selection)
+	})
+
+	t.Run("should combine fields selected twice", func(t *testing.T) {
+		selection, err := parseFieldSelection("items(sku),items(quantity),location(x),location")
+
+		require.NoError(t, err)
+		assert.Equal(t, fieldSelection{
+			"items":    {"sku": nil, "quantity": nil},
+			"location": nil,
+		}, selection)
+	})
+
+	t.Run("should reject malformed expressions", func(t *testing.T) {
+		for _, expression := range []string{"id,", "id(", "id(name", "id)", "(id)", "id,,name", "id name"} {
+			_, err := parseFieldSelection(expression)
+			assert.Error(t, err, expression)
+		}
+	})
+}
+
+func TestRespondWithFields(t *testing.T) {
+	orderID := uuid.New()
+	couriers := []servers.Courier{{
+		Id:       uuid.New(),
+		Name:     "Alice",
+		Location: servers.Location{X: 3, Y: 4},
+		StoragePlaces: []servers.StoragePlace{
+			{Id: uuid.New(), Name: "Bag", TotalVolume: 10, OrderId: &orderID},
+		},
+	}}
+
+	respond := func(t *testing.T, fields *string) *httptest.ResponseRecorder {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/api/v1/couriers", nil), rec)
+
+		require.NoError(t, respondWithFields(ctx, http.StatusOK, couriers, fields))
+		return rec
+	}
+
+	t.Run("should write whole response without fields", func(t *testing.T) {
+		rec := respond(t, nil)
+
+		var body []servers.Courier
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, couriers, body)
+	})
+
+	t.Run("should trim response to selected fields", func(t *testing.T) {
+		fields := "name,storagePlaces(orderId,totalVolume)"
+		rec := respond(t, &fields)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `[{"name":"Alice","storagePlaces":[{"orderId":"`+orderID.String()+`","totalVolume":10}]}]`,
+			rec.Body.String())
+	})
+
+	t.Run("should reject unknown and plain nested fields", func(t *testing.T) {
+		for _, fields := range []string{"phone", "storagePlaces(volume)", "name(first)", "id(x)"} {
+			rec := respond(t, &fields)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code, fields)
+		}
+	})
+}
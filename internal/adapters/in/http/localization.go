@@ -0,0 +1,58 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"delivery/internal/generated/servers"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/i18n"
+
+	"github.com/labstack/echo/v4"
+)
+
+// acceptLanguageHeader carries the client's language preference.
+const acceptLanguageHeader = "Accept-Language"
+
+// requestLanguage negotiates the response language from the request's Accept-Language header.
+func requestLanguage(ctx echo.Context) i18n.Language {
+	return i18n.FromAcceptLanguage(ctx.Request().Header.Get(acceptLanguageHeader), i18n.DefaultLanguage)
+}
+
+// courierLanguage picks the language of a new courier: the explicit profile value if given,
+// otherwise the request's Accept-Language header, otherwise Russian.
+func courierLanguage(ctx echo.Context, language *servers.Language) i18n.Language {
+	if language != nil {
+		return i18n.Language(*language)
+	}
+	return i18n.FromAcceptLanguage(ctx.Request().Header.Get(acceptLanguageHeader), i18n.Russian)
+}
+
+// respondError writes an Error response whose message is localized for the request.
+// Machine-readable codes such as capacityExceededCode are not localized and are written directly.
+func respondError(ctx echo.Context, status int32, key i18n.MessageKey, args ...any) error {
+	return ctx.JSON(int(status), servers.Error{
+		Code:    status,
+		Message: i18n.Translate(requestLanguage(ctx), key, args...),
+	})
+}
+
+// respondValidationError writes a 400 Error response for err using a "_detail" message key.
+// When err carries errs.ValidationErrors, every invalid field is listed in the response as well.
+func respondValidationError(ctx echo.Context, key i18n.MessageKey, err error) error {
+	response := servers.Error{
+		Code:    http.StatusBadRequest,
+		Message: i18n.Translate(requestLanguage(ctx), key, err.Error()),
+	}
+
+	var validation *errs.ValidationErrors
+	if errors.As(err, &validation) {
+		fields := make([]servers.FieldError, len(validation.Fields))
+		for i, field := range validation.Fields {
+			fields[i] = servers.FieldError{Field: field.Field, Message: field.Error()}
+		}
+		response.Fields = &fields
+	}
+
+	return ctx.JSON(http.StatusBadRequest, response)
+}
@@ -0,0 +1,54 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"delivery/internal/generated/servers"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/i18n"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRespondValidationError(t *testing.T) {
+	respond := func(t *testing.T, err error) servers.Error {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/couriers", nil)
+		req.Header.Set(acceptLanguageHeader, "en")
+		rec := httptest.NewRecorder()
+
+		require.NoError(t, respondValidationError(echo.New().NewContext(req, rec), i18n.InvalidCourierData, err))
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+
+		var body servers.Error
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		return body
+	}
+
+	t.Run("should list invalid fields", func(t *testing.T) {
+		body := respond(t, errs.JoinFields(
+			errs.Field("name", errs.NewValueIsRequiredError("name")),
+			errs.Field("location", errs.JoinFields(errs.Field("x", errs.NewValueIsInvalidError("x")))),
+		))
+
+		assert.Equal(t, "Invalid courier data: value is required: name\nvalue is invalid: x", body.Message)
+		require.NotNil(t, body.Fields)
+		assert.Equal(t, []servers.FieldError{
+			{Field: "name", Message: "value is required: name"},
+			{Field: "location.x", Message: "value is invalid: x"},
+		}, *body.Fields)
+	})
+
+	t.Run("should omit fields for other errors", func(t *testing.T) {
+		body := respond(t, errors.New("broken"))
+
+		assert.Equal(t, "Invalid courier data: broken", body.Message)
+		assert.Nil(t, body.Fields)
+	})
+}
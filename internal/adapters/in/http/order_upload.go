@@ -0,0 +1,172 @@
+package http
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/errs"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	// orderUploadField is the multipart form field carrying the uploaded file.
+	orderUploadField = "file"
+
+	// maxOrderUploadSize limits uploaded files to 10 MiB, well above MaxImportedOrders rows.
+	maxOrderUploadSize = 10 << 20
+)
+
+// Columns of an order upload; header names are matched case-insensitively,
+// ignoring spaces, dashes and underscores, so "Delivery From" and "delivery_from" both work.
+const (
+	streetColumn       = "street"
+	volumeColumn       = "volume"
+	deliveryFromColumn = "deliveryfrom"
+	deliveryToColumn   = "deliveryto"
+)
+
+// decodeOrderUpload reads the order rows of an uploaded CSV or XLSX file.
+// The first row holds the column headers; blank rows are skipped. Row numbers in the result
+// are the line numbers in the file, so clients can match the report to their spreadsheet.
+func decodeOrderUpload(filename string, src io.Reader) ([]commands.OrderImportRow, error) {
+	var records [][]string
+	var lines []int
+	var err error
+
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		records, lines, err = readCSV(src)
+	case ".xlsx":
+		records, lines, err = readXLSX(src)
+	default:
+		return nil, errs.NewValueIsInvalidErrorWithCause(
+			"file",
+			fmt.Errorf("%q is not a .csv or .xlsx file", filename),
+		)
+	}
+	if err != nil {
+		return nil, errs.NewValueIsInvalidErrorWithCause("file", err)
+	}
+
+	if len(records) == 0 {
+		return nil, errs.NewValueIsRequiredError("header row")
+	}
+
+	columns, err := uploadColumns(records[0], streetColumn, volumeColumn)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]commands.OrderImportRow, 0, len(records)-1)
+	for i, record := range records[1:] {
+		if isBlankRecord(record) {
+			continue
+		}
+
+		rows = append(rows, commands.OrderImportRow{
+			Line:         lines[i+1],
+			Street:       cell(record, columns, streetColumn),
+			Volume:       cell(record, columns, volumeColumn),
+			DeliveryFrom: cell(record, columns, deliveryFromColumn),
+			DeliveryTo:   cell(record, columns, deliveryToColumn),
+		})
+	}
+
+	return rows, nil
+}
+
+func readCSV(src io.Reader) ([][]string, []int, error) {
+	reader := csv.NewReader(src)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var records [][]string
+	var lines []int
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return records, lines, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		records = append(records, record)
+		lines = append(lines, line)
+	}
+}
+
+// readXLSX reads the first sheet of the workbook.
+func readXLSX(src io.Reader) ([][]string, []int, error) {
+	workbook, err := excelize.OpenReader(src)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		_ = workbook.Close()
+	}()
+
+	sheets := workbook.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, nil, nil
+	}
+
+	records, err := workbook.GetRows(sheets[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lines := make([]int, len(records))
+	for i := range records {
+		lines[i] = i + 1
+	}
+	return records, lines, nil
+}
+
+// uploadColumns maps the column names to their positions in the header row.
+// Returns an error naming every required column missing from the header.
+func uploadColumns(header []string, required ...string) (map[string]int, error) {
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		key := strings.NewReplacer(" ", "", "-", "", "_", "").Replace(strings.ToLower(strings.TrimSpace(name)))
+		if _, seen := columns[key]; !seen {
+			columns[key] = i
+		}
+	}
+
+	var missing []error
+	for _, column := range required {
+		if _, ok := columns[column]; !ok {
+			missing = append(missing, errs.NewValueIsRequiredError(column+" column"))
+		}
+	}
+	if len(missing) > 0 {
+		return nil, errors.Join(missing...)
+	}
+
+	return columns, nil
+}
+
+func cell(record []string, columns map[string]int, column string) string {
+	i, ok := columns[column]
+	if !ok || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
+
+func isBlankRecord(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,72 @@
+package http
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestDecodeOrderUpload_CSV(t *testing.T) {
+	file := "Street,Volume,Delivery From,delivery_to\n" +
+		"1 Main Street,5,,\n" +
+		"\n" +
+		"\"2 Oak Avenue, office 4\",3,2025-03-01T09:00:00Z,2025-03-01T18:00:00Z\n"
+
+	rows, err := decodeOrderUpload("orders.CSV", strings.NewReader(file))
+
+	require.NoError(t, err)
+	assert.Equal(t, []commands.OrderImportRow{
+		{Line: 2, Street: "1 Main Street", Volume: "5"},
+		{Line: 4, Street: "2 Oak Avenue, office 4", Volume: "3",
+			DeliveryFrom: "2025-03-01T09:00:00Z", DeliveryTo: "2025-03-01T18:00:00Z"},
+	}, rows)
+}
+
+func TestDecodeOrderUpload_XLSX(t *testing.T) {
+	workbook := excelize.NewFile()
+	sheet := workbook.GetSheetName(0)
+	require.NoError(t, workbook.SetSheetRow(sheet, "A1", &[]any{"volume", "street"}))
+	require.NoError(t, workbook.SetSheetRow(sheet, "A2", &[]any{7, "1 Main Street"}))
+	var file bytes.Buffer
+	require.NoError(t, workbook.Write(&file))
+
+	rows, err := decodeOrderUpload("orders.xlsx", &file)
+
+	require.NoError(t, err)
+	assert.Equal(t, []commands.OrderImportRow{{Line: 2, Street: "1 Main Street", Volume: "7"}}, rows)
+}
+
+func TestDecodeOrderUpload_Errors(t *testing.T) {
+	t.Run("should reject unsupported formats", func(t *testing.T) {
+		_, err := decodeOrderUpload("orders.txt", strings.NewReader("street,volume\n"))
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should require street and volume columns", func(t *testing.T) {
+		_, err := decodeOrderUpload("orders.csv", strings.NewReader("address\n1 Main Street\n"))
+
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+		require.ErrorContains(t, err, "street column")
+		require.ErrorContains(t, err, "volume column")
+	})
+
+	t.Run("should require header row", func(t *testing.T) {
+		_, err := decodeOrderUpload("orders.csv", strings.NewReader(""))
+
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+	})
+
+	t.Run("should reject malformed workbooks", func(t *testing.T) {
+		_, err := decodeOrderUpload("orders.xlsx", strings.NewReader("not a workbook"))
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+}
@@ -0,0 +1,32 @@
+package http
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// maxPaymentEventSize limits payment webhook bodies to 64 KiB, well above a single event.
+const maxPaymentEventSize = 64 << 10
+
+// verifyPaymentSignature checks the hex-encoded HMAC-SHA256 of the payload signed with secret.
+// Without a secret every payload is accepted, so deployments behind a trusted network
+// can leave the webhook unsigned.
+func verifyPaymentSignature(secret string, payload []byte, signature *string) bool {
+	if secret == "" {
+		return true
+	}
+	if signature == nil {
+		return false
+	}
+
+	received, err := hex.DecodeString(strings.TrimSpace(*signature))
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hmac.Equal(received, mac.Sum(nil))
+}
@@ -0,0 +1,37 @@
+package http
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyPaymentSignature(t *testing.T) {
+	payload := []byte(`{"orderId":"0b8a3e2c-5a7d-4a43-9f0e-3c1d2b4a5e6f","status":"paid"}`)
+	sign := func(payload []byte) string {
+		mac := hmac.New(sha256.New, []byte("s3cret"))
+		mac.Write(payload)
+		return hex.EncodeToString(mac.Sum(nil))
+	}
+	valid := sign(payload)
+	tampered := sign(append(payload, ' '))
+	malformed := "not-hex"
+
+	t.Run("should accept any payload without secret", func(t *testing.T) {
+		assert.True(t, verifyPaymentSignature("", payload, nil))
+	})
+
+	t.Run("should accept valid signature", func(t *testing.T) {
+		assert.True(t, verifyPaymentSignature("s3cret", payload, &valid))
+	})
+
+	t.Run("should reject missing, tampered and malformed signatures", func(t *testing.T) {
+		assert.False(t, verifyPaymentSignature("s3cret", payload, nil))
+		assert.False(t, verifyPaymentSignature("s3cret", payload, &tampered))
+		assert.False(t, verifyPaymentSignature("s3cret", payload, &malformed))
+		assert.False(t, verifyPaymentSignature("other", payload, &valid))
+	})
+}
@@ -0,0 +1,97 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"delivery/internal/generated/servers"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/requestid"
+
+	"github.com/labstack/echo/v4"
+)
+
+// problemContentType is the media type of RFC 7807 problem details.
+const problemContentType = "application/problem+json"
+
+// Problem is an RFC 7807 problem details response. RequestID and Errors extend the standard
+// members with the correlation ID of the request and the invalid fields of a validation failure.
+type Problem struct {
+	Type      string               `json:"type"`
+	Title     string               `json:"title"`
+	Status    int                  `json:"status"`
+	Detail    string               `json:"detail,omitempty"`
+	RequestID string               `json:"requestId,omitempty"`
+	Errors    []servers.FieldError `json:"errors,omitempty"`
+}
+
+// ProblemMiddleware answers errors returned to Echo instead of being written by the handler
+// with problem details: errs.ObjectNotFoundError becomes 404, errs.ErrConcurrencyConflict 409,
+// validation failures 400 with the invalid fields, and echo.HTTPError, such as an unknown route,
+// keeps its status. Any other error becomes 500 without details, so internals do not leak.
+func ProblemMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		err := next(ctx)
+		if err == nil || ctx.Response().Committed {
+			return err
+		}
+
+		problem := newProblem(err)
+		problem.RequestID = requestid.FromContext(ctx.Request().Context())
+		if ctx.Request().Method == http.MethodHead {
+			return ctx.NoContent(problem.Status)
+		}
+		ctx.Response().Header().Set(echo.HeaderContentType, problemContentType)
+		return ctx.JSON(problem.Status, problem)
+	}
+}
+
+// newProblem maps err to the problem details it is answered with.
+func newProblem(err error) Problem {
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		problem := problemOf(httpErr.Code)
+		if httpErr.Code < http.StatusInternalServerError {
+			problem.Detail = fmt.Sprint(httpErr.Message)
+		}
+		return problem
+	}
+
+	switch {
+	case errors.Is(err, errs.ErrObjectNotFound):
+		problem := problemOf(http.StatusNotFound)
+		problem.Detail = err.Error()
+		return problem
+	case errors.Is(err, errs.ErrConcurrencyConflict):
+		problem := problemOf(http.StatusConflict)
+		problem.Detail = err.Error()
+		return problem
+	case errors.Is(err, errs.ErrValidationFailed),
+		errors.Is(err, errs.ErrValueIsInvalid),
+		errors.Is(err, errs.ErrValueIsRequired),
+		errors.Is(err, errs.ErrValueIsOutOfRange):
+		problem := problemOf(http.StatusBadRequest)
+		problem.Detail = err.Error()
+		var validation *errs.ValidationErrors
+		if errors.As(err, &validation) {
+			problem.Errors = make([]servers.FieldError, len(validation.Fields))
+			for i, field := range validation.Fields {
+				problem.Errors[i] = servers.FieldError{Field: field.Field, Message: field.Error()}
+			}
+		}
+		return problem
+	default:
+		return problemOf(http.StatusInternalServerError)
+	}
+}
+
+// problemOf returns the problem details of a status without further details. The type is
+// "about:blank", so the title is the standard reason phrase of the status.
+func problemOf(status int) Problem {
+	return Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+	}
+}
@@ -0,0 +1,109 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProblemMiddleware(t *testing.T) {
+	serve := func(t *testing.T, handler echo.HandlerFunc) (*httptest.ResponseRecorder, Problem) {
+		t.Helper()
+		e := echo.New()
+		e.Use(RequestIDMiddleware, ProblemMiddleware)
+		e.GET("/api/v1/orders/:orderId", handler)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/orders/42", nil)
+		request.Header.Set(echo.HeaderXRequestID, "req-42")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, request)
+
+		var problem Problem
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+		return rec, problem
+	}
+
+	t.Run("should map missing objects to 404", func(t *testing.T) {
+		rec, problem := serve(t, func(echo.Context) error {
+			return errs.NewObjectNotFoundError("order", "42")
+		})
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Equal(t, "application/problem+json", rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, "about:blank", problem.Type)
+		assert.Equal(t, "Not Found", problem.Title)
+		assert.Equal(t, http.StatusNotFound, problem.Status)
+		assert.Equal(t, "object not found: 42", problem.Detail)
+		assert.Equal(t, "req-42", problem.RequestID)
+	})
+
+	t.Run("should map concurrency conflicts to 409", func(t *testing.T) {
+		rec, problem := serve(t, func(echo.Context) error {
+			return errs.NewConcurrencyConflictError("order", "42", 3)
+		})
+
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		assert.Equal(t, http.StatusConflict, problem.Status)
+	})
+
+	t.Run("should list invalid fields", func(t *testing.T) {
+		rec, problem := serve(t, func(echo.Context) error {
+			return errs.JoinFields(
+				errs.Field("volume", errs.NewValueIsInvalidError("volume")),
+				errs.Field("street", errs.NewValueIsRequiredError("street")),
+			)
+		})
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Len(t, problem.Errors, 2)
+		assert.Equal(t, "volume", problem.Errors[0].Field)
+		assert.Equal(t, "street", problem.Errors[1].Field)
+	})
+
+	t.Run("should map invalid values to 400", func(t *testing.T) {
+		rec, problem := serve(t, func(echo.Context) error {
+			return errs.NewValueIsInvalidError("orderId")
+		})
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Empty(t, problem.Errors)
+	})
+
+	t.Run("should keep status of HTTP errors", func(t *testing.T) {
+		rec, problem := serve(t, func(echo.Context) error {
+			return echo.NewHTTPError(http.StatusUnsupportedMediaType, "unsupported media type")
+		})
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, rec.Code)
+		assert.Equal(t, "unsupported media type", problem.Detail)
+	})
+
+	t.Run("should hide internal errors", func(t *testing.T) {
+		rec, problem := serve(t, func(echo.Context) error {
+			return errors.New("pq: connection refused")
+		})
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Equal(t, "Internal Server Error", problem.Title)
+		assert.Empty(t, problem.Detail)
+	})
+
+	t.Run("should map unknown routes", func(t *testing.T) {
+		e := echo.New()
+		e.Use(ProblemMiddleware)
+
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/unknown", nil))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Equal(t, "application/problem+json", rec.Header().Get(echo.HeaderContentType))
+	})
+}
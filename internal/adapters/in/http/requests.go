@@ -0,0 +1,136 @@
+package http
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"delivery/internal/pkg/metrics"
+	"delivery/internal/pkg/requestid"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RequestIDMiddleware binds every request to a correlation ID. The ID sent by the caller in the
+// X-Request-ID header is kept, so a request can be followed across services; requests without it
+// or with a malformed one get a generated ID. The ID is returned in the X-Request-ID response header.
+func RequestIDMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		id := ctx.Request().Header.Get(echo.HeaderXRequestID)
+		if !requestid.IsValid(id) {
+			id = requestid.New()
+		}
+
+		request := ctx.Request()
+		ctx.SetRequest(request.WithContext(requestid.WithID(request.Context(), id)))
+		ctx.Response().Header().Set(echo.HeaderXRequestID, id)
+		return next(ctx)
+	}
+}
+
+// RequestLoggingMiddleware logs every request with its status and duration once it is served.
+// Requests are logged by route pattern rather than requested path, so identifiers and tracking
+// tokens never end up in the log. Server errors are logged as warnings: they are recorded in the
+// ring of recent errors by ErrorRecorderMiddleware already.
+func RequestLoggingMiddleware(logger *slog.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			started := time.Now()
+			err := next(ctx)
+
+			status := responseStatus(ctx, err)
+			level := slog.LevelInfo
+			if status >= http.StatusInternalServerError {
+				level = slog.LevelWarn
+			}
+			logger.LogAttrs(ctx.Request().Context(), level, "HTTP request served",
+				slog.String("method", ctx.Request().Method),
+				slog.String("route", ctx.Path()),
+				slog.Int("status", status),
+				slog.Duration("duration", time.Since(started)),
+			)
+			return err
+		}
+	}
+}
+
+// requestDuration measures how long requests take to serve, by route pattern and status.
+var requestDuration = metrics.NewHistogramVec(
+	"http_request_duration_seconds",
+	"Duration of served HTTP requests by method, route pattern and status.",
+	metrics.DefaultDurationBuckets,
+	"method", "route", "status",
+)
+
+// RequestMetricsMiddleware records the duration of every request in the
+// http_request_duration_seconds histogram. Like the request log, it labels requests by route
+// pattern, which keeps the number of series bounded; requests matching no route share one.
+func RequestMetricsMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		started := time.Now()
+		err := next(ctx)
+
+		route := ctx.Path()
+		if route == "" {
+			route = "unmatched"
+		}
+		requestDuration.ObserveSince(started,
+			ctx.Request().Method, route, strconv.Itoa(responseStatus(ctx, err)))
+		return err
+	}
+}
+
+// TracingMiddleware serves every request in a server span, continuing the trace of the caller
+// sent in the traceparent header. Like the request log, spans are named by route pattern; the
+// spans of handlers, units of work and statements started with the request's context are its
+// children. Server errors mark the span as failed.
+func TracingMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		request := ctx.Request()
+		route := ctx.Path()
+		if route == "" {
+			route = "unmatched"
+		}
+
+		spanCtx := tracing.Extract(request.Context(), propagation.HeaderCarrier(request.Header))
+		spanCtx, span := tracing.Start(spanCtx, request.Method+" "+route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", request.Method),
+				attribute.String("http.route", route),
+			),
+		)
+		defer span.End()
+
+		ctx.SetRequest(request.WithContext(spanCtx))
+		err := next(ctx)
+
+		status := responseStatus(ctx, err)
+		span.SetAttributes(attribute.Int("http.response.status_code", status))
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+		return err
+	}
+}
+
+// responseStatus returns the status the request is answered with, including the status
+// of an error returned to Echo instead of being written by the handler.
+func responseStatus(ctx echo.Context, err error) int {
+	var httpErr *echo.HTTPError
+	switch {
+	case errors.As(err, &httpErr):
+		return httpErr.Code
+	case err != nil:
+		return http.StatusInternalServerError
+	default:
+		return ctx.Response().Status
+	}
+}
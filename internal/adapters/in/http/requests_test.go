@@ -0,0 +1,155 @@
+package http
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"delivery/internal/pkg/metrics"
+	"delivery/internal/pkg/requestid"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestRequestIDMiddleware(t *testing.T) {
+	serve := func(t *testing.T, header string) (*httptest.ResponseRecorder, string) {
+		t.Helper()
+		var seen string
+		e := echo.New()
+		e.Use(RequestIDMiddleware)
+		e.GET("/health", func(ctx echo.Context) error {
+			seen = requestid.FromContext(ctx.Request().Context())
+			return ctx.NoContent(http.StatusOK)
+		})
+
+		request := httptest.NewRequest(http.MethodGet, "/health", nil)
+		if header != "" {
+			request.Header.Set(echo.HeaderXRequestID, header)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, request)
+		return rec, seen
+	}
+
+	t.Run("should keep the caller's ID", func(t *testing.T) {
+		rec, seen := serve(t, "gateway-7f3a")
+
+		assert.Equal(t, "gateway-7f3a", seen)
+		assert.Equal(t, "gateway-7f3a", rec.Header().Get(echo.HeaderXRequestID))
+	})
+
+	t.Run("should generate missing ID", func(t *testing.T) {
+		rec, seen := serve(t, "")
+
+		assert.True(t, requestid.IsValid(seen))
+		assert.Equal(t, seen, rec.Header().Get(echo.HeaderXRequestID))
+	})
+
+	t.Run("should replace malformed ID", func(t *testing.T) {
+		_, seen := serve(t, "two words")
+
+		assert.NotEqual(t, "two words", seen)
+		assert.True(t, requestid.IsValid(seen))
+	})
+}
+
+func TestRequestLoggingMiddleware(t *testing.T) {
+	serve := func(t *testing.T, handler echo.HandlerFunc) string {
+		t.Helper()
+		var out bytes.Buffer
+		logger := slog.New(requestid.NewLogHandler(slog.NewTextHandler(&out, nil)))
+		e := echo.New()
+		e.Use(RequestIDMiddleware, RequestLoggingMiddleware(logger))
+		e.GET("/api/v1/tracking/:trackingToken", handler)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/tracking/secret-token", nil)
+		request.Header.Set(echo.HeaderXRequestID, "req-42")
+		e.ServeHTTP(httptest.NewRecorder(), request)
+		return out.String()
+	}
+
+	t.Run("should log route, status and request ID", func(t *testing.T) {
+		line := serve(t, func(ctx echo.Context) error {
+			return ctx.NoContent(http.StatusNoContent)
+		})
+
+		assert.Contains(t, line, "level=INFO")
+		assert.Contains(t, line, "route=/api/v1/tracking/:trackingToken")
+		assert.Contains(t, line, "status=204")
+		assert.Contains(t, line, "request_id=req-42")
+		assert.NotContains(t, line, "secret-token")
+	})
+
+	t.Run("should warn about server errors", func(t *testing.T) {
+		line := serve(t, func(echo.Context) error {
+			return errors.New("connection refused")
+		})
+
+		assert.Contains(t, line, "level=WARN")
+		assert.Contains(t, line, "status=500")
+	})
+}
+
+func TestRequestMetricsMiddleware(t *testing.T) {
+	e := echo.New()
+	e.Use(RequestMetricsMiddleware)
+	e.GET("/api/v1/tracking/:trackingToken", func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusNoContent)
+	})
+
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/tracking/secret-token", nil))
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/unknown/secret-path", nil))
+
+	exposed := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(exposed, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Contains(t, exposed.Body.String(),
+		`http_request_duration_seconds_count{method="GET",route="/api/v1/tracking/:trackingToken",status="204"} 1`)
+	assert.Contains(t, exposed.Body.String(),
+		`http_request_duration_seconds_count{method="GET",route="unmatched",status="404"} 1`)
+	assert.NotContains(t, exposed.Body.String(), "secret")
+}
+
+func TestTracingMiddleware(t *testing.T) {
+	_, err := tracing.Setup(tracing.Config{ServiceName: "delivery", SampleRatio: 1})
+	require.NoError(t, err)
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+
+	var handled trace.SpanContext
+	e := echo.New()
+	e.Use(TracingMiddleware)
+	e.GET("/api/v1/orders/:orderId", func(ctx echo.Context) error {
+		handled = trace.SpanContextFromContext(ctx.Request().Context())
+		return errors.New("database is down")
+	})
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/orders/42", nil)
+	request.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	e.ServeHTTP(httptest.NewRecorder(), request)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "GET /api/v1/orders/:orderId", span.Name())
+	assert.Equal(t, trace.SpanKindServer, span.SpanKind())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", span.Parent().SpanID().String())
+	assert.Equal(t, span.SpanContext().SpanID(), handled.SpanID())
+	assert.Equal(t, codes.Error, span.Status().Code)
+	assert.Contains(t, span.Attributes(), attribute.Int("http.response.status_code", http.StatusInternalServerError))
+}
@@ -1,26 +1,140 @@
 package http
 
 import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"delivery/internal/core/application/usecases/commands"
 	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/announcement"
+	"delivery/internal/core/domain/model/blob"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/device"
+	"delivery/internal/core/domain/model/identity"
 	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/matching"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/pickup"
+	"delivery/internal/core/domain/model/relay"
+	"delivery/internal/core/domain/model/sla"
+	"delivery/internal/core/domain/model/surge"
+	"delivery/internal/core/domain/model/track"
+	"delivery/internal/core/domain/model/usage"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/core/ports"
 	"delivery/internal/generated/servers"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/i18n"
 
 	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// capacityExceededCode is reported to clients when the fleet cannot take more orders.
+const capacityExceededCode = "capacity_exceeded"
+
+// fraudRejectedCode is reported to clients when fraud checks refuse an order.
+const fraudRejectedCode = "fraud_rejected"
+
+// sharedTrackingPath is the public route customers open via a shared tracking link.
+const sharedTrackingPath = "/api/v1/tracking/"
+
 // Server implements the ServerInterface for handling HTTP requests.
 // It coordinates between HTTP handlers and application use cases.
 type Server struct {
 	// Command handlers
-	createCourierHandler commands.CreateCourierCommandHandler
-	createOrderHandler   commands.CreateOrderCommandHandler
+	createCourierHandler                commands.CreateCourierCommandHandler
+	createOrderHandler                  commands.CreateOrderCommandHandler
+	setStoragePlaceMaintenanceHandler   commands.SetStoragePlaceMaintenanceCommandHandler
+	postOrderMessageHandler             commands.PostOrderMessageCommandHandler
+	checkFleetCapacityHandler           commands.CheckFleetCapacityCommandHandler
+	shareOrderTrackingHandler           commands.ShareOrderTrackingCommandHandler
+	deactivateCourierHandler            commands.DeactivateCourierCommandHandler
+	approveOrderReviewHandler           commands.ApproveOrderReviewCommandHandler
+	recalculateETAHandler               commands.RecalculateETACommandHandler
+	updateCourierProfileHandler         commands.UpdateCourierProfileCommandHandler
+	purgeSyntheticDataHandler           commands.PurgeSyntheticDataCommandHandler
+	setRolloutPercentageHandler         commands.SetRolloutPercentageCommandHandler
+	importOrdersHandler                 commands.ImportOrdersCommandHandler
+	setCourierShiftHandler              commands.SetCourierShiftCommandHandler
+	broadcastAnnouncementHandler        commands.BroadcastAnnouncementCommandHandler
+	createPickupSlotHandler             commands.CreatePickupSlotCommandHandler
+	changePickupSlotCapacityHandler     commands.ChangePickupSlotCapacityCommandHandler
+	tipOrderHandler                     commands.TipOrderCommandHandler
+	updateOrderPaymentHandler           commands.UpdateOrderPaymentCommandHandler
+	scheduleCourierMaintenanceHandler   commands.ScheduleCourierMaintenanceCommandHandler
+	rescheduleCourierMaintenanceHandler commands.RescheduleCourierMaintenanceCommandHandler
+	cancelCourierMaintenanceHandler     commands.CancelCourierMaintenanceCommandHandler
+	planCourierAbsenceHandler           commands.PlanCourierAbsenceCommandHandler
+	cancelCourierAbsenceHandler         commands.CancelCourierAbsenceCommandHandler
+	setCourierInsuranceHandler          commands.SetCourierInsuranceCommandHandler
+	confirmOrderHandoverHandler         commands.ConfirmOrderHandoverCommandHandler
+	cancelOrderHandler                  commands.CancelOrderCommandHandler
+	recomputeMicrozonesHandler          commands.RecomputeMicrozonesCommandHandler
+	meterAPIUsageHandler                commands.MeterAPIUsageCommandHandler
+	recordDeviceTelemetryHandler        commands.RecordDeviceTelemetryCommandHandler
 
 	// Query handlers
-	getAllCouriersHandler       queries.GetAllCouriersQueryHandler
-	getUncompletedOrdersHandler queries.GetUncompletedOrdersQueryHandler
+	getAllCouriersHandler               queries.GetAllCouriersQueryHandler
+	getUncompletedOrdersHandler         queries.GetUncompletedOrdersQueryHandler
+	getOrderThreadHandler               queries.GetOrderThreadQueryHandler
+	getSharedTrackingHandler            queries.GetSharedTrackingQueryHandler
+	getOrdersUnderReviewHandler         queries.GetOrdersUnderReviewQueryHandler
+	getCourierWorkingHoursHandler       queries.GetCourierWorkingHoursQueryHandler
+	getAssignmentExplanationHandler     queries.GetAssignmentExplanationQueryHandler
+	getAnnouncementsHandler             queries.GetAnnouncementsQueryHandler
+	getPickupSlotsHandler               queries.GetPickupSlotsQueryHandler
+	getPayoutExportHandler              queries.GetPayoutExportQueryHandler
+	getCourierMaintenanceWindowsHandler queries.GetCourierMaintenanceWindowsQueryHandler
+	getChangesHandler                   queries.GetChangesQueryHandler
+	getMicrozonesHandler                queries.GetMicrozonesQueryHandler
+	getAPIUsageHandler                  queries.GetAPIUsageQueryHandler
+	getDeviceHealthHandler              queries.GetDeviceHealthQueryHandler
+	getOrderByExternalReferenceHandler  queries.GetOrderByExternalReferenceQueryHandler
+	changeSurgeModeHandler              commands.ChangeSurgeModeCommandHandler
+	getSurgeModeHandler                 queries.GetSurgeModeQueryHandler
+	replaceSLATargetsHandler            commands.ReplaceSLATargetsCommandHandler
+	computeSLAComplianceHandler         commands.ComputeSLAComplianceCommandHandler
+	getSLATargetsHandler                queries.GetSLATargetsQueryHandler
+	getSLAReportHandler                 queries.GetSLAReportQueryHandler
+	getFleetWhatIfHandler               queries.GetFleetWhatIfQueryHandler
+	transferOrderHandler                commands.TransferOrderCommandHandler
+	importCourierLocationsHandler       commands.ImportCourierLocationsCommandHandler
+	updateCourierLocationHandler        commands.UpdateCourierLocationCommandHandler
+	getOrdersPageHandler                queries.GetOrdersPageQueryHandler
+	getSLOStatusHandler                 queries.GetSLOStatusQueryHandler
+	mergeCouriersHandler                commands.MergeCouriersCommandHandler
+	getOrderETAHandler                  queries.GetOrderETAQueryHandler
+	setCourierScheduleHandler           commands.SetCourierScheduleCommandHandler
+	clearCourierScheduleHandler         commands.ClearCourierScheduleCommandHandler
+	getCourierAvailabilityHandler       queries.GetCourierAvailabilityQueryHandler
+	replaceMatchingRulesHandler         commands.ReplaceMatchingRulesCommandHandler
+	getMatchingRulesHandler             queries.GetMatchingRulesQueryHandler
+	getOrderHistoryHandler              queries.GetOrderHistoryQueryHandler
+	getOrderStateHandler                queries.GetOrderStateQueryHandler
+	erasePersonalDataHandler            commands.ErasePersonalDataCommandHandler
+	batchCreateCouriersHandler          commands.BatchCreateCouriersCommandHandler
+	clearCourierReviewFlagHandler       commands.ClearCourierReviewFlagCommandHandler
+	setCourierBreakHandler              commands.SetCourierBreakCommandHandler
+	bulkCancelOrdersHandler             commands.BulkCancelOrdersCommandHandler
+	rateOrderHandler                    commands.RateOrderCommandHandler
+	getCourierStatsHandler              queries.GetCourierStatsQueryHandler
+	getBadgeRulesHandler                queries.GetBadgeRulesQueryHandler
+
+	// issueBlobUploadHandler is nil when no blob storage is configured
+	issueBlobUploadHandler *commands.IssueBlobUploadCommandHandler
+
+	// paymentWebhookSecret signs payment provider events; empty disables signature checks
+	paymentWebhookSecret string
 }
 
 // NewServer creates a new HTTP server with the required command and query handlers.
@@ -29,25 +143,169 @@ func NewServer(
 	createOrderHandler commands.CreateOrderCommandHandler,
 	getAllCouriersHandler queries.GetAllCouriersQueryHandler,
 	getUncompletedOrdersHandler queries.GetUncompletedOrdersQueryHandler,
+	setStoragePlaceMaintenanceHandler commands.SetStoragePlaceMaintenanceCommandHandler,
+	postOrderMessageHandler commands.PostOrderMessageCommandHandler,
+	getOrderThreadHandler queries.GetOrderThreadQueryHandler,
+	checkFleetCapacityHandler commands.CheckFleetCapacityCommandHandler,
+	shareOrderTrackingHandler commands.ShareOrderTrackingCommandHandler,
+	getSharedTrackingHandler queries.GetSharedTrackingQueryHandler,
+	deactivateCourierHandler commands.DeactivateCourierCommandHandler,
+	approveOrderReviewHandler commands.ApproveOrderReviewCommandHandler,
+	getOrdersUnderReviewHandler queries.GetOrdersUnderReviewQueryHandler,
+	recalculateETAHandler commands.RecalculateETACommandHandler,
+	updateCourierProfileHandler commands.UpdateCourierProfileCommandHandler,
+	purgeSyntheticDataHandler commands.PurgeSyntheticDataCommandHandler,
+	setRolloutPercentageHandler commands.SetRolloutPercentageCommandHandler,
+	importOrdersHandler commands.ImportOrdersCommandHandler,
+	setCourierShiftHandler commands.SetCourierShiftCommandHandler,
+	getCourierWorkingHoursHandler queries.GetCourierWorkingHoursQueryHandler,
+	getAssignmentExplanationHandler queries.GetAssignmentExplanationQueryHandler,
+	broadcastAnnouncementHandler commands.BroadcastAnnouncementCommandHandler,
+	getAnnouncementsHandler queries.GetAnnouncementsQueryHandler,
+	createPickupSlotHandler commands.CreatePickupSlotCommandHandler,
+	changePickupSlotCapacityHandler commands.ChangePickupSlotCapacityCommandHandler,
+	getPickupSlotsHandler queries.GetPickupSlotsQueryHandler,
+	tipOrderHandler commands.TipOrderCommandHandler,
+	getPayoutExportHandler queries.GetPayoutExportQueryHandler,
+	updateOrderPaymentHandler commands.UpdateOrderPaymentCommandHandler,
+	paymentWebhookSecret string,
+	scheduleCourierMaintenanceHandler commands.ScheduleCourierMaintenanceCommandHandler,
+	rescheduleCourierMaintenanceHandler commands.RescheduleCourierMaintenanceCommandHandler,
+	cancelCourierMaintenanceHandler commands.CancelCourierMaintenanceCommandHandler,
+	getCourierMaintenanceWindowsHandler queries.GetCourierMaintenanceWindowsQueryHandler,
+	getChangesHandler queries.GetChangesQueryHandler,
+	planCourierAbsenceHandler commands.PlanCourierAbsenceCommandHandler,
+	cancelCourierAbsenceHandler commands.CancelCourierAbsenceCommandHandler,
+	setCourierInsuranceHandler commands.SetCourierInsuranceCommandHandler,
+	confirmOrderHandoverHandler commands.ConfirmOrderHandoverCommandHandler,
+	recomputeMicrozonesHandler commands.RecomputeMicrozonesCommandHandler,
+	getMicrozonesHandler queries.GetMicrozonesQueryHandler,
+	meterAPIUsageHandler commands.MeterAPIUsageCommandHandler,
+	getAPIUsageHandler queries.GetAPIUsageQueryHandler,
+	recordDeviceTelemetryHandler commands.RecordDeviceTelemetryCommandHandler,
+	getDeviceHealthHandler queries.GetDeviceHealthQueryHandler,
+	getOrderByExternalReferenceHandler queries.GetOrderByExternalReferenceQueryHandler,
+	changeSurgeModeHandler commands.ChangeSurgeModeCommandHandler,
+	getSurgeModeHandler queries.GetSurgeModeQueryHandler,
+	replaceSLATargetsHandler commands.ReplaceSLATargetsCommandHandler,
+	computeSLAComplianceHandler commands.ComputeSLAComplianceCommandHandler,
+	getSLATargetsHandler queries.GetSLATargetsQueryHandler,
+	getSLAReportHandler queries.GetSLAReportQueryHandler,
+	importCourierLocationsHandler commands.ImportCourierLocationsCommandHandler,
+	getFleetWhatIfHandler queries.GetFleetWhatIfQueryHandler,
+	transferOrderHandler commands.TransferOrderCommandHandler,
+	getOrdersPageHandler queries.GetOrdersPageQueryHandler,
+	mergeCouriersHandler commands.MergeCouriersCommandHandler,
+	getOrderETAHandler queries.GetOrderETAQueryHandler,
+	cancelOrderHandler commands.CancelOrderCommandHandler,
+	issueBlobUploadHandler *commands.IssueBlobUploadCommandHandler,
+	getSLOStatusHandler queries.GetSLOStatusQueryHandler,
+	setCourierScheduleHandler commands.SetCourierScheduleCommandHandler,
+	clearCourierScheduleHandler commands.ClearCourierScheduleCommandHandler,
+	getCourierAvailabilityHandler queries.GetCourierAvailabilityQueryHandler,
+	replaceMatchingRulesHandler commands.ReplaceMatchingRulesCommandHandler,
+	getMatchingRulesHandler queries.GetMatchingRulesQueryHandler,
+	getOrderHistoryHandler queries.GetOrderHistoryQueryHandler,
+	getOrderStateHandler queries.GetOrderStateQueryHandler,
+	erasePersonalDataHandler commands.ErasePersonalDataCommandHandler,
+	batchCreateCouriersHandler commands.BatchCreateCouriersCommandHandler,
+	updateCourierLocationHandler commands.UpdateCourierLocationCommandHandler,
+	clearCourierReviewFlagHandler commands.ClearCourierReviewFlagCommandHandler,
+	setCourierBreakHandler commands.SetCourierBreakCommandHandler,
+	bulkCancelOrdersHandler commands.BulkCancelOrdersCommandHandler,
+	rateOrderHandler commands.RateOrderCommandHandler,
+	getCourierStatsHandler queries.GetCourierStatsQueryHandler,
+	getBadgeRulesHandler queries.GetBadgeRulesQueryHandler,
 ) *Server {
 	return &Server{
-		createCourierHandler:        createCourierHandler,
-		createOrderHandler:          createOrderHandler,
-		getAllCouriersHandler:       getAllCouriersHandler,
-		getUncompletedOrdersHandler: getUncompletedOrdersHandler,
+		createCourierHandler:                createCourierHandler,
+		createOrderHandler:                  createOrderHandler,
+		setStoragePlaceMaintenanceHandler:   setStoragePlaceMaintenanceHandler,
+		postOrderMessageHandler:             postOrderMessageHandler,
+		checkFleetCapacityHandler:           checkFleetCapacityHandler,
+		shareOrderTrackingHandler:           shareOrderTrackingHandler,
+		deactivateCourierHandler:            deactivateCourierHandler,
+		approveOrderReviewHandler:           approveOrderReviewHandler,
+		recalculateETAHandler:               recalculateETAHandler,
+		updateCourierProfileHandler:         updateCourierProfileHandler,
+		purgeSyntheticDataHandler:           purgeSyntheticDataHandler,
+		setRolloutPercentageHandler:         setRolloutPercentageHandler,
+		importOrdersHandler:                 importOrdersHandler,
+		setCourierShiftHandler:              setCourierShiftHandler,
+		broadcastAnnouncementHandler:        broadcastAnnouncementHandler,
+		createPickupSlotHandler:             createPickupSlotHandler,
+		changePickupSlotCapacityHandler:     changePickupSlotCapacityHandler,
+		tipOrderHandler:                     tipOrderHandler,
+		getAllCouriersHandler:               getAllCouriersHandler,
+		getUncompletedOrdersHandler:         getUncompletedOrdersHandler,
+		getOrderThreadHandler:               getOrderThreadHandler,
+		getSharedTrackingHandler:            getSharedTrackingHandler,
+		getOrdersUnderReviewHandler:         getOrdersUnderReviewHandler,
+		getCourierWorkingHoursHandler:       getCourierWorkingHoursHandler,
+		getAssignmentExplanationHandler:     getAssignmentExplanationHandler,
+		getAnnouncementsHandler:             getAnnouncementsHandler,
+		getPickupSlotsHandler:               getPickupSlotsHandler,
+		getPayoutExportHandler:              getPayoutExportHandler,
+		updateOrderPaymentHandler:           updateOrderPaymentHandler,
+		paymentWebhookSecret:                paymentWebhookSecret,
+		scheduleCourierMaintenanceHandler:   scheduleCourierMaintenanceHandler,
+		rescheduleCourierMaintenanceHandler: rescheduleCourierMaintenanceHandler,
+		cancelCourierMaintenanceHandler:     cancelCourierMaintenanceHandler,
+		getCourierMaintenanceWindowsHandler: getCourierMaintenanceWindowsHandler,
+		getChangesHandler:                   getChangesHandler,
+		planCourierAbsenceHandler:           planCourierAbsenceHandler,
+		cancelCourierAbsenceHandler:         cancelCourierAbsenceHandler,
+		setCourierInsuranceHandler:          setCourierInsuranceHandler,
+		confirmOrderHandoverHandler:         confirmOrderHandoverHandler,
+		recomputeMicrozonesHandler:          recomputeMicrozonesHandler,
+		getMicrozonesHandler:                getMicrozonesHandler,
+		meterAPIUsageHandler:                meterAPIUsageHandler,
+		getAPIUsageHandler:                  getAPIUsageHandler,
+		recordDeviceTelemetryHandler:        recordDeviceTelemetryHandler,
+		getDeviceHealthHandler:              getDeviceHealthHandler,
+		getOrderByExternalReferenceHandler:  getOrderByExternalReferenceHandler,
+		changeSurgeModeHandler:              changeSurgeModeHandler,
+		getSurgeModeHandler:                 getSurgeModeHandler,
+		replaceSLATargetsHandler:            replaceSLATargetsHandler,
+		computeSLAComplianceHandler:         computeSLAComplianceHandler,
+		getSLATargetsHandler:                getSLATargetsHandler,
+		getSLAReportHandler:                 getSLAReportHandler,
+		importCourierLocationsHandler:       importCourierLocationsHandler,
+		getFleetWhatIfHandler:               getFleetWhatIfHandler,
+		transferOrderHandler:                transferOrderHandler,
+		getOrdersPageHandler:                getOrdersPageHandler,
+		mergeCouriersHandler:                mergeCouriersHandler,
+		getOrderETAHandler:                  getOrderETAHandler,
+		cancelOrderHandler:                  cancelOrderHandler,
+		issueBlobUploadHandler:              issueBlobUploadHandler,
+		getSLOStatusHandler:                 getSLOStatusHandler,
+		setCourierScheduleHandler:           setCourierScheduleHandler,
+		clearCourierScheduleHandler:         clearCourierScheduleHandler,
+		getCourierAvailabilityHandler:       getCourierAvailabilityHandler,
+		replaceMatchingRulesHandler:         replaceMatchingRulesHandler,
+		getMatchingRulesHandler:             getMatchingRulesHandler,
+		getOrderHistoryHandler:              getOrderHistoryHandler,
+		getOrderStateHandler:                getOrderStateHandler,
+		erasePersonalDataHandler:            erasePersonalDataHandler,
+		batchCreateCouriersHandler:          batchCreateCouriersHandler,
+		updateCourierLocationHandler:        updateCourierLocationHandler,
+		clearCourierReviewFlagHandler:       clearCourierReviewFlagHandler,
+		setCourierBreakHandler:              setCourierBreakHandler,
+		bulkCancelOrdersHandler:             bulkCancelOrdersHandler,
+		rateOrderHandler:                    rateOrderHandler,
+		getCourierStatsHandler:              getCourierStatsHandler,
+		getBadgeRulesHandler:                getBadgeRulesHandler,
 	}
 }
 
-// GetCouriers handles GET /api/v1/couriers - retrieves all couriers.
-func (s *Server) GetCouriers(ctx echo.Context) error {
+// GetCouriers handles GET /api/v1/couriers - retrieves all couriers with their storage places
+// and absences, trimmed to the requested fields.
+func (s *Server) GetCouriers(ctx echo.Context, params servers.GetCouriersParams) error {
 	query := queries.NewGetAllCouriersQuery()
 
 	couriers, err := s.getAllCouriersHandler.Handle(ctx.Request().Context(), query)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, servers.Error{
-			Code:    http.StatusInternalServerError,
-			Message: "Failed to retrieve couriers",
-		})
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveCouriers)
 	}
 
 	response := make([]servers.Courier, len(couriers))
@@ -60,100 +318,3752 @@ func (s *Server) GetCouriers(ctx echo.Context) error {
 				X: int(courier.Location.X()),
 				Y: int(courier.Location.Y()),
 			},
-			Name: courier.Name,
+			Name:          courier.Name,
+			ExternalId:    courier.ExternalID,
+			StoragePlaces: toAPIStoragePlaces(courier.StoragePlaces),
+			Absences:      toAPIAbsences(courier.Absences),
+			Insured:       courier.Insured,
+		}
+		response[i].SubstitutingFor = make([]openapi_types.UUID, len(courier.SubstitutingFor))
+		for j, absentID := range courier.SubstitutingFor {
+			response[i].SubstitutingFor[j] = absentID.Bytes()
 		}
 	}
 
-	return ctx.JSON(http.StatusOK, response)
+	return respondWithFields(ctx, http.StatusOK, response, params.Fields)
+}
+
+// toAPIStoragePlaces maps the storage places of a courier read model to the API representation.
+func toAPIStoragePlaces(places []queries.CourierStoragePlace) []servers.StoragePlace {
+	response := make([]servers.StoragePlace, len(places))
+	for i, place := range places {
+		response[i] = servers.StoragePlace{
+			Id:           place.ID.Bytes(),
+			Name:         place.Name,
+			TotalVolume:  place.TotalVolume,
+			OutOfService: place.OutOfService,
+			Thermal:      place.Thermal,
+		}
+		if place.OrderID != nil {
+			orderID := openapi_types.UUID(place.OrderID.Bytes())
+			response[i].OrderId = &orderID
+		}
+	}
+	return response
 }
 
 // CreateCourier handles POST /api/v1/couriers - creates a new courier.
 func (s *Server) CreateCourier(ctx echo.Context) error {
 	var newCourier servers.NewCourier
 	if err := ctx.Bind(&newCourier); err != nil {
-		return ctx.JSON(http.StatusBadRequest, servers.Error{
-			Code:    http.StatusBadRequest,
-			Message: "Invalid request body",
-		})
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
 	}
 
 	// Generate random location for the courier
 	location, err := kernel.NewRandomLocation()
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, servers.Error{
-			Code:    http.StatusInternalServerError,
-			Message: "Failed to generate courier location",
-		})
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToGenerateCourierLocation)
+	}
+
+	var cmd commands.CreateCourierCommand
+	if newCourier.ExternalId != nil {
+		cmd, err = commands.NewCreateCourierCommandWithExternalID(
+			newCourier.Name,
+			newCourier.Speed,
+			location,
+			courierLanguage(ctx, newCourier.Language),
+			*newCourier.ExternalId,
+		)
+	} else {
+		cmd, err = commands.NewCreateCourierCommandWithLanguage(
+			newCourier.Name,
+			newCourier.Speed,
+			location,
+			courierLanguage(ctx, newCourier.Language),
+		)
+	}
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidCourierData, err)
 	}
 
-	cmd, err := commands.NewCreateCourierCommand(newCourier.Name, newCourier.Speed, location)
+	registration, err := s.createCourierHandler.Handle(ctx.Request().Context(), cmd)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, servers.Error{
-			Code:    http.StatusBadRequest,
-			Message: "Invalid courier data: " + err.Error(),
-		})
+		return respondError(ctx, http.StatusConflict, i18n.FailedToCreateCourier)
 	}
 
-	if handleErr := s.createCourierHandler.Handle(ctx.Request().Context(), cmd); handleErr != nil {
-		return ctx.JSON(http.StatusConflict, servers.Error{
-			Code:    http.StatusConflict,
-			Message: "Failed to create courier",
-		})
+	// A repeat for an already registered external ID returns the courier created first
+	status := http.StatusCreated
+	if registration.Existing {
+		status = http.StatusOK
+	}
+	return ctx.JSON(status, toAPICourier(registration.Courier))
+}
+
+// ImportCouriers handles POST /api/v1/couriers/import - registers a JSON or CSV batch of couriers
+// in one transaction. If any row is invalid, nothing is created and the report lists the row errors.
+func (s *Server) ImportCouriers(ctx echo.Context) error {
+	rows, err := decodeCourierImport(ctx.Request().Header.Get(echo.HeaderContentType), ctx.Request().Body)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidCourierImport, err.Error())
 	}
 
-	return ctx.NoContent(http.StatusCreated)
+	cmd, err := commands.NewBatchCreateCouriersCommand(rows, courierLanguage(ctx, nil))
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidCourierImport, err.Error())
+	}
+
+	report, err := s.batchCreateCouriersHandler.Handle(ctx.Request().Context(), cmd)
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToImportCouriers)
+	}
+
+	response := servers.CourierImportReport{
+		Created:  report.Created(),
+		Existing: report.Existing(),
+		Failed:   report.Failed(),
+		Rows:     make([]servers.CourierImportResult, len(report.Results)),
+	}
+	for i, result := range report.Results {
+		row := servers.CourierImportResult{Row: result.Line}
+		if result.CourierID != nil {
+			courierID := result.CourierID.Bytes()
+			existing := result.Existing
+			row.CourierId = &courierID
+			row.Existing = &existing
+		}
+		if result.Err != nil {
+			message := result.Err.Error()
+			row.Error = &message
+		}
+		response.Rows[i] = row
+	}
+
+	if report.Failed() > 0 {
+		return ctx.JSON(http.StatusUnprocessableEntity, response)
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// toAPICourier maps a courier aggregate to the API representation.
+func toAPICourier(c *courier.Courier) servers.Courier {
+	response := servers.Courier{
+		Id: c.ID().Bytes(),
+		Location: servers.Location{
+			X: int(c.Location().X()),
+			Y: int(c.Location().Y()),
+		},
+		Name:          c.Name(),
+		StoragePlaces: make([]servers.StoragePlace, 0, len(c.StoragePlaces())),
+		Insured:       c.IsInsured(),
+	}
+	if c.ExternalID() != nil {
+		externalID := c.ExternalID().String()
+		response.ExternalId = &externalID
+	}
+
+	for _, place := range c.StoragePlaces() {
+		apiPlace := servers.StoragePlace{
+			Id:           place.ID().Bytes(),
+			Name:         place.Name(),
+			TotalVolume:  place.TotalVolume(),
+			OutOfService: place.IsOutOfService(),
+			Thermal:      place.IsThermal(),
+		}
+		if place.OrderID() != nil {
+			orderID := openapi_types.UUID(place.OrderID().Bytes())
+			apiPlace.OrderId = &orderID
+		}
+		response.StoragePlaces = append(response.StoragePlaces, apiPlace)
+	}
+	return response
 }
 
 // CreateOrder handles POST /api/v1/orders - creates a new order.
+// Responds with 429 capacity_exceeded while the fleet capacity breaker is tripped,
+// or accepts the order with a Warning header when configured to warn instead.
 func (s *Server) CreateOrder(ctx echo.Context) error {
-	// For this API, we'll create an order with a random location and volume
-	// Since the OpenAPI spec doesn't specify request body for order creation
-	orderID := kernel.NewUUID()
-	street := "Auto-generated order"
-	volume := 10 // Default volume
+	var newOrder servers.NewOrder
+	if err := ctx.Bind(&newOrder); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	var itemErrors errs.ValidationErrors
+	items := make([]order.Item, 0, len(newOrder.Items))
+	for i, line := range newOrder.Items {
+		item, err := order.NewItem(line.Sku, line.Quantity, line.UnitVolume)
+		if err != nil {
+			itemErrors.Add(fmt.Sprintf("items.%d", i), err)
+			continue
+		}
+		items = append(items, item)
+	}
+	if err := itemErrors.Err(); err != nil {
+		return respondValidationError(ctx, i18n.InvalidOrderData, err)
+	}
+
+	paymentMethod := order.CashOnDelivery
+	if newOrder.PaymentMethod != nil {
+		paymentMethod = fromAPIPaymentMethod(*newOrder.PaymentMethod)
+	}
+
+	deliveryTier := order.ExpressDelivery
+	if newOrder.DeliveryTier != nil {
+		deliveryTier = fromAPIDeliveryTier(*newOrder.DeliveryTier)
+	}
+
+	declaredValue := 0
+	if newOrder.DeclaredValue != nil {
+		declaredValue = *newOrder.DeclaredValue
+	}
+
+	var externalReference *order.ExternalReference
+	if newOrder.ExternalReference != nil {
+		ref, err := fromAPIExternalReference(*newOrder.ExternalReference)
+		if err != nil {
+			return respondValidationError(ctx, i18n.InvalidOrderData, errs.JoinFields(errs.Field("externalReference", err)))
+		}
+		externalReference = &ref
+	}
+
+	temperatureClass := order.Ambient
+	if newOrder.TemperatureClass != nil {
+		temperatureClass = fromAPITemperatureClass(*newOrder.TemperatureClass)
+	}
+
+	priority := order.NormalPriority
+	if newOrder.Priority != nil {
+		priority = fromAPIOrderPriority(*newOrder.Priority)
+	}
+
+	cmd, err := commands.NewCreateOrderCommandWithPriority(
+		kernel.NewUUID(), newOrder.Street, items, paymentMethod, deliveryTier, declaredValue, externalReference,
+		temperatureClass, nil, priority,
+	)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidOrderData, err)
+	}
 
-	cmd, err := commands.NewCreateOrderCommand(orderID, street, volume)
+	decision, err := s.checkFleetCapacityHandler.Handle(ctx.Request().Context(), commands.NewCheckFleetCapacityCommand())
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, servers.Error{
-			Code:    http.StatusBadRequest,
-			Message: "Invalid order data: " + err.Error(),
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToCheckFleetCapacity)
+	}
+
+	if decision.Rejected {
+		return ctx.JSON(http.StatusTooManyRequests, servers.Error{
+			Code:    http.StatusTooManyRequests,
+			Message: capacityExceededCode,
 		})
 	}
 
+	if decision.Warning {
+		ctx.Response().Header().Set("Warning", `199 - "`+capacityExceededCode+`"`)
+	}
+
+	if err = s.meterAPIUsage(ctx, usage.OrdersCreated, 1); err != nil {
+		return respondMeteringError(ctx, err)
+	}
+
 	if handleErr := s.createOrderHandler.Handle(ctx.Request().Context(), cmd); handleErr != nil {
-		return ctx.JSON(http.StatusInternalServerError, servers.Error{
-			Code:    http.StatusInternalServerError,
-			Message: "Failed to create order",
-		})
+		if errors.Is(handleErr, commands.ErrOrderIsRejectedAsFraud) {
+			return ctx.JSON(http.StatusForbidden, servers.Error{
+				Code:    http.StatusForbidden,
+				Message: fraudRejectedCode,
+			})
+		}
+		if errors.Is(handleErr, order.ErrExternalReferenceIsAlreadyRegistered) {
+			return respondError(ctx, http.StatusConflict, i18n.ExternalOrderIsAlreadyLinked)
+		}
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToCreateOrder)
 	}
 
 	return ctx.NoContent(http.StatusCreated)
 }
 
-// GetOrders handles GET /api/v1/orders/active - retrieves all uncompleted orders.
-func (s *Server) GetOrders(ctx echo.Context) error {
+// UploadOrders handles POST /api/v1/orders/upload - creates orders from a CSV or XLSX file.
+// Rows are validated one by one; the response reports the outcome of every row.
+func (s *Server) UploadOrders(ctx echo.Context) error {
+	upload, err := ctx.FormFile(orderUploadField)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidOrderUpload, err.Error())
+	}
+	if upload.Size > maxOrderUploadSize {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidOrderUpload,
+			fmt.Sprintf("file is larger than %d bytes", maxOrderUploadSize))
+	}
+
+	src, err := upload.Open()
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidOrderUpload, err.Error())
+	}
+	defer func() {
+		_ = src.Close()
+	}()
+
+	rows, err := decodeOrderUpload(upload.Filename, src)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidOrderUpload, err.Error())
+	}
+
+	cmd, err := commands.NewImportOrdersCommand(rows)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidOrderUpload, err.Error())
+	}
+
+	decision, err := s.checkFleetCapacityHandler.Handle(ctx.Request().Context(), commands.NewCheckFleetCapacityCommand())
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToCheckFleetCapacity)
+	}
+
+	if decision.Rejected {
+		return ctx.JSON(http.StatusTooManyRequests, servers.Error{
+			Code:    http.StatusTooManyRequests,
+			Message: capacityExceededCode,
+		})
+	}
+
+	if decision.Warning {
+		ctx.Response().Header().Set("Warning", `199 - "`+capacityExceededCode+`"`)
+	}
+
+	if err = s.meterAPIUsage(ctx, usage.OrdersCreated, len(rows)); err != nil {
+		return respondMeteringError(ctx, err)
+	}
+
+	report, err := s.importOrdersHandler.Handle(ctx.Request().Context(), cmd)
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToImportOrders)
+	}
+
+	response := servers.OrderUploadReport{
+		Created: report.Created(),
+		Failed:  report.Failed(),
+		Rows:    make([]servers.OrderUploadRow, len(report.Results)),
+	}
+	for i, result := range report.Results {
+		row := servers.OrderUploadRow{Row: result.Line}
+		if result.OrderID != nil {
+			orderID := result.OrderID.Bytes()
+			underReview := result.UnderReview
+			row.OrderId = &orderID
+			row.UnderReview = &underReview
+		}
+		if result.Err != nil {
+			message := result.Err.Error()
+			row.Error = &message
+		}
+		response.Rows[i] = row
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// ListOrders handles GET /api/v1/orders - retrieves a page of orders in the requested statuses
+// together with the number of such orders.
+func (s *Server) ListOrders(ctx echo.Context, params servers.ListOrdersParams) error {
+	var statuses []order.Status
+	if params.Status != nil {
+		statuses = make([]order.Status, len(*params.Status))
+		for i, status := range *params.Status {
+			statuses[i] = fromAPIOrderStatus(status)
+		}
+	}
+	sort := queries.OldestFirst
+	if params.Sort != nil {
+		sort = fromAPIOrderSort(*params.Sort)
+	}
+	limit, offset := queries.DefaultOrdersPageLimit, 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	query, err := queries.NewGetOrdersPageQuery(statuses, sort, limit, offset)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidOrdersPageRequest, err.Error())
+	}
+
+	page, err := s.getOrdersPageHandler.Handle(ctx.Request().Context(), query)
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveOrders)
+	}
+
+	response := servers.OrderPage{
+		Orders: make([]servers.OrderSummary, len(page.Orders)),
+		Total:  page.Total,
+		Limit:  limit,
+		Offset: offset,
+	}
+	for i, summary := range page.Orders {
+		response.Orders[i] = servers.OrderSummary{
+			Id:            summary.ID.Bytes(),
+			Status:        toAPIOrderStatus(summary.Status),
+			Location:      servers.Location{X: int(summary.Location.X()), Y: int(summary.Location.Y())},
+			Volume:        summary.Volume,
+			Priority:      toAPIOrderPriority(summary.Priority),
+			DeliveryTier:  toAPIDeliveryTier(summary.DeliveryTier),
+			PaymentStatus: toAPIPaymentStatus(summary.PaymentStatus),
+			CreatedAt:     summary.CreatedAt,
+			CompletedAt:   summary.CompletedAt,
+		}
+		if summary.CourierID != nil {
+			courierID := openapi_types.UUID(summary.CourierID.Bytes())
+			response.Orders[i].CourierId = &courierID
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetOrders handles GET /api/v1/orders/active - retrieves all uncompleted orders,
+// trimmed to the requested fields.
+func (s *Server) GetOrders(ctx echo.Context, params servers.GetOrdersParams) error {
 	query := queries.NewGetUncompletedOrdersQuery()
 
 	orders, err := s.getUncompletedOrdersHandler.Handle(ctx.Request().Context(), query)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, servers.Error{
-			Code:    http.StatusInternalServerError,
-			Message: "Failed to retrieve orders",
-		})
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveOrders)
 	}
 
 	response := make([]servers.Order, len(orders))
 	for i, order := range orders {
 		googleUUID := order.ID.Bytes()
 
+		items := make([]servers.OrderItem, len(order.Items))
+		for j, line := range order.Items {
+			items[j] = servers.OrderItem{
+				Sku:        line.SKU,
+				Quantity:   line.Quantity,
+				UnitVolume: line.UnitVolume,
+			}
+		}
+
 		response[i] = servers.Order{
 			Id: googleUUID,
 			Location: servers.Location{
 				X: int(order.Location.X()),
 				Y: int(order.Location.Y()),
 			},
+			Volume:              order.Volume,
+			Items:               items,
+			PaymentMethod:       toAPIPaymentMethod(order.PaymentMethod),
+			PaymentStatus:       toAPIPaymentStatus(order.PaymentStatus),
+			DeliveryTier:        toAPIDeliveryTier(order.DeliveryTier),
+			BatchClosesAt:       order.BatchClosesAt,
+			DeclaredValue:       order.DeclaredValue,
+			InsuranceRequired:   order.InsuranceRequired,
+			PickupConfirmedAt:   order.PickupConfirmedAt,
+			DeliveryConfirmedAt: order.DeliveryConfirmedAt,
+			RouteDeviatedAt:     order.RouteDeviatedAt,
+		}
+	}
+
+	return respondWithFields(ctx, http.StatusOK, response, params.Fields)
+}
+
+// GetOrderByExternalReference handles GET /api/v1/orders/by-external/{marketplace}/{externalId} -
+// finds the order that fulfils a marketplace order, so partner systems can correlate their orders.
+func (s *Server) GetOrderByExternalReference(ctx echo.Context, marketplace string, externalID string) error {
+	query, err := queries.NewGetOrderByExternalReferenceQuery(marketplace, externalID)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidExternalReference, err.Error())
+	}
+
+	linked, err := s.getOrderByExternalReferenceHandler.Handle(ctx.Request().Context(), query)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			})
+		}
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveLinkedOrder)
+	}
+
+	response := servers.LinkedOrder{
+		Id:     linked.ID.Bytes(),
+		Status: toAPIOrderStatus(linked.Status),
+		ExternalReference: toAPIExternalReference(&queries.ExternalReferenceSnapshot{
+			Marketplace: linked.Marketplace,
+			OrderID:     linked.ExternalOrderID,
+			DeepLink:    linked.DeepLink,
+		}),
+	}
+	if linked.CourierID != nil {
+		courierID := openapi_types.UUID(linked.CourierID.Bytes())
+		response.CourierId = &courierID
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// SetCourierShift handles PUT /api/v1/couriers/{courierId}/shift - starts or ends a courier's shift.
+func (s *Server) SetCourierShift(ctx echo.Context, courierID openapi_types.UUID) error {
+	var body servers.CourierShift
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	courierUUID, err := kernel.UUIDFromBytes(courierID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	var proof *identity.Proof
+	if body.Verification != nil {
+		method, parseErr := identity.ParseMethod(string(body.Verification.Method))
+		if parseErr != nil {
+			return respondValidationError(ctx, i18n.InvalidShiftRequest, errs.JoinFields(errs.Field("method", parseErr)))
+		}
+		verification, proofErr := identity.NewProof(method, body.Verification.Secret)
+		if proofErr != nil {
+			return respondValidationError(ctx, i18n.InvalidShiftRequest, proofErr)
+		}
+		proof = &verification
+	}
+
+	cmd, err := commands.NewSetCourierShiftCommand(courierUUID, body.OnShift, proof)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidShiftRequest, err.Error())
+	}
+
+	if handleErr := s.setCourierShiftHandler.Handle(ctx.Request().Context(), cmd); handleErr != nil {
+		var tooMany *identity.TooManyAttemptsError
+		switch {
+		case errors.Is(handleErr, identity.ErrProofIsRequired):
+			return respondError(ctx, http.StatusForbidden, i18n.IdentityProofIsRequired)
+		case errors.Is(handleErr, identity.ErrVerificationFailed):
+			return respondError(ctx, http.StatusForbidden, i18n.IdentityVerificationFailed)
+		case errors.As(handleErr, &tooMany):
+			retryAfter := max(int(time.Until(tooMany.LockedUntil).Seconds()), 1)
+			ctx.Response().Header().Set("Retry-After", strconv.Itoa(retryAfter))
+			return respondError(ctx, http.StatusTooManyRequests, i18n.TooManyVerificationAttempts,
+				tooMany.LockedUntil.Format(time.RFC3339))
+		case errors.Is(handleErr, errs.ErrObjectNotFound):
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: handleErr.Error(),
+			})
+		case errors.Is(handleErr, courier.ErrDailyWorkingHoursExceeded):
+			return respondError(ctx, http.StatusConflict, i18n.DailyWorkingHoursExceeded)
+		case errors.Is(handleErr, courier.ErrShiftIsAlreadyStarted),
+			errors.Is(handleErr, courier.ErrShiftIsNotStarted),
+			errors.Is(handleErr, courier.ErrCourierIsDeactivated):
+			return ctx.JSON(http.StatusConflict, servers.Error{
+				Code:    http.StatusConflict,
+				Message: handleErr.Error(),
+			})
+		default:
+			return respondError(ctx, http.StatusInternalServerError, i18n.FailedToChangeShift)
+		}
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// SetCourierBreak handles PUT /api/v1/couriers/{courierId}/break - starts or ends a courier's break.
+func (s *Server) SetCourierBreak(ctx echo.Context, courierID openapi_types.UUID) error {
+	var body servers.CourierBreak
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	courierUUID, err := kernel.UUIDFromBytes(courierID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	cmd, err := commands.NewSetCourierBreakCommand(courierUUID, body.OnBreak)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	if handleErr := s.setCourierBreakHandler.Handle(ctx.Request().Context(), cmd); handleErr != nil {
+		switch {
+		case errors.Is(handleErr, errs.ErrObjectNotFound):
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: handleErr.Error(),
+			})
+		case errors.Is(handleErr, courier.ErrStatusTransitionIsInvalid),
+			errors.Is(handleErr, courier.ErrShiftIsNotStarted),
+			errors.Is(handleErr, courier.ErrCourierIsDeactivated):
+			return ctx.JSON(http.StatusConflict, servers.Error{
+				Code:    http.StatusConflict,
+				Message: handleErr.Error(),
+			})
+		default:
+			return respondError(ctx, http.StatusInternalServerError, i18n.FailedToChangeBreak)
+		}
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// RecordDeviceTelemetry handles POST /api/v1/couriers/{courierId}/device-telemetry - records
+// the battery and connectivity of a courier's device and returns the device health in the window.
+func (s *Server) RecordDeviceTelemetry(ctx echo.Context, courierID openapi_types.UUID) error {
+	var body servers.DeviceTelemetry
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	courierUUID, err := kernel.UUIDFromBytes(courierID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	connectivity, err := device.ParseConnectivity(string(body.Connectivity))
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidDeviceTelemetry, errs.JoinFields(errs.Field("connectivity", err)))
+	}
+
+	recordedAt := time.Now()
+	if body.RecordedAt != nil {
+		recordedAt = *body.RecordedAt
+	}
+
+	reading, err := device.NewReading(courierUUID, body.Battery, body.Charging, connectivity, recordedAt)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidDeviceTelemetry, err)
+	}
+
+	cmd, err := commands.NewRecordDeviceTelemetryCommand(reading)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidDeviceTelemetry, err)
+	}
+
+	health, err := s.recordDeviceTelemetryHandler.Handle(ctx.Request().Context(), cmd)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrObjectNotFound):
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			})
+		case errors.Is(err, errs.ErrValueIsInvalid):
+			return respondValidationError(ctx, i18n.InvalidDeviceTelemetry, err)
+		default:
+			return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRecordDeviceTelemetry)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, toAPIDeviceHealth(health))
+}
+
+// UpdateCourierLocation handles PUT /api/v1/couriers/{courierId}/location - moves the courier
+// to the position the courier app reported and records it in the courier's location history.
+func (s *Server) UpdateCourierLocation(ctx echo.Context, courierID openapi_types.UUID) error {
+	var body servers.CourierLocationReport
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	courierUUID, err := kernel.UUIDFromBytes(courierID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	location, err := fromAPILocation(body.Location)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidCourierLocation, errs.JoinFields(errs.Field("location", err)))
+	}
+
+	recordedAt := time.Now()
+	if body.RecordedAt != nil {
+		recordedAt = *body.RecordedAt
+	}
+
+	point, err := track.NewPoint(location, recordedAt)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidCourierLocation, err)
+	}
+
+	cmd, err := commands.NewUpdateCourierLocationCommand(courierUUID, point)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidCourierLocation, err)
+	}
+
+	if err = s.updateCourierLocationHandler.Handle(ctx.Request().Context(), cmd); err != nil {
+		switch {
+		case errors.Is(err, errs.ErrObjectNotFound):
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			})
+		case errors.Is(err, errs.ErrValidationFailed):
+			return respondValidationError(ctx, i18n.InvalidCourierLocation, err)
+		default:
+			return respondError(ctx, http.StatusInternalServerError, i18n.FailedToUpdateCourierLocation)
+		}
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// ImportCourierLocations handles POST /api/v1/couriers/{courierId}/locations/batch - merges the
+// positions a courier app recorded while offline into the courier's location history.
+func (s *Server) ImportCourierLocations(ctx echo.Context, courierID openapi_types.UUID) error {
+	var body servers.CourierLocationBatch
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	courierUUID, err := kernel.UUIDFromBytes(courierID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	points := make([]track.Point, 0, len(body.Points))
+	var validation errs.ValidationErrors
+	for i, p := range body.Points {
+		location, locationErr := fromAPILocation(p.Location)
+		if locationErr != nil {
+			validation.Add("points."+strconv.Itoa(i)+".location", locationErr)
+			continue
+		}
+		point, pointErr := track.NewPoint(location, p.RecordedAt)
+		if pointErr != nil {
+			validation.Add("points."+strconv.Itoa(i), pointErr)
+			continue
+		}
+		points = append(points, point)
+	}
+	if err = validation.Err(); err != nil {
+		return respondValidationError(ctx, i18n.InvalidCourierLocations, err)
+	}
+
+	cmd, err := commands.NewImportCourierLocationsCommand(courierUUID, points)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidCourierLocations, err)
+	}
+
+	report, err := s.importCourierLocationsHandler.Handle(ctx.Request().Context(), cmd)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrObjectNotFound):
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			})
+		case errors.Is(err, errs.ErrValidationFailed):
+			return respondValidationError(ctx, i18n.InvalidCourierLocations, err)
+		default:
+			return respondError(ctx, http.StatusInternalServerError, i18n.FailedToImportCourierLocations)
+		}
+	}
+
+	response := servers.CourierLocationImport{
+		Received:   report.Received,
+		Imported:   report.Imported,
+		Duplicates: report.Duplicates,
+		Days:       make([]servers.CourierDailyTrack, len(report.Days)),
+	}
+	for i, day := range report.Days {
+		response.Days[i] = servers.CourierDailyTrack{
+			Day:      day.Day().Format(time.DateOnly),
+			Points:   day.Points(),
+			Distance: day.Distance(),
+			FirstAt:  day.FirstAt(),
+			LastAt:   day.LastAt(),
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetDeviceHealth handles GET /api/v1/admin/couriers/device-health - reports the device health
+// of active couriers in the telemetry window, ordered by name.
+func (s *Server) GetDeviceHealth(ctx echo.Context) error {
+	report, err := s.getDeviceHealthHandler.Handle(ctx.Request().Context(), queries.NewGetDeviceHealthQuery())
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveDeviceHealth)
+	}
+
+	response := make([]servers.CourierDeviceHealth, len(report))
+	for i, courierHealth := range report {
+		response[i] = servers.CourierDeviceHealth{
+			CourierId:   courierHealth.CourierID.Bytes(),
+			CourierName: courierHealth.CourierName,
+			Health:      toAPIDeviceHealth(courierHealth.Health),
 		}
 	}
 
 	return ctx.JSON(http.StatusOK, response)
 }
+
+// toAPIDeviceHealth maps the device health to the API representation, leaving the latest
+// reading out when the device reported nothing within the window.
+func toAPIDeviceHealth(health device.Health) servers.DeviceHealth {
+	response := servers.DeviceHealth{
+		Reported:         health.Reported,
+		LowBattery:       health.LowBattery,
+		PoorConnectivity: health.PoorConnectivity,
+		Degraded:         health.IsDegraded(),
+	}
+	if health.Reported {
+		connectivity := servers.Connectivity(health.Connectivity.String())
+		response.Battery = &health.Battery
+		response.Charging = &health.Charging
+		response.Connectivity = &connectivity
+		response.LastSeenAt = &health.LastSeenAt
+	}
+	return response
+}
+
+// GetCourierWorkingHours handles GET /api/v1/admin/couriers/working-hours
+// - reports today's on-shift time of active couriers against the daily limit.
+func (s *Server) GetCourierWorkingHours(ctx echo.Context) error {
+	report, err := s.getCourierWorkingHoursHandler.Handle(ctx.Request().Context(), queries.NewGetCourierWorkingHoursQuery())
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveWorkingHours)
+	}
+
+	response := make([]servers.CourierWorkingHours, len(report))
+	for i, hours := range report {
+		response[i] = servers.CourierWorkingHours{
+			CourierId:     hours.ID.Bytes(),
+			Name:          hours.Name,
+			WorkedMinutes: int(hours.Worked / time.Minute),
+			LimitMinutes:  int(hours.Limit / time.Minute),
+			OnShift:       hours.OnShift,
+			Status:        toAPIWorkingHoursStatus(hours.Status),
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// SetStoragePlaceMaintenance handles PUT /api/v1/admin/couriers/{courierId}/storage-places/{storagePlaceId}/maintenance
+// - takes a storage place out of service or returns it to service.
+func (s *Server) SetStoragePlaceMaintenance(
+	ctx echo.Context,
+	courierID openapi_types.UUID,
+	storagePlaceID openapi_types.UUID,
+) error {
+	var body servers.StoragePlaceMaintenance
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	courierUUID, courierErr := kernel.UUIDFromBytes(courierID[:])
+	storagePlaceUUID, storagePlaceErr := kernel.UUIDFromBytes(storagePlaceID[:])
+	if err := errors.Join(courierErr, storagePlaceErr); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	cmd, err := commands.NewSetStoragePlaceMaintenanceCommand(courierUUID, storagePlaceUUID, body.OutOfService)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidMaintenanceRequest, err.Error())
+	}
+
+	if handleErr := s.setStoragePlaceMaintenanceHandler.Handle(ctx.Request().Context(), cmd); handleErr != nil {
+		switch {
+		case errors.Is(handleErr, errs.ErrObjectNotFound), errors.Is(handleErr, courier.ErrStoragePlaceNotFound):
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: handleErr.Error(),
+			})
+		case errors.Is(handleErr, courier.ErrStoragePlaceIsOccupied):
+			return respondError(ctx, http.StatusConflict, i18n.StoragePlaceIsOccupied)
+		default:
+			return respondError(ctx, http.StatusInternalServerError, i18n.FailedToChangeMaintenance)
+		}
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// DeactivateCourier handles POST /api/v1/admin/couriers/{courierId}/deactivation
+// - takes a courier out of service and redistributes their active orders.
+func (s *Server) DeactivateCourier(ctx echo.Context, courierID openapi_types.UUID) error {
+	var body servers.CourierDeactivation
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	courierUUID, err := kernel.UUIDFromBytes(courierID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	cmd, err := commands.NewDeactivateCourierCommand(courierUUID, fromAPIDeactivationReason(body.Reason))
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidDeactivationRequest, err.Error())
+	}
+
+	report, handleErr := s.deactivateCourierHandler.Handle(ctx.Request().Context(), cmd)
+	if handleErr != nil {
+		switch {
+		case errors.Is(handleErr, errs.ErrObjectNotFound):
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: handleErr.Error(),
+			})
+		case errors.Is(handleErr, courier.ErrCourierIsDeactivated):
+			return ctx.JSON(http.StatusConflict, servers.Error{
+				Code:    http.StatusConflict,
+				Message: handleErr.Error(),
+			})
+		default:
+			return respondError(ctx, http.StatusInternalServerError, i18n.FailedToDeactivateCourier)
+		}
+	}
+
+	response := servers.CourierDeactivationResult{
+		Reassigned: make([]servers.OrderReassignment, len(report.Reassigned)),
+		Requeued:   make([]openapi_types.UUID, len(report.Requeued)),
+	}
+	for i, reassignment := range report.Reassigned {
+		response.Reassigned[i] = servers.OrderReassignment{
+			OrderId:   reassignment.OrderID.Bytes(),
+			CourierId: reassignment.CourierID.Bytes(),
+		}
+	}
+	for i, orderID := range report.Requeued {
+		response.Requeued[i] = orderID.Bytes()
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// UpdateCourierProfile handles PUT /api/v1/admin/couriers/{courierId}/profile
+// - replaces the courier's photo, phone and vehicle plate.
+func (s *Server) UpdateCourierProfile(ctx echo.Context, courierID openapi_types.UUID) error {
+	var body servers.CourierProfile
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	courierUUID, err := kernel.UUIDFromBytes(courierID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	profile, err := courier.NewProfile(
+		valueOrEmpty(body.PhotoUrl),
+		valueOrEmpty(body.Phone),
+		valueOrEmpty(body.VehiclePlate),
+	)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidCourierProfile, err)
+	}
+	if body.VehicleType != nil {
+		profile, err = profile.WithVehicleType(fromAPIVehicleType(*body.VehicleType))
+		if err != nil {
+			return respondValidationError(ctx, i18n.InvalidCourierProfile, errs.JoinFields(errs.Field("vehicleType", err)))
+		}
+	}
+
+	cmd, err := commands.NewUpdateCourierProfileCommand(courierUUID, profile)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidCourierProfile, err.Error())
+	}
+
+	updated, handleErr := s.updateCourierProfileHandler.Handle(ctx.Request().Context(), cmd)
+	if handleErr != nil {
+		if errors.Is(handleErr, errs.ErrObjectNotFound) {
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: handleErr.Error(),
+			})
+		}
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToUpdateCourierProfile)
+	}
+
+	return ctx.JSON(http.StatusOK, toAPICourierProfile(updated))
+}
+
+// MergeCouriers handles POST /api/v1/admin/couriers/{courierId}/merges
+// - merges a duplicate courier record into the courier, or previews the merge.
+func (s *Server) MergeCouriers(ctx echo.Context, courierID openapi_types.UUID) error {
+	var body servers.CourierMergeRequest
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	courierUUID, err := kernel.UUIDFromBytes(courierID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+	duplicateUUID, err := kernel.UUIDFromBytes(body.DuplicateId[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	cmd, err := commands.NewMergeCouriersCommand(courierUUID, duplicateUUID, body.DryRun != nil && *body.DryRun)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidCourierMerge, err)
+	}
+
+	merge, handleErr := s.mergeCouriersHandler.Handle(ctx.Request().Context(), cmd)
+	if handleErr != nil {
+		switch {
+		case errors.Is(handleErr, errs.ErrObjectNotFound):
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: handleErr.Error(),
+			})
+		case errors.Is(handleErr, courier.ErrCourierIsMergedWithItself),
+			errors.Is(handleErr, courier.ErrCourierIsDeactivated),
+			errors.Is(handleErr, courier.ErrDuplicateCourierIsOnShift),
+			errors.Is(handleErr, courier.ErrDuplicateCourierCarriesOrders),
+			errors.Is(handleErr, courier.ErrDuplicateCourierHasPlans),
+			errors.Is(handleErr, courier.ErrSubstituteIsAbsentCourier),
+			errors.Is(handleErr, courier.ErrExternalIDIsAlreadyLinked),
+			errors.Is(handleErr, errs.ErrConcurrencyConflict):
+			return ctx.JSON(http.StatusConflict, servers.Error{
+				Code:    http.StatusConflict,
+				Message: handleErr.Error(),
+			})
+		default:
+			return respondError(ctx, http.StatusInternalServerError, i18n.FailedToMergeCouriers)
+		}
+	}
+
+	records := merge.Records
+	return ctx.JSON(http.StatusOK, servers.CourierMerge{
+		CourierId:              openapi_types.UUID(merge.Courier.ID().Bytes()),
+		DryRun:                 merge.DryRun,
+		Orders:                 records.Orders,
+		StoragePlaces:          records.StoragePlaces,
+		Earnings:               records.Earnings,
+		Handovers:              records.Handovers,
+		AssignmentExplanations: records.AssignmentExplanations,
+		AnnouncementDeliveries: records.AnnouncementDeliveries,
+		Substitutions:          records.Substitutions,
+		LocationPoints:         records.LocationPoints,
+		DailyTracks:            records.DailyTracks,
+		DeviceReadings:         records.DeviceReadings,
+		VerificationAttempts:   records.VerificationAttempts,
+	})
+}
+
+// GetCourierMaintenanceWindows handles GET /api/v1/admin/couriers/{courierId}/maintenance-windows
+// - lists the courier's vehicle maintenance windows with their status.
+func (s *Server) GetCourierMaintenanceWindows(ctx echo.Context, courierID openapi_types.UUID) error {
+	courierUUID, err := kernel.UUIDFromBytes(courierID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	query, err := queries.NewGetCourierMaintenanceWindowsQuery(courierUUID)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	windows, err := s.getCourierMaintenanceWindowsHandler.Handle(ctx.Request().Context(), query)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			})
+		}
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveMaintenance)
+	}
+
+	response := make([]servers.MaintenanceWindow, len(windows))
+	for i, window := range windows {
+		response[i] = servers.MaintenanceWindow{
+			Id:       window.ID.Bytes(),
+			StartsAt: window.StartsAt,
+			EndsAt:   window.EndsAt,
+			Status:   toAPIMaintenanceStatus(window.Status),
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// ScheduleCourierMaintenance handles POST /api/v1/admin/couriers/{courierId}/maintenance-windows
+// - puts a vehicle maintenance window into the courier's calendar.
+func (s *Server) ScheduleCourierMaintenance(ctx echo.Context, courierID openapi_types.UUID) error {
+	var body servers.MaintenanceWindowSchedule
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	courierUUID, err := kernel.UUIDFromBytes(courierID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	cmd, err := commands.NewScheduleCourierMaintenanceCommand(courierUUID, body.StartsAt, body.EndsAt)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	window, err := s.scheduleCourierMaintenanceHandler.Handle(ctx.Request().Context(), cmd)
+	if err != nil {
+		return respondMaintenanceError(ctx, err, i18n.FailedToScheduleMaintenance)
+	}
+
+	return ctx.JSON(http.StatusCreated, toAPIMaintenanceWindow(window, time.Now()))
+}
+
+// RescheduleCourierMaintenance handles PUT /api/v1/admin/couriers/{courierId}/maintenance-windows/{windowId}
+// - moves a maintenance window to another time.
+func (s *Server) RescheduleCourierMaintenance(
+	ctx echo.Context,
+	courierID openapi_types.UUID,
+	windowID openapi_types.UUID,
+) error {
+	var body servers.MaintenanceWindowSchedule
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	courierUUID, err := kernel.UUIDFromBytes(courierID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+	windowUUID, err := kernel.UUIDFromBytes(windowID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	cmd, err := commands.NewRescheduleCourierMaintenanceCommand(courierUUID, windowUUID, body.StartsAt, body.EndsAt)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	window, err := s.rescheduleCourierMaintenanceHandler.Handle(ctx.Request().Context(), cmd)
+	if err != nil {
+		return respondMaintenanceError(ctx, err, i18n.FailedToScheduleMaintenance)
+	}
+
+	return ctx.JSON(http.StatusOK, toAPIMaintenanceWindow(window, time.Now()))
+}
+
+// CancelCourierMaintenance handles DELETE /api/v1/admin/couriers/{courierId}/maintenance-windows/{windowId}
+// - removes a maintenance window from the courier's calendar.
+func (s *Server) CancelCourierMaintenance(
+	ctx echo.Context,
+	courierID openapi_types.UUID,
+	windowID openapi_types.UUID,
+) error {
+	courierUUID, err := kernel.UUIDFromBytes(courierID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+	windowUUID, err := kernel.UUIDFromBytes(windowID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	cmd, err := commands.NewCancelCourierMaintenanceCommand(courierUUID, windowUUID)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	if err = s.cancelCourierMaintenanceHandler.Handle(ctx.Request().Context(), cmd); err != nil {
+		return respondMaintenanceError(ctx, err, i18n.FailedToCancelMaintenance)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// respondMaintenanceError maps errors of the maintenance commands to responses:
+// unknown couriers and windows are 404, windows the calendar refuses are 409.
+func respondMaintenanceError(ctx echo.Context, err error, failure i18n.MessageKey) error {
+	switch {
+	case errors.Is(err, errs.ErrObjectNotFound),
+		errors.Is(err, courier.ErrMaintenanceWindowNotFound):
+		return ctx.JSON(http.StatusNotFound, servers.Error{
+			Code:    http.StatusNotFound,
+			Message: err.Error(),
+		})
+	case errors.Is(err, courier.ErrMaintenanceWindowOverlaps),
+		errors.Is(err, courier.ErrMaintenanceWindowIsOver),
+		errors.Is(err, courier.ErrMaintenanceOverlapsAssignment):
+		return ctx.JSON(http.StatusConflict, servers.Error{
+			Code:    http.StatusConflict,
+			Message: err.Error(),
+		})
+	case errors.Is(err, errs.ErrValidationFailed):
+		return respondValidationError(ctx, i18n.InvalidMaintenanceWindow, err)
+	default:
+		return respondError(ctx, http.StatusInternalServerError, failure)
+	}
+}
+
+// toAPIAbsences maps the absences of a courier read model to the API representation.
+func toAPIAbsences(absences []queries.CourierAbsence) []servers.CourierAbsence {
+	response := make([]servers.CourierAbsence, len(absences))
+	for i, absence := range absences {
+		response[i] = servers.CourierAbsence{
+			Id:           absence.ID.Bytes(),
+			StartsAt:     absence.StartsAt,
+			EndsAt:       absence.EndsAt,
+			SubstituteId: absence.SubstituteID.Bytes(),
+		}
+	}
+	return response
+}
+
+// PlanCourierAbsence handles POST /api/v1/admin/couriers/{courierId}/absences
+// - plans a leave of the courier with a substitute who takes over their orders.
+func (s *Server) PlanCourierAbsence(ctx echo.Context, courierID openapi_types.UUID) error {
+	var body servers.CourierAbsencePlan
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	courierUUID, err := kernel.UUIDFromBytes(courierID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+	substituteUUID, err := kernel.UUIDFromBytes(body.SubstituteId[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	cmd, err := commands.NewPlanCourierAbsenceCommand(courierUUID, body.StartsAt, body.EndsAt, substituteUUID)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	absence, err := s.planCourierAbsenceHandler.Handle(ctx.Request().Context(), cmd)
+	if err != nil {
+		return respondAbsenceError(ctx, err, i18n.FailedToPlanAbsence)
+	}
+
+	return ctx.JSON(http.StatusCreated, servers.CourierAbsence{
+		Id:           absence.ID().Bytes(),
+		StartsAt:     absence.StartsAt(),
+		EndsAt:       absence.EndsAt(),
+		SubstituteId: absence.SubstituteID().Bytes(),
+	})
+}
+
+// CancelCourierAbsence handles DELETE /api/v1/admin/couriers/{courierId}/absences/{absenceId}
+// - cancels a planned or ongoing absence of the courier.
+func (s *Server) CancelCourierAbsence(
+	ctx echo.Context,
+	courierID openapi_types.UUID,
+	absenceID openapi_types.UUID,
+) error {
+	courierUUID, err := kernel.UUIDFromBytes(courierID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+	absenceUUID, err := kernel.UUIDFromBytes(absenceID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	cmd, err := commands.NewCancelCourierAbsenceCommand(courierUUID, absenceUUID)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	if err = s.cancelCourierAbsenceHandler.Handle(ctx.Request().Context(), cmd); err != nil {
+		return respondAbsenceError(ctx, err, i18n.FailedToCancelAbsence)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// respondAbsenceError maps errors of the absence commands to responses: unknown couriers and
+// absences are 404, absences that conflict with the calendars of either courier are 409.
+func respondAbsenceError(ctx echo.Context, err error, failure i18n.MessageKey) error {
+	switch {
+	case errors.Is(err, errs.ErrObjectNotFound),
+		errors.Is(err, courier.ErrAbsenceNotFound):
+		return ctx.JSON(http.StatusNotFound, servers.Error{
+			Code:    http.StatusNotFound,
+			Message: err.Error(),
+		})
+	case errors.Is(err, courier.ErrAbsenceOverlaps),
+		errors.Is(err, courier.ErrAbsenceIsOver),
+		errors.Is(err, courier.ErrSubstituteIsAbsentCourier),
+		errors.Is(err, courier.ErrSubstituteIsUnavailable),
+		errors.Is(err, courier.ErrAbsentCourierIsSubstitute):
+		return ctx.JSON(http.StatusConflict, servers.Error{
+			Code:    http.StatusConflict,
+			Message: err.Error(),
+		})
+	case errors.Is(err, errs.ErrValidationFailed):
+		return respondValidationError(ctx, i18n.InvalidAbsence, err)
+	default:
+		return respondError(ctx, http.StatusInternalServerError, failure)
+	}
+}
+
+// SetCourierSchedule handles PUT /api/v1/admin/couriers/{courierId}/schedule
+// - replaces the weekly windows the courier is dispatched in.
+func (s *Server) SetCourierSchedule(ctx echo.Context, courierID openapi_types.UUID) error {
+	var body servers.CourierScheduleChange
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	courierUUID, err := kernel.UUIDFromBytes(courierID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	windows, err := fromAPIScheduleWindows(body.Windows)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidCourierSchedule, err)
+	}
+
+	cmd, err := commands.NewSetCourierScheduleCommand(courierUUID, windows)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	schedule, err := s.setCourierScheduleHandler.Handle(ctx.Request().Context(), cmd)
+	if err != nil {
+		return respondScheduleError(ctx, err, i18n.FailedToSetCourierSchedule)
+	}
+
+	return ctx.JSON(http.StatusOK, servers.CourierSchedule{
+		Id:      schedule.ID().Bytes(),
+		Windows: toAPIScheduleWindows(schedule.Windows()),
+	})
+}
+
+// ClearCourierSchedule handles DELETE /api/v1/admin/couriers/{courierId}/schedule
+// - lets the courier be dispatched whenever on shift again.
+func (s *Server) ClearCourierSchedule(ctx echo.Context, courierID openapi_types.UUID) error {
+	courierUUID, err := kernel.UUIDFromBytes(courierID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	cmd, err := commands.NewClearCourierScheduleCommand(courierUUID)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	if err = s.clearCourierScheduleHandler.Handle(ctx.Request().Context(), cmd); err != nil {
+		return respondScheduleError(ctx, err, i18n.FailedToClearCourierSchedule)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// ClearCourierReviewFlag handles DELETE /api/v1/admin/couriers/{courierId}/review-flag
+// - returns a courier flagged by the inactivity watchdog to dispatch after a dispatcher reviewed it.
+func (s *Server) ClearCourierReviewFlag(ctx echo.Context, courierID openapi_types.UUID) error {
+	courierUUID, err := kernel.UUIDFromBytes(courierID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	cmd, err := commands.NewClearCourierReviewFlagCommand(courierUUID)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	if err = s.clearCourierReviewFlagHandler.Handle(ctx.Request().Context(), cmd); err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			})
+		}
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToClearCourierReviewFlag)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// respondScheduleError maps errors of the schedule commands to responses:
+// unknown couriers are 404, invalid and overlapping windows are 400.
+func respondScheduleError(ctx echo.Context, err error, failure i18n.MessageKey) error {
+	switch {
+	case errors.Is(err, errs.ErrObjectNotFound):
+		return ctx.JSON(http.StatusNotFound, servers.Error{
+			Code:    http.StatusNotFound,
+			Message: err.Error(),
+		})
+	case errors.Is(err, courier.ErrScheduleWindowsOverlap),
+		errors.Is(err, errs.ErrValueIsRequired),
+		errors.Is(err, errs.ErrValidationFailed):
+		return respondValidationError(ctx, i18n.InvalidCourierSchedule, err)
+	default:
+		return respondError(ctx, http.StatusInternalServerError, failure)
+	}
+}
+
+// SetCourierInsurance handles PUT /api/v1/admin/couriers/{courierId}/insurance
+// - insures a courier for high-value orders or revokes the insurance.
+func (s *Server) SetCourierInsurance(ctx echo.Context, courierID openapi_types.UUID) error {
+	var body servers.CourierInsurance
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	courierUUID, err := kernel.UUIDFromBytes(courierID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	cmd, err := commands.NewSetCourierInsuranceCommand(courierUUID, body.Insured)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	if handleErr := s.setCourierInsuranceHandler.Handle(ctx.Request().Context(), cmd); handleErr != nil {
+		if errors.Is(handleErr, errs.ErrObjectNotFound) {
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: handleErr.Error(),
+			})
+		}
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToChangeInsurance)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// PurgeSyntheticData handles POST /api/v1/admin/synthetic-data/purge
+// - removes expired test data of the request's tenant.
+func (s *Server) PurgeSyntheticData(ctx echo.Context) error {
+	var body servers.SyntheticDataPurge
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	cmd, err := commands.NewPurgeSyntheticDataCommand(time.Duration(body.OlderThanSeconds) * time.Second)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidSyntheticDataPurge, err.Error())
+	}
+
+	purge, err := s.purgeSyntheticDataHandler.Handle(ctx.Request().Context(), cmd)
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToPurgeSyntheticData)
+	}
+
+	return ctx.JSON(http.StatusOK, servers.SyntheticDataPurgeResult{
+		Orders:   purge.Orders,
+		Couriers: purge.Couriers,
+	})
+}
+
+// SetRolloutPercentage handles PUT /api/v1/admin/rollouts/{flag} - changes the share of a staged rollout.
+func (s *Server) SetRolloutPercentage(ctx echo.Context, flag string) error {
+	var body servers.RolloutChange
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	cmd, err := commands.NewSetRolloutPercentageCommand(flag, body.Percentage)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRolloutChange, err.Error())
+	}
+
+	if handleErr := s.setRolloutPercentageHandler.Handle(ctx.Request().Context(), cmd); handleErr != nil {
+		if errors.Is(handleErr, errs.ErrObjectNotFound) {
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: handleErr.Error(),
+			})
+		}
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToChangeRollout)
+	}
+
+	return ctx.JSON(http.StatusOK, servers.Rollout{
+		Flag:       cmd.Flag(),
+		Percentage: int(cmd.Percentage()),
+	})
+}
+
+// GetSurgeMode handles GET /api/v1/admin/surge - returns the surge mode with its latest toggles.
+func (s *Server) GetSurgeMode(ctx echo.Context) error {
+	state, err := s.getSurgeModeHandler.Handle(ctx.Request().Context(), queries.NewGetSurgeModeQuery())
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveSurgeMode)
+	}
+
+	response := servers.SurgeMode{
+		Setting: servers.SurgeSetting(state.Setting.String()),
+		Active:  state.Active,
+		Toggles: make([]servers.SurgeToggle, len(state.Toggles)),
+	}
+	for i, toggle := range state.Toggles {
+		response.Toggles[i] = servers.SurgeToggle{
+			Id:        toggle.ID.Bytes(),
+			Source:    servers.SurgeToggleSource(toggle.Source.String()),
+			Setting:   servers.SurgeSetting(toggle.Setting.String()),
+			WasActive: toggle.WasActive,
+			Active:    toggle.Active,
+			Backlog:   toggle.Backlog,
+			ToggledAt: toggle.ToggledAt,
+		}
+		if toggle.Reason != "" {
+			reason := toggle.Reason
+			response.Toggles[i].Reason = &reason
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// ChangeSurgeMode handles PUT /api/v1/admin/surge - switches the surge mode on or off, or back
+// to auto, and invites the off-shift couriers when the surge mode activates.
+func (s *Server) ChangeSurgeMode(ctx echo.Context) error {
+	var body servers.SurgeModeChange
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	cmd, err := commands.NewChangeSurgeModeCommand(string(body.Setting), body.Reason)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidSurgeChange, err)
+	}
+
+	change, err := s.changeSurgeModeHandler.Handle(ctx.Request().Context(), cmd)
+	if err != nil {
+		if errors.Is(err, errs.ErrValidationFailed) {
+			return respondValidationError(ctx, i18n.InvalidSurgeChange, err)
+		}
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToChangeSurgeMode)
+	}
+
+	return ctx.JSON(http.StatusOK, servers.SurgeModeChangeResult{
+		Toggle:    toAPISurgeToggle(change.Toggle),
+		Invited:   change.Invited,
+		Uninvited: change.Uninvited,
+	})
+}
+
+// BroadcastAnnouncement handles POST /api/v1/admin/announcements
+// - sends an announcement to the couriers on shift, optionally only to those inside a zone.
+func (s *Server) BroadcastAnnouncement(ctx echo.Context) error {
+	var body servers.NewAnnouncement
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	zone, err := fromAPIZone(body.Zone)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidAnnouncement, err)
+	}
+
+	cmd, err := commands.NewBroadcastAnnouncementCommand(body.Text, zone)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidAnnouncement, err)
+	}
+
+	sent, err := s.broadcastAnnouncementHandler.Handle(ctx.Request().Context(), cmd)
+	if err != nil {
+		if errors.Is(err, errs.ErrValidationFailed) {
+			return respondValidationError(ctx, i18n.InvalidAnnouncement, err)
+		}
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToBroadcastAnnouncement)
+	}
+
+	response := servers.Announcement{
+		Id:         sent.ID().Bytes(),
+		Text:       sent.Text(),
+		PostedAt:   sent.PostedAt(),
+		Deliveries: make([]servers.AnnouncementDelivery, 0),
+	}
+	if zone, ok := sent.Zone(); ok {
+		response.Zone = toAPIZone(zone)
+	}
+	for _, delivery := range sent.Deliveries() {
+		response.Deliveries = append(response.Deliveries, toAPIAnnouncementDelivery(
+			delivery.CourierID(), delivery.Status(), delivery.ChangedAt(), delivery.Failure()))
+		switch delivery.Status() { //nolint:exhaustive // deliveries are never created with an unknown status
+		case announcement.Delivered:
+			response.Delivered++
+		case announcement.Failed:
+			response.Failed++
+		case announcement.Pending:
+			response.Pending++
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, response)
+}
+
+// GetAnnouncements handles GET /api/v1/admin/announcements - lists the latest announcements
+// with their delivery to every recipient.
+func (s *Server) GetAnnouncements(ctx echo.Context) error {
+	history, err := s.getAnnouncementsHandler.Handle(ctx.Request().Context(), queries.NewGetAnnouncementsQuery())
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveAnnouncements)
+	}
+
+	response := make([]servers.Announcement, len(history))
+	for i, item := range history {
+		response[i] = servers.Announcement{
+			Id:         item.ID.Bytes(),
+			Text:       item.Text,
+			PostedAt:   item.PostedAt,
+			Delivered:  item.Delivered,
+			Failed:     item.Failed,
+			Pending:    item.Pending,
+			Deliveries: make([]servers.AnnouncementDelivery, len(item.Deliveries)),
+		}
+		if item.Zone != nil {
+			response[i].Zone = toAPIZone(*item.Zone)
+		}
+		for j, delivery := range item.Deliveries {
+			response[i].Deliveries[j] = toAPIAnnouncementDelivery(
+				delivery.CourierID, delivery.Status, delivery.ChangedAt, delivery.Failure)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// CreatePickupSlot handles POST /api/v1/admin/pickup-slots - opens a pickup slot at the warehouse.
+func (s *Server) CreatePickupSlot(ctx echo.Context) error {
+	var body servers.NewPickupSlot
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	cmd := commands.NewCreatePickupSlotCommand(body.StartsAt, body.EndsAt, body.Capacity)
+
+	slot, err := s.createPickupSlotHandler.Handle(ctx.Request().Context(), cmd)
+	if err != nil {
+		if errors.Is(err, errs.ErrValidationFailed) {
+			return respondValidationError(ctx, i18n.InvalidPickupSlot, err)
+		}
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToCreatePickupSlot)
+	}
+
+	return ctx.JSON(http.StatusCreated, toAPIPickupSlot(slot))
+}
+
+// ChangePickupSlotCapacity handles PUT /api/v1/admin/pickup-slots/{slotId}/capacity
+// - changes how many orders the warehouse hands out in a slot.
+func (s *Server) ChangePickupSlotCapacity(ctx echo.Context, slotID openapi_types.UUID) error {
+	var body servers.PickupSlotCapacity
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	slotUUID, err := kernel.UUIDFromBytes(slotID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	cmd, err := commands.NewChangePickupSlotCapacityCommand(slotUUID, body.Capacity)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidPickupSlot, err)
+	}
+
+	slot, err := s.changePickupSlotCapacityHandler.Handle(ctx.Request().Context(), cmd)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrObjectNotFound):
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			})
+		case errors.Is(err, pickup.ErrCapacityIsBelowBookings):
+			return respondError(ctx, http.StatusConflict, i18n.PickupSlotCapacityBelowBookings)
+		case errors.Is(err, errs.ErrValidationFailed):
+			return respondValidationError(ctx, i18n.InvalidPickupSlot, err)
+		default:
+			return respondError(ctx, http.StatusInternalServerError, i18n.FailedToChangePickupSlot)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, toAPIPickupSlot(slot))
+}
+
+// GetPickupSlots handles GET /api/v1/admin/pickup-slots - lists the pickup slots that are not over
+// with their occupancy.
+func (s *Server) GetPickupSlots(ctx echo.Context) error {
+	slots, err := s.getPickupSlotsHandler.Handle(ctx.Request().Context(), queries.NewGetPickupSlotsQuery())
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrievePickupSlots)
+	}
+
+	response := make([]servers.PickupSlot, len(slots))
+	for i, slot := range slots {
+		response[i] = servers.PickupSlot{
+			Id:        slot.ID.Bytes(),
+			StartsAt:  slot.StartsAt,
+			EndsAt:    slot.EndsAt,
+			Capacity:  slot.Capacity,
+			Booked:    slot.Booked,
+			Remaining: slot.Remaining,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetMicrozones handles GET /api/v1/admin/microzones - retrieves the dense-demand microzones, busiest first.
+func (s *Server) GetMicrozones(ctx echo.Context) error {
+	microzones, err := s.getMicrozonesHandler.Handle(ctx.Request().Context(), queries.NewGetMicrozonesQuery())
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveMicrozones)
+	}
+
+	response := make([]servers.Microzone, len(microzones))
+	for i, m := range microzones {
+		response[i] = servers.Microzone{
+			Id: m.ID.Bytes(),
+			Bounds: servers.Zone{
+				From: servers.Location{X: m.FromX, Y: m.FromY},
+				To:   servers.Location{X: m.ToX, Y: m.ToY},
+			},
+			Centroid:   servers.Location{X: m.CentroidX, Y: m.CentroidY},
+			Deliveries: m.Deliveries,
+			ComputedAt: m.ComputedAt,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// RecomputeMicrozones handles POST /api/v1/admin/microzones/recompute - recomputes the microzones
+// of the request's tenant without waiting for the nightly job.
+func (s *Server) RecomputeMicrozones(ctx echo.Context) error {
+	computed, err := s.recomputeMicrozonesHandler.Handle(ctx.Request().Context(), commands.NewRecomputeMicrozonesCommand())
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRecomputeMicrozones)
+	}
+
+	return ctx.JSON(http.StatusOK, servers.MicrozoneRecomputation{Microzones: computed})
+}
+
+// GetSLATargets handles GET /api/v1/admin/sla/targets - retrieves the SLA targets of the districts.
+func (s *Server) GetSLATargets(ctx echo.Context) error {
+	targets, err := s.getSLATargetsHandler.Handle(ctx.Request().Context(), queries.NewGetSLATargetsQuery())
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveSLATargets)
+	}
+
+	response := make([]servers.SLATarget, len(targets))
+	for i, t := range targets {
+		response[i] = servers.SLATarget{
+			Id:       t.ID.Bytes(),
+			District: t.District,
+			Zone: servers.Zone{
+				From: servers.Location{X: t.FromX, Y: t.FromY},
+				To:   servers.Location{X: t.ToX, Y: t.ToY},
+			},
+			DeliveryTier:    toAPIDeliveryTier(t.Tier),
+			DeliveryMinutes: int(t.DeliveryTime / time.Minute),
+			Goal:            t.Goal,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// ReplaceSLATargets handles PUT /api/v1/admin/sla/targets - replaces the SLA targets of the districts
+// as a whole; the nightly job measures the new targets from the next day on.
+func (s *Server) ReplaceSLATargets(ctx echo.Context) error {
+	var body servers.SLATargetsChange
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	specs := make([]commands.SLATargetSpec, 0, len(body.Targets))
+	var validation errs.ValidationErrors
+	for i, target := range body.Targets {
+		zone, err := fromAPIZone(&target.Zone)
+		if err != nil {
+			validation.Add("targets."+strconv.Itoa(i), err)
+			continue
+		}
+		specs = append(specs, commands.SLATargetSpec{
+			District:     target.District,
+			Zone:         *zone,
+			Tier:         fromAPIDeliveryTier(target.DeliveryTier),
+			DeliveryTime: time.Duration(target.DeliveryMinutes) * time.Minute,
+			Goal:         target.Goal,
+		})
+	}
+	if err := validation.Err(); err != nil {
+		return respondValidationError(ctx, i18n.InvalidSLATargets, err)
+	}
+
+	cmd, err := commands.NewReplaceSLATargetsCommand(specs)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidSLATargets, err)
+	}
+
+	targets, err := s.replaceSLATargetsHandler.Handle(ctx.Request().Context(), cmd)
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToReplaceSLATargets)
+	}
+
+	response := make([]servers.SLATarget, len(targets))
+	for i, t := range targets {
+		response[i] = servers.SLATarget{
+			Id:              t.ID().Bytes(),
+			District:        t.District(),
+			Zone:            *toAPIZone(t.Zone()),
+			DeliveryTier:    toAPIDeliveryTier(t.Tier()),
+			DeliveryMinutes: int(t.DeliveryTime() / time.Minute),
+			Goal:            t.Goal(),
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetMatchingRules handles GET /api/v1/admin/dispatch/matching-rules - retrieves the dispatch matching rules.
+func (s *Server) GetMatchingRules(ctx echo.Context) error {
+	rules, err := s.getMatchingRulesHandler.Handle(ctx.Request().Context(), queries.NewGetMatchingRulesQuery())
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveMatchingRules)
+	}
+
+	response := make([]servers.MatchingRule, len(rules))
+	for i, r := range rules {
+		response[i] = servers.MatchingRule{
+			Id:             r.ID.Bytes(),
+			Name:           r.Name,
+			Condition:      servers.MatchingRuleCondition{VolumeAbove: r.VolumeAbove},
+			VehicleTypes:   make([]servers.VehicleType, len(r.VehicleTypes)),
+			ThermalStorage: r.ThermalStorage,
+		}
+		if r.TemperatureClass != nil {
+			class := toAPITemperatureClass(*r.TemperatureClass)
+			response[i].Condition.TemperatureClass = &class
+		}
+		if r.Zone != nil {
+			response[i].Condition.Zone = &servers.Zone{
+				From: servers.Location{X: r.Zone.FromX, Y: r.Zone.FromY},
+				To:   servers.Location{X: r.Zone.ToX, Y: r.Zone.ToY},
+			}
+		}
+		for j, vehicleType := range r.VehicleTypes {
+			response[i].VehicleTypes[j] = toAPIVehicleType(vehicleType)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// ReplaceMatchingRules handles PUT /api/v1/admin/dispatch/matching-rules - replaces the dispatch
+// matching rules as a whole; they apply from the next assignment on.
+func (s *Server) ReplaceMatchingRules(ctx echo.Context) error {
+	var body servers.MatchingRulesChange
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	specs := make([]commands.MatchingRuleSpec, 0, len(body.Rules))
+	var validation errs.ValidationErrors
+	for i, rule := range body.Rules {
+		zone, err := fromAPIZone(rule.Condition.Zone)
+		if err != nil {
+			validation.Add("rules."+strconv.Itoa(i)+".condition", err)
+			continue
+		}
+
+		spec := commands.MatchingRuleSpec{
+			Name:        rule.Name,
+			Condition:   matching.Condition{VolumeAbove: rule.Condition.VolumeAbove, Zone: zone},
+			Requirement: matching.Requirement{ThermalStorage: rule.ThermalStorage != nil && *rule.ThermalStorage},
+		}
+		if rule.Condition.TemperatureClass != nil {
+			class := fromAPITemperatureClass(*rule.Condition.TemperatureClass)
+			spec.Condition.TemperatureClass = &class
+		}
+		if rule.VehicleTypes != nil {
+			for _, vehicleType := range *rule.VehicleTypes {
+				spec.Requirement.VehicleTypes = append(spec.Requirement.VehicleTypes, fromAPIVehicleType(vehicleType))
+			}
+		}
+		specs = append(specs, spec)
+	}
+	if err := validation.Err(); err != nil {
+		return respondValidationError(ctx, i18n.InvalidMatchingRules, err)
+	}
+
+	cmd, err := commands.NewReplaceMatchingRulesCommand(specs)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidMatchingRules, err)
+	}
+
+	rules, err := s.replaceMatchingRulesHandler.Handle(ctx.Request().Context(), cmd)
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToReplaceMatchingRules)
+	}
+
+	response := make([]servers.MatchingRule, len(rules))
+	for i, r := range rules {
+		response[i] = toAPIMatchingRule(r)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// ComputeSLACompliance handles POST /api/v1/admin/sla/compliance - computes the SLA compliance
+// of the request's tenant on a day, replacing what was computed for it before.
+func (s *Server) ComputeSLACompliance(ctx echo.Context) error {
+	var body servers.SLAComplianceComputation
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	day, err := time.Parse(time.DateOnly, body.Day)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidSLADay, err.Error())
+	}
+
+	cmd, err := commands.NewComputeSLAComplianceCommand(day)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidSLADay, err.Error())
+	}
+
+	compliance, err := s.computeSLAComplianceHandler.Handle(ctx.Request().Context(), cmd)
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToComputeSLACompliance)
+	}
+
+	response := servers.SLAComplianceComputationResult{
+		Day:     cmd.Day().Format(time.DateOnly),
+		Targets: len(compliance),
+	}
+	for _, c := range compliance {
+		if !c.IsMet() {
+			response.Missed++
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetSLAReport handles GET /api/v1/reports/sla - reports how every district met its SLA targets
+// over a period, with the compliance of each day for the trend.
+func (s *Server) GetSLAReport(ctx echo.Context, params servers.GetSLAReportParams) error {
+	from, to, err := slaReportPeriod(params.From, params.To)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidSLAReportPeriod, err.Error())
+	}
+
+	query, err := queries.NewGetSLAReportQuery(from, to)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidSLAReportPeriod, err.Error())
+	}
+
+	districts, err := s.getSLAReportHandler.Handle(ctx.Request().Context(), query)
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveSLAReport)
+	}
+
+	response := servers.SLAReport{
+		From:      query.From().Format(time.DateOnly),
+		To:        query.To().Format(time.DateOnly),
+		Districts: make([]servers.SLADistrictReport, len(districts)),
+	}
+	for i, d := range districts {
+		trend := make([]servers.SLAReportDay, len(d.Trend))
+		for j, day := range d.Trend {
+			trend[j] = servers.SLAReportDay{
+				Day:        day.Day.Format(time.DateOnly),
+				Goal:       day.Goal,
+				Delivered:  day.Delivered,
+				OnTime:     day.OnTime,
+				Compliance: day.Percentage,
+				Met:        day.Met,
+			}
+		}
+		response.Districts[i] = servers.SLADistrictReport{
+			District:        d.District,
+			DeliveryTier:    toAPIDeliveryTier(d.Tier),
+			DeliveryMinutes: int(d.DeliveryTime / time.Minute),
+			Goal:            d.Goal,
+			Delivered:       d.Delivered,
+			OnTime:          d.OnTime,
+			Compliance:      d.Percentage,
+			Met:             d.Met,
+			Trend:           trend,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetSLOStatus handles GET /api/v1/stats/slo - reports the assignment and delivery latency
+// percentiles over the rolling SLO window, how they meet the objectives and the order queue depth.
+func (s *Server) GetSLOStatus(ctx echo.Context) error {
+	status, err := s.getSLOStatusHandler.Handle(ctx.Request().Context(), queries.NewGetSLOStatusQuery())
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveSLOStatus)
+	}
+
+	response := servers.SLOStatus{
+		QueueDepth:    int(status.QueueDepth),
+		WindowSeconds: int(status.Window / time.Second),
+		MeasuredAt:    status.MeasuredAt,
+		Stages:        make([]servers.SLOStage, len(status.Measurements)),
+	}
+	for i, measurement := range status.Measurements {
+		objective := measurement.Objective()
+		percentiles := measurement.Percentiles()
+		response.Stages[i] = servers.SLOStage{
+			Stage:            objective.Stage().String(),
+			ThresholdSeconds: int(objective.Threshold() / time.Second),
+			Goal:             objective.Goal(),
+			AlertBurnRate:    objective.AlertBurnRate(),
+			Orders:           measurement.Orders(),
+			Within:           measurement.Within(),
+			Compliance:       measurement.Compliance(),
+			Met:              measurement.IsMet(),
+			BurnRate:         measurement.BurnRate(),
+			Burning:          measurement.IsBurning(),
+			Percentiles: servers.SLOPercentiles{
+				P50: percentiles.P50.Seconds(),
+				P90: percentiles.P90.Seconds(),
+				P95: percentiles.P95.Seconds(),
+				P99: percentiles.P99.Seconds(),
+			},
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// AnalyzeFleetWhatIf handles POST /api/v1/admin/fleet/what-if - projects how the average delivery
+// time and the order backlog would change if couriers were added to the fleet.
+func (s *Server) AnalyzeFleetWhatIf(ctx echo.Context) error {
+	var body servers.FleetWhatIfRequest
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	zone, err := fromAPIZone(body.Zone)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidWhatIfRequest, err)
+	}
+
+	period := queries.DefaultWhatIfPeriod
+	if body.Hours != nil {
+		period = time.Duration(*body.Hours) * time.Hour
+	}
+
+	query, err := queries.NewGetFleetWhatIfQuery(body.Couriers, body.Speed, zone, period)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidWhatIfRequest, err)
+	}
+
+	analysis, err := s.getFleetWhatIfHandler.Handle(ctx.Request().Context(), query)
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToAnalyzeFleet)
+	}
+
+	return ctx.JSON(http.StatusOK, servers.FleetWhatIf{
+		From:                       analysis.From,
+		To:                         analysis.To,
+		AddedCouriers:              analysis.AddedCouriers,
+		Speed:                      analysis.Speed,
+		Zone:                       *toAPIZone(analysis.Zone),
+		Baseline:                   toAPIFleetSimulationOutcome(analysis.Baseline),
+		Projected:                  toAPIFleetSimulationOutcome(analysis.Projected),
+		DeliveryImprovementSeconds: analysis.DeliveryTimeImprovement().Seconds(),
+		BacklogImprovement:         analysis.BacklogImprovement(),
+	})
+}
+
+// GetAPIUsage handles GET /api/v1/admin/api-usage - retrieves the API usage of every partner client
+// in a month for billing, busiest client first.
+func (s *Server) GetAPIUsage(ctx echo.Context, params servers.GetAPIUsageParams) error {
+	period, err := usagePeriod(params.Month)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidUsagePeriod, err.Error())
+	}
+
+	query, err := queries.NewGetAPIUsageQuery(period)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidUsagePeriod, err.Error())
+	}
+
+	clients, err := s.getAPIUsageHandler.Handle(ctx.Request().Context(), query)
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveAPIUsage)
+	}
+
+	response := make([]servers.APIUsage, len(clients))
+	for i, c := range clients {
+		response[i] = toAPIUsage(c)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetOwnAPIUsage handles GET /api/v1/usage - retrieves the API usage of the client sending the request
+// in a month and what is left of its quota.
+func (s *Server) GetOwnAPIUsage(ctx echo.Context, params servers.GetOwnAPIUsageParams) error {
+	client, ok := apiClientFromContext(ctx.Request().Context())
+	if !ok {
+		return respondError(ctx, http.StatusUnauthorized, i18n.APIKeyIsRequired)
+	}
+
+	period, err := usagePeriod(params.Month)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidUsagePeriod, err.Error())
+	}
+
+	query, err := queries.NewGetClientAPIUsageQuery(client, period)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidUsagePeriod, err.Error())
+	}
+
+	clients, err := s.getAPIUsageHandler.Handle(ctx.Request().Context(), query)
+	if err != nil || len(clients) == 0 {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveAPIUsage)
+	}
+
+	return ctx.JSON(http.StatusOK, toAPIUsage(clients[0]))
+}
+
+// GetPayoutExport handles GET /api/v1/admin/payouts - exports the courier earnings of a period as CSV.
+func (s *Server) GetPayoutExport(ctx echo.Context, params servers.GetPayoutExportParams) error {
+	query, err := queries.NewGetPayoutExportQuery(params.From, params.To)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidPayoutPeriod, err.Error())
+	}
+
+	payouts, err := s.getPayoutExportHandler.Handle(ctx.Request().Context(), query)
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToExportPayouts)
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	_ = writer.Write([]string{"courier_id", "courier_name", "tips", "tip_amount", "total"})
+	for _, payout := range payouts {
+		_ = writer.Write([]string{
+			payout.CourierID.String(),
+			payout.CourierName,
+			strconv.Itoa(payout.Tips),
+			strconv.Itoa(payout.TipAmount),
+			strconv.Itoa(payout.Total),
+		})
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToExportPayouts)
+	}
+
+	return ctx.Blob(http.StatusOK, "text/csv", buf.Bytes())
+}
+
+// ErasePersonalData handles POST /api/v1/admin/personal-data-erasures - erases the personal data
+// of a customer's orders and returns the verification report.
+func (s *Server) ErasePersonalData(ctx echo.Context) error {
+	var body servers.PersonalDataErasureRequest
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	orderIDs := make([]kernel.UUID, 0, len(body.OrderIds))
+	for _, id := range body.OrderIds {
+		orderID, err := kernel.UUIDFromBytes(id[:])
+		if err != nil {
+			return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+		}
+		orderIDs = append(orderIDs, orderID)
+	}
+
+	cmd, err := commands.NewErasePersonalDataCommand(body.RequestReference, orderIDs)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidPersonalDataErasure, err)
+	}
+
+	report, handleErr := s.erasePersonalDataHandler.Handle(ctx.Request().Context(), cmd)
+	if handleErr != nil {
+		switch {
+		case errors.Is(handleErr, errs.ErrObjectNotFound):
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: handleErr.Error(),
+			})
+		case errors.Is(handleErr, order.ErrOrderIsActive),
+			errors.Is(handleErr, errs.ErrConcurrencyConflict):
+			return ctx.JSON(http.StatusConflict, servers.Error{
+				Code:    http.StatusConflict,
+				Message: handleErr.Error(),
+			})
+		default:
+			return respondError(ctx, http.StatusInternalServerError, i18n.FailedToErasePersonalData)
+		}
+	}
+
+	erasedOrderIDs := make([]openapi_types.UUID, 0, len(report.OrderIDs))
+	for _, id := range report.OrderIDs {
+		erasedOrderIDs = append(erasedOrderIDs, openapi_types.UUID(id.Bytes()))
+	}
+
+	return ctx.JSON(http.StatusOK, servers.PersonalDataErasure{
+		Id:               openapi_types.UUID(report.ID.Bytes()),
+		RequestReference: report.RequestReference,
+		OrderIds:         erasedOrderIDs,
+		Erased:           toPersonalDataRecords(report.Erased),
+		Remaining:        toPersonalDataRecords(report.Remaining),
+		ErasedAt:         report.ErasedAt,
+	})
+}
+
+// toPersonalDataRecords converts the record counts of an erasure to the API representation.
+func toPersonalDataRecords(records ports.PersonalDataRecords) servers.PersonalDataRecords {
+	return servers.PersonalDataRecords{
+		TrackingTokens:     records.TrackingTokens,
+		ExternalReferences: records.ExternalReferences,
+		Messages:           records.Messages,
+		PaymentReferences:  records.PaymentReferences,
+		ChangeSnapshots:    records.ChangeSnapshots,
+		EventTombstones:    records.EventTombstones,
+	}
+}
+
+// GetChanges handles GET /api/v1/sync/changes - returns the change feed of orders and couriers after a cursor.
+func (s *Server) GetChanges(ctx echo.Context, params servers.GetChangesParams) error {
+	since, limit := int64(0), queries.DefaultChangesLimit
+	if params.Since != nil {
+		since = *params.Since
+	}
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	query, err := queries.NewGetChangesQuery(since, limit)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidChangeFeedRequest, err.Error())
+	}
+
+	feed, err := s.getChangesHandler.Handle(ctx.Request().Context(), query)
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveChanges)
+	}
+
+	response := servers.ChangeFeed{
+		Changes:    make([]servers.Change, len(feed.Changes)),
+		NextCursor: feed.NextCursor,
+	}
+	for i, change := range feed.Changes {
+		response.Changes[i] = servers.Change{
+			Cursor:        change.Cursor,
+			AggregateType: servers.ChangeAggregateType(change.AggregateType),
+			AggregateId:   change.AggregateID.Bytes(),
+			Version:       change.Version,
+			ChangedAt:     change.ChangedAt,
+		}
+		if change.Order != nil {
+			response.Changes[i].Order = &servers.OrderSnapshot{
+				Status:            toAPIOrderStatus(change.Order.Status),
+				CourierId:         change.Order.CourierID,
+				Location:          servers.Location{X: change.Order.X, Y: change.Order.Y},
+				Volume:            change.Order.Volume,
+				DeliveryTier:      toAPIDeliveryTier(change.Order.DeliveryTier),
+				DeclaredValue:     change.Order.DeclaredValue,
+				InsuranceRequired: change.Order.InsuranceRequired,
+			}
+			if change.Order.RatingStars > 0 {
+				stars := change.Order.RatingStars
+				response.Changes[i].Order.RatingStars = &stars
+			}
+			if change.Order.ExternalReference != nil {
+				ref := toAPIExternalReference(change.Order.ExternalReference)
+				response.Changes[i].Order.ExternalReference = &ref
+			}
+		}
+		if change.Courier != nil {
+			response.Changes[i].Courier = &servers.CourierSnapshot{
+				Name:        change.Courier.Name,
+				Speed:       change.Courier.Speed,
+				Location:    servers.Location{X: change.Courier.X, Y: change.Courier.Y},
+				Paused:      change.Courier.Paused,
+				Deactivated: change.Courier.Deactivated,
+				Insured:     change.Courier.Insured,
+			}
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetCourierAvailabilityFeed handles GET /api/v1/sync/courier-availability - returns the changes of
+// courier availability after a cursor, so the workforce management system can mirror the fleet.
+func (s *Server) GetCourierAvailabilityFeed(ctx echo.Context, params servers.GetCourierAvailabilityFeedParams) error {
+	since, limit := int64(0), queries.DefaultChangesLimit
+	if params.Since != nil {
+		since = *params.Since
+	}
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	query, err := queries.NewGetCourierAvailabilityQuery(since, limit)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidAvailabilityFeedRequest, err.Error())
+	}
+
+	feed, err := s.getCourierAvailabilityHandler.Handle(ctx.Request().Context(), query)
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveAvailability)
+	}
+
+	response := servers.CourierAvailabilityFeed{
+		Changes:    make([]servers.CourierAvailabilityChange, len(feed.Changes)),
+		NextCursor: feed.NextCursor,
+	}
+	for i, change := range feed.Changes {
+		response.Changes[i] = servers.CourierAvailabilityChange{
+			Cursor:       change.Cursor,
+			CourierId:    change.CourierID.Bytes(),
+			Version:      change.Version,
+			Availability: toAPICourierAvailability(change.Availability),
+			ChangedAt:    change.ChangedAt,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetOrderReviewQueue handles GET /api/v1/admin/orders/review-queue - lists orders held by fraud checks.
+func (s *Server) GetOrderReviewQueue(ctx echo.Context) error {
+	queue, err := s.getOrdersUnderReviewHandler.Handle(ctx.Request().Context(), queries.NewGetOrdersUnderReviewQuery())
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveReviewQueue)
+	}
+
+	response := make([]servers.OrderUnderReview, len(queue))
+	for i, held := range queue {
+		response[i] = servers.OrderUnderReview{
+			Id: held.ID.Bytes(),
+			Location: servers.Location{
+				X: int(held.Location.X()),
+				Y: int(held.Location.Y()),
+			},
+			Volume: held.Volume,
+			Reason: held.Reason,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// ApproveOrderReview handles POST /api/v1/admin/orders/{orderId}/review-approval - releases a held order.
+func (s *Server) ApproveOrderReview(ctx echo.Context, orderID openapi_types.UUID) error {
+	orderUUID, err := kernel.UUIDFromBytes(orderID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	cmd, err := commands.NewApproveOrderReviewCommand(orderUUID)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidReviewApproval, err.Error())
+	}
+
+	if handleErr := s.approveOrderReviewHandler.Handle(ctx.Request().Context(), cmd); handleErr != nil {
+		switch {
+		case errors.Is(handleErr, errs.ErrObjectNotFound):
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: handleErr.Error(),
+			})
+		case errors.Is(handleErr, order.ErrOrderIsNotUnderReview):
+			return ctx.JSON(http.StatusConflict, servers.Error{
+				Code:    http.StatusConflict,
+				Message: handleErr.Error(),
+			})
+		default:
+			return respondError(ctx, http.StatusInternalServerError, i18n.FailedToApproveOrderReview)
+		}
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// GetOrderMessages handles GET /api/v1/orders/{orderId}/messages - retrieves the order thread.
+func (s *Server) GetOrderMessages(ctx echo.Context, orderID openapi_types.UUID) error {
+	orderUUID, err := kernel.UUIDFromBytes(orderID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	query, err := queries.NewGetOrderThreadQuery(orderUUID)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidThreadRequest, err.Error())
+	}
+
+	thread, err := s.getOrderThreadHandler.Handle(ctx.Request().Context(), query)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			})
+		}
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveOrderMessages)
+	}
+
+	messages := make([]servers.OrderMessage, len(thread.Messages))
+	for i, message := range thread.Messages {
+		messages[i] = servers.OrderMessage{
+			Id:     message.ID.Bytes(),
+			Sender: toAPISender(message.Sender),
+			SentAt: message.SentAt,
+			Text:   message.Text,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, servers.OrderThread{
+		Closed:   thread.Closed,
+		Messages: messages,
+	})
+}
+
+// GetAssignmentExplanation handles GET /api/v1/orders/{orderId}/assignment-explanation -
+// explains why the order was assigned to its courier.
+func (s *Server) GetAssignmentExplanation(ctx echo.Context, orderID openapi_types.UUID) error {
+	orderUUID, err := kernel.UUIDFromBytes(orderID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	query, err := queries.NewGetAssignmentExplanationQuery(orderUUID)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	explanation, err := s.getAssignmentExplanationHandler.Handle(ctx.Request().Context(), query)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			})
+		}
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToExplainAssignment)
+	}
+
+	candidates := make([]servers.AssignmentCandidate, len(explanation.Candidates))
+	for i, candidate := range explanation.Candidates {
+		factors := make([]servers.ScoreFactor, len(candidate.Factors))
+		for j, factor := range candidate.Factors {
+			factors[j] = servers.ScoreFactor{
+				Contribution: factor.Contribution,
+				Name:         factor.Name,
+				Value:        factor.Value,
+				Weight:       factor.Weight,
+			}
+		}
+
+		candidates[i] = servers.AssignmentCandidate{
+			CourierId: candidate.CourierID.Bytes(),
+			Factors:   factors,
+			Score:     candidate.Score,
+			Selected:  candidate.Selected,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, servers.AssignmentExplanation{
+		Candidates:  candidates,
+		CourierId:   explanation.CourierID.Bytes(),
+		ExplainedAt: explanation.ExplainedAt,
+		Score:       explanation.Score,
+		Strategy:    explanation.Strategy,
+	})
+}
+
+// PostOrderMessage handles POST /api/v1/orders/{orderId}/messages - posts a message to the order thread.
+func (s *Server) PostOrderMessage(ctx echo.Context, orderID openapi_types.UUID) error {
+	var body servers.NewOrderMessage
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	orderUUID, err := kernel.UUIDFromBytes(orderID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	cmd, err := commands.NewPostOrderMessageCommand(orderUUID, fromAPISender(body.Sender), body.Text)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidMessage, err.Error())
+	}
+
+	if handleErr := s.postOrderMessageHandler.Handle(ctx.Request().Context(), cmd); handleErr != nil {
+		switch {
+		case errors.Is(handleErr, errs.ErrObjectNotFound):
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: handleErr.Error(),
+			})
+		case errors.Is(handleErr, errs.ErrValueIsInvalid):
+			return respondError(ctx, http.StatusBadRequest, i18n.InvalidMessage, handleErr.Error())
+		case errors.Is(handleErr, order.ErrThreadIsClosed):
+			return respondError(ctx, http.StatusConflict, i18n.OrderThreadIsClosed)
+		default:
+			return respondError(ctx, http.StatusInternalServerError, i18n.FailedToPostOrderMessage)
+		}
+	}
+
+	return ctx.NoContent(http.StatusCreated)
+}
+
+// ShareOrderTracking handles POST /api/v1/orders/{orderId}/tracking-link - issues a customer tracking link.
+func (s *Server) ShareOrderTracking(ctx echo.Context, orderID openapi_types.UUID) error {
+	orderUUID, err := kernel.UUIDFromBytes(orderID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	cmd, err := commands.NewShareOrderTrackingCommand(orderUUID)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidTrackingRequest, err.Error())
+	}
+
+	token, handleErr := s.shareOrderTrackingHandler.Handle(ctx.Request().Context(), cmd)
+	if handleErr != nil {
+		switch {
+		case errors.Is(handleErr, errs.ErrObjectNotFound):
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: handleErr.Error(),
+			})
+		case errors.Is(handleErr, order.ErrTrackingIsClosed):
+			return respondError(ctx, http.StatusConflict, i18n.OrderTrackingIsClosed)
+		default:
+			return respondError(ctx, http.StatusInternalServerError, i18n.FailedToShareOrderTracking)
+		}
+	}
+
+	return ctx.JSON(http.StatusCreated, servers.TrackingLink{
+		Token: token.String(),
+		Path:  sharedTrackingPath + token.String(),
+	})
+}
+
+// RecalculateOrderEta handles POST /api/v1/orders/{orderId}/recalculate-eta - refreshes the delivery estimate.
+func (s *Server) RecalculateOrderEta(ctx echo.Context, orderID openapi_types.UUID) error {
+	orderUUID, err := kernel.UUIDFromBytes(orderID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	cmd, err := commands.NewRecalculateETACommand(orderUUID)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidETARequest, err.Error())
+	}
+
+	eta, handleErr := s.recalculateETAHandler.Handle(ctx.Request().Context(), cmd)
+	if handleErr != nil {
+		switch {
+		case errors.Is(handleErr, errs.ErrObjectNotFound):
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: handleErr.Error(),
+			})
+		case errors.Is(handleErr, order.ErrOrderIsNotAssigned):
+			return respondError(ctx, http.StatusConflict, i18n.OrderIsNotAssigned)
+		default:
+			return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRecalculateETA)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, servers.EstimatedArrival{
+		Turns:        eta.Turns(),
+		CalculatedAt: eta.CalculatedAt(),
+	})
+}
+
+// GetOrderEta handles GET /api/v1/orders/{orderId}/eta
+// - estimates the delivery of an assigned order from the current position of its courier.
+func (s *Server) GetOrderEta(ctx echo.Context, orderID openapi_types.UUID) error {
+	orderUUID, err := kernel.UUIDFromBytes(orderID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	query, err := queries.NewGetOrderETAQuery(orderUUID)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidETARequest, err.Error())
+	}
+
+	eta, handleErr := s.getOrderETAHandler.Handle(ctx.Request().Context(), query)
+	if handleErr != nil {
+		switch {
+		case errors.Is(handleErr, errs.ErrObjectNotFound):
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: handleErr.Error(),
+			})
+		case errors.Is(handleErr, order.ErrOrderIsNotAssigned):
+			return respondError(ctx, http.StatusConflict, i18n.OrderIsNotAssigned)
+		default:
+			return respondError(ctx, http.StatusInternalServerError, i18n.FailedToEstimateETA)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, servers.OrderEta{
+		OrderId:      openapi_types.UUID(eta.OrderID.Bytes()),
+		CourierId:    openapi_types.UUID(eta.CourierID.Bytes()),
+		Turns:        eta.Turns,
+		CalculatedAt: eta.CalculatedAt,
+		ArrivesAt:    eta.ArrivesAt,
+	})
+}
+
+// GetCourierStats handles GET /api/v1/couriers/{courierId}/stats
+// - reports the deliveries and ratings of the courier and the badges the courier earned.
+func (s *Server) GetCourierStats(ctx echo.Context, courierID openapi_types.UUID) error {
+	courierUUID, err := kernel.UUIDFromBytes(courierID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	query, err := queries.NewGetCourierStatsQuery(courierUUID)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	stats, err := s.getCourierStatsHandler.Handle(ctx.Request().Context(), query)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			})
+		}
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveCourierStats)
+	}
+
+	response := servers.CourierStats{
+		Deliveries:    stats.Deliveries,
+		Ratings:       stats.Ratings,
+		AverageRating: stats.AverageRating,
+		Badges:        make([]servers.CourierBadge, len(stats.Badges)),
+	}
+	for i, earned := range stats.Badges {
+		response.Badges[i] = servers.CourierBadge{Name: earned.Name, AwardedAt: earned.AwardedAt}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetBadgeRules handles GET /api/v1/admin/badge-rules - lists the configured badge rules.
+func (s *Server) GetBadgeRules(ctx echo.Context) error {
+	rules, err := s.getBadgeRulesHandler.Handle(ctx.Request().Context(), queries.NewGetBadgeRulesQuery())
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveBadgeRules)
+	}
+
+	response := make([]servers.BadgeRule, len(rules))
+	for i, rule := range rules {
+		response[i] = servers.BadgeRule{
+			Name:      rule.Name,
+			Kind:      servers.BadgeKind(rule.Kind.String()),
+			Threshold: rule.Threshold,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetOrderHistory handles GET /api/v1/orders/{orderId}/history
+// - lists the status and courier transitions of the order, oldest first.
+func (s *Server) GetOrderHistory(ctx echo.Context, orderID openapi_types.UUID) error {
+	orderUUID, err := kernel.UUIDFromBytes(orderID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	query, err := queries.NewGetOrderHistoryQuery(orderUUID)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	history, err := s.getOrderHistoryHandler.Handle(ctx.Request().Context(), query)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			})
+		}
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveOrderHistory)
+	}
+
+	response := make([]servers.OrderHistoryEntry, len(history))
+	for i, entry := range history {
+		response[i] = servers.OrderHistoryEntry{
+			Status:    toAPIOrderStatus(entry.Status),
+			ChangedAt: entry.ChangedAt,
+		}
+		if entry.CourierID != nil {
+			courierID := openapi_types.UUID(entry.CourierID.Bytes())
+			response[i].CourierId = &courierID
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetOrder handles GET /api/v1/orders/{orderId}
+// - returns the state of the order as of the asOf moment, the current moment by default.
+func (s *Server) GetOrder(ctx echo.Context, orderID openapi_types.UUID, params servers.GetOrderParams) error {
+	orderUUID, err := kernel.UUIDFromBytes(orderID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	asOf := time.Now()
+	if params.AsOf != nil {
+		asOf = *params.AsOf
+	}
+
+	query, err := queries.NewGetOrderStateQuery(orderUUID, asOf)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidOrderStateRequest, err.Error())
+	}
+
+	state, err := s.getOrderStateHandler.Handle(ctx.Request().Context(), query)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			})
+		}
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveOrderState)
+	}
+
+	response := servers.OrderState{
+		Id:        openapi_types.UUID(state.ID.Bytes()),
+		Status:    toAPIOrderStatus(state.Status),
+		Location:  servers.Location{X: int(state.Location.X()), Y: int(state.Location.Y())},
+		ChangedAt: state.ChangedAt,
+	}
+	if state.CourierID != nil {
+		courierID := openapi_types.UUID(state.CourierID.Bytes())
+		response.CourierId = &courierID
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// ConfirmOrderHandover handles POST /api/v1/orders/{orderId}/handover-confirmations
+// - confirms the pickup or the delivery of an insured order.
+func (s *Server) ConfirmOrderHandover(ctx echo.Context, orderID openapi_types.UUID) error {
+	var body servers.HandoverConfirmation
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	orderUUID, err := kernel.UUIDFromBytes(orderID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	cmd, err := commands.NewConfirmOrderHandoverCommand(orderUUID, commands.HandoverStep(body.Step))
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidHandoverConfirmation, err)
+	}
+
+	if handleErr := s.confirmOrderHandoverHandler.Handle(ctx.Request().Context(), cmd); handleErr != nil {
+		switch {
+		case errors.Is(handleErr, errs.ErrObjectNotFound):
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: handleErr.Error(),
+			})
+		case errors.Is(handleErr, order.ErrConfirmationIsNotRequired),
+			errors.Is(handleErr, order.ErrOrderIsNotAssigned),
+			errors.Is(handleErr, order.ErrPickupIsNotConfirmed),
+			errors.Is(handleErr, commands.ErrCourierHasNotArrived):
+			return ctx.JSON(http.StatusConflict, servers.Error{
+				Code:    http.StatusConflict,
+				Message: handleErr.Error(),
+			})
+		default:
+			return respondError(ctx, http.StatusInternalServerError, i18n.FailedToConfirmHandover)
+		}
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// CancelOrder handles POST /api/v1/orders/{orderId}/cancellation - cancels an order that
+// was not delivered yet and frees the storage place of its courier.
+func (s *Server) CancelOrder(ctx echo.Context, orderID openapi_types.UUID) error {
+	orderUUID, err := kernel.UUIDFromBytes(orderID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	cmd, err := commands.NewCancelOrderCommand(orderUUID)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidOrderCancellation, err)
+	}
+
+	if handleErr := s.cancelOrderHandler.Handle(ctx.Request().Context(), cmd); handleErr != nil {
+		switch {
+		case errors.Is(handleErr, errs.ErrObjectNotFound):
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: handleErr.Error(),
+			})
+		case errors.Is(handleErr, order.ErrOrderIsCompleted),
+			errors.Is(handleErr, order.ErrOrderIsCancelled):
+			return ctx.JSON(http.StatusConflict, servers.Error{
+				Code:    http.StatusConflict,
+				Message: handleErr.Error(),
+			})
+		default:
+			return respondError(ctx, http.StatusInternalServerError, i18n.FailedToCancelOrder)
+		}
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// BulkCancelOrders handles POST /api/v1/orders/cancel-bulk - calls off the orders matching
+// a filter in batches, for example when a warehouse closes unexpectedly.
+func (s *Server) BulkCancelOrders(ctx echo.Context) error {
+	var body servers.BulkCancellationRequest
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	zone, err := fromAPIZone(body.Zone)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidBulkCancellation, err)
+	}
+	status := order.Created
+	if body.Status != nil {
+		status = fromAPIOrderStatus(*body.Status)
+	}
+	limit := commands.MaxBulkCancelledOrders
+	if body.Limit != nil {
+		limit = *body.Limit
+	}
+
+	cmd, err := commands.NewBulkCancelOrdersCommand(body.Reason, status, zone, body.CreatedBefore, limit)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidBulkCancellation, err)
+	}
+
+	report, err := s.bulkCancelOrdersHandler.Handle(ctx.Request().Context(), cmd)
+	if err != nil {
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToCancelOrdersInBulk)
+	}
+
+	orderIDs := make([]openapi_types.UUID, 0, len(report.OrderIDs))
+	for _, id := range report.OrderIDs {
+		orderIDs = append(orderIDs, openapi_types.UUID(id.Bytes()))
+	}
+
+	return ctx.JSON(http.StatusOK, servers.BulkCancellation{
+		Id:           openapi_types.UUID(report.ID.Bytes()),
+		Reason:       report.Reason,
+		OrderIds:     orderIDs,
+		Cancelled:    len(orderIDs),
+		Batches:      report.Batches,
+		LimitReached: report.LimitReached,
+	})
+}
+
+// TransferOrder handles POST /api/v1/orders/{orderId}/transfers - hands an order over
+// to another courier on the way to the customer.
+func (s *Server) TransferOrder(ctx echo.Context, orderID openapi_types.UUID) error {
+	var body servers.OrderTransferRequest
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	orderUUID, err := kernel.UUIDFromBytes(orderID[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+	courierUUID, err := kernel.UUIDFromBytes(body.CourierId[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+	storagePlaceUUID, err := kernel.UUIDFromBytes(body.StoragePlaceId[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	cmd, err := commands.NewTransferOrderCommand(orderUUID, courierUUID, storagePlaceUUID)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidOrderTransfer, err)
+	}
+
+	transfer, handleErr := s.transferOrderHandler.Handle(ctx.Request().Context(), cmd)
+	if handleErr != nil {
+		switch {
+		case errors.Is(handleErr, errs.ErrObjectNotFound),
+			errors.Is(handleErr, courier.ErrStoragePlaceNotFound):
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: handleErr.Error(),
+			})
+		case errors.Is(handleErr, order.ErrOrderIsNotAssigned),
+			errors.Is(handleErr, services.ErrOrderIsNotCarriedByCourier),
+			errors.Is(handleErr, services.ErrCouriersAreApart),
+			errors.Is(handleErr, services.ErrCourierCannotReceiveOrder),
+			errors.Is(handleErr, relay.ErrHandoverToSameCourier),
+			errors.Is(handleErr, courier.ErrCannotStoreOrderInThisStoragePlace),
+			errors.Is(handleErr, courier.ErrCourierIsNotInsured):
+			return ctx.JSON(http.StatusConflict, servers.Error{
+				Code:    http.StatusConflict,
+				Message: handleErr.Error(),
+			})
+		default:
+			return respondError(ctx, http.StatusInternalServerError, i18n.FailedToTransferOrder)
+		}
+	}
+
+	handover := transfer.Handover
+	return ctx.JSON(http.StatusCreated, servers.OrderTransfer{
+		Id:                 openapi_types.UUID(handover.ID().Bytes()),
+		OrderId:            openapi_types.UUID(handover.OrderID().Bytes()),
+		FromCourierId:      openapi_types.UUID(handover.From().CourierID().Bytes()),
+		FromStoragePlaceId: openapi_types.UUID(handover.From().StoragePlaceID().Bytes()),
+		ToCourierId:        openapi_types.UUID(handover.To().CourierID().Bytes()),
+		ToStoragePlaceId:   openapi_types.UUID(handover.To().StoragePlaceID().Bytes()),
+		Location: servers.Location{
+			X: int(handover.Location().X()),
+			Y: int(handover.Location().Y()),
+		},
+		HandedOverAt: handover.HandedOverAt(),
+		EstimatedArrival: servers.EstimatedArrival{
+			Turns:        transfer.EstimatedArrival.Turns(),
+			CalculatedAt: transfer.EstimatedArrival.CalculatedAt(),
+		},
+	})
+}
+
+// IssueBlobUpload handles POST /api/v1/uploads - issues a signed URL the client uploads
+// a delivery photo or a courier document to.
+func (s *Server) IssueBlobUpload(ctx echo.Context) error {
+	var body servers.BlobUploadRequest
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	if s.issueBlobUploadHandler == nil {
+		return respondError(ctx, http.StatusServiceUnavailable, i18n.BlobStorageIsNotConfigured)
+	}
+
+	purpose, err := blob.ParsePurpose(string(body.Purpose))
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidBlobUpload, err)
+	}
+	ownerUUID, err := kernel.UUIDFromBytes(body.OwnerId[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	cmd, err := commands.NewIssueBlobUploadCommand(purpose, ownerUUID, body.ContentType, body.Size)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidBlobUpload, err)
+	}
+
+	ticket, handleErr := s.issueBlobUploadHandler.Handle(ctx.Request().Context(), cmd)
+	if handleErr != nil {
+		if errors.Is(handleErr, errs.ErrValidationFailed) {
+			return respondValidationError(ctx, i18n.InvalidBlobUpload, handleErr)
+		}
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToIssueBlobUpload)
+	}
+
+	return ctx.JSON(http.StatusCreated, servers.BlobUpload{
+		Id:        openapi_types.UUID(ticket.Upload.ID().Bytes()),
+		Key:       ticket.Upload.Key(),
+		Url:       ticket.Signed.URL,
+		Method:    ticket.Signed.Method,
+		Headers:   ticket.Signed.Headers,
+		ExpiresAt: ticket.Signed.ExpiresAt,
+	})
+}
+
+// GetSharedTracking handles GET /api/v1/tracking/{trackingToken} - the customer-facing tracking view.
+func (s *Server) GetSharedTracking(ctx echo.Context, trackingToken string) error {
+	token, err := order.TrackingTokenFromString(trackingToken)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidTrackingToken)
+	}
+
+	query, err := queries.NewGetSharedTrackingQuery(token)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidTrackingRequest, err.Error())
+	}
+
+	tracking, err := s.getSharedTrackingHandler.Handle(ctx.Request().Context(), query)
+	if err != nil {
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return respondError(ctx, http.StatusNotFound, i18n.TrackingLinkNotFound)
+		}
+		return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRetrieveTracking)
+	}
+
+	response := servers.SharedTracking{
+		Status:        toAPIOrderStatus(tracking.Status),
+		BatchClosesAt: tracking.BatchClosesAt,
+	}
+	if tracking.Courier != nil {
+		eta := tracking.Courier.ETA
+		response.Eta = &eta
+		response.CourierLocation = &servers.Location{
+			X: int(tracking.Courier.ApproximateLocation.X()),
+			Y: int(tracking.Courier.ApproximateLocation.Y()),
+		}
+		if tracking.Courier.FirstName != "" {
+			firstName := tracking.Courier.FirstName
+			response.CourierFirstName = &firstName
+		}
+		response.CourierPhotoUrl = tracking.Courier.PhotoURL
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// TipOrder handles POST /api/v1/tracking/{trackingToken}/tip - the customer tips the courier of a
+// delivered order. A retry with the same Idempotency-Key answers 200 with the tip already left.
+func (s *Server) TipOrder(ctx echo.Context, trackingToken string, params servers.TipOrderParams) error {
+	var body servers.NewTip
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	token, err := order.TrackingTokenFromString(trackingToken)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidTrackingToken)
+	}
+
+	cmd, err := commands.NewTipOrderCommand(token, body.Amount, params.IdempotencyKey)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidTip, err.Error())
+	}
+
+	receipt, err := s.tipOrderHandler.Handle(ctx.Request().Context(), cmd)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrObjectNotFound):
+			return respondError(ctx, http.StatusNotFound, i18n.TrackingLinkNotFound)
+		case errors.Is(err, order.ErrOrderIsNotDelivered):
+			return respondError(ctx, http.StatusConflict, i18n.OrderIsNotDelivered)
+		case errors.Is(err, order.ErrOrderIsAlreadyTipped):
+			return respondError(ctx, http.StatusConflict, i18n.OrderIsAlreadyTipped)
+		case errors.Is(err, errs.ErrValueIsOutOfRange),
+			errors.Is(err, errs.ErrValueIsRequired),
+			errors.Is(err, errs.ErrValueIsInvalid):
+			return respondError(ctx, http.StatusBadRequest, i18n.InvalidTip, err.Error())
+		default:
+			return respondError(ctx, http.StatusInternalServerError, i18n.FailedToTipOrder)
+		}
+	}
+
+	status := http.StatusCreated
+	if receipt.Replayed {
+		status = http.StatusOK
+	}
+	return ctx.JSON(status, servers.Tip{
+		Amount:   receipt.Tip.Amount(),
+		TippedAt: receipt.Tip.TippedAt(),
+	})
+}
+
+// RateOrder handles POST /api/v1/tracking/{trackingToken}/rating - the customer rates the delivery of
+// an order. Rating the order again with the same stars answers 200 with the rating already given.
+func (s *Server) RateOrder(ctx echo.Context, trackingToken string) error {
+	var body servers.NewRating
+	if err := ctx.Bind(&body); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	token, err := order.TrackingTokenFromString(trackingToken)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidTrackingToken)
+	}
+
+	cmd, err := commands.NewRateOrderCommand(token, body.Stars)
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRating, err.Error())
+	}
+
+	receipt, err := s.rateOrderHandler.Handle(ctx.Request().Context(), cmd)
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrObjectNotFound):
+			return respondError(ctx, http.StatusNotFound, i18n.TrackingLinkNotFound)
+		case errors.Is(err, order.ErrOrderIsNotDelivered):
+			return respondError(ctx, http.StatusConflict, i18n.OrderIsNotDelivered)
+		case errors.Is(err, order.ErrOrderIsAlreadyRated):
+			return respondError(ctx, http.StatusConflict, i18n.OrderIsAlreadyRated)
+		case errors.Is(err, errs.ErrValueIsOutOfRange),
+			errors.Is(err, errs.ErrValueIsRequired):
+			return respondError(ctx, http.StatusBadRequest, i18n.InvalidRating, err.Error())
+		default:
+			return respondError(ctx, http.StatusInternalServerError, i18n.FailedToRateOrder)
+		}
+	}
+
+	status := http.StatusCreated
+	if receipt.Replayed {
+		status = http.StatusOK
+	}
+	return ctx.JSON(status, servers.Rating{
+		Stars:   receipt.Rating.Stars(),
+		RatedAt: receipt.Rating.RatedAt(),
+	})
+}
+
+// ReceivePaymentEvent handles POST /api/v1/payments/webhook - applies a payment provider event to the order.
+// The raw body is read before decoding so that its signature can be checked.
+func (s *Server) ReceivePaymentEvent(ctx echo.Context, params servers.ReceivePaymentEventParams) error {
+	payload, err := io.ReadAll(io.LimitReader(ctx.Request().Body, maxPaymentEventSize))
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	if !verifyPaymentSignature(s.paymentWebhookSecret, payload, params.XPaymentSignature) {
+		return respondError(ctx, http.StatusUnauthorized, i18n.InvalidPaymentSignature)
+	}
+
+	if err = s.meterAPIUsage(ctx, usage.WebhooksConsumed, 1); err != nil {
+		return respondMeteringError(ctx, err)
+	}
+
+	var event servers.PaymentEvent
+	if err = json.Unmarshal(payload, &event); err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidRequestBody)
+	}
+
+	orderUUID, err := kernel.UUIDFromBytes(event.OrderId[:])
+	if err != nil {
+		return respondError(ctx, http.StatusBadRequest, i18n.InvalidIdentifier, err.Error())
+	}
+
+	cmd, err := commands.NewUpdateOrderPaymentCommand(
+		orderUUID, fromAPIPaymentStatus(event.Status), event.EventId, event.OccurredAt,
+	)
+	if err != nil {
+		return respondValidationError(ctx, i18n.InvalidPaymentEvent, err)
+	}
+
+	if handleErr := s.updateOrderPaymentHandler.Handle(ctx.Request().Context(), cmd); handleErr != nil {
+		switch {
+		case errors.Is(handleErr, errs.ErrObjectNotFound):
+			return ctx.JSON(http.StatusNotFound, servers.Error{
+				Code:    http.StatusNotFound,
+				Message: handleErr.Error(),
+			})
+		case errors.Is(handleErr, order.ErrPaymentTransitionIsInvalid):
+			return ctx.JSON(http.StatusConflict, servers.Error{
+				Code:    http.StatusConflict,
+				Message: handleErr.Error(),
+			})
+		case errors.Is(handleErr, errs.ErrValueIsRequired),
+			errors.Is(handleErr, errs.ErrValueIsInvalid):
+			return respondValidationError(ctx, i18n.InvalidPaymentEvent, handleErr)
+		default:
+			return respondError(ctx, http.StatusInternalServerError, i18n.FailedToUpdatePayment)
+		}
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// usagePeriod parses the requested usage month, the current one if none is given.
+func usagePeriod(month *string) (usage.Period, error) {
+	if month == nil {
+		return usage.PeriodOf(time.Now()), nil
+	}
+	return usage.ParsePeriod(*month)
+}
+
+// slaReportPeriod parses the requested report days. The period ends yesterday, the last day
+// with computed compliance, and spans 30 days unless given.
+func slaReportPeriod(from *string, to *string) (time.Time, time.Time, error) {
+	last := sla.Day(time.Now()).AddDate(0, 0, -1)
+	if to != nil {
+		day, err := time.Parse(time.DateOnly, *to)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		last = day
+	}
+
+	first := last.AddDate(0, 0, -30)
+	if from != nil {
+		day, err := time.Parse(time.DateOnly, *from)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		first = day
+	}
+
+	return first, last, nil
+}
+
+// toAPIUsage maps a client's usage to the API representation.
+func toAPIUsage(c queries.GetAPIUsageQueryResponse) servers.APIUsage {
+	return servers.APIUsage{
+		Client:           c.Client,
+		Month:            c.Period,
+		ResetsAt:         c.ResetsAt,
+		Requests:         toAPIUsageMetric(c.Requests),
+		OrdersCreated:    toAPIUsageMetric(c.OrdersCreated),
+		WebhooksConsumed: toAPIUsageMetric(c.WebhooksConsumed),
+	}
+}
+
+// toAPIUsageMetric maps the usage of a metric to the API representation.
+// The remaining quota is left out for unlimited metrics.
+func toAPIUsageMetric(metric queries.APIUsageMetric) servers.APIUsageMetric {
+	response := servers.APIUsageMetric{Used: metric.Used, Limit: metric.Limit}
+	if metric.Limit != 0 {
+		remaining := max(metric.Limit-metric.Used, 0)
+		response.Remaining = &remaining
+	}
+	return response
+}
+
+// fromAPIPaymentMethod maps the API payment method to the domain value.
+// Unknown values map to order.UnknownPaymentMethod and are rejected by command validation.
+func fromAPIPaymentMethod(method servers.PaymentMethod) order.PaymentMethod {
+	switch method {
+	case servers.CashOnDelivery:
+		return order.CashOnDelivery
+	case servers.Online:
+		return order.OnlinePayment
+	default:
+		return order.UnknownPaymentMethod
+	}
+}
+
+// toAPIPaymentMethod maps the domain payment method to the API value.
+func toAPIPaymentMethod(method order.PaymentMethod) servers.PaymentMethod {
+	if method == order.OnlinePayment {
+		return servers.Online
+	}
+	return servers.CashOnDelivery
+}
+
+// fromAPIDeliveryTier maps the API delivery tier to the domain value.
+// Unknown values map to order.UnknownDeliveryTier and are rejected by command validation.
+func fromAPIDeliveryTier(tier servers.DeliveryTier) order.DeliveryTier {
+	switch tier {
+	case servers.Express:
+		return order.ExpressDelivery
+	case servers.Economy:
+		return order.EconomyDelivery
+	default:
+		return order.UnknownDeliveryTier
+	}
+}
+
+// toAPIDeliveryTier maps the domain delivery tier to the API value.
+func toAPIDeliveryTier(tier order.DeliveryTier) servers.DeliveryTier {
+	if tier == order.EconomyDelivery {
+		return servers.Economy
+	}
+	return servers.Express
+}
+
+// toAPICourierAvailability maps the domain courier availability to the API value.
+func toAPICourierAvailability(availability courier.Availability) servers.CourierAvailability {
+	switch availability {
+	case courier.AvailabilityFree:
+		return servers.Free
+	case courier.AvailabilityBusy:
+		return servers.Busy
+	default:
+		return servers.OffShift
+	}
+}
+
+// toAPIOrderPriority maps the domain order priority to the API value.
+func toAPIOrderPriority(priority order.Priority) servers.OrderPriority {
+	switch priority {
+	case order.LowPriority:
+		return servers.Low
+	case order.HighPriority:
+		return servers.High
+	case order.UrgentPriority:
+		return servers.Urgent
+	default:
+		return servers.Normal
+	}
+}
+
+// fromAPIOrderSort maps the API sort of a page of orders to the query value.
+// Unknown values map to an invalid sort, which the query rejects.
+func fromAPIOrderSort(sort servers.ListOrdersParamsSort) queries.OrderSort {
+	switch sort {
+	case servers.ListOrdersParamsSortCreatedAt:
+		return queries.OldestFirst
+	case servers.ListOrdersParamsSortMinusCreatedAt:
+		return queries.NewestFirst
+	case servers.ListOrdersParamsSortPriority:
+		return queries.LeastUrgentFirst
+	case servers.ListOrdersParamsSortMinusPriority:
+		return queries.MostUrgentFirst
+	default:
+		return queries.OrderSort(-1)
+	}
+}
+
+// fromAPITemperatureClass maps the API temperature class to the domain value.
+// Unknown values map to order.UnknownTemperatureClass and are rejected by command validation.
+func fromAPITemperatureClass(class servers.TemperatureClass) order.TemperatureClass {
+	switch class {
+	case servers.Ambient:
+		return order.Ambient
+	case servers.Chilled:
+		return order.Chilled
+	case servers.Frozen:
+		return order.Frozen
+	default:
+		return order.UnknownTemperatureClass
+	}
+}
+
+// toAPITemperatureClass maps the domain temperature class to the API value.
+func toAPITemperatureClass(class order.TemperatureClass) servers.TemperatureClass {
+	switch class {
+	case order.Chilled:
+		return servers.Chilled
+	case order.Frozen:
+		return servers.Frozen
+	default:
+		return servers.Ambient
+	}
+}
+
+// fromAPIOrderPriority maps the API order priority to the domain value.
+// Unknown values map to order.UnknownPriority and are rejected by command validation.
+func fromAPIOrderPriority(priority servers.OrderPriority) order.Priority {
+	switch priority {
+	case servers.Low:
+		return order.LowPriority
+	case servers.Normal:
+		return order.NormalPriority
+	case servers.High:
+		return order.HighPriority
+	case servers.Urgent:
+		return order.UrgentPriority
+	default:
+		return order.UnknownPriority
+	}
+}
+
+// fromAPIVehicleType maps the API vehicle type to the domain value.
+// Unknown values map to courier.UnknownVehicleType and are rejected by validation.
+func fromAPIVehicleType(vehicleType servers.VehicleType) courier.VehicleType {
+	switch vehicleType {
+	case servers.Bicycle:
+		return courier.Bicycle
+	case servers.Scooter:
+		return courier.Scooter
+	case servers.Car:
+		return courier.Car
+	default:
+		return courier.UnknownVehicleType
+	}
+}
+
+// toAPIVehicleType maps the domain vehicle type to the API value.
+func toAPIVehicleType(vehicleType courier.VehicleType) servers.VehicleType {
+	switch vehicleType {
+	case courier.Scooter:
+		return servers.Scooter
+	case courier.Car:
+		return servers.Car
+	default:
+		return servers.Bicycle
+	}
+}
+
+// toAPIMatchingRule maps a matching rule to the API representation.
+func toAPIMatchingRule(rule *matching.Rule) servers.MatchingRule {
+	condition := rule.Condition()
+	requirement := rule.Requirement()
+
+	response := servers.MatchingRule{
+		Id:             rule.ID().Bytes(),
+		Name:           rule.Name(),
+		Condition:      servers.MatchingRuleCondition{VolumeAbove: condition.VolumeAbove},
+		VehicleTypes:   make([]servers.VehicleType, len(requirement.VehicleTypes)),
+		ThermalStorage: requirement.ThermalStorage,
+	}
+	if condition.TemperatureClass != nil {
+		class := toAPITemperatureClass(*condition.TemperatureClass)
+		response.Condition.TemperatureClass = &class
+	}
+	if condition.Zone != nil {
+		response.Condition.Zone = toAPIZone(*condition.Zone)
+	}
+	for i, vehicleType := range requirement.VehicleTypes {
+		response.VehicleTypes[i] = toAPIVehicleType(vehicleType)
+	}
+	return response
+}
+
+// fromAPIPaymentStatus maps the API payment status to the domain value.
+// Unknown values map to order.UnknownPaymentStatus and are rejected by command validation.
+func fromAPIPaymentStatus(status servers.PaymentStatus) order.PaymentStatus {
+	switch status {
+	case servers.PaymentStatusPending:
+		return order.PaymentPending
+	case servers.PaymentStatusPaid:
+		return order.PaymentPaid
+	case servers.PaymentStatusRefunded:
+		return order.PaymentRefunded
+	default:
+		return order.UnknownPaymentStatus
+	}
+}
+
+// toAPIPaymentStatus maps the domain payment status to the API value.
+func toAPIPaymentStatus(status order.PaymentStatus) servers.PaymentStatus {
+	switch status {
+	case order.PaymentPaid:
+		return servers.PaymentStatusPaid
+	case order.PaymentRefunded:
+		return servers.PaymentStatusRefunded
+	default:
+		return servers.PaymentStatusPending
+	}
+}
+
+// fromAPISender maps the API message sender to the domain sender.
+// Unknown values map to order.UnknownSender and are rejected by command validation.
+func fromAPISender(sender servers.MessageSender) order.Sender {
+	switch sender {
+	case servers.MessageSenderCourier:
+		return order.CourierSender
+	case servers.MessageSenderDispatcher:
+		return order.DispatcherSender
+	default:
+		return order.UnknownSender
+	}
+}
+
+// fromAPIDeactivationReason maps the API deactivation reason to the domain value.
+func fromAPIDeactivationReason(reason servers.DeactivationReason) courier.DeactivationReason {
+	switch reason {
+	case servers.DeactivationReasonOffboarded:
+		return courier.Offboarded
+	case servers.DeactivationReasonOffline:
+		return courier.WentOffline
+	default:
+		return courier.NotDeactivated
+	}
+}
+
+// toAPISender maps the domain message sender to the API representation.
+func toAPISender(sender order.Sender) servers.MessageSender {
+	if sender == order.DispatcherSender {
+		return servers.MessageSenderDispatcher
+	}
+	return servers.MessageSenderCourier
+}
+
+// toAPIOrderStatus maps the domain order status to the API representation.
+func toAPIOrderStatus(status order.Status) servers.OrderStatus {
+	switch status {
+	case order.Assigned:
+		return servers.Assigned
+	case order.Completed:
+		return servers.Completed
+	case order.Cancelled:
+		return servers.Cancelled
+	default:
+		return servers.Created
+	}
+}
+
+// fromAPIOrderStatus maps the API order status to the domain value.
+// Unknown values map to order.Unknown, which validation rejects.
+func fromAPIOrderStatus(status servers.OrderStatus) order.Status {
+	switch status {
+	case servers.Created:
+		return order.Created
+	case servers.Assigned:
+		return order.Assigned
+	case servers.Completed:
+		return order.Completed
+	case servers.Cancelled:
+		return order.Cancelled
+	default:
+		return order.Unknown
+	}
+}
+
+// fromAPIExternalReference maps the API marketplace order reference to the domain value.
+func fromAPIExternalReference(ref servers.ExternalReference) (order.ExternalReference, error) {
+	deepLink := ""
+	if ref.DeepLink != nil {
+		deepLink = *ref.DeepLink
+	}
+	return order.NewExternalReference(ref.Marketplace, ref.OrderId, deepLink)
+}
+
+// toAPIExternalReference maps a marketplace order reference to the API representation, omitting an empty deep link.
+func toAPIExternalReference(ref *queries.ExternalReferenceSnapshot) servers.ExternalReference {
+	response := servers.ExternalReference{
+		Marketplace: ref.Marketplace,
+		OrderId:     ref.OrderID,
+	}
+	if ref.DeepLink != "" {
+		deepLink := ref.DeepLink
+		response.DeepLink = &deepLink
+	}
+	return response
+}
+
+// toAPICourierProfile maps the domain courier profile to the API representation, omitting unset fields.
+func toAPICourierProfile(profile courier.Profile) servers.CourierProfile {
+	var response servers.CourierProfile
+	if photoURL := profile.PhotoURL(); photoURL != nil {
+		value := photoURL.String()
+		response.PhotoUrl = &value
+	}
+	if phone := profile.Phone(); phone != nil {
+		value := phone.String()
+		response.Phone = &value
+	}
+	if plate := profile.VehiclePlate(); plate != nil {
+		value := plate.String()
+		response.VehiclePlate = &value
+	}
+	if vehicleType := profile.VehicleType(); vehicleType != nil {
+		value := toAPIVehicleType(*vehicleType)
+		response.VehicleType = &value
+	}
+	return response
+}
+
+// toAPIWorkingHoursStatus maps the domain working hours status to the API representation.
+func toAPIWorkingHoursStatus(status courier.WorkingHoursStatus) servers.WorkingHoursStatus {
+	switch status {
+	case courier.ApproachingLimit:
+		return servers.ApproachingLimit
+	case courier.LimitReached:
+		return servers.LimitReached
+	default:
+		return servers.WithinLimit
+	}
+}
+
+// fromAPIZone maps the API zone to the domain value; a missing zone addresses everyone and maps to nil.
+// Invalid corners are reported as fields of "zone".
+func fromAPIZone(zone *servers.Zone) (*kernel.Zone, error) {
+	if zone == nil {
+		return nil, nil
+	}
+
+	from, fromErr := fromAPILocation(zone.From)
+	to, toErr := fromAPILocation(zone.To)
+	if err := errs.JoinFields(
+		errs.Field("zone.from", fromErr),
+		errs.Field("zone.to", toErr),
+	); err != nil {
+		return nil, err
+	}
+
+	result, err := kernel.NewZone(from, to)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// fromAPILocation maps an API location to the domain value. Coordinates beyond the range of
+// kernel.Coordinate are clamped, so they are still rejected as out of the grid.
+func fromAPILocation(location servers.Location) (kernel.Location, error) {
+	clamp := func(value int) kernel.Coordinate {
+		return kernel.Coordinate(max(math.MinInt8, min(math.MaxInt8, value)))
+	}
+	return kernel.NewLocation(clamp(location.X), clamp(location.Y))
+}
+
+// toAPIZone maps the domain zone to the API representation.
+func toAPIZone(zone kernel.Zone) *servers.Zone {
+	return &servers.Zone{
+		From: servers.Location{X: int(zone.From().X()), Y: int(zone.From().Y())},
+		To:   servers.Location{X: int(zone.To().X()), Y: int(zone.To().Y())},
+	}
+}
+
+// toAPIFleetSimulationOutcome maps a simulation outcome to the API representation.
+func toAPIFleetSimulationOutcome(outcome services.SimulationOutcome) servers.FleetSimulationOutcome {
+	return servers.FleetSimulationOutcome{
+		Couriers:               outcome.Couriers,
+		Orders:                 outcome.Orders,
+		Delivered:              outcome.Delivered,
+		Undelivered:            outcome.Undelivered(),
+		AverageDeliverySeconds: outcome.AverageDeliveryTime.Seconds(),
+		AverageBacklog:         outcome.AverageBacklog,
+		PeakBacklog:            outcome.PeakBacklog,
+	}
+}
+
+// toAPIAnnouncementDelivery maps the delivery of an announcement to the API representation.
+func toAPIAnnouncementDelivery(
+	courierID kernel.UUID,
+	status announcement.DeliveryStatus,
+	changedAt time.Time,
+	failure string,
+) servers.AnnouncementDelivery {
+	response := servers.AnnouncementDelivery{
+		CourierId: courierID.Bytes(),
+		ChangedAt: changedAt,
+	}
+
+	switch status { //nolint:exhaustive // unknown statuses are reported as pending
+	case announcement.Delivered:
+		response.Status = servers.AnnouncementDeliveryStatusDelivered
+	case announcement.Failed:
+		response.Status = servers.AnnouncementDeliveryStatusFailed
+		response.Failure = &failure
+	default:
+		response.Status = servers.AnnouncementDeliveryStatusPending
+	}
+
+	return response
+}
+
+// toAPIPickupSlot maps the pickup slot aggregate to the API representation.
+func toAPIPickupSlot(slot *pickup.Slot) servers.PickupSlot {
+	return servers.PickupSlot{
+		Id:        slot.ID().Bytes(),
+		StartsAt:  slot.StartsAt(),
+		EndsAt:    slot.EndsAt(),
+		Capacity:  slot.Capacity(),
+		Booked:    len(slot.Bookings()),
+		Remaining: slot.Remaining(),
+	}
+}
+
+// toAPIMaintenanceWindow maps a maintenance window to the API representation with its status at now.
+// Windows starting soon are reported as scheduled; the warning period only applies to listings.
+func toAPIMaintenanceWindow(window courier.MaintenanceWindow, now time.Time) servers.MaintenanceWindow {
+	return servers.MaintenanceWindow{
+		Id:       window.ID().Bytes(),
+		StartsAt: window.StartsAt(),
+		EndsAt:   window.EndsAt(),
+		Status:   toAPIMaintenanceStatus(window.Status(now, 0)),
+	}
+}
+
+// toAPIMaintenanceStatus maps the domain maintenance status to the API representation.
+func toAPIMaintenanceStatus(status courier.MaintenanceStatus) servers.MaintenanceStatus {
+	switch status {
+	case courier.MaintenanceApproaching:
+		return servers.Approaching
+	case courier.MaintenanceActive:
+		return servers.Active
+	case courier.MaintenanceFinished:
+		return servers.Finished
+	default:
+		return servers.Scheduled
+	}
+}
+
+// fromAPIScheduleWindows maps the API schedule windows to the domain values.
+// Invalid windows are reported as fields of "windows.<index>".
+func fromAPIScheduleWindows(windows []servers.CourierScheduleWindow) ([]courier.ScheduleWindow, error) {
+	var validation errs.ValidationErrors
+	result := make([]courier.ScheduleWindow, 0, len(windows))
+	for i, window := range windows {
+		weekday, weekdayErr := fromAPIWeekday(window.Weekday)
+		start, startErr := fromAPIClockTime(window.Start)
+		end, endErr := fromAPIClockTime(window.End)
+		if err := errs.JoinFields(
+			errs.Field("weekday", weekdayErr),
+			errs.Field("start", startErr),
+			errs.Field("end", endErr),
+		); err != nil {
+			validation.Add(fmt.Sprintf("windows.%d", i), err)
+			continue
+		}
+
+		scheduleWindow, err := courier.NewScheduleWindow(weekday, start, end)
+		if err != nil {
+			validation.Add(fmt.Sprintf("windows.%d", i), err)
+			continue
+		}
+		result = append(result, scheduleWindow)
+	}
+	if err := validation.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// toAPIScheduleWindows maps the domain schedule windows to the API representation.
+func toAPIScheduleWindows(windows []courier.ScheduleWindow) []servers.CourierScheduleWindow {
+	response := make([]servers.CourierScheduleWindow, len(windows))
+	for i, window := range windows {
+		response[i] = servers.CourierScheduleWindow{
+			Weekday: toAPIWeekday(window.Weekday()),
+			Start:   toAPIClockTime(window.Start()),
+			End:     toAPIClockTime(window.End()),
+		}
+	}
+	return response
+}
+
+// fromAPIWeekday maps the API weekday to the domain value.
+func fromAPIWeekday(weekday servers.Weekday) (time.Weekday, error) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if toAPIWeekday(day) == weekday {
+			return day, nil
+		}
+	}
+	return time.Sunday, errs.NewValueIsInvalidError("weekday")
+}
+
+// toAPIWeekday maps the domain weekday to the API representation, its lowercase English name.
+func toAPIWeekday(weekday time.Weekday) servers.Weekday {
+	return servers.Weekday(strings.ToLower(weekday.String()))
+}
+
+// fromAPIClockTime maps an API time of day, "HH:MM" with "24:00" for the end of the day,
+// to the time since midnight.
+func fromAPIClockTime(value string) (time.Duration, error) {
+	if value == "24:00" {
+		return 24 * time.Hour, nil
+	}
+	clock, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, errs.NewValueIsInvalidErrorWithCause("time", err)
+	}
+	return time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute, nil
+}
+
+// toAPIClockTime maps the time since midnight to the API time of day, "HH:MM".
+func toAPIClockTime(sinceMidnight time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(sinceMidnight.Hours()), int(sinceMidnight.Minutes())%60)
+}
+
+func toAPISurgeToggle(toggle surge.Toggle) servers.SurgeToggle {
+	response := servers.SurgeToggle{
+		Id:        toggle.ID().Bytes(),
+		Source:    servers.SurgeToggleSource(toggle.Source().String()),
+		Setting:   servers.SurgeSetting(toggle.Setting().String()),
+		WasActive: toggle.WasActive(),
+		Active:    toggle.Active(),
+		Backlog:   toggle.Backlog(),
+		ToggledAt: toggle.At(),
+	}
+	if reason := toggle.Reason(); reason != "" {
+		response.Reason = &reason
+	}
+	return response
+}
+
+// valueOrEmpty returns the value of an optional request field, or an empty string if it is absent.
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,38 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"delivery/internal/pkg/i18n"
+	"delivery/internal/pkg/synthetic"
+
+	"github.com/labstack/echo/v4"
+)
+
+// syntheticDataHeader flags requests whose data is synthetic test data.
+const syntheticDataHeader = "X-Synthetic-Data"
+
+// SyntheticDataMiddleware marks requests sent with "X-Synthetic-Data: true", so couriers
+// and orders they create are recorded as test data and purged by the janitor once expired.
+// Requests without the header or with a false value create ordinary data; other values are rejected.
+func SyntheticDataMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		value := ctx.Request().Header.Get(syntheticDataHeader)
+		if value == "" {
+			return next(ctx)
+		}
+
+		marked, err := strconv.ParseBool(value)
+		if err != nil {
+			return respondError(ctx, http.StatusBadRequest, i18n.InvalidSyntheticDataHeader, value)
+		}
+		if !marked {
+			return next(ctx)
+		}
+
+		request := ctx.Request()
+		ctx.SetRequest(request.WithContext(synthetic.WithMarker(request.Context())))
+		return next(ctx)
+	}
+}
@@ -0,0 +1,60 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"delivery/internal/pkg/i18n"
+	"delivery/internal/pkg/tenant"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// tenantHeader names the tenant a request acts for.
+	tenantHeader = "X-Tenant-ID"
+
+	// bearerPrefix precedes the tenant's token in the Authorization header.
+	bearerPrefix = "Bearer "
+)
+
+// TenantMiddleware binds every request to the tenant that authenticated it with its token
+// in the "Authorization: Bearer <token>" header, so the unit of work and queries only see
+// that tenant's data. The X-Tenant-ID header is optional and must name the authenticated
+// tenant: naming another one is refused with 403 Forbidden, and naming a tenant without a
+// token with 401 Unauthorized. Requests with neither act for the default tenant.
+func TenantMiddleware(credentials tenant.Credentials) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			named := ctx.Request().Header.Get(tenantHeader)
+			if named != "" {
+				if err := tenant.ID(named).Validate(); err != nil {
+					return respondError(ctx, http.StatusBadRequest, i18n.InvalidTenant, err.Error())
+				}
+			}
+
+			authorization := ctx.Request().Header.Get(echo.HeaderAuthorization)
+			if authorization == "" {
+				if named == "" {
+					return next(ctx)
+				}
+				ctx.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
+				return respondError(ctx, http.StatusUnauthorized, i18n.TenantTokenIsRequired, named)
+			}
+
+			token, found := strings.CutPrefix(authorization, bearerPrefix)
+			id, ok := credentials.Authenticate(token)
+			if !found || !ok {
+				ctx.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer error="invalid_token"`)
+				return respondError(ctx, http.StatusUnauthorized, i18n.InvalidTenantToken)
+			}
+			if named != "" && tenant.ID(named) != id {
+				return respondError(ctx, http.StatusForbidden, i18n.TenantDoesNotMatchToken, named)
+			}
+
+			request := ctx.Request()
+			ctx.SetRequest(request.WithContext(tenant.WithID(request.Context(), id)))
+			return next(ctx)
+		}
+	}
+}
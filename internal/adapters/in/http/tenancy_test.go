@@ -0,0 +1,86 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"delivery/internal/pkg/tenant"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantMiddleware(t *testing.T) {
+	credentials, err := tenant.ParseCredentials("acme:acme-token-0123456789,globex:globex-token-0123456789")
+	require.NoError(t, err)
+
+	serve := func(named, authorization string) (*httptest.ResponseRecorder, *tenant.ID) {
+		var bound *tenant.ID
+		e := echo.New()
+		e.Use(TenantMiddleware(credentials))
+		e.GET("/api/v1/orders/active", func(ctx echo.Context) error {
+			if id, ok := tenant.FromContext(ctx.Request().Context()); ok {
+				bound = &id
+			}
+			return ctx.NoContent(http.StatusNoContent)
+		})
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/orders/active", nil)
+		if named != "" {
+			request.Header.Set(tenantHeader, named)
+		}
+		if authorization != "" {
+			request.Header.Set(echo.HeaderAuthorization, authorization)
+		}
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, request)
+		return recorder, bound
+	}
+
+	t.Run("binds the request to the tenant of the token", func(t *testing.T) {
+		recorder, bound := serve("", "Bearer acme-token-0123456789")
+		assert.Equal(t, http.StatusNoContent, recorder.Code)
+		require.NotNil(t, bound)
+		assert.Equal(t, tenant.ID("acme"), *bound)
+
+		recorder, bound = serve("acme", "Bearer acme-token-0123456789")
+		assert.Equal(t, http.StatusNoContent, recorder.Code)
+		require.NotNil(t, bound)
+		assert.Equal(t, tenant.ID("acme"), *bound)
+	})
+
+	t.Run("acts for the default tenant without a tenant and a token", func(t *testing.T) {
+		recorder, bound := serve("", "")
+		assert.Equal(t, http.StatusNoContent, recorder.Code)
+		assert.Nil(t, bound)
+	})
+
+	t.Run("refuses a tenant named without its token", func(t *testing.T) {
+		recorder, bound := serve("acme", "")
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+		assert.Equal(t, "Bearer", recorder.Header().Get(echo.HeaderWWWAuthenticate))
+		assert.Nil(t, bound)
+	})
+
+	t.Run("refuses an unknown token", func(t *testing.T) {
+		for _, authorization := range []string{"Bearer forged-token-0123456789", "acme-token-0123456789"} {
+			recorder, bound := serve("acme", authorization)
+			assert.Equal(t, http.StatusUnauthorized, recorder.Code, authorization)
+			assert.Nil(t, bound)
+		}
+	})
+
+	t.Run("refuses a tenant the token does not authenticate", func(t *testing.T) {
+		recorder, bound := serve("acme", "Bearer globex-token-0123456789")
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+		assert.Nil(t, bound)
+	})
+
+	t.Run("rejects a malformed tenant", func(t *testing.T) {
+		recorder, bound := serve("Not A Tenant!", "Bearer acme-token-0123456789")
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Nil(t, bound)
+	})
+}
@@ -0,0 +1,135 @@
+// Package alerthook provides a client posting service level and escalation alerts to an alerting
+// webhook, such as an incident management or chat integration.
+package alerthook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"delivery/internal/core/ports"
+)
+
+// sloBurnEvent is the body posted to the webhook for a burning or resolved stage.
+// Status is "firing" while the stage burns its error budget and "resolved" once it stopped.
+type sloBurnEvent struct {
+	Alert         string  `json:"alert"`
+	Status        string  `json:"status"`
+	Stage         string  `json:"stage"`
+	BurnRate      float64 `json:"burnRate"`
+	AlertBurnRate float64 `json:"alertBurnRate"`
+	Compliance    float64 `json:"compliance"`
+	Goal          float64 `json:"goal"`
+	Orders        int     `json:"orders"`
+	WindowSeconds int64   `json:"windowSeconds"`
+}
+
+// orderEscalationEvent is the body posted to the webhook for an urgent order reaching an escalation tier.
+type orderEscalationEvent struct {
+	Alert         string   `json:"alert"`
+	Status        string   `json:"status"`
+	OrderID       string   `json:"orderId"`
+	Tier          int      `json:"tier"`
+	Tiers         int      `json:"tiers"`
+	WaitedSeconds int64    `json:"waitedSeconds"`
+	Relaxations   []string `json:"relaxations"`
+}
+
+// Client implements ports.SLOAlert and ports.OrderEscalationAlert over a webhook accepting JSON posts.
+// Any 2xx response counts as delivered.
+type Client struct {
+	url        string
+	httpClient *http.Client
+	logger     *slog.Logger
+}
+
+// NewClient creates a client posting to the webhook at url with the given request timeout.
+func NewClient(url string, timeout time.Duration, logger *slog.Logger) *Client {
+	return &Client{
+		url:        url,
+		httpClient: &http.Client{Timeout: timeout},
+		logger:     logger.With("component", "alert_hook_client"),
+	}
+}
+
+// NotifySLOBurn posts the burning or resolved stage to the webhook.
+func (c *Client) NotifySLOBurn(ctx context.Context, burn ports.SLOBurn) error {
+	status := "firing"
+	if burn.Resolved {
+		status = "resolved"
+	}
+
+	err := c.post(ctx, sloBurnEvent{
+		Alert:         "slo_burn_rate",
+		Status:        status,
+		Stage:         burn.Stage.String(),
+		BurnRate:      burn.BurnRate,
+		AlertBurnRate: burn.AlertBurnRate,
+		Compliance:    burn.Compliance,
+		Goal:          burn.Goal,
+		Orders:        burn.Orders,
+		WindowSeconds: int64(burn.Window.Seconds()),
+	})
+	if err != nil {
+		c.logger.WarnContext(ctx, "Alert webhook notification failed",
+			"stage", burn.Stage.String(),
+			"status", status,
+			"error", err)
+		return fmt.Errorf("alert webhook: %w", err)
+	}
+	return nil
+}
+
+// NotifyOrderEscalation posts the urgent order that reached an escalation tier to the webhook.
+func (c *Client) NotifyOrderEscalation(ctx context.Context, escalated ports.OrderEscalation) error {
+	relaxations := make([]string, 0, len(escalated.Relaxations))
+	for _, relaxation := range escalated.Relaxations {
+		relaxations = append(relaxations, relaxation.String())
+	}
+
+	err := c.post(ctx, orderEscalationEvent{
+		Alert:         "urgent_order_escalation",
+		Status:        "firing",
+		OrderID:       escalated.OrderID.String(),
+		Tier:          escalated.Tier,
+		Tiers:         escalated.Tiers,
+		WaitedSeconds: int64(escalated.Waited.Seconds()),
+		Relaxations:   relaxations,
+	})
+	if err != nil {
+		c.logger.WarnContext(ctx, "Alert webhook notification failed",
+			"order_id", escalated.OrderID.String(),
+			"tier", escalated.Tier,
+			"error", err)
+		return fmt.Errorf("alert webhook: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) post(ctx context.Context, event any) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status %d", response.StatusCode)
+	}
+	return nil
+}
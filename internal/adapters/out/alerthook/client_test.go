@@ -0,0 +1,109 @@
+package alerthook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/alerthook"
+	"delivery/internal/core/domain/model/escalation"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/slo"
+	"delivery/internal/core/ports"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *alerthook.Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return alerthook.NewClient(server.URL+"/hooks/slo", time.Second, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestClient_NotifySLOBurn_PostsEvent(t *testing.T) {
+	tests := []struct {
+		name     string
+		resolved bool
+		status   string
+	}{
+		{"firing", false, "firing"},
+		{"resolved", true, "resolved"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/hooks/slo", r.URL.Path)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+				var body map[string]any
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, "slo_burn_rate", body["alert"])
+				assert.Equal(t, tt.status, body["status"])
+				assert.Equal(t, "delivery", body["stage"])
+				assert.InDelta(t, 4.5, body["burnRate"], 0.001)
+				assert.InDelta(t, 3600.0, body["windowSeconds"], 0.001)
+
+				w.WriteHeader(http.StatusAccepted)
+			})
+
+			err := client.NotifySLOBurn(context.Background(), ports.SLOBurn{
+				Stage:         slo.DeliveryStage,
+				BurnRate:      4.5,
+				AlertBurnRate: 2,
+				Compliance:    77.5,
+				Goal:          95,
+				Orders:        40,
+				Window:        time.Hour,
+				Resolved:      tt.resolved,
+			})
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestClient_NotifySLOBurn_UnexpectedStatus_ReturnsError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	err := client.NotifySLOBurn(context.Background(), ports.SLOBurn{Stage: slo.AssignmentStage})
+
+	require.Error(t, err)
+}
+
+func TestClient_NotifyOrderEscalation_PostsEvent(t *testing.T) {
+	orderID := kernel.NewUUID()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "urgent_order_escalation", body["alert"])
+		assert.Equal(t, "firing", body["status"])
+		assert.Equal(t, orderID.String(), body["orderId"])
+		assert.InDelta(t, 2.0, body["tier"], 0.001)
+		assert.InDelta(t, 1800.0, body["waitedSeconds"], 0.001)
+		assert.Equal(t, []any{"matching-rules"}, body["relaxations"])
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	err := client.NotifyOrderEscalation(context.Background(), ports.OrderEscalation{
+		OrderID:     orderID,
+		Tier:        2,
+		Tiers:       2,
+		Waited:      30 * time.Minute,
+		Relaxations: []escalation.Relaxation{escalation.WaiveMatchingRules},
+	})
+
+	require.NoError(t, err)
+}
@@ -0,0 +1,96 @@
+// Package availabilityhook provides a publisher posting courier availability events to a webhook,
+// such as the one of a workforce management system mirroring the fleet.
+package availabilityhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"delivery/internal/adapters/out/postgres/outboxrepo"
+	"delivery/internal/core/ports"
+)
+
+const (
+	// messageIDHeader carries the outbox message ID so the receiver can deduplicate redeliveries.
+	messageIDHeader = "Message-Id"
+	// eventTypeHeader carries the integration event name.
+	eventTypeHeader = "Event-Type"
+	// traceParentHeader carries the W3C trace context the message was recorded in.
+	traceParentHeader = "Traceparent"
+)
+
+// Publisher implements ports.EventPublisher by posting CourierAvailabilityChanged events to a
+// webhook accepting JSON posts and passing every message on to the next publisher, the message
+// broker, if any. Any 2xx response counts as delivered.
+//
+// A failed post fails the publish, so the outbox relay retries the message on its next run:
+// the webhook receives every change at least once and in order, and drops the duplicates by
+// message ID or by the version in the body.
+//
+// Example:
+//
+//	publisher := availabilityhook.NewPublisher(url, 5*time.Second, kafkaPublisher, logger)
+//	relay := commands.NewRelayOutboxCommandHandler(outboxRepo, publisher)
+type Publisher struct {
+	url        string
+	httpClient *http.Client
+	next       ports.EventPublisher
+	logger     *slog.Logger
+}
+
+// NewPublisher creates a publisher posting to the webhook at url with the given request timeout.
+// Messages are passed on to next; a nil next leaves the webhook the only destination.
+func NewPublisher(url string, timeout time.Duration, next ports.EventPublisher, logger *slog.Logger) *Publisher {
+	return &Publisher{
+		url:        url,
+		httpClient: &http.Client{Timeout: timeout},
+		next:       next,
+		logger:     logger.With("component", "availability_hook_publisher"),
+	}
+}
+
+// Publish posts the message to the webhook if it is a courier availability event, then passes it on.
+func (p *Publisher) Publish(ctx context.Context, message ports.OutboxMessage) error {
+	if message.EventType == outboxrepo.CourierAvailabilityChangedEvent {
+		if err := p.post(ctx, message); err != nil {
+			p.logger.WarnContext(ctx, "Availability webhook delivery failed",
+				"message_id", message.ID.String(),
+				"courier_id", message.AggregateID,
+				"error", err)
+			return fmt.Errorf("availability webhook: %w", err)
+		}
+	}
+
+	if p.next == nil {
+		return nil
+	}
+	return p.next.Publish(ctx, message)
+}
+
+func (p *Publisher) post(ctx context.Context, message ports.OutboxMessage) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(message.Payload))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(messageIDHeader, message.ID.String())
+	request.Header.Set(eventTypeHeader, message.EventType)
+	if message.TraceParent != "" {
+		request.Header.Set(traceParentHeader, message.TraceParent)
+	}
+
+	response, err := p.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status %d", response.StatusCode)
+	}
+	return nil
+}
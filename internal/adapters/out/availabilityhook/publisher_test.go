@@ -0,0 +1,122 @@
+package availabilityhook_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/availabilityhook"
+	"delivery/internal/adapters/out/postgres/outboxrepo"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingPublisher keeps the messages passed on to it and fails with err.
+type recordingPublisher struct {
+	published []ports.OutboxMessage
+	err       error
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, message ports.OutboxMessage) error {
+	p.published = append(p.published, message)
+	return p.err
+}
+
+func newTestPublisher(t *testing.T, next ports.EventPublisher, handler http.HandlerFunc) *availabilityhook.Publisher {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return availabilityhook.NewPublisher(
+		server.URL+"/hooks/availability",
+		time.Second,
+		next,
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+	)
+}
+
+func availabilityMessage(t *testing.T) ports.OutboxMessage {
+	t.Helper()
+	message, err := outboxrepo.NewCourierAvailabilityChangedMessage(
+		kernel.NewUUID(), courier.AvailabilityBusy, courier.AvailabilityFree, 2, time.Now(),
+	)
+	require.NoError(t, err)
+	return message
+}
+
+func TestPublisher_Publish_PostsAvailabilityEvents(t *testing.T) {
+	message := availabilityMessage(t)
+	next := &recordingPublisher{}
+	posted := 0
+	publisher := newTestPublisher(t, next, func(w http.ResponseWriter, r *http.Request) {
+		posted++
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/hooks/availability", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, message.ID.String(), r.Header.Get("Message-Id"))
+		assert.Equal(t, outboxrepo.CourierAvailabilityChangedEvent, r.Header.Get("Event-Type"))
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, string(message.Payload), string(body))
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := publisher.Publish(context.Background(), message)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, posted)
+	assert.Equal(t, []ports.OutboxMessage{message}, next.published)
+}
+
+func TestPublisher_Publish_PassesOtherEventsOn(t *testing.T) {
+	next := &recordingPublisher{}
+	publisher := newTestPublisher(t, next, func(http.ResponseWriter, *http.Request) {
+		t.Error("only availability events are posted")
+	})
+	message := outboxrepo.NewOrderErasedMessage(kernel.NewUUID(), time.Now())
+
+	err := publisher.Publish(context.Background(), message)
+
+	require.NoError(t, err)
+	assert.Equal(t, []ports.OutboxMessage{message}, next.published)
+}
+
+func TestPublisher_Publish_UnexpectedStatus_ReturnsError(t *testing.T) {
+	next := &recordingPublisher{}
+	publisher := newTestPublisher(t, next, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	err := publisher.Publish(context.Background(), availabilityMessage(t))
+
+	// The relay retries the message, so it is not passed on yet
+	require.Error(t, err)
+	assert.Empty(t, next.published)
+}
+
+func TestPublisher_Publish_WithoutNextPublisher(t *testing.T) {
+	publisher := newTestPublisher(t, nil, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	require.NoError(t, publisher.Publish(context.Background(), availabilityMessage(t)))
+}
+
+func TestPublisher_Publish_ReturnsErrorOfNextPublisher(t *testing.T) {
+	boom := errors.New("broker unavailable")
+	publisher := newTestPublisher(t, &recordingPublisher{err: boom}, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	require.ErrorIs(t, publisher.Publish(context.Background(), availabilityMessage(t)), boom)
+}
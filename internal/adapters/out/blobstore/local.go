@@ -0,0 +1,193 @@
+// Package blobstore provides the blob storage backends files such as delivery photos and courier
+// documents are uploaded to: a local directory served by the service itself, and S3 or an
+// S3-compatible store like MinIO. Clients upload with presigned URLs, so every backend checks the
+// content type, size and expiry of an upload without the service handling the file in between.
+package blobstore
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"delivery/internal/core/ports"
+)
+
+// LocalUploadPath is the path prefix LocalStorage receives uploads under.
+const LocalUploadPath = "/blobs/"
+
+var (
+	// ErrSigningKeyIsRequired is returned when a local storage is created without a signing key.
+	ErrSigningKeyIsRequired = errors.New("blob storage signing key is required")
+
+	// ErrBlobKeyIsInvalid is returned for keys that are empty or would leave the storage,
+	// such as absolute paths or keys with ".." segments.
+	ErrBlobKeyIsInvalid = errors.New("blob key is invalid")
+)
+
+// LocalStorage implements ports.BlobStorage over a directory of the local filesystem.
+// It is meant for development and single-instance deployments: the service receives the uploads
+// itself at LocalUploadPath, checking the HMAC signature of the URL it issued before writing
+// the file, so the upload flow is the same as with S3.
+type LocalStorage struct {
+	root       string
+	publicURL  string
+	signingKey []byte
+	now        func() time.Time
+}
+
+// NewLocalStorage creates a storage that keeps files under root and issues upload URLs
+// on publicURL, the address clients reach the service at. URLs are signed with signingKey.
+func NewLocalStorage(root string, publicURL string, signingKey []byte) (*LocalStorage, error) {
+	if len(signingKey) == 0 {
+		return nil, ErrSigningKeyIsRequired
+	}
+	if root == "" {
+		return nil, errors.New("blob storage directory is required")
+	}
+
+	return &LocalStorage{
+		root:       root,
+		publicURL:  strings.TrimRight(publicURL, "/"),
+		signingKey: signingKey,
+		now:        time.Now,
+	}, nil
+}
+
+// SignUpload issues a URL that accepts a PUT of exactly size bytes of the content type until expiresAt.
+func (s *LocalStorage) SignUpload(
+	_ context.Context,
+	key string,
+	contentType string,
+	size int64,
+	expiresAt time.Time,
+) (ports.SignedUpload, error) {
+	if !validKey(key) {
+		return ports.SignedUpload{}, ErrBlobKeyIsInvalid
+	}
+
+	expires := strconv.FormatInt(expiresAt.Unix(), 10)
+	signature := s.sign(key, contentType, size, expires)
+	return ports.SignedUpload{
+		URL:    s.publicURL + LocalUploadPath + key + "?expires=" + expires + "&signature=" + signature,
+		Method: http.MethodPut,
+		Headers: map[string]string{
+			"Content-Type":   contentType,
+			"Content-Length": strconv.FormatInt(size, 10),
+		},
+		ExpiresAt: time.Unix(expiresAt.Unix(), 0).UTC(),
+	}, nil
+}
+
+// Delete removes the file stored under key.
+func (s *LocalStorage) Delete(_ context.Context, key string) error {
+	if !validKey(key) {
+		return ErrBlobKeyIsInvalid
+	}
+
+	err := os.Remove(s.path(key))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// ServeHTTP receives an upload to a URL issued by SignUpload. The file is written only if the
+// signature matches the requested key, content type and size and the URL has not expired;
+// a body of another size is refused and leaves no file behind.
+func (s *LocalStorage) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	key := strings.TrimPrefix(r.URL.Path, LocalUploadPath)
+	expires := r.URL.Query().Get("expires")
+	if !validKey(key) || !s.verify(key, r.Header.Get("Content-Type"), r.ContentLength, expires,
+		r.URL.Query().Get("signature")) {
+		http.Error(w, "signature does not match", http.StatusForbidden)
+		return
+	}
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || s.now().Unix() > expiresAt {
+		http.Error(w, "upload URL has expired", http.StatusForbidden)
+		return
+	}
+
+	if err = s.write(key, r.Body, r.ContentLength); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// write stores exactly size bytes of body under key, replacing the file atomically.
+func (s *LocalStorage) write(key string, body io.Reader, size int64) error {
+	path := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(file.Name())
+	}()
+
+	written, err := io.Copy(file, io.LimitReader(body, size+1))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if written != size {
+		return fmt.Errorf("body has %d bytes, the upload was signed for %d", written, size)
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+func (s *LocalStorage) path(key string) string {
+	return filepath.Join(s.root, filepath.FromSlash(key))
+}
+
+func (s *LocalStorage) sign(key string, contentType string, size int64, expires string) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(strings.Join(
+		[]string{http.MethodPut, key, contentType, strconv.FormatInt(size, 10), expires}, "\n")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *LocalStorage) verify(key string, contentType string, size int64, expires string, signature string) bool {
+	received, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	expected, _ := hex.DecodeString(s.sign(key, contentType, size, expires))
+	return hmac.Equal(received, expected)
+}
+
+// validKey accepts relative slash-separated keys without empty, "." or ".." segments.
+func validKey(key string) bool {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return false
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,119 @@
+package blobstore_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/blobstore"
+	"delivery/internal/core/ports"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const photoKey = "delivery-photo/order/upload.jpg"
+
+// newLocalStorage creates a storage in a temporary directory served by a test server.
+func newLocalStorage(t *testing.T) (*blobstore.LocalStorage, string) {
+	t.Helper()
+	root := t.TempDir()
+	var storage *blobstore.LocalStorage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		storage.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	storage, err := blobstore.NewLocalStorage(root, server.URL, []byte("secret"))
+	require.NoError(t, err)
+	return storage, root
+}
+
+// upload sends body to the signed URL with the given content type.
+func upload(t *testing.T, signed ports.SignedUpload, contentType string, body []byte) int {
+	t.Helper()
+	req, err := http.NewRequestWithContext(context.Background(), signed.Method, signed.URL, bytes.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestLocalStorage_Upload(t *testing.T) {
+	ctx := context.Background()
+	body := []byte("jpeg bytes")
+	expiresAt := time.Now().Add(time.Minute)
+
+	t.Run("should store a file uploaded as signed", func(t *testing.T) {
+		storage, root := newLocalStorage(t)
+		signed, err := storage.SignUpload(ctx, photoKey, "image/jpeg", int64(len(body)), expiresAt)
+		require.NoError(t, err)
+		assert.Equal(t, http.MethodPut, signed.Method)
+		assert.Equal(t, "image/jpeg", signed.Headers["Content-Type"])
+		assert.Equal(t, strconv.Itoa(len(body)), signed.Headers["Content-Length"])
+
+		require.Equal(t, http.StatusOK, upload(t, signed, "image/jpeg", body))
+
+		stored, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(photoKey)))
+		require.NoError(t, err)
+		assert.Equal(t, body, stored)
+	})
+
+	t.Run("should refuse an upload that does not match the signature", func(t *testing.T) {
+		storage, root := newLocalStorage(t)
+		signed, err := storage.SignUpload(ctx, photoKey, "image/jpeg", int64(len(body)), expiresAt)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusForbidden, upload(t, signed, "application/pdf", body))
+		assert.Equal(t, http.StatusForbidden, upload(t, signed, "image/jpeg", append(body, 'x')))
+
+		_, err = os.Stat(filepath.Join(root, filepath.FromSlash(photoKey)))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("should refuse an expired upload", func(t *testing.T) {
+		storage, _ := newLocalStorage(t)
+		signed, err := storage.SignUpload(ctx, photoKey, "image/jpeg", int64(len(body)), time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusForbidden, upload(t, signed, "image/jpeg", body))
+	})
+
+	t.Run("should refuse keys leaving the storage", func(t *testing.T) {
+		storage, _ := newLocalStorage(t)
+
+		for _, key := range []string{"", "/etc/passwd", "delivery-photo/../../secret", "a//b"} {
+			_, err := storage.SignUpload(ctx, key, "image/jpeg", 1, expiresAt)
+			require.ErrorIs(t, err, blobstore.ErrBlobKeyIsInvalid, key)
+		}
+	})
+}
+
+func TestLocalStorage_Delete(t *testing.T) {
+	ctx := context.Background()
+	storage, root := newLocalStorage(t)
+	signed, err := storage.SignUpload(ctx, photoKey, "image/jpeg", 4, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, upload(t, signed, "image/jpeg", []byte("jpeg")))
+
+	require.NoError(t, storage.Delete(ctx, photoKey))
+	require.NoError(t, storage.Delete(ctx, photoKey), "deleting a missing file is not an error")
+
+	_, err = os.Stat(filepath.Join(root, filepath.FromSlash(photoKey)))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestNewLocalStorage_RequiresSigningKey(t *testing.T) {
+	_, err := blobstore.NewLocalStorage(t.TempDir(), "http://localhost:8082", nil)
+
+	require.ErrorIs(t, err, blobstore.ErrSigningKeyIsRequired)
+}
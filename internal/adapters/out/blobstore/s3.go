@@ -0,0 +1,159 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"delivery/internal/core/ports"
+)
+
+// maxPresignExpiry is the longest validity S3 accepts for a presigned URL.
+const maxPresignExpiry = 7 * 24 * time.Hour
+
+// S3Config locates a bucket and the credentials to access it with.
+type S3Config struct {
+	// Endpoint is the address of an S3-compatible store such as MinIO, e.g. http://minio:9000.
+	// The bucket is then addressed in the path. Empty for Amazon S3, whose buckets are
+	// addressed as virtual hosts of the region's endpoint.
+	Endpoint string
+
+	// Region is the region of the bucket, e.g. eu-central-1; MinIO accepts us-east-1.
+	Region string
+
+	// Bucket is the bucket files are stored in.
+	Bucket string
+
+	// AccessKeyID and SecretAccessKey are the credentials requests are signed with.
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// S3Storage implements ports.BlobStorage over a bucket of Amazon S3 or an S3-compatible store.
+// Clients upload with presigned PUT URLs that also sign the content type and length,
+// so the store refuses a file of another type or size than the one the upload was issued for.
+type S3Storage struct {
+	baseURL    *url.URL
+	pathStyle  bool
+	bucket     string
+	signer     sigV4Signer
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// NewS3Storage creates a storage for the configured bucket. Deletes time out after timeout.
+func NewS3Storage(config S3Config, timeout time.Duration) (*S3Storage, error) {
+	if config.Bucket == "" || config.Region == "" {
+		return nil, errors.New("S3 bucket and region are required")
+	}
+	if config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return nil, errors.New("S3 credentials are required")
+	}
+
+	storage := &S3Storage{
+		bucket: config.Bucket,
+		signer: sigV4Signer{
+			accessKeyID:     config.AccessKeyID,
+			secretAccessKey: config.SecretAccessKey,
+			region:          config.Region,
+		},
+		httpClient: &http.Client{Timeout: timeout},
+		now:        time.Now,
+	}
+
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.s3.%s.amazonaws.com", config.Bucket, config.Region)
+	} else {
+		storage.pathStyle = true
+	}
+	baseURL, err := url.Parse(strings.TrimRight(endpoint, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid S3 endpoint: %w", err)
+	}
+	if baseURL.Scheme != "http" && baseURL.Scheme != "https" {
+		return nil, fmt.Errorf("invalid S3 endpoint %q: scheme must be http or https", endpoint)
+	}
+	storage.baseURL = baseURL
+
+	return storage, nil
+}
+
+// SignUpload presigns a PUT of exactly size bytes of the content type under key until expiresAt.
+func (s *S3Storage) SignUpload(
+	_ context.Context,
+	key string,
+	contentType string,
+	size int64,
+	expiresAt time.Time,
+) (ports.SignedUpload, error) {
+	if !validKey(key) {
+		return ports.SignedUpload{}, ErrBlobKeyIsInvalid
+	}
+
+	now := s.now().UTC().Truncate(time.Second)
+	expires := expiresAt.Sub(now).Truncate(time.Second)
+	if expires <= 0 || expires > maxPresignExpiry {
+		return ports.SignedUpload{}, fmt.Errorf("upload URL must expire within %s", maxPresignExpiry)
+	}
+
+	u := s.objectURL(key)
+	headers := http.Header{}
+	headers.Set("Host", u.Host)
+	headers.Set("Content-Type", contentType)
+	headers.Set("Content-Length", strconv.FormatInt(size, 10))
+
+	return ports.SignedUpload{
+		URL:    s.signer.presign(http.MethodPut, u, headers, now, expires).String(),
+		Method: http.MethodPut,
+		Headers: map[string]string{
+			"Content-Type":   contentType,
+			"Content-Length": strconv.FormatInt(size, 10),
+		},
+		ExpiresAt: now.Add(expires),
+	}, nil
+}
+
+// Delete removes the object stored under key. S3 answers deletes of missing objects with
+// success as well, and a 404 of a store that does not is accepted too.
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	if !validKey(key) {
+		return ErrBlobKeyIsInvalid
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key).String(), nil)
+	if err != nil {
+		return err
+	}
+	s.signer.sign(req, s.now())
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return fmt.Errorf("S3 delete of %s returned status %d", key, resp.StatusCode)
+	}
+}
+
+// objectURL addresses the object in the bucket, in the path for S3-compatible stores.
+func (s *S3Storage) objectURL(key string) *url.URL {
+	u := *s.baseURL
+	path := "/" + key
+	if s.pathStyle {
+		path = "/" + s.bucket + path
+	}
+	u.Path = strings.TrimRight(u.Path, "/") + path
+	u.RawPath = ""
+	return &u
+}
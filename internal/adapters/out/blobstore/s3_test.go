@@ -0,0 +1,103 @@
+package blobstore_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/blobstore"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newS3Storage(t *testing.T, endpoint string) *blobstore.S3Storage {
+	t.Helper()
+	storage, err := blobstore.NewS3Storage(blobstore.S3Config{
+		Endpoint:        endpoint,
+		Region:          "us-east-1",
+		Bucket:          "deliveries",
+		AccessKeyID:     "minio",
+		SecretAccessKey: "minio-secret",
+	}, time.Second)
+	require.NoError(t, err)
+	return storage
+}
+
+func TestS3Storage_SignUpload(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should address MinIO buckets in the path", func(t *testing.T) {
+		storage := newS3Storage(t, "http://minio:9000")
+
+		signed, err := storage.SignUpload(ctx, photoKey, "image/png", 2048, time.Now().Add(15*time.Minute))
+
+		require.NoError(t, err)
+		u, err := url.Parse(signed.URL)
+		require.NoError(t, err)
+		assert.Equal(t, "minio:9000", u.Host)
+		assert.Equal(t, "/deliveries/"+photoKey, u.Path)
+		assert.Equal(t, "content-length;content-type;host", u.Query().Get("X-Amz-SignedHeaders"))
+		assert.Contains(t, []string{"899", "900"}, u.Query().Get("X-Amz-Expires"), "whole seconds until expiry")
+		assert.Len(t, u.Query().Get("X-Amz-Signature"), 64)
+		assert.Equal(t, http.MethodPut, signed.Method)
+		assert.Equal(t, map[string]string{"Content-Type": "image/png", "Content-Length": "2048"}, signed.Headers)
+	})
+
+	t.Run("should address Amazon S3 buckets as virtual hosts", func(t *testing.T) {
+		storage := newS3Storage(t, "")
+
+		signed, err := storage.SignUpload(ctx, photoKey, "image/png", 2048, time.Now().Add(time.Hour))
+
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(signed.URL, "https://deliveries.s3.us-east-1.amazonaws.com/"+photoKey+"?"),
+			signed.URL)
+	})
+
+	t.Run("should refuse expiries S3 does not accept", func(t *testing.T) {
+		storage := newS3Storage(t, "http://minio:9000")
+
+		_, err := storage.SignUpload(ctx, photoKey, "image/png", 2048, time.Now().Add(8*24*time.Hour))
+		require.Error(t, err)
+		_, err = storage.SignUpload(ctx, photoKey, "image/png", 2048, time.Now().Add(-time.Minute))
+		require.Error(t, err)
+	})
+}
+
+func TestS3Storage_Delete(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{name: "deleted", status: http.StatusNoContent},
+		{name: "missing", status: http.StatusNotFound},
+		{name: "forbidden", status: http.StatusForbidden, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, "/deliveries/"+photoKey, r.URL.Path)
+				assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"),
+					"AWS4-HMAC-SHA256 Credential=minio/"), r.Header.Get("Authorization"))
+				assert.Equal(t, "UNSIGNED-PAYLOAD", r.Header.Get("X-Amz-Content-Sha256"))
+				w.WriteHeader(tt.status)
+			}))
+			t.Cleanup(server.Close)
+
+			err := newS3Storage(t, server.URL).Delete(context.Background(), photoKey)
+
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
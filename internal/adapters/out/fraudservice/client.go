@@ -0,0 +1,114 @@
+// Package fraudservice provides a client for an external anti-fraud service.
+package fraudservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"delivery/internal/core/ports"
+)
+
+// checkOrderPath is the endpoint of the anti-fraud service that screens orders.
+const checkOrderPath = "/v1/checks/orders"
+
+// unavailableReason is recorded on orders held because the service could not be reached.
+const unavailableReason = "fraud service unavailable"
+
+// checkOrderRequest is the body sent to the anti-fraud service.
+type checkOrderRequest struct {
+	OrderID string `json:"orderId"`
+	Street  string `json:"street"`
+	Volume  int    `json:"volume"`
+}
+
+// checkOrderResponse is the decision returned by the anti-fraud service.
+// Verdict is one of "clear", "review" or "reject".
+type checkOrderResponse struct {
+	Verdict string `json:"verdict"`
+	Reason  string `json:"reason"`
+}
+
+// Client implements ports.FraudChecker over the anti-fraud service HTTP API.
+//
+// The client never blocks order intake because of its own failures: when the service
+// is unreachable, times out or answers with an unexpected response, the order is
+// accepted but held for manual review.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	logger     *slog.Logger
+}
+
+// NewClient creates a client for the service at baseURL with the given request timeout.
+func NewClient(baseURL string, timeout time.Duration, logger *slog.Logger) *Client {
+	return &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: timeout},
+		logger:     logger.With("component", "fraud_service_client"),
+	}
+}
+
+// CheckOrder asks the anti-fraud service to screen the order.
+func (c *Client) CheckOrder(ctx context.Context, check ports.OrderFraudCheck) (ports.FraudAssessment, error) {
+	response, err := c.call(ctx, check)
+	if err != nil {
+		c.logger.WarnContext(ctx, "Fraud service check failed, holding order for review",
+			"order_id", check.OrderID.String(),
+			"error", err)
+		return ports.FraudAssessment{Verdict: ports.FraudVerdictReview, Reason: unavailableReason}, nil
+	}
+
+	switch response.Verdict {
+	case "clear":
+		return ports.FraudAssessment{Verdict: ports.FraudVerdictClear}, nil
+	case "review":
+		return ports.FraudAssessment{Verdict: ports.FraudVerdictReview, Reason: response.Reason}, nil
+	case "reject":
+		return ports.FraudAssessment{Verdict: ports.FraudVerdictReject, Reason: response.Reason}, nil
+	default:
+		c.logger.WarnContext(ctx, "Fraud service returned unknown verdict, holding order for review",
+			"order_id", check.OrderID.String(),
+			"verdict", response.Verdict)
+		return ports.FraudAssessment{Verdict: ports.FraudVerdictReview, Reason: unavailableReason}, nil
+	}
+}
+
+func (c *Client) call(ctx context.Context, check ports.OrderFraudCheck) (checkOrderResponse, error) {
+	body, err := json.Marshal(checkOrderRequest{
+		OrderID: check.OrderID.String(),
+		Street:  check.Street,
+		Volume:  check.Volume,
+	})
+	if err != nil {
+		return checkOrderResponse{}, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+checkOrderPath, bytes.NewReader(body))
+	if err != nil {
+		return checkOrderResponse{}, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return checkOrderResponse{}, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return checkOrderResponse{}, fmt.Errorf("unexpected status %d", response.StatusCode)
+	}
+
+	var decoded checkOrderResponse
+	if err = json.NewDecoder(response.Body).Decode(&decoded); err != nil {
+		return checkOrderResponse{}, err
+	}
+
+	return decoded, nil
+}
@@ -0,0 +1,95 @@
+package fraudservice_test
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/fraudservice"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *fraudservice.Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return fraudservice.NewClient(server.URL, time.Second, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestClient_CheckOrder_MapsVerdicts(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		expected ports.FraudAssessment
+	}{
+		{"clear", `{"verdict":"clear"}`, ports.FraudAssessment{Verdict: ports.FraudVerdictClear}},
+		{"review", `{"verdict":"review","reason":"new device"}`,
+			ports.FraudAssessment{Verdict: ports.FraudVerdictReview, Reason: "new device"}},
+		{"reject", `{"verdict":"reject","reason":"stolen card"}`,
+			ports.FraudAssessment{Verdict: ports.FraudVerdictReject, Reason: "stolen card"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orderID := kernel.NewUUID()
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/v1/checks/orders", r.URL.Path)
+
+				var body map[string]any
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, orderID.String(), body["orderId"])
+				assert.Equal(t, "Main St", body["street"])
+
+				_, _ = w.Write([]byte(tt.response))
+			})
+
+			assessment, err := client.CheckOrder(t.Context(), ports.OrderFraudCheck{
+				OrderID: orderID,
+				Street:  "Main St",
+				Volume:  10,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, assessment)
+		})
+	}
+}
+
+func TestClient_CheckOrder_FailuresHoldForReview(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+	}{
+		{"server error", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}},
+		{"malformed body", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("not json"))
+		}},
+		{"unknown verdict", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"verdict":"maybe"}`))
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, tt.handler)
+
+			assessment, err := client.CheckOrder(t.Context(), ports.OrderFraudCheck{OrderID: kernel.NewUUID()})
+
+			require.NoError(t, err)
+			assert.Equal(t, ports.FraudVerdictReview, assessment.Verdict)
+			assert.NotEmpty(t, assessment.Reason)
+		})
+	}
+}
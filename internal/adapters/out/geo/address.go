@@ -0,0 +1,75 @@
+package geo
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// streetWords maps the abbreviations of street types and other address words, in Russian and
+// English, to one spelling, so "ул. Ленина" and "улица Ленина" or "5 Main St." and
+// "5 Main Street" normalize to the same address.
+var streetWords = map[string]string{
+	"ул":    "улица",
+	"пр":    "проспект",
+	"пр-т":  "проспект",
+	"просп": "проспект",
+	"пер":   "переулок",
+	"пл":    "площадь",
+	"ш":     "шоссе",
+	"наб":   "набережная",
+	"б-р":   "бульвар",
+	"бул":   "бульвар",
+	"стр":   "строение",
+	"корп":  "корпус",
+	"к":     "корпус",
+	"st":    "street",
+	"str":   "street",
+	"ave":   "avenue",
+	"av":    "avenue",
+	"rd":    "road",
+	"blvd":  "boulevard",
+	"ln":    "lane",
+	"sq":    "square",
+	"hwy":   "highway",
+	"bldg":  "building",
+	"apt":   "apartment",
+	"ste":   "suite",
+}
+
+// houseWords mark the house number and carry no information of their own:
+// "д. 5" and "5" are the same house, as are "No. 5" and "5".
+var houseWords = map[string]bool{
+	"д":     true,
+	"дом":   true,
+	"no":    true,
+	"nr":    true,
+	"house": true,
+}
+
+// NormalizeAddress returns the canonical form of a street address used to match its different
+// spellings: lower case, without punctuation, with street types and other abbreviations spelled
+// out and the words sorted, so the order of the street and the house number does not matter.
+// "ул. Лёнина, д. 5", "Ленина ул 5" as well as "5 Main St." and "Main Street, No 5" normalize
+// to the same addresses. Returns an empty string if the address has no words.
+func NormalizeAddress(street string) string {
+	street = strings.ReplaceAll(strings.ToLower(street), "ё", "е")
+	fields := strings.FieldsFunc(street, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '/'
+	})
+
+	words := make([]string, 0, len(fields))
+	for _, field := range fields {
+		word := strings.Trim(field, "-/")
+		if word == "" || houseWords[word] {
+			continue
+		}
+		if full, ok := streetWords[word]; ok {
+			word = full
+		}
+		words = append(words, word)
+	}
+
+	slices.Sort(words)
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,44 @@
+package geo_test
+
+import (
+	"testing"
+
+	"delivery/internal/adapters/out/geo"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeAddress(t *testing.T) {
+	tests := []struct {
+		name      string
+		spellings []string
+	}{
+		{"russian street types and house markers", []string{
+			"ул. Ленина, д. 5", "улица Ленина 5", "Ленина ул, дом 5", "  УЛ ЛЕНИНА,5 ",
+		}},
+		{"russian yo", []string{"ул. Королёва, 12", "улица Королева 12"}},
+		{"russian hyphenated abbreviations", []string{"пр-т Мира, д. 1/2", "проспект Мира 1/2"}},
+		{"english street types and house markers", []string{
+			"5 Main St.", "5 Main Street", "Main Street, No. 5", "main st 5",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := geo.NormalizeAddress(tt.spellings[0])
+			assert.NotEmpty(t, expected)
+			for _, spelling := range tt.spellings[1:] {
+				assert.Equal(t, expected, geo.NormalizeAddress(spelling), spelling)
+			}
+		})
+	}
+
+	t.Run("should tell different houses apart", func(t *testing.T) {
+		assert.NotEqual(t, geo.NormalizeAddress("ул. Ленина, 5"), geo.NormalizeAddress("ул. Ленина, 7"))
+		assert.NotEqual(t, geo.NormalizeAddress("5 Main St"), geo.NormalizeAddress("5 Main Ave"))
+	})
+
+	t.Run("should return empty address without words", func(t *testing.T) {
+		assert.Empty(t, geo.NormalizeAddress(" ., - "))
+	})
+}
@@ -0,0 +1,78 @@
+package geo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+)
+
+// FallbackLocator implements ports.GeoLocator with a fallback chain, so order intake does not
+// fail because of the geo service:
+//
+//  1. The primary locator, normally the geo service, resolves the address. Every resolved
+//     address is cached under its normalized form.
+//  2. If the primary locator fails, the address is looked up in the cache, so an address
+//     resolved before, in any of its spellings, is still located.
+//  3. If the address is not cached either, ports.ErrAddressIsUnresolved is returned and the
+//     order is held for manual review.
+//
+// Failures of the cache itself are logged and never fail an address the primary locator resolved.
+//
+// Example:
+//
+//	locator := geo.NewFallbackLocator(geoClient, postgres.NewGormResolvedAddressCache(db), logger)
+//	location, err := locator.Locate(ctx, "ул. Ленина, д. 5")
+//	if errors.Is(err, ports.ErrAddressIsUnresolved) {
+//	    // hold the order for review
+//	}
+type FallbackLocator struct {
+	primary ports.GeoLocator
+	cache   ports.ResolvedAddressCache
+	logger  *slog.Logger
+}
+
+// NewFallbackLocator creates a locator that falls back from primary to the cache of resolved addresses.
+func NewFallbackLocator(primary ports.GeoLocator, cache ports.ResolvedAddressCache, logger *slog.Logger) FallbackLocator {
+	return FallbackLocator{
+		primary: primary,
+		cache:   cache,
+		logger:  logger.With("component", "geo_fallback_locator"),
+	}
+}
+
+// Locate resolves the street address through the fallback chain.
+// Returns ports.ErrAddressIsUnresolved if neither the primary locator nor the cache knows the
+// address, and the context error if ctx is done.
+func (l FallbackLocator) Locate(ctx context.Context, street string) (kernel.Location, error) {
+	address := NormalizeAddress(street)
+	if address == "" {
+		return kernel.Location{}, fmt.Errorf("%w: address has no words", ports.ErrAddressIsUnresolved)
+	}
+
+	location, err := l.primary.Locate(ctx, street)
+	if err == nil {
+		if cacheErr := l.cache.Put(ctx, address, location); cacheErr != nil {
+			l.logger.WarnContext(ctx, "Failed to cache resolved address", "error", cacheErr)
+		}
+		return location, nil
+	}
+
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return kernel.Location{}, ctxErr
+	}
+
+	l.logger.WarnContext(ctx, "Geo service failed to resolve address, falling back to cache", "error", err)
+
+	cached, ok, cacheErr := l.cache.Get(ctx, address)
+	if cacheErr != nil {
+		l.logger.WarnContext(ctx, "Failed to read resolved address from cache", "error", cacheErr)
+	}
+	if ok {
+		return cached, nil
+	}
+
+	return kernel.Location{}, fmt.Errorf("%w: %w", ports.ErrAddressIsUnresolved, err)
+}
@@ -0,0 +1,120 @@
+package geo_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"delivery/internal/adapters/out/geo"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubLocator struct {
+	location kernel.Location
+	err      error
+}
+
+func (l stubLocator) Locate(_ context.Context, _ string) (kernel.Location, error) {
+	return l.location, l.err
+}
+
+type memoryAddressCache struct {
+	locations map[string]kernel.Location
+	err       error
+}
+
+func newMemoryAddressCache() *memoryAddressCache {
+	return &memoryAddressCache{locations: make(map[string]kernel.Location)}
+}
+
+func (c *memoryAddressCache) Get(_ context.Context, address string) (kernel.Location, bool, error) {
+	if c.err != nil {
+		return kernel.Location{}, false, c.err
+	}
+	location, ok := c.locations[address]
+	return location, ok, nil
+}
+
+func (c *memoryAddressCache) Put(_ context.Context, address string, location kernel.Location) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.locations[address] = location
+	return nil
+}
+
+func newFallbackLocator(primary ports.GeoLocator, cache ports.ResolvedAddressCache) geo.FallbackLocator {
+	return geo.NewFallbackLocator(primary, cache, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestFallbackLocator_Locate(t *testing.T) {
+	resolved, _ := kernel.NewLocation(3, 4)
+	unavailable := stubLocator{err: errors.New("geo service unavailable")}
+
+	t.Run("should cache addresses resolved by the primary locator", func(t *testing.T) {
+		cache := newMemoryAddressCache()
+
+		location, err := newFallbackLocator(stubLocator{location: resolved}, cache).Locate(t.Context(), "ул. Ленина, д. 5")
+
+		require.NoError(t, err)
+		assert.Equal(t, resolved, location)
+		assert.Equal(t, resolved, cache.locations[geo.NormalizeAddress("ул. Ленина, д. 5")])
+	})
+
+	t.Run("should locate another spelling from cache when the primary locator fails", func(t *testing.T) {
+		cache := newMemoryAddressCache()
+		_, err := newFallbackLocator(stubLocator{location: resolved}, cache).Locate(t.Context(), "ул. Ленина, д. 5")
+		require.NoError(t, err)
+
+		location, err := newFallbackLocator(unavailable, cache).Locate(t.Context(), "Ленина улица 5")
+
+		require.NoError(t, err)
+		assert.Equal(t, resolved, location)
+	})
+
+	t.Run("should report unresolved address missing from cache", func(t *testing.T) {
+		_, err := newFallbackLocator(unavailable, newMemoryAddressCache()).Locate(t.Context(), "5 Main St")
+
+		require.ErrorIs(t, err, ports.ErrAddressIsUnresolved)
+		assert.ErrorContains(t, err, "geo service unavailable")
+	})
+
+	t.Run("should report unresolved address when cache fails too", func(t *testing.T) {
+		cache := &memoryAddressCache{err: errors.New("connection refused")}
+
+		_, err := newFallbackLocator(unavailable, cache).Locate(t.Context(), "5 Main St")
+
+		require.ErrorIs(t, err, ports.ErrAddressIsUnresolved)
+	})
+
+	t.Run("should not fail resolved address when cache fails", func(t *testing.T) {
+		cache := &memoryAddressCache{err: errors.New("connection refused")}
+
+		location, err := newFallbackLocator(stubLocator{location: resolved}, cache).Locate(t.Context(), "5 Main St")
+
+		require.NoError(t, err)
+		assert.Equal(t, resolved, location)
+	})
+
+	t.Run("should report unresolved address without words", func(t *testing.T) {
+		_, err := newFallbackLocator(stubLocator{location: resolved}, newMemoryAddressCache()).Locate(t.Context(), " , ")
+
+		require.ErrorIs(t, err, ports.ErrAddressIsUnresolved)
+	})
+
+	t.Run("should fail when context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		_, err := newFallbackLocator(geo.NewRandomLocator(), newMemoryAddressCache()).Locate(ctx, "5 Main St")
+
+		require.ErrorIs(t, err, context.Canceled)
+		require.NotErrorIs(t, err, ports.ErrAddressIsUnresolved)
+	})
+}
@@ -0,0 +1,26 @@
+// Package geo provides address resolution for incoming orders.
+package geo
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/kernel"
+)
+
+// RandomLocator implements ports.GeoLocator by placing every address at a random location,
+// the same way orders created through the API are placed. It stands in for the geo service
+// until its gRPC client is wired in (see the "gRPC Client" section of the README).
+type RandomLocator struct{}
+
+// NewRandomLocator creates a locator that places addresses at random locations.
+func NewRandomLocator() RandomLocator {
+	return RandomLocator{}
+}
+
+// Locate returns a random location on the city grid.
+func (RandomLocator) Locate(ctx context.Context, _ string) (kernel.Location, error) {
+	if err := ctx.Err(); err != nil {
+		return kernel.Location{}, err
+	}
+	return kernel.NewRandomLocation()
+}
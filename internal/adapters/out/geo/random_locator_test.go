@@ -0,0 +1,28 @@
+package geo_test
+
+import (
+	"context"
+	"testing"
+
+	"delivery/internal/adapters/out/geo"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRandomLocator_Locate(t *testing.T) {
+	t.Run("should return valid location", func(t *testing.T) {
+		location, err := geo.NewRandomLocator().Locate(context.Background(), "1 Main Street")
+
+		require.NoError(t, err)
+		require.NoError(t, location.Validate())
+	})
+
+	t.Run("should fail when context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := geo.NewRandomLocator().Locate(ctx, "1 Main Street")
+
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
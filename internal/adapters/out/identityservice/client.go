@@ -0,0 +1,109 @@
+// Package identityservice provides a client for an external courier identity verification service.
+package identityservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"delivery/internal/core/domain/model/identity"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+)
+
+// verifyPath is the endpoint of the identity service that checks a courier's proof.
+const verifyPath = "/v1/verifications"
+
+// verifyRequest is the body sent to the identity service.
+// Method is "pin" or "device_attestation".
+type verifyRequest struct {
+	CourierID string `json:"courierId"`
+	Method    string `json:"method"`
+	Secret    string `json:"secret"`
+}
+
+// verifyResponse is the decision returned by the identity service.
+type verifyResponse struct {
+	Verified *bool  `json:"verified"`
+	Reason   string `json:"reason"`
+}
+
+// Client implements ports.IdentityVerifier over the identity service HTTP API.
+//
+// Unlike a rejected proof, a failure of the service itself is returned as an error: the
+// courier cannot start the shift, but the attempt does not count against them.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	logger     *slog.Logger
+}
+
+// NewClient creates a client for the service at baseURL with the given request timeout.
+func NewClient(baseURL string, timeout time.Duration, logger *slog.Logger) *Client {
+	return &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: timeout},
+		logger:     logger.With("component", "identity_service_client"),
+	}
+}
+
+// Verify asks the identity service whether the proof belongs to the courier.
+func (c *Client) Verify(
+	ctx context.Context,
+	courierID kernel.UUID,
+	proof identity.Proof,
+) (ports.IdentityVerification, error) {
+	response, err := c.call(ctx, courierID, proof)
+	if err != nil {
+		c.logger.WarnContext(ctx, "Identity service verification failed",
+			"courier_id", courierID.String(),
+			"method", proof.Method().String(),
+			"error", err)
+		return ports.IdentityVerification{}, fmt.Errorf("identity service: %w", err)
+	}
+
+	return ports.IdentityVerification{Verified: *response.Verified, Reason: response.Reason}, nil
+}
+
+func (c *Client) call(ctx context.Context, courierID kernel.UUID, proof identity.Proof) (verifyResponse, error) {
+	body, err := json.Marshal(verifyRequest{
+		CourierID: courierID.String(),
+		Method:    proof.Method().String(),
+		Secret:    proof.Secret(),
+	})
+	if err != nil {
+		return verifyResponse{}, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+verifyPath, bytes.NewReader(body))
+	if err != nil {
+		return verifyResponse{}, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return verifyResponse{}, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return verifyResponse{}, fmt.Errorf("unexpected status %d", response.StatusCode)
+	}
+
+	var decoded verifyResponse
+	if err = json.NewDecoder(response.Body).Decode(&decoded); err != nil {
+		return verifyResponse{}, err
+	}
+	if decoded.Verified == nil {
+		return verifyResponse{}, errors.New("response has no verified flag")
+	}
+
+	return decoded, nil
+}
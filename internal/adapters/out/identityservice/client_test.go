@@ -0,0 +1,96 @@
+package identityservice_test
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/identityservice"
+	"delivery/internal/core/domain/model/identity"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *identityservice.Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return identityservice.NewClient(server.URL, time.Second, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func newPINProof(t *testing.T) identity.Proof {
+	t.Helper()
+	proof, err := identity.NewProof(identity.PIN, "4821")
+	require.NoError(t, err)
+	return proof
+}
+
+func TestClient_Verify_MapsDecisions(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		expected ports.IdentityVerification
+	}{
+		{"verified", `{"verified":true}`, ports.IdentityVerification{Verified: true}},
+		{"rejected", `{"verified":false,"reason":"wrong PIN"}`,
+			ports.IdentityVerification{Verified: false, Reason: "wrong PIN"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			courierID := kernel.NewUUID()
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/v1/verifications", r.URL.Path)
+
+				var body map[string]any
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, courierID.String(), body["courierId"])
+				assert.Equal(t, "pin", body["method"])
+				assert.Equal(t, "4821", body["secret"])
+
+				_, _ = w.Write([]byte(tt.response))
+			})
+
+			verification, err := client.Verify(t.Context(), courierID, newPINProof(t))
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, verification)
+		})
+	}
+}
+
+func TestClient_Verify_FailuresAreErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+	}{
+		{"server error", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}},
+		{"malformed body", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("not json"))
+		}},
+		{"missing decision", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"reason":"unknown"}`))
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, tt.handler)
+
+			_, err := client.Verify(t.Context(), kernel.NewUUID(), newPINProof(t))
+
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+// Package kafka provides Kafka producers for publishing integration events.
+package kafka
+
+import (
+	"context"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/IBM/sarama"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// messageIDHeader carries the outbox message ID so consumers can deduplicate redeliveries.
+	messageIDHeader = "message-id"
+	// eventTypeHeader carries the integration event name.
+	eventTypeHeader = "event-type"
+)
+
+// OutboxPublisher publishes outbox messages to a single Kafka topic.
+// Messages are keyed by aggregate ID, so the default hash partitioner keeps events
+// of one aggregate in a single partition and preserves their order.
+type OutboxPublisher struct {
+	producer sarama.SyncProducer
+	topic    string
+}
+
+// NewOutboxPublisher connects an idempotent synchronous producer to the brokers.
+func NewOutboxPublisher(brokers []string, topic string) (*OutboxPublisher, error) {
+	config := sarama.NewConfig()
+	config.Producer.Return.Successes = true
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Idempotent = true
+	config.Net.MaxOpenRequests = 1
+
+	producer, err := sarama.NewSyncProducer(brokers, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OutboxPublisher{
+		producer: producer,
+		topic:    topic,
+	}, nil
+}
+
+// Publish sends a message and waits for acknowledgement from all in-sync replicas.
+// A message without a payload is sent as a tombstone, with a null value.
+//
+// The message is sent in a producer span of the trace that recorded it, linked to the span of ctx,
+// and carries the trace context in W3C headers, so consumers continue the trace of the operation
+// that raised the event rather than the one of the relay.
+func (p *OutboxPublisher) Publish(ctx context.Context, message ports.OutboxMessage) (err error) {
+	if err = ctx.Err(); err != nil {
+		return err
+	}
+
+	spanCtx, span := tracing.Start(tracing.WithTraceParent(ctx, message.TraceParent), "publish "+p.topic,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithLinks(trace.LinkFromContext(ctx)),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "kafka"),
+			attribute.String("messaging.destination.name", p.topic),
+			attribute.String("messaging.message.id", message.ID.String()),
+		),
+	)
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	var value sarama.Encoder
+	if len(message.Payload) > 0 {
+		value = sarama.ByteEncoder(message.Payload)
+	}
+
+	headers := headerCarrier{
+		{Key: []byte(messageIDHeader), Value: []byte(message.ID.String())},
+		{Key: []byte(eventTypeHeader), Value: []byte(message.EventType)},
+	}
+	tracing.Inject(spanCtx, &headers)
+
+	_, _, err = p.producer.SendMessage(&sarama.ProducerMessage{
+		Topic:     p.topic,
+		Key:       sarama.StringEncoder(message.AggregateID),
+		Value:     value,
+		Headers:   headers,
+		Timestamp: message.OccurredAt,
+	})
+	return err
+}
+
+// headerCarrier adapts the headers of a Kafka message to the propagation of trace context.
+type headerCarrier []sarama.RecordHeader
+
+// Get returns the value of the header key, or "" if there is none.
+func (c *headerCarrier) Get(key string) string {
+	for _, header := range *c {
+		if string(header.Key) == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+// Set replaces the value of the header key, adding the header if there is none.
+func (c *headerCarrier) Set(key, value string) {
+	for i, header := range *c {
+		if string(header.Key) == key {
+			(*c)[i].Value = []byte(value)
+			return
+		}
+	}
+	*c = append(*c, sarama.RecordHeader{Key: []byte(key), Value: []byte(value)})
+}
+
+// Keys returns the keys of the headers.
+func (c *headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(*c))
+	for _, header := range *c {
+		keys = append(keys, string(header.Key))
+	}
+	return keys
+}
+
+// Close flushes and closes the underlying producer.
+func (p *OutboxPublisher) Close() error {
+	return p.producer.Close()
+}
@@ -0,0 +1,56 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxPublisher_Publish_PropagatesTraceOfMessage(t *testing.T) {
+	_, err := tracing.Setup(tracing.Config{ServiceName: "delivery", SampleRatio: 1})
+	require.NoError(t, err)
+
+	producer := mocks.NewSyncProducer(t, nil)
+	var sent *sarama.ProducerMessage
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(message *sarama.ProducerMessage) error {
+		sent = message
+		return nil
+	})
+	publisher := &OutboxPublisher{producer: producer, topic: "order.status.changed"}
+
+	message := ports.OutboxMessage{
+		ID:          kernel.NewUUID(),
+		AggregateID: "order-1",
+		EventType:   "OrderCompleted",
+		Payload:     []byte(`{}`),
+		OccurredAt:  time.Now(),
+		TraceParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	}
+	require.NoError(t, publisher.Publish(context.Background(), message))
+
+	require.NotNil(t, sent)
+	headers := headerCarrier(sent.Headers)
+	assert.Equal(t, message.ID.String(), headers.Get(messageIDHeader))
+	assert.Equal(t, "OrderCompleted", headers.Get(eventTypeHeader))
+	assert.Contains(t, headers.Get("traceparent"), "4bf92f3577b34da6a3ce929d0e0e4736")
+}
+
+func TestHeaderCarrier_Set(t *testing.T) {
+	headers := headerCarrier{{Key: []byte("traceparent"), Value: []byte("old")}}
+
+	headers.Set("traceparent", "new")
+	headers.Set("tracestate", "vendor=1")
+
+	assert.Equal(t, "new", headers.Get("traceparent"))
+	assert.Equal(t, "vendor=1", headers.Get("tracestate"))
+	assert.Equal(t, []string{"traceparent", "tracestate"}, headers.Keys())
+}
@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/core/domain/model/announcement"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AnnouncementDTO is a dispatch announcement broadcast to couriers on shift.
+// The zone columns are all set for announcements restricted to a zone and all NULL otherwise.
+type AnnouncementDTO struct {
+	ID         uuid.UUID                 `gorm:"type:uuid;primaryKey"`
+	Text       string                    `gorm:"type:text;not null"`
+	ZoneFromX  *int                      `gorm:"type:smallint"`
+	ZoneFromY  *int                      `gorm:"type:smallint"`
+	ZoneToX    *int                      `gorm:"type:smallint"`
+	ZoneToY    *int                      `gorm:"type:smallint"`
+	PostedAt   time.Time                 `gorm:"not null;index"`
+	Deliveries []AnnouncementDeliveryDTO `gorm:"foreignKey:AnnouncementID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName specifies the database table name for announcements.
+// Overrides GORM's default naming convention to use "announcements".
+func (AnnouncementDTO) TableName() string {
+	return "announcements"
+}
+
+// AnnouncementDeliveryDTO is the delivery state of an announcement for one courier.
+// Deliveries are removed together with their courier, so purged synthetic couriers
+// leave no trace in the announcement history.
+type AnnouncementDeliveryDTO struct {
+	AnnouncementID uuid.UUID               `gorm:"type:uuid;primaryKey"`
+	CourierID      uuid.UUID               `gorm:"type:uuid;primaryKey"`
+	Courier        *courierrepo.CourierDTO `gorm:"foreignKey:CourierID;constraint:OnDelete:CASCADE"`
+	Position       int                     `gorm:"not null"`
+	Status         int                     `gorm:"not null"`
+	ChangedAt      time.Time               `gorm:"not null"`
+	Failure        string                  `gorm:"type:text;not null;default:''"`
+}
+
+// TableName specifies the database table name for announcement deliveries.
+// Overrides GORM's default naming convention to use "announcement_deliveries".
+func (AnnouncementDeliveryDTO) TableName() string {
+	return "announcement_deliveries"
+}
+
+// newAnnouncementDTO converts an announcement to its database representation.
+func newAnnouncementDTO(a *announcement.Announcement) AnnouncementDTO {
+	dto := AnnouncementDTO{
+		ID:       a.ID().Bytes(),
+		Text:     a.Text(),
+		PostedAt: a.PostedAt().UTC(),
+	}
+
+	if zone, ok := a.Zone(); ok {
+		dto.ZoneFromX = coordinate(zone.From().X())
+		dto.ZoneFromY = coordinate(zone.From().Y())
+		dto.ZoneToX = coordinate(zone.To().X())
+		dto.ZoneToY = coordinate(zone.To().Y())
+	}
+
+	for i, delivery := range a.Deliveries() {
+		dto.Deliveries = append(dto.Deliveries, AnnouncementDeliveryDTO{
+			AnnouncementID: dto.ID,
+			CourierID:      delivery.CourierID().Bytes(),
+			Position:       i,
+			Status:         int(delivery.Status()),
+			ChangedAt:      delivery.ChangedAt().UTC(),
+			Failure:        delivery.Failure(),
+		})
+	}
+
+	return dto
+}
+
+func coordinate(c kernel.Coordinate) *int {
+	value := int(c)
+	return &value
+}
+
+// GormAnnouncementRepository implements ports.AnnouncementRepository over the announcements
+// and announcement_deliveries tables. Each call runs in a transaction of its own bound to
+// the tenant carried by ctx, since broadcasts are not part of a courier or order unit of work.
+type GormAnnouncementRepository struct {
+	db *gorm.DB
+}
+
+// NewGormAnnouncementRepository creates an announcement repository over the given connection.
+func NewGormAnnouncementRepository(db *gorm.DB) *GormAnnouncementRepository {
+	return &GormAnnouncementRepository{db: db}
+}
+
+// Add stores a new announcement with its recipients.
+func (r *GormAnnouncementRepository) Add(ctx context.Context, a *announcement.Announcement) error {
+	dto := newAnnouncementDTO(a)
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		if err := tx.Omit(clause.Associations).Create(&dto).Error; err != nil {
+			return err
+		}
+
+		if len(dto.Deliveries) == 0 {
+			return nil
+		}
+		return tx.Omit(clause.Associations).Create(&dto.Deliveries).Error
+	})
+}
+
+// Update stores the current state of the announcement's deliveries.
+func (r *GormAnnouncementRepository) Update(ctx context.Context, a *announcement.Announcement) error {
+	dto := newAnnouncementDTO(a)
+	if len(dto.Deliveries) == 0 {
+		return nil
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		return tx.Omit(clause.Associations).Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "announcement_id"}, {Name: "courier_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"status", "changed_at", "failure"}),
+		}).Create(&dto.Deliveries).Error
+	})
+}
@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/usage"
+
+	"gorm.io/gorm"
+)
+
+// APIUsageDTO accounts the API usage of a partner client in one month.
+// Clients are stored by the fingerprint of their API key, never by the key itself.
+type APIUsageDTO struct {
+	Client           string    `gorm:"type:char(64);primaryKey"`
+	Period           time.Time `gorm:"type:date;primaryKey"`
+	Requests         int       `gorm:"not null;default:0"`
+	OrdersCreated    int       `gorm:"not null;default:0"`
+	WebhooksConsumed int       `gorm:"not null;default:0"`
+	UpdatedAt        time.Time `gorm:"not null"`
+}
+
+// TableName specifies the database table name for API usage.
+// Overrides GORM's default naming convention to use "api_usage".
+func (APIUsageDTO) TableName() string {
+	return "api_usage"
+}
+
+// usageColumn returns the api_usage column accounting the metric.
+func usageColumn(metric usage.Metric) (string, error) {
+	switch metric {
+	case usage.Requests:
+		return "requests", nil
+	case usage.OrdersCreated:
+		return "orders_created", nil
+	case usage.WebhooksConsumed:
+		return "webhooks_consumed", nil
+	case usage.UnknownMetric:
+	}
+	return "", fmt.Errorf("no api_usage column for metric %s", metric)
+}
+
+// GormUsageLedger implements ports.UsageLedger over the api_usage table.
+// Usage is billed per API key rather than per tenant, so the table is shared.
+type GormUsageLedger struct {
+	db *gorm.DB
+}
+
+// NewGormUsageLedger creates a usage ledger over the given connection.
+func NewGormUsageLedger(db *gorm.DB) *GormUsageLedger {
+	return &GormUsageLedger{db: db}
+}
+
+// Used returns the client's usage of the metric in the period.
+func (l *GormUsageLedger) Used(
+	ctx context.Context,
+	client usage.Client,
+	period usage.Period,
+	metric usage.Metric,
+) (int, error) {
+	column, err := usageColumn(metric)
+	if err != nil {
+		return 0, err
+	}
+
+	var used []int
+	err = l.db.WithContext(ctx).
+		Model(&APIUsageDTO{}).
+		Where("client = ? AND period = ?", client.Fingerprint(), period.Start()).
+		Pluck(column, &used).Error
+	if err != nil || len(used) == 0 {
+		return 0, err
+	}
+	return used[0], nil
+}
+
+// Add increments the client's usage of the metric in the period, creating its row on first use.
+// The increment happens in the database, so concurrent requests never lose usage.
+func (l *GormUsageLedger) Add(
+	ctx context.Context,
+	client usage.Client,
+	period usage.Period,
+	metric usage.Metric,
+	amount int,
+) error {
+	column, err := usageColumn(metric)
+	if err != nil {
+		return err
+	}
+
+	return l.db.WithContext(ctx).Exec(fmt.Sprintf(`
+		INSERT INTO api_usage (client, period, %[1]s, updated_at)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT (client, period)
+		DO UPDATE SET %[1]s = api_usage.%[1]s + EXCLUDED.%[1]s, updated_at = EXCLUDED.updated_at
+	`, column), client.Fingerprint(), period.Start(), amount, time.Now().UTC()).Error
+}
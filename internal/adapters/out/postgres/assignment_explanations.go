@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AssignmentExplanationDTO records why a courier was assigned to an order.
+// An order reassigned after a courier was deactivated has one explanation per assignment.
+type AssignmentExplanationDTO struct {
+	ID          uuid.UUID                  `gorm:"type:uuid;primaryKey"`
+	OrderID     uuid.UUID                  `gorm:"type:uuid;not null;index"`
+	Order       *orderrepo.OrderDTO        `gorm:"foreignKey:OrderID;constraint:OnDelete:CASCADE"`
+	CourierID   uuid.UUID                  `gorm:"type:uuid;not null"`
+	Strategy    string                     `gorm:"type:varchar(64);not null"`
+	Score       float64                    `gorm:"not null"`
+	ExplainedAt time.Time                  `gorm:"not null"`
+	Factors     []AssignmentScoreFactorDTO `gorm:"foreignKey:ExplanationID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName specifies the database table name for assignment explanations.
+// Overrides GORM's default naming convention to use "assignment_explanations".
+func (AssignmentExplanationDTO) TableName() string {
+	return "assignment_explanations"
+}
+
+// AssignmentScoreFactorDTO is one factor of the score of a courier considered for an assignment.
+// Factors are keyed by their position within the explanation, so candidates keep the order
+// the dispatcher considered them in.
+type AssignmentScoreFactorDTO struct {
+	ExplanationID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Position      int       `gorm:"primaryKey"`
+	CourierID     uuid.UUID `gorm:"type:uuid;not null"`
+	Name          string    `gorm:"type:varchar(64);not null"`
+	Value         float64   `gorm:"not null"`
+	Weight        float64   `gorm:"not null"`
+}
+
+// TableName specifies the database table name for assignment score factors.
+// Overrides GORM's default naming convention to use "assignment_score_factors".
+func (AssignmentScoreFactorDTO) TableName() string {
+	return "assignment_score_factors"
+}
+
+// newAssignmentExplanation converts the explanation of a committed assignment to its
+// database representation.
+func newAssignmentExplanation(assignment commands.DispatchAssignment, explainedAt time.Time) AssignmentExplanationDTO {
+	explanation := assignment.Explanation
+	dto := AssignmentExplanationDTO{
+		ID:          uuid.New(),
+		OrderID:     assignment.Order.ID().Bytes(),
+		CourierID:   explanation.CourierID.Bytes(),
+		Strategy:    explanation.Strategy,
+		Score:       explanation.Score,
+		ExplainedAt: explainedAt.UTC(),
+	}
+
+	for _, candidate := range explanation.Candidates {
+		for _, factor := range candidate.Breakdown.Factors {
+			dto.Factors = append(dto.Factors, AssignmentScoreFactorDTO{
+				ExplanationID: dto.ID,
+				Position:      len(dto.Factors),
+				CourierID:     candidate.CourierID.Bytes(),
+				Name:          factor.Name,
+				Value:         factor.Value,
+				Weight:        factor.Weight,
+			})
+		}
+	}
+
+	return dto
+}
+
+// GormAssignmentExplanationRecorder stores the score breakdown of every committed courier
+// assignment, so operators can later answer why a courier got an order.
+// It is registered as a dispatch post-processor and only acts once the assignment is committed;
+// failures are logged rather than returned because they cannot undo the assignment.
+type GormAssignmentExplanationRecorder struct {
+	db     *gorm.DB
+	logger *slog.Logger
+}
+
+// NewGormAssignmentExplanationRecorder creates a recorder that writes over the given connection.
+func NewGormAssignmentExplanationRecorder(db *gorm.DB, logger *slog.Logger) *GormAssignmentExplanationRecorder {
+	return &GormAssignmentExplanationRecorder{db: db, logger: logger}
+}
+
+// ProcessAssignment accepts every assignment; explanations are only recorded once committed.
+func (r *GormAssignmentExplanationRecorder) ProcessAssignment(_ context.Context, _ *commands.DispatchAssignment) error {
+	return nil
+}
+
+// AssignmentCommitted stores the explanation of the assignment for the tenant carried by ctx.
+func (r *GormAssignmentExplanationRecorder) AssignmentCommitted(
+	ctx context.Context,
+	assignment commands.DispatchAssignment,
+) {
+	dto := newAssignmentExplanation(assignment, time.Now())
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		if err := tx.Omit(clause.Associations).Create(&dto).Error; err != nil {
+			return err
+		}
+
+		if len(dto.Factors) == 0 {
+			return nil
+		}
+		return tx.Create(&dto.Factors).Error
+	})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to record assignment explanation",
+			"order_id", assignment.Order.ID().String(),
+			"error", err,
+		)
+	}
+}
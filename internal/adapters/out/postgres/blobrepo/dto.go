@@ -0,0 +1,66 @@
+// Package blobrepo provides data transfer objects and the repository for the uploads issued
+// for the blob storage. Uploads are kept until the file is removed, so every stored file
+// can be traced back to what it is for.
+package blobrepo
+
+import (
+	"time"
+
+	"delivery/internal/core/domain/model/blob"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/google/uuid"
+)
+
+// UploadDTO represents the database structure for persisting uploads.
+// The table is shared by all tenants like the blob storage itself, so the cleanup of orphaned
+// files sees every upload. Owners are kept as plain IDs since they are orders or couriers
+// depending on the purpose; the files of purged owners are orphaned and removed in turn.
+type UploadDTO struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	Purpose     int        `gorm:"type:smallint;not null"`
+	OwnerID     uuid.UUID  `gorm:"type:uuid;not null;index"`
+	ContentType string     `gorm:"type:varchar(100);not null"`
+	Size        int64      `gorm:"not null"`
+	IssuedAt    time.Time  `gorm:"not null;index"`
+	AttachedAt  *time.Time `gorm:"index"`
+}
+
+// TableName specifies the database table name for uploads.
+// Overrides GORM's default naming convention to use "blob_uploads".
+func (UploadDTO) TableName() string {
+	return "blob_uploads"
+}
+
+// fromDomain converts an upload to its database representation.
+func fromDomain(upload *blob.Upload) UploadDTO {
+	dto := UploadDTO{
+		ID:          upload.ID().Bytes(),
+		Purpose:     int(upload.Purpose()),
+		OwnerID:     upload.OwnerID().Bytes(),
+		ContentType: upload.ContentType(),
+		Size:        upload.Size(),
+		IssuedAt:    upload.IssuedAt().UTC(),
+	}
+	if attachedAt := upload.AttachedAt(); attachedAt != nil {
+		utc := attachedAt.UTC()
+		dto.AttachedAt = &utc
+	}
+
+	return dto
+}
+
+// toDomain restores an upload from its database representation.
+func toDomain(dto UploadDTO) (*blob.Upload, error) {
+	id, err := kernel.UUIDFromBytes(dto.ID[:])
+	if err != nil {
+		return nil, err
+	}
+	ownerID, err := kernel.UUIDFromBytes(dto.OwnerID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return blob.RestoreUpload(id, blob.Purpose(dto.Purpose), ownerID, dto.ContentType, dto.Size,
+		dto.IssuedAt, dto.AttachedAt)
+}
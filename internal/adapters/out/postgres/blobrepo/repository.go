@@ -0,0 +1,108 @@
+package blobrepo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/blob"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"gorm.io/gorm"
+)
+
+// GormUploadRepository implements BlobUploadRepository using GORM.
+// Uploads are not part of an order or courier unit of work, so every call stands on its own.
+type GormUploadRepository struct {
+	db *gorm.DB
+}
+
+// NewGormUploadRepository creates a new GORM upload repository.
+func NewGormUploadRepository(db *gorm.DB) *GormUploadRepository {
+	return &GormUploadRepository{db: db}
+}
+
+// Add persists a new upload.
+func (r *GormUploadRepository) Add(ctx context.Context, upload *blob.Upload) error {
+	if err := upload.Validate(); err != nil {
+		return err
+	}
+
+	dto := fromDomain(upload)
+	return r.db.WithContext(ctx).Create(&dto).Error
+}
+
+// Get retrieves an upload by ID.
+func (r *GormUploadRepository) Get(ctx context.Context, id kernel.UUID) (*blob.Upload, error) {
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+
+	var dto UploadDTO
+	if err := r.db.WithContext(ctx).First(&dto, "id = ?", id.Bytes()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewObjectNotFoundError("upload", id.String())
+		}
+		return nil, err
+	}
+
+	return toDomain(dto)
+}
+
+// Update persists the attachment of an existing upload.
+func (r *GormUploadRepository) Update(ctx context.Context, upload *blob.Upload) error {
+	if err := upload.Validate(); err != nil {
+		return err
+	}
+
+	dto := fromDomain(upload)
+	result := r.db.WithContext(ctx).
+		Model(&UploadDTO{}).
+		Where("id = ?", dto.ID).
+		Update("attached_at", dto.AttachedAt)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// GetOrphaned retrieves at most limit unattached uploads issued before the cutoff, oldest first.
+func (r *GormUploadRepository) GetOrphaned(
+	ctx context.Context,
+	issuedBefore time.Time,
+	limit int,
+) ([]*blob.Upload, error) {
+	var dtos []UploadDTO
+	if err := r.db.WithContext(ctx).
+		Where("attached_at IS NULL AND issued_at < ?", issuedBefore.UTC()).
+		Order("issued_at, id").
+		Limit(limit).
+		Find(&dtos).Error; err != nil {
+		return nil, err
+	}
+
+	uploads := make([]*blob.Upload, 0, len(dtos))
+	for _, dto := range dtos {
+		upload, err := toDomain(dto)
+		if err != nil {
+			return nil, err
+		}
+		uploads = append(uploads, upload)
+	}
+
+	return uploads, nil
+}
+
+// Delete removes an upload.
+func (r *GormUploadRepository) Delete(ctx context.Context, id kernel.UUID) error {
+	if err := id.Validate(); err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Delete(&UploadDTO{}, "id = ?", id.Bytes()).Error
+}
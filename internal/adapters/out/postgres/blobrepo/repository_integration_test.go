@@ -0,0 +1,129 @@
+package blobrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/postgres/blobrepo"
+	"delivery/internal/core/domain/model/blob"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+// UploadRepositoryIntegrationTestSuite provides integration tests for the upload repository
+// using PostgreSQL containers to verify database persistence behavior.
+type UploadRepositoryIntegrationTestSuite struct {
+	suite.Suite
+	template   *pgtest.Template
+	db         *gorm.DB
+	repository *blobrepo.GormUploadRepository
+	issuedAt   time.Time
+}
+
+func (suite *UploadRepositoryIntegrationTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(&blobrepo.UploadDTO{})
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *UploadRepositoryIntegrationTestSuite) SetupTest() {
+	// Every test works in a fresh clone of the migrated database
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.repository = blobrepo.NewGormUploadRepository(suite.db)
+	suite.issuedAt = time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+}
+
+func (suite *UploadRepositoryIntegrationTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		suite.Require().NoError(suite.template.Terminate(context.Background()))
+	}
+}
+
+func (suite *UploadRepositoryIntegrationTestSuite) TestAdd_NewUpload_CanBeRetrieved() {
+	ctx := context.Background()
+	upload := suite.createUpload(suite.issuedAt)
+
+	suite.Require().NoError(suite.repository.Add(ctx, upload))
+
+	stored, err := suite.repository.Get(ctx, upload.ID())
+	suite.Require().NoError(err)
+	suite.Equal(upload.Purpose(), stored.Purpose())
+	suite.True(upload.OwnerID().IsEqual(stored.OwnerID()))
+	suite.Equal(upload.ContentType(), stored.ContentType())
+	suite.Equal(upload.Size(), stored.Size())
+	suite.True(suite.issuedAt.Equal(stored.IssuedAt()))
+	suite.Nil(stored.AttachedAt())
+	suite.Equal(upload.Key(), stored.Key())
+}
+
+func (suite *UploadRepositoryIntegrationTestSuite) TestGet_MissingUpload_ReturnsNotFound() {
+	_, err := suite.repository.Get(context.Background(), kernel.NewUUID())
+
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+}
+
+func (suite *UploadRepositoryIntegrationTestSuite) TestUpdate_AttachedUpload_IsNotOrphaned() {
+	ctx := context.Background()
+	attached := suite.createUpload(suite.issuedAt)
+	orphaned := suite.createUpload(suite.issuedAt.Add(time.Minute))
+	recent := suite.createUpload(suite.issuedAt.Add(2 * time.Hour))
+	for _, upload := range []*blob.Upload{recent, orphaned, attached} {
+		suite.Require().NoError(suite.repository.Add(ctx, upload))
+	}
+
+	suite.Require().NoError(attached.Attach(suite.issuedAt.Add(time.Minute)))
+	suite.Require().NoError(suite.repository.Update(ctx, attached))
+
+	uploads, err := suite.repository.GetOrphaned(ctx, suite.issuedAt.Add(time.Hour), 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(uploads, 1)
+	suite.True(uploads[0].ID().IsEqual(orphaned.ID()))
+
+	stored, err := suite.repository.Get(ctx, attached.ID())
+	suite.Require().NoError(err)
+	suite.NotNil(stored.AttachedAt())
+}
+
+func (suite *UploadRepositoryIntegrationTestSuite) TestGetOrphaned_ReturnsOldestFirstUpToLimit() {
+	ctx := context.Background()
+	newer := suite.createUpload(suite.issuedAt.Add(time.Minute))
+	oldest := suite.createUpload(suite.issuedAt)
+	suite.Require().NoError(suite.repository.Add(ctx, newer))
+	suite.Require().NoError(suite.repository.Add(ctx, oldest))
+
+	uploads, err := suite.repository.GetOrphaned(ctx, suite.issuedAt.Add(time.Hour), 1)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(uploads, 1)
+	suite.True(uploads[0].ID().IsEqual(oldest.ID()))
+}
+
+func (suite *UploadRepositoryIntegrationTestSuite) TestDelete_RemovesUpload() {
+	ctx := context.Background()
+	upload := suite.createUpload(suite.issuedAt)
+	suite.Require().NoError(suite.repository.Add(ctx, upload))
+
+	suite.Require().NoError(suite.repository.Delete(ctx, upload.ID()))
+	suite.Require().NoError(suite.repository.Delete(ctx, upload.ID()), "deleting a missing upload is not an error")
+
+	_, err := suite.repository.Get(ctx, upload.ID())
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+}
+
+func (suite *UploadRepositoryIntegrationTestSuite) createUpload(issuedAt time.Time) *blob.Upload {
+	upload, err := blob.NewUpload(kernel.NewUUID(), blob.DeliveryPhoto, kernel.NewUUID(), "image/jpeg", 2048, issuedAt)
+	suite.Require().NoError(err)
+	return upload
+}
+
+func TestUploadRepositoryIntegrationTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UploadRepositoryIntegrationTestSuite))
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"delivery/internal/core/ports"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var _ ports.BulkCancellationLog = (*GormBulkCancellationLog)(nil)
+
+// BulkCancellationDTO is the audit entry of a batch of orders cancelled in bulk: why and by
+// which filter the orders were selected, and which orders were cancelled.
+type BulkCancellationDTO struct {
+	ID            uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Batch         int       `gorm:"primaryKey"`
+	Reason        string    `gorm:"type:varchar(255);not null"`
+	Status        int       `gorm:"type:smallint;not null"`
+	ZoneFromX     *int      `gorm:"type:smallint"`
+	ZoneFromY     *int      `gorm:"type:smallint"`
+	ZoneToX       *int      `gorm:"type:smallint"`
+	ZoneToY       *int      `gorm:"type:smallint"`
+	CreatedBefore *time.Time
+	OrderIDs      []byte    `gorm:"type:jsonb;not null"`
+	CancelledAt   time.Time `gorm:"not null;index"`
+}
+
+// TableName specifies the database table name for bulk cancellation audit entries.
+// Overrides GORM's default naming convention to use "order_bulk_cancellations".
+func (BulkCancellationDTO) TableName() string {
+	return "order_bulk_cancellations"
+}
+
+// GormBulkCancellationLog implements BulkCancellationLog using GORM.
+type GormBulkCancellationLog struct {
+	db *gorm.DB
+}
+
+// NewGormBulkCancellationLog creates a bulk cancellation audit log.
+func NewGormBulkCancellationLog(db *gorm.DB) *GormBulkCancellationLog {
+	return &GormBulkCancellationLog{db: db}
+}
+
+// Record appends the audit entry of a batch to the log.
+func (l *GormBulkCancellationLog) Record(ctx context.Context, cancellation ports.BulkCancellation) error {
+	if err := cancellation.ID.Validate(); err != nil {
+		return err
+	}
+
+	orderIDs := make([]string, 0, len(cancellation.OrderIDs))
+	for _, id := range cancellation.OrderIDs {
+		orderIDs = append(orderIDs, id.String())
+	}
+	encoded, err := json.Marshal(orderIDs)
+	if err != nil {
+		return err
+	}
+
+	dto := BulkCancellationDTO{
+		ID:          cancellation.ID.Bytes(),
+		Batch:       cancellation.Batch,
+		Reason:      cancellation.Reason,
+		Status:      int(cancellation.Filter.Status),
+		OrderIDs:    encoded,
+		CancelledAt: cancellation.CancelledAt.UTC(),
+	}
+	if zone := cancellation.Filter.Zone; zone != nil {
+		fromX, fromY := int(zone.From().X()), int(zone.From().Y())
+		toX, toY := int(zone.To().X()), int(zone.To().Y())
+		dto.ZoneFromX, dto.ZoneFromY, dto.ZoneToX, dto.ZoneToY = &fromX, &fromY, &toX, &toY
+	}
+	if cancellation.Filter.CreatedBefore != nil {
+		createdBefore := cancellation.Filter.CreatedBefore.UTC()
+		dto.CreatedBefore = &createdBefore
+	}
+	return l.db.WithContext(ctx).Create(&dto).Error
+}
@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"encoding/json"
+	"time"
+
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// changeLogLock is the key of the advisory lock that serializes appends to the change log.
+const changeLogLock = 7_142_380_615
+
+// ChangeLogDTO is an entry of the append-only change log behind the change feed.
+// The unit of work appends one entry per changed order or courier when it commits; the
+// sequential ID is the cursor dependent services sync with.
+type ChangeLogDTO struct {
+	ID            int64     `gorm:"primaryKey;autoIncrement"`
+	AggregateType string    `gorm:"type:varchar(32);not null;uniqueIndex:idx_change_log_aggregate_version,priority:1"`
+	AggregateID   uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_change_log_aggregate_version,priority:2"`
+	Version       int64     `gorm:"not null;uniqueIndex:idx_change_log_aggregate_version,priority:3"`
+	ChangedAt     time.Time `gorm:"not null"`
+	Snapshot      []byte    `gorm:"type:jsonb;not null"`
+}
+
+// TableName specifies the database table name for the change log.
+func (ChangeLogDTO) TableName() string {
+	return "change_log"
+}
+
+// appendChanges records the tracked orders and couriers in the change log within tx.
+// An aggregate tracked several times is recorded once with its final state; other aggregates
+// are not part of the feed.
+//
+// Entries must become visible in the order of their IDs, or a consumer that already moved its
+// cursor past a later entry would never see an earlier one committed after it. The advisory lock
+// is held until the transaction ends, so appending transactions commit one at a time.
+func appendChanges(tx *gorm.DB, tracked []trackedAggregate, changedAt time.Time) error {
+	entries := make([]ChangeLogDTO, 0, len(tracked))
+	seen := make(map[string]int, len(tracked))
+	for _, aggregate := range tracked {
+		aggregateType, snapshot, ok := changeSnapshot(aggregate.Aggregate)
+		if !ok {
+			continue
+		}
+
+		payload, err := json.Marshal(snapshot)
+		if err != nil {
+			return err
+		}
+
+		entry := ChangeLogDTO{
+			AggregateType: aggregateType,
+			AggregateID:   aggregate.ID.Bytes(),
+			ChangedAt:     changedAt.UTC(),
+			Snapshot:      payload,
+		}
+		key := aggregateType + "/" + aggregate.ID.String()
+		if index, ok := seen[key]; ok {
+			entries[index] = entry
+			continue
+		}
+		seen[key] = len(entries)
+		entries = append(entries, entry)
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", changeLogLock).Error; err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		err := tx.Exec(`
+			INSERT INTO change_log (aggregate_type, aggregate_id, version, changed_at, snapshot)
+			SELECT ?, ?, COALESCE(MAX(version), 0) + 1, ?, ?
+			FROM change_log
+			WHERE aggregate_type = ? AND aggregate_id = ?
+		`, entry.AggregateType, entry.AggregateID, entry.ChangedAt, entry.Snapshot,
+			entry.AggregateType, entry.AggregateID).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// changeSnapshot returns the aggregate type and the snapshot of an aggregate that is part of the
+// change feed.
+func changeSnapshot(aggregate any) (string, any, bool) {
+	switch a := aggregate.(type) {
+	case *order.Order:
+		snapshot := queries.OrderSnapshot{
+			Status:            a.Status(),
+			X:                 int(a.Location().X()),
+			Y:                 int(a.Location().Y()),
+			Volume:            a.Volume(),
+			DeliveryTier:      a.DeliveryTier(),
+			DeclaredValue:     a.DeclaredValue(),
+			InsuranceRequired: a.IsInsuranceRequired(),
+		}
+		if a.Courier() != nil {
+			courierID := a.Courier().Bytes()
+			snapshot.CourierID = &courierID
+		}
+		if ref := a.ExternalReference(); ref != nil {
+			snapshot.ExternalReference = &queries.ExternalReferenceSnapshot{
+				Marketplace: ref.Marketplace(),
+				OrderID:     ref.OrderID(),
+				DeepLink:    ref.DeepLink(),
+			}
+		}
+		if rating := a.Rating(); rating != nil {
+			snapshot.RatingStars = rating.Stars()
+		}
+		return queries.OrderChange, snapshot, true
+	case *courier.Courier:
+		return queries.CourierChange, queries.CourierSnapshot{
+			Name:        a.Name(),
+			Speed:       a.Speed(),
+			X:           int(a.Location().X()),
+			Y:           int(a.Location().Y()),
+			Paused:      a.IsOnBreak(),
+			Deactivated: a.IsDeactivated(),
+			Insured:     a.IsInsured(),
+		}, true
+	default:
+		return "", nil, false
+	}
+}
@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/adapters/out/postgres/outboxrepo"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// availabilityLogLock is the key of the advisory lock that serializes appends to the availability log.
+const availabilityLogLock = 7_142_380_616
+
+// CourierAvailabilityDTO is an entry of the append-only log of courier availability behind the
+// availability feed. The unit of work appends an entry when a committed change makes a courier
+// free, busy or off shift; the sequential ID is the cursor the workforce management system
+// syncs with, and the version counts the changes of each courier from 1.
+type CourierAvailabilityDTO struct {
+	ID           int64     `gorm:"primaryKey;autoIncrement"`
+	CourierID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_courier_availability_version,priority:1"`
+	Version      int64     `gorm:"not null;uniqueIndex:idx_courier_availability_version,priority:2"`
+	Availability int       `gorm:"type:smallint;not null"`
+	ChangedAt    time.Time `gorm:"not null"`
+}
+
+// TableName specifies the database table name for the availability log.
+func (CourierAvailabilityDTO) TableName() string {
+	return "courier_availability_log"
+}
+
+// recordAvailabilityChanges appends an entry to the availability log for every tracked courier
+// whose availability differs from the last one recorded, and writes the matching event to the
+// outbox within tx. A courier never recorded before counts as off shift, so new couriers are
+// recorded once they start their first shift. A courier tracked several times is recorded once
+// with its final state.
+//
+// Like the change log, entries must become visible in the order of their IDs, so the advisory
+// lock is held until the transaction ends.
+func recordAvailabilityChanges(ctx context.Context, tx *gorm.DB, tracked []trackedAggregate, changedAt time.Time) error {
+	couriers := make([]*courier.Courier, 0, len(tracked))
+	seen := make(map[kernel.UUID]int, len(tracked))
+	for _, aggregate := range tracked {
+		c, ok := aggregate.Aggregate.(*courier.Courier)
+		if !ok {
+			continue
+		}
+		if index, ok := seen[c.ID()]; ok {
+			couriers[index] = c
+			continue
+		}
+		seen[c.ID()] = len(couriers)
+		couriers = append(couriers, c)
+	}
+
+	if len(couriers) == 0 {
+		return nil
+	}
+
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", availabilityLogLock).Error; err != nil {
+		return err
+	}
+
+	ids := make([]uuid.UUID, 0, len(couriers))
+	for _, c := range couriers {
+		ids = append(ids, c.ID().Bytes())
+	}
+
+	var latest []CourierAvailabilityDTO
+	err := tx.Raw(`
+		SELECT DISTINCT ON (courier_id) courier_id, version, availability
+		FROM courier_availability_log
+		WHERE courier_id IN ?
+		ORDER BY courier_id, version DESC
+	`, ids).Scan(&latest).Error
+	if err != nil {
+		return err
+	}
+
+	recorded := make(map[uuid.UUID]CourierAvailabilityDTO, len(latest))
+	for _, entry := range latest {
+		recorded[entry.CourierID] = entry
+	}
+
+	outbox := outboxrepo.NewGormOutboxRepository(tx)
+	changedAt = changedAt.UTC().Truncate(time.Microsecond)
+	for _, c := range couriers {
+		availability := c.Availability()
+		last := recorded[c.ID().Bytes()]
+		previous := courier.Availability(last.Availability)
+		if availability == previous {
+			continue
+		}
+
+		entry := CourierAvailabilityDTO{
+			CourierID:    c.ID().Bytes(),
+			Version:      last.Version + 1,
+			Availability: int(availability),
+			ChangedAt:    changedAt,
+		}
+
+		if err := tx.Create(&entry).Error; err != nil {
+			return err
+		}
+
+		message, err := outboxrepo.NewCourierAvailabilityChangedMessage(
+			c.ID(), availability, previous, entry.Version, changedAt,
+		)
+		if err != nil {
+			return err
+		}
+		if err = outbox.Add(ctx, message); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
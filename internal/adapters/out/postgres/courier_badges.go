@@ -0,0 +1,167 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/badge"
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CourierBadgesProjectionName identifies the courier badges projection on the command line
+// and in the projection_checkpoints table.
+const CourierBadgesProjectionName = "courier-badges"
+
+// CourierDeliveryDTO is an order a courier delivered and the customer's rating of the delivery.
+// RatedAt is when the rating was committed, nil until the customer rates the delivery.
+type CourierDeliveryDTO struct {
+	OrderID     uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	CourierID   uuid.UUID  `gorm:"type:uuid;not null;index:idx_courier_deliveries_rated,priority:1"`
+	DeliveredAt time.Time  `gorm:"not null"`
+	RatingStars *int       `gorm:"type:smallint"`
+	RatedAt     *time.Time `gorm:"index:idx_courier_deliveries_rated,priority:2"`
+}
+
+// TableName specifies the database table name for courier deliveries.
+// Overrides GORM's default naming convention to use "courier_deliveries".
+func (CourierDeliveryDTO) TableName() string {
+	return "courier_deliveries"
+}
+
+// CourierBadgeDTO is a badge a courier earned. ChangeID is the change log ID of the delivery
+// or rating that earned it.
+type CourierBadgeDTO struct {
+	CourierID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Badge     string    `gorm:"type:varchar(64);primaryKey"`
+	AwardedAt time.Time `gorm:"not null"`
+	ChangeID  int64     `gorm:"not null"`
+}
+
+// TableName specifies the database table name for courier badges.
+// Overrides GORM's default naming convention to use "courier_badges".
+func (CourierBadgeDTO) TableName() string {
+	return "courier_badges"
+}
+
+// CourierBadgesProjection keeps the courier_deliveries table, the deliveries of every courier
+// and their ratings, and awards the badges of the configured rules into courier_badges.
+// A rule is checked whenever a delivery or a rating of the courier comes in, so a rule added
+// later is awarded for past achievements only once the projection is backfilled anew.
+type CourierBadgesProjection struct {
+	rules []badge.Rule
+}
+
+// NewCourierBadgesProjection creates the courier badges projection awarding the badges of the rules.
+// Without rules the projection still records deliveries and ratings.
+func NewCourierBadgesProjection(rules []badge.Rule) CourierBadgesProjection {
+	return CourierBadgesProjection{rules: rules}
+}
+
+// Name returns CourierBadgesProjectionName.
+func (CourierBadgesProjection) Name() string {
+	return CourierBadgesProjectionName
+}
+
+// Description explains what the read model holds.
+func (CourierBadgesProjection) Description() string {
+	return "Deliveries, ratings and earned badges of every courier"
+}
+
+// Reset deletes the deliveries and badges of all couriers.
+func (CourierBadgesProjection) Reset(_ context.Context, tx *gorm.DB) error {
+	if err := tx.Exec("DELETE FROM courier_badges").Error; err != nil {
+		return err
+	}
+	return tx.Exec("DELETE FROM courier_deliveries").Error
+}
+
+// Project records every order completed by a courier as a delivery of the courier and the first
+// rating of every delivery, then awards the badges the courier earned with it.
+func (p CourierBadgesProjection) Project(_ context.Context, tx *gorm.DB, changes []queries.Change) error {
+	for _, change := range changes {
+		if change.Order == nil || change.Order.Status != order.Completed || change.Order.CourierID == nil {
+			continue
+		}
+
+		courierID := *change.Order.CourierID
+		delivered := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&CourierDeliveryDTO{
+			OrderID:     change.AggregateID.Bytes(),
+			CourierID:   courierID,
+			DeliveredAt: change.ChangedAt,
+		})
+		if delivered.Error != nil {
+			return delivered.Error
+		}
+		achieved := delivered.RowsAffected > 0
+
+		if change.Order.RatingStars > 0 {
+			rated := tx.Exec(`
+				UPDATE courier_deliveries SET rating_stars = ?, rated_at = ?
+				WHERE order_id = ? AND rating_stars IS NULL
+			`, change.Order.RatingStars, change.ChangedAt, change.AggregateID.Bytes())
+			if rated.Error != nil {
+				return rated.Error
+			}
+			achieved = achieved || rated.RowsAffected > 0
+		}
+
+		if achieved {
+			if err := p.award(tx, courierID, change); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// award gives the courier the badges of the rules the courier meets as of the change
+// and has not earned before.
+func (p CourierBadgesProjection) award(tx *gorm.DB, courierID uuid.UUID, change queries.Change) error {
+	if len(p.rules) == 0 {
+		return nil
+	}
+
+	tally, err := courierTally(tx, courierID, change.ChangedAt)
+	if err != nil {
+		return err
+	}
+
+	var earned []CourierBadgeDTO
+	for _, rule := range p.rules {
+		if rule.IsEarnedBy(tally) {
+			earned = append(earned, CourierBadgeDTO{
+				CourierID: courierID,
+				Badge:     rule.Name(),
+				AwardedAt: change.ChangedAt,
+				ChangeID:  change.Cursor,
+			})
+		}
+	}
+
+	if len(earned) == 0 {
+		return nil
+	}
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&earned).Error
+}
+
+// courierTally counts the deliveries of the courier and the ratings of them committed in the week of the moment.
+func courierTally(tx *gorm.DB, courierID uuid.UUID, at time.Time) (badge.Tally, error) {
+	week := badge.WeekOf(at)
+
+	var tally badge.Tally
+	err := tx.Raw(`
+		SELECT
+			COUNT(*),
+			COUNT(*) FILTER (WHERE rated_at >= ? AND rated_at < ?),
+			COUNT(*) FILTER (WHERE rated_at >= ? AND rated_at < ? AND rating_stars = ?)
+		FROM courier_deliveries
+		WHERE courier_id = ?
+	`, week, week.AddDate(0, 0, 7), week, week.AddDate(0, 0, 7), badge.FiveStars, courierID).
+		Row().Scan(&tally.Deliveries, &tally.WeekRatings, &tally.WeekFiveStarRatings)
+	return tally, err
+}
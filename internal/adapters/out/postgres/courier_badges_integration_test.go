@@ -0,0 +1,160 @@
+package postgres_test
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/badge"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+// CourierBadgesIntegrationTestSuite verifies that the courier badges projection records
+// deliveries and ratings from the change log and awards the badges of the rules.
+type CourierBadgesIntegrationTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	adminDB  *gorm.DB
+	appDB    *gorm.DB
+	version  int64
+}
+
+// SetupSuite starts PostgreSQL and creates the tenant tables, the checkpoints and the application role.
+func (suite *CourierBadgesIntegrationTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		if err := migrateTenantTables(db); err != nil {
+			return err
+		}
+		if err := db.AutoMigrate(&postgres_adapter.ProjectionCheckpointDTO{}); err != nil {
+			return err
+		}
+		if err := postgres_adapter.ApplyTenancyPolicies(db, defaultTenant); err != nil {
+			return err
+		}
+		return createAppRole(db)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+// SetupTest clones the template for the test and connects to the clone
+// both as the superuser and as the application role.
+func (suite *CourierBadgesIntegrationTestSuite) SetupTest() {
+	database := suite.template.CreateDatabase(suite.T())
+
+	suite.adminDB = pgtest.Open(suite.T(), suite.template.DSN(pgtest.User, pgtest.Password, database))
+	suite.appDB = pgtest.Open(suite.T(), suite.template.DSN("delivery_app", "app", database))
+	suite.version = 0
+}
+
+// TearDownSuite cleans up PostgreSQL container after all tests complete.
+func (suite *CourierBadgesIntegrationTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *CourierBadgesIntegrationTestSuite) TestProjectNext_AwardsDeliveriesBadgeOnce() {
+	ctx := context.Background()
+	courierID := uuid.New()
+	runner := suite.runner("hat-trick=deliveries:3")
+
+	first, second := uuid.New(), uuid.New()
+	suite.appendOrderChange(first, order.Assigned, &courierID, 0)
+	suite.appendOrderChange(first, order.Completed, &courierID, 0)
+	suite.appendOrderChange(second, order.Completed, &courierID, 0)
+	// Rating a delivery is another change of a completed order, not another delivery
+	suite.appendOrderChange(second, order.Completed, &courierID, 4)
+	_, err := runner.ProjectNext(ctx, 100)
+	suite.Require().NoError(err)
+	suite.Empty(suite.badges(courierID))
+
+	third, fourth := uuid.New(), uuid.New()
+	suite.appendOrderChange(third, order.Completed, &courierID, 0)
+	suite.appendOrderChange(fourth, order.Completed, &courierID, 0)
+	_, err = runner.ProjectNext(ctx, 100)
+	suite.Require().NoError(err)
+
+	badges := suite.badges(courierID)
+	suite.Require().Len(badges, 1)
+	suite.Equal("hat-trick", badges[0].Badge)
+	suite.Equal(int64(5), badges[0].ChangeID, "the third delivery earned the badge")
+}
+
+func (suite *CourierBadgesIntegrationTestSuite) TestProjectNext_AwardsFiveStarWeekOnlyWithoutLowerRatings() {
+	ctx := context.Background()
+	starred, spoiled := uuid.New(), uuid.New()
+	runner := suite.runner("five-star-week=five-star-week:2")
+
+	for _, stars := range []int{5, 5} {
+		orderID := uuid.New()
+		suite.appendOrderChange(orderID, order.Completed, &starred, 0)
+		suite.appendOrderChange(orderID, order.Completed, &starred, stars)
+	}
+	for _, stars := range []int{5, 3, 5} {
+		orderID := uuid.New()
+		suite.appendOrderChange(orderID, order.Completed, &spoiled, 0)
+		suite.appendOrderChange(orderID, order.Completed, &spoiled, stars)
+	}
+	_, err := runner.ProjectNext(ctx, 100)
+	suite.Require().NoError(err)
+
+	badges := suite.badges(starred)
+	suite.Require().Len(badges, 1)
+	suite.Equal("five-star-week", badges[0].Badge)
+	suite.Empty(suite.badges(spoiled))
+}
+
+func (suite *CourierBadgesIntegrationTestSuite) runner(rules string) *postgres_adapter.ProjectionBackfillRunner {
+	parsed, err := badge.ParseRules(rules)
+	suite.Require().NoError(err)
+
+	runner, err := postgres_adapter.NewProjectionBackfillRunner(
+		suite.appDB, slog.Default(), postgres_adapter.NewCourierBadgesProjection(parsed),
+	)
+	suite.Require().NoError(err)
+	return runner
+}
+
+// appendOrderChange records a change of the order in the change log of the default tenant.
+// Every change gets the next version, which is enough for the projection.
+func (suite *CourierBadgesIntegrationTestSuite) appendOrderChange(
+	orderID uuid.UUID,
+	status order.Status,
+	courierID *uuid.UUID,
+	ratingStars int,
+) {
+	suite.version++
+	snapshot, err := json.Marshal(queries.OrderSnapshot{
+		Status: status, CourierID: courierID, X: 1, Y: 1, Volume: 5, RatingStars: ratingStars,
+	})
+	suite.Require().NoError(err)
+
+	err = suite.adminDB.Exec(`
+		INSERT INTO change_log (aggregate_type, aggregate_id, version, changed_at, snapshot)
+		VALUES (?, ?, ?, ?, ?)
+	`, queries.OrderChange, orderID, suite.version, time.Now().UTC(), string(snapshot)).Error
+	suite.Require().NoError(err)
+}
+
+func (suite *CourierBadgesIntegrationTestSuite) badges(courierID uuid.UUID) []postgres_adapter.CourierBadgeDTO {
+	var badges []postgres_adapter.CourierBadgeDTO
+	err := suite.adminDB.Where("courier_id = ?", courierID).Order("awarded_at, badge").Find(&badges).Error
+	suite.Require().NoError(err)
+	return badges
+}
+
+func TestCourierBadgesIntegrationTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(CourierBadgesIntegrationTestSuite))
+}
@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+
+	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+
+	"gorm.io/gorm"
+)
+
+var _ ports.CourierMergeRepository = (*GormCourierMergeRepository)(nil)
+
+// GormCourierMergeRepository moves the records of a duplicate courier to another courier with
+// plain SQL statements, since they are spread over tables of several repositories.
+type GormCourierMergeRepository struct {
+	db *gorm.DB
+}
+
+// NewGormCourierMergeRepository creates a courier merge repository.
+func NewGormCourierMergeRepository(db *gorm.DB) *GormCourierMergeRepository {
+	return &GormCourierMergeRepository{db: db}
+}
+
+// Merge moves the records of the duplicate to the survivor and deletes the duplicate.
+// Blob uploads are not moved: they are shared by all tenants and keep their owner as a plain ID.
+func (r *GormCourierMergeRepository) Merge(
+	ctx context.Context,
+	duplicate *courier.Courier,
+	survivorID kernel.UUID,
+) (ports.MergedCourierRecords, error) {
+	if err := duplicate.Validate(); err != nil {
+		return ports.MergedCourierRecords{}, err
+	}
+	if err := survivorID.Validate(); err != nil {
+		return ports.MergedCourierRecords{}, err
+	}
+
+	db := r.db.WithContext(ctx)
+	from := duplicate.ID().Bytes()
+	to := survivorID.Bytes()
+
+	// Bumping the version locks the duplicate, so nothing is written for it until it is deleted
+	locked := db.Exec(`UPDATE couriers SET version = version + 1 WHERE id = ? AND version = ?`,
+		from, duplicate.Version())
+	if locked.Error != nil {
+		return ports.MergedCourierRecords{}, locked.Error
+	}
+	if locked.RowsAffected == 0 {
+		return ports.MergedCourierRecords{}, r.staleOrMissing(ctx, duplicate)
+	}
+
+	var records ports.MergedCourierRecords
+	moves := []struct {
+		count     *int
+		statement string
+	}{
+		{&records.Orders, `UPDATE orders SET courier_id = @to, version = version + 1 WHERE courier_id = @from`},
+		{&records.StoragePlaces, `UPDATE storage_places SET courier_id = @to WHERE courier_id = @from`},
+		{&records.Earnings, `UPDATE courier_earnings SET courier_id = @to WHERE courier_id = @from`},
+		{&records.Handovers, `UPDATE order_handovers SET from_courier_id = @to WHERE from_courier_id = @from`},
+		{&records.Handovers, `UPDATE order_handovers SET to_courier_id = @to WHERE to_courier_id = @from`},
+		{&records.AssignmentExplanations, `UPDATE assignment_explanations SET courier_id = @to WHERE courier_id = @from`},
+		{nil, `UPDATE assignment_score_factors SET courier_id = @to WHERE courier_id = @from`},
+		{&records.Substitutions, `UPDATE courier_absences SET substitute_id = @to
+			WHERE substitute_id = @from AND courier_id <> @to`},
+		{&records.DeviceReadings, `UPDATE courier_device_readings SET courier_id = @to WHERE courier_id = @from`},
+		{&records.VerificationAttempts, `UPDATE courier_verification_attempts SET courier_id = @to
+			WHERE courier_id = @from`},
+		// A courier has one point per moment and one delivery per announcement, so those the
+		// survivor already has are kept and the copies of the duplicate are dropped below
+		{&records.LocationPoints, `UPDATE courier_location_points p SET courier_id = @to
+			WHERE p.courier_id = @from AND NOT EXISTS (
+				SELECT 1 FROM courier_location_points s
+				WHERE s.courier_id = @to AND s.recorded_at = p.recorded_at
+			)`},
+		{nil, `DELETE FROM courier_location_points WHERE courier_id = @from`},
+		{&records.AnnouncementDeliveries, `UPDATE announcement_deliveries d SET courier_id = @to
+			WHERE d.courier_id = @from AND NOT EXISTS (
+				SELECT 1 FROM announcement_deliveries s
+				WHERE s.courier_id = @to AND s.announcement_id = d.announcement_id
+			)`},
+		{nil, `DELETE FROM announcement_deliveries WHERE courier_id = @from`},
+		// Tracks of the same day are added up, so the survivor's day covers both records
+		{&records.DailyTracks, `INSERT INTO courier_daily_tracks (courier_id, day, points, distance, first_at, last_at)
+			SELECT @to, day, points, distance, first_at, last_at FROM courier_daily_tracks WHERE courier_id = @from
+			ON CONFLICT (courier_id, day) DO UPDATE SET
+				points = courier_daily_tracks.points + EXCLUDED.points,
+				distance = courier_daily_tracks.distance + EXCLUDED.distance,
+				first_at = LEAST(courier_daily_tracks.first_at, EXCLUDED.first_at),
+				last_at = GREATEST(courier_daily_tracks.last_at, EXCLUDED.last_at)`},
+		{nil, `DELETE FROM courier_daily_tracks WHERE courier_id = @from`},
+	}
+
+	arguments := map[string]any{"from": from, "to": to}
+	for _, move := range moves {
+		result := db.Exec(move.statement, arguments)
+		if result.Error != nil {
+			return ports.MergedCourierRecords{}, result.Error
+		}
+		if move.count != nil {
+			*move.count += int(result.RowsAffected)
+		}
+	}
+
+	// Finished maintenance windows and absences of the duplicate are deleted with it
+	if err := db.Delete(&courierrepo.CourierDTO{}, "id = ?", from).Error; err != nil {
+		return ports.MergedCourierRecords{}, err
+	}
+
+	return records, nil
+}
+
+// staleOrMissing explains a lock that matched no row: the duplicate was either changed by another
+// transaction since it was loaded or does not exist.
+func (r *GormCourierMergeRepository) staleOrMissing(ctx context.Context, duplicate *courier.Courier) error {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&courierrepo.CourierDTO{}).
+		Where("id = ?", duplicate.ID().Bytes()).Count(&count).Error; err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return errs.NewObjectNotFoundError("courier", duplicate.ID().String())
+	}
+	return errs.NewConcurrencyConflictError("courier", duplicate.ID().String(), duplicate.Version())
+}
@@ -0,0 +1,169 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/adapters/out/postgres/earningsrepo"
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/adapters/out/postgres/relayrepo"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+// CourierMergeIntegrationTestSuite verifies that a merge moves the records of the duplicate
+// to the survivor and refuses a duplicate that changed since it was loaded.
+type CourierMergeIntegrationTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	db       *gorm.DB
+	factory  ports.UnitOfWorkFactory
+}
+
+// SetupSuite starts PostgreSQL and applies migrations of every table that refers to couriers.
+func (suite *CourierMergeIntegrationTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&orderrepo.OrderDTO{},
+			&orderrepo.OrderMessageDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{},
+			&orderrepo.OrderItemDTO{},
+			&courierrepo.CourierDTO{},
+			&courierrepo.StoragePlaceDTO{},
+			&courierrepo.MaintenanceWindowDTO{},
+			&courierrepo.AbsenceDTO{},
+			&courierrepo.ScheduleWindowDTO{},
+			&earningsrepo.EntryDTO{},
+			&relayrepo.HandoverDTO{},
+			&postgres_adapter.AssignmentExplanationDTO{},
+			&postgres_adapter.AssignmentScoreFactorDTO{},
+			&postgres_adapter.AnnouncementDTO{},
+			&postgres_adapter.AnnouncementDeliveryDTO{},
+			&postgres_adapter.CourierDeviceReadingDTO{},
+			&postgres_adapter.CourierLocationPointDTO{},
+			&postgres_adapter.CourierDailyTrackDTO{},
+			&postgres_adapter.VerificationAttemptDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+// SetupTest gives every test a fresh clone of the migrated database.
+func (suite *CourierMergeIntegrationTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.factory = postgres_adapter.NewGormUnitOfWorkFactory(suite.db)
+}
+
+// TearDownSuite cleans up PostgreSQL container after all tests complete.
+func (suite *CourierMergeIntegrationTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+// TestMerge_MovesRecordsOfDuplicate verifies that orders, storage places and tracks move to the
+// survivor, points of the same moment are kept once and tracks of the same day are added up.
+func (suite *CourierMergeIntegrationTestSuite) TestMerge_MovesRecordsOfDuplicate() {
+	ctx := context.Background()
+	survivor := suite.add(createTestCourier())
+	duplicate := suite.add(createTestCourier())
+	delivered := createTestOrder()
+	suite.Require().NoError(delivered.Assign(duplicate.ID()))
+	uow := suite.factory.Create()
+	suite.Require().NoError(uow.Begin(ctx))
+	suite.Require().NoError(uow.OrderRepository().Add(ctx, delivered))
+	suite.Require().NoError(uow.Commit(ctx))
+
+	day := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	shared := day.Add(9 * time.Hour)
+	suite.addPoint(survivor, shared)
+	suite.addPoint(duplicate, shared)
+	suite.addPoint(duplicate, shared.Add(time.Minute))
+	suite.addDailyTrack(survivor, day, 3, 10)
+	suite.addDailyTrack(duplicate, day, 2, 4)
+
+	uow = suite.factory.Create()
+	suite.Require().NoError(uow.Begin(ctx))
+	loaded, err := uow.CourierRepository().Get(ctx, duplicate.ID())
+	suite.Require().NoError(err)
+	records, err := uow.CourierMergeRepository().Merge(ctx, loaded, survivor.ID())
+	suite.Require().NoError(err)
+	suite.Require().NoError(uow.Commit(ctx))
+
+	suite.Equal(1, records.Orders)
+	suite.Equal(1, records.StoragePlaces)
+	suite.Equal(1, records.LocationPoints)
+	suite.Equal(1, records.DailyTracks)
+	suite.Equal(int64(0), suite.count("couriers", "id = ?", duplicate.ID().String()))
+	suite.Equal(int64(1), suite.count("orders", "courier_id = ?", survivor.ID().String()))
+	suite.Equal(int64(2), suite.count("storage_places", "courier_id = ?", survivor.ID().String()))
+	suite.Equal(int64(2), suite.count("courier_location_points", "courier_id = ?", survivor.ID().String()))
+
+	var track postgres_adapter.CourierDailyTrackDTO
+	suite.Require().NoError(suite.db.Where("courier_id = ?", survivor.ID().String()).First(&track).Error)
+	suite.Equal(5, track.Points)
+	suite.Equal(14, track.Distance)
+}
+
+// TestMerge_DuplicateChanged verifies that a duplicate updated since it was loaded is not merged.
+func (suite *CourierMergeIntegrationTestSuite) TestMerge_DuplicateChanged() {
+	ctx := context.Background()
+	survivor := suite.add(createTestCourier())
+	duplicate := suite.add(createTestCourier())
+	suite.Require().NoError(suite.db.Exec(
+		"UPDATE couriers SET version = version + 1 WHERE id = ?", duplicate.ID().String(),
+	).Error)
+
+	uow := suite.factory.Create()
+	suite.Require().NoError(uow.Begin(ctx))
+	_, err := uow.CourierMergeRepository().Merge(ctx, duplicate, survivor.ID())
+	suite.Require().NoError(uow.Rollback(ctx))
+
+	suite.Require().ErrorIs(err, errs.ErrConcurrencyConflict)
+	suite.Equal(int64(1), suite.count("couriers", "id = ?", duplicate.ID().String()))
+}
+
+// add persists the courier in its own unit of work.
+func (suite *CourierMergeIntegrationTestSuite) add(c *courier.Courier) *courier.Courier {
+	ctx := context.Background()
+	uow := suite.factory.Create()
+	suite.Require().NoError(uow.Begin(ctx))
+	suite.Require().NoError(uow.CourierRepository().Add(ctx, c))
+	suite.Require().NoError(uow.Commit(ctx))
+	return c
+}
+
+func (suite *CourierMergeIntegrationTestSuite) addPoint(c *courier.Courier, recordedAt time.Time) {
+	suite.Require().NoError(suite.db.Exec(
+		"INSERT INTO courier_location_points (courier_id, recorded_at, location_x, location_y) VALUES (?, ?, 1, 1)",
+		c.ID().String(), recordedAt,
+	).Error)
+}
+
+func (suite *CourierMergeIntegrationTestSuite) addDailyTrack(c *courier.Courier, day time.Time, points, distance int) {
+	suite.Require().NoError(suite.db.Exec(
+		"INSERT INTO courier_daily_tracks (courier_id, day, points, distance) VALUES (?, ?, ?, ?)",
+		c.ID().String(), day, points, distance,
+	).Error)
+}
+
+func (suite *CourierMergeIntegrationTestSuite) count(table, condition string, args ...any) int64 {
+	var count int64
+	suite.Require().NoError(suite.db.Table(table).Where(condition, args...).Count(&count).Error)
+	return count
+}
+
+func TestCourierMergeIntegrationTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(CourierMergeIntegrationTestSuite))
+}
@@ -0,0 +1,184 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/track"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CourierLocationPointDTO is a position a courier app recorded. A courier has at most one
+// point per moment, so a resent point is skipped.
+type CourierLocationPointDTO struct {
+	CourierID  uuid.UUID         `gorm:"type:uuid;primaryKey"`
+	RecordedAt time.Time         `gorm:"primaryKey"`
+	LocationX  kernel.Coordinate `gorm:"type:smallint;not null"`
+	LocationY  kernel.Coordinate `gorm:"type:smallint;not null"`
+}
+
+// TableName specifies the database table name for location points.
+// Overrides GORM's default naming convention to use "courier_location_points".
+func (CourierLocationPointDTO) TableName() string {
+	return "courier_location_points"
+}
+
+// toDomain restores the point from its database representation.
+func (dto CourierLocationPointDTO) toDomain() (track.Point, error) {
+	location, err := kernel.NewLocation(dto.LocationX, dto.LocationY)
+	if err != nil {
+		return track.Point{}, err
+	}
+
+	return track.NewPoint(location, dto.RecordedAt)
+}
+
+// CourierDailyTrackDTO is how much a courier moved on a UTC day.
+type CourierDailyTrackDTO struct {
+	CourierID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Day       time.Time `gorm:"type:date;primaryKey"`
+	Points    int       `gorm:"not null"`
+	Distance  int       `gorm:"not null"`
+	FirstAt   *time.Time
+	LastAt    *time.Time
+}
+
+// TableName specifies the database table name for daily tracks.
+// Overrides GORM's default naming convention to use "courier_daily_tracks".
+func (CourierDailyTrackDTO) TableName() string {
+	return "courier_daily_tracks"
+}
+
+// newCourierDailyTrackDTO converts a daily track to its database representation.
+func newCourierDailyTrackDTO(t track.DailyTrack) CourierDailyTrackDTO {
+	dto := CourierDailyTrackDTO{
+		CourierID: t.CourierID().Bytes(),
+		Day:       t.Day(),
+		Points:    t.Points(),
+		Distance:  t.Distance(),
+	}
+	if t.Points() > 0 {
+		firstAt, lastAt := t.FirstAt(), t.LastAt()
+		dto.FirstAt, dto.LastAt = &firstAt, &lastAt
+	}
+	return dto
+}
+
+// GormCourierTrackRepository implements ports.CourierTrackRepository over the
+// courier_location_points and courier_daily_tracks tables. Each call runs in a transaction
+// of its own bound to the tenant carried by ctx, since the location history is not part of
+// a courier unit of work.
+type GormCourierTrackRepository struct {
+	db *gorm.DB
+}
+
+// NewGormCourierTrackRepository creates a location history repository over the given connection.
+func NewGormCourierTrackRepository(db *gorm.DB) *GormCourierTrackRepository {
+	return &GormCourierTrackRepository{db: db}
+}
+
+// Merge stores the points unless the courier does not exist, skipping the moments the
+// courier's history already has.
+func (r *GormCourierTrackRepository) Merge(
+	ctx context.Context,
+	courierID kernel.UUID,
+	points []track.Point,
+) (int, error) {
+	dtos := make([]CourierLocationPointDTO, 0, len(points))
+	for _, p := range points {
+		dtos = append(dtos, CourierLocationPointDTO{
+			CourierID:  courierID.Bytes(),
+			RecordedAt: p.RecordedAt(),
+			LocationX:  p.Location().X(),
+			LocationY:  p.Location().Y(),
+		})
+	}
+
+	var merged int
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		var exists bool
+		if err := tx.Raw("SELECT EXISTS (SELECT 1 FROM couriers WHERE id = ?)", courierID.String()).
+			Scan(&exists).Error; err != nil {
+			return err
+		}
+		if !exists {
+			return errs.NewObjectNotFoundError("courier", courierID)
+		}
+
+		if len(dtos) == 0 {
+			return nil
+		}
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&dtos)
+		merged = int(result.RowsAffected)
+		return result.Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return merged, nil
+}
+
+// GetDay returns the courier's points recorded on the UTC day, oldest first.
+func (r *GormCourierTrackRepository) GetDay(
+	ctx context.Context,
+	courierID kernel.UUID,
+	day time.Time,
+) ([]track.Point, error) {
+	var dtos []CourierLocationPointDTO
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		start := track.Day(day)
+		return tx.Where("courier_id = ? AND recorded_at >= ? AND recorded_at < ?",
+			courierID.String(), start, start.AddDate(0, 0, 1)).
+			Order("recorded_at").
+			Find(&dtos).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]track.Point, 0, len(dtos))
+	for _, dto := range dtos {
+		p, toDomainErr := dto.toDomain()
+		if toDomainErr != nil {
+			return nil, toDomainErr
+		}
+		points = append(points, p)
+	}
+	return points, nil
+}
+
+// SaveDailyTracks upserts the daily tracks by courier and day.
+func (r *GormCourierTrackRepository) SaveDailyTracks(ctx context.Context, tracks []track.DailyTrack) error {
+	if len(tracks) == 0 {
+		return nil
+	}
+
+	dtos := make([]CourierDailyTrackDTO, 0, len(tracks))
+	for _, t := range tracks {
+		dtos = append(dtos, newCourierDailyTrackDTO(t))
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "courier_id"}, {Name: "day"}},
+			DoUpdates: clause.AssignmentColumns([]string{"points", "distance", "first_at", "last_at"}),
+		}).Create(&dtos).Error
+	})
+}
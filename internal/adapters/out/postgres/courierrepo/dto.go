@@ -4,8 +4,12 @@
 package courierrepo
 
 import (
+	"fmt"
+	"time"
+
 	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/i18n"
 
 	"github.com/google/uuid"
 )
@@ -13,11 +17,30 @@ import (
 // CourierDTO represents the database structure for persisting courier aggregates.
 // Maps courier domain entities to relational database tables with proper foreign key relationships.
 type CourierDTO struct {
-	ID            uuid.UUID         `gorm:"type:uuid;primaryKey"`
-	Name          string            `gorm:"type:varchar(255);not null"`
-	Speed         int               `gorm:"type:int;not null"`
-	Location      LocationDTO       `gorm:"embedded;embeddedPrefix:location_"`
-	StoragePlaces []StoragePlaceDTO `gorm:"foreignKey:CourierID;constraint:OnDelete:CASCADE"`
+	ID                 uuid.UUID         `gorm:"type:uuid;primaryKey"`
+	Name               string            `gorm:"type:varchar(255);not null"`
+	Speed              int               `gorm:"type:int;not null"`
+	Location           LocationDTO       `gorm:"embedded;embeddedPrefix:location_"`
+	StoragePlaces      []StoragePlaceDTO `gorm:"foreignKey:CourierID;constraint:OnDelete:CASCADE"`
+	Paused             bool              `gorm:"not null;default:false"` // legacy break flag, see statusInput
+	ReviewRequired     bool              `gorm:"not null;default:false"`
+	Insured            bool              `gorm:"not null;default:false"`
+	DeactivationReason int               `gorm:"type:smallint;not null;default:0"`
+	Language           string            `gorm:"type:varchar(8);not null;default:'ru'"`
+	PhotoURL           *string           `gorm:"column:photo_url;type:varchar(2048)"`
+	Phone              *string           `gorm:"type:varchar(16)"`
+	VehiclePlate       *string           `gorm:"type:varchar(12)"`
+	VehicleType        *int              `gorm:"type:smallint"`
+	WorkDay            *time.Time        `gorm:"type:date"`
+	WorkedSeconds      int64             `gorm:"not null;default:0"`
+	ShiftStartedAt     *time.Time
+	Status             *int                   `gorm:"type:smallint;index"`
+	ExternalID         *string                `gorm:"type:varchar(64);uniqueIndex:idx_couriers_external_id"`
+	MaintenanceWindows []MaintenanceWindowDTO `gorm:"foreignKey:CourierID;constraint:OnDelete:CASCADE"`
+	Absences           []AbsenceDTO           `gorm:"foreignKey:CourierID;constraint:OnDelete:CASCADE"`
+	ScheduleWindows    []ScheduleWindowDTO    `gorm:"foreignKey:CourierID;constraint:OnDelete:CASCADE"`
+	// Version is compared and bumped by every update, so concurrent writers cannot overwrite each other
+	Version int `gorm:"not null;default:0"`
 }
 
 // TableName specifies the database table name for courier entities.
@@ -36,11 +59,13 @@ type LocationDTO struct {
 // StoragePlaceDTO represents the database structure for persisting storage place entities.
 // Links to courier via foreign key and optionally references stored orders.
 type StoragePlaceDTO struct {
-	ID          uuid.UUID  `gorm:"type:uuid;primaryKey"`
-	CourierID   uuid.UUID  `gorm:"type:uuid;not null;index"`
-	Name        string     `gorm:"type:varchar(255);not null"`
-	TotalVolume int        `gorm:"type:int;not null"`
-	OrderID     *uuid.UUID `gorm:"type:uuid;index"`
+	ID           uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	CourierID    uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Name         string     `gorm:"type:varchar(255);not null"`
+	TotalVolume  int        `gorm:"type:int;not null"`
+	OrderID      *uuid.UUID `gorm:"type:uuid;index"`
+	OutOfService bool       `gorm:"not null;default:false"`
+	Thermal      bool       `gorm:"not null;default:false"`
 }
 
 // TableName specifies the database table name for storage place entities.
@@ -49,6 +74,52 @@ func (StoragePlaceDTO) TableName() string {
 	return "storage_places"
 }
 
+// MaintenanceWindowDTO represents the database structure for persisting courier vehicle maintenance windows.
+type MaintenanceWindowDTO struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	CourierID uuid.UUID `gorm:"type:uuid;not null;index"`
+	StartsAt  time.Time `gorm:"not null"`
+	EndsAt    time.Time `gorm:"not null"`
+}
+
+// TableName specifies the database table name for maintenance window entities.
+// Overrides GORM's default naming convention to use "courier_maintenance_windows".
+func (MaintenanceWindowDTO) TableName() string {
+	return "courier_maintenance_windows"
+}
+
+// AbsenceDTO represents the database structure for persisting planned courier absences.
+type AbsenceDTO struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey"`
+	CourierID    uuid.UUID `gorm:"type:uuid;not null;index"`
+	StartsAt     time.Time `gorm:"not null"`
+	EndsAt       time.Time `gorm:"not null"`
+	SubstituteID uuid.UUID `gorm:"type:uuid;not null;index"`
+}
+
+// TableName specifies the database table name for absence entities.
+// Overrides GORM's default naming convention to use "courier_absences".
+func (AbsenceDTO) TableName() string {
+	return "courier_absences"
+}
+
+// ScheduleWindowDTO represents the database structure for persisting the windows of courier working schedules.
+// A schedule is the set of rows sharing a schedule ID; replacing the schedule replaces the rows.
+// Times are minutes since midnight UTC.
+type ScheduleWindowDTO struct {
+	ScheduleID  uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Weekday     int       `gorm:"type:smallint;primaryKey"`
+	StartMinute int       `gorm:"type:smallint;primaryKey"`
+	EndMinute   int       `gorm:"type:smallint;not null"`
+	CourierID   uuid.UUID `gorm:"type:uuid;not null;index"`
+}
+
+// TableName specifies the database table name for schedule window entities.
+// Overrides GORM's default naming convention to use "courier_schedule_windows".
+func (ScheduleWindowDTO) TableName() string {
+	return "courier_schedule_windows"
+}
+
 // fromDomain converts a courier domain aggregate to its database representation.
 // Maps all aggregate entities including storage places and their current state.
 func fromDomain(courier *courier.Courier) CourierDTO {
@@ -63,14 +134,50 @@ func fromDomain(courier *courier.Courier) CourierDTO {
 		}
 
 		storagePlaces = append(storagePlaces, StoragePlaceDTO{
-			ID:          sp.ID().Bytes(),
-			CourierID:   courierID,
-			Name:        sp.Name(),
-			TotalVolume: sp.TotalVolume(),
-			OrderID:     orderID,
+			ID:           sp.ID().Bytes(),
+			CourierID:    courierID,
+			Name:         sp.Name(),
+			TotalVolume:  sp.TotalVolume(),
+			OrderID:      orderID,
+			OutOfService: sp.IsOutOfService(),
+			Thermal:      sp.IsThermal(),
+		})
+	}
+
+	maintenanceWindows := make([]MaintenanceWindowDTO, 0, len(courier.MaintenanceWindows()))
+	for _, window := range courier.MaintenanceWindows() {
+		maintenanceWindows = append(maintenanceWindows, MaintenanceWindowDTO{
+			ID:        window.ID().Bytes(),
+			CourierID: courierID,
+			StartsAt:  window.StartsAt(),
+			EndsAt:    window.EndsAt(),
 		})
 	}
 
+	absences := make([]AbsenceDTO, 0, len(courier.Absences()))
+	for _, absence := range courier.Absences() {
+		absences = append(absences, AbsenceDTO{
+			ID:           absence.ID().Bytes(),
+			CourierID:    courierID,
+			StartsAt:     absence.StartsAt(),
+			EndsAt:       absence.EndsAt(),
+			SubstituteID: absence.SubstituteID().Bytes(),
+		})
+	}
+
+	var scheduleWindows []ScheduleWindowDTO
+	if schedule := courier.Schedule(); schedule != nil {
+		for _, window := range schedule.Windows() {
+			scheduleWindows = append(scheduleWindows, ScheduleWindowDTO{
+				ScheduleID:  schedule.ID().Bytes(),
+				Weekday:     int(window.Weekday()),
+				StartMinute: int(window.Start() / time.Minute),
+				EndMinute:   int(window.End() / time.Minute),
+				CourierID:   courierID,
+			})
+		}
+	}
+
 	return CourierDTO{
 		ID:    courierID,
 		Name:  courier.Name(),
@@ -79,8 +186,93 @@ func fromDomain(courier *courier.Courier) CourierDTO {
 			X: courier.Location().X(),
 			Y: courier.Location().Y(),
 		},
-		StoragePlaces: storagePlaces,
+		StoragePlaces:      storagePlaces,
+		ReviewRequired:     courier.IsReviewRequired(),
+		Insured:            courier.IsInsured(),
+		DeactivationReason: int(courier.DeactivationReason()),
+		Language:           courier.Language().String(),
+		PhotoURL:           profileValue(courier.Profile().PhotoURL()),
+		Phone:              profileValue(courier.Profile().Phone()),
+		VehiclePlate:       profileValue(courier.Profile().VehiclePlate()),
+		VehicleType:        vehicleTypeValue(courier.Profile().VehicleType()),
+		WorkDay:            workDayValue(courier.WorkLog().Day()),
+		WorkedSeconds:      int64(courier.WorkLog().Completed() / time.Second),
+		ShiftStartedAt:     courier.WorkLog().ShiftStartedAt(),
+		Status:             statusValue(courier.Status()),
+		ExternalID:         profileValue(courier.ExternalID()),
+		MaintenanceWindows: maintenanceWindows,
+		Absences:           absences,
+		ScheduleWindows:    scheduleWindows,
+		Version:            courier.Version(),
+	}
+}
+
+// statusValue returns the persisted form of the courier's status.
+func statusValue(status courier.Status) *int {
+	value := int(status)
+	return &value
+}
+
+// statusInput returns the courier's status, derived from the shift and the storage for rows
+// written before the status column existed. Couriers on shift whose row still carries the
+// paused flag, written before breaks became a status, are on a break; saving them clears the flag.
+func statusInput(dto CourierDTO) courier.Status {
+	status := persistedStatus(dto)
+	if dto.Paused && (status == courier.Available || status == courier.Busy) {
+		return courier.OnBreak
+	}
+	return status
+}
+
+// persistedStatus returns the status stored in the row, or the one derived for rows written
+// before the status column existed.
+func persistedStatus(dto CourierDTO) courier.Status {
+	if dto.Status != nil {
+		return courier.Status(*dto.Status)
+	}
+	if dto.ShiftStartedAt == nil {
+		return courier.Offline
+	}
+	for _, sp := range dto.StoragePlaces {
+		if sp.OrderID != nil {
+			return courier.Busy
+		}
+	}
+	return courier.Available
+}
+
+// workDayValue returns the persisted work day, nil for couriers who have not worked yet.
+func workDayValue(day time.Time) *time.Time {
+	if day.IsZero() {
+		return nil
+	}
+	return &day
+}
+
+// profileValue returns the string form of an optional field, nil if the field is not set.
+func profileValue[T fmt.Stringer](field *T) *string {
+	if field == nil {
+		return nil
+	}
+	value := (*field).String()
+	return &value
+}
+
+// vehicleTypeValue returns the persisted form of the courier's vehicle type, nil if not set.
+func vehicleTypeValue(vehicleType *courier.VehicleType) *int {
+	if vehicleType == nil {
+		return nil
+	}
+	value := int(*vehicleType)
+	return &value
+}
+
+// profileInput returns the persisted profile field, or an empty string for NULL columns.
+func profileInput(value *string) string {
+	if value == nil {
+		return ""
 	}
+	return *value
 }
 
 // toDomain converts a database DTO to a courier domain aggregate.
@@ -106,7 +298,153 @@ func toDomain(dto CourierDTO) (*courier.Courier, error) {
 		storagePlaces = append(storagePlaces, sp)
 	}
 
-	return courier.RestoreCourier(id, dto.Name, dto.Speed, loc, storagePlaces)
+	restored, err := courier.RestoreCourier(id, dto.Name, dto.Speed, loc, storagePlaces)
+	if err != nil {
+		return nil, err
+	}
+
+	// Operational flags are plain state without invariants, so they are applied after restoration
+	if dto.ReviewRequired {
+		restored.FlagForReview()
+	}
+	if dto.Insured {
+		restored.Insure()
+	}
+	if err = restored.RestoreDeactivation(courier.DeactivationReason(dto.DeactivationReason)); err != nil {
+		return nil, err
+	}
+	if err = restored.ChangeLanguage(i18n.Language(dto.Language)); err != nil {
+		return nil, err
+	}
+
+	profile, err := courier.NewProfile(
+		profileInput(dto.PhotoURL),
+		profileInput(dto.Phone),
+		profileInput(dto.VehiclePlate),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if dto.VehicleType != nil {
+		if profile, err = profile.WithVehicleType(courier.VehicleType(*dto.VehicleType)); err != nil {
+			return nil, err
+		}
+	}
+	restored.ChangeProfile(profile)
+
+	var workDay time.Time
+	if dto.WorkDay != nil {
+		workDay = *dto.WorkDay
+	}
+	workLog, err := courier.RestoreWorkLog(workDay, time.Duration(dto.WorkedSeconds)*time.Second, dto.ShiftStartedAt)
+	if err != nil {
+		return nil, err
+	}
+	restored.RestoreWorkLog(workLog)
+	if err = restored.RestoreStatus(statusInput(dto)); err != nil {
+		return nil, err
+	}
+
+	if dto.ExternalID != nil {
+		externalID, externalErr := courier.NewExternalID(*dto.ExternalID)
+		if externalErr != nil {
+			return nil, externalErr
+		}
+		if err = restored.LinkExternalID(externalID); err != nil {
+			return nil, err
+		}
+	}
+
+	maintenanceWindows := make([]courier.MaintenanceWindow, 0, len(dto.MaintenanceWindows))
+	for _, windowDto := range dto.MaintenanceWindows {
+		window, windowErr := maintenanceWindowToDomain(windowDto)
+		if windowErr != nil {
+			return nil, windowErr
+		}
+		maintenanceWindows = append(maintenanceWindows, window)
+	}
+	if err = restored.RestoreMaintenanceWindows(maintenanceWindows); err != nil {
+		return nil, err
+	}
+
+	absences := make([]courier.Absence, 0, len(dto.Absences))
+	for _, absenceDto := range dto.Absences {
+		absence, absenceErr := absenceToDomain(absenceDto)
+		if absenceErr != nil {
+			return nil, absenceErr
+		}
+		absences = append(absences, absence)
+	}
+	if err = restored.RestoreAbsences(absences); err != nil {
+		return nil, err
+	}
+
+	schedule, err := scheduleToDomain(dto.ScheduleWindows)
+	if err != nil {
+		return nil, err
+	}
+	if err = restored.RestoreSchedule(schedule); err != nil {
+		return nil, err
+	}
+
+	restored.RestoreVersion(dto.Version)
+
+	return restored, nil
+}
+
+// maintenanceWindowToDomain converts a maintenance window DTO to its domain value.
+func maintenanceWindowToDomain(dto MaintenanceWindowDTO) (courier.MaintenanceWindow, error) {
+	id, err := kernel.UUIDFromBytes(dto.ID[:])
+	if err != nil {
+		return courier.MaintenanceWindow{}, err
+	}
+
+	return courier.NewMaintenanceWindow(id, dto.StartsAt, dto.EndsAt)
+}
+
+// scheduleToDomain converts the schedule window DTOs of a courier to its schedule, nil without windows.
+func scheduleToDomain(dtos []ScheduleWindowDTO) (*courier.Schedule, error) {
+	if len(dtos) == 0 {
+		return nil, nil //nolint:nilnil // a courier without windows has no schedule
+	}
+
+	id, err := kernel.UUIDFromBytes(dtos[0].ScheduleID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	windows := make([]courier.ScheduleWindow, 0, len(dtos))
+	for _, dto := range dtos {
+		window, windowErr := courier.NewScheduleWindow(
+			time.Weekday(dto.Weekday),
+			time.Duration(dto.StartMinute)*time.Minute,
+			time.Duration(dto.EndMinute)*time.Minute,
+		)
+		if windowErr != nil {
+			return nil, windowErr
+		}
+		windows = append(windows, window)
+	}
+
+	schedule, err := courier.NewSchedule(id, windows)
+	if err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// absenceToDomain converts an absence DTO to its domain value.
+func absenceToDomain(dto AbsenceDTO) (courier.Absence, error) {
+	id, err := kernel.UUIDFromBytes(dto.ID[:])
+	if err != nil {
+		return courier.Absence{}, err
+	}
+	substituteID, err := kernel.UUIDFromBytes(dto.SubstituteID[:])
+	if err != nil {
+		return courier.Absence{}, err
+	}
+
+	return courier.NewAbsence(id, dto.StartsAt, dto.EndsAt, substituteID)
 }
 
 // storageplaceToDomain converts a storage place DTO to domain entity.
@@ -126,5 +464,21 @@ func storageplaceToDomain(dto StoragePlaceDTO) (*courier.StoragePlace, error) {
 		orderID = &oID
 	}
 
-	return courier.RestoreStoragePlace(id, dto.Name, dto.TotalVolume, orderID)
+	sp, err := courier.RestoreStoragePlace(id, dto.Name, dto.TotalVolume, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if dto.Thermal {
+		sp.MarkThermal()
+	}
+
+	// StartMaintenance also rejects the inconsistent "occupied and out of service" state
+	if dto.OutOfService {
+		if err = sp.StartMaintenance(); err != nil {
+			return nil, err
+		}
+	}
+
+	return sp, nil
 }
@@ -3,15 +3,27 @@ package courierrepo
 import (
 	"context"
 	"errors"
+	"time"
 
 	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
 	"delivery/internal/pkg/errs"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm"
 )
 
+const (
+	// uniqueViolationState is the SQLSTATE Postgres reports for a violated unique index.
+	uniqueViolationState = "23505"
+
+	// externalIDIndex is the unique index of courier external IDs, declared on CourierDTO and
+	// scoped by tenant with postgres.ApplyTenancyPolicies.
+	externalIDIndex = "idx_couriers_external_id"
+)
+
 // GormCourierRepository implements CourierRepository using GORM.
 type GormCourierRepository struct {
 	db      *gorm.DB
@@ -32,6 +44,7 @@ func NewGormCourierRepository(db *gorm.DB, tracker aggregateTracker) *GormCourie
 }
 
 // Add saves a new courier to the database.
+// Returns courier.ErrExternalIDIsAlreadyRegistered if another courier is linked to its external ID.
 func (r *GormCourierRepository) Add(ctx context.Context, aggregate *courier.Courier) error {
 	if err := aggregate.Validate(); err != nil {
 		return err
@@ -39,6 +52,9 @@ func (r *GormCourierRepository) Add(ctx context.Context, aggregate *courier.Cour
 
 	dto := fromDomain(aggregate)
 	if err := r.db.WithContext(ctx).Create(&dto).Error; err != nil {
+		if isExternalIDConflict(err) {
+			return courier.ErrExternalIDIsAlreadyRegistered
+		}
 		return err
 	}
 
@@ -46,13 +62,28 @@ func (r *GormCourierRepository) Add(ctx context.Context, aggregate *courier.Cour
 	return nil
 }
 
-// Update saves an existing courier to the database.
+// Update saves an existing courier to the database and bumps its version.
+// Returns errs.ErrConcurrencyConflict if the courier was updated since it was loaded.
 func (r *GormCourierRepository) Update(ctx context.Context, aggregate *courier.Courier) error {
 	if err := aggregate.Validate(); err != nil {
 		return err
 	}
 
+	// Bumping the version first both detects a concurrent update and locks the row until commit
+	bumped := r.db.WithContext(ctx).
+		Model(&CourierDTO{}).
+		Where("id = ? AND version = ?", aggregate.ID().Bytes(), aggregate.Version()).
+		Update("version", aggregate.Version()+1)
+	if bumped.Error != nil {
+		return bumped.Error
+	}
+
+	if bumped.RowsAffected == 0 {
+		return r.staleOrMissing(ctx, aggregate)
+	}
+
 	dto := fromDomain(aggregate)
+	dto.Version = aggregate.Version() + 1
 
 	// Use Session with FullSaveAssociations to properly update nested associations
 	result := r.db.WithContext(ctx).Session(&gorm.Session{FullSaveAssociations: true}).Save(&dto)
@@ -60,14 +91,70 @@ func (r *GormCourierRepository) Update(ctx context.Context, aggregate *courier.C
 		return result.Error
 	}
 
-	if result.RowsAffected == 0 {
-		return gorm.ErrRecordNotFound
+	// Saving associations never removes rows, so storage places removed from the aggregate are deleted explicitly
+	placeIDs := make([]uuid.UUID, 0, len(dto.StoragePlaces))
+	for _, place := range dto.StoragePlaces {
+		placeIDs = append(placeIDs, place.ID)
+	}
+	if err := r.db.WithContext(ctx).Where("courier_id = ? AND id NOT IN ?", dto.ID, placeIDs).
+		Delete(&StoragePlaceDTO{}).Error; err != nil {
+		return err
 	}
 
+	// Likewise, windows cancelled on the aggregate are deleted explicitly
+	cancelled := r.db.WithContext(ctx).Where("courier_id = ?", dto.ID)
+	if len(dto.MaintenanceWindows) > 0 {
+		windowIDs := make([]uuid.UUID, 0, len(dto.MaintenanceWindows))
+		for _, window := range dto.MaintenanceWindows {
+			windowIDs = append(windowIDs, window.ID)
+		}
+		cancelled = cancelled.Where("id NOT IN ?", windowIDs)
+	}
+	if err := cancelled.Delete(&MaintenanceWindowDTO{}).Error; err != nil {
+		return err
+	}
+
+	cancelledAbsences := r.db.WithContext(ctx).Where("courier_id = ?", dto.ID)
+	if len(dto.Absences) > 0 {
+		absenceIDs := make([]uuid.UUID, 0, len(dto.Absences))
+		for _, absence := range dto.Absences {
+			absenceIDs = append(absenceIDs, absence.ID)
+		}
+		cancelledAbsences = cancelledAbsences.Where("id NOT IN ?", absenceIDs)
+	}
+	if err := cancelledAbsences.Delete(&AbsenceDTO{}).Error; err != nil {
+		return err
+	}
+
+	// A replaced or cleared schedule leaves rows of another schedule ID behind
+	replacedSchedules := r.db.WithContext(ctx).Where("courier_id = ?", dto.ID)
+	if len(dto.ScheduleWindows) > 0 {
+		replacedSchedules = replacedSchedules.Where("schedule_id <> ?", dto.ScheduleWindows[0].ScheduleID)
+	}
+	if err := replacedSchedules.Delete(&ScheduleWindowDTO{}).Error; err != nil {
+		return err
+	}
+
+	aggregate.RestoreVersion(dto.Version)
 	r.tracker.TrackAggregate(aggregate.ID(), aggregate)
 	return nil
 }
 
+// staleOrMissing explains an update that matched no row: the courier was either changed by another
+// transaction since it was loaded or does not exist.
+func (r *GormCourierRepository) staleOrMissing(ctx context.Context, aggregate *courier.Courier) error {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&CourierDTO{}).
+		Where("id = ?", aggregate.ID().Bytes()).Count(&count).Error; err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return errs.NewConcurrencyConflictError("courier", aggregate.ID().String(), aggregate.Version())
+}
+
 // Get retrieves a courier by ID.
 func (r *GormCourierRepository) Get(ctx context.Context, id kernel.UUID) (*courier.Courier, error) {
 	if err := id.Validate(); err != nil {
@@ -75,7 +162,7 @@ func (r *GormCourierRepository) Get(ctx context.Context, id kernel.UUID) (*couri
 	}
 
 	var dto CourierDTO
-	if err := r.db.WithContext(ctx).Preload("StoragePlaces").First(&dto, "id = ?", id.Bytes()).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("StoragePlaces").Preload("MaintenanceWindows").Preload("Absences").Preload("ScheduleWindows").First(&dto, "id = ?", id.Bytes()).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errs.NewObjectNotFoundError("courier", id.String())
 		}
@@ -85,8 +172,74 @@ func (r *GormCourierRepository) Get(ctx context.Context, id kernel.UUID) (*couri
 	return toDomain(dto)
 }
 
+// GetByExternalID retrieves the courier linked to an external system ID.
+func (r *GormCourierRepository) GetByExternalID(
+	ctx context.Context,
+	externalID courier.ExternalID,
+) (*courier.Courier, error) {
+	if err := externalID.Validate(); err != nil {
+		return nil, err
+	}
+
+	var dto CourierDTO
+	err := r.db.WithContext(ctx).Preload("StoragePlaces").Preload("MaintenanceWindows").Preload("Absences").Preload("ScheduleWindows").First(&dto, "external_id = ?", externalID.String()).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewObjectNotFoundError("courier", externalID.String())
+		}
+		return nil, err
+	}
+
+	return toDomain(dto)
+}
+
+// GetByIDs retrieves the couriers with the given IDs, ordered by ID. Unknown IDs are skipped.
+func (r *GormCourierRepository) GetByIDs(ctx context.Context, ids []kernel.UUID) ([]*courier.Courier, error) {
+	if len(ids) == 0 {
+		return []*courier.Courier{}, nil
+	}
+
+	keys := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if err := id.Validate(); err != nil {
+			return nil, err
+		}
+		keys = append(keys, id.Bytes())
+	}
+
+	var dtos []CourierDTO
+	if err := r.db.WithContext(ctx).
+		Preload("StoragePlaces").Preload("MaintenanceWindows").Preload("Absences").Preload("ScheduleWindows").
+		Where("id IN ?", keys).
+		Order("id").
+		Find(&dtos).Error; err != nil {
+		return nil, err
+	}
+
+	couriers := make([]*courier.Courier, 0, len(dtos))
+	for _, dto := range dtos {
+		c, err := toDomain(dto)
+		if err != nil {
+			return nil, err
+		}
+		couriers = append(couriers, c)
+	}
+
+	return couriers, nil
+}
+
+// isExternalIDConflict reports whether err is a violation of the unique external ID index.
+func isExternalIDConflict(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationState && pgErr.ConstraintName == externalIDIndex
+}
+
 // GetAllFree retrieves all couriers that are not currently assigned to active orders.
-// A courier is considered free if they are not assigned to any order in Assigned status.
+// A courier is considered free if they are Available, carry no order in storage, are not assigned
+// to any order in Assigned status, are neither flagged for review nor deactivated, their
+// vehicle is not in maintenance and they are not absent. Couriers saved before the status was
+// persisted count as Available while their shift runs, unless their row is still paused, which
+// made them take a break before breaks became a status.
 // Orders in Created status don't have couriers assigned yet, and orders in Completed
 // status have finished, so their couriers are available again.
 //
@@ -100,14 +253,164 @@ func (r *GormCourierRepository) Get(ctx context.Context, id kernel.UUID) (*couri
 //		fmt.Printf("Available courier: %s\n", courier.Name())
 //	}
 func (r *GormCourierRepository) GetAllFree(ctx context.Context) ([]*courier.Courier, error) {
+	now := time.Now().UTC()
+
 	var dtos []CourierDTO
 	// Join with orders table to find couriers not assigned to any orders in Assigned status
 	if err := r.db.WithContext(ctx).
-		Preload("StoragePlaces").
+		Preload("StoragePlaces").Preload("MaintenanceWindows").Preload("Absences").Preload("ScheduleWindows").
 		Table("couriers").
 		Select("couriers.*").
 		Joins("LEFT JOIN orders ON couriers.id = orders.courier_id AND orders.status = ?", int(order.Assigned)).
 		Where("orders.courier_id IS NULL").
+		Where(
+			"(couriers.status = ? OR (couriers.status IS NULL AND couriers.shift_started_at IS NOT NULL))",
+			int(courier.Available),
+		).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM storage_places sp
+			WHERE sp.courier_id = couriers.id AND sp.order_id IS NOT NULL
+		)`).
+		Where("couriers.paused = ? AND couriers.review_required = ?", false, false).
+		Where("couriers.deactivation_reason = ?", int(courier.NotDeactivated)).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM courier_maintenance_windows w
+			WHERE w.courier_id = couriers.id AND w.starts_at <= ? AND w.ends_at > ?
+		)`, now, now).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM courier_absences a
+			WHERE a.courier_id = couriers.id AND a.starts_at <= ? AND a.ends_at > ?
+		)`, now, now).
+		Find(&dtos).Error; err != nil {
+		return nil, err
+	}
+
+	couriers := make([]*courier.Courier, 0, len(dtos))
+	for _, dto := range dtos {
+		c, err := toDomain(dto)
+		if err != nil {
+			return nil, err
+		}
+		couriers = append(couriers, c)
+	}
+
+	return couriers, nil
+}
+
+// GetAllWithFreeStorage retrieves the couriers who can take another order. Unlike GetAllFree
+// it includes Busy couriers and couriers assigned to orders, as long as one of their storage
+// places in service is empty; the other rules of GetAllFree apply.
+func (r *GormCourierRepository) GetAllWithFreeStorage(ctx context.Context) ([]*courier.Courier, error) {
+	now := time.Now().UTC()
+
+	var dtos []CourierDTO
+	if err := r.db.WithContext(ctx).
+		Preload("StoragePlaces").Preload("MaintenanceWindows").Preload("Absences").Preload("ScheduleWindows").
+		Where(
+			"(status IN ? OR (status IS NULL AND shift_started_at IS NOT NULL))",
+			[]int{int(courier.Available), int(courier.Busy)},
+		).
+		Where(`EXISTS (
+			SELECT 1 FROM storage_places sp
+			WHERE sp.courier_id = couriers.id AND sp.order_id IS NULL AND NOT sp.out_of_service
+		)`).
+		Where("paused = ? AND review_required = ?", false, false).
+		Where("deactivation_reason = ?", int(courier.NotDeactivated)).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM courier_maintenance_windows w
+			WHERE w.courier_id = couriers.id AND w.starts_at <= ? AND w.ends_at > ?
+		)`, now, now).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM courier_absences a
+			WHERE a.courier_id = couriers.id AND a.starts_at <= ? AND a.ends_at > ?
+		)`, now, now).
+		Order("id").
+		Find(&dtos).Error; err != nil {
+		return nil, err
+	}
+
+	couriers := make([]*courier.Courier, 0, len(dtos))
+	for _, dto := range dtos {
+		c, err := toDomain(dto)
+		if err != nil {
+			return nil, err
+		}
+		couriers = append(couriers, c)
+	}
+
+	return couriers, nil
+}
+
+// GetAllOnShift retrieves all couriers with a running shift who are not deactivated.
+// Unlike GetAllFree it includes couriers who are busy, on a break or flagged for review.
+func (r *GormCourierRepository) GetAllOnShift(ctx context.Context) ([]*courier.Courier, error) {
+	var dtos []CourierDTO
+	if err := r.db.WithContext(ctx).
+		Preload("StoragePlaces").Preload("MaintenanceWindows").Preload("Absences").Preload("ScheduleWindows").
+		Where("shift_started_at IS NOT NULL").
+		Where("deactivation_reason = ?", int(courier.NotDeactivated)).
+		Order("id").
+		Find(&dtos).Error; err != nil {
+		return nil, err
+	}
+
+	couriers := make([]*courier.Courier, 0, len(dtos))
+	for _, dto := range dtos {
+		c, err := toDomain(dto)
+		if err != nil {
+			return nil, err
+		}
+		couriers = append(couriers, c)
+	}
+
+	return couriers, nil
+}
+
+// GetAllOffShift retrieves all couriers without a running shift who are neither deactivated
+// nor absent. Couriers whose vehicle is in maintenance are included; they may switch vehicles.
+func (r *GormCourierRepository) GetAllOffShift(ctx context.Context) ([]*courier.Courier, error) {
+	now := time.Now().UTC()
+
+	var dtos []CourierDTO
+	if err := r.db.WithContext(ctx).
+		Preload("StoragePlaces").Preload("MaintenanceWindows").Preload("Absences").Preload("ScheduleWindows").
+		Where("shift_started_at IS NULL").
+		Where("deactivation_reason = ?", int(courier.NotDeactivated)).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM courier_absences a
+			WHERE a.courier_id = couriers.id AND a.starts_at <= ? AND a.ends_at > ?
+		)`, now, now).
+		Order("id").
+		Find(&dtos).Error; err != nil {
+		return nil, err
+	}
+
+	couriers := make([]*courier.Courier, 0, len(dtos))
+	for _, dto := range dtos {
+		c, err := toDomain(dto)
+		if err != nil {
+			return nil, err
+		}
+		couriers = append(couriers, c)
+	}
+
+	return couriers, nil
+}
+
+// GetAllSubstitutedBy retrieves all couriers with an absence that is not over yet and names
+// the given courier as the substitute.
+func (r *GormCourierRepository) GetAllSubstitutedBy(
+	ctx context.Context,
+	substituteID kernel.UUID,
+) ([]*courier.Courier, error) {
+	var dtos []CourierDTO
+	if err := r.db.WithContext(ctx).
+		Preload("StoragePlaces").Preload("MaintenanceWindows").Preload("Absences").Preload("ScheduleWindows").
+		Where(`EXISTS (
+			SELECT 1 FROM courier_absences a
+			WHERE a.courier_id = couriers.id AND a.substitute_id = ? AND a.ends_at > ?
+		)`, substituteID.Bytes(), time.Now().UTC()).
+		Order("id").
 		Find(&dtos).Error; err != nil {
 		return nil, err
 	}
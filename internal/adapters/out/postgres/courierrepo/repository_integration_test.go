@@ -12,13 +12,11 @@ import (
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
 	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/i18n"
+	"delivery/internal/pkg/pgtest"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
-	"github.com/testcontainers/testcontainers-go"
-	"github.com/testcontainers/testcontainers-go/modules/postgres"
-	"github.com/testcontainers/testcontainers-go/wait"
-	postgresdriver "gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
@@ -35,7 +33,7 @@ func (m *MockAggregateTracker) TrackAggregate(id kernel.UUID, aggregate any) {
 // using PostgreSQL containers to verify database persistence behavior.
 type CourierRepositoryIntegrationTestSuite struct {
 	suite.Suite
-	container         *postgres.PostgresContainer
+	template          *pgtest.Template
 	db                *gorm.DB
 	courierRepository *courierrepo.GormCourierRepository
 	orderRepository   *orderrepo.GormOrderRepository
@@ -43,42 +41,26 @@ type CourierRepositoryIntegrationTestSuite struct {
 }
 
 func (suite *CourierRepositoryIntegrationTestSuite) SetupSuite() {
-	ctx := context.Background()
-
-	// Start PostgreSQL container
-	container, err := postgres.Run(ctx,
-		"postgres:15-alpine",
-		postgres.WithDatabase("testdb"),
-		postgres.WithUsername("testuser"),
-		postgres.WithPassword("testpass"),
-		testcontainers.WithWaitStrategy(
-			wait.ForLog("database system is ready to accept connections").
-				WithOccurrence(2).
-				WithStartupTimeout(30*time.Second),
-		),
-	)
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&courierrepo.CourierDTO{},
+			&courierrepo.StoragePlaceDTO{},
+			&courierrepo.MaintenanceWindowDTO{},
+			&courierrepo.AbsenceDTO{},
+			&courierrepo.ScheduleWindowDTO{},
+			&orderrepo.OrderDTO{},
+			&orderrepo.OrderMessageDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{},
+			&orderrepo.OrderItemDTO{},
+		)
+	})
 	suite.Require().NoError(err)
-	suite.container = container
-
-	// Get connection string and connect to database
-	connStr, err := container.ConnectionString(ctx, "sslmode=disable")
-	suite.Require().NoError(err)
-
-	db, err := gorm.Open(postgresdriver.Open(connStr), &gorm.Config{})
-	suite.Require().NoError(err)
-	suite.db = db
-
-	// Auto-migrate the schema
-	suite.Require().NoError(db.AutoMigrate(
-		&courierrepo.CourierDTO{},
-		&courierrepo.StoragePlaceDTO{},
-		&orderrepo.OrderDTO{},
-	))
+	suite.template = template
 }
 
 func (suite *CourierRepositoryIntegrationTestSuite) SetupTest() {
-	// Clean the database before each test
-	suite.Require().NoError(suite.db.Exec("TRUNCATE TABLE storage_places, couriers, orders").Error)
+	// Every test works in a fresh clone of the migrated database
+	suite.db = suite.template.NewDatabase(suite.T())
 
 	// Create fresh repositories and tracker for each test
 	suite.tracker = new(MockAggregateTracker)
@@ -87,8 +69,8 @@ func (suite *CourierRepositoryIntegrationTestSuite) SetupTest() {
 }
 
 func (suite *CourierRepositoryIntegrationTestSuite) TearDownSuite() {
-	if suite.container != nil {
-		suite.Require().NoError(suite.container.Terminate(context.Background()))
+	if suite.template != nil {
+		suite.Require().NoError(suite.template.Terminate(context.Background()))
 	}
 }
 
@@ -326,12 +308,38 @@ func (suite *CourierRepositoryIntegrationTestSuite) TestUpdate_NonExistentCourie
 	suite.tracker.AssertExpectations(suite.T())
 }
 
+func (suite *CourierRepositoryIntegrationTestSuite) TestUpdate_StaleCourier_ReturnsConcurrencyConflict() {
+	ctx := context.Background()
+	testCourier := suite.createTestCourier()
+	suite.tracker.On("TrackAggregate", mock.Anything, mock.Anything)
+	suite.Require().NoError(suite.courierRepository.Add(ctx, testCourier))
+
+	first, err := suite.courierRepository.Get(ctx, testCourier.ID())
+	suite.Require().NoError(err)
+	second, err := suite.courierRepository.Get(ctx, testCourier.ID())
+	suite.Require().NoError(err)
+
+	first.Insure()
+	suite.Require().NoError(suite.courierRepository.Update(ctx, first))
+	suite.Equal(1, first.Version())
+
+	second.FlagForReview()
+	err = suite.courierRepository.Update(ctx, second)
+
+	suite.Require().ErrorIs(err, errs.ErrConcurrencyConflict)
+	stored, err := suite.courierRepository.Get(ctx, testCourier.ID())
+	suite.Require().NoError(err)
+	suite.Equal(1, stored.Version())
+	suite.True(stored.IsInsured())
+	suite.False(stored.IsReviewRequired())
+}
+
 func (suite *CourierRepositoryIntegrationTestSuite) TestGetAllFree_NoCouriersAssigned_ReturnsAllCouriers() {
 	ctx := context.Background()
 
 	// Create and add multiple couriers
-	courier1 := suite.createTestCourier()
-	courier2 := suite.createTestCourierWithName("Courier 2")
+	courier1 := suite.createOnShiftCourierWithName("Test Courier")
+	courier2 := suite.createOnShiftCourierWithName("Courier 2")
 
 	// Set expectations for both couriers
 	suite.tracker.On("TrackAggregate", courier1.ID(), courier1).Once()
@@ -354,12 +362,579 @@ func (suite *CourierRepositoryIntegrationTestSuite) TestGetAllFree_NoCouriersAss
 	suite.tracker.AssertExpectations(suite.T())
 }
 
+func (suite *CourierRepositoryIntegrationTestSuite) TestGetAllFree_OnBreakOrFlaggedCouriers_AreExcluded() {
+	ctx := context.Background()
+
+	activeCourier := suite.createOnShiftCourierWithName("Active Courier")
+	onBreakCourier := suite.createOnShiftCourierWithName("On Break Courier")
+	suite.Require().NoError(onBreakCourier.StartBreak())
+	flaggedCourier := suite.createOnShiftCourierWithName("Flagged Courier")
+	flaggedCourier.FlagForReview()
+
+	for _, c := range []*courier.Courier{activeCourier, onBreakCourier, flaggedCourier} {
+		suite.tracker.On("TrackAggregate", c.ID(), c).Once()
+		suite.Require().NoError(suite.courierRepository.Add(ctx, c))
+	}
+
+	freeCouriers, err := suite.courierRepository.GetAllFree(ctx)
+	suite.Require().NoError(err)
+
+	suite.Len(freeCouriers, 1)
+	suite.Equal(activeCourier.ID(), freeCouriers[0].ID())
+
+	// Flags survive a round trip through the database
+	restored, err := suite.courierRepository.Get(ctx, flaggedCourier.ID())
+	suite.Require().NoError(err)
+	suite.True(restored.IsReviewRequired())
+	suite.False(restored.IsOnBreak())
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *CourierRepositoryIntegrationTestSuite) TestGet_LegacyPausedCourier_IsOnBreak() {
+	ctx := context.Background()
+
+	legacyCourier := suite.createOnShiftCourierWithName("Legacy Paused Courier")
+	suite.tracker.On("TrackAggregate", legacyCourier.ID(), mock.Anything).Twice()
+	suite.Require().NoError(suite.courierRepository.Add(ctx, legacyCourier))
+	// Rows written before breaks became a status carry the paused flag instead
+	suite.Require().NoError(suite.db.Model(&courierrepo.CourierDTO{}).
+		Where("id = ?", legacyCourier.ID().Bytes()).
+		Update("paused", true).Error)
+
+	freeCouriers, err := suite.courierRepository.GetAllFree(ctx)
+	suite.Require().NoError(err)
+	suite.Empty(freeCouriers)
+
+	restored, err := suite.courierRepository.Get(ctx, legacyCourier.ID())
+	suite.Require().NoError(err)
+	suite.True(restored.IsOnBreak())
+
+	// Saving the courier moves the break into the status
+	suite.Require().NoError(suite.courierRepository.Update(ctx, restored))
+	var dto courierrepo.CourierDTO
+	suite.Require().NoError(suite.db.First(&dto, "id = ?", legacyCourier.ID().Bytes()).Error)
+	suite.False(dto.Paused)
+	suite.Require().NotNil(dto.Status)
+	suite.Equal(int(courier.OnBreak), *dto.Status)
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *CourierRepositoryIntegrationTestSuite) TestGetAllFree_DeactivatedCouriers_AreExcluded() {
+	ctx := context.Background()
+
+	activeCourier := suite.createOnShiftCourierWithName("Active Courier")
+	offboardedCourier := suite.createOnShiftCourierWithName("Offboarded Courier")
+	_, err := offboardedCourier.Deactivate(courier.Offboarded)
+	suite.Require().NoError(err)
+
+	for _, c := range []*courier.Courier{activeCourier, offboardedCourier} {
+		suite.tracker.On("TrackAggregate", c.ID(), c).Once()
+		suite.Require().NoError(suite.courierRepository.Add(ctx, c))
+	}
+
+	freeCouriers, err := suite.courierRepository.GetAllFree(ctx)
+	suite.Require().NoError(err)
+
+	suite.Len(freeCouriers, 1)
+	suite.Equal(activeCourier.ID(), freeCouriers[0].ID())
+
+	restored, err := suite.courierRepository.Get(ctx, offboardedCourier.ID())
+	suite.Require().NoError(err)
+	suite.Equal(courier.Offboarded, restored.DeactivationReason())
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *CourierRepositoryIntegrationTestSuite) TestGetAllFree_UnavailableCouriers_AreExcluded() {
+	ctx := context.Background()
+
+	availableCourier := suite.createOnShiftCourierWithName("Available Courier")
+	offShiftCourier := suite.createTestCourierWithName("Off Shift Courier")
+	onBreakCourier := suite.createOnShiftCourierWithName("On Break Courier")
+	suite.Require().NoError(onBreakCourier.StartBreak())
+	busyCourier := suite.createOnShiftCourierWithName("Busy Courier")
+	suite.Require().NoError(busyCourier.TakeOrder(suite.createTestOrderWithStatus(ctx, kernel.UUID{}, order.Created)))
+
+	for _, c := range []*courier.Courier{availableCourier, offShiftCourier, onBreakCourier, busyCourier} {
+		suite.tracker.On("TrackAggregate", c.ID(), c).Once()
+		suite.Require().NoError(suite.courierRepository.Add(ctx, c))
+	}
+
+	freeCouriers, err := suite.courierRepository.GetAllFree(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Len(freeCouriers, 1)
+	suite.Equal(availableCourier.ID(), freeCouriers[0].ID())
+
+	restored, err := suite.courierRepository.Get(ctx, onBreakCourier.ID())
+	suite.Require().NoError(err)
+	suite.Equal(courier.OnBreak, restored.Status())
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *CourierRepositoryIntegrationTestSuite) TestGetAllWithFreeStorage_IncludesCarryingCouriersWithRoom() {
+	ctx := context.Background()
+
+	availableCourier := suite.createOnShiftCourierWithName("Available Courier")
+	carryingCourier := suite.createOnShiftCourierWithName("Carrying Courier")
+	suite.Require().NoError(carryingCourier.AddStoragePlace("Trunk", 20))
+	suite.Require().NoError(carryingCourier.TakeOrder(suite.createTestOrderWithStatus(ctx, kernel.UUID{}, order.Created)))
+	fullCourier := suite.createOnShiftCourierWithName("Full Courier")
+	suite.Require().NoError(fullCourier.TakeOrder(suite.createTestOrderWithStatus(ctx, kernel.UUID{}, order.Created)))
+	onBreakCourier := suite.createOnShiftCourierWithName("On Break Courier")
+	suite.Require().NoError(onBreakCourier.StartBreak())
+
+	for _, c := range []*courier.Courier{availableCourier, carryingCourier, fullCourier, onBreakCourier} {
+		suite.tracker.On("TrackAggregate", c.ID(), c).Once()
+		suite.Require().NoError(suite.courierRepository.Add(ctx, c))
+	}
+
+	couriers, err := suite.courierRepository.GetAllWithFreeStorage(ctx)
+	suite.Require().NoError(err)
+
+	ids := make([]kernel.UUID, 0, len(couriers))
+	for _, c := range couriers {
+		ids = append(ids, c.ID())
+	}
+	suite.ElementsMatch([]kernel.UUID{availableCourier.ID(), carryingCourier.ID()}, ids)
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *CourierRepositoryIntegrationTestSuite) TestGetAllFree_CourierWithoutStatus_DerivesItFromShift() {
+	ctx := context.Background()
+
+	onShiftCourier := suite.createOnShiftCourierWithName("Legacy On Shift Courier")
+	offShiftCourier := suite.createTestCourierWithName("Legacy Off Shift Courier")
+	for _, c := range []*courier.Courier{onShiftCourier, offShiftCourier} {
+		suite.tracker.On("TrackAggregate", c.ID(), c).Once()
+		suite.Require().NoError(suite.courierRepository.Add(ctx, c))
+	}
+	suite.Require().NoError(suite.db.Exec("UPDATE couriers SET status = NULL").Error)
+
+	freeCouriers, err := suite.courierRepository.GetAllFree(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Len(freeCouriers, 1)
+	suite.Equal(onShiftCourier.ID(), freeCouriers[0].ID())
+	suite.Equal(courier.Available, freeCouriers[0].Status())
+
+	restored, err := suite.courierRepository.Get(ctx, offShiftCourier.ID())
+	suite.Require().NoError(err)
+	suite.Equal(courier.Offline, restored.Status())
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *CourierRepositoryIntegrationTestSuite) TestGetAllOnShift_ReturnsCouriersWithRunningShift() {
+	ctx := context.Background()
+	limit, err := courier.NewWorkingHoursLimit(8*time.Hour, 30*time.Minute)
+	suite.Require().NoError(err)
+	now := time.Now()
+
+	onShift := suite.createTestCourierWithName("On Shift Courier")
+	suite.Require().NoError(onShift.StartShift(now, limit))
+	onBreak := suite.createTestCourierWithName("On Break Courier")
+	suite.Require().NoError(onBreak.StartShift(now, limit))
+	suite.Require().NoError(onBreak.StartBreak())
+	offShift := suite.createTestCourierWithName("Off Shift Courier")
+
+	for _, c := range []*courier.Courier{onShift, onBreak, offShift} {
+		suite.tracker.On("TrackAggregate", c.ID(), c).Once()
+		suite.Require().NoError(suite.courierRepository.Add(ctx, c))
+	}
+
+	couriers, err := suite.courierRepository.GetAllOnShift(ctx)
+	suite.Require().NoError(err)
+
+	ids := make([]kernel.UUID, 0, len(couriers))
+	for _, c := range couriers {
+		ids = append(ids, c.ID())
+	}
+	suite.ElementsMatch([]kernel.UUID{onShift.ID(), onBreak.ID()}, ids)
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *CourierRepositoryIntegrationTestSuite) TestGetAllOffShift_ReturnsCouriersWhoCouldStartShift() {
+	ctx := context.Background()
+	limit, err := courier.NewWorkingHoursLimit(8*time.Hour, 30*time.Minute)
+	suite.Require().NoError(err)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	offShift := suite.createTestCourierWithName("Off Shift Courier")
+	onShift := suite.createTestCourierWithName("On Shift Courier")
+	suite.Require().NoError(onShift.StartShift(now, limit))
+	deactivated := suite.createTestCourierWithName("Deactivated Courier")
+	_, err = deactivated.Deactivate(courier.Offboarded)
+	suite.Require().NoError(err)
+	absent := suite.createTestCourierWithName("Absent Courier")
+	absence, err := courier.NewAbsence(kernel.NewUUID(), now.Add(-time.Hour), now.Add(time.Hour), offShift.ID())
+	suite.Require().NoError(err)
+	suite.Require().NoError(absent.PlanAbsence(absence, now))
+
+	for _, c := range []*courier.Courier{offShift, onShift, deactivated, absent} {
+		suite.tracker.On("TrackAggregate", c.ID(), c).Once()
+		suite.Require().NoError(suite.courierRepository.Add(ctx, c))
+	}
+
+	couriers, err := suite.courierRepository.GetAllOffShift(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Len(couriers, 1)
+	suite.Equal(offShift.ID(), couriers[0].ID())
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *CourierRepositoryIntegrationTestSuite) TestGet_CourierLanguage_IsRestored() {
+	ctx := context.Background()
+
+	location, err := kernel.NewLocation(3, 3)
+	suite.Require().NoError(err)
+	englishCourier, err := courier.NewCourierWithLanguage(kernel.NewUUID(), "English Courier", 2, location, i18n.English)
+	suite.Require().NoError(err)
+
+	suite.tracker.On("TrackAggregate", englishCourier.ID(), englishCourier).Once()
+	suite.Require().NoError(suite.courierRepository.Add(ctx, englishCourier))
+
+	restored, err := suite.courierRepository.Get(ctx, englishCourier.ID())
+	suite.Require().NoError(err)
+	suite.Equal(i18n.English, restored.Language())
+	suite.Equal("Bag", restored.StoragePlaces()[0].Name())
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *CourierRepositoryIntegrationTestSuite) TestUpdate_CourierProfile_PersistedAndCleared() {
+	ctx := context.Background()
+
+	location, err := kernel.NewLocation(3, 3)
+	suite.Require().NoError(err)
+	c, err := courier.NewCourier(kernel.NewUUID(), "Profile Courier", 2, location)
+	suite.Require().NoError(err)
+	profile, err := courier.NewProfile("https://cdn.example.com/42.jpg", "+79123456789", "A123BC77")
+	suite.Require().NoError(err)
+	c.ChangeProfile(profile)
+
+	suite.tracker.On("TrackAggregate", c.ID(), c).Times(2)
+	suite.Require().NoError(suite.courierRepository.Add(ctx, c))
+
+	restored, err := suite.courierRepository.Get(ctx, c.ID())
+	suite.Require().NoError(err)
+	suite.Equal(profile, restored.Profile())
+
+	c.ChangeProfile(courier.Profile{})
+	suite.Require().NoError(suite.courierRepository.Update(ctx, c))
+
+	restored, err = suite.courierRepository.Get(ctx, c.ID())
+	suite.Require().NoError(err)
+	suite.True(restored.Profile().IsEmpty())
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *CourierRepositoryIntegrationTestSuite) TestAdd_VehicleTypeAndThermalStorage_Persisted() {
+	ctx := context.Background()
+
+	location, err := kernel.NewLocation(3, 4)
+	suite.Require().NoError(err)
+	c, err := courier.NewCourier(kernel.NewUUID(), "Thermal Courier", 2, location)
+	suite.Require().NoError(err)
+	profile, err := courier.Profile{}.WithVehicleType(courier.Scooter)
+	suite.Require().NoError(err)
+	c.ChangeProfile(profile)
+	suite.Require().NoError(c.AddThermalStoragePlace("Thermal bag", 20))
+
+	suite.tracker.On("TrackAggregate", c.ID(), c).Once()
+	suite.Require().NoError(suite.courierRepository.Add(ctx, c))
+
+	restored, err := suite.courierRepository.Get(ctx, c.ID())
+	suite.Require().NoError(err)
+	suite.Require().NotNil(restored.Profile().VehicleType())
+	suite.Equal(courier.Scooter, *restored.Profile().VehicleType())
+	for _, place := range restored.StoragePlaces() {
+		suite.Equal(place.Name() == "Thermal bag", place.IsThermal(), place.Name())
+	}
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *CourierRepositoryIntegrationTestSuite) TestUpdate_CourierWorkLog_Persisted() {
+	ctx := context.Background()
+
+	location, err := kernel.NewLocation(4, 4)
+	suite.Require().NoError(err)
+	c, err := courier.NewCourier(kernel.NewUUID(), "Shift Courier", 2, location)
+	suite.Require().NoError(err)
+	limit, err := courier.NewWorkingHoursLimit(8*time.Hour, 30*time.Minute)
+	suite.Require().NoError(err)
+
+	morning := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+	suite.Require().NoError(c.StartShift(morning, limit))
+	suite.Require().NoError(c.EndShift(morning.Add(3 * time.Hour)))
+	suite.Require().NoError(c.StartShift(morning.Add(4*time.Hour), limit))
+
+	suite.tracker.On("TrackAggregate", c.ID(), c).Once()
+	suite.Require().NoError(suite.courierRepository.Add(ctx, c))
+
+	restored, err := suite.courierRepository.Get(ctx, c.ID())
+	suite.Require().NoError(err)
+	suite.True(restored.WorkLog().IsOnShift())
+	suite.Equal(3*time.Hour, restored.WorkLog().Completed())
+	suite.Equal(4*time.Hour, restored.WorkLog().WorkedOn(morning.Add(5*time.Hour)))
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *CourierRepositoryIntegrationTestSuite) TestUpdate_CourierMaintenanceWindows_PersistedAndCancelled() {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	c := suite.createOnShiftCourierWithName("Maintenance Courier")
+	active, err := courier.NewMaintenanceWindow(kernel.NewUUID(), now.Add(-time.Hour), now.Add(time.Hour))
+	suite.Require().NoError(err)
+	upcoming, err := courier.NewMaintenanceWindow(kernel.NewUUID(), now.Add(2*time.Hour), now.Add(3*time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(c.ScheduleMaintenance(upcoming, now))
+	suite.Require().NoError(c.ScheduleMaintenance(active, now))
+
+	suite.tracker.On("TrackAggregate", c.ID(), c).Times(2)
+	suite.Require().NoError(suite.courierRepository.Add(ctx, c))
+
+	restored, err := suite.courierRepository.Get(ctx, c.ID())
+	suite.Require().NoError(err)
+	suite.Require().Len(restored.MaintenanceWindows(), 2)
+	suite.True(restored.MaintenanceWindows()[0].StartsAt().Equal(active.StartsAt()))
+	suite.True(restored.IsUnderMaintenance(now))
+
+	free, err := suite.courierRepository.GetAllFree(ctx)
+	suite.Require().NoError(err)
+	suite.Empty(free)
+
+	suite.Require().NoError(c.CancelMaintenance(active.ID()))
+	suite.Require().NoError(suite.courierRepository.Update(ctx, c))
+
+	restored, err = suite.courierRepository.Get(ctx, c.ID())
+	suite.Require().NoError(err)
+	suite.Require().Len(restored.MaintenanceWindows(), 1)
+	suite.Equal(upcoming.ID(), restored.MaintenanceWindows()[0].ID())
+
+	free, err = suite.courierRepository.GetAllFree(ctx)
+	suite.Require().NoError(err)
+	suite.Len(free, 1)
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *CourierRepositoryIntegrationTestSuite) TestUpdate_CourierSchedule_PersistedReplacedAndCleared() {
+	ctx := context.Background()
+
+	c := suite.createTestCourierWithName("Scheduled Courier")
+	mornings, err := courier.NewScheduleWindow(time.Monday, 8*time.Hour, 12*time.Hour)
+	suite.Require().NoError(err)
+	nights, err := courier.NewScheduleWindow(time.Friday, 22*time.Hour, 24*time.Hour)
+	suite.Require().NoError(err)
+	schedule, err := courier.NewSchedule(kernel.NewUUID(), []courier.ScheduleWindow{nights, mornings})
+	suite.Require().NoError(err)
+	suite.Require().NoError(c.SetSchedule(schedule))
+
+	suite.tracker.On("TrackAggregate", c.ID(), c).Times(3)
+	suite.Require().NoError(suite.courierRepository.Add(ctx, c))
+
+	restored, err := suite.courierRepository.Get(ctx, c.ID())
+	suite.Require().NoError(err)
+	suite.Require().NotNil(restored.Schedule())
+	suite.Equal(schedule.ID(), restored.Schedule().ID())
+	suite.Equal([]courier.ScheduleWindow{mornings, nights}, restored.Schedule().Windows())
+
+	// Replacing the schedule drops the windows of the previous one
+	evenings, err := courier.NewScheduleWindow(time.Monday, 18*time.Hour, 22*time.Hour)
+	suite.Require().NoError(err)
+	replacement, err := courier.NewSchedule(kernel.NewUUID(), []courier.ScheduleWindow{evenings})
+	suite.Require().NoError(err)
+	suite.Require().NoError(c.SetSchedule(replacement))
+	suite.Require().NoError(suite.courierRepository.Update(ctx, c))
+
+	restored, err = suite.courierRepository.Get(ctx, c.ID())
+	suite.Require().NoError(err)
+	suite.Require().NotNil(restored.Schedule())
+	suite.Equal(replacement.ID(), restored.Schedule().ID())
+	suite.Equal([]courier.ScheduleWindow{evenings}, restored.Schedule().Windows())
+
+	c.ClearSchedule()
+	suite.Require().NoError(suite.courierRepository.Update(ctx, c))
+
+	restored, err = suite.courierRepository.Get(ctx, c.ID())
+	suite.Require().NoError(err)
+	suite.Nil(restored.Schedule())
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *CourierRepositoryIntegrationTestSuite) TestUpdate_CourierStoragePlaces_ResizedAndRemoved() {
+	ctx := context.Background()
+
+	c := suite.createOnShiftCourierWithName("Storage Courier")
+	suite.Require().NoError(c.AddStoragePlace("Backpack", 20))
+	bag := c.StoragePlaces()[0]
+	backpack := c.StoragePlaces()[1]
+
+	suite.tracker.On("TrackAggregate", c.ID(), c).Times(2)
+	suite.Require().NoError(suite.courierRepository.Add(ctx, c))
+
+	suite.Require().NoError(c.RemoveStoragePlace(bag.ID()))
+	suite.Require().NoError(c.ResizeStoragePlace(backpack.ID(), 25))
+	suite.Require().NoError(suite.courierRepository.Update(ctx, c))
+
+	restored, err := suite.courierRepository.Get(ctx, c.ID())
+	suite.Require().NoError(err)
+	suite.Require().Len(restored.StoragePlaces(), 1)
+	suite.Equal(backpack.ID(), restored.StoragePlaces()[0].ID())
+	suite.Equal(25, restored.StoragePlaces()[0].TotalVolume())
+
+	var orphans int64
+	suite.Require().NoError(suite.db.Model(&courierrepo.StoragePlaceDTO{}).
+		Where("id = ?", bag.ID().Bytes()).Count(&orphans).Error)
+	suite.Zero(orphans)
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *CourierRepositoryIntegrationTestSuite) TestUpdate_CourierAbsences_PersistedAndCancelled() {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	c := suite.createOnShiftCourierWithName("Absent Courier")
+	substitute := suite.createOnShiftCourierWithName("Substitute Courier")
+	active, err := courier.NewAbsence(kernel.NewUUID(), now.Add(-time.Hour), now.Add(time.Hour), substitute.ID())
+	suite.Require().NoError(err)
+	upcoming, err := courier.NewAbsence(kernel.NewUUID(), now.Add(24*time.Hour), now.Add(48*time.Hour), substitute.ID())
+	suite.Require().NoError(err)
+	suite.Require().NoError(c.PlanAbsence(upcoming, now))
+	suite.Require().NoError(c.PlanAbsence(active, now))
+
+	suite.tracker.On("TrackAggregate", c.ID(), c).Times(2)
+	suite.tracker.On("TrackAggregate", substitute.ID(), substitute).Once()
+	suite.Require().NoError(suite.courierRepository.Add(ctx, c))
+	suite.Require().NoError(suite.courierRepository.Add(ctx, substitute))
+
+	restored, err := suite.courierRepository.Get(ctx, c.ID())
+	suite.Require().NoError(err)
+	suite.Require().Len(restored.Absences(), 2)
+	suite.Equal(active.ID(), restored.Absences()[0].ID())
+	suite.Equal(substitute.ID(), restored.Absences()[0].SubstituteID())
+	suite.True(restored.IsAbsent(now))
+
+	free, err := suite.courierRepository.GetAllFree(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(free, 1)
+	suite.Equal(substitute.ID(), free[0].ID())
+
+	substituted, err := suite.courierRepository.GetAllSubstitutedBy(ctx, substitute.ID())
+	suite.Require().NoError(err)
+	suite.Require().Len(substituted, 1)
+	suite.Equal(c.ID(), substituted[0].ID())
+
+	suite.Require().NoError(c.CancelAbsence(active.ID()))
+	suite.Require().NoError(suite.courierRepository.Update(ctx, c))
+
+	restored, err = suite.courierRepository.Get(ctx, c.ID())
+	suite.Require().NoError(err)
+	suite.Require().Len(restored.Absences(), 1)
+	suite.Equal(upcoming.ID(), restored.Absences()[0].ID())
+
+	free, err = suite.courierRepository.GetAllFree(ctx)
+	suite.Require().NoError(err)
+	suite.Len(free, 2)
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *CourierRepositoryIntegrationTestSuite) TestGetByExternalID_ReturnsLinkedCourier() {
+	ctx := context.Background()
+
+	externalID, err := courier.NewExternalID("HR-004217")
+	suite.Require().NoError(err)
+	linked := suite.createTestCourierWithName("Linked Courier")
+	suite.Require().NoError(linked.LinkExternalID(externalID))
+	unlinked := suite.createTestCourierWithName("Unlinked Courier")
+
+	suite.tracker.On("TrackAggregate", linked.ID(), linked).Once()
+	suite.tracker.On("TrackAggregate", unlinked.ID(), unlinked).Once()
+	suite.Require().NoError(suite.courierRepository.Add(ctx, linked))
+	suite.Require().NoError(suite.courierRepository.Add(ctx, unlinked))
+
+	restored, err := suite.courierRepository.GetByExternalID(ctx, externalID)
+	suite.Require().NoError(err)
+	suite.Equal(linked.ID(), restored.ID())
+	suite.Require().NotNil(restored.ExternalID())
+	suite.Equal("HR-004217", restored.ExternalID().String())
+
+	unknown, err := courier.NewExternalID("HR-000001")
+	suite.Require().NoError(err)
+	_, err = suite.courierRepository.GetByExternalID(ctx, unknown)
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *CourierRepositoryIntegrationTestSuite) TestGetByIDs_ReturnsKnownCouriersOrderedByID() {
+	ctx := context.Background()
+
+	first := suite.createTestCourierWithName("First Courier")
+	second := suite.createTestCourierWithName("Second Courier")
+	other := suite.createTestCourierWithName("Other Courier")
+	suite.tracker.On("TrackAggregate", mock.Anything, mock.Anything)
+	for _, c := range []*courier.Courier{first, second, other} {
+		suite.Require().NoError(suite.courierRepository.Add(ctx, c))
+	}
+
+	couriers, err := suite.courierRepository.GetByIDs(ctx, []kernel.UUID{second.ID(), kernel.NewUUID(), first.ID()})
+	suite.Require().NoError(err)
+	suite.Require().Len(couriers, 2)
+
+	expected := []kernel.UUID{first.ID(), second.ID()}
+	if first.ID().String() > second.ID().String() {
+		expected = []kernel.UUID{second.ID(), first.ID()}
+	}
+	suite.Equal(expected, []kernel.UUID{couriers[0].ID(), couriers[1].ID()})
+	suite.Len(couriers[0].StoragePlaces(), len(first.StoragePlaces()))
+
+	none, err := suite.courierRepository.GetByIDs(ctx, nil)
+	suite.Require().NoError(err)
+	suite.Empty(none)
+}
+
+func (suite *CourierRepositoryIntegrationTestSuite) TestAdd_DuplicateExternalID_ReturnsAlreadyRegistered() {
+	ctx := context.Background()
+
+	externalID, err := courier.NewExternalID("HR-004217")
+	suite.Require().NoError(err)
+	first := suite.createTestCourierWithName("First Courier")
+	suite.Require().NoError(first.LinkExternalID(externalID))
+	duplicate := suite.createTestCourierWithName("Duplicate Courier")
+	suite.Require().NoError(duplicate.LinkExternalID(externalID))
+
+	suite.tracker.On("TrackAggregate", first.ID(), first).Once()
+	suite.Require().NoError(suite.courierRepository.Add(ctx, first))
+
+	err = suite.courierRepository.Add(ctx, duplicate)
+	suite.Require().ErrorIs(err, courier.ErrExternalIDIsAlreadyRegistered)
+	suite.assertCourierCount(1)
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
 func (suite *CourierRepositoryIntegrationTestSuite) TestGetAllFree_SomeCouriersAssigned_ReturnsOnlyFreeCouriers() {
 	ctx := context.Background()
 
 	// Create and add multiple couriers
-	freeCourier := suite.createTestCourierWithName("Free Courier")
-	assignedCourier := suite.createTestCourierWithName("Assigned Courier")
+	freeCourier := suite.createOnShiftCourierWithName("Free Courier")
+	assignedCourier := suite.createOnShiftCourierWithName("Assigned Courier")
 
 	// Set expectations for both couriers
 	suite.tracker.On("TrackAggregate", freeCourier.ID(), freeCourier).Once()
@@ -397,7 +972,7 @@ func (suite *CourierRepositoryIntegrationTestSuite) TestGetAllFree_AllCouriersAs
 	ctx := context.Background()
 
 	// Create and add courier
-	assignedCourier := suite.createTestCourierWithName("Assigned Courier")
+	assignedCourier := suite.createOnShiftCourierWithName("Assigned Courier")
 
 	// Set expectations for courier
 	suite.tracker.On("TrackAggregate", assignedCourier.ID(), assignedCourier).Once()
@@ -429,7 +1004,7 @@ func (suite *CourierRepositoryIntegrationTestSuite) TestGetAllFree_CourierWithCo
 	ctx := context.Background()
 
 	// Create and add courier
-	courierWithCompletedOrder := suite.createTestCourierWithName("Courier With Completed Order")
+	courierWithCompletedOrder := suite.createOnShiftCourierWithName("Courier With Completed Order")
 
 	// Set expectations for courier
 	suite.tracker.On("TrackAggregate", courierWithCompletedOrder.ID(), courierWithCompletedOrder).Once()
@@ -463,7 +1038,7 @@ func (suite *CourierRepositoryIntegrationTestSuite) TestGetAllFree_CourierWithCr
 	ctx := context.Background()
 
 	// Create and add courier
-	freeCourier := suite.createTestCourierWithName("Free Courier")
+	freeCourier := suite.createOnShiftCourierWithName("Free Courier")
 
 	// Set expectations for courier
 	suite.tracker.On("TrackAggregate", freeCourier.ID(), freeCourier).Once()
@@ -552,15 +1127,9 @@ func (suite *CourierRepositoryIntegrationTestSuite) TestGetAllFree_BusinessScena
 	}
 }
 
-// setupSubtest prepares a clean environment for each subtest.
+// setupSubtest gives each subtest a database, repositories and tracker of its own.
 func (suite *CourierRepositoryIntegrationTestSuite) setupSubtest() {
-	// Clean the database at the start of each subtest to ensure isolation
-	suite.Require().NoError(suite.db.Exec("TRUNCATE TABLE storage_places, couriers, orders").Error)
-
-	// Recreate fresh repositories and tracker for each subtest
-	suite.tracker = new(MockAggregateTracker)
-	suite.courierRepository = courierrepo.NewGormCourierRepository(suite.db, suite.tracker)
-	suite.orderRepository = orderrepo.NewGormOrderRepository(suite.db, suite.tracker)
+	suite.SetupTest()
 }
 
 // createCouriersAndOrders creates couriers and orders based on test case data.
@@ -587,7 +1156,7 @@ func (suite *CourierRepositoryIntegrationTestSuite) createCourierAndOrder(
 	ctx context.Context, courierName string, orderStatus order.Status,
 ) {
 	// Create and add courier
-	testCourier := suite.createTestCourierWithName(courierName)
+	testCourier := suite.createOnShiftCourierWithName(courierName)
 	suite.tracker.On("TrackAggregate", testCourier.ID(), testCourier).Once()
 	err := suite.courierRepository.Add(ctx, testCourier)
 	suite.Require().NoError(err)
@@ -673,6 +1242,17 @@ func (suite *CourierRepositoryIntegrationTestSuite) createTestCourierWithName(na
 	return testCourier
 }
 
+// createOnShiftCourierWithName creates a test courier with specified name who started a shift,
+// so that the courier is Available for dispatch.
+func (suite *CourierRepositoryIntegrationTestSuite) createOnShiftCourierWithName(name string) *courier.Courier {
+	limit, err := courier.NewWorkingHoursLimit(8*time.Hour, 30*time.Minute)
+	suite.Require().NoError(err)
+
+	testCourier := suite.createTestCourierWithName(name)
+	suite.Require().NoError(testCourier.StartShift(time.Now(), limit))
+	return testCourier
+}
+
 // createTestStoragePlaces creates test storage places for courier.
 func (suite *CourierRepositoryIntegrationTestSuite) createTestStoragePlaces() ([]*courier.StoragePlace, error) {
 	sp1ID := kernel.NewUUID()
@@ -842,5 +1422,6 @@ func (suite *CourierRepositoryIntegrationTestSuite) assertStoragePlaceCount(expe
 }
 
 func TestCourierRepositoryIntegrationTestSuite(t *testing.T) {
+	t.Parallel()
 	suite.Run(t, new(CourierRepositoryIntegrationTestSuite))
 }
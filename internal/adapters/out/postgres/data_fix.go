@@ -0,0 +1,278 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrDataFixNameIsRequired is returned when registering a data fix without a name.
+	ErrDataFixNameIsRequired = errors.New("data fix name is required")
+
+	// ErrDataFixIsAlreadyRegistered is returned when two data fixes share the same name.
+	ErrDataFixIsAlreadyRegistered = errors.New("data fix is already registered")
+
+	// ErrDataFixIsAlreadyApplied is returned when applying a fix whose last execution was not reverted.
+	ErrDataFixIsAlreadyApplied = errors.New("data fix is already applied")
+
+	// ErrDataFixIsNotApplied is returned when reverting a fix that has no active execution.
+	ErrDataFixIsNotApplied = errors.New("data fix is not applied")
+
+	// errDataFixDryRun rolls back the transaction of a dry run.
+	errDataFixDryRun = errors.New("data fix dry run")
+)
+
+// DataFix is a one-off, reversible correction of persisted data.
+// Implementations operate directly on the transaction they are given and must not
+// commit or roll it back; the DataFixRunner owns the transaction lifecycle.
+//
+// Example:
+//
+//	type clearReviewFlags struct{}
+//
+//	func (clearReviewFlags) Name() string        { return "clear-review-flags" }
+//	func (clearReviewFlags) Description() string { return "Clear the review flag of all couriers" }
+//
+//	func (clearReviewFlags) Apply(ctx context.Context, tx *gorm.DB) (DataFixResult, error) {
+//	    // select affected ids, update them and return the ids as undo data
+//	}
+//
+//	func (clearReviewFlags) Revert(ctx context.Context, tx *gorm.DB, undo []byte) (int64, error) {
+//	    // flag the couriers listed in undo again
+//	}
+type DataFix interface {
+	// Name uniquely identifies the fix on the command line and in the data_fixes table.
+	Name() string
+
+	// Description explains what the fix changes.
+	Description() string
+
+	// Apply performs the fix and returns the affected rows together with the data
+	// needed to revert it.
+	Apply(ctx context.Context, tx *gorm.DB) (DataFixResult, error)
+
+	// Revert undoes a previous Apply using the undo data it returned and reports
+	// the number of restored rows.
+	Revert(ctx context.Context, tx *gorm.DB, undo []byte) (int64, error)
+}
+
+// DataFixResult describes the outcome of DataFix.Apply.
+type DataFixResult struct {
+	// Affected lists human-readable identifiers of the changed rows for the audit log.
+	Affected []string
+
+	// Undo is opaque data persisted with the execution and passed back to Revert.
+	Undo []byte
+}
+
+// DataFixExecutionDTO records a data fix execution in the data_fixes table.
+// A fix counts as applied while its latest execution has no RevertedAt.
+type DataFixExecutionDTO struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Name         string    `gorm:"type:varchar(255);not null;index"`
+	AffectedRows int       `gorm:"not null"`
+	Undo         []byte    `gorm:"type:bytea"`
+	AppliedAt    time.Time `gorm:"not null"`
+	RevertedAt   *time.Time
+}
+
+// TableName specifies the database table name for data fix executions.
+// Overrides GORM's default naming convention to use "data_fixes".
+func (DataFixExecutionDTO) TableName() string {
+	return "data_fixes"
+}
+
+// DataFixReport summarizes a data fix run.
+type DataFixReport struct {
+	Name         string
+	AffectedRows int
+	DryRun       bool
+}
+
+// DataFixRunner applies and reverts registered data fixes inside a unit of work
+// transaction, logs the affected rows and records every real execution in the
+// data_fixes table. Dry runs perform the same work and then roll it back.
+//
+// Example:
+//
+//	runner, err := NewDataFixRunner(factory, logger, NewClearOrphanedStoragePlacesFix())
+//	if err != nil {
+//	    return err
+//	}
+//
+//	report, err := runner.Apply(ctx, "clear-orphaned-storage-places", true)
+//	fmt.Printf("%d rows would change\n", report.AffectedRows)
+type DataFixRunner struct {
+	uowFactory *GormUnitOfWorkFactory
+	logger     *slog.Logger
+	fixes      map[string]DataFix
+}
+
+// NewDataFixRunner creates a runner for the given fixes.
+// Returns an error if a fix has no name or two fixes share a name.
+func NewDataFixRunner(
+	uowFactory *GormUnitOfWorkFactory,
+	logger *slog.Logger,
+	fixes ...DataFix,
+) (*DataFixRunner, error) {
+	runner := &DataFixRunner{
+		uowFactory: uowFactory,
+		logger:     logger,
+		fixes:      make(map[string]DataFix, len(fixes)),
+	}
+
+	for _, fix := range fixes {
+		if fix.Name() == "" {
+			return nil, ErrDataFixNameIsRequired
+		}
+		if _, exists := runner.fixes[fix.Name()]; exists {
+			return nil, fmt.Errorf("%w: %s", ErrDataFixIsAlreadyRegistered, fix.Name())
+		}
+		runner.fixes[fix.Name()] = fix
+	}
+
+	return runner, nil
+}
+
+// Fixes returns the registered fixes sorted by name.
+func (r *DataFixRunner) Fixes() []DataFix {
+	fixes := make([]DataFix, 0, len(r.fixes))
+	for _, fix := range r.fixes {
+		fixes = append(fixes, fix)
+	}
+
+	sort.Slice(fixes, func(i, j int) bool {
+		return fixes[i].Name() < fixes[j].Name()
+	})
+	return fixes
+}
+
+// Apply runs the named fix. With dryRun the changes and the execution record are rolled back.
+// Returns an ObjectNotFoundError for unknown fixes and ErrDataFixIsAlreadyApplied if the
+// previous execution was not reverted.
+func (r *DataFixRunner) Apply(ctx context.Context, name string, dryRun bool) (DataFixReport, error) {
+	fix, ok := r.fixes[name]
+	if !ok {
+		return DataFixReport{}, errs.NewObjectNotFoundError("data fix", name)
+	}
+
+	var report DataFixReport
+	uow := r.uowFactory.create()
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		execution, applied, err := activeExecution(ctx, uow.tx, name)
+		if err != nil {
+			return err
+		}
+		if applied {
+			return fmt.Errorf(
+				"%w: %s at %s", ErrDataFixIsAlreadyApplied, name, execution.AppliedAt.Format(time.RFC3339),
+			)
+		}
+
+		result, err := fix.Apply(ctx, uow.tx)
+		if err != nil {
+			return err
+		}
+
+		r.logger.InfoContext(ctx, "data fix applied",
+			"name", name,
+			"dry_run", dryRun,
+			"affected_rows", len(result.Affected),
+			"affected", result.Affected,
+		)
+
+		report = DataFixReport{Name: name, AffectedRows: len(result.Affected), DryRun: dryRun}
+		if dryRun {
+			return errDataFixDryRun
+		}
+
+		return uow.tx.WithContext(ctx).Create(&DataFixExecutionDTO{
+			ID:           uuid.New(),
+			Name:         name,
+			AffectedRows: len(result.Affected),
+			Undo:         result.Undo,
+			AppliedAt:    time.Now().UTC(),
+		}).Error
+	})
+	if err != nil && !errors.Is(err, errDataFixDryRun) {
+		return DataFixReport{}, err
+	}
+
+	return report, nil
+}
+
+// Revert undoes the latest execution of the named fix. With dryRun nothing is persisted.
+// Returns ErrDataFixIsNotApplied if the fix has no active execution.
+func (r *DataFixRunner) Revert(ctx context.Context, name string, dryRun bool) (DataFixReport, error) {
+	fix, ok := r.fixes[name]
+	if !ok {
+		return DataFixReport{}, errs.NewObjectNotFoundError("data fix", name)
+	}
+
+	var report DataFixReport
+	uow := r.uowFactory.create()
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		execution, applied, err := activeExecution(ctx, uow.tx, name)
+		if err != nil {
+			return err
+		}
+		if !applied {
+			return fmt.Errorf("%w: %s", ErrDataFixIsNotApplied, name)
+		}
+
+		restored, err := fix.Revert(ctx, uow.tx, execution.Undo)
+		if err != nil {
+			return err
+		}
+
+		r.logger.InfoContext(ctx, "data fix reverted",
+			"name", name,
+			"dry_run", dryRun,
+			"execution_id", execution.ID.String(),
+			"restored_rows", restored,
+		)
+
+		report = DataFixReport{Name: name, AffectedRows: int(restored), DryRun: dryRun}
+		if dryRun {
+			return errDataFixDryRun
+		}
+
+		revertedAt := time.Now().UTC()
+		return uow.tx.WithContext(ctx).Model(&execution).Update("reverted_at", &revertedAt).Error
+	})
+	if err != nil && !errors.Is(err, errDataFixDryRun) {
+		return DataFixReport{}, err
+	}
+
+	return report, nil
+}
+
+// activeExecution returns the latest unreverted execution of the fix and whether one exists.
+// The row is locked so concurrent runs of the same fix are serialized.
+func activeExecution(ctx context.Context, tx *gorm.DB, name string) (DataFixExecutionDTO, bool, error) {
+	var executions []DataFixExecutionDTO
+	err := tx.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("name = ? AND reverted_at IS NULL", name).
+		Order("applied_at DESC").
+		Limit(1).
+		Find(&executions).Error
+	if err != nil {
+		return DataFixExecutionDTO{}, false, err
+	}
+
+	if len(executions) == 0 {
+		return DataFixExecutionDTO{}, false, nil
+	}
+	return executions[0], true, nil
+}
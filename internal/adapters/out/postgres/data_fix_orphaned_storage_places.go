@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ClearOrphanedStoragePlacesFix releases storage places that still reference an order
+// which no longer exists or has already been completed or cancelled.
+// The cleared references are kept as undo data so the fix can be reverted.
+type ClearOrphanedStoragePlacesFix struct{}
+
+// NewClearOrphanedStoragePlacesFix creates the orphaned storage place cleanup fix.
+func NewClearOrphanedStoragePlacesFix() ClearOrphanedStoragePlacesFix {
+	return ClearOrphanedStoragePlacesFix{}
+}
+
+// storagePlaceReference is the undo record of a cleared storage place.
+type storagePlaceReference struct {
+	StoragePlaceID uuid.UUID `json:"storage_place_id"`
+	OrderID        uuid.UUID `json:"order_id"`
+}
+
+// Name returns the fix identifier.
+func (ClearOrphanedStoragePlacesFix) Name() string {
+	return "clear-orphaned-storage-places"
+}
+
+// Description explains what the fix changes.
+func (ClearOrphanedStoragePlacesFix) Description() string {
+	return "Clear storage place references to missing, completed or cancelled orders"
+}
+
+// Apply clears the orphaned references and returns them as undo data.
+func (ClearOrphanedStoragePlacesFix) Apply(ctx context.Context, tx *gorm.DB) (DataFixResult, error) {
+	var references []storagePlaceReference
+	err := tx.WithContext(ctx).Raw(`
+		SELECT
+			sp.id AS storage_place_id,
+			sp.order_id AS order_id
+		FROM storage_places sp
+		LEFT JOIN orders o ON o.id = sp.order_id
+		WHERE sp.order_id IS NOT NULL
+		  AND (o.id IS NULL OR o.status IN (?, ?))
+		ORDER BY sp.id
+	`, int(order.Completed), int(order.Cancelled)).Scan(&references).Error
+	if err != nil {
+		return DataFixResult{}, err
+	}
+
+	if len(references) == 0 {
+		return DataFixResult{Affected: []string{}}, nil
+	}
+
+	ids := make([]uuid.UUID, len(references))
+	affected := make([]string, len(references))
+	for i, reference := range references {
+		ids[i] = reference.StoragePlaceID
+		affected[i] = "storage_place " + reference.StoragePlaceID.String() + " -> order " + reference.OrderID.String()
+	}
+
+	err = tx.WithContext(ctx).Exec(`UPDATE storage_places SET order_id = NULL WHERE id IN ?`, ids).Error
+	if err != nil {
+		return DataFixResult{}, err
+	}
+
+	undo, err := json.Marshal(references)
+	if err != nil {
+		return DataFixResult{}, err
+	}
+
+	return DataFixResult{Affected: affected, Undo: undo}, nil
+}
+
+// Revert restores the cleared references to storage places that are still empty.
+// Places that received a new order since the fix was applied are left untouched.
+func (ClearOrphanedStoragePlacesFix) Revert(ctx context.Context, tx *gorm.DB, undo []byte) (int64, error) {
+	if len(undo) == 0 {
+		return 0, nil
+	}
+
+	var references []storagePlaceReference
+	if err := json.Unmarshal(undo, &references); err != nil {
+		return 0, err
+	}
+
+	var restored int64
+	for _, reference := range references {
+		result := tx.WithContext(ctx).Exec(
+			`UPDATE storage_places SET order_id = ? WHERE id = ? AND order_id IS NULL`,
+			reference.OrderID, reference.StoragePlaceID,
+		)
+		if result.Error != nil {
+			return 0, result.Error
+		}
+		restored += result.RowsAffected
+	}
+
+	return restored, nil
+}
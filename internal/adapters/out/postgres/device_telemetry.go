@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/device"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CourierDeviceReadingDTO is a telemetry reading of a courier's device within the rolling window.
+type CourierDeviceReadingDTO struct {
+	ID           int64     `gorm:"primaryKey;autoIncrement"`
+	CourierID    uuid.UUID `gorm:"type:uuid;not null;index:idx_courier_device_readings_courier,priority:1"`
+	Battery      int       `gorm:"type:smallint;not null"`
+	Charging     bool      `gorm:"not null"`
+	Connectivity string    `gorm:"type:varchar(16);not null"`
+	RecordedAt   time.Time `gorm:"not null;index:idx_courier_device_readings_courier,priority:2"`
+}
+
+// TableName specifies the database table name for device readings.
+// Overrides GORM's default naming convention to use "courier_device_readings".
+func (CourierDeviceReadingDTO) TableName() string {
+	return "courier_device_readings"
+}
+
+// toDomain restores the reading from its database representation.
+func (dto CourierDeviceReadingDTO) toDomain() (device.Reading, error) {
+	courierID, err := kernel.UUIDFromBytes(dto.CourierID[:])
+	if err != nil {
+		return device.Reading{}, err
+	}
+	connectivity, err := device.ParseConnectivity(dto.Connectivity)
+	if err != nil {
+		return device.Reading{}, err
+	}
+
+	return device.NewReading(courierID, dto.Battery, dto.Charging, connectivity, dto.RecordedAt)
+}
+
+// GormDeviceTelemetryRepository implements ports.DeviceTelemetryRepository over the
+// courier_device_readings table. Each call runs in a transaction of its own bound to the
+// tenant carried by ctx, since telemetry is not part of a courier unit of work.
+type GormDeviceTelemetryRepository struct {
+	db *gorm.DB
+}
+
+// NewGormDeviceTelemetryRepository creates a telemetry repository over the given connection.
+func NewGormDeviceTelemetryRepository(db *gorm.DB) *GormDeviceTelemetryRepository {
+	return &GormDeviceTelemetryRepository{db: db}
+}
+
+// Add stores the reading unless the courier does not exist and deletes the courier's readings
+// taken before windowStart, so the table holds no more than the window of every courier.
+func (r *GormDeviceTelemetryRepository) Add(ctx context.Context, reading device.Reading, windowStart time.Time) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		// The courier check and the insert are one statement, so telemetry costs one round trip
+		result := tx.Exec(`
+			INSERT INTO courier_device_readings (courier_id, battery, charging, connectivity, recorded_at)
+			SELECT id, ?, ?, ?, ? FROM couriers WHERE id = ?
+		`, reading.Battery(), reading.IsCharging(), reading.Connectivity().String(),
+			reading.RecordedAt().UTC(), reading.CourierID().String())
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errs.NewObjectNotFoundError("courier", reading.CourierID())
+		}
+
+		return tx.Exec(
+			"DELETE FROM courier_device_readings WHERE courier_id = ? AND recorded_at < ?",
+			reading.CourierID().String(), windowStart.UTC(),
+		).Error
+	})
+}
+
+// GetSince returns the readings of the couriers taken at or after since, oldest first.
+func (r *GormDeviceTelemetryRepository) GetSince(
+	ctx context.Context,
+	since time.Time,
+	courierIDs ...kernel.UUID,
+) ([]device.Reading, error) {
+	if len(courierIDs) == 0 {
+		return []device.Reading{}, nil
+	}
+
+	ids := make([]string, 0, len(courierIDs))
+	for _, id := range courierIDs {
+		ids = append(ids, id.String())
+	}
+
+	var dtos []CourierDeviceReadingDTO
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		return tx.Where("courier_id IN ? AND recorded_at >= ?", ids, since.UTC()).
+			Order("recorded_at, id").
+			Find(&dtos).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	readings := make([]device.Reading, 0, len(dtos))
+	for _, dto := range dtos {
+		reading, toDomainErr := dto.toDomain()
+		if toDomainErr != nil {
+			return nil, toDomainErr
+		}
+		readings = append(readings, reading)
+	}
+	return readings, nil
+}
@@ -0,0 +1,45 @@
+// Package earningsrepo provides data transfer objects and the ledger for the couriers' earnings.
+// Ledger entries are only ever inserted; payout exports read them back through queries.
+package earningsrepo
+
+import (
+	"time"
+
+	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/core/domain/model/earnings"
+
+	"github.com/google/uuid"
+)
+
+// EntryDTO represents the database structure for persisting earnings ledger entries.
+// An order yields at most one entry of each kind, which the unique index enforces even for
+// concurrent submissions. Entries are removed together with their courier. Orders are kept as plain
+// IDs, since the earnings outlive completed orders moved to the archive; the synthetic data purge
+// removes the entries of the orders it deletes.
+type EntryDTO struct {
+	ID        uuid.UUID               `gorm:"type:uuid;primaryKey"`
+	CourierID uuid.UUID               `gorm:"type:uuid;not null;index"`
+	Courier   *courierrepo.CourierDTO `gorm:"foreignKey:CourierID;constraint:OnDelete:CASCADE"`
+	OrderID   uuid.UUID               `gorm:"type:uuid;not null;uniqueIndex:idx_courier_earnings_order_kind"`
+	Kind      int                     `gorm:"type:smallint;not null;uniqueIndex:idx_courier_earnings_order_kind"`
+	Amount    int                     `gorm:"not null"`
+	EarnedAt  time.Time               `gorm:"not null;index"`
+}
+
+// TableName specifies the database table name for earnings ledger entries.
+// Overrides GORM's default naming convention to use "courier_earnings".
+func (EntryDTO) TableName() string {
+	return "courier_earnings"
+}
+
+// fromDomain converts a ledger entry to its database representation.
+func fromDomain(entry *earnings.Entry) EntryDTO {
+	return EntryDTO{
+		ID:        entry.ID().Bytes(),
+		CourierID: entry.CourierID().Bytes(),
+		OrderID:   entry.OrderID().Bytes(),
+		Kind:      int(entry.Kind()),
+		Amount:    entry.Amount(),
+		EarnedAt:  entry.EarnedAt().UTC(),
+	}
+}
@@ -0,0 +1,45 @@
+package earningsrepo
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/earnings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormEarningsLedger implements EarningsLedger using GORM.
+type GormEarningsLedger struct {
+	db *gorm.DB
+}
+
+// NewGormEarningsLedger creates a new GORM earnings ledger.
+func NewGormEarningsLedger(db *gorm.DB) *GormEarningsLedger {
+	return &GormEarningsLedger{db: db}
+}
+
+// Record appends an entry to the ledger.
+// Returns earnings.ErrEntryIsAlreadyRecorded if the order already yielded an entry of the same kind.
+func (l *GormEarningsLedger) Record(ctx context.Context, entry *earnings.Entry) error {
+	if err := entry.Validate(); err != nil {
+		return err
+	}
+
+	dto := fromDomain(entry)
+	result := l.db.WithContext(ctx).
+		Omit(clause.Associations).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "order_id"}, {Name: "kind"}},
+			DoNothing: true,
+		}).
+		Create(&dto)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return earnings.ErrEntryIsAlreadyRecorded
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package earningsrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/adapters/out/postgres/earningsrepo"
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/earnings"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+// MockAggregateTracker is a mock implementation of aggregateTracker interface.
+type MockAggregateTracker struct {
+	mock.Mock
+}
+
+func (m *MockAggregateTracker) TrackAggregate(id kernel.UUID, aggregate any) {
+	m.Called(id, aggregate)
+}
+
+// EarningsLedgerIntegrationTestSuite provides integration tests for the earnings ledger
+// using PostgreSQL containers to verify database persistence behavior.
+type EarningsLedgerIntegrationTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	db       *gorm.DB
+	ledger   *earningsrepo.GormEarningsLedger
+	couriers *courierrepo.GormCourierRepository
+	orders   *orderrepo.GormOrderRepository
+	earnedAt time.Time
+}
+
+func (suite *EarningsLedgerIntegrationTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&courierrepo.CourierDTO{}, &courierrepo.StoragePlaceDTO{}, &courierrepo.MaintenanceWindowDTO{},
+			&courierrepo.AbsenceDTO{},
+			&courierrepo.ScheduleWindowDTO{},
+			&orderrepo.OrderDTO{}, &orderrepo.OrderMessageDTO{}, &orderrepo.OrderItemDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{},
+			&earningsrepo.EntryDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *EarningsLedgerIntegrationTestSuite) SetupTest() {
+	// Every test works in a fresh clone of the migrated database
+	suite.db = suite.template.NewDatabase(suite.T())
+
+	tracker := new(MockAggregateTracker)
+	tracker.On("TrackAggregate", mock.Anything, mock.Anything)
+	suite.ledger = earningsrepo.NewGormEarningsLedger(suite.db)
+	suite.couriers = courierrepo.NewGormCourierRepository(suite.db, tracker)
+	suite.orders = orderrepo.NewGormOrderRepository(suite.db, tracker)
+	suite.earnedAt = time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+}
+
+func (suite *EarningsLedgerIntegrationTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		suite.Require().NoError(suite.template.Terminate(context.Background()))
+	}
+}
+
+func (suite *EarningsLedgerIntegrationTestSuite) TestRecord_NewEntry_Persisted() {
+	ctx := context.Background()
+	courierID, orderID := suite.createDeliveredOrder()
+	entry := suite.newTip(courierID, orderID, 250)
+
+	suite.Require().NoError(suite.ledger.Record(ctx, entry))
+
+	var stored earningsrepo.EntryDTO
+	suite.Require().NoError(suite.db.First(&stored, "id = ?", entry.ID().Bytes()).Error)
+	suite.Equal(courierID.Bytes(), stored.CourierID)
+	suite.Equal(orderID.Bytes(), stored.OrderID)
+	suite.Equal(int(earnings.Tip), stored.Kind)
+	suite.Equal(250, stored.Amount)
+	suite.True(suite.earnedAt.Equal(stored.EarnedAt))
+}
+
+func (suite *EarningsLedgerIntegrationTestSuite) TestRecord_SecondEntryOfKindForOrder_ReturnsAlreadyRecorded() {
+	ctx := context.Background()
+	courierID, orderID := suite.createDeliveredOrder()
+	suite.Require().NoError(suite.ledger.Record(ctx, suite.newTip(courierID, orderID, 250)))
+
+	err := suite.ledger.Record(ctx, suite.newTip(courierID, orderID, 500))
+
+	suite.Require().ErrorIs(err, earnings.ErrEntryIsAlreadyRecorded)
+	suite.assertEntryCount(1)
+}
+
+func (suite *EarningsLedgerIntegrationTestSuite) TestRecord_InvalidEntry_ReturnsError() {
+	err := suite.ledger.Record(context.Background(), nil)
+
+	suite.Require().ErrorIs(err, earnings.ErrEntryIsNotConstructed)
+}
+
+func (suite *EarningsLedgerIntegrationTestSuite) TestDeleteOrder_KeepsEntries() {
+	ctx := context.Background()
+	courierID, orderID := suite.createDeliveredOrder()
+	suite.Require().NoError(suite.ledger.Record(ctx, suite.newTip(courierID, orderID, 250)))
+
+	suite.Require().NoError(suite.db.Exec("DELETE FROM orders WHERE id = ?", orderID.Bytes()).Error)
+
+	suite.assertEntryCount(1)
+}
+
+func (suite *EarningsLedgerIntegrationTestSuite) createDeliveredOrder() (kernel.UUID, kernel.UUID) {
+	ctx := context.Background()
+	location, err := kernel.NewLocation(1, 1)
+	suite.Require().NoError(err)
+
+	c, err := courier.NewCourier(kernel.NewUUID(), "Alice", 2, location)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.couriers.Add(ctx, c))
+
+	o, err := order.NewOrder(kernel.NewUUID(), location, 1)
+	suite.Require().NoError(err)
+	suite.Require().NoError(o.Assign(c.ID()))
+	suite.Require().NoError(o.Complete())
+	suite.Require().NoError(suite.orders.Add(ctx, o))
+
+	return c.ID(), o.ID()
+}
+
+func (suite *EarningsLedgerIntegrationTestSuite) newTip(courierID, orderID kernel.UUID, amount int) *earnings.Entry {
+	entry, err := earnings.NewEntry(kernel.NewUUID(), courierID, orderID, earnings.Tip, amount, suite.earnedAt)
+	suite.Require().NoError(err)
+	return entry
+}
+
+func (suite *EarningsLedgerIntegrationTestSuite) assertEntryCount(expected int64) {
+	var count int64
+	suite.Require().NoError(suite.db.Model(&earningsrepo.EntryDTO{}).Count(&count).Error)
+	suite.Equal(expected, count)
+}
+
+func TestEarningsLedgerIntegrationTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(EarningsLedgerIntegrationTestSuite))
+}
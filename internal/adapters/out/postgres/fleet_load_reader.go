@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+
+	"gorm.io/gorm"
+)
+
+// GormFleetLoadReader implements ports.FleetLoadReader with aggregate SQL counts.
+// Free couriers are counted with the same rules as CourierRepository.GetAllFree.
+type GormFleetLoadReader struct {
+	db *gorm.DB
+}
+
+// NewGormFleetLoadReader creates a fleet load reader over the given connection.
+func NewGormFleetLoadReader(db *gorm.DB) *GormFleetLoadReader {
+	return &GormFleetLoadReader{db: db}
+}
+
+// GetFleetLoad counts the queued orders and free couriers of the tenant carried by ctx in a single query.
+func (r *GormFleetLoadReader) GetFleetLoad(ctx context.Context) (ports.FleetLoad, error) {
+	var queuedOrders, freeCouriers int64
+	now := time.Now().UTC()
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		return tx.Raw(`
+			SELECT
+				(SELECT COUNT(*) FROM orders WHERE status = ? AND review_reason = '') AS queued_orders,
+				(SELECT COUNT(*)
+				 FROM couriers
+				 LEFT JOIN orders ON couriers.id = orders.courier_id AND orders.status = ?
+				 WHERE orders.courier_id IS NULL
+				   AND (couriers.status = ? OR (couriers.status IS NULL AND couriers.shift_started_at IS NOT NULL))
+				   AND NOT EXISTS (
+				       SELECT 1 FROM storage_places sp
+				       WHERE sp.courier_id = couriers.id AND sp.order_id IS NOT NULL
+				   )
+				   AND couriers.paused = FALSE
+				   AND couriers.review_required = FALSE
+				   AND couriers.deactivation_reason = ?
+				   AND NOT EXISTS (
+				       SELECT 1 FROM courier_maintenance_windows w
+				       WHERE w.courier_id = couriers.id AND w.starts_at <= ? AND w.ends_at > ?
+				   )
+				   AND NOT EXISTS (
+				       SELECT 1 FROM courier_absences a
+				       WHERE a.courier_id = couriers.id AND a.starts_at <= ? AND a.ends_at > ?
+				   )) AS free_couriers
+		`,
+			int(order.Created), int(order.Assigned), int(courier.Available), int(courier.NotDeactivated),
+			now, now, now, now,
+		).Row().Scan(&queuedOrders, &freeCouriers)
+	})
+	if err != nil {
+		return ports.FleetLoad{}, err
+	}
+
+	return ports.FleetLoad{
+		QueuedOrders: int(queuedOrders),
+		FreeCouriers: int(freeCouriers),
+	}, nil
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"delivery/internal/core/ports"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// BlacklistEntryDTO is a delivery address that is refused by the fraud check.
+// Streets are stored normalized (trimmed, lower case) so lookups ignore case and padding.
+type BlacklistEntryDTO struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Street    string    `gorm:"type:varchar(255);not null;uniqueIndex"`
+	Reason    string    `gorm:"type:varchar(255);not null"`
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+// TableName specifies the database table name for blacklist entries.
+// Overrides GORM's default naming convention to use "fraud_blacklist".
+func (BlacklistEntryDTO) TableName() string {
+	return "fraud_blacklist"
+}
+
+// NewBlacklistEntry creates a blacklist entry for the street with a normalized address.
+func NewBlacklistEntry(street, reason string) BlacklistEntryDTO {
+	return BlacklistEntryDTO{
+		ID:        uuid.New(),
+		Street:    normalizeStreet(street),
+		Reason:    reason,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// GormBlacklistFraudChecker implements ports.FraudChecker over the local fraud_blacklist table.
+// Orders to a blacklisted street are rejected; all other orders pass.
+type GormBlacklistFraudChecker struct {
+	db *gorm.DB
+}
+
+// NewGormBlacklistFraudChecker creates a blacklist checker over the given connection.
+func NewGormBlacklistFraudChecker(db *gorm.DB) *GormBlacklistFraudChecker {
+	return &GormBlacklistFraudChecker{db: db}
+}
+
+// CheckOrder rejects the order if its street is on the blacklist.
+func (c *GormBlacklistFraudChecker) CheckOrder(
+	ctx context.Context,
+	check ports.OrderFraudCheck,
+) (ports.FraudAssessment, error) {
+	var entries []BlacklistEntryDTO
+	err := c.db.WithContext(ctx).
+		Where("street = ?", normalizeStreet(check.Street)).
+		Limit(1).
+		Find(&entries).Error
+	if err != nil {
+		return ports.FraudAssessment{}, err
+	}
+
+	if len(entries) == 0 {
+		return ports.FraudAssessment{Verdict: ports.FraudVerdictClear}, nil
+	}
+
+	return ports.FraudAssessment{
+		Verdict: ports.FraudVerdictReject,
+		Reason:  "blacklisted address: " + entries[0].Reason,
+	}, nil
+}
+
+func normalizeStreet(street string) string {
+	return strings.ToLower(strings.TrimSpace(street))
+}
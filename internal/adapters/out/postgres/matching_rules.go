@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/matching"
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// MatchingRuleDTO is a dispatch matching rule. Criteria the rule ignores are NULL.
+// Allowed vehicle types are stored as a bit mask with bit 1<<type set for each type,
+// 0 allowing any vehicle.
+type MatchingRuleDTO struct {
+	ID               uuid.UUID          `gorm:"type:uuid;primaryKey"`
+	Name             string             `gorm:"type:varchar(100);not null"`
+	VolumeAbove      *int               `gorm:"type:integer"`
+	TemperatureClass *int               `gorm:"type:smallint"`
+	ZoneFromX        *kernel.Coordinate `gorm:"type:smallint"`
+	ZoneFromY        *kernel.Coordinate `gorm:"type:smallint"`
+	ZoneToX          *kernel.Coordinate `gorm:"type:smallint"`
+	ZoneToY          *kernel.Coordinate `gorm:"type:smallint"`
+	VehicleTypes     int                `gorm:"type:smallint;not null;default:0"`
+	ThermalStorage   bool               `gorm:"not null;default:false"`
+}
+
+// TableName specifies the database table name for matching rules.
+// Overrides GORM's default naming convention to use "matching_rules".
+func (MatchingRuleDTO) TableName() string {
+	return "matching_rules"
+}
+
+// newMatchingRuleDTO converts a rule to its database representation.
+func newMatchingRuleDTO(r *matching.Rule) MatchingRuleDTO {
+	condition := r.Condition()
+	requirement := r.Requirement()
+
+	dto := MatchingRuleDTO{
+		ID:             r.ID().Bytes(),
+		Name:           r.Name(),
+		VolumeAbove:    condition.VolumeAbove,
+		ThermalStorage: requirement.ThermalStorage,
+	}
+	if condition.TemperatureClass != nil {
+		class := int(*condition.TemperatureClass)
+		dto.TemperatureClass = &class
+	}
+	if condition.Zone != nil {
+		fromX, fromY := condition.Zone.From().X(), condition.Zone.From().Y()
+		toX, toY := condition.Zone.To().X(), condition.Zone.To().Y()
+		dto.ZoneFromX, dto.ZoneFromY, dto.ZoneToX, dto.ZoneToY = &fromX, &fromY, &toX, &toY
+	}
+	for _, vehicleType := range requirement.VehicleTypes {
+		dto.VehicleTypes |= 1 << vehicleType
+	}
+	return dto
+}
+
+// toDomain restores the rule from its database representation.
+func (dto MatchingRuleDTO) toDomain() (*matching.Rule, error) {
+	id, err := kernel.UUIDFromBytes(dto.ID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	condition := matching.Condition{VolumeAbove: dto.VolumeAbove}
+	if dto.TemperatureClass != nil {
+		class := order.TemperatureClass(*dto.TemperatureClass)
+		condition.TemperatureClass = &class
+	}
+	if dto.ZoneFromX != nil && dto.ZoneFromY != nil && dto.ZoneToX != nil && dto.ZoneToY != nil {
+		from, fromErr := kernel.NewLocation(*dto.ZoneFromX, *dto.ZoneFromY)
+		if fromErr != nil {
+			return nil, fromErr
+		}
+		to, toErr := kernel.NewLocation(*dto.ZoneToX, *dto.ZoneToY)
+		if toErr != nil {
+			return nil, toErr
+		}
+		zone, zoneErr := kernel.NewZone(from, to)
+		if zoneErr != nil {
+			return nil, zoneErr
+		}
+		condition.Zone = &zone
+	}
+
+	requirement := matching.Requirement{ThermalStorage: dto.ThermalStorage}
+	for _, vehicleType := range courier.GetValidVehicleTypes() {
+		if dto.VehicleTypes&(1<<vehicleType) != 0 {
+			requirement.VehicleTypes = append(requirement.VehicleTypes, vehicleType)
+		}
+	}
+
+	return matching.NewRule(id, dto.Name, condition, requirement)
+}
+
+// GormMatchingRuleRepository implements ports.MatchingRuleRepository over the matching_rules table.
+// Each call runs in a transaction of its own bound to the tenant carried by ctx, since
+// matching rules are not part of a courier or order unit of work.
+type GormMatchingRuleRepository struct {
+	db *gorm.DB
+}
+
+// NewGormMatchingRuleRepository creates a matching rule repository over the given connection.
+func NewGormMatchingRuleRepository(db *gorm.DB) *GormMatchingRuleRepository {
+	return &GormMatchingRuleRepository{db: db}
+}
+
+// ReplaceRules deletes the tenant's rules and stores the given ones in one transaction,
+// so dispatch never evaluates a partial configuration.
+func (r *GormMatchingRuleRepository) ReplaceRules(ctx context.Context, rules []*matching.Rule) error {
+	dtos := make([]MatchingRuleDTO, 0, len(rules))
+	for _, rule := range rules {
+		dtos = append(dtos, newMatchingRuleDTO(rule))
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		if err := tx.Exec("DELETE FROM matching_rules").Error; err != nil {
+			return err
+		}
+
+		if len(dtos) == 0 {
+			return nil
+		}
+		return tx.Create(&dtos).Error
+	})
+}
+
+// GetRules returns the tenant's rules ordered by name.
+func (r *GormMatchingRuleRepository) GetRules(ctx context.Context) ([]*matching.Rule, error) {
+	var dtos []MatchingRuleDTO
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		return tx.Order("name, id").Find(&dtos).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*matching.Rule, 0, len(dtos))
+	for _, dto := range dtos {
+		rule, toDomainErr := dto.toDomain()
+		if toDomainErr != nil {
+			return nil, toDomainErr
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
@@ -0,0 +1,249 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/microzone"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/sla"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// MicrozoneDTO is a dense-demand microzone computed from the delivery history.
+type MicrozoneDTO struct {
+	ID         uuid.UUID         `gorm:"type:uuid;primaryKey"`
+	FromX      kernel.Coordinate `gorm:"type:smallint;not null"`
+	FromY      kernel.Coordinate `gorm:"type:smallint;not null"`
+	ToX        kernel.Coordinate `gorm:"type:smallint;not null"`
+	ToY        kernel.Coordinate `gorm:"type:smallint;not null"`
+	CentroidX  kernel.Coordinate `gorm:"type:smallint;not null"`
+	CentroidY  kernel.Coordinate `gorm:"type:smallint;not null"`
+	Deliveries int               `gorm:"not null"`
+	ComputedAt time.Time         `gorm:"not null"`
+}
+
+// TableName specifies the database table name for microzones.
+// Overrides GORM's default naming convention to use "microzones".
+func (MicrozoneDTO) TableName() string {
+	return "microzones"
+}
+
+// newMicrozoneDTO converts a microzone to its database representation.
+func newMicrozoneDTO(m *microzone.Microzone) MicrozoneDTO {
+	return MicrozoneDTO{
+		ID:         m.ID().Bytes(),
+		FromX:      m.Bounds().From().X(),
+		FromY:      m.Bounds().From().Y(),
+		ToX:        m.Bounds().To().X(),
+		ToY:        m.Bounds().To().Y(),
+		CentroidX:  m.Centroid().X(),
+		CentroidY:  m.Centroid().Y(),
+		Deliveries: m.Deliveries(),
+		ComputedAt: m.ComputedAt().UTC(),
+	}
+}
+
+// toDomain restores the microzone from its database representation.
+func (dto MicrozoneDTO) toDomain() (*microzone.Microzone, error) {
+	from, err := kernel.NewLocation(dto.FromX, dto.FromY)
+	if err != nil {
+		return nil, err
+	}
+	to, err := kernel.NewLocation(dto.ToX, dto.ToY)
+	if err != nil {
+		return nil, err
+	}
+	bounds, err := kernel.NewZone(from, to)
+	if err != nil {
+		return nil, err
+	}
+	centroid, err := kernel.NewLocation(dto.CentroidX, dto.CentroidY)
+	if err != nil {
+		return nil, err
+	}
+	id, err := kernel.UUIDFromBytes(dto.ID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return microzone.NewMicrozone(id, bounds, centroid, dto.Deliveries, dto.ComputedAt)
+}
+
+// GormMicrozoneRepository implements ports.MicrozoneRepository over the microzones table.
+// Each call runs in a transaction of its own bound to the tenant carried by ctx, since
+// microzones are not part of a courier or order unit of work.
+type GormMicrozoneRepository struct {
+	db *gorm.DB
+}
+
+// NewGormMicrozoneRepository creates a microzone repository over the given connection.
+func NewGormMicrozoneRepository(db *gorm.DB) *GormMicrozoneRepository {
+	return &GormMicrozoneRepository{db: db}
+}
+
+// ReplaceAll deletes the tenant's microzones and stores the given ones in one transaction,
+// so dispatch and reports never see a partial computation.
+func (r *GormMicrozoneRepository) ReplaceAll(ctx context.Context, microzones []*microzone.Microzone) error {
+	dtos := make([]MicrozoneDTO, 0, len(microzones))
+	for _, m := range microzones {
+		dtos = append(dtos, newMicrozoneDTO(m))
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		if err := tx.Exec("DELETE FROM microzones").Error; err != nil {
+			return err
+		}
+
+		if len(dtos) == 0 {
+			return nil
+		}
+		return tx.Create(&dtos).Error
+	})
+}
+
+// GetAll returns the tenant's microzones, busiest first.
+func (r *GormMicrozoneRepository) GetAll(ctx context.Context) ([]*microzone.Microzone, error) {
+	var dtos []MicrozoneDTO
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		return tx.Order("deliveries DESC, from_x, from_y").Find(&dtos).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	microzones := make([]*microzone.Microzone, 0, len(dtos))
+	for _, dto := range dtos {
+		m, toDomainErr := dto.toDomain()
+		if toDomainErr != nil {
+			return nil, toDomainErr
+		}
+		microzones = append(microzones, m)
+	}
+	return microzones, nil
+}
+
+// GormDeliveryHistoryReader implements ports.DeliveryHistoryReader over the orders and the change log.
+type GormDeliveryHistoryReader struct {
+	db *gorm.DB
+}
+
+// NewGormDeliveryHistoryReader creates a delivery history reader over the given connection.
+func NewGormDeliveryHistoryReader(db *gorm.DB) *GormDeliveryHistoryReader {
+	return &GormDeliveryHistoryReader{db: db}
+}
+
+// GetDeliveryPoints counts the tenant's completed orders per delivery location, archived ones included.
+// Synthetic orders left by integration suites are not part of the history.
+func (r *GormDeliveryHistoryReader) GetDeliveryPoints(ctx context.Context) ([]microzone.DeliveryPoint, error) {
+	var rows []struct {
+		LocationX  kernel.Coordinate
+		LocationY  kernel.Coordinate
+		Deliveries int
+	}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		// Archived orders were completed, and keep their location in their snapshot
+		return tx.Raw(`
+			SELECT location_x, location_y, COUNT(*) AS deliveries
+			FROM (
+				SELECT location_x, location_y
+				FROM orders
+				WHERE status = ? AND synthetic_at IS NULL
+				UNION ALL
+				SELECT (snapshot->'Location'->>'X')::smallint, (snapshot->'Location'->>'Y')::smallint
+				FROM orders_archive
+			) delivered
+			GROUP BY location_x, location_y
+		`, int(order.Completed)).Scan(&rows).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]microzone.DeliveryPoint, 0, len(rows))
+	for _, row := range rows {
+		location, locationErr := kernel.NewLocation(row.LocationX, row.LocationY)
+		if locationErr != nil {
+			return nil, locationErr
+		}
+		points = append(points, microzone.DeliveryPoint{Location: location, Deliveries: row.Deliveries})
+	}
+	return points, nil
+}
+
+// GetCompletedDeliveries returns the tenant's orders completed within [from, to).
+// Orders carry no timestamps of their own, so an order was created when its first change was
+// logged and completed when its first completed snapshot was. Synthetic orders are left out.
+func (r *GormDeliveryHistoryReader) GetCompletedDeliveries(
+	ctx context.Context,
+	from time.Time,
+	to time.Time,
+) ([]sla.Delivery, error) {
+	var rows []struct {
+		LocationX    kernel.Coordinate
+		LocationY    kernel.Coordinate
+		DeliveryTier int
+		CreatedAt    time.Time
+		CompletedAt  time.Time
+	}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		// Completed is final, so the first completed snapshot of an order is its only completion
+		return tx.Raw(`
+			SELECT o.location_x, o.location_y, o.delivery_tier,
+				created.changed_at AS created_at, completed.changed_at AS completed_at
+			FROM change_log completed
+			JOIN change_log created ON created.aggregate_type = completed.aggregate_type
+				AND created.aggregate_id = completed.aggregate_id AND created.version = 1
+			JOIN orders o ON o.id = completed.aggregate_id
+			WHERE completed.aggregate_type = ?
+				AND (completed.snapshot->>'status')::int = ?
+				AND completed.changed_at >= ? AND completed.changed_at < ?
+				AND o.synthetic_at IS NULL
+				AND NOT EXISTS (
+					SELECT 1 FROM change_log earlier
+					WHERE earlier.aggregate_type = completed.aggregate_type
+						AND earlier.aggregate_id = completed.aggregate_id
+						AND earlier.version < completed.version
+						AND (earlier.snapshot->>'status')::int = ?
+				)
+		`, queries.OrderChange, int(order.Completed), from.UTC(), to.UTC(), int(order.Completed)).Scan(&rows).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]sla.Delivery, 0, len(rows))
+	for _, row := range rows {
+		location, locationErr := kernel.NewLocation(row.LocationX, row.LocationY)
+		if locationErr != nil {
+			return nil, locationErr
+		}
+		deliveries = append(deliveries, sla.Delivery{
+			Location:    location,
+			Tier:        order.DeliveryTier(row.DeliveryTier),
+			CreatedAt:   row.CreatedAt.UTC(),
+			CompletedAt: row.CompletedAt.UTC(),
+		})
+	}
+	return deliveries, nil
+}
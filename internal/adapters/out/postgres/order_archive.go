@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// ApplyOrderArchival prepares the database for moving completed orders to the orders_archive table.
+// It must run after the GORM migrations and is safe to run on every start.
+//
+// The earnings ledger and the relay history outlive archived orders, so their rows refer to orders
+// by plain IDs. Earlier versions declared foreign keys that removed them together with their order;
+// GORM never drops constraints, so they are dropped here.
+func ApplyOrderArchival(db *gorm.DB) error {
+	statements := []string{
+		`ALTER TABLE courier_earnings DROP CONSTRAINT IF EXISTS fk_courier_earnings_order`,
+		`ALTER TABLE order_handovers DROP CONSTRAINT IF EXISTS fk_order_handovers_order`,
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return fmt.Errorf("apply order archival: %w", err)
+			}
+		}
+		return nil
+	})
+}
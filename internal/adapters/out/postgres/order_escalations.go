@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/escalation"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// OrderEscalationDTO is the latest escalation tier an urgent order reached while waiting for a courier.
+type OrderEscalationDTO struct {
+	OrderID     uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Tier        int       `gorm:"type:smallint;not null"`
+	EscalatedAt time.Time `gorm:"not null"`
+}
+
+// TableName specifies the database table name for order escalations.
+// Overrides GORM's default naming convention to use "order_escalations".
+func (OrderEscalationDTO) TableName() string {
+	return "order_escalations"
+}
+
+// toDomain restores the escalation from its database representation.
+func (dto OrderEscalationDTO) toDomain() (escalation.Escalation, error) {
+	orderID, err := kernel.UUIDFromBytes(dto.OrderID[:])
+	if err != nil {
+		return escalation.Escalation{}, err
+	}
+	return escalation.RestoreEscalation(orderID, dto.Tier, dto.EscalatedAt)
+}
+
+// GormOrderEscalationRepository implements ports.OrderEscalationRepository over the order_escalations table.
+// Each call runs in a transaction of its own bound to the tenant carried by ctx, since the
+// escalation of an order is not part of an order unit of work.
+type GormOrderEscalationRepository struct {
+	db *gorm.DB
+}
+
+// NewGormOrderEscalationRepository creates an order escalation repository over the given connection.
+func NewGormOrderEscalationRepository(db *gorm.DB) *GormOrderEscalationRepository {
+	return &GormOrderEscalationRepository{db: db}
+}
+
+// Get retrieves the escalation of the order, or an ObjectNotFoundError if it has not escalated.
+func (r *GormOrderEscalationRepository) Get(ctx context.Context, orderID kernel.UUID) (escalation.Escalation, error) {
+	var dto OrderEscalationDTO
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		return tx.Take(&dto, "order_id = ?", orderID.String()).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return escalation.Escalation{}, errs.NewObjectNotFoundError("escalation", orderID)
+	}
+	if err != nil {
+		return escalation.Escalation{}, err
+	}
+	return dto.toDomain()
+}
+
+// Save upserts the escalation by order.
+func (r *GormOrderEscalationRepository) Save(ctx context.Context, escalated escalation.Escalation) error {
+	if err := escalated.Validate(); err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "order_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"tier", "escalated_at"}),
+		}).Create(&OrderEscalationDTO{
+			OrderID:     escalated.OrderID().Bytes(),
+			Tier:        escalated.Tier(),
+			EscalatedAt: escalated.EscalatedAt().UTC(),
+		}).Error
+	})
+}
@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/adapters/out/postgres/outboxrepo"
+	"delivery/internal/core/domain/model/order"
+
+	"gorm.io/gorm"
+)
+
+// storeOrderEvents writes the events raised by the tracked orders to the outbox within tx,
+// so an event is stored if and only if the change that raised it is committed.
+// Returns the orders whose events were stored; an order tracked several times is stored once.
+//
+// The events of one commit are spaced a microsecond apart, the precision of the outbox
+// timestamps, so the relay publishes them in the order they were raised.
+func storeOrderEvents(ctx context.Context, tx *gorm.DB, tracked []trackedAggregate, occurredAt time.Time) ([]*order.Order, error) {
+	outbox := outboxrepo.NewGormOutboxRepository(tx)
+	occurredAt = occurredAt.Truncate(time.Microsecond)
+	stored := make([]*order.Order, 0, len(tracked))
+	seen := make(map[*order.Order]bool, len(tracked))
+	for _, aggregate := range tracked {
+		o, ok := aggregate.Aggregate.(*order.Order)
+		if !ok || seen[o] {
+			continue
+		}
+		seen[o] = true
+
+		for _, event := range o.Events() {
+			message, err := outboxrepo.NewOrderEventMessage(event, occurredAt)
+			if err != nil {
+				return nil, err
+			}
+			if err = outbox.Add(ctx, message); err != nil {
+				return nil, err
+			}
+			occurredAt = occurredAt.Add(time.Microsecond)
+		}
+		stored = append(stored, o)
+	}
+
+	return stored, nil
+}
@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/application/usecases/queries"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// OrderHistoryProjectionName identifies the order history projection on the command line
+// and in the projection_checkpoints table.
+const OrderHistoryProjectionName = "order-history"
+
+// OrderHistoryEntryDTO is a transition of an order: the status and courier a change left it with.
+// ChangeID is the change log ID of the change, which orders transitions committed at the same time.
+type OrderHistoryEntryDTO struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	OrderID   uuid.UUID  `gorm:"type:uuid;not null;index:idx_order_history_order,priority:1"`
+	Status    int        `gorm:"type:smallint;not null"`
+	CourierID *uuid.UUID `gorm:"type:uuid"`
+	ChangedAt time.Time  `gorm:"not null;index:idx_order_history_order,priority:2"`
+	ChangeID  int64      `gorm:"not null"`
+}
+
+// TableName specifies the database table name for order history entries.
+// Overrides GORM's default naming convention to use "order_history".
+func (OrderHistoryEntryDTO) TableName() string {
+	return "order_history"
+}
+
+// OrderHistoryProjection keeps the order_history table, the transitions every order went through.
+// The orders table only holds the latest state, while the change log records every change;
+// the projection keeps the changes that moved an order to another status or courier and drops
+// the rest, such as a changed delivery location.
+type OrderHistoryProjection struct{}
+
+// NewOrderHistoryProjection creates the order history projection.
+func NewOrderHistoryProjection() OrderHistoryProjection {
+	return OrderHistoryProjection{}
+}
+
+// Name returns OrderHistoryProjectionName.
+func (OrderHistoryProjection) Name() string {
+	return OrderHistoryProjectionName
+}
+
+// Description explains what the read model holds.
+func (OrderHistoryProjection) Description() string {
+	return "Status and courier transitions of every order"
+}
+
+// Reset deletes the history of all orders.
+func (OrderHistoryProjection) Reset(_ context.Context, tx *gorm.DB) error {
+	return tx.Exec("DELETE FROM order_history").Error
+}
+
+// Project records a transition for every change to an order that left it with another status
+// or courier than its latest recorded transition.
+func (OrderHistoryProjection) Project(_ context.Context, tx *gorm.DB, changes []queries.Change) error {
+	orderIDs := make([]uuid.UUID, 0, len(changes))
+	for _, change := range changes {
+		if change.Order != nil {
+			orderIDs = append(orderIDs, change.AggregateID.Bytes())
+		}
+	}
+	if len(orderIDs) == 0 {
+		return nil
+	}
+
+	latest, err := latestOrderTransitions(tx, orderIDs)
+	if err != nil {
+		return err
+	}
+
+	entries := make([]OrderHistoryEntryDTO, 0, len(orderIDs))
+	for _, change := range changes {
+		if change.Order == nil {
+			continue
+		}
+
+		orderID := change.AggregateID.Bytes()
+		entry := OrderHistoryEntryDTO{
+			ID:        uuid.New(),
+			OrderID:   orderID,
+			Status:    int(change.Order.Status),
+			CourierID: change.Order.CourierID,
+			ChangedAt: change.ChangedAt,
+			ChangeID:  change.Cursor,
+		}
+		if previous, ok := latest[orderID]; ok && previous.isSameTransition(entry) {
+			continue
+		}
+
+		latest[orderID] = entry
+		entries = append(entries, entry)
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+	return tx.Create(&entries).Error
+}
+
+// latestOrderTransitions returns the latest recorded transition of each of the orders that has any.
+func latestOrderTransitions(tx *gorm.DB, orderIDs []uuid.UUID) (map[uuid.UUID]OrderHistoryEntryDTO, error) {
+	var entries []OrderHistoryEntryDTO
+	err := tx.Raw(`
+		SELECT DISTINCT ON (order_id) *
+		FROM order_history
+		WHERE order_id IN ?
+		ORDER BY order_id, changed_at DESC, change_id DESC
+	`, orderIDs).Scan(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	latest := make(map[uuid.UUID]OrderHistoryEntryDTO, len(entries))
+	for _, entry := range entries {
+		latest[entry.OrderID] = entry
+	}
+	return latest, nil
+}
+
+// isSameTransition reports whether both entries leave the order with the same status and courier.
+func (dto OrderHistoryEntryDTO) isSameTransition(other OrderHistoryEntryDTO) bool {
+	if dto.Status != other.Status {
+		return false
+	}
+	if dto.CourierID == nil || other.CourierID == nil {
+		return dto.CourierID == nil && other.CourierID == nil
+	}
+	return *dto.CourierID == *other.CourierID
+}
@@ -0,0 +1,142 @@
+package postgres_test
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+// OrderHistoryIntegrationTestSuite verifies that the order history projection records
+// the status and courier transitions of orders from the change log.
+type OrderHistoryIntegrationTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	adminDB  *gorm.DB
+	appDB    *gorm.DB
+}
+
+// SetupSuite starts PostgreSQL and creates the tenant tables, the checkpoints and the application role.
+func (suite *OrderHistoryIntegrationTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		if err := migrateTenantTables(db); err != nil {
+			return err
+		}
+		if err := db.AutoMigrate(&postgres_adapter.ProjectionCheckpointDTO{}); err != nil {
+			return err
+		}
+		if err := postgres_adapter.ApplyTenancyPolicies(db, defaultTenant); err != nil {
+			return err
+		}
+		return createAppRole(db)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+// SetupTest clones the template for the test and connects to the clone
+// both as the superuser and as the application role.
+func (suite *OrderHistoryIntegrationTestSuite) SetupTest() {
+	database := suite.template.CreateDatabase(suite.T())
+
+	suite.adminDB = pgtest.Open(suite.T(), suite.template.DSN(pgtest.User, pgtest.Password, database))
+	suite.appDB = pgtest.Open(suite.T(), suite.template.DSN("delivery_app", "app", database))
+}
+
+// TearDownSuite cleans up PostgreSQL container after all tests complete.
+func (suite *OrderHistoryIntegrationTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *OrderHistoryIntegrationTestSuite) TestProjectNext_RecordsTransitions() {
+	ctx := context.Background()
+	orderID := uuid.New()
+	courierID := uuid.New()
+	suite.appendOrderChange(orderID, 1, order.Created, nil)
+	suite.appendOrderChange(orderID, 2, order.Assigned, &courierID)
+	runner := suite.runner()
+
+	projected, err := runner.ProjectNext(ctx, 10)
+
+	suite.Require().NoError(err)
+	suite.Equal(2, projected)
+	history := suite.history(orderID)
+	suite.Require().Len(history, 2)
+	suite.Equal(int(order.Created), history[0].Status)
+	suite.Nil(history[0].CourierID)
+	suite.Equal(int(order.Assigned), history[1].Status)
+	suite.Equal(courierID, *history[1].CourierID)
+}
+
+func (suite *OrderHistoryIntegrationTestSuite) TestProjectNext_SkipsChangesWithoutTransition() {
+	ctx := context.Background()
+	orderID := uuid.New()
+	courierID := uuid.New()
+	otherCourierID := uuid.New()
+	runner := suite.runner()
+
+	suite.appendOrderChange(orderID, 1, order.Assigned, &courierID)
+	_, err := runner.ProjectNext(ctx, 10)
+	suite.Require().NoError(err)
+
+	// The second change only moved the delivery location, the third one reassigned the order
+	suite.appendOrderChange(orderID, 2, order.Assigned, &courierID)
+	suite.appendOrderChange(orderID, 3, order.Assigned, &otherCourierID)
+	_, err = runner.ProjectNext(ctx, 10)
+	suite.Require().NoError(err)
+
+	history := suite.history(orderID)
+	suite.Require().Len(history, 2)
+	suite.Equal(courierID, *history[0].CourierID)
+	suite.Equal(otherCourierID, *history[1].CourierID)
+}
+
+func (suite *OrderHistoryIntegrationTestSuite) runner() *postgres_adapter.ProjectionBackfillRunner {
+	runner, err := postgres_adapter.NewProjectionBackfillRunner(
+		suite.appDB, slog.Default(), postgres_adapter.NewOrderHistoryProjection(),
+	)
+	suite.Require().NoError(err)
+	return runner
+}
+
+// appendOrderChange records a change of the order in the change log of the default tenant.
+func (suite *OrderHistoryIntegrationTestSuite) appendOrderChange(
+	orderID uuid.UUID,
+	version int64,
+	status order.Status,
+	courierID *uuid.UUID,
+) {
+	snapshot, err := json.Marshal(queries.OrderSnapshot{Status: status, CourierID: courierID, X: 1, Y: 1, Volume: 5})
+	suite.Require().NoError(err)
+
+	err = suite.adminDB.Exec(`
+		INSERT INTO change_log (aggregate_type, aggregate_id, version, changed_at, snapshot)
+		VALUES (?, ?, ?, ?, ?)
+	`, queries.OrderChange, orderID, version, time.Now().UTC(), string(snapshot)).Error
+	suite.Require().NoError(err)
+}
+
+func (suite *OrderHistoryIntegrationTestSuite) history(orderID uuid.UUID) []postgres_adapter.OrderHistoryEntryDTO {
+	var entries []postgres_adapter.OrderHistoryEntryDTO
+	err := suite.adminDB.Where("order_id = ?", orderID).Order("changed_at, change_id").Find(&entries).Error
+	suite.Require().NoError(err)
+	return entries
+}
+
+func TestOrderHistoryIntegrationTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(OrderHistoryIntegrationTestSuite))
+}
@@ -4,6 +4,8 @@
 package orderrepo
 
 import (
+	"time"
+
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
 
@@ -14,11 +16,78 @@ import (
 // Maps order domain entities to relational database tables with proper indexing
 // for efficient querying by status and courier assignment.
 type OrderDTO struct {
-	ID        uuid.UUID   `gorm:"type:uuid;primaryKey"`
-	CourierID *uuid.UUID  `gorm:"type:uuid;index"`
-	Location  LocationDTO `gorm:"embedded;embeddedPrefix:location_"`
-	Volume    int
-	Status    int
+	ID            uuid.UUID   `gorm:"type:uuid;primaryKey"`
+	CourierID     *uuid.UUID  `gorm:"type:uuid;index"`
+	Location      LocationDTO `gorm:"embedded;embeddedPrefix:location_"`
+	Volume        int
+	Status        int
+	TrackingToken *string           `gorm:"type:varchar(64);uniqueIndex"`
+	ReviewReason  string            `gorm:"type:varchar(255);not null;default:''"`
+	Messages      []OrderMessageDTO `gorm:"foreignKey:OrderID;constraint:OnDelete:CASCADE"`
+	Items         []OrderItemDTO    `gorm:"foreignKey:OrderID;constraint:OnDelete:CASCADE"`
+
+	EstimatedArrivalTurns *int
+	EstimatedArrivalAt    *time.Time
+
+	DeliveryWindowFrom *time.Time
+	DeliveryWindowTo   *time.Time
+
+	TipAmount         *int
+	TipIdempotencyKey *string `gorm:"type:varchar(64)"`
+	TippedAt          *time.Time
+
+	RatingStars *int `gorm:"type:smallint"`
+	RatedAt     *time.Time
+
+	// Orders created before payments were tracked are cash on delivery with a pending payment
+	PaymentMethod      int                         `gorm:"type:smallint;not null;default:1"`
+	PaymentStatus      int                         `gorm:"type:smallint;not null;default:1"`
+	PaymentTransitions []OrderPaymentTransitionDTO `gorm:"foreignKey:OrderID;constraint:OnDelete:CASCADE"`
+
+	// Orders created before delivery tiers were introduced are express orders
+	DeliveryTier  int        `gorm:"type:smallint;not null;default:1"`
+	BatchClosesAt *time.Time `gorm:"index"`
+
+	// Orders created before temperature classes were introduced are ambient
+	TemperatureClass int `gorm:"type:smallint;not null;default:1"`
+
+	// Waiting orders are dispatched most urgent first, then oldest first; orders created before
+	// priorities were introduced have normal priority. CreatedAt is set once, when the order is added
+	Priority  int       `gorm:"type:smallint;not null;default:2;index:idx_orders_dispatch_queue,priority:1,sort:desc"`
+	CreatedAt time.Time `gorm:"<-:create;not null;default:now();index:idx_orders_dispatch_queue,priority:2"`
+
+	// CompletedAt is stamped by the repository when the order is first saved completed and is never
+	// written from the aggregate; completed orders are archived a while after it
+	CompletedAt *time.Time `gorm:"->;index"`
+
+	// Orders created before values were declared have no declared value and are not insured
+	DeclaredValue       int  `gorm:"not null;default:0"`
+	InsuranceRequired   bool `gorm:"not null;default:false"`
+	PickupConfirmedAt   *time.Time
+	DeliveryConfirmedAt *time.Time
+
+	// A marketplace order is delivered by at most one order
+	ExternalMarketplace *string `gorm:"type:varchar(32);uniqueIndex:idx_orders_external_reference"`
+	ExternalOrderID     *string `gorm:"type:varchar(64);uniqueIndex:idx_orders_external_reference"`
+	ExternalDeepLink    *string `gorm:"type:varchar(2048)"`
+
+	// A confirmed basket is delivered by at most one order, so redelivered confirmations create no duplicates
+	BasketID *uuid.UUID `gorm:"type:uuid;uniqueIndex:idx_orders_basket_id"`
+
+	// Route tracking of the assigned courier; orders assigned before it was introduced start untracked
+	RouteOriginX        *kernel.Coordinate `gorm:"type:smallint"`
+	RouteOriginY        *kernel.Coordinate `gorm:"type:smallint"`
+	RouteDeviationTicks int                `gorm:"not null;default:0"`
+	RouteDeviatedAt     *time.Time
+
+	// Route legs of the order; orders created before routes were introduced are taken straight to their location
+	PickupX        *kernel.Coordinate `gorm:"type:smallint"`
+	PickupY        *kernel.Coordinate `gorm:"type:smallint"`
+	RouteWaypoints []LocationDTO      `gorm:"type:jsonb;serializer:json"`
+	ReachedStops   int                `gorm:"not null;default:0"`
+
+	// Version is compared and bumped by every update, so concurrent writers cannot overwrite each other
+	Version int `gorm:"not null;default:0"`
 }
 
 // TableName specifies the database table name for order entities.
@@ -34,8 +103,75 @@ type LocationDTO struct {
 	Y kernel.Coordinate `gorm:"type:smallint"`
 }
 
+// OrderMessageDTO represents the database structure for persisting order thread messages.
+// Links to the order via foreign key; messages are immutable and only ever inserted.
+type OrderMessageDTO struct {
+	ID      uuid.UUID `gorm:"type:uuid;primaryKey"`
+	OrderID uuid.UUID `gorm:"type:uuid;not null;index"`
+	Sender  int       `gorm:"type:smallint;not null"`
+	Text    string    `gorm:"type:varchar(500);not null"`
+	SentAt  time.Time `gorm:"not null"`
+}
+
+// TableName specifies the database table name for order thread messages.
+// Overrides GORM's default naming convention to use "order_messages".
+func (OrderMessageDTO) TableName() string {
+	return "order_messages"
+}
+
+// OrderItemDTO represents the database structure for persisting order item lines.
+// Lines are written together with the order and never change, so they are keyed by
+// their position within the order.
+type OrderItemDTO struct {
+	OrderID    uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Position   int       `gorm:"primaryKey"`
+	SKU        string    `gorm:"column:sku;type:varchar(64);not null"`
+	Quantity   int       `gorm:"not null"`
+	UnitVolume int       `gorm:"not null"`
+}
+
+// TableName specifies the database table name for order item lines.
+// Overrides GORM's default naming convention to use "order_items".
+func (OrderItemDTO) TableName() string {
+	return "order_items"
+}
+
+// OrderPaymentTransitionDTO represents the database structure for the payment status audit trail.
+// Transitions are immutable and only ever inserted; the unique reference per order makes
+// redelivered payment events harmless.
+type OrderPaymentTransitionDTO struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey"`
+	OrderID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_order_payment_transitions_reference"`
+	FromStatus int       `gorm:"type:smallint;not null"`
+	ToStatus   int       `gorm:"type:smallint;not null"`
+	Reference  string    `gorm:"type:varchar(128);not null;uniqueIndex:idx_order_payment_transitions_reference"`
+	OccurredAt time.Time `gorm:"not null"`
+}
+
+// TableName specifies the database table name for payment status transitions.
+// Overrides GORM's default naming convention to use "order_payment_transitions".
+func (OrderPaymentTransitionDTO) TableName() string {
+	return "order_payment_transitions"
+}
+
+// ArchivedOrderDTO represents a completed order moved out of the orders table by the archival job.
+// The order is kept whole as it was last saved, messages, items and payment transitions included,
+// so archived orders are restored exactly; only the completion time is queried by.
+type ArchivedOrderDTO struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey"`
+	CompletedAt time.Time `gorm:"not null;index"`
+	ArchivedAt  time.Time `gorm:"not null"`
+	Snapshot    OrderDTO  `gorm:"type:jsonb;serializer:json;not null"`
+}
+
+// TableName specifies the database table name for archived orders.
+// Overrides GORM's default naming convention to use "orders_archive".
+func (ArchivedOrderDTO) TableName() string {
+	return "orders_archive"
+}
+
 // fromDomain converts an order domain aggregate to its database representation.
-// Maps all order attributes including optional courier assignment.
+// Maps all order attributes including optional courier assignment and thread messages.
 func fromDomain(order *order.Order) OrderDTO {
 	var courierID *uuid.UUID
 	if id := order.Courier(); id != nil {
@@ -43,20 +179,173 @@ func fromDomain(order *order.Order) OrderDTO {
 		courierID = &raw
 	}
 
+	var basketID *uuid.UUID
+	if id := order.BasketID(); id != nil {
+		raw := id.Bytes()
+		basketID = &raw
+	}
+
+	var trackingToken *string
+	if token := order.TrackingToken(); token != nil {
+		value := token.String()
+		trackingToken = &value
+	}
+
+	var etaTurns *int
+	var etaAt *time.Time
+	if eta := order.EstimatedArrival(); eta != nil {
+		turns, at := eta.Turns(), eta.CalculatedAt().UTC()
+		etaTurns, etaAt = &turns, &at
+	}
+
+	var windowFrom, windowTo *time.Time
+	if window := order.DeliveryWindow(); window != nil {
+		from, to := window.From().UTC(), window.To().UTC()
+		windowFrom, windowTo = &from, &to
+	}
+
+	var tipAmount *int
+	var tipKey *string
+	var tippedAt *time.Time
+	if tip := order.Tip(); tip != nil {
+		amount, key, at := tip.Amount(), tip.IdempotencyKey(), tip.TippedAt().UTC()
+		tipAmount, tipKey, tippedAt = &amount, &key, &at
+	}
+
+	var ratingStars *int
+	var ratedAt *time.Time
+	if rating := order.Rating(); rating != nil {
+		stars, at := rating.Stars(), rating.RatedAt().UTC()
+		ratingStars, ratedAt = &stars, &at
+	}
+
+	var routeOriginX, routeOriginY *kernel.Coordinate
+	if origin := order.RouteOrigin(); origin != nil {
+		x, y := origin.X(), origin.Y()
+		routeOriginX, routeOriginY = &x, &y
+	}
+
+	var pickupX, pickupY *kernel.Coordinate
+	var routeWaypoints []LocationDTO
+	if route := order.Route(); route != nil {
+		x, y := route.Pickup().X(), route.Pickup().Y()
+		pickupX, pickupY = &x, &y
+		for _, waypoint := range route.Waypoints() {
+			routeWaypoints = append(routeWaypoints, LocationDTO{X: waypoint.X(), Y: waypoint.Y()})
+		}
+	}
+
+	var externalMarketplace, externalOrderID, externalDeepLink *string
+	if ref := order.ExternalReference(); ref != nil {
+		marketplace, id := ref.Marketplace(), ref.OrderID()
+		externalMarketplace, externalOrderID = &marketplace, &id
+		if link := ref.DeepLink(); link != "" {
+			externalDeepLink = &link
+		}
+	}
+
+	orderID := order.ID().Bytes()
+	items := make([]OrderItemDTO, 0, len(order.Items()))
+	for position, item := range order.Items() {
+		items = append(items, OrderItemDTO{
+			OrderID:    orderID,
+			Position:   position,
+			SKU:        item.SKU(),
+			Quantity:   item.Quantity(),
+			UnitVolume: item.UnitVolume(),
+		})
+	}
+
+	messages := make([]OrderMessageDTO, 0, len(order.Messages()))
+	for _, m := range order.Messages() {
+		messages = append(messages, OrderMessageDTO{
+			ID:      m.ID().Bytes(),
+			OrderID: orderID,
+			Sender:  int(m.Sender()),
+			Text:    m.Text(),
+			SentAt:  m.SentAt().UTC(),
+		})
+	}
+
+	paymentTransitions := make([]OrderPaymentTransitionDTO, 0, len(order.PaymentTransitions()))
+	for _, t := range order.PaymentTransitions() {
+		paymentTransitions = append(paymentTransitions, OrderPaymentTransitionDTO{
+			ID:         t.ID().Bytes(),
+			OrderID:    orderID,
+			FromStatus: int(t.From()),
+			ToStatus:   int(t.To()),
+			Reference:  t.Reference(),
+			OccurredAt: t.OccurredAt().UTC(),
+		})
+	}
+
 	return OrderDTO{
-		ID:        order.ID().Bytes(),
+		ID:        orderID,
 		CourierID: courierID,
 		Location: LocationDTO{
 			X: order.Location().X(),
 			Y: order.Location().Y(),
 		},
-		Volume: order.Volume(),
-		Status: int(order.Status()),
+		Volume:        order.Volume(),
+		Status:        int(order.Status()),
+		TrackingToken: trackingToken,
+		ReviewReason:  order.ReviewReason(),
+		Messages:      messages,
+		Items:         items,
+
+		EstimatedArrivalTurns: etaTurns,
+		EstimatedArrivalAt:    etaAt,
+
+		DeliveryWindowFrom: windowFrom,
+		DeliveryWindowTo:   windowTo,
+
+		TipAmount:         tipAmount,
+		TipIdempotencyKey: tipKey,
+		TippedAt:          tippedAt,
+
+		RatingStars: ratingStars,
+		RatedAt:     ratedAt,
+
+		PaymentMethod:      int(order.PaymentMethod()),
+		PaymentStatus:      int(order.PaymentStatus()),
+		PaymentTransitions: paymentTransitions,
+
+		DeliveryTier:  int(order.DeliveryTier()),
+		BatchClosesAt: order.BatchClosesAt(),
+
+		TemperatureClass: int(order.TemperatureClass()),
+
+		Priority: int(order.Priority()),
+
+		DeclaredValue:       order.DeclaredValue(),
+		InsuranceRequired:   order.IsInsuranceRequired(),
+		PickupConfirmedAt:   order.PickupConfirmedAt(),
+		DeliveryConfirmedAt: order.DeliveryConfirmedAt(),
+
+		ExternalMarketplace: externalMarketplace,
+		ExternalOrderID:     externalOrderID,
+		ExternalDeepLink:    externalDeepLink,
+
+		BasketID: basketID,
+
+		RouteOriginX:        routeOriginX,
+		RouteOriginY:        routeOriginY,
+		RouteDeviationTicks: order.RouteDeviationTicks(),
+		RouteDeviatedAt:     order.RouteDeviatedAt(),
+
+		PickupX:        pickupX,
+		PickupY:        pickupY,
+		RouteWaypoints: routeWaypoints,
+		ReachedStops:   order.ReachedStops(),
+
+		Version: order.Version(),
 	}
 }
 
 // toDomain converts a database DTO to an order domain aggregate.
-// Reconstructs the complete aggregate including status and courier assignment using RestoreOrder.
+// Reconstructs the complete aggregate including status and courier assignment using RestoreOrder,
+// then attaches the persisted item lines, thread messages, tracking token, fraud review hold, delivery window,
+// tip, rating, payment, delivery tier, priority, declared value, external reference, route and route tracking.
 func toDomain(dto OrderDTO) (*order.Order, error) {
 	id, err := kernel.UUIDFromBytes(dto.ID[:])
 	if err != nil {
@@ -78,5 +367,227 @@ func toDomain(dto OrderDTO) (*order.Order, error) {
 		return nil, err
 	}
 
-	return order.RestoreOrder(id, loc, dto.Volume, order.Status(dto.Status), courierID)
+	o, err := order.RestoreOrder(id, loc, dto.Volume, order.Status(dto.Status), courierID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]order.Item, 0, len(dto.Items))
+	for _, itemDTO := range dto.Items {
+		item, itemErr := order.NewItem(itemDTO.SKU, itemDTO.Quantity, itemDTO.UnitVolume)
+		if itemErr != nil {
+			return nil, itemErr
+		}
+		items = append(items, item)
+	}
+
+	if err = o.RestoreItems(items); err != nil {
+		return nil, err
+	}
+
+	messages := make([]*order.Message, 0, len(dto.Messages))
+	for _, messageDTO := range dto.Messages {
+		message, messageErr := messageToDomain(messageDTO)
+		if messageErr != nil {
+			return nil, messageErr
+		}
+		messages = append(messages, message)
+	}
+
+	if err = o.RestoreMessages(messages); err != nil {
+		return nil, err
+	}
+
+	if dto.TrackingToken != nil {
+		token, tokenErr := order.TrackingTokenFromString(*dto.TrackingToken)
+		if tokenErr != nil {
+			return nil, tokenErr
+		}
+
+		if err = o.RestoreTrackingToken(token); err != nil {
+			return nil, err
+		}
+	}
+
+	if dto.ReviewReason != "" {
+		if err = o.HoldForReview(dto.ReviewReason); err != nil {
+			return nil, err
+		}
+	}
+
+	if dto.EstimatedArrivalTurns != nil && dto.EstimatedArrivalAt != nil {
+		eta, etaErr := order.NewEstimatedArrival(*dto.EstimatedArrivalTurns, *dto.EstimatedArrivalAt)
+		if etaErr != nil {
+			return nil, etaErr
+		}
+
+		if err = o.RecordEstimatedArrival(eta); err != nil {
+			return nil, err
+		}
+	}
+
+	if dto.DeliveryWindowFrom != nil && dto.DeliveryWindowTo != nil {
+		window, windowErr := order.NewDeliveryWindow(*dto.DeliveryWindowFrom, *dto.DeliveryWindowTo)
+		if windowErr != nil {
+			return nil, windowErr
+		}
+
+		if err = o.RestoreDeliveryWindow(window); err != nil {
+			return nil, err
+		}
+	}
+
+	if dto.TipAmount != nil && dto.TipIdempotencyKey != nil && dto.TippedAt != nil {
+		tip, tipErr := order.NewTip(*dto.TipAmount, *dto.TipIdempotencyKey, *dto.TippedAt)
+		if tipErr != nil {
+			return nil, tipErr
+		}
+
+		if err = o.RestoreTip(tip); err != nil {
+			return nil, err
+		}
+	}
+
+	if dto.RatingStars != nil && dto.RatedAt != nil {
+		rating, ratingErr := order.NewRating(*dto.RatingStars, *dto.RatedAt)
+		if ratingErr != nil {
+			return nil, ratingErr
+		}
+
+		if err = o.RestoreRating(rating); err != nil {
+			return nil, err
+		}
+	}
+
+	transitions := make([]order.PaymentTransition, 0, len(dto.PaymentTransitions))
+	for _, transitionDTO := range dto.PaymentTransitions {
+		transition, transitionErr := paymentTransitionToDomain(transitionDTO)
+		if transitionErr != nil {
+			return nil, transitionErr
+		}
+		transitions = append(transitions, transition)
+	}
+
+	err = o.RestorePayment(order.PaymentMethod(dto.PaymentMethod), order.PaymentStatus(dto.PaymentStatus), transitions)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = o.RestoreDeliveryTier(order.DeliveryTier(dto.DeliveryTier), dto.BatchClosesAt); err != nil {
+		return nil, err
+	}
+
+	if err = o.RestoreTemperatureClass(order.TemperatureClass(dto.TemperatureClass)); err != nil {
+		return nil, err
+	}
+
+	if err = o.RestorePriority(order.Priority(dto.Priority)); err != nil {
+		return nil, err
+	}
+
+	err = o.RestoreInsurance(dto.DeclaredValue, dto.InsuranceRequired, dto.PickupConfirmedAt, dto.DeliveryConfirmedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if dto.ExternalMarketplace != nil && dto.ExternalOrderID != nil {
+		deepLink := ""
+		if dto.ExternalDeepLink != nil {
+			deepLink = *dto.ExternalDeepLink
+		}
+		ref, refErr := order.NewExternalReference(*dto.ExternalMarketplace, *dto.ExternalOrderID, deepLink)
+		if refErr != nil {
+			return nil, refErr
+		}
+
+		if err = o.LinkExternalReference(ref); err != nil {
+			return nil, err
+		}
+	}
+
+	if dto.BasketID != nil {
+		basketID, basketErr := kernel.UUIDFromBytes((*dto.BasketID)[:])
+		if basketErr != nil {
+			return nil, basketErr
+		}
+
+		if err = o.LinkBasket(basketID); err != nil {
+			return nil, err
+		}
+	}
+
+	var routeOrigin *kernel.Location
+	if dto.RouteOriginX != nil && dto.RouteOriginY != nil {
+		origin, originErr := kernel.NewLocation(*dto.RouteOriginX, *dto.RouteOriginY)
+		if originErr != nil {
+			return nil, originErr
+		}
+		routeOrigin = &origin
+	}
+
+	if err = o.RestoreRoute(routeOrigin, dto.RouteDeviationTicks, dto.RouteDeviatedAt); err != nil {
+		return nil, err
+	}
+
+	route, err := routeToDomain(dto, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = o.RestoreRouteStops(route, dto.ReachedStops); err != nil {
+		return nil, err
+	}
+	o.RestoreVersion(dto.Version)
+
+	return o, nil
+}
+
+// routeToDomain converts the route legs of an order DTO to the route ending at dropOff;
+// nil if the order has no pickup.
+func routeToDomain(dto OrderDTO, dropOff kernel.Location) (*order.Route, error) {
+	if dto.PickupX == nil || dto.PickupY == nil {
+		return nil, nil
+	}
+
+	pickup, err := kernel.NewLocation(*dto.PickupX, *dto.PickupY)
+	if err != nil {
+		return nil, err
+	}
+
+	waypoints := make([]kernel.Location, 0, len(dto.RouteWaypoints))
+	for _, waypointDTO := range dto.RouteWaypoints {
+		waypoint, waypointErr := kernel.NewLocation(waypointDTO.X, waypointDTO.Y)
+		if waypointErr != nil {
+			return nil, waypointErr
+		}
+		waypoints = append(waypoints, waypoint)
+	}
+
+	route, err := order.NewRoute(pickup, dropOff, waypoints...)
+	if err != nil {
+		return nil, err
+	}
+	return &route, nil
+}
+
+// messageToDomain converts a thread message DTO to its domain entity.
+func messageToDomain(dto OrderMessageDTO) (*order.Message, error) {
+	id, err := kernel.UUIDFromBytes(dto.ID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return order.NewMessage(id, order.Sender(dto.Sender), dto.Text, dto.SentAt)
+}
+
+// paymentTransitionToDomain converts a payment transition DTO to its domain entity.
+func paymentTransitionToDomain(dto OrderPaymentTransitionDTO) (order.PaymentTransition, error) {
+	id, err := kernel.UUIDFromBytes(dto.ID[:])
+	if err != nil {
+		return order.PaymentTransition{}, err
+	}
+
+	return order.NewPaymentTransition(
+		id, order.PaymentStatus(dto.FromStatus), order.PaymentStatus(dto.ToStatus), dto.Reference, dto.OccurredAt,
+	)
 }
@@ -3,14 +3,46 @@ package orderrepo
 import (
 	"context"
 	"errors"
+	"time"
 
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
 	"delivery/internal/pkg/errs"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+const (
+	// uniqueViolationState is the SQLSTATE Postgres reports for a violated unique index.
+	uniqueViolationState = "23505"
+
+	// externalReferenceIndex is the unique index of marketplace order references, declared on OrderDTO.
+	// Like basketIndex it is scoped by tenant with postgres.ApplyTenancyPolicies.
+	externalReferenceIndex = "idx_orders_external_reference"
+
+	// basketIndex is the unique index of the baskets orders are created from, declared on OrderDTO.
+	basketIndex = "idx_orders_basket_id"
+)
+
+// orderedMessages preloads thread messages in posting order.
+func orderedMessages(db *gorm.DB) *gorm.DB {
+	return db.Order("sent_at, id")
+}
+
+// orderedItems preloads item lines in the order they were given.
+func orderedItems(db *gorm.DB) *gorm.DB {
+	return db.Order("position")
+}
+
+// orderedPaymentTransitions preloads the payment audit trail in the order it was applied.
+func orderedPaymentTransitions(db *gorm.DB) *gorm.DB {
+	return db.Order("occurred_at, id")
+}
+
 // GormOrderRepository implements OrderRepository using GORM.
 type GormOrderRepository struct {
 	db      *gorm.DB
@@ -31,6 +63,8 @@ func NewGormOrderRepository(db *gorm.DB, tracker aggregateTracker) *GormOrderRep
 }
 
 // Add saves a new order to the database.
+// Returns order.ErrExternalReferenceIsAlreadyRegistered if another order refers to its marketplace order
+// and order.ErrBasketIsAlreadyOrdered if another order was created from its basket.
 func (r *GormOrderRepository) Add(ctx context.Context, aggregate *order.Order) error {
 	if err := aggregate.Validate(); err != nil {
 		return err
@@ -38,6 +72,16 @@ func (r *GormOrderRepository) Add(ctx context.Context, aggregate *order.Order) e
 
 	dto := fromDomain(aggregate)
 	if err := r.db.WithContext(ctx).Create(&dto).Error; err != nil {
+		if isUniqueViolation(err, externalReferenceIndex) {
+			return order.ErrExternalReferenceIsAlreadyRegistered
+		}
+		if isUniqueViolation(err, basketIndex) {
+			return order.ErrBasketIsAlreadyOrdered
+		}
+		return err
+	}
+
+	if err := r.stampCompletion(ctx, aggregate); err != nil {
 		return err
 	}
 
@@ -45,26 +89,84 @@ func (r *GormOrderRepository) Add(ctx context.Context, aggregate *order.Order) e
 	return nil
 }
 
-// Update saves an existing order to the database.
+// Update saves an existing order to the database and bumps its version.
+// Returns errs.ErrConcurrencyConflict if the order was updated since it was loaded.
+// Returns order.ErrExternalReferenceIsAlreadyRegistered if another order refers to its marketplace order.
 func (r *GormOrderRepository) Update(ctx context.Context, aggregate *order.Order) error {
 	if err := aggregate.Validate(); err != nil {
 		return err
 	}
 
 	dto := fromDomain(aggregate)
-	result := r.db.WithContext(ctx).Model(&OrderDTO{}).Where("id = ?", dto.ID).Updates(&dto)
+	dto.Version = aggregate.Version() + 1
+	// Select all columns so that cleared values such as the courier or the ETA are written too
+	result := r.db.WithContext(ctx).
+		Model(&OrderDTO{}).
+		Select("*").
+		Omit(clause.Associations).
+		Where("id = ? AND version = ?", dto.ID, aggregate.Version()).
+		Updates(&dto)
 	if result.Error != nil {
+		if isUniqueViolation(result.Error, externalReferenceIndex) {
+			return order.ErrExternalReferenceIsAlreadyRegistered
+		}
 		return result.Error
 	}
 
 	if result.RowsAffected == 0 {
-		return gorm.ErrRecordNotFound
+		return r.staleOrMissing(ctx, aggregate)
+	}
+
+	// Item lines never change after creation; messages and payment transitions are immutable,
+	// so only new ones need to be inserted
+	if len(dto.Messages) > 0 {
+		if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&dto.Messages).Error; err != nil {
+			return err
+		}
+	}
+	if len(dto.PaymentTransitions) > 0 {
+		if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&dto.PaymentTransitions).Error; err != nil {
+			return err
+		}
+	}
+
+	if err := r.stampCompletion(ctx, aggregate); err != nil {
+		return err
 	}
 
+	aggregate.RestoreVersion(dto.Version)
 	r.tracker.TrackAggregate(aggregate.ID(), aggregate)
 	return nil
 }
 
+// stampCompletion records when a completed order was first saved completed. The time is kept in the
+// completed_at column only, which the archival of completed orders is based on.
+func (r *GormOrderRepository) stampCompletion(ctx context.Context, aggregate *order.Order) error {
+	if aggregate.Status() != order.Completed {
+		return nil
+	}
+
+	return r.db.WithContext(ctx).
+		Exec("UPDATE orders SET completed_at = now() WHERE id = ? AND completed_at IS NULL", aggregate.ID().Bytes()).
+		Error
+}
+
+// staleOrMissing explains an update that matched no row: the order was either changed by another
+// transaction since it was loaded or does not exist.
+func (r *GormOrderRepository) staleOrMissing(ctx context.Context, aggregate *order.Order) error {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&OrderDTO{}).
+		Where("id = ?", aggregate.ID().Bytes()).Count(&count).Error; err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return errs.NewConcurrencyConflictError("order", aggregate.ID().String(), aggregate.Version())
+}
+
 // Get retrieves an order by ID.
 func (r *GormOrderRepository) Get(ctx context.Context, id kernel.UUID) (*order.Order, error) {
 	if err := id.Validate(); err != nil {
@@ -72,7 +174,9 @@ func (r *GormOrderRepository) Get(ctx context.Context, id kernel.UUID) (*order.O
 	}
 
 	var dto OrderDTO
-	if err := r.db.WithContext(ctx).First(&dto, "id = ?", id.Bytes()).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("Messages", orderedMessages).Preload("Items", orderedItems).
+		Preload("PaymentTransitions", orderedPaymentTransitions).
+		First(&dto, "id = ?", id.Bytes()).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errs.NewObjectNotFoundError("order", id.String())
 		}
@@ -82,12 +186,24 @@ func (r *GormOrderRepository) Get(ctx context.Context, id kernel.UUID) (*order.O
 	return toDomain(dto)
 }
 
-// GetFirstInCreatedStatus retrieves the first order with Created status.
-func (r *GormOrderRepository) GetFirstInCreatedStatus(ctx context.Context) (*order.Order, error) {
+// isUniqueViolation reports whether err is a violation of the unique index.
+func isUniqueViolation(err error, index string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationState && pgErr.ConstraintName == index
+}
+
+// GetByTrackingToken retrieves the order shared through the tracking token.
+func (r *GormOrderRepository) GetByTrackingToken(ctx context.Context, token order.TrackingToken) (*order.Order, error) {
+	if err := token.Validate(); err != nil {
+		return nil, err
+	}
+
 	var dto OrderDTO
-	if err := r.db.WithContext(ctx).First(&dto, "status = ?", int(order.Created)).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("Messages", orderedMessages).Preload("Items", orderedItems).
+		Preload("PaymentTransitions", orderedPaymentTransitions).
+		First(&dto, "tracking_token = ?", token.String()).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errs.NewObjectNotFoundError("order", "first in created status")
+			return nil, errs.NewObjectNotFoundError("order", "tracking "+token.String())
 		}
 		return nil, err
 	}
@@ -95,10 +211,131 @@ func (r *GormOrderRepository) GetFirstInCreatedStatus(ctx context.Context) (*ord
 	return toDomain(dto)
 }
 
+// GetByBasketID retrieves the order created from the basket.
+// Returns an ObjectNotFoundError if no order was created from it.
+func (r *GormOrderRepository) GetByBasketID(ctx context.Context, basketID kernel.UUID) (*order.Order, error) {
+	if err := basketID.Validate(); err != nil {
+		return nil, err
+	}
+
+	var dto OrderDTO
+	if err := r.db.WithContext(ctx).Preload("Messages", orderedMessages).Preload("Items", orderedItems).
+		Preload("PaymentTransitions", orderedPaymentTransitions).
+		First(&dto, "basket_id = ?", basketID.Bytes()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewObjectNotFoundError("order", "basket "+basketID.String())
+		}
+		return nil, err
+	}
+
+	return toDomain(dto)
+}
+
+// GetNextDispatchable retrieves the order in Created status to dispatch next: the most urgent one,
+// and the one created first among equally urgent orders. Orders held for fraud review are skipped
+// until they are approved, orders paid online are skipped until they are paid, and economy orders
+// until their batch is released.
+func (r *GormOrderRepository) GetNextDispatchable(ctx context.Context) (*order.Order, error) {
+	var dto OrderDTO
+	if err := r.dispatchable(ctx).Take(&dto).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewObjectNotFoundError("order", "next dispatchable")
+		}
+		return nil, err
+	}
+
+	return toDomain(dto)
+}
+
+// GetDispatchable retrieves at most limit orders to dispatch, in the order GetNextDispatchable
+// returns them one by one.
+func (r *GormOrderRepository) GetDispatchable(ctx context.Context, limit int) ([]*order.Order, error) {
+	var dtos []OrderDTO
+	if err := r.dispatchable(ctx).Limit(limit).Find(&dtos).Error; err != nil {
+		return nil, err
+	}
+
+	orders := make([]*order.Order, 0, len(dtos))
+	for _, dto := range dtos {
+		o, err := toDomain(dto)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, o)
+	}
+
+	return orders, nil
+}
+
+// dispatchable selects the orders waiting for dispatch, the next one to dispatch first.
+func (r *GormOrderRepository) dispatchable(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Preload("Messages", orderedMessages).Preload("Items", orderedItems).
+		Preload("PaymentTransitions", orderedPaymentTransitions).
+		Order("priority DESC, created_at, id").
+		Where("status = ? AND review_reason = '' AND batch_closes_at IS NULL AND "+
+			"(payment_status = ? OR (payment_method = ? AND payment_status = ?))",
+			int(order.Created), int(order.PaymentPaid), int(order.CashOnDelivery), int(order.PaymentPending))
+}
+
+// GetIDsMatching retrieves the IDs of at most limit orders in the status of the filter, delivered within
+// its zone and created before its cutoff when it sets them, earliest created first.
+func (r *GormOrderRepository) GetIDsMatching(ctx context.Context, filter ports.OrderFilter, limit int) ([]kernel.UUID, error) {
+	query := r.db.WithContext(ctx).Model(&OrderDTO{}).Where("status = ?", int(filter.Status))
+	if filter.Zone != nil {
+		query = query.Where("location_x BETWEEN ? AND ? AND location_y BETWEEN ? AND ?",
+			filter.Zone.From().X(), filter.Zone.To().X(), filter.Zone.From().Y(), filter.Zone.To().Y())
+	}
+	if filter.CreatedBefore != nil {
+		query = query.Where("created_at < ?", filter.CreatedBefore.UTC())
+	}
+	if filter.Priority != nil {
+		query = query.Where("priority = ?", int(*filter.Priority))
+	}
+
+	var rows []uuid.UUID
+	if err := query.Order("created_at, id").Limit(limit).Pluck("id", &rows).Error; err != nil {
+		return nil, err
+	}
+
+	ids := make([]kernel.UUID, 0, len(rows))
+	for _, row := range rows {
+		id, err := kernel.UUIDFromBytes(row[:])
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// GetAllAwaitingBatch retrieves all economy orders whose batch was not released yet.
+func (r *GormOrderRepository) GetAllAwaitingBatch(ctx context.Context) ([]*order.Order, error) {
+	var dtos []OrderDTO
+	if err := r.db.WithContext(ctx).Preload("Messages", orderedMessages).Preload("Items", orderedItems).
+		Preload("PaymentTransitions", orderedPaymentTransitions).
+		Order("batch_closes_at").
+		Find(&dtos, "batch_closes_at IS NOT NULL").Error; err != nil {
+		return nil, err
+	}
+
+	orders := make([]*order.Order, 0, len(dtos))
+	for _, dto := range dtos {
+		o, err := toDomain(dto)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, o)
+	}
+
+	return orders, nil
+}
+
 // GetAllInAssignedStatus retrieves all orders with Assigned status.
 func (r *GormOrderRepository) GetAllInAssignedStatus(ctx context.Context) ([]*order.Order, error) {
 	var dtos []OrderDTO
-	if err := r.db.WithContext(ctx).Find(&dtos, "status = ?", int(order.Assigned)).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("Messages", orderedMessages).Preload("Items", orderedItems).
+		Preload("PaymentTransitions", orderedPaymentTransitions).
+		Find(&dtos, "status = ?", int(order.Assigned)).Error; err != nil {
 		return nil, err
 	}
 
@@ -113,3 +350,94 @@ func (r *GormOrderRepository) GetAllInAssignedStatus(ctx context.Context) ([]*or
 
 	return orders, nil
 }
+
+// ArchiveCompleted moves at most limit orders completed before the cutoff, earliest completed
+// first, from the orders table to the orders_archive table and returns how many were moved.
+// Orders completed before completion times were recorded count as completed when first seen here.
+// Orders locked by another transaction are left to a later call.
+func (r *GormOrderRepository) ArchiveCompleted(ctx context.Context, completedBefore time.Time, limit int) (int, error) {
+	db := r.db.WithContext(ctx)
+	if err := db.Exec("UPDATE orders SET completed_at = now() WHERE status = ? AND completed_at IS NULL",
+		int(order.Completed)).Error; err != nil {
+		return 0, err
+	}
+
+	var dtos []OrderDTO
+	if err := db.Preload("Messages", orderedMessages).Preload("Items", orderedItems).
+		Preload("PaymentTransitions", orderedPaymentTransitions).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Order("completed_at, id").
+		Limit(limit).
+		Find(&dtos, "status = ? AND completed_at < ?", int(order.Completed), completedBefore).Error; err != nil {
+		return 0, err
+	}
+	if len(dtos) == 0 {
+		return 0, nil
+	}
+
+	archivedAt := time.Now().UTC()
+	archived := make([]ArchivedOrderDTO, 0, len(dtos))
+	ids := make([]uuid.UUID, 0, len(dtos))
+	for _, dto := range dtos {
+		archived = append(archived, ArchivedOrderDTO{
+			ID:          dto.ID,
+			CompletedAt: dto.CompletedAt.UTC(),
+			ArchivedAt:  archivedAt,
+			Snapshot:    dto,
+		})
+		ids = append(ids, dto.ID)
+	}
+
+	if err := db.Create(&archived).Error; err != nil {
+		return 0, err
+	}
+	// Messages, items and payment transitions are removed with their order
+	if err := db.Delete(&OrderDTO{}, "id IN ?", ids).Error; err != nil {
+		return 0, err
+	}
+
+	return len(dtos), nil
+}
+
+// GetArchived retrieves an archived order by ID as it was when it was archived.
+// Returns an ObjectNotFoundError if no archived order has the ID.
+func (r *GormOrderRepository) GetArchived(ctx context.Context, id kernel.UUID) (*order.Order, error) {
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+
+	var dto ArchivedOrderDTO
+	if err := r.db.WithContext(ctx).First(&dto, "id = ?", id.Bytes()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewObjectNotFoundError("archived order", id.String())
+		}
+		return nil, err
+	}
+
+	return toDomain(dto.Snapshot)
+}
+
+// GetAllArchivedCompletedBetween retrieves the archived orders completed within [from, to),
+// earliest completed first.
+func (r *GormOrderRepository) GetAllArchivedCompletedBetween(
+	ctx context.Context,
+	from, to time.Time,
+) ([]*order.Order, error) {
+	var dtos []ArchivedOrderDTO
+	if err := r.db.WithContext(ctx).
+		Order("completed_at, id").
+		Find(&dtos, "completed_at >= ? AND completed_at < ?", from, to).Error; err != nil {
+		return nil, err
+	}
+
+	orders := make([]*order.Order, 0, len(dtos))
+	for _, dto := range dtos {
+		o, err := toDomain(dto.Snapshot)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, o)
+	}
+
+	return orders, nil
+}
@@ -9,14 +9,12 @@ import (
 	"delivery/internal/adapters/out/postgres/orderrepo"
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
 	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/pgtest"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
-	"github.com/testcontainers/testcontainers-go"
-	"github.com/testcontainers/testcontainers-go/modules/postgres"
-	"github.com/testcontainers/testcontainers-go/wait"
-	postgresdriver "gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
@@ -33,45 +31,26 @@ func (m *MockAggregateTracker) TrackAggregate(id kernel.UUID, aggregate any) {
 // using PostgreSQL containers to verify database persistence behavior.
 type OrderRepositoryIntegrationTestSuite struct {
 	suite.Suite
-	container  *postgres.PostgresContainer
+	template   *pgtest.Template
 	db         *gorm.DB
 	repository *orderrepo.GormOrderRepository
 	tracker    *MockAggregateTracker
 }
 
 func (suite *OrderRepositoryIntegrationTestSuite) SetupSuite() {
-	ctx := context.Background()
-
-	// Start PostgreSQL container
-	container, err := postgres.Run(ctx,
-		"postgres:15-alpine",
-		postgres.WithDatabase("testdb"),
-		postgres.WithUsername("testuser"),
-		postgres.WithPassword("testpass"),
-		testcontainers.WithWaitStrategy(
-			wait.ForLog("database system is ready to accept connections").
-				WithOccurrence(2).
-				WithStartupTimeout(30*time.Second),
-		),
-	)
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&orderrepo.OrderDTO{}, &orderrepo.OrderMessageDTO{}, &orderrepo.OrderItemDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{}, &orderrepo.ArchivedOrderDTO{},
+		)
+	})
 	suite.Require().NoError(err)
-	suite.container = container
-
-	// Get connection string and connect to database
-	connStr, err := container.ConnectionString(ctx, "sslmode=disable")
-	suite.Require().NoError(err)
-
-	db, err := gorm.Open(postgresdriver.Open(connStr), &gorm.Config{})
-	suite.Require().NoError(err)
-	suite.db = db
-
-	// Auto-migrate the schema
-	suite.Require().NoError(db.AutoMigrate(&orderrepo.OrderDTO{}))
+	suite.template = template
 }
 
 func (suite *OrderRepositoryIntegrationTestSuite) SetupTest() {
-	// Clean the database before each test
-	suite.Require().NoError(suite.db.Exec("TRUNCATE TABLE orders").Error)
+	// Every test works in a fresh clone of the migrated database
+	suite.db = suite.template.NewDatabase(suite.T())
 
 	// Create fresh repository and tracker for each test
 	suite.tracker = new(MockAggregateTracker)
@@ -79,8 +58,8 @@ func (suite *OrderRepositoryIntegrationTestSuite) SetupTest() {
 }
 
 func (suite *OrderRepositoryIntegrationTestSuite) TearDownSuite() {
-	if suite.container != nil {
-		suite.Require().NoError(suite.container.Terminate(context.Background()))
+	if suite.template != nil {
+		suite.Require().NoError(suite.template.Terminate(context.Background()))
 	}
 }
 
@@ -190,6 +169,37 @@ func (suite *OrderRepositoryIntegrationTestSuite) TestGet_ExistingOrder_ReturnsO
 	suite.tracker.AssertExpectations(suite.T())
 }
 
+func (suite *OrderRepositoryIntegrationTestSuite) TestGet_OrderItems_RestoredInOrder() {
+	ctx := context.Background()
+
+	location, err := kernel.NewLocation(3, 4)
+	suite.Require().NoError(err)
+	books, err := order.NewItem("BOOK-1", 2, 3)
+	suite.Require().NoError(err)
+	lamp, err := order.NewItem("LAMP-7", 1, 4)
+	suite.Require().NoError(err)
+
+	originalOrder, err := order.NewOrderWithItems(kernel.NewUUID(), location, []order.Item{lamp, books})
+	suite.Require().NoError(err)
+	suite.tracker.On("TrackAggregate", originalOrder.ID(), originalOrder).Twice()
+	suite.Require().NoError(suite.repository.Add(ctx, originalOrder))
+
+	// Updating the order must leave its item lines untouched
+	suite.Require().NoError(originalOrder.Assign(kernel.NewUUID()))
+	suite.Require().NoError(suite.repository.Update(ctx, originalOrder))
+
+	retrievedOrder, err := suite.repository.Get(ctx, originalOrder.ID())
+	suite.Require().NoError(err)
+	suite.Equal(10, retrievedOrder.Volume())
+	suite.Require().Len(retrievedOrder.Items(), 2)
+	suite.Equal("LAMP-7", retrievedOrder.Items()[0].SKU())
+	suite.Equal("BOOK-1", retrievedOrder.Items()[1].SKU())
+	suite.Equal(2, retrievedOrder.Items()[1].Quantity())
+	suite.Equal(3, retrievedOrder.Items()[1].UnitVolume())
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
 func (suite *OrderRepositoryIntegrationTestSuite) TestGet_NonExistentOrder_ReturnsNotFoundError() {
 	ctx := context.Background()
 
@@ -308,7 +318,463 @@ func (suite *OrderRepositoryIntegrationTestSuite) TestUpdate_NonExistentOrder_Re
 	suite.tracker.AssertExpectations(suite.T())
 }
 
-func (suite *OrderRepositoryIntegrationTestSuite) TestGetFirstInCreatedStatus_OrdersExist_ReturnsFirstCreatedOrder() {
+func (suite *OrderRepositoryIntegrationTestSuite) TestUpdate_StaleOrder_ReturnsConcurrencyConflict() {
+	ctx := context.Background()
+	testOrder := suite.createTestOrder()
+	suite.tracker.On("TrackAggregate", mock.Anything, mock.Anything)
+	suite.Require().NoError(suite.repository.Add(ctx, testOrder))
+
+	first, err := suite.repository.Get(ctx, testOrder.ID())
+	suite.Require().NoError(err)
+	second, err := suite.repository.Get(ctx, testOrder.ID())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(first.Assign(kernel.NewUUID()))
+	suite.Require().NoError(suite.repository.Update(ctx, first))
+	suite.Equal(1, first.Version())
+
+	suite.Require().NoError(second.Assign(kernel.NewUUID()))
+	err = suite.repository.Update(ctx, second)
+
+	suite.Require().ErrorIs(err, errs.ErrConcurrencyConflict)
+	stored, err := suite.repository.Get(ctx, testOrder.ID())
+	suite.Require().NoError(err)
+	suite.Equal(1, stored.Version())
+	suite.True(stored.Courier().IsEqual(*first.Courier()))
+
+	// The winner keeps updating the order it holds
+	suite.Require().NoError(suite.repository.Update(ctx, first))
+	suite.Equal(2, first.Version())
+}
+
+func (suite *OrderRepositoryIntegrationTestSuite) TestUpdate_ThreadMessages_PersistedInOrder() {
+	ctx := context.Background()
+
+	// Create and add order with one message
+	testOrder := suite.createTestOrder()
+	sentAt := time.Now().Truncate(time.Microsecond)
+	_, err := testOrder.PostMessage(order.DispatcherSender, "Ring twice", sentAt)
+	suite.Require().NoError(err)
+
+	suite.tracker.On("TrackAggregate", testOrder.ID(), testOrder).Twice()
+	suite.Require().NoError(suite.repository.Add(ctx, testOrder))
+
+	// Post a reply and update the order
+	_, err = testOrder.PostMessage(order.CourierSender, "Ok", sentAt.Add(time.Second))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.repository.Update(ctx, testOrder))
+
+	// Retrieve and verify the thread
+	retrievedOrder, err := suite.repository.Get(ctx, testOrder.ID())
+	suite.Require().NoError(err)
+
+	messages := retrievedOrder.Messages()
+	suite.Require().Len(messages, 2)
+	suite.Equal(order.DispatcherSender, messages[0].Sender())
+	suite.Equal("Ring twice", messages[0].Text())
+	suite.True(sentAt.Equal(messages[0].SentAt()))
+	suite.Equal(order.CourierSender, messages[1].Sender())
+	suite.Equal("Ok", messages[1].Text())
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *OrderRepositoryIntegrationTestSuite) TestUpdate_TrackingToken_Persisted() {
+	ctx := context.Background()
+
+	testOrder := suite.createTestOrder()
+	suite.tracker.On("TrackAggregate", testOrder.ID(), testOrder).Twice()
+	suite.Require().NoError(suite.repository.Add(ctx, testOrder))
+
+	token, err := testOrder.ShareTracking()
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.repository.Update(ctx, testOrder))
+
+	retrievedOrder, err := suite.repository.Get(ctx, testOrder.ID())
+	suite.Require().NoError(err)
+	suite.Require().NotNil(retrievedOrder.TrackingToken())
+	suite.True(retrievedOrder.TrackingToken().IsEqual(token))
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *OrderRepositoryIntegrationTestSuite) TestGetByTrackingToken_TippedOrder_ReturnsOrderWithTip() {
+	ctx := context.Background()
+
+	testOrder := suite.createTestOrder()
+	token, err := testOrder.ShareTracking()
+	suite.Require().NoError(err)
+	suite.tracker.On("TrackAggregate", testOrder.ID(), testOrder).Twice()
+	suite.Require().NoError(suite.repository.Add(ctx, testOrder))
+
+	suite.Require().NoError(testOrder.Assign(kernel.NewUUID()))
+	suite.Require().NoError(testOrder.Complete())
+	tippedAt := time.Now().UTC().Truncate(time.Microsecond)
+	tip, err := order.NewTip(250, "key-1", tippedAt)
+	suite.Require().NoError(err)
+	_, err = testOrder.AddTip(tip)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.repository.Update(ctx, testOrder))
+
+	retrievedOrder, err := suite.repository.GetByTrackingToken(ctx, token)
+	suite.Require().NoError(err)
+	suite.Equal(testOrder.ID(), retrievedOrder.ID())
+	suite.Require().NotNil(retrievedOrder.Tip())
+	suite.Equal(250, retrievedOrder.Tip().Amount())
+	suite.Equal("key-1", retrievedOrder.Tip().IdempotencyKey())
+	suite.True(tippedAt.Equal(retrievedOrder.Tip().TippedAt()))
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *OrderRepositoryIntegrationTestSuite) TestUpdate_Rating_Persisted() {
+	ctx := context.Background()
+
+	testOrder := suite.createTestOrder()
+	suite.tracker.On("TrackAggregate", testOrder.ID(), testOrder).Twice()
+	suite.Require().NoError(suite.repository.Add(ctx, testOrder))
+
+	suite.Require().NoError(testOrder.Assign(kernel.NewUUID()))
+	suite.Require().NoError(testOrder.Complete())
+	ratedAt := time.Now().UTC().Truncate(time.Microsecond)
+	rating, err := order.NewRating(4, ratedAt)
+	suite.Require().NoError(err)
+	_, err = testOrder.Rate(rating)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.repository.Update(ctx, testOrder))
+
+	retrievedOrder, err := suite.repository.Get(ctx, testOrder.ID())
+	suite.Require().NoError(err)
+	suite.Require().NotNil(retrievedOrder.Rating())
+	suite.Equal(4, retrievedOrder.Rating().Stars())
+	suite.True(ratedAt.Equal(retrievedOrder.Rating().RatedAt()))
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *OrderRepositoryIntegrationTestSuite) TestGetByTrackingToken_UnknownToken_ReturnsNotFoundError() {
+	_, err := suite.repository.GetByTrackingToken(context.Background(), order.NewTrackingToken())
+
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+}
+
+func (suite *OrderRepositoryIntegrationTestSuite) TestUpdate_EstimatedArrival_PersistedAndCleared() {
+	ctx := context.Background()
+
+	testOrder := suite.createTestOrder()
+	suite.tracker.On("TrackAggregate", testOrder.ID(), testOrder).Times(3)
+	suite.Require().NoError(suite.repository.Add(ctx, testOrder))
+
+	suite.Require().NoError(testOrder.Assign(kernel.NewUUID()))
+	calculatedAt := time.Now().UTC().Truncate(time.Microsecond)
+	eta, err := order.NewEstimatedArrival(6, calculatedAt)
+	suite.Require().NoError(err)
+	suite.Require().NoError(testOrder.RecordEstimatedArrival(eta))
+	suite.Require().NoError(suite.repository.Update(ctx, testOrder))
+
+	retrievedOrder, err := suite.repository.Get(ctx, testOrder.ID())
+	suite.Require().NoError(err)
+	suite.Require().NotNil(retrievedOrder.EstimatedArrival())
+	suite.Equal(6, retrievedOrder.EstimatedArrival().Turns())
+	suite.True(calculatedAt.Equal(retrievedOrder.EstimatedArrival().CalculatedAt()))
+
+	// Unassigning clears the courier and the estimate, both must be written back as NULL
+	suite.Require().NoError(testOrder.Unassign())
+	suite.Require().NoError(suite.repository.Update(ctx, testOrder))
+
+	retrievedOrder, err = suite.repository.Get(ctx, testOrder.ID())
+	suite.Require().NoError(err)
+	suite.Nil(retrievedOrder.Courier())
+	suite.Nil(retrievedOrder.EstimatedArrival())
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *OrderRepositoryIntegrationTestSuite) TestAdd_DeliveryWindow_Persisted() {
+	ctx := context.Background()
+
+	testOrder := suite.createTestOrder()
+	from := time.Now().UTC().Truncate(time.Microsecond)
+	window, err := order.NewDeliveryWindow(from, from.Add(4*time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(testOrder.ScheduleDelivery(window))
+	suite.tracker.On("TrackAggregate", testOrder.ID(), testOrder).Once()
+	suite.Require().NoError(suite.repository.Add(ctx, testOrder))
+
+	retrievedOrder, err := suite.repository.Get(ctx, testOrder.ID())
+	suite.Require().NoError(err)
+	suite.Require().NotNil(retrievedOrder.DeliveryWindow())
+	suite.True(window.From().Equal(retrievedOrder.DeliveryWindow().From()))
+	suite.True(window.To().Equal(retrievedOrder.DeliveryWindow().To()))
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *OrderRepositoryIntegrationTestSuite) TestGetDispatchable_ReturnsMostUrgentFirstUpToLimit() {
+	ctx := context.Background()
+
+	normalOrder := suite.createTestOrder()
+	urgentOrder := suite.createTestOrder()
+	suite.Require().NoError(urgentOrder.ChangePriority(order.UrgentPriority))
+	heldOrder := suite.createTestOrder()
+	suite.Require().NoError(heldOrder.HoldForReview("blacklisted address"))
+	lowOrder := suite.createTestOrder()
+	suite.Require().NoError(lowOrder.ChangePriority(order.LowPriority))
+	for _, o := range []*order.Order{normalOrder, urgentOrder, heldOrder, lowOrder} {
+		suite.tracker.On("TrackAggregate", o.ID(), o).Once()
+		suite.Require().NoError(suite.repository.Add(ctx, o))
+	}
+
+	pending, err := suite.repository.GetDispatchable(ctx, 2)
+	suite.Require().NoError(err)
+	suite.Require().Len(pending, 2)
+	suite.Equal(urgentOrder.ID(), pending[0].ID())
+	suite.Equal(normalOrder.ID(), pending[1].ID())
+
+	all, err := suite.repository.GetDispatchable(ctx, 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(all, 3)
+	suite.Equal(lowOrder.ID(), all[2].ID())
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *OrderRepositoryIntegrationTestSuite) TestGetIDsMatching_FiltersByStatusZoneAndCreationTime() {
+	ctx := context.Background()
+	suite.tracker.On("TrackAggregate", mock.Anything, mock.Anything)
+	now := time.Now().UTC()
+
+	add := func(x, y kernel.Coordinate, status order.Status, createdAt time.Time) kernel.UUID {
+		location, err := kernel.NewLocation(x, y)
+		suite.Require().NoError(err)
+		var courierID *kernel.UUID
+		if status == order.Assigned {
+			id := kernel.NewUUID()
+			courierID = &id
+		}
+		o, err := order.RestoreOrder(kernel.NewUUID(), location, 10, status, courierID)
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.repository.Add(ctx, o))
+		suite.Require().NoError(suite.db.Exec("UPDATE orders SET created_at = ? WHERE id = ?",
+			createdAt, o.ID().Bytes()).Error)
+		return o.ID()
+	}
+	oldest := add(2, 2, order.Created, now.Add(-3*time.Hour))
+	older := add(3, 3, order.Created, now.Add(-2*time.Hour))
+	outside := add(8, 8, order.Created, now.Add(-90*time.Minute))
+	recent := add(2, 2, order.Created, now)
+	add(2, 2, order.Assigned, now.Add(-2*time.Hour))
+
+	from, err := kernel.NewLocation(1, 1)
+	suite.Require().NoError(err)
+	to, err := kernel.NewLocation(4, 4)
+	suite.Require().NoError(err)
+	zone, err := kernel.NewZone(from, to)
+	suite.Require().NoError(err)
+	cutoff := now.Add(-time.Hour)
+
+	matching, err := suite.repository.GetIDsMatching(ctx,
+		ports.OrderFilter{Status: order.Created, Zone: &zone, CreatedBefore: &cutoff}, 10)
+	suite.Require().NoError(err)
+	suite.Equal([]kernel.UUID{oldest, older}, matching)
+
+	limited, err := suite.repository.GetIDsMatching(ctx, ports.OrderFilter{Status: order.Created}, 3)
+	suite.Require().NoError(err)
+	suite.Equal([]kernel.UUID{oldest, older, outside}, limited)
+
+	all, err := suite.repository.GetIDsMatching(ctx, ports.OrderFilter{Status: order.Created}, 10)
+	suite.Require().NoError(err)
+	suite.Len(all, 4)
+	suite.Equal(recent, all[3])
+}
+
+func (suite *OrderRepositoryIntegrationTestSuite) TestGetIDsMatching_FiltersByPriority() {
+	ctx := context.Background()
+	suite.tracker.On("TrackAggregate", mock.Anything, mock.Anything)
+
+	urgentOrder := suite.createTestOrder()
+	suite.Require().NoError(urgentOrder.ChangePriority(order.UrgentPriority))
+	suite.Require().NoError(suite.repository.Add(ctx, urgentOrder))
+	suite.Require().NoError(suite.repository.Add(ctx, suite.createTestOrder()))
+
+	urgent := order.UrgentPriority
+	matching, err := suite.repository.GetIDsMatching(ctx,
+		ports.OrderFilter{Status: order.Created, Priority: &urgent}, 10)
+	suite.Require().NoError(err)
+	suite.Equal([]kernel.UUID{urgentOrder.ID()}, matching)
+}
+
+func (suite *OrderRepositoryIntegrationTestSuite) TestGetNextDispatchable_OrderUnderReview_IsSkipped() {
+	ctx := context.Background()
+
+	heldOrder := suite.createTestOrder()
+	suite.Require().NoError(heldOrder.HoldForReview("blacklisted address"))
+	suite.tracker.On("TrackAggregate", heldOrder.ID(), heldOrder).Once()
+	suite.Require().NoError(suite.repository.Add(ctx, heldOrder))
+
+	_, err := suite.repository.GetNextDispatchable(ctx)
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+
+	// The hold survives a round trip through the database
+	retrievedOrder, err := suite.repository.Get(ctx, heldOrder.ID())
+	suite.Require().NoError(err)
+	suite.True(retrievedOrder.IsUnderReview())
+	suite.Equal("blacklisted address", retrievedOrder.ReviewReason())
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *OrderRepositoryIntegrationTestSuite) TestGetNextDispatchable_OrderAwaitingPayment_IsSkippedUntilPaid() {
+	ctx := context.Background()
+
+	onlineOrder := suite.createTestOrder()
+	suite.Require().NoError(onlineOrder.ChangePaymentMethod(order.OnlinePayment))
+	suite.tracker.On("TrackAggregate", onlineOrder.ID(), onlineOrder).Times(3)
+	suite.Require().NoError(suite.repository.Add(ctx, onlineOrder))
+
+	_, err := suite.repository.GetNextDispatchable(ctx)
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+
+	paidAt := time.Now().UTC().Truncate(time.Microsecond)
+	_, err = onlineOrder.ApplyPaymentEvent(order.PaymentPaid, "evt_1", paidAt)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.repository.Update(ctx, onlineOrder))
+	// Saving again does not duplicate the audit trail
+	suite.Require().NoError(suite.repository.Update(ctx, onlineOrder))
+
+	retrievedOrder, err := suite.repository.GetNextDispatchable(ctx)
+	suite.Require().NoError(err)
+	suite.Equal(onlineOrder.ID(), retrievedOrder.ID())
+	suite.Equal(order.OnlinePayment, retrievedOrder.PaymentMethod())
+	suite.Equal(order.PaymentPaid, retrievedOrder.PaymentStatus())
+	suite.Require().Len(retrievedOrder.PaymentTransitions(), 1)
+	suite.Equal("evt_1", retrievedOrder.PaymentTransitions()[0].Reference())
+	suite.True(paidAt.Equal(retrievedOrder.PaymentTransitions()[0].OccurredAt()))
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *OrderRepositoryIntegrationTestSuite) TestGetNextDispatchable_EconomyOrder_IsSkippedUntilReleased() {
+	ctx := context.Background()
+	window, err := order.NewBatchingWindow(30 * time.Minute)
+	suite.Require().NoError(err)
+
+	economyOrder := suite.createTestOrder()
+	suite.Require().NoError(economyOrder.ChangeDeliveryTier(order.EconomyDelivery, window, time.Now().Add(-time.Hour)))
+	suite.tracker.On("TrackAggregate", economyOrder.ID(), economyOrder).Times(2)
+	suite.Require().NoError(suite.repository.Add(ctx, economyOrder))
+
+	_, err = suite.repository.GetNextDispatchable(ctx)
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+
+	awaiting, err := suite.repository.GetAllAwaitingBatch(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(awaiting, 1)
+	suite.Equal(order.EconomyDelivery, awaiting[0].DeliveryTier())
+	suite.True(economyOrder.BatchClosesAt().Equal(*awaiting[0].BatchClosesAt()))
+
+	suite.Require().True(economyOrder.ReleaseBatch(time.Now()))
+	suite.Require().NoError(suite.repository.Update(ctx, economyOrder))
+
+	retrievedOrder, err := suite.repository.GetNextDispatchable(ctx)
+	suite.Require().NoError(err)
+	suite.Equal(economyOrder.ID(), retrievedOrder.ID())
+	suite.Equal(order.EconomyDelivery, retrievedOrder.DeliveryTier())
+	suite.False(retrievedOrder.IsAwaitingBatch())
+
+	awaiting, err = suite.repository.GetAllAwaitingBatch(ctx)
+	suite.Require().NoError(err)
+	suite.Empty(awaiting)
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *OrderRepositoryIntegrationTestSuite) TestUpdate_DeclaredValueAndConfirmations_Persisted() {
+	ctx := context.Background()
+	threshold, err := order.NewInsuranceThreshold(100_000)
+	suite.Require().NoError(err)
+	pickedUpAt := time.Now().UTC().Truncate(time.Second)
+
+	insuredOrder := suite.createTestOrder()
+	suite.Require().NoError(insuredOrder.DeclareValue(250_000, threshold))
+	suite.tracker.On("TrackAggregate", insuredOrder.ID(), insuredOrder).Times(2)
+	suite.Require().NoError(suite.repository.Add(ctx, insuredOrder))
+
+	suite.Require().NoError(insuredOrder.Assign(kernel.NewUUID()))
+	suite.Require().NoError(insuredOrder.ConfirmPickup(pickedUpAt))
+	suite.Require().NoError(suite.repository.Update(ctx, insuredOrder))
+
+	retrievedOrder, err := suite.repository.Get(ctx, insuredOrder.ID())
+	suite.Require().NoError(err)
+	suite.Equal(250_000, retrievedOrder.DeclaredValue())
+	suite.True(retrievedOrder.IsInsuranceRequired())
+	suite.Require().NotNil(retrievedOrder.PickupConfirmedAt())
+	suite.True(pickedUpAt.Equal(*retrievedOrder.PickupConfirmedAt()))
+	suite.Nil(retrievedOrder.DeliveryConfirmedAt())
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *OrderRepositoryIntegrationTestSuite) TestAdd_ExternalReference_PersistedAndUniquePerMarketplace() {
+	ctx := context.Background()
+	ref, err := order.NewExternalReference("ozon", "48213377-0021", "https://ozon.ru/orders/48213377-0021")
+	suite.Require().NoError(err)
+
+	linkedOrder := suite.createTestOrder()
+	suite.Require().NoError(linkedOrder.LinkExternalReference(ref))
+	suite.tracker.On("TrackAggregate", linkedOrder.ID(), linkedOrder).Once()
+	suite.Require().NoError(suite.repository.Add(ctx, linkedOrder))
+
+	retrievedOrder, err := suite.repository.Get(ctx, linkedOrder.ID())
+	suite.Require().NoError(err)
+	suite.Require().NotNil(retrievedOrder.ExternalReference())
+	suite.Equal("ozon", retrievedOrder.ExternalReference().Marketplace())
+	suite.Equal("48213377-0021", retrievedOrder.ExternalReference().OrderID())
+	suite.Equal("https://ozon.ru/orders/48213377-0021", retrievedOrder.ExternalReference().DeepLink())
+
+	// The same external order ID on another marketplace is a different order
+	otherMarketplace, err := order.NewExternalReference("wildberries", "48213377-0021", "")
+	suite.Require().NoError(err)
+	otherOrder := suite.createTestOrder()
+	suite.Require().NoError(otherOrder.LinkExternalReference(otherMarketplace))
+	suite.tracker.On("TrackAggregate", otherOrder.ID(), otherOrder).Once()
+	suite.Require().NoError(suite.repository.Add(ctx, otherOrder))
+
+	duplicate := suite.createTestOrder()
+	suite.Require().NoError(duplicate.LinkExternalReference(ref))
+	err = suite.repository.Add(ctx, duplicate)
+	suite.Require().ErrorIs(err, order.ErrExternalReferenceIsAlreadyRegistered)
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *OrderRepositoryIntegrationTestSuite) TestAdd_Basket_PersistedAndOrderedOnce() {
+	ctx := context.Background()
+	basketID := kernel.NewUUID()
+
+	basketOrder := suite.createTestOrder()
+	suite.Require().NoError(basketOrder.LinkBasket(basketID))
+	suite.tracker.On("TrackAggregate", basketOrder.ID(), basketOrder).Once()
+	suite.Require().NoError(suite.repository.Add(ctx, basketOrder))
+
+	retrievedOrder, err := suite.repository.GetByBasketID(ctx, basketID)
+	suite.Require().NoError(err)
+	suite.Equal(basketOrder.ID(), retrievedOrder.ID())
+	suite.Require().NotNil(retrievedOrder.BasketID())
+	suite.Equal(basketID, *retrievedOrder.BasketID())
+
+	_, err = suite.repository.GetByBasketID(ctx, kernel.NewUUID())
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+
+	duplicate := suite.createTestOrder()
+	suite.Require().NoError(duplicate.LinkBasket(basketID))
+	err = suite.repository.Add(ctx, duplicate)
+	suite.Require().ErrorIs(err, order.ErrBasketIsAlreadyOrdered)
+
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *OrderRepositoryIntegrationTestSuite) TestGetNextDispatchable_OrdersExist_ReturnsFirstCreatedOrder() {
 	ctx := context.Background()
 
 	// Setup mock expectations for mixed statuses
@@ -318,7 +784,7 @@ func (suite *OrderRepositoryIntegrationTestSuite) TestGetFirstInCreatedStatus_Or
 	orders := suite.createTestOrdersWithDifferentStatuses(ctx)
 
 	// Get first order in Created status
-	retrievedOrder, err := suite.repository.GetFirstInCreatedStatus(ctx)
+	retrievedOrder, err := suite.repository.GetNextDispatchable(ctx)
 	suite.Require().NoError(err)
 
 	// Verify it's one of the created orders
@@ -338,7 +804,35 @@ func (suite *OrderRepositoryIntegrationTestSuite) TestGetFirstInCreatedStatus_Or
 	suite.tracker.AssertExpectations(suite.T())
 }
 
-func (suite *OrderRepositoryIntegrationTestSuite) TestGetFirstInCreatedStatus_NoCreatedOrders_ReturnsNotFoundError() {
+func (suite *OrderRepositoryIntegrationTestSuite) TestGetNextDispatchable_MostUrgentThenOldestFirst() {
+	ctx := context.Background()
+	suite.tracker.On("TrackAggregate", mock.Anything, mock.Anything)
+
+	add := func(priority order.Priority) *order.Order {
+		o := suite.createTestOrder()
+		suite.Require().NoError(o.ChangePriority(priority))
+		suite.Require().NoError(suite.repository.Add(ctx, o))
+		return o
+	}
+	add(order.LowPriority)
+	firstHigh := add(order.HighPriority)
+	add(order.HighPriority)
+	urgent := add(order.UrgentPriority)
+
+	next, err := suite.repository.GetNextDispatchable(ctx)
+	suite.Require().NoError(err)
+	suite.Equal(urgent.ID(), next.ID())
+	suite.Equal(order.UrgentPriority, next.Priority())
+
+	suite.Require().NoError(next.Assign(kernel.NewUUID()))
+	suite.Require().NoError(suite.repository.Update(ctx, next))
+
+	next, err = suite.repository.GetNextDispatchable(ctx)
+	suite.Require().NoError(err)
+	suite.Equal(firstHigh.ID(), next.ID())
+}
+
+func (suite *OrderRepositoryIntegrationTestSuite) TestGetNextDispatchable_NoCreatedOrders_ReturnsNotFoundError() {
 	ctx := context.Background()
 
 	// Setup mock expectations for the orders we'll create
@@ -349,7 +843,7 @@ func (suite *OrderRepositoryIntegrationTestSuite) TestGetFirstInCreatedStatus_No
 	suite.createOrderWithStatus(ctx, order.Completed)
 
 	// Try to get first created order
-	retrievedOrder, err := suite.repository.GetFirstInCreatedStatus(ctx)
+	retrievedOrder, err := suite.repository.GetNextDispatchable(ctx)
 
 	// Verify error and result
 	suite.Nil(retrievedOrder)
@@ -553,8 +1047,8 @@ func (suite *OrderRepositoryIntegrationTestSuite) TestOrderRepository_QueryLogic
 	err = suite.repository.Add(ctx, completedOrder)
 	suite.Require().NoError(err)
 
-	// Test GetFirstInCreatedStatus
-	firstCreated, err := suite.repository.GetFirstInCreatedStatus(ctx)
+	// Test GetNextDispatchable
+	firstCreated, err := suite.repository.GetNextDispatchable(ctx)
 	suite.Require().NoError(err)
 	suite.Equal(order.Created, firstCreated.Status())
 	suite.Nil(firstCreated.Courier())
@@ -571,6 +1065,125 @@ func (suite *OrderRepositoryIntegrationTestSuite) TestOrderRepository_QueryLogic
 }
 
 // TestOrderRepository_Concurrency verifies repository behavior under concurrent access.
+func (suite *OrderRepositoryIntegrationTestSuite) TestUpdate_RouteTracking_Persisted() {
+	ctx := context.Background()
+	origin, _ := kernel.NewLocation(1, 1)
+	away, _ := kernel.NewLocation(9, 9)
+	policy, _ := order.NewRouteDeviationPolicy(0, 1)
+
+	courierID := kernel.NewUUID()
+	testOrder := suite.createTestOrderWithStatus(order.Assigned, &courierID)
+	suite.tracker.On("TrackAggregate", testOrder.ID(), testOrder).Twice()
+	suite.Require().NoError(suite.repository.Add(ctx, testOrder))
+
+	_, _, err := testOrder.TrackRoute(origin, policy, time.Now())
+	suite.Require().NoError(err)
+	_, flagged, err := testOrder.TrackRoute(away, policy, time.Now())
+	suite.Require().NoError(err)
+	suite.Require().True(flagged)
+	suite.Require().NoError(suite.repository.Update(ctx, testOrder))
+
+	retrievedOrder, err := suite.repository.Get(ctx, testOrder.ID())
+	suite.Require().NoError(err)
+	suite.Require().NotNil(retrievedOrder.RouteOrigin())
+	suite.Equal(origin, *retrievedOrder.RouteOrigin())
+	suite.Equal(1, retrievedOrder.RouteDeviationTicks())
+	suite.Require().NotNil(retrievedOrder.RouteDeviatedAt())
+	suite.WithinDuration(*testOrder.RouteDeviatedAt(), *retrievedOrder.RouteDeviatedAt(), time.Millisecond)
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *OrderRepositoryIntegrationTestSuite) TestUpdate_RouteStops_Persisted() {
+	ctx := context.Background()
+	pickup, _ := kernel.NewLocation(1, 1)
+	waypoint, _ := kernel.NewLocation(4, 2)
+	dropOff, _ := kernel.NewLocation(6, 6)
+	route, _ := order.NewRoute(pickup, dropOff, waypoint)
+
+	testOrder, err := order.NewOrderWithRoute(kernel.NewUUID(), route, 5)
+	suite.Require().NoError(err)
+	suite.Require().NoError(testOrder.Assign(kernel.NewUUID()))
+	suite.tracker.On("TrackAggregate", testOrder.ID(), testOrder).Twice()
+	suite.Require().NoError(suite.repository.Add(ctx, testOrder))
+
+	_, err = testOrder.ReachStop(pickup)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.repository.Update(ctx, testOrder))
+
+	retrievedOrder, err := suite.repository.Get(ctx, testOrder.ID())
+	suite.Require().NoError(err)
+	suite.Require().NotNil(retrievedOrder.Route())
+	suite.Equal([]kernel.Location{pickup, waypoint, dropOff}, retrievedOrder.Route().Stops())
+	suite.Equal(1, retrievedOrder.ReachedStops())
+	suite.Equal(waypoint, retrievedOrder.NextStop())
+	suite.tracker.AssertExpectations(suite.T())
+}
+
+func (suite *OrderRepositoryIntegrationTestSuite) TestUpdate_CompletedOrder_StampsCompletionOnce() {
+	ctx := context.Background()
+	suite.tracker.On("TrackAggregate", mock.Anything, mock.Anything)
+	testOrder := suite.createOrderWithStatus(ctx, order.Assigned)
+	suite.Nil(suite.completedAt(testOrder.ID()))
+
+	suite.Require().NoError(testOrder.Complete())
+	suite.Require().NoError(suite.repository.Update(ctx, testOrder))
+	completedAt := suite.completedAt(testOrder.ID())
+	suite.Require().NotNil(completedAt)
+
+	suite.Require().NoError(suite.repository.Update(ctx, testOrder))
+	suite.Equal(completedAt, suite.completedAt(testOrder.ID()))
+}
+
+func (suite *OrderRepositoryIntegrationTestSuite) TestArchiveCompleted_MovesOrdersCompletedBeforeCutoff() {
+	ctx := context.Background()
+	suite.tracker.On("TrackAggregate", mock.Anything, mock.Anything)
+	now := time.Now().UTC()
+
+	old := suite.createOrderWithStatus(ctx, order.Completed)
+	suite.Require().NoError(suite.db.Exec("UPDATE orders SET completed_at = ? WHERE id = ?",
+		now.AddDate(0, 0, -40), old.ID().Bytes()).Error)
+	suite.createOrderWithStatus(ctx, order.Completed)
+	suite.createOrderWithStatus(ctx, order.Assigned)
+
+	archived, err := suite.repository.ArchiveCompleted(ctx, now.AddDate(0, 0, -30), 10)
+
+	suite.Require().NoError(err)
+	suite.Equal(1, archived)
+	suite.assertOrderCount(2)
+	_, err = suite.repository.Get(ctx, old.ID())
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+
+	restored, err := suite.repository.GetArchived(ctx, old.ID())
+	suite.Require().NoError(err)
+	suite.Equal(old.ID(), restored.ID())
+	suite.Equal(order.Completed, restored.Status())
+	suite.Equal(old.Courier(), restored.Courier())
+
+	between, err := suite.repository.GetAllArchivedCompletedBetween(ctx, now.AddDate(0, 0, -60), now)
+	suite.Require().NoError(err)
+	suite.Require().Len(between, 1)
+	suite.Equal(old.ID(), between[0].ID())
+}
+
+func (suite *OrderRepositoryIntegrationTestSuite) TestArchiveCompleted_StampsCompletedOrdersWithoutTime() {
+	ctx := context.Background()
+	suite.tracker.On("TrackAggregate", mock.Anything, mock.Anything)
+	legacy := suite.createOrderWithStatus(ctx, order.Completed)
+	suite.Require().NoError(suite.db.Exec("UPDATE orders SET completed_at = NULL").Error)
+
+	archived, err := suite.repository.ArchiveCompleted(ctx, time.Now().UTC().AddDate(0, 0, -1), 10)
+
+	suite.Require().NoError(err)
+	suite.Zero(archived)
+	suite.NotNil(suite.completedAt(legacy.ID()))
+}
+
+func (suite *OrderRepositoryIntegrationTestSuite) TestGetArchived_UnknownOrder_ReturnsNotFoundError() {
+	_, err := suite.repository.GetArchived(context.Background(), kernel.NewUUID())
+
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+}
+
 func (suite *OrderRepositoryIntegrationTestSuite) TestOrderRepository_Concurrency() {
 	ctx := context.Background()
 
@@ -631,6 +1244,14 @@ func (suite *OrderRepositoryIntegrationTestSuite) createTestOrderWithStatus(
 }
 
 // assertOrderCount verifies the number of orders in the database.
+// completedAt returns the completion time stamped for the order, nil if none was.
+func (suite *OrderRepositoryIntegrationTestSuite) completedAt(id kernel.UUID) *time.Time {
+	var completedAt *time.Time
+	suite.Require().NoError(suite.db.Raw("SELECT completed_at FROM orders WHERE id = ?", id.Bytes()).
+		Row().Scan(&completedAt))
+	return completedAt
+}
+
 func (suite *OrderRepositoryIntegrationTestSuite) assertOrderCount(expected int) {
 	var count int64
 	err := suite.db.Model(&orderrepo.OrderDTO{}).Count(&count).Error
@@ -639,5 +1260,6 @@ func (suite *OrderRepositoryIntegrationTestSuite) assertOrderCount(expected int)
 }
 
 func TestOrderRepositoryIntegrationTestSuite(t *testing.T) {
+	t.Parallel()
 	suite.Run(t, new(OrderRepositoryIntegrationTestSuite))
 }
@@ -0,0 +1,70 @@
+package outboxrepo
+
+import (
+	"encoding/json"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+)
+
+// CourierAvailabilityChangedEvent is emitted when a courier becomes free, busy or goes off shift,
+// so the workforce management system can mirror the availability of the fleet.
+const CourierAvailabilityChangedEvent = "CourierAvailabilityChanged"
+
+// courierAvailabilityPayload is the JSON body of courier availability events.
+// Version counts the availability changes of each courier from 1, the same version the pull feed
+// reports, so a consumer can drop events older than the state it holds.
+type courierAvailabilityPayload struct {
+	CourierID            string    `json:"courierId"`
+	Availability         string    `json:"availability"`
+	PreviousAvailability string    `json:"previousAvailability"`
+	Version              int64     `json:"version"`
+	ChangedAt            time.Time `json:"changedAt"`
+}
+
+// NewCourierAvailabilityChangedMessage creates the event of a courier whose availability changed
+// from previous to availability.
+// Messages are keyed by courier, so the changes of each courier arrive in order.
+func NewCourierAvailabilityChangedMessage(
+	courierID kernel.UUID,
+	availability courier.Availability,
+	previous courier.Availability,
+	version int64,
+	changedAt time.Time,
+) (ports.OutboxMessage, error) {
+	changedAt = changedAt.UTC()
+
+	body, err := json.Marshal(courierAvailabilityPayload{
+		CourierID:            courierID.String(),
+		Availability:         availabilityName(availability),
+		PreviousAvailability: availabilityName(previous),
+		Version:              version,
+		ChangedAt:            changedAt,
+	})
+	if err != nil {
+		return ports.OutboxMessage{}, err
+	}
+
+	return ports.OutboxMessage{
+		ID:          kernel.NewUUID(),
+		AggregateID: courierID.String(),
+		EventType:   CourierAvailabilityChangedEvent,
+		Payload:     body,
+		OccurredAt:  changedAt,
+	}, nil
+}
+
+// availabilityName returns the name of the availability in events:
+// "free", "busy" or "off_shift".
+func availabilityName(availability courier.Availability) string {
+	switch availability {
+	case courier.AvailabilityFree:
+		return "free"
+	case courier.AvailabilityBusy:
+		return "busy"
+	default:
+		return "off_shift"
+	}
+}
@@ -0,0 +1,59 @@
+package outboxrepo
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+)
+
+// CourierNotificationEvent is emitted for every notification pushed to a courier's device.
+const CourierNotificationEvent = "CourierNotification"
+
+// courierNotificationPayload is the JSON body of courier notification events.
+type courierNotificationPayload struct {
+	NotificationID string    `json:"notificationId"`
+	CourierID      string    `json:"courierId"`
+	Text           string    `json:"text"`
+	SentAt         time.Time `json:"sentAt"`
+}
+
+// OutboxCourierNotifier implements ports.CourierNotifier by writing notifications to the outbox,
+// from where the relay publishes them for the courier app's push gateway.
+// Events are keyed by courier, so each courier receives their notifications in order.
+// A notification counts as delivered once it is stored in the outbox.
+type OutboxCourierNotifier struct {
+	repository *GormOutboxRepository
+}
+
+// NewOutboxCourierNotifier creates a notifier that stores notifications in the given outbox.
+func NewOutboxCourierNotifier(repository *GormOutboxRepository) *OutboxCourierNotifier {
+	return &OutboxCourierNotifier{repository: repository}
+}
+
+// NotifyCourier stores the notification for the courier in the outbox.
+func (n *OutboxCourierNotifier) NotifyCourier(
+	ctx context.Context,
+	courierID kernel.UUID,
+	notification ports.CourierNotification,
+) error {
+	body, err := json.Marshal(courierNotificationPayload{
+		NotificationID: notification.ID.String(),
+		CourierID:      courierID.String(),
+		Text:           notification.Text,
+		SentAt:         notification.SentAt.UTC(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return n.repository.Add(ctx, ports.OutboxMessage{
+		ID:          kernel.NewUUID(),
+		AggregateID: courierID.String(),
+		EventType:   CourierNotificationEvent,
+		Payload:     body,
+		OccurredAt:  time.Now().UTC(),
+	})
+}
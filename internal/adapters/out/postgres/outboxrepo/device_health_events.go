@@ -0,0 +1,88 @@
+package outboxrepo
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"delivery/internal/core/domain/model/device"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+)
+
+const (
+	// CourierDeviceDegradedEvent is emitted when a courier's device gets a low battery or poor
+	// connectivity, or degrades for another reason, and the courier stops receiving orders.
+	CourierDeviceDegradedEvent = "CourierDeviceDegraded"
+
+	// CourierDeviceRecoveredEvent is emitted when a degraded device is fit for orders again.
+	CourierDeviceRecoveredEvent = "CourierDeviceRecovered"
+)
+
+// deviceHealthPayload is the JSON body of courier device health events.
+type deviceHealthPayload struct {
+	CourierID        string    `json:"courierId"`
+	Battery          int       `json:"battery"`
+	Charging         bool      `json:"charging"`
+	Connectivity     string    `json:"connectivity"`
+	LowBattery       bool      `json:"lowBattery"`
+	PoorConnectivity bool      `json:"poorConnectivity"`
+	LastSeenAt       time.Time `json:"lastSeenAt"`
+}
+
+// DeviceHealthEventRecorder writes changes of courier device health to the outbox so the
+// operations console can reach the courier, and logs them for monitoring.
+// Events are keyed by courier, so the changes of each device arrive in order.
+type DeviceHealthEventRecorder struct {
+	repository *GormOutboxRepository
+	logger     *slog.Logger
+}
+
+// NewDeviceHealthEventRecorder creates a recorder that stores events in the given outbox.
+func NewDeviceHealthEventRecorder(repository *GormOutboxRepository, logger *slog.Logger) *DeviceHealthEventRecorder {
+	return &DeviceHealthEventRecorder{
+		repository: repository,
+		logger:     logger,
+	}
+}
+
+// DeviceHealthChanged records a device health change.
+// Failures are logged rather than returned so telemetry ingestion is never blocked by event delivery.
+func (r *DeviceHealthEventRecorder) DeviceHealthChanged(ctx context.Context, courierID kernel.UUID, health device.Health) {
+	eventType := CourierDeviceRecoveredEvent
+	if health.IsDegraded() {
+		eventType = CourierDeviceDegradedEvent
+	}
+
+	r.logger.WarnContext(ctx, "Courier device health changed",
+		"event", eventType,
+		"courier_id", courierID.String(),
+		"battery", health.Battery,
+		"connectivity", health.Connectivity.String(),
+	)
+
+	body, err := json.Marshal(deviceHealthPayload{
+		CourierID:        courierID.String(),
+		Battery:          health.Battery,
+		Charging:         health.Charging,
+		Connectivity:     health.Connectivity.String(),
+		LowBattery:       health.LowBattery,
+		PoorConnectivity: health.PoorConnectivity,
+		LastSeenAt:       health.LastSeenAt.UTC(),
+	})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to encode device health event", "error", err)
+		return
+	}
+
+	if err = r.repository.Add(ctx, ports.OutboxMessage{
+		ID:          kernel.NewUUID(),
+		AggregateID: courierID.String(),
+		EventType:   eventType,
+		Payload:     body,
+		OccurredAt:  time.Now().UTC(),
+	}); err != nil {
+		r.logger.ErrorContext(ctx, "Failed to store device health event", "error", err)
+	}
+}
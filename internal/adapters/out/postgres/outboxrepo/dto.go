@@ -0,0 +1,59 @@
+// Package outboxrepo provides persistence for the transactional outbox.
+// Integration events are stored in the same database transaction as aggregate changes
+// and are later published to the message broker.
+package outboxrepo
+
+import (
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+// OutboxMessageDTO represents the database structure of an outbox message.
+// The composite index on (occurred_at, id) backs ordered scans used by relays and replays.
+type OutboxMessageDTO struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primaryKey;index:idx_outbox_occurred_at_id,priority:2"`
+	AggregateID string     `gorm:"type:varchar(255);not null;index"`
+	EventType   string     `gorm:"type:varchar(255);not null"`
+	Payload     []byte     `gorm:"type:bytea;not null"`
+	OccurredAt  time.Time  `gorm:"not null;index:idx_outbox_occurred_at_id,priority:1"`
+	ProcessedAt *time.Time `gorm:"index"`
+	TraceParent string     `gorm:"type:varchar(55);not null;default:''"`
+}
+
+// TableName specifies the database table name for outbox messages.
+func (OutboxMessageDTO) TableName() string {
+	return "outbox"
+}
+
+// fromPort converts an outbox message to its database representation.
+func fromPort(message ports.OutboxMessage) OutboxMessageDTO {
+	return OutboxMessageDTO{
+		ID:          message.ID.Bytes(),
+		AggregateID: message.AggregateID,
+		EventType:   message.EventType,
+		Payload:     message.Payload,
+		OccurredAt:  message.OccurredAt.UTC(),
+		TraceParent: message.TraceParent,
+	}
+}
+
+// toPort converts a database DTO to an outbox message.
+func toPort(dto OutboxMessageDTO) (ports.OutboxMessage, error) {
+	id, err := kernel.UUIDFromBytes(dto.ID[:])
+	if err != nil {
+		return ports.OutboxMessage{}, err
+	}
+
+	return ports.OutboxMessage{
+		ID:          id,
+		AggregateID: dto.AggregateID,
+		EventType:   dto.EventType,
+		Payload:     dto.Payload,
+		OccurredAt:  dto.OccurredAt,
+		TraceParent: dto.TraceParent,
+	}, nil
+}
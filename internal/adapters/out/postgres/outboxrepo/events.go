@@ -0,0 +1,23 @@
+package outboxrepo
+
+// EventPayloads returns an empty payload of every integration event written to the outbox,
+// keyed by event type, so the message catalog can describe the events consumers receive.
+// Every new event type must be listed here.
+func EventPayloads() map[string]any {
+	return map[string]any{
+		FleetCapacityExceededEvent:      fleetCapacityPayload{},
+		FleetCapacityRestoredEvent:      fleetCapacityPayload{},
+		CourierNotificationEvent:        courierNotificationPayload{},
+		CourierDeviceDegradedEvent:      deviceHealthPayload{},
+		CourierDeviceRecoveredEvent:     deviceHealthPayload{},
+		CourierAvailabilityChangedEvent: courierAvailabilityPayload{},
+		RouteDeviationEvent:             routeDeviationPayload{},
+		OrderCreatedEvent:               orderCreatedPayload{},
+		OrderAssignedEvent:              orderCourierPayload{},
+		OrderCompletedEvent:             orderCourierPayload{},
+		OrderCancelledEvent:             orderCancelledPayload{},
+		OrderErasedEvent:                struct{}{},
+
+		CourierIdentityVerificationFailedEvent: identityVerificationFailedPayload{},
+	}
+}
@@ -0,0 +1,89 @@
+package outboxrepo
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"math"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/core/ports"
+)
+
+const (
+	// fleetAggregateID keys all fleet capacity events to a single partition to keep them ordered.
+	fleetAggregateID = "fleet"
+
+	// FleetCapacityExceededEvent is emitted when the capacity breaker trips.
+	FleetCapacityExceededEvent = "FleetCapacityExceeded"
+
+	// FleetCapacityRestoredEvent is emitted when the fleet load returns below the limit.
+	FleetCapacityRestoredEvent = "FleetCapacityRestored"
+)
+
+// fleetCapacityPayload is the JSON body of fleet capacity events.
+// Ratio is omitted when no courier is free and the ratio is infinite.
+type fleetCapacityPayload struct {
+	QueuedOrders int      `json:"queuedOrders"`
+	FreeCouriers int      `json:"freeCouriers"`
+	Ratio        *float64 `json:"ratio,omitempty"`
+	MaxRatio     float64  `json:"maxRatio"`
+}
+
+// FleetCapacityEventRecorder writes fleet capacity state changes to the outbox
+// so the storefront can throttle demand, and logs them for monitoring.
+type FleetCapacityEventRecorder struct {
+	repository *GormOutboxRepository
+	logger     *slog.Logger
+}
+
+// NewFleetCapacityEventRecorder creates a recorder that stores events in the given outbox.
+func NewFleetCapacityEventRecorder(repository *GormOutboxRepository, logger *slog.Logger) *FleetCapacityEventRecorder {
+	return &FleetCapacityEventRecorder{
+		repository: repository,
+		logger:     logger,
+	}
+}
+
+// FleetCapacityChanged records a breaker transition.
+// Failures are logged rather than returned so order intake is never blocked by event delivery.
+func (r *FleetCapacityEventRecorder) FleetCapacityChanged(ctx context.Context, assessment services.CapacityAssessment) {
+	eventType := FleetCapacityRestoredEvent
+	if assessment.Exceeded {
+		eventType = FleetCapacityExceededEvent
+	}
+
+	payload := fleetCapacityPayload{
+		QueuedOrders: assessment.QueuedOrders,
+		FreeCouriers: assessment.FreeCouriers,
+		MaxRatio:     assessment.MaxRatio,
+	}
+	if !math.IsInf(assessment.Ratio, 0) {
+		payload.Ratio = &assessment.Ratio
+	}
+
+	r.logger.WarnContext(ctx, "Fleet capacity state changed",
+		"event", eventType,
+		"queued_orders", assessment.QueuedOrders,
+		"free_couriers", assessment.FreeCouriers,
+		"max_ratio", assessment.MaxRatio,
+	)
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to encode fleet capacity event", "error", err)
+		return
+	}
+
+	if err = r.repository.Add(ctx, ports.OutboxMessage{
+		ID:          kernel.NewUUID(),
+		AggregateID: fleetAggregateID,
+		EventType:   eventType,
+		Payload:     body,
+		OccurredAt:  time.Now().UTC(),
+	}); err != nil {
+		r.logger.ErrorContext(ctx, "Failed to store fleet capacity event", "error", err)
+	}
+}
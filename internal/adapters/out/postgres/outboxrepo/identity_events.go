@@ -0,0 +1,87 @@
+package outboxrepo
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"delivery/internal/core/domain/model/identity"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+)
+
+// CourierIdentityVerificationFailedEvent is emitted when a courier's proof of identity was rejected
+// on shift start. LockedUntil is set when the failure used up the courier's attempts.
+const CourierIdentityVerificationFailedEvent = "CourierIdentityVerificationFailed"
+
+// identityVerificationFailedPayload is the JSON body of failed identity verification events.
+type identityVerificationFailedPayload struct {
+	AttemptID   string     `json:"attemptId"`
+	CourierID   string     `json:"courierId"`
+	Method      string     `json:"method"`
+	Reason      string     `json:"reason"`
+	AttemptedAt time.Time  `json:"attemptedAt"`
+	LockedUntil *time.Time `json:"lockedUntil,omitempty"`
+}
+
+// IdentityEventRecorder writes failed identity verifications of couriers to the outbox so
+// security can review them, and logs them for monitoring.
+// Events are keyed by courier, so the failures of each courier arrive in order.
+type IdentityEventRecorder struct {
+	repository *GormOutboxRepository
+	logger     *slog.Logger
+}
+
+// NewIdentityEventRecorder creates a recorder that stores events in the given outbox.
+func NewIdentityEventRecorder(repository *GormOutboxRepository, logger *slog.Logger) *IdentityEventRecorder {
+	return &IdentityEventRecorder{
+		repository: repository,
+		logger:     logger,
+	}
+}
+
+// IdentityVerificationFailed records a rejected proof of identity.
+// Failures are logged rather than returned so the courier gets the verification outcome
+// regardless of event delivery.
+func (r *IdentityEventRecorder) IdentityVerificationFailed(
+	ctx context.Context,
+	attempt identity.Attempt,
+	lockedUntil *time.Time,
+) {
+	payload := identityVerificationFailedPayload{
+		AttemptID:   attempt.ID().String(),
+		CourierID:   attempt.CourierID().String(),
+		Method:      attempt.Method().String(),
+		Reason:      attempt.Reason(),
+		AttemptedAt: attempt.AttemptedAt(),
+	}
+	if lockedUntil != nil {
+		until := lockedUntil.UTC()
+		payload.LockedUntil = &until
+	}
+
+	r.logger.WarnContext(ctx, "Courier identity verification failed",
+		"event", CourierIdentityVerificationFailedEvent,
+		"courier_id", payload.CourierID,
+		"method", payload.Method,
+		"reason", payload.Reason,
+		"locked", lockedUntil != nil,
+	)
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to encode identity verification event", "error", err)
+		return
+	}
+
+	if err = r.repository.Add(ctx, ports.OutboxMessage{
+		ID:          kernel.NewUUID(),
+		AggregateID: payload.CourierID,
+		EventType:   CourierIdentityVerificationFailedEvent,
+		Payload:     body,
+		OccurredAt:  time.Now().UTC(),
+	}); err != nil {
+		r.logger.ErrorContext(ctx, "Failed to store identity verification event", "error", err)
+	}
+}
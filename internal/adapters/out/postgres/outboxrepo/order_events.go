@@ -0,0 +1,108 @@
+package outboxrepo
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+)
+
+const (
+	// OrderCreatedEvent is emitted when an order is created.
+	OrderCreatedEvent = "OrderCreated"
+
+	// OrderAssignedEvent is emitted when an order is assigned to a courier, including reassignments.
+	OrderAssignedEvent = "OrderAssigned"
+
+	// OrderCompletedEvent is emitted when an order is delivered.
+	OrderCompletedEvent = "OrderCompleted"
+
+	// OrderCancelledEvent is emitted when an order is called off before it is delivered.
+	OrderCancelledEvent = "OrderCancelled"
+
+	// OrderErasedEvent is a tombstone emitted when the personal data of an order is erased.
+	// It has no payload, so compacted topics drop the earlier events of the order.
+	OrderErasedEvent = "OrderErased"
+)
+
+// orderCreatedPayload is the JSON body of order created events.
+type orderCreatedPayload struct {
+	OrderID    string          `json:"orderId"`
+	Location   locationPayload `json:"location"`
+	Volume     int             `json:"volume"`
+	OccurredAt time.Time       `json:"occurredAt"`
+}
+
+// orderCourierPayload is the JSON body of order assigned and order completed events.
+type orderCourierPayload struct {
+	OrderID    string    `json:"orderId"`
+	CourierID  string    `json:"courierId"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
+
+// orderCancelledPayload is the JSON body of order cancelled events.
+// CourierID is omitted for orders that were not assigned when they were cancelled,
+// Reason for orders cancelled without one.
+type orderCancelledPayload struct {
+	OrderID    string    `json:"orderId"`
+	CourierID  string    `json:"courierId,omitempty"`
+	Reason     string    `json:"reason,omitempty"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
+
+// NewOrderEventMessage converts an event raised by an order to an outbox message.
+// Messages are keyed by order, so the events of each order arrive in order.
+func NewOrderEventMessage(event order.Event, occurredAt time.Time) (ports.OutboxMessage, error) {
+	occurredAt = occurredAt.UTC()
+
+	var payload any
+	switch e := event.(type) {
+	case order.CreatedEvent:
+		payload = orderCreatedPayload{
+			OrderID:    e.OrderID.String(),
+			Location:   toLocationPayload(e.Location),
+			Volume:     e.Volume,
+			OccurredAt: occurredAt,
+		}
+	case order.AssignedEvent:
+		payload = orderCourierPayload{OrderID: e.OrderID.String(), CourierID: e.CourierID.String(), OccurredAt: occurredAt}
+	case order.CompletedEvent:
+		payload = orderCourierPayload{OrderID: e.OrderID.String(), CourierID: e.CourierID.String(), OccurredAt: occurredAt}
+	case order.CancelledEvent:
+		cancelled := orderCancelledPayload{OrderID: e.OrderID.String(), Reason: e.Reason, OccurredAt: occurredAt}
+		if e.CourierID != nil {
+			cancelled.CourierID = e.CourierID.String()
+		}
+		payload = cancelled
+	default:
+		return ports.OutboxMessage{}, fmt.Errorf("unknown order event %T", event)
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return ports.OutboxMessage{}, err
+	}
+
+	return ports.OutboxMessage{
+		ID:          kernel.NewUUID(),
+		AggregateID: event.AggregateID().String(),
+		EventType:   event.EventType(),
+		Payload:     body,
+		OccurredAt:  occurredAt,
+	}, nil
+}
+
+// NewOrderErasedMessage creates the tombstone of an order whose personal data was erased.
+// It is keyed by order like the other events of the order, so it compacts them away.
+func NewOrderErasedMessage(orderID kernel.UUID, occurredAt time.Time) ports.OutboxMessage {
+	return ports.OutboxMessage{
+		ID:          kernel.NewUUID(),
+		AggregateID: orderID.String(),
+		EventType:   OrderErasedEvent,
+		Payload:     []byte{},
+		OccurredAt:  occurredAt.UTC(),
+	}
+}
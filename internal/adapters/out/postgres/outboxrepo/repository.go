@@ -0,0 +1,115 @@
+package outboxrepo
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tracing"
+
+	"gorm.io/gorm"
+)
+
+// GormOutboxRepository implements OutboxRepository using GORM.
+type GormOutboxRepository struct {
+	db *gorm.DB
+}
+
+// NewGormOutboxRepository creates a new GORM outbox repository.
+func NewGormOutboxRepository(db *gorm.DB) *GormOutboxRepository {
+	return &GormOutboxRepository{
+		db: db,
+	}
+}
+
+// Add stores a new message in the outbox. A message without a trace parent is stored with the
+// one of the span of ctx, so the message is published within the trace that recorded it.
+func (r *GormOutboxRepository) Add(ctx context.Context, message ports.OutboxMessage) error {
+	if err := message.ID.Validate(); err != nil {
+		return err
+	}
+	if message.TraceParent == "" {
+		message.TraceParent = tracing.TraceParent(ctx)
+	}
+
+	dto := fromPort(message)
+	return r.db.WithContext(ctx).Create(&dto).Error
+}
+
+// GetSince returns up to limit messages positioned after the cursor,
+// ordered by occurrence time and ID. Processed messages are included,
+// which makes the method suitable for replays.
+//
+// Example:
+//
+//	cursor := ports.OutboxCursor{OccurredAt: since}
+//	for {
+//		batch, err := repo.GetSince(ctx, cursor, 100)
+//		if err != nil || len(batch) == 0 {
+//			break
+//		}
+//		last := batch[len(batch)-1]
+//		cursor = ports.OutboxCursor{OccurredAt: last.OccurredAt, AfterID: &last.ID}
+//	}
+func (r *GormOutboxRepository) GetSince(
+	ctx context.Context,
+	cursor ports.OutboxCursor,
+	limit int,
+) ([]ports.OutboxMessage, error) {
+	query := r.db.WithContext(ctx).Model(&OutboxMessageDTO{})
+	if cursor.AfterID != nil {
+		query = query.Where("(occurred_at, id) > (?, ?)", cursor.OccurredAt.UTC(), cursor.AfterID.Bytes())
+	} else {
+		query = query.Where("occurred_at >= ?", cursor.OccurredAt.UTC())
+	}
+
+	var dtos []OutboxMessageDTO
+	if err := query.Order("occurred_at, id").Limit(limit).Find(&dtos).Error; err != nil {
+		return nil, err
+	}
+
+	messages := make([]ports.OutboxMessage, 0, len(dtos))
+	for _, dto := range dtos {
+		message, err := toPort(dto)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
+
+// GetUnprocessed returns up to limit messages the relay has not published yet,
+// ordered by occurrence time and ID.
+func (r *GormOutboxRepository) GetUnprocessed(ctx context.Context, limit int) ([]ports.OutboxMessage, error) {
+	var dtos []OutboxMessageDTO
+	err := r.db.WithContext(ctx).
+		Where("processed_at IS NULL").
+		Order("occurred_at, id").
+		Limit(limit).
+		Find(&dtos).Error
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]ports.OutboxMessage, 0, len(dtos))
+	for _, dto := range dtos {
+		message, toPortErr := toPort(dto)
+		if toPortErr != nil {
+			return nil, toPortErr
+		}
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
+
+// MarkProcessed records that the message was published, so the relay does not publish it again.
+func (r *GormOutboxRepository) MarkProcessed(ctx context.Context, id kernel.UUID, processedAt time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&OutboxMessageDTO{}).
+		Where("id = ?", id.Bytes()).
+		Update("processed_at", processedAt.UTC()).Error
+}
@@ -0,0 +1,146 @@
+package outboxrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/postgres/outboxrepo"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+// OutboxRepositoryIntegrationTestSuite provides integration tests for OutboxRepository
+// using PostgreSQL containers to verify ordering and cursor behavior.
+type OutboxRepositoryIntegrationTestSuite struct {
+	suite.Suite
+	template   *pgtest.Template
+	db         *gorm.DB
+	repository *outboxrepo.GormOutboxRepository
+}
+
+func (suite *OutboxRepositoryIntegrationTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(&outboxrepo.OutboxMessageDTO{})
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *OutboxRepositoryIntegrationTestSuite) SetupTest() {
+	// Every test works in a fresh clone of the migrated database
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.repository = outboxrepo.NewGormOutboxRepository(suite.db)
+}
+
+func (suite *OutboxRepositoryIntegrationTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		suite.Require().NoError(suite.template.Terminate(context.Background()))
+	}
+}
+
+func (suite *OutboxRepositoryIntegrationTestSuite) TestGetSince_ReturnsMessagesInOrder() {
+	ctx := context.Background()
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	early := suite.addMessage(base.Add(-time.Minute))
+	second := suite.addMessage(base.Add(2 * time.Second))
+	first := suite.addMessage(base)
+
+	messages, err := suite.repository.GetSince(ctx, ports.OutboxCursor{OccurredAt: base}, 10)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(messages, 2)
+	suite.Equal(first.ID, messages[0].ID)
+	suite.Equal(second.ID, messages[1].ID)
+	suite.NotEqual(early.ID, messages[0].ID)
+	suite.Equal(first.AggregateID, messages[0].AggregateID)
+	suite.Equal(first.EventType, messages[0].EventType)
+	suite.Equal(first.Payload, messages[0].Payload)
+	suite.True(first.OccurredAt.Equal(messages[0].OccurredAt))
+}
+
+func (suite *OutboxRepositoryIntegrationTestSuite) TestGetSince_CursorSkipsAlreadyReadMessages() {
+	ctx := context.Background()
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// Three messages sharing the same timestamp must be paginated by ID
+	for range 3 {
+		suite.addMessage(base)
+	}
+
+	firstPage, err := suite.repository.GetSince(ctx, ports.OutboxCursor{OccurredAt: base}, 2)
+	suite.Require().NoError(err)
+	suite.Require().Len(firstPage, 2)
+
+	last := firstPage[1]
+	secondPage, err := suite.repository.GetSince(ctx, ports.OutboxCursor{OccurredAt: last.OccurredAt, AfterID: &last.ID}, 2)
+	suite.Require().NoError(err)
+	suite.Require().Len(secondPage, 1)
+	suite.NotEqual(firstPage[0].ID, secondPage[0].ID)
+	suite.NotEqual(firstPage[1].ID, secondPage[0].ID)
+}
+
+func (suite *OutboxRepositoryIntegrationTestSuite) TestAdd_InvalidID_ReturnsError() {
+	err := suite.repository.Add(context.Background(), ports.OutboxMessage{OccurredAt: time.Now()})
+
+	suite.Require().ErrorIs(err, kernel.ErrUUIDIsNotConstructed)
+}
+
+func (suite *OutboxRepositoryIntegrationTestSuite) TestGetUnprocessed_SkipsMessagesMarkedProcessed() {
+	ctx := context.Background()
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	second := suite.addMessage(base.Add(time.Second))
+	first := suite.addMessage(base)
+	suite.Require().NoError(suite.repository.MarkProcessed(ctx, first.ID, base.Add(time.Minute)))
+
+	messages, err := suite.repository.GetUnprocessed(ctx, 10)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(messages, 1)
+	suite.Equal(second.ID, messages[0].ID)
+}
+
+func (suite *OutboxRepositoryIntegrationTestSuite) TestOutboxCourierNotifier_StoresNotificationKeyedByCourier() {
+	ctx := context.Background()
+	courierID := kernel.NewUUID()
+	sentAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	notifier := outboxrepo.NewOutboxCourierNotifier(suite.repository)
+
+	err := notifier.NotifyCourier(ctx, courierID, ports.CourierNotification{
+		ID:     kernel.NewUUID(),
+		Text:   "Surge pricing active",
+		SentAt: sentAt,
+	})
+
+	suite.Require().NoError(err)
+	messages, err := suite.repository.GetSince(ctx, ports.OutboxCursor{}, 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(messages, 1)
+	suite.Equal(courierID.String(), messages[0].AggregateID)
+	suite.Equal(outboxrepo.CourierNotificationEvent, messages[0].EventType)
+	suite.Contains(string(messages[0].Payload), `"text":"Surge pricing active"`)
+}
+
+// addMessage stores an outbox message with the given occurrence time.
+func (suite *OutboxRepositoryIntegrationTestSuite) addMessage(occurredAt time.Time) ports.OutboxMessage {
+	message := ports.OutboxMessage{
+		ID:          kernel.NewUUID(),
+		AggregateID: kernel.NewUUID().String(),
+		EventType:   "OrderStatusChanged",
+		Payload:     []byte(`{"status":"Assigned"}`),
+		OccurredAt:  occurredAt,
+	}
+	suite.Require().NoError(suite.repository.Add(context.Background(), message))
+	return message
+}
+
+func TestOutboxRepositoryIntegrationTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(OutboxRepositoryIntegrationTestSuite))
+}
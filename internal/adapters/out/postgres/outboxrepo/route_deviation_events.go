@@ -0,0 +1,102 @@
+package outboxrepo
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+)
+
+// RouteDeviationEvent is emitted when an order is flagged because its courier strayed from the
+// route to the order for several movement ticks.
+const RouteDeviationEvent = "RouteDeviation"
+
+// routeDeviationPayload is the JSON body of route deviation events.
+type routeDeviationPayload struct {
+	OrderID    string          `json:"orderId"`
+	CourierID  string          `json:"courierId"`
+	Origin     locationPayload `json:"origin"`
+	Location   locationPayload `json:"location"`
+	Target     locationPayload `json:"target"`
+	Deviation  int             `json:"deviation"`
+	Ticks      int             `json:"ticks"`
+	DeviatedAt time.Time       `json:"deviatedAt"`
+}
+
+// locationPayload is a location on the delivery grid.
+type locationPayload struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+// RouteDeviationEventRecorder writes flagged route deviations to the outbox so the dispatcher
+// can reach the courier, and logs them for monitoring.
+// Events are keyed by order, so the deviations of each delivery arrive in order.
+type RouteDeviationEventRecorder struct {
+	repository *GormOutboxRepository
+	logger     *slog.Logger
+}
+
+// NewRouteDeviationEventRecorder creates a recorder that stores events in the given outbox.
+func NewRouteDeviationEventRecorder(repository *GormOutboxRepository, logger *slog.Logger) *RouteDeviationEventRecorder {
+	return &RouteDeviationEventRecorder{
+		repository: repository,
+		logger:     logger,
+	}
+}
+
+// RouteDeviated records a flagged route deviation.
+// Failures are logged rather than returned so courier movement is never blocked by event delivery.
+func (r *RouteDeviationEventRecorder) RouteDeviated(ctx context.Context, o *order.Order, deviation order.RouteDeviation) {
+	var courierID string
+	if id := o.Courier(); id != nil {
+		courierID = id.String()
+	}
+	deviatedAt := time.Now().UTC()
+	if at := o.RouteDeviatedAt(); at != nil {
+		deviatedAt = at.UTC()
+	}
+
+	r.logger.WarnContext(ctx, "Courier deviated from route",
+		"event", RouteDeviationEvent,
+		"order_id", o.ID().String(),
+		"courier_id", courierID,
+		"location", deviation.Location.String(),
+		"deviation", deviation.Distance,
+		"ticks", deviation.Ticks,
+	)
+
+	body, err := json.Marshal(routeDeviationPayload{
+		OrderID:    o.ID().String(),
+		CourierID:  courierID,
+		Origin:     toLocationPayload(deviation.Origin),
+		Location:   toLocationPayload(deviation.Location),
+		Target:     toLocationPayload(o.NextStop()),
+		Deviation:  deviation.Distance,
+		Ticks:      deviation.Ticks,
+		DeviatedAt: deviatedAt,
+	})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Failed to encode route deviation event", "error", err)
+		return
+	}
+
+	if err = r.repository.Add(ctx, ports.OutboxMessage{
+		ID:          kernel.NewUUID(),
+		AggregateID: o.ID().String(),
+		EventType:   RouteDeviationEvent,
+		Payload:     body,
+		OccurredAt:  time.Now().UTC(),
+	}); err != nil {
+		r.logger.ErrorContext(ctx, "Failed to store route deviation event", "error", err)
+	}
+}
+
+// toLocationPayload converts a grid location to its JSON form.
+func toLocationPayload(location kernel.Location) locationPayload {
+	return locationPayload{X: int(location.X()), Y: int(location.Y())}
+}
@@ -0,0 +1,239 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/adapters/out/postgres/outboxrepo"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// erasedMessageText replaces the texts of erased order threads. Messages cannot be blank,
+	// so the thread keeps its length and senders.
+	erasedMessageText = "[erased]"
+
+	// erasedPaymentReferencePrefix starts the references of erased payment transitions, which stay
+	// unique per order by ending with the ID of the transition.
+	erasedPaymentReferencePrefix = "erased-"
+)
+
+var _ ports.PersonalDataEraser = (*GormPersonalDataEraser)(nil)
+
+// PersonalDataErasureDTO is the audit entry of an erasure request: which orders were erased on
+// whose request and how many records were anonymized. It holds no personal data itself.
+type PersonalDataErasureDTO struct {
+	ID                 uuid.UUID `gorm:"type:uuid;primaryKey"`
+	RequestReference   string    `gorm:"type:varchar(128);not null;index"`
+	OrderIDs           []byte    `gorm:"type:jsonb;not null"`
+	TrackingTokens     int       `gorm:"not null"`
+	ExternalReferences int       `gorm:"not null"`
+	Messages           int       `gorm:"not null"`
+	PaymentReferences  int       `gorm:"not null"`
+	ChangeSnapshots    int       `gorm:"not null"`
+	EventTombstones    int       `gorm:"not null"`
+	ErasedAt           time.Time `gorm:"not null"`
+}
+
+// TableName specifies the database table name for erasure audit entries.
+// Overrides GORM's default naming convention to use "personal_data_erasures".
+func (PersonalDataErasureDTO) TableName() string {
+	return "personal_data_erasures"
+}
+
+// GormPersonalDataEraser anonymizes the personal data of orders with plain SQL statements,
+// since it is spread over the tables of several repositories and the change log.
+//
+// The delivery location of an order is kept: it is a cell of the delivery grid rather than an
+// address, and the SLA and microzone statistics are built from it. The order history and the
+// courier notifications hold no data of the customer.
+type GormPersonalDataEraser struct {
+	db *gorm.DB
+}
+
+// NewGormPersonalDataEraser creates a personal data eraser.
+func NewGormPersonalDataEraser(db *gorm.DB) *GormPersonalDataEraser {
+	return &GormPersonalDataEraser{db: db}
+}
+
+// Erase clears the tracking tokens and external references of the orders, replaces the texts of
+// their messages and the references of their payment transitions, drops the external references
+// from their change log snapshots and queues an OrderErased tombstone for each of them.
+// Records erased before are not counted again; a tombstone is queued on every erasure.
+func (r *GormPersonalDataEraser) Erase(
+	ctx context.Context,
+	orders []*order.Order,
+) (ports.PersonalDataRecords, error) {
+	db := r.db.WithContext(ctx)
+
+	ids := make([]uuid.UUID, 0, len(orders))
+	for _, o := range orders {
+		if err := o.Validate(); err != nil {
+			return ports.PersonalDataRecords{}, err
+		}
+
+		// Bumping the version locks the order, so nothing is written for it while it is erased
+		locked := db.Exec(`UPDATE orders SET version = version + 1 WHERE id = ? AND version = ?`,
+			o.ID().Bytes(), o.Version())
+		if locked.Error != nil {
+			return ports.PersonalDataRecords{}, locked.Error
+		}
+		if locked.RowsAffected == 0 {
+			return ports.PersonalDataRecords{}, r.staleOrMissing(ctx, o)
+		}
+
+		ids = append(ids, o.ID().Bytes())
+	}
+
+	if len(ids) == 0 {
+		return ports.PersonalDataRecords{}, nil
+	}
+
+	var records ports.PersonalDataRecords
+	erasures := []struct {
+		count     *int
+		statement string
+	}{
+		{&records.TrackingTokens, `UPDATE orders SET tracking_token = NULL
+			WHERE id IN @ids AND tracking_token IS NOT NULL`},
+		{&records.ExternalReferences, `UPDATE orders
+			SET external_marketplace = NULL, external_order_id = NULL, external_deep_link = NULL
+			WHERE id IN @ids AND (external_marketplace IS NOT NULL OR external_order_id IS NOT NULL
+				OR external_deep_link IS NOT NULL)`},
+		{&records.Messages, `UPDATE order_messages SET text = @text
+			WHERE order_id IN @ids AND text <> @text`},
+		{&records.PaymentReferences, `UPDATE order_payment_transitions SET reference = @prefix::text || id::text
+			WHERE order_id IN @ids AND reference <> @prefix::text || id::text`},
+		{&records.ChangeSnapshots, `UPDATE change_log SET snapshot = snapshot - 'externalReference'
+			WHERE aggregate_type = @orderChange AND aggregate_id IN @ids
+				AND snapshot -> 'externalReference' IS NOT NULL`},
+	}
+
+	arguments := erasureArguments(ids)
+	for _, erasure := range erasures {
+		result := db.Exec(erasure.statement, arguments)
+		if result.Error != nil {
+			return ports.PersonalDataRecords{}, result.Error
+		}
+		*erasure.count += int(result.RowsAffected)
+	}
+
+	outbox := outboxrepo.NewGormOutboxRepository(db)
+	erasedAt := time.Now().UTC()
+	for _, o := range orders {
+		if err := outbox.Add(ctx, outboxrepo.NewOrderErasedMessage(o.ID(), erasedAt)); err != nil {
+			return ports.PersonalDataRecords{}, err
+		}
+		records.EventTombstones++
+	}
+
+	return records, nil
+}
+
+// Remaining counts the records of the orders Erase would still anonymize.
+func (r *GormPersonalDataEraser) Remaining(
+	ctx context.Context,
+	orderIDs []kernel.UUID,
+) (ports.PersonalDataRecords, error) {
+	if len(orderIDs) == 0 {
+		return ports.PersonalDataRecords{}, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(orderIDs))
+	for _, id := range orderIDs {
+		ids = append(ids, id.Bytes())
+	}
+
+	var records ports.PersonalDataRecords
+	counts := []struct {
+		count *int
+		query string
+	}{
+		{&records.TrackingTokens, `SELECT count(*) FROM orders
+			WHERE id IN @ids AND tracking_token IS NOT NULL`},
+		{&records.ExternalReferences, `SELECT count(*) FROM orders
+			WHERE id IN @ids AND (external_marketplace IS NOT NULL OR external_order_id IS NOT NULL
+				OR external_deep_link IS NOT NULL)`},
+		{&records.Messages, `SELECT count(*) FROM order_messages
+			WHERE order_id IN @ids AND text <> @text`},
+		{&records.PaymentReferences, `SELECT count(*) FROM order_payment_transitions
+			WHERE order_id IN @ids AND reference <> @prefix::text || id::text`},
+		{&records.ChangeSnapshots, `SELECT count(*) FROM change_log
+			WHERE aggregate_type = @orderChange AND aggregate_id IN @ids
+				AND snapshot -> 'externalReference' IS NOT NULL`},
+	}
+
+	arguments := erasureArguments(ids)
+	db := r.db.WithContext(ctx)
+	for _, count := range counts {
+		if err := db.Raw(count.query, arguments).Scan(count.count).Error; err != nil {
+			return ports.PersonalDataRecords{}, err
+		}
+	}
+
+	return records, nil
+}
+
+// RecordErasure stores the audit entry of an erasure.
+func (r *GormPersonalDataEraser) RecordErasure(ctx context.Context, erasure ports.PersonalDataErasure) error {
+	if err := erasure.ID.Validate(); err != nil {
+		return err
+	}
+
+	orderIDs := make([]string, 0, len(erasure.OrderIDs))
+	for _, id := range erasure.OrderIDs {
+		orderIDs = append(orderIDs, id.String())
+	}
+	encoded, err := json.Marshal(orderIDs)
+	if err != nil {
+		return err
+	}
+
+	dto := PersonalDataErasureDTO{
+		ID:                 erasure.ID.Bytes(),
+		RequestReference:   erasure.RequestReference,
+		OrderIDs:           encoded,
+		TrackingTokens:     erasure.Erased.TrackingTokens,
+		ExternalReferences: erasure.Erased.ExternalReferences,
+		Messages:           erasure.Erased.Messages,
+		PaymentReferences:  erasure.Erased.PaymentReferences,
+		ChangeSnapshots:    erasure.Erased.ChangeSnapshots,
+		EventTombstones:    erasure.Erased.EventTombstones,
+		ErasedAt:           erasure.ErasedAt.UTC(),
+	}
+	return r.db.WithContext(ctx).Create(&dto).Error
+}
+
+// erasureArguments returns the named arguments of the erasure statements for the orders.
+func erasureArguments(ids []uuid.UUID) map[string]any {
+	return map[string]any{
+		"ids":         ids,
+		"text":        erasedMessageText,
+		"prefix":      erasedPaymentReferencePrefix,
+		"orderChange": queries.OrderChange,
+	}
+}
+
+// staleOrMissing explains a lock that matched no row: the order was either changed by another
+// transaction since it was loaded or does not exist.
+func (r *GormPersonalDataEraser) staleOrMissing(ctx context.Context, o *order.Order) error {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&orderrepo.OrderDTO{}).
+		Where("id = ?", o.ID().Bytes()).Count(&count).Error; err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return errs.NewObjectNotFoundError("order", o.ID().String())
+	}
+	return errs.NewConcurrencyConflictError("order", o.ID().String(), o.Version())
+}
@@ -0,0 +1,150 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/adapters/out/postgres/outboxrepo"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+// PersonalDataErasureIntegrationTestSuite verifies that an erasure anonymizes the personal data
+// of orders, leaves nothing for the verification and refuses orders that changed since they were loaded.
+type PersonalDataErasureIntegrationTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	db       *gorm.DB
+	factory  ports.UnitOfWorkFactory
+}
+
+// SetupSuite starts PostgreSQL and applies migrations of every table that holds personal data of orders.
+func (suite *PersonalDataErasureIntegrationTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&orderrepo.OrderDTO{},
+			&orderrepo.OrderMessageDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{},
+			&orderrepo.OrderItemDTO{},
+			&postgres_adapter.ChangeLogDTO{},
+			&postgres_adapter.CourierAvailabilityDTO{},
+			&postgres_adapter.PersonalDataErasureDTO{},
+			&outboxrepo.OutboxMessageDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+// SetupTest gives every test a fresh clone of the migrated database.
+func (suite *PersonalDataErasureIntegrationTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.factory = postgres_adapter.NewGormUnitOfWorkFactory(suite.db)
+}
+
+// TearDownSuite cleans up PostgreSQL container after all tests complete.
+func (suite *PersonalDataErasureIntegrationTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+// TestErase_AnonymizesOrder verifies that the tracking token, the external reference, the messages and
+// the change log snapshots of the order are erased, a tombstone is queued and the location is kept.
+func (suite *PersonalDataErasureIntegrationTestSuite) TestErase_AnonymizesOrder() {
+	ctx := context.Background()
+	delivered := suite.addDeliveredOrder()
+
+	uow := suite.factory.Create()
+	suite.Require().NoError(uow.Begin(ctx))
+	loaded, err := uow.OrderRepository().Get(ctx, delivered.ID())
+	suite.Require().NoError(err)
+	eraser := uow.PersonalDataEraser()
+	erased, err := eraser.Erase(ctx, []*order.Order{loaded})
+	suite.Require().NoError(err)
+	remaining, err := eraser.Remaining(ctx, []kernel.UUID{delivered.ID()})
+	suite.Require().NoError(err)
+	suite.Require().NoError(eraser.RecordErasure(ctx, ports.PersonalDataErasure{
+		ID:               kernel.NewUUID(),
+		RequestReference: "DSR-2041",
+		OrderIDs:         []kernel.UUID{delivered.ID()},
+		Erased:           erased,
+		ErasedAt:         time.Now(),
+	}))
+	suite.Require().NoError(uow.Commit(ctx))
+
+	suite.Equal(1, erased.TrackingTokens)
+	suite.Equal(1, erased.ExternalReferences)
+	suite.Equal(1, erased.Messages)
+	suite.Positive(erased.ChangeSnapshots)
+	suite.Equal(1, erased.EventTombstones)
+	suite.Zero(remaining.Total())
+
+	var dto orderrepo.OrderDTO
+	suite.Require().NoError(suite.db.Where("id = ?", delivered.ID().String()).First(&dto).Error)
+	suite.Nil(dto.TrackingToken)
+	suite.Nil(dto.ExternalOrderID)
+	suite.Equal(delivered.Location().X(), dto.Location.X)
+	suite.Equal(int64(1), suite.count("outbox", "aggregate_id = ? AND event_type = ?",
+		delivered.ID().String(), outboxrepo.OrderErasedEvent))
+	suite.Equal(int64(1), suite.count("personal_data_erasures", "request_reference = ?", "DSR-2041"))
+}
+
+// TestErase_OrderChanged verifies that an order updated since it was loaded is not erased.
+func (suite *PersonalDataErasureIntegrationTestSuite) TestErase_OrderChanged() {
+	ctx := context.Background()
+	delivered := suite.addDeliveredOrder()
+	suite.Require().NoError(suite.db.Exec(
+		"UPDATE orders SET version = version + 1 WHERE id = ?", delivered.ID().String(),
+	).Error)
+
+	uow := suite.factory.Create()
+	suite.Require().NoError(uow.Begin(ctx))
+	_, err := uow.PersonalDataEraser().Erase(ctx, []*order.Order{delivered})
+	suite.Require().NoError(uow.Rollback(ctx))
+
+	suite.Require().ErrorIs(err, errs.ErrConcurrencyConflict)
+	suite.Equal(int64(1), suite.count("orders", "id = ? AND tracking_token IS NOT NULL", delivered.ID().String()))
+}
+
+// addDeliveredOrder persists a delivered order with a tracking token, an external reference and a message.
+func (suite *PersonalDataErasureIntegrationTestSuite) addDeliveredOrder() *order.Order {
+	ctx := context.Background()
+	o := createTestOrder()
+	ref, err := order.NewExternalReference("ozon", "48213377-0021", "https://seller.ozon.ru/orders/48213377-0021")
+	suite.Require().NoError(err)
+	suite.Require().NoError(o.LinkExternalReference(ref))
+	_, err = o.ShareTracking()
+	suite.Require().NoError(err)
+	_, err = o.PostMessage(order.CourierSender, "Call me at the gate", time.Now())
+	suite.Require().NoError(err)
+	suite.Require().NoError(o.Assign(kernel.NewUUID()))
+	suite.Require().NoError(o.Complete())
+
+	uow := suite.factory.Create()
+	suite.Require().NoError(uow.Begin(ctx))
+	suite.Require().NoError(uow.OrderRepository().Add(ctx, o))
+	suite.Require().NoError(uow.Commit(ctx))
+	return o
+}
+
+func (suite *PersonalDataErasureIntegrationTestSuite) count(table, condition string, args ...any) int64 {
+	var count int64
+	suite.Require().NoError(suite.db.Table(table).Where(condition, args...).Count(&count).Error)
+	return count
+}
+
+func TestPersonalDataErasureIntegrationTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(PersonalDataErasureIntegrationTestSuite))
+}
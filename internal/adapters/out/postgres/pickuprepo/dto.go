@@ -0,0 +1,86 @@
+// Package pickuprepo provides data transfer objects and the repository for warehouse pickup slots.
+// This package implements the repository pattern for the pickup slot aggregate, handling
+// the conversion between domain entities and database representations.
+package pickuprepo
+
+import (
+	"time"
+
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/pickup"
+
+	"github.com/google/uuid"
+)
+
+// PickupSlotDTO represents the database structure for persisting pickup slot aggregates.
+// Start and end are indexed since dispatch looks for the earliest slot that has not ended.
+type PickupSlotDTO struct {
+	ID       uuid.UUID              `gorm:"type:uuid;primaryKey"`
+	StartsAt time.Time              `gorm:"not null;index"`
+	EndsAt   time.Time              `gorm:"not null;index"`
+	Capacity int                    `gorm:"not null"`
+	Bookings []PickupSlotBookingDTO `gorm:"foreignKey:SlotID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName specifies the database table name for pickup slots.
+// Overrides GORM's default naming convention to use "pickup_slots".
+func (PickupSlotDTO) TableName() string {
+	return "pickup_slots"
+}
+
+// PickupSlotBookingDTO represents an order booked into a pickup slot.
+// An order is booked at most once; bookings are removed together with their order,
+// so purged synthetic orders free their places.
+type PickupSlotBookingDTO struct {
+	SlotID   uuid.UUID           `gorm:"type:uuid;primaryKey"`
+	OrderID  uuid.UUID           `gorm:"type:uuid;primaryKey;uniqueIndex"`
+	Order    *orderrepo.OrderDTO `gorm:"foreignKey:OrderID;constraint:OnDelete:CASCADE"`
+	Position int                 `gorm:"not null"`
+}
+
+// TableName specifies the database table name for pickup slot bookings.
+// Overrides GORM's default naming convention to use "pickup_slot_bookings".
+func (PickupSlotBookingDTO) TableName() string {
+	return "pickup_slot_bookings"
+}
+
+// fromDomain converts a pickup slot domain aggregate to its database representation.
+func fromDomain(slot *pickup.Slot) PickupSlotDTO {
+	slotID := slot.ID().Bytes()
+	bookings := make([]PickupSlotBookingDTO, 0, len(slot.Bookings()))
+	for position, orderID := range slot.Bookings() {
+		bookings = append(bookings, PickupSlotBookingDTO{
+			SlotID:   slotID,
+			OrderID:  orderID.Bytes(),
+			Position: position,
+		})
+	}
+
+	return PickupSlotDTO{
+		ID:       slotID,
+		StartsAt: slot.StartsAt().UTC(),
+		EndsAt:   slot.EndsAt().UTC(),
+		Capacity: slot.Capacity(),
+		Bookings: bookings,
+	}
+}
+
+// toDomain converts a database DTO with its bookings to a pickup slot domain aggregate.
+func toDomain(dto PickupSlotDTO) (*pickup.Slot, error) {
+	id, err := kernel.UUIDFromBytes(dto.ID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	bookings := make([]kernel.UUID, 0, len(dto.Bookings))
+	for _, booking := range dto.Bookings {
+		orderID, orderErr := kernel.UUIDFromBytes(booking.OrderID[:])
+		if orderErr != nil {
+			return nil, orderErr
+		}
+		bookings = append(bookings, orderID)
+	}
+
+	return pickup.RestoreSlot(id, dto.StartsAt, dto.EndsAt, dto.Capacity, bookings)
+}
@@ -0,0 +1,173 @@
+package pickuprepo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/pickup"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// orderedBookings preloads bookings in booking order.
+func orderedBookings(db *gorm.DB) *gorm.DB {
+	return db.Order("position")
+}
+
+// GormPickupSlotRepository implements PickupSlotRepository using GORM.
+type GormPickupSlotRepository struct {
+	db      *gorm.DB
+	tracker aggregateTracker
+}
+
+// aggregateTracker defines the interface for tracking aggregates.
+type aggregateTracker interface {
+	TrackAggregate(id kernel.UUID, aggregate any)
+}
+
+// NewGormPickupSlotRepository creates a new GORM pickup slot repository.
+func NewGormPickupSlotRepository(db *gorm.DB, tracker aggregateTracker) *GormPickupSlotRepository {
+	return &GormPickupSlotRepository{
+		db:      db,
+		tracker: tracker,
+	}
+}
+
+// Add saves a new pickup slot with its bookings to the database.
+func (r *GormPickupSlotRepository) Add(ctx context.Context, aggregate *pickup.Slot) error {
+	if err := aggregate.Validate(); err != nil {
+		return err
+	}
+
+	dto := fromDomain(aggregate)
+	if err := r.db.WithContext(ctx).Omit(clause.Associations).Create(&dto).Error; err != nil {
+		return err
+	}
+
+	if len(dto.Bookings) > 0 {
+		if err := r.db.WithContext(ctx).Omit(clause.Associations).Create(&dto.Bookings).Error; err != nil {
+			return err
+		}
+	}
+
+	r.tracker.TrackAggregate(aggregate.ID(), aggregate)
+	return nil
+}
+
+// Update saves the capacity of an existing pickup slot and inserts its new bookings.
+func (r *GormPickupSlotRepository) Update(ctx context.Context, aggregate *pickup.Slot) error {
+	if err := aggregate.Validate(); err != nil {
+		return err
+	}
+
+	dto := fromDomain(aggregate)
+	result := r.db.WithContext(ctx).
+		Model(&PickupSlotDTO{}).
+		Where("id = ?", dto.ID).
+		Update("capacity", dto.Capacity)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	// Released bookings are deleted; the others are upserted, as releasing moves the later ones up
+	released := r.db.WithContext(ctx).Where("slot_id = ?", dto.ID)
+	if len(dto.Bookings) > 0 {
+		orderIDs := make([]uuid.UUID, 0, len(dto.Bookings))
+		for _, booking := range dto.Bookings {
+			orderIDs = append(orderIDs, booking.OrderID)
+		}
+		released = released.Where("order_id NOT IN ?", orderIDs)
+	}
+	if err := released.Delete(&PickupSlotBookingDTO{}).Error; err != nil {
+		return err
+	}
+
+	if len(dto.Bookings) > 0 {
+		err := r.db.WithContext(ctx).Omit(clause.Associations).Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "slot_id"}, {Name: "order_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"position"}),
+		}).Create(&dto.Bookings).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	r.tracker.TrackAggregate(aggregate.ID(), aggregate)
+	return nil
+}
+
+// Get retrieves a pickup slot by ID.
+func (r *GormPickupSlotRepository) Get(ctx context.Context, id kernel.UUID) (*pickup.Slot, error) {
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+
+	return r.first(ctx, id.String(), r.db.Where("id = ?", id.Bytes()))
+}
+
+// GetFirstAvailable retrieves the earliest starting slot that has not ended and has free capacity.
+// The slot row is locked until the end of the transaction, so concurrent dispatchers
+// book it one after another; the bookings are read once the lock is held.
+func (r *GormPickupSlotRepository) GetFirstAvailable(ctx context.Context, now time.Time) (*pickup.Slot, error) {
+	query := r.db.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("ends_at > ?", now.UTC()).
+		Where("capacity > (SELECT count(*) FROM pickup_slot_bookings b WHERE b.slot_id = pickup_slots.id)").
+		Order("starts_at, id")
+
+	return r.first(ctx, "first available", query)
+}
+
+// GetByOrder retrieves the pickup slot the order is booked into.
+func (r *GormPickupSlotRepository) GetByOrder(ctx context.Context, orderID kernel.UUID) (*pickup.Slot, error) {
+	if err := orderID.Validate(); err != nil {
+		return nil, err
+	}
+
+	query := r.db.Where("id = (SELECT slot_id FROM pickup_slot_bookings WHERE order_id = ?)", orderID.Bytes())
+	return r.first(ctx, "booked for order "+orderID.String(), query)
+}
+
+// GetNotStarted retrieves the pickup slots that start after now, ordered by start.
+func (r *GormPickupSlotRepository) GetNotStarted(ctx context.Context, now time.Time) ([]*pickup.Slot, error) {
+	var dtos []PickupSlotDTO
+	if err := r.db.WithContext(ctx).Preload("Bookings", orderedBookings).
+		Where("starts_at > ?", now.UTC()).
+		Order("starts_at, id").
+		Find(&dtos).Error; err != nil {
+		return nil, err
+	}
+
+	slots := make([]*pickup.Slot, 0, len(dtos))
+	for _, dto := range dtos {
+		slot, err := toDomain(dto)
+		if err != nil {
+			return nil, err
+		}
+		slots = append(slots, slot)
+	}
+
+	return slots, nil
+}
+
+// first loads the first slot matched by query together with its bookings.
+func (r *GormPickupSlotRepository) first(ctx context.Context, key string, query *gorm.DB) (*pickup.Slot, error) {
+	var dto PickupSlotDTO
+	if err := query.WithContext(ctx).Preload("Bookings", orderedBookings).First(&dto).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewObjectNotFoundError("pickup slot", key)
+		}
+		return nil, err
+	}
+
+	return toDomain(dto)
+}
@@ -0,0 +1,217 @@
+package pickuprepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/adapters/out/postgres/pickuprepo"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/pickup"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+// MockAggregateTracker is a mock implementation of aggregateTracker interface.
+type MockAggregateTracker struct {
+	mock.Mock
+}
+
+func (m *MockAggregateTracker) TrackAggregate(id kernel.UUID, aggregate any) {
+	m.Called(id, aggregate)
+}
+
+// PickupSlotRepositoryIntegrationTestSuite provides integration tests for PickupSlotRepository
+// using PostgreSQL containers to verify database persistence behavior.
+type PickupSlotRepositoryIntegrationTestSuite struct {
+	suite.Suite
+	template   *pgtest.Template
+	db         *gorm.DB
+	repository *pickuprepo.GormPickupSlotRepository
+	orders     *orderrepo.GormOrderRepository
+	now        time.Time
+}
+
+func (suite *PickupSlotRepositoryIntegrationTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&orderrepo.OrderDTO{}, &orderrepo.OrderMessageDTO{}, &orderrepo.OrderItemDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{},
+			&pickuprepo.PickupSlotDTO{}, &pickuprepo.PickupSlotBookingDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *PickupSlotRepositoryIntegrationTestSuite) SetupTest() {
+	// Every test works in a fresh clone of the migrated database
+	suite.db = suite.template.NewDatabase(suite.T())
+
+	tracker := new(MockAggregateTracker)
+	tracker.On("TrackAggregate", mock.Anything, mock.Anything)
+	suite.repository = pickuprepo.NewGormPickupSlotRepository(suite.db, tracker)
+	suite.orders = orderrepo.NewGormOrderRepository(suite.db, tracker)
+	suite.now = time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+}
+
+func (suite *PickupSlotRepositoryIntegrationTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		suite.Require().NoError(suite.template.Terminate(context.Background()))
+	}
+}
+
+func (suite *PickupSlotRepositoryIntegrationTestSuite) TestAddAndGet_RestoresBookingsInOrder() {
+	ctx := context.Background()
+	first, second := suite.addOrder(), suite.addOrder()
+	slot := suite.addSlot(suite.now, 3, second, first)
+
+	retrieved, err := suite.repository.Get(ctx, slot.ID())
+
+	suite.Require().NoError(err)
+	suite.True(slot.StartsAt().Equal(retrieved.StartsAt()))
+	suite.True(slot.EndsAt().Equal(retrieved.EndsAt()))
+	suite.Equal(3, retrieved.Capacity())
+	suite.Equal([]kernel.UUID{second, first}, retrieved.Bookings())
+}
+
+func (suite *PickupSlotRepositoryIntegrationTestSuite) TestGet_NonExistentSlot_ReturnsNotFoundError() {
+	_, err := suite.repository.Get(context.Background(), kernel.NewUUID())
+
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+}
+
+func (suite *PickupSlotRepositoryIntegrationTestSuite) TestUpdate_StoresCapacityAndNewBookings() {
+	ctx := context.Background()
+	booked, added := suite.addOrder(), suite.addOrder()
+	slot := suite.addSlot(suite.now, 2, booked)
+
+	suite.Require().NoError(slot.ChangeCapacity(4))
+	suite.Require().NoError(slot.Book(added, suite.now))
+	suite.Require().NoError(suite.repository.Update(ctx, slot))
+
+	retrieved, err := suite.repository.Get(ctx, slot.ID())
+	suite.Require().NoError(err)
+	suite.Equal(4, retrieved.Capacity())
+	suite.Equal([]kernel.UUID{booked, added}, retrieved.Bookings())
+}
+
+func (suite *PickupSlotRepositoryIntegrationTestSuite) TestUpdate_DeletesReleasedBookings() {
+	ctx := context.Background()
+	first, released, last := suite.addOrder(), suite.addOrder(), suite.addOrder()
+	slot := suite.addSlot(suite.now, 3, first, released, last)
+
+	suite.Require().NoError(slot.Release(released))
+	suite.Require().NoError(suite.repository.Update(ctx, slot))
+	added := suite.addOrder()
+	suite.Require().NoError(slot.Book(added, suite.now))
+	suite.Require().NoError(suite.repository.Update(ctx, slot))
+
+	retrieved, err := suite.repository.Get(ctx, slot.ID())
+	suite.Require().NoError(err)
+	suite.Equal([]kernel.UUID{first, last, added}, retrieved.Bookings())
+	_, err = suite.repository.GetByOrder(ctx, released)
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+}
+
+func (suite *PickupSlotRepositoryIntegrationTestSuite) TestUpdate_NonExistentSlot_ReturnsError() {
+	slot, err := pickup.NewSlot(kernel.NewUUID(), suite.now, suite.now.Add(time.Hour), 1)
+	suite.Require().NoError(err)
+
+	suite.Require().ErrorIs(suite.repository.Update(context.Background(), slot), gorm.ErrRecordNotFound)
+}
+
+func (suite *PickupSlotRepositoryIntegrationTestSuite) TestGetFirstAvailable_SkipsFullAndEndedSlots() {
+	ctx := context.Background()
+	suite.addSlot(suite.now.Add(-time.Hour), 5)                        // ended
+	suite.addSlot(suite.now.Add(-10*time.Minute), 1, suite.addOrder()) // full
+	later := suite.addSlot(suite.now.Add(30*time.Minute), 1)
+	current := suite.addSlot(suite.now.Add(-5*time.Minute), 2, suite.addOrder())
+
+	err := suite.db.Transaction(func(tx *gorm.DB) error {
+		slot, err := pickuprepo.NewGormPickupSlotRepository(tx, suite.trackerStub()).GetFirstAvailable(ctx, suite.now)
+		suite.Require().NoError(err)
+		suite.Equal(current.ID(), slot.ID())
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.repository.GetFirstAvailable(ctx, later.EndsAt())
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+}
+
+func (suite *PickupSlotRepositoryIntegrationTestSuite) TestGetByOrder() {
+	ctx := context.Background()
+	orderID := suite.addOrder()
+	slot := suite.addSlot(suite.now, 2, orderID)
+
+	retrieved, err := suite.repository.GetByOrder(ctx, orderID)
+	suite.Require().NoError(err)
+	suite.Equal(slot.ID(), retrieved.ID())
+
+	_, err = suite.repository.GetByOrder(ctx, suite.addOrder())
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+}
+
+func (suite *PickupSlotRepositoryIntegrationTestSuite) TestGetNotStarted_ReturnsUpcomingSlotsByStart() {
+	ctx := context.Background()
+	suite.addSlot(suite.now.Add(-5*time.Minute), 1)
+	second := suite.addSlot(suite.now.Add(time.Hour), 1)
+	first := suite.addSlot(suite.now.Add(time.Minute), 1, suite.addOrder())
+
+	slots, err := suite.repository.GetNotStarted(ctx, suite.now)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(slots, 2)
+	suite.Equal(first.ID(), slots[0].ID())
+	suite.Len(slots[0].Bookings(), 1)
+	suite.Equal(second.ID(), slots[1].ID())
+}
+
+func (suite *PickupSlotRepositoryIntegrationTestSuite) TestBookingsAreRemovedWithTheirOrder() {
+	ctx := context.Background()
+	orderID := suite.addOrder()
+	slot := suite.addSlot(suite.now, 1, orderID)
+
+	suite.Require().NoError(suite.db.Exec("DELETE FROM orders WHERE id = ?", orderID.Bytes()).Error)
+
+	retrieved, err := suite.repository.Get(ctx, slot.ID())
+	suite.Require().NoError(err)
+	suite.Empty(retrieved.Bookings())
+}
+
+func (suite *PickupSlotRepositoryIntegrationTestSuite) addOrder() kernel.UUID {
+	location, err := kernel.NewLocation(5, 5)
+	suite.Require().NoError(err)
+	o, err := order.NewOrder(kernel.NewUUID(), location, 5)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.orders.Add(context.Background(), o))
+	return o.ID()
+}
+
+// addSlot stores a thirty minute slot starting at startsAt with the given bookings.
+func (suite *PickupSlotRepositoryIntegrationTestSuite) addSlot(
+	startsAt time.Time, capacity int, bookings ...kernel.UUID,
+) *pickup.Slot {
+	slot, err := pickup.RestoreSlot(kernel.NewUUID(), startsAt, startsAt.Add(30*time.Minute), capacity, bookings)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.repository.Add(context.Background(), slot))
+	return slot
+}
+
+func (suite *PickupSlotRepositoryIntegrationTestSuite) trackerStub() *MockAggregateTracker {
+	tracker := new(MockAggregateTracker)
+	tracker.On("TrackAggregate", mock.Anything, mock.Anything)
+	return tracker
+}
+
+func TestPickupSlotRepositoryIntegrationTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(PickupSlotRepositoryIntegrationTestSuite))
+}
@@ -0,0 +1,468 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/pkg/errs"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrProjectionNameIsRequired is returned when registering a projection without a name.
+	ErrProjectionNameIsRequired = errors.New("projection name is required")
+
+	// ErrProjectionIsAlreadyRegistered is returned when two projections share the same name.
+	ErrProjectionIsAlreadyRegistered = errors.New("projection is already registered")
+
+	// ErrBackfillBatchSizeIsInvalid is returned when a backfill is asked for empty batches.
+	ErrBackfillBatchSizeIsInvalid = errors.New("backfill batch size must be between 1 and the change feed page limit")
+
+	// ErrBackfillRateIsInvalid is returned when a backfill is given a negative rate.
+	ErrBackfillRateIsInvalid = errors.New("backfill rate must not be negative")
+)
+
+// Projection is a read model built from the change feed of orders and couriers.
+// Implementations write to their own tables within the transaction they are given and must
+// not commit or roll it back; the ProjectionBackfillRunner owns the transaction lifecycle
+// and stores the cursor of the last projected change in the same transaction.
+// Tables of a projection hold tenant data and belong in tenantTables.
+//
+// Example:
+//
+//	type deliveredOrdersPerDay struct{}
+//
+//	func (deliveredOrdersPerDay) Name() string        { return "delivered-orders-per-day" }
+//	func (deliveredOrdersPerDay) Description() string { return "Completed orders per day" }
+//
+//	func (deliveredOrdersPerDay) Reset(ctx context.Context, tx *gorm.DB) error {
+//	    return tx.Exec("DELETE FROM delivered_orders_per_day").Error
+//	}
+//
+//	func (deliveredOrdersPerDay) Project(ctx context.Context, tx *gorm.DB, changes []queries.Change) error {
+//	    // upsert a row per day of every change that completed an order
+//	}
+type Projection interface {
+	// Name uniquely identifies the projection on the command line and in the
+	// projection_checkpoints table.
+	Name() string
+
+	// Description explains what the read model holds.
+	Description() string
+
+	// Reset deletes everything the projection has built, so a backfill can start over.
+	Reset(ctx context.Context, tx *gorm.DB) error
+
+	// Project applies a batch of changes, oldest first.
+	Project(ctx context.Context, tx *gorm.DB, changes []queries.Change) error
+}
+
+// ProjectionCheckpointDTO records how far a projection has consumed the change feed of a
+// tenant. The change log is per tenant, so the table is shared and keyed by the tenant
+// explicitly rather than protected by row-level security.
+type ProjectionCheckpointDTO struct {
+	Tenant      string    `gorm:"type:varchar(64);primaryKey"`
+	Projection  string    `gorm:"type:varchar(255);primaryKey"`
+	Cursor      int64     `gorm:"not null"`
+	Projected   int64     `gorm:"not null"`
+	UpdatedAt   time.Time `gorm:"not null"`
+	CompletedAt *time.Time
+}
+
+// TableName specifies the database table name for projection checkpoints.
+// Overrides GORM's default naming convention to use "projection_checkpoints".
+func (ProjectionCheckpointDTO) TableName() string {
+	return "projection_checkpoints"
+}
+
+// BackfillOptions tunes a projection backfill.
+type BackfillOptions struct {
+	// BatchSize is the number of changes projected and checkpointed in one transaction.
+	BatchSize int
+
+	// MaxPerSecond limits the projected changes per second, 0 disables throttling.
+	MaxPerSecond int
+
+	// Restart resets the projection and its checkpoint before the backfill.
+	Restart bool
+}
+
+// ProjectionProgress describes how far a projection has consumed the change feed.
+type ProjectionProgress struct {
+	Name string
+
+	// Cursor is the change log ID of the last projected change.
+	Cursor int64
+
+	// Head is the change log ID of the latest change.
+	Head int64
+
+	// Projected counts the changes projected since the projection was last reset.
+	Projected int64
+
+	// CompletedAt is when a backfill last caught up with the head, nil if none has.
+	CompletedAt *time.Time
+}
+
+// ProjectionBackfillRunner populates new read-model projections from the change log, so
+// dashboards built on them do not start empty. It reads the change feed in batches and
+// projects every batch in one transaction together with the checkpoint, so a backfill
+// stopped by an error or a signal resumes after the last committed batch and never
+// projects a change twice.
+//
+// Example:
+//
+//	runner, err := NewProjectionBackfillRunner(db, logger, newDeliveredOrdersPerDay())
+//	if err != nil {
+//	    return err
+//	}
+//
+//	progress, err := runner.Backfill(ctx, "delivered-orders-per-day", BackfillOptions{BatchSize: 500})
+//	fmt.Printf("projected up to change %d of %d\n", progress.Cursor, progress.Head)
+type ProjectionBackfillRunner struct {
+	db          *gorm.DB
+	logger      *slog.Logger
+	changes     queries.GetChangesQueryHandler
+	projections map[string]Projection
+}
+
+// NewProjectionBackfillRunner creates a runner for the given projections.
+// Returns an error if a projection has no name or two projections share a name.
+func NewProjectionBackfillRunner(
+	db *gorm.DB,
+	logger *slog.Logger,
+	projections ...Projection,
+) (*ProjectionBackfillRunner, error) {
+	runner := &ProjectionBackfillRunner{
+		db:          db,
+		logger:      logger,
+		changes:     queries.NewGetChangesQueryHandler(db),
+		projections: make(map[string]Projection, len(projections)),
+	}
+
+	for _, projection := range projections {
+		if projection.Name() == "" {
+			return nil, ErrProjectionNameIsRequired
+		}
+		if _, exists := runner.projections[projection.Name()]; exists {
+			return nil, fmt.Errorf("%w: %s", ErrProjectionIsAlreadyRegistered, projection.Name())
+		}
+		runner.projections[projection.Name()] = projection
+	}
+
+	return runner, nil
+}
+
+// Projections returns the registered projections sorted by name.
+func (r *ProjectionBackfillRunner) Projections() []Projection {
+	projections := make([]Projection, 0, len(r.projections))
+	for _, projection := range r.projections {
+		projections = append(projections, projection)
+	}
+
+	sort.Slice(projections, func(i, j int) bool {
+		return projections[i].Name() < projections[j].Name()
+	})
+	return projections
+}
+
+// Progress reports how far the named projection has consumed the change feed of the tenant
+// carried by ctx. Returns an ObjectNotFoundError for unknown projections.
+func (r *ProjectionBackfillRunner) Progress(ctx context.Context, name string) (ProjectionProgress, error) {
+	if _, ok := r.projections[name]; !ok {
+		return ProjectionProgress{}, errs.NewObjectNotFoundError("projection", name)
+	}
+
+	var progress ProjectionProgress
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		checkpoint, err := loadCheckpoint(tx, name, false)
+		if err != nil {
+			return err
+		}
+		head, err := changeLogHead(tx)
+		if err != nil {
+			return err
+		}
+
+		progress = checkpoint.progress(head)
+		return nil
+	})
+	return progress, err
+}
+
+// Backfill projects the changes recorded after the checkpoint of the named projection until
+// it catches up with the change log, logging progress after every batch. Changes committed
+// while the backfill runs are projected too. On failure the returned progress describes the
+// last committed batch, which is where the next run resumes.
+// Returns an ObjectNotFoundError for unknown projections.
+func (r *ProjectionBackfillRunner) Backfill(
+	ctx context.Context,
+	name string,
+	options BackfillOptions,
+) (ProjectionProgress, error) {
+	projection, ok := r.projections[name]
+	if !ok {
+		return ProjectionProgress{}, errs.NewObjectNotFoundError("projection", name)
+	}
+	if options.BatchSize < 1 || options.BatchSize > queries.MaxChangesLimit {
+		return ProjectionProgress{}, ErrBackfillBatchSizeIsInvalid
+	}
+	if options.MaxPerSecond < 0 {
+		return ProjectionProgress{}, ErrBackfillRateIsInvalid
+	}
+
+	if options.Restart {
+		if err := r.reset(ctx, projection); err != nil {
+			return ProjectionProgress{}, err
+		}
+	}
+
+	progress, err := r.Progress(ctx, name)
+	if err != nil {
+		return ProjectionProgress{}, err
+	}
+
+	r.logger.InfoContext(ctx, "projection backfill started",
+		"projection", name,
+		"cursor", progress.Cursor,
+		"head", progress.Head,
+	)
+
+	next := time.Now()
+	for {
+		if err = waitUntil(ctx, next); err != nil {
+			return progress, err
+		}
+
+		started := time.Now()
+		var (
+			caughtUp bool
+			batch    int
+		)
+		caughtUp, batch, err = r.projectBatch(ctx, projection, options.BatchSize, &progress)
+		if err != nil {
+			return progress, err
+		}
+
+		if batch > 0 {
+			r.logger.InfoContext(ctx, "projection backfill progress",
+				"projection", name,
+				"cursor", progress.Cursor,
+				"head", progress.Head,
+				"projected", progress.Projected,
+			)
+		}
+
+		if caughtUp {
+			r.logger.InfoContext(ctx, "projection backfill finished",
+				"projection", name,
+				"cursor", progress.Cursor,
+				"projected", progress.Projected,
+			)
+			return progress, nil
+		}
+
+		if options.MaxPerSecond > 0 {
+			next = started.Add(time.Duration(batch) * time.Second / time.Duration(options.MaxPerSecond))
+		}
+	}
+}
+
+// ProjectNext projects the next batch of changes of every registered projection for the tenant
+// carried by ctx, so projections keep up with the change log once backfilled. It stops at the
+// first failing projection and returns the number of changes projected until then.
+func (r *ProjectionBackfillRunner) ProjectNext(ctx context.Context, batchSize int) (int, error) {
+	if batchSize < 1 || batchSize > queries.MaxChangesLimit {
+		return 0, ErrBackfillBatchSizeIsInvalid
+	}
+
+	projected := 0
+	for _, projection := range r.Projections() {
+		var progress ProjectionProgress
+		_, batch, err := r.projectBatch(ctx, projection, batchSize, &progress)
+		if err != nil {
+			return projected, fmt.Errorf("projection %s: %w", projection.Name(), err)
+		}
+		projected += batch
+	}
+	return projected, nil
+}
+
+// projectBatch projects the next batch of changes and moves the checkpoint past it in one
+// transaction, then updates progress. It reports whether the projection caught up with the
+// change log and how many changes the batch held.
+func (r *ProjectionBackfillRunner) projectBatch(
+	ctx context.Context,
+	projection Projection,
+	batchSize int,
+	progress *ProjectionProgress,
+) (bool, int, error) {
+	var (
+		next     ProjectionProgress
+		caughtUp bool
+		batch    int
+	)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		// The lock keeps two runs of the same backfill from projecting the same changes
+		checkpoint, err := loadCheckpoint(tx, projection.Name(), true)
+		if err != nil {
+			return err
+		}
+
+		query, err := queries.NewGetChangesQuery(checkpoint.Cursor, batchSize)
+		if err != nil {
+			return err
+		}
+		feed, err := r.changes.Handle(ctx, query)
+		if err != nil {
+			return err
+		}
+		batch = len(feed.Changes)
+
+		if batch > 0 {
+			if err = projection.Project(ctx, tx, feed.Changes); err != nil {
+				return fmt.Errorf("project changes %d..%d: %w", feed.Changes[0].Cursor, feed.NextCursor, err)
+			}
+		}
+
+		checkpoint.Cursor = feed.NextCursor
+		checkpoint.Projected += int64(batch)
+		checkpoint.UpdatedAt = time.Now().UTC()
+		if caughtUp = batch < batchSize; caughtUp {
+			completedAt := checkpoint.UpdatedAt
+			checkpoint.CompletedAt = &completedAt
+		}
+		if err = saveCheckpoint(tx, checkpoint); err != nil {
+			return err
+		}
+
+		head, err := changeLogHead(tx)
+		if err != nil {
+			return err
+		}
+		next = checkpoint.progress(head)
+		return nil
+	})
+	if err != nil {
+		return false, 0, err
+	}
+
+	*progress = next
+	return caughtUp, batch, nil
+}
+
+// reset deletes the read model of the projection and its checkpoint in one transaction.
+func (r *ProjectionBackfillRunner) reset(ctx context.Context, projection Projection) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		if err := projection.Reset(ctx, tx); err != nil {
+			return err
+		}
+
+		err := tx.Exec(
+			"DELETE FROM projection_checkpoints WHERE tenant = delivery_current_tenant() AND projection = ?",
+			projection.Name(),
+		).Error
+		if err != nil {
+			return err
+		}
+
+		r.logger.InfoContext(ctx, "projection reset", "projection", projection.Name())
+		return nil
+	})
+}
+
+// loadCheckpoint returns the checkpoint of the projection for the tenant bound to tx, or an
+// empty one if the projection was never backfilled. With lock the row is locked until tx ends;
+// a missing row is created first so there is always a row to lock.
+func loadCheckpoint(tx *gorm.DB, name string, lock bool) (ProjectionCheckpointDTO, error) {
+	if lock {
+		err := tx.Exec(`
+			INSERT INTO projection_checkpoints (tenant, projection, cursor, projected, updated_at)
+			VALUES (delivery_current_tenant(), ?, 0, 0, ?)
+			ON CONFLICT DO NOTHING
+		`, name, time.Now().UTC()).Error
+		if err != nil {
+			return ProjectionCheckpointDTO{}, err
+		}
+	}
+
+	query := tx.Where("tenant = delivery_current_tenant() AND projection = ?", name)
+	if lock {
+		query = query.Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+
+	var checkpoints []ProjectionCheckpointDTO
+	if err := query.Limit(1).Find(&checkpoints).Error; err != nil {
+		return ProjectionCheckpointDTO{}, err
+	}
+
+	if len(checkpoints) == 0 {
+		return ProjectionCheckpointDTO{Projection: name}, nil
+	}
+	return checkpoints[0], nil
+}
+
+// saveCheckpoint stores the checkpoint loaded with a lock by loadCheckpoint.
+func saveCheckpoint(tx *gorm.DB, checkpoint ProjectionCheckpointDTO) error {
+	return tx.Exec(`
+		UPDATE projection_checkpoints
+		SET cursor = ?, projected = ?, updated_at = ?, completed_at = ?
+		WHERE tenant = delivery_current_tenant() AND projection = ?
+	`, checkpoint.Cursor, checkpoint.Projected, checkpoint.UpdatedAt, checkpoint.CompletedAt,
+		checkpoint.Projection).Error
+}
+
+// changeLogHead returns the ID of the latest change of the tenant bound to tx, 0 if none.
+func changeLogHead(tx *gorm.DB) (int64, error) {
+	var head int64
+	err := tx.Raw("SELECT COALESCE(MAX(id), 0) FROM change_log").Scan(&head).Error
+	return head, err
+}
+
+// progress describes the checkpoint relative to the head of the change log.
+func (dto ProjectionCheckpointDTO) progress(head int64) ProjectionProgress {
+	return ProjectionProgress{
+		Name:        dto.Projection,
+		Cursor:      dto.Cursor,
+		Head:        head,
+		Projected:   dto.Projected,
+		CompletedAt: dto.CompletedAt,
+	}
+}
+
+// waitUntil blocks until the given time or until the context is cancelled.
+func waitUntil(ctx context.Context, at time.Time) error {
+	delay := time.Until(at)
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
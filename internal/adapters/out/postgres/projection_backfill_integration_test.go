@@ -0,0 +1,223 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/pkg/pgtest"
+	"delivery/internal/pkg/tenant"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+// ProjectionBackfillIntegrationTestSuite verifies that backfills project the whole change log,
+// resume after failures without projecting a change twice and keep tenants apart.
+// Row-level security never applies to superusers, so the runner connects as the application
+// role while the suite keeps a superuser connection for setup.
+type ProjectionBackfillIntegrationTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	adminDB  *gorm.DB
+	appDB    *gorm.DB
+}
+
+// SetupSuite starts PostgreSQL and creates the tenant tables, the checkpoints, the read model
+// of the test projection and the application role.
+func (suite *ProjectionBackfillIntegrationTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		if err := migrateTenantTables(db); err != nil {
+			return err
+		}
+		if err := db.AutoMigrate(&postgres_adapter.ProjectionCheckpointDTO{}); err != nil {
+			return err
+		}
+		if err := postgres_adapter.ApplyTenancyPolicies(db, defaultTenant); err != nil {
+			return err
+		}
+		err := db.Exec("CREATE TABLE projected_couriers (change_id bigint PRIMARY KEY, name text NOT NULL)").Error
+		if err != nil {
+			return err
+		}
+		return createAppRole(db)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+// SetupTest clones the template for the test and connects to the clone
+// both as the superuser and as the application role.
+func (suite *ProjectionBackfillIntegrationTestSuite) SetupTest() {
+	database := suite.template.CreateDatabase(suite.T())
+
+	suite.adminDB = pgtest.Open(suite.T(), suite.template.DSN(pgtest.User, pgtest.Password, database))
+	suite.appDB = pgtest.Open(suite.T(), suite.template.DSN("delivery_app", "app", database))
+}
+
+// TearDownSuite cleans up PostgreSQL container after all tests complete.
+func (suite *ProjectionBackfillIntegrationTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *ProjectionBackfillIntegrationTestSuite) TestBackfill_ProjectsWholeChangeLogInBatches() {
+	ctx := context.Background()
+	suite.appendCourierChanges(ctx, 7)
+	runner := suite.runner(&courierNamesProjection{})
+
+	progress, err := runner.Backfill(ctx, "courier-names", postgres_adapter.BackfillOptions{BatchSize: 3})
+
+	suite.Require().NoError(err)
+	suite.Equal(int64(7), progress.Projected)
+	suite.Equal(progress.Head, progress.Cursor)
+	suite.NotNil(progress.CompletedAt)
+	suite.Equal(int64(7), suite.projectedCount())
+
+	// A second run finds nothing new and keeps the checkpoint
+	progress, err = runner.Backfill(ctx, "courier-names", postgres_adapter.BackfillOptions{BatchSize: 3})
+	suite.Require().NoError(err)
+	suite.Equal(int64(7), progress.Projected)
+}
+
+func (suite *ProjectionBackfillIntegrationTestSuite) TestBackfill_ResumesAfterFailedBatch() {
+	ctx := context.Background()
+	suite.appendCourierChanges(ctx, 5)
+	failing := &courierNamesProjection{failAfter: 2}
+	runner := suite.runner(failing)
+
+	progress, err := runner.Backfill(ctx, "courier-names", postgres_adapter.BackfillOptions{BatchSize: 2})
+
+	suite.Require().Error(err)
+	suite.Equal(int64(2), progress.Projected)
+	suite.Equal(int64(2), suite.projectedCount())
+
+	failing.failAfter = 0
+	progress, err = runner.Backfill(ctx, "courier-names", postgres_adapter.BackfillOptions{BatchSize: 2})
+
+	// Projecting a change twice would violate the primary key of the read model
+	suite.Require().NoError(err)
+	suite.Equal(int64(5), progress.Projected)
+	suite.Equal(int64(5), suite.projectedCount())
+}
+
+func (suite *ProjectionBackfillIntegrationTestSuite) TestBackfill_RestartRebuildsProjection() {
+	ctx := context.Background()
+	suite.appendCourierChanges(ctx, 3)
+	runner := suite.runner(&courierNamesProjection{})
+	_, err := runner.Backfill(ctx, "courier-names", postgres_adapter.BackfillOptions{BatchSize: 10})
+	suite.Require().NoError(err)
+
+	progress, err := runner.Backfill(ctx, "courier-names", postgres_adapter.BackfillOptions{BatchSize: 10, Restart: true})
+
+	suite.Require().NoError(err)
+	suite.Equal(int64(3), progress.Projected)
+	suite.Equal(int64(3), suite.projectedCount())
+}
+
+func (suite *ProjectionBackfillIntegrationTestSuite) TestBackfill_KeepsCheckpointPerTenant() {
+	acme := tenant.WithID(context.Background(), acmeTenant)
+	suite.appendCourierChanges(context.Background(), 2)
+	suite.appendCourierChanges(acme, 3)
+	runner := suite.runner(&courierNamesProjection{})
+
+	progress, err := runner.Backfill(acme, "courier-names", postgres_adapter.BackfillOptions{BatchSize: 10})
+	suite.Require().NoError(err)
+	suite.Equal(int64(3), progress.Projected)
+
+	progress, err = runner.Progress(context.Background(), "courier-names")
+	suite.Require().NoError(err)
+	suite.Zero(progress.Cursor)
+	suite.Nil(progress.CompletedAt)
+}
+
+func (suite *ProjectionBackfillIntegrationTestSuite) TestBackfill_Throttled() {
+	ctx := context.Background()
+	suite.appendCourierChanges(ctx, 4)
+	runner := suite.runner(&courierNamesProjection{})
+
+	started := time.Now()
+	_, err := runner.Backfill(ctx, "courier-names", postgres_adapter.BackfillOptions{BatchSize: 2, MaxPerSecond: 10})
+
+	suite.Require().NoError(err)
+	// The first two batches of two changes each take 200ms at 10 changes per second
+	suite.GreaterOrEqual(time.Since(started), 400*time.Millisecond)
+}
+
+func (suite *ProjectionBackfillIntegrationTestSuite) runner(projection postgres_adapter.Projection) *postgres_adapter.ProjectionBackfillRunner {
+	runner, err := postgres_adapter.NewProjectionBackfillRunner(suite.appDB, slog.Default(), projection)
+	suite.Require().NoError(err)
+	return runner
+}
+
+// appendCourierChanges records changes of new couriers in the change log of the tenant in ctx.
+func (suite *ProjectionBackfillIntegrationTestSuite) appendCourierChanges(ctx context.Context, count int) {
+	err := suite.adminDB.Transaction(func(tx *gorm.DB) error {
+		if id, ok := tenant.FromContext(ctx); ok {
+			if err := bindTenant(tx, id); err != nil {
+				return err
+			}
+		}
+		for i := range count {
+			err := tx.Exec(`
+				INSERT INTO change_log (aggregate_type, aggregate_id, version, changed_at, snapshot)
+				VALUES (?, ?, 1, ?, ?)
+			`, queries.CourierChange, uuid.New(), time.Now().UTC(), fmt.Sprintf(`{"name":"Courier %d"}`, i)).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *ProjectionBackfillIntegrationTestSuite) projectedCount() int64 {
+	var count int64
+	suite.Require().NoError(suite.adminDB.Table("projected_couriers").Count(&count).Error)
+	return count
+}
+
+// courierNamesProjection copies the names of couriers into projected_couriers.
+// With failAfter it fails once it has projected that many changes.
+type courierNamesProjection struct {
+	failAfter int
+	projected int
+}
+
+func (p *courierNamesProjection) Name() string        { return "courier-names" }
+func (p *courierNamesProjection) Description() string { return "Names of couriers as of every change" }
+
+func (p *courierNamesProjection) Reset(_ context.Context, tx *gorm.DB) error {
+	p.projected = 0
+	return tx.Exec("DELETE FROM projected_couriers").Error
+}
+
+func (p *courierNamesProjection) Project(_ context.Context, tx *gorm.DB, changes []queries.Change) error {
+	if p.failAfter > 0 && p.projected >= p.failAfter {
+		return errors.New("projection failed")
+	}
+
+	for _, change := range changes {
+		err := tx.Exec("INSERT INTO projected_couriers (change_id, name) VALUES (?, ?)",
+			change.Cursor, change.Courier.Name).Error
+		if err != nil {
+			return err
+		}
+	}
+	p.projected += len(changes)
+	return nil
+}
+
+func TestProjectionBackfillIntegrationTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ProjectionBackfillIntegrationTestSuite))
+}
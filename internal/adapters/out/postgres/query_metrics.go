@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/pkg/metrics"
+
+	"gorm.io/gorm"
+)
+
+// queryStartedKey stores the start of a statement in the instance of its GORM statement.
+const queryStartedKey = "metrics:query_started"
+
+// queryDuration measures the statements run through GORM by operation and table.
+var queryDuration = metrics.NewHistogramVec(
+	"db_query_duration_seconds",
+	"Duration of database statements run through GORM by operation, table and outcome.",
+	metrics.DefaultDurationBuckets,
+	"operation", "table", "outcome",
+)
+
+// QueryMetrics is a GORM plugin timing every statement in the db_query_duration_seconds histogram.
+// Statements are labeled by the GORM operation and the table of the model; raw SQL has no table.
+// Lookups finding no record are counted as successes.
+//
+// Example:
+//
+//	if err := db.Use(postgres.QueryMetrics{}); err != nil {
+//	    return err
+//	}
+type QueryMetrics struct{}
+
+// Name returns "metrics", the name the plugin is registered under.
+func (QueryMetrics) Name() string {
+	return "metrics"
+}
+
+// Initialize registers the callbacks around the statements of every GORM operation.
+func (QueryMetrics) Initialize(db *gorm.DB) error {
+	callbacks := db.Callback()
+	return errors.Join(
+		callbacks.Create().Before("gorm:create").Register("metrics:before_create", startQuery),
+		callbacks.Create().After("gorm:create").Register("metrics:after_create", observeQuery("create")),
+		callbacks.Query().Before("gorm:query").Register("metrics:before_query", startQuery),
+		callbacks.Query().After("gorm:query").Register("metrics:after_query", observeQuery("query")),
+		callbacks.Update().Before("gorm:update").Register("metrics:before_update", startQuery),
+		callbacks.Update().After("gorm:update").Register("metrics:after_update", observeQuery("update")),
+		callbacks.Delete().Before("gorm:delete").Register("metrics:before_delete", startQuery),
+		callbacks.Delete().After("gorm:delete").Register("metrics:after_delete", observeQuery("delete")),
+		callbacks.Row().Before("gorm:row").Register("metrics:before_row", startQuery),
+		callbacks.Row().After("gorm:row").Register("metrics:after_row", observeQuery("row")),
+		callbacks.Raw().Before("gorm:raw").Register("metrics:before_raw", startQuery),
+		callbacks.Raw().After("gorm:raw").Register("metrics:after_raw", observeQuery("raw")),
+	)
+}
+
+func startQuery(tx *gorm.DB) {
+	tx.InstanceSet(queryStartedKey, time.Now())
+}
+
+func observeQuery(operation string) func(tx *gorm.DB) {
+	return func(tx *gorm.DB) {
+		value, ok := tx.InstanceGet(queryStartedKey)
+		if !ok {
+			return
+		}
+		started, ok := value.(time.Time)
+		if !ok {
+			return
+		}
+
+		outcome := "success"
+		if tx.Error != nil && !errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			outcome = "error"
+		}
+		queryDuration.ObserveSince(started, operation, tx.Statement.Table, outcome)
+	}
+}
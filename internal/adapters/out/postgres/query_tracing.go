@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/tracing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// querySpanKey stores the span of a statement in the instance of its GORM statement.
+const querySpanKey = "tracing:query_span"
+
+// QueryTracing is a GORM plugin recording every statement in a client span, a child of the span
+// of the statement's context. Spans carry the GORM operation, the table of the model and the
+// number of affected rows, not the SQL, so parameters such as addresses are never exported.
+// Lookups finding no record are not failures.
+//
+// Example:
+//
+//	if err := db.Use(postgres.QueryTracing{}); err != nil {
+//	    return err
+//	}
+type QueryTracing struct{}
+
+// Name returns "tracing", the name the plugin is registered under.
+func (QueryTracing) Name() string {
+	return "tracing"
+}
+
+// Initialize registers the callbacks around the statements of every GORM operation.
+func (QueryTracing) Initialize(db *gorm.DB) error {
+	callbacks := db.Callback()
+	return errors.Join(
+		callbacks.Create().Before("gorm:create").Register("tracing:before_create", startQuerySpan("create")),
+		callbacks.Create().After("gorm:create").Register("tracing:after_create", endQuerySpan),
+		callbacks.Query().Before("gorm:query").Register("tracing:before_query", startQuerySpan("query")),
+		callbacks.Query().After("gorm:query").Register("tracing:after_query", endQuerySpan),
+		callbacks.Update().Before("gorm:update").Register("tracing:before_update", startQuerySpan("update")),
+		callbacks.Update().After("gorm:update").Register("tracing:after_update", endQuerySpan),
+		callbacks.Delete().Before("gorm:delete").Register("tracing:before_delete", startQuerySpan("delete")),
+		callbacks.Delete().After("gorm:delete").Register("tracing:after_delete", endQuerySpan),
+		callbacks.Row().Before("gorm:row").Register("tracing:before_row", startQuerySpan("row")),
+		callbacks.Row().After("gorm:row").Register("tracing:after_row", endQuerySpan),
+		callbacks.Raw().Before("gorm:raw").Register("tracing:before_raw", startQuerySpan("raw")),
+		callbacks.Raw().After("gorm:raw").Register("tracing:after_raw", endQuerySpan),
+	)
+}
+
+func startQuerySpan(operation string) func(tx *gorm.DB) {
+	return func(tx *gorm.DB) {
+		name := operation
+		if tx.Statement.Table != "" {
+			name += " " + tx.Statement.Table
+		}
+
+		_, span := tracing.Start(tx.Statement.Context, name,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				attribute.String("db.system", "postgresql"),
+				attribute.String("db.operation", operation),
+				attribute.String("db.sql.table", tx.Statement.Table),
+			),
+		)
+		tx.InstanceSet(querySpanKey, span)
+	}
+}
+
+func endQuerySpan(tx *gorm.DB) {
+	value, ok := tx.InstanceGet(querySpanKey)
+	if !ok {
+		return
+	}
+	span, ok := value.(trace.Span)
+	if !ok {
+		return
+	}
+
+	span.SetAttributes(attribute.Int64("db.rows_affected", tx.RowsAffected))
+	if !errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		tracing.RecordError(span, tx.Error)
+	}
+	span.End()
+}
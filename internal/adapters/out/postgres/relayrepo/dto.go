@@ -0,0 +1,53 @@
+// Package relayrepo provides data transfer objects and the repository for the orders handed over
+// between couriers. Handovers are only ever inserted and keep the order's relay history.
+package relayrepo
+
+import (
+	"time"
+
+	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/relay"
+
+	"github.com/google/uuid"
+)
+
+// HandoverDTO represents the database structure for persisting handovers.
+// Handovers are removed together with either courier. Orders are kept as plain IDs, since the
+// relay history outlives completed orders moved to the archive; the synthetic data purge removes
+// the handovers of the orders it deletes. Storage places are kept as plain IDs too: a courier may
+// remove a storage place later without rewriting the history.
+type HandoverDTO struct {
+	ID                 uuid.UUID               `gorm:"type:uuid;primaryKey"`
+	OrderID            uuid.UUID               `gorm:"type:uuid;not null;index"`
+	FromCourierID      uuid.UUID               `gorm:"type:uuid;not null;index"`
+	FromCourier        *courierrepo.CourierDTO `gorm:"foreignKey:FromCourierID;constraint:OnDelete:CASCADE"`
+	FromStoragePlaceID uuid.UUID               `gorm:"type:uuid;not null"`
+	ToCourierID        uuid.UUID               `gorm:"type:uuid;not null;index"`
+	ToCourier          *courierrepo.CourierDTO `gorm:"foreignKey:ToCourierID;constraint:OnDelete:CASCADE"`
+	ToStoragePlaceID   uuid.UUID               `gorm:"type:uuid;not null"`
+	LocationX          kernel.Coordinate       `gorm:"type:smallint;not null"`
+	LocationY          kernel.Coordinate       `gorm:"type:smallint;not null"`
+	HandedOverAt       time.Time               `gorm:"not null;index"`
+}
+
+// TableName specifies the database table name for handovers.
+// Overrides GORM's default naming convention to use "order_handovers".
+func (HandoverDTO) TableName() string {
+	return "order_handovers"
+}
+
+// fromDomain converts a handover to its database representation.
+func fromDomain(handover *relay.Handover) HandoverDTO {
+	return HandoverDTO{
+		ID:                 handover.ID().Bytes(),
+		OrderID:            handover.OrderID().Bytes(),
+		FromCourierID:      handover.From().CourierID().Bytes(),
+		FromStoragePlaceID: handover.From().StoragePlaceID().Bytes(),
+		ToCourierID:        handover.To().CourierID().Bytes(),
+		ToStoragePlaceID:   handover.To().StoragePlaceID().Bytes(),
+		LocationX:          handover.Location().X(),
+		LocationY:          handover.Location().Y(),
+		HandedOverAt:       handover.HandedOverAt().UTC(),
+	}
+}
@@ -0,0 +1,30 @@
+package relayrepo
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/relay"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormHandoverRepository implements HandoverRepository using GORM.
+type GormHandoverRepository struct {
+	db *gorm.DB
+}
+
+// NewGormHandoverRepository creates a new GORM handover repository.
+func NewGormHandoverRepository(db *gorm.DB) *GormHandoverRepository {
+	return &GormHandoverRepository{db: db}
+}
+
+// Add persists a new handover.
+func (r *GormHandoverRepository) Add(ctx context.Context, handover *relay.Handover) error {
+	if err := handover.Validate(); err != nil {
+		return err
+	}
+
+	dto := fromDomain(handover)
+	return r.db.WithContext(ctx).Omit(clause.Associations).Create(&dto).Error
+}
@@ -0,0 +1,140 @@
+package relayrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/adapters/out/postgres/relayrepo"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/relay"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+// MockAggregateTracker is a mock implementation of aggregateTracker interface.
+type MockAggregateTracker struct {
+	mock.Mock
+}
+
+func (m *MockAggregateTracker) TrackAggregate(id kernel.UUID, aggregate any) {
+	m.Called(id, aggregate)
+}
+
+// HandoverRepositoryIntegrationTestSuite provides integration tests for the handover repository
+// using PostgreSQL containers to verify database persistence behavior.
+type HandoverRepositoryIntegrationTestSuite struct {
+	suite.Suite
+	template     *pgtest.Template
+	db           *gorm.DB
+	repository   *relayrepo.GormHandoverRepository
+	couriers     *courierrepo.GormCourierRepository
+	orders       *orderrepo.GormOrderRepository
+	handedOverAt time.Time
+}
+
+func (suite *HandoverRepositoryIntegrationTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&courierrepo.CourierDTO{}, &courierrepo.StoragePlaceDTO{}, &courierrepo.MaintenanceWindowDTO{},
+			&courierrepo.AbsenceDTO{},
+			&courierrepo.ScheduleWindowDTO{},
+			&orderrepo.OrderDTO{}, &orderrepo.OrderMessageDTO{}, &orderrepo.OrderItemDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{},
+			&relayrepo.HandoverDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *HandoverRepositoryIntegrationTestSuite) SetupTest() {
+	// Every test works in a fresh clone of the migrated database
+	suite.db = suite.template.NewDatabase(suite.T())
+
+	tracker := new(MockAggregateTracker)
+	tracker.On("TrackAggregate", mock.Anything, mock.Anything)
+	suite.repository = relayrepo.NewGormHandoverRepository(suite.db)
+	suite.couriers = courierrepo.NewGormCourierRepository(suite.db, tracker)
+	suite.orders = orderrepo.NewGormOrderRepository(suite.db, tracker)
+	suite.handedOverAt = time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+}
+
+func (suite *HandoverRepositoryIntegrationTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		suite.Require().NoError(suite.template.Terminate(context.Background()))
+	}
+}
+
+func (suite *HandoverRepositoryIntegrationTestSuite) TestAdd_NewHandover_Persisted() {
+	handover := suite.createHandover()
+
+	suite.Require().NoError(suite.repository.Add(context.Background(), handover))
+
+	var stored relayrepo.HandoverDTO
+	suite.Require().NoError(suite.db.First(&stored, "id = ?", handover.ID().Bytes()).Error)
+	suite.Equal(handover.OrderID().Bytes(), stored.OrderID)
+	suite.Equal(handover.From().CourierID().Bytes(), stored.FromCourierID)
+	suite.Equal(handover.From().StoragePlaceID().Bytes(), stored.FromStoragePlaceID)
+	suite.Equal(handover.To().CourierID().Bytes(), stored.ToCourierID)
+	suite.Equal(handover.To().StoragePlaceID().Bytes(), stored.ToStoragePlaceID)
+	suite.Equal(handover.Location().X(), stored.LocationX)
+	suite.Equal(handover.Location().Y(), stored.LocationY)
+	suite.True(suite.handedOverAt.Equal(stored.HandedOverAt))
+}
+
+func (suite *HandoverRepositoryIntegrationTestSuite) TestAdd_InvalidHandover_ReturnsError() {
+	err := suite.repository.Add(context.Background(), nil)
+
+	suite.Require().ErrorIs(err, relay.ErrHandoverIsNotConstructed)
+}
+
+func (suite *HandoverRepositoryIntegrationTestSuite) TestDeleteCourier_RemovesHandovers() {
+	handover := suite.createHandover()
+	suite.Require().NoError(suite.repository.Add(context.Background(), handover))
+
+	suite.Require().NoError(
+		suite.db.Exec("DELETE FROM couriers WHERE id = ?", handover.To().CourierID().Bytes()).Error)
+
+	var count int64
+	suite.Require().NoError(suite.db.Model(&relayrepo.HandoverDTO{}).Count(&count).Error)
+	suite.Zero(count)
+}
+
+func (suite *HandoverRepositoryIntegrationTestSuite) createHandover() *relay.Handover {
+	ctx := context.Background()
+	location, err := kernel.NewLocation(4, 4)
+	suite.Require().NoError(err)
+
+	giver, err := courier.NewCourier(kernel.NewUUID(), "Alice", 2, location)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.couriers.Add(ctx, giver))
+	receiver, err := courier.NewCourier(kernel.NewUUID(), "Bob", 2, location)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.couriers.Add(ctx, receiver))
+
+	o, err := order.NewOrder(kernel.NewUUID(), location, 1)
+	suite.Require().NoError(err)
+	suite.Require().NoError(o.Assign(receiver.ID()))
+	suite.Require().NoError(suite.orders.Add(ctx, o))
+
+	from, err := relay.NewHolder(giver.ID(), giver.StoragePlaces()[0].ID())
+	suite.Require().NoError(err)
+	to, err := relay.NewHolder(receiver.ID(), receiver.StoragePlaces()[0].ID())
+	suite.Require().NoError(err)
+	handover, err := relay.NewHandover(kernel.NewUUID(), o.ID(), from, to, location, suite.handedOverAt)
+	suite.Require().NoError(err)
+	return handover
+}
+
+func TestHandoverRepositoryIntegrationTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(HandoverRepositoryIntegrationTestSuite))
+}
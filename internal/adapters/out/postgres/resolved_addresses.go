@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ResolvedAddressDTO is the location the geo service resolved a normalized address to.
+type ResolvedAddressDTO struct {
+	Address    string            `gorm:"type:varchar(255);primaryKey"`
+	X          kernel.Coordinate `gorm:"type:smallint;not null"`
+	Y          kernel.Coordinate `gorm:"type:smallint;not null"`
+	ResolvedAt time.Time         `gorm:"not null"`
+}
+
+// TableName specifies the database table name for resolved addresses.
+// Overrides GORM's default naming convention to use "resolved_addresses".
+func (ResolvedAddressDTO) TableName() string {
+	return "resolved_addresses"
+}
+
+// GormResolvedAddressCache implements ports.ResolvedAddressCache over the resolved_addresses table.
+// The table is shared by all tenants like fraud_blacklist, since an address resolves to the
+// same location whoever delivers to it.
+type GormResolvedAddressCache struct {
+	db *gorm.DB
+}
+
+// NewGormResolvedAddressCache creates a cache of resolved addresses over the given connection.
+func NewGormResolvedAddressCache(db *gorm.DB) *GormResolvedAddressCache {
+	return &GormResolvedAddressCache{db: db}
+}
+
+// Get returns the cached location of the address and whether there is one.
+func (c *GormResolvedAddressCache) Get(ctx context.Context, address string) (kernel.Location, bool, error) {
+	var dtos []ResolvedAddressDTO
+	err := c.db.WithContext(ctx).
+		Where("address = ?", address).
+		Limit(1).
+		Find(&dtos).Error
+	if err != nil {
+		return kernel.Location{}, false, err
+	}
+
+	if len(dtos) == 0 {
+		return kernel.Location{}, false, nil
+	}
+
+	location, err := kernel.NewLocation(dtos[0].X, dtos[0].Y)
+	if err != nil {
+		return kernel.Location{}, false, err
+	}
+	return location, true, nil
+}
+
+// Put stores the location of the address, replacing the previous one.
+func (c *GormResolvedAddressCache) Put(ctx context.Context, address string, location kernel.Location) error {
+	dto := ResolvedAddressDTO{
+		Address:    address,
+		X:          location.X(),
+		Y:          location.Y(),
+		ResolvedAt: time.Now().UTC(),
+	}
+
+	return c.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "address"}},
+			DoUpdates: clause.AssignmentColumns([]string{"x", "y", "resolved_at"}),
+		}).
+		Create(&dto).Error
+}
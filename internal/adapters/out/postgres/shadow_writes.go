@@ -0,0 +1,273 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrShadowColumnIsInvalid is returned when a shadow column spec cannot be parsed
+	// or names a table that does not support shadow writes.
+	ErrShadowColumnIsInvalid = errors.New("shadow column is invalid")
+
+	// ErrShadowColumnIsMissing is returned when a column of a shadow column spec does not exist.
+	// The migration must add the new column before shadow writes are enabled for it.
+	ErrShadowColumnIsMissing = errors.New("shadow column does not exist")
+)
+
+// shadowWriteTrigger is the name of the trigger that fills the shadow columns of a table.
+const shadowWriteTrigger = "delivery_shadow_write"
+
+// columnName matches the unquoted identifiers allowed in shadow column specs,
+// since they are interpolated into DDL.
+var columnName = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// shadowWriteTables lists the hot tables whose columns can be migrated with shadow writes.
+func shadowWriteTables() []string {
+	return []string{"orders", "couriers"}
+}
+
+// ShadowColumn is a column of a hot table being migrated to a new column without downtime.
+// While shadow writes are enabled every write to the table also stores the value of Column
+// in Shadow, and every read compares the two, so the readers can be switched to Shadow once
+// no mismatches are reported.
+type ShadowColumn struct {
+	Table  string
+	Column string
+	Shadow string
+}
+
+// String returns the column in the "table.column:shadow" form of the configuration.
+func (c ShadowColumn) String() string {
+	return fmt.Sprintf("%s.%s:%s", c.Table, c.Column, c.Shadow)
+}
+
+// ParseShadowColumns parses a comma-separated list of "table.column:shadow" specs,
+// for example "orders.volume:volume_v2,couriers.speed:speed_v2".
+// Returns no columns for an empty spec and ErrShadowColumnIsInvalid for a malformed one.
+func ParseShadowColumns(spec string) ([]ShadowColumn, error) {
+	var columns []ShadowColumn
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		qualified, shadow, ok := strings.Cut(item, ":")
+		table, column, qualifiedOK := strings.Cut(qualified, ".")
+		if !ok || !qualifiedOK {
+			return nil, fmt.Errorf("%w: %q is not in table.column:shadow form", ErrShadowColumnIsInvalid, item)
+		}
+
+		c := ShadowColumn{Table: table, Column: column, Shadow: shadow}
+		if err := c.validate(columns); err != nil {
+			return nil, err
+		}
+		columns = append(columns, c)
+	}
+
+	return columns, nil
+}
+
+func (c ShadowColumn) validate(previous []ShadowColumn) error {
+	if !slices.Contains(shadowWriteTables(), c.Table) {
+		return fmt.Errorf("%w: %s: shadow writes support only tables %s",
+			ErrShadowColumnIsInvalid, c, strings.Join(shadowWriteTables(), ", "))
+	}
+	if !columnName.MatchString(c.Column) || !columnName.MatchString(c.Shadow) {
+		return fmt.Errorf("%w: %s: columns must be lower case identifiers", ErrShadowColumnIsInvalid, c)
+	}
+	if c.Column == c.Shadow {
+		return fmt.Errorf("%w: %s: a column cannot shadow itself", ErrShadowColumnIsInvalid, c)
+	}
+
+	for _, other := range previous {
+		if other.Table == c.Table && (other.Shadow == c.Shadow || other.Shadow == c.Column || other.Column == c.Shadow) {
+			return fmt.Errorf("%w: %s conflicts with %s", ErrShadowColumnIsInvalid, c, other)
+		}
+	}
+	return nil
+}
+
+// ApplyShadowWrites makes the database write every shadow column together with its column.
+// It must run after the GORM migrations and is safe to run on every start.
+//
+// The columns are copied by a BEFORE INSERT OR UPDATE trigger, so every writer, including
+// raw statements and data fixes, keeps them in sync. Tables without shadow columns lose their
+// trigger, so removing a column from the configuration turns its shadow writes off.
+// Adding or removing the trigger briefly locks the table; starts that keep shadow writes on
+// for a table only replace the trigger function, which takes no lock on the table.
+// Returns ErrShadowColumnIsMissing if a column or its shadow does not exist.
+func ApplyShadowWrites(db *gorm.DB, columns []ShadowColumn) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, table := range shadowWriteTables() {
+			if err := applyShadowWriteTrigger(tx, table, columns); err != nil {
+				return fmt.Errorf("apply shadow writes to %s: %w", table, err)
+			}
+		}
+		return nil
+	})
+}
+
+// applyShadowWriteTrigger installs, updates or removes the shadow write trigger of the table.
+func applyShadowWriteTrigger(tx *gorm.DB, table string, columns []ShadowColumn) error {
+	var installed bool
+	err := tx.Raw("SELECT EXISTS (SELECT 1 FROM pg_trigger WHERE tgrelid = ?::regclass AND tgname = ?)",
+		table, shadowWriteTrigger).Scan(&installed).Error
+	if err != nil {
+		return err
+	}
+
+	assignments := make([]string, 0)
+	for _, c := range columns {
+		if c.Table != table {
+			continue
+		}
+		if err = checkShadowColumn(tx, c); err != nil {
+			return err
+		}
+		assignments = append(assignments, fmt.Sprintf("NEW.%s := NEW.%s;", c.Shadow, c.Column))
+	}
+
+	statements := make([]string, 0, 2)
+	if len(assignments) == 0 {
+		if installed {
+			statements = append(statements, fmt.Sprintf("DROP TRIGGER %s ON %s", shadowWriteTrigger, table))
+		}
+		statements = append(statements, fmt.Sprintf("DROP FUNCTION IF EXISTS %s_%s()", shadowWriteTrigger, table))
+	} else {
+		statements = append(statements, fmt.Sprintf(`CREATE OR REPLACE FUNCTION %s_%s() RETURNS trigger
+			LANGUAGE plpgsql
+			AS $$ BEGIN %s RETURN NEW; END $$`, shadowWriteTrigger, table, strings.Join(assignments, " ")))
+		if !installed {
+			statements = append(statements, fmt.Sprintf(`CREATE TRIGGER %[1]s BEFORE INSERT OR UPDATE ON %[2]s
+				FOR EACH ROW EXECUTE FUNCTION %[1]s_%[2]s()`, shadowWriteTrigger, table))
+		}
+	}
+
+	for _, statement := range statements {
+		if err = tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkShadowColumn ensures both the column and its shadow exist.
+func checkShadowColumn(tx *gorm.DB, c ShadowColumn) error {
+	var found []string
+	err := tx.Raw(`
+		SELECT attname FROM pg_attribute
+		WHERE attrelid = ?::regclass AND attname IN (?, ?) AND attnum > 0 AND NOT attisdropped
+	`, c.Table, c.Column, c.Shadow).Scan(&found).Error
+	if err != nil {
+		return err
+	}
+
+	for _, column := range []string{c.Column, c.Shadow} {
+		if !slices.Contains(found, column) {
+			return fmt.Errorf("%w: %s.%s", ErrShadowColumnIsMissing, c.Table, column)
+		}
+	}
+	return nil
+}
+
+// RegisterShadowReadComparison compares the shadow columns of every row read from a table
+// with shadow writes through GORM, and logs the rows whose shadow differs from its column.
+// Readers are always served the original column; the comparison only reports.
+//
+// The comparison costs an extra lookup by primary key per read of the table, which is why
+// shadow writes are meant to stay enabled only for the duration of a migration.
+// Rows written before shadow writes were enabled are reported until they are backfilled.
+func RegisterShadowReadComparison(db *gorm.DB, columns []ShadowColumn, logger *slog.Logger) error {
+	if len(columns) == 0 {
+		return nil
+	}
+
+	comparison := shadowReadComparison{
+		columns: columns,
+		logger:  logger.With("component", "shadow_read_comparison"),
+	}
+	return db.Callback().Query().After("gorm:query").Register("shadow_writes:compare", comparison.compare)
+}
+
+// shadowReadComparison is the GORM callback behind RegisterShadowReadComparison.
+type shadowReadComparison struct {
+	columns []ShadowColumn
+	logger  *slog.Logger
+}
+
+func (s shadowReadComparison) compare(tx *gorm.DB) {
+	if tx.Error != nil || tx.Statement.Schema == nil || tx.Statement.Schema.PrioritizedPrimaryField == nil {
+		return
+	}
+
+	table := tx.Statement.Schema.Table
+	if !slices.ContainsFunc(s.columns, func(c ShadowColumn) bool { return c.Table == table }) {
+		return
+	}
+
+	ids := readPrimaryKeys(tx)
+	if len(ids) == 0 {
+		return
+	}
+
+	ctx := tx.Statement.Context
+	primaryKey := tx.Statement.Schema.PrioritizedPrimaryField.DBName
+	for _, c := range s.columns {
+		if c.Table != table {
+			continue
+		}
+
+		// The lookup shares the connection of the read, so it sees the same tenant and transaction
+		var mismatched []string
+		err := tx.Session(&gorm.Session{NewDB: true}).Raw(fmt.Sprintf(`
+			SELECT %[1]s::text FROM %[2]s
+			WHERE %[1]s IN ? AND %[3]s::text IS DISTINCT FROM %[4]s::text
+		`, primaryKey, c.Table, c.Shadow, c.Column), ids).Scan(&mismatched).Error
+		if err != nil {
+			s.logger.WarnContext(ctx, "Failed to compare shadow column", "shadow_column", c.String(), "error", err)
+			continue
+		}
+
+		if len(mismatched) > 0 {
+			s.logger.WarnContext(ctx, "Shadow column differs from its column",
+				"shadow_column", c.String(),
+				"rows", len(mismatched),
+				"ids", mismatched)
+		}
+	}
+}
+
+// readPrimaryKeys returns the primary keys of the rows the query scanned.
+func readPrimaryKeys(tx *gorm.DB) []any {
+	field := tx.Statement.Schema.PrioritizedPrimaryField
+	ctx := tx.Statement.Context
+
+	ids := make([]any, 0)
+	collect := func(row reflect.Value) {
+		if value, zero := field.ValueOf(ctx, row); !zero {
+			ids = append(ids, value)
+		}
+	}
+
+	rows := reflect.Indirect(tx.Statement.ReflectValue)
+	switch rows.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := range rows.Len() {
+			collect(reflect.Indirect(rows.Index(i)))
+		}
+	case reflect.Struct:
+		collect(rows)
+	default:
+	}
+
+	return ids
+}
@@ -0,0 +1,186 @@
+package postgres_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+// ShadowWritesIntegrationTestSuite verifies that shadow columns are written with their
+// columns by every writer and that reads report rows whose shadow went out of sync.
+type ShadowWritesIntegrationTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	db       *gorm.DB
+	factory  ports.UnitOfWorkFactory
+	logs     *bytes.Buffer
+	columns  []postgres_adapter.ShadowColumn
+}
+
+// SetupSuite starts PostgreSQL and adds the shadow column a migration of orders.volume would add.
+func (suite *ShadowWritesIntegrationTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		if err := migrateTenantTables(db); err != nil {
+			return err
+		}
+		if err := postgres_adapter.ApplyTenancyPolicies(db, defaultTenant); err != nil {
+			return err
+		}
+		return db.Exec("ALTER TABLE orders ADD COLUMN volume_v2 bigint").Error
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+
+	suite.columns, err = postgres_adapter.ParseShadowColumns("orders.volume:volume_v2")
+	suite.Require().NoError(err)
+}
+
+// SetupTest clones the template and enables shadow writes with read comparison on the clone.
+func (suite *ShadowWritesIntegrationTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.factory = postgres_adapter.NewGormUnitOfWorkFactory(suite.db)
+
+	suite.logs = &bytes.Buffer{}
+	logger := slog.New(slog.NewTextHandler(suite.logs, nil))
+	suite.Require().NoError(postgres_adapter.ApplyShadowWrites(suite.db, suite.columns))
+	suite.Require().NoError(postgres_adapter.RegisterShadowReadComparison(suite.db, suite.columns, logger))
+}
+
+// TearDownSuite cleans up PostgreSQL container after all tests complete.
+func (suite *ShadowWritesIntegrationTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *ShadowWritesIntegrationTestSuite) TestWrites_FillShadowColumn() {
+	ctx := context.Background()
+	o := createTestOrder()
+	suite.addOrder(ctx, o)
+
+	suite.Equal(int64(o.Volume()), suite.shadowVolume(o.ID().String()))
+
+	// Raw writes bypass the repositories and must be shadowed all the same
+	suite.Require().NoError(suite.db.Exec("UPDATE orders SET volume = 42 WHERE id = ?", o.ID().String()).Error)
+	suite.Equal(int64(42), suite.shadowVolume(o.ID().String()))
+}
+
+func (suite *ShadowWritesIntegrationTestSuite) TestReads_ReportShadowMismatch() {
+	ctx := context.Background()
+	o := createTestOrder()
+	suite.addOrder(ctx, o)
+
+	suite.getOrder(ctx, o)
+	suite.NotContains(suite.logs.String(), "Shadow column differs")
+
+	// A row the trigger never saw, like one written before shadow writes were enabled
+	suite.Require().NoError(suite.db.Exec("ALTER TABLE orders DISABLE TRIGGER delivery_shadow_write").Error)
+	suite.Require().NoError(suite.db.Exec("UPDATE orders SET volume_v2 = NULL WHERE id = ?", o.ID().String()).Error)
+	suite.Require().NoError(suite.db.Exec("ALTER TABLE orders ENABLE TRIGGER delivery_shadow_write").Error)
+
+	restored := suite.getOrder(ctx, o)
+
+	suite.Equal(o.Volume(), restored.Volume(), "readers are served the original column")
+	suite.Contains(suite.logs.String(), "Shadow column differs")
+	suite.Contains(suite.logs.String(), o.ID().String())
+}
+
+func (suite *ShadowWritesIntegrationTestSuite) TestApply_WithoutColumnsTurnsShadowWritesOff() {
+	ctx := context.Background()
+	suite.Require().NoError(postgres_adapter.ApplyShadowWrites(suite.db, nil))
+
+	o := createTestOrder()
+	suite.addOrder(ctx, o)
+
+	var shadow *int64
+	suite.Require().NoError(suite.db.Raw("SELECT volume_v2 FROM orders WHERE id = ?", o.ID().String()).
+		Scan(&shadow).Error)
+	suite.Nil(shadow)
+}
+
+func (suite *ShadowWritesIntegrationTestSuite) TestApply_MissingShadowColumn() {
+	columns, err := postgres_adapter.ParseShadowColumns("couriers.speed:speed_v2")
+	suite.Require().NoError(err)
+
+	err = postgres_adapter.ApplyShadowWrites(suite.db, columns)
+
+	suite.Require().ErrorIs(err, postgres_adapter.ErrShadowColumnIsMissing)
+}
+
+func (suite *ShadowWritesIntegrationTestSuite) addOrder(ctx context.Context, o *order.Order) {
+	suite.T().Helper()
+	uow := suite.factory.Create()
+	suite.Require().NoError(uow.Begin(ctx))
+	suite.Require().NoError(uow.OrderRepository().Add(ctx, o))
+	suite.Require().NoError(uow.Commit(ctx))
+}
+
+func (suite *ShadowWritesIntegrationTestSuite) getOrder(ctx context.Context, o *order.Order) *order.Order {
+	suite.T().Helper()
+	uow := suite.factory.Create()
+	suite.Require().NoError(uow.Begin(ctx))
+	defer func() {
+		_ = uow.Rollback(ctx)
+	}()
+
+	restored, err := uow.OrderRepository().Get(ctx, o.ID())
+	suite.Require().NoError(err)
+	return restored
+}
+
+func (suite *ShadowWritesIntegrationTestSuite) shadowVolume(id string) int64 {
+	suite.T().Helper()
+	var shadow int64
+	suite.Require().NoError(suite.db.Raw("SELECT volume_v2 FROM orders WHERE id = ?", id).Scan(&shadow).Error)
+	return shadow
+}
+
+func TestShadowWritesIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(ShadowWritesIntegrationTestSuite))
+}
+
+func TestParseShadowColumns(t *testing.T) {
+	t.Run("should parse comma-separated columns", func(t *testing.T) {
+		columns, err := postgres_adapter.ParseShadowColumns(" orders.volume:volume_v2, couriers.speed:speed_v2 ")
+
+		require.NoError(t, err)
+		assert.Equal(t, []postgres_adapter.ShadowColumn{
+			{Table: "orders", Column: "volume", Shadow: "volume_v2"},
+			{Table: "couriers", Column: "speed", Shadow: "speed_v2"},
+		}, columns)
+	})
+
+	t.Run("should parse empty spec", func(t *testing.T) {
+		columns, err := postgres_adapter.ParseShadowColumns("")
+
+		require.NoError(t, err)
+		assert.Empty(t, columns)
+	})
+
+	for _, spec := range []string{
+		"orders.volume",
+		"volume:volume_v2",
+		"microzones.deliveries:deliveries_v2",
+		"orders.volume:volume;DROP TABLE orders",
+		"orders.volume:volume",
+		"orders.volume:volume_v2,orders.status:volume_v2",
+	} {
+		t.Run("should refuse "+spec, func(t *testing.T) {
+			_, err := postgres_adapter.ParseShadowColumns(spec)
+
+			require.ErrorIs(t, err, postgres_adapter.ErrShadowColumnIsInvalid)
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/sla"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SLATargetDTO is the delivery time agreed for a delivery tier in a district.
+type SLATargetDTO struct {
+	ID                  uuid.UUID         `gorm:"type:uuid;primaryKey"`
+	District            string            `gorm:"type:varchar(100);not null"`
+	FromX               kernel.Coordinate `gorm:"type:smallint;not null"`
+	FromY               kernel.Coordinate `gorm:"type:smallint;not null"`
+	ToX                 kernel.Coordinate `gorm:"type:smallint;not null"`
+	ToY                 kernel.Coordinate `gorm:"type:smallint;not null"`
+	DeliveryTier        int               `gorm:"type:smallint;not null"`
+	DeliveryTimeMinutes int               `gorm:"not null"`
+	Goal                int               `gorm:"type:smallint;not null"`
+}
+
+// TableName specifies the database table name for SLA targets.
+// Overrides GORM's default naming convention to use "sla_targets".
+func (SLATargetDTO) TableName() string {
+	return "sla_targets"
+}
+
+// SLAComplianceDTO is how the deliveries of one day met the target of a district.
+// Rows have no unique key besides their ID, since districts are only unique within a tenant.
+type SLAComplianceDTO struct {
+	ID                  uuid.UUID `gorm:"type:uuid;primaryKey"`
+	District            string    `gorm:"type:varchar(100);not null"`
+	DeliveryTier        int       `gorm:"type:smallint;not null"`
+	DeliveryTimeMinutes int       `gorm:"not null"`
+	Goal                int       `gorm:"type:smallint;not null"`
+	Day                 time.Time `gorm:"type:date;not null;index"`
+	Delivered           int       `gorm:"not null"`
+	OnTime              int       `gorm:"not null"`
+}
+
+// TableName specifies the database table name for SLA compliance.
+// Overrides GORM's default naming convention to use "sla_daily_compliance".
+func (SLAComplianceDTO) TableName() string {
+	return "sla_daily_compliance"
+}
+
+// newSLATargetDTO converts a target to its database representation.
+func newSLATargetDTO(t *sla.Target) SLATargetDTO {
+	return SLATargetDTO{
+		ID:                  t.ID().Bytes(),
+		District:            t.District(),
+		FromX:               t.Zone().From().X(),
+		FromY:               t.Zone().From().Y(),
+		ToX:                 t.Zone().To().X(),
+		ToY:                 t.Zone().To().Y(),
+		DeliveryTier:        int(t.Tier()),
+		DeliveryTimeMinutes: int(t.DeliveryTime() / time.Minute),
+		Goal:                t.Goal(),
+	}
+}
+
+// toDomain restores the target from its database representation.
+func (dto SLATargetDTO) toDomain() (*sla.Target, error) {
+	from, err := kernel.NewLocation(dto.FromX, dto.FromY)
+	if err != nil {
+		return nil, err
+	}
+	to, err := kernel.NewLocation(dto.ToX, dto.ToY)
+	if err != nil {
+		return nil, err
+	}
+	zone, err := kernel.NewZone(from, to)
+	if err != nil {
+		return nil, err
+	}
+	id, err := kernel.UUIDFromBytes(dto.ID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return sla.NewTarget(
+		id,
+		dto.District,
+		zone,
+		order.DeliveryTier(dto.DeliveryTier),
+		time.Duration(dto.DeliveryTimeMinutes)*time.Minute,
+		dto.Goal,
+	)
+}
+
+// newSLAComplianceDTO converts the compliance of a day to its database representation.
+func newSLAComplianceDTO(c sla.Compliance) SLAComplianceDTO {
+	return SLAComplianceDTO{
+		ID:                  uuid.New(),
+		District:            c.District(),
+		DeliveryTier:        int(c.Tier()),
+		DeliveryTimeMinutes: int(c.DeliveryTime() / time.Minute),
+		Goal:                c.Goal(),
+		Day:                 c.Day(),
+		Delivered:           c.Delivered(),
+		OnTime:              c.OnTime(),
+	}
+}
+
+// GormSLARepository implements ports.SLARepository over the sla_targets and sla_daily_compliance tables.
+// Each call runs in a transaction of its own bound to the tenant carried by ctx, since
+// SLA targets are not part of a courier or order unit of work.
+type GormSLARepository struct {
+	db *gorm.DB
+}
+
+// NewGormSLARepository creates an SLA repository over the given connection.
+func NewGormSLARepository(db *gorm.DB) *GormSLARepository {
+	return &GormSLARepository{db: db}
+}
+
+// ReplaceTargets deletes the tenant's targets and stores the given ones in one transaction,
+// so the nightly job never measures a partial configuration.
+func (r *GormSLARepository) ReplaceTargets(ctx context.Context, targets []*sla.Target) error {
+	dtos := make([]SLATargetDTO, 0, len(targets))
+	for _, t := range targets {
+		dtos = append(dtos, newSLATargetDTO(t))
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		if err := tx.Exec("DELETE FROM sla_targets").Error; err != nil {
+			return err
+		}
+
+		if len(dtos) == 0 {
+			return nil
+		}
+		return tx.Create(&dtos).Error
+	})
+}
+
+// GetTargets returns the tenant's targets ordered by district and delivery tier.
+func (r *GormSLARepository) GetTargets(ctx context.Context) ([]*sla.Target, error) {
+	var dtos []SLATargetDTO
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		return tx.Order("district, delivery_tier").Find(&dtos).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]*sla.Target, 0, len(dtos))
+	for _, dto := range dtos {
+		t, toDomainErr := dto.toDomain()
+		if toDomainErr != nil {
+			return nil, toDomainErr
+		}
+		targets = append(targets, t)
+	}
+	return targets, nil
+}
+
+// SaveCompliance deletes the tenant's compliance of the day and stores the given one in one transaction.
+func (r *GormSLARepository) SaveCompliance(ctx context.Context, day time.Time, compliance []sla.Compliance) error {
+	dtos := make([]SLAComplianceDTO, 0, len(compliance))
+	for _, c := range compliance {
+		dtos = append(dtos, newSLAComplianceDTO(c))
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		if err := tx.Exec("DELETE FROM sla_daily_compliance WHERE day = ?", sla.Day(day)).Error; err != nil {
+			return err
+		}
+
+		if len(dtos) == 0 {
+			return nil
+		}
+		return tx.Create(&dtos).Error
+	})
+}
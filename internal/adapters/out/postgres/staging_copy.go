@@ -0,0 +1,383 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/pseudonym"
+
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrStagingCopyBatchSizeIsInvalid is returned when copying with a batch size below one.
+	ErrStagingCopyBatchSizeIsInvalid = errors.New("staging copy batch size must be positive")
+
+	// ErrStagingTableIsNotListed is returned when a tenant table is missing from the staging
+	// tables, so its personal data would not be reviewed before copying.
+	ErrStagingTableIsNotListed = errors.New("tenant table is not listed for the staging copy")
+
+	// ErrStagingCopyTargetIsSource is returned when the staging database is the source database,
+	// which the copy would wipe.
+	ErrStagingCopyTargetIsSource = errors.New("staging copy target is the source database")
+)
+
+// stagingLocationRadius is how many grid cells a copied location is moved at most on each axis.
+const stagingLocationRadius = 1
+
+// stagingFirstNames and stagingLastNames make up the pseudonyms of courier names.
+var (
+	stagingFirstNames = []string{
+		"Anna", "Boris", "Vera", "Gleb", "Daria", "Egor", "Zoya", "Ivan",
+		"Kira", "Lev", "Maria", "Nikita", "Olga", "Pavel", "Rita", "Stepan",
+	}
+	stagingLastNames = []string{
+		"Orlov", "Sokolov", "Volkov", "Lebedev", "Kozlov", "Novikov", "Morozov", "Petrov",
+		"Pavlov", "Semenov", "Golubev", "Vinogradov", "Bogdanov", "Vorobyov", "Fedorov", "Mikhailov",
+	}
+)
+
+// rowAnonymizer replaces the personal data of a copied row in place.
+type rowAnonymizer func(p pseudonym.Pseudonymizer, row map[string]any)
+
+// stagingTable tells how a tenant table is copied to staging. Tables that are not copied
+// are still cleared in staging, so no stale rows refer to the copied data.
+type stagingTable struct {
+	name      string
+	copied    bool
+	anonymize rowAnonymizer
+}
+
+// stagingTables lists every tenant table in the order rows can be inserted without
+// violating foreign keys. The change log is not copied: its snapshots embed the personal
+// data of couriers, and staging clients resync from the copied tables instead. Neither is the order
+// archive, whose snapshots embed personal data too, nor are
+// courier location points: a movement history reveals where a courier lives even when fuzzed. Identity verification
+// attempts, personal data erasures and bulk cancellations are audit trails and have no use outside production.
+func stagingTables() []stagingTable {
+	return []stagingTable{
+		{name: "couriers", copied: true, anonymize: anonymizeCourier},
+		{name: "storage_places", copied: true},
+		{name: "courier_maintenance_windows", copied: true},
+		{name: "courier_absences", copied: true},
+		{name: "courier_schedule_windows", copied: true},
+		{name: "orders", copied: true, anonymize: anonymizeOrder},
+		{name: "order_messages", copied: true, anonymize: anonymizeOrderMessage},
+		{name: "order_items", copied: true},
+		{name: "order_payment_transitions", copied: true, anonymize: anonymizePaymentTransition},
+		{name: "orders_archive"},
+		{name: "change_log"},
+		{name: "courier_availability_log", copied: true},
+		{name: "order_history", copied: true},
+		{name: "assignment_explanations", copied: true},
+		{name: "assignment_score_factors", copied: true},
+		{name: "announcements", copied: true},
+		{name: "announcement_deliveries", copied: true},
+		{name: "microzones", copied: true},
+		{name: "pickup_slots", copied: true},
+		{name: "pickup_slot_bookings", copied: true},
+		{name: "courier_earnings", copied: true},
+		{name: "order_handovers", copied: true},
+		{name: "courier_device_readings", copied: true},
+		{name: "surge_toggles", copied: true},
+		{name: "sla_targets", copied: true},
+		{name: "sla_daily_compliance", copied: true},
+		{name: "matching_rules", copied: true},
+		{name: "courier_location_points"},
+		{name: "courier_daily_tracks", copied: true},
+		{name: "courier_verification_attempts"},
+		{name: "personal_data_erasures"},
+		{name: "order_bulk_cancellations"},
+		{name: "order_escalations", copied: true},
+		{name: "courier_deliveries", copied: true},
+		{name: "courier_badges", copied: true},
+	}
+}
+
+// CopiedTable reports how many rows of a table were copied to staging.
+type CopiedTable struct {
+	Name string
+	Rows int64
+}
+
+// StagingCopyReport describes a completed staging copy.
+type StagingCopyReport struct {
+	Tables []CopiedTable
+}
+
+// StagingCopier copies the data of a tenant from a production database into a staging
+// database, replacing personal data with deterministic pseudonyms on the way: courier names,
+// phones, vehicle plates, photos and external IDs, order message texts, tracking tokens and
+// payment references are pseudonymized, and courier and order locations are moved by up to
+// one grid cell. Since pseudonyms only depend on the key, repeated copies agree with each other.
+//
+// The source is read in one read-only snapshot, so the copy is consistent across tables.
+// The tenant's staging data is replaced in one transaction, so staging never holds a partial copy.
+//
+// Example:
+//
+//	pseudonyms, _ := pseudonym.New(key)
+//	copier := NewStagingCopier(productionDB, stagingDB, pseudonyms, logger)
+//	report, err := copier.Copy(tenant.WithID(ctx, acme), 500)
+type StagingCopier struct {
+	source     *gorm.DB
+	target     *gorm.DB
+	pseudonyms pseudonym.Pseudonymizer
+	logger     *slog.Logger
+}
+
+// NewStagingCopier creates a copier from the source database into the target database.
+func NewStagingCopier(
+	source *gorm.DB,
+	target *gorm.DB,
+	pseudonyms pseudonym.Pseudonymizer,
+	logger *slog.Logger,
+) *StagingCopier {
+	return &StagingCopier{source: source, target: target, pseudonyms: pseudonyms, logger: logger}
+}
+
+// Copy replaces the staging data of the tenant in ctx with an anonymized copy of its source
+// data, inserting batchSize rows at once. Both databases must be migrated to the same schema.
+// Returns ErrStagingCopyTargetIsSource if both connections lead to the same database and
+// ErrStagingTableIsNotListed if a new tenant table was not added to the staging tables.
+func (c *StagingCopier) Copy(ctx context.Context, batchSize int) (StagingCopyReport, error) {
+	if batchSize < 1 {
+		return StagingCopyReport{}, ErrStagingCopyBatchSizeIsInvalid
+	}
+
+	tables := stagingTables()
+	if err := checkStagingTables(tables); err != nil {
+		return StagingCopyReport{}, err
+	}
+	if err := c.checkDistinct(ctx); err != nil {
+		return StagingCopyReport{}, err
+	}
+
+	var report StagingCopyReport
+	err := c.target.WithContext(ctx).Transaction(func(target *gorm.DB) error {
+		if err := bindTenant(ctx, target); err != nil {
+			return err
+		}
+
+		// Dependent rows go first; the tenant filter also holds for roles that bypass row-level security
+		for i := len(tables) - 1; i >= 0; i-- {
+			if err := target.Exec(fmt.Sprintf(
+				"DELETE FROM %s WHERE tenant_id = delivery_current_tenant()", tables[i].name,
+			)).Error; err != nil {
+				return fmt.Errorf("clear %s: %w", tables[i].name, err)
+			}
+		}
+
+		return c.source.WithContext(ctx).Transaction(func(source *gorm.DB) error {
+			if err := bindTenant(ctx, source); err != nil {
+				return err
+			}
+
+			for _, table := range tables {
+				if !table.copied {
+					continue
+				}
+
+				copied, err := c.copyTable(source, target, table, batchSize)
+				if err != nil {
+					return fmt.Errorf("copy %s: %w", table.name, err)
+				}
+				report.Tables = append(report.Tables, CopiedTable{Name: table.name, Rows: copied})
+				c.logger.InfoContext(ctx, "Copied table to staging", "table", table.name, "rows", copied)
+			}
+			return nil
+		}, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	})
+	if err != nil {
+		return StagingCopyReport{}, err
+	}
+	return report, nil
+}
+
+// copyTable streams the tenant's rows of the table from the source and inserts them
+// anonymized into the target in batches.
+func (c *StagingCopier) copyTable(source, target *gorm.DB, table stagingTable, batchSize int) (int64, error) {
+	rows, err := source.Table(table.name).Where("tenant_id = delivery_current_tenant()").Rows()
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var copied int64
+	batch := make([]map[string]any, 0, batchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := target.Table(table.name).Create(&batch).Error; err != nil {
+			return err
+		}
+		copied += int64(len(batch))
+		batch = make([]map[string]any, 0, batchSize)
+		return nil
+	}
+
+	for rows.Next() {
+		row := make(map[string]any)
+		if err = source.ScanRows(rows, &row); err != nil {
+			return copied, err
+		}
+		if table.anonymize != nil {
+			table.anonymize(c.pseudonyms, row)
+		}
+
+		batch = append(batch, row)
+		if len(batch) == batchSize {
+			if err = flush(); err != nil {
+				return copied, err
+			}
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return copied, err
+	}
+	return copied, flush()
+}
+
+// checkStagingTables ensures every tenant table is listed, copied or not.
+func checkStagingTables(tables []stagingTable) error {
+	listed := make(map[string]bool, len(tables))
+	for _, table := range tables {
+		listed[table.name] = true
+	}
+
+	for _, table := range tenantTables() {
+		if !listed[table] {
+			return fmt.Errorf("%w: %s", ErrStagingTableIsNotListed, table)
+		}
+	}
+	return nil
+}
+
+// checkDistinct refuses to copy when the source and the target are the same database.
+func (c *StagingCopier) checkDistinct(ctx context.Context) error {
+	source, err := databaseIdentity(ctx, c.source)
+	if err != nil {
+		return err
+	}
+	target, err := databaseIdentity(ctx, c.target)
+	if err != nil {
+		return err
+	}
+
+	if source == target {
+		return fmt.Errorf("%w: %s", ErrStagingCopyTargetIsSource, source)
+	}
+	return nil
+}
+
+// databaseIdentity names the server address, port and database a connection leads to.
+func databaseIdentity(ctx context.Context, db *gorm.DB) (string, error) {
+	var identity string
+	err := db.WithContext(ctx).Raw(`
+		SELECT concat_ws(':', coalesce(host(inet_server_addr()), 'local'), inet_server_port(), current_database())
+	`).Scan(&identity).Error
+	return identity, err
+}
+
+// anonymizeCourier pseudonymizes the name and the profile of a courier and fuzzes its location.
+func anonymizeCourier(p pseudonym.Pseudonymizer, row map[string]any) {
+	rewriteString(row, "name", func(name string) string {
+		return p.Pick("first_name", name, stagingFirstNames) + " " + p.Pick("last_name", name, stagingLastNames)
+	})
+	rewriteString(row, "phone", func(phone string) string {
+		return "+7900" + p.Digits("phone", phone, 7)
+	})
+	rewriteString(row, "vehicle_plate", func(plate string) string {
+		return "A" + p.Digits("vehicle_plate", plate, 3) + "AA"
+	})
+	rewriteString(row, "photo_url", func(url string) string {
+		return "https://example.com/couriers/" + p.Hex("photo_url", url, 16) + ".jpg"
+	})
+	rewriteString(row, "external_id", func(externalID string) string {
+		return "ext-" + p.Hex("external_id", externalID, 24)
+	})
+	fuzzLocation(p, row)
+}
+
+// anonymizeOrder fuzzes the delivery location of an order and pseudonymizes its tracking token,
+// which customers may have shared, and its marketplace order, which leads to the customer's account.
+func anonymizeOrder(p pseudonym.Pseudonymizer, row map[string]any) {
+	rewriteString(row, "tracking_token", func(token string) string {
+		return p.Hex("tracking_token", token, 32)
+	})
+	rewriteString(row, "external_order_id", func(externalOrderID string) string {
+		return "ext-" + p.Hex("external_order_id", externalOrderID, 24)
+	})
+	rewriteString(row, "external_deep_link", func(link string) string {
+		return "https://example.com/orders/" + p.Hex("external_deep_link", link, 16)
+	})
+	fuzzLocation(p, row)
+}
+
+// anonymizeOrderMessage replaces the text of a message between a courier and a customer.
+func anonymizeOrderMessage(p pseudonym.Pseudonymizer, row map[string]any) {
+	rewriteString(row, "text", func(text string) string {
+		return "Message " + p.Hex("message", text, 8)
+	})
+}
+
+// anonymizePaymentTransition pseudonymizes the payment provider's reference, keeping
+// references unique within an order.
+func anonymizePaymentTransition(p pseudonym.Pseudonymizer, row map[string]any) {
+	rewriteString(row, "reference", func(reference string) string {
+		return "ref-" + p.Hex("reference", reference, 24)
+	})
+}
+
+// rewriteString replaces a non-NULL text column of the row.
+func rewriteString(row map[string]any, column string, rewrite func(string) string) {
+	switch value := row[column].(type) {
+	case string:
+		row[column] = rewrite(value)
+	case []byte:
+		row[column] = rewrite(string(value))
+	}
+}
+
+// fuzzLocation moves the location_x and location_y columns of the row by up to
+// stagingLocationRadius cells within the delivery grid, keyed by the row's ID so a row
+// always moves the same way.
+func fuzzLocation(p pseudonym.Pseudonymizer, row map[string]any) {
+	id := fmt.Sprint(row["id"])
+	grid := kernel.Grid()
+	for _, axis := range []struct {
+		column   string
+		min, max kernel.Coordinate
+	}{
+		{"location_x", grid.MinX(), grid.MaxX()},
+		{"location_y", grid.MinY(), grid.MaxY()},
+	} {
+		coordinate, ok := integerValue(row[axis.column])
+		if !ok {
+			continue
+		}
+		fuzzed := coordinate + int64(p.Offset(axis.column, id, stagingLocationRadius))
+		row[axis.column] = min(max(fuzzed, int64(axis.min)), int64(axis.max))
+	}
+}
+
+// integerValue reads an integer column scanned by the driver.
+func integerValue(value any) (int64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return v, true
+	case int32:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int:
+		return int64(v), true
+	default:
+		return 0, false
+	}
+}
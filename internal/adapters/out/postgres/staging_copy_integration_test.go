@@ -0,0 +1,208 @@
+package postgres_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/pkg/pgtest"
+	"delivery/internal/pkg/pseudonym"
+	"delivery/internal/pkg/tenant"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+// StagingCopyIntegrationTestSuite verifies that staging copies carry no personal data,
+// pseudonymize it the same way on every run and replace the staging data of one tenant only.
+type StagingCopyIntegrationTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	sourceDB *gorm.DB
+	targetDB *gorm.DB
+	copier   *postgres_adapter.StagingCopier
+}
+
+// SetupSuite starts PostgreSQL and creates the tenant tables under row-level security.
+func (suite *StagingCopyIntegrationTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		if err := migrateTenantTables(db); err != nil {
+			return err
+		}
+		return postgres_adapter.ApplyTenancyPolicies(db, defaultTenant)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+// SetupTest clones the template into a source and a staging database.
+func (suite *StagingCopyIntegrationTestSuite) SetupTest() {
+	suite.sourceDB = suite.template.NewDatabase(suite.T())
+	suite.targetDB = suite.template.NewDatabase(suite.T())
+
+	pseudonyms, err := pseudonym.New("staging-copy-test-key")
+	suite.Require().NoError(err)
+	suite.copier = postgres_adapter.NewStagingCopier(suite.sourceDB, suite.targetDB, pseudonyms, slog.Default())
+}
+
+// TearDownSuite cleans up PostgreSQL container after all tests complete.
+func (suite *StagingCopyIntegrationTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *StagingCopyIntegrationTestSuite) TestCopy_PseudonymizesPersonalData() {
+	ctx := context.Background()
+	courierID := suite.addCourier(ctx, suite.sourceDB, "Alice Smith", "+79123456789")
+	orderID := suite.addOrder(ctx, courierID, "Call me at +79123456789")
+
+	report, err := suite.copier.Copy(ctx, 1)
+
+	suite.Require().NoError(err)
+	suite.Contains(report.Tables, postgres_adapter.CopiedTable{Name: "couriers", Rows: 1})
+	suite.Contains(report.Tables, postgres_adapter.CopiedTable{Name: "order_messages", Rows: 1})
+
+	copied := suite.courier(courierID)
+	suite.NotEqual("Alice Smith", copied.Name)
+	suite.Regexp(`^[A-Z][a-z]+ [A-Z][a-z]+$`, copied.Name)
+	suite.Regexp(`^\+7900[0-9]{7}$`, copied.Phone)
+	suite.Regexp(`^ext-[0-9a-f]{24}$`, copied.ExternalID)
+	suite.InDelta(5, copied.LocationX, 1)
+	suite.InDelta(5, copied.LocationY, 1)
+
+	var message struct{ Text string }
+	suite.Require().NoError(suite.targetDB.Raw("SELECT text FROM order_messages WHERE order_id = ?", orderID).
+		Scan(&message).Error)
+	suite.NotContains(message.Text, "+79123456789")
+
+	var token string
+	suite.Require().NoError(suite.targetDB.Raw("SELECT tracking_token FROM orders WHERE id = ?", orderID).
+		Scan(&token).Error)
+	suite.Len(token, 32)
+	suite.NotEqual(trackingToken, token)
+
+	var external struct{ ExternalMarketplace, ExternalOrderID, ExternalDeepLink string }
+	suite.Require().NoError(suite.targetDB.Raw(`
+		SELECT external_marketplace, external_order_id, external_deep_link FROM orders WHERE id = ?
+	`, orderID).Scan(&external).Error)
+	suite.Equal("ozon", external.ExternalMarketplace)
+	suite.Regexp(`^ext-[0-9a-f]{24}$`, external.ExternalOrderID)
+	suite.Regexp(`^https://example\.com/orders/[0-9a-f]{16}$`, external.ExternalDeepLink)
+}
+
+func (suite *StagingCopyIntegrationTestSuite) TestCopy_RepeatedCopiesAgree() {
+	ctx := context.Background()
+	courierID := suite.addCourier(ctx, suite.sourceDB, "Alice Smith", "+79123456789")
+
+	_, err := suite.copier.Copy(ctx, 500)
+	suite.Require().NoError(err)
+	first := suite.courier(courierID)
+
+	_, err = suite.copier.Copy(ctx, 500)
+	suite.Require().NoError(err)
+
+	suite.Equal(first, suite.courier(courierID))
+	suite.Equal(int64(1), suite.count(suite.targetDB, "couriers"))
+}
+
+func (suite *StagingCopyIntegrationTestSuite) TestCopy_ReplacesStagingDataOfTenantOnly() {
+	ctx := context.Background()
+	acme := tenant.WithID(ctx, acmeTenant)
+	suite.addCourier(ctx, suite.sourceDB, "Alice Smith", "+79123456789")
+	suite.addCourier(acme, suite.sourceDB, "Acme Courier", "+79000000001")
+	suite.addCourier(ctx, suite.targetDB, "Stale Courier", "+79000000002")
+	staleAcme := suite.addCourier(acme, suite.targetDB, "Acme Staging Courier", "+79000000003")
+
+	_, err := suite.copier.Copy(ctx, 500)
+
+	suite.Require().NoError(err)
+	var tenants []string
+	suite.Require().NoError(suite.targetDB.Raw("SELECT tenant_id FROM couriers ORDER BY tenant_id").
+		Scan(&tenants).Error)
+	suite.Equal([]string{acmeTenant.String(), defaultTenant.String()}, tenants)
+	suite.Equal("Acme Staging Courier", suite.courier(staleAcme).Name)
+}
+
+func (suite *StagingCopyIntegrationTestSuite) TestCopy_RefusesToCopyIntoSource() {
+	ctx := context.Background()
+	suite.addCourier(ctx, suite.sourceDB, "Alice Smith", "+79123456789")
+	pseudonyms, err := pseudonym.New("staging-copy-test-key")
+	suite.Require().NoError(err)
+	copier := postgres_adapter.NewStagingCopier(suite.sourceDB, suite.sourceDB, pseudonyms, slog.Default())
+
+	_, err = copier.Copy(ctx, 500)
+
+	suite.Require().ErrorIs(err, postgres_adapter.ErrStagingCopyTargetIsSource)
+	suite.Equal(int64(1), suite.count(suite.sourceDB, "couriers"))
+}
+
+// trackingToken is the tracking token of the orders added by addOrder.
+const trackingToken = "0123456789abcdef0123456789abcdef"
+
+type copiedCourier struct {
+	Name       string
+	Phone      string
+	ExternalID string
+	LocationX  int
+	LocationY  int
+}
+
+// addCourier inserts a courier at (5, 5) for the tenant in ctx.
+func (suite *StagingCopyIntegrationTestSuite) addCourier(ctx context.Context, db *gorm.DB, name, phone string) uuid.UUID {
+	id := uuid.New()
+	suite.exec(ctx, db, `
+		INSERT INTO couriers (id, name, speed, location_x, location_y, phone, external_id)
+		VALUES (?, ?, 2, 5, 5, ?, ?)
+	`, id, name, phone, "hr-"+id.String())
+	return id
+}
+
+// addOrder inserts an order of the courier with a tracking token, a marketplace order and a message.
+func (suite *StagingCopyIntegrationTestSuite) addOrder(ctx context.Context, courierID uuid.UUID, text string) uuid.UUID {
+	id := uuid.New()
+	suite.exec(ctx, suite.sourceDB, `
+		INSERT INTO orders (id, courier_id, location_x, location_y, volume, status, tracking_token,
+			external_marketplace, external_order_id, external_deep_link)
+		VALUES (?, ?, 3, 3, 5, 2, ?, 'ozon', ?, ?)
+	`, id, courierID, trackingToken, "48213377-"+id.String()[:4], "https://ozon.ru/orders/"+id.String())
+	suite.exec(ctx, suite.sourceDB, `
+		INSERT INTO order_messages (id, order_id, sender, text, sent_at) VALUES (?, ?, 1, ?, now())
+	`, uuid.New(), id, text)
+	return id
+}
+
+// exec runs the statement in a transaction bound to the tenant in ctx.
+func (suite *StagingCopyIntegrationTestSuite) exec(ctx context.Context, db *gorm.DB, statement string, args ...any) {
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if id, ok := tenant.FromContext(ctx); ok {
+			if err := bindTenant(tx, id); err != nil {
+				return err
+			}
+		}
+		return tx.Exec(statement, args...).Error
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *StagingCopyIntegrationTestSuite) courier(id uuid.UUID) copiedCourier {
+	var c copiedCourier
+	suite.Require().NoError(suite.targetDB.Raw(`
+		SELECT name, phone, external_id, location_x, location_y FROM couriers WHERE id = ?
+	`, id).Scan(&c).Error)
+	return c
+}
+
+func (suite *StagingCopyIntegrationTestSuite) count(db *gorm.DB, table string) int64 {
+	var count int64
+	suite.Require().NoError(db.Table(table).Count(&count).Error)
+	return count
+}
+
+func TestStagingCopyIntegrationTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(StagingCopyIntegrationTestSuite))
+}
@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/querycost"
+
+	"gorm.io/gorm"
+)
+
+// RegisterStatementTimeoutErrors makes statements canceled by the statement timeout of a
+// querycost budget fail with errs.QueryTimeoutError instead of the raw driver error.
+// It covers every statement run through GORM on sessions created with querycost.WithBudget.
+func RegisterStatementTimeoutErrors(db *gorm.DB) error {
+	translate := func(tx *gorm.DB) {
+		budget, ok := querycost.BudgetOf(tx)
+		if !ok || tx.Error == nil {
+			return
+		}
+		tx.Error = querycost.Translate(tx.Statement.Context, tx.Error, budget.Workload)
+	}
+
+	callbacks := db.Callback()
+	return errors.Join(
+		callbacks.Create().Register("querycost:translate_create", translate),
+		callbacks.Query().Register("querycost:translate_query", translate),
+		callbacks.Update().Register("querycost:translate_update", translate),
+		callbacks.Delete().Register("querycost:translate_delete", translate),
+		callbacks.Row().Register("querycost:translate_row", translate),
+		callbacks.Raw().Register("querycost:translate_raw", translate),
+	)
+}
+
+// bindStatementTimeout limits every statement of the transaction to the budget of its session.
+// The setting is local to the transaction, so pooled connections never carry it over.
+func bindStatementTimeout(tx *gorm.DB) error {
+	budget, ok := querycost.BudgetOf(tx)
+	if !ok {
+		return nil
+	}
+
+	return tx.Exec("SELECT set_config(?, ?, true)", querycost.SessionSetting, budget.SessionValue()).Error
+}
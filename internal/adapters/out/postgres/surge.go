@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/surge"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SurgeToggleDTO is an entry of the audit trail of surge mode toggles.
+type SurgeToggleDTO struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Source    string    `gorm:"type:varchar(16);not null"`
+	Setting   string    `gorm:"type:varchar(16);not null"`
+	WasActive bool      `gorm:"not null"`
+	Active    bool      `gorm:"not null"`
+	Backlog   int       `gorm:"not null"`
+	Reason    string    `gorm:"type:text;not null;default:''"`
+	ToggledAt time.Time `gorm:"not null;index"`
+}
+
+// TableName specifies the database table name for surge toggles.
+// Overrides GORM's default naming convention to use "surge_toggles".
+func (SurgeToggleDTO) TableName() string {
+	return "surge_toggles"
+}
+
+// toDomain restores the toggle from its database representation.
+func (dto SurgeToggleDTO) toDomain() (surge.Toggle, error) {
+	id, err := kernel.UUIDFromBytes(dto.ID[:])
+	if err != nil {
+		return surge.Toggle{}, err
+	}
+	source, err := surge.ParseSource(dto.Source)
+	if err != nil {
+		return surge.Toggle{}, err
+	}
+	setting, err := surge.ParseSetting(dto.Setting)
+	if err != nil {
+		return surge.Toggle{}, err
+	}
+
+	return surge.RestoreToggle(id, source, setting, dto.WasActive, dto.Active, dto.Backlog, dto.Reason, dto.ToggledAt)
+}
+
+// GormSurgeRepository implements ports.SurgeRepository over the surge_toggles table.
+// Each call runs in a transaction of its own bound to the tenant carried by ctx,
+// since the surge mode is not part of a courier unit of work.
+type GormSurgeRepository struct {
+	db *gorm.DB
+}
+
+// NewGormSurgeRepository creates a surge repository over the given connection.
+func NewGormSurgeRepository(db *gorm.DB) *GormSurgeRepository {
+	return &GormSurgeRepository{db: db}
+}
+
+// GetMode returns the mode left behind by the latest toggle, or surge.DefaultMode if there is none.
+func (r *GormSurgeRepository) GetMode(ctx context.Context) (surge.Mode, error) {
+	var dto SurgeToggleDTO
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		return tx.Order("toggled_at DESC, id").Take(&dto).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return surge.DefaultMode(), nil
+	}
+	if err != nil {
+		return surge.Mode{}, err
+	}
+
+	toggle, err := dto.toDomain()
+	if err != nil {
+		return surge.Mode{}, err
+	}
+	return toggle.Mode(), nil
+}
+
+// AddToggle appends the toggle to the audit trail.
+func (r *GormSurgeRepository) AddToggle(ctx context.Context, toggle surge.Toggle) error {
+	if err := toggle.Validate(); err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		return tx.Create(&SurgeToggleDTO{
+			ID:        toggle.ID().Bytes(),
+			Source:    toggle.Source().String(),
+			Setting:   toggle.Setting().String(),
+			WasActive: toggle.WasActive(),
+			Active:    toggle.Active(),
+			Backlog:   toggle.Backlog(),
+			Reason:    toggle.Reason(),
+			ToggledAt: toggle.At().UTC(),
+		}).Error
+	})
+}
@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/synthetic"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// syntheticTables lists the aggregate root tables whose rows may be marked as synthetic.
+// Child rows (storage places, order messages, items, assignment explanations and
+// announcement deliveries) are removed with their roots.
+func syntheticTables() []string {
+	return []string{"couriers", "orders"}
+}
+
+// ApplySyntheticDataMarkers adds the synthetic_at column to the aggregate root tables.
+// It must run after the GORM migrations and is safe to run on every start.
+//
+// The column defaults to the insertion time when the transaction is marked with
+// synthetic.SessionSetting and to NULL otherwise, so repositories never write it and
+// ordinary rows are never mistaken for test data.
+func ApplySyntheticDataMarkers(db *gorm.DB) error {
+	statements := []string{
+		fmt.Sprintf(`CREATE OR REPLACE FUNCTION delivery_synthetic_at() RETURNS timestamptz
+			LANGUAGE sql STABLE
+			AS $$ SELECT CASE WHEN current_setting('%s', true) = 'on' THEN now() END $$`,
+			synthetic.SessionSetting),
+	}
+	for _, table := range syntheticTables() {
+		statements = append(statements,
+			fmt.Sprintf(`ALTER TABLE %s ADD COLUMN IF NOT EXISTS synthetic_at timestamptz
+				DEFAULT delivery_synthetic_at()`, table),
+			fmt.Sprintf(`CREATE INDEX IF NOT EXISTS idx_%[1]s_synthetic_at ON %[1]s (synthetic_at)
+				WHERE synthetic_at IS NOT NULL`, table),
+		)
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return fmt.Errorf("apply synthetic data markers: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// bindSynthetic marks the transaction as creating synthetic data if ctx carries the marker.
+// The setting is local to the transaction, so pooled connections never carry it over.
+func bindSynthetic(ctx context.Context, tx *gorm.DB) error {
+	if !synthetic.IsMarked(ctx) {
+		return nil
+	}
+
+	return tx.Exec("SELECT set_config(?, 'on', true)", synthetic.SessionSetting).Error
+}
+
+// GormSyntheticDataJanitor implements ports.SyntheticDataJanitor with bulk deletes.
+// Like every storage access it only sees the tenant carried by ctx.
+type GormSyntheticDataJanitor struct {
+	db *gorm.DB
+}
+
+// NewGormSyntheticDataJanitor creates a janitor over the given connection.
+func NewGormSyntheticDataJanitor(db *gorm.DB) *GormSyntheticDataJanitor {
+	return &GormSyntheticDataJanitor{db: db}
+}
+
+// PurgeSyntheticData deletes expired synthetic orders first, so that couriers who only
+// delivered synthetic orders become free, and then every expired synthetic courier that no
+// remaining assigned order depends on, together with their device readings. All deletes run
+// in one transaction.
+func (j *GormSyntheticDataJanitor) PurgeSyntheticData(
+	ctx context.Context,
+	createdBefore time.Time,
+) (ports.SyntheticDataPurge, error) {
+	var purge ports.SyntheticDataPurge
+
+	err := j.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		var orderIDs []uuid.UUID
+		err := tx.Raw(`
+			DELETE FROM orders
+			WHERE synthetic_at IS NOT NULL AND synthetic_at < ?
+			RETURNING id
+		`, createdBefore).Scan(&orderIDs).Error
+		if err != nil {
+			return err
+		}
+
+		// Earnings and handovers refer to orders by plain IDs, as they outlive archived orders
+		if len(orderIDs) > 0 {
+			for _, table := range []string{"courier_earnings", "order_handovers"} {
+				if err = tx.Exec(`DELETE FROM `+table+` WHERE order_id IN ?`, orderIDs).Error; err != nil {
+					return err
+				}
+			}
+		}
+
+		couriers := tx.Exec(`
+			DELETE FROM couriers c
+			WHERE c.synthetic_at IS NOT NULL AND c.synthetic_at < ?
+			  AND NOT EXISTS (
+				SELECT 1 FROM orders o WHERE o.courier_id = c.id AND o.status = ?
+			  )
+		`, createdBefore, int(order.Assigned))
+		if couriers.Error != nil {
+			return couriers.Error
+		}
+
+		// Device readings, location history and verification attempts are not tied to couriers
+		// by a foreign key and would outlive them
+		for _, table := range []string{
+			"courier_device_readings", "courier_location_points", "courier_daily_tracks",
+			"courier_verification_attempts",
+		} {
+			err := tx.Exec(`
+				DELETE FROM ` + table + ` r
+				WHERE NOT EXISTS (SELECT 1 FROM couriers c WHERE c.id = r.courier_id)
+			`).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		purge = ports.SyntheticDataPurge{
+			Orders:   len(orderIDs),
+			Couriers: int(couriers.RowsAffected),
+		}
+		return nil
+	})
+	if err != nil {
+		return ports.SyntheticDataPurge{}, err
+	}
+
+	return purge, nil
+}
@@ -0,0 +1,186 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/adapters/out/postgres/outboxrepo"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/pgtest"
+	"delivery/internal/pkg/synthetic"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+// SyntheticDataIntegrationTestSuite verifies that marked transactions stamp the aggregates
+// they create and that the janitor removes only expired synthetic data.
+type SyntheticDataIntegrationTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	db       *gorm.DB
+	factory  ports.UnitOfWorkFactory
+	janitor  *postgres_adapter.GormSyntheticDataJanitor
+}
+
+// SetupSuite starts PostgreSQL and applies migrations and synthetic data markers.
+func (suite *SyntheticDataIntegrationTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		err := db.AutoMigrate(
+			&orderrepo.OrderDTO{},
+			&orderrepo.OrderMessageDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{},
+			&orderrepo.OrderItemDTO{},
+			&courierrepo.CourierDTO{},
+			&courierrepo.StoragePlaceDTO{},
+			&courierrepo.MaintenanceWindowDTO{},
+			&courierrepo.AbsenceDTO{},
+			&courierrepo.ScheduleWindowDTO{},
+			&postgres_adapter.ChangeLogDTO{},
+			&postgres_adapter.CourierAvailabilityDTO{},
+			&outboxrepo.OutboxMessageDTO{},
+			&postgres_adapter.CourierDeviceReadingDTO{},
+			&postgres_adapter.CourierLocationPointDTO{},
+			&postgres_adapter.CourierDailyTrackDTO{},
+			&postgres_adapter.VerificationAttemptDTO{},
+		)
+		if err != nil {
+			return err
+		}
+		if err := postgres_adapter.ApplySyntheticDataMarkers(db); err != nil {
+			return err
+		}
+		// Applying the markers again must be harmless, the service does it on every start
+		return postgres_adapter.ApplySyntheticDataMarkers(db)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+// SetupTest gives every test a fresh clone of the migrated database.
+func (suite *SyntheticDataIntegrationTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.factory = postgres_adapter.NewGormUnitOfWorkFactory(suite.db)
+	suite.janitor = postgres_adapter.NewGormSyntheticDataJanitor(suite.db)
+}
+
+// TearDownSuite cleans up PostgreSQL container after all tests complete.
+func (suite *SyntheticDataIntegrationTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+// TestUnitOfWork_MarkedTransaction_StampsAggregates verifies that only aggregates added
+// under the synthetic marker get a synthetic_at timestamp.
+func (suite *SyntheticDataIntegrationTestSuite) TestUnitOfWork_MarkedTransaction_StampsAggregates() {
+	syntheticCourier, syntheticOrder := suite.add(synthetic.WithMarker(context.Background()), createTestCourier())
+	ordinaryCourier, ordinaryOrder := suite.add(context.Background(), createTestCourier())
+
+	suite.True(suite.isSynthetic("couriers", syntheticCourier.ID().String()))
+	suite.True(suite.isSynthetic("orders", syntheticOrder.ID().String()))
+	suite.False(suite.isSynthetic("couriers", ordinaryCourier.ID().String()))
+	suite.False(suite.isSynthetic("orders", ordinaryOrder.ID().String()))
+}
+
+// TestPurgeSyntheticData_RemovesOnlyExpiredSyntheticData verifies the TTL cutoff and that
+// ordinary data and recent synthetic data survive a purge.
+func (suite *SyntheticDataIntegrationTestSuite) TestPurgeSyntheticData_RemovesOnlyExpiredSyntheticData() {
+	ctx := context.Background()
+	markedCtx := synthetic.WithMarker(ctx)
+
+	expiredCourier, expiredOrder := suite.add(markedCtx, createTestCourier())
+	recentCourier, recentOrder := suite.add(markedCtx, createTestCourier())
+	ordinaryCourier, ordinaryOrder := suite.add(ctx, createTestCourier())
+	suite.backdate(expiredCourier.ID().String(), expiredOrder.ID().String(), 2*time.Hour)
+
+	purge, err := suite.janitor.PurgeSyntheticData(ctx, time.Now().Add(-time.Hour))
+
+	suite.Require().NoError(err)
+	suite.Equal(ports.SyntheticDataPurge{Orders: 1, Couriers: 1}, purge)
+	suite.Equal(int64(0), suite.count("orders", expiredOrder.ID().String()))
+	suite.Equal(int64(0), suite.count("couriers", expiredCourier.ID().String()))
+	suite.Equal(int64(0), suite.countStoragePlaces(expiredCourier.ID().String()))
+	for _, id := range []string{recentOrder.ID().String(), ordinaryOrder.ID().String()} {
+		suite.Equal(int64(1), suite.count("orders", id))
+	}
+	for _, id := range []string{recentCourier.ID().String(), ordinaryCourier.ID().String()} {
+		suite.Equal(int64(1), suite.count("couriers", id))
+	}
+}
+
+// TestPurgeSyntheticData_KeepsCourierDeliveringOrdinaryOrder verifies that an expired synthetic
+// courier is kept while an order that is not purged is still assigned to them.
+func (suite *SyntheticDataIntegrationTestSuite) TestPurgeSyntheticData_KeepsCourierDeliveringOrdinaryOrder() {
+	ctx := context.Background()
+
+	syntheticCourier, syntheticOrder := suite.add(synthetic.WithMarker(ctx), createTestCourier())
+	suite.backdate(syntheticCourier.ID().String(), syntheticOrder.ID().String(), 2*time.Hour)
+
+	ordinaryOrder := createTestOrder()
+	suite.Require().NoError(ordinaryOrder.Assign(syntheticCourier.ID()))
+	uow := suite.factory.Create()
+	suite.Require().NoError(uow.Begin(ctx))
+	suite.Require().NoError(uow.OrderRepository().Add(ctx, ordinaryOrder))
+	suite.Require().NoError(uow.Commit(ctx))
+
+	purge, err := suite.janitor.PurgeSyntheticData(ctx, time.Now().Add(-time.Hour))
+
+	suite.Require().NoError(err)
+	suite.Equal(ports.SyntheticDataPurge{Orders: 1, Couriers: 0}, purge)
+	suite.Equal(int64(1), suite.count("couriers", syntheticCourier.ID().String()))
+}
+
+// add persists the courier and a fresh order in one unit of work bound to ctx.
+func (suite *SyntheticDataIntegrationTestSuite) add(
+	ctx context.Context,
+	c *courier.Courier,
+) (*courier.Courier, *order.Order) {
+	o := createTestOrder()
+
+	uow := suite.factory.Create()
+	suite.Require().NoError(uow.Begin(ctx))
+	suite.Require().NoError(uow.CourierRepository().Add(ctx, c))
+	suite.Require().NoError(uow.OrderRepository().Add(ctx, o))
+	suite.Require().NoError(uow.Commit(ctx))
+
+	return c, o
+}
+
+// backdate moves the synthetic timestamps of a courier and an order into the past.
+func (suite *SyntheticDataIntegrationTestSuite) backdate(courierID, orderID string, age time.Duration) {
+	createdAt := time.Now().Add(-age)
+	suite.Require().NoError(suite.db.Exec("UPDATE couriers SET synthetic_at = ? WHERE id = ?", createdAt, courierID).Error)
+	suite.Require().NoError(suite.db.Exec("UPDATE orders SET synthetic_at = ? WHERE id = ?", createdAt, orderID).Error)
+}
+
+func (suite *SyntheticDataIntegrationTestSuite) isSynthetic(table, id string) bool {
+	var marked bool
+	err := suite.db.Raw("SELECT synthetic_at IS NOT NULL FROM "+table+" WHERE id = ?", id).Row().Scan(&marked)
+	suite.Require().NoError(err)
+	return marked
+}
+
+func (suite *SyntheticDataIntegrationTestSuite) count(table, id string) int64 {
+	var count int64
+	suite.Require().NoError(suite.db.Table(table).Where("id = ?", id).Count(&count).Error)
+	return count
+}
+
+func (suite *SyntheticDataIntegrationTestSuite) countStoragePlaces(courierID string) int64 {
+	var count int64
+	suite.Require().NoError(suite.db.Table("storage_places").Where("courier_id = ?", courierID).Count(&count).Error)
+	return count
+}
+
+func TestSyntheticDataIntegrationTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(SyntheticDataIntegrationTestSuite))
+}
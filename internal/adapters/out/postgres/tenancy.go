@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"delivery/internal/pkg/tenant"
+
+	"gorm.io/gorm"
+)
+
+// tenantTables lists the tables whose rows belong to a single tenant.
+// Outbox and bookkeeping tables such as data_fixes, fraud_blacklist and resolved_addresses are shared.
+func tenantTables() []string {
+	return []string{
+		"couriers", "storage_places", "courier_maintenance_windows", "courier_absences", "courier_schedule_windows",
+		"orders", "order_messages", "order_items",
+		"order_payment_transitions", "orders_archive", "change_log", "order_history",
+		"courier_availability_log",
+		"assignment_explanations", "assignment_score_factors",
+		"announcements", "announcement_deliveries",
+		"microzones",
+		"pickup_slots", "pickup_slot_bookings",
+		"courier_earnings",
+		"order_handovers",
+		"courier_device_readings",
+		"surge_toggles",
+		"sla_targets", "sla_daily_compliance",
+		"matching_rules",
+		"courier_location_points", "courier_daily_tracks",
+		"courier_verification_attempts",
+		"personal_data_erasures",
+		"order_bulk_cancellations",
+		"order_escalations",
+		"courier_deliveries", "courier_badges",
+	}
+}
+
+// tenantUniqueIndex is a unique index GORM declares on a DTO that must only be unique within a tenant.
+// The index keeps its name, so repositories still recognize its violations by the constraint name.
+type tenantUniqueIndex struct {
+	name    string
+	table   string
+	columns string
+}
+
+// tenantUniqueIndexes lists the unique indexes scoped by tenant_id: two tenants may, for example,
+// import couriers with the same HR external ID or receive orders with the same marketplace reference.
+func tenantUniqueIndexes() []tenantUniqueIndex {
+	return []tenantUniqueIndex{
+		{name: "idx_couriers_external_id", table: "couriers", columns: "external_id"},
+		{name: "idx_orders_external_reference", table: "orders", columns: "external_marketplace, external_order_id"},
+		{name: "idx_orders_basket_id", table: "orders", columns: "basket_id"},
+		{name: "idx_orders_tracking_token", table: "orders", columns: "tracking_token"},
+	}
+}
+
+// ApplyTenancyPolicies enforces tenant isolation in the database with row-level security.
+// It must run after the GORM migrations and is safe to run on every start.
+//
+// Every tenant table gets a tenant_id column that defaults to the session's tenant, so
+// repositories never have to write it, and a policy that hides and protects the rows of
+// all other tenants. Connections without a tenant in their session act for defaultTenant;
+// existing rows are assigned to it when the column is first added.
+//
+// The unique indexes of tenantUniqueIndexes are rebuilt once to lead with tenant_id, so values
+// such as courier external IDs are unique within a tenant instead of across all tenants.
+//
+// Policies are FORCEd so they also bind the table owner, but Postgres never applies them
+// to superusers or roles with BYPASSRLS: the service must connect as an ordinary role.
+func ApplyTenancyPolicies(db *gorm.DB, defaultTenant tenant.ID) error {
+	if err := defaultTenant.Validate(); err != nil {
+		return err
+	}
+
+	// The ID is validated to [a-z0-9_-], so it is safe to inline as a literal
+	statements := []string{
+		fmt.Sprintf(`CREATE OR REPLACE FUNCTION delivery_current_tenant() RETURNS varchar
+			LANGUAGE sql STABLE
+			AS $$ SELECT coalesce(nullif(current_setting('%s', true), ''), '%s')::varchar $$`,
+			tenant.SessionSetting, defaultTenant),
+	}
+	for _, table := range tenantTables() {
+		statements = append(statements,
+			fmt.Sprintf(`ALTER TABLE %s ADD COLUMN IF NOT EXISTS tenant_id varchar(64) NOT NULL
+				DEFAULT delivery_current_tenant()`, table),
+			fmt.Sprintf(`CREATE INDEX IF NOT EXISTS idx_%[1]s_tenant_id ON %[1]s (tenant_id)`, table),
+			fmt.Sprintf(`ALTER TABLE %s ENABLE ROW LEVEL SECURITY`, table),
+			fmt.Sprintf(`ALTER TABLE %s FORCE ROW LEVEL SECURITY`, table),
+			fmt.Sprintf(`DROP POLICY IF EXISTS tenant_isolation ON %s`, table),
+			fmt.Sprintf(`CREATE POLICY tenant_isolation ON %s
+				USING (tenant_id = delivery_current_tenant())
+				WITH CHECK (tenant_id = delivery_current_tenant())`, table),
+		)
+	}
+
+	for _, index := range tenantUniqueIndexes() {
+		// Indexes already scoped by tenant are left alone, so restarts do not rebuild them
+		statements = append(statements, fmt.Sprintf(`DO $$ BEGIN
+			IF NOT EXISTS (SELECT 1 FROM pg_indexes WHERE schemaname = current_schema()
+				AND indexname = '%[1]s' AND indexdef LIKE '%%(tenant_id, %%') THEN
+				DROP INDEX IF EXISTS %[1]s;
+				CREATE UNIQUE INDEX %[1]s ON %[2]s (tenant_id, %[3]s);
+			END IF;
+		END $$`, index.name, index.table, index.columns))
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return fmt.Errorf("apply tenancy policies: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// bindTenant scopes the transaction to the tenant carried by ctx.
+// The setting is local to the transaction, so pooled connections never carry it over.
+// Without a tenant in ctx the transaction acts for the default tenant.
+func bindTenant(ctx context.Context, tx *gorm.DB) error {
+	id, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	return tx.Exec("SELECT set_config(?, ?, true)", tenant.SessionSetting, id.String()).Error
+}
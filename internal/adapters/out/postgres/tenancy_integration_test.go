@@ -0,0 +1,466 @@
+package postgres_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/adapters/out/postgres/earningsrepo"
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/adapters/out/postgres/outboxrepo"
+	"delivery/internal/adapters/out/postgres/pickuprepo"
+	"delivery/internal/adapters/out/postgres/relayrepo"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/jobs"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/pgtest"
+	"delivery/internal/pkg/tenant"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultTenant = tenant.ID("default")
+	acmeTenant    = tenant.ID("acme")
+	globexTenant  = tenant.ID("globex")
+)
+
+// TenancyIntegrationTestSuite proves that row-level security isolates tenants.
+// The policies never apply to superusers, so the service side connects as an ordinary
+// role while the suite keeps a superuser connection for setup and for checking raw rows.
+type TenancyIntegrationTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	adminDB  *gorm.DB
+	appDB    *gorm.DB
+	factory  ports.UnitOfWorkFactory
+}
+
+// SetupSuite starts PostgreSQL, applies migrations and tenancy policies to the template
+// database and creates the non-superuser application role.
+func (suite *TenancyIntegrationTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		if err := migrateTenantTables(db); err != nil {
+			return err
+		}
+		if err := postgres_adapter.ApplyTenancyPolicies(db, defaultTenant); err != nil {
+			return err
+		}
+		// Applying the policies again must be harmless, the service does it on every start
+		if err := postgres_adapter.ApplyTenancyPolicies(db, defaultTenant); err != nil {
+			return err
+		}
+		return createAppRole(db)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+// SetupTest clones the template for the test and connects to the clone
+// both as the superuser and as the application role.
+func (suite *TenancyIntegrationTestSuite) SetupTest() {
+	database := suite.template.CreateDatabase(suite.T())
+
+	suite.adminDB = pgtest.Open(suite.T(), suite.template.DSN(pgtest.User, pgtest.Password, database))
+	suite.appDB = pgtest.Open(suite.T(), suite.template.DSN("delivery_app", "app", database))
+	suite.factory = postgres_adapter.NewGormUnitOfWorkFactory(suite.appDB)
+}
+
+// TearDownSuite cleans up PostgreSQL container after all tests complete.
+func (suite *TenancyIntegrationTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+// TestUnitOfWork_TenantsDoNotSeeEachOther verifies that aggregates written for one tenant
+// are invisible to another tenant's unit of work.
+func (suite *TenancyIntegrationTestSuite) TestUnitOfWork_TenantsDoNotSeeEachOther() {
+	acmeCtx := tenant.WithID(context.Background(), acmeTenant)
+	globexCtx := tenant.WithID(context.Background(), globexTenant)
+
+	acmeCourier := createTestCourier()
+	acmeOrder := createTestOrder()
+
+	uow := suite.factory.Create()
+	suite.Require().NoError(uow.Begin(acmeCtx))
+	suite.Require().NoError(uow.CourierRepository().Add(acmeCtx, acmeCourier))
+	suite.Require().NoError(uow.OrderRepository().Add(acmeCtx, acmeOrder))
+	suite.Require().NoError(uow.Commit(acmeCtx))
+
+	globexUow := suite.factory.Create()
+	suite.Require().NoError(globexUow.Begin(globexCtx))
+	defer func() {
+		_ = globexUow.Rollback(globexCtx)
+	}()
+
+	_, err := globexUow.CourierRepository().Get(globexCtx, acmeCourier.ID())
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+	_, err = globexUow.OrderRepository().Get(globexCtx, acmeOrder.ID())
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+
+	free, err := globexUow.CourierRepository().GetAllFree(globexCtx)
+	suite.Require().NoError(err)
+	suite.Empty(free)
+
+	acmeUow := suite.factory.Create()
+	suite.Require().NoError(acmeUow.Begin(acmeCtx))
+	defer func() {
+		_ = acmeUow.Rollback(acmeCtx)
+	}()
+
+	restored, err := acmeUow.CourierRepository().Get(acmeCtx, acmeCourier.ID())
+	suite.Require().NoError(err)
+	suite.Len(restored.StoragePlaces(), 1, "child rows must be stored for the same tenant")
+}
+
+// TestRawQuery_WithoutTenant_SeesOnlyDefaultTenant verifies that hand-written queries on a
+// connection without a tenant are confined to the default tenant instead of seeing everything.
+func (suite *TenancyIntegrationTestSuite) TestRawQuery_WithoutTenant_SeesOnlyDefaultTenant() {
+	acmeOrder := createTestOrder()
+	defaultOrder := createTestOrder()
+	suite.addOrder(tenant.WithID(context.Background(), acmeTenant), acmeOrder)
+	suite.addOrder(context.Background(), defaultOrder)
+
+	var visible []string
+	suite.Require().NoError(suite.appDB.Raw("SELECT id::text FROM orders").Scan(&visible).Error)
+	suite.Equal([]string{defaultOrder.ID().String()}, visible)
+
+	rows, err := suite.adminDB.Raw("SELECT id::text, tenant_id FROM orders").Rows()
+	suite.Require().NoError(err)
+	defer rows.Close()
+
+	owners := make(map[string]string)
+	for rows.Next() {
+		var id, owner string
+		suite.Require().NoError(rows.Scan(&id, &owner))
+		owners[id] = owner
+	}
+	suite.Require().NoError(rows.Err())
+
+	suite.Equal(acmeTenant.String(), owners[acmeOrder.ID().String()])
+	suite.Equal(defaultTenant.String(), owners[defaultOrder.ID().String()])
+}
+
+// TestRawWrite_CannotReachOtherTenant verifies that raw statements can neither change
+// another tenant's rows nor move rows to another tenant.
+func (suite *TenancyIntegrationTestSuite) TestRawWrite_CannotReachOtherTenant() {
+	acmeCtx := tenant.WithID(context.Background(), acmeTenant)
+	globexCtx := tenant.WithID(context.Background(), globexTenant)
+
+	acmeOrder := createTestOrder()
+	suite.addOrder(acmeCtx, acmeOrder)
+
+	uow := suite.factory.Create()
+	suite.Require().NoError(uow.Begin(globexCtx))
+	defer func() {
+		_ = uow.Rollback(globexCtx)
+	}()
+
+	// Through the unit of work's transaction a repository sees no row to update
+	suite.Require().Error(uow.OrderRepository().Update(globexCtx, acmeOrder))
+
+	err := suite.appDB.Transaction(func(tx *gorm.DB) error {
+		if bindErr := bindTenant(tx, globexTenant); bindErr != nil {
+			return bindErr
+		}
+
+		result := tx.Exec("UPDATE orders SET volume = 1 WHERE id = ?", acmeOrder.ID().String())
+		suite.Require().NoError(result.Error)
+		suite.Zero(result.RowsAffected, "other tenant's rows must be invisible to UPDATE")
+
+		result = tx.Exec("DELETE FROM orders WHERE id = ?", acmeOrder.ID().String())
+		suite.Require().NoError(result.Error)
+		suite.Zero(result.RowsAffected, "other tenant's rows must be invisible to DELETE")
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	err = suite.appDB.Transaction(func(tx *gorm.DB) error {
+		if bindErr := bindTenant(tx, acmeTenant); bindErr != nil {
+			return bindErr
+		}
+		return tx.Exec("UPDATE orders SET tenant_id = ? WHERE id = ?", globexTenant.String(), acmeOrder.ID().String()).
+			Error
+	})
+	suite.Require().Error(err, "rows must not be handed over to another tenant")
+
+	var volume int
+	suite.Require().NoError(suite.adminDB.Raw("SELECT volume FROM orders WHERE id = ?", acmeOrder.ID().String()).
+		Scan(&volume).Error)
+	suite.Equal(acmeOrder.Volume(), volume)
+}
+
+// TestCourierExternalID_UniqueWithinTenant verifies that two tenants may register couriers with the
+// same HR external ID, while a tenant still cannot register it twice.
+func (suite *TenancyIntegrationTestSuite) TestCourierExternalID_UniqueWithinTenant() {
+	acmeCtx := tenant.WithID(context.Background(), acmeTenant)
+	globexCtx := tenant.WithID(context.Background(), globexTenant)
+	externalID, err := courier.NewExternalID("HR-1042")
+	suite.Require().NoError(err)
+
+	acmeCourier := createTestCourier()
+	suite.Require().NoError(acmeCourier.LinkExternalID(externalID))
+	suite.Require().NoError(suite.tryAddCourier(acmeCtx, acmeCourier))
+
+	globexCourier := createTestCourier()
+	suite.Require().NoError(globexCourier.LinkExternalID(externalID))
+	suite.Require().NoError(suite.tryAddCourier(globexCtx, globexCourier))
+
+	duplicate := createTestCourier()
+	suite.Require().NoError(duplicate.LinkExternalID(externalID))
+	suite.Require().ErrorIs(suite.tryAddCourier(acmeCtx, duplicate), courier.ErrExternalIDIsAlreadyRegistered)
+}
+
+// TestOrderReferences_UniqueWithinTenant verifies that the marketplace reference, the basket and
+// the tracking token of an order are unique within a tenant, but may recur in another tenant.
+func (suite *TenancyIntegrationTestSuite) TestOrderReferences_UniqueWithinTenant() {
+	acmeCtx := tenant.WithID(context.Background(), acmeTenant)
+	globexCtx := tenant.WithID(context.Background(), globexTenant)
+	reference, err := order.NewExternalReference("ozon", "48213377-0021", "")
+	suite.Require().NoError(err)
+	basketID := kernel.NewUUID()
+
+	acmeOrder := createTestOrder()
+	suite.Require().NoError(acmeOrder.LinkExternalReference(reference))
+	suite.Require().NoError(acmeOrder.LinkBasket(basketID))
+	token, err := acmeOrder.ShareTracking()
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.tryAddOrder(acmeCtx, acmeOrder))
+
+	globexOrder := createTestOrder()
+	suite.Require().NoError(globexOrder.LinkExternalReference(reference))
+	suite.Require().NoError(globexOrder.LinkBasket(basketID))
+	suite.Require().NoError(globexOrder.RestoreTrackingToken(token))
+	suite.Require().NoError(suite.tryAddOrder(globexCtx, globexOrder))
+
+	sameReference := createTestOrder()
+	suite.Require().NoError(sameReference.LinkExternalReference(reference))
+	suite.Require().ErrorIs(suite.tryAddOrder(acmeCtx, sameReference), order.ErrExternalReferenceIsAlreadyRegistered)
+
+	sameBasket := createTestOrder()
+	suite.Require().NoError(sameBasket.LinkBasket(basketID))
+	suite.Require().ErrorIs(suite.tryAddOrder(acmeCtx, sameBasket), order.ErrBasketIsAlreadyOrdered)
+
+	sameToken := createTestOrder()
+	suite.Require().NoError(sameToken.RestoreTrackingToken(token))
+	suite.Require().Error(suite.tryAddOrder(acmeCtx, sameToken))
+}
+
+// TestCourierAssignmentJob_DispatchesEveryTenant verifies that the assignment job dispatches the
+// orders of a tenant other than the default one to that tenant's couriers.
+func (suite *TenancyIntegrationTestSuite) TestCourierAssignmentJob_DispatchesEveryTenant() {
+	acmeCtx := tenant.WithID(context.Background(), acmeTenant)
+	acmeCourier := createTestCourier()
+	acmeOrder := createTestOrder()
+
+	uow := suite.factory.Create()
+	suite.Require().NoError(uow.Begin(acmeCtx))
+	suite.Require().NoError(uow.CourierRepository().Add(acmeCtx, acmeCourier))
+	suite.Require().NoError(uow.OrderRepository().Add(acmeCtx, acmeOrder))
+	suite.Require().NoError(uow.Commit(acmeCtx))
+
+	tenants, err := jobs.NewTenants(defaultTenant, acmeTenant)
+	suite.Require().NoError(err)
+	bounds, err := jobs.NewTickBounds(100*time.Millisecond, 200*time.Millisecond)
+	suite.Require().NoError(err)
+	job := jobs.NewCourierAssignmentJob(
+		commands.NewAssignCourierCommandHandler(commandUoWFactory{factory: suite.factory}),
+		postgres_adapter.NewGormFleetLoadReader(suite.appDB),
+		bounds,
+		nil,
+		tenants,
+		slog.Default(),
+	)
+	suite.Require().NoError(job.Start())
+	defer func() {
+		<-job.Stop().Done()
+	}()
+
+	suite.Eventually(func() bool {
+		check := suite.factory.Create()
+		if err := check.Begin(acmeCtx); err != nil {
+			return false
+		}
+		defer func() {
+			_ = check.Rollback(acmeCtx)
+		}()
+
+		dispatched, err := check.OrderRepository().Get(acmeCtx, acmeOrder.ID())
+		return err == nil && dispatched.Status() == order.Assigned
+	}, 5*time.Second, 100*time.Millisecond, "the order of a non-default tenant must be dispatched")
+}
+
+// TestOrderLifecycleWatchdogJob_CancelsTimedOutOrdersOfEveryTenant verifies that the watchdog
+// cancels the orders waiting in Created beyond their tenant's timeout and leaves newer ones alone.
+func (suite *TenancyIntegrationTestSuite) TestOrderLifecycleWatchdogJob_CancelsTimedOutOrdersOfEveryTenant() {
+	defaultCtx := tenant.WithID(context.Background(), defaultTenant)
+	acmeCtx := tenant.WithID(context.Background(), acmeTenant)
+	now := time.Now()
+	addCreatedAt := func(ctx context.Context, createdAt time.Time) kernel.UUID {
+		o := createTestOrder()
+		suite.addOrder(ctx, o)
+		suite.Require().NoError(suite.adminDB.Exec("UPDATE orders SET created_at = ? WHERE id = ?",
+			createdAt, o.ID().Bytes()).Error)
+		return o.ID()
+	}
+
+	// The default tenant waits an hour, acme half an hour
+	defaultStale := addCreatedAt(defaultCtx, now.Add(-2*time.Hour))
+	defaultRecent := addCreatedAt(defaultCtx, now.Add(-40*time.Minute))
+	acmeStale := addCreatedAt(acmeCtx, now.Add(-40*time.Minute))
+	acmeFresh := addCreatedAt(acmeCtx, now)
+
+	timeouts, err := tenant.ParseDurations("acme:30m")
+	suite.Require().NoError(err)
+	tenants, err := jobs.NewTenants(defaultTenant, acmeTenant)
+	suite.Require().NoError(err)
+	job := jobs.NewOrderLifecycleWatchdogJob(
+		commands.NewBulkCancelOrdersCommandHandler(bulkCancellationUoWFactory{factory: suite.factory}),
+		time.Hour,
+		timeouts,
+		tenants,
+		slog.Default(),
+	)
+	suite.Require().NoError(job.Start())
+	defer func() {
+		<-job.Stop().Done()
+	}()
+
+	// The watchdog ticks at the start of every minute
+	suite.Eventually(func() bool {
+		return suite.orderStatus(defaultCtx, defaultStale) == order.Cancelled &&
+			suite.orderStatus(acmeCtx, acmeStale) == order.Cancelled
+	}, 70*time.Second, time.Second, "the timed out orders of both tenants must be cancelled")
+
+	suite.Equal(order.Created, suite.orderStatus(defaultCtx, defaultRecent))
+	suite.Equal(order.Created, suite.orderStatus(acmeCtx, acmeFresh))
+}
+
+// addOrder stores the order in a committed unit of work for the tenant carried by ctx.
+func (suite *TenancyIntegrationTestSuite) addOrder(ctx context.Context, o *order.Order) {
+	uow := suite.factory.Create()
+	suite.Require().NoError(uow.Begin(ctx))
+	suite.Require().NoError(uow.OrderRepository().Add(ctx, o))
+	suite.Require().NoError(uow.Commit(ctx))
+}
+
+// tryAddCourier stores the courier in a unit of work for the tenant carried by ctx and reports
+// why it was rejected, if it was.
+func (suite *TenancyIntegrationTestSuite) tryAddCourier(ctx context.Context, c *courier.Courier) error {
+	uow := suite.factory.Create()
+	return uow.Do(ctx, func(ctx context.Context) error {
+		return uow.CourierRepository().Add(ctx, c)
+	})
+}
+
+// tryAddOrder stores the order in a unit of work for the tenant carried by ctx and reports
+// why it was rejected, if it was.
+func (suite *TenancyIntegrationTestSuite) tryAddOrder(ctx context.Context, o *order.Order) error {
+	uow := suite.factory.Create()
+	return uow.Do(ctx, func(ctx context.Context) error {
+		return uow.OrderRepository().Add(ctx, o)
+	})
+}
+
+// orderStatus reads the status of the order in a unit of work for the tenant carried by ctx.
+func (suite *TenancyIntegrationTestSuite) orderStatus(ctx context.Context, id kernel.UUID) order.Status {
+	uow := suite.factory.Create()
+	suite.Require().NoError(uow.Begin(ctx))
+	defer func() {
+		_ = uow.Rollback(ctx)
+	}()
+
+	o, err := uow.OrderRepository().Get(ctx, id)
+	suite.Require().NoError(err)
+	return o.Status()
+}
+
+// commandUoWFactory hands the units of work to command handlers, as the composition root does.
+type commandUoWFactory struct {
+	factory ports.UnitOfWorkFactory
+}
+
+func (f commandUoWFactory) Create() commands.UoW {
+	return f.factory.Create()
+}
+
+// bulkCancellationUoWFactory hands the units of work to the bulk cancellation, as the composition root does.
+type bulkCancellationUoWFactory struct {
+	factory ports.UnitOfWorkFactory
+}
+
+func (f bulkCancellationUoWFactory) Create() commands.BulkCancellationUoW {
+	return f.factory.Create()
+}
+
+// bindTenant scopes a raw transaction to the tenant the same way the unit of work does.
+func bindTenant(tx *gorm.DB, id tenant.ID) error {
+	return tx.Exec("SELECT set_config(?, ?, true)", tenant.SessionSetting, id.String()).Error
+}
+
+// migrateTenantTables creates every table ApplyTenancyPolicies puts under row-level security.
+func migrateTenantTables(db *gorm.DB) error {
+	return db.AutoMigrate(
+		&orderrepo.OrderDTO{},
+		&orderrepo.OrderMessageDTO{},
+		&orderrepo.OrderPaymentTransitionDTO{},
+		&orderrepo.ArchivedOrderDTO{},
+		&orderrepo.OrderItemDTO{},
+		&courierrepo.CourierDTO{},
+		&courierrepo.StoragePlaceDTO{},
+		&courierrepo.MaintenanceWindowDTO{},
+		&courierrepo.AbsenceDTO{},
+		&courierrepo.ScheduleWindowDTO{},
+		&postgres_adapter.ChangeLogDTO{},
+		&postgres_adapter.CourierAvailabilityDTO{},
+		&postgres_adapter.OrderHistoryEntryDTO{},
+		&postgres_adapter.AssignmentExplanationDTO{},
+		&postgres_adapter.AssignmentScoreFactorDTO{},
+		&postgres_adapter.AnnouncementDTO{},
+		&postgres_adapter.AnnouncementDeliveryDTO{},
+		&postgres_adapter.MicrozoneDTO{},
+		&pickuprepo.PickupSlotDTO{},
+		&pickuprepo.PickupSlotBookingDTO{},
+		&earningsrepo.EntryDTO{},
+		&relayrepo.HandoverDTO{},
+		&postgres_adapter.CourierDeviceReadingDTO{},
+		&postgres_adapter.SurgeToggleDTO{},
+		&postgres_adapter.SLATargetDTO{},
+		&postgres_adapter.SLAComplianceDTO{},
+		&postgres_adapter.MatchingRuleDTO{},
+		&postgres_adapter.CourierLocationPointDTO{},
+		&postgres_adapter.CourierDailyTrackDTO{},
+		&postgres_adapter.VerificationAttemptDTO{},
+		&postgres_adapter.PersonalDataErasureDTO{},
+		&postgres_adapter.BulkCancellationDTO{},
+		&postgres_adapter.OrderEscalationDTO{},
+		&postgres_adapter.CourierDeliveryDTO{},
+		&postgres_adapter.CourierBadgeDTO{},
+		// Shared by all tenants, but written by every unit of work that changes an order
+		&outboxrepo.OutboxMessageDTO{},
+	)
+}
+
+// createAppRole creates the non-superuser role the service connects as, with access to every
+// table created so far. The role belongs to the server, the grants are copied into every clone.
+func createAppRole(db *gorm.DB) error {
+	if err := db.Exec("CREATE ROLE delivery_app LOGIN PASSWORD 'app'").Error; err != nil {
+		return err
+	}
+	return db.Exec("GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA public TO delivery_app").Error
+}
+
+func TestTenancyIntegrationTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(TenancyIntegrationTestSuite))
+}
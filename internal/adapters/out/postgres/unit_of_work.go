@@ -11,11 +11,20 @@
 //
 // Usage Patterns:
 //
-// Basic Transaction Management:
+// Transaction Helper:
 //
 //	factory := NewGormUnitOfWorkFactory(db)
 //	uow := factory.Create()
 //
+//	// Commits when the function succeeds, rolls back on an error or a panic
+//	return uow.Do(ctx, func(ctx context.Context) error {
+//	    return uow.OrderRepository().Add(ctx, order)
+//	})
+//
+// Basic Transaction Management:
+//
+//	uow := factory.Create()
+//
 //	if err := uow.Begin(ctx); err != nil {
 //	    return err
 //	}
@@ -55,6 +64,7 @@
 //	return uow.Commit(ctx)
 //
 // Error Handling Best Practices:
+//   - Prefer Do() over managing the transaction by hand
 //   - Always handle Begin() errors
 //   - Use defer/recover for automatic rollback
 //   - Explicit rollback on business logic errors
@@ -73,12 +83,19 @@ package postgres
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/adapters/out/postgres/earningsrepo"
 	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/adapters/out/postgres/pickuprepo"
+	"delivery/internal/adapters/out/postgres/relayrepo"
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tracing"
 
+	"go.opentelemetry.io/otel/trace"
 	"gorm.io/gorm"
 )
 
@@ -147,6 +164,11 @@ func NewGormUnitOfWorkFactory(db *gorm.DB) *GormUnitOfWorkFactory {
 //
 //nolint:ireturn // Factory returns interface for proper abstraction
 func (f *GormUnitOfWorkFactory) Create() ports.UnitOfWork {
+	return f.create()
+}
+
+// create returns the concrete unit of work so adapters in this package can reach the transaction.
+func (f *GormUnitOfWorkFactory) create() *GormUnitOfWork {
 	return &GormUnitOfWork{
 		db:                f.db,
 		trackedAggregates: make([]trackedAggregate, 0),
@@ -194,11 +216,19 @@ type GormUnitOfWork struct {
 	db                *gorm.DB
 	tx                *gorm.DB
 	trackedAggregates []trackedAggregate
+	// span covers the transaction from Begin to Commit or Rollback
+	span trace.Span
 }
 
 // Begin initiates a new database transaction for the unit of work.
 // Subsequent repository operations will execute within this transaction context.
+// The transaction is bound to the tenant carried by ctx, see tenant.WithID;
+// row-level security then hides the data of every other tenant. Aggregates added in a
+// transaction marked with synthetic.WithMarker are stamped as synthetic test data.
+// When the factory's connection carries a querycost budget, every statement of the
+// transaction runs under its statement timeout.
 // Multiple calls to Begin on the same instance are safe and will not create nested transactions.
+// A new transaction starts with no tracked aggregates.
 //
 // Example:
 //
@@ -211,9 +241,30 @@ func (uow *GormUnitOfWork) Begin(ctx context.Context) error {
 		return nil
 	}
 
-	uow.tx = uow.db.WithContext(ctx).Begin()
+	uow.trackedAggregates = uow.trackedAggregates[:0]
+	spanCtx, span := tracing.Start(ctx, "UnitOfWork")
+	uow.tx = uow.db.WithContext(spanCtx).Begin()
 	if uow.tx.Error != nil {
-		return uow.tx.Error
+		err := uow.tx.Error
+		uow.tx = nil
+		endTransactionSpan(span, err)
+		return err
+	}
+	uow.span = span
+
+	if err := bindTenant(ctx, uow.tx); err != nil {
+		_ = uow.rollback(err)
+		return err
+	}
+
+	if err := bindSynthetic(ctx, uow.tx); err != nil {
+		_ = uow.rollback(err)
+		return err
+	}
+
+	if err := bindStatementTimeout(uow.tx); err != nil {
+		_ = uow.rollback(err)
+		return err
 	}
 
 	return nil
@@ -221,7 +272,13 @@ func (uow *GormUnitOfWork) Begin(ctx context.Context) error {
 
 // Commit finalizes all changes made within the current transaction.
 // All tracked aggregates and their modifications become permanent in the database.
-// After commit, the transaction is closed and cannot be reused.
+// Tracked orders and couriers are appended to the change log in the same transaction,
+// so the change feed never misses or invents a change; couriers whose availability changed are
+// appended to the availability log likewise, with an event in the outbox. The events the tracked orders raised
+// are written to the outbox in the same transaction too and cleared once committed; the
+// outbox relay publishes them.
+// After commit, the transaction is closed and cannot be reused. When writing the change log,
+// the availability log or the outbox fails, the transaction is rolled back and closed as well.
 //
 // Returns error if no active transaction exists or if the commit operation fails.
 //
@@ -237,14 +294,39 @@ func (uow *GormUnitOfWork) Begin(ctx context.Context) error {
 //	if err := uow.Commit(ctx); err != nil {
 //	    return fmt.Errorf("failed to commit changes: %w", err)
 //	}
-func (uow *GormUnitOfWork) Commit(_ context.Context) error {
+func (uow *GormUnitOfWork) Commit(ctx context.Context) error {
 	if uow.tx == nil {
 		return gorm.ErrInvalidTransaction
 	}
 
-	err := uow.tx.Commit().Error
+	now := time.Now()
+	if err := appendChanges(uow.tx, uow.trackedAggregates, now); err != nil {
+		_ = uow.rollback(err)
+		return err
+	}
+	if err := recordAvailabilityChanges(ctx, uow.tx, uow.trackedAggregates, now); err != nil {
+		_ = uow.rollback(err)
+		return err
+	}
+
+	orders, err := storeOrderEvents(ctx, uow.tx, uow.trackedAggregates, now)
+	if err != nil {
+		_ = uow.rollback(err)
+		return err
+	}
+
+	err = uow.tx.Commit().Error
 	uow.tx = nil
-	return err
+	endTransactionSpan(uow.span, err)
+	uow.span = nil
+	if err != nil {
+		return err
+	}
+
+	for _, o := range orders {
+		o.ClearEvents()
+	}
+	return nil
 }
 
 // Rollback discards all changes made within the current transaction.
@@ -276,11 +358,71 @@ func (uow *GormUnitOfWork) Rollback(_ context.Context) error {
 		return nil // No active transaction, nothing to rollback
 	}
 
+	return uow.rollback(nil)
+}
+
+// Do runs fn within a transaction of the unit of work. The transaction is committed
+// when fn succeeds and rolled back when fn or the commit fails. A panic in fn rolls
+// the transaction back too and is re-raised afterwards.
+// The ctx passed to fn is the ctx of Do; repositories obtained inside fn are bound
+// to the transaction.
+//
+// A Do called while a transaction of the unit of work is open, from Begin or an outer Do,
+// joins that transaction: it runs fn and returns its error, leaving the commit or rollback
+// to whoever began the transaction.
+//
+// Example:
+//
+//	uow := factory.Create()
+//	err := uow.Do(ctx, func(ctx context.Context) error {
+//	    if err := uow.OrderRepository().Add(ctx, order); err != nil {
+//	        return err
+//	    }
+//	    return uow.CourierRepository().Update(ctx, courier)
+//	})
+func (uow *GormUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if uow.tx != nil {
+		return fn(ctx)
+	}
+
+	if err = uow.Begin(ctx); err != nil {
+		return err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			_ = uow.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			_ = uow.Rollback(ctx)
+		}
+	}()
+
+	if err = fn(ctx); err != nil {
+		return err
+	}
+	return uow.Commit(ctx)
+}
+
+// rollback rolls the transaction back and ends its span, failed with cause if it is not nil.
+func (uow *GormUnitOfWork) rollback(cause error) error {
 	err := uow.tx.Rollback().Error
 	uow.tx = nil
+	endTransactionSpan(uow.span, errors.Join(cause, err))
+	uow.span = nil
 	return err
 }
 
+// endTransactionSpan ends the span of a transaction, failed with err if it is not nil.
+func endTransactionSpan(span trace.Span, err error) {
+	if span == nil {
+		return
+	}
+	tracing.RecordError(span, err)
+	span.End()
+}
+
 // CourierRepository provides access to courier persistence operations within the unit of work.
 // Repository operations will execute within the current transaction if one is active,
 // otherwise they use the main database connection for immediate execution.
@@ -341,6 +483,101 @@ func (uow *GormUnitOfWork) OrderRepository() ports.OrderRepository {
 	return orderrepo.NewGormOrderRepository(db, uow)
 }
 
+// PickupSlotRepository provides access to pickup slot persistence operations within the unit of work.
+// Repository operations will execute within the current transaction if one is active,
+// otherwise they use the main database connection for immediate execution.
+//
+// Booking a slot in the same transaction as the assignment keeps the slot capacity
+// and the assigned orders consistent.
+//
+//nolint:ireturn // Repository returns interface for proper abstraction
+func (uow *GormUnitOfWork) PickupSlotRepository() ports.PickupSlotRepository {
+	db := uow.db
+	if uow.tx != nil {
+		db = uow.tx
+	}
+	return pickuprepo.NewGormPickupSlotRepository(db, uow)
+}
+
+// EarningsLedger provides access to the couriers' earnings ledger within the unit of work.
+// Ledger operations will execute within the current transaction if one is active,
+// otherwise they use the main database connection for immediate execution.
+//
+// Recording an earning in the same transaction as the order change it stems from
+// keeps the ledger and the orders consistent.
+//
+//nolint:ireturn // Ledger returns interface for proper abstraction
+func (uow *GormUnitOfWork) EarningsLedger() ports.EarningsLedger {
+	db := uow.db
+	if uow.tx != nil {
+		db = uow.tx
+	}
+	return earningsrepo.NewGormEarningsLedger(db)
+}
+
+// HandoverRepository provides access to the handovers of orders between couriers within the unit of work.
+// Repository operations will execute within the current transaction if one is active,
+// otherwise they use the main database connection for immediate execution.
+//
+// Recording a handover in the same transaction as the couriers and the order it moves
+// keeps the relay history and the storage places consistent.
+//
+//nolint:ireturn // Repository returns interface for proper abstraction
+func (uow *GormUnitOfWork) HandoverRepository() ports.HandoverRepository {
+	db := uow.db
+	if uow.tx != nil {
+		db = uow.tx
+	}
+	return relayrepo.NewGormHandoverRepository(db)
+}
+
+// CourierMergeRepository provides access to the merging of duplicate courier records within the unit of work.
+// Repository operations will execute within the current transaction if one is active,
+// otherwise they use the main database connection for immediate execution.
+//
+// Moving the records of the duplicate in the same transaction as the surviving courier
+// keeps a merge all-or-nothing and lets a dry run roll it back.
+//
+//nolint:ireturn // Repository returns interface for proper abstraction
+func (uow *GormUnitOfWork) CourierMergeRepository() ports.CourierMergeRepository {
+	db := uow.db
+	if uow.tx != nil {
+		db = uow.tx
+	}
+	return NewGormCourierMergeRepository(db)
+}
+
+// PersonalDataEraser provides access to the erasure of customers' personal data within the unit of work.
+// Eraser operations will execute within the current transaction if one is active,
+// otherwise they use the main database connection for immediate execution.
+//
+// Erasing in the same transaction as the verification and the audit entry lets an erasure
+// that left personal data behind roll back as a whole.
+//
+//nolint:ireturn // Eraser returns interface for proper abstraction
+func (uow *GormUnitOfWork) PersonalDataEraser() ports.PersonalDataEraser {
+	db := uow.db
+	if uow.tx != nil {
+		db = uow.tx
+	}
+	return NewGormPersonalDataEraser(db)
+}
+
+// BulkCancellationLog provides access to the audit log of bulk cancellations within the unit of work.
+// Log operations will execute within the current transaction if one is active,
+// otherwise they use the main database connection for immediate execution.
+//
+// Recording a batch in the same transaction as its cancellations keeps the log in step with the orders.
+//
+//nolint:ireturn // Log returns interface for proper abstraction
+func (uow *GormUnitOfWork) BulkCancellationLog() ports.BulkCancellationLog {
+	db := uow.db
+	if uow.tx != nil {
+		db = uow.tx
+	}
+	return NewGormBulkCancellationLog(db)
+}
+
 // TrackAggregate registers a domain aggregate as modified within this unit of work.
 // This method is typically called by repository implementations when aggregates
 // are added, updated, or otherwise modified.
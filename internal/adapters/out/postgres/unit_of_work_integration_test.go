@@ -2,21 +2,24 @@ package postgres_test
 
 import (
 	"context"
+	"errors"
+	"log/slog"
+	"slices"
 	"testing"
+	"time"
 
 	postgres_adapter "delivery/internal/adapters/out/postgres"
 	"delivery/internal/adapters/out/postgres/courierrepo"
 	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/adapters/out/postgres/outboxrepo"
 	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
 	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/pgtest"
 
 	"github.com/stretchr/testify/suite"
-	"github.com/testcontainers/testcontainers-go"
-	"github.com/testcontainers/testcontainers-go/modules/postgres"
-	"github.com/testcontainers/testcontainers-go/wait"
-	gorm_postgres "gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
@@ -24,55 +27,46 @@ import (
 // for the GORM-based Unit of Work implementation with real PostgreSQL database.
 type UnitOfWorkIntegrationTestSuite struct {
 	suite.Suite
-	container *postgres.PostgresContainer
-	db        *gorm.DB
-	factory   ports.UnitOfWorkFactory
+	template *pgtest.Template
+	db       *gorm.DB
+	factory  ports.UnitOfWorkFactory
 }
 
-// SetupSuite initializes PostgreSQL container and database connection for all tests.
-// Runs database migrations to prepare schema for unit of work operations.
+// SetupSuite starts PostgreSQL and migrates the template database for all tests.
 func (suite *UnitOfWorkIntegrationTestSuite) SetupSuite() {
-	ctx := context.Background()
-
-	// Start PostgreSQL container
-	container, err := postgres.Run(ctx,
-		"postgres:15-alpine",
-		postgres.WithDatabase("testdb"),
-		postgres.WithUsername("testuser"),
-		postgres.WithPassword("testpass"),
-		testcontainers.WithWaitStrategy(wait.ForLog("database system is ready to accept connections").
-			WithOccurrence(2)),
-	)
-	suite.Require().NoError(err)
-	suite.container = container
-
-	// Connect to database
-	dsn, err := container.ConnectionString(ctx, "sslmode=disable")
-	suite.Require().NoError(err)
-
-	db, err := gorm.Open(gorm_postgres.Open(dsn), &gorm.Config{})
-	suite.Require().NoError(err)
-	suite.db = db
-
-	// Run migrations
-	err = db.AutoMigrate(&orderrepo.OrderDTO{}, &courierrepo.CourierDTO{}, &courierrepo.StoragePlaceDTO{})
-	suite.Require().NoError(err)
-
-	// Create factory
-	suite.factory = postgres_adapter.NewGormUnitOfWorkFactory(db)
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&orderrepo.OrderDTO{},
+			&orderrepo.OrderMessageDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{},
+			&orderrepo.OrderItemDTO{},
+			&courierrepo.CourierDTO{},
+			&courierrepo.StoragePlaceDTO{},
+			&courierrepo.MaintenanceWindowDTO{},
+			&courierrepo.AbsenceDTO{},
+			&courierrepo.ScheduleWindowDTO{},
+			&postgres_adapter.ChangeLogDTO{},
+			&postgres_adapter.CourierAvailabilityDTO{},
+			&outboxrepo.OutboxMessageDTO{},
+			&postgres_adapter.DataFixExecutionDTO{},
+			&postgres_adapter.BlacklistEntryDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
 }
 
-// SetupTest ensures clean database state before each test.
-// Truncates all tables to prevent test interference.
+// SetupTest gives every test a fresh clone of the migrated database,
+// so tests never see each other's rows.
 func (suite *UnitOfWorkIntegrationTestSuite) SetupTest() {
-	err := suite.db.Exec("TRUNCATE TABLE orders, couriers, storage_places").Error
-	suite.Require().NoError(err)
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.factory = postgres_adapter.NewGormUnitOfWorkFactory(suite.db)
 }
 
 // TearDownSuite cleans up PostgreSQL container after all tests complete.
 func (suite *UnitOfWorkIntegrationTestSuite) TearDownSuite() {
-	if suite.container != nil {
-		err := suite.container.Terminate(context.Background())
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
 		suite.Require().NoError(err)
 	}
 }
@@ -269,6 +263,216 @@ func (suite *UnitOfWorkIntegrationTestSuite) TestUnitOfWork_TransactionRollback(
 	suite.Require().Error(err, "Courier should not exist after rollback")
 }
 
+// TestUnitOfWork_Do_CommitsOnSuccess verifies Do commits the changes of a function that succeeds.
+func (suite *UnitOfWorkIntegrationTestSuite) TestUnitOfWork_Do_CommitsOnSuccess() {
+	ctx := context.Background()
+	uow := suite.factory.Create()
+	testOrder := createTestOrder()
+	testCourier := createTestCourier()
+
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		if err := uow.OrderRepository().Add(ctx, testOrder); err != nil {
+			return err
+		}
+		return uow.CourierRepository().Add(ctx, testCourier)
+	})
+	suite.Require().NoError(err)
+
+	newUow := suite.factory.Create()
+	_, err = newUow.OrderRepository().Get(ctx, testOrder.ID())
+	suite.Require().NoError(err)
+	_, err = newUow.CourierRepository().Get(ctx, testCourier.ID())
+	suite.Require().NoError(err)
+}
+
+// TestUnitOfWork_Do_RollsBackOnError verifies Do discards the changes of a function that fails
+// and returns its error.
+func (suite *UnitOfWorkIntegrationTestSuite) TestUnitOfWork_Do_RollsBackOnError() {
+	ctx := context.Background()
+	uow := suite.factory.Create()
+	testOrder := createTestOrder()
+	failure := errors.New("business rule violated")
+
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		if err := uow.OrderRepository().Add(ctx, testOrder); err != nil {
+			return err
+		}
+		return failure
+	})
+	suite.Require().ErrorIs(err, failure)
+
+	_, err = suite.factory.Create().OrderRepository().Get(ctx, testOrder.ID())
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+
+	// The unit of work is usable again after the rollback
+	err = uow.Do(ctx, func(ctx context.Context) error {
+		return uow.OrderRepository().Add(ctx, testOrder)
+	})
+	suite.Require().NoError(err)
+}
+
+// TestUnitOfWork_Do_RollsBackOnPanic verifies Do discards the changes of a function that panics
+// and re-raises the panic.
+func (suite *UnitOfWorkIntegrationTestSuite) TestUnitOfWork_Do_RollsBackOnPanic() {
+	ctx := context.Background()
+	uow := suite.factory.Create()
+	testOrder := createTestOrder()
+
+	suite.Require().PanicsWithValue("boom", func() {
+		_ = uow.Do(ctx, func(ctx context.Context) error {
+			if err := uow.OrderRepository().Add(ctx, testOrder); err != nil {
+				return err
+			}
+			panic("boom")
+		})
+	})
+
+	_, err := suite.factory.Create().OrderRepository().Get(ctx, testOrder.ID())
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+}
+
+// TestUnitOfWork_Do_NestedJoinsOuterTransaction verifies a nested Do runs within the outer
+// transaction and leaves its commit to the outer Do.
+func (suite *UnitOfWorkIntegrationTestSuite) TestUnitOfWork_Do_NestedJoinsOuterTransaction() {
+	ctx := context.Background()
+	uow := suite.factory.Create()
+	testOrder := createTestOrder()
+	failure := errors.New("business rule violated")
+
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		err := uow.Do(ctx, func(ctx context.Context) error {
+			return uow.OrderRepository().Add(ctx, testOrder)
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = suite.factory.Create().OrderRepository().Get(ctx, testOrder.ID())
+		suite.Require().ErrorIs(err, errs.ErrObjectNotFound, "the nested Do must not commit")
+		return failure
+	})
+	suite.Require().ErrorIs(err, failure)
+
+	_, err = suite.factory.Create().OrderRepository().Get(ctx, testOrder.ID())
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+}
+
+// TestUnitOfWork_Commit_RollsBackWhenWritingLogsFails verifies a failure before the commit
+// rolls the transaction back and closes it.
+func (suite *UnitOfWorkIntegrationTestSuite) TestUnitOfWork_Commit_RollsBackWhenWritingLogsFails() {
+	ctx := context.Background()
+	uow := suite.factory.Create()
+	testOrder := createTestOrder()
+	testCourier := createTestCourier()
+
+	suite.Require().NoError(uow.Begin(ctx))
+	suite.Require().NoError(uow.OrderRepository().Add(ctx, testOrder))
+	suite.Require().NoError(uow.CourierRepository().Add(ctx, testCourier))
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	suite.Require().Error(uow.Commit(canceled))
+	suite.Require().ErrorIs(uow.Commit(ctx), gorm.ErrInvalidTransaction)
+
+	_, err := suite.factory.Create().OrderRepository().Get(ctx, testOrder.ID())
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+	_, err = suite.factory.Create().CourierRepository().Get(ctx, testCourier.ID())
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+}
+
+// TestUnitOfWork_StoresOrderEventsInOutbox verifies that the events raised by tracked orders
+// are written to the outbox with the commit, in the order they were raised, and cleared.
+func (suite *UnitOfWorkIntegrationTestSuite) TestUnitOfWork_StoresOrderEventsInOutbox() {
+	ctx := context.Background()
+	testOrder := createTestOrder()
+	testCourier := createTestCourier()
+
+	uow := suite.factory.Create()
+	suite.Require().NoError(uow.Begin(ctx))
+	suite.Require().NoError(uow.CourierRepository().Add(ctx, testCourier))
+	suite.Require().NoError(uow.OrderRepository().Add(ctx, testOrder))
+	suite.Require().NoError(testOrder.Assign(testCourier.ID()))
+	suite.Require().NoError(uow.OrderRepository().Update(ctx, testOrder))
+	suite.Require().NoError(uow.Commit(ctx))
+
+	messages, err := outboxrepo.NewGormOutboxRepository(suite.db).GetUnprocessed(ctx, 10)
+	suite.Require().NoError(err)
+	// The courier on shift is announced free as well
+	messages = slices.DeleteFunc(messages, func(message ports.OutboxMessage) bool {
+		return message.EventType == outboxrepo.CourierAvailabilityChangedEvent
+	})
+	suite.Require().Len(messages, 2)
+	suite.Equal(outboxrepo.OrderCreatedEvent, messages[0].EventType)
+	suite.Equal(outboxrepo.OrderAssignedEvent, messages[1].EventType)
+	suite.Equal(testOrder.ID().String(), messages[1].AggregateID)
+	suite.Empty(testOrder.Events())
+}
+
+// TestUnitOfWork_RecordsCourierAvailabilityChanges verifies that a courier becoming busy or going
+// off shift is appended to the availability log with an event, and other changes are not.
+func (suite *UnitOfWorkIntegrationTestSuite) TestUnitOfWork_RecordsCourierAvailabilityChanges() {
+	ctx := context.Background()
+	testCourier := createTestCourier()
+	save := func(change func() error) {
+		uow := suite.factory.Create()
+		suite.Require().NoError(uow.Begin(ctx))
+		suite.Require().NoError(change())
+		suite.Require().NoError(uow.CourierRepository().Update(ctx, testCourier))
+		suite.Require().NoError(uow.Commit(ctx))
+	}
+
+	uow := suite.factory.Create()
+	suite.Require().NoError(uow.Begin(ctx))
+	suite.Require().NoError(uow.CourierRepository().Add(ctx, testCourier))
+	suite.Require().NoError(uow.Commit(ctx))
+
+	save(testCourier.StartBreak)
+	// Moving does not change the availability
+	save(func() error {
+		location, _ := kernel.NewLocation(4, 4)
+		return testCourier.Move(location)
+	})
+	save(func() error { return testCourier.EndShift(time.Now()) })
+
+	var entries []postgres_adapter.CourierAvailabilityDTO
+	suite.Require().NoError(suite.db.Order("id").Find(&entries).Error)
+	suite.Require().Len(entries, 3)
+	for i, availability := range []courier.Availability{
+		courier.AvailabilityFree, courier.AvailabilityBusy, courier.AvailabilityOffShift,
+	} {
+		suite.Equal(int64(i+1), entries[i].Version)
+		suite.Equal(int(availability), entries[i].Availability)
+	}
+
+	messages, err := outboxrepo.NewGormOutboxRepository(suite.db).GetUnprocessed(ctx, 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(messages, 3)
+	suite.Equal(outboxrepo.CourierAvailabilityChangedEvent, messages[2].EventType)
+	suite.Equal(testCourier.ID().String(), messages[2].AggregateID)
+	suite.Contains(string(messages[2].Payload), `"availability":"off_shift","previousAvailability":"busy","version":3`)
+}
+
+// TestUnitOfWork_StoresOrderCancelledEvent verifies that an order cancelled before it was assigned
+// is announced without a courier.
+func (suite *UnitOfWorkIntegrationTestSuite) TestUnitOfWork_StoresOrderCancelledEvent() {
+	ctx := context.Background()
+	testOrder := createTestOrder()
+
+	uow := suite.factory.Create()
+	suite.Require().NoError(uow.Begin(ctx))
+	suite.Require().NoError(uow.OrderRepository().Add(ctx, testOrder))
+	suite.Require().NoError(testOrder.Cancel())
+	suite.Require().NoError(uow.OrderRepository().Update(ctx, testOrder))
+	suite.Require().NoError(uow.Commit(ctx))
+
+	messages, err := outboxrepo.NewGormOutboxRepository(suite.db).GetUnprocessed(ctx, 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(messages, 2)
+	suite.Equal(outboxrepo.OrderCancelledEvent, messages[1].EventType)
+	suite.Contains(string(messages[1].Payload), testOrder.ID().String())
+	suite.NotContains(string(messages[1].Payload), "courierId")
+}
+
 // TestUnitOfWork_AggregateTracking verifies that aggregate tracking mechanism works
 // during unit of work operations by ensuring repository operations complete successfully.
 func (suite *UnitOfWorkIntegrationTestSuite) TestUnitOfWork_AggregateTracking() {
@@ -401,11 +605,13 @@ func createTestOrder() *order.Order {
 	return testOrder
 }
 
-// createTestCourier creates a valid courier for testing purposes.
+// createTestCourier creates a valid courier on shift, Available for dispatch, for testing purposes.
 func createTestCourier() *courier.Courier {
 	id := kernel.NewUUID()
 	location, _ := kernel.NewLocation(3, 4)
 	testCourier, _ := courier.NewCourier(id, "Test Courier", 3, location)
+	limit, _ := courier.NewWorkingHoursLimit(8*time.Hour, 30*time.Minute)
+	_ = testCourier.StartShift(time.Now(), limit)
 	return testCourier
 }
 
@@ -452,13 +658,8 @@ func (suite *UnitOfWorkIntegrationTestSuite) TestUnitOfWork_OrderDeliveryWorkflo
 	suite.Require().NoError(err)
 
 	// Step 6: Release order from courier storage
-	for _, place := range testCourier.StoragePlaces() {
-		if place.OrderID() != nil && place.OrderID().IsEqual(testOrder.ID()) {
-			err = place.Clear(testOrder.ID())
-			suite.Require().NoError(err)
-			break
-		}
-	}
+	err = testCourier.CompleteOrder(testOrder.ID())
+	suite.Require().NoError(err)
 	err = uow.CourierRepository().Update(ctx, testCourier)
 	suite.Require().NoError(err)
 
@@ -723,7 +924,7 @@ func (suite *UnitOfWorkIntegrationTestSuite) TestUnitOfWork_QueryConsistency() {
 	suite.Require().NoError(err)
 
 	// Query for created orders - should include order2 but not order1
-	createdOrder, err := uow.OrderRepository().GetFirstInCreatedStatus(ctx)
+	createdOrder, err := uow.OrderRepository().GetNextDispatchable(ctx)
 	suite.Require().NoError(err)
 	suite.Equal(order2.ID(), createdOrder.ID(), "Should find the unassigned order")
 
@@ -745,7 +946,7 @@ func (suite *UnitOfWorkIntegrationTestSuite) TestUnitOfWork_QueryConsistency() {
 	// Verify queries still return consistent results after commit
 	newUow := suite.factory.Create()
 
-	createdOrder, err = newUow.OrderRepository().GetFirstInCreatedStatus(ctx)
+	createdOrder, err = newUow.OrderRepository().GetNextDispatchable(ctx)
 	suite.Require().NoError(err)
 	suite.Equal(order2.ID(), createdOrder.ID())
 
@@ -759,6 +960,127 @@ func (suite *UnitOfWorkIntegrationTestSuite) TestUnitOfWork_QueryConsistency() {
 	suite.Empty(freeCouriers)
 }
 
+// TestFleetLoadReader_CountsQueuedOrdersAndFreeCouriers verifies the capacity counts
+// follow the same free courier rules as the courier repository.
+func (suite *UnitOfWorkIntegrationTestSuite) TestFleetLoadReader_CountsQueuedOrdersAndFreeCouriers() {
+	ctx := context.Background()
+	uow := suite.factory.Create()
+	reader := postgres_adapter.NewGormFleetLoadReader(suite.db)
+
+	busyCourier := createTestCourier()
+	onBreakCourier := createTestCourier()
+	suite.Require().NoError(onBreakCourier.StartBreak())
+	freeCourier := createTestCourier()
+	for _, c := range []*courier.Courier{busyCourier, onBreakCourier, freeCourier} {
+		suite.Require().NoError(uow.CourierRepository().Add(ctx, c))
+	}
+
+	assignedOrder := createTestOrder()
+	suite.Require().NoError(assignedOrder.Assign(busyCourier.ID()))
+	heldOrder := createTestOrder()
+	suite.Require().NoError(heldOrder.HoldForReview("blacklisted address"))
+	for _, o := range []*order.Order{assignedOrder, heldOrder, createTestOrder(), createTestOrder()} {
+		suite.Require().NoError(uow.OrderRepository().Add(ctx, o))
+	}
+
+	load, err := reader.GetFleetLoad(ctx)
+
+	suite.Require().NoError(err)
+	suite.Equal(2, load.QueuedOrders)
+	suite.Equal(1, load.FreeCouriers)
+}
+
+// TestBlacklistFraudChecker_RejectsBlacklistedStreet verifies that blacklist lookups
+// ignore case and surrounding whitespace and let other streets through.
+func (suite *UnitOfWorkIntegrationTestSuite) TestBlacklistFraudChecker_RejectsBlacklistedStreet() {
+	ctx := context.Background()
+	checker := postgres_adapter.NewGormBlacklistFraudChecker(suite.db)
+
+	entry := postgres_adapter.NewBlacklistEntry("Несуществующая, 13", "chargebacks")
+	suite.Require().NoError(suite.db.Create(&entry).Error)
+
+	assessment, err := checker.CheckOrder(ctx, ports.OrderFraudCheck{
+		OrderID: kernel.NewUUID(),
+		Street:  "  НЕСУЩЕСТВУЮЩАЯ, 13 ",
+		Volume:  5,
+	})
+	suite.Require().NoError(err)
+	suite.Equal(ports.FraudVerdictReject, assessment.Verdict)
+	suite.Contains(assessment.Reason, "chargebacks")
+
+	assessment, err = checker.CheckOrder(ctx, ports.OrderFraudCheck{
+		OrderID: kernel.NewUUID(),
+		Street:  "Тверская, 1",
+		Volume:  5,
+	})
+	suite.Require().NoError(err)
+	suite.Equal(ports.FraudVerdictClear, assessment.Verdict)
+}
+
+// TestDataFixRunner_ClearOrphanedStoragePlaces verifies dry run, apply, repeated apply
+// and revert of a data fix, including the data_fixes execution record.
+func (suite *UnitOfWorkIntegrationTestSuite) TestDataFixRunner_ClearOrphanedStoragePlaces() {
+	ctx := context.Background()
+	fix := postgres_adapter.NewClearOrphanedStoragePlacesFix()
+	runner, err := postgres_adapter.NewDataFixRunner(
+		postgres_adapter.NewGormUnitOfWorkFactory(suite.db), slog.Default(), fix,
+	)
+	suite.Require().NoError(err)
+
+	// Courier holds an order that was never persisted
+	orphanedOrder := createTestOrder()
+	testCourier := createTestCourier()
+	suite.Require().NoError(testCourier.TakeOrder(orphanedOrder))
+	suite.Require().NoError(suite.factory.Create().CourierRepository().Add(ctx, testCourier))
+
+	report, err := runner.Apply(ctx, fix.Name(), true)
+	suite.Require().NoError(err)
+	suite.True(report.DryRun)
+	suite.Equal(1, report.AffectedRows)
+	suite.Equal(int64(1), suite.countOccupiedStoragePlaces())
+	suite.Equal(int64(0), suite.countDataFixes())
+
+	report, err = runner.Apply(ctx, fix.Name(), false)
+	suite.Require().NoError(err)
+	suite.Equal(1, report.AffectedRows)
+	suite.Equal(int64(0), suite.countOccupiedStoragePlaces())
+	suite.Equal(int64(1), suite.countDataFixes())
+
+	_, err = runner.Apply(ctx, fix.Name(), false)
+	suite.Require().ErrorIs(err, postgres_adapter.ErrDataFixIsAlreadyApplied)
+
+	report, err = runner.Revert(ctx, fix.Name(), false)
+	suite.Require().NoError(err)
+	suite.Equal(1, report.AffectedRows)
+	suite.Equal(int64(1), suite.countOccupiedStoragePlaces())
+
+	_, err = runner.Revert(ctx, fix.Name(), false)
+	suite.Require().ErrorIs(err, postgres_adapter.ErrDataFixIsNotApplied)
+}
+
+// TestDataFixRunner_UnknownFix verifies that unknown fix names are reported as not found.
+func (suite *UnitOfWorkIntegrationTestSuite) TestDataFixRunner_UnknownFix() {
+	runner, err := postgres_adapter.NewDataFixRunner(postgres_adapter.NewGormUnitOfWorkFactory(suite.db), slog.Default())
+	suite.Require().NoError(err)
+
+	_, err = runner.Apply(context.Background(), "missing", true)
+
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+}
+
+func (suite *UnitOfWorkIntegrationTestSuite) countOccupiedStoragePlaces() int64 {
+	var count int64
+	suite.Require().NoError(suite.db.Model(&courierrepo.StoragePlaceDTO{}).Where("order_id IS NOT NULL").Count(&count).Error)
+	return count
+}
+
+func (suite *UnitOfWorkIntegrationTestSuite) countDataFixes() int64 {
+	var count int64
+	suite.Require().NoError(suite.db.Model(&postgres_adapter.DataFixExecutionDTO{}).Count(&count).Error)
+	return count
+}
+
 func TestUnitOfWorkIntegrationTestSuite(t *testing.T) {
+	t.Parallel()
 	suite.Run(t, new(UnitOfWorkIntegrationTestSuite))
 }
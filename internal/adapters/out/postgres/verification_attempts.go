@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/identity"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// VerificationAttemptDTO is an audited identity verification attempt of a courier.
+type VerificationAttemptDTO struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey"`
+	CourierID   uuid.UUID `gorm:"type:uuid;not null;index:idx_courier_verification_attempts_courier,priority:1"`
+	Method      string    `gorm:"type:varchar(32);not null"`
+	Verified    bool      `gorm:"not null"`
+	Reason      string    `gorm:"type:varchar(255);not null;default:''"`
+	AttemptedAt time.Time `gorm:"not null;index:idx_courier_verification_attempts_courier,priority:2"`
+}
+
+// TableName specifies the database table name for verification attempts.
+// Overrides GORM's default naming convention to use "courier_verification_attempts".
+func (VerificationAttemptDTO) TableName() string {
+	return "courier_verification_attempts"
+}
+
+// toDomain restores the attempt from its database representation.
+func (dto VerificationAttemptDTO) toDomain() (identity.Attempt, error) {
+	id, err := kernel.UUIDFromBytes(dto.ID[:])
+	if err != nil {
+		return identity.Attempt{}, err
+	}
+	courierID, err := kernel.UUIDFromBytes(dto.CourierID[:])
+	if err != nil {
+		return identity.Attempt{}, err
+	}
+	method, err := identity.ParseMethod(dto.Method)
+	if err != nil {
+		return identity.Attempt{}, err
+	}
+
+	return identity.NewAttempt(id, courierID, method, dto.Verified, dto.Reason, dto.AttemptedAt)
+}
+
+// GormVerificationAttemptRepository implements ports.VerificationAttemptRepository over the
+// courier_verification_attempts table. Each call runs in a transaction of its own bound to the
+// tenant carried by ctx, so an attempt stays audited when the shift it was made for is refused.
+type GormVerificationAttemptRepository struct {
+	db *gorm.DB
+}
+
+// NewGormVerificationAttemptRepository creates a verification attempt repository over the given connection.
+func NewGormVerificationAttemptRepository(db *gorm.DB) *GormVerificationAttemptRepository {
+	return &GormVerificationAttemptRepository{db: db}
+}
+
+// Add stores the attempt unless the courier does not exist.
+func (r *GormVerificationAttemptRepository) Add(ctx context.Context, attempt identity.Attempt) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		result := tx.Exec(`
+			INSERT INTO courier_verification_attempts (id, courier_id, method, verified, reason, attempted_at)
+			SELECT ?, id, ?, ?, ?, ? FROM couriers WHERE id = ?
+		`, attempt.ID().String(), attempt.Method().String(), attempt.IsVerified(), attempt.Reason(),
+			attempt.AttemptedAt(), attempt.CourierID().String())
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errs.NewObjectNotFoundError("courier", attempt.CourierID())
+		}
+		return nil
+	})
+}
+
+// GetSince returns the courier's attempts made at or after since, oldest first.
+func (r *GormVerificationAttemptRepository) GetSince(
+	ctx context.Context,
+	courierID kernel.UUID,
+	since time.Time,
+) ([]identity.Attempt, error) {
+	var dtos []VerificationAttemptDTO
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bindTenant(ctx, tx); err != nil {
+			return err
+		}
+
+		return tx.Where("courier_id = ? AND attempted_at >= ?", courierID.String(), since.UTC()).
+			Order("attempted_at, id").
+			Find(&dtos).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	attempts := make([]identity.Attempt, 0, len(dtos))
+	for _, dto := range dtos {
+		attempt, toDomainErr := dto.toDomain()
+		if toDomainErr != nil {
+			return nil, toDomainErr
+		}
+		attempts = append(attempts, attempt)
+	}
+	return attempts, nil
+}
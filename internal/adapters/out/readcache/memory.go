@@ -0,0 +1,111 @@
+// Package readcache provides the backends read models are cached in. MemoryCache keeps the entries
+// in memory of the instance; a shared backend such as Redis implements the same ports.ReadCache,
+// so every instance reads and invalidates the same entries.
+package readcache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"delivery/internal/core/ports"
+)
+
+// ErrCapacityIsInvalid is returned when a memory cache is created without room for entries.
+var ErrCapacityIsInvalid = errors.New("cache capacity must be positive")
+
+var _ ports.ReadCache = (*MemoryCache)(nil)
+
+// MemoryCache implements ports.ReadCache in memory of the instance. It holds at most capacity
+// entries: once full, expired entries are dropped first and then arbitrary ones, so a burst of
+// distinct keys cannot exhaust the memory.
+type MemoryCache struct {
+	mu       sync.Mutex
+	entries  map[string]memoryEntry
+	capacity int
+	now      func() time.Time
+}
+
+type memoryEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewMemoryCache creates a cache holding at most capacity entries.
+// Returns ErrCapacityIsInvalid if capacity is not positive.
+func NewMemoryCache(capacity int) (*MemoryCache, error) {
+	if capacity <= 0 {
+		return nil, ErrCapacityIsInvalid
+	}
+
+	return &MemoryCache{
+		entries:  make(map[string]memoryEntry, capacity),
+		capacity: capacity,
+		now:      time.Now,
+	}, nil
+}
+
+// Get returns the value stored under key, removing it once expired.
+func (c *MemoryCache) Get(_ context.Context, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	if !c.now().Before(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false, nil
+	}
+	return entry.value, true, nil
+}
+
+// Set stores the value under key until ttl elapses, making room when the cache is full.
+func (c *MemoryCache) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.capacity {
+		c.evict(now)
+	}
+	c.entries[key] = memoryEntry{value: value, expiresAt: now.Add(ttl)}
+	return nil
+}
+
+// Delete removes the keys.
+func (c *MemoryCache) Delete(_ context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		delete(c.entries, key)
+	}
+	return nil
+}
+
+// Len returns the number of entries held, including expired ones not dropped yet.
+func (c *MemoryCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+// evict drops the expired entries, or an arbitrary one if none has expired.
+func (c *MemoryCache) evict(now time.Time) {
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+	if len(c.entries) < c.capacity {
+		return
+	}
+	for key := range c.entries {
+		delete(c.entries, key)
+		return
+	}
+}
@@ -0,0 +1,80 @@
+package readcache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCache(t *testing.T, capacity int) (*MemoryCache, *time.Time) {
+	t.Helper()
+	cache, err := NewMemoryCache(capacity)
+	require.NoError(t, err)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+	return cache, &now
+}
+
+func TestMemoryCache(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("should return stored value until it expires", func(t *testing.T) {
+		cache, now := newTestCache(t, 10)
+		require.NoError(t, cache.Set(ctx, "tracking:a", []byte("view"), 5*time.Second))
+
+		value, ok, err := cache.Get(ctx, "tracking:a")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, []byte("view"), value)
+
+		*now = now.Add(5 * time.Second)
+		_, ok, err = cache.Get(ctx, "tracking:a")
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.Zero(t, cache.Len())
+	})
+
+	t.Run("should delete keys", func(t *testing.T) {
+		cache, _ := newTestCache(t, 10)
+		require.NoError(t, cache.Set(ctx, "tracking:a", []byte("view"), time.Minute))
+
+		require.NoError(t, cache.Delete(ctx, "tracking:a", "tracking:missing"))
+
+		_, ok, err := cache.Get(ctx, "tracking:a")
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("should drop expired entries first when full", func(t *testing.T) {
+		cache, now := newTestCache(t, 2)
+		require.NoError(t, cache.Set(ctx, "short", []byte("1"), time.Second))
+		require.NoError(t, cache.Set(ctx, "long", []byte("2"), time.Minute))
+		*now = now.Add(2 * time.Second)
+
+		require.NoError(t, cache.Set(ctx, "new", []byte("3"), time.Minute))
+
+		_, ok, _ := cache.Get(ctx, "long")
+		assert.True(t, ok)
+		_, ok, _ = cache.Get(ctx, "new")
+		assert.True(t, ok)
+	})
+
+	t.Run("should stay within capacity", func(t *testing.T) {
+		cache, _ := newTestCache(t, 2)
+		for _, key := range []string{"a", "b", "c", "d"} {
+			require.NoError(t, cache.Set(ctx, key, []byte(key), time.Minute))
+		}
+
+		assert.Equal(t, 2, cache.Len())
+		_, ok, _ := cache.Get(ctx, "d")
+		assert.True(t, ok)
+	})
+}
+
+func TestNewMemoryCache_InvalidCapacity(t *testing.T) {
+	_, err := NewMemoryCache(0)
+
+	require.ErrorIs(t, err, ErrCapacityIsInvalid)
+}
@@ -34,6 +34,7 @@ type AddCourierStorageCommand struct { //nolint:recvcheck //using for validation
 	courierID   kernel.UUID
 	name        string
 	totalVolume int
+	thermal     bool
 
 	guard guard.ConstructorGuard
 }
@@ -61,6 +62,22 @@ func NewAddCourierStorageCommand(
 	return command, nil
 }
 
+// NewAddThermalCourierStorageCommand creates a command like NewAddCourierStorageCommand
+// that adds a thermal storage place, which keeps chilled and frozen orders at temperature.
+func NewAddThermalCourierStorageCommand(
+	courierID kernel.UUID,
+	name string,
+	totalVolume int,
+) (AddCourierStorageCommand, error) {
+	command, err := NewAddCourierStorageCommand(courierID, name, totalVolume)
+	if err != nil {
+		return AddCourierStorageCommand{}, err
+	}
+
+	command.thermal = true
+	return command, nil
+}
+
 // Validate ensures the command was created through the constructor.
 // Returns ErrAddCourierStorageCommandIsNotConstructed if validation fails.
 func (c AddCourierStorageCommand) Validate() error {
@@ -82,6 +99,11 @@ func (c AddCourierStorageCommand) TotalVolume() int {
 	return c.totalVolume
 }
 
+// Thermal reports whether the storage place to be added is thermal.
+func (c AddCourierStorageCommand) Thermal() bool {
+	return c.thermal
+}
+
 func (c *AddCourierStorageCommand) setCourierID(courierID kernel.UUID) error {
 	if err := courierID.Validate(); err != nil {
 		return err
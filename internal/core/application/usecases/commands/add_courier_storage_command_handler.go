@@ -2,6 +2,8 @@ package commands
 
 import (
 	"context"
+
+	"delivery/internal/pkg/tracing"
 )
 
 // AddCourierStorageCommandHandler handles the business logic for adding storage places to couriers.
@@ -28,39 +30,33 @@ func NewAddCourierStorageCommandHandler(uowFactory CourierUoWFactory) AddCourier
 }
 
 // Handle processes the AddCourierStorageCommand within a transaction.
-// Retrieves the courier, adds the new storage place, and persists the changes.
+// Retrieves the courier, adds the new storage place, thermal if the command says so, and persists the changes.
 // Automatically rolls back on any error to maintain data consistency.
 func (h *AddCourierStorageCommandHandler) Handle(ctx context.Context, cmd AddCourierStorageCommand) error {
+	ctx, span := tracing.Start(ctx, "AddCourierStorageCommand")
+	defer span.End()
+
 	if err := cmd.Validate(); err != nil {
 		return err
 	}
 
 	uow := h.uowFactory.Create()
-	if err := uow.Begin(ctx); err != nil {
-		return err
-	}
-
-	defer func() {
-		_ = uow.Rollback(ctx)
-	}()
-
-	courierRepo := uow.CourierRepository()
-	courierEntity, err := courierRepo.Get(ctx, cmd.CourierID())
-	if err != nil {
-		return err
-	}
-
-	if err = courierEntity.AddStoragePlace(cmd.Name(), cmd.TotalVolume()); err != nil {
-		return err
-	}
-
-	if err = courierRepo.Update(ctx, courierEntity); err != nil {
-		return err
-	}
-
-	if err = uow.Commit(ctx); err != nil {
-		return err
-	}
-
-	return nil
+	return uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		courierEntity, err := courierRepo.Get(ctx, cmd.CourierID())
+		if err != nil {
+			return err
+		}
+
+		if cmd.Thermal() {
+			err = courierEntity.AddThermalStoragePlace(cmd.Name(), cmd.TotalVolume())
+		} else {
+			err = courierEntity.AddStoragePlace(cmd.Name(), cmd.TotalVolume())
+		}
+		if err != nil {
+			return err
+		}
+
+		return courierRepo.Update(ctx, courierEntity)
+	})
 }
@@ -51,7 +51,6 @@ func TestAddCourierStorageCommandHandler_Handle_Success(t *testing.T) {
 		mockRepo.On("Get", ctx, courierID).Return(courierEntity, nil).Once(),
 		mockRepo.On("Update", ctx, courierEntity).Return(nil).Once(),
 		mockUoW.On("Commit", ctx).Return(nil).Once(),
-		mockUoW.On("Rollback", ctx).Return(nil).Once(),
 	)
 	mockFactory.On("Create").Return(mockUoW).Once()
 
@@ -370,7 +369,6 @@ func TestAddCourierStorageCommandHandler_Handle_VerifiesStorageAddedCorrectly(t
 			return true
 		})).Return(nil).Once(),
 		mockUoW.On("Commit", ctx).Return(nil).Once(),
-		mockUoW.On("Rollback", ctx).Return(nil).Once(),
 	)
 	mockFactory.On("Create").Return(mockUoW).Once()
 
@@ -429,7 +427,6 @@ func BenchmarkAddCourierStorageCommandHandler_Handle(b *testing.B) {
 	mockRepo.On("Get", ctx, courierID).Return(courierEntity, nil).Times(b.N)
 	mockRepo.On("Update", ctx, courierEntity).Return(nil).Times(b.N)
 	mockUoW.On("Commit", ctx).Return(nil).Times(b.N)
-	mockUoW.On("Rollback", ctx).Return(nil).Times(b.N)
 
 	handler := commands.NewAddCourierStorageCommandHandler(mockFactory)
 
@@ -441,3 +438,33 @@ func BenchmarkAddCourierStorageCommandHandler_Handle(b *testing.B) {
 		}
 	}
 }
+
+func TestAddCourierStorageCommandHandler_Handle_Thermal(t *testing.T) {
+	ctx := t.Context()
+	courierID := kernel.NewUUID()
+	cmd, err := commands.NewAddThermalCourierStorageCommand(courierID, "Thermal bag", 20)
+	require.NoError(t, err)
+
+	location, err := kernel.NewLocation(5, 7)
+	require.NoError(t, err)
+	courierEntity, err := courier.NewCourier(courierID, "Test Courier", 3, location)
+	require.NoError(t, err)
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+	mockUoW.On("Begin", ctx).Return(nil).Once()
+	mockUoW.On("CourierRepository").Return(mockRepo).Once()
+	mockRepo.On("Get", ctx, courierID).Return(courierEntity, nil).Once()
+	mockRepo.On("Update", ctx, courierEntity).Return(nil).Once()
+	mockUoW.On("Commit", ctx).Return(nil).Once()
+	mockFactory.On("Create").Return(mockUoW).Once()
+
+	handler := commands.NewAddCourierStorageCommandHandler(mockFactory)
+	require.NoError(t, handler.Handle(ctx, cmd))
+
+	places := courierEntity.StoragePlaces()
+	added := places[len(places)-1]
+	assert.Equal(t, "Thermal bag", added.Name())
+	assert.True(t, added.IsThermal())
+}
@@ -269,6 +269,17 @@ func TestAddCourierStorageCommand_GetterMethods(t *testing.T) {
 	assert.Equal(t, courierID, cmd.CourierID())
 	assert.Equal(t, name, cmd.Name())
 	assert.Equal(t, totalVolume, cmd.TotalVolume())
+	assert.False(t, cmd.Thermal())
+}
+
+func TestNewAddThermalCourierStorageCommand(t *testing.T) {
+	cmd, err := commands.NewAddThermalCourierStorageCommand(kernel.NewUUID(), "Thermal bag", 20)
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.True(t, cmd.Thermal())
+
+	_, err = commands.NewAddThermalCourierStorageCommand(kernel.NewUUID(), "Thermal bag", 0)
+	require.ErrorIs(t, err, commands.ErrTotalVolumeIsInvalid)
 }
 
 func TestAddCourierStorageCommand_GetterMethods_ZeroValueReturnsDefaults(t *testing.T) {
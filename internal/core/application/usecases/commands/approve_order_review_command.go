@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrApproveOrderReviewCommandIsNotConstructed = errors.New(
+		"ApproveOrderReviewCommand must be created via NewApproveOrderReviewCommand constructor",
+	)
+)
+
+// ApproveOrderReviewCommand represents an operator's decision to release an order
+// held for fraud review back to dispatch.
+//
+// Example:
+//
+//	cmd, err := NewApproveOrderReviewCommand(orderID)
+//	if err != nil {
+//	    return fmt.Errorf("invalid order id: %w", err)
+//	}
+//
+//	handler := NewApproveOrderReviewCommandHandler(uowFactory)
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    return fmt.Errorf("failed to approve order: %w", err)
+//	}
+type ApproveOrderReviewCommand struct { //nolint:recvcheck //using for validation
+	orderID kernel.UUID
+
+	guard guard.ConstructorGuard
+}
+
+// NewApproveOrderReviewCommand creates a command to approve an order held for review.
+// Validates that the order ID is valid.
+func NewApproveOrderReviewCommand(orderID kernel.UUID) (ApproveOrderReviewCommand, error) {
+	command := ApproveOrderReviewCommand{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := command.setOrderID(orderID); err != nil {
+		return ApproveOrderReviewCommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrApproveOrderReviewCommandIsNotConstructed if validation fails.
+func (c ApproveOrderReviewCommand) Validate() error {
+	return c.guard.Validate(ErrApproveOrderReviewCommandIsNotConstructed)
+}
+
+// OrderID returns the ID of the order to approve.
+func (c ApproveOrderReviewCommand) OrderID() kernel.UUID {
+	return c.orderID
+}
+
+func (c *ApproveOrderReviewCommand) setOrderID(orderID kernel.UUID) error {
+	if err := orderID.Validate(); err != nil {
+		return err
+	}
+
+	c.orderID = orderID
+	return nil
+}
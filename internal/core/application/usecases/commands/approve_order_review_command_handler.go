@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/pkg/tracing"
+)
+
+// ApproveOrderReviewCommandHandler releases orders held for fraud review.
+// Approved orders return to the dispatch queue and are picked up by the assignment job.
+//
+// Example:
+//
+//	handler := NewApproveOrderReviewCommandHandler(uowFactory)
+//	cmd, _ := NewApproveOrderReviewCommand(orderID)
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    log.Printf("Failed to approve order: %v", err)
+//	}
+type ApproveOrderReviewCommandHandler struct {
+	uowFactory OrderUoWFactory
+}
+
+// NewApproveOrderReviewCommandHandler creates a new handler for order review approvals.
+// Requires an OrderUoWFactory for transactional operations.
+func NewApproveOrderReviewCommandHandler(uowFactory OrderUoWFactory) ApproveOrderReviewCommandHandler {
+	return ApproveOrderReviewCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the ApproveOrderReviewCommand within a transaction.
+// Returns order.ErrOrderIsNotUnderReview when the order is not held for review.
+func (h *ApproveOrderReviewCommandHandler) Handle(ctx context.Context, cmd ApproveOrderReviewCommand) error {
+	ctx, span := tracing.Start(ctx, "ApproveOrderReviewCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+
+	uow := h.uowFactory.Create()
+	return uow.Do(ctx, func(ctx context.Context) error {
+		orderRepo := uow.OrderRepository()
+		orderAggregate, err := orderRepo.Get(ctx, cmd.OrderID())
+		if err != nil {
+			return err
+		}
+
+		if err = orderAggregate.ApproveReview(); err != nil {
+			return err
+		}
+
+		return orderRepo.Update(ctx, orderAggregate)
+	})
+}
@@ -0,0 +1,66 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApproveOrderReviewCommandHandler_Handle_Success(t *testing.T) {
+	ctx := t.Context()
+	orderAggregate := createOrderForThread(t)
+	require.NoError(t, orderAggregate.HoldForReview("blacklisted address"))
+
+	repo := new(MoveOrderRepo)
+	uow := new(MockOrderUoW)
+	factory := new(MockOrderUoWFactory)
+
+	mock.InOrder(
+		factory.On("Create").Return(uow).Once(),
+		uow.On("Begin", ctx).Return(nil).Once(),
+		uow.On("OrderRepository").Return(repo).Once(),
+		repo.On("Get", ctx, orderAggregate.ID()).Return(orderAggregate, nil).Once(),
+		repo.On("Update", ctx, orderAggregate).Return(nil).Once(),
+		uow.On("Commit", ctx).Return(nil).Once(),
+	)
+
+	cmd, err := commands.NewApproveOrderReviewCommand(orderAggregate.ID())
+	require.NoError(t, err)
+
+	handler := commands.NewApproveOrderReviewCommandHandler(factory)
+	err = handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.False(t, orderAggregate.IsUnderReview())
+	repo.AssertExpectations(t)
+	uow.AssertExpectations(t)
+}
+
+func TestApproveOrderReviewCommandHandler_Handle_NotUnderReview(t *testing.T) {
+	ctx := t.Context()
+	orderAggregate := createOrderForThread(t)
+
+	repo := new(MoveOrderRepo)
+	uow := new(MockOrderUoW)
+	factory := new(MockOrderUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(repo).Once()
+	repo.On("Get", ctx, orderAggregate.ID()).Return(orderAggregate, nil).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewApproveOrderReviewCommand(orderAggregate.ID())
+	require.NoError(t, err)
+
+	handler := commands.NewApproveOrderReviewCommandHandler(factory)
+	err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, order.ErrOrderIsNotUnderReview)
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
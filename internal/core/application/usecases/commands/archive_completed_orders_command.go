@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrArchiveCompletedOrdersCommandIsNotConstructed = errors.New(
+		"ArchiveCompletedOrdersCommand must be created via NewArchiveCompletedOrdersCommand constructor",
+	)
+	ErrArchiveAgeIsInvalid = errors.New("completed orders must be archived after at least one day")
+)
+
+// ArchiveCompletedOrdersCommand represents a request to move the orders completed more than the given
+// number of days ago out of the orders table into the archive, where they can still be looked up.
+//
+// Example:
+//
+//	cmd, err := NewArchiveCompletedOrdersCommand(90)
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	archived, err := handler.Handle(ctx, cmd)
+//	fmt.Printf("Archived %d orders", archived)
+type ArchiveCompletedOrdersCommand struct { //nolint:recvcheck //using for validation
+	olderThanDays int
+
+	guard guard.ConstructorGuard
+}
+
+// NewArchiveCompletedOrdersCommand creates a command to archive the orders completed more than
+// olderThanDays days ago. Returns ErrArchiveAgeIsInvalid unless olderThanDays is positive.
+func NewArchiveCompletedOrdersCommand(olderThanDays int) (ArchiveCompletedOrdersCommand, error) {
+	command := ArchiveCompletedOrdersCommand{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := command.setOlderThanDays(olderThanDays); err != nil {
+		return ArchiveCompletedOrdersCommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrArchiveCompletedOrdersCommandIsNotConstructed if validation fails.
+func (c ArchiveCompletedOrdersCommand) Validate() error {
+	return c.guard.Validate(ErrArchiveCompletedOrdersCommandIsNotConstructed)
+}
+
+// OlderThanDays returns how many days ago the orders to archive must have been completed at least.
+func (c ArchiveCompletedOrdersCommand) OlderThanDays() int {
+	return c.olderThanDays
+}
+
+func (c *ArchiveCompletedOrdersCommand) setOlderThanDays(olderThanDays int) error {
+	if olderThanDays <= 0 {
+		return ErrArchiveAgeIsInvalid
+	}
+
+	c.olderThanDays = olderThanDays
+	return nil
+}
@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/pkg/tracing"
+)
+
+// completedOrderArchiveBatchSize is how many completed orders are archived in one transaction.
+const completedOrderArchiveBatchSize = 500
+
+// ArchiveCompletedOrdersCommandHandler moves long completed orders to the archive, so the orders
+// table only holds the orders in progress and the recent ones. It is run periodically by the
+// order archival job.
+//
+// Example:
+//
+//	handler := NewArchiveCompletedOrdersCommandHandler(uowFactory)
+//	cmd, _ := NewArchiveCompletedOrdersCommand(90)
+//	archived, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    log.Printf("Failed to archive completed orders: %v", err)
+//	}
+type ArchiveCompletedOrdersCommandHandler struct {
+	uowFactory OrderUoWFactory
+}
+
+// NewArchiveCompletedOrdersCommandHandler creates a handler for the archival of completed orders.
+// Requires an OrderUoWFactory for transactional persistence.
+func NewArchiveCompletedOrdersCommandHandler(uowFactory OrderUoWFactory) ArchiveCompletedOrdersCommandHandler {
+	return ArchiveCompletedOrdersCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle archives the orders completed more than cmd.OlderThanDays() days ago and returns how many
+// were archived. Orders are archived in batches of a transaction each, so a long backlog neither
+// holds locks for long nor is lost entirely when a batch fails; the orders archived until then are
+// reported together with the error.
+func (h *ArchiveCompletedOrdersCommandHandler) Handle(ctx context.Context, cmd ArchiveCompletedOrdersCommand) (int, error) {
+	ctx, span := tracing.Start(ctx, "ArchiveCompletedOrdersCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return 0, err
+	}
+
+	cutoff := time.Now().UTC().AddDate(0, 0, -cmd.OlderThanDays())
+	archived := 0
+	for {
+		batch, err := h.archiveBatch(ctx, cutoff)
+		if err != nil {
+			return archived, err
+		}
+
+		archived += batch
+		if batch < completedOrderArchiveBatchSize {
+			return archived, nil
+		}
+	}
+}
+
+// archiveBatch archives one batch of completed orders within one transaction.
+func (h *ArchiveCompletedOrdersCommandHandler) archiveBatch(ctx context.Context, cutoff time.Time) (int, error) {
+	archived := 0
+	uow := h.uowFactory.Create()
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		archived, err = uow.OrderRepository().ArchiveCompleted(ctx, cutoff, completedOrderArchiveBatchSize)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return archived, nil
+}
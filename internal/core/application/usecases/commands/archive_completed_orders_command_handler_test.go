@@ -0,0 +1,75 @@
+package commands_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveCompletedOrdersCommandHandler_Handle_ArchivesInBatches(t *testing.T) {
+	ctx := t.Context()
+	repo := new(MoveOrderRepo)
+	uow := new(MockOrderUoW)
+	factory := new(MockOrderUoWFactory)
+
+	before := time.Now().UTC().AddDate(0, 0, -30)
+	cutoff := mock.MatchedBy(func(cutoff time.Time) bool {
+		return !cutoff.Before(before) && !cutoff.After(time.Now().UTC().AddDate(0, 0, -30))
+	})
+	factory.On("Create").Return(uow).Twice()
+	uow.On("Begin", ctx).Return(nil).Twice()
+	uow.On("OrderRepository").Return(repo).Twice()
+	repo.On("ArchiveCompleted", ctx, cutoff, 500).Return(500, nil).Once()
+	repo.On("ArchiveCompleted", ctx, cutoff, 500).Return(3, nil).Once()
+	uow.On("Commit", ctx).Return(nil).Twice()
+
+	cmd, err := commands.NewArchiveCompletedOrdersCommand(30)
+	require.NoError(t, err)
+
+	handler := commands.NewArchiveCompletedOrdersCommandHandler(factory)
+	archived, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, 503, archived)
+	repo.AssertExpectations(t)
+	uow.AssertExpectations(t)
+}
+
+func TestArchiveCompletedOrdersCommandHandler_Handle_ReportsBatchesArchivedBeforeError(t *testing.T) {
+	ctx := t.Context()
+	repo := new(MoveOrderRepo)
+	uow := new(MockOrderUoW)
+	factory := new(MockOrderUoWFactory)
+	failure := errors.New("db error")
+
+	factory.On("Create").Return(uow).Twice()
+	uow.On("Begin", ctx).Return(nil).Twice()
+	uow.On("OrderRepository").Return(repo).Twice()
+	repo.On("ArchiveCompleted", ctx, mock.Anything, 500).Return(500, nil).Once()
+	repo.On("ArchiveCompleted", ctx, mock.Anything, 500).Return(0, failure).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewArchiveCompletedOrdersCommand(30)
+	require.NoError(t, err)
+
+	handler := commands.NewArchiveCompletedOrdersCommandHandler(factory)
+	archived, err := handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, failure)
+	assert.Equal(t, 500, archived)
+	uow.AssertExpectations(t)
+}
+
+func TestArchiveCompletedOrdersCommandHandler_Handle_NotConstructed(t *testing.T) {
+	handler := commands.NewArchiveCompletedOrdersCommandHandler(new(MockOrderUoWFactory))
+	_, err := handler.Handle(t.Context(), commands.ArchiveCompletedOrdersCommand{})
+
+	require.ErrorIs(t, err, commands.ErrArchiveCompletedOrdersCommandIsNotConstructed)
+}
@@ -0,0 +1,32 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewArchiveCompletedOrdersCommand_ValidInput(t *testing.T) {
+	cmd, err := commands.NewArchiveCompletedOrdersCommand(90)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, 90, cmd.OlderThanDays())
+}
+
+func TestNewArchiveCompletedOrdersCommand_InvalidAge(t *testing.T) {
+	for _, days := range []int{0, -1} {
+		_, err := commands.NewArchiveCompletedOrdersCommand(days)
+
+		require.ErrorIs(t, err, commands.ErrArchiveAgeIsInvalid)
+	}
+}
+
+func TestArchiveCompletedOrdersCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.ArchiveCompletedOrdersCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrArchiveCompletedOrdersCommandIsNotConstructed)
+}
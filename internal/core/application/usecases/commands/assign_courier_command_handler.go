@@ -1,15 +1,48 @@
 package commands
 
 import (
+	"cmp"
 	"context"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/device"
+	"delivery/internal/core/domain/model/escalation"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/matching"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/pickup"
+	"delivery/internal/core/domain/model/surge"
 	"delivery/internal/core/domain/services"
+	"delivery/internal/core/ports"
 	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tracing"
 	"errors"
+	"strconv"
+	"time"
+)
+
+const (
+	// workingHoursAnnotation records the working hours status of the assigned courier
+	// when a working hours limit is enforced.
+	workingHoursAnnotation = "working_hours.status"
+
+	// pickupSlotAnnotation records when the pickup slot booked for the order starts
+	// when pickup slots are required.
+	pickupSlotAnnotation = "pickup_slot.starts_at"
+
+	// surgeAnnotation records that the order was dispatched while the surge mode was active.
+	surgeAnnotation = "surge.active"
+
+	// escalationAnnotation records the escalation tier an urgent order had reached when it was dispatched.
+	escalationAnnotation = "escalation.tier"
 )
 
 var (
 	ErrNoFreeCouriersFound = errors.New("no free couriers found")
 	ErrNoOrderFound        = errors.New("no order found")
+
+	// ErrNoPickupSlotAvailable is returned when pickup slots are required and every slot
+	// at the warehouse is full or over; dispatch is deferred until a slot frees up or is added.
+	ErrNoPickupSlotAvailable = errors.New("no pickup slot available")
 )
 
 // AssignCourierCommandHandler orchestrates the courier assignment process.
@@ -32,70 +65,565 @@ var (
 //	    log.Println("Courier assigned successfully")
 //	}
 type AssignCourierCommandHandler struct {
-	uowFactory UoWFactory
+	uowFactory     UoWFactory
+	postProcessors []DispatchPostProcessor
+	experiment     *DispatchExperiment
+	workingHours   *courier.WorkingHoursLimit
+	pickupSlots    bool
+
+	// dispatcher ranks the couriers of orders outside the experiment; the zero value assigns the fastest courier
+	dispatcher services.OrderDispatcher
+
+	// shiftEndDrain stops dispatching to couriers whose working hours limit is approaching
+	shiftEndDrain bool
+
+	// maintenanceWarning is how long before a vehicle maintenance window the courier stops receiving orders
+	maintenanceWarning time.Duration
+
+	// degradation switches to greedy dispatch under load; nil always dispatches in full mode
+	degradation *DispatchDegradation
+
+	// deviceTelemetry and devicePolicy keep couriers with a degraded device from receiving orders
+	deviceTelemetry ports.DeviceTelemetryRepository
+	devicePolicy    device.HealthPolicy
+
+	// surges and surgePolicy relax the shift end drain and the maintenance warning while the surge mode is active
+	surges      ports.SurgeRepository
+	surgePolicy surge.Policy
+
+	// batchSize is the most orders a pass dispatches; passes of more than one order
+	// also consider couriers who carry orders but have an empty storage place
+	batchSize int
+
+	// matchingRules keeps couriers without the vehicle or storage an order requires from receiving it
+	matchingRules ports.MatchingRuleRepository
+
+	// escalations and escalationPolicy relax the constraints of escalated urgent orders
+	escalations      ports.OrderEscalationRepository
+	escalationPolicy escalation.Policy
 }
 
 // NewAssignCourierCommandHandler creates a handler for courier assignment operations.
 // Requires a UoWFactory for coordinating transactional updates across repositories.
-func NewAssignCourierCommandHandler(uowFactory UoWFactory) AssignCourierCommandHandler {
+// Optional post-processors can veto or annotate each assignment before it is persisted.
+func NewAssignCourierCommandHandler(
+	uowFactory UoWFactory,
+	postProcessors ...DispatchPostProcessor,
+) AssignCourierCommandHandler {
 	return AssignCourierCommandHandler{
-		uowFactory: uowFactory,
+		uowFactory:     uowFactory,
+		postProcessors: postProcessors,
 	}
 }
 
+// NewAssignCourierCommandHandlerWithExperiment creates a handler like NewAssignCourierCommandHandler
+// that dispatches the orders enabled by the experiment's flag with its candidate strategy.
+func NewAssignCourierCommandHandlerWithExperiment(
+	uowFactory UoWFactory,
+	experiment DispatchExperiment,
+	postProcessors ...DispatchPostProcessor,
+) AssignCourierCommandHandler {
+	handler := NewAssignCourierCommandHandler(uowFactory, postProcessors...)
+	handler.experiment = &experiment
+	return handler
+}
+
+// WithWorkingHoursLimit returns a copy of the handler that skips couriers who reached
+// the daily working hours limit and annotates the assignment with the courier's status.
+func (h AssignCourierCommandHandler) WithWorkingHoursLimit(limit courier.WorkingHoursLimit) AssignCourierCommandHandler {
+	h.workingHours = &limit
+	return h
+}
+
+// WithShiftEndDrain returns a copy of the handler that drains couriers near the end of their shift:
+// once the working hours limit is approaching they receive no new orders and only finish the ones
+// they carry. It has no effect without a working hours limit.
+func (h AssignCourierCommandHandler) WithShiftEndDrain() AssignCourierCommandHandler {
+	h.shiftEndDrain = true
+	return h
+}
+
+// WithMaintenanceWarning returns a copy of the handler that also skips couriers whose vehicle
+// maintenance starts within warnBefore, so that no order is assigned across a maintenance window.
+func (h AssignCourierCommandHandler) WithMaintenanceWarning(warnBefore time.Duration) AssignCourierCommandHandler {
+	h.maintenanceWarning = warnBefore
+	return h
+}
+
+// WithDispatchStrategy returns a copy of the handler that ranks couriers with strategy instead of
+// by travel time alone. The strategy is the baseline of the dispatch experiment as well.
+func (h AssignCourierCommandHandler) WithDispatchStrategy(strategy services.DispatchStrategy) AssignCourierCommandHandler {
+	h.dispatcher = services.NewOrderDispatcherWithStrategy(strategy)
+	return h
+}
+
+// WithDegradation returns a copy of the handler that dispatches in the mode of degradation:
+// in greedy mode the nearest courier is assigned and the dispatch experiment is skipped.
+func (h AssignCourierCommandHandler) WithDegradation(degradation *DispatchDegradation) AssignCourierCommandHandler {
+	h.degradation = degradation
+	return h
+}
+
+// WithDeviceHealth returns a copy of the handler that skips couriers whose device has a low battery
+// or poor connectivity under the policy. Couriers whose app reports no telemetry are still considered.
+func (h AssignCourierCommandHandler) WithDeviceHealth(
+	telemetry ports.DeviceTelemetryRepository,
+	policy device.HealthPolicy,
+) AssignCourierCommandHandler {
+	h.deviceTelemetry = telemetry
+	h.devicePolicy = policy
+	return h
+}
+
+// WithSurge returns a copy of the handler that relaxes dispatch while the surge mode is active:
+// couriers near the end of their shift are not drained, and couriers stop receiving orders only
+// within the policy's maintenance warning if it is shorter than the regular one. The working hours
+// limit, maintenance windows and device health still apply.
+func (h AssignCourierCommandHandler) WithSurge(surges ports.SurgeRepository, policy surge.Policy) AssignCourierCommandHandler {
+	h.surges = surges
+	h.surgePolicy = policy
+	return h
+}
+
+// WithMatchingRules returns a copy of the handler that only considers the couriers admitted by
+// the matching rules that apply to the order, see matching.Admits. Rules are read on every
+// assignment, so changes apply without a restart.
+func (h AssignCourierCommandHandler) WithMatchingRules(rules ports.MatchingRuleRepository) AssignCourierCommandHandler {
+	h.matchingRules = rules
+	return h
+}
+
+// WithEscalation returns a copy of the handler that lifts the relaxations of the escalation tier
+// an order reached: past a tier waiving the matching rules, any courier may take the order.
+// The tier is recorded as the "escalation.tier" annotation.
+func (h AssignCourierCommandHandler) WithEscalation(
+	escalations ports.OrderEscalationRepository,
+	policy escalation.Policy,
+) AssignCourierCommandHandler {
+	h.escalations = escalations
+	h.escalationPolicy = policy
+	return h
+}
+
+// WithPickupSlots returns a copy of the handler that books a warehouse pickup slot for every
+// assigned order. An order keeps the slot it was booked into when it is assigned again.
+func (h AssignCourierCommandHandler) WithPickupSlots() AssignCourierCommandHandler {
+	h.pickupSlots = true
+	return h
+}
+
+// WithBatchDispatch returns a copy of the handler that dispatches up to size pending orders
+// in one pass. Couriers who carry orders stay candidates while one of their storage places
+// in service is empty, so a courier may take several orders of a pass, one per storage place.
+// Orders no courier can take are left for a later pass instead of failing it.
+// A size of 1 keeps dispatching one order per pass to couriers without orders.
+func (h AssignCourierCommandHandler) WithBatchDispatch(size int) AssignCourierCommandHandler {
+	h.batchSize = size
+	return h
+}
+
 // Handle processes the courier assignment command.
-// Retrieves the first pending order, finds available couriers, and uses OrderDispatcher
-// to select the best match. Updates both entities within a single transaction.
+// Retrieves the next pending order, finds available couriers, and uses OrderDispatcher
+// to select the best match. Urgent orders are dispatched first: pending orders are taken by
+// priority, then oldest first, so an urgent order is not left waiting behind a backlog of normal ones. Updates both entities within a single transaction.
+// The strategy that selected the courier is recorded as the "dispatch.strategy" annotation;
+// while the degradation is in greedy mode it is "greedy-nearest".
+// Couriers whose vehicle is in maintenance, or goes into maintenance within the maintenance warning,
+// are not considered, nor are couriers with a schedule outside of its windows.
+// With a working hours limit, couriers who reached it are not considered and the status of the
+// assigned courier is recorded as the "working_hours.status" annotation. With shift end drain,
+// couriers approaching the limit are not considered either. With device health, couriers whose
+// device has a low battery or poor connectivity are not considered. With matching rules, couriers
+// lacking the vehicle type or thermal storage a rule requires for the order are not considered,
+// unless, with escalation, the order reached a tier waiving the matching rules.
+// With pickup slots, the order is booked into the earliest slot with free capacity and the
+// slot start is recorded as the "pickup_slot.starts_at" annotation; when every slot is full
+// or over, dispatch is deferred with ErrNoPickupSlotAvailable.
+// With surge, an assignment made while the surge mode is active is relaxed and recorded with
+// the "surge.active" annotation.
+// Registered post-processors run before persisting; a veto (ErrAssignmentIsVetoed) rolls back the assignment.
+// With batch dispatch, several orders are assigned in one transaction and a veto rolls back all of them;
+// the pass fails with the reason of the first order left waiting only if no order was assigned.
 // Returns specific errors for no orders (ErrNoOrderFound) or no couriers (ErrNoFreeCouriersFound).
+// An assignment that loses a concurrency conflict to another job is dispatched again.
 func (h AssignCourierCommandHandler) Handle(ctx context.Context, command AssignCourierCommand) error {
+	ctx, span := tracing.Start(ctx, "AssignCourierCommand")
+	defer span.End()
+
 	if err := command.Validate(); err != nil {
 		return err
 	}
 
+	return retryOnConflict(ctx, h.assign)
+}
+
+// assign dispatches the next pending orders within one transaction.
+func (h AssignCourierCommandHandler) assign(ctx context.Context) error {
+	var assignments []*DispatchAssignment
 	uow := h.uowFactory.Create()
-	if err := uow.Begin(ctx); err != nil {
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		ordersRepo := uow.OrderRepository()
+
+		orders, err := h.pendingOrders(ctx, ordersRepo)
+		if err != nil {
+			return err
+		}
+
+		couriers, err := h.candidateCouriers(ctx, courierRepo)
+		if err != nil {
+			return err
+		}
+
+		surging := false
+		if h.surges != nil {
+			mode, modeErr := h.surges.GetMode(ctx)
+			if modeErr != nil {
+				return modeErr
+			}
+			if surging = mode.Active(); surging {
+				h = h.duringSurge()
+			}
+		}
+
+		now := time.Now()
+		couriers = h.couriersOutOfMaintenance(couriers, now)
+		couriers = couriersWithinSchedule(couriers, now)
+		if h.workingHours != nil {
+			couriers = h.couriersWithinWorkingHours(couriers, now)
+		}
+		if h.deviceTelemetry != nil {
+			if couriers, err = h.couriersWithHealthyDevices(ctx, couriers, now); err != nil {
+				return err
+			}
+		}
+
+		// The first reason an order was left waiting fails the pass if no order was assigned
+		var waiting error
+		for _, order := range orders {
+			assignment, dispatchErr := h.dispatch(ctx, uow, order, couriers, now)
+			if errors.Is(dispatchErr, ErrNoFreeCouriersFound) || errors.Is(dispatchErr, services.ErrCourierNotFound) ||
+				errors.Is(dispatchErr, ErrNoPickupSlotAvailable) {
+				waiting = cmp.Or(waiting, dispatchErr)
+				if errors.Is(dispatchErr, ErrNoPickupSlotAvailable) {
+					// Later orders would find every slot full as well
+					break
+				}
+				continue
+			}
+			if dispatchErr != nil {
+				return dispatchErr
+			}
+
+			if surging {
+				assignment.Annotate(surgeAnnotation, "true")
+			}
+			for _, processor := range h.postProcessors {
+				if err = processor.ProcessAssignment(ctx, assignment); err != nil {
+					return err
+				}
+			}
+
+			if err = ordersRepo.Update(ctx, order); err != nil {
+				return err
+			}
+			assignments = append(assignments, assignment)
+		}
+		if len(assignments) == 0 {
+			return waiting
+		}
+
+		// A courier taking several orders of the pass is saved once, with all of them
+		updated := make(map[*courier.Courier]bool, len(assignments))
+		for _, assignment := range assignments {
+			if updated[assignment.Courier] {
+				continue
+			}
+			updated[assignment.Courier] = true
+			if err = courierRepo.Update(ctx, assignment.Courier); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
 		return err
 	}
 
-	defer func() {
-		_ = uow.Rollback(ctx)
-	}()
+	for _, assignment := range assignments {
+		for _, processor := range h.postProcessors {
+			if listener, ok := processor.(DispatchCommitListener); ok {
+				listener.AssignmentCommitted(ctx, *assignment)
+			}
+		}
+	}
 
-	courierRepo := uow.CourierRepository()
-	ordersRepo := uow.OrderRepository()
+	return nil
+}
 
-	order, err := ordersRepo.GetFirstInCreatedStatus(ctx)
-	if errors.Is(err, errs.ErrObjectNotFound) {
-		return ErrNoOrderFound
+// pendingOrders returns the orders to dispatch in this pass, most urgent first.
+func (h AssignCourierCommandHandler) pendingOrders(ctx context.Context, orders ports.OrderRepository) ([]*order.Order, error) {
+	if h.batchSize <= 1 {
+		next, err := orders.GetNextDispatchable(ctx)
+		if errors.Is(err, errs.ErrObjectNotFound) {
+			return nil, ErrNoOrderFound
+		}
+		if err != nil {
+			return nil, err
+		}
+		return []*order.Order{next}, nil
 	}
+
+	pending, err := orders.GetDispatchable(ctx, h.batchSize)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if len(pending) == 0 {
+		return nil, ErrNoOrderFound
+	}
+	return pending, nil
+}
+
+// candidateCouriers returns the couriers able to take an order in this pass, before the filters
+// of the handler apply: the couriers without orders, or those with an empty storage place
+// when passes dispatch several orders.
+func (h AssignCourierCommandHandler) candidateCouriers(
+	ctx context.Context,
+	couriers ports.CourierRepository,
+) ([]*courier.Courier, error) {
+	if h.batchSize <= 1 {
+		return couriers.GetAllFree(ctx)
 	}
+	return couriers.GetAllWithFreeStorage(ctx)
+}
 
-	couriers, err := courierRepo.GetAllFree(ctx)
+// dispatch assigns the order to one of the couriers and books its pickup slot. The assignment is
+// annotated, but neither processed nor saved. Returns ErrNoFreeCouriersFound when the filters
+// leave no courier for the order and services.ErrCourierNotFound when none has room for it.
+func (h AssignCourierCommandHandler) dispatch(
+	ctx context.Context,
+	uow UoW,
+	order *order.Order,
+	couriers []*courier.Courier,
+	now time.Time,
+) (*DispatchAssignment, error) {
+	tier, err := h.escalationTier(ctx, order)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if h.matchingRules != nil && !h.escalationPolicy.Relaxes(tier, escalation.WaiveMatchingRules) {
+		if couriers, err = h.couriersMatchingRules(ctx, order, couriers); err != nil {
+			return nil, err
+		}
 	}
 	if len(couriers) == 0 {
-		return ErrNoFreeCouriersFound
+		return nil, ErrNoFreeCouriersFound
+	}
+
+	var slot *pickup.Slot
+	if h.pickupSlots {
+		if slot, err = h.pickupSlot(ctx, uow, order, now); err != nil {
+			return nil, err
+		}
+	}
+
+	dispatcher := h.dispatcher
+	greedy, degraded := h.degradation.dispatcher()
+	switch {
+	case degraded:
+		dispatcher = greedy
+	case h.experiment != nil:
+		dispatcher = h.experiment.dispatcher(ctx, h.dispatcher, order, couriers)
 	}
 
-	assignedCourier, err := services.NewOrderDispatcher().Dispatch(order, couriers)
+	assignedCourier, explanation, err := dispatcher.DispatchExplained(order, couriers)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if err = ordersRepo.Update(ctx, order); err != nil {
-		return err
+	assignment := &DispatchAssignment{Order: order, Courier: assignedCourier, Explanation: explanation}
+	assignment.Annotate(dispatchStrategyAnnotation, dispatcher.Strategy().Name())
+	if h.workingHours != nil {
+		status := h.workingHours.Assess(assignedCourier.WorkLog().WorkedOn(now))
+		assignment.Annotate(workingHoursAnnotation, status.String())
+	}
+	if tier > 0 {
+		assignment.Annotate(escalationAnnotation, strconv.Itoa(tier))
+	}
+	if slot != nil {
+		assignment.Annotate(pickupSlotAnnotation, slot.StartsAt().UTC().Format(time.RFC3339))
+		// Saved right away, so the next order of the pass sees the booking
+		if err = uow.PickupSlotRepository().Update(ctx, slot); err != nil {
+			return nil, err
+		}
 	}
 
-	if err = courierRepo.Update(ctx, assignedCourier); err != nil {
-		return err
+	return assignment, nil
+}
+
+// duringSurge returns a copy of the handler with the limits relaxed for the surge mode.
+func (h AssignCourierCommandHandler) duringSurge() AssignCourierCommandHandler {
+	h.shiftEndDrain = false
+	h.maintenanceWarning = min(h.maintenanceWarning, h.surgePolicy.MaintenanceWarning())
+	return h
+}
+
+// couriersWithinWorkingHours drops the couriers who may not receive orders at now:
+// those who reached the limit and, when draining, those approaching it.
+func (h AssignCourierCommandHandler) couriersWithinWorkingHours(
+	couriers []*courier.Courier,
+	now time.Time,
+) []*courier.Courier {
+	allowed := make([]*courier.Courier, 0, len(couriers))
+	for _, c := range couriers {
+		status := h.workingHours.Assess(c.WorkLog().WorkedOn(now))
+		if status == courier.LimitReached || (h.shiftEndDrain && status == courier.ApproachingLimit) {
+			continue
+		}
+		allowed = append(allowed, c)
 	}
+	return allowed
+}
 
-	if err = uow.Commit(ctx); err != nil {
-		return err
+// couriersOutOfMaintenance drops the couriers whose vehicle is in maintenance at now
+// or goes into maintenance within the maintenance warning.
+func (h AssignCourierCommandHandler) couriersOutOfMaintenance(
+	couriers []*courier.Courier,
+	now time.Time,
+) []*courier.Courier {
+	allowed := make([]*courier.Courier, 0, len(couriers))
+	for _, c := range couriers {
+		if c.MaintenanceStatus(now, h.maintenanceWarning) == courier.MaintenanceScheduled {
+			allowed = append(allowed, c)
+		}
 	}
+	return allowed
+}
 
-	return nil
+// couriersWithinSchedule drops the couriers whose schedule has no window at now.
+func couriersWithinSchedule(couriers []*courier.Courier, now time.Time) []*courier.Courier {
+	allowed := make([]*courier.Courier, 0, len(couriers))
+	for _, c := range couriers {
+		if c.IsWithinSchedule(now) {
+			allowed = append(allowed, c)
+		}
+	}
+	return allowed
+}
+
+// couriersWithHealthyDevices drops the couriers whose device is degraded at now.
+func (h AssignCourierCommandHandler) couriersWithHealthyDevices(
+	ctx context.Context,
+	couriers []*courier.Courier,
+	now time.Time,
+) ([]*courier.Courier, error) {
+	if len(couriers) == 0 {
+		return couriers, nil
+	}
+
+	ids := make([]kernel.UUID, 0, len(couriers))
+	for _, c := range couriers {
+		ids = append(ids, c.ID())
+	}
+	readings, err := h.deviceTelemetry.GetSince(ctx, h.devicePolicy.WindowStart(now), ids...)
+	if err != nil {
+		return nil, err
+	}
+
+	byCourier := make(map[kernel.UUID][]device.Reading, len(couriers))
+	for _, reading := range readings {
+		byCourier[reading.CourierID()] = append(byCourier[reading.CourierID()], reading)
+	}
+
+	allowed := make([]*courier.Courier, 0, len(couriers))
+	for _, c := range couriers {
+		if !h.devicePolicy.Assess(byCourier[c.ID()], now).IsDegraded() {
+			allowed = append(allowed, c)
+		}
+	}
+	return allowed, nil
+}
+
+// couriersMatchingRules drops the couriers the matching rules do not admit for the order.
+func (h AssignCourierCommandHandler) couriersMatchingRules(
+	ctx context.Context,
+	o *order.Order,
+	couriers []*courier.Courier,
+) ([]*courier.Courier, error) {
+	if len(couriers) == 0 {
+		return couriers, nil
+	}
+
+	rules, err := h.matchingRules.GetRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := make([]*courier.Courier, 0, len(couriers))
+	for _, c := range couriers {
+		admitted, admitErr := matching.Admits(rules, o, c)
+		if admitErr != nil {
+			return nil, admitErr
+		}
+		if admitted {
+			allowed = append(allowed, c)
+		}
+	}
+	return allowed, nil
+}
+
+// escalationTier returns the escalation tier the order reached, 0 if it has not escalated
+// or escalation is off.
+func (h AssignCourierCommandHandler) escalationTier(ctx context.Context, o *order.Order) (int, error) {
+	if h.escalations == nil {
+		return 0, nil
+	}
+
+	escalated, err := h.escalations.Get(ctx, o.ID())
+	if errors.Is(err, errs.ErrObjectNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return escalated.Tier(), nil
+}
+
+// pickupSlot returns the slot the order is booked into, booking it into the earliest
+// available slot first if it has none yet.
+func (h AssignCourierCommandHandler) pickupSlot(
+	ctx context.Context,
+	uow UoW,
+	o *order.Order,
+	now time.Time,
+) (*pickup.Slot, error) {
+	slots := uow.PickupSlotRepository()
+
+	slot, err := slots.GetByOrder(ctx, o.ID())
+	if err == nil {
+		return slot, nil
+	}
+	if !errors.Is(err, errs.ErrObjectNotFound) {
+		return nil, err
+	}
+
+	slot, err = slots.GetFirstAvailable(ctx, now)
+	if errors.Is(err, errs.ErrObjectNotFound) {
+		return nil, ErrNoPickupSlotAvailable
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The slot may have been filled by a concurrent dispatch while its row was locked
+	err = slot.Book(o.ID(), now)
+	if errors.Is(err, pickup.ErrSlotIsFull) || errors.Is(err, pickup.ErrSlotHasEnded) {
+		return nil, ErrNoPickupSlotAvailable
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return slot, nil
 }
@@ -4,14 +4,21 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"delivery/internal/core/application/usecases/commands"
 	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/device"
+	"delivery/internal/core/domain/model/escalation"
 	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/matching"
 	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/pickup"
+	"delivery/internal/core/domain/model/surge"
 	"delivery/internal/core/domain/services"
 	"delivery/internal/core/ports"
 	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/rollout"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -38,6 +45,25 @@ func (m *MockAssignCourierRepository) Get(ctx context.Context, id kernel.UUID) (
 	return args.Get(0).(*courier.Courier), args.Error(1)
 }
 
+func (m *MockAssignCourierRepository) GetByExternalID(
+	ctx context.Context,
+	externalID courier.ExternalID,
+) (*courier.Courier, error) {
+	args := m.Called(ctx, externalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*courier.Courier), args.Error(1)
+}
+
+func (m *MockAssignCourierRepository) GetByIDs(ctx context.Context, ids []kernel.UUID) ([]*courier.Courier, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*courier.Courier), args.Error(1)
+}
+
 func (m *MockAssignCourierRepository) GetAllFree(ctx context.Context) ([]*courier.Courier, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
@@ -46,6 +72,41 @@ func (m *MockAssignCourierRepository) GetAllFree(ctx context.Context) ([]*courie
 	return args.Get(0).([]*courier.Courier), args.Error(1)
 }
 
+func (m *MockAssignCourierRepository) GetAllWithFreeStorage(ctx context.Context) ([]*courier.Courier, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*courier.Courier), args.Error(1)
+}
+
+func (m *MockAssignCourierRepository) GetAllOnShift(ctx context.Context) ([]*courier.Courier, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*courier.Courier), args.Error(1)
+}
+
+func (m *MockAssignCourierRepository) GetAllOffShift(ctx context.Context) ([]*courier.Courier, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*courier.Courier), args.Error(1)
+}
+
+func (m *MockAssignCourierRepository) GetAllSubstitutedBy(
+	ctx context.Context,
+	substituteID kernel.UUID,
+) ([]*courier.Courier, error) {
+	args := m.Called(ctx, substituteID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*courier.Courier), args.Error(1)
+}
+
 type MockAssignOrderRepository struct{ mock.Mock }
 
 func (m *MockAssignOrderRepository) Add(ctx context.Context, o *order.Order) error {
@@ -66,7 +127,23 @@ func (m *MockAssignOrderRepository) Get(ctx context.Context, id kernel.UUID) (*o
 	return args.Get(0).(*order.Order), args.Error(1)
 }
 
-func (m *MockAssignOrderRepository) GetFirstInCreatedStatus(ctx context.Context) (*order.Order, error) {
+func (m *MockAssignOrderRepository) GetByTrackingToken(ctx context.Context, token order.TrackingToken) (*order.Order, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
+func (m *MockAssignOrderRepository) GetByBasketID(ctx context.Context, basketID kernel.UUID) (*order.Order, error) {
+	args := m.Called(ctx, basketID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
+func (m *MockAssignOrderRepository) GetNextDispatchable(ctx context.Context) (*order.Order, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -74,6 +151,30 @@ func (m *MockAssignOrderRepository) GetFirstInCreatedStatus(ctx context.Context)
 	return args.Get(0).(*order.Order), args.Error(1)
 }
 
+func (m *MockAssignOrderRepository) GetDispatchable(ctx context.Context, limit int) ([]*order.Order, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.Order), args.Error(1)
+}
+
+func (m *MockAssignOrderRepository) GetIDsMatching(ctx context.Context, filter ports.OrderFilter, limit int) ([]kernel.UUID, error) {
+	args := m.Called(ctx, filter, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]kernel.UUID), args.Error(1)
+}
+
+func (m *MockAssignOrderRepository) GetAllAwaitingBatch(ctx context.Context) ([]*order.Order, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.Order), args.Error(1)
+}
+
 func (m *MockAssignOrderRepository) GetAllInAssignedStatus(ctx context.Context) ([]*order.Order, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
@@ -82,6 +183,27 @@ func (m *MockAssignOrderRepository) GetAllInAssignedStatus(ctx context.Context)
 	return args.Get(0).([]*order.Order), args.Error(1)
 }
 
+func (m *MockAssignOrderRepository) ArchiveCompleted(ctx context.Context, completedBefore time.Time, limit int) (int, error) {
+	args := m.Called(ctx, completedBefore, limit)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockAssignOrderRepository) GetArchived(ctx context.Context, id kernel.UUID) (*order.Order, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
+func (m *MockAssignOrderRepository) GetAllArchivedCompletedBetween(ctx context.Context, from, to time.Time) ([]*order.Order, error) {
+	args := m.Called(ctx, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.Order), args.Error(1)
+}
+
 type MockAssignUoW struct{ mock.Mock }
 
 func (m *MockAssignUoW) Begin(ctx context.Context) error {
@@ -89,6 +211,10 @@ func (m *MockAssignUoW) Begin(ctx context.Context) error {
 	return args.Error(0)
 }
 
+func (m *MockAssignUoW) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return doInTransaction(ctx, m, fn)
+}
+
 func (m *MockAssignUoW) Commit(ctx context.Context) error {
 	args := m.Called(ctx)
 	return args.Error(0)
@@ -109,6 +235,52 @@ func (m *MockAssignUoW) CourierRepository() ports.CourierRepository {
 	return args.Get(0).(ports.CourierRepository)
 }
 
+func (m *MockAssignUoW) PickupSlotRepository() ports.PickupSlotRepository {
+	args := m.Called()
+	return args.Get(0).(ports.PickupSlotRepository)
+}
+
+type MockPickupSlotRepository struct{ mock.Mock }
+
+func (m *MockPickupSlotRepository) Add(ctx context.Context, slot *pickup.Slot) error {
+	args := m.Called(ctx, slot)
+	return args.Error(0)
+}
+
+func (m *MockPickupSlotRepository) Update(ctx context.Context, slot *pickup.Slot) error {
+	args := m.Called(ctx, slot)
+	return args.Error(0)
+}
+
+func (m *MockPickupSlotRepository) Get(ctx context.Context, id kernel.UUID) (*pickup.Slot, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pickup.Slot), args.Error(1)
+}
+
+func (m *MockPickupSlotRepository) GetFirstAvailable(ctx context.Context, now time.Time) (*pickup.Slot, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pickup.Slot), args.Error(1)
+}
+
+func (m *MockPickupSlotRepository) GetByOrder(ctx context.Context, orderID kernel.UUID) (*pickup.Slot, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pickup.Slot), args.Error(1)
+}
+
+func (m *MockPickupSlotRepository) GetNotStarted(ctx context.Context, now time.Time) ([]*pickup.Slot, error) {
+	args := m.Called(ctx, now)
+	return args.Get(0).([]*pickup.Slot), args.Error(1)
+}
+
 type MockAssignUoWFactory struct{ mock.Mock }
 
 func (m *MockAssignUoWFactory) Create() commands.UoW {
@@ -138,12 +310,11 @@ func TestAssignCourierCommandHandler_Handle_Success(t *testing.T) {
 		uow.On("Begin", ctx).Return(nil).Once(),
 		uow.On("CourierRepository").Return(courierRepo).Once(),
 		uow.On("OrderRepository").Return(orderRepo).Once(),
-		orderRepo.On("GetFirstInCreatedStatus", ctx).Return(testOrder, nil).Once(),
+		orderRepo.On("GetNextDispatchable", ctx).Return(testOrder, nil).Once(),
 		courierRepo.On("GetAllFree", ctx).Return(testCouriers, nil).Once(),
 		orderRepo.On("Update", ctx, mock.AnythingOfType("*order.Order")).Return(nil).Once(),
 		courierRepo.On("Update", ctx, mock.AnythingOfType("*courier.Courier")).Return(nil).Once(),
 		uow.On("Commit", ctx).Return(nil).Once(),
-		uow.On("Rollback", ctx).Return(nil).Once(),
 	)
 
 	factory := new(MockAssignUoWFactory)
@@ -203,7 +374,7 @@ func TestAssignCourierCommandHandler_Handle_NoOrderFound(t *testing.T) {
 		uow.On("Begin", ctx).Return(nil).Once(),
 		uow.On("CourierRepository").Return(courierRepo).Once(),
 		uow.On("OrderRepository").Return(orderRepo).Once(),
-		orderRepo.On("GetFirstInCreatedStatus", ctx).Return(nil, errs.ErrObjectNotFound).Once(),
+		orderRepo.On("GetNextDispatchable", ctx).Return(nil, errs.ErrObjectNotFound).Once(),
 		uow.On("Rollback", ctx).Return(nil).Once(),
 	)
 
@@ -229,7 +400,7 @@ func TestAssignCourierCommandHandler_Handle_GetOrderError(t *testing.T) {
 		uow.On("Begin", ctx).Return(nil).Once(),
 		uow.On("CourierRepository").Return(courierRepo).Once(),
 		uow.On("OrderRepository").Return(orderRepo).Once(),
-		orderRepo.On("GetFirstInCreatedStatus", ctx).Return(nil, errors.New("database error")).Once(),
+		orderRepo.On("GetNextDispatchable", ctx).Return(nil, errors.New("database error")).Once(),
 		uow.On("Rollback", ctx).Return(nil).Once(),
 	)
 
@@ -259,7 +430,7 @@ func TestAssignCourierCommandHandler_Handle_NoFreeCouriers(t *testing.T) {
 		uow.On("Begin", ctx).Return(nil).Once(),
 		uow.On("CourierRepository").Return(courierRepo).Once(),
 		uow.On("OrderRepository").Return(orderRepo).Once(),
-		orderRepo.On("GetFirstInCreatedStatus", ctx).Return(testOrder, nil).Once(),
+		orderRepo.On("GetNextDispatchable", ctx).Return(testOrder, nil).Once(),
 		courierRepo.On("GetAllFree", ctx).Return([]*courier.Courier{}, nil).Once(),
 		uow.On("Rollback", ctx).Return(nil).Once(),
 	)
@@ -290,7 +461,7 @@ func TestAssignCourierCommandHandler_Handle_GetCouriersError(t *testing.T) {
 		uow.On("Begin", ctx).Return(nil).Once(),
 		uow.On("CourierRepository").Return(courierRepo).Once(),
 		uow.On("OrderRepository").Return(orderRepo).Once(),
-		orderRepo.On("GetFirstInCreatedStatus", ctx).Return(testOrder, nil).Once(),
+		orderRepo.On("GetNextDispatchable", ctx).Return(testOrder, nil).Once(),
 		courierRepo.On("GetAllFree", ctx).Return(nil, errors.New("database error")).Once(),
 		uow.On("Rollback", ctx).Return(nil).Once(),
 	)
@@ -325,7 +496,7 @@ func TestAssignCourierCommandHandler_Handle_DispatchError(t *testing.T) {
 		uow.On("Begin", ctx).Return(nil).Once(),
 		uow.On("CourierRepository").Return(courierRepo).Once(),
 		uow.On("OrderRepository").Return(orderRepo).Once(),
-		orderRepo.On("GetFirstInCreatedStatus", ctx).Return(testOrder, nil).Once(),
+		orderRepo.On("GetNextDispatchable", ctx).Return(testOrder, nil).Once(),
 		courierRepo.On("GetAllFree", ctx).Return(testCouriers, nil).Once(),
 		uow.On("Rollback", ctx).Return(nil).Once(),
 	)
@@ -360,7 +531,7 @@ func TestAssignCourierCommandHandler_Handle_UpdateOrderError(t *testing.T) {
 		uow.On("Begin", ctx).Return(nil).Once(),
 		uow.On("CourierRepository").Return(courierRepo).Once(),
 		uow.On("OrderRepository").Return(orderRepo).Once(),
-		orderRepo.On("GetFirstInCreatedStatus", ctx).Return(testOrder, nil).Once(),
+		orderRepo.On("GetNextDispatchable", ctx).Return(testOrder, nil).Once(),
 		courierRepo.On("GetAllFree", ctx).Return(testCouriers, nil).Once(),
 		orderRepo.On("Update", ctx, mock.AnythingOfType("*order.Order")).Return(errors.New("update error")).Once(),
 		uow.On("Rollback", ctx).Return(nil).Once(),
@@ -396,7 +567,7 @@ func TestAssignCourierCommandHandler_Handle_UpdateCourierError(t *testing.T) {
 		uow.On("Begin", ctx).Return(nil).Once(),
 		uow.On("CourierRepository").Return(courierRepo).Once(),
 		uow.On("OrderRepository").Return(orderRepo).Once(),
-		orderRepo.On("GetFirstInCreatedStatus", ctx).Return(testOrder, nil).Once(),
+		orderRepo.On("GetNextDispatchable", ctx).Return(testOrder, nil).Once(),
 		courierRepo.On("GetAllFree", ctx).Return(testCouriers, nil).Once(),
 		orderRepo.On("Update", ctx, mock.AnythingOfType("*order.Order")).Return(nil).Once(),
 		courierRepo.On("Update", ctx, mock.AnythingOfType("*courier.Courier")).
@@ -435,7 +606,7 @@ func TestAssignCourierCommandHandler_Handle_CommitError(t *testing.T) {
 		uow.On("Begin", ctx).Return(nil).Once(),
 		uow.On("CourierRepository").Return(courierRepo).Once(),
 		uow.On("OrderRepository").Return(orderRepo).Once(),
-		orderRepo.On("GetFirstInCreatedStatus", ctx).Return(testOrder, nil).Once(),
+		orderRepo.On("GetNextDispatchable", ctx).Return(testOrder, nil).Once(),
 		courierRepo.On("GetAllFree", ctx).Return(testCouriers, nil).Once(),
 		orderRepo.On("Update", ctx, mock.AnythingOfType("*order.Order")).Return(nil).Once(),
 		courierRepo.On("Update", ctx, mock.AnythingOfType("*courier.Courier")).Return(nil).Once(),
@@ -489,12 +660,11 @@ func TestAssignCourierCommandHandler_Handle_MultipleCouriers(t *testing.T) {
 		uow.On("Begin", ctx).Return(nil).Once(),
 		uow.On("CourierRepository").Return(courierRepo).Once(),
 		uow.On("OrderRepository").Return(orderRepo).Once(),
-		orderRepo.On("GetFirstInCreatedStatus", ctx).Return(testOrder, nil).Once(),
+		orderRepo.On("GetNextDispatchable", ctx).Return(testOrder, nil).Once(),
 		courierRepo.On("GetAllFree", ctx).Return(testCouriers, nil).Once(),
 		orderRepo.On("Update", ctx, mock.AnythingOfType("*order.Order")).Return(nil).Once(),
 		courierRepo.On("Update", ctx, mock.AnythingOfType("*courier.Courier")).Return(nil).Once(),
 		uow.On("Commit", ctx).Return(nil).Once(),
-		uow.On("Rollback", ctx).Return(nil).Once(),
 	)
 
 	factory := new(MockAssignUoWFactory)
@@ -510,3 +680,997 @@ func TestAssignCourierCommandHandler_Handle_MultipleCouriers(t *testing.T) {
 	updatedCourier := updateCall.Arguments[1].(*courier.Courier)
 	assert.Equal(t, courier2ID, updatedCourier.ID())
 }
+
+type MockDispatchPostProcessor struct{ mock.Mock }
+
+func (m *MockDispatchPostProcessor) ProcessAssignment(ctx context.Context, assignment *commands.DispatchAssignment) error {
+	args := m.Called(ctx, assignment)
+	return args.Error(0)
+}
+
+type MockDispatchCommitListener struct {
+	MockDispatchPostProcessor
+}
+
+func (m *MockDispatchCommitListener) AssignmentCommitted(ctx context.Context, assignment commands.DispatchAssignment) {
+	m.Called(ctx, assignment)
+}
+
+func TestAssignCourierCommandHandler_Handle_PostProcessorsAnnotateAndObserveCommit(t *testing.T) {
+	ctx := t.Context()
+	cmd := commands.NewAssignCourierCommand()
+
+	location, _ := kernel.NewLocation(5, 7)
+	testOrder, _ := order.NewOrder(kernel.NewUUID(), location, 10)
+	testCourier, _ := courier.NewCourier(kernel.NewUUID(), "John Doe", 3, location)
+
+	orderRepo := new(MockAssignOrderRepository)
+	courierRepo := new(MockAssignCourierRepository)
+	uow := new(MockAssignUoW)
+	annotator := new(MockDispatchPostProcessor)
+	listener := new(MockDispatchCommitListener)
+
+	mock.InOrder(
+		uow.On("Begin", ctx).Return(nil).Once(),
+		uow.On("CourierRepository").Return(courierRepo).Once(),
+		uow.On("OrderRepository").Return(orderRepo).Once(),
+		orderRepo.On("GetNextDispatchable", ctx).Return(testOrder, nil).Once(),
+		courierRepo.On("GetAllFree", ctx).Return([]*courier.Courier{testCourier}, nil).Once(),
+		annotator.On("ProcessAssignment", ctx, mock.AnythingOfType("*commands.DispatchAssignment")).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*commands.DispatchAssignment).Annotate("quota.remaining", "4")
+			}).Return(nil).Once(),
+		listener.On("ProcessAssignment", ctx, mock.AnythingOfType("*commands.DispatchAssignment")).Return(nil).Once(),
+		orderRepo.On("Update", ctx, testOrder).Return(nil).Once(),
+		courierRepo.On("Update", ctx, testCourier).Return(nil).Once(),
+		uow.On("Commit", ctx).Return(nil).Once(),
+		listener.On("AssignmentCommitted", ctx, mock.MatchedBy(func(a commands.DispatchAssignment) bool {
+			return a.Order == testOrder && a.Courier == testCourier && a.Annotations()["quota.remaining"] == "4" &&
+				a.Explanation.Strategy == "fastest-delivery" && a.Explanation.CourierID == testCourier.ID() &&
+				len(a.Explanation.Candidates) == 1
+		})).Once(),
+	)
+
+	factory := new(MockAssignUoWFactory)
+	factory.On("Create").Return(uow).Once()
+
+	handler := commands.NewAssignCourierCommandHandler(factory, annotator, listener)
+	err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	annotator.AssertExpectations(t)
+	listener.AssertExpectations(t)
+	uow.AssertExpectations(t)
+}
+
+func TestAssignCourierCommandHandler_Handle_PostProcessorVeto(t *testing.T) {
+	ctx := t.Context()
+	cmd := commands.NewAssignCourierCommand()
+
+	location, _ := kernel.NewLocation(5, 7)
+	testOrder, _ := order.NewOrder(kernel.NewUUID(), location, 10)
+	testCourier, _ := courier.NewCourier(kernel.NewUUID(), "John Doe", 3, location)
+
+	orderRepo := new(MockAssignOrderRepository)
+	courierRepo := new(MockAssignCourierRepository)
+	uow := new(MockAssignUoW)
+	vetoing := new(MockDispatchPostProcessor)
+	next := new(MockDispatchCommitListener)
+
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("CourierRepository").Return(courierRepo).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	orderRepo.On("GetNextDispatchable", ctx).Return(testOrder, nil).Once()
+	courierRepo.On("GetAllFree", ctx).Return([]*courier.Courier{testCourier}, nil).Once()
+	vetoing.On("ProcessAssignment", ctx, mock.Anything).Return(commands.VetoAssignment("fraud suspected")).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	factory := new(MockAssignUoWFactory)
+	factory.On("Create").Return(uow).Once()
+
+	handler := commands.NewAssignCourierCommandHandler(factory, vetoing, next)
+	err := handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, commands.ErrAssignmentIsVetoed)
+	assert.Contains(t, err.Error(), "fraud suspected")
+	next.AssertNotCalled(t, "ProcessAssignment", mock.Anything, mock.Anything)
+	next.AssertNotCalled(t, "AssignmentCommitted", mock.Anything, mock.Anything)
+	orderRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	uow.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+func TestAssignCourierCommandHandler_Handle_WorkingHoursLimit(t *testing.T) {
+	ctx := t.Context()
+	now := time.Now()
+	limit, err := courier.NewWorkingHoursLimit(8*time.Hour, 30*time.Minute)
+	require.NoError(t, err)
+
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	nearLocation, _ := kernel.NewLocation(5, 6)
+	farLocation, _ := kernel.NewLocation(5, 9)
+	testOrder, _ := order.NewOrder(kernel.NewUUID(), orderLocation, 5)
+
+	// The near courier would be the fastest but has no working hours left today
+	exhausted, _ := courier.NewCourier(kernel.NewUUID(), "Exhausted", 1, nearLocation)
+	exhaustedLog, err := courier.RestoreWorkLog(now, 8*time.Hour, nil)
+	require.NoError(t, err)
+	exhausted.RestoreWorkLog(exhaustedLog)
+	tired, _ := courier.NewCourier(kernel.NewUUID(), "Tired", 1, farLocation)
+	tiredLog, err := courier.RestoreWorkLog(now, 7*time.Hour+45*time.Minute, nil)
+	require.NoError(t, err)
+	tired.RestoreWorkLog(tiredLog)
+
+	orderRepo := new(MockAssignOrderRepository)
+	courierRepo := new(MockAssignCourierRepository)
+	uow := new(MockAssignUoW)
+	listener := new(MockDispatchCommitListener)
+
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("CourierRepository").Return(courierRepo).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	orderRepo.On("GetNextDispatchable", ctx).Return(testOrder, nil).Once()
+	courierRepo.On("GetAllFree", ctx).Return([]*courier.Courier{exhausted, tired}, nil).Once()
+	listener.On("ProcessAssignment", ctx, mock.Anything).Return(nil).Once()
+	orderRepo.On("Update", ctx, testOrder).Return(nil).Once()
+	courierRepo.On("Update", ctx, tired).Return(nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+	listener.On("AssignmentCommitted", ctx, mock.MatchedBy(func(a commands.DispatchAssignment) bool {
+		return a.Annotations()["working_hours.status"] == "approaching_limit"
+	})).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	factory := new(MockAssignUoWFactory)
+	factory.On("Create").Return(uow).Once()
+
+	handler := commands.NewAssignCourierCommandHandler(factory, listener).WithWorkingHoursLimit(limit)
+	require.NoError(t, handler.Handle(ctx, commands.NewAssignCourierCommand()))
+
+	assert.True(t, testOrder.Courier().IsEqual(tired.ID()))
+	listener.AssertExpectations(t)
+
+	t.Run("should report no couriers when all reached the limit", func(t *testing.T) {
+		pending, _ := order.NewOrder(kernel.NewUUID(), orderLocation, 5)
+		orderRepo := new(MockAssignOrderRepository)
+		courierRepo := new(MockAssignCourierRepository)
+		uow := new(MockAssignUoW)
+
+		uow.On("Begin", ctx).Return(nil).Once()
+		uow.On("CourierRepository").Return(courierRepo).Once()
+		uow.On("OrderRepository").Return(orderRepo).Once()
+		orderRepo.On("GetNextDispatchable", ctx).Return(pending, nil).Once()
+		courierRepo.On("GetAllFree", ctx).Return([]*courier.Courier{exhausted}, nil).Once()
+		uow.On("Rollback", ctx).Return(nil).Once()
+
+		factory := new(MockAssignUoWFactory)
+		factory.On("Create").Return(uow).Once()
+
+		handler := commands.NewAssignCourierCommandHandler(factory).WithWorkingHoursLimit(limit)
+		err := handler.Handle(ctx, commands.NewAssignCourierCommand())
+
+		require.ErrorIs(t, err, commands.ErrNoFreeCouriersFound)
+		orderRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAssignCourierCommandHandler_Handle_ShiftEndDrain(t *testing.T) {
+	ctx := t.Context()
+	now := time.Now()
+	limit, err := courier.NewWorkingHoursLimit(8*time.Hour, 30*time.Minute)
+	require.NoError(t, err)
+
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	nearLocation, _ := kernel.NewLocation(5, 6)
+	farLocation, _ := kernel.NewLocation(5, 9)
+	testOrder, _ := order.NewOrder(kernel.NewUUID(), orderLocation, 5)
+
+	// The near courier would be the fastest but the shift ends soon
+	draining, _ := courier.NewCourier(kernel.NewUUID(), "Draining", 1, nearLocation)
+	drainingLog, err := courier.RestoreWorkLog(now, 7*time.Hour+45*time.Minute, nil)
+	require.NoError(t, err)
+	draining.RestoreWorkLog(drainingLog)
+	fresh, _ := courier.NewCourier(kernel.NewUUID(), "Fresh", 1, farLocation)
+
+	orderRepo := new(MockAssignOrderRepository)
+	courierRepo := new(MockAssignCourierRepository)
+	uow := new(MockAssignUoW)
+
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("CourierRepository").Return(courierRepo).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	orderRepo.On("GetNextDispatchable", ctx).Return(testOrder, nil).Once()
+	courierRepo.On("GetAllFree", ctx).Return([]*courier.Courier{draining, fresh}, nil).Once()
+	orderRepo.On("Update", ctx, testOrder).Return(nil).Once()
+	courierRepo.On("Update", ctx, fresh).Return(nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	factory := new(MockAssignUoWFactory)
+	factory.On("Create").Return(uow).Once()
+
+	handler := commands.NewAssignCourierCommandHandler(factory).WithWorkingHoursLimit(limit).WithShiftEndDrain()
+	require.NoError(t, handler.Handle(ctx, commands.NewAssignCourierCommand()))
+
+	assert.True(t, testOrder.Courier().IsEqual(fresh.ID()))
+	courierRepo.AssertExpectations(t)
+}
+
+func TestAssignCourierCommandHandler_Handle_MaintenanceWarning(t *testing.T) {
+	ctx := t.Context()
+	now := time.Now()
+
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	nearLocation, _ := kernel.NewLocation(5, 6)
+	farLocation, _ := kernel.NewLocation(5, 9)
+	testOrder, _ := order.NewOrder(kernel.NewUUID(), orderLocation, 5)
+
+	// The near courier would be the fastest but the vehicle goes into maintenance soon
+	scheduled, _ := courier.NewCourier(kernel.NewUUID(), "Scheduled", 1, nearLocation)
+	window, err := courier.NewMaintenanceWindow(kernel.NewUUID(), now.Add(20*time.Minute), now.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, scheduled.ScheduleMaintenance(window, now))
+	available, _ := courier.NewCourier(kernel.NewUUID(), "Available", 1, farLocation)
+
+	orderRepo := new(MockAssignOrderRepository)
+	courierRepo := new(MockAssignCourierRepository)
+	uow := new(MockAssignUoW)
+
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("CourierRepository").Return(courierRepo).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	orderRepo.On("GetNextDispatchable", ctx).Return(testOrder, nil).Once()
+	courierRepo.On("GetAllFree", ctx).Return([]*courier.Courier{scheduled, available}, nil).Once()
+	orderRepo.On("Update", ctx, testOrder).Return(nil).Once()
+	courierRepo.On("Update", ctx, available).Return(nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	factory := new(MockAssignUoWFactory)
+	factory.On("Create").Return(uow).Once()
+
+	handler := commands.NewAssignCourierCommandHandler(factory).WithMaintenanceWarning(30 * time.Minute)
+	require.NoError(t, handler.Handle(ctx, commands.NewAssignCourierCommand()))
+
+	assert.True(t, testOrder.Courier().IsEqual(available.ID()))
+	courierRepo.AssertExpectations(t)
+}
+
+func TestAssignCourierCommandHandler_Handle_OutsideSchedule(t *testing.T) {
+	ctx := t.Context()
+
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	nearLocation, _ := kernel.NewLocation(5, 6)
+	farLocation, _ := kernel.NewLocation(5, 9)
+	testOrder, _ := order.NewOrder(kernel.NewUUID(), orderLocation, 5)
+
+	// The near courier would be the fastest but works only on another weekday
+	offDay := time.Now().UTC().AddDate(0, 0, 2).Weekday()
+	window, err := courier.NewScheduleWindow(offDay, 0, 24*time.Hour)
+	require.NoError(t, err)
+	schedule, err := courier.NewSchedule(kernel.NewUUID(), []courier.ScheduleWindow{window})
+	require.NoError(t, err)
+	offShift, _ := courier.NewCourier(kernel.NewUUID(), "Off shift", 1, nearLocation)
+	require.NoError(t, offShift.SetSchedule(schedule))
+	available, _ := courier.NewCourier(kernel.NewUUID(), "Available", 1, farLocation)
+
+	orderRepo := new(MockAssignOrderRepository)
+	courierRepo := new(MockAssignCourierRepository)
+	uow := new(MockAssignUoW)
+
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("CourierRepository").Return(courierRepo).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	orderRepo.On("GetNextDispatchable", ctx).Return(testOrder, nil).Once()
+	courierRepo.On("GetAllFree", ctx).Return([]*courier.Courier{offShift, available}, nil).Once()
+	orderRepo.On("Update", ctx, testOrder).Return(nil).Once()
+	courierRepo.On("Update", ctx, available).Return(nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	factory := new(MockAssignUoWFactory)
+	factory.On("Create").Return(uow).Once()
+
+	handler := commands.NewAssignCourierCommandHandler(factory)
+	require.NoError(t, handler.Handle(ctx, commands.NewAssignCourierCommand()))
+
+	assert.True(t, testOrder.Courier().IsEqual(available.ID()))
+	courierRepo.AssertExpectations(t)
+}
+
+func TestAssignCourierCommandHandler_Handle_Surge(t *testing.T) {
+	ctx := t.Context()
+	now := time.Now()
+	limit, err := courier.NewWorkingHoursLimit(8*time.Hour, 30*time.Minute)
+	require.NoError(t, err)
+
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	nearLocation, _ := kernel.NewLocation(5, 6)
+	farLocation, _ := kernel.NewLocation(5, 9)
+
+	// The near courier is draining and goes into maintenance in 20 minutes
+	newNearCourier := func(t *testing.T) *courier.Courier {
+		t.Helper()
+		c, _ := courier.NewCourier(kernel.NewUUID(), "Near", 1, nearLocation)
+		workLog, logErr := courier.RestoreWorkLog(now, 7*time.Hour+45*time.Minute, nil)
+		require.NoError(t, logErr)
+		c.RestoreWorkLog(workLog)
+		window, windowErr := courier.NewMaintenanceWindow(kernel.NewUUID(), now.Add(20*time.Minute), now.Add(2*time.Hour))
+		require.NoError(t, windowErr)
+		require.NoError(t, c.ScheduleMaintenance(window, now))
+		return c
+	}
+
+	dispatch := func(t *testing.T, mode surge.Mode) map[string]string {
+		t.Helper()
+		testOrder, _ := order.NewOrder(kernel.NewUUID(), orderLocation, 5)
+		near := newNearCourier(t)
+		far, _ := courier.NewCourier(kernel.NewUUID(), "Far", 1, farLocation)
+
+		orderRepo := new(MockAssignOrderRepository)
+		courierRepo := new(MockAssignCourierRepository)
+		uow := new(MockAssignUoW)
+		listener := new(MockDispatchCommitListener)
+		surges := new(MockSurgeRepository)
+
+		var annotations map[string]string
+		uow.On("Begin", ctx).Return(nil).Once()
+		uow.On("CourierRepository").Return(courierRepo).Once()
+		uow.On("OrderRepository").Return(orderRepo).Once()
+		orderRepo.On("GetNextDispatchable", ctx).Return(testOrder, nil).Once()
+		courierRepo.On("GetAllFree", ctx).Return([]*courier.Courier{near, far}, nil).Once()
+		surges.On("GetMode", ctx).Return(mode, nil).Once()
+		listener.On("ProcessAssignment", ctx, mock.Anything).Return(nil).Once()
+		orderRepo.On("Update", ctx, testOrder).Return(nil).Once()
+		courierRepo.On("Update", ctx, mock.Anything).Return(nil).Once()
+		uow.On("Commit", ctx).Return(nil).Once()
+		listener.On("AssignmentCommitted", ctx, mock.Anything).Run(func(args mock.Arguments) {
+			assignment := args.Get(1).(commands.DispatchAssignment)
+			annotations = assignment.Annotations()
+		}).Once()
+		uow.On("Rollback", ctx).Return(nil).Once()
+
+		factory := new(MockAssignUoWFactory)
+		factory.On("Create").Return(uow).Once()
+
+		handler := commands.NewAssignCourierCommandHandler(factory, listener).
+			WithWorkingHoursLimit(limit).
+			WithShiftEndDrain().
+			WithMaintenanceWarning(30*time.Minute).
+			WithSurge(surges, surgePolicy(t))
+		require.NoError(t, handler.Handle(ctx, commands.NewAssignCourierCommand()))
+
+		assert.Equal(t, mode.Active(), testOrder.Courier().IsEqual(near.ID()))
+		return annotations
+	}
+
+	t.Run("should keep limits while surge is off", func(t *testing.T) {
+		annotations := dispatch(t, surge.DefaultMode())
+
+		assert.NotContains(t, annotations, "surge.active")
+	})
+
+	t.Run("should relax drain and maintenance warning while surge is on", func(t *testing.T) {
+		annotations := dispatch(t, activeSurgeMode(t))
+
+		assert.Equal(t, "true", annotations["surge.active"])
+	})
+}
+
+func TestAssignCourierCommandHandler_Handle_PickupSlots(t *testing.T) {
+	ctx := t.Context()
+	location, _ := kernel.NewLocation(5, 5)
+
+	newSlot := func(t *testing.T, capacity int, bookings ...kernel.UUID) *pickup.Slot {
+		t.Helper()
+		startsAt := time.Now().Add(time.Minute).Truncate(time.Second)
+		slot, err := pickup.RestoreSlot(kernel.NewUUID(), startsAt, startsAt.Add(30*time.Minute), capacity, bookings)
+		require.NoError(t, err)
+		return slot
+	}
+
+	setup := func(
+		t *testing.T, testOrder *order.Order,
+	) (*MockAssignOrderRepository, *MockAssignCourierRepository, *MockPickupSlotRepository, *MockAssignUoW, *MockAssignUoWFactory) {
+		t.Helper()
+		testCourier, _ := courier.NewCourier(kernel.NewUUID(), "John Doe", 3, location)
+
+		orderRepo := new(MockAssignOrderRepository)
+		courierRepo := new(MockAssignCourierRepository)
+		slotRepo := new(MockPickupSlotRepository)
+		uow := new(MockAssignUoW)
+
+		uow.On("Begin", ctx).Return(nil).Once()
+		uow.On("CourierRepository").Return(courierRepo).Once()
+		uow.On("OrderRepository").Return(orderRepo).Once()
+		uow.On("PickupSlotRepository").Return(slotRepo)
+		orderRepo.On("GetNextDispatchable", ctx).Return(testOrder, nil).Once()
+		courierRepo.On("GetAllFree", ctx).Return([]*courier.Courier{testCourier}, nil).Once()
+
+		factory := new(MockAssignUoWFactory)
+		factory.On("Create").Return(uow).Once()
+		return orderRepo, courierRepo, slotRepo, uow, factory
+	}
+
+	t.Run("should book the first available slot", func(t *testing.T) {
+		testOrder, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+		slot := newSlot(t, 2)
+		orderRepo, courierRepo, slotRepo, uow, factory := setup(t, testOrder)
+		listener := new(MockDispatchCommitListener)
+
+		slotRepo.On("GetByOrder", ctx, testOrder.ID()).Return(nil, errs.NewObjectNotFoundError("pickup slot", testOrder.ID())).Once()
+		slotRepo.On("GetFirstAvailable", ctx, mock.AnythingOfType("time.Time")).Return(slot, nil).Once()
+		listener.On("ProcessAssignment", ctx, mock.Anything).Return(nil).Once()
+		orderRepo.On("Update", ctx, testOrder).Return(nil).Once()
+		courierRepo.On("Update", ctx, mock.AnythingOfType("*courier.Courier")).Return(nil).Once()
+		slotRepo.On("Update", ctx, slot).Return(nil).Once()
+		uow.On("Commit", ctx).Return(nil).Once()
+		listener.On("AssignmentCommitted", ctx, mock.MatchedBy(func(a commands.DispatchAssignment) bool {
+			return a.Annotations()["pickup_slot.starts_at"] == slot.StartsAt().UTC().Format(time.RFC3339)
+		})).Once()
+
+		handler := commands.NewAssignCourierCommandHandler(factory, listener).WithPickupSlots()
+		require.NoError(t, handler.Handle(ctx, commands.NewAssignCourierCommand()))
+
+		assert.True(t, slot.IsBooked(testOrder.ID()))
+		assert.Equal(t, 1, slot.Remaining())
+		slotRepo.AssertExpectations(t)
+		listener.AssertExpectations(t)
+		uow.AssertExpectations(t)
+	})
+
+	t.Run("should keep the slot of a reassigned order", func(t *testing.T) {
+		testOrder, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+		slot := newSlot(t, 1, testOrder.ID())
+		orderRepo, courierRepo, slotRepo, uow, factory := setup(t, testOrder)
+
+		slotRepo.On("GetByOrder", ctx, testOrder.ID()).Return(slot, nil).Once()
+		orderRepo.On("Update", ctx, testOrder).Return(nil).Once()
+		courierRepo.On("Update", ctx, mock.AnythingOfType("*courier.Courier")).Return(nil).Once()
+		slotRepo.On("Update", ctx, slot).Return(nil).Once()
+		uow.On("Commit", ctx).Return(nil).Once()
+
+		handler := commands.NewAssignCourierCommandHandler(factory).WithPickupSlots()
+		require.NoError(t, handler.Handle(ctx, commands.NewAssignCourierCommand()))
+
+		assert.Equal(t, 0, slot.Remaining())
+		slotRepo.AssertNotCalled(t, "GetFirstAvailable", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should defer dispatch when no slot is available", func(t *testing.T) {
+		testOrder, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+		orderRepo, _, slotRepo, uow, factory := setup(t, testOrder)
+
+		slotRepo.On("GetByOrder", ctx, testOrder.ID()).Return(nil, errs.NewObjectNotFoundError("pickup slot", testOrder.ID())).Once()
+		slotRepo.On("GetFirstAvailable", ctx, mock.AnythingOfType("time.Time")).
+			Return(nil, errs.NewObjectNotFoundError("pickup slot", "available")).Once()
+		uow.On("Rollback", ctx).Return(nil).Once()
+
+		handler := commands.NewAssignCourierCommandHandler(factory).WithPickupSlots()
+		err := handler.Handle(ctx, commands.NewAssignCourierCommand())
+
+		require.ErrorIs(t, err, commands.ErrNoPickupSlotAvailable)
+		assert.Nil(t, testOrder.Courier())
+		orderRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		uow.AssertNotCalled(t, "Commit", mock.Anything)
+	})
+
+	t.Run("should defer dispatch when the slot filled up concurrently", func(t *testing.T) {
+		testOrder, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+		full := newSlot(t, 1, kernel.NewUUID())
+		_, _, slotRepo, uow, factory := setup(t, testOrder)
+
+		slotRepo.On("GetByOrder", ctx, testOrder.ID()).Return(nil, errs.NewObjectNotFoundError("pickup slot", testOrder.ID())).Once()
+		slotRepo.On("GetFirstAvailable", ctx, mock.AnythingOfType("time.Time")).Return(full, nil).Once()
+		uow.On("Rollback", ctx).Return(nil).Once()
+
+		handler := commands.NewAssignCourierCommandHandler(factory).WithPickupSlots()
+		err := handler.Handle(ctx, commands.NewAssignCourierCommand())
+
+		require.ErrorIs(t, err, commands.ErrNoPickupSlotAvailable)
+		uow.AssertNotCalled(t, "Commit", mock.Anything)
+	})
+}
+
+type recordingDispatchObserver struct {
+	comparisons []commands.DispatchComparison
+}
+
+func (o *recordingDispatchObserver) DispatchCompared(_ context.Context, comparison commands.DispatchComparison) {
+	o.comparisons = append(o.comparisons, comparison)
+}
+
+func TestAssignCourierCommandHandler_Handle_DispatchExperiment(t *testing.T) {
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	nearLocation, _ := kernel.NewLocation(5, 6)
+	farLocation, _ := kernel.NewLocation(5, 8)
+
+	tests := []struct {
+		name            string
+		percentage      rollout.Percentage
+		expectNear      bool
+		expectedApplied string
+	}{
+		{"flag off keeps the default strategy", 0, true, "fastest-delivery"},
+		{"flag on applies the candidate", 100, false, "best-fit"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			testOrder, _ := order.NewOrder(kernel.NewUUID(), orderLocation, 20)
+
+			// The near courier would waste a large trunk, the far one fits the order tightly
+			near, _ := courier.NewCourier(kernel.NewUUID(), "Near", 1, nearLocation)
+			require.NoError(t, near.AddStoragePlace("Trunk", 100))
+			far, _ := courier.NewCourier(kernel.NewUUID(), "Far", 1, farLocation)
+			require.NoError(t, far.AddStoragePlace("Trunk", 25))
+			couriers := []*courier.Courier{near, far}
+			expectedCourier := far
+			if tt.expectNear {
+				expectedCourier = near
+			}
+
+			orderRepo := new(MockAssignOrderRepository)
+			courierRepo := new(MockAssignCourierRepository)
+			uow := new(MockAssignUoW)
+			listener := new(MockDispatchCommitListener)
+
+			uow.On("Begin", ctx).Return(nil).Once()
+			uow.On("CourierRepository").Return(courierRepo).Once()
+			uow.On("OrderRepository").Return(orderRepo).Once()
+			orderRepo.On("GetNextDispatchable", ctx).Return(testOrder, nil).Once()
+			courierRepo.On("GetAllFree", ctx).Return(couriers, nil).Once()
+			listener.On("ProcessAssignment", ctx, mock.Anything).Return(nil).Once()
+			orderRepo.On("Update", ctx, testOrder).Return(nil).Once()
+			courierRepo.On("Update", ctx, mock.AnythingOfType("*courier.Courier")).Return(nil).Once()
+			uow.On("Commit", ctx).Return(nil).Once()
+			listener.On("AssignmentCommitted", ctx, mock.MatchedBy(func(a commands.DispatchAssignment) bool {
+				return a.Annotations()["dispatch.strategy"] == tt.expectedApplied
+			})).Once()
+			uow.On("Rollback", ctx).Return(nil).Once()
+
+			factory := new(MockAssignUoWFactory)
+			factory.On("Create").Return(uow).Once()
+
+			flag, err := rollout.NewFlag("best-fit-dispatch", tt.percentage)
+			require.NoError(t, err)
+			observer := &recordingDispatchObserver{}
+			experiment, err := commands.NewDispatchExperiment(flag, services.BestFitStrategy{}, observer)
+			require.NoError(t, err)
+
+			handler := commands.NewAssignCourierCommandHandlerWithExperiment(factory, experiment, listener)
+			require.NoError(t, handler.Handle(ctx, commands.NewAssignCourierCommand()))
+
+			assert.True(t, testOrder.Courier().IsEqual(expectedCourier.ID()))
+			listener.AssertExpectations(t)
+
+			require.Len(t, observer.comparisons, 1)
+			comparison := observer.comparisons[0]
+			assert.Equal(t, testOrder.ID(), comparison.OrderID)
+			assert.Equal(t, tt.expectedApplied, comparison.Applied)
+			assert.Equal(t, "fastest-delivery", comparison.Baseline.Strategy)
+			assert.True(t, comparison.Baseline.Courier.IsEqual(near.ID()))
+			assert.Equal(t, "best-fit", comparison.Candidate.Strategy)
+			assert.True(t, comparison.Candidate.Courier.IsEqual(far.ID()))
+		})
+	}
+}
+
+func TestAssignCourierCommandHandler_Handle_Degradation(t *testing.T) {
+	ctx := t.Context()
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	slowLocation, _ := kernel.NewLocation(5, 7)
+	fastLocation, _ := kernel.NewLocation(5, 8)
+	testOrder, _ := order.NewOrder(kernel.NewUUID(), orderLocation, 5)
+
+	// The slow courier is nearer, the fast one arrives first
+	slow, _ := courier.NewCourier(kernel.NewUUID(), "Slow", 1, slowLocation)
+	fast, _ := courier.NewCourier(kernel.NewUUID(), "Fast", 3, fastLocation)
+
+	orderRepo := new(MockAssignOrderRepository)
+	courierRepo := new(MockAssignCourierRepository)
+	uow := new(MockAssignUoW)
+	listener := new(MockDispatchCommitListener)
+
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("CourierRepository").Return(courierRepo).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	orderRepo.On("GetNextDispatchable", ctx).Return(testOrder, nil).Once()
+	courierRepo.On("GetAllFree", ctx).Return([]*courier.Courier{slow, fast}, nil).Once()
+	listener.On("ProcessAssignment", ctx, mock.Anything).Return(nil).Once()
+	orderRepo.On("Update", ctx, testOrder).Return(nil).Once()
+	courierRepo.On("Update", ctx, slow).Return(nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+	listener.On("AssignmentCommitted", ctx, mock.MatchedBy(func(a commands.DispatchAssignment) bool {
+		return a.Annotations()["dispatch.strategy"] == "greedy-nearest"
+	})).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	factory := new(MockAssignUoWFactory)
+	factory.On("Create").Return(uow).Once()
+
+	flag, err := rollout.NewFlag("best-fit-dispatch", 100)
+	require.NoError(t, err)
+	observer := &recordingDispatchObserver{}
+	experiment, err := commands.NewDispatchExperiment(flag, services.BestFitStrategy{}, observer)
+	require.NoError(t, err)
+
+	degradation, err := commands.NewDispatchDegradation(time.Second, 0)
+	require.NoError(t, err)
+	_, switched := degradation.Observe(commands.DispatchPressure{Latency: 2 * time.Second})
+	require.True(t, switched)
+
+	handler := commands.NewAssignCourierCommandHandlerWithExperiment(factory, experiment, listener).
+		WithDegradation(degradation)
+	require.NoError(t, handler.Handle(ctx, commands.NewAssignCourierCommand()))
+
+	assert.True(t, testOrder.Courier().IsEqual(slow.ID()))
+	assert.Empty(t, observer.comparisons, "the experiment is skipped in greedy mode")
+	listener.AssertExpectations(t)
+	courierRepo.AssertExpectations(t)
+}
+
+func TestAssignCourierCommandHandler_Handle_DispatchStrategy(t *testing.T) {
+	ctx := t.Context()
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	nearLocation, _ := kernel.NewLocation(5, 6)
+	farLocation, _ := kernel.NewLocation(5, 8)
+	testOrder, _ := order.NewOrder(kernel.NewUUID(), orderLocation, 5)
+
+	// The near courier already carries an order, the far one carries nothing
+	loaded, _ := courier.NewCourier(kernel.NewUUID(), "Loaded", 1, nearLocation)
+	require.NoError(t, loaded.AddStoragePlace("Trunk", 25))
+	carried, _ := order.NewOrder(kernel.NewUUID(), orderLocation, 20)
+	require.NoError(t, loaded.TakeOrder(carried))
+	free, _ := courier.NewCourier(kernel.NewUUID(), "Free", 1, farLocation)
+
+	orderRepo := new(MockAssignOrderRepository)
+	courierRepo := new(MockAssignCourierRepository)
+	uow := new(MockAssignUoW)
+	listener := new(MockDispatchCommitListener)
+
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("CourierRepository").Return(courierRepo).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	orderRepo.On("GetNextDispatchable", ctx).Return(testOrder, nil).Once()
+	courierRepo.On("GetAllFree", ctx).Return([]*courier.Courier{loaded, free}, nil).Once()
+	listener.On("ProcessAssignment", ctx, mock.Anything).Return(nil).Once()
+	orderRepo.On("Update", ctx, testOrder).Return(nil).Once()
+	courierRepo.On("Update", ctx, free).Return(nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+	listener.On("AssignmentCommitted", ctx, mock.MatchedBy(func(a commands.DispatchAssignment) bool {
+		return a.Annotations()["dispatch.strategy"] == "weighted" && len(a.Explanation.Candidates) == 2
+	})).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	factory := new(MockAssignUoWFactory)
+	factory.On("Create").Return(uow).Once()
+
+	weights, err := services.NewDispatchWeights(1, 5, 0)
+	require.NoError(t, err)
+	handler := commands.NewAssignCourierCommandHandler(factory, listener).
+		WithDispatchStrategy(services.NewWeightedStrategy(weights))
+	require.NoError(t, handler.Handle(ctx, commands.NewAssignCourierCommand()))
+
+	assert.True(t, testOrder.Courier().IsEqual(free.ID()))
+	listener.AssertExpectations(t)
+	courierRepo.AssertExpectations(t)
+}
+
+func TestNewDispatchExperiment_RequiresAllParts(t *testing.T) {
+	_, err := commands.NewDispatchExperiment(nil, nil, nil)
+
+	require.ErrorIs(t, err, errs.ErrValueIsRequired)
+}
+
+func TestAssignCourierCommandHandler_Handle_DeviceHealth(t *testing.T) {
+	ctx := t.Context()
+
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	nearLocation, _ := kernel.NewLocation(5, 6)
+	farLocation, _ := kernel.NewLocation(5, 9)
+	silentLocation, _ := kernel.NewLocation(9, 9)
+	testOrder, _ := order.NewOrder(kernel.NewUUID(), orderLocation, 5)
+
+	// The near courier would be the fastest but the phone is about to die
+	drained, _ := courier.NewCourier(kernel.NewUUID(), "Drained", 1, nearLocation)
+	healthy, _ := courier.NewCourier(kernel.NewUUID(), "Healthy", 1, farLocation)
+	silent, _ := courier.NewCourier(kernel.NewUUID(), "Silent", 1, silentLocation)
+
+	orderRepo := new(MockAssignOrderRepository)
+	courierRepo := new(MockAssignCourierRepository)
+	telemetry := new(MockDeviceTelemetryRepository)
+	uow := new(MockAssignUoW)
+
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("CourierRepository").Return(courierRepo).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	orderRepo.On("GetNextDispatchable", ctx).Return(testOrder, nil).Once()
+	courierRepo.On("GetAllFree", ctx).Return([]*courier.Courier{drained, healthy, silent}, nil).Once()
+	telemetry.On("GetSince", ctx, mock.Anything, []kernel.UUID{drained.ID(), healthy.ID(), silent.ID()}).
+		Return([]device.Reading{
+			deviceReading(t, drained.ID(), 5, device.Good, time.Minute),
+			deviceReading(t, healthy.ID(), 70, device.Good, time.Minute),
+		}, nil).Once()
+	orderRepo.On("Update", ctx, testOrder).Return(nil).Once()
+	courierRepo.On("Update", ctx, healthy).Return(nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	factory := new(MockAssignUoWFactory)
+	factory.On("Create").Return(uow).Once()
+
+	policy, _ := device.NewHealthPolicy(15, 10*time.Minute)
+	handler := commands.NewAssignCourierCommandHandler(factory).WithDeviceHealth(telemetry, policy)
+	require.NoError(t, handler.Handle(ctx, commands.NewAssignCourierCommand()))
+
+	assert.True(t, testOrder.Courier().IsEqual(healthy.ID()))
+	courierRepo.AssertExpectations(t)
+	telemetry.AssertExpectations(t)
+}
+
+func TestAssignCourierCommandHandler_Handle_BatchDispatch(t *testing.T) {
+	ctx := t.Context()
+	location, _ := kernel.NewLocation(5, 5)
+	newOrder := func(volume int) *order.Order {
+		o, err := order.NewOrder(kernel.NewUUID(), location, volume)
+		require.NoError(t, err)
+		return o
+	}
+	newCourier := func(y kernel.Coordinate) *courier.Courier {
+		courierLocation, _ := kernel.NewLocation(5, y)
+		c, err := courier.NewCourier(kernel.NewUUID(), "Courier", 1, courierLocation)
+		require.NoError(t, err)
+		return c
+	}
+
+	t.Run("should fill the free storage of carrying couriers", func(t *testing.T) {
+		// The near courier already carries an order in its bag and has an empty trunk
+		near := newCourier(6)
+		require.NoError(t, near.AddStoragePlace("Trunk", 20))
+		require.NoError(t, near.TakeOrder(newOrder(5)))
+		far := newCourier(9)
+		first, second, tooLarge := newOrder(15), newOrder(5), newOrder(30)
+
+		orderRepo := new(MockAssignOrderRepository)
+		courierRepo := new(MockAssignCourierRepository)
+		uow := new(MockAssignUoW)
+		listener := new(MockDispatchCommitListener)
+
+		uow.On("Begin", ctx).Return(nil).Once()
+		uow.On("CourierRepository").Return(courierRepo).Once()
+		uow.On("OrderRepository").Return(orderRepo).Once()
+		orderRepo.On("GetDispatchable", ctx, 3).Return([]*order.Order{first, tooLarge, second}, nil).Once()
+		courierRepo.On("GetAllWithFreeStorage", ctx).Return([]*courier.Courier{near, far}, nil).Once()
+		listener.On("ProcessAssignment", ctx, mock.Anything).Return(nil).Twice()
+		orderRepo.On("Update", ctx, first).Return(nil).Once()
+		orderRepo.On("Update", ctx, second).Return(nil).Once()
+		courierRepo.On("Update", ctx, near).Return(nil).Once()
+		courierRepo.On("Update", ctx, far).Return(nil).Once()
+		uow.On("Commit", ctx).Return(nil).Once()
+		listener.On("AssignmentCommitted", ctx, mock.Anything).Twice()
+		uow.On("Rollback", ctx).Return(nil).Once()
+
+		factory := new(MockAssignUoWFactory)
+		factory.On("Create").Return(uow).Once()
+
+		handler := commands.NewAssignCourierCommandHandler(factory, listener).WithBatchDispatch(3)
+		require.NoError(t, handler.Handle(ctx, commands.NewAssignCourierCommand()))
+
+		assert.True(t, first.Courier().IsEqual(near.ID()), "the trunk of the near courier")
+		assert.True(t, second.Courier().IsEqual(far.ID()), "the near courier is full")
+		assert.Equal(t, order.Created, tooLarge.Status())
+		orderRepo.AssertExpectations(t)
+		courierRepo.AssertExpectations(t)
+		listener.AssertExpectations(t)
+	})
+
+	t.Run("should save a courier taking several orders once", func(t *testing.T) {
+		only := newCourier(6)
+		require.NoError(t, only.AddStoragePlace("Trunk", 20))
+		first, second := newOrder(5), newOrder(5)
+
+		orderRepo := new(MockAssignOrderRepository)
+		courierRepo := new(MockAssignCourierRepository)
+		uow := new(MockAssignUoW)
+
+		uow.On("Begin", ctx).Return(nil).Once()
+		uow.On("CourierRepository").Return(courierRepo).Once()
+		uow.On("OrderRepository").Return(orderRepo).Once()
+		orderRepo.On("GetDispatchable", ctx, 5).Return([]*order.Order{first, second}, nil).Once()
+		courierRepo.On("GetAllWithFreeStorage", ctx).Return([]*courier.Courier{only}, nil).Once()
+		orderRepo.On("Update", ctx, first).Return(nil).Once()
+		orderRepo.On("Update", ctx, second).Return(nil).Once()
+		courierRepo.On("Update", ctx, only).Return(nil).Once()
+		uow.On("Commit", ctx).Return(nil).Once()
+		uow.On("Rollback", ctx).Return(nil).Once()
+
+		factory := new(MockAssignUoWFactory)
+		factory.On("Create").Return(uow).Once()
+
+		handler := commands.NewAssignCourierCommandHandler(factory).
+			WithBatchDispatch(5).
+			WithDispatchStrategy(services.LeastLoadedStrategy{})
+		require.NoError(t, handler.Handle(ctx, commands.NewAssignCourierCommand()))
+
+		assert.True(t, first.Courier().IsEqual(only.ID()))
+		assert.True(t, second.Courier().IsEqual(only.ID()))
+		courierRepo.AssertNumberOfCalls(t, "Update", 1)
+	})
+
+	t.Run("should report the first waiting reason when no order was assigned", func(t *testing.T) {
+		full := newCourier(6)
+		require.NoError(t, full.TakeOrder(newOrder(10)))
+
+		orderRepo := new(MockAssignOrderRepository)
+		courierRepo := new(MockAssignCourierRepository)
+		uow := new(MockAssignUoW)
+
+		uow.On("Begin", ctx).Return(nil).Once()
+		uow.On("CourierRepository").Return(courierRepo).Once()
+		uow.On("OrderRepository").Return(orderRepo).Once()
+		orderRepo.On("GetDispatchable", ctx, 2).Return([]*order.Order{newOrder(5), newOrder(5)}, nil).Once()
+		courierRepo.On("GetAllWithFreeStorage", ctx).Return([]*courier.Courier{full}, nil).Once()
+		uow.On("Rollback", ctx).Return(nil).Once()
+
+		factory := new(MockAssignUoWFactory)
+		factory.On("Create").Return(uow).Once()
+
+		err := commands.NewAssignCourierCommandHandler(factory).WithBatchDispatch(2).
+			Handle(ctx, commands.NewAssignCourierCommand())
+
+		require.ErrorIs(t, err, services.ErrCourierNotFound)
+		orderRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		courierRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should report no order when none is pending", func(t *testing.T) {
+		orderRepo := new(MockAssignOrderRepository)
+		courierRepo := new(MockAssignCourierRepository)
+		uow := new(MockAssignUoW)
+
+		uow.On("Begin", ctx).Return(nil).Once()
+		uow.On("CourierRepository").Return(courierRepo).Once()
+		uow.On("OrderRepository").Return(orderRepo).Once()
+		orderRepo.On("GetDispatchable", ctx, 2).Return([]*order.Order{}, nil).Once()
+		uow.On("Rollback", ctx).Return(nil).Once()
+
+		factory := new(MockAssignUoWFactory)
+		factory.On("Create").Return(uow).Once()
+
+		err := commands.NewAssignCourierCommandHandler(factory).WithBatchDispatch(2).
+			Handle(ctx, commands.NewAssignCourierCommand())
+
+		require.ErrorIs(t, err, commands.ErrNoOrderFound)
+	})
+}
+
+func TestAssignCourierCommandHandler_Handle_MatchingRules(t *testing.T) {
+	ctx := t.Context()
+
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	nearLocation, _ := kernel.NewLocation(5, 6)
+	farLocation, _ := kernel.NewLocation(5, 9)
+	testOrder, _ := order.NewOrder(kernel.NewUUID(), orderLocation, 5)
+
+	// The cyclist would be the fastest but the order is too large for a bicycle
+	cyclist, _ := courier.NewCourier(kernel.NewUUID(), "Cyclist", 1, nearLocation)
+	bicycle, _ := courier.Profile{}.WithVehicleType(courier.Bicycle)
+	cyclist.ChangeProfile(bicycle)
+	driver, _ := courier.NewCourier(kernel.NewUUID(), "Driver", 1, farLocation)
+	car, _ := courier.Profile{}.WithVehicleType(courier.Car)
+	driver.ChangeProfile(car)
+
+	above := 3
+	rule, err := matching.NewRule(kernel.NewUUID(), "Large orders", matching.Condition{VolumeAbove: &above},
+		matching.Requirement{VehicleTypes: []courier.VehicleType{courier.Car}})
+	require.NoError(t, err)
+
+	orderRepo := new(MockAssignOrderRepository)
+	courierRepo := new(MockAssignCourierRepository)
+	rules := new(MockMatchingRuleRepository)
+	uow := new(MockAssignUoW)
+
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("CourierRepository").Return(courierRepo).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	orderRepo.On("GetNextDispatchable", ctx).Return(testOrder, nil).Once()
+	courierRepo.On("GetAllFree", ctx).Return([]*courier.Courier{cyclist, driver}, nil).Once()
+	rules.On("GetRules", ctx).Return([]*matching.Rule{rule}, nil).Once()
+	orderRepo.On("Update", ctx, testOrder).Return(nil).Once()
+	courierRepo.On("Update", ctx, driver).Return(nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	factory := new(MockAssignUoWFactory)
+	factory.On("Create").Return(uow).Once()
+
+	handler := commands.NewAssignCourierCommandHandler(factory).WithMatchingRules(rules)
+	require.NoError(t, handler.Handle(ctx, commands.NewAssignCourierCommand()))
+
+	assert.True(t, testOrder.Courier().IsEqual(driver.ID()))
+	courierRepo.AssertExpectations(t)
+	rules.AssertExpectations(t)
+}
+
+func TestAssignCourierCommandHandler_Handle_MatchingRulesAdmitNoCourier(t *testing.T) {
+	ctx := t.Context()
+
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	courierLocation, _ := kernel.NewLocation(5, 6)
+	testOrder, _ := order.NewOrder(kernel.NewUUID(), orderLocation, 5)
+	require.NoError(t, testOrder.ChangeTemperatureClass(order.Frozen))
+	// The bag the order would go into keeps nothing frozen
+	plain, _ := courier.NewCourier(kernel.NewUUID(), "Plain", 1, courierLocation)
+
+	frozen := order.Frozen
+	rule, err := matching.NewRule(kernel.NewUUID(), "Frozen", matching.Condition{TemperatureClass: &frozen},
+		matching.Requirement{ThermalStorage: true})
+	require.NoError(t, err)
+
+	orderRepo := new(MockAssignOrderRepository)
+	courierRepo := new(MockAssignCourierRepository)
+	rules := new(MockMatchingRuleRepository)
+	uow := new(MockAssignUoW)
+
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("CourierRepository").Return(courierRepo).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	orderRepo.On("GetNextDispatchable", ctx).Return(testOrder, nil).Once()
+	courierRepo.On("GetAllFree", ctx).Return([]*courier.Courier{plain}, nil).Once()
+	rules.On("GetRules", ctx).Return([]*matching.Rule{rule}, nil).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	factory := new(MockAssignUoWFactory)
+	factory.On("Create").Return(uow).Once()
+
+	handler := commands.NewAssignCourierCommandHandler(factory).WithMatchingRules(rules)
+	err = handler.Handle(ctx, commands.NewAssignCourierCommand())
+
+	require.ErrorIs(t, err, commands.ErrNoFreeCouriersFound)
+	orderRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestAssignCourierCommandHandler_Handle_EscalationWaivesMatchingRules(t *testing.T) {
+	ctx := t.Context()
+
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	courierLocation, _ := kernel.NewLocation(5, 6)
+	testOrder, _ := order.NewOrder(kernel.NewUUID(), orderLocation, 5)
+	require.NoError(t, testOrder.ChangeTemperatureClass(order.Frozen))
+	// No courier keeps frozen goods, but the order waited past the tier waiving the rules
+	plain, _ := courier.NewCourier(kernel.NewUUID(), "Plain", 1, courierLocation)
+
+	policy, err := escalation.ParsePolicy("10m,30m:matching-rules")
+	require.NoError(t, err)
+	escalated, err := policy.Escalate(testOrder.ID(), 0, 2, time.Now())
+	require.NoError(t, err)
+
+	orderRepo := new(MockAssignOrderRepository)
+	courierRepo := new(MockAssignCourierRepository)
+	rules := new(MockMatchingRuleRepository)
+	escalations := new(MockOrderEscalationRepository)
+	uow := new(MockAssignUoW)
+	listener := new(MockDispatchCommitListener)
+
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("CourierRepository").Return(courierRepo).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	orderRepo.On("GetNextDispatchable", ctx).Return(testOrder, nil).Once()
+	courierRepo.On("GetAllFree", ctx).Return([]*courier.Courier{plain}, nil).Once()
+	escalations.On("Get", ctx, testOrder.ID()).Return(escalated, nil).Once()
+	listener.On("ProcessAssignment", ctx, mock.Anything).Return(nil).Once()
+	orderRepo.On("Update", ctx, testOrder).Return(nil).Once()
+	courierRepo.On("Update", ctx, plain).Return(nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+	listener.On("AssignmentCommitted", ctx, mock.MatchedBy(func(a commands.DispatchAssignment) bool {
+		return a.Annotations()["escalation.tier"] == "2"
+	})).Once()
+
+	factory := new(MockAssignUoWFactory)
+	factory.On("Create").Return(uow).Once()
+
+	handler := commands.NewAssignCourierCommandHandler(factory, listener).
+		WithMatchingRules(rules).
+		WithEscalation(escalations, policy)
+	require.NoError(t, handler.Handle(ctx, commands.NewAssignCourierCommand()))
+
+	assert.True(t, testOrder.Courier().IsEqual(plain.ID()))
+	rules.AssertNotCalled(t, "GetRules", mock.Anything)
+	listener.AssertExpectations(t)
+}
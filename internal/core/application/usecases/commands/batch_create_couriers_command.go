@@ -0,0 +1,118 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"delivery/internal/pkg/guard"
+	"delivery/internal/pkg/i18n"
+)
+
+// MaxImportedCouriers limits how many couriers a single batch may contain.
+const MaxImportedCouriers = 1000
+
+var (
+	ErrBatchCreateCouriersCommandIsNotConstructed = errors.New(
+		"BatchCreateCouriersCommand must be created via NewBatchCreateCouriersCommand constructor",
+	)
+	ErrCourierRowsAreRequired = errors.New("at least one courier row is required")
+	ErrTooManyCourierRows     = fmt.Errorf("no more than %d courier rows can be imported at once", MaxImportedCouriers)
+	ErrExternalIDIsRepeated   = errors.New("external ID is repeated in the batch")
+)
+
+// CourierImportRow is one courier of a batch as sent by the client.
+// Values are kept as text so that every row can be validated and reported on its own:
+// Speed is a positive integer, Language a supported language tag (empty for the default)
+// and ExternalID the optional HR system ID of the courier.
+type CourierImportRow struct {
+	// Line is the row number in the batch, used to report results
+	Line       int
+	Name       string
+	Speed      string
+	Language   string
+	ExternalID string
+}
+
+// BatchCreateCouriersCommand represents the onboarding of a fleet: many couriers registered
+// at once, typically from an export of the HR system.
+//
+// Example:
+//
+//	cmd, err := NewBatchCreateCouriersCommand([]CourierImportRow{
+//	    {Line: 1, Name: "John Doe", Speed: "2", ExternalID: "HR-004217"},
+//	    {Line: 2, Name: "Jane Roe", Speed: "3", Language: "en"},
+//	}, i18n.Russian)
+//	if err != nil {
+//	    return fmt.Errorf("invalid batch: %w", err)
+//	}
+//
+//	report, err := handler.Handle(ctx, cmd)
+type BatchCreateCouriersCommand struct { //nolint:recvcheck //using for validation
+	rows            []CourierImportRow
+	defaultLanguage i18n.Language
+
+	guard guard.ConstructorGuard
+}
+
+// NewBatchCreateCouriersCommand creates a command to register couriers in one batch.
+// Rows without a language get defaultLanguage.
+// Validates that there is at least one row and no more than MaxImportedCouriers and that
+// the default language is supported; the rows themselves are validated one by one when
+// the command is handled.
+func NewBatchCreateCouriersCommand(
+	rows []CourierImportRow,
+	defaultLanguage i18n.Language,
+) (BatchCreateCouriersCommand, error) {
+	command := BatchCreateCouriersCommand{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := command.setRows(rows); err != nil {
+		return BatchCreateCouriersCommand{}, err
+	}
+
+	if err := command.setDefaultLanguage(defaultLanguage); err != nil {
+		return BatchCreateCouriersCommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrBatchCreateCouriersCommandIsNotConstructed if validation fails.
+func (c BatchCreateCouriersCommand) Validate() error {
+	return c.guard.Validate(ErrBatchCreateCouriersCommandIsNotConstructed)
+}
+
+// Rows returns a copy of the rows in batch order.
+func (c BatchCreateCouriersCommand) Rows() []CourierImportRow {
+	rows := make([]CourierImportRow, len(c.rows))
+	copy(rows, c.rows)
+	return rows
+}
+
+// DefaultLanguage returns the language of rows without one.
+func (c BatchCreateCouriersCommand) DefaultLanguage() i18n.Language {
+	return c.defaultLanguage
+}
+
+func (c *BatchCreateCouriersCommand) setRows(rows []CourierImportRow) error {
+	if len(rows) == 0 {
+		return ErrCourierRowsAreRequired
+	}
+	if len(rows) > MaxImportedCouriers {
+		return ErrTooManyCourierRows
+	}
+
+	c.rows = append([]CourierImportRow(nil), rows...)
+	return nil
+}
+
+func (c *BatchCreateCouriersCommand) setDefaultLanguage(language i18n.Language) error {
+	if err := language.Validate(); err != nil {
+		return err
+	}
+
+	c.defaultLanguage = language
+	return nil
+}
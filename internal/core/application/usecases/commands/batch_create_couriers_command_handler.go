@@ -0,0 +1,220 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/i18n"
+	"delivery/internal/pkg/tracing"
+)
+
+// CourierImportResult is the outcome of one row of a courier batch.
+// CourierID is set when the row is valid: it is the created courier, or the courier registered
+// first under the row's external ID when Existing is true. Err explains why the row is invalid.
+type CourierImportResult struct {
+	Line      int
+	CourierID *kernel.UUID
+	Existing  bool
+	Err       error
+}
+
+// CourierImportReport lists the outcome of every row of a courier batch in batch order.
+type CourierImportReport struct {
+	Results []CourierImportResult
+}
+
+// Created returns the number of couriers created by the batch.
+func (r CourierImportReport) Created() int {
+	created := 0
+	for _, result := range r.Results {
+		if result.CourierID != nil && !result.Existing {
+			created++
+		}
+	}
+	return created
+}
+
+// Existing returns the number of rows whose external ID was already registered.
+func (r CourierImportReport) Existing() int {
+	existing := 0
+	for _, result := range r.Results {
+		if result.Existing {
+			existing++
+		}
+	}
+	return existing
+}
+
+// Failed returns the number of invalid rows.
+func (r CourierImportReport) Failed() int {
+	failed := 0
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failed++
+		}
+	}
+	return failed
+}
+
+// BatchCreateCouriersCommandHandler registers a batch of couriers atomically.
+//
+// Every row is validated on its own, so the report names all invalid rows at once. The batch is
+// all or nothing: if any row is invalid, no courier is created. Otherwise all couriers are added
+// in a single transaction. Rows keyed by an already registered external ID are duplicate-safe,
+// like single creations: they report the registered courier and create nothing, so a failed
+// import can be repeated as a whole.
+//
+// Example:
+//
+//	handler := NewBatchCreateCouriersCommandHandler(uowFactory)
+//	report, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    return fmt.Errorf("import failed: %w", err)
+//	}
+//	if report.Failed() > 0 {
+//	    return fmt.Errorf("%d rows are invalid, nothing was created", report.Failed())
+//	}
+type BatchCreateCouriersCommandHandler struct {
+	uowFactory CourierUoWFactory
+}
+
+// NewBatchCreateCouriersCommandHandler creates a handler for batch courier registration.
+// Requires a CourierUoWFactory for transactional persistence operations.
+func NewBatchCreateCouriersCommandHandler(uowFactory CourierUoWFactory) BatchCreateCouriersCommandHandler {
+	return BatchCreateCouriersCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle validates the rows and, if all of them are valid, creates their couriers in one transaction.
+// Returns an error if the command is invalid or the batch cannot be stored; invalid rows are
+// recorded in the report instead.
+func (h *BatchCreateCouriersCommandHandler) Handle(
+	ctx context.Context,
+	cmd BatchCreateCouriersCommand,
+) (CourierImportReport, error) {
+	ctx, span := tracing.Start(ctx, "BatchCreateCouriersCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return CourierImportReport{}, err
+	}
+
+	rows := cmd.Rows()
+	report := CourierImportReport{Results: make([]CourierImportResult, len(rows))}
+	prepared := make([]CreateCourierCommand, len(rows))
+	seen := make(map[string]int, len(rows))
+	for i, row := range rows {
+		report.Results[i] = CourierImportResult{Line: row.Line}
+
+		create, err := prepareCourierImportRow(row, cmd.DefaultLanguage())
+		if err != nil {
+			report.Results[i].Err = err
+			continue
+		}
+
+		if externalID := create.ExternalID(); externalID != nil {
+			if first, repeated := seen[externalID.String()]; repeated {
+				report.Results[i].Err = fmt.Errorf("%w, first on row %d", ErrExternalIDIsRepeated, rows[first].Line)
+				continue
+			}
+			seen[externalID.String()] = i
+		}
+
+		prepared[i] = create
+	}
+
+	if report.Failed() > 0 {
+		return report, nil
+	}
+
+	if err := h.store(ctx, prepared, &report); err != nil {
+		return CourierImportReport{}, err
+	}
+
+	return report, nil
+}
+
+// store adds the couriers of the batch in a single transaction, skipping the rows whose
+// external ID is already registered, and records the courier of every row.
+func (h *BatchCreateCouriersCommandHandler) store(
+	ctx context.Context,
+	prepared []CreateCourierCommand,
+	report *CourierImportReport,
+) error {
+	uow := h.uowFactory.Create()
+	return uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		for i, create := range prepared {
+			if externalID := create.ExternalID(); externalID != nil {
+				registered, err := courierRepo.GetByExternalID(ctx, *externalID)
+				if err == nil {
+					id := registered.ID()
+					report.Results[i].CourierID = &id
+					report.Results[i].Existing = true
+					continue
+				}
+				if !errors.Is(err, errs.ErrObjectNotFound) {
+					return err
+				}
+			}
+
+			courierEntity, err := courier.NewCourierWithLanguage(
+				create.CourierID(),
+				create.Name(),
+				create.Speed(),
+				create.Location(),
+				create.Language(),
+			)
+			if err != nil {
+				return err
+			}
+
+			if externalID := create.ExternalID(); externalID != nil {
+				if err = courierEntity.LinkExternalID(*externalID); err != nil {
+					return err
+				}
+			}
+
+			if err = courierRepo.Add(ctx, courierEntity); err != nil {
+				return err
+			}
+
+			id := courierEntity.ID()
+			report.Results[i].CourierID = &id
+		}
+
+		return nil
+	})
+}
+
+// prepareCourierImportRow validates the text values of a row and builds the creation it describes.
+// The courier starts at a random location, like a courier registered on its own.
+func prepareCourierImportRow(row CourierImportRow, defaultLanguage i18n.Language) (CreateCourierCommand, error) {
+	speed, err := strconv.Atoi(strings.TrimSpace(row.Speed))
+	if err != nil {
+		return CreateCourierCommand{}, errs.NewValueIsInvalidErrorWithCause("speed", err)
+	}
+
+	language := defaultLanguage
+	if tag := strings.TrimSpace(row.Language); tag != "" {
+		language = i18n.Language(tag)
+	}
+
+	location, err := kernel.NewRandomLocation()
+	if err != nil {
+		return CreateCourierCommand{}, err
+	}
+
+	name := strings.TrimSpace(row.Name)
+	if externalID := strings.TrimSpace(row.ExternalID); externalID != "" {
+		return NewCreateCourierCommandWithExternalID(name, speed, location, language, externalID)
+	}
+	return NewCreateCourierCommandWithLanguage(name, speed, location, language)
+}
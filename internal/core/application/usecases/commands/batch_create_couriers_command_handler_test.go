@@ -0,0 +1,155 @@
+package commands_test
+
+import (
+	"errors"
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/i18n"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newBatchCourierUoW(t *testing.T) (*MockCourierUoWFactory, *MockCourierUoW, *MockCourierRepository) {
+	t.Helper()
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+	mockFactory.On("Create").Return(mockUoW)
+	mockUoW.On("Begin", mock.Anything).Return(nil)
+	mockUoW.On("Rollback", mock.Anything).Return(nil)
+	mockUoW.On("CourierRepository").Return(mockRepo)
+	return mockFactory, mockUoW, mockRepo
+}
+
+func TestBatchCreateCouriersCommandHandler_Handle_CreatesAllCouriers(t *testing.T) {
+	// Arrange
+	ctx := t.Context()
+	mockFactory, mockUoW, mockRepo := newBatchCourierUoW(t)
+
+	location, err := kernel.NewLocation(1, 1)
+	require.NoError(t, err)
+	registered, err := courier.NewCourier(kernel.NewUUID(), "Registered", 2, location)
+	require.NoError(t, err)
+
+	registeredID, err := courier.NewExternalID("HR-1")
+	require.NoError(t, err)
+	newID, err := courier.NewExternalID("HR-2")
+	require.NoError(t, err)
+
+	mockRepo.On("GetByExternalID", mock.Anything, registeredID).Return(registered, nil)
+	mockRepo.On("GetByExternalID", mock.Anything, newID).Return(nil, errs.ErrObjectNotFound)
+	var added []*courier.Courier
+	mockRepo.On("Add", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		added = append(added, args.Get(1).(*courier.Courier))
+	}).Return(nil)
+	mockUoW.On("Commit", mock.Anything).Return(nil)
+
+	cmd, err := commands.NewBatchCreateCouriersCommand([]commands.CourierImportRow{
+		{Line: 1, Name: "John Doe", Speed: "2", ExternalID: "HR-1"},
+		{Line: 2, Name: " Jane Roe ", Speed: "3", Language: "en", ExternalID: "HR-2"},
+		{Line: 3, Name: "Ivan Petrov", Speed: "1"},
+	}, i18n.Russian)
+	require.NoError(t, err)
+
+	handler := commands.NewBatchCreateCouriersCommandHandler(mockFactory)
+
+	// Act
+	report, err := handler.Handle(ctx, cmd)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Created())
+	assert.Equal(t, 1, report.Existing())
+	assert.Equal(t, 0, report.Failed())
+
+	assert.True(t, report.Results[0].Existing)
+	assert.Equal(t, registered.ID(), *report.Results[0].CourierID)
+
+	require.Len(t, added, 2)
+	assert.Equal(t, "Jane Roe", added[0].Name())
+	assert.Equal(t, i18n.English, added[0].Language())
+	assert.Equal(t, newID, *added[0].ExternalID())
+	assert.Equal(t, added[0].ID(), *report.Results[1].CourierID)
+	assert.Equal(t, i18n.Russian, added[1].Language())
+	assert.Nil(t, added[1].ExternalID())
+
+	mockUoW.AssertNumberOfCalls(t, "Begin", 1)
+	mockUoW.AssertNumberOfCalls(t, "Commit", 1)
+}
+
+func TestBatchCreateCouriersCommandHandler_Handle_InvalidRowsCreateNothing(t *testing.T) {
+	// Arrange
+	ctx := t.Context()
+	mockFactory := new(MockCourierUoWFactory)
+
+	cmd, err := commands.NewBatchCreateCouriersCommand([]commands.CourierImportRow{
+		{Line: 1, Name: "John Doe", Speed: "2", ExternalID: "HR-1"},
+		{Line: 2, Name: "", Speed: "2"},
+		{Line: 3, Name: "Jane Roe", Speed: "fast"},
+		{Line: 4, Name: "Ivan Petrov", Speed: "1", Language: "xx"},
+		{Line: 5, Name: "John Doe", Speed: "2", ExternalID: "HR-1"},
+	}, i18n.Russian)
+	require.NoError(t, err)
+
+	handler := commands.NewBatchCreateCouriersCommandHandler(mockFactory)
+
+	// Act
+	report, err := handler.Handle(ctx, cmd)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 0, report.Created())
+	assert.Equal(t, 4, report.Failed())
+
+	assert.NoError(t, report.Results[0].Err)
+	assert.Nil(t, report.Results[0].CourierID)
+	require.ErrorIs(t, report.Results[1].Err, commands.ErrNameIsRequired)
+	require.ErrorIs(t, report.Results[2].Err, errs.ErrValueIsInvalid)
+	require.ErrorContains(t, report.Results[2].Err, "speed")
+	require.ErrorContains(t, report.Results[3].Err, "language")
+	require.ErrorIs(t, report.Results[4].Err, commands.ErrExternalIDIsRepeated)
+	require.ErrorContains(t, report.Results[4].Err, "row 1")
+
+	mockFactory.AssertNotCalled(t, "Create")
+}
+
+func TestBatchCreateCouriersCommandHandler_Handle_StoreError(t *testing.T) {
+	// Arrange
+	ctx := t.Context()
+	mockFactory, mockUoW, mockRepo := newBatchCourierUoW(t)
+
+	storeErr := errors.New("database unavailable")
+	mockRepo.On("Add", mock.Anything, mock.Anything).Return(nil).Once()
+	mockRepo.On("Add", mock.Anything, mock.Anything).Return(storeErr).Once()
+
+	cmd, err := commands.NewBatchCreateCouriersCommand([]commands.CourierImportRow{
+		{Line: 1, Name: "John Doe", Speed: "2"},
+		{Line: 2, Name: "Jane Roe", Speed: "3"},
+	}, i18n.Russian)
+	require.NoError(t, err)
+
+	handler := commands.NewBatchCreateCouriersCommandHandler(mockFactory)
+
+	// Act
+	_, err = handler.Handle(ctx, cmd)
+
+	// Assert
+	require.ErrorIs(t, err, storeErr)
+	mockUoW.AssertNotCalled(t, "Commit", mock.Anything)
+	mockUoW.AssertCalled(t, "Rollback", mock.Anything)
+}
+
+func TestBatchCreateCouriersCommandHandler_Handle_ValidationError(t *testing.T) {
+	handler := commands.NewBatchCreateCouriersCommandHandler(new(MockCourierUoWFactory))
+
+	_, err := handler.Handle(t.Context(), commands.BatchCreateCouriersCommand{})
+
+	require.ErrorIs(t, err, commands.ErrBatchCreateCouriersCommandIsNotConstructed)
+}
@@ -0,0 +1,54 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/i18n"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBatchCreateCouriersCommand_ValidInput(t *testing.T) {
+	rows := []commands.CourierImportRow{
+		{Line: 1, Name: "John Doe", Speed: "2", ExternalID: "HR-1"},
+		{Line: 2, Name: "Jane Roe", Speed: "3", Language: "en"},
+	}
+
+	cmd, err := commands.NewBatchCreateCouriersCommand(rows, i18n.Russian)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, rows, cmd.Rows())
+	assert.Equal(t, i18n.Russian, cmd.DefaultLanguage())
+}
+
+func TestNewBatchCreateCouriersCommand_NoRows(t *testing.T) {
+	_, err := commands.NewBatchCreateCouriersCommand(nil, i18n.Russian)
+
+	require.ErrorIs(t, err, commands.ErrCourierRowsAreRequired)
+}
+
+func TestNewBatchCreateCouriersCommand_TooManyRows(t *testing.T) {
+	rows := make([]commands.CourierImportRow, commands.MaxImportedCouriers+1)
+
+	_, err := commands.NewBatchCreateCouriersCommand(rows, i18n.Russian)
+
+	require.ErrorIs(t, err, commands.ErrTooManyCourierRows)
+}
+
+func TestNewBatchCreateCouriersCommand_UnsupportedLanguage(t *testing.T) {
+	_, err := commands.NewBatchCreateCouriersCommand(
+		[]commands.CourierImportRow{{Line: 1, Name: "John Doe", Speed: "2"}},
+		i18n.Language("xx"),
+	)
+
+	require.Error(t, err)
+}
+
+func TestBatchCreateCouriersCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.BatchCreateCouriersCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrBatchCreateCouriersCommandIsNotConstructed)
+}
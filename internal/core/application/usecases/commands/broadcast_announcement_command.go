@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"errors"
+	"strings"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrBroadcastAnnouncementCommandIsNotConstructed = errors.New(
+		"BroadcastAnnouncementCommand must be created via NewBroadcastAnnouncementCommand constructor",
+	)
+	ErrAnnouncementTextIsRequired = errors.New("announcement text is required")
+)
+
+// BroadcastAnnouncementCommand represents dispatch broadcasting an announcement to the couriers
+// on shift, either to all of them or to the ones inside a zone.
+//
+// Example:
+//
+//	cmd, err := NewBroadcastAnnouncementCommand("Surge pricing active in the city centre", &zone)
+//	if err != nil {
+//	    return fmt.Errorf("invalid announcement: %w", err)
+//	}
+//
+//	handler := NewBroadcastAnnouncementCommandHandler(uowFactory, announcements, notifier)
+//	sent, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    return fmt.Errorf("failed to broadcast announcement: %w", err)
+//	}
+type BroadcastAnnouncementCommand struct { //nolint:recvcheck //using for validation
+	text string
+	zone *kernel.Zone
+
+	guard guard.ConstructorGuard
+}
+
+// NewBroadcastAnnouncementCommand creates a command to broadcast an announcement.
+// A nil zone addresses every courier on shift.
+// Text length limits are enforced by the announcement aggregate.
+func NewBroadcastAnnouncementCommand(text string, zone *kernel.Zone) (BroadcastAnnouncementCommand, error) {
+	command := BroadcastAnnouncementCommand{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := errors.Join(
+		command.setText(text),
+		command.setZone(zone),
+	); err != nil {
+		return BroadcastAnnouncementCommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrBroadcastAnnouncementCommandIsNotConstructed if validation fails.
+func (c BroadcastAnnouncementCommand) Validate() error {
+	return c.guard.Validate(ErrBroadcastAnnouncementCommandIsNotConstructed)
+}
+
+// Text returns the announcement body.
+func (c BroadcastAnnouncementCommand) Text() string {
+	return c.text
+}
+
+// Zone returns the zone the announcement is restricted to, or nil for everyone on shift.
+func (c BroadcastAnnouncementCommand) Zone() *kernel.Zone {
+	return c.zone
+}
+
+func (c *BroadcastAnnouncementCommand) setText(text string) error {
+	if strings.TrimSpace(text) == "" {
+		return ErrAnnouncementTextIsRequired
+	}
+
+	c.text = text
+	return nil
+}
+
+func (c *BroadcastAnnouncementCommand) setZone(zone *kernel.Zone) error {
+	if zone == nil {
+		return nil
+	}
+	if err := zone.Validate(); err != nil {
+		return err
+	}
+
+	c.zone = zone
+	return nil
+}
@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/announcement"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tracing"
+)
+
+// BroadcastAnnouncementCommandHandler sends dispatch announcements to the couriers on shift
+// and tracks their delivery per courier.
+//
+// The announcement is stored with its recipients before any notification is sent, so a broadcast
+// interrupted midway leaves the unsent deliveries Pending in the history rather than losing them.
+// A courier whose notification fails does not stop the broadcast; the failure is recorded instead.
+//
+// Example:
+//
+//	handler := NewBroadcastAnnouncementCommandHandler(uowFactory, announcements, notifier)
+//	cmd, _ := NewBroadcastAnnouncementCommand("Surge pricing active", &zone)
+//	sent, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    log.Printf("Failed to broadcast: %v", err)
+//	}
+type BroadcastAnnouncementCommandHandler struct {
+	uowFactory    CourierUoWFactory
+	announcements ports.AnnouncementRepository
+	notifier      ports.CourierNotifier
+}
+
+// NewBroadcastAnnouncementCommandHandler creates a new handler for announcements.
+// Requires a CourierUoWFactory to find the couriers on shift, the repository the
+// announcements are stored in, and the notifier that reaches the couriers.
+func NewBroadcastAnnouncementCommandHandler(
+	uowFactory CourierUoWFactory,
+	announcements ports.AnnouncementRepository,
+	notifier ports.CourierNotifier,
+) BroadcastAnnouncementCommandHandler {
+	return BroadcastAnnouncementCommandHandler{
+		uowFactory:    uowFactory,
+		announcements: announcements,
+		notifier:      notifier,
+	}
+}
+
+// Handle broadcasts the announcement to the addressed couriers on shift and returns it
+// with the outcome of every delivery. An announcement nobody is addressed by is still stored.
+func (h *BroadcastAnnouncementCommandHandler) Handle(
+	ctx context.Context,
+	cmd BroadcastAnnouncementCommand,
+) (*announcement.Announcement, error) {
+	ctx, span := tracing.Start(ctx, "BroadcastAnnouncementCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+
+	couriers, err := h.getCouriersOnShift(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	postedAt := time.Now()
+	sent, err := announcement.NewAnnouncement(kernel.NewUUID(), cmd.Text(), cmd.Zone(), postedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range couriers {
+		reaches, err := sent.Reaches(c.Location())
+		if err != nil {
+			return nil, err
+		}
+		if !reaches {
+			continue
+		}
+		if err = sent.AddRecipient(c.ID()); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = h.announcements.Add(ctx, sent); err != nil {
+		return nil, err
+	}
+
+	notification := ports.CourierNotification{
+		ID:     sent.ID(),
+		Text:   sent.Text(),
+		SentAt: postedAt,
+	}
+	for _, delivery := range sent.Deliveries() {
+		if notifyErr := h.notifier.NotifyCourier(ctx, delivery.CourierID(), notification); notifyErr != nil {
+			err = sent.MarkFailed(delivery.CourierID(), time.Now(), notifyErr.Error())
+		} else {
+			err = sent.MarkDelivered(delivery.CourierID(), time.Now())
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = h.announcements.Update(ctx, sent); err != nil {
+		return nil, err
+	}
+
+	return sent, nil
+}
+
+// getCouriersOnShift reads the couriers on shift in a transaction of its own.
+func (h *BroadcastAnnouncementCommandHandler) getCouriersOnShift(ctx context.Context) ([]*courier.Courier, error) {
+	var couriers []*courier.Courier
+	uow := h.uowFactory.Create()
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		couriers, err = uow.CourierRepository().GetAllOnShift(ctx)
+		return err
+	})
+	return couriers, err
+}
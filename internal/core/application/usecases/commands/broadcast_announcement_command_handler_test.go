@@ -0,0 +1,154 @@
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/announcement"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockAnnouncementRepository struct{ mock.Mock }
+
+func (m *MockAnnouncementRepository) Add(ctx context.Context, a *announcement.Announcement) error {
+	args := m.Called(ctx, a)
+	return args.Error(0)
+}
+
+func (m *MockAnnouncementRepository) Update(ctx context.Context, a *announcement.Announcement) error {
+	args := m.Called(ctx, a)
+	return args.Error(0)
+}
+
+type MockCourierNotifier struct{ mock.Mock }
+
+func (m *MockCourierNotifier) NotifyCourier(
+	ctx context.Context,
+	courierID kernel.UUID,
+	notification ports.CourierNotification,
+) error {
+	args := m.Called(ctx, courierID, notification)
+	return args.Error(0)
+}
+
+func expectCouriersOnShift(ctx context.Context, couriers []*courier.Courier) *MockCourierUoWFactory {
+	repo := new(MockCourierRepository)
+	uow := new(MockCourierUoW)
+	factory := new(MockCourierUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("CourierRepository").Return(repo).Once()
+	repo.On("GetAllOnShift", ctx).Return(couriers, nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	return factory
+}
+
+func TestBroadcastAnnouncementCommandHandler_Handle_Zone(t *testing.T) {
+	ctx := t.Context()
+	inside := createCourierAt(t, 2, 2)
+	failing := createCourierAt(t, 3, 1)
+	outside := createCourierAt(t, 8, 8)
+	factory := expectCouriersOnShift(ctx, []*courier.Courier{inside, failing, outside})
+
+	repo := new(MockAnnouncementRepository)
+	repo.On("Add", ctx, mock.MatchedBy(func(a *announcement.Announcement) bool {
+		for _, delivery := range a.Deliveries() {
+			if delivery.Status() != announcement.Pending {
+				return false
+			}
+		}
+		return len(a.Deliveries()) == 2
+	})).Return(nil).Once()
+	repo.On("Update", ctx, mock.AnythingOfType("*announcement.Announcement")).Return(nil).Once()
+
+	notifier := new(MockCourierNotifier)
+	isAnnouncement := mock.MatchedBy(func(n ports.CourierNotification) bool {
+		return n.Text == "Surge pricing active"
+	})
+	notifier.On("NotifyCourier", ctx, inside.ID(), isAnnouncement).Return(nil).Once()
+	notifier.On("NotifyCourier", ctx, failing.ID(), isAnnouncement).Return(errors.New("push rejected")).Once()
+
+	from, err := kernel.NewLocation(1, 1)
+	require.NoError(t, err)
+	to, err := kernel.NewLocation(3, 3)
+	require.NoError(t, err)
+	zone, err := kernel.NewZone(from, to)
+	require.NoError(t, err)
+	cmd, err := commands.NewBroadcastAnnouncementCommand("Surge pricing active", &zone)
+	require.NoError(t, err)
+
+	handler := commands.NewBroadcastAnnouncementCommandHandler(factory, repo, notifier)
+	sent, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	deliveries := sent.Deliveries()
+	require.Len(t, deliveries, 2)
+	assert.True(t, deliveries[0].CourierID().IsEqual(inside.ID()))
+	assert.Equal(t, announcement.Delivered, deliveries[0].Status())
+	assert.True(t, deliveries[1].CourierID().IsEqual(failing.ID()))
+	assert.Equal(t, announcement.Failed, deliveries[1].Status())
+	assert.Equal(t, "push rejected", deliveries[1].Failure())
+	repo.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+}
+
+func TestBroadcastAnnouncementCommandHandler_Handle_Everyone(t *testing.T) {
+	ctx := t.Context()
+	near := createCourierAt(t, 2, 2)
+	far := createCourierAt(t, 9, 9)
+	factory := expectCouriersOnShift(ctx, []*courier.Courier{near, far})
+
+	repo := new(MockAnnouncementRepository)
+	repo.On("Add", ctx, mock.Anything).Return(nil).Once()
+	repo.On("Update", ctx, mock.Anything).Return(nil).Once()
+
+	notifier := new(MockCourierNotifier)
+	notifier.On("NotifyCourier", ctx, mock.Anything, mock.Anything).Return(nil).Twice()
+
+	cmd, err := commands.NewBroadcastAnnouncementCommand("Rain expected", nil)
+	require.NoError(t, err)
+
+	handler := commands.NewBroadcastAnnouncementCommandHandler(factory, repo, notifier)
+	sent, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Len(t, sent.Deliveries(), 2)
+	notifier.AssertExpectations(t)
+}
+
+func TestBroadcastAnnouncementCommandHandler_Handle_AddFails(t *testing.T) {
+	ctx := t.Context()
+	factory := expectCouriersOnShift(ctx, []*courier.Courier{createCourierAt(t, 2, 2)})
+
+	repo := new(MockAnnouncementRepository)
+	repo.On("Add", ctx, mock.Anything).Return(errors.New("database error")).Once()
+	notifier := new(MockCourierNotifier)
+
+	cmd, err := commands.NewBroadcastAnnouncementCommand("Rain expected", nil)
+	require.NoError(t, err)
+
+	handler := commands.NewBroadcastAnnouncementCommandHandler(factory, repo, notifier)
+	_, err = handler.Handle(ctx, cmd)
+
+	require.EqualError(t, err, "database error")
+	notifier.AssertNotCalled(t, "NotifyCourier", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestBroadcastAnnouncementCommandHandler_Handle_InvalidCommand(t *testing.T) {
+	handler := commands.NewBroadcastAnnouncementCommandHandler(
+		new(MockCourierUoWFactory), new(MockAnnouncementRepository), new(MockCourierNotifier))
+
+	_, err := handler.Handle(t.Context(), commands.BroadcastAnnouncementCommand{})
+
+	require.ErrorIs(t, err, commands.ErrBroadcastAnnouncementCommandIsNotConstructed)
+}
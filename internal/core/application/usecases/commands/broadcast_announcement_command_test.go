@@ -0,0 +1,47 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBroadcastAnnouncementCommand_ValidInput(t *testing.T) {
+	from, err := kernel.NewLocation(1, 1)
+	require.NoError(t, err)
+	to, err := kernel.NewLocation(3, 3)
+	require.NoError(t, err)
+	zone, err := kernel.NewZone(from, to)
+	require.NoError(t, err)
+
+	cmd, err := commands.NewBroadcastAnnouncementCommand("Surge pricing active", &zone)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, "Surge pricing active", cmd.Text())
+	assert.Equal(t, &zone, cmd.Zone())
+}
+
+func TestNewBroadcastAnnouncementCommand_WithoutZone(t *testing.T) {
+	cmd, err := commands.NewBroadcastAnnouncementCommand("Rain expected", nil)
+
+	require.NoError(t, err)
+	assert.Nil(t, cmd.Zone())
+}
+
+func TestNewBroadcastAnnouncementCommand_InvalidInput(t *testing.T) {
+	_, err := commands.NewBroadcastAnnouncementCommand("  ", &kernel.Zone{})
+
+	require.ErrorIs(t, err, commands.ErrAnnouncementTextIsRequired)
+	require.ErrorIs(t, err, kernel.ErrZoneIsNotConstructed)
+}
+
+func TestBroadcastAnnouncementCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.BroadcastAnnouncementCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrBroadcastAnnouncementCommandIsNotConstructed)
+}
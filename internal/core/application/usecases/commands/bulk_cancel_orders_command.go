@@ -0,0 +1,156 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+const (
+	// MaxBulkCancelledOrders caps the number of orders one bulk cancellation cancels.
+	MaxBulkCancelledOrders = 1000
+
+	// BulkCancellationBatchSize is the number of orders a bulk cancellation cancels in one transaction.
+	BulkCancellationBatchSize = 100
+
+	// MaxBulkCancellationReasonLength is the maximum number of characters of a bulk cancellation reason.
+	MaxBulkCancellationReasonLength = 255
+)
+
+var (
+	ErrBulkCancelOrdersCommandIsNotConstructed = errors.New(
+		"BulkCancelOrdersCommand must be created via NewBulkCancelOrdersCommand constructor",
+	)
+)
+
+// BulkCancelOrdersCommand represents a request to call off the orders matching a filter at once,
+// for example when a warehouse closes unexpectedly. Only orders that were not delivered yet can be
+// cancelled, so the filter selects orders in Created or Assigned status; the reason is recorded
+// in the audit log.
+//
+// Example:
+//
+//	closedBefore := time.Now()
+//	cmd, err := NewBulkCancelOrdersCommand("Warehouse closed", order.Created, &zone, &closedBefore, 500)
+//	if err != nil {
+//	    return fmt.Errorf("invalid bulk cancellation: %w", err)
+//	}
+//
+//	handler := NewBulkCancelOrdersCommandHandler(uowFactory)
+//	report, err := handler.Handle(ctx, cmd)
+type BulkCancelOrdersCommand struct { //nolint:recvcheck //using for validation
+	reason string
+	filter ports.OrderFilter
+	limit  int
+
+	guard guard.ConstructorGuard
+}
+
+// NewBulkCancelOrdersCommand creates a command to cancel at most limit orders in the status,
+// optionally only those delivered within the zone and those created before createdBefore.
+// Returns a validation error if the reason is blank or longer than MaxBulkCancellationReasonLength,
+// the status is neither Created nor Assigned, the zone is invalid or the limit is not between
+// 1 and MaxBulkCancelledOrders.
+func NewBulkCancelOrdersCommand(
+	reason string,
+	status order.Status,
+	zone *kernel.Zone,
+	createdBefore *time.Time,
+	limit int,
+) (BulkCancelOrdersCommand, error) {
+	reason = strings.TrimSpace(reason)
+
+	var validation errs.ValidationErrors
+	switch {
+	case reason == "":
+		validation.Add("reason", errs.NewValueIsRequiredError("reason"))
+	case utf8.RuneCountInString(reason) > MaxBulkCancellationReasonLength:
+		validation.Add("reason", errs.NewValueIsInvalidErrorWithCause(
+			"reason is invalid",
+			fmt.Errorf("reason exceeds %d characters", MaxBulkCancellationReasonLength),
+		))
+	}
+	if status != order.Created && status != order.Assigned {
+		validation.Add("status", errs.NewValueIsInvalidErrorWithCause(
+			"status is invalid",
+			fmt.Errorf("only %s and %s orders can be cancelled, got %s", order.Created, order.Assigned, status),
+		))
+	}
+	if zone != nil {
+		validation.Add("zone", zone.Validate())
+	}
+	if limit < 1 || limit > MaxBulkCancelledOrders {
+		validation.Add("limit", errs.NewValueIsOutOfRangeError("limit", limit, 1, MaxBulkCancelledOrders))
+	}
+	if err := validation.Err(); err != nil {
+		return BulkCancelOrdersCommand{}, err
+	}
+
+	filter := ports.OrderFilter{Status: status}
+	if zone != nil {
+		filterZone := *zone
+		filter.Zone = &filterZone
+	}
+	if createdBefore != nil {
+		before := createdBefore.UTC()
+		filter.CreatedBefore = &before
+	}
+
+	return BulkCancelOrdersCommand{
+		reason: reason,
+		filter: filter,
+		limit:  limit,
+		guard:  guard.NewConstructorGuard(),
+	}, nil
+}
+
+// NewCancelTimedOutOrdersCommand creates the bulk cancellation of the orders created more than
+// timeout before now and still waiting for a courier in Created. At most MaxBulkCancelledOrders
+// orders are cancelled; the rest are left to a later run.
+// Returns a validation error if the timeout is not positive.
+func NewCancelTimedOutOrdersCommand(timeout time.Duration, now time.Time) (BulkCancelOrdersCommand, error) {
+	if timeout <= 0 {
+		return BulkCancelOrdersCommand{}, errs.NewValueIsInvalidErrorWithCause(
+			"timeout is invalid",
+			fmt.Errorf("timeout must be positive, got %s", timeout),
+		)
+	}
+
+	createdBefore := now.Add(-timeout)
+	return NewBulkCancelOrdersCommand(
+		fmt.Sprintf("Not dispatched within %s", timeout),
+		order.Created,
+		nil,
+		&createdBefore,
+		MaxBulkCancelledOrders,
+	)
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrBulkCancelOrdersCommandIsNotConstructed if validation fails.
+func (c BulkCancelOrdersCommand) Validate() error {
+	return c.guard.Validate(ErrBulkCancelOrdersCommandIsNotConstructed)
+}
+
+// Reason returns why the orders are cancelled.
+func (c BulkCancelOrdersCommand) Reason() string {
+	return c.reason
+}
+
+// Filter returns the filter selecting the orders to cancel.
+func (c BulkCancelOrdersCommand) Filter() ports.OrderFilter {
+	return c.filter
+}
+
+// Limit returns the maximum number of orders to cancel.
+func (c BulkCancelOrdersCommand) Limit() int {
+	return c.limit
+}
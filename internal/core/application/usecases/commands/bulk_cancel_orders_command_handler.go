@@ -0,0 +1,144 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tracing"
+)
+
+// BulkCancellationReport summarizes a bulk cancellation.
+type BulkCancellationReport struct {
+	// ID identifies the audit entries of the bulk cancellation
+	ID kernel.UUID
+
+	// Reason is why the orders were cancelled
+	Reason string
+
+	// OrderIDs are the cancelled orders, earliest created first
+	OrderIDs []kernel.UUID
+
+	// Batches is the number of transactions the orders were cancelled in
+	Batches int
+
+	// LimitReached reports whether the limit stopped the cancellation, so more orders may match
+	LimitReached bool
+}
+
+// BulkCancelOrdersCommandHandler calls off the orders matching a filter in batches of
+// BulkCancellationBatchSize orders. Each order is cancelled the way CancelOrderCommandHandler
+// cancels it, with the reason passed on to the customer in its OrderCancelled event. Each batch
+// is cancelled and recorded in the audit log in one transaction, so a failed batch changes
+// nothing while the batches before it stay cancelled. Running the cancellation again picks up
+// the orders still matching.
+//
+// Example:
+//
+//	handler := NewBulkCancelOrdersCommandHandler(uowFactory)
+//	report, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    log.Printf("stopped after %d orders: %v", len(report.OrderIDs), err)
+//	}
+type BulkCancelOrdersCommandHandler struct {
+	uowFactory BulkCancellationUoWFactory
+}
+
+// NewBulkCancelOrdersCommandHandler creates a new handler for bulk order cancellations.
+// Requires a BulkCancellationUoWFactory for coordinating the orders, couriers and the audit log.
+func NewBulkCancelOrdersCommandHandler(uowFactory BulkCancellationUoWFactory) BulkCancelOrdersCommandHandler {
+	return BulkCancelOrdersCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the BulkCancelOrdersCommand batch by batch.
+// On error the report holds the orders cancelled by the batches committed before the failure.
+func (h *BulkCancelOrdersCommandHandler) Handle(
+	ctx context.Context,
+	cmd BulkCancelOrdersCommand,
+) (BulkCancellationReport, error) {
+	ctx, span := tracing.Start(ctx, "BulkCancelOrdersCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return BulkCancellationReport{}, err
+	}
+
+	report := BulkCancellationReport{
+		ID:       kernel.NewUUID(),
+		Reason:   cmd.Reason(),
+		OrderIDs: make([]kernel.UUID, 0),
+	}
+	for {
+		remaining := cmd.Limit() - len(report.OrderIDs)
+		if remaining == 0 {
+			report.LimitReached = true
+			return report, nil
+		}
+
+		size := min(BulkCancellationBatchSize, remaining)
+		cancelled, err := h.cancelBatch(ctx, cmd, report.ID, report.Batches+1, size)
+		if err != nil {
+			return report, fmt.Errorf("batch %d: %w", report.Batches+1, err)
+		}
+		if len(cancelled) == 0 {
+			return report, nil
+		}
+
+		report.OrderIDs = append(report.OrderIDs, cancelled...)
+		report.Batches++
+		if len(cancelled) < size {
+			return report, nil
+		}
+	}
+}
+
+// cancelBatch cancels at most size orders matching the filter and records them in the audit log
+// as the batch of the bulk cancellation. Returns the cancelled orders, none once no order matches.
+func (h *BulkCancelOrdersCommandHandler) cancelBatch(
+	ctx context.Context,
+	cmd BulkCancelOrdersCommand,
+	id kernel.UUID,
+	batch int,
+	size int,
+) ([]kernel.UUID, error) {
+	var cancelled []kernel.UUID
+	uow := h.uowFactory.Create()
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		orderIDs, err := uow.OrderRepository().GetIDsMatching(ctx, cmd.Filter(), size)
+		if err != nil {
+			return err
+		}
+		if len(orderIDs) == 0 {
+			return nil
+		}
+
+		for _, orderID := range orderIDs {
+			if err = cancelOrder(ctx, uow, orderID, cmd.Reason()); err != nil {
+				return fmt.Errorf("order %s: %w", orderID, err)
+			}
+		}
+
+		if err = uow.BulkCancellationLog().Record(ctx, ports.BulkCancellation{
+			ID:          id,
+			Batch:       batch,
+			Reason:      cmd.Reason(),
+			Filter:      cmd.Filter(),
+			OrderIDs:    orderIDs,
+			CancelledAt: time.Now().UTC(),
+		}); err != nil {
+			return err
+		}
+
+		cancelled = orderIDs
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cancelled, nil
+}
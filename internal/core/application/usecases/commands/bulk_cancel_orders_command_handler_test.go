@@ -0,0 +1,208 @@
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockBulkCancellationLog struct{ mock.Mock }
+
+func (m *MockBulkCancellationLog) Record(ctx context.Context, cancellation ports.BulkCancellation) error {
+	args := m.Called(ctx, cancellation)
+	return args.Error(0)
+}
+
+type MockBulkCancellationUoW struct {
+	MockAssignUoW
+}
+
+func (m *MockBulkCancellationUoW) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return doInTransaction(ctx, m, fn)
+}
+
+func (m *MockBulkCancellationUoW) BulkCancellationLog() ports.BulkCancellationLog {
+	args := m.Called()
+	return args.Get(0).(ports.BulkCancellationLog)
+}
+
+type MockBulkCancellationUoWFactory struct{ mock.Mock }
+
+func (m *MockBulkCancellationUoWFactory) Create() commands.BulkCancellationUoW {
+	args := m.Called()
+	return args.Get(0).(commands.BulkCancellationUoW)
+}
+
+func TestBulkCancelOrdersCommandHandler_Handle(t *testing.T) {
+	ctx := t.Context()
+
+	setup := func(t *testing.T) (
+		*MockAssignOrderRepository, *MockBulkCancellationLog, *MockBulkCancellationUoW, *MockBulkCancellationUoWFactory,
+	) {
+		t.Helper()
+		orderRepo := new(MockAssignOrderRepository)
+		slotRepo := new(MockPickupSlotRepository)
+		log := new(MockBulkCancellationLog)
+		uow := new(MockBulkCancellationUoW)
+		factory := new(MockBulkCancellationUoWFactory)
+
+		factory.On("Create").Return(uow)
+		uow.On("Begin", ctx).Return(nil)
+		uow.On("OrderRepository").Return(orderRepo)
+		uow.On("PickupSlotRepository").Return(slotRepo)
+		uow.On("BulkCancellationLog").Return(log)
+		slotRepo.On("GetByOrder", ctx, mock.Anything).Return(nil, errs.NewObjectNotFoundError("pickup slot", "order"))
+		return orderRepo, log, uow, factory
+	}
+
+	createdOrders := func(t *testing.T, orderRepo *MockAssignOrderRepository, count int) ([]*order.Order, []kernel.UUID) {
+		t.Helper()
+		location, err := kernel.NewLocation(5, 5)
+		require.NoError(t, err)
+		orders := make([]*order.Order, 0, count)
+		ids := make([]kernel.UUID, 0, count)
+		for range count {
+			o, orderErr := order.NewOrder(kernel.NewUUID(), location, 5)
+			require.NoError(t, orderErr)
+			orderRepo.On("Get", ctx, o.ID()).Return(o, nil).Once()
+			orderRepo.On("Update", ctx, o).Return(nil).Once()
+			orders = append(orders, o)
+			ids = append(ids, o.ID())
+		}
+		return orders, ids
+	}
+
+	t.Run("should cancel matching orders in audited batches", func(t *testing.T) {
+		orderRepo, log, uow, factory := setup(t)
+		cmd, err := commands.NewBulkCancelOrdersCommand("Warehouse closed", order.Created, nil, nil, 150)
+		require.NoError(t, err)
+		first, firstIDs := createdOrders(t, orderRepo, commands.BulkCancellationBatchSize)
+		second, secondIDs := createdOrders(t, orderRepo, 20)
+		orderRepo.On("GetIDsMatching", ctx, cmd.Filter(), commands.BulkCancellationBatchSize).Return(firstIDs, nil).Once()
+		orderRepo.On("GetIDsMatching", ctx, cmd.Filter(), 50).Return(secondIDs, nil).Once()
+		var batches []ports.BulkCancellation
+		log.On("Record", ctx, mock.Anything).Run(func(args mock.Arguments) {
+			batches = append(batches, args.Get(1).(ports.BulkCancellation))
+		}).Return(nil).Twice()
+		uow.On("Commit", ctx).Return(nil).Twice()
+
+		handler := commands.NewBulkCancelOrdersCommandHandler(factory)
+		report, err := handler.Handle(ctx, cmd)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Warehouse closed", report.Reason)
+		assert.Equal(t, append(firstIDs, secondIDs...), report.OrderIDs)
+		assert.Equal(t, 2, report.Batches)
+		assert.False(t, report.LimitReached)
+		for _, o := range append(first, second...) {
+			assert.Equal(t, order.Cancelled, o.Status())
+			assert.Contains(t, o.Events(), order.CancelledEvent{OrderID: o.ID(), Reason: "Warehouse closed"})
+		}
+		require.Len(t, batches, 2)
+		assert.Equal(t, report.ID, batches[0].ID)
+		assert.Equal(t, report.ID, batches[1].ID)
+		assert.Equal(t, 1, batches[0].Batch)
+		assert.Equal(t, 2, batches[1].Batch)
+		assert.Equal(t, firstIDs, batches[0].OrderIDs)
+		assert.Equal(t, "Warehouse closed", batches[1].Reason)
+		assert.Equal(t, cmd.Filter(), batches[1].Filter)
+		orderRepo.AssertExpectations(t)
+		log.AssertExpectations(t)
+		uow.AssertExpectations(t)
+	})
+
+	t.Run("should stop at the limit", func(t *testing.T) {
+		orderRepo, log, uow, factory := setup(t)
+		cmd, err := commands.NewBulkCancelOrdersCommand("Warehouse closed", order.Created, nil, nil, 3)
+		require.NoError(t, err)
+		_, ids := createdOrders(t, orderRepo, 3)
+		orderRepo.On("GetIDsMatching", ctx, cmd.Filter(), 3).Return(ids, nil).Once()
+		log.On("Record", ctx, mock.Anything).Return(nil).Once()
+		uow.On("Commit", ctx).Return(nil).Once()
+
+		handler := commands.NewBulkCancelOrdersCommandHandler(factory)
+		report, err := handler.Handle(ctx, cmd)
+
+		require.NoError(t, err)
+		assert.Equal(t, ids, report.OrderIDs)
+		assert.Equal(t, 1, report.Batches)
+		assert.True(t, report.LimitReached)
+		orderRepo.AssertExpectations(t)
+	})
+
+	t.Run("should record nothing when no order matches", func(t *testing.T) {
+		orderRepo, log, uow, factory := setup(t)
+		cmd, err := commands.NewBulkCancelOrdersCommand("Warehouse closed", order.Created, nil, nil, 10)
+		require.NoError(t, err)
+		orderRepo.On("GetIDsMatching", ctx, cmd.Filter(), 10).Return([]kernel.UUID{}, nil).Once()
+		uow.On("Commit", ctx).Return(nil).Once()
+
+		handler := commands.NewBulkCancelOrdersCommandHandler(factory)
+		report, err := handler.Handle(ctx, cmd)
+
+		require.NoError(t, err)
+		assert.Empty(t, report.OrderIDs)
+		assert.Zero(t, report.Batches)
+		assert.False(t, report.LimitReached)
+		log.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should keep the batches committed before a failed one", func(t *testing.T) {
+		orderRepo, log, uow, factory := setup(t)
+		cmd, err := commands.NewBulkCancelOrdersCommand("Warehouse closed", order.Created, nil, nil, 150)
+		require.NoError(t, err)
+		_, firstIDs := createdOrders(t, orderRepo, commands.BulkCancellationBatchSize)
+		missing := kernel.NewUUID()
+		orderRepo.On("GetIDsMatching", ctx, cmd.Filter(), commands.BulkCancellationBatchSize).Return(firstIDs, nil).Once()
+		orderRepo.On("GetIDsMatching", ctx, cmd.Filter(), 50).Return([]kernel.UUID{missing}, nil).Once()
+		orderRepo.On("Get", ctx, missing).Return(nil, errs.NewObjectNotFoundError("order", missing)).Once()
+		log.On("Record", ctx, mock.Anything).Return(nil).Once()
+		uow.On("Commit", ctx).Return(nil).Once()
+		uow.On("Rollback", ctx).Return(nil).Once()
+
+		handler := commands.NewBulkCancelOrdersCommandHandler(factory)
+		report, err := handler.Handle(ctx, cmd)
+
+		require.ErrorIs(t, err, errs.ErrObjectNotFound)
+		assert.Equal(t, firstIDs, report.OrderIDs)
+		assert.Equal(t, 1, report.Batches)
+		log.AssertExpectations(t)
+		uow.AssertExpectations(t)
+	})
+
+	t.Run("should roll the batch back when the audit log fails", func(t *testing.T) {
+		orderRepo, log, uow, factory := setup(t)
+		cmd, err := commands.NewBulkCancelOrdersCommand("Warehouse closed", order.Created, nil, nil, 10)
+		require.NoError(t, err)
+		_, ids := createdOrders(t, orderRepo, 2)
+		orderRepo.On("GetIDsMatching", ctx, cmd.Filter(), 10).Return(ids, nil).Once()
+		logErr := errors.New("audit log unavailable")
+		log.On("Record", ctx, mock.Anything).Return(logErr).Once()
+		uow.On("Rollback", ctx).Return(nil).Once()
+
+		handler := commands.NewBulkCancelOrdersCommandHandler(factory)
+		report, err := handler.Handle(ctx, cmd)
+
+		require.ErrorIs(t, err, logErr)
+		assert.Empty(t, report.OrderIDs)
+		uow.AssertNotCalled(t, "Commit", mock.Anything)
+	})
+
+	t.Run("should refuse unconstructed command", func(t *testing.T) {
+		handler := commands.NewBulkCancelOrdersCommandHandler(new(MockBulkCancellationUoWFactory))
+
+		_, err := handler.Handle(ctx, commands.BulkCancelOrdersCommand{})
+
+		require.ErrorIs(t, err, commands.ErrBulkCancelOrdersCommandIsNotConstructed)
+	})
+}
@@ -0,0 +1,113 @@
+package commands_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBulkCancelOrdersCommand_ValidInput(t *testing.T) {
+	from, err := kernel.NewLocation(1, 1)
+	require.NoError(t, err)
+	to, err := kernel.NewLocation(4, 4)
+	require.NoError(t, err)
+	zone, err := kernel.NewZone(from, to)
+	require.NoError(t, err)
+	createdBefore := time.Date(2025, 3, 1, 12, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+
+	cmd, err := commands.NewBulkCancelOrdersCommand(" Warehouse closed ", order.Created, &zone, &createdBefore, 500)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, "Warehouse closed", cmd.Reason())
+	assert.Equal(t, 500, cmd.Limit())
+	assert.Equal(t, order.Created, cmd.Filter().Status)
+	require.NotNil(t, cmd.Filter().Zone)
+	assert.Equal(t, zone.String(), cmd.Filter().Zone.String())
+	require.NotNil(t, cmd.Filter().CreatedBefore)
+	assert.Equal(t, time.UTC, cmd.Filter().CreatedBefore.Location())
+	assert.True(t, createdBefore.Equal(*cmd.Filter().CreatedBefore))
+}
+
+func TestNewBulkCancelOrdersCommand_WithoutOptionalFilters(t *testing.T) {
+	cmd, err := commands.NewBulkCancelOrdersCommand("Warehouse closed", order.Assigned, nil, nil, 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, order.Assigned, cmd.Filter().Status)
+	assert.Nil(t, cmd.Filter().Zone)
+	assert.Nil(t, cmd.Filter().CreatedBefore)
+}
+
+func TestNewBulkCancelOrdersCommand_InvalidInput(t *testing.T) {
+	t.Run("should require reason", func(t *testing.T) {
+		_, err := commands.NewBulkCancelOrdersCommand("  ", order.Created, nil, nil, 10)
+
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+	})
+
+	t.Run("should refuse long reason", func(t *testing.T) {
+		reason := strings.Repeat("r", commands.MaxBulkCancellationReasonLength+1)
+
+		_, err := commands.NewBulkCancelOrdersCommand(reason, order.Created, nil, nil, 10)
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should refuse final statuses", func(t *testing.T) {
+		for _, status := range []order.Status{order.Completed, order.Cancelled, order.Unknown} {
+			_, err := commands.NewBulkCancelOrdersCommand("Warehouse closed", status, nil, nil, 10)
+
+			var validation *errs.ValidationErrors
+			require.ErrorAs(t, err, &validation)
+			assert.Equal(t, "status", validation.Fields[0].Field)
+		}
+	})
+
+	t.Run("should refuse unconstructed zone", func(t *testing.T) {
+		_, err := commands.NewBulkCancelOrdersCommand("Warehouse closed", order.Created, &kernel.Zone{}, nil, 10)
+
+		require.ErrorIs(t, err, kernel.ErrZoneIsNotConstructed)
+	})
+
+	t.Run("should refuse limit out of range", func(t *testing.T) {
+		for _, limit := range []int{0, commands.MaxBulkCancelledOrders + 1} {
+			_, err := commands.NewBulkCancelOrdersCommand("Warehouse closed", order.Created, nil, nil, limit)
+
+			require.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+		}
+	})
+}
+
+func TestBulkCancelOrdersCommand_NotConstructed(t *testing.T) {
+	require.ErrorIs(t, commands.BulkCancelOrdersCommand{}.Validate(),
+		commands.ErrBulkCancelOrdersCommandIsNotConstructed)
+}
+
+func TestNewCancelTimedOutOrdersCommand(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	cmd, err := commands.NewCancelTimedOutOrdersCommand(30*time.Minute, now)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, "Not dispatched within 30m0s", cmd.Reason())
+	assert.Equal(t, commands.MaxBulkCancelledOrders, cmd.Limit())
+	assert.Equal(t, order.Created, cmd.Filter().Status)
+	assert.Nil(t, cmd.Filter().Zone)
+	require.NotNil(t, cmd.Filter().CreatedBefore)
+	assert.Equal(t, now.Add(-30*time.Minute), *cmd.Filter().CreatedBefore)
+}
+
+func TestNewCancelTimedOutOrdersCommand_InvalidTimeout(t *testing.T) {
+	_, err := commands.NewCancelTimedOutOrdersCommand(0, time.Now())
+
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
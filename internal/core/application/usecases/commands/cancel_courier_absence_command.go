@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrCancelCourierAbsenceCommandIsNotConstructed = errors.New(
+		"CancelCourierAbsenceCommand must be created via NewCancelCourierAbsenceCommand constructor",
+	)
+)
+
+// CancelCourierAbsenceCommand represents operations cancelling a planned absence of a courier.
+//
+// Example:
+//
+//	cmd, err := NewCancelCourierAbsenceCommand(courierID, absenceID)
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewCancelCourierAbsenceCommandHandler(uowFactory)
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    return fmt.Errorf("failed to cancel absence: %w", err)
+//	}
+type CancelCourierAbsenceCommand struct { //nolint:recvcheck //using for validation
+	courierID kernel.UUID
+	absenceID kernel.UUID
+
+	guard guard.ConstructorGuard
+}
+
+// NewCancelCourierAbsenceCommand creates a command to cancel an absence.
+// Returns an error if an ID is invalid.
+func NewCancelCourierAbsenceCommand(courierID, absenceID kernel.UUID) (CancelCourierAbsenceCommand, error) {
+	if err := errs.JoinFields(
+		errs.Field("courierId", courierID.Validate()),
+		errs.Field("absenceId", absenceID.Validate()),
+	); err != nil {
+		return CancelCourierAbsenceCommand{}, err
+	}
+
+	return CancelCourierAbsenceCommand{
+		courierID: courierID,
+		absenceID: absenceID,
+		guard:     guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrCancelCourierAbsenceCommandIsNotConstructed if validation fails.
+func (c CancelCourierAbsenceCommand) Validate() error {
+	return c.guard.Validate(ErrCancelCourierAbsenceCommandIsNotConstructed)
+}
+
+// CourierID returns the ID of the courier whose absence is cancelled.
+func (c CancelCourierAbsenceCommand) CourierID() kernel.UUID {
+	return c.courierID
+}
+
+// AbsenceID returns the ID of the absence to cancel.
+func (c CancelCourierAbsenceCommand) AbsenceID() kernel.UUID {
+	return c.absenceID
+}
@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/pkg/tracing"
+)
+
+// CancelCourierAbsenceCommandHandler removes planned absences of couriers.
+// Cancelling an active absence makes the courier available for dispatch again; orders already
+// handed over stay with the substitute.
+//
+// Example:
+//
+//	handler := NewCancelCourierAbsenceCommandHandler(uowFactory)
+//	cmd, _ := NewCancelCourierAbsenceCommand(courierID, absenceID)
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    log.Printf("Failed to cancel absence: %v", err)
+//	}
+type CancelCourierAbsenceCommandHandler struct {
+	uowFactory CourierUoWFactory
+}
+
+// NewCancelCourierAbsenceCommandHandler creates a new handler for cancelling absences.
+// Requires a CourierUoWFactory for transactional operations.
+func NewCancelCourierAbsenceCommandHandler(uowFactory CourierUoWFactory) CancelCourierAbsenceCommandHandler {
+	return CancelCourierAbsenceCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the CancelCourierAbsenceCommand within a transaction.
+// Returns courier.ErrAbsenceNotFound if the courier has no such absence.
+func (h *CancelCourierAbsenceCommandHandler) Handle(ctx context.Context, cmd CancelCourierAbsenceCommand) error {
+	ctx, span := tracing.Start(ctx, "CancelCourierAbsenceCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+
+	uow := h.uowFactory.Create()
+	return uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		courierEntity, err := courierRepo.Get(ctx, cmd.CourierID())
+		if err != nil {
+			return err
+		}
+
+		if err = courierEntity.CancelAbsence(cmd.AbsenceID()); err != nil {
+			return err
+		}
+
+		return courierRepo.Update(ctx, courierEntity)
+	})
+}
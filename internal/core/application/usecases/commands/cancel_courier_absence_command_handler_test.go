@@ -0,0 +1,72 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelCourierAbsenceCommandHandler_Handle_Success(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+	now := time.Now()
+	absence, err := courier.NewAbsence(kernel.NewUUID(), now.Add(-time.Hour), now.Add(time.Hour), kernel.NewUUID())
+	require.NoError(t, err)
+	require.NoError(t, courierEntity.PlanAbsence(absence, now))
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+
+	mockFactory.On("Create").Return(mockUoW)
+	mockUoW.On("Begin", ctx).Return(nil)
+	mockUoW.On("CourierRepository").Return(mockRepo)
+	mockUoW.On("Commit", ctx).Return(nil)
+	mockUoW.On("Rollback", ctx).Return(nil).Maybe()
+	mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil)
+	mockRepo.On("Update", ctx, courierEntity).Return(nil).Once()
+
+	cmd, err := commands.NewCancelCourierAbsenceCommand(courierEntity.ID(), absence.ID())
+	require.NoError(t, err)
+
+	handler := commands.NewCancelCourierAbsenceCommandHandler(mockFactory)
+	require.NoError(t, handler.Handle(ctx, cmd))
+
+	assert.Empty(t, courierEntity.Absences())
+	assert.False(t, courierEntity.IsAbsent(now))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCancelCourierAbsenceCommandHandler_Handle_AbsenceNotFound(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+
+	mock.InOrder(
+		mockFactory.On("Create").Return(mockUoW).Once(),
+		mockUoW.On("Begin", ctx).Return(nil).Once(),
+		mockUoW.On("CourierRepository").Return(mockRepo).Once(),
+		mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil).Once(),
+		mockUoW.On("Rollback", ctx).Return(nil).Once(),
+	)
+
+	cmd, err := commands.NewCancelCourierAbsenceCommand(courierEntity.ID(), kernel.NewUUID())
+	require.NoError(t, err)
+
+	handler := commands.NewCancelCourierAbsenceCommandHandler(mockFactory)
+	err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, courier.ErrAbsenceNotFound)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mockUoW.AssertNotCalled(t, "Commit", mock.Anything)
+}
@@ -0,0 +1,34 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCancelCourierAbsenceCommand_ValidInput(t *testing.T) {
+	courierID, absenceID := kernel.NewUUID(), kernel.NewUUID()
+
+	cmd, err := commands.NewCancelCourierAbsenceCommand(courierID, absenceID)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, courierID, cmd.CourierID())
+	assert.Equal(t, absenceID, cmd.AbsenceID())
+}
+
+func TestNewCancelCourierAbsenceCommand_InvalidID(t *testing.T) {
+	_, err := commands.NewCancelCourierAbsenceCommand(kernel.UUID{}, kernel.NewUUID())
+
+	require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+}
+
+func TestCancelCourierAbsenceCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.CancelCourierAbsenceCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrCancelCourierAbsenceCommandIsNotConstructed)
+}
@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrCancelCourierMaintenanceCommandIsNotConstructed = errors.New(
+		"CancelCourierMaintenanceCommand must be created via NewCancelCourierMaintenanceCommand constructor",
+	)
+)
+
+// CancelCourierMaintenanceCommand represents operations removing a courier's maintenance window.
+//
+// Example:
+//
+//	cmd, err := NewCancelCourierMaintenanceCommand(courierID, windowID)
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewCancelCourierMaintenanceCommandHandler(uowFactory)
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    return fmt.Errorf("failed to cancel maintenance: %w", err)
+//	}
+type CancelCourierMaintenanceCommand struct { //nolint:recvcheck //using for validation
+	courierID kernel.UUID
+	windowID  kernel.UUID
+
+	guard guard.ConstructorGuard
+}
+
+// NewCancelCourierMaintenanceCommand creates a command to cancel a maintenance window.
+// Returns an error if an ID is invalid.
+func NewCancelCourierMaintenanceCommand(courierID, windowID kernel.UUID) (CancelCourierMaintenanceCommand, error) {
+	if err := errs.JoinFields(
+		errs.Field("courierId", courierID.Validate()),
+		errs.Field("windowId", windowID.Validate()),
+	); err != nil {
+		return CancelCourierMaintenanceCommand{}, err
+	}
+
+	return CancelCourierMaintenanceCommand{
+		courierID: courierID,
+		windowID:  windowID,
+		guard:     guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrCancelCourierMaintenanceCommandIsNotConstructed if validation fails.
+func (c CancelCourierMaintenanceCommand) Validate() error {
+	return c.guard.Validate(ErrCancelCourierMaintenanceCommandIsNotConstructed)
+}
+
+// CourierID returns the ID of the courier whose maintenance is cancelled.
+func (c CancelCourierMaintenanceCommand) CourierID() kernel.UUID {
+	return c.courierID
+}
+
+// WindowID returns the ID of the maintenance window to cancel.
+func (c CancelCourierMaintenanceCommand) WindowID() kernel.UUID {
+	return c.windowID
+}
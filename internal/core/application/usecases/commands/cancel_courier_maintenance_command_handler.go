@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/pkg/tracing"
+)
+
+// CancelCourierMaintenanceCommandHandler removes maintenance windows of couriers' vehicles.
+// Cancelling an active window makes the courier available for dispatch again.
+//
+// Example:
+//
+//	handler := NewCancelCourierMaintenanceCommandHandler(uowFactory)
+//	cmd, _ := NewCancelCourierMaintenanceCommand(courierID, windowID)
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    log.Printf("Failed to cancel maintenance: %v", err)
+//	}
+type CancelCourierMaintenanceCommandHandler struct {
+	uowFactory CourierUoWFactory
+}
+
+// NewCancelCourierMaintenanceCommandHandler creates a new handler for cancelling maintenance windows.
+// Requires a CourierUoWFactory for transactional operations.
+func NewCancelCourierMaintenanceCommandHandler(uowFactory CourierUoWFactory) CancelCourierMaintenanceCommandHandler {
+	return CancelCourierMaintenanceCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the CancelCourierMaintenanceCommand within a transaction.
+// Returns courier.ErrMaintenanceWindowNotFound if the courier has no such window.
+func (h *CancelCourierMaintenanceCommandHandler) Handle(ctx context.Context, cmd CancelCourierMaintenanceCommand) error {
+	ctx, span := tracing.Start(ctx, "CancelCourierMaintenanceCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+
+	uow := h.uowFactory.Create()
+	return uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		courierEntity, err := courierRepo.Get(ctx, cmd.CourierID())
+		if err != nil {
+			return err
+		}
+
+		if err = courierEntity.CancelMaintenance(cmd.WindowID()); err != nil {
+			return err
+		}
+
+		return courierRepo.Update(ctx, courierEntity)
+	})
+}
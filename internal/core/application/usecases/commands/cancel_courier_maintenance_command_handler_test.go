@@ -0,0 +1,80 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelCourierMaintenanceCommandHandler_Handle_Success(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+	now := time.Now()
+	window, err := courier.NewMaintenanceWindow(kernel.NewUUID(), now.Add(-time.Hour), now.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, courierEntity.ScheduleMaintenance(window, now))
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+
+	mockFactory.On("Create").Return(mockUoW)
+	mockUoW.On("Begin", ctx).Return(nil)
+	mockUoW.On("CourierRepository").Return(mockRepo)
+	mockUoW.On("Commit", ctx).Return(nil)
+	mockUoW.On("Rollback", ctx).Return(nil).Maybe()
+	mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil)
+	mockRepo.On("Update", ctx, courierEntity).Return(nil).Once()
+
+	cmd, err := commands.NewCancelCourierMaintenanceCommand(courierEntity.ID(), window.ID())
+	require.NoError(t, err)
+
+	handler := commands.NewCancelCourierMaintenanceCommandHandler(mockFactory)
+	require.NoError(t, handler.Handle(ctx, cmd))
+
+	assert.Empty(t, courierEntity.MaintenanceWindows())
+	assert.False(t, courierEntity.IsUnderMaintenance(now))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCancelCourierMaintenanceCommandHandler_Handle_WindowNotFound(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+
+	mock.InOrder(
+		mockFactory.On("Create").Return(mockUoW).Once(),
+		mockUoW.On("Begin", ctx).Return(nil).Once(),
+		mockUoW.On("CourierRepository").Return(mockRepo).Once(),
+		mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil).Once(),
+		mockUoW.On("Rollback", ctx).Return(nil).Once(),
+	)
+
+	cmd, err := commands.NewCancelCourierMaintenanceCommand(courierEntity.ID(), kernel.NewUUID())
+	require.NoError(t, err)
+
+	handler := commands.NewCancelCourierMaintenanceCommandHandler(mockFactory)
+	err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, courier.ErrMaintenanceWindowNotFound)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mockUoW.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+func TestCancelCourierMaintenanceCommandHandler_Handle_ValidationError(t *testing.T) {
+	handler := commands.NewCancelCourierMaintenanceCommandHandler(new(MockCourierUoWFactory))
+
+	err := handler.Handle(t.Context(), commands.CancelCourierMaintenanceCommand{})
+
+	require.ErrorIs(t, err, commands.ErrCancelCourierMaintenanceCommandIsNotConstructed)
+}
@@ -0,0 +1,34 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCancelCourierMaintenanceCommand_ValidInput(t *testing.T) {
+	courierID, windowID := kernel.NewUUID(), kernel.NewUUID()
+
+	cmd, err := commands.NewCancelCourierMaintenanceCommand(courierID, windowID)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, courierID, cmd.CourierID())
+	assert.Equal(t, windowID, cmd.WindowID())
+}
+
+func TestNewCancelCourierMaintenanceCommand_InvalidID(t *testing.T) {
+	_, err := commands.NewCancelCourierMaintenanceCommand(kernel.UUID{}, kernel.NewUUID())
+
+	require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+}
+
+func TestCancelCourierMaintenanceCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.CancelCourierMaintenanceCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrCancelCourierMaintenanceCommandIsNotConstructed)
+}
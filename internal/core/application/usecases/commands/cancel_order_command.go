@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrCancelOrderCommandIsNotConstructed = errors.New(
+		"CancelOrderCommand must be created via NewCancelOrderCommand constructor",
+	)
+)
+
+// CancelOrderCommand represents a request to call off an order that was not delivered yet.
+//
+// Example:
+//
+//	cmd, err := NewCancelOrderCommand(orderID)
+//	if err != nil {
+//	    return fmt.Errorf("invalid cancellation: %w", err)
+//	}
+//
+//	handler := NewCancelOrderCommandHandler(uowFactory)
+//	if err := handler.Handle(ctx, cmd); errors.Is(err, order.ErrOrderIsCompleted) {
+//	    // The order was delivered in the meantime
+//	}
+type CancelOrderCommand struct { //nolint:recvcheck //using for validation
+	orderID kernel.UUID
+
+	guard guard.ConstructorGuard
+}
+
+// NewCancelOrderCommand creates a command to cancel the order with the given ID.
+// Validates that the order ID is valid.
+func NewCancelOrderCommand(orderID kernel.UUID) (CancelOrderCommand, error) {
+	command := CancelOrderCommand{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := errs.JoinFields(
+		errs.Field("orderId", command.setOrderID(orderID)),
+	); err != nil {
+		return CancelOrderCommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrCancelOrderCommandIsNotConstructed if validation fails.
+func (c CancelOrderCommand) Validate() error {
+	return c.guard.Validate(ErrCancelOrderCommandIsNotConstructed)
+}
+
+// OrderID returns the ID of the order to cancel.
+func (c CancelOrderCommand) OrderID() kernel.UUID {
+	return c.orderID
+}
+
+func (c *CancelOrderCommand) setOrderID(orderID kernel.UUID) error {
+	if err := orderID.Validate(); err != nil {
+		return err
+	}
+
+	c.orderID = orderID
+	return nil
+}
@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tracing"
+)
+
+// CancelOrderCommandHandler calls off orders that were not delivered yet.
+// An order cancelled on the way leaves its courier, whose storage place is freed in the same
+// transaction together with the order's warehouse pickup slot booking; the cancellation is
+// announced with an OrderCancelled event.
+//
+// Example:
+//
+//	handler := NewCancelOrderCommandHandler(uowFactory)
+//	cmd, _ := NewCancelOrderCommand(orderID)
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    return fmt.Errorf("failed to cancel order: %w", err)
+//	}
+type CancelOrderCommandHandler struct {
+	uowFactory UoWFactory
+}
+
+// NewCancelOrderCommandHandler creates a new handler for order cancellations.
+// Requires a UoWFactory for coordinating updates across order and courier repositories.
+func NewCancelOrderCommandHandler(uowFactory UoWFactory) CancelOrderCommandHandler {
+	return CancelOrderCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the CancelOrderCommand within a transaction.
+// Returns an ObjectNotFoundError for unknown orders, order.ErrOrderIsCompleted for delivered
+// orders and order.ErrOrderIsCancelled for orders that are already cancelled.
+func (h *CancelOrderCommandHandler) Handle(ctx context.Context, cmd CancelOrderCommand) error {
+	ctx, span := tracing.Start(ctx, "CancelOrderCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+
+	uow := h.uowFactory.Create()
+	return uow.Do(ctx, func(ctx context.Context) error {
+		return cancelOrder(ctx, uow, cmd.OrderID(), "")
+	})
+}
+
+// cancelOrder cancels the order within the transaction of uow, freeing the storage place of its
+// courier and its pickup slot booking. Bulk cancellations cancel each order of a batch with it,
+// passing their reason on to the customer; an empty reason cancels the order without one.
+func cancelOrder(ctx context.Context, uow UoW, orderID kernel.UUID, reason string) error {
+	orderRepo := uow.OrderRepository()
+	orderAggregate, err := orderRepo.Get(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	courierID := orderAggregate.Courier()
+	if reason == "" {
+		err = orderAggregate.Cancel()
+	} else {
+		err = orderAggregate.CancelWithReason(reason)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = releasePickupSlot(ctx, uow, orderAggregate.ID()); err != nil {
+		return err
+	}
+
+	if courierID != nil {
+		courierRepo := uow.CourierRepository()
+		courierAggregate, courierErr := courierRepo.Get(ctx, *courierID)
+		if courierErr != nil {
+			return courierErr
+		}
+
+		if err = courierAggregate.ReleaseOrder(orderAggregate.ID()); err != nil {
+			return err
+		}
+
+		if err = courierRepo.Update(ctx, courierAggregate); err != nil {
+			return err
+		}
+	}
+
+	return orderRepo.Update(ctx, orderAggregate)
+}
+
+// releasePickupSlot frees the place the order took in a warehouse pickup slot, if it booked one.
+func releasePickupSlot(ctx context.Context, uow UoW, orderID kernel.UUID) error {
+	slots := uow.PickupSlotRepository()
+	slot, err := slots.GetByOrder(ctx, orderID)
+	if errors.Is(err, errs.ErrObjectNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = slot.Release(orderID); err != nil {
+		return err
+	}
+	return slots.Update(ctx, slot)
+}
@@ -0,0 +1,133 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/pickup"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelOrderCommandHandler_Handle(t *testing.T) {
+	ctx := t.Context()
+
+	setup := func(t *testing.T) (
+		*MockAssignOrderRepository, *MockAssignCourierRepository, *MockPickupSlotRepository, *MockAssignUoW, *MockAssignUoWFactory,
+	) {
+		t.Helper()
+		orderRepo := new(MockAssignOrderRepository)
+		courierRepo := new(MockAssignCourierRepository)
+		slotRepo := new(MockPickupSlotRepository)
+		uow := new(MockAssignUoW)
+		factory := new(MockAssignUoWFactory)
+
+		factory.On("Create").Return(uow).Once()
+		uow.On("Begin", ctx).Return(nil).Once()
+		uow.On("OrderRepository").Return(orderRepo).Once()
+		uow.On("CourierRepository").Return(courierRepo).Maybe()
+		uow.On("PickupSlotRepository").Return(slotRepo).Maybe()
+		return orderRepo, courierRepo, slotRepo, uow, factory
+	}
+
+	t.Run("should cancel created order", func(t *testing.T) {
+		location, err := kernel.NewLocation(5, 5)
+		require.NoError(t, err)
+		o, err := order.NewOrder(kernel.NewUUID(), location, 5)
+		require.NoError(t, err)
+		orderRepo, courierRepo, slotRepo, uow, factory := setup(t)
+		orderRepo.On("Get", ctx, o.ID()).Return(o, nil).Once()
+		slotRepo.On("GetByOrder", ctx, o.ID()).Return(nil, errs.NewObjectNotFoundError("pickup slot", o.ID().String())).Once()
+		orderRepo.On("Update", ctx, o).Return(nil).Once()
+		uow.On("Commit", ctx).Return(nil).Once()
+
+		cmd, err := commands.NewCancelOrderCommand(o.ID())
+		require.NoError(t, err)
+		handler := commands.NewCancelOrderCommandHandler(factory)
+		require.NoError(t, handler.Handle(ctx, cmd))
+
+		assert.Equal(t, order.Cancelled, o.Status())
+		courierRepo.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+		orderRepo.AssertExpectations(t)
+		uow.AssertExpectations(t)
+	})
+
+	t.Run("should free the storage place of the courier", func(t *testing.T) {
+		c := createCourierAt(t, 1, 1)
+		o := createAssignedOrderAt(t, c, 5, 5)
+		orderRepo, courierRepo, slotRepo, uow, factory := setup(t)
+		orderRepo.On("Get", ctx, o.ID()).Return(o, nil).Once()
+		slotRepo.On("GetByOrder", ctx, o.ID()).Return(nil, errs.NewObjectNotFoundError("pickup slot", o.ID().String())).Once()
+		courierRepo.On("Get", ctx, c.ID()).Return(c, nil).Once()
+		courierRepo.On("Update", ctx, c).Return(nil).Once()
+		orderRepo.On("Update", ctx, o).Return(nil).Once()
+		uow.On("Commit", ctx).Return(nil).Once()
+
+		cmd, err := commands.NewCancelOrderCommand(o.ID())
+		require.NoError(t, err)
+		handler := commands.NewCancelOrderCommandHandler(factory)
+		require.NoError(t, handler.Handle(ctx, cmd))
+
+		assert.Equal(t, order.Cancelled, o.Status())
+		assert.Nil(t, o.Courier())
+		assert.Nil(t, c.StoragePlaces()[0].OrderID())
+		courierRepo.AssertExpectations(t)
+		orderRepo.AssertExpectations(t)
+		uow.AssertExpectations(t)
+	})
+
+	t.Run("should release the pickup slot booking", func(t *testing.T) {
+		c := createCourierAt(t, 1, 1)
+		o := createAssignedOrderAt(t, c, 5, 5)
+		startsAt := time.Now().Add(time.Hour)
+		other := kernel.NewUUID()
+		slot, err := pickup.RestoreSlot(kernel.NewUUID(), startsAt, startsAt.Add(30*time.Minute), 2,
+			[]kernel.UUID{o.ID(), other})
+		require.NoError(t, err)
+		orderRepo, courierRepo, slotRepo, uow, factory := setup(t)
+		orderRepo.On("Get", ctx, o.ID()).Return(o, nil).Once()
+		slotRepo.On("GetByOrder", ctx, o.ID()).Return(slot, nil).Once()
+		slotRepo.On("Update", ctx, slot).Return(nil).Once()
+		courierRepo.On("Get", ctx, c.ID()).Return(c, nil).Once()
+		courierRepo.On("Update", ctx, c).Return(nil).Once()
+		orderRepo.On("Update", ctx, o).Return(nil).Once()
+		uow.On("Commit", ctx).Return(nil).Once()
+
+		cmd, err := commands.NewCancelOrderCommand(o.ID())
+		require.NoError(t, err)
+		handler := commands.NewCancelOrderCommandHandler(factory)
+		require.NoError(t, handler.Handle(ctx, cmd))
+
+		assert.False(t, slot.IsBooked(o.ID()))
+		assert.Equal(t, []kernel.UUID{other}, slot.Bookings())
+		assert.Equal(t, 1, slot.Remaining())
+		slotRepo.AssertExpectations(t)
+		orderRepo.AssertExpectations(t)
+		uow.AssertExpectations(t)
+	})
+
+	t.Run("should refuse completed orders", func(t *testing.T) {
+		c := createCourierAt(t, 5, 5)
+		o := createAssignedOrderAt(t, c, 5, 5)
+		require.NoError(t, o.Complete())
+		orderRepo, courierRepo, slotRepo, uow, factory := setup(t)
+		orderRepo.On("Get", ctx, o.ID()).Return(o, nil).Once()
+		uow.On("Rollback", ctx).Return(nil).Once()
+
+		cmd, err := commands.NewCancelOrderCommand(o.ID())
+		require.NoError(t, err)
+		handler := commands.NewCancelOrderCommandHandler(factory)
+		require.ErrorIs(t, handler.Handle(ctx, cmd), order.ErrOrderIsCompleted)
+
+		courierRepo.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+		slotRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		orderRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		uow.AssertNotCalled(t, "Commit", mock.Anything)
+	})
+}
@@ -0,0 +1,33 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCancelOrderCommand_ValidInput(t *testing.T) {
+	orderID := kernel.NewUUID()
+
+	cmd, err := commands.NewCancelOrderCommand(orderID)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, orderID, cmd.OrderID())
+}
+
+func TestNewCancelOrderCommand_InvalidInput(t *testing.T) {
+	_, err := commands.NewCancelOrderCommand(kernel.UUID{})
+
+	require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+}
+
+func TestCancelOrderCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.CancelOrderCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrCancelOrderCommandIsNotConstructed)
+}
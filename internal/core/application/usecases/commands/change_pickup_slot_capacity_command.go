@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrChangePickupSlotCapacityCommandIsNotConstructed = errors.New(
+		"ChangePickupSlotCapacityCommand must be created via NewChangePickupSlotCapacityCommand constructor",
+	)
+)
+
+// ChangePickupSlotCapacityCommand represents dispatch changing how many orders a pickup slot takes,
+// e.g. when fewer staff work the pickup counter than planned.
+//
+// Example:
+//
+//	cmd, err := NewChangePickupSlotCapacityCommand(slotID, 3)
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewChangePickupSlotCapacityCommandHandler(uowFactory)
+//	slot, err := handler.Handle(ctx, cmd)
+//	if errors.Is(err, pickup.ErrCapacityIsBelowBookings) {
+//	    return fmt.Errorf("slot is already booked beyond the new capacity: %w", err)
+//	}
+type ChangePickupSlotCapacityCommand struct { //nolint:recvcheck //using for validation
+	slotID   kernel.UUID
+	capacity int
+
+	guard guard.ConstructorGuard
+}
+
+// NewChangePickupSlotCapacityCommand creates a command to change the capacity of a pickup slot.
+// Returns an error if the slot ID is invalid; the capacity range is enforced by the slot.
+func NewChangePickupSlotCapacityCommand(slotID kernel.UUID, capacity int) (ChangePickupSlotCapacityCommand, error) {
+	command := ChangePickupSlotCapacityCommand{
+		capacity: capacity,
+		guard:    guard.NewConstructorGuard(),
+	}
+
+	if err := command.setSlotID(slotID); err != nil {
+		return ChangePickupSlotCapacityCommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrChangePickupSlotCapacityCommandIsNotConstructed if validation fails.
+func (c ChangePickupSlotCapacityCommand) Validate() error {
+	return c.guard.Validate(ErrChangePickupSlotCapacityCommandIsNotConstructed)
+}
+
+// SlotID returns the ID of the pickup slot to change.
+func (c ChangePickupSlotCapacityCommand) SlotID() kernel.UUID {
+	return c.slotID
+}
+
+// Capacity returns the new number of orders the slot takes.
+func (c ChangePickupSlotCapacityCommand) Capacity() int {
+	return c.capacity
+}
+
+func (c *ChangePickupSlotCapacityCommand) setSlotID(slotID kernel.UUID) error {
+	if err := slotID.Validate(); err != nil {
+		return err
+	}
+
+	c.slotID = slotID
+	return nil
+}
@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/pickup"
+	"delivery/internal/pkg/tracing"
+)
+
+// ChangePickupSlotCapacityCommandHandler changes the capacity of pickup slots.
+// Orders already booked into a slot keep their place, so the capacity never drops below them.
+//
+// Example:
+//
+//	handler := NewChangePickupSlotCapacityCommandHandler(uowFactory)
+//	cmd, _ := NewChangePickupSlotCapacityCommand(slotID, 3)
+//	slot, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    log.Printf("Failed to change pickup slot capacity: %v", err)
+//	}
+type ChangePickupSlotCapacityCommandHandler struct {
+	uowFactory PickupSlotUoWFactory
+}
+
+// NewChangePickupSlotCapacityCommandHandler creates a new handler for pickup slot capacity changes.
+// Requires a PickupSlotUoWFactory for transactional operations.
+func NewChangePickupSlotCapacityCommandHandler(uowFactory PickupSlotUoWFactory) ChangePickupSlotCapacityCommandHandler {
+	return ChangePickupSlotCapacityCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the ChangePickupSlotCapacityCommand within a transaction and returns the slot.
+// Returns errs.ErrObjectNotFound for unknown slots and pickup.ErrCapacityIsBelowBookings
+// when more orders are booked than the new capacity.
+func (h *ChangePickupSlotCapacityCommandHandler) Handle(
+	ctx context.Context,
+	cmd ChangePickupSlotCapacityCommand,
+) (*pickup.Slot, error) {
+	ctx, span := tracing.Start(ctx, "ChangePickupSlotCapacityCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+
+	var slot *pickup.Slot
+	uow := h.uowFactory.Create()
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		slotRepo := uow.PickupSlotRepository()
+		var err error
+		slot, err = slotRepo.Get(ctx, cmd.SlotID())
+		if err != nil {
+			return err
+		}
+
+		if err = slot.ChangeCapacity(cmd.Capacity()); err != nil {
+			return err
+		}
+
+		return slotRepo.Update(ctx, slot)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return slot, nil
+}
@@ -0,0 +1,112 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/pickup"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createPickupSlot(t *testing.T, capacity int, bookings ...kernel.UUID) *pickup.Slot {
+	t.Helper()
+	startsAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	slot, err := pickup.RestoreSlot(kernel.NewUUID(), startsAt, startsAt.Add(30*time.Minute), capacity, bookings)
+	require.NoError(t, err)
+	return slot
+}
+
+func TestChangePickupSlotCapacityCommandHandler_Handle_Success(t *testing.T) {
+	ctx := t.Context()
+	slot := createPickupSlot(t, 2, kernel.NewUUID())
+
+	slotRepo := new(MockPickupSlotRepository)
+	uow := new(MockPickupSlotUoW)
+	factory := new(MockPickupSlotUoWFactory)
+
+	mock.InOrder(
+		factory.On("Create").Return(uow).Once(),
+		uow.On("Begin", ctx).Return(nil).Once(),
+		uow.On("PickupSlotRepository").Return(slotRepo).Once(),
+		slotRepo.On("Get", ctx, slot.ID()).Return(slot, nil).Once(),
+		slotRepo.On("Update", ctx, slot).Return(nil).Once(),
+		uow.On("Commit", ctx).Return(nil).Once(),
+	)
+
+	cmd, err := commands.NewChangePickupSlotCapacityCommand(slot.ID(), 4)
+	require.NoError(t, err)
+
+	handler := commands.NewChangePickupSlotCapacityCommandHandler(factory)
+	changed, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, changed.Capacity())
+	assert.Equal(t, 3, changed.Remaining())
+	slotRepo.AssertExpectations(t)
+	uow.AssertExpectations(t)
+}
+
+func TestChangePickupSlotCapacityCommandHandler_Handle_BelowBookings(t *testing.T) {
+	ctx := t.Context()
+	slot := createPickupSlot(t, 2, kernel.NewUUID(), kernel.NewUUID())
+
+	slotRepo := new(MockPickupSlotRepository)
+	uow := new(MockPickupSlotUoW)
+	factory := new(MockPickupSlotUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("PickupSlotRepository").Return(slotRepo).Once()
+	slotRepo.On("Get", ctx, slot.ID()).Return(slot, nil).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewChangePickupSlotCapacityCommand(slot.ID(), 1)
+	require.NoError(t, err)
+
+	handler := commands.NewChangePickupSlotCapacityCommandHandler(factory)
+	_, err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, pickup.ErrCapacityIsBelowBookings)
+	assert.Equal(t, 2, slot.Capacity())
+	slotRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	uow.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+func TestChangePickupSlotCapacityCommandHandler_Handle_SlotNotFound(t *testing.T) {
+	ctx := t.Context()
+	slotID := kernel.NewUUID()
+
+	slotRepo := new(MockPickupSlotRepository)
+	uow := new(MockPickupSlotUoW)
+	factory := new(MockPickupSlotUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("PickupSlotRepository").Return(slotRepo).Once()
+	slotRepo.On("Get", ctx, slotID).Return(nil, errs.NewObjectNotFoundError("pickup slot", slotID)).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewChangePickupSlotCapacityCommand(slotID, 3)
+	require.NoError(t, err)
+
+	handler := commands.NewChangePickupSlotCapacityCommandHandler(factory)
+	_, err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, errs.ErrObjectNotFound)
+}
+
+func TestChangePickupSlotCapacityCommandHandler_Handle_NotConstructedCommand(t *testing.T) {
+	factory := new(MockPickupSlotUoWFactory)
+
+	handler := commands.NewChangePickupSlotCapacityCommandHandler(factory)
+	_, err := handler.Handle(t.Context(), commands.ChangePickupSlotCapacityCommand{})
+
+	require.ErrorIs(t, err, commands.ErrChangePickupSlotCapacityCommandIsNotConstructed)
+	factory.AssertNotCalled(t, "Create")
+}
@@ -0,0 +1,34 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewChangePickupSlotCapacityCommand_ValidInput(t *testing.T) {
+	slotID := kernel.NewUUID()
+
+	cmd, err := commands.NewChangePickupSlotCapacityCommand(slotID, 3)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, slotID, cmd.SlotID())
+	assert.Equal(t, 3, cmd.Capacity())
+}
+
+func TestNewChangePickupSlotCapacityCommand_InvalidID(t *testing.T) {
+	_, err := commands.NewChangePickupSlotCapacityCommand(kernel.UUID{}, 3)
+
+	require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+}
+
+func TestChangePickupSlotCapacityCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.ChangePickupSlotCapacityCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrChangePickupSlotCapacityCommandIsNotConstructed)
+}
@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/surge"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrChangeSurgeModeCommandIsNotConstructed = errors.New(
+		"ChangeSurgeModeCommand must be created via NewChangeSurgeModeCommand constructor",
+	)
+)
+
+// ChangeSurgeModeCommand represents a dispatcher's request to force the surge mode on or off,
+// or to hand it back to the backlog, together with the reason recorded in the audit trail.
+//
+// Example:
+//
+//	cmd, err := NewChangeSurgeModeCommand("on", "Stadium concert ends at 22:00")
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewChangeSurgeModeCommandHandler(surges, loadReader, policy, uowFactory, notifier)
+//	change, err := handler.Handle(ctx, cmd)
+type ChangeSurgeModeCommand struct { //nolint:recvcheck //using for validation
+	setting surge.Setting
+	reason  string
+
+	guard guard.ConstructorGuard
+}
+
+// NewChangeSurgeModeCommand creates a command to change the surge mode.
+// The setting is one of "auto", "on" or "off"; the reason is validated when the change is made.
+func NewChangeSurgeModeCommand(setting string, reason string) (ChangeSurgeModeCommand, error) {
+	parsed, err := surge.ParseSetting(setting)
+	if err = errs.JoinFields(errs.Field("setting", err)); err != nil {
+		return ChangeSurgeModeCommand{}, err
+	}
+
+	return ChangeSurgeModeCommand{setting: parsed, reason: reason, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrChangeSurgeModeCommandIsNotConstructed if validation fails.
+func (c ChangeSurgeModeCommand) Validate() error {
+	return c.guard.Validate(ErrChangeSurgeModeCommandIsNotConstructed)
+}
+
+// Setting returns the requested surge setting.
+func (c ChangeSurgeModeCommand) Setting() surge.Setting {
+	return c.setting
+}
+
+// Reason returns why the dispatcher changes the surge mode.
+func (c ChangeSurgeModeCommand) Reason() string {
+	return c.reason
+}
@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/surge"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tracing"
+)
+
+// ChangeSurgeModeCommandHandler applies a dispatcher's change of the surge mode, records it in
+// the audit trail and, when the change activates the surge mode, invites the off-shift couriers
+// to start a shift for the surge bonus.
+//
+// Setting the mode back to auto evaluates the current backlog right away, so the surge mode
+// stays on if the backlog still calls for it.
+//
+// Example:
+//
+//	handler := NewChangeSurgeModeCommandHandler(surges, loadReader, policy, uowFactory, notifier)
+//	cmd, _ := NewChangeSurgeModeCommand("on", "Stadium concert ends at 22:00")
+//	change, err := handler.Handle(ctx, cmd)
+//	if err == nil {
+//	    log.Printf("surge active: %t, %d couriers invited", change.Toggle.Active(), change.Invited)
+//	}
+type ChangeSurgeModeCommandHandler struct {
+	surges      ports.SurgeRepository
+	loadReader  ports.FleetLoadReader
+	policy      surge.Policy
+	invitations surgeInvitations
+}
+
+// NewChangeSurgeModeCommandHandler creates a handler for manual surge mode changes.
+// Requires the repository of the audit trail, the fleet load for the backlog, the surge policy,
+// a CourierUoWFactory to find the off-shift couriers and the notifier that reaches them.
+func NewChangeSurgeModeCommandHandler(
+	surges ports.SurgeRepository,
+	loadReader ports.FleetLoadReader,
+	policy surge.Policy,
+	uowFactory CourierUoWFactory,
+	notifier ports.CourierNotifier,
+) ChangeSurgeModeCommandHandler {
+	return ChangeSurgeModeCommandHandler{
+		surges:      surges,
+		loadReader:  loadReader,
+		policy:      policy,
+		invitations: surgeInvitations{uowFactory: uowFactory, notifier: notifier},
+	}
+}
+
+// Handle changes the surge mode and returns the recorded toggle with the outcome of the
+// invitations. Every change is recorded, including one that leaves the mode as it was.
+// The toggle stays recorded if the off-shift couriers cannot be read afterwards.
+// Returns a validation error if the reason is blank or too long.
+func (h ChangeSurgeModeCommandHandler) Handle(ctx context.Context, cmd ChangeSurgeModeCommand) (SurgeModeChange, error) {
+	ctx, span := tracing.Start(ctx, "ChangeSurgeModeCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return SurgeModeChange{}, err
+	}
+
+	load, err := h.loadReader.GetFleetLoad(ctx)
+	if err != nil {
+		return SurgeModeChange{}, err
+	}
+
+	mode, err := h.surges.GetMode(ctx)
+	if err != nil {
+		return SurgeModeChange{}, err
+	}
+
+	toggle, err := mode.Change(kernel.NewUUID(), cmd.Setting(), load.QueuedOrders, h.policy, cmd.Reason(), time.Now())
+	if err != nil {
+		return SurgeModeChange{}, err
+	}
+
+	if err = h.surges.AddToggle(ctx, toggle); err != nil {
+		return SurgeModeChange{}, err
+	}
+
+	return h.invitations.send(ctx, toggle)
+}
@@ -0,0 +1,153 @@
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/surge"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/i18n"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockSurgeRepository is a mock for ports.SurgeRepository.
+type MockSurgeRepository struct{ mock.Mock }
+
+func (m *MockSurgeRepository) GetMode(ctx context.Context) (surge.Mode, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(surge.Mode), args.Error(1)
+}
+
+func (m *MockSurgeRepository) AddToggle(ctx context.Context, toggle surge.Toggle) error {
+	args := m.Called(ctx, toggle)
+	return args.Error(0)
+}
+
+func surgePolicy(t *testing.T) surge.Policy {
+	t.Helper()
+	policy, err := surge.NewPolicy(100, 15*time.Minute)
+	require.NoError(t, err)
+	return policy
+}
+
+func activeSurgeMode(t *testing.T) surge.Mode {
+	t.Helper()
+	toggle, err := surge.DefaultMode().Change(kernel.NewUUID(), surge.ForcedOn, 0, surgePolicy(t), "Rain", time.Now())
+	require.NoError(t, err)
+	return toggle.Mode()
+}
+
+func expectCouriersOffShift(ctx context.Context, couriers []*courier.Courier) *MockCourierUoWFactory {
+	repo := new(MockCourierRepository)
+	uow := new(MockCourierUoW)
+	factory := new(MockCourierUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("CourierRepository").Return(repo).Once()
+	repo.On("GetAllOffShift", ctx).Return(couriers, nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	return factory
+}
+
+func changeSurgeMode(t *testing.T, setting string, reason string) commands.ChangeSurgeModeCommand {
+	t.Helper()
+	cmd, err := commands.NewChangeSurgeModeCommand(setting, reason)
+	require.NoError(t, err)
+	return cmd
+}
+
+func TestChangeSurgeModeCommandHandler_Handle_ActivationInvitesOffShiftCouriers(t *testing.T) {
+	ctx := t.Context()
+	location, _ := kernel.NewLocation(1, 1)
+	russian := createCourierAt(t, 1, 1)
+	english, _ := courier.NewCourierWithLanguage(kernel.NewUUID(), "Courier", 2, location, i18n.English)
+	unreachable := createCourierAt(t, 2, 2)
+	factory := expectCouriersOffShift(ctx, []*courier.Courier{russian, english, unreachable})
+
+	loadReader := new(MockFleetLoadReader)
+	loadReader.On("GetFleetLoad", ctx).Return(ports.FleetLoad{QueuedOrders: 40, FreeCouriers: 1}, nil).Once()
+	surges := new(MockSurgeRepository)
+	surges.On("GetMode", ctx).Return(surge.DefaultMode(), nil).Once()
+	surges.On("AddToggle", ctx, mock.MatchedBy(func(toggle surge.Toggle) bool {
+		return toggle.Source() == surge.Manual && toggle.Activated() &&
+			toggle.Backlog() == 40 && toggle.Reason() == "Stadium concert"
+	})).Return(nil).Once()
+
+	notifier := new(MockCourierNotifier)
+	notifier.On("NotifyCourier", ctx, russian.ID(), mock.MatchedBy(func(n ports.CourierNotification) bool {
+		return n.Text == i18n.Translate(i18n.Russian, i18n.SurgeBonusInvitation)
+	})).Return(nil).Once()
+	notifier.On("NotifyCourier", ctx, english.ID(), mock.MatchedBy(func(n ports.CourierNotification) bool {
+		return n.Text == i18n.Translate(i18n.English, i18n.SurgeBonusInvitation)
+	})).Return(nil).Once()
+	notifier.On("NotifyCourier", ctx, unreachable.ID(), mock.Anything).Return(errors.New("push rejected")).Once()
+
+	handler := commands.NewChangeSurgeModeCommandHandler(surges, loadReader, surgePolicy(t), factory, notifier)
+	change, err := handler.Handle(ctx, changeSurgeMode(t, "on", "Stadium concert"))
+
+	require.NoError(t, err)
+	assert.True(t, change.Toggle.Active())
+	assert.Equal(t, 2, change.Invited)
+	assert.Equal(t, 1, change.Uninvited)
+	surges.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+}
+
+func TestChangeSurgeModeCommandHandler_Handle_DeactivationInvitesNobody(t *testing.T) {
+	ctx := t.Context()
+	factory := new(MockCourierUoWFactory)
+	notifier := new(MockCourierNotifier)
+
+	loadReader := new(MockFleetLoadReader)
+	loadReader.On("GetFleetLoad", ctx).Return(ports.FleetLoad{QueuedOrders: 150}, nil).Once()
+	surges := new(MockSurgeRepository)
+	surges.On("GetMode", ctx).Return(activeSurgeMode(t), nil).Once()
+	surges.On("AddToggle", ctx, mock.MatchedBy(func(toggle surge.Toggle) bool {
+		return toggle.WasActive() && !toggle.Active() && toggle.Setting() == surge.ForcedOff
+	})).Return(nil).Once()
+
+	handler := commands.NewChangeSurgeModeCommandHandler(surges, loadReader, surgePolicy(t), factory, notifier)
+	change, err := handler.Handle(ctx, changeSurgeMode(t, "off", "Bonus budget spent"))
+
+	require.NoError(t, err)
+	assert.False(t, change.Toggle.Active())
+	assert.Zero(t, change.Invited)
+	factory.AssertNotCalled(t, "Create")
+	notifier.AssertNotCalled(t, "NotifyCourier", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestChangeSurgeModeCommandHandler_Handle_BlankReasonIsNotRecorded(t *testing.T) {
+	ctx := t.Context()
+	loadReader := new(MockFleetLoadReader)
+	loadReader.On("GetFleetLoad", ctx).Return(ports.FleetLoad{}, nil).Once()
+	surges := new(MockSurgeRepository)
+	surges.On("GetMode", ctx).Return(surge.DefaultMode(), nil).Once()
+
+	handler := commands.NewChangeSurgeModeCommandHandler(
+		surges, loadReader, surgePolicy(t), new(MockCourierUoWFactory), new(MockCourierNotifier))
+	_, err := handler.Handle(ctx, changeSurgeMode(t, "on", " "))
+
+	require.ErrorIs(t, err, errs.ErrValidationFailed)
+	surges.AssertNotCalled(t, "AddToggle", mock.Anything, mock.Anything)
+}
+
+func TestChangeSurgeModeCommandHandler_Handle_InvalidCommand(t *testing.T) {
+	handler := commands.NewChangeSurgeModeCommandHandler(
+		new(MockSurgeRepository), new(MockFleetLoadReader), surgePolicy(t),
+		new(MockCourierUoWFactory), new(MockCourierNotifier))
+
+	_, err := handler.Handle(t.Context(), commands.ChangeSurgeModeCommand{})
+
+	require.ErrorIs(t, err, commands.ErrChangeSurgeModeCommandIsNotConstructed)
+}
@@ -0,0 +1,36 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/surge"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewChangeSurgeModeCommand_ValidInput(t *testing.T) {
+	cmd, err := commands.NewChangeSurgeModeCommand("on", "Stadium concert")
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, surge.ForcedOn, cmd.Setting())
+	assert.Equal(t, "Stadium concert", cmd.Reason())
+}
+
+func TestNewChangeSurgeModeCommand_InvalidInput(t *testing.T) {
+	_, err := commands.NewChangeSurgeModeCommand("always", "Stadium concert")
+
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	var validation *errs.ValidationErrors
+	require.ErrorAs(t, err, &validation)
+	assert.Equal(t, "setting", validation.Fields[0].Field)
+}
+
+func TestChangeSurgeModeCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.ChangeSurgeModeCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrChangeSurgeModeCommandIsNotConstructed)
+}
@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrCheckFleetCapacityCommandIsNotConstructed = errors.New(
+		"CheckFleetCapacityCommand must be created via NewCheckFleetCapacityCommand constructor",
+	)
+)
+
+// CheckFleetCapacityCommand represents a request to evaluate the fleet capacity breaker
+// before accepting a new order.
+//
+// Example:
+//
+//	cmd := NewCheckFleetCapacityCommand()
+//	decision, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    return fmt.Errorf("capacity check failed: %w", err)
+//	}
+//	if decision.Rejected {
+//	    return ErrFleetCapacityExceeded
+//	}
+type CheckFleetCapacityCommand struct {
+	guard guard.ConstructorGuard
+}
+
+// NewCheckFleetCapacityCommand creates a command to evaluate the fleet capacity breaker.
+// This is a parameterless command; the current load is read from storage.
+func NewCheckFleetCapacityCommand() CheckFleetCapacityCommand {
+	return CheckFleetCapacityCommand{guard: guard.NewConstructorGuard()}
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrCheckFleetCapacityCommandIsNotConstructed if validation fails.
+func (c CheckFleetCapacityCommand) Validate() error {
+	return c.guard.Validate(ErrCheckFleetCapacityCommandIsNotConstructed)
+}
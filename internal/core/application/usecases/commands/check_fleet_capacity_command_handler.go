@@ -0,0 +1,138 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"delivery/internal/core/domain/services"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tenant"
+	"delivery/internal/pkg/tracing"
+)
+
+// CapacityOverflowMode defines how order intake behaves while the fleet is over capacity.
+type CapacityOverflowMode string
+
+const (
+	// RejectOnCapacityOverflow refuses new orders while the breaker is tripped.
+	RejectOnCapacityOverflow CapacityOverflowMode = "reject"
+
+	// WarnOnCapacityOverflow accepts new orders but flags them with a capacity warning.
+	WarnOnCapacityOverflow CapacityOverflowMode = "warn"
+)
+
+// ErrCapacityOverflowModeIsInvalid is returned for unsupported overflow modes.
+var ErrCapacityOverflowModeIsInvalid = errors.New("capacity overflow mode is invalid")
+
+// Validate checks that the mode is one of the supported values.
+func (m CapacityOverflowMode) Validate() error {
+	switch m {
+	case RejectOnCapacityOverflow, WarnOnCapacityOverflow:
+		return nil
+	default:
+		return fmt.Errorf("%w: %q", ErrCapacityOverflowModeIsInvalid, string(m))
+	}
+}
+
+// FleetCapacityDecision is the outcome of a capacity check for a single incoming order.
+type FleetCapacityDecision struct {
+	// Assessment is the fleet load the decision is based on.
+	Assessment services.CapacityAssessment
+	// Rejected reports that the order must not be accepted.
+	Rejected bool
+	// Warning reports that the order may be accepted but the fleet is over capacity.
+	Warning bool
+}
+
+// FleetCapacityObserver is notified whenever the breaker trips or recovers,
+// so that the storefront and monitoring can throttle demand.
+type FleetCapacityObserver interface {
+	FleetCapacityChanged(ctx context.Context, assessment services.CapacityAssessment)
+}
+
+// CheckFleetCapacityCommandHandler evaluates the fleet capacity breaker for order intake.
+//
+// The handler compares queued orders with free couriers. When the ratio exceeds the limit,
+// new orders are rejected or accepted with a warning depending on the configured mode.
+// The observer is notified only on state transitions, not on every check.
+// The breaker state is kept per tenant, so one tenant's overload does not trip another's breaker.
+//
+// Example:
+//
+//	breaker, _ := services.NewCapacityBreaker(5)
+//	handler := NewCheckFleetCapacityCommandHandler(loadReader, breaker, RejectOnCapacityOverflow, observer)
+//	decision, err := handler.Handle(ctx, NewCheckFleetCapacityCommand())
+type CheckFleetCapacityCommandHandler struct {
+	loadReader ports.FleetLoadReader
+	breaker    services.CapacityBreaker
+	mode       CapacityOverflowMode
+	observer   FleetCapacityObserver
+	state      *capacityState
+}
+
+// NewCheckFleetCapacityCommandHandler creates a capacity check handler.
+// Requires a FleetLoadReader, a configured breaker, the overflow mode, and an observer for state changes.
+func NewCheckFleetCapacityCommandHandler(
+	loadReader ports.FleetLoadReader,
+	breaker services.CapacityBreaker,
+	mode CapacityOverflowMode,
+	observer FleetCapacityObserver,
+) CheckFleetCapacityCommandHandler {
+	return CheckFleetCapacityCommandHandler{
+		loadReader: loadReader,
+		breaker:    breaker,
+		mode:       mode,
+		observer:   observer,
+		state:      &capacityState{exceeded: make(map[tenant.ID]bool)},
+	}
+}
+
+// Handle reads the current fleet load and decides whether a new order may be accepted.
+func (h *CheckFleetCapacityCommandHandler) Handle(
+	ctx context.Context,
+	cmd CheckFleetCapacityCommand,
+) (FleetCapacityDecision, error) {
+	ctx, span := tracing.Start(ctx, "CheckFleetCapacityCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return FleetCapacityDecision{}, err
+	}
+
+	load, err := h.loadReader.GetFleetLoad(ctx)
+	if err != nil {
+		return FleetCapacityDecision{}, err
+	}
+
+	assessment := h.breaker.Evaluate(load.QueuedOrders, load.FreeCouriers)
+	tenantID, _ := tenant.FromContext(ctx)
+	if h.state.transition(tenantID, assessment.Exceeded) {
+		h.observer.FleetCapacityChanged(ctx, assessment)
+	}
+
+	decision := FleetCapacityDecision{Assessment: assessment}
+	if assessment.Exceeded {
+		decision.Rejected = h.mode == RejectOnCapacityOverflow
+		decision.Warning = !decision.Rejected
+	}
+
+	return decision, nil
+}
+
+// capacityState remembers for every tenant whether its breaker is currently tripped.
+type capacityState struct {
+	mu       sync.Mutex
+	exceeded map[tenant.ID]bool
+}
+
+// transition records the tenant's new state and reports whether it differs from the previous one.
+func (s *capacityState) transition(id tenant.ID, exceeded bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changed := s.exceeded[id] != exceeded
+	s.exceeded[id] = exceeded
+	return changed
+}
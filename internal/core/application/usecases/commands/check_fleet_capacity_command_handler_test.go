@@ -0,0 +1,149 @@
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tenant"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockFleetLoadReader is a mock for ports.FleetLoadReader.
+type MockFleetLoadReader struct{ mock.Mock }
+
+func (m *MockFleetLoadReader) GetFleetLoad(ctx context.Context) (ports.FleetLoad, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(ports.FleetLoad), args.Error(1)
+}
+
+// MockFleetCapacityObserver records capacity state transitions.
+type MockFleetCapacityObserver struct {
+	changes []services.CapacityAssessment
+}
+
+func (m *MockFleetCapacityObserver) FleetCapacityChanged(_ context.Context, assessment services.CapacityAssessment) {
+	m.changes = append(m.changes, assessment)
+}
+
+func newCapacityHandler(
+	t *testing.T,
+	reader ports.FleetLoadReader,
+	mode commands.CapacityOverflowMode,
+	observer commands.FleetCapacityObserver,
+) commands.CheckFleetCapacityCommandHandler {
+	t.Helper()
+	breaker, err := services.NewCapacityBreaker(2)
+	require.NoError(t, err)
+	return commands.NewCheckFleetCapacityCommandHandler(reader, breaker, mode, observer)
+}
+
+func TestCheckFleetCapacityCommandHandler_Handle_WithinCapacity(t *testing.T) {
+	ctx := t.Context()
+	reader := new(MockFleetLoadReader)
+	observer := &MockFleetCapacityObserver{}
+	reader.On("GetFleetLoad", ctx).Return(ports.FleetLoad{QueuedOrders: 4, FreeCouriers: 2}, nil).Once()
+
+	handler := newCapacityHandler(t, reader, commands.RejectOnCapacityOverflow, observer)
+	decision, err := handler.Handle(ctx, commands.NewCheckFleetCapacityCommand())
+
+	require.NoError(t, err)
+	assert.False(t, decision.Rejected)
+	assert.False(t, decision.Warning)
+	assert.InDelta(t, 2, decision.Assessment.Ratio, 0)
+	assert.Empty(t, observer.changes)
+}
+
+func TestCheckFleetCapacityCommandHandler_Handle_RejectMode(t *testing.T) {
+	ctx := t.Context()
+	reader := new(MockFleetLoadReader)
+	observer := &MockFleetCapacityObserver{}
+	reader.On("GetFleetLoad", ctx).Return(ports.FleetLoad{QueuedOrders: 5, FreeCouriers: 1}, nil)
+
+	handler := newCapacityHandler(t, reader, commands.RejectOnCapacityOverflow, observer)
+	decision, err := handler.Handle(ctx, commands.NewCheckFleetCapacityCommand())
+
+	require.NoError(t, err)
+	assert.True(t, decision.Rejected)
+	assert.False(t, decision.Warning)
+	assert.True(t, decision.Assessment.Exceeded)
+}
+
+func TestCheckFleetCapacityCommandHandler_Handle_WarnMode(t *testing.T) {
+	ctx := t.Context()
+	reader := new(MockFleetLoadReader)
+	reader.On("GetFleetLoad", ctx).Return(ports.FleetLoad{QueuedOrders: 3, FreeCouriers: 0}, nil)
+
+	handler := newCapacityHandler(t, reader, commands.WarnOnCapacityOverflow, &MockFleetCapacityObserver{})
+	decision, err := handler.Handle(ctx, commands.NewCheckFleetCapacityCommand())
+
+	require.NoError(t, err)
+	assert.False(t, decision.Rejected)
+	assert.True(t, decision.Warning)
+}
+
+func TestCheckFleetCapacityCommandHandler_Handle_NotifiesOnlyOnTransitions(t *testing.T) {
+	ctx := t.Context()
+	reader := new(MockFleetLoadReader)
+	observer := &MockFleetCapacityObserver{}
+	mock.InOrder(
+		reader.On("GetFleetLoad", ctx).Return(ports.FleetLoad{QueuedOrders: 9, FreeCouriers: 1}, nil).Twice(),
+		reader.On("GetFleetLoad", ctx).Return(ports.FleetLoad{QueuedOrders: 1, FreeCouriers: 1}, nil).Once(),
+	)
+
+	handler := newCapacityHandler(t, reader, commands.RejectOnCapacityOverflow, observer)
+	for range 3 {
+		_, err := handler.Handle(ctx, commands.NewCheckFleetCapacityCommand())
+		require.NoError(t, err)
+	}
+
+	require.Len(t, observer.changes, 2)
+	assert.True(t, observer.changes[0].Exceeded)
+	assert.False(t, observer.changes[1].Exceeded)
+	reader.AssertExpectations(t)
+}
+
+func TestCheckFleetCapacityCommandHandler_Handle_TenantsKeepSeparateState(t *testing.T) {
+	acmeCtx := tenant.WithID(t.Context(), "acme")
+	globexCtx := tenant.WithID(t.Context(), "globex")
+	reader := new(MockFleetLoadReader)
+	observer := &MockFleetCapacityObserver{}
+	reader.On("GetFleetLoad", acmeCtx).Return(ports.FleetLoad{QueuedOrders: 9, FreeCouriers: 1}, nil)
+	reader.On("GetFleetLoad", globexCtx).Return(ports.FleetLoad{QueuedOrders: 1, FreeCouriers: 1}, nil)
+
+	handler := newCapacityHandler(t, reader, commands.RejectOnCapacityOverflow, observer)
+	for _, ctx := range []context.Context{acmeCtx, globexCtx, acmeCtx, globexCtx} {
+		_, err := handler.Handle(ctx, commands.NewCheckFleetCapacityCommand())
+		require.NoError(t, err)
+	}
+
+	// Only acme tripped its breaker; globex staying within capacity is not a recovery
+	require.Len(t, observer.changes, 1)
+	assert.True(t, observer.changes[0].Exceeded)
+}
+
+func TestCheckFleetCapacityCommandHandler_Handle_ReaderError(t *testing.T) {
+	ctx := t.Context()
+	readErr := errors.New("database unavailable")
+	reader := new(MockFleetLoadReader)
+	reader.On("GetFleetLoad", ctx).Return(ports.FleetLoad{}, readErr).Once()
+
+	handler := newCapacityHandler(t, reader, commands.RejectOnCapacityOverflow, &MockFleetCapacityObserver{})
+	_, err := handler.Handle(ctx, commands.NewCheckFleetCapacityCommand())
+
+	require.ErrorIs(t, err, readErr)
+}
+
+func TestCheckFleetCapacityCommandHandler_Handle_InvalidCommand(t *testing.T) {
+	handler := newCapacityHandler(t, new(MockFleetLoadReader), commands.RejectOnCapacityOverflow, &MockFleetCapacityObserver{})
+
+	_, err := handler.Handle(t.Context(), commands.CheckFleetCapacityCommand{})
+
+	require.ErrorIs(t, err, commands.ErrCheckFleetCapacityCommandIsNotConstructed)
+}
@@ -0,0 +1,27 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCheckFleetCapacityCommand_Valid(t *testing.T) {
+	cmd := commands.NewCheckFleetCapacityCommand()
+
+	require.NoError(t, cmd.Validate())
+}
+
+func TestCheckFleetCapacityCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.CheckFleetCapacityCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrCheckFleetCapacityCommandIsNotConstructed)
+}
+
+func TestCapacityOverflowMode_Validate(t *testing.T) {
+	require.NoError(t, commands.RejectOnCapacityOverflow.Validate())
+	require.NoError(t, commands.WarnOnCapacityOverflow.Validate())
+	require.ErrorIs(t, commands.CapacityOverflowMode("drop").Validate(), commands.ErrCapacityOverflowModeIsInvalid)
+}
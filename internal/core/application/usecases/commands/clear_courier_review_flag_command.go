@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrClearCourierReviewFlagCommandIsNotConstructed = errors.New(
+		"ClearCourierReviewFlagCommand must be created via NewClearCourierReviewFlagCommand constructor",
+	)
+)
+
+// ClearCourierReviewFlagCommand represents operations returning a courier flagged for review to dispatch.
+//
+// Example:
+//
+//	cmd, err := NewClearCourierReviewFlagCommand(courierID)
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewClearCourierReviewFlagCommandHandler(uowFactory)
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    return fmt.Errorf("failed to clear review flag: %w", err)
+//	}
+type ClearCourierReviewFlagCommand struct { //nolint:recvcheck //using for validation
+	courierID kernel.UUID
+
+	guard guard.ConstructorGuard
+}
+
+// NewClearCourierReviewFlagCommand creates a command to clear a courier's review flag.
+// Returns an error if the courier ID is invalid.
+func NewClearCourierReviewFlagCommand(courierID kernel.UUID) (ClearCourierReviewFlagCommand, error) {
+	if err := courierID.Validate(); err != nil {
+		return ClearCourierReviewFlagCommand{}, err
+	}
+
+	return ClearCourierReviewFlagCommand{
+		courierID: courierID,
+		guard:     guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrClearCourierReviewFlagCommandIsNotConstructed if validation fails.
+func (c ClearCourierReviewFlagCommand) Validate() error {
+	return c.guard.Validate(ErrClearCourierReviewFlagCommandIsNotConstructed)
+}
+
+// CourierID returns the ID of the courier whose review flag is cleared.
+func (c ClearCourierReviewFlagCommand) CourierID() kernel.UUID {
+	return c.courierID
+}
@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/pkg/tracing"
+)
+
+// ClearCourierReviewFlagCommandHandler clears the review flag the inactivity watchdog sets on couriers
+// whose orders it unassigned. Flagged couriers receive no orders until operations clear the flag.
+//
+// Example:
+//
+//	handler := NewClearCourierReviewFlagCommandHandler(uowFactory)
+//	cmd, _ := NewClearCourierReviewFlagCommand(courierID)
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    log.Printf("Failed to clear review flag: %v", err)
+//	}
+type ClearCourierReviewFlagCommandHandler struct {
+	uowFactory CourierUoWFactory
+}
+
+// NewClearCourierReviewFlagCommandHandler creates a new handler for clearing review flags.
+// Requires a CourierUoWFactory for transactional operations.
+func NewClearCourierReviewFlagCommandHandler(uowFactory CourierUoWFactory) ClearCourierReviewFlagCommandHandler {
+	return ClearCourierReviewFlagCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the ClearCourierReviewFlagCommand within a transaction.
+// Clearing the flag of a courier who is not flagged succeeds without a change.
+func (h *ClearCourierReviewFlagCommandHandler) Handle(ctx context.Context, cmd ClearCourierReviewFlagCommand) error {
+	ctx, span := tracing.Start(ctx, "ClearCourierReviewFlagCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+
+	uow := h.uowFactory.Create()
+	return uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		courierEntity, err := courierRepo.Get(ctx, cmd.CourierID())
+		if err != nil {
+			return err
+		}
+		if !courierEntity.IsReviewRequired() {
+			return nil
+		}
+
+		courierEntity.ClearReviewFlag()
+
+		return courierRepo.Update(ctx, courierEntity)
+	})
+}
@@ -0,0 +1,72 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClearCourierReviewFlagCommandHandler_Handle_Success(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+	courierEntity.FlagForReview()
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+
+	mock.InOrder(
+		mockFactory.On("Create").Return(mockUoW).Once(),
+		mockUoW.On("Begin", ctx).Return(nil).Once(),
+		mockUoW.On("CourierRepository").Return(mockRepo).Once(),
+		mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil).Once(),
+		mockRepo.On("Update", ctx, courierEntity).Return(nil).Once(),
+		mockUoW.On("Commit", ctx).Return(nil).Once(),
+	)
+	mockUoW.On("Rollback", ctx).Return(nil).Maybe()
+
+	cmd, err := commands.NewClearCourierReviewFlagCommand(courierEntity.ID())
+	require.NoError(t, err)
+
+	handler := commands.NewClearCourierReviewFlagCommandHandler(mockFactory)
+	require.NoError(t, handler.Handle(ctx, cmd))
+
+	assert.False(t, courierEntity.IsReviewRequired())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestClearCourierReviewFlagCommandHandler_Handle_NotFlagged(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+
+	mockFactory.On("Create").Return(mockUoW).Once()
+	mockUoW.On("Begin", ctx).Return(nil).Once()
+	mockUoW.On("CourierRepository").Return(mockRepo).Once()
+	mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil).Once()
+	mockUoW.On("Commit", ctx).Return(nil).Once()
+	mockUoW.On("Rollback", ctx).Return(nil).Maybe()
+
+	cmd, err := commands.NewClearCourierReviewFlagCommand(courierEntity.ID())
+	require.NoError(t, err)
+
+	handler := commands.NewClearCourierReviewFlagCommandHandler(mockFactory)
+	require.NoError(t, handler.Handle(ctx, cmd))
+
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestClearCourierReviewFlagCommandHandler_Handle_ValidationError(t *testing.T) {
+	handler := commands.NewClearCourierReviewFlagCommandHandler(new(MockCourierUoWFactory))
+
+	err := handler.Handle(t.Context(), commands.ClearCourierReviewFlagCommand{})
+
+	require.ErrorIs(t, err, commands.ErrClearCourierReviewFlagCommandIsNotConstructed)
+}
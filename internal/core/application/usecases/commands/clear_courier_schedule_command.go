@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrClearCourierScheduleCommandIsNotConstructed = errors.New(
+		"ClearCourierScheduleCommand must be created via NewClearCourierScheduleCommand constructor",
+	)
+)
+
+// ClearCourierScheduleCommand represents operations removing the weekly working schedule of a courier.
+//
+// Example:
+//
+//	cmd, err := NewClearCourierScheduleCommand(courierID)
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewClearCourierScheduleCommandHandler(uowFactory)
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    return fmt.Errorf("failed to clear schedule: %w", err)
+//	}
+type ClearCourierScheduleCommand struct { //nolint:recvcheck //using for validation
+	courierID kernel.UUID
+
+	guard guard.ConstructorGuard
+}
+
+// NewClearCourierScheduleCommand creates a command to clear a courier's schedule.
+// Returns an error if the courier ID is invalid.
+func NewClearCourierScheduleCommand(courierID kernel.UUID) (ClearCourierScheduleCommand, error) {
+	if err := courierID.Validate(); err != nil {
+		return ClearCourierScheduleCommand{}, err
+	}
+
+	return ClearCourierScheduleCommand{
+		courierID: courierID,
+		guard:     guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrClearCourierScheduleCommandIsNotConstructed if validation fails.
+func (c ClearCourierScheduleCommand) Validate() error {
+	return c.guard.Validate(ErrClearCourierScheduleCommandIsNotConstructed)
+}
+
+// CourierID returns the ID of the courier whose schedule is cleared.
+func (c ClearCourierScheduleCommand) CourierID() kernel.UUID {
+	return c.courierID
+}
@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/pkg/tracing"
+)
+
+// ClearCourierScheduleCommandHandler removes the weekly working schedules of couriers.
+// A courier without a schedule is dispatched whenever on shift.
+//
+// Example:
+//
+//	handler := NewClearCourierScheduleCommandHandler(uowFactory)
+//	cmd, _ := NewClearCourierScheduleCommand(courierID)
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    log.Printf("Failed to clear schedule: %v", err)
+//	}
+type ClearCourierScheduleCommandHandler struct {
+	uowFactory CourierUoWFactory
+}
+
+// NewClearCourierScheduleCommandHandler creates a new handler for clearing schedules.
+// Requires a CourierUoWFactory for transactional operations.
+func NewClearCourierScheduleCommandHandler(uowFactory CourierUoWFactory) ClearCourierScheduleCommandHandler {
+	return ClearCourierScheduleCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the ClearCourierScheduleCommand within a transaction.
+// Clearing the schedule of a courier without one succeeds.
+func (h *ClearCourierScheduleCommandHandler) Handle(ctx context.Context, cmd ClearCourierScheduleCommand) error {
+	ctx, span := tracing.Start(ctx, "ClearCourierScheduleCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+
+	uow := h.uowFactory.Create()
+	return uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		courierEntity, err := courierRepo.Get(ctx, cmd.CourierID())
+		if err != nil {
+			return err
+		}
+
+		courierEntity.ClearSchedule()
+
+		return courierRepo.Update(ctx, courierEntity)
+	})
+}
@@ -0,0 +1,54 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClearCourierScheduleCommandHandler_Handle_Success(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+	window, err := courier.NewScheduleWindow(time.Monday, 9*time.Hour, 17*time.Hour)
+	require.NoError(t, err)
+	schedule, err := courier.NewSchedule(courierEntity.ID(), []courier.ScheduleWindow{window})
+	require.NoError(t, err)
+	require.NoError(t, courierEntity.SetSchedule(schedule))
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+
+	mock.InOrder(
+		mockFactory.On("Create").Return(mockUoW).Once(),
+		mockUoW.On("Begin", ctx).Return(nil).Once(),
+		mockUoW.On("CourierRepository").Return(mockRepo).Once(),
+		mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil).Once(),
+		mockRepo.On("Update", ctx, courierEntity).Return(nil).Once(),
+		mockUoW.On("Commit", ctx).Return(nil).Once(),
+	)
+	mockUoW.On("Rollback", ctx).Return(nil).Maybe()
+
+	cmd, err := commands.NewClearCourierScheduleCommand(courierEntity.ID())
+	require.NoError(t, err)
+
+	handler := commands.NewClearCourierScheduleCommandHandler(mockFactory)
+	require.NoError(t, handler.Handle(ctx, cmd))
+
+	assert.Nil(t, courierEntity.Schedule())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestClearCourierScheduleCommandHandler_Handle_ValidationError(t *testing.T) {
+	handler := commands.NewClearCourierScheduleCommandHandler(new(MockCourierUoWFactory))
+
+	err := handler.Handle(t.Context(), commands.ClearCourierScheduleCommand{})
+
+	require.ErrorIs(t, err, commands.ErrClearCourierScheduleCommandIsNotConstructed)
+}
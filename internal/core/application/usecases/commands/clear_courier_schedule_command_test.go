@@ -0,0 +1,33 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClearCourierScheduleCommand_ValidInput(t *testing.T) {
+	courierID := kernel.NewUUID()
+
+	cmd, err := commands.NewClearCourierScheduleCommand(courierID)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, courierID, cmd.CourierID())
+}
+
+func TestNewClearCourierScheduleCommand_InvalidID(t *testing.T) {
+	_, err := commands.NewClearCourierScheduleCommand(kernel.UUID{})
+
+	require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+}
+
+func TestClearCourierScheduleCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.ClearCourierScheduleCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrClearCourierScheduleCommandIsNotConstructed)
+}
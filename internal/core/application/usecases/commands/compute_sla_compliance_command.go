@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/sla"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrComputeSLAComplianceCommandIsNotConstructed = errors.New(
+		"ComputeSLAComplianceCommand must be created via NewComputeSLAComplianceCommand constructor",
+	)
+)
+
+// ComputeSLAComplianceCommand represents a request to measure how the deliveries completed on
+// a UTC day met the SLA targets, replacing the compliance computed for the day before.
+//
+// Example:
+//
+//	cmd, err := NewComputeSLAComplianceCommand(time.Now().AddDate(0, 0, -1))
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//	compliance, err := handler.Handle(ctx, cmd)
+type ComputeSLAComplianceCommand struct {
+	day time.Time
+
+	guard guard.ConstructorGuard
+}
+
+// NewComputeSLAComplianceCommand creates a command to compute the compliance of the UTC day
+// the moment falls on.
+func NewComputeSLAComplianceCommand(moment time.Time) (ComputeSLAComplianceCommand, error) {
+	if moment.IsZero() {
+		return ComputeSLAComplianceCommand{}, errs.JoinFields(errs.Field("day", errs.NewValueIsRequiredError("day")))
+	}
+	if moment.After(time.Now()) {
+		return ComputeSLAComplianceCommand{}, errs.JoinFields(errs.Field("day", errs.NewValueIsInvalidErrorWithCause(
+			"day is invalid",
+			fmt.Errorf("%s is in the future", moment),
+		)))
+	}
+
+	return ComputeSLAComplianceCommand{day: sla.Day(moment), guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrComputeSLAComplianceCommandIsNotConstructed if validation fails.
+func (c ComputeSLAComplianceCommand) Validate() error {
+	return c.guard.Validate(ErrComputeSLAComplianceCommandIsNotConstructed)
+}
+
+// Day returns the start of the UTC day to compute the compliance of.
+func (c ComputeSLAComplianceCommand) Day() time.Time {
+	return c.day
+}
@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/sla"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tracing"
+)
+
+// ComputeSLAComplianceCommandHandler measures the deliveries completed on a day against the
+// current SLA targets and stores the compliance of every target. It is run nightly by the SLA
+// compliance job for the day before.
+//
+// Example:
+//
+//	handler := NewComputeSLAComplianceCommandHandler(history, slas)
+//	cmd, _ := NewComputeSLAComplianceCommand(time.Now().AddDate(0, 0, -1))
+//	compliance, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    log.Printf("Failed to compute SLA compliance: %v", err)
+//	}
+type ComputeSLAComplianceCommandHandler struct {
+	history ports.DeliveryHistoryReader
+	slas    ports.SLARepository
+}
+
+// NewComputeSLAComplianceCommandHandler creates a handler that reads the completed deliveries
+// from history and the targets and compliance from slas.
+func NewComputeSLAComplianceCommandHandler(
+	history ports.DeliveryHistoryReader,
+	slas ports.SLARepository,
+) ComputeSLAComplianceCommandHandler {
+	return ComputeSLAComplianceCommandHandler{history: history, slas: slas}
+}
+
+// Handle computes and stores the compliance of the day, one per target.
+// Without targets the stored compliance of the day is cleared.
+func (h ComputeSLAComplianceCommandHandler) Handle(
+	ctx context.Context,
+	cmd ComputeSLAComplianceCommand,
+) ([]sla.Compliance, error) {
+	ctx, span := tracing.Start(ctx, "ComputeSLAComplianceCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+
+	targets, err := h.slas.GetTargets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := h.history.GetCompletedDeliveries(ctx, cmd.Day(), cmd.Day().AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	compliance, err := sla.Measure(targets, cmd.Day(), deliveries)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.slas.SaveCompliance(ctx, cmd.Day(), compliance); err != nil {
+		return nil, err
+	}
+
+	return compliance, nil
+}
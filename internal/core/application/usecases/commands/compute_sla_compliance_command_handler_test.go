@@ -0,0 +1,87 @@
+package commands_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/sla"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func slaDelivery(x, y kernel.Coordinate, completedAt time.Time, took time.Duration) sla.Delivery {
+	location, _ := kernel.NewLocation(x, y)
+	return sla.Delivery{
+		Location:    location,
+		Tier:        order.ExpressDelivery,
+		CreatedAt:   completedAt.Add(-took),
+		CompletedAt: completedAt,
+	}
+}
+
+func TestComputeSLAComplianceCommandHandler_Handle_SavesCompliance(t *testing.T) {
+	ctx := t.Context()
+	history := new(MockDeliveryHistoryReader)
+	repository := new(MockSLARepository)
+	day := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	target, err := sla.NewTarget(kernel.NewUUID(), "North", slaZone(1, 1, 5, 5), order.ExpressDelivery, time.Hour, 90)
+	require.NoError(t, err)
+
+	repository.On("GetTargets", ctx).Return([]*sla.Target{target}, nil).Once()
+	history.On("GetCompletedDeliveries", ctx, day, day.AddDate(0, 0, 1)).Return([]sla.Delivery{
+		slaDelivery(2, 2, day.Add(10*time.Hour), 30*time.Minute),
+		slaDelivery(3, 3, day.Add(11*time.Hour), 2*time.Hour),
+		slaDelivery(9, 9, day.Add(12*time.Hour), 2*time.Hour),
+	}, nil).Once()
+	repository.On("SaveCompliance", ctx, day, mock.MatchedBy(func(compliance []sla.Compliance) bool {
+		return len(compliance) == 1 && compliance[0].Delivered() == 2 && compliance[0].OnTime() == 1
+	})).Return(nil).Once()
+
+	cmd, err := commands.NewComputeSLAComplianceCommand(day.Add(23 * time.Hour))
+	require.NoError(t, err)
+
+	handler := commands.NewComputeSLAComplianceCommandHandler(history, repository)
+	compliance, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	require.Len(t, compliance, 1)
+	assert.False(t, compliance[0].IsMet())
+	history.AssertExpectations(t)
+	repository.AssertExpectations(t)
+}
+
+func TestComputeSLAComplianceCommandHandler_Handle_HistoryError(t *testing.T) {
+	ctx := t.Context()
+	history := new(MockDeliveryHistoryReader)
+	repository := new(MockSLARepository)
+	failure := errors.New("connection lost")
+
+	repository.On("GetTargets", ctx).Return([]*sla.Target{}, nil).Once()
+	history.On("GetCompletedDeliveries", ctx, mock.Anything, mock.Anything).Return([]sla.Delivery(nil), failure).Once()
+
+	cmd, err := commands.NewComputeSLAComplianceCommand(time.Now().AddDate(0, 0, -1))
+	require.NoError(t, err)
+
+	handler := commands.NewComputeSLAComplianceCommandHandler(history, repository)
+	_, err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, failure)
+	repository.AssertNotCalled(t, "SaveCompliance", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestComputeSLAComplianceCommandHandler_Handle_InvalidCommand(t *testing.T) {
+	history := new(MockDeliveryHistoryReader)
+	repository := new(MockSLARepository)
+
+	handler := commands.NewComputeSLAComplianceCommandHandler(history, repository)
+	_, err := handler.Handle(t.Context(), commands.ComputeSLAComplianceCommand{})
+
+	require.ErrorIs(t, err, commands.ErrComputeSLAComplianceCommandIsNotConstructed)
+	repository.AssertNotCalled(t, "GetTargets", mock.Anything)
+}
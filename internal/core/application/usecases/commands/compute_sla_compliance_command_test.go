@@ -0,0 +1,36 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewComputeSLAComplianceCommand_Valid(t *testing.T) {
+	moment := time.Date(2025, 3, 1, 17, 30, 0, 0, time.FixedZone("MSK", 3*60*60))
+
+	cmd, err := commands.NewComputeSLAComplianceCommand(moment)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), cmd.Day())
+}
+
+func TestNewComputeSLAComplianceCommand_InvalidDay(t *testing.T) {
+	_, err := commands.NewComputeSLAComplianceCommand(time.Time{})
+	require.ErrorIs(t, err, errs.ErrValueIsRequired)
+
+	_, err = commands.NewComputeSLAComplianceCommand(time.Now().Add(time.Hour))
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
+
+func TestComputeSLAComplianceCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.ComputeSLAComplianceCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrComputeSLAComplianceCommandIsNotConstructed)
+}
@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrConfirmOrderHandoverCommandIsNotConstructed = errors.New(
+		"ConfirmOrderHandoverCommand must be created via NewConfirmOrderHandoverCommand constructor",
+	)
+)
+
+// HandoverStep is the moment an insured order changes hands.
+type HandoverStep string
+
+const (
+	// PickupHandover is the courier taking the order at the warehouse.
+	PickupHandover HandoverStep = "pickup"
+
+	// DeliveryHandover is the courier handing the order to the customer.
+	DeliveryHandover HandoverStep = "delivery"
+)
+
+// ConfirmOrderHandoverCommand represents the courier's confirmation that an insured order
+// changed hands at the pickup or at the delivery.
+//
+// Example:
+//
+//	cmd, err := NewConfirmOrderHandoverCommand(orderID, PickupHandover)
+//	if err != nil {
+//	    return fmt.Errorf("invalid confirmation: %w", err)
+//	}
+//
+//	handler := NewConfirmOrderHandoverCommandHandler(uowFactory)
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    return fmt.Errorf("failed to confirm handover: %w", err)
+//	}
+type ConfirmOrderHandoverCommand struct { //nolint:recvcheck //using for validation
+	orderID kernel.UUID
+	step    HandoverStep
+
+	guard guard.ConstructorGuard
+}
+
+// NewConfirmOrderHandoverCommand creates a command to confirm a handover of an insured order.
+// Validates that the order ID is valid and the step is PickupHandover or DeliveryHandover.
+func NewConfirmOrderHandoverCommand(orderID kernel.UUID, step HandoverStep) (ConfirmOrderHandoverCommand, error) {
+	command := ConfirmOrderHandoverCommand{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := errs.JoinFields(
+		errs.Field("orderId", command.setOrderID(orderID)),
+		errs.Field("step", command.setStep(step)),
+	); err != nil {
+		return ConfirmOrderHandoverCommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrConfirmOrderHandoverCommandIsNotConstructed if validation fails.
+func (c ConfirmOrderHandoverCommand) Validate() error {
+	return c.guard.Validate(ErrConfirmOrderHandoverCommandIsNotConstructed)
+}
+
+// OrderID returns the ID of the insured order.
+func (c ConfirmOrderHandoverCommand) OrderID() kernel.UUID {
+	return c.orderID
+}
+
+// Step returns the handover being confirmed.
+func (c ConfirmOrderHandoverCommand) Step() HandoverStep {
+	return c.step
+}
+
+func (c *ConfirmOrderHandoverCommand) setOrderID(orderID kernel.UUID) error {
+	if err := orderID.Validate(); err != nil {
+		return err
+	}
+
+	c.orderID = orderID
+	return nil
+}
+
+func (c *ConfirmOrderHandoverCommand) setStep(step HandoverStep) error {
+	if step != PickupHandover && step != DeliveryHandover {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"handover step is invalid",
+			fmt.Errorf("%q is not a handover step", step),
+		)
+	}
+
+	c.step = step
+	return nil
+}
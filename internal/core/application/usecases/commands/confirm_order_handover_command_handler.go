@@ -0,0 +1,114 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/tracing"
+)
+
+// ErrCourierHasNotArrived is returned when confirming the delivery of an order before its
+// courier reached the delivery location.
+var ErrCourierHasNotArrived = errors.New("courier has not arrived at the delivery location")
+
+// ConfirmOrderHandoverCommandHandler records the handover confirmations of insured orders.
+// The courier of an insured order waits at the warehouse until the pickup is confirmed, and
+// on arrival until the delivery is confirmed; confirming the delivery completes the order.
+//
+// Example:
+//
+//	handler := NewConfirmOrderHandoverCommandHandler(uowFactory)
+//	cmd, _ := NewConfirmOrderHandoverCommand(orderID, DeliveryHandover)
+//	if err := handler.Handle(ctx, cmd); errors.Is(err, ErrCourierHasNotArrived) {
+//	    log.Printf("Courier of order %s is still on the way", orderID)
+//	}
+type ConfirmOrderHandoverCommandHandler struct {
+	uowFactory UoWFactory
+}
+
+// NewConfirmOrderHandoverCommandHandler creates a new handler for handover confirmations.
+// Requires a UoWFactory for coordinating updates across order and courier repositories.
+func NewConfirmOrderHandoverCommandHandler(uowFactory UoWFactory) ConfirmOrderHandoverCommandHandler {
+	return ConfirmOrderHandoverCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the ConfirmOrderHandoverCommand within a transaction.
+// Returns order.ErrConfirmationIsNotRequired for orders that are not insured,
+// order.ErrOrderIsNotAssigned for orders without a courier, order.ErrPickupIsNotConfirmed
+// when confirming the delivery before the pickup, and ErrCourierHasNotArrived when confirming
+// the delivery before the courier reached the customer.
+func (h *ConfirmOrderHandoverCommandHandler) Handle(ctx context.Context, cmd ConfirmOrderHandoverCommand) error {
+	ctx, span := tracing.Start(ctx, "ConfirmOrderHandoverCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+
+	uow := h.uowFactory.Create()
+	return uow.Do(ctx, func(ctx context.Context) error {
+		orderRepo := uow.OrderRepository()
+		orderAggregate, err := orderRepo.Get(ctx, cmd.OrderID())
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if cmd.Step() == PickupHandover {
+			err = orderAggregate.ConfirmPickup(now)
+		} else {
+			err = h.confirmDelivery(ctx, uow, orderAggregate, now)
+		}
+		if err != nil {
+			return err
+		}
+
+		return orderRepo.Update(ctx, orderAggregate)
+	})
+}
+
+// confirmDelivery confirms the delivery of an order whose courier reached the customer and
+// completes the order, freeing the courier's storage place.
+func (h *ConfirmOrderHandoverCommandHandler) confirmDelivery(
+	ctx context.Context,
+	uow UoW,
+	orderAggregate *order.Order,
+	now time.Time,
+) error {
+	if !orderAggregate.IsInsuranceRequired() {
+		return order.ErrConfirmationIsNotRequired
+	}
+	if orderAggregate.Courier() == nil {
+		return order.ErrOrderIsNotAssigned
+	}
+
+	courierRepo := uow.CourierRepository()
+	courierAggregate, err := courierRepo.Get(ctx, *orderAggregate.Courier())
+	if err != nil {
+		return err
+	}
+
+	arrived, err := courierAggregate.Location().IsEqual(orderAggregate.Location())
+	if err != nil {
+		return err
+	}
+	if !arrived {
+		return ErrCourierHasNotArrived
+	}
+
+	if err = orderAggregate.ConfirmDelivery(now); err != nil {
+		return err
+	}
+	if err = orderAggregate.Complete(); err != nil {
+		return err
+	}
+	if err = courierAggregate.CompleteOrder(orderAggregate.ID()); err != nil {
+		return err
+	}
+
+	return courierRepo.Update(ctx, courierAggregate)
+}
@@ -0,0 +1,126 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createInsuredOrderAt(t *testing.T, c *courier.Courier, x, y kernel.Coordinate) *order.Order {
+	t.Helper()
+	location, err := kernel.NewLocation(x, y)
+	require.NoError(t, err)
+	threshold, err := order.NewInsuranceThreshold(100_000)
+	require.NoError(t, err)
+
+	o, err := order.NewOrder(kernel.NewUUID(), location, 5)
+	require.NoError(t, err)
+	require.NoError(t, o.DeclareValue(250_000, threshold))
+	c.Insure()
+	require.NoError(t, c.TakeOrder(o))
+	require.NoError(t, o.Assign(c.ID()))
+	return o
+}
+
+func TestConfirmOrderHandoverCommandHandler_Handle(t *testing.T) {
+	ctx := t.Context()
+
+	setup := func(t *testing.T) (*MockAssignOrderRepository, *MockAssignCourierRepository, *MockAssignUoW, *MockAssignUoWFactory) {
+		t.Helper()
+		orderRepo := new(MockAssignOrderRepository)
+		courierRepo := new(MockAssignCourierRepository)
+		uow := new(MockAssignUoW)
+		factory := new(MockAssignUoWFactory)
+
+		factory.On("Create").Return(uow).Once()
+		uow.On("Begin", ctx).Return(nil).Once()
+		uow.On("OrderRepository").Return(orderRepo).Once()
+		uow.On("CourierRepository").Return(courierRepo).Maybe()
+		return orderRepo, courierRepo, uow, factory
+	}
+
+	t.Run("should confirm pickup", func(t *testing.T) {
+		c := createCourierAt(t, 1, 1)
+		o := createInsuredOrderAt(t, c, 5, 5)
+		orderRepo, _, uow, factory := setup(t)
+		orderRepo.On("Get", ctx, o.ID()).Return(o, nil).Once()
+		orderRepo.On("Update", ctx, o).Return(nil).Once()
+		uow.On("Commit", ctx).Return(nil).Once()
+
+		cmd, err := commands.NewConfirmOrderHandoverCommand(o.ID(), commands.PickupHandover)
+		require.NoError(t, err)
+		handler := commands.NewConfirmOrderHandoverCommandHandler(factory)
+		require.NoError(t, handler.Handle(ctx, cmd))
+
+		assert.NotNil(t, o.PickupConfirmedAt())
+		assert.Equal(t, order.Assigned, o.Status())
+		orderRepo.AssertExpectations(t)
+		uow.AssertExpectations(t)
+	})
+
+	t.Run("should complete order once the courier arrived", func(t *testing.T) {
+		c := createCourierAt(t, 5, 5)
+		o := createInsuredOrderAt(t, c, 5, 5)
+		require.NoError(t, o.ConfirmPickup(time.Now()))
+		orderRepo, courierRepo, uow, factory := setup(t)
+		orderRepo.On("Get", ctx, o.ID()).Return(o, nil).Once()
+		courierRepo.On("Get", ctx, c.ID()).Return(c, nil).Once()
+		courierRepo.On("Update", ctx, c).Return(nil).Once()
+		orderRepo.On("Update", ctx, o).Return(nil).Once()
+		uow.On("Commit", ctx).Return(nil).Once()
+
+		cmd, err := commands.NewConfirmOrderHandoverCommand(o.ID(), commands.DeliveryHandover)
+		require.NoError(t, err)
+		handler := commands.NewConfirmOrderHandoverCommandHandler(factory)
+		require.NoError(t, handler.Handle(ctx, cmd))
+
+		assert.NotNil(t, o.DeliveryConfirmedAt())
+		assert.Equal(t, order.Completed, o.Status())
+		assert.Nil(t, c.StoragePlaces()[0].OrderID())
+		orderRepo.AssertExpectations(t)
+		courierRepo.AssertExpectations(t)
+	})
+
+	t.Run("should refuse delivery before the courier arrived", func(t *testing.T) {
+		c := createCourierAt(t, 1, 1)
+		o := createInsuredOrderAt(t, c, 5, 5)
+		require.NoError(t, o.ConfirmPickup(time.Now()))
+		orderRepo, courierRepo, uow, factory := setup(t)
+		orderRepo.On("Get", ctx, o.ID()).Return(o, nil).Once()
+		courierRepo.On("Get", ctx, c.ID()).Return(c, nil).Once()
+		uow.On("Rollback", ctx).Return(nil).Once()
+
+		cmd, err := commands.NewConfirmOrderHandoverCommand(o.ID(), commands.DeliveryHandover)
+		require.NoError(t, err)
+		handler := commands.NewConfirmOrderHandoverCommandHandler(factory)
+		require.ErrorIs(t, handler.Handle(ctx, cmd), commands.ErrCourierHasNotArrived)
+
+		assert.Nil(t, o.DeliveryConfirmedAt())
+		orderRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		uow.AssertNotCalled(t, "Commit", mock.Anything)
+	})
+
+	t.Run("should refuse orders that are not insured", func(t *testing.T) {
+		c := createCourierAt(t, 5, 5)
+		o := createAssignedOrderAt(t, c, 5, 5)
+		orderRepo, courierRepo, uow, factory := setup(t)
+		orderRepo.On("Get", ctx, o.ID()).Return(o, nil).Once()
+		uow.On("Rollback", ctx).Return(nil).Once()
+
+		cmd, err := commands.NewConfirmOrderHandoverCommand(o.ID(), commands.DeliveryHandover)
+		require.NoError(t, err)
+		handler := commands.NewConfirmOrderHandoverCommandHandler(factory)
+		require.ErrorIs(t, handler.Handle(ctx, cmd), order.ErrConfirmationIsNotRequired)
+
+		courierRepo.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+		uow.AssertNotCalled(t, "Commit", mock.Anything)
+	})
+}
@@ -0,0 +1,37 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConfirmOrderHandoverCommand_ValidInput(t *testing.T) {
+	orderID := kernel.NewUUID()
+
+	cmd, err := commands.NewConfirmOrderHandoverCommand(orderID, commands.DeliveryHandover)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, orderID, cmd.OrderID())
+	assert.Equal(t, commands.DeliveryHandover, cmd.Step())
+}
+
+func TestNewConfirmOrderHandoverCommand_InvalidInput(t *testing.T) {
+	_, err := commands.NewConfirmOrderHandoverCommand(kernel.UUID{}, commands.PickupHandover)
+	require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+
+	_, err = commands.NewConfirmOrderHandoverCommand(kernel.NewUUID(), "dropoff")
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
+
+func TestConfirmOrderHandoverCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.ConfirmOrderHandoverCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrConfirmOrderHandoverCommandIsNotConstructed)
+}
@@ -0,0 +1,23 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"delivery/internal/pkg/errs"
+)
+
+// maxConflictRetries bounds how often a transaction is run again after losing a concurrency
+// conflict, so two writers that keep colliding eventually report the conflict.
+const maxConflictRetries = 3
+
+// retryOnConflict runs the transaction and runs it again while it fails with
+// errs.ErrConcurrencyConflict. Every run must begin its own unit of work and load the aggregates
+// anew, so a rerun works on what the winning writer committed.
+func retryOnConflict(ctx context.Context, transaction func(ctx context.Context) error) error {
+	err := transaction(ctx)
+	for attempt := 0; attempt < maxConflictRetries && errors.Is(err, errs.ErrConcurrencyConflict); attempt++ {
+		err = transaction(ctx)
+	}
+	return err
+}
@@ -1,8 +1,11 @@
 package commands
 
 import (
+	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
 	"delivery/internal/pkg/guard"
+	"delivery/internal/pkg/i18n"
 	"errors"
 )
 
@@ -35,6 +38,10 @@ type CreateCourierCommand struct { //nolint:recvcheck //using for validation
 	name      string
 	speed     int
 	location  kernel.Location
+	language  i18n.Language
+
+	// externalID is the HR system ID the creation is keyed by, nil for couriers without one
+	externalID *courier.ExternalID
 
 	guard guard.ConstructorGuard
 }
@@ -43,15 +50,61 @@ type CreateCourierCommand struct { //nolint:recvcheck //using for validation
 // Automatically generates a unique ID for the courier.
 // Validates that name is not empty, speed is positive, and location is valid.
 func NewCreateCourierCommand(name string, speed int, location kernel.Location) (CreateCourierCommand, error) {
+	return NewCreateCourierCommandWithLanguage(name, speed, location, i18n.Russian)
+}
+
+// NewCreateCourierCommandWithLanguage creates a command to register a new courier
+// who prefers the given language for courier-facing strings.
+// Validates the same fields as NewCreateCourierCommand and that the language is supported.
+func NewCreateCourierCommandWithLanguage(
+	name string,
+	speed int,
+	location kernel.Location,
+	language i18n.Language,
+) (CreateCourierCommand, error) {
+	command := CreateCourierCommand{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := errs.JoinFields(
+		errs.Field("id", command.setCourierID(kernel.NewUUID())),
+		errs.Field("name", command.setName(name)),
+		errs.Field("speed", command.setSpeed(speed)),
+		errs.Field("location", command.setLocation(location)),
+		errs.Field("language", command.setLanguage(language)),
+	); err != nil {
+		return CreateCourierCommand{}, err
+	}
+
+	return command, nil
+}
+
+// NewCreateCourierCommandWithExternalID creates a command to register a courier known to the
+// HR system under externalID. The creation is keyed by the external ID: repeating it returns
+// the courier registered first instead of creating a duplicate.
+// Validates the same fields as NewCreateCourierCommandWithLanguage and the external ID.
+//
+// Example:
+//
+//	cmd, err := NewCreateCourierCommandWithExternalID("John Doe", 60, location, i18n.English, "HR-004217")
+func NewCreateCourierCommandWithExternalID(
+	name string,
+	speed int,
+	location kernel.Location,
+	language i18n.Language,
+	externalID string,
+) (CreateCourierCommand, error) {
 	command := CreateCourierCommand{
 		guard: guard.NewConstructorGuard(),
 	}
 
-	if err := errors.Join(
-		command.setCourierID(kernel.NewUUID()),
-		command.setName(name),
-		command.setSpeed(speed),
-		command.setLocation(location),
+	if err := errs.JoinFields(
+		errs.Field("id", command.setCourierID(kernel.NewUUID())),
+		errs.Field("name", command.setName(name)),
+		errs.Field("speed", command.setSpeed(speed)),
+		errs.Field("location", command.setLocation(location)),
+		errs.Field("language", command.setLanguage(language)),
+		errs.Field("externalId", command.setExternalID(externalID)),
 	); err != nil {
 		return CreateCourierCommand{}, err
 	}
@@ -85,6 +138,16 @@ func (c CreateCourierCommand) Location() kernel.Location {
 	return c.location
 }
 
+// Language returns the courier's preferred language from the command.
+func (c CreateCourierCommand) Language() i18n.Language {
+	return c.language
+}
+
+// ExternalID returns the HR system ID of the courier, nil if the command is not keyed by one.
+func (c CreateCourierCommand) ExternalID() *courier.ExternalID {
+	return c.externalID
+}
+
 func (c *CreateCourierCommand) setCourierID(id kernel.UUID) error {
 	if err := id.Validate(); err != nil {
 		return err
@@ -120,3 +183,22 @@ func (c *CreateCourierCommand) setLocation(location kernel.Location) error {
 	c.location = location
 	return nil
 }
+
+func (c *CreateCourierCommand) setLanguage(language i18n.Language) error {
+	if err := language.Validate(); err != nil {
+		return err
+	}
+
+	c.language = language
+	return nil
+}
+
+func (c *CreateCourierCommand) setExternalID(value string) error {
+	externalID, err := courier.NewExternalID(value)
+	if err != nil {
+		return err
+	}
+
+	c.externalID = &externalID
+	return nil
+}
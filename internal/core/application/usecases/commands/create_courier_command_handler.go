@@ -2,12 +2,26 @@ package commands
 
 import (
 	"context"
+	"errors"
 
 	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tracing"
 )
 
+// CourierRegistration is the outcome of a courier creation.
+type CourierRegistration struct {
+	// Courier is the created courier, or the courier registered first under the same external ID
+	Courier *courier.Courier
+
+	// Existing is true if the creation was a repeat for an already registered external ID
+	Existing bool
+}
+
 // CreateCourierCommandHandler handles the business logic for courier registration.
 // Creates and persists new courier entities with initial location and capabilities.
+// Creations keyed by an external ID are duplicate-safe: a repeat returns the courier
+// registered first, including when both creations run concurrently.
 //
 // Example:
 //
@@ -15,7 +29,8 @@ import (
 //	location := kernel.NewLocation(40.7128, -74.0060) // New York
 //	cmd, _ := NewCreateCourierCommand("Express Courier", 80, location)
 //
-//	if err := handler.Handle(ctx, cmd); err != nil {
+//	registration, err := handler.Handle(ctx, cmd)
+//	if err != nil {
 //	    return fmt.Errorf("courier registration failed: %w", err)
 //	}
 type CreateCourierCommandHandler struct {
@@ -33,33 +48,86 @@ func NewCreateCourierCommandHandler(uowFactory CourierUoWFactory) CreateCourierC
 // Handle processes the courier creation command.
 // Creates a new courier entity and persists it within a transaction.
 // Automatically rolls back on any error to prevent partial data.
-func (h *CreateCourierCommandHandler) Handle(ctx context.Context, cmd CreateCourierCommand) error {
+// If the command carries an external ID that is already registered, the registered
+// courier is returned unchanged and nothing is created.
+func (h *CreateCourierCommandHandler) Handle(ctx context.Context, cmd CreateCourierCommand) (CourierRegistration, error) {
+	ctx, span := tracing.Start(ctx, "CreateCourierCommand")
+	defer span.End()
+
 	if err := cmd.Validate(); err != nil {
-		return err
+		return CourierRegistration{}, err
 	}
 
-	uow := h.uowFactory.Create()
-	if err := uow.Begin(ctx); err != nil {
-		return err
+	registration, err := h.create(ctx, cmd)
+	if errors.Is(err, courier.ErrExternalIDIsAlreadyRegistered) {
+		// A concurrent creation with the same external ID committed first
+		return h.getRegistered(ctx, *cmd.ExternalID())
 	}
+	return registration, err
+}
+
+func (h *CreateCourierCommandHandler) create(ctx context.Context, cmd CreateCourierCommand) (CourierRegistration, error) {
+	var registration CourierRegistration
+	uow := h.uowFactory.Create()
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		if externalID := cmd.ExternalID(); externalID != nil {
+			registered, err := courierRepo.GetByExternalID(ctx, *externalID)
+			if err == nil {
+				registration = CourierRegistration{Courier: registered, Existing: true}
+				return nil
+			}
+			if !errors.Is(err, errs.ErrObjectNotFound) {
+				return err
+			}
+		}
+
+		courierEntity, err := courier.NewCourierWithLanguage(
+			cmd.CourierID(),
+			cmd.Name(),
+			cmd.Speed(),
+			cmd.Location(),
+			cmd.Language(),
+		)
+		if err != nil {
+			return err
+		}
+
+		if externalID := cmd.ExternalID(); externalID != nil {
+			if err = courierEntity.LinkExternalID(*externalID); err != nil {
+				return err
+			}
+		}
 
-	defer func() {
-		_ = uow.Rollback(ctx)
-	}()
+		if err = courierRepo.Add(ctx, courierEntity); err != nil {
+			return err
+		}
 
-	courierRepo := uow.CourierRepository()
-	courierEntity, err := courier.NewCourier(cmd.CourierID(), cmd.Name(), cmd.Speed(), cmd.Location())
+		registration = CourierRegistration{Courier: courierEntity, Existing: false}
+		return nil
+	})
 	if err != nil {
-		return err
+		return CourierRegistration{}, err
 	}
 
-	if err = courierRepo.Add(ctx, courierEntity); err != nil {
-		return err
-	}
+	return registration, nil
+}
 
-	if err = uow.Commit(ctx); err != nil {
+// getRegistered loads the courier registered under externalID in a new transaction.
+func (h *CreateCourierCommandHandler) getRegistered(
+	ctx context.Context,
+	externalID courier.ExternalID,
+) (CourierRegistration, error) {
+	var registered *courier.Courier
+	uow := h.uowFactory.Create()
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		registered, err = uow.CourierRepository().GetByExternalID(ctx, externalID)
 		return err
+	})
+	if err != nil {
+		return CourierRegistration{}, err
 	}
 
-	return nil
+	return CourierRegistration{Courier: registered, Existing: true}, nil
 }
@@ -9,6 +9,8 @@ import (
 	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/i18n"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -35,11 +37,53 @@ func (m *MockCourierRepository) Get(ctx context.Context, id kernel.UUID) (*couri
 	return args.Get(0).(*courier.Courier), args.Error(1)
 }
 
+func (m *MockCourierRepository) GetByExternalID(
+	ctx context.Context,
+	externalID courier.ExternalID,
+) (*courier.Courier, error) {
+	args := m.Called(ctx, externalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*courier.Courier), args.Error(1)
+}
+
+func (m *MockCourierRepository) GetByIDs(ctx context.Context, ids []kernel.UUID) ([]*courier.Courier, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*courier.Courier), args.Error(1)
+}
+
 func (m *MockCourierRepository) GetAllFree(ctx context.Context) ([]*courier.Courier, error) {
 	args := m.Called(ctx)
 	return args.Get(0).([]*courier.Courier), args.Error(1)
 }
 
+func (m *MockCourierRepository) GetAllWithFreeStorage(ctx context.Context) ([]*courier.Courier, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*courier.Courier), args.Error(1)
+}
+
+func (m *MockCourierRepository) GetAllOnShift(ctx context.Context) ([]*courier.Courier, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*courier.Courier), args.Error(1)
+}
+
+func (m *MockCourierRepository) GetAllOffShift(ctx context.Context) ([]*courier.Courier, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*courier.Courier), args.Error(1)
+}
+
+func (m *MockCourierRepository) GetAllSubstitutedBy(
+	ctx context.Context,
+	substituteID kernel.UUID,
+) ([]*courier.Courier, error) {
+	args := m.Called(ctx, substituteID)
+	return args.Get(0).([]*courier.Courier), args.Error(1)
+}
+
 type MockCourierUoW struct {
 	mock.Mock
 }
@@ -49,6 +93,10 @@ func (m *MockCourierUoW) Begin(ctx context.Context) error {
 	return args.Error(0)
 }
 
+func (m *MockCourierUoW) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return doInTransaction(ctx, m, fn)
+}
+
 func (m *MockCourierUoW) Commit(ctx context.Context) error {
 	args := m.Called(ctx)
 	return args.Error(0)
@@ -105,14 +153,13 @@ func TestCreateCourierCommandHandler_Handle_Success(t *testing.T) {
 		mockUoW.On("CourierRepository").Return(mockRepo).Once(),
 		mockRepo.On("Add", ctx, mock.AnythingOfType("*courier.Courier")).Return(nil).Once(),
 		mockUoW.On("Commit", ctx).Return(nil).Once(),
-		mockUoW.On("Rollback", ctx).Return(nil).Once(),
 	)
 	mockFactory.On("Create").Return(mockUoW).Once()
 
 	handler := commands.NewCreateCourierCommandHandler(mockFactory)
 
 	// Act
-	err = handler.Handle(ctx, cmd)
+	_, err = handler.Handle(ctx, cmd)
 
 	// Assert
 	require.NoError(t, err)
@@ -130,7 +177,7 @@ func TestCreateCourierCommandHandler_Handle_InvalidCommand(t *testing.T) {
 	handler := commands.NewCreateCourierCommandHandler(mockFactory)
 
 	// Act
-	err := handler.Handle(ctx, invalidCmd)
+	_, err := handler.Handle(ctx, invalidCmd)
 
 	// Assert
 	require.Error(t, err)
@@ -162,7 +209,7 @@ func TestCreateCourierCommandHandler_Handle_BeginTransactionError(t *testing.T)
 	handler := commands.NewCreateCourierCommandHandler(mockFactory)
 
 	// Act
-	err = handler.Handle(ctx, cmd)
+	_, err = handler.Handle(ctx, cmd)
 
 	// Assert
 	require.Error(t, err)
@@ -207,7 +254,7 @@ func TestCreateCourierCommandHandler_Handle_RepositoryAddError(t *testing.T) {
 	handler := commands.NewCreateCourierCommandHandler(mockFactory)
 
 	// Act
-	err = handler.Handle(ctx, cmd)
+	_, err = handler.Handle(ctx, cmd)
 
 	// Assert
 	require.Error(t, err)
@@ -246,7 +293,7 @@ func TestCreateCourierCommandHandler_Handle_CommitError(t *testing.T) {
 	handler := commands.NewCreateCourierCommandHandler(mockFactory)
 
 	// Act
-	err = handler.Handle(ctx, cmd)
+	_, err = handler.Handle(ctx, cmd)
 
 	// Assert
 	require.Error(t, err)
@@ -285,7 +332,7 @@ func TestCreateCourierCommandHandler_Handle_RepositoryAddErrorWithRollbackError(
 	handler := commands.NewCreateCourierCommandHandler(mockFactory)
 
 	// Act
-	err = handler.Handle(ctx, cmd)
+	_, err = handler.Handle(ctx, cmd)
 
 	// Assert
 	// Should return the original repository error, not the rollback error
@@ -326,7 +373,7 @@ func TestCreateCourierCommandHandler_Handle_CommitErrorWithRollbackError(t *test
 	handler := commands.NewCreateCourierCommandHandler(mockFactory)
 
 	// Act
-	err = handler.Handle(ctx, cmd)
+	_, err = handler.Handle(ctx, cmd)
 
 	// Assert
 	// Should return the original commit error, not the rollback error
@@ -362,14 +409,13 @@ func TestCreateCourierCommandHandler_Handle_VerifiesCourierDataCorrectness(t *te
 			return true
 		})).Return(nil).Once(),
 		mockUoW.On("Commit", ctx).Return(nil).Once(),
-		mockUoW.On("Rollback", ctx).Return(nil).Once(),
 	)
 	mockFactory.On("Create").Return(mockUoW).Once()
 
 	handler := commands.NewCreateCourierCommandHandler(mockFactory)
 
 	// Act
-	err = handler.Handle(ctx, cmd)
+	_, err = handler.Handle(ctx, cmd)
 
 	// Assert
 	require.NoError(t, err)
@@ -404,6 +450,105 @@ func TestCreateCourierCommandHandler_Handle_MultipleCommandsGenerateUniqueIDs(t
 	assert.NotEqual(t, cmd1.CourierID(), cmd2.CourierID(), "Different commands should generate unique courier IDs")
 }
 
+func TestCreateCourierCommandHandler_Handle_ExternalID(t *testing.T) {
+	location, err := kernel.NewLocation(5, 7)
+	require.NoError(t, err)
+	externalID, err := courier.NewExternalID("HR-004217")
+	require.NoError(t, err)
+	registered, err := courier.NewCourier(kernel.NewUUID(), "John Doe", 3, location)
+	require.NoError(t, err)
+	require.NoError(t, registered.LinkExternalID(externalID))
+
+	newCommand := func(t *testing.T) commands.CreateCourierCommand {
+		t.Helper()
+		cmd, cmdErr := commands.NewCreateCourierCommandWithExternalID("John Doe", 3, location, i18n.Russian, "HR-004217")
+		require.NoError(t, cmdErr)
+		return cmd
+	}
+
+	t.Run("creates courier linked to new external id", func(t *testing.T) {
+		ctx := t.Context()
+		cmd := newCommand(t)
+		mockRepo := new(MockCourierRepository)
+		mockUoW := new(MockCourierUoW)
+		mockFactory := new(MockCourierUoWFactory)
+
+		mockFactory.On("Create").Return(mockUoW).Once()
+		mockUoW.On("Begin", ctx).Return(nil).Once()
+		mockUoW.On("CourierRepository").Return(mockRepo).Once()
+		mockRepo.On("GetByExternalID", ctx, externalID).
+			Return(nil, errs.NewObjectNotFoundError("courier", externalID.String())).Once()
+		mockRepo.On("Add", ctx, mock.AnythingOfType("*courier.Courier")).Return(nil).Once()
+		mockUoW.On("Commit", ctx).Return(nil).Once()
+
+		handler := commands.NewCreateCourierCommandHandler(mockFactory)
+		registration, handleErr := handler.Handle(ctx, cmd)
+
+		require.NoError(t, handleErr)
+		assert.False(t, registration.Existing)
+		assert.Equal(t, cmd.CourierID(), registration.Courier.ID())
+		require.NotNil(t, registration.Courier.ExternalID())
+		assert.Equal(t, "HR-004217", registration.Courier.ExternalID().String())
+		mockRepo.AssertExpectations(t)
+		mockUoW.AssertExpectations(t)
+	})
+
+	t.Run("returns courier registered with the same external id", func(t *testing.T) {
+		ctx := t.Context()
+		mockRepo := new(MockCourierRepository)
+		mockUoW := new(MockCourierUoW)
+		mockFactory := new(MockCourierUoWFactory)
+
+		mockFactory.On("Create").Return(mockUoW).Once()
+		mockUoW.On("Begin", ctx).Return(nil).Once()
+		mockUoW.On("CourierRepository").Return(mockRepo).Once()
+		mockRepo.On("GetByExternalID", ctx, externalID).Return(registered, nil).Once()
+		mockUoW.On("Commit", ctx).Return(nil).Once()
+
+		handler := commands.NewCreateCourierCommandHandler(mockFactory)
+		registration, handleErr := handler.Handle(ctx, newCommand(t))
+
+		require.NoError(t, handleErr)
+		assert.True(t, registration.Existing)
+		assert.Same(t, registered, registration.Courier)
+		mockRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+		mockUoW.AssertExpectations(t)
+	})
+
+	t.Run("returns courier registered by a concurrent creation", func(t *testing.T) {
+		ctx := t.Context()
+		mockRepo := new(MockCourierRepository)
+		firstUoW := new(MockCourierUoW)
+		secondUoW := new(MockCourierUoW)
+		mockFactory := new(MockCourierUoWFactory)
+
+		mockFactory.On("Create").Return(firstUoW).Once()
+		mockFactory.On("Create").Return(secondUoW).Once()
+		firstUoW.On("Begin", ctx).Return(nil).Once()
+		firstUoW.On("CourierRepository").Return(mockRepo).Once()
+		mockRepo.On("GetByExternalID", ctx, externalID).
+			Return(nil, errs.NewObjectNotFoundError("courier", externalID.String())).Once()
+		mockRepo.On("Add", ctx, mock.AnythingOfType("*courier.Courier")).
+			Return(courier.ErrExternalIDIsAlreadyRegistered).Once()
+		firstUoW.On("Rollback", ctx).Return(nil).Once()
+		secondUoW.On("Begin", ctx).Return(nil).Once()
+		secondUoW.On("CourierRepository").Return(mockRepo).Once()
+		mockRepo.On("GetByExternalID", ctx, externalID).Return(registered, nil).Once()
+		secondUoW.On("Commit", ctx).Return(nil).Once()
+
+		handler := commands.NewCreateCourierCommandHandler(mockFactory)
+		registration, handleErr := handler.Handle(ctx, newCommand(t))
+
+		require.NoError(t, handleErr)
+		assert.True(t, registration.Existing)
+		assert.Same(t, registered, registration.Courier)
+		mockFactory.AssertExpectations(t)
+		mockRepo.AssertExpectations(t)
+		firstUoW.AssertExpectations(t)
+		secondUoW.AssertExpectations(t)
+	})
+}
+
 // Benchmark test to ensure performance is acceptable.
 func BenchmarkCreateCourierCommandHandler_Handle(b *testing.B) {
 	ctx := b.Context()
@@ -423,13 +568,12 @@ func BenchmarkCreateCourierCommandHandler_Handle(b *testing.B) {
 	mockUoW.On("CourierRepository").Return(mockRepo).Times(b.N)
 	mockRepo.On("Add", ctx, mock.AnythingOfType("*courier.Courier")).Return(nil).Times(b.N)
 	mockUoW.On("Commit", ctx).Return(nil).Times(b.N)
-	mockUoW.On("Rollback", ctx).Return(nil).Times(b.N)
 
 	handler := commands.NewCreateCourierCommandHandler(mockFactory)
 
 	b.ResetTimer()
 	for range b.N {
-		benchErr := handler.Handle(ctx, cmd)
+		_, benchErr := handler.Handle(ctx, cmd)
 		if benchErr != nil {
 			b.Fatal(benchErr)
 		}
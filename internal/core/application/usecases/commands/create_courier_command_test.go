@@ -5,6 +5,8 @@ import (
 
 	"delivery/internal/core/application/usecases/commands"
 	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/i18n"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -32,6 +34,31 @@ func TestNewCreateCourierCommand_ValidInput(t *testing.T) {
 	assert.NoError(t, cmd.CourierID().Validate())
 }
 
+func TestNewCreateCourierCommandWithLanguage(t *testing.T) {
+	location, err := kernel.NewLocation(5, 7)
+	require.NoError(t, err)
+
+	t.Run("default constructor uses russian", func(t *testing.T) {
+		cmd, cmdErr := commands.NewCreateCourierCommand("John Doe", 3, location)
+
+		require.NoError(t, cmdErr)
+		assert.Equal(t, i18n.Russian, cmd.Language())
+	})
+
+	t.Run("keeps requested language", func(t *testing.T) {
+		cmd, cmdErr := commands.NewCreateCourierCommandWithLanguage("John Doe", 3, location, i18n.English)
+
+		require.NoError(t, cmdErr)
+		assert.Equal(t, i18n.English, cmd.Language())
+	})
+
+	t.Run("rejects unsupported language", func(t *testing.T) {
+		_, cmdErr := commands.NewCreateCourierCommandWithLanguage("John Doe", 3, location, i18n.Language("de"))
+
+		require.Error(t, cmdErr)
+	})
+}
+
 func TestNewCreateCourierCommand_ValidInputBoundaryValues(t *testing.T) {
 	// Test with boundary location values
 	testCases := []struct {
@@ -409,3 +436,36 @@ func BenchmarkNewCreateCourierCommand(b *testing.B) {
 		}
 	}
 }
+
+func TestNewCreateCourierCommandWithExternalID(t *testing.T) {
+	location, err := kernel.NewLocation(5, 7)
+	require.NoError(t, err)
+
+	t.Run("keeps trimmed external id", func(t *testing.T) {
+		cmd, cmdErr := commands.NewCreateCourierCommandWithExternalID("John Doe", 3, location, i18n.English, " HR-1 ")
+
+		require.NoError(t, cmdErr)
+		require.NotNil(t, cmd.ExternalID())
+		assert.Equal(t, "HR-1", cmd.ExternalID().String())
+		assert.Equal(t, i18n.English, cmd.Language())
+	})
+
+	t.Run("commands without external id are not keyed", func(t *testing.T) {
+		cmd, cmdErr := commands.NewCreateCourierCommand("John Doe", 3, location)
+
+		require.NoError(t, cmdErr)
+		assert.Nil(t, cmd.ExternalID())
+	})
+
+	t.Run("reports invalid external id with other fields", func(t *testing.T) {
+		_, cmdErr := commands.NewCreateCourierCommandWithExternalID("", 3, location, i18n.English, "HR 1")
+
+		var validation *errs.ValidationErrors
+		require.ErrorAs(t, cmdErr, &validation)
+		fields := make([]string, 0, len(validation.Fields))
+		for _, field := range validation.Fields {
+			fields = append(fields, field.Field)
+		}
+		assert.ElementsMatch(t, []string{"name", "externalId"}, fields)
+	})
+}
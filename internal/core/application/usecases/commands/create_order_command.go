@@ -2,8 +2,11 @@ package commands
 
 import (
 	"errors"
+	"fmt"
 
 	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
 	"delivery/internal/pkg/guard"
 )
 
@@ -12,16 +15,17 @@ var (
 		"CreateOrderCommand must be created via NewCreateOrderCommand constructor",
 	)
 	ErrStreetIsRequired = errors.New("street is required")
-	ErrVolumeIsInvalid  = errors.New("volume must be greater than 0")
+	ErrItemsAreRequired = errors.New("at least one item is required")
 )
 
 // CreateOrderCommand represents a request to create a new delivery order.
-// Encapsulates order details including destination and package volume requirements.
+// Encapsulates order details including destination and the item lines the package volume is derived from.
 //
 // Example:
 //
 //	orderID := kernel.NewUUID()
-//	cmd, err := NewCreateOrderCommand(orderID, "123 Main Street", 25)
+//	item, _ := order.NewItem("SKU-42", 5, 5)
+//	cmd, err := NewCreateOrderCommand(orderID, "123 Main Street", []order.Item{item})
 //	if err != nil {
 //	    return fmt.Errorf("invalid order data: %w", err)
 //	}
@@ -32,25 +36,210 @@ var (
 //	}
 //	fmt.Printf("Order %s created and awaiting courier assignment", orderID)
 type CreateOrderCommand struct { //nolint:recvcheck //using for validation
-	orderID kernel.UUID
-	street  string
-	volume  int
+	orderID       kernel.UUID
+	street        string
+	items         []order.Item
+	paymentMethod order.PaymentMethod
+	deliveryTier  order.DeliveryTier
+	declaredValue int
+
+	externalReference *order.ExternalReference
+	temperatureClass  order.TemperatureClass
+	basketID          *kernel.UUID
+	priority          order.Priority
 
 	guard guard.ConstructorGuard
 }
 
 // NewCreateOrderCommand creates a command to register a new delivery order.
-// Validates that order ID is valid, street is not empty, and volume is positive.
+// Validates that order ID is valid, street is not empty, and there is at least one valid item line.
 // Returns an error if any validation fails.
-func NewCreateOrderCommand(orderID kernel.UUID, street string, volume int) (CreateOrderCommand, error) {
+// The order is paid cash on delivery and delivered express.
+func NewCreateOrderCommand(orderID kernel.UUID, street string, items []order.Item) (CreateOrderCommand, error) {
+	return NewCreateOrderCommandWithPaymentMethod(orderID, street, items, order.CashOnDelivery)
+}
+
+// NewCreateOrderCommandWithPaymentMethod creates a command to register a new delivery order
+// paid with the given method. Orders paid online are not dispatched until the payment
+// provider confirms the payment.
+// Validates the same fields as NewCreateOrderCommand and the payment method.
+// The order is delivered express.
+//
+// Example:
+//
+//	cmd, err := NewCreateOrderCommandWithPaymentMethod(orderID, "123 Main Street", items, order.OnlinePayment)
+func NewCreateOrderCommandWithPaymentMethod(
+	orderID kernel.UUID,
+	street string,
+	items []order.Item,
+	paymentMethod order.PaymentMethod,
+) (CreateOrderCommand, error) {
+	return NewCreateOrderCommandWithDeliveryTier(orderID, street, items, paymentMethod, order.ExpressDelivery)
+}
+
+// NewCreateOrderCommandWithDeliveryTier creates a command to register a new delivery order
+// paid with the given method and delivered in the given tier. Economy orders wait for their
+// batching window to close before they are dispatched.
+// Validates the same fields as NewCreateOrderCommandWithPaymentMethod and the delivery tier.
+// No value is declared.
+//
+// Example:
+//
+//	cmd, err := NewCreateOrderCommandWithDeliveryTier(
+//	    orderID, "123 Main Street", items, order.CashOnDelivery, order.EconomyDelivery,
+//	)
+func NewCreateOrderCommandWithDeliveryTier(
+	orderID kernel.UUID,
+	street string,
+	items []order.Item,
+	paymentMethod order.PaymentMethod,
+	deliveryTier order.DeliveryTier,
+) (CreateOrderCommand, error) {
+	return NewCreateOrderCommandWithDeclaredValue(orderID, street, items, paymentMethod, deliveryTier, 0)
+}
+
+// NewCreateOrderCommandWithDeclaredValue creates a command to register a new delivery order
+// whose contents are worth declaredValue in minor currency units; zero declares no value.
+// Orders worth at least the insurance threshold go only to insured couriers.
+// Validates the same fields as NewCreateOrderCommandWithDeliveryTier and that the value is not negative.
+// The order is not linked to a marketplace order.
+//
+// Example:
+//
+//	cmd, err := NewCreateOrderCommandWithDeclaredValue(
+//	    orderID, "123 Main Street", items, order.CashOnDelivery, order.ExpressDelivery, 250_000,
+//	)
+func NewCreateOrderCommandWithDeclaredValue(
+	orderID kernel.UUID,
+	street string,
+	items []order.Item,
+	paymentMethod order.PaymentMethod,
+	deliveryTier order.DeliveryTier,
+	declaredValue int,
+) (CreateOrderCommand, error) {
+	return NewCreateOrderCommandWithExternalReference(
+		orderID, street, items, paymentMethod, deliveryTier, declaredValue, nil,
+	)
+}
+
+// NewCreateOrderCommandWithExternalReference creates a command to register a new delivery order
+// that fulfils the marketplace order externalReference refers to; nil links no marketplace order.
+// A marketplace order is delivered by at most one order.
+// Validates the same fields as NewCreateOrderCommandWithDeclaredValue and the reference.
+// The contents are ambient.
+//
+// Example:
+//
+//	ref, _ := order.NewExternalReference("ozon", "48213377-0021", "")
+//	cmd, err := NewCreateOrderCommandWithExternalReference(
+//	    orderID, "123 Main Street", items, order.CashOnDelivery, order.ExpressDelivery, 0, &ref,
+//	)
+func NewCreateOrderCommandWithExternalReference(
+	orderID kernel.UUID,
+	street string,
+	items []order.Item,
+	paymentMethod order.PaymentMethod,
+	deliveryTier order.DeliveryTier,
+	declaredValue int,
+	externalReference *order.ExternalReference,
+) (CreateOrderCommand, error) {
+	return NewCreateOrderCommandWithTemperatureClass(
+		orderID, street, items, paymentMethod, deliveryTier, declaredValue, externalReference, order.Ambient,
+	)
+}
+
+// NewCreateOrderCommandWithTemperatureClass creates a command to register a new delivery order
+// whose contents must be kept at the temperature of the given class on the way. Dispatch matching
+// rules may require thermal storage for chilled or frozen orders.
+// Validates the same fields as NewCreateOrderCommandWithExternalReference and the temperature class.
+// The order is not created from a basket.
+//
+// Example:
+//
+//	cmd, err := NewCreateOrderCommandWithTemperatureClass(
+//	    orderID, "123 Main Street", items, order.CashOnDelivery, order.ExpressDelivery, 0, nil, order.Frozen,
+//	)
+func NewCreateOrderCommandWithTemperatureClass(
+	orderID kernel.UUID,
+	street string,
+	items []order.Item,
+	paymentMethod order.PaymentMethod,
+	deliveryTier order.DeliveryTier,
+	declaredValue int,
+	externalReference *order.ExternalReference,
+	temperatureClass order.TemperatureClass,
+) (CreateOrderCommand, error) {
+	return NewCreateOrderCommandWithBasketID(
+		orderID, street, items, paymentMethod, deliveryTier, declaredValue, externalReference, temperatureClass, nil,
+	)
+}
+
+// NewCreateOrderCommandWithBasketID creates a command to register the delivery order of the
+// confirmed basket basketID; nil creates the order from no basket. The basket ID is the
+// idempotency key of the command: a basket is delivered by at most one order, so handling a
+// redelivered confirmation again succeeds without creating another order.
+// Validates the same fields as NewCreateOrderCommandWithTemperatureClass and the basket ID.
+//
+// Example:
+//
+//	basketID := kernel.NewUUID()
+//	cmd, err := NewCreateOrderCommandWithBasketID(
+//	    orderID, "123 Main Street", items, order.CashOnDelivery, order.ExpressDelivery, 0, nil, order.Ambient, &basketID,
+//	)
+func NewCreateOrderCommandWithBasketID(
+	orderID kernel.UUID,
+	street string,
+	items []order.Item,
+	paymentMethod order.PaymentMethod,
+	deliveryTier order.DeliveryTier,
+	declaredValue int,
+	externalReference *order.ExternalReference,
+	temperatureClass order.TemperatureClass,
+	basketID *kernel.UUID,
+) (CreateOrderCommand, error) {
+	return NewCreateOrderCommandWithPriority(
+		orderID, street, items, paymentMethod, deliveryTier, declaredValue, externalReference, temperatureClass, basketID,
+		order.NormalPriority,
+	)
+}
+
+// NewCreateOrderCommandWithPriority creates a command to register a new delivery order that is
+// dispatched with the given priority: waiting orders are assigned most urgent first.
+// Validates the same fields as NewCreateOrderCommandWithBasketID and the priority.
+//
+// Example:
+//
+//	cmd, err := NewCreateOrderCommandWithPriority(
+//	    orderID, "123 Main Street", items, order.CashOnDelivery, order.ExpressDelivery, 0, nil, order.Ambient, nil,
+//	    order.UrgentPriority,
+//	)
+func NewCreateOrderCommandWithPriority(
+	orderID kernel.UUID,
+	street string,
+	items []order.Item,
+	paymentMethod order.PaymentMethod,
+	deliveryTier order.DeliveryTier,
+	declaredValue int,
+	externalReference *order.ExternalReference,
+	temperatureClass order.TemperatureClass,
+	basketID *kernel.UUID,
+	priority order.Priority,
+) (CreateOrderCommand, error) {
 	orderCommand := CreateOrderCommand{
 		guard: guard.NewConstructorGuard(),
 	}
 
-	if err := errors.Join(
-		orderCommand.setOrderID(orderID),
-		orderCommand.setStreet(street),
-		orderCommand.setVolume(volume),
+	if err := errs.JoinFields(
+		errs.Field("id", orderCommand.setOrderID(orderID)),
+		errs.Field("street", orderCommand.setStreet(street)),
+		errs.Field("items", orderCommand.setItems(items)),
+		errs.Field("paymentMethod", orderCommand.setPaymentMethod(paymentMethod)),
+		errs.Field("deliveryTier", orderCommand.setDeliveryTier(deliveryTier)),
+		errs.Field("declaredValue", orderCommand.setDeclaredValue(declaredValue)),
+		errs.Field("externalReference", orderCommand.setExternalReference(externalReference)),
+		errs.Field("temperatureClass", orderCommand.setTemperatureClass(temperatureClass)),
+		errs.Field("basketID", orderCommand.setBasketID(basketID)),
+		errs.Field("priority", orderCommand.setPriority(priority)),
 	); err != nil {
 		return CreateOrderCommand{}, err
 	}
@@ -74,9 +263,55 @@ func (c CreateOrderCommand) Street() string {
 	return c.street
 }
 
-// Volume returns the package volume in cubic units.
+// Items returns a copy of the order's item lines.
+func (c CreateOrderCommand) Items() []order.Item {
+	items := make([]order.Item, len(c.items))
+	copy(items, c.items)
+	return items
+}
+
+// PaymentMethod returns how the customer pays for the order.
+func (c CreateOrderCommand) PaymentMethod() order.PaymentMethod {
+	return c.paymentMethod
+}
+
+// DeliveryTier returns the speed of delivery the customer ordered.
+func (c CreateOrderCommand) DeliveryTier() order.DeliveryTier {
+	return c.deliveryTier
+}
+
+// DeclaredValue returns the value of the order's contents in minor currency units; zero if none was declared.
+func (c CreateOrderCommand) DeclaredValue() int {
+	return c.declaredValue
+}
+
+// ExternalReference returns the marketplace order the order fulfils, nil if it is not linked to one.
+func (c CreateOrderCommand) ExternalReference() *order.ExternalReference {
+	return c.externalReference
+}
+
+// TemperatureClass returns the temperature the contents must be kept at on the way.
+func (c CreateOrderCommand) TemperatureClass() order.TemperatureClass {
+	return c.temperatureClass
+}
+
+// BasketID returns the basket the order is created from, nil if it is not created from one.
+func (c CreateOrderCommand) BasketID() *kernel.UUID {
+	return c.basketID
+}
+
+// Priority returns how urgently the order must be dispatched.
+func (c CreateOrderCommand) Priority() order.Priority {
+	return c.priority
+}
+
+// Volume returns the package volume in cubic units: the sum of the item line volumes.
 func (c CreateOrderCommand) Volume() int {
-	return c.volume
+	volume := 0
+	for _, item := range c.items {
+		volume += item.Volume()
+	}
+	return volume
 }
 
 func (c *CreateOrderCommand) setOrderID(orderID kernel.UUID) error {
@@ -97,11 +332,91 @@ func (c *CreateOrderCommand) setStreet(street string) error {
 	return nil
 }
 
-func (c *CreateOrderCommand) setVolume(volume int) error {
-	if volume <= 0 {
-		return ErrVolumeIsInvalid
+func (c *CreateOrderCommand) setItems(items []order.Item) error {
+	if len(items) == 0 {
+		return ErrItemsAreRequired
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			return err
+		}
+	}
+
+	c.items = append([]order.Item(nil), items...)
+	return nil
+}
+
+func (c *CreateOrderCommand) setPaymentMethod(paymentMethod order.PaymentMethod) error {
+	if err := paymentMethod.Validate(); err != nil {
+		return err
+	}
+
+	c.paymentMethod = paymentMethod
+	return nil
+}
+
+func (c *CreateOrderCommand) setDeliveryTier(deliveryTier order.DeliveryTier) error {
+	if err := deliveryTier.Validate(); err != nil {
+		return err
+	}
+
+	c.deliveryTier = deliveryTier
+	return nil
+}
+
+func (c *CreateOrderCommand) setDeclaredValue(declaredValue int) error {
+	if declaredValue < 0 {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"declared value is invalid",
+			fmt.Errorf("%d is negative", declaredValue),
+		)
+	}
+
+	c.declaredValue = declaredValue
+	return nil
+}
+
+func (c *CreateOrderCommand) setExternalReference(externalReference *order.ExternalReference) error {
+	if externalReference == nil {
+		return nil
+	}
+	if err := externalReference.Validate(); err != nil {
+		return err
+	}
+
+	ref := *externalReference
+	c.externalReference = &ref
+	return nil
+}
+
+func (c *CreateOrderCommand) setTemperatureClass(temperatureClass order.TemperatureClass) error {
+	if err := temperatureClass.Validate(); err != nil {
+		return err
+	}
+
+	c.temperatureClass = temperatureClass
+	return nil
+}
+
+func (c *CreateOrderCommand) setBasketID(basketID *kernel.UUID) error {
+	if basketID == nil {
+		return nil
+	}
+	if err := basketID.Validate(); err != nil {
+		return err
+	}
+
+	id := *basketID
+	c.basketID = &id
+	return nil
+}
+
+func (c *CreateOrderCommand) setPriority(priority order.Priority) error {
+	if err := priority.Validate(); err != nil {
+		return err
 	}
 
-	c.volume = volume
+	c.priority = priority
 	return nil
 }
@@ -4,68 +4,271 @@ import (
 	"context"
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tracing"
+	"errors"
+	"fmt"
+	"time"
 )
 
+var (
+	// ErrOrderIsRejectedAsFraud is returned when a fraud checker refuses the order.
+	ErrOrderIsRejectedAsFraud = errors.New("order is rejected by fraud check")
+)
+
+// unresolvedAddressReason is recorded on orders held because their address could not be resolved.
+const unresolvedAddressReason = "address could not be resolved"
+
 // CreateOrderCommandHandler handles the business logic for order creation.
-// Creates new orders with random delivery locations and initial "created" status.
+// Creates new orders in initial "created" status, located by the geo locator or at a random
+// location when the handler has none. An order whose address cannot be resolved is not refused:
+// it gets a provisional random location and is held for review until an operator checks it.
+// Every order is screened by the configured fraud checkers first: the most severe verdict
+// wins, so a rejection refuses the order and a review verdict holds it out of dispatch
+// until an operator approves it. Economy orders join the batch that is open when they are created
+// and enter dispatch when its batching window closes. Orders with a declared value at or above the
+// insurance threshold are insured. Orders placed on a marketplace are linked to the marketplace order.
+// Orders are dispatched with the priority of the command, most urgent first.
+// Commands carrying a basket ID are idempotent: a basket that already has an order is not ordered again.
 //
 // Example:
 //
 //	handler := NewCreateOrderCommandHandler(uowFactory)
 //	orderID := kernel.NewUUID()
-//	cmd, _ := NewCreateOrderCommand(orderID, "456 Oak Avenue", 15)
+//	item, _ := order.NewItem("SKU-42", 3, 5)
+//	cmd, _ := NewCreateOrderCommand(orderID, "456 Oak Avenue", []order.Item{item})
 //
 //	if err := handler.Handle(ctx, cmd); err != nil {
 //	    return fmt.Errorf("order creation failed: %w", err)
 //	}
 //	// Order is now created and ready for courier assignment
 type CreateOrderCommandHandler struct {
-	uowFactory OrderUoWFactory
+	uowFactory    OrderUoWFactory
+	fraudCheckers []ports.FraudChecker
+
+	// locator resolves delivery addresses; nil places orders at random locations
+	locator ports.GeoLocator
+
+	// batching is the batching window of economy orders
+	batching order.BatchingWindow
+
+	// insurance is the declared value from which orders are insured
+	insurance order.InsuranceThreshold
 }
 
 // NewCreateOrderCommandHandler creates a handler for order creation operations.
 // Requires an OrderUoWFactory for transactional persistence.
-func NewCreateOrderCommandHandler(uowFactory OrderUoWFactory) CreateOrderCommandHandler {
+// Optional fraud checkers are consulted in order; checking stops at the first rejection.
+func NewCreateOrderCommandHandler(
+	uowFactory OrderUoWFactory,
+	fraudCheckers ...ports.FraudChecker,
+) CreateOrderCommandHandler {
 	return CreateOrderCommandHandler{
-		uowFactory: uowFactory,
+		uowFactory:    uowFactory,
+		fraudCheckers: fraudCheckers,
 	}
 }
 
+// WithBatchingWindow returns a copy of the handler that batches economy orders over window.
+// Without a batching window economy orders are refused as invalid.
+func (h CreateOrderCommandHandler) WithBatchingWindow(window order.BatchingWindow) CreateOrderCommandHandler {
+	h.batching = window
+	return h
+}
+
+// WithInsuranceThreshold returns a copy of the handler that insures orders declared at or above threshold.
+// Without an insurance threshold orders with a declared value are refused as invalid.
+func (h CreateOrderCommandHandler) WithInsuranceThreshold(threshold order.InsuranceThreshold) CreateOrderCommandHandler {
+	h.insurance = threshold
+	return h
+}
+
+// WithGeoLocator returns a copy of the handler that resolves delivery addresses through locator.
+// Without a locator orders are placed at random locations.
+func (h CreateOrderCommandHandler) WithGeoLocator(locator ports.GeoLocator) CreateOrderCommandHandler {
+	h.locator = locator
+	return h
+}
+
 // Handle processes the order creation command.
-// Generates a random delivery location and creates the order in "created" status.
+// Locates the delivery address and creates the order in "created" status.
 // Uses transaction to ensure order is properly persisted or rolled back on error.
+// A replayed command whose basket already has an order succeeds without side effects:
+// neither fraud checkers nor the geo locator are consulted and no order is created.
+// Returns ErrOrderIsRejectedAsFraud if a fraud checker refuses the order and
+// order.ErrExternalReferenceIsAlreadyRegistered if another order fulfils its marketplace order.
 func (h *CreateOrderCommandHandler) Handle(ctx context.Context, cmd CreateOrderCommand) error {
+	ctx, span := tracing.Start(ctx, "CreateOrderCommand")
+	defer span.End()
+
 	if err := cmd.Validate(); err != nil {
 		return err
 	}
 
-	location, err := kernel.NewRandomLocation()
+	if basketID := cmd.BasketID(); basketID != nil {
+		ordered, err := h.isBasketOrdered(ctx, *basketID)
+		if err != nil {
+			return err
+		}
+		if ordered {
+			return nil
+		}
+	}
+
+	assessment, err := h.checkFraud(ctx, cmd)
 	if err != nil {
 		return err
 	}
+	if assessment.Verdict == ports.FraudVerdictReject {
+		return fmt.Errorf("%w: %s", ErrOrderIsRejectedAsFraud, assessment.Reason)
+	}
 
-	uow := h.uowFactory.Create()
-	if err = uow.Begin(ctx); err != nil {
+	location, unresolved, err := h.locate(ctx, cmd.Street())
+	if err != nil {
 		return err
 	}
 
-	defer func() {
-		_ = uow.Rollback(ctx)
-	}()
+	uow := h.uowFactory.Create()
+	err = uow.Do(ctx, func(ctx context.Context) error {
+		orderRepo := uow.OrderRepository()
+		o, err := order.NewOrderWithItems(cmd.OrderID(), location, cmd.Items())
+		if err != nil {
+			return err
+		}
 
-	orderRepo := uow.OrderRepository()
-	order, err := order.NewOrder(cmd.OrderID(), location, cmd.Volume())
-	if err != nil {
-		return err
+		if err = o.ChangePaymentMethod(cmd.PaymentMethod()); err != nil {
+			return err
+		}
+
+		if err = o.ChangeDeliveryTier(cmd.DeliveryTier(), h.batching, time.Now()); err != nil {
+			return err
+		}
+
+		if err = o.ChangeTemperatureClass(cmd.TemperatureClass()); err != nil {
+			return err
+		}
+
+		if err = o.ChangePriority(cmd.Priority()); err != nil {
+			return err
+		}
+
+		if cmd.DeclaredValue() > 0 {
+			if err = o.DeclareValue(cmd.DeclaredValue(), h.insurance); err != nil {
+				return err
+			}
+		}
+
+		if ref := cmd.ExternalReference(); ref != nil {
+			if err = o.LinkExternalReference(*ref); err != nil {
+				return err
+			}
+		}
+
+		if basketID := cmd.BasketID(); basketID != nil {
+			if err = o.LinkBasket(*basketID); err != nil {
+				return err
+			}
+		}
+
+		if assessment.Verdict == ports.FraudVerdictReview {
+			if err = o.HoldForReview(assessment.Reason); err != nil {
+				return err
+			}
+		}
+
+		if unresolved {
+			if err = holdUnresolvedAddress(o); err != nil {
+				return err
+			}
+		}
+
+		return orderRepo.Add(ctx, o)
+	})
+	if errors.Is(err, order.ErrBasketIsAlreadyOrdered) {
+		// A concurrent replay of the command created the order first
+		return nil
 	}
+	return err
+}
 
-	if err = orderRepo.Add(ctx, order); err != nil {
+// isBasketOrdered reports whether an order was already created from the basket.
+func (h *CreateOrderCommandHandler) isBasketOrdered(ctx context.Context, basketID kernel.UUID) (bool, error) {
+	uow := h.uowFactory.Create()
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		_, err := uow.OrderRepository().GetByBasketID(ctx, basketID)
 		return err
+	})
+	if errors.Is(err, errs.ErrObjectNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
 	}
+	return true, nil
+}
 
-	if err = uow.Commit(ctx); err != nil {
-		return err
+// checkFraud runs the fraud checkers and returns the most severe assessment.
+func (h *CreateOrderCommandHandler) checkFraud(ctx context.Context, cmd CreateOrderCommand) (ports.FraudAssessment, error) {
+	return screenOrder(ctx, h.fraudCheckers, ports.OrderFraudCheck{
+		OrderID: cmd.OrderID(),
+		Street:  cmd.Street(),
+		Volume:  cmd.Volume(),
+	})
+}
+
+// locate resolves the delivery address, or places the order at a random location without a locator.
+func (h *CreateOrderCommandHandler) locate(ctx context.Context, street string) (kernel.Location, bool, error) {
+	if h.locator == nil {
+		location, err := kernel.NewRandomLocation()
+		return location, false, err
+	}
+	return locateOrder(ctx, h.locator, street)
+}
+
+// locateOrder resolves the delivery address through the locator. An address the locator cannot
+// resolve does not refuse the order: it gets a provisional random location and unresolved is
+// reported, so the order is held until an operator checks the address.
+func locateOrder(ctx context.Context, locator ports.GeoLocator, street string) (kernel.Location, bool, error) {
+	location, err := locator.Locate(ctx, street)
+	if errors.Is(err, ports.ErrAddressIsUnresolved) {
+		location, err = kernel.NewRandomLocation()
+		return location, true, err
+	}
+	return location, false, err
+}
+
+// holdUnresolvedAddress holds the order for review of its address,
+// keeping the reason it is already held for, if any.
+func holdUnresolvedAddress(o *order.Order) error {
+	reason := unresolvedAddressReason
+	if o.IsUnderReview() {
+		reason = o.ReviewReason() + "; " + reason
+	}
+	return o.HoldForReview(reason)
+}
+
+// screenOrder runs the fraud checkers in order and returns the most severe assessment.
+// Checking stops at the first rejection.
+func screenOrder(
+	ctx context.Context,
+	checkers []ports.FraudChecker,
+	check ports.OrderFraudCheck,
+) (ports.FraudAssessment, error) {
+	result := ports.FraudAssessment{Verdict: ports.FraudVerdictClear}
+	for _, checker := range checkers {
+		assessment, err := checker.CheckOrder(ctx, check)
+		if err != nil {
+			return ports.FraudAssessment{}, err
+		}
+
+		if assessment.Verdict > result.Verdict {
+			result = assessment
+		}
+		if result.Verdict == ports.FraudVerdictReject {
+			break
+		}
 	}
 
-	return nil
+	return result, nil
 }
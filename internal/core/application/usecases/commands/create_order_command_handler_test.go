@@ -3,12 +3,15 @@ package commands_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"delivery/internal/core/application/usecases/commands"
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
 	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -24,12 +27,42 @@ func (m *MockOrderRepository) Update(_ context.Context, _ *order.Order) error {
 func (m *MockOrderRepository) Get(_ context.Context, _ kernel.UUID) (*order.Order, error) {
 	return nil, errors.New("not implemented in mock")
 }
-func (m *MockOrderRepository) GetFirstInCreatedStatus(_ context.Context) (*order.Order, error) {
+func (m *MockOrderRepository) GetByTrackingToken(_ context.Context, _ order.TrackingToken) (*order.Order, error) {
 	return nil, errors.New("not implemented in mock")
 }
+func (m *MockOrderRepository) GetByBasketID(ctx context.Context, basketID kernel.UUID) (*order.Order, error) {
+	args := m.Called(ctx, basketID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+func (m *MockOrderRepository) GetNextDispatchable(_ context.Context) (*order.Order, error) {
+	return nil, errors.New("not implemented in mock")
+}
+func (m *MockOrderRepository) GetDispatchable(_ context.Context, _ int) ([]*order.Order, error) {
+	return nil, errors.New("not implemented in mock")
+}
+func (m *MockOrderRepository) GetIDsMatching(_ context.Context, _ ports.OrderFilter, _ int) ([]kernel.UUID, error) {
+	return nil, errors.New("not implemented in mock")
+}
+
+func (m *MockOrderRepository) GetAllAwaitingBatch(_ context.Context) ([]*order.Order, error) {
+	return nil, errors.New("not implemented in mock")
+}
+
 func (m *MockOrderRepository) GetAllInAssignedStatus(_ context.Context) ([]*order.Order, error) {
 	return nil, errors.New("not implemented in mock")
 }
+func (m *MockOrderRepository) ArchiveCompleted(_ context.Context, _ time.Time, _ int) (int, error) {
+	return 0, errors.New("not implemented in mock")
+}
+func (m *MockOrderRepository) GetArchived(_ context.Context, _ kernel.UUID) (*order.Order, error) {
+	return nil, errors.New("not implemented in mock")
+}
+func (m *MockOrderRepository) GetAllArchivedCompletedBetween(_ context.Context, _, _ time.Time) ([]*order.Order, error) {
+	return nil, errors.New("not implemented in mock")
+}
 
 type MockOrderUoW struct{ mock.Mock }
 
@@ -37,6 +70,9 @@ func (m *MockOrderUoW) Begin(ctx context.Context) error {
 	args := m.Called(ctx)
 	return args.Error(0)
 }
+func (m *MockOrderUoW) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return doInTransaction(ctx, m, fn)
+}
 func (m *MockOrderUoW) Commit(ctx context.Context) error {
 	args := m.Called(ctx)
 	return args.Error(0)
@@ -58,19 +94,42 @@ func (m *MockOrderUoWFactory) Create() commands.OrderUoW {
 	return args.Get(0).(commands.OrderUoW)
 }
 
+// doInTransaction runs fn like the unit of work does, through the Begin, Commit and Rollback of the mock.
+func doInTransaction(ctx context.Context, tx commands.TxManager, fn func(ctx context.Context) error) (err error) {
+	if err = tx.Begin(ctx); err != nil {
+		return err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			_ = tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	if err = fn(ctx); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
 func TestCreateOrderCommandHandler_Handle_Success(t *testing.T) {
 	ctx := t.Context()
 	id := kernel.NewUUID()
-	cmd, _ := commands.NewCreateOrderCommand(id, "Main St", 10)
+	cmd, _ := commands.NewCreateOrderCommand(id, "Main St", createOrderItems(t))
 
+	var added *order.Order
 	repo := new(MockOrderRepository)
 	uow := new(MockOrderUoW)
 	mock.InOrder(
 		uow.On("Begin", ctx).Return(nil).Once(),
 		uow.On("OrderRepository").Return(repo).Once(),
-		repo.On("Add", mock.Anything, mock.AnythingOfType("*order.Order")).Return(nil).Once(),
+		repo.On("Add", mock.Anything, mock.AnythingOfType("*order.Order")).
+			Run(func(args mock.Arguments) { added = args.Get(1).(*order.Order) }).Return(nil).Once(),
 		uow.On("Commit", ctx).Return(nil).Once(),
-		uow.On("Rollback", ctx).Return(nil).Once(),
 	)
 
 	factory := new(MockOrderUoWFactory)
@@ -79,6 +138,9 @@ func TestCreateOrderCommandHandler_Handle_Success(t *testing.T) {
 	h := commands.NewCreateOrderCommandHandler(factory)
 	err := h.Handle(ctx, cmd)
 	require.NoError(t, err)
+	require.NotNil(t, added)
+	require.Equal(t, cmd.Items(), added.Items())
+	require.Equal(t, 10, added.Volume())
 	repo.AssertExpectations(t)
 	uow.AssertExpectations(t)
 	factory.AssertExpectations(t)
@@ -96,7 +158,7 @@ func TestCreateOrderCommandHandler_Handle_ValidationError(t *testing.T) {
 func TestCreateOrderCommandHandler_Handle_BeginError(t *testing.T) {
 	ctx := t.Context()
 	id := kernel.NewUUID()
-	cmd, _ := commands.NewCreateOrderCommand(id, "Main St", 10)
+	cmd, _ := commands.NewCreateOrderCommand(id, "Main St", createOrderItems(t))
 
 	uow := new(MockOrderUoW)
 	factory := new(MockOrderUoWFactory)
@@ -113,7 +175,7 @@ func TestCreateOrderCommandHandler_Handle_BeginError(t *testing.T) {
 func TestCreateOrderCommandHandler_Handle_AddError(t *testing.T) {
 	ctx := t.Context()
 	id := kernel.NewUUID()
-	cmd, _ := commands.NewCreateOrderCommand(id, "Main St", 10)
+	cmd, _ := commands.NewCreateOrderCommand(id, "Main St", createOrderItems(t))
 
 	repo := new(MockOrderRepository)
 	uow := new(MockOrderUoW)
@@ -138,7 +200,7 @@ func TestCreateOrderCommandHandler_Handle_AddError(t *testing.T) {
 func TestCreateOrderCommandHandler_Handle_CommitError(t *testing.T) {
 	ctx := t.Context()
 	id := kernel.NewUUID()
-	cmd, _ := commands.NewCreateOrderCommand(id, "Main St", 10)
+	cmd, _ := commands.NewCreateOrderCommand(id, "Main St", createOrderItems(t))
 
 	repo := new(MockOrderRepository)
 	uow := new(MockOrderUoW)
@@ -160,3 +222,412 @@ func TestCreateOrderCommandHandler_Handle_CommitError(t *testing.T) {
 	uow.AssertExpectations(t)
 	factory.AssertExpectations(t)
 }
+
+type MockFraudChecker struct{ mock.Mock }
+
+func (m *MockFraudChecker) CheckOrder(ctx context.Context, check ports.OrderFraudCheck) (ports.FraudAssessment, error) {
+	args := m.Called(ctx, check)
+	return args.Get(0).(ports.FraudAssessment), args.Error(1)
+}
+
+func TestCreateOrderCommandHandler_Handle_FraudReject(t *testing.T) {
+	ctx := t.Context()
+	id := kernel.NewUUID()
+	cmd, _ := commands.NewCreateOrderCommand(id, "Main St", createOrderItems(t))
+
+	reviewing := new(MockFraudChecker)
+	rejecting := new(MockFraudChecker)
+	skipped := new(MockFraudChecker)
+	check := ports.OrderFraudCheck{OrderID: id, Street: "Main St", Volume: 10}
+	reviewing.On("CheckOrder", ctx, check).
+		Return(ports.FraudAssessment{Verdict: ports.FraudVerdictReview, Reason: "new customer"}, nil).Once()
+	rejecting.On("CheckOrder", ctx, check).
+		Return(ports.FraudAssessment{Verdict: ports.FraudVerdictReject, Reason: "blacklisted address"}, nil).Once()
+
+	factory := new(MockOrderUoWFactory)
+
+	h := commands.NewCreateOrderCommandHandler(factory, reviewing, rejecting, skipped)
+	err := h.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, commands.ErrOrderIsRejectedAsFraud)
+	require.ErrorContains(t, err, "blacklisted address")
+	factory.AssertNotCalled(t, "Create")
+	skipped.AssertNotCalled(t, "CheckOrder", mock.Anything, mock.Anything)
+}
+
+func TestCreateOrderCommandHandler_Handle_FraudReviewHoldsOrder(t *testing.T) {
+	ctx := t.Context()
+	id := kernel.NewUUID()
+	cmd, _ := commands.NewCreateOrderCommand(id, "Main St", createOrderItems(t))
+
+	passing := new(MockFraudChecker)
+	reviewing := new(MockFraudChecker)
+	passing.On("CheckOrder", ctx, mock.Anything).Return(ports.FraudAssessment{}, nil).Once()
+	reviewing.On("CheckOrder", ctx, mock.Anything).
+		Return(ports.FraudAssessment{Verdict: ports.FraudVerdictReview, Reason: "unusual volume"}, nil).Once()
+
+	var added *order.Order
+	repo := new(MockOrderRepository)
+	uow := new(MockOrderUoW)
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(repo).Once()
+	repo.On("Add", mock.Anything, mock.AnythingOfType("*order.Order")).
+		Run(func(args mock.Arguments) { added = args.Get(1).(*order.Order) }).Return(nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	factory := new(MockOrderUoWFactory)
+	factory.On("Create").Return(uow).Once()
+
+	h := commands.NewCreateOrderCommandHandler(factory, passing, reviewing)
+	err := h.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	require.NotNil(t, added)
+	require.True(t, added.IsUnderReview())
+	require.Equal(t, "unusual volume", added.ReviewReason())
+	passing.AssertExpectations(t)
+	reviewing.AssertExpectations(t)
+}
+
+func TestCreateOrderCommandHandler_Handle_OnlinePaymentAwaitsPayment(t *testing.T) {
+	ctx := t.Context()
+	cmd, _ := commands.NewCreateOrderCommandWithPaymentMethod(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.OnlinePayment,
+	)
+
+	var added *order.Order
+	repo := new(MockOrderRepository)
+	uow := new(MockOrderUoW)
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(repo).Once()
+	repo.On("Add", mock.Anything, mock.AnythingOfType("*order.Order")).
+		Run(func(args mock.Arguments) { added = args.Get(1).(*order.Order) }).Return(nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	factory := new(MockOrderUoWFactory)
+	factory.On("Create").Return(uow).Once()
+
+	h := commands.NewCreateOrderCommandHandler(factory)
+	err := h.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	require.NotNil(t, added)
+	require.Equal(t, order.OnlinePayment, added.PaymentMethod())
+	require.ErrorIs(t, added.ValidateAssign(), order.ErrOrderIsAwaitingPayment)
+}
+
+func TestCreateOrderCommandHandler_Handle_EconomyOrderAwaitsBatch(t *testing.T) {
+	ctx := t.Context()
+	cmd, _ := commands.NewCreateOrderCommandWithDeliveryTier(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.CashOnDelivery, order.EconomyDelivery,
+	)
+	window, _ := order.NewBatchingWindow(30 * time.Minute)
+
+	var added *order.Order
+	repo := new(MockOrderRepository)
+	uow := new(MockOrderUoW)
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(repo).Once()
+	repo.On("Add", mock.Anything, mock.AnythingOfType("*order.Order")).
+		Run(func(args mock.Arguments) { added = args.Get(1).(*order.Order) }).Return(nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	factory := new(MockOrderUoWFactory)
+	factory.On("Create").Return(uow).Once()
+
+	h := commands.NewCreateOrderCommandHandler(factory).WithBatchingWindow(window)
+	err := h.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	require.NotNil(t, added)
+	require.Equal(t, order.EconomyDelivery, added.DeliveryTier())
+	require.NotNil(t, added.BatchClosesAt())
+	require.WithinDuration(t, time.Now(), *added.BatchClosesAt(), 30*time.Minute)
+	require.ErrorIs(t, added.ValidateAssign(), order.ErrOrderIsAwaitingBatch)
+}
+
+func TestCreateOrderCommandHandler_Handle_HighValueOrderIsInsured(t *testing.T) {
+	ctx := t.Context()
+	cmd, _ := commands.NewCreateOrderCommandWithDeclaredValue(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.CashOnDelivery, order.ExpressDelivery, 250_000,
+	)
+	threshold, _ := order.NewInsuranceThreshold(100_000)
+
+	var added *order.Order
+	repo := new(MockOrderRepository)
+	uow := new(MockOrderUoW)
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(repo).Once()
+	repo.On("Add", mock.Anything, mock.AnythingOfType("*order.Order")).
+		Run(func(args mock.Arguments) { added = args.Get(1).(*order.Order) }).Return(nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	factory := new(MockOrderUoWFactory)
+	factory.On("Create").Return(uow).Once()
+
+	h := commands.NewCreateOrderCommandHandler(factory).WithInsuranceThreshold(threshold)
+	err := h.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	require.NotNil(t, added)
+	require.Equal(t, 250_000, added.DeclaredValue())
+	require.True(t, added.IsInsuranceRequired())
+}
+
+func TestCreateOrderCommandHandler_Handle_MarketplaceOrderIsLinked(t *testing.T) {
+	ctx := t.Context()
+	ref, _ := order.NewExternalReference("ozon", "48213377-0021", "")
+	cmd, _ := commands.NewCreateOrderCommandWithExternalReference(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.CashOnDelivery, order.ExpressDelivery, 0, &ref,
+	)
+
+	var added *order.Order
+	repo := new(MockOrderRepository)
+	uow := new(MockOrderUoW)
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(repo).Once()
+	repo.On("Add", mock.Anything, mock.AnythingOfType("*order.Order")).
+		Run(func(args mock.Arguments) { added = args.Get(1).(*order.Order) }).Return(nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	factory := new(MockOrderUoWFactory)
+	factory.On("Create").Return(uow).Once()
+
+	h := commands.NewCreateOrderCommandHandler(factory)
+	err := h.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	require.NotNil(t, added)
+	require.NotNil(t, added.ExternalReference())
+	require.True(t, added.ExternalReference().Refers(ref))
+}
+
+func TestCreateOrderCommandHandler_Handle_FrozenOrder(t *testing.T) {
+	ctx := t.Context()
+	cmd, _ := commands.NewCreateOrderCommandWithTemperatureClass(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.CashOnDelivery, order.ExpressDelivery, 0, nil, order.Frozen,
+	)
+
+	var added *order.Order
+	repo := new(MockOrderRepository)
+	uow := new(MockOrderUoW)
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(repo).Once()
+	repo.On("Add", mock.Anything, mock.AnythingOfType("*order.Order")).
+		Run(func(args mock.Arguments) { added = args.Get(1).(*order.Order) }).Return(nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	factory := new(MockOrderUoWFactory)
+	factory.On("Create").Return(uow).Once()
+
+	h := commands.NewCreateOrderCommandHandler(factory)
+	err := h.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	require.NotNil(t, added)
+	require.Equal(t, order.Frozen, added.TemperatureClass())
+}
+
+func TestCreateOrderCommandHandler_Handle_UrgentOrder(t *testing.T) {
+	ctx := t.Context()
+	cmd, _ := commands.NewCreateOrderCommandWithPriority(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.CashOnDelivery, order.ExpressDelivery, 0, nil,
+		order.Ambient, nil, order.UrgentPriority,
+	)
+
+	var added *order.Order
+	repo := new(MockOrderRepository)
+	uow := new(MockOrderUoW)
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(repo).Once()
+	repo.On("Add", mock.Anything, mock.AnythingOfType("*order.Order")).
+		Run(func(args mock.Arguments) { added = args.Get(1).(*order.Order) }).Return(nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	factory := new(MockOrderUoWFactory)
+	factory.On("Create").Return(uow).Once()
+
+	h := commands.NewCreateOrderCommandHandler(factory)
+	err := h.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	require.NotNil(t, added)
+	require.Equal(t, order.UrgentPriority, added.Priority())
+}
+
+func TestCreateOrderCommandHandler_Handle_MarketplaceOrderIsAlreadyRegistered(t *testing.T) {
+	ctx := t.Context()
+	ref, _ := order.NewExternalReference("ozon", "48213377-0021", "")
+	cmd, _ := commands.NewCreateOrderCommandWithExternalReference(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.CashOnDelivery, order.ExpressDelivery, 0, &ref,
+	)
+
+	repo := new(MockOrderRepository)
+	uow := new(MockOrderUoW)
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(repo).Once()
+	repo.On("Add", mock.Anything, mock.AnythingOfType("*order.Order")).
+		Return(order.ErrExternalReferenceIsAlreadyRegistered).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	factory := new(MockOrderUoWFactory)
+	factory.On("Create").Return(uow).Once()
+
+	h := commands.NewCreateOrderCommandHandler(factory)
+	err := h.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, order.ErrExternalReferenceIsAlreadyRegistered)
+	uow.AssertNotCalled(t, "Commit", ctx)
+}
+
+func TestCreateOrderCommandHandler_Handle_BasketOrderIsLinked(t *testing.T) {
+	ctx := t.Context()
+	basketID := kernel.NewUUID()
+	cmd, _ := commands.NewCreateOrderCommandWithBasketID(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.CashOnDelivery, order.ExpressDelivery, 0, nil,
+		order.Ambient, &basketID,
+	)
+
+	var added *order.Order
+	repo := new(MockOrderRepository)
+	uow := new(MockOrderUoW)
+	uow.On("Begin", ctx).Return(nil).Twice()
+	uow.On("OrderRepository").Return(repo).Twice()
+	repo.On("GetByBasketID", ctx, basketID).Return(nil, errs.NewObjectNotFoundError("order", basketID)).Once()
+	repo.On("Add", mock.Anything, mock.AnythingOfType("*order.Order")).
+		Run(func(args mock.Arguments) { added = args.Get(1).(*order.Order) }).Return(nil).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	factory := new(MockOrderUoWFactory)
+	factory.On("Create").Return(uow).Twice()
+
+	h := commands.NewCreateOrderCommandHandler(factory)
+	err := h.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	require.NotNil(t, added)
+	require.NotNil(t, added.BasketID())
+	require.Equal(t, basketID, *added.BasketID())
+	repo.AssertExpectations(t)
+	uow.AssertExpectations(t)
+}
+
+func TestCreateOrderCommandHandler_Handle_ReplayedBasketIsNotOrderedAgain(t *testing.T) {
+	ctx := t.Context()
+	basketID := kernel.NewUUID()
+	cmd, _ := commands.NewCreateOrderCommandWithBasketID(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.CashOnDelivery, order.ExpressDelivery, 0, nil,
+		order.Ambient, &basketID,
+	)
+	location, err := kernel.NewLocation(5, 7)
+	require.NoError(t, err)
+	existing, err := order.NewOrderWithItems(kernel.NewUUID(), location, createOrderItems(t))
+	require.NoError(t, err)
+	require.NoError(t, existing.LinkBasket(basketID))
+
+	repo := new(MockOrderRepository)
+	uow := new(MockOrderUoW)
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(repo).Once()
+	repo.On("GetByBasketID", ctx, basketID).Return(existing, nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	factory := new(MockOrderUoWFactory)
+	factory.On("Create").Return(uow).Once()
+	checker := new(MockFraudChecker)
+
+	h := commands.NewCreateOrderCommandHandler(factory, checker)
+	err = h.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	repo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	uow.AssertExpectations(t)
+	checker.AssertNotCalled(t, "CheckOrder", mock.Anything, mock.Anything)
+}
+
+func TestCreateOrderCommandHandler_Handle_ConcurrentReplayOfBasketSucceeds(t *testing.T) {
+	ctx := t.Context()
+	basketID := kernel.NewUUID()
+	cmd, _ := commands.NewCreateOrderCommandWithBasketID(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.CashOnDelivery, order.ExpressDelivery, 0, nil,
+		order.Ambient, &basketID,
+	)
+
+	repo := new(MockOrderRepository)
+	uow := new(MockOrderUoW)
+	uow.On("Begin", ctx).Return(nil).Twice()
+	uow.On("OrderRepository").Return(repo).Twice()
+	repo.On("GetByBasketID", ctx, basketID).Return(nil, errs.NewObjectNotFoundError("order", basketID)).Once()
+	repo.On("Add", mock.Anything, mock.AnythingOfType("*order.Order")).Return(order.ErrBasketIsAlreadyOrdered).Once()
+	uow.On("Rollback", ctx).Return(nil).Twice()
+
+	factory := new(MockOrderUoWFactory)
+	factory.On("Create").Return(uow).Twice()
+
+	h := commands.NewCreateOrderCommandHandler(factory)
+	err := h.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	uow.AssertNotCalled(t, "Commit", ctx)
+}
+
+func TestCreateOrderCommandHandler_Handle_FraudCheckError(t *testing.T) {
+	ctx := t.Context()
+	cmd, _ := commands.NewCreateOrderCommand(kernel.NewUUID(), "Main St", createOrderItems(t))
+
+	failing := new(MockFraudChecker)
+	failing.On("CheckOrder", ctx, mock.Anything).Return(ports.FraudAssessment{}, errors.New("blacklist unavailable")).Once()
+
+	factory := new(MockOrderUoWFactory)
+
+	h := commands.NewCreateOrderCommandHandler(factory, failing)
+	err := h.Handle(ctx, cmd)
+
+	require.EqualError(t, err, "blacklist unavailable")
+	factory.AssertNotCalled(t, "Create")
+}
+
+func TestCreateOrderCommandHandler_Handle_UnresolvedAddressHoldsOrder(t *testing.T) {
+	ctx := t.Context()
+	cmd, _ := commands.NewCreateOrderCommand(kernel.NewUUID(), "Main St", createOrderItems(t))
+
+	reviewing := new(MockFraudChecker)
+	reviewing.On("CheckOrder", ctx, mock.Anything).
+		Return(ports.FraudAssessment{Verdict: ports.FraudVerdictReview, Reason: "unusual volume"}, nil).Once()
+
+	var added *order.Order
+	repo := new(MockOrderRepository)
+	uow := new(MockOrderUoW)
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(repo).Once()
+	repo.On("Add", mock.Anything, mock.AnythingOfType("*order.Order")).
+		Run(func(args mock.Arguments) { added = args.Get(1).(*order.Order) }).Return(nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	factory := new(MockOrderUoWFactory)
+	factory.On("Create").Return(uow).Once()
+
+	locator := stubGeoLocator{err: fmt.Errorf("%w: geo unavailable", ports.ErrAddressIsUnresolved)}
+	h := commands.NewCreateOrderCommandHandler(factory, reviewing).WithGeoLocator(locator)
+	err := h.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	require.NotNil(t, added)
+	require.True(t, added.IsUnderReview())
+	require.Equal(t, "unusual volume; address could not be resolved", added.ReviewReason())
+}
+
+func TestCreateOrderCommandHandler_Handle_GeoLocatorError(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	cmd, _ := commands.NewCreateOrderCommand(kernel.NewUUID(), "Main St", createOrderItems(t))
+
+	factory := new(MockOrderUoWFactory)
+
+	h := commands.NewCreateOrderCommandHandler(factory).WithGeoLocator(stubGeoLocator{err: context.Canceled})
+	err := h.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, context.Canceled)
+	factory.AssertNotCalled(t, "Create")
+}
@@ -5,43 +5,187 @@ import (
 
 	"delivery/internal/core/application/usecases/commands"
 	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+// createOrderItems returns item lines with a total volume of 10.
+func createOrderItems(t *testing.T) []order.Item {
+	t.Helper()
+	books, err := order.NewItem("BOOK-1", 2, 3)
+	require.NoError(t, err)
+	lamp, err := order.NewItem("LAMP-7", 1, 4)
+	require.NoError(t, err)
+	return []order.Item{books, lamp}
+}
+
 func TestNewCreateOrderCommand_ValidInput(t *testing.T) {
 	id := kernel.NewUUID()
-	cmd, err := commands.NewCreateOrderCommand(id, "Main St", 10)
+	items := createOrderItems(t)
+	cmd, err := commands.NewCreateOrderCommand(id, "Main St", items)
 	require.NoError(t, err)
 	assert.Equal(t, id, cmd.OrderID())
 	assert.Equal(t, "Main St", cmd.Street())
+	assert.Equal(t, items, cmd.Items())
 	assert.Equal(t, 10, cmd.Volume())
+	assert.Equal(t, order.CashOnDelivery, cmd.PaymentMethod())
+	assert.Equal(t, order.ExpressDelivery, cmd.DeliveryTier())
+}
+
+func TestNewCreateOrderCommandWithPaymentMethod(t *testing.T) {
+	cmd, err := commands.NewCreateOrderCommandWithPaymentMethod(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.OnlinePayment,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, order.OnlinePayment, cmd.PaymentMethod())
+
+	_, err = commands.NewCreateOrderCommandWithPaymentMethod(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.UnknownPaymentMethod,
+	)
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
+
+func TestNewCreateOrderCommandWithDeliveryTier(t *testing.T) {
+	cmd, err := commands.NewCreateOrderCommandWithDeliveryTier(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.CashOnDelivery, order.EconomyDelivery,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, order.EconomyDelivery, cmd.DeliveryTier())
+
+	_, err = commands.NewCreateOrderCommandWithDeliveryTier(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.CashOnDelivery, order.UnknownDeliveryTier,
+	)
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
+
+func TestNewCreateOrderCommandWithDeclaredValue(t *testing.T) {
+	cmd, err := commands.NewCreateOrderCommandWithDeclaredValue(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.CashOnDelivery, order.ExpressDelivery, 250_000,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 250_000, cmd.DeclaredValue())
+
+	_, err = commands.NewCreateOrderCommandWithDeclaredValue(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.CashOnDelivery, order.ExpressDelivery, -1,
+	)
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
+
+func TestNewCreateOrderCommandWithExternalReference(t *testing.T) {
+	ref, err := order.NewExternalReference("ozon", "48213377-0021", "")
+	require.NoError(t, err)
+
+	cmd, err := commands.NewCreateOrderCommandWithExternalReference(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.CashOnDelivery, order.ExpressDelivery, 0, &ref,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, cmd.ExternalReference())
+	assert.True(t, cmd.ExternalReference().Refers(ref))
+
+	cmd, err = commands.NewCreateOrderCommandWithDeclaredValue(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.CashOnDelivery, order.ExpressDelivery, 0,
+	)
+	require.NoError(t, err)
+	assert.Nil(t, cmd.ExternalReference())
+
+	_, err = commands.NewCreateOrderCommandWithExternalReference(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.CashOnDelivery, order.ExpressDelivery, 0,
+		&order.ExternalReference{},
+	)
+	require.ErrorIs(t, err, order.ErrExternalReferenceIsNotConstructed)
+}
+
+func TestNewCreateOrderCommandWithTemperatureClass(t *testing.T) {
+	cmd, err := commands.NewCreateOrderCommandWithTemperatureClass(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.CashOnDelivery, order.ExpressDelivery, 0, nil, order.Frozen,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, order.Frozen, cmd.TemperatureClass())
+
+	cmd, err = commands.NewCreateOrderCommand(kernel.NewUUID(), "Main St", createOrderItems(t))
+	require.NoError(t, err)
+	assert.Equal(t, order.Ambient, cmd.TemperatureClass())
+
+	_, err = commands.NewCreateOrderCommandWithTemperatureClass(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.CashOnDelivery, order.ExpressDelivery, 0, nil,
+		order.UnknownTemperatureClass,
+	)
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
+
+func TestNewCreateOrderCommandWithBasketID(t *testing.T) {
+	basketID := kernel.NewUUID()
+	cmd, err := commands.NewCreateOrderCommandWithBasketID(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.CashOnDelivery, order.ExpressDelivery, 0, nil,
+		order.Ambient, &basketID,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, cmd.BasketID())
+	assert.Equal(t, basketID, *cmd.BasketID())
+
+	cmd, err = commands.NewCreateOrderCommand(kernel.NewUUID(), "Main St", createOrderItems(t))
+	require.NoError(t, err)
+	assert.Nil(t, cmd.BasketID())
+
+	_, err = commands.NewCreateOrderCommandWithBasketID(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.CashOnDelivery, order.ExpressDelivery, 0, nil,
+		order.Ambient, &kernel.UUID{},
+	)
+	require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+}
+
+func TestNewCreateOrderCommandWithPriority(t *testing.T) {
+	cmd, err := commands.NewCreateOrderCommandWithPriority(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.CashOnDelivery, order.ExpressDelivery, 0, nil,
+		order.Ambient, nil, order.UrgentPriority,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, order.UrgentPriority, cmd.Priority())
+
+	cmd, err = commands.NewCreateOrderCommand(kernel.NewUUID(), "Main St", createOrderItems(t))
+	require.NoError(t, err)
+	assert.Equal(t, order.NormalPriority, cmd.Priority())
+
+	_, err = commands.NewCreateOrderCommandWithPriority(
+		kernel.NewUUID(), "Main St", createOrderItems(t), order.CashOnDelivery, order.ExpressDelivery, 0, nil,
+		order.Ambient, nil, order.UnknownPriority,
+	)
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
 }
 
 func TestNewCreateOrderCommand_InvalidInput(t *testing.T) {
 	id := kernel.NewUUID()
-	_, err := commands.NewCreateOrderCommand(id, "", 0)
+	_, err := commands.NewCreateOrderCommand(id, "", nil)
 	require.Error(t, err)
 }
 
 func TestNewCreateOrderCommand_InvalidOrderID(t *testing.T) {
 	invalidID := kernel.UUID{} // zero value, should trigger validation error
-	_, err := commands.NewCreateOrderCommand(invalidID, "Main St", 10)
+	_, err := commands.NewCreateOrderCommand(invalidID, "Main St", createOrderItems(t))
 	require.Error(t, err)
 	assert.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
 }
 
 func TestNewCreateOrderCommand_EmptyStreet(t *testing.T) {
 	id := kernel.NewUUID()
-	_, err := commands.NewCreateOrderCommand(id, "", 10)
+	_, err := commands.NewCreateOrderCommand(id, "", createOrderItems(t))
 	require.Error(t, err)
 	assert.ErrorIs(t, err, commands.ErrStreetIsRequired)
 }
 
-func TestNewCreateOrderCommand_InvalidVolume(t *testing.T) {
+func TestNewCreateOrderCommand_NoItems(t *testing.T) {
+	id := kernel.NewUUID()
+	_, err := commands.NewCreateOrderCommand(id, "Main St", nil)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, commands.ErrItemsAreRequired)
+}
+
+func TestNewCreateOrderCommand_InvalidItem(t *testing.T) {
 	id := kernel.NewUUID()
-	_, err := commands.NewCreateOrderCommand(id, "Main St", 0)
+	_, err := commands.NewCreateOrderCommand(id, "Main St", []order.Item{{}})
 	require.Error(t, err)
-	assert.ErrorIs(t, err, commands.ErrVolumeIsInvalid)
+	assert.ErrorIs(t, err, order.ErrItemIsNotConstructed)
 }
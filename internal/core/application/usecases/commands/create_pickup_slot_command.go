@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrCreatePickupSlotCommandIsNotConstructed = errors.New(
+		"CreatePickupSlotCommand must be created via NewCreatePickupSlotCommand constructor",
+	)
+)
+
+// CreatePickupSlotCommand represents dispatch opening a pickup window at the warehouse.
+//
+// Example:
+//
+//	cmd := NewCreatePickupSlotCommand(startsAt, startsAt.Add(30*time.Minute), 5)
+//
+//	handler := NewCreatePickupSlotCommandHandler(uowFactory)
+//	slot, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    return fmt.Errorf("failed to create pickup slot: %w", err)
+//	}
+type CreatePickupSlotCommand struct { //nolint:recvcheck //using for validation
+	startsAt time.Time
+	endsAt   time.Time
+	capacity int
+
+	guard guard.ConstructorGuard
+}
+
+// NewCreatePickupSlotCommand creates a command to open a pickup slot.
+// The window and capacity are validated by the pickup slot aggregate.
+func NewCreatePickupSlotCommand(startsAt, endsAt time.Time, capacity int) CreatePickupSlotCommand {
+	return CreatePickupSlotCommand{
+		startsAt: startsAt,
+		endsAt:   endsAt,
+		capacity: capacity,
+		guard:    guard.NewConstructorGuard(),
+	}
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrCreatePickupSlotCommandIsNotConstructed if validation fails.
+func (c CreatePickupSlotCommand) Validate() error {
+	return c.guard.Validate(ErrCreatePickupSlotCommandIsNotConstructed)
+}
+
+// StartsAt returns when the warehouse starts handing out orders of the slot.
+func (c CreatePickupSlotCommand) StartsAt() time.Time {
+	return c.startsAt
+}
+
+// EndsAt returns when the slot is over.
+func (c CreatePickupSlotCommand) EndsAt() time.Time {
+	return c.endsAt
+}
+
+// Capacity returns the number of orders the slot takes.
+func (c CreatePickupSlotCommand) Capacity() int {
+	return c.capacity
+}
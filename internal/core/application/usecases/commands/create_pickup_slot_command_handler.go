@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/pickup"
+	"delivery/internal/pkg/tracing"
+)
+
+// CreatePickupSlotCommandHandler opens pickup slots at the warehouse.
+// Orders are booked into the new slot as soon as it is committed.
+//
+// Example:
+//
+//	handler := NewCreatePickupSlotCommandHandler(uowFactory)
+//	cmd := NewCreatePickupSlotCommand(startsAt, endsAt, 5)
+//	slot, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    log.Printf("Failed to create pickup slot: %v", err)
+//	}
+type CreatePickupSlotCommandHandler struct {
+	uowFactory PickupSlotUoWFactory
+}
+
+// NewCreatePickupSlotCommandHandler creates a new handler for opening pickup slots.
+// Requires a PickupSlotUoWFactory for transactional operations.
+func NewCreatePickupSlotCommandHandler(uowFactory PickupSlotUoWFactory) CreatePickupSlotCommandHandler {
+	return CreatePickupSlotCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the CreatePickupSlotCommand within a transaction and returns the new slot.
+// Returns a validation error when the window is empty or the capacity is out of range.
+func (h *CreatePickupSlotCommandHandler) Handle(
+	ctx context.Context,
+	cmd CreatePickupSlotCommand,
+) (*pickup.Slot, error) {
+	ctx, span := tracing.Start(ctx, "CreatePickupSlotCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+
+	slot, err := pickup.NewSlot(kernel.NewUUID(), cmd.StartsAt(), cmd.EndsAt(), cmd.Capacity())
+	if err != nil {
+		return nil, err
+	}
+
+	uow := h.uowFactory.Create()
+	err = uow.Do(ctx, func(ctx context.Context) error {
+		return uow.PickupSlotRepository().Add(ctx, slot)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return slot, nil
+}
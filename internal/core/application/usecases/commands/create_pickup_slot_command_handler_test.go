@@ -0,0 +1,124 @@
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockPickupSlotUoW struct{ mock.Mock }
+
+func (m *MockPickupSlotUoW) Begin(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockPickupSlotUoW) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return doInTransaction(ctx, m, fn)
+}
+
+func (m *MockPickupSlotUoW) Commit(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockPickupSlotUoW) Rollback(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockPickupSlotUoW) PickupSlotRepository() ports.PickupSlotRepository {
+	args := m.Called()
+	return args.Get(0).(ports.PickupSlotRepository)
+}
+
+type MockPickupSlotUoWFactory struct{ mock.Mock }
+
+func (m *MockPickupSlotUoWFactory) Create() commands.PickupSlotUoW {
+	args := m.Called()
+	return args.Get(0).(commands.PickupSlotUoW)
+}
+
+func TestCreatePickupSlotCommandHandler_Handle_Success(t *testing.T) {
+	ctx := t.Context()
+	startsAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	cmd := commands.NewCreatePickupSlotCommand(startsAt, startsAt.Add(30*time.Minute), 5)
+
+	slotRepo := new(MockPickupSlotRepository)
+	uow := new(MockPickupSlotUoW)
+	factory := new(MockPickupSlotUoWFactory)
+
+	mock.InOrder(
+		factory.On("Create").Return(uow).Once(),
+		uow.On("Begin", ctx).Return(nil).Once(),
+		uow.On("PickupSlotRepository").Return(slotRepo).Once(),
+		slotRepo.On("Add", ctx, mock.AnythingOfType("*pickup.Slot")).Return(nil).Once(),
+		uow.On("Commit", ctx).Return(nil).Once(),
+	)
+
+	handler := commands.NewCreatePickupSlotCommandHandler(factory)
+	slot, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	require.NoError(t, slot.Validate())
+	assert.Equal(t, startsAt, slot.StartsAt())
+	assert.Equal(t, 5, slot.Capacity())
+	assert.Empty(t, slot.Bookings())
+	slotRepo.AssertExpectations(t)
+	uow.AssertExpectations(t)
+}
+
+func TestCreatePickupSlotCommandHandler_Handle_InvalidSlot(t *testing.T) {
+	startsAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	cmd := commands.NewCreatePickupSlotCommand(startsAt, startsAt, 0)
+	factory := new(MockPickupSlotUoWFactory)
+
+	handler := commands.NewCreatePickupSlotCommandHandler(factory)
+	slot, err := handler.Handle(t.Context(), cmd)
+
+	require.ErrorIs(t, err, errs.ErrValidationFailed)
+	assert.Nil(t, slot)
+	factory.AssertNotCalled(t, "Create")
+}
+
+func TestCreatePickupSlotCommandHandler_Handle_AddError(t *testing.T) {
+	ctx := t.Context()
+	startsAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	cmd := commands.NewCreatePickupSlotCommand(startsAt, startsAt.Add(time.Hour), 1)
+	addErr := errors.New("insert failed")
+
+	slotRepo := new(MockPickupSlotRepository)
+	uow := new(MockPickupSlotUoW)
+	factory := new(MockPickupSlotUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("PickupSlotRepository").Return(slotRepo).Once()
+	slotRepo.On("Add", ctx, mock.AnythingOfType("*pickup.Slot")).Return(addErr).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	handler := commands.NewCreatePickupSlotCommandHandler(factory)
+	_, err := handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, addErr)
+	uow.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+func TestCreatePickupSlotCommandHandler_Handle_NotConstructedCommand(t *testing.T) {
+	factory := new(MockPickupSlotUoWFactory)
+
+	handler := commands.NewCreatePickupSlotCommandHandler(factory)
+	_, err := handler.Handle(t.Context(), commands.CreatePickupSlotCommand{})
+
+	require.ErrorIs(t, err, commands.ErrCreatePickupSlotCommandIsNotConstructed)
+	factory.AssertNotCalled(t, "Create")
+}
@@ -0,0 +1,29 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCreatePickupSlotCommand(t *testing.T) {
+	startsAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	endsAt := startsAt.Add(30 * time.Minute)
+
+	cmd := commands.NewCreatePickupSlotCommand(startsAt, endsAt, 5)
+
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, startsAt, cmd.StartsAt())
+	assert.Equal(t, endsAt, cmd.EndsAt())
+	assert.Equal(t, 5, cmd.Capacity())
+}
+
+func TestCreatePickupSlotCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.CreatePickupSlotCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrCreatePickupSlotCommandIsNotConstructed)
+}
@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrDeactivateCourierCommandIsNotConstructed = errors.New(
+		"DeactivateCourierCommand must be created via NewDeactivateCourierCommand constructor",
+	)
+)
+
+// DeactivateCourierCommand represents a request to take a courier out of service,
+// for example when they are offboarded or go offline mid-shift.
+// All active orders of the courier are reassigned or returned to the dispatch queue.
+//
+// Example:
+//
+//	cmd, err := NewDeactivateCourierCommand(courierID, courier.WentOffline)
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewDeactivateCourierCommandHandler(uowFactory)
+//	report, err := handler.Handle(ctx, cmd)
+type DeactivateCourierCommand struct { //nolint:recvcheck //using for validation
+	courierID kernel.UUID
+	reason    courier.DeactivationReason
+
+	guard guard.ConstructorGuard
+}
+
+// NewDeactivateCourierCommand creates a command to deactivate a courier.
+// Validates that the courier ID and the reason are valid.
+// Returns an error if any validation fails.
+func NewDeactivateCourierCommand(
+	courierID kernel.UUID,
+	reason courier.DeactivationReason,
+) (DeactivateCourierCommand, error) {
+	command := DeactivateCourierCommand{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := errors.Join(
+		command.setCourierID(courierID),
+		command.setReason(reason),
+	); err != nil {
+		return DeactivateCourierCommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrDeactivateCourierCommandIsNotConstructed if validation fails.
+func (c DeactivateCourierCommand) Validate() error {
+	return c.guard.Validate(ErrDeactivateCourierCommandIsNotConstructed)
+}
+
+// CourierID returns the ID of the courier to deactivate.
+func (c DeactivateCourierCommand) CourierID() kernel.UUID {
+	return c.courierID
+}
+
+// Reason returns why the courier is taken out of service.
+func (c DeactivateCourierCommand) Reason() courier.DeactivationReason {
+	return c.reason
+}
+
+func (c *DeactivateCourierCommand) setCourierID(courierID kernel.UUID) error {
+	if err := courierID.Validate(); err != nil {
+		return err
+	}
+
+	c.courierID = courierID
+	return nil
+}
+
+func (c *DeactivateCourierCommand) setReason(reason courier.DeactivationReason) error {
+	if err := reason.Validate(); err != nil {
+		return err
+	}
+
+	c.reason = reason
+	return nil
+}
@@ -0,0 +1,214 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/pkg/tracing"
+)
+
+// OrderReassignment records that an order was handed over to another courier.
+type OrderReassignment struct {
+	OrderID   kernel.UUID
+	CourierID kernel.UUID
+}
+
+// DeactivateCourierReport summarizes what happened to the orders of a deactivated courier.
+type DeactivateCourierReport struct {
+	// Reassigned lists orders handed over to other couriers.
+	Reassigned []OrderReassignment
+	// Requeued lists orders returned to Created status for the assignment job.
+	Requeued []kernel.UUID
+}
+
+// DeactivateCourierCommandHandler takes a courier out of service in a single transaction.
+// Every order the courier still holds is unassigned, the courier's storage places are
+// emptied and the deactivation reason is recorded on the courier. Each released order is
+// then offered to the remaining free couriers; orders nobody can take, or whose assignment
+// is vetoed by a post-processor, stay in Created status for the regular assignment job.
+//
+// Example:
+//
+//	handler := NewDeactivateCourierCommandHandler(uowFactory)
+//	cmd, _ := NewDeactivateCourierCommand(courierID, courier.Offboarded)
+//	report, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    return err
+//	}
+//	log.Printf("reassigned %d, requeued %d", len(report.Reassigned), len(report.Requeued))
+type DeactivateCourierCommandHandler struct {
+	uowFactory     UoWFactory
+	postProcessors []DispatchPostProcessor
+}
+
+// NewDeactivateCourierCommandHandler creates a handler for courier deactivation.
+// Requires a UoWFactory for coordinating updates across order and courier repositories.
+// Optional post-processors are applied to every reassignment exactly as in AssignCourierCommandHandler.
+func NewDeactivateCourierCommandHandler(
+	uowFactory UoWFactory,
+	postProcessors ...DispatchPostProcessor,
+) DeactivateCourierCommandHandler {
+	return DeactivateCourierCommandHandler{
+		uowFactory:     uowFactory,
+		postProcessors: postProcessors,
+	}
+}
+
+// Handle deactivates the courier and redistributes their active orders.
+// Returns an ObjectNotFoundError for unknown couriers and courier.ErrCourierIsDeactivated
+// if the courier is already out of service. Orders are handed over only to couriers within their schedule.
+// Nothing is persisted on error.
+func (h *DeactivateCourierCommandHandler) Handle(
+	ctx context.Context,
+	cmd DeactivateCourierCommand,
+) (DeactivateCourierReport, error) {
+	ctx, span := tracing.Start(ctx, "DeactivateCourierCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return DeactivateCourierReport{}, err
+	}
+
+	report := DeactivateCourierReport{
+		Reassigned: make([]OrderReassignment, 0),
+		Requeued:   make([]kernel.UUID, 0),
+	}
+	var assignments []*DispatchAssignment
+
+	uow := h.uowFactory.Create()
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		ordersRepo := uow.OrderRepository()
+
+		deactivated, err := courierRepo.Get(ctx, cmd.CourierID())
+		if err != nil {
+			return err
+		}
+
+		assigned, err := ordersRepo.GetAllInAssignedStatus(ctx)
+		if err != nil {
+			return err
+		}
+
+		released := make([]*order.Order, 0)
+		for _, o := range assigned {
+			if !o.Courier().IsEqual(deactivated.ID()) {
+				continue
+			}
+
+			if err = o.Unassign(); err != nil {
+				return err
+			}
+			released = append(released, o)
+		}
+
+		if _, err = deactivated.Deactivate(cmd.Reason()); err != nil {
+			return err
+		}
+
+		// The courier must be persisted as deactivated before looking up free couriers,
+		// otherwise it would be offered its own orders again
+		if err = courierRepo.Update(ctx, deactivated); err != nil {
+			return err
+		}
+
+		candidates, err := courierRepo.GetAllFree(ctx)
+		if err != nil {
+			return err
+		}
+		candidates = couriersWithinSchedule(candidates, time.Now())
+
+		assignments = make([]*DispatchAssignment, 0, len(released))
+
+		for _, o := range released {
+			assignment, reassigned, redispatchErr := h.redispatch(ctx, o, candidates)
+			if redispatchErr != nil {
+				return redispatchErr
+			}
+
+			if !reassigned {
+				report.Requeued = append(report.Requeued, o.ID())
+			} else {
+				report.Reassigned = append(report.Reassigned, OrderReassignment{
+					OrderID:   o.ID(),
+					CourierID: assignment.Courier.ID(),
+				})
+				assignments = append(assignments, assignment)
+				candidates = withoutCourier(candidates, assignment.Courier)
+
+				if err = courierRepo.Update(ctx, assignment.Courier); err != nil {
+					return err
+				}
+			}
+
+			if err = ordersRepo.Update(ctx, o); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return DeactivateCourierReport{}, err
+	}
+
+	for _, assignment := range assignments {
+		for _, processor := range h.postProcessors {
+			if listener, ok := processor.(DispatchCommitListener); ok {
+				listener.AssignmentCommitted(ctx, *assignment)
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// redispatch offers a released order to the candidate couriers and reports whether it was reassigned.
+// When no courier can take the order or the assignment is vetoed, the order and the
+// candidates are left unchanged.
+func (h *DeactivateCourierCommandHandler) redispatch(
+	ctx context.Context,
+	o *order.Order,
+	candidates []*courier.Courier,
+) (*DispatchAssignment, bool, error) {
+	if len(candidates) == 0 {
+		return nil, false, nil
+	}
+
+	assignedCourier, explanation, err := services.NewOrderDispatcher().DispatchExplained(o, candidates)
+	if errors.Is(err, services.ErrCourierNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	assignment := &DispatchAssignment{Order: o, Courier: assignedCourier, Explanation: explanation}
+	for _, processor := range h.postProcessors {
+		err = processor.ProcessAssignment(ctx, assignment)
+		if errors.Is(err, ErrAssignmentIsVetoed) {
+			return nil, false, errors.Join(o.Unassign(), assignedCourier.ReleaseOrder(o.ID()))
+		}
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	return assignment, true, nil
+}
+
+// withoutCourier returns the candidates except the given courier, who is no longer free.
+func withoutCourier(candidates []*courier.Courier, taken *courier.Courier) []*courier.Courier {
+	remaining := make([]*courier.Courier, 0, len(candidates))
+	for _, c := range candidates {
+		if !c.IsEqual(taken) {
+			remaining = append(remaining, c)
+		}
+	}
+	return remaining
+}
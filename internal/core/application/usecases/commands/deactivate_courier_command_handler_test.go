@@ -0,0 +1,232 @@
+package commands_test
+
+import (
+	"errors"
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createAssignedOrder(t *testing.T, c *courier.Courier, volume int) *order.Order {
+	t.Helper()
+	location, err := kernel.NewLocation(5, 5)
+	require.NoError(t, err)
+
+	o, err := order.NewOrder(kernel.NewUUID(), location, volume)
+	require.NoError(t, err)
+	require.NoError(t, c.TakeOrder(o))
+	require.NoError(t, o.Assign(c.ID()))
+	return o
+}
+
+func createCourierAt(t *testing.T, x, y kernel.Coordinate) *courier.Courier {
+	t.Helper()
+	location, err := kernel.NewLocation(x, y)
+	require.NoError(t, err)
+
+	c, err := courier.NewCourier(kernel.NewUUID(), "Courier", 2, location)
+	require.NoError(t, err)
+	return c
+}
+
+func TestDeactivateCourierCommandHandler_Handle_ReassignsAndRequeues(t *testing.T) {
+	ctx := t.Context()
+
+	leaving := createCourierAt(t, 1, 1)
+	require.NoError(t, leaving.AddStoragePlace("Багажник", 10))
+	first := createAssignedOrder(t, leaving, 5)
+	second := createAssignedOrder(t, leaving, 5)
+
+	other := createCourierAt(t, 6, 6)
+	otherOrder := createAssignedOrder(t, other, 5)
+	substitute := createCourierAt(t, 4, 4)
+
+	cmd, err := commands.NewDeactivateCourierCommand(leaving.ID(), courier.Offboarded)
+	require.NoError(t, err)
+
+	orderRepo := new(MockAssignOrderRepository)
+	courierRepo := new(MockAssignCourierRepository)
+	uow := new(MockAssignUoW)
+	factory := new(MockAssignUoWFactory)
+	listener := new(MockDispatchCommitListener)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("CourierRepository").Return(courierRepo).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	courierRepo.On("Get", ctx, leaving.ID()).Return(leaving, nil).Once()
+	orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{first, otherOrder, second}, nil).Once()
+	courierRepo.On("Update", ctx, leaving).Return(nil).Once()
+	courierRepo.On("GetAllFree", ctx).Return([]*courier.Courier{substitute}, nil).Once()
+	listener.On("ProcessAssignment", ctx, mock.AnythingOfType("*commands.DispatchAssignment")).Return(nil).Once()
+	courierRepo.On("Update", ctx, substitute).Return(nil).Once()
+	orderRepo.On("Update", ctx, first).Return(nil).Once()
+	orderRepo.On("Update", ctx, second).Return(nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+	listener.On("AssignmentCommitted", ctx, mock.AnythingOfType("commands.DispatchAssignment")).Return().Once()
+
+	handler := commands.NewDeactivateCourierCommandHandler(factory, listener)
+	report, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, []commands.OrderReassignment{{OrderID: first.ID(), CourierID: substitute.ID()}}, report.Reassigned)
+	assert.Equal(t, []kernel.UUID{second.ID()}, report.Requeued)
+
+	assert.True(t, leaving.IsDeactivated())
+	assert.Equal(t, courier.Offboarded, leaving.DeactivationReason())
+	for _, storagePlace := range leaving.StoragePlaces() {
+		assert.Nil(t, storagePlace.OrderID())
+	}
+
+	assert.Equal(t, order.Assigned, first.Status())
+	assert.True(t, first.Courier().IsEqual(substitute.ID()))
+	assert.Equal(t, order.Created, second.Status())
+	assert.Nil(t, second.Courier())
+	assert.Equal(t, order.Assigned, otherOrder.Status())
+
+	orderRepo.AssertExpectations(t)
+	courierRepo.AssertExpectations(t)
+	uow.AssertExpectations(t)
+	listener.AssertExpectations(t)
+}
+
+func TestDeactivateCourierCommandHandler_Handle_VetoedReassignmentIsRequeued(t *testing.T) {
+	ctx := t.Context()
+
+	leaving := createCourierAt(t, 1, 1)
+	released := createAssignedOrder(t, leaving, 5)
+	substitute := createCourierAt(t, 4, 4)
+
+	cmd, err := commands.NewDeactivateCourierCommand(leaving.ID(), courier.WentOffline)
+	require.NoError(t, err)
+
+	orderRepo := new(MockAssignOrderRepository)
+	courierRepo := new(MockAssignCourierRepository)
+	uow := new(MockAssignUoW)
+	factory := new(MockAssignUoWFactory)
+	vetoing := new(MockDispatchPostProcessor)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("CourierRepository").Return(courierRepo).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	courierRepo.On("Get", ctx, leaving.ID()).Return(leaving, nil).Once()
+	orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{released}, nil).Once()
+	courierRepo.On("Update", ctx, leaving).Return(nil).Once()
+	courierRepo.On("GetAllFree", ctx).Return([]*courier.Courier{substitute}, nil).Once()
+	vetoing.On("ProcessAssignment", ctx, mock.Anything).Return(commands.VetoAssignment("fraud suspected")).Once()
+	orderRepo.On("Update", ctx, released).Return(nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	handler := commands.NewDeactivateCourierCommandHandler(factory, vetoing)
+	report, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Empty(t, report.Reassigned)
+	assert.Equal(t, []kernel.UUID{released.ID()}, report.Requeued)
+	assert.Equal(t, order.Created, released.Status())
+	assert.Nil(t, substitute.StoragePlaces()[0].OrderID())
+	courierRepo.AssertNotCalled(t, "Update", ctx, substitute)
+}
+
+func TestDeactivateCourierCommandHandler_Handle_AlreadyDeactivated(t *testing.T) {
+	ctx := t.Context()
+
+	leaving := createCourierAt(t, 1, 1)
+	_, err := leaving.Deactivate(courier.Offboarded)
+	require.NoError(t, err)
+
+	cmd, err := commands.NewDeactivateCourierCommand(leaving.ID(), courier.WentOffline)
+	require.NoError(t, err)
+
+	orderRepo := new(MockAssignOrderRepository)
+	courierRepo := new(MockAssignCourierRepository)
+	uow := new(MockAssignUoW)
+	factory := new(MockAssignUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("CourierRepository").Return(courierRepo).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	courierRepo.On("Get", ctx, leaving.ID()).Return(leaving, nil).Once()
+	orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{}, nil).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	handler := commands.NewDeactivateCourierCommandHandler(factory)
+	_, err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, courier.ErrCourierIsDeactivated)
+	uow.AssertNotCalled(t, "Commit", ctx)
+	courierRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestDeactivateCourierCommandHandler_Handle_CourierNotFound(t *testing.T) {
+	ctx := t.Context()
+	courierID := kernel.NewUUID()
+
+	cmd, err := commands.NewDeactivateCourierCommand(courierID, courier.Offboarded)
+	require.NoError(t, err)
+
+	orderRepo := new(MockAssignOrderRepository)
+	courierRepo := new(MockAssignCourierRepository)
+	uow := new(MockAssignUoW)
+	factory := new(MockAssignUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("CourierRepository").Return(courierRepo).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	courierRepo.On("Get", ctx, courierID).Return(nil, errs.NewObjectNotFoundError("courier", courierID.String())).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	handler := commands.NewDeactivateCourierCommandHandler(factory)
+	_, err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, errs.ErrObjectNotFound)
+}
+
+func TestDeactivateCourierCommandHandler_Handle_UpdateErrorAborts(t *testing.T) {
+	ctx := t.Context()
+
+	leaving := createCourierAt(t, 1, 1)
+	cmd, err := commands.NewDeactivateCourierCommand(leaving.ID(), courier.Offboarded)
+	require.NoError(t, err)
+
+	orderRepo := new(MockAssignOrderRepository)
+	courierRepo := new(MockAssignCourierRepository)
+	uow := new(MockAssignUoW)
+	factory := new(MockAssignUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("CourierRepository").Return(courierRepo).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	courierRepo.On("Get", ctx, leaving.ID()).Return(leaving, nil).Once()
+	orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{}, nil).Once()
+	courierRepo.On("Update", ctx, leaving).Return(errors.New("db down")).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	handler := commands.NewDeactivateCourierCommandHandler(factory)
+	_, err = handler.Handle(ctx, cmd)
+
+	require.EqualError(t, err, "db down")
+	uow.AssertNotCalled(t, "Commit", ctx)
+}
+
+func TestDeactivateCourierCommandHandler_Handle_ValidationError(t *testing.T) {
+	factory := new(MockAssignUoWFactory)
+	handler := commands.NewDeactivateCourierCommandHandler(factory)
+
+	_, err := handler.Handle(t.Context(), commands.DeactivateCourierCommand{})
+
+	require.ErrorIs(t, err, commands.ErrDeactivateCourierCommandIsNotConstructed)
+	factory.AssertNotCalled(t, "Create")
+}
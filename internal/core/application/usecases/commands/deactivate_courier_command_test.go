@@ -0,0 +1,38 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDeactivateCourierCommand_ValidInput(t *testing.T) {
+	courierID := kernel.NewUUID()
+
+	cmd, err := commands.NewDeactivateCourierCommand(courierID, courier.WentOffline)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, courierID, cmd.CourierID())
+	assert.Equal(t, courier.WentOffline, cmd.Reason())
+}
+
+func TestNewDeactivateCourierCommand_InvalidInput(t *testing.T) {
+	_, err := commands.NewDeactivateCourierCommand(kernel.UUID{}, courier.NotDeactivated)
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+	assert.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
+
+func TestDeactivateCourierCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.DeactivateCourierCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrDeactivateCourierCommandIsNotConstructed)
+}
@@ -0,0 +1,138 @@
+package commands
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"delivery/internal/core/domain/services"
+)
+
+// ErrDispatchDegradationIsInvalid is returned when neither degradation threshold is set
+// or a threshold is negative.
+var ErrDispatchDegradationIsInvalid = errors.New("dispatch degradation needs a positive latency or backlog threshold")
+
+// DispatchMode is the way the assignment handler ranks couriers.
+type DispatchMode string
+
+const (
+	// FullDispatch ranks couriers with the full scoring strategy, including dispatch experiments.
+	FullDispatch DispatchMode = "full"
+
+	// GreedyDispatch assigns the nearest courier with services.GreedyNearestStrategy and skips
+	// dispatch experiments, keeping assignments cheap while the dispatcher is under load.
+	GreedyDispatch DispatchMode = "greedy"
+)
+
+// DispatchPressure is the load the dispatcher was under during an assignment tick.
+type DispatchPressure struct {
+	// Latency is how long the assignment took.
+	Latency time.Duration
+	// Backlog is the number of orders still waiting for a courier.
+	Backlog int
+}
+
+// DispatchModeSwitch records a change of the dispatch mode and the pressure that caused it.
+type DispatchModeSwitch struct {
+	From     DispatchMode
+	To       DispatchMode
+	Pressure DispatchPressure
+}
+
+// DispatchDegradation switches the dispatcher to greedy mode under load and back when the
+// pressure subsides. It is shared by the assignment handler, which dispatches in the current
+// mode, and the assignment job, which observes the pressure after every tick.
+//
+// Business rules:
+//   - The dispatcher degrades once the latency or the backlog reaches its threshold
+//   - It recovers once every measure is below half of its threshold, so the mode does not flap
+//     around a threshold
+//   - A threshold of zero disables that measure
+//
+// Example:
+//
+//	degradation, _ := NewDispatchDegradation(2*time.Second, 500)
+//	handler := NewAssignCourierCommandHandler(uowFactory).WithDegradation(degradation)
+//
+//	// After every assignment tick
+//	if change, switched := degradation.Observe(DispatchPressure{Latency: elapsed, Backlog: queued}); switched {
+//	    log.Printf("dispatch mode %s -> %s", change.From, change.To)
+//	}
+type DispatchDegradation struct {
+	maxLatency time.Duration
+	maxBacklog int
+
+	mu   sync.Mutex
+	mode DispatchMode
+}
+
+// NewDispatchDegradation creates a degradation in full mode that degrades at maxLatency per
+// assignment or maxBacklog waiting orders. Returns ErrDispatchDegradationIsInvalid if a threshold
+// is negative or both are zero.
+func NewDispatchDegradation(maxLatency time.Duration, maxBacklog int) (*DispatchDegradation, error) {
+	if maxLatency < 0 || maxBacklog < 0 || (maxLatency == 0 && maxBacklog == 0) {
+		return nil, ErrDispatchDegradationIsInvalid
+	}
+
+	return &DispatchDegradation{maxLatency: maxLatency, maxBacklog: maxBacklog, mode: FullDispatch}, nil
+}
+
+// MaxLatency returns the assignment latency that degrades the dispatcher; zero if disabled.
+func (d *DispatchDegradation) MaxLatency() time.Duration {
+	return d.maxLatency
+}
+
+// MaxBacklog returns the number of waiting orders that degrades the dispatcher; zero if disabled.
+func (d *DispatchDegradation) MaxBacklog() int {
+	return d.maxBacklog
+}
+
+// Mode returns the current dispatch mode.
+func (d *DispatchDegradation) Mode() DispatchMode {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.mode
+}
+
+// Observe updates the mode for the pressure of the last assignment tick and reports whether
+// it switched.
+func (d *DispatchDegradation) Observe(pressure DispatchPressure) (DispatchModeSwitch, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	next := d.mode
+	switch {
+	case d.mode == FullDispatch && d.overloaded(pressure):
+		next = GreedyDispatch
+	case d.mode == GreedyDispatch && d.relieved(pressure):
+		next = FullDispatch
+	}
+
+	if next == d.mode {
+		return DispatchModeSwitch{}, false
+	}
+
+	change := DispatchModeSwitch{From: d.mode, To: next, Pressure: pressure}
+	d.mode = next
+	return change, true
+}
+
+// overloaded reports whether any enabled measure reached its threshold.
+func (d *DispatchDegradation) overloaded(pressure DispatchPressure) bool {
+	return (d.maxLatency > 0 && pressure.Latency >= d.maxLatency) ||
+		(d.maxBacklog > 0 && pressure.Backlog >= d.maxBacklog)
+}
+
+// relieved reports whether every enabled measure is below half of its threshold.
+func (d *DispatchDegradation) relieved(pressure DispatchPressure) bool {
+	return (d.maxLatency == 0 || pressure.Latency < d.maxLatency/2) &&
+		(d.maxBacklog == 0 || pressure.Backlog < d.maxBacklog/2)
+}
+
+// dispatcher returns the greedy dispatcher in greedy mode and false in full mode or without degradation.
+func (d *DispatchDegradation) dispatcher() (services.OrderDispatcher, bool) {
+	if d == nil || d.Mode() != GreedyDispatch {
+		return services.OrderDispatcher{}, false
+	}
+	return services.NewOrderDispatcherWithStrategy(services.GreedyNearestStrategy{}), true
+}
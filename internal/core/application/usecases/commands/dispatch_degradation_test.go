@@ -0,0 +1,76 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDispatchDegradation(t *testing.T) {
+	t.Run("should start in full mode", func(t *testing.T) {
+		degradation, err := commands.NewDispatchDegradation(2*time.Second, 100)
+
+		require.NoError(t, err)
+		assert.Equal(t, commands.FullDispatch, degradation.Mode())
+		assert.Equal(t, 2*time.Second, degradation.MaxLatency())
+		assert.Equal(t, 100, degradation.MaxBacklog())
+	})
+
+	t.Run("should require a threshold", func(t *testing.T) {
+		_, err := commands.NewDispatchDegradation(0, 0)
+		require.ErrorIs(t, err, commands.ErrDispatchDegradationIsInvalid)
+
+		_, err = commands.NewDispatchDegradation(-time.Second, 100)
+		require.ErrorIs(t, err, commands.ErrDispatchDegradationIsInvalid)
+	})
+}
+
+func TestDispatchDegradation_Observe(t *testing.T) {
+	t.Run("should degrade on latency and recover below half of it", func(t *testing.T) {
+		degradation, err := commands.NewDispatchDegradation(2*time.Second, 100)
+		require.NoError(t, err)
+
+		_, switched := degradation.Observe(commands.DispatchPressure{Latency: time.Second, Backlog: 10})
+		assert.False(t, switched)
+
+		change, switched := degradation.Observe(commands.DispatchPressure{Latency: 2 * time.Second, Backlog: 10})
+		require.True(t, switched)
+		assert.Equal(t, commands.FullDispatch, change.From)
+		assert.Equal(t, commands.GreedyDispatch, change.To)
+		assert.Equal(t, 2*time.Second, change.Pressure.Latency)
+		assert.Equal(t, commands.GreedyDispatch, degradation.Mode())
+
+		// Below the threshold but not below half of it the dispatcher stays greedy
+		_, switched = degradation.Observe(commands.DispatchPressure{Latency: 1500 * time.Millisecond, Backlog: 10})
+		assert.False(t, switched)
+
+		change, switched = degradation.Observe(commands.DispatchPressure{Latency: 500 * time.Millisecond, Backlog: 10})
+		require.True(t, switched)
+		assert.Equal(t, commands.FullDispatch, change.To)
+		assert.Equal(t, commands.FullDispatch, degradation.Mode())
+	})
+
+	t.Run("should degrade on backlog and wait for both measures to recover", func(t *testing.T) {
+		degradation, err := commands.NewDispatchDegradation(2*time.Second, 100)
+		require.NoError(t, err)
+
+		_, switched := degradation.Observe(commands.DispatchPressure{Backlog: 100})
+		require.True(t, switched)
+
+		_, switched = degradation.Observe(commands.DispatchPressure{Latency: 1500 * time.Millisecond, Backlog: 10})
+		assert.False(t, switched)
+		assert.Equal(t, commands.GreedyDispatch, degradation.Mode())
+	})
+
+	t.Run("should ignore a disabled measure", func(t *testing.T) {
+		degradation, err := commands.NewDispatchDegradation(0, 100)
+		require.NoError(t, err)
+
+		_, switched := degradation.Observe(commands.DispatchPressure{Latency: time.Hour, Backlog: 10})
+		assert.False(t, switched)
+	})
+}
@@ -0,0 +1,121 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/rollout"
+)
+
+// dispatchStrategyAnnotation records on every assignment which strategy selected the courier.
+const dispatchStrategyAnnotation = "dispatch.strategy"
+
+// DispatchExperiment rolls a candidate dispatch strategy out to a share of orders.
+// Orders enabled by the flag are dispatched with the candidate, all others with the default
+// strategy. Every order is scored by both strategies and the comparison is reported to the
+// observer, so the candidate can be judged on real traffic before it takes over.
+type DispatchExperiment struct {
+	flag      *rollout.Flag
+	candidate services.DispatchStrategy
+	observer  DispatchComparisonObserver
+}
+
+// NewDispatchExperiment creates an experiment routing the orders enabled by flag to candidate.
+func NewDispatchExperiment(
+	flag *rollout.Flag,
+	candidate services.DispatchStrategy,
+	observer DispatchComparisonObserver,
+) (DispatchExperiment, error) {
+	if err := errors.Join(
+		func() error {
+			if flag == nil {
+				return errs.NewValueIsRequiredError("flag")
+			}
+			return nil
+		}(),
+		func() error {
+			if candidate == nil {
+				return errs.NewValueIsRequiredError("candidate")
+			}
+			return nil
+		}(),
+		func() error {
+			if observer == nil {
+				return errs.NewValueIsRequiredError("observer")
+			}
+			return nil
+		}(),
+	); err != nil {
+		return DispatchExperiment{}, err
+	}
+
+	return DispatchExperiment{flag: flag, candidate: candidate, observer: observer}, nil
+}
+
+// DispatchChoice is the courier a strategy selects for an order.
+// Courier is nil and Err is set when the strategy finds no courier.
+type DispatchChoice struct {
+	Strategy string
+	Courier  *kernel.UUID
+	Score    float64
+	Err      error
+}
+
+// DispatchComparison is the shadow scoring of one order by the default and the candidate strategy.
+type DispatchComparison struct {
+	OrderID   kernel.UUID
+	Applied   string
+	Baseline  DispatchChoice
+	Candidate DispatchChoice
+}
+
+// DispatchComparisonObserver receives the shadow scoring of every order dispatched during an
+// experiment, typically to log it for offline comparison.
+type DispatchComparisonObserver interface {
+	DispatchCompared(ctx context.Context, comparison DispatchComparison)
+}
+
+// dispatcher scores the order with the baseline and the candidate strategy, reports the
+// comparison and returns the dispatcher the flag selects for the order.
+func (e DispatchExperiment) dispatcher(
+	ctx context.Context,
+	baseline services.OrderDispatcher,
+	order *order.Order,
+	couriers []*courier.Courier,
+) services.OrderDispatcher {
+	candidate := services.NewOrderDispatcherWithStrategy(e.candidate)
+
+	applied := baseline
+	if e.flag.Enabled(order.ID().String()) {
+		applied = candidate
+	}
+
+	e.observer.DispatchCompared(ctx, DispatchComparison{
+		OrderID:   order.ID(),
+		Applied:   applied.Strategy().Name(),
+		Baseline:  choose(baseline, order, couriers),
+		Candidate: choose(candidate, order, couriers),
+	})
+	return applied
+}
+
+// choose records the courier the dispatcher would select without assigning it.
+func choose(dispatcher services.OrderDispatcher, order *order.Order, couriers []*courier.Courier) DispatchChoice {
+	choice := DispatchChoice{Strategy: dispatcher.Strategy().Name()}
+
+	selected, score, err := dispatcher.Select(order, couriers)
+	if err != nil {
+		choice.Err = err
+		return choice
+	}
+
+	courierID := selected.ID()
+	choice.Courier = &courierID
+	choice.Score = score
+	return choice
+}
@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
+)
+
+var (
+	// ErrAssignmentIsVetoed is returned by AssignCourierCommandHandler when a dispatch
+	// post-processor rejects the courier selected by the dispatcher.
+	ErrAssignmentIsVetoed = errors.New("assignment is vetoed")
+)
+
+// VetoAssignment builds the error a DispatchPostProcessor returns to reject an assignment.
+//
+// Example:
+//
+//	if blocked {
+//	    return commands.VetoAssignment("courier exceeded daily quota")
+//	}
+func VetoAssignment(reason string) error {
+	return fmt.Errorf("%w: %s", ErrAssignmentIsVetoed, reason)
+}
+
+// DispatchAssignment is the courier-order match proposed by the dispatcher.
+// Both aggregates already reflect the assignment but are not yet persisted.
+// Post-processors may inspect them and attach annotations for processors that run later.
+// Explanation holds the score breakdown of every courier the dispatcher considered.
+type DispatchAssignment struct {
+	Order       *order.Order
+	Courier     *courier.Courier
+	Explanation services.AssignmentExplanation
+
+	annotations map[string]string
+}
+
+// Annotate attaches a key-value note to the assignment, overwriting an existing key.
+func (a *DispatchAssignment) Annotate(key, value string) {
+	if a.annotations == nil {
+		a.annotations = make(map[string]string)
+	}
+	a.annotations[key] = value
+}
+
+// Annotations returns a copy of the notes attached by post-processors.
+func (a *DispatchAssignment) Annotations() map[string]string {
+	annotations := make(map[string]string, len(a.annotations))
+	for key, value := range a.annotations {
+		annotations[key] = value
+	}
+	return annotations
+}
+
+// DispatchPostProcessor extends courier assignment without changing the core dispatch flow.
+// Processors run in registration order inside the assignment transaction, after the
+// dispatcher has selected a courier and before anything is persisted.
+//
+// Returning an error aborts the assignment and rolls back the transaction; use
+// VetoAssignment to signal a deliberate business rejection.
+//
+// Example:
+//
+//	type quotaProcessor struct{ quotas QuotaService }
+//
+//	func (p quotaProcessor) ProcessAssignment(ctx context.Context, a *commands.DispatchAssignment) error {
+//	    left, err := p.quotas.Remaining(ctx, a.Courier.ID())
+//	    if err != nil {
+//	        return err
+//	    }
+//	    if left == 0 {
+//	        return commands.VetoAssignment("courier quota exhausted")
+//	    }
+//	    a.Annotate("quota.remaining", strconv.Itoa(left-1))
+//	    return nil
+//	}
+type DispatchPostProcessor interface {
+	ProcessAssignment(ctx context.Context, assignment *DispatchAssignment) error
+}
+
+// DispatchCommitListener is an optional interface for post-processors that need to act
+// only once the assignment has been committed, such as sending notifications.
+// Listener failures cannot undo the assignment and are therefore not reported.
+type DispatchCommitListener interface {
+	AssignmentCommitted(ctx context.Context, assignment DispatchAssignment)
+}
@@ -0,0 +1,113 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+const (
+	// MaxErasedOrders is the maximum number of orders one erasure request can name.
+	MaxErasedOrders = 100
+
+	// MaxErasureRequestReferenceLength is the maximum number of characters of an erasure request reference.
+	MaxErasureRequestReferenceLength = 128
+)
+
+var (
+	ErrErasePersonalDataCommandIsNotConstructed = errors.New(
+		"ErasePersonalDataCommand must be created via NewErasePersonalDataCommand constructor",
+	)
+)
+
+// ErasePersonalDataCommand represents a data subject's request to erase their personal data.
+// Customers are not registered, so the request names the orders of the customer; the request
+// reference identifies the request in the audit log, for example the ticket of the privacy desk.
+//
+// Example:
+//
+//	cmd, err := NewErasePersonalDataCommand("DSR-2041", []kernel.UUID{orderID})
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewErasePersonalDataCommandHandler(uowFactory)
+//	report, err := handler.Handle(ctx, cmd)
+type ErasePersonalDataCommand struct { //nolint:recvcheck //using for validation
+	requestReference string
+	orderIDs         []kernel.UUID
+
+	guard guard.ConstructorGuard
+}
+
+// NewErasePersonalDataCommand creates a command to erase the personal data of the orders.
+// An order named twice is erased once. Returns a validation error if the reference is blank or
+// longer than MaxErasureRequestReferenceLength, no orders or more than MaxErasedOrders are named,
+// or an ID is invalid.
+func NewErasePersonalDataCommand(requestReference string, orderIDs []kernel.UUID) (ErasePersonalDataCommand, error) {
+	requestReference = strings.TrimSpace(requestReference)
+
+	var validation errs.ValidationErrors
+	switch {
+	case requestReference == "":
+		validation.Add("requestReference", errs.NewValueIsRequiredError("requestReference"))
+	case utf8.RuneCountInString(requestReference) > MaxErasureRequestReferenceLength:
+		validation.Add("requestReference", errs.NewValueIsInvalidErrorWithCause(
+			"requestReference is invalid",
+			fmt.Errorf("reference exceeds %d characters", MaxErasureRequestReferenceLength),
+		))
+	}
+	switch {
+	case len(orderIDs) == 0:
+		validation.Add("orderIds", errs.NewValueIsRequiredError("orderIds"))
+	case len(orderIDs) > MaxErasedOrders:
+		validation.Add("orderIds", errs.NewValueIsInvalidErrorWithCause(
+			"orderIds are invalid",
+			fmt.Errorf("%d orders exceed the request limit of %d", len(orderIDs), MaxErasedOrders),
+		))
+	}
+	for i, id := range orderIDs {
+		validation.Add("orderIds."+strconv.Itoa(i), id.Validate())
+	}
+	if err := validation.Err(); err != nil {
+		return ErasePersonalDataCommand{}, err
+	}
+
+	unique := make([]kernel.UUID, 0, len(orderIDs))
+	seen := make(map[kernel.UUID]struct{}, len(orderIDs))
+	for _, id := range orderIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+
+	return ErasePersonalDataCommand{
+		requestReference: requestReference,
+		orderIDs:         unique,
+		guard:            guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrErasePersonalDataCommandIsNotConstructed if validation fails.
+func (c ErasePersonalDataCommand) Validate() error {
+	return c.guard.Validate(ErrErasePersonalDataCommandIsNotConstructed)
+}
+
+// RequestReference returns the reference of the erasure request.
+func (c ErasePersonalDataCommand) RequestReference() string {
+	return c.requestReference
+}
+
+// OrderIDs returns the IDs of the customer's orders, each once.
+func (c ErasePersonalDataCommand) OrderIDs() []kernel.UUID {
+	return c.orderIDs
+}
@@ -0,0 +1,136 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tracing"
+)
+
+// ErrPersonalDataRemains is returned when an erasure left personal data behind. The erasure is
+// rolled back, so the request can be retried once the gap is fixed.
+var ErrPersonalDataRemains = errors.New("personal data remains after erasure")
+
+// PersonalDataErasureReport is the verification report of an erasure.
+type PersonalDataErasureReport struct {
+	// ID identifies the audit entry of the erasure
+	ID kernel.UUID
+
+	// RequestReference is the reference of the erasure request
+	RequestReference string
+
+	// OrderIDs are the erased orders
+	OrderIDs []kernel.UUID
+
+	// Erased counts the records anonymized by the erasure
+	Erased ports.PersonalDataRecords
+
+	// Remaining counts the records still holding personal data once the erasure was verified
+	Remaining ports.PersonalDataRecords
+
+	// ErasedAt is the moment the erasure was recorded
+	ErasedAt time.Time
+}
+
+// ErasePersonalDataCommandHandler erases the personal data of customers' orders.
+// The orders are erased, verified and audited in one transaction, so an erasure that left
+// personal data behind or failed midway changes nothing. The statistics built from the orders,
+// such as their delivery grid cells, volumes and status transitions, are kept.
+//
+// Example:
+//
+//	handler := NewErasePersonalDataCommandHandler(uowFactory)
+//	cmd, _ := NewErasePersonalDataCommand("DSR-2041", orderIDs)
+//	report, err := handler.Handle(ctx, cmd)
+//	if errors.Is(err, order.ErrOrderIsActive) {
+//	    // The order has to be delivered or cancelled first
+//	}
+type ErasePersonalDataCommandHandler struct {
+	uowFactory ErasureUoWFactory
+}
+
+// NewErasePersonalDataCommandHandler creates a new handler for personal data erasures.
+// Requires an ErasureUoWFactory for transactional operations.
+func NewErasePersonalDataCommandHandler(uowFactory ErasureUoWFactory) ErasePersonalDataCommandHandler {
+	return ErasePersonalDataCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the ErasePersonalDataCommand within a transaction.
+// Returns errs.ErrObjectNotFound if an order does not exist, order.ErrOrderIsActive if an order is
+// still being delivered, errs.ErrConcurrencyConflict if an order changed while it was erased and
+// ErrPersonalDataRemains if the verification found personal data left.
+func (h *ErasePersonalDataCommandHandler) Handle(
+	ctx context.Context,
+	cmd ErasePersonalDataCommand,
+) (PersonalDataErasureReport, error) {
+	ctx, span := tracing.Start(ctx, "ErasePersonalDataCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return PersonalDataErasureReport{}, err
+	}
+
+	var report PersonalDataErasureReport
+	uow := h.uowFactory.Create()
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		orderRepo := uow.OrderRepository()
+		orders := make([]*order.Order, 0, len(cmd.OrderIDs()))
+		for _, id := range cmd.OrderIDs() {
+			o, err := orderRepo.Get(ctx, id)
+			if err != nil {
+				return err
+			}
+			if err = o.ValidateDataErasure(); err != nil {
+				return fmt.Errorf("order %s: %w", id, err)
+			}
+			orders = append(orders, o)
+		}
+
+		eraser := uow.PersonalDataEraser()
+		erased, err := eraser.Erase(ctx, orders)
+		if err != nil {
+			return err
+		}
+
+		remaining, err := eraser.Remaining(ctx, cmd.OrderIDs())
+		if err != nil {
+			return err
+		}
+		if remaining.Total() > 0 {
+			return fmt.Errorf("%w: %d records", ErrPersonalDataRemains, remaining.Total())
+		}
+
+		erasure := ports.PersonalDataErasure{
+			ID:               kernel.NewUUID(),
+			RequestReference: cmd.RequestReference(),
+			OrderIDs:         cmd.OrderIDs(),
+			Erased:           erased,
+			ErasedAt:         time.Now().UTC(),
+		}
+		if err = eraser.RecordErasure(ctx, erasure); err != nil {
+			return err
+		}
+
+		report = PersonalDataErasureReport{
+			ID:               erasure.ID,
+			RequestReference: erasure.RequestReference,
+			OrderIDs:         erasure.OrderIDs,
+			Erased:           erased,
+			Remaining:        remaining,
+			ErasedAt:         erasure.ErasedAt,
+		}
+		return nil
+	})
+	if err != nil {
+		return PersonalDataErasureReport{}, err
+	}
+
+	return report, nil
+}
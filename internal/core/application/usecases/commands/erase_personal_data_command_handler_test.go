@@ -0,0 +1,191 @@
+package commands_test
+
+import (
+	"context"
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockPersonalDataEraser struct{ mock.Mock }
+
+func (m *MockPersonalDataEraser) Erase(ctx context.Context, orders []*order.Order) (ports.PersonalDataRecords, error) {
+	args := m.Called(ctx, orders)
+	return args.Get(0).(ports.PersonalDataRecords), args.Error(1)
+}
+
+func (m *MockPersonalDataEraser) Remaining(
+	ctx context.Context,
+	orderIDs []kernel.UUID,
+) (ports.PersonalDataRecords, error) {
+	args := m.Called(ctx, orderIDs)
+	return args.Get(0).(ports.PersonalDataRecords), args.Error(1)
+}
+
+func (m *MockPersonalDataEraser) RecordErasure(ctx context.Context, erasure ports.PersonalDataErasure) error {
+	args := m.Called(ctx, erasure)
+	return args.Error(0)
+}
+
+type MockErasureUoW struct{ mock.Mock }
+
+func (m *MockErasureUoW) Begin(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockErasureUoW) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return doInTransaction(ctx, m, fn)
+}
+
+func (m *MockErasureUoW) Commit(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockErasureUoW) Rollback(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockErasureUoW) OrderRepository() ports.OrderRepository {
+	args := m.Called()
+	return args.Get(0).(ports.OrderRepository)
+}
+
+func (m *MockErasureUoW) PersonalDataEraser() ports.PersonalDataEraser {
+	args := m.Called()
+	return args.Get(0).(ports.PersonalDataEraser)
+}
+
+type MockErasureUoWFactory struct{ mock.Mock }
+
+func (m *MockErasureUoWFactory) Create() commands.ErasureUoW {
+	args := m.Called()
+	return args.Get(0).(commands.ErasureUoW)
+}
+
+func TestErasePersonalDataCommandHandler_Handle(t *testing.T) {
+	ctx := t.Context()
+	delivered, _ := createDeliveredOrder(t, kernel.NewUUID())
+	erased := ports.PersonalDataRecords{TrackingTokens: 1, Messages: 3, EventTombstones: 1}
+
+	orderRepo := new(MoveOrderRepo)
+	eraser := new(MockPersonalDataEraser)
+	uow := new(MockErasureUoW)
+	factory := new(MockErasureUoWFactory)
+
+	mock.InOrder(
+		factory.On("Create").Return(uow).Once(),
+		uow.On("Begin", ctx).Return(nil).Once(),
+		uow.On("OrderRepository").Return(orderRepo).Once(),
+		orderRepo.On("Get", ctx, delivered.ID()).Return(delivered, nil).Once(),
+		uow.On("PersonalDataEraser").Return(eraser).Once(),
+		eraser.On("Erase", ctx, []*order.Order{delivered}).Return(erased, nil).Once(),
+		eraser.On("Remaining", ctx, []kernel.UUID{delivered.ID()}).Return(ports.PersonalDataRecords{}, nil).Once(),
+		eraser.On("RecordErasure", ctx, mock.MatchedBy(func(erasure ports.PersonalDataErasure) bool {
+			return erasure.RequestReference == "DSR-2041" && erasure.Erased == erased
+		})).Return(nil).Once(),
+		uow.On("Commit", ctx).Return(nil).Once(),
+	)
+
+	cmd, err := commands.NewErasePersonalDataCommand("DSR-2041", []kernel.UUID{delivered.ID()})
+	require.NoError(t, err)
+
+	handler := commands.NewErasePersonalDataCommandHandler(factory)
+	report, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, erased, report.Erased)
+	assert.Zero(t, report.Remaining.Total())
+	assert.Equal(t, []kernel.UUID{delivered.ID()}, report.OrderIDs)
+	require.NoError(t, report.ID.Validate())
+	eraser.AssertExpectations(t)
+	uow.AssertExpectations(t)
+}
+
+func TestErasePersonalDataCommandHandler_Handle_ActiveOrder(t *testing.T) {
+	ctx := t.Context()
+	active := createOrderForThread(t)
+
+	orderRepo := new(MoveOrderRepo)
+	uow := new(MockErasureUoW)
+	factory := new(MockErasureUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	orderRepo.On("Get", ctx, active.ID()).Return(active, nil).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewErasePersonalDataCommand("DSR-2041", []kernel.UUID{active.ID()})
+	require.NoError(t, err)
+
+	handler := commands.NewErasePersonalDataCommandHandler(factory)
+	_, err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, order.ErrOrderIsActive)
+	uow.AssertNotCalled(t, "PersonalDataEraser")
+	uow.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+func TestErasePersonalDataCommandHandler_Handle_OrderNotFound(t *testing.T) {
+	ctx := t.Context()
+	orderID := kernel.NewUUID()
+
+	orderRepo := new(MoveOrderRepo)
+	uow := new(MockErasureUoW)
+	factory := new(MockErasureUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	orderRepo.On("Get", ctx, orderID).Return(nil, errs.NewObjectNotFoundError("order", orderID.String())).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewErasePersonalDataCommand("DSR-2041", []kernel.UUID{orderID})
+	require.NoError(t, err)
+
+	handler := commands.NewErasePersonalDataCommandHandler(factory)
+	_, err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, errs.ErrObjectNotFound)
+	uow.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+func TestErasePersonalDataCommandHandler_Handle_PersonalDataRemains(t *testing.T) {
+	ctx := t.Context()
+	delivered, _ := createDeliveredOrder(t, kernel.NewUUID())
+
+	orderRepo := new(MoveOrderRepo)
+	eraser := new(MockPersonalDataEraser)
+	uow := new(MockErasureUoW)
+	factory := new(MockErasureUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	orderRepo.On("Get", ctx, delivered.ID()).Return(delivered, nil).Once()
+	uow.On("PersonalDataEraser").Return(eraser).Once()
+	eraser.On("Erase", ctx, mock.Anything).Return(ports.PersonalDataRecords{EventTombstones: 1}, nil).Once()
+	eraser.On("Remaining", ctx, mock.Anything).Return(ports.PersonalDataRecords{Messages: 2}, nil).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewErasePersonalDataCommand("DSR-2041", []kernel.UUID{delivered.ID()})
+	require.NoError(t, err)
+
+	handler := commands.NewErasePersonalDataCommandHandler(factory)
+	_, err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, commands.ErrPersonalDataRemains)
+	eraser.AssertNotCalled(t, "RecordErasure", mock.Anything, mock.Anything)
+	uow.AssertNotCalled(t, "Commit", mock.Anything)
+}
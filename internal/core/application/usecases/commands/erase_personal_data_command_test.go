@@ -0,0 +1,71 @@
+package commands_test
+
+import (
+	"strings"
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewErasePersonalDataCommand_ValidInput(t *testing.T) {
+	first := kernel.NewUUID()
+	second := kernel.NewUUID()
+
+	cmd, err := commands.NewErasePersonalDataCommand(" DSR-2041 ", []kernel.UUID{first, second, first})
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, "DSR-2041", cmd.RequestReference())
+	assert.Equal(t, []kernel.UUID{first, second}, cmd.OrderIDs())
+}
+
+func TestNewErasePersonalDataCommand_InvalidInput(t *testing.T) {
+	t.Run("should require reference", func(t *testing.T) {
+		_, err := commands.NewErasePersonalDataCommand("  ", []kernel.UUID{kernel.NewUUID()})
+
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+	})
+
+	t.Run("should refuse long reference", func(t *testing.T) {
+		reference := strings.Repeat("r", commands.MaxErasureRequestReferenceLength+1)
+
+		_, err := commands.NewErasePersonalDataCommand(reference, []kernel.UUID{kernel.NewUUID()})
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should require orders", func(t *testing.T) {
+		_, err := commands.NewErasePersonalDataCommand("DSR-2041", nil)
+
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+	})
+
+	t.Run("should refuse orders over the limit", func(t *testing.T) {
+		orderIDs := make([]kernel.UUID, commands.MaxErasedOrders+1)
+		for i := range orderIDs {
+			orderIDs[i] = kernel.NewUUID()
+		}
+
+		_, err := commands.NewErasePersonalDataCommand("DSR-2041", orderIDs)
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should name invalid order ID", func(t *testing.T) {
+		_, err := commands.NewErasePersonalDataCommand("DSR-2041", []kernel.UUID{kernel.NewUUID(), {}})
+
+		var validation *errs.ValidationErrors
+		require.ErrorAs(t, err, &validation)
+		assert.Equal(t, "orderIds.1", validation.Fields[0].Field)
+	})
+}
+
+func TestErasePersonalDataCommand_NotConstructed(t *testing.T) {
+	require.ErrorIs(t, commands.ErasePersonalDataCommand{}.Validate(),
+		commands.ErrErasePersonalDataCommandIsNotConstructed)
+}
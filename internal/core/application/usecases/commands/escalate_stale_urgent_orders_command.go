@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrEscalateStaleUrgentOrdersCommandIsNotConstructed = errors.New(
+		"EscalateStaleUrgentOrdersCommand must be created via NewEscalateStaleUrgentOrdersCommand constructor",
+	)
+)
+
+// EscalateStaleUrgentOrdersCommand represents a request to escalate the urgent orders that have
+// waited for a courier long enough to reach the next tier of the escalation policy.
+//
+// Example:
+//
+//	cmd := NewEscalateStaleUrgentOrdersCommand()
+//	escalated, err := handler.Handle(ctx, cmd)
+//	if err == nil {
+//	    log.Printf("escalated %d urgent orders", len(escalated))
+//	}
+type EscalateStaleUrgentOrdersCommand struct {
+	guard guard.ConstructorGuard
+}
+
+// NewEscalateStaleUrgentOrdersCommand creates a command to escalate stale urgent orders.
+// This is a parameterless command; the waiting orders are read from storage.
+func NewEscalateStaleUrgentOrdersCommand() EscalateStaleUrgentOrdersCommand {
+	return EscalateStaleUrgentOrdersCommand{guard: guard.NewConstructorGuard()}
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrEscalateStaleUrgentOrdersCommandIsNotConstructed if validation fails.
+func (c EscalateStaleUrgentOrdersCommand) Validate() error {
+	return c.guard.Validate(ErrEscalateStaleUrgentOrdersCommandIsNotConstructed)
+}
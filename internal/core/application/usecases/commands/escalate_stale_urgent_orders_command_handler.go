@@ -0,0 +1,156 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/escalation"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tracing"
+)
+
+// MaxEscalatedOrdersPerTier caps the number of waiting orders one run considers for each tier.
+const MaxEscalatedOrdersPerTier = 1000
+
+// EscalateStaleUrgentOrdersCommandHandler escalates the urgent orders left waiting for a courier
+// through the tiers of the escalation policy. Each new tier an order reaches is persisted, so
+// dispatch lifts the tier's relaxations for it, and reported to the optional ops alert.
+//
+// Example:
+//
+//	policy, _ := escalation.ParsePolicy("10m,30m:matching-rules")
+//	handler := NewEscalateStaleUrgentOrdersCommandHandler(uowFactory, escalations, policy, alert)
+//	escalated, err := handler.Handle(ctx, NewEscalateStaleUrgentOrdersCommand())
+type EscalateStaleUrgentOrdersCommandHandler struct {
+	uowFactory  OrderUoWFactory
+	escalations ports.OrderEscalationRepository
+	policy      escalation.Policy
+	alert       ports.OrderEscalationAlert
+}
+
+// NewEscalateStaleUrgentOrdersCommandHandler creates a handler for escalating stale urgent orders.
+// Requires an OrderUoWFactory to find the waiting orders, the repository of their escalations
+// and the escalation policy. A nil alert leaves the escalations to the caller's log.
+func NewEscalateStaleUrgentOrdersCommandHandler(
+	uowFactory OrderUoWFactory,
+	escalations ports.OrderEscalationRepository,
+	policy escalation.Policy,
+	alert ports.OrderEscalationAlert,
+) EscalateStaleUrgentOrdersCommandHandler {
+	return EscalateStaleUrgentOrdersCommandHandler{
+		uowFactory:  uowFactory,
+		escalations: escalations,
+		policy:      policy,
+		alert:       alert,
+	}
+}
+
+// Handle escalates every urgent order in Created that waited, since it was created, for at least
+// the waiting time of a tier it has not reached yet, straight to the highest such tier.
+// Returns the escalations made, earliest created order first within a tier, highest tier first.
+// The escalations are saved before ops are notified: an order whose notification fails keeps its
+// tier and is not reported again, and the failures are returned together with the escalations.
+func (h EscalateStaleUrgentOrdersCommandHandler) Handle(
+	ctx context.Context,
+	cmd EscalateStaleUrgentOrdersCommand,
+) ([]escalation.Escalation, error) {
+	ctx, span := tracing.Start(ctx, "EscalateStaleUrgentOrdersCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	if err := h.policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	due, err := h.dueOrders(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	tiers := h.policy.Tiers()
+	var escalated []escalation.Escalation
+	var notifyErrs []error
+	for _, waiting := range due {
+		current := 0
+		previous, getErr := h.escalations.Get(ctx, waiting.id)
+		switch {
+		case getErr == nil:
+			current = previous.Tier()
+		case !errors.Is(getErr, errs.ErrObjectNotFound):
+			return escalated, getErr
+		}
+		if current >= waiting.tier {
+			continue
+		}
+
+		next, escalateErr := h.policy.Escalate(waiting.id, current, waiting.tier, now)
+		if escalateErr != nil {
+			return escalated, escalateErr
+		}
+		if err = h.escalations.Save(ctx, next); err != nil {
+			return escalated, err
+		}
+		escalated = append(escalated, next)
+
+		if h.alert != nil {
+			notifyErrs = append(notifyErrs, h.alert.NotifyOrderEscalation(ctx, ports.OrderEscalation{
+				OrderID:     waiting.id,
+				Tier:        waiting.tier,
+				Tiers:       len(tiers),
+				Waited:      tiers[waiting.tier-1].After(),
+				Relaxations: h.policy.Relaxations(waiting.tier),
+			}))
+		}
+	}
+
+	return escalated, errors.Join(notifyErrs...)
+}
+
+// dueOrder is a waiting urgent order and the highest tier its waiting time reaches.
+type dueOrder struct {
+	id   kernel.UUID
+	tier int
+}
+
+// dueOrders returns the urgent orders in Created with the highest tier each of them reaches.
+func (h EscalateStaleUrgentOrdersCommandHandler) dueOrders(ctx context.Context, now time.Time) ([]dueOrder, error) {
+	tiers := h.policy.Tiers()
+	urgent := order.UrgentPriority
+
+	var due []dueOrder
+	reached := make(map[kernel.UUID]bool)
+	uow := h.uowFactory.Create()
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		orders := uow.OrderRepository()
+		for tier := len(tiers); tier >= 1; tier-- {
+			createdBefore := now.Add(-tiers[tier-1].After())
+			ids, err := orders.GetIDsMatching(ctx, ports.OrderFilter{
+				Status:        order.Created,
+				CreatedBefore: &createdBefore,
+				Priority:      &urgent,
+			}, MaxEscalatedOrdersPerTier)
+			if err != nil {
+				return err
+			}
+
+			for _, id := range ids {
+				if !reached[id] {
+					reached[id] = true
+					due = append(due, dueOrder{id: id, tier: tier})
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return due, nil
+}
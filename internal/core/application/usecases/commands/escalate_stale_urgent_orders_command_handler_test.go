@@ -0,0 +1,144 @@
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/escalation"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockOrderEscalationRepository struct{ mock.Mock }
+
+func (m *MockOrderEscalationRepository) Get(ctx context.Context, orderID kernel.UUID) (escalation.Escalation, error) {
+	args := m.Called(ctx, orderID)
+	return args.Get(0).(escalation.Escalation), args.Error(1)
+}
+
+func (m *MockOrderEscalationRepository) Save(ctx context.Context, escalated escalation.Escalation) error {
+	args := m.Called(ctx, escalated)
+	return args.Error(0)
+}
+
+type MockOrderEscalationAlert struct{ mock.Mock }
+
+func (m *MockOrderEscalationAlert) NotifyOrderEscalation(ctx context.Context, escalated ports.OrderEscalation) error {
+	args := m.Called(ctx, escalated)
+	return args.Error(0)
+}
+
+func escalationPolicy(t *testing.T) escalation.Policy {
+	t.Helper()
+	policy, err := escalation.ParsePolicy("10m,30m:matching-rules")
+	require.NoError(t, err)
+	return policy
+}
+
+// urgentWaitingSince matches the filter of the urgent orders in Created created before now minus the wait.
+func urgentWaitingSince(wait time.Duration) any {
+	return mock.MatchedBy(func(filter ports.OrderFilter) bool {
+		return filter.Status == order.Created && filter.Priority != nil && *filter.Priority == order.UrgentPriority &&
+			filter.CreatedBefore != nil && time.Since(filter.CreatedBefore.Add(wait)) < time.Minute
+	})
+}
+
+func notEscalated(id kernel.UUID) (escalation.Escalation, error) {
+	return escalation.Escalation{}, errs.NewObjectNotFoundError("escalation", id)
+}
+
+func TestEscalateStaleUrgentOrdersCommandHandler_Handle_EscalatesToTheHighestTierReached(t *testing.T) {
+	ctx := t.Context()
+	longWaiting, shortWaiting, notified := kernel.NewUUID(), kernel.NewUUID(), kernel.NewUUID()
+	previously, err := escalation.RestoreEscalation(notified, 1, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+
+	repo := new(MoveOrderRepo)
+	uow := new(MockOrderUoW)
+	factory := new(MockOrderUoWFactory)
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(repo).Once()
+	repo.On("GetIDsMatching", ctx, urgentWaitingSince(30*time.Minute), commands.MaxEscalatedOrdersPerTier).
+		Return([]kernel.UUID{longWaiting}, nil).Once()
+	repo.On("GetIDsMatching", ctx, urgentWaitingSince(10*time.Minute), commands.MaxEscalatedOrdersPerTier).
+		Return([]kernel.UUID{longWaiting, shortWaiting, notified}, nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	escalations := new(MockOrderEscalationRepository)
+	escalations.On("Get", ctx, longWaiting).Return(notEscalated(longWaiting)).Once()
+	escalations.On("Get", ctx, shortWaiting).Return(notEscalated(shortWaiting)).Once()
+	escalations.On("Get", ctx, notified).Return(previously, nil).Once()
+	escalations.On("Save", ctx, mock.Anything).Return(nil).Twice()
+
+	alert := new(MockOrderEscalationAlert)
+	alert.On("NotifyOrderEscalation", ctx, ports.OrderEscalation{
+		OrderID: longWaiting, Tier: 2, Tiers: 2, Waited: 30 * time.Minute,
+		Relaxations: []escalation.Relaxation{escalation.WaiveMatchingRules},
+	}).Return(nil).Once()
+	alert.On("NotifyOrderEscalation", ctx, ports.OrderEscalation{
+		OrderID: shortWaiting, Tier: 1, Tiers: 2, Waited: 10 * time.Minute,
+	}).Return(nil).Once()
+
+	handler := commands.NewEscalateStaleUrgentOrdersCommandHandler(factory, escalations, escalationPolicy(t), alert)
+	escalated, err := handler.Handle(ctx, commands.NewEscalateStaleUrgentOrdersCommand())
+
+	require.NoError(t, err)
+	require.Len(t, escalated, 2)
+	assert.Equal(t, longWaiting, escalated[0].OrderID())
+	assert.Equal(t, 2, escalated[0].Tier())
+	assert.Equal(t, shortWaiting, escalated[1].OrderID())
+	assert.Equal(t, 1, escalated[1].Tier())
+	escalations.AssertExpectations(t)
+	alert.AssertExpectations(t)
+}
+
+func TestEscalateStaleUrgentOrdersCommandHandler_Handle_FailedNotificationKeepsTheTier(t *testing.T) {
+	ctx := t.Context()
+	waiting := kernel.NewUUID()
+
+	repo := new(MoveOrderRepo)
+	uow := new(MockOrderUoW)
+	factory := new(MockOrderUoWFactory)
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(repo).Once()
+	repo.On("GetIDsMatching", ctx, urgentWaitingSince(30*time.Minute), commands.MaxEscalatedOrdersPerTier).
+		Return([]kernel.UUID{}, nil).Once()
+	repo.On("GetIDsMatching", ctx, urgentWaitingSince(10*time.Minute), commands.MaxEscalatedOrdersPerTier).
+		Return([]kernel.UUID{waiting}, nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	escalations := new(MockOrderEscalationRepository)
+	escalations.On("Get", ctx, waiting).Return(notEscalated(waiting)).Once()
+	escalations.On("Save", ctx, mock.MatchedBy(func(e escalation.Escalation) bool {
+		return e.OrderID() == waiting && e.Tier() == 1
+	})).Return(nil).Once()
+	alert := new(MockOrderEscalationAlert)
+	alert.On("NotifyOrderEscalation", ctx, mock.Anything).Return(errors.New("webhook down")).Once()
+
+	handler := commands.NewEscalateStaleUrgentOrdersCommandHandler(factory, escalations, escalationPolicy(t), alert)
+	escalated, err := handler.Handle(ctx, commands.NewEscalateStaleUrgentOrdersCommand())
+
+	require.Error(t, err)
+	assert.Len(t, escalated, 1)
+	escalations.AssertExpectations(t)
+}
+
+func TestEscalateStaleUrgentOrdersCommandHandler_Handle_InvalidCommand(t *testing.T) {
+	handler := commands.NewEscalateStaleUrgentOrdersCommandHandler(
+		new(MockOrderUoWFactory), new(MockOrderEscalationRepository), escalationPolicy(t), nil)
+
+	_, err := handler.Handle(t.Context(), commands.EscalateStaleUrgentOrdersCommand{})
+
+	require.ErrorIs(t, err, commands.ErrEscalateStaleUrgentOrdersCommandIsNotConstructed)
+}
@@ -0,0 +1,21 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEscalateStaleUrgentOrdersCommand_Valid(t *testing.T) {
+	cmd := commands.NewEscalateStaleUrgentOrdersCommand()
+
+	require.NoError(t, cmd.Validate())
+}
+
+func TestEscalateStaleUrgentOrdersCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.EscalateStaleUrgentOrdersCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrEscalateStaleUrgentOrdersCommandIsNotConstructed)
+}
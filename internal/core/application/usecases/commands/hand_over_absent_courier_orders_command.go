@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/guard"
+)
+
+var ErrHandOverAbsentCourierOrdersCommandIsNotConstructed = errors.New(
+	"HandOverAbsentCourierOrdersCommand must be created via NewHandOverAbsentCourierOrdersCommand constructor",
+)
+
+// HandOverAbsentCourierOrdersCommand triggers the absence handover: the orders of couriers
+// who are absent pass to their substitutes.
+//
+// Example:
+//
+//	cmd := NewHandOverAbsentCourierOrdersCommand()
+//	report, err := handler.Handle(ctx, cmd)
+//	log.Printf("handed over %d orders", len(report.HandedOver))
+type HandOverAbsentCourierOrdersCommand struct {
+	guard guard.ConstructorGuard
+}
+
+// NewHandOverAbsentCourierOrdersCommand creates a new command to trigger the absence handover.
+func NewHandOverAbsentCourierOrdersCommand() HandOverAbsentCourierOrdersCommand {
+	return HandOverAbsentCourierOrdersCommand{
+		guard: guard.NewConstructorGuard(),
+	}
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrHandOverAbsentCourierOrdersCommandIsNotConstructed if validation fails.
+func (c HandOverAbsentCourierOrdersCommand) Validate() error {
+	return c.guard.Validate(ErrHandOverAbsentCourierOrdersCommandIsNotConstructed)
+}
@@ -0,0 +1,207 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/pkg/tracing"
+)
+
+// absenceAnnotation records the absent courier whose order the substitute took over.
+const absenceAnnotation = "absence.substituted_for"
+
+// HandOverAbsentCourierOrdersReport summarizes one absence handover tick.
+type HandOverAbsentCourierOrdersReport struct {
+	// HandedOver lists orders moved to substitutes.
+	HandedOver []OrderReassignment
+	// Kept lists orders the substitute could not take; they stay with the absent courier
+	// and are offered again on the next tick.
+	Kept []kernel.UUID
+}
+
+// HandOverAbsentCourierOrdersCommandHandler passes the orders of absent couriers to their substitutes.
+// Absent couriers are not dispatched, so the orders they still hold when the absence starts are the
+// only ones to hand over. The substitute takes every order that fits in their storage places, even
+// while busy with orders of their own; a substitute who has been deactivated or is absent themselves
+// takes nothing.
+//
+// Example:
+//
+//	handler := NewHandOverAbsentCourierOrdersCommandHandler(uowFactory)
+//
+//	// Called periodically by the absence handover job
+//	report, err := handler.Handle(ctx, NewHandOverAbsentCourierOrdersCommand())
+//	if err != nil {
+//	    return fmt.Errorf("absence handover tick failed: %w", err)
+//	}
+type HandOverAbsentCourierOrdersCommandHandler struct {
+	uowFactory     UoWFactory
+	postProcessors []DispatchPostProcessor
+}
+
+// NewHandOverAbsentCourierOrdersCommandHandler creates a handler for the absence handover.
+// Requires a UoWFactory for coordinating updates across order and courier repositories.
+// Optional post-processors are applied to every handover exactly as in AssignCourierCommandHandler.
+func NewHandOverAbsentCourierOrdersCommandHandler(
+	uowFactory UoWFactory,
+	postProcessors ...DispatchPostProcessor,
+) HandOverAbsentCourierOrdersCommandHandler {
+	return HandOverAbsentCourierOrdersCommandHandler{
+		uowFactory:     uowFactory,
+		postProcessors: postProcessors,
+	}
+}
+
+// Handle runs a single absence handover tick within a transaction.
+// Each handover is recorded as the "absence.substituted_for" annotation with the ID of the
+// absent courier; a veto (ErrAssignmentIsVetoed) keeps the order with that courier.
+func (h *HandOverAbsentCourierOrdersCommandHandler) Handle(
+	ctx context.Context,
+	cmd HandOverAbsentCourierOrdersCommand,
+) (HandOverAbsentCourierOrdersReport, error) {
+	ctx, span := tracing.Start(ctx, "HandOverAbsentCourierOrdersCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return HandOverAbsentCourierOrdersReport{}, err
+	}
+
+	report := HandOverAbsentCourierOrdersReport{
+		HandedOver: make([]OrderReassignment, 0),
+		Kept:       make([]kernel.UUID, 0),
+	}
+	assignments := make([]*DispatchAssignment, 0)
+
+	uow := h.uowFactory.Create()
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		ordersRepo := uow.OrderRepository()
+
+		orders, err := ordersRepo.GetAllInAssignedStatus(ctx)
+		if err != nil {
+			return err
+		}
+
+		ordersByCourier := make(map[string][]*order.Order)
+		courierIDs := make([]kernel.UUID, 0)
+		for _, o := range orders {
+			key := o.Courier().String()
+			if _, ok := ordersByCourier[key]; !ok {
+				courierIDs = append(courierIDs, *o.Courier())
+			}
+			ordersByCourier[key] = append(ordersByCourier[key], o)
+		}
+
+		now := time.Now()
+
+		for _, courierID := range courierIDs {
+			absent, courierErr := courierRepo.Get(ctx, courierID)
+			if courierErr != nil {
+				return courierErr
+			}
+
+			absence, isAbsent := absent.ActiveAbsence(now)
+			if !isAbsent {
+				continue
+			}
+
+			substitute, substituteErr := courierRepo.Get(ctx, absence.SubstituteID())
+			if substituteErr != nil {
+				return substituteErr
+			}
+
+			handedOver := false
+			for _, o := range ordersByCourier[courierID.String()] {
+				if substitute.IsDeactivated() || substitute.IsAbsent(now) {
+					report.Kept = append(report.Kept, o.ID())
+					continue
+				}
+
+				assignment, ok, handOverErr := h.handOver(ctx, absent, o, substitute)
+				if handOverErr != nil {
+					return handOverErr
+				}
+				if !ok {
+					report.Kept = append(report.Kept, o.ID())
+					continue
+				}
+
+				report.HandedOver = append(report.HandedOver, OrderReassignment{
+					OrderID:   o.ID(),
+					CourierID: substitute.ID(),
+				})
+				assignments = append(assignments, assignment)
+				handedOver = true
+
+				if err = ordersRepo.Update(ctx, o); err != nil {
+					return err
+				}
+			}
+
+			if handedOver {
+				if err = courierRepo.Update(ctx, substitute); err != nil {
+					return err
+				}
+				if err = courierRepo.Update(ctx, absent); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return HandOverAbsentCourierOrdersReport{}, err
+	}
+
+	for _, assignment := range assignments {
+		for _, processor := range h.postProcessors {
+			if listener, ok := processor.(DispatchCommitListener); ok {
+				listener.AssignmentCommitted(ctx, *assignment)
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// handOver reassigns the order of the absent courier to the substitute and reports whether it was
+// handed over. When the order does not fit or the handover is vetoed, the order stays with the
+// absent courier and the substitute is left unchanged.
+func (h *HandOverAbsentCourierOrdersCommandHandler) handOver(
+	ctx context.Context,
+	absent *courier.Courier,
+	o *order.Order,
+	substitute *courier.Courier,
+) (*DispatchAssignment, bool, error) {
+	_, explanation, err := services.NewOrderDispatcher().DispatchExplained(o, []*courier.Courier{substitute})
+	if errors.Is(err, services.ErrCourierNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	assignment := &DispatchAssignment{Order: o, Courier: substitute, Explanation: explanation}
+	assignment.Annotate(absenceAnnotation, absent.ID().String())
+	for _, processor := range h.postProcessors {
+		err = processor.ProcessAssignment(ctx, assignment)
+		if errors.Is(err, ErrAssignmentIsVetoed) {
+			return nil, false, errors.Join(o.Assign(absent.ID()), substitute.ReleaseOrder(o.ID()))
+		}
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	if err = absent.ReleaseOrder(o.ID()); err != nil {
+		return nil, false, err
+	}
+
+	return assignment, true, nil
+}
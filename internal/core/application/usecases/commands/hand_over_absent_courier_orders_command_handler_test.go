@@ -0,0 +1,118 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandOverAbsentCourierOrdersCommandHandler_Handle(t *testing.T) {
+	ctx := t.Context()
+	cmd := commands.NewHandOverAbsentCourierOrdersCommand()
+	now := time.Now()
+
+	setup := func(
+		t *testing.T,
+		orders []*order.Order,
+		couriers ...*courier.Courier,
+	) (*MockAssignOrderRepository, *MockAssignCourierRepository, *MockAssignUoWFactory) {
+		t.Helper()
+		orderRepo := new(MockAssignOrderRepository)
+		courierRepo := new(MockAssignCourierRepository)
+		uow := new(MockAssignUoW)
+		factory := new(MockAssignUoWFactory)
+
+		factory.On("Create").Return(uow).Once()
+		uow.On("Begin", ctx).Return(nil).Once()
+		uow.On("CourierRepository").Return(courierRepo).Once()
+		uow.On("OrderRepository").Return(orderRepo).Once()
+		orderRepo.On("GetAllInAssignedStatus", ctx).Return(orders, nil).Once()
+		for _, c := range couriers {
+			courierRepo.On("Get", ctx, c.ID()).Return(c, nil).Maybe()
+		}
+		uow.On("Commit", ctx).Return(nil).Once()
+		return orderRepo, courierRepo, factory
+	}
+
+	planAbsence := func(t *testing.T, absent *courier.Courier, from, to time.Duration, substituteID kernel.UUID) {
+		t.Helper()
+		absence, err := courier.NewAbsence(kernel.NewUUID(), now.Add(from), now.Add(to), substituteID)
+		require.NoError(t, err)
+		require.NoError(t, absent.PlanAbsence(absence, now))
+	}
+
+	t.Run("should hand over the orders of an absent courier to the substitute", func(t *testing.T) {
+		absent := createCourierAt(t, 1, 1)
+		substitute := createCourierAt(t, 9, 9)
+		o := createAssignedOrderAt(t, absent, 5, 5)
+		planAbsence(t, absent, -time.Hour, time.Hour, substitute.ID())
+
+		orderRepo, courierRepo, factory := setup(t, []*order.Order{o}, absent, substitute)
+		listener := new(MockDispatchCommitListener)
+		listener.On("ProcessAssignment", ctx, mock.AnythingOfType("*commands.DispatchAssignment")).Return(nil).Once()
+		listener.On("AssignmentCommitted", ctx, mock.MatchedBy(func(a commands.DispatchAssignment) bool {
+			return a.Annotations()["absence.substituted_for"] == absent.ID().String()
+		})).Once()
+		orderRepo.On("Update", ctx, o).Return(nil).Once()
+		courierRepo.On("Update", ctx, substitute).Return(nil).Once()
+		courierRepo.On("Update", ctx, absent).Return(nil).Once()
+
+		handler := commands.NewHandOverAbsentCourierOrdersCommandHandler(factory, listener)
+		report, err := handler.Handle(ctx, cmd)
+
+		require.NoError(t, err)
+		assert.Equal(t, []commands.OrderReassignment{{OrderID: o.ID(), CourierID: substitute.ID()}}, report.HandedOver)
+		assert.Empty(t, report.Kept)
+		assert.True(t, o.Courier().IsEqual(substitute.ID()))
+		for _, place := range absent.StoragePlaces() {
+			assert.Nil(t, place.OrderID())
+		}
+		orderRepo.AssertExpectations(t)
+		courierRepo.AssertExpectations(t)
+		listener.AssertExpectations(t)
+	})
+
+	t.Run("should keep orders when the substitute is absent too", func(t *testing.T) {
+		absent := createCourierAt(t, 1, 1)
+		substitute := createCourierAt(t, 9, 9)
+		o := createAssignedOrderAt(t, absent, 5, 5)
+		planAbsence(t, absent, -time.Hour, time.Hour, substitute.ID())
+		planAbsence(t, substitute, -time.Hour, time.Hour, kernel.NewUUID())
+
+		orderRepo, courierRepo, factory := setup(t, []*order.Order{o}, absent, substitute)
+
+		handler := commands.NewHandOverAbsentCourierOrdersCommandHandler(factory)
+		report, err := handler.Handle(ctx, cmd)
+
+		require.NoError(t, err)
+		assert.Empty(t, report.HandedOver)
+		assert.Equal(t, []kernel.UUID{o.ID()}, report.Kept)
+		assert.True(t, o.Courier().IsEqual(absent.ID()))
+		orderRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		courierRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should leave couriers who are at work alone", func(t *testing.T) {
+		present := createCourierAt(t, 1, 1)
+		o := createAssignedOrderAt(t, present, 5, 5)
+		planAbsence(t, present, time.Hour, 2*time.Hour, kernel.NewUUID())
+
+		orderRepo, _, factory := setup(t, []*order.Order{o}, present)
+
+		handler := commands.NewHandOverAbsentCourierOrdersCommandHandler(factory)
+		report, err := handler.Handle(ctx, cmd)
+
+		require.NoError(t, err)
+		assert.Empty(t, report.HandedOver)
+		assert.Empty(t, report.Kept)
+		orderRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,19 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHandOverAbsentCourierOrdersCommand(t *testing.T) {
+	cmd := commands.NewHandOverAbsentCourierOrdersCommand()
+	require.NoError(t, cmd.Validate())
+}
+
+func TestHandOverAbsentCourierOrdersCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.HandOverAbsentCourierOrdersCommand
+	require.ErrorIs(t, cmd.Validate(), commands.ErrHandOverAbsentCourierOrdersCommandIsNotConstructed)
+}
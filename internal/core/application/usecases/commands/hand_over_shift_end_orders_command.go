@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrHandOverShiftEndOrdersCommandIsNotConstructed = errors.New(
+		"HandOverShiftEndOrdersCommand must be created via NewHandOverShiftEndOrdersCommand constructor",
+	)
+	ErrCourierTurnIsInvalid = errors.New("courier turn must be greater than 0")
+)
+
+// HandOverShiftEndOrdersCommand triggers the shift end handover.
+// Couriers near the end of their shift keep the orders they can deliver in time;
+// the others are handed over to free couriers on shift.
+//
+// Example:
+//
+//	cmd, err := NewHandOverShiftEndOrdersCommand(time.Second)
+//	if err != nil {
+//	    return fmt.Errorf("invalid handover settings: %w", err)
+//	}
+//
+//	report, err := handler.Handle(ctx, cmd)
+//	log.Printf("handed over %d orders", len(report.HandedOver))
+type HandOverShiftEndOrdersCommand struct { //nolint:recvcheck //using for validation
+	turn time.Duration
+
+	guard guard.ConstructorGuard
+}
+
+// NewHandOverShiftEndOrdersCommand creates a handover command.
+// turn is the wall-clock length of one courier turn, used to tell whether a delivery completes
+// before the shift ends. Returns an error if the turn is not positive.
+func NewHandOverShiftEndOrdersCommand(turn time.Duration) (HandOverShiftEndOrdersCommand, error) {
+	command := HandOverShiftEndOrdersCommand{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := command.setTurn(turn); err != nil {
+		return HandOverShiftEndOrdersCommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrHandOverShiftEndOrdersCommandIsNotConstructed if validation fails.
+func (c HandOverShiftEndOrdersCommand) Validate() error {
+	return c.guard.Validate(ErrHandOverShiftEndOrdersCommandIsNotConstructed)
+}
+
+// Turn returns the wall-clock length of one courier turn.
+func (c HandOverShiftEndOrdersCommand) Turn() time.Duration {
+	return c.turn
+}
+
+func (c *HandOverShiftEndOrdersCommand) setTurn(turn time.Duration) error {
+	if turn <= 0 {
+		return ErrCourierTurnIsInvalid
+	}
+
+	c.turn = turn
+	return nil
+}
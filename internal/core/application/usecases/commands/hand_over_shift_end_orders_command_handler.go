@@ -0,0 +1,250 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tracing"
+)
+
+// handOverAnnotation records the courier whose shift ended before they could deliver the order.
+const handOverAnnotation = "shift_end.handed_over_from"
+
+// HandOverShiftEndOrdersReport summarizes one shift end handover tick.
+type HandOverShiftEndOrdersReport struct {
+	// HandedOver lists orders moved to couriers who are on shift.
+	HandedOver []OrderReassignment
+	// Kept lists orders that could not be delivered in time but that no free courier could take;
+	// they stay with their courier and are offered again on the next tick.
+	Kept []kernel.UUID
+}
+
+// HandOverShiftEndOrdersCommandHandler hands over the orders of couriers whose shift is ending.
+// A courier is draining once the working hours limit is approaching: the assignment job no longer
+// offers them orders and they finish the ones they carry. Every order a draining courier cannot
+// deliver before reaching the limit, estimated from the distance to the order, is reassigned to
+// the best free courier on shift who is well within the limit. Couriers off shift are left alone.
+//
+// Example:
+//
+//	handler := NewHandOverShiftEndOrdersCommandHandler(uowFactory, limit)
+//	cmd, _ := NewHandOverShiftEndOrdersCommand(time.Second)
+//
+//	// Called periodically by the shift end job
+//	report, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    return fmt.Errorf("handover tick failed: %w", err)
+//	}
+type HandOverShiftEndOrdersCommandHandler struct {
+	uowFactory     UoWFactory
+	workingHours   courier.WorkingHoursLimit
+	postProcessors []DispatchPostProcessor
+}
+
+// NewHandOverShiftEndOrdersCommandHandler creates a handler for the shift end handover.
+// Requires a UoWFactory for coordinating updates across order and courier repositories and the
+// working hours limit that defines when shifts end. Optional post-processors are applied to every
+// handover exactly as in AssignCourierCommandHandler.
+func NewHandOverShiftEndOrdersCommandHandler(
+	uowFactory UoWFactory,
+	workingHours courier.WorkingHoursLimit,
+	postProcessors ...DispatchPostProcessor,
+) HandOverShiftEndOrdersCommandHandler {
+	return HandOverShiftEndOrdersCommandHandler{
+		uowFactory:     uowFactory,
+		workingHours:   workingHours,
+		postProcessors: postProcessors,
+	}
+}
+
+// Handle runs a single handover tick within a transaction.
+// Each handover is recorded as the "shift_end.handed_over_from" annotation with the ID of the
+// draining courier; a veto (ErrAssignmentIsVetoed) keeps the order with that courier.
+func (h *HandOverShiftEndOrdersCommandHandler) Handle(
+	ctx context.Context,
+	cmd HandOverShiftEndOrdersCommand,
+) (HandOverShiftEndOrdersReport, error) {
+	ctx, span := tracing.Start(ctx, "HandOverShiftEndOrdersCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return HandOverShiftEndOrdersReport{}, err
+	}
+	if err := h.workingHours.Validate(); err != nil {
+		return HandOverShiftEndOrdersReport{}, err
+	}
+
+	report := HandOverShiftEndOrdersReport{
+		HandedOver: make([]OrderReassignment, 0),
+		Kept:       make([]kernel.UUID, 0),
+	}
+	assignments := make([]*DispatchAssignment, 0)
+
+	uow := h.uowFactory.Create()
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		ordersRepo := uow.OrderRepository()
+
+		orders, err := ordersRepo.GetAllInAssignedStatus(ctx)
+		if err != nil {
+			return err
+		}
+
+		ordersByCourier := make(map[string][]*order.Order)
+		courierIDs := make([]kernel.UUID, 0)
+		for _, o := range orders {
+			key := o.Courier().String()
+			if _, ok := ordersByCourier[key]; !ok {
+				courierIDs = append(courierIDs, *o.Courier())
+			}
+			ordersByCourier[key] = append(ordersByCourier[key], o)
+		}
+
+		now := time.Now()
+		var candidates []*courier.Courier
+
+		for _, courierID := range courierIDs {
+			draining, courierErr := courierRepo.Get(ctx, courierID)
+			if courierErr != nil {
+				return courierErr
+			}
+
+			worked := draining.WorkLog().WorkedOn(now)
+			if !draining.WorkLog().IsOnShift() || h.workingHours.Assess(worked) == courier.WithinLimit {
+				continue
+			}
+			timeLeft := h.workingHours.Remaining(worked)
+
+			handedOver := false
+			for _, o := range ordersByCourier[courierID.String()] {
+				travelTime, travelErr := draining.CalculateTimeToLocation(o.Location())
+				if travelErr != nil {
+					return travelErr
+				}
+				if time.Duration(math.Ceil(travelTime))*cmd.Turn() <= timeLeft {
+					continue
+				}
+
+				if candidates == nil {
+					if candidates, err = h.candidates(ctx, courierRepo, now); err != nil {
+						return err
+					}
+				}
+
+				assignment, ok, handOverErr := h.handOver(ctx, draining, o, candidates)
+				if handOverErr != nil {
+					return handOverErr
+				}
+				if !ok {
+					report.Kept = append(report.Kept, o.ID())
+					continue
+				}
+
+				report.HandedOver = append(report.HandedOver, OrderReassignment{
+					OrderID:   o.ID(),
+					CourierID: assignment.Courier.ID(),
+				})
+				assignments = append(assignments, assignment)
+				candidates = withoutCourier(candidates, assignment.Courier)
+				handedOver = true
+
+				if err = courierRepo.Update(ctx, assignment.Courier); err != nil {
+					return err
+				}
+				if err = ordersRepo.Update(ctx, o); err != nil {
+					return err
+				}
+			}
+
+			if handedOver {
+				if err = courierRepo.Update(ctx, draining); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return HandOverShiftEndOrdersReport{}, err
+	}
+
+	for _, assignment := range assignments {
+		for _, processor := range h.postProcessors {
+			if listener, ok := processor.(DispatchCommitListener); ok {
+				listener.AssignmentCommitted(ctx, *assignment)
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// candidates returns the free couriers on shift who are well within the working hours limit
+// and within their schedule.
+func (h *HandOverShiftEndOrdersCommandHandler) candidates(
+	ctx context.Context,
+	courierRepo ports.CourierRepository,
+	now time.Time,
+) ([]*courier.Courier, error) {
+	free, err := courierRepo.GetAllFree(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]*courier.Courier, 0, len(free))
+	for _, c := range free {
+		if c.WorkLog().IsOnShift() && c.IsWithinSchedule(now) &&
+			h.workingHours.Assess(c.WorkLog().WorkedOn(now)) == courier.WithinLimit {
+			candidates = append(candidates, c)
+		}
+	}
+	return candidates, nil
+}
+
+// handOver reassigns the order of the draining courier to the best candidate and reports whether
+// it was handed over. When no candidate can take the order or the handover is vetoed, the order
+// stays with the draining courier and the candidates are left unchanged.
+func (h *HandOverShiftEndOrdersCommandHandler) handOver(
+	ctx context.Context,
+	draining *courier.Courier,
+	o *order.Order,
+	candidates []*courier.Courier,
+) (*DispatchAssignment, bool, error) {
+	if len(candidates) == 0 {
+		return nil, false, nil
+	}
+
+	assignedCourier, explanation, err := services.NewOrderDispatcher().DispatchExplained(o, candidates)
+	if errors.Is(err, services.ErrCourierNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	assignment := &DispatchAssignment{Order: o, Courier: assignedCourier, Explanation: explanation}
+	assignment.Annotate(handOverAnnotation, draining.ID().String())
+	for _, processor := range h.postProcessors {
+		err = processor.ProcessAssignment(ctx, assignment)
+		if errors.Is(err, ErrAssignmentIsVetoed) {
+			return nil, false, errors.Join(o.Assign(draining.ID()), assignedCourier.ReleaseOrder(o.ID()))
+		}
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	if err = draining.ReleaseOrder(o.ID()); err != nil {
+		return nil, false, err
+	}
+
+	return assignment, true, nil
+}
@@ -0,0 +1,170 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createAssignedOrderAt(t *testing.T, c *courier.Courier, x, y kernel.Coordinate) *order.Order {
+	t.Helper()
+	location, err := kernel.NewLocation(x, y)
+	require.NoError(t, err)
+
+	o, err := order.NewOrder(kernel.NewUUID(), location, 5)
+	require.NoError(t, err)
+	require.NoError(t, c.TakeOrder(o))
+	require.NoError(t, o.Assign(c.ID()))
+	return o
+}
+
+func startShiftAfter(t *testing.T, c *courier.Courier, worked time.Duration) {
+	t.Helper()
+	now := time.Now()
+	log, err := courier.RestoreWorkLog(now, worked, &now)
+	require.NoError(t, err)
+	c.RestoreWorkLog(log)
+}
+
+func TestHandOverShiftEndOrdersCommandHandler_Handle(t *testing.T) {
+	ctx := t.Context()
+	limit, err := courier.NewWorkingHoursLimit(8*time.Hour, 30*time.Minute)
+	require.NoError(t, err)
+	// 15 minutes are left; a turn of two minutes lets the draining courier make 7 moves
+	cmd, err := commands.NewHandOverShiftEndOrdersCommand(2 * time.Minute)
+	require.NoError(t, err)
+
+	setup := func(
+		t *testing.T,
+		draining *courier.Courier,
+		orders []*order.Order,
+		free []*courier.Courier,
+	) (*MockAssignOrderRepository, *MockAssignCourierRepository, *MockAssignUoW, *MockAssignUoWFactory) {
+		t.Helper()
+		orderRepo := new(MockAssignOrderRepository)
+		courierRepo := new(MockAssignCourierRepository)
+		uow := new(MockAssignUoW)
+		factory := new(MockAssignUoWFactory)
+
+		factory.On("Create").Return(uow).Once()
+		uow.On("Begin", ctx).Return(nil).Once()
+		uow.On("CourierRepository").Return(courierRepo).Once()
+		uow.On("OrderRepository").Return(orderRepo).Once()
+		orderRepo.On("GetAllInAssignedStatus", ctx).Return(orders, nil).Once()
+		courierRepo.On("Get", ctx, draining.ID()).Return(draining, nil).Once()
+		courierRepo.On("GetAllFree", ctx).Return(free, nil).Maybe()
+		uow.On("Commit", ctx).Return(nil).Once()
+		return orderRepo, courierRepo, uow, factory
+	}
+
+	newDraining := func(t *testing.T) *courier.Courier {
+		t.Helper()
+		draining := createCourierAt(t, 1, 1)
+		require.NoError(t, draining.AddStoragePlace("Багажник", 10))
+		startShiftAfter(t, draining, 7*time.Hour+45*time.Minute)
+		return draining
+	}
+
+	t.Run("should hand over orders that cannot be delivered before the shift ends", func(t *testing.T) {
+		draining := newDraining(t)
+		near := createAssignedOrderAt(t, draining, 5, 5)
+		far := createAssignedOrderAt(t, draining, 10, 10)
+
+		// The closest free courier is off shift and cannot take over
+		offShift := createCourierAt(t, 10, 9)
+		onShift := createCourierAt(t, 9, 9)
+		startShiftAfter(t, onShift, time.Hour)
+
+		orderRepo, courierRepo, uow, factory := setup(t, draining, []*order.Order{near, far}, []*courier.Courier{offShift, onShift})
+		listener := new(MockDispatchCommitListener)
+		listener.On("ProcessAssignment", ctx, mock.AnythingOfType("*commands.DispatchAssignment")).Return(nil).Once()
+		listener.On("AssignmentCommitted", ctx, mock.MatchedBy(func(a commands.DispatchAssignment) bool {
+			return a.Annotations()["shift_end.handed_over_from"] == draining.ID().String()
+		})).Once()
+		courierRepo.On("Update", ctx, onShift).Return(nil).Once()
+		orderRepo.On("Update", ctx, far).Return(nil).Once()
+		courierRepo.On("Update", ctx, draining).Return(nil).Once()
+
+		handler := commands.NewHandOverShiftEndOrdersCommandHandler(factory, limit, listener)
+		report, err := handler.Handle(ctx, cmd)
+
+		require.NoError(t, err)
+		assert.Equal(t, []commands.OrderReassignment{{OrderID: far.ID(), CourierID: onShift.ID()}}, report.HandedOver)
+		assert.Empty(t, report.Kept)
+		assert.True(t, near.Courier().IsEqual(draining.ID()))
+		assert.True(t, far.Courier().IsEqual(onShift.ID()))
+		assert.Equal(t, order.Assigned, far.Status())
+
+		canTake, err := draining.CanTakeOrder(far)
+		require.NoError(t, err)
+		assert.True(t, canTake)
+
+		orderRepo.AssertExpectations(t)
+		courierRepo.AssertExpectations(t)
+		uow.AssertExpectations(t)
+		listener.AssertExpectations(t)
+	})
+
+	t.Run("should keep orders nobody can take over", func(t *testing.T) {
+		draining := newDraining(t)
+		far := createAssignedOrderAt(t, draining, 10, 10)
+
+		orderRepo, courierRepo, _, factory := setup(t, draining, []*order.Order{far}, []*courier.Courier{})
+
+		handler := commands.NewHandOverShiftEndOrdersCommandHandler(factory, limit)
+		report, err := handler.Handle(ctx, cmd)
+
+		require.NoError(t, err)
+		assert.Empty(t, report.HandedOver)
+		assert.Equal(t, []kernel.UUID{far.ID()}, report.Kept)
+		assert.True(t, far.Courier().IsEqual(draining.ID()))
+		orderRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		courierRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should keep vetoed handovers with the draining courier", func(t *testing.T) {
+		draining := newDraining(t)
+		far := createAssignedOrderAt(t, draining, 10, 10)
+		onShift := createCourierAt(t, 9, 9)
+		startShiftAfter(t, onShift, time.Hour)
+
+		orderRepo, courierRepo, _, factory := setup(t, draining, []*order.Order{far}, []*courier.Courier{onShift})
+		vetoing := new(MockDispatchPostProcessor)
+		vetoing.On("ProcessAssignment", ctx, mock.Anything).Return(commands.VetoAssignment("fraud suspected")).Once()
+
+		handler := commands.NewHandOverShiftEndOrdersCommandHandler(factory, limit, vetoing)
+		report, err := handler.Handle(ctx, cmd)
+
+		require.NoError(t, err)
+		assert.Equal(t, []kernel.UUID{far.ID()}, report.Kept)
+		assert.True(t, far.Courier().IsEqual(draining.ID()))
+		assert.Equal(t, order.Assigned, far.Status())
+		assert.Nil(t, onShift.StoragePlaces()[0].OrderID())
+		orderRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		courierRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should leave couriers within the limit alone", func(t *testing.T) {
+		busy := createCourierAt(t, 1, 1)
+		startShiftAfter(t, busy, time.Hour)
+		far := createAssignedOrderAt(t, busy, 10, 10)
+
+		_, courierRepo, _, factory := setup(t, busy, []*order.Order{far}, nil)
+
+		handler := commands.NewHandOverShiftEndOrdersCommandHandler(factory, limit)
+		report, err := handler.Handle(ctx, cmd)
+
+		require.NoError(t, err)
+		assert.Empty(t, report.HandedOver)
+		assert.Empty(t, report.Kept)
+		courierRepo.AssertNotCalled(t, "GetAllFree", mock.Anything)
+	})
+}
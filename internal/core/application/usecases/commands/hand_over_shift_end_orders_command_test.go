@@ -0,0 +1,30 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHandOverShiftEndOrdersCommand_ValidInput(t *testing.T) {
+	cmd, err := commands.NewHandOverShiftEndOrdersCommand(time.Second)
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, time.Second, cmd.Turn())
+}
+
+func TestNewHandOverShiftEndOrdersCommand_InvalidTurn(t *testing.T) {
+	for _, turn := range []time.Duration{0, -time.Second} {
+		_, err := commands.NewHandOverShiftEndOrdersCommand(turn)
+		require.ErrorIs(t, err, commands.ErrCourierTurnIsInvalid)
+	}
+}
+
+func TestHandOverShiftEndOrdersCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.HandOverShiftEndOrdersCommand
+	require.ErrorIs(t, cmd.Validate(), commands.ErrHandOverShiftEndOrdersCommandIsNotConstructed)
+}
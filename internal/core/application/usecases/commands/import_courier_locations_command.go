@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/track"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrImportCourierLocationsCommandIsNotConstructed = errors.New(
+		"ImportCourierLocationsCommand must be created via NewImportCourierLocationsCommand constructor",
+	)
+)
+
+// ImportCourierLocationsCommand represents a batch of positions a courier app recorded while
+// offline and uploads once it syncs.
+//
+// Example:
+//
+//	point, _ := track.NewPoint(location, recordedAt)
+//	cmd, err := NewImportCourierLocationsCommand(courierID, []track.Point{point})
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewImportCourierLocationsCommandHandler(tracks)
+//	report, err := handler.Handle(ctx, cmd)
+type ImportCourierLocationsCommand struct { //nolint:recvcheck //using for validation
+	courierID kernel.UUID
+	points    []track.Point
+
+	guard guard.ConstructorGuard
+}
+
+// NewImportCourierLocationsCommand creates a command to import the courier's points.
+// Returns a validation error if the courier ID is invalid, the batch is empty or larger than
+// track.MaxBatchPoints, or a point was not created through its constructor.
+func NewImportCourierLocationsCommand(
+	courierID kernel.UUID,
+	points []track.Point,
+) (ImportCourierLocationsCommand, error) {
+	var validation errs.ValidationErrors
+	validation.Add("courierId", courierID.Validate())
+	switch {
+	case len(points) == 0:
+		validation.Add("points", errs.NewValueIsRequiredError("points"))
+	case len(points) > track.MaxBatchPoints:
+		validation.Add("points", errs.NewValueIsInvalidErrorWithCause(
+			"points are invalid",
+			fmt.Errorf("%d points exceed the batch limit of %d", len(points), track.MaxBatchPoints),
+		))
+	}
+	for i, point := range points {
+		validation.Add("points."+strconv.Itoa(i), point.Validate())
+	}
+	if err := validation.Err(); err != nil {
+		return ImportCourierLocationsCommand{}, err
+	}
+
+	return ImportCourierLocationsCommand{
+		courierID: courierID,
+		points:    points,
+		guard:     guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrImportCourierLocationsCommandIsNotConstructed if validation fails.
+func (c ImportCourierLocationsCommand) Validate() error {
+	return c.guard.Validate(ErrImportCourierLocationsCommandIsNotConstructed)
+}
+
+// CourierID returns the ID of the courier whose app recorded the points.
+func (c ImportCourierLocationsCommand) CourierID() kernel.UUID {
+	return c.courierID
+}
+
+// Points returns the uploaded points in the order the app sent them.
+func (c ImportCourierLocationsCommand) Points() []track.Point {
+	return c.points
+}
@@ -0,0 +1,112 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/track"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tracing"
+)
+
+// CourierLocationImport describes an imported batch of points: how many were received, how
+// many of them were new to the courier's history and the daily tracks they changed.
+type CourierLocationImport struct {
+	Received   int
+	Imported   int
+	Duplicates int
+	Days       []track.DailyTrack
+}
+
+// ImportCourierLocationsCommandHandler merges the points courier apps upload after being offline
+// into the location history and summarizes again the daily tracks of the days they fall on.
+// The courier's current location is left to dispatch, as the points describe the past.
+//
+// Example:
+//
+//	handler := NewImportCourierLocationsCommandHandler(tracks)
+//	report, err := handler.Handle(ctx, cmd)
+//	if err == nil {
+//	    log.Printf("%d of %d points were new", report.Imported, report.Received)
+//	}
+type ImportCourierLocationsCommandHandler struct {
+	tracks ports.CourierTrackRepository
+}
+
+// NewImportCourierLocationsCommandHandler creates a handler for location batches.
+func NewImportCourierLocationsCommandHandler(tracks ports.CourierTrackRepository) ImportCourierLocationsCommandHandler {
+	return ImportCourierLocationsCommandHandler{tracks: tracks}
+}
+
+// Handle drops the points resent within the batch, merges the rest into the courier's history
+// and returns the daily tracks of the days the batch touched, earliest first. Points recorded
+// more than a minute in the future refuse the whole batch. Returns an ObjectNotFoundError if
+// the courier does not exist.
+func (h ImportCourierLocationsCommandHandler) Handle(
+	ctx context.Context,
+	cmd ImportCourierLocationsCommand,
+) (CourierLocationImport, error) {
+	ctx, span := tracing.Start(ctx, "ImportCourierLocationsCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return CourierLocationImport{}, err
+	}
+
+	points := track.Deduplicate(cmd.Points())
+	latest := points[len(points)-1].RecordedAt()
+	if latest.After(time.Now().Add(maxTelemetryClockSkew)) {
+		return CourierLocationImport{}, errs.JoinFields(errs.Field("points", errs.NewValueIsInvalidErrorWithCause(
+			"recordedAt is invalid",
+			fmt.Errorf("%s is in the future", latest.Format(time.RFC3339)),
+		)))
+	}
+
+	imported, err := h.tracks.Merge(ctx, cmd.CourierID(), points)
+	if err != nil {
+		return CourierLocationImport{}, err
+	}
+
+	dailyTracks, err := summarizeTrackDays(ctx, h.tracks, cmd.CourierID(), track.Days(points))
+	if err != nil {
+		return CourierLocationImport{}, err
+	}
+
+	return CourierLocationImport{
+		Received:   len(cmd.Points()),
+		Imported:   imported,
+		Duplicates: len(cmd.Points()) - imported,
+		Days:       dailyTracks,
+	}, nil
+}
+
+// summarizeTrackDays summarizes again the courier's daily tracks of the days from the location
+// history and stores them, returning them in the order of days.
+func summarizeTrackDays(
+	ctx context.Context,
+	tracks ports.CourierTrackRepository,
+	courierID kernel.UUID,
+	days []time.Time,
+) ([]track.DailyTrack, error) {
+	dailyTracks := make([]track.DailyTrack, 0, len(days))
+	for _, day := range days {
+		history, err := tracks.GetDay(ctx, courierID, day)
+		if err != nil {
+			return nil, err
+		}
+
+		daily, err := track.Summarize(courierID, day, history)
+		if err != nil {
+			return nil, err
+		}
+		dailyTracks = append(dailyTracks, daily)
+	}
+
+	if err := tracks.SaveDailyTracks(ctx, dailyTracks); err != nil {
+		return nil, err
+	}
+	return dailyTracks, nil
+}
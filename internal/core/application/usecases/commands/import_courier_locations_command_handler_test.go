@@ -0,0 +1,120 @@
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/track"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockCourierTrackRepository is a mock for ports.CourierTrackRepository.
+type MockCourierTrackRepository struct {
+	mock.Mock
+}
+
+func (m *MockCourierTrackRepository) Merge(ctx context.Context, courierID kernel.UUID, points []track.Point) (int, error) {
+	args := m.Called(ctx, courierID, points)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockCourierTrackRepository) GetDay(
+	ctx context.Context,
+	courierID kernel.UUID,
+	day time.Time,
+) ([]track.Point, error) {
+	args := m.Called(ctx, courierID, day)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]track.Point), args.Error(1)
+}
+
+func (m *MockCourierTrackRepository) SaveDailyTracks(ctx context.Context, tracks []track.DailyTrack) error {
+	args := m.Called(ctx, tracks)
+	return args.Error(0)
+}
+
+func importCourierLocations(t *testing.T, courierID kernel.UUID, points ...track.Point) commands.ImportCourierLocationsCommand {
+	t.Helper()
+	cmd, err := commands.NewImportCourierLocationsCommand(courierID, points)
+	require.NoError(t, err)
+	return cmd
+}
+
+func TestImportCourierLocationsCommandHandler_Handle_MergesPointsAndSummarizesTheirDays(t *testing.T) {
+	ctx := t.Context()
+	courierID := kernel.NewUUID()
+	today := track.Day(time.Now())
+	yesterday := today.AddDate(0, 0, -1)
+	late := trackPoint(t, 4, 4, yesterday.Add(20*time.Hour))
+	early := trackPoint(t, 1, 1, yesterday.Add(8*time.Hour))
+	resent := trackPoint(t, 9, 9, yesterday.Add(8*time.Hour))
+	morning := trackPoint(t, 2, 2, today)
+	tracks := new(MockCourierTrackRepository)
+
+	tracks.On("Merge", ctx, courierID, []track.Point{early, late, morning}).Return(2, nil).Once()
+	tracks.On("GetDay", ctx, courierID, yesterday).
+		Return([]track.Point{early, trackPoint(t, 1, 3, yesterday.Add(12*time.Hour)), late}, nil).Once()
+	tracks.On("GetDay", ctx, courierID, today).Return([]track.Point{morning}, nil).Once()
+	tracks.On("SaveDailyTracks", ctx, mock.MatchedBy(func(days []track.DailyTrack) bool {
+		return len(days) == 2
+	})).Return(nil).Once()
+
+	handler := commands.NewImportCourierLocationsCommandHandler(tracks)
+	report, err := handler.Handle(ctx, importCourierLocations(t, courierID, late, early, resent, morning))
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, report.Received)
+	assert.Equal(t, 2, report.Imported)
+	assert.Equal(t, 2, report.Duplicates)
+	require.Len(t, report.Days, 2)
+	assert.Equal(t, yesterday, report.Days[0].Day())
+	assert.Equal(t, 3, report.Days[0].Points())
+	assert.Equal(t, 6, report.Days[0].Distance())
+	assert.Equal(t, today, report.Days[1].Day())
+	assert.Equal(t, 1, report.Days[1].Points())
+	tracks.AssertExpectations(t)
+}
+
+func TestImportCourierLocationsCommandHandler_Handle_RefusesPointFromTheFuture(t *testing.T) {
+	tracks := new(MockCourierTrackRepository)
+	cmd := importCourierLocations(t, kernel.NewUUID(),
+		trackPoint(t, 1, 1, time.Now()), trackPoint(t, 1, 2, time.Now().Add(time.Hour)))
+
+	handler := commands.NewImportCourierLocationsCommandHandler(tracks)
+	_, err := handler.Handle(t.Context(), cmd)
+
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	tracks.AssertNotCalled(t, "Merge", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestImportCourierLocationsCommandHandler_Handle_UnknownCourier(t *testing.T) {
+	ctx := t.Context()
+	courierID := kernel.NewUUID()
+	tracks := new(MockCourierTrackRepository)
+
+	tracks.On("Merge", ctx, courierID, mock.Anything).
+		Return(0, errs.NewObjectNotFoundError("courier", courierID)).Once()
+
+	handler := commands.NewImportCourierLocationsCommandHandler(tracks)
+	_, err := handler.Handle(ctx, importCourierLocations(t, courierID, trackPoint(t, 1, 1, time.Now())))
+
+	require.True(t, errors.Is(err, errs.ErrObjectNotFound))
+	tracks.AssertNotCalled(t, "SaveDailyTracks", mock.Anything, mock.Anything)
+}
+
+func TestImportCourierLocationsCommandHandler_Handle_InvalidCommand(t *testing.T) {
+	handler := commands.NewImportCourierLocationsCommandHandler(new(MockCourierTrackRepository))
+	_, err := handler.Handle(t.Context(), commands.ImportCourierLocationsCommand{})
+
+	require.ErrorIs(t, err, commands.ErrImportCourierLocationsCommandIsNotConstructed)
+}
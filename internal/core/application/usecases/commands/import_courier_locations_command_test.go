@@ -0,0 +1,71 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/track"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func trackPoint(t *testing.T, x, y kernel.Coordinate, recordedAt time.Time) track.Point {
+	t.Helper()
+	location, err := kernel.NewLocation(x, y)
+	require.NoError(t, err)
+	point, err := track.NewPoint(location, recordedAt)
+	require.NoError(t, err)
+	return point
+}
+
+func TestNewImportCourierLocationsCommand_ValidInput(t *testing.T) {
+	courierID := kernel.NewUUID()
+	points := []track.Point{trackPoint(t, 1, 1, time.Now())}
+
+	cmd, err := commands.NewImportCourierLocationsCommand(courierID, points)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, courierID, cmd.CourierID())
+	assert.Equal(t, points, cmd.Points())
+}
+
+func TestNewImportCourierLocationsCommand_InvalidInput(t *testing.T) {
+	t.Run("should refuse empty batch", func(t *testing.T) {
+		_, err := commands.NewImportCourierLocationsCommand(kernel.NewUUID(), nil)
+
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+	})
+
+	t.Run("should refuse batch over the limit", func(t *testing.T) {
+		points := make([]track.Point, track.MaxBatchPoints+1)
+		for i := range points {
+			points[i] = trackPoint(t, 1, 1, time.Now().Add(-time.Duration(i)*time.Second))
+		}
+
+		_, err := commands.NewImportCourierLocationsCommand(kernel.NewUUID(), points)
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should name points not created via constructor", func(t *testing.T) {
+		_, err := commands.NewImportCourierLocationsCommand(kernel.NewUUID(), []track.Point{
+			trackPoint(t, 1, 1, time.Now()),
+			{},
+		})
+
+		require.ErrorIs(t, err, track.ErrPointIsNotConstructed)
+		var validation *errs.ValidationErrors
+		require.ErrorAs(t, err, &validation)
+		assert.Equal(t, "points.1", validation.Fields[0].Field)
+	})
+}
+
+func TestImportCourierLocationsCommand_NotConstructed(t *testing.T) {
+	require.ErrorIs(t, commands.ImportCourierLocationsCommand{}.Validate(),
+		commands.ErrImportCourierLocationsCommandIsNotConstructed)
+}
@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"delivery/internal/pkg/guard"
+)
+
+// MaxImportedOrders limits how many rows a single upload may contain.
+const MaxImportedOrders = 1000
+
+var (
+	ErrImportOrdersCommandIsNotConstructed = errors.New(
+		"ImportOrdersCommand must be created via NewImportOrdersCommand constructor",
+	)
+	ErrImportRowsAreRequired = errors.New("at least one order row is required")
+	ErrTooManyImportRows     = fmt.Errorf("no more than %d order rows can be imported at once", MaxImportedOrders)
+)
+
+// OrderImportRow is one order of a bulk upload as read from the file.
+// Values are kept as text so that every row can be validated and reported on its own:
+// Volume is a positive integer, DeliveryFrom and DeliveryTo are RFC 3339 timestamps
+// and are either both set or both empty.
+type OrderImportRow struct {
+	// Line is the row number in the uploaded file, used to report results
+	Line         int
+	Street       string
+	Volume       string
+	DeliveryFrom string
+	DeliveryTo   string
+}
+
+// ImportOrdersCommand represents a bulk upload of orders, typically a corporate client's
+// spreadsheet of destination addresses, volumes and delivery windows.
+//
+// Example:
+//
+//	cmd, err := NewImportOrdersCommand([]OrderImportRow{
+//	    {Line: 2, Street: "1 Main Street", Volume: "5"},
+//	    {Line: 3, Street: "2 Oak Avenue", Volume: "3",
+//	        DeliveryFrom: "2025-03-01T09:00:00Z", DeliveryTo: "2025-03-01T18:00:00Z"},
+//	})
+//	if err != nil {
+//	    return fmt.Errorf("invalid upload: %w", err)
+//	}
+//
+//	report, err := handler.Handle(ctx, cmd)
+type ImportOrdersCommand struct { //nolint:recvcheck //using for validation
+	rows []OrderImportRow
+
+	guard guard.ConstructorGuard
+}
+
+// NewImportOrdersCommand creates a command to import orders in bulk.
+// Validates that there is at least one row and no more than MaxImportedOrders;
+// the rows themselves are validated one by one when the command is handled.
+func NewImportOrdersCommand(rows []OrderImportRow) (ImportOrdersCommand, error) {
+	command := ImportOrdersCommand{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := command.setRows(rows); err != nil {
+		return ImportOrdersCommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrImportOrdersCommandIsNotConstructed if validation fails.
+func (c ImportOrdersCommand) Validate() error {
+	return c.guard.Validate(ErrImportOrdersCommandIsNotConstructed)
+}
+
+// Rows returns a copy of the uploaded rows in file order.
+func (c ImportOrdersCommand) Rows() []OrderImportRow {
+	rows := make([]OrderImportRow, len(c.rows))
+	copy(rows, c.rows)
+	return rows
+}
+
+func (c *ImportOrdersCommand) setRows(rows []OrderImportRow) error {
+	if len(rows) == 0 {
+		return ErrImportRowsAreRequired
+	}
+	if len(rows) > MaxImportedOrders {
+		return ErrTooManyImportRows
+	}
+
+	c.rows = append([]OrderImportRow(nil), rows...)
+	return nil
+}
@@ -0,0 +1,271 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tracing"
+)
+
+// DefaultImportBatchSize is how many imported orders are stored per transaction
+// when the handler is created with a non-positive batch size.
+const DefaultImportBatchSize = 50
+
+// OrderImportResult is the outcome of one uploaded row.
+// OrderID is set when the order was created; Err explains why it was not.
+type OrderImportResult struct {
+	Line        int
+	OrderID     *kernel.UUID
+	UnderReview bool
+	Err         error
+}
+
+// OrderImportReport lists the outcome of every uploaded row in file order.
+type OrderImportReport struct {
+	Results []OrderImportResult
+}
+
+// Created returns the number of orders created by the import.
+func (r OrderImportReport) Created() int {
+	created := 0
+	for _, result := range r.Results {
+		if result.OrderID != nil {
+			created++
+		}
+	}
+	return created
+}
+
+// Failed returns the number of rows that did not produce an order.
+func (r OrderImportReport) Failed() int {
+	return len(r.Results) - r.Created()
+}
+
+// ImportOrdersCommandHandler creates orders from a bulk upload.
+//
+// Every row is validated, its address resolved through the geo locator and screened by the
+// fraud checkers on its own, so a bad row never blocks the rest of the upload. A row whose
+// address cannot be resolved creates an order held for review, like a single order does. Valid orders
+// are stored in batches, one transaction per batch: if a batch fails to commit, all its rows
+// are reported as failed and the following batches are still attempted.
+//
+// Example:
+//
+//	handler := NewImportOrdersCommandHandler(uowFactory, locator, DefaultImportBatchSize)
+//	report, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    return fmt.Errorf("import failed: %w", err)
+//	}
+//	fmt.Printf("%d orders created, %d rows rejected", report.Created(), report.Failed())
+type ImportOrdersCommandHandler struct {
+	uowFactory    OrderUoWFactory
+	locator       ports.GeoLocator
+	batchSize     int
+	fraudCheckers []ports.FraudChecker
+}
+
+// NewImportOrdersCommandHandler creates a handler for bulk order imports.
+// Requires an OrderUoWFactory for transactional persistence and a GeoLocator for addresses.
+// Optional fraud checkers are consulted for every row like for single orders.
+func NewImportOrdersCommandHandler(
+	uowFactory OrderUoWFactory,
+	locator ports.GeoLocator,
+	batchSize int,
+	fraudCheckers ...ports.FraudChecker,
+) ImportOrdersCommandHandler {
+	if batchSize <= 0 {
+		batchSize = DefaultImportBatchSize
+	}
+
+	return ImportOrdersCommandHandler{
+		uowFactory:    uowFactory,
+		locator:       locator,
+		batchSize:     batchSize,
+		fraudCheckers: fraudCheckers,
+	}
+}
+
+// Handle imports the uploaded rows and reports the outcome of each of them.
+// Returns an error only if the command is invalid or the context is cancelled;
+// per-row failures are recorded in the report.
+func (h *ImportOrdersCommandHandler) Handle(ctx context.Context, cmd ImportOrdersCommand) (OrderImportReport, error) {
+	ctx, span := tracing.Start(ctx, "ImportOrdersCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return OrderImportReport{}, err
+	}
+
+	rows := cmd.Rows()
+	report := OrderImportReport{Results: make([]OrderImportResult, len(rows))}
+
+	batch := make([]int, 0, h.batchSize)
+	pending := make([]*order.Order, len(rows))
+	for i, row := range rows {
+		if err := ctx.Err(); err != nil {
+			return OrderImportReport{}, err
+		}
+
+		report.Results[i] = OrderImportResult{Line: row.Line}
+
+		prepared, err := h.prepare(ctx, row)
+		if err != nil {
+			report.Results[i].Err = err
+			continue
+		}
+
+		pending[i] = prepared
+		batch = append(batch, i)
+		if len(batch) == h.batchSize {
+			h.store(ctx, batch, pending, &report)
+			batch = batch[:0]
+		}
+	}
+
+	if len(batch) > 0 {
+		h.store(ctx, batch, pending, &report)
+	}
+
+	return report, nil
+}
+
+// prepare validates a row and builds the order it describes, located and screened for fraud.
+func (h *ImportOrdersCommandHandler) prepare(ctx context.Context, row OrderImportRow) (*order.Order, error) {
+	street, volume, window, err := parseOrderImportRow(row)
+	if err != nil {
+		return nil, err
+	}
+
+	id := kernel.NewUUID()
+	assessment, err := screenOrder(ctx, h.fraudCheckers, ports.OrderFraudCheck{
+		OrderID: id,
+		Street:  street,
+		Volume:  volume,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if assessment.Verdict == ports.FraudVerdictReject {
+		return nil, fmt.Errorf("%w: %s", ErrOrderIsRejectedAsFraud, assessment.Reason)
+	}
+
+	location, unresolved, err := locateOrder(ctx, h.locator, street)
+	if err != nil {
+		return nil, err
+	}
+
+	prepared, err := order.NewOrder(id, location, volume)
+	if err != nil {
+		return nil, err
+	}
+
+	if window != nil {
+		if err = prepared.ScheduleDelivery(*window); err != nil {
+			return nil, err
+		}
+	}
+
+	if assessment.Verdict == ports.FraudVerdictReview {
+		if err = prepared.HoldForReview(assessment.Reason); err != nil {
+			return nil, err
+		}
+	}
+
+	if unresolved {
+		if err = holdUnresolvedAddress(prepared); err != nil {
+			return nil, err
+		}
+	}
+
+	return prepared, nil
+}
+
+// store adds the orders of one batch in a single transaction and records the outcome of its rows.
+func (h *ImportOrdersCommandHandler) store(
+	ctx context.Context,
+	batch []int,
+	pending []*order.Order,
+	report *OrderImportReport,
+) {
+	err := h.storeBatch(ctx, batch, pending)
+	for _, i := range batch {
+		if err != nil {
+			report.Results[i].Err = err
+			continue
+		}
+
+		id := pending[i].ID()
+		report.Results[i].OrderID = &id
+		report.Results[i].UnderReview = pending[i].IsUnderReview()
+	}
+}
+
+func (h *ImportOrdersCommandHandler) storeBatch(ctx context.Context, batch []int, pending []*order.Order) error {
+	uow := h.uowFactory.Create()
+	return uow.Do(ctx, func(ctx context.Context) error {
+		orderRepo := uow.OrderRepository()
+		for _, i := range batch {
+			if err := orderRepo.Add(ctx, pending[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// parseOrderImportRow validates the text values of an uploaded row.
+func parseOrderImportRow(row OrderImportRow) (string, int, *order.DeliveryWindow, error) {
+	street := strings.TrimSpace(row.Street)
+	if street == "" {
+		return "", 0, nil, ErrStreetIsRequired
+	}
+
+	volume, err := strconv.Atoi(strings.TrimSpace(row.Volume))
+	if err != nil {
+		return "", 0, nil, errs.NewValueIsInvalidErrorWithCause("volume", err)
+	}
+
+	from, to := strings.TrimSpace(row.DeliveryFrom), strings.TrimSpace(row.DeliveryTo)
+	if from == "" && to == "" {
+		return street, volume, nil, nil
+	}
+
+	window, err := parseDeliveryWindow(from, to)
+	if err != nil {
+		return "", 0, nil, err
+	}
+
+	return street, volume, &window, nil
+}
+
+func parseDeliveryWindow(from string, to string) (order.DeliveryWindow, error) {
+	var fromTime, toTime time.Time
+	var fromErr, toErr error
+	if from != "" {
+		fromTime, fromErr = time.Parse(time.RFC3339, from)
+		if fromErr != nil {
+			fromErr = errs.NewValueIsInvalidErrorWithCause("delivery window start", fromErr)
+		}
+	}
+	if to != "" {
+		toTime, toErr = time.Parse(time.RFC3339, to)
+		if toErr != nil {
+			toErr = errs.NewValueIsInvalidErrorWithCause("delivery window end", toErr)
+		}
+	}
+
+	if err := errors.Join(fromErr, toErr); err != nil {
+		return order.DeliveryWindow{}, err
+	}
+
+	return order.NewDeliveryWindow(fromTime, toTime)
+}
@@ -0,0 +1,198 @@
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type stubGeoLocator struct {
+	err error
+}
+
+func (l stubGeoLocator) Locate(_ context.Context, _ string) (kernel.Location, error) {
+	if l.err != nil {
+		return kernel.Location{}, l.err
+	}
+	return kernel.NewLocation(3, 4)
+}
+
+func TestImportOrdersCommandHandler_Handle_ReportsEveryRow(t *testing.T) {
+	ctx := t.Context()
+	cmd, _ := commands.NewImportOrdersCommand([]commands.OrderImportRow{
+		{Line: 2, Street: "1 Main Street", Volume: "5"},
+		{Line: 3, Street: "", Volume: "5"},
+		{Line: 4, Street: "2 Oak Avenue", Volume: "many"},
+		{Line: 5, Street: "3 Elm Street", Volume: "0"},
+		{Line: 6, Street: "4 Pine Street", Volume: "2", DeliveryFrom: "2025-03-01T09:00:00Z"},
+		{Line: 7, Street: "5 Birch Street", Volume: "2",
+			DeliveryFrom: "2025-03-01T09:00:00Z", DeliveryTo: "2025-03-01T18:00:00Z"},
+	})
+
+	var added []*order.Order
+	repo := new(MockOrderRepository)
+	repo.On("Add", mock.Anything, mock.AnythingOfType("*order.Order")).
+		Run(func(args mock.Arguments) { added = append(added, args.Get(1).(*order.Order)) }).Return(nil).Twice()
+	uow := new(MockOrderUoW)
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(repo).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+	factory := new(MockOrderUoWFactory)
+	factory.On("Create").Return(uow).Once()
+
+	h := commands.NewImportOrdersCommandHandler(factory, stubGeoLocator{}, commands.DefaultImportBatchSize)
+	report, err := h.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	require.Len(t, report.Results, 6)
+	assert.Equal(t, 2, report.Created())
+	assert.Equal(t, 4, report.Failed())
+
+	assert.Equal(t, 2, report.Results[0].Line)
+	require.NotNil(t, report.Results[0].OrderID)
+	assert.Equal(t, added[0].ID(), *report.Results[0].OrderID)
+	require.ErrorIs(t, report.Results[1].Err, commands.ErrStreetIsRequired)
+	require.ErrorIs(t, report.Results[2].Err, errs.ErrValueIsInvalid)
+	require.ErrorIs(t, report.Results[3].Err, errs.ErrValueIsInvalid)
+	require.ErrorIs(t, report.Results[4].Err, errs.ErrValueIsRequired)
+
+	require.NotNil(t, report.Results[5].OrderID)
+	require.NotNil(t, added[1].DeliveryWindow())
+	assert.Equal(t, time.Date(2025, 3, 1, 18, 0, 0, 0, time.UTC), added[1].DeliveryWindow().To())
+	repo.AssertExpectations(t)
+	uow.AssertExpectations(t)
+}
+
+func TestImportOrdersCommandHandler_Handle_StoresInBatches(t *testing.T) {
+	ctx := t.Context()
+	cmd, _ := commands.NewImportOrdersCommand([]commands.OrderImportRow{
+		{Line: 2, Street: "1 Main Street", Volume: "1"},
+		{Line: 3, Street: "2 Main Street", Volume: "1"},
+		{Line: 4, Street: "3 Main Street", Volume: "1"},
+	})
+
+	repo := new(MockOrderRepository)
+	repo.On("Add", mock.Anything, mock.AnythingOfType("*order.Order")).Return(nil).Times(3)
+
+	failing := new(MockOrderUoW)
+	failing.On("Begin", ctx).Return(nil).Once()
+	failing.On("OrderRepository").Return(repo).Once()
+	failing.On("Commit", ctx).Return(errors.New("commit failed")).Once()
+	failing.On("Rollback", ctx).Return(nil).Once()
+	passing := new(MockOrderUoW)
+	passing.On("Begin", ctx).Return(nil).Once()
+	passing.On("OrderRepository").Return(repo).Once()
+	passing.On("Commit", ctx).Return(nil).Once()
+
+	factory := new(MockOrderUoWFactory)
+	factory.On("Create").Return(failing).Once()
+	factory.On("Create").Return(passing).Once()
+
+	h := commands.NewImportOrdersCommandHandler(factory, stubGeoLocator{}, 2)
+	report, err := h.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	require.EqualError(t, report.Results[0].Err, "commit failed")
+	require.EqualError(t, report.Results[1].Err, "commit failed")
+	require.NoError(t, report.Results[2].Err)
+	require.NotNil(t, report.Results[2].OrderID)
+	assert.Equal(t, 1, report.Created())
+	failing.AssertExpectations(t)
+	passing.AssertExpectations(t)
+	factory.AssertExpectations(t)
+}
+
+func TestImportOrdersCommandHandler_Handle_FraudAndGeoFailures(t *testing.T) {
+	ctx := t.Context()
+	cmd, _ := commands.NewImportOrdersCommand([]commands.OrderImportRow{
+		{Line: 2, Street: "Blacklisted Street", Volume: "1"},
+		{Line: 3, Street: "Suspicious Street", Volume: "1"},
+	})
+
+	checker := new(MockFraudChecker)
+	checker.On("CheckOrder", ctx, mock.MatchedBy(func(check ports.OrderFraudCheck) bool {
+		return check.Street == "Blacklisted Street"
+	})).Return(ports.FraudAssessment{Verdict: ports.FraudVerdictReject, Reason: "blacklisted address"}, nil).Once()
+	checker.On("CheckOrder", ctx, mock.MatchedBy(func(check ports.OrderFraudCheck) bool {
+		return check.Street == "Suspicious Street"
+	})).Return(ports.FraudAssessment{Verdict: ports.FraudVerdictReview, Reason: "new customer"}, nil).Once()
+
+	t.Run("should hold reviewed rows and refuse rejected ones", func(t *testing.T) {
+		repo := new(MockOrderRepository)
+		repo.On("Add", mock.Anything, mock.AnythingOfType("*order.Order")).Return(nil).Once()
+		uow := new(MockOrderUoW)
+		uow.On("Begin", ctx).Return(nil).Once()
+		uow.On("OrderRepository").Return(repo).Once()
+		uow.On("Commit", ctx).Return(nil).Once()
+		factory := new(MockOrderUoWFactory)
+		factory.On("Create").Return(uow).Once()
+
+		h := commands.NewImportOrdersCommandHandler(factory, stubGeoLocator{}, 0, checker)
+		report, err := h.Handle(ctx, cmd)
+
+		require.NoError(t, err)
+		require.ErrorIs(t, report.Results[0].Err, commands.ErrOrderIsRejectedAsFraud)
+		require.NotNil(t, report.Results[1].OrderID)
+		assert.True(t, report.Results[1].UnderReview)
+		checker.AssertExpectations(t)
+	})
+
+	t.Run("should report rows whose address lookup fails", func(t *testing.T) {
+		factory := new(MockOrderUoWFactory)
+
+		h := commands.NewImportOrdersCommandHandler(factory, stubGeoLocator{err: errors.New("geo unavailable")}, 0)
+		report, err := h.Handle(ctx, cmd)
+
+		require.NoError(t, err)
+		assert.Zero(t, report.Created())
+		require.EqualError(t, report.Results[0].Err, "geo unavailable")
+		factory.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("should hold rows whose address is unresolved", func(t *testing.T) {
+		reviewing := new(MockFraudChecker)
+		reviewing.On("CheckOrder", ctx, mock.Anything).
+			Return(ports.FraudAssessment{Verdict: ports.FraudVerdictReview, Reason: "new customer"}, nil).Once()
+		var added []*order.Order
+		repo := new(MockOrderRepository)
+		repo.On("Add", mock.Anything, mock.AnythingOfType("*order.Order")).
+			Run(func(args mock.Arguments) { added = append(added, args.Get(1).(*order.Order)) }).Return(nil).Once()
+		uow := new(MockOrderUoW)
+		uow.On("Begin", ctx).Return(nil).Once()
+		uow.On("OrderRepository").Return(repo).Once()
+		uow.On("Commit", ctx).Return(nil).Once()
+		factory := new(MockOrderUoWFactory)
+		factory.On("Create").Return(uow).Once()
+
+		locator := stubGeoLocator{err: fmt.Errorf("%w: geo unavailable", ports.ErrAddressIsUnresolved)}
+		single, _ := commands.NewImportOrdersCommand([]commands.OrderImportRow{{Line: 2, Street: "Unknown Street", Volume: "1"}})
+		h := commands.NewImportOrdersCommandHandler(factory, locator, 0, reviewing)
+		report, err := h.Handle(ctx, single)
+
+		require.NoError(t, err)
+		require.NotNil(t, report.Results[0].OrderID)
+		assert.True(t, report.Results[0].UnderReview)
+		require.Len(t, added, 1)
+		assert.Equal(t, "new customer; address could not be resolved", added[0].ReviewReason())
+	})
+}
+
+func TestImportOrdersCommandHandler_Handle_ValidationError(t *testing.T) {
+	h := commands.NewImportOrdersCommandHandler(new(MockOrderUoWFactory), stubGeoLocator{}, 0)
+
+	_, err := h.Handle(t.Context(), commands.ImportOrdersCommand{})
+
+	require.ErrorIs(t, err, commands.ErrImportOrdersCommandIsNotConstructed)
+}
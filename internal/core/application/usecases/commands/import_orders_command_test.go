@@ -0,0 +1,52 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewImportOrdersCommand_ValidInput(t *testing.T) {
+	rows := []commands.OrderImportRow{
+		{Line: 2, Street: "1 Main Street", Volume: "5"},
+		{Line: 3, Street: "2 Oak Avenue", Volume: "3"},
+	}
+
+	cmd, err := commands.NewImportOrdersCommand(rows)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, rows, cmd.Rows())
+}
+
+func TestNewImportOrdersCommand_NoRows(t *testing.T) {
+	_, err := commands.NewImportOrdersCommand(nil)
+
+	require.ErrorIs(t, err, commands.ErrImportRowsAreRequired)
+}
+
+func TestNewImportOrdersCommand_TooManyRows(t *testing.T) {
+	rows := make([]commands.OrderImportRow, commands.MaxImportedOrders+1)
+
+	_, err := commands.NewImportOrdersCommand(rows)
+
+	require.ErrorIs(t, err, commands.ErrTooManyImportRows)
+}
+
+func TestImportOrdersCommand_Rows_ReturnsCopy(t *testing.T) {
+	cmd, _ := commands.NewImportOrdersCommand([]commands.OrderImportRow{{Line: 2, Street: "1 Main Street", Volume: "5"}})
+
+	rows := cmd.Rows()
+	rows[0].Street = "changed"
+
+	assert.Equal(t, "1 Main Street", cmd.Rows()[0].Street)
+}
+
+func TestImportOrdersCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.ImportOrdersCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrImportOrdersCommandIsNotConstructed)
+}
@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"errors"
+	"strings"
+
+	"delivery/internal/core/domain/model/blob"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrIssueBlobUploadCommandIsNotConstructed = errors.New(
+		"IssueBlobUploadCommand must be created via NewIssueBlobUploadCommand constructor",
+	)
+)
+
+// IssueBlobUploadCommand represents a client asking to upload a file, such as the photo proving
+// a delivery or a scan of a courier's document. The client names what the file is for, who owns
+// it and the exact content type and size of the file; the upload URL only accepts that file.
+//
+// Example:
+//
+//	cmd, err := NewIssueBlobUploadCommand(blob.DeliveryPhoto, orderID, "image/jpeg", 2_400_000)
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewIssueBlobUploadCommandHandler(uploads, storage)
+//	ticket, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    return fmt.Errorf("failed to issue upload: %w", err)
+//	}
+type IssueBlobUploadCommand struct { //nolint:recvcheck //using for validation
+	purpose     blob.Purpose
+	ownerID     kernel.UUID
+	contentType string
+	size        int64
+
+	guard guard.ConstructorGuard
+}
+
+// NewIssueBlobUploadCommand creates a command to upload a file of the content type and size
+// for the purpose. Whether the purpose accepts the content type and size is checked by the
+// handler; the constructor only rejects values that are missing or malformed.
+func NewIssueBlobUploadCommand(
+	purpose blob.Purpose,
+	ownerID kernel.UUID,
+	contentType string,
+	size int64,
+) (IssueBlobUploadCommand, error) {
+	contentType = strings.TrimSpace(contentType)
+
+	var fields errs.ValidationErrors
+	fields.Add("purpose", purpose.Validate())
+	fields.Add("ownerId", ownerID.Validate())
+	if contentType == "" {
+		fields.Add("contentType", errs.NewValueIsRequiredError("contentType"))
+	}
+	if size <= 0 {
+		fields.Add("size", errs.NewValueIsOutOfRangeError("size", size, 1, purpose.MaxSize()))
+	}
+	if err := fields.Err(); err != nil {
+		return IssueBlobUploadCommand{}, err
+	}
+
+	return IssueBlobUploadCommand{
+		purpose:     purpose,
+		ownerID:     ownerID,
+		contentType: contentType,
+		size:        size,
+		guard:       guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrIssueBlobUploadCommandIsNotConstructed if validation fails.
+func (c IssueBlobUploadCommand) Validate() error {
+	return c.guard.Validate(ErrIssueBlobUploadCommandIsNotConstructed)
+}
+
+// Purpose returns what the file is for.
+func (c IssueBlobUploadCommand) Purpose() blob.Purpose {
+	return c.purpose
+}
+
+// OwnerID returns the order of a delivery photo or the courier of a courier document.
+func (c IssueBlobUploadCommand) OwnerID() kernel.UUID {
+	return c.ownerID
+}
+
+// ContentType returns the media type of the file.
+func (c IssueBlobUploadCommand) ContentType() string {
+	return c.contentType
+}
+
+// Size returns the size of the file in bytes.
+func (c IssueBlobUploadCommand) Size() int64 {
+	return c.size
+}
@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/blob"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tracing"
+)
+
+// UploadURLValidity is how long a client may use an issued upload URL.
+const UploadURLValidity = 15 * time.Minute
+
+// BlobUploadTicket is an issued upload together with the signed request the client uploads the file with.
+type BlobUploadTicket struct {
+	Upload *blob.Upload
+	Signed ports.SignedUpload
+}
+
+// IssueBlobUploadCommandHandler allows clients to upload files directly to the blob storage.
+//
+// The upload is stored before its URL is signed, so every file that can reach the storage is
+// known to the cleanup: an upload no feature attaches is removed with its file once orphaned.
+//
+// Example:
+//
+//	handler := NewIssueBlobUploadCommandHandler(uploads, storage)
+//	cmd, _ := NewIssueBlobUploadCommand(blob.CourierDocument, courierID, "application/pdf", 180_000)
+//	ticket, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    log.Printf("Failed to issue upload: %v", err)
+//	}
+type IssueBlobUploadCommandHandler struct {
+	uploads ports.BlobUploadRepository
+	storage ports.BlobStorage
+}
+
+// NewIssueBlobUploadCommandHandler creates a handler for uploads to the given storage.
+func NewIssueBlobUploadCommandHandler(
+	uploads ports.BlobUploadRepository,
+	storage ports.BlobStorage,
+) IssueBlobUploadCommandHandler {
+	return IssueBlobUploadCommandHandler{
+		uploads: uploads,
+		storage: storage,
+	}
+}
+
+// Handle issues the upload and signs its URL for UploadURLValidity.
+// Returns a validation error wrapping blob.ErrContentTypeIsNotAccepted or errs.ErrValueIsOutOfRange
+// if the purpose does not accept the file.
+func (h *IssueBlobUploadCommandHandler) Handle(
+	ctx context.Context,
+	cmd IssueBlobUploadCommand,
+) (BlobUploadTicket, error) {
+	ctx, span := tracing.Start(ctx, "IssueBlobUploadCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return BlobUploadTicket{}, err
+	}
+
+	now := time.Now().UTC()
+	upload, err := blob.NewUpload(kernel.NewUUID(), cmd.Purpose(), cmd.OwnerID(), cmd.ContentType(), cmd.Size(), now)
+	if err != nil {
+		return BlobUploadTicket{}, err
+	}
+
+	if err = h.uploads.Add(ctx, upload); err != nil {
+		return BlobUploadTicket{}, err
+	}
+
+	signed, err := h.storage.SignUpload(ctx, upload.Key(), upload.ContentType(), upload.Size(),
+		now.Add(UploadURLValidity))
+	if err != nil {
+		return BlobUploadTicket{}, err
+	}
+
+	return BlobUploadTicket{Upload: upload, Signed: signed}, nil
+}
@@ -0,0 +1,148 @@
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/blob"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockBlobUploadRepository is a mock for ports.BlobUploadRepository.
+type MockBlobUploadRepository struct {
+	mock.Mock
+}
+
+func (m *MockBlobUploadRepository) Add(ctx context.Context, upload *blob.Upload) error {
+	args := m.Called(ctx, upload)
+	return args.Error(0)
+}
+
+func (m *MockBlobUploadRepository) Get(ctx context.Context, id kernel.UUID) (*blob.Upload, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*blob.Upload), args.Error(1)
+}
+
+func (m *MockBlobUploadRepository) Update(ctx context.Context, upload *blob.Upload) error {
+	args := m.Called(ctx, upload)
+	return args.Error(0)
+}
+
+func (m *MockBlobUploadRepository) GetOrphaned(
+	ctx context.Context,
+	issuedBefore time.Time,
+	limit int,
+) ([]*blob.Upload, error) {
+	args := m.Called(ctx, issuedBefore, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*blob.Upload), args.Error(1)
+}
+
+func (m *MockBlobUploadRepository) Delete(ctx context.Context, id kernel.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// MockBlobStorage is a mock for ports.BlobStorage.
+type MockBlobStorage struct {
+	mock.Mock
+}
+
+func (m *MockBlobStorage) SignUpload(
+	ctx context.Context,
+	key string,
+	contentType string,
+	size int64,
+	expiresAt time.Time,
+) (ports.SignedUpload, error) {
+	args := m.Called(ctx, key, contentType, size, expiresAt)
+	return args.Get(0).(ports.SignedUpload), args.Error(1)
+}
+
+func (m *MockBlobStorage) Delete(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func TestIssueBlobUploadCommandHandler_Handle_StoresUploadAndSignsURL(t *testing.T) {
+	ctx := t.Context()
+	uploads := new(MockBlobUploadRepository)
+	storage := new(MockBlobStorage)
+	orderID := kernel.NewUUID()
+	signed := ports.SignedUpload{URL: "https://blobs.example.com/upload", Method: http.MethodPut}
+
+	var stored *blob.Upload
+	uploads.On("Add", ctx, mock.AnythingOfType("*blob.Upload")).
+		Run(func(args mock.Arguments) { stored = args.Get(1).(*blob.Upload) }).
+		Return(nil).Once()
+	storage.On("SignUpload", ctx, mock.MatchedBy(func(key string) bool {
+		return stored != nil && key == stored.Key()
+	}), "image/png", int64(2048), mock.MatchedBy(func(expiresAt time.Time) bool {
+		return expiresAt.Equal(stored.IssuedAt().Add(commands.UploadURLValidity))
+	})).Return(signed, nil).Once()
+
+	cmd, err := commands.NewIssueBlobUploadCommand(blob.DeliveryPhoto, orderID, "image/png", 2048)
+	require.NoError(t, err)
+
+	handler := commands.NewIssueBlobUploadCommandHandler(uploads, storage)
+	ticket, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Same(t, stored, ticket.Upload)
+	assert.Equal(t, signed, ticket.Signed)
+	assert.True(t, ticket.Upload.OwnerID().IsEqual(orderID))
+	uploads.AssertExpectations(t)
+	storage.AssertExpectations(t)
+}
+
+func TestIssueBlobUploadCommandHandler_Handle_RefusesFileThePurposeDoesNotAccept(t *testing.T) {
+	uploads := new(MockBlobUploadRepository)
+	storage := new(MockBlobStorage)
+	cmd, err := commands.NewIssueBlobUploadCommand(blob.DeliveryPhoto, kernel.NewUUID(), "application/pdf", 2048)
+	require.NoError(t, err)
+
+	handler := commands.NewIssueBlobUploadCommandHandler(uploads, storage)
+	_, err = handler.Handle(t.Context(), cmd)
+
+	require.ErrorIs(t, err, blob.ErrContentTypeIsNotAccepted)
+	uploads.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	storage.AssertNotCalled(t, "SignUpload", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestIssueBlobUploadCommandHandler_Handle_RepositoryError(t *testing.T) {
+	ctx := t.Context()
+	uploads := new(MockBlobUploadRepository)
+	storage := new(MockBlobStorage)
+	failure := errors.New("connection lost")
+	uploads.On("Add", ctx, mock.Anything).Return(failure).Once()
+	cmd, err := commands.NewIssueBlobUploadCommand(blob.CourierDocument, kernel.NewUUID(), "application/pdf", 2048)
+	require.NoError(t, err)
+
+	handler := commands.NewIssueBlobUploadCommandHandler(uploads, storage)
+	_, err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, failure)
+	storage.AssertNotCalled(t, "SignUpload", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestIssueBlobUploadCommandHandler_Handle_InvalidCommand(t *testing.T) {
+	handler := commands.NewIssueBlobUploadCommandHandler(new(MockBlobUploadRepository), new(MockBlobStorage))
+
+	_, err := handler.Handle(t.Context(), commands.IssueBlobUploadCommand{})
+
+	require.ErrorIs(t, err, commands.ErrIssueBlobUploadCommandIsNotConstructed)
+}
@@ -0,0 +1,41 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/blob"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIssueBlobUploadCommand_ValidInput(t *testing.T) {
+	orderID := kernel.NewUUID()
+
+	cmd, err := commands.NewIssueBlobUploadCommand(blob.DeliveryPhoto, orderID, " image/jpeg ", 2048)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, blob.DeliveryPhoto, cmd.Purpose())
+	assert.True(t, cmd.OwnerID().IsEqual(orderID))
+	assert.Equal(t, "image/jpeg", cmd.ContentType())
+	assert.Equal(t, int64(2048), cmd.Size())
+}
+
+func TestNewIssueBlobUploadCommand_InvalidInput(t *testing.T) {
+	_, err := commands.NewIssueBlobUploadCommand(blob.UnknownPurpose, kernel.UUID{}, "", 0)
+
+	require.ErrorIs(t, err, errs.ErrValidationFailed)
+	var validation *errs.ValidationErrors
+	require.ErrorAs(t, err, &validation)
+	assert.Len(t, validation.Fields, 4)
+}
+
+func TestIssueBlobUploadCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.IssueBlobUploadCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrIssueBlobUploadCommandIsNotConstructed)
+}
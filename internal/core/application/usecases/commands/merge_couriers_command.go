@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrMergeCouriersCommandIsNotConstructed = errors.New(
+		"MergeCouriersCommand must be created via NewMergeCouriersCommand constructor",
+	)
+)
+
+// MergeCouriersCommand represents merging a duplicate courier record, for example one created twice
+// during onboarding, into the record that stays. A dry run reports what the merge would move
+// without changing anything.
+//
+// Example:
+//
+//	cmd, err := NewMergeCouriersCommand(courierID, duplicateID, true)
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewMergeCouriersCommandHandler(uowFactory)
+//	merge, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    return fmt.Errorf("failed to merge couriers: %w", err)
+//	}
+type MergeCouriersCommand struct { //nolint:recvcheck //using for validation
+	courierID   kernel.UUID
+	duplicateID kernel.UUID
+	dryRun      bool
+
+	guard guard.ConstructorGuard
+}
+
+// NewMergeCouriersCommand creates a command to merge the duplicate into the courier.
+// Returns an error if an ID is invalid.
+func NewMergeCouriersCommand(courierID, duplicateID kernel.UUID, dryRun bool) (MergeCouriersCommand, error) {
+	if err := errs.JoinFields(
+		errs.Field("courierId", courierID.Validate()),
+		errs.Field("duplicateId", duplicateID.Validate()),
+	); err != nil {
+		return MergeCouriersCommand{}, err
+	}
+
+	return MergeCouriersCommand{
+		courierID:   courierID,
+		duplicateID: duplicateID,
+		dryRun:      dryRun,
+		guard:       guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrMergeCouriersCommandIsNotConstructed if validation fails.
+func (c MergeCouriersCommand) Validate() error {
+	return c.guard.Validate(ErrMergeCouriersCommandIsNotConstructed)
+}
+
+// CourierID returns the ID of the courier record that stays.
+func (c MergeCouriersCommand) CourierID() kernel.UUID {
+	return c.courierID
+}
+
+// DuplicateID returns the ID of the duplicate record that is merged and deleted.
+func (c MergeCouriersCommand) DuplicateID() kernel.UUID {
+	return c.duplicateID
+}
+
+// DryRun reports whether the merge is only previewed.
+func (c MergeCouriersCommand) DryRun() bool {
+	return c.dryRun
+}
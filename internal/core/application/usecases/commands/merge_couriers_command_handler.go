@@ -0,0 +1,104 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tracing"
+)
+
+// errMergeIsDryRun rolls back the transaction of a dry run merge once it is planned.
+var errMergeIsDryRun = errors.New("merge is a dry run")
+
+// CourierMerge is the outcome of merging a duplicate courier record.
+type CourierMerge struct {
+	// Courier is the record that stays, with the storage places of the duplicate
+	Courier *courier.Courier
+
+	// Records counts what was moved from the duplicate
+	Records ports.MergedCourierRecords
+
+	// DryRun is set when the merge was rolled back after it was planned
+	DryRun bool
+}
+
+// MergeCouriersCommandHandler merges duplicate courier records.
+// The courier, the moved records and the deletion of the duplicate are written in one transaction,
+// so a failed merge leaves both records as they were. A dry run performs the merge and rolls it back,
+// like a data fix dry run, so the preview counts exactly what a real merge would move.
+//
+// Example:
+//
+//	handler := NewMergeCouriersCommandHandler(uowFactory)
+//	cmd, _ := NewMergeCouriersCommand(courierID, duplicateID, false)
+//	merge, err := handler.Handle(ctx, cmd)
+//	if errors.Is(err, courier.ErrDuplicateCourierCarriesOrders) {
+//	    // The duplicate has to deliver or hand over its orders first
+//	}
+type MergeCouriersCommandHandler struct {
+	uowFactory MergeUoWFactory
+}
+
+// NewMergeCouriersCommandHandler creates a new handler for courier merges.
+// Requires a MergeUoWFactory for transactional operations.
+func NewMergeCouriersCommandHandler(uowFactory MergeUoWFactory) MergeCouriersCommandHandler {
+	return MergeCouriersCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the MergeCouriersCommand within a transaction.
+// Returns errs.ErrObjectNotFound if a courier does not exist, the errors of
+// courier.Courier.MergeDuplicate if the merge breaks a rule, and errs.ErrConcurrencyConflict
+// if a record changed while it was merged.
+func (h *MergeCouriersCommandHandler) Handle(ctx context.Context, cmd MergeCouriersCommand) (CourierMerge, error) {
+	ctx, span := tracing.Start(ctx, "MergeCouriersCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return CourierMerge{}, err
+	}
+
+	var merge CourierMerge
+	uow := h.uowFactory.Create()
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		survivor, err := courierRepo.Get(ctx, cmd.CourierID())
+		if err != nil {
+			return err
+		}
+
+		duplicate, err := courierRepo.Get(ctx, cmd.DuplicateID())
+		if err != nil {
+			return err
+		}
+
+		if err = survivor.MergeDuplicate(duplicate, time.Now().UTC()); err != nil {
+			return err
+		}
+
+		records, err := uow.CourierMergeRepository().Merge(ctx, duplicate, survivor.ID())
+		if err != nil {
+			return err
+		}
+
+		if err = courierRepo.Update(ctx, survivor); err != nil {
+			return err
+		}
+
+		merge = CourierMerge{Courier: survivor, Records: records, DryRun: cmd.DryRun()}
+		if cmd.DryRun() {
+			// Failing the transaction rolls the dry run back
+			return errMergeIsDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errMergeIsDryRun) {
+		return CourierMerge{}, err
+	}
+
+	return merge, nil
+}
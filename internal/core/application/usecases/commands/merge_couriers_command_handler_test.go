@@ -0,0 +1,204 @@
+package commands_test
+
+import (
+	"context"
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockCourierMergeRepository struct{ mock.Mock }
+
+func (m *MockCourierMergeRepository) Merge(
+	ctx context.Context,
+	duplicate *courier.Courier,
+	survivorID kernel.UUID,
+) (ports.MergedCourierRecords, error) {
+	args := m.Called(ctx, duplicate, survivorID)
+	return args.Get(0).(ports.MergedCourierRecords), args.Error(1)
+}
+
+type MockMergeUoW struct{ mock.Mock }
+
+func (m *MockMergeUoW) Begin(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockMergeUoW) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return doInTransaction(ctx, m, fn)
+}
+
+func (m *MockMergeUoW) Commit(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockMergeUoW) Rollback(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockMergeUoW) CourierRepository() ports.CourierRepository {
+	args := m.Called()
+	return args.Get(0).(ports.CourierRepository)
+}
+
+func (m *MockMergeUoW) CourierMergeRepository() ports.CourierMergeRepository {
+	args := m.Called()
+	return args.Get(0).(ports.CourierMergeRepository)
+}
+
+type MockMergeUoWFactory struct{ mock.Mock }
+
+func (m *MockMergeUoWFactory) Create() commands.MergeUoW {
+	args := m.Called()
+	return args.Get(0).(commands.MergeUoW)
+}
+
+// createDuplicateCouriers returns two offline records of the same courier.
+func createDuplicateCouriers(t *testing.T) (*courier.Courier, *courier.Courier) {
+	t.Helper()
+	location, err := kernel.NewLocation(3, 3)
+	require.NoError(t, err)
+	survivor, err := courier.NewCourier(kernel.NewUUID(), "Alice", 2, location)
+	require.NoError(t, err)
+	duplicate, err := courier.NewCourier(kernel.NewUUID(), "Alice", 2, location)
+	require.NoError(t, err)
+	return survivor, duplicate
+}
+
+func TestMergeCouriersCommandHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name   string
+		dryRun bool
+	}{
+		{name: "merge", dryRun: false},
+		{name: "dry run", dryRun: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			survivor, duplicate := createDuplicateCouriers(t)
+			records := ports.MergedCourierRecords{Orders: 3, StoragePlaces: 1, Earnings: 6}
+
+			courierRepo := new(MoveCourierRepo)
+			mergeRepo := new(MockCourierMergeRepository)
+			uow := new(MockMergeUoW)
+			factory := new(MockMergeUoWFactory)
+
+			calls := []*mock.Call{
+				factory.On("Create").Return(uow).Once(),
+				uow.On("Begin", ctx).Return(nil).Once(),
+				uow.On("CourierRepository").Return(courierRepo).Once(),
+				courierRepo.On("Get", ctx, survivor.ID()).Return(survivor, nil).Once(),
+				courierRepo.On("Get", ctx, duplicate.ID()).Return(duplicate, nil).Once(),
+				uow.On("CourierMergeRepository").Return(mergeRepo).Once(),
+				mergeRepo.On("Merge", ctx, duplicate, survivor.ID()).Return(records, nil).Once(),
+				courierRepo.On("Update", ctx, survivor).Return(nil).Once(),
+			}
+			if tt.dryRun {
+				calls = append(calls, uow.On("Rollback", ctx).Return(nil).Once())
+			} else {
+				calls = append(calls, uow.On("Commit", ctx).Return(nil).Once())
+			}
+			mock.InOrder(calls...)
+
+			cmd, err := commands.NewMergeCouriersCommand(survivor.ID(), duplicate.ID(), tt.dryRun)
+			require.NoError(t, err)
+
+			handler := commands.NewMergeCouriersCommandHandler(factory)
+			merge, err := handler.Handle(ctx, cmd)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.dryRun, merge.DryRun)
+			assert.Equal(t, records, merge.Records)
+			assert.Same(t, survivor, merge.Courier)
+			assert.Len(t, survivor.StoragePlaces(), 2)
+			courierRepo.AssertExpectations(t)
+			mergeRepo.AssertExpectations(t)
+			uow.AssertExpectations(t)
+			if tt.dryRun {
+				uow.AssertNotCalled(t, "Commit", mock.Anything)
+			}
+		})
+	}
+}
+
+func TestMergeCouriersCommandHandler_Handle_DuplicateCarriesOrders(t *testing.T) {
+	ctx := t.Context()
+	survivor, duplicate := createDuplicateCouriers(t)
+	orderAggregate, err := order.NewOrder(kernel.NewUUID(), duplicate.Location(), 5)
+	require.NoError(t, err)
+	require.NoError(t, duplicate.TakeOrder(orderAggregate))
+
+	courierRepo := new(MoveCourierRepo)
+	uow := new(MockMergeUoW)
+	factory := new(MockMergeUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("CourierRepository").Return(courierRepo).Once()
+	courierRepo.On("Get", ctx, survivor.ID()).Return(survivor, nil).Once()
+	courierRepo.On("Get", ctx, duplicate.ID()).Return(duplicate, nil).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewMergeCouriersCommand(survivor.ID(), duplicate.ID(), false)
+	require.NoError(t, err)
+
+	handler := commands.NewMergeCouriersCommandHandler(factory)
+	_, err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, courier.ErrDuplicateCourierCarriesOrders)
+	uow.AssertNotCalled(t, "CourierMergeRepository")
+	courierRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	uow.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+func TestMergeCouriersCommandHandler_Handle_DuplicateChanged(t *testing.T) {
+	ctx := t.Context()
+	survivor, duplicate := createDuplicateCouriers(t)
+	conflict := errs.NewConcurrencyConflictError("courier", duplicate.ID().String(), duplicate.Version())
+
+	courierRepo := new(MoveCourierRepo)
+	mergeRepo := new(MockCourierMergeRepository)
+	uow := new(MockMergeUoW)
+	factory := new(MockMergeUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("CourierRepository").Return(courierRepo).Once()
+	courierRepo.On("Get", ctx, survivor.ID()).Return(survivor, nil).Once()
+	courierRepo.On("Get", ctx, duplicate.ID()).Return(duplicate, nil).Once()
+	uow.On("CourierMergeRepository").Return(mergeRepo).Once()
+	mergeRepo.On("Merge", ctx, duplicate, survivor.ID()).Return(ports.MergedCourierRecords{}, conflict).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewMergeCouriersCommand(survivor.ID(), duplicate.ID(), false)
+	require.NoError(t, err)
+
+	handler := commands.NewMergeCouriersCommandHandler(factory)
+	_, err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, errs.ErrConcurrencyConflict)
+	courierRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	uow.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+func TestNewMergeCouriersCommand_InvalidInput(t *testing.T) {
+	_, err := commands.NewMergeCouriersCommand(kernel.NewUUID(), kernel.UUID{}, false)
+
+	require.ErrorIs(t, err, errs.ErrValidationFailed)
+	var zero commands.MergeCouriersCommand
+	require.ErrorIs(t, zero.Validate(), commands.ErrMergeCouriersCommandIsNotConstructed)
+}
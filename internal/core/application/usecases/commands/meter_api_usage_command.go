@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"delivery/internal/core/domain/model/usage"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrMeterAPIUsageCommandIsNotConstructed = errors.New(
+		"MeterAPIUsageCommand must be created via NewMeterAPIUsageCommand constructor",
+	)
+)
+
+// MeterAPIUsageCommand represents usage of the API by a partner client that is accounted
+// against its monthly quota.
+//
+// Example:
+//
+//	cmd, err := NewMeterAPIUsageCommand(client, usage.OrdersCreated, 1)
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewMeterAPIUsageCommandHandler(ledger, quota)
+//	if err := handler.Handle(ctx, cmd); errors.Is(err, usage.ErrQuotaIsExceeded) {
+//	    return fmt.Errorf("refused: %w", err)
+//	}
+type MeterAPIUsageCommand struct { //nolint:recvcheck //using for validation
+	client usage.Client
+	metric usage.Metric
+	amount int
+
+	guard guard.ConstructorGuard
+}
+
+// NewMeterAPIUsageCommand creates a command to account amount of the metric to the client.
+// Returns an error if the client or metric is invalid or the amount is not positive.
+func NewMeterAPIUsageCommand(client usage.Client, metric usage.Metric, amount int) (MeterAPIUsageCommand, error) {
+	var amountErr error
+	if amount <= 0 {
+		amountErr = errs.NewValueIsInvalidErrorWithCause("amount", fmt.Errorf("%d must be positive", amount))
+	}
+
+	if err := errs.JoinFields(
+		errs.Field("client", client.Validate()),
+		errs.Field("metric", metric.Validate()),
+		errs.Field("amount", amountErr),
+	); err != nil {
+		return MeterAPIUsageCommand{}, err
+	}
+
+	return MeterAPIUsageCommand{
+		client: client,
+		metric: metric,
+		amount: amount,
+		guard:  guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrMeterAPIUsageCommandIsNotConstructed if validation fails.
+func (c MeterAPIUsageCommand) Validate() error {
+	return c.guard.Validate(ErrMeterAPIUsageCommandIsNotConstructed)
+}
+
+// Client returns the client the usage is accounted to.
+func (c MeterAPIUsageCommand) Client() usage.Client {
+	return c.client
+}
+
+// Metric returns the metric that is consumed.
+func (c MeterAPIUsageCommand) Metric() usage.Metric {
+	return c.metric
+}
+
+// Amount returns how much of the metric is consumed.
+func (c MeterAPIUsageCommand) Amount() int {
+	return c.amount
+}
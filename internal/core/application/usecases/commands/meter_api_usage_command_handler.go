@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/usage"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tracing"
+)
+
+// MeterAPIUsageCommandHandler accounts API usage of partner clients and enforces their
+// monthly quota: usage that would take a metric past its limit is refused and not accounted.
+//
+// The quota is checked against the usage read before accounting, so concurrent requests of
+// one client may overshoot a limit by the number of requests in flight.
+//
+// Example:
+//
+//	quota, _ := usage.NewQuota(100_000, 5_000, 0)
+//	handler := NewMeterAPIUsageCommandHandler(ledger, quota)
+//	err := handler.Handle(ctx, cmd)
+//	var exceeded *usage.QuotaExceededError
+//	if errors.As(err, &exceeded) {
+//	    log.Printf("Quota resets at %s", exceeded.ResetsAt)
+//	}
+type MeterAPIUsageCommandHandler struct {
+	ledger ports.UsageLedger
+	quota  usage.Quota
+}
+
+// NewMeterAPIUsageCommandHandler creates a handler accounting usage in ledger within quota.
+func NewMeterAPIUsageCommandHandler(ledger ports.UsageLedger, quota usage.Quota) MeterAPIUsageCommandHandler {
+	return MeterAPIUsageCommandHandler{ledger: ledger, quota: quota}
+}
+
+// Handle accounts the usage to the current month. It returns a *usage.QuotaExceededError
+// when the client's quota does not allow it.
+func (h *MeterAPIUsageCommandHandler) Handle(ctx context.Context, cmd MeterAPIUsageCommand) error {
+	ctx, span := tracing.Start(ctx, "MeterAPIUsageCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+
+	period := usage.PeriodOf(time.Now())
+
+	if h.quota.Limit(cmd.Metric()) != 0 {
+		used, err := h.ledger.Used(ctx, cmd.Client(), period, cmd.Metric())
+		if err != nil {
+			return err
+		}
+
+		if err = h.quota.Check(cmd.Metric(), used, cmd.Amount(), period); err != nil {
+			return err
+		}
+	}
+
+	return h.ledger.Add(ctx, cmd.Client(), period, cmd.Metric(), cmd.Amount())
+}
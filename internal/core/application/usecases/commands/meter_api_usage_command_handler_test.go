@@ -0,0 +1,115 @@
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/usage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockUsageLedger is a mock for ports.UsageLedger.
+type MockUsageLedger struct {
+	mock.Mock
+}
+
+func (m *MockUsageLedger) Used(
+	ctx context.Context, client usage.Client, period usage.Period, metric usage.Metric,
+) (int, error) {
+	args := m.Called(ctx, client, period, metric)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockUsageLedger) Add(
+	ctx context.Context, client usage.Client, period usage.Period, metric usage.Metric, amount int,
+) error {
+	args := m.Called(ctx, client, period, metric, amount)
+	return args.Error(0)
+}
+
+func meterAPIUsage(t *testing.T, metric usage.Metric, amount int) commands.MeterAPIUsageCommand {
+	t.Helper()
+	client, err := usage.NewClient("partner-secret")
+	require.NoError(t, err)
+	cmd, err := commands.NewMeterAPIUsageCommand(client, metric, amount)
+	require.NoError(t, err)
+	return cmd
+}
+
+func TestMeterAPIUsageCommandHandler_Handle_AccountsUsageWithinQuota(t *testing.T) {
+	ctx := t.Context()
+	ledger := new(MockUsageLedger)
+	quota, _ := usage.NewQuota(0, 10, 0)
+	cmd := meterAPIUsage(t, usage.OrdersCreated, 3)
+
+	ledger.On("Used", ctx, cmd.Client(), mock.Anything, usage.OrdersCreated).Return(7, nil).Once()
+	ledger.On("Add", ctx, cmd.Client(), mock.Anything, usage.OrdersCreated, 3).Return(nil).Once()
+
+	handler := commands.NewMeterAPIUsageCommandHandler(ledger, quota)
+	require.NoError(t, handler.Handle(ctx, cmd))
+
+	ledger.AssertExpectations(t)
+}
+
+func TestMeterAPIUsageCommandHandler_Handle_RefusesUsagePastQuota(t *testing.T) {
+	ctx := t.Context()
+	ledger := new(MockUsageLedger)
+	quota, _ := usage.NewQuota(0, 10, 0)
+	cmd := meterAPIUsage(t, usage.OrdersCreated, 3)
+
+	ledger.On("Used", ctx, cmd.Client(), mock.Anything, usage.OrdersCreated).Return(8, nil).Once()
+
+	handler := commands.NewMeterAPIUsageCommandHandler(ledger, quota)
+	err := handler.Handle(ctx, cmd)
+
+	var exceeded *usage.QuotaExceededError
+	require.ErrorAs(t, err, &exceeded)
+	assert.Equal(t, 10, exceeded.Limit)
+	assert.Equal(t, 8, exceeded.Used)
+	ledger.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestMeterAPIUsageCommandHandler_Handle_UnlimitedMetricSkipsCheck(t *testing.T) {
+	ctx := t.Context()
+	ledger := new(MockUsageLedger)
+	quota, _ := usage.NewQuota(0, 10, 0)
+	cmd := meterAPIUsage(t, usage.Requests, 1)
+
+	ledger.On("Add", ctx, cmd.Client(), mock.Anything, usage.Requests, 1).Return(nil).Once()
+
+	handler := commands.NewMeterAPIUsageCommandHandler(ledger, quota)
+	require.NoError(t, handler.Handle(ctx, cmd))
+
+	ledger.AssertExpectations(t)
+	ledger.AssertNotCalled(t, "Used", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestMeterAPIUsageCommandHandler_Handle_LedgerError(t *testing.T) {
+	ctx := t.Context()
+	ledger := new(MockUsageLedger)
+	quota, _ := usage.NewQuota(0, 10, 0)
+	cmd := meterAPIUsage(t, usage.OrdersCreated, 1)
+	failure := errors.New("connection lost")
+
+	ledger.On("Used", ctx, cmd.Client(), mock.Anything, usage.OrdersCreated).Return(0, failure).Once()
+
+	handler := commands.NewMeterAPIUsageCommandHandler(ledger, quota)
+
+	require.ErrorIs(t, handler.Handle(ctx, cmd), failure)
+}
+
+func TestMeterAPIUsageCommandHandler_Handle_InvalidCommand(t *testing.T) {
+	ledger := new(MockUsageLedger)
+	quota, _ := usage.NewQuota(0, 0, 0)
+
+	handler := commands.NewMeterAPIUsageCommandHandler(ledger, quota)
+
+	require.ErrorIs(t, handler.Handle(t.Context(), commands.MeterAPIUsageCommand{}),
+		commands.ErrMeterAPIUsageCommandIsNotConstructed)
+	ledger.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,52 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/usage"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMeterAPIUsageCommand_ValidInput(t *testing.T) {
+	client, _ := usage.NewClient("partner-secret")
+
+	cmd, err := commands.NewMeterAPIUsageCommand(client, usage.OrdersCreated, 3)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, client, cmd.Client())
+	assert.Equal(t, usage.OrdersCreated, cmd.Metric())
+	assert.Equal(t, 3, cmd.Amount())
+}
+
+func TestNewMeterAPIUsageCommand_InvalidInput(t *testing.T) {
+	client, _ := usage.NewClient("partner-secret")
+
+	t.Run("client", func(t *testing.T) {
+		_, err := commands.NewMeterAPIUsageCommand(usage.Client{}, usage.Requests, 1)
+
+		require.ErrorIs(t, err, usage.ErrClientIsNotConstructed)
+	})
+
+	t.Run("metric", func(t *testing.T) {
+		_, err := commands.NewMeterAPIUsageCommand(client, usage.UnknownMetric, 1)
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("amount", func(t *testing.T) {
+		_, err := commands.NewMeterAPIUsageCommand(client, usage.Requests, 0)
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+}
+
+func TestMeterAPIUsageCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.MeterAPIUsageCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrMeterAPIUsageCommandIsNotConstructed)
+}
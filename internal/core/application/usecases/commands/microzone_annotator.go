@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"context"
+	"strconv"
+
+	"delivery/internal/core/domain/model/microzone"
+	"delivery/internal/core/ports"
+)
+
+const (
+	// MicrozoneAnnotation is the annotation holding the ID of the microzone the order is delivered to.
+	MicrozoneAnnotation = "microzone.id"
+
+	// MicrozoneCourierAnnotation is the annotation telling whether the assigned courier was
+	// inside the order's microzone, "true" or "false".
+	MicrozoneCourierAnnotation = "microzone.courier_inside"
+)
+
+// MicrozoneAnnotator is a DispatchPostProcessor that annotates every assignment of an order
+// delivered to a dense-demand microzone with the microzone and whether the courier was already
+// inside it, so assignment preferences and reports can tell local from inbound couriers.
+// Orders outside every microzone are left unannotated.
+type MicrozoneAnnotator struct {
+	microzones ports.MicrozoneRepository
+}
+
+// NewMicrozoneAnnotator creates an annotator reading the microzones from the repository.
+func NewMicrozoneAnnotator(microzones ports.MicrozoneRepository) MicrozoneAnnotator {
+	return MicrozoneAnnotator{microzones: microzones}
+}
+
+// ProcessAssignment annotates the assignment with the order's microzone; it never vetoes.
+func (a MicrozoneAnnotator) ProcessAssignment(ctx context.Context, assignment *DispatchAssignment) error {
+	microzones, err := a.microzones.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	located, ok, err := microzone.Locate(microzones, assignment.Order.Location())
+	if err != nil || !ok {
+		return err
+	}
+
+	inside, err := located.Contains(assignment.Courier.Location())
+	if err != nil {
+		return err
+	}
+
+	assignment.Annotate(MicrozoneAnnotation, located.ID().String())
+	assignment.Annotate(MicrozoneCourierAnnotation, strconv.FormatBool(inside))
+	return nil
+}
@@ -0,0 +1,68 @@
+package commands_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/microzone"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMicrozoneAnnotator_ProcessAssignment(t *testing.T) {
+	from, _ := kernel.NewLocation(2, 2)
+	to, _ := kernel.NewLocation(4, 4)
+	bounds, _ := kernel.NewZone(from, to)
+	centroid, _ := kernel.NewLocation(3, 3)
+	downtown, err := microzone.NewMicrozone(kernel.NewUUID(), bounds, centroid, 120, time.Now())
+	require.NoError(t, err)
+
+	t.Run("should annotate orders inside a microzone", func(t *testing.T) {
+		repository := new(MockMicrozoneRepository)
+		repository.On("GetAll", t.Context()).Return([]*microzone.Microzone{downtown}, nil)
+
+		local := createCourierAt(t, 2, 4)
+		inbound := createCourierAt(t, 9, 9)
+		for _, c := range []struct {
+			courierInside string
+			assignment    *commands.DispatchAssignment
+		}{
+			{"true", &commands.DispatchAssignment{Courier: local, Order: createAssignedOrderAt(t, local, 3, 3)}},
+			{"false", &commands.DispatchAssignment{Courier: inbound, Order: createAssignedOrderAt(t, inbound, 3, 3)}},
+		} {
+			require.NoError(t, commands.NewMicrozoneAnnotator(repository).ProcessAssignment(t.Context(), c.assignment))
+
+			assert.Equal(t, map[string]string{
+				commands.MicrozoneAnnotation:        downtown.ID().String(),
+				commands.MicrozoneCourierAnnotation: c.courierInside,
+			}, c.assignment.Annotations())
+		}
+	})
+
+	t.Run("should leave orders outside every microzone unannotated", func(t *testing.T) {
+		repository := new(MockMicrozoneRepository)
+		repository.On("GetAll", t.Context()).Return([]*microzone.Microzone{downtown}, nil)
+		c := createCourierAt(t, 3, 3)
+		assignment := &commands.DispatchAssignment{Courier: c, Order: createAssignedOrderAt(t, c, 8, 8)}
+
+		require.NoError(t, commands.NewMicrozoneAnnotator(repository).ProcessAssignment(t.Context(), assignment))
+
+		assert.Empty(t, assignment.Annotations())
+	})
+
+	t.Run("should fail when microzones cannot be read", func(t *testing.T) {
+		repository := new(MockMicrozoneRepository)
+		failure := errors.New("connection lost")
+		repository.On("GetAll", t.Context()).Return(nil, failure)
+		c := createCourierAt(t, 3, 3)
+		assignment := &commands.DispatchAssignment{Courier: c, Order: createAssignedOrderAt(t, c, 3, 3)}
+
+		err := commands.NewMicrozoneAnnotator(repository).ProcessAssignment(t.Context(), assignment)
+
+		require.ErrorIs(t, err, failure)
+	})
+}
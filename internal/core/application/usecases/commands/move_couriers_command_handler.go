@@ -3,7 +3,12 @@ package commands
 import (
 	"context"
 	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tracing"
+	"time"
 )
 
 // MoveCouriersCommandHandler orchestrates the movement of all active couriers.
@@ -22,7 +27,18 @@ import (
 //
 //	// This would typically be called periodically by a scheduler
 type MoveCouriersCommandHandler struct {
-	uowFactory UoWFactory
+	uowFactory  UoWFactory
+	pickupSlots bool
+
+	// routeDeviation is nil unless couriers are checked against their routes
+	routeDeviation *order.RouteDeviationPolicy
+	observer       RouteDeviationObserver
+}
+
+// RouteDeviationObserver is notified whenever an order is flagged because its courier strayed
+// from the route, so the dispatcher can reach the courier.
+type RouteDeviationObserver interface {
+	RouteDeviated(ctx context.Context, o *order.Order, deviation order.RouteDeviation)
 }
 
 // NewMoveCouriersCommandHandler creates a handler for courier movement operations.
@@ -33,72 +49,211 @@ func NewMoveCouriersCommandHandler(uowFactory UoWFactory) MoveCouriersCommandHan
 	}
 }
 
+// WithPickupSlots returns a copy of the handler that holds couriers until the pickup slot
+// of their order starts, since the warehouse does not hand the order out before.
+func (h MoveCouriersCommandHandler) WithPickupSlots() MoveCouriersCommandHandler {
+	h.pickupSlots = true
+	return h
+}
+
+// WithRouteDeviation returns a copy of the handler that compares where every courier is with the
+// route to its order before moving it, flags orders whose courier strayed from the route according
+// to the policy and reports them to the observer once the movement is committed.
+func (h MoveCouriersCommandHandler) WithRouteDeviation(
+	policy order.RouteDeviationPolicy,
+	observer RouteDeviationObserver,
+) MoveCouriersCommandHandler {
+	h.routeDeviation = &policy
+	h.observer = observer
+	return h
+}
+
 // Handle processes the courier movement command.
 // Retrieves all orders in "assigned" status, moves each courier towards its destination,
-// and completes orders when couriers arrive. All updates occur within a single transaction.
+// and completes orders when couriers arrive. Couriers of orders with a route head to the pickup
+// first and call at every waypoint before the destination. All updates occur within a single transaction.
+// With pickup slots, couriers whose order is booked into a slot that has not started yet stay put.
+// Couriers on a break stay put with their orders until the break ends.
+// Couriers of insured orders stay put until the pickup is confirmed, at the pickup of the route if
+// the order has one, and wait on arrival until the
+// delivery is confirmed with ConfirmOrderHandoverCommandHandler, which completes the order.
+// With route deviation, couriers that stay put are not checked, as they are not on their way yet.
+// The couriers of a tick are loaded together once, so a courier carrying several orders moves
+// towards each of them in turn.
+// A tick that loses a concurrency conflict to another job is moved again.
 func (h *MoveCouriersCommandHandler) Handle(ctx context.Context, cmd MoveCouriersCommand) error {
+	ctx, span := tracing.Start(ctx, "MoveCouriersCommand")
+	defer span.End()
+
 	if err := cmd.Validate(); err != nil {
 		return err
 	}
 
+	return retryOnConflict(ctx, h.move)
+}
+
+// move makes one tick of courier movement within one transaction.
+func (h *MoveCouriersCommandHandler) move(ctx context.Context) error {
+	var deviated []deviatedOrder
 	uow := h.uowFactory.Create()
-	if err := uow.Begin(ctx); err != nil {
-		return err
-	}
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		ordersRepo := uow.OrderRepository()
 
-	defer func() {
-		_ = uow.Rollback(ctx)
-	}()
+		orders, err := ordersRepo.GetAllInAssignedStatus(ctx)
+		if err != nil {
+			return err
+		}
 
-	courierRepo := uow.CourierRepository()
-	ordersRepo := uow.OrderRepository()
+		waiting := make(map[kernel.UUID]bool)
+		if h.pickupSlots {
+			if waiting, err = h.ordersAwaitingPickup(ctx, uow); err != nil {
+				return err
+			}
+		}
+
+		moving := make([]*order.Order, 0, len(orders))
+		for _, o := range orders {
+			if waiting[o.ID()] || awaitsPickupConfirmation(o) {
+				continue
+			}
+			moving = append(moving, o)
+		}
+
+		couriers, err := h.couriersOf(ctx, courierRepo, moving)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		for _, order := range moving {
+			courier := couriers[*order.Courier()]
+			if courier.IsOnBreak() {
+				continue
+			}
 
-	orders, err := ordersRepo.GetAllInAssignedStatus(ctx)
+			if h.routeDeviation != nil {
+				deviation, flagged, trackErr := order.TrackRoute(courier.Location(), *h.routeDeviation, now)
+				if trackErr != nil {
+					return trackErr
+				}
+				if flagged {
+					deviated = append(deviated, deviatedOrder{order: order, deviation: deviation})
+				}
+			}
+
+			if err = h.moveOrderCourier(order, courier); err != nil {
+				return err
+			}
+
+			if err = ordersRepo.Update(ctx, order); err != nil {
+				return err
+			}
+
+			if err = courierRepo.Update(ctx, courier); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
 	if err != nil {
 		return err
 	}
 
-	for _, order := range orders {
-		courier, courierErr := courierRepo.Get(ctx, *order.Courier())
-		if courierErr != nil {
-			return courierErr
-		}
+	for _, d := range deviated {
+		h.observer.RouteDeviated(ctx, d.order, d.deviation)
+	}
 
-		if err = h.moveOrderCourier(order, courier); err != nil {
-			return err
-		}
+	return nil
+}
 
-		if err = ordersRepo.Update(ctx, order); err != nil {
-			return err
+// awaitsPickupConfirmation reports whether the courier of an insured order stays put until it
+// confirms the pickup: where it is, or at the pickup once it reached it if the order has a route.
+func awaitsPickupConfirmation(o *order.Order) bool {
+	return o.IsInsuranceRequired() && o.PickupConfirmedAt() == nil && (o.Route() == nil || o.ReachedStops() > 0)
+}
+
+// deviatedOrder is an order flagged during a tick, reported once the tick is committed.
+type deviatedOrder struct {
+	order     *order.Order
+	deviation order.RouteDeviation
+}
+
+// ordersAwaitingPickup returns the orders booked into pickup slots that have not started yet.
+func (h *MoveCouriersCommandHandler) ordersAwaitingPickup(ctx context.Context, uow UoW) (map[kernel.UUID]bool, error) {
+	slots, err := uow.PickupSlotRepository().GetNotStarted(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	waiting := make(map[kernel.UUID]bool)
+	for _, slot := range slots {
+		for _, orderID := range slot.Bookings() {
+			waiting[orderID] = true
 		}
+	}
+	return waiting, nil
+}
 
-		if err = courierRepo.Update(ctx, courier); err != nil {
-			return err
+// couriersOf loads the couriers delivering the orders with a single query and caches them for
+// the tick, keyed by ID. Returns an ObjectNotFoundError if the courier of an order does not exist.
+func (h *MoveCouriersCommandHandler) couriersOf(
+	ctx context.Context,
+	courierRepo ports.CourierRepository,
+	orders []*order.Order,
+) (map[kernel.UUID]*courier.Courier, error) {
+	couriers := make(map[kernel.UUID]*courier.Courier)
+	if len(orders) == 0 {
+		return couriers, nil
+	}
+
+	ids := make([]kernel.UUID, 0, len(orders))
+	requested := make(map[kernel.UUID]bool)
+	for _, o := range orders {
+		if id := *o.Courier(); !requested[id] {
+			requested[id] = true
+			ids = append(ids, id)
 		}
 	}
 
-	if err = uow.Commit(ctx); err != nil {
-		return err
+	loaded, err := courierRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range loaded {
+		couriers[c.ID()] = c
 	}
 
-	return nil
+	for _, id := range ids {
+		if _, ok := couriers[id]; !ok {
+			return nil, errs.NewObjectNotFoundError("courier", id.String())
+		}
+	}
+	return couriers, nil
 }
 
 // moveOrderCourier handles the movement logic for a single courier-order pair.
-// Moves the courier towards the order location and completes both order and courier
-// states when the destination is reached.
+// Moves the courier towards the next stop of the order, which is the order location unless
+// the order has a route, and completes both order and courier states when the courier
+// arrives at the order location after calling at every stop of the route.
 func (h *MoveCouriersCommandHandler) moveOrderCourier(
 	order *order.Order,
 	courier *courier.Courier,
 ) error {
-	if err := courier.Move(order.Location()); err != nil {
+	if err := courier.Move(order.NextStop()); err != nil {
 		return err
 	}
 
-	if equal, err := courier.Location().IsEqual(order.Location()); err != nil || !equal {
+	if arrived, err := order.ReachStop(courier.Location()); err != nil || !arrived {
 		return err
 	}
 
+	// The courier hands an insured order over only once the delivery is confirmed
+	if order.IsInsuranceRequired() {
+		return nil
+	}
+
 	if err := order.Complete(); err != nil {
 		return err
 	}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"delivery/internal/core/application/usecases/commands"
 	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/pickup"
 	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -36,11 +39,53 @@ func (m *MoveCourierRepo) Get(ctx context.Context, id kernel.UUID) (*courier.Cou
 	return args.Get(0).(*courier.Courier), args.Error(1)
 }
 
+func (m *MoveCourierRepo) GetByExternalID(
+	ctx context.Context,
+	externalID courier.ExternalID,
+) (*courier.Courier, error) {
+	args := m.Called(ctx, externalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*courier.Courier), args.Error(1)
+}
+
+func (m *MoveCourierRepo) GetByIDs(ctx context.Context, ids []kernel.UUID) ([]*courier.Courier, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*courier.Courier), args.Error(1)
+}
+
 func (m *MoveCourierRepo) GetAllFree(ctx context.Context) ([]*courier.Courier, error) {
 	args := m.Called(ctx)
 	return args.Get(0).([]*courier.Courier), args.Error(1)
 }
 
+func (m *MoveCourierRepo) GetAllWithFreeStorage(ctx context.Context) ([]*courier.Courier, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*courier.Courier), args.Error(1)
+}
+
+func (m *MoveCourierRepo) GetAllOnShift(ctx context.Context) ([]*courier.Courier, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*courier.Courier), args.Error(1)
+}
+
+func (m *MoveCourierRepo) GetAllOffShift(ctx context.Context) ([]*courier.Courier, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*courier.Courier), args.Error(1)
+}
+
+func (m *MoveCourierRepo) GetAllSubstitutedBy(
+	ctx context.Context,
+	substituteID kernel.UUID,
+) ([]*courier.Courier, error) {
+	args := m.Called(ctx, substituteID)
+	return args.Get(0).([]*courier.Courier), args.Error(1)
+}
+
 type MoveOrderRepo struct{ mock.Mock }
 
 func (m *MoveOrderRepo) Add(ctx context.Context, o *order.Order) error {
@@ -61,7 +106,23 @@ func (m *MoveOrderRepo) Get(ctx context.Context, id kernel.UUID) (*order.Order,
 	return args.Get(0).(*order.Order), args.Error(1)
 }
 
-func (m *MoveOrderRepo) GetFirstInCreatedStatus(ctx context.Context) (*order.Order, error) {
+func (m *MoveOrderRepo) GetByTrackingToken(ctx context.Context, token order.TrackingToken) (*order.Order, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
+func (m *MoveOrderRepo) GetByBasketID(ctx context.Context, basketID kernel.UUID) (*order.Order, error) {
+	args := m.Called(ctx, basketID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
+func (m *MoveOrderRepo) GetNextDispatchable(ctx context.Context) (*order.Order, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -69,6 +130,30 @@ func (m *MoveOrderRepo) GetFirstInCreatedStatus(ctx context.Context) (*order.Ord
 	return args.Get(0).(*order.Order), args.Error(1)
 }
 
+func (m *MoveOrderRepo) GetDispatchable(ctx context.Context, limit int) ([]*order.Order, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.Order), args.Error(1)
+}
+
+func (m *MoveOrderRepo) GetIDsMatching(ctx context.Context, filter ports.OrderFilter, limit int) ([]kernel.UUID, error) {
+	args := m.Called(ctx, filter, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]kernel.UUID), args.Error(1)
+}
+
+func (m *MoveOrderRepo) GetAllAwaitingBatch(ctx context.Context) ([]*order.Order, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.Order), args.Error(1)
+}
+
 func (m *MoveOrderRepo) GetAllInAssignedStatus(ctx context.Context) ([]*order.Order, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
@@ -77,6 +162,27 @@ func (m *MoveOrderRepo) GetAllInAssignedStatus(ctx context.Context) ([]*order.Or
 	return args.Get(0).([]*order.Order), args.Error(1)
 }
 
+func (m *MoveOrderRepo) ArchiveCompleted(ctx context.Context, completedBefore time.Time, limit int) (int, error) {
+	args := m.Called(ctx, completedBefore, limit)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MoveOrderRepo) GetArchived(ctx context.Context, id kernel.UUID) (*order.Order, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Order), args.Error(1)
+}
+
+func (m *MoveOrderRepo) GetAllArchivedCompletedBetween(ctx context.Context, from, to time.Time) ([]*order.Order, error) {
+	args := m.Called(ctx, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.Order), args.Error(1)
+}
+
 type MoveUnitOfWork struct{ mock.Mock }
 
 func (m *MoveUnitOfWork) Begin(ctx context.Context) error {
@@ -84,6 +190,10 @@ func (m *MoveUnitOfWork) Begin(ctx context.Context) error {
 	return args.Error(0)
 }
 
+func (m *MoveUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return doInTransaction(ctx, m, fn)
+}
+
 func (m *MoveUnitOfWork) Commit(ctx context.Context) error {
 	args := m.Called(ctx)
 	return args.Error(0)
@@ -104,6 +214,11 @@ func (m *MoveUnitOfWork) OrderRepository() ports.OrderRepository {
 	return args.Get(0).(ports.OrderRepository)
 }
 
+func (m *MoveUnitOfWork) PickupSlotRepository() ports.PickupSlotRepository {
+	args := m.Called()
+	return args.Get(0).(ports.PickupSlotRepository)
+}
+
 type MoveUoWFactory struct{ mock.Mock }
 
 func (m *MoveUoWFactory) Create() commands.UoW {
@@ -165,11 +280,10 @@ func TestMoveCouriersCommandHandler_Handle_Success(t *testing.T) {
 		uow.On("CourierRepository").Return(courierRepo).Once(),
 		uow.On("OrderRepository").Return(orderRepo).Once(),
 		orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{testOrder}, nil).Once(),
-		courierRepo.On("Get", ctx, courierID).Return(testCourier, nil).Once(),
+		courierRepo.On("GetByIDs", ctx, []kernel.UUID{courierID}).Return([]*courier.Courier{testCourier}, nil).Once(),
 		orderRepo.On("Update", ctx, testOrder).Return(nil).Once(),
 		courierRepo.On("Update", ctx, testCourier).Return(nil).Once(),
 		uow.On("Commit", ctx).Return(nil).Once(),
-		uow.On("Rollback", ctx).Return(nil).Once(),
 	)
 
 	// Act
@@ -267,7 +381,7 @@ func TestMoveCouriersCommandHandler_Handle_GetCourierError(t *testing.T) {
 		uow.On("CourierRepository").Return(courierRepo).Once(),
 		uow.On("OrderRepository").Return(orderRepo).Once(),
 		orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{testOrder}, nil).Once(),
-		courierRepo.On("Get", ctx, courierID).Return(nil, errors.New("courier not found")).Once(),
+		courierRepo.On("GetByIDs", ctx, []kernel.UUID{courierID}).Return(nil, errors.New("courier not found")).Once(),
 		uow.On("Rollback", ctx).Return(nil).Once(),
 	)
 
@@ -304,7 +418,7 @@ func TestMoveCouriersCommandHandler_Handle_CourierCompleteOrderError(t *testing.
 		uow.On("CourierRepository").Return(courierRepo).Once(),
 		uow.On("OrderRepository").Return(orderRepo).Once(),
 		orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{testOrder}, nil).Once(),
-		courierRepo.On("Get", ctx, courierID).Return(testCourier, nil).Once(),
+		courierRepo.On("GetByIDs", ctx, []kernel.UUID{courierID}).Return([]*courier.Courier{testCourier}, nil).Once(),
 		uow.On("Rollback", ctx).Return(nil).Once(),
 	)
 
@@ -345,7 +459,7 @@ func TestMoveCouriersCommandHandler_Handle_OrderUpdateError(t *testing.T) {
 		uow.On("CourierRepository").Return(courierRepo).Once(),
 		uow.On("OrderRepository").Return(orderRepo).Once(),
 		orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{testOrder}, nil).Once(),
-		courierRepo.On("Get", ctx, courierID).Return(testCourier, nil).Once(),
+		courierRepo.On("GetByIDs", ctx, []kernel.UUID{courierID}).Return([]*courier.Courier{testCourier}, nil).Once(),
 		orderRepo.On("Update", ctx, testOrder).Return(errors.New("order update error")).Once(),
 		uow.On("Rollback", ctx).Return(nil).Once(),
 	)
@@ -387,7 +501,7 @@ func TestMoveCouriersCommandHandler_Handle_CourierUpdateError(t *testing.T) {
 		uow.On("CourierRepository").Return(courierRepo).Once(),
 		uow.On("OrderRepository").Return(orderRepo).Once(),
 		orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{testOrder}, nil).Once(),
-		courierRepo.On("Get", ctx, courierID).Return(testCourier, nil).Once(),
+		courierRepo.On("GetByIDs", ctx, []kernel.UUID{courierID}).Return([]*courier.Courier{testCourier}, nil).Once(),
 		orderRepo.On("Update", ctx, testOrder).Return(nil).Once(),
 		courierRepo.On("Update", ctx, testCourier).Return(errors.New("courier update error")).Once(),
 		uow.On("Rollback", ctx).Return(nil).Once(),
@@ -404,6 +518,90 @@ func TestMoveCouriersCommandHandler_Handle_CourierUpdateError(t *testing.T) {
 	courierRepo.AssertExpectations(t)
 }
 
+func TestMoveCouriersCommandHandler_Handle_ConcurrencyConflictIsRetried(t *testing.T) {
+	ctx := t.Context()
+	cmd := commands.NewMoveCouriersCommand()
+
+	courierID := kernel.NewUUID()
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	courierLocation, _ := kernel.NewLocation(3, 3)
+	staleOrder, staleCourier, err := createTestOrderWithCourier(courierID, orderLocation, courierLocation)
+	require.NoError(t, err)
+	freshOrder, freshCourier, err := createTestOrderWithCourier(courierID, orderLocation, courierLocation)
+	require.NoError(t, err)
+
+	courierRepo := new(MoveCourierRepo)
+	orderRepo := new(MoveOrderRepo)
+	uow := new(MoveUnitOfWork)
+	factory := new(MoveUoWFactory)
+
+	conflict := errs.NewConcurrencyConflictError("courier", courierID.String(), 0)
+	mock.InOrder(
+		factory.On("Create").Return(uow).Once(),
+		uow.On("Begin", ctx).Return(nil).Once(),
+		uow.On("CourierRepository").Return(courierRepo).Once(),
+		uow.On("OrderRepository").Return(orderRepo).Once(),
+		orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{staleOrder}, nil).Once(),
+		courierRepo.On("GetByIDs", ctx, []kernel.UUID{courierID}).Return([]*courier.Courier{staleCourier}, nil).Once(),
+		orderRepo.On("Update", ctx, staleOrder).Return(nil).Once(),
+		courierRepo.On("Update", ctx, staleCourier).Return(conflict).Once(),
+		uow.On("Rollback", ctx).Return(nil).Once(),
+		// The second run loads the aggregates the winning writer committed
+		factory.On("Create").Return(uow).Once(),
+		uow.On("Begin", ctx).Return(nil).Once(),
+		uow.On("CourierRepository").Return(courierRepo).Once(),
+		uow.On("OrderRepository").Return(orderRepo).Once(),
+		orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{freshOrder}, nil).Once(),
+		courierRepo.On("GetByIDs", ctx, []kernel.UUID{courierID}).Return([]*courier.Courier{freshCourier}, nil).Once(),
+		orderRepo.On("Update", ctx, freshOrder).Return(nil).Once(),
+		courierRepo.On("Update", ctx, freshCourier).Return(nil).Once(),
+		uow.On("Commit", ctx).Return(nil).Once(),
+	)
+
+	handler := commands.NewMoveCouriersCommandHandler(factory)
+	err = handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	factory.AssertExpectations(t)
+	uow.AssertExpectations(t)
+	orderRepo.AssertExpectations(t)
+	courierRepo.AssertExpectations(t)
+}
+
+func TestMoveCouriersCommandHandler_Handle_PersistentConflictIsReported(t *testing.T) {
+	ctx := t.Context()
+	cmd := commands.NewMoveCouriersCommand()
+
+	courierID := kernel.NewUUID()
+	orderLocation, _ := kernel.NewLocation(9, 9)
+	courierLocation, _ := kernel.NewLocation(1, 1)
+	testOrder, testCourier, err := createTestOrderWithCourier(courierID, orderLocation, courierLocation)
+	require.NoError(t, err)
+
+	courierRepo := new(MoveCourierRepo)
+	orderRepo := new(MoveOrderRepo)
+	uow := new(MoveUnitOfWork)
+	factory := new(MoveUoWFactory)
+
+	factory.On("Create").Return(uow)
+	uow.On("Begin", ctx).Return(nil)
+	uow.On("CourierRepository").Return(courierRepo)
+	uow.On("OrderRepository").Return(orderRepo)
+	orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{testOrder}, nil)
+	courierRepo.On("GetByIDs", ctx, []kernel.UUID{courierID}).Return([]*courier.Courier{testCourier}, nil)
+	orderRepo.On("Update", ctx, mock.Anything).Return(
+		errs.NewConcurrencyConflictError("order", "stale", 0))
+	uow.On("Rollback", ctx).Return(nil)
+
+	handler := commands.NewMoveCouriersCommandHandler(factory)
+	err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, errs.ErrConcurrencyConflict)
+	// The first run and three retries
+	factory.AssertNumberOfCalls(t, "Create", 4)
+	uow.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
 func TestMoveCouriersCommandHandler_Handle_CommitError(t *testing.T) {
 	ctx := t.Context()
 	cmd := commands.NewMoveCouriersCommand()
@@ -430,7 +628,7 @@ func TestMoveCouriersCommandHandler_Handle_CommitError(t *testing.T) {
 		uow.On("CourierRepository").Return(courierRepo).Once(),
 		uow.On("OrderRepository").Return(orderRepo).Once(),
 		orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{testOrder}, nil).Once(),
-		courierRepo.On("Get", ctx, courierID).Return(testCourier, nil).Once(),
+		courierRepo.On("GetByIDs", ctx, []kernel.UUID{courierID}).Return([]*courier.Courier{testCourier}, nil).Once(),
 		orderRepo.On("Update", ctx, testOrder).Return(nil).Once(),
 		courierRepo.On("Update", ctx, testCourier).Return(nil).Once(),
 		uow.On("Commit", ctx).Return(errors.New("commit error")).Once(),
@@ -464,7 +662,6 @@ func TestMoveCouriersCommandHandler_Handle_NoOrdersAssigned(t *testing.T) {
 		uow.On("OrderRepository").Return(orderRepo).Once(),
 		orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{}, nil).Once(),
 		uow.On("Commit", ctx).Return(nil).Once(),
-		uow.On("Rollback", ctx).Return(nil).Once(),
 	)
 
 	handler := commands.NewMoveCouriersCommandHandler(factory)
@@ -510,16 +707,15 @@ func TestMoveCouriersCommandHandler_Handle_MultipleOrders(t *testing.T) {
 		uow.On("CourierRepository").Return(courierRepo).Once(),
 		uow.On("OrderRepository").Return(orderRepo).Once(),
 		orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{testOrder1, testOrder2}, nil).Once(),
+		courierRepo.On("GetByIDs", ctx, []kernel.UUID{courierID1, courierID2}).
+			Return([]*courier.Courier{testCourier1, testCourier2}, nil).Once(),
 		// First order processing
-		courierRepo.On("Get", ctx, courierID1).Return(testCourier1, nil).Once(),
 		orderRepo.On("Update", ctx, testOrder1).Return(nil).Once(),
 		courierRepo.On("Update", ctx, testCourier1).Return(nil).Once(),
 		// Second order processing
-		courierRepo.On("Get", ctx, courierID2).Return(testCourier2, nil).Once(),
 		orderRepo.On("Update", ctx, testOrder2).Return(nil).Once(),
 		courierRepo.On("Update", ctx, testCourier2).Return(nil).Once(),
 		uow.On("Commit", ctx).Return(nil).Once(),
-		uow.On("Rollback", ctx).Return(nil).Once(),
 	)
 
 	handler := commands.NewMoveCouriersCommandHandler(factory)
@@ -532,6 +728,90 @@ func TestMoveCouriersCommandHandler_Handle_MultipleOrders(t *testing.T) {
 	courierRepo.AssertExpectations(t)
 }
 
+func TestMoveCouriersCommandHandler_Handle_PickupSlots(t *testing.T) {
+	ctx := t.Context()
+	cmd := commands.NewMoveCouriersCommand()
+
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	courierLocation, _ := kernel.NewLocation(3, 3)
+	waitingOrder, waitingCourier, err := createTestOrderWithCourier(kernel.NewUUID(), orderLocation, courierLocation)
+	require.NoError(t, err)
+	movingOrder, movingCourier, err := createTestOrderWithCourier(kernel.NewUUID(), orderLocation, courierLocation)
+	require.NoError(t, err)
+
+	// The slot of the waiting order opens in an hour, the courier stays at the warehouse until then
+	startsAt := time.Now().Add(time.Hour)
+	slot, err := pickup.RestoreSlot(kernel.NewUUID(), startsAt, startsAt.Add(30*time.Minute), 2,
+		[]kernel.UUID{waitingOrder.ID()})
+	require.NoError(t, err)
+
+	courierRepo := new(MoveCourierRepo)
+	orderRepo := new(MoveOrderRepo)
+	slotRepo := new(MockPickupSlotRepository)
+	uow := new(MoveUnitOfWork)
+	factory := new(MoveUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("CourierRepository").Return(courierRepo).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	uow.On("PickupSlotRepository").Return(slotRepo).Once()
+	orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{waitingOrder, movingOrder}, nil).Once()
+	slotRepo.On("GetNotStarted", ctx, mock.AnythingOfType("time.Time")).Return([]*pickup.Slot{slot}, nil).Once()
+	courierRepo.On("GetByIDs", ctx, []kernel.UUID{*movingOrder.Courier()}).Return([]*courier.Courier{movingCourier}, nil).Once()
+	orderRepo.On("Update", ctx, movingOrder).Return(nil).Once()
+	courierRepo.On("Update", ctx, movingCourier).Return(nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	handler := commands.NewMoveCouriersCommandHandler(factory).WithPickupSlots()
+	require.NoError(t, handler.Handle(ctx, cmd))
+
+	stayed, err := waitingCourier.Location().IsEqual(courierLocation)
+	require.NoError(t, err)
+	assert.True(t, stayed)
+	moved, err := movingCourier.Location().IsEqual(courierLocation)
+	require.NoError(t, err)
+	assert.False(t, moved)
+	courierRepo.AssertExpectations(t)
+	orderRepo.AssertExpectations(t)
+	slotRepo.AssertExpectations(t)
+}
+
+func TestMoveCouriersCommandHandler_Handle_CourierOnBreakStaysPut(t *testing.T) {
+	ctx := t.Context()
+	cmd := commands.NewMoveCouriersCommand()
+
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	courierLocation, _ := kernel.NewLocation(3, 3)
+	testOrder, testCourier, err := createTestOrderWithCourier(kernel.NewUUID(), orderLocation, courierLocation)
+	require.NoError(t, err)
+	require.NoError(t, testCourier.StartShift(time.Now(), createShiftLimit(t)))
+	require.NoError(t, testCourier.TakeOrder(testOrder))
+	require.NoError(t, testCourier.StartBreak())
+
+	courierRepo := new(MoveCourierRepo)
+	orderRepo := new(MoveOrderRepo)
+	uow := new(MoveUnitOfWork)
+	factory := new(MoveUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("CourierRepository").Return(courierRepo).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{testOrder}, nil).Once()
+	courierRepo.On("GetByIDs", ctx, []kernel.UUID{testCourier.ID()}).Return([]*courier.Courier{testCourier}, nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	handler := commands.NewMoveCouriersCommandHandler(factory)
+	require.NoError(t, handler.Handle(ctx, cmd))
+
+	stayed, err := testCourier.Location().IsEqual(courierLocation)
+	require.NoError(t, err)
+	assert.True(t, stayed)
+	orderRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	courierRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
 func TestMoveCouriersCommandHandler_Handle_CourierMovesOneStepTowardDestination(t *testing.T) {
 	ctx := t.Context()
 	cmd := commands.NewMoveCouriersCommand()
@@ -557,12 +837,11 @@ func TestMoveCouriersCommandHandler_Handle_CourierMovesOneStepTowardDestination(
 		uow.On("CourierRepository").Return(courierRepo).Once(),
 		uow.On("OrderRepository").Return(orderRepo).Once(),
 		orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{testOrder}, nil).Once(),
-		courierRepo.On("Get", ctx, courierID).Return(testCourier, nil).Once(),
+		courierRepo.On("GetByIDs", ctx, []kernel.UUID{courierID}).Return([]*courier.Courier{testCourier}, nil).Once(),
 		// Courier moves but doesn't reach destination - this is allowed and successful
 		orderRepo.On("Update", ctx, testOrder).Return(nil).Once(),
 		courierRepo.On("Update", ctx, testCourier).Return(nil).Once(),
 		uow.On("Commit", ctx).Return(nil).Once(),
-		uow.On("Rollback", ctx).Return(nil).Once(),
 	)
 
 	handler := commands.NewMoveCouriersCommandHandler(factory)
@@ -607,12 +886,11 @@ func TestMoveCouriersCommandHandler_Handle_CourierMovesPartiallyMultipleSteps(t
 		uow.On("CourierRepository").Return(courierRepo).Once(),
 		uow.On("OrderRepository").Return(orderRepo).Once(),
 		orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{testOrder}, nil).Once(),
-		courierRepo.On("Get", ctx, courierID).Return(testCourier, nil).Once(),
+		courierRepo.On("GetByIDs", ctx, []kernel.UUID{courierID}).Return([]*courier.Courier{testCourier}, nil).Once(),
 		// Courier moves but doesn't reach destination - this is allowed and successful
 		orderRepo.On("Update", ctx, testOrder).Return(nil).Once(),
 		courierRepo.On("Update", ctx, testCourier).Return(nil).Once(),
 		uow.On("Commit", ctx).Return(nil).Once(),
-		uow.On("Rollback", ctx).Return(nil).Once(),
 	)
 
 	handler := commands.NewMoveCouriersCommandHandler(factory)
@@ -656,7 +934,7 @@ func TestMoveCouriersCommandHandler_Handle_CourierMovesExactlyToDestinationButHa
 		uow.On("CourierRepository").Return(courierRepo).Once(),
 		uow.On("OrderRepository").Return(orderRepo).Once(),
 		orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{testOrder}, nil).Once(),
-		courierRepo.On("Get", ctx, courierID).Return(testCourier, nil).Once(),
+		courierRepo.On("GetByIDs", ctx, []kernel.UUID{courierID}).Return([]*courier.Courier{testCourier}, nil).Once(),
 		uow.On("Rollback", ctx).Return(nil).Once(),
 	)
 
@@ -708,16 +986,16 @@ func TestMoveCouriersCommandHandler_Handle_MultipleOrdersPartialMovement(t *test
 		uow.On("CourierRepository").Return(courierRepo).Once(),
 		uow.On("OrderRepository").Return(orderRepo).Once(),
 		orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{testOrder1, testOrder2}, nil).Once(),
+		// Both couriers are loaded at once; the result is ordered by ID, not by order
+		courierRepo.On("GetByIDs", ctx, []kernel.UUID{courierID1, courierID2}).
+			Return([]*courier.Courier{testCourier2, testCourier1}, nil).Once(),
 		// First courier - moves but doesn't reach destination (partial movement is allowed)
-		courierRepo.On("Get", ctx, courierID1).Return(testCourier1, nil).Once(),
 		orderRepo.On("Update", ctx, testOrder1).Return(nil).Once(),
 		courierRepo.On("Update", ctx, testCourier1).Return(nil).Once(),
 		// Second courier - reaches destination and completes order
-		courierRepo.On("Get", ctx, courierID2).Return(testCourier2, nil).Once(),
 		orderRepo.On("Update", ctx, testOrder2).Return(nil).Once(),
 		courierRepo.On("Update", ctx, testCourier2).Return(nil).Once(),
 		uow.On("Commit", ctx).Return(nil).Once(),
-		uow.On("Rollback", ctx).Return(nil).Once(),
 	)
 
 	handler := commands.NewMoveCouriersCommandHandler(factory)
@@ -729,3 +1007,292 @@ func TestMoveCouriersCommandHandler_Handle_MultipleOrdersPartialMovement(t *test
 	orderRepo.AssertExpectations(t)
 	courierRepo.AssertExpectations(t)
 }
+
+func TestMoveCouriersCommandHandler_Handle_InsuredOrders(t *testing.T) {
+	ctx := t.Context()
+	cmd := commands.NewMoveCouriersCommand()
+	threshold, _ := order.NewInsuranceThreshold(100_000)
+
+	newInsured := func(t *testing.T) (*order.Order, *courier.Courier) {
+		t.Helper()
+		location, _ := kernel.NewLocation(2, 2)
+		testOrder, err := order.NewOrder(kernel.NewUUID(), location, 5)
+		require.NoError(t, err)
+		require.NoError(t, testOrder.DeclareValue(250_000, threshold))
+
+		testCourier, err := courier.NewCourier(kernel.NewUUID(), "Test Courier", 2, location)
+		require.NoError(t, err)
+		testCourier.Insure()
+		require.NoError(t, testCourier.TakeOrder(testOrder))
+		require.NoError(t, testOrder.Assign(testCourier.ID()))
+		return testOrder, testCourier
+	}
+
+	// Without a confirmed pickup the courier does not leave; on arrival it waits for the delivery confirmation
+	unconfirmedOrder, _ := newInsured(t)
+	arrivedOrder, arrivedCourier := newInsured(t)
+	require.NoError(t, arrivedOrder.ConfirmPickup(time.Now()))
+
+	courierRepo := new(MoveCourierRepo)
+	orderRepo := new(MoveOrderRepo)
+	uow := new(MoveUnitOfWork)
+	factory := new(MoveUoWFactory)
+
+	mock.InOrder(
+		factory.On("Create").Return(uow).Once(),
+		uow.On("Begin", ctx).Return(nil).Once(),
+		uow.On("CourierRepository").Return(courierRepo).Once(),
+		uow.On("OrderRepository").Return(orderRepo).Once(),
+		orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{unconfirmedOrder, arrivedOrder}, nil).Once(),
+		courierRepo.On("GetByIDs", ctx, []kernel.UUID{arrivedCourier.ID()}).Return([]*courier.Courier{arrivedCourier}, nil).Once(),
+		orderRepo.On("Update", ctx, arrivedOrder).Return(nil).Once(),
+		courierRepo.On("Update", ctx, arrivedCourier).Return(nil).Once(),
+		uow.On("Commit", ctx).Return(nil).Once(),
+	)
+
+	handler := commands.NewMoveCouriersCommandHandler(factory)
+	err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, order.Assigned, arrivedOrder.Status())
+	require.NotNil(t, arrivedCourier.StoragePlaces()[0].OrderID())
+	assert.Equal(t, arrivedOrder.ID(), *arrivedCourier.StoragePlaces()[0].OrderID())
+	factory.AssertExpectations(t)
+	uow.AssertExpectations(t)
+	orderRepo.AssertExpectations(t)
+	courierRepo.AssertExpectations(t)
+}
+
+func TestMoveCouriersCommandHandler_Handle_RouteLegs(t *testing.T) {
+	ctx := t.Context()
+	cmd := commands.NewMoveCouriersCommand()
+
+	// The courier collects the order at the pickup first, although the drop-off is closer
+	courierLocation, _ := kernel.NewLocation(3, 3)
+	pickup, _ := kernel.NewLocation(1, 1)
+	dropOff, _ := kernel.NewLocation(3, 1)
+	route, _ := order.NewRoute(pickup, dropOff)
+	testOrder, err := order.NewOrderWithRoute(kernel.NewUUID(), route, 5)
+	require.NoError(t, err)
+	testCourier, err := courier.NewCourier(kernel.NewUUID(), "Test Courier", 2, courierLocation)
+	require.NoError(t, err)
+	require.NoError(t, testCourier.TakeOrder(testOrder))
+	require.NoError(t, testOrder.Assign(testCourier.ID()))
+
+	courierRepo := new(MoveCourierRepo)
+	orderRepo := new(MoveOrderRepo)
+	uow := new(MoveUnitOfWork)
+	factory := new(MoveUoWFactory)
+
+	factory.On("Create").Return(uow)
+	uow.On("Begin", ctx).Return(nil)
+	uow.On("CourierRepository").Return(courierRepo)
+	uow.On("OrderRepository").Return(orderRepo)
+	orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{testOrder}, nil)
+	courierRepo.On("GetByIDs", ctx, []kernel.UUID{testCourier.ID()}).Return([]*courier.Courier{testCourier}, nil)
+	orderRepo.On("Update", ctx, testOrder).Return(nil)
+	courierRepo.On("Update", ctx, testCourier).Return(nil)
+	uow.On("Commit", ctx).Return(nil)
+	uow.On("Rollback", ctx).Return(nil).Maybe()
+
+	handler := commands.NewMoveCouriersCommandHandler(factory)
+
+	// 3,3 -> 1,3: on the way to the pickup
+	require.NoError(t, handler.Handle(ctx, cmd))
+	assert.Equal(t, pickup, testOrder.NextStop())
+
+	// 1,3 -> 1,1: at the pickup, heading to the drop-off
+	require.NoError(t, handler.Handle(ctx, cmd))
+	assert.Equal(t, pickup, testCourier.Location())
+	assert.Equal(t, dropOff, testOrder.NextStop())
+	assert.Equal(t, order.Assigned, testOrder.Status())
+
+	// 1,1 -> 3,1: delivered
+	require.NoError(t, handler.Handle(ctx, cmd))
+	assert.Equal(t, dropOff, testCourier.Location())
+	assert.Equal(t, order.Completed, testOrder.Status())
+}
+
+// MockRouteDeviationObserver records the orders reported as deviated.
+type MockRouteDeviationObserver struct {
+	orders     []kernel.UUID
+	deviations []order.RouteDeviation
+}
+
+func (m *MockRouteDeviationObserver) RouteDeviated(_ context.Context, o *order.Order, deviation order.RouteDeviation) {
+	m.orders = append(m.orders, o.ID())
+	m.deviations = append(m.deviations, deviation)
+}
+
+func TestMoveCouriersCommandHandler_Handle_RouteDeviation(t *testing.T) {
+	ctx := t.Context()
+	cmd := commands.NewMoveCouriersCommand()
+	policy, _ := order.NewRouteDeviationPolicy(2, 2)
+	courierID := kernel.NewUUID()
+	target, _ := kernel.NewLocation(5, 5)
+	origin, _ := kernel.NewLocation(1, 1)
+	away, _ := kernel.NewLocation(9, 9)
+	testOrder, onRoute, err := createTestOrderWithCourier(courierID, target, origin)
+	require.NoError(t, err)
+
+	// The courier sets out from (1, 1) and is then seen far beyond the order on two ticks
+	offRoute := make([]*courier.Courier, 0, 2)
+	for range 2 {
+		c, courierErr := courier.NewCourier(courierID, "Test Courier", 2, away)
+		require.NoError(t, courierErr)
+		offRoute = append(offRoute, c)
+	}
+
+	courierRepo := new(MoveCourierRepo)
+	orderRepo := new(MoveOrderRepo)
+	uow := new(MoveUnitOfWork)
+	factory := new(MoveUoWFactory)
+
+	factory.On("Create").Return(uow)
+	uow.On("Begin", ctx).Return(nil)
+	uow.On("CourierRepository").Return(courierRepo)
+	uow.On("OrderRepository").Return(orderRepo)
+	orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{testOrder}, nil)
+	courierRepo.On("GetByIDs", ctx, []kernel.UUID{courierID}).Return([]*courier.Courier{onRoute}, nil).Once()
+	courierRepo.On("GetByIDs", ctx, []kernel.UUID{courierID}).Return([]*courier.Courier{offRoute[0]}, nil).Once()
+	courierRepo.On("GetByIDs", ctx, []kernel.UUID{courierID}).Return([]*courier.Courier{offRoute[1]}, nil).Once()
+	orderRepo.On("Update", ctx, testOrder).Return(nil)
+	courierRepo.On("Update", ctx, mock.Anything).Return(nil)
+	uow.On("Commit", ctx).Return(nil)
+	uow.On("Rollback", ctx).Return(nil).Maybe()
+
+	observer := &MockRouteDeviationObserver{}
+	handler := commands.NewMoveCouriersCommandHandler(factory).WithRouteDeviation(policy, observer)
+
+	require.NoError(t, handler.Handle(ctx, cmd))
+	require.NoError(t, handler.Handle(ctx, cmd))
+	assert.Empty(t, observer.orders, "a single tick off route is tolerated")
+	assert.Equal(t, 1, testOrder.RouteDeviationTicks())
+
+	require.NoError(t, handler.Handle(ctx, cmd))
+
+	assert.Equal(t, []kernel.UUID{testOrder.ID()}, observer.orders)
+	assert.Equal(t, origin, observer.deviations[0].Origin)
+	assert.Equal(t, 2, observer.deviations[0].Ticks)
+	assert.NotNil(t, testOrder.RouteDeviatedAt())
+	courierRepo.AssertExpectations(t)
+}
+
+func TestMoveCouriersCommandHandler_Handle_RouteDeviationIsNotReportedWhenCommitFails(t *testing.T) {
+	ctx := t.Context()
+	cmd := commands.NewMoveCouriersCommand()
+	policy, _ := order.NewRouteDeviationPolicy(0, 1)
+	courierID := kernel.NewUUID()
+	target, _ := kernel.NewLocation(5, 5)
+	away, _ := kernel.NewLocation(9, 9)
+	testOrder, testCourier, err := createTestOrderWithCourier(courierID, target, away)
+	require.NoError(t, err)
+
+	// The route is set out from the first observation, so the order was seen on route before
+	origin, _ := kernel.NewLocation(1, 1)
+	_, _, err = testOrder.TrackRoute(origin, policy, time.Now())
+	require.NoError(t, err)
+
+	courierRepo := new(MoveCourierRepo)
+	orderRepo := new(MoveOrderRepo)
+	uow := new(MoveUnitOfWork)
+	factory := new(MoveUoWFactory)
+	commitErr := errors.New("commit failed")
+
+	factory.On("Create").Return(uow)
+	uow.On("Begin", ctx).Return(nil)
+	uow.On("CourierRepository").Return(courierRepo)
+	uow.On("OrderRepository").Return(orderRepo)
+	orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{testOrder}, nil)
+	courierRepo.On("GetByIDs", ctx, []kernel.UUID{courierID}).Return([]*courier.Courier{testCourier}, nil)
+	orderRepo.On("Update", ctx, testOrder).Return(nil)
+	courierRepo.On("Update", ctx, testCourier).Return(nil)
+	uow.On("Commit", ctx).Return(commitErr)
+	uow.On("Rollback", ctx).Return(nil)
+
+	observer := &MockRouteDeviationObserver{}
+	handler := commands.NewMoveCouriersCommandHandler(factory).WithRouteDeviation(policy, observer)
+
+	require.ErrorIs(t, handler.Handle(ctx, cmd), commitErr)
+	assert.Empty(t, observer.orders)
+}
+
+func TestMoveCouriersCommandHandler_Handle_CourierWithSeveralOrdersIsLoadedOnce(t *testing.T) {
+	ctx := t.Context()
+	cmd := commands.NewMoveCouriersCommand()
+
+	// The courier carries two orders and heads for each of them in turn within the tick
+	courierID := kernel.NewUUID()
+	firstLocation, _ := kernel.NewLocation(9, 1)
+	secondLocation, _ := kernel.NewLocation(1, 9)
+	courierLocation, _ := kernel.NewLocation(5, 5)
+	firstOrder, testCourier, err := createTestOrderWithCourier(courierID, firstLocation, courierLocation)
+	require.NoError(t, err)
+	secondOrder, err := order.NewOrder(kernel.NewUUID(), secondLocation, 5)
+	require.NoError(t, err)
+	require.NoError(t, secondOrder.Assign(courierID))
+
+	courierRepo := new(MoveCourierRepo)
+	orderRepo := new(MoveOrderRepo)
+	uow := new(MoveUnitOfWork)
+	factory := new(MoveUoWFactory)
+
+	mock.InOrder(
+		factory.On("Create").Return(uow).Once(),
+		uow.On("Begin", ctx).Return(nil).Once(),
+		uow.On("CourierRepository").Return(courierRepo).Once(),
+		uow.On("OrderRepository").Return(orderRepo).Once(),
+		orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{firstOrder, secondOrder}, nil).Once(),
+		courierRepo.On("GetByIDs", ctx, []kernel.UUID{courierID}).Return([]*courier.Courier{testCourier}, nil).Once(),
+		orderRepo.On("Update", ctx, firstOrder).Return(nil).Once(),
+		courierRepo.On("Update", ctx, testCourier).Return(nil).Once(),
+		orderRepo.On("Update", ctx, secondOrder).Return(nil).Once(),
+		courierRepo.On("Update", ctx, testCourier).Return(nil).Once(),
+		uow.On("Commit", ctx).Return(nil).Once(),
+	)
+
+	handler := commands.NewMoveCouriersCommandHandler(factory)
+	require.NoError(t, handler.Handle(ctx, cmd))
+
+	// Two steps east towards the first order, then two steps west towards the second one
+	back, err := testCourier.Location().IsEqual(courierLocation)
+	require.NoError(t, err)
+	assert.True(t, back)
+	courierRepo.AssertNumberOfCalls(t, "GetByIDs", 1)
+	courierRepo.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	orderRepo.AssertExpectations(t)
+	uow.AssertExpectations(t)
+}
+
+func TestMoveCouriersCommandHandler_Handle_MissingCourier(t *testing.T) {
+	ctx := t.Context()
+	cmd := commands.NewMoveCouriersCommand()
+
+	courierID := kernel.NewUUID()
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	courierLocation, _ := kernel.NewLocation(3, 3)
+	testOrder, _, err := createTestOrderWithCourier(courierID, orderLocation, courierLocation)
+	require.NoError(t, err)
+
+	courierRepo := new(MoveCourierRepo)
+	orderRepo := new(MoveOrderRepo)
+	uow := new(MoveUnitOfWork)
+	factory := new(MoveUoWFactory)
+
+	mock.InOrder(
+		factory.On("Create").Return(uow).Once(),
+		uow.On("Begin", ctx).Return(nil).Once(),
+		uow.On("CourierRepository").Return(courierRepo).Once(),
+		uow.On("OrderRepository").Return(orderRepo).Once(),
+		orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{testOrder}, nil).Once(),
+		courierRepo.On("GetByIDs", ctx, []kernel.UUID{courierID}).Return([]*courier.Courier{}, nil).Once(),
+		uow.On("Rollback", ctx).Return(nil).Once(),
+	)
+
+	handler := commands.NewMoveCouriersCommandHandler(factory)
+	err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, errs.ErrObjectNotFound)
+	orderRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	uow.AssertNotCalled(t, "Commit", mock.Anything)
+}
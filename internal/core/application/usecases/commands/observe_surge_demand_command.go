@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrObserveSurgeDemandCommandIsNotConstructed = errors.New(
+		"ObserveSurgeDemandCommand must be created via NewObserveSurgeDemandCommand constructor",
+	)
+)
+
+// ObserveSurgeDemandCommand represents a request to let the current order backlog switch
+// the surge mode on or off while it is set to auto.
+//
+// Example:
+//
+//	cmd := NewObserveSurgeDemandCommand()
+//	change, switched, err := handler.Handle(ctx, cmd)
+//	if err == nil && switched {
+//	    log.Printf("surge mode active: %t", change.Toggle.Active())
+//	}
+type ObserveSurgeDemandCommand struct {
+	guard guard.ConstructorGuard
+}
+
+// NewObserveSurgeDemandCommand creates a command to observe the surge demand.
+// This is a parameterless command; the current backlog is read from storage.
+func NewObserveSurgeDemandCommand() ObserveSurgeDemandCommand {
+	return ObserveSurgeDemandCommand{guard: guard.NewConstructorGuard()}
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrObserveSurgeDemandCommandIsNotConstructed if validation fails.
+func (c ObserveSurgeDemandCommand) Validate() error {
+	return c.guard.Validate(ErrObserveSurgeDemandCommandIsNotConstructed)
+}
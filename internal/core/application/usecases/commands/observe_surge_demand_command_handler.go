@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/surge"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tracing"
+)
+
+// ObserveSurgeDemandCommandHandler lets the order backlog switch the surge mode while it is set
+// to auto. Every switch is recorded in the audit trail; when the surge mode activates, the
+// off-shift couriers are invited to start a shift for the surge bonus.
+//
+// Example:
+//
+//	handler := NewObserveSurgeDemandCommandHandler(surges, loadReader, policy, uowFactory, notifier)
+//	change, err := handler.Handle(ctx, NewObserveSurgeDemandCommand())
+//	if err == nil && change != nil {
+//	    log.Printf("surge active: %t at backlog %d", change.Toggle.Active(), change.Toggle.Backlog())
+//	}
+type ObserveSurgeDemandCommandHandler struct {
+	surges      ports.SurgeRepository
+	loadReader  ports.FleetLoadReader
+	policy      surge.Policy
+	invitations surgeInvitations
+}
+
+// NewObserveSurgeDemandCommandHandler creates a handler for automatic surge mode switches.
+// Requires the repository of the audit trail, the fleet load for the backlog, the surge policy,
+// a CourierUoWFactory to find the off-shift couriers and the notifier that reaches them.
+func NewObserveSurgeDemandCommandHandler(
+	surges ports.SurgeRepository,
+	loadReader ports.FleetLoadReader,
+	policy surge.Policy,
+	uowFactory CourierUoWFactory,
+	notifier ports.CourierNotifier,
+) ObserveSurgeDemandCommandHandler {
+	return ObserveSurgeDemandCommandHandler{
+		surges:      surges,
+		loadReader:  loadReader,
+		policy:      policy,
+		invitations: surgeInvitations{uowFactory: uowFactory, notifier: notifier},
+	}
+}
+
+// Handle evaluates the current backlog and returns the change when it switched the surge mode,
+// or nil when the mode stays as it was. A forced setting is left alone. The toggle stays recorded if the off-shift couriers
+// cannot be read afterwards.
+func (h ObserveSurgeDemandCommandHandler) Handle(
+	ctx context.Context,
+	cmd ObserveSurgeDemandCommand,
+) (*SurgeModeChange, error) {
+	ctx, span := tracing.Start(ctx, "ObserveSurgeDemandCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+
+	mode, err := h.surges.GetMode(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if mode.Setting() != surge.Auto {
+		return nil, nil //nolint:nilnil // a forced setting is left alone
+	}
+
+	load, err := h.loadReader.GetFleetLoad(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	toggle, switched, err := mode.Observe(kernel.NewUUID(), load.QueuedOrders, h.policy, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !switched {
+		return nil, nil //nolint:nilnil // the backlog keeps the mode as it was
+	}
+
+	if err = h.surges.AddToggle(ctx, toggle); err != nil {
+		return nil, err
+	}
+
+	change, err := h.invitations.send(ctx, toggle)
+	if err != nil {
+		return nil, err
+	}
+	return &change, nil
+}
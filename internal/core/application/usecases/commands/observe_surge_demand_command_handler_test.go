@@ -0,0 +1,79 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/surge"
+	"delivery/internal/core/ports"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserveSurgeDemandCommandHandler_Handle_BacklogActivatesSurge(t *testing.T) {
+	ctx := t.Context()
+	offShift := createCourierAt(t, 1, 1)
+	factory := expectCouriersOffShift(ctx, []*courier.Courier{offShift})
+
+	loadReader := new(MockFleetLoadReader)
+	loadReader.On("GetFleetLoad", ctx).Return(ports.FleetLoad{QueuedOrders: 100}, nil).Once()
+	surges := new(MockSurgeRepository)
+	surges.On("GetMode", ctx).Return(surge.DefaultMode(), nil).Once()
+	surges.On("AddToggle", ctx, mock.MatchedBy(func(toggle surge.Toggle) bool {
+		return toggle.Source() == surge.Automatic && toggle.Activated() && toggle.Backlog() == 100
+	})).Return(nil).Once()
+	notifier := new(MockCourierNotifier)
+	notifier.On("NotifyCourier", ctx, offShift.ID(), mock.Anything).Return(nil).Once()
+
+	handler := commands.NewObserveSurgeDemandCommandHandler(surges, loadReader, surgePolicy(t), factory, notifier)
+	change, err := handler.Handle(ctx, commands.NewObserveSurgeDemandCommand())
+
+	require.NoError(t, err)
+	require.NotNil(t, change)
+	assert.Equal(t, 1, change.Invited)
+	surges.AssertExpectations(t)
+}
+
+func TestObserveSurgeDemandCommandHandler_Handle_BacklogBelowThresholdKeepsMode(t *testing.T) {
+	ctx := t.Context()
+	loadReader := new(MockFleetLoadReader)
+	loadReader.On("GetFleetLoad", ctx).Return(ports.FleetLoad{QueuedOrders: 99}, nil).Once()
+	surges := new(MockSurgeRepository)
+	surges.On("GetMode", ctx).Return(surge.DefaultMode(), nil).Once()
+
+	handler := commands.NewObserveSurgeDemandCommandHandler(
+		surges, loadReader, surgePolicy(t), new(MockCourierUoWFactory), new(MockCourierNotifier))
+	change, err := handler.Handle(ctx, commands.NewObserveSurgeDemandCommand())
+
+	require.NoError(t, err)
+	assert.Nil(t, change)
+	surges.AssertNotCalled(t, "AddToggle", mock.Anything, mock.Anything)
+}
+
+func TestObserveSurgeDemandCommandHandler_Handle_ForcedSettingIsLeftAlone(t *testing.T) {
+	ctx := t.Context()
+	loadReader := new(MockFleetLoadReader)
+	surges := new(MockSurgeRepository)
+	surges.On("GetMode", ctx).Return(activeSurgeMode(t), nil).Once()
+
+	handler := commands.NewObserveSurgeDemandCommandHandler(
+		surges, loadReader, surgePolicy(t), new(MockCourierUoWFactory), new(MockCourierNotifier))
+	change, err := handler.Handle(ctx, commands.NewObserveSurgeDemandCommand())
+
+	require.NoError(t, err)
+	assert.Nil(t, change)
+	loadReader.AssertNotCalled(t, "GetFleetLoad", mock.Anything)
+}
+
+func TestObserveSurgeDemandCommandHandler_Handle_InvalidCommand(t *testing.T) {
+	handler := commands.NewObserveSurgeDemandCommandHandler(
+		new(MockSurgeRepository), new(MockFleetLoadReader), surgePolicy(t),
+		new(MockCourierUoWFactory), new(MockCourierNotifier))
+
+	_, err := handler.Handle(t.Context(), commands.ObserveSurgeDemandCommand{})
+
+	require.ErrorIs(t, err, commands.ErrObserveSurgeDemandCommandIsNotConstructed)
+}
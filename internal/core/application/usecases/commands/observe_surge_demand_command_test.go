@@ -0,0 +1,21 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewObserveSurgeDemandCommand_Valid(t *testing.T) {
+	cmd := commands.NewObserveSurgeDemandCommand()
+
+	require.NoError(t, cmd.Validate())
+}
+
+func TestObserveSurgeDemandCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.ObserveSurgeDemandCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrObserveSurgeDemandCommandIsNotConstructed)
+}
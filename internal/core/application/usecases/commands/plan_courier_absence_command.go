@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrPlanCourierAbsenceCommandIsNotConstructed = errors.New(
+		"PlanCourierAbsenceCommand must be created via NewPlanCourierAbsenceCommand constructor",
+	)
+)
+
+// PlanCourierAbsenceCommand represents operations planning an absence of a courier
+// and naming the substitute who takes over their orders.
+//
+// Example:
+//
+//	cmd, err := NewPlanCourierAbsenceCommand(courierID, startsAt, startsAt.Add(7*24*time.Hour), substituteID)
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewPlanCourierAbsenceCommandHandler(uowFactory)
+//	absence, err := handler.Handle(ctx, cmd)
+type PlanCourierAbsenceCommand struct { //nolint:recvcheck //using for validation
+	courierID    kernel.UUID
+	startsAt     time.Time
+	endsAt       time.Time
+	substituteID kernel.UUID
+
+	guard guard.ConstructorGuard
+}
+
+// NewPlanCourierAbsenceCommand creates a command to plan an absence.
+// Returns an error if an ID is invalid; the period is validated by the courier aggregate.
+func NewPlanCourierAbsenceCommand(
+	courierID kernel.UUID,
+	startsAt, endsAt time.Time,
+	substituteID kernel.UUID,
+) (PlanCourierAbsenceCommand, error) {
+	if err := errs.JoinFields(
+		errs.Field("courierId", courierID.Validate()),
+		errs.Field("substituteId", substituteID.Validate()),
+	); err != nil {
+		return PlanCourierAbsenceCommand{}, err
+	}
+
+	return PlanCourierAbsenceCommand{
+		courierID:    courierID,
+		startsAt:     startsAt,
+		endsAt:       endsAt,
+		substituteID: substituteID,
+		guard:        guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrPlanCourierAbsenceCommandIsNotConstructed if validation fails.
+func (c PlanCourierAbsenceCommand) Validate() error {
+	return c.guard.Validate(ErrPlanCourierAbsenceCommandIsNotConstructed)
+}
+
+// CourierID returns the ID of the absent courier.
+func (c PlanCourierAbsenceCommand) CourierID() kernel.UUID {
+	return c.courierID
+}
+
+// StartsAt returns when the courier stops working.
+func (c PlanCourierAbsenceCommand) StartsAt() time.Time {
+	return c.startsAt
+}
+
+// EndsAt returns when the courier is back at work.
+func (c PlanCourierAbsenceCommand) EndsAt() time.Time {
+	return c.endsAt
+}
+
+// SubstituteID returns the ID of the courier who takes over the absent courier's orders.
+func (c PlanCourierAbsenceCommand) SubstituteID() kernel.UUID {
+	return c.substituteID
+}
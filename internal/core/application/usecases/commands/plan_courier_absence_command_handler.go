@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/tracing"
+)
+
+// PlanCourierAbsenceCommandHandler plans absences of couriers. While an absence lasts the courier
+// is not dispatched and the absence handover passes their orders to the substitute.
+//
+// Besides the rules of the courier aggregate, the handler checks the absence against the calendars
+// of the other couriers: the substitute must be active and present during the whole absence, and
+// the absent courier must not be anyone's substitute at the same time.
+//
+// Example:
+//
+//	handler := NewPlanCourierAbsenceCommandHandler(uowFactory)
+//	cmd, _ := NewPlanCourierAbsenceCommand(courierID, startsAt, endsAt, substituteID)
+//	if _, err := handler.Handle(ctx, cmd); errors.Is(err, courier.ErrSubstituteIsUnavailable) {
+//	    log.Printf("Courier %s cannot substitute at that time", substituteID)
+//	}
+type PlanCourierAbsenceCommandHandler struct {
+	uowFactory CourierUoWFactory
+}
+
+// NewPlanCourierAbsenceCommandHandler creates a new handler for planning absences.
+// Requires a CourierUoWFactory for transactional operations.
+func NewPlanCourierAbsenceCommandHandler(uowFactory CourierUoWFactory) PlanCourierAbsenceCommandHandler {
+	return PlanCourierAbsenceCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the PlanCourierAbsenceCommand within a transaction and returns the new absence.
+// Returns a validation error for an empty absence, an ObjectNotFoundError for an unknown courier or
+// substitute, or courier.ErrAbsenceIsOver, courier.ErrAbsenceOverlaps, courier.ErrSubstituteIsAbsentCourier,
+// courier.ErrSubstituteIsUnavailable or courier.ErrAbsentCourierIsSubstitute when the absence
+// cannot be planned.
+func (h *PlanCourierAbsenceCommandHandler) Handle(
+	ctx context.Context,
+	cmd PlanCourierAbsenceCommand,
+) (courier.Absence, error) {
+	ctx, span := tracing.Start(ctx, "PlanCourierAbsenceCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return courier.Absence{}, err
+	}
+
+	absence, err := courier.NewAbsence(kernel.NewUUID(), cmd.StartsAt(), cmd.EndsAt(), cmd.SubstituteID())
+	if err != nil {
+		return courier.Absence{}, err
+	}
+
+	uow := h.uowFactory.Create()
+	err = uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		absent, err := courierRepo.Get(ctx, cmd.CourierID())
+		if err != nil {
+			return err
+		}
+
+		if err = absent.PlanAbsence(absence, time.Now()); err != nil {
+			return err
+		}
+
+		substitute, err := courierRepo.Get(ctx, cmd.SubstituteID())
+		if err != nil {
+			return err
+		}
+		if err = substitute.CanSubstitute(absence); err != nil {
+			return err
+		}
+
+		substituted, err := courierRepo.GetAllSubstitutedBy(ctx, absent.ID())
+		if err != nil {
+			return err
+		}
+		for _, other := range substituted {
+			if other.NeedsSubstituteDuring(absent.ID(), absence) {
+				return fmt.Errorf("%w: courier %s", courier.ErrAbsentCourierIsSubstitute, other.ID())
+			}
+		}
+
+		return courierRepo.Update(ctx, absent)
+	})
+	if err != nil {
+		return courier.Absence{}, err
+	}
+
+	return absence, nil
+}
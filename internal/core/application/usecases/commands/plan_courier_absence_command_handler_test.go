@@ -0,0 +1,106 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanCourierAbsenceCommandHandler_Handle(t *testing.T) {
+	ctx := t.Context()
+	startsAt := time.Now().Add(time.Hour)
+	endsAt := startsAt.Add(24 * time.Hour)
+
+	setup := func(absent, substitute *courier.Courier, substituted []*courier.Courier) *MockCourierRepository {
+		mockRepo := new(MockCourierRepository)
+		mockRepo.On("Get", ctx, absent.ID()).Return(absent, nil)
+		mockRepo.On("Get", ctx, substitute.ID()).Return(substitute, nil)
+		mockRepo.On("GetAllSubstitutedBy", ctx, absent.ID()).Return(substituted, nil).Maybe()
+		return mockRepo
+	}
+	newHandler := func(mockRepo *MockCourierRepository) (commands.PlanCourierAbsenceCommandHandler, *MockCourierUoW) {
+		mockUoW := new(MockCourierUoW)
+		mockFactory := new(MockCourierUoWFactory)
+		mockFactory.On("Create").Return(mockUoW)
+		mockUoW.On("Begin", ctx).Return(nil)
+		mockUoW.On("CourierRepository").Return(mockRepo)
+		mockUoW.On("Commit", ctx).Return(nil)
+		mockUoW.On("Rollback", ctx).Return(nil)
+		return commands.NewPlanCourierAbsenceCommandHandler(mockFactory), mockUoW
+	}
+
+	t.Run("should plan absence with an available substitute", func(t *testing.T) {
+		absent, substitute := createCourierAt(t, 1, 1), createCourierAt(t, 2, 2)
+		mockRepo := setup(absent, substitute, []*courier.Courier{})
+		mockRepo.On("Update", ctx, absent).Return(nil).Once()
+		handler, _ := newHandler(mockRepo)
+
+		cmd, err := commands.NewPlanCourierAbsenceCommand(absent.ID(), startsAt, endsAt, substitute.ID())
+		require.NoError(t, err)
+		absence, err := handler.Handle(ctx, cmd)
+
+		require.NoError(t, err)
+		assert.Equal(t, substitute.ID(), absence.SubstituteID())
+		require.Len(t, absent.Absences(), 1)
+		assert.Equal(t, absence.ID(), absent.Absences()[0].ID())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should refuse substitute absent at the same time", func(t *testing.T) {
+		absent, substitute := createCourierAt(t, 1, 1), createCourierAt(t, 2, 2)
+		own, err := courier.NewAbsence(kernel.NewUUID(), startsAt.Add(time.Hour), endsAt.Add(time.Hour), kernel.NewUUID())
+		require.NoError(t, err)
+		require.NoError(t, substitute.PlanAbsence(own, time.Now()))
+
+		mockRepo := setup(absent, substitute, []*courier.Courier{})
+		handler, mockUoW := newHandler(mockRepo)
+
+		cmd, err := commands.NewPlanCourierAbsenceCommand(absent.ID(), startsAt, endsAt, substitute.ID())
+		require.NoError(t, err)
+		_, err = handler.Handle(ctx, cmd)
+
+		require.ErrorIs(t, err, courier.ErrSubstituteIsUnavailable)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		mockUoW.AssertNotCalled(t, "Commit", mock.Anything)
+	})
+
+	t.Run("should refuse absence while substituting for another courier", func(t *testing.T) {
+		absent, substitute, covered := createCourierAt(t, 1, 1), createCourierAt(t, 2, 2), createCourierAt(t, 3, 3)
+		coveredAbsence, err := courier.NewAbsence(kernel.NewUUID(), startsAt, endsAt, absent.ID())
+		require.NoError(t, err)
+		require.NoError(t, covered.PlanAbsence(coveredAbsence, time.Now()))
+
+		mockRepo := setup(absent, substitute, []*courier.Courier{covered})
+		handler, _ := newHandler(mockRepo)
+
+		cmd, err := commands.NewPlanCourierAbsenceCommand(
+			absent.ID(), startsAt.Add(time.Hour), endsAt.Add(time.Hour), substitute.ID(),
+		)
+		require.NoError(t, err)
+		_, err = handler.Handle(ctx, cmd)
+
+		require.ErrorIs(t, err, courier.ErrAbsentCourierIsSubstitute)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should refuse courier as their own substitute", func(t *testing.T) {
+		absent := createCourierAt(t, 1, 1)
+		mockRepo := new(MockCourierRepository)
+		mockRepo.On("Get", ctx, absent.ID()).Return(absent, nil)
+		handler, _ := newHandler(mockRepo)
+
+		cmd, err := commands.NewPlanCourierAbsenceCommand(absent.ID(), startsAt, endsAt, absent.ID())
+		require.NoError(t, err)
+		_, err = handler.Handle(ctx, cmd)
+
+		require.ErrorIs(t, err, courier.ErrSubstituteIsAbsentCourier)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
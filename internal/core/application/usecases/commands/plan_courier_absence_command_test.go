@@ -0,0 +1,38 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPlanCourierAbsenceCommand_ValidInput(t *testing.T) {
+	courierID, substituteID := kernel.NewUUID(), kernel.NewUUID()
+	startsAt := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	cmd, err := commands.NewPlanCourierAbsenceCommand(courierID, startsAt, startsAt.Add(24*time.Hour), substituteID)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, courierID, cmd.CourierID())
+	assert.Equal(t, startsAt, cmd.StartsAt())
+	assert.Equal(t, startsAt.Add(24*time.Hour), cmd.EndsAt())
+	assert.Equal(t, substituteID, cmd.SubstituteID())
+}
+
+func TestNewPlanCourierAbsenceCommand_InvalidID(t *testing.T) {
+	_, err := commands.NewPlanCourierAbsenceCommand(kernel.NewUUID(), time.Now(), time.Now(), kernel.UUID{})
+
+	require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+}
+
+func TestPlanCourierAbsenceCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.PlanCourierAbsenceCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrPlanCourierAbsenceCommandIsNotConstructed)
+}
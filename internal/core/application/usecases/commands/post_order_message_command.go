@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"errors"
+	"strings"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrPostOrderMessageCommandIsNotConstructed = errors.New(
+		"PostOrderMessageCommand must be created via NewPostOrderMessageCommand constructor",
+	)
+	ErrMessageTextIsRequired = errors.New("message text is required")
+)
+
+// PostOrderMessageCommand represents a courier or dispatcher posting a short message
+// to the communication thread attached to an order.
+//
+// Example:
+//
+//	cmd, err := NewPostOrderMessageCommand(orderID, order.CourierSender, "Entrance is closed, waiting outside")
+//	if err != nil {
+//	    return fmt.Errorf("invalid message: %w", err)
+//	}
+//
+//	handler := NewPostOrderMessageCommandHandler(uowFactory)
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    return fmt.Errorf("failed to post message: %w", err)
+//	}
+type PostOrderMessageCommand struct { //nolint:recvcheck //using for validation
+	orderID kernel.UUID
+	sender  order.Sender
+	text    string
+
+	guard guard.ConstructorGuard
+}
+
+// NewPostOrderMessageCommand creates a command to post a message to an order thread.
+// Validates that the order ID and sender are valid and the text is not blank.
+// Message length limits are enforced by the order aggregate.
+func NewPostOrderMessageCommand(orderID kernel.UUID, sender order.Sender, text string) (PostOrderMessageCommand, error) {
+	command := PostOrderMessageCommand{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := errors.Join(
+		command.setOrderID(orderID),
+		command.setSender(sender),
+		command.setText(text),
+	); err != nil {
+		return PostOrderMessageCommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrPostOrderMessageCommandIsNotConstructed if validation fails.
+func (c PostOrderMessageCommand) Validate() error {
+	return c.guard.Validate(ErrPostOrderMessageCommandIsNotConstructed)
+}
+
+// OrderID returns the ID of the order whose thread receives the message.
+func (c PostOrderMessageCommand) OrderID() kernel.UUID {
+	return c.orderID
+}
+
+// Sender returns the party posting the message.
+func (c PostOrderMessageCommand) Sender() order.Sender {
+	return c.sender
+}
+
+// Text returns the message body.
+func (c PostOrderMessageCommand) Text() string {
+	return c.text
+}
+
+func (c *PostOrderMessageCommand) setOrderID(orderID kernel.UUID) error {
+	if err := orderID.Validate(); err != nil {
+		return err
+	}
+
+	c.orderID = orderID
+	return nil
+}
+
+func (c *PostOrderMessageCommand) setSender(sender order.Sender) error {
+	if err := sender.Validate(); err != nil {
+		return err
+	}
+
+	c.sender = sender
+	return nil
+}
+
+func (c *PostOrderMessageCommand) setText(text string) error {
+	if strings.TrimSpace(text) == "" {
+		return ErrMessageTextIsRequired
+	}
+
+	c.text = text
+	return nil
+}
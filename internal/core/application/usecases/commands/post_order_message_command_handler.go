@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/pkg/tracing"
+)
+
+// PostOrderMessageCommandHandler appends messages to order communication threads.
+// Uses transactional operations to ensure data consistency when modifying order aggregates.
+//
+// Example:
+//
+//	handler := NewPostOrderMessageCommandHandler(uowFactory)
+//	cmd, _ := NewPostOrderMessageCommand(orderID, order.DispatcherSender, "Customer asked to call")
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    log.Printf("Failed to post message: %v", err)
+//	}
+type PostOrderMessageCommandHandler struct {
+	uowFactory OrderUoWFactory
+}
+
+// NewPostOrderMessageCommandHandler creates a new handler for order thread messages.
+// Requires an OrderUoWFactory for transactional operations.
+func NewPostOrderMessageCommandHandler(uowFactory OrderUoWFactory) PostOrderMessageCommandHandler {
+	return PostOrderMessageCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the PostOrderMessageCommand within a transaction.
+// Retrieves the order, posts the message, and persists the changes.
+// Returns order.ErrThreadIsClosed when the order has already been completed.
+func (h *PostOrderMessageCommandHandler) Handle(ctx context.Context, cmd PostOrderMessageCommand) error {
+	ctx, span := tracing.Start(ctx, "PostOrderMessageCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+
+	uow := h.uowFactory.Create()
+	return uow.Do(ctx, func(ctx context.Context) error {
+		orderRepo := uow.OrderRepository()
+		orderAggregate, err := orderRepo.Get(ctx, cmd.OrderID())
+		if err != nil {
+			return err
+		}
+
+		if _, err = orderAggregate.PostMessage(cmd.Sender(), cmd.Text(), time.Now()); err != nil {
+			return err
+		}
+
+		return orderRepo.Update(ctx, orderAggregate)
+	})
+}
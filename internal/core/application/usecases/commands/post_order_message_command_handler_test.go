@@ -0,0 +1,131 @@
+package commands_test
+
+import (
+	"strings"
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createOrderForThread(t *testing.T) *order.Order {
+	t.Helper()
+	location, err := kernel.NewLocation(2, 3)
+	require.NoError(t, err)
+	o, err := order.NewOrder(kernel.NewUUID(), location, 5)
+	require.NoError(t, err)
+	return o
+}
+
+func TestPostOrderMessageCommandHandler_Handle_Success(t *testing.T) {
+	ctx := t.Context()
+	orderAggregate := createOrderForThread(t)
+
+	repo := new(MoveOrderRepo)
+	uow := new(MockOrderUoW)
+	factory := new(MockOrderUoWFactory)
+
+	mock.InOrder(
+		factory.On("Create").Return(uow).Once(),
+		uow.On("Begin", ctx).Return(nil).Once(),
+		uow.On("OrderRepository").Return(repo).Once(),
+		repo.On("Get", ctx, orderAggregate.ID()).Return(orderAggregate, nil).Once(),
+		repo.On("Update", ctx, orderAggregate).Return(nil).Once(),
+		uow.On("Commit", ctx).Return(nil).Once(),
+	)
+
+	cmd, err := commands.NewPostOrderMessageCommand(orderAggregate.ID(), order.DispatcherSender, "Call on arrival")
+	require.NoError(t, err)
+
+	handler := commands.NewPostOrderMessageCommandHandler(factory)
+	require.NoError(t, handler.Handle(ctx, cmd))
+
+	messages := orderAggregate.Messages()
+	require.Len(t, messages, 1)
+	assert.Equal(t, order.DispatcherSender, messages[0].Sender())
+	assert.Equal(t, "Call on arrival", messages[0].Text())
+	repo.AssertExpectations(t)
+	uow.AssertExpectations(t)
+}
+
+func TestPostOrderMessageCommandHandler_Handle_ClosedThread(t *testing.T) {
+	ctx := t.Context()
+	orderAggregate := createOrderForThread(t)
+	require.NoError(t, orderAggregate.Assign(kernel.NewUUID()))
+	require.NoError(t, orderAggregate.Complete())
+
+	repo := new(MoveOrderRepo)
+	uow := new(MockOrderUoW)
+	factory := new(MockOrderUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(repo).Once()
+	repo.On("Get", ctx, orderAggregate.ID()).Return(orderAggregate, nil).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewPostOrderMessageCommand(orderAggregate.ID(), order.CourierSender, "Delivered")
+	require.NoError(t, err)
+
+	handler := commands.NewPostOrderMessageCommandHandler(factory)
+	err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, order.ErrThreadIsClosed)
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	uow.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+func TestPostOrderMessageCommandHandler_Handle_TooLongText(t *testing.T) {
+	ctx := t.Context()
+	orderAggregate := createOrderForThread(t)
+
+	repo := new(MoveOrderRepo)
+	uow := new(MockOrderUoW)
+	factory := new(MockOrderUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(repo).Once()
+	repo.On("Get", ctx, orderAggregate.ID()).Return(orderAggregate, nil).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewPostOrderMessageCommand(
+		orderAggregate.ID(), order.CourierSender, strings.Repeat("a", order.MaxMessageLength+1),
+	)
+	require.NoError(t, err)
+
+	handler := commands.NewPostOrderMessageCommandHandler(factory)
+	err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	assert.Empty(t, orderAggregate.Messages())
+}
+
+func TestPostOrderMessageCommandHandler_Handle_OrderNotFound(t *testing.T) {
+	ctx := t.Context()
+	orderID := kernel.NewUUID()
+
+	repo := new(MoveOrderRepo)
+	uow := new(MockOrderUoW)
+	factory := new(MockOrderUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(repo).Once()
+	repo.On("Get", ctx, orderID).Return(nil, errs.NewObjectNotFoundError("order", orderID.String())).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewPostOrderMessageCommand(orderID, order.CourierSender, "Hello")
+	require.NoError(t, err)
+
+	handler := commands.NewPostOrderMessageCommandHandler(factory)
+	err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, errs.ErrObjectNotFound)
+}
@@ -0,0 +1,39 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPostOrderMessageCommand_ValidInput(t *testing.T) {
+	orderID := kernel.NewUUID()
+
+	cmd, err := commands.NewPostOrderMessageCommand(orderID, order.CourierSender, "Running late")
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, orderID, cmd.OrderID())
+	assert.Equal(t, order.CourierSender, cmd.Sender())
+	assert.Equal(t, "Running late", cmd.Text())
+}
+
+func TestNewPostOrderMessageCommand_InvalidInput(t *testing.T) {
+	_, err := commands.NewPostOrderMessageCommand(kernel.UUID{}, order.UnknownSender, "  ")
+
+	require.Error(t, err)
+	require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+	require.ErrorIs(t, err, commands.ErrMessageTextIsRequired)
+	assert.Contains(t, err.Error(), "sender is invalid")
+}
+
+func TestPostOrderMessageCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.PostOrderMessageCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrPostOrderMessageCommandIsNotConstructed)
+}
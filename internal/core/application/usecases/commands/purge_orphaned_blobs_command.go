@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrPurgeOrphanedBlobsCommandIsNotConstructed = errors.New(
+		"PurgeOrphanedBlobsCommand must be created via NewPurgeOrphanedBlobsCommand constructor",
+	)
+	ErrOrphanedBlobAgeIsInvalid = errors.New("orphaned blob age must be longer than the upload URL validity")
+)
+
+// PurgeOrphanedBlobsCommand represents a request to remove the files no feature attached:
+// delivery photos of deliveries never confirmed with them, documents uploaded but never
+// submitted, and uploads whose URL was never used. Only uploads older than the given age are
+// removed, which must be longer than UploadURLValidity so no file is removed while it is uploaded.
+//
+// Example:
+//
+//	cmd, err := NewPurgeOrphanedBlobsCommand(24 * time.Hour)
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	removed, err := handler.Handle(ctx, cmd)
+//	fmt.Printf("Removed %d orphaned files", removed)
+type PurgeOrphanedBlobsCommand struct { //nolint:recvcheck //using for validation
+	olderThan time.Duration
+
+	guard guard.ConstructorGuard
+}
+
+// NewPurgeOrphanedBlobsCommand creates a command to purge orphaned uploads older than olderThan.
+// Returns ErrOrphanedBlobAgeIsInvalid unless olderThan is longer than UploadURLValidity.
+func NewPurgeOrphanedBlobsCommand(olderThan time.Duration) (PurgeOrphanedBlobsCommand, error) {
+	command := PurgeOrphanedBlobsCommand{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := command.setOlderThan(olderThan); err != nil {
+		return PurgeOrphanedBlobsCommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrPurgeOrphanedBlobsCommandIsNotConstructed if validation fails.
+func (c PurgeOrphanedBlobsCommand) Validate() error {
+	return c.guard.Validate(ErrPurgeOrphanedBlobsCommandIsNotConstructed)
+}
+
+// OlderThan returns the minimum age of the orphaned uploads to remove.
+func (c PurgeOrphanedBlobsCommand) OlderThan() time.Duration {
+	return c.olderThan
+}
+
+func (c *PurgeOrphanedBlobsCommand) setOlderThan(olderThan time.Duration) error {
+	if olderThan <= UploadURLValidity {
+		return ErrOrphanedBlobAgeIsInvalid
+	}
+
+	c.olderThan = olderThan
+	return nil
+}
@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tracing"
+)
+
+// orphanedBlobBatchSize is how many orphaned uploads are loaded at a time.
+const orphanedBlobBatchSize = 100
+
+// PurgeOrphanedBlobsCommandHandler removes orphaned uploads together with their files.
+// It is run periodically by the orphaned blob janitor job.
+//
+// A file is deleted before its upload, so a purge interrupted midway never leaves a file
+// the cleanup no longer knows about; the next purge deletes the upload of a file already gone.
+//
+// Example:
+//
+//	handler := NewPurgeOrphanedBlobsCommandHandler(uploads, storage)
+//	cmd, _ := NewPurgeOrphanedBlobsCommand(24 * time.Hour)
+//	removed, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    log.Printf("Failed to purge orphaned blobs: %v", err)
+//	}
+type PurgeOrphanedBlobsCommandHandler struct {
+	uploads ports.BlobUploadRepository
+	storage ports.BlobStorage
+}
+
+// NewPurgeOrphanedBlobsCommandHandler creates a handler for orphaned blob purges.
+func NewPurgeOrphanedBlobsCommandHandler(
+	uploads ports.BlobUploadRepository,
+	storage ports.BlobStorage,
+) PurgeOrphanedBlobsCommandHandler {
+	return PurgeOrphanedBlobsCommandHandler{
+		uploads: uploads,
+		storage: storage,
+	}
+}
+
+// Handle removes the uploads issued more than cmd.OlderThan() ago that no feature attached,
+// and returns how many were removed. Stops at the first file the storage fails to delete,
+// reporting the uploads removed until then.
+func (h *PurgeOrphanedBlobsCommandHandler) Handle(ctx context.Context, cmd PurgeOrphanedBlobsCommand) (int, error) {
+	ctx, span := tracing.Start(ctx, "PurgeOrphanedBlobsCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return 0, err
+	}
+
+	cutoff := time.Now().UTC().Add(-cmd.OlderThan())
+	removed := 0
+	for {
+		uploads, err := h.uploads.GetOrphaned(ctx, cutoff, orphanedBlobBatchSize)
+		if err != nil {
+			return removed, err
+		}
+
+		for _, upload := range uploads {
+			if err = h.storage.Delete(ctx, upload.Key()); err != nil {
+				return removed, err
+			}
+			if err = h.uploads.Delete(ctx, upload.ID()); err != nil {
+				return removed, err
+			}
+			removed++
+		}
+
+		if len(uploads) < orphanedBlobBatchSize {
+			return removed, nil
+		}
+	}
+}
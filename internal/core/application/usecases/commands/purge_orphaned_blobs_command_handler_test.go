@@ -0,0 +1,91 @@
+package commands_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/blob"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// createOrphanedUploads creates n uploads issued a day ago.
+func createOrphanedUploads(t *testing.T, n int) []*blob.Upload {
+	t.Helper()
+	uploads := make([]*blob.Upload, 0, n)
+	for range n {
+		upload, err := blob.NewUpload(kernel.NewUUID(), blob.DeliveryPhoto, kernel.NewUUID(), "image/jpeg", 2048,
+			time.Now().Add(-24*time.Hour))
+		require.NoError(t, err)
+		uploads = append(uploads, upload)
+	}
+	return uploads
+}
+
+func TestPurgeOrphanedBlobsCommandHandler_Handle_RemovesFilesThenUploads(t *testing.T) {
+	ctx := t.Context()
+	uploads := new(MockBlobUploadRepository)
+	storage := new(MockBlobStorage)
+	full := createOrphanedUploads(t, 100)
+	rest := createOrphanedUploads(t, 2)
+
+	before := time.Now().UTC().Add(-time.Hour)
+	cutoff := mock.MatchedBy(func(cutoff time.Time) bool {
+		return !cutoff.Before(before) && !cutoff.After(time.Now().UTC().Add(-time.Hour))
+	})
+	uploads.On("GetOrphaned", ctx, cutoff, 100).Return(full, nil).Once()
+	uploads.On("GetOrphaned", ctx, cutoff, 100).Return(rest, nil).Once()
+	for _, upload := range append(full, rest...) {
+		storage.On("Delete", ctx, upload.Key()).Return(nil).Once()
+		uploads.On("Delete", ctx, upload.ID()).Return(nil).Once()
+	}
+
+	cmd, err := commands.NewPurgeOrphanedBlobsCommand(time.Hour)
+	require.NoError(t, err)
+
+	handler := commands.NewPurgeOrphanedBlobsCommandHandler(uploads, storage)
+	removed, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, 102, removed)
+	uploads.AssertExpectations(t)
+	storage.AssertExpectations(t)
+}
+
+func TestPurgeOrphanedBlobsCommandHandler_Handle_KeepsUploadOfFileNotDeleted(t *testing.T) {
+	ctx := t.Context()
+	uploads := new(MockBlobUploadRepository)
+	storage := new(MockBlobStorage)
+	orphans := createOrphanedUploads(t, 2)
+	failure := errors.New("access denied")
+
+	uploads.On("GetOrphaned", ctx, mock.Anything, 100).Return(orphans, nil).Once()
+	storage.On("Delete", ctx, orphans[0].Key()).Return(nil).Once()
+	uploads.On("Delete", ctx, orphans[0].ID()).Return(nil).Once()
+	storage.On("Delete", ctx, orphans[1].Key()).Return(failure).Once()
+
+	cmd, err := commands.NewPurgeOrphanedBlobsCommand(time.Hour)
+	require.NoError(t, err)
+
+	handler := commands.NewPurgeOrphanedBlobsCommandHandler(uploads, storage)
+	removed, err := handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, failure)
+	assert.Equal(t, 1, removed)
+	uploads.AssertNotCalled(t, "Delete", ctx, orphans[1].ID())
+}
+
+func TestPurgeOrphanedBlobsCommandHandler_Handle_InvalidCommand(t *testing.T) {
+	uploads := new(MockBlobUploadRepository)
+	handler := commands.NewPurgeOrphanedBlobsCommandHandler(uploads, new(MockBlobStorage))
+
+	_, err := handler.Handle(t.Context(), commands.PurgeOrphanedBlobsCommand{})
+
+	require.ErrorIs(t, err, commands.ErrPurgeOrphanedBlobsCommandIsNotConstructed)
+	uploads.AssertNotCalled(t, "GetOrphaned", mock.Anything, mock.Anything, mock.Anything)
+}
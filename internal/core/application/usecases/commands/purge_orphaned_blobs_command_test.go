@@ -0,0 +1,31 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPurgeOrphanedBlobsCommand_ValidInput(t *testing.T) {
+	cmd, err := commands.NewPurgeOrphanedBlobsCommand(24 * time.Hour)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, 24*time.Hour, cmd.OlderThan())
+}
+
+func TestNewPurgeOrphanedBlobsCommand_AgeWithinUploadURLValidity(t *testing.T) {
+	_, err := commands.NewPurgeOrphanedBlobsCommand(commands.UploadURLValidity)
+
+	require.ErrorIs(t, err, commands.ErrOrphanedBlobAgeIsInvalid)
+}
+
+func TestPurgeOrphanedBlobsCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.PurgeOrphanedBlobsCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrPurgeOrphanedBlobsCommandIsNotConstructed)
+}
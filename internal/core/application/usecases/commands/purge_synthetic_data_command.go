@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrPurgeSyntheticDataCommandIsNotConstructed = errors.New(
+		"PurgeSyntheticDataCommand must be created via NewPurgeSyntheticDataCommand constructor",
+	)
+	ErrSyntheticDataAgeIsInvalid = errors.New("synthetic data age must not be negative")
+)
+
+// PurgeSyntheticDataCommand represents a request to remove test data left behind by
+// integration suites: couriers and orders created by requests marked as synthetic.
+// Only data older than the given age is removed, so suites still running are not disturbed.
+//
+// Example:
+//
+//	cmd, err := NewPurgeSyntheticDataCommand(24 * time.Hour)
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	purge, err := handler.Handle(ctx, cmd)
+//	fmt.Printf("Removed %d orders and %d couriers", purge.Orders, purge.Couriers)
+type PurgeSyntheticDataCommand struct { //nolint:recvcheck //using for validation
+	olderThan time.Duration
+
+	guard guard.ConstructorGuard
+}
+
+// NewPurgeSyntheticDataCommand creates a command to purge synthetic data older than olderThan.
+// An age of 0 removes all synthetic data; negative ages are rejected.
+func NewPurgeSyntheticDataCommand(olderThan time.Duration) (PurgeSyntheticDataCommand, error) {
+	command := PurgeSyntheticDataCommand{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := command.setOlderThan(olderThan); err != nil {
+		return PurgeSyntheticDataCommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrPurgeSyntheticDataCommandIsNotConstructed if validation fails.
+func (c PurgeSyntheticDataCommand) Validate() error {
+	return c.guard.Validate(ErrPurgeSyntheticDataCommandIsNotConstructed)
+}
+
+// OlderThan returns the minimum age of the synthetic data to remove.
+func (c PurgeSyntheticDataCommand) OlderThan() time.Duration {
+	return c.olderThan
+}
+
+func (c *PurgeSyntheticDataCommand) setOlderThan(olderThan time.Duration) error {
+	if olderThan < 0 {
+		return ErrSyntheticDataAgeIsInvalid
+	}
+
+	c.olderThan = olderThan
+	return nil
+}
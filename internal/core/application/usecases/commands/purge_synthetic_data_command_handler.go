@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tracing"
+)
+
+// PurgeSyntheticDataCommandHandler removes expired test data through a SyntheticDataJanitor.
+// It is run periodically by the janitor job and on demand by integration suites.
+//
+// Example:
+//
+//	handler := NewPurgeSyntheticDataCommandHandler(janitor)
+//	cmd, _ := NewPurgeSyntheticDataCommand(24 * time.Hour)
+//	purge, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    log.Printf("Failed to purge synthetic data: %v", err)
+//	}
+type PurgeSyntheticDataCommandHandler struct {
+	janitor ports.SyntheticDataJanitor
+}
+
+// NewPurgeSyntheticDataCommandHandler creates a handler for synthetic data purges.
+func NewPurgeSyntheticDataCommandHandler(janitor ports.SyntheticDataJanitor) PurgeSyntheticDataCommandHandler {
+	return PurgeSyntheticDataCommandHandler{
+		janitor: janitor,
+	}
+}
+
+// Handle removes synthetic data created more than cmd.OlderThan() ago and reports what was removed.
+func (h *PurgeSyntheticDataCommandHandler) Handle(
+	ctx context.Context,
+	cmd PurgeSyntheticDataCommand,
+) (ports.SyntheticDataPurge, error) {
+	ctx, span := tracing.Start(ctx, "PurgeSyntheticDataCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return ports.SyntheticDataPurge{}, err
+	}
+
+	return h.janitor.PurgeSyntheticData(ctx, time.Now().UTC().Add(-cmd.OlderThan()))
+}
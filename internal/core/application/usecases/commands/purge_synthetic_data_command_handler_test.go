@@ -0,0 +1,74 @@
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/ports"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockSyntheticDataJanitor is a mock for ports.SyntheticDataJanitor.
+type MockSyntheticDataJanitor struct {
+	mock.Mock
+}
+
+func (m *MockSyntheticDataJanitor) PurgeSyntheticData(
+	ctx context.Context,
+	createdBefore time.Time,
+) (ports.SyntheticDataPurge, error) {
+	args := m.Called(ctx, createdBefore)
+	return args.Get(0).(ports.SyntheticDataPurge), args.Error(1)
+}
+
+func TestPurgeSyntheticDataCommandHandler_Handle_PurgesDataOlderThanAge(t *testing.T) {
+	ctx := t.Context()
+	janitor := new(MockSyntheticDataJanitor)
+	expected := ports.SyntheticDataPurge{Orders: 3, Couriers: 1}
+
+	before := time.Now().UTC().Add(-time.Hour)
+	janitor.On("PurgeSyntheticData", ctx, mock.MatchedBy(func(cutoff time.Time) bool {
+		return !cutoff.Before(before) && !cutoff.After(time.Now().UTC().Add(-time.Hour))
+	})).Return(expected, nil).Once()
+
+	cmd, err := commands.NewPurgeSyntheticDataCommand(time.Hour)
+	require.NoError(t, err)
+
+	handler := commands.NewPurgeSyntheticDataCommandHandler(janitor)
+	purge, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, expected, purge)
+	janitor.AssertExpectations(t)
+}
+
+func TestPurgeSyntheticDataCommandHandler_Handle_JanitorError(t *testing.T) {
+	ctx := t.Context()
+	janitor := new(MockSyntheticDataJanitor)
+	failure := errors.New("connection lost")
+	janitor.On("PurgeSyntheticData", ctx, mock.Anything).Return(ports.SyntheticDataPurge{}, failure).Once()
+
+	cmd, err := commands.NewPurgeSyntheticDataCommand(0)
+	require.NoError(t, err)
+
+	handler := commands.NewPurgeSyntheticDataCommandHandler(janitor)
+	_, err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, failure)
+}
+
+func TestPurgeSyntheticDataCommandHandler_Handle_InvalidCommand(t *testing.T) {
+	janitor := new(MockSyntheticDataJanitor)
+	handler := commands.NewPurgeSyntheticDataCommandHandler(janitor)
+
+	_, err := handler.Handle(t.Context(), commands.PurgeSyntheticDataCommand{})
+
+	require.ErrorIs(t, err, commands.ErrPurgeSyntheticDataCommandIsNotConstructed)
+	janitor.AssertNotCalled(t, "PurgeSyntheticData", mock.Anything, mock.Anything)
+}
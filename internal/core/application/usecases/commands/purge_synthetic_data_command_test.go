@@ -0,0 +1,38 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPurgeSyntheticDataCommand_ValidInput(t *testing.T) {
+	cmd, err := commands.NewPurgeSyntheticDataCommand(24 * time.Hour)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, 24*time.Hour, cmd.OlderThan())
+}
+
+func TestNewPurgeSyntheticDataCommand_ZeroAge(t *testing.T) {
+	cmd, err := commands.NewPurgeSyntheticDataCommand(0)
+
+	require.NoError(t, err)
+	assert.Zero(t, cmd.OlderThan())
+}
+
+func TestNewPurgeSyntheticDataCommand_NegativeAge(t *testing.T) {
+	_, err := commands.NewPurgeSyntheticDataCommand(-time.Second)
+
+	require.ErrorIs(t, err, commands.ErrSyntheticDataAgeIsInvalid)
+}
+
+func TestPurgeSyntheticDataCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.PurgeSyntheticDataCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrPurgeSyntheticDataCommandIsNotConstructed)
+}
@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrRateOrderCommandIsNotConstructed = errors.New(
+		"RateOrderCommand must be created via NewRateOrderCommand constructor",
+	)
+)
+
+// RateOrderCommand represents a customer's rating of the delivery of an order.
+// The order is identified by its tracking token, the only identifier customers have.
+//
+// Example:
+//
+//	token, _ := order.TrackingTokenFromString(tokenFromLink)
+//	cmd, err := NewRateOrderCommand(token, 5)
+//	if err != nil {
+//	    return fmt.Errorf("invalid rating: %w", err)
+//	}
+//
+//	handler := NewRateOrderCommandHandler(uowFactory)
+//	receipt, err := handler.Handle(ctx, cmd)
+type RateOrderCommand struct { //nolint:recvcheck //using for validation
+	token order.TrackingToken
+	stars int
+
+	guard guard.ConstructorGuard
+}
+
+// NewRateOrderCommand creates a command to rate the delivery of the order behind the token.
+// Validates the token; the stars are validated when the rating is made.
+func NewRateOrderCommand(token order.TrackingToken, stars int) (RateOrderCommand, error) {
+	if err := token.Validate(); err != nil {
+		return RateOrderCommand{}, err
+	}
+
+	return RateOrderCommand{
+		token: token,
+		stars: stars,
+		guard: guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrRateOrderCommandIsNotConstructed if validation fails.
+func (c RateOrderCommand) Validate() error {
+	return c.guard.Validate(ErrRateOrderCommandIsNotConstructed)
+}
+
+// Token returns the tracking token of the rated order.
+func (c RateOrderCommand) Token() order.TrackingToken {
+	return c.token
+}
+
+// Stars returns the rating in stars.
+func (c RateOrderCommand) Stars() int {
+	return c.stars
+}
@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/tracing"
+)
+
+// OrderRatingReceipt is the outcome of a rating submission.
+type OrderRatingReceipt struct {
+	// OrderID is the rated order
+	OrderID kernel.UUID
+
+	// Rating is the rating recorded for the delivery
+	Rating order.Rating
+
+	// Replayed is true if the submission repeated the recorded rating
+	Replayed bool
+}
+
+// RateOrderCommandHandler records customer ratings of deliveries. A rating lands in the change
+// log with the order, where the courier badge projection picks it up.
+//
+// Example:
+//
+//	handler := NewRateOrderCommandHandler(uowFactory)
+//	cmd, _ := NewRateOrderCommand(token, 5)
+//	receipt, err := handler.Handle(ctx, cmd)
+//	if errors.Is(err, order.ErrOrderIsAlreadyRated) {
+//	    // The customer has already rated the delivery with other stars
+//	}
+type RateOrderCommandHandler struct {
+	uowFactory OrderUoWFactory
+}
+
+// NewRateOrderCommandHandler creates a new handler for customer ratings.
+// Requires an OrderUoWFactory for transactional operations.
+func NewRateOrderCommandHandler(uowFactory OrderUoWFactory) RateOrderCommandHandler {
+	return RateOrderCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the RateOrderCommand within a transaction.
+// Resubmitting the stars of the recorded rating returns that rating without changes.
+// Returns order.ErrOrderIsNotDelivered if the order is not delivered yet and
+// order.ErrOrderIsAlreadyRated if it was rated with other stars.
+func (h RateOrderCommandHandler) Handle(ctx context.Context, cmd RateOrderCommand) (OrderRatingReceipt, error) {
+	ctx, span := tracing.Start(ctx, "RateOrderCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return OrderRatingReceipt{}, err
+	}
+
+	rating, err := order.NewRating(cmd.Stars(), time.Now())
+	if err != nil {
+		return OrderRatingReceipt{}, err
+	}
+
+	var receipt OrderRatingReceipt
+	uow := h.uowFactory.Create()
+	err = uow.Do(ctx, func(ctx context.Context) error {
+		orderRepo := uow.OrderRepository()
+		orderAggregate, err := orderRepo.GetByTrackingToken(ctx, cmd.Token())
+		if err != nil {
+			return err
+		}
+
+		rated, err := orderAggregate.Rate(rating)
+		if err != nil {
+			return err
+		}
+		if !rated {
+			receipt = OrderRatingReceipt{OrderID: orderAggregate.ID(), Rating: *orderAggregate.Rating(), Replayed: true}
+			return nil
+		}
+
+		if err = orderRepo.Update(ctx, orderAggregate); err != nil {
+			return err
+		}
+
+		receipt = OrderRatingReceipt{OrderID: orderAggregate.ID(), Rating: rating, Replayed: false}
+		return nil
+	})
+	if err != nil {
+		return OrderRatingReceipt{}, err
+	}
+
+	return receipt, nil
+}
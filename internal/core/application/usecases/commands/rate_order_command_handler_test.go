@@ -0,0 +1,127 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateOrderCommandHandler_Handle_Success(t *testing.T) {
+	ctx := t.Context()
+	orderAggregate, token := createDeliveredOrder(t, kernel.NewUUID())
+
+	repo := new(MoveOrderRepo)
+	uow := new(MockOrderUoW)
+	factory := new(MockOrderUoWFactory)
+
+	mock.InOrder(
+		factory.On("Create").Return(uow).Once(),
+		uow.On("Begin", ctx).Return(nil).Once(),
+		uow.On("OrderRepository").Return(repo).Once(),
+		repo.On("GetByTrackingToken", ctx, token).Return(orderAggregate, nil).Once(),
+		repo.On("Update", ctx, orderAggregate).Return(nil).Once(),
+		uow.On("Commit", ctx).Return(nil).Once(),
+	)
+
+	cmd, err := commands.NewRateOrderCommand(token, 5)
+	require.NoError(t, err)
+
+	handler := commands.NewRateOrderCommandHandler(factory)
+	receipt, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.False(t, receipt.Replayed)
+	assert.True(t, receipt.OrderID.IsEqual(orderAggregate.ID()))
+	assert.Equal(t, 5, receipt.Rating.Stars())
+	require.NotNil(t, orderAggregate.Rating())
+	repo.AssertExpectations(t)
+	uow.AssertExpectations(t)
+}
+
+func TestRateOrderCommandHandler_Handle_Retry(t *testing.T) {
+	ctx := t.Context()
+	orderAggregate, token := createDeliveredOrder(t, kernel.NewUUID())
+	recorded, err := order.NewRating(5, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	_, err = orderAggregate.Rate(recorded)
+	require.NoError(t, err)
+
+	repo := new(MoveOrderRepo)
+	uow := new(MockOrderUoW)
+	factory := new(MockOrderUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(repo).Once()
+	repo.On("GetByTrackingToken", ctx, token).Return(orderAggregate, nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewRateOrderCommand(token, 5)
+	require.NoError(t, err)
+
+	handler := commands.NewRateOrderCommandHandler(factory)
+	receipt, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.True(t, receipt.Replayed)
+	assert.Equal(t, recorded.RatedAt(), receipt.Rating.RatedAt())
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	uow.AssertExpectations(t)
+}
+
+func TestRateOrderCommandHandler_Handle_Rejected(t *testing.T) {
+	t.Run("should refuse undelivered order", func(t *testing.T) {
+		ctx := t.Context()
+		orderAggregate := createOrderForThread(t)
+		token, err := orderAggregate.ShareTracking()
+		require.NoError(t, err)
+
+		repo := new(MoveOrderRepo)
+		uow := new(MockOrderUoW)
+		factory := new(MockOrderUoWFactory)
+
+		factory.On("Create").Return(uow).Once()
+		uow.On("Begin", ctx).Return(nil).Once()
+		uow.On("OrderRepository").Return(repo).Once()
+		repo.On("GetByTrackingToken", ctx, token).Return(orderAggregate, nil).Once()
+		uow.On("Rollback", ctx).Return(nil).Once()
+
+		cmd, err := commands.NewRateOrderCommand(token, 5)
+		require.NoError(t, err)
+
+		handler := commands.NewRateOrderCommandHandler(factory)
+		_, err = handler.Handle(ctx, cmd)
+
+		require.ErrorIs(t, err, order.ErrOrderIsNotDelivered)
+		repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should validate stars before opening a transaction", func(t *testing.T) {
+		factory := new(MockOrderUoWFactory)
+
+		cmd, err := commands.NewRateOrderCommand(order.NewTrackingToken(), 6)
+		require.NoError(t, err)
+
+		handler := commands.NewRateOrderCommandHandler(factory)
+		_, err = handler.Handle(t.Context(), cmd)
+
+		require.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+		factory.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("should fail with command not created via constructor", func(t *testing.T) {
+		handler := commands.NewRateOrderCommandHandler(new(MockOrderUoWFactory))
+
+		_, err := handler.Handle(t.Context(), commands.RateOrderCommand{})
+
+		require.ErrorIs(t, err, commands.ErrRateOrderCommandIsNotConstructed)
+	})
+}
@@ -0,0 +1,34 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRateOrderCommand_ValidInput(t *testing.T) {
+	token := order.NewTrackingToken()
+
+	cmd, err := commands.NewRateOrderCommand(token, 5)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.True(t, cmd.Token().IsEqual(token))
+	assert.Equal(t, 5, cmd.Stars())
+}
+
+func TestNewRateOrderCommand_InvalidToken(t *testing.T) {
+	_, err := commands.NewRateOrderCommand(order.TrackingToken{}, 5)
+
+	require.ErrorIs(t, err, order.ErrTrackingTokenIsNotConstructed)
+}
+
+func TestRateOrderCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.RateOrderCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrRateOrderCommandIsNotConstructed)
+}
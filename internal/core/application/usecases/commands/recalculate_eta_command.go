@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrRecalculateETACommandIsNotConstructed = errors.New(
+		"RecalculateETACommand must be created via NewRecalculateETACommand constructor",
+	)
+)
+
+// RecalculateETACommand requests a fresh delivery estimate for an assigned order
+// from its courier's current location.
+//
+// Example:
+//
+//	cmd, err := NewRecalculateETACommand(orderID)
+//	if err != nil {
+//	    return fmt.Errorf("invalid order id: %w", err)
+//	}
+//
+//	handler := NewRecalculateETACommandHandler(uowFactory)
+//	eta, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    return fmt.Errorf("failed to recalculate eta: %w", err)
+//	}
+type RecalculateETACommand struct { //nolint:recvcheck //using for validation
+	orderID kernel.UUID
+
+	guard guard.ConstructorGuard
+}
+
+// NewRecalculateETACommand creates a command to recalculate the ETA of an order.
+// Validates that the order ID is valid.
+func NewRecalculateETACommand(orderID kernel.UUID) (RecalculateETACommand, error) {
+	command := RecalculateETACommand{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := command.setOrderID(orderID); err != nil {
+		return RecalculateETACommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrRecalculateETACommandIsNotConstructed if validation fails.
+func (c RecalculateETACommand) Validate() error {
+	return c.guard.Validate(ErrRecalculateETACommandIsNotConstructed)
+}
+
+// OrderID returns the ID of the order to recalculate the ETA for.
+func (c RecalculateETACommand) OrderID() kernel.UUID {
+	return c.orderID
+}
+
+func (c *RecalculateETACommand) setOrderID(orderID kernel.UUID) error {
+	if err := orderID.Validate(); err != nil {
+		return err
+	}
+
+	c.orderID = orderID
+	return nil
+}
@@ -0,0 +1,113 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tracing"
+)
+
+// RecalculateETACommandHandler refreshes the delivery estimate of an assigned order.
+// The estimate is the courier's travel time from its current location to the delivery
+// location through the stops of the order's route it has not called at yet, rounded up to whole turns, and is stored on the order. With pickup slots the turns
+// the courier waits for the slot of the order to start are added to the travel time.
+//
+// Example:
+//
+//	handler := NewRecalculateETACommandHandler(uowFactory)
+//	cmd, _ := NewRecalculateETACommand(orderID)
+//	eta, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    log.Printf("Failed to recalculate eta: %v", err)
+//	}
+type RecalculateETACommandHandler struct {
+	uowFactory UoWFactory
+
+	// pickupTurn is the length of a courier turn; zero when pickup slots are not used
+	pickupTurn time.Duration
+}
+
+// NewRecalculateETACommandHandler creates a new handler for ETA recalculation.
+// Requires a UoWFactory since the order and its courier are read together.
+func NewRecalculateETACommandHandler(uowFactory UoWFactory) RecalculateETACommandHandler {
+	return RecalculateETACommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// WithPickupSlots returns a copy of the handler that includes the wait for the pickup slot of
+// the order in the estimate. turn is the wall-clock length of one courier turn.
+func (h RecalculateETACommandHandler) WithPickupSlots(turn time.Duration) RecalculateETACommandHandler {
+	h.pickupTurn = turn
+	return h
+}
+
+// Handle processes the RecalculateETACommand within a transaction and returns the new estimate.
+// Returns order.ErrOrderIsNotAssigned when no courier is delivering the order.
+func (h *RecalculateETACommandHandler) Handle(
+	ctx context.Context,
+	cmd RecalculateETACommand,
+) (order.EstimatedArrival, error) {
+	ctx, span := tracing.Start(ctx, "RecalculateETACommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return order.EstimatedArrival{}, err
+	}
+
+	var eta order.EstimatedArrival
+	uow := h.uowFactory.Create()
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		orderRepo := uow.OrderRepository()
+		orderAggregate, err := orderRepo.Get(ctx, cmd.OrderID())
+		if err != nil {
+			return err
+		}
+
+		if orderAggregate.Status() != order.Assigned || orderAggregate.Courier() == nil {
+			return order.ErrOrderIsNotAssigned
+		}
+
+		courierAggregate, err := uow.CourierRepository().Get(ctx, *orderAggregate.Courier())
+		if err != nil {
+			return err
+		}
+
+		travelTime, err := courierAggregate.CalculateTimeAlongStops(orderAggregate.RemainingStops())
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		turns := int(math.Ceil(travelTime))
+		if h.pickupTurn > 0 {
+			slot, slotErr := uow.PickupSlotRepository().GetByOrder(ctx, orderAggregate.ID())
+			if slotErr != nil && !errors.Is(slotErr, errs.ErrObjectNotFound) {
+				return slotErr
+			}
+			if slotErr == nil {
+				turns += slot.WaitTurns(now, h.pickupTurn)
+			}
+		}
+
+		eta, err = order.NewEstimatedArrival(turns, now)
+		if err != nil {
+			return err
+		}
+
+		if err = orderAggregate.RecordEstimatedArrival(eta); err != nil {
+			return err
+		}
+
+		return orderRepo.Update(ctx, orderAggregate)
+	})
+	if err != nil {
+		return order.EstimatedArrival{}, err
+	}
+
+	return eta, nil
+}
@@ -0,0 +1,208 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/pickup"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecalculateETACommandHandler_Handle_Success(t *testing.T) {
+	ctx := t.Context()
+	// Manhattan distance from (1,1) to (5,5) is 8, at speed 2 that is 4 turns
+	c := createCourierAt(t, 1, 1)
+	orderAggregate := createAssignedOrder(t, c, 5)
+
+	orderRepo := new(MockAssignOrderRepository)
+	courierRepo := new(MockAssignCourierRepository)
+	uow := new(MockAssignUoW)
+	factory := new(MockAssignUoWFactory)
+
+	mock.InOrder(
+		factory.On("Create").Return(uow).Once(),
+		uow.On("Begin", ctx).Return(nil).Once(),
+		uow.On("OrderRepository").Return(orderRepo).Once(),
+		orderRepo.On("Get", ctx, orderAggregate.ID()).Return(orderAggregate, nil).Once(),
+		uow.On("CourierRepository").Return(courierRepo).Once(),
+		courierRepo.On("Get", ctx, c.ID()).Return(c, nil).Once(),
+		orderRepo.On("Update", ctx, orderAggregate).Return(nil).Once(),
+		uow.On("Commit", ctx).Return(nil).Once(),
+	)
+
+	cmd, err := commands.NewRecalculateETACommand(orderAggregate.ID())
+	require.NoError(t, err)
+
+	handler := commands.NewRecalculateETACommandHandler(factory)
+	eta, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, eta.Turns())
+	assert.False(t, eta.CalculatedAt().IsZero())
+	require.NotNil(t, orderAggregate.EstimatedArrival())
+	assert.Equal(t, eta.Turns(), orderAggregate.EstimatedArrival().Turns())
+	orderRepo.AssertExpectations(t)
+	courierRepo.AssertExpectations(t)
+	uow.AssertExpectations(t)
+}
+
+func TestRecalculateETACommandHandler_Handle_RoundsUpPartialTurns(t *testing.T) {
+	ctx := t.Context()
+	// Distance 7 at speed 2 takes 3.5 turns, the courier arrives on the 4th
+	c := createCourierAt(t, 2, 1)
+	orderAggregate := createAssignedOrder(t, c, 5)
+
+	orderRepo := new(MockAssignOrderRepository)
+	courierRepo := new(MockAssignCourierRepository)
+	uow := new(MockAssignUoW)
+	factory := new(MockAssignUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	uow.On("CourierRepository").Return(courierRepo).Once()
+	orderRepo.On("Get", ctx, orderAggregate.ID()).Return(orderAggregate, nil).Once()
+	courierRepo.On("Get", ctx, c.ID()).Return(c, nil).Once()
+	orderRepo.On("Update", ctx, orderAggregate).Return(nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewRecalculateETACommand(orderAggregate.ID())
+	require.NoError(t, err)
+
+	handler := commands.NewRecalculateETACommandHandler(factory)
+	eta, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, eta.Turns())
+}
+
+func TestRecalculateETACommandHandler_Handle_PickupSlots(t *testing.T) {
+	ctx := t.Context()
+
+	setup := func(t *testing.T) (*order.Order, *MockPickupSlotRepository, *MockAssignUoWFactory) {
+		t.Helper()
+		// Manhattan distance from (1,1) to (5,5) is 8, at speed 2 that is 4 turns
+		c := createCourierAt(t, 1, 1)
+		orderAggregate := createAssignedOrder(t, c, 5)
+
+		orderRepo := new(MockAssignOrderRepository)
+		courierRepo := new(MockAssignCourierRepository)
+		slotRepo := new(MockPickupSlotRepository)
+		uow := new(MockAssignUoW)
+		factory := new(MockAssignUoWFactory)
+
+		factory.On("Create").Return(uow).Once()
+		uow.On("Begin", ctx).Return(nil).Once()
+		uow.On("OrderRepository").Return(orderRepo).Once()
+		uow.On("CourierRepository").Return(courierRepo).Once()
+		uow.On("PickupSlotRepository").Return(slotRepo).Once()
+		orderRepo.On("Get", ctx, orderAggregate.ID()).Return(orderAggregate, nil).Once()
+		courierRepo.On("Get", ctx, c.ID()).Return(c, nil).Once()
+		orderRepo.On("Update", ctx, orderAggregate).Return(nil).Once()
+		uow.On("Commit", ctx).Return(nil).Once()
+		return orderAggregate, slotRepo, factory
+	}
+
+	t.Run("should add the wait for the slot to start", func(t *testing.T) {
+		orderAggregate, slotRepo, factory := setup(t)
+		// The slot starts in a little under 10 turns of a second
+		startsAt := time.Now().Add(9*time.Second + 500*time.Millisecond)
+		slot, err := pickup.RestoreSlot(kernel.NewUUID(), startsAt, startsAt.Add(time.Minute), 1,
+			[]kernel.UUID{orderAggregate.ID()})
+		require.NoError(t, err)
+		slotRepo.On("GetByOrder", ctx, orderAggregate.ID()).Return(slot, nil).Once()
+
+		cmd, err := commands.NewRecalculateETACommand(orderAggregate.ID())
+		require.NoError(t, err)
+
+		handler := commands.NewRecalculateETACommandHandler(factory).WithPickupSlots(time.Second)
+		eta, err := handler.Handle(ctx, cmd)
+
+		require.NoError(t, err)
+		assert.Equal(t, 14, eta.Turns())
+	})
+
+	t.Run("should only count travel for orders without a slot", func(t *testing.T) {
+		orderAggregate, slotRepo, factory := setup(t)
+		slotRepo.On("GetByOrder", ctx, orderAggregate.ID()).
+			Return(nil, errs.NewObjectNotFoundError("pickup slot", orderAggregate.ID())).Once()
+
+		cmd, err := commands.NewRecalculateETACommand(orderAggregate.ID())
+		require.NoError(t, err)
+
+		handler := commands.NewRecalculateETACommandHandler(factory).WithPickupSlots(time.Second)
+		eta, err := handler.Handle(ctx, cmd)
+
+		require.NoError(t, err)
+		assert.Equal(t, 4, eta.Turns())
+	})
+}
+
+func TestRecalculateETACommandHandler_Handle_OrderNotAssigned(t *testing.T) {
+	ctx := t.Context()
+	location, err := kernel.NewLocation(5, 5)
+	require.NoError(t, err)
+	orderAggregate, err := order.NewOrder(kernel.NewUUID(), location, 5)
+	require.NoError(t, err)
+
+	orderRepo := new(MockAssignOrderRepository)
+	uow := new(MockAssignUoW)
+	factory := new(MockAssignUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	orderRepo.On("Get", ctx, orderAggregate.ID()).Return(orderAggregate, nil).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewRecalculateETACommand(orderAggregate.ID())
+	require.NoError(t, err)
+
+	handler := commands.NewRecalculateETACommandHandler(factory)
+	_, err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, order.ErrOrderIsNotAssigned)
+	uow.AssertNotCalled(t, "CourierRepository")
+	orderRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestRecalculateETACommandHandler_Handle_OrderNotFound(t *testing.T) {
+	ctx := t.Context()
+	orderID := kernel.NewUUID()
+
+	orderRepo := new(MockAssignOrderRepository)
+	uow := new(MockAssignUoW)
+	factory := new(MockAssignUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	orderRepo.On("Get", ctx, orderID).Return(nil, errs.NewObjectNotFoundError("order", orderID)).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewRecalculateETACommand(orderID)
+	require.NoError(t, err)
+
+	handler := commands.NewRecalculateETACommandHandler(factory)
+	_, err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, errs.ErrObjectNotFound)
+	uow.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+func TestRecalculateETACommandHandler_Handle_NotConstructedCommand(t *testing.T) {
+	factory := new(MockAssignUoWFactory)
+
+	handler := commands.NewRecalculateETACommandHandler(factory)
+	_, err := handler.Handle(t.Context(), commands.RecalculateETACommand{})
+
+	require.ErrorIs(t, err, commands.ErrRecalculateETACommandIsNotConstructed)
+	factory.AssertNotCalled(t, "Create")
+}
@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrRecomputeMicrozonesCommandIsNotConstructed = errors.New(
+		"RecomputeMicrozonesCommand must be created via NewRecomputeMicrozonesCommand constructor",
+	)
+)
+
+// RecomputeMicrozonesCommand represents a request to cluster the delivery history into
+// dense-demand microzones, replacing the microzones computed before.
+//
+// Example:
+//
+//	cmd := NewRecomputeMicrozonesCommand()
+//	computed, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    return fmt.Errorf("failed to recompute microzones: %w", err)
+//	}
+//	fmt.Printf("%d microzones", computed)
+type RecomputeMicrozonesCommand struct {
+	guard guard.ConstructorGuard
+}
+
+// NewRecomputeMicrozonesCommand creates a command to recompute the microzones.
+// This is a parameterless command; the delivery history is read from storage.
+func NewRecomputeMicrozonesCommand() RecomputeMicrozonesCommand {
+	return RecomputeMicrozonesCommand{guard: guard.NewConstructorGuard()}
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrRecomputeMicrozonesCommandIsNotConstructed if validation fails.
+func (c RecomputeMicrozonesCommand) Validate() error {
+	return c.guard.Validate(ErrRecomputeMicrozonesCommandIsNotConstructed)
+}
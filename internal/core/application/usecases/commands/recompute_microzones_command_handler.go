@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/microzone"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tracing"
+)
+
+// RecomputeMicrozonesCommandHandler clusters the completed deliveries into dense-demand microzones
+// and replaces the stored microzones with the result. It is run nightly by the microzone job and
+// on demand through the admin API.
+//
+// Example:
+//
+//	clustering, _ := microzone.NewClustering(1, 50)
+//	handler := NewRecomputeMicrozonesCommandHandler(history, microzones, clustering)
+//	computed, err := handler.Handle(ctx, NewRecomputeMicrozonesCommand())
+//	if err != nil {
+//	    log.Printf("Failed to recompute microzones: %v", err)
+//	}
+type RecomputeMicrozonesCommandHandler struct {
+	history    ports.DeliveryHistoryReader
+	microzones ports.MicrozoneRepository
+	clustering microzone.Clustering
+}
+
+// NewRecomputeMicrozonesCommandHandler creates a handler that clusters the delivery history
+// read from history and stores the microzones in microzones.
+func NewRecomputeMicrozonesCommandHandler(
+	history ports.DeliveryHistoryReader,
+	microzones ports.MicrozoneRepository,
+	clustering microzone.Clustering,
+) RecomputeMicrozonesCommandHandler {
+	return RecomputeMicrozonesCommandHandler{
+		history:    history,
+		microzones: microzones,
+		clustering: clustering,
+	}
+}
+
+// Handle recomputes the microzones and returns how many were found.
+// Without dense demand the stored microzones are cleared.
+func (h *RecomputeMicrozonesCommandHandler) Handle(ctx context.Context, cmd RecomputeMicrozonesCommand) (int, error) {
+	ctx, span := tracing.Start(ctx, "RecomputeMicrozonesCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return 0, err
+	}
+
+	points, err := h.history.GetDeliveryPoints(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	microzones, err := h.clustering.Cluster(points, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	if err = h.microzones.ReplaceAll(ctx, microzones); err != nil {
+		return 0, err
+	}
+
+	return len(microzones), nil
+}
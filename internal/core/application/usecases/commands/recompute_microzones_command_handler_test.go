@@ -0,0 +1,130 @@
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/microzone"
+	"delivery/internal/core/domain/model/sla"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockDeliveryHistoryReader is a mock for ports.DeliveryHistoryReader.
+type MockDeliveryHistoryReader struct {
+	mock.Mock
+}
+
+func (m *MockDeliveryHistoryReader) GetDeliveryPoints(ctx context.Context) ([]microzone.DeliveryPoint, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]microzone.DeliveryPoint), args.Error(1)
+}
+
+func (m *MockDeliveryHistoryReader) GetCompletedDeliveries(
+	ctx context.Context,
+	from time.Time,
+	to time.Time,
+) ([]sla.Delivery, error) {
+	args := m.Called(ctx, from, to)
+	return args.Get(0).([]sla.Delivery), args.Error(1)
+}
+
+// MockMicrozoneRepository is a mock for ports.MicrozoneRepository.
+type MockMicrozoneRepository struct {
+	mock.Mock
+}
+
+func (m *MockMicrozoneRepository) ReplaceAll(ctx context.Context, microzones []*microzone.Microzone) error {
+	args := m.Called(ctx, microzones)
+	return args.Error(0)
+}
+
+func (m *MockMicrozoneRepository) GetAll(ctx context.Context) ([]*microzone.Microzone, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*microzone.Microzone), args.Error(1)
+}
+
+func deliveryPoint(x, y kernel.Coordinate, deliveries int) microzone.DeliveryPoint {
+	location, _ := kernel.NewLocation(x, y)
+	return microzone.DeliveryPoint{Location: location, Deliveries: deliveries}
+}
+
+func TestRecomputeMicrozonesCommandHandler_Handle_ReplacesMicrozones(t *testing.T) {
+	ctx := t.Context()
+	history := new(MockDeliveryHistoryReader)
+	repository := new(MockMicrozoneRepository)
+	clustering, _ := microzone.NewClustering(1, 10)
+
+	history.On("GetDeliveryPoints", ctx).Return([]microzone.DeliveryPoint{
+		deliveryPoint(2, 2, 8), deliveryPoint(3, 2, 4),
+		deliveryPoint(8, 8, 15),
+		deliveryPoint(5, 9, 1),
+	}, nil).Once()
+	repository.On("ReplaceAll", ctx, mock.MatchedBy(func(zones []*microzone.Microzone) bool {
+		return len(zones) == 2 && zones[0].Deliveries() == 15 && zones[1].Deliveries() == 12
+	})).Return(nil).Once()
+
+	handler := commands.NewRecomputeMicrozonesCommandHandler(history, repository, clustering)
+	computed, err := handler.Handle(ctx, commands.NewRecomputeMicrozonesCommand())
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, computed)
+	history.AssertExpectations(t)
+	repository.AssertExpectations(t)
+}
+
+func TestRecomputeMicrozonesCommandHandler_Handle_ClearsMicrozonesWithoutDemand(t *testing.T) {
+	ctx := t.Context()
+	history := new(MockDeliveryHistoryReader)
+	repository := new(MockMicrozoneRepository)
+	clustering, _ := microzone.NewClustering(1, 10)
+
+	history.On("GetDeliveryPoints", ctx).Return([]microzone.DeliveryPoint{}, nil).Once()
+	repository.On("ReplaceAll", ctx, mock.MatchedBy(func(zones []*microzone.Microzone) bool {
+		return len(zones) == 0
+	})).Return(nil).Once()
+
+	handler := commands.NewRecomputeMicrozonesCommandHandler(history, repository, clustering)
+	computed, err := handler.Handle(ctx, commands.NewRecomputeMicrozonesCommand())
+
+	require.NoError(t, err)
+	assert.Zero(t, computed)
+	repository.AssertExpectations(t)
+}
+
+func TestRecomputeMicrozonesCommandHandler_Handle_HistoryError(t *testing.T) {
+	ctx := t.Context()
+	history := new(MockDeliveryHistoryReader)
+	repository := new(MockMicrozoneRepository)
+	clustering, _ := microzone.NewClustering(1, 10)
+	failure := errors.New("connection lost")
+
+	history.On("GetDeliveryPoints", ctx).Return([]microzone.DeliveryPoint(nil), failure).Once()
+
+	handler := commands.NewRecomputeMicrozonesCommandHandler(history, repository, clustering)
+	_, err := handler.Handle(ctx, commands.NewRecomputeMicrozonesCommand())
+
+	require.ErrorIs(t, err, failure)
+	repository.AssertNotCalled(t, "ReplaceAll", mock.Anything, mock.Anything)
+}
+
+func TestRecomputeMicrozonesCommandHandler_Handle_InvalidCommand(t *testing.T) {
+	history := new(MockDeliveryHistoryReader)
+	repository := new(MockMicrozoneRepository)
+	clustering, _ := microzone.NewClustering(1, 10)
+
+	handler := commands.NewRecomputeMicrozonesCommandHandler(history, repository, clustering)
+	_, err := handler.Handle(t.Context(), commands.RecomputeMicrozonesCommand{})
+
+	require.ErrorIs(t, err, commands.ErrRecomputeMicrozonesCommandIsNotConstructed)
+	history.AssertNotCalled(t, "GetDeliveryPoints", mock.Anything)
+}
@@ -0,0 +1,21 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRecomputeMicrozonesCommand_Valid(t *testing.T) {
+	cmd := commands.NewRecomputeMicrozonesCommand()
+
+	require.NoError(t, cmd.Validate())
+}
+
+func TestRecomputeMicrozonesCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.RecomputeMicrozonesCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrRecomputeMicrozonesCommandIsNotConstructed)
+}
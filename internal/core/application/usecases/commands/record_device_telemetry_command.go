@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/device"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrRecordDeviceTelemetryCommandIsNotConstructed = errors.New(
+		"RecordDeviceTelemetryCommand must be created via NewRecordDeviceTelemetryCommand constructor",
+	)
+)
+
+// RecordDeviceTelemetryCommand represents a telemetry reading a courier app reports
+// periodically about its device.
+//
+// Example:
+//
+//	reading, err := device.NewReading(courierID, 42, false, device.Good, time.Now())
+//	if err != nil {
+//	    return fmt.Errorf("invalid reading: %w", err)
+//	}
+//
+//	cmd, _ := NewRecordDeviceTelemetryCommand(reading)
+//	handler := NewRecordDeviceTelemetryCommandHandler(telemetry, policy, observer)
+//	health, err := handler.Handle(ctx, cmd)
+type RecordDeviceTelemetryCommand struct { //nolint:recvcheck //using for validation
+	reading device.Reading
+
+	guard guard.ConstructorGuard
+}
+
+// NewRecordDeviceTelemetryCommand creates a command to record the reading.
+// Returns an error if the reading was not created through its constructor.
+func NewRecordDeviceTelemetryCommand(reading device.Reading) (RecordDeviceTelemetryCommand, error) {
+	if err := errs.JoinFields(errs.Field("reading", reading.Validate())); err != nil {
+		return RecordDeviceTelemetryCommand{}, err
+	}
+
+	return RecordDeviceTelemetryCommand{reading: reading, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrRecordDeviceTelemetryCommandIsNotConstructed if validation fails.
+func (c RecordDeviceTelemetryCommand) Validate() error {
+	return c.guard.Validate(ErrRecordDeviceTelemetryCommandIsNotConstructed)
+}
+
+// Reading returns the reported reading.
+func (c RecordDeviceTelemetryCommand) Reading() device.Reading {
+	return c.reading
+}
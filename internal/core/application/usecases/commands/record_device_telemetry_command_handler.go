@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/device"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tracing"
+)
+
+// maxTelemetryClockSkew is how far ahead of the server clock a reading may be taken.
+// Readings further in the future would mask every later one as the latest.
+const maxTelemetryClockSkew = time.Minute
+
+// DeviceHealthObserver is notified whenever a courier's device degrades, recovers or
+// degrades for another reason, so operations can reach the courier.
+type DeviceHealthObserver interface {
+	DeviceHealthChanged(ctx context.Context, courierID kernel.UUID, health device.Health)
+}
+
+// RecordDeviceTelemetryCommandHandler stores the readings courier apps report in the rolling
+// window of their courier and reports changes of the device health to the observer.
+//
+// Example:
+//
+//	handler := NewRecordDeviceTelemetryCommandHandler(telemetry, policy, observer)
+//	health, err := handler.Handle(ctx, cmd)
+//	if err == nil && health.IsDegraded() {
+//	    log.Println("Courier receives no new orders until the device recovers")
+//	}
+type RecordDeviceTelemetryCommandHandler struct {
+	telemetry ports.DeviceTelemetryRepository
+	policy    device.HealthPolicy
+	observer  DeviceHealthObserver
+}
+
+// NewRecordDeviceTelemetryCommandHandler creates a handler for device telemetry.
+func NewRecordDeviceTelemetryCommandHandler(
+	telemetry ports.DeviceTelemetryRepository,
+	policy device.HealthPolicy,
+	observer DeviceHealthObserver,
+) RecordDeviceTelemetryCommandHandler {
+	return RecordDeviceTelemetryCommandHandler{
+		telemetry: telemetry,
+		policy:    policy,
+		observer:  observer,
+	}
+}
+
+// Handle records the reading, drops the courier's readings that left the window and returns
+// the device health including the reading. Readings taken more than a minute in the future
+// are refused. Returns an ObjectNotFoundError if the courier does not exist.
+func (h RecordDeviceTelemetryCommandHandler) Handle(
+	ctx context.Context,
+	cmd RecordDeviceTelemetryCommand,
+) (device.Health, error) {
+	ctx, span := tracing.Start(ctx, "RecordDeviceTelemetryCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return device.Health{}, err
+	}
+
+	reading := cmd.Reading()
+	now := time.Now()
+	if reading.RecordedAt().After(now.Add(maxTelemetryClockSkew)) {
+		return device.Health{}, errs.JoinFields(errs.Field("recordedAt", errs.NewValueIsInvalidErrorWithCause(
+			"recordedAt is invalid",
+			fmt.Errorf("%s is in the future", reading.RecordedAt().Format(time.RFC3339)),
+		)))
+	}
+
+	windowStart := h.policy.WindowStart(now)
+	readings, err := h.telemetry.GetSince(ctx, windowStart, reading.CourierID())
+	if err != nil {
+		return device.Health{}, err
+	}
+
+	if err = h.telemetry.Add(ctx, reading, windowStart); err != nil {
+		return device.Health{}, err
+	}
+
+	previous := h.policy.Assess(readings, now)
+	current := h.policy.Assess(append(readings, reading), now)
+	if current.LowBattery != previous.LowBattery || current.PoorConnectivity != previous.PoorConnectivity {
+		h.observer.DeviceHealthChanged(ctx, reading.CourierID(), current)
+	}
+
+	return current, nil
+}
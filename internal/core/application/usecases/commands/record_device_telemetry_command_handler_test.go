@@ -0,0 +1,151 @@
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/device"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockDeviceTelemetryRepository is a mock for ports.DeviceTelemetryRepository.
+type MockDeviceTelemetryRepository struct {
+	mock.Mock
+}
+
+func (m *MockDeviceTelemetryRepository) Add(ctx context.Context, reading device.Reading, windowStart time.Time) error {
+	args := m.Called(ctx, reading, windowStart)
+	return args.Error(0)
+}
+
+func (m *MockDeviceTelemetryRepository) GetSince(
+	ctx context.Context,
+	since time.Time,
+	courierIDs ...kernel.UUID,
+) ([]device.Reading, error) {
+	args := m.Called(ctx, since, courierIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]device.Reading), args.Error(1)
+}
+
+// MockDeviceHealthObserver records every reported device health change.
+type MockDeviceHealthObserver struct {
+	changes []device.Health
+}
+
+func (m *MockDeviceHealthObserver) DeviceHealthChanged(_ context.Context, _ kernel.UUID, health device.Health) {
+	m.changes = append(m.changes, health)
+}
+
+func deviceReading(t *testing.T, courierID kernel.UUID, battery int, connectivity device.Connectivity, ago time.Duration) device.Reading {
+	t.Helper()
+	reading, err := device.NewReading(courierID, battery, false, connectivity, time.Now().Add(-ago))
+	require.NoError(t, err)
+	return reading
+}
+
+func recordDeviceTelemetry(t *testing.T, reading device.Reading) commands.RecordDeviceTelemetryCommand {
+	t.Helper()
+	cmd, err := commands.NewRecordDeviceTelemetryCommand(reading)
+	require.NoError(t, err)
+	return cmd
+}
+
+func TestRecordDeviceTelemetryCommandHandler_Handle_StoresReadingOfHealthyDevice(t *testing.T) {
+	ctx := t.Context()
+	courierID := kernel.NewUUID()
+	telemetry := new(MockDeviceTelemetryRepository)
+	observer := new(MockDeviceHealthObserver)
+	policy, _ := device.NewHealthPolicy(20, 10*time.Minute)
+	reading := deviceReading(t, courierID, 80, device.Good, 0)
+
+	telemetry.On("GetSince", ctx, mock.Anything, []kernel.UUID{courierID}).
+		Return([]device.Reading{deviceReading(t, courierID, 82, device.Good, time.Minute)}, nil).Once()
+	telemetry.On("Add", ctx, reading, mock.Anything).Return(nil).Once()
+
+	handler := commands.NewRecordDeviceTelemetryCommandHandler(telemetry, policy, observer)
+	health, err := handler.Handle(ctx, recordDeviceTelemetry(t, reading))
+
+	require.NoError(t, err)
+	assert.True(t, health.Reported)
+	assert.Equal(t, 80, health.Battery)
+	assert.False(t, health.IsDegraded())
+	assert.Empty(t, observer.changes)
+	telemetry.AssertExpectations(t)
+}
+
+func TestRecordDeviceTelemetryCommandHandler_Handle_ReportsDegradationAndRecovery(t *testing.T) {
+	ctx := t.Context()
+	courierID := kernel.NewUUID()
+	telemetry := new(MockDeviceTelemetryRepository)
+	observer := new(MockDeviceHealthObserver)
+	policy, _ := device.NewHealthPolicy(20, 10*time.Minute)
+	low := deviceReading(t, courierID, 15, device.Good, 0)
+	charged := deviceReading(t, courierID, 25, device.Good, 0)
+
+	telemetry.On("GetSince", ctx, mock.Anything, []kernel.UUID{courierID}).
+		Return([]device.Reading{}, nil).Once()
+	telemetry.On("GetSince", ctx, mock.Anything, []kernel.UUID{courierID}).
+		Return([]device.Reading{deviceReading(t, courierID, 15, device.Good, time.Minute)}, nil).Once()
+	telemetry.On("Add", ctx, mock.Anything, mock.Anything).Return(nil)
+
+	handler := commands.NewRecordDeviceTelemetryCommandHandler(telemetry, policy, observer)
+	_, err := handler.Handle(ctx, recordDeviceTelemetry(t, low))
+	require.NoError(t, err)
+	_, err = handler.Handle(ctx, recordDeviceTelemetry(t, charged))
+	require.NoError(t, err)
+
+	require.Len(t, observer.changes, 2)
+	assert.True(t, observer.changes[0].LowBattery)
+	assert.False(t, observer.changes[1].IsDegraded())
+}
+
+func TestRecordDeviceTelemetryCommandHandler_Handle_RefusesReadingFromTheFuture(t *testing.T) {
+	telemetry := new(MockDeviceTelemetryRepository)
+	policy, _ := device.NewHealthPolicy(20, 10*time.Minute)
+	reading := deviceReading(t, kernel.NewUUID(), 80, device.Good, -time.Hour)
+
+	handler := commands.NewRecordDeviceTelemetryCommandHandler(telemetry, policy, new(MockDeviceHealthObserver))
+	_, err := handler.Handle(t.Context(), recordDeviceTelemetry(t, reading))
+
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	telemetry.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRecordDeviceTelemetryCommandHandler_Handle_UnknownCourier(t *testing.T) {
+	ctx := t.Context()
+	courierID := kernel.NewUUID()
+	telemetry := new(MockDeviceTelemetryRepository)
+	observer := new(MockDeviceHealthObserver)
+	policy, _ := device.NewHealthPolicy(20, 10*time.Minute)
+	notFound := errs.NewObjectNotFoundError("courier", courierID)
+
+	telemetry.On("GetSince", ctx, mock.Anything, []kernel.UUID{courierID}).Return([]device.Reading{}, nil).Once()
+	telemetry.On("Add", ctx, mock.Anything, mock.Anything).Return(notFound).Once()
+
+	handler := commands.NewRecordDeviceTelemetryCommandHandler(telemetry, policy, observer)
+	_, err := handler.Handle(ctx, recordDeviceTelemetry(t, deviceReading(t, courierID, 5, device.Offline, 0)))
+
+	require.True(t, errors.Is(err, errs.ErrObjectNotFound))
+	assert.Empty(t, observer.changes)
+}
+
+func TestRecordDeviceTelemetryCommandHandler_Handle_InvalidCommand(t *testing.T) {
+	telemetry := new(MockDeviceTelemetryRepository)
+	policy, _ := device.NewHealthPolicy(20, 10*time.Minute)
+
+	handler := commands.NewRecordDeviceTelemetryCommandHandler(telemetry, policy, new(MockDeviceHealthObserver))
+	_, err := handler.Handle(t.Context(), commands.RecordDeviceTelemetryCommand{})
+
+	require.ErrorIs(t, err, commands.ErrRecordDeviceTelemetryCommandIsNotConstructed)
+}
@@ -0,0 +1,39 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/device"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRecordDeviceTelemetryCommand_ValidInput(t *testing.T) {
+	reading, err := device.NewReading(kernel.NewUUID(), 42, false, device.Good, time.Now())
+	require.NoError(t, err)
+
+	cmd, err := commands.NewRecordDeviceTelemetryCommand(reading)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, reading, cmd.Reading())
+}
+
+func TestNewRecordDeviceTelemetryCommand_InvalidInput(t *testing.T) {
+	_, err := commands.NewRecordDeviceTelemetryCommand(device.Reading{})
+
+	require.ErrorIs(t, err, device.ErrReadingIsNotConstructed)
+	var validation *errs.ValidationErrors
+	require.ErrorAs(t, err, &validation)
+	assert.Equal(t, "reading", validation.Fields[0].Field)
+}
+
+func TestRecordDeviceTelemetryCommand_NotConstructed(t *testing.T) {
+	require.ErrorIs(t, commands.RecordDeviceTelemetryCommand{}.Validate(),
+		commands.ErrRecordDeviceTelemetryCommandIsNotConstructed)
+}
@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/guard"
+)
+
+var ErrRelayOutboxCommandIsNotConstructed = errors.New(
+	"RelayOutboxCommand must be created via NewRelayOutboxCommand constructor",
+)
+
+// RelayOutboxCommand represents a request to publish the outbox messages not published yet.
+// Sent by the outbox relay job on every tick.
+//
+// Example:
+//
+//	cmd, err := NewRelayOutboxCommand(100)
+//	if err != nil {
+//	    return fmt.Errorf("invalid relay parameters: %w", err)
+//	}
+//
+//	published, err := handler.Handle(ctx, cmd)
+type RelayOutboxCommand struct { //nolint:recvcheck //using for validation
+	batchSize int
+
+	guard guard.ConstructorGuard
+}
+
+// NewRelayOutboxCommand creates a command to relay outbox messages.
+// batchSize must be positive.
+func NewRelayOutboxCommand(batchSize int) (RelayOutboxCommand, error) {
+	command := RelayOutboxCommand{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := command.setBatchSize(batchSize); err != nil {
+		return RelayOutboxCommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrRelayOutboxCommandIsNotConstructed if validation fails.
+func (c RelayOutboxCommand) Validate() error {
+	return c.guard.Validate(ErrRelayOutboxCommandIsNotConstructed)
+}
+
+// BatchSize returns the maximum number of messages published by one run.
+func (c RelayOutboxCommand) BatchSize() int {
+	return c.batchSize
+}
+
+func (c *RelayOutboxCommand) setBatchSize(batchSize int) error {
+	if batchSize <= 0 {
+		return ErrBatchSizeIsInvalid
+	}
+
+	c.batchSize = batchSize
+	return nil
+}
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tracing"
+)
+
+// RelayOutboxCommandHandler publishes the outbox messages not published yet to the message broker.
+//
+// Delivery is at least once: a message is marked processed only after the broker acknowledged it,
+// so a message published right before a crash or a failed mark is published again on the next run.
+// Consumers drop the duplicates by message ID. Messages are published one at a time in
+// (occurred_at, id) order, and the run stops at the first failure, so the events of an aggregate
+// never overtake each other.
+//
+// Example:
+//
+//	handler := NewRelayOutboxCommandHandler(outboxRepo, publisher)
+//	cmd, _ := NewRelayOutboxCommand(100)
+//	published, err := handler.Handle(ctx, cmd)
+type RelayOutboxCommandHandler struct {
+	queue     ports.OutboxQueue
+	publisher ports.EventPublisher
+}
+
+// NewRelayOutboxCommandHandler creates a handler for the outbox relay.
+func NewRelayOutboxCommandHandler(queue ports.OutboxQueue, publisher ports.EventPublisher) RelayOutboxCommandHandler {
+	return RelayOutboxCommandHandler{
+		queue:     queue,
+		publisher: publisher,
+	}
+}
+
+// Handle publishes up to the command's batch size of the oldest queued messages; a backlog
+// drains over several runs, which keeps every run short.
+// Returns the number of messages published, also when it stops on an error.
+func (h *RelayOutboxCommandHandler) Handle(ctx context.Context, cmd RelayOutboxCommand) (int, error) {
+	ctx, span := tracing.Start(ctx, "RelayOutboxCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return 0, err
+	}
+
+	batch, err := h.queue.GetUnprocessed(ctx, cmd.BatchSize())
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	for _, message := range batch {
+		if err = h.publisher.Publish(ctx, message); err != nil {
+			return published, err
+		}
+		if err = h.queue.MarkProcessed(ctx, message.ID, time.Now()); err != nil {
+			return published, err
+		}
+		published++
+	}
+
+	return published, nil
+}
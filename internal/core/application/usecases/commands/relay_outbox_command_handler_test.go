@@ -0,0 +1,115 @@
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockOutboxQueue is an in-memory ports.OutboxQueue.
+type MockOutboxQueue struct {
+	messages  []ports.OutboxMessage
+	processed map[kernel.UUID]bool
+	markErr   error
+}
+
+func newMockOutboxQueue(messages []ports.OutboxMessage) *MockOutboxQueue {
+	return &MockOutboxQueue{messages: messages, processed: make(map[kernel.UUID]bool)}
+}
+
+func (q *MockOutboxQueue) GetUnprocessed(_ context.Context, limit int) ([]ports.OutboxMessage, error) {
+	var batch []ports.OutboxMessage
+	for _, message := range q.messages {
+		if len(batch) == limit {
+			break
+		}
+		if !q.processed[message.ID] {
+			batch = append(batch, message)
+		}
+	}
+	return batch, nil
+}
+
+func (q *MockOutboxQueue) MarkProcessed(_ context.Context, id kernel.UUID, _ time.Time) error {
+	if q.markErr != nil {
+		return q.markErr
+	}
+	q.processed[id] = true
+	return nil
+}
+
+func TestRelayOutboxCommandHandler_Handle_PublishesOldestBatchInOrder(t *testing.T) {
+	messages := createOutboxMessages(time.Now(), 3)
+	queue := newMockOutboxQueue(messages)
+	publisher := &MockEventPublisher{}
+
+	cmd, err := commands.NewRelayOutboxCommand(2)
+	require.NoError(t, err)
+	handler := commands.NewRelayOutboxCommandHandler(queue, publisher)
+
+	published, err := handler.Handle(t.Context(), cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, published)
+	assert.Equal(t, messages[:2], publisher.published)
+
+	published, err = handler.Handle(t.Context(), cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, published)
+	assert.Equal(t, messages, publisher.published)
+	assert.Len(t, queue.processed, 3)
+}
+
+func TestRelayOutboxCommandHandler_Handle_PublishErrorKeepsMessageQueued(t *testing.T) {
+	publishErr := errors.New("broker unavailable")
+	queue := newMockOutboxQueue(createOutboxMessages(time.Now(), 2))
+
+	cmd, err := commands.NewRelayOutboxCommand(10)
+	require.NoError(t, err)
+
+	handler := commands.NewRelayOutboxCommandHandler(queue, &MockEventPublisher{err: publishErr})
+	published, err := handler.Handle(t.Context(), cmd)
+
+	require.ErrorIs(t, err, publishErr)
+	assert.Equal(t, 0, published)
+	assert.Empty(t, queue.processed)
+}
+
+func TestRelayOutboxCommandHandler_Handle_RepublishesMessageNotMarked(t *testing.T) {
+	markErr := errors.New("connection lost")
+	messages := createOutboxMessages(time.Now(), 1)
+	queue := newMockOutboxQueue(messages)
+	queue.markErr = markErr
+	publisher := &MockEventPublisher{}
+
+	cmd, err := commands.NewRelayOutboxCommand(10)
+	require.NoError(t, err)
+	handler := commands.NewRelayOutboxCommandHandler(queue, publisher)
+
+	_, err = handler.Handle(t.Context(), cmd)
+	require.ErrorIs(t, err, markErr)
+
+	queue.markErr = nil
+	published, err := handler.Handle(t.Context(), cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, published)
+	assert.Equal(t, []ports.OutboxMessage{messages[0], messages[0]}, publisher.published)
+}
+
+func TestRelayOutboxCommandHandler_Handle_InvalidCommand(t *testing.T) {
+	handler := commands.NewRelayOutboxCommandHandler(newMockOutboxQueue(nil), &MockEventPublisher{})
+
+	_, err := handler.Handle(t.Context(), commands.RelayOutboxCommand{})
+
+	require.ErrorIs(t, err, commands.ErrRelayOutboxCommandIsNotConstructed)
+}
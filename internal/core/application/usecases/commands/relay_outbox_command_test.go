@@ -0,0 +1,30 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRelayOutboxCommand_ValidInput(t *testing.T) {
+	cmd, err := commands.NewRelayOutboxCommand(100)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, 100, cmd.BatchSize())
+}
+
+func TestNewRelayOutboxCommand_InvalidBatchSize(t *testing.T) {
+	_, err := commands.NewRelayOutboxCommand(0)
+
+	require.ErrorIs(t, err, commands.ErrBatchSizeIsInvalid)
+}
+
+func TestRelayOutboxCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.RelayOutboxCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrRelayOutboxCommandIsNotConstructed)
+}
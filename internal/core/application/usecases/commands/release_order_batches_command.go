@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrReleaseOrderBatchesCommandIsNotConstructed = errors.New(
+		"ReleaseOrderBatchesCommand must be created via NewReleaseOrderBatchesCommand constructor",
+	)
+)
+
+// ReleaseOrderBatchesCommand represents a request to let economy orders whose batching window
+// has closed enter dispatch.
+//
+// Example:
+//
+//	cmd := NewReleaseOrderBatchesCommand()
+//	released, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    return fmt.Errorf("failed to release batches: %w", err)
+//	}
+//	fmt.Printf("%d economy orders entered dispatch", released)
+type ReleaseOrderBatchesCommand struct {
+	guard guard.ConstructorGuard
+}
+
+// NewReleaseOrderBatchesCommand creates a command to release closed economy batches.
+// This is a parameterless command; the batches are read from storage.
+func NewReleaseOrderBatchesCommand() ReleaseOrderBatchesCommand {
+	return ReleaseOrderBatchesCommand{guard: guard.NewConstructorGuard()}
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrReleaseOrderBatchesCommandIsNotConstructed if validation fails.
+func (c ReleaseOrderBatchesCommand) Validate() error {
+	return c.guard.Validate(ErrReleaseOrderBatchesCommandIsNotConstructed)
+}
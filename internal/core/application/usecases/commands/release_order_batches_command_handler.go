@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/pkg/tracing"
+)
+
+// ReleaseOrderBatchesCommandHandler lets economy orders enter dispatch once their batching window
+// has closed. All orders of a batch are released together, so the assignment job can put several
+// of them on one courier's trip.
+//
+// Example:
+//
+//	handler := NewReleaseOrderBatchesCommandHandler(uowFactory)
+//
+//	// Called periodically by the batching job
+//	released, err := handler.Handle(ctx, NewReleaseOrderBatchesCommand())
+//	if err != nil {
+//	    return fmt.Errorf("release failed: %w", err)
+//	}
+type ReleaseOrderBatchesCommandHandler struct {
+	uowFactory OrderUoWFactory
+}
+
+// NewReleaseOrderBatchesCommandHandler creates a handler for releasing economy batches.
+// Requires an OrderUoWFactory for transactional persistence.
+func NewReleaseOrderBatchesCommandHandler(uowFactory OrderUoWFactory) ReleaseOrderBatchesCommandHandler {
+	return ReleaseOrderBatchesCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle releases every economy order whose batching window has closed within a transaction
+// and returns how many orders entered dispatch. Orders whose window is still open are left unchanged.
+func (h *ReleaseOrderBatchesCommandHandler) Handle(ctx context.Context, cmd ReleaseOrderBatchesCommand) (int, error) {
+	ctx, span := tracing.Start(ctx, "ReleaseOrderBatchesCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return 0, err
+	}
+
+	released := 0
+	uow := h.uowFactory.Create()
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		orderRepo := uow.OrderRepository()
+		orders, err := orderRepo.GetAllAwaitingBatch(ctx)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		for _, o := range orders {
+			if !o.ReleaseBatch(now) {
+				continue
+			}
+
+			if err = orderRepo.Update(ctx, o); err != nil {
+				return err
+			}
+			released++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return released, nil
+}
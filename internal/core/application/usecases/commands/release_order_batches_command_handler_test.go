@@ -0,0 +1,78 @@
+package commands_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createEconomyOrder(t *testing.T, createdAt time.Time) *order.Order {
+	t.Helper()
+	window, err := order.NewBatchingWindow(30 * time.Minute)
+	require.NoError(t, err)
+	o := createOrderForThread(t)
+	require.NoError(t, o.ChangeDeliveryTier(order.EconomyDelivery, window, createdAt))
+	return o
+}
+
+func TestReleaseOrderBatchesCommandHandler_Handle_Success(t *testing.T) {
+	ctx := t.Context()
+	closed := createEconomyOrder(t, time.Now().Add(-time.Hour))
+	open := createEconomyOrder(t, time.Now())
+
+	repo := new(MoveOrderRepo)
+	uow := new(MockOrderUoW)
+	factory := new(MockOrderUoWFactory)
+
+	mock.InOrder(
+		factory.On("Create").Return(uow).Once(),
+		uow.On("Begin", ctx).Return(nil).Once(),
+		uow.On("OrderRepository").Return(repo).Once(),
+		repo.On("GetAllAwaitingBatch", ctx).Return([]*order.Order{closed, open}, nil).Once(),
+		repo.On("Update", ctx, closed).Return(nil).Once(),
+		uow.On("Commit", ctx).Return(nil).Once(),
+	)
+
+	handler := commands.NewReleaseOrderBatchesCommandHandler(factory)
+	released, err := handler.Handle(ctx, commands.NewReleaseOrderBatchesCommand())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, released)
+	require.NoError(t, closed.ValidateAssign())
+	require.ErrorIs(t, open.ValidateAssign(), order.ErrOrderIsAwaitingBatch)
+	repo.AssertExpectations(t)
+	uow.AssertExpectations(t)
+}
+
+func TestReleaseOrderBatchesCommandHandler_Handle_RepositoryError(t *testing.T) {
+	ctx := t.Context()
+	repo := new(MoveOrderRepo)
+	uow := new(MockOrderUoW)
+	factory := new(MockOrderUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(repo).Once()
+	repo.On("GetAllAwaitingBatch", ctx).Return(nil, errors.New("db error")).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	handler := commands.NewReleaseOrderBatchesCommandHandler(factory)
+	_, err := handler.Handle(ctx, commands.NewReleaseOrderBatchesCommand())
+
+	require.Error(t, err)
+	uow.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+func TestReleaseOrderBatchesCommandHandler_Handle_NotConstructed(t *testing.T) {
+	handler := commands.NewReleaseOrderBatchesCommandHandler(new(MockOrderUoWFactory))
+	_, err := handler.Handle(t.Context(), commands.ReleaseOrderBatchesCommand{})
+
+	require.ErrorIs(t, err, commands.ErrReleaseOrderBatchesCommandIsNotConstructed)
+}
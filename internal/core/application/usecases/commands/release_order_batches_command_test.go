@@ -0,0 +1,21 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReleaseOrderBatchesCommand_Valid(t *testing.T) {
+	cmd := commands.NewReleaseOrderBatchesCommand()
+
+	require.NoError(t, cmd.Validate())
+}
+
+func TestReleaseOrderBatchesCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.ReleaseOrderBatchesCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrReleaseOrderBatchesCommandIsNotConstructed)
+}
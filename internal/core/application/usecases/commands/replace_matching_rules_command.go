@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"errors"
+	"strconv"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/matching"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrReplaceMatchingRulesCommandIsNotConstructed = errors.New(
+		"ReplaceMatchingRulesCommand must be created via NewReplaceMatchingRulesCommand constructor",
+	)
+)
+
+// MatchingRuleSpec describes a rule of ReplaceMatchingRulesCommand: its name, the orders it
+// applies to and what a courier must offer to take them.
+type MatchingRuleSpec struct {
+	Name        string
+	Condition   matching.Condition
+	Requirement matching.Requirement
+}
+
+// ReplaceMatchingRulesCommand represents an operator's request to replace the dispatch
+// matching rules as a whole. An empty command removes every rule.
+//
+// Example:
+//
+//	above := 20
+//	cmd, err := NewReplaceMatchingRulesCommand([]MatchingRuleSpec{{
+//	    Name:        "Крупные заказы",
+//	    Condition:   matching.Condition{VolumeAbove: &above},
+//	    Requirement: matching.Requirement{VehicleTypes: []courier.VehicleType{courier.Car}},
+//	}})
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	rules, err := handler.Handle(ctx, cmd)
+type ReplaceMatchingRulesCommand struct {
+	rules []*matching.Rule
+
+	guard guard.ConstructorGuard
+}
+
+// NewReplaceMatchingRulesCommand creates a command to replace the matching rules.
+// Returns a validation error naming the invalid fields of every rule, or
+// matching.ErrRuleIsDuplicated if two rules share a name.
+func NewReplaceMatchingRulesCommand(specs []MatchingRuleSpec) (ReplaceMatchingRulesCommand, error) {
+	rules := make([]*matching.Rule, 0, len(specs))
+	var validation errs.ValidationErrors
+	for i, spec := range specs {
+		rule, err := matching.NewRule(kernel.NewUUID(), spec.Name, spec.Condition, spec.Requirement)
+		if err != nil {
+			validation.Add("rules."+strconv.Itoa(i), err)
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	if err := validation.Err(); err != nil {
+		return ReplaceMatchingRulesCommand{}, err
+	}
+
+	if err := matching.CheckRules(rules); err != nil {
+		return ReplaceMatchingRulesCommand{}, errs.JoinFields(errs.Field("rules", err))
+	}
+
+	return ReplaceMatchingRulesCommand{rules: rules, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrReplaceMatchingRulesCommandIsNotConstructed if validation fails.
+func (c ReplaceMatchingRulesCommand) Validate() error {
+	return c.guard.Validate(ErrReplaceMatchingRulesCommandIsNotConstructed)
+}
+
+// Rules returns the rules replacing the current ones.
+func (c ReplaceMatchingRulesCommand) Rules() []*matching.Rule {
+	return c.rules
+}
@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/matching"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tracing"
+)
+
+// ReplaceMatchingRulesCommandHandler replaces the dispatch matching rules. The new rules
+// apply from the next assignment; orders already assigned keep their couriers.
+//
+// Example:
+//
+//	handler := NewReplaceMatchingRulesCommandHandler(rules)
+//	replaced, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    log.Printf("Failed to replace matching rules: %v", err)
+//	}
+type ReplaceMatchingRulesCommandHandler struct {
+	rules ports.MatchingRuleRepository
+}
+
+// NewReplaceMatchingRulesCommandHandler creates a handler that stores the matching rules in rules.
+func NewReplaceMatchingRulesCommandHandler(rules ports.MatchingRuleRepository) ReplaceMatchingRulesCommandHandler {
+	return ReplaceMatchingRulesCommandHandler{rules: rules}
+}
+
+// Handle replaces the stored rules and returns the new ones.
+func (h ReplaceMatchingRulesCommandHandler) Handle(
+	ctx context.Context,
+	cmd ReplaceMatchingRulesCommand,
+) ([]*matching.Rule, error) {
+	ctx, span := tracing.Start(ctx, "ReplaceMatchingRulesCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := h.rules.ReplaceRules(ctx, cmd.Rules()); err != nil {
+		return nil, err
+	}
+
+	return cmd.Rules(), nil
+}
@@ -0,0 +1,75 @@
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/matching"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockMatchingRuleRepository is a mock for ports.MatchingRuleRepository.
+type MockMatchingRuleRepository struct {
+	mock.Mock
+}
+
+func (m *MockMatchingRuleRepository) ReplaceRules(ctx context.Context, rules []*matching.Rule) error {
+	args := m.Called(ctx, rules)
+	return args.Error(0)
+}
+
+func (m *MockMatchingRuleRepository) GetRules(ctx context.Context) ([]*matching.Rule, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*matching.Rule), args.Error(1)
+}
+
+func TestReplaceMatchingRulesCommandHandler_Handle_ReplacesRules(t *testing.T) {
+	ctx := t.Context()
+	repository := new(MockMatchingRuleRepository)
+	cmd, err := commands.NewReplaceMatchingRulesCommand([]commands.MatchingRuleSpec{
+		{Name: "Thermal", Requirement: matching.Requirement{ThermalStorage: true}},
+	})
+	require.NoError(t, err)
+
+	repository.On("ReplaceRules", ctx, cmd.Rules()).Return(nil).Once()
+
+	handler := commands.NewReplaceMatchingRulesCommandHandler(repository)
+	rules, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, cmd.Rules(), rules)
+	repository.AssertExpectations(t)
+}
+
+func TestReplaceMatchingRulesCommandHandler_Handle_RepositoryError(t *testing.T) {
+	ctx := t.Context()
+	repository := new(MockMatchingRuleRepository)
+	failure := errors.New("connection lost")
+	cmd, err := commands.NewReplaceMatchingRulesCommand(nil)
+	require.NoError(t, err)
+
+	repository.On("ReplaceRules", ctx, mock.Anything).Return(failure).Once()
+
+	handler := commands.NewReplaceMatchingRulesCommandHandler(repository)
+	_, err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, failure)
+}
+
+func TestReplaceMatchingRulesCommandHandler_Handle_InvalidCommand(t *testing.T) {
+	repository := new(MockMatchingRuleRepository)
+
+	handler := commands.NewReplaceMatchingRulesCommandHandler(repository)
+	_, err := handler.Handle(t.Context(), commands.ReplaceMatchingRulesCommand{})
+
+	require.ErrorIs(t, err, commands.ErrReplaceMatchingRulesCommandIsNotConstructed)
+	repository.AssertNotCalled(t, "ReplaceRules", mock.Anything, mock.Anything)
+}
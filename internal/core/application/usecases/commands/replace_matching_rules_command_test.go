@@ -0,0 +1,66 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/matching"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReplaceMatchingRulesCommand_Valid(t *testing.T) {
+	above := 20
+	cmd, err := commands.NewReplaceMatchingRulesCommand([]commands.MatchingRuleSpec{
+		{
+			Name:        "Large orders",
+			Condition:   matching.Condition{VolumeAbove: &above},
+			Requirement: matching.Requirement{VehicleTypes: []courier.VehicleType{courier.Car}},
+		},
+		{Name: "Thermal", Requirement: matching.Requirement{ThermalStorage: true}},
+	})
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	require.Len(t, cmd.Rules(), 2)
+	assert.Equal(t, "Large orders", cmd.Rules()[0].Name())
+	assert.NotEqual(t, cmd.Rules()[0].ID(), cmd.Rules()[1].ID())
+}
+
+func TestNewReplaceMatchingRulesCommand_NoRules(t *testing.T) {
+	cmd, err := commands.NewReplaceMatchingRulesCommand(nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, cmd.Rules())
+}
+
+func TestNewReplaceMatchingRulesCommand_InvalidRule(t *testing.T) {
+	_, err := commands.NewReplaceMatchingRulesCommand([]commands.MatchingRuleSpec{
+		{Name: "Thermal", Requirement: matching.Requirement{ThermalStorage: true}},
+		{Name: "", Requirement: matching.Requirement{ThermalStorage: true}},
+	})
+
+	require.ErrorIs(t, err, errs.ErrValueIsRequired)
+	var validation *errs.ValidationErrors
+	require.ErrorAs(t, err, &validation)
+	assert.Equal(t, "rules.1.name", validation.Fields[0].Field)
+}
+
+func TestNewReplaceMatchingRulesCommand_DuplicatedRule(t *testing.T) {
+	_, err := commands.NewReplaceMatchingRulesCommand([]commands.MatchingRuleSpec{
+		{Name: "Thermal", Requirement: matching.Requirement{ThermalStorage: true}},
+		{Name: "thermal", Requirement: matching.Requirement{VehicleTypes: []courier.VehicleType{courier.Car}}},
+	})
+
+	require.ErrorIs(t, err, matching.ErrRuleIsDuplicated)
+	require.ErrorIs(t, err, errs.ErrValidationFailed)
+}
+
+func TestReplaceMatchingRulesCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.ReplaceMatchingRulesCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrReplaceMatchingRulesCommandIsNotConstructed)
+}
@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/sla"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrReplaceSLATargetsCommandIsNotConstructed = errors.New(
+		"ReplaceSLATargetsCommand must be created via NewReplaceSLATargetsCommand constructor",
+	)
+)
+
+// SLATargetSpec describes a target of ReplaceSLATargetsCommand: the district with its part of
+// the grid, the delivery tier, the delivery time and the percentage of deliveries that must meet it.
+type SLATargetSpec struct {
+	District     string
+	Zone         kernel.Zone
+	Tier         order.DeliveryTier
+	DeliveryTime time.Duration
+	Goal         int
+}
+
+// ReplaceSLATargetsCommand represents an operator's request to replace the SLA targets of
+// the districts as a whole. An empty command removes every target.
+//
+// Example:
+//
+//	cmd, err := NewReplaceSLATargetsCommand([]SLATargetSpec{{
+//	    District: "Центральный", Zone: zone, Tier: order.ExpressDelivery, DeliveryTime: 45 * time.Minute, Goal: 95,
+//	}})
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	targets, err := handler.Handle(ctx, cmd)
+type ReplaceSLATargetsCommand struct {
+	targets []*sla.Target
+
+	guard guard.ConstructorGuard
+}
+
+// NewReplaceSLATargetsCommand creates a command to replace the SLA targets.
+// Returns a validation error naming the invalid fields of every target, or
+// sla.ErrTargetIsDuplicated if a district has two targets for a delivery tier.
+func NewReplaceSLATargetsCommand(specs []SLATargetSpec) (ReplaceSLATargetsCommand, error) {
+	targets := make([]*sla.Target, 0, len(specs))
+	var validation errs.ValidationErrors
+	for i, spec := range specs {
+		target, err := sla.NewTarget(kernel.NewUUID(), spec.District, spec.Zone, spec.Tier, spec.DeliveryTime, spec.Goal)
+		if err != nil {
+			validation.Add("targets."+strconv.Itoa(i), err)
+			continue
+		}
+		targets = append(targets, target)
+	}
+	if err := validation.Err(); err != nil {
+		return ReplaceSLATargetsCommand{}, err
+	}
+
+	if err := sla.CheckTargets(targets); err != nil {
+		return ReplaceSLATargetsCommand{}, errs.JoinFields(errs.Field("targets", err))
+	}
+
+	return ReplaceSLATargetsCommand{targets: targets, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrReplaceSLATargetsCommandIsNotConstructed if validation fails.
+func (c ReplaceSLATargetsCommand) Validate() error {
+	return c.guard.Validate(ErrReplaceSLATargetsCommandIsNotConstructed)
+}
+
+// Targets returns the targets replacing the current ones.
+func (c ReplaceSLATargetsCommand) Targets() []*sla.Target {
+	return c.targets
+}
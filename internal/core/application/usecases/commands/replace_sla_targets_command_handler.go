@@ -0,0 +1,45 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/sla"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tracing"
+)
+
+// ReplaceSLATargetsCommandHandler replaces the SLA targets of the districts. The new targets
+// apply from the next computation of the compliance; the compliance of earlier days keeps the
+// targets it was measured against.
+//
+// Example:
+//
+//	handler := NewReplaceSLATargetsCommandHandler(slas)
+//	targets, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    log.Printf("Failed to replace SLA targets: %v", err)
+//	}
+type ReplaceSLATargetsCommandHandler struct {
+	slas ports.SLARepository
+}
+
+// NewReplaceSLATargetsCommandHandler creates a handler that stores the SLA targets in slas.
+func NewReplaceSLATargetsCommandHandler(slas ports.SLARepository) ReplaceSLATargetsCommandHandler {
+	return ReplaceSLATargetsCommandHandler{slas: slas}
+}
+
+// Handle replaces the stored targets and returns the new ones.
+func (h ReplaceSLATargetsCommandHandler) Handle(ctx context.Context, cmd ReplaceSLATargetsCommand) ([]*sla.Target, error) {
+	ctx, span := tracing.Start(ctx, "ReplaceSLATargetsCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := h.slas.ReplaceTargets(ctx, cmd.Targets()); err != nil {
+		return nil, err
+	}
+
+	return cmd.Targets(), nil
+}
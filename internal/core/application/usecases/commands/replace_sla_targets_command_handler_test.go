@@ -0,0 +1,82 @@
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/sla"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockSLARepository is a mock for ports.SLARepository.
+type MockSLARepository struct {
+	mock.Mock
+}
+
+func (m *MockSLARepository) ReplaceTargets(ctx context.Context, targets []*sla.Target) error {
+	args := m.Called(ctx, targets)
+	return args.Error(0)
+}
+
+func (m *MockSLARepository) GetTargets(ctx context.Context) ([]*sla.Target, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*sla.Target), args.Error(1)
+}
+
+func (m *MockSLARepository) SaveCompliance(ctx context.Context, day time.Time, compliance []sla.Compliance) error {
+	args := m.Called(ctx, day, compliance)
+	return args.Error(0)
+}
+
+func TestReplaceSLATargetsCommandHandler_Handle_ReplacesTargets(t *testing.T) {
+	ctx := t.Context()
+	repository := new(MockSLARepository)
+	cmd, err := commands.NewReplaceSLATargetsCommand([]commands.SLATargetSpec{
+		{District: "North", Zone: slaZone(1, 1, 5, 5), Tier: order.ExpressDelivery, DeliveryTime: 45 * time.Minute, Goal: 95},
+	})
+	require.NoError(t, err)
+
+	repository.On("ReplaceTargets", ctx, cmd.Targets()).Return(nil).Once()
+
+	handler := commands.NewReplaceSLATargetsCommandHandler(repository)
+	targets, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, cmd.Targets(), targets)
+	repository.AssertExpectations(t)
+}
+
+func TestReplaceSLATargetsCommandHandler_Handle_RepositoryError(t *testing.T) {
+	ctx := t.Context()
+	repository := new(MockSLARepository)
+	failure := errors.New("connection lost")
+	cmd, err := commands.NewReplaceSLATargetsCommand(nil)
+	require.NoError(t, err)
+
+	repository.On("ReplaceTargets", ctx, mock.Anything).Return(failure).Once()
+
+	handler := commands.NewReplaceSLATargetsCommandHandler(repository)
+	_, err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, failure)
+}
+
+func TestReplaceSLATargetsCommandHandler_Handle_InvalidCommand(t *testing.T) {
+	repository := new(MockSLARepository)
+
+	handler := commands.NewReplaceSLATargetsCommandHandler(repository)
+	_, err := handler.Handle(t.Context(), commands.ReplaceSLATargetsCommand{})
+
+	require.ErrorIs(t, err, commands.ErrReplaceSLATargetsCommandIsNotConstructed)
+	repository.AssertNotCalled(t, "ReplaceTargets", mock.Anything, mock.Anything)
+}
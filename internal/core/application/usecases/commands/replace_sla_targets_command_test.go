@@ -0,0 +1,70 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/sla"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func slaZone(fromX, fromY, toX, toY kernel.Coordinate) kernel.Zone {
+	from, _ := kernel.NewLocation(fromX, fromY)
+	to, _ := kernel.NewLocation(toX, toY)
+	zone, _ := kernel.NewZone(from, to)
+	return zone
+}
+
+func TestNewReplaceSLATargetsCommand_Valid(t *testing.T) {
+	cmd, err := commands.NewReplaceSLATargetsCommand([]commands.SLATargetSpec{
+		{District: "North", Zone: slaZone(1, 1, 5, 5), Tier: order.ExpressDelivery, DeliveryTime: 45 * time.Minute, Goal: 95},
+		{District: "North", Zone: slaZone(1, 1, 5, 5), Tier: order.EconomyDelivery, DeliveryTime: 3 * time.Hour, Goal: 90},
+	})
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	require.Len(t, cmd.Targets(), 2)
+	assert.Equal(t, "North", cmd.Targets()[0].District())
+	assert.NotEqual(t, cmd.Targets()[0].ID(), cmd.Targets()[1].ID())
+}
+
+func TestNewReplaceSLATargetsCommand_NoTargets(t *testing.T) {
+	cmd, err := commands.NewReplaceSLATargetsCommand(nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, cmd.Targets())
+}
+
+func TestNewReplaceSLATargetsCommand_InvalidTarget(t *testing.T) {
+	_, err := commands.NewReplaceSLATargetsCommand([]commands.SLATargetSpec{
+		{District: "North", Zone: slaZone(1, 1, 5, 5), Tier: order.ExpressDelivery, DeliveryTime: 45 * time.Minute, Goal: 95},
+		{District: "South", Zone: slaZone(1, 1, 5, 5), Tier: order.ExpressDelivery, DeliveryTime: 45 * time.Minute, Goal: 0},
+	})
+
+	require.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+	var validation *errs.ValidationErrors
+	require.ErrorAs(t, err, &validation)
+	assert.Equal(t, "targets.1.goal", validation.Fields[0].Field)
+}
+
+func TestNewReplaceSLATargetsCommand_DuplicatedTarget(t *testing.T) {
+	_, err := commands.NewReplaceSLATargetsCommand([]commands.SLATargetSpec{
+		{District: "North", Zone: slaZone(1, 1, 5, 5), Tier: order.ExpressDelivery, DeliveryTime: 45 * time.Minute, Goal: 95},
+		{District: "North", Zone: slaZone(2, 2, 6, 6), Tier: order.ExpressDelivery, DeliveryTime: time.Hour, Goal: 90},
+	})
+
+	require.ErrorIs(t, err, sla.ErrTargetIsDuplicated)
+	require.ErrorIs(t, err, errs.ErrValidationFailed)
+}
+
+func TestReplaceSLATargetsCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.ReplaceSLATargetsCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrReplaceSLATargetsCommandIsNotConstructed)
+}
@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrReplayOutboxCommandIsNotConstructed = errors.New(
+		"ReplayOutboxCommand must be created via NewReplayOutboxCommand constructor",
+	)
+	ErrReplaySinceIsRequired = errors.New("replay start timestamp is required")
+	ErrBatchSizeIsInvalid    = errors.New("batch size must be greater than 0")
+	ErrReplayRateIsInvalid   = errors.New("replay rate must not be negative")
+)
+
+// ReplayOutboxCommand represents a request to republish outbox events to the broker.
+// Used for disaster recovery when a topic was lost and downstream consumers must be rebuilt.
+// Events are replayed in their original order starting from the given timestamp.
+//
+// Example:
+//
+//	since := time.Now().Add(-24 * time.Hour)
+//	cmd, err := NewReplayOutboxCommand(since, 500, 200) // 200 messages per second
+//	if err != nil {
+//	    return fmt.Errorf("invalid replay parameters: %w", err)
+//	}
+//
+//	report, err := handler.Handle(ctx, cmd)
+//	fmt.Printf("Replayed %d events", report.Published)
+type ReplayOutboxCommand struct { //nolint:recvcheck //using for validation
+	since        time.Time
+	batchSize    int
+	maxPerSecond int
+
+	guard guard.ConstructorGuard
+}
+
+// NewReplayOutboxCommand creates a command to replay outbox events.
+// since is required; batchSize must be positive; maxPerSecond of 0 disables throttling.
+// Returns an error if any validation fails.
+func NewReplayOutboxCommand(since time.Time, batchSize int, maxPerSecond int) (ReplayOutboxCommand, error) {
+	command := ReplayOutboxCommand{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := errors.Join(
+		command.setSince(since),
+		command.setBatchSize(batchSize),
+		command.setMaxPerSecond(maxPerSecond),
+	); err != nil {
+		return ReplayOutboxCommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrReplayOutboxCommandIsNotConstructed if validation fails.
+func (c ReplayOutboxCommand) Validate() error {
+	return c.guard.Validate(ErrReplayOutboxCommandIsNotConstructed)
+}
+
+// Since returns the timestamp of the first event to replay.
+func (c ReplayOutboxCommand) Since() time.Time {
+	return c.since
+}
+
+// BatchSize returns how many events are loaded from the outbox at once.
+func (c ReplayOutboxCommand) BatchSize() int {
+	return c.batchSize
+}
+
+// MaxPerSecond returns the publishing rate limit (0 means unlimited).
+func (c ReplayOutboxCommand) MaxPerSecond() int {
+	return c.maxPerSecond
+}
+
+func (c *ReplayOutboxCommand) setSince(since time.Time) error {
+	if since.IsZero() {
+		return ErrReplaySinceIsRequired
+	}
+
+	c.since = since
+	return nil
+}
+
+func (c *ReplayOutboxCommand) setBatchSize(batchSize int) error {
+	if batchSize <= 0 {
+		return ErrBatchSizeIsInvalid
+	}
+
+	c.batchSize = batchSize
+	return nil
+}
+
+func (c *ReplayOutboxCommand) setMaxPerSecond(maxPerSecond int) error {
+	if maxPerSecond < 0 {
+		return ErrReplayRateIsInvalid
+	}
+
+	c.maxPerSecond = maxPerSecond
+	return nil
+}
@@ -0,0 +1,127 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tracing"
+)
+
+// ReplayOutboxReport summarizes the progress of an outbox replay.
+type ReplayOutboxReport struct {
+	// Published is the number of events sent to the broker so far.
+	Published int
+	// LastMessageID is the ID of the last published event (nil if nothing was published).
+	LastMessageID *kernel.UUID
+	// LastOccurredAt is the occurrence time of the last published event.
+	LastOccurredAt time.Time
+}
+
+// ReplayProgressReporter receives progress updates after every replayed batch.
+type ReplayProgressReporter interface {
+	ReportProgress(ctx context.Context, report ReplayOutboxReport)
+}
+
+// ReplayOutboxCommandHandler republishes outbox events to the message broker.
+//
+// Replays are idempotent from the consumer's point of view: every event keeps its original
+// message ID, which the publisher propagates so consumers can drop duplicates. Ordering keys
+// are respected because events are read in (occurred_at, id) order and published one at a
+// time, keyed by aggregate ID. A replay interrupted by an error can be resumed from the
+// LastOccurredAt of the returned report.
+//
+// Example:
+//
+//	handler := NewReplayOutboxCommandHandler(outboxRepo, publisher, reporter)
+//	cmd, _ := NewReplayOutboxCommand(since, 500, 200)
+//	report, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    log.Printf("Replay stopped after %d events: %v", report.Published, err)
+//	}
+type ReplayOutboxCommandHandler struct {
+	outboxRepository ports.OutboxRepository
+	publisher        ports.EventPublisher
+	reporter         ReplayProgressReporter
+}
+
+// NewReplayOutboxCommandHandler creates a handler for outbox replays.
+// The reporter is notified after every batch.
+func NewReplayOutboxCommandHandler(
+	outboxRepository ports.OutboxRepository,
+	publisher ports.EventPublisher,
+	reporter ReplayProgressReporter,
+) ReplayOutboxCommandHandler {
+	return ReplayOutboxCommandHandler{
+		outboxRepository: outboxRepository,
+		publisher:        publisher,
+		reporter:         reporter,
+	}
+}
+
+// Handle replays all outbox events recorded since the command timestamp.
+// Returns the final report; on failure the report describes the events published before the error.
+func (h *ReplayOutboxCommandHandler) Handle(ctx context.Context, cmd ReplayOutboxCommand) (ReplayOutboxReport, error) {
+	ctx, span := tracing.Start(ctx, "ReplayOutboxCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return ReplayOutboxReport{}, err
+	}
+
+	var throttle <-chan time.Time
+	if cmd.MaxPerSecond() > 0 {
+		ticker := time.NewTicker(time.Second / time.Duration(cmd.MaxPerSecond()))
+		defer ticker.Stop()
+		throttle = ticker.C
+	}
+
+	report := ReplayOutboxReport{}
+	cursor := ports.OutboxCursor{OccurredAt: cmd.Since()}
+
+	for {
+		batch, err := h.outboxRepository.GetSince(ctx, cursor, cmd.BatchSize())
+		if err != nil {
+			return report, err
+		}
+
+		for _, message := range batch {
+			if err = h.wait(ctx, throttle); err != nil {
+				return report, err
+			}
+
+			if err = h.publisher.Publish(ctx, message); err != nil {
+				return report, err
+			}
+
+			id := message.ID
+			report.Published++
+			report.LastMessageID = &id
+			report.LastOccurredAt = message.OccurredAt
+			cursor = ports.OutboxCursor{OccurredAt: message.OccurredAt, AfterID: &id}
+		}
+
+		if len(batch) > 0 {
+			h.reporter.ReportProgress(ctx, report)
+		}
+
+		if len(batch) < cmd.BatchSize() {
+			return report, nil
+		}
+	}
+}
+
+// wait blocks until the throttle allows the next message or the context is cancelled.
+func (h *ReplayOutboxCommandHandler) wait(ctx context.Context, throttle <-chan time.Time) error {
+	if throttle == nil {
+		return ctx.Err()
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-throttle:
+		return nil
+	}
+}
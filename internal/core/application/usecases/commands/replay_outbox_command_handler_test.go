@@ -0,0 +1,188 @@
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockOutboxRepository is a mock for ports.OutboxRepository.
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+func (m *MockOutboxRepository) GetSince(
+	ctx context.Context,
+	cursor ports.OutboxCursor,
+	limit int,
+) ([]ports.OutboxMessage, error) {
+	args := m.Called(ctx, cursor, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]ports.OutboxMessage), args.Error(1)
+}
+
+// MockEventPublisher records published messages in order.
+type MockEventPublisher struct {
+	published []ports.OutboxMessage
+	err       error
+}
+
+func (m *MockEventPublisher) Publish(_ context.Context, message ports.OutboxMessage) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.published = append(m.published, message)
+	return nil
+}
+
+// MockProgressReporter records every reported progress snapshot.
+type MockProgressReporter struct {
+	reports []commands.ReplayOutboxReport
+}
+
+func (m *MockProgressReporter) ReportProgress(_ context.Context, report commands.ReplayOutboxReport) {
+	m.reports = append(m.reports, report)
+}
+
+func createOutboxMessages(since time.Time, count int) []ports.OutboxMessage {
+	messages := make([]ports.OutboxMessage, 0, count)
+	for i := range count {
+		messages = append(messages, ports.OutboxMessage{
+			ID:          kernel.NewUUID(),
+			AggregateID: "order-1",
+			EventType:   "OrderStatusChanged",
+			Payload:     []byte(`{}`),
+			OccurredAt:  since.Add(time.Duration(i) * time.Second),
+		})
+	}
+	return messages
+}
+
+func TestReplayOutboxCommandHandler_Handle_ReplaysAllBatchesInOrder(t *testing.T) {
+	ctx := t.Context()
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	messages := createOutboxMessages(since, 3)
+
+	repo := new(MockOutboxRepository)
+	publisher := &MockEventPublisher{}
+	reporter := &MockProgressReporter{}
+
+	lastOfFirstBatch := messages[1].ID
+	repo.On("GetSince", ctx, ports.OutboxCursor{OccurredAt: since}, 2).Return(messages[:2], nil).Once()
+	repo.On("GetSince", ctx, ports.OutboxCursor{OccurredAt: messages[1].OccurredAt, AfterID: &lastOfFirstBatch}, 2).
+		Return(messages[2:], nil).Once()
+
+	cmd, err := commands.NewReplayOutboxCommand(since, 2, 0)
+	require.NoError(t, err)
+
+	handler := commands.NewReplayOutboxCommandHandler(repo, publisher, reporter)
+	report, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, messages, publisher.published)
+	assert.Equal(t, 3, report.Published)
+	require.NotNil(t, report.LastMessageID)
+	assert.Equal(t, messages[2].ID, *report.LastMessageID)
+	assert.Equal(t, messages[2].OccurredAt, report.LastOccurredAt)
+	require.Len(t, reporter.reports, 2)
+	assert.Equal(t, 2, reporter.reports[0].Published)
+	repo.AssertExpectations(t)
+}
+
+func TestReplayOutboxCommandHandler_Handle_EmptyOutbox(t *testing.T) {
+	ctx := t.Context()
+	since := time.Now()
+
+	repo := new(MockOutboxRepository)
+	reporter := &MockProgressReporter{}
+	repo.On("GetSince", ctx, ports.OutboxCursor{OccurredAt: since}, 10).Return([]ports.OutboxMessage{}, nil).Once()
+
+	cmd, err := commands.NewReplayOutboxCommand(since, 10, 0)
+	require.NoError(t, err)
+
+	handler := commands.NewReplayOutboxCommandHandler(repo, &MockEventPublisher{}, reporter)
+	report, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, report.Published)
+	assert.Nil(t, report.LastMessageID)
+	assert.Empty(t, reporter.reports)
+}
+
+func TestReplayOutboxCommandHandler_Handle_PublishError(t *testing.T) {
+	ctx := t.Context()
+	since := time.Now()
+	publishErr := errors.New("broker unavailable")
+
+	repo := new(MockOutboxRepository)
+	repo.On("GetSince", ctx, mock.Anything, 10).Return(createOutboxMessages(since, 2), nil).Once()
+
+	cmd, err := commands.NewReplayOutboxCommand(since, 10, 0)
+	require.NoError(t, err)
+
+	handler := commands.NewReplayOutboxCommandHandler(repo, &MockEventPublisher{err: publishErr}, &MockProgressReporter{})
+	report, err := handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, publishErr)
+	assert.Equal(t, 0, report.Published)
+}
+
+func TestReplayOutboxCommandHandler_Handle_Throttled(t *testing.T) {
+	ctx := t.Context()
+	since := time.Now()
+
+	repo := new(MockOutboxRepository)
+	publisher := &MockEventPublisher{}
+	repo.On("GetSince", ctx, mock.Anything, 10).Return(createOutboxMessages(since, 3), nil).Once()
+
+	cmd, err := commands.NewReplayOutboxCommand(since, 10, 100)
+	require.NoError(t, err)
+
+	handler := commands.NewReplayOutboxCommandHandler(repo, publisher, &MockProgressReporter{})
+	started := time.Now()
+	report, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.Published)
+	assert.GreaterOrEqual(t, time.Since(started), 25*time.Millisecond)
+}
+
+func TestReplayOutboxCommandHandler_Handle_CancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	since := time.Now()
+
+	repo := new(MockOutboxRepository)
+	publisher := &MockEventPublisher{}
+	repo.On("GetSince", ctx, mock.Anything, 10).Return(createOutboxMessages(since, 1), nil).Once()
+
+	cmd, err := commands.NewReplayOutboxCommand(since, 10, 1)
+	require.NoError(t, err)
+
+	handler := commands.NewReplayOutboxCommandHandler(repo, publisher, &MockProgressReporter{})
+	_, err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, publisher.published)
+}
+
+func TestReplayOutboxCommandHandler_Handle_InvalidCommand(t *testing.T) {
+	handler := commands.NewReplayOutboxCommandHandler(
+		new(MockOutboxRepository), &MockEventPublisher{}, &MockProgressReporter{},
+	)
+
+	_, err := handler.Handle(t.Context(), commands.ReplayOutboxCommand{})
+
+	require.ErrorIs(t, err, commands.ErrReplayOutboxCommandIsNotConstructed)
+}
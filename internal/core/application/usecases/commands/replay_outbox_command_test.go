@@ -0,0 +1,59 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReplayOutboxCommand_ValidInput(t *testing.T) {
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cmd, err := commands.NewReplayOutboxCommand(since, 100, 50)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, since, cmd.Since())
+	assert.Equal(t, 100, cmd.BatchSize())
+	assert.Equal(t, 50, cmd.MaxPerSecond())
+}
+
+func TestNewReplayOutboxCommand_UnlimitedRate(t *testing.T) {
+	cmd, err := commands.NewReplayOutboxCommand(time.Now(), 10, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, cmd.MaxPerSecond())
+}
+
+func TestNewReplayOutboxCommand_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name         string
+		since        time.Time
+		batchSize    int
+		maxPerSecond int
+		expected     error
+	}{
+		{"zero since", time.Time{}, 10, 0, commands.ErrReplaySinceIsRequired},
+		{"zero batch size", time.Now(), 0, 0, commands.ErrBatchSizeIsInvalid},
+		{"negative batch size", time.Now(), -1, 0, commands.ErrBatchSizeIsInvalid},
+		{"negative rate", time.Now(), 10, -5, commands.ErrReplayRateIsInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := commands.NewReplayOutboxCommand(tt.since, tt.batchSize, tt.maxPerSecond)
+
+			require.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestReplayOutboxCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.ReplayOutboxCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrReplayOutboxCommandIsNotConstructed)
+}
@@ -14,7 +14,10 @@ import (
 type (
 	// TxManager handles database transaction lifecycle.
 	// Ensures atomic operations across multiple repository calls.
+	// Handlers run their work through Do, which commits on success and rolls back
+	// on an error or a panic.
 	TxManager interface {
+		Do(ctx context.Context, fn func(ctx context.Context) error) error
 		Begin(ctx context.Context) error
 		Commit(ctx context.Context) error
 		Rollback(ctx context.Context) error
@@ -30,6 +33,36 @@ type (
 		CourierRepository() ports.CourierRepository
 	}
 
+	// PickupSlotRepoFactory provides access to pickup slot repository within a transaction.
+	PickupSlotRepoFactory interface {
+		PickupSlotRepository() ports.PickupSlotRepository
+	}
+
+	// EarningsLedgerFactory provides access to the couriers' earnings ledger within a transaction.
+	EarningsLedgerFactory interface {
+		EarningsLedger() ports.EarningsLedger
+	}
+
+	// HandoverRepoFactory provides access to the handovers of orders between couriers within a transaction.
+	HandoverRepoFactory interface {
+		HandoverRepository() ports.HandoverRepository
+	}
+
+	// CourierMergeRepoFactory provides access to the merging of duplicate courier records within a transaction.
+	CourierMergeRepoFactory interface {
+		CourierMergeRepository() ports.CourierMergeRepository
+	}
+
+	// PersonalDataEraserFactory provides access to the erasure of customers' personal data within a transaction.
+	PersonalDataEraserFactory interface {
+		PersonalDataEraser() ports.PersonalDataEraser
+	}
+
+	// BulkCancellationLogFactory provides access to the audit log of bulk cancellations within a transaction.
+	BulkCancellationLogFactory interface {
+		BulkCancellationLog() ports.BulkCancellationLog
+	}
+
 	// OrderUoW manages transactions for order-only operations.
 	// Used when commands only modify order aggregates.
 	OrderUoW interface {
@@ -54,23 +87,100 @@ type (
 		Create() CourierUoW
 	}
 
+	// PickupSlotUoW manages transactions for pickup slot-only operations.
+	// Used when commands only manage the warehouse pickup slots.
+	PickupSlotUoW interface {
+		TxManager
+		PickupSlotRepoFactory
+	}
+
+	// PickupSlotUoWFactory creates new pickup slot unit of work instances.
+	PickupSlotUoWFactory interface {
+		Create() PickupSlotUoW
+	}
+
+	// TipUoW manages transactions that credit a courier for an order.
+	// Used when order changes are recorded in the earnings ledger as well.
+	TipUoW interface {
+		TxManager
+		OrderRepoFactory
+		EarningsLedgerFactory
+	}
+
+	// TipUoWFactory creates new tip unit of work instances.
+	TipUoWFactory interface {
+		Create() TipUoW
+	}
+
+	// TransferUoW manages transactions that hand an order over between couriers.
+	// Used when both couriers, the order and the handover record change together.
+	TransferUoW interface {
+		TxManager
+		CourierRepoFactory
+		OrderRepoFactory
+		HandoverRepoFactory
+	}
+
+	// TransferUoWFactory creates new transfer unit of work instances.
+	TransferUoWFactory interface {
+		Create() TransferUoW
+	}
+
+	// MergeUoW manages transactions that merge a duplicate courier record into another.
+	// Used when the surviving courier and the records of the duplicate change together.
+	MergeUoW interface {
+		TxManager
+		CourierRepoFactory
+		CourierMergeRepoFactory
+	}
+
+	// MergeUoWFactory creates new merge unit of work instances.
+	MergeUoWFactory interface {
+		Create() MergeUoW
+	}
+
+	// ErasureUoW manages transactions that erase the personal data of customers' orders.
+	// Used when the orders are erased, verified and audited together.
+	ErasureUoW interface {
+		TxManager
+		OrderRepoFactory
+		PersonalDataEraserFactory
+	}
+
+	// ErasureUoWFactory creates new erasure unit of work instances.
+	ErasureUoWFactory interface {
+		Create() ErasureUoW
+	}
+
+	// BulkCancellationUoW manages transactions that cancel a batch of orders in bulk.
+	// Used when the orders of a batch are cancelled and audited together.
+	BulkCancellationUoW interface {
+		UoW
+		BulkCancellationLogFactory
+	}
+
+	// BulkCancellationUoWFactory creates new bulk cancellation unit of work instances.
+	BulkCancellationUoWFactory interface {
+		Create() BulkCancellationUoW
+	}
+
 	// UoW manages transactions across both order and courier aggregates.
+	// Dispatch also books the warehouse pickup slots of the orders it assigns.
 	// Used for commands that coordinate changes between multiple aggregate types.
 	//
 	// Example:
 	//   uow := factory.Create()
-	//   err := uow.Begin(ctx)
-	//   defer uow.Rollback(ctx)
-	//
-	//   orderRepo := uow.OrderRepository()
-	//   courierRepo := uow.CourierRepository()
-	//   // ... perform operations
-	//
-	//   err = uow.Commit(ctx)
+	//   err := uow.Do(ctx, func(ctx context.Context) error {
+	//       orderRepo := uow.OrderRepository()
+	//       courierRepo := uow.CourierRepository()
+	//       // ... perform operations
+	//       return nil
+	//   })
 	UoW interface {
 		TxManager
 		CourierRepoFactory
 		OrderRepoFactory
+		PickupSlotRepoFactory
 	}
 
 	// UoWFactory creates new unit of work instances for cross-aggregate operations.
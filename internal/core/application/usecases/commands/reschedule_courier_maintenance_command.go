@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrRescheduleCourierMaintenanceCommandIsNotConstructed = errors.New(
+		"RescheduleCourierMaintenanceCommand must be created via NewRescheduleCourierMaintenanceCommand constructor",
+	)
+)
+
+// RescheduleCourierMaintenanceCommand represents operations moving a courier's maintenance window.
+//
+// Example:
+//
+//	cmd, err := NewRescheduleCourierMaintenanceCommand(courierID, windowID, startsAt, endsAt)
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewRescheduleCourierMaintenanceCommandHandler(uowFactory)
+//	window, err := handler.Handle(ctx, cmd)
+type RescheduleCourierMaintenanceCommand struct { //nolint:recvcheck //using for validation
+	courierID kernel.UUID
+	windowID  kernel.UUID
+	startsAt  time.Time
+	endsAt    time.Time
+
+	guard guard.ConstructorGuard
+}
+
+// NewRescheduleCourierMaintenanceCommand creates a command to move a maintenance window to a new time.
+// Returns an error if an ID is invalid; the window is validated by the courier aggregate.
+func NewRescheduleCourierMaintenanceCommand(
+	courierID, windowID kernel.UUID,
+	startsAt, endsAt time.Time,
+) (RescheduleCourierMaintenanceCommand, error) {
+	if err := errs.JoinFields(
+		errs.Field("courierId", courierID.Validate()),
+		errs.Field("windowId", windowID.Validate()),
+	); err != nil {
+		return RescheduleCourierMaintenanceCommand{}, err
+	}
+
+	return RescheduleCourierMaintenanceCommand{
+		courierID: courierID,
+		windowID:  windowID,
+		startsAt:  startsAt,
+		endsAt:    endsAt,
+		guard:     guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrRescheduleCourierMaintenanceCommandIsNotConstructed if validation fails.
+func (c RescheduleCourierMaintenanceCommand) Validate() error {
+	return c.guard.Validate(ErrRescheduleCourierMaintenanceCommandIsNotConstructed)
+}
+
+// CourierID returns the ID of the courier whose maintenance is moved.
+func (c RescheduleCourierMaintenanceCommand) CourierID() kernel.UUID {
+	return c.courierID
+}
+
+// WindowID returns the ID of the maintenance window to move.
+func (c RescheduleCourierMaintenanceCommand) WindowID() kernel.UUID {
+	return c.windowID
+}
+
+// StartsAt returns the new start of the window.
+func (c RescheduleCourierMaintenanceCommand) StartsAt() time.Time {
+	return c.startsAt
+}
+
+// EndsAt returns the new end of the window.
+func (c RescheduleCourierMaintenanceCommand) EndsAt() time.Time {
+	return c.endsAt
+}
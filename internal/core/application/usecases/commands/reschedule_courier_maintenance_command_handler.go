@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/pkg/tracing"
+)
+
+// RescheduleCourierMaintenanceCommandHandler moves maintenance windows of couriers' vehicles.
+//
+// Example:
+//
+//	handler := NewRescheduleCourierMaintenanceCommandHandler(uowFactory)
+//	cmd, _ := NewRescheduleCourierMaintenanceCommand(courierID, windowID, startsAt, endsAt)
+//	if _, err := handler.Handle(ctx, cmd); errors.Is(err, courier.ErrMaintenanceWindowNotFound) {
+//	    log.Printf("Window %s was cancelled", windowID)
+//	}
+type RescheduleCourierMaintenanceCommandHandler struct {
+	uowFactory CourierUoWFactory
+}
+
+// NewRescheduleCourierMaintenanceCommandHandler creates a new handler for moving maintenance windows.
+// Requires a CourierUoWFactory for transactional operations.
+func NewRescheduleCourierMaintenanceCommandHandler(
+	uowFactory CourierUoWFactory,
+) RescheduleCourierMaintenanceCommandHandler {
+	return RescheduleCourierMaintenanceCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the RescheduleCourierMaintenanceCommand within a transaction and returns the moved window.
+// Returns a validation error for an empty window, courier.ErrMaintenanceWindowNotFound for an unknown window and the errors of
+// ScheduleCourierMaintenanceCommandHandler when the new time breaks a scheduling rule.
+func (h *RescheduleCourierMaintenanceCommandHandler) Handle(
+	ctx context.Context,
+	cmd RescheduleCourierMaintenanceCommand,
+) (courier.MaintenanceWindow, error) {
+	ctx, span := tracing.Start(ctx, "RescheduleCourierMaintenanceCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return courier.MaintenanceWindow{}, err
+	}
+
+	window, err := courier.NewMaintenanceWindow(cmd.WindowID(), cmd.StartsAt(), cmd.EndsAt())
+	if err != nil {
+		return courier.MaintenanceWindow{}, err
+	}
+
+	uow := h.uowFactory.Create()
+	err = uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		courierEntity, err := courierRepo.Get(ctx, cmd.CourierID())
+		if err != nil {
+			return err
+		}
+
+		if err = courierEntity.RescheduleMaintenance(window, time.Now()); err != nil {
+			return err
+		}
+
+		return courierRepo.Update(ctx, courierEntity)
+	})
+	if err != nil {
+		return courier.MaintenanceWindow{}, err
+	}
+
+	return window, nil
+}
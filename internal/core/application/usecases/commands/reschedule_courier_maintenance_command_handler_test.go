@@ -0,0 +1,86 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRescheduleCourierMaintenanceCommandHandler_Handle_Success(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+	now := time.Now()
+	window, err := courier.NewMaintenanceWindow(kernel.NewUUID(), now.Add(time.Hour), now.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, courierEntity.ScheduleMaintenance(window, now))
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+
+	mockFactory.On("Create").Return(mockUoW)
+	mockUoW.On("Begin", ctx).Return(nil)
+	mockUoW.On("CourierRepository").Return(mockRepo)
+	mockUoW.On("Commit", ctx).Return(nil)
+	mockUoW.On("Rollback", ctx).Return(nil).Maybe()
+	mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil)
+	mockRepo.On("Update", ctx, courierEntity).Return(nil).Once()
+
+	cmd, err := commands.NewRescheduleCourierMaintenanceCommand(
+		courierEntity.ID(), window.ID(), now.Add(3*time.Hour), now.Add(4*time.Hour),
+	)
+	require.NoError(t, err)
+
+	handler := commands.NewRescheduleCourierMaintenanceCommandHandler(mockFactory)
+	moved, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, window.ID(), moved.ID())
+	assert.Equal(t, now.Add(3*time.Hour).UTC(), courierEntity.MaintenanceWindows()[0].StartsAt())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRescheduleCourierMaintenanceCommandHandler_Handle_WindowNotFound(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+	now := time.Now()
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+
+	mock.InOrder(
+		mockFactory.On("Create").Return(mockUoW).Once(),
+		mockUoW.On("Begin", ctx).Return(nil).Once(),
+		mockUoW.On("CourierRepository").Return(mockRepo).Once(),
+		mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil).Once(),
+		mockUoW.On("Rollback", ctx).Return(nil).Once(),
+	)
+
+	cmd, err := commands.NewRescheduleCourierMaintenanceCommand(
+		courierEntity.ID(), kernel.NewUUID(), now.Add(time.Hour), now.Add(2*time.Hour),
+	)
+	require.NoError(t, err)
+
+	handler := commands.NewRescheduleCourierMaintenanceCommandHandler(mockFactory)
+	_, err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, courier.ErrMaintenanceWindowNotFound)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mockUoW.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+func TestRescheduleCourierMaintenanceCommandHandler_Handle_ValidationError(t *testing.T) {
+	handler := commands.NewRescheduleCourierMaintenanceCommandHandler(new(MockCourierUoWFactory))
+
+	_, err := handler.Handle(t.Context(), commands.RescheduleCourierMaintenanceCommand{})
+
+	require.ErrorIs(t, err, commands.ErrRescheduleCourierMaintenanceCommandIsNotConstructed)
+}
@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrScheduleCourierMaintenanceCommandIsNotConstructed = errors.New(
+		"ScheduleCourierMaintenanceCommand must be created via NewScheduleCourierMaintenanceCommand constructor",
+	)
+)
+
+// ScheduleCourierMaintenanceCommand represents operations booking a maintenance window
+// for a courier's vehicle.
+//
+// Example:
+//
+//	cmd, err := NewScheduleCourierMaintenanceCommand(courierID, startsAt, startsAt.Add(4*time.Hour))
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewScheduleCourierMaintenanceCommandHandler(uowFactory)
+//	window, err := handler.Handle(ctx, cmd)
+type ScheduleCourierMaintenanceCommand struct { //nolint:recvcheck //using for validation
+	courierID kernel.UUID
+	startsAt  time.Time
+	endsAt    time.Time
+
+	guard guard.ConstructorGuard
+}
+
+// NewScheduleCourierMaintenanceCommand creates a command to schedule a maintenance window.
+// Returns an error if the courier ID is invalid; the window is validated by the courier aggregate.
+func NewScheduleCourierMaintenanceCommand(
+	courierID kernel.UUID,
+	startsAt, endsAt time.Time,
+) (ScheduleCourierMaintenanceCommand, error) {
+	if err := courierID.Validate(); err != nil {
+		return ScheduleCourierMaintenanceCommand{}, err
+	}
+
+	return ScheduleCourierMaintenanceCommand{
+		courierID: courierID,
+		startsAt:  startsAt,
+		endsAt:    endsAt,
+		guard:     guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrScheduleCourierMaintenanceCommandIsNotConstructed if validation fails.
+func (c ScheduleCourierMaintenanceCommand) Validate() error {
+	return c.guard.Validate(ErrScheduleCourierMaintenanceCommandIsNotConstructed)
+}
+
+// CourierID returns the ID of the courier whose vehicle goes into maintenance.
+func (c ScheduleCourierMaintenanceCommand) CourierID() kernel.UUID {
+	return c.courierID
+}
+
+// StartsAt returns when the vehicle goes into maintenance.
+func (c ScheduleCourierMaintenanceCommand) StartsAt() time.Time {
+	return c.startsAt
+}
+
+// EndsAt returns when the vehicle is back in service.
+func (c ScheduleCourierMaintenanceCommand) EndsAt() time.Time {
+	return c.endsAt
+}
@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/tracing"
+)
+
+// ScheduleCourierMaintenanceCommandHandler books maintenance windows for couriers' vehicles.
+// The courier is not dispatched while a window is active.
+//
+// Example:
+//
+//	handler := NewScheduleCourierMaintenanceCommandHandler(uowFactory)
+//	cmd, _ := NewScheduleCourierMaintenanceCommand(courierID, startsAt, endsAt)
+//	if _, err := handler.Handle(ctx, cmd); errors.Is(err, courier.ErrMaintenanceWindowOverlaps) {
+//	    log.Printf("Courier %s already has maintenance at that time", courierID)
+//	}
+type ScheduleCourierMaintenanceCommandHandler struct {
+	uowFactory CourierUoWFactory
+}
+
+// NewScheduleCourierMaintenanceCommandHandler creates a new handler for scheduling maintenance.
+// Requires a CourierUoWFactory for transactional operations.
+func NewScheduleCourierMaintenanceCommandHandler(
+	uowFactory CourierUoWFactory,
+) ScheduleCourierMaintenanceCommandHandler {
+	return ScheduleCourierMaintenanceCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the ScheduleCourierMaintenanceCommand within a transaction and returns the new window.
+// Returns a validation error for an empty window, or courier.ErrMaintenanceWindowIsOver,
+// courier.ErrMaintenanceWindowOverlaps or courier.ErrMaintenanceOverlapsAssignment
+// when the window cannot be scheduled.
+func (h *ScheduleCourierMaintenanceCommandHandler) Handle(
+	ctx context.Context,
+	cmd ScheduleCourierMaintenanceCommand,
+) (courier.MaintenanceWindow, error) {
+	ctx, span := tracing.Start(ctx, "ScheduleCourierMaintenanceCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return courier.MaintenanceWindow{}, err
+	}
+
+	window, err := courier.NewMaintenanceWindow(kernel.NewUUID(), cmd.StartsAt(), cmd.EndsAt())
+	if err != nil {
+		return courier.MaintenanceWindow{}, err
+	}
+
+	uow := h.uowFactory.Create()
+	err = uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		courierEntity, err := courierRepo.Get(ctx, cmd.CourierID())
+		if err != nil {
+			return err
+		}
+
+		if err = courierEntity.ScheduleMaintenance(window, time.Now()); err != nil {
+			return err
+		}
+
+		return courierRepo.Update(ctx, courierEntity)
+	})
+	if err != nil {
+		return courier.MaintenanceWindow{}, err
+	}
+
+	return window, nil
+}
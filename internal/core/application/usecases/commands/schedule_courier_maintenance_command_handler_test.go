@@ -0,0 +1,98 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleCourierMaintenanceCommandHandler_Handle_Success(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+	startsAt := time.Now().Add(time.Hour)
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+
+	mockFactory.On("Create").Return(mockUoW)
+	mockUoW.On("Begin", ctx).Return(nil)
+	mockUoW.On("CourierRepository").Return(mockRepo)
+	mockUoW.On("Commit", ctx).Return(nil)
+	mockUoW.On("Rollback", ctx).Return(nil).Maybe()
+	mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil)
+	mockRepo.On("Update", ctx, courierEntity).Return(nil).Once()
+
+	cmd, err := commands.NewScheduleCourierMaintenanceCommand(courierEntity.ID(), startsAt, startsAt.Add(time.Hour))
+	require.NoError(t, err)
+
+	handler := commands.NewScheduleCourierMaintenanceCommandHandler(mockFactory)
+	window, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, startsAt.UTC(), window.StartsAt())
+	require.Len(t, courierEntity.MaintenanceWindows(), 1)
+	assert.Equal(t, window.ID(), courierEntity.MaintenanceWindows()[0].ID())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestScheduleCourierMaintenanceCommandHandler_Handle_Overlap(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+	startsAt := time.Now().Add(time.Hour)
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+
+	mockFactory.On("Create").Return(mockUoW)
+	mockUoW.On("Begin", ctx).Return(nil)
+	mockUoW.On("CourierRepository").Return(mockRepo)
+	mockUoW.On("Commit", ctx).Return(nil)
+	mockUoW.On("Rollback", ctx).Return(nil).Maybe()
+	mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil)
+	mockRepo.On("Update", ctx, courierEntity).Return(nil).Once()
+
+	handler := commands.NewScheduleCourierMaintenanceCommandHandler(mockFactory)
+	first, err := commands.NewScheduleCourierMaintenanceCommand(courierEntity.ID(), startsAt, startsAt.Add(2*time.Hour))
+	require.NoError(t, err)
+	_, err = handler.Handle(ctx, first)
+	require.NoError(t, err)
+
+	overlapping, err := commands.NewScheduleCourierMaintenanceCommand(
+		courierEntity.ID(), startsAt.Add(time.Hour), startsAt.Add(3*time.Hour),
+	)
+	require.NoError(t, err)
+	_, err = handler.Handle(ctx, overlapping)
+
+	require.ErrorIs(t, err, courier.ErrMaintenanceWindowOverlaps)
+	mockRepo.AssertNumberOfCalls(t, "Update", 1)
+	mockUoW.AssertNumberOfCalls(t, "Commit", 1)
+}
+
+func TestScheduleCourierMaintenanceCommandHandler_Handle_EmptyWindow(t *testing.T) {
+	mockFactory := new(MockCourierUoWFactory)
+	startsAt := time.Now().Add(time.Hour)
+
+	cmd, err := commands.NewScheduleCourierMaintenanceCommand(createCourierForMaintenance(t).ID(), startsAt, startsAt)
+	require.NoError(t, err)
+
+	handler := commands.NewScheduleCourierMaintenanceCommandHandler(mockFactory)
+	_, err = handler.Handle(t.Context(), cmd)
+
+	require.Error(t, err)
+	mockFactory.AssertNotCalled(t, "Create")
+}
+
+func TestScheduleCourierMaintenanceCommandHandler_Handle_ValidationError(t *testing.T) {
+	handler := commands.NewScheduleCourierMaintenanceCommandHandler(new(MockCourierUoWFactory))
+
+	_, err := handler.Handle(t.Context(), commands.ScheduleCourierMaintenanceCommand{})
+
+	require.ErrorIs(t, err, commands.ErrScheduleCourierMaintenanceCommandIsNotConstructed)
+}
@@ -0,0 +1,37 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewScheduleCourierMaintenanceCommand_ValidInput(t *testing.T) {
+	courierID := kernel.NewUUID()
+	startsAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	cmd, err := commands.NewScheduleCourierMaintenanceCommand(courierID, startsAt, startsAt.Add(time.Hour))
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, courierID, cmd.CourierID())
+	assert.Equal(t, startsAt, cmd.StartsAt())
+	assert.Equal(t, startsAt.Add(time.Hour), cmd.EndsAt())
+}
+
+func TestNewScheduleCourierMaintenanceCommand_InvalidID(t *testing.T) {
+	_, err := commands.NewScheduleCourierMaintenanceCommand(kernel.UUID{}, time.Now(), time.Now())
+
+	require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+}
+
+func TestScheduleCourierMaintenanceCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.ScheduleCourierMaintenanceCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrScheduleCourierMaintenanceCommandIsNotConstructed)
+}
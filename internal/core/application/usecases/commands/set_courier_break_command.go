@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrSetCourierBreakCommandIsNotConstructed = errors.New(
+		"SetCourierBreakCommand must be created via NewSetCourierBreakCommand constructor",
+	)
+)
+
+// SetCourierBreakCommand represents a request to start or end a courier's break during the shift.
+//
+// Example:
+//
+//	cmd, err := NewSetCourierBreakCommand(courierID, true)
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewSetCourierBreakCommandHandler(uowFactory)
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    return fmt.Errorf("failed to start break: %w", err)
+//	}
+type SetCourierBreakCommand struct { //nolint:recvcheck //using for validation
+	courierID kernel.UUID
+	onBreak   bool
+
+	guard guard.ConstructorGuard
+}
+
+// NewSetCourierBreakCommand creates a command to start (onBreak) or end a courier's break.
+// Returns an error if the courier ID is invalid.
+func NewSetCourierBreakCommand(courierID kernel.UUID, onBreak bool) (SetCourierBreakCommand, error) {
+	if err := courierID.Validate(); err != nil {
+		return SetCourierBreakCommand{}, err
+	}
+
+	return SetCourierBreakCommand{
+		courierID: courierID,
+		onBreak:   onBreak,
+		guard:     guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrSetCourierBreakCommandIsNotConstructed if validation fails.
+func (c SetCourierBreakCommand) Validate() error {
+	return c.guard.Validate(ErrSetCourierBreakCommandIsNotConstructed)
+}
+
+// CourierID returns the ID of the courier starting or ending the break.
+func (c SetCourierBreakCommand) CourierID() kernel.UUID {
+	return c.courierID
+}
+
+// OnBreak reports whether the break should be started rather than ended.
+func (c SetCourierBreakCommand) OnBreak() bool {
+	return c.onBreak
+}
@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/pkg/tracing"
+)
+
+// SetCourierBreakCommandHandler handles couriers starting and ending breaks during the shift.
+// A courier on a break receives no orders, keeps the orders it carries without moving on and is
+// exempt from the inactivity watchdog; ending the break brings the courier back Available, or
+// Busy when carrying orders.
+//
+// Example:
+//
+//	handler := NewSetCourierBreakCommandHandler(uowFactory)
+//	cmd, _ := NewSetCourierBreakCommand(courierID, true)
+//	if err := handler.Handle(ctx, cmd); errors.Is(err, courier.ErrStatusTransitionIsInvalid) {
+//	    log.Printf("Courier %s is off shift", courierID)
+//	}
+type SetCourierBreakCommandHandler struct {
+	uowFactory CourierUoWFactory
+}
+
+// NewSetCourierBreakCommandHandler creates a new handler for courier breaks.
+// Requires a CourierUoWFactory for transactional operations.
+func NewSetCourierBreakCommandHandler(uowFactory CourierUoWFactory) SetCourierBreakCommandHandler {
+	return SetCourierBreakCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the SetCourierBreakCommand within a transaction.
+// Starting a break the courier already takes, or ending one it does not, succeeds without a change.
+// Returns courier.ErrStatusTransitionIsInvalid if the courier is off dispatch, and
+// courier.ErrShiftIsNotStarted or courier.ErrCourierIsDeactivated if the courier cannot go online.
+func (h *SetCourierBreakCommandHandler) Handle(ctx context.Context, cmd SetCourierBreakCommand) error {
+	ctx, span := tracing.Start(ctx, "SetCourierBreakCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+
+	uow := h.uowFactory.Create()
+	return uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		courierEntity, err := courierRepo.Get(ctx, cmd.CourierID())
+		if err != nil {
+			return err
+		}
+		if courierEntity.IsOnBreak() == cmd.OnBreak() {
+			return nil
+		}
+
+		if cmd.OnBreak() {
+			err = courierEntity.StartBreak()
+		} else {
+			err = courierEntity.GoOnline()
+		}
+		if err != nil {
+			return err
+		}
+
+		return courierRepo.Update(ctx, courierEntity)
+	})
+}
@@ -0,0 +1,103 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetCourierBreakCommandHandler_Handle_StartAndEnd(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+	require.NoError(t, courierEntity.StartShift(time.Now(), createShiftLimit(t)))
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+
+	mockFactory.On("Create").Return(mockUoW).Twice()
+	mockUoW.On("Begin", ctx).Return(nil).Twice()
+	mockUoW.On("CourierRepository").Return(mockRepo).Twice()
+	mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil).Twice()
+	mockRepo.On("Update", ctx, courierEntity).Return(nil).Twice()
+	mockUoW.On("Commit", ctx).Return(nil).Twice()
+	mockUoW.On("Rollback", ctx).Return(nil).Maybe()
+
+	handler := commands.NewSetCourierBreakCommandHandler(mockFactory)
+
+	start, err := commands.NewSetCourierBreakCommand(courierEntity.ID(), true)
+	require.NoError(t, err)
+	require.NoError(t, handler.Handle(ctx, start))
+	assert.True(t, courierEntity.IsOnBreak())
+
+	end, err := commands.NewSetCourierBreakCommand(courierEntity.ID(), false)
+	require.NoError(t, err)
+	require.NoError(t, handler.Handle(ctx, end))
+	assert.Equal(t, courier.Available, courierEntity.Status())
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSetCourierBreakCommandHandler_Handle_NotOnBreak(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+	require.NoError(t, courierEntity.StartShift(time.Now(), createShiftLimit(t)))
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+
+	mockFactory.On("Create").Return(mockUoW).Once()
+	mockUoW.On("Begin", ctx).Return(nil).Once()
+	mockUoW.On("CourierRepository").Return(mockRepo).Once()
+	mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil).Once()
+	mockUoW.On("Commit", ctx).Return(nil).Once()
+	mockUoW.On("Rollback", ctx).Return(nil).Maybe()
+
+	cmd, err := commands.NewSetCourierBreakCommand(courierEntity.ID(), false)
+	require.NoError(t, err)
+
+	handler := commands.NewSetCourierBreakCommandHandler(mockFactory)
+	require.NoError(t, handler.Handle(ctx, cmd))
+
+	assert.Equal(t, courier.Available, courierEntity.Status())
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestSetCourierBreakCommandHandler_Handle_OffShift(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+
+	mockFactory.On("Create").Return(mockUoW).Once()
+	mockUoW.On("Begin", ctx).Return(nil).Once()
+	mockUoW.On("CourierRepository").Return(mockRepo).Once()
+	mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil).Once()
+	mockUoW.On("Rollback", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewSetCourierBreakCommand(courierEntity.ID(), true)
+	require.NoError(t, err)
+
+	handler := commands.NewSetCourierBreakCommandHandler(mockFactory)
+	err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, courier.ErrStatusTransitionIsInvalid)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestSetCourierBreakCommandHandler_Handle_ValidationError(t *testing.T) {
+	handler := commands.NewSetCourierBreakCommandHandler(new(MockCourierUoWFactory))
+
+	err := handler.Handle(t.Context(), commands.SetCourierBreakCommand{})
+
+	require.ErrorIs(t, err, commands.ErrSetCourierBreakCommandIsNotConstructed)
+}
@@ -0,0 +1,34 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSetCourierBreakCommand_ValidInput(t *testing.T) {
+	courierID := kernel.NewUUID()
+
+	cmd, err := commands.NewSetCourierBreakCommand(courierID, true)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, courierID, cmd.CourierID())
+	assert.True(t, cmd.OnBreak())
+}
+
+func TestNewSetCourierBreakCommand_InvalidID(t *testing.T) {
+	_, err := commands.NewSetCourierBreakCommand(kernel.UUID{}, false)
+
+	require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+}
+
+func TestSetCourierBreakCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.SetCourierBreakCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrSetCourierBreakCommandIsNotConstructed)
+}
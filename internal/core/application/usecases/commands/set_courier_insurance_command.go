@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrSetCourierInsuranceCommandIsNotConstructed = errors.New(
+		"SetCourierInsuranceCommand must be created via NewSetCourierInsuranceCommand constructor",
+	)
+)
+
+// SetCourierInsuranceCommand represents a request to insure a courier for high-value orders
+// or to revoke the insurance.
+//
+// Example:
+//
+//	cmd, err := NewSetCourierInsuranceCommand(courierID, true)
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewSetCourierInsuranceCommandHandler(uowFactory)
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    return fmt.Errorf("failed to insure courier: %w", err)
+//	}
+type SetCourierInsuranceCommand struct { //nolint:recvcheck //using for validation
+	courierID kernel.UUID
+	insured   bool
+
+	guard guard.ConstructorGuard
+}
+
+// NewSetCourierInsuranceCommand creates a command to insure (insured) a courier or revoke the insurance.
+// Returns an error if the courier ID is invalid.
+func NewSetCourierInsuranceCommand(courierID kernel.UUID, insured bool) (SetCourierInsuranceCommand, error) {
+	command := SetCourierInsuranceCommand{
+		insured: insured,
+		guard:   guard.NewConstructorGuard(),
+	}
+
+	if err := command.setCourierID(courierID); err != nil {
+		return SetCourierInsuranceCommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrSetCourierInsuranceCommandIsNotConstructed if validation fails.
+func (c SetCourierInsuranceCommand) Validate() error {
+	return c.guard.Validate(ErrSetCourierInsuranceCommandIsNotConstructed)
+}
+
+// CourierID returns the ID of the courier whose insurance changes.
+func (c SetCourierInsuranceCommand) CourierID() kernel.UUID {
+	return c.courierID
+}
+
+// Insured reports whether the courier should be insured rather than have the insurance revoked.
+func (c SetCourierInsuranceCommand) Insured() bool {
+	return c.insured
+}
+
+func (c *SetCourierInsuranceCommand) setCourierID(courierID kernel.UUID) error {
+	if err := courierID.Validate(); err != nil {
+		return err
+	}
+
+	c.courierID = courierID
+	return nil
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/pkg/tracing"
+)
+
+// SetCourierInsuranceCommandHandler insures couriers for high-value orders and revokes the insurance.
+// Only insured couriers are offered orders whose declared value reaches the insurance threshold;
+// revoking the insurance leaves the insured orders the courier already carries with them.
+//
+// Example:
+//
+//	handler := NewSetCourierInsuranceCommandHandler(uowFactory)
+//	cmd, _ := NewSetCourierInsuranceCommand(courierID, true)
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    log.Printf("Failed to insure courier: %v", err)
+//	}
+type SetCourierInsuranceCommandHandler struct {
+	uowFactory CourierUoWFactory
+}
+
+// NewSetCourierInsuranceCommandHandler creates a new handler for courier insurance.
+// Requires a CourierUoWFactory for transactional operations.
+func NewSetCourierInsuranceCommandHandler(uowFactory CourierUoWFactory) SetCourierInsuranceCommandHandler {
+	return SetCourierInsuranceCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the SetCourierInsuranceCommand within a transaction.
+// Retrieves the courier, insures it or revokes the insurance, and persists the changes.
+func (h *SetCourierInsuranceCommandHandler) Handle(ctx context.Context, cmd SetCourierInsuranceCommand) error {
+	ctx, span := tracing.Start(ctx, "SetCourierInsuranceCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+
+	uow := h.uowFactory.Create()
+	return uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		courierEntity, err := courierRepo.Get(ctx, cmd.CourierID())
+		if err != nil {
+			return err
+		}
+
+		if cmd.Insured() {
+			courierEntity.Insure()
+		} else {
+			courierEntity.RevokeInsurance()
+		}
+
+		return courierRepo.Update(ctx, courierEntity)
+	})
+}
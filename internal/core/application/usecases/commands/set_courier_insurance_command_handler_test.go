@@ -0,0 +1,49 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetCourierInsuranceCommandHandler_Handle_InsureAndRevoke(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+
+	mockFactory.On("Create").Return(mockUoW)
+	mockUoW.On("Begin", ctx).Return(nil)
+	mockUoW.On("CourierRepository").Return(mockRepo)
+	mockUoW.On("Commit", ctx).Return(nil)
+	mockUoW.On("Rollback", ctx).Return(nil).Maybe()
+	mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil)
+	mockRepo.On("Update", ctx, courierEntity).Return(nil).Twice()
+
+	handler := commands.NewSetCourierInsuranceCommandHandler(mockFactory)
+
+	insure, err := commands.NewSetCourierInsuranceCommand(courierEntity.ID(), true)
+	require.NoError(t, err)
+	require.NoError(t, handler.Handle(ctx, insure))
+	assert.True(t, courierEntity.IsInsured())
+
+	revoke, err := commands.NewSetCourierInsuranceCommand(courierEntity.ID(), false)
+	require.NoError(t, err)
+	require.NoError(t, handler.Handle(ctx, revoke))
+	assert.False(t, courierEntity.IsInsured())
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSetCourierInsuranceCommandHandler_Handle_ValidationError(t *testing.T) {
+	handler := commands.NewSetCourierInsuranceCommandHandler(new(MockCourierUoWFactory))
+
+	err := handler.Handle(t.Context(), commands.SetCourierInsuranceCommand{})
+
+	require.ErrorIs(t, err, commands.ErrSetCourierInsuranceCommandIsNotConstructed)
+}
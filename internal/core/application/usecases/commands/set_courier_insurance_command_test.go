@@ -0,0 +1,34 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSetCourierInsuranceCommand_ValidInput(t *testing.T) {
+	courierID := kernel.NewUUID()
+
+	cmd, err := commands.NewSetCourierInsuranceCommand(courierID, true)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, courierID, cmd.CourierID())
+	assert.True(t, cmd.Insured())
+}
+
+func TestNewSetCourierInsuranceCommand_InvalidID(t *testing.T) {
+	_, err := commands.NewSetCourierInsuranceCommand(kernel.UUID{}, false)
+
+	require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+}
+
+func TestSetCourierInsuranceCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.SetCourierInsuranceCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrSetCourierInsuranceCommandIsNotConstructed)
+}
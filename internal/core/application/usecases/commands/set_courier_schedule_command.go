@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"errors"
+	"slices"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrSetCourierScheduleCommandIsNotConstructed = errors.New(
+		"SetCourierScheduleCommand must be created via NewSetCourierScheduleCommand constructor",
+	)
+)
+
+// SetCourierScheduleCommand represents operations replacing the weekly working schedule of a courier.
+//
+// Example:
+//
+//	monday, _ := courier.NewScheduleWindow(time.Monday, 9*time.Hour, 17*time.Hour)
+//	cmd, err := NewSetCourierScheduleCommand(courierID, []courier.ScheduleWindow{monday})
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewSetCourierScheduleCommandHandler(uowFactory)
+//	schedule, err := handler.Handle(ctx, cmd)
+type SetCourierScheduleCommand struct { //nolint:recvcheck //using for validation
+	courierID kernel.UUID
+	windows   []courier.ScheduleWindow
+
+	guard guard.ConstructorGuard
+}
+
+// NewSetCourierScheduleCommand creates a command to set a courier's schedule.
+// Returns an error if the courier ID is invalid; the windows are validated by the schedule.
+func NewSetCourierScheduleCommand(
+	courierID kernel.UUID,
+	windows []courier.ScheduleWindow,
+) (SetCourierScheduleCommand, error) {
+	if err := courierID.Validate(); err != nil {
+		return SetCourierScheduleCommand{}, err
+	}
+
+	return SetCourierScheduleCommand{
+		courierID: courierID,
+		windows:   slices.Clone(windows),
+		guard:     guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrSetCourierScheduleCommandIsNotConstructed if validation fails.
+func (c SetCourierScheduleCommand) Validate() error {
+	return c.guard.Validate(ErrSetCourierScheduleCommandIsNotConstructed)
+}
+
+// CourierID returns the ID of the courier whose schedule is set.
+func (c SetCourierScheduleCommand) CourierID() kernel.UUID {
+	return c.courierID
+}
+
+// Windows returns a copy of the working windows of the new schedule.
+func (c SetCourierScheduleCommand) Windows() []courier.ScheduleWindow {
+	return slices.Clone(c.windows)
+}
@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/tracing"
+)
+
+// SetCourierScheduleCommandHandler replaces the weekly working schedules of couriers.
+// The courier is then dispatched only within the windows of the schedule.
+//
+// Example:
+//
+//	handler := NewSetCourierScheduleCommandHandler(uowFactory)
+//	cmd, _ := NewSetCourierScheduleCommand(courierID, windows)
+//	if _, err := handler.Handle(ctx, cmd); errors.Is(err, courier.ErrScheduleWindowsOverlap) {
+//	    log.Printf("Schedule windows of courier %s overlap", courierID)
+//	}
+type SetCourierScheduleCommandHandler struct {
+	uowFactory CourierUoWFactory
+}
+
+// NewSetCourierScheduleCommandHandler creates a new handler for setting schedules.
+// Requires a CourierUoWFactory for transactional operations.
+func NewSetCourierScheduleCommandHandler(uowFactory CourierUoWFactory) SetCourierScheduleCommandHandler {
+	return SetCourierScheduleCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the SetCourierScheduleCommand within a transaction and returns the new schedule.
+// Returns a validation error without windows, or courier.ErrScheduleWindowsOverlap
+// when two windows overlap.
+func (h *SetCourierScheduleCommandHandler) Handle(
+	ctx context.Context,
+	cmd SetCourierScheduleCommand,
+) (courier.Schedule, error) {
+	ctx, span := tracing.Start(ctx, "SetCourierScheduleCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return courier.Schedule{}, err
+	}
+
+	schedule, err := courier.NewSchedule(kernel.NewUUID(), cmd.Windows())
+	if err != nil {
+		return courier.Schedule{}, err
+	}
+
+	uow := h.uowFactory.Create()
+	err = uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		courierEntity, err := courierRepo.Get(ctx, cmd.CourierID())
+		if err != nil {
+			return err
+		}
+
+		if err = courierEntity.SetSchedule(schedule); err != nil {
+			return err
+		}
+
+		return courierRepo.Update(ctx, courierEntity)
+	})
+	if err != nil {
+		return courier.Schedule{}, err
+	}
+
+	return schedule, nil
+}
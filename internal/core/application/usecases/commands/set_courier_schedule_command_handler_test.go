@@ -0,0 +1,72 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetCourierScheduleCommandHandler_Handle_Success(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+	window, err := courier.NewScheduleWindow(time.Monday, 9*time.Hour, 17*time.Hour)
+	require.NoError(t, err)
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+
+	mockFactory.On("Create").Return(mockUoW)
+	mockUoW.On("Begin", ctx).Return(nil)
+	mockUoW.On("CourierRepository").Return(mockRepo)
+	mockUoW.On("Commit", ctx).Return(nil)
+	mockUoW.On("Rollback", ctx).Return(nil).Maybe()
+	mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil)
+	mockRepo.On("Update", ctx, courierEntity).Return(nil).Once()
+
+	cmd, err := commands.NewSetCourierScheduleCommand(courierEntity.ID(), []courier.ScheduleWindow{window})
+	require.NoError(t, err)
+
+	handler := commands.NewSetCourierScheduleCommandHandler(mockFactory)
+	schedule, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	require.NotNil(t, courierEntity.Schedule())
+	assert.Equal(t, schedule.ID(), courierEntity.Schedule().ID())
+	assert.Equal(t, []courier.ScheduleWindow{window}, schedule.Windows())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSetCourierScheduleCommandHandler_Handle_WindowsOverlap(t *testing.T) {
+	morning, err := courier.NewScheduleWindow(time.Monday, 9*time.Hour, 13*time.Hour)
+	require.NoError(t, err)
+	noon, err := courier.NewScheduleWindow(time.Monday, 12*time.Hour, 17*time.Hour)
+	require.NoError(t, err)
+
+	mockFactory := new(MockCourierUoWFactory)
+
+	cmd, err := commands.NewSetCourierScheduleCommand(
+		createCourierForMaintenance(t).ID(),
+		[]courier.ScheduleWindow{morning, noon},
+	)
+	require.NoError(t, err)
+
+	handler := commands.NewSetCourierScheduleCommandHandler(mockFactory)
+	_, err = handler.Handle(t.Context(), cmd)
+
+	require.ErrorIs(t, err, courier.ErrScheduleWindowsOverlap)
+	mockFactory.AssertNotCalled(t, "Create")
+}
+
+func TestSetCourierScheduleCommandHandler_Handle_ValidationError(t *testing.T) {
+	handler := commands.NewSetCourierScheduleCommandHandler(new(MockCourierUoWFactory))
+
+	_, err := handler.Handle(t.Context(), commands.SetCourierScheduleCommand{})
+
+	require.ErrorIs(t, err, commands.ErrSetCourierScheduleCommandIsNotConstructed)
+}
@@ -0,0 +1,38 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSetCourierScheduleCommand_ValidInput(t *testing.T) {
+	courierID := kernel.NewUUID()
+	window, err := courier.NewScheduleWindow(time.Monday, 9*time.Hour, 17*time.Hour)
+	require.NoError(t, err)
+
+	cmd, err := commands.NewSetCourierScheduleCommand(courierID, []courier.ScheduleWindow{window})
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, courierID, cmd.CourierID())
+	assert.Equal(t, []courier.ScheduleWindow{window}, cmd.Windows())
+}
+
+func TestNewSetCourierScheduleCommand_InvalidID(t *testing.T) {
+	_, err := commands.NewSetCourierScheduleCommand(kernel.UUID{}, nil)
+
+	require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+}
+
+func TestSetCourierScheduleCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.SetCourierScheduleCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrSetCourierScheduleCommandIsNotConstructed)
+}
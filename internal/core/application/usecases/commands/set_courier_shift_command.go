@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/identity"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrSetCourierShiftCommandIsNotConstructed = errors.New(
+		"SetCourierShiftCommand must be created via NewSetCourierShiftCommand constructor",
+	)
+)
+
+// SetCourierShiftCommand represents a request to start or end a courier's working shift.
+//
+// Example:
+//
+//	proof, _ := identity.NewProof(identity.PIN, "4821")
+//	cmd, err := NewSetCourierShiftCommand(courierID, true, &proof)
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewSetCourierShiftCommandHandler(uowFactory, limit)
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    return fmt.Errorf("failed to start shift: %w", err)
+//	}
+type SetCourierShiftCommand struct { //nolint:recvcheck //using for validation
+	courierID kernel.UUID
+	onShift   bool
+	proof     *identity.Proof
+
+	guard guard.ConstructorGuard
+}
+
+// NewSetCourierShiftCommand creates a command to start (onShift) or end a courier's shift.
+// proof is the courier's proof of identity, required to start a shift when identity
+// verification is enabled and nil otherwise; it is ignored when ending a shift.
+// Returns an error if the courier ID or the proof is invalid.
+func NewSetCourierShiftCommand(
+	courierID kernel.UUID,
+	onShift bool,
+	proof *identity.Proof,
+) (SetCourierShiftCommand, error) {
+	command := SetCourierShiftCommand{
+		onShift: onShift,
+		guard:   guard.NewConstructorGuard(),
+	}
+
+	if err := command.setCourierID(courierID); err != nil {
+		return SetCourierShiftCommand{}, err
+	}
+	if err := command.setProof(proof); err != nil {
+		return SetCourierShiftCommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrSetCourierShiftCommandIsNotConstructed if validation fails.
+func (c SetCourierShiftCommand) Validate() error {
+	return c.guard.Validate(ErrSetCourierShiftCommandIsNotConstructed)
+}
+
+// CourierID returns the ID of the courier starting or ending the shift.
+func (c SetCourierShiftCommand) CourierID() kernel.UUID {
+	return c.courierID
+}
+
+// OnShift reports whether the shift should be started rather than ended.
+func (c SetCourierShiftCommand) OnShift() bool {
+	return c.onShift
+}
+
+// Proof returns the courier's proof of identity, nil when none was presented.
+func (c SetCourierShiftCommand) Proof() *identity.Proof {
+	return c.proof
+}
+
+func (c *SetCourierShiftCommand) setCourierID(courierID kernel.UUID) error {
+	if err := courierID.Validate(); err != nil {
+		return err
+	}
+
+	c.courierID = courierID
+	return nil
+}
+
+func (c *SetCourierShiftCommand) setProof(proof *identity.Proof) error {
+	if proof == nil {
+		return nil
+	}
+	if err := proof.Validate(); err != nil {
+		return err
+	}
+
+	c.proof = proof
+	return nil
+}
@@ -0,0 +1,155 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/identity"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tracing"
+)
+
+// IdentityVerificationObserver is notified whenever a courier's proof of identity is rejected,
+// with the time until which the courier is refused further attempts, nil if they may try again.
+type IdentityVerificationObserver interface {
+	IdentityVerificationFailed(ctx context.Context, attempt identity.Attempt, lockedUntil *time.Time)
+}
+
+// SetCourierShiftCommandHandler handles couriers starting and ending their working shifts.
+// Starting a shift is refused once the courier worked the daily working hours limit, and, with
+// identity verification enabled, unless the courier proves their identity.
+//
+// Example:
+//
+//	handler := NewSetCourierShiftCommandHandler(uowFactory, limit)
+//	cmd, _ := NewSetCourierShiftCommand(courierID, true, nil)
+//	if err := handler.Handle(ctx, cmd); errors.Is(err, courier.ErrDailyWorkingHoursExceeded) {
+//	    log.Printf("Courier %s has to rest until tomorrow", courierID)
+//	}
+type SetCourierShiftCommandHandler struct {
+	uowFactory CourierUoWFactory
+	limit      courier.WorkingHoursLimit
+
+	verifier     ports.IdentityVerifier
+	attempts     ports.VerificationAttemptRepository
+	attemptLimit identity.AttemptLimit
+	observer     IdentityVerificationObserver
+}
+
+// NewSetCourierShiftCommandHandler creates a new handler for courier shifts.
+// Requires a CourierUoWFactory for transactional operations and the daily working hours limit.
+func NewSetCourierShiftCommandHandler(
+	uowFactory CourierUoWFactory,
+	limit courier.WorkingHoursLimit,
+) SetCourierShiftCommandHandler {
+	return SetCourierShiftCommandHandler{
+		uowFactory: uowFactory,
+		limit:      limit,
+	}
+}
+
+// WithIdentityVerification returns a copy of the handler that requires couriers to prove their
+// identity to the verifier when starting a shift. Every verified or rejected attempt is audited,
+// rejections are reported to the observer, and couriers who used up the attempt limit are
+// refused until the window lets them try again. observer may be nil.
+func (h SetCourierShiftCommandHandler) WithIdentityVerification(
+	verifier ports.IdentityVerifier,
+	attempts ports.VerificationAttemptRepository,
+	limit identity.AttemptLimit,
+	observer IdentityVerificationObserver,
+) SetCourierShiftCommandHandler {
+	h.verifier = verifier
+	h.attempts = attempts
+	h.attemptLimit = limit
+	h.observer = observer
+	return h
+}
+
+// Handle processes the SetCourierShiftCommand within a transaction.
+// Retrieves the courier, starts or ends the shift at the current time, and persists the changes.
+// Returns courier.ErrShiftIsAlreadyStarted, courier.ErrShiftIsNotStarted or
+// courier.ErrDailyWorkingHoursExceeded when the shift cannot be changed, and
+// identity.ErrProofIsRequired, identity.ErrTooManyAttempts or identity.ErrVerificationFailed
+// when the courier could not prove their identity.
+func (h *SetCourierShiftCommandHandler) Handle(ctx context.Context, cmd SetCourierShiftCommand) error {
+	ctx, span := tracing.Start(ctx, "SetCourierShiftCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if cmd.OnShift() && h.verifier != nil {
+		if err := h.verifyIdentity(ctx, cmd.CourierID(), cmd.Proof(), now); err != nil {
+			return err
+		}
+	}
+
+	uow := h.uowFactory.Create()
+	return uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		courierEntity, err := courierRepo.Get(ctx, cmd.CourierID())
+		if err != nil {
+			return err
+		}
+
+		if cmd.OnShift() {
+			err = courierEntity.StartShift(now, h.limit)
+		} else {
+			err = courierEntity.EndShift(now)
+		}
+		if err != nil {
+			return err
+		}
+
+		return courierRepo.Update(ctx, courierEntity)
+	})
+}
+
+// verifyIdentity checks the courier's proof with the verifier and audits the attempt.
+// Attempts refused by the attempt limit never reach the verifier and are not audited, so they
+// do not extend the lock. When the verifier fails, the attempt is not counted against the courier.
+func (h *SetCourierShiftCommandHandler) verifyIdentity(
+	ctx context.Context,
+	courierID kernel.UUID,
+	proof *identity.Proof,
+	now time.Time,
+) error {
+	if proof == nil {
+		return identity.ErrProofIsRequired
+	}
+
+	recent, err := h.attempts.GetSince(ctx, courierID, now.Add(-h.attemptLimit.Window()))
+	if err != nil {
+		return err
+	}
+	if err = h.attemptLimit.Check(recent, now); err != nil {
+		return err
+	}
+
+	verification, err := h.verifier.Verify(ctx, courierID, *proof)
+	if err != nil {
+		return err
+	}
+
+	attempt, err := identity.NewAttempt(
+		kernel.NewUUID(), courierID, proof.Method(), verification.Verified, verification.Reason, now,
+	)
+	if err != nil {
+		return err
+	}
+	if err = h.attempts.Add(ctx, attempt); err != nil {
+		return err
+	}
+
+	if verification.Verified {
+		return nil
+	}
+	if h.observer != nil {
+		h.observer.IdentityVerificationFailed(ctx, attempt, h.attemptLimit.LockedUntil(append(recent, attempt), now))
+	}
+	return identity.ErrVerificationFailed
+}
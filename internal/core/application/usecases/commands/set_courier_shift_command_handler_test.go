@@ -0,0 +1,291 @@
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/identity"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createShiftLimit(t *testing.T) courier.WorkingHoursLimit {
+	t.Helper()
+	limit, err := courier.NewWorkingHoursLimit(8*time.Hour, 30*time.Minute)
+	require.NoError(t, err)
+	return limit
+}
+
+type MockIdentityVerifier struct {
+	mock.Mock
+}
+
+func (m *MockIdentityVerifier) Verify(
+	ctx context.Context,
+	courierID kernel.UUID,
+	proof identity.Proof,
+) (ports.IdentityVerification, error) {
+	args := m.Called(ctx, courierID, proof)
+	return args.Get(0).(ports.IdentityVerification), args.Error(1)
+}
+
+// MockVerificationAttemptRepository keeps the added attempts in memory.
+type MockVerificationAttemptRepository struct {
+	attempts []identity.Attempt
+}
+
+func (m *MockVerificationAttemptRepository) Add(_ context.Context, attempt identity.Attempt) error {
+	m.attempts = append(m.attempts, attempt)
+	return nil
+}
+
+func (m *MockVerificationAttemptRepository) GetSince(
+	_ context.Context,
+	courierID kernel.UUID,
+	since time.Time,
+) ([]identity.Attempt, error) {
+	var attempts []identity.Attempt
+	for _, attempt := range m.attempts {
+		if attempt.CourierID() == courierID && !attempt.AttemptedAt().Before(since) {
+			attempts = append(attempts, attempt)
+		}
+	}
+	return attempts, nil
+}
+
+// MockIdentityVerificationObserver records every rejected attempt and the reported lock.
+type MockIdentityVerificationObserver struct {
+	failures []identity.Attempt
+	locks    []*time.Time
+}
+
+func (m *MockIdentityVerificationObserver) IdentityVerificationFailed(
+	_ context.Context,
+	attempt identity.Attempt,
+	lockedUntil *time.Time,
+) {
+	m.failures = append(m.failures, attempt)
+	m.locks = append(m.locks, lockedUntil)
+}
+
+func createShiftProof(t *testing.T) *identity.Proof {
+	t.Helper()
+	proof, err := identity.NewProof(identity.PIN, "4821")
+	require.NoError(t, err)
+	return &proof
+}
+
+func createAttemptLimit(t *testing.T) identity.AttemptLimit {
+	t.Helper()
+	limit, err := identity.NewAttemptLimit(2, 15*time.Minute)
+	require.NoError(t, err)
+	return limit
+}
+
+func TestSetCourierShiftCommandHandler_Handle_StartAndEnd(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+
+	mockFactory.On("Create").Return(mockUoW)
+	mockUoW.On("Begin", ctx).Return(nil)
+	mockUoW.On("CourierRepository").Return(mockRepo)
+	mockUoW.On("Commit", ctx).Return(nil)
+	mockUoW.On("Rollback", ctx).Return(nil).Maybe()
+	mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil)
+	mockRepo.On("Update", ctx, courierEntity).Return(nil).Twice()
+
+	handler := commands.NewSetCourierShiftCommandHandler(mockFactory, createShiftLimit(t))
+
+	start, err := commands.NewSetCourierShiftCommand(courierEntity.ID(), true, nil)
+	require.NoError(t, err)
+	require.NoError(t, handler.Handle(ctx, start))
+	assert.True(t, courierEntity.WorkLog().IsOnShift())
+
+	end, err := commands.NewSetCourierShiftCommand(courierEntity.ID(), false, nil)
+	require.NoError(t, err)
+	require.NoError(t, handler.Handle(ctx, end))
+	assert.False(t, courierEntity.WorkLog().IsOnShift())
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSetCourierShiftCommandHandler_Handle_LimitReached(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+	workLog, err := courier.RestoreWorkLog(time.Now(), 8*time.Hour, nil)
+	require.NoError(t, err)
+	courierEntity.RestoreWorkLog(workLog)
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+
+	mock.InOrder(
+		mockFactory.On("Create").Return(mockUoW).Once(),
+		mockUoW.On("Begin", ctx).Return(nil).Once(),
+		mockUoW.On("CourierRepository").Return(mockRepo).Once(),
+		mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil).Once(),
+		mockUoW.On("Rollback", ctx).Return(nil).Once(),
+	)
+
+	cmd, err := commands.NewSetCourierShiftCommand(courierEntity.ID(), true, nil)
+	require.NoError(t, err)
+
+	handler := commands.NewSetCourierShiftCommandHandler(mockFactory, createShiftLimit(t))
+	err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, courier.ErrDailyWorkingHoursExceeded)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mockUoW.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+func TestSetCourierShiftCommandHandler_Handle_ValidationError(t *testing.T) {
+	handler := commands.NewSetCourierShiftCommandHandler(new(MockCourierUoWFactory), createShiftLimit(t))
+
+	err := handler.Handle(t.Context(), commands.SetCourierShiftCommand{})
+
+	require.ErrorIs(t, err, commands.ErrSetCourierShiftCommandIsNotConstructed)
+}
+
+func TestSetCourierShiftCommandHandler_Handle_VerifiedIdentity(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+	proof := createShiftProof(t)
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+	mockFactory.On("Create").Return(mockUoW)
+	mockUoW.On("Begin", ctx).Return(nil)
+	mockUoW.On("CourierRepository").Return(mockRepo)
+	mockUoW.On("Commit", ctx).Return(nil)
+	mockUoW.On("Rollback", ctx).Return(nil).Maybe()
+	mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil)
+	mockRepo.On("Update", ctx, courierEntity).Return(nil)
+
+	verifier := new(MockIdentityVerifier)
+	verifier.On("Verify", ctx, courierEntity.ID(), *proof).Return(ports.IdentityVerification{Verified: true}, nil)
+	attempts := new(MockVerificationAttemptRepository)
+	observer := new(MockIdentityVerificationObserver)
+
+	handler := commands.NewSetCourierShiftCommandHandler(mockFactory, createShiftLimit(t)).
+		WithIdentityVerification(verifier, attempts, createAttemptLimit(t), observer)
+
+	cmd, err := commands.NewSetCourierShiftCommand(courierEntity.ID(), true, proof)
+	require.NoError(t, err)
+	require.NoError(t, handler.Handle(ctx, cmd))
+
+	assert.True(t, courierEntity.WorkLog().IsOnShift())
+	require.Len(t, attempts.attempts, 1)
+	assert.True(t, attempts.attempts[0].IsVerified())
+	assert.Equal(t, identity.PIN, attempts.attempts[0].Method())
+	assert.Empty(t, observer.failures)
+}
+
+func TestSetCourierShiftCommandHandler_Handle_RejectedIdentity(t *testing.T) {
+	ctx := t.Context()
+	courierID := kernel.NewUUID()
+	proof := createShiftProof(t)
+
+	mockFactory := new(MockCourierUoWFactory)
+	verifier := new(MockIdentityVerifier)
+	verifier.On("Verify", ctx, courierID, *proof).
+		Return(ports.IdentityVerification{Verified: false, Reason: "wrong PIN"}, nil).Twice()
+	attempts := new(MockVerificationAttemptRepository)
+	observer := new(MockIdentityVerificationObserver)
+
+	handler := commands.NewSetCourierShiftCommandHandler(mockFactory, createShiftLimit(t)).
+		WithIdentityVerification(verifier, attempts, createAttemptLimit(t), observer)
+	cmd, err := commands.NewSetCourierShiftCommand(courierID, true, proof)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, handler.Handle(ctx, cmd), identity.ErrVerificationFailed)
+	require.ErrorIs(t, handler.Handle(ctx, cmd), identity.ErrVerificationFailed)
+
+	require.Len(t, attempts.attempts, 2)
+	assert.Equal(t, "wrong PIN", attempts.attempts[0].Reason())
+	require.Len(t, observer.failures, 2)
+	assert.Nil(t, observer.locks[0])
+	require.NotNil(t, observer.locks[1], "the second failure uses up the attempts")
+
+	// Further attempts are refused without asking the verifier or auditing them
+	require.ErrorIs(t, handler.Handle(ctx, cmd), identity.ErrTooManyAttempts)
+	assert.Len(t, attempts.attempts, 2)
+	verifier.AssertExpectations(t)
+	mockFactory.AssertNotCalled(t, "Create")
+}
+
+func TestSetCourierShiftCommandHandler_Handle_ProofRequired(t *testing.T) {
+	mockFactory := new(MockCourierUoWFactory)
+	verifier := new(MockIdentityVerifier)
+	attempts := new(MockVerificationAttemptRepository)
+
+	handler := commands.NewSetCourierShiftCommandHandler(mockFactory, createShiftLimit(t)).
+		WithIdentityVerification(verifier, attempts, createAttemptLimit(t), nil)
+	cmd, err := commands.NewSetCourierShiftCommand(kernel.NewUUID(), true, nil)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, handler.Handle(t.Context(), cmd), identity.ErrProofIsRequired)
+	assert.Empty(t, attempts.attempts)
+	verifier.AssertNotCalled(t, "Verify", mock.Anything, mock.Anything, mock.Anything)
+	mockFactory.AssertNotCalled(t, "Create")
+}
+
+func TestSetCourierShiftCommandHandler_Handle_VerifierUnavailable(t *testing.T) {
+	ctx := t.Context()
+	courierID := kernel.NewUUID()
+	proof := createShiftProof(t)
+	unavailable := errors.New("identity service unavailable")
+
+	verifier := new(MockIdentityVerifier)
+	verifier.On("Verify", ctx, courierID, *proof).Return(ports.IdentityVerification{}, unavailable)
+	attempts := new(MockVerificationAttemptRepository)
+
+	handler := commands.NewSetCourierShiftCommandHandler(new(MockCourierUoWFactory), createShiftLimit(t)).
+		WithIdentityVerification(verifier, attempts, createAttemptLimit(t), nil)
+	cmd, err := commands.NewSetCourierShiftCommand(courierID, true, proof)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, handler.Handle(ctx, cmd), unavailable)
+	assert.Empty(t, attempts.attempts, "an unchecked proof does not count against the courier")
+}
+
+func TestSetCourierShiftCommandHandler_Handle_EndWithoutVerification(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+	require.NoError(t, courierEntity.StartShift(time.Now().Add(-time.Hour), createShiftLimit(t)))
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+	mockFactory.On("Create").Return(mockUoW)
+	mockUoW.On("Begin", ctx).Return(nil)
+	mockUoW.On("CourierRepository").Return(mockRepo)
+	mockUoW.On("Commit", ctx).Return(nil)
+	mockUoW.On("Rollback", ctx).Return(nil).Maybe()
+	mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil)
+	mockRepo.On("Update", ctx, courierEntity).Return(nil)
+
+	verifier := new(MockIdentityVerifier)
+	handler := commands.NewSetCourierShiftCommandHandler(mockFactory, createShiftLimit(t)).
+		WithIdentityVerification(verifier, new(MockVerificationAttemptRepository), createAttemptLimit(t), nil)
+
+	cmd, err := commands.NewSetCourierShiftCommand(courierEntity.ID(), false, nil)
+	require.NoError(t, err)
+	require.NoError(t, handler.Handle(ctx, cmd))
+
+	assert.False(t, courierEntity.WorkLog().IsOnShift())
+	verifier.AssertNotCalled(t, "Verify", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,53 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/identity"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSetCourierShiftCommand_ValidInput(t *testing.T) {
+	courierID := kernel.NewUUID()
+
+	cmd, err := commands.NewSetCourierShiftCommand(courierID, true, nil)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, courierID, cmd.CourierID())
+	assert.True(t, cmd.OnShift())
+	assert.Nil(t, cmd.Proof())
+}
+
+func TestNewSetCourierShiftCommand_WithProof(t *testing.T) {
+	proof, err := identity.NewProof(identity.DeviceAttestation, "attestation-token")
+	require.NoError(t, err)
+
+	cmd, err := commands.NewSetCourierShiftCommand(kernel.NewUUID(), true, &proof)
+
+	require.NoError(t, err)
+	require.NotNil(t, cmd.Proof())
+	assert.Equal(t, identity.DeviceAttestation, cmd.Proof().Method())
+}
+
+func TestNewSetCourierShiftCommand_InvalidProof(t *testing.T) {
+	_, err := commands.NewSetCourierShiftCommand(kernel.NewUUID(), true, &identity.Proof{})
+
+	require.ErrorIs(t, err, identity.ErrProofIsNotConstructed)
+}
+
+func TestNewSetCourierShiftCommand_InvalidID(t *testing.T) {
+	_, err := commands.NewSetCourierShiftCommand(kernel.UUID{}, false, nil)
+
+	require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+}
+
+func TestSetCourierShiftCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.SetCourierShiftCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrSetCourierShiftCommandIsNotConstructed)
+}
@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+	"delivery/internal/pkg/rollout"
+)
+
+var (
+	ErrSetRolloutPercentageCommandIsNotConstructed = errors.New(
+		"SetRolloutPercentageCommand must be created via NewSetRolloutPercentageCommand constructor",
+	)
+)
+
+// SetRolloutPercentageCommand represents a request to change the share of traffic a rollout flag
+// routes to new behavior, for example to widen or roll back a dispatch strategy experiment.
+//
+// Example:
+//
+//	cmd, err := NewSetRolloutPercentageCommand("best-fit-dispatch", 25)
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewSetRolloutPercentageCommandHandler(registry)
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    return fmt.Errorf("failed to change rollout: %w", err)
+//	}
+type SetRolloutPercentageCommand struct { //nolint:recvcheck //using for validation
+	flag       string
+	percentage rollout.Percentage
+
+	guard guard.ConstructorGuard
+}
+
+// NewSetRolloutPercentageCommand creates a command to change a rollout flag percentage.
+// Validates that the flag name is present and the percentage is between 0 and 100.
+func NewSetRolloutPercentageCommand(flag string, percentage int) (SetRolloutPercentageCommand, error) {
+	command := SetRolloutPercentageCommand{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := errors.Join(
+		command.setFlag(flag),
+		command.setPercentage(percentage),
+	); err != nil {
+		return SetRolloutPercentageCommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrSetRolloutPercentageCommandIsNotConstructed if validation fails.
+func (c SetRolloutPercentageCommand) Validate() error {
+	return c.guard.Validate(ErrSetRolloutPercentageCommandIsNotConstructed)
+}
+
+// Flag returns the name of the rollout flag to change.
+func (c SetRolloutPercentageCommand) Flag() string {
+	return c.flag
+}
+
+// Percentage returns the new share of traffic the flag enables.
+func (c SetRolloutPercentageCommand) Percentage() rollout.Percentage {
+	return c.percentage
+}
+
+func (c *SetRolloutPercentageCommand) setFlag(flag string) error {
+	if flag == "" {
+		return errs.NewValueIsRequiredError("flag")
+	}
+
+	c.flag = flag
+	return nil
+}
+
+func (c *SetRolloutPercentageCommand) setPercentage(value int) error {
+	percentage, err := rollout.NewPercentage(value)
+	if err != nil {
+		return err
+	}
+
+	c.percentage = percentage
+	return nil
+}
@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/pkg/rollout"
+	"delivery/internal/pkg/tracing"
+)
+
+// SetRolloutPercentageCommandHandler changes rollout flags at runtime.
+// Changes take effect immediately and last until the service restarts,
+// after which the configured percentages apply again.
+//
+// Example:
+//
+//	handler := NewSetRolloutPercentageCommandHandler(registry)
+//	cmd, _ := NewSetRolloutPercentageCommand("best-fit-dispatch", 0)
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    log.Printf("Failed to roll back: %v", err)
+//	}
+type SetRolloutPercentageCommandHandler struct {
+	registry *rollout.Registry
+}
+
+// NewSetRolloutPercentageCommandHandler creates a handler for rollout changes.
+func NewSetRolloutPercentageCommandHandler(registry *rollout.Registry) SetRolloutPercentageCommandHandler {
+	return SetRolloutPercentageCommandHandler{
+		registry: registry,
+	}
+}
+
+// Handle sets the percentage of the flag.
+// Returns an ObjectNotFoundError if no flag has the given name.
+func (h SetRolloutPercentageCommandHandler) Handle(ctx context.Context, cmd SetRolloutPercentageCommand) error {
+	_, span := tracing.Start(ctx, "SetRolloutPercentageCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+
+	flag, err := h.registry.Flag(cmd.Flag())
+	if err != nil {
+		return err
+	}
+
+	flag.SetPercentage(cmd.Percentage())
+	return nil
+}
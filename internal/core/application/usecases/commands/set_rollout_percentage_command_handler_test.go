@@ -0,0 +1,39 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/rollout"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetRolloutPercentageCommandHandler_Handle(t *testing.T) {
+	flag, err := rollout.NewFlag("best-fit-dispatch", 10)
+	require.NoError(t, err)
+	handler := commands.NewSetRolloutPercentageCommandHandler(rollout.NewRegistry(flag))
+
+	t.Run("changes the flag percentage", func(t *testing.T) {
+		cmd, cmdErr := commands.NewSetRolloutPercentageCommand("best-fit-dispatch", 60)
+		require.NoError(t, cmdErr)
+
+		require.NoError(t, handler.Handle(t.Context(), cmd))
+		assert.Equal(t, rollout.Percentage(60), flag.Percentage())
+	})
+
+	t.Run("unknown flag is not found", func(t *testing.T) {
+		cmd, cmdErr := commands.NewSetRolloutPercentageCommand("unknown", 60)
+		require.NoError(t, cmdErr)
+
+		require.ErrorIs(t, handler.Handle(t.Context(), cmd), errs.ErrObjectNotFound)
+	})
+
+	t.Run("command must be constructed", func(t *testing.T) {
+		err := handler.Handle(t.Context(), commands.SetRolloutPercentageCommand{})
+
+		require.ErrorIs(t, err, commands.ErrSetRolloutPercentageCommandIsNotConstructed)
+	})
+}
@@ -0,0 +1,35 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/rollout"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSetRolloutPercentageCommand_ValidInput(t *testing.T) {
+	cmd, err := commands.NewSetRolloutPercentageCommand("best-fit-dispatch", 25)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, "best-fit-dispatch", cmd.Flag())
+	assert.Equal(t, rollout.Percentage(25), cmd.Percentage())
+}
+
+func TestNewSetRolloutPercentageCommand_InvalidInput(t *testing.T) {
+	_, err := commands.NewSetRolloutPercentageCommand("", 101)
+
+	require.Error(t, err)
+	require.ErrorIs(t, err, errs.ErrValueIsRequired)
+	assert.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+}
+
+func TestSetRolloutPercentageCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.SetRolloutPercentageCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrSetRolloutPercentageCommandIsNotConstructed)
+}
@@ -0,0 +1,98 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrSetStoragePlaceMaintenanceCommandIsNotConstructed = errors.New(
+		"SetStoragePlaceMaintenanceCommand must be created via NewSetStoragePlaceMaintenanceCommand constructor",
+	)
+)
+
+// SetStoragePlaceMaintenanceCommand represents a request to take a courier's storage place
+// out of service (broken zipper, contamination) or to return it to service.
+//
+// Example:
+//
+//	cmd, err := NewSetStoragePlaceMaintenanceCommand(courierID, storagePlaceID, true)
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewSetStoragePlaceMaintenanceCommandHandler(uowFactory)
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    return fmt.Errorf("failed to change maintenance state: %w", err)
+//	}
+type SetStoragePlaceMaintenanceCommand struct { //nolint:recvcheck //using for validation
+	courierID      kernel.UUID
+	storagePlaceID kernel.UUID
+	outOfService   bool
+
+	guard guard.ConstructorGuard
+}
+
+// NewSetStoragePlaceMaintenanceCommand creates a command to change a storage place maintenance state.
+// Validates that courier and storage place IDs are valid.
+// Returns an error if any validation fails.
+func NewSetStoragePlaceMaintenanceCommand(
+	courierID kernel.UUID,
+	storagePlaceID kernel.UUID,
+	outOfService bool,
+) (SetStoragePlaceMaintenanceCommand, error) {
+	command := SetStoragePlaceMaintenanceCommand{
+		outOfService: outOfService,
+		guard:        guard.NewConstructorGuard(),
+	}
+
+	if err := errors.Join(
+		command.setCourierID(courierID),
+		command.setStoragePlaceID(storagePlaceID),
+	); err != nil {
+		return SetStoragePlaceMaintenanceCommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrSetStoragePlaceMaintenanceCommandIsNotConstructed if validation fails.
+func (c SetStoragePlaceMaintenanceCommand) Validate() error {
+	return c.guard.Validate(ErrSetStoragePlaceMaintenanceCommandIsNotConstructed)
+}
+
+// CourierID returns the ID of the courier owning the storage place.
+func (c SetStoragePlaceMaintenanceCommand) CourierID() kernel.UUID {
+	return c.courierID
+}
+
+// StoragePlaceID returns the ID of the storage place to change.
+func (c SetStoragePlaceMaintenanceCommand) StoragePlaceID() kernel.UUID {
+	return c.storagePlaceID
+}
+
+// OutOfService reports whether the storage place should be taken out of service.
+func (c SetStoragePlaceMaintenanceCommand) OutOfService() bool {
+	return c.outOfService
+}
+
+func (c *SetStoragePlaceMaintenanceCommand) setCourierID(courierID kernel.UUID) error {
+	if err := courierID.Validate(); err != nil {
+		return err
+	}
+
+	c.courierID = courierID
+	return nil
+}
+
+func (c *SetStoragePlaceMaintenanceCommand) setStoragePlaceID(storagePlaceID kernel.UUID) error {
+	if err := storagePlaceID.Validate(); err != nil {
+		return err
+	}
+
+	c.storagePlaceID = storagePlaceID
+	return nil
+}
@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/pkg/tracing"
+)
+
+// SetStoragePlaceMaintenanceCommandHandler handles taking storage places in and out of service.
+// Uses transactional operations to ensure data consistency when modifying courier entities.
+//
+// Example:
+//
+//	handler := NewSetStoragePlaceMaintenanceCommandHandler(uowFactory)
+//	cmd, _ := NewSetStoragePlaceMaintenanceCommand(courierID, storagePlaceID, true)
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    log.Printf("Failed to change maintenance state: %v", err)
+//	}
+type SetStoragePlaceMaintenanceCommandHandler struct {
+	uowFactory CourierUoWFactory
+}
+
+// NewSetStoragePlaceMaintenanceCommandHandler creates a new handler for storage place maintenance.
+// Requires a CourierUoWFactory for transactional operations.
+func NewSetStoragePlaceMaintenanceCommandHandler(
+	uowFactory CourierUoWFactory,
+) SetStoragePlaceMaintenanceCommandHandler {
+	return SetStoragePlaceMaintenanceCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the SetStoragePlaceMaintenanceCommand within a transaction.
+// Retrieves the courier, changes the storage place state, and persists the changes.
+// Returns courier.ErrStoragePlaceIsOccupied when maintenance is requested for an occupied place.
+func (h *SetStoragePlaceMaintenanceCommandHandler) Handle(
+	ctx context.Context,
+	cmd SetStoragePlaceMaintenanceCommand,
+) error {
+	ctx, span := tracing.Start(ctx, "SetStoragePlaceMaintenanceCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+
+	uow := h.uowFactory.Create()
+	return uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		courierEntity, err := courierRepo.Get(ctx, cmd.CourierID())
+		if err != nil {
+			return err
+		}
+
+		if cmd.OutOfService() {
+			err = courierEntity.StartStoragePlaceMaintenance(cmd.StoragePlaceID())
+		} else {
+			err = courierEntity.FinishStoragePlaceMaintenance(cmd.StoragePlaceID())
+		}
+		if err != nil {
+			return err
+		}
+
+		return courierRepo.Update(ctx, courierEntity)
+	})
+}
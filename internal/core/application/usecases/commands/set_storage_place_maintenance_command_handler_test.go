@@ -0,0 +1,117 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createCourierForMaintenance(t *testing.T) *courier.Courier {
+	t.Helper()
+	location, err := kernel.NewLocation(5, 7)
+	require.NoError(t, err)
+	c, err := courier.NewCourier(kernel.NewUUID(), "Test Courier", 3, location)
+	require.NoError(t, err)
+	return c
+}
+
+func TestSetStoragePlaceMaintenanceCommandHandler_Handle_StartAndFinish(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+	storagePlaceID := courierEntity.StoragePlaces()[0].ID()
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+
+	mockFactory.On("Create").Return(mockUoW)
+	mockUoW.On("Begin", ctx).Return(nil)
+	mockUoW.On("CourierRepository").Return(mockRepo)
+	mockUoW.On("Commit", ctx).Return(nil)
+	mockUoW.On("Rollback", ctx).Return(nil).Maybe()
+	mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil)
+	mockRepo.On("Update", ctx, courierEntity).Return(nil).Twice()
+
+	handler := commands.NewSetStoragePlaceMaintenanceCommandHandler(mockFactory)
+
+	start, err := commands.NewSetStoragePlaceMaintenanceCommand(courierEntity.ID(), storagePlaceID, true)
+	require.NoError(t, err)
+	require.NoError(t, handler.Handle(ctx, start))
+	assert.True(t, courierEntity.StoragePlaces()[0].IsOutOfService())
+
+	finish, err := commands.NewSetStoragePlaceMaintenanceCommand(courierEntity.ID(), storagePlaceID, false)
+	require.NoError(t, err)
+	require.NoError(t, handler.Handle(ctx, finish))
+	assert.False(t, courierEntity.StoragePlaces()[0].IsOutOfService())
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSetStoragePlaceMaintenanceCommandHandler_Handle_OccupiedPlace(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+	storagePlace := courierEntity.StoragePlaces()[0]
+	require.NoError(t, storagePlace.Store(kernel.NewUUID(), 5))
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+
+	mock.InOrder(
+		mockFactory.On("Create").Return(mockUoW).Once(),
+		mockUoW.On("Begin", ctx).Return(nil).Once(),
+		mockUoW.On("CourierRepository").Return(mockRepo).Once(),
+		mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil).Once(),
+		mockUoW.On("Rollback", ctx).Return(nil).Once(),
+	)
+
+	cmd, err := commands.NewSetStoragePlaceMaintenanceCommand(courierEntity.ID(), storagePlace.ID(), true)
+	require.NoError(t, err)
+
+	handler := commands.NewSetStoragePlaceMaintenanceCommandHandler(mockFactory)
+	err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, courier.ErrStoragePlaceIsOccupied)
+	assert.False(t, storagePlace.IsOutOfService())
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mockUoW.AssertExpectations(t)
+}
+
+func TestSetStoragePlaceMaintenanceCommandHandler_Handle_UnknownStoragePlace(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+
+	mockFactory.On("Create").Return(mockUoW).Once()
+	mockUoW.On("Begin", ctx).Return(nil).Once()
+	mockUoW.On("CourierRepository").Return(mockRepo).Once()
+	mockUoW.On("Rollback", ctx).Return(nil).Once()
+	mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil).Once()
+
+	cmd, err := commands.NewSetStoragePlaceMaintenanceCommand(courierEntity.ID(), kernel.NewUUID(), true)
+	require.NoError(t, err)
+
+	handler := commands.NewSetStoragePlaceMaintenanceCommandHandler(mockFactory)
+	err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, courier.ErrStoragePlaceNotFound)
+}
+
+func TestSetStoragePlaceMaintenanceCommandHandler_Handle_InvalidCommand(t *testing.T) {
+	mockFactory := new(MockCourierUoWFactory)
+	handler := commands.NewSetStoragePlaceMaintenanceCommandHandler(mockFactory)
+
+	err := handler.Handle(t.Context(), commands.SetStoragePlaceMaintenanceCommand{})
+
+	require.ErrorIs(t, err, commands.ErrSetStoragePlaceMaintenanceCommandIsNotConstructed)
+	mockFactory.AssertNotCalled(t, "Create")
+}
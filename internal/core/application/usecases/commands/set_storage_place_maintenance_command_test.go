@@ -0,0 +1,37 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSetStoragePlaceMaintenanceCommand_ValidInput(t *testing.T) {
+	courierID := kernel.NewUUID()
+	storagePlaceID := kernel.NewUUID()
+
+	cmd, err := commands.NewSetStoragePlaceMaintenanceCommand(courierID, storagePlaceID, true)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, courierID, cmd.CourierID())
+	assert.Equal(t, storagePlaceID, cmd.StoragePlaceID())
+	assert.True(t, cmd.OutOfService())
+}
+
+func TestNewSetStoragePlaceMaintenanceCommand_InvalidIDs(t *testing.T) {
+	_, err := commands.NewSetStoragePlaceMaintenanceCommand(kernel.UUID{}, kernel.UUID{}, false)
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+}
+
+func TestSetStoragePlaceMaintenanceCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.SetStoragePlaceMaintenanceCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrSetStoragePlaceMaintenanceCommandIsNotConstructed)
+}
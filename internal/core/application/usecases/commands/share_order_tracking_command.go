@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrShareOrderTrackingCommandIsNotConstructed = errors.New(
+		"ShareOrderTrackingCommand must be created via NewShareOrderTrackingCommand constructor",
+	)
+)
+
+// ShareOrderTrackingCommand represents a request to generate a customer-facing tracking
+// link for an order. Repeated requests return the same token.
+//
+// Example:
+//
+//	cmd, err := NewShareOrderTrackingCommand(orderID)
+//	if err != nil {
+//	    return fmt.Errorf("invalid order id: %w", err)
+//	}
+//
+//	handler := NewShareOrderTrackingCommandHandler(uowFactory)
+//	token, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    return fmt.Errorf("failed to share tracking: %w", err)
+//	}
+type ShareOrderTrackingCommand struct { //nolint:recvcheck //using for validation
+	orderID kernel.UUID
+
+	guard guard.ConstructorGuard
+}
+
+// NewShareOrderTrackingCommand creates a command to share the tracking link of an order.
+// Validates that the order ID is valid.
+func NewShareOrderTrackingCommand(orderID kernel.UUID) (ShareOrderTrackingCommand, error) {
+	command := ShareOrderTrackingCommand{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := command.setOrderID(orderID); err != nil {
+		return ShareOrderTrackingCommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrShareOrderTrackingCommandIsNotConstructed if validation fails.
+func (c ShareOrderTrackingCommand) Validate() error {
+	return c.guard.Validate(ErrShareOrderTrackingCommandIsNotConstructed)
+}
+
+// OrderID returns the ID of the order whose tracking is shared.
+func (c ShareOrderTrackingCommand) OrderID() kernel.UUID {
+	return c.orderID
+}
+
+func (c *ShareOrderTrackingCommand) setOrderID(orderID kernel.UUID) error {
+	if err := orderID.Validate(); err != nil {
+		return err
+	}
+
+	c.orderID = orderID
+	return nil
+}
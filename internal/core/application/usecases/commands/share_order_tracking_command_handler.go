@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/tracing"
+)
+
+// ShareOrderTrackingCommandHandler issues customer tracking tokens for orders.
+// Uses transactional operations to ensure the token is persisted before it is handed out.
+//
+// Example:
+//
+//	handler := NewShareOrderTrackingCommandHandler(uowFactory)
+//	cmd, _ := NewShareOrderTrackingCommand(orderID)
+//	token, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    log.Printf("Failed to share tracking: %v", err)
+//	}
+type ShareOrderTrackingCommandHandler struct {
+	uowFactory OrderUoWFactory
+}
+
+// NewShareOrderTrackingCommandHandler creates a new handler for sharing order tracking.
+// Requires an OrderUoWFactory for transactional operations.
+func NewShareOrderTrackingCommandHandler(uowFactory OrderUoWFactory) ShareOrderTrackingCommandHandler {
+	return ShareOrderTrackingCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the ShareOrderTrackingCommand within a transaction.
+// Retrieves the order, issues its tracking token if needed, and persists the changes.
+// Returns order.ErrTrackingIsClosed when the order has already been completed.
+func (h *ShareOrderTrackingCommandHandler) Handle(
+	ctx context.Context,
+	cmd ShareOrderTrackingCommand,
+) (order.TrackingToken, error) {
+	ctx, span := tracing.Start(ctx, "ShareOrderTrackingCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return order.TrackingToken{}, err
+	}
+
+	var token order.TrackingToken
+	uow := h.uowFactory.Create()
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		orderRepo := uow.OrderRepository()
+		orderAggregate, err := orderRepo.Get(ctx, cmd.OrderID())
+		if err != nil {
+			return err
+		}
+
+		token, err = orderAggregate.ShareTracking()
+		if err != nil {
+			return err
+		}
+
+		return orderRepo.Update(ctx, orderAggregate)
+	})
+	if err != nil {
+		return order.TrackingToken{}, err
+	}
+
+	return token, nil
+}
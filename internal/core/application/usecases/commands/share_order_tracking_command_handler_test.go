@@ -0,0 +1,70 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShareOrderTrackingCommandHandler_Handle_Success(t *testing.T) {
+	ctx := t.Context()
+	orderAggregate := createOrderForThread(t)
+
+	repo := new(MoveOrderRepo)
+	uow := new(MockOrderUoW)
+	factory := new(MockOrderUoWFactory)
+
+	mock.InOrder(
+		factory.On("Create").Return(uow).Once(),
+		uow.On("Begin", ctx).Return(nil).Once(),
+		uow.On("OrderRepository").Return(repo).Once(),
+		repo.On("Get", ctx, orderAggregate.ID()).Return(orderAggregate, nil).Once(),
+		repo.On("Update", ctx, orderAggregate).Return(nil).Once(),
+		uow.On("Commit", ctx).Return(nil).Once(),
+	)
+
+	cmd, err := commands.NewShareOrderTrackingCommand(orderAggregate.ID())
+	require.NoError(t, err)
+
+	handler := commands.NewShareOrderTrackingCommandHandler(factory)
+	token, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	require.NoError(t, token.Validate())
+	require.NotNil(t, orderAggregate.TrackingToken())
+	assert.True(t, orderAggregate.TrackingToken().IsEqual(token))
+	repo.AssertExpectations(t)
+	uow.AssertExpectations(t)
+}
+
+func TestShareOrderTrackingCommandHandler_Handle_CompletedOrder(t *testing.T) {
+	ctx := t.Context()
+	orderAggregate := createOrderForThread(t)
+	require.NoError(t, orderAggregate.Assign(kernel.NewUUID()))
+	require.NoError(t, orderAggregate.Complete())
+
+	repo := new(MoveOrderRepo)
+	uow := new(MockOrderUoW)
+	factory := new(MockOrderUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(repo).Once()
+	repo.On("Get", ctx, orderAggregate.ID()).Return(orderAggregate, nil).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewShareOrderTrackingCommand(orderAggregate.ID())
+	require.NoError(t, err)
+
+	handler := commands.NewShareOrderTrackingCommandHandler(factory)
+	_, err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, order.ErrTrackingIsClosed)
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
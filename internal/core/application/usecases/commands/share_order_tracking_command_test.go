@@ -0,0 +1,33 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewShareOrderTrackingCommand_ValidInput(t *testing.T) {
+	orderID := kernel.NewUUID()
+
+	cmd, err := commands.NewShareOrderTrackingCommand(orderID)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, orderID, cmd.OrderID())
+}
+
+func TestNewShareOrderTrackingCommand_InvalidOrderID(t *testing.T) {
+	_, err := commands.NewShareOrderTrackingCommand(kernel.UUID{})
+
+	require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+}
+
+func TestShareOrderTrackingCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.ShareOrderTrackingCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrShareOrderTrackingCommandIsNotConstructed)
+}
@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/surge"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/i18n"
+)
+
+// SurgeModeChange is a toggle of the surge mode and the outcome of the surge bonus invitations
+// it sent. Invitations are only sent when the toggle activated the surge mode.
+type SurgeModeChange struct {
+	Toggle surge.Toggle
+
+	// Invited is the number of off-shift couriers notified about the surge bonus
+	Invited int
+
+	// Uninvited is the number of off-shift couriers whose notification was not accepted
+	Uninvited int
+}
+
+// surgeInvitations notifies the couriers who could start a shift about the surge bonus,
+// each in the courier's own language.
+type surgeInvitations struct {
+	uowFactory CourierUoWFactory
+	notifier   ports.CourierNotifier
+}
+
+// send records the toggle in a change and, if the toggle activated the surge mode, invites
+// the off-shift couriers. A courier whose notification fails does not stop the others.
+func (i surgeInvitations) send(ctx context.Context, toggle surge.Toggle) (SurgeModeChange, error) {
+	change := SurgeModeChange{Toggle: toggle}
+	if !toggle.Activated() {
+		return change, nil
+	}
+
+	couriers, err := i.getCouriersOffShift(ctx)
+	if err != nil {
+		return SurgeModeChange{}, err
+	}
+
+	for _, c := range couriers {
+		notification := ports.CourierNotification{
+			ID:     toggle.ID(),
+			Text:   i18n.Translate(c.Language(), i18n.SurgeBonusInvitation),
+			SentAt: toggle.At(),
+		}
+		if notifyErr := i.notifier.NotifyCourier(ctx, c.ID(), notification); notifyErr != nil {
+			change.Uninvited++
+			continue
+		}
+		change.Invited++
+	}
+
+	return change, nil
+}
+
+// getCouriersOffShift reads the couriers off shift in a transaction of its own.
+func (i surgeInvitations) getCouriersOffShift(ctx context.Context) ([]*courier.Courier, error) {
+	var couriers []*courier.Courier
+	uow := i.uowFactory.Create()
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		couriers, err = uow.CourierRepository().GetAllOffShift(ctx)
+		return err
+	})
+	return couriers, err
+}
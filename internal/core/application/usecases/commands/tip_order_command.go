@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrTipOrderCommandIsNotConstructed = errors.New(
+		"TipOrderCommand must be created via NewTipOrderCommand constructor",
+	)
+)
+
+// TipOrderCommand represents a customer's tip for the courier of a delivered order.
+// The order is identified by its tracking token, the only identifier customers have;
+// the idempotency key makes retried submissions safe.
+//
+// Example:
+//
+//	token, _ := order.TrackingTokenFromString(tokenFromLink)
+//	cmd, err := NewTipOrderCommand(token, 250, idempotencyKey)
+//	if err != nil {
+//	    return fmt.Errorf("invalid tip: %w", err)
+//	}
+//
+//	handler := NewTipOrderCommandHandler(uowFactory)
+//	receipt, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    return fmt.Errorf("failed to tip: %w", err)
+//	}
+type TipOrderCommand struct { //nolint:recvcheck //using for validation
+	token          order.TrackingToken
+	amount         int
+	idempotencyKey string
+
+	guard guard.ConstructorGuard
+}
+
+// NewTipOrderCommand creates a command to tip the courier of the order behind the token.
+// Validates the token; the amount and the idempotency key are validated when the tip is made.
+func NewTipOrderCommand(token order.TrackingToken, amount int, idempotencyKey string) (TipOrderCommand, error) {
+	if err := token.Validate(); err != nil {
+		return TipOrderCommand{}, err
+	}
+
+	return TipOrderCommand{
+		token:          token,
+		amount:         amount,
+		idempotencyKey: idempotencyKey,
+		guard:          guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrTipOrderCommandIsNotConstructed if validation fails.
+func (c TipOrderCommand) Validate() error {
+	return c.guard.Validate(ErrTipOrderCommandIsNotConstructed)
+}
+
+// Token returns the tracking token of the tipped order.
+func (c TipOrderCommand) Token() order.TrackingToken {
+	return c.token
+}
+
+// Amount returns the tip in minor currency units.
+func (c TipOrderCommand) Amount() int {
+	return c.amount
+}
+
+// IdempotencyKey returns the key of the customer's submission.
+func (c TipOrderCommand) IdempotencyKey() string {
+	return c.idempotencyKey
+}
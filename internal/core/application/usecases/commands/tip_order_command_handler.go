@@ -0,0 +1,112 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/earnings"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/tracing"
+)
+
+// OrderTipReceipt is the outcome of a tip submission.
+type OrderTipReceipt struct {
+	// OrderID is the tipped order
+	OrderID kernel.UUID
+
+	// Tip is the tip recorded for the order
+	Tip order.Tip
+
+	// Replayed is true if the submission was a retry of the recorded tip
+	Replayed bool
+}
+
+// TipOrderCommandHandler records customer tips and credits them to the courier's earnings ledger.
+// The tip and the ledger entry are written in one transaction, so a tip is never lost or paid twice.
+//
+// Example:
+//
+//	handler := NewTipOrderCommandHandler(uowFactory)
+//	cmd, _ := NewTipOrderCommand(token, 250, idempotencyKey)
+//	receipt, err := handler.Handle(ctx, cmd)
+//	if errors.Is(err, order.ErrOrderIsAlreadyTipped) {
+//	    // The customer has already tipped with another submission
+//	}
+type TipOrderCommandHandler struct {
+	uowFactory TipUoWFactory
+}
+
+// NewTipOrderCommandHandler creates a new handler for customer tips.
+// Requires a TipUoWFactory for transactional operations.
+func NewTipOrderCommandHandler(uowFactory TipUoWFactory) TipOrderCommandHandler {
+	return TipOrderCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the TipOrderCommand within a transaction.
+// A retry with the idempotency key of the recorded tip returns that tip without changes.
+// Returns order.ErrOrderIsNotDelivered if the order is not delivered yet and
+// order.ErrOrderIsAlreadyTipped if it was tipped with another idempotency key,
+// including by a concurrent submission.
+func (h *TipOrderCommandHandler) Handle(ctx context.Context, cmd TipOrderCommand) (OrderTipReceipt, error) {
+	ctx, span := tracing.Start(ctx, "TipOrderCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return OrderTipReceipt{}, err
+	}
+
+	now := time.Now()
+	tip, err := order.NewTip(cmd.Amount(), cmd.IdempotencyKey(), now)
+	if err != nil {
+		return OrderTipReceipt{}, err
+	}
+
+	var receipt OrderTipReceipt
+	uow := h.uowFactory.Create()
+	err = uow.Do(ctx, func(ctx context.Context) error {
+		orderRepo := uow.OrderRepository()
+		orderAggregate, err := orderRepo.GetByTrackingToken(ctx, cmd.Token())
+		if err != nil {
+			return err
+		}
+
+		added, err := orderAggregate.AddTip(tip)
+		if err != nil {
+			return err
+		}
+		if !added {
+			receipt = OrderTipReceipt{OrderID: orderAggregate.ID(), Tip: *orderAggregate.Tip(), Replayed: true}
+			return nil
+		}
+
+		entry, err := earnings.NewEntry(
+			kernel.NewUUID(), *orderAggregate.Courier(), orderAggregate.ID(), earnings.Tip, tip.Amount(), now,
+		)
+		if err != nil {
+			return err
+		}
+
+		if err = orderRepo.Update(ctx, orderAggregate); err != nil {
+			return err
+		}
+
+		if err = uow.EarningsLedger().Record(ctx, entry); err != nil {
+			if errors.Is(err, earnings.ErrEntryIsAlreadyRecorded) {
+				return order.ErrOrderIsAlreadyTipped
+			}
+			return err
+		}
+
+		receipt = OrderTipReceipt{OrderID: orderAggregate.ID(), Tip: tip, Replayed: false}
+		return nil
+	})
+	if err != nil {
+		return OrderTipReceipt{}, err
+	}
+
+	return receipt, nil
+}
@@ -0,0 +1,216 @@
+package commands_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/earnings"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockEarningsLedger struct{ mock.Mock }
+
+func (m *MockEarningsLedger) Record(ctx context.Context, entry *earnings.Entry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+type MockTipUoW struct{ mock.Mock }
+
+func (m *MockTipUoW) Begin(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockTipUoW) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return doInTransaction(ctx, m, fn)
+}
+
+func (m *MockTipUoW) Commit(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockTipUoW) Rollback(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockTipUoW) OrderRepository() ports.OrderRepository {
+	args := m.Called()
+	return args.Get(0).(ports.OrderRepository)
+}
+
+func (m *MockTipUoW) EarningsLedger() ports.EarningsLedger {
+	args := m.Called()
+	return args.Get(0).(ports.EarningsLedger)
+}
+
+type MockTipUoWFactory struct{ mock.Mock }
+
+func (m *MockTipUoWFactory) Create() commands.TipUoW {
+	args := m.Called()
+	return args.Get(0).(commands.TipUoW)
+}
+
+// createDeliveredOrder returns a completed order with a shared tracking link.
+func createDeliveredOrder(t *testing.T, courierID kernel.UUID) (*order.Order, order.TrackingToken) {
+	t.Helper()
+	o := createOrderForThread(t)
+	token, err := o.ShareTracking()
+	require.NoError(t, err)
+	require.NoError(t, o.Assign(courierID))
+	require.NoError(t, o.Complete())
+	return o, token
+}
+
+func TestTipOrderCommandHandler_Handle_Success(t *testing.T) {
+	ctx := t.Context()
+	courierID := kernel.NewUUID()
+	orderAggregate, token := createDeliveredOrder(t, courierID)
+
+	repo := new(MoveOrderRepo)
+	ledger := new(MockEarningsLedger)
+	uow := new(MockTipUoW)
+	factory := new(MockTipUoWFactory)
+
+	isTipEntry := mock.MatchedBy(func(entry *earnings.Entry) bool {
+		return entry.CourierID().IsEqual(courierID) && entry.OrderID().IsEqual(orderAggregate.ID()) &&
+			entry.Kind() == earnings.Tip && entry.Amount() == 250
+	})
+	mock.InOrder(
+		factory.On("Create").Return(uow).Once(),
+		uow.On("Begin", ctx).Return(nil).Once(),
+		uow.On("OrderRepository").Return(repo).Once(),
+		repo.On("GetByTrackingToken", ctx, token).Return(orderAggregate, nil).Once(),
+		repo.On("Update", ctx, orderAggregate).Return(nil).Once(),
+		uow.On("EarningsLedger").Return(ledger).Once(),
+		ledger.On("Record", ctx, isTipEntry).Return(nil).Once(),
+		uow.On("Commit", ctx).Return(nil).Once(),
+	)
+
+	cmd, err := commands.NewTipOrderCommand(token, 250, "key-1")
+	require.NoError(t, err)
+
+	handler := commands.NewTipOrderCommandHandler(factory)
+	receipt, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.False(t, receipt.Replayed)
+	assert.True(t, receipt.OrderID.IsEqual(orderAggregate.ID()))
+	assert.Equal(t, 250, receipt.Tip.Amount())
+	require.NotNil(t, orderAggregate.Tip())
+	repo.AssertExpectations(t)
+	ledger.AssertExpectations(t)
+	uow.AssertExpectations(t)
+}
+
+func TestTipOrderCommandHandler_Handle_Retry(t *testing.T) {
+	ctx := t.Context()
+	orderAggregate, token := createDeliveredOrder(t, kernel.NewUUID())
+	recorded, err := order.NewTip(250, "key-1", time.Now())
+	require.NoError(t, err)
+	_, err = orderAggregate.AddTip(recorded)
+	require.NoError(t, err)
+
+	repo := new(MoveOrderRepo)
+	uow := new(MockTipUoW)
+	factory := new(MockTipUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(repo).Once()
+	repo.On("GetByTrackingToken", ctx, token).Return(orderAggregate, nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewTipOrderCommand(token, 500, "key-1")
+	require.NoError(t, err)
+
+	handler := commands.NewTipOrderCommandHandler(factory)
+	receipt, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.True(t, receipt.Replayed)
+	assert.Equal(t, 250, receipt.Tip.Amount())
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	uow.AssertNotCalled(t, "EarningsLedger")
+	uow.AssertExpectations(t)
+}
+
+func TestTipOrderCommandHandler_Handle_Rejected(t *testing.T) {
+	t.Run("should refuse undelivered order", func(t *testing.T) {
+		ctx := t.Context()
+		orderAggregate := createOrderForThread(t)
+		token, err := orderAggregate.ShareTracking()
+		require.NoError(t, err)
+
+		repo := new(MoveOrderRepo)
+		uow := new(MockTipUoW)
+		factory := new(MockTipUoWFactory)
+
+		factory.On("Create").Return(uow).Once()
+		uow.On("Begin", ctx).Return(nil).Once()
+		uow.On("OrderRepository").Return(repo).Once()
+		repo.On("GetByTrackingToken", ctx, token).Return(orderAggregate, nil).Once()
+		uow.On("Rollback", ctx).Return(nil).Once()
+
+		cmd, err := commands.NewTipOrderCommand(token, 250, "key-1")
+		require.NoError(t, err)
+
+		handler := commands.NewTipOrderCommandHandler(factory)
+		_, err = handler.Handle(ctx, cmd)
+
+		require.ErrorIs(t, err, order.ErrOrderIsNotDelivered)
+		repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should report concurrent tip recorded first", func(t *testing.T) {
+		ctx := t.Context()
+		orderAggregate, token := createDeliveredOrder(t, kernel.NewUUID())
+
+		repo := new(MoveOrderRepo)
+		ledger := new(MockEarningsLedger)
+		uow := new(MockTipUoW)
+		factory := new(MockTipUoWFactory)
+
+		factory.On("Create").Return(uow).Once()
+		uow.On("Begin", ctx).Return(nil).Once()
+		uow.On("OrderRepository").Return(repo).Once()
+		repo.On("GetByTrackingToken", ctx, token).Return(orderAggregate, nil).Once()
+		repo.On("Update", ctx, orderAggregate).Return(nil).Once()
+		uow.On("EarningsLedger").Return(ledger).Once()
+		ledger.On("Record", ctx, mock.Anything).Return(earnings.ErrEntryIsAlreadyRecorded).Once()
+		uow.On("Rollback", ctx).Return(nil).Once()
+
+		cmd, err := commands.NewTipOrderCommand(token, 250, "key-2")
+		require.NoError(t, err)
+
+		handler := commands.NewTipOrderCommandHandler(factory)
+		_, err = handler.Handle(ctx, cmd)
+
+		require.ErrorIs(t, err, order.ErrOrderIsAlreadyTipped)
+		uow.AssertNotCalled(t, "Commit", mock.Anything)
+	})
+
+	t.Run("should validate amount before opening a transaction", func(t *testing.T) {
+		factory := new(MockTipUoWFactory)
+
+		cmd, err := commands.NewTipOrderCommand(order.NewTrackingToken(), 0, "key-1")
+		require.NoError(t, err)
+
+		handler := commands.NewTipOrderCommandHandler(factory)
+		_, err = handler.Handle(t.Context(), cmd)
+
+		require.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+		factory.AssertNotCalled(t, "Create")
+	})
+}
@@ -0,0 +1,35 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTipOrderCommand_ValidInput(t *testing.T) {
+	token := order.NewTrackingToken()
+
+	cmd, err := commands.NewTipOrderCommand(token, 250, "key-1")
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.True(t, cmd.Token().IsEqual(token))
+	assert.Equal(t, 250, cmd.Amount())
+	assert.Equal(t, "key-1", cmd.IdempotencyKey())
+}
+
+func TestNewTipOrderCommand_InvalidToken(t *testing.T) {
+	_, err := commands.NewTipOrderCommand(order.TrackingToken{}, 250, "key-1")
+
+	require.ErrorIs(t, err, order.ErrTrackingTokenIsNotConstructed)
+}
+
+func TestTipOrderCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.TipOrderCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrTipOrderCommandIsNotConstructed)
+}
@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrTransferOrderCommandIsNotConstructed = errors.New(
+		"TransferOrderCommand must be created via NewTransferOrderCommand constructor",
+	)
+)
+
+// TransferOrderCommand represents handing an order over from the courier carrying it to another
+// courier on the way to the customer (relay delivery). The receiving courier names the storage
+// place the order goes into.
+//
+// Example:
+//
+//	cmd, err := NewTransferOrderCommand(orderID, receiverID, backpackID)
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewTransferOrderCommandHandler(uowFactory)
+//	transfer, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    return fmt.Errorf("failed to transfer order: %w", err)
+//	}
+type TransferOrderCommand struct { //nolint:recvcheck //using for validation
+	orderID        kernel.UUID
+	courierID      kernel.UUID
+	storagePlaceID kernel.UUID
+
+	guard guard.ConstructorGuard
+}
+
+// NewTransferOrderCommand creates a command to hand the order over to the courier, into their
+// storage place. Returns an error if an ID is invalid.
+func NewTransferOrderCommand(orderID, courierID, storagePlaceID kernel.UUID) (TransferOrderCommand, error) {
+	if err := errs.JoinFields(
+		errs.Field("orderId", orderID.Validate()),
+		errs.Field("courierId", courierID.Validate()),
+		errs.Field("storagePlaceId", storagePlaceID.Validate()),
+	); err != nil {
+		return TransferOrderCommand{}, err
+	}
+
+	return TransferOrderCommand{
+		orderID:        orderID,
+		courierID:      courierID,
+		storagePlaceID: storagePlaceID,
+		guard:          guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrTransferOrderCommandIsNotConstructed if validation fails.
+func (c TransferOrderCommand) Validate() error {
+	return c.guard.Validate(ErrTransferOrderCommandIsNotConstructed)
+}
+
+// OrderID returns the ID of the order to hand over.
+func (c TransferOrderCommand) OrderID() kernel.UUID {
+	return c.orderID
+}
+
+// CourierID returns the ID of the courier receiving the order.
+func (c TransferOrderCommand) CourierID() kernel.UUID {
+	return c.courierID
+}
+
+// StoragePlaceID returns the ID of the receiving courier's storage place the order goes into.
+func (c TransferOrderCommand) StoragePlaceID() kernel.UUID {
+	return c.storagePlaceID
+}
@@ -0,0 +1,128 @@
+package commands
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/relay"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/pkg/tracing"
+)
+
+// OrderTransfer is the outcome of handing an order over between couriers.
+type OrderTransfer struct {
+	// Handover is the recorded handover
+	Handover *relay.Handover
+
+	// EstimatedArrival is the delivery estimate from the receiving courier's location
+	EstimatedArrival order.EstimatedArrival
+}
+
+// TransferOrderCommandHandler hands orders over between couriers who met on the way.
+// Both couriers, the order and the handover record are written in one transaction, so the order
+// is never in two storage places or in none. The delivery estimate is recalculated from the
+// receiving courier, rounded up to whole turns like RecalculateETACommandHandler does.
+//
+// Example:
+//
+//	handler := NewTransferOrderCommandHandler(uowFactory)
+//	cmd, _ := NewTransferOrderCommand(orderID, receiverID, backpackID)
+//	transfer, err := handler.Handle(ctx, cmd)
+//	if errors.Is(err, services.ErrCouriersAreApart) {
+//	    // The couriers have not met yet
+//	}
+type TransferOrderCommandHandler struct {
+	uowFactory TransferUoWFactory
+}
+
+// NewTransferOrderCommandHandler creates a new handler for order handovers.
+// Requires a TransferUoWFactory for transactional operations.
+func NewTransferOrderCommandHandler(uowFactory TransferUoWFactory) TransferOrderCommandHandler {
+	return TransferOrderCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the TransferOrderCommand within a transaction.
+// Returns errs.ErrObjectNotFound if the order or a courier does not exist,
+// order.ErrOrderIsNotAssigned if no courier is delivering the order, and the errors of
+// services.OrderRelay if the handover breaks a rule.
+func (h *TransferOrderCommandHandler) Handle(ctx context.Context, cmd TransferOrderCommand) (OrderTransfer, error) {
+	ctx, span := tracing.Start(ctx, "TransferOrderCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return OrderTransfer{}, err
+	}
+
+	var transfer OrderTransfer
+	uow := h.uowFactory.Create()
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		orderRepo := uow.OrderRepository()
+		orderAggregate, err := orderRepo.Get(ctx, cmd.OrderID())
+		if err != nil {
+			return err
+		}
+
+		if orderAggregate.Status() != order.Assigned || orderAggregate.Courier() == nil {
+			return order.ErrOrderIsNotAssigned
+		}
+
+		courierRepo := uow.CourierRepository()
+		giver, err := courierRepo.Get(ctx, *orderAggregate.Courier())
+		if err != nil {
+			return err
+		}
+
+		receiver, err := courierRepo.Get(ctx, cmd.CourierID())
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		handover, err := services.NewOrderRelay().HandOver(orderAggregate, giver, receiver, cmd.StoragePlaceID(), now)
+		if err != nil {
+			return err
+		}
+
+		travelTime, err := receiver.CalculateTimeToLocation(orderAggregate.Location())
+		if err != nil {
+			return err
+		}
+
+		eta, err := order.NewEstimatedArrival(int(math.Ceil(travelTime)), now)
+		if err != nil {
+			return err
+		}
+
+		if err = orderAggregate.RecordEstimatedArrival(eta); err != nil {
+			return err
+		}
+
+		if err = courierRepo.Update(ctx, giver); err != nil {
+			return err
+		}
+
+		if err = courierRepo.Update(ctx, receiver); err != nil {
+			return err
+		}
+
+		if err = orderRepo.Update(ctx, orderAggregate); err != nil {
+			return err
+		}
+
+		if err = uow.HandoverRepository().Add(ctx, handover); err != nil {
+			return err
+		}
+
+		transfer = OrderTransfer{Handover: handover, EstimatedArrival: eta}
+		return nil
+	})
+	if err != nil {
+		return OrderTransfer{}, err
+	}
+
+	return transfer, nil
+}
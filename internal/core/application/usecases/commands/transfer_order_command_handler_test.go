@@ -0,0 +1,215 @@
+package commands_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/relay"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockHandoverRepository struct{ mock.Mock }
+
+func (m *MockHandoverRepository) Add(ctx context.Context, handover *relay.Handover) error {
+	args := m.Called(ctx, handover)
+	return args.Error(0)
+}
+
+type MockTransferUoW struct{ mock.Mock }
+
+func (m *MockTransferUoW) Begin(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockTransferUoW) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return doInTransaction(ctx, m, fn)
+}
+
+func (m *MockTransferUoW) Commit(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockTransferUoW) Rollback(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockTransferUoW) CourierRepository() ports.CourierRepository {
+	args := m.Called()
+	return args.Get(0).(ports.CourierRepository)
+}
+
+func (m *MockTransferUoW) OrderRepository() ports.OrderRepository {
+	args := m.Called()
+	return args.Get(0).(ports.OrderRepository)
+}
+
+func (m *MockTransferUoW) HandoverRepository() ports.HandoverRepository {
+	args := m.Called()
+	return args.Get(0).(ports.HandoverRepository)
+}
+
+type MockTransferUoWFactory struct{ mock.Mock }
+
+func (m *MockTransferUoWFactory) Create() commands.TransferUoW {
+	args := m.Called()
+	return args.Get(0).(commands.TransferUoW)
+}
+
+// createCourierOnShift returns a courier on shift at the location.
+func createCourierOnShift(t *testing.T, location kernel.Location) *courier.Courier {
+	t.Helper()
+	c, err := courier.NewCourier(kernel.NewUUID(), "Relay Courier", 2, location)
+	require.NoError(t, err)
+	limit, err := courier.NewWorkingHoursLimit(8*time.Hour, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, c.StartShift(time.Now(), limit))
+	return c
+}
+
+func TestTransferOrderCommandHandler_Handle_Success(t *testing.T) {
+	ctx := t.Context()
+	meetingPoint, err := kernel.NewLocation(3, 3)
+	require.NoError(t, err)
+	destination, err := kernel.NewLocation(3, 8)
+	require.NoError(t, err)
+	giver := createCourierOnShift(t, meetingPoint)
+	receiver := createCourierOnShift(t, meetingPoint)
+	orderAggregate, err := order.NewOrder(kernel.NewUUID(), destination, 5)
+	require.NoError(t, err)
+	require.NoError(t, orderAggregate.Assign(giver.ID()))
+	require.NoError(t, giver.TakeOrder(orderAggregate))
+	storagePlaceID := receiver.StoragePlaces()[0].ID()
+
+	orderRepo := new(MoveOrderRepo)
+	courierRepo := new(MoveCourierRepo)
+	handoverRepo := new(MockHandoverRepository)
+	uow := new(MockTransferUoW)
+	factory := new(MockTransferUoWFactory)
+
+	isHandover := mock.MatchedBy(func(handover *relay.Handover) bool {
+		return handover.OrderID().IsEqual(orderAggregate.ID()) &&
+			handover.From().CourierID().IsEqual(giver.ID()) &&
+			handover.To().StoragePlaceID().IsEqual(storagePlaceID)
+	})
+	mock.InOrder(
+		factory.On("Create").Return(uow).Once(),
+		uow.On("Begin", ctx).Return(nil).Once(),
+		uow.On("OrderRepository").Return(orderRepo).Once(),
+		orderRepo.On("Get", ctx, orderAggregate.ID()).Return(orderAggregate, nil).Once(),
+		uow.On("CourierRepository").Return(courierRepo).Once(),
+		courierRepo.On("Get", ctx, giver.ID()).Return(giver, nil).Once(),
+		courierRepo.On("Get", ctx, receiver.ID()).Return(receiver, nil).Once(),
+		courierRepo.On("Update", ctx, giver).Return(nil).Once(),
+		courierRepo.On("Update", ctx, receiver).Return(nil).Once(),
+		orderRepo.On("Update", ctx, orderAggregate).Return(nil).Once(),
+		uow.On("HandoverRepository").Return(handoverRepo).Once(),
+		handoverRepo.On("Add", ctx, isHandover).Return(nil).Once(),
+		uow.On("Commit", ctx).Return(nil).Once(),
+	)
+
+	cmd, err := commands.NewTransferOrderCommand(orderAggregate.ID(), receiver.ID(), storagePlaceID)
+	require.NoError(t, err)
+
+	handler := commands.NewTransferOrderCommandHandler(factory)
+	transfer, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.True(t, transfer.Handover.To().CourierID().IsEqual(receiver.ID()))
+	assert.True(t, orderAggregate.Courier().IsEqual(receiver.ID()))
+	// 5 cells at speed 2 take 3 turns
+	assert.Equal(t, 3, transfer.EstimatedArrival.Turns())
+	require.NotNil(t, orderAggregate.EstimatedArrival())
+	assert.Equal(t, transfer.EstimatedArrival, *orderAggregate.EstimatedArrival())
+	orderRepo.AssertExpectations(t)
+	courierRepo.AssertExpectations(t)
+	handoverRepo.AssertExpectations(t)
+	uow.AssertExpectations(t)
+}
+
+func TestTransferOrderCommandHandler_Handle_CouriersApart(t *testing.T) {
+	ctx := t.Context()
+	here, err := kernel.NewLocation(3, 3)
+	require.NoError(t, err)
+	there, err := kernel.NewLocation(7, 7)
+	require.NoError(t, err)
+	giver := createCourierOnShift(t, here)
+	receiver := createCourierOnShift(t, there)
+	orderAggregate, err := order.NewOrder(kernel.NewUUID(), there, 5)
+	require.NoError(t, err)
+	require.NoError(t, orderAggregate.Assign(giver.ID()))
+	require.NoError(t, giver.TakeOrder(orderAggregate))
+
+	orderRepo := new(MoveOrderRepo)
+	courierRepo := new(MoveCourierRepo)
+	uow := new(MockTransferUoW)
+	factory := new(MockTransferUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	orderRepo.On("Get", ctx, orderAggregate.ID()).Return(orderAggregate, nil).Once()
+	uow.On("CourierRepository").Return(courierRepo).Once()
+	courierRepo.On("Get", ctx, giver.ID()).Return(giver, nil).Once()
+	courierRepo.On("Get", ctx, receiver.ID()).Return(receiver, nil).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewTransferOrderCommand(orderAggregate.ID(), receiver.ID(), receiver.StoragePlaces()[0].ID())
+	require.NoError(t, err)
+
+	handler := commands.NewTransferOrderCommandHandler(factory)
+	_, err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, services.ErrCouriersAreApart)
+	courierRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	orderRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	uow.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+func TestTransferOrderCommandHandler_Handle_OrderNotAssigned(t *testing.T) {
+	ctx := t.Context()
+	location, err := kernel.NewLocation(3, 3)
+	require.NoError(t, err)
+	orderAggregate, err := order.NewOrder(kernel.NewUUID(), location, 5)
+	require.NoError(t, err)
+
+	orderRepo := new(MoveOrderRepo)
+	uow := new(MockTransferUoW)
+	factory := new(MockTransferUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(orderRepo).Once()
+	orderRepo.On("Get", ctx, orderAggregate.ID()).Return(orderAggregate, nil).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewTransferOrderCommand(orderAggregate.ID(), kernel.NewUUID(), kernel.NewUUID())
+	require.NoError(t, err)
+
+	handler := commands.NewTransferOrderCommandHandler(factory)
+	_, err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, order.ErrOrderIsNotAssigned)
+	uow.AssertNotCalled(t, "CourierRepository")
+}
+
+func TestNewTransferOrderCommand_InvalidInput(t *testing.T) {
+	_, err := commands.NewTransferOrderCommand(kernel.UUID{}, kernel.NewUUID(), kernel.UUID{})
+
+	require.ErrorIs(t, err, errs.ErrValidationFailed)
+	var zero commands.TransferOrderCommand
+	require.ErrorIs(t, zero.Validate(), commands.ErrTransferOrderCommandIsNotConstructed)
+}
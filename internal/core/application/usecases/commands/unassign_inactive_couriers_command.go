@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrUnassignInactiveCouriersCommandIsNotConstructed = errors.New(
+		"UnassignInactiveCouriersCommand must be created via NewUnassignInactiveCouriersCommand constructor",
+	)
+	ErrInactivityThresholdIsInvalid = errors.New("inactivity threshold must be greater than 0")
+)
+
+// UnassignInactiveCouriersCommand triggers the courier inactivity watchdog.
+// Couriers that keep an assigned order but do not change location for the configured
+// number of consecutive ticks lose their orders and are flagged for review.
+//
+// Example:
+//
+//	cmd, err := NewUnassignInactiveCouriersCommand(30)
+//	if err != nil {
+//	    return fmt.Errorf("invalid watchdog settings: %w", err)
+//	}
+//
+//	handler := NewUnassignInactiveCouriersCommandHandler(uowFactory)
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    return fmt.Errorf("watchdog tick failed: %w", err)
+//	}
+type UnassignInactiveCouriersCommand struct { //nolint:recvcheck //using for validation
+	thresholdTicks int
+
+	guard guard.ConstructorGuard
+}
+
+// NewUnassignInactiveCouriersCommand creates a watchdog command.
+// thresholdTicks is the number of consecutive ticks without movement after which
+// a courier is considered inactive. Returns an error if the threshold is not positive.
+func NewUnassignInactiveCouriersCommand(thresholdTicks int) (UnassignInactiveCouriersCommand, error) {
+	command := UnassignInactiveCouriersCommand{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := command.setThresholdTicks(thresholdTicks); err != nil {
+		return UnassignInactiveCouriersCommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrUnassignInactiveCouriersCommandIsNotConstructed if validation fails.
+func (c UnassignInactiveCouriersCommand) Validate() error {
+	return c.guard.Validate(ErrUnassignInactiveCouriersCommandIsNotConstructed)
+}
+
+// ThresholdTicks returns the number of idle ticks that marks a courier as inactive.
+func (c UnassignInactiveCouriersCommand) ThresholdTicks() int {
+	return c.thresholdTicks
+}
+
+func (c *UnassignInactiveCouriersCommand) setThresholdTicks(thresholdTicks int) error {
+	if thresholdTicks <= 0 {
+		return ErrInactivityThresholdIsInvalid
+	}
+
+	c.thresholdTicks = thresholdTicks
+	return nil
+}
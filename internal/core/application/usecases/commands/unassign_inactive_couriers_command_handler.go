@@ -0,0 +1,188 @@
+package commands
+
+import (
+	"context"
+	"sync"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/tenant"
+	"delivery/internal/pkg/tracing"
+)
+
+// UnassignInactiveCouriersCommandHandler watches assigned couriers for inactivity.
+// On every tick it compares each busy courier's location with the one seen on the
+// previous tick. Once a courier stays in place for the configured number of consecutive
+// ticks, its orders are returned to Created status for reassignment and the courier
+// is flagged for review. Couriers on a break are exempt from the check.
+//
+// The handler keeps the observed positions in memory, so a single instance must be
+// reused across ticks. Copies of the handler share the same state. Positions are kept
+// per tenant, so the ticks of one tenant leave the counters of the others alone.
+//
+// Example:
+//
+//	handler := NewUnassignInactiveCouriersCommandHandler(uowFactory)
+//	cmd, _ := NewUnassignInactiveCouriersCommand(30)
+//
+//	// Called periodically by the watchdog job
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    return fmt.Errorf("watchdog tick failed: %w", err)
+//	}
+type UnassignInactiveCouriersCommandHandler struct {
+	uowFactory UoWFactory
+	tracker    *inactivityTracker
+}
+
+// NewUnassignInactiveCouriersCommandHandler creates a handler for the inactivity watchdog.
+// Requires a UoWFactory for coordinating updates across order and courier repositories.
+func NewUnassignInactiveCouriersCommandHandler(uowFactory UoWFactory) UnassignInactiveCouriersCommandHandler {
+	return UnassignInactiveCouriersCommandHandler{
+		uowFactory: uowFactory,
+		tracker:    newInactivityTracker(),
+	}
+}
+
+// Handle runs a single watchdog tick.
+// Loads all orders in "assigned" status, updates idle counters of their couriers and
+// unassigns orders of couriers that reached the threshold. All updates occur within
+// a single transaction; idle counters are only advanced once the transaction commits.
+func (h *UnassignInactiveCouriersCommandHandler) Handle(
+	ctx context.Context,
+	cmd UnassignInactiveCouriersCommand,
+) error {
+	ctx, span := tracing.Start(ctx, "UnassignInactiveCouriersCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+
+	tenantID, _ := tenant.FromContext(ctx)
+	observations := make(map[string]courierObservation)
+	uow := h.uowFactory.Create()
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		ordersRepo := uow.OrderRepository()
+
+		orders, err := ordersRepo.GetAllInAssignedStatus(ctx)
+		if err != nil {
+			return err
+		}
+
+		ordersByCourier := make(map[string][]*order.Order)
+		courierIDs := make([]kernel.UUID, 0)
+		for _, o := range orders {
+			key := o.Courier().String()
+			if _, ok := ordersByCourier[key]; !ok {
+				courierIDs = append(courierIDs, *o.Courier())
+			}
+			ordersByCourier[key] = append(ordersByCourier[key], o)
+		}
+
+		for _, courierID := range courierIDs {
+			c, courierErr := courierRepo.Get(ctx, courierID)
+			if courierErr != nil {
+				return courierErr
+			}
+
+			if c.IsOnBreak() {
+				continue
+			}
+
+			observation := h.tracker.observe(tenantID, c)
+			if observation.idleTicks < cmd.ThresholdTicks() {
+				observations[courierID.String()] = observation
+				continue
+			}
+
+			if err = h.unassignCourierOrders(c, ordersByCourier[courierID.String()]); err != nil {
+				return err
+			}
+
+			for _, o := range ordersByCourier[courierID.String()] {
+				if err = ordersRepo.Update(ctx, o); err != nil {
+					return err
+				}
+			}
+
+			if err = courierRepo.Update(ctx, c); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	h.tracker.replace(tenantID, observations)
+	return nil
+}
+
+// unassignCourierOrders returns all orders of an inactive courier to the dispatch queue
+// and flags the courier for review.
+func (h *UnassignInactiveCouriersCommandHandler) unassignCourierOrders(
+	c *courier.Courier,
+	orders []*order.Order,
+) error {
+	for _, o := range orders {
+		if err := o.Unassign(); err != nil {
+			return err
+		}
+
+		if err := c.ReleaseOrder(o.ID()); err != nil {
+			return err
+		}
+	}
+
+	c.FlagForReview()
+	return nil
+}
+
+// courierObservation is the last known position of a busy courier and the number of
+// consecutive ticks it has stayed there.
+type courierObservation struct {
+	location  kernel.Location
+	idleTicks int
+}
+
+// inactivityTracker stores courier observations of every tenant between watchdog ticks.
+type inactivityTracker struct {
+	mu           sync.Mutex
+	observations map[tenant.ID]map[string]courierObservation
+}
+
+func newInactivityTracker() *inactivityTracker {
+	return &inactivityTracker{
+		observations: make(map[tenant.ID]map[string]courierObservation),
+	}
+}
+
+// observe computes the observation of the tenant's courier for the current tick without storing it.
+func (t *inactivityTracker) observe(id tenant.ID, c *courier.Courier) courierObservation {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	previous, ok := t.observations[id][c.ID().String()]
+	if !ok {
+		return courierObservation{location: c.Location()}
+	}
+
+	if equal, err := previous.location.IsEqual(c.Location()); err != nil || !equal {
+		return courierObservation{location: c.Location()}
+	}
+
+	return courierObservation{location: c.Location(), idleTicks: previous.idleTicks + 1}
+}
+
+// replace stores the tenant's observations of the current tick, dropping its couriers that are
+// no longer busy, are on a break or were just unassigned. Other tenants keep their observations.
+func (t *inactivityTracker) replace(id tenant.ID, observations map[string]courierObservation) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.observations[id] = observations
+}
@@ -0,0 +1,206 @@
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/tenant"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// setupWatchdogMocks wires mocks that serve the same assigned order and courier on every tick.
+func setupWatchdogMocks(
+	ctx context.Context,
+	testOrder *order.Order,
+	testCourier *courier.Courier,
+) (*MoveUoWFactory, *MoveUnitOfWork, *MoveOrderRepo, *MoveCourierRepo) {
+	courierRepo := new(MoveCourierRepo)
+	orderRepo := new(MoveOrderRepo)
+	uow := new(MoveUnitOfWork)
+	factory := new(MoveUoWFactory)
+
+	factory.On("Create").Return(uow)
+	uow.On("Begin", ctx).Return(nil)
+	uow.On("CourierRepository").Return(courierRepo)
+	uow.On("OrderRepository").Return(orderRepo)
+	uow.On("Commit", ctx).Return(nil)
+	uow.On("Rollback", ctx).Return(nil).Maybe()
+	orderRepo.On("GetAllInAssignedStatus", ctx).Return([]*order.Order{testOrder}, nil)
+	courierRepo.On("Get", ctx, testCourier.ID()).Return(testCourier, nil)
+
+	return factory, uow, orderRepo, courierRepo
+}
+
+func createStalledCourierWithOrder(t *testing.T) (*order.Order, *courier.Courier) {
+	t.Helper()
+	courierID := kernel.NewUUID()
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	courierLocation, _ := kernel.NewLocation(3, 3)
+	testOrder, testCourier, err := createTestOrderWithCourier(courierID, orderLocation, courierLocation)
+	require.NoError(t, err)
+	require.NoError(t, testCourier.TakeOrder(testOrder))
+	return testOrder, testCourier
+}
+
+func TestUnassignInactiveCouriersCommandHandler_Handle_UnassignsAfterThreshold(t *testing.T) {
+	ctx := t.Context()
+	cmd, err := commands.NewUnassignInactiveCouriersCommand(2)
+	require.NoError(t, err)
+
+	testOrder, testCourier := createStalledCourierWithOrder(t)
+	factory, _, orderRepo, courierRepo := setupWatchdogMocks(ctx, testOrder, testCourier)
+	orderRepo.On("Update", ctx, testOrder).Return(nil).Once()
+	courierRepo.On("Update", ctx, testCourier).Return(nil).Once()
+
+	handler := commands.NewUnassignInactiveCouriersCommandHandler(factory)
+
+	// First observation and one idle tick stay below the threshold
+	require.NoError(t, handler.Handle(ctx, cmd))
+	require.NoError(t, handler.Handle(ctx, cmd))
+	orderRepo.AssertNotCalled(t, "Update", ctx, testOrder)
+	assert.Equal(t, order.Assigned, testOrder.Status())
+
+	// Second idle tick reaches the threshold
+	require.NoError(t, handler.Handle(ctx, cmd))
+
+	assert.Equal(t, order.Created, testOrder.Status())
+	assert.Nil(t, testOrder.Courier())
+	assert.True(t, testCourier.IsReviewRequired())
+	assert.Nil(t, testCourier.StoragePlaces()[0].OrderID())
+	orderRepo.AssertExpectations(t)
+	courierRepo.AssertExpectations(t)
+}
+
+func TestUnassignInactiveCouriersCommandHandler_Handle_MovementResetsCounter(t *testing.T) {
+	ctx := t.Context()
+	cmd, err := commands.NewUnassignInactiveCouriersCommand(2)
+	require.NoError(t, err)
+
+	testOrder, testCourier := createStalledCourierWithOrder(t)
+	factory, _, orderRepo, _ := setupWatchdogMocks(ctx, testOrder, testCourier)
+
+	handler := commands.NewUnassignInactiveCouriersCommandHandler(factory)
+
+	require.NoError(t, handler.Handle(ctx, cmd))
+	require.NoError(t, handler.Handle(ctx, cmd))
+	require.NoError(t, testCourier.Move(testOrder.Location()))
+	require.NoError(t, handler.Handle(ctx, cmd))
+	require.NoError(t, handler.Handle(ctx, cmd))
+
+	assert.Equal(t, order.Assigned, testOrder.Status())
+	assert.False(t, testCourier.IsReviewRequired())
+	orderRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestUnassignInactiveCouriersCommandHandler_Handle_TenantsKeepSeparateCounters(t *testing.T) {
+	acmeCtx := tenant.WithID(t.Context(), "acme")
+	globexCtx := tenant.WithID(t.Context(), "globex")
+	cmd, err := commands.NewUnassignInactiveCouriersCommand(2)
+	require.NoError(t, err)
+
+	acmeOrder, acmeCourier := createStalledCourierWithOrder(t)
+	globexOrder, globexCourier := createStalledCourierWithOrder(t)
+
+	courierRepo := new(MoveCourierRepo)
+	orderRepo := new(MoveOrderRepo)
+	uow := new(MoveUnitOfWork)
+	factory := new(MoveUoWFactory)
+	factory.On("Create").Return(uow)
+	uow.On("CourierRepository").Return(courierRepo)
+	uow.On("OrderRepository").Return(orderRepo)
+	for _, ctx := range []context.Context{acmeCtx, globexCtx} {
+		uow.On("Begin", ctx).Return(nil)
+		uow.On("Commit", ctx).Return(nil)
+		uow.On("Rollback", ctx).Return(nil).Maybe()
+	}
+	orderRepo.On("GetAllInAssignedStatus", acmeCtx).Return([]*order.Order{acmeOrder}, nil)
+	orderRepo.On("GetAllInAssignedStatus", globexCtx).Return([]*order.Order{globexOrder}, nil)
+	courierRepo.On("Get", acmeCtx, acmeCourier.ID()).Return(acmeCourier, nil)
+	courierRepo.On("Get", globexCtx, globexCourier.ID()).Return(globexCourier, nil)
+	orderRepo.On("Update", acmeCtx, acmeOrder).Return(nil).Once()
+	courierRepo.On("Update", acmeCtx, acmeCourier).Return(nil).Once()
+
+	handler := commands.NewUnassignInactiveCouriersCommandHandler(factory)
+
+	// Ticks of the tenants interleave, as the watchdog job runs them one after another
+	require.NoError(t, handler.Handle(acmeCtx, cmd))
+	require.NoError(t, handler.Handle(globexCtx, cmd))
+	require.NoError(t, handler.Handle(acmeCtx, cmd))
+	require.NoError(t, handler.Handle(globexCtx, cmd))
+	require.NoError(t, handler.Handle(acmeCtx, cmd))
+
+	assert.Equal(t, order.Created, acmeOrder.Status())
+	assert.True(t, acmeCourier.IsReviewRequired())
+	assert.Equal(t, order.Assigned, globexOrder.Status())
+	assert.False(t, globexCourier.IsReviewRequired())
+	orderRepo.AssertExpectations(t)
+	courierRepo.AssertExpectations(t)
+}
+
+func TestUnassignInactiveCouriersCommandHandler_Handle_CourierOnBreakIsExempt(t *testing.T) {
+	ctx := t.Context()
+	cmd, err := commands.NewUnassignInactiveCouriersCommand(1)
+	require.NoError(t, err)
+
+	testOrder, testCourier := createStalledCourierWithOrder(t)
+	require.NoError(t, testCourier.StartShift(time.Now(), createShiftLimit(t)))
+	require.NoError(t, testCourier.StartBreak())
+	factory, _, orderRepo, courierRepo := setupWatchdogMocks(ctx, testOrder, testCourier)
+
+	handler := commands.NewUnassignInactiveCouriersCommandHandler(factory)
+	for range 3 {
+		require.NoError(t, handler.Handle(ctx, cmd))
+	}
+
+	assert.Equal(t, order.Assigned, testOrder.Status())
+	assert.False(t, testCourier.IsReviewRequired())
+	orderRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	courierRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestUnassignInactiveCouriersCommandHandler_Handle_ValidationError(t *testing.T) {
+	ctx := t.Context()
+	factory := new(MoveUoWFactory)
+
+	handler := commands.NewUnassignInactiveCouriersCommandHandler(factory)
+	err := handler.Handle(ctx, commands.UnassignInactiveCouriersCommand{})
+
+	require.ErrorIs(t, err, commands.ErrUnassignInactiveCouriersCommandIsNotConstructed)
+	factory.AssertNotCalled(t, "Create")
+}
+
+func TestUnassignInactiveCouriersCommandHandler_Handle_RepositoryError(t *testing.T) {
+	ctx := t.Context()
+	cmd, err := commands.NewUnassignInactiveCouriersCommand(1)
+	require.NoError(t, err)
+
+	orderRepo := new(MoveOrderRepo)
+	uow := new(MoveUnitOfWork)
+	factory := new(MoveUoWFactory)
+
+	mock.InOrder(
+		factory.On("Create").Return(uow).Once(),
+		uow.On("Begin", ctx).Return(nil).Once(),
+		uow.On("CourierRepository").Return(new(MoveCourierRepo)).Once(),
+		uow.On("OrderRepository").Return(orderRepo).Once(),
+		orderRepo.On("GetAllInAssignedStatus", ctx).Return(nil, errors.New("repository error")).Once(),
+		uow.On("Rollback", ctx).Return(nil).Once(),
+	)
+
+	handler := commands.NewUnassignInactiveCouriersCommandHandler(factory)
+	err = handler.Handle(ctx, cmd)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "repository error")
+	uow.AssertExpectations(t)
+	orderRepo.AssertExpectations(t)
+}
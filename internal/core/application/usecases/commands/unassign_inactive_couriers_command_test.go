@@ -0,0 +1,29 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUnassignInactiveCouriersCommand_ValidInput(t *testing.T) {
+	cmd, err := commands.NewUnassignInactiveCouriersCommand(5)
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, 5, cmd.ThresholdTicks())
+}
+
+func TestNewUnassignInactiveCouriersCommand_InvalidThreshold(t *testing.T) {
+	for _, threshold := range []int{0, -1} {
+		_, err := commands.NewUnassignInactiveCouriersCommand(threshold)
+		require.ErrorIs(t, err, commands.ErrInactivityThresholdIsInvalid)
+	}
+}
+
+func TestUnassignInactiveCouriersCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.UnassignInactiveCouriersCommand
+	require.ErrorIs(t, cmd.Validate(), commands.ErrUnassignInactiveCouriersCommandIsNotConstructed)
+}
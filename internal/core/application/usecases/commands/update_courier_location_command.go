@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/track"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrUpdateCourierLocationCommandIsNotConstructed = errors.New(
+		"UpdateCourierLocationCommand must be created via NewUpdateCourierLocationCommand constructor",
+	)
+)
+
+// UpdateCourierLocationCommand represents the current position a courier app derived from GPS
+// and reports while online.
+//
+// Example:
+//
+//	point, _ := track.NewPoint(location, time.Now())
+//	cmd, err := NewUpdateCourierLocationCommand(courierID, point)
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewUpdateCourierLocationCommandHandler(uowFactory, tracks)
+//	err = handler.Handle(ctx, cmd)
+type UpdateCourierLocationCommand struct { //nolint:recvcheck //using for validation
+	courierID kernel.UUID
+	point     track.Point
+
+	guard guard.ConstructorGuard
+}
+
+// NewUpdateCourierLocationCommand creates a command to report the courier's position.
+// Returns a validation error if the courier ID is invalid or the point was not created
+// through its constructor.
+func NewUpdateCourierLocationCommand(courierID kernel.UUID, point track.Point) (UpdateCourierLocationCommand, error) {
+	if err := errs.JoinFields(
+		errs.Field("courierId", courierID.Validate()),
+		errs.Field("point", point.Validate()),
+	); err != nil {
+		return UpdateCourierLocationCommand{}, err
+	}
+
+	return UpdateCourierLocationCommand{
+		courierID: courierID,
+		point:     point,
+		guard:     guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrUpdateCourierLocationCommandIsNotConstructed if validation fails.
+func (c UpdateCourierLocationCommand) Validate() error {
+	return c.guard.Validate(ErrUpdateCourierLocationCommandIsNotConstructed)
+}
+
+// CourierID returns the ID of the courier whose app reported the position.
+func (c UpdateCourierLocationCommand) CourierID() kernel.UUID {
+	return c.courierID
+}
+
+// Point returns the reported position and when it was recorded.
+func (c UpdateCourierLocationCommand) Point() track.Point {
+	return c.point
+}
@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/track"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tracing"
+)
+
+// UpdateCourierLocationCommandHandler places couriers at the positions their apps report, so
+// dispatch works with where couriers actually are rather than with simulated movement. Every
+// report is added to the courier's location history, and the daily track of its day is
+// summarized again.
+//
+// Example:
+//
+//	handler := NewUpdateCourierLocationCommandHandler(uowFactory, tracks)
+//	if err := handler.Handle(ctx, cmd); errors.Is(err, errs.ErrObjectNotFound) {
+//	    log.Printf("Courier %s does not exist", cmd.CourierID())
+//	}
+type UpdateCourierLocationCommandHandler struct {
+	uowFactory CourierUoWFactory
+	tracks     ports.CourierTrackRepository
+}
+
+// NewUpdateCourierLocationCommandHandler creates a handler for location reports.
+// Requires a CourierUoWFactory for transactional operations and the location history.
+func NewUpdateCourierLocationCommandHandler(
+	uowFactory CourierUoWFactory,
+	tracks ports.CourierTrackRepository,
+) UpdateCourierLocationCommandHandler {
+	return UpdateCourierLocationCommandHandler{
+		uowFactory: uowFactory,
+		tracks:     tracks,
+	}
+}
+
+// Handle moves the courier to the reported location within a transaction, then records the
+// report in the location history. A report resent for a moment the history already has moves
+// the courier again and is not recorded twice. Reports recorded more than a minute in the future
+// are refused. Returns an ObjectNotFoundError if the courier does not exist.
+func (h UpdateCourierLocationCommandHandler) Handle(ctx context.Context, cmd UpdateCourierLocationCommand) error {
+	ctx, span := tracing.Start(ctx, "UpdateCourierLocationCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+
+	point := cmd.Point()
+	if point.RecordedAt().After(time.Now().Add(maxTelemetryClockSkew)) {
+		return errs.JoinFields(errs.Field("recordedAt", errs.NewValueIsInvalidErrorWithCause(
+			"recordedAt is invalid",
+			fmt.Errorf("%s is in the future", point.RecordedAt().Format(time.RFC3339)),
+		)))
+	}
+
+	uow := h.uowFactory.Create()
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		courierEntity, err := courierRepo.Get(ctx, cmd.CourierID())
+		if err != nil {
+			return err
+		}
+
+		if err = courierEntity.ReportLocation(point.Location()); err != nil {
+			return err
+		}
+
+		return courierRepo.Update(ctx, courierEntity)
+	})
+	if err != nil {
+		return err
+	}
+
+	// The location history is stored apart from the courier unit of work
+	if _, err = h.tracks.Merge(ctx, cmd.CourierID(), []track.Point{point}); err != nil {
+		return err
+	}
+
+	_, err = summarizeTrackDays(ctx, h.tracks, cmd.CourierID(), track.Days([]track.Point{point}))
+	return err
+}
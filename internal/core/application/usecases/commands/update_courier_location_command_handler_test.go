@@ -0,0 +1,100 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/track"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateCourierLocationCommandHandler_Handle_MovesCourierAndRecordsHistory(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+	point := trackPoint(t, 9, 8, time.Now())
+	day := track.Day(point.RecordedAt())
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+	tracks := new(MockCourierTrackRepository)
+	mock.InOrder(
+		mockFactory.On("Create").Return(mockUoW).Once(),
+		mockUoW.On("Begin", ctx).Return(nil).Once(),
+		mockUoW.On("CourierRepository").Return(mockRepo).Once(),
+		mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil).Once(),
+		mockRepo.On("Update", ctx, courierEntity).Return(nil).Once(),
+		mockUoW.On("Commit", ctx).Return(nil).Once(),
+		tracks.On("Merge", ctx, courierEntity.ID(), []track.Point{point}).Return(1, nil).Once(),
+		tracks.On("GetDay", ctx, courierEntity.ID(), day).Return([]track.Point{point}, nil).Once(),
+		tracks.On("SaveDailyTracks", ctx, mock.MatchedBy(func(days []track.DailyTrack) bool {
+			return len(days) == 1 && days[0].Day().Equal(day) && days[0].Points() == 1
+		})).Return(nil).Once(),
+	)
+
+	cmd, err := commands.NewUpdateCourierLocationCommand(courierEntity.ID(), point)
+	require.NoError(t, err)
+
+	handler := commands.NewUpdateCourierLocationCommandHandler(mockFactory, tracks)
+	require.NoError(t, handler.Handle(ctx, cmd))
+
+	assert.Equal(t, point.Location(), courierEntity.Location())
+	mockRepo.AssertExpectations(t)
+	mockUoW.AssertExpectations(t)
+	tracks.AssertExpectations(t)
+}
+
+func TestUpdateCourierLocationCommandHandler_Handle_UnknownCourier(t *testing.T) {
+	ctx := t.Context()
+	courierID := kernel.NewUUID()
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+	tracks := new(MockCourierTrackRepository)
+	mockFactory.On("Create").Return(mockUoW).Once()
+	mockUoW.On("Begin", ctx).Return(nil).Once()
+	mockUoW.On("CourierRepository").Return(mockRepo).Once()
+	mockRepo.On("Get", ctx, courierID).Return((*courier.Courier)(nil), errs.NewObjectNotFoundError("courier", courierID)).Once()
+	mockUoW.On("Rollback", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewUpdateCourierLocationCommand(courierID, trackPoint(t, 2, 2, time.Now()))
+	require.NoError(t, err)
+
+	handler := commands.NewUpdateCourierLocationCommandHandler(mockFactory, tracks)
+	err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, errs.ErrObjectNotFound)
+	mockUoW.AssertNotCalled(t, "Commit", ctx)
+	tracks.AssertNotCalled(t, "Merge", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateCourierLocationCommandHandler_Handle_FutureReport(t *testing.T) {
+	ctx := t.Context()
+	mockFactory := new(MockCourierUoWFactory)
+	tracks := new(MockCourierTrackRepository)
+
+	cmd, err := commands.NewUpdateCourierLocationCommand(kernel.NewUUID(), trackPoint(t, 2, 2, time.Now().Add(time.Hour)))
+	require.NoError(t, err)
+
+	handler := commands.NewUpdateCourierLocationCommandHandler(mockFactory, tracks)
+	err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	mockFactory.AssertNotCalled(t, "Create")
+}
+
+func TestUpdateCourierLocationCommandHandler_Handle_NotConstructed(t *testing.T) {
+	handler := commands.NewUpdateCourierLocationCommandHandler(new(MockCourierUoWFactory), new(MockCourierTrackRepository))
+
+	err := handler.Handle(t.Context(), commands.UpdateCourierLocationCommand{})
+
+	require.ErrorIs(t, err, commands.ErrUpdateCourierLocationCommandIsNotConstructed)
+}
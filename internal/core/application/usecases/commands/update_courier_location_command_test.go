@@ -0,0 +1,41 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/track"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUpdateCourierLocationCommand_ValidInput(t *testing.T) {
+	courierID := kernel.NewUUID()
+	point := trackPoint(t, 4, 7, time.Now())
+
+	cmd, err := commands.NewUpdateCourierLocationCommand(courierID, point)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, courierID, cmd.CourierID())
+	assert.Equal(t, point, cmd.Point())
+}
+
+func TestNewUpdateCourierLocationCommand_InvalidInput(t *testing.T) {
+	_, err := commands.NewUpdateCourierLocationCommand(kernel.UUID{}, track.Point{})
+
+	var validation *errs.ValidationErrors
+	require.ErrorAs(t, err, &validation)
+	require.Len(t, validation.Fields, 2)
+	assert.Equal(t, "courierId", validation.Fields[0].Field)
+	assert.Equal(t, "point", validation.Fields[1].Field)
+}
+
+func TestUpdateCourierLocationCommand_NotConstructed(t *testing.T) {
+	require.ErrorIs(t, commands.UpdateCourierLocationCommand{}.Validate(),
+		commands.ErrUpdateCourierLocationCommandIsNotConstructed)
+}
@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrUpdateCourierProfileCommandIsNotConstructed = errors.New(
+		"UpdateCourierProfileCommand must be created via NewUpdateCourierProfileCommand constructor",
+	)
+)
+
+// UpdateCourierProfileCommand represents a request to replace a courier's photo, phone and vehicle plate.
+// Fields missing from the profile are cleared.
+//
+// Example:
+//
+//	profile, err := courier.NewProfile(photoURL, phone, vehiclePlate)
+//	if err != nil {
+//	    return fmt.Errorf("invalid profile: %w", err)
+//	}
+//
+//	cmd, err := NewUpdateCourierProfileCommand(courierID, profile)
+//	if err != nil {
+//	    return fmt.Errorf("invalid command: %w", err)
+//	}
+//
+//	handler := NewUpdateCourierProfileCommandHandler(uowFactory)
+//	updated, err := handler.Handle(ctx, cmd)
+type UpdateCourierProfileCommand struct { //nolint:recvcheck //using for validation
+	courierID kernel.UUID
+	profile   courier.Profile
+
+	guard guard.ConstructorGuard
+}
+
+// NewUpdateCourierProfileCommand creates a command to update a courier's profile.
+// Validates that the courier ID is valid; the profile is validated by its constructor.
+func NewUpdateCourierProfileCommand(
+	courierID kernel.UUID,
+	profile courier.Profile,
+) (UpdateCourierProfileCommand, error) {
+	command := UpdateCourierProfileCommand{
+		profile: profile,
+		guard:   guard.NewConstructorGuard(),
+	}
+
+	if err := command.setCourierID(courierID); err != nil {
+		return UpdateCourierProfileCommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrUpdateCourierProfileCommandIsNotConstructed if validation fails.
+func (c UpdateCourierProfileCommand) Validate() error {
+	return c.guard.Validate(ErrUpdateCourierProfileCommandIsNotConstructed)
+}
+
+// CourierID returns the ID of the courier whose profile is updated.
+func (c UpdateCourierProfileCommand) CourierID() kernel.UUID {
+	return c.courierID
+}
+
+// Profile returns the new profile of the courier.
+func (c UpdateCourierProfileCommand) Profile() courier.Profile {
+	return c.profile
+}
+
+func (c *UpdateCourierProfileCommand) setCourierID(courierID kernel.UUID) error {
+	if err := courierID.Validate(); err != nil {
+		return err
+	}
+
+	c.courierID = courierID
+	return nil
+}
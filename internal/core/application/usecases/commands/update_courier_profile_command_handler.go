@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/pkg/tracing"
+)
+
+// UpdateCourierProfileCommandHandler replaces the profile details of a courier.
+//
+// Example:
+//
+//	handler := NewUpdateCourierProfileCommandHandler(uowFactory)
+//	cmd, _ := NewUpdateCourierProfileCommand(courierID, profile)
+//	updated, err := handler.Handle(ctx, cmd)
+//	if err != nil {
+//	    log.Printf("Failed to update courier profile: %v", err)
+//	}
+type UpdateCourierProfileCommandHandler struct {
+	uowFactory CourierUoWFactory
+}
+
+// NewUpdateCourierProfileCommandHandler creates a new handler for courier profile updates.
+// Requires a CourierUoWFactory for transactional operations.
+func NewUpdateCourierProfileCommandHandler(uowFactory CourierUoWFactory) UpdateCourierProfileCommandHandler {
+	return UpdateCourierProfileCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the UpdateCourierProfileCommand within a transaction and returns the stored profile.
+// Returns an ObjectNotFoundError for unknown couriers.
+func (h *UpdateCourierProfileCommandHandler) Handle(
+	ctx context.Context,
+	cmd UpdateCourierProfileCommand,
+) (courier.Profile, error) {
+	ctx, span := tracing.Start(ctx, "UpdateCourierProfileCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return courier.Profile{}, err
+	}
+
+	var profile courier.Profile
+	uow := h.uowFactory.Create()
+	err := uow.Do(ctx, func(ctx context.Context) error {
+		courierRepo := uow.CourierRepository()
+		courierEntity, err := courierRepo.Get(ctx, cmd.CourierID())
+		if err != nil {
+			return err
+		}
+
+		courierEntity.ChangeProfile(cmd.Profile())
+
+		if err = courierRepo.Update(ctx, courierEntity); err != nil {
+			return err
+		}
+
+		profile = courierEntity.Profile()
+		return nil
+	})
+	if err != nil {
+		return courier.Profile{}, err
+	}
+
+	return profile, nil
+}
@@ -0,0 +1,85 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateCourierProfileCommandHandler_Handle_ReplacesProfile(t *testing.T) {
+	ctx := t.Context()
+	courierEntity := createCourierForMaintenance(t)
+	initial, err := courier.NewProfile("https://cdn.example.com/1.jpg", "+79123456789", "A123BC")
+	require.NoError(t, err)
+	courierEntity.ChangeProfile(initial)
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+
+	mockFactory.On("Create").Return(mockUoW)
+	mockUoW.On("Begin", ctx).Return(nil)
+	mockUoW.On("CourierRepository").Return(mockRepo)
+	mockUoW.On("Commit", ctx).Return(nil)
+	mockUoW.On("Rollback", ctx).Return(nil).Maybe()
+	mockRepo.On("Get", ctx, courierEntity.ID()).Return(courierEntity, nil)
+	mockRepo.On("Update", ctx, courierEntity).Return(nil)
+
+	profile, err := courier.NewProfile("https://cdn.example.com/2.jpg", "", "")
+	require.NoError(t, err)
+	cmd, err := commands.NewUpdateCourierProfileCommand(courierEntity.ID(), profile)
+	require.NoError(t, err)
+
+	handler := commands.NewUpdateCourierProfileCommandHandler(mockFactory)
+	updated, err := handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	require.NotNil(t, updated.PhotoURL())
+	assert.Equal(t, "https://cdn.example.com/2.jpg", updated.PhotoURL().String())
+	assert.Nil(t, updated.Phone())
+	assert.Nil(t, updated.VehiclePlate())
+	assert.Equal(t, profile, courierEntity.Profile())
+	mockRepo.AssertExpectations(t)
+	mockUoW.AssertExpectations(t)
+}
+
+func TestUpdateCourierProfileCommandHandler_Handle_UnknownCourier(t *testing.T) {
+	ctx := t.Context()
+	courierID := kernel.NewUUID()
+
+	mockRepo := new(MockCourierRepository)
+	mockUoW := new(MockCourierUoW)
+	mockFactory := new(MockCourierUoWFactory)
+
+	mockFactory.On("Create").Return(mockUoW)
+	mockUoW.On("Begin", ctx).Return(nil)
+	mockUoW.On("CourierRepository").Return(mockRepo)
+	mockUoW.On("Rollback", ctx).Return(nil)
+	notFound := errs.NewObjectNotFoundError("courier", courierID.String())
+	mockRepo.On("Get", ctx, courierID).Return((*courier.Courier)(nil), notFound)
+
+	cmd, err := commands.NewUpdateCourierProfileCommand(courierID, courier.Profile{})
+	require.NoError(t, err)
+
+	handler := commands.NewUpdateCourierProfileCommandHandler(mockFactory)
+	_, err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, errs.ErrObjectNotFound)
+	mockUoW.AssertNotCalled(t, "Commit", ctx)
+}
+
+func TestUpdateCourierProfileCommandHandler_Handle_InvalidCommand(t *testing.T) {
+	mockFactory := new(MockCourierUoWFactory)
+	handler := commands.NewUpdateCourierProfileCommandHandler(mockFactory)
+
+	_, err := handler.Handle(t.Context(), commands.UpdateCourierProfileCommand{})
+
+	require.ErrorIs(t, err, commands.ErrUpdateCourierProfileCommandIsNotConstructed)
+	mockFactory.AssertNotCalled(t, "Create")
+}
@@ -0,0 +1,37 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUpdateCourierProfileCommand_ValidInput(t *testing.T) {
+	courierID := kernel.NewUUID()
+	profile, err := courier.NewProfile("https://cdn.example.com/1.jpg", "+79123456789", "")
+	require.NoError(t, err)
+
+	cmd, err := commands.NewUpdateCourierProfileCommand(courierID, profile)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, courierID, cmd.CourierID())
+	assert.Equal(t, profile, cmd.Profile())
+}
+
+func TestNewUpdateCourierProfileCommand_InvalidID(t *testing.T) {
+	_, err := commands.NewUpdateCourierProfileCommand(kernel.UUID{}, courier.Profile{})
+
+	require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+}
+
+func TestUpdateCourierProfileCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.UpdateCourierProfileCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrUpdateCourierProfileCommandIsNotConstructed)
+}
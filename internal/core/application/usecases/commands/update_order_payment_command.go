@@ -0,0 +1,91 @@
+package commands
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrUpdateOrderPaymentCommandIsNotConstructed = errors.New(
+		"UpdateOrderPaymentCommand must be created via NewUpdateOrderPaymentCommand constructor",
+	)
+)
+
+// UpdateOrderPaymentCommand represents a payment event reported by the payment provider.
+// The reference is the provider's event ID; redelivered events with the same reference
+// are applied only once.
+//
+// Example:
+//
+//	cmd, err := NewUpdateOrderPaymentCommand(orderID, order.PaymentPaid, "evt_1042", occurredAt)
+//	if err != nil {
+//	    return fmt.Errorf("invalid payment event: %w", err)
+//	}
+//
+//	handler := NewUpdateOrderPaymentCommandHandler(uowFactory)
+//	if err := handler.Handle(ctx, cmd); err != nil {
+//	    return fmt.Errorf("failed to update payment: %w", err)
+//	}
+type UpdateOrderPaymentCommand struct { //nolint:recvcheck //using for validation
+	orderID    kernel.UUID
+	status     order.PaymentStatus
+	reference  string
+	occurredAt time.Time
+
+	guard guard.ConstructorGuard
+}
+
+// NewUpdateOrderPaymentCommand creates a command to move the order's payment to the reported status.
+// Validates the order ID and the status; the reference and the time are validated when the event is applied.
+func NewUpdateOrderPaymentCommand(
+	orderID kernel.UUID,
+	status order.PaymentStatus,
+	reference string,
+	occurredAt time.Time,
+) (UpdateOrderPaymentCommand, error) {
+	if err := errs.JoinFields(
+		errs.Field("orderId", orderID.Validate()),
+		errs.Field("status", status.Validate()),
+	); err != nil {
+		return UpdateOrderPaymentCommand{}, err
+	}
+
+	return UpdateOrderPaymentCommand{
+		orderID:    orderID,
+		status:     status,
+		reference:  reference,
+		occurredAt: occurredAt,
+		guard:      guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrUpdateOrderPaymentCommandIsNotConstructed if validation fails.
+func (c UpdateOrderPaymentCommand) Validate() error {
+	return c.guard.Validate(ErrUpdateOrderPaymentCommandIsNotConstructed)
+}
+
+// OrderID returns the ID of the paid order.
+func (c UpdateOrderPaymentCommand) OrderID() kernel.UUID {
+	return c.orderID
+}
+
+// Status returns the payment status reported by the payment provider.
+func (c UpdateOrderPaymentCommand) Status() order.PaymentStatus {
+	return c.status
+}
+
+// Reference returns the payment provider's event ID.
+func (c UpdateOrderPaymentCommand) Reference() string {
+	return c.reference
+}
+
+// OccurredAt returns when the payment provider reported the event.
+func (c UpdateOrderPaymentCommand) OccurredAt() time.Time {
+	return c.occurredAt
+}
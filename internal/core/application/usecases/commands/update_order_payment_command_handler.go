@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/pkg/tracing"
+)
+
+// UpdateOrderPaymentCommandHandler applies payment events from the payment provider to orders.
+// Orders paid online enter the dispatch queue once their payment is confirmed.
+//
+// Example:
+//
+//	handler := NewUpdateOrderPaymentCommandHandler(uowFactory)
+//	cmd, _ := NewUpdateOrderPaymentCommand(orderID, order.PaymentPaid, "evt_1042", occurredAt)
+//	if err := handler.Handle(ctx, cmd); errors.Is(err, order.ErrPaymentTransitionIsInvalid) {
+//	    // For example a refund reported before the payment
+//	}
+type UpdateOrderPaymentCommandHandler struct {
+	uowFactory OrderUoWFactory
+}
+
+// NewUpdateOrderPaymentCommandHandler creates a new handler for payment events.
+// Requires an OrderUoWFactory for transactional operations.
+func NewUpdateOrderPaymentCommandHandler(uowFactory OrderUoWFactory) UpdateOrderPaymentCommandHandler {
+	return UpdateOrderPaymentCommandHandler{
+		uowFactory: uowFactory,
+	}
+}
+
+// Handle processes the UpdateOrderPaymentCommand within a transaction.
+// Redelivered events and events reporting the current status change nothing.
+// Returns order.ErrPaymentTransitionIsInvalid when the event does not follow the payment lifecycle.
+func (h *UpdateOrderPaymentCommandHandler) Handle(ctx context.Context, cmd UpdateOrderPaymentCommand) error {
+	ctx, span := tracing.Start(ctx, "UpdateOrderPaymentCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+
+	uow := h.uowFactory.Create()
+	return uow.Do(ctx, func(ctx context.Context) error {
+		orderRepo := uow.OrderRepository()
+		orderAggregate, err := orderRepo.Get(ctx, cmd.OrderID())
+		if err != nil {
+			return err
+		}
+
+		changed, err := orderAggregate.ApplyPaymentEvent(cmd.Status(), cmd.Reference(), cmd.OccurredAt())
+		if err != nil {
+			return err
+		}
+		if !changed {
+			return nil
+		}
+
+		return orderRepo.Update(ctx, orderAggregate)
+	})
+}
@@ -0,0 +1,97 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateOrderPaymentCommandHandler_Handle_Success(t *testing.T) {
+	ctx := t.Context()
+	orderAggregate := createOrderForThread(t)
+	require.NoError(t, orderAggregate.ChangePaymentMethod(order.OnlinePayment))
+
+	repo := new(MoveOrderRepo)
+	uow := new(MockOrderUoW)
+	factory := new(MockOrderUoWFactory)
+
+	mock.InOrder(
+		factory.On("Create").Return(uow).Once(),
+		uow.On("Begin", ctx).Return(nil).Once(),
+		uow.On("OrderRepository").Return(repo).Once(),
+		repo.On("Get", ctx, orderAggregate.ID()).Return(orderAggregate, nil).Once(),
+		repo.On("Update", ctx, orderAggregate).Return(nil).Once(),
+		uow.On("Commit", ctx).Return(nil).Once(),
+	)
+
+	cmd, err := commands.NewUpdateOrderPaymentCommand(orderAggregate.ID(), order.PaymentPaid, "evt_1", time.Now())
+	require.NoError(t, err)
+
+	handler := commands.NewUpdateOrderPaymentCommandHandler(factory)
+	err = handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, order.PaymentPaid, orderAggregate.PaymentStatus())
+	assert.Len(t, orderAggregate.PaymentTransitions(), 1)
+	repo.AssertExpectations(t)
+	uow.AssertExpectations(t)
+}
+
+func TestUpdateOrderPaymentCommandHandler_Handle_RedeliveredEvent(t *testing.T) {
+	ctx := t.Context()
+	orderAggregate := createOrderForThread(t)
+	_, err := orderAggregate.ApplyPaymentEvent(order.PaymentPaid, "evt_1", time.Now())
+	require.NoError(t, err)
+
+	repo := new(MoveOrderRepo)
+	uow := new(MockOrderUoW)
+	factory := new(MockOrderUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(repo).Once()
+	repo.On("Get", ctx, orderAggregate.ID()).Return(orderAggregate, nil).Once()
+	uow.On("Commit", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewUpdateOrderPaymentCommand(orderAggregate.ID(), order.PaymentPaid, "evt_1", time.Now())
+	require.NoError(t, err)
+
+	handler := commands.NewUpdateOrderPaymentCommandHandler(factory)
+	err = handler.Handle(ctx, cmd)
+
+	require.NoError(t, err)
+	assert.Len(t, orderAggregate.PaymentTransitions(), 1)
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	uow.AssertExpectations(t)
+}
+
+func TestUpdateOrderPaymentCommandHandler_Handle_InvalidTransition(t *testing.T) {
+	ctx := t.Context()
+	orderAggregate := createOrderForThread(t)
+
+	repo := new(MoveOrderRepo)
+	uow := new(MockOrderUoW)
+	factory := new(MockOrderUoWFactory)
+
+	factory.On("Create").Return(uow).Once()
+	uow.On("Begin", ctx).Return(nil).Once()
+	uow.On("OrderRepository").Return(repo).Once()
+	repo.On("Get", ctx, orderAggregate.ID()).Return(orderAggregate, nil).Once()
+	uow.On("Rollback", ctx).Return(nil).Once()
+
+	cmd, err := commands.NewUpdateOrderPaymentCommand(orderAggregate.ID(), order.PaymentRefunded, "evt_1", time.Now())
+	require.NoError(t, err)
+
+	handler := commands.NewUpdateOrderPaymentCommandHandler(factory)
+	err = handler.Handle(ctx, cmd)
+
+	require.ErrorIs(t, err, order.ErrPaymentTransitionIsInvalid)
+	assert.Equal(t, order.PaymentPending, orderAggregate.PaymentStatus())
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
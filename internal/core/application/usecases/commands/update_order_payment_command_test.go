@@ -0,0 +1,41 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUpdateOrderPaymentCommand_ValidInput(t *testing.T) {
+	orderID := kernel.NewUUID()
+	occurredAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	cmd, err := commands.NewUpdateOrderPaymentCommand(orderID, order.PaymentPaid, "evt_1", occurredAt)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, orderID, cmd.OrderID())
+	assert.Equal(t, order.PaymentPaid, cmd.Status())
+	assert.Equal(t, "evt_1", cmd.Reference())
+	assert.Equal(t, occurredAt, cmd.OccurredAt())
+}
+
+func TestNewUpdateOrderPaymentCommand_InvalidInput(t *testing.T) {
+	_, err := commands.NewUpdateOrderPaymentCommand(kernel.UUID{}, order.UnknownPaymentStatus, "evt_1", time.Now())
+
+	require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
+
+func TestUpdateOrderPaymentCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.UpdateOrderPaymentCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrUpdateOrderPaymentCommandIsNotConstructed)
+}
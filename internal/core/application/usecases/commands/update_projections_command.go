@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"errors"
+
+	"delivery/internal/pkg/guard"
+)
+
+var ErrUpdateProjectionsCommandIsNotConstructed = errors.New(
+	"UpdateProjectionsCommand must be created via NewUpdateProjectionsCommand constructor",
+)
+
+// UpdateProjectionsCommand represents a request to project the changes recorded since the
+// read-model projections were last updated. Sent by the projection job on every tick.
+//
+// Example:
+//
+//	cmd, err := NewUpdateProjectionsCommand(500)
+//	if err != nil {
+//	    return fmt.Errorf("invalid projection parameters: %w", err)
+//	}
+//
+//	projected, err := handler.Handle(ctx, cmd)
+type UpdateProjectionsCommand struct { //nolint:recvcheck //using for validation
+	batchSize int
+
+	guard guard.ConstructorGuard
+}
+
+// NewUpdateProjectionsCommand creates a command to update the projections.
+// batchSize must be positive.
+func NewUpdateProjectionsCommand(batchSize int) (UpdateProjectionsCommand, error) {
+	command := UpdateProjectionsCommand{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := command.setBatchSize(batchSize); err != nil {
+		return UpdateProjectionsCommand{}, err
+	}
+
+	return command, nil
+}
+
+// Validate ensures the command was created through the constructor.
+// Returns ErrUpdateProjectionsCommandIsNotConstructed if validation fails.
+func (c UpdateProjectionsCommand) Validate() error {
+	return c.guard.Validate(ErrUpdateProjectionsCommandIsNotConstructed)
+}
+
+// BatchSize returns the maximum number of changes projected into each projection by one run.
+func (c UpdateProjectionsCommand) BatchSize() int {
+	return c.batchSize
+}
+
+func (c *UpdateProjectionsCommand) setBatchSize(batchSize int) error {
+	if batchSize <= 0 {
+		return ErrBatchSizeIsInvalid
+	}
+
+	c.batchSize = batchSize
+	return nil
+}
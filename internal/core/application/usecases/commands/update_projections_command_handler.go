@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"context"
+
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tracing"
+)
+
+// UpdateProjectionsCommandHandler keeps the read-model projections, such as the order history,
+// up to date with the change log. Every run projects at most a batch of changes into each
+// projection, so a backlog drains over several runs and every run stays short.
+//
+// Example:
+//
+//	handler := NewUpdateProjectionsCommandHandler(runner)
+//	cmd, _ := NewUpdateProjectionsCommand(500)
+//	projected, err := handler.Handle(ctx, cmd)
+type UpdateProjectionsCommandHandler struct {
+	updater ports.ProjectionUpdater
+}
+
+// NewUpdateProjectionsCommandHandler creates a handler for projection updates.
+func NewUpdateProjectionsCommandHandler(updater ports.ProjectionUpdater) UpdateProjectionsCommandHandler {
+	return UpdateProjectionsCommandHandler{updater: updater}
+}
+
+// Handle projects up to the command's batch size of new changes into each projection.
+// Returns the number of changes projected, also when it stops on an error.
+func (h *UpdateProjectionsCommandHandler) Handle(ctx context.Context, cmd UpdateProjectionsCommand) (int, error) {
+	ctx, span := tracing.Start(ctx, "UpdateProjectionsCommand")
+	defer span.End()
+
+	if err := cmd.Validate(); err != nil {
+		return 0, err
+	}
+
+	return h.updater.ProjectNext(ctx, cmd.BatchSize())
+}
@@ -0,0 +1,59 @@
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockProjectionUpdater is a ports.ProjectionUpdater that projects a fixed number of changes.
+type MockProjectionUpdater struct {
+	projected int
+	err       error
+	batchSize int
+}
+
+func (u *MockProjectionUpdater) ProjectNext(_ context.Context, batchSize int) (int, error) {
+	u.batchSize = batchSize
+	return u.projected, u.err
+}
+
+func TestUpdateProjectionsCommandHandler_Handle_ProjectsBatch(t *testing.T) {
+	updater := &MockProjectionUpdater{projected: 3}
+	handler := commands.NewUpdateProjectionsCommandHandler(updater)
+	cmd, err := commands.NewUpdateProjectionsCommand(100)
+	require.NoError(t, err)
+
+	projected, err := handler.Handle(t.Context(), cmd)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, projected)
+	assert.Equal(t, 100, updater.batchSize)
+}
+
+func TestUpdateProjectionsCommandHandler_Handle_ReturnsProjectedOnError(t *testing.T) {
+	updateErr := errors.New("projection failed")
+	handler := commands.NewUpdateProjectionsCommandHandler(&MockProjectionUpdater{projected: 2, err: updateErr})
+	cmd, err := commands.NewUpdateProjectionsCommand(100)
+	require.NoError(t, err)
+
+	projected, err := handler.Handle(t.Context(), cmd)
+
+	require.ErrorIs(t, err, updateErr)
+	assert.Equal(t, 2, projected)
+}
+
+func TestUpdateProjectionsCommandHandler_Handle_NotConstructedCommand(t *testing.T) {
+	updater := &MockProjectionUpdater{}
+	handler := commands.NewUpdateProjectionsCommandHandler(updater)
+
+	_, err := handler.Handle(t.Context(), commands.UpdateProjectionsCommand{})
+
+	require.ErrorIs(t, err, commands.ErrUpdateProjectionsCommandIsNotConstructed)
+	assert.Zero(t, updater.batchSize)
+}
@@ -0,0 +1,30 @@
+package commands_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUpdateProjectionsCommand_ValidInput(t *testing.T) {
+	cmd, err := commands.NewUpdateProjectionsCommand(500)
+
+	require.NoError(t, err)
+	require.NoError(t, cmd.Validate())
+	assert.Equal(t, 500, cmd.BatchSize())
+}
+
+func TestNewUpdateProjectionsCommand_InvalidBatchSize(t *testing.T) {
+	_, err := commands.NewUpdateProjectionsCommand(0)
+
+	require.ErrorIs(t, err, commands.ErrBatchSizeIsInvalid)
+}
+
+func TestUpdateProjectionsCommand_Validate_ZeroValue(t *testing.T) {
+	var cmd commands.UpdateProjectionsCommand
+
+	require.ErrorIs(t, cmd.Validate(), commands.ErrUpdateProjectionsCommandIsNotConstructed)
+}
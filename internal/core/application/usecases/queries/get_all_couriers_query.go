@@ -5,6 +5,7 @@ package queries
 
 import (
 	"errors"
+	"time"
 
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/pkg/guard"
@@ -63,4 +64,34 @@ type GetAllCouriersQueryResponse struct {
 	ID       kernel.UUID
 	Name     string
 	Location kernel.Location
+	// ExternalID is the courier's HR system ID; nil for couriers created without one.
+	ExternalID *string
+	// Insured reports whether the courier may carry insured high-value orders.
+	Insured bool
+	// StoragePlaces lists the courier's storage places by name.
+	StoragePlaces []CourierStoragePlace
+	// Absences lists the courier's current and upcoming absences, earliest first.
+	Absences []CourierAbsence
+	// SubstitutingFor lists the couriers who are absent now and whose orders this courier takes over.
+	SubstitutingFor []kernel.UUID
+}
+
+// CourierStoragePlace is one storage place of a courier.
+type CourierStoragePlace struct {
+	ID          kernel.UUID
+	Name        string
+	TotalVolume int
+	// OrderID is the order the place holds; nil when the place is empty.
+	OrderID      *kernel.UUID
+	OutOfService bool
+	Thermal      bool
+}
+
+// CourierAbsence is a planned absence of a courier.
+type CourierAbsence struct {
+	ID       kernel.UUID
+	StartsAt time.Time
+	EndsAt   time.Time
+	// SubstituteID is the courier who takes over the orders during the absence.
+	SubstituteID kernel.UUID
 }
@@ -2,8 +2,10 @@ package queries
 
 import (
 	"context"
+	"time"
 
 	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/tracing"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -35,24 +37,36 @@ func NewGetAllCouriersQueryHandler(db *gorm.DB) GetAllCouriersQueryHandler {
 }
 
 // Handle executes the query to retrieve all couriers.
-// Returns a slice of courier read models sorted by name.
+// Returns a slice of courier read models sorted by name, together with their storage places,
+// their absences that are not over and the absent couriers they substitute for.
 // Converts database types to domain types for consistency.
 func (h GetAllCouriersQueryHandler) Handle(
 	ctx context.Context,
 	query GetAllCouriersQuery,
 ) ([]GetAllCouriersQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetAllCouriersQuery")
+	defer span.End()
+
 	if err := query.Validate(); err != nil {
 		return nil, err
 	}
 
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	couriers := make([]GetAllCouriersQueryResponse, 0)
 
-	rows, err := h.db.WithContext(ctx).Raw(`
+	rows, err := session.Raw(`
 		SELECT 
 			id, 
 			name, 
 			location_x, 
-			location_y 
+			location_y,
+			external_id,
+			insured
 		FROM couriers
 		ORDER BY name
 	`).Rows()
@@ -71,6 +85,8 @@ func (h GetAllCouriersQueryHandler) Handle(
 			&courier.Name,
 			&locationX,
 			&locationY,
+			&courier.ExternalID,
+			&courier.Insured,
 		)
 		if err != nil {
 			return nil, err
@@ -90,6 +106,9 @@ func (h GetAllCouriersQueryHandler) Handle(
 			return nil, locErr
 		}
 		courier.Location = location
+		courier.StoragePlaces = make([]CourierStoragePlace, 0)
+		courier.Absences = make([]CourierAbsence, 0)
+		courier.SubstitutingFor = make([]kernel.UUID, 0)
 		couriers = append(couriers, courier)
 	}
 
@@ -97,5 +116,135 @@ func (h GetAllCouriersQueryHandler) Handle(
 		return nil, err
 	}
 
+	if err = h.attachStoragePlaces(session, couriers); err != nil {
+		return nil, err
+	}
+
+	if err = h.attachAbsences(session, couriers, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
 	return couriers, nil
 }
+
+// attachStoragePlaces loads the storage places of all couriers in one query
+// and appends them to the matching responses sorted by name.
+func (h GetAllCouriersQueryHandler) attachStoragePlaces(
+	session *gorm.DB,
+	couriers []GetAllCouriersQueryResponse,
+) error {
+	byID := make(map[uuid.UUID]int, len(couriers))
+	for i, c := range couriers {
+		byID[c.ID.Bytes()] = i
+	}
+
+	rows, err := session.Raw(`
+		SELECT
+			id,
+			courier_id,
+			name,
+			total_volume,
+			order_id,
+			out_of_service,
+			thermal
+		FROM storage_places
+		ORDER BY courier_id, name, id
+	`).Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var place CourierStoragePlace
+		var id, courierID uuid.UUID
+		var orderID *uuid.UUID
+
+		err = rows.Scan(
+			&id, &courierID, &place.Name, &place.TotalVolume, &orderID, &place.OutOfService, &place.Thermal,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Couriers added between the two reads are simply skipped
+		i, ok := byID[courierID]
+		if !ok {
+			continue
+		}
+
+		if place.ID, err = kernel.UUIDFromBytes(id[:]); err != nil {
+			return err
+		}
+		if orderID != nil {
+			orderUUID, idErr := kernel.UUIDFromBytes(orderID[:])
+			if idErr != nil {
+				return idErr
+			}
+			place.OrderID = &orderUUID
+		}
+		couriers[i].StoragePlaces = append(couriers[i].StoragePlaces, place)
+	}
+
+	return rows.Err()
+}
+
+// attachAbsences loads the absences that are not over at now in one query, appends them to the
+// absent couriers and lists the couriers absent at now with their substitutes.
+func (h GetAllCouriersQueryHandler) attachAbsences(
+	session *gorm.DB,
+	couriers []GetAllCouriersQueryResponse,
+	now time.Time,
+) error {
+	byID := make(map[uuid.UUID]int, len(couriers))
+	for i, c := range couriers {
+		byID[c.ID.Bytes()] = i
+	}
+
+	rows, err := session.Raw(`
+		SELECT
+			id,
+			courier_id,
+			starts_at,
+			ends_at,
+			substitute_id
+		FROM courier_absences
+		WHERE ends_at > ?
+		ORDER BY courier_id, starts_at
+	`, now).Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var absence CourierAbsence
+		var id, courierID, substituteID uuid.UUID
+
+		if err = rows.Scan(&id, &courierID, &absence.StartsAt, &absence.EndsAt, &substituteID); err != nil {
+			return err
+		}
+
+		// Couriers added between the reads are simply skipped
+		i, ok := byID[courierID]
+		if !ok {
+			continue
+		}
+
+		if absence.ID, err = kernel.UUIDFromBytes(id[:]); err != nil {
+			return err
+		}
+		if absence.SubstituteID, err = kernel.UUIDFromBytes(substituteID[:]); err != nil {
+			return err
+		}
+		couriers[i].Absences = append(couriers[i].Absences, absence)
+
+		if !absence.StartsAt.After(now) {
+			if j, found := byID[substituteID]; found {
+				couriers[j].SubstitutingFor = append(couriers[j].SubstitutingFor, couriers[i].ID)
+			}
+		}
+	}
+
+	return rows.Err()
+}
@@ -9,62 +9,41 @@ import (
 	"delivery/internal/core/application/usecases/queries"
 	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/pgtest"
 
 	"github.com/stretchr/testify/suite"
-	"github.com/testcontainers/testcontainers-go"
-	"github.com/testcontainers/testcontainers-go/modules/postgres"
-	"github.com/testcontainers/testcontainers-go/wait"
-	gorm_postgres "gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
 type GetAllCouriersQueryHandlerTestSuite struct {
 	suite.Suite
-	container *postgres.PostgresContainer
-	db        *gorm.DB
-	handler   queries.GetAllCouriersQueryHandler
+	template *pgtest.Template
+	db       *gorm.DB
+	handler  queries.GetAllCouriersQueryHandler
 }
 
 func (suite *GetAllCouriersQueryHandlerTestSuite) SetupSuite() {
-	ctx := context.Background()
-
-	container, err := postgres.Run(ctx,
-		"postgres:15-alpine",
-		postgres.WithDatabase("testdb"),
-		postgres.WithUsername("testuser"),
-		postgres.WithPassword("testpass"),
-		testcontainers.WithWaitStrategy(
-			wait.ForLog("database system is ready to accept connections").
-				WithOccurrence(2).
-				WithStartupTimeout(30*time.Second),
-		),
-	)
-	suite.Require().NoError(err)
-	suite.container = container
-
-	dsn, err := container.ConnectionString(ctx, "sslmode=disable")
-	suite.Require().NoError(err)
-
-	db, err := gorm.Open(gorm_postgres.Open(dsn), &gorm.Config{})
-	suite.Require().NoError(err)
-	suite.db = db
-
-	err = db.AutoMigrate(&courierrepo.CourierDTO{}, &courierrepo.StoragePlaceDTO{})
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&courierrepo.CourierDTO{}, &courierrepo.StoragePlaceDTO{}, &courierrepo.MaintenanceWindowDTO{},
+			&courierrepo.AbsenceDTO{},
+			&courierrepo.ScheduleWindowDTO{},
+		)
+	})
 	suite.Require().NoError(err)
-
-	suite.handler = queries.NewGetAllCouriersQueryHandler(db)
+	suite.template = template
 }
 
 func (suite *GetAllCouriersQueryHandlerTestSuite) TearDownSuite() {
-	if suite.container != nil {
-		err := suite.container.Terminate(context.Background())
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
 		suite.Require().NoError(err)
 	}
 }
 
 func (suite *GetAllCouriersQueryHandlerTestSuite) SetupTest() {
-	err := suite.db.Exec("TRUNCATE TABLE couriers CASCADE").Error
-	suite.Require().NoError(err)
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetAllCouriersQueryHandler(suite.db)
 }
 
 func (suite *GetAllCouriersQueryHandlerTestSuite) TestHandle_EmptyDatabase_ReturnsEmptySlice() {
@@ -107,6 +86,90 @@ func (suite *GetAllCouriersQueryHandlerTestSuite) TestHandle_WithCouriers_Return
 	suite.True(isEqual)
 }
 
+func (suite *GetAllCouriersQueryHandlerTestSuite) TestHandle_WithStoragePlaces_ReturnsPlacesOrderedByName() {
+	location, _ := kernel.NewLocation(7, 2)
+	bob, _ := courier.NewCourier(kernel.NewUUID(), "Bob", 5, location)
+	suite.Require().NoError(bob.AddStoragePlace("Side Bag", 10))
+	suite.Require().NoError(bob.AddStoragePlace("Large Box", 25))
+	suite.saveCouriers([]*courier.Courier{bob})
+
+	result, err := suite.handler.Handle(context.Background(), queries.NewGetAllCouriersQuery())
+
+	suite.Require().NoError(err)
+	suite.Require().Len(result, 1)
+	places := result[0].StoragePlaces
+	suite.Require().Len(places, len(bob.StoragePlaces()))
+	for i := 1; i < len(places); i++ {
+		suite.LessOrEqual(places[i-1].Name, places[i].Name)
+	}
+	for _, place := range places {
+		suite.Nil(place.OrderID)
+		suite.False(place.OutOfService)
+		if place.Name == "Large Box" {
+			suite.Equal(25, place.TotalVolume)
+		}
+	}
+}
+
+func (suite *GetAllCouriersQueryHandlerTestSuite) TestHandle_WithExternalID_ReturnsMapping() {
+	location, _ := kernel.NewLocation(7, 2)
+	alice, _ := courier.NewCourier(kernel.NewUUID(), "Alice", 3, location)
+	externalID, err := courier.NewExternalID("HR-004217")
+	suite.Require().NoError(err)
+	suite.Require().NoError(alice.LinkExternalID(externalID))
+	bob, _ := courier.NewCourier(kernel.NewUUID(), "Bob", 5, location)
+	suite.saveCouriers([]*courier.Courier{alice, bob})
+
+	result, err := suite.handler.Handle(context.Background(), queries.NewGetAllCouriersQuery())
+
+	suite.Require().NoError(err)
+	suite.Require().Len(result, 2)
+	suite.Require().NotNil(result[0].ExternalID)
+	suite.Equal("HR-004217", *result[0].ExternalID)
+	suite.Nil(result[1].ExternalID)
+}
+
+func (suite *GetAllCouriersQueryHandlerTestSuite) TestHandle_WithInsuredCourier_ReturnsInsurance() {
+	location, _ := kernel.NewLocation(7, 2)
+	alice, _ := courier.NewCourier(kernel.NewUUID(), "Alice", 3, location)
+	alice.Insure()
+	bob, _ := courier.NewCourier(kernel.NewUUID(), "Bob", 5, location)
+	suite.saveCouriers([]*courier.Courier{alice, bob})
+
+	result, err := suite.handler.Handle(context.Background(), queries.NewGetAllCouriersQuery())
+
+	suite.Require().NoError(err)
+	suite.Require().Len(result, 2)
+	suite.True(result[0].Insured)
+	suite.False(result[1].Insured)
+}
+
+func (suite *GetAllCouriersQueryHandlerTestSuite) TestHandle_WithAbsences_ReturnsAbsencesAndSubstitutes() {
+	location, _ := kernel.NewLocation(7, 2)
+	alice, _ := courier.NewCourier(kernel.NewUUID(), "Alice", 3, location)
+	bob, _ := courier.NewCourier(kernel.NewUUID(), "Bob", 5, location)
+	now := time.Now().UTC().Truncate(time.Second)
+	current, err := courier.NewAbsence(kernel.NewUUID(), now.Add(-time.Hour), now.Add(time.Hour), bob.ID())
+	suite.Require().NoError(err)
+	upcoming, err := courier.NewAbsence(kernel.NewUUID(), now.Add(24*time.Hour), now.Add(48*time.Hour), bob.ID())
+	suite.Require().NoError(err)
+	suite.Require().NoError(alice.PlanAbsence(current, now))
+	suite.Require().NoError(alice.PlanAbsence(upcoming, now))
+	suite.saveCouriers([]*courier.Courier{alice, bob})
+
+	result, err := suite.handler.Handle(context.Background(), queries.NewGetAllCouriersQuery())
+
+	suite.Require().NoError(err)
+	suite.Require().Len(result, 2)
+	suite.Require().Len(result[0].Absences, 2)
+	suite.Equal(current.ID(), result[0].Absences[0].ID)
+	suite.Equal(bob.ID(), result[0].Absences[0].SubstituteID)
+	suite.Equal(upcoming.ID(), result[0].Absences[1].ID)
+	suite.Empty(result[0].SubstitutingFor)
+	suite.Empty(result[1].Absences)
+	suite.Equal([]kernel.UUID{alice.ID()}, result[1].SubstitutingFor)
+}
+
 func (suite *GetAllCouriersQueryHandlerTestSuite) TestHandle_InvalidQuery_ReturnsError() {
 	invalidQuery := queries.GetAllCouriersQuery{}
 
@@ -227,6 +290,7 @@ func (suite *GetAllCouriersQueryHandlerTestSuite) createAndSaveLargeCourierSet()
 }
 
 func TestGetAllCouriersQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
 	suite.Run(t, new(GetAllCouriersQueryHandlerTestSuite))
 }
 
@@ -0,0 +1,70 @@
+package queries
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/announcement"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+// AnnouncementsHistoryLimit is the number of most recent announcements the history returns.
+const AnnouncementsHistoryLimit = 50
+
+var (
+	ErrGetAnnouncementsQueryIsNotConstructed = errors.New(
+		"GetAnnouncementsQuery must be created via NewGetAnnouncementsQuery constructor",
+	)
+)
+
+// GetAnnouncementsQuery retrieves the history of dispatch announcements with the state of
+// their delivery to every recipient, newest first.
+//
+// Example:
+//
+//	query := NewGetAnnouncementsQuery()
+//	handler := NewGetAnnouncementsQueryHandler(db)
+//
+//	history, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return fmt.Errorf("failed to get announcements: %w", err)
+//	}
+//	for _, a := range history {
+//	    fmt.Printf("%s: %d of %d delivered\n", a.Text, a.Delivered, len(a.Deliveries))
+//	}
+type GetAnnouncementsQuery struct {
+	guard guard.ConstructorGuard
+}
+
+// NewGetAnnouncementsQuery creates a query for the announcements history.
+func NewGetAnnouncementsQuery() GetAnnouncementsQuery {
+	return GetAnnouncementsQuery{guard: guard.NewConstructorGuard()}
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetAnnouncementsQueryIsNotConstructed if validation fails.
+func (q GetAnnouncementsQuery) Validate() error {
+	return q.guard.Validate(ErrGetAnnouncementsQueryIsNotConstructed)
+}
+
+// GetAnnouncementsQueryResponse is an announcement in the history.
+// Zone is nil for announcements addressed to every courier on shift.
+type GetAnnouncementsQueryResponse struct {
+	ID         kernel.UUID
+	Text       string
+	Zone       *kernel.Zone
+	PostedAt   time.Time
+	Delivered  int
+	Failed     int
+	Pending    int
+	Deliveries []AnnouncementDelivery
+}
+
+// AnnouncementDelivery is the delivery state of an announcement for one recipient.
+type AnnouncementDelivery struct {
+	CourierID kernel.UUID
+	Status    announcement.DeliveryStatus
+	ChangedAt time.Time
+	Failure   string
+}
@@ -0,0 +1,167 @@
+package queries
+
+import (
+	"context"
+	"database/sql"
+
+	"delivery/internal/core/domain/model/announcement"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetAnnouncementsQueryHandler retrieves the announcements history from the database.
+//
+// Example:
+//
+//	handler := NewGetAnnouncementsQueryHandler(db)
+//	history, err := handler.Handle(ctx, NewGetAnnouncementsQuery())
+//	if err != nil {
+//	    return err
+//	}
+type GetAnnouncementsQueryHandler struct {
+	db *gorm.DB
+}
+
+// NewGetAnnouncementsQueryHandler creates a handler for announcements history queries.
+// Requires a GORM database connection for query execution.
+func NewGetAnnouncementsQueryHandler(db *gorm.DB) GetAnnouncementsQueryHandler {
+	return GetAnnouncementsQueryHandler{db: db}
+}
+
+// Handle executes the query to retrieve the AnnouncementsHistoryLimit most recent announcements.
+// Returns an empty slice if nothing was announced.
+func (h GetAnnouncementsQueryHandler) Handle(
+	ctx context.Context,
+	query GetAnnouncementsQuery,
+) ([]GetAnnouncementsQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetAnnouncementsQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	history := make([]GetAnnouncementsQueryResponse, 0)
+	positions := make(map[uuid.UUID]int)
+
+	rows, err := session.Raw(`
+		SELECT id, text, zone_from_x, zone_from_y, zone_to_x, zone_to_y, posted_at
+		FROM announcements
+		ORDER BY posted_at DESC, id
+		LIMIT ?
+	`, AnnouncementsHistoryLimit).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			response               GetAnnouncementsQueryResponse
+			id                     uuid.UUID
+			fromX, fromY, toX, toY sql.NullInt16
+		)
+
+		if err = rows.Scan(&id, &response.Text, &fromX, &fromY, &toX, &toY, &response.PostedAt); err != nil {
+			return nil, err
+		}
+
+		if response.ID, err = kernel.UUIDFromBytes(id[:]); err != nil {
+			return nil, err
+		}
+
+		if fromX.Valid && fromY.Valid && toX.Valid && toY.Valid {
+			zone, zoneErr := restoreZone(fromX.Int16, fromY.Int16, toX.Int16, toY.Int16)
+			if zoneErr != nil {
+				return nil, zoneErr
+			}
+			response.Zone = &zone
+		}
+
+		response.Deliveries = make([]AnnouncementDelivery, 0)
+		positions[id] = len(history)
+		history = append(history, response)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(history) == 0 {
+		return history, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(positions))
+	for id := range positions {
+		ids = append(ids, id)
+	}
+
+	deliveryRows, err := session.Raw(`
+		SELECT announcement_id, courier_id, status, changed_at, failure
+		FROM announcement_deliveries
+		WHERE announcement_id IN ?
+		ORDER BY announcement_id, position
+	`, ids).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer deliveryRows.Close()
+
+	for deliveryRows.Next() {
+		var (
+			delivery                AnnouncementDelivery
+			announcementID, courier uuid.UUID
+			status                  int
+		)
+
+		if err = deliveryRows.Scan(&announcementID, &courier, &status, &delivery.ChangedAt, &delivery.Failure); err != nil {
+			return nil, err
+		}
+
+		if delivery.CourierID, err = kernel.UUIDFromBytes(courier[:]); err != nil {
+			return nil, err
+		}
+		delivery.Status = announcement.DeliveryStatus(status)
+
+		response := &history[positions[announcementID]]
+		response.Deliveries = append(response.Deliveries, delivery)
+		switch delivery.Status { //nolint:exhaustive // deliveries are never stored with an unknown status
+		case announcement.Delivered:
+			response.Delivered++
+		case announcement.Failed:
+			response.Failed++
+		case announcement.Pending:
+			response.Pending++
+		}
+	}
+
+	if err = deliveryRows.Err(); err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// restoreZone rebuilds a zone from the coordinates of its corners.
+func restoreZone(fromX, fromY, toX, toY int16) (kernel.Zone, error) {
+	from, err := kernel.NewLocation(kernel.Coordinate(fromX), kernel.Coordinate(fromY))
+	if err != nil {
+		return kernel.Zone{}, err
+	}
+
+	to, err := kernel.NewLocation(kernel.Coordinate(toX), kernel.Coordinate(toY))
+	if err != nil {
+		return kernel.Zone{}, err
+	}
+
+	return kernel.NewZone(from, to)
+}
@@ -0,0 +1,130 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/announcement"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetAnnouncementsQueryHandlerTestSuite struct {
+	suite.Suite
+	template      *pgtest.Template
+	db            *gorm.DB
+	handler       queries.GetAnnouncementsQueryHandler
+	courierRepo   *courierrepo.GormCourierRepository
+	announcements *postgres_adapter.GormAnnouncementRepository
+}
+
+func (suite *GetAnnouncementsQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&courierrepo.CourierDTO{},
+			&courierrepo.StoragePlaceDTO{},
+			&courierrepo.MaintenanceWindowDTO{},
+			&courierrepo.AbsenceDTO{},
+			&courierrepo.ScheduleWindowDTO{},
+			&postgres_adapter.AnnouncementDTO{},
+			&postgres_adapter.AnnouncementDeliveryDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetAnnouncementsQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetAnnouncementsQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetAnnouncementsQueryHandler(suite.db)
+	suite.courierRepo = courierrepo.NewGormCourierRepository(suite.db, &mockAggregateTracker{})
+	suite.announcements = postgres_adapter.NewGormAnnouncementRepository(suite.db)
+}
+
+func (suite *GetAnnouncementsQueryHandlerTestSuite) TestHandle_ReturnsDeliveriesNewestFirst() {
+	ctx := context.Background()
+	postedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	delivered, failed := suite.createCourier(), suite.createCourier()
+
+	from, err := kernel.NewLocation(1, 1)
+	suite.Require().NoError(err)
+	to, err := kernel.NewLocation(3, 3)
+	suite.Require().NoError(err)
+	zone, err := kernel.NewZone(from, to)
+	suite.Require().NoError(err)
+
+	zoned, err := announcement.NewAnnouncement(kernel.NewUUID(), "Surge pricing active", &zone, postedAt)
+	suite.Require().NoError(err)
+	suite.Require().NoError(zoned.AddRecipient(delivered.ID()))
+	suite.Require().NoError(zoned.AddRecipient(failed.ID()))
+	suite.Require().NoError(suite.announcements.Add(ctx, zoned))
+	suite.Require().NoError(zoned.MarkDelivered(delivered.ID(), postedAt.Add(time.Second)))
+	suite.Require().NoError(zoned.MarkFailed(failed.ID(), postedAt.Add(time.Second), "push rejected"))
+	suite.Require().NoError(suite.announcements.Update(ctx, zoned))
+
+	older, err := announcement.NewAnnouncement(kernel.NewUUID(), "Rain expected", nil, postedAt.Add(-time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.announcements.Add(ctx, older))
+
+	history, err := suite.handler.Handle(ctx, queries.NewGetAnnouncementsQuery())
+
+	suite.Require().NoError(err)
+	suite.Require().Len(history, 2)
+
+	latest := history[0]
+	suite.Equal(zoned.ID(), latest.ID)
+	suite.Equal("Surge pricing active", latest.Text)
+	suite.Require().NotNil(latest.Zone)
+	suite.Equal(zone.String(), latest.Zone.String())
+	suite.True(postedAt.Equal(latest.PostedAt))
+	suite.Equal(1, latest.Delivered)
+	suite.Equal(1, latest.Failed)
+	suite.Zero(latest.Pending)
+	suite.Require().Len(latest.Deliveries, 2)
+	suite.Equal(delivered.ID(), latest.Deliveries[0].CourierID)
+	suite.Equal(announcement.Delivered, latest.Deliveries[0].Status)
+	suite.Equal(failed.ID(), latest.Deliveries[1].CourierID)
+	suite.Equal(announcement.Failed, latest.Deliveries[1].Status)
+	suite.Equal("push rejected", latest.Deliveries[1].Failure)
+
+	suite.Equal(older.ID(), history[1].ID)
+	suite.Nil(history[1].Zone)
+	suite.Empty(history[1].Deliveries)
+}
+
+func (suite *GetAnnouncementsQueryHandlerTestSuite) TestHandle_NoAnnouncements_ReturnsEmptySlice() {
+	history, err := suite.handler.Handle(context.Background(), queries.NewGetAnnouncementsQuery())
+
+	suite.Require().NoError(err)
+	suite.NotNil(history)
+	suite.Empty(history)
+}
+
+func (suite *GetAnnouncementsQueryHandlerTestSuite) createCourier() *courier.Courier {
+	location, err := kernel.NewLocation(2, 2)
+	suite.Require().NoError(err)
+	c, err := courier.NewCourier(kernel.NewUUID(), "Alice", 3, location)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.courierRepo.Add(context.Background(), c))
+	return c
+}
+
+func TestGetAnnouncementsQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetAnnouncementsQueryHandlerTestSuite))
+}
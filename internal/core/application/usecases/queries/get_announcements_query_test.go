@@ -0,0 +1,21 @@
+package queries_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/queries"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetAnnouncementsQuery_Valid(t *testing.T) {
+	query := queries.NewGetAnnouncementsQuery()
+
+	require.NoError(t, query.Validate())
+}
+
+func TestGetAnnouncementsQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetAnnouncementsQuery{}
+
+	require.ErrorIs(t, query.Validate(), queries.ErrGetAnnouncementsQueryIsNotConstructed)
+}
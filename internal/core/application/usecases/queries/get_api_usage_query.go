@@ -0,0 +1,95 @@
+package queries
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/usage"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrGetAPIUsageQueryIsNotConstructed = errors.New(
+		"GetAPIUsageQuery must be created via NewGetAPIUsageQuery constructor",
+	)
+)
+
+// GetAPIUsageQuery retrieves the API usage of partner clients in a month along with their quota,
+// either of every client for billing or of a single client checking its own consumption.
+//
+// Example:
+//
+//	query, err := NewGetAPIUsageQuery(usage.PeriodOf(time.Now()))
+//	if err != nil {
+//	    return fmt.Errorf("invalid query: %w", err)
+//	}
+//
+//	clients, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return fmt.Errorf("failed to get API usage: %w", err)
+//	}
+//	for _, c := range clients {
+//	    fmt.Printf("%s: %d orders created\n", c.Client, c.OrdersCreated.Used)
+//	}
+type GetAPIUsageQuery struct {
+	period usage.Period
+	client *usage.Client
+
+	guard guard.ConstructorGuard
+}
+
+// NewGetAPIUsageQuery creates a query for the usage of every client in the period.
+// Returns an error if the period is invalid.
+func NewGetAPIUsageQuery(period usage.Period) (GetAPIUsageQuery, error) {
+	if err := errs.JoinFields(errs.Field("period", period.Validate())); err != nil {
+		return GetAPIUsageQuery{}, err
+	}
+
+	return GetAPIUsageQuery{period: period, guard: guard.NewConstructorGuard()}, nil
+}
+
+// NewGetClientAPIUsageQuery creates a query for the usage of a single client in the period.
+// Returns an error if the client or period is invalid.
+func NewGetClientAPIUsageQuery(client usage.Client, period usage.Period) (GetAPIUsageQuery, error) {
+	if err := errs.JoinFields(
+		errs.Field("client", client.Validate()),
+		errs.Field("period", period.Validate()),
+	); err != nil {
+		return GetAPIUsageQuery{}, err
+	}
+
+	return GetAPIUsageQuery{period: period, client: &client, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the query was created through a constructor.
+// Returns ErrGetAPIUsageQueryIsNotConstructed if validation fails.
+func (q GetAPIUsageQuery) Validate() error {
+	return q.guard.Validate(ErrGetAPIUsageQueryIsNotConstructed)
+}
+
+// Period returns the month whose usage is retrieved.
+func (q GetAPIUsageQuery) Period() usage.Period {
+	return q.period
+}
+
+// Client returns the client whose usage is retrieved, or nil for every client.
+func (q GetAPIUsageQuery) Client() *usage.Client {
+	return q.client
+}
+
+// GetAPIUsageQueryResponse is the usage of one client in the month and when its quota resets.
+type GetAPIUsageQueryResponse struct {
+	Client           string
+	Period           string
+	ResetsAt         time.Time
+	Requests         APIUsageMetric
+	OrdersCreated    APIUsageMetric
+	WebhooksConsumed APIUsageMetric
+}
+
+// APIUsageMetric is the usage of one metric and its monthly limit; a zero limit means unlimited.
+type APIUsageMetric struct {
+	Used  int
+	Limit int
+}
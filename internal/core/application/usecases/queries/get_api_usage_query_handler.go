@@ -0,0 +1,100 @@
+package queries
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/usage"
+	"delivery/internal/pkg/tracing"
+
+	"gorm.io/gorm"
+)
+
+// GetAPIUsageQueryHandler retrieves the API usage of partner clients from the database
+// and pairs it with the configured quota.
+//
+// Example:
+//
+//	quota, _ := usage.NewQuota(100_000, 5_000, 0)
+//	handler := NewGetAPIUsageQueryHandler(db, quota)
+//	clients, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return err
+//	}
+type GetAPIUsageQueryHandler struct {
+	db    *gorm.DB
+	quota usage.Quota
+}
+
+// NewGetAPIUsageQueryHandler creates a handler for API usage queries reporting limits from quota.
+// Requires a GORM database connection for query execution.
+func NewGetAPIUsageQueryHandler(db *gorm.DB, quota usage.Quota) GetAPIUsageQueryHandler {
+	return GetAPIUsageQueryHandler{db: db, quota: quota}
+}
+
+// Handle executes the query to retrieve the usage, busiest client first.
+// A single client without usage in the period gets a response of zeros; a query for every
+// client returns only those that used the API.
+func (h GetAPIUsageQueryHandler) Handle(
+	ctx context.Context,
+	query GetAPIUsageQuery,
+) ([]GetAPIUsageQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetAPIUsageQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	statement := session.Table("api_usage").
+		Select("client, requests, orders_created, webhooks_consumed").
+		Where("period = ?", query.Period().Start())
+	if query.Client() != nil {
+		statement = statement.Where("client = ?", query.Client().Fingerprint())
+	}
+
+	rows, err := statement.Order("requests DESC, client").Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	clients := make([]GetAPIUsageQueryResponse, 0)
+	for rows.Next() {
+		c := h.newResponse(query.Period())
+		if err = rows.Scan(
+			&c.Client, &c.Requests.Used, &c.OrdersCreated.Used, &c.WebhooksConsumed.Used,
+		); err != nil {
+			return nil, err
+		}
+		clients = append(clients, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(clients) == 0 && query.Client() != nil {
+		c := h.newResponse(query.Period())
+		c.Client = query.Client().Fingerprint()
+		clients = append(clients, c)
+	}
+
+	return clients, nil
+}
+
+// newResponse returns a response for the period without usage yet.
+func (h GetAPIUsageQueryHandler) newResponse(period usage.Period) GetAPIUsageQueryResponse {
+	return GetAPIUsageQueryResponse{
+		Period:           period.String(),
+		ResetsAt:         period.End(),
+		Requests:         APIUsageMetric{Limit: h.quota.Limit(usage.Requests)},
+		OrdersCreated:    APIUsageMetric{Limit: h.quota.Limit(usage.OrdersCreated)},
+		WebhooksConsumed: APIUsageMetric{Limit: h.quota.Limit(usage.WebhooksConsumed)},
+	}
+}
@@ -0,0 +1,120 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/usage"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetAPIUsageQueryHandlerTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	db       *gorm.DB
+	handler  queries.GetAPIUsageQueryHandler
+	ledger   *postgres_adapter.GormUsageLedger
+}
+
+func (suite *GetAPIUsageQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(&postgres_adapter.APIUsageDTO{})
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetAPIUsageQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetAPIUsageQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	quota, err := usage.NewQuota(1000, 50, 0)
+	suite.Require().NoError(err)
+	suite.handler = queries.NewGetAPIUsageQueryHandler(suite.db, quota)
+	suite.ledger = postgres_adapter.NewGormUsageLedger(suite.db)
+}
+
+func (suite *GetAPIUsageQueryHandlerTestSuite) TestHandle_ReturnsUsageOfEveryClientBusiestFirst() {
+	ctx := context.Background()
+	march := usage.PeriodOf(time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC))
+	april := usage.PeriodOf(time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC))
+	quiet, busy := suite.client("quiet"), suite.client("busy")
+
+	suite.Require().NoError(suite.ledger.Add(ctx, quiet, march, usage.Requests, 3))
+	suite.Require().NoError(suite.ledger.Add(ctx, busy, march, usage.Requests, 10))
+	suite.Require().NoError(suite.ledger.Add(ctx, busy, march, usage.Requests, 5))
+	suite.Require().NoError(suite.ledger.Add(ctx, busy, march, usage.OrdersCreated, 4))
+	suite.Require().NoError(suite.ledger.Add(ctx, busy, march, usage.WebhooksConsumed, 2))
+	suite.Require().NoError(suite.ledger.Add(ctx, quiet, april, usage.Requests, 100))
+
+	query, err := queries.NewGetAPIUsageQuery(march)
+	suite.Require().NoError(err)
+	clients, err := suite.handler.Handle(ctx, query)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(clients, 2)
+	suite.Equal(busy.Fingerprint(), clients[0].Client)
+	suite.Equal("2025-03", clients[0].Period)
+	suite.Equal(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), clients[0].ResetsAt)
+	suite.Equal(queries.APIUsageMetric{Used: 15, Limit: 1000}, clients[0].Requests)
+	suite.Equal(queries.APIUsageMetric{Used: 4, Limit: 50}, clients[0].OrdersCreated)
+	suite.Equal(queries.APIUsageMetric{Used: 2, Limit: 0}, clients[0].WebhooksConsumed)
+	suite.Equal(quiet.Fingerprint(), clients[1].Client)
+	suite.Equal(3, clients[1].Requests.Used)
+
+	used, err := suite.ledger.Used(ctx, busy, march, usage.OrdersCreated)
+	suite.Require().NoError(err)
+	suite.Equal(4, used)
+}
+
+func (suite *GetAPIUsageQueryHandlerTestSuite) TestHandle_SingleClient() {
+	ctx := context.Background()
+	period := usage.PeriodOf(time.Now())
+	partner, other := suite.client("partner"), suite.client("other")
+	suite.Require().NoError(suite.ledger.Add(ctx, partner, period, usage.OrdersCreated, 7))
+	suite.Require().NoError(suite.ledger.Add(ctx, other, period, usage.OrdersCreated, 9))
+
+	query, err := queries.NewGetClientAPIUsageQuery(partner, period)
+	suite.Require().NoError(err)
+	clients, err := suite.handler.Handle(ctx, query)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(clients, 1)
+	suite.Equal(partner.Fingerprint(), clients[0].Client)
+	suite.Equal(7, clients[0].OrdersCreated.Used)
+}
+
+func (suite *GetAPIUsageQueryHandlerTestSuite) TestHandle_SingleClientWithoutUsage_ReturnsZeros() {
+	newcomer := suite.client("newcomer")
+	query, err := queries.NewGetClientAPIUsageQuery(newcomer, usage.PeriodOf(time.Now()))
+	suite.Require().NoError(err)
+
+	clients, err := suite.handler.Handle(context.Background(), query)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(clients, 1)
+	suite.Equal(newcomer.Fingerprint(), clients[0].Client)
+	suite.Equal(queries.APIUsageMetric{Used: 0, Limit: 1000}, clients[0].Requests)
+}
+
+func (suite *GetAPIUsageQueryHandlerTestSuite) client(apiKey string) usage.Client {
+	client, err := usage.NewClient(apiKey)
+	suite.Require().NoError(err)
+	return client
+}
+
+func TestGetAPIUsageQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetAPIUsageQueryHandlerTestSuite))
+}
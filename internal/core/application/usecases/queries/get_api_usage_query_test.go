@@ -0,0 +1,47 @@
+package queries_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/usage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetAPIUsageQuery_Valid(t *testing.T) {
+	period := usage.PeriodOf(time.Now())
+
+	query, err := queries.NewGetAPIUsageQuery(period)
+
+	require.NoError(t, err)
+	require.NoError(t, query.Validate())
+	assert.Equal(t, period, query.Period())
+	assert.Nil(t, query.Client())
+}
+
+func TestNewGetClientAPIUsageQuery_Valid(t *testing.T) {
+	client, _ := usage.NewClient("partner-secret")
+
+	query, err := queries.NewGetClientAPIUsageQuery(client, usage.PeriodOf(time.Now()))
+
+	require.NoError(t, err)
+	require.NotNil(t, query.Client())
+	assert.Equal(t, client, *query.Client())
+}
+
+func TestNewGetAPIUsageQuery_InvalidInput(t *testing.T) {
+	_, err := queries.NewGetAPIUsageQuery(usage.Period{})
+	require.ErrorIs(t, err, usage.ErrPeriodIsNotConstructed)
+
+	_, err = queries.NewGetClientAPIUsageQuery(usage.Client{}, usage.PeriodOf(time.Now()))
+	require.ErrorIs(t, err, usage.ErrClientIsNotConstructed)
+}
+
+func TestGetAPIUsageQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetAPIUsageQuery{}
+
+	require.ErrorIs(t, query.Validate(), queries.ErrGetAPIUsageQueryIsNotConstructed)
+}
@@ -0,0 +1,89 @@
+package queries
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrGetAssignmentExplanationQueryIsNotConstructed = errors.New(
+		"GetAssignmentExplanationQuery must be created via NewGetAssignmentExplanationQuery constructor",
+	)
+)
+
+// GetAssignmentExplanationQuery retrieves why the courier of an order was selected:
+// the score breakdown of every courier the dispatcher considered for the latest assignment.
+//
+// Example:
+//
+//	query, err := NewGetAssignmentExplanationQuery(orderID)
+//	if err != nil {
+//	    return fmt.Errorf("invalid order id: %w", err)
+//	}
+//
+//	explanation, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return fmt.Errorf("failed to retrieve explanation: %w", err)
+//	}
+//
+//	for _, candidate := range explanation.Candidates {
+//	    fmt.Printf("%s scored %.2f\n", candidate.CourierID, candidate.Score)
+//	}
+type GetAssignmentExplanationQuery struct {
+	orderID kernel.UUID
+
+	guard guard.ConstructorGuard
+}
+
+// NewGetAssignmentExplanationQuery creates a query for the assignment explanation of the given order.
+// Returns an error if the order ID is invalid.
+func NewGetAssignmentExplanationQuery(orderID kernel.UUID) (GetAssignmentExplanationQuery, error) {
+	if err := orderID.Validate(); err != nil {
+		return GetAssignmentExplanationQuery{}, err
+	}
+
+	return GetAssignmentExplanationQuery{orderID: orderID, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetAssignmentExplanationQueryIsNotConstructed if validation fails.
+func (q GetAssignmentExplanationQuery) Validate() error {
+	return q.guard.Validate(ErrGetAssignmentExplanationQueryIsNotConstructed)
+}
+
+// OrderID returns the ID of the order whose assignment is explained.
+func (q GetAssignmentExplanationQuery) OrderID() kernel.UUID {
+	return q.orderID
+}
+
+// GetAssignmentExplanationQueryResponse represents the explanation of the latest courier
+// assignment of an order in the read model.
+// Candidates are listed in the order the dispatcher considered them; the selected courier
+// has the lowest score.
+type GetAssignmentExplanationQueryResponse struct {
+	OrderID     kernel.UUID
+	CourierID   kernel.UUID
+	Strategy    string
+	Score       float64
+	ExplainedAt time.Time
+	Candidates  []AssignmentCandidate
+}
+
+// AssignmentCandidate represents a courier that was able to take the order.
+type AssignmentCandidate struct {
+	CourierID kernel.UUID
+	Score     float64
+	Selected  bool
+	Factors   []AssignmentScoreFactor
+}
+
+// AssignmentScoreFactor represents one weighted component of a candidate's score.
+type AssignmentScoreFactor struct {
+	Name         string
+	Value        float64
+	Weight       float64
+	Contribution float64
+}
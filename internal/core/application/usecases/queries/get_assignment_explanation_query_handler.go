@@ -0,0 +1,126 @@
+package queries
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetAssignmentExplanationQueryHandler retrieves recorded assignment explanations from the database.
+//
+// Example:
+//
+//	handler := NewGetAssignmentExplanationQueryHandler(db)
+//	query, _ := NewGetAssignmentExplanationQuery(orderID)
+//
+//	explanation, err := handler.Handle(ctx, query)
+//	if errors.Is(err, errs.ErrObjectNotFound) {
+//	    // The order was never assigned
+//	}
+type GetAssignmentExplanationQueryHandler struct {
+	db *gorm.DB
+}
+
+// NewGetAssignmentExplanationQueryHandler creates a handler for assignment explanation queries.
+// Requires a GORM database connection for query execution.
+func NewGetAssignmentExplanationQueryHandler(db *gorm.DB) GetAssignmentExplanationQueryHandler {
+	return GetAssignmentExplanationQueryHandler{db: db}
+}
+
+// Handle executes the query to retrieve the explanation of the latest assignment of an order.
+// Returns an ObjectNotFoundError if no assignment of the order was explained.
+func (h GetAssignmentExplanationQueryHandler) Handle(
+	ctx context.Context,
+	query GetAssignmentExplanationQuery,
+) (GetAssignmentExplanationQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetAssignmentExplanationQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return GetAssignmentExplanationQueryResponse{}, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return GetAssignmentExplanationQueryResponse{}, err
+	}
+	defer release()
+
+	var explanationID, courierID uuid.UUID
+	explanation := GetAssignmentExplanationQueryResponse{
+		OrderID:    query.OrderID(),
+		Candidates: make([]AssignmentCandidate, 0),
+	}
+	err = session.Raw(`
+		SELECT id, courier_id, strategy, score, explained_at
+		FROM assignment_explanations
+		WHERE order_id = ?
+		ORDER BY explained_at DESC, id
+		LIMIT 1
+	`, query.OrderID().Bytes()).Row().
+		Scan(&explanationID, &courierID, &explanation.Strategy, &explanation.Score, &explanation.ExplainedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return GetAssignmentExplanationQueryResponse{}, errs.NewObjectNotFoundError(
+				"assignment explanation", query.OrderID().String(),
+			)
+		}
+		return GetAssignmentExplanationQueryResponse{}, err
+	}
+
+	if explanation.CourierID, err = kernel.UUIDFromBytes(courierID[:]); err != nil {
+		return GetAssignmentExplanationQueryResponse{}, err
+	}
+
+	rows, err := session.Raw(`
+		SELECT courier_id, name, value, weight
+		FROM assignment_score_factors
+		WHERE explanation_id = ?
+		ORDER BY position
+	`, explanationID).Rows()
+	if err != nil {
+		return GetAssignmentExplanationQueryResponse{}, err
+	}
+	defer rows.Close()
+
+	// Factors of one candidate are stored next to each other
+	for rows.Next() {
+		var candidateID uuid.UUID
+		var factor AssignmentScoreFactor
+
+		if err = rows.Scan(&candidateID, &factor.Name, &factor.Value, &factor.Weight); err != nil {
+			return GetAssignmentExplanationQueryResponse{}, err
+		}
+		factor.Contribution = factor.Value * factor.Weight
+
+		last := len(explanation.Candidates) - 1
+		if last < 0 || explanation.Candidates[last].CourierID.Bytes() != candidateID {
+			id, idErr := kernel.UUIDFromBytes(candidateID[:])
+			if idErr != nil {
+				return GetAssignmentExplanationQueryResponse{}, idErr
+			}
+			explanation.Candidates = append(explanation.Candidates, AssignmentCandidate{
+				CourierID: id,
+				Selected:  id.IsEqual(explanation.CourierID),
+			})
+			last++
+		}
+
+		candidate := &explanation.Candidates[last]
+		candidate.Factors = append(candidate.Factors, factor)
+		candidate.Score += factor.Contribution
+	}
+
+	if err = rows.Err(); err != nil {
+		return GetAssignmentExplanationQueryResponse{}, err
+	}
+
+	return explanation, nil
+}
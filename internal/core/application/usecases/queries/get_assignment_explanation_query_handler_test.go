@@ -0,0 +1,131 @@
+package queries_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetAssignmentExplanationQueryHandlerTestSuite struct {
+	suite.Suite
+	template  *pgtest.Template
+	db        *gorm.DB
+	handler   queries.GetAssignmentExplanationQueryHandler
+	orderRepo *orderrepo.GormOrderRepository
+	recorder  *postgres_adapter.GormAssignmentExplanationRecorder
+}
+
+func (suite *GetAssignmentExplanationQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&orderrepo.OrderDTO{},
+			&orderrepo.OrderMessageDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{},
+			&orderrepo.OrderItemDTO{},
+			&postgres_adapter.AssignmentExplanationDTO{},
+			&postgres_adapter.AssignmentScoreFactorDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetAssignmentExplanationQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetAssignmentExplanationQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetAssignmentExplanationQueryHandler(suite.db)
+	suite.orderRepo = orderrepo.NewGormOrderRepository(suite.db, &mockAggregateTracker{})
+	suite.recorder = postgres_adapter.NewGormAssignmentExplanationRecorder(suite.db, slog.Default())
+}
+
+func (suite *GetAssignmentExplanationQueryHandlerTestSuite) TestHandle_RecordedAssignment_ReturnsCandidates() {
+	ctx := context.Background()
+	o := suite.createOrder()
+	suite.Require().NoError(suite.orderRepo.Add(ctx, o))
+	near, far := kernel.NewUUID(), kernel.NewUUID()
+
+	suite.recorder.AssignmentCommitted(ctx, commands.DispatchAssignment{
+		Order: o,
+		Explanation: services.AssignmentExplanation{
+			Strategy:  "best-fit",
+			CourierID: far,
+			Score:     3.5,
+			Candidates: []services.CandidateScore{
+				{CourierID: near, Breakdown: services.ScoreBreakdown{Factors: []services.ScoreFactor{
+					{Name: services.TravelTimeFactor, Value: 1, Weight: 1},
+					{Name: services.StorageWasteFactor, Value: 80, Weight: 0.1},
+				}}},
+				{CourierID: far, Breakdown: services.ScoreBreakdown{Factors: []services.ScoreFactor{
+					{Name: services.TravelTimeFactor, Value: 3, Weight: 1},
+					{Name: services.StorageWasteFactor, Value: 5, Weight: 0.1},
+				}}},
+			},
+		},
+	})
+
+	query, err := queries.NewGetAssignmentExplanationQuery(o.ID())
+	suite.Require().NoError(err)
+
+	result, err := suite.handler.Handle(ctx, query)
+
+	suite.Require().NoError(err)
+	suite.Equal(o.ID(), result.OrderID)
+	suite.Equal(far, result.CourierID)
+	suite.Equal("best-fit", result.Strategy)
+	suite.InDelta(3.5, result.Score, 0.0001)
+	suite.Require().Len(result.Candidates, 2)
+	suite.Equal(near, result.Candidates[0].CourierID)
+	suite.False(result.Candidates[0].Selected)
+	suite.InDelta(9.0, result.Candidates[0].Score, 0.0001)
+	suite.Require().Len(result.Candidates[0].Factors, 2)
+	suite.Equal(services.StorageWasteFactor, result.Candidates[0].Factors[1].Name)
+	suite.InDelta(8.0, result.Candidates[0].Factors[1].Contribution, 0.0001)
+	suite.Equal(far, result.Candidates[1].CourierID)
+	suite.True(result.Candidates[1].Selected)
+	suite.InDelta(3.5, result.Candidates[1].Score, 0.0001)
+}
+
+func (suite *GetAssignmentExplanationQueryHandlerTestSuite) TestHandle_UnassignedOrder_ReturnsNotFound() {
+	ctx := context.Background()
+	o := suite.createOrder()
+	suite.Require().NoError(suite.orderRepo.Add(ctx, o))
+
+	query, err := queries.NewGetAssignmentExplanationQuery(o.ID())
+	suite.Require().NoError(err)
+
+	_, err = suite.handler.Handle(ctx, query)
+
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+}
+
+func (suite *GetAssignmentExplanationQueryHandlerTestSuite) createOrder() *order.Order {
+	location, err := kernel.NewLocation(3, 4)
+	suite.Require().NoError(err)
+	o, err := order.NewOrder(kernel.NewUUID(), location, 10)
+	suite.Require().NoError(err)
+	return o
+}
+
+func TestGetAssignmentExplanationQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetAssignmentExplanationQueryHandlerTestSuite))
+}
@@ -0,0 +1,45 @@
+package queries
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/badge"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrGetBadgeRulesQueryIsNotConstructed = errors.New(
+		"GetBadgeRulesQuery must be created via NewGetBadgeRulesQuery constructor",
+	)
+)
+
+// GetBadgeRulesQuery retrieves the configured rules couriers earn badges by, in configuration order.
+//
+// Example:
+//
+//	query := NewGetBadgeRulesQuery()
+//	rules, err := handler.Handle(ctx, query)
+//	for _, r := range rules {
+//	    fmt.Printf("%s: %s %d\n", r.Name, r.Kind, r.Threshold)
+//	}
+type GetBadgeRulesQuery struct {
+	guard guard.ConstructorGuard
+}
+
+// NewGetBadgeRulesQuery creates a query for the configured badge rules.
+func NewGetBadgeRulesQuery() GetBadgeRulesQuery {
+	return GetBadgeRulesQuery{guard: guard.NewConstructorGuard()}
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetBadgeRulesQueryIsNotConstructed if validation fails.
+func (q GetBadgeRulesQuery) Validate() error {
+	return q.guard.Validate(ErrGetBadgeRulesQueryIsNotConstructed)
+}
+
+// GetBadgeRulesQueryResponse is a badge rule: the badge and what a courier must achieve to earn it.
+type GetBadgeRulesQueryResponse struct {
+	Name      string
+	Kind      badge.Kind
+	Threshold int
+}
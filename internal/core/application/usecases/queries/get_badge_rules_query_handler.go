@@ -0,0 +1,45 @@
+package queries
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/badge"
+	"delivery/internal/pkg/tracing"
+)
+
+// GetBadgeRulesQueryHandler reports the badge rules the service was configured with.
+// The rules are read from the configuration once at startup and are the same for all tenants.
+//
+// Example:
+//
+//	rules, _ := badge.ParseRules("century=deliveries:100")
+//	handler := NewGetBadgeRulesQueryHandler(rules)
+//	configured, err := handler.Handle(ctx, NewGetBadgeRulesQuery())
+type GetBadgeRulesQueryHandler struct {
+	rules []badge.Rule
+}
+
+// NewGetBadgeRulesQueryHandler creates a handler reporting the given rules.
+func NewGetBadgeRulesQueryHandler(rules []badge.Rule) GetBadgeRulesQueryHandler {
+	return GetBadgeRulesQueryHandler{rules: rules}
+}
+
+// Handle executes the query and returns the rules in configuration order.
+// Returns an empty slice if no rules are configured.
+func (h GetBadgeRulesQueryHandler) Handle(
+	ctx context.Context,
+	query GetBadgeRulesQuery,
+) ([]GetBadgeRulesQueryResponse, error) {
+	_, span := tracing.Start(ctx, "GetBadgeRulesQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	rules := make([]GetBadgeRulesQueryResponse, len(h.rules))
+	for i, rule := range h.rules {
+		rules[i] = GetBadgeRulesQueryResponse{Name: rule.Name(), Kind: rule.Kind(), Threshold: rule.Threshold()}
+	}
+	return rules, nil
+}
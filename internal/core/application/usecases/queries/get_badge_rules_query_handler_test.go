@@ -0,0 +1,43 @@
+package queries_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/badge"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBadgeRulesQueryHandler_Handle_ReturnsRulesInConfigurationOrder(t *testing.T) {
+	rules, err := badge.ParseRules("century=deliveries:100,five-star-week=five-star-week:5")
+	require.NoError(t, err)
+	handler := queries.NewGetBadgeRulesQueryHandler(rules)
+
+	configured, err := handler.Handle(t.Context(), queries.NewGetBadgeRulesQuery())
+
+	require.NoError(t, err)
+	assert.Equal(t, []queries.GetBadgeRulesQueryResponse{
+		{Name: "century", Kind: badge.Deliveries, Threshold: 100},
+		{Name: "five-star-week", Kind: badge.FiveStarWeek, Threshold: 5},
+	}, configured)
+}
+
+func TestGetBadgeRulesQueryHandler_Handle_NoRules(t *testing.T) {
+	handler := queries.NewGetBadgeRulesQueryHandler(nil)
+
+	configured, err := handler.Handle(t.Context(), queries.NewGetBadgeRulesQuery())
+
+	require.NoError(t, err)
+	assert.NotNil(t, configured)
+	assert.Empty(t, configured)
+}
+
+func TestGetBadgeRulesQueryHandler_Handle_InvalidQuery(t *testing.T) {
+	handler := queries.NewGetBadgeRulesQueryHandler(nil)
+
+	_, err := handler.Handle(t.Context(), queries.GetBadgeRulesQuery{})
+
+	require.ErrorIs(t, err, queries.ErrGetBadgeRulesQueryIsNotConstructed)
+}
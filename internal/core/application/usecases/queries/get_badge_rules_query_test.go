@@ -0,0 +1,21 @@
+package queries_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/queries"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetBadgeRulesQuery_Valid(t *testing.T) {
+	query := queries.NewGetBadgeRulesQuery()
+
+	require.NoError(t, query.Validate())
+}
+
+func TestGetBadgeRulesQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetBadgeRulesQuery{}
+
+	require.ErrorIs(t, query.Validate(), queries.ErrGetBadgeRulesQueryIsNotConstructed)
+}
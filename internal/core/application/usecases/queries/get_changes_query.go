@@ -0,0 +1,159 @@
+package queries
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultChangesLimit is the number of changes a page of the change feed holds unless asked otherwise.
+	DefaultChangesLimit = 100
+
+	// MaxChangesLimit is the largest page of the change feed.
+	MaxChangesLimit = 1000
+)
+
+const (
+	// OrderChange is the aggregate type of changes to orders.
+	OrderChange = "order"
+
+	// CourierChange is the aggregate type of changes to couriers.
+	CourierChange = "courier"
+)
+
+var (
+	ErrGetChangesQueryIsNotConstructed = errors.New(
+		"GetChangesQuery must be created via NewGetChangesQuery constructor",
+	)
+)
+
+// GetChangesQuery retrieves the change feed of orders and couriers after a cursor, oldest first.
+// Dependent services sync their copy of the state incrementally: they start at cursor 0
+// and pass the NextCursor of every page to the next query.
+//
+// Example:
+//
+//	query, err := NewGetChangesQuery(cursor, DefaultChangesLimit)
+//	if err != nil {
+//	    return fmt.Errorf("invalid cursor: %w", err)
+//	}
+//
+//	feed, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return fmt.Errorf("failed to get changes: %w", err)
+//	}
+//	for _, change := range feed.Changes {
+//	    fmt.Printf("%s %s is at version %d\n", change.AggregateType, change.AggregateID, change.Version)
+//	}
+//	cursor = feed.NextCursor
+type GetChangesQuery struct {
+	since int64
+	limit int
+
+	guard guard.ConstructorGuard
+}
+
+// NewGetChangesQuery creates a query for at most limit changes recorded after the since cursor.
+// Returns an error if the cursor is negative or the limit is outside 1..MaxChangesLimit.
+func NewGetChangesQuery(since int64, limit int) (GetChangesQuery, error) {
+	var fields errs.ValidationErrors
+	if since < 0 {
+		fields.Add("since", errs.NewValueIsInvalidErrorWithCause(
+			"since is invalid",
+			fmt.Errorf("cursor %d must not be negative", since),
+		))
+	}
+	if limit < 1 || limit > MaxChangesLimit {
+		fields.Add("limit", errs.NewValueIsInvalidErrorWithCause(
+			"limit is invalid",
+			fmt.Errorf("%d is not between 1 and %d", limit, MaxChangesLimit),
+		))
+	}
+	if err := fields.Err(); err != nil {
+		return GetChangesQuery{}, err
+	}
+
+	return GetChangesQuery{since: since, limit: limit, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetChangesQueryIsNotConstructed if validation fails.
+func (q GetChangesQuery) Validate() error {
+	return q.guard.Validate(ErrGetChangesQueryIsNotConstructed)
+}
+
+// Since returns the cursor the feed continues after.
+func (q GetChangesQuery) Since() int64 {
+	return q.since
+}
+
+// Limit returns the largest number of changes to return.
+func (q GetChangesQuery) Limit() int {
+	return q.limit
+}
+
+// GetChangesQueryResponse is a page of the change feed.
+// NextCursor is the cursor of the last change on the page, or the cursor the query started
+// after when there were no new changes.
+type GetChangesQueryResponse struct {
+	Changes    []Change
+	NextCursor int64
+}
+
+// Change is a committed change to an order or a courier with the state it left the aggregate in.
+// Versions count the changes of each aggregate from 1, so a consumer can drop changes older
+// than the state it holds. Exactly one of Order and Courier is set, depending on AggregateType.
+type Change struct {
+	Cursor        int64
+	AggregateType string
+	AggregateID   kernel.UUID
+	Version       int64
+	ChangedAt     time.Time
+	Order         *OrderSnapshot
+	Courier       *CourierSnapshot
+}
+
+// OrderSnapshot is the state of an order as of a change.
+// It is recorded in the change log as JSON when the change is committed.
+type OrderSnapshot struct {
+	Status       order.Status       `json:"status"`
+	CourierID    *uuid.UUID         `json:"courierId,omitempty"`
+	X            int                `json:"x"`
+	Y            int                `json:"y"`
+	Volume       int                `json:"volume"`
+	DeliveryTier order.DeliveryTier `json:"deliveryTier"`
+	// DeclaredValue and InsuranceRequired let billing charge for insured deliveries.
+	DeclaredValue     int  `json:"declaredValue"`
+	InsuranceRequired bool `json:"insuranceRequired"`
+	// ExternalReference lets partners correlate the order with their marketplace order; nil if not linked.
+	ExternalReference *ExternalReferenceSnapshot `json:"externalReference,omitempty"`
+	// RatingStars is the customer's rating of the delivery, 0 until the customer rates it.
+	RatingStars int `json:"ratingStars,omitempty"`
+}
+
+// ExternalReferenceSnapshot is the marketplace order an order fulfils as of a change.
+type ExternalReferenceSnapshot struct {
+	Marketplace string `json:"marketplace"`
+	OrderID     string `json:"orderId"`
+	DeepLink    string `json:"deepLink,omitempty"`
+}
+
+// CourierSnapshot is the state of a courier as of a change.
+// It is recorded in the change log as JSON when the change is committed.
+type CourierSnapshot struct {
+	Name        string `json:"name"`
+	Speed       int    `json:"speed"`
+	X           int    `json:"x"`
+	Y           int    `json:"y"`
+	Paused      bool   `json:"paused"` // on a break
+	Deactivated bool   `json:"deactivated"`
+	Insured     bool   `json:"insured"`
+}
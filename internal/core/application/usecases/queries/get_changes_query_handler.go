@@ -0,0 +1,107 @@
+package queries
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetChangesQueryHandler reads the change feed from the change log the unit of work appends
+// to on every commit.
+//
+// Example:
+//
+//	handler := NewGetChangesQueryHandler(db)
+//	query, _ := NewGetChangesQuery(0, DefaultChangesLimit)
+//	feed, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return err
+//	}
+type GetChangesQueryHandler struct {
+	db *gorm.DB
+}
+
+// NewGetChangesQueryHandler creates a handler for change feed queries.
+// Requires a GORM database connection for query execution.
+func NewGetChangesQueryHandler(db *gorm.DB) GetChangesQueryHandler {
+	return GetChangesQueryHandler{db: db}
+}
+
+// Handle executes the query to retrieve the changes recorded after the query's cursor.
+// Returns an empty page with the same cursor if nothing changed since.
+func (h GetChangesQueryHandler) Handle(ctx context.Context, query GetChangesQuery) (GetChangesQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetChangesQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return GetChangesQueryResponse{}, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return GetChangesQueryResponse{}, err
+	}
+	defer release()
+
+	rows, err := session.Raw(`
+		SELECT id, aggregate_type, aggregate_id, version, changed_at, snapshot
+		FROM change_log
+		WHERE id > ?
+		ORDER BY id
+		LIMIT ?
+	`, query.Since(), query.Limit()).Rows()
+	if err != nil {
+		return GetChangesQueryResponse{}, err
+	}
+	defer rows.Close()
+
+	response := GetChangesQueryResponse{Changes: make([]Change, 0), NextCursor: query.Since()}
+	for rows.Next() {
+		var (
+			change      Change
+			aggregateID uuid.UUID
+			snapshot    []byte
+		)
+
+		err = rows.Scan(&change.Cursor, &change.AggregateType, &aggregateID, &change.Version, &change.ChangedAt, &snapshot)
+		if err != nil {
+			return GetChangesQueryResponse{}, err
+		}
+
+		if change.AggregateID, err = kernel.UUIDFromBytes(aggregateID[:]); err != nil {
+			return GetChangesQueryResponse{}, err
+		}
+		if err = decodeSnapshot(&change, snapshot); err != nil {
+			return GetChangesQueryResponse{}, err
+		}
+
+		response.Changes = append(response.Changes, change)
+		response.NextCursor = change.Cursor
+	}
+
+	if err = rows.Err(); err != nil {
+		return GetChangesQueryResponse{}, err
+	}
+
+	return response, nil
+}
+
+// decodeSnapshot unmarshals the snapshot of the change according to its aggregate type.
+func decodeSnapshot(change *Change, snapshot []byte) error {
+	switch change.AggregateType {
+	case OrderChange:
+		change.Order = &OrderSnapshot{}
+		return json.Unmarshal(snapshot, change.Order)
+	case CourierChange:
+		change.Courier = &CourierSnapshot{}
+		return json.Unmarshal(snapshot, change.Courier)
+	default:
+		return fmt.Errorf("change %d has unknown aggregate type %q", change.Cursor, change.AggregateType)
+	}
+}
@@ -0,0 +1,210 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/adapters/out/postgres/outboxrepo"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetChangesQueryHandlerTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	db       *gorm.DB
+	handler  queries.GetChangesQueryHandler
+	factory  ports.UnitOfWorkFactory
+}
+
+func (suite *GetChangesQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&orderrepo.OrderDTO{},
+			&orderrepo.OrderMessageDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{},
+			&orderrepo.OrderItemDTO{},
+			&courierrepo.CourierDTO{},
+			&courierrepo.StoragePlaceDTO{},
+			&courierrepo.MaintenanceWindowDTO{},
+			&courierrepo.AbsenceDTO{},
+			&courierrepo.ScheduleWindowDTO{},
+			&postgres_adapter.ChangeLogDTO{},
+			&postgres_adapter.CourierAvailabilityDTO{},
+			&outboxrepo.OutboxMessageDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetChangesQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetChangesQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetChangesQueryHandler(suite.db)
+	suite.factory = postgres_adapter.NewGormUnitOfWorkFactory(suite.db)
+}
+
+func (suite *GetChangesQueryHandlerTestSuite) TestHandle_ReturnsVersionedChangesInCommitOrder() {
+	ctx := context.Background()
+	location, err := kernel.NewLocation(2, 3)
+	suite.Require().NoError(err)
+	c, err := courier.NewCourier(kernel.NewUUID(), "Alice", 2, location)
+	suite.Require().NoError(err)
+	suite.Require().NoError(c.AddStoragePlace("Сумка", 10))
+	o, err := order.NewOrder(kernel.NewUUID(), location, 5)
+	suite.Require().NoError(err)
+
+	suite.commit(func(uow ports.UnitOfWork) error {
+		if err := uow.CourierRepository().Add(ctx, c); err != nil {
+			return err
+		}
+		return uow.OrderRepository().Add(ctx, o)
+	})
+
+	suite.Require().NoError(c.TakeOrder(o))
+	suite.Require().NoError(o.Assign(c.ID()))
+	suite.commit(func(uow ports.UnitOfWork) error {
+		// Tracking the order twice within a transaction records a single change
+		if err := uow.OrderRepository().Update(ctx, o); err != nil {
+			return err
+		}
+		if err := uow.CourierRepository().Update(ctx, c); err != nil {
+			return err
+		}
+		return uow.OrderRepository().Update(ctx, o)
+	})
+
+	query, err := queries.NewGetChangesQuery(0, queries.DefaultChangesLimit)
+	suite.Require().NoError(err)
+	feed, err := suite.handler.Handle(ctx, query)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(feed.Changes, 4)
+	suite.Equal(feed.Changes[3].Cursor, feed.NextCursor)
+
+	created := feed.Changes[1]
+	suite.Equal(queries.OrderChange, created.AggregateType)
+	suite.Equal(o.ID(), created.AggregateID)
+	suite.Equal(int64(1), created.Version)
+	suite.Require().NotNil(created.Order)
+	suite.Nil(created.Courier)
+	suite.Equal(order.Created, created.Order.Status)
+	suite.Nil(created.Order.CourierID)
+
+	assigned := feed.Changes[2]
+	suite.Equal(o.ID(), assigned.AggregateID)
+	suite.Equal(int64(2), assigned.Version)
+	suite.Equal(order.Assigned, assigned.Order.Status)
+	suite.Require().NotNil(assigned.Order.CourierID)
+	suite.Equal(c.ID().Bytes(), *assigned.Order.CourierID)
+
+	updated := feed.Changes[3]
+	suite.Equal(queries.CourierChange, updated.AggregateType)
+	suite.Equal(c.ID(), updated.AggregateID)
+	suite.Equal(int64(2), updated.Version)
+	suite.Require().NotNil(updated.Courier)
+	suite.Equal("Alice", updated.Courier.Name)
+	suite.Equal(2, updated.Courier.X)
+
+	next, err := queries.NewGetChangesQuery(feed.Changes[1].Cursor, 1)
+	suite.Require().NoError(err)
+	page, err := suite.handler.Handle(ctx, next)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(page.Changes, 1)
+	suite.Equal(assigned.Cursor, page.NextCursor)
+}
+
+func (suite *GetChangesQueryHandlerTestSuite) TestHandle_OrderChanges_CarryExternalReference() {
+	ctx := context.Background()
+	location, err := kernel.NewLocation(2, 3)
+	suite.Require().NoError(err)
+	o, err := order.NewOrder(kernel.NewUUID(), location, 5)
+	suite.Require().NoError(err)
+	ref, err := order.NewExternalReference("ozon", "48213377-0021", "https://ozon.ru/orders/48213377-0021")
+	suite.Require().NoError(err)
+	suite.Require().NoError(o.LinkExternalReference(ref))
+
+	suite.commit(func(uow ports.UnitOfWork) error {
+		return uow.OrderRepository().Add(ctx, o)
+	})
+
+	query, err := queries.NewGetChangesQuery(0, queries.DefaultChangesLimit)
+	suite.Require().NoError(err)
+	feed, err := suite.handler.Handle(ctx, query)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(feed.Changes, 1)
+	suite.Require().NotNil(feed.Changes[0].Order)
+	suite.Equal(&queries.ExternalReferenceSnapshot{
+		Marketplace: "ozon",
+		OrderID:     "48213377-0021",
+		DeepLink:    "https://ozon.ru/orders/48213377-0021",
+	}, feed.Changes[0].Order.ExternalReference)
+}
+
+func (suite *GetChangesQueryHandlerTestSuite) TestHandle_NoChanges_KeepsCursor() {
+	query, err := queries.NewGetChangesQuery(7, queries.DefaultChangesLimit)
+	suite.Require().NoError(err)
+
+	feed, err := suite.handler.Handle(context.Background(), query)
+
+	suite.Require().NoError(err)
+	suite.NotNil(feed.Changes)
+	suite.Empty(feed.Changes)
+	suite.Equal(int64(7), feed.NextCursor)
+}
+
+func (suite *GetChangesQueryHandlerTestSuite) TestHandle_RolledBackChanges_AreNotRecorded() {
+	ctx := context.Background()
+	location, err := kernel.NewLocation(1, 1)
+	suite.Require().NoError(err)
+	o, err := order.NewOrder(kernel.NewUUID(), location, 5)
+	suite.Require().NoError(err)
+
+	uow := suite.factory.Create()
+	suite.Require().NoError(uow.Begin(ctx))
+	suite.Require().NoError(uow.OrderRepository().Add(ctx, o))
+	suite.Require().NoError(uow.Rollback(ctx))
+
+	query, err := queries.NewGetChangesQuery(0, queries.DefaultChangesLimit)
+	suite.Require().NoError(err)
+	feed, err := suite.handler.Handle(ctx, query)
+
+	suite.Require().NoError(err)
+	suite.Empty(feed.Changes)
+}
+
+func (suite *GetChangesQueryHandlerTestSuite) commit(change func(uow ports.UnitOfWork) error) {
+	ctx := context.Background()
+	uow := suite.factory.Create()
+	suite.Require().NoError(uow.Begin(ctx))
+	defer func() {
+		_ = uow.Rollback(ctx)
+	}()
+
+	suite.Require().NoError(change(uow))
+	suite.Require().NoError(uow.Commit(ctx))
+}
+
+func TestGetChangesQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetChangesQueryHandlerTestSuite))
+}
@@ -0,0 +1,37 @@
+package queries_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetChangesQuery_Valid(t *testing.T) {
+	query, err := queries.NewGetChangesQuery(42, queries.DefaultChangesLimit)
+
+	require.NoError(t, err)
+	require.NoError(t, query.Validate())
+	assert.Equal(t, int64(42), query.Since())
+	assert.Equal(t, queries.DefaultChangesLimit, query.Limit())
+}
+
+func TestNewGetChangesQuery_Invalid(t *testing.T) {
+	_, err := queries.NewGetChangesQuery(-1, queries.DefaultChangesLimit)
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+
+	_, err = queries.NewGetChangesQuery(0, 0)
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+
+	_, err = queries.NewGetChangesQuery(0, queries.MaxChangesLimit+1)
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
+
+func TestGetChangesQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetChangesQuery{}
+
+	require.ErrorIs(t, query.Validate(), queries.ErrGetChangesQueryIsNotConstructed)
+}
@@ -0,0 +1,104 @@
+package queries
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrGetCourierAvailabilityQueryIsNotConstructed = errors.New(
+		"GetCourierAvailabilityQuery must be created via NewGetCourierAvailabilityQuery constructor",
+	)
+)
+
+// GetCourierAvailabilityQuery retrieves the availability feed of couriers after a cursor, oldest first.
+// The workforce management system mirrors when couriers are free, busy or off shift: it starts
+// at cursor 0 and passes the NextCursor of every page to the next query. Pages hold at most
+// MaxChangesLimit changes, like the change feed.
+//
+// Example:
+//
+//	query, err := NewGetCourierAvailabilityQuery(cursor, DefaultChangesLimit)
+//	if err != nil {
+//	    return fmt.Errorf("invalid cursor: %w", err)
+//	}
+//
+//	feed, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return fmt.Errorf("failed to get availability: %w", err)
+//	}
+//	for _, change := range feed.Changes {
+//	    fmt.Printf("courier %s is %s as of version %d\n", change.CourierID, change.Availability, change.Version)
+//	}
+//	cursor = feed.NextCursor
+type GetCourierAvailabilityQuery struct {
+	since int64
+	limit int
+
+	guard guard.ConstructorGuard
+}
+
+// NewGetCourierAvailabilityQuery creates a query for at most limit availability changes
+// recorded after the since cursor.
+// Returns an error if the cursor is negative or the limit is outside 1..MaxChangesLimit.
+func NewGetCourierAvailabilityQuery(since int64, limit int) (GetCourierAvailabilityQuery, error) {
+	var fields errs.ValidationErrors
+	if since < 0 {
+		fields.Add("since", errs.NewValueIsInvalidErrorWithCause(
+			"since is invalid",
+			fmt.Errorf("cursor %d must not be negative", since),
+		))
+	}
+	if limit < 1 || limit > MaxChangesLimit {
+		fields.Add("limit", errs.NewValueIsInvalidErrorWithCause(
+			"limit is invalid",
+			fmt.Errorf("%d is not between 1 and %d", limit, MaxChangesLimit),
+		))
+	}
+	if err := fields.Err(); err != nil {
+		return GetCourierAvailabilityQuery{}, err
+	}
+
+	return GetCourierAvailabilityQuery{since: since, limit: limit, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetCourierAvailabilityQueryIsNotConstructed if validation fails.
+func (q GetCourierAvailabilityQuery) Validate() error {
+	return q.guard.Validate(ErrGetCourierAvailabilityQueryIsNotConstructed)
+}
+
+// Since returns the cursor the feed continues after.
+func (q GetCourierAvailabilityQuery) Since() int64 {
+	return q.since
+}
+
+// Limit returns the largest number of changes to return.
+func (q GetCourierAvailabilityQuery) Limit() int {
+	return q.limit
+}
+
+// GetCourierAvailabilityQueryResponse is a page of the availability feed.
+// NextCursor is the cursor of the last change on the page, or the cursor the query started
+// after when there were no new changes.
+type GetCourierAvailabilityQueryResponse struct {
+	Changes    []AvailabilityChange
+	NextCursor int64
+}
+
+// AvailabilityChange is a committed change of the availability of a courier.
+// Versions count the availability changes of each courier from 1, the same versions the
+// CourierAvailabilityChanged events carry, so a consumer of both can drop the duplicates.
+type AvailabilityChange struct {
+	Cursor       int64
+	CourierID    kernel.UUID
+	Version      int64
+	Availability courier.Availability
+	ChangedAt    time.Time
+}
@@ -0,0 +1,93 @@
+package queries
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetCourierAvailabilityQueryHandler reads the availability feed from the availability log the
+// unit of work appends to when a commit changes the availability of a courier.
+//
+// Example:
+//
+//	handler := NewGetCourierAvailabilityQueryHandler(db)
+//	query, _ := NewGetCourierAvailabilityQuery(0, DefaultChangesLimit)
+//	feed, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return err
+//	}
+type GetCourierAvailabilityQueryHandler struct {
+	db *gorm.DB
+}
+
+// NewGetCourierAvailabilityQueryHandler creates a handler for availability feed queries.
+// Requires a GORM database connection for query execution.
+func NewGetCourierAvailabilityQueryHandler(db *gorm.DB) GetCourierAvailabilityQueryHandler {
+	return GetCourierAvailabilityQueryHandler{db: db}
+}
+
+// Handle executes the query to retrieve the availability changes recorded after the query's cursor.
+// Returns an empty page with the same cursor if no availability changed since.
+func (h GetCourierAvailabilityQueryHandler) Handle(
+	ctx context.Context,
+	query GetCourierAvailabilityQuery,
+) (GetCourierAvailabilityQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetCourierAvailabilityQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return GetCourierAvailabilityQueryResponse{}, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return GetCourierAvailabilityQueryResponse{}, err
+	}
+	defer release()
+
+	rows, err := session.Raw(`
+		SELECT id, courier_id, version, availability, changed_at
+		FROM courier_availability_log
+		WHERE id > ?
+		ORDER BY id
+		LIMIT ?
+	`, query.Since(), query.Limit()).Rows()
+	if err != nil {
+		return GetCourierAvailabilityQueryResponse{}, err
+	}
+	defer rows.Close()
+
+	response := GetCourierAvailabilityQueryResponse{Changes: make([]AvailabilityChange, 0), NextCursor: query.Since()}
+	for rows.Next() {
+		var (
+			change       AvailabilityChange
+			courierID    uuid.UUID
+			availability int
+		)
+
+		err = rows.Scan(&change.Cursor, &courierID, &change.Version, &availability, &change.ChangedAt)
+		if err != nil {
+			return GetCourierAvailabilityQueryResponse{}, err
+		}
+
+		if change.CourierID, err = kernel.UUIDFromBytes(courierID[:]); err != nil {
+			return GetCourierAvailabilityQueryResponse{}, err
+		}
+		change.Availability = courier.Availability(availability)
+
+		response.Changes = append(response.Changes, change)
+		response.NextCursor = change.Cursor
+	}
+
+	if err = rows.Err(); err != nil {
+		return GetCourierAvailabilityQueryResponse{}, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,148 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/adapters/out/postgres/outboxrepo"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetCourierAvailabilityQueryHandlerTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	db       *gorm.DB
+	handler  queries.GetCourierAvailabilityQueryHandler
+	factory  ports.UnitOfWorkFactory
+}
+
+func (suite *GetCourierAvailabilityQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&courierrepo.CourierDTO{},
+			&courierrepo.StoragePlaceDTO{},
+			&courierrepo.MaintenanceWindowDTO{},
+			&courierrepo.AbsenceDTO{},
+			&courierrepo.ScheduleWindowDTO{},
+			&postgres_adapter.ChangeLogDTO{},
+			&postgres_adapter.CourierAvailabilityDTO{},
+			&outboxrepo.OutboxMessageDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetCourierAvailabilityQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetCourierAvailabilityQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetCourierAvailabilityQueryHandler(suite.db)
+	suite.factory = postgres_adapter.NewGormUnitOfWorkFactory(suite.db)
+}
+
+func (suite *GetCourierAvailabilityQueryHandlerTestSuite) TestHandle_ReturnsVersionedChangesInCommitOrder() {
+	ctx := context.Background()
+	location, err := kernel.NewLocation(2, 3)
+	suite.Require().NoError(err)
+	alice, err := courier.NewCourier(kernel.NewUUID(), "Alice", 2, location)
+	suite.Require().NoError(err)
+	bob, err := courier.NewCourier(kernel.NewUUID(), "Bob", 2, location)
+	suite.Require().NoError(err)
+	limit, err := courier.NewWorkingHoursLimit(8*time.Hour, 30*time.Minute)
+	suite.Require().NoError(err)
+
+	// Couriers off shift are not recorded until they start a shift
+	suite.commit(func(uow ports.UnitOfWork) error {
+		if err := uow.CourierRepository().Add(ctx, alice); err != nil {
+			return err
+		}
+		return uow.CourierRepository().Add(ctx, bob)
+	})
+
+	suite.Require().NoError(alice.StartShift(time.Now(), limit))
+	suite.commit(func(uow ports.UnitOfWork) error {
+		return uow.CourierRepository().Update(ctx, alice)
+	})
+
+	suite.Require().NoError(alice.StartBreak())
+	suite.Require().NoError(bob.StartShift(time.Now(), limit))
+	suite.commit(func(uow ports.UnitOfWork) error {
+		if err := uow.CourierRepository().Update(ctx, alice); err != nil {
+			return err
+		}
+		return uow.CourierRepository().Update(ctx, bob)
+	})
+
+	query, err := queries.NewGetCourierAvailabilityQuery(0, queries.DefaultChangesLimit)
+	suite.Require().NoError(err)
+	feed, err := suite.handler.Handle(ctx, query)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(feed.Changes, 3)
+	suite.Equal(feed.Changes[2].Cursor, feed.NextCursor)
+
+	free := feed.Changes[0]
+	suite.Equal(alice.ID(), free.CourierID)
+	suite.Equal(int64(1), free.Version)
+	suite.Equal(courier.AvailabilityFree, free.Availability)
+
+	onBreak := feed.Changes[1]
+	suite.Equal(alice.ID(), onBreak.CourierID)
+	suite.Equal(int64(2), onBreak.Version)
+	suite.Equal(courier.AvailabilityBusy, onBreak.Availability)
+
+	next, err := queries.NewGetCourierAvailabilityQuery(onBreak.Cursor, 1)
+	suite.Require().NoError(err)
+	page, err := suite.handler.Handle(ctx, next)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(page.Changes, 1)
+	suite.Equal(bob.ID(), page.Changes[0].CourierID)
+	suite.Equal(int64(1), page.Changes[0].Version)
+	suite.Equal(courier.AvailabilityFree, page.Changes[0].Availability)
+}
+
+func (suite *GetCourierAvailabilityQueryHandlerTestSuite) TestHandle_NoChanges_KeepsCursor() {
+	query, err := queries.NewGetCourierAvailabilityQuery(7, queries.DefaultChangesLimit)
+	suite.Require().NoError(err)
+
+	feed, err := suite.handler.Handle(context.Background(), query)
+
+	suite.Require().NoError(err)
+	suite.NotNil(feed.Changes)
+	suite.Empty(feed.Changes)
+	suite.Equal(int64(7), feed.NextCursor)
+}
+
+func (suite *GetCourierAvailabilityQueryHandlerTestSuite) commit(change func(uow ports.UnitOfWork) error) {
+	ctx := context.Background()
+	uow := suite.factory.Create()
+	suite.Require().NoError(uow.Begin(ctx))
+	defer func() {
+		_ = uow.Rollback(ctx)
+	}()
+
+	suite.Require().NoError(change(uow))
+	suite.Require().NoError(uow.Commit(ctx))
+}
+
+func TestGetCourierAvailabilityQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetCourierAvailabilityQueryHandlerTestSuite))
+}
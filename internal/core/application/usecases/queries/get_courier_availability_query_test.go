@@ -0,0 +1,34 @@
+package queries_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetCourierAvailabilityQuery_Valid(t *testing.T) {
+	query, err := queries.NewGetCourierAvailabilityQuery(42, queries.DefaultChangesLimit)
+
+	require.NoError(t, err)
+	require.NoError(t, query.Validate())
+	assert.Equal(t, int64(42), query.Since())
+	assert.Equal(t, queries.DefaultChangesLimit, query.Limit())
+}
+
+func TestNewGetCourierAvailabilityQuery_Invalid(t *testing.T) {
+	_, err := queries.NewGetCourierAvailabilityQuery(-1, queries.DefaultChangesLimit)
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+
+	_, err = queries.NewGetCourierAvailabilityQuery(0, queries.MaxChangesLimit+1)
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
+
+func TestGetCourierAvailabilityQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetCourierAvailabilityQuery{}
+
+	require.ErrorIs(t, query.Validate(), queries.ErrGetCourierAvailabilityQueryIsNotConstructed)
+}
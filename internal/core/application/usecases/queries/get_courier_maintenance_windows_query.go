@@ -0,0 +1,65 @@
+package queries
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrGetCourierMaintenanceWindowsQueryIsNotConstructed = errors.New(
+		"GetCourierMaintenanceWindowsQuery must be created via NewGetCourierMaintenanceWindowsQuery constructor",
+	)
+)
+
+// GetCourierMaintenanceWindowsQuery retrieves the vehicle maintenance calendar of a courier,
+// earliest window first.
+//
+// Example:
+//
+//	query, err := NewGetCourierMaintenanceWindowsQuery(courierID)
+//	if err != nil {
+//	    return fmt.Errorf("invalid courier id: %w", err)
+//	}
+//
+//	windows, err := handler.Handle(ctx, query)
+//	for _, w := range windows {
+//	    fmt.Printf("%s - %s: %s\n", w.StartsAt, w.EndsAt, w.Status)
+//	}
+type GetCourierMaintenanceWindowsQuery struct {
+	courierID kernel.UUID
+
+	guard guard.ConstructorGuard
+}
+
+// NewGetCourierMaintenanceWindowsQuery creates a query for the maintenance windows of the given courier.
+// Returns an error if the courier ID is invalid.
+func NewGetCourierMaintenanceWindowsQuery(courierID kernel.UUID) (GetCourierMaintenanceWindowsQuery, error) {
+	if err := courierID.Validate(); err != nil {
+		return GetCourierMaintenanceWindowsQuery{}, err
+	}
+
+	return GetCourierMaintenanceWindowsQuery{courierID: courierID, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetCourierMaintenanceWindowsQueryIsNotConstructed if validation fails.
+func (q GetCourierMaintenanceWindowsQuery) Validate() error {
+	return q.guard.Validate(ErrGetCourierMaintenanceWindowsQueryIsNotConstructed)
+}
+
+// CourierID returns the ID of the courier whose maintenance windows are requested.
+func (q GetCourierMaintenanceWindowsQuery) CourierID() kernel.UUID {
+	return q.courierID
+}
+
+// GetCourierMaintenanceWindowsQueryResponse is a maintenance window with its status at the time of the query.
+type GetCourierMaintenanceWindowsQueryResponse struct {
+	ID       kernel.UUID
+	StartsAt time.Time
+	EndsAt   time.Time
+	Status   courier.MaintenanceStatus
+}
@@ -0,0 +1,113 @@
+package queries
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetCourierMaintenanceWindowsQueryHandler retrieves couriers' maintenance calendars from the database.
+// Windows starting within the warning period are reported as approaching.
+//
+// Example:
+//
+//	handler := NewGetCourierMaintenanceWindowsQueryHandler(db, time.Hour)
+//	windows, err := handler.Handle(ctx, query)
+//	if errors.Is(err, errs.ErrObjectNotFound) {
+//	    return nil, ErrCourierNotFound
+//	}
+type GetCourierMaintenanceWindowsQueryHandler struct {
+	db         *gorm.DB
+	warnBefore time.Duration
+}
+
+// NewGetCourierMaintenanceWindowsQueryHandler creates a handler for maintenance window queries.
+// Requires a GORM database connection and how long before a window couriers are warned.
+func NewGetCourierMaintenanceWindowsQueryHandler(
+	db *gorm.DB,
+	warnBefore time.Duration,
+) GetCourierMaintenanceWindowsQueryHandler {
+	return GetCourierMaintenanceWindowsQueryHandler{db: db, warnBefore: warnBefore}
+}
+
+// Handle executes the query and returns the courier's windows ordered by start, finished ones included.
+// Returns an ObjectNotFoundError if the courier does not exist.
+func (h GetCourierMaintenanceWindowsQueryHandler) Handle(
+	ctx context.Context,
+	query GetCourierMaintenanceWindowsQuery,
+) ([]GetCourierMaintenanceWindowsQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetCourierMaintenanceWindowsQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	var id uuid.UUID
+	err = session.Raw(`SELECT id FROM couriers WHERE id = ?`, query.CourierID().Bytes()).Row().Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.NewObjectNotFoundError("courier", query.CourierID().String())
+		}
+		return nil, err
+	}
+
+	rows, err := session.Raw(`
+		SELECT id, starts_at, ends_at
+		FROM courier_maintenance_windows
+		WHERE courier_id = ?
+		ORDER BY starts_at, id
+	`, query.CourierID().Bytes()).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	now := time.Now()
+	windows := make([]GetCourierMaintenanceWindowsQueryResponse, 0)
+	for rows.Next() {
+		var windowID uuid.UUID
+		var startsAt, endsAt time.Time
+
+		if err = rows.Scan(&windowID, &startsAt, &endsAt); err != nil {
+			return nil, err
+		}
+
+		restoredID, idErr := kernel.UUIDFromBytes(windowID[:])
+		if idErr != nil {
+			return nil, idErr
+		}
+		window, windowErr := courier.NewMaintenanceWindow(restoredID, startsAt, endsAt)
+		if windowErr != nil {
+			return nil, windowErr
+		}
+
+		windows = append(windows, GetCourierMaintenanceWindowsQueryResponse{
+			ID:       window.ID(),
+			StartsAt: window.StartsAt(),
+			EndsAt:   window.EndsAt(),
+			Status:   window.Status(now, h.warnBefore),
+		})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return windows, nil
+}
@@ -0,0 +1,108 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetCourierMaintenanceWindowsQueryHandlerTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	db       *gorm.DB
+	handler  queries.GetCourierMaintenanceWindowsQueryHandler
+}
+
+func (suite *GetCourierMaintenanceWindowsQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&courierrepo.CourierDTO{}, &courierrepo.StoragePlaceDTO{}, &courierrepo.MaintenanceWindowDTO{},
+			&courierrepo.AbsenceDTO{},
+			&courierrepo.ScheduleWindowDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetCourierMaintenanceWindowsQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetCourierMaintenanceWindowsQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetCourierMaintenanceWindowsQueryHandler(suite.db, time.Hour)
+}
+
+func (suite *GetCourierMaintenanceWindowsQueryHandlerTestSuite) TestHandle_ReturnsWindowsWithStatus() {
+	now := time.Now().UTC().Truncate(time.Second)
+	location, err := kernel.NewLocation(5, 5)
+	suite.Require().NoError(err)
+	c, err := courier.NewCourier(kernel.NewUUID(), "Alice", 3, location)
+	suite.Require().NoError(err)
+
+	upcoming := suite.scheduleWindow(c, now.Add(3*time.Hour), now.Add(4*time.Hour), now)
+	approaching := suite.scheduleWindow(c, now.Add(30*time.Minute), now.Add(time.Hour), now)
+	active := suite.scheduleWindow(c, now.Add(-time.Hour), now.Add(10*time.Minute), now)
+
+	repo := courierrepo.NewGormCourierRepository(suite.db, &mockAggregateTracker{})
+	suite.Require().NoError(repo.Add(context.Background(), c))
+
+	query, err := queries.NewGetCourierMaintenanceWindowsQuery(c.ID())
+	suite.Require().NoError(err)
+	windows, err := suite.handler.Handle(context.Background(), query)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(windows, 3)
+	suite.Equal(active.ID(), windows[0].ID)
+	suite.Equal(courier.MaintenanceActive, windows[0].Status)
+	suite.Equal(approaching.ID(), windows[1].ID)
+	suite.Equal(courier.MaintenanceApproaching, windows[1].Status)
+	suite.Equal(upcoming.ID(), windows[2].ID)
+	suite.Equal(courier.MaintenanceScheduled, windows[2].Status)
+	suite.True(upcoming.StartsAt().Equal(windows[2].StartsAt))
+}
+
+func (suite *GetCourierMaintenanceWindowsQueryHandlerTestSuite) TestHandle_CourierNotFound_ReturnsError() {
+	query, err := queries.NewGetCourierMaintenanceWindowsQuery(kernel.NewUUID())
+	suite.Require().NoError(err)
+
+	_, err = suite.handler.Handle(context.Background(), query)
+
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+}
+
+func (suite *GetCourierMaintenanceWindowsQueryHandlerTestSuite) TestHandle_InvalidQuery_ReturnsError() {
+	result, err := suite.handler.Handle(context.Background(), queries.GetCourierMaintenanceWindowsQuery{})
+
+	suite.Require().ErrorIs(err, queries.ErrGetCourierMaintenanceWindowsQueryIsNotConstructed)
+	suite.Nil(result)
+}
+
+func (suite *GetCourierMaintenanceWindowsQueryHandlerTestSuite) scheduleWindow(
+	c *courier.Courier,
+	startsAt, endsAt, now time.Time,
+) courier.MaintenanceWindow {
+	window, err := courier.NewMaintenanceWindow(kernel.NewUUID(), startsAt, endsAt)
+	suite.Require().NoError(err)
+	suite.Require().NoError(c.ScheduleMaintenance(window, now))
+	return window
+}
+
+func TestGetCourierMaintenanceWindowsQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetCourierMaintenanceWindowsQueryHandlerTestSuite))
+}
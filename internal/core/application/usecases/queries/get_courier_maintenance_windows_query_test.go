@@ -0,0 +1,33 @@
+package queries_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetCourierMaintenanceWindowsQuery_Valid(t *testing.T) {
+	courierID := kernel.NewUUID()
+
+	query, err := queries.NewGetCourierMaintenanceWindowsQuery(courierID)
+
+	require.NoError(t, err)
+	require.NoError(t, query.Validate())
+	assert.Equal(t, courierID, query.CourierID())
+}
+
+func TestNewGetCourierMaintenanceWindowsQuery_InvalidCourierID(t *testing.T) {
+	_, err := queries.NewGetCourierMaintenanceWindowsQuery(kernel.UUID{})
+
+	require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+}
+
+func TestGetCourierMaintenanceWindowsQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetCourierMaintenanceWindowsQuery{}
+
+	require.ErrorIs(t, query.Validate(), queries.ErrGetCourierMaintenanceWindowsQueryIsNotConstructed)
+}
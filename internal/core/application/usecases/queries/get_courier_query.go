@@ -0,0 +1,70 @@
+package queries
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrGetCourierQueryIsNotConstructed = errors.New(
+		"GetCourierQuery must be created via NewGetCourierQuery constructor",
+	)
+)
+
+// GetCourierQuery retrieves a single courier with their status and storage places.
+//
+// Example:
+//
+//	query, err := NewGetCourierQuery(courierID)
+//	if err != nil {
+//	    return fmt.Errorf("invalid courier ID: %w", err)
+//	}
+//
+//	c, err := handler.Handle(ctx, query)
+//	if errors.Is(err, errs.ErrObjectNotFound) {
+//	    // No such courier
+//	}
+type GetCourierQuery struct {
+	courierID kernel.UUID
+
+	guard guard.ConstructorGuard
+}
+
+// NewGetCourierQuery creates a query for the courier with the given ID.
+// Returns an error if the ID was not constructed.
+func NewGetCourierQuery(courierID kernel.UUID) (GetCourierQuery, error) {
+	if err := courierID.Validate(); err != nil {
+		return GetCourierQuery{}, err
+	}
+
+	return GetCourierQuery{courierID: courierID, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetCourierQueryIsNotConstructed if validation fails.
+func (q GetCourierQuery) Validate() error {
+	return q.guard.Validate(ErrGetCourierQueryIsNotConstructed)
+}
+
+// CourierID returns the ID of the requested courier.
+func (q GetCourierQuery) CourierID() kernel.UUID {
+	return q.courierID
+}
+
+// GetCourierQueryResponse represents a courier in the read model.
+type GetCourierQueryResponse struct {
+	ID       kernel.UUID
+	Name     string
+	Speed    int
+	Location kernel.Location
+	Status   courier.Status
+	// ExternalID is the courier's HR system ID; nil for couriers created without one.
+	ExternalID *string
+	// Insured reports whether the courier may carry insured high-value orders.
+	Insured bool
+	// StoragePlaces lists the courier's storage places by name.
+	StoragePlaces []CourierStoragePlace
+}
@@ -0,0 +1,168 @@
+package queries
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetCourierQueryHandler retrieves single couriers from the database.
+//
+// Example:
+//
+//	handler := NewGetCourierQueryHandler(db)
+//	query, _ := NewGetCourierQuery(courierID)
+//
+//	c, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    log.Printf("Failed to get courier: %v", err)
+//	    return err
+//	}
+//
+//	fmt.Printf("%s is %s\n", c.Name, c.Status)
+type GetCourierQueryHandler struct {
+	db *gorm.DB
+}
+
+// NewGetCourierQueryHandler creates a handler for single courier queries.
+// Requires a GORM database connection for query execution.
+func NewGetCourierQueryHandler(db *gorm.DB) GetCourierQueryHandler {
+	return GetCourierQueryHandler{db: db}
+}
+
+// Handle executes the query to retrieve the courier with their storage places sorted by name.
+// Returns an ObjectNotFoundError if the tenant has no such courier.
+func (h GetCourierQueryHandler) Handle(
+	ctx context.Context,
+	query GetCourierQuery,
+) (GetCourierQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetCourierQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return GetCourierQueryResponse{}, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return GetCourierQueryResponse{}, err
+	}
+	defer release()
+
+	response := GetCourierQueryResponse{ID: query.CourierID()}
+	var locationX, locationY int8
+	var status *int
+	var shiftStartedAt *time.Time
+	err = session.Raw(`
+		SELECT
+			name,
+			speed,
+			location_x,
+			location_y,
+			status,
+			shift_started_at,
+			external_id,
+			insured
+		FROM couriers
+		WHERE id = ?
+	`, query.CourierID().Bytes()).Row().Scan(
+		&response.Name,
+		&response.Speed,
+		&locationX,
+		&locationY,
+		&status,
+		&shiftStartedAt,
+		&response.ExternalID,
+		&response.Insured,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return GetCourierQueryResponse{}, errs.NewObjectNotFoundError("courier", query.CourierID().String())
+		}
+		return GetCourierQueryResponse{}, err
+	}
+
+	response.Location, err = kernel.NewLocation(kernel.Coordinate(locationX), kernel.Coordinate(locationY))
+	if err != nil {
+		return GetCourierQueryResponse{}, err
+	}
+
+	response.StoragePlaces, err = h.storagePlaces(session, query.CourierID())
+	if err != nil {
+		return GetCourierQueryResponse{}, err
+	}
+	response.Status = courierStatus(status, shiftStartedAt, response.StoragePlaces)
+
+	return response, nil
+}
+
+// storagePlaces loads the storage places of the courier sorted by name.
+func (h GetCourierQueryHandler) storagePlaces(session *gorm.DB, courierID kernel.UUID) ([]CourierStoragePlace, error) {
+	rows, err := session.Raw(`
+		SELECT
+			id,
+			name,
+			total_volume,
+			order_id,
+			out_of_service,
+			thermal
+		FROM storage_places
+		WHERE courier_id = ?
+		ORDER BY name, id
+	`, courierID.Bytes()).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	places := make([]CourierStoragePlace, 0)
+	for rows.Next() {
+		var place CourierStoragePlace
+		var id uuid.UUID
+		var orderID *uuid.UUID
+
+		if err = rows.Scan(&id, &place.Name, &place.TotalVolume, &orderID, &place.OutOfService, &place.Thermal); err != nil {
+			return nil, err
+		}
+
+		if place.ID, err = kernel.UUIDFromBytes(id[:]); err != nil {
+			return nil, err
+		}
+		if orderID != nil {
+			orderUUID, idErr := kernel.UUIDFromBytes(orderID[:])
+			if idErr != nil {
+				return nil, idErr
+			}
+			place.OrderID = &orderUUID
+		}
+		places = append(places, place)
+	}
+
+	return places, rows.Err()
+}
+
+// courierStatus returns the stored status of a courier, derived from the shift and the storage
+// for couriers saved before the status was persisted.
+func courierStatus(status *int, shiftStartedAt *time.Time, places []CourierStoragePlace) courier.Status {
+	if status != nil {
+		return courier.Status(*status)
+	}
+	if shiftStartedAt == nil {
+		return courier.Offline
+	}
+	for _, place := range places {
+		if place.OrderID != nil {
+			return courier.Busy
+		}
+	}
+	return courier.Available
+}
@@ -0,0 +1,101 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetCourierQueryHandlerTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	db       *gorm.DB
+	handler  queries.GetCourierQueryHandler
+	repo     *courierrepo.GormCourierRepository
+}
+
+func (suite *GetCourierQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&courierrepo.CourierDTO{}, &courierrepo.StoragePlaceDTO{}, &courierrepo.MaintenanceWindowDTO{},
+			&courierrepo.AbsenceDTO{},
+			&courierrepo.ScheduleWindowDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetCourierQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetCourierQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetCourierQueryHandler(suite.db)
+	suite.repo = courierrepo.NewGormCourierRepository(suite.db, &mockAggregateTracker{})
+}
+
+func (suite *GetCourierQueryHandlerTestSuite) TestHandle_ExistingCourier_ReturnsCourier() {
+	ctx := context.Background()
+	location, err := kernel.NewLocation(3, 4)
+	suite.Require().NoError(err)
+	c, err := courier.NewCourier(kernel.NewUUID(), "Alice", 3, location)
+	suite.Require().NoError(err)
+	suite.Require().NoError(c.AddStoragePlace("Backpack", 15))
+	limit, err := courier.NewWorkingHoursLimit(8*time.Hour, 30*time.Minute)
+	suite.Require().NoError(err)
+	suite.Require().NoError(c.StartShift(time.Now(), limit))
+	c.Insure()
+	suite.Require().NoError(suite.repo.Add(ctx, c))
+
+	query, err := queries.NewGetCourierQuery(c.ID())
+	suite.Require().NoError(err)
+
+	result, err := suite.handler.Handle(ctx, query)
+
+	suite.Require().NoError(err)
+	suite.Equal(c.ID(), result.ID)
+	suite.Equal("Alice", result.Name)
+	suite.Equal(3, result.Speed)
+	suite.Equal(location, result.Location)
+	suite.Equal(courier.Available, result.Status)
+	suite.True(result.Insured)
+	suite.Nil(result.ExternalID)
+	suite.Require().Len(result.StoragePlaces, 2)
+	suite.Equal("Backpack", result.StoragePlaces[0].Name)
+	suite.Equal(15, result.StoragePlaces[0].TotalVolume)
+}
+
+func (suite *GetCourierQueryHandlerTestSuite) TestHandle_UnknownCourier_ReturnsNotFound() {
+	query, err := queries.NewGetCourierQuery(kernel.NewUUID())
+	suite.Require().NoError(err)
+
+	_, err = suite.handler.Handle(context.Background(), query)
+
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+}
+
+func (suite *GetCourierQueryHandlerTestSuite) TestHandle_InvalidQuery_ReturnsError() {
+	_, err := suite.handler.Handle(context.Background(), queries.GetCourierQuery{})
+
+	suite.Require().ErrorIs(err, queries.ErrGetCourierQueryIsNotConstructed)
+}
+
+func TestGetCourierQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetCourierQueryHandlerTestSuite))
+}
@@ -0,0 +1,33 @@
+package queries_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetCourierQuery_Valid(t *testing.T) {
+	courierID := kernel.NewUUID()
+
+	query, err := queries.NewGetCourierQuery(courierID)
+
+	require.NoError(t, err)
+	require.NoError(t, query.Validate())
+	assert.Equal(t, courierID, query.CourierID())
+}
+
+func TestNewGetCourierQuery_InvalidCourierID(t *testing.T) {
+	_, err := queries.NewGetCourierQuery(kernel.UUID{})
+
+	require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+}
+
+func TestGetCourierQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetCourierQuery{}
+
+	require.ErrorIs(t, query.Validate(), queries.ErrGetCourierQueryIsNotConstructed)
+}
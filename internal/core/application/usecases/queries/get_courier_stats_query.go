@@ -0,0 +1,72 @@
+package queries
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrGetCourierStatsQueryIsNotConstructed = errors.New(
+		"GetCourierStatsQuery must be created via NewGetCourierStatsQuery constructor",
+	)
+)
+
+// GetCourierStatsQuery retrieves the delivery statistics of a courier and the badges the courier earned.
+// The statistics are a read model projected from the change log, so the latest delivery or rating
+// may show up a few seconds after it was committed.
+//
+// Example:
+//
+//	query, err := NewGetCourierStatsQuery(courierID)
+//	if err != nil {
+//	    return fmt.Errorf("invalid courier id: %w", err)
+//	}
+//
+//	stats, err := handler.Handle(ctx, query)
+//	for _, b := range stats.Badges {
+//	    fmt.Printf("%s since %s\n", b.Name, b.AwardedAt)
+//	}
+type GetCourierStatsQuery struct {
+	courierID kernel.UUID
+
+	guard guard.ConstructorGuard
+}
+
+// NewGetCourierStatsQuery creates a query for the statistics of the courier with the given ID.
+// Returns an error if the courier ID is invalid.
+func NewGetCourierStatsQuery(courierID kernel.UUID) (GetCourierStatsQuery, error) {
+	if err := courierID.Validate(); err != nil {
+		return GetCourierStatsQuery{}, err
+	}
+
+	return GetCourierStatsQuery{courierID: courierID, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetCourierStatsQueryIsNotConstructed if validation fails.
+func (q GetCourierStatsQuery) Validate() error {
+	return q.guard.Validate(ErrGetCourierStatsQueryIsNotConstructed)
+}
+
+// CourierID returns the ID of the courier whose statistics are requested.
+func (q GetCourierStatsQuery) CourierID() kernel.UUID {
+	return q.courierID
+}
+
+// GetCourierStatsQueryResponse is what a courier has achieved: the orders delivered, the customer
+// ratings of them and the badges earned, earliest first. AverageRating is nil without ratings.
+type GetCourierStatsQueryResponse struct {
+	Deliveries    int
+	Ratings       int
+	AverageRating *float64
+	Badges        []CourierBadge
+}
+
+// CourierBadge is a badge a courier earned and when.
+type CourierBadge struct {
+	Name      string
+	AwardedAt time.Time
+}
@@ -0,0 +1,97 @@
+package queries
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetCourierStatsQueryHandler retrieves courier statistics from the courier_deliveries and
+// courier_badges read models, which the courier badges projection maintains from the change log.
+//
+// Example:
+//
+//	handler := NewGetCourierStatsQueryHandler(db)
+//	stats, err := handler.Handle(ctx, query)
+//	if errors.Is(err, errs.ErrObjectNotFound) {
+//	    return nil, ErrCourierNotFound
+//	}
+type GetCourierStatsQueryHandler struct {
+	db *gorm.DB
+}
+
+// NewGetCourierStatsQueryHandler creates a handler for courier statistics queries.
+// Requires a GORM database connection for query execution.
+func NewGetCourierStatsQueryHandler(db *gorm.DB) GetCourierStatsQueryHandler {
+	return GetCourierStatsQueryHandler{db: db}
+}
+
+// Handle executes the query and returns the courier's statistics, all zero until the first
+// delivery is projected. Returns an ObjectNotFoundError if the courier does not exist.
+func (h GetCourierStatsQueryHandler) Handle(
+	ctx context.Context,
+	query GetCourierStatsQuery,
+) (GetCourierStatsQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetCourierStatsQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return GetCourierStatsQueryResponse{}, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return GetCourierStatsQueryResponse{}, err
+	}
+	defer release()
+
+	var id uuid.UUID
+	err = session.Raw(`SELECT id FROM couriers WHERE id = ?`, query.CourierID().Bytes()).Row().Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return GetCourierStatsQueryResponse{}, errs.NewObjectNotFoundError("courier", query.CourierID().String())
+		}
+		return GetCourierStatsQueryResponse{}, err
+	}
+
+	stats := GetCourierStatsQueryResponse{Badges: make([]CourierBadge, 0)}
+	err = session.Raw(`
+		SELECT COUNT(*), COUNT(rating_stars), AVG(rating_stars)::float8
+		FROM courier_deliveries
+		WHERE courier_id = ?
+	`, query.CourierID().Bytes()).Row().Scan(&stats.Deliveries, &stats.Ratings, &stats.AverageRating)
+	if err != nil {
+		return GetCourierStatsQueryResponse{}, err
+	}
+
+	rows, err := session.Raw(`
+		SELECT badge, awarded_at
+		FROM courier_badges
+		WHERE courier_id = ?
+		ORDER BY awarded_at, badge
+	`, query.CourierID().Bytes()).Rows()
+	if err != nil {
+		return GetCourierStatsQueryResponse{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var earned CourierBadge
+		if err = rows.Scan(&earned.Name, &earned.AwardedAt); err != nil {
+			return GetCourierStatsQueryResponse{}, err
+		}
+		stats.Badges = append(stats.Badges, earned)
+	}
+
+	if err = rows.Err(); err != nil {
+		return GetCourierStatsQueryResponse{}, err
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,143 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetCourierStatsQueryHandlerTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	db       *gorm.DB
+	handler  queries.GetCourierStatsQueryHandler
+}
+
+func (suite *GetCourierStatsQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&courierrepo.CourierDTO{}, &courierrepo.StoragePlaceDTO{}, &courierrepo.MaintenanceWindowDTO{},
+			&courierrepo.AbsenceDTO{},
+			&courierrepo.ScheduleWindowDTO{},
+			&postgres_adapter.CourierDeliveryDTO{},
+			&postgres_adapter.CourierBadgeDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetCourierStatsQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetCourierStatsQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetCourierStatsQueryHandler(suite.db)
+}
+
+func (suite *GetCourierStatsQueryHandlerTestSuite) TestHandle_ReturnsDeliveriesRatingsAndBadges() {
+	c := suite.addCourier()
+	now := time.Now().UTC().Truncate(time.Second)
+	suite.addDelivery(c.ID(), now.Add(-2*time.Hour), 5)
+	suite.addDelivery(c.ID(), now.Add(-time.Hour), 4)
+	suite.addDelivery(c.ID(), now, 0)
+	suite.addBadge(c.ID(), "hat-trick", now)
+	suite.addBadge(c.ID(), "first-delivery", now.Add(-2*time.Hour))
+
+	query, err := queries.NewGetCourierStatsQuery(c.ID())
+	suite.Require().NoError(err)
+	stats, err := suite.handler.Handle(context.Background(), query)
+
+	suite.Require().NoError(err)
+	suite.Equal(3, stats.Deliveries)
+	suite.Equal(2, stats.Ratings)
+	suite.Require().NotNil(stats.AverageRating)
+	suite.InDelta(4.5, *stats.AverageRating, 0.001)
+	suite.Require().Len(stats.Badges, 2)
+	suite.Equal("first-delivery", stats.Badges[0].Name)
+	suite.Equal("hat-trick", stats.Badges[1].Name)
+	suite.True(now.Equal(stats.Badges[1].AwardedAt))
+}
+
+func (suite *GetCourierStatsQueryHandlerTestSuite) TestHandle_NothingProjected_ReturnsEmptyStats() {
+	c := suite.addCourier()
+
+	query, err := queries.NewGetCourierStatsQuery(c.ID())
+	suite.Require().NoError(err)
+	stats, err := suite.handler.Handle(context.Background(), query)
+
+	suite.Require().NoError(err)
+	suite.Zero(stats.Deliveries)
+	suite.Nil(stats.AverageRating)
+	suite.NotNil(stats.Badges)
+	suite.Empty(stats.Badges)
+}
+
+func (suite *GetCourierStatsQueryHandlerTestSuite) TestHandle_CourierNotFound_ReturnsError() {
+	query, err := queries.NewGetCourierStatsQuery(kernel.NewUUID())
+	suite.Require().NoError(err)
+
+	_, err = suite.handler.Handle(context.Background(), query)
+
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+}
+
+func (suite *GetCourierStatsQueryHandlerTestSuite) TestHandle_InvalidQuery_ReturnsError() {
+	_, err := suite.handler.Handle(context.Background(), queries.GetCourierStatsQuery{})
+
+	suite.Require().ErrorIs(err, queries.ErrGetCourierStatsQueryIsNotConstructed)
+}
+
+func (suite *GetCourierStatsQueryHandlerTestSuite) addCourier() *courier.Courier {
+	location, err := kernel.NewLocation(5, 5)
+	suite.Require().NoError(err)
+	c, err := courier.NewCourier(kernel.NewUUID(), "Alice", 3, location)
+	suite.Require().NoError(err)
+
+	repo := courierrepo.NewGormCourierRepository(suite.db, &mockAggregateTracker{})
+	suite.Require().NoError(repo.Add(context.Background(), c))
+	return c
+}
+
+// addDelivery records a delivery of the courier, rated with the stars unless they are 0.
+func (suite *GetCourierStatsQueryHandlerTestSuite) addDelivery(courierID kernel.UUID, at time.Time, stars int) {
+	delivery := postgres_adapter.CourierDeliveryDTO{
+		OrderID:     uuid.New(),
+		CourierID:   courierID.Bytes(),
+		DeliveredAt: at,
+	}
+	if stars > 0 {
+		delivery.RatingStars, delivery.RatedAt = &stars, &at
+	}
+	suite.Require().NoError(suite.db.Create(&delivery).Error)
+}
+
+func (suite *GetCourierStatsQueryHandlerTestSuite) addBadge(courierID kernel.UUID, name string, at time.Time) {
+	suite.Require().NoError(suite.db.Create(&postgres_adapter.CourierBadgeDTO{
+		CourierID: courierID.Bytes(),
+		Badge:     name,
+		AwardedAt: at,
+		ChangeID:  1,
+	}).Error)
+}
+
+func TestGetCourierStatsQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetCourierStatsQueryHandlerTestSuite))
+}
@@ -0,0 +1,58 @@
+package queries
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrGetCourierWorkingHoursQueryIsNotConstructed = errors.New(
+		"GetCourierWorkingHoursQuery must be created via NewGetCourierWorkingHoursQuery constructor",
+	)
+)
+
+// GetCourierWorkingHoursQuery retrieves today's on-shift time of every active courier
+// for working hours compliance reporting.
+//
+// Example:
+//
+//	query := NewGetCourierWorkingHoursQuery()
+//	handler := NewGetCourierWorkingHoursQueryHandler(db, limit)
+//
+//	report, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return fmt.Errorf("failed to retrieve working hours: %w", err)
+//	}
+//
+//	for _, hours := range report {
+//	    fmt.Printf("%s worked %s (%s)\n", hours.Name, hours.Worked, hours.Status)
+//	}
+type GetCourierWorkingHoursQuery struct {
+	guard guard.ConstructorGuard
+}
+
+// NewGetCourierWorkingHoursQuery creates a query for the working hours report.
+func NewGetCourierWorkingHoursQuery() GetCourierWorkingHoursQuery {
+	return GetCourierWorkingHoursQuery{guard: guard.NewConstructorGuard()}
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetCourierWorkingHoursQueryIsNotConstructed if validation fails.
+func (q GetCourierWorkingHoursQuery) Validate() error {
+	return q.guard.Validate(ErrGetCourierWorkingHoursQueryIsNotConstructed)
+}
+
+// GetCourierWorkingHoursQueryResponse is a courier's line of the working hours report.
+// Worked includes the running shift; Status compares it with the daily limit.
+type GetCourierWorkingHoursQueryResponse struct {
+	ID      kernel.UUID
+	Name    string
+	Worked  time.Duration
+	Limit   time.Duration
+	OnShift bool
+	Status  courier.WorkingHoursStatus
+}
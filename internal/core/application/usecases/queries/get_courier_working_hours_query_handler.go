@@ -0,0 +1,120 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetCourierWorkingHoursQueryHandler reports how long active couriers worked today
+// compared with the daily working hours limit.
+//
+// Example:
+//
+//	handler := NewGetCourierWorkingHoursQueryHandler(db, limit)
+//	report, err := handler.Handle(ctx, NewGetCourierWorkingHoursQuery())
+//	if err != nil {
+//	    log.Printf("Failed to get working hours: %v", err)
+//	    return err
+//	}
+type GetCourierWorkingHoursQueryHandler struct {
+	db    *gorm.DB
+	limit courier.WorkingHoursLimit
+}
+
+// NewGetCourierWorkingHoursQueryHandler creates a handler for the working hours report.
+// Requires a GORM database connection and the daily working hours limit.
+func NewGetCourierWorkingHoursQueryHandler(
+	db *gorm.DB,
+	limit courier.WorkingHoursLimit,
+) GetCourierWorkingHoursQueryHandler {
+	return GetCourierWorkingHoursQueryHandler{db: db, limit: limit}
+}
+
+// Handle executes the query and returns the report sorted by courier name.
+// Deactivated couriers are not reported.
+func (h GetCourierWorkingHoursQueryHandler) Handle(
+	ctx context.Context,
+	query GetCourierWorkingHoursQuery,
+) ([]GetCourierWorkingHoursQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetCourierWorkingHoursQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+	if err := h.limit.Validate(); err != nil {
+		return nil, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	rows, err := session.Raw(`
+		SELECT
+			id,
+			name,
+			work_day,
+			worked_seconds,
+			shift_started_at
+		FROM couriers
+		WHERE deactivation_reason = 0
+		ORDER BY name
+	`).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	now := time.Now()
+	report := make([]GetCourierWorkingHoursQueryResponse, 0)
+	for rows.Next() {
+		var id uuid.UUID
+		var name string
+		var workDay, shiftStartedAt *time.Time
+		var workedSeconds int64
+
+		if err = rows.Scan(&id, &name, &workDay, &workedSeconds, &shiftStartedAt); err != nil {
+			return nil, err
+		}
+
+		courierID, idErr := kernel.UUIDFromBytes(id[:])
+		if idErr != nil {
+			return nil, idErr
+		}
+
+		var day time.Time
+		if workDay != nil {
+			day = *workDay
+		}
+		workLog, logErr := courier.RestoreWorkLog(day, time.Duration(workedSeconds)*time.Second, shiftStartedAt)
+		if logErr != nil {
+			return nil, logErr
+		}
+
+		worked := workLog.WorkedOn(now)
+		report = append(report, GetCourierWorkingHoursQueryResponse{
+			ID:      courierID,
+			Name:    name,
+			Worked:  worked,
+			Limit:   h.limit.Daily(),
+			OnShift: workLog.IsOnShift(),
+			Status:  h.limit.Assess(worked),
+		})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
@@ -0,0 +1,116 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetCourierWorkingHoursQueryHandlerTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	db       *gorm.DB
+	limit    courier.WorkingHoursLimit
+	handler  queries.GetCourierWorkingHoursQueryHandler
+}
+
+func (suite *GetCourierWorkingHoursQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&courierrepo.CourierDTO{}, &courierrepo.StoragePlaceDTO{}, &courierrepo.MaintenanceWindowDTO{},
+			&courierrepo.AbsenceDTO{},
+			&courierrepo.ScheduleWindowDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetCourierWorkingHoursQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetCourierWorkingHoursQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+
+	limit, err := courier.NewWorkingHoursLimit(8*time.Hour, 30*time.Minute)
+	suite.Require().NoError(err)
+	suite.limit = limit
+	suite.handler = queries.NewGetCourierWorkingHoursQueryHandler(suite.db, suite.limit)
+}
+
+func (suite *GetCourierWorkingHoursQueryHandlerTestSuite) TestHandle_ReportsWorkedTimeAndStatus() {
+	now := time.Now()
+	rested := suite.saveCourier("Alice", nil)
+	exhausted := suite.saveCourier("Bob", func(c *courier.Courier) {
+		workLog, err := courier.RestoreWorkLog(now, 8*time.Hour, nil)
+		suite.Require().NoError(err)
+		c.RestoreWorkLog(workLog)
+	})
+	suite.saveCourier("Charlie", func(c *courier.Courier) {
+		_, err := c.Deactivate(courier.Offboarded)
+		suite.Require().NoError(err)
+	})
+	onShift := suite.saveCourier("Dave", func(c *courier.Courier) {
+		suite.Require().NoError(c.StartShift(now, suite.limit))
+	})
+
+	report, err := suite.handler.Handle(context.Background(), queries.NewGetCourierWorkingHoursQuery())
+
+	suite.Require().NoError(err)
+	suite.Require().Len(report, 3)
+
+	suite.Equal(rested.ID(), report[0].ID)
+	suite.Zero(report[0].Worked)
+	suite.Equal(courier.WithinLimit, report[0].Status)
+
+	suite.Equal(exhausted.ID(), report[1].ID)
+	suite.Equal(8*time.Hour, report[1].Worked)
+	suite.Equal(8*time.Hour, report[1].Limit)
+	suite.Equal(courier.LimitReached, report[1].Status)
+
+	suite.Equal(onShift.ID(), report[2].ID)
+	suite.True(report[2].OnShift)
+	suite.Equal(courier.WithinLimit, report[2].Status)
+}
+
+func (suite *GetCourierWorkingHoursQueryHandlerTestSuite) TestHandle_InvalidQuery_ReturnsError() {
+	result, err := suite.handler.Handle(context.Background(), queries.GetCourierWorkingHoursQuery{})
+
+	suite.Require().ErrorIs(err, queries.ErrGetCourierWorkingHoursQueryIsNotConstructed)
+	suite.Nil(result)
+}
+
+func (suite *GetCourierWorkingHoursQueryHandlerTestSuite) saveCourier(
+	name string,
+	prepare func(c *courier.Courier),
+) *courier.Courier {
+	location, err := kernel.NewLocation(5, 5)
+	suite.Require().NoError(err)
+	c, err := courier.NewCourier(kernel.NewUUID(), name, 3, location)
+	suite.Require().NoError(err)
+	if prepare != nil {
+		prepare(c)
+	}
+
+	repo := courierrepo.NewGormCourierRepository(suite.db, &mockAggregateTracker{})
+	suite.Require().NoError(repo.Add(context.Background(), c))
+	return c
+}
+
+func TestGetCourierWorkingHoursQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetCourierWorkingHoursQueryHandlerTestSuite))
+}
@@ -0,0 +1,21 @@
+package queries_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/queries"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetCourierWorkingHoursQuery_Valid(t *testing.T) {
+	query := queries.NewGetCourierWorkingHoursQuery()
+
+	require.NoError(t, query.Validate())
+}
+
+func TestGetCourierWorkingHoursQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetCourierWorkingHoursQuery{}
+
+	require.ErrorIs(t, query.Validate(), queries.ErrGetCourierWorkingHoursQueryIsNotConstructed)
+}
@@ -0,0 +1,53 @@
+package queries
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/device"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrGetDeviceHealthQueryIsNotConstructed = errors.New(
+		"GetDeviceHealthQuery must be created via NewGetDeviceHealthQuery constructor",
+	)
+)
+
+// GetDeviceHealthQuery retrieves the current device health of every courier in service,
+// assessed from the telemetry readings of the rolling window.
+//
+// Example:
+//
+//	query := NewGetDeviceHealthQuery()
+//	handler := NewGetDeviceHealthQueryHandler(db, policy)
+//
+//	couriers, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return fmt.Errorf("failed to get device health: %w", err)
+//	}
+//	for _, c := range couriers {
+//	    fmt.Printf("%s: battery %d%%, degraded: %t\n", c.CourierName, c.Health.Battery, c.Health.IsDegraded())
+//	}
+type GetDeviceHealthQuery struct {
+	guard guard.ConstructorGuard
+}
+
+// NewGetDeviceHealthQuery creates a query for the device health of all couriers.
+func NewGetDeviceHealthQuery() GetDeviceHealthQuery {
+	return GetDeviceHealthQuery{guard: guard.NewConstructorGuard()}
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetDeviceHealthQueryIsNotConstructed if validation fails.
+func (q GetDeviceHealthQuery) Validate() error {
+	return q.guard.Validate(ErrGetDeviceHealthQueryIsNotConstructed)
+}
+
+// GetDeviceHealthQueryResponse represents the device of a courier in the read model.
+// Health is not Reported when the courier's app sent no telemetry within the window.
+type GetDeviceHealthQueryResponse struct {
+	CourierID   kernel.UUID
+	CourierName string
+	Health      device.Health
+}
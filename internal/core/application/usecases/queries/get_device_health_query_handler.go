@@ -0,0 +1,127 @@
+package queries
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"delivery/internal/core/domain/model/device"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetDeviceHealthQueryHandler retrieves the telemetry readings of the rolling window from
+// the database and assesses them with the device health policy.
+//
+// Example:
+//
+//	policy, _ := device.NewHealthPolicy(15, 10*time.Minute)
+//	handler := NewGetDeviceHealthQueryHandler(db, policy)
+//	couriers, err := handler.Handle(ctx, NewGetDeviceHealthQuery())
+//	if err != nil {
+//	    return err
+//	}
+type GetDeviceHealthQueryHandler struct {
+	db     *gorm.DB
+	policy device.HealthPolicy
+}
+
+// NewGetDeviceHealthQueryHandler creates a handler for device health queries assessing with policy.
+// Requires a GORM database connection for query execution.
+func NewGetDeviceHealthQueryHandler(db *gorm.DB, policy device.HealthPolicy) GetDeviceHealthQueryHandler {
+	return GetDeviceHealthQueryHandler{db: db, policy: policy}
+}
+
+// Handle executes the query to retrieve the device health of the couriers in service, by name.
+// Deactivated couriers are left out.
+func (h GetDeviceHealthQueryHandler) Handle(
+	ctx context.Context,
+	query GetDeviceHealthQuery,
+) ([]GetDeviceHealthQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetDeviceHealthQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	now := time.Now()
+	rows, err := session.Raw(`
+		SELECT c.id, c.name, r.battery, r.charging, r.connectivity, r.recorded_at
+		FROM couriers c
+		LEFT JOIN courier_device_readings r ON r.courier_id = c.id AND r.recorded_at >= ?
+		WHERE c.deactivation_reason = 0
+		ORDER BY c.name, c.id
+	`, h.policy.WindowStart(now)).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	couriers := make([]GetDeviceHealthQueryResponse, 0)
+	readings := make(map[kernel.UUID][]device.Reading)
+	for rows.Next() {
+		var (
+			id           uuid.UUID
+			name         string
+			battery      sql.NullInt64
+			charging     sql.NullBool
+			connectivity sql.NullString
+			recordedAt   sql.NullTime
+		)
+
+		if err = rows.Scan(&id, &name, &battery, &charging, &connectivity, &recordedAt); err != nil {
+			return nil, err
+		}
+
+		courierID, idErr := kernel.UUIDFromBytes(id[:])
+		if idErr != nil {
+			return nil, idErr
+		}
+		if len(couriers) == 0 || couriers[len(couriers)-1].CourierID != courierID {
+			couriers = append(couriers, GetDeviceHealthQueryResponse{CourierID: courierID, CourierName: name})
+		}
+		if !recordedAt.Valid {
+			continue
+		}
+
+		reading, readingErr := h.newReading(courierID, battery, charging, connectivity, recordedAt)
+		if readingErr != nil {
+			return nil, readingErr
+		}
+		readings[courierID] = append(readings[courierID], reading)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range couriers {
+		couriers[i].Health = h.policy.Assess(readings[couriers[i].CourierID], now)
+	}
+	return couriers, nil
+}
+
+// newReading restores a reading from the columns of courier_device_readings.
+func (h GetDeviceHealthQueryHandler) newReading(
+	courierID kernel.UUID,
+	battery sql.NullInt64,
+	charging sql.NullBool,
+	connectivity sql.NullString,
+	recordedAt sql.NullTime,
+) (device.Reading, error) {
+	parsed, err := device.ParseConnectivity(connectivity.String)
+	if err != nil {
+		return device.Reading{}, err
+	}
+	return device.NewReading(courierID, int(battery.Int64), charging.Bool, parsed, recordedAt.Time)
+}
@@ -0,0 +1,146 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/device"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetDeviceHealthQueryHandlerTestSuite struct {
+	suite.Suite
+	template  *pgtest.Template
+	db        *gorm.DB
+	policy    device.HealthPolicy
+	handler   queries.GetDeviceHealthQueryHandler
+	telemetry *postgres_adapter.GormDeviceTelemetryRepository
+}
+
+func (suite *GetDeviceHealthQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&courierrepo.CourierDTO{}, &courierrepo.StoragePlaceDTO{}, &courierrepo.MaintenanceWindowDTO{},
+			&courierrepo.AbsenceDTO{}, &courierrepo.ScheduleWindowDTO{}, &postgres_adapter.CourierDeviceReadingDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetDeviceHealthQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetDeviceHealthQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+
+	policy, err := device.NewHealthPolicy(15, 10*time.Minute)
+	suite.Require().NoError(err)
+	suite.policy = policy
+	suite.handler = queries.NewGetDeviceHealthQueryHandler(suite.db, suite.policy)
+	suite.telemetry = postgres_adapter.NewGormDeviceTelemetryRepository(suite.db)
+}
+
+func (suite *GetDeviceHealthQueryHandlerTestSuite) TestHandle_AssessesReadingsOfWindow() {
+	now := time.Now().Truncate(time.Second)
+	healthy := suite.saveCourier("Alice", nil)
+	drained := suite.saveCourier("Bob", nil)
+	silent := suite.saveCourier("Charlie", nil)
+	deactivated := suite.saveCourier("Dave", func(c *courier.Courier) {
+		_, err := c.Deactivate(courier.Offboarded)
+		suite.Require().NoError(err)
+	})
+
+	suite.record(healthy, 80, device.Good, now.Add(-time.Minute))
+	suite.record(drained, 60, device.Good, now.Add(-5*time.Minute))
+	suite.record(drained, 10, device.Poor, now.Add(-time.Minute))
+	suite.record(deactivated, 5, device.Offline, now.Add(-time.Minute))
+	// Readings of silent couriers that left the window are ignored
+	suite.Require().NoError(suite.telemetry.Add(context.Background(),
+		suite.reading(silent, 5, device.Offline, now.Add(-time.Hour)), now.Add(-2*time.Hour)))
+
+	report, err := suite.handler.Handle(context.Background(), queries.NewGetDeviceHealthQuery())
+
+	suite.Require().NoError(err)
+	suite.Require().Len(report, 3)
+
+	suite.Equal(healthy.ID(), report[0].CourierID)
+	suite.Equal("Alice", report[0].CourierName)
+	suite.True(report[0].Health.Reported)
+	suite.Equal(80, report[0].Health.Battery)
+	suite.Equal(device.Good, report[0].Health.Connectivity)
+	suite.True(now.Add(-time.Minute).Equal(report[0].Health.LastSeenAt))
+	suite.False(report[0].Health.IsDegraded())
+
+	suite.Equal(drained.ID(), report[1].CourierID)
+	suite.Equal(10, report[1].Health.Battery)
+	suite.True(report[1].Health.LowBattery)
+	suite.True(report[1].Health.PoorConnectivity)
+
+	suite.Equal(silent.ID(), report[2].CourierID)
+	suite.False(report[2].Health.Reported)
+	suite.False(report[2].Health.IsDegraded())
+}
+
+func (suite *GetDeviceHealthQueryHandlerTestSuite) TestHandle_InvalidQuery_ReturnsError() {
+	result, err := suite.handler.Handle(context.Background(), queries.GetDeviceHealthQuery{})
+
+	suite.Require().ErrorIs(err, queries.ErrGetDeviceHealthQueryIsNotConstructed)
+	suite.Nil(result)
+}
+
+func (suite *GetDeviceHealthQueryHandlerTestSuite) record(
+	c *courier.Courier,
+	battery int,
+	connectivity device.Connectivity,
+	recordedAt time.Time,
+) {
+	reading := suite.reading(c, battery, connectivity, recordedAt)
+	suite.Require().NoError(suite.telemetry.Add(context.Background(), reading, suite.policy.WindowStart(time.Now())))
+}
+
+func (suite *GetDeviceHealthQueryHandlerTestSuite) reading(
+	c *courier.Courier,
+	battery int,
+	connectivity device.Connectivity,
+	recordedAt time.Time,
+) device.Reading {
+	reading, err := device.NewReading(c.ID(), battery, false, connectivity, recordedAt)
+	suite.Require().NoError(err)
+	return reading
+}
+
+func (suite *GetDeviceHealthQueryHandlerTestSuite) saveCourier(
+	name string,
+	prepare func(c *courier.Courier),
+) *courier.Courier {
+	location, err := kernel.NewLocation(5, 5)
+	suite.Require().NoError(err)
+	c, err := courier.NewCourier(kernel.NewUUID(), name, 3, location)
+	suite.Require().NoError(err)
+	if prepare != nil {
+		prepare(c)
+	}
+
+	repo := courierrepo.NewGormCourierRepository(suite.db, &mockAggregateTracker{})
+	suite.Require().NoError(repo.Add(context.Background(), c))
+	return c
+}
+
+func TestGetDeviceHealthQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetDeviceHealthQueryHandlerTestSuite))
+}
@@ -0,0 +1,21 @@
+package queries_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/queries"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetDeviceHealthQuery_Valid(t *testing.T) {
+	query := queries.NewGetDeviceHealthQuery()
+
+	require.NoError(t, query.Validate())
+}
+
+func TestGetDeviceHealthQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetDeviceHealthQuery{}
+
+	require.ErrorIs(t, query.Validate(), queries.ErrGetDeviceHealthQueryIsNotConstructed)
+}
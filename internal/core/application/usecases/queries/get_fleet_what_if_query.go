@@ -0,0 +1,144 @@
+package queries
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+const (
+	// MaxWhatIfCouriers is the largest number of couriers a what-if analysis may add to the fleet.
+	MaxWhatIfCouriers = 100
+
+	// DefaultWhatIfPeriod is how much of the recent order history is replayed by default.
+	DefaultWhatIfPeriod = 24 * time.Hour
+
+	// MaxWhatIfPeriod is the longest order history a what-if analysis may replay.
+	MaxWhatIfPeriod = 7 * 24 * time.Hour
+)
+
+var (
+	ErrGetFleetWhatIfQueryIsNotConstructed = errors.New(
+		"GetFleetWhatIfQuery must be created via NewGetFleetWhatIfQuery constructor",
+	)
+)
+
+// GetFleetWhatIfQuery projects how the service would change if couriers were added to the fleet.
+// The orders that came in during the recent period are replayed against the couriers on shift,
+// as they are and with the added couriers placed in the zone.
+//
+// Example:
+//
+//	query, err := NewGetFleetWhatIfQuery(5, 2, nil, DefaultWhatIfPeriod)
+//	if err != nil {
+//	    return fmt.Errorf("invalid analysis: %w", err)
+//	}
+//
+//	analysis, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return fmt.Errorf("failed to analyze the fleet: %w", err)
+//	}
+//	fmt.Printf("%s faster on average\n", analysis.DeliveryTimeImprovement())
+type GetFleetWhatIfQuery struct {
+	couriers int
+	speed    int
+	zone     *kernel.Zone
+	period   time.Duration
+
+	guard guard.ConstructorGuard
+}
+
+// NewGetFleetWhatIfQuery creates a query for adding couriers of the given speed to the fleet.
+// The couriers are spread over the zone, or over the whole grid when zone is nil, and the
+// orders of the last period are replayed. Returns an error if the number of couriers is not
+// within 1 to MaxWhatIfCouriers, the speed is not positive, the zone is invalid, or the period
+// is not within a second to MaxWhatIfPeriod.
+func NewGetFleetWhatIfQuery(
+	couriers int,
+	speed int,
+	zone *kernel.Zone,
+	period time.Duration,
+) (GetFleetWhatIfQuery, error) {
+	var fields errs.ValidationErrors
+	if couriers < 1 || couriers > MaxWhatIfCouriers {
+		fields.Add("couriers", errs.NewValueIsOutOfRangeError("couriers", couriers, 1, MaxWhatIfCouriers))
+	}
+	if speed <= 0 {
+		fields.Add("speed", errs.NewValueIsInvalidErrorWithCause(
+			"speed is invalid",
+			fmt.Errorf("speed %d must be greater than 0", speed),
+		))
+	}
+	if zone != nil {
+		if err := zone.Validate(); err != nil {
+			fields.Add("zone", err)
+		}
+	}
+	if period < services.SimulationTick || period > MaxWhatIfPeriod {
+		fields.Add("period", errs.NewValueIsOutOfRangeError("period", period, services.SimulationTick, MaxWhatIfPeriod))
+	}
+	if err := fields.Err(); err != nil {
+		return GetFleetWhatIfQuery{}, err
+	}
+
+	return GetFleetWhatIfQuery{
+		couriers: couriers,
+		speed:    speed,
+		zone:     zone,
+		period:   period,
+		guard:    guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetFleetWhatIfQueryIsNotConstructed if validation fails.
+func (q GetFleetWhatIfQuery) Validate() error {
+	return q.guard.Validate(ErrGetFleetWhatIfQueryIsNotConstructed)
+}
+
+// Couriers returns the number of couriers to add.
+func (q GetFleetWhatIfQuery) Couriers() int {
+	return q.couriers
+}
+
+// Speed returns the speed of the couriers to add.
+func (q GetFleetWhatIfQuery) Speed() int {
+	return q.speed
+}
+
+// Zone returns the zone the couriers are added in, nil for the whole grid.
+func (q GetFleetWhatIfQuery) Zone() *kernel.Zone {
+	return q.zone
+}
+
+// Period returns how much of the recent order history is replayed.
+func (q GetFleetWhatIfQuery) Period() time.Duration {
+	return q.period
+}
+
+// GetFleetWhatIfQueryResponse compares the service of the current fleet with the service it
+// would give with the added couriers, over the same replayed orders.
+type GetFleetWhatIfQueryResponse struct {
+	From          time.Time
+	To            time.Time
+	AddedCouriers int
+	Speed         int
+	Zone          kernel.Zone
+	Baseline      services.SimulationOutcome
+	Projected     services.SimulationOutcome
+}
+
+// DeliveryTimeImprovement returns how much shorter the average delivery would be; negative if longer.
+func (r GetFleetWhatIfQueryResponse) DeliveryTimeImprovement() time.Duration {
+	return r.Baseline.AverageDeliveryTime - r.Projected.AverageDeliveryTime
+}
+
+// BacklogImprovement returns how many fewer orders would be waiting for a courier on average.
+func (r GetFleetWhatIfQueryResponse) BacklogImprovement() float64 {
+	return r.Baseline.AverageBacklog - r.Projected.AverageBacklog
+}
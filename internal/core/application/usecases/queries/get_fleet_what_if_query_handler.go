@@ -0,0 +1,217 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetFleetWhatIfQueryHandler projects the effect of adding couriers to the fleet by replaying
+// the recent orders with the fleet simulator.
+//
+// Example:
+//
+//	handler := NewGetFleetWhatIfQueryHandler(db)
+//	analysis, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return err
+//	}
+type GetFleetWhatIfQueryHandler struct {
+	db        *gorm.DB
+	simulator services.FleetSimulator
+}
+
+// NewGetFleetWhatIfQueryHandler creates a handler for fleet what-if analyses.
+// Requires a GORM database connection for query execution.
+func NewGetFleetWhatIfQueryHandler(db *gorm.DB) GetFleetWhatIfQueryHandler {
+	return GetFleetWhatIfQueryHandler{
+		db:        db,
+		simulator: services.NewFleetSimulator(services.NewOrderDispatcher()),
+	}
+}
+
+// Handle replays the orders created during the query's period up to now, leaving out synthetic
+// test data, against the couriers on shift who are not deactivated. The simulation starts them
+// where they are now and without orders, first as they are and then with the added couriers.
+func (h GetFleetWhatIfQueryHandler) Handle(
+	ctx context.Context,
+	query GetFleetWhatIfQuery,
+) (GetFleetWhatIfQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetFleetWhatIfQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return GetFleetWhatIfQueryResponse{}, err
+	}
+
+	zone, err := whatIfZone(query.Zone())
+	if err != nil {
+		return GetFleetWhatIfQueryResponse{}, err
+	}
+
+	to := time.Now().UTC().Truncate(services.SimulationTick)
+	from := to.Add(-query.Period())
+
+	arrivals, fleet, err := h.load(ctx, from, to)
+	if err != nil {
+		return GetFleetWhatIfQueryResponse{}, err
+	}
+
+	baselineFleet, err := newWhatIfFleet(fleet)
+	if err != nil {
+		return GetFleetWhatIfQueryResponse{}, err
+	}
+	baseline, err := h.simulator.Simulate(baselineFleet, arrivals, from, to)
+	if err != nil {
+		return GetFleetWhatIfQueryResponse{}, err
+	}
+
+	projectedFleet, err := newWhatIfFleet(append(fleet, spreadOverZone(zone, query.Couriers(), query.Speed())...))
+	if err != nil {
+		return GetFleetWhatIfQueryResponse{}, err
+	}
+	projected, err := h.simulator.Simulate(projectedFleet, arrivals, from, to)
+	if err != nil {
+		return GetFleetWhatIfQueryResponse{}, err
+	}
+
+	return GetFleetWhatIfQueryResponse{
+		From:          from,
+		To:            to,
+		AddedCouriers: query.Couriers(),
+		Speed:         query.Speed(),
+		Zone:          zone,
+		Baseline:      baseline,
+		Projected:     projected,
+	}, nil
+}
+
+// whatIfCourier is a courier of the current fleet as far as the simulation is concerned.
+type whatIfCourier struct {
+	speed    int
+	location kernel.Location
+}
+
+// load reads the order arrivals of [from, to) and the couriers on shift.
+// The reads are done before simulating, so the session is not held while the simulation runs.
+func (h GetFleetWhatIfQueryHandler) load(
+	ctx context.Context,
+	from time.Time,
+	to time.Time,
+) ([]services.Arrival, []whatIfCourier, error) {
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+
+	// Orders carry no timestamps of their own, so an order came in when its first change was logged
+	var arrivalRows []struct {
+		LocationX kernel.Coordinate
+		LocationY kernel.Coordinate
+		ChangedAt time.Time
+	}
+	if err = session.Raw(`
+		SELECT o.location_x, o.location_y, created.changed_at
+		FROM change_log created
+		JOIN orders o ON o.id = created.aggregate_id
+		WHERE created.aggregate_type = ? AND created.version = 1
+			AND created.changed_at >= ? AND created.changed_at < ?
+			AND o.synthetic_at IS NULL
+		ORDER BY created.changed_at, o.id
+	`, OrderChange, from, to).Scan(&arrivalRows).Error; err != nil {
+		return nil, nil, err
+	}
+
+	arrivals := make([]services.Arrival, 0, len(arrivalRows))
+	for _, row := range arrivalRows {
+		location, locationErr := kernel.NewLocation(row.LocationX, row.LocationY)
+		if locationErr != nil {
+			return nil, nil, locationErr
+		}
+		arrivals = append(arrivals, services.Arrival{At: row.ChangedAt.UTC(), Location: location})
+	}
+
+	var courierRows []struct {
+		ID        uuid.UUID
+		Speed     int
+		LocationX kernel.Coordinate
+		LocationY kernel.Coordinate
+	}
+	if err = session.Raw(`
+		SELECT id, speed, location_x, location_y
+		FROM couriers
+		WHERE shift_started_at IS NOT NULL AND deactivation_reason = ?
+		ORDER BY id
+	`, int(courier.NotDeactivated)).Scan(&courierRows).Error; err != nil {
+		return nil, nil, err
+	}
+
+	fleet := make([]whatIfCourier, 0, len(courierRows))
+	for _, row := range courierRows {
+		location, locationErr := kernel.NewLocation(row.LocationX, row.LocationY)
+		if locationErr != nil {
+			return nil, nil, fmt.Errorf("courier %s: %w", row.ID, locationErr)
+		}
+		fleet = append(fleet, whatIfCourier{speed: row.Speed, location: location})
+	}
+
+	return arrivals, fleet, nil
+}
+
+// whatIfZone returns the zone the couriers are added in, the whole grid when none is given.
+func whatIfZone(zone *kernel.Zone) (kernel.Zone, error) {
+	if zone != nil {
+		return *zone, nil
+	}
+
+	grid := kernel.Grid()
+	from, err := kernel.NewLocation(grid.MinX(), grid.MinY())
+	if err != nil {
+		return kernel.Zone{}, err
+	}
+	to, err := kernel.NewLocation(grid.MaxX(), grid.MaxY())
+	if err != nil {
+		return kernel.Zone{}, err
+	}
+	return kernel.NewZone(from, to)
+}
+
+// spreadOverZone places the couriers evenly over the cells of the zone, row by row.
+func spreadOverZone(zone kernel.Zone, couriers int, speed int) []whatIfCourier {
+	width := int(zone.To().X()-zone.From().X()) + 1
+	height := int(zone.To().Y()-zone.From().Y()) + 1
+	cells := width * height
+
+	added := make([]whatIfCourier, 0, couriers)
+	for i := range couriers {
+		cell := i * cells / couriers
+		location, _ := kernel.NewLocation(
+			zone.From().X()+kernel.Coordinate(cell%width), //nolint:gosec // within the zone
+			zone.From().Y()+kernel.Coordinate(cell/width), //nolint:gosec // within the zone
+		)
+		added = append(added, whatIfCourier{speed: speed, location: location})
+	}
+	return added
+}
+
+// newWhatIfFleet creates fresh couriers for a simulation, which moves and loads them.
+func newWhatIfFleet(fleet []whatIfCourier) ([]*courier.Courier, error) {
+	couriers := make([]*courier.Courier, 0, len(fleet))
+	for i, c := range fleet {
+		simulated, err := courier.NewCourier(kernel.NewUUID(), fmt.Sprintf("What-if courier %d", i+1), c.speed, c.location)
+		if err != nil {
+			return nil, err
+		}
+		couriers = append(couriers, simulated)
+	}
+	return couriers, nil
+}
@@ -0,0 +1,132 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/adapters/out/postgres/outboxrepo"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetFleetWhatIfQueryHandlerTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	db       *gorm.DB
+	handler  queries.GetFleetWhatIfQueryHandler
+	factory  ports.UnitOfWorkFactory
+}
+
+func (suite *GetFleetWhatIfQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		if err := db.AutoMigrate(
+			&orderrepo.OrderDTO{},
+			&orderrepo.OrderMessageDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{},
+			&orderrepo.OrderItemDTO{},
+			&courierrepo.CourierDTO{},
+			&courierrepo.StoragePlaceDTO{},
+			&courierrepo.MaintenanceWindowDTO{},
+			&courierrepo.AbsenceDTO{},
+			&courierrepo.ScheduleWindowDTO{},
+			&postgres_adapter.ChangeLogDTO{},
+			&postgres_adapter.CourierAvailabilityDTO{},
+			&outboxrepo.OutboxMessageDTO{},
+		); err != nil {
+			return err
+		}
+		return postgres_adapter.ApplySyntheticDataMarkers(db)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetFleetWhatIfQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetFleetWhatIfQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetFleetWhatIfQueryHandler(suite.db)
+	suite.factory = postgres_adapter.NewGormUnitOfWorkFactory(suite.db)
+}
+
+func (suite *GetFleetWhatIfQueryHandlerTestSuite) TestHandle_AddedCouriers_ImproveDeliveryTime() {
+	ctx := context.Background()
+	limit, err := courier.NewWorkingHoursLimit(8*time.Hour, 30*time.Minute)
+	suite.Require().NoError(err)
+
+	start, err := kernel.NewLocation(1, 1)
+	suite.Require().NoError(err)
+	onShift, err := courier.NewCourier(kernel.NewUUID(), "On Shift", 1, start)
+	suite.Require().NoError(err)
+	suite.Require().NoError(onShift.StartShift(time.Now(), limit))
+	offShift, err := courier.NewCourier(kernel.NewUUID(), "Off Shift", 5, start)
+	suite.Require().NoError(err)
+
+	destination, err := kernel.NewLocation(10, 10)
+	suite.Require().NoError(err)
+	o, err := order.NewOrder(kernel.NewUUID(), destination, 5)
+	suite.Require().NoError(err)
+
+	uow := suite.factory.Create()
+	suite.Require().NoError(uow.Begin(ctx))
+	suite.Require().NoError(uow.CourierRepository().Add(ctx, onShift))
+	suite.Require().NoError(uow.CourierRepository().Add(ctx, offShift))
+	suite.Require().NoError(uow.OrderRepository().Add(ctx, o))
+	suite.Require().NoError(uow.Commit(ctx))
+
+	// The order came in a minute ago, well within the replayed period
+	suite.Require().NoError(suite.db.Exec(
+		"UPDATE change_log SET changed_at = ? WHERE aggregate_id = ?",
+		time.Now().Add(-time.Minute).Truncate(time.Second), o.ID().Bytes(),
+	).Error)
+
+	query, err := queries.NewGetFleetWhatIfQuery(5, 5, nil, time.Hour)
+	suite.Require().NoError(err)
+	analysis, err := suite.handler.Handle(ctx, query)
+
+	suite.Require().NoError(err)
+	suite.Equal(time.Hour, analysis.To.Sub(analysis.From))
+	suite.Equal(5, analysis.AddedCouriers)
+	suite.Equal(1, analysis.Baseline.Couriers)
+	suite.Equal(1, analysis.Baseline.Orders)
+	suite.Equal(1, analysis.Baseline.Delivered)
+	suite.Equal(18*time.Second, analysis.Baseline.AverageDeliveryTime)
+	suite.Equal(6, analysis.Projected.Couriers)
+	suite.Equal(1, analysis.Projected.Delivered)
+	suite.Positive(analysis.DeliveryTimeImprovement())
+}
+
+func (suite *GetFleetWhatIfQueryHandlerTestSuite) TestHandle_NoHistory_ReportsNoOrders() {
+	query, err := queries.NewGetFleetWhatIfQuery(1, 1, nil, time.Hour)
+	suite.Require().NoError(err)
+
+	analysis, err := suite.handler.Handle(context.Background(), query)
+
+	suite.Require().NoError(err)
+	suite.Zero(analysis.Baseline.Couriers)
+	suite.Zero(analysis.Baseline.Orders)
+	suite.Equal(1, analysis.Projected.Couriers)
+	suite.Zero(analysis.DeliveryTimeImprovement())
+	suite.InDelta(0, analysis.BacklogImprovement(), 0)
+}
+
+func TestGetFleetWhatIfQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetFleetWhatIfQueryHandlerTestSuite))
+}
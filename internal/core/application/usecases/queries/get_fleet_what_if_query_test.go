@@ -0,0 +1,64 @@
+package queries_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetFleetWhatIfQuery_Valid(t *testing.T) {
+	from, err := kernel.NewLocation(1, 1)
+	require.NoError(t, err)
+	to, err := kernel.NewLocation(3, 3)
+	require.NoError(t, err)
+	zone, err := kernel.NewZone(from, to)
+	require.NoError(t, err)
+
+	query, err := queries.NewGetFleetWhatIfQuery(5, 2, &zone, 6*time.Hour)
+
+	require.NoError(t, err)
+	require.NoError(t, query.Validate())
+	assert.Equal(t, 5, query.Couriers())
+	assert.Equal(t, 2, query.Speed())
+	assert.Equal(t, &zone, query.Zone())
+	assert.Equal(t, 6*time.Hour, query.Period())
+}
+
+func TestNewGetFleetWhatIfQuery_WithoutZone(t *testing.T) {
+	query, err := queries.NewGetFleetWhatIfQuery(1, 1, nil, queries.DefaultWhatIfPeriod)
+
+	require.NoError(t, err)
+	assert.Nil(t, query.Zone())
+}
+
+func TestNewGetFleetWhatIfQuery_Invalid(t *testing.T) {
+	_, err := queries.NewGetFleetWhatIfQuery(0, 1, nil, time.Hour)
+	require.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+
+	_, err = queries.NewGetFleetWhatIfQuery(queries.MaxWhatIfCouriers+1, 1, nil, time.Hour)
+	require.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+
+	_, err = queries.NewGetFleetWhatIfQuery(1, 0, nil, time.Hour)
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+
+	_, err = queries.NewGetFleetWhatIfQuery(1, 1, &kernel.Zone{}, time.Hour)
+	require.ErrorIs(t, err, errs.ErrValueIsRequired)
+
+	_, err = queries.NewGetFleetWhatIfQuery(1, 1, nil, queries.MaxWhatIfPeriod+time.Hour)
+	require.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+
+	_, err = queries.NewGetFleetWhatIfQuery(1, 1, nil, 0)
+	require.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+}
+
+func TestGetFleetWhatIfQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetFleetWhatIfQuery{}
+
+	require.ErrorIs(t, query.Validate(), queries.ErrGetFleetWhatIfQueryIsNotConstructed)
+}
@@ -0,0 +1,51 @@
+package queries
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrGetFreeCouriersQueryIsNotConstructed = errors.New(
+		"GetFreeCouriersQuery must be created via NewGetFreeCouriersQuery constructor",
+	)
+)
+
+// GetFreeCouriersQuery retrieves the couriers who can take an order right now,
+// selected with the same rules the dispatcher uses.
+//
+// Example:
+//
+//	query := NewGetFreeCouriersQuery()
+//	handler := NewGetFreeCouriersQueryHandler(db)
+//
+//	couriers, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return fmt.Errorf("failed to retrieve free couriers: %w", err)
+//	}
+//
+//	fmt.Printf("%d couriers are free\n", len(couriers))
+type GetFreeCouriersQuery struct {
+	guard guard.ConstructorGuard
+}
+
+// NewGetFreeCouriersQuery creates a query for the free couriers.
+func NewGetFreeCouriersQuery() GetFreeCouriersQuery {
+	return GetFreeCouriersQuery{guard: guard.NewConstructorGuard()}
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetFreeCouriersQueryIsNotConstructed if validation fails.
+func (q GetFreeCouriersQuery) Validate() error {
+	return q.guard.Validate(ErrGetFreeCouriersQueryIsNotConstructed)
+}
+
+// GetFreeCouriersQueryResponse represents a free courier in the read model.
+type GetFreeCouriersQueryResponse struct {
+	ID       kernel.UUID
+	Name     string
+	Speed    int
+	Location kernel.Location
+}
@@ -0,0 +1,116 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetFreeCouriersQueryHandler retrieves free couriers from the database.
+// Couriers are free with the same rules as CourierRepository.GetAllFree: Available, carrying
+// nothing, assigned to no order, neither flagged for review nor deactivated, and neither in
+// vehicle maintenance nor absent. Rows still paused from before breaks became a status are on a break.
+//
+// Example:
+//
+//	handler := NewGetFreeCouriersQueryHandler(db)
+//	query := NewGetFreeCouriersQuery()
+//
+//	couriers, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    log.Printf("Failed to get free couriers: %v", err)
+//	    return err
+//	}
+type GetFreeCouriersQueryHandler struct {
+	db *gorm.DB
+}
+
+// NewGetFreeCouriersQueryHandler creates a handler for free courier queries.
+// Requires a GORM database connection for query execution.
+func NewGetFreeCouriersQueryHandler(db *gorm.DB) GetFreeCouriersQueryHandler {
+	return GetFreeCouriersQueryHandler{db: db}
+}
+
+// Handle executes the query to retrieve the free couriers sorted by name.
+func (h GetFreeCouriersQueryHandler) Handle(
+	ctx context.Context,
+	query GetFreeCouriersQuery,
+) ([]GetFreeCouriersQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetFreeCouriersQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	now := time.Now().UTC()
+	rows, err := session.Raw(`
+		SELECT
+			couriers.id,
+			couriers.name,
+			couriers.speed,
+			couriers.location_x,
+			couriers.location_y
+		FROM couriers
+		LEFT JOIN orders ON couriers.id = orders.courier_id AND orders.status = ?
+		WHERE orders.courier_id IS NULL
+		  AND (couriers.status = ? OR (couriers.status IS NULL AND couriers.shift_started_at IS NOT NULL))
+		  AND NOT EXISTS (
+		      SELECT 1 FROM storage_places sp
+		      WHERE sp.courier_id = couriers.id AND sp.order_id IS NOT NULL
+		  )
+		  AND couriers.paused = FALSE
+		  AND couriers.review_required = FALSE
+		  AND couriers.deactivation_reason = ?
+		  AND NOT EXISTS (
+		      SELECT 1 FROM courier_maintenance_windows w
+		      WHERE w.courier_id = couriers.id AND w.starts_at <= ? AND w.ends_at > ?
+		  )
+		  AND NOT EXISTS (
+		      SELECT 1 FROM courier_absences a
+		      WHERE a.courier_id = couriers.id AND a.starts_at <= ? AND a.ends_at > ?
+		  )
+		ORDER BY couriers.name, couriers.id
+	`,
+		int(order.Assigned), int(courier.Available), int(courier.NotDeactivated),
+		now, now, now, now,
+	).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	couriers := make([]GetFreeCouriersQueryResponse, 0)
+	for rows.Next() {
+		var c GetFreeCouriersQueryResponse
+		var id uuid.UUID
+		var locationX, locationY int8
+
+		if err = rows.Scan(&id, &c.Name, &c.Speed, &locationX, &locationY); err != nil {
+			return nil, err
+		}
+
+		if c.ID, err = kernel.UUIDFromBytes(id[:]); err != nil {
+			return nil, err
+		}
+		if c.Location, err = kernel.NewLocation(kernel.Coordinate(locationX), kernel.Coordinate(locationY)); err != nil {
+			return nil, err
+		}
+		couriers = append(couriers, c)
+	}
+
+	return couriers, rows.Err()
+}
@@ -0,0 +1,124 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/adapters/out/postgres/outboxrepo"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetFreeCouriersQueryHandlerTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	db       *gorm.DB
+	handler  queries.GetFreeCouriersQueryHandler
+	factory  ports.UnitOfWorkFactory
+}
+
+func (suite *GetFreeCouriersQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		if err := db.AutoMigrate(
+			&orderrepo.OrderDTO{},
+			&orderrepo.OrderMessageDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{},
+			&orderrepo.OrderItemDTO{},
+			&courierrepo.CourierDTO{},
+			&courierrepo.StoragePlaceDTO{},
+			&courierrepo.MaintenanceWindowDTO{},
+			&courierrepo.AbsenceDTO{},
+			&courierrepo.ScheduleWindowDTO{},
+			&postgres_adapter.ChangeLogDTO{},
+			&postgres_adapter.CourierAvailabilityDTO{},
+			&outboxrepo.OutboxMessageDTO{},
+		); err != nil {
+			return err
+		}
+		return postgres_adapter.ApplySyntheticDataMarkers(db)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetFreeCouriersQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetFreeCouriersQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetFreeCouriersQueryHandler(suite.db)
+	suite.factory = postgres_adapter.NewGormUnitOfWorkFactory(suite.db)
+}
+
+func (suite *GetFreeCouriersQueryHandlerTestSuite) TestHandle_ReturnsOnlyFreeCouriersOrderedByName() {
+	ctx := context.Background()
+	limit, err := courier.NewWorkingHoursLimit(8*time.Hour, 30*time.Minute)
+	suite.Require().NoError(err)
+	location, err := kernel.NewLocation(2, 2)
+	suite.Require().NoError(err)
+
+	bob, err := courier.NewCourier(kernel.NewUUID(), "Bob", 2, location)
+	suite.Require().NoError(err)
+	suite.Require().NoError(bob.StartShift(time.Now(), limit))
+	alice, err := courier.NewCourier(kernel.NewUUID(), "Alice", 3, location)
+	suite.Require().NoError(err)
+	suite.Require().NoError(alice.StartShift(time.Now(), limit))
+	busy, err := courier.NewCourier(kernel.NewUUID(), "Busy", 2, location)
+	suite.Require().NoError(err)
+	suite.Require().NoError(busy.StartShift(time.Now(), limit))
+	offShift, err := courier.NewCourier(kernel.NewUUID(), "Off Shift", 2, location)
+	suite.Require().NoError(err)
+
+	destination, err := kernel.NewLocation(9, 9)
+	suite.Require().NoError(err)
+	o, err := order.NewOrder(kernel.NewUUID(), destination, 5)
+	suite.Require().NoError(err)
+	suite.Require().NoError(o.Assign(busy.ID()))
+	suite.Require().NoError(busy.TakeOrder(o))
+
+	uow := suite.factory.Create()
+	suite.Require().NoError(uow.Begin(ctx))
+	for _, c := range []*courier.Courier{bob, alice, busy, offShift} {
+		suite.Require().NoError(uow.CourierRepository().Add(ctx, c))
+	}
+	suite.Require().NoError(uow.OrderRepository().Add(ctx, o))
+	suite.Require().NoError(uow.Commit(ctx))
+
+	result, err := suite.handler.Handle(ctx, queries.NewGetFreeCouriersQuery())
+
+	suite.Require().NoError(err)
+	suite.Require().Len(result, 2)
+	suite.Equal(alice.ID(), result[0].ID)
+	suite.Equal("Alice", result[0].Name)
+	suite.Equal(3, result[0].Speed)
+	suite.Equal(location, result[0].Location)
+	suite.Equal(bob.ID(), result[1].ID)
+}
+
+func (suite *GetFreeCouriersQueryHandlerTestSuite) TestHandle_EmptyDatabase_ReturnsEmptySlice() {
+	result, err := suite.handler.Handle(context.Background(), queries.NewGetFreeCouriersQuery())
+
+	suite.Require().NoError(err)
+	suite.NotNil(result)
+	suite.Empty(result)
+}
+
+func TestGetFreeCouriersQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetFreeCouriersQueryHandlerTestSuite))
+}
@@ -0,0 +1,21 @@
+package queries_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/queries"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetFreeCouriersQuery_Valid(t *testing.T) {
+	query := queries.NewGetFreeCouriersQuery()
+
+	require.NoError(t, query.Validate())
+}
+
+func TestGetFreeCouriersQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetFreeCouriersQuery{}
+
+	require.ErrorIs(t, query.Validate(), queries.ErrGetFreeCouriersQueryIsNotConstructed)
+}
@@ -0,0 +1,65 @@
+package queries
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrGetMatchingRulesQueryIsNotConstructed = errors.New(
+		"GetMatchingRulesQuery must be created via NewGetMatchingRulesQuery constructor",
+	)
+)
+
+// GetMatchingRulesQuery retrieves the dispatch matching rules, ordered by name.
+//
+// Example:
+//
+//	query := NewGetMatchingRulesQuery()
+//	handler := NewGetMatchingRulesQueryHandler(db)
+//
+//	rules, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return fmt.Errorf("failed to get matching rules: %w", err)
+//	}
+//	for _, r := range rules {
+//	    fmt.Printf("%s: %v\n", r.Name, r.VehicleTypes)
+//	}
+type GetMatchingRulesQuery struct {
+	guard guard.ConstructorGuard
+}
+
+// NewGetMatchingRulesQuery creates a query for the current matching rules.
+func NewGetMatchingRulesQuery() GetMatchingRulesQuery {
+	return GetMatchingRulesQuery{guard: guard.NewConstructorGuard()}
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetMatchingRulesQueryIsNotConstructed if validation fails.
+func (q GetMatchingRulesQuery) Validate() error {
+	return q.guard.Validate(ErrGetMatchingRulesQueryIsNotConstructed)
+}
+
+// GetMatchingRulesQueryResponse is a matching rule. Criteria the rule ignores are nil;
+// the zone is set as a whole or not at all.
+type GetMatchingRulesQueryResponse struct {
+	ID               kernel.UUID
+	Name             string
+	VolumeAbove      *int
+	TemperatureClass *order.TemperatureClass
+	Zone             *MatchingRuleZone
+	VehicleTypes     []courier.VehicleType
+	ThermalStorage   bool
+}
+
+// MatchingRuleZone is the part of the grid a matching rule applies to, borders included.
+type MatchingRuleZone struct {
+	FromX int
+	FromY int
+	ToX   int
+	ToY   int
+}
@@ -0,0 +1,106 @@
+package queries
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetMatchingRulesQueryHandler retrieves the current dispatch matching rules from the database.
+//
+// Example:
+//
+//	handler := NewGetMatchingRulesQueryHandler(db)
+//	rules, err := handler.Handle(ctx, NewGetMatchingRulesQuery())
+//	if err != nil {
+//	    return err
+//	}
+type GetMatchingRulesQueryHandler struct {
+	db *gorm.DB
+}
+
+// NewGetMatchingRulesQueryHandler creates a handler for matching rule queries.
+// Requires a GORM database connection for query execution.
+func NewGetMatchingRulesQueryHandler(db *gorm.DB) GetMatchingRulesQueryHandler {
+	return GetMatchingRulesQueryHandler{db: db}
+}
+
+// Handle executes the query to retrieve the rules ordered by name.
+// Returns an empty slice if no rules are configured.
+func (h GetMatchingRulesQueryHandler) Handle(
+	ctx context.Context,
+	query GetMatchingRulesQuery,
+) ([]GetMatchingRulesQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetMatchingRulesQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	rows, err := session.Raw(`
+		SELECT id, name, volume_above, temperature_class, zone_from_x, zone_from_y, zone_to_x, zone_to_y,
+			vehicle_types, thermal_storage
+		FROM matching_rules
+		ORDER BY name, id
+	`).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := make([]GetMatchingRulesQueryResponse, 0)
+	for rows.Next() {
+		var (
+			r                      GetMatchingRulesQueryResponse
+			id                     uuid.UUID
+			class                  *int
+			fromX, fromY, toX, toY *int
+			vehicleTypes           int
+		)
+
+		if err = rows.Scan(
+			&id, &r.Name, &r.VolumeAbove, &class, &fromX, &fromY, &toX, &toY, &vehicleTypes, &r.ThermalStorage,
+		); err != nil {
+			return nil, err
+		}
+
+		if r.ID, err = kernel.UUIDFromBytes(id[:]); err != nil {
+			return nil, err
+		}
+		if class != nil {
+			temperatureClass := order.TemperatureClass(*class)
+			r.TemperatureClass = &temperatureClass
+		}
+		if fromX != nil && fromY != nil && toX != nil && toY != nil {
+			r.Zone = &MatchingRuleZone{FromX: *fromX, FromY: *fromY, ToX: *toX, ToY: *toY}
+		}
+		// Allowed vehicle types are stored as a bit mask, see postgres.MatchingRuleDTO
+		r.VehicleTypes = make([]courier.VehicleType, 0)
+		for _, vehicleType := range courier.GetValidVehicleTypes() {
+			if vehicleTypes&(1<<vehicleType) != 0 {
+				r.VehicleTypes = append(r.VehicleTypes, vehicleType)
+			}
+		}
+
+		rules = append(rules, r)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,21 @@
+package queries_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/queries"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetMatchingRulesQuery_Valid(t *testing.T) {
+	query := queries.NewGetMatchingRulesQuery()
+
+	require.NoError(t, query.Validate())
+}
+
+func TestGetMatchingRulesQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetMatchingRulesQuery{}
+
+	require.ErrorIs(t, query.Validate(), queries.ErrGetMatchingRulesQueryIsNotConstructed)
+}
@@ -0,0 +1,58 @@
+package queries
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrGetMicrozonesQueryIsNotConstructed = errors.New(
+		"GetMicrozonesQuery must be created via NewGetMicrozonesQuery constructor",
+	)
+)
+
+// GetMicrozonesQuery retrieves the dense-demand microzones computed from the delivery history,
+// busiest first.
+//
+// Example:
+//
+//	query := NewGetMicrozonesQuery()
+//	handler := NewGetMicrozonesQueryHandler(db)
+//
+//	microzones, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return fmt.Errorf("failed to get microzones: %w", err)
+//	}
+//	for _, m := range microzones {
+//	    fmt.Printf("(%d,%d)-(%d,%d): %d deliveries\n", m.FromX, m.FromY, m.ToX, m.ToY, m.Deliveries)
+//	}
+type GetMicrozonesQuery struct {
+	guard guard.ConstructorGuard
+}
+
+// NewGetMicrozonesQuery creates a query for the current microzones.
+func NewGetMicrozonesQuery() GetMicrozonesQuery {
+	return GetMicrozonesQuery{guard: guard.NewConstructorGuard()}
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetMicrozonesQueryIsNotConstructed if validation fails.
+func (q GetMicrozonesQuery) Validate() error {
+	return q.guard.Validate(ErrGetMicrozonesQueryIsNotConstructed)
+}
+
+// GetMicrozonesQueryResponse is a microzone with its bounds, centroid and historical deliveries.
+type GetMicrozonesQueryResponse struct {
+	ID         kernel.UUID
+	FromX      int
+	FromY      int
+	ToX        int
+	ToY        int
+	CentroidX  int
+	CentroidY  int
+	Deliveries int
+	ComputedAt time.Time
+}
@@ -0,0 +1,86 @@
+package queries
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetMicrozonesQueryHandler retrieves the current microzones from the database.
+//
+// Example:
+//
+//	handler := NewGetMicrozonesQueryHandler(db)
+//	microzones, err := handler.Handle(ctx, NewGetMicrozonesQuery())
+//	if err != nil {
+//	    return err
+//	}
+type GetMicrozonesQueryHandler struct {
+	db *gorm.DB
+}
+
+// NewGetMicrozonesQueryHandler creates a handler for microzone queries.
+// Requires a GORM database connection for query execution.
+func NewGetMicrozonesQueryHandler(db *gorm.DB) GetMicrozonesQueryHandler {
+	return GetMicrozonesQueryHandler{db: db}
+}
+
+// Handle executes the query to retrieve the microzones, busiest first.
+// Returns an empty slice if none were computed or the delivery history has no dense demand.
+func (h GetMicrozonesQueryHandler) Handle(
+	ctx context.Context,
+	query GetMicrozonesQuery,
+) ([]GetMicrozonesQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetMicrozonesQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	rows, err := session.Raw(`
+		SELECT id, from_x, from_y, to_x, to_y, centroid_x, centroid_y, deliveries, computed_at
+		FROM microzones
+		ORDER BY deliveries DESC, from_x, from_y
+	`).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	microzones := make([]GetMicrozonesQueryResponse, 0)
+	for rows.Next() {
+		var (
+			m  GetMicrozonesQueryResponse
+			id uuid.UUID
+		)
+
+		if err = rows.Scan(
+			&id, &m.FromX, &m.FromY, &m.ToX, &m.ToY, &m.CentroidX, &m.CentroidY, &m.Deliveries, &m.ComputedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		if m.ID, err = kernel.UUIDFromBytes(id[:]); err != nil {
+			return nil, err
+		}
+
+		microzones = append(microzones, m)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return microzones, nil
+}
@@ -0,0 +1,100 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/microzone"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetMicrozonesQueryHandlerTestSuite struct {
+	suite.Suite
+	template   *pgtest.Template
+	db         *gorm.DB
+	handler    queries.GetMicrozonesQueryHandler
+	repository *postgres_adapter.GormMicrozoneRepository
+}
+
+func (suite *GetMicrozonesQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(&postgres_adapter.MicrozoneDTO{})
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetMicrozonesQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetMicrozonesQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetMicrozonesQueryHandler(suite.db)
+	suite.repository = postgres_adapter.NewGormMicrozoneRepository(suite.db)
+}
+
+func (suite *GetMicrozonesQueryHandlerTestSuite) TestHandle_ReturnsLatestMicrozonesBusiestFirst() {
+	ctx := context.Background()
+	clustering, err := microzone.NewClustering(1, 10)
+	suite.Require().NoError(err)
+
+	stale, err := clustering.Cluster([]microzone.DeliveryPoint{suite.point(5, 5, 40)}, time.Now())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.repository.ReplaceAll(ctx, stale))
+
+	computedAt := time.Now().Truncate(time.Second)
+	current, err := clustering.Cluster([]microzone.DeliveryPoint{
+		suite.point(2, 2, 6), suite.point(3, 2, 6),
+		suite.point(8, 8, 30),
+	}, computedAt)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.repository.ReplaceAll(ctx, current))
+
+	microzones, err := suite.handler.Handle(ctx, queries.NewGetMicrozonesQuery())
+
+	suite.Require().NoError(err)
+	suite.Require().Len(microzones, 2)
+	suite.Equal(current[0].ID(), microzones[0].ID)
+	suite.Equal(30, microzones[0].Deliveries)
+	suite.Equal(current[1].ID(), microzones[1].ID)
+	suite.Equal([]int{2, 2, 3, 2}, []int{microzones[1].FromX, microzones[1].FromY, microzones[1].ToX, microzones[1].ToY})
+	suite.Equal([]int{3, 2}, []int{microzones[1].CentroidX, microzones[1].CentroidY})
+	suite.Equal(12, microzones[1].Deliveries)
+	suite.True(computedAt.Equal(microzones[1].ComputedAt))
+
+	stored, err := suite.repository.GetAll(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(stored, 2)
+	suite.Equal(current[0].ID(), stored[0].ID())
+	suite.Equal(current[1].Bounds().String(), stored[1].Bounds().String())
+}
+
+func (suite *GetMicrozonesQueryHandlerTestSuite) TestHandle_NoMicrozones_ReturnsEmptySlice() {
+	microzones, err := suite.handler.Handle(context.Background(), queries.NewGetMicrozonesQuery())
+
+	suite.Require().NoError(err)
+	suite.NotNil(microzones)
+	suite.Empty(microzones)
+}
+
+func (suite *GetMicrozonesQueryHandlerTestSuite) point(x, y kernel.Coordinate, deliveries int) microzone.DeliveryPoint {
+	location, err := kernel.NewLocation(x, y)
+	suite.Require().NoError(err)
+	return microzone.DeliveryPoint{Location: location, Deliveries: deliveries}
+}
+
+func TestGetMicrozonesQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetMicrozonesQueryHandlerTestSuite))
+}
@@ -0,0 +1,21 @@
+package queries_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/queries"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetMicrozonesQuery_Valid(t *testing.T) {
+	query := queries.NewGetMicrozonesQuery()
+
+	require.NoError(t, query.Validate())
+}
+
+func TestGetMicrozonesQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetMicrozonesQuery{}
+
+	require.ErrorIs(t, query.Validate(), queries.ErrGetMicrozonesQueryIsNotConstructed)
+}
@@ -0,0 +1,79 @@
+package queries
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrGetOrderByExternalReferenceQueryIsNotConstructed = errors.New(
+		"GetOrderByExternalReferenceQuery must be created via NewGetOrderByExternalReferenceQuery constructor",
+	)
+)
+
+// GetOrderByExternalReferenceQuery finds the order that fulfils a marketplace order,
+// so partner systems can correlate their orders with deliveries.
+//
+// Example:
+//
+//	query, err := NewGetOrderByExternalReferenceQuery("ozon", "48213377-0021")
+//	if err != nil {
+//	    return fmt.Errorf("invalid reference: %w", err)
+//	}
+//
+//	linked, err := handler.Handle(ctx, query)
+//	if errors.Is(err, errs.ErrObjectNotFound) {
+//	    // No order fulfils the marketplace order
+//	}
+type GetOrderByExternalReferenceQuery struct {
+	marketplace     string
+	externalOrderID string
+
+	guard guard.ConstructorGuard
+}
+
+// NewGetOrderByExternalReferenceQuery creates a query for the order that fulfils the order
+// externalOrderID on the marketplace.
+// Returns an error if the marketplace or the external order ID is invalid.
+func NewGetOrderByExternalReferenceQuery(marketplace, externalOrderID string) (GetOrderByExternalReferenceQuery, error) {
+	ref, err := order.NewExternalReference(marketplace, externalOrderID, "")
+	if err != nil {
+		return GetOrderByExternalReferenceQuery{}, err
+	}
+
+	return GetOrderByExternalReferenceQuery{
+		marketplace:     ref.Marketplace(),
+		externalOrderID: ref.OrderID(),
+		guard:           guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetOrderByExternalReferenceQueryIsNotConstructed if validation fails.
+func (q GetOrderByExternalReferenceQuery) Validate() error {
+	return q.guard.Validate(ErrGetOrderByExternalReferenceQueryIsNotConstructed)
+}
+
+// Marketplace returns the slug of the marketplace.
+func (q GetOrderByExternalReferenceQuery) Marketplace() string {
+	return q.marketplace
+}
+
+// ExternalOrderID returns the ID of the order on the marketplace.
+func (q GetOrderByExternalReferenceQuery) ExternalOrderID() string {
+	return q.externalOrderID
+}
+
+// GetOrderByExternalReferenceQueryResponse represents an order linked to a marketplace order.
+// CourierID is nil while no courier is delivering the order; DeepLink is empty if none was given.
+type GetOrderByExternalReferenceQueryResponse struct {
+	ID              kernel.UUID
+	Status          order.Status
+	CourierID       *kernel.UUID
+	Marketplace     string
+	ExternalOrderID string
+	DeepLink        string
+}
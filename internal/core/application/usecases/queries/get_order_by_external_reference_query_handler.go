@@ -0,0 +1,102 @@
+package queries
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetOrderByExternalReferenceQueryHandler retrieves orders by their marketplace order from the database.
+//
+// Example:
+//
+//	handler := NewGetOrderByExternalReferenceQueryHandler(db)
+//	query, _ := NewGetOrderByExternalReferenceQuery("ozon", "48213377-0021")
+//
+//	linked, err := handler.Handle(ctx, query)
+//	if errors.Is(err, errs.ErrObjectNotFound) {
+//	    // No order fulfils the marketplace order
+//	}
+type GetOrderByExternalReferenceQueryHandler struct {
+	db *gorm.DB
+}
+
+// NewGetOrderByExternalReferenceQueryHandler creates a handler for marketplace order lookups.
+// Requires a GORM database connection for query execution.
+func NewGetOrderByExternalReferenceQueryHandler(db *gorm.DB) GetOrderByExternalReferenceQueryHandler {
+	return GetOrderByExternalReferenceQueryHandler{db: db}
+}
+
+// Handle executes the query to retrieve the order that fulfils the marketplace order, in any status.
+// Returns an ObjectNotFoundError if no order of the tenant is linked to it.
+func (h GetOrderByExternalReferenceQueryHandler) Handle(
+	ctx context.Context,
+	query GetOrderByExternalReferenceQuery,
+) (GetOrderByExternalReferenceQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetOrderByExternalReferenceQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return GetOrderByExternalReferenceQueryResponse{}, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return GetOrderByExternalReferenceQueryResponse{}, err
+	}
+	defer release()
+
+	var id uuid.UUID
+	var status int
+	var courierID *uuid.UUID
+	var deepLink *string
+	err = session.Raw(`
+		SELECT
+			id,
+			status,
+			courier_id,
+			external_deep_link
+		FROM orders
+		WHERE external_marketplace = ? AND external_order_id = ?
+	`, query.Marketplace(), query.ExternalOrderID()).Row().Scan(&id, &status, &courierID, &deepLink)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return GetOrderByExternalReferenceQueryResponse{}, errs.NewObjectNotFoundError(
+				"order", query.Marketplace()+"/"+query.ExternalOrderID(),
+			)
+		}
+		return GetOrderByExternalReferenceQueryResponse{}, err
+	}
+
+	orderID, err := kernel.UUIDFromBytes(id[:])
+	if err != nil {
+		return GetOrderByExternalReferenceQueryResponse{}, err
+	}
+
+	response := GetOrderByExternalReferenceQueryResponse{
+		ID:              orderID,
+		Status:          order.Status(status),
+		Marketplace:     query.Marketplace(),
+		ExternalOrderID: query.ExternalOrderID(),
+	}
+	if courierID != nil {
+		cID, courierErr := kernel.UUIDFromBytes(courierID[:])
+		if courierErr != nil {
+			return GetOrderByExternalReferenceQueryResponse{}, courierErr
+		}
+		response.CourierID = &cID
+	}
+	if deepLink != nil {
+		response.DeepLink = *deepLink
+	}
+
+	return response, nil
+}
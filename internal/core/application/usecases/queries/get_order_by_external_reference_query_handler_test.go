@@ -0,0 +1,101 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetOrderByExternalReferenceQueryHandlerTestSuite struct {
+	suite.Suite
+	template  *pgtest.Template
+	db        *gorm.DB
+	handler   queries.GetOrderByExternalReferenceQueryHandler
+	orderRepo *orderrepo.GormOrderRepository
+}
+
+func (suite *GetOrderByExternalReferenceQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&orderrepo.OrderDTO{}, &orderrepo.OrderMessageDTO{}, &orderrepo.OrderItemDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetOrderByExternalReferenceQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetOrderByExternalReferenceQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetOrderByExternalReferenceQueryHandler(suite.db)
+	suite.orderRepo = orderrepo.NewGormOrderRepository(suite.db, &mockAggregateTracker{})
+}
+
+func (suite *GetOrderByExternalReferenceQueryHandlerTestSuite) TestHandle_LinkedOrder_ReturnsOrder() {
+	ctx := context.Background()
+	courierID := kernel.NewUUID()
+	o := suite.createOrder("ozon", "48213377-0021", "https://ozon.ru/orders/48213377-0021")
+	suite.Require().NoError(o.Assign(courierID))
+	suite.Require().NoError(suite.orderRepo.Add(ctx, o))
+	suite.Require().NoError(suite.orderRepo.Add(ctx, suite.createOrder("wildberries", "48213377-0021", "")))
+
+	query, err := queries.NewGetOrderByExternalReferenceQuery("ozon", "48213377-0021")
+	suite.Require().NoError(err)
+
+	result, err := suite.handler.Handle(ctx, query)
+
+	suite.Require().NoError(err)
+	suite.Equal(o.ID(), result.ID)
+	suite.Equal(order.Assigned, result.Status)
+	suite.Require().NotNil(result.CourierID)
+	suite.Equal(courierID, *result.CourierID)
+	suite.Equal("ozon", result.Marketplace)
+	suite.Equal("48213377-0021", result.ExternalOrderID)
+	suite.Equal("https://ozon.ru/orders/48213377-0021", result.DeepLink)
+}
+
+func (suite *GetOrderByExternalReferenceQueryHandlerTestSuite) TestHandle_UnknownReference_ReturnsNotFound() {
+	ctx := context.Background()
+	suite.Require().NoError(suite.orderRepo.Add(ctx, suite.createOrder("ozon", "48213377-0021", "")))
+
+	query, err := queries.NewGetOrderByExternalReferenceQuery("ozon", "48213377-0022")
+	suite.Require().NoError(err)
+
+	_, err = suite.handler.Handle(ctx, query)
+
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+}
+
+func (suite *GetOrderByExternalReferenceQueryHandlerTestSuite) createOrder(
+	marketplace, externalOrderID, deepLink string,
+) *order.Order {
+	location, err := kernel.NewLocation(3, 4)
+	suite.Require().NoError(err)
+	o, err := order.NewOrder(kernel.NewUUID(), location, 10)
+	suite.Require().NoError(err)
+	ref, err := order.NewExternalReference(marketplace, externalOrderID, deepLink)
+	suite.Require().NoError(err)
+	suite.Require().NoError(o.LinkExternalReference(ref))
+	return o
+}
+
+func TestGetOrderByExternalReferenceQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetOrderByExternalReferenceQueryHandlerTestSuite))
+}
@@ -0,0 +1,34 @@
+package queries_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetOrderByExternalReferenceQuery_Valid(t *testing.T) {
+	query, err := queries.NewGetOrderByExternalReferenceQuery("ozon", " 48213377-0021 ")
+
+	require.NoError(t, err)
+	require.NoError(t, query.Validate())
+	assert.Equal(t, "ozon", query.Marketplace())
+	assert.Equal(t, "48213377-0021", query.ExternalOrderID())
+}
+
+func TestNewGetOrderByExternalReferenceQuery_InvalidReference(t *testing.T) {
+	_, err := queries.NewGetOrderByExternalReferenceQuery("Ozon", "")
+
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	require.ErrorIs(t, err, errs.ErrValueIsRequired)
+}
+
+func TestGetOrderByExternalReferenceQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetOrderByExternalReferenceQuery{}
+	err := query.Validate()
+	require.Error(t, err)
+	assert.ErrorIs(t, err, queries.ErrGetOrderByExternalReferenceQueryIsNotConstructed)
+}
@@ -0,0 +1,67 @@
+package queries
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrGetOrderETAQueryIsNotConstructed = errors.New(
+		"GetOrderETAQuery must be created via NewGetOrderETAQuery constructor",
+	)
+)
+
+// GetOrderETAQuery estimates when the courier delivering an order reaches the customer,
+// from where the courier is right now. Unlike RecalculateETACommand it stores nothing.
+//
+// Example:
+//
+//	query, err := NewGetOrderETAQuery(orderID)
+//	if err != nil {
+//	    return fmt.Errorf("invalid order ID: %w", err)
+//	}
+//
+//	eta, err := handler.Handle(ctx, query)
+//	if errors.Is(err, order.ErrOrderIsNotAssigned) {
+//	    // No courier is delivering the order
+//	}
+type GetOrderETAQuery struct {
+	orderID kernel.UUID
+
+	guard guard.ConstructorGuard
+}
+
+// NewGetOrderETAQuery creates a query for the delivery estimate of the order with the given ID.
+// Returns an error if the ID was not constructed.
+func NewGetOrderETAQuery(orderID kernel.UUID) (GetOrderETAQuery, error) {
+	if err := orderID.Validate(); err != nil {
+		return GetOrderETAQuery{}, err
+	}
+
+	return GetOrderETAQuery{orderID: orderID, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetOrderETAQueryIsNotConstructed if validation fails.
+func (q GetOrderETAQuery) Validate() error {
+	return q.guard.Validate(ErrGetOrderETAQueryIsNotConstructed)
+}
+
+// OrderID returns the ID of the order to estimate.
+func (q GetOrderETAQuery) OrderID() kernel.UUID {
+	return q.orderID
+}
+
+// GetOrderETAQueryResponse represents the delivery estimate of an assigned order.
+// Turns is the courier's travel time to the delivery location rounded up to whole turns;
+// ArrivesAt is CalculatedAt plus that many courier turns.
+type GetOrderETAQueryResponse struct {
+	OrderID      kernel.UUID
+	CourierID    kernel.UUID
+	Turns        int
+	CalculatedAt time.Time
+	ArrivesAt    time.Time
+}
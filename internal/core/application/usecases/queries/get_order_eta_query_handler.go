@@ -0,0 +1,135 @@
+package queries
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetOrderETAQueryHandler estimates delivery times of assigned orders from the database.
+// The order is read together with its courier, and the travel time is calculated by
+// courier.Courier.CalculateTimeToLocation, like RecalculateETACommandHandler does.
+//
+// Example:
+//
+//	handler := NewGetOrderETAQueryHandler(db, time.Second)
+//	query, _ := NewGetOrderETAQuery(orderID)
+//
+//	eta, err := handler.Handle(ctx, query)
+//	if err == nil {
+//	    fmt.Printf("Arriving in %d turns at %s\n", eta.Turns, eta.ArrivesAt)
+//	}
+type GetOrderETAQueryHandler struct {
+	db *gorm.DB
+
+	// turn is the wall-clock length of a courier turn
+	turn time.Duration
+}
+
+// NewGetOrderETAQueryHandler creates a handler for delivery estimates.
+// Requires a GORM database connection for query execution and the length of a courier turn.
+func NewGetOrderETAQueryHandler(db *gorm.DB, turn time.Duration) GetOrderETAQueryHandler {
+	return GetOrderETAQueryHandler{db: db, turn: turn}
+}
+
+// Handle executes the query to estimate the delivery of a single order.
+// Returns an ObjectNotFoundError if the order or its courier does not exist and
+// order.ErrOrderIsNotAssigned if no courier is delivering the order.
+func (h GetOrderETAQueryHandler) Handle(ctx context.Context, query GetOrderETAQuery) (GetOrderETAQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetOrderETAQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return GetOrderETAQueryResponse{}, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return GetOrderETAQueryResponse{}, err
+	}
+	defer release()
+
+	var (
+		status             int
+		orderX, orderY     kernel.Coordinate
+		courierID          *uuid.UUID
+		courierName        sql.NullString
+		courierSpeed       sql.NullInt32
+		courierX, courierY sql.NullInt16
+	)
+	err = session.Raw(`
+		SELECT
+			o.status,
+			o.location_x,
+			o.location_y,
+			o.courier_id,
+			c.name,
+			c.speed,
+			c.location_x,
+			c.location_y
+		FROM orders o
+		LEFT JOIN couriers c ON c.id = o.courier_id
+		WHERE o.id = ?
+	`, query.OrderID().String()).Row().Scan(
+		&status, &orderX, &orderY, &courierID, &courierName, &courierSpeed, &courierX, &courierY,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return GetOrderETAQueryResponse{}, errs.NewObjectNotFoundError("order", query.OrderID().String())
+		}
+		return GetOrderETAQueryResponse{}, err
+	}
+
+	if order.Status(status) != order.Assigned || courierID == nil {
+		return GetOrderETAQueryResponse{}, order.ErrOrderIsNotAssigned
+	}
+	if !courierSpeed.Valid || !courierX.Valid || !courierY.Valid {
+		return GetOrderETAQueryResponse{}, errs.NewObjectNotFoundError("courier", courierID.String())
+	}
+
+	restoredCourierID, err := kernel.UUIDFromBytes(courierID[:])
+	if err != nil {
+		return GetOrderETAQueryResponse{}, err
+	}
+
+	destination, err := kernel.NewLocation(orderX, orderY)
+	if err != nil {
+		return GetOrderETAQueryResponse{}, err
+	}
+
+	position, err := kernel.NewLocation(kernel.Coordinate(courierX.Int16), kernel.Coordinate(courierY.Int16))
+	if err != nil {
+		return GetOrderETAQueryResponse{}, err
+	}
+
+	c, err := courier.NewCourier(restoredCourierID, courierName.String, int(courierSpeed.Int32), position)
+	if err != nil {
+		return GetOrderETAQueryResponse{}, err
+	}
+
+	travelTime, err := c.CalculateTimeToLocation(destination)
+	if err != nil {
+		return GetOrderETAQueryResponse{}, err
+	}
+
+	turns := int(math.Ceil(travelTime))
+	now := time.Now().UTC()
+	return GetOrderETAQueryResponse{
+		OrderID:      query.OrderID(),
+		CourierID:    restoredCourierID,
+		Turns:        turns,
+		CalculatedAt: now,
+		ArrivesAt:    now.Add(time.Duration(turns) * h.turn),
+	}, nil
+}
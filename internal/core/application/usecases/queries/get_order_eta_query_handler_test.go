@@ -0,0 +1,120 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetOrderETAQueryHandlerTestSuite struct {
+	suite.Suite
+	template    *pgtest.Template
+	db          *gorm.DB
+	handler     queries.GetOrderETAQueryHandler
+	orderRepo   *orderrepo.GormOrderRepository
+	courierRepo *courierrepo.GormCourierRepository
+}
+
+func (suite *GetOrderETAQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&orderrepo.OrderDTO{},
+			&orderrepo.OrderMessageDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{},
+			&orderrepo.OrderItemDTO{},
+			&courierrepo.CourierDTO{},
+			&courierrepo.StoragePlaceDTO{},
+			&courierrepo.MaintenanceWindowDTO{},
+			&courierrepo.AbsenceDTO{},
+			&courierrepo.ScheduleWindowDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetOrderETAQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetOrderETAQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetOrderETAQueryHandler(suite.db, time.Second)
+	suite.orderRepo = orderrepo.NewGormOrderRepository(suite.db, &mockAggregateTracker{})
+	suite.courierRepo = courierrepo.NewGormCourierRepository(suite.db, &mockAggregateTracker{})
+}
+
+func (suite *GetOrderETAQueryHandlerTestSuite) TestHandle_AssignedOrder_ReturnsETAFromCourierPosition() {
+	ctx := context.Background()
+	courierLocation, err := kernel.NewLocation(1, 1)
+	suite.Require().NoError(err)
+	c, err := courier.NewCourier(kernel.NewUUID(), "Bike", 2, courierLocation)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.courierRepo.Add(ctx, c))
+
+	o := suite.createOrder(6, 4)
+	suite.Require().NoError(o.Assign(c.ID()))
+	suite.Require().NoError(suite.orderRepo.Add(ctx, o))
+
+	query, err := queries.NewGetOrderETAQuery(o.ID())
+	suite.Require().NoError(err)
+
+	result, err := suite.handler.Handle(ctx, query)
+
+	suite.Require().NoError(err)
+	suite.Equal(o.ID(), result.OrderID)
+	suite.Equal(c.ID(), result.CourierID)
+	// 8 cells at speed 2 take 4 turns of a second
+	suite.Equal(4, result.Turns)
+	suite.Equal(result.CalculatedAt.Add(4*time.Second), result.ArrivesAt)
+}
+
+func (suite *GetOrderETAQueryHandlerTestSuite) TestHandle_UnassignedOrder_ReturnsNotAssigned() {
+	ctx := context.Background()
+	o := suite.createOrder(6, 4)
+	suite.Require().NoError(suite.orderRepo.Add(ctx, o))
+
+	query, err := queries.NewGetOrderETAQuery(o.ID())
+	suite.Require().NoError(err)
+
+	_, err = suite.handler.Handle(ctx, query)
+
+	suite.Require().ErrorIs(err, order.ErrOrderIsNotAssigned)
+}
+
+func (suite *GetOrderETAQueryHandlerTestSuite) TestHandle_UnknownOrder_ReturnsNotFound() {
+	query, err := queries.NewGetOrderETAQuery(kernel.NewUUID())
+	suite.Require().NoError(err)
+
+	_, err = suite.handler.Handle(context.Background(), query)
+
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+}
+
+func (suite *GetOrderETAQueryHandlerTestSuite) createOrder(x, y kernel.Coordinate) *order.Order {
+	location, err := kernel.NewLocation(x, y)
+	suite.Require().NoError(err)
+	o, err := order.NewOrder(kernel.NewUUID(), location, 5)
+	suite.Require().NoError(err)
+	return o
+}
+
+func TestGetOrderETAQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetOrderETAQueryHandlerTestSuite))
+}
@@ -0,0 +1,34 @@
+package queries_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetOrderETAQuery_Valid(t *testing.T) {
+	orderID := kernel.NewUUID()
+
+	query, err := queries.NewGetOrderETAQuery(orderID)
+
+	require.NoError(t, err)
+	require.NoError(t, query.Validate())
+	assert.Equal(t, orderID, query.OrderID())
+}
+
+func TestNewGetOrderETAQuery_InvalidID(t *testing.T) {
+	_, err := queries.NewGetOrderETAQuery(kernel.UUID{})
+
+	require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+}
+
+func TestGetOrderETAQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetOrderETAQuery{}
+	err := query.Validate()
+	require.Error(t, err)
+	assert.ErrorIs(t, err, queries.ErrGetOrderETAQueryIsNotConstructed)
+}
@@ -0,0 +1,66 @@
+package queries
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrGetOrderHistoryQueryIsNotConstructed = errors.New(
+		"GetOrderHistoryQuery must be created via NewGetOrderHistoryQuery constructor",
+	)
+)
+
+// GetOrderHistoryQuery retrieves the transitions an order went through, oldest first.
+// The history is a read model projected from the change log, so the latest transition
+// may show up a few seconds after it was committed.
+//
+// Example:
+//
+//	query, err := NewGetOrderHistoryQuery(orderID)
+//	if err != nil {
+//	    return fmt.Errorf("invalid order id: %w", err)
+//	}
+//
+//	history, err := handler.Handle(ctx, query)
+//	for _, entry := range history {
+//	    fmt.Printf("%s: %s\n", entry.ChangedAt, entry.Status)
+//	}
+type GetOrderHistoryQuery struct {
+	orderID kernel.UUID
+
+	guard guard.ConstructorGuard
+}
+
+// NewGetOrderHistoryQuery creates a query for the history of the order with the given ID.
+// Returns an error if the order ID is invalid.
+func NewGetOrderHistoryQuery(orderID kernel.UUID) (GetOrderHistoryQuery, error) {
+	if err := orderID.Validate(); err != nil {
+		return GetOrderHistoryQuery{}, err
+	}
+
+	return GetOrderHistoryQuery{orderID: orderID, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetOrderHistoryQueryIsNotConstructed if validation fails.
+func (q GetOrderHistoryQuery) Validate() error {
+	return q.guard.Validate(ErrGetOrderHistoryQueryIsNotConstructed)
+}
+
+// OrderID returns the ID of the order whose history is requested.
+func (q GetOrderHistoryQuery) OrderID() kernel.UUID {
+	return q.orderID
+}
+
+// GetOrderHistoryQueryResponse is a transition of an order: the status and courier
+// it was left with and when. CourierID is nil while no courier holds the order.
+type GetOrderHistoryQueryResponse struct {
+	Status    order.Status
+	CourierID *kernel.UUID
+	ChangedAt time.Time
+}
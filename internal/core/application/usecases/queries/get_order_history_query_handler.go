@@ -0,0 +1,106 @@
+package queries
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetOrderHistoryQueryHandler retrieves order histories from the order_history read model,
+// which the order history projection maintains from the change log.
+//
+// Example:
+//
+//	handler := NewGetOrderHistoryQueryHandler(db)
+//	history, err := handler.Handle(ctx, query)
+//	if errors.Is(err, errs.ErrObjectNotFound) {
+//	    return nil, ErrOrderNotFound
+//	}
+type GetOrderHistoryQueryHandler struct {
+	db *gorm.DB
+}
+
+// NewGetOrderHistoryQueryHandler creates a handler for order history queries.
+// Requires a GORM database connection for query execution.
+func NewGetOrderHistoryQueryHandler(db *gorm.DB) GetOrderHistoryQueryHandler {
+	return GetOrderHistoryQueryHandler{db: db}
+}
+
+// Handle executes the query and returns the order's transitions, oldest first.
+// Returns an empty slice if none was projected yet and an ObjectNotFoundError if the order does not exist.
+func (h GetOrderHistoryQueryHandler) Handle(
+	ctx context.Context,
+	query GetOrderHistoryQuery,
+) ([]GetOrderHistoryQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetOrderHistoryQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	var id uuid.UUID
+	err = session.Raw(`SELECT id FROM orders WHERE id = ?`, query.OrderID().Bytes()).Row().Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.NewObjectNotFoundError("order", query.OrderID().String())
+		}
+		return nil, err
+	}
+
+	rows, err := session.Raw(`
+		SELECT status, courier_id, changed_at
+		FROM order_history
+		WHERE order_id = ?
+		ORDER BY changed_at, change_id
+	`, query.OrderID().Bytes()).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := make([]GetOrderHistoryQueryResponse, 0)
+	for rows.Next() {
+		var (
+			status    int
+			courierID *uuid.UUID
+			changedAt time.Time
+		)
+
+		if err = rows.Scan(&status, &courierID, &changedAt); err != nil {
+			return nil, err
+		}
+
+		entry := GetOrderHistoryQueryResponse{Status: order.Status(status), ChangedAt: changedAt}
+		if courierID != nil {
+			restoredID, idErr := kernel.UUIDFromBytes(courierID[:])
+			if idErr != nil {
+				return nil, idErr
+			}
+			entry.CourierID = &restoredID
+		}
+
+		history = append(history, entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
@@ -0,0 +1,138 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetOrderHistoryQueryHandlerTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	db       *gorm.DB
+	handler  queries.GetOrderHistoryQueryHandler
+}
+
+func (suite *GetOrderHistoryQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&orderrepo.OrderDTO{},
+			&orderrepo.OrderMessageDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{},
+			&orderrepo.OrderItemDTO{},
+			&postgres_adapter.OrderHistoryEntryDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetOrderHistoryQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetOrderHistoryQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetOrderHistoryQueryHandler(suite.db)
+}
+
+func (suite *GetOrderHistoryQueryHandlerTestSuite) TestHandle_ReturnsTransitionsOldestFirst() {
+	o := suite.addOrder()
+	courierID := kernel.NewUUID()
+	createdAt := time.Now().UTC().Truncate(time.Second).Add(-time.Minute)
+	suite.addEntry(o.ID(), order.Assigned, &courierID, createdAt.Add(30*time.Second), 2)
+	suite.addEntry(o.ID(), order.Created, nil, createdAt, 1)
+
+	query, err := queries.NewGetOrderHistoryQuery(o.ID())
+	suite.Require().NoError(err)
+	history, err := suite.handler.Handle(context.Background(), query)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(history, 2)
+	suite.Equal(order.Created, history[0].Status)
+	suite.Nil(history[0].CourierID)
+	suite.True(createdAt.Equal(history[0].ChangedAt))
+	suite.Equal(order.Assigned, history[1].Status)
+	suite.Require().NotNil(history[1].CourierID)
+	suite.Equal(courierID, *history[1].CourierID)
+}
+
+func (suite *GetOrderHistoryQueryHandlerTestSuite) TestHandle_NothingProjected_ReturnsEmptyHistory() {
+	o := suite.addOrder()
+
+	query, err := queries.NewGetOrderHistoryQuery(o.ID())
+	suite.Require().NoError(err)
+	history, err := suite.handler.Handle(context.Background(), query)
+
+	suite.Require().NoError(err)
+	suite.NotNil(history)
+	suite.Empty(history)
+}
+
+func (suite *GetOrderHistoryQueryHandlerTestSuite) TestHandle_OrderNotFound_ReturnsError() {
+	query, err := queries.NewGetOrderHistoryQuery(kernel.NewUUID())
+	suite.Require().NoError(err)
+
+	_, err = suite.handler.Handle(context.Background(), query)
+
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+}
+
+func (suite *GetOrderHistoryQueryHandlerTestSuite) TestHandle_InvalidQuery_ReturnsError() {
+	result, err := suite.handler.Handle(context.Background(), queries.GetOrderHistoryQuery{})
+
+	suite.Require().ErrorIs(err, queries.ErrGetOrderHistoryQueryIsNotConstructed)
+	suite.Nil(result)
+}
+
+func (suite *GetOrderHistoryQueryHandlerTestSuite) addOrder() *order.Order {
+	location, err := kernel.NewLocation(3, 3)
+	suite.Require().NoError(err)
+	o, err := order.NewOrder(kernel.NewUUID(), location, 5)
+	suite.Require().NoError(err)
+
+	repo := orderrepo.NewGormOrderRepository(suite.db, &mockAggregateTracker{})
+	suite.Require().NoError(repo.Add(context.Background(), o))
+	return o
+}
+
+func (suite *GetOrderHistoryQueryHandlerTestSuite) addEntry(
+	orderID kernel.UUID,
+	status order.Status,
+	courierID *kernel.UUID,
+	changedAt time.Time,
+	changeID int64,
+) {
+	entry := postgres_adapter.OrderHistoryEntryDTO{
+		ID:        uuid.New(),
+		OrderID:   orderID.Bytes(),
+		Status:    int(status),
+		ChangedAt: changedAt,
+		ChangeID:  changeID,
+	}
+	if courierID != nil {
+		id := courierID.Bytes()
+		entry.CourierID = &id
+	}
+	suite.Require().NoError(suite.db.Create(&entry).Error)
+}
+
+func TestGetOrderHistoryQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetOrderHistoryQueryHandlerTestSuite))
+}
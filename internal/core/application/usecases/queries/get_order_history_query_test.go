@@ -0,0 +1,33 @@
+package queries_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetOrderHistoryQuery_Valid(t *testing.T) {
+	orderID := kernel.NewUUID()
+
+	query, err := queries.NewGetOrderHistoryQuery(orderID)
+
+	require.NoError(t, err)
+	require.NoError(t, query.Validate())
+	assert.Equal(t, orderID, query.OrderID())
+}
+
+func TestNewGetOrderHistoryQuery_InvalidOrderID(t *testing.T) {
+	_, err := queries.NewGetOrderHistoryQuery(kernel.UUID{})
+
+	require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+}
+
+func TestGetOrderHistoryQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetOrderHistoryQuery{}
+
+	require.ErrorIs(t, query.Validate(), queries.ErrGetOrderHistoryQueryIsNotConstructed)
+}
@@ -0,0 +1,77 @@
+package queries
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrGetOrderStateQueryIsNotConstructed = errors.New(
+		"GetOrderStateQuery must be created via NewGetOrderStateQuery constructor",
+	)
+)
+
+// GetOrderStateQuery retrieves the state an order was in at a moment of the past, such as
+// when a customer disputes a delivery. The state is rebuilt from the change log, which keeps
+// a snapshot of every committed change, so it is also available for archived orders.
+//
+// Example:
+//
+//	query, err := NewGetOrderStateQuery(orderID, disputedAt)
+//	if err != nil {
+//	    return fmt.Errorf("invalid order state query: %w", err)
+//	}
+//
+//	state, err := handler.Handle(ctx, query)
+//	fmt.Printf("%s at %s\n", state.Status, state.ChangedAt)
+type GetOrderStateQuery struct {
+	orderID kernel.UUID
+	asOf    time.Time
+
+	guard guard.ConstructorGuard
+}
+
+// NewGetOrderStateQuery creates a query for the state of the order with the given ID as of asOf.
+// Returns an error if the order ID is invalid or asOf is not set.
+func NewGetOrderStateQuery(orderID kernel.UUID, asOf time.Time) (GetOrderStateQuery, error) {
+	if err := orderID.Validate(); err != nil {
+		return GetOrderStateQuery{}, err
+	}
+	if asOf.IsZero() {
+		return GetOrderStateQuery{}, errs.NewValueIsRequiredError("asOf")
+	}
+
+	return GetOrderStateQuery{orderID: orderID, asOf: asOf.UTC(), guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetOrderStateQueryIsNotConstructed if validation fails.
+func (q GetOrderStateQuery) Validate() error {
+	return q.guard.Validate(ErrGetOrderStateQueryIsNotConstructed)
+}
+
+// OrderID returns the ID of the order whose state is requested.
+func (q GetOrderStateQuery) OrderID() kernel.UUID {
+	return q.orderID
+}
+
+// AsOf returns the moment the state is requested for, in UTC.
+func (q GetOrderStateQuery) AsOf() time.Time {
+	return q.asOf
+}
+
+// GetOrderStateQueryResponse is the state of an order as of the requested moment:
+// the state the latest change before that moment left it in and when that change was committed.
+// CourierID is nil while no courier held the order.
+type GetOrderStateQueryResponse struct {
+	ID        kernel.UUID
+	Status    order.Status
+	CourierID *kernel.UUID
+	Location  kernel.Location
+	ChangedAt time.Time
+}
@@ -0,0 +1,97 @@
+package queries
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tracing"
+
+	"gorm.io/gorm"
+)
+
+// GetOrderStateQueryHandler rebuilds past order states from the change log.
+//
+// Example:
+//
+//	handler := NewGetOrderStateQueryHandler(db)
+//	state, err := handler.Handle(ctx, query)
+//	if errors.Is(err, errs.ErrObjectNotFound) {
+//	    return nil, ErrOrderNotFound
+//	}
+type GetOrderStateQueryHandler struct {
+	db *gorm.DB
+}
+
+// NewGetOrderStateQueryHandler creates a handler for order state queries.
+// Requires a GORM database connection for query execution.
+func NewGetOrderStateQueryHandler(db *gorm.DB) GetOrderStateQueryHandler {
+	return GetOrderStateQueryHandler{db: db}
+}
+
+// Handle executes the query and returns the snapshot of the latest change to the order
+// committed at or before the requested moment.
+// Returns an ObjectNotFoundError if the order does not exist or was not created yet at that moment.
+func (h GetOrderStateQueryHandler) Handle(
+	ctx context.Context,
+	query GetOrderStateQuery,
+) (GetOrderStateQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetOrderStateQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return GetOrderStateQueryResponse{}, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return GetOrderStateQueryResponse{}, err
+	}
+	defer release()
+
+	var entries []struct {
+		ChangedAt time.Time
+		Snapshot  []byte
+	}
+	err = session.Raw(`
+		SELECT changed_at, snapshot
+		FROM change_log
+		WHERE aggregate_type = ? AND aggregate_id = ? AND changed_at <= ?
+		ORDER BY version DESC
+		LIMIT 1
+	`, OrderChange, query.OrderID().Bytes(), query.AsOf()).Scan(&entries).Error
+	if err != nil {
+		return GetOrderStateQueryResponse{}, err
+	}
+	if len(entries) == 0 {
+		return GetOrderStateQueryResponse{}, errs.NewObjectNotFoundError("order", query.OrderID().String())
+	}
+
+	var snapshot OrderSnapshot
+	if err = json.Unmarshal(entries[0].Snapshot, &snapshot); err != nil {
+		return GetOrderStateQueryResponse{}, err
+	}
+
+	location, err := kernel.NewLocation(kernel.Coordinate(snapshot.X), kernel.Coordinate(snapshot.Y))
+	if err != nil {
+		return GetOrderStateQueryResponse{}, err
+	}
+
+	state := GetOrderStateQueryResponse{
+		ID:        query.OrderID(),
+		Status:    snapshot.Status,
+		Location:  location,
+		ChangedAt: entries[0].ChangedAt,
+	}
+	if snapshot.CourierID != nil {
+		courierID, idErr := kernel.UUIDFromBytes(snapshot.CourierID[:])
+		if idErr != nil {
+			return GetOrderStateQueryResponse{}, idErr
+		}
+		state.CourierID = &courierID
+	}
+
+	return state, nil
+}
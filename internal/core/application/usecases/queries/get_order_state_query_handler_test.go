@@ -0,0 +1,135 @@
+package queries_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetOrderStateQueryHandlerTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	db       *gorm.DB
+	handler  queries.GetOrderStateQueryHandler
+}
+
+func (suite *GetOrderStateQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(&postgres_adapter.ChangeLogDTO{})
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetOrderStateQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetOrderStateQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetOrderStateQueryHandler(suite.db)
+}
+
+func (suite *GetOrderStateQueryHandlerTestSuite) TestHandle_ReturnsStateOfLatestChangeBeforeMoment() {
+	orderID := kernel.NewUUID()
+	courierID := kernel.NewUUID()
+	snapshotCourierID := courierID.Bytes()
+	createdAt := time.Now().UTC().Truncate(time.Second).Add(-time.Hour)
+	suite.addChange(orderID, 1, createdAt, queries.OrderSnapshot{Status: order.Created, X: 3, Y: 4})
+	suite.addChange(orderID, 2, createdAt.Add(10*time.Minute), queries.OrderSnapshot{
+		Status: order.Assigned, CourierID: &snapshotCourierID, X: 3, Y: 4,
+	})
+	suite.addChange(orderID, 3, createdAt.Add(30*time.Minute), queries.OrderSnapshot{
+		Status: order.Completed, CourierID: &snapshotCourierID, X: 3, Y: 4,
+	})
+
+	query, err := queries.NewGetOrderStateQuery(orderID, createdAt.Add(20*time.Minute))
+	suite.Require().NoError(err)
+	state, err := suite.handler.Handle(context.Background(), query)
+
+	suite.Require().NoError(err)
+	suite.Equal(orderID, state.ID)
+	suite.Equal(order.Assigned, state.Status)
+	suite.Require().NotNil(state.CourierID)
+	suite.Equal(courierID, *state.CourierID)
+	suite.Equal(kernel.Coordinate(3), state.Location.X())
+	suite.Equal(kernel.Coordinate(4), state.Location.Y())
+	suite.True(createdAt.Add(10 * time.Minute).Equal(state.ChangedAt))
+}
+
+func (suite *GetOrderStateQueryHandlerTestSuite) TestHandle_MomentOfChange_ReturnsThatChange() {
+	orderID := kernel.NewUUID()
+	createdAt := time.Now().UTC().Truncate(time.Second).Add(-time.Hour)
+	suite.addChange(orderID, 1, createdAt, queries.OrderSnapshot{Status: order.Created, X: 1, Y: 1})
+
+	query, err := queries.NewGetOrderStateQuery(orderID, createdAt)
+	suite.Require().NoError(err)
+	state, err := suite.handler.Handle(context.Background(), query)
+
+	suite.Require().NoError(err)
+	suite.Equal(order.Created, state.Status)
+	suite.Nil(state.CourierID)
+}
+
+func (suite *GetOrderStateQueryHandlerTestSuite) TestHandle_BeforeOrderWasCreated_ReturnsError() {
+	orderID := kernel.NewUUID()
+	createdAt := time.Now().UTC().Truncate(time.Second).Add(-time.Hour)
+	suite.addChange(orderID, 1, createdAt, queries.OrderSnapshot{Status: order.Created, X: 1, Y: 1})
+
+	query, err := queries.NewGetOrderStateQuery(orderID, createdAt.Add(-time.Second))
+	suite.Require().NoError(err)
+	_, err = suite.handler.Handle(context.Background(), query)
+
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+}
+
+func (suite *GetOrderStateQueryHandlerTestSuite) TestHandle_OrderNotFound_ReturnsError() {
+	query, err := queries.NewGetOrderStateQuery(kernel.NewUUID(), time.Now())
+	suite.Require().NoError(err)
+
+	_, err = suite.handler.Handle(context.Background(), query)
+
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+}
+
+func (suite *GetOrderStateQueryHandlerTestSuite) TestHandle_InvalidQuery_ReturnsError() {
+	_, err := suite.handler.Handle(context.Background(), queries.GetOrderStateQuery{})
+
+	suite.Require().ErrorIs(err, queries.ErrGetOrderStateQueryIsNotConstructed)
+}
+
+func (suite *GetOrderStateQueryHandlerTestSuite) addChange(
+	orderID kernel.UUID,
+	version int64,
+	changedAt time.Time,
+	snapshot queries.OrderSnapshot,
+) {
+	payload, err := json.Marshal(snapshot)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.db.Create(&postgres_adapter.ChangeLogDTO{
+		AggregateType: queries.OrderChange,
+		AggregateID:   orderID.Bytes(),
+		Version:       version,
+		ChangedAt:     changedAt,
+		Snapshot:      payload,
+	}).Error)
+}
+
+func TestGetOrderStateQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetOrderStateQueryHandlerTestSuite))
+}
@@ -0,0 +1,44 @@
+package queries_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetOrderStateQuery_Valid(t *testing.T) {
+	orderID := kernel.NewUUID()
+	asOf := time.Date(2025, 3, 1, 12, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+
+	query, err := queries.NewGetOrderStateQuery(orderID, asOf)
+
+	require.NoError(t, err)
+	require.NoError(t, query.Validate())
+	assert.Equal(t, orderID, query.OrderID())
+	assert.Equal(t, time.UTC, query.AsOf().Location())
+	assert.True(t, asOf.Equal(query.AsOf()))
+}
+
+func TestNewGetOrderStateQuery_InvalidOrderID(t *testing.T) {
+	_, err := queries.NewGetOrderStateQuery(kernel.UUID{}, time.Now())
+
+	require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+}
+
+func TestNewGetOrderStateQuery_WithoutMoment(t *testing.T) {
+	_, err := queries.NewGetOrderStateQuery(kernel.NewUUID(), time.Time{})
+
+	require.ErrorIs(t, err, errs.ErrValueIsRequired)
+}
+
+func TestGetOrderStateQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetOrderStateQuery{}
+
+	require.ErrorIs(t, query.Validate(), queries.ErrGetOrderStateQueryIsNotConstructed)
+}
@@ -0,0 +1,77 @@
+package queries
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrGetOrderThreadQueryIsNotConstructed = errors.New(
+		"GetOrderThreadQuery must be created via NewGetOrderThreadQuery constructor",
+	)
+)
+
+// GetOrderThreadQuery retrieves the courier-dispatcher communication thread of an order.
+// Returns messages in posting order together with the thread state.
+//
+// Example:
+//
+//	query, err := NewGetOrderThreadQuery(orderID)
+//	if err != nil {
+//	    return fmt.Errorf("invalid order id: %w", err)
+//	}
+//
+//	thread, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return fmt.Errorf("failed to retrieve thread: %w", err)
+//	}
+//
+//	for _, message := range thread.Messages {
+//	    fmt.Printf("[%s] %s: %s\n", message.SentAt, message.Sender, message.Text)
+//	}
+type GetOrderThreadQuery struct {
+	orderID kernel.UUID
+
+	guard guard.ConstructorGuard
+}
+
+// NewGetOrderThreadQuery creates a query for the thread of the given order.
+// Returns an error if the order ID is invalid.
+func NewGetOrderThreadQuery(orderID kernel.UUID) (GetOrderThreadQuery, error) {
+	if err := orderID.Validate(); err != nil {
+		return GetOrderThreadQuery{}, err
+	}
+
+	return GetOrderThreadQuery{orderID: orderID, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetOrderThreadQueryIsNotConstructed if validation fails.
+func (q GetOrderThreadQuery) Validate() error {
+	return q.guard.Validate(ErrGetOrderThreadQueryIsNotConstructed)
+}
+
+// OrderID returns the ID of the order whose thread is requested.
+func (q GetOrderThreadQuery) OrderID() kernel.UUID {
+	return q.orderID
+}
+
+// GetOrderThreadQueryResponse represents an order thread in the read model.
+// Closed is true once the order is completed or cancelled and no more messages are accepted.
+type GetOrderThreadQueryResponse struct {
+	OrderID  kernel.UUID
+	Closed   bool
+	Messages []OrderThreadMessage
+}
+
+// OrderThreadMessage represents a single message of an order thread.
+type OrderThreadMessage struct {
+	ID     kernel.UUID
+	Sender order.Sender
+	Text   string
+	SentAt time.Time
+}
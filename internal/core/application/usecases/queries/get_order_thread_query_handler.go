@@ -0,0 +1,115 @@
+package queries
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetOrderThreadQueryHandler retrieves order communication threads from the database.
+//
+// Example:
+//
+//	handler := NewGetOrderThreadQueryHandler(db)
+//	query, _ := NewGetOrderThreadQuery(orderID)
+//
+//	thread, err := handler.Handle(ctx, query)
+//	if errors.Is(err, errs.ErrObjectNotFound) {
+//	    // Order does not exist
+//	}
+type GetOrderThreadQueryHandler struct {
+	db *gorm.DB
+}
+
+// NewGetOrderThreadQueryHandler creates a handler for order thread queries.
+// Requires a GORM database connection for query execution.
+func NewGetOrderThreadQueryHandler(db *gorm.DB) GetOrderThreadQueryHandler {
+	return GetOrderThreadQueryHandler{db: db}
+}
+
+// Handle executes the query to retrieve the thread of a single order.
+// Messages are sorted by posting time. Returns an ObjectNotFoundError if the order does not exist.
+func (h GetOrderThreadQueryHandler) Handle(
+	ctx context.Context,
+	query GetOrderThreadQuery,
+) (GetOrderThreadQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetOrderThreadQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return GetOrderThreadQueryResponse{}, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return GetOrderThreadQueryResponse{}, err
+	}
+	defer release()
+
+	var status int
+	err = session.Raw(`
+		SELECT status
+		FROM orders
+		WHERE id = ?
+	`, query.OrderID().Bytes()).Row().Scan(&status)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return GetOrderThreadQueryResponse{}, errs.NewObjectNotFoundError("order", query.OrderID().String())
+		}
+		return GetOrderThreadQueryResponse{}, err
+	}
+
+	thread := GetOrderThreadQueryResponse{
+		OrderID:  query.OrderID(),
+		Closed:   order.Status(status).IsFinal(),
+		Messages: make([]OrderThreadMessage, 0),
+	}
+
+	rows, err := session.Raw(`
+		SELECT
+			id,
+			sender,
+			text,
+			sent_at
+		FROM order_messages
+		WHERE order_id = ?
+		ORDER BY sent_at, id
+	`, query.OrderID().Bytes()).Rows()
+	if err != nil {
+		return GetOrderThreadQueryResponse{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var message OrderThreadMessage
+		var id uuid.UUID
+		var sender int
+
+		if err = rows.Scan(&id, &sender, &message.Text, &message.SentAt); err != nil {
+			return GetOrderThreadQueryResponse{}, err
+		}
+
+		messageID, idErr := kernel.UUIDFromBytes(id[:])
+		if idErr != nil {
+			return GetOrderThreadQueryResponse{}, idErr
+		}
+		message.ID = messageID
+		message.Sender = order.Sender(sender)
+
+		thread.Messages = append(thread.Messages, message)
+	}
+
+	if err = rows.Err(); err != nil {
+		return GetOrderThreadQueryResponse{}, err
+	}
+
+	return thread, nil
+}
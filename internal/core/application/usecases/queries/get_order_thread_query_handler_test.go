@@ -0,0 +1,114 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetOrderThreadQueryHandlerTestSuite struct {
+	suite.Suite
+	template  *pgtest.Template
+	db        *gorm.DB
+	handler   queries.GetOrderThreadQueryHandler
+	orderRepo *orderrepo.GormOrderRepository
+}
+
+func (suite *GetOrderThreadQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&orderrepo.OrderDTO{}, &orderrepo.OrderMessageDTO{}, &orderrepo.OrderItemDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetOrderThreadQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetOrderThreadQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetOrderThreadQueryHandler(suite.db)
+	suite.orderRepo = orderrepo.NewGormOrderRepository(suite.db, &mockAggregateTracker{})
+}
+
+func (suite *GetOrderThreadQueryHandlerTestSuite) TestHandle_OrderWithMessages_ReturnsThreadInOrder() {
+	ctx := context.Background()
+	o := suite.createOrder()
+	sentAt := time.Now().Truncate(time.Microsecond)
+	_, err := o.PostMessage(order.CourierSender, "Second", sentAt.Add(time.Minute))
+	suite.Require().NoError(err)
+	_, err = o.PostMessage(order.DispatcherSender, "First", sentAt)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.orderRepo.Add(ctx, o))
+
+	query, err := queries.NewGetOrderThreadQuery(o.ID())
+	suite.Require().NoError(err)
+
+	result, err := suite.handler.Handle(ctx, query)
+
+	suite.Require().NoError(err)
+	suite.Equal(o.ID(), result.OrderID)
+	suite.False(result.Closed)
+	suite.Require().Len(result.Messages, 2)
+	suite.Equal("First", result.Messages[0].Text)
+	suite.Equal(order.DispatcherSender, result.Messages[0].Sender)
+	suite.Equal("Second", result.Messages[1].Text)
+	suite.Equal(order.CourierSender, result.Messages[1].Sender)
+}
+
+func (suite *GetOrderThreadQueryHandlerTestSuite) TestHandle_CompletedOrder_ReturnsClosedThread() {
+	ctx := context.Background()
+	o := suite.createOrder()
+	suite.Require().NoError(o.Assign(kernel.NewUUID()))
+	suite.Require().NoError(o.Complete())
+	suite.Require().NoError(suite.orderRepo.Add(ctx, o))
+
+	query, err := queries.NewGetOrderThreadQuery(o.ID())
+	suite.Require().NoError(err)
+
+	result, err := suite.handler.Handle(ctx, query)
+
+	suite.Require().NoError(err)
+	suite.True(result.Closed)
+	suite.NotNil(result.Messages)
+	suite.Empty(result.Messages)
+}
+
+func (suite *GetOrderThreadQueryHandlerTestSuite) TestHandle_UnknownOrder_ReturnsNotFound() {
+	query, err := queries.NewGetOrderThreadQuery(kernel.NewUUID())
+	suite.Require().NoError(err)
+
+	_, err = suite.handler.Handle(context.Background(), query)
+
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+}
+
+func (suite *GetOrderThreadQueryHandlerTestSuite) createOrder() *order.Order {
+	location, err := kernel.NewLocation(3, 4)
+	suite.Require().NoError(err)
+	o, err := order.NewOrder(kernel.NewUUID(), location, 10)
+	suite.Require().NoError(err)
+	return o
+}
+
+func TestGetOrderThreadQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetOrderThreadQueryHandlerTestSuite))
+}
@@ -0,0 +1,34 @@
+package queries_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/kernel"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetOrderThreadQuery_Valid(t *testing.T) {
+	orderID := kernel.NewUUID()
+
+	query, err := queries.NewGetOrderThreadQuery(orderID)
+
+	require.NoError(t, err)
+	require.NoError(t, query.Validate())
+	assert.Equal(t, orderID, query.OrderID())
+}
+
+func TestNewGetOrderThreadQuery_InvalidOrderID(t *testing.T) {
+	_, err := queries.NewGetOrderThreadQuery(kernel.UUID{})
+
+	require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+}
+
+func TestGetOrderThreadQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetOrderThreadQuery{}
+	err := query.Validate()
+	require.Error(t, err)
+	assert.ErrorIs(t, err, queries.ErrGetOrderThreadQueryIsNotConstructed)
+}
@@ -0,0 +1,161 @@
+package queries
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+const (
+	// DefaultOrdersPageLimit is the number of orders a page holds unless asked otherwise.
+	DefaultOrdersPageLimit = 50
+
+	// MaxOrdersPageLimit is the largest page of orders.
+	MaxOrdersPageLimit = 500
+)
+
+// OrderSort is the order in which a page of orders is listed.
+// Orders with equal sort values are listed by ID, so pages never overlap.
+type OrderSort int
+
+const (
+	// OldestFirst lists orders by creation time, earliest first. Orders are listed oldest first
+	// unless stated otherwise.
+	OldestFirst OrderSort = iota
+
+	// NewestFirst lists orders by creation time, latest first.
+	NewestFirst
+
+	// LeastUrgentFirst lists orders by priority, lowest first.
+	LeastUrgentFirst
+
+	// MostUrgentFirst lists orders by priority, most urgent first, as they are dispatched.
+	MostUrgentFirst
+)
+
+var (
+	ErrGetOrdersPageQueryIsNotConstructed = errors.New(
+		"GetOrdersPageQuery must be created via NewGetOrdersPageQuery constructor",
+	)
+)
+
+// GetOrdersPageQuery retrieves a page of orders in the given statuses, together with the number
+// of orders in these statuses, so clients can page through orders of any status.
+//
+// Example:
+//
+//	query, err := NewGetOrdersPageQuery([]order.Status{order.Created}, MostUrgentFirst, DefaultOrdersPageLimit, 0)
+//	if err != nil {
+//	    return fmt.Errorf("invalid page: %w", err)
+//	}
+//
+//	page, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return fmt.Errorf("failed to get orders: %w", err)
+//	}
+//	fmt.Printf("Showing %d of %d waiting orders\n", len(page.Orders), page.Total)
+type GetOrdersPageQuery struct {
+	statuses []order.Status
+	sort     OrderSort
+	limit    int
+	offset   int
+
+	guard guard.ConstructorGuard
+}
+
+// NewGetOrdersPageQuery creates a query for at most limit orders in the given statuses after skipping
+// offset orders. No statuses select orders in any status.
+// Returns an error if a status or the sort is unknown, the limit is outside 1..MaxOrdersPageLimit
+// or the offset is negative.
+func NewGetOrdersPageQuery(statuses []order.Status, sort OrderSort, limit, offset int) (GetOrdersPageQuery, error) {
+	var fields errs.ValidationErrors
+	for _, status := range statuses {
+		if err := status.Validate(); err != nil {
+			fields.Add("status", err)
+			break
+		}
+	}
+	if sort < OldestFirst || sort > MostUrgentFirst {
+		fields.Add("sort", errs.NewValueIsInvalidErrorWithCause(
+			"sort is invalid",
+			fmt.Errorf("unknown sort %d", sort),
+		))
+	}
+	if limit < 1 || limit > MaxOrdersPageLimit {
+		fields.Add("limit", errs.NewValueIsInvalidErrorWithCause(
+			"limit is invalid",
+			fmt.Errorf("%d is not between 1 and %d", limit, MaxOrdersPageLimit),
+		))
+	}
+	if offset < 0 {
+		fields.Add("offset", errs.NewValueIsInvalidErrorWithCause(
+			"offset is invalid",
+			fmt.Errorf("offset %d must not be negative", offset),
+		))
+	}
+	if err := fields.Err(); err != nil {
+		return GetOrdersPageQuery{}, err
+	}
+
+	return GetOrdersPageQuery{
+		statuses: append([]order.Status(nil), statuses...),
+		sort:     sort,
+		limit:    limit,
+		offset:   offset,
+		guard:    guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetOrdersPageQueryIsNotConstructed if validation fails.
+func (q GetOrdersPageQuery) Validate() error {
+	return q.guard.Validate(ErrGetOrdersPageQueryIsNotConstructed)
+}
+
+// Statuses returns the statuses of the orders to list; empty for orders in any status.
+func (q GetOrdersPageQuery) Statuses() []order.Status {
+	return append([]order.Status(nil), q.statuses...)
+}
+
+// Sort returns the order in which the orders are listed.
+func (q GetOrdersPageQuery) Sort() OrderSort {
+	return q.sort
+}
+
+// Limit returns the largest number of orders to return.
+func (q GetOrdersPageQuery) Limit() int {
+	return q.limit
+}
+
+// Offset returns the number of orders to skip.
+func (q GetOrdersPageQuery) Offset() int {
+	return q.offset
+}
+
+// GetOrdersPageQueryResponse is a page of orders.
+// Total is the number of orders in the requested statuses, regardless of the page.
+type GetOrdersPageQueryResponse struct {
+	Orders []OrderSummary
+	Total  int64
+}
+
+// OrderSummary is an order as listed on a page of orders.
+type OrderSummary struct {
+	ID     kernel.UUID
+	Status order.Status
+	// CourierID is the courier the order is assigned to; nil while it is not assigned.
+	CourierID     *kernel.UUID
+	Location      kernel.Location
+	Volume        int
+	Priority      order.Priority
+	DeliveryTier  order.DeliveryTier
+	PaymentStatus order.PaymentStatus
+	CreatedAt     time.Time
+	// CompletedAt is when the order was delivered; nil for orders not delivered.
+	CompletedAt *time.Time
+}
@@ -0,0 +1,159 @@
+package queries
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// orderSortClauses are the ORDER BY clauses of each sort. Every clause ends with the order ID,
+// so orders with equal sort values keep their place from page to page.
+var orderSortClauses = map[OrderSort]string{
+	OldestFirst:      "created_at, id",
+	NewestFirst:      "created_at DESC, id DESC",
+	LeastUrgentFirst: "priority, created_at, id",
+	MostUrgentFirst:  "priority DESC, created_at, id",
+}
+
+// GetOrdersPageQueryHandler retrieves pages of orders from the database.
+// Counts the orders matching the filter with a separate statement, so orders changing status
+// between the two reads may make the total differ from the pages by a few orders.
+//
+// Example:
+//
+//	handler := NewGetOrdersPageQueryHandler(db)
+//	query, _ := NewGetOrdersPageQuery(nil, NewestFirst, DefaultOrdersPageLimit, 0)
+//
+//	page, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    log.Printf("Failed to get orders: %v", err)
+//	    return err
+//	}
+type GetOrdersPageQueryHandler struct {
+	db *gorm.DB
+}
+
+// NewGetOrdersPageQueryHandler creates a handler for order page queries.
+// Requires a GORM database connection for query execution.
+func NewGetOrdersPageQueryHandler(db *gorm.DB) GetOrdersPageQueryHandler {
+	return GetOrdersPageQueryHandler{db: db}
+}
+
+// Handle executes the query to retrieve a page of orders in the requested statuses
+// and the number of such orders.
+func (h GetOrdersPageQueryHandler) Handle(
+	ctx context.Context,
+	query GetOrdersPageQuery,
+) (GetOrdersPageQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetOrdersPageQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return GetOrdersPageQueryResponse{}, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return GetOrdersPageQueryResponse{}, err
+	}
+	defer release()
+
+	where, args := "TRUE", []any{}
+	if statuses := query.Statuses(); len(statuses) > 0 {
+		values := make([]int, len(statuses))
+		for i, status := range statuses {
+			values[i] = int(status)
+		}
+		where, args = "status IN ?", []any{values}
+	}
+
+	page := GetOrdersPageQueryResponse{Orders: make([]OrderSummary, 0)}
+
+	err = session.Raw(`SELECT COUNT(*) FROM orders WHERE `+where, args...).Scan(&page.Total).Error
+	if err != nil {
+		return GetOrdersPageQueryResponse{}, err
+	}
+
+	rows, err := session.Raw(`
+		SELECT
+			id,
+			status,
+			courier_id,
+			location_x,
+			location_y,
+			volume,
+			priority,
+			delivery_tier,
+			payment_status,
+			created_at,
+			completed_at
+		FROM orders
+		WHERE `+where+`
+		ORDER BY `+orderSortClauses[query.Sort()]+`
+		LIMIT ? OFFSET ?
+	`, append(args, query.Limit(), query.Offset())...).Rows()
+	if err != nil {
+		return GetOrdersPageQueryResponse{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		summary, scanErr := scanOrderSummary(rows.Scan)
+		if scanErr != nil {
+			return GetOrdersPageQueryResponse{}, scanErr
+		}
+		page.Orders = append(page.Orders, summary)
+	}
+
+	if err = rows.Err(); err != nil {
+		return GetOrdersPageQueryResponse{}, err
+	}
+
+	return page, nil
+}
+
+// scanOrderSummary reads one row of the page into an order summary.
+func scanOrderSummary(scan func(dest ...any) error) (OrderSummary, error) {
+	var summary OrderSummary
+	var id uuid.UUID
+	var courierID *uuid.UUID
+	var locationX, locationY int8
+
+	err := scan(
+		&id,
+		&summary.Status,
+		&courierID,
+		&locationX,
+		&locationY,
+		&summary.Volume,
+		&summary.Priority,
+		&summary.DeliveryTier,
+		&summary.PaymentStatus,
+		&summary.CreatedAt,
+		&summary.CompletedAt,
+	)
+	if err != nil {
+		return OrderSummary{}, err
+	}
+
+	if summary.ID, err = kernel.UUIDFromBytes(id[:]); err != nil {
+		return OrderSummary{}, err
+	}
+	if courierID != nil {
+		courier, idErr := kernel.UUIDFromBytes(courierID[:])
+		if idErr != nil {
+			return OrderSummary{}, idErr
+		}
+		summary.CourierID = &courier
+	}
+
+	summary.Location, err = kernel.NewLocation(kernel.Coordinate(locationX), kernel.Coordinate(locationY))
+	if err != nil {
+		return OrderSummary{}, err
+	}
+	return summary, nil
+}
@@ -0,0 +1,163 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+
+	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetOrdersPageQueryHandlerTestSuite struct {
+	suite.Suite
+	template  *pgtest.Template
+	db        *gorm.DB
+	handler   queries.GetOrdersPageQueryHandler
+	orderRepo *orderrepo.GormOrderRepository
+}
+
+func (suite *GetOrdersPageQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&orderrepo.OrderDTO{},
+			&orderrepo.OrderMessageDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{},
+			&orderrepo.OrderItemDTO{},
+			&courierrepo.CourierDTO{},
+			&courierrepo.StoragePlaceDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetOrdersPageQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetOrdersPageQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetOrdersPageQueryHandler(suite.db)
+	suite.orderRepo = orderrepo.NewGormOrderRepository(suite.db, &mockAggregateTracker{})
+}
+
+func (suite *GetOrdersPageQueryHandlerTestSuite) TestHandle_EmptyDatabase_ReturnsEmptyPage() {
+	query, err := queries.NewGetOrdersPageQuery(nil, queries.OldestFirst, queries.DefaultOrdersPageLimit, 0)
+	suite.Require().NoError(err)
+
+	page, err := suite.handler.Handle(context.Background(), query)
+
+	suite.Require().NoError(err)
+	suite.NotNil(page.Orders)
+	suite.Empty(page.Orders)
+	suite.Zero(page.Total)
+}
+
+func (suite *GetOrdersPageQueryHandlerTestSuite) TestHandle_PagesThroughFilteredOrders() {
+	created := []*order.Order{suite.addOrder(order.Created), suite.addOrder(order.Created), suite.addOrder(order.Created)}
+	assigned := suite.addOrder(order.Assigned)
+	suite.addOrder(order.Completed)
+
+	statuses := []order.Status{order.Created, order.Assigned}
+	seen := make([]kernel.UUID, 0)
+	for offset := 0; offset < 4; offset += 3 {
+		query, err := queries.NewGetOrdersPageQuery(statuses, queries.OldestFirst, 3, offset)
+		suite.Require().NoError(err)
+
+		page, err := suite.handler.Handle(context.Background(), query)
+
+		suite.Require().NoError(err)
+		suite.Equal(int64(4), page.Total)
+		for _, summary := range page.Orders {
+			suite.NotEqual(order.Completed, summary.Status)
+			seen = append(seen, summary.ID)
+		}
+	}
+
+	suite.Len(seen, 4)
+	for _, o := range append(created, assigned) {
+		suite.Contains(seen, o.ID())
+	}
+}
+
+func (suite *GetOrdersPageQueryHandlerTestSuite) TestHandle_SortsByPriority() {
+	normal := suite.addOrder(order.Created)
+	urgent := suite.addOrderWithPriority(order.UrgentPriority)
+	low := suite.addOrderWithPriority(order.LowPriority)
+
+	query, err := queries.NewGetOrdersPageQuery(nil, queries.MostUrgentFirst, queries.DefaultOrdersPageLimit, 0)
+	suite.Require().NoError(err)
+
+	page, err := suite.handler.Handle(context.Background(), query)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(page.Orders, 3)
+	suite.Equal(urgent.ID(), page.Orders[0].ID)
+	suite.Equal(order.UrgentPriority, page.Orders[0].Priority)
+	suite.Equal(normal.ID(), page.Orders[1].ID)
+	suite.Equal(low.ID(), page.Orders[2].ID)
+}
+
+func (suite *GetOrdersPageQueryHandlerTestSuite) TestHandle_ReportsCourierAndCompletion() {
+	completed := suite.addOrder(order.Completed)
+	completedStatus := []order.Status{order.Completed}
+
+	query, err := queries.NewGetOrdersPageQuery(completedStatus, queries.NewestFirst, queries.DefaultOrdersPageLimit, 0)
+	suite.Require().NoError(err)
+
+	page, err := suite.handler.Handle(context.Background(), query)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(page.Orders, 1)
+	summary := page.Orders[0]
+	suite.Equal(completed.ID(), summary.ID)
+	suite.Require().NotNil(summary.CourierID)
+	suite.Equal(*completed.Courier(), *summary.CourierID)
+	suite.NotNil(summary.CompletedAt)
+	suite.False(summary.CreatedAt.IsZero())
+}
+
+// addOrder saves a new order brought to the given status.
+func (suite *GetOrdersPageQueryHandlerTestSuite) addOrder(status order.Status) *order.Order {
+	location, err := kernel.NewLocation(3, 4)
+	suite.Require().NoError(err)
+	o, err := order.NewOrder(kernel.NewUUID(), location, 5)
+	suite.Require().NoError(err)
+
+	if status == order.Assigned || status == order.Completed {
+		suite.Require().NoError(o.Assign(kernel.NewUUID()))
+	}
+	if status == order.Completed {
+		suite.Require().NoError(o.Complete())
+	}
+
+	suite.Require().NoError(suite.orderRepo.Add(context.Background(), o))
+	return o
+}
+
+// addOrderWithPriority saves a new waiting order with the given priority.
+func (suite *GetOrdersPageQueryHandlerTestSuite) addOrderWithPriority(priority order.Priority) *order.Order {
+	location, err := kernel.NewLocation(3, 4)
+	suite.Require().NoError(err)
+	o, err := order.NewOrder(kernel.NewUUID(), location, 5)
+	suite.Require().NoError(err)
+	suite.Require().NoError(o.ChangePriority(priority))
+
+	suite.Require().NoError(suite.orderRepo.Add(context.Background(), o))
+	return o
+}
+
+func TestGetOrdersPageQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetOrdersPageQueryHandlerTestSuite))
+}
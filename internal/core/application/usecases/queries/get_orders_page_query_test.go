@@ -0,0 +1,59 @@
+package queries_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetOrdersPageQuery_Valid(t *testing.T) {
+	statuses := []order.Status{order.Created, order.Assigned}
+
+	query, err := queries.NewGetOrdersPageQuery(statuses, queries.MostUrgentFirst, 20, 40)
+
+	require.NoError(t, err)
+	require.NoError(t, query.Validate())
+	assert.Equal(t, statuses, query.Statuses())
+	assert.Equal(t, queries.MostUrgentFirst, query.Sort())
+	assert.Equal(t, 20, query.Limit())
+	assert.Equal(t, 40, query.Offset())
+
+	// The query keeps its own copy of the statuses
+	statuses[0] = order.Completed
+	assert.Equal(t, order.Created, query.Statuses()[0])
+}
+
+func TestNewGetOrdersPageQuery_AnyStatus(t *testing.T) {
+	query, err := queries.NewGetOrdersPageQuery(nil, queries.OldestFirst, queries.DefaultOrdersPageLimit, 0)
+
+	require.NoError(t, err)
+	assert.Empty(t, query.Statuses())
+}
+
+func TestNewGetOrdersPageQuery_Invalid(t *testing.T) {
+	_, err := queries.NewGetOrdersPageQuery([]order.Status{order.Unknown}, queries.OldestFirst, 10, 0)
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+
+	_, err = queries.NewGetOrdersPageQuery(nil, queries.OrderSort(-1), 10, 0)
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+
+	_, err = queries.NewGetOrdersPageQuery(nil, queries.OldestFirst, 0, 0)
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+
+	_, err = queries.NewGetOrdersPageQuery(nil, queries.OldestFirst, queries.MaxOrdersPageLimit+1, 0)
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+
+	_, err = queries.NewGetOrdersPageQuery(nil, queries.OldestFirst, 10, -1)
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
+
+func TestGetOrdersPageQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetOrdersPageQuery{}
+
+	require.ErrorIs(t, query.Validate(), queries.ErrGetOrdersPageQueryIsNotConstructed)
+}
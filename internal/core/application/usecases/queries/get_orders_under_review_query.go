@@ -0,0 +1,52 @@
+package queries
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrGetOrdersUnderReviewQueryIsNotConstructed = errors.New(
+		"GetOrdersUnderReviewQuery must be created via NewGetOrdersUnderReviewQuery constructor",
+	)
+)
+
+// GetOrdersUnderReviewQuery retrieves the fraud review queue: orders held out of dispatch
+// until an operator approves them.
+//
+// Example:
+//
+//	query := NewGetOrdersUnderReviewQuery()
+//	handler := NewGetOrdersUnderReviewQueryHandler(db)
+//
+//	queue, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return fmt.Errorf("failed to get review queue: %w", err)
+//	}
+//	for _, o := range queue {
+//	    fmt.Printf("Order %s: %s\n", o.ID, o.Reason)
+//	}
+type GetOrdersUnderReviewQuery struct {
+	guard guard.ConstructorGuard
+}
+
+// NewGetOrdersUnderReviewQuery creates a query for the fraud review queue.
+func NewGetOrdersUnderReviewQuery() GetOrdersUnderReviewQuery {
+	return GetOrdersUnderReviewQuery{guard: guard.NewConstructorGuard()}
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetOrdersUnderReviewQueryIsNotConstructed if validation fails.
+func (q GetOrdersUnderReviewQuery) Validate() error {
+	return q.guard.Validate(ErrGetOrdersUnderReviewQueryIsNotConstructed)
+}
+
+// GetOrdersUnderReviewQueryResponse is an order waiting in the fraud review queue.
+type GetOrdersUnderReviewQueryResponse struct {
+	ID       kernel.UUID
+	Location kernel.Location
+	Volume   int
+	Reason   string
+}
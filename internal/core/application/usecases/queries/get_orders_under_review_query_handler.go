@@ -0,0 +1,97 @@
+package queries
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetOrdersUnderReviewQueryHandler reads the fraud review queue from the database.
+// Orders are returned in a stable order so operators can work through the queue.
+//
+// Example:
+//
+//	handler := NewGetOrdersUnderReviewQueryHandler(db)
+//	queue, err := handler.Handle(ctx, NewGetOrdersUnderReviewQuery())
+type GetOrdersUnderReviewQueryHandler struct {
+	db *gorm.DB
+}
+
+// NewGetOrdersUnderReviewQueryHandler creates a handler for the fraud review queue.
+// Requires a GORM database connection for query execution.
+func NewGetOrdersUnderReviewQueryHandler(db *gorm.DB) GetOrdersUnderReviewQueryHandler {
+	return GetOrdersUnderReviewQueryHandler{db: db}
+}
+
+// Handle executes the query to retrieve all orders held for review.
+// Returns an empty slice if the queue is empty.
+func (h GetOrdersUnderReviewQueryHandler) Handle(
+	ctx context.Context,
+	query GetOrdersUnderReviewQuery,
+) ([]GetOrdersUnderReviewQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetOrdersUnderReviewQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	queue := make([]GetOrdersUnderReviewQueryResponse, 0)
+
+	rows, err := session.Raw(`
+		SELECT
+			id,
+			location_x,
+			location_y,
+			volume,
+			review_reason
+		FROM orders
+		WHERE review_reason <> ''
+		ORDER BY id
+	`).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			response             GetOrdersUnderReviewQueryResponse
+			locationX, locationY int8
+			id                   uuid.UUID
+		)
+
+		if err = rows.Scan(&id, &locationX, &locationY, &response.Volume, &response.Reason); err != nil {
+			return nil, err
+		}
+
+		orderID, idErr := kernel.UUIDFromBytes(id[:])
+		if idErr != nil {
+			return nil, idErr
+		}
+		response.ID = orderID
+
+		location, locErr := kernel.NewLocation(kernel.Coordinate(locationX), kernel.Coordinate(locationY))
+		if locErr != nil {
+			return nil, locErr
+		}
+		response.Location = location
+		queue = append(queue, response)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return queue, nil
+}
@@ -0,0 +1,87 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetOrdersUnderReviewQueryHandlerTestSuite struct {
+	suite.Suite
+	template  *pgtest.Template
+	db        *gorm.DB
+	handler   queries.GetOrdersUnderReviewQueryHandler
+	orderRepo *orderrepo.GormOrderRepository
+}
+
+func (suite *GetOrdersUnderReviewQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&orderrepo.OrderDTO{}, &orderrepo.OrderMessageDTO{}, &orderrepo.OrderItemDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetOrdersUnderReviewQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetOrdersUnderReviewQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetOrdersUnderReviewQueryHandler(suite.db)
+	suite.orderRepo = orderrepo.NewGormOrderRepository(suite.db, &mockAggregateTracker{})
+}
+
+func (suite *GetOrdersUnderReviewQueryHandlerTestSuite) TestHandle_EmptyQueue_ReturnsEmptySlice() {
+	result, err := suite.handler.Handle(context.Background(), queries.NewGetOrdersUnderReviewQuery())
+
+	suite.Require().NoError(err)
+	suite.NotNil(result)
+	suite.Empty(result)
+}
+
+func (suite *GetOrdersUnderReviewQueryHandlerTestSuite) TestHandle_ReturnsOnlyHeldOrders() {
+	ctx := context.Background()
+
+	location, _ := kernel.NewLocation(3, 4)
+	held, _ := order.NewOrder(kernel.NewUUID(), location, 12)
+	suite.Require().NoError(held.HoldForReview("blacklisted address"))
+	regular, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+
+	suite.Require().NoError(suite.orderRepo.Add(ctx, held))
+	suite.Require().NoError(suite.orderRepo.Add(ctx, regular))
+
+	result, err := suite.handler.Handle(ctx, queries.NewGetOrdersUnderReviewQuery())
+
+	suite.Require().NoError(err)
+	suite.Require().Len(result, 1)
+	suite.Equal(held.ID(), result[0].ID)
+	suite.Equal(12, result[0].Volume)
+	suite.Equal("blacklisted address", result[0].Reason)
+	suite.Equal(location.X(), result[0].Location.X())
+}
+
+func (suite *GetOrdersUnderReviewQueryHandlerTestSuite) TestHandle_InvalidQuery_ReturnsError() {
+	_, err := suite.handler.Handle(context.Background(), queries.GetOrdersUnderReviewQuery{})
+
+	suite.Require().ErrorIs(err, queries.ErrGetOrdersUnderReviewQueryIsNotConstructed)
+}
+
+func TestGetOrdersUnderReviewQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetOrdersUnderReviewQueryHandlerTestSuite))
+}
@@ -0,0 +1,21 @@
+package queries_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/queries"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetOrdersUnderReviewQuery_Valid(t *testing.T) {
+	query := queries.NewGetOrdersUnderReviewQuery()
+
+	require.NoError(t, query.Validate())
+}
+
+func TestGetOrdersUnderReviewQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetOrdersUnderReviewQuery{}
+
+	require.ErrorIs(t, query.Validate(), queries.ErrGetOrdersUnderReviewQueryIsNotConstructed)
+}
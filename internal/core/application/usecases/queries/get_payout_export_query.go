@@ -0,0 +1,90 @@
+package queries
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrGetPayoutExportQueryIsNotConstructed = errors.New(
+		"GetPayoutExportQuery must be created via NewGetPayoutExportQuery constructor",
+	)
+)
+
+// GetPayoutExportQuery retrieves the couriers' earnings for a payout period, summed up
+// from the earnings ledger.
+//
+// Example:
+//
+//	query, err := NewGetPayoutExportQuery(periodStart, periodEnd)
+//	if err != nil {
+//	    return fmt.Errorf("invalid payout period: %w", err)
+//	}
+//
+//	payouts, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return fmt.Errorf("failed to export payouts: %w", err)
+//	}
+//
+//	for _, payout := range payouts {
+//	    fmt.Printf("%s earned %d, %d of it in tips\n", payout.CourierName, payout.Total, payout.TipAmount)
+//	}
+type GetPayoutExportQuery struct {
+	from time.Time
+	to   time.Time
+
+	guard guard.ConstructorGuard
+}
+
+// NewGetPayoutExportQuery creates a query for the earnings made from the start of the period
+// up to, but not including, its end. Returns an error if either bound is missing or the period is empty.
+func NewGetPayoutExportQuery(from, to time.Time) (GetPayoutExportQuery, error) {
+	var fields errs.ValidationErrors
+	if from.IsZero() {
+		fields.Add("from", errs.NewValueIsRequiredError("from"))
+	}
+	if to.IsZero() {
+		fields.Add("to", errs.NewValueIsRequiredError("to"))
+	} else if !from.IsZero() && !from.Before(to) {
+		fields.Add("to", errs.NewValueIsInvalidErrorWithCause(
+			"to is invalid",
+			fmt.Errorf("end %s must be after start %s", to.Format(time.RFC3339), from.Format(time.RFC3339)),
+		))
+	}
+	if err := fields.Err(); err != nil {
+		return GetPayoutExportQuery{}, err
+	}
+
+	return GetPayoutExportQuery{from: from, to: to, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetPayoutExportQueryIsNotConstructed if validation fails.
+func (q GetPayoutExportQuery) Validate() error {
+	return q.guard.Validate(ErrGetPayoutExportQueryIsNotConstructed)
+}
+
+// From returns the start of the payout period.
+func (q GetPayoutExportQuery) From() time.Time {
+	return q.from
+}
+
+// To returns the end of the payout period, which is not included.
+func (q GetPayoutExportQuery) To() time.Time {
+	return q.to
+}
+
+// GetPayoutExportQueryResponse is a courier's line of the payout export.
+// Amounts are in minor currency units; Total sums every kind of earnings.
+type GetPayoutExportQueryResponse struct {
+	CourierID   kernel.UUID
+	CourierName string
+	Tips        int
+	TipAmount   int
+	Total       int
+}
@@ -0,0 +1,94 @@
+package queries
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/earnings"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetPayoutExportQueryHandler sums up the earnings ledger per courier for payouts.
+//
+// Example:
+//
+//	handler := NewGetPayoutExportQueryHandler(db)
+//	payouts, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    log.Printf("Failed to export payouts: %v", err)
+//	    return err
+//	}
+type GetPayoutExportQueryHandler struct {
+	db *gorm.DB
+}
+
+// NewGetPayoutExportQueryHandler creates a handler for payout exports.
+// Requires a GORM database connection for query execution.
+func NewGetPayoutExportQueryHandler(db *gorm.DB) GetPayoutExportQueryHandler {
+	return GetPayoutExportQueryHandler{db: db}
+}
+
+// Handle executes the query and returns one line per courier who earned anything in the
+// period, sorted by courier name.
+func (h GetPayoutExportQueryHandler) Handle(
+	ctx context.Context,
+	query GetPayoutExportQuery,
+) ([]GetPayoutExportQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetPayoutExportQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	rows, err := session.Raw(`
+		SELECT
+			e.courier_id,
+			c.name,
+			COUNT(*) FILTER (WHERE e.kind = ?),
+			COALESCE(SUM(e.amount) FILTER (WHERE e.kind = ?), 0),
+			SUM(e.amount)
+		FROM courier_earnings e
+		JOIN couriers c ON c.id = e.courier_id
+		WHERE e.earned_at >= ? AND e.earned_at < ?
+		GROUP BY e.courier_id, c.name
+		ORDER BY c.name, e.courier_id
+	`, int(earnings.Tip), int(earnings.Tip), query.From().UTC(), query.To().UTC()).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	payouts := make([]GetPayoutExportQueryResponse, 0)
+	for rows.Next() {
+		var id uuid.UUID
+		var payout GetPayoutExportQueryResponse
+
+		if err = rows.Scan(&id, &payout.CourierName, &payout.Tips, &payout.TipAmount, &payout.Total); err != nil {
+			return nil, err
+		}
+
+		courierID, idErr := kernel.UUIDFromBytes(id[:])
+		if idErr != nil {
+			return nil, idErr
+		}
+		payout.CourierID = courierID
+
+		payouts = append(payouts, payout)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return payouts, nil
+}
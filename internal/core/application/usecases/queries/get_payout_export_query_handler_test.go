@@ -0,0 +1,138 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/adapters/out/postgres/earningsrepo"
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/earnings"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetPayoutExportQueryHandlerTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	db       *gorm.DB
+	handler  queries.GetPayoutExportQueryHandler
+	ledger   *earningsrepo.GormEarningsLedger
+}
+
+func (suite *GetPayoutExportQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&courierrepo.CourierDTO{},
+			&courierrepo.StoragePlaceDTO{},
+			&courierrepo.MaintenanceWindowDTO{},
+			&courierrepo.AbsenceDTO{},
+			&courierrepo.ScheduleWindowDTO{},
+			&orderrepo.OrderDTO{},
+			&orderrepo.OrderMessageDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{},
+			&orderrepo.OrderItemDTO{},
+			&earningsrepo.EntryDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetPayoutExportQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetPayoutExportQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetPayoutExportQueryHandler(suite.db)
+	suite.ledger = earningsrepo.NewGormEarningsLedger(suite.db)
+}
+
+func (suite *GetPayoutExportQueryHandlerTestSuite) TestHandle_SumsEarningsOfPeriodPerCourier() {
+	periodStart := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	bob := suite.createCourier("Bob")
+	alice := suite.createCourier("Alice")
+	suite.recordTip(bob, 300, periodStart)
+	suite.recordTip(bob, 200, periodStart.Add(24*time.Hour))
+	suite.recordTip(alice, 150, periodStart.Add(time.Hour))
+	suite.recordTip(alice, 999, periodStart.Add(-time.Second))
+	suite.recordTip(alice, 999, periodStart.AddDate(0, 1, 0))
+
+	query, err := queries.NewGetPayoutExportQuery(periodStart, periodStart.AddDate(0, 1, 0))
+	suite.Require().NoError(err)
+
+	payouts, err := suite.handler.Handle(context.Background(), query)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(payouts, 2)
+	suite.Equal(alice.ID(), payouts[0].CourierID)
+	suite.Equal("Alice", payouts[0].CourierName)
+	suite.Equal(1, payouts[0].Tips)
+	suite.Equal(150, payouts[0].TipAmount)
+	suite.Equal(150, payouts[0].Total)
+	suite.Equal(bob.ID(), payouts[1].CourierID)
+	suite.Equal(2, payouts[1].Tips)
+	suite.Equal(500, payouts[1].TipAmount)
+	suite.Equal(500, payouts[1].Total)
+}
+
+func (suite *GetPayoutExportQueryHandlerTestSuite) TestHandle_NoEarnings_ReturnsEmptySlice() {
+	query, err := queries.NewGetPayoutExportQuery(time.Now().Add(-time.Hour), time.Now())
+	suite.Require().NoError(err)
+
+	payouts, err := suite.handler.Handle(context.Background(), query)
+
+	suite.Require().NoError(err)
+	suite.NotNil(payouts)
+	suite.Empty(payouts)
+}
+
+func (suite *GetPayoutExportQueryHandlerTestSuite) TestHandle_InvalidQuery_ReturnsError() {
+	result, err := suite.handler.Handle(context.Background(), queries.GetPayoutExportQuery{})
+
+	suite.Require().ErrorIs(err, queries.ErrGetPayoutExportQueryIsNotConstructed)
+	suite.Nil(result)
+}
+
+func (suite *GetPayoutExportQueryHandlerTestSuite) createCourier(name string) *courier.Courier {
+	location, err := kernel.NewLocation(5, 5)
+	suite.Require().NoError(err)
+	c, err := courier.NewCourier(kernel.NewUUID(), name, 3, location)
+	suite.Require().NoError(err)
+
+	repo := courierrepo.NewGormCourierRepository(suite.db, &mockAggregateTracker{})
+	suite.Require().NoError(repo.Add(context.Background(), c))
+	return c
+}
+
+// recordTip records a tip for a new order delivered by the courier.
+func (suite *GetPayoutExportQueryHandlerTestSuite) recordTip(c *courier.Courier, amount int, earnedAt time.Time) {
+	location, err := kernel.NewLocation(1, 1)
+	suite.Require().NoError(err)
+	o, err := order.NewOrder(kernel.NewUUID(), location, 1)
+	suite.Require().NoError(err)
+	suite.Require().NoError(o.Assign(c.ID()))
+	suite.Require().NoError(o.Complete())
+	repo := orderrepo.NewGormOrderRepository(suite.db, &mockAggregateTracker{})
+	suite.Require().NoError(repo.Add(context.Background(), o))
+
+	entry, err := earnings.NewEntry(kernel.NewUUID(), c.ID(), o.ID(), earnings.Tip, amount, earnedAt)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.ledger.Record(context.Background(), entry))
+}
+
+func TestGetPayoutExportQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetPayoutExportQueryHandlerTestSuite))
+}
@@ -0,0 +1,40 @@
+package queries_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetPayoutExportQuery_Valid(t *testing.T) {
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	query, err := queries.NewGetPayoutExportQuery(from, to)
+
+	require.NoError(t, err)
+	require.NoError(t, query.Validate())
+	assert.Equal(t, from, query.From())
+	assert.Equal(t, to, query.To())
+}
+
+func TestNewGetPayoutExportQuery_InvalidPeriod(t *testing.T) {
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := queries.NewGetPayoutExportQuery(time.Time{}, time.Time{})
+	require.ErrorIs(t, err, errs.ErrValueIsRequired)
+
+	_, err = queries.NewGetPayoutExportQuery(from, from)
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
+
+func TestGetPayoutExportQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetPayoutExportQuery{}
+
+	require.ErrorIs(t, query.Validate(), queries.ErrGetPayoutExportQueryIsNotConstructed)
+}
@@ -0,0 +1,55 @@
+package queries
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrGetPickupSlotsQueryIsNotConstructed = errors.New(
+		"GetPickupSlotsQuery must be created via NewGetPickupSlotsQuery constructor",
+	)
+)
+
+// GetPickupSlotsQuery retrieves the warehouse pickup slots that are not over yet with
+// their occupancy, earliest first.
+//
+// Example:
+//
+//	query := NewGetPickupSlotsQuery()
+//	handler := NewGetPickupSlotsQueryHandler(db)
+//
+//	slots, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return fmt.Errorf("failed to get pickup slots: %w", err)
+//	}
+//	for _, s := range slots {
+//	    fmt.Printf("%s: %d of %d places left\n", s.StartsAt, s.Remaining, s.Capacity)
+//	}
+type GetPickupSlotsQuery struct {
+	guard guard.ConstructorGuard
+}
+
+// NewGetPickupSlotsQuery creates a query for the upcoming pickup slots.
+func NewGetPickupSlotsQuery() GetPickupSlotsQuery {
+	return GetPickupSlotsQuery{guard: guard.NewConstructorGuard()}
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetPickupSlotsQueryIsNotConstructed if validation fails.
+func (q GetPickupSlotsQuery) Validate() error {
+	return q.guard.Validate(ErrGetPickupSlotsQueryIsNotConstructed)
+}
+
+// GetPickupSlotsQueryResponse is a pickup slot with the number of booked and free places.
+type GetPickupSlotsQueryResponse struct {
+	ID        kernel.UUID
+	StartsAt  time.Time
+	EndsAt    time.Time
+	Capacity  int
+	Booked    int
+	Remaining int
+}
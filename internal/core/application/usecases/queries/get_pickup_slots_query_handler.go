@@ -0,0 +1,89 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetPickupSlotsQueryHandler retrieves the upcoming pickup slots from the database.
+//
+// Example:
+//
+//	handler := NewGetPickupSlotsQueryHandler(db)
+//	slots, err := handler.Handle(ctx, NewGetPickupSlotsQuery())
+//	if err != nil {
+//	    return err
+//	}
+type GetPickupSlotsQueryHandler struct {
+	db *gorm.DB
+}
+
+// NewGetPickupSlotsQueryHandler creates a handler for pickup slot queries.
+// Requires a GORM database connection for query execution.
+func NewGetPickupSlotsQueryHandler(db *gorm.DB) GetPickupSlotsQueryHandler {
+	return GetPickupSlotsQueryHandler{db: db}
+}
+
+// Handle executes the query to retrieve the slots that have not ended, ordered by start.
+// Returns an empty slice if no such slot exists.
+func (h GetPickupSlotsQueryHandler) Handle(
+	ctx context.Context,
+	query GetPickupSlotsQuery,
+) ([]GetPickupSlotsQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetPickupSlotsQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	rows, err := session.Raw(`
+		SELECT s.id, s.starts_at, s.ends_at, s.capacity, count(b.order_id) AS booked
+		FROM pickup_slots s
+		LEFT JOIN pickup_slot_bookings b ON b.slot_id = s.id
+		WHERE s.ends_at > ?
+		GROUP BY s.id
+		ORDER BY s.starts_at, s.id
+	`, time.Now().UTC()).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	slots := make([]GetPickupSlotsQueryResponse, 0)
+	for rows.Next() {
+		var (
+			slot GetPickupSlotsQueryResponse
+			id   uuid.UUID
+		)
+
+		if err = rows.Scan(&id, &slot.StartsAt, &slot.EndsAt, &slot.Capacity, &slot.Booked); err != nil {
+			return nil, err
+		}
+
+		if slot.ID, err = kernel.UUIDFromBytes(id[:]); err != nil {
+			return nil, err
+		}
+		slot.Remaining = slot.Capacity - slot.Booked
+
+		slots = append(slots, slot)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return slots, nil
+}
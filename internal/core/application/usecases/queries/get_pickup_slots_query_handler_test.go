@@ -0,0 +1,111 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/adapters/out/postgres/pickuprepo"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/pickup"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetPickupSlotsQueryHandlerTestSuite struct {
+	suite.Suite
+	template  *pgtest.Template
+	db        *gorm.DB
+	handler   queries.GetPickupSlotsQueryHandler
+	orderRepo *orderrepo.GormOrderRepository
+	slotRepo  *pickuprepo.GormPickupSlotRepository
+}
+
+func (suite *GetPickupSlotsQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&orderrepo.OrderDTO{},
+			&orderrepo.OrderMessageDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{},
+			&orderrepo.OrderItemDTO{},
+			&pickuprepo.PickupSlotDTO{},
+			&pickuprepo.PickupSlotBookingDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetPickupSlotsQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetPickupSlotsQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetPickupSlotsQueryHandler(suite.db)
+	suite.orderRepo = orderrepo.NewGormOrderRepository(suite.db, &mockAggregateTracker{})
+	suite.slotRepo = pickuprepo.NewGormPickupSlotRepository(suite.db, &mockAggregateTracker{})
+}
+
+func (suite *GetPickupSlotsQueryHandlerTestSuite) TestHandle_ReturnsSlotsNotOverWithOccupancy() {
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+
+	suite.createSlot(now.Add(-time.Hour), 3)
+	later := suite.createSlot(now.Add(time.Hour), 2)
+	current := suite.createSlot(now.Add(-10*time.Minute), 3, suite.createOrder(), suite.createOrder())
+
+	slots, err := suite.handler.Handle(ctx, queries.NewGetPickupSlotsQuery())
+
+	suite.Require().NoError(err)
+	suite.Require().Len(slots, 2)
+	suite.Equal(current.ID(), slots[0].ID)
+	suite.True(current.StartsAt().Equal(slots[0].StartsAt))
+	suite.True(current.EndsAt().Equal(slots[0].EndsAt))
+	suite.Equal(3, slots[0].Capacity)
+	suite.Equal(2, slots[0].Booked)
+	suite.Equal(1, slots[0].Remaining)
+	suite.Equal(later.ID(), slots[1].ID)
+	suite.Zero(slots[1].Booked)
+	suite.Equal(2, slots[1].Remaining)
+}
+
+func (suite *GetPickupSlotsQueryHandlerTestSuite) TestHandle_NoSlots_ReturnsEmptySlice() {
+	slots, err := suite.handler.Handle(context.Background(), queries.NewGetPickupSlotsQuery())
+
+	suite.Require().NoError(err)
+	suite.NotNil(slots)
+	suite.Empty(slots)
+}
+
+func (suite *GetPickupSlotsQueryHandlerTestSuite) createOrder() kernel.UUID {
+	location, err := kernel.NewLocation(2, 2)
+	suite.Require().NoError(err)
+	o, err := order.NewOrder(kernel.NewUUID(), location, 5)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.orderRepo.Add(context.Background(), o))
+	return o.ID()
+}
+
+// createSlot stores a thirty minute slot starting at startsAt with the given bookings.
+func (suite *GetPickupSlotsQueryHandlerTestSuite) createSlot(
+	startsAt time.Time, capacity int, bookings ...kernel.UUID,
+) *pickup.Slot {
+	slot, err := pickup.RestoreSlot(kernel.NewUUID(), startsAt, startsAt.Add(30*time.Minute), capacity, bookings)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.slotRepo.Add(context.Background(), slot))
+	return slot
+}
+
+func TestGetPickupSlotsQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetPickupSlotsQueryHandlerTestSuite))
+}
@@ -0,0 +1,21 @@
+package queries_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/queries"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetPickupSlotsQuery_Valid(t *testing.T) {
+	query := queries.NewGetPickupSlotsQuery()
+
+	require.NoError(t, query.Validate())
+}
+
+func TestGetPickupSlotsQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetPickupSlotsQuery{}
+
+	require.ErrorIs(t, query.Validate(), queries.ErrGetPickupSlotsQueryIsNotConstructed)
+}
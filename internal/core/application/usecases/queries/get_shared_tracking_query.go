@@ -0,0 +1,85 @@
+package queries
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrGetSharedTrackingQueryIsNotConstructed = errors.New(
+		"GetSharedTrackingQuery must be created via NewGetSharedTrackingQuery constructor",
+	)
+)
+
+// GetSharedTrackingQuery retrieves the customer-facing tracking view of an order
+// identified by its tracking token. The view never exposes the courier's identity, contacts
+// or exact position; the courier is shown by first name and photo only.
+//
+// Example:
+//
+//	token, err := order.TrackingTokenFromString(tokenFromLink)
+//	if err != nil {
+//	    return fmt.Errorf("invalid tracking link: %w", err)
+//	}
+//
+//	query, _ := NewGetSharedTrackingQuery(token)
+//	tracking, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return fmt.Errorf("failed to retrieve tracking: %w", err)
+//	}
+//
+//	if tracking.Courier != nil {
+//	    fmt.Printf("Courier near %s, arriving in %d turns\n", tracking.Courier.ApproximateLocation, tracking.Courier.ETA)
+//	}
+type GetSharedTrackingQuery struct {
+	token order.TrackingToken
+
+	guard guard.ConstructorGuard
+}
+
+// NewGetSharedTrackingQuery creates a query for the tracking view behind the given token.
+// Returns an error if the token is invalid.
+func NewGetSharedTrackingQuery(token order.TrackingToken) (GetSharedTrackingQuery, error) {
+	if err := token.Validate(); err != nil {
+		return GetSharedTrackingQuery{}, err
+	}
+
+	return GetSharedTrackingQuery{token: token, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetSharedTrackingQueryIsNotConstructed if validation fails.
+func (q GetSharedTrackingQuery) Validate() error {
+	return q.guard.Validate(ErrGetSharedTrackingQueryIsNotConstructed)
+}
+
+// Token returns the tracking token from the shared link.
+func (q GetSharedTrackingQuery) Token() order.TrackingToken {
+	return q.token
+}
+
+// GetSharedTrackingQueryResponse represents the customer tracking view in the read model.
+// Courier is nil while the order awaits assignment and once it has been delivered.
+// BatchClosesAt is when an economy order enters dispatch; it is nil for express orders and
+// once the batch is released, so customers are not promised an earlier delivery.
+type GetSharedTrackingQueryResponse struct {
+	OrderID       kernel.UUID
+	Status        order.Status
+	Courier       *SharedTrackingCourier
+	BatchClosesAt *time.Time
+}
+
+// SharedTrackingCourier describes the assigned courier as shown to the customer.
+// Only the first name and the optional photo identify the courier. ApproximateLocation
+// is snapped to a coarse grid cell; ETA is the remaining time to the delivery location
+// in whole turns.
+type SharedTrackingCourier struct {
+	FirstName           string
+	PhotoURL            *string
+	ApproximateLocation kernel.Location
+	ETA                 int
+}
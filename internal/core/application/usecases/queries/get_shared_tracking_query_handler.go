@@ -0,0 +1,198 @@
+package queries
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// trackingCellSize is the side of the grid cell customers see the courier in.
+const trackingCellSize kernel.Coordinate = 3
+
+// GetSharedTrackingQueryHandler retrieves customer tracking views from the database,
+// through a SharedTrackingCache when one is configured.
+//
+// Example:
+//
+//	handler := NewGetSharedTrackingQueryHandler(db, cache)
+//	query, _ := NewGetSharedTrackingQuery(token)
+//
+//	tracking, err := handler.Handle(ctx, query)
+//	if errors.Is(err, errs.ErrObjectNotFound) {
+//	    // Unknown or revoked link
+//	}
+type GetSharedTrackingQueryHandler struct {
+	db    *gorm.DB
+	cache *SharedTrackingCache
+}
+
+// NewGetSharedTrackingQueryHandler creates a handler for shared tracking queries.
+// Requires a GORM database connection for query execution; a nil cache reads every view
+// from the database.
+func NewGetSharedTrackingQueryHandler(db *gorm.DB, cache *SharedTrackingCache) GetSharedTrackingQueryHandler {
+	return GetSharedTrackingQueryHandler{db: db, cache: cache}
+}
+
+// Handle executes the query to retrieve the tracking view of a single order.
+// Courier details are only returned while the order is assigned and are limited to the
+// first name and photo of the courier, never the phone or vehicle plate; the courier position
+// is fuzzed to the center of its trackingCellSize cell, while the ETA is calculated
+// from the exact position. Returns an ObjectNotFoundError if no order has the token.
+func (h GetSharedTrackingQueryHandler) Handle(
+	ctx context.Context,
+	query GetSharedTrackingQuery,
+) (GetSharedTrackingQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetSharedTrackingQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return GetSharedTrackingQueryResponse{}, err
+	}
+	if h.cache == nil {
+		return h.load(ctx, query)
+	}
+
+	return h.cache.get(ctx, query.Token(), func(ctx context.Context) (GetSharedTrackingQueryResponse, error) {
+		return h.load(ctx, query)
+	})
+}
+
+// load reads the view from the database.
+func (h GetSharedTrackingQueryHandler) load(
+	ctx context.Context,
+	query GetSharedTrackingQuery,
+) (GetSharedTrackingQueryResponse, error) {
+	if err := query.Validate(); err != nil {
+		return GetSharedTrackingQueryResponse{}, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return GetSharedTrackingQueryResponse{}, err
+	}
+	defer release()
+
+	var (
+		id                 uuid.UUID
+		status             int
+		orderX, orderY     kernel.Coordinate
+		courierX, courierY sql.NullInt16
+		courierSpeed       sql.NullInt32
+		courierName        sql.NullString
+		courierPhotoURL    sql.NullString
+		batchClosesAt      *time.Time
+	)
+
+	err = session.Raw(`
+		SELECT
+			o.id,
+			o.status,
+			o.location_x,
+			o.location_y,
+			c.location_x,
+			c.location_y,
+			c.speed,
+			c.name,
+			c.photo_url,
+			o.batch_closes_at
+		FROM orders o
+		LEFT JOIN couriers c ON c.id = o.courier_id
+		WHERE o.tracking_token = ?
+	`, query.Token().String()).Row().Scan(
+		&id, &status, &orderX, &orderY, &courierX, &courierY, &courierSpeed, &courierName, &courierPhotoURL,
+		&batchClosesAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return GetSharedTrackingQueryResponse{}, errs.NewObjectNotFoundError("tracking", query.Token().String())
+		}
+		return GetSharedTrackingQueryResponse{}, err
+	}
+
+	orderID, err := kernel.UUIDFromBytes(id[:])
+	if err != nil {
+		return GetSharedTrackingQueryResponse{}, err
+	}
+
+	tracking := GetSharedTrackingQueryResponse{
+		OrderID:       orderID,
+		Status:        order.Status(status),
+		BatchClosesAt: batchClosesAt,
+	}
+
+	if tracking.Status != order.Assigned || !courierX.Valid || !courierY.Valid || !courierSpeed.Valid {
+		return tracking, nil
+	}
+
+	destination, err := kernel.NewLocation(orderX, orderY)
+	if err != nil {
+		return GetSharedTrackingQueryResponse{}, err
+	}
+
+	position, err := kernel.NewLocation(kernel.Coordinate(courierX.Int16), kernel.Coordinate(courierY.Int16))
+	if err != nil {
+		return GetSharedTrackingQueryResponse{}, err
+	}
+
+	approximate, err := approximateLocation(position)
+	if err != nil {
+		return GetSharedTrackingQueryResponse{}, err
+	}
+
+	distance, err := position.Distance(destination)
+	if err != nil {
+		return GetSharedTrackingQueryResponse{}, err
+	}
+
+	tracking.Courier = &SharedTrackingCourier{
+		FirstName:           firstName(courierName.String),
+		ApproximateLocation: approximate,
+		ETA:                 ceilDiv(distance, int(courierSpeed.Int32)),
+	}
+	if courierPhotoURL.Valid {
+		tracking.Courier.PhotoURL = &courierPhotoURL.String
+	}
+
+	return tracking, nil
+}
+
+// firstName returns the first word of the courier's full name, the only part shown to customers.
+func firstName(name string) string {
+	if fields := strings.Fields(name); len(fields) > 0 {
+		return fields[0]
+	}
+	return ""
+}
+
+// approximateLocation snaps a location to the center of its trackingCellSize grid cell,
+// clamped to the grid bounds.
+func approximateLocation(location kernel.Location) (kernel.Location, error) {
+	grid := kernel.Grid()
+	return kernel.NewLocation(
+		snapCoordinate(location.X(), grid.MinX(), grid.MaxX()),
+		snapCoordinate(location.Y(), grid.MinY(), grid.MaxY()),
+	)
+}
+
+func snapCoordinate(value, minValue, maxValue kernel.Coordinate) kernel.Coordinate {
+	center := minValue + (value-minValue)/trackingCellSize*trackingCellSize + trackingCellSize/2
+	return min(center, maxValue)
+}
+
+// ceilDiv returns a/b rounded up; a non-positive divisor yields zero.
+func ceilDiv(a, b int) int {
+	if b <= 0 {
+		return 0
+	}
+	return (a + b - 1) / b
+}
@@ -0,0 +1,211 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"delivery/internal/adapters/out/postgres/courierrepo"
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/adapters/out/readcache"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetSharedTrackingQueryHandlerTestSuite struct {
+	suite.Suite
+	template    *pgtest.Template
+	db          *gorm.DB
+	handler     queries.GetSharedTrackingQueryHandler
+	orderRepo   *orderrepo.GormOrderRepository
+	courierRepo *courierrepo.GormCourierRepository
+}
+
+func (suite *GetSharedTrackingQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&orderrepo.OrderDTO{},
+			&orderrepo.OrderMessageDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{},
+			&orderrepo.OrderItemDTO{},
+			&courierrepo.CourierDTO{},
+			&courierrepo.StoragePlaceDTO{},
+			&courierrepo.MaintenanceWindowDTO{},
+			&courierrepo.AbsenceDTO{},
+			&courierrepo.ScheduleWindowDTO{},
+		)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetSharedTrackingQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetSharedTrackingQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetSharedTrackingQueryHandler(suite.db, nil)
+	suite.orderRepo = orderrepo.NewGormOrderRepository(suite.db, &mockAggregateTracker{})
+	suite.courierRepo = courierrepo.NewGormCourierRepository(suite.db, &mockAggregateTracker{})
+}
+
+func (suite *GetSharedTrackingQueryHandlerTestSuite) TestHandle_AssignedOrder_ReturnsFuzzedPositionAndETA() {
+	ctx := context.Background()
+	courierLocation, err := kernel.NewLocation(1, 1)
+	suite.Require().NoError(err)
+	c, err := courier.NewCourier(kernel.NewUUID(), "Bike", 2, courierLocation)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.courierRepo.Add(ctx, c))
+
+	o := suite.createOrder(6, 4)
+	suite.Require().NoError(o.Assign(c.ID()))
+	token, err := o.ShareTracking()
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.orderRepo.Add(ctx, o))
+
+	query, err := queries.NewGetSharedTrackingQuery(token)
+	suite.Require().NoError(err)
+
+	result, err := suite.handler.Handle(ctx, query)
+
+	suite.Require().NoError(err)
+	suite.Equal(o.ID(), result.OrderID)
+	suite.Equal(order.Assigned, result.Status)
+	suite.Require().NotNil(result.Courier)
+	suite.Equal(kernel.Coordinate(2), result.Courier.ApproximateLocation.X())
+	suite.Equal(kernel.Coordinate(2), result.Courier.ApproximateLocation.Y())
+	// Distance (1,1) -> (6,4) is 8 at speed 2
+	suite.Equal(4, result.Courier.ETA)
+	suite.Equal("Bike", result.Courier.FirstName)
+	suite.Nil(result.Courier.PhotoURL)
+}
+
+func (suite *GetSharedTrackingQueryHandlerTestSuite) TestHandle_AssignedOrder_ExposesFirstNameAndPhotoOnly() {
+	ctx := context.Background()
+	courierLocation, err := kernel.NewLocation(1, 1)
+	suite.Require().NoError(err)
+	c, err := courier.NewCourier(kernel.NewUUID(), "Anna Petrova", 2, courierLocation)
+	suite.Require().NoError(err)
+	profile, err := courier.NewProfile("https://cdn.example.com/anna.jpg", "+79123456789", "A123BC77")
+	suite.Require().NoError(err)
+	c.ChangeProfile(profile)
+	suite.Require().NoError(suite.courierRepo.Add(ctx, c))
+
+	o := suite.createOrder(6, 4)
+	suite.Require().NoError(o.Assign(c.ID()))
+	token, err := o.ShareTracking()
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.orderRepo.Add(ctx, o))
+
+	query, err := queries.NewGetSharedTrackingQuery(token)
+	suite.Require().NoError(err)
+
+	result, err := suite.handler.Handle(ctx, query)
+
+	suite.Require().NoError(err)
+	suite.Require().NotNil(result.Courier)
+	suite.Equal("Anna", result.Courier.FirstName)
+	suite.Require().NotNil(result.Courier.PhotoURL)
+	suite.Equal("https://cdn.example.com/anna.jpg", *result.Courier.PhotoURL)
+}
+
+func (suite *GetSharedTrackingQueryHandlerTestSuite) TestHandle_UnassignedOrder_HidesCourier() {
+	ctx := context.Background()
+	o := suite.createOrder(3, 4)
+	token, err := o.ShareTracking()
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.orderRepo.Add(ctx, o))
+
+	query, err := queries.NewGetSharedTrackingQuery(token)
+	suite.Require().NoError(err)
+
+	result, err := suite.handler.Handle(ctx, query)
+
+	suite.Require().NoError(err)
+	suite.Equal(order.Created, result.Status)
+	suite.Nil(result.Courier)
+	suite.Nil(result.BatchClosesAt)
+}
+
+func (suite *GetSharedTrackingQueryHandlerTestSuite) TestHandle_EconomyOrder_PromisesBatchClose() {
+	ctx := context.Background()
+	window, _ := order.NewBatchingWindow(30 * time.Minute)
+	o := suite.createOrder(3, 4)
+	suite.Require().NoError(o.ChangeDeliveryTier(order.EconomyDelivery, window, time.Now()))
+	token, err := o.ShareTracking()
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.orderRepo.Add(ctx, o))
+
+	query, err := queries.NewGetSharedTrackingQuery(token)
+	suite.Require().NoError(err)
+
+	result, err := suite.handler.Handle(ctx, query)
+
+	suite.Require().NoError(err)
+	suite.Require().NotNil(result.BatchClosesAt)
+	suite.WithinDuration(*o.BatchClosesAt(), *result.BatchClosesAt, time.Millisecond)
+}
+
+func (suite *GetSharedTrackingQueryHandlerTestSuite) TestHandle_UnknownToken_ReturnsNotFound() {
+	query, err := queries.NewGetSharedTrackingQuery(order.NewTrackingToken())
+	suite.Require().NoError(err)
+
+	_, err = suite.handler.Handle(context.Background(), query)
+
+	suite.Require().ErrorIs(err, errs.ErrObjectNotFound)
+}
+
+func (suite *GetSharedTrackingQueryHandlerTestSuite) TestHandle_Cached_ServesViewUntilInvalidated() {
+	ctx := context.Background()
+	memory, err := readcache.NewMemoryCache(100)
+	suite.Require().NoError(err)
+	cache, err := queries.NewSharedTrackingCache(memory, time.Minute)
+	suite.Require().NoError(err)
+	handler := queries.NewGetSharedTrackingQueryHandler(suite.db, cache)
+
+	o := suite.createOrder(3, 4)
+	token, err := o.ShareTracking()
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.orderRepo.Add(ctx, o))
+	query, err := queries.NewGetSharedTrackingQuery(token)
+	suite.Require().NoError(err)
+
+	_, err = handler.Handle(ctx, query)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.db.Exec(
+		"UPDATE orders SET status = ? WHERE id = ?", int(order.Completed), o.ID().String(),
+	).Error)
+
+	cached, err := handler.Handle(ctx, query)
+	suite.Require().NoError(err)
+	suite.Equal(order.Created, cached.Status)
+
+	suite.Require().NoError(cache.Invalidate(ctx, o.ID().String()))
+	fresh, err := handler.Handle(ctx, query)
+	suite.Require().NoError(err)
+	suite.Equal(order.Completed, fresh.Status)
+}
+
+func (suite *GetSharedTrackingQueryHandlerTestSuite) createOrder(x, y kernel.Coordinate) *order.Order {
+	location, err := kernel.NewLocation(x, y)
+	suite.Require().NoError(err)
+	o, err := order.NewOrder(kernel.NewUUID(), location, 10)
+	suite.Require().NoError(err)
+	return o
+}
+
+func TestGetSharedTrackingQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetSharedTrackingQueryHandlerTestSuite))
+}
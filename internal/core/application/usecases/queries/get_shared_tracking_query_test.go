@@ -0,0 +1,34 @@
+package queries_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetSharedTrackingQuery_Valid(t *testing.T) {
+	token := order.NewTrackingToken()
+
+	query, err := queries.NewGetSharedTrackingQuery(token)
+
+	require.NoError(t, err)
+	require.NoError(t, query.Validate())
+	assert.True(t, token.IsEqual(query.Token()))
+}
+
+func TestNewGetSharedTrackingQuery_InvalidToken(t *testing.T) {
+	_, err := queries.NewGetSharedTrackingQuery(order.TrackingToken{})
+
+	require.ErrorIs(t, err, order.ErrTrackingTokenIsNotConstructed)
+}
+
+func TestGetSharedTrackingQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetSharedTrackingQuery{}
+	err := query.Validate()
+	require.Error(t, err)
+	assert.ErrorIs(t, err, queries.ErrGetSharedTrackingQueryIsNotConstructed)
+}
@@ -0,0 +1,107 @@
+package queries
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/sla"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// MaxSLAReportDays is the longest period an SLA report covers.
+const MaxSLAReportDays = 366
+
+var (
+	ErrGetSLAReportQueryIsNotConstructed = errors.New(
+		"GetSLAReportQuery must be created via NewGetSLAReportQuery constructor",
+	)
+)
+
+// GetSLAReportQuery retrieves how the districts met their SLA targets over a period of UTC days,
+// with the compliance of every day as the trend.
+//
+// Example:
+//
+//	query, err := NewGetSLAReportQuery(time.Now().AddDate(0, 0, -30), time.Now().AddDate(0, 0, -1))
+//	if err != nil {
+//	    return fmt.Errorf("invalid query: %w", err)
+//	}
+//
+//	districts, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return fmt.Errorf("failed to get SLA report: %w", err)
+//	}
+//	for _, d := range districts {
+//	    fmt.Printf("%s %s: %.1f%% on time, goal %d%%\n", d.District, d.Tier, d.Percentage, d.Goal)
+//	}
+type GetSLAReportQuery struct {
+	from time.Time
+	to   time.Time
+
+	guard guard.ConstructorGuard
+}
+
+// NewGetSLAReportQuery creates a query for the SLA report of the UTC days from the day of from
+// to the day of to, both included. Returns an error if the period is reversed or longer than
+// MaxSLAReportDays.
+func NewGetSLAReportQuery(from time.Time, to time.Time) (GetSLAReportQuery, error) {
+	from, to = sla.Day(from), sla.Day(to)
+	if to.Before(from) {
+		return GetSLAReportQuery{}, errs.JoinFields(errs.Field("to", errs.NewValueIsInvalidErrorWithCause(
+			"to is invalid",
+			fmt.Errorf("%s is before %s", to.Format(time.DateOnly), from.Format(time.DateOnly)),
+		)))
+	}
+	if to.Sub(from) >= MaxSLAReportDays*24*time.Hour {
+		return GetSLAReportQuery{}, errs.JoinFields(errs.Field("from", errs.NewValueIsInvalidErrorWithCause(
+			"from is invalid",
+			fmt.Errorf("the period must not be longer than %d days", MaxSLAReportDays),
+		)))
+	}
+
+	return GetSLAReportQuery{from: from, to: to, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetSLAReportQueryIsNotConstructed if validation fails.
+func (q GetSLAReportQuery) Validate() error {
+	return q.guard.Validate(ErrGetSLAReportQueryIsNotConstructed)
+}
+
+// From returns the first day of the report.
+func (q GetSLAReportQuery) From() time.Time {
+	return q.from
+}
+
+// To returns the last day of the report.
+func (q GetSLAReportQuery) To() time.Time {
+	return q.to
+}
+
+// GetSLAReportQueryResponse is how a district met its target for a delivery tier over the period.
+// DeliveryTime and Goal are those of the last day in the period; Met compares the whole period
+// with that goal.
+type GetSLAReportQueryResponse struct {
+	District     string
+	Tier         order.DeliveryTier
+	DeliveryTime time.Duration
+	Goal         int
+	Delivered    int
+	OnTime       int
+	Percentage   float64
+	Met          bool
+	Trend        []SLAReportDay
+}
+
+// SLAReportDay is the compliance of a district on one day of the report, against the target of that day.
+type SLAReportDay struct {
+	Day        time.Time
+	Goal       int
+	Delivered  int
+	OnTime     int
+	Percentage float64
+	Met        bool
+}
@@ -0,0 +1,110 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/sla"
+	"delivery/internal/pkg/tracing"
+
+	"gorm.io/gorm"
+)
+
+// GetSLAReportQueryHandler retrieves the SLA report from the daily compliance computed by the
+// SLA compliance job.
+//
+// Example:
+//
+//	handler := NewGetSLAReportQueryHandler(db)
+//	districts, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return err
+//	}
+type GetSLAReportQueryHandler struct {
+	db *gorm.DB
+}
+
+// NewGetSLAReportQueryHandler creates a handler for SLA report queries.
+// Requires a GORM database connection for query execution.
+func NewGetSLAReportQueryHandler(db *gorm.DB) GetSLAReportQueryHandler {
+	return GetSLAReportQueryHandler{db: db}
+}
+
+// Handle executes the query to retrieve the compliance of every district and delivery tier
+// measured in the period, ordered by district and tier, each with its days in order.
+// Districts whose target was removed are reported for the days it was measured.
+// Returns an empty slice if no compliance was computed in the period.
+func (h GetSLAReportQueryHandler) Handle(
+	ctx context.Context,
+	query GetSLAReportQuery,
+) ([]GetSLAReportQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetSLAReportQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	rows, err := session.Raw(`
+		SELECT district, delivery_tier, delivery_time_minutes, goal, day, delivered, on_time
+		FROM sla_daily_compliance
+		WHERE day BETWEEN ? AND ?
+		ORDER BY district, delivery_tier, day
+	`, query.From(), query.To()).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	districts := make([]GetSLAReportQueryResponse, 0)
+	for rows.Next() {
+		var (
+			district     string
+			tier         int
+			deliveryTime int
+			day          SLAReportDay
+		)
+		if err = rows.Scan(&district, &tier, &deliveryTime, &day.Goal, &day.Day, &day.Delivered, &day.OnTime); err != nil {
+			return nil, err
+		}
+		day.Day = sla.Day(day.Day)
+		day.Percentage = sla.Percentage(day.Delivered, day.OnTime)
+		day.Met = sla.IsMet(day.Goal, day.Delivered, day.OnTime)
+
+		last := len(districts) - 1
+		if last < 0 || districts[last].District != district || districts[last].Tier != order.DeliveryTier(tier) {
+			districts = append(districts, GetSLAReportQueryResponse{
+				District: district,
+				Tier:     order.DeliveryTier(tier),
+				Trend:    make([]SLAReportDay, 0),
+			})
+			last++
+		}
+
+		d := &districts[last]
+		d.DeliveryTime = time.Duration(deliveryTime) * time.Minute
+		d.Goal = day.Goal
+		d.Delivered += day.Delivered
+		d.OnTime += day.OnTime
+		d.Trend = append(d.Trend, day)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range districts {
+		d := &districts[i]
+		d.Percentage = sla.Percentage(d.Delivered, d.OnTime)
+		d.Met = sla.IsMet(d.Goal, d.Delivered, d.OnTime)
+	}
+
+	return districts, nil
+}
@@ -0,0 +1,177 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/sla"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetSLAReportQueryHandlerTestSuite struct {
+	suite.Suite
+	template       *pgtest.Template
+	db             *gorm.DB
+	handler        queries.GetSLAReportQueryHandler
+	targetsHandler queries.GetSLATargetsQueryHandler
+	repository     *postgres_adapter.GormSLARepository
+}
+
+func (suite *GetSLAReportQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(&postgres_adapter.SLATargetDTO{}, &postgres_adapter.SLAComplianceDTO{})
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetSLAReportQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetSLAReportQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetSLAReportQueryHandler(suite.db)
+	suite.targetsHandler = queries.NewGetSLATargetsQueryHandler(suite.db)
+	suite.repository = postgres_adapter.NewGormSLARepository(suite.db)
+}
+
+func (suite *GetSLAReportQueryHandlerTestSuite) TestHandle_ReturnsComplianceWithTrend() {
+	ctx := context.Background()
+	day := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	north := suite.target("North", order.ExpressDelivery, 45*time.Minute, 80)
+	south := suite.target("South", order.ExpressDelivery, time.Hour, 90)
+	completed := day.Add(12 * time.Hour)
+
+	suite.save(day, []*sla.Target{north, south}, []sla.Delivery{
+		suite.delivery(2, 2, completed, 30*time.Minute),
+		suite.delivery(3, 3, completed, time.Hour),
+	})
+	suite.save(day.AddDate(0, 0, 1), []*sla.Target{north}, []sla.Delivery{
+		suite.delivery(2, 2, completed.AddDate(0, 0, 1), 30*time.Minute),
+		suite.delivery(2, 2, completed.AddDate(0, 0, 1), 40*time.Minute),
+	})
+	// Measured again, the day replaces its earlier compliance
+	suite.save(day.AddDate(0, 0, 1), []*sla.Target{north}, []sla.Delivery{
+		suite.delivery(2, 2, completed.AddDate(0, 0, 1), 30*time.Minute),
+		suite.delivery(2, 2, completed.AddDate(0, 0, 1), 40*time.Minute),
+		suite.delivery(2, 2, completed.AddDate(0, 0, 1), 50*time.Minute),
+	})
+	suite.save(day.AddDate(0, 0, 5), []*sla.Target{north}, nil)
+
+	query, err := queries.NewGetSLAReportQuery(day, day.AddDate(0, 0, 1))
+	suite.Require().NoError(err)
+	districts, err := suite.handler.Handle(ctx, query)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(districts, 2)
+
+	suite.Equal("North", districts[0].District)
+	suite.Equal(order.ExpressDelivery, districts[0].Tier)
+	suite.Equal(45*time.Minute, districts[0].DeliveryTime)
+	suite.Equal(80, districts[0].Goal)
+	suite.Equal(5, districts[0].Delivered)
+	suite.Equal(3, districts[0].OnTime)
+	suite.InDelta(60.0, districts[0].Percentage, 0.001)
+	suite.False(districts[0].Met)
+	suite.Require().Len(districts[0].Trend, 2)
+	suite.Equal(day, districts[0].Trend[0].Day)
+	suite.False(districts[0].Trend[0].Met)
+	suite.Equal(3, districts[0].Trend[1].Delivered)
+	suite.Equal(2, districts[0].Trend[1].OnTime)
+
+	suite.Equal("South", districts[1].District)
+	suite.Require().Len(districts[1].Trend, 1)
+	suite.True(districts[1].Met)
+}
+
+func (suite *GetSLAReportQueryHandlerTestSuite) TestHandle_NoCompliance_ReturnsEmptySlice() {
+	query, err := queries.NewGetSLAReportQuery(time.Now().AddDate(0, 0, -7), time.Now())
+	suite.Require().NoError(err)
+
+	districts, err := suite.handler.Handle(context.Background(), query)
+
+	suite.Require().NoError(err)
+	suite.NotNil(districts)
+	suite.Empty(districts)
+}
+
+func (suite *GetSLAReportQueryHandlerTestSuite) TestTargets_ReturnsReplacedTargets() {
+	ctx := context.Background()
+	suite.Require().NoError(suite.repository.ReplaceTargets(ctx, []*sla.Target{
+		suite.target("South", order.ExpressDelivery, time.Hour, 90),
+	}))
+	north := suite.target("North", order.EconomyDelivery, 3*time.Hour, 85)
+	suite.Require().NoError(suite.repository.ReplaceTargets(ctx, []*sla.Target{north}))
+
+	targets, err := suite.targetsHandler.Handle(ctx, queries.NewGetSLATargetsQuery())
+
+	suite.Require().NoError(err)
+	suite.Require().Len(targets, 1)
+	suite.Equal(north.ID(), targets[0].ID)
+	suite.Equal("North", targets[0].District)
+	suite.Equal([]int{1, 1, 5, 5}, []int{targets[0].FromX, targets[0].FromY, targets[0].ToX, targets[0].ToY})
+	suite.Equal(order.EconomyDelivery, targets[0].Tier)
+	suite.Equal(3*time.Hour, targets[0].DeliveryTime)
+	suite.Equal(85, targets[0].Goal)
+
+	stored, err := suite.repository.GetTargets(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(stored, 1)
+	suite.Equal(north.Zone().String(), stored[0].Zone().String())
+}
+
+func (suite *GetSLAReportQueryHandlerTestSuite) target(
+	district string,
+	tier order.DeliveryTier,
+	deliveryTime time.Duration,
+	goal int,
+) *sla.Target {
+	from, err := kernel.NewLocation(1, 1)
+	suite.Require().NoError(err)
+	to, err := kernel.NewLocation(5, 5)
+	suite.Require().NoError(err)
+	zone, err := kernel.NewZone(from, to)
+	suite.Require().NoError(err)
+
+	target, err := sla.NewTarget(kernel.NewUUID(), district, zone, tier, deliveryTime, goal)
+	suite.Require().NoError(err)
+	return target
+}
+
+func (suite *GetSLAReportQueryHandlerTestSuite) delivery(
+	x, y kernel.Coordinate,
+	completedAt time.Time,
+	took time.Duration,
+) sla.Delivery {
+	location, err := kernel.NewLocation(x, y)
+	suite.Require().NoError(err)
+	return sla.Delivery{
+		Location:    location,
+		Tier:        order.ExpressDelivery,
+		CreatedAt:   completedAt.Add(-took),
+		CompletedAt: completedAt,
+	}
+}
+
+func (suite *GetSLAReportQueryHandlerTestSuite) save(day time.Time, targets []*sla.Target, deliveries []sla.Delivery) {
+	compliance, err := sla.Measure(targets, day, deliveries)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.repository.SaveCompliance(context.Background(), day, compliance))
+}
+
+func TestGetSLAReportQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetSLAReportQueryHandlerTestSuite))
+}
@@ -0,0 +1,43 @@
+package queries_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetSLAReportQuery_Valid(t *testing.T) {
+	query, err := queries.NewGetSLAReportQuery(
+		time.Date(2025, 3, 1, 15, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 31, 9, 0, 0, 0, time.UTC),
+	)
+
+	require.NoError(t, err)
+	require.NoError(t, query.Validate())
+	assert.Equal(t, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), query.From())
+	assert.Equal(t, time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), query.To())
+}
+
+func TestNewGetSLAReportQuery_InvalidPeriod(t *testing.T) {
+	day := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := queries.NewGetSLAReportQuery(day, day.AddDate(0, 0, -1))
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+
+	_, err = queries.NewGetSLAReportQuery(day, day.AddDate(0, 0, queries.MaxSLAReportDays))
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+
+	_, err = queries.NewGetSLAReportQuery(day, day.AddDate(0, 0, queries.MaxSLAReportDays-1))
+	require.NoError(t, err)
+}
+
+func TestGetSLAReportQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetSLAReportQuery{}
+
+	require.ErrorIs(t, query.Validate(), queries.ErrGetSLAReportQueryIsNotConstructed)
+}
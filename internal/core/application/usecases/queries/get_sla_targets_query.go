@@ -0,0 +1,58 @@
+package queries
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrGetSLATargetsQueryIsNotConstructed = errors.New(
+		"GetSLATargetsQuery must be created via NewGetSLATargetsQuery constructor",
+	)
+)
+
+// GetSLATargetsQuery retrieves the SLA targets of the districts, ordered by district and delivery tier.
+//
+// Example:
+//
+//	query := NewGetSLATargetsQuery()
+//	handler := NewGetSLATargetsQueryHandler(db)
+//
+//	targets, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return fmt.Errorf("failed to get SLA targets: %w", err)
+//	}
+//	for _, t := range targets {
+//	    fmt.Printf("%s %s: %d%% within %s\n", t.District, t.Tier, t.Goal, t.DeliveryTime)
+//	}
+type GetSLATargetsQuery struct {
+	guard guard.ConstructorGuard
+}
+
+// NewGetSLATargetsQuery creates a query for the current SLA targets.
+func NewGetSLATargetsQuery() GetSLATargetsQuery {
+	return GetSLATargetsQuery{guard: guard.NewConstructorGuard()}
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetSLATargetsQueryIsNotConstructed if validation fails.
+func (q GetSLATargetsQuery) Validate() error {
+	return q.guard.Validate(ErrGetSLATargetsQueryIsNotConstructed)
+}
+
+// GetSLATargetsQueryResponse is an SLA target with the part of the grid its district covers.
+type GetSLATargetsQueryResponse struct {
+	ID           kernel.UUID
+	District     string
+	FromX        int
+	FromY        int
+	ToX          int
+	ToY          int
+	Tier         order.DeliveryTier
+	DeliveryTime time.Duration
+	Goal         int
+}
@@ -0,0 +1,92 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetSLATargetsQueryHandler retrieves the current SLA targets from the database.
+//
+// Example:
+//
+//	handler := NewGetSLATargetsQueryHandler(db)
+//	targets, err := handler.Handle(ctx, NewGetSLATargetsQuery())
+//	if err != nil {
+//	    return err
+//	}
+type GetSLATargetsQueryHandler struct {
+	db *gorm.DB
+}
+
+// NewGetSLATargetsQueryHandler creates a handler for SLA target queries.
+// Requires a GORM database connection for query execution.
+func NewGetSLATargetsQueryHandler(db *gorm.DB) GetSLATargetsQueryHandler {
+	return GetSLATargetsQueryHandler{db: db}
+}
+
+// Handle executes the query to retrieve the targets ordered by district and delivery tier.
+// Returns an empty slice if no targets are configured.
+func (h GetSLATargetsQueryHandler) Handle(
+	ctx context.Context,
+	query GetSLATargetsQuery,
+) ([]GetSLATargetsQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetSLATargetsQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	rows, err := session.Raw(`
+		SELECT id, district, from_x, from_y, to_x, to_y, delivery_tier, delivery_time_minutes, goal
+		FROM sla_targets
+		ORDER BY district, delivery_tier
+	`).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	targets := make([]GetSLATargetsQueryResponse, 0)
+	for rows.Next() {
+		var (
+			t            GetSLATargetsQueryResponse
+			id           uuid.UUID
+			tier         int
+			deliveryTime int
+		)
+
+		if err = rows.Scan(
+			&id, &t.District, &t.FromX, &t.FromY, &t.ToX, &t.ToY, &tier, &deliveryTime, &t.Goal,
+		); err != nil {
+			return nil, err
+		}
+
+		if t.ID, err = kernel.UUIDFromBytes(id[:]); err != nil {
+			return nil, err
+		}
+		t.Tier = order.DeliveryTier(tier)
+		t.DeliveryTime = time.Duration(deliveryTime) * time.Minute
+
+		targets = append(targets, t)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return targets, nil
+}
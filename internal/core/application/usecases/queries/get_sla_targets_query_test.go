@@ -0,0 +1,21 @@
+package queries_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/queries"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetSLATargetsQuery_Valid(t *testing.T) {
+	query := queries.NewGetSLATargetsQuery()
+
+	require.NoError(t, query.Validate())
+}
+
+func TestGetSLATargetsQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetSLATargetsQuery{}
+
+	require.ErrorIs(t, query.Validate(), queries.ErrGetSLATargetsQueryIsNotConstructed)
+}
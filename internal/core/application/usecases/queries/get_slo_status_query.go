@@ -0,0 +1,53 @@
+package queries
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/slo"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	ErrGetSLOStatusQueryIsNotConstructed = errors.New(
+		"GetSLOStatusQuery must be created via NewGetSLOStatusQuery constructor",
+	)
+)
+
+// GetSLOStatusQuery retrieves how the orders that went through the assignment and delivery stages
+// in the rolling window met their service level objectives, together with the number of orders
+// waiting for a courier.
+//
+// Example:
+//
+//	query := NewGetSLOStatusQuery()
+//	handler := NewGetSLOStatusQueryHandler(db, time.Hour, objectives...)
+//
+//	status, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return fmt.Errorf("failed to get SLO status: %w", err)
+//	}
+//	fmt.Printf("%d orders wait for a courier\n", status.QueueDepth)
+type GetSLOStatusQuery struct {
+	guard guard.ConstructorGuard
+}
+
+// NewGetSLOStatusQuery creates a query for the SLO status.
+func NewGetSLOStatusQuery() GetSLOStatusQuery {
+	return GetSLOStatusQuery{guard: guard.NewConstructorGuard()}
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetSLOStatusQueryIsNotConstructed if validation fails.
+func (q GetSLOStatusQuery) Validate() error {
+	return q.guard.Validate(ErrGetSLOStatusQueryIsNotConstructed)
+}
+
+// GetSLOStatusQueryResponse is the SLO status measured over the window ending at MeasuredAt.
+// Measurements hold one measurement per objective, in the order the handler was configured with.
+type GetSLOStatusQueryResponse struct {
+	QueueDepth   int64
+	Window       time.Duration
+	MeasuredAt   time.Time
+	Measurements []slo.Measurement
+}
@@ -0,0 +1,153 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/slo"
+	"delivery/internal/pkg/tracing"
+
+	"gorm.io/gorm"
+)
+
+// stageLatencyStatements select the latency in seconds of every order that left a stage in the window.
+// Both read the order_history read model, which keeps every status transition of an order,
+// and skip synthetic orders, which would skew the latencies of the real ones.
+var stageLatencyStatements = map[slo.Stage]string{
+	// The first assignment of an order ends its assignment stage; later ones follow an unassignment
+	slo.AssignmentStage: `
+		SELECT EXTRACT(EPOCH FROM h.changed_at - o.created_at)
+		FROM order_history h
+		JOIN orders o ON o.id = h.order_id
+		WHERE h.status = @assigned
+			AND h.changed_at >= @since
+			AND o.synthetic_at IS NULL
+			AND NOT EXISTS (
+				SELECT 1 FROM order_history e
+				WHERE e.order_id = h.order_id
+					AND e.status = @assigned
+					AND (e.changed_at, e.change_id) < (h.changed_at, h.change_id)
+			)
+	`,
+	// The delivery stage starts with the latest assignment before the completion,
+	// so orders handed over to another courier are measured from the handover
+	slo.DeliveryStage: `
+		SELECT EXTRACT(EPOCH FROM h.changed_at - a.changed_at)
+		FROM order_history h
+		JOIN orders o ON o.id = h.order_id
+		JOIN LATERAL (
+			SELECT e.changed_at FROM order_history e
+			WHERE e.order_id = h.order_id
+				AND e.status = @assigned
+				AND (e.changed_at, e.change_id) < (h.changed_at, h.change_id)
+			ORDER BY e.changed_at DESC, e.change_id DESC
+			LIMIT 1
+		) a ON TRUE
+		WHERE h.status = @completed
+			AND h.changed_at >= @since
+			AND o.synthetic_at IS NULL
+	`,
+}
+
+// GetSLOStatusQueryHandler measures the service level objectives of the orders that left a stage
+// within the rolling window, from the order_history read model the order history projection maintains.
+// Transitions not projected yet are measured on a later request.
+//
+// Example:
+//
+//	assignment, _ := slo.NewObjective(slo.AssignmentStage, 5*time.Minute, 95, 2)
+//	delivery, _ := slo.NewObjective(slo.DeliveryStage, 45*time.Minute, 95, 2)
+//	handler := NewGetSLOStatusQueryHandler(db, time.Hour, assignment, delivery)
+//
+//	status, err := handler.Handle(ctx, NewGetSLOStatusQuery())
+//	if err != nil {
+//	    log.Printf("Failed to get SLO status: %v", err)
+//	    return err
+//	}
+type GetSLOStatusQueryHandler struct {
+	db         *gorm.DB
+	window     time.Duration
+	objectives []slo.Objective
+}
+
+// NewGetSLOStatusQueryHandler creates a handler measuring the objectives over the given rolling window.
+// Requires a GORM database connection for query execution.
+func NewGetSLOStatusQueryHandler(db *gorm.DB, window time.Duration, objectives ...slo.Objective) GetSLOStatusQueryHandler {
+	return GetSLOStatusQueryHandler{db: db, window: window, objectives: objectives}
+}
+
+// Handle executes the query and measures every objective over the window ending now.
+func (h GetSLOStatusQueryHandler) Handle(
+	ctx context.Context,
+	query GetSLOStatusQuery,
+) (GetSLOStatusQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetSLOStatusQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return GetSLOStatusQueryResponse{}, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return GetSLOStatusQueryResponse{}, err
+	}
+	defer release()
+
+	status := GetSLOStatusQueryResponse{
+		Window:       h.window,
+		MeasuredAt:   time.Now().UTC(),
+		Measurements: make([]slo.Measurement, 0, len(h.objectives)),
+	}
+
+	err = session.Raw(`
+		SELECT COUNT(*) FROM orders
+		WHERE status = ? AND review_reason = '' AND synthetic_at IS NULL
+	`, int(order.Created)).Scan(&status.QueueDepth).Error
+	if err != nil {
+		return GetSLOStatusQueryResponse{}, err
+	}
+
+	since := status.MeasuredAt.Add(-h.window)
+	for _, objective := range h.objectives {
+		latencies, latencyErr := stageLatencies(session, objective.Stage(), since)
+		if latencyErr != nil {
+			return GetSLOStatusQueryResponse{}, latencyErr
+		}
+
+		measurement, measureErr := slo.Measure(objective, latencies)
+		if measureErr != nil {
+			return GetSLOStatusQueryResponse{}, measureErr
+		}
+		status.Measurements = append(status.Measurements, measurement)
+	}
+
+	return status, nil
+}
+
+// stageLatencies returns the latencies of the orders that left the stage since the given moment.
+func stageLatencies(session *gorm.DB, stage slo.Stage, since time.Time) ([]time.Duration, error) {
+	statement, ok := stageLatencyStatements[stage]
+	if !ok {
+		return nil, fmt.Errorf("no latency statement for SLO stage %s", stage)
+	}
+
+	var seconds []float64
+	err := session.Raw(statement, map[string]any{
+		"assigned":  int(order.Assigned),
+		"completed": int(order.Completed),
+		"since":     since,
+	}).Scan(&seconds).Error
+	if err != nil {
+		return nil, err
+	}
+
+	latencies := make([]time.Duration, len(seconds))
+	for i, s := range seconds {
+		// Clock skew between the application and the change log may make a latency slightly negative
+		latencies[i] = max(time.Duration(s*float64(time.Second)), 0)
+	}
+	return latencies, nil
+}
@@ -0,0 +1,175 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/adapters/out/postgres/orderrepo"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/slo"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetSLOStatusQueryHandlerTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	db       *gorm.DB
+	handler  queries.GetSLOStatusQueryHandler
+	changeID int64
+}
+
+func (suite *GetSLOStatusQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		if err := db.AutoMigrate(
+			&orderrepo.OrderDTO{},
+			&orderrepo.OrderMessageDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{},
+			&orderrepo.OrderItemDTO{},
+			&postgres_adapter.OrderHistoryEntryDTO{},
+		); err != nil {
+			return err
+		}
+		return postgres_adapter.ApplySyntheticDataMarkers(db)
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetSLOStatusQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetSLOStatusQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+
+	assignment, err := slo.NewObjective(slo.AssignmentStage, 5*time.Minute, 90, 2)
+	suite.Require().NoError(err)
+	delivery, err := slo.NewObjective(slo.DeliveryStage, 30*time.Minute, 90, 2)
+	suite.Require().NoError(err)
+	suite.handler = queries.NewGetSLOStatusQueryHandler(suite.db, time.Hour, assignment, delivery)
+}
+
+func (suite *GetSLOStatusQueryHandlerTestSuite) TestHandle_EmptyDatabase_MeetsObjectives() {
+	status, err := suite.handler.Handle(context.Background(), queries.NewGetSLOStatusQuery())
+
+	suite.Require().NoError(err)
+	suite.Zero(status.QueueDepth)
+	suite.Equal(time.Hour, status.Window)
+	suite.Require().Len(status.Measurements, 2)
+	for _, measurement := range status.Measurements {
+		suite.Zero(measurement.Orders())
+		suite.False(measurement.IsBurning())
+	}
+}
+
+func (suite *GetSLOStatusQueryHandlerTestSuite) TestHandle_MeasuresStagesWithinWindow() {
+	now := time.Now().UTC().Truncate(time.Second)
+
+	// Assigned 2 minutes after creation and delivered 10 minutes later
+	fast := suite.addOrder(order.Completed, now.Add(-30*time.Minute))
+	suite.addEntry(fast, order.Assigned, now.Add(-28*time.Minute))
+	suite.addEntry(fast, order.Completed, now.Add(-18*time.Minute))
+
+	// Waited 20 minutes for a courier, then was handed over and delivered 40 minutes after the handover
+	slow := suite.addOrder(order.Completed, now.Add(-100*time.Minute))
+	suite.addEntry(slow, order.Assigned, now.Add(-80*time.Minute))
+	suite.addEntry(slow, order.Created, now.Add(-70*time.Minute))
+	suite.addEntry(slow, order.Assigned, now.Add(-50*time.Minute))
+	suite.addEntry(slow, order.Completed, now.Add(-10*time.Minute))
+
+	// Assigned before the window
+	old := suite.addOrder(order.Assigned, now.Add(-3*time.Hour))
+	suite.addEntry(old, order.Assigned, now.Add(-2*time.Hour))
+
+	suite.addOrder(order.Created, now.Add(-time.Minute))
+	suite.addOrder(order.Created, now.Add(-2*time.Minute))
+
+	status, err := suite.handler.Handle(context.Background(), queries.NewGetSLOStatusQuery())
+
+	suite.Require().NoError(err)
+	suite.Equal(int64(2), status.QueueDepth)
+	suite.Require().Len(status.Measurements, 2)
+
+	// The second assignment of the slow order follows an unassignment and does not end its assignment stage
+	assignment := status.Measurements[0]
+	suite.Equal(slo.AssignmentStage, assignment.Objective().Stage())
+	suite.Equal(1, assignment.Orders())
+	suite.Equal(2*time.Minute, assignment.Percentiles().P99)
+
+	delivery := status.Measurements[1]
+	suite.Equal(slo.DeliveryStage, delivery.Objective().Stage())
+	suite.Equal(2, delivery.Orders())
+	suite.Equal(1, delivery.Within())
+	suite.Equal(10*time.Minute, delivery.Percentiles().P50)
+	suite.Equal(40*time.Minute, delivery.Percentiles().P99)
+	suite.True(delivery.IsBurning())
+}
+
+func (suite *GetSLOStatusQueryHandlerTestSuite) TestHandle_SkipsSyntheticOrders() {
+	now := time.Now().UTC().Truncate(time.Second)
+	synthetic := suite.addOrder(order.Assigned, now.Add(-30*time.Minute))
+	suite.addEntry(synthetic, order.Assigned, now.Add(-time.Minute))
+	suite.addOrder(order.Created, now)
+	suite.Require().NoError(suite.db.Exec(`UPDATE orders SET synthetic_at = ?`, now).Error)
+
+	status, err := suite.handler.Handle(context.Background(), queries.NewGetSLOStatusQuery())
+
+	suite.Require().NoError(err)
+	suite.Zero(status.QueueDepth)
+	suite.Zero(status.Measurements[0].Orders())
+}
+
+func (suite *GetSLOStatusQueryHandlerTestSuite) TestHandle_InvalidQuery_ReturnsError() {
+	_, err := suite.handler.Handle(context.Background(), queries.GetSLOStatusQuery{})
+
+	suite.Require().ErrorIs(err, queries.ErrGetSLOStatusQueryIsNotConstructed)
+}
+
+// addOrder saves a new order created at the given moment and brought to the given status.
+func (suite *GetSLOStatusQueryHandlerTestSuite) addOrder(status order.Status, createdAt time.Time) kernel.UUID {
+	location, err := kernel.NewLocation(3, 3)
+	suite.Require().NoError(err)
+	o, err := order.NewOrder(kernel.NewUUID(), location, 5)
+	suite.Require().NoError(err)
+	if status == order.Assigned || status == order.Completed {
+		suite.Require().NoError(o.Assign(kernel.NewUUID()))
+	}
+	if status == order.Completed {
+		suite.Require().NoError(o.Complete())
+	}
+
+	repo := orderrepo.NewGormOrderRepository(suite.db, &mockAggregateTracker{})
+	suite.Require().NoError(repo.Add(context.Background(), o))
+	err = suite.db.Exec(`UPDATE orders SET created_at = ? WHERE id = ?`, createdAt, o.ID().Bytes()).Error
+	suite.Require().NoError(err)
+	return o.ID()
+}
+
+// addEntry records a transition of the order, after all transitions recorded before.
+func (suite *GetSLOStatusQueryHandlerTestSuite) addEntry(orderID kernel.UUID, status order.Status, changedAt time.Time) {
+	suite.changeID++
+	entry := postgres_adapter.OrderHistoryEntryDTO{
+		ID:        uuid.New(),
+		OrderID:   orderID.Bytes(),
+		Status:    int(status),
+		ChangedAt: changedAt,
+		ChangeID:  suite.changeID,
+	}
+	suite.Require().NoError(suite.db.Create(&entry).Error)
+}
+
+func TestGetSLOStatusQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetSLOStatusQueryHandlerTestSuite))
+}
@@ -0,0 +1,21 @@
+package queries_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/queries"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetSLOStatusQuery_Valid(t *testing.T) {
+	query := queries.NewGetSLOStatusQuery()
+
+	require.NoError(t, query.Validate())
+}
+
+func TestGetSLOStatusQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetSLOStatusQuery{}
+
+	require.ErrorIs(t, query.Validate(), queries.ErrGetSLOStatusQueryIsNotConstructed)
+}
@@ -0,0 +1,67 @@
+package queries
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/surge"
+	"delivery/internal/pkg/guard"
+)
+
+// SurgeTogglesHistoryLimit is the number of most recent surge toggles the audit trail returns.
+const SurgeTogglesHistoryLimit = 50
+
+var (
+	ErrGetSurgeModeQueryIsNotConstructed = errors.New(
+		"GetSurgeModeQuery must be created via NewGetSurgeModeQuery constructor",
+	)
+)
+
+// GetSurgeModeQuery retrieves the current surge mode with the audit trail of its toggles,
+// newest first.
+//
+// Example:
+//
+//	query := NewGetSurgeModeQuery()
+//	handler := NewGetSurgeModeQueryHandler(db)
+//
+//	state, err := handler.Handle(ctx, query)
+//	if err != nil {
+//	    return fmt.Errorf("failed to get surge mode: %w", err)
+//	}
+//	fmt.Printf("surge %s, active: %t\n", state.Setting, state.Active)
+type GetSurgeModeQuery struct {
+	guard guard.ConstructorGuard
+}
+
+// NewGetSurgeModeQuery creates a query for the surge mode.
+func NewGetSurgeModeQuery() GetSurgeModeQuery {
+	return GetSurgeModeQuery{guard: guard.NewConstructorGuard()}
+}
+
+// Validate ensures the query was created through the constructor.
+// Returns ErrGetSurgeModeQueryIsNotConstructed if validation fails.
+func (q GetSurgeModeQuery) Validate() error {
+	return q.guard.Validate(ErrGetSurgeModeQueryIsNotConstructed)
+}
+
+// GetSurgeModeQueryResponse is the current surge mode and its latest toggles.
+// Before the first toggle the mode is set to auto and inactive.
+type GetSurgeModeQueryResponse struct {
+	Setting surge.Setting
+	Active  bool
+	Toggles []SurgeToggle
+}
+
+// SurgeToggle is an entry of the surge mode audit trail.
+type SurgeToggle struct {
+	ID        kernel.UUID
+	Source    surge.Source
+	Setting   surge.Setting
+	WasActive bool
+	Active    bool
+	Backlog   int
+	Reason    string
+	ToggledAt time.Time
+}
@@ -0,0 +1,100 @@
+package queries
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/surge"
+	"delivery/internal/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetSurgeModeQueryHandler retrieves the surge mode and its audit trail from the database.
+//
+// Example:
+//
+//	handler := NewGetSurgeModeQueryHandler(db)
+//	state, err := handler.Handle(ctx, NewGetSurgeModeQuery())
+//	if err != nil {
+//	    return err
+//	}
+type GetSurgeModeQueryHandler struct {
+	db *gorm.DB
+}
+
+// NewGetSurgeModeQueryHandler creates a handler for surge mode queries.
+// Requires a GORM database connection for query execution.
+func NewGetSurgeModeQueryHandler(db *gorm.DB) GetSurgeModeQueryHandler {
+	return GetSurgeModeQueryHandler{db: db}
+}
+
+// Handle executes the query to retrieve the surge mode left behind by the latest toggle and
+// the SurgeTogglesHistoryLimit most recent toggles.
+func (h GetSurgeModeQueryHandler) Handle(
+	ctx context.Context,
+	query GetSurgeModeQuery,
+) (GetSurgeModeQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetSurgeModeQuery")
+	defer span.End()
+
+	if err := query.Validate(); err != nil {
+		return GetSurgeModeQueryResponse{}, err
+	}
+
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return GetSurgeModeQueryResponse{}, err
+	}
+	defer release()
+
+	rows, err := session.Raw(`
+		SELECT id, source, setting, was_active, active, backlog, reason, toggled_at
+		FROM surge_toggles
+		ORDER BY toggled_at DESC, id
+		LIMIT ?
+	`, SurgeTogglesHistoryLimit).Rows()
+	if err != nil {
+		return GetSurgeModeQueryResponse{}, err
+	}
+	defer rows.Close()
+
+	mode := surge.DefaultMode()
+	response := GetSurgeModeQueryResponse{Setting: mode.Setting(), Active: mode.Active(), Toggles: make([]SurgeToggle, 0)}
+	for rows.Next() {
+		var (
+			toggle          SurgeToggle
+			id              uuid.UUID
+			source, setting string
+		)
+
+		if err = rows.Scan(&id, &source, &setting, &toggle.WasActive, &toggle.Active,
+			&toggle.Backlog, &toggle.Reason, &toggle.ToggledAt); err != nil {
+			return GetSurgeModeQueryResponse{}, err
+		}
+
+		if toggle.ID, err = kernel.UUIDFromBytes(id[:]); err != nil {
+			return GetSurgeModeQueryResponse{}, err
+		}
+		if toggle.Source, err = surge.ParseSource(source); err != nil {
+			return GetSurgeModeQueryResponse{}, err
+		}
+		if toggle.Setting, err = surge.ParseSetting(setting); err != nil {
+			return GetSurgeModeQueryResponse{}, err
+		}
+
+		response.Toggles = append(response.Toggles, toggle)
+	}
+
+	if err = rows.Err(); err != nil {
+		return GetSurgeModeQueryResponse{}, err
+	}
+
+	if len(response.Toggles) > 0 {
+		response.Setting = response.Toggles[0].Setting
+		response.Active = response.Toggles[0].Active
+	}
+
+	return response, nil
+}
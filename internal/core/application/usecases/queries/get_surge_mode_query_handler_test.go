@@ -0,0 +1,98 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	postgres_adapter "delivery/internal/adapters/out/postgres"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/surge"
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type GetSurgeModeQueryHandlerTestSuite struct {
+	suite.Suite
+	template *pgtest.Template
+	db       *gorm.DB
+	handler  queries.GetSurgeModeQueryHandler
+	surges   *postgres_adapter.GormSurgeRepository
+}
+
+func (suite *GetSurgeModeQueryHandlerTestSuite) SetupSuite() {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(&postgres_adapter.SurgeToggleDTO{})
+	})
+	suite.Require().NoError(err)
+	suite.template = template
+}
+
+func (suite *GetSurgeModeQueryHandlerTestSuite) TearDownSuite() {
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *GetSurgeModeQueryHandlerTestSuite) SetupTest() {
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetSurgeModeQueryHandler(suite.db)
+	suite.surges = postgres_adapter.NewGormSurgeRepository(suite.db)
+}
+
+func (suite *GetSurgeModeQueryHandlerTestSuite) TestHandle_NeverToggled_ReturnsDefaultMode() {
+	state, err := suite.handler.Handle(context.Background(), queries.NewGetSurgeModeQuery())
+
+	suite.Require().NoError(err)
+	suite.Equal(surge.Auto, state.Setting)
+	suite.False(state.Active)
+	suite.Empty(state.Toggles)
+}
+
+func (suite *GetSurgeModeQueryHandlerTestSuite) TestHandle_ReturnsLatestToggleFirst() {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	policy, err := surge.NewPolicy(100, 15*time.Minute)
+	suite.Require().NoError(err)
+
+	activated, switched, err := surge.DefaultMode().Observe(kernel.NewUUID(), 120, policy, now.Add(-time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().True(switched)
+	suite.Require().NoError(suite.surges.AddToggle(ctx, activated))
+	forcedOff, err := activated.Mode().Change(kernel.NewUUID(), surge.ForcedOff, 110, policy, "Bonus budget spent", now)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.surges.AddToggle(ctx, forcedOff))
+
+	state, err := suite.handler.Handle(ctx, queries.NewGetSurgeModeQuery())
+
+	suite.Require().NoError(err)
+	suite.Equal(surge.ForcedOff, state.Setting)
+	suite.False(state.Active)
+	suite.Require().Len(state.Toggles, 2)
+	suite.Equal(forcedOff.ID(), state.Toggles[0].ID)
+	suite.Equal(surge.Manual, state.Toggles[0].Source)
+	suite.True(state.Toggles[0].WasActive)
+	suite.Equal("Bonus budget spent", state.Toggles[0].Reason)
+	suite.True(now.Equal(state.Toggles[0].ToggledAt))
+	suite.Equal(surge.Automatic, state.Toggles[1].Source)
+	suite.Equal(120, state.Toggles[1].Backlog)
+
+	mode, err := suite.surges.GetMode(ctx)
+	suite.Require().NoError(err)
+	suite.Equal(forcedOff.Mode(), mode)
+}
+
+func (suite *GetSurgeModeQueryHandlerTestSuite) TestHandle_InvalidQuery_ReturnsError() {
+	_, err := suite.handler.Handle(context.Background(), queries.GetSurgeModeQuery{})
+
+	suite.Require().ErrorIs(err, queries.ErrGetSurgeModeQueryIsNotConstructed)
+}
+
+func TestGetSurgeModeQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetSurgeModeQueryHandlerTestSuite))
+}
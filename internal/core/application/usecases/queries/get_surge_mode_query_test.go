@@ -0,0 +1,21 @@
+package queries_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/application/usecases/queries"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGetSurgeModeQuery_Valid(t *testing.T) {
+	query := queries.NewGetSurgeModeQuery()
+
+	require.NoError(t, query.Validate())
+}
+
+func TestGetSurgeModeQuery_NotConstructedViaConstructor(t *testing.T) {
+	query := queries.GetSurgeModeQuery{}
+
+	require.ErrorIs(t, query.Validate(), queries.ErrGetSurgeModeQueryIsNotConstructed)
+}
@@ -2,8 +2,10 @@ package queries
 
 import (
 	"errors"
+	"time"
 
 	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
 	"delivery/internal/pkg/guard"
 )
 
@@ -59,4 +61,33 @@ func (q GetUncompletedOrdersQuery) Validate() error {
 type GetUncompletedOrdersQueryResponse struct {
 	ID       kernel.UUID
 	Location kernel.Location
+	Volume   int
+	// Items lists the order's contents; empty for orders created by volume alone.
+	Items []OrderItemLine
+	// PaymentMethod and PaymentStatus tell whether the order can be dispatched:
+	// orders paid online wait in the queue until they are paid.
+	PaymentMethod order.PaymentMethod
+	PaymentStatus order.PaymentStatus
+	// DeliveryTier is the speed of delivery the customer ordered.
+	DeliveryTier order.DeliveryTier
+	// BatchClosesAt is when an economy order enters dispatch; nil once its batch is released
+	// and for express orders.
+	BatchClosesAt *time.Time
+	// DeclaredValue is the value of the order's contents in minor currency units; zero if not declared.
+	DeclaredValue int
+	// InsuranceRequired reports whether the order is insured: its courier confirms the pickup
+	// and the delivery, which are nil until confirmed.
+	InsuranceRequired   bool
+	PickupConfirmedAt   *time.Time
+	DeliveryConfirmedAt *time.Time
+	// RouteDeviatedAt is when the order was flagged for the dispatcher's attention because its
+	// courier strayed from the route; nil if it was not flagged.
+	RouteDeviatedAt *time.Time
+}
+
+// OrderItemLine is one line of an order's contents.
+type OrderItemLine struct {
+	SKU        string
+	Quantity   int
+	UnitVolume int
 }
@@ -5,13 +5,14 @@ import (
 
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/tracing"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
 // GetUncompletedOrdersQueryHandler retrieves orders pending delivery from the database.
-// Filters out completed orders to provide active delivery workload visibility.
+// Filters out completed and cancelled orders to provide active delivery workload visibility.
 //
 // Example:
 //
@@ -38,27 +39,46 @@ func NewGetUncompletedOrdersQueryHandler(db *gorm.DB) GetUncompletedOrdersQueryH
 }
 
 // Handle executes the query to retrieve all uncompleted orders.
-// Returns orders in "created" or "assigned" status, excluding completed deliveries.
-// Results are sorted by order ID for consistent output.
+// Returns orders in "created" or "assigned" status, excluding completed and cancelled orders,
+// together with their item lines, payment status, delivery tier and route deviation flag. Results are sorted by order ID for consistent output.
 func (h GetUncompletedOrdersQueryHandler) Handle(
 	ctx context.Context,
 	query GetUncompletedOrdersQuery,
 ) ([]GetUncompletedOrdersQueryResponse, error) {
+	ctx, span := tracing.Start(ctx, "GetUncompletedOrdersQuery")
+	defer span.End()
+
 	if err := query.Validate(); err != nil {
 		return nil, err
 	}
 
+	session, release, err := tenantSession(ctx, h.db)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	orders := make([]GetUncompletedOrdersQueryResponse, 0)
 
-	rows, err := h.db.WithContext(ctx).Raw(`
+	rows, err := session.Raw(`
 		SELECT 
 			id, 
 			location_x, 
-			location_y 
+			location_y,
+			volume,
+			payment_method,
+			payment_status,
+			delivery_tier,
+			batch_closes_at,
+			declared_value,
+			insurance_required,
+			pickup_confirmed_at,
+			delivery_confirmed_at,
+			route_deviated_at
 		FROM orders
-		WHERE status != ?
+		WHERE status NOT IN (?, ?)
 		ORDER BY id
-	`, int(order.Completed)).Rows()
+	`, int(order.Completed), int(order.Cancelled)).Rows()
 	if err != nil {
 		return nil, err
 	}
@@ -73,6 +93,16 @@ func (h GetUncompletedOrdersQueryHandler) Handle(
 			&id,
 			&locationX,
 			&locationY,
+			&orderResp.Volume,
+			&orderResp.PaymentMethod,
+			&orderResp.PaymentStatus,
+			&orderResp.DeliveryTier,
+			&orderResp.BatchClosesAt,
+			&orderResp.DeclaredValue,
+			&orderResp.InsuranceRequired,
+			&orderResp.PickupConfirmedAt,
+			&orderResp.DeliveryConfirmedAt,
+			&orderResp.RouteDeviatedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -92,6 +122,7 @@ func (h GetUncompletedOrdersQueryHandler) Handle(
 			return nil, locErr
 		}
 		orderResp.Location = location
+		orderResp.Items = make([]OrderItemLine, 0)
 		orders = append(orders, orderResp)
 	}
 
@@ -99,5 +130,53 @@ func (h GetUncompletedOrdersQueryHandler) Handle(
 		return nil, err
 	}
 
+	if err = h.attachItems(session, orders); err != nil {
+		return nil, err
+	}
+
 	return orders, nil
 }
+
+// attachItems loads the item lines of all uncompleted orders in one query
+// and appends them to the matching responses in their original order.
+func (h GetUncompletedOrdersQueryHandler) attachItems(
+	session *gorm.DB,
+	orders []GetUncompletedOrdersQueryResponse,
+) error {
+	byID := make(map[uuid.UUID]int, len(orders))
+	for i, o := range orders {
+		byID[o.ID.Bytes()] = i
+	}
+
+	rows, err := session.Raw(`
+		SELECT
+			i.order_id,
+			i.sku,
+			i.quantity,
+			i.unit_volume
+		FROM order_items i
+		JOIN orders o ON o.id = i.order_id
+		WHERE o.status NOT IN (?, ?)
+		ORDER BY i.order_id, i.position
+	`, int(order.Completed), int(order.Cancelled)).Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var line OrderItemLine
+		var id uuid.UUID
+
+		if err = rows.Scan(&id, &line.SKU, &line.Quantity, &line.UnitVolume); err != nil {
+			return err
+		}
+
+		// Orders changing status between the two reads are simply skipped
+		if i, ok := byID[id]; ok {
+			orders[i].Items = append(orders[i].Items, line)
+		}
+	}
+
+	return rows.Err()
+}
@@ -11,18 +11,15 @@ import (
 	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/pgtest"
 
 	"github.com/stretchr/testify/suite"
-	"github.com/testcontainers/testcontainers-go"
-	"github.com/testcontainers/testcontainers-go/modules/postgres"
-	"github.com/testcontainers/testcontainers-go/wait"
-	gorm_postgres "gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
 type GetUncompletedOrdersQueryHandlerTestSuite struct {
 	suite.Suite
-	container   *postgres.PostgresContainer
+	template    *pgtest.Template
 	db          *gorm.DB
 	handler     queries.GetUncompletedOrdersQueryHandler
 	orderRepo   *orderrepo.GormOrderRepository
@@ -31,54 +28,42 @@ type GetUncompletedOrdersQueryHandlerTestSuite struct {
 }
 
 func (suite *GetUncompletedOrdersQueryHandlerTestSuite) SetupSuite() {
-	ctx := context.Background()
-
-	container, err := postgres.Run(ctx,
-		"postgres:15-alpine",
-		postgres.WithDatabase("testdb"),
-		postgres.WithUsername("testuser"),
-		postgres.WithPassword("testpass"),
-		testcontainers.WithWaitStrategy(
-			wait.ForLog("database system is ready to accept connections").
-				WithOccurrence(2).
-				WithStartupTimeout(30*time.Second),
-		),
-	)
-	suite.Require().NoError(err)
-	suite.container = container
-
-	dsn, err := container.ConnectionString(ctx, "sslmode=disable")
-	suite.Require().NoError(err)
-
-	db, err := gorm.Open(gorm_postgres.Open(dsn), &gorm.Config{})
-	suite.Require().NoError(err)
-	suite.db = db
-
-	err = db.AutoMigrate(&orderrepo.OrderDTO{}, &courierrepo.CourierDTO{}, &courierrepo.StoragePlaceDTO{})
-	suite.Require().NoError(err)
-
-	suite.handler = queries.NewGetUncompletedOrdersQueryHandler(db)
-	suite.orderRepo = orderrepo.NewGormOrderRepository(db, &mockAggregateTracker{})
-	suite.courierRepo = courierrepo.NewGormCourierRepository(db, &mockAggregateTracker{})
-
-	// Create a test courier for assigned orders
-	location, err := kernel.NewLocation(5, 5)
-	suite.Require().NoError(err)
-	suite.testCourier, err = courier.NewCourier(kernel.NewUUID(), "Test Courier", 3, location)
-	suite.Require().NoError(err)
-	err = suite.courierRepo.Add(ctx, suite.testCourier)
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		return db.AutoMigrate(
+			&orderrepo.OrderDTO{},
+			&orderrepo.OrderMessageDTO{},
+			&orderrepo.OrderPaymentTransitionDTO{},
+			&orderrepo.OrderItemDTO{},
+			&courierrepo.CourierDTO{},
+			&courierrepo.StoragePlaceDTO{},
+			&courierrepo.MaintenanceWindowDTO{},
+			&courierrepo.AbsenceDTO{},
+			&courierrepo.ScheduleWindowDTO{},
+		)
+	})
 	suite.Require().NoError(err)
+	suite.template = template
 }
 
 func (suite *GetUncompletedOrdersQueryHandlerTestSuite) TearDownSuite() {
-	if suite.container != nil {
-		err := suite.container.Terminate(context.Background())
+	if suite.template != nil {
+		err := suite.template.Terminate(context.Background())
 		suite.Require().NoError(err)
 	}
 }
 
 func (suite *GetUncompletedOrdersQueryHandlerTestSuite) SetupTest() {
-	err := suite.db.Exec("TRUNCATE TABLE orders CASCADE").Error
+	suite.db = suite.template.NewDatabase(suite.T())
+	suite.handler = queries.NewGetUncompletedOrdersQueryHandler(suite.db)
+	suite.orderRepo = orderrepo.NewGormOrderRepository(suite.db, &mockAggregateTracker{})
+	suite.courierRepo = courierrepo.NewGormCourierRepository(suite.db, &mockAggregateTracker{})
+
+	// Create a test courier for assigned orders
+	location, err := kernel.NewLocation(5, 5)
+	suite.Require().NoError(err)
+	suite.testCourier, err = courier.NewCourier(kernel.NewUUID(), "Test Courier", 3, location)
+	suite.Require().NoError(err)
+	err = suite.courierRepo.Add(context.Background(), suite.testCourier)
 	suite.Require().NoError(err)
 }
 
@@ -158,6 +143,140 @@ func (suite *GetUncompletedOrdersQueryHandlerTestSuite) TestHandle_WithMixedStat
 	}
 }
 
+func (suite *GetUncompletedOrdersQueryHandlerTestSuite) TestHandle_OrderWithItems_ReturnsItemLines() {
+	location, _ := kernel.NewLocation(3, 4)
+	books, err := order.NewItem("BOOK-1", 2, 3)
+	suite.Require().NoError(err)
+	lamp, err := order.NewItem("LAMP-7", 1, 4)
+	suite.Require().NoError(err)
+	withItems, err := order.NewOrderWithItems(kernel.NewUUID(), location, []order.Item{lamp, books})
+	suite.Require().NoError(err)
+	byVolume, err := order.NewOrder(kernel.NewUUID(), location, 7)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.orderRepo.Add(context.Background(), withItems))
+	suite.Require().NoError(suite.orderRepo.Add(context.Background(), byVolume))
+
+	result, err := suite.handler.Handle(context.Background(), queries.NewGetUncompletedOrdersQuery())
+
+	suite.Require().NoError(err)
+	suite.Require().Len(result, 2)
+	for _, r := range result {
+		if r.ID == withItems.ID() {
+			suite.Equal(10, r.Volume)
+			suite.Equal([]queries.OrderItemLine{
+				{SKU: "LAMP-7", Quantity: 1, UnitVolume: 4},
+				{SKU: "BOOK-1", Quantity: 2, UnitVolume: 3},
+			}, r.Items)
+			continue
+		}
+		suite.Equal(7, r.Volume)
+		suite.Empty(r.Items)
+	}
+}
+
+func (suite *GetUncompletedOrdersQueryHandlerTestSuite) TestHandle_ReturnsPaymentStatus() {
+	location, _ := kernel.NewLocation(3, 4)
+	cashOrder, err := order.NewOrder(kernel.NewUUID(), location, 5)
+	suite.Require().NoError(err)
+	paidOrder, err := order.NewOrder(kernel.NewUUID(), location, 5)
+	suite.Require().NoError(err)
+	suite.Require().NoError(paidOrder.ChangePaymentMethod(order.OnlinePayment))
+	_, err = paidOrder.ApplyPaymentEvent(order.PaymentPaid, "evt_1", time.Now())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.orderRepo.Add(context.Background(), cashOrder))
+	suite.Require().NoError(suite.orderRepo.Add(context.Background(), paidOrder))
+
+	result, err := suite.handler.Handle(context.Background(), queries.NewGetUncompletedOrdersQuery())
+
+	suite.Require().NoError(err)
+	suite.Require().Len(result, 2)
+	for _, r := range result {
+		if r.ID == paidOrder.ID() {
+			suite.Equal(order.OnlinePayment, r.PaymentMethod)
+			suite.Equal(order.PaymentPaid, r.PaymentStatus)
+			continue
+		}
+		suite.Equal(order.CashOnDelivery, r.PaymentMethod)
+		suite.Equal(order.PaymentPending, r.PaymentStatus)
+	}
+}
+
+func (suite *GetUncompletedOrdersQueryHandlerTestSuite) TestHandle_ReturnsDeliveryTier() {
+	location, _ := kernel.NewLocation(3, 4)
+	window, _ := order.NewBatchingWindow(30 * time.Minute)
+	expressOrder, err := order.NewOrder(kernel.NewUUID(), location, 5)
+	suite.Require().NoError(err)
+	economyOrder, err := order.NewOrder(kernel.NewUUID(), location, 5)
+	suite.Require().NoError(err)
+	suite.Require().NoError(economyOrder.ChangeDeliveryTier(order.EconomyDelivery, window, time.Now()))
+
+	suite.Require().NoError(suite.orderRepo.Add(context.Background(), expressOrder))
+	suite.Require().NoError(suite.orderRepo.Add(context.Background(), economyOrder))
+
+	result, err := suite.handler.Handle(context.Background(), queries.NewGetUncompletedOrdersQuery())
+
+	suite.Require().NoError(err)
+	suite.Require().Len(result, 2)
+	for _, r := range result {
+		if r.ID == economyOrder.ID() {
+			suite.Equal(order.EconomyDelivery, r.DeliveryTier)
+			suite.Require().NotNil(r.BatchClosesAt)
+			suite.WithinDuration(*economyOrder.BatchClosesAt(), *r.BatchClosesAt, time.Millisecond)
+			continue
+		}
+		suite.Equal(order.ExpressDelivery, r.DeliveryTier)
+		suite.Nil(r.BatchClosesAt)
+	}
+}
+
+func (suite *GetUncompletedOrdersQueryHandlerTestSuite) TestHandle_ReturnsDeclaredValue() {
+	location, _ := kernel.NewLocation(3, 4)
+	threshold, _ := order.NewInsuranceThreshold(100_000)
+	insuredOrder, err := order.NewOrder(kernel.NewUUID(), location, 5)
+	suite.Require().NoError(err)
+	suite.Require().NoError(insuredOrder.DeclareValue(250_000, threshold))
+	suite.Require().NoError(insuredOrder.Assign(kernel.NewUUID()))
+	suite.Require().NoError(insuredOrder.ConfirmPickup(time.Now()))
+
+	suite.Require().NoError(suite.orderRepo.Add(context.Background(), insuredOrder))
+
+	result, err := suite.handler.Handle(context.Background(), queries.NewGetUncompletedOrdersQuery())
+
+	suite.Require().NoError(err)
+	suite.Require().Len(result, 1)
+	suite.Equal(250_000, result[0].DeclaredValue)
+	suite.True(result[0].InsuranceRequired)
+	suite.Require().NotNil(result[0].PickupConfirmedAt)
+	suite.WithinDuration(*insuredOrder.PickupConfirmedAt(), *result[0].PickupConfirmedAt, time.Millisecond)
+	suite.Nil(result[0].DeliveryConfirmedAt)
+}
+
+func (suite *GetUncompletedOrdersQueryHandlerTestSuite) TestHandle_ReturnsRouteDeviation() {
+	target, _ := kernel.NewLocation(5, 5)
+	origin, _ := kernel.NewLocation(1, 1)
+	away, _ := kernel.NewLocation(9, 9)
+	policy, _ := order.NewRouteDeviationPolicy(0, 1)
+	deviatedOrder, err := order.NewOrder(kernel.NewUUID(), target, 5)
+	suite.Require().NoError(err)
+	suite.Require().NoError(deviatedOrder.Assign(kernel.NewUUID()))
+	_, _, err = deviatedOrder.TrackRoute(origin, policy, time.Now())
+	suite.Require().NoError(err)
+	_, flagged, err := deviatedOrder.TrackRoute(away, policy, time.Now())
+	suite.Require().NoError(err)
+	suite.Require().True(flagged)
+
+	suite.Require().NoError(suite.orderRepo.Add(context.Background(), deviatedOrder))
+
+	result, err := suite.handler.Handle(context.Background(), queries.NewGetUncompletedOrdersQuery())
+
+	suite.Require().NoError(err)
+	suite.Require().Len(result, 1)
+	suite.Require().NotNil(result[0].RouteDeviatedAt)
+	suite.WithinDuration(*deviatedOrder.RouteDeviatedAt(), *result[0].RouteDeviatedAt, time.Millisecond)
+}
+
 func (suite *GetUncompletedOrdersQueryHandlerTestSuite) TestHandle_InvalidQuery_ReturnsError() {
 	invalidQuery := queries.GetUncompletedOrdersQuery{}
 
@@ -321,5 +440,6 @@ func (suite *GetUncompletedOrdersQueryHandlerTestSuite) createCompletedOrders()
 }
 
 func TestGetUncompletedOrdersQueryHandlerTestSuite(t *testing.T) {
+	t.Parallel()
 	suite.Run(t, new(GetUncompletedOrdersQueryHandlerTestSuite))
 }
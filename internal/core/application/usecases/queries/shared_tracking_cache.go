@@ -0,0 +1,183 @@
+package queries
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tenant"
+
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// trackingKeyPrefix prefixes the cache keys of tracking views, which are scoped by tenant and token.
+	trackingKeyPrefix = "tracking:"
+
+	// trackingOrderKeyPrefix prefixes the cache keys naming the tracking view of an order,
+	// so order events can invalidate the view without knowing its token.
+	trackingOrderKeyPrefix = "tracking-order:"
+)
+
+// ErrTrackingCacheTTLIsInvalid is returned when a tracking cache is created with a non-positive TTL.
+var ErrTrackingCacheTTLIsInvalid = errors.New("tracking cache TTL must be positive")
+
+// SharedTrackingCache keeps the customer tracking views for a short time, so traffic spikes on the
+// public tracking page do not reach the database. Concurrent misses of the same view are coalesced
+// into one database read. Views are invalidated on order events; a view changed without an event,
+// for example by the courier moving, is served for at most the TTL.
+// Failures of the cache backend are not reported: the view is read from the database instead.
+type SharedTrackingCache struct {
+	cache  ports.ReadCache
+	ttl    time.Duration
+	flight singleflight.Group
+}
+
+// NewSharedTrackingCache creates a cache keeping tracking views in cache for ttl.
+// Returns ErrTrackingCacheTTLIsInvalid if ttl is not positive.
+func NewSharedTrackingCache(cache ports.ReadCache, ttl time.Duration) (*SharedTrackingCache, error) {
+	if ttl <= 0 {
+		return nil, ErrTrackingCacheTTLIsInvalid
+	}
+
+	return &SharedTrackingCache{cache: cache, ttl: ttl}, nil
+}
+
+// Invalidate drops the cached tracking views of the orders, so their next request reads the database.
+func (c *SharedTrackingCache) Invalidate(ctx context.Context, orderIDs ...string) error {
+	keys := make([]string, 0, 2*len(orderIDs))
+	for _, orderID := range orderIDs {
+		orderKey := trackingOrderKeyPrefix + orderID
+		trackingKey, ok, err := c.cache.Get(ctx, orderKey)
+		if err != nil {
+			return err
+		}
+		if ok {
+			keys = append(keys, orderKey, string(trackingKey))
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return c.cache.Delete(ctx, keys...)
+}
+
+// get returns the cached view of the token, reading a missing one with load.
+func (c *SharedTrackingCache) get(
+	ctx context.Context,
+	token order.TrackingToken,
+	load func(ctx context.Context) (GetSharedTrackingQueryResponse, error),
+) (GetSharedTrackingQueryResponse, error) {
+	key := trackingKey(ctx, token)
+	if cached, ok, err := c.cache.Get(ctx, key); err == nil && ok {
+		if tracking, decodeErr := decodeTracking(cached); decodeErr == nil {
+			return tracking, nil
+		}
+	}
+
+	// The first request reads the view for every request waiting on it, so it must not be
+	// canceled when that one request goes away.
+	loaded, err, _ := c.flight.Do(key, func() (any, error) {
+		loadCtx := context.WithoutCancel(ctx)
+		tracking, loadErr := load(loadCtx)
+		if loadErr != nil {
+			return GetSharedTrackingQueryResponse{}, loadErr
+		}
+		c.store(loadCtx, key, tracking)
+		return tracking, nil
+	})
+	if err != nil {
+		return GetSharedTrackingQueryResponse{}, err
+	}
+	return loaded.(GetSharedTrackingQueryResponse), nil
+}
+
+// store caches the view and indexes it by the order.
+func (c *SharedTrackingCache) store(ctx context.Context, key string, tracking GetSharedTrackingQueryResponse) {
+	encoded, err := encodeTracking(tracking)
+	if err != nil {
+		return
+	}
+	if err = c.cache.Set(ctx, key, encoded, c.ttl); err != nil {
+		return
+	}
+	_ = c.cache.Set(ctx, trackingOrderKeyPrefix+tracking.OrderID.String(), []byte(key), c.ttl)
+}
+
+// trackingKey scopes the token by the tenant, so a token never reveals the order of another tenant.
+func trackingKey(ctx context.Context, token order.TrackingToken) string {
+	id, _ := tenant.FromContext(ctx)
+	return trackingKeyPrefix + id.String() + ":" + token.String()
+}
+
+// cachedTracking is the serialized form of a tracking view.
+type cachedTracking struct {
+	OrderID       string                 `json:"orderId"`
+	Status        int                    `json:"status"`
+	BatchClosesAt *time.Time             `json:"batchClosesAt,omitempty"`
+	Courier       *cachedTrackingCourier `json:"courier,omitempty"`
+}
+
+type cachedTrackingCourier struct {
+	FirstName string  `json:"firstName"`
+	PhotoURL  *string `json:"photoUrl,omitempty"`
+	X         int     `json:"x"`
+	Y         int     `json:"y"`
+	ETA       int     `json:"eta"`
+}
+
+func encodeTracking(tracking GetSharedTrackingQueryResponse) ([]byte, error) {
+	cached := cachedTracking{
+		OrderID:       tracking.OrderID.String(),
+		Status:        int(tracking.Status),
+		BatchClosesAt: tracking.BatchClosesAt,
+	}
+	if tracking.Courier != nil {
+		cached.Courier = &cachedTrackingCourier{
+			FirstName: tracking.Courier.FirstName,
+			PhotoURL:  tracking.Courier.PhotoURL,
+			X:         int(tracking.Courier.ApproximateLocation.X()),
+			Y:         int(tracking.Courier.ApproximateLocation.Y()),
+			ETA:       tracking.Courier.ETA,
+		}
+	}
+	return json.Marshal(cached)
+}
+
+func decodeTracking(encoded []byte) (GetSharedTrackingQueryResponse, error) {
+	var cached cachedTracking
+	if err := json.Unmarshal(encoded, &cached); err != nil {
+		return GetSharedTrackingQueryResponse{}, err
+	}
+
+	orderID, err := kernel.UUIDFromString(cached.OrderID)
+	if err != nil {
+		return GetSharedTrackingQueryResponse{}, err
+	}
+
+	tracking := GetSharedTrackingQueryResponse{
+		OrderID:       orderID,
+		Status:        order.Status(cached.Status),
+		BatchClosesAt: cached.BatchClosesAt,
+	}
+	if cached.Courier != nil {
+		location, locationErr := kernel.NewLocation(
+			kernel.Coordinate(cached.Courier.X),
+			kernel.Coordinate(cached.Courier.Y),
+		)
+		if locationErr != nil {
+			return GetSharedTrackingQueryResponse{}, locationErr
+		}
+		tracking.Courier = &SharedTrackingCourier{
+			FirstName:           cached.Courier.FirstName,
+			PhotoURL:            cached.Courier.PhotoURL,
+			ApproximateLocation: location,
+			ETA:                 cached.Courier.ETA,
+		}
+	}
+	return tracking, nil
+}
@@ -0,0 +1,46 @@
+package queries
+
+import (
+	"context"
+	"database/sql"
+
+	"delivery/internal/pkg/querycost"
+	"delivery/internal/pkg/tenant"
+
+	"gorm.io/gorm"
+)
+
+// tenantSession returns a session for reading on behalf of the tenant carried by ctx.
+// With a tenant or a querycost budget on db, reads run in a read-only transaction bound
+// to the tenant and limited by the budget's statement timeout, and release ends that
+// transaction. Otherwise the plain connection is used and row-level security scopes the
+// reads to the default tenant. Release must be called once reading is done.
+func tenantSession(ctx context.Context, db *gorm.DB) (*gorm.DB, func(), error) {
+	id, hasTenant := tenant.FromContext(ctx)
+	budget, hasBudget := querycost.BudgetOf(db)
+	if !hasTenant && !hasBudget {
+		return db.WithContext(ctx), func() {}, nil
+	}
+
+	tx := db.WithContext(ctx).Begin(&sql.TxOptions{ReadOnly: true})
+	if tx.Error != nil {
+		return nil, nil, tx.Error
+	}
+
+	if hasTenant {
+		if err := tx.Exec("SELECT set_config(?, ?, true)", tenant.SessionSetting, id.String()).Error; err != nil {
+			_ = tx.Rollback()
+			return nil, nil, err
+		}
+	}
+
+	if hasBudget {
+		err := tx.Exec("SELECT set_config(?, ?, true)", querycost.SessionSetting, budget.SessionValue()).Error
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, nil, err
+		}
+	}
+
+	return tx, func() { _ = tx.Rollback() }, nil
+}
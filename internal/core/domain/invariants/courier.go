@@ -0,0 +1,186 @@
+package invariants
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+)
+
+// maxWorkedPerDay bounds the completed working time a work log can hold for its day.
+const maxWorkedPerDay = 24 * time.Hour
+
+// CheckCourierInvariants reports every rule the courier breaks:
+//   - the courier is constructed, named, has a positive speed, a valid location and language
+//   - the courier is active or deactivated for a valid reason
+//   - storage places are valid and unique, an order is held by at most one of them,
+//     and a place out of service holds no order
+//   - the work log holds at most a day of completed time for a day starting at midnight UTC
+//   - the status is valid, only a courier on shift is not Offline, a deactivated courier is Offline,
+//     a Busy courier carries orders and an Available one carries none
+//   - maintenance windows and absences are valid, unique, sorted by start and do not overlap
+//   - the courier does not substitute for itself
+//
+// Returns nil if the courier satisfies every rule.
+func CheckCourierInvariants(c *courier.Courier) error {
+	if err := c.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvariantViolated, err)
+	}
+
+	var v violations
+	checkCourierIdentity(&v, c)
+	checkStoragePlaces(&v, c)
+	checkWorkLog(&v, c)
+	checkStatus(&v, c)
+	checkMaintenanceWindows(&v, c)
+	checkAbsences(&v, c)
+	return v.err()
+}
+
+func checkCourierIdentity(v *violations, c *courier.Courier) {
+	if strings.TrimSpace(c.Name()) == "" {
+		v.add("courier %s has no name", c.ID())
+	}
+	if c.Speed() <= 0 {
+		v.add("courier %s has speed %d", c.ID(), c.Speed())
+	}
+	if err := c.Location().Validate(); err != nil {
+		v.add("courier %s has an invalid location: %v", c.ID(), err)
+	}
+	if err := c.Language().Validate(); err != nil {
+		v.add("courier %s has an invalid language: %v", c.ID(), err)
+	}
+	if reason := c.DeactivationReason(); reason != courier.NotDeactivated {
+		if err := reason.Validate(); err != nil {
+			v.add("courier %s is deactivated for an invalid reason: %v", c.ID(), err)
+		}
+	}
+}
+
+func checkStoragePlaces(v *violations, c *courier.Courier) {
+	places := c.StoragePlaces()
+	if len(places) == 0 {
+		v.add("courier %s has no storage places", c.ID())
+	}
+
+	placeIDs := make(map[string]bool, len(places))
+	heldBy := make(map[string]string, len(places))
+	for _, place := range places {
+		if err := place.Validate(); err != nil {
+			v.add("courier %s has an invalid storage place: %v", c.ID(), err)
+			continue
+		}
+
+		id := place.ID().String()
+		if placeIDs[id] {
+			v.add("courier %s has storage place %s twice", c.ID(), id)
+		}
+		placeIDs[id] = true
+
+		orderID := place.OrderID()
+		if orderID == nil {
+			continue
+		}
+		if place.IsOutOfService() {
+			v.add("storage place %s of courier %s is out of service but holds order %s", id, c.ID(), orderID)
+		}
+		if other, held := heldBy[orderID.String()]; held {
+			v.add("order %s is held by storage places %s and %s of courier %s", orderID, other, id, c.ID())
+		}
+		heldBy[orderID.String()] = id
+	}
+}
+
+func checkWorkLog(v *violations, c *courier.Courier) {
+	log := c.WorkLog()
+	if log.Completed() < 0 || log.Completed() > maxWorkedPerDay {
+		v.add("courier %s has %s of completed working time on a day", c.ID(), log.Completed())
+	}
+	if log.Completed() > 0 && log.Day().IsZero() {
+		v.add("courier %s has completed working time without a day", c.ID())
+	}
+	if day := log.Day(); !day.IsZero() && (day.Location() != time.UTC || !day.Equal(day.Truncate(maxWorkedPerDay))) {
+		v.add("courier %s has a work day %s that is not a midnight UTC", c.ID(), day)
+	}
+	if startedAt := log.ShiftStartedAt(); startedAt != nil && startedAt.Location() != time.UTC {
+		v.add("courier %s has a shift started at %s, not in UTC", c.ID(), startedAt)
+	}
+}
+
+func checkStatus(v *violations, c *courier.Courier) {
+	status := c.Status()
+	if err := status.Validate(); err != nil {
+		v.add("courier %s has an invalid status: %v", c.ID(), err)
+		return
+	}
+	if status != courier.Offline && !c.WorkLog().IsOnShift() {
+		v.add("courier %s is %s without a running shift", c.ID(), status)
+	}
+	if status != courier.Offline && c.IsDeactivated() {
+		v.add("courier %s is %s but deactivated", c.ID(), status)
+	}
+
+	carriesOrders := false
+	for _, place := range c.StoragePlaces() {
+		if place.OrderID() != nil {
+			carriesOrders = true
+			break
+		}
+	}
+	if status == courier.Busy && !carriesOrders {
+		v.add("courier %s is Busy without orders", c.ID())
+	}
+	if status == courier.Available && carriesOrders {
+		v.add("courier %s is Available while carrying orders", c.ID())
+	}
+}
+
+func checkMaintenanceWindows(v *violations, c *courier.Courier) {
+	windows := c.MaintenanceWindows()
+	for i, window := range windows {
+		if err := window.Validate(); err != nil {
+			v.add("courier %s has an invalid maintenance window: %v", c.ID(), err)
+			continue
+		}
+		if !window.EndsAt().After(window.StartsAt()) {
+			v.add("maintenance window %s of courier %s does not end after it starts", window.ID(), c.ID())
+		}
+		if i > 0 && window.StartsAt().Before(windows[i-1].StartsAt()) {
+			v.add("maintenance windows of courier %s are not sorted by start", c.ID())
+		}
+		for _, other := range windows[i+1:] {
+			if window.ID().IsEqual(other.ID()) {
+				v.add("courier %s has maintenance window %s twice", c.ID(), window.ID())
+			} else if window.Overlaps(other) {
+				v.add("maintenance windows %s and %s of courier %s overlap", window.ID(), other.ID(), c.ID())
+			}
+		}
+	}
+}
+
+func checkAbsences(v *violations, c *courier.Courier) {
+	absences := c.Absences()
+	for i, absence := range absences {
+		if err := absence.Validate(); err != nil {
+			v.add("courier %s has an invalid absence: %v", c.ID(), err)
+			continue
+		}
+		if !absence.EndsAt().After(absence.StartsAt()) {
+			v.add("absence %s of courier %s does not end after it starts", absence.ID(), c.ID())
+		}
+		if absence.SubstituteID().IsEqual(c.ID()) {
+			v.add("courier %s substitutes for itself during absence %s", c.ID(), absence.ID())
+		}
+		if i > 0 && absence.StartsAt().Before(absences[i-1].StartsAt()) {
+			v.add("absences of courier %s are not sorted by start", c.ID())
+		}
+		for _, other := range absences[i+1:] {
+			if absence.ID().IsEqual(other.ID()) {
+				v.add("courier %s has absence %s twice", c.ID(), absence.ID())
+			} else if absence.Overlaps(other) {
+				v.add("absences %s and %s of courier %s overlap", absence.ID(), other.ID(), c.ID())
+			}
+		}
+	}
+}
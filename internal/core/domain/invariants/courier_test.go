@@ -0,0 +1,239 @@
+package invariants_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/invariants"
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+var epoch = time.Date(2025, 3, 3, 8, 0, 0, 0, time.UTC)
+
+// courierMachine applies random operations to a courier and keeps the orders it must be holding,
+// so a lost or duplicated order is caught along with the invariants.
+type courierMachine struct {
+	courier *courier.Courier
+	now     time.Time
+	limit   courier.WorkingHoursLimit
+	held    []kernel.UUID
+}
+
+func newCourierMachine(t *rapid.T) *courierMachine {
+	c, err := courier.NewCourier(kernel.NewUUID(), "Courier", rapid.IntRange(1, 5).Draw(t, "speed"), drawLocation(t, "location"))
+	require.NoError(t, err)
+	limit, err := courier.NewWorkingHoursLimit(8*time.Hour, 30*time.Minute)
+	require.NoError(t, err)
+
+	return &courierMachine{courier: c, now: epoch, limit: limit}
+}
+
+func (m *courierMachine) actions() map[string]func(*rapid.T) {
+	return map[string]func(*rapid.T){
+		"AdvanceClock": func(t *rapid.T) {
+			m.now = m.now.Add(time.Duration(rapid.IntRange(1, 12*60).Draw(t, "minutes")) * time.Minute)
+		},
+		"AddStoragePlace": func(t *rapid.T) {
+			require.NoError(t, m.courier.AddStoragePlace("Box", rapid.IntRange(1, 20).Draw(t, "volume")))
+		},
+		"TakeOrder": func(t *rapid.T) {
+			o := drawOrder(t)
+			if m.courier.TakeOrder(o) == nil {
+				m.held = append(m.held, o.ID())
+			}
+		},
+		"CompleteOrder": func(t *rapid.T) {
+			if len(m.held) == 0 {
+				t.Skip("no orders held")
+			}
+			i := rapid.IntRange(0, len(m.held)-1).Draw(t, "order")
+			require.NoError(t, m.courier.CompleteOrder(m.held[i]))
+			m.held = slices.Delete(m.held, i, i+1)
+		},
+		"CompleteUnknownOrder": func(t *rapid.T) {
+			require.Error(t, m.courier.CompleteOrder(kernel.NewUUID()))
+		},
+		"StartStoragePlaceMaintenance": func(t *rapid.T) {
+			place := rapid.SampledFrom(m.courier.StoragePlaces()).Draw(t, "place")
+			err := m.courier.StartStoragePlaceMaintenance(place.ID())
+			if place.OrderID() != nil {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		},
+		"FinishStoragePlaceMaintenance": func(t *rapid.T) {
+			place := rapid.SampledFrom(m.courier.StoragePlaces()).Draw(t, "place")
+			require.NoError(t, m.courier.FinishStoragePlaceMaintenance(place.ID()))
+		},
+		"Deactivate": func(t *rapid.T) {
+			wasDeactivated := m.courier.IsDeactivated()
+			reason := rapid.SampledFrom([]courier.DeactivationReason{courier.Offboarded, courier.WentOffline}).Draw(t, "reason")
+			released, err := m.courier.Deactivate(reason)
+			if wasDeactivated {
+				require.ErrorIs(t, err, courier.ErrCourierIsDeactivated)
+				return
+			}
+			require.NoError(t, err)
+			require.ElementsMatch(t, m.held, released)
+			m.held = nil
+		},
+		"Reactivate": func(*rapid.T) {
+			m.courier.Reactivate()
+		},
+		"Insure": func(*rapid.T) {
+			m.courier.Insure()
+		},
+		"RevokeInsurance": func(*rapid.T) {
+			m.courier.RevokeInsurance()
+		},
+		"StartShift": func(*rapid.T) {
+			_ = m.courier.StartShift(m.now, m.limit)
+		},
+		"EndShift": func(*rapid.T) {
+			_ = m.courier.EndShift(m.now)
+		},
+		"GoOnline": func(*rapid.T) {
+			_ = m.courier.GoOnline()
+		},
+		"StartBreak": func(*rapid.T) {
+			_ = m.courier.StartBreak()
+		},
+		"SetOffline": func(*rapid.T) {
+			_ = m.courier.SetOffline()
+		},
+		"ScheduleMaintenance": func(t *rapid.T) {
+			_ = m.courier.ScheduleMaintenance(drawMaintenanceWindow(t, kernel.NewUUID(), m.now), m.now)
+		},
+		"RescheduleMaintenance": func(t *rapid.T) {
+			windows := m.courier.MaintenanceWindows()
+			if len(windows) == 0 {
+				t.Skip("no maintenance windows")
+			}
+			window := rapid.SampledFrom(windows).Draw(t, "window")
+			_ = m.courier.RescheduleMaintenance(drawMaintenanceWindow(t, window.ID(), m.now), m.now)
+		},
+		"CancelMaintenance": func(t *rapid.T) {
+			windows := m.courier.MaintenanceWindows()
+			if len(windows) == 0 {
+				t.Skip("no maintenance windows")
+			}
+			window := rapid.SampledFrom(windows).Draw(t, "window")
+			require.NoError(t, m.courier.CancelMaintenance(window.ID()))
+		},
+		"PlanAbsence": func(t *rapid.T) {
+			substituteID := kernel.NewUUID()
+			if rapid.Bool().Draw(t, "self") {
+				substituteID = m.courier.ID()
+			}
+			startsAt := m.now.Add(time.Duration(rapid.IntRange(-48, 240).Draw(t, "startsIn")) * time.Hour)
+			length := time.Duration(rapid.IntRange(1, 96).Draw(t, "hours")) * time.Hour
+			absence, err := courier.NewAbsence(kernel.NewUUID(), startsAt, startsAt.Add(length), substituteID)
+			require.NoError(t, err)
+			_ = m.courier.PlanAbsence(absence, m.now)
+		},
+		"CancelAbsence": func(t *rapid.T) {
+			absences := m.courier.Absences()
+			if len(absences) == 0 {
+				t.Skip("no absences")
+			}
+			absence := rapid.SampledFrom(absences).Draw(t, "absence")
+			require.NoError(t, m.courier.CancelAbsence(absence.ID()))
+		},
+		"Move": func(t *rapid.T) {
+			require.NoError(t, m.courier.Move(drawLocation(t, "target")))
+		},
+		"": func(t *rapid.T) {
+			require.NoError(t, invariants.CheckCourierInvariants(m.courier))
+			require.ElementsMatch(t, m.held, storedOrders(m.courier))
+		},
+	}
+}
+
+func TestCheckCourierInvariants_HoldAfterRandomOperations(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		m := newCourierMachine(t)
+		t.Repeat(m.actions())
+	})
+}
+
+func TestCheckCourierInvariants_ReportsBrokenCourier(t *testing.T) {
+	t.Run("should report an order held by two storage places", func(t *testing.T) {
+		orderID := kernel.NewUUID()
+		bag, err := courier.RestoreStoragePlace(kernel.NewUUID(), "Bag", 10, &orderID)
+		require.NoError(t, err)
+		box, err := courier.RestoreStoragePlace(kernel.NewUUID(), "Box", 10, &orderID)
+		require.NoError(t, err)
+		location, _ := kernel.NewLocation(1, 1)
+		c, err := courier.RestoreCourier(kernel.NewUUID(), "Courier", 1, location, []*courier.StoragePlace{bag, box})
+		require.NoError(t, err)
+
+		err = invariants.CheckCourierInvariants(c)
+
+		require.ErrorIs(t, err, invariants.ErrInvariantViolated)
+		assert.Contains(t, err.Error(), orderID.String())
+	})
+
+	t.Run("should report a status without a running shift", func(t *testing.T) {
+		location, _ := kernel.NewLocation(1, 1)
+		c, err := courier.NewCourier(kernel.NewUUID(), "Courier", 1, location)
+		require.NoError(t, err)
+		require.NoError(t, c.RestoreStatus(courier.Available))
+
+		err = invariants.CheckCourierInvariants(c)
+
+		require.ErrorIs(t, err, invariants.ErrInvariantViolated)
+		assert.Contains(t, err.Error(), "without a running shift")
+	})
+
+	t.Run("should report a courier not created via constructor", func(t *testing.T) {
+		err := invariants.CheckCourierInvariants(&courier.Courier{})
+
+		require.ErrorIs(t, err, invariants.ErrInvariantViolated)
+		require.ErrorIs(t, err, courier.ErrCourierIsNotConstructed)
+	})
+}
+
+func drawLocation(t *rapid.T, label string) kernel.Location {
+	location, err := kernel.NewLocation(
+		kernel.Coordinate(rapid.IntRange(int(kernel.LocationMinX), int(kernel.LocationMaxX)).Draw(t, label+"X")),
+		kernel.Coordinate(rapid.IntRange(int(kernel.LocationMinY), int(kernel.LocationMaxY)).Draw(t, label+"Y")),
+	)
+	require.NoError(t, err)
+	return location
+}
+
+// drawOrder draws a new order of random volume, insured half of the time.
+func drawOrder(t *rapid.T) *order.Order {
+	o, err := order.NewOrder(kernel.NewUUID(), drawLocation(t, "orderLocation"), rapid.IntRange(1, 12).Draw(t, "orderVolume"))
+	require.NoError(t, err)
+	threshold, err := order.NewInsuranceThreshold(100)
+	require.NoError(t, err)
+	require.NoError(t, o.DeclareValue(rapid.IntRange(0, 200).Draw(t, "declaredValue"), threshold))
+	return o
+}
+
+func drawMaintenanceWindow(t *rapid.T, id kernel.UUID, now time.Time) courier.MaintenanceWindow {
+	startsAt := now.Add(time.Duration(rapid.IntRange(-120, 48*60).Draw(t, "startsInMinutes")) * time.Minute)
+	length := time.Duration(rapid.IntRange(1, 8*60).Draw(t, "minutes")) * time.Minute
+	window, err := courier.NewMaintenanceWindow(id, startsAt, startsAt.Add(length))
+	require.NoError(t, err)
+	return window
+}
+
+func storedOrders(c *courier.Courier) []kernel.UUID {
+	stored := make([]kernel.UUID, 0)
+	for _, place := range c.StoragePlaces() {
+		if orderID := place.OrderID(); orderID != nil {
+			stored = append(stored, *orderID)
+		}
+	}
+	return stored
+}
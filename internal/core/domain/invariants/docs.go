@@ -0,0 +1,25 @@
+// Package invariants checks the rules every courier and order must satisfy whatever
+// sequence of domain operations produced them. The aggregates enforce these rules one
+// operation at a time; the checkers restate them over the whole state, so tests can
+// apply long random sequences of operations and catch a transition that breaks a rule
+// the example-based tests never reach.
+//
+// The package includes:
+//   - CheckCourierInvariants: Storage, maintenance, absence and working time rules of a courier
+//   - CheckOrderInvariants: Status, review, batching, insurance, payment and route rules of an order
+//
+// The checkers report every violated rule at once, each wrapping ErrInvariantViolated.
+// They never modify the aggregate, so they can run after every step of a test.
+//
+// Example:
+//
+//	rapid.Check(t, func(t *rapid.T) {
+//	    c := newCourier(t)
+//	    for range rapid.IntRange(1, 50).Draw(t, "steps") {
+//	        applyRandomOperation(t, c)
+//	        if err := invariants.CheckCourierInvariants(c); err != nil {
+//	            t.Fatal(err)
+//	        }
+//	    }
+//	})
+package invariants
@@ -0,0 +1,24 @@
+package invariants
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvariantViolated is wrapped by every violation a checker reports.
+	ErrInvariantViolated = errors.New("invariant violated")
+)
+
+// violations collects the rules broken by an aggregate.
+type violations []error
+
+// add records a broken rule described by a format string.
+func (v *violations) add(format string, args ...any) {
+	*v = append(*v, fmt.Errorf("%w: "+format, append([]any{ErrInvariantViolated}, args...)...))
+}
+
+// err joins the broken rules, nil if there are none.
+func (v violations) err() error {
+	return errors.Join(v...)
+}
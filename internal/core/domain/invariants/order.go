@@ -0,0 +1,138 @@
+package invariants
+
+import (
+	"fmt"
+
+	"delivery/internal/core/domain/model/order"
+)
+
+// CheckOrderInvariants reports every rule the order breaks:
+//   - the order is constructed with a valid status, a courier exactly when it is assigned
+//     or completed, a positive volume and items that add up to it
+//   - an order under review or awaiting its economy batch is neither assigned nor completed
+//   - an estimated arrival belongs to an assigned order and a tip to a delivered one
+//   - handover confirmations belong to an insured order with a courier, the delivery is
+//     confirmed after the pickup, and an insured order is only completed once delivered
+//   - payment transitions form a chain from pending to the current payment status
+//   - a tracked route belongs to an order with a courier and a deviation to a tracked route
+//
+// Returns nil if the order satisfies every rule.
+func CheckOrderInvariants(o *order.Order) error {
+	if err := o.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvariantViolated, err)
+	}
+
+	var v violations
+	checkOrderStatus(&v, o)
+	checkOrderVolume(&v, o)
+	checkDispatchHolds(&v, o)
+	checkInsurance(&v, o)
+	checkPayment(&v, o)
+	checkRoute(&v, o)
+	return v.err()
+}
+
+func checkOrderStatus(v *violations, o *order.Order) {
+	if err := o.Status().Validate(); err != nil {
+		v.add("order %s has an invalid status: %v", o.ID(), err)
+		return
+	}
+	if err := o.ValidateSetStatusCourier(); err != nil {
+		v.add("order %s in status %s: %v", o.ID(), o.Status(), err)
+	}
+	if o.EstimatedArrival() != nil && o.Status() != order.Assigned {
+		v.add("order %s in status %s has an estimated arrival", o.ID(), o.Status())
+	}
+	if o.Tip() != nil && (o.Status() != order.Completed || o.Courier() == nil) {
+		v.add("order %s in status %s is tipped", o.ID(), o.Status())
+	}
+}
+
+func checkOrderVolume(v *violations, o *order.Order) {
+	if o.Volume() <= 0 {
+		v.add("order %s has volume %d", o.ID(), o.Volume())
+	}
+
+	items := o.Items()
+	if len(items) == 0 {
+		return
+	}
+	volume := 0
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			v.add("order %s has an invalid item: %v", o.ID(), err)
+			return
+		}
+		volume += item.Volume()
+	}
+	if volume != o.Volume() {
+		v.add("items of order %s add up to volume %d, the order has %d", o.ID(), volume, o.Volume())
+	}
+}
+
+func checkDispatchHolds(v *violations, o *order.Order) {
+	held := o.Status() != order.Created || o.Courier() != nil
+	if o.IsUnderReview() && held {
+		v.add("order %s in status %s is under review", o.ID(), o.Status())
+	}
+	if o.IsAwaitingBatch() {
+		if held {
+			v.add("order %s in status %s awaits its batch", o.ID(), o.Status())
+		}
+		if o.DeliveryTier() != order.EconomyDelivery {
+			v.add("order %s with %s delivery awaits a batch", o.ID(), o.DeliveryTier())
+		}
+	}
+}
+
+func checkInsurance(v *violations, o *order.Order) {
+	if o.DeclaredValue() < 0 {
+		v.add("order %s has declared value %d", o.ID(), o.DeclaredValue())
+	}
+
+	pickedUp, delivered := o.PickupConfirmedAt(), o.DeliveryConfirmedAt()
+	if (pickedUp != nil || delivered != nil) && (!o.IsInsuranceRequired() || o.Courier() == nil) {
+		v.add("order %s has handover confirmations but is not an insured order with a courier", o.ID())
+	}
+	if delivered != nil && (pickedUp == nil || delivered.Before(*pickedUp)) {
+		v.add("order %s has its delivery confirmed before its pickup", o.ID())
+	}
+	if o.IsInsuranceRequired() && o.Status() == order.Completed && delivered == nil {
+		v.add("insured order %s is completed without a delivery confirmation", o.ID())
+	}
+}
+
+func checkPayment(v *violations, o *order.Order) {
+	if err := o.PaymentMethod().Validate(); err != nil {
+		v.add("order %s has an invalid payment method: %v", o.ID(), err)
+	}
+
+	status := order.PaymentPending
+	references := make(map[string]bool)
+	for _, transition := range o.PaymentTransitions() {
+		if transition.From() != status || !transition.From().CanTransitionTo(transition.To()) {
+			v.add("order %s has a payment transition from %s to %s after %s",
+				o.ID(), transition.From(), transition.To(), status)
+		}
+		if references[transition.Reference()] {
+			v.add("order %s has payment event %s applied twice", o.ID(), transition.Reference())
+		}
+		references[transition.Reference()] = true
+		status = transition.To()
+	}
+	if o.PaymentStatus() != status {
+		v.add("order %s has payment status %s, its transitions end at %s", o.ID(), o.PaymentStatus(), status)
+	}
+}
+
+func checkRoute(v *violations, o *order.Order) {
+	if o.RouteDeviationTicks() < 0 {
+		v.add("order %s has %d route deviation ticks", o.ID(), o.RouteDeviationTicks())
+	}
+	if o.RouteOrigin() != nil && o.Courier() == nil {
+		v.add("order %s tracks a route without a courier", o.ID())
+	}
+	if o.RouteDeviatedAt() != nil && o.RouteOrigin() == nil {
+		v.add("order %s deviated from a route it does not track", o.ID())
+	}
+}
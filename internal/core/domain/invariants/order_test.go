@@ -0,0 +1,154 @@
+package invariants_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/invariants"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+// orderMachine applies random operations to an order and keeps the courier it was completed by,
+// so a completed order that changes again is caught along with the invariants.
+type orderMachine struct {
+	order       *order.Order
+	now         time.Time
+	couriers    []kernel.UUID
+	completedBy *kernel.UUID
+}
+
+func newOrderMachine(t *rapid.T) *orderMachine {
+	o, err := order.NewOrder(kernel.NewUUID(), drawLocation(t, "location"), rapid.IntRange(1, 12).Draw(t, "volume"))
+	require.NoError(t, err)
+
+	return &orderMachine{order: o, now: epoch, couriers: []kernel.UUID{kernel.NewUUID(), kernel.NewUUID()}}
+}
+
+func (m *orderMachine) actions() map[string]func(*rapid.T) {
+	return map[string]func(*rapid.T){
+		"AdvanceClock": func(t *rapid.T) {
+			m.now = m.now.Add(time.Duration(rapid.IntRange(1, 60).Draw(t, "minutes")) * time.Minute)
+		},
+		"Assign": func(t *rapid.T) {
+			_ = m.order.Assign(rapid.SampledFrom(m.couriers).Draw(t, "courier"))
+		},
+		"Unassign": func(*rapid.T) {
+			_ = m.order.Unassign()
+		},
+		"Complete": func(*rapid.T) {
+			if m.order.Complete() == nil && m.completedBy == nil {
+				m.completedBy = m.order.Courier()
+			}
+		},
+		"HoldForReview": func(t *rapid.T) {
+			_ = m.order.HoldForReview(rapid.SampledFrom([]string{"", "Suspicious address"}).Draw(t, "reason"))
+		},
+		"ApproveReview": func(*rapid.T) {
+			_ = m.order.ApproveReview()
+		},
+		"ChangeDeliveryTier": func(t *rapid.T) {
+			tier := rapid.SampledFrom([]order.DeliveryTier{order.ExpressDelivery, order.EconomyDelivery}).Draw(t, "tier")
+			window, err := order.NewBatchingWindow(time.Duration(rapid.IntRange(1, 60).Draw(t, "window")) * time.Minute)
+			require.NoError(t, err)
+			_ = m.order.ChangeDeliveryTier(tier, window, m.now)
+		},
+		"ReleaseBatch": func(*rapid.T) {
+			m.order.ReleaseBatch(m.now)
+		},
+		"DeclareValue": func(t *rapid.T) {
+			threshold, err := order.NewInsuranceThreshold(100)
+			require.NoError(t, err)
+			_ = m.order.DeclareValue(rapid.IntRange(0, 200).Draw(t, "declaredValue"), threshold)
+		},
+		"ConfirmPickup": func(*rapid.T) {
+			_ = m.order.ConfirmPickup(m.now)
+		},
+		"ConfirmDelivery": func(*rapid.T) {
+			_ = m.order.ConfirmDelivery(m.now)
+		},
+		"ChangePaymentMethod": func(t *rapid.T) {
+			method := rapid.SampledFrom([]order.PaymentMethod{order.CashOnDelivery, order.OnlinePayment}).Draw(t, "method")
+			_ = m.order.ChangePaymentMethod(method)
+		},
+		"ApplyPaymentEvent": func(t *rapid.T) {
+			status := rapid.SampledFrom([]order.PaymentStatus{
+				order.PaymentPending, order.PaymentPaid, order.PaymentRefunded,
+			}).Draw(t, "status")
+			reference := rapid.SampledFrom([]string{"evt-1", "evt-2", "evt-3"}).Draw(t, "reference")
+			_, _ = m.order.ApplyPaymentEvent(status, reference, m.now)
+		},
+		"RecordEstimatedArrival": func(t *rapid.T) {
+			eta, err := order.NewEstimatedArrival(rapid.IntRange(0, 20).Draw(t, "turns"), m.now)
+			require.NoError(t, err)
+			_ = m.order.RecordEstimatedArrival(eta)
+		},
+		"AddTip": func(t *rapid.T) {
+			key := rapid.SampledFrom([]string{"tip-1", "tip-2"}).Draw(t, "idempotencyKey")
+			tip, err := order.NewTip(rapid.IntRange(1, 1000).Draw(t, "amount"), key, m.now)
+			require.NoError(t, err)
+			_, _ = m.order.AddTip(tip)
+		},
+		"TrackRoute": func(t *rapid.T) {
+			policy, err := order.NewRouteDeviationPolicy(1, 2)
+			require.NoError(t, err)
+			_, _, _ = m.order.TrackRoute(drawLocation(t, "courierLocation"), policy, m.now)
+		},
+		"": func(t *rapid.T) {
+			require.NoError(t, invariants.CheckOrderInvariants(m.order))
+			if m.completedBy != nil {
+				require.Equal(t, order.Completed, m.order.Status())
+				require.Equal(t, m.completedBy, m.order.Courier())
+			}
+		},
+	}
+}
+
+func TestCheckOrderInvariants_HoldAfterRandomOperations(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		m := newOrderMachine(t)
+		t.Repeat(m.actions())
+	})
+}
+
+func TestCheckOrderInvariants_ReportsBrokenOrder(t *testing.T) {
+	location, _ := kernel.NewLocation(1, 1)
+	now := time.Date(2025, 3, 3, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should report a tip on an order not delivered", func(t *testing.T) {
+		o, err := order.NewOrder(kernel.NewUUID(), location, 1)
+		require.NoError(t, err)
+		tip, err := order.NewTip(100, "tip-1", now)
+		require.NoError(t, err)
+		require.NoError(t, o.RestoreTip(tip))
+
+		require.ErrorIs(t, invariants.CheckOrderInvariants(o), invariants.ErrInvariantViolated)
+	})
+
+	t.Run("should report a delivery confirmed without a pickup", func(t *testing.T) {
+		courierID := kernel.NewUUID()
+		o, err := order.RestoreOrder(kernel.NewUUID(), location, 1, order.Assigned, &courierID)
+		require.NoError(t, err)
+		require.NoError(t, o.RestoreInsurance(150, true, nil, &now))
+
+		require.ErrorIs(t, invariants.CheckOrderInvariants(o), invariants.ErrInvariantViolated)
+	})
+
+	t.Run("should report a payment status its transitions do not lead to", func(t *testing.T) {
+		o, err := order.NewOrder(kernel.NewUUID(), location, 1)
+		require.NoError(t, err)
+		require.NoError(t, o.RestorePayment(order.OnlinePayment, order.PaymentPaid, nil))
+
+		require.ErrorIs(t, invariants.CheckOrderInvariants(o), invariants.ErrInvariantViolated)
+	})
+
+	t.Run("should report an order not created via constructor", func(t *testing.T) {
+		err := invariants.CheckOrderInvariants(&order.Order{})
+
+		require.ErrorIs(t, err, invariants.ErrInvariantViolated)
+		require.ErrorIs(t, err, order.ErrOrderIsNotConstructed)
+	})
+}
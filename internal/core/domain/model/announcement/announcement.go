@@ -0,0 +1,233 @@
+package announcement
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// MaxTextLength is the maximum number of characters allowed in an announcement.
+const MaxTextLength = 280
+
+var (
+	// ErrAnnouncementIsNotConstructed indicates that an Announcement was not properly
+	// initialized through the NewAnnouncement constructor function.
+	ErrAnnouncementIsNotConstructed = errors.New("Announcement must be created via NewAnnouncement constructor")
+
+	// ErrRecipientIsAlreadyAdded is returned when a courier is added to the recipients twice.
+	ErrRecipientIsAlreadyAdded = errors.New("courier is already a recipient of the announcement")
+
+	// ErrRecipientIsNotFound is returned when a delivery is recorded for a courier
+	// who is not a recipient of the announcement.
+	ErrRecipientIsNotFound = errors.New("courier is not a recipient of the announcement")
+
+	// ErrDeliveryIsNotPending is returned when the outcome of a delivery is recorded twice.
+	ErrDeliveryIsNotPending = errors.New("announcement delivery is not pending")
+)
+
+// Announcement is a message dispatch broadcasts to on-shift couriers, either to all of them
+// or to the ones inside a zone, together with the state of its delivery to every recipient.
+//
+// Key business rules:
+//   - Must be constructed through NewAnnouncement constructor
+//   - Text must not be blank and must not exceed MaxTextLength characters
+//   - Every courier is a recipient at most once
+//   - A delivery starts Pending and is resolved exactly once, as Delivered or Failed
+type Announcement struct {
+	// id uniquely identifies the announcement
+	id kernel.UUID
+
+	// text is the announcement body
+	text string
+
+	// zone restricts the recipients to the couriers inside it; nil addresses everyone on shift
+	zone *kernel.Zone
+
+	// postedAt is the moment dispatch posted the announcement
+	postedAt time.Time
+
+	// deliveries tracks the announcement per recipient, in the order recipients were added
+	deliveries []Delivery
+
+	// guard ensures the entity was properly initialized
+	guard guard.ConstructorGuard
+}
+
+// NewAnnouncement creates an announcement without recipients.
+// A nil zone addresses every courier on shift.
+//
+// Example:
+//
+//	a, err := announcement.NewAnnouncement(kernel.NewUUID(), "Surge pricing active", &zone, time.Now())
+//	if err != nil {
+//	    return fmt.Errorf("invalid announcement: %w", err)
+//	}
+func NewAnnouncement(id kernel.UUID, text string, zone *kernel.Zone, postedAt time.Time) (*Announcement, error) {
+	announcement := &Announcement{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := errs.JoinFields(
+		errs.Field("id", announcement.setID(id)),
+		errs.Field("text", announcement.setText(text)),
+		errs.Field("zone", announcement.setZone(zone)),
+		errs.Field("postedAt", announcement.setPostedAt(postedAt)),
+	); err != nil {
+		return nil, err
+	}
+
+	return announcement, nil
+}
+
+// Validate ensures the Announcement instance was properly constructed through NewAnnouncement.
+func (a *Announcement) Validate() error {
+	if a == nil {
+		return ErrAnnouncementIsNotConstructed
+	}
+
+	return a.guard.Validate(ErrAnnouncementIsNotConstructed)
+}
+
+// ID returns the announcement's unique identifier.
+func (a *Announcement) ID() kernel.UUID {
+	return a.id
+}
+
+// Text returns the announcement body.
+func (a *Announcement) Text() string {
+	return a.text
+}
+
+// Zone returns the zone the announcement is restricted to, and false if it addresses everyone.
+func (a *Announcement) Zone() (kernel.Zone, bool) {
+	if a.zone == nil {
+		return kernel.Zone{}, false
+	}
+	return *a.zone, true
+}
+
+// PostedAt returns the moment dispatch posted the announcement.
+func (a *Announcement) PostedAt() time.Time {
+	return a.postedAt
+}
+
+// Deliveries returns a copy of the deliveries in the order the recipients were added.
+func (a *Announcement) Deliveries() []Delivery {
+	deliveries := make([]Delivery, len(a.deliveries))
+	copy(deliveries, a.deliveries)
+	return deliveries
+}
+
+// Reaches reports whether a courier at the location is addressed by the announcement.
+func (a *Announcement) Reaches(location kernel.Location) (bool, error) {
+	if a.zone == nil {
+		return true, location.Validate()
+	}
+	return a.zone.Contains(location)
+}
+
+// AddRecipient adds a Pending delivery for the courier.
+// Returns ErrRecipientIsAlreadyAdded if the courier already is a recipient.
+func (a *Announcement) AddRecipient(courierID kernel.UUID) error {
+	if err := courierID.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := a.findDelivery(courierID); err == nil {
+		return ErrRecipientIsAlreadyAdded
+	}
+
+	a.deliveries = append(a.deliveries, Delivery{
+		courierID: courierID,
+		status:    Pending,
+		changedAt: a.postedAt,
+	})
+	return nil
+}
+
+// MarkDelivered records that the announcement reached the courier at the given time.
+// Returns ErrRecipientIsNotFound or ErrDeliveryIsNotPending.
+func (a *Announcement) MarkDelivered(courierID kernel.UUID, at time.Time) error {
+	return a.resolve(courierID, Delivered, at, "")
+}
+
+// MarkFailed records that the announcement could not be sent to the courier, and why.
+// Returns ErrRecipientIsNotFound or ErrDeliveryIsNotPending.
+func (a *Announcement) MarkFailed(courierID kernel.UUID, at time.Time, reason string) error {
+	return a.resolve(courierID, Failed, at, reason)
+}
+
+func (a *Announcement) resolve(courierID kernel.UUID, status DeliveryStatus, at time.Time, failure string) error {
+	i, err := a.findDelivery(courierID)
+	if err != nil {
+		return err
+	}
+
+	if a.deliveries[i].status != Pending {
+		return ErrDeliveryIsNotPending
+	}
+
+	a.deliveries[i] = Delivery{
+		courierID: courierID,
+		status:    status,
+		changedAt: at,
+		failure:   failure,
+	}
+	return nil
+}
+
+func (a *Announcement) findDelivery(courierID kernel.UUID) (int, error) {
+	for i, delivery := range a.deliveries {
+		if delivery.courierID.IsEqual(courierID) {
+			return i, nil
+		}
+	}
+	return 0, ErrRecipientIsNotFound
+}
+
+func (a *Announcement) setID(id kernel.UUID) error {
+	if err := id.Validate(); err != nil {
+		return err
+	}
+	a.id = id
+	return nil
+}
+
+func (a *Announcement) setText(text string) error {
+	if strings.TrimSpace(text) == "" {
+		return errs.NewValueIsRequiredError("text")
+	}
+	if length := utf8.RuneCountInString(text); length > MaxTextLength {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"text is invalid",
+			fmt.Errorf("%d characters exceed the limit of %d", length, MaxTextLength),
+		)
+	}
+	a.text = text
+	return nil
+}
+
+func (a *Announcement) setZone(zone *kernel.Zone) error {
+	if zone == nil {
+		return nil
+	}
+	if err := zone.Validate(); err != nil {
+		return err
+	}
+	a.zone = zone
+	return nil
+}
+
+func (a *Announcement) setPostedAt(postedAt time.Time) error {
+	if postedAt.IsZero() {
+		return errs.NewValueIsRequiredError("postedAt")
+	}
+	a.postedAt = postedAt
+	return nil
+}
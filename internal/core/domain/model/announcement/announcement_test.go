@@ -0,0 +1,174 @@
+package announcement_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/announcement"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var postedAt = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func mustLocation(t *testing.T, x, y kernel.Coordinate) kernel.Location {
+	t.Helper()
+	location, err := kernel.NewLocation(x, y)
+	require.NoError(t, err)
+	return location
+}
+
+func mustZone(t *testing.T) *kernel.Zone {
+	t.Helper()
+	zone, err := kernel.NewZone(mustLocation(t, 1, 1), mustLocation(t, 3, 3))
+	require.NoError(t, err)
+	return &zone
+}
+
+func TestNewAnnouncement(t *testing.T) {
+	t.Run("should create announcement for everyone", func(t *testing.T) {
+		id := kernel.NewUUID()
+
+		a, err := announcement.NewAnnouncement(id, "Surge pricing active", nil, postedAt)
+
+		require.NoError(t, err)
+		require.NoError(t, a.Validate())
+		assert.True(t, a.ID().IsEqual(id))
+		assert.Equal(t, "Surge pricing active", a.Text())
+		assert.Equal(t, postedAt, a.PostedAt())
+		assert.Empty(t, a.Deliveries())
+		_, zoned := a.Zone()
+		assert.False(t, zoned)
+	})
+
+	t.Run("should create announcement for a zone", func(t *testing.T) {
+		zone := mustZone(t)
+
+		a, err := announcement.NewAnnouncement(kernel.NewUUID(), "Road closed", zone, postedAt)
+
+		require.NoError(t, err)
+		got, zoned := a.Zone()
+		assert.True(t, zoned)
+		assert.Equal(t, *zone, got)
+	})
+
+	t.Run("should report every invalid field", func(t *testing.T) {
+		a, err := announcement.NewAnnouncement(
+			kernel.UUID{}, strings.Repeat("a", announcement.MaxTextLength+1), &kernel.Zone{}, time.Time{})
+
+		require.ErrorIs(t, err, errs.ErrValidationFailed)
+		assert.Nil(t, a)
+		var validation *errs.ValidationErrors
+		require.ErrorAs(t, err, &validation)
+		fields := make([]string, 0, len(validation.Fields))
+		for _, field := range validation.Fields {
+			fields = append(fields, field.Field)
+		}
+		assert.Equal(t, []string{"id", "text", "zone", "postedAt"}, fields)
+	})
+
+	t.Run("should reject blank text", func(t *testing.T) {
+		_, err := announcement.NewAnnouncement(kernel.NewUUID(), "  ", nil, postedAt)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "text")
+	})
+
+	t.Run("nil announcement should be invalid", func(t *testing.T) {
+		var a *announcement.Announcement
+
+		require.ErrorIs(t, a.Validate(), announcement.ErrAnnouncementIsNotConstructed)
+	})
+}
+
+func TestAnnouncement_Reaches(t *testing.T) {
+	everyone, err := announcement.NewAnnouncement(kernel.NewUUID(), "Rain expected", nil, postedAt)
+	require.NoError(t, err)
+	zoned, err := announcement.NewAnnouncement(kernel.NewUUID(), "Surge pricing active", mustZone(t), postedAt)
+	require.NoError(t, err)
+
+	reaches, err := everyone.Reaches(mustLocation(t, 9, 9))
+	require.NoError(t, err)
+	assert.True(t, reaches)
+
+	reaches, err = zoned.Reaches(mustLocation(t, 2, 3))
+	require.NoError(t, err)
+	assert.True(t, reaches)
+
+	reaches, err = zoned.Reaches(mustLocation(t, 9, 9))
+	require.NoError(t, err)
+	assert.False(t, reaches)
+
+	_, err = everyone.Reaches(kernel.Location{})
+	require.ErrorIs(t, err, kernel.ErrLocationIsNotConstructed)
+}
+
+func TestAnnouncement_Deliveries(t *testing.T) {
+	deliveredAt := postedAt.Add(time.Second)
+
+	newAnnouncement := func(t *testing.T, recipients ...kernel.UUID) *announcement.Announcement {
+		t.Helper()
+		a, err := announcement.NewAnnouncement(kernel.NewUUID(), "Surge pricing active", nil, postedAt)
+		require.NoError(t, err)
+		for _, recipient := range recipients {
+			require.NoError(t, a.AddRecipient(recipient))
+		}
+		return a
+	}
+
+	t.Run("should add pending recipients", func(t *testing.T) {
+		first, second := kernel.NewUUID(), kernel.NewUUID()
+
+		a := newAnnouncement(t, first, second)
+
+		deliveries := a.Deliveries()
+		require.Len(t, deliveries, 2)
+		assert.True(t, deliveries[0].CourierID().IsEqual(first))
+		assert.Equal(t, announcement.Pending, deliveries[0].Status())
+		assert.Equal(t, postedAt, deliveries[0].ChangedAt())
+		assert.True(t, deliveries[1].CourierID().IsEqual(second))
+	})
+
+	t.Run("should refuse duplicate recipients", func(t *testing.T) {
+		courierID := kernel.NewUUID()
+		a := newAnnouncement(t, courierID)
+
+		require.ErrorIs(t, a.AddRecipient(courierID), announcement.ErrRecipientIsAlreadyAdded)
+		assert.Len(t, a.Deliveries(), 1)
+	})
+
+	t.Run("should record delivery outcomes once", func(t *testing.T) {
+		delivered, failed := kernel.NewUUID(), kernel.NewUUID()
+		a := newAnnouncement(t, delivered, failed)
+
+		require.NoError(t, a.MarkDelivered(delivered, deliveredAt))
+		require.NoError(t, a.MarkFailed(failed, deliveredAt, "channel unavailable"))
+
+		deliveries := a.Deliveries()
+		assert.Equal(t, announcement.Delivered, deliveries[0].Status())
+		assert.Equal(t, deliveredAt, deliveries[0].ChangedAt())
+		assert.Empty(t, deliveries[0].Failure())
+		assert.Equal(t, announcement.Failed, deliveries[1].Status())
+		assert.Equal(t, "channel unavailable", deliveries[1].Failure())
+
+		require.ErrorIs(t, a.MarkFailed(delivered, deliveredAt, "late"), announcement.ErrDeliveryIsNotPending)
+	})
+
+	t.Run("should refuse outcomes for unknown couriers", func(t *testing.T) {
+		a := newAnnouncement(t)
+
+		require.ErrorIs(t, a.MarkDelivered(kernel.NewUUID(), deliveredAt), announcement.ErrRecipientIsNotFound)
+	})
+}
+
+func TestDeliveryStatus(t *testing.T) {
+	require.NoError(t, announcement.Delivered.Validate())
+	assert.Equal(t, "Delivered", announcement.Delivered.String())
+
+	require.Error(t, announcement.UnknownDeliveryStatus.Validate())
+	assert.Equal(t, "Unknown", announcement.DeliveryStatus(42).String())
+}
@@ -0,0 +1,91 @@
+package announcement
+
+import (
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+)
+
+// DeliveryStatus is the state of an announcement's delivery to a single courier.
+//
+// State transitions:
+//
+//	Pending ──┬──> Delivered
+//	          └──> Failed
+type DeliveryStatus int
+
+const (
+	// UnknownDeliveryStatus represents an invalid or undefined status.
+	// This value (0) helps catch uninitialized DeliveryStatus values.
+	UnknownDeliveryStatus DeliveryStatus = iota
+
+	// Pending marks a recipient the announcement has not been sent to yet.
+	Pending
+
+	// Delivered marks a recipient whose notification channel accepted the announcement.
+	Delivered
+
+	// Failed marks a recipient the announcement could not be sent to.
+	Failed
+)
+
+// getValidDeliveryStatusStrings returns a map of valid DeliveryStatus values to their string representations.
+func getValidDeliveryStatusStrings() map[DeliveryStatus]string {
+	//nolint:exhaustive // UnknownDeliveryStatus is intentionally excluded as it's invalid
+	return map[DeliveryStatus]string{
+		Pending:   "Pending",
+		Delivered: "Delivered",
+		Failed:    "Failed",
+	}
+}
+
+// Validate checks if the DeliveryStatus value is valid.
+func (s DeliveryStatus) Validate() error {
+	if _, ok := getValidDeliveryStatusStrings()[s]; !ok {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"delivery status is invalid",
+			fmt.Errorf("%d is not a valid delivery status", s),
+		)
+	}
+	return nil
+}
+
+// String returns the human-readable name of the status.
+// Returns "Unknown" for invalid status values.
+func (s DeliveryStatus) String() string {
+	if str, ok := getValidDeliveryStatusStrings()[s]; ok {
+		return str
+	}
+	return "Unknown"
+}
+
+// Delivery tracks an announcement sent to one courier.
+// Delivery is a value object; the announcement replaces it on every status change.
+type Delivery struct {
+	courierID kernel.UUID
+	status    DeliveryStatus
+	changedAt time.Time
+	failure   string
+}
+
+// CourierID returns the recipient of the announcement.
+func (d Delivery) CourierID() kernel.UUID {
+	return d.courierID
+}
+
+// Status returns the delivery state.
+func (d Delivery) Status() DeliveryStatus {
+	return d.status
+}
+
+// ChangedAt returns the moment of the last status change.
+func (d Delivery) ChangedAt() time.Time {
+	return d.changedAt
+}
+
+// Failure returns why a Failed delivery failed; it is empty for other statuses.
+func (d Delivery) Failure() string {
+	return d.failure
+}
@@ -0,0 +1,17 @@
+// Package announcement provides the domain model of dispatch announcements
+// broadcast to couriers on shift.
+//
+// The package includes:
+//   - Announcement: The aggregate root with the text, the optional target zone and the recipients
+//   - Delivery and DeliveryStatus: The per-courier state of the announcement's delivery
+//
+// Key business rules:
+//   - Announcement text must not be blank and must not exceed MaxTextLength characters
+//   - An announcement without a zone addresses every courier on shift
+//   - An announcement with a zone addresses the couriers located inside it, borders included
+//   - Every courier receives an announcement at most once
+//   - A delivery is resolved exactly once, as Delivered or Failed
+//
+// The package follows Domain-Driven Design principles, providing rich domain
+// behavior, encapsulation, and validation to ensure business rules are enforced.
+package announcement
@@ -0,0 +1,16 @@
+// Package badge provides the domain model of the badges couriers earn for their deliveries,
+// such as a hundred deliveries or a week of five-star ratings.
+//
+// The package includes:
+//   - Kind: What a badge rule counts
+//   - Rule: A named badge and what a courier must achieve to earn it
+//   - Tally: What a courier has achieved as of a delivery or a rating
+//
+// Key business rules:
+//   - Badge rules are configured, not hard-coded; each has a unique name and a positive threshold
+//   - A courier earns a badge once, when a delivery or a rating first meets its rule, and keeps it
+//   - Weeks start on Monday at midnight UTC
+//
+// The package follows Domain-Driven Design principles, providing rich domain
+// behavior, encapsulation, and validation to ensure business rules are enforced.
+package badge
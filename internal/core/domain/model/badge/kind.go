@@ -0,0 +1,64 @@
+package badge
+
+import (
+	"fmt"
+
+	"delivery/internal/pkg/errs"
+)
+
+// Kind is what a badge rule counts to decide whether a courier earned the badge.
+type Kind int
+
+const (
+	// UnknownKind represents an invalid or undefined kind.
+	// This value (0) helps catch uninitialized Kind values.
+	UnknownKind Kind = iota
+
+	// Deliveries counts the orders the courier delivered in total.
+	Deliveries
+
+	// FiveStarWeek counts the deliveries rated in a week, provided all of them got five stars.
+	FiveStarWeek
+)
+
+// getValidKindStrings returns a map of valid Kind values to their string representations.
+func getValidKindStrings() map[Kind]string {
+	//nolint:exhaustive // UnknownKind is intentionally excluded as it's invalid
+	return map[Kind]string{
+		Deliveries:   "deliveries",
+		FiveStarWeek: "five-star-week",
+	}
+}
+
+// ParseKind returns the kind with the given name.
+func ParseKind(value string) (Kind, error) {
+	for kind, name := range getValidKindStrings() {
+		if name == value {
+			return kind, nil
+		}
+	}
+	return UnknownKind, errs.NewValueIsInvalidErrorWithCause(
+		"badge rule kind is invalid",
+		fmt.Errorf("%q is not one of deliveries, five-star-week", value),
+	)
+}
+
+// Validate checks if the Kind value is valid.
+func (k Kind) Validate() error {
+	if _, ok := getValidKindStrings()[k]; !ok {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"badge rule kind is invalid",
+			fmt.Errorf("%d is not a valid kind", k),
+		)
+	}
+	return nil
+}
+
+// String returns the name of the kind.
+// Returns "unknown" for invalid kind values.
+func (k Kind) String() string {
+	if str, ok := getValidKindStrings()[k]; ok {
+		return str
+	}
+	return "unknown"
+}
@@ -0,0 +1,164 @@
+package badge
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// MaxRuleNameLength is the maximum number of characters of a badge name.
+const MaxRuleNameLength = 64
+
+var (
+	// ErrRuleIsNotConstructed indicates that a Rule was not properly initialized
+	// through the NewRule or ParseRules constructors.
+	ErrRuleIsNotConstructed = errors.New("Rule must be created via NewRule or ParseRules constructor")
+
+	// ruleNamePattern matches lowercase names of letters and digits separated by single hyphens.
+	ruleNamePattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+)
+
+// Rule names a badge and what a courier must achieve to earn it: the kind of achievement
+// and the threshold it must reach.
+//
+// Key business rules:
+//   - Must be constructed through NewRule or ParseRules
+//   - The name is lowercase letters and digits separated by hyphens, at most MaxRuleNameLength characters
+//   - The threshold is positive
+//   - A Deliveries rule is earned once the courier delivered threshold orders
+//   - A FiveStarWeek rule is earned once threshold deliveries rated in a week all got five stars
+type Rule struct {
+	name      string
+	kind      Kind
+	threshold int
+
+	guard guard.ConstructorGuard
+}
+
+// NewRule creates a rule awarding the named badge once the achievement of the kind reaches the threshold.
+// Returns a validation error if the name, the kind or the threshold is invalid.
+//
+// Example:
+//
+//	rule, err := badge.NewRule("century", badge.Deliveries, 100)
+func NewRule(name string, kind Kind, threshold int) (Rule, error) {
+	var validation errs.ValidationErrors
+	if !ruleNamePattern.MatchString(name) || len(name) > MaxRuleNameLength {
+		validation.Add("name", errs.NewValueIsInvalidErrorWithCause(
+			"badge rule is invalid",
+			fmt.Errorf("name %q must be lowercase letters and digits separated by hyphens, at most %d characters",
+				name, MaxRuleNameLength),
+		))
+	}
+	if err := kind.Validate(); err != nil {
+		validation.Add("kind", err)
+	}
+	if threshold < 1 {
+		validation.Add("threshold", errs.NewValueIsInvalidErrorWithCause(
+			"badge rule is invalid",
+			fmt.Errorf("threshold %d must be positive", threshold),
+		))
+	}
+	if err := validation.Err(); err != nil {
+		return Rule{}, err
+	}
+
+	return Rule{name: name, kind: kind, threshold: threshold, guard: guard.NewConstructorGuard()}, nil
+}
+
+// ParseRules creates rules from a comma-separated list, each a badge name followed by an equals
+// sign, the kind and a colon with the threshold. Returns no rules for a blank list and a
+// validation error if a rule is invalid or two rules share a name.
+//
+// Example:
+//
+//	rules, err := badge.ParseRules("century=deliveries:100,five-star-week=five-star-week:5")
+func ParseRules(spec string) ([]Rule, error) {
+	var rules []Rule
+	names := make(map[string]bool)
+	for i, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		name, condition, hasName := strings.Cut(part, "=")
+		kindName, thresholdText, hasThreshold := strings.Cut(condition, ":")
+		if !hasName || !hasThreshold {
+			return nil, errs.NewValueIsInvalidErrorWithCause(
+				"badge rules are invalid",
+				fmt.Errorf("rule %d must look like century=deliveries:100", i+1),
+			)
+		}
+
+		kind, err := ParseKind(strings.TrimSpace(kindName))
+		if err != nil {
+			return nil, err
+		}
+		threshold, err := strconv.Atoi(strings.TrimSpace(thresholdText))
+		if err != nil {
+			return nil, errs.NewValueIsInvalidErrorWithCause(
+				"badge rules are invalid",
+				fmt.Errorf("rule %d must end with a whole threshold", i+1),
+			)
+		}
+
+		rule, err := NewRule(strings.TrimSpace(name), kind, threshold)
+		if err != nil {
+			return nil, err
+		}
+		if names[rule.name] {
+			return nil, errs.NewValueIsInvalidErrorWithCause(
+				"badge rules are invalid",
+				fmt.Errorf("badge %s is defined more than once", rule.name),
+			)
+		}
+		names[rule.name] = true
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// Validate ensures the Rule was properly constructed.
+// Returns ErrRuleIsNotConstructed if validation fails.
+func (r Rule) Validate() error {
+	return r.guard.Validate(ErrRuleIsNotConstructed)
+}
+
+// Name returns the name of the badge the rule awards.
+func (r Rule) Name() string {
+	return r.name
+}
+
+// Kind returns what the rule counts.
+func (r Rule) Kind() Kind {
+	return r.kind
+}
+
+// Threshold returns the count the achievement must reach.
+func (r Rule) Threshold() int {
+	return r.threshold
+}
+
+// IsEarnedBy reports whether a courier with the tally meets the rule.
+func (r Rule) IsEarnedBy(tally Tally) bool {
+	switch r.kind {
+	case Deliveries:
+		return tally.Deliveries >= r.threshold
+	case FiveStarWeek:
+		return tally.WeekRatings >= r.threshold && tally.WeekFiveStarRatings == tally.WeekRatings
+	case UnknownKind:
+		return false
+	}
+	return false
+}
+
+// String returns the rule in the format ParseRules reads.
+func (r Rule) String() string {
+	return fmt.Sprintf("%s=%s:%d", r.name, r.kind, r.threshold)
+}
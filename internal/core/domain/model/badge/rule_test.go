@@ -0,0 +1,81 @@
+package badge_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/badge"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRules(t *testing.T) {
+	t.Run("should parse rules", func(t *testing.T) {
+		rules, err := badge.ParseRules(" century = deliveries:100 , five-star-week=five-star-week:5 ")
+
+		require.NoError(t, err)
+		require.Len(t, rules, 2)
+		require.NoError(t, rules[0].Validate())
+		assert.Equal(t, "century", rules[0].Name())
+		assert.Equal(t, badge.Deliveries, rules[0].Kind())
+		assert.Equal(t, 100, rules[0].Threshold())
+		assert.Equal(t, "five-star-week=five-star-week:5", rules[1].String())
+	})
+
+	t.Run("should parse no rules from a blank list", func(t *testing.T) {
+		rules, err := badge.ParseRules(" ")
+
+		require.NoError(t, err)
+		assert.Empty(t, rules)
+	})
+
+	for name, spec := range map[string]string{
+		"no threshold":       "century=deliveries",
+		"no name":            "deliveries:100",
+		"unknown kind":       "century=distance:100",
+		"fractional count":   "century=deliveries:1.5",
+		"zero threshold":     "century=deliveries:0",
+		"uppercase name":     "Century=deliveries:100",
+		"repeated name":      "century=deliveries:100,century=deliveries:200",
+		"name with a space":  "one hundred=deliveries:100",
+		"trailing separator": "century-=deliveries:100",
+	} {
+		t.Run("should fail with "+name, func(t *testing.T) {
+			_, err := badge.ParseRules(spec)
+
+			require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+		})
+	}
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, badge.Rule{}.Validate(), badge.ErrRuleIsNotConstructed)
+	})
+}
+
+func TestRule_IsEarnedBy(t *testing.T) {
+	century, err := badge.NewRule("century", badge.Deliveries, 100)
+	require.NoError(t, err)
+	fiveStarWeek, err := badge.NewRule("five-star-week", badge.FiveStarWeek, 5)
+	require.NoError(t, err)
+
+	assert.False(t, century.IsEarnedBy(badge.Tally{Deliveries: 99}))
+	assert.True(t, century.IsEarnedBy(badge.Tally{Deliveries: 100}))
+
+	assert.False(t, fiveStarWeek.IsEarnedBy(badge.Tally{WeekRatings: 4, WeekFiveStarRatings: 4}))
+	assert.True(t, fiveStarWeek.IsEarnedBy(badge.Tally{WeekRatings: 5, WeekFiveStarRatings: 5}))
+	assert.False(t, fiveStarWeek.IsEarnedBy(badge.Tally{WeekRatings: 6, WeekFiveStarRatings: 5}),
+		"a lower rating in the week spoils it")
+}
+
+func TestWeekOf(t *testing.T) {
+	monday := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, monday, badge.WeekOf(monday))
+	assert.Equal(t, monday, badge.WeekOf(time.Date(2025, 3, 9, 23, 59, 0, 0, time.UTC)))
+	assert.Equal(t, monday.AddDate(0, 0, 7), badge.WeekOf(time.Date(2025, 3, 10, 0, 30, 0, 0, time.UTC)))
+	moscow := time.FixedZone("MSK", 3*3600)
+	assert.Equal(t, monday.AddDate(0, 0, -7), badge.WeekOf(time.Date(2025, 3, 3, 2, 0, 0, 0, moscow)),
+		"weeks are in UTC")
+}
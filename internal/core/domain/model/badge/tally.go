@@ -0,0 +1,30 @@
+package badge
+
+import (
+	"time"
+
+	"delivery/internal/core/domain/model/order"
+)
+
+// FiveStars is the rating a FiveStarWeek rule requires of every delivery rated in the week.
+const FiveStars = order.MaxRatingStars
+
+// Tally is what a courier has achieved as of a delivery or a rating: the deliveries so far
+// and the ratings received in the week of the delivery or rating.
+type Tally struct {
+	// Deliveries counts the orders the courier delivered in total
+	Deliveries int
+
+	// WeekRatings counts the deliveries of the courier rated in the week
+	WeekRatings int
+
+	// WeekFiveStarRatings counts the deliveries of the courier rated five stars in the week
+	WeekFiveStarRatings int
+}
+
+// WeekOf returns the start of the week of the moment, Monday at midnight UTC.
+func WeekOf(at time.Time) time.Time {
+	day := at.UTC().Truncate(24 * time.Hour)
+	sinceMonday := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -sinceMonday)
+}
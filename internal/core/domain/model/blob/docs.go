@@ -0,0 +1,17 @@
+// Package blob provides the domain model of the files couriers and operators upload next to
+// the deliveries: photos proving a delivery and documents of couriers.
+//
+// The package includes:
+//   - Purpose: What an uploaded file is for, which decides the content types and size it may have
+//   - Upload: A file a client was allowed to upload to the blob storage, and whether it is in use
+//
+// Key business rules:
+//   - Delivery photos are JPEG, PNG or WebP images of at most MaxDeliveryPhotoSize bytes
+//   - Courier documents are JPEG or PNG scans or PDF files of at most MaxCourierDocumentSize bytes
+//   - A file is stored under a key derived from its purpose, owner and upload ID, never from
+//     a name chosen by the client
+//   - An upload no feature attached within the grace period is orphaned and its file is removed
+//
+// The package follows Domain-Driven Design principles, providing rich domain
+// behavior, encapsulation, and validation to ensure business rules are enforced.
+package blob
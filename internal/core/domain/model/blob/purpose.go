@@ -0,0 +1,114 @@
+package blob
+
+import (
+	"fmt"
+
+	"delivery/internal/pkg/errs"
+)
+
+const (
+	// MaxDeliveryPhotoSize is the largest delivery photo in bytes, 10 MiB.
+	MaxDeliveryPhotoSize int64 = 10 << 20
+
+	// MaxCourierDocumentSize is the largest courier document in bytes, 20 MiB.
+	MaxCourierDocumentSize int64 = 20 << 20
+)
+
+// Purpose is what an uploaded file is for.
+type Purpose int
+
+const (
+	// UnknownPurpose represents an invalid or undefined purpose.
+	// This value (0) helps catch uninitialized Purpose values.
+	UnknownPurpose Purpose = iota
+
+	// DeliveryPhoto is a photo a courier takes as proof of a delivery. Owned by the order.
+	DeliveryPhoto
+
+	// CourierDocument is a scan of a courier's document, such as a driving licence. Owned by the courier.
+	CourierDocument
+)
+
+// getValidPurposeStrings returns a map of valid Purpose values to their string representations.
+func getValidPurposeStrings() map[Purpose]string {
+	//nolint:exhaustive // UnknownPurpose is intentionally excluded as it's invalid
+	return map[Purpose]string{
+		DeliveryPhoto:   "delivery-photo",
+		CourierDocument: "courier-document",
+	}
+}
+
+// contentTypes returns the content types accepted for each purpose with the file extension
+// their blobs are stored with.
+func contentTypes() map[Purpose]map[string]string {
+	//nolint:exhaustive // UnknownPurpose is intentionally excluded as it's invalid
+	return map[Purpose]map[string]string{
+		DeliveryPhoto: {
+			"image/jpeg": "jpg",
+			"image/png":  "png",
+			"image/webp": "webp",
+		},
+		CourierDocument: {
+			"image/jpeg":      "jpg",
+			"image/png":       "png",
+			"application/pdf": "pdf",
+		},
+	}
+}
+
+// ParsePurpose returns the purpose with the given name.
+func ParsePurpose(value string) (Purpose, error) {
+	for purpose, name := range getValidPurposeStrings() {
+		if name == value {
+			return purpose, nil
+		}
+	}
+	return UnknownPurpose, errs.NewValueIsInvalidErrorWithCause(
+		"upload purpose is invalid",
+		fmt.Errorf("%q is not one of delivery-photo or courier-document", value),
+	)
+}
+
+// Validate checks if the Purpose value is valid.
+func (p Purpose) Validate() error {
+	if _, ok := getValidPurposeStrings()[p]; !ok {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"upload purpose is invalid",
+			fmt.Errorf("%d is not a valid upload purpose", p),
+		)
+	}
+	return nil
+}
+
+// String returns the name of the purpose.
+// Returns "unknown" for invalid purpose values.
+func (p Purpose) String() string {
+	if str, ok := getValidPurposeStrings()[p]; ok {
+		return str
+	}
+	return "unknown"
+}
+
+// MaxSize returns the largest file in bytes accepted for the purpose, or 0 for an invalid purpose.
+func (p Purpose) MaxSize() int64 {
+	//nolint:exhaustive // UnknownPurpose accepts nothing
+	switch p {
+	case DeliveryPhoto:
+		return MaxDeliveryPhotoSize
+	case CourierDocument:
+		return MaxCourierDocumentSize
+	default:
+		return 0
+	}
+}
+
+// Accepts reports whether files of the content type may be uploaded for the purpose.
+func (p Purpose) Accepts(contentType string) bool {
+	_, ok := contentTypes()[p][contentType]
+	return ok
+}
+
+// extension returns the file extension blobs of the content type are stored with.
+func (p Purpose) extension(contentType string) string {
+	return contentTypes()[p][contentType]
+}
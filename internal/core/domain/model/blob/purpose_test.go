@@ -0,0 +1,50 @@
+package blob_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/domain/model/blob"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePurpose(t *testing.T) {
+	tests := []struct {
+		value string
+		want  blob.Purpose
+	}{
+		{value: "delivery-photo", want: blob.DeliveryPhoto},
+		{value: "courier-document", want: blob.CourierDocument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			purpose, err := blob.ParsePurpose(tt.value)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, purpose)
+			assert.Equal(t, tt.value, purpose.String())
+		})
+	}
+
+	_, err := blob.ParsePurpose("avatar")
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
+
+func TestPurpose_Accepts(t *testing.T) {
+	assert.True(t, blob.DeliveryPhoto.Accepts("image/jpeg"))
+	assert.True(t, blob.DeliveryPhoto.Accepts("image/webp"))
+	assert.False(t, blob.DeliveryPhoto.Accepts("application/pdf"))
+	assert.True(t, blob.CourierDocument.Accepts("application/pdf"))
+	assert.False(t, blob.CourierDocument.Accepts("image/webp"))
+	assert.False(t, blob.UnknownPurpose.Accepts("image/jpeg"))
+}
+
+func TestPurpose_MaxSize(t *testing.T) {
+	assert.Equal(t, blob.MaxDeliveryPhotoSize, blob.DeliveryPhoto.MaxSize())
+	assert.Equal(t, blob.MaxCourierDocumentSize, blob.CourierDocument.MaxSize())
+	assert.Zero(t, blob.UnknownPurpose.MaxSize())
+	require.Error(t, blob.UnknownPurpose.Validate())
+}
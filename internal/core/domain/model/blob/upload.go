@@ -0,0 +1,201 @@
+package blob
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	// ErrUploadIsNotConstructed indicates that an Upload was not properly
+	// initialized through the NewUpload constructor function.
+	ErrUploadIsNotConstructed = errors.New("Upload must be created via NewUpload constructor")
+
+	// ErrContentTypeIsNotAccepted is returned when files of a content type may not be uploaded
+	// for the purpose.
+	ErrContentTypeIsNotAccepted = errors.New("content type is not accepted for the upload purpose")
+
+	// ErrUploadIsAlreadyAttached is returned when a feature attaches an upload that is already in use.
+	ErrUploadIsAlreadyAttached = errors.New("upload is already attached")
+)
+
+// Upload is a file a client was allowed to upload to the blob storage.
+// The client uploads the file itself with a signed URL; the feature the file is for
+// attaches the upload once it uses the file, such as a delivery confirmed with the photo.
+//
+// Key business rules:
+//   - Must be constructed through NewUpload constructor
+//   - The content type and size must be accepted for the purpose
+//   - The blob key is derived from the purpose, owner and ID
+//   - An upload is attached at most once; unattached uploads are orphaned after a grace period
+type Upload struct {
+	// id uniquely identifies the upload
+	id kernel.UUID
+
+	// purpose is what the file is for
+	purpose Purpose
+
+	// ownerID is the order of a delivery photo or the courier of a courier document
+	ownerID kernel.UUID
+
+	// contentType is the media type of the file
+	contentType string
+
+	// size is the exact size of the file in bytes
+	size int64
+
+	// issuedAt is when the upload was allowed
+	issuedAt time.Time
+
+	// attachedAt is when a feature started using the file, nil while unattached
+	attachedAt *time.Time
+
+	// guard ensures the upload was created via NewUpload
+	guard guard.ConstructorGuard
+}
+
+// NewUpload creates an upload of a file with the given content type and size for the purpose.
+//
+// Example:
+//
+//	upload, err := blob.NewUpload(kernel.NewUUID(), blob.DeliveryPhoto, orderID, "image/jpeg", 2_400_000, time.Now())
+//	if errors.Is(err, blob.ErrContentTypeIsNotAccepted) {
+//	    // Delivery photos must be images
+//	}
+func NewUpload(
+	id kernel.UUID,
+	purpose Purpose,
+	ownerID kernel.UUID,
+	contentType string,
+	size int64,
+	issuedAt time.Time,
+) (*Upload, error) {
+	contentType = strings.ToLower(strings.TrimSpace(contentType))
+
+	var fields errs.ValidationErrors
+	fields.Add("id", id.Validate())
+	purposeErr := purpose.Validate()
+	fields.Add("purpose", purposeErr)
+	fields.Add("ownerId", ownerID.Validate())
+	if purposeErr == nil {
+		if contentType == "" {
+			fields.Add("contentType", errs.NewValueIsRequiredError("contentType"))
+		} else if !purpose.Accepts(contentType) {
+			fields.Add("contentType", fmt.Errorf("%w: %s is not accepted for %s",
+				ErrContentTypeIsNotAccepted, contentType, purpose))
+		}
+		if size <= 0 || size > purpose.MaxSize() {
+			fields.Add("size", errs.NewValueIsOutOfRangeError("size", size, 1, purpose.MaxSize()))
+		}
+	}
+	if issuedAt.IsZero() {
+		fields.Add("issuedAt", errs.NewValueIsRequiredError("issuedAt"))
+	}
+	if err := fields.Err(); err != nil {
+		return nil, err
+	}
+
+	return &Upload{
+		id:          id,
+		purpose:     purpose,
+		ownerID:     ownerID,
+		contentType: contentType,
+		size:        size,
+		issuedAt:    issuedAt,
+		guard:       guard.NewConstructorGuard(),
+	}, nil
+}
+
+// RestoreUpload recreates a stored upload with validation. Used by repositories.
+// It enforces the same rules as NewUpload.
+func RestoreUpload(
+	id kernel.UUID,
+	purpose Purpose,
+	ownerID kernel.UUID,
+	contentType string,
+	size int64,
+	issuedAt time.Time,
+	attachedAt *time.Time,
+) (*Upload, error) {
+	upload, err := NewUpload(id, purpose, ownerID, contentType, size, issuedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	upload.attachedAt = attachedAt
+	return upload, nil
+}
+
+// Validate ensures the Upload instance was properly constructed through NewUpload or RestoreUpload.
+func (u *Upload) Validate() error {
+	if u == nil {
+		return ErrUploadIsNotConstructed
+	}
+
+	return u.guard.Validate(ErrUploadIsNotConstructed)
+}
+
+// ID returns the upload's unique identifier.
+func (u *Upload) ID() kernel.UUID {
+	return u.id
+}
+
+// Purpose returns what the file is for.
+func (u *Upload) Purpose() Purpose {
+	return u.purpose
+}
+
+// OwnerID returns the order of a delivery photo or the courier of a courier document.
+func (u *Upload) OwnerID() kernel.UUID {
+	return u.ownerID
+}
+
+// ContentType returns the media type of the file.
+func (u *Upload) ContentType() string {
+	return u.contentType
+}
+
+// Size returns the size of the file in bytes.
+func (u *Upload) Size() int64 {
+	return u.size
+}
+
+// IssuedAt returns when the upload was allowed.
+func (u *Upload) IssuedAt() time.Time {
+	return u.issuedAt
+}
+
+// AttachedAt returns when a feature started using the file, or nil while it is unattached.
+func (u *Upload) AttachedAt() *time.Time {
+	return u.attachedAt
+}
+
+// Key returns the key the file is stored under in the blob storage:
+// <purpose>/<owner ID>/<upload ID>.<extension>.
+func (u *Upload) Key() string {
+	return fmt.Sprintf("%s/%s/%s.%s", u.purpose, u.ownerID, u.id, u.purpose.extension(u.contentType))
+}
+
+// Attach marks the file as used by a feature at the given time, so it is never orphaned.
+// Returns ErrUploadIsAlreadyAttached if the upload is already attached.
+func (u *Upload) Attach(now time.Time) error {
+	if u.attachedAt != nil {
+		return ErrUploadIsAlreadyAttached
+	}
+	if now.IsZero() {
+		return errs.NewValueIsRequiredError("now")
+	}
+
+	u.attachedAt = &now
+	return nil
+}
+
+// IsOrphaned reports whether the upload was issued before the cutoff and no feature attached it.
+func (u *Upload) IsOrphaned(issuedBefore time.Time) bool {
+	return u.attachedAt == nil && u.issuedAt.Before(issuedBefore)
+}
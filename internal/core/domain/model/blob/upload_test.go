@@ -0,0 +1,104 @@
+package blob_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/blob"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var issuedAt = time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+func TestNewUpload(t *testing.T) {
+	t.Run("should create upload", func(t *testing.T) {
+		id, orderID := kernel.NewUUID(), kernel.NewUUID()
+
+		upload, err := blob.NewUpload(id, blob.DeliveryPhoto, orderID, " Image/JPEG ", 2_400_000, issuedAt)
+
+		require.NoError(t, err)
+		require.NoError(t, upload.Validate())
+		assert.True(t, upload.ID().IsEqual(id))
+		assert.Equal(t, blob.DeliveryPhoto, upload.Purpose())
+		assert.True(t, upload.OwnerID().IsEqual(orderID))
+		assert.Equal(t, "image/jpeg", upload.ContentType())
+		assert.Equal(t, int64(2_400_000), upload.Size())
+		assert.Equal(t, issuedAt, upload.IssuedAt())
+		assert.Nil(t, upload.AttachedAt())
+		assert.Equal(t, "delivery-photo/"+orderID.String()+"/"+id.String()+".jpg", upload.Key())
+	})
+
+	t.Run("should refuse a content type the purpose does not accept", func(t *testing.T) {
+		upload, err := blob.NewUpload(
+			kernel.NewUUID(), blob.DeliveryPhoto, kernel.NewUUID(), "application/pdf", 1000, issuedAt)
+
+		require.ErrorIs(t, err, blob.ErrContentTypeIsNotAccepted)
+		assert.Nil(t, upload)
+	})
+
+	t.Run("should refuse a file larger than the purpose allows", func(t *testing.T) {
+		_, err := blob.NewUpload(kernel.NewUUID(), blob.DeliveryPhoto, kernel.NewUUID(), "image/png",
+			blob.MaxDeliveryPhotoSize+1, issuedAt)
+		require.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+
+		_, err = blob.NewUpload(kernel.NewUUID(), blob.CourierDocument, kernel.NewUUID(), "application/pdf",
+			blob.MaxDeliveryPhotoSize+1, issuedAt)
+		require.NoError(t, err)
+	})
+
+	t.Run("should report every invalid field", func(t *testing.T) {
+		upload, err := blob.NewUpload(kernel.UUID{}, blob.CourierDocument, kernel.UUID{}, "", 0, time.Time{})
+
+		require.ErrorIs(t, err, errs.ErrValidationFailed)
+		assert.Nil(t, upload)
+		var validation *errs.ValidationErrors
+		require.ErrorAs(t, err, &validation)
+		fields := make([]string, 0, len(validation.Fields))
+		for _, field := range validation.Fields {
+			fields = append(fields, field.Field)
+		}
+		assert.Equal(t, []string{"id", "ownerId", "contentType", "size", "issuedAt"}, fields)
+	})
+
+	t.Run("nil upload should be invalid", func(t *testing.T) {
+		var upload *blob.Upload
+
+		require.ErrorIs(t, upload.Validate(), blob.ErrUploadIsNotConstructed)
+	})
+}
+
+func TestUpload_Attach(t *testing.T) {
+	upload, err := blob.NewUpload(kernel.NewUUID(), blob.CourierDocument, kernel.NewUUID(), "application/pdf",
+		1000, issuedAt)
+	require.NoError(t, err)
+	cutoff := issuedAt.Add(time.Hour)
+	assert.True(t, upload.IsOrphaned(cutoff))
+	assert.False(t, upload.IsOrphaned(issuedAt), "an upload is not orphaned before the grace period ends")
+
+	attachedAt := issuedAt.Add(time.Minute)
+	require.NoError(t, upload.Attach(attachedAt))
+
+	require.NotNil(t, upload.AttachedAt())
+	assert.Equal(t, attachedAt, *upload.AttachedAt())
+	assert.False(t, upload.IsOrphaned(cutoff))
+	require.ErrorIs(t, upload.Attach(cutoff), blob.ErrUploadIsAlreadyAttached)
+}
+
+func TestRestoreUpload(t *testing.T) {
+	attachedAt := issuedAt.Add(time.Minute)
+
+	upload, err := blob.RestoreUpload(kernel.NewUUID(), blob.DeliveryPhoto, kernel.NewUUID(), "image/webp", 1000,
+		issuedAt, &attachedAt)
+
+	require.NoError(t, err)
+	require.NoError(t, upload.Validate())
+	assert.Equal(t, &attachedAt, upload.AttachedAt())
+
+	_, err = blob.RestoreUpload(kernel.NewUUID(), blob.DeliveryPhoto, kernel.NewUUID(), "text/plain", 1000,
+		issuedAt, nil)
+	require.ErrorIs(t, err, blob.ErrContentTypeIsNotAccepted)
+}
@@ -0,0 +1,265 @@
+package courier
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	// ErrAbsenceIsNotConstructed indicates that an Absence was not properly initialized
+	// through the NewAbsence constructor.
+	ErrAbsenceIsNotConstructed = errors.New("Absence must be created via NewAbsence constructor")
+
+	// ErrAbsenceNotFound is returned when a courier has no absence with the given ID.
+	ErrAbsenceNotFound = errors.New("absence not found")
+
+	// ErrAbsenceOverlaps is returned when an absence overlaps another absence of the courier.
+	ErrAbsenceOverlaps = errors.New("absence overlaps another absence")
+
+	// ErrAbsenceIsOver is returned when planning an absence that has already ended.
+	ErrAbsenceIsOver = errors.New("absence is over")
+
+	// ErrSubstituteIsAbsentCourier is returned when a courier is named as their own substitute.
+	ErrSubstituteIsAbsentCourier = errors.New("courier cannot substitute for themselves")
+
+	// ErrSubstituteIsUnavailable is returned when the substitute is deactivated or absent themselves
+	// during the absence.
+	ErrSubstituteIsUnavailable = errors.New("substitute is unavailable during the absence")
+
+	// ErrAbsentCourierIsSubstitute is returned when a courier would be absent while substituting
+	// for another absent courier.
+	ErrAbsentCourierIsSubstitute = errors.New("courier substitutes for another courier during the absence")
+)
+
+// Absence is a planned period in which the courier does not work. A substitute courier
+// takes over the orders of the absent courier while it lasts.
+//
+// Key business rules:
+//   - Must be constructed through NewAbsence
+//   - The absence must not be empty: startsAt is before endsAt
+//   - The substitute is another courier who is available during the whole absence
+type Absence struct {
+	// id uniquely identifies the absence
+	id kernel.UUID
+
+	// startsAt is when the courier stops working
+	startsAt time.Time
+
+	// endsAt is when the courier is back at work
+	endsAt time.Time
+
+	// substituteID is the courier who takes over the absent courier's orders
+	substituteID kernel.UUID
+
+	// guard ensures the absence was created via NewAbsence
+	guard guard.ConstructorGuard
+}
+
+// NewAbsence creates an absence with validation.
+// Used both when planning absences and when restoring them from persistent storage.
+//
+// Example:
+//
+//	absence, err := courier.NewAbsence(
+//	    kernel.NewUUID(),
+//	    time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC),
+//	    time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC),
+//	    substituteID,
+//	)
+func NewAbsence(id kernel.UUID, startsAt, endsAt time.Time, substituteID kernel.UUID) (Absence, error) {
+	if err := errs.JoinFields(
+		errs.Field("id", id.Validate()),
+		errs.Field("startsAt", validateMaintenanceStart(startsAt)),
+		errs.Field("endsAt", validateMaintenanceEnd(startsAt, endsAt)),
+		errs.Field("substituteId", substituteID.Validate()),
+	); err != nil {
+		return Absence{}, err
+	}
+
+	return Absence{
+		id:           id,
+		startsAt:     startsAt.UTC(),
+		endsAt:       endsAt.UTC(),
+		substituteID: substituteID,
+		guard:        guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the Absence was created through NewAbsence.
+func (a Absence) Validate() error {
+	return a.guard.Validate(ErrAbsenceIsNotConstructed)
+}
+
+// ID returns the absence's unique identifier.
+func (a Absence) ID() kernel.UUID {
+	return a.id
+}
+
+// StartsAt returns when the courier stops working.
+func (a Absence) StartsAt() time.Time {
+	return a.startsAt
+}
+
+// EndsAt returns when the courier is back at work.
+func (a Absence) EndsAt() time.Time {
+	return a.endsAt
+}
+
+// SubstituteID returns the courier who takes over the absent courier's orders.
+func (a Absence) SubstituteID() kernel.UUID {
+	return a.substituteID
+}
+
+// Overlaps reports whether the two absences share any moment. Adjacent absences do not overlap.
+func (a Absence) Overlaps(other Absence) bool {
+	return a.startsAt.Before(other.endsAt) && other.startsAt.Before(a.endsAt)
+}
+
+// IsActive reports whether the absence lasts at now.
+func (a Absence) IsActive(now time.Time) bool {
+	return !now.Before(a.startsAt) && now.Before(a.endsAt)
+}
+
+// IsOver reports whether the absence has ended at now.
+func (a Absence) IsOver(now time.Time) bool {
+	return !now.Before(a.endsAt)
+}
+
+// PlanAbsence adds an absence to the courier's calendar.
+//
+// This method enforces the following business rules:
+//   - The absence must not be over at now
+//   - The courier cannot substitute for themselves
+//   - The absence must not overlap another absence of the courier
+//
+// The substitute is checked against their own calendar with CanSubstitute.
+// Returns ErrAbsenceIsOver, ErrSubstituteIsAbsentCourier or ErrAbsenceOverlaps if a rule is violated,
+// or ErrAbsenceIsNotConstructed for an invalid absence.
+//
+// Example:
+//
+//	absence, _ := courier.NewAbsence(kernel.NewUUID(), startsAt, endsAt, substitute.ID())
+//	if err := substitute.CanSubstitute(absence); err != nil {
+//	    return err
+//	}
+//	err := c.PlanAbsence(absence, time.Now())
+func (c *Courier) PlanAbsence(absence Absence, now time.Time) error {
+	if err := absence.Validate(); err != nil {
+		return err
+	}
+
+	if absence.IsOver(now) {
+		return ErrAbsenceIsOver
+	}
+	if absence.SubstituteID().IsEqual(c.id) {
+		return ErrSubstituteIsAbsentCourier
+	}
+	for _, other := range c.absences {
+		if absence.Overlaps(other) {
+			return ErrAbsenceOverlaps
+		}
+	}
+
+	c.absences = append(c.absences, absence)
+	c.sortAbsences()
+	return nil
+}
+
+// CancelAbsence removes the absence with the given ID from the courier's calendar.
+// Returns ErrAbsenceNotFound if the courier has no such absence.
+func (c *Courier) CancelAbsence(absenceID kernel.UUID) error {
+	index := slices.IndexFunc(c.absences, func(absence Absence) bool {
+		return absence.ID().IsEqual(absenceID)
+	})
+	if index < 0 {
+		return ErrAbsenceNotFound
+	}
+
+	c.absences = slices.Delete(c.absences, index, index+1)
+	return nil
+}
+
+// Absences returns a copy of the courier's absences, earliest first.
+func (c *Courier) Absences() []Absence {
+	return slices.Clone(c.absences)
+}
+
+// ActiveAbsence returns the absence lasting at now and false if the courier is at work.
+func (c *Courier) ActiveAbsence(now time.Time) (Absence, bool) {
+	for _, absence := range c.absences {
+		if absence.IsActive(now) {
+			return absence, true
+		}
+	}
+	return Absence{}, false
+}
+
+// IsAbsent reports whether the courier is absent at now.
+func (c *Courier) IsAbsent(now time.Time) bool {
+	_, absent := c.ActiveAbsence(now)
+	return absent
+}
+
+// CanSubstitute checks that the courier can substitute during the absence of another courier.
+// Returns ErrSubstituteIsUnavailable if the courier is deactivated or absent at any moment of it.
+func (c *Courier) CanSubstitute(absence Absence) error {
+	if err := absence.Validate(); err != nil {
+		return err
+	}
+
+	if c.IsDeactivated() {
+		return fmt.Errorf("%w: courier %s is deactivated", ErrSubstituteIsUnavailable, c.id)
+	}
+	for _, own := range c.absences {
+		if own.Overlaps(absence) {
+			return fmt.Errorf("%w: courier %s is absent from %s", ErrSubstituteIsUnavailable,
+				c.id, own.StartsAt().Format(time.RFC3339))
+		}
+	}
+	return nil
+}
+
+// NeedsSubstituteDuring reports whether an absence of the courier that overlaps absence names
+// substituteID as the substitute. A courier planning an absence must not be needed as a substitute
+// at the same time.
+func (c *Courier) NeedsSubstituteDuring(substituteID kernel.UUID, absence Absence) bool {
+	for _, own := range c.absences {
+		if own.SubstituteID().IsEqual(substituteID) && own.Overlaps(absence) {
+			return true
+		}
+	}
+	return false
+}
+
+// RestoreAbsences reapplies persisted absences.
+// Intended for repositories rebuilding the aggregate; absences must not overlap.
+func (c *Courier) RestoreAbsences(absences []Absence) error {
+	restored := make([]Absence, 0, len(absences))
+	for _, absence := range absences {
+		if err := absence.Validate(); err != nil {
+			return err
+		}
+		for _, other := range restored {
+			if absence.Overlaps(other) {
+				return ErrAbsenceOverlaps
+			}
+		}
+		restored = append(restored, absence)
+	}
+
+	c.absences = restored
+	c.sortAbsences()
+	return nil
+}
+
+func (c *Courier) sortAbsences() {
+	slices.SortFunc(c.absences, func(a, b Absence) int {
+		return a.startsAt.Compare(b.startsAt)
+	})
+}
@@ -0,0 +1,131 @@
+package courier_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAbsence(t *testing.T) {
+	startsAt := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	substituteID := kernel.NewUUID()
+
+	t.Run("should create valid absence", func(t *testing.T) {
+		absence, err := courier.NewAbsence(kernel.NewUUID(), startsAt, startsAt.Add(24*time.Hour), substituteID)
+
+		require.NoError(t, err)
+		require.NoError(t, absence.Validate())
+		assert.Equal(t, startsAt, absence.StartsAt())
+		assert.Equal(t, startsAt.Add(24*time.Hour), absence.EndsAt())
+		assert.Equal(t, substituteID, absence.SubstituteID())
+		assert.True(t, absence.IsActive(startsAt))
+		assert.False(t, absence.IsActive(startsAt.Add(24*time.Hour)))
+	})
+
+	t.Run("should fail with empty absence or missing substitute", func(t *testing.T) {
+		_, err := courier.NewAbsence(kernel.NewUUID(), startsAt, startsAt, substituteID)
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+
+		_, err = courier.NewAbsence(kernel.NewUUID(), startsAt, startsAt.Add(time.Hour), kernel.UUID{})
+		require.ErrorIs(t, err, kernel.ErrUUIDIsNotConstructed)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, courier.Absence{}.Validate(), courier.ErrAbsenceIsNotConstructed)
+	})
+}
+
+func TestCourier_PlanAbsence(t *testing.T) {
+	now := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+	location, _ := kernel.NewLocation(1, 1)
+	newCourier := func(t *testing.T) *courier.Courier {
+		t.Helper()
+		c, err := courier.NewCourier(kernel.NewUUID(), "Alice", 2, location)
+		require.NoError(t, err)
+		return c
+	}
+	absence := func(t *testing.T, from, to time.Duration, substituteID kernel.UUID) courier.Absence {
+		t.Helper()
+		a, err := courier.NewAbsence(kernel.NewUUID(), now.Add(from), now.Add(to), substituteID)
+		require.NoError(t, err)
+		return a
+	}
+
+	t.Run("should keep absences earliest first and report the active one", func(t *testing.T) {
+		c := newCourier(t)
+		later := absence(t, 48*time.Hour, 72*time.Hour, kernel.NewUUID())
+		current := absence(t, -time.Hour, 24*time.Hour, kernel.NewUUID())
+
+		require.NoError(t, c.PlanAbsence(later, now))
+		require.NoError(t, c.PlanAbsence(current, now))
+
+		absences := c.Absences()
+		require.Len(t, absences, 2)
+		assert.Equal(t, current.ID(), absences[0].ID())
+		active, ok := c.ActiveAbsence(now)
+		require.True(t, ok)
+		assert.Equal(t, current.ID(), active.ID())
+		assert.False(t, c.IsAbsent(now.Add(24*time.Hour)))
+	})
+
+	t.Run("should refuse overlapping, finished and self-substituted absences", func(t *testing.T) {
+		c := newCourier(t)
+		require.NoError(t, c.PlanAbsence(absence(t, time.Hour, 24*time.Hour, kernel.NewUUID()), now))
+
+		require.ErrorIs(t, c.PlanAbsence(absence(t, 2*time.Hour, 48*time.Hour, kernel.NewUUID()), now),
+			courier.ErrAbsenceOverlaps)
+		require.ErrorIs(t, c.PlanAbsence(absence(t, -2*time.Hour, -time.Hour, kernel.NewUUID()), now),
+			courier.ErrAbsenceIsOver)
+		require.ErrorIs(t, c.PlanAbsence(absence(t, 48*time.Hour, 72*time.Hour, c.ID()), now),
+			courier.ErrSubstituteIsAbsentCourier)
+		require.ErrorIs(t, c.PlanAbsence(courier.Absence{}, now), courier.ErrAbsenceIsNotConstructed)
+		assert.Len(t, c.Absences(), 1)
+	})
+
+	t.Run("should cancel absence", func(t *testing.T) {
+		c := newCourier(t)
+		planned := absence(t, time.Hour, 24*time.Hour, kernel.NewUUID())
+		require.NoError(t, c.PlanAbsence(planned, now))
+
+		require.NoError(t, c.CancelAbsence(planned.ID()))
+		assert.Empty(t, c.Absences())
+		require.ErrorIs(t, c.CancelAbsence(planned.ID()), courier.ErrAbsenceNotFound)
+	})
+}
+
+func TestCourier_CanSubstitute(t *testing.T) {
+	now := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+	location, _ := kernel.NewLocation(1, 1)
+	absent, _ := courier.NewCourier(kernel.NewUUID(), "Alice", 2, location)
+	absence, _ := courier.NewAbsence(kernel.NewUUID(), now.Add(time.Hour), now.Add(24*time.Hour), kernel.NewUUID())
+
+	t.Run("should accept available courier", func(t *testing.T) {
+		substitute, _ := courier.NewCourier(kernel.NewUUID(), "Bob", 2, location)
+
+		require.NoError(t, substitute.CanSubstitute(absence))
+	})
+
+	t.Run("should refuse courier absent at the same time", func(t *testing.T) {
+		substitute, _ := courier.NewCourier(kernel.NewUUID(), "Bob", 2, location)
+		own, _ := courier.NewAbsence(kernel.NewUUID(), now.Add(12*time.Hour), now.Add(36*time.Hour), absent.ID())
+		require.NoError(t, substitute.PlanAbsence(own, now))
+
+		require.ErrorIs(t, substitute.CanSubstitute(absence), courier.ErrSubstituteIsUnavailable)
+		assert.True(t, substitute.NeedsSubstituteDuring(absent.ID(), absence))
+		assert.False(t, substitute.NeedsSubstituteDuring(kernel.NewUUID(), absence))
+	})
+
+	t.Run("should refuse deactivated courier", func(t *testing.T) {
+		substitute, _ := courier.NewCourier(kernel.NewUUID(), "Bob", 2, location)
+		_, err := substitute.Deactivate(courier.Offboarded)
+		require.NoError(t, err)
+
+		require.ErrorIs(t, substitute.CanSubstitute(absence), courier.ErrSubstituteIsUnavailable)
+	})
+}
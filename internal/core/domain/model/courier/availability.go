@@ -0,0 +1,70 @@
+package courier
+
+import (
+	"fmt"
+
+	"delivery/internal/pkg/errs"
+)
+
+// Availability is how a courier looks to a workforce management system mirroring the fleet:
+// free for orders, busy on shift, or off shift. It is derived from the status of the courier
+// and the flags taking the courier off dispatch; the schedule is not taken into account.
+// The zero value AvailabilityOffShift is the availability of a courier who was never on shift.
+type Availability int
+
+const (
+	// AvailabilityOffShift marks a courier who is offline or deactivated.
+	AvailabilityOffShift Availability = iota
+
+	// AvailabilityFree marks a courier on shift who may receive orders.
+	AvailabilityFree
+
+	// AvailabilityBusy marks a courier on shift who receives no orders: the courier carries
+	// orders, takes a break or is flagged for review.
+	AvailabilityBusy
+)
+
+// getValidAvailabilityStrings returns a map of valid Availability values to their string representations.
+func getValidAvailabilityStrings() map[Availability]string {
+	return map[Availability]string{
+		AvailabilityOffShift: "OffShift",
+		AvailabilityFree:     "Free",
+		AvailabilityBusy:     "Busy",
+	}
+}
+
+// GetValidAvailabilities returns every valid availability.
+func GetValidAvailabilities() []Availability {
+	return []Availability{AvailabilityOffShift, AvailabilityFree, AvailabilityBusy}
+}
+
+// Validate checks if the Availability value is a known availability.
+func (a Availability) Validate() error {
+	if _, ok := getValidAvailabilityStrings()[a]; !ok {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"availability",
+			fmt.Errorf("%d is not a valid courier availability", a),
+		)
+	}
+	return nil
+}
+
+// String returns the human-readable name of the availability, "Unknown" for invalid values.
+func (a Availability) String() string {
+	if str, ok := getValidAvailabilityStrings()[a]; ok {
+		return str
+	}
+	return "Unknown"
+}
+
+// Availability returns whether the courier is free for orders, busy on shift or off shift.
+func (c *Courier) Availability() Availability {
+	switch {
+	case c.IsDeactivated() || c.status == Offline:
+		return AvailabilityOffShift
+	case c.status == Available && !c.reviewRequired:
+		return AvailabilityFree
+	default:
+		return AvailabilityBusy
+	}
+}
@@ -0,0 +1,74 @@
+package courier_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAvailability_Validate(t *testing.T) {
+	for _, availability := range courier.GetValidAvailabilities() {
+		require.NoError(t, availability.Validate())
+	}
+
+	require.ErrorIs(t, courier.Availability(42).Validate(), errs.ErrValueIsInvalid)
+	assert.Equal(t, "Unknown", courier.Availability(42).String())
+	assert.Equal(t, "OffShift", courier.AvailabilityOffShift.String())
+}
+
+func TestCourier_Availability(t *testing.T) {
+	newCourier := func(t *testing.T) *courier.Courier {
+		t.Helper()
+		c, err := courier.NewCourier(kernel.NewUUID(), "Alice", 2, createValidLocation(t, 1, 1))
+		require.NoError(t, err)
+		return c
+	}
+	onShift := func(t *testing.T) *courier.Courier {
+		t.Helper()
+		c := newCourier(t)
+		require.NoError(t, c.StartShift(time.Now(), createWorkingHoursLimit(t)))
+		return c
+	}
+
+	t.Run("should be off shift before the shift", func(t *testing.T) {
+		assert.Equal(t, courier.AvailabilityOffShift, newCourier(t).Availability())
+	})
+
+	t.Run("should be free on shift without orders", func(t *testing.T) {
+		assert.Equal(t, courier.AvailabilityFree, onShift(t).Availability())
+	})
+
+	t.Run("should be busy while carrying orders", func(t *testing.T) {
+		c := onShift(t)
+		require.NoError(t, c.TakeOrder(createValidOrder(t, 5)))
+
+		assert.Equal(t, courier.AvailabilityBusy, c.Availability())
+	})
+
+	t.Run("should be busy on a break or flagged for review", func(t *testing.T) {
+		onBreak := onShift(t)
+		require.NoError(t, onBreak.StartBreak())
+		flagged := onShift(t)
+		flagged.FlagForReview()
+
+		assert.Equal(t, courier.AvailabilityBusy, onBreak.Availability())
+		assert.Equal(t, courier.AvailabilityBusy, flagged.Availability())
+	})
+
+	t.Run("should be off shift once the shift ends or the courier is deactivated", func(t *testing.T) {
+		ended := onShift(t)
+		require.NoError(t, ended.EndShift(time.Now()))
+		deactivated := onShift(t)
+		_, err := deactivated.Deactivate(courier.Offboarded)
+		require.NoError(t, err)
+
+		assert.Equal(t, courier.AvailabilityOffShift, ended.Availability())
+		assert.Equal(t, courier.AvailabilityOffShift, deactivated.Availability())
+	})
+}
@@ -2,16 +2,19 @@ package courier
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
 	"delivery/internal/pkg/errs"
 	"delivery/internal/pkg/guard"
+	"delivery/internal/pkg/i18n"
 )
 
 const (
-	// courierDefaultBagName is the default name for the courier's primary storage bag.
-	courierDefaultBagName = "Сумка"
+	// courierDefaultLanguage is the language of couriers created without an explicit preference.
+	courierDefaultLanguage = i18n.Russian
 	// courierDefaultBagVolume is the default volume capacity for the courier's primary storage bag.
 	courierDefaultBagVolume = 10
 )
@@ -26,6 +29,10 @@ var (
 	ErrCourierIsNotConstructed = errors.New("Courier must be created via NewCourier constructor")
 	// ErrStoragePlaceNotFound is returned when a requested storage place cannot be found.
 	ErrStoragePlaceNotFound = errors.New("storage place not found")
+	// ErrCourierIsNotInsured is returned when an uninsured courier takes an insured order.
+	ErrCourierIsNotInsured = errors.New("courier is not insured")
+	// ErrLastStoragePlaceCannotBeRemoved is returned when removing the only storage place of a courier.
+	ErrLastStoragePlaceCannotBeRemoved = errors.New("courier must keep at least one storage place")
 )
 
 // Courier represents a delivery courier in the system.
@@ -65,6 +72,30 @@ type Courier struct {
 	location kernel.Location
 	// storagePlaces are the available storage containers for carrying orders
 	storagePlaces []*StoragePlace
+	// reviewRequired marks a courier flagged for manual review by operations
+	reviewRequired bool
+	// insured marks a courier who may carry insured high-value orders
+	insured bool
+	// deactivationReason records why the courier was taken out of service, NotDeactivated if active
+	deactivationReason DeactivationReason
+	// language is the courier's preferred language for courier-facing strings
+	language i18n.Language
+	// profile holds the optional photo, phone and vehicle plate of the courier
+	profile Profile
+	// externalID identifies the courier in the HR system, nil if the courier was created without one
+	externalID *ExternalID
+	// workLog records the courier's on-shift time for the current day
+	workLog WorkLog
+	// status is where the courier stands in the working day, Offline when off shift
+	status Status
+	// maintenanceWindows are the vehicle maintenance windows of the courier, earliest first
+	maintenanceWindows []MaintenanceWindow
+	// absences are the planned absences of the courier, earliest first
+	absences []Absence
+	// schedule is the weekly working schedule of the courier, nil if the courier has none
+	schedule *Schedule
+	// version is the stored revision of the courier, which repositories compare to detect concurrent updates
+	version int
 	// guard ensures the courier was properly constructed
 	guard guard.ConstructorGuard
 }
@@ -99,20 +130,41 @@ type Courier struct {
 //	}
 //	fmt.Printf("Created courier: %s at %s", courier.Name(), courier.Location())
 func NewCourier(id kernel.UUID, name string, speed int, location kernel.Location) (*Courier, error) {
+	return NewCourierWithLanguage(id, name, speed, location, courierDefaultLanguage)
+}
+
+// NewCourierWithLanguage creates a new Courier like NewCourier, recording the courier's
+// preferred language and naming the default storage bag in that language.
+//
+// Example:
+//
+//	courier, err := NewCourierWithLanguage(kernel.NewUUID(), "Alice", 2, location, i18n.English)
+//	// courier.StoragePlaces()[0].Name() == "Bag"
+func NewCourierWithLanguage(
+	id kernel.UUID,
+	name string,
+	speed int,
+	location kernel.Location,
+	language i18n.Language,
+) (*Courier, error) {
 	courier := &Courier{
 		guard: guard.NewConstructorGuard(),
 	}
 
-	if err := errors.Join(
-		courier.setID(id),
-		courier.setName(name),
-		courier.setSpeed(speed),
-		courier.setLocation(location),
-		courier.AddStoragePlace(courierDefaultBagName, courierDefaultBagVolume),
+	if err := errs.JoinFields(
+		errs.Field("id", courier.setID(id)),
+		errs.Field("name", courier.setName(name)),
+		errs.Field("speed", courier.setSpeed(speed)),
+		errs.Field("location", courier.setLocation(location)),
+		errs.Field("language", courier.ChangeLanguage(language)),
 	); err != nil {
 		return nil, err
 	}
 
+	if err := courier.AddStoragePlace(i18n.Translate(language, i18n.DefaultBagName), courierDefaultBagVolume); err != nil {
+		return nil, err
+	}
+
 	return courier, nil
 }
 
@@ -168,12 +220,13 @@ func RestoreCourier(
 		guard: guard.NewConstructorGuard(),
 	}
 
-	if err := errors.Join(
-		courier.setID(id),
-		courier.setName(name),
-		courier.setSpeed(speed),
-		courier.setLocation(location),
-		courier.setStoragePlaces(storagePlaces),
+	if err := errs.JoinFields(
+		errs.Field("id", courier.setID(id)),
+		errs.Field("name", courier.setName(name)),
+		errs.Field("speed", courier.setSpeed(speed)),
+		errs.Field("location", courier.setLocation(location)),
+		errs.Field("storagePlaces", courier.setStoragePlaces(storagePlaces)),
+		errs.Field("language", courier.ChangeLanguage(courierDefaultLanguage)),
 	); err != nil {
 		return nil, err
 	}
@@ -334,6 +387,91 @@ func (c *Courier) AddStoragePlace(name string, volume int) error {
 	return nil
 }
 
+// AddThermalStoragePlace adds an insulated storage place, such as a thermal bag, to the courier.
+// Validates the name and volume like AddStoragePlace.
+//
+// Example:
+//
+//	err := courier.AddThermalStoragePlace("Thermal bag", 20)
+func (c *Courier) AddThermalStoragePlace(name string, volume int) error {
+	storagePlace, err := NewStoragePlace(kernel.NewUUID(), name, volume)
+	if err != nil {
+		return err
+	}
+
+	storagePlace.MarkThermal()
+	c.storagePlaces = append(c.storagePlaces, storagePlace)
+	return nil
+}
+
+// RemoveStoragePlace removes one of the courier's storage places, e.g. a bag the courier no longer uses.
+//
+// Parameters:
+//   - storagePlaceID: Identifier of the storage place to remove
+//
+// Returns:
+//   - error: ErrStoragePlaceNotFound if the courier has no such storage place,
+//     ErrStoragePlaceIsOccupied if the storage place holds an order,
+//     or ErrLastStoragePlaceCannotBeRemoved if it is the courier's only storage place
+//
+// Business rules:
+//   - An order in transit must be delivered or released before its storage place is removed
+//   - A courier always keeps at least one storage place
+//
+// Example:
+//
+//	if err := courier.RemoveStoragePlace(sideBagID); err != nil {
+//	    return err
+//	}
+func (c *Courier) RemoveStoragePlace(storagePlaceID kernel.UUID) error {
+	storagePlace, err := c.findStoragePlaceByID(storagePlaceID)
+	if err != nil {
+		return err
+	}
+
+	if storagePlace.isOccupied() {
+		return ErrStoragePlaceIsOccupied
+	}
+	if len(c.storagePlaces) == 1 {
+		return ErrLastStoragePlaceCannotBeRemoved
+	}
+
+	remaining := make([]*StoragePlace, 0, len(c.storagePlaces)-1)
+	for _, place := range c.storagePlaces {
+		if !place.IsEqual(storagePlace) {
+			remaining = append(remaining, place)
+		}
+	}
+	c.storagePlaces = remaining
+	return nil
+}
+
+// ResizeStoragePlace changes the volume capacity of one of the courier's storage places,
+// e.g. after the courier replaces a bag with a bigger one.
+//
+// Parameters:
+//   - storagePlaceID: Identifier of the storage place to resize
+//   - volume: New maximum volume capacity (must be positive)
+//
+// Returns:
+//   - error: ErrStoragePlaceNotFound if the courier has no such storage place,
+//     ErrStoragePlaceIsOccupied if the storage place holds an order,
+//     or a validation error if the volume is invalid
+//
+// Example:
+//
+//	if err := courier.ResizeStoragePlace(backpackID, 25); err != nil {
+//	    return err
+//	}
+func (c *Courier) ResizeStoragePlace(storagePlaceID kernel.UUID, volume int) error {
+	storagePlace, err := c.findStoragePlaceByID(storagePlaceID)
+	if err != nil {
+		return err
+	}
+
+	return storagePlace.Resize(volume)
+}
+
 // CanTakeOrder checks if the courier can accept a specific order.
 // This method validates order capacity against available storage without actually taking the order.
 // It's used for order assignment decisions and capacity planning.
@@ -347,6 +485,7 @@ func (c *Courier) AddStoragePlace(name string, volume int) error {
 //
 // Business rules:
 //   - Order must be valid (proper construction and validation)
+//   - Insured orders can only be taken by insured couriers
 //   - At least one storage place must have sufficient free capacity
 //   - Order volume must not exceed any individual storage place capacity
 //
@@ -365,6 +504,10 @@ func (c *Courier) CanTakeOrder(order *order.Order) (bool, error) {
 		return false, err
 	}
 
+	if order.IsInsuranceRequired() && !c.insured {
+		return false, nil
+	}
+
 	storagePlace, err := c.findStorageForVolume(order.Volume())
 	if err != nil {
 		return false, err
@@ -381,10 +524,12 @@ func (c *Courier) CanTakeOrder(order *order.Order) (bool, error) {
 //   - order: The order to take (must be valid and fit in available storage)
 //
 // Returns:
-//   - error: Validation error if order is invalid, or ErrStoragePlaceNotFound if no capacity
+//   - error: Validation error if order is invalid, ErrCourierIsNotInsured for an insured order
+//     and an uninsured courier, or ErrStoragePlaceNotFound if no capacity
 //
 // Business rules:
 //   - Order must be valid and have volume > 0
+//   - Insured orders can only be taken by insured couriers
 //   - Must have available storage place with sufficient capacity
 //   - Order is stored in the first available storage place that can accommodate it
 //   - Once taken, the storage place becomes occupied until order completion
@@ -408,6 +553,10 @@ func (c *Courier) TakeOrder(order *order.Order) error {
 		return err
 	}
 
+	if order.IsInsuranceRequired() && !c.insured {
+		return ErrCourierIsNotInsured
+	}
+
 	storagePlace, err := c.findStorageForVolume(order.Volume())
 	if err != nil {
 		return err
@@ -417,7 +566,55 @@ func (c *Courier) TakeOrder(order *order.Order) error {
 		return ErrStoragePlaceNotFound
 	}
 
-	return storagePlace.Store(order.ID(), order.Volume())
+	if err = storagePlace.Store(order.ID(), order.Volume()); err != nil {
+		return err
+	}
+
+	if c.status == Available {
+		c.status = Busy
+	}
+	return nil
+}
+
+// TakeOrderInto stores an order in the given storage place of the courier.
+// Unlike TakeOrder the storage place is not chosen by the courier: it is used when another
+// courier hands the order over on the way and the order goes straight into a chosen bag.
+//
+// Parameters:
+//   - order: The order to take (must be valid)
+//   - storagePlaceID: Identifier of the courier's storage place to put the order in
+//
+// Returns:
+//   - error: Validation error if the order or storagePlaceID is invalid, ErrCourierIsNotInsured
+//     for an insured order and an uninsured courier, ErrStoragePlaceNotFound if the courier has
+//     no such storage place, or ErrCannotStoreOrderInThisStoragePlace if the storage place is out
+//     of service, occupied or too small for the order
+//
+// State changes:
+//   - The storage place becomes occupied with the order
+//   - An Available courier becomes Busy
+func (c *Courier) TakeOrderInto(order *order.Order, storagePlaceID kernel.UUID) error {
+	if err := order.Validate(); err != nil {
+		return err
+	}
+
+	if order.IsInsuranceRequired() && !c.insured {
+		return ErrCourierIsNotInsured
+	}
+
+	storagePlace, err := c.findStoragePlaceByID(storagePlaceID)
+	if err != nil {
+		return err
+	}
+
+	if err = storagePlace.Store(order.ID(), order.Volume()); err != nil {
+		return err
+	}
+
+	if c.status == Available {
+		c.status = Busy
+	}
+	return nil
 }
 
 // CompleteOrder marks an order as delivered and frees up the associated storage.
@@ -438,6 +635,7 @@ func (c *Courier) TakeOrder(order *order.Order) error {
 // State changes:
 //   - Storage place holding the order becomes empty and available
 //   - Courier's available capacity increases
+//   - A Busy courier becomes Available once the last order leaves the storage
 //
 // Example:
 //
@@ -461,7 +659,399 @@ func (c *Courier) CompleteOrder(orderID kernel.UUID) error {
 		return ErrStoragePlaceNotFound
 	}
 
-	return storagePlace.Clear(orderID)
+	if err = storagePlace.Clear(orderID); err != nil {
+		return err
+	}
+
+	if c.status == Busy && !c.carriesOrders() {
+		c.status = Available
+	}
+	return nil
+}
+
+// StartStoragePlaceMaintenance takes one of the courier's storage places out of service.
+// The remaining storage places stay available for new orders.
+//
+// Parameters:
+//   - storagePlaceID: Identifier of the storage place to take out of service
+//
+// Returns:
+//   - error: ErrStoragePlaceNotFound if the courier has no such storage place,
+//     or ErrStoragePlaceIsOccupied if the storage place holds an order
+//
+// Example:
+//
+//	// The backpack zipper is broken
+//	if err := courier.StartStoragePlaceMaintenance(backpackID); err != nil {
+//	    return err
+//	}
+func (c *Courier) StartStoragePlaceMaintenance(storagePlaceID kernel.UUID) error {
+	storagePlace, err := c.findStoragePlaceByID(storagePlaceID)
+	if err != nil {
+		return err
+	}
+
+	return storagePlace.StartMaintenance()
+}
+
+// FinishStoragePlaceMaintenance returns one of the courier's storage places to service.
+//
+// Parameters:
+//   - storagePlaceID: Identifier of the storage place to return to service
+//
+// Returns:
+//   - error: ErrStoragePlaceNotFound if the courier has no such storage place
+func (c *Courier) FinishStoragePlaceMaintenance(storagePlaceID kernel.UUID) error {
+	storagePlace, err := c.findStoragePlaceByID(storagePlaceID)
+	if err != nil {
+		return err
+	}
+
+	storagePlace.FinishMaintenance()
+	return nil
+}
+
+// ReleaseOrder removes an undelivered order from the courier's storage.
+// Unlike CompleteOrder, this is used when the order is taken away from the courier
+// (for example, by the inactivity watchdog) so that it can be dispatched again.
+//
+// Parameters:
+//   - orderID: Unique identifier of the order to release (must be valid UUID)
+//
+// Returns:
+//   - error: Validation error if orderID is invalid, or ErrStoragePlaceNotFound if order not found
+//
+// State changes:
+//   - Storage place holding the order becomes empty and available
+func (c *Courier) ReleaseOrder(orderID kernel.UUID) error {
+	return c.CompleteOrder(orderID)
+}
+
+// FlagForReview marks the courier for manual review by operations.
+// Flagged couriers are not offered new orders until the flag is cleared.
+//
+// Example:
+//
+//	// Courier has not moved for too long
+//	courier.FlagForReview()
+//	courier.IsReviewRequired() // true
+func (c *Courier) FlagForReview() {
+	c.reviewRequired = true
+}
+
+// ClearReviewFlag removes the manual review flag from the courier.
+func (c *Courier) ClearReviewFlag() {
+	c.reviewRequired = false
+}
+
+// IsReviewRequired reports whether the courier is flagged for manual review.
+func (c *Courier) IsReviewRequired() bool {
+	return c.reviewRequired
+}
+
+// Insure lets the courier carry insured high-value orders.
+// Calling Insure on an insured courier has no effect.
+func (c *Courier) Insure() {
+	c.insured = true
+}
+
+// RevokeInsurance stops offering insured orders to the courier. Insured orders the courier
+// already carries stay with them.
+func (c *Courier) RevokeInsurance() {
+	c.insured = false
+}
+
+// IsInsured reports whether the courier may carry insured high-value orders.
+func (c *Courier) IsInsured() bool {
+	return c.insured
+}
+
+// Deactivate takes the courier out of service for the given reason and empties all
+// storage places. The caller is responsible for returning the released orders to
+// dispatch; Deactivate only frees the courier's storage.
+//
+// Parameters:
+//   - reason: Why the courier leaves service (must be a valid reason)
+//
+// Returns:
+//   - []kernel.UUID: Identifiers of the orders that were still held in storage
+//   - error: Validation error if reason is invalid, or ErrCourierIsDeactivated if the
+//     courier is already out of service
+//
+// Example:
+//
+//	released, err := courier.Deactivate(WentOffline)
+//	if err != nil {
+//	    return err
+//	}
+//	// requeue or reassign released orders
+func (c *Courier) Deactivate(reason DeactivationReason) ([]kernel.UUID, error) {
+	if err := reason.Validate(); err != nil {
+		return nil, err
+	}
+
+	if c.IsDeactivated() {
+		return nil, fmt.Errorf("%w: %s", ErrCourierIsDeactivated, c.deactivationReason)
+	}
+
+	released := make([]kernel.UUID, 0)
+	for _, storagePlace := range c.storagePlaces {
+		orderID := storagePlace.OrderID()
+		if orderID == nil {
+			continue
+		}
+
+		if err := storagePlace.Clear(*orderID); err != nil {
+			return nil, err
+		}
+		released = append(released, *orderID)
+	}
+
+	c.deactivationReason = reason
+	c.status = Offline
+	return released, nil
+}
+
+// Reactivate returns a deactivated courier to service.
+// Calling Reactivate on an active courier has no effect.
+func (c *Courier) Reactivate() {
+	c.deactivationReason = NotDeactivated
+}
+
+// RestoreDeactivation reapplies a persisted deactivation without touching storage places.
+// Intended for repositories rebuilding the aggregate; NotDeactivated leaves the courier active.
+func (c *Courier) RestoreDeactivation(reason DeactivationReason) error {
+	if reason != NotDeactivated {
+		if err := reason.Validate(); err != nil {
+			return err
+		}
+	}
+
+	c.deactivationReason = reason
+	return nil
+}
+
+// IsDeactivated reports whether the courier is out of service.
+// Deactivated couriers are not offered new orders.
+func (c *Courier) IsDeactivated() bool {
+	return c.deactivationReason != NotDeactivated
+}
+
+// DeactivationReason returns why the courier is out of service, NotDeactivated if active.
+func (c *Courier) DeactivationReason() DeactivationReason {
+	return c.deactivationReason
+}
+
+// ChangeLanguage sets the courier's preferred language for courier-facing strings.
+// Names of existing storage places are left as they are.
+// Returns a ValueIsInvalidError for unsupported languages.
+func (c *Courier) ChangeLanguage(language i18n.Language) error {
+	if err := language.Validate(); err != nil {
+		return err
+	}
+
+	c.language = language
+	return nil
+}
+
+// Language returns the courier's preferred language.
+func (c *Courier) Language() i18n.Language {
+	return c.language
+}
+
+// ChangeProfile replaces the courier's photo, phone and vehicle plate.
+// Fields absent from the new profile are cleared.
+func (c *Courier) ChangeProfile(profile Profile) {
+	c.profile = profile
+}
+
+// Profile returns the courier's contact and presentation details.
+func (c *Courier) Profile() Profile {
+	return c.profile
+}
+
+// LinkExternalID records the ID of the courier in an external system.
+// Linking the same ID again is a no-op; an external ID is never replaced.
+// Returns ErrExternalIDIsAlreadyLinked if the courier is linked to another ID.
+func (c *Courier) LinkExternalID(externalID ExternalID) error {
+	if err := externalID.Validate(); err != nil {
+		return err
+	}
+	if c.externalID != nil && c.externalID.String() != externalID.String() {
+		return ErrExternalIDIsAlreadyLinked
+	}
+
+	c.externalID = &externalID
+	return nil
+}
+
+// ExternalID returns the ID of the courier in an external system, nil if it is not linked.
+func (c *Courier) ExternalID() *ExternalID {
+	return c.externalID
+}
+
+// StartShift starts a working shift at now.
+//
+// This method enforces the following business rules:
+//   - Deactivated couriers cannot start a shift
+//   - Only one shift runs at a time
+//   - A courier who already worked the daily limit cannot start another shift that day
+//
+// Returns:
+//   - nil on success
+//   - ErrCourierIsDeactivated, ErrShiftIsAlreadyStarted or ErrDailyWorkingHoursExceeded
+//     if a rule is violated, or ErrWorkingHoursLimitIsNotConstructed for an invalid limit
+//
+// Example:
+//
+//	limit, _ := courier.NewWorkingHoursLimit(8*time.Hour, 30*time.Minute)
+//	if err := c.StartShift(time.Now(), limit); errors.Is(err, courier.ErrDailyWorkingHoursExceeded) {
+//	    // Courier has to rest until tomorrow
+//	}
+func (c *Courier) StartShift(now time.Time, limit WorkingHoursLimit) error {
+	if err := limit.Validate(); err != nil {
+		return err
+	}
+
+	if c.IsDeactivated() {
+		return fmt.Errorf("%w: %s", ErrCourierIsDeactivated, c.deactivationReason)
+	}
+	if c.workLog.IsOnShift() {
+		return ErrShiftIsAlreadyStarted
+	}
+	if limit.Assess(c.workLog.WorkedOn(now)) == LimitReached {
+		return ErrDailyWorkingHoursExceeded
+	}
+
+	startedAt := now.UTC()
+	c.workLog.shiftStartedAt = &startedAt
+	c.status = Available
+	if c.carriesOrders() {
+		c.status = Busy
+	}
+	return nil
+}
+
+// EndShift ends the running shift at now and adds its time to the day's work log.
+// Only the part of a shift after midnight counts towards the new day. The courier goes Offline.
+// Returns ErrShiftIsNotStarted if the courier is off shift.
+func (c *Courier) EndShift(now time.Time) error {
+	if !c.workLog.IsOnShift() {
+		return ErrShiftIsNotStarted
+	}
+
+	c.workLog = WorkLog{
+		day:       startOfDay(now),
+		completed: c.workLog.WorkedOn(now),
+	}
+	c.status = Offline
+	return nil
+}
+
+// WorkLog returns the courier's on-shift time record for the current day.
+func (c *Courier) WorkLog() WorkLog {
+	return c.workLog
+}
+
+// RestoreWorkLog reapplies a persisted work log. Intended for repositories rebuilding the aggregate.
+func (c *Courier) RestoreWorkLog(log WorkLog) {
+	c.workLog = log
+}
+
+// GoOnline makes the courier Available for new orders again after a break or going offline
+// during the shift; a courier who still carries orders comes back Busy. Calling GoOnline on an
+// Available courier has no effect.
+//
+// Returns:
+//   - ErrCourierIsDeactivated if the courier is out of service
+//   - ErrShiftIsNotStarted if the courier is off shift
+//   - ErrStatusTransitionIsInvalid if the courier is Busy
+func (c *Courier) GoOnline() error {
+	switch c.status {
+	case Available:
+		return nil
+	case Offline, OnBreak:
+		if c.IsDeactivated() {
+			return fmt.Errorf("%w: %s", ErrCourierIsDeactivated, c.deactivationReason)
+		}
+		if !c.workLog.IsOnShift() {
+			return ErrShiftIsNotStarted
+		}
+		c.status = Available
+		if c.carriesOrders() {
+			c.status = Busy
+		}
+		return nil
+	default:
+		return transitionError(c.status, Available)
+	}
+}
+
+// StartBreak takes a courier on shift off dispatch for a break. A Busy courier keeps the orders
+// it carries and stops on the way until GoOnline. Calling StartBreak on a courier already on a
+// break has no effect.
+// Returns ErrStatusTransitionIsInvalid if the courier is Offline.
+func (c *Courier) StartBreak() error {
+	switch c.status {
+	case OnBreak:
+		return nil
+	case Available, Busy:
+		c.status = OnBreak
+		return nil
+	default:
+		return transitionError(c.status, OnBreak)
+	}
+}
+
+// IsOnBreak reports whether the courier takes a break during the shift.
+// Couriers on a break receive no orders, do not move and are exempt from inactivity checks.
+func (c *Courier) IsOnBreak() bool {
+	return c.status == OnBreak
+}
+
+// SetOffline takes the courier off dispatch without ending the shift. Calling SetOffline
+// on an Offline courier has no effect.
+// Returns ErrStatusTransitionIsInvalid if the courier carries orders: they have to be delivered first.
+func (c *Courier) SetOffline() error {
+	switch {
+	case c.status == Offline:
+		return nil
+	case c.status == Available, c.status == OnBreak && !c.carriesOrders():
+		c.status = Offline
+		return nil
+	default:
+		return transitionError(c.status, Offline)
+	}
+}
+
+// Status returns where the courier stands in the working day.
+func (c *Courier) Status() Status {
+	return c.status
+}
+
+// IsAvailable reports whether the courier is on shift and waits for orders.
+func (c *Courier) IsAvailable() bool {
+	return c.status == Available
+}
+
+// RestoreStatus reapplies a persisted status. Intended for repositories rebuilding the aggregate.
+func (c *Courier) RestoreStatus(status Status) error {
+	if err := status.Validate(); err != nil {
+		return err
+	}
+
+	c.status = status
+	return nil
+}
+
+// Version returns the stored revision of the courier, zero for a courier that was never stored.
+func (c *Courier) Version() int {
+	return c.version
+}
+
+// RestoreVersion reapplies a stored revision. Intended for repositories rebuilding or storing the aggregate.
+func (c *Courier) RestoreVersion(version int) {
+	c.version = version
 }
 
 // CalculateTimeToLocation estimates the time required to reach a target location.
@@ -501,6 +1091,32 @@ func (c *Courier) CalculateTimeToLocation(target kernel.Location) (float64, erro
 	return float64(distance) / float64(c.speed), nil
 }
 
+// CalculateTimeAlongStops calculates how many turns the courier needs to call at the stops
+// in turn, starting from its current location; an order collected at a pickup is reached
+// through the pickup. Returns zero for no stops, or a validation error for an invalid stop.
+//
+// Example:
+//
+//	time, err := courier.CalculateTimeAlongStops(o.RemainingStops())
+func (c *Courier) CalculateTimeAlongStops(stops []kernel.Location) (float64, error) {
+	var distance int
+	from := c.location
+	for _, stop := range stops {
+		if err := stop.Validate(); err != nil {
+			return 0, err
+		}
+
+		leg, err := from.Distance(stop)
+		if err != nil {
+			return 0, err
+		}
+		distance += leg
+		from = stop
+	}
+
+	return float64(distance) / float64(c.speed), nil
+}
+
 // Move attempts to move the courier toward a target location.
 // This method implements speed-constrained movement with optimized pathfinding.
 // The courier moves up to 'speed' steps per call, prioritizing X-axis movement.
@@ -573,6 +1189,25 @@ func (c *Courier) Move(target kernel.Location) error {
 	return c.setLocation(newLocation)
 }
 
+// ReportLocation places the courier at the location the courier's app reported. Unlike Move,
+// the reported position is where the courier actually is, so it is not limited by speed.
+//
+// Parameters:
+//   - location: The reported location (must be valid)
+//
+// Returns:
+//   - error: Validation error if location is invalid
+//
+// Example:
+//
+//	location, _ := kernel.NewLocation(7, 3)
+//	if err := courier.ReportLocation(location); err != nil {
+//	    return err
+//	}
+func (c *Courier) ReportLocation(location kernel.Location) error {
+	return c.setLocation(location)
+}
+
 // findStorageForVolume locates the first available storage place that can accommodate the specified volume.
 // This is an internal helper method used by order management operations.
 // It searches through all storage places and returns the first one with sufficient free capacity.
@@ -628,6 +1263,32 @@ func (c *Courier) findStoragePlaceByOrderID(orderID kernel.UUID) (*StoragePlace,
 	return nil, ErrStoragePlaceNotFound
 }
 
+// carriesOrders reports whether any storage place of the courier holds an order.
+func (c *Courier) carriesOrders() bool {
+	for _, storagePlace := range c.storagePlaces {
+		if storagePlace.isOccupied() {
+			return true
+		}
+	}
+	return false
+}
+
+// findStoragePlaceByID locates a storage place of the courier by its identifier.
+// Returns ErrStoragePlaceNotFound if the courier has no storage place with this ID.
+func (c *Courier) findStoragePlaceByID(storagePlaceID kernel.UUID) (*StoragePlace, error) {
+	if err := storagePlaceID.Validate(); err != nil {
+		return nil, err
+	}
+
+	for _, storagePlace := range c.storagePlaces {
+		if storagePlace.ID().IsEqual(storagePlaceID) {
+			return storagePlace, nil
+		}
+	}
+
+	return nil, ErrStoragePlaceNotFound
+}
+
 // setID sets the courier's unique identifier with validation.
 // This is an internal setter used during courier construction.
 func (c *Courier) setID(id kernel.UUID) error {
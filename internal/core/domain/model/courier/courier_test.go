@@ -3,10 +3,13 @@ package courier_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"delivery/internal/core/domain/model/courier"
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/i18n"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -130,6 +133,14 @@ func TestNewCourier(t *testing.T) {
 		assert.Contains(t, errorStr, "name")
 		assert.Contains(t, errorStr, "speed")
 		assert.Contains(t, errorStr, "location must be created")
+
+		var validation *errs.ValidationErrors
+		require.ErrorAs(t, err, &validation)
+		fields := make([]string, len(validation.Fields))
+		for i, field := range validation.Fields {
+			fields[i] = field.Field
+		}
+		assert.Equal(t, []string{"id", "name", "speed", "location"}, fields)
 	})
 
 	t.Run("should handle boundary values", func(t *testing.T) {
@@ -865,6 +876,68 @@ func TestCourier_TakeOrder(t *testing.T) {
 	})
 }
 
+func TestCourier_TakeOrderInto(t *testing.T) {
+	t.Run("should store order in the given storage place", func(t *testing.T) {
+		c := createValidCourier(t)
+		require.NoError(t, c.AddStoragePlace("Backpack", 15))
+		backpack := c.StoragePlaces()[1]
+		o := createValidOrder(t, 8)
+
+		err := c.TakeOrderInto(o, backpack.ID())
+
+		require.NoError(t, err)
+		storagePlaces := c.StoragePlaces()
+		assert.Nil(t, storagePlaces[0].OrderID())
+		require.NotNil(t, storagePlaces[1].OrderID())
+		assert.True(t, storagePlaces[1].OrderID().IsEqual(o.ID()))
+	})
+
+	t.Run("should make an available courier busy", func(t *testing.T) {
+		c := createValidCourier(t)
+		require.NoError(t, c.StartShift(time.Now(), createWorkingHoursLimit(t)))
+		require.Equal(t, courier.Available, c.Status())
+
+		require.NoError(t, c.TakeOrderInto(createValidOrder(t, 5), c.StoragePlaces()[0].ID()))
+
+		assert.Equal(t, courier.Busy, c.Status())
+	})
+
+	t.Run("should return error for unknown storage place", func(t *testing.T) {
+		c := createValidCourier(t)
+
+		err := c.TakeOrderInto(createValidOrder(t, 5), kernel.NewUUID())
+
+		require.ErrorIs(t, err, courier.ErrStoragePlaceNotFound)
+	})
+
+	t.Run("should return error when storage place cannot hold the order", func(t *testing.T) {
+		c := createValidCourier(t)
+		require.NoError(t, c.AddStoragePlace("Side Bag", 5))
+		bag, sideBag := c.StoragePlaces()[0], c.StoragePlaces()[1]
+		require.NoError(t, c.TakeOrderInto(createValidOrder(t, 5), bag.ID()))
+
+		occupiedErr := c.TakeOrderInto(createValidOrder(t, 3), bag.ID())
+		tooLargeErr := c.TakeOrderInto(createValidOrder(t, 8), sideBag.ID())
+
+		require.ErrorIs(t, occupiedErr, courier.ErrCannotStoreOrderInThisStoragePlace)
+		require.ErrorIs(t, tooLargeErr, courier.ErrCannotStoreOrderInThisStoragePlace)
+		assert.Nil(t, sideBag.OrderID())
+	})
+
+	t.Run("should refuse insured order for uninsured courier", func(t *testing.T) {
+		threshold, err := order.NewInsuranceThreshold(100_000)
+		require.NoError(t, err)
+		c := createValidCourier(t)
+		o := createValidOrder(t, 5)
+		require.NoError(t, o.DeclareValue(250_000, threshold))
+
+		err = c.TakeOrderInto(o, c.StoragePlaces()[0].ID())
+
+		require.ErrorIs(t, err, courier.ErrCourierIsNotInsured)
+		assert.Nil(t, c.StoragePlaces()[0].OrderID())
+	})
+}
+
 func TestCourier_CompleteOrder(t *testing.T) {
 	t.Run("should complete order successfully", func(t *testing.T) {
 		c := createValidCourier(t)
@@ -992,6 +1065,324 @@ func TestCourier_CompleteOrder(t *testing.T) {
 	})
 }
 
+func TestCourier_ReleaseOrder(t *testing.T) {
+	t.Run("should release stored order", func(t *testing.T) {
+		c := createValidCourier(t)
+		order := createValidOrder(t, 8)
+		require.NoError(t, c.TakeOrder(order))
+
+		err := c.ReleaseOrder(order.ID())
+
+		require.NoError(t, err)
+		assert.Nil(t, c.StoragePlaces()[0].OrderID())
+	})
+
+	t.Run("should return error when order not found", func(t *testing.T) {
+		c := createValidCourier(t)
+
+		err := c.ReleaseOrder(kernel.NewUUID())
+
+		require.ErrorIs(t, err, courier.ErrStoragePlaceNotFound)
+	})
+}
+
+func TestCourier_StoragePlaceMaintenance(t *testing.T) {
+	t.Run("should skip out-of-service place and use the remaining ones", func(t *testing.T) {
+		c := createValidCourier(t)
+		require.NoError(t, c.AddStoragePlace("Backpack", 10))
+		bag := c.StoragePlaces()[0]
+		backpack := c.StoragePlaces()[1]
+
+		require.NoError(t, c.StartStoragePlaceMaintenance(bag.ID()))
+
+		order := createValidOrder(t, 8)
+		require.NoError(t, c.TakeOrder(order))
+		assert.Nil(t, bag.OrderID())
+		assert.True(t, backpack.OrderID().IsEqual(order.ID()))
+	})
+
+	t.Run("should not take orders when all places are out of service", func(t *testing.T) {
+		c := createValidCourier(t)
+		require.NoError(t, c.StartStoragePlaceMaintenance(c.StoragePlaces()[0].ID()))
+
+		canTake, err := c.CanTakeOrder(createValidOrder(t, 1))
+
+		require.NoError(t, err)
+		assert.False(t, canTake)
+
+		require.NoError(t, c.FinishStoragePlaceMaintenance(c.StoragePlaces()[0].ID()))
+		canTake, err = c.CanTakeOrder(createValidOrder(t, 1))
+		require.NoError(t, err)
+		assert.True(t, canTake)
+	})
+
+	t.Run("should return error for unknown storage place", func(t *testing.T) {
+		c := createValidCourier(t)
+
+		require.ErrorIs(t, c.StartStoragePlaceMaintenance(kernel.NewUUID()), courier.ErrStoragePlaceNotFound)
+		require.ErrorIs(t, c.FinishStoragePlaceMaintenance(kernel.NewUUID()), courier.ErrStoragePlaceNotFound)
+	})
+
+	t.Run("should not start maintenance on occupied place", func(t *testing.T) {
+		c := createValidCourier(t)
+		require.NoError(t, c.TakeOrder(createValidOrder(t, 5)))
+
+		err := c.StartStoragePlaceMaintenance(c.StoragePlaces()[0].ID())
+
+		require.ErrorIs(t, err, courier.ErrStoragePlaceIsOccupied)
+	})
+}
+
+func TestCourier_RemoveStoragePlace(t *testing.T) {
+	t.Run("should remove an empty place", func(t *testing.T) {
+		c := createValidCourier(t)
+		require.NoError(t, c.AddStoragePlace("Backpack", 20))
+		bag := c.StoragePlaces()[0]
+		backpack := c.StoragePlaces()[1]
+
+		require.NoError(t, c.RemoveStoragePlace(bag.ID()))
+
+		require.Len(t, c.StoragePlaces(), 1)
+		assert.True(t, c.StoragePlaces()[0].IsEqual(backpack))
+	})
+
+	t.Run("should keep the last place", func(t *testing.T) {
+		c := createValidCourier(t)
+
+		err := c.RemoveStoragePlace(c.StoragePlaces()[0].ID())
+
+		require.ErrorIs(t, err, courier.ErrLastStoragePlaceCannotBeRemoved)
+		assert.Len(t, c.StoragePlaces(), 1)
+	})
+
+	t.Run("should not remove an occupied place", func(t *testing.T) {
+		c := createValidCourier(t)
+		require.NoError(t, c.AddStoragePlace("Backpack", 20))
+		require.NoError(t, c.TakeOrder(createValidOrder(t, 5)))
+
+		err := c.RemoveStoragePlace(c.StoragePlaces()[0].ID())
+
+		require.ErrorIs(t, err, courier.ErrStoragePlaceIsOccupied)
+		assert.Len(t, c.StoragePlaces(), 2)
+	})
+
+	t.Run("should return error for unknown storage place", func(t *testing.T) {
+		c := createValidCourier(t)
+		require.NoError(t, c.AddStoragePlace("Backpack", 20))
+
+		require.ErrorIs(t, c.RemoveStoragePlace(kernel.NewUUID()), courier.ErrStoragePlaceNotFound)
+	})
+}
+
+func TestCourier_ResizeStoragePlace(t *testing.T) {
+	t.Run("should take bigger orders after resizing", func(t *testing.T) {
+		c := createValidCourier(t)
+		bag := c.StoragePlaces()[0]
+		order := createValidOrder(t, 15)
+
+		require.NoError(t, c.ResizeStoragePlace(bag.ID(), 20))
+
+		assert.Equal(t, 20, bag.TotalVolume())
+		canTake, err := c.CanTakeOrder(order)
+		require.NoError(t, err)
+		assert.True(t, canTake)
+	})
+
+	t.Run("should not resize an occupied place", func(t *testing.T) {
+		c := createValidCourier(t)
+		require.NoError(t, c.TakeOrder(createValidOrder(t, 5)))
+
+		err := c.ResizeStoragePlace(c.StoragePlaces()[0].ID(), 20)
+
+		require.ErrorIs(t, err, courier.ErrStoragePlaceIsOccupied)
+	})
+
+	t.Run("should reject invalid volume", func(t *testing.T) {
+		c := createValidCourier(t)
+
+		err := c.ResizeStoragePlace(c.StoragePlaces()[0].ID(), 0)
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should return error for unknown storage place", func(t *testing.T) {
+		c := createValidCourier(t)
+
+		require.ErrorIs(t, c.ResizeStoragePlace(kernel.NewUUID(), 20), courier.ErrStoragePlaceNotFound)
+	})
+}
+
+func TestCourier_Review(t *testing.T) {
+	t.Run("new courier is not on a break and not flagged", func(t *testing.T) {
+		c := createValidCourier(t)
+
+		assert.False(t, c.IsOnBreak())
+		assert.False(t, c.IsReviewRequired())
+	})
+
+	t.Run("should flag and clear review", func(t *testing.T) {
+		c := createValidCourier(t)
+
+		c.FlagForReview()
+		assert.True(t, c.IsReviewRequired())
+
+		c.ClearReviewFlag()
+		assert.False(t, c.IsReviewRequired())
+	})
+}
+
+func TestCourier_Insurance(t *testing.T) {
+	threshold, err := order.NewInsuranceThreshold(100_000)
+	require.NoError(t, err)
+
+	newInsuredOrder := func(t *testing.T) *order.Order {
+		t.Helper()
+		location := createValidLocation(t, 5, 5)
+		o, orderErr := order.NewOrder(kernel.NewUUID(), location, 5)
+		require.NoError(t, orderErr)
+		require.NoError(t, o.DeclareValue(250_000, threshold))
+		return o
+	}
+
+	t.Run("uninsured courier cannot take insured order", func(t *testing.T) {
+		c := createValidCourier(t)
+		o := newInsuredOrder(t)
+
+		assert.False(t, c.IsInsured())
+		canTake, err := c.CanTakeOrder(o)
+		require.NoError(t, err)
+		assert.False(t, canTake)
+		require.ErrorIs(t, c.TakeOrder(o), courier.ErrCourierIsNotInsured)
+	})
+
+	t.Run("insured courier takes insured order", func(t *testing.T) {
+		c := createValidCourier(t)
+		o := newInsuredOrder(t)
+
+		c.Insure()
+		canTake, err := c.CanTakeOrder(o)
+		require.NoError(t, err)
+		assert.True(t, canTake)
+		require.NoError(t, c.TakeOrder(o))
+
+		c.RevokeInsurance()
+		assert.False(t, c.IsInsured())
+	})
+}
+
+func TestNewCourierWithLanguage(t *testing.T) {
+	location := createValidLocation(t, 1, 1)
+
+	t.Run("new courier defaults to russian", func(t *testing.T) {
+		c := createValidCourier(t)
+
+		assert.Equal(t, i18n.Russian, c.Language())
+	})
+
+	t.Run("should name default bag in courier language", func(t *testing.T) {
+		c, err := courier.NewCourierWithLanguage(kernel.NewUUID(), "Alice", 2, location, i18n.English)
+
+		require.NoError(t, err)
+		assert.Equal(t, i18n.English, c.Language())
+		assert.Equal(t, "Bag", c.StoragePlaces()[0].Name())
+	})
+
+	t.Run("should reject unsupported language", func(t *testing.T) {
+		c, err := courier.NewCourierWithLanguage(kernel.NewUUID(), "Alice", 2, location, i18n.Language("de"))
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+		assert.Nil(t, c)
+	})
+
+	t.Run("change language keeps storage place names", func(t *testing.T) {
+		c := createValidCourier(t)
+
+		require.NoError(t, c.ChangeLanguage(i18n.English))
+
+		assert.Equal(t, i18n.English, c.Language())
+		assert.Equal(t, "Сумка", c.StoragePlaces()[0].Name())
+		require.Error(t, c.ChangeLanguage(""))
+		assert.Equal(t, i18n.English, c.Language())
+	})
+}
+
+func TestCourier_Deactivate(t *testing.T) {
+	t.Run("new courier is active", func(t *testing.T) {
+		c := createValidCourier(t)
+
+		assert.False(t, c.IsDeactivated())
+		assert.Equal(t, courier.NotDeactivated, c.DeactivationReason())
+	})
+
+	t.Run("should empty storage places and record reason", func(t *testing.T) {
+		c := createValidCourier(t)
+		require.NoError(t, c.AddStoragePlace("Багажник", 20))
+		first := createValidOrder(t, 8)
+		second := createValidOrder(t, 15)
+		require.NoError(t, c.TakeOrder(first))
+		require.NoError(t, c.TakeOrder(second))
+
+		released, err := c.Deactivate(courier.Offboarded)
+
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []kernel.UUID{first.ID(), second.ID()}, released)
+		assert.True(t, c.IsDeactivated())
+		assert.Equal(t, courier.Offboarded, c.DeactivationReason())
+		for _, storagePlace := range c.StoragePlaces() {
+			assert.Nil(t, storagePlace.OrderID())
+		}
+	})
+
+	t.Run("should return error for invalid reason", func(t *testing.T) {
+		c := createValidCourier(t)
+
+		_, err := c.Deactivate(courier.NotDeactivated)
+
+		require.Error(t, err)
+		assert.False(t, c.IsDeactivated())
+	})
+
+	t.Run("should return error when already deactivated", func(t *testing.T) {
+		c := createValidCourier(t)
+		_, err := c.Deactivate(courier.WentOffline)
+		require.NoError(t, err)
+
+		_, err = c.Deactivate(courier.Offboarded)
+
+		require.ErrorIs(t, err, courier.ErrCourierIsDeactivated)
+		assert.Equal(t, courier.WentOffline, c.DeactivationReason())
+	})
+
+	t.Run("should reactivate courier", func(t *testing.T) {
+		c := createValidCourier(t)
+		_, err := c.Deactivate(courier.WentOffline)
+		require.NoError(t, err)
+
+		c.Reactivate()
+
+		assert.False(t, c.IsDeactivated())
+	})
+
+	t.Run("should restore deactivation", func(t *testing.T) {
+		c := createValidCourier(t)
+
+		require.NoError(t, c.RestoreDeactivation(courier.Offboarded))
+		assert.Equal(t, courier.Offboarded, c.DeactivationReason())
+
+		require.NoError(t, c.RestoreDeactivation(courier.NotDeactivated))
+		assert.False(t, c.IsDeactivated())
+
+		require.Error(t, c.RestoreDeactivation(courier.DeactivationReason(42)))
+	})
+}
+
+func TestDeactivationReason_String(t *testing.T) {
+	assert.Equal(t, "NotDeactivated", courier.NotDeactivated.String())
+	assert.Equal(t, "Offboarded", courier.Offboarded.String())
+	assert.Equal(t, "WentOffline", courier.WentOffline.String())
+	assert.Equal(t, "Unknown", courier.DeactivationReason(42).String())
+}
+
 func TestCourier_AddStoragePlace(t *testing.T) {
 	t.Run("should add storage place successfully with valid parameters", func(t *testing.T) {
 		c := createValidCourier(t)
@@ -1157,6 +1548,21 @@ func TestCourier_AddStoragePlace(t *testing.T) {
 	})
 }
 
+func TestCourier_AddThermalStoragePlace(t *testing.T) {
+	c := createValidCourier(t)
+
+	require.NoError(t, c.AddThermalStoragePlace("Thermal bag", 20))
+
+	storagePlaces := c.StoragePlaces()
+	assert.False(t, storagePlaces[0].IsThermal())
+	thermal := storagePlaces[len(storagePlaces)-1]
+	assert.True(t, thermal.IsThermal())
+	assert.Equal(t, "Thermal bag", thermal.Name())
+	assert.Equal(t, 20, thermal.TotalVolume())
+
+	require.Error(t, c.AddThermalStoragePlace("", 20))
+}
+
 func TestCourier_IntegrationScenarios(t *testing.T) {
 	t.Run("complete delivery workflow", func(t *testing.T) {
 		// Create courier at pickup location
@@ -1555,6 +1961,59 @@ func TestCourier_Move_EdgeCases(t *testing.T) {
 	})
 }
 
+func TestCourier_ReportLocation(t *testing.T) {
+	t.Run("should place the courier at the reported location regardless of speed", func(t *testing.T) {
+		c := createValidCourier(t)
+		reported := createValidLocation(t, 9, 8)
+
+		require.NoError(t, c.ReportLocation(reported))
+
+		assert.Equal(t, reported, c.Location())
+	})
+
+	t.Run("should reject invalid location", func(t *testing.T) {
+		c := createValidCourier(t)
+		start := c.Location()
+
+		require.Error(t, c.ReportLocation(kernel.Location{}))
+		assert.Equal(t, start, c.Location())
+	})
+}
+
+func TestCourier_CalculateTimeAlongStops(t *testing.T) {
+	t.Run("should add up the legs between the stops", func(t *testing.T) {
+		c, err := courier.NewCourier(kernel.NewUUID(), "Test", 2, createValidLocation(t, 1, 1))
+		require.NoError(t, err)
+
+		// 1,1 -> 4,1 -> 4,5 -> 2,5: 3 + 4 + 2 = 9 cells at speed 2
+		time, err := c.CalculateTimeAlongStops([]kernel.Location{
+			createValidLocation(t, 4, 1),
+			createValidLocation(t, 4, 5),
+			createValidLocation(t, 2, 5),
+		})
+
+		require.NoError(t, err)
+		assert.InDelta(t, 4.5, time, 0.0001)
+	})
+
+	t.Run("should return 0 without stops", func(t *testing.T) {
+		c := createValidCourier(t)
+
+		time, err := c.CalculateTimeAlongStops(nil)
+
+		require.NoError(t, err)
+		assert.InDelta(t, 0.0, time, 0.0001)
+	})
+
+	t.Run("should return error for invalid stop", func(t *testing.T) {
+		c := createValidCourier(t)
+
+		_, err := c.CalculateTimeAlongStops([]kernel.Location{createValidLocation(t, 3, 3), {}})
+
+		require.Error(t, err)
+	})
+}
+
 func TestCourier_CalculateTimeToLocation_EdgeCases(t *testing.T) {
 	t.Run("should handle distance calculation error", func(t *testing.T) {
 		c := createValidCourier(t)
@@ -0,0 +1,62 @@
+package courier
+
+import (
+	"errors"
+	"fmt"
+
+	"delivery/internal/pkg/errs"
+)
+
+var (
+	// ErrCourierIsDeactivated is returned when deactivating a courier who is already out of service.
+	ErrCourierIsDeactivated = errors.New("courier is deactivated")
+)
+
+// DeactivationReason records why a courier was taken out of service.
+// The zero value NotDeactivated means the courier is active.
+type DeactivationReason int
+
+const (
+	// NotDeactivated marks an active courier.
+	// This value (0) is not a valid reason to deactivate a courier.
+	NotDeactivated DeactivationReason = iota
+
+	// Offboarded marks a courier who has left the fleet.
+	Offboarded
+
+	// WentOffline marks a courier who stopped working mid-shift.
+	WentOffline
+)
+
+// getValidDeactivationReasonStrings returns a map of valid DeactivationReason values
+// to their string representations.
+func getValidDeactivationReasonStrings() map[DeactivationReason]string {
+	//nolint:exhaustive // NotDeactivated is intentionally excluded as it's not a reason
+	return map[DeactivationReason]string{
+		Offboarded:  "Offboarded",
+		WentOffline: "WentOffline",
+	}
+}
+
+// Validate checks if the DeactivationReason value is a valid reason to deactivate a courier.
+func (r DeactivationReason) Validate() error {
+	if _, ok := getValidDeactivationReasonStrings()[r]; !ok {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"deactivation reason is invalid",
+			fmt.Errorf("%d is not a valid deactivation reason", r),
+		)
+	}
+	return nil
+}
+
+// String returns the human-readable name of the reason.
+// Returns "NotDeactivated" for the zero value and "Unknown" for other invalid values.
+func (r DeactivationReason) String() string {
+	if r == NotDeactivated {
+		return "NotDeactivated"
+	}
+	if str, ok := getValidDeactivationReasonStrings()[r]; ok {
+		return str
+	}
+	return "Unknown"
+}
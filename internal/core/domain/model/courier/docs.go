@@ -5,12 +5,32 @@
 // The package includes:
 //   - Courier: The aggregate root that manages courier identity, movement, and orders
 //   - StoragePlace: An entity that manages temporary storage of orders during delivery
+//   - WorkingHoursLimit and WorkLog: The daily on-shift limit and the time a courier worked today
+//   - Status: Where a courier stands in the working day (Offline, Available, Busy, OnBreak)
+//   - MaintenanceWindow: A period in which the courier's vehicle is in maintenance
+//   - Absence: A planned period off work in which a substitute courier takes over the orders
+//   - Schedule and ScheduleWindow: The weekly windows (UTC) in which the courier may be dispatched
+//   - Availability: Whether a courier is free for orders, busy on shift or off shift
 //
 // Key business rules:
 //   - Couriers must have a valid unique identifier, name, and speed
 //   - Couriers can pick up and deliver orders based on location and capacity
 //   - Storage places enforce volume constraints and can store at most one order
 //   - Couriers can only take orders that fit in their available storage places
+//   - An order handed over by another courier goes into the storage place the receiving courier chose
+//   - A duplicate record of a courier is merged into the courier once it is off shift, carries no orders
+//     and has no upcoming plans; the courier gains its storage places, external ID and insurance
+//   - Only insured couriers can take insured high-value orders
+//   - Deactivated couriers hold no orders and record why they left service
+//   - Default storage bag is named in the courier's preferred language (Russian unless chosen otherwise)
+//   - Profile details (photo URL, E.164 phone, vehicle plate) are optional and validated when set
+//   - A courier who worked the daily limit (per UTC day) cannot start another shift that day
+//   - Only Available couriers are dispatched; a courier with orders is Busy and cannot go offline or on a break
+//   - Maintenance windows of a courier do not overlap, and one cannot start while the courier carries orders
+//   - Absences of a courier do not overlap; the substitute is another active courier who is not absent
+//     at the same time, and an absent courier substitutes for no one
+//   - Schedule windows lie within a day and do not overlap; a courier with a schedule is dispatched
+//     only within its windows, one without a schedule whenever on shift
 //
 // The package follows Domain-Driven Design principles, providing rich domain
 // behavior, encapsulation, and validation to ensure business rules are enforced.
@@ -0,0 +1,72 @@
+package courier
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// MaxExternalIDLength is the maximum number of characters in a courier external ID.
+const MaxExternalIDLength = 64
+
+var (
+	// ErrExternalIDIsNotConstructed indicates that an ExternalID was not created via NewExternalID.
+	ErrExternalIDIsNotConstructed = errors.New("ExternalID must be created via NewExternalID constructor")
+
+	// ErrExternalIDIsAlreadyLinked is returned when linking a courier who already has another external ID.
+	ErrExternalIDIsAlreadyLinked = errors.New("courier is already linked to another external id")
+
+	// ErrExternalIDIsAlreadyRegistered is returned when another courier is registered with the same external ID.
+	ErrExternalIDIsAlreadyRegistered = errors.New("external id is already registered")
+)
+
+// ExternalID identifies the courier in an external system, such as the HR system the courier
+// was onboarded in. A courier has at most one external ID and it is unique among couriers.
+type ExternalID struct {
+	value string
+	guard guard.ConstructorGuard
+}
+
+// NewExternalID validates an external ID of at most MaxExternalIDLength printable characters
+// without spaces. Surrounding spaces are trimmed; letter case is kept.
+//
+// Example:
+//
+//	externalID, err := courier.NewExternalID("HR-004217")
+func NewExternalID(value string) (ExternalID, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return ExternalID{}, errs.NewValueIsRequiredError("external id")
+	}
+	if length := utf8.RuneCountInString(value); length > MaxExternalIDLength {
+		return ExternalID{}, errs.NewValueIsInvalidErrorWithCause(
+			"external id is invalid",
+			fmt.Errorf("%d characters exceed the limit of %d", length, MaxExternalIDLength),
+		)
+	}
+	for _, r := range value {
+		if unicode.IsSpace(r) || !unicode.IsPrint(r) {
+			return ExternalID{}, errs.NewValueIsInvalidErrorWithCause(
+				"external id is invalid",
+				fmt.Errorf("%q contains %q", value, r),
+			)
+		}
+	}
+
+	return ExternalID{value: value, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the ExternalID was created through NewExternalID.
+func (e ExternalID) Validate() error {
+	return e.guard.Validate(ErrExternalIDIsNotConstructed)
+}
+
+// String returns the external ID.
+func (e ExternalID) String() string {
+	return e.value
+}
@@ -0,0 +1,89 @@
+package courier_test
+
+import (
+	"strings"
+	"testing"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExternalID(t *testing.T) {
+	t.Run("should trim surrounding spaces", func(t *testing.T) {
+		externalID, err := courier.NewExternalID(" HR-004217 ")
+
+		require.NoError(t, err)
+		require.NoError(t, externalID.Validate())
+		assert.Equal(t, "HR-004217", externalID.String())
+	})
+
+	tests := []struct {
+		name     string
+		value    string
+		expected error
+	}{
+		{"blank id", " ", errs.ErrValueIsRequired},
+		{"id with inner spaces", "HR 004217", errs.ErrValueIsInvalid},
+		{"id with control characters", "HR\x00004217", errs.ErrValueIsInvalid},
+		{"too long id", strings.Repeat("x", courier.MaxExternalIDLength+1), errs.ErrValueIsInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run("should reject "+tt.name, func(t *testing.T) {
+			_, err := courier.NewExternalID(tt.value)
+
+			require.ErrorIs(t, err, tt.expected)
+		})
+	}
+
+	t.Run("should reject zero value", func(t *testing.T) {
+		var externalID courier.ExternalID
+
+		require.ErrorIs(t, externalID.Validate(), courier.ErrExternalIDIsNotConstructed)
+	})
+}
+
+func TestCourier_LinkExternalID(t *testing.T) {
+	newCourier := func(t *testing.T) *courier.Courier {
+		t.Helper()
+		location, err := kernel.NewLocation(1, 1)
+		require.NoError(t, err)
+		c, err := courier.NewCourier(kernel.NewUUID(), "Alice", 2, location)
+		require.NoError(t, err)
+		return c
+	}
+	externalID, err := courier.NewExternalID("HR-004217")
+	require.NoError(t, err)
+
+	t.Run("should link external id once", func(t *testing.T) {
+		c := newCourier(t)
+		assert.Nil(t, c.ExternalID())
+
+		require.NoError(t, c.LinkExternalID(externalID))
+		require.NoError(t, c.LinkExternalID(externalID))
+
+		require.NotNil(t, c.ExternalID())
+		assert.Equal(t, "HR-004217", c.ExternalID().String())
+	})
+
+	t.Run("should not replace linked external id", func(t *testing.T) {
+		c := newCourier(t)
+		require.NoError(t, c.LinkExternalID(externalID))
+		other, err := courier.NewExternalID("HR-000001")
+		require.NoError(t, err)
+
+		require.ErrorIs(t, c.LinkExternalID(other), courier.ErrExternalIDIsAlreadyLinked)
+		assert.Equal(t, "HR-004217", c.ExternalID().String())
+	})
+
+	t.Run("should reject zero value", func(t *testing.T) {
+		c := newCourier(t)
+
+		require.ErrorIs(t, c.LinkExternalID(courier.ExternalID{}), courier.ErrExternalIDIsNotConstructed)
+		assert.Nil(t, c.ExternalID())
+	})
+}
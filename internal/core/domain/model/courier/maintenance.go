@@ -0,0 +1,328 @@
+package courier
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	// ErrMaintenanceWindowIsNotConstructed indicates that a MaintenanceWindow was not properly
+	// initialized through the NewMaintenanceWindow constructor.
+	ErrMaintenanceWindowIsNotConstructed = errors.New(
+		"MaintenanceWindow must be created via NewMaintenanceWindow constructor",
+	)
+
+	// ErrMaintenanceWindowNotFound is returned when a courier has no maintenance window with the given ID.
+	ErrMaintenanceWindowNotFound = errors.New("maintenance window not found")
+
+	// ErrMaintenanceWindowOverlaps is returned when a maintenance window overlaps another window of the courier.
+	ErrMaintenanceWindowOverlaps = errors.New("maintenance window overlaps another maintenance window")
+
+	// ErrMaintenanceWindowIsOver is returned when scheduling a maintenance window that has already ended.
+	ErrMaintenanceWindowIsOver = errors.New("maintenance window is over")
+
+	// ErrMaintenanceOverlapsAssignment is returned when a maintenance window would start
+	// while the courier still carries orders.
+	ErrMaintenanceOverlapsAssignment = errors.New("maintenance window overlaps an assigned order")
+)
+
+// MaintenanceStatus tells how a maintenance window relates to the moment asked about.
+type MaintenanceStatus int
+
+const (
+	// MaintenanceScheduled means the window starts later than the warning period.
+	MaintenanceScheduled MaintenanceStatus = iota
+
+	// MaintenanceApproaching means the window starts within the warning period; the courier
+	// should finish the current delivery and receives no new orders.
+	MaintenanceApproaching
+
+	// MaintenanceActive means the vehicle is in maintenance and the courier cannot be dispatched.
+	MaintenanceActive
+
+	// MaintenanceFinished means the window is over.
+	MaintenanceFinished
+)
+
+// String returns the name of the status as reported by the API.
+func (s MaintenanceStatus) String() string {
+	switch s {
+	case MaintenanceScheduled:
+		return "scheduled"
+	case MaintenanceApproaching:
+		return "approaching"
+	case MaintenanceActive:
+		return "active"
+	case MaintenanceFinished:
+		return "finished"
+	default:
+		return "unknown"
+	}
+}
+
+// MaintenanceWindow is a period in which the courier's vehicle is in maintenance.
+// The courier is not dispatched during the window.
+//
+// Key business rules:
+//   - Must be constructed through NewMaintenanceWindow
+//   - The window must not be empty: startsAt is before endsAt
+type MaintenanceWindow struct {
+	// id uniquely identifies the window
+	id kernel.UUID
+
+	// startsAt is when the vehicle goes into maintenance
+	startsAt time.Time
+
+	// endsAt is when the vehicle is back in service
+	endsAt time.Time
+
+	// guard ensures the window was created via NewMaintenanceWindow
+	guard guard.ConstructorGuard
+}
+
+// NewMaintenanceWindow creates a maintenance window with validation.
+// Used both when scheduling maintenance and when restoring windows from persistent storage.
+//
+// Example:
+//
+//	window, err := courier.NewMaintenanceWindow(
+//	    kernel.NewUUID(),
+//	    time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC),
+//	    time.Date(2025, 3, 1, 13, 0, 0, 0, time.UTC),
+//	)
+func NewMaintenanceWindow(id kernel.UUID, startsAt, endsAt time.Time) (MaintenanceWindow, error) {
+	if err := errs.JoinFields(
+		errs.Field("id", id.Validate()),
+		errs.Field("startsAt", validateMaintenanceStart(startsAt)),
+		errs.Field("endsAt", validateMaintenanceEnd(startsAt, endsAt)),
+	); err != nil {
+		return MaintenanceWindow{}, err
+	}
+
+	return MaintenanceWindow{
+		id:       id,
+		startsAt: startsAt.UTC(),
+		endsAt:   endsAt.UTC(),
+		guard:    guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the MaintenanceWindow was created through NewMaintenanceWindow.
+func (w MaintenanceWindow) Validate() error {
+	return w.guard.Validate(ErrMaintenanceWindowIsNotConstructed)
+}
+
+// ID returns the window's unique identifier.
+func (w MaintenanceWindow) ID() kernel.UUID {
+	return w.id
+}
+
+// StartsAt returns when the vehicle goes into maintenance.
+func (w MaintenanceWindow) StartsAt() time.Time {
+	return w.startsAt
+}
+
+// EndsAt returns when the vehicle is back in service.
+func (w MaintenanceWindow) EndsAt() time.Time {
+	return w.endsAt
+}
+
+// Overlaps reports whether the two windows share any moment. Adjacent windows do not overlap.
+func (w MaintenanceWindow) Overlaps(other MaintenanceWindow) bool {
+	return w.startsAt.Before(other.endsAt) && other.startsAt.Before(w.endsAt)
+}
+
+// Status returns the status of the window at now; windows starting within warnBefore are approaching.
+func (w MaintenanceWindow) Status(now time.Time, warnBefore time.Duration) MaintenanceStatus {
+	switch {
+	case !now.Before(w.endsAt):
+		return MaintenanceFinished
+	case !now.Before(w.startsAt):
+		return MaintenanceActive
+	case !now.Before(w.startsAt.Add(-warnBefore)):
+		return MaintenanceApproaching
+	default:
+		return MaintenanceScheduled
+	}
+}
+
+func validateMaintenanceStart(startsAt time.Time) error {
+	if startsAt.IsZero() {
+		return errs.NewValueIsRequiredError("startsAt")
+	}
+	return nil
+}
+
+func validateMaintenanceEnd(startsAt, endsAt time.Time) error {
+	if endsAt.IsZero() {
+		return errs.NewValueIsRequiredError("endsAt")
+	}
+	if !startsAt.IsZero() && !startsAt.Before(endsAt) {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"endsAt is invalid",
+			fmt.Errorf("end %s must be after start %s", endsAt.Format(time.RFC3339), startsAt.Format(time.RFC3339)),
+		)
+	}
+	return nil
+}
+
+// ScheduleMaintenance adds a maintenance window to the courier's calendar.
+//
+// This method enforces the following business rules:
+//   - The window must not be over at now
+//   - The window must not overlap another window of the courier
+//   - A window that is already active at now cannot be scheduled while the courier carries orders
+//
+// Returns ErrMaintenanceWindowIsOver, ErrMaintenanceWindowOverlaps or ErrMaintenanceOverlapsAssignment
+// if a rule is violated, or ErrMaintenanceWindowIsNotConstructed for an invalid window.
+//
+// Example:
+//
+//	window, _ := courier.NewMaintenanceWindow(kernel.NewUUID(), startsAt, endsAt)
+//	if err := c.ScheduleMaintenance(window, time.Now()); errors.Is(err, courier.ErrMaintenanceWindowOverlaps) {
+//	    // Pick another time
+//	}
+func (c *Courier) ScheduleMaintenance(window MaintenanceWindow, now time.Time) error {
+	if err := c.checkMaintenance(window, now); err != nil {
+		return err
+	}
+
+	c.maintenanceWindows = append(c.maintenanceWindows, window)
+	c.sortMaintenanceWindows()
+	return nil
+}
+
+// RescheduleMaintenance replaces the maintenance window with the ID of window by window,
+// under the same rules as ScheduleMaintenance.
+// Returns ErrMaintenanceWindowNotFound if the courier has no window with that ID.
+//
+// Example:
+//
+//	moved, _ := courier.NewMaintenanceWindow(windowID, startsAt.Add(time.Hour), endsAt.Add(time.Hour))
+//	err := c.RescheduleMaintenance(moved, time.Now())
+func (c *Courier) RescheduleMaintenance(window MaintenanceWindow, now time.Time) error {
+	if err := window.Validate(); err != nil {
+		return err
+	}
+
+	index := c.findMaintenanceWindow(window.ID())
+	if index < 0 {
+		return ErrMaintenanceWindowNotFound
+	}
+	if err := c.checkMaintenance(window, now); err != nil {
+		return err
+	}
+
+	c.maintenanceWindows[index] = window
+	c.sortMaintenanceWindows()
+	return nil
+}
+
+// CancelMaintenance removes the maintenance window with the given ID from the courier's calendar.
+// Returns ErrMaintenanceWindowNotFound if the courier has no such window.
+func (c *Courier) CancelMaintenance(windowID kernel.UUID) error {
+	index := c.findMaintenanceWindow(windowID)
+	if index < 0 {
+		return ErrMaintenanceWindowNotFound
+	}
+
+	c.maintenanceWindows = slices.Delete(c.maintenanceWindows, index, index+1)
+	return nil
+}
+
+// MaintenanceWindows returns a copy of the courier's maintenance windows, earliest first.
+func (c *Courier) MaintenanceWindows() []MaintenanceWindow {
+	return slices.Clone(c.maintenanceWindows)
+}
+
+// MaintenanceStatus returns the most pressing status of the courier's maintenance windows at now:
+// MaintenanceActive during a window, MaintenanceApproaching when one starts within warnBefore,
+// and MaintenanceScheduled otherwise, including couriers without upcoming windows.
+func (c *Courier) MaintenanceStatus(now time.Time, warnBefore time.Duration) MaintenanceStatus {
+	status := MaintenanceScheduled
+	for _, window := range c.maintenanceWindows {
+		switch window.Status(now, warnBefore) {
+		case MaintenanceActive:
+			return MaintenanceActive
+		case MaintenanceApproaching:
+			status = MaintenanceApproaching
+		case MaintenanceScheduled, MaintenanceFinished:
+		}
+	}
+	return status
+}
+
+// IsUnderMaintenance reports whether the courier's vehicle is in maintenance at now.
+func (c *Courier) IsUnderMaintenance(now time.Time) bool {
+	return c.MaintenanceStatus(now, 0) == MaintenanceActive
+}
+
+// RestoreMaintenanceWindows reapplies persisted maintenance windows.
+// Intended for repositories rebuilding the aggregate; windows must not overlap.
+func (c *Courier) RestoreMaintenanceWindows(windows []MaintenanceWindow) error {
+	restored := make([]MaintenanceWindow, 0, len(windows))
+	for _, window := range windows {
+		if err := window.Validate(); err != nil {
+			return err
+		}
+		for _, other := range restored {
+			if window.Overlaps(other) {
+				return ErrMaintenanceWindowOverlaps
+			}
+		}
+		restored = append(restored, window)
+	}
+
+	c.maintenanceWindows = restored
+	c.sortMaintenanceWindows()
+	return nil
+}
+
+// checkMaintenance applies the scheduling rules to a new or moved window.
+func (c *Courier) checkMaintenance(window MaintenanceWindow, now time.Time) error {
+	if err := window.Validate(); err != nil {
+		return err
+	}
+
+	if window.Status(now, 0) == MaintenanceFinished {
+		return ErrMaintenanceWindowIsOver
+	}
+	for _, other := range c.maintenanceWindows {
+		if !other.ID().IsEqual(window.ID()) && window.Overlaps(other) {
+			return ErrMaintenanceWindowOverlaps
+		}
+	}
+	if window.Status(now, 0) == MaintenanceActive && c.isCarryingOrders() {
+		return ErrMaintenanceOverlapsAssignment
+	}
+
+	return nil
+}
+
+// isCarryingOrders reports whether any storage place holds an order.
+func (c *Courier) isCarryingOrders() bool {
+	for _, storagePlace := range c.storagePlaces {
+		if storagePlace.OrderID() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Courier) findMaintenanceWindow(windowID kernel.UUID) int {
+	return slices.IndexFunc(c.maintenanceWindows, func(window MaintenanceWindow) bool {
+		return window.ID().IsEqual(windowID)
+	})
+}
+
+func (c *Courier) sortMaintenanceWindows() {
+	slices.SortFunc(c.maintenanceWindows, func(a, b MaintenanceWindow) int {
+		return a.startsAt.Compare(b.startsAt)
+	})
+}
@@ -0,0 +1,197 @@
+package courier_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMaintenanceWindow(t *testing.T) {
+	startsAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("should create valid window", func(t *testing.T) {
+		window, err := courier.NewMaintenanceWindow(kernel.NewUUID(), startsAt, startsAt.Add(4*time.Hour))
+
+		require.NoError(t, err)
+		require.NoError(t, window.Validate())
+		assert.Equal(t, startsAt, window.StartsAt())
+		assert.Equal(t, startsAt.Add(4*time.Hour), window.EndsAt())
+	})
+
+	t.Run("should fail with empty or missing window", func(t *testing.T) {
+		_, err := courier.NewMaintenanceWindow(kernel.NewUUID(), startsAt, startsAt)
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+
+		_, err = courier.NewMaintenanceWindow(kernel.NewUUID(), time.Time{}, startsAt)
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, courier.MaintenanceWindow{}.Validate(), courier.ErrMaintenanceWindowIsNotConstructed)
+	})
+}
+
+func TestMaintenanceWindow_Status(t *testing.T) {
+	startsAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	window, _ := courier.NewMaintenanceWindow(kernel.NewUUID(), startsAt, startsAt.Add(time.Hour))
+
+	tests := []struct {
+		now      time.Time
+		expected courier.MaintenanceStatus
+	}{
+		{startsAt.Add(-2 * time.Hour), courier.MaintenanceScheduled},
+		{startsAt.Add(-time.Hour), courier.MaintenanceApproaching},
+		{startsAt, courier.MaintenanceActive},
+		{startsAt.Add(time.Hour), courier.MaintenanceFinished},
+	}
+
+	for _, tt := range tests {
+		t.Run("should be "+tt.expected.String(), func(t *testing.T) {
+			assert.Equal(t, tt.expected, window.Status(tt.now, time.Hour))
+		})
+	}
+}
+
+func TestCourier_ScheduleMaintenance(t *testing.T) {
+	now := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+	location, _ := kernel.NewLocation(1, 1)
+	newCourier := func(t *testing.T) *courier.Courier {
+		t.Helper()
+		c, err := courier.NewCourier(kernel.NewUUID(), "Alice", 2, location)
+		require.NoError(t, err)
+		return c
+	}
+	window := func(t *testing.T, from, to time.Duration) courier.MaintenanceWindow {
+		t.Helper()
+		w, err := courier.NewMaintenanceWindow(kernel.NewUUID(), now.Add(from), now.Add(to))
+		require.NoError(t, err)
+		return w
+	}
+
+	t.Run("should keep windows earliest first", func(t *testing.T) {
+		c := newCourier(t)
+
+		require.NoError(t, c.ScheduleMaintenance(window(t, 5*time.Hour, 6*time.Hour), now))
+		require.NoError(t, c.ScheduleMaintenance(window(t, time.Hour, 2*time.Hour), now))
+		require.NoError(t, c.ScheduleMaintenance(window(t, 2*time.Hour, 3*time.Hour), now))
+
+		windows := c.MaintenanceWindows()
+		require.Len(t, windows, 3)
+		assert.Equal(t, now.Add(time.Hour), windows[0].StartsAt())
+		assert.Equal(t, now.Add(5*time.Hour), windows[2].StartsAt())
+	})
+
+	t.Run("should refuse overlapping and finished windows", func(t *testing.T) {
+		c := newCourier(t)
+		require.NoError(t, c.ScheduleMaintenance(window(t, time.Hour, 3*time.Hour), now))
+
+		require.ErrorIs(t, c.ScheduleMaintenance(window(t, 2*time.Hour, 4*time.Hour), now),
+			courier.ErrMaintenanceWindowOverlaps)
+		require.ErrorIs(t, c.ScheduleMaintenance(window(t, -2*time.Hour, -time.Hour), now),
+			courier.ErrMaintenanceWindowIsOver)
+		require.ErrorIs(t, c.ScheduleMaintenance(courier.MaintenanceWindow{}, now),
+			courier.ErrMaintenanceWindowIsNotConstructed)
+		assert.Len(t, c.MaintenanceWindows(), 1)
+	})
+
+	t.Run("should refuse active window while carrying orders", func(t *testing.T) {
+		c := newCourier(t)
+		o, err := order.NewOrder(kernel.NewUUID(), location, 5)
+		require.NoError(t, err)
+		require.NoError(t, c.TakeOrder(o))
+
+		require.ErrorIs(t, c.ScheduleMaintenance(window(t, -time.Hour, time.Hour), now),
+			courier.ErrMaintenanceOverlapsAssignment)
+		require.NoError(t, c.ScheduleMaintenance(window(t, time.Hour, 2*time.Hour), now))
+	})
+
+	t.Run("should report the most pressing status", func(t *testing.T) {
+		c := newCourier(t)
+		assert.Equal(t, courier.MaintenanceScheduled, c.MaintenanceStatus(now, time.Hour))
+
+		require.NoError(t, c.ScheduleMaintenance(window(t, 30*time.Minute, time.Hour), now))
+		assert.Equal(t, courier.MaintenanceApproaching, c.MaintenanceStatus(now, time.Hour))
+		assert.False(t, c.IsUnderMaintenance(now))
+
+		require.NoError(t, c.ScheduleMaintenance(window(t, 0, 30*time.Minute), now))
+		assert.Equal(t, courier.MaintenanceActive, c.MaintenanceStatus(now, time.Hour))
+		assert.True(t, c.IsUnderMaintenance(now))
+	})
+}
+
+func TestCourier_RescheduleMaintenance(t *testing.T) {
+	now := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+	location, _ := kernel.NewLocation(1, 1)
+	c, _ := courier.NewCourier(kernel.NewUUID(), "Alice", 2, location)
+	first, _ := courier.NewMaintenanceWindow(kernel.NewUUID(), now.Add(time.Hour), now.Add(2*time.Hour))
+	second, _ := courier.NewMaintenanceWindow(kernel.NewUUID(), now.Add(3*time.Hour), now.Add(4*time.Hour))
+	require.NoError(t, c.ScheduleMaintenance(first, now))
+	require.NoError(t, c.ScheduleMaintenance(second, now))
+
+	reschedule := func(id kernel.UUID, from, to time.Duration) error {
+		window, err := courier.NewMaintenanceWindow(id, now.Add(from), now.Add(to))
+		require.NoError(t, err)
+		return c.RescheduleMaintenance(window, now)
+	}
+
+	t.Run("should move window over its own time", func(t *testing.T) {
+		require.NoError(t, reschedule(first.ID(), 90*time.Minute, 150*time.Minute))
+
+		assert.Equal(t, now.Add(90*time.Minute), c.MaintenanceWindows()[0].StartsAt())
+	})
+
+	t.Run("should refuse overlap with another window", func(t *testing.T) {
+		err := reschedule(first.ID(), 2*time.Hour, 5*time.Hour)
+
+		require.ErrorIs(t, err, courier.ErrMaintenanceWindowOverlaps)
+		assert.Equal(t, now.Add(90*time.Minute), c.MaintenanceWindows()[0].StartsAt())
+	})
+
+	t.Run("should fail for unknown window", func(t *testing.T) {
+		err := reschedule(kernel.NewUUID(), time.Hour, 2*time.Hour)
+
+		require.ErrorIs(t, err, courier.ErrMaintenanceWindowNotFound)
+	})
+}
+
+func TestCourier_CancelMaintenance(t *testing.T) {
+	now := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+	location, _ := kernel.NewLocation(1, 1)
+	c, _ := courier.NewCourier(kernel.NewUUID(), "Alice", 2, location)
+	window, _ := courier.NewMaintenanceWindow(kernel.NewUUID(), now.Add(time.Hour), now.Add(2*time.Hour))
+	require.NoError(t, c.ScheduleMaintenance(window, now))
+
+	require.NoError(t, c.CancelMaintenance(window.ID()))
+	assert.Empty(t, c.MaintenanceWindows())
+	require.ErrorIs(t, c.CancelMaintenance(window.ID()), courier.ErrMaintenanceWindowNotFound)
+}
+
+func TestCourier_RestoreMaintenanceWindows(t *testing.T) {
+	now := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+	location, _ := kernel.NewLocation(1, 1)
+	first, _ := courier.NewMaintenanceWindow(kernel.NewUUID(), now, now.Add(2*time.Hour))
+	overlapping, _ := courier.NewMaintenanceWindow(kernel.NewUUID(), now.Add(time.Hour), now.Add(3*time.Hour))
+
+	t.Run("should restore windows", func(t *testing.T) {
+		c, _ := courier.NewCourier(kernel.NewUUID(), "Alice", 2, location)
+
+		require.NoError(t, c.RestoreMaintenanceWindows([]courier.MaintenanceWindow{first}))
+		assert.Len(t, c.MaintenanceWindows(), 1)
+	})
+
+	t.Run("should fail with invalid windows", func(t *testing.T) {
+		c, _ := courier.NewCourier(kernel.NewUUID(), "Alice", 2, location)
+
+		require.ErrorIs(t, c.RestoreMaintenanceWindows([]courier.MaintenanceWindow{first, overlapping}),
+			courier.ErrMaintenanceWindowOverlaps)
+		require.ErrorIs(t, c.RestoreMaintenanceWindows([]courier.MaintenanceWindow{{}}),
+			courier.ErrMaintenanceWindowIsNotConstructed)
+	})
+}
@@ -0,0 +1,101 @@
+package courier
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrCourierIsMergedWithItself is returned when a courier record would be merged into itself.
+	ErrCourierIsMergedWithItself = errors.New("courier cannot be merged with itself")
+
+	// ErrDuplicateCourierIsOnShift is returned when the duplicate record of a merge is on shift.
+	ErrDuplicateCourierIsOnShift = errors.New("duplicate courier must be off shift to be merged")
+
+	// ErrDuplicateCourierCarriesOrders is returned when the duplicate record of a merge carries orders.
+	ErrDuplicateCourierCarriesOrders = errors.New("duplicate courier must not carry orders to be merged")
+
+	// ErrDuplicateCourierHasPlans is returned when the duplicate record of a merge has maintenance
+	// windows or absences that are not over yet.
+	ErrDuplicateCourierHasPlans = errors.New("duplicate courier has upcoming maintenance windows or absences")
+)
+
+// MergeDuplicate takes over a duplicate record of the same courier, for example one created
+// twice during onboarding. The courier keeps their own identity, location and shift and gains
+// the storage places of the duplicate, its external ID if they have none and its insurance.
+// The duplicate itself is left unchanged; the caller removes it once its records are moved.
+//
+// This method enforces the following business rules:
+//   - The duplicate is another record, and the courier is not deactivated
+//   - The duplicate is off shift and carries no orders
+//   - The duplicate has no maintenance windows or absences that are not over at now; the
+//     finished ones are history and are dropped with the duplicate
+//   - The courier does not name the duplicate as the substitute of an absence that is not over
+//   - Both records are not linked to different external IDs
+//
+// Returns ErrCourierIsMergedWithItself, ErrCourierIsDeactivated, ErrDuplicateCourierIsOnShift,
+// ErrDuplicateCourierCarriesOrders, ErrDuplicateCourierHasPlans, ErrSubstituteIsAbsentCourier or
+// ErrExternalIDIsAlreadyLinked; the courier is left unchanged on all of these errors.
+//
+// Example:
+//
+//	if err := survivor.MergeDuplicate(duplicate, time.Now()); err != nil {
+//	    return err
+//	}
+//	// move the orders and the history of the duplicate, then delete it
+func (c *Courier) MergeDuplicate(duplicate *Courier, now time.Time) error {
+	if err := errors.Join(c.Validate(), duplicate.Validate()); err != nil {
+		return err
+	}
+
+	if c.id.IsEqual(duplicate.id) {
+		return ErrCourierIsMergedWithItself
+	}
+	if c.IsDeactivated() {
+		return ErrCourierIsDeactivated
+	}
+	if duplicate.status != Offline {
+		return ErrDuplicateCourierIsOnShift
+	}
+	if duplicate.carriesOrders() {
+		return ErrDuplicateCourierCarriesOrders
+	}
+	if duplicate.hasPlansAfter(now) {
+		return ErrDuplicateCourierHasPlans
+	}
+	for _, absence := range c.absences {
+		if absence.SubstituteID().IsEqual(duplicate.id) && !absence.IsOver(now) {
+			return ErrSubstituteIsAbsentCourier
+		}
+	}
+	if c.externalID != nil && duplicate.externalID != nil &&
+		c.externalID.String() != duplicate.externalID.String() {
+		return ErrExternalIDIsAlreadyLinked
+	}
+
+	if c.externalID == nil && duplicate.externalID != nil {
+		externalID := *duplicate.externalID
+		c.externalID = &externalID
+	}
+	if duplicate.insured {
+		c.insured = true
+	}
+	c.storagePlaces = append(c.storagePlaces, duplicate.storagePlaces...)
+	return nil
+}
+
+// hasPlansAfter reports whether the courier has a maintenance window or an absence that is not
+// over at now.
+func (c *Courier) hasPlansAfter(now time.Time) bool {
+	for _, window := range c.maintenanceWindows {
+		if window.Status(now, 0) != MaintenanceFinished {
+			return true
+		}
+	}
+	for _, absence := range c.absences {
+		if !absence.IsOver(now) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+package courier_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCourier_MergeDuplicate(t *testing.T) {
+	now := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+	location, _ := kernel.NewLocation(1, 1)
+	newCourier := func(t *testing.T, name string) *courier.Courier {
+		t.Helper()
+		c, err := courier.NewCourier(kernel.NewUUID(), name, 2, location)
+		require.NoError(t, err)
+		return c
+	}
+	externalID := func(t *testing.T, value string) courier.ExternalID {
+		t.Helper()
+		id, err := courier.NewExternalID(value)
+		require.NoError(t, err)
+		return id
+	}
+
+	t.Run("should take over storage places, external ID and insurance", func(t *testing.T) {
+		survivor := newCourier(t, "Alice")
+		duplicate := newCourier(t, "Alice Smith")
+		require.NoError(t, duplicate.AddStoragePlace("Backpack", 20))
+		require.NoError(t, duplicate.LinkExternalID(externalID(t, "hr-42")))
+		duplicate.Insure()
+
+		err := survivor.MergeDuplicate(duplicate, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Alice", survivor.Name())
+		require.Len(t, survivor.StoragePlaces(), 3)
+		assert.True(t, survivor.StoragePlaces()[1].ID().IsEqual(duplicate.StoragePlaces()[0].ID()))
+		assert.True(t, survivor.StoragePlaces()[2].ID().IsEqual(duplicate.StoragePlaces()[1].ID()))
+		require.NotNil(t, survivor.ExternalID())
+		assert.Equal(t, "hr-42", survivor.ExternalID().String())
+		assert.True(t, survivor.IsInsured())
+	})
+
+	t.Run("should keep the external ID of the courier when both records share it", func(t *testing.T) {
+		survivor := newCourier(t, "Alice")
+		require.NoError(t, survivor.LinkExternalID(externalID(t, "hr-42")))
+		duplicate := newCourier(t, "Alice")
+		require.NoError(t, duplicate.LinkExternalID(externalID(t, "hr-42")))
+
+		require.NoError(t, survivor.MergeDuplicate(duplicate, now))
+		assert.Equal(t, "hr-42", survivor.ExternalID().String())
+	})
+
+	t.Run("should drop finished plans of the duplicate", func(t *testing.T) {
+		survivor := newCourier(t, "Alice")
+		duplicate := newCourier(t, "Alice")
+		window, err := courier.NewMaintenanceWindow(kernel.NewUUID(), now.Add(time.Hour), now.Add(2*time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, duplicate.ScheduleMaintenance(window, now))
+		absence, err := courier.NewAbsence(kernel.NewUUID(), now.Add(time.Hour), now.Add(3*time.Hour), survivor.ID())
+		require.NoError(t, err)
+		require.NoError(t, duplicate.PlanAbsence(absence, now))
+
+		require.NoError(t, survivor.MergeDuplicate(duplicate, now.Add(3*time.Hour)))
+		assert.Empty(t, survivor.MaintenanceWindows())
+		assert.Empty(t, survivor.Absences())
+	})
+
+	t.Run("should refuse a merge that breaks a rule", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			prepare func(t *testing.T, survivor, duplicate *courier.Courier) *courier.Courier
+			wantErr error
+		}{
+			{
+				name: "duplicate is the courier",
+				prepare: func(_ *testing.T, survivor, _ *courier.Courier) *courier.Courier {
+					return survivor
+				},
+				wantErr: courier.ErrCourierIsMergedWithItself,
+			},
+			{
+				name: "courier is deactivated",
+				prepare: func(t *testing.T, survivor, duplicate *courier.Courier) *courier.Courier {
+					_, err := survivor.Deactivate(courier.Offboarded)
+					require.NoError(t, err)
+					return duplicate
+				},
+				wantErr: courier.ErrCourierIsDeactivated,
+			},
+			{
+				name: "duplicate is on shift",
+				prepare: func(t *testing.T, _, duplicate *courier.Courier) *courier.Courier {
+					limit, err := courier.NewWorkingHoursLimit(8*time.Hour, time.Hour)
+					require.NoError(t, err)
+					require.NoError(t, duplicate.StartShift(now, limit))
+					return duplicate
+				},
+				wantErr: courier.ErrDuplicateCourierIsOnShift,
+			},
+			{
+				name: "duplicate carries an order",
+				prepare: func(t *testing.T, _, duplicate *courier.Courier) *courier.Courier {
+					o, err := order.NewOrder(kernel.NewUUID(), location, 5)
+					require.NoError(t, err)
+					require.NoError(t, duplicate.TakeOrder(o))
+					return duplicate
+				},
+				wantErr: courier.ErrDuplicateCourierCarriesOrders,
+			},
+			{
+				name: "duplicate has an upcoming maintenance window",
+				prepare: func(t *testing.T, _, duplicate *courier.Courier) *courier.Courier {
+					window, err := courier.NewMaintenanceWindow(kernel.NewUUID(), now.Add(time.Hour), now.Add(2*time.Hour))
+					require.NoError(t, err)
+					require.NoError(t, duplicate.ScheduleMaintenance(window, now))
+					return duplicate
+				},
+				wantErr: courier.ErrDuplicateCourierHasPlans,
+			},
+			{
+				name: "duplicate has an upcoming absence",
+				prepare: func(t *testing.T, survivor, duplicate *courier.Courier) *courier.Courier {
+					absence, err := courier.NewAbsence(kernel.NewUUID(), now.Add(time.Hour), now.Add(2*time.Hour), survivor.ID())
+					require.NoError(t, err)
+					require.NoError(t, duplicate.PlanAbsence(absence, now))
+					return duplicate
+				},
+				wantErr: courier.ErrDuplicateCourierHasPlans,
+			},
+			{
+				name: "duplicate substitutes for the courier",
+				prepare: func(t *testing.T, survivor, duplicate *courier.Courier) *courier.Courier {
+					absence, err := courier.NewAbsence(kernel.NewUUID(), now.Add(time.Hour), now.Add(2*time.Hour), duplicate.ID())
+					require.NoError(t, err)
+					require.NoError(t, survivor.PlanAbsence(absence, now))
+					return duplicate
+				},
+				wantErr: courier.ErrSubstituteIsAbsentCourier,
+			},
+			{
+				name: "records are linked to different external IDs",
+				prepare: func(t *testing.T, survivor, duplicate *courier.Courier) *courier.Courier {
+					require.NoError(t, survivor.LinkExternalID(externalID(t, "hr-42")))
+					require.NoError(t, duplicate.LinkExternalID(externalID(t, "hr-43")))
+					return duplicate
+				},
+				wantErr: courier.ErrExternalIDIsAlreadyLinked,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				survivor := newCourier(t, "Alice")
+				duplicate := tt.prepare(t, survivor, newCourier(t, "Alice"))
+
+				err := survivor.MergeDuplicate(duplicate, now)
+
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Len(t, survivor.StoragePlaces(), 1)
+			})
+		}
+	})
+}
@@ -0,0 +1,280 @@
+package courier
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+const (
+	// MaxPhotoURLLength is the maximum number of characters in a courier photo URL.
+	MaxPhotoURLLength = 2048
+
+	// MinVehiclePlateLength and MaxVehiclePlateLength bound the number of characters in a vehicle plate.
+	MinVehiclePlateLength = 2
+	MaxVehiclePlateLength = 12
+)
+
+var (
+	// ErrPhotoURLIsNotConstructed indicates that a PhotoURL was not created via NewPhotoURL.
+	ErrPhotoURLIsNotConstructed = errors.New("PhotoURL must be created via NewPhotoURL constructor")
+
+	// ErrPhoneIsNotConstructed indicates that a Phone was not created via NewPhone.
+	ErrPhoneIsNotConstructed = errors.New("Phone must be created via NewPhone constructor")
+
+	// ErrVehiclePlateIsNotConstructed indicates that a VehiclePlate was not created via NewVehiclePlate.
+	ErrVehiclePlateIsNotConstructed = errors.New("VehiclePlate must be created via NewVehiclePlate constructor")
+)
+
+// phonePattern matches an E.164 number: a plus sign and 8 to 15 digits without a leading zero.
+var phonePattern = regexp.MustCompile(`^\+[1-9][0-9]{7,14}$`)
+
+// PhotoURL is an absolute http(s) link to the courier's photo.
+type PhotoURL struct {
+	value string
+	guard guard.ConstructorGuard
+}
+
+// NewPhotoURL validates an absolute http or https URL of at most MaxPhotoURLLength characters.
+//
+// Example:
+//
+//	photo, err := courier.NewPhotoURL("https://cdn.example.com/couriers/42.jpg")
+func NewPhotoURL(value string) (PhotoURL, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return PhotoURL{}, errs.NewValueIsRequiredError("photo url")
+	}
+	if length := utf8.RuneCountInString(value); length > MaxPhotoURLLength {
+		return PhotoURL{}, errs.NewValueIsInvalidErrorWithCause(
+			"photo url is invalid",
+			fmt.Errorf("%d characters exceed the limit of %d", length, MaxPhotoURLLength),
+		)
+	}
+
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return PhotoURL{}, errs.NewValueIsInvalidErrorWithCause("photo url is invalid", err)
+	}
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return PhotoURL{}, errs.NewValueIsInvalidErrorWithCause(
+			"photo url is invalid",
+			fmt.Errorf("%q is not an absolute http(s) URL", value),
+		)
+	}
+
+	return PhotoURL{value: value, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the PhotoURL was created through NewPhotoURL.
+func (p PhotoURL) Validate() error {
+	return p.guard.Validate(ErrPhotoURLIsNotConstructed)
+}
+
+// String returns the URL.
+func (p PhotoURL) String() string {
+	return p.value
+}
+
+// Phone is the courier's contact number in E.164 format.
+type Phone struct {
+	value string
+	guard guard.ConstructorGuard
+}
+
+// NewPhone normalizes and validates a phone number. Spaces, dashes, dots and parentheses
+// are dropped, so "+7 (912) 345-67-89" is stored as "+79123456789".
+func NewPhone(value string) (Phone, error) {
+	normalized := strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || strings.ContainsRune("-.()", r) {
+			return -1
+		}
+		return r
+	}, value)
+
+	if normalized == "" {
+		return Phone{}, errs.NewValueIsRequiredError("phone")
+	}
+	if !phonePattern.MatchString(normalized) {
+		return Phone{}, errs.NewValueIsInvalidErrorWithCause(
+			"phone is invalid",
+			fmt.Errorf("%q is not an E.164 number", value),
+		)
+	}
+
+	return Phone{value: normalized, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the Phone was created through NewPhone.
+func (p Phone) Validate() error {
+	return p.guard.Validate(ErrPhoneIsNotConstructed)
+}
+
+// String returns the normalized E.164 number.
+func (p Phone) String() string {
+	return p.value
+}
+
+// VehiclePlate is the registration plate of the courier's vehicle.
+type VehiclePlate struct {
+	value string
+	guard guard.ConstructorGuard
+}
+
+// NewVehiclePlate normalizes a plate to upper case and validates it. A plate holds
+// MinVehiclePlateLength to MaxVehiclePlateLength letters, digits, spaces or dashes and
+// at least one digit; surrounding and repeated spaces are collapsed.
+func NewVehiclePlate(value string) (VehiclePlate, error) {
+	normalized := strings.ToUpper(strings.Join(strings.Fields(value), " "))
+	if normalized == "" {
+		return VehiclePlate{}, errs.NewValueIsRequiredError("vehicle plate")
+	}
+
+	length := utf8.RuneCountInString(normalized)
+	if length < MinVehiclePlateLength || length > MaxVehiclePlateLength {
+		return VehiclePlate{}, errs.NewValueIsOutOfRangeError(
+			"vehicle plate length", length, MinVehiclePlateLength, MaxVehiclePlateLength,
+		)
+	}
+
+	hasDigit := false
+	for _, r := range normalized {
+		switch {
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsLetter(r), r == ' ', r == '-':
+		default:
+			return VehiclePlate{}, errs.NewValueIsInvalidErrorWithCause(
+				"vehicle plate is invalid",
+				fmt.Errorf("%q contains %q", value, r),
+			)
+		}
+	}
+	if !hasDigit {
+		return VehiclePlate{}, errs.NewValueIsInvalidErrorWithCause(
+			"vehicle plate is invalid",
+			fmt.Errorf("%q contains no digits", value),
+		)
+	}
+
+	return VehiclePlate{value: normalized, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the VehiclePlate was created through NewVehiclePlate.
+func (p VehiclePlate) Validate() error {
+	return p.guard.Validate(ErrVehiclePlateIsNotConstructed)
+}
+
+// String returns the normalized plate.
+func (p VehiclePlate) String() string {
+	return p.value
+}
+
+// Profile holds the optional contact and presentation details of a courier and the type of
+// their vehicle. Every field may be absent; the zero value is an empty profile.
+type Profile struct {
+	photoURL     *PhotoURL
+	phone        *Phone
+	vehiclePlate *VehiclePlate
+	vehicleType  *VehicleType
+}
+
+// NewProfile builds a profile from raw input. Blank values leave the corresponding field empty;
+// non-blank values must pass the validation of their value object. Errors of all fields are joined.
+//
+// Example:
+//
+//	profile, err := courier.NewProfile("https://cdn.example.com/couriers/42.jpg", "+79123456789", "")
+//	// profile.VehiclePlate() == nil
+func NewProfile(photoURL, phone, vehiclePlate string) (Profile, error) {
+	var (
+		profile                      Profile
+		photoErr, phoneErr, plateErr error
+	)
+
+	if strings.TrimSpace(photoURL) != "" {
+		var value PhotoURL
+		if value, photoErr = NewPhotoURL(photoURL); photoErr == nil {
+			profile.photoURL = &value
+		}
+	}
+	if strings.TrimSpace(phone) != "" {
+		var value Phone
+		if value, phoneErr = NewPhone(phone); phoneErr == nil {
+			profile.phone = &value
+		}
+	}
+	if strings.TrimSpace(vehiclePlate) != "" {
+		var value VehiclePlate
+		if value, plateErr = NewVehiclePlate(vehiclePlate); plateErr == nil {
+			profile.vehiclePlate = &value
+		}
+	}
+
+	if err := errs.JoinFields(
+		errs.Field("photoUrl", photoErr),
+		errs.Field("phone", phoneErr),
+		errs.Field("vehiclePlate", plateErr),
+	); err != nil {
+		return Profile{}, err
+	}
+
+	return profile, nil
+}
+
+// WithVehicleType returns a copy of the profile with the type of the courier's vehicle set.
+// Returns a validation error for an unknown vehicle type.
+//
+// Example:
+//
+//	profile, _ := courier.NewProfile("", "+79123456789", "А123ВС77")
+//	profile, err := profile.WithVehicleType(courier.Car)
+func (p Profile) WithVehicleType(vehicleType VehicleType) (Profile, error) {
+	if err := vehicleType.Validate(); err != nil {
+		return Profile{}, err
+	}
+
+	p.vehicleType = &vehicleType
+	return p, nil
+}
+
+// PhotoURL returns the courier's photo link, nil if not set.
+func (p Profile) PhotoURL() *PhotoURL {
+	return copyOf(p.photoURL)
+}
+
+// Phone returns the courier's contact number, nil if not set.
+func (p Profile) Phone() *Phone {
+	return copyOf(p.phone)
+}
+
+// VehiclePlate returns the courier's vehicle plate, nil if not set.
+func (p Profile) VehiclePlate() *VehiclePlate {
+	return copyOf(p.vehiclePlate)
+}
+
+// VehicleType returns the type of the courier's vehicle, nil if not set.
+func (p Profile) VehicleType() *VehicleType {
+	return copyOf(p.vehicleType)
+}
+
+// IsEmpty reports whether no profile field is set.
+func (p Profile) IsEmpty() bool {
+	return p.photoURL == nil && p.phone == nil && p.vehiclePlate == nil && p.vehicleType == nil
+}
+
+// copyOf returns a pointer to a copy of the value so callers cannot alter the profile.
+func copyOf[T any](value *T) *T {
+	if value == nil {
+		return nil
+	}
+	c := *value
+	return &c
+}
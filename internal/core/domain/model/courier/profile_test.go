@@ -0,0 +1,170 @@
+package courier_test
+
+import (
+	"strings"
+	"testing"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPhotoURL(t *testing.T) {
+	t.Run("should accept absolute https url", func(t *testing.T) {
+		photo, err := courier.NewPhotoURL(" https://cdn.example.com/couriers/42.jpg ")
+
+		require.NoError(t, err)
+		require.NoError(t, photo.Validate())
+		assert.Equal(t, "https://cdn.example.com/couriers/42.jpg", photo.String())
+	})
+
+	tests := []struct {
+		name     string
+		value    string
+		expected error
+	}{
+		{"blank url", " ", errs.ErrValueIsRequired},
+		{"relative url", "/couriers/42.jpg", errs.ErrValueIsInvalid},
+		{"unsupported scheme", "ftp://cdn.example.com/42.jpg", errs.ErrValueIsInvalid},
+		{"missing host", "https:///42.jpg", errs.ErrValueIsInvalid},
+		{"too long url", "https://cdn.example.com/" + strings.Repeat("x", courier.MaxPhotoURLLength), errs.ErrValueIsInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run("should reject "+tt.name, func(t *testing.T) {
+			_, err := courier.NewPhotoURL(tt.value)
+
+			require.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestNewPhone(t *testing.T) {
+	t.Run("should normalize formatted number", func(t *testing.T) {
+		phone, err := courier.NewPhone("+7 (912) 345-67-89")
+
+		require.NoError(t, err)
+		require.NoError(t, phone.Validate())
+		assert.Equal(t, "+79123456789", phone.String())
+	})
+
+	tests := []struct {
+		name     string
+		value    string
+		expected error
+	}{
+		{"blank number", " - ", errs.ErrValueIsRequired},
+		{"missing plus", "79123456789", errs.ErrValueIsInvalid},
+		{"leading zero", "+0123456789", errs.ErrValueIsInvalid},
+		{"too short number", "+7912345", errs.ErrValueIsInvalid},
+		{"too long number", "+7912345678901234", errs.ErrValueIsInvalid},
+		{"letters", "+7912CALLME", errs.ErrValueIsInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run("should reject "+tt.name, func(t *testing.T) {
+			_, err := courier.NewPhone(tt.value)
+
+			require.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestNewVehiclePlate(t *testing.T) {
+	t.Run("should normalize case and spaces", func(t *testing.T) {
+		plate, err := courier.NewVehiclePlate("  а123вс   77 ")
+
+		require.NoError(t, err)
+		require.NoError(t, plate.Validate())
+		assert.Equal(t, "А123ВС 77", plate.String())
+	})
+
+	tests := []struct {
+		name     string
+		value    string
+		expected error
+	}{
+		{"blank plate", "   ", errs.ErrValueIsRequired},
+		{"too short plate", "1", errs.ErrValueIsOutOfRange},
+		{"too long plate", "AB1234567890C", errs.ErrValueIsOutOfRange},
+		{"punctuation", "AB#123", errs.ErrValueIsInvalid},
+		{"no digits", "ABCDEF", errs.ErrValueIsInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run("should reject "+tt.name, func(t *testing.T) {
+			_, err := courier.NewVehiclePlate(tt.value)
+
+			require.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestProfileValueObjects_NotConstructedViaConstructor(t *testing.T) {
+	require.ErrorIs(t, courier.PhotoURL{}.Validate(), courier.ErrPhotoURLIsNotConstructed)
+	require.ErrorIs(t, courier.Phone{}.Validate(), courier.ErrPhoneIsNotConstructed)
+	require.ErrorIs(t, courier.VehiclePlate{}.Validate(), courier.ErrVehiclePlateIsNotConstructed)
+}
+
+func TestNewProfile(t *testing.T) {
+	t.Run("should leave blank fields empty", func(t *testing.T) {
+		profile, err := courier.NewProfile("https://cdn.example.com/42.jpg", " ", "")
+
+		require.NoError(t, err)
+		require.NotNil(t, profile.PhotoURL())
+		assert.Equal(t, "https://cdn.example.com/42.jpg", profile.PhotoURL().String())
+		assert.Nil(t, profile.Phone())
+		assert.Nil(t, profile.VehiclePlate())
+		assert.False(t, profile.IsEmpty())
+	})
+
+	t.Run("should join errors of all invalid fields", func(t *testing.T) {
+		_, err := courier.NewProfile("ftp://cdn.example.com/42.jpg", "12345", "AB#1")
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+		assert.Contains(t, err.Error(), "photo url")
+		assert.Contains(t, err.Error(), "phone")
+		assert.Contains(t, err.Error(), "vehicle plate")
+	})
+
+	t.Run("zero value is empty", func(t *testing.T) {
+		assert.True(t, courier.Profile{}.IsEmpty())
+	})
+}
+
+func TestProfile_WithVehicleType(t *testing.T) {
+	t.Run("should set vehicle type", func(t *testing.T) {
+		profile, err := courier.Profile{}.WithVehicleType(courier.Car)
+
+		require.NoError(t, err)
+		require.NotNil(t, profile.VehicleType())
+		assert.Equal(t, courier.Car, *profile.VehicleType())
+		assert.False(t, profile.IsEmpty())
+	})
+
+	t.Run("should reject unknown vehicle type", func(t *testing.T) {
+		_, err := courier.Profile{}.WithVehicleType(courier.UnknownVehicleType)
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+}
+
+func TestCourier_ChangeProfile(t *testing.T) {
+	location, _ := kernel.NewLocation(1, 1)
+	c, err := courier.NewCourier(kernel.NewUUID(), "Alice", 2, location)
+	require.NoError(t, err)
+	assert.True(t, c.Profile().IsEmpty())
+
+	profile, err := courier.NewProfile("https://cdn.example.com/42.jpg", "+79123456789", "A123BC77")
+	require.NoError(t, err)
+
+	c.ChangeProfile(profile)
+	assert.Equal(t, "+79123456789", c.Profile().Phone().String())
+	assert.Equal(t, "A123BC77", c.Profile().VehiclePlate().String())
+
+	c.ChangeProfile(courier.Profile{})
+	assert.True(t, c.Profile().IsEmpty())
+}
@@ -0,0 +1,276 @@
+package courier
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// dayLength is the length of the day schedule windows lie within.
+const dayLength = 24 * time.Hour
+
+var (
+	// ErrScheduleWindowIsNotConstructed indicates that a ScheduleWindow was not properly
+	// initialized through the NewScheduleWindow constructor.
+	ErrScheduleWindowIsNotConstructed = errors.New(
+		"ScheduleWindow must be created via NewScheduleWindow constructor",
+	)
+
+	// ErrScheduleIsNotConstructed indicates that a Schedule was not properly
+	// initialized through the NewSchedule constructor.
+	ErrScheduleIsNotConstructed = errors.New("Schedule must be created via NewSchedule constructor")
+
+	// ErrScheduleWindowsOverlap is returned when two windows of a schedule share a moment.
+	ErrScheduleWindowsOverlap = errors.New("schedule windows overlap")
+)
+
+// ScheduleWindow is a weekly working period of a courier, such as Mondays from 09:00 to 17:00.
+// Times are in UTC, like the working hours. A window lies within one day, so a night shift
+// is two windows: one ending at 24:00 and one starting at 00:00 on the next weekday.
+//
+// Key business rules:
+//   - Must be constructed through NewScheduleWindow
+//   - The window starts and ends on whole minutes, starts before it ends and ends at 24:00 at the latest
+type ScheduleWindow struct {
+	// weekday is the day of the week the window recurs on
+	weekday time.Weekday
+
+	// start is when the window opens, as the time since midnight
+	start time.Duration
+
+	// end is when the window closes, as the time since midnight
+	end time.Duration
+
+	// guard ensures the window was created via NewScheduleWindow
+	guard guard.ConstructorGuard
+}
+
+// NewScheduleWindow creates a window recurring on weekday from start to end, both given as
+// the time since midnight UTC.
+//
+// Example:
+//
+//	// Mondays from 09:00 to 17:00
+//	window, err := courier.NewScheduleWindow(time.Monday, 9*time.Hour, 17*time.Hour)
+func NewScheduleWindow(weekday time.Weekday, start, end time.Duration) (ScheduleWindow, error) {
+	if err := errs.JoinFields(
+		errs.Field("weekday", validateWeekday(weekday)),
+		errs.Field("start", validateScheduleTime("start", start)),
+		errs.Field("end", validateScheduleEnd(start, end)),
+	); err != nil {
+		return ScheduleWindow{}, err
+	}
+
+	return ScheduleWindow{
+		weekday: weekday,
+		start:   start,
+		end:     end,
+		guard:   guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the ScheduleWindow was created through NewScheduleWindow.
+func (w ScheduleWindow) Validate() error {
+	return w.guard.Validate(ErrScheduleWindowIsNotConstructed)
+}
+
+// Weekday returns the day of the week the window recurs on.
+func (w ScheduleWindow) Weekday() time.Weekday {
+	return w.weekday
+}
+
+// Start returns when the window opens, as the time since midnight UTC.
+func (w ScheduleWindow) Start() time.Duration {
+	return w.start
+}
+
+// End returns when the window closes, as the time since midnight UTC.
+func (w ScheduleWindow) End() time.Duration {
+	return w.end
+}
+
+// Contains reports whether now falls within the window. The window includes its start but not its end.
+func (w ScheduleWindow) Contains(now time.Time) bool {
+	now = now.UTC()
+	if now.Weekday() != w.weekday {
+		return false
+	}
+
+	sinceMidnight := now.Sub(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC))
+	return sinceMidnight >= w.start && sinceMidnight < w.end
+}
+
+// Overlaps reports whether the two windows share any moment. Adjacent windows do not overlap.
+func (w ScheduleWindow) Overlaps(other ScheduleWindow) bool {
+	return w.weekday == other.weekday && w.start < other.end && other.start < w.end
+}
+
+func validateWeekday(weekday time.Weekday) error {
+	if weekday < time.Sunday || weekday > time.Saturday {
+		return errs.NewValueIsOutOfRangeError("weekday", int(weekday), int(time.Sunday), int(time.Saturday))
+	}
+	return nil
+}
+
+func validateScheduleTime(name string, value time.Duration) error {
+	if value < 0 || value > dayLength {
+		return errs.NewValueIsOutOfRangeError(name, value.String(), "0s", dayLength.String())
+	}
+	if value%time.Minute != 0 {
+		return errs.NewValueIsInvalidErrorWithCause(
+			name,
+			fmt.Errorf("%s is not a whole number of minutes", value),
+		)
+	}
+	return nil
+}
+
+func validateScheduleEnd(start, end time.Duration) error {
+	if err := validateScheduleTime("end", end); err != nil {
+		return err
+	}
+	if end <= start {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"end",
+			fmt.Errorf("end %s must be after start %s", end, start),
+		)
+	}
+	return nil
+}
+
+// Schedule is the weekly working schedule of a courier: the windows in which the courier may be
+// dispatched. A courier without a schedule is dispatched whenever on shift.
+//
+// Key business rules:
+//   - Must be constructed through NewSchedule
+//   - A schedule has at least one window, and its windows do not overlap
+type Schedule struct {
+	// id uniquely identifies the schedule; a replaced schedule gets a new ID
+	id kernel.UUID
+
+	// windows are the working windows, by weekday from Sunday and then by start
+	windows []ScheduleWindow
+
+	// guard ensures the schedule was created via NewSchedule
+	guard guard.ConstructorGuard
+}
+
+// NewSchedule creates a schedule of the given windows.
+// Used both when setting a schedule and when restoring schedules from persistent storage.
+// Returns ErrScheduleWindowsOverlap if two windows overlap.
+//
+// Example:
+//
+//	weekdays := make([]courier.ScheduleWindow, 0, 5)
+//	for day := time.Monday; day <= time.Friday; day++ {
+//	    window, _ := courier.NewScheduleWindow(day, 9*time.Hour, 17*time.Hour)
+//	    weekdays = append(weekdays, window)
+//	}
+//	schedule, err := courier.NewSchedule(kernel.NewUUID(), weekdays)
+func NewSchedule(id kernel.UUID, windows []ScheduleWindow) (Schedule, error) {
+	if err := id.Validate(); err != nil {
+		return Schedule{}, err
+	}
+	if len(windows) == 0 {
+		return Schedule{}, errs.NewValueIsRequiredError("windows")
+	}
+
+	sorted := make([]ScheduleWindow, 0, len(windows))
+	for _, window := range windows {
+		if err := window.Validate(); err != nil {
+			return Schedule{}, err
+		}
+		for _, other := range sorted {
+			if window.Overlaps(other) {
+				return Schedule{}, ErrScheduleWindowsOverlap
+			}
+		}
+		sorted = append(sorted, window)
+	}
+	slices.SortFunc(sorted, func(a, b ScheduleWindow) int {
+		return cmp.Or(cmp.Compare(a.weekday, b.weekday), cmp.Compare(a.start, b.start))
+	})
+
+	return Schedule{
+		id:      id,
+		windows: sorted,
+		guard:   guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the Schedule was created through NewSchedule.
+func (s Schedule) Validate() error {
+	return s.guard.Validate(ErrScheduleIsNotConstructed)
+}
+
+// ID returns the schedule's unique identifier.
+func (s Schedule) ID() kernel.UUID {
+	return s.id
+}
+
+// Windows returns a copy of the schedule's windows, by weekday from Sunday and then by start.
+func (s Schedule) Windows() []ScheduleWindow {
+	return slices.Clone(s.windows)
+}
+
+// Covers reports whether now falls within one of the schedule's windows.
+func (s Schedule) Covers(now time.Time) bool {
+	return slices.ContainsFunc(s.windows, func(window ScheduleWindow) bool {
+		return window.Contains(now)
+	})
+}
+
+// SetSchedule replaces the courier's working schedule. The courier is then dispatched only
+// within the windows of the schedule; the running shift and the orders carried are not affected.
+// Returns ErrScheduleIsNotConstructed for an invalid schedule.
+//
+// Example:
+//
+//	schedule, _ := courier.NewSchedule(kernel.NewUUID(), windows)
+//	if err := c.SetSchedule(schedule); err != nil {
+//	    return err
+//	}
+func (c *Courier) SetSchedule(schedule Schedule) error {
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+
+	c.schedule = &schedule
+	return nil
+}
+
+// ClearSchedule removes the courier's working schedule, so the courier is dispatched whenever on shift.
+func (c *Courier) ClearSchedule() {
+	c.schedule = nil
+}
+
+// Schedule returns a copy of the courier's working schedule, nil if the courier has none.
+func (c *Courier) Schedule() *Schedule {
+	if c.schedule == nil {
+		return nil
+	}
+	schedule := *c.schedule
+	return &schedule
+}
+
+// IsWithinSchedule reports whether the courier may be dispatched at now under the working schedule.
+// Couriers without a schedule always may.
+func (c *Courier) IsWithinSchedule(now time.Time) bool {
+	return c.schedule == nil || c.schedule.Covers(now)
+}
+
+// RestoreSchedule reapplies a persisted working schedule; nil restores a courier without one.
+// Intended for repositories rebuilding the aggregate.
+func (c *Courier) RestoreSchedule(schedule *Schedule) error {
+	if schedule == nil {
+		c.schedule = nil
+		return nil
+	}
+	return c.SetSchedule(*schedule)
+}
@@ -0,0 +1,140 @@
+package courier_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// monday is a Monday, 2025-03-03, at midnight UTC.
+var monday = time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+
+func TestNewScheduleWindow(t *testing.T) {
+	t.Run("should create valid window", func(t *testing.T) {
+		window, err := courier.NewScheduleWindow(time.Monday, 9*time.Hour, 17*time.Hour)
+
+		require.NoError(t, err)
+		require.NoError(t, window.Validate())
+		assert.Equal(t, time.Monday, window.Weekday())
+		assert.Equal(t, 9*time.Hour, window.Start())
+		assert.Equal(t, 17*time.Hour, window.End())
+	})
+
+	t.Run("should allow a window ending at midnight", func(t *testing.T) {
+		_, err := courier.NewScheduleWindow(time.Friday, 22*time.Hour, 24*time.Hour)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail with invalid bounds", func(t *testing.T) {
+		_, err := courier.NewScheduleWindow(time.Weekday(7), 9*time.Hour, 17*time.Hour)
+		require.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+
+		_, err = courier.NewScheduleWindow(time.Monday, 17*time.Hour, 9*time.Hour)
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+
+		_, err = courier.NewScheduleWindow(time.Monday, 9*time.Hour, 25*time.Hour)
+		require.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+
+		_, err = courier.NewScheduleWindow(time.Monday, 9*time.Hour+30*time.Second, 17*time.Hour)
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, courier.ScheduleWindow{}.Validate(), courier.ErrScheduleWindowIsNotConstructed)
+	})
+}
+
+func TestScheduleWindow_Contains(t *testing.T) {
+	window, err := courier.NewScheduleWindow(time.Monday, 9*time.Hour, 17*time.Hour)
+	require.NoError(t, err)
+
+	assert.False(t, window.Contains(monday.Add(9*time.Hour-time.Minute)))
+	assert.True(t, window.Contains(monday.Add(9*time.Hour)))
+	assert.True(t, window.Contains(monday.Add(17*time.Hour-time.Second)))
+	assert.False(t, window.Contains(monday.Add(17*time.Hour)))
+	assert.False(t, window.Contains(monday.Add(24*time.Hour+12*time.Hour)))
+
+	// Times are compared in UTC whatever the location of now
+	moscow := time.FixedZone("MSK", 3*60*60)
+	assert.True(t, window.Contains(monday.Add(9*time.Hour).In(moscow)))
+}
+
+func TestNewSchedule(t *testing.T) {
+	morning, _ := courier.NewScheduleWindow(time.Tuesday, 8*time.Hour, 12*time.Hour)
+	afternoon, _ := courier.NewScheduleWindow(time.Tuesday, 12*time.Hour, 16*time.Hour)
+	mondays, _ := courier.NewScheduleWindow(time.Monday, 10*time.Hour, 18*time.Hour)
+
+	t.Run("should order windows by weekday and start", func(t *testing.T) {
+		schedule, err := courier.NewSchedule(kernel.NewUUID(), []courier.ScheduleWindow{afternoon, mondays, morning})
+
+		require.NoError(t, err)
+		require.NoError(t, schedule.Validate())
+		assert.Equal(t, []courier.ScheduleWindow{mondays, morning, afternoon}, schedule.Windows())
+	})
+
+	t.Run("should fail with overlapping windows", func(t *testing.T) {
+		long, _ := courier.NewScheduleWindow(time.Tuesday, 10*time.Hour, 14*time.Hour)
+
+		_, err := courier.NewSchedule(kernel.NewUUID(), []courier.ScheduleWindow{morning, long})
+
+		require.ErrorIs(t, err, courier.ErrScheduleWindowsOverlap)
+	})
+
+	t.Run("should fail without windows", func(t *testing.T) {
+		_, err := courier.NewSchedule(kernel.NewUUID(), nil)
+
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+	})
+
+	t.Run("should fail with window not created via constructor", func(t *testing.T) {
+		_, err := courier.NewSchedule(kernel.NewUUID(), []courier.ScheduleWindow{{}})
+
+		require.ErrorIs(t, err, courier.ErrScheduleWindowIsNotConstructed)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, courier.Schedule{}.Validate(), courier.ErrScheduleIsNotConstructed)
+	})
+}
+
+func TestCourier_Schedule(t *testing.T) {
+	location, _ := kernel.NewLocation(1, 1)
+	c, err := courier.NewCourier(kernel.NewUUID(), "Alice", 2, location)
+	require.NoError(t, err)
+
+	t.Run("should dispatch a courier without schedule at any time", func(t *testing.T) {
+		assert.Nil(t, c.Schedule())
+		assert.True(t, c.IsWithinSchedule(monday.Add(3*time.Hour)))
+	})
+
+	t.Run("should dispatch a courier with schedule only within its windows", func(t *testing.T) {
+		window, _ := courier.NewScheduleWindow(time.Monday, 9*time.Hour, 17*time.Hour)
+		schedule, _ := courier.NewSchedule(kernel.NewUUID(), []courier.ScheduleWindow{window})
+
+		require.NoError(t, c.SetSchedule(schedule))
+
+		require.NotNil(t, c.Schedule())
+		assert.Equal(t, schedule.ID(), c.Schedule().ID())
+		assert.True(t, c.IsWithinSchedule(monday.Add(10*time.Hour)))
+		assert.False(t, c.IsWithinSchedule(monday.Add(3*time.Hour)))
+		assert.False(t, c.IsWithinSchedule(monday.Add(24*time.Hour+10*time.Hour)))
+	})
+
+	t.Run("should dispatch at any time once the schedule is cleared", func(t *testing.T) {
+		c.ClearSchedule()
+
+		assert.Nil(t, c.Schedule())
+		assert.True(t, c.IsWithinSchedule(monday.Add(3*time.Hour)))
+	})
+
+	t.Run("should reject a schedule not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, c.SetSchedule(courier.Schedule{}), courier.ErrScheduleIsNotConstructed)
+	})
+}
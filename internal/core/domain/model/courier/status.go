@@ -0,0 +1,73 @@
+package courier
+
+import (
+	"errors"
+	"fmt"
+
+	"delivery/internal/pkg/errs"
+)
+
+var (
+	// ErrStatusTransitionIsInvalid is returned when the courier's status cannot change as requested.
+	ErrStatusTransitionIsInvalid = errors.New("courier status transition is invalid")
+)
+
+// Status is where a courier stands in the working day.
+// The zero value Offline means the courier does not work right now.
+//
+// Lifecycle:
+//   - Offline -> Available: the courier starts a shift, or goes online again during it
+//   - Available -> Busy: the courier takes an order, and back once the storage is empty
+//   - Available or Busy -> OnBreak -> Available or Busy: the courier takes a break during the
+//     shift, keeping the orders it carries
+//   - Available or OnBreak -> Offline: a courier carrying no orders goes offline; ending the
+//     shift or being deactivated takes the courier offline from any status
+type Status int
+
+const (
+	// Offline marks a courier who is off shift or went offline during it.
+	Offline Status = iota
+
+	// Available marks a courier on shift who is waiting for orders.
+	Available
+
+	// Busy marks a courier on shift who carries at least one order.
+	Busy
+
+	// OnBreak marks a courier on shift who takes a break and receives no orders.
+	OnBreak
+)
+
+// getValidStatusStrings returns a map of valid Status values to their string representations.
+func getValidStatusStrings() map[Status]string {
+	return map[Status]string{
+		Offline:   "Offline",
+		Available: "Available",
+		Busy:      "Busy",
+		OnBreak:   "OnBreak",
+	}
+}
+
+// Validate checks if the Status value is a known status.
+func (s Status) Validate() error {
+	if _, ok := getValidStatusStrings()[s]; !ok {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"courier status is invalid",
+			fmt.Errorf("%d is not a valid courier status", s),
+		)
+	}
+	return nil
+}
+
+// String returns the human-readable name of the status, "Unknown" for invalid values.
+func (s Status) String() string {
+	if str, ok := getValidStatusStrings()[s]; ok {
+		return str
+	}
+	return "Unknown"
+}
+
+// transitionError reports a refused status change.
+func transitionError(from Status, to Status) error {
+	return fmt.Errorf("%w: %s to %s", ErrStatusTransitionIsInvalid, from, to)
+}
@@ -0,0 +1,157 @@
+package courier_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatus_Validate(t *testing.T) {
+	for _, status := range []courier.Status{courier.Offline, courier.Available, courier.Busy, courier.OnBreak} {
+		require.NoError(t, status.Validate())
+	}
+
+	require.ErrorIs(t, courier.Status(42).Validate(), errs.ErrValueIsInvalid)
+}
+
+func TestStatus_String(t *testing.T) {
+	assert.Equal(t, "Offline", courier.Offline.String())
+	assert.Equal(t, "Available", courier.Available.String())
+	assert.Equal(t, "Busy", courier.Busy.String())
+	assert.Equal(t, "OnBreak", courier.OnBreak.String())
+	assert.Equal(t, "Unknown", courier.Status(42).String())
+}
+
+func TestCourier_Status(t *testing.T) {
+	limit := createWorkingHoursLimit(t)
+	morning := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+
+	startShift := func(t *testing.T) *courier.Courier {
+		t.Helper()
+		c := createValidCourier(t)
+		require.NoError(t, c.StartShift(morning, limit))
+		return c
+	}
+
+	t.Run("new courier is offline", func(t *testing.T) {
+		c := createValidCourier(t)
+
+		assert.Equal(t, courier.Offline, c.Status())
+		assert.False(t, c.IsAvailable())
+	})
+
+	t.Run("should follow the shift", func(t *testing.T) {
+		c := startShift(t)
+		assert.Equal(t, courier.Available, c.Status())
+		assert.True(t, c.IsAvailable())
+
+		require.NoError(t, c.EndShift(morning.Add(time.Hour)))
+		assert.Equal(t, courier.Offline, c.Status())
+	})
+
+	t.Run("should be busy while carrying orders", func(t *testing.T) {
+		c := startShift(t)
+		require.NoError(t, c.AddStoragePlace("Багажник", 20))
+		first := createValidOrder(t, 5)
+		second := createValidOrder(t, 5)
+
+		require.NoError(t, c.TakeOrder(first))
+		require.NoError(t, c.TakeOrder(second))
+		assert.Equal(t, courier.Busy, c.Status())
+
+		require.NoError(t, c.CompleteOrder(first.ID()))
+		assert.Equal(t, courier.Busy, c.Status())
+
+		require.NoError(t, c.ReleaseOrder(second.ID()))
+		assert.Equal(t, courier.Available, c.Status())
+	})
+
+	t.Run("should take and end a break", func(t *testing.T) {
+		c := startShift(t)
+
+		require.NoError(t, c.StartBreak())
+		require.NoError(t, c.StartBreak())
+		assert.Equal(t, courier.OnBreak, c.Status())
+
+		require.NoError(t, c.GoOnline())
+		assert.Equal(t, courier.Available, c.Status())
+	})
+
+	t.Run("should go offline and back online during the shift", func(t *testing.T) {
+		c := startShift(t)
+		require.NoError(t, c.StartBreak())
+
+		require.NoError(t, c.SetOffline())
+		require.NoError(t, c.SetOffline())
+		assert.Equal(t, courier.Offline, c.Status())
+		require.ErrorIs(t, c.StartBreak(), courier.ErrStatusTransitionIsInvalid)
+
+		require.NoError(t, c.GoOnline())
+		assert.Equal(t, courier.Available, c.Status())
+	})
+
+	t.Run("should refuse to go online off shift", func(t *testing.T) {
+		c := createValidCourier(t)
+
+		require.ErrorIs(t, c.GoOnline(), courier.ErrShiftIsNotStarted)
+		assert.Equal(t, courier.Offline, c.Status())
+	})
+
+	t.Run("should refuse going offline while busy", func(t *testing.T) {
+		c := startShift(t)
+		require.NoError(t, c.TakeOrder(createValidOrder(t, 5)))
+
+		require.ErrorIs(t, c.SetOffline(), courier.ErrStatusTransitionIsInvalid)
+		require.ErrorIs(t, c.GoOnline(), courier.ErrStatusTransitionIsInvalid)
+		assert.Equal(t, courier.Busy, c.Status())
+	})
+
+	t.Run("should take a break while carrying orders", func(t *testing.T) {
+		c := startShift(t)
+		require.NoError(t, c.TakeOrder(createValidOrder(t, 5)))
+
+		require.NoError(t, c.StartBreak())
+		assert.True(t, c.IsOnBreak())
+		require.ErrorIs(t, c.SetOffline(), courier.ErrStatusTransitionIsInvalid)
+
+		require.NoError(t, c.GoOnline())
+		assert.Equal(t, courier.Busy, c.Status())
+		assert.False(t, c.IsOnBreak())
+	})
+
+	t.Run("should come back busy when carrying orders", func(t *testing.T) {
+		c := startShift(t)
+		require.NoError(t, c.StartBreak())
+		require.NoError(t, c.TakeOrder(createValidOrder(t, 5)))
+		assert.Equal(t, courier.OnBreak, c.Status())
+
+		require.NoError(t, c.GoOnline())
+		assert.Equal(t, courier.Busy, c.Status())
+	})
+
+	t.Run("should go offline when deactivated", func(t *testing.T) {
+		c := startShift(t)
+		require.NoError(t, c.TakeOrder(createValidOrder(t, 5)))
+
+		_, err := c.Deactivate(courier.WentOffline)
+
+		require.NoError(t, err)
+		assert.Equal(t, courier.Offline, c.Status())
+		require.ErrorIs(t, c.GoOnline(), courier.ErrCourierIsDeactivated)
+	})
+
+	t.Run("should restore status", func(t *testing.T) {
+		c := createValidCourier(t)
+
+		require.NoError(t, c.RestoreStatus(courier.OnBreak))
+		assert.Equal(t, courier.OnBreak, c.Status())
+
+		require.ErrorIs(t, c.RestoreStatus(courier.Status(42)), errs.ErrValueIsInvalid)
+		assert.Equal(t, courier.OnBreak, c.Status())
+	})
+}
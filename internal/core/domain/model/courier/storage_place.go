@@ -22,6 +22,10 @@ var (
 	// ErrStoragePlaceIsNotConstructed indicates that the StoragePlace was not
 	// properly initialized through the NewStoragePlace constructor function.
 	ErrStoragePlaceIsNotConstructed = errors.New("StoragePlace must be created via NewStoragePlace constructor")
+
+	// ErrStoragePlaceIsOccupied indicates that the storage place cannot be taken
+	// out of service, resized or removed while it still holds an order.
+	ErrStoragePlaceIsOccupied = errors.New("storage place is occupied")
 )
 
 // StoragePlace represents a physical storage location where orders can be temporarily stored
@@ -37,6 +41,7 @@ var (
 //   - Can only store one order at a time (binary occupancy)
 //   - Order volume must not exceed storage place capacity
 //   - Only the stored order can be cleared from the storage place
+//   - An out-of-service place cannot store orders and must be empty to enter maintenance
 //
 // Example usage:
 //
@@ -72,6 +77,12 @@ type StoragePlace struct {
 	// orderID points to the currently stored order, nil if empty
 	orderID *kernel.UUID
 
+	// outOfService marks the storage place as under maintenance (e.g. broken zipper)
+	outOfService bool
+
+	// thermal marks an insulated storage place that keeps chilled and frozen orders cold
+	thermal bool
+
 	// guard ensures the entity was properly initialized
 	guard guard.ConstructorGuard
 }
@@ -107,7 +118,11 @@ func NewStoragePlace(id kernel.UUID, name string, totalVolume int) (*StoragePlac
 		guard: guard.NewConstructorGuard(),
 	}
 
-	if err := errors.Join(place.setID(id), place.setName(name), place.setTotalVolume(totalVolume)); err != nil {
+	if err := errs.JoinFields(
+		errs.Field("id", place.setID(id)),
+		errs.Field("name", place.setName(name)),
+		errs.Field("totalVolume", place.setTotalVolume(totalVolume)),
+	); err != nil {
 		return nil, err
 	}
 
@@ -156,11 +171,11 @@ func RestoreStoragePlace(id kernel.UUID, name string, totalVolume int, orderID *
 		guard: guard.NewConstructorGuard(),
 	}
 
-	if err := errors.Join(
-		place.setID(id),
-		place.setName(name),
-		place.setTotalVolume(totalVolume),
-		place.setOrderID(orderID),
+	if err := errs.JoinFields(
+		errs.Field("id", place.setID(id)),
+		errs.Field("name", place.setName(name)),
+		errs.Field("totalVolume", place.setTotalVolume(totalVolume)),
+		errs.Field("orderId", place.setOrderID(orderID)),
 	); err != nil {
 		return nil, err
 	}
@@ -223,12 +238,87 @@ func (s *StoragePlace) OrderID() *kernel.UUID {
 	return s.orderID
 }
 
+// IsOutOfService reports whether the storage place is under maintenance.
+//
+// Returns:
+//   - bool: True if the storage place cannot be used for new orders
+func (s *StoragePlace) IsOutOfService() bool {
+	return s.outOfService
+}
+
+// IsThermal reports whether the storage place is insulated and keeps orders cold.
+func (s *StoragePlace) IsThermal() bool {
+	return s.thermal
+}
+
+// MarkThermal records that the storage place is insulated, e.g. a thermal bag.
+// Dispatch matching rules may require orders to travel in a thermal storage place.
+func (s *StoragePlace) MarkThermal() {
+	s.thermal = true
+}
+
+// StartMaintenance takes the storage place out of service so that no new orders
+// are stored in it. Other storage places of the courier remain usable.
+//
+// Business rules enforced:
+//   - Storage place must be empty; an order in transit must be delivered first
+//   - Starting maintenance on a place that is already out of service has no effect
+//
+// Returns:
+//   - error: ErrStoragePlaceIsOccupied if the place holds an order
+//
+// Example:
+//
+//	if err := place.StartMaintenance(); err != nil {
+//	    if errors.Is(err, courier.ErrStoragePlaceIsOccupied) {
+//	        return errors.New("deliver the order before repairing the bag")
+//	    }
+//	    return err
+//	}
+func (s *StoragePlace) StartMaintenance() error {
+	if s.isOccupied() {
+		return ErrStoragePlaceIsOccupied
+	}
+
+	s.outOfService = true
+	return nil
+}
+
+// FinishMaintenance returns the storage place to service.
+// Finishing maintenance on a place that is in service has no effect.
+func (s *StoragePlace) FinishMaintenance() {
+	s.outOfService = false
+}
+
+// Resize changes the maximum volume capacity of the storage place.
+//
+// Business rules enforced:
+//   - Storage place must be empty, so the order it holds always fits
+//   - Volume must be positive (greater than 0)
+//
+// Returns:
+//   - error: ErrStoragePlaceIsOccupied if the place holds an order, or a validation error if the volume is invalid
+//
+// Example:
+//
+//	if err := place.Resize(25); err != nil {
+//	    return err
+//	}
+func (s *StoragePlace) Resize(totalVolume int) error {
+	if s.isOccupied() {
+		return ErrStoragePlaceIsOccupied
+	}
+
+	return s.setTotalVolume(totalVolume)
+}
+
 // CanStore determines whether an order with the specified volume can be stored
 // in this storage place. This method checks both the availability of the storage
 // place and whether it has sufficient capacity.
 //
 // Business rules enforced:
 //   - Volume must be positive (greater than 0)
+//   - Storage place must be in service
 //   - Storage place must not be currently occupied
 //   - Available volume must be sufficient for the order
 //
@@ -256,7 +346,7 @@ func (s *StoragePlace) CanStore(volume int) (bool, error) {
 		)
 	}
 
-	return !s.isOccupied() && s.totalVolume >= volume, nil
+	return !s.outOfService && !s.isOccupied() && s.totalVolume >= volume, nil
 }
 
 // Store places an order in this storage place, marking it as occupied.
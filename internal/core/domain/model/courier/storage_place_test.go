@@ -488,6 +488,75 @@ func TestStoragePlace_Clear(t *testing.T) {
 	})
 }
 
+func TestStoragePlace_Maintenance(t *testing.T) {
+	t.Run("should reject orders while out of service", func(t *testing.T) {
+		place, err := courier.NewStoragePlace(kernel.NewUUID(), "Backpack", 10)
+		require.NoError(t, err)
+
+		require.NoError(t, place.StartMaintenance())
+		assert.True(t, place.IsOutOfService())
+
+		canStore, err := place.CanStore(5)
+		require.NoError(t, err)
+		assert.False(t, canStore)
+		require.ErrorIs(t, place.Store(kernel.NewUUID(), 5), courier.ErrCannotStoreOrderInThisStoragePlace)
+	})
+
+	t.Run("should accept orders again after maintenance", func(t *testing.T) {
+		place, err := courier.NewStoragePlace(kernel.NewUUID(), "Backpack", 10)
+		require.NoError(t, err)
+		require.NoError(t, place.StartMaintenance())
+
+		place.FinishMaintenance()
+
+		assert.False(t, place.IsOutOfService())
+		canStore, err := place.CanStore(5)
+		require.NoError(t, err)
+		assert.True(t, canStore)
+	})
+
+	t.Run("should not start maintenance on occupied place", func(t *testing.T) {
+		place, err := courier.NewStoragePlace(kernel.NewUUID(), "Backpack", 10)
+		require.NoError(t, err)
+		require.NoError(t, place.Store(kernel.NewUUID(), 5))
+
+		err = place.StartMaintenance()
+
+		require.ErrorIs(t, err, courier.ErrStoragePlaceIsOccupied)
+		assert.False(t, place.IsOutOfService())
+	})
+}
+
+func TestStoragePlace_Resize(t *testing.T) {
+	t.Run("should change the volume of an empty place", func(t *testing.T) {
+		place, err := courier.NewStoragePlace(kernel.NewUUID(), "Backpack", 10)
+		require.NoError(t, err)
+
+		require.NoError(t, place.Resize(25))
+
+		assert.Equal(t, 25, place.TotalVolume())
+	})
+
+	t.Run("should not resize an occupied place", func(t *testing.T) {
+		place, err := courier.NewStoragePlace(kernel.NewUUID(), "Backpack", 10)
+		require.NoError(t, err)
+		require.NoError(t, place.Store(kernel.NewUUID(), 5))
+
+		err = place.Resize(25)
+
+		require.ErrorIs(t, err, courier.ErrStoragePlaceIsOccupied)
+		assert.Equal(t, 10, place.TotalVolume())
+	})
+
+	t.Run("should reject non-positive volume", func(t *testing.T) {
+		place, err := courier.NewStoragePlace(kernel.NewUUID(), "Backpack", 10)
+		require.NoError(t, err)
+
+		require.ErrorIs(t, place.Resize(-1), errs.ErrValueIsInvalid)
+		assert.Equal(t, 10, place.TotalVolume())
+	})
+}
+
 func TestStoragePlace_Validate(t *testing.T) {
 	t.Run("should return nil for properly constructed storage place", func(t *testing.T) {
 		place := createValidStoragePlace(t)
@@ -0,0 +1,61 @@
+package courier
+
+import (
+	"fmt"
+
+	"delivery/internal/pkg/errs"
+)
+
+// VehicleType is the kind of vehicle a courier delivers with. Dispatch matching rules
+// may restrict orders to some vehicle types, see the matching package.
+type VehicleType int
+
+const (
+	// UnknownVehicleType represents an invalid or undefined vehicle type.
+	// This value (0) helps catch uninitialized VehicleType values.
+	UnknownVehicleType VehicleType = iota
+
+	// Bicycle couriers ride a bicycle.
+	Bicycle
+
+	// Scooter couriers ride a motor scooter.
+	Scooter
+
+	// Car couriers drive a car.
+	Car
+)
+
+// getValidVehicleTypeStrings returns a map of valid VehicleType values to their string representations.
+func getValidVehicleTypeStrings() map[VehicleType]string {
+	//nolint:exhaustive // UnknownVehicleType is intentionally excluded as it's invalid
+	return map[VehicleType]string{
+		Bicycle: "Bicycle",
+		Scooter: "Scooter",
+		Car:     "Car",
+	}
+}
+
+// GetValidVehicleTypes returns every valid vehicle type.
+func GetValidVehicleTypes() []VehicleType {
+	return []VehicleType{Bicycle, Scooter, Car}
+}
+
+// Validate checks if the VehicleType value is valid.
+func (t VehicleType) Validate() error {
+	if _, ok := getValidVehicleTypeStrings()[t]; !ok {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"vehicle type is invalid",
+			fmt.Errorf("%d is not a valid vehicle type", t),
+		)
+	}
+	return nil
+}
+
+// String returns the human-readable name of the vehicle type.
+// Returns "Unknown" for invalid vehicle type values.
+func (t VehicleType) String() string {
+	if str, ok := getValidVehicleTypeStrings()[t]; ok {
+		return str
+	}
+	return "Unknown"
+}
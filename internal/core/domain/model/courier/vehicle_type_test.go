@@ -0,0 +1,27 @@
+package courier_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVehicleType_Validate(t *testing.T) {
+	for _, vehicleType := range courier.GetValidVehicleTypes() {
+		require.NoError(t, vehicleType.Validate())
+	}
+
+	require.ErrorIs(t, courier.UnknownVehicleType.Validate(), errs.ErrValueIsInvalid)
+	require.ErrorIs(t, courier.VehicleType(42).Validate(), errs.ErrValueIsInvalid)
+}
+
+func TestVehicleType_String(t *testing.T) {
+	assert.Equal(t, "Bicycle", courier.Bicycle.String())
+	assert.Equal(t, "Scooter", courier.Scooter.String())
+	assert.Equal(t, "Car", courier.Car.String())
+	assert.Equal(t, "Unknown", courier.UnknownVehicleType.String())
+}
@@ -0,0 +1,221 @@
+package courier
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+const hoursPerDay = 24 * time.Hour
+
+var (
+	// ErrWorkingHoursLimitIsNotConstructed indicates that a WorkingHoursLimit was not
+	// properly initialized through the NewWorkingHoursLimit constructor.
+	ErrWorkingHoursLimitIsNotConstructed = errors.New(
+		"WorkingHoursLimit must be created via NewWorkingHoursLimit constructor",
+	)
+
+	// ErrShiftIsAlreadyStarted is returned when starting a shift while the courier is on shift.
+	ErrShiftIsAlreadyStarted = errors.New("shift is already started")
+
+	// ErrShiftIsNotStarted is returned when ending a shift while the courier is off shift.
+	ErrShiftIsNotStarted = errors.New("shift is not started")
+
+	// ErrDailyWorkingHoursExceeded is returned when a courier who reached the daily limit starts a shift.
+	ErrDailyWorkingHoursExceeded = errors.New("daily working hours are exceeded")
+)
+
+// WorkingHoursStatus tells how the time a courier worked today relates to the daily limit.
+type WorkingHoursStatus int
+
+const (
+	// WithinLimit means the courier can keep working.
+	WithinLimit WorkingHoursStatus = iota
+
+	// ApproachingLimit means the courier is close to the limit and should be told to wrap up.
+	ApproachingLimit
+
+	// LimitReached means the courier may neither start a shift nor receive orders until tomorrow.
+	LimitReached
+)
+
+// String returns the name of the status as reported by the API.
+func (s WorkingHoursStatus) String() string {
+	switch s {
+	case WithinLimit:
+		return "within_limit"
+	case ApproachingLimit:
+		return "approaching_limit"
+	case LimitReached:
+		return "limit_reached"
+	default:
+		return "unknown"
+	}
+}
+
+// WorkingHoursLimit is the legal maximum of on-shift time per courier per calendar day (UTC),
+// together with how long before the limit couriers are warned.
+//
+// Key business rules:
+//   - Must be constructed through NewWorkingHoursLimit
+//   - The daily limit is positive and no longer than a day
+//   - The warning period is not negative and shorter than the daily limit
+type WorkingHoursLimit struct {
+	// daily is the maximum on-shift time per day
+	daily time.Duration
+
+	// warnBefore is how long before the limit the status becomes ApproachingLimit
+	warnBefore time.Duration
+
+	// guard ensures the limit was created via NewWorkingHoursLimit
+	guard guard.ConstructorGuard
+}
+
+// NewWorkingHoursLimit creates a daily working hours limit with validation.
+//
+// Example:
+//
+//	limit, err := courier.NewWorkingHoursLimit(8*time.Hour, 30*time.Minute)
+func NewWorkingHoursLimit(daily time.Duration, warnBefore time.Duration) (WorkingHoursLimit, error) {
+	if daily <= 0 || daily > hoursPerDay {
+		return WorkingHoursLimit{}, errs.NewValueIsInvalidErrorWithCause(
+			"daily working hours",
+			fmt.Errorf("%s is not between 0 and %s", daily, hoursPerDay),
+		)
+	}
+	if warnBefore < 0 || warnBefore >= daily {
+		return WorkingHoursLimit{}, errs.NewValueIsInvalidErrorWithCause(
+			"working hours warning",
+			fmt.Errorf("%s is not between 0 and the daily limit %s", warnBefore, daily),
+		)
+	}
+
+	return WorkingHoursLimit{
+		daily:      daily,
+		warnBefore: warnBefore,
+		guard:      guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the limit was created through the constructor.
+// Returns ErrWorkingHoursLimitIsNotConstructed if validation fails.
+func (l WorkingHoursLimit) Validate() error {
+	return l.guard.Validate(ErrWorkingHoursLimitIsNotConstructed)
+}
+
+// Daily returns the maximum on-shift time per day.
+func (l WorkingHoursLimit) Daily() time.Duration {
+	return l.daily
+}
+
+// WarnBefore returns how long before the limit couriers are warned.
+func (l WorkingHoursLimit) WarnBefore() time.Duration {
+	return l.warnBefore
+}
+
+// Assess returns the status of a courier who worked the given time today.
+func (l WorkingHoursLimit) Assess(worked time.Duration) WorkingHoursStatus {
+	switch {
+	case worked >= l.daily:
+		return LimitReached
+	case worked >= l.daily-l.warnBefore:
+		return ApproachingLimit
+	default:
+		return WithinLimit
+	}
+}
+
+// Remaining returns how much on-shift time is left to a courier who worked the given time today,
+// zero once the limit is reached.
+func (l WorkingHoursLimit) Remaining(worked time.Duration) time.Duration {
+	return max(0, l.daily-worked)
+}
+
+// WorkLog records a courier's on-shift time for the current calendar day (UTC).
+// Time of finished shifts is accumulated per day; a running shift counts up to the moment
+// asked about, and only its part after midnight counts towards the new day.
+// The zero value is the log of a courier who has not worked yet.
+type WorkLog struct {
+	// day is the midnight (UTC) of the day completed belongs to
+	day time.Time
+
+	// completed is the time of the shifts finished on day
+	completed time.Duration
+
+	// shiftStartedAt is when the running shift started (nil if off shift)
+	shiftStartedAt *time.Time
+}
+
+// RestoreWorkLog rebuilds a persisted work log.
+// Used by repositories; day is truncated to midnight UTC.
+func RestoreWorkLog(day time.Time, completed time.Duration, shiftStartedAt *time.Time) (WorkLog, error) {
+	if completed < 0 {
+		return WorkLog{}, errs.NewValueIsInvalidErrorWithCause(
+			"worked time",
+			fmt.Errorf("%s must not be negative", completed),
+		)
+	}
+	if completed > 0 && day.IsZero() {
+		return WorkLog{}, errs.NewValueIsRequiredError("work day")
+	}
+
+	log := WorkLog{completed: completed}
+	if !day.IsZero() {
+		log.day = startOfDay(day)
+	}
+	if shiftStartedAt != nil {
+		startedAt := shiftStartedAt.UTC()
+		log.shiftStartedAt = &startedAt
+	}
+	return log, nil
+}
+
+// Day returns the day the completed time belongs to, the zero time if the courier has not worked yet.
+func (w WorkLog) Day() time.Time {
+	return w.day
+}
+
+// Completed returns the time of the shifts finished on Day.
+func (w WorkLog) Completed() time.Duration {
+	return w.completed
+}
+
+// ShiftStartedAt returns when the running shift started, nil if the courier is off shift.
+func (w WorkLog) ShiftStartedAt() *time.Time {
+	return w.shiftStartedAt
+}
+
+// IsOnShift reports whether a shift is running.
+func (w WorkLog) IsOnShift() bool {
+	return w.shiftStartedAt != nil
+}
+
+// WorkedOn returns the on-shift time of the day of now, including the running shift.
+func (w WorkLog) WorkedOn(now time.Time) time.Duration {
+	today := startOfDay(now)
+
+	var worked time.Duration
+	if w.day.Equal(today) {
+		worked = w.completed
+	}
+
+	if w.shiftStartedAt != nil {
+		startedAt := *w.shiftStartedAt
+		if startedAt.Before(today) {
+			startedAt = today
+		}
+		if now.After(startedAt) {
+			worked += now.Sub(startedAt)
+		}
+	}
+
+	return worked
+}
+
+// startOfDay returns the midnight (UTC) of the day of t.
+func startOfDay(t time.Time) time.Time {
+	return t.UTC().Truncate(hoursPerDay)
+}
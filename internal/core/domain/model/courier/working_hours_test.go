@@ -0,0 +1,145 @@
+package courier_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createWorkingHoursLimit(t *testing.T) courier.WorkingHoursLimit {
+	t.Helper()
+	limit, err := courier.NewWorkingHoursLimit(8*time.Hour, 30*time.Minute)
+	require.NoError(t, err)
+	return limit
+}
+
+func TestNewWorkingHoursLimit(t *testing.T) {
+	t.Run("should create limit with valid values", func(t *testing.T) {
+		limit, err := courier.NewWorkingHoursLimit(8*time.Hour, 30*time.Minute)
+
+		require.NoError(t, err)
+		require.NoError(t, limit.Validate())
+		assert.Equal(t, 8*time.Hour, limit.Daily())
+		assert.Equal(t, 30*time.Minute, limit.WarnBefore())
+	})
+
+	tests := []struct {
+		name       string
+		daily      time.Duration
+		warnBefore time.Duration
+	}{
+		{"zero daily limit", 0, 0},
+		{"daily limit longer than a day", 25 * time.Hour, 0},
+		{"negative warning", 8 * time.Hour, -time.Minute},
+		{"warning as long as the limit", 8 * time.Hour, 8 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run("should reject "+tt.name, func(t *testing.T) {
+			_, err := courier.NewWorkingHoursLimit(tt.daily, tt.warnBefore)
+
+			require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+		})
+	}
+
+	t.Run("should detect unconstructed limit", func(t *testing.T) {
+		require.ErrorIs(t, courier.WorkingHoursLimit{}.Validate(), courier.ErrWorkingHoursLimitIsNotConstructed)
+	})
+}
+
+func TestWorkingHoursLimit_Assess(t *testing.T) {
+	limit := createWorkingHoursLimit(t)
+
+	assert.Equal(t, courier.WithinLimit, limit.Assess(7*time.Hour))
+	assert.Equal(t, courier.ApproachingLimit, limit.Assess(7*time.Hour+30*time.Minute))
+	assert.Equal(t, courier.LimitReached, limit.Assess(8*time.Hour))
+	assert.Equal(t, "approaching_limit", courier.ApproachingLimit.String())
+}
+
+func TestWorkingHoursLimit_Remaining(t *testing.T) {
+	limit := createWorkingHoursLimit(t)
+
+	assert.Equal(t, 45*time.Minute, limit.Remaining(7*time.Hour+15*time.Minute))
+	assert.Zero(t, limit.Remaining(8*time.Hour))
+	assert.Zero(t, limit.Remaining(9*time.Hour))
+}
+
+func TestWorkLog_WorkedOn(t *testing.T) {
+	day := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should count completed time of the same day only", func(t *testing.T) {
+		log, err := courier.RestoreWorkLog(day.Add(15*time.Hour), 3*time.Hour, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, day, log.Day())
+		assert.Equal(t, 3*time.Hour, log.WorkedOn(day.Add(20*time.Hour)))
+		assert.Zero(t, log.WorkedOn(day.Add(30*time.Hour)))
+	})
+
+	t.Run("should count running shift from midnight", func(t *testing.T) {
+		startedAt := day.Add(22 * time.Hour)
+		log, err := courier.RestoreWorkLog(day, time.Hour, &startedAt)
+		require.NoError(t, err)
+
+		assert.Equal(t, 2*time.Hour, log.WorkedOn(day.Add(23*time.Hour)))
+		assert.Equal(t, 3*time.Hour, log.WorkedOn(day.Add(27*time.Hour)))
+	})
+
+	t.Run("should reject invalid persisted values", func(t *testing.T) {
+		_, err := courier.RestoreWorkLog(day, -time.Hour, nil)
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+
+		_, err = courier.RestoreWorkLog(time.Time{}, time.Hour, nil)
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+	})
+}
+
+func TestCourier_Shifts(t *testing.T) {
+	limit := createWorkingHoursLimit(t)
+	morning := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+
+	t.Run("should accumulate shifts of a day", func(t *testing.T) {
+		c := createValidCourier(t)
+
+		require.NoError(t, c.StartShift(morning, limit))
+		assert.True(t, c.WorkLog().IsOnShift())
+		require.NoError(t, c.EndShift(morning.Add(3*time.Hour)))
+		require.NoError(t, c.StartShift(morning.Add(4*time.Hour), limit))
+		require.NoError(t, c.EndShift(morning.Add(6*time.Hour)))
+
+		assert.False(t, c.WorkLog().IsOnShift())
+		assert.Equal(t, 5*time.Hour, c.WorkLog().Completed())
+	})
+
+	t.Run("should refuse shift once the limit is reached", func(t *testing.T) {
+		c := createValidCourier(t)
+		require.NoError(t, c.StartShift(morning, limit))
+		require.NoError(t, c.EndShift(morning.Add(8*time.Hour)))
+
+		err := c.StartShift(morning.Add(9*time.Hour), limit)
+
+		require.ErrorIs(t, err, courier.ErrDailyWorkingHoursExceeded)
+		require.NoError(t, c.StartShift(morning.Add(24*time.Hour), limit))
+	})
+
+	t.Run("should enforce shift state", func(t *testing.T) {
+		c := createValidCourier(t)
+
+		require.ErrorIs(t, c.EndShift(morning), courier.ErrShiftIsNotStarted)
+		require.NoError(t, c.StartShift(morning, limit))
+		require.ErrorIs(t, c.StartShift(morning, limit), courier.ErrShiftIsAlreadyStarted)
+	})
+
+	t.Run("should refuse shift of deactivated courier", func(t *testing.T) {
+		c := createValidCourier(t)
+		_, err := c.Deactivate(courier.Offboarded)
+		require.NoError(t, err)
+
+		require.ErrorIs(t, c.StartShift(morning, limit), courier.ErrCourierIsDeactivated)
+	})
+}
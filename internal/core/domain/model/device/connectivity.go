@@ -0,0 +1,68 @@
+package device
+
+import (
+	"fmt"
+
+	"delivery/internal/pkg/errs"
+)
+
+// Connectivity is the quality of the network connection a courier app reports.
+type Connectivity int
+
+const (
+	// UnknownConnectivity represents an invalid or undefined connectivity.
+	// This value (0) helps catch uninitialized Connectivity values.
+	UnknownConnectivity Connectivity = iota
+
+	// Offline means the app has no connection and reports the reading once it reconnects.
+	Offline
+
+	// Poor means the connection is too weak for timely order updates.
+	Poor
+
+	// Good means the connection is stable.
+	Good
+)
+
+// getValidConnectivityStrings returns a map of valid Connectivity values to their string representations.
+func getValidConnectivityStrings() map[Connectivity]string {
+	//nolint:exhaustive // UnknownConnectivity is intentionally excluded as it's invalid
+	return map[Connectivity]string{
+		Offline: "offline",
+		Poor:    "poor",
+		Good:    "good",
+	}
+}
+
+// ParseConnectivity returns the connectivity with the given name.
+func ParseConnectivity(value string) (Connectivity, error) {
+	for connectivity, name := range getValidConnectivityStrings() {
+		if name == value {
+			return connectivity, nil
+		}
+	}
+	return UnknownConnectivity, errs.NewValueIsInvalidErrorWithCause(
+		"connectivity is invalid",
+		fmt.Errorf("%q is not one of offline, poor or good", value),
+	)
+}
+
+// Validate checks if the Connectivity value is valid.
+func (c Connectivity) Validate() error {
+	if _, ok := getValidConnectivityStrings()[c]; !ok {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"connectivity is invalid",
+			fmt.Errorf("%d is not a valid connectivity", c),
+		)
+	}
+	return nil
+}
+
+// String returns the name of the connectivity.
+// Returns "unknown" for invalid connectivity values.
+func (c Connectivity) String() string {
+	if str, ok := getValidConnectivityStrings()[c]; ok {
+		return str
+	}
+	return "unknown"
+}
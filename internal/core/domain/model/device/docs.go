@@ -0,0 +1,21 @@
+// Package device provides the domain model of courier device telemetry: the battery and
+// connectivity readings courier apps report periodically and the health of a courier's device
+// derived from the readings of a rolling window.
+//
+// The package includes:
+//   - Reading: A telemetry sample reported by a courier app
+//   - Connectivity: The quality of the app's network connection
+//   - HealthPolicy: The thresholds a device must meet to receive orders
+//   - Health: The assessed state of a courier's device
+//
+// Key business rules:
+//   - Battery levels are percentages from 0 to 100
+//   - Only readings within the policy's window count; a device without any is of unknown health
+//   - A device below the minimum battery level that is not charging has a low battery
+//   - A device that is offline, or poorly connected in at least half of the window, has poor connectivity
+//   - Couriers whose device has a low battery or poor connectivity receive no new orders;
+//     devices of unknown health do not block dispatch, so apps without telemetry keep working
+//
+// The package follows Domain-Driven Design principles, providing rich domain
+// behavior, encapsulation, and validation to ensure business rules are enforced.
+package device
@@ -0,0 +1,135 @@
+package device
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// ErrHealthPolicyIsNotConstructed indicates that a HealthPolicy was not properly initialized
+// through the NewHealthPolicy constructor.
+var ErrHealthPolicyIsNotConstructed = errors.New("HealthPolicy must be created via NewHealthPolicy constructor")
+
+// Health is the state of a courier's device assessed from the readings of a window.
+// Battery, Charging, Connectivity and LastSeenAt come from the latest reading and are
+// zero when the device reported nothing within the window.
+type Health struct {
+	Reported     bool
+	Battery      int
+	Charging     bool
+	Connectivity Connectivity
+	LastSeenAt   time.Time
+
+	// LowBattery is set when the latest reading is below the minimum level and not charging.
+	LowBattery bool
+
+	// PoorConnectivity is set when the device is offline or poorly connected in at least
+	// half of the window.
+	PoorConnectivity bool
+}
+
+// IsDegraded reports whether the device keeps the courier from receiving new orders.
+func (h Health) IsDegraded() bool {
+	return h.LowBattery || h.PoorConnectivity
+}
+
+// HealthPolicy decides whether a courier's device is fit for receiving orders.
+//
+// Key business rules:
+//   - Must be constructed through NewHealthPolicy
+//   - The minimum battery level is between 0 and MaxBattery percent; 0 never reports a low battery
+//   - The window is positive; readings older than the window are ignored
+type HealthPolicy struct {
+	minBattery int
+	window     time.Duration
+
+	guard guard.ConstructorGuard
+}
+
+// NewHealthPolicy creates a policy with validation.
+//
+// Example:
+//
+//	policy, err := device.NewHealthPolicy(15, 10*time.Minute)
+func NewHealthPolicy(minBattery int, window time.Duration) (HealthPolicy, error) {
+	var validation errs.ValidationErrors
+	if minBattery < 0 || minBattery > MaxBattery {
+		validation.Add("minBattery", errs.NewValueIsInvalidErrorWithCause(
+			"minimum battery is invalid",
+			fmt.Errorf("%d is not between 0 and %d", minBattery, MaxBattery),
+		))
+	}
+	if window <= 0 {
+		validation.Add("window", errs.NewValueIsInvalidErrorWithCause(
+			"telemetry window is invalid",
+			fmt.Errorf("%s must be positive", window),
+		))
+	}
+	if err := validation.Err(); err != nil {
+		return HealthPolicy{}, err
+	}
+
+	return HealthPolicy{minBattery: minBattery, window: window, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the HealthPolicy was properly constructed.
+// Returns ErrHealthPolicyIsNotConstructed if validation fails.
+func (p HealthPolicy) Validate() error {
+	return p.guard.Validate(ErrHealthPolicyIsNotConstructed)
+}
+
+// MinBattery returns the battery level in percent below which a device that is not charging
+// has a low battery.
+func (p HealthPolicy) MinBattery() int {
+	return p.minBattery
+}
+
+// Window returns how long readings are taken into account.
+func (p HealthPolicy) Window() time.Duration {
+	return p.window
+}
+
+// WindowStart returns the time of the oldest reading that counts at now.
+func (p HealthPolicy) WindowStart(now time.Time) time.Time {
+	return now.Add(-p.window)
+}
+
+// Assess derives the health of a device from its readings, in any order.
+// Readings taken before the window started at now are ignored.
+func (p HealthPolicy) Assess(readings []Reading, now time.Time) Health {
+	var (
+		latest    Reading
+		counted   int
+		connected int
+	)
+	for _, reading := range readings {
+		if reading.RecordedAt().Before(p.WindowStart(now)) {
+			continue
+		}
+
+		counted++
+		if reading.Connectivity() == Good {
+			connected++
+		}
+		if latest.RecordedAt().IsZero() || reading.RecordedAt().After(latest.RecordedAt()) {
+			latest = reading
+		}
+	}
+
+	if counted == 0 {
+		return Health{}
+	}
+
+	return Health{
+		Reported:         true,
+		Battery:          latest.Battery(),
+		Charging:         latest.IsCharging(),
+		Connectivity:     latest.Connectivity(),
+		LastSeenAt:       latest.RecordedAt(),
+		LowBattery:       latest.Battery() < p.minBattery && !latest.IsCharging(),
+		PoorConnectivity: latest.Connectivity() == Offline || 2*connected <= counted,
+	}
+}
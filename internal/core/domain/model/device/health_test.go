@@ -0,0 +1,103 @@
+package device_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/device"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHealthPolicy(t *testing.T) {
+	t.Run("should create policy", func(t *testing.T) {
+		policy, err := device.NewHealthPolicy(15, 10*time.Minute)
+
+		require.NoError(t, err)
+		require.NoError(t, policy.Validate())
+		assert.Equal(t, 15, policy.MinBattery())
+		assert.Equal(t, 10*time.Minute, policy.Window())
+	})
+
+	t.Run("should refuse invalid thresholds", func(t *testing.T) {
+		_, err := device.NewHealthPolicy(-1, 0)
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+		var validation *errs.ValidationErrors
+		require.ErrorAs(t, err, &validation)
+		assert.Len(t, validation.Fields, 2)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, device.HealthPolicy{}.Validate(), device.ErrHealthPolicyIsNotConstructed)
+	})
+}
+
+func TestHealthPolicy_Assess(t *testing.T) {
+	policy, _ := device.NewHealthPolicy(20, 10*time.Minute)
+	now := time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC)
+	courierID := kernel.NewUUID()
+	reading := func(ago time.Duration, battery int, charging bool, connectivity device.Connectivity) device.Reading {
+		r, err := device.NewReading(courierID, battery, charging, connectivity, now.Add(-ago))
+		require.NoError(t, err)
+		return r
+	}
+
+	t.Run("should report unknown health without readings in the window", func(t *testing.T) {
+		health := policy.Assess([]device.Reading{reading(11*time.Minute, 5, false, device.Offline)}, now)
+
+		assert.False(t, health.Reported)
+		assert.False(t, health.IsDegraded())
+	})
+
+	t.Run("should take the battery from the latest reading", func(t *testing.T) {
+		health := policy.Assess([]device.Reading{
+			reading(time.Minute, 60, false, device.Good),
+			reading(5*time.Minute, 10, false, device.Good),
+		}, now)
+
+		assert.True(t, health.Reported)
+		assert.Equal(t, 60, health.Battery)
+		assert.Equal(t, now.Add(-time.Minute), health.LastSeenAt)
+		assert.False(t, health.IsDegraded())
+	})
+
+	t.Run("should report a low battery unless charging", func(t *testing.T) {
+		low := policy.Assess([]device.Reading{reading(time.Minute, 19, false, device.Good)}, now)
+		charging := policy.Assess([]device.Reading{reading(time.Minute, 19, true, device.Good)}, now)
+
+		assert.True(t, low.LowBattery)
+		assert.True(t, low.IsDegraded())
+		assert.False(t, charging.LowBattery)
+	})
+
+	t.Run("should report poor connectivity when offline", func(t *testing.T) {
+		health := policy.Assess([]device.Reading{
+			reading(time.Minute, 80, false, device.Offline),
+			reading(2*time.Minute, 80, false, device.Good),
+			reading(3*time.Minute, 80, false, device.Good),
+		}, now)
+
+		assert.True(t, health.PoorConnectivity)
+		assert.Equal(t, device.Offline, health.Connectivity)
+	})
+
+	t.Run("should report poor connectivity for half of the window", func(t *testing.T) {
+		poor := policy.Assess([]device.Reading{
+			reading(time.Minute, 80, false, device.Good),
+			reading(2*time.Minute, 80, false, device.Poor),
+		}, now)
+		recovering := policy.Assess([]device.Reading{
+			reading(time.Minute, 80, false, device.Good),
+			reading(2*time.Minute, 80, false, device.Good),
+			reading(3*time.Minute, 80, false, device.Poor),
+			reading(20*time.Minute, 80, false, device.Poor),
+		}, now)
+
+		assert.True(t, poor.PoorConnectivity)
+		assert.False(t, recovering.PoorConnectivity)
+	})
+}
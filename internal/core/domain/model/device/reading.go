@@ -0,0 +1,109 @@
+package device
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// MaxBattery is the battery level of a fully charged device, in percent.
+const MaxBattery = 100
+
+// ErrReadingIsNotConstructed indicates that a Reading was not properly initialized
+// through the NewReading constructor.
+var ErrReadingIsNotConstructed = errors.New("Reading must be created via NewReading constructor")
+
+// Reading is a telemetry sample a courier app reports about its device.
+//
+// Key business rules:
+//   - Must be constructed through NewReading
+//   - Belongs to a courier
+//   - The battery level is between 0 and MaxBattery percent
+//   - The connectivity is known
+//   - Has the time the app took it, which may lie in the past for readings sent after reconnecting
+type Reading struct {
+	courierID    kernel.UUID
+	battery      int
+	charging     bool
+	connectivity Connectivity
+	recordedAt   time.Time
+
+	guard guard.ConstructorGuard
+}
+
+// NewReading creates a telemetry reading with validation.
+//
+// Example:
+//
+//	reading, err := device.NewReading(courierID, 42, false, device.Good, time.Now())
+func NewReading(
+	courierID kernel.UUID,
+	battery int,
+	charging bool,
+	connectivity Connectivity,
+	recordedAt time.Time,
+) (Reading, error) {
+	var validation errs.ValidationErrors
+	if err := courierID.Validate(); err != nil {
+		validation.Add("courierId", err)
+	}
+	if battery < 0 || battery > MaxBattery {
+		validation.Add("battery", errs.NewValueIsInvalidErrorWithCause(
+			"battery is invalid",
+			fmt.Errorf("%d is not between 0 and %d", battery, MaxBattery),
+		))
+	}
+	if err := connectivity.Validate(); err != nil {
+		validation.Add("connectivity", err)
+	}
+	if recordedAt.IsZero() {
+		validation.Add("recordedAt", errs.NewValueIsRequiredError("recordedAt"))
+	}
+	if err := validation.Err(); err != nil {
+		return Reading{}, err
+	}
+
+	return Reading{
+		courierID:    courierID,
+		battery:      battery,
+		charging:     charging,
+		connectivity: connectivity,
+		recordedAt:   recordedAt,
+		guard:        guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the Reading was properly constructed.
+// Returns ErrReadingIsNotConstructed if validation fails.
+func (r Reading) Validate() error {
+	return r.guard.Validate(ErrReadingIsNotConstructed)
+}
+
+// CourierID returns the courier whose app reported the reading.
+func (r Reading) CourierID() kernel.UUID {
+	return r.courierID
+}
+
+// Battery returns the battery level in percent.
+func (r Reading) Battery() int {
+	return r.battery
+}
+
+// IsCharging reports whether the device was plugged in.
+func (r Reading) IsCharging() bool {
+	return r.charging
+}
+
+// Connectivity returns the quality of the network connection.
+func (r Reading) Connectivity() Connectivity {
+	return r.connectivity
+}
+
+// RecordedAt returns when the app took the reading.
+func (r Reading) RecordedAt() time.Time {
+	return r.recordedAt
+}
@@ -0,0 +1,58 @@
+package device_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/device"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReading(t *testing.T) {
+	t.Run("should create reading", func(t *testing.T) {
+		courierID := kernel.NewUUID()
+		recordedAt := time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC)
+
+		reading, err := device.NewReading(courierID, 42, true, device.Poor, recordedAt)
+
+		require.NoError(t, err)
+		require.NoError(t, reading.Validate())
+		assert.Equal(t, courierID, reading.CourierID())
+		assert.Equal(t, 42, reading.Battery())
+		assert.True(t, reading.IsCharging())
+		assert.Equal(t, device.Poor, reading.Connectivity())
+		assert.Equal(t, recordedAt, reading.RecordedAt())
+	})
+
+	t.Run("should refuse invalid values", func(t *testing.T) {
+		_, err := device.NewReading(kernel.NewUUID(), 101, false, device.UnknownConnectivity, time.Time{})
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+		var validation *errs.ValidationErrors
+		require.ErrorAs(t, err, &validation)
+		require.Len(t, validation.Fields, 3)
+		assert.Equal(t, "battery", validation.Fields[0].Field)
+		assert.Equal(t, "connectivity", validation.Fields[1].Field)
+		assert.Equal(t, "recordedAt", validation.Fields[2].Field)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, device.Reading{}.Validate(), device.ErrReadingIsNotConstructed)
+	})
+}
+
+func TestParseConnectivity(t *testing.T) {
+	for _, connectivity := range []device.Connectivity{device.Offline, device.Poor, device.Good} {
+		parsed, err := device.ParseConnectivity(connectivity.String())
+
+		require.NoError(t, err)
+		assert.Equal(t, connectivity, parsed)
+	}
+
+	_, err := device.ParseConnectivity("excellent")
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
@@ -0,0 +1,15 @@
+// Package earnings provides the domain model of the couriers' earnings ledger.
+//
+// The package includes:
+//   - Entry: A single amount a courier earned for an order
+//   - Kind: What the amount was earned for
+//
+// Key business rules:
+//   - Entries are immutable and only ever appended to the ledger
+//   - Every entry belongs to a courier and the order it was earned for
+//   - Amounts are positive and in minor currency units
+//   - An order yields at most one entry of each kind
+//
+// The package follows Domain-Driven Design principles, providing rich domain
+// behavior, encapsulation, and validation to ensure business rules are enforced.
+package earnings
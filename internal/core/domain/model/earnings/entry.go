@@ -0,0 +1,124 @@
+package earnings
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	// ErrEntryIsNotConstructed indicates that an Entry was not properly
+	// initialized through the NewEntry constructor function.
+	ErrEntryIsNotConstructed = errors.New("Entry must be created via NewEntry constructor")
+
+	// ErrEntryIsAlreadyRecorded is returned by the ledger when the order already
+	// yielded an entry of the same kind.
+	ErrEntryIsAlreadyRecorded = errors.New("earnings entry is already recorded")
+)
+
+// Entry is an amount a courier earned for an order. Entries are immutable.
+//
+// Key business rules:
+//   - Must be constructed through NewEntry constructor
+//   - Belongs to a courier and the order it was earned for
+//   - The amount is positive and in minor currency units
+type Entry struct {
+	// id uniquely identifies the entry
+	id kernel.UUID
+
+	// courierID is the courier who earned the amount
+	courierID kernel.UUID
+
+	// orderID is the order the amount was earned for
+	orderID kernel.UUID
+
+	// kind tells what the amount was earned for
+	kind Kind
+
+	// amount is the earned amount in minor currency units
+	amount int
+
+	// earnedAt is when the amount was earned
+	earnedAt time.Time
+
+	// guard ensures the entry was created via NewEntry
+	guard guard.ConstructorGuard
+}
+
+// NewEntry creates a ledger entry.
+//
+// Example:
+//
+//	entry, err := earnings.NewEntry(kernel.NewUUID(), *o.Courier(), o.ID(), earnings.Tip, 250, time.Now())
+//	if err != nil {
+//	    return fmt.Errorf("invalid earnings entry: %w", err)
+//	}
+func NewEntry(id, courierID, orderID kernel.UUID, kind Kind, amount int, earnedAt time.Time) (*Entry, error) {
+	var fields errs.ValidationErrors
+	fields.Add("id", id.Validate())
+	fields.Add("courierId", courierID.Validate())
+	fields.Add("orderId", orderID.Validate())
+	fields.Add("kind", kind.Validate())
+	if amount < 1 {
+		fields.Add("amount", errs.NewValueIsInvalidErrorWithCause("amount is invalid", fmt.Errorf("%d must be positive", amount)))
+	}
+	if earnedAt.IsZero() {
+		fields.Add("earnedAt", errs.NewValueIsRequiredError("earnedAt"))
+	}
+	if err := fields.Err(); err != nil {
+		return nil, err
+	}
+
+	return &Entry{
+		id:        id,
+		courierID: courierID,
+		orderID:   orderID,
+		kind:      kind,
+		amount:    amount,
+		earnedAt:  earnedAt,
+		guard:     guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the Entry instance was properly constructed through NewEntry.
+func (e *Entry) Validate() error {
+	if e == nil {
+		return ErrEntryIsNotConstructed
+	}
+
+	return e.guard.Validate(ErrEntryIsNotConstructed)
+}
+
+// ID returns the entry's unique identifier.
+func (e *Entry) ID() kernel.UUID {
+	return e.id
+}
+
+// CourierID returns the courier who earned the amount.
+func (e *Entry) CourierID() kernel.UUID {
+	return e.courierID
+}
+
+// OrderID returns the order the amount was earned for.
+func (e *Entry) OrderID() kernel.UUID {
+	return e.orderID
+}
+
+// Kind returns what the amount was earned for.
+func (e *Entry) Kind() Kind {
+	return e.kind
+}
+
+// Amount returns the earned amount in minor currency units.
+func (e *Entry) Amount() int {
+	return e.amount
+}
+
+// EarnedAt returns when the amount was earned.
+func (e *Entry) EarnedAt() time.Time {
+	return e.earnedAt
+}
@@ -0,0 +1,61 @@
+package earnings_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/earnings"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var earnedAt = time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+func TestNewEntry(t *testing.T) {
+	t.Run("should create tip entry", func(t *testing.T) {
+		id, courierID, orderID := kernel.NewUUID(), kernel.NewUUID(), kernel.NewUUID()
+
+		entry, err := earnings.NewEntry(id, courierID, orderID, earnings.Tip, 250, earnedAt)
+
+		require.NoError(t, err)
+		require.NoError(t, entry.Validate())
+		assert.True(t, entry.ID().IsEqual(id))
+		assert.True(t, entry.CourierID().IsEqual(courierID))
+		assert.True(t, entry.OrderID().IsEqual(orderID))
+		assert.Equal(t, earnings.Tip, entry.Kind())
+		assert.Equal(t, 250, entry.Amount())
+		assert.Equal(t, earnedAt, entry.EarnedAt())
+	})
+
+	t.Run("should report every invalid field", func(t *testing.T) {
+		entry, err := earnings.NewEntry(
+			kernel.UUID{}, kernel.UUID{}, kernel.UUID{}, earnings.UnknownKind, 0, time.Time{})
+
+		require.ErrorIs(t, err, errs.ErrValidationFailed)
+		assert.Nil(t, entry)
+		var validation *errs.ValidationErrors
+		require.ErrorAs(t, err, &validation)
+		fields := make([]string, 0, len(validation.Fields))
+		for _, field := range validation.Fields {
+			fields = append(fields, field.Field)
+		}
+		assert.Equal(t, []string{"id", "courierId", "orderId", "kind", "amount", "earnedAt"}, fields)
+	})
+
+	t.Run("nil entry should be invalid", func(t *testing.T) {
+		var entry *earnings.Entry
+
+		require.ErrorIs(t, entry.Validate(), earnings.ErrEntryIsNotConstructed)
+	})
+}
+
+func TestKind(t *testing.T) {
+	require.NoError(t, earnings.Tip.Validate())
+	assert.Equal(t, "Tip", earnings.Tip.String())
+
+	require.Error(t, earnings.UnknownKind.Validate())
+	assert.Equal(t, "Unknown", earnings.Kind(42).String())
+}
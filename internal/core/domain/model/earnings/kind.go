@@ -0,0 +1,44 @@
+package earnings
+
+import (
+	"fmt"
+
+	"delivery/internal/pkg/errs"
+)
+
+// Kind tells what a ledger entry was earned for.
+type Kind int
+
+const (
+	// UnknownKind represents an invalid or undefined kind.
+	// This value (0) helps catch uninitialized Kind values.
+	UnknownKind Kind = iota
+
+	// Tip marks a gratuity a customer left for a delivered order.
+	Tip
+)
+
+// getValidKindStrings returns a map of valid Kind values to their string representations.
+func getValidKindStrings() map[Kind]string {
+	//nolint:exhaustive // UnknownKind is intentionally excluded as it's invalid
+	return map[Kind]string{
+		Tip: "Tip",
+	}
+}
+
+// Validate checks if the Kind value is valid.
+func (k Kind) Validate() error {
+	if _, ok := getValidKindStrings()[k]; !ok {
+		return errs.NewValueIsInvalidErrorWithCause("kind is invalid", fmt.Errorf("%d is not a valid kind", k))
+	}
+	return nil
+}
+
+// String returns the human-readable name of the kind.
+// Returns "Unknown" for invalid kind values.
+func (k Kind) String() string {
+	if str, ok := getValidKindStrings()[k]; ok {
+		return str
+	}
+	return "Unknown"
+}
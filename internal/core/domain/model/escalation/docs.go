@@ -0,0 +1,19 @@
+// Package escalation provides the domain model of the escalation of urgent orders that wait
+// too long for a courier: operations are notified tier by tier, and later tiers relax the
+// constraints dispatch puts on the couriers that may take the order.
+//
+// The package includes:
+//   - Relaxation: A dispatch constraint a tier lifts for the orders it reached
+//   - Tier: How long an urgent order waits before it escalates, and what it relaxes
+//   - Policy: The tiers an urgent order escalates through
+//   - Escalation: The persisted tier an order has reached
+//
+// Key business rules:
+//   - Only urgent orders still waiting for a courier escalate
+//   - Tiers are reached in order of their waiting time, which is measured from the creation of the order
+//   - An order never escalates to a tier it has already reached; ops are notified once per tier
+//   - Relaxations accumulate: a tier keeps relaxing whatever the tiers before it relaxed
+//
+// The package follows Domain-Driven Design principles, providing rich domain
+// behavior, encapsulation, and validation to ensure business rules are enforced.
+package escalation
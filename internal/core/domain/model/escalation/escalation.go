@@ -0,0 +1,76 @@
+package escalation
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// ErrEscalationIsNotConstructed indicates that an Escalation was not created through
+// Policy.Escalate or RestoreEscalation.
+var ErrEscalationIsNotConstructed = errors.New(
+	"Escalation must be created via Policy.Escalate or RestoreEscalation",
+)
+
+// Escalation is the persisted state of an escalated order: the latest tier it reached and when.
+//
+// Key business rules:
+//   - Refers to an order by its ID
+//   - The tier is at least 1; orders that have not escalated have no escalation
+type Escalation struct {
+	orderID     kernel.UUID
+	tier        int
+	escalatedAt time.Time
+
+	guard guard.ConstructorGuard
+}
+
+// RestoreEscalation recreates an escalation from persistence with validation.
+// Used by repositories.
+func RestoreEscalation(orderID kernel.UUID, tier int, escalatedAt time.Time) (Escalation, error) {
+	var validation errs.ValidationErrors
+	if err := orderID.Validate(); err != nil {
+		validation.Add("orderID", err)
+	}
+	if tier < 1 {
+		validation.Add("tier", errs.NewValueIsInvalidErrorWithCause(
+			"escalation tier is invalid",
+			fmt.Errorf("%d must be at least 1", tier),
+		))
+	}
+	if err := validation.Err(); err != nil {
+		return Escalation{}, err
+	}
+
+	return Escalation{
+		orderID:     orderID,
+		tier:        tier,
+		escalatedAt: escalatedAt,
+		guard:       guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the Escalation was properly constructed.
+// Returns ErrEscalationIsNotConstructed if validation fails.
+func (e Escalation) Validate() error {
+	return e.guard.Validate(ErrEscalationIsNotConstructed)
+}
+
+// OrderID returns the ID of the escalated order.
+func (e Escalation) OrderID() kernel.UUID {
+	return e.orderID
+}
+
+// Tier returns the latest tier the order reached, starting at 1.
+func (e Escalation) Tier() int {
+	return e.tier
+}
+
+// EscalatedAt returns when the order reached its tier.
+func (e Escalation) EscalatedAt() time.Time {
+	return e.escalatedAt
+}
@@ -0,0 +1,201 @@
+package escalation
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	// ErrPolicyIsNotConstructed indicates that a Policy was not properly initialized
+	// through the NewPolicy or ParsePolicy constructors.
+	ErrPolicyIsNotConstructed = errors.New("Policy must be created via NewPolicy or ParsePolicy constructor")
+
+	// ErrTierIsAlreadyReached is returned when escalating an order to a tier it has already reached.
+	ErrTierIsAlreadyReached = errors.New("order has already reached the escalation tier")
+)
+
+// Tier is a step of the escalation of an urgent order: once the order waited for a courier
+// for After, ops are notified and dispatch lifts the tier's relaxations for it.
+type Tier struct {
+	after       time.Duration
+	relaxations []Relaxation
+}
+
+// NewTier creates a tier reached after waiting for after, lifting the given relaxations.
+// Returns a validation error if after is not positive or a relaxation is invalid or repeated.
+//
+// Example:
+//
+//	tier, err := escalation.NewTier(30*time.Minute, escalation.WaiveMatchingRules)
+func NewTier(after time.Duration, relaxations ...Relaxation) (Tier, error) {
+	var validation errs.ValidationErrors
+	if after <= 0 {
+		validation.Add("after", errs.NewValueIsInvalidErrorWithCause(
+			"escalation tier is invalid",
+			fmt.Errorf("waiting time %s must be positive", after),
+		))
+	}
+	for i, relaxation := range relaxations {
+		if err := relaxation.Validate(); err != nil {
+			validation.Add("relaxations", err)
+		} else if slices.Contains(relaxations[:i], relaxation) {
+			validation.Add("relaxations", errs.NewValueIsInvalidErrorWithCause(
+				"escalation tier is invalid",
+				fmt.Errorf("relaxation %s is given more than once", relaxation),
+			))
+		}
+	}
+	if err := validation.Err(); err != nil {
+		return Tier{}, err
+	}
+
+	return Tier{after: after, relaxations: slices.Clone(relaxations)}, nil
+}
+
+// After returns how long an urgent order waits for a courier before it reaches the tier.
+func (t Tier) After() time.Duration {
+	return t.after
+}
+
+// Relaxations returns the relaxations the tier adds to those of the tiers before it.
+func (t Tier) Relaxations() []Relaxation {
+	return slices.Clone(t.relaxations)
+}
+
+// Policy lists the tiers an urgent order escalates through while it waits for a courier.
+//
+// Key business rules:
+//   - Must be constructed through NewPolicy or ParsePolicy
+//   - There is at least one tier, and each tier is reached after a longer wait than the one before it
+//   - Tiers are numbered from 1; tier 0 stands for an order that has not escalated
+//   - A tier keeps the relaxations of the tiers before it
+type Policy struct {
+	tiers []Tier
+
+	guard guard.ConstructorGuard
+}
+
+// NewPolicy creates a policy escalating through the tiers in the given order.
+// Returns a validation error if there is no tier or a tier is not reached after the one before it.
+//
+// Example:
+//
+//	notify, _ := escalation.NewTier(10 * time.Minute)
+//	relax, _ := escalation.NewTier(30*time.Minute, escalation.WaiveMatchingRules)
+//	policy, err := escalation.NewPolicy(notify, relax)
+func NewPolicy(tiers ...Tier) (Policy, error) {
+	if len(tiers) == 0 {
+		return Policy{}, errs.NewValueIsRequiredError("tiers")
+	}
+	for i, tier := range tiers {
+		if tier.after <= 0 {
+			return Policy{}, errs.NewValueIsInvalidErrorWithCause(
+				"escalation policy is invalid",
+				fmt.Errorf("tier %d must be created via NewTier", i+1),
+			)
+		}
+		if i > 0 && tier.after <= tiers[i-1].after {
+			return Policy{}, errs.NewValueIsInvalidErrorWithCause(
+				"escalation policy is invalid",
+				fmt.Errorf("tier %d after %s must wait longer than tier %d after %s",
+					i+1, tier.after, i, tiers[i-1].after),
+			)
+		}
+	}
+
+	return Policy{tiers: slices.Clone(tiers), guard: guard.NewConstructorGuard()}, nil
+}
+
+// ParsePolicy creates a policy from a comma-separated list of tiers, each a waiting time
+// optionally followed by a colon and the relaxations it lifts, joined with "+".
+//
+// Example:
+//
+//	policy, err := escalation.ParsePolicy("10m,30m:matching-rules")
+func ParsePolicy(spec string) (Policy, error) {
+	var tiers []Tier
+	for i, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		text, names, _ := strings.Cut(part, ":")
+		after, err := time.ParseDuration(strings.TrimSpace(text))
+		if err != nil {
+			return Policy{}, errs.NewValueIsInvalidErrorWithCause(
+				"escalation policy is invalid",
+				fmt.Errorf("tier %d must start with a waiting time such as 10m", i+1),
+			)
+		}
+
+		var relaxations []Relaxation
+		for _, name := range strings.Split(names, "+") {
+			if name = strings.TrimSpace(name); name == "" {
+				continue
+			}
+			relaxation, parseErr := ParseRelaxation(name)
+			if parseErr != nil {
+				return Policy{}, parseErr
+			}
+			relaxations = append(relaxations, relaxation)
+		}
+
+		tier, err := NewTier(after, relaxations...)
+		if err != nil {
+			return Policy{}, err
+		}
+		tiers = append(tiers, tier)
+	}
+	return NewPolicy(tiers...)
+}
+
+// Validate ensures the Policy was properly constructed.
+// Returns ErrPolicyIsNotConstructed if validation fails.
+func (p Policy) Validate() error {
+	return p.guard.Validate(ErrPolicyIsNotConstructed)
+}
+
+// Tiers returns the tiers in the order they are reached.
+func (p Policy) Tiers() []Tier {
+	return slices.Clone(p.tiers)
+}
+
+// Relaxations returns the relaxations in effect for an order at the tier,
+// those of the tier and of every tier before it. Tier 0 relaxes nothing.
+func (p Policy) Relaxations(tier int) []Relaxation {
+	var relaxations []Relaxation
+	for _, t := range p.tiers[:min(max(tier, 0), len(p.tiers))] {
+		relaxations = append(relaxations, t.relaxations...)
+	}
+	return relaxations
+}
+
+// Relaxes reports whether the relaxation is in effect for an order at the tier.
+func (p Policy) Relaxes(tier int, relaxation Relaxation) bool {
+	return slices.Contains(p.Relaxations(tier), relaxation)
+}
+
+// Escalate raises the order from the current tier to the given one at the moment.
+// Returns ErrTierIsAlreadyReached unless the tier is above the current one, and a validation
+// error if the tier is not one of the policy's.
+func (p Policy) Escalate(orderID kernel.UUID, current, tier int, at time.Time) (Escalation, error) {
+	if err := p.Validate(); err != nil {
+		return Escalation{}, err
+	}
+	if tier < 1 || tier > len(p.tiers) {
+		return Escalation{}, errs.NewValueIsOutOfRangeError("tier", tier, 1, len(p.tiers))
+	}
+	if tier <= current {
+		return Escalation{}, ErrTierIsAlreadyReached
+	}
+
+	return RestoreEscalation(orderID, tier, at)
+}
@@ -0,0 +1,97 @@
+package escalation_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/escalation"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePolicy(t *testing.T) {
+	t.Run("should parse tiers with relaxations", func(t *testing.T) {
+		policy, err := escalation.ParsePolicy(" 10m , 30m:matching-rules ")
+
+		require.NoError(t, err)
+		require.NoError(t, policy.Validate())
+		tiers := policy.Tiers()
+		require.Len(t, tiers, 2)
+		assert.Equal(t, 10*time.Minute, tiers[0].After())
+		assert.Empty(t, tiers[0].Relaxations())
+		assert.Equal(t, 30*time.Minute, tiers[1].After())
+		assert.Equal(t, []escalation.Relaxation{escalation.WaiveMatchingRules}, tiers[1].Relaxations())
+	})
+
+	for name, spec := range map[string]string{
+		"no tier":               "",
+		"bad waiting time":      "soon",
+		"non-positive wait":     "0s",
+		"unknown relaxation":    "10m:radius",
+		"repeated relaxation":   "10m:matching-rules+matching-rules",
+		"tiers out of order":    "30m,10m",
+		"tiers with equal wait": "10m,10m",
+	} {
+		t.Run("should fail with "+name, func(t *testing.T) {
+			_, err := escalation.ParsePolicy(spec)
+
+			require.Error(t, err)
+		})
+	}
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, escalation.Policy{}.Validate(), escalation.ErrPolicyIsNotConstructed)
+	})
+}
+
+func TestPolicy_Relaxes(t *testing.T) {
+	policy, err := escalation.ParsePolicy("10m,30m:matching-rules,1h")
+	require.NoError(t, err)
+
+	assert.False(t, policy.Relaxes(0, escalation.WaiveMatchingRules))
+	assert.False(t, policy.Relaxes(1, escalation.WaiveMatchingRules))
+	assert.True(t, policy.Relaxes(2, escalation.WaiveMatchingRules))
+	assert.True(t, policy.Relaxes(3, escalation.WaiveMatchingRules), "later tiers keep the relaxation")
+	assert.True(t, policy.Relaxes(4, escalation.WaiveMatchingRules), "tiers beyond the policy keep all relaxations")
+}
+
+func TestPolicy_Escalate(t *testing.T) {
+	policy, err := escalation.ParsePolicy("10m,30m:matching-rules")
+	require.NoError(t, err)
+	orderID := kernel.NewUUID()
+	now := time.Date(2026, 10, 15, 18, 0, 0, 0, time.UTC)
+
+	t.Run("should escalate to a higher tier", func(t *testing.T) {
+		escalated, err := policy.Escalate(orderID, 0, 2, now)
+
+		require.NoError(t, err)
+		require.NoError(t, escalated.Validate())
+		assert.Equal(t, orderID, escalated.OrderID())
+		assert.Equal(t, 2, escalated.Tier())
+		assert.Equal(t, now, escalated.EscalatedAt())
+	})
+
+	t.Run("should fail for a tier already reached", func(t *testing.T) {
+		_, err := policy.Escalate(orderID, 2, 2, now)
+
+		require.ErrorIs(t, err, escalation.ErrTierIsAlreadyReached)
+	})
+
+	t.Run("should fail for a tier outside the policy", func(t *testing.T) {
+		_, err := policy.Escalate(orderID, 0, 3, now)
+
+		require.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+	})
+}
+
+func TestRestoreEscalation(t *testing.T) {
+	_, err := escalation.RestoreEscalation(kernel.UUID{}, 0, time.Now())
+
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	var validation *errs.ValidationErrors
+	require.ErrorAs(t, err, &validation)
+	assert.Len(t, validation.Fields, 2)
+}
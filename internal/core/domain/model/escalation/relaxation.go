@@ -0,0 +1,61 @@
+package escalation
+
+import (
+	"fmt"
+
+	"delivery/internal/pkg/errs"
+)
+
+// Relaxation is a dispatch constraint an escalation tier lifts for the orders that reached it.
+type Relaxation int
+
+const (
+	// UnknownRelaxation represents an invalid or undefined relaxation.
+	// This value (0) helps catch uninitialized Relaxation values.
+	UnknownRelaxation Relaxation = iota
+
+	// WaiveMatchingRules lets couriers lacking the vehicle type or thermal storage the matching
+	// rules require for the order take it, widening the order to less equipped couriers.
+	WaiveMatchingRules
+)
+
+// getValidRelaxationStrings returns a map of valid Relaxation values to their string representations.
+func getValidRelaxationStrings() map[Relaxation]string {
+	//nolint:exhaustive // UnknownRelaxation is intentionally excluded as it's invalid
+	return map[Relaxation]string{
+		WaiveMatchingRules: "matching-rules",
+	}
+}
+
+// ParseRelaxation returns the relaxation with the given name.
+func ParseRelaxation(value string) (Relaxation, error) {
+	for relaxation, name := range getValidRelaxationStrings() {
+		if name == value {
+			return relaxation, nil
+		}
+	}
+	return UnknownRelaxation, errs.NewValueIsInvalidErrorWithCause(
+		"escalation relaxation is invalid",
+		fmt.Errorf("%q is not one of matching-rules", value),
+	)
+}
+
+// Validate checks if the Relaxation value is valid.
+func (r Relaxation) Validate() error {
+	if _, ok := getValidRelaxationStrings()[r]; !ok {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"escalation relaxation is invalid",
+			fmt.Errorf("%d is not a valid relaxation", r),
+		)
+	}
+	return nil
+}
+
+// String returns the name of the relaxation.
+// Returns "unknown" for invalid relaxation values.
+func (r Relaxation) String() string {
+	if str, ok := getValidRelaxationStrings()[r]; ok {
+		return str
+	}
+	return "unknown"
+}
@@ -0,0 +1,235 @@
+package identity
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// MaxReasonLength is the maximum number of characters of the reason kept with an attempt.
+const MaxReasonLength = 255
+
+var (
+	// ErrAttemptIsNotConstructed indicates that an Attempt was not created through NewAttempt.
+	ErrAttemptIsNotConstructed = errors.New("Attempt must be created via NewAttempt constructor")
+
+	// ErrAttemptLimitIsNotConstructed indicates that an AttemptLimit was not created through NewAttemptLimit.
+	ErrAttemptLimitIsNotConstructed = errors.New("AttemptLimit must be created via NewAttemptLimit constructor")
+
+	// ErrProofIsRequired is returned when a courier starts a shift without proving their identity.
+	ErrProofIsRequired = errors.New("identity verification is required to start a shift")
+
+	// ErrVerificationFailed is returned when the verifier rejected the courier's proof.
+	ErrVerificationFailed = errors.New("identity verification failed")
+
+	// ErrTooManyAttempts is returned when a courier failed verification too often within the window.
+	ErrTooManyAttempts = errors.New("too many failed identity verification attempts")
+)
+
+// TooManyAttemptsError tells the courier until when further verification attempts are refused.
+// It matches ErrTooManyAttempts with errors.Is.
+type TooManyAttemptsError struct {
+	LockedUntil time.Time
+}
+
+func (e *TooManyAttemptsError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", ErrTooManyAttempts, e.LockedUntil.UTC().Format(time.RFC3339))
+}
+
+func (e *TooManyAttemptsError) Unwrap() error {
+	return ErrTooManyAttempts
+}
+
+// Attempt is an audited attempt of a courier to verify their identity.
+//
+// Key business rules:
+//   - Belongs to a courier and records the method, never the secret
+//   - The reason a verifier gave is kept, cut to MaxReasonLength characters
+type Attempt struct {
+	id          kernel.UUID
+	courierID   kernel.UUID
+	method      Method
+	verified    bool
+	reason      string
+	attemptedAt time.Time
+
+	guard guard.ConstructorGuard
+}
+
+// NewAttempt creates an attempt with validation. Used by the shift handler to audit a verification
+// and by repositories restoring stored attempts.
+//
+// Example:
+//
+//	attempt, err := identity.NewAttempt(kernel.NewUUID(), courierID, identity.PIN, false, "wrong PIN", time.Now())
+func NewAttempt(
+	id kernel.UUID,
+	courierID kernel.UUID,
+	method Method,
+	verified bool,
+	reason string,
+	attemptedAt time.Time,
+) (Attempt, error) {
+	var attemptedAtErr error
+	if attemptedAt.IsZero() {
+		attemptedAtErr = errs.NewValueIsRequiredError("attemptedAt")
+	}
+
+	if err := errs.JoinFields(
+		errs.Field("id", id.Validate()),
+		errs.Field("courierId", courierID.Validate()),
+		errs.Field("method", method.Validate()),
+		errs.Field("attemptedAt", attemptedAtErr),
+	); err != nil {
+		return Attempt{}, err
+	}
+
+	reason = strings.TrimSpace(reason)
+	if utf8.RuneCountInString(reason) > MaxReasonLength {
+		reason = string([]rune(reason)[:MaxReasonLength])
+	}
+
+	return Attempt{
+		id:          id,
+		courierID:   courierID,
+		method:      method,
+		verified:    verified,
+		reason:      reason,
+		attemptedAt: attemptedAt.UTC(),
+		guard:       guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the Attempt was created through NewAttempt.
+func (a Attempt) Validate() error {
+	return a.guard.Validate(ErrAttemptIsNotConstructed)
+}
+
+// ID returns the attempt's unique identifier.
+func (a Attempt) ID() kernel.UUID {
+	return a.id
+}
+
+// CourierID returns the ID of the courier who attempted the verification.
+func (a Attempt) CourierID() kernel.UUID {
+	return a.courierID
+}
+
+// Method returns how the courier tried to prove their identity.
+func (a Attempt) Method() Method {
+	return a.method
+}
+
+// IsVerified reports whether the verifier accepted the proof.
+func (a Attempt) IsVerified() bool {
+	return a.verified
+}
+
+// Reason returns why the verifier rejected the proof, empty when it gave none.
+func (a Attempt) Reason() string {
+	return a.reason
+}
+
+// AttemptedAt returns when the verification was attempted, in UTC.
+func (a Attempt) AttemptedAt() time.Time {
+	return a.attemptedAt
+}
+
+// AttemptLimit is how many failed verification attempts a courier may make within a window.
+//
+// Key business rules:
+//   - Must be constructed through NewAttemptLimit
+//   - At least one failure is allowed and the window is positive
+type AttemptLimit struct {
+	maxFailures int
+	window      time.Duration
+
+	guard guard.ConstructorGuard
+}
+
+// NewAttemptLimit creates a limit with validation.
+//
+// Example:
+//
+//	limit, err := identity.NewAttemptLimit(5, 15*time.Minute)
+func NewAttemptLimit(maxFailures int, window time.Duration) (AttemptLimit, error) {
+	var validation errs.ValidationErrors
+	if maxFailures < 1 {
+		validation.Add("maxFailures", errs.NewValueIsInvalidErrorWithCause(
+			"maximum failures is invalid",
+			fmt.Errorf("%d must be positive", maxFailures),
+		))
+	}
+	if window <= 0 {
+		validation.Add("window", errs.NewValueIsInvalidErrorWithCause(
+			"attempt window is invalid",
+			fmt.Errorf("%s must be positive", window),
+		))
+	}
+	if err := validation.Err(); err != nil {
+		return AttemptLimit{}, err
+	}
+
+	return AttemptLimit{maxFailures: maxFailures, window: window, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the AttemptLimit was created through NewAttemptLimit.
+func (l AttemptLimit) Validate() error {
+	return l.guard.Validate(ErrAttemptLimitIsNotConstructed)
+}
+
+// MaxFailures returns how many failed attempts are allowed within the window.
+func (l AttemptLimit) MaxFailures() int {
+	return l.maxFailures
+}
+
+// Window returns how long a failed attempt counts against the courier.
+func (l AttemptLimit) Window() time.Duration {
+	return l.window
+}
+
+// LockedUntil returns until when the courier is refused further attempts, given their attempts,
+// or nil when they may attempt now. Only failures within the window since the last successful
+// attempt count.
+func (l AttemptLimit) LockedUntil(attempts []Attempt, now time.Time) *time.Time {
+	since := now.Add(-l.window)
+	sorted := append([]Attempt(nil), attempts...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].attemptedAt.Before(sorted[j].attemptedAt)
+	})
+
+	var failures []time.Time
+	for _, attempt := range sorted {
+		if !attempt.attemptedAt.After(since) {
+			continue
+		}
+		if attempt.verified {
+			failures = failures[:0]
+			continue
+		}
+		failures = append(failures, attempt.attemptedAt)
+	}
+
+	if len(failures) < l.maxFailures {
+		return nil
+	}
+
+	// The courier may attempt again once all but maxFailures-1 of the failures left the window
+	until := failures[len(failures)-l.maxFailures].Add(l.window)
+	return &until
+}
+
+// Check returns a *TooManyAttemptsError when the courier is refused further attempts.
+func (l AttemptLimit) Check(attempts []Attempt, now time.Time) error {
+	if until := l.LockedUntil(attempts, now); until != nil {
+		return &TooManyAttemptsError{LockedUntil: *until}
+	}
+	return nil
+}
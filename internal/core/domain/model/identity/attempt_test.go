@@ -0,0 +1,124 @@
+package identity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/identity"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAttempt(t *testing.T) {
+	now := time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should create attempt", func(t *testing.T) {
+		courierID := kernel.NewUUID()
+		attempt, err := identity.NewAttempt(kernel.NewUUID(), courierID, identity.PIN, false, " wrong PIN ", now)
+
+		require.NoError(t, err)
+		require.NoError(t, attempt.Validate())
+		assert.Equal(t, courierID, attempt.CourierID())
+		assert.Equal(t, identity.PIN, attempt.Method())
+		assert.False(t, attempt.IsVerified())
+		assert.Equal(t, "wrong PIN", attempt.Reason())
+		assert.Equal(t, now, attempt.AttemptedAt())
+	})
+
+	t.Run("should cut long reasons", func(t *testing.T) {
+		attempt, err := identity.NewAttempt(
+			kernel.NewUUID(), kernel.NewUUID(), identity.PIN, false, strings.Repeat("x", 300), now,
+		)
+
+		require.NoError(t, err)
+		assert.Len(t, attempt.Reason(), identity.MaxReasonLength)
+	})
+
+	t.Run("should refuse invalid attempts", func(t *testing.T) {
+		_, err := identity.NewAttempt(kernel.UUID{}, kernel.UUID{}, identity.UnknownMethod, false, "", time.Time{})
+
+		var validation *errs.ValidationErrors
+		require.ErrorAs(t, err, &validation)
+		assert.Len(t, validation.Fields, 4)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, identity.Attempt{}.Validate(), identity.ErrAttemptIsNotConstructed)
+	})
+}
+
+func TestNewAttemptLimit(t *testing.T) {
+	t.Run("should create limit", func(t *testing.T) {
+		limit, err := identity.NewAttemptLimit(5, 15*time.Minute)
+
+		require.NoError(t, err)
+		require.NoError(t, limit.Validate())
+		assert.Equal(t, 5, limit.MaxFailures())
+		assert.Equal(t, 15*time.Minute, limit.Window())
+	})
+
+	t.Run("should refuse invalid limits", func(t *testing.T) {
+		_, err := identity.NewAttemptLimit(0, 0)
+
+		var validation *errs.ValidationErrors
+		require.ErrorAs(t, err, &validation)
+		assert.Len(t, validation.Fields, 2)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, identity.AttemptLimit{}.Validate(), identity.ErrAttemptLimitIsNotConstructed)
+	})
+}
+
+func TestAttemptLimit_Check(t *testing.T) {
+	limit, _ := identity.NewAttemptLimit(3, 15*time.Minute)
+	now := time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC)
+	courierID := kernel.NewUUID()
+	attempt := func(ago time.Duration, verified bool) identity.Attempt {
+		a, err := identity.NewAttempt(kernel.NewUUID(), courierID, identity.PIN, verified, "", now.Add(-ago))
+		require.NoError(t, err)
+		return a
+	}
+
+	t.Run("should allow attempts below the limit", func(t *testing.T) {
+		require.NoError(t, limit.Check([]identity.Attempt{
+			attempt(time.Minute, false),
+			attempt(2*time.Minute, false),
+		}, now))
+	})
+
+	t.Run("should refuse attempts at the limit", func(t *testing.T) {
+		attempts := []identity.Attempt{
+			attempt(time.Minute, false),
+			attempt(5*time.Minute, false),
+			attempt(10*time.Minute, false),
+		}
+
+		err := limit.Check(attempts, now)
+		require.ErrorIs(t, err, identity.ErrTooManyAttempts)
+		var tooMany *identity.TooManyAttemptsError
+		require.ErrorAs(t, err, &tooMany)
+		assert.Equal(t, now.Add(5*time.Minute), tooMany.LockedUntil)
+	})
+
+	t.Run("should not count failures outside the window", func(t *testing.T) {
+		require.NoError(t, limit.Check([]identity.Attempt{
+			attempt(time.Minute, false),
+			attempt(5*time.Minute, false),
+			attempt(20*time.Minute, false),
+		}, now))
+	})
+
+	t.Run("should not count failures before a success", func(t *testing.T) {
+		require.NoError(t, limit.Check([]identity.Attempt{
+			attempt(time.Minute, false),
+			attempt(3*time.Minute, true),
+			attempt(5*time.Minute, false),
+			attempt(10*time.Minute, false),
+		}, now))
+	})
+}
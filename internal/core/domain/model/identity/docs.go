@@ -0,0 +1,19 @@
+// Package identity provides the domain model of courier identity verification: the proof a
+// courier presents when starting a shift and the audited attempts to verify it.
+//
+// The package includes:
+//   - Method: How the courier proves their identity, a PIN or a device attestation
+//   - Proof: The secret presented for a method
+//   - Attempt: An audited verification attempt and its outcome
+//   - AttemptLimit: How many failed attempts a courier may make within a window
+//
+// Key business rules:
+//   - A PIN is 4 to 8 digits; a device attestation is an opaque token of at most MaxAttestationLength characters
+//   - Secrets are never part of an attempt, so the audit log holds no credentials
+//   - A courier who failed as many times as the limit allows within the window is refused
+//     further attempts until the oldest of those failures leaves the window; a successful
+//     attempt clears the failures before it
+//
+// The package follows Domain-Driven Design principles, providing rich domain
+// behavior, encapsulation, and validation to ensure business rules are enforced.
+package identity
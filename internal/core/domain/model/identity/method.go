@@ -0,0 +1,64 @@
+package identity
+
+import (
+	"fmt"
+
+	"delivery/internal/pkg/errs"
+)
+
+// Method is how a courier proves their identity.
+type Method int
+
+const (
+	// UnknownMethod represents an invalid or undefined method.
+	// This value (0) helps catch uninitialized Method values.
+	UnknownMethod Method = iota
+
+	// PIN is a numeric code the courier enters in the app.
+	PIN
+
+	// DeviceAttestation is a token the courier's enrolled device signs.
+	DeviceAttestation
+)
+
+// getValidMethodStrings returns a map of valid Method values to their string representations.
+func getValidMethodStrings() map[Method]string {
+	//nolint:exhaustive // UnknownMethod is intentionally excluded as it's invalid
+	return map[Method]string{
+		PIN:               "pin",
+		DeviceAttestation: "device_attestation",
+	}
+}
+
+// ParseMethod returns the method with the given name.
+func ParseMethod(value string) (Method, error) {
+	for method, name := range getValidMethodStrings() {
+		if name == value {
+			return method, nil
+		}
+	}
+	return UnknownMethod, errs.NewValueIsInvalidErrorWithCause(
+		"verification method is invalid",
+		fmt.Errorf("%q is not one of pin or device_attestation", value),
+	)
+}
+
+// Validate checks if the Method value is valid.
+func (m Method) Validate() error {
+	if _, ok := getValidMethodStrings()[m]; !ok {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"verification method is invalid",
+			fmt.Errorf("%d is not a valid verification method", m),
+		)
+	}
+	return nil
+}
+
+// String returns the name of the method.
+// Returns "unknown" for invalid method values.
+func (m Method) String() string {
+	if str, ok := getValidMethodStrings()[m]; ok {
+		return str
+	}
+	return "unknown"
+}
@@ -0,0 +1,97 @@
+package identity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+const (
+	// MinPINLength is the minimum number of digits in a PIN.
+	MinPINLength = 4
+
+	// MaxPINLength is the maximum number of digits in a PIN.
+	MaxPINLength = 8
+
+	// MaxAttestationLength is the maximum number of characters in a device attestation token.
+	MaxAttestationLength = 4096
+)
+
+// ErrProofIsNotConstructed indicates that a Proof was not created through NewProof.
+var ErrProofIsNotConstructed = errors.New("Proof must be created via NewProof constructor")
+
+// Proof is the secret a courier presents to verify their identity with a method.
+// The secret is only handed to the verifier; it is never stored or logged.
+type Proof struct {
+	method Method
+	secret string
+
+	guard guard.ConstructorGuard
+}
+
+// NewProof creates a proof with validation.
+//
+// Example:
+//
+//	proof, err := identity.NewProof(identity.PIN, "4821")
+func NewProof(method Method, secret string) (Proof, error) {
+	if err := method.Validate(); err != nil {
+		return Proof{}, errs.JoinFields(errs.Field("method", err))
+	}
+
+	secret = strings.TrimSpace(secret)
+	if err := validateSecret(method, secret); err != nil {
+		return Proof{}, errs.JoinFields(errs.Field("secret", err))
+	}
+
+	return Proof{method: method, secret: secret, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the Proof was created through NewProof.
+func (p Proof) Validate() error {
+	return p.guard.Validate(ErrProofIsNotConstructed)
+}
+
+// Method returns how the courier proves their identity.
+func (p Proof) Method() Method {
+	return p.method
+}
+
+// Secret returns the PIN or the attestation token.
+func (p Proof) Secret() string {
+	return p.secret
+}
+
+// String returns the method only, so a proof never leaks its secret into logs.
+func (p Proof) String() string {
+	return "Proof(" + p.method.String() + ")"
+}
+
+func validateSecret(method Method, secret string) error {
+	if secret == "" {
+		return errs.NewValueIsRequiredError("secret")
+	}
+
+	//nolint:exhaustive // the method is validated before
+	switch method {
+	case PIN:
+		if len(secret) < MinPINLength || len(secret) > MaxPINLength || strings.Trim(secret, "0123456789") != "" {
+			return errs.NewValueIsInvalidErrorWithCause(
+				"PIN is invalid",
+				fmt.Errorf("must be %d to %d digits", MinPINLength, MaxPINLength),
+			)
+		}
+	case DeviceAttestation:
+		if utf8.RuneCountInString(secret) > MaxAttestationLength {
+			return errs.NewValueIsInvalidErrorWithCause(
+				"device attestation is invalid",
+				fmt.Errorf("must be at most %d characters", MaxAttestationLength),
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package identity_test
+
+import (
+	"strings"
+	"testing"
+
+	"delivery/internal/core/domain/model/identity"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProof(t *testing.T) {
+	t.Run("should create a PIN proof", func(t *testing.T) {
+		proof, err := identity.NewProof(identity.PIN, " 4821 ")
+
+		require.NoError(t, err)
+		require.NoError(t, proof.Validate())
+		assert.Equal(t, identity.PIN, proof.Method())
+		assert.Equal(t, "4821", proof.Secret())
+	})
+
+	t.Run("should create an attestation proof", func(t *testing.T) {
+		proof, err := identity.NewProof(identity.DeviceAttestation, "eyJhbGciOi.payload.signature")
+
+		require.NoError(t, err)
+		assert.Equal(t, identity.DeviceAttestation, proof.Method())
+	})
+
+	t.Run("should refuse invalid PINs", func(t *testing.T) {
+		for _, pin := range []string{"", "123", "123456789", "12a4"} {
+			_, err := identity.NewProof(identity.PIN, pin)
+
+			require.Error(t, err, pin)
+			var validation *errs.ValidationErrors
+			require.ErrorAs(t, err, &validation, pin)
+			assert.Equal(t, "secret", validation.Fields[0].Field, pin)
+		}
+	})
+
+	t.Run("should refuse too long attestations", func(t *testing.T) {
+		_, err := identity.NewProof(identity.DeviceAttestation, strings.Repeat("a", identity.MaxAttestationLength+1))
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should refuse unknown methods", func(t *testing.T) {
+		_, err := identity.NewProof(identity.UnknownMethod, "4821")
+
+		var validation *errs.ValidationErrors
+		require.ErrorAs(t, err, &validation)
+		assert.Equal(t, "method", validation.Fields[0].Field)
+	})
+
+	t.Run("should not leak the secret", func(t *testing.T) {
+		proof, _ := identity.NewProof(identity.PIN, "4821")
+
+		assert.NotContains(t, proof.String(), "4821")
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, identity.Proof{}.Validate(), identity.ErrProofIsNotConstructed)
+	})
+}
+
+func TestParseMethod(t *testing.T) {
+	method, err := identity.ParseMethod("device_attestation")
+	require.NoError(t, err)
+	assert.Equal(t, identity.DeviceAttestation, method)
+
+	_, err = identity.ParseMethod("password")
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
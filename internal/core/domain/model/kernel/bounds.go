@@ -0,0 +1,134 @@
+package kernel
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sync/atomic"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// ErrBoundsIsNotConstructed is returned when attempting to use an improperly initialized Bounds.
+var ErrBoundsIsNotConstructed = errs.NewValueIsRequiredError("bounds must be created via NewBounds constructor")
+
+// Bounds is the rectangle of coordinates a delivery grid spans, corners inclusive.
+// Bounds is an immutable value object.
+//
+// Key business rules:
+//   - Must be constructed through NewBounds
+//   - Coordinates are not negative and the maximum of each axis is above its minimum
+//
+// Example:
+//
+//	bounds, err := kernel.NewBounds(1, 1, 50, 40)
+//	if err != nil {
+//	    // Handle validation error
+//	}
+//	loc, err := kernel.NewLocationInBounds(42, 17, bounds)
+type Bounds struct { //nolint:recvcheck //using for validation
+	minX  Coordinate
+	minY  Coordinate
+	maxX  Coordinate
+	maxY  Coordinate
+	guard guard.ConstructorGuard
+}
+
+// NewBounds creates the bounds of a grid spanning [minX..maxX] and [minY..maxY].
+// Returns a validation error if a minimum is negative or not below its maximum.
+func NewBounds(minX, minY, maxX, maxY Coordinate) (Bounds, error) {
+	if err := errs.JoinFields(
+		errs.Field("x", validateAxis(minX, maxX)),
+		errs.Field("y", validateAxis(minY, maxY)),
+	); err != nil {
+		return Bounds{}, err
+	}
+
+	return Bounds{minX: minX, minY: minY, maxX: maxX, maxY: maxY, guard: guard.NewConstructorGuard()}, nil
+}
+
+// DefaultBounds returns the bounds of the default grid,
+// [LocationMinX..LocationMaxX] by [LocationMinY..LocationMaxY].
+func DefaultBounds() Bounds {
+	bounds, _ := NewBounds(LocationMinX, LocationMinY, LocationMaxX, LocationMaxY)
+	return bounds
+}
+
+// Validate checks if the Bounds was properly constructed using NewBounds.
+func (b Bounds) Validate() error {
+	return b.guard.Validate(ErrBoundsIsNotConstructed)
+}
+
+// MinX returns the minimum X coordinate of the grid.
+func (b Bounds) MinX() Coordinate {
+	return b.minX
+}
+
+// MinY returns the minimum Y coordinate of the grid.
+func (b Bounds) MinY() Coordinate {
+	return b.minY
+}
+
+// MaxX returns the maximum X coordinate of the grid.
+func (b Bounds) MaxX() Coordinate {
+	return b.maxX
+}
+
+// MaxY returns the maximum Y coordinate of the grid.
+func (b Bounds) MaxY() Coordinate {
+	return b.maxY
+}
+
+// Contains reports whether the coordinates lie within the bounds.
+func (b Bounds) Contains(x, y Coordinate) bool {
+	return x >= b.minX && x <= b.maxX && y >= b.minY && y <= b.maxY
+}
+
+// String returns the bounds in the format "Bounds(minX,minY..maxX,maxY)".
+func (b Bounds) String() string {
+	return fmt.Sprintf("Bounds(%d,%d..%d,%d)", b.minX, b.minY, b.maxX, b.maxY)
+}
+
+// randomLocation returns a location with random coordinates within the bounds.
+func (b Bounds) randomLocation() (Location, error) {
+	x := Coordinate(rand.IntN(int(b.maxX)-int(b.minX)+1) + int(b.minX)) //nolint:gosec // it's ok
+	y := Coordinate(rand.IntN(int(b.maxY)-int(b.minY)+1) + int(b.minY)) //nolint:gosec // it's ok
+	return NewLocationInBounds(x, y, b)
+}
+
+func validateAxis(minValue, maxValue Coordinate) error {
+	if minValue < 0 {
+		return errs.NewValueIsOutOfRangeError("minimum", minValue, Coordinate(0), Coordinate(math.MaxInt8))
+	}
+	if maxValue <= minValue {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"grid axis is invalid",
+			fmt.Errorf("maximum %d must be above minimum %d", maxValue, minValue),
+		)
+	}
+	return nil
+}
+
+// grid holds the bounds of the delivery grid NewLocation validates against.
+var grid atomic.Pointer[Bounds]
+
+// SetGrid configures the bounds of the delivery grid used by NewLocation and NewRandomLocation.
+// It is meant to be called once by the composition root before any location is created;
+// locations created earlier keep their coordinates. Returns an error for unconstructed bounds.
+func SetGrid(bounds Bounds) error {
+	if err := bounds.Validate(); err != nil {
+		return err
+	}
+
+	grid.Store(&bounds)
+	return nil
+}
+
+// Grid returns the bounds of the delivery grid, DefaultBounds unless SetGrid configured others.
+func Grid() Bounds {
+	if bounds := grid.Load(); bounds != nil {
+		return *bounds
+	}
+	return DefaultBounds()
+}
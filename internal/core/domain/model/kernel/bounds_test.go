@@ -0,0 +1,121 @@
+package kernel_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+)
+
+func TestNewBounds(t *testing.T) {
+	t.Run("should create bounds", func(t *testing.T) {
+		bounds, err := kernel.NewBounds(0, 1, 50, 40)
+
+		require.NoError(t, err)
+		require.NoError(t, bounds.Validate())
+		assert.Equal(t, kernel.Coordinate(0), bounds.MinX())
+		assert.Equal(t, kernel.Coordinate(1), bounds.MinY())
+		assert.Equal(t, kernel.Coordinate(50), bounds.MaxX())
+		assert.Equal(t, kernel.Coordinate(40), bounds.MaxY())
+		assert.Equal(t, "Bounds(0,1..50,40)", bounds.String())
+	})
+
+	t.Run("should refuse negative minimums and empty axes", func(t *testing.T) {
+		_, err := kernel.NewBounds(-1, 5, 10, 5)
+
+		var validation *errs.ValidationErrors
+		require.ErrorAs(t, err, &validation)
+		require.Len(t, validation.Fields, 2)
+		assert.Equal(t, "x", validation.Fields[0].Field)
+		assert.Equal(t, "y", validation.Fields[1].Field)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, kernel.Bounds{}.Validate(), kernel.ErrBoundsIsNotConstructed)
+	})
+
+	t.Run("should default to the fixed grid", func(t *testing.T) {
+		bounds := kernel.DefaultBounds()
+
+		assert.Equal(t, kernel.LocationMinX, bounds.MinX())
+		assert.Equal(t, kernel.LocationMaxY, bounds.MaxY())
+		assert.True(t, bounds.Contains(10, 10))
+		assert.False(t, bounds.Contains(11, 10))
+	})
+}
+
+func TestNewLocationInBounds(t *testing.T) {
+	bounds, err := kernel.NewBounds(1, 1, 100, 60)
+	require.NoError(t, err)
+
+	t.Run("should accept coordinates beyond the default grid", func(t *testing.T) {
+		loc, err := kernel.NewLocationInBounds(100, 60, bounds)
+
+		require.NoError(t, err)
+		assert.Equal(t, kernel.Coordinate(100), loc.X())
+		assert.Equal(t, kernel.Coordinate(60), loc.Y())
+	})
+
+	t.Run("should refuse coordinates outside the bounds", func(t *testing.T) {
+		_, err := kernel.NewLocationInBounds(101, 61, bounds)
+
+		var validation *errs.ValidationErrors
+		require.ErrorAs(t, err, &validation)
+		assert.Len(t, validation.Fields, 2)
+		require.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+	})
+
+	t.Run("should refuse unconstructed bounds", func(t *testing.T) {
+		_, err := kernel.NewLocationInBounds(1, 1, kernel.Bounds{})
+
+		require.ErrorIs(t, err, kernel.ErrBoundsIsNotConstructed)
+	})
+
+	t.Run("should generate random locations within the bounds", func(t *testing.T) {
+		small, _ := kernel.NewBounds(20, 30, 21, 31)
+		for range 50 {
+			loc, err := kernel.NewRandomLocationInBounds(small)
+
+			require.NoError(t, err)
+			assert.True(t, small.Contains(loc.X(), loc.Y()), loc.String())
+		}
+	})
+
+	t.Run("should measure distances across a large grid", func(t *testing.T) {
+		large, _ := kernel.NewBounds(0, 0, 127, 127)
+		from, _ := kernel.NewLocationInBounds(0, 0, large)
+		to, _ := kernel.NewLocationInBounds(127, 127, large)
+
+		distance, err := from.Distance(to)
+
+		require.NoError(t, err)
+		assert.Equal(t, 254, distance)
+	})
+}
+
+func TestSetGrid(t *testing.T) {
+	bounds, err := kernel.NewBounds(1, 1, 30, 20)
+	require.NoError(t, err)
+	require.NoError(t, kernel.SetGrid(bounds))
+	t.Cleanup(func() { _ = kernel.SetGrid(kernel.DefaultBounds()) })
+
+	assert.Equal(t, bounds, kernel.Grid())
+
+	loc, err := kernel.NewLocation(30, 20)
+	require.NoError(t, err)
+	assert.Equal(t, kernel.Coordinate(30), loc.X())
+
+	_, err = kernel.NewLocation(31, 20)
+	require.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+
+	for range 50 {
+		random, randomErr := kernel.NewRandomLocation()
+		require.NoError(t, randomErr)
+		assert.True(t, bounds.Contains(random.X(), random.Y()))
+	}
+
+	require.ErrorIs(t, kernel.SetGrid(kernel.Bounds{}), kernel.ErrBoundsIsNotConstructed)
+}
@@ -5,6 +5,8 @@
 // The package includes:
 //   - UUID: A value object for unique identifiers with validation and comparison capabilities
 //   - Location: A value object representing coordinates on the delivery grid
+//   - Bounds: A value object representing the extent of a grid; the delivery grid is configured with SetGrid
+//   - Zone: A value object representing a rectangular area of the delivery grid
 //   - ConstructorGuard: A defensive programming pattern to ensure proper object construction
 //
 // These primitives enforce domain invariants and validation rules, ensuring that
@@ -3,24 +3,23 @@ package kernel
 import (
 	"errors"
 	"fmt"
-	"math/rand/v2"
 
 	"delivery/internal/pkg/errs"
 	"delivery/internal/pkg/guard"
 )
 
 // Coordinate represents a position value on the delivery grid.
-// Valid coordinates range from LocationMinX/Y to LocationMaxX/Y inclusive.
+// Valid coordinates lie within the bounds of the grid, see Grid.
 type Coordinate int8
 
 const (
-	// LocationMinX is the minimum valid X coordinate on the delivery grid.
+	// LocationMinX is the minimum X coordinate of the default delivery grid.
 	LocationMinX Coordinate = 1
-	// LocationMinY is the minimum valid Y coordinate on the delivery grid.
+	// LocationMinY is the minimum Y coordinate of the default delivery grid.
 	LocationMinY Coordinate = 1
-	// LocationMaxX is the maximum valid X coordinate on the delivery grid.
+	// LocationMaxX is the maximum X coordinate of the default delivery grid.
 	LocationMaxX Coordinate = 10
-	// LocationMaxY is the maximum valid Y coordinate on the delivery grid.
+	// LocationMaxY is the maximum Y coordinate of the default delivery grid.
 	LocationMaxY Coordinate = 10
 )
 
@@ -47,12 +46,12 @@ type Location struct { //nolint:recvcheck //using for validation
 }
 
 // NewLocation creates a new Location with the specified coordinates.
-// Both x and y coordinates must be within the valid range [LocationMinX..LocationMaxX] and [LocationMinY..LocationMaxY].
+// Both x and y coordinates must be within the bounds of the delivery grid, see Grid.
 // Returns an error if either coordinate is outside the valid bounds.
 //
 // Parameters:
-//   - x: The X coordinate (must be between LocationMinX and LocationMaxX inclusive)
-//   - y: The Y coordinate (must be between LocationMinY and LocationMaxY inclusive)
+//   - x: The X coordinate (must be between the grid's MinX and MaxX inclusive)
+//   - y: The Y coordinate (must be between the grid's MinY and MaxY inclusive)
 //
 // Returns:
 //   - Location: A valid location instance
@@ -66,11 +65,30 @@ type Location struct { //nolint:recvcheck //using for validation
 //	}
 //	// loc is now ready to use
 func NewLocation(x Coordinate, y Coordinate) (Location, error) {
+	return NewLocationInBounds(x, y, Grid())
+}
+
+// NewLocationInBounds creates a new Location with the specified coordinates within the given bounds
+// rather than the bounds of the delivery grid.
+// Returns an error if the bounds are not constructed or either coordinate is outside them.
+//
+// Example:
+//
+//	bounds, _ := NewBounds(1, 1, 50, 40)
+//	loc, err := NewLocationInBounds(42, 17, bounds)
+func NewLocationInBounds(x Coordinate, y Coordinate, bounds Bounds) (Location, error) {
+	if err := bounds.Validate(); err != nil {
+		return Location{}, err
+	}
+
 	loc := Location{
 		guard: guard.NewConstructorGuard(),
 	}
 
-	if err := errors.Join(loc.setX(x), loc.setY(y)); err != nil {
+	if err := errs.JoinFields(
+		errs.Field("x", loc.setX(x, bounds)),
+		errs.Field("y", loc.setY(y, bounds)),
+	); err != nil {
 		return Location{}, err
 	}
 
@@ -78,7 +96,7 @@ func NewLocation(x Coordinate, y Coordinate) (Location, error) {
 }
 
 // NewRandomLocation creates a new Location with randomly generated coordinates.
-// The coordinates are guaranteed to be within valid bounds [LocationMinX..LocationMaxX] and [LocationMinY..LocationMaxY].
+// The coordinates are guaranteed to be within the bounds of the delivery grid, see Grid.
 // This function is useful for testing or generating random delivery locations.
 //
 // Returns:
@@ -93,9 +111,16 @@ func NewLocation(x Coordinate, y Coordinate) (Location, error) {
 //	}
 //	fmt.Printf("Random location: %s", loc)
 func NewRandomLocation() (Location, error) {
-	x := Coordinate(rand.IntN(int(LocationMaxX-LocationMinX+1)) + int(LocationMinX)) //nolint:gosec // it's ok
-	y := Coordinate(rand.IntN(int(LocationMaxY-LocationMinY+1)) + int(LocationMinY)) //nolint:gosec // it's ok
-	return NewLocation(x, y)
+	return Grid().randomLocation()
+}
+
+// NewRandomLocationInBounds creates a new Location with random coordinates within the given bounds.
+// Returns an error only if the bounds are not constructed.
+func NewRandomLocationInBounds(bounds Bounds) (Location, error) {
+	if err := bounds.Validate(); err != nil {
+		return Location{}, err
+	}
+	return bounds.randomLocation()
 }
 
 // Validate checks if the Location was properly constructed using a constructor.
@@ -121,7 +146,7 @@ func (l Location) Validate() error {
 }
 
 // X returns the X coordinate of the location.
-// The returned coordinate is guaranteed to be within valid bounds [LocationMinX..LocationMaxX]
+// The returned coordinate is guaranteed to be within the bounds the location was created in
 // for properly constructed Location instances.
 //
 // Returns:
@@ -136,7 +161,7 @@ func (l Location) X() Coordinate {
 }
 
 // Y returns the Y coordinate of the location.
-// The returned coordinate is guaranteed to be within valid bounds [LocationMinY..LocationMaxY]
+// The returned coordinate is guaranteed to be within the bounds the location was created in
 // for properly constructed Location instances.
 //
 // Returns:
@@ -224,9 +249,10 @@ func (l Location) Distance(other Location) (int, error) {
 		return 0, err
 	}
 
-	dx := abs(l.x - other.x)
-	dy := abs(l.y - other.y)
-	return int(dx + dy), nil
+	// Computed in int: on a large grid the sum of both axes overflows a Coordinate
+	dx := abs(int(l.x) - int(other.x))
+	dy := abs(int(l.y) - int(other.y))
+	return dx + dy, nil
 }
 
 // setX sets the x coordinate with validation.
@@ -234,9 +260,9 @@ func (l Location) Distance(other Location) (int, error) {
 // Although mixing receiver types is generally not recommended, in this case we use pointer
 // receivers for these private setters to enable self-encapsulated validation of business
 // requirements during object construction.
-func (l *Location) setX(x Coordinate) error {
-	if x < LocationMinX || x > LocationMaxX {
-		return errs.NewValueIsOutOfRangeError("x", x, LocationMinX, LocationMaxX)
+func (l *Location) setX(x Coordinate, bounds Bounds) error {
+	if x < bounds.MinX() || x > bounds.MaxX() {
+		return errs.NewValueIsOutOfRangeError("x", x, bounds.MinX(), bounds.MaxX())
 	}
 
 	l.x = x
@@ -248,16 +274,16 @@ func (l *Location) setX(x Coordinate) error {
 // Although mixing receiver types is generally not recommended, in this case we use pointer
 // receivers for these private setters to enable self-encapsulated validation of business
 // requirements during object construction.
-func (l *Location) setY(y Coordinate) error {
-	if y < LocationMinY || y > LocationMaxY {
-		return errs.NewValueIsOutOfRangeError("y", y, LocationMinY, LocationMaxY)
+func (l *Location) setY(y Coordinate, bounds Bounds) error {
+	if y < bounds.MinY() || y > bounds.MaxY() {
+		return errs.NewValueIsOutOfRangeError("y", y, bounds.MinY(), bounds.MaxY())
 	}
 
 	l.y = y
 	return nil
 }
 
-func abs(x Coordinate) Coordinate {
+func abs(x int) int {
 	if x < 0 {
 		return -x
 	}
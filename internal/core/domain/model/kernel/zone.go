@@ -0,0 +1,80 @@
+package kernel
+
+import (
+	"errors"
+	"fmt"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// ErrZoneIsNotConstructed is returned when attempting to use an improperly initialized Zone.
+var ErrZoneIsNotConstructed = errs.NewValueIsRequiredError("zone must be created via NewZone constructor")
+
+// Zone is a rectangular area of the delivery grid, bounded by two opposite corners inclusive.
+// Zone is an immutable value object; the corners are normalized so that From is the
+// lower-left and To the upper-right corner regardless of the order they were given in.
+//
+// Example:
+//
+//	from, _ := kernel.NewLocation(1, 1)
+//	to, _ := kernel.NewLocation(5, 5)
+//	zone, err := kernel.NewZone(from, to)
+//	if err != nil {
+//	    // Handle validation error
+//	}
+//	fmt.Println(zone.Contains(courierLocation))
+type Zone struct { //nolint:recvcheck //using for validation
+	from  Location
+	to    Location
+	guard guard.ConstructorGuard
+}
+
+// NewZone creates a zone spanning the rectangle between two opposite corners.
+// Returns a validation error if either corner is not a constructed Location.
+func NewZone(from Location, to Location) (Zone, error) {
+	if err := errs.JoinFields(errs.Field("from", from.Validate()), errs.Field("to", to.Validate())); err != nil {
+		return Zone{}, err
+	}
+
+	// The normalized corners lie within whatever bounds the given corners were created in
+	lower := Location{x: min(from.X(), to.X()), y: min(from.Y(), to.Y()), guard: guard.NewConstructorGuard()}
+	upper := Location{x: max(from.X(), to.X()), y: max(from.Y(), to.Y()), guard: guard.NewConstructorGuard()}
+
+	return Zone{
+		from:  lower,
+		to:    upper,
+		guard: guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate checks if the Zone was properly constructed using NewZone.
+func (z Zone) Validate() error {
+	return z.guard.Validate(ErrZoneIsNotConstructed)
+}
+
+// From returns the lower-left corner of the zone.
+func (z Zone) From() Location {
+	return z.from
+}
+
+// To returns the upper-right corner of the zone.
+func (z Zone) To() Location {
+	return z.to
+}
+
+// Contains reports whether the location lies within the zone, borders included.
+// Both the zone and the location must be properly constructed.
+func (z Zone) Contains(location Location) (bool, error) {
+	if err := errors.Join(z.Validate(), location.Validate()); err != nil {
+		return false, err
+	}
+
+	return location.X() >= z.from.X() && location.X() <= z.to.X() &&
+		location.Y() >= z.from.Y() && location.Y() <= z.to.Y(), nil
+}
+
+// String returns a human-readable representation in the format "Zone(x1,y1-x2,y2)".
+func (z Zone) String() string {
+	return fmt.Sprintf("Zone(%d,%d-%d,%d)", z.from.X(), z.from.Y(), z.to.X(), z.to.Y())
+}
@@ -0,0 +1,71 @@
+package kernel_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"delivery/internal/core/domain/model/kernel"
+)
+
+func mustLocation(t *testing.T, x, y kernel.Coordinate) kernel.Location {
+	t.Helper()
+	location, err := kernel.NewLocation(x, y)
+	require.NoError(t, err)
+	return location
+}
+
+func TestNewZone(t *testing.T) {
+	t.Run("should normalize the corners", func(t *testing.T) {
+		zone, err := kernel.NewZone(mustLocation(t, 7, 2), mustLocation(t, 3, 8))
+
+		require.NoError(t, err)
+		require.NoError(t, zone.Validate())
+		assert.Equal(t, mustLocation(t, 3, 2), zone.From())
+		assert.Equal(t, mustLocation(t, 7, 8), zone.To())
+		assert.Equal(t, "Zone(3,2-7,8)", zone.String())
+	})
+
+	t.Run("should fail with an unconstructed corner", func(t *testing.T) {
+		_, err := kernel.NewZone(kernel.Location{}, mustLocation(t, 3, 8))
+
+		require.ErrorIs(t, err, kernel.ErrLocationIsNotConstructed)
+	})
+
+	t.Run("zero value should be invalid", func(t *testing.T) {
+		require.ErrorIs(t, kernel.Zone{}.Validate(), kernel.ErrZoneIsNotConstructed)
+	})
+}
+
+func TestZone_Contains(t *testing.T) {
+	zone, err := kernel.NewZone(mustLocation(t, 2, 2), mustLocation(t, 4, 5))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		location kernel.Location
+		want     bool
+	}{
+		{"inside", mustLocation(t, 3, 3), true},
+		{"on the lower corner", mustLocation(t, 2, 2), true},
+		{"on the upper border", mustLocation(t, 4, 5), true},
+		{"left of the zone", mustLocation(t, 1, 3), false},
+		{"above the zone", mustLocation(t, 3, 6), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contains, err := zone.Contains(tt.location)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, contains)
+		})
+	}
+
+	t.Run("should fail with an unconstructed location", func(t *testing.T) {
+		_, err := zone.Contains(kernel.Location{})
+
+		require.ErrorIs(t, err, kernel.ErrLocationIsNotConstructed)
+	})
+}
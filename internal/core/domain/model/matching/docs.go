@@ -0,0 +1,20 @@
+// Package matching provides the domain model of dispatch matching rules: the constraints
+// operations put on which couriers may take which orders, such as large orders going by car only.
+//
+// The package includes:
+//   - Condition: The orders a rule applies to, by volume, temperature class and delivery zone
+//   - Requirement: The vehicle types and storage a courier must offer to take such an order
+//   - Rule: A named condition with its requirement
+//
+// Key business rules:
+//   - A rule applies to an order when every criterion of its condition holds; a condition
+//     without criteria applies to every order
+//   - A rule requires something: allowed vehicle types, thermal storage or both
+//   - A courier is eligible for an order when it meets the requirement of every rule applying to it;
+//     couriers without a declared vehicle type never meet a vehicle type requirement
+//   - Thermal storage is met when the storage place the courier would put the order into is thermal
+//   - Rule names are unique, ignoring case
+//
+// The package follows Domain-Driven Design principles, providing rich domain
+// behavior, encapsulation, and validation to ensure business rules are enforced.
+package matching
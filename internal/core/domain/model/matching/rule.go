@@ -0,0 +1,305 @@
+package matching
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+const (
+	// MaxNameLength is the maximum number of characters in the name of a rule.
+	MaxNameLength = 100
+)
+
+var (
+	// ErrRuleIsNotConstructed indicates that a Rule was not properly
+	// initialized through the NewRule constructor function.
+	ErrRuleIsNotConstructed = errors.New("Rule must be created via NewRule constructor")
+
+	// ErrRuleRequiresNothing is returned when a rule neither restricts vehicle types nor requires thermal storage.
+	ErrRuleRequiresNothing = errors.New("rule must require vehicle types or thermal storage")
+
+	// ErrRuleIsDuplicated is returned when two rules share a name.
+	ErrRuleIsDuplicated = errors.New("rule name is already used")
+)
+
+// Condition selects the orders a rule applies to. Every criterion that is set must hold.
+type Condition struct {
+	// VolumeAbove selects orders whose volume exceeds it; nil ignores the volume
+	VolumeAbove *int
+
+	// TemperatureClass selects orders of the class; nil ignores the class
+	TemperatureClass *order.TemperatureClass
+
+	// Zone selects orders delivered within it, borders included; nil ignores the location
+	Zone *kernel.Zone
+}
+
+// Requirement is what a courier must offer to take an order a rule applies to.
+type Requirement struct {
+	// VehicleTypes lists the vehicle types allowed; empty allows any vehicle
+	VehicleTypes []courier.VehicleType
+
+	// ThermalStorage requires the order to travel in a thermal storage place
+	ThermalStorage bool
+}
+
+// Rule restricts the couriers that may take the orders matching its condition.
+//
+// Key business rules:
+//   - Must be constructed through NewRule
+//   - The name is not blank and has at most MaxNameLength characters
+//   - The volume threshold is not negative; the temperature class and the zone are valid
+//   - The requirement lists valid vehicle types, requires thermal storage, or both;
+//     vehicle types are kept sorted and once each
+type Rule struct {
+	// id uniquely identifies the rule
+	id kernel.UUID
+
+	// name is what operations know the rule by
+	name string
+
+	// condition selects the orders the rule applies to
+	condition Condition
+
+	// requirement is what a courier must offer to take those orders
+	requirement Requirement
+
+	// guard ensures the entity was properly initialized
+	guard guard.ConstructorGuard
+}
+
+// NewRule creates a rule. Used by the admin API and by repositories restoring stored rules.
+//
+// Example:
+//
+//	above := 20
+//	rule, err := matching.NewRule(
+//	    kernel.NewUUID(),
+//	    "Крупные заказы",
+//	    matching.Condition{VolumeAbove: &above},
+//	    matching.Requirement{VehicleTypes: []courier.VehicleType{courier.Car}},
+//	)
+func NewRule(id kernel.UUID, name string, condition Condition, requirement Requirement) (*Rule, error) {
+	rule := &Rule{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := errs.JoinFields(
+		errs.Field("id", rule.setID(id)),
+		errs.Field("name", rule.setName(name)),
+		errs.Field("condition", rule.setCondition(condition)),
+		errs.Field("requirement", rule.setRequirement(requirement)),
+	); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// Validate ensures the Rule instance was properly constructed through NewRule.
+func (r *Rule) Validate() error {
+	if r == nil {
+		return ErrRuleIsNotConstructed
+	}
+
+	return r.guard.Validate(ErrRuleIsNotConstructed)
+}
+
+// ID returns the rule's unique identifier.
+func (r *Rule) ID() kernel.UUID {
+	return r.id
+}
+
+// Name returns the name of the rule.
+func (r *Rule) Name() string {
+	return r.name
+}
+
+// Condition returns the orders the rule applies to.
+func (r *Rule) Condition() Condition {
+	return Condition{
+		VolumeAbove:      copyOf(r.condition.VolumeAbove),
+		TemperatureClass: copyOf(r.condition.TemperatureClass),
+		Zone:             copyOf(r.condition.Zone),
+	}
+}
+
+// Requirement returns what a courier must offer to take the orders the rule applies to.
+// Vehicle types are sorted.
+func (r *Rule) Requirement() Requirement {
+	requirement := r.requirement
+	requirement.VehicleTypes = slices.Clone(r.requirement.VehicleTypes)
+	return requirement
+}
+
+// AppliesTo reports whether the order matches every criterion of the rule's condition.
+func (r *Rule) AppliesTo(o *order.Order) (bool, error) {
+	if r.condition.VolumeAbove != nil && o.Volume() <= *r.condition.VolumeAbove {
+		return false, nil
+	}
+	if r.condition.TemperatureClass != nil && o.TemperatureClass() != *r.condition.TemperatureClass {
+		return false, nil
+	}
+	if r.condition.Zone != nil {
+		return r.condition.Zone.Contains(o.Location())
+	}
+	return true, nil
+}
+
+// IsMetBy reports whether the courier offers what the rule requires to take the order: an allowed
+// vehicle type and, with thermal storage required, a thermal storage place for it. Couriers store
+// orders in the first storage place that fits, see courier.Courier.TakeOrder, so that place must be
+// thermal. Whether the rule applies to the order is not checked.
+func (r *Rule) IsMetBy(o *order.Order, c *courier.Courier) (bool, error) {
+	if len(r.requirement.VehicleTypes) > 0 {
+		vehicleType := c.Profile().VehicleType()
+		if vehicleType == nil || !slices.Contains(r.requirement.VehicleTypes, *vehicleType) {
+			return false, nil
+		}
+	}
+
+	if !r.requirement.ThermalStorage {
+		return true, nil
+	}
+	for _, storagePlace := range c.StoragePlaces() {
+		canStore, err := storagePlace.CanStore(o.Volume())
+		if err != nil {
+			return false, err
+		}
+		if canStore {
+			return storagePlace.IsThermal(), nil
+		}
+	}
+	return false, nil
+}
+
+// Admits reports whether the courier is eligible for the order: it meets the requirement
+// of every rule that applies to the order. Without rules every courier is eligible.
+func Admits(rules []*Rule, o *order.Order, c *courier.Courier) (bool, error) {
+	for _, rule := range rules {
+		applies, err := rule.AppliesTo(o)
+		if err != nil {
+			return false, err
+		}
+		if !applies {
+			continue
+		}
+
+		met, err := rule.IsMetBy(o, c)
+		if err != nil || !met {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// CheckRules ensures no two rules share a name, ignoring case.
+// Returns ErrRuleIsDuplicated naming the first duplicate.
+func CheckRules(rules []*Rule) error {
+	seen := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+
+		key := strings.ToLower(rule.name)
+		if seen[key] {
+			return fmt.Errorf("%w: %s", ErrRuleIsDuplicated, rule.name)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+func (r *Rule) setID(id kernel.UUID) error {
+	if err := id.Validate(); err != nil {
+		return err
+	}
+
+	r.id = id
+	return nil
+}
+
+func (r *Rule) setName(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errs.NewValueIsRequiredError("name")
+	}
+	if utf8.RuneCountInString(name) > MaxNameLength {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"name is invalid",
+			fmt.Errorf("must be at most %d characters", MaxNameLength),
+		)
+	}
+
+	r.name = name
+	return nil
+}
+
+func (r *Rule) setCondition(condition Condition) error {
+	var volumeErr, classErr, zoneErr error
+	if condition.VolumeAbove != nil && *condition.VolumeAbove < 0 {
+		volumeErr = errs.NewValueIsInvalidErrorWithCause(
+			"volume threshold is invalid",
+			fmt.Errorf("%d is negative", *condition.VolumeAbove),
+		)
+	}
+	if condition.TemperatureClass != nil {
+		classErr = condition.TemperatureClass.Validate()
+	}
+	if condition.Zone != nil {
+		zoneErr = condition.Zone.Validate()
+	}
+	if err := errs.JoinFields(
+		errs.Field("volumeAbove", volumeErr),
+		errs.Field("temperatureClass", classErr),
+		errs.Field("zone", zoneErr),
+	); err != nil {
+		return err
+	}
+
+	r.condition = Condition{
+		VolumeAbove:      copyOf(condition.VolumeAbove),
+		TemperatureClass: copyOf(condition.TemperatureClass),
+		Zone:             copyOf(condition.Zone),
+	}
+	return nil
+}
+
+func (r *Rule) setRequirement(requirement Requirement) error {
+	if len(requirement.VehicleTypes) == 0 && !requirement.ThermalStorage {
+		return ErrRuleRequiresNothing
+	}
+
+	for _, vehicleType := range requirement.VehicleTypes {
+		if err := vehicleType.Validate(); err != nil {
+			return errs.JoinFields(errs.Field("vehicleTypes", err))
+		}
+	}
+
+	vehicleTypes := slices.Clone(requirement.VehicleTypes)
+	slices.Sort(vehicleTypes)
+	r.requirement = Requirement{
+		VehicleTypes:   slices.Compact(vehicleTypes),
+		ThermalStorage: requirement.ThermalStorage,
+	}
+	return nil
+}
+
+// copyOf returns a pointer to a copy of the value so callers cannot alter the rule.
+func copyOf[T any](value *T) *T {
+	if value == nil {
+		return nil
+	}
+	c := *value
+	return &c
+}
@@ -0,0 +1,250 @@
+package matching_test
+
+import (
+	"strings"
+	"testing"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/matching"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func zone(fromX, fromY, toX, toY kernel.Coordinate) kernel.Zone {
+	from, _ := kernel.NewLocation(fromX, fromY)
+	to, _ := kernel.NewLocation(toX, toY)
+	z, _ := kernel.NewZone(from, to)
+	return z
+}
+
+func rule(t *testing.T, name string, condition matching.Condition, requirement matching.Requirement) *matching.Rule {
+	t.Helper()
+	r, err := matching.NewRule(kernel.NewUUID(), name, condition, requirement)
+	require.NoError(t, err)
+	return r
+}
+
+func newOrder(t *testing.T, x, y kernel.Coordinate, volume int, class order.TemperatureClass) *order.Order {
+	t.Helper()
+	location, err := kernel.NewLocation(x, y)
+	require.NoError(t, err)
+	o, err := order.NewOrder(kernel.NewUUID(), location, volume)
+	require.NoError(t, err)
+	require.NoError(t, o.ChangeTemperatureClass(class))
+	return o
+}
+
+// newCourier returns a courier with a 10-volume bag and the given vehicle, UnknownVehicleType for none.
+func newCourier(t *testing.T, vehicleType courier.VehicleType) *courier.Courier {
+	t.Helper()
+	location, err := kernel.NewLocation(1, 1)
+	require.NoError(t, err)
+	c, err := courier.NewCourier(kernel.NewUUID(), "Alice", 2, location)
+	require.NoError(t, err)
+	if vehicleType != courier.UnknownVehicleType {
+		profile, profileErr := courier.Profile{}.WithVehicleType(vehicleType)
+		require.NoError(t, profileErr)
+		c.ChangeProfile(profile)
+	}
+	return c
+}
+
+func vehicles(types ...courier.VehicleType) matching.Requirement {
+	return matching.Requirement{VehicleTypes: types}
+}
+
+func TestNewRule(t *testing.T) {
+	t.Run("should create rule", func(t *testing.T) {
+		id := kernel.NewUUID()
+		above := 20
+		class := order.Frozen
+		z := zone(1, 1, 4, 4)
+
+		r, err := matching.NewRule(id, " Крупные заказы ", matching.Condition{
+			VolumeAbove: &above, TemperatureClass: &class, Zone: &z,
+		}, matching.Requirement{
+			VehicleTypes:   []courier.VehicleType{courier.Car, courier.Scooter, courier.Car},
+			ThermalStorage: true,
+		})
+
+		require.NoError(t, err)
+		require.NoError(t, r.Validate())
+		assert.Equal(t, id, r.ID())
+		assert.Equal(t, "Крупные заказы", r.Name())
+		assert.Equal(t, 20, *r.Condition().VolumeAbove)
+		assert.Equal(t, order.Frozen, *r.Condition().TemperatureClass)
+		assert.Equal(t, "Zone(1,1-4,4)", r.Condition().Zone.String())
+		assert.Equal(t, []courier.VehicleType{courier.Scooter, courier.Car}, r.Requirement().VehicleTypes)
+		assert.True(t, r.Requirement().ThermalStorage)
+	})
+
+	negative := -1
+	unknownClass := order.UnknownTemperatureClass
+	tests := []struct {
+		name        string
+		ruleName    string
+		condition   matching.Condition
+		requirement matching.Requirement
+		err         error
+	}{
+		{"blank name", " ", matching.Condition{}, vehicles(courier.Car), errs.ErrValueIsRequired},
+		{"too long name", strings.Repeat("x", matching.MaxNameLength+1), matching.Condition{}, vehicles(courier.Car), errs.ErrValueIsInvalid},
+		{"negative volume", "Rule", matching.Condition{VolumeAbove: &negative}, vehicles(courier.Car), errs.ErrValueIsInvalid},
+		{"unknown class", "Rule", matching.Condition{TemperatureClass: &unknownClass}, vehicles(courier.Car), errs.ErrValueIsInvalid},
+		{"unknown vehicle type", "Rule", matching.Condition{}, vehicles(courier.UnknownVehicleType), errs.ErrValueIsInvalid},
+		{"no requirement", "Rule", matching.Condition{}, matching.Requirement{}, matching.ErrRuleRequiresNothing},
+	}
+
+	for _, tt := range tests {
+		t.Run("should reject "+tt.name, func(t *testing.T) {
+			_, err := matching.NewRule(kernel.NewUUID(), tt.ruleName, tt.condition, tt.requirement)
+
+			require.ErrorIs(t, err, errs.ErrValidationFailed)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, (&matching.Rule{}).Validate(), matching.ErrRuleIsNotConstructed)
+	})
+}
+
+func TestRule_AppliesTo(t *testing.T) {
+	above := 20
+	frozen := order.Frozen
+	center := zone(3, 3, 6, 6)
+
+	tests := []struct {
+		name      string
+		condition matching.Condition
+		order     *order.Order
+		expected  bool
+	}{
+		{"no criteria", matching.Condition{}, newOrder(t, 1, 1, 5, order.Ambient), true},
+		{"volume above threshold", matching.Condition{VolumeAbove: &above}, newOrder(t, 1, 1, 21, order.Ambient), true},
+		{"volume at threshold", matching.Condition{VolumeAbove: &above}, newOrder(t, 1, 1, 20, order.Ambient), false},
+		{"same class", matching.Condition{TemperatureClass: &frozen}, newOrder(t, 1, 1, 5, order.Frozen), true},
+		{"other class", matching.Condition{TemperatureClass: &frozen}, newOrder(t, 1, 1, 5, order.Chilled), false},
+		{"on zone border", matching.Condition{Zone: &center}, newOrder(t, 6, 3, 5, order.Ambient), true},
+		{"outside zone", matching.Condition{Zone: &center}, newOrder(t, 7, 3, 5, order.Ambient), false},
+		{"only some criteria hold", matching.Condition{VolumeAbove: &above, Zone: &center}, newOrder(t, 4, 4, 5, order.Ambient), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := rule(t, "Rule", tt.condition, vehicles(courier.Car))
+
+			applies, err := r.AppliesTo(tt.order)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, applies)
+		})
+	}
+}
+
+func TestRule_IsMetBy(t *testing.T) {
+	t.Run("vehicle types", func(t *testing.T) {
+		r := rule(t, "Rule", matching.Condition{}, vehicles(courier.Bicycle, courier.Scooter))
+		o := newOrder(t, 1, 1, 5, order.Ambient)
+
+		for vehicleType, expected := range map[courier.VehicleType]bool{
+			courier.Bicycle:            true,
+			courier.Scooter:            true,
+			courier.Car:                false,
+			courier.UnknownVehicleType: false,
+		} {
+			met, err := r.IsMetBy(o, newCourier(t, vehicleType))
+
+			require.NoError(t, err)
+			assert.Equal(t, expected, met, vehicleType.String())
+		}
+	})
+
+	t.Run("thermal storage", func(t *testing.T) {
+		r := rule(t, "Rule", matching.Condition{}, matching.Requirement{ThermalStorage: true})
+		o := newOrder(t, 1, 1, 15, order.Frozen)
+
+		plain := newCourier(t, courier.Car)
+		require.NoError(t, plain.AddStoragePlace("Trunk", 20))
+		thermal := newCourier(t, courier.Car)
+		require.NoError(t, thermal.AddThermalStoragePlace("Thermal bag", 20))
+		// The order would go into the first storage place that fits, which is not thermal
+		plainFirst := newCourier(t, courier.Car)
+		require.NoError(t, plainFirst.AddStoragePlace("Trunk", 20))
+		require.NoError(t, plainFirst.AddThermalStoragePlace("Thermal bag", 20))
+
+		for name, tc := range map[string]struct {
+			courier  *courier.Courier
+			expected bool
+		}{
+			"plain storage":        {plain, false},
+			"thermal storage":      {thermal, true},
+			"plain storage first":  {plainFirst, false},
+			"no storage that fits": {newCourier(t, courier.Car), false},
+		} {
+			met, err := r.IsMetBy(o, tc.courier)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, met, name)
+		}
+	})
+}
+
+func TestAdmits(t *testing.T) {
+	above := 20
+	center := zone(3, 3, 6, 6)
+	rules := []*matching.Rule{
+		rule(t, "Large orders", matching.Condition{VolumeAbove: &above}, vehicles(courier.Car)),
+		rule(t, "Center", matching.Condition{Zone: &center}, vehicles(courier.Bicycle)),
+	}
+
+	t.Run("should admit any courier without rules", func(t *testing.T) {
+		admitted, err := matching.Admits(nil, newOrder(t, 4, 4, 30, order.Ambient), newCourier(t, courier.UnknownVehicleType))
+
+		require.NoError(t, err)
+		assert.True(t, admitted)
+	})
+
+	t.Run("should admit courier meeting every applying rule", func(t *testing.T) {
+		admitted, err := matching.Admits(rules, newOrder(t, 4, 4, 5, order.Ambient), newCourier(t, courier.Bicycle))
+
+		require.NoError(t, err)
+		assert.True(t, admitted)
+	})
+
+	t.Run("should ignore rules that do not apply", func(t *testing.T) {
+		admitted, err := matching.Admits(rules, newOrder(t, 1, 1, 5, order.Ambient), newCourier(t, courier.Scooter))
+
+		require.NoError(t, err)
+		assert.True(t, admitted)
+	})
+
+	t.Run("should refuse courier failing one applying rule", func(t *testing.T) {
+		admitted, err := matching.Admits(rules, newOrder(t, 4, 4, 30, order.Ambient), newCourier(t, courier.Car))
+
+		require.NoError(t, err)
+		assert.False(t, admitted)
+	})
+}
+
+func TestCheckRules(t *testing.T) {
+	t.Run("should accept distinct names", func(t *testing.T) {
+		require.NoError(t, matching.CheckRules([]*matching.Rule{
+			rule(t, "Large orders", matching.Condition{}, vehicles(courier.Car)),
+			rule(t, "Frozen", matching.Condition{}, matching.Requirement{ThermalStorage: true}),
+		}))
+	})
+
+	t.Run("should reject names differing only in case", func(t *testing.T) {
+		err := matching.CheckRules([]*matching.Rule{
+			rule(t, "Large orders", matching.Condition{}, vehicles(courier.Car)),
+			rule(t, "large ORDERS", matching.Condition{}, vehicles(courier.Scooter)),
+		})
+
+		require.ErrorIs(t, err, matching.ErrRuleIsDuplicated)
+	})
+}
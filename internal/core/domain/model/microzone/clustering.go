@@ -0,0 +1,256 @@
+package microzone
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// ErrClusteringIsNotConstructed indicates that a Clustering was not properly
+// initialized through the NewClustering constructor.
+var ErrClusteringIsNotConstructed = errors.New("Clustering must be created via NewClustering constructor")
+
+// DeliveryPoint is the number of historical deliveries made to one location of the grid.
+type DeliveryPoint struct {
+	Location   kernel.Location
+	Deliveries int
+}
+
+// Clustering groups delivery points into microzones by density: a location is dense when
+// at least minDeliveries deliveries were made within radius grid cells of it, counting its own.
+// Dense locations within radius of each other form one microzone; sparse locations within radius
+// of a dense one join the microzone of the nearest dense location, and the rest is left out.
+//
+// Key business rules:
+//   - Must be constructed through NewClustering
+//   - The radius is positive and measured like Location.Distance
+//   - The minimum number of deliveries is positive
+type Clustering struct {
+	// radius is the distance in grid cells within which deliveries count towards a location's density
+	radius int
+
+	// minDeliveries is the density from which a location is dense
+	minDeliveries int
+
+	// guard ensures the clustering was created via NewClustering
+	guard guard.ConstructorGuard
+}
+
+// NewClustering creates a clustering with validation.
+//
+// Example:
+//
+//	clustering, err := microzone.NewClustering(1, 50)
+//	zones, err := clustering.Cluster(points, time.Now())
+func NewClustering(radius int, minDeliveries int) (Clustering, error) {
+	if err := errs.JoinFields(
+		errs.Field("radius", positive("microzone radius", radius)),
+		errs.Field("minDeliveries", positive("microzone minimum deliveries", minDeliveries)),
+	); err != nil {
+		return Clustering{}, err
+	}
+
+	return Clustering{
+		radius:        radius,
+		minDeliveries: minDeliveries,
+		guard:         guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the clustering was created through the constructor.
+// Returns ErrClusteringIsNotConstructed if validation fails.
+func (c Clustering) Validate() error {
+	return c.guard.Validate(ErrClusteringIsNotConstructed)
+}
+
+// Radius returns the distance in grid cells within which deliveries count towards a location's density.
+func (c Clustering) Radius() int {
+	return c.radius
+}
+
+// MinDeliveries returns the density from which a location is dense.
+func (c Clustering) MinDeliveries() int {
+	return c.minDeliveries
+}
+
+// Cluster groups the delivery points into microzones computed at now, busiest first.
+// Points of the same location are added up and points without deliveries are ignored.
+// The result does not depend on the order of the points.
+func (c Clustering) Cluster(points []DeliveryPoint, now time.Time) ([]*Microzone, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	cells, err := aggregate(points)
+	if err != nil {
+		return nil, err
+	}
+
+	// Union-find over the dense cells; every cell starts as its own cluster
+	parent := make([]int, len(cells))
+	dense := make([]bool, len(cells))
+	for i := range cells {
+		parent[i] = i
+
+		density := 0
+		for j := range cells {
+			if cells[i].distance(cells[j]) <= c.radius {
+				density += cells[j].deliveries
+			}
+		}
+		dense[i] = density >= c.minDeliveries
+	}
+
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	for i := range cells {
+		for j := i + 1; j < len(cells); j++ {
+			if dense[i] && dense[j] && cells[i].distance(cells[j]) <= c.radius {
+				parent[find(j)] = find(i)
+			}
+		}
+	}
+
+	members := make(map[int][]cell)
+	for i := range cells {
+		core := i
+		if !dense[i] {
+			core = nearestDense(cells, dense, i, c.radius)
+			if core < 0 {
+				continue
+			}
+		}
+		root := find(core)
+		members[root] = append(members[root], cells[i])
+	}
+
+	microzones := make([]*Microzone, 0, len(members))
+	for _, cluster := range members {
+		microzone, zoneErr := newMicrozone(cluster, now)
+		if zoneErr != nil {
+			return nil, zoneErr
+		}
+		microzones = append(microzones, microzone)
+	}
+
+	slices.SortFunc(microzones, func(a, b *Microzone) int {
+		return cmp.Or(
+			cmp.Compare(b.deliveries, a.deliveries),
+			cmp.Compare(a.bounds.From().X(), b.bounds.From().X()),
+			cmp.Compare(a.bounds.From().Y(), b.bounds.From().Y()),
+		)
+	})
+	return microzones, nil
+}
+
+// cell is a grid location with the deliveries made to it.
+type cell struct {
+	x, y       kernel.Coordinate
+	deliveries int
+}
+
+func (c cell) distance(other cell) int {
+	return int(abs(c.x-other.x) + abs(c.y-other.y))
+}
+
+// aggregate adds up the deliveries per location and returns the locations with deliveries
+// ordered by their coordinates.
+func aggregate(points []DeliveryPoint) ([]cell, error) {
+	deliveries := make(map[[2]kernel.Coordinate]int)
+	for _, point := range points {
+		if err := point.Location.Validate(); err != nil {
+			return nil, err
+		}
+		if point.Deliveries > 0 {
+			deliveries[[2]kernel.Coordinate{point.Location.X(), point.Location.Y()}] += point.Deliveries
+		}
+	}
+
+	cells := make([]cell, 0, len(deliveries))
+	for xy, count := range deliveries {
+		cells = append(cells, cell{x: xy[0], y: xy[1], deliveries: count})
+	}
+	slices.SortFunc(cells, func(a, b cell) int {
+		return cmp.Or(cmp.Compare(a.x, b.x), cmp.Compare(a.y, b.y))
+	})
+	return cells, nil
+}
+
+// nearestDense returns the index of the dense cell nearest to cells[i] within radius,
+// the first in coordinate order on a tie, or -1 if there is none.
+func nearestDense(cells []cell, dense []bool, i int, radius int) int {
+	nearest := -1
+	for j := range cells {
+		if !dense[j] {
+			continue
+		}
+		d := cells[i].distance(cells[j])
+		if d <= radius && (nearest < 0 || d < cells[i].distance(cells[nearest])) {
+			nearest = j
+		}
+	}
+	return nearest
+}
+
+// newMicrozone builds the microzone of a cluster: its bounding rectangle, its centroid as the
+// delivery-weighted mean rounded to the nearest cell, and its total deliveries.
+func newMicrozone(cluster []cell, now time.Time) (*Microzone, error) {
+	minX, minY := cluster[0].x, cluster[0].y
+	maxX, maxY := minX, minY
+	var total, sumX, sumY int
+	for _, c := range cluster {
+		minX, maxX = min(minX, c.x), max(maxX, c.x)
+		minY, maxY = min(minY, c.y), max(maxY, c.y)
+		total += c.deliveries
+		sumX += int(c.x) * c.deliveries
+		sumY += int(c.y) * c.deliveries
+	}
+
+	from, err := kernel.NewLocation(minX, minY)
+	if err != nil {
+		return nil, err
+	}
+	to, err := kernel.NewLocation(maxX, maxY)
+	if err != nil {
+		return nil, err
+	}
+	bounds, err := kernel.NewZone(from, to)
+	if err != nil {
+		return nil, err
+	}
+	centroid, err := kernel.NewLocation(
+		kernel.Coordinate((sumX+total/2)/total),
+		kernel.Coordinate((sumY+total/2)/total),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMicrozone(kernel.NewUUID(), bounds, centroid, total, now)
+}
+
+func positive(name string, value int) error {
+	if value <= 0 {
+		return errs.NewValueIsInvalidErrorWithCause(name, fmt.Errorf("%d must be positive", value))
+	}
+	return nil
+}
+
+func abs(x kernel.Coordinate) kernel.Coordinate {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,117 @@
+package microzone_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/microzone"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func point(x, y kernel.Coordinate, deliveries int) microzone.DeliveryPoint {
+	location, _ := kernel.NewLocation(x, y)
+	return microzone.DeliveryPoint{Location: location, Deliveries: deliveries}
+}
+
+func TestNewClustering(t *testing.T) {
+	t.Run("should create clustering", func(t *testing.T) {
+		clustering, err := microzone.NewClustering(1, 50)
+
+		require.NoError(t, err)
+		require.NoError(t, clustering.Validate())
+		assert.Equal(t, 1, clustering.Radius())
+		assert.Equal(t, 50, clustering.MinDeliveries())
+	})
+
+	t.Run("should fail with non-positive parameters", func(t *testing.T) {
+		_, err := microzone.NewClustering(0, 50)
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+
+		_, err = microzone.NewClustering(1, 0)
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, microzone.Clustering{}.Validate(), microzone.ErrClusteringIsNotConstructed)
+
+		_, err := microzone.Clustering{}.Cluster(nil, time.Now())
+		require.ErrorIs(t, err, microzone.ErrClusteringIsNotConstructed)
+	})
+}
+
+func TestClustering_Cluster(t *testing.T) {
+	now := time.Date(2025, 3, 1, 3, 0, 0, 0, time.UTC)
+	clustering, _ := microzone.NewClustering(1, 10)
+
+	t.Run("should group dense neighbouring locations into one microzone", func(t *testing.T) {
+		zones, err := clustering.Cluster([]microzone.DeliveryPoint{
+			point(2, 2, 6), point(3, 2, 6), point(4, 2, 6),
+			point(9, 9, 2),
+		}, now)
+
+		require.NoError(t, err)
+		require.Len(t, zones, 1)
+		assert.Equal(t, "Zone(2,2-4,2)", zones[0].Bounds().String())
+		assert.Equal(t, "Location(3,2)", zones[0].Centroid().String())
+		assert.Equal(t, 18, zones[0].Deliveries())
+		assert.Equal(t, now, zones[0].ComputedAt())
+	})
+
+	t.Run("should attach sparse neighbours to the nearest dense location", func(t *testing.T) {
+		zones, err := clustering.Cluster([]microzone.DeliveryPoint{
+			point(4, 5, 2), point(5, 5, 8), point(6, 5, 1), point(6, 7, 1),
+		}, now)
+
+		require.NoError(t, err)
+		require.Len(t, zones, 1)
+		assert.Equal(t, "Zone(4,5-6,5)", zones[0].Bounds().String())
+		assert.Equal(t, 11, zones[0].Deliveries())
+	})
+
+	t.Run("should keep distant clusters apart, busiest first", func(t *testing.T) {
+		zones, err := clustering.Cluster([]microzone.DeliveryPoint{
+			point(9, 9, 20), point(1, 1, 12), point(1, 1, 3),
+		}, now)
+
+		require.NoError(t, err)
+		require.Len(t, zones, 2)
+		assert.Equal(t, 20, zones[0].Deliveries())
+		assert.Equal(t, "Zone(9,9-9,9)", zones[0].Bounds().String())
+		assert.Equal(t, 15, zones[1].Deliveries())
+		assert.Equal(t, "Zone(1,1-1,1)", zones[1].Bounds().String())
+	})
+
+	t.Run("should weigh the centroid by deliveries", func(t *testing.T) {
+		zones, err := clustering.Cluster([]microzone.DeliveryPoint{
+			point(2, 2, 30), point(3, 2, 10), point(4, 2, 10),
+		}, now)
+
+		require.NoError(t, err)
+		require.Len(t, zones, 1)
+		assert.Equal(t, "Location(3,2)", zones[0].Centroid().String())
+
+		zones, err = clustering.Cluster([]microzone.DeliveryPoint{
+			point(2, 2, 80), point(3, 2, 10), point(4, 2, 10),
+		}, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Location(2,2)", zones[0].Centroid().String())
+	})
+
+	t.Run("should find nothing without dense locations", func(t *testing.T) {
+		zones, err := clustering.Cluster([]microzone.DeliveryPoint{point(2, 2, 3), point(7, 7, 0)}, now)
+
+		require.NoError(t, err)
+		assert.Empty(t, zones)
+	})
+
+	t.Run("should refuse points without location", func(t *testing.T) {
+		_, err := clustering.Cluster([]microzone.DeliveryPoint{{Deliveries: 5}}, now)
+
+		require.ErrorIs(t, err, kernel.ErrLocationIsNotConstructed)
+	})
+}
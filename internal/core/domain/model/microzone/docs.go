@@ -0,0 +1,18 @@
+// Package microzone provides the domain model of dense-demand microzones: the areas of the
+// delivery grid where deliveries cluster, derived from the delivery history instead of being drawn by hand.
+//
+// The package includes:
+//   - Microzone: A cluster of delivery points with its bounding zone, weighted centroid and demand
+//   - Clustering: The density parameters that turn delivery points into microzones
+//   - DeliveryPoint: The number of deliveries made to one location of the grid
+//
+// Key business rules:
+//   - A location is dense when at least MinDeliveries deliveries were made within Radius cells of it
+//   - Dense locations within Radius cells of each other belong to the same microzone
+//   - Sparse locations within Radius cells of a dense one join the microzone of the nearest dense location
+//   - Locations far from every dense location belong to no microzone
+//   - A microzone's centroid is the delivery-weighted mean of its locations and always lies within its bounds
+//
+// The package follows Domain-Driven Design principles, providing rich domain
+// behavior, encapsulation, and validation to ensure business rules are enforced.
+package microzone
@@ -0,0 +1,191 @@
+package microzone
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	// ErrMicrozoneIsNotConstructed indicates that a Microzone was not properly
+	// initialized through the NewMicrozone constructor function.
+	ErrMicrozoneIsNotConstructed = errors.New("Microzone must be created via NewMicrozone constructor")
+
+	// ErrCentroidIsOutsideBounds is returned when the centroid of a microzone lies outside its bounds.
+	ErrCentroidIsOutsideBounds = errors.New("centroid must lie within the microzone bounds")
+)
+
+// Microzone is an area of the grid where deliveries cluster, computed from the delivery history.
+//
+// Key business rules:
+//   - Must be constructed through NewMicrozone
+//   - The centroid lies within the bounds
+//   - A microzone holds at least one delivery
+type Microzone struct {
+	// id uniquely identifies the microzone within one computation
+	id kernel.UUID
+
+	// bounds is the smallest rectangle containing every location of the cluster
+	bounds kernel.Zone
+
+	// centroid is the delivery-weighted mean of the cluster's locations
+	centroid kernel.Location
+
+	// deliveries is the number of historical deliveries made inside the cluster
+	deliveries int
+
+	// computedAt is the moment the microzone was computed
+	computedAt time.Time
+
+	// guard ensures the entity was properly initialized
+	guard guard.ConstructorGuard
+}
+
+// NewMicrozone creates a microzone. Used by Clustering and by repositories restoring
+// previously computed microzones.
+//
+// Example:
+//
+//	from, _ := kernel.NewLocation(2, 2)
+//	to, _ := kernel.NewLocation(4, 3)
+//	bounds, _ := kernel.NewZone(from, to)
+//	centroid, _ := kernel.NewLocation(3, 2)
+//	zone, err := microzone.NewMicrozone(kernel.NewUUID(), bounds, centroid, 42, time.Now())
+func NewMicrozone(
+	id kernel.UUID,
+	bounds kernel.Zone,
+	centroid kernel.Location,
+	deliveries int,
+	computedAt time.Time,
+) (*Microzone, error) {
+	microzone := &Microzone{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := errs.JoinFields(
+		errs.Field("id", microzone.setID(id)),
+		errs.Field("bounds", microzone.setBounds(bounds, centroid)),
+		errs.Field("deliveries", microzone.setDeliveries(deliveries)),
+		errs.Field("computedAt", microzone.setComputedAt(computedAt)),
+	); err != nil {
+		return nil, err
+	}
+
+	return microzone, nil
+}
+
+// Validate ensures the Microzone instance was properly constructed through NewMicrozone.
+func (m *Microzone) Validate() error {
+	if m == nil {
+		return ErrMicrozoneIsNotConstructed
+	}
+
+	return m.guard.Validate(ErrMicrozoneIsNotConstructed)
+}
+
+// ID returns the microzone's unique identifier.
+func (m *Microzone) ID() kernel.UUID {
+	return m.id
+}
+
+// Bounds returns the smallest rectangle containing every location of the microzone.
+func (m *Microzone) Bounds() kernel.Zone {
+	return m.bounds
+}
+
+// Centroid returns the delivery-weighted mean of the microzone's locations.
+func (m *Microzone) Centroid() kernel.Location {
+	return m.centroid
+}
+
+// Deliveries returns the number of historical deliveries made inside the microzone.
+func (m *Microzone) Deliveries() int {
+	return m.deliveries
+}
+
+// ComputedAt returns the moment the microzone was computed.
+func (m *Microzone) ComputedAt() time.Time {
+	return m.computedAt
+}
+
+// Contains reports whether the location lies within the microzone's bounds, borders included.
+func (m *Microzone) Contains(location kernel.Location) (bool, error) {
+	return m.bounds.Contains(location)
+}
+
+// Locate returns the microzone the location belongs to, and false if it lies in none.
+// Bounding rectangles of neighbouring microzones may overlap; the location then belongs to
+// the microzone with the nearest centroid, and of equally near ones to the busiest.
+func Locate(microzones []*Microzone, location kernel.Location) (*Microzone, bool, error) {
+	var (
+		located  *Microzone
+		distance int
+	)
+	for _, microzone := range microzones {
+		contains, err := microzone.Contains(location)
+		if err != nil {
+			return nil, false, err
+		}
+		if !contains {
+			continue
+		}
+
+		d, err := microzone.centroid.Distance(location)
+		if err != nil {
+			return nil, false, err
+		}
+		if located == nil || d < distance || (d == distance && microzone.deliveries > located.deliveries) {
+			located, distance = microzone, d
+		}
+	}
+
+	return located, located != nil, nil
+}
+
+func (m *Microzone) setID(id kernel.UUID) error {
+	if err := id.Validate(); err != nil {
+		return err
+	}
+
+	m.id = id
+	return nil
+}
+
+func (m *Microzone) setBounds(bounds kernel.Zone, centroid kernel.Location) error {
+	contains, err := bounds.Contains(centroid)
+	if err != nil {
+		return err
+	}
+	if !contains {
+		return fmt.Errorf("%w: %s is outside %s", ErrCentroidIsOutsideBounds, centroid, bounds)
+	}
+
+	m.bounds = bounds
+	m.centroid = centroid
+	return nil
+}
+
+func (m *Microzone) setDeliveries(deliveries int) error {
+	if deliveries <= 0 {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"microzone deliveries",
+			fmt.Errorf("%d must be positive", deliveries),
+		)
+	}
+
+	m.deliveries = deliveries
+	return nil
+}
+
+func (m *Microzone) setComputedAt(computedAt time.Time) error {
+	if computedAt.IsZero() {
+		return errs.NewValueIsRequiredError("computedAt")
+	}
+
+	m.computedAt = computedAt.UTC()
+	return nil
+}
@@ -0,0 +1,105 @@
+package microzone_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/microzone"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func zone(fromX, fromY, toX, toY kernel.Coordinate) kernel.Zone {
+	from, _ := kernel.NewLocation(fromX, fromY)
+	to, _ := kernel.NewLocation(toX, toY)
+	z, _ := kernel.NewZone(from, to)
+	return z
+}
+
+func TestNewMicrozone(t *testing.T) {
+	now := time.Date(2025, 3, 1, 3, 0, 0, 0, time.UTC)
+	centroid, _ := kernel.NewLocation(3, 2)
+
+	t.Run("should create microzone", func(t *testing.T) {
+		id := kernel.NewUUID()
+		m, err := microzone.NewMicrozone(id, zone(2, 2, 4, 3), centroid, 42, now)
+
+		require.NoError(t, err)
+		require.NoError(t, m.Validate())
+		assert.Equal(t, id, m.ID())
+		assert.Equal(t, "Zone(2,2-4,3)", m.Bounds().String())
+		assert.Equal(t, centroid, m.Centroid())
+		assert.Equal(t, 42, m.Deliveries())
+		assert.Equal(t, now, m.ComputedAt())
+	})
+
+	t.Run("should refuse centroid outside bounds", func(t *testing.T) {
+		_, err := microzone.NewMicrozone(kernel.NewUUID(), zone(5, 5, 6, 6), centroid, 42, now)
+
+		require.ErrorIs(t, err, microzone.ErrCentroidIsOutsideBounds)
+	})
+
+	t.Run("should refuse microzone without deliveries", func(t *testing.T) {
+		_, err := microzone.NewMicrozone(kernel.NewUUID(), zone(2, 2, 4, 3), centroid, 0, now)
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		var m *microzone.Microzone
+		require.ErrorIs(t, m.Validate(), microzone.ErrMicrozoneIsNotConstructed)
+	})
+}
+
+func TestLocate(t *testing.T) {
+	now := time.Date(2025, 3, 1, 3, 0, 0, 0, time.UTC)
+	westCentroid, _ := kernel.NewLocation(2, 2)
+	eastCentroid, _ := kernel.NewLocation(5, 2)
+	west, _ := microzone.NewMicrozone(kernel.NewUUID(), zone(1, 1, 4, 3), westCentroid, 30, now)
+	east, _ := microzone.NewMicrozone(kernel.NewUUID(), zone(3, 1, 6, 3), eastCentroid, 20, now)
+	zones := []*microzone.Microzone{west, east}
+
+	t.Run("should locate the microzone containing the location", func(t *testing.T) {
+		location, _ := kernel.NewLocation(1, 3)
+
+		located, ok, err := microzone.Locate(zones, location)
+
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, west.ID(), located.ID())
+	})
+
+	t.Run("should prefer the nearest centroid where bounds overlap", func(t *testing.T) {
+		location, _ := kernel.NewLocation(4, 2)
+
+		located, ok, err := microzone.Locate(zones, location)
+
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, east.ID(), located.ID())
+	})
+
+	t.Run("should prefer the busiest of equally near microzones", func(t *testing.T) {
+		location, _ := kernel.NewLocation(3, 3)
+		eastCentroid, _ := kernel.NewLocation(4, 2)
+		closer, _ := microzone.NewMicrozone(kernel.NewUUID(), zone(3, 1, 6, 3), eastCentroid, 20, now)
+
+		located, ok, err := microzone.Locate([]*microzone.Microzone{closer, west}, location)
+
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, west.ID(), located.ID())
+	})
+
+	t.Run("should report locations outside every microzone", func(t *testing.T) {
+		location, _ := kernel.NewLocation(9, 9)
+
+		_, ok, err := microzone.Locate(zones, location)
+
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
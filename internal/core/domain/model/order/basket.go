@@ -0,0 +1,37 @@
+package order
+
+import (
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+)
+
+var (
+	// ErrBasketIsAlreadyLinked is returned when linking an order created from another basket.
+	ErrBasketIsAlreadyLinked = errors.New("order is already linked to another basket")
+
+	// ErrBasketIsAlreadyOrdered is returned when another order was created from the same basket.
+	ErrBasketIsAlreadyOrdered = errors.New("basket is already ordered")
+)
+
+// LinkBasket records the confirmed customer basket the order was created from.
+// A basket is delivered by at most one order, so its ID makes the creation of the order
+// idempotent when the confirmation of the basket is delivered more than once.
+// Linking the same basket again does nothing.
+// Returns ErrBasketIsAlreadyLinked if the order was created from another basket.
+func (o *Order) LinkBasket(basketID kernel.UUID) error {
+	if err := basketID.Validate(); err != nil {
+		return err
+	}
+	if o.basketID != nil && !o.basketID.IsEqual(basketID) {
+		return ErrBasketIsAlreadyLinked
+	}
+
+	o.basketID = &basketID
+	return nil
+}
+
+// BasketID returns the basket the order was created from, nil if it was not created from one.
+func (o *Order) BasketID() *kernel.UUID {
+	return o.basketID
+}
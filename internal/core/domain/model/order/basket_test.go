@@ -0,0 +1,47 @@
+package order_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrder_LinkBasket(t *testing.T) {
+	location, _ := kernel.NewLocation(5, 7)
+	basketID := kernel.NewUUID()
+
+	t.Run("should link order to basket", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 10)
+
+		require.NoError(t, o.LinkBasket(basketID))
+
+		require.NotNil(t, o.BasketID())
+		assert.True(t, o.BasketID().IsEqual(basketID))
+	})
+
+	t.Run("should accept the same basket again", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 10)
+		require.NoError(t, o.LinkBasket(basketID))
+
+		require.NoError(t, o.LinkBasket(basketID))
+	})
+
+	t.Run("should not replace another basket", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 10)
+		require.NoError(t, o.LinkBasket(basketID))
+
+		require.ErrorIs(t, o.LinkBasket(kernel.NewUUID()), order.ErrBasketIsAlreadyLinked)
+		assert.True(t, o.BasketID().IsEqual(basketID))
+	})
+
+	t.Run("should fail with invalid basket ID", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 10)
+
+		require.Error(t, o.LinkBasket(kernel.UUID{}))
+		assert.Nil(t, o.BasketID())
+	})
+}
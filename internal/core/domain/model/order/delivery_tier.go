@@ -0,0 +1,119 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	// ErrBatchingWindowIsNotConstructed indicates that a BatchingWindow was not
+	// properly initialized through the NewBatchingWindow constructor.
+	ErrBatchingWindowIsNotConstructed = errors.New(
+		"BatchingWindow must be created via NewBatchingWindow constructor",
+	)
+
+	// ErrOrderIsAwaitingBatch is returned when dispatching an economy order before its batching window closes.
+	ErrOrderIsAwaitingBatch = errors.New("order is awaiting its batch")
+
+	// ErrDeliveryTierIsFixed is returned when changing the delivery tier of an order that was dispatched.
+	ErrDeliveryTierIsFixed = errors.New("delivery tier can no longer be changed")
+)
+
+// DeliveryTier is the speed of delivery the customer ordered.
+type DeliveryTier int
+
+const (
+	// UnknownDeliveryTier represents an invalid or undefined delivery tier.
+	// This value (0) helps catch uninitialized DeliveryTier values.
+	UnknownDeliveryTier DeliveryTier = iota
+
+	// ExpressDelivery orders enter dispatch as soon as they are created.
+	ExpressDelivery
+
+	// EconomyDelivery orders accumulate in a batching window and enter dispatch together when it closes,
+	// so couriers can take several of them on one trip.
+	EconomyDelivery
+)
+
+// getValidDeliveryTierStrings returns a map of valid DeliveryTier values to their string representations.
+func getValidDeliveryTierStrings() map[DeliveryTier]string {
+	//nolint:exhaustive // UnknownDeliveryTier is intentionally excluded as it's invalid
+	return map[DeliveryTier]string{
+		ExpressDelivery: "Express",
+		EconomyDelivery: "Economy",
+	}
+}
+
+// Validate checks if the DeliveryTier value is valid.
+func (t DeliveryTier) Validate() error {
+	if _, ok := getValidDeliveryTierStrings()[t]; !ok {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"delivery tier is invalid",
+			fmt.Errorf("%d is not a valid delivery tier", t),
+		)
+	}
+	return nil
+}
+
+// String returns the human-readable name of the delivery tier.
+// Returns "Unknown" for invalid delivery tier values.
+func (t DeliveryTier) String() string {
+	if str, ok := getValidDeliveryTierStrings()[t]; ok {
+		return str
+	}
+	return "Unknown"
+}
+
+// BatchingWindow is the period economy orders accumulate over before they enter dispatch.
+// Windows are aligned to multiples of their length, so with 30 minutes every batch closes
+// on the hour or half past.
+//
+// Key business rules:
+//   - Must be constructed through NewBatchingWindow
+//   - The length is positive
+type BatchingWindow struct {
+	// length is how long a batch accumulates orders
+	length time.Duration
+
+	// guard ensures the window was created via NewBatchingWindow
+	guard guard.ConstructorGuard
+}
+
+// NewBatchingWindow creates a batching window with validation.
+//
+// Example:
+//
+//	window, err := order.NewBatchingWindow(30 * time.Minute)
+func NewBatchingWindow(length time.Duration) (BatchingWindow, error) {
+	if length <= 0 {
+		return BatchingWindow{}, errs.NewValueIsInvalidErrorWithCause(
+			"batching window",
+			fmt.Errorf("%s must be positive", length),
+		)
+	}
+
+	return BatchingWindow{
+		length: length,
+		guard:  guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the window was created through the constructor.
+// Returns ErrBatchingWindowIsNotConstructed if validation fails.
+func (w BatchingWindow) Validate() error {
+	return w.guard.Validate(ErrBatchingWindowIsNotConstructed)
+}
+
+// Length returns how long a batch accumulates orders.
+func (w BatchingWindow) Length() time.Duration {
+	return w.length
+}
+
+// ClosesAt returns when the batch that is open at now closes.
+func (w BatchingWindow) ClosesAt(now time.Time) time.Time {
+	return now.Truncate(w.length).Add(w.length)
+}
@@ -0,0 +1,110 @@
+package order_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBatchingWindow(t *testing.T) {
+	t.Run("should align batches to the window length", func(t *testing.T) {
+		window, err := order.NewBatchingWindow(30 * time.Minute)
+
+		require.NoError(t, err)
+		require.NoError(t, window.Validate())
+		assert.Equal(t,
+			time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC),
+			window.ClosesAt(time.Date(2025, 3, 1, 9, 12, 0, 0, time.UTC)),
+		)
+		assert.Equal(t,
+			time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC),
+			window.ClosesAt(time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)),
+		)
+	})
+
+	t.Run("should fail with non-positive length", func(t *testing.T) {
+		_, err := order.NewBatchingWindow(0)
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, order.BatchingWindow{}.Validate(), order.ErrBatchingWindowIsNotConstructed)
+	})
+}
+
+func TestOrder_ChangeDeliveryTier(t *testing.T) {
+	location, _ := kernel.NewLocation(5, 7)
+	window, _ := order.NewBatchingWindow(30 * time.Minute)
+	now := time.Date(2025, 3, 1, 9, 12, 0, 0, time.UTC)
+
+	t.Run("should create express order", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+
+		assert.Equal(t, order.ExpressDelivery, o.DeliveryTier())
+		assert.False(t, o.IsAwaitingBatch())
+		assert.Nil(t, o.BatchClosesAt())
+	})
+
+	t.Run("should hold economy order until its batch is released", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+
+		require.NoError(t, o.ChangeDeliveryTier(order.EconomyDelivery, window, now))
+
+		assert.Equal(t, order.EconomyDelivery, o.DeliveryTier())
+		require.NotNil(t, o.BatchClosesAt())
+		assert.Equal(t, time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC), *o.BatchClosesAt())
+		require.ErrorIs(t, o.ValidateAssign(), order.ErrOrderIsAwaitingBatch)
+		require.ErrorIs(t, o.Assign(kernel.NewUUID()), order.ErrOrderIsAwaitingBatch)
+
+		assert.False(t, o.ReleaseBatch(now.Add(time.Minute)))
+		assert.True(t, o.ReleaseBatch(*o.BatchClosesAt()))
+
+		assert.False(t, o.IsAwaitingBatch())
+		assert.Equal(t, order.EconomyDelivery, o.DeliveryTier())
+		require.NoError(t, o.Assign(kernel.NewUUID()))
+	})
+
+	t.Run("should refuse unknown tier", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+
+		require.ErrorIs(t, o.ChangeDeliveryTier(order.UnknownDeliveryTier, window, now), errs.ErrValueIsInvalid)
+		require.ErrorIs(t,
+			o.ChangeDeliveryTier(order.EconomyDelivery, order.BatchingWindow{}, now),
+			order.ErrBatchingWindowIsNotConstructed,
+		)
+		assert.Equal(t, order.ExpressDelivery, o.DeliveryTier())
+	})
+
+	t.Run("should refuse change after dispatch", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+		_ = o.Assign(kernel.NewUUID())
+
+		require.ErrorIs(t, o.ChangeDeliveryTier(order.EconomyDelivery, window, now), order.ErrDeliveryTierIsFixed)
+	})
+}
+
+func TestOrder_RestoreDeliveryTier(t *testing.T) {
+	location, _ := kernel.NewLocation(5, 7)
+	closesAt := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+
+	t.Run("should restore pending batch", func(t *testing.T) {
+		o, _ := order.RestoreOrder(kernel.NewUUID(), location, 5, order.Created, nil)
+
+		require.NoError(t, o.RestoreDeliveryTier(order.EconomyDelivery, &closesAt))
+
+		assert.Equal(t, order.EconomyDelivery, o.DeliveryTier())
+		assert.True(t, o.IsAwaitingBatch())
+	})
+
+	t.Run("should fail with unknown tier", func(t *testing.T) {
+		o, _ := order.RestoreOrder(kernel.NewUUID(), location, 5, order.Created, nil)
+
+		require.ErrorIs(t, o.RestoreDeliveryTier(order.UnknownDeliveryTier, nil), errs.ErrValueIsInvalid)
+	})
+}
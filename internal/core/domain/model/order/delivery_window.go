@@ -0,0 +1,80 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	// ErrDeliveryWindowIsNotConstructed indicates that a DeliveryWindow was not
+	// properly initialized through the NewDeliveryWindow constructor.
+	ErrDeliveryWindowIsNotConstructed = errors.New(
+		"DeliveryWindow must be created via NewDeliveryWindow constructor",
+	)
+)
+
+// DeliveryWindow is the time range in which the customer expects the order to arrive,
+// for example the office hours of a corporate client.
+//
+// Key business rules:
+//   - Must be constructed through NewDeliveryWindow
+//   - Both bounds are required and the window must not be empty: from is before to
+type DeliveryWindow struct {
+	// from is the earliest acceptable delivery time
+	from time.Time
+
+	// to is the latest acceptable delivery time
+	to time.Time
+
+	// guard ensures the window was created via NewDeliveryWindow
+	guard guard.ConstructorGuard
+}
+
+// NewDeliveryWindow creates a delivery window with validation.
+//
+// Example:
+//
+//	window, err := order.NewDeliveryWindow(
+//	    time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC),
+//	    time.Date(2025, 3, 1, 18, 0, 0, 0, time.UTC),
+//	)
+func NewDeliveryWindow(from time.Time, to time.Time) (DeliveryWindow, error) {
+	if from.IsZero() {
+		return DeliveryWindow{}, errs.NewValueIsRequiredError("delivery window start")
+	}
+	if to.IsZero() {
+		return DeliveryWindow{}, errs.NewValueIsRequiredError("delivery window end")
+	}
+	if !from.Before(to) {
+		return DeliveryWindow{}, errs.NewValueIsInvalidErrorWithCause(
+			"delivery window",
+			fmt.Errorf("start %s must be before end %s", from.Format(time.RFC3339), to.Format(time.RFC3339)),
+		)
+	}
+
+	return DeliveryWindow{
+		from:  from,
+		to:    to,
+		guard: guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the window was created through the constructor.
+// Returns ErrDeliveryWindowIsNotConstructed if validation fails.
+func (w DeliveryWindow) Validate() error {
+	return w.guard.Validate(ErrDeliveryWindowIsNotConstructed)
+}
+
+// From returns the earliest acceptable delivery time.
+func (w DeliveryWindow) From() time.Time {
+	return w.from
+}
+
+// To returns the latest acceptable delivery time.
+func (w DeliveryWindow) To() time.Time {
+	return w.to
+}
@@ -0,0 +1,80 @@
+package order_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDeliveryWindow(t *testing.T) {
+	from := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 3, 1, 18, 0, 0, 0, time.UTC)
+
+	t.Run("should create valid window", func(t *testing.T) {
+		window, err := order.NewDeliveryWindow(from, to)
+
+		require.NoError(t, err)
+		require.NoError(t, window.Validate())
+		assert.Equal(t, from, window.From())
+		assert.Equal(t, to, window.To())
+	})
+
+	t.Run("should fail without bounds", func(t *testing.T) {
+		_, err := order.NewDeliveryWindow(time.Time{}, to)
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+
+		_, err = order.NewDeliveryWindow(from, time.Time{})
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+	})
+
+	t.Run("should fail when start is not before end", func(t *testing.T) {
+		_, err := order.NewDeliveryWindow(to, from)
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+
+		_, err = order.NewDeliveryWindow(from, from)
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+}
+
+func TestDeliveryWindow_NotConstructedViaConstructor(t *testing.T) {
+	window := order.DeliveryWindow{}
+
+	require.ErrorIs(t, window.Validate(), order.ErrDeliveryWindowIsNotConstructed)
+}
+
+func TestOrder_ScheduleDelivery(t *testing.T) {
+	location, _ := kernel.NewLocation(5, 7)
+	window, _ := order.NewDeliveryWindow(
+		time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 1, 18, 0, 0, 0, time.UTC),
+	)
+
+	t.Run("should schedule created order", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+
+		require.Nil(t, o.DeliveryWindow())
+		require.NoError(t, o.ScheduleDelivery(window))
+		require.NotNil(t, o.DeliveryWindow())
+		assert.Equal(t, window.From(), o.DeliveryWindow().From())
+	})
+
+	t.Run("should fail with window not created via constructor", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+
+		require.ErrorIs(t, o.ScheduleDelivery(order.DeliveryWindow{}), order.ErrDeliveryWindowIsNotConstructed)
+	})
+
+	t.Run("should fail for completed order", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+		_ = o.Assign(kernel.NewUUID())
+		_ = o.Complete()
+
+		require.ErrorIs(t, o.ScheduleDelivery(window), errs.ErrValueIsInvalid)
+	})
+}
@@ -5,12 +5,41 @@
 // The package includes:
 //   - Order: The aggregate root that manages order identity, properties, and lifecycle
 //   - Status: A state machine that enforces valid order status transitions
+//   - Message: A note in the courier-dispatcher communication thread of an order
+//   - TrackingToken: A secret that grants a customer access to the order's tracking link
+//   - EstimatedArrival: The last calculated delivery ETA of an assigned order
+//   - Item: A line of the order's contents with SKU, quantity and unit volume
+//   - DeliveryWindow: The time range in which the customer expects the order
+//   - Tip: The customer's gratuity for the courier of a delivered order
+//   - Rating: The customer's assessment of a delivery in stars
+//   - InsuranceThreshold: The declared value from which an order is insured
+//   - Priority: How urgently an order must be dispatched
+//   - Route: The pickup, waypoints and drop-off an order travels through
+//   - RouteDeviationPolicy: When a courier strayed from the route to an order for too long
 //
 // Key business rules:
 //   - Orders must have a valid unique identifier, location, and positive volume
+//   - An order created from item lines has the sum of their volumes as its volume
 //   - Order status follows a defined workflow: Created -> Assigned -> Completed
 //   - Orders can be reassigned while in the Assigned status
 //   - Orders can only be completed when in the Assigned status
+//   - Orders can be cancelled until they are completed; a cancelled order leaves its courier and,
+//     like a completed one, accepts no messages, tracking shares or delivery windows
+//   - The order thread accepts messages until the order is completed
+//   - A tracking link can be shared until the order is completed
+//   - Orders held for fraud review cannot be assigned until approved
+//   - Waiting orders are dispatched most urgent first; the priority can change only while the order waits
+//   - Only assigned orders carry an ETA; it is discarded on reassignment, unassignment or completion
+//   - A delivery window can be set or changed until the order is completed
+//   - A delivered order can be tipped once; retries with the same idempotency key are ignored
+//   - A delivered order can be rated once; resubmitting the same stars is ignored
+//   - The declared value is fixed once the order is dispatched
+//   - Insured orders go only to insured couriers and are completed only after the courier confirmed
+//     the pickup and then the delivery; the confirmations are discarded when the order changes hands
+//   - An order is flagged for the dispatcher once its courier stayed off every shortest route to it
+//     for several movement ticks; the route tracking is discarded when the order changes hands
+//   - An order with a route is delivered only after its courier called at the pickup and every waypoint
+//     in turn; the stops called at are discarded when the order changes hands
 //
 // The package follows Domain-Driven Design principles, providing rich domain
 // behavior, encapsulation, and validation to ensure business rules are enforced.
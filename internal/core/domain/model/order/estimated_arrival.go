@@ -0,0 +1,78 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	// ErrEstimatedArrivalIsNotConstructed indicates that an EstimatedArrival was not
+	// properly initialized through the NewEstimatedArrival constructor.
+	ErrEstimatedArrivalIsNotConstructed = errors.New(
+		"EstimatedArrival must be created via NewEstimatedArrival constructor",
+	)
+
+	// ErrOrderIsNotAssigned indicates that an ETA was recorded for an order no courier is delivering.
+	ErrOrderIsNotAssigned = errors.New("order is not assigned")
+)
+
+// EstimatedArrival is the last calculated time remaining until an assigned order is delivered,
+// measured in courier turns like courier.CalculateTimeToLocation.
+//
+// Key business rules:
+//   - Must be constructed through NewEstimatedArrival
+//   - Turns are never negative; 0 means the courier is at the delivery location
+//   - Records when it was calculated, since it goes stale as the courier moves
+type EstimatedArrival struct {
+	// turns is the remaining time to the delivery location
+	turns int
+
+	// calculatedAt is when the estimate was made
+	calculatedAt time.Time
+
+	// guard ensures the estimate was created via NewEstimatedArrival
+	guard guard.ConstructorGuard
+}
+
+// NewEstimatedArrival creates an estimate of the remaining delivery time.
+//
+// Example:
+//
+//	eta, err := order.NewEstimatedArrival(4, time.Now())
+func NewEstimatedArrival(turns int, calculatedAt time.Time) (EstimatedArrival, error) {
+	if turns < 0 {
+		return EstimatedArrival{}, errs.NewValueIsInvalidErrorWithCause(
+			"estimated arrival turns",
+			fmt.Errorf("%d must not be negative", turns),
+		)
+	}
+	if calculatedAt.IsZero() {
+		return EstimatedArrival{}, errs.NewValueIsRequiredError("estimated arrival calculation time")
+	}
+
+	return EstimatedArrival{
+		turns:        turns,
+		calculatedAt: calculatedAt,
+		guard:        guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the estimate was created through the constructor.
+// Returns ErrEstimatedArrivalIsNotConstructed if validation fails.
+func (e EstimatedArrival) Validate() error {
+	return e.guard.Validate(ErrEstimatedArrivalIsNotConstructed)
+}
+
+// Turns returns the remaining time to the delivery location.
+func (e EstimatedArrival) Turns() int {
+	return e.turns
+}
+
+// CalculatedAt returns when the estimate was made.
+func (e EstimatedArrival) CalculatedAt() time.Time {
+	return e.calculatedAt
+}
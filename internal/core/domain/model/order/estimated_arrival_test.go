@@ -0,0 +1,104 @@
+package order_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEstimatedArrival(t *testing.T) {
+	calculatedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should create valid estimate", func(t *testing.T) {
+		eta, err := order.NewEstimatedArrival(4, calculatedAt)
+
+		require.NoError(t, err)
+		require.NoError(t, eta.Validate())
+		assert.Equal(t, 4, eta.Turns())
+		assert.Equal(t, calculatedAt, eta.CalculatedAt())
+	})
+
+	t.Run("should allow zero turns at the delivery location", func(t *testing.T) {
+		eta, err := order.NewEstimatedArrival(0, calculatedAt)
+
+		require.NoError(t, err)
+		assert.Zero(t, eta.Turns())
+	})
+
+	t.Run("should fail with negative turns", func(t *testing.T) {
+		_, err := order.NewEstimatedArrival(-1, calculatedAt)
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should fail without calculation time", func(t *testing.T) {
+		_, err := order.NewEstimatedArrival(4, time.Time{})
+
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+	})
+}
+
+func TestEstimatedArrival_NotConstructedViaConstructor(t *testing.T) {
+	eta := order.EstimatedArrival{}
+
+	require.ErrorIs(t, eta.Validate(), order.ErrEstimatedArrivalIsNotConstructed)
+}
+
+func TestOrder_RecordEstimatedArrival(t *testing.T) {
+	location, _ := kernel.NewLocation(5, 7)
+	courierID := kernel.NewUUID()
+	eta, _ := order.NewEstimatedArrival(3, time.Now())
+
+	t.Run("should record estimate for assigned order", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+		_ = o.Assign(courierID)
+
+		err := o.RecordEstimatedArrival(eta)
+
+		require.NoError(t, err)
+		require.NotNil(t, o.EstimatedArrival())
+		assert.Equal(t, 3, o.EstimatedArrival().Turns())
+	})
+
+	t.Run("should fail for order without courier", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+
+		err := o.RecordEstimatedArrival(eta)
+
+		require.ErrorIs(t, err, order.ErrOrderIsNotAssigned)
+		assert.Nil(t, o.EstimatedArrival())
+	})
+
+	t.Run("should fail for unconstructed estimate", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+		_ = o.Assign(courierID)
+
+		err := o.RecordEstimatedArrival(order.EstimatedArrival{})
+
+		require.ErrorIs(t, err, order.ErrEstimatedArrivalIsNotConstructed)
+	})
+
+	t.Run("should discard estimate once it no longer applies", func(t *testing.T) {
+		transitions := map[string]func(o *order.Order) error{
+			"complete": func(o *order.Order) error { return o.Complete() },
+			"unassign": func(o *order.Order) error { return o.Unassign() },
+			"reassign": func(o *order.Order) error { return o.Assign(kernel.NewUUID()) },
+		}
+
+		for name, transition := range transitions {
+			o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+			_ = o.Assign(courierID)
+			require.NoError(t, o.RecordEstimatedArrival(eta))
+
+			require.NoError(t, transition(o), name)
+
+			assert.Nil(t, o.EstimatedArrival(), name)
+		}
+	})
+}
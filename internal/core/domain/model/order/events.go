@@ -0,0 +1,98 @@
+package order
+
+import (
+	"delivery/internal/core/domain/model/kernel"
+)
+
+// Event is a change in the order lifecycle that other services are told about.
+// Orders collect the events they raise until the unit of work stores them with the order.
+type Event interface {
+	// EventType returns the name of the integration event, e.g. "OrderCompleted".
+	EventType() string
+
+	// AggregateID returns the ID of the order that raised the event.
+	AggregateID() kernel.UUID
+}
+
+// CreatedEvent is raised when an order is created.
+type CreatedEvent struct {
+	OrderID  kernel.UUID
+	Location kernel.Location
+	Volume   int
+}
+
+// EventType returns "OrderCreated".
+func (CreatedEvent) EventType() string {
+	return "OrderCreated"
+}
+
+// AggregateID returns the ID of the created order.
+func (e CreatedEvent) AggregateID() kernel.UUID {
+	return e.OrderID
+}
+
+// AssignedEvent is raised when an order is assigned to a courier, including reassignments.
+type AssignedEvent struct {
+	OrderID   kernel.UUID
+	CourierID kernel.UUID
+}
+
+// EventType returns "OrderAssigned".
+func (AssignedEvent) EventType() string {
+	return "OrderAssigned"
+}
+
+// AggregateID returns the ID of the assigned order.
+func (e AssignedEvent) AggregateID() kernel.UUID {
+	return e.OrderID
+}
+
+// CompletedEvent is raised when an order is delivered.
+type CompletedEvent struct {
+	OrderID   kernel.UUID
+	CourierID kernel.UUID
+}
+
+// EventType returns "OrderCompleted".
+func (CompletedEvent) EventType() string {
+	return "OrderCompleted"
+}
+
+// AggregateID returns the ID of the completed order.
+func (e CompletedEvent) AggregateID() kernel.UUID {
+	return e.OrderID
+}
+
+// CancelledEvent is raised when an order is called off before it is delivered.
+// CourierID is the courier who was delivering the order, nil if it was not assigned.
+// Reason tells the customer why the order was cancelled, empty if none was given.
+type CancelledEvent struct {
+	OrderID   kernel.UUID
+	CourierID *kernel.UUID
+	Reason    string
+}
+
+// EventType returns "OrderCancelled".
+func (CancelledEvent) EventType() string {
+	return "OrderCancelled"
+}
+
+// AggregateID returns the ID of the cancelled order.
+func (e CancelledEvent) AggregateID() kernel.UUID {
+	return e.OrderID
+}
+
+// Events returns the events the order raised since it was created or restored,
+// or since the events were last cleared, in the order they were raised.
+func (o *Order) Events() []Event {
+	return append([]Event(nil), o.events...)
+}
+
+// ClearEvents forgets the raised events once they are stored.
+func (o *Order) ClearEvents() {
+	o.events = nil
+}
+
+func (o *Order) raise(event Event) {
+	o.events = append(o.events, event)
+}
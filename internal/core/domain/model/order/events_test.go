@@ -0,0 +1,77 @@
+package order_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrder_Events(t *testing.T) {
+	location, _ := kernel.NewLocation(5, 5)
+
+	t.Run("should raise lifecycle events in order", func(t *testing.T) {
+		id := kernel.NewUUID()
+		courierID := kernel.NewUUID()
+		o, err := order.NewOrder(id, location, 5)
+		require.NoError(t, err)
+
+		require.NoError(t, o.Assign(courierID))
+		require.NoError(t, o.Complete())
+
+		assert.Equal(t, []order.Event{
+			order.CreatedEvent{OrderID: id, Location: location, Volume: 5},
+			order.AssignedEvent{OrderID: id, CourierID: courierID},
+			order.CompletedEvent{OrderID: id, CourierID: courierID},
+		}, o.Events())
+		for _, event := range o.Events() {
+			assert.Equal(t, id, event.AggregateID())
+		}
+	})
+
+	t.Run("should raise cancelled event with the courier of the order", func(t *testing.T) {
+		courierID := kernel.NewUUID()
+		assigned, err := order.RestoreOrder(kernel.NewUUID(), location, 5, order.Assigned, &courierID)
+		require.NoError(t, err)
+		created, err := order.RestoreOrder(kernel.NewUUID(), location, 5, order.Created, nil)
+		require.NoError(t, err)
+
+		require.NoError(t, assigned.Cancel())
+		require.NoError(t, created.Cancel())
+
+		assert.Equal(t, []order.Event{
+			order.CancelledEvent{OrderID: assigned.ID(), CourierID: &courierID},
+		}, assigned.Events())
+		assert.Equal(t, []order.Event{order.CancelledEvent{OrderID: created.ID()}}, created.Events())
+		assert.Equal(t, "OrderCancelled", created.Events()[0].EventType())
+	})
+
+	t.Run("should raise no events for restored order", func(t *testing.T) {
+		o, err := order.RestoreOrder(kernel.NewUUID(), location, 5, order.Created, nil)
+		require.NoError(t, err)
+
+		assert.Empty(t, o.Events())
+	})
+
+	t.Run("should raise no event for rejected transition", func(t *testing.T) {
+		o, err := order.RestoreOrder(kernel.NewUUID(), location, 5, order.Created, nil)
+		require.NoError(t, err)
+
+		require.Error(t, o.Complete())
+		assert.Empty(t, o.Events())
+	})
+
+	t.Run("should forget cleared events", func(t *testing.T) {
+		o, err := order.NewOrder(kernel.NewUUID(), location, 5)
+		require.NoError(t, err)
+
+		o.ClearEvents()
+		require.NoError(t, o.Assign(kernel.NewUUID()))
+
+		require.Len(t, o.Events(), 1)
+		assert.Equal(t, "OrderAssigned", o.Events()[0].EventType())
+	})
+}
@@ -0,0 +1,206 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+const (
+	// MaxMarketplaceLength is the maximum number of characters in a marketplace slug.
+	MaxMarketplaceLength = 32
+
+	// MaxExternalOrderIDLength is the maximum number of characters in a marketplace order ID.
+	MaxExternalOrderIDLength = 64
+
+	// MaxDeepLinkLength is the maximum number of characters in a marketplace deep link.
+	MaxDeepLinkLength = 2048
+)
+
+var (
+	// ErrExternalReferenceIsNotConstructed indicates that an ExternalReference was not created
+	// via NewExternalReference.
+	ErrExternalReferenceIsNotConstructed = errors.New(
+		"ExternalReference must be created via NewExternalReference constructor",
+	)
+
+	// ErrExternalReferenceIsAlreadyLinked is returned when linking an order that already refers
+	// to another marketplace order.
+	ErrExternalReferenceIsAlreadyLinked = errors.New("order is already linked to another external reference")
+
+	// ErrExternalReferenceIsAlreadyRegistered is returned when another order refers to the same
+	// marketplace order.
+	ErrExternalReferenceIsAlreadyRegistered = errors.New("external reference is already registered")
+)
+
+// marketplacePattern matches a marketplace slug: lowercase letters, digits and inner dashes.
+var marketplacePattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// ExternalReference links an order to the order it fulfils on an external marketplace, so
+// partner systems can correlate the delivery with their own records. A marketplace order is
+// delivered by at most one order.
+//
+// Key business rules:
+//   - Must be constructed through NewExternalReference
+//   - The marketplace is a lowercase slug of at most MaxMarketplaceLength characters
+//   - The external order ID is at most MaxExternalOrderIDLength printable characters without spaces
+//   - The deep link is optional; if given it is an absolute http(s) URL
+type ExternalReference struct {
+	// marketplace identifies the marketplace, such as "ozon"
+	marketplace string
+
+	// orderID is the ID of the order on the marketplace
+	orderID string
+
+	// deepLink opens the order on the marketplace (empty if none)
+	deepLink string
+
+	// guard ensures the reference was created via NewExternalReference
+	guard guard.ConstructorGuard
+}
+
+// NewExternalReference validates a reference to the order orderID on the marketplace.
+// An empty deepLink links no page. Surrounding spaces are trimmed.
+//
+// Example:
+//
+//	ref, err := order.NewExternalReference("ozon", "48213377-0021", "https://seller.ozon.ru/orders/48213377-0021")
+func NewExternalReference(marketplace, orderID, deepLink string) (ExternalReference, error) {
+	ref := ExternalReference{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := errs.JoinFields(
+		errs.Field("marketplace", ref.setMarketplace(marketplace)),
+		errs.Field("orderId", ref.setOrderID(orderID)),
+		errs.Field("deepLink", ref.setDeepLink(deepLink)),
+	); err != nil {
+		return ExternalReference{}, err
+	}
+
+	return ref, nil
+}
+
+// Validate ensures the ExternalReference was created through NewExternalReference.
+func (r ExternalReference) Validate() error {
+	return r.guard.Validate(ErrExternalReferenceIsNotConstructed)
+}
+
+// Marketplace returns the slug of the marketplace.
+func (r ExternalReference) Marketplace() string {
+	return r.marketplace
+}
+
+// OrderID returns the ID of the order on the marketplace.
+func (r ExternalReference) OrderID() string {
+	return r.orderID
+}
+
+// DeepLink returns the link to the order on the marketplace; empty if none was given.
+func (r ExternalReference) DeepLink() string {
+	return r.deepLink
+}
+
+// Refers reports whether both references point to the same marketplace order.
+// Deep links are not compared.
+func (r ExternalReference) Refers(other ExternalReference) bool {
+	return r.marketplace == other.marketplace && r.orderID == other.orderID
+}
+
+func (r *ExternalReference) setMarketplace(marketplace string) error {
+	marketplace = strings.TrimSpace(marketplace)
+	if marketplace == "" {
+		return errs.NewValueIsRequiredError("marketplace")
+	}
+	if length := utf8.RuneCountInString(marketplace); length > MaxMarketplaceLength {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"marketplace is invalid",
+			fmt.Errorf("%d characters exceed the limit of %d", length, MaxMarketplaceLength),
+		)
+	}
+	if !marketplacePattern.MatchString(marketplace) {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"marketplace is invalid",
+			fmt.Errorf("%q is not a lowercase slug", marketplace),
+		)
+	}
+	r.marketplace = marketplace
+	return nil
+}
+
+func (r *ExternalReference) setOrderID(orderID string) error {
+	orderID = strings.TrimSpace(orderID)
+	if orderID == "" {
+		return errs.NewValueIsRequiredError("external order id")
+	}
+	if length := utf8.RuneCountInString(orderID); length > MaxExternalOrderIDLength {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"external order id is invalid",
+			fmt.Errorf("%d characters exceed the limit of %d", length, MaxExternalOrderIDLength),
+		)
+	}
+	for _, c := range orderID {
+		if unicode.IsSpace(c) || !unicode.IsPrint(c) {
+			return errs.NewValueIsInvalidErrorWithCause(
+				"external order id is invalid",
+				fmt.Errorf("%q contains %q", orderID, c),
+			)
+		}
+	}
+	r.orderID = orderID
+	return nil
+}
+
+func (r *ExternalReference) setDeepLink(deepLink string) error {
+	deepLink = strings.TrimSpace(deepLink)
+	if deepLink == "" {
+		return nil
+	}
+	if length := utf8.RuneCountInString(deepLink); length > MaxDeepLinkLength {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"deep link is invalid",
+			fmt.Errorf("%d characters exceed the limit of %d", length, MaxDeepLinkLength),
+		)
+	}
+
+	parsed, err := url.Parse(deepLink)
+	if err != nil {
+		return errs.NewValueIsInvalidErrorWithCause("deep link is invalid", err)
+	}
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"deep link is invalid",
+			fmt.Errorf("%q is not an absolute http(s) URL", deepLink),
+		)
+	}
+	r.deepLink = deepLink
+	return nil
+}
+
+// LinkExternalReference records the marketplace order the order fulfils.
+// Linking the same marketplace order again updates the deep link; a reference to another
+// marketplace order is never replaced.
+// Returns ErrExternalReferenceIsAlreadyLinked if the order refers to another marketplace order.
+func (o *Order) LinkExternalReference(ref ExternalReference) error {
+	if err := ref.Validate(); err != nil {
+		return err
+	}
+	if o.externalReference != nil && !o.externalReference.Refers(ref) {
+		return ErrExternalReferenceIsAlreadyLinked
+	}
+
+	o.externalReference = &ref
+	return nil
+}
+
+// ExternalReference returns the marketplace order the order fulfils, nil if it is not linked.
+func (o *Order) ExternalReference() *ExternalReference {
+	return o.externalReference
+}
@@ -0,0 +1,104 @@
+package order_test
+
+import (
+	"strings"
+	"testing"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExternalReference(t *testing.T) {
+	t.Run("should trim surrounding spaces", func(t *testing.T) {
+		ref, err := order.NewExternalReference(" ozon ", " 48213377-0021 ", " https://ozon.ru/orders/48213377-0021 ")
+
+		require.NoError(t, err)
+		require.NoError(t, ref.Validate())
+		assert.Equal(t, "ozon", ref.Marketplace())
+		assert.Equal(t, "48213377-0021", ref.OrderID())
+		assert.Equal(t, "https://ozon.ru/orders/48213377-0021", ref.DeepLink())
+	})
+
+	t.Run("should accept reference without deep link", func(t *testing.T) {
+		ref, err := order.NewExternalReference("yandex-market", "YM-1", "")
+
+		require.NoError(t, err)
+		assert.Empty(t, ref.DeepLink())
+	})
+
+	tests := []struct {
+		name        string
+		marketplace string
+		orderID     string
+		deepLink    string
+		expected    error
+	}{
+		{"blank marketplace", " ", "1", "", errs.ErrValueIsRequired},
+		{"uppercase marketplace", "Ozon", "1", "", errs.ErrValueIsInvalid},
+		{"marketplace with trailing dash", "ozon-", "1", "", errs.ErrValueIsInvalid},
+		{"too long marketplace", strings.Repeat("x", order.MaxMarketplaceLength+1), "1", "", errs.ErrValueIsInvalid},
+		{"blank order id", "ozon", " ", "", errs.ErrValueIsRequired},
+		{"order id with inner spaces", "ozon", "48213377 0021", "", errs.ErrValueIsInvalid},
+		{"too long order id", "ozon", strings.Repeat("x", order.MaxExternalOrderIDLength+1), "", errs.ErrValueIsInvalid},
+		{"relative deep link", "ozon", "1", "/orders/1", errs.ErrValueIsInvalid},
+		{"deep link with other scheme", "ozon", "1", "javascript:alert(1)", errs.ErrValueIsInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run("should reject "+tt.name, func(t *testing.T) {
+			_, err := order.NewExternalReference(tt.marketplace, tt.orderID, tt.deepLink)
+
+			require.ErrorIs(t, err, tt.expected)
+		})
+	}
+
+	t.Run("should reject zero value", func(t *testing.T) {
+		var ref order.ExternalReference
+
+		require.ErrorIs(t, ref.Validate(), order.ErrExternalReferenceIsNotConstructed)
+	})
+}
+
+func TestOrder_LinkExternalReference(t *testing.T) {
+	location, _ := kernel.NewLocation(5, 7)
+	ref, _ := order.NewExternalReference("ozon", "48213377-0021", "")
+
+	t.Run("should link order to marketplace order", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 10)
+
+		require.NoError(t, o.LinkExternalReference(ref))
+
+		require.NotNil(t, o.ExternalReference())
+		assert.True(t, o.ExternalReference().Refers(ref))
+	})
+
+	t.Run("should update deep link of the same marketplace order", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 10)
+		require.NoError(t, o.LinkExternalReference(ref))
+		withLink, _ := order.NewExternalReference("ozon", "48213377-0021", "https://ozon.ru/orders/48213377-0021")
+
+		require.NoError(t, o.LinkExternalReference(withLink))
+
+		assert.Equal(t, "https://ozon.ru/orders/48213377-0021", o.ExternalReference().DeepLink())
+	})
+
+	t.Run("should not replace reference to another marketplace order", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 10)
+		require.NoError(t, o.LinkExternalReference(ref))
+		other, _ := order.NewExternalReference("wildberries", "48213377-0021", "")
+
+		require.ErrorIs(t, o.LinkExternalReference(other), order.ErrExternalReferenceIsAlreadyLinked)
+		assert.Equal(t, "ozon", o.ExternalReference().Marketplace())
+	})
+
+	t.Run("should fail with reference not created via constructor", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 10)
+
+		require.ErrorIs(t, o.LinkExternalReference(order.ExternalReference{}), order.ErrExternalReferenceIsNotConstructed)
+		assert.Nil(t, o.ExternalReference())
+	})
+}
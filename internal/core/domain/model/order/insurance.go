@@ -0,0 +1,204 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	// ErrInsuranceThresholdIsNotConstructed indicates that an InsuranceThreshold was not
+	// properly initialized through the NewInsuranceThreshold constructor.
+	ErrInsuranceThresholdIsNotConstructed = errors.New(
+		"InsuranceThreshold must be created via NewInsuranceThreshold constructor",
+	)
+
+	// ErrDeclaredValueIsFixed is returned when declaring the value of an order that was dispatched.
+	ErrDeclaredValueIsFixed = errors.New("declared value can no longer be changed")
+
+	// ErrConfirmationIsNotRequired is returned when confirming a handover of an order that is not insured.
+	ErrConfirmationIsNotRequired = errors.New("order does not require handover confirmations")
+
+	// ErrPickupIsNotConfirmed is returned when confirming the delivery of an insured order
+	// before its pickup was confirmed.
+	ErrPickupIsNotConfirmed = errors.New("pickup is not confirmed")
+
+	// ErrDeliveryIsNotConfirmed is returned when completing an insured order before its delivery was confirmed.
+	ErrDeliveryIsNotConfirmed = errors.New("delivery is not confirmed")
+)
+
+// InsuranceThreshold is the declared value from which an order is insured: only insured couriers
+// may carry it and its courier confirms both the pickup and the delivery.
+//
+// Key business rules:
+//   - Must be constructed through NewInsuranceThreshold
+//   - The amount is positive and in minor currency units
+type InsuranceThreshold struct {
+	// amount is the lowest insured declared value in minor currency units
+	amount int
+
+	// guard ensures the threshold was created via NewInsuranceThreshold
+	guard guard.ConstructorGuard
+}
+
+// NewInsuranceThreshold creates an insurance threshold with validation.
+//
+// Example:
+//
+//	threshold, err := order.NewInsuranceThreshold(100_000) // 1000.00
+func NewInsuranceThreshold(amount int) (InsuranceThreshold, error) {
+	if amount <= 0 {
+		return InsuranceThreshold{}, errs.NewValueIsInvalidErrorWithCause(
+			"insurance threshold",
+			fmt.Errorf("%d must be positive", amount),
+		)
+	}
+
+	return InsuranceThreshold{
+		amount: amount,
+		guard:  guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the threshold was created through the constructor.
+// Returns ErrInsuranceThresholdIsNotConstructed if validation fails.
+func (t InsuranceThreshold) Validate() error {
+	return t.guard.Validate(ErrInsuranceThresholdIsNotConstructed)
+}
+
+// Amount returns the lowest insured declared value in minor currency units.
+func (t InsuranceThreshold) Amount() int {
+	return t.amount
+}
+
+// Requires reports whether an order of the declared value is insured.
+func (t InsuranceThreshold) Requires(declaredValue int) bool {
+	return declaredValue >= t.amount
+}
+
+// DeclaredValue returns the value of the order's contents the customer declared, in minor
+// currency units; zero if no value was declared.
+func (o *Order) DeclaredValue() int {
+	return o.declaredValue
+}
+
+// IsInsuranceRequired reports whether the order is insured: only insured couriers may carry it
+// and it is delivered only after its courier confirmed the pickup and the delivery.
+func (o *Order) IsInsuranceRequired() bool {
+	return o.insuranceRequired
+}
+
+// PickupConfirmedAt returns when the courier confirmed picking up an insured order; nil until confirmed.
+func (o *Order) PickupConfirmedAt() *time.Time {
+	return o.pickupConfirmedAt
+}
+
+// DeliveryConfirmedAt returns when the courier confirmed handing an insured order to the customer;
+// nil until confirmed.
+func (o *Order) DeliveryConfirmedAt() *time.Time {
+	return o.deliveryConfirmedAt
+}
+
+// DeclareValue records the value of the order's contents in minor currency units and whether
+// the threshold makes the order insured. The value can only change while the order waits for its
+// first dispatch. Returns ErrDeclaredValueIsFixed otherwise, or a validation error for a negative
+// value or a threshold that was not constructed.
+//
+// Example:
+//
+//	threshold, _ := order.NewInsuranceThreshold(100_000)
+//	_ = o.DeclareValue(250_000, threshold)
+//	o.IsInsuranceRequired() // true
+func (o *Order) DeclareValue(value int, threshold InsuranceThreshold) error {
+	if value < 0 {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"declared value is invalid",
+			fmt.Errorf("%d is negative", value),
+		)
+	}
+	if err := threshold.Validate(); err != nil {
+		return err
+	}
+
+	if o.status != Created || o.courierID != nil {
+		return ErrDeclaredValueIsFixed
+	}
+
+	o.declaredValue = value
+	o.insuranceRequired = threshold.Requires(value)
+	return nil
+}
+
+// ConfirmPickup records that the courier picked up the insured order at now. Confirming again
+// keeps the first confirmation. Returns ErrConfirmationIsNotRequired for orders that are not
+// insured, or ErrOrderIsNotAssigned if the order is not assigned.
+func (o *Order) ConfirmPickup(now time.Time) error {
+	if !o.insuranceRequired {
+		return ErrConfirmationIsNotRequired
+	}
+	if o.status != Assigned {
+		return ErrOrderIsNotAssigned
+	}
+	if o.pickupConfirmedAt != nil {
+		return nil
+	}
+
+	confirmedAt := now.UTC()
+	o.pickupConfirmedAt = &confirmedAt
+	return nil
+}
+
+// ConfirmDelivery records that the courier handed the insured order to the customer at now,
+// which lets the order be completed. Confirming again keeps the first confirmation.
+// Returns ErrConfirmationIsNotRequired for orders that are not insured, ErrPickupIsNotConfirmed
+// before the pickup was confirmed, or ErrOrderIsNotAssigned if the order is not assigned.
+func (o *Order) ConfirmDelivery(now time.Time) error {
+	if !o.insuranceRequired {
+		return ErrConfirmationIsNotRequired
+	}
+	if o.status != Assigned {
+		return ErrOrderIsNotAssigned
+	}
+	if o.pickupConfirmedAt == nil {
+		return ErrPickupIsNotConfirmed
+	}
+	if o.deliveryConfirmedAt != nil {
+		return nil
+	}
+
+	confirmedAt := now.UTC()
+	o.deliveryConfirmedAt = &confirmedAt
+	return nil
+}
+
+// RestoreInsurance attaches the previously persisted declared value and handover confirmations
+// to the order. Used by repositories after RestoreOrder.
+func (o *Order) RestoreInsurance(
+	declaredValue int,
+	insuranceRequired bool,
+	pickupConfirmedAt *time.Time,
+	deliveryConfirmedAt *time.Time,
+) error {
+	if declaredValue < 0 {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"declared value is invalid",
+			fmt.Errorf("%d is negative", declaredValue),
+		)
+	}
+
+	o.declaredValue = declaredValue
+	o.insuranceRequired = insuranceRequired
+	o.pickupConfirmedAt = pickupConfirmedAt
+	o.deliveryConfirmedAt = deliveryConfirmedAt
+	return nil
+}
+
+// resetConfirmations discards the handover confirmations when the order changes hands,
+// so the next courier confirms the pickup again.
+func (o *Order) resetConfirmations() {
+	o.pickupConfirmedAt = nil
+	o.deliveryConfirmedAt = nil
+}
@@ -0,0 +1,132 @@
+package order_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewInsuranceThreshold(t *testing.T) {
+	t.Run("should insure values from the threshold", func(t *testing.T) {
+		threshold, err := order.NewInsuranceThreshold(100_000)
+
+		require.NoError(t, err)
+		require.NoError(t, threshold.Validate())
+		assert.Equal(t, 100_000, threshold.Amount())
+		assert.False(t, threshold.Requires(99_999))
+		assert.True(t, threshold.Requires(100_000))
+	})
+
+	t.Run("should fail with non-positive amount", func(t *testing.T) {
+		_, err := order.NewInsuranceThreshold(0)
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, order.InsuranceThreshold{}.Validate(), order.ErrInsuranceThresholdIsNotConstructed)
+	})
+}
+
+func TestOrder_DeclareValue(t *testing.T) {
+	location, _ := kernel.NewLocation(5, 7)
+	threshold, _ := order.NewInsuranceThreshold(100_000)
+
+	t.Run("should insure high-value order", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+
+		require.NoError(t, o.DeclareValue(250_000, threshold))
+
+		assert.Equal(t, 250_000, o.DeclaredValue())
+		assert.True(t, o.IsInsuranceRequired())
+	})
+
+	t.Run("should not insure low-value order", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+
+		require.NoError(t, o.DeclareValue(5_000, threshold))
+
+		assert.Equal(t, 5_000, o.DeclaredValue())
+		assert.False(t, o.IsInsuranceRequired())
+	})
+
+	t.Run("should refuse invalid value or threshold", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+
+		require.ErrorIs(t, o.DeclareValue(-1, threshold), errs.ErrValueIsInvalid)
+		require.ErrorIs(t, o.DeclareValue(1, order.InsuranceThreshold{}), order.ErrInsuranceThresholdIsNotConstructed)
+		assert.Zero(t, o.DeclaredValue())
+	})
+
+	t.Run("should refuse change after dispatch", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+		_ = o.Assign(kernel.NewUUID())
+
+		require.ErrorIs(t, o.DeclareValue(250_000, threshold), order.ErrDeclaredValueIsFixed)
+	})
+}
+
+func TestOrder_ConfirmHandover(t *testing.T) {
+	location, _ := kernel.NewLocation(5, 7)
+	threshold, _ := order.NewInsuranceThreshold(100_000)
+	now := time.Date(2025, 3, 1, 9, 12, 0, 0, time.UTC)
+
+	newInsured := func(t *testing.T) *order.Order {
+		t.Helper()
+		o, err := order.NewOrder(kernel.NewUUID(), location, 5)
+		require.NoError(t, err)
+		require.NoError(t, o.DeclareValue(250_000, threshold))
+		return o
+	}
+
+	t.Run("should complete insured order only after both confirmations", func(t *testing.T) {
+		o := newInsured(t)
+		require.NoError(t, o.Assign(kernel.NewUUID()))
+
+		require.ErrorIs(t, o.Complete(), order.ErrDeliveryIsNotConfirmed)
+		require.ErrorIs(t, o.ConfirmDelivery(now), order.ErrPickupIsNotConfirmed)
+
+		require.NoError(t, o.ConfirmPickup(now))
+		require.NoError(t, o.ConfirmPickup(now.Add(time.Minute)))
+		require.NoError(t, o.ConfirmDelivery(now.Add(10*time.Minute)))
+
+		require.NotNil(t, o.PickupConfirmedAt())
+		assert.Equal(t, now, *o.PickupConfirmedAt())
+		require.NotNil(t, o.DeliveryConfirmedAt())
+		assert.Equal(t, now.Add(10*time.Minute), *o.DeliveryConfirmedAt())
+		require.NoError(t, o.Complete())
+	})
+
+	t.Run("should discard confirmations when the order changes hands", func(t *testing.T) {
+		o := newInsured(t)
+		courierID := kernel.NewUUID()
+		require.NoError(t, o.Assign(courierID))
+		require.NoError(t, o.ConfirmPickup(now))
+
+		require.NoError(t, o.Assign(courierID))
+		assert.NotNil(t, o.PickupConfirmedAt())
+
+		require.NoError(t, o.Assign(kernel.NewUUID()))
+		assert.Nil(t, o.PickupConfirmedAt())
+
+		require.NoError(t, o.ConfirmPickup(now))
+		require.NoError(t, o.Unassign())
+		assert.Nil(t, o.PickupConfirmedAt())
+	})
+
+	t.Run("should refuse confirmations of unassigned or uninsured orders", func(t *testing.T) {
+		insured := newInsured(t)
+		require.ErrorIs(t, insured.ConfirmPickup(now), order.ErrOrderIsNotAssigned)
+
+		plain, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+		_ = plain.Assign(kernel.NewUUID())
+		require.ErrorIs(t, plain.ConfirmPickup(now), order.ErrConfirmationIsNotRequired)
+		require.ErrorIs(t, plain.ConfirmDelivery(now), order.ErrConfirmationIsNotRequired)
+		require.NoError(t, plain.Complete())
+	})
+}
@@ -0,0 +1,148 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+const (
+	// MaxSKULength is the maximum number of characters in an item's stock keeping unit.
+	MaxSKULength = 64
+
+	// MaxItemQuantity is the largest quantity a single item line may hold.
+	MaxItemQuantity = 10_000
+)
+
+var (
+	// ErrItemIsNotConstructed indicates that an Item was not properly
+	// initialized through the NewItem constructor function.
+	ErrItemIsNotConstructed = errors.New("Item must be created via NewItem constructor")
+
+	// ErrItemsAreRequired is returned when creating an order from contents without any item lines.
+	ErrItemsAreRequired = errs.NewValueIsRequiredError("items")
+
+	// ErrItemsDoNotMatchVolume is returned when restored item lines do not add up to the order volume.
+	ErrItemsDoNotMatchVolume = errors.New("item volumes do not add up to the order volume")
+)
+
+// Item is one line of an order's contents: a stock keeping unit, how many of it are packed
+// and how much room each one takes. Items are immutable once created.
+//
+// Key business rules:
+//   - Must be constructed through NewItem constructor
+//   - SKU must not be blank and must not exceed MaxSKULength characters
+//   - Quantity must be between 1 and MaxItemQuantity
+//   - Unit volume must be positive
+type Item struct {
+	// sku identifies the product
+	sku string
+
+	// quantity is the number of units on the line
+	quantity int
+
+	// unitVolume is the room a single unit takes
+	unitVolume int
+
+	// guard ensures the value was properly initialized
+	guard guard.ConstructorGuard
+}
+
+// NewItem creates an order item line with validation.
+// Used both when creating orders and when restoring them from persistent storage.
+//
+// Example:
+//
+//	item, err := order.NewItem("SKU-42", 3, 5) // contributes 15 to the order volume
+//	if err != nil {
+//	    return fmt.Errorf("invalid item: %w", err)
+//	}
+func NewItem(sku string, quantity int, unitVolume int) (Item, error) {
+	item := Item{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := errs.JoinFields(
+		errs.Field("sku", item.setSKU(sku)),
+		errs.Field("quantity", item.setQuantity(quantity)),
+		errs.Field("unitVolume", item.setUnitVolume(unitVolume)),
+	); err != nil {
+		return Item{}, err
+	}
+
+	return item, nil
+}
+
+// Validate ensures the Item was properly constructed through NewItem.
+func (i Item) Validate() error {
+	return i.guard.Validate(ErrItemIsNotConstructed)
+}
+
+// SKU returns the stock keeping unit of the item.
+func (i Item) SKU() string {
+	return i.sku
+}
+
+// Quantity returns the number of units on the line.
+func (i Item) Quantity() int {
+	return i.quantity
+}
+
+// UnitVolume returns the room a single unit takes.
+func (i Item) UnitVolume() int {
+	return i.unitVolume
+}
+
+// Volume returns the room the whole line takes: quantity times unit volume.
+func (i Item) Volume() int {
+	return i.quantity * i.unitVolume
+}
+
+func (i *Item) setSKU(sku string) error {
+	if strings.TrimSpace(sku) == "" {
+		return errs.NewValueIsRequiredError("sku")
+	}
+	if length := utf8.RuneCountInString(sku); length > MaxSKULength {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"sku is invalid",
+			fmt.Errorf("%d characters exceed the limit of %d", length, MaxSKULength),
+		)
+	}
+	i.sku = sku
+	return nil
+}
+
+func (i *Item) setQuantity(quantity int) error {
+	if quantity <= 0 || quantity > MaxItemQuantity {
+		return errs.NewValueIsOutOfRangeError("quantity", quantity, 1, MaxItemQuantity)
+	}
+	i.quantity = quantity
+	return nil
+}
+
+func (i *Item) setUnitVolume(unitVolume int) error {
+	if unitVolume <= 0 {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"unit volume is invalid",
+			fmt.Errorf("%d is not greater than 0", unitVolume),
+		)
+	}
+	i.unitVolume = unitVolume
+	return nil
+}
+
+// itemsVolume sums the volumes of the item lines after validating each of them.
+func itemsVolume(items []Item) (int, error) {
+	total := 0
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			return 0, err
+		}
+		total += item.Volume()
+	}
+	return total, nil
+}
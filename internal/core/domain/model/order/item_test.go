@@ -0,0 +1,129 @@
+package order_test
+
+import (
+	"strings"
+	"testing"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustItem(t *testing.T, sku string, quantity, unitVolume int) order.Item {
+	t.Helper()
+	item, err := order.NewItem(sku, quantity, unitVolume)
+	require.NoError(t, err)
+	return item
+}
+
+func TestNewItem(t *testing.T) {
+	t.Run("should create valid item", func(t *testing.T) {
+		item, err := order.NewItem("SKU-42", 3, 5)
+
+		require.NoError(t, err)
+		require.NoError(t, item.Validate())
+		assert.Equal(t, "SKU-42", item.SKU())
+		assert.Equal(t, 3, item.Quantity())
+		assert.Equal(t, 5, item.UnitVolume())
+		assert.Equal(t, 15, item.Volume())
+	})
+
+	tests := []struct {
+		name       string
+		sku        string
+		quantity   int
+		unitVolume int
+		expected   error
+	}{
+		{"blank sku", "  ", 1, 1, errs.ErrValueIsRequired},
+		{"too long sku", strings.Repeat("x", order.MaxSKULength+1), 1, 1, errs.ErrValueIsInvalid},
+		{"zero quantity", "SKU", 0, 1, errs.ErrValueIsOutOfRange},
+		{"too large quantity", "SKU", order.MaxItemQuantity + 1, 1, errs.ErrValueIsOutOfRange},
+		{"zero unit volume", "SKU", 1, 0, errs.ErrValueIsInvalid},
+		{"negative unit volume", "SKU", 1, -2, errs.ErrValueIsInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run("should fail with "+tt.name, func(t *testing.T) {
+			_, err := order.NewItem(tt.sku, tt.quantity, tt.unitVolume)
+
+			require.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestItem_NotConstructedViaConstructor(t *testing.T) {
+	item := order.Item{}
+
+	require.ErrorIs(t, item.Validate(), order.ErrItemIsNotConstructed)
+}
+
+func TestNewOrderWithItems(t *testing.T) {
+	location, _ := kernel.NewLocation(5, 7)
+
+	t.Run("should derive volume from items", func(t *testing.T) {
+		items := []order.Item{mustItem(t, "BOOK-1", 2, 3), mustItem(t, "LAMP-7", 1, 4)}
+
+		o, err := order.NewOrderWithItems(kernel.NewUUID(), location, items)
+
+		require.NoError(t, err)
+		assert.Equal(t, 10, o.Volume())
+		assert.Equal(t, items, o.Items())
+		assert.Equal(t, order.Created, o.Status())
+	})
+
+	t.Run("should not share the caller's slice", func(t *testing.T) {
+		items := []order.Item{mustItem(t, "BOOK-1", 2, 3)}
+		o, err := order.NewOrderWithItems(kernel.NewUUID(), location, items)
+		require.NoError(t, err)
+
+		items[0] = mustItem(t, "LAMP-7", 1, 4)
+
+		assert.Equal(t, "BOOK-1", o.Items()[0].SKU())
+	})
+
+	t.Run("should require items", func(t *testing.T) {
+		_, err := order.NewOrderWithItems(kernel.NewUUID(), location, nil)
+
+		require.ErrorIs(t, err, order.ErrItemsAreRequired)
+	})
+
+	t.Run("should reject unconstructed item", func(t *testing.T) {
+		_, err := order.NewOrderWithItems(kernel.NewUUID(), location, []order.Item{{}})
+
+		require.ErrorIs(t, err, order.ErrItemIsNotConstructed)
+	})
+}
+
+func TestOrder_RestoreItems(t *testing.T) {
+	location, _ := kernel.NewLocation(5, 7)
+	items := []order.Item{mustItem(t, "BOOK-1", 2, 3), mustItem(t, "LAMP-7", 1, 4)}
+
+	t.Run("should restore items matching the volume", func(t *testing.T) {
+		o, _ := order.RestoreOrder(kernel.NewUUID(), location, 10, order.Created, nil)
+
+		require.NoError(t, o.RestoreItems(items))
+
+		assert.Equal(t, items, o.Items())
+	})
+
+	t.Run("should accept orders without items", func(t *testing.T) {
+		o, _ := order.RestoreOrder(kernel.NewUUID(), location, 10, order.Created, nil)
+
+		require.NoError(t, o.RestoreItems(nil))
+
+		assert.Empty(t, o.Items())
+	})
+
+	t.Run("should reject items not matching the volume", func(t *testing.T) {
+		o, _ := order.RestoreOrder(kernel.NewUUID(), location, 11, order.Created, nil)
+
+		err := o.RestoreItems(items)
+
+		require.ErrorIs(t, err, order.ErrItemsDoNotMatchVolume)
+		assert.Empty(t, o.Items())
+	})
+}
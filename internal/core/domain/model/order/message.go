@@ -0,0 +1,184 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// MaxMessageLength is the maximum number of characters allowed in a thread message.
+const MaxMessageLength = 500
+
+var (
+	// ErrMessageIsNotConstructed indicates that a Message was not properly
+	// initialized through the NewMessage constructor function.
+	ErrMessageIsNotConstructed = errors.New("Message must be created via NewMessage constructor")
+
+	// ErrThreadIsClosed indicates that the order's communication thread no longer
+	// accepts messages because the order has been completed.
+	ErrThreadIsClosed = errors.New("order thread is closed")
+)
+
+// Sender identifies who posted a message to the order thread.
+type Sender int
+
+const (
+	// UnknownSender represents an invalid or undefined sender.
+	// This value (0) helps catch uninitialized Sender values.
+	UnknownSender Sender = iota
+
+	// CourierSender marks a message written by the courier delivering the order.
+	CourierSender
+
+	// DispatcherSender marks a message written by a dispatcher.
+	DispatcherSender
+)
+
+// getValidSenderStrings returns a map of valid Sender values to their string representations.
+func getValidSenderStrings() map[Sender]string {
+	//nolint:exhaustive // UnknownSender is intentionally excluded as it's invalid
+	return map[Sender]string{
+		CourierSender:    "Courier",
+		DispatcherSender: "Dispatcher",
+	}
+}
+
+// Validate checks if the Sender value is valid.
+func (s Sender) Validate() error {
+	if _, ok := getValidSenderStrings()[s]; !ok {
+		return errs.NewValueIsInvalidErrorWithCause("sender is invalid", fmt.Errorf("%d is not a valid sender", s))
+	}
+	return nil
+}
+
+// String returns the human-readable name of the sender.
+// Returns "Unknown" for invalid sender values.
+func (s Sender) String() string {
+	if str, ok := getValidSenderStrings()[s]; ok {
+		return str
+	}
+	return "Unknown"
+}
+
+// Message is a short note exchanged between a courier and a dispatcher within an order thread.
+// Messages are immutable once created.
+//
+// Key business rules:
+//   - Must be constructed through NewMessage constructor
+//   - Text must not be blank and must not exceed MaxMessageLength characters
+//   - Must have a known sender and a send time
+type Message struct {
+	// id uniquely identifies the message
+	id kernel.UUID
+
+	// sender is the party who posted the message
+	sender Sender
+
+	// text is the message body
+	text string
+
+	// sentAt is the moment the message was posted
+	sentAt time.Time
+
+	// guard ensures the entity was properly initialized
+	guard guard.ConstructorGuard
+}
+
+// NewMessage creates a new Message with validation.
+// Used both when posting new messages and when restoring them from persistent storage.
+//
+// Example:
+//
+//	msg, err := order.NewMessage(kernel.NewUUID(), order.CourierSender, "Stuck in traffic", time.Now())
+//	if err != nil {
+//	    return fmt.Errorf("invalid message: %w", err)
+//	}
+func NewMessage(id kernel.UUID, sender Sender, text string, sentAt time.Time) (*Message, error) {
+	message := &Message{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := errors.Join(
+		message.setID(id),
+		message.setSender(sender),
+		message.setText(text),
+		message.setSentAt(sentAt),
+	); err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// Validate ensures the Message instance was properly constructed through NewMessage.
+func (m *Message) Validate() error {
+	if m == nil {
+		return ErrMessageIsNotConstructed
+	}
+
+	return m.guard.Validate(ErrMessageIsNotConstructed)
+}
+
+// ID returns the message's unique identifier.
+func (m *Message) ID() kernel.UUID {
+	return m.id
+}
+
+// Sender returns the party who posted the message.
+func (m *Message) Sender() Sender {
+	return m.sender
+}
+
+// Text returns the message body.
+func (m *Message) Text() string {
+	return m.text
+}
+
+// SentAt returns the moment the message was posted.
+func (m *Message) SentAt() time.Time {
+	return m.sentAt
+}
+
+func (m *Message) setID(id kernel.UUID) error {
+	if err := id.Validate(); err != nil {
+		return err
+	}
+	m.id = id
+	return nil
+}
+
+func (m *Message) setSender(sender Sender) error {
+	if err := sender.Validate(); err != nil {
+		return err
+	}
+	m.sender = sender
+	return nil
+}
+
+func (m *Message) setText(text string) error {
+	if strings.TrimSpace(text) == "" {
+		return errs.NewValueIsRequiredError("text")
+	}
+	if length := utf8.RuneCountInString(text); length > MaxMessageLength {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"text is invalid",
+			fmt.Errorf("%d characters exceed the limit of %d", length, MaxMessageLength),
+		)
+	}
+	m.text = text
+	return nil
+}
+
+func (m *Message) setSentAt(sentAt time.Time) error {
+	if sentAt.IsZero() {
+		return errs.NewValueIsRequiredError("sentAt")
+	}
+	m.sentAt = sentAt
+	return nil
+}
@@ -0,0 +1,149 @@
+package order_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMessage(t *testing.T) {
+	sentAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should create valid message", func(t *testing.T) {
+		id := kernel.NewUUID()
+
+		m, err := order.NewMessage(id, order.CourierSender, "Stuck in traffic", sentAt)
+
+		require.NoError(t, err)
+		require.NoError(t, m.Validate())
+		assert.True(t, m.ID().IsEqual(id))
+		assert.Equal(t, order.CourierSender, m.Sender())
+		assert.Equal(t, "Stuck in traffic", m.Text())
+		assert.Equal(t, sentAt, m.SentAt())
+	})
+
+	t.Run("should accept text at the length limit", func(t *testing.T) {
+		_, err := order.NewMessage(kernel.NewUUID(), order.DispatcherSender, strings.Repeat("я", order.MaxMessageLength), sentAt)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail with invalid parameters", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			id       kernel.UUID
+			sender   order.Sender
+			text     string
+			sentAt   time.Time
+			expected string
+		}{
+			{"invalid id", kernel.UUID{}, order.CourierSender, "hi", sentAt, "UUID must be created"},
+			{"unknown sender", kernel.NewUUID(), order.UnknownSender, "hi", sentAt, "sender is invalid"},
+			{"blank text", kernel.NewUUID(), order.CourierSender, "   ", sentAt, "text"},
+			{"too long text", kernel.NewUUID(), order.CourierSender, strings.Repeat("a", order.MaxMessageLength+1), sentAt, "exceed the limit"},
+			{"zero sent time", kernel.NewUUID(), order.CourierSender, "hi", time.Time{}, "sentAt"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				m, err := order.NewMessage(tt.id, tt.sender, tt.text, tt.sentAt)
+
+				require.Error(t, err)
+				assert.Nil(t, m)
+				assert.Contains(t, err.Error(), tt.expected)
+			})
+		}
+	})
+}
+
+func TestMessage_Validate(t *testing.T) {
+	var m *order.Message
+	require.ErrorIs(t, m.Validate(), order.ErrMessageIsNotConstructed)
+
+	require.ErrorIs(t, (&order.Message{}).Validate(), order.ErrMessageIsNotConstructed)
+}
+
+func TestSender_String(t *testing.T) {
+	assert.Equal(t, "Courier", order.CourierSender.String())
+	assert.Equal(t, "Dispatcher", order.DispatcherSender.String())
+	assert.Equal(t, "Unknown", order.UnknownSender.String())
+}
+
+func TestOrder_PostMessage(t *testing.T) {
+	location, _ := kernel.NewLocation(5, 7)
+	sentAt := time.Now()
+
+	t.Run("should append messages in order", func(t *testing.T) {
+		o, err := order.NewOrder(kernel.NewUUID(), location, 10)
+		require.NoError(t, err)
+
+		first, err := o.PostMessage(order.DispatcherSender, "Ring twice", sentAt)
+		require.NoError(t, err)
+		second, err := o.PostMessage(order.CourierSender, "Ok", sentAt.Add(time.Second))
+		require.NoError(t, err)
+
+		messages := o.Messages()
+		require.Len(t, messages, 2)
+		assert.Same(t, first, messages[0])
+		assert.Same(t, second, messages[1])
+		assert.False(t, o.IsThreadClosed())
+	})
+
+	t.Run("should reject invalid message without changing thread", func(t *testing.T) {
+		o, err := order.NewOrder(kernel.NewUUID(), location, 10)
+		require.NoError(t, err)
+
+		_, err = o.PostMessage(order.UnknownSender, "hi", sentAt)
+
+		require.Error(t, err)
+		assert.Empty(t, o.Messages())
+	})
+
+	t.Run("should close thread on completion", func(t *testing.T) {
+		o, err := order.NewOrder(kernel.NewUUID(), location, 10)
+		require.NoError(t, err)
+		require.NoError(t, o.Assign(kernel.NewUUID()))
+		_, err = o.PostMessage(order.CourierSender, "On my way", sentAt)
+		require.NoError(t, err)
+
+		require.NoError(t, o.Complete())
+
+		assert.True(t, o.IsThreadClosed())
+		_, err = o.PostMessage(order.CourierSender, "Delivered", sentAt)
+		require.ErrorIs(t, err, order.ErrThreadIsClosed)
+		assert.Len(t, o.Messages(), 1)
+	})
+
+	t.Run("should not expose internal slice", func(t *testing.T) {
+		o, err := order.NewOrder(kernel.NewUUID(), location, 10)
+		require.NoError(t, err)
+		_, err = o.PostMessage(order.CourierSender, "hi", sentAt)
+		require.NoError(t, err)
+
+		messages := o.Messages()
+		messages[0] = nil
+
+		assert.NotNil(t, o.Messages()[0])
+	})
+}
+
+func TestOrder_RestoreMessages(t *testing.T) {
+	location, _ := kernel.NewLocation(5, 7)
+	courierID := kernel.NewUUID()
+	o, err := order.RestoreOrder(kernel.NewUUID(), location, 10, order.Completed, &courierID)
+	require.NoError(t, err)
+
+	m, err := order.NewMessage(kernel.NewUUID(), order.CourierSender, "Delivered", time.Now())
+	require.NoError(t, err)
+
+	require.NoError(t, o.RestoreMessages([]*order.Message{m}))
+	assert.Len(t, o.Messages(), 1)
+
+	require.ErrorIs(t, o.RestoreMessages([]*order.Message{{}}), order.ErrMessageIsNotConstructed)
+}
@@ -3,6 +3,8 @@ package order
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/pkg/errs"
@@ -13,6 +15,27 @@ var (
 	// ErrOrderIsNotConstructed is returned when an Order instance was not created through
 	// the NewOrder factory method. This ensures all orders are properly validated.
 	ErrOrderIsNotConstructed = errors.New("Order must be created via NewOrder constructor")
+
+	// ErrOrderIsUnderReview is returned when assigning an order that is held for fraud review.
+	ErrOrderIsUnderReview = errors.New("order is under review")
+
+	// ErrOrderIsNotUnderReview is returned when approving an order that is not held for review.
+	ErrOrderIsNotUnderReview = errors.New("order is not under review")
+
+	// ErrReviewReasonIsRequired is returned when holding an order for review without a reason.
+	ErrReviewReasonIsRequired = errs.NewValueIsRequiredError("review reason")
+
+	// ErrCancellationReasonIsRequired is returned when cancelling an order with a blank reason.
+	ErrCancellationReasonIsRequired = errs.NewValueIsRequiredError("cancellation reason")
+
+	// ErrOrderIsCompleted is returned when cancelling an order that was already delivered.
+	ErrOrderIsCompleted = errors.New("order is completed")
+
+	// ErrOrderIsCancelled is returned when cancelling an order that was already cancelled.
+	ErrOrderIsCancelled = errors.New("order is cancelled")
+
+	// ErrOrderIsActive is returned when erasing the customer data of an order that is still being delivered.
+	ErrOrderIsActive = errors.New("order is active")
 )
 
 // Order represents a delivery order in the system. It is the aggregate root that manages
@@ -40,9 +63,93 @@ type Order struct {
 	// volume represents the order size/weight (must be positive)
 	volume int
 
+	// items are the order's contents; their volumes add up to volume (empty for orders created by volume)
+	items []Item
+
 	// status represents the current state in the order lifecycle
 	status Status
 
+	// messages is the courier-dispatcher communication thread, ordered by posting time
+	messages []*Message
+
+	// trackingToken grants customers access to the order's live tracking (nil until shared)
+	trackingToken *TrackingToken
+
+	// reviewReason explains why the order is held for fraud review (empty if not held)
+	reviewReason string
+
+	// estimatedArrival is the last calculated delivery ETA (nil until calculated or once stale)
+	estimatedArrival *EstimatedArrival
+
+	// deliveryWindow is when the customer expects the order (nil if any time suits)
+	deliveryWindow *DeliveryWindow
+
+	// tip is the customer's gratuity for the courier (nil until the customer tips)
+	tip *Tip
+
+	// rating is the customer's assessment of the delivery (nil until the customer rates it)
+	rating *Rating
+
+	// paymentMethod is how the customer pays for the order
+	paymentMethod PaymentMethod
+
+	// paymentStatus is the state of the payment as reported by the payment provider
+	paymentStatus PaymentStatus
+
+	// paymentTransitions audit the changes of the payment status in the order they were applied
+	paymentTransitions []PaymentTransition
+
+	// deliveryTier is the speed of delivery the customer ordered
+	deliveryTier DeliveryTier
+
+	// temperatureClass is the temperature the contents must be kept at on the way
+	temperatureClass TemperatureClass
+
+	// priority is how urgently the order must be dispatched
+	priority Priority
+
+	// batchClosesAt is when the batching window of an economy order closes (nil once released or for express orders)
+	batchClosesAt *time.Time
+
+	// declaredValue is the value of the contents the customer declared in minor currency units (zero if none)
+	declaredValue int
+
+	// insuranceRequired marks an order whose declared value reached the insurance threshold
+	insuranceRequired bool
+
+	// pickupConfirmedAt is when the courier confirmed picking up an insured order (nil until confirmed)
+	pickupConfirmedAt *time.Time
+
+	// deliveryConfirmedAt is when the courier confirmed handing an insured order over (nil until confirmed)
+	deliveryConfirmedAt *time.Time
+
+	// externalReference is the marketplace order the order fulfils (nil if it was not placed on a marketplace)
+	externalReference *ExternalReference
+
+	// basketID is the confirmed customer basket the order was created from (nil if it was not created from one)
+	basketID *kernel.UUID
+
+	// route is the way the order travels from its pickup to its location (nil if the courier takes it straight there)
+	route *Route
+
+	// reachedStops is the number of stops of the route the courier already called at
+	reachedStops int
+
+	// routeOrigin is where the courier set out towards the order (nil until the route is tracked)
+	routeOrigin *kernel.Location
+
+	// routeDeviationTicks is the number of consecutive movement ticks the courier was off route
+	routeDeviationTicks int
+
+	// routeDeviatedAt is when the order was flagged because its courier strayed from the route (nil if not flagged)
+	routeDeviatedAt *time.Time
+
+	// version is the stored revision of the order, which repositories compare to detect concurrent updates
+	version int
+
+	// events are the lifecycle events raised since the order was loaded, until the unit of work stores them
+	events []Event
+
 	// guard ensures the order was created via NewOrder
 	guard guard.ConstructorGuard
 }
@@ -68,22 +175,60 @@ type Order struct {
 //	    // Handle validation error
 //	}
 //
-// The constructor validates all inputs and ensures the order is created
-// with Created status and no courier assigned.
+// The constructor validates all inputs and ensures the order is created with Created status,
+// no courier assigned, a pending cash on delivery payment, express delivery, ambient contents and normal priority.
+// Orders whose contents are known should be created with NewOrderWithItems, which derives the volume from them,
+// and orders collected somewhere else than the courier's location with NewOrderWithRoute.
 func NewOrder(id kernel.UUID, location kernel.Location, volume int) (*Order, error) {
 	order := &Order{
-		status: Created,
-		guard:  guard.NewConstructorGuard(),
+		status:           Created,
+		paymentMethod:    CashOnDelivery,
+		paymentStatus:    PaymentPending,
+		deliveryTier:     ExpressDelivery,
+		temperatureClass: Ambient,
+		priority:         NormalPriority,
+		guard:            guard.NewConstructorGuard(),
 	}
 
-	if err := errors.Join(
-		order.setID(id),
-		order.setLocation(location),
-		order.setVolume(volume),
+	if err := errs.JoinFields(
+		errs.Field("id", order.setID(id)),
+		errs.Field("location", order.setLocation(location)),
+		errs.Field("volume", order.setVolume(volume)),
 	); err != nil {
 		return nil, err
 	}
 
+	order.raise(CreatedEvent{OrderID: order.id, Location: order.location, Volume: order.volume})
+	return order, nil
+}
+
+// NewOrderWithItems creates a new Order from its contents. The order volume is the sum
+// of the item line volumes, so it always matches what is actually packed.
+//
+// Returns ErrItemsAreRequired if no item lines are given, or the validation error of
+// the first invalid argument.
+//
+// Example:
+//
+//	books, _ := order.NewItem("BOOK-1", 2, 3)
+//	lamp, _ := order.NewItem("LAMP-7", 1, 4)
+//	o, err := order.NewOrderWithItems(kernel.NewUUID(), location, []order.Item{books, lamp}) // volume 10
+func NewOrderWithItems(id kernel.UUID, location kernel.Location, items []Item) (*Order, error) {
+	if len(items) == 0 {
+		return nil, ErrItemsAreRequired
+	}
+
+	volume, err := itemsVolume(items)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := NewOrder(id, location, volume)
+	if err != nil {
+		return nil, err
+	}
+
+	order.items = append([]Item(nil), items...)
 	return order, nil
 }
 
@@ -134,15 +279,20 @@ func RestoreOrder(
 	courierID *kernel.UUID,
 ) (*Order, error) {
 	order := &Order{
-		guard: guard.NewConstructorGuard(),
+		paymentMethod:    CashOnDelivery,
+		paymentStatus:    PaymentPending,
+		deliveryTier:     ExpressDelivery,
+		temperatureClass: Ambient,
+		priority:         NormalPriority,
+		guard:            guard.NewConstructorGuard(),
 	}
 
-	if err := errors.Join(
-		order.setID(id),
-		order.setLocation(location),
-		order.setVolume(volume),
-		order.setStatus(status),
-		order.setCourierID(courierID),
+	if err := errs.JoinFields(
+		errs.Field("id", order.setID(id)),
+		errs.Field("location", order.setLocation(location)),
+		errs.Field("volume", order.setVolume(volume)),
+		errs.Field("status", order.setStatus(status)),
+		errs.Field("courierId", order.setCourierID(courierID)),
 	); err != nil {
 		return nil, err
 	}
@@ -217,7 +367,7 @@ func (o *Order) Courier() *kernel.UUID {
 //   - Assigned status (reassignment allowed)
 //
 // Invalid states for assignment:
-//   - Completed status (final state, no further assignments)
+//   - Completed or Cancelled status (final state, no further assignments)
 //   - Unknown status (invalid state)
 //
 // Returns:
@@ -235,6 +385,15 @@ func (o *Order) Courier() *kernel.UUID {
 //	}
 //	// Proceed with courier search and assignment
 func (o *Order) ValidateAssign() error {
+	if o.IsUnderReview() {
+		return ErrOrderIsUnderReview
+	}
+	if o.status == Created && !o.IsPaymentCleared() {
+		return ErrOrderIsAwaitingPayment
+	}
+	if o.IsAwaitingBatch() {
+		return ErrOrderIsAwaitingBatch
+	}
 	return o.status.ValidateAssign()
 }
 
@@ -267,13 +426,32 @@ func (o *Order) Assign(courierID kernel.UUID) error {
 		return err
 	}
 
+	if o.IsUnderReview() {
+		return ErrOrderIsUnderReview
+	}
+
+	if o.status == Created && !o.IsPaymentCleared() {
+		return ErrOrderIsAwaitingPayment
+	}
+
+	if o.IsAwaitingBatch() {
+		return ErrOrderIsAwaitingBatch
+	}
+
 	newStatus, err := o.status.Assign()
 	if err != nil {
 		return err
 	}
 
+	if o.courierID == nil || !o.courierID.IsEqual(courierID) {
+		o.resetConfirmations()
+		o.resetRoute()
+	}
+
 	o.status = newStatus
 	o.courierID = &courierID
+	o.estimatedArrival = nil
+	o.raise(AssignedEvent{OrderID: o.id, CourierID: courierID})
 	return nil
 }
 
@@ -281,10 +459,12 @@ func (o *Order) Assign(courierID kernel.UUID) error {
 //
 // This method enforces the following business rules:
 //   - The order must be in Assigned status
+//   - An insured order must have its delivery confirmed
 //   - Completed is a final state with no further transitions
 //
 // Returns:
 //   - nil on successful completion
+//   - ErrDeliveryIsNotConfirmed if the order is insured and its delivery is not confirmed
 //   - error if the order is not in Assigned status
 //
 // Example:
@@ -297,12 +477,741 @@ func (o *Order) Assign(courierID kernel.UUID) error {
 // After successful completion, the order's status becomes Completed,
 // which is the final state in the order lifecycle.
 func (o *Order) Complete() error {
+	if o.insuranceRequired && o.status == Assigned && o.deliveryConfirmedAt == nil {
+		return ErrDeliveryIsNotConfirmed
+	}
+
 	newStatus, err := o.status.Complete()
 	if err != nil {
 		return err
 	}
 
 	o.status = newStatus
+	o.estimatedArrival = nil
+	o.raise(CompletedEvent{OrderID: o.id, CourierID: *o.courierID})
+	return nil
+}
+
+// Unassign detaches the order from its courier and returns it to Created status.
+//
+// This method enforces the following business rules:
+//   - The order must be in Assigned status
+//   - The courier reference is cleared so the order can be dispatched again
+//
+// Returns:
+//   - nil on successful unassignment
+//   - error if the order is not in Assigned status
+//
+// Example:
+//
+//	if err := order.Unassign(); err != nil {
+//	    // Order was not assigned
+//	}
+//
+// After successful unassignment, the order's status becomes Created and
+// Courier() returns nil, so the assignment job will pick the order up again.
+func (o *Order) Unassign() error {
+	newStatus, err := o.status.Unassign()
+	if err != nil {
+		return err
+	}
+
+	o.status = newStatus
+	o.courierID = nil
+	o.estimatedArrival = nil
+	o.resetConfirmations()
+	o.resetRoute()
+	return nil
+}
+
+// Cancel calls the order off before it is delivered.
+//
+// This method enforces the following business rules:
+//   - Completed orders cannot be cancelled
+//   - The courier reference is cleared; the caller frees the storage place the courier kept for the order
+//   - A review hold or a pending batch no longer matters and is dropped with the ETA and the route tracking
+//
+// Returns:
+//   - nil on successful cancellation
+//   - ErrOrderIsCompleted if the order is delivered, ErrOrderIsCancelled if it is already cancelled
+//
+// Example:
+//
+//	courierID := order.Courier()
+//	if err := order.Cancel(); err != nil {
+//	    return err
+//	}
+//	if courierID != nil {
+//	    // Free the storage place of the courier
+//	}
+//
+// After successful cancellation, the order's status becomes Cancelled,
+// which is a final state in the order lifecycle.
+func (o *Order) Cancel() error {
+	return o.cancel("")
+}
+
+// CancelWithReason calls the order off like Cancel, telling the customer why: the reason is
+// carried by the CancelledEvent. Returns ErrCancellationReasonIsRequired if the reason is blank.
+func (o *Order) CancelWithReason(reason string) error {
+	if strings.TrimSpace(reason) == "" {
+		return ErrCancellationReasonIsRequired
+	}
+	return o.cancel(reason)
+}
+
+func (o *Order) cancel(reason string) error {
+	if o.status == Completed {
+		return ErrOrderIsCompleted
+	}
+	if o.status == Cancelled {
+		return ErrOrderIsCancelled
+	}
+
+	newStatus, err := o.status.Cancel()
+	if err != nil {
+		return err
+	}
+
+	o.raise(CancelledEvent{OrderID: o.id, CourierID: o.courierID, Reason: reason})
+	o.status = newStatus
+	o.courierID = nil
+	o.estimatedArrival = nil
+	o.reviewReason = ""
+	o.batchClosesAt = nil
+	o.resetConfirmations()
+	o.resetRoute()
+	return nil
+}
+
+// ValidateDataErasure checks that the customer data of the order can be erased.
+// The data is needed until the order is delivered or cancelled, so an order in progress
+// returns ErrOrderIsActive.
+func (o *Order) ValidateDataErasure() error {
+	if !o.status.IsFinal() {
+		return ErrOrderIsActive
+	}
+	return nil
+}
+
+// Items returns a copy of the order's item lines.
+// Orders created by volume alone have no item lines.
+func (o *Order) Items() []Item {
+	items := make([]Item, len(o.items))
+	copy(items, o.items)
+	return items
+}
+
+// RestoreItems attaches previously persisted item lines to the order.
+// Used by repositories after RestoreOrder. Returns ErrItemsDoNotMatchVolume if the lines
+// do not add up to the restored volume; no lines at all are accepted for orders created by volume.
+func (o *Order) RestoreItems(items []Item) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	volume, err := itemsVolume(items)
+	if err != nil {
+		return err
+	}
+
+	if volume != o.volume {
+		return fmt.Errorf("%w: items %d, order %d", ErrItemsDoNotMatchVolume, volume, o.volume)
+	}
+
+	o.items = append([]Item(nil), items...)
+	return nil
+}
+
+// Messages returns a copy of the order's communication thread in posting order.
+func (o *Order) Messages() []*Message {
+	messages := make([]*Message, len(o.messages))
+	copy(messages, o.messages)
+	return messages
+}
+
+// IsThreadClosed reports whether the communication thread no longer accepts messages.
+// The thread closes automatically once the order is completed or cancelled.
+func (o *Order) IsThreadClosed() bool {
+	return o.status.IsFinal()
+}
+
+// PostMessage appends a new message from the given sender to the order's thread.
+//
+// This method enforces the following business rules:
+//   - The thread must be open (order is neither completed nor cancelled)
+//   - The message must have a known sender and a non-blank text within MaxMessageLength
+//
+// Returns:
+//   - *Message: The posted message
+//   - error: ErrThreadIsClosed or a validation error
+//
+// Example:
+//
+//	msg, err := order.PostMessage(order.DispatcherSender, "Call the customer on arrival", time.Now())
+//	if errors.Is(err, order.ErrThreadIsClosed) {
+//	    // Order already delivered
+//	}
+func (o *Order) PostMessage(sender Sender, text string, sentAt time.Time) (*Message, error) {
+	if o.IsThreadClosed() {
+		return nil, ErrThreadIsClosed
+	}
+
+	message, err := NewMessage(kernel.NewUUID(), sender, text, sentAt)
+	if err != nil {
+		return nil, err
+	}
+
+	o.messages = append(o.messages, message)
+	return message, nil
+}
+
+// RestoreMessages attaches previously persisted messages to the order's thread.
+// Used by repositories after RestoreOrder; unlike PostMessage it is allowed on closed threads.
+func (o *Order) RestoreMessages(messages []*Message) error {
+	for _, message := range messages {
+		if err := message.Validate(); err != nil {
+			return err
+		}
+	}
+
+	o.messages = append(o.messages, messages...)
+	return nil
+}
+
+// TrackingToken returns the token of the order's customer tracking link.
+// Returns nil if the link has not been shared yet.
+func (o *Order) TrackingToken() *TrackingToken {
+	return o.trackingToken
+}
+
+// ShareTracking issues the customer tracking token for the order.
+//
+// This method enforces the following business rules:
+//   - Tracking cannot be shared for a completed or cancelled order
+//   - Sharing is idempotent: an already issued token is returned unchanged
+//
+// Returns:
+//   - TrackingToken: The order's tracking token
+//   - error: ErrTrackingIsClosed if the order is completed or cancelled
+//
+// Example:
+//
+//	token, err := order.ShareTracking()
+//	if errors.Is(err, order.ErrTrackingIsClosed) {
+//	    // Order already delivered
+//	}
+func (o *Order) ShareTracking() (TrackingToken, error) {
+	if o.status.IsFinal() {
+		return TrackingToken{}, ErrTrackingIsClosed
+	}
+
+	if o.trackingToken == nil {
+		token := NewTrackingToken()
+		o.trackingToken = &token
+	}
+
+	return *o.trackingToken, nil
+}
+
+// RestoreTrackingToken attaches a previously persisted tracking token to the order.
+// Used by repositories after RestoreOrder; unlike ShareTracking it is allowed on completed orders.
+func (o *Order) RestoreTrackingToken(token TrackingToken) error {
+	if err := token.Validate(); err != nil {
+		return err
+	}
+
+	o.trackingToken = &token
+	return nil
+}
+
+// Version returns the stored revision of the order, zero for an order that was never stored.
+func (o *Order) Version() int {
+	return o.version
+}
+
+// RestoreVersion reapplies a stored revision. Intended for repositories rebuilding or storing the aggregate.
+func (o *Order) RestoreVersion(version int) {
+	o.version = version
+}
+
+// EstimatedArrival returns the last calculated delivery ETA.
+// Returns nil if none was calculated for the current assignment.
+func (o *Order) EstimatedArrival() *EstimatedArrival {
+	return o.estimatedArrival
+}
+
+// RecordEstimatedArrival stores a freshly calculated delivery ETA.
+//
+// This method enforces the following business rules:
+//   - Only assigned orders have an ETA; it is discarded on (re)assignment, unassignment and completion
+//   - A new estimate replaces the previous one
+//
+// Returns:
+//   - nil on success
+//   - ErrOrderIsNotAssigned if no courier is delivering the order
+//
+// Example:
+//
+//	eta, _ := order.NewEstimatedArrival(turns, time.Now())
+//	if err := o.RecordEstimatedArrival(eta); err != nil {
+//	    return err
+//	}
+func (o *Order) RecordEstimatedArrival(eta EstimatedArrival) error {
+	if err := eta.Validate(); err != nil {
+		return err
+	}
+
+	if o.status != Assigned {
+		return ErrOrderIsNotAssigned
+	}
+
+	o.estimatedArrival = &eta
+	return nil
+}
+
+// DeliveryWindow returns when the customer expects the order.
+// Returns nil if the order may be delivered at any time.
+func (o *Order) DeliveryWindow() *DeliveryWindow {
+	return o.deliveryWindow
+}
+
+// ScheduleDelivery sets the time range in which the order should be delivered.
+//
+// This method enforces the following business rules:
+//   - Completed and cancelled orders cannot be rescheduled
+//   - A new window replaces the previous one
+//
+// Returns:
+//   - nil on success
+//   - ErrDeliveryWindowIsNotConstructed if the window was not created via NewDeliveryWindow
+//   - a status error if the order is completed or cancelled
+//
+// Example:
+//
+//	window, _ := order.NewDeliveryWindow(opensAt, closesAt)
+//	if err := o.ScheduleDelivery(window); err != nil {
+//	    return err
+//	}
+func (o *Order) ScheduleDelivery(window DeliveryWindow) error {
+	if err := window.Validate(); err != nil {
+		return err
+	}
+
+	if o.status.IsFinal() {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"status is invalid",
+			fmt.Errorf("%s is not a valid status to schedule delivery", o.status.String()),
+		)
+	}
+
+	o.deliveryWindow = &window
+	return nil
+}
+
+// RestoreDeliveryWindow attaches a previously persisted delivery window to the order.
+// Used by repositories after RestoreOrder; unlike ScheduleDelivery it is allowed on completed orders.
+func (o *Order) RestoreDeliveryWindow(window DeliveryWindow) error {
+	if err := window.Validate(); err != nil {
+		return err
+	}
+
+	o.deliveryWindow = &window
+	return nil
+}
+
+// HoldForReview keeps a suspicious order out of dispatch until an operator approves it.
+//
+// This method enforces the following business rules:
+//   - Only orders in Created status can be held
+//   - A reason is required so operators know what to check
+//   - Holding an order that is already held replaces the reason
+//
+// Returns:
+//   - nil on success
+//   - ErrReviewReasonIsRequired if reason is blank, or a status error if the order
+//     is not in Created status
+//
+// Example:
+//
+//	if err := order.HoldForReview("address is on the watch list"); err != nil {
+//	    return err
+//	}
+//	order.ValidateAssign() // ErrOrderIsUnderReview
+func (o *Order) HoldForReview(reason string) error {
+	if strings.TrimSpace(reason) == "" {
+		return ErrReviewReasonIsRequired
+	}
+
+	if o.status != Created {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"status is invalid",
+			fmt.Errorf("%s is not a valid status to hold for review", o.status.String()),
+		)
+	}
+
+	o.reviewReason = reason
+	return nil
+}
+
+// ApproveReview releases an order held for review back to dispatch.
+// Returns ErrOrderIsNotUnderReview if the order is not held.
+func (o *Order) ApproveReview() error {
+	if !o.IsUnderReview() {
+		return ErrOrderIsNotUnderReview
+	}
+
+	o.reviewReason = ""
+	return nil
+}
+
+// IsUnderReview reports whether the order is held for fraud review.
+func (o *Order) IsUnderReview() bool {
+	return o.reviewReason != ""
+}
+
+// ReviewReason returns why the order is held for review, or an empty string if it is not.
+func (o *Order) ReviewReason() string {
+	return o.reviewReason
+}
+
+// Tip returns the customer's tip for the courier.
+// Returns nil if the customer has not tipped.
+func (o *Order) Tip() *Tip {
+	return o.tip
+}
+
+// AddTip records the customer's tip for the courier who delivered the order.
+//
+// This method enforces the following business rules:
+//   - Only completed orders delivered by a courier can be tipped
+//   - An order is tipped at most once
+//   - Submitting a tip with the idempotency key of the recorded one is a retry:
+//     the recorded tip is kept and no error is returned
+//
+// Returns:
+//   - bool: true if the tip was recorded, false for a retry
+//   - error: ErrOrderIsNotDelivered, or ErrOrderIsAlreadyTipped for a tip with another key
+//
+// Example:
+//
+//	added, err := o.AddTip(tip)
+//	if errors.Is(err, order.ErrOrderIsAlreadyTipped) {
+//	    // The customer has already tipped
+//	}
+//	if !added {
+//	    // Retried submission, o.Tip() is the tip recorded before
+//	}
+func (o *Order) AddTip(tip Tip) (bool, error) {
+	if err := tip.Validate(); err != nil {
+		return false, err
+	}
+
+	if o.status != Completed || o.courierID == nil {
+		return false, ErrOrderIsNotDelivered
+	}
+
+	if o.tip != nil {
+		if o.tip.IdempotencyKey() == tip.IdempotencyKey() {
+			return false, nil
+		}
+		return false, ErrOrderIsAlreadyTipped
+	}
+
+	o.tip = &tip
+	return true, nil
+}
+
+// RestoreTip attaches a previously persisted tip to the order.
+// Used by repositories after RestoreOrder.
+func (o *Order) RestoreTip(tip Tip) error {
+	if err := tip.Validate(); err != nil {
+		return err
+	}
+
+	o.tip = &tip
+	return nil
+}
+
+// Rating returns the customer's rating of the delivery.
+// Returns nil if the customer has not rated it.
+func (o *Order) Rating() *Rating {
+	return o.rating
+}
+
+// Rate records the customer's rating of the delivery by the courier.
+//
+// This method enforces the following business rules:
+//   - Only completed orders delivered by a courier can be rated
+//   - A delivery is rated at most once
+//   - Submitting the stars of the recorded rating again is a retry:
+//     the recorded rating is kept and no error is returned
+//
+// Returns:
+//   - bool: true if the rating was recorded, false for a retry
+//   - error: ErrOrderIsNotDelivered, or ErrOrderIsAlreadyRated for a rating with other stars
+//
+// Example:
+//
+//	rated, err := o.Rate(rating)
+//	if errors.Is(err, order.ErrOrderIsAlreadyRated) {
+//	    // The customer has already rated the delivery
+//	}
+func (o *Order) Rate(rating Rating) (bool, error) {
+	if err := rating.Validate(); err != nil {
+		return false, err
+	}
+
+	if o.status != Completed || o.courierID == nil {
+		return false, ErrOrderIsNotDelivered
+	}
+
+	if o.rating != nil {
+		if o.rating.Stars() == rating.Stars() {
+			return false, nil
+		}
+		return false, ErrOrderIsAlreadyRated
+	}
+
+	o.rating = &rating
+	return true, nil
+}
+
+// RestoreRating attaches a previously persisted rating to the order.
+// Used by repositories after RestoreOrder.
+func (o *Order) RestoreRating(rating Rating) error {
+	if err := rating.Validate(); err != nil {
+		return err
+	}
+
+	o.rating = &rating
+	return nil
+}
+
+// PaymentMethod returns how the customer pays for the order.
+func (o *Order) PaymentMethod() PaymentMethod {
+	return o.paymentMethod
+}
+
+// PaymentStatus returns the state of the order's payment.
+func (o *Order) PaymentStatus() PaymentStatus {
+	return o.paymentStatus
+}
+
+// PaymentTransitions returns a copy of the audit trail of payment status changes in the order they were applied.
+func (o *Order) PaymentTransitions() []PaymentTransition {
+	transitions := make([]PaymentTransition, len(o.paymentTransitions))
+	copy(transitions, o.paymentTransitions)
+	return transitions
+}
+
+// IsPaymentCleared reports whether the payment allows dispatching the order: orders paid online
+// must be paid, cash on delivery orders are cleared until their payment is refunded.
+func (o *Order) IsPaymentCleared() bool {
+	if o.paymentMethod == CashOnDelivery {
+		return o.paymentStatus != PaymentRefunded
+	}
+	return o.paymentStatus == PaymentPaid
+}
+
+// ChangePaymentMethod sets how the customer pays for the order.
+// The method can only change while the order waits for dispatch and before any payment event.
+// Returns ErrPaymentMethodIsFixed otherwise, or a validation error for an unknown method.
+//
+// Example:
+//
+//	o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+//	_ = o.ChangePaymentMethod(order.OnlinePayment)
+//	o.ValidateAssign() // ErrOrderIsAwaitingPayment until the payment is confirmed
+func (o *Order) ChangePaymentMethod(method PaymentMethod) error {
+	if err := method.Validate(); err != nil {
+		return err
+	}
+
+	if o.status != Created || len(o.paymentTransitions) > 0 {
+		return ErrPaymentMethodIsFixed
+	}
+
+	o.paymentMethod = method
+	return nil
+}
+
+// ApplyPaymentEvent moves the payment to the status reported by the payment provider and
+// records the transition in the audit trail.
+//
+// This method enforces the following business rules:
+//   - Payments move from Pending to Paid and from Paid to Refunded only
+//   - An event whose reference was already applied is a redelivery and changes nothing
+//   - An event reporting the current status changes nothing
+//
+// Returns:
+//   - bool: true if the status changed, false for redeliveries and events reporting the current status
+//   - error: ErrPaymentTransitionIsInvalid for transitions outside the lifecycle, or a validation error
+//
+// Example:
+//
+//	changed, err := o.ApplyPaymentEvent(order.PaymentPaid, "evt_1042", time.Now())
+//	if errors.Is(err, order.ErrPaymentTransitionIsInvalid) {
+//	    // For example a refund reported before the payment
+//	}
+func (o *Order) ApplyPaymentEvent(status PaymentStatus, reference string, occurredAt time.Time) (bool, error) {
+	if err := errs.JoinFields(
+		errs.Field("status", status.Validate()),
+		errs.Field("reference", validatePaymentReference(reference)),
+	); err != nil {
+		return false, err
+	}
+
+	for _, transition := range o.paymentTransitions {
+		if transition.Reference() == reference {
+			return false, nil
+		}
+	}
+	if status == o.paymentStatus {
+		return false, nil
+	}
+
+	if !o.paymentStatus.CanTransitionTo(status) {
+		return false, fmt.Errorf(
+			"%w: %s to %s", ErrPaymentTransitionIsInvalid, o.paymentStatus.String(), status.String(),
+		)
+	}
+
+	transition, err := NewPaymentTransition(kernel.NewUUID(), o.paymentStatus, status, reference, occurredAt)
+	if err != nil {
+		return false, err
+	}
+
+	o.paymentStatus = status
+	o.paymentTransitions = append(o.paymentTransitions, transition)
+	return true, nil
+}
+
+// RestorePayment attaches the previously persisted payment and its audit trail to the order.
+// Used by repositories after RestoreOrder.
+func (o *Order) RestorePayment(method PaymentMethod, status PaymentStatus, transitions []PaymentTransition) error {
+	if err := errs.JoinFields(
+		errs.Field("paymentMethod", method.Validate()),
+		errs.Field("paymentStatus", status.Validate()),
+	); err != nil {
+		return err
+	}
+	for _, transition := range transitions {
+		if err := transition.Validate(); err != nil {
+			return err
+		}
+	}
+
+	o.paymentMethod = method
+	o.paymentStatus = status
+	o.paymentTransitions = append([]PaymentTransition(nil), transitions...)
+	return nil
+}
+
+// DeliveryTier returns the speed of delivery the customer ordered.
+func (o *Order) DeliveryTier() DeliveryTier {
+	return o.deliveryTier
+}
+
+// BatchClosesAt returns when the batching window of an economy order closes and the order enters dispatch.
+// Returns nil for express orders and once the batch is released.
+func (o *Order) BatchClosesAt() *time.Time {
+	return o.batchClosesAt
+}
+
+// IsAwaitingBatch reports whether the order is an economy order whose batch was not released yet.
+func (o *Order) IsAwaitingBatch() bool {
+	return o.batchClosesAt != nil
+}
+
+// ChangeDeliveryTier sets the speed of delivery the customer ordered. An economy order joins the
+// batch open at now and is held out of dispatch until the batching window closes; the window is
+// ignored for express orders. The tier can only change while the order waits for its first dispatch.
+// Returns ErrDeliveryTierIsFixed otherwise, or a validation error for an unknown tier.
+//
+// Example:
+//
+//	window, _ := order.NewBatchingWindow(30 * time.Minute)
+//	_ = o.ChangeDeliveryTier(order.EconomyDelivery, window, time.Now())
+//	o.ValidateAssign() // ErrOrderIsAwaitingBatch until the batch is released
+func (o *Order) ChangeDeliveryTier(tier DeliveryTier, window BatchingWindow, now time.Time) error {
+	if err := tier.Validate(); err != nil {
+		return err
+	}
+
+	if o.status != Created || o.courierID != nil {
+		return ErrDeliveryTierIsFixed
+	}
+
+	if tier != EconomyDelivery {
+		o.deliveryTier = tier
+		o.batchClosesAt = nil
+		return nil
+	}
+
+	if err := window.Validate(); err != nil {
+		return err
+	}
+
+	closesAt := window.ClosesAt(now).UTC()
+	o.deliveryTier = tier
+	o.batchClosesAt = &closesAt
+	return nil
+}
+
+// ReleaseBatch lets an economy order enter dispatch once its batching window has closed at now.
+// Returns true if the order was released; orders that are not awaiting a batch, or whose window
+// is still open, are left unchanged.
+func (o *Order) ReleaseBatch(now time.Time) bool {
+	if o.batchClosesAt == nil || now.Before(*o.batchClosesAt) {
+		return false
+	}
+
+	o.batchClosesAt = nil
+	return true
+}
+
+// RestoreDeliveryTier attaches the previously persisted delivery tier and pending batch to the order.
+// Used by repositories after RestoreOrder.
+func (o *Order) RestoreDeliveryTier(tier DeliveryTier, batchClosesAt *time.Time) error {
+	if err := tier.Validate(); err != nil {
+		return err
+	}
+
+	o.deliveryTier = tier
+	o.batchClosesAt = batchClosesAt
+	return nil
+}
+
+// TemperatureClass returns the temperature the contents must be kept at on the way.
+func (o *Order) TemperatureClass() TemperatureClass {
+	return o.temperatureClass
+}
+
+// ChangeTemperatureClass sets the temperature the contents must be kept at on the way.
+// Like the delivery tier, the class can only change while the order waits for its first dispatch.
+// Returns ErrTemperatureClassIsFixed otherwise, or a validation error for an unknown class.
+func (o *Order) ChangeTemperatureClass(class TemperatureClass) error {
+	if err := class.Validate(); err != nil {
+		return err
+	}
+
+	if o.status != Created || o.courierID != nil {
+		return ErrTemperatureClassIsFixed
+	}
+
+	o.temperatureClass = class
+	return nil
+}
+
+// RestoreTemperatureClass attaches the previously persisted temperature class to the order.
+// Used by repositories after RestoreOrder.
+func (o *Order) RestoreTemperatureClass(class TemperatureClass) error {
+	if err := class.Validate(); err != nil {
+		return err
+	}
+
+	o.temperatureClass = class
 	return nil
 }
 
@@ -79,6 +79,12 @@ func TestNewOrder(t *testing.T) {
 		assert.Contains(t, err.Error(), "UUID must be created")
 		assert.Contains(t, err.Error(), "location must be created")
 		assert.Contains(t, err.Error(), "volume is invalid")
+
+		var validation *errs.ValidationErrors
+		require.ErrorAs(t, err, &validation)
+		require.Len(t, validation.Fields, 3)
+		assert.Equal(t, "volume", validation.Fields[2].Field)
+		require.ErrorIs(t, err, errs.ErrValidationFailed)
 	})
 
 	t.Run("should accept minimum valid volume", func(t *testing.T) {
@@ -401,6 +407,215 @@ func TestOrder_Complete(t *testing.T) {
 	})
 }
 
+func TestOrder_Unassign(t *testing.T) {
+	validID := kernel.NewUUID()
+	validLocation, _ := kernel.NewLocation(5, 7)
+	validVolume := 100
+	courierID := kernel.NewUUID()
+
+	t.Run("should unassign assigned order", func(t *testing.T) {
+		o, _ := order.NewOrder(validID, validLocation, validVolume)
+		_ = o.Assign(courierID)
+
+		err := o.Unassign()
+
+		require.NoError(t, err)
+		assert.Equal(t, order.Created, o.Status())
+		assert.Nil(t, o.Courier())
+		require.NoError(t, o.ValidateSetStatusCourier())
+	})
+
+	t.Run("should fail to unassign created order", func(t *testing.T) {
+		o, _ := order.NewOrder(validID, validLocation, validVolume)
+
+		err := o.Unassign()
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Created is not a valid status to unassign")
+		assert.Equal(t, order.Created, o.Status())
+	})
+
+	t.Run("should fail to unassign completed order", func(t *testing.T) {
+		o, _ := order.NewOrder(validID, validLocation, validVolume)
+		_ = o.Assign(courierID)
+		_ = o.Complete()
+
+		err := o.Unassign()
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Completed is not a valid status to unassign")
+		assert.True(t, o.Courier().IsEqual(courierID))
+	})
+}
+
+func TestOrder_Cancel(t *testing.T) {
+	validLocation, _ := kernel.NewLocation(5, 7)
+	validVolume := 100
+	courierID := kernel.NewUUID()
+
+	t.Run("should cancel created order", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), validLocation, validVolume)
+
+		err := o.Cancel()
+
+		require.NoError(t, err)
+		assert.Equal(t, order.Cancelled, o.Status())
+		assert.Nil(t, o.Courier())
+		require.NoError(t, o.ValidateSetStatusCourier())
+	})
+
+	t.Run("should cancel assigned order and leave its courier", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), validLocation, validVolume)
+		_ = o.Assign(courierID)
+
+		err := o.Cancel()
+
+		require.NoError(t, err)
+		assert.Equal(t, order.Cancelled, o.Status())
+		assert.Nil(t, o.Courier())
+		assert.Nil(t, o.EstimatedArrival())
+		require.NoError(t, o.ValidateSetStatusCourier())
+	})
+
+	t.Run("should drop review hold", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), validLocation, validVolume)
+		_ = o.HoldForReview("address is blacklisted")
+
+		require.NoError(t, o.Cancel())
+
+		assert.False(t, o.IsUnderReview())
+	})
+
+	t.Run("should close thread and tracking", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), validLocation, validVolume)
+		require.NoError(t, o.Cancel())
+
+		assert.True(t, o.IsThreadClosed())
+		_, err := o.ShareTracking()
+		require.ErrorIs(t, err, order.ErrTrackingIsClosed)
+		require.ErrorIs(t, o.ValidateAssign(), errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should fail to cancel completed order", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), validLocation, validVolume)
+		_ = o.Assign(courierID)
+		_ = o.Complete()
+
+		err := o.Cancel()
+
+		require.ErrorIs(t, err, order.ErrOrderIsCompleted)
+		assert.Equal(t, order.Completed, o.Status())
+		assert.True(t, o.Courier().IsEqual(courierID))
+	})
+
+	t.Run("should fail to cancel cancelled order", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), validLocation, validVolume)
+		_ = o.Cancel()
+
+		require.ErrorIs(t, o.Cancel(), order.ErrOrderIsCancelled)
+	})
+
+	t.Run("should cancel with reason", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), validLocation, validVolume)
+		o.ClearEvents()
+
+		require.NoError(t, o.CancelWithReason("Not dispatched within 24h0m0s"))
+
+		assert.Equal(t, order.Cancelled, o.Status())
+		assert.Equal(t, []order.Event{
+			order.CancelledEvent{OrderID: o.ID(), Reason: "Not dispatched within 24h0m0s"},
+		}, o.Events())
+	})
+
+	t.Run("should fail to cancel with blank reason", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), validLocation, validVolume)
+
+		err := o.CancelWithReason("  ")
+
+		require.ErrorIs(t, err, order.ErrCancellationReasonIsRequired)
+		assert.Equal(t, order.Created, o.Status())
+	})
+}
+
+func TestOrder_ValidateDataErasure(t *testing.T) {
+	location, _ := kernel.NewLocation(5, 7)
+
+	t.Run("should reject active order", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 10)
+		require.ErrorIs(t, o.ValidateDataErasure(), order.ErrOrderIsActive)
+
+		require.NoError(t, o.Assign(kernel.NewUUID()))
+		require.ErrorIs(t, o.ValidateDataErasure(), order.ErrOrderIsActive)
+	})
+
+	t.Run("should accept completed and cancelled orders", func(t *testing.T) {
+		completed, _ := order.NewOrder(kernel.NewUUID(), location, 10)
+		require.NoError(t, completed.Assign(kernel.NewUUID()))
+		require.NoError(t, completed.Complete())
+		cancelled, _ := order.NewOrder(kernel.NewUUID(), location, 10)
+		require.NoError(t, cancelled.Cancel())
+
+		require.NoError(t, completed.ValidateDataErasure())
+		require.NoError(t, cancelled.ValidateDataErasure())
+	})
+}
+
+func TestOrder_HoldForReview(t *testing.T) {
+	validID := kernel.NewUUID()
+	validLocation, _ := kernel.NewLocation(5, 7)
+	validVolume := 100
+	courierID := kernel.NewUUID()
+
+	t.Run("should hold created order and block assignment", func(t *testing.T) {
+		o, _ := order.NewOrder(validID, validLocation, validVolume)
+
+		err := o.HoldForReview("address is blacklisted")
+
+		require.NoError(t, err)
+		assert.True(t, o.IsUnderReview())
+		assert.Equal(t, "address is blacklisted", o.ReviewReason())
+		require.ErrorIs(t, o.ValidateAssign(), order.ErrOrderIsUnderReview)
+		require.ErrorIs(t, o.Assign(courierID), order.ErrOrderIsUnderReview)
+		assert.Equal(t, order.Created, o.Status())
+	})
+
+	t.Run("should allow assignment after approval", func(t *testing.T) {
+		o, _ := order.NewOrder(validID, validLocation, validVolume)
+		require.NoError(t, o.HoldForReview("unusual volume"))
+
+		require.NoError(t, o.ApproveReview())
+
+		assert.False(t, o.IsUnderReview())
+		assert.Empty(t, o.ReviewReason())
+		require.NoError(t, o.Assign(courierID))
+	})
+
+	t.Run("should require reason", func(t *testing.T) {
+		o, _ := order.NewOrder(validID, validLocation, validVolume)
+
+		err := o.HoldForReview("  ")
+
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+		assert.False(t, o.IsUnderReview())
+	})
+
+	t.Run("should fail to hold assigned order", func(t *testing.T) {
+		o, _ := order.NewOrder(validID, validLocation, validVolume)
+		_ = o.Assign(courierID)
+
+		err := o.HoldForReview("late signal")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Assigned is not a valid status to hold for review")
+	})
+
+	t.Run("should fail to approve order that is not held", func(t *testing.T) {
+		o, _ := order.NewOrder(validID, validLocation, validVolume)
+
+		require.ErrorIs(t, o.ApproveReview(), order.ErrOrderIsNotUnderReview)
+	})
+}
+
 func TestOrder_FullWorkflow(t *testing.T) {
 	t.Run("should follow complete order lifecycle", func(t *testing.T) {
 		// Setup
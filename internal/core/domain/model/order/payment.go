@@ -0,0 +1,243 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// MaxPaymentReferenceLength is the maximum number of characters of a payment event reference.
+const MaxPaymentReferenceLength = 128
+
+var (
+	// ErrPaymentTransitionIsNotConstructed indicates that a PaymentTransition was not properly
+	// initialized through the NewPaymentTransition constructor.
+	ErrPaymentTransitionIsNotConstructed = errors.New(
+		"PaymentTransition must be created via NewPaymentTransition constructor",
+	)
+
+	// ErrOrderIsAwaitingPayment is returned when dispatching an order paid online before it is paid.
+	ErrOrderIsAwaitingPayment = errors.New("order is awaiting payment")
+
+	// ErrPaymentTransitionIsInvalid is returned when a payment event does not follow the payment lifecycle.
+	ErrPaymentTransitionIsInvalid = errors.New("payment transition is invalid")
+
+	// ErrPaymentMethodIsFixed is returned when changing the payment method of an order
+	// that is already dispatched or has payment events.
+	ErrPaymentMethodIsFixed = errors.New("payment method can no longer be changed")
+)
+
+// PaymentMethod is how the customer pays for an order.
+type PaymentMethod int
+
+const (
+	// UnknownPaymentMethod represents an invalid or undefined payment method.
+	// This value (0) helps catch uninitialized PaymentMethod values.
+	UnknownPaymentMethod PaymentMethod = iota
+
+	// CashOnDelivery orders are paid to the courier and can be dispatched before they are paid.
+	CashOnDelivery
+
+	// OnlinePayment orders are paid through the payment provider and wait for it before dispatch.
+	OnlinePayment
+)
+
+// getValidPaymentMethodStrings returns a map of valid PaymentMethod values to their string representations.
+func getValidPaymentMethodStrings() map[PaymentMethod]string {
+	//nolint:exhaustive // UnknownPaymentMethod is intentionally excluded as it's invalid
+	return map[PaymentMethod]string{
+		CashOnDelivery: "CashOnDelivery",
+		OnlinePayment:  "Online",
+	}
+}
+
+// Validate checks if the PaymentMethod value is valid.
+func (m PaymentMethod) Validate() error {
+	if _, ok := getValidPaymentMethodStrings()[m]; !ok {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"payment method is invalid",
+			fmt.Errorf("%d is not a valid payment method", m),
+		)
+	}
+	return nil
+}
+
+// String returns the human-readable name of the payment method.
+// Returns "Unknown" for invalid payment method values.
+func (m PaymentMethod) String() string {
+	if str, ok := getValidPaymentMethodStrings()[m]; ok {
+		return str
+	}
+	return "Unknown"
+}
+
+// PaymentStatus is the state of an order's payment as reported by the payment provider.
+//
+// State transitions:
+//
+//	Pending ──> Paid ──> Refunded
+type PaymentStatus int
+
+const (
+	// UnknownPaymentStatus represents an invalid or undefined payment status.
+	// This value (0) helps catch uninitialized PaymentStatus values.
+	UnknownPaymentStatus PaymentStatus = iota
+
+	// PaymentPending is the initial status: the customer has not paid yet.
+	PaymentPending
+
+	// PaymentPaid indicates the payment provider confirmed the payment.
+	PaymentPaid
+
+	// PaymentRefunded indicates the payment was returned to the customer. This is a final state.
+	PaymentRefunded
+)
+
+// getValidPaymentStatusStrings returns a map of valid PaymentStatus values to their string representations.
+func getValidPaymentStatusStrings() map[PaymentStatus]string {
+	//nolint:exhaustive // UnknownPaymentStatus is intentionally excluded as it's invalid
+	return map[PaymentStatus]string{
+		PaymentPending:  "Pending",
+		PaymentPaid:     "Paid",
+		PaymentRefunded: "Refunded",
+	}
+}
+
+// Validate checks if the PaymentStatus value is valid.
+func (s PaymentStatus) Validate() error {
+	if _, ok := getValidPaymentStatusStrings()[s]; !ok {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"payment status is invalid",
+			fmt.Errorf("%d is not a valid payment status", s),
+		)
+	}
+	return nil
+}
+
+// String returns the human-readable name of the payment status.
+// Returns "Unknown" for invalid payment status values.
+func (s PaymentStatus) String() string {
+	if str, ok := getValidPaymentStatusStrings()[s]; ok {
+		return str
+	}
+	return "Unknown"
+}
+
+// CanTransitionTo reports whether the payment lifecycle allows moving from s to next.
+func (s PaymentStatus) CanTransitionTo(next PaymentStatus) bool {
+	return (s == PaymentPending && next == PaymentPaid) || (s == PaymentPaid && next == PaymentRefunded)
+}
+
+// PaymentTransition is an audit record of a change of an order's payment status.
+// Transitions are immutable once created.
+//
+// Key business rules:
+//   - Must be constructed through NewPaymentTransition constructor
+//   - From and To must be valid payment statuses
+//   - The reference identifies the payment event that caused the transition; it is not blank
+//     and at most MaxPaymentReferenceLength characters
+type PaymentTransition struct {
+	// id uniquely identifies the transition
+	id kernel.UUID
+
+	// from is the payment status before the transition
+	from PaymentStatus
+
+	// to is the payment status after the transition
+	to PaymentStatus
+
+	// reference is the payment provider's ID of the event that caused the transition
+	reference string
+
+	// occurredAt is when the payment provider reported the event
+	occurredAt time.Time
+
+	// guard ensures the entity was properly initialized
+	guard guard.ConstructorGuard
+}
+
+// NewPaymentTransition creates a new PaymentTransition with validation.
+// Used both when applying payment events and when restoring transitions from persistent storage.
+//
+// Example:
+//
+//	transition, err := order.NewPaymentTransition(
+//	    kernel.NewUUID(), order.PaymentPending, order.PaymentPaid, "evt_1042", time.Now(),
+//	)
+func NewPaymentTransition(
+	id kernel.UUID,
+	from PaymentStatus,
+	to PaymentStatus,
+	reference string,
+	occurredAt time.Time,
+) (PaymentTransition, error) {
+	if err := errs.JoinFields(
+		errs.Field("id", id.Validate()),
+		errs.Field("from", from.Validate()),
+		errs.Field("to", to.Validate()),
+		errs.Field("reference", validatePaymentReference(reference)),
+	); err != nil {
+		return PaymentTransition{}, err
+	}
+	if occurredAt.IsZero() {
+		return PaymentTransition{}, errs.NewValueIsRequiredError("payment event time")
+	}
+
+	return PaymentTransition{
+		id:         id,
+		from:       from,
+		to:         to,
+		reference:  reference,
+		occurredAt: occurredAt,
+		guard:      guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the PaymentTransition was created through NewPaymentTransition.
+func (t PaymentTransition) Validate() error {
+	return t.guard.Validate(ErrPaymentTransitionIsNotConstructed)
+}
+
+// ID returns the transition's unique identifier.
+func (t PaymentTransition) ID() kernel.UUID {
+	return t.id
+}
+
+// From returns the payment status before the transition.
+func (t PaymentTransition) From() PaymentStatus {
+	return t.from
+}
+
+// To returns the payment status after the transition.
+func (t PaymentTransition) To() PaymentStatus {
+	return t.to
+}
+
+// Reference returns the payment provider's ID of the event that caused the transition.
+func (t PaymentTransition) Reference() string {
+	return t.reference
+}
+
+// OccurredAt returns when the payment provider reported the event.
+func (t PaymentTransition) OccurredAt() time.Time {
+	return t.occurredAt
+}
+
+func validatePaymentReference(reference string) error {
+	if strings.TrimSpace(reference) == "" {
+		return errs.NewValueIsRequiredError("payment reference")
+	}
+	if length := utf8.RuneCountInString(reference); length > MaxPaymentReferenceLength {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"payment reference is invalid",
+			fmt.Errorf("%d characters exceed the limit of %d", length, MaxPaymentReferenceLength),
+		)
+	}
+	return nil
+}
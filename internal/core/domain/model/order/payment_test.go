@@ -0,0 +1,196 @@
+package order_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPaymentTransition(t *testing.T) {
+	occurredAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("should create valid transition", func(t *testing.T) {
+		transition, err := order.NewPaymentTransition(
+			kernel.NewUUID(), order.PaymentPending, order.PaymentPaid, "evt_1", occurredAt,
+		)
+
+		require.NoError(t, err)
+		require.NoError(t, transition.Validate())
+		assert.Equal(t, order.PaymentPending, transition.From())
+		assert.Equal(t, order.PaymentPaid, transition.To())
+		assert.Equal(t, "evt_1", transition.Reference())
+		assert.Equal(t, occurredAt, transition.OccurredAt())
+	})
+
+	t.Run("should fail with unknown status", func(t *testing.T) {
+		_, err := order.NewPaymentTransition(
+			kernel.NewUUID(), order.UnknownPaymentStatus, order.PaymentPaid, "evt_1", occurredAt,
+		)
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should fail with blank or too long reference", func(t *testing.T) {
+		_, err := order.NewPaymentTransition(
+			kernel.NewUUID(), order.PaymentPending, order.PaymentPaid, " ", occurredAt,
+		)
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+
+		_, err = order.NewPaymentTransition(
+			kernel.NewUUID(), order.PaymentPending, order.PaymentPaid,
+			strings.Repeat("e", order.MaxPaymentReferenceLength+1), occurredAt,
+		)
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should fail without time", func(t *testing.T) {
+		_, err := order.NewPaymentTransition(
+			kernel.NewUUID(), order.PaymentPending, order.PaymentPaid, "evt_1", time.Time{},
+		)
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, order.PaymentTransition{}.Validate(), order.ErrPaymentTransitionIsNotConstructed)
+	})
+}
+
+func TestOrder_ApplyPaymentEvent(t *testing.T) {
+	location, _ := kernel.NewLocation(5, 7)
+	occurredAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	online := func(t *testing.T) *order.Order {
+		t.Helper()
+		o, err := order.NewOrder(kernel.NewUUID(), location, 5)
+		require.NoError(t, err)
+		require.NoError(t, o.ChangePaymentMethod(order.OnlinePayment))
+		return o
+	}
+
+	t.Run("should create cash on delivery order with pending payment", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+
+		assert.Equal(t, order.CashOnDelivery, o.PaymentMethod())
+		assert.Equal(t, order.PaymentPending, o.PaymentStatus())
+		assert.True(t, o.IsPaymentCleared())
+		require.NoError(t, o.ValidateAssign())
+	})
+
+	t.Run("should hold online order until paid", func(t *testing.T) {
+		o := online(t)
+
+		require.ErrorIs(t, o.ValidateAssign(), order.ErrOrderIsAwaitingPayment)
+		require.ErrorIs(t, o.Assign(kernel.NewUUID()), order.ErrOrderIsAwaitingPayment)
+
+		changed, err := o.ApplyPaymentEvent(order.PaymentPaid, "evt_1", occurredAt)
+
+		require.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, order.PaymentPaid, o.PaymentStatus())
+		require.NoError(t, o.Assign(kernel.NewUUID()))
+	})
+
+	t.Run("should audit transitions in order", func(t *testing.T) {
+		o := online(t)
+		_, _ = o.ApplyPaymentEvent(order.PaymentPaid, "evt_1", occurredAt)
+		_, _ = o.ApplyPaymentEvent(order.PaymentRefunded, "evt_2", occurredAt.Add(time.Hour))
+
+		transitions := o.PaymentTransitions()
+
+		require.Len(t, transitions, 2)
+		assert.Equal(t, order.PaymentPending, transitions[0].From())
+		assert.Equal(t, order.PaymentPaid, transitions[0].To())
+		assert.Equal(t, "evt_2", transitions[1].Reference())
+		assert.Equal(t, order.PaymentRefunded, o.PaymentStatus())
+		require.ErrorIs(t, o.ValidateAssign(), order.ErrOrderIsAwaitingPayment)
+	})
+
+	t.Run("should ignore redelivered and repeated events", func(t *testing.T) {
+		o := online(t)
+		_, _ = o.ApplyPaymentEvent(order.PaymentPaid, "evt_1", occurredAt)
+
+		changed, err := o.ApplyPaymentEvent(order.PaymentPaid, "evt_1", occurredAt)
+		require.NoError(t, err)
+		assert.False(t, changed)
+
+		changed, err = o.ApplyPaymentEvent(order.PaymentPaid, "evt_2", occurredAt)
+		require.NoError(t, err)
+		assert.False(t, changed)
+
+		assert.Len(t, o.PaymentTransitions(), 1)
+	})
+
+	t.Run("should refuse transitions outside the lifecycle", func(t *testing.T) {
+		o := online(t)
+
+		_, err := o.ApplyPaymentEvent(order.PaymentRefunded, "evt_1", occurredAt)
+		require.ErrorIs(t, err, order.ErrPaymentTransitionIsInvalid)
+
+		_, _ = o.ApplyPaymentEvent(order.PaymentPaid, "evt_2", occurredAt)
+		_, _ = o.ApplyPaymentEvent(order.PaymentRefunded, "evt_3", occurredAt)
+		_, err = o.ApplyPaymentEvent(order.PaymentPending, "evt_4", occurredAt)
+		require.ErrorIs(t, err, order.ErrPaymentTransitionIsInvalid)
+	})
+
+	t.Run("should hold refunded cash on delivery order", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+		_, _ = o.ApplyPaymentEvent(order.PaymentPaid, "evt_1", occurredAt)
+		_, _ = o.ApplyPaymentEvent(order.PaymentRefunded, "evt_2", occurredAt)
+
+		require.ErrorIs(t, o.ValidateAssign(), order.ErrOrderIsAwaitingPayment)
+	})
+}
+
+func TestOrder_ChangePaymentMethod(t *testing.T) {
+	location, _ := kernel.NewLocation(5, 7)
+
+	t.Run("should refuse unknown method", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+
+		require.ErrorIs(t, o.ChangePaymentMethod(order.UnknownPaymentMethod), errs.ErrValueIsInvalid)
+		assert.Equal(t, order.CashOnDelivery, o.PaymentMethod())
+	})
+
+	t.Run("should refuse change after dispatch or payment events", func(t *testing.T) {
+		assigned, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+		_ = assigned.Assign(kernel.NewUUID())
+		require.ErrorIs(t, assigned.ChangePaymentMethod(order.OnlinePayment), order.ErrPaymentMethodIsFixed)
+
+		paid, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+		_, _ = paid.ApplyPaymentEvent(order.PaymentPaid, "evt_1", time.Now())
+		require.ErrorIs(t, paid.ChangePaymentMethod(order.OnlinePayment), order.ErrPaymentMethodIsFixed)
+	})
+}
+
+func TestOrder_RestorePayment(t *testing.T) {
+	location, _ := kernel.NewLocation(5, 7)
+	transition, _ := order.NewPaymentTransition(
+		kernel.NewUUID(), order.PaymentPending, order.PaymentPaid, "evt_1", time.Now(),
+	)
+
+	t.Run("should restore payment", func(t *testing.T) {
+		o, _ := order.RestoreOrder(kernel.NewUUID(), location, 5, order.Created, nil)
+
+		require.NoError(t, o.RestorePayment(order.OnlinePayment, order.PaymentPaid, []order.PaymentTransition{transition}))
+
+		assert.Equal(t, order.OnlinePayment, o.PaymentMethod())
+		assert.Equal(t, order.PaymentPaid, o.PaymentStatus())
+		assert.Len(t, o.PaymentTransitions(), 1)
+	})
+
+	t.Run("should fail with invalid payment", func(t *testing.T) {
+		o, _ := order.RestoreOrder(kernel.NewUUID(), location, 5, order.Created, nil)
+
+		require.ErrorIs(t, o.RestorePayment(order.UnknownPaymentMethod, order.PaymentPaid, nil), errs.ErrValueIsInvalid)
+		require.ErrorIs(t,
+			o.RestorePayment(order.OnlinePayment, order.PaymentPaid, []order.PaymentTransition{{}}),
+			order.ErrPaymentTransitionIsNotConstructed,
+		)
+	})
+}
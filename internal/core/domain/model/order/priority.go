@@ -0,0 +1,99 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+
+	"delivery/internal/pkg/errs"
+)
+
+var (
+	// ErrPriorityIsFixed is returned when changing the priority of an order that is no longer waiting for dispatch.
+	ErrPriorityIsFixed = errors.New("priority can no longer be changed")
+)
+
+// Priority is how urgently an order must be dispatched. Orders waiting for a courier are
+// dispatched in the order of their priority, most urgent first, and by creation time within a priority.
+// Priorities compare by urgency: a greater value is more urgent.
+type Priority int
+
+const (
+	// UnknownPriority represents an invalid or undefined priority.
+	// This value (0) helps catch uninitialized Priority values.
+	UnknownPriority Priority = iota
+
+	// LowPriority orders are dispatched once no other order is waiting.
+	LowPriority
+
+	// NormalPriority orders are dispatched in turn. Orders have normal priority unless stated otherwise.
+	NormalPriority
+
+	// HighPriority orders are dispatched before normal ones.
+	HighPriority
+
+	// UrgentPriority orders are dispatched first, e.g. deliveries bound by a tight SLA.
+	UrgentPriority
+)
+
+// getValidPriorityStrings returns a map of valid Priority values to their string representations.
+func getValidPriorityStrings() map[Priority]string {
+	//nolint:exhaustive // UnknownPriority is intentionally excluded as it's invalid
+	return map[Priority]string{
+		LowPriority:    "Low",
+		NormalPriority: "Normal",
+		HighPriority:   "High",
+		UrgentPriority: "Urgent",
+	}
+}
+
+// Validate checks if the Priority value is valid.
+func (p Priority) Validate() error {
+	if _, ok := getValidPriorityStrings()[p]; !ok {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"priority is invalid",
+			fmt.Errorf("%d is not a valid priority", p),
+		)
+	}
+	return nil
+}
+
+// String returns the human-readable name of the priority.
+// Returns "Unknown" for invalid priority values.
+func (p Priority) String() string {
+	if str, ok := getValidPriorityStrings()[p]; ok {
+		return str
+	}
+	return "Unknown"
+}
+
+// Priority returns how urgently the order must be dispatched.
+func (o *Order) Priority() Priority {
+	return o.priority
+}
+
+// ChangePriority sets how urgently the order must be dispatched. The priority can change while
+// the order waits for a courier, including after it was unassigned, so an order at risk of missing
+// its SLA can be escalated. Returns ErrPriorityIsFixed otherwise, or a validation error for an unknown priority.
+func (o *Order) ChangePriority(priority Priority) error {
+	if err := priority.Validate(); err != nil {
+		return err
+	}
+
+	if o.status != Created {
+		return ErrPriorityIsFixed
+	}
+
+	o.priority = priority
+	return nil
+}
+
+// RestorePriority attaches the previously persisted priority to the order.
+// Used by repositories after RestoreOrder.
+func (o *Order) RestorePriority(priority Priority) error {
+	if err := priority.Validate(); err != nil {
+		return err
+	}
+
+	o.priority = priority
+	return nil
+}
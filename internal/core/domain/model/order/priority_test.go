@@ -0,0 +1,79 @@
+package order_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriority_Validate(t *testing.T) {
+	for _, priority := range []order.Priority{
+		order.LowPriority, order.NormalPriority, order.HighPriority, order.UrgentPriority,
+	} {
+		require.NoError(t, priority.Validate())
+	}
+
+	require.ErrorIs(t, order.UnknownPriority.Validate(), errs.ErrValueIsInvalid)
+	assert.Equal(t, "Urgent", order.UrgentPriority.String())
+	assert.Equal(t, "Unknown", order.Priority(42).String())
+	assert.Greater(t, order.UrgentPriority, order.HighPriority)
+	assert.Greater(t, order.NormalPriority, order.LowPriority)
+}
+
+func TestOrder_ChangePriority(t *testing.T) {
+	location, _ := kernel.NewLocation(5, 7)
+
+	t.Run("should create order with normal priority", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+
+		assert.Equal(t, order.NormalPriority, o.Priority())
+	})
+
+	t.Run("should change priority of order waiting for dispatch", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+
+		require.NoError(t, o.ChangePriority(order.UrgentPriority))
+
+		assert.Equal(t, order.UrgentPriority, o.Priority())
+	})
+
+	t.Run("should escalate unassigned order", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+		require.NoError(t, o.Assign(kernel.NewUUID()))
+		require.NoError(t, o.Unassign())
+
+		require.NoError(t, o.ChangePriority(order.HighPriority))
+
+		assert.Equal(t, order.HighPriority, o.Priority())
+	})
+
+	t.Run("should reject unknown priority", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+
+		require.ErrorIs(t, o.ChangePriority(order.UnknownPriority), errs.ErrValueIsInvalid)
+		assert.Equal(t, order.NormalPriority, o.Priority())
+	})
+
+	t.Run("should keep priority of assigned order", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+		require.NoError(t, o.Assign(kernel.NewUUID()))
+
+		require.ErrorIs(t, o.ChangePriority(order.UrgentPriority), order.ErrPriorityIsFixed)
+		assert.Equal(t, order.NormalPriority, o.Priority())
+	})
+
+	t.Run("should restore priority of assigned order", func(t *testing.T) {
+		courierID := kernel.NewUUID()
+		o, _ := order.RestoreOrder(kernel.NewUUID(), location, 5, order.Assigned, &courierID)
+
+		require.NoError(t, o.RestorePriority(order.LowPriority))
+
+		assert.Equal(t, order.LowPriority, o.Priority())
+		require.ErrorIs(t, o.RestorePriority(order.UnknownPriority), errs.ErrValueIsInvalid)
+	})
+}
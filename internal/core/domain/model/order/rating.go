@@ -0,0 +1,78 @@
+package order
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+const (
+	// MinRatingStars is the lowest rating a customer can give a delivery.
+	MinRatingStars = 1
+
+	// MaxRatingStars is the highest rating a customer can give a delivery.
+	MaxRatingStars = 5
+)
+
+var (
+	// ErrRatingIsNotConstructed indicates that a Rating was not properly initialized
+	// through the NewRating constructor.
+	ErrRatingIsNotConstructed = errors.New("Rating must be created via NewRating constructor")
+
+	// ErrOrderIsAlreadyRated indicates that a delivery was rated a second time with other stars.
+	ErrOrderIsAlreadyRated = errors.New("order is already rated")
+)
+
+// Rating is the customer's assessment of the delivery of an order, in stars.
+//
+// Key business rules:
+//   - Must be constructed through NewRating
+//   - The stars are between MinRatingStars and MaxRatingStars
+type Rating struct {
+	// stars is the assessment, MaxRatingStars being the best
+	stars int
+
+	// ratedAt is when the customer rated the delivery
+	ratedAt time.Time
+
+	// guard ensures the rating was created via NewRating
+	guard guard.ConstructorGuard
+}
+
+// NewRating creates a rating of the given stars.
+//
+// Example:
+//
+//	rating, err := order.NewRating(5, time.Now())
+func NewRating(stars int, ratedAt time.Time) (Rating, error) {
+	if stars < MinRatingStars || stars > MaxRatingStars {
+		return Rating{}, errs.NewValueIsOutOfRangeError("rating stars", stars, MinRatingStars, MaxRatingStars)
+	}
+	if ratedAt.IsZero() {
+		return Rating{}, errs.NewValueIsRequiredError("rating time")
+	}
+
+	return Rating{
+		stars:   stars,
+		ratedAt: ratedAt,
+		guard:   guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the rating was created through the constructor.
+// Returns ErrRatingIsNotConstructed if validation fails.
+func (r Rating) Validate() error {
+	return r.guard.Validate(ErrRatingIsNotConstructed)
+}
+
+// Stars returns the assessment in stars.
+func (r Rating) Stars() int {
+	return r.stars
+}
+
+// RatedAt returns when the customer rated the delivery.
+func (r Rating) RatedAt() time.Time {
+	return r.ratedAt
+}
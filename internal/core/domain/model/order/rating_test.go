@@ -0,0 +1,111 @@
+package order_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRating(t *testing.T) {
+	ratedAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("should create valid rating", func(t *testing.T) {
+		rating, err := order.NewRating(5, ratedAt)
+
+		require.NoError(t, err)
+		require.NoError(t, rating.Validate())
+		assert.Equal(t, 5, rating.Stars())
+		assert.Equal(t, ratedAt, rating.RatedAt())
+	})
+
+	t.Run("should fail with stars out of range", func(t *testing.T) {
+		_, err := order.NewRating(order.MinRatingStars-1, ratedAt)
+		require.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+
+		_, err = order.NewRating(order.MaxRatingStars+1, ratedAt)
+		require.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+	})
+
+	t.Run("should fail without time", func(t *testing.T) {
+		_, err := order.NewRating(5, time.Time{})
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, order.Rating{}.Validate(), order.ErrRatingIsNotConstructed)
+	})
+}
+
+func TestOrder_Rate(t *testing.T) {
+	location, _ := kernel.NewLocation(5, 7)
+	ratedAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	rating, _ := order.NewRating(5, ratedAt)
+
+	delivered := func(t *testing.T) *order.Order {
+		t.Helper()
+		o, err := order.NewOrder(kernel.NewUUID(), location, 5)
+		require.NoError(t, err)
+		require.NoError(t, o.Assign(kernel.NewUUID()))
+		require.NoError(t, o.Complete())
+		return o
+	}
+
+	t.Run("should rate delivered order", func(t *testing.T) {
+		o := delivered(t)
+
+		rated, err := o.Rate(rating)
+
+		require.NoError(t, err)
+		assert.True(t, rated)
+		require.NotNil(t, o.Rating())
+		assert.Equal(t, 5, o.Rating().Stars())
+	})
+
+	t.Run("should ignore resubmission of the same stars", func(t *testing.T) {
+		o := delivered(t)
+		_, _ = o.Rate(rating)
+		retry, _ := order.NewRating(5, ratedAt.Add(time.Minute))
+
+		rated, err := o.Rate(retry)
+
+		require.NoError(t, err)
+		assert.False(t, rated)
+		assert.Equal(t, ratedAt, o.Rating().RatedAt())
+	})
+
+	t.Run("should refuse rating with other stars", func(t *testing.T) {
+		o := delivered(t)
+		_, _ = o.Rate(rating)
+		other, _ := order.NewRating(3, ratedAt)
+
+		rated, err := o.Rate(other)
+
+		require.ErrorIs(t, err, order.ErrOrderIsAlreadyRated)
+		assert.False(t, rated)
+		assert.Equal(t, 5, o.Rating().Stars())
+	})
+
+	t.Run("should refuse undelivered order", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+		_ = o.Assign(kernel.NewUUID())
+
+		_, err := o.Rate(rating)
+
+		require.ErrorIs(t, err, order.ErrOrderIsNotDelivered)
+		assert.Nil(t, o.Rating())
+	})
+
+	t.Run("should fail with rating not created via constructor", func(t *testing.T) {
+		o := delivered(t)
+
+		_, err := o.Rate(order.Rating{})
+
+		require.ErrorIs(t, err, order.ErrRatingIsNotConstructed)
+	})
+}
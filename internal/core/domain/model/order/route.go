@@ -0,0 +1,218 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// MaxRouteWaypoints is the maximum number of stops a route makes between the pickup and the drop-off.
+const MaxRouteWaypoints = 8
+
+// ErrRouteIsNotConstructed indicates that a Route was not created via NewRoute.
+var ErrRouteIsNotConstructed = errors.New("Route must be created via NewRoute constructor")
+
+// Route is the way an order travels: the courier collects it at the pickup, calls at the
+// waypoints in turn and hands it over at the drop-off, which is the order's location.
+// Each part of the way between two consecutive stops is a leg.
+//
+// Key business rules:
+//   - Must be constructed through NewRoute
+//   - The pickup, the waypoints and the drop-off are valid locations
+//   - A route has at most MaxRouteWaypoints waypoints
+type Route struct {
+	// pickup is where the courier collects the order
+	pickup kernel.Location
+
+	// waypoints are the stops between the pickup and the drop-off, in the order they are visited
+	waypoints []kernel.Location
+
+	// dropOff is where the courier hands the order over
+	dropOff kernel.Location
+
+	// guard ensures the route was created via NewRoute
+	guard guard.ConstructorGuard
+}
+
+// NewRoute validates a route from pickup through the waypoints to dropOff.
+//
+// Example:
+//
+//	warehouse, _ := kernel.NewLocation(1, 1)
+//	customer, _ := kernel.NewLocation(8, 6)
+//	route, err := order.NewRoute(warehouse, customer)
+func NewRoute(pickup, dropOff kernel.Location, waypoints ...kernel.Location) (Route, error) {
+	var validation errs.ValidationErrors
+	if err := pickup.Validate(); err != nil {
+		validation.Add("pickup", err)
+	}
+	if err := dropOff.Validate(); err != nil {
+		validation.Add("dropOff", err)
+	}
+	if len(waypoints) > MaxRouteWaypoints {
+		validation.Add("waypoints", errs.NewValueIsInvalidErrorWithCause(
+			"route waypoints are invalid",
+			fmt.Errorf("%d waypoints exceed the maximum of %d", len(waypoints), MaxRouteWaypoints),
+		))
+	}
+	for i, waypoint := range waypoints {
+		if err := waypoint.Validate(); err != nil {
+			validation.Add(fmt.Sprintf("waypoints[%d]", i), err)
+		}
+	}
+	if err := validation.Err(); err != nil {
+		return Route{}, err
+	}
+
+	return Route{
+		pickup:    pickup,
+		waypoints: append([]kernel.Location(nil), waypoints...),
+		dropOff:   dropOff,
+		guard:     guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the Route was properly constructed.
+// Returns ErrRouteIsNotConstructed if validation fails.
+func (r Route) Validate() error {
+	return r.guard.Validate(ErrRouteIsNotConstructed)
+}
+
+// Pickup returns where the courier collects the order.
+func (r Route) Pickup() kernel.Location {
+	return r.pickup
+}
+
+// Waypoints returns the stops between the pickup and the drop-off, in the order they are visited.
+func (r Route) Waypoints() []kernel.Location {
+	return append([]kernel.Location(nil), r.waypoints...)
+}
+
+// DropOff returns where the courier hands the order over.
+func (r Route) DropOff() kernel.Location {
+	return r.dropOff
+}
+
+// Stops returns every stop of the route in the order they are visited,
+// from the pickup through the waypoints to the drop-off.
+func (r Route) Stops() []kernel.Location {
+	stops := make([]kernel.Location, 0, len(r.waypoints)+2)
+	stops = append(stops, r.pickup)
+	stops = append(stops, r.waypoints...)
+	return append(stops, r.dropOff)
+}
+
+// NewOrderWithRoute creates a new Order travelling along the route: its location is the route's
+// drop-off, and couriers assigned to it collect it at the pickup and call at the waypoints first.
+//
+// Returns ErrRouteIsNotConstructed if the route was not created via NewRoute, or the validation
+// error of NewOrder.
+//
+// Example:
+//
+//	route, _ := order.NewRoute(warehouse, customer)
+//	o, err := order.NewOrderWithRoute(kernel.NewUUID(), route, 5)
+func NewOrderWithRoute(id kernel.UUID, route Route, volume int) (*Order, error) {
+	if err := route.Validate(); err != nil {
+		return nil, err
+	}
+
+	order, err := NewOrder(id, route.DropOff(), volume)
+	if err != nil {
+		return nil, err
+	}
+
+	order.route = &route
+	return order, nil
+}
+
+// Route returns the way the order travels; nil if the courier takes it straight to its location.
+func (o *Order) Route() *Route {
+	return o.route
+}
+
+// ReachedStops returns how many stops of the route the courier already called at;
+// zero for orders without a route.
+func (o *Order) ReachedStops() int {
+	return o.reachedStops
+}
+
+// NextStop returns where the courier of the order heads to: the first stop of the route it has
+// not called at yet, or the order's location if the order has no route.
+func (o *Order) NextStop() kernel.Location {
+	if o.route == nil {
+		return o.location
+	}
+	return o.route.Stops()[o.reachedStops]
+}
+
+// RemainingStops returns the stops the courier still has to call at in the order they are
+// visited, ending with the order's location.
+func (o *Order) RemainingStops() []kernel.Location {
+	if o.route == nil {
+		return []kernel.Location{o.location}
+	}
+	return o.route.Stops()[o.reachedStops:]
+}
+
+// ReachStop records that the courier of the order stands at location. A courier standing at
+// the next stop before the drop-off calls at it, and at every following stop at the same
+// location, and sets out towards the stop after; the route tracking starts over from there.
+// Reports whether the courier arrived at the order's location after calling at every stop.
+// Returns ErrOrderIsNotAssigned if the order is not assigned.
+//
+// Example:
+//
+//	_ = courier.Move(o.NextStop())
+//	arrived, err := o.ReachStop(courier.Location())
+//	if err == nil && arrived {
+//	    // Hand the order over
+//	}
+func (o *Order) ReachStop(location kernel.Location) (bool, error) {
+	if err := location.Validate(); err != nil {
+		return false, err
+	}
+	if o.status != Assigned {
+		return false, ErrOrderIsNotAssigned
+	}
+
+	stops := o.RemainingStops()
+	for len(stops) > 1 {
+		reached, err := location.IsEqual(stops[0])
+		if err != nil || !reached {
+			return false, err
+		}
+
+		o.reachedStops++
+		stops = stops[1:]
+		o.routeOrigin = &location
+		o.routeDeviationTicks = 0
+	}
+
+	return location.IsEqual(stops[0])
+}
+
+// RestoreRouteStops attaches the previously persisted route and the number of its stops the
+// courier already called at to the order. Used by repositories after RestoreOrder.
+func (o *Order) RestoreRouteStops(route *Route, reachedStops int) error {
+	stops := 1
+	if route != nil {
+		if err := route.Validate(); err != nil {
+			return err
+		}
+		stops = len(route.Stops())
+	}
+	if reachedStops < 0 || reachedStops >= stops {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"reached route stops are invalid",
+			fmt.Errorf("%d is out of [0, %d)", reachedStops, stops),
+		)
+	}
+
+	o.route = route
+	o.reachedStops = reachedStops
+	return nil
+}
@@ -0,0 +1,210 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// ErrRouteDeviationPolicyIsNotConstructed indicates that a RouteDeviationPolicy was not properly
+// initialized through the NewRouteDeviationPolicy constructor.
+var ErrRouteDeviationPolicyIsNotConstructed = errors.New(
+	"RouteDeviationPolicy must be created via NewRouteDeviationPolicy constructor",
+)
+
+// RouteDeviationPolicy decides when a courier strayed from the route to an order for long enough
+// to need the dispatcher's attention. The route is any shortest path from where the courier set
+// out towards the order to its next stop, see Order.NextStop; a courier deviates by the number of cells it
+// would have to walk back to reach one of them.
+//
+// Key business rules:
+//   - Must be constructed through NewRouteDeviationPolicy
+//   - The maximum deviation is non-negative; deviations up to it are tolerated
+//   - The number of ticks is positive; the courier must exceed the maximum on that many consecutive ticks
+type RouteDeviationPolicy struct {
+	maxDeviation int
+	ticks        int
+
+	guard guard.ConstructorGuard
+}
+
+// NewRouteDeviationPolicy creates a policy with validation.
+//
+// Example:
+//
+//	policy, err := order.NewRouteDeviationPolicy(2, 3) // more than 2 cells off route on 3 ticks in a row
+func NewRouteDeviationPolicy(maxDeviation, ticks int) (RouteDeviationPolicy, error) {
+	var validation errs.ValidationErrors
+	if maxDeviation < 0 {
+		validation.Add("maxDeviation", errs.NewValueIsInvalidErrorWithCause(
+			"maximum route deviation is invalid",
+			fmt.Errorf("%d is negative", maxDeviation),
+		))
+	}
+	if ticks <= 0 {
+		validation.Add("ticks", errs.NewValueIsInvalidErrorWithCause(
+			"route deviation ticks are invalid",
+			fmt.Errorf("%d must be positive", ticks),
+		))
+	}
+	if err := validation.Err(); err != nil {
+		return RouteDeviationPolicy{}, err
+	}
+
+	return RouteDeviationPolicy{maxDeviation: maxDeviation, ticks: ticks, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the RouteDeviationPolicy was properly constructed.
+// Returns ErrRouteDeviationPolicyIsNotConstructed if validation fails.
+func (p RouteDeviationPolicy) Validate() error {
+	return p.guard.Validate(ErrRouteDeviationPolicyIsNotConstructed)
+}
+
+// MaxDeviation returns how many cells a courier may be off route without counting as deviating.
+func (p RouteDeviationPolicy) MaxDeviation() int {
+	return p.maxDeviation
+}
+
+// Ticks returns on how many consecutive ticks a courier must deviate before the order is flagged.
+func (p RouteDeviationPolicy) Ticks() int {
+	return p.ticks
+}
+
+// RouteDeviation is the departure of an order's courier from the route to the order,
+// as observed on a movement tick.
+type RouteDeviation struct {
+	// Origin is where the courier set out towards the order
+	Origin kernel.Location
+
+	// Location is where the courier was observed
+	Location kernel.Location
+
+	// Distance is the number of cells between the courier and the nearest shortest route
+	Distance int
+
+	// Ticks is the number of consecutive ticks the courier exceeded the maximum deviation
+	Ticks int
+}
+
+// RouteOrigin returns where the courier set out towards the order; nil until the route is tracked.
+func (o *Order) RouteOrigin() *kernel.Location {
+	return o.routeOrigin
+}
+
+// RouteDeviationTicks returns the number of consecutive ticks the courier was off route.
+func (o *Order) RouteDeviationTicks() int {
+	return o.routeDeviationTicks
+}
+
+// RouteDeviatedAt returns when the order was flagged for the dispatcher's attention because its
+// courier strayed from the route; nil if it was not flagged.
+func (o *Order) RouteDeviatedAt() *time.Time {
+	return o.routeDeviatedAt
+}
+
+// TrackRoute compares the courier's location on a movement tick with the route to the order.
+// The first observation after an assignment sets out the route from the courier's location;
+// calling at a stop of the order's route sets it out again from the stop.
+// Once the courier deviated by more than the policy's maximum on the policy's number of
+// consecutive ticks, the order is flagged at now and TrackRoute reports the deviation with true.
+// An order is flagged at most once per assignment; tracking continues so the deviation stays current.
+// Returns ErrOrderIsNotAssigned if the order is not assigned.
+//
+// Example:
+//
+//	deviation, flagged, err := o.TrackRoute(courier.Location(), policy, time.Now())
+//	if err == nil && flagged {
+//	    log.Printf("courier is %d cells off route", deviation.Distance)
+//	}
+func (o *Order) TrackRoute(
+	location kernel.Location,
+	policy RouteDeviationPolicy,
+	now time.Time,
+) (RouteDeviation, bool, error) {
+	if err := policy.Validate(); err != nil {
+		return RouteDeviation{}, false, err
+	}
+	if err := location.Validate(); err != nil {
+		return RouteDeviation{}, false, err
+	}
+	if o.status != Assigned {
+		return RouteDeviation{}, false, ErrOrderIsNotAssigned
+	}
+
+	if o.routeOrigin == nil {
+		o.routeOrigin = &location
+	}
+
+	deviation := RouteDeviation{
+		Origin:   *o.routeOrigin,
+		Location: location,
+		Distance: distanceFromRoute(*o.routeOrigin, o.NextStop(), location),
+	}
+
+	if deviation.Distance > policy.MaxDeviation() {
+		o.routeDeviationTicks++
+	} else {
+		o.routeDeviationTicks = 0
+	}
+	deviation.Ticks = o.routeDeviationTicks
+
+	if o.routeDeviatedAt != nil || o.routeDeviationTicks < policy.Ticks() {
+		return deviation, false, nil
+	}
+
+	o.routeDeviatedAt = &now
+	return deviation, true, nil
+}
+
+// RestoreRoute attaches the previously persisted route tracking to the order.
+// Used by repositories after RestoreOrder.
+func (o *Order) RestoreRoute(origin *kernel.Location, deviationTicks int, deviatedAt *time.Time) error {
+	if origin != nil {
+		if err := origin.Validate(); err != nil {
+			return err
+		}
+	}
+	if deviationTicks < 0 {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"route deviation ticks are invalid",
+			fmt.Errorf("%d is negative", deviationTicks),
+		)
+	}
+
+	o.routeOrigin = origin
+	o.routeDeviationTicks = deviationTicks
+	o.routeDeviatedAt = deviatedAt
+	return nil
+}
+
+// resetRoute discards the route tracking and the stops called at when the order changes hands,
+// so the route of the next courier starts where that courier is and leads through every stop.
+func (o *Order) resetRoute() {
+	o.reachedStops = 0
+	o.routeOrigin = nil
+	o.routeDeviationTicks = 0
+	o.routeDeviatedAt = nil
+}
+
+// distanceFromRoute returns how many cells location lies outside the rectangle spanned by
+// origin and target, which holds every shortest path between them on the grid.
+func distanceFromRoute(origin, target, location kernel.Location) int {
+	return outside(location.X(), origin.X(), target.X()) + outside(location.Y(), origin.Y(), target.Y())
+}
+
+// outside returns how far v lies outside the interval between a and b.
+func outside(v, a, b kernel.Coordinate) int {
+	low, high := min(a, b), max(a, b)
+	switch {
+	case v < low:
+		return int(low) - int(v)
+	case v > high:
+		return int(v) - int(high)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,141 @@
+package order_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRouteDeviationPolicy(t *testing.T) {
+	t.Run("should create policy", func(t *testing.T) {
+		policy, err := order.NewRouteDeviationPolicy(2, 3)
+
+		require.NoError(t, err)
+		require.NoError(t, policy.Validate())
+		assert.Equal(t, 2, policy.MaxDeviation())
+		assert.Equal(t, 3, policy.Ticks())
+	})
+
+	t.Run("should fail with negative deviation or non-positive ticks", func(t *testing.T) {
+		_, err := order.NewRouteDeviationPolicy(-1, 0)
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+		var validation *errs.ValidationErrors
+		require.ErrorAs(t, err, &validation)
+		assert.Len(t, validation.Fields, 2)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, order.RouteDeviationPolicy{}.Validate(), order.ErrRouteDeviationPolicyIsNotConstructed)
+	})
+}
+
+func TestOrder_TrackRoute(t *testing.T) {
+	target, _ := kernel.NewLocation(5, 5)
+	origin, _ := kernel.NewLocation(1, 1)
+	onRoute, _ := kernel.NewLocation(3, 2)
+	offRoute, _ := kernel.NewLocation(8, 1)
+	policy, _ := order.NewRouteDeviationPolicy(2, 2)
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	assigned := func(t *testing.T) *order.Order {
+		o, _ := order.NewOrder(kernel.NewUUID(), target, 5)
+		require.NoError(t, o.Assign(kernel.NewUUID()))
+		return o
+	}
+
+	t.Run("should set out the route from the first observed location", func(t *testing.T) {
+		o := assigned(t)
+
+		deviation, flagged, err := o.TrackRoute(origin, policy, now)
+
+		require.NoError(t, err)
+		assert.False(t, flagged)
+		assert.Equal(t, origin, *o.RouteOrigin())
+		assert.Equal(t, origin, deviation.Origin)
+		assert.Zero(t, deviation.Distance)
+	})
+
+	t.Run("should tolerate any shortest route", func(t *testing.T) {
+		o := assigned(t)
+		_, _, _ = o.TrackRoute(origin, policy, now)
+
+		deviation, flagged, err := o.TrackRoute(onRoute, policy, now)
+
+		require.NoError(t, err)
+		assert.False(t, flagged)
+		assert.Zero(t, deviation.Distance)
+		assert.Zero(t, o.RouteDeviationTicks())
+	})
+
+	t.Run("should flag order once deviation lasts for the ticks", func(t *testing.T) {
+		o := assigned(t)
+		_, _, _ = o.TrackRoute(origin, policy, now)
+
+		deviation, flagged, err := o.TrackRoute(offRoute, policy, now)
+		require.NoError(t, err)
+		assert.False(t, flagged)
+		assert.Equal(t, 3, deviation.Distance)
+		assert.Nil(t, o.RouteDeviatedAt())
+
+		deviation, flagged, err = o.TrackRoute(offRoute, policy, now)
+		require.NoError(t, err)
+		assert.True(t, flagged)
+		assert.Equal(t, 2, deviation.Ticks)
+		assert.Equal(t, &now, o.RouteDeviatedAt())
+
+		_, flagged, err = o.TrackRoute(offRoute, policy, now.Add(time.Second))
+		require.NoError(t, err)
+		assert.False(t, flagged, "an order is flagged once per assignment")
+		assert.Equal(t, &now, o.RouteDeviatedAt())
+	})
+
+	t.Run("should restart counting when courier returns to the route", func(t *testing.T) {
+		o := assigned(t)
+		_, _, _ = o.TrackRoute(origin, policy, now)
+		_, _, _ = o.TrackRoute(offRoute, policy, now)
+
+		_, _, err := o.TrackRoute(onRoute, policy, now)
+		require.NoError(t, err)
+		_, flagged, err := o.TrackRoute(offRoute, policy, now)
+
+		require.NoError(t, err)
+		assert.False(t, flagged)
+		assert.Equal(t, 1, o.RouteDeviationTicks())
+	})
+
+	t.Run("should discard tracking when order changes hands", func(t *testing.T) {
+		o := assigned(t)
+		_, _, _ = o.TrackRoute(origin, policy, now)
+		_, _, _ = o.TrackRoute(offRoute, policy, now)
+		_, _, _ = o.TrackRoute(offRoute, policy, now)
+
+		require.NoError(t, o.Assign(kernel.NewUUID()))
+
+		assert.Nil(t, o.RouteOrigin())
+		assert.Zero(t, o.RouteDeviationTicks())
+		assert.Nil(t, o.RouteDeviatedAt())
+	})
+
+	t.Run("should fail for order that is not assigned", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), target, 5)
+
+		_, _, err := o.TrackRoute(origin, policy, now)
+
+		require.ErrorIs(t, err, order.ErrOrderIsNotAssigned)
+	})
+
+	t.Run("should fail with policy not created via constructor", func(t *testing.T) {
+		o := assigned(t)
+
+		_, _, err := o.TrackRoute(origin, order.RouteDeviationPolicy{}, now)
+
+		require.ErrorIs(t, err, order.ErrRouteDeviationPolicyIsNotConstructed)
+	})
+}
@@ -0,0 +1,194 @@
+package order_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRoute(t *testing.T) {
+	pickup, _ := kernel.NewLocation(1, 1)
+	waypoint, _ := kernel.NewLocation(4, 2)
+	dropOff, _ := kernel.NewLocation(6, 6)
+
+	t.Run("should create route through waypoints", func(t *testing.T) {
+		route, err := order.NewRoute(pickup, dropOff, waypoint)
+
+		require.NoError(t, err)
+		require.NoError(t, route.Validate())
+		assert.Equal(t, pickup, route.Pickup())
+		assert.Equal(t, []kernel.Location{waypoint}, route.Waypoints())
+		assert.Equal(t, dropOff, route.DropOff())
+		assert.Equal(t, []kernel.Location{pickup, waypoint, dropOff}, route.Stops())
+	})
+
+	t.Run("should fail with invalid stops", func(t *testing.T) {
+		_, err := order.NewRoute(kernel.Location{}, dropOff, waypoint, kernel.Location{})
+
+		var validation *errs.ValidationErrors
+		require.ErrorAs(t, err, &validation)
+		assert.Len(t, validation.Fields, 2)
+	})
+
+	t.Run("should fail with too many waypoints", func(t *testing.T) {
+		waypoints := make([]kernel.Location, order.MaxRouteWaypoints+1)
+		for i := range waypoints {
+			waypoints[i] = waypoint
+		}
+
+		_, err := order.NewRoute(pickup, dropOff, waypoints...)
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, order.Route{}.Validate(), order.ErrRouteIsNotConstructed)
+	})
+}
+
+func TestNewOrderWithRoute(t *testing.T) {
+	pickup, _ := kernel.NewLocation(1, 1)
+	dropOff, _ := kernel.NewLocation(6, 6)
+	route, _ := order.NewRoute(pickup, dropOff)
+
+	t.Run("should deliver order to drop-off through pickup", func(t *testing.T) {
+		o, err := order.NewOrderWithRoute(kernel.NewUUID(), route, 5)
+
+		require.NoError(t, err)
+		assert.Equal(t, dropOff, o.Location())
+		require.NotNil(t, o.Route())
+		assert.Equal(t, pickup, o.NextStop())
+		assert.Equal(t, []kernel.Location{pickup, dropOff}, o.RemainingStops())
+	})
+
+	t.Run("should fail with route not created via constructor", func(t *testing.T) {
+		_, err := order.NewOrderWithRoute(kernel.NewUUID(), order.Route{}, 5)
+
+		require.ErrorIs(t, err, order.ErrRouteIsNotConstructed)
+	})
+}
+
+func TestOrder_ReachStop(t *testing.T) {
+	pickup, _ := kernel.NewLocation(1, 1)
+	waypoint, _ := kernel.NewLocation(4, 2)
+	dropOff, _ := kernel.NewLocation(6, 6)
+	elsewhere, _ := kernel.NewLocation(9, 9)
+	route, _ := order.NewRoute(pickup, dropOff, waypoint)
+
+	assigned := func(t *testing.T, route order.Route) *order.Order {
+		o, err := order.NewOrderWithRoute(kernel.NewUUID(), route, 5)
+		require.NoError(t, err)
+		require.NoError(t, o.Assign(kernel.NewUUID()))
+		return o
+	}
+
+	t.Run("should call at stops in turn before arriving", func(t *testing.T) {
+		o := assigned(t, route)
+
+		arrived, err := o.ReachStop(dropOff)
+		require.NoError(t, err)
+		assert.False(t, arrived, "the drop-off is reached only through the pickup")
+		assert.Equal(t, pickup, o.NextStop())
+
+		arrived, err = o.ReachStop(pickup)
+		require.NoError(t, err)
+		assert.False(t, arrived)
+		assert.Equal(t, waypoint, o.NextStop())
+
+		arrived, err = o.ReachStop(waypoint)
+		require.NoError(t, err)
+		assert.False(t, arrived)
+		assert.Equal(t, []kernel.Location{dropOff}, o.RemainingStops())
+
+		arrived, err = o.ReachStop(dropOff)
+		require.NoError(t, err)
+		assert.True(t, arrived)
+		assert.Equal(t, 2, o.ReachedStops())
+	})
+
+	t.Run("should call at consecutive stops at the same location at once", func(t *testing.T) {
+		o := assigned(t, func() order.Route {
+			r, _ := order.NewRoute(pickup, dropOff, pickup, dropOff)
+			return r
+		}())
+
+		_, _ = o.ReachStop(pickup)
+		arrived, err := o.ReachStop(dropOff)
+
+		require.NoError(t, err)
+		assert.True(t, arrived)
+		assert.Equal(t, 3, o.ReachedStops())
+	})
+
+	t.Run("should set out route tracking again from reached stop", func(t *testing.T) {
+		o := assigned(t, route)
+		policy, _ := order.NewRouteDeviationPolicy(0, 1)
+		_, _, _ = o.TrackRoute(elsewhere, policy, time.Now())
+
+		_, err := o.ReachStop(pickup)
+
+		require.NoError(t, err)
+		assert.Equal(t, &pickup, o.RouteOrigin())
+		assert.Zero(t, o.RouteDeviationTicks())
+	})
+
+	t.Run("should arrive at location of order without route", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), dropOff, 5)
+		require.NoError(t, o.Assign(kernel.NewUUID()))
+
+		assert.Equal(t, dropOff, o.NextStop())
+		arrived, err := o.ReachStop(dropOff)
+
+		require.NoError(t, err)
+		assert.True(t, arrived)
+		assert.Zero(t, o.ReachedStops())
+	})
+
+	t.Run("should discard stops called at when order changes hands", func(t *testing.T) {
+		o := assigned(t, route)
+		_, _ = o.ReachStop(pickup)
+
+		require.NoError(t, o.Unassign())
+
+		assert.Zero(t, o.ReachedStops())
+		assert.Equal(t, pickup, o.NextStop())
+	})
+
+	t.Run("should fail when order is not assigned", func(t *testing.T) {
+		o, _ := order.NewOrderWithRoute(kernel.NewUUID(), route, 5)
+
+		_, err := o.ReachStop(pickup)
+
+		require.ErrorIs(t, err, order.ErrOrderIsNotAssigned)
+	})
+}
+
+func TestOrder_RestoreRouteStops(t *testing.T) {
+	pickup, _ := kernel.NewLocation(1, 1)
+	dropOff, _ := kernel.NewLocation(6, 6)
+	route, _ := order.NewRoute(pickup, dropOff)
+
+	t.Run("should restore route and stops called at", func(t *testing.T) {
+		courierID := kernel.NewUUID()
+		o, _ := order.RestoreOrder(kernel.NewUUID(), dropOff, 5, order.Assigned, &courierID)
+
+		require.NoError(t, o.RestoreRouteStops(&route, 1))
+
+		assert.Equal(t, &route, o.Route())
+		assert.Equal(t, dropOff, o.NextStop())
+	})
+
+	t.Run("should fail when stops called at exceed route", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), dropOff, 5)
+
+		require.ErrorIs(t, o.RestoreRouteStops(&route, 2), errs.ErrValueIsInvalid)
+		require.ErrorIs(t, o.RestoreRouteStops(nil, 1), errs.ErrValueIsInvalid)
+		require.ErrorIs(t, o.RestoreRouteStops(&route, -1), errs.ErrValueIsInvalid)
+	})
+}
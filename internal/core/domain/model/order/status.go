@@ -17,6 +17,9 @@ import (
 //	          └────────┘
 //	     (reassignment allowed)
 //
+//	Assigned ──> Created (unassignment)
+//	Created, Assigned ──> Cancelled (cancellation)
+//
 // Status is a value object that validates state transitions
 // and provides string representations for persistence and display.
 type Status int
@@ -37,6 +40,10 @@ const (
 	// Completed indicates the order has been successfully delivered.
 	// This is a final state with no further transitions allowed.
 	Completed
+
+	// Cancelled indicates the order was called off before it was delivered.
+	// This is a final state with no further transitions allowed.
+	Cancelled
 )
 
 // getStatusStrings returns a map of Status values to their string representations.
@@ -47,6 +54,7 @@ func getStatusStrings() map[Status]string {
 		Created:   "Created",
 		Assigned:  "Assigned",
 		Completed: "Completed",
+		Cancelled: "Cancelled",
 	}
 }
 
@@ -58,12 +66,13 @@ func getValidStatusStrings() map[Status]string {
 		Created:   "Created",
 		Assigned:  "Assigned",
 		Completed: "Completed",
+		Cancelled: "Cancelled",
 	}
 }
 
 // Validate checks if the Status value is valid.
 //
-// Valid statuses are: Created, Assigned, Completed.
+// Valid statuses are: Created, Assigned, Completed, Cancelled.
 // Unknown (0) and any other values are invalid.
 //
 // Returns:
@@ -82,7 +91,7 @@ func (s Status) Validate() error {
 // String returns the human-readable name of the status.
 //
 // Returns:
-//   - "Created", "Assigned", or "Completed" for valid statuses
+//   - "Created", "Assigned", "Completed" or "Cancelled" for valid statuses
 //   - "Unknown" for invalid status values
 //
 // This method implements the fmt.Stringer interface and is safe
@@ -98,6 +107,12 @@ func (s Status) String() string {
 	return "Unknown"
 }
 
+// IsFinal reports whether the order has left its lifecycle, that is, it was delivered or cancelled.
+// Orders in a final status no longer change hands, accept messages or share tracking.
+func (s Status) IsFinal() bool {
+	return s == Completed || s == Cancelled
+}
+
 // ValidateAssign checks if the status allows assignment without performing the transition.
 //
 // Valid statuses for assignment:
@@ -106,6 +121,7 @@ func (s Status) String() string {
 //
 // Invalid statuses for assignment:
 //   - Completed (cannot assign completed orders)
+//   - Cancelled (cannot assign cancelled orders)
 //   - Unknown (invalid status)
 //
 // Returns:
@@ -139,6 +155,7 @@ func (s Status) ValidateAssign() error {
 //   - Created orders must not have a courier assigned
 //   - Assigned orders must have a courier assigned
 //   - Completed orders must have a courier assigned
+//   - Cancelled orders must not have a courier assigned
 //
 // Parameters:
 //   - courier: whether the order has a courier assigned
@@ -226,3 +243,56 @@ func (s Status) Complete() (Status, error) {
 
 	return Completed, nil
 }
+
+// Unassign transitions the status back to Created.
+//
+// Valid transitions:
+//   - Assigned -> Created (order returned to the dispatch queue)
+//
+// Invalid transitions:
+//   - Created -> Created (order is not assigned)
+//   - Completed -> Created (order is already delivered)
+//   - Unknown -> Created (invalid initial state)
+//
+// Returns:
+//   - (Created, nil) on valid transition
+//   - (0, error) if transition is not allowed from current status
+//
+// This method is used by Order.Unassign() to enforce state transitions.
+func (s Status) Unassign() (Status, error) {
+	if s != Assigned {
+		return 0, errs.NewValueIsInvalidErrorWithCause(
+			"status is invalid",
+			fmt.Errorf("%s is not a valid status to unassign", s.String()),
+		)
+	}
+
+	return Created, nil
+}
+
+// Cancel transitions the status to Cancelled.
+//
+// Valid transitions:
+//   - Created -> Cancelled (order called off before dispatch)
+//   - Assigned -> Cancelled (order called off on the way)
+//
+// Invalid transitions:
+//   - Completed -> Cancelled (order is already delivered)
+//   - Cancelled -> Cancelled (order is already cancelled)
+//   - Unknown -> Cancelled (invalid initial state)
+//
+// Returns:
+//   - (Cancelled, nil) on valid transition
+//   - (0, error) if transition is not allowed from current status
+//
+// This method is used by Order.Cancel() to enforce state transitions.
+func (s Status) Cancel() (Status, error) {
+	if s != Created && s != Assigned {
+		return 0, errs.NewValueIsInvalidErrorWithCause(
+			"status is invalid",
+			fmt.Errorf("%s is not a valid status to cancel", s.String()),
+		)
+	}
+
+	return Cancelled, nil
+}
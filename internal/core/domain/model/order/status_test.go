@@ -17,6 +17,7 @@ func TestStatus_Constants(t *testing.T) {
 		assert.Equal(t, 1, int(order.Created))
 		assert.Equal(t, 2, int(order.Assigned))
 		assert.Equal(t, 3, int(order.Completed))
+		assert.Equal(t, 4, int(order.Cancelled))
 	})
 
 	t.Run("should have distinct values", func(t *testing.T) {
@@ -25,6 +26,7 @@ func TestStatus_Constants(t *testing.T) {
 			order.Created,
 			order.Assigned,
 			order.Completed,
+			order.Cancelled,
 		}
 
 		for i, status1 := range statuses {
@@ -44,6 +46,7 @@ func TestStatus_Validate(t *testing.T) {
 			order.Created,
 			order.Assigned,
 			order.Completed,
+			order.Cancelled,
 		}
 
 		for _, status := range validStatuses {
@@ -66,7 +69,7 @@ func TestStatus_Validate(t *testing.T) {
 	t.Run("should reject invalid status values", func(t *testing.T) {
 		invalidStatuses := []order.Status{
 			order.Status(-1),
-			order.Status(4),
+			order.Status(5),
 			order.Status(100),
 			order.Status(-999),
 		}
@@ -93,6 +96,7 @@ func TestStatus_String(t *testing.T) {
 			{order.Created, "Created"},
 			{order.Assigned, "Assigned"},
 			{order.Completed, "Completed"},
+			{order.Cancelled, "Cancelled"},
 		}
 
 		for _, tc := range testCases {
@@ -107,7 +111,7 @@ func TestStatus_String(t *testing.T) {
 		invalidStatuses := []order.Status{
 			order.Unknown,
 			order.Status(-1),
-			order.Status(4),
+			order.Status(5),
 			order.Status(100),
 		}
 
@@ -253,6 +257,65 @@ func TestStatus_Complete(t *testing.T) {
 	})
 }
 
+func TestStatus_Unassign(t *testing.T) {
+	t.Run("should allow transition from Assigned to Created", func(t *testing.T) {
+		status := order.Assigned
+
+		newStatus, err := status.Unassign()
+
+		require.NoError(t, err)
+		assert.Equal(t, order.Created, newStatus)
+	})
+
+	t.Run("should reject transition from non-assigned statuses", func(t *testing.T) {
+		for _, status := range []order.Status{
+			order.Unknown, order.Created, order.Completed, order.Cancelled, order.Status(100),
+		} {
+			t.Run(status.String(), func(t *testing.T) {
+				newStatus, err := status.Unassign()
+
+				require.Error(t, err)
+				assert.Equal(t, order.Status(0), newStatus)
+				assert.IsType(t, &errs.ValueIsInvalidError{}, err)
+				assert.Contains(t, err.Error(), "is not a valid status to unassign")
+			})
+		}
+	})
+}
+
+func TestStatus_Cancel(t *testing.T) {
+	t.Run("should allow transition from Created and Assigned to Cancelled", func(t *testing.T) {
+		for _, status := range []order.Status{order.Created, order.Assigned} {
+			t.Run(status.String(), func(t *testing.T) {
+				newStatus, err := status.Cancel()
+
+				require.NoError(t, err)
+				assert.Equal(t, order.Cancelled, newStatus)
+			})
+		}
+	})
+
+	t.Run("should reject transition from final and invalid statuses", func(t *testing.T) {
+		for _, status := range []order.Status{order.Unknown, order.Completed, order.Cancelled, order.Status(100)} {
+			t.Run(status.String(), func(t *testing.T) {
+				newStatus, err := status.Cancel()
+
+				require.Error(t, err)
+				assert.Equal(t, order.Status(0), newStatus)
+				assert.IsType(t, &errs.ValueIsInvalidError{}, err)
+				assert.Contains(t, err.Error(), "is not a valid status to cancel")
+			})
+		}
+	})
+}
+
+func TestStatus_IsFinal(t *testing.T) {
+	assert.False(t, order.Created.IsFinal())
+	assert.False(t, order.Assigned.IsFinal())
+	assert.True(t, order.Completed.IsFinal())
+	assert.True(t, order.Cancelled.IsFinal())
+}
+
 func TestStatus_StateMachine(t *testing.T) {
 	t.Run("should follow valid state transitions", func(t *testing.T) {
 		// Test full valid workflow: Created -> Assigned -> Completed
@@ -364,7 +427,7 @@ func TestStatus_EdgeCases(t *testing.T) {
 		require.Error(t, belowRange.Validate())
 
 		// Test just above valid range
-		aboveRange := order.Status(4)
+		aboveRange := order.Status(5)
 		assert.Equal(t, "Unknown", aboveRange.String())
 		require.Error(t, aboveRange.Validate())
 	})
@@ -426,6 +489,7 @@ func TestStatus_ValidateAssign(t *testing.T) {
 	t.Run("should reject assignment from invalid statuses", func(t *testing.T) {
 		invalidStatuses := []order.Status{
 			order.Completed,
+			order.Cancelled,
 			order.Unknown,
 		}
 
@@ -444,7 +508,7 @@ func TestStatus_ValidateAssign(t *testing.T) {
 	t.Run("should reject assignment from arbitrary invalid status values", func(t *testing.T) {
 		invalidStatuses := []order.Status{
 			order.Status(-1),
-			order.Status(4),
+			order.Status(5),
 			order.Status(100),
 			order.Status(-999),
 		}
@@ -0,0 +1,61 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+
+	"delivery/internal/pkg/errs"
+)
+
+var (
+	// ErrTemperatureClassIsFixed is returned when changing the temperature class of an order that was dispatched.
+	ErrTemperatureClassIsFixed = errors.New("temperature class can no longer be changed")
+)
+
+// TemperatureClass is the temperature the contents of an order must be kept at on the way.
+type TemperatureClass int
+
+const (
+	// UnknownTemperatureClass represents an invalid or undefined temperature class.
+	// This value (0) helps catch uninitialized TemperatureClass values.
+	UnknownTemperatureClass TemperatureClass = iota
+
+	// Ambient orders travel at room temperature. Orders are ambient unless stated otherwise.
+	Ambient
+
+	// Chilled orders must be kept cool, e.g. dairy.
+	Chilled
+
+	// Frozen orders must be kept frozen, e.g. ice cream.
+	Frozen
+)
+
+// getValidTemperatureClassStrings returns a map of valid TemperatureClass values to their string representations.
+func getValidTemperatureClassStrings() map[TemperatureClass]string {
+	//nolint:exhaustive // UnknownTemperatureClass is intentionally excluded as it's invalid
+	return map[TemperatureClass]string{
+		Ambient: "Ambient",
+		Chilled: "Chilled",
+		Frozen:  "Frozen",
+	}
+}
+
+// Validate checks if the TemperatureClass value is valid.
+func (c TemperatureClass) Validate() error {
+	if _, ok := getValidTemperatureClassStrings()[c]; !ok {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"temperature class is invalid",
+			fmt.Errorf("%d is not a valid temperature class", c),
+		)
+	}
+	return nil
+}
+
+// String returns the human-readable name of the temperature class.
+// Returns "Unknown" for invalid temperature class values.
+func (c TemperatureClass) String() string {
+	if str, ok := getValidTemperatureClassStrings()[c]; ok {
+		return str
+	}
+	return "Unknown"
+}
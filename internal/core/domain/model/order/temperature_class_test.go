@@ -0,0 +1,64 @@
+package order_test
+
+import (
+	"testing"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemperatureClass_Validate(t *testing.T) {
+	for _, class := range []order.TemperatureClass{order.Ambient, order.Chilled, order.Frozen} {
+		require.NoError(t, class.Validate())
+	}
+
+	require.ErrorIs(t, order.UnknownTemperatureClass.Validate(), errs.ErrValueIsInvalid)
+	assert.Equal(t, "Frozen", order.Frozen.String())
+	assert.Equal(t, "Unknown", order.TemperatureClass(42).String())
+}
+
+func TestOrder_ChangeTemperatureClass(t *testing.T) {
+	location, _ := kernel.NewLocation(5, 7)
+
+	t.Run("should create ambient order", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+
+		assert.Equal(t, order.Ambient, o.TemperatureClass())
+	})
+
+	t.Run("should change class of order waiting for dispatch", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+
+		require.NoError(t, o.ChangeTemperatureClass(order.Frozen))
+
+		assert.Equal(t, order.Frozen, o.TemperatureClass())
+	})
+
+	t.Run("should reject unknown class", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+
+		require.ErrorIs(t, o.ChangeTemperatureClass(order.UnknownTemperatureClass), errs.ErrValueIsInvalid)
+		assert.Equal(t, order.Ambient, o.TemperatureClass())
+	})
+
+	t.Run("should keep class of assigned order", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+		require.NoError(t, o.Assign(kernel.NewUUID()))
+
+		require.ErrorIs(t, o.ChangeTemperatureClass(order.Chilled), order.ErrTemperatureClassIsFixed)
+		assert.Equal(t, order.Ambient, o.TemperatureClass())
+	})
+
+	t.Run("should restore class of assigned order", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+		require.NoError(t, o.Assign(kernel.NewUUID()))
+
+		require.NoError(t, o.RestoreTemperatureClass(order.Chilled))
+
+		assert.Equal(t, order.Chilled, o.TemperatureClass())
+	})
+}
@@ -0,0 +1,105 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+const (
+	// MaxTipAmount is the largest tip a customer can leave, in minor currency units.
+	MaxTipAmount = 100_000
+
+	// MaxIdempotencyKeyLength is the maximum number of characters of a tip idempotency key.
+	MaxIdempotencyKeyLength = 64
+)
+
+var (
+	// ErrTipIsNotConstructed indicates that a Tip was not properly initialized
+	// through the NewTip constructor.
+	ErrTipIsNotConstructed = errors.New("Tip must be created via NewTip constructor")
+
+	// ErrOrderIsNotDelivered indicates that a tip was left for an order that is not delivered yet.
+	ErrOrderIsNotDelivered = errors.New("order is not delivered")
+
+	// ErrOrderIsAlreadyTipped indicates that a second tip was left for an order.
+	ErrOrderIsAlreadyTipped = errors.New("order is already tipped")
+)
+
+// Tip is the gratuity a customer leaves for the courier of a delivered order.
+// The idempotency key is chosen by the customer's client, so a retried submission
+// is recognized as the same tip instead of tipping twice.
+//
+// Key business rules:
+//   - Must be constructed through NewTip
+//   - The amount is between 1 and MaxTipAmount minor currency units
+//   - The idempotency key is not blank and at most MaxIdempotencyKeyLength characters
+type Tip struct {
+	// amount is the tip in minor currency units
+	amount int
+
+	// idempotencyKey identifies the customer's submission
+	idempotencyKey string
+
+	// tippedAt is when the tip was left
+	tippedAt time.Time
+
+	// guard ensures the tip was created via NewTip
+	guard guard.ConstructorGuard
+}
+
+// NewTip creates a tip of the given amount in minor currency units.
+//
+// Example:
+//
+//	tip, err := order.NewTip(250, request.Header.Get("Idempotency-Key"), time.Now())
+func NewTip(amount int, idempotencyKey string, tippedAt time.Time) (Tip, error) {
+	if amount < 1 || amount > MaxTipAmount {
+		return Tip{}, errs.NewValueIsOutOfRangeError("tip amount", amount, 1, MaxTipAmount)
+	}
+	if strings.TrimSpace(idempotencyKey) == "" {
+		return Tip{}, errs.NewValueIsRequiredError("idempotency key")
+	}
+	if length := utf8.RuneCountInString(idempotencyKey); length > MaxIdempotencyKeyLength {
+		return Tip{}, errs.NewValueIsInvalidErrorWithCause(
+			"idempotency key is invalid",
+			fmt.Errorf("%d characters exceed the limit of %d", length, MaxIdempotencyKeyLength),
+		)
+	}
+	if tippedAt.IsZero() {
+		return Tip{}, errs.NewValueIsRequiredError("tip time")
+	}
+
+	return Tip{
+		amount:         amount,
+		idempotencyKey: idempotencyKey,
+		tippedAt:       tippedAt,
+		guard:          guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the tip was created through the constructor.
+// Returns ErrTipIsNotConstructed if validation fails.
+func (t Tip) Validate() error {
+	return t.guard.Validate(ErrTipIsNotConstructed)
+}
+
+// Amount returns the tip in minor currency units.
+func (t Tip) Amount() int {
+	return t.amount
+}
+
+// IdempotencyKey returns the key of the customer's submission.
+func (t Tip) IdempotencyKey() string {
+	return t.idempotencyKey
+}
+
+// TippedAt returns when the tip was left.
+func (t Tip) TippedAt() time.Time {
+	return t.tippedAt
+}
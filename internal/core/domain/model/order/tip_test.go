@@ -0,0 +1,120 @@
+package order_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTip(t *testing.T) {
+	tippedAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("should create valid tip", func(t *testing.T) {
+		tip, err := order.NewTip(250, "key-1", tippedAt)
+
+		require.NoError(t, err)
+		require.NoError(t, tip.Validate())
+		assert.Equal(t, 250, tip.Amount())
+		assert.Equal(t, "key-1", tip.IdempotencyKey())
+		assert.Equal(t, tippedAt, tip.TippedAt())
+	})
+
+	t.Run("should fail with amount out of range", func(t *testing.T) {
+		_, err := order.NewTip(0, "key-1", tippedAt)
+		require.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+
+		_, err = order.NewTip(order.MaxTipAmount+1, "key-1", tippedAt)
+		require.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+	})
+
+	t.Run("should fail with blank or too long idempotency key", func(t *testing.T) {
+		_, err := order.NewTip(250, " ", tippedAt)
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+
+		_, err = order.NewTip(250, strings.Repeat("k", order.MaxIdempotencyKeyLength+1), tippedAt)
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should fail without time", func(t *testing.T) {
+		_, err := order.NewTip(250, "key-1", time.Time{})
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, order.Tip{}.Validate(), order.ErrTipIsNotConstructed)
+	})
+}
+
+func TestOrder_AddTip(t *testing.T) {
+	location, _ := kernel.NewLocation(5, 7)
+	tippedAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	tip, _ := order.NewTip(250, "key-1", tippedAt)
+
+	delivered := func(t *testing.T) *order.Order {
+		t.Helper()
+		o, err := order.NewOrder(kernel.NewUUID(), location, 5)
+		require.NoError(t, err)
+		require.NoError(t, o.Assign(kernel.NewUUID()))
+		require.NoError(t, o.Complete())
+		return o
+	}
+
+	t.Run("should tip delivered order", func(t *testing.T) {
+		o := delivered(t)
+
+		added, err := o.AddTip(tip)
+
+		require.NoError(t, err)
+		assert.True(t, added)
+		require.NotNil(t, o.Tip())
+		assert.Equal(t, 250, o.Tip().Amount())
+	})
+
+	t.Run("should ignore retry with the same idempotency key", func(t *testing.T) {
+		o := delivered(t)
+		_, _ = o.AddTip(tip)
+		retry, _ := order.NewTip(500, "key-1", tippedAt.Add(time.Minute))
+
+		added, err := o.AddTip(retry)
+
+		require.NoError(t, err)
+		assert.False(t, added)
+		assert.Equal(t, 250, o.Tip().Amount())
+	})
+
+	t.Run("should refuse second tip", func(t *testing.T) {
+		o := delivered(t)
+		_, _ = o.AddTip(tip)
+		second, _ := order.NewTip(250, "key-2", tippedAt)
+
+		added, err := o.AddTip(second)
+
+		require.ErrorIs(t, err, order.ErrOrderIsAlreadyTipped)
+		assert.False(t, added)
+	})
+
+	t.Run("should refuse undelivered order", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 5)
+		_ = o.Assign(kernel.NewUUID())
+
+		_, err := o.AddTip(tip)
+
+		require.ErrorIs(t, err, order.ErrOrderIsNotDelivered)
+		assert.Nil(t, o.Tip())
+	})
+
+	t.Run("should fail with tip not created via constructor", func(t *testing.T) {
+		o := delivered(t)
+
+		_, err := o.AddTip(order.Tip{})
+
+		require.ErrorIs(t, err, order.ErrTipIsNotConstructed)
+	})
+}
@@ -0,0 +1,89 @@
+package order
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// trackingTokenBytes is the amount of random data encoded in a tracking token.
+const trackingTokenBytes = 16
+
+var (
+	// ErrTrackingTokenIsNotConstructed indicates that a TrackingToken was not properly
+	// initialized through the NewTrackingToken or TrackingTokenFromString constructors.
+	ErrTrackingTokenIsNotConstructed = errors.New("TrackingToken must be created via NewTrackingToken constructor")
+
+	// ErrTrackingIsClosed indicates that a tracking link can no longer be shared
+	// because the order has been completed.
+	ErrTrackingIsClosed = errors.New("order tracking is closed")
+)
+
+// TrackingToken is an unguessable secret that grants a customer read-only access
+// to the live tracking of a single order. Tokens are opaque lowercase hex strings.
+//
+// Key business rules:
+//   - Must be constructed through NewTrackingToken or TrackingTokenFromString
+//   - Carries 128 bits of randomness so it cannot be enumerated
+type TrackingToken struct {
+	// value is the hex-encoded token
+	value string
+
+	// guard ensures the token was created via a constructor
+	guard guard.ConstructorGuard
+}
+
+// NewTrackingToken generates a new random tracking token.
+//
+// Example:
+//
+//	token := order.NewTrackingToken()
+//	link := "/track/" + token.String()
+func NewTrackingToken() TrackingToken {
+	raw := make([]byte, trackingTokenBytes)
+	// crypto/rand.Read never returns an error on supported platforms.
+	_, _ = rand.Read(raw)
+
+	return TrackingToken{
+		value: hex.EncodeToString(raw),
+		guard: guard.NewConstructorGuard(),
+	}
+}
+
+// TrackingTokenFromString parses a tracking token received from a customer link
+// or restored from persistent storage.
+//
+// Returns:
+//   - TrackingToken: The parsed token
+//   - error: Validation error if the string is not a well-formed token
+func TrackingTokenFromString(s string) (TrackingToken, error) {
+	raw, err := hex.DecodeString(s)
+	if err != nil || len(raw) != trackingTokenBytes || hex.EncodeToString(raw) != s {
+		return TrackingToken{}, errs.NewValueIsInvalidErrorWithCause(
+			"tracking token is invalid",
+			fmt.Errorf("%q is not a %d-byte lowercase hex string", s, trackingTokenBytes),
+		)
+	}
+
+	return TrackingToken{value: s, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the TrackingToken was properly constructed.
+// Returns ErrTrackingTokenIsNotConstructed if the token was created directly.
+func (t TrackingToken) Validate() error {
+	return t.guard.Validate(ErrTrackingTokenIsNotConstructed)
+}
+
+// String returns the hex-encoded token.
+func (t TrackingToken) String() string {
+	return t.value
+}
+
+// IsEqual compares two tracking tokens by value.
+func (t TrackingToken) IsEqual(other TrackingToken) bool {
+	return t.value == other.value
+}
@@ -0,0 +1,107 @@
+package order_test
+
+import (
+	"strings"
+	"testing"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTrackingToken(t *testing.T) {
+	t.Run("should create unique valid tokens", func(t *testing.T) {
+		first := order.NewTrackingToken()
+		second := order.NewTrackingToken()
+
+		require.NoError(t, first.Validate())
+		assert.Len(t, first.String(), 32)
+		assert.False(t, first.IsEqual(second))
+	})
+
+	t.Run("should round-trip through string", func(t *testing.T) {
+		token := order.NewTrackingToken()
+
+		parsed, err := order.TrackingTokenFromString(token.String())
+
+		require.NoError(t, err)
+		require.NoError(t, parsed.Validate())
+		assert.True(t, parsed.IsEqual(token))
+	})
+}
+
+func TestTrackingTokenFromString_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"empty", ""},
+		{"not hex", strings.Repeat("z", 32)},
+		{"too short", "abcdef"},
+		{"uppercase", strings.Repeat("A", 32)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := order.TrackingTokenFromString(tt.value)
+
+			require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+		})
+	}
+}
+
+func TestTrackingToken_NotConstructedViaConstructor(t *testing.T) {
+	token := order.TrackingToken{}
+
+	require.ErrorIs(t, token.Validate(), order.ErrTrackingTokenIsNotConstructed)
+}
+
+func TestOrder_ShareTracking(t *testing.T) {
+	location, _ := kernel.NewLocation(5, 5)
+
+	t.Run("should issue token once", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 10)
+		require.Nil(t, o.TrackingToken())
+
+		first, err := o.ShareTracking()
+		require.NoError(t, err)
+		second, err := o.ShareTracking()
+		require.NoError(t, err)
+
+		assert.True(t, first.IsEqual(second))
+		require.NotNil(t, o.TrackingToken())
+		assert.True(t, o.TrackingToken().IsEqual(first))
+	})
+
+	t.Run("should fail for completed order", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 10)
+		require.NoError(t, o.Assign(kernel.NewUUID()))
+		require.NoError(t, o.Complete())
+
+		_, err := o.ShareTracking()
+
+		require.ErrorIs(t, err, order.ErrTrackingIsClosed)
+	})
+
+	t.Run("should restore token on completed order", func(t *testing.T) {
+		courierID := kernel.NewUUID()
+		o, err := order.RestoreOrder(kernel.NewUUID(), location, 10, order.Completed, &courierID)
+		require.NoError(t, err)
+		token := order.NewTrackingToken()
+
+		require.NoError(t, o.RestoreTrackingToken(token))
+
+		assert.True(t, o.TrackingToken().IsEqual(token))
+	})
+
+	t.Run("should reject unconstructed token on restore", func(t *testing.T) {
+		o, _ := order.NewOrder(kernel.NewUUID(), location, 10)
+
+		err := o.RestoreTrackingToken(order.TrackingToken{})
+
+		require.ErrorIs(t, err, order.ErrTrackingTokenIsNotConstructed)
+	})
+}
@@ -0,0 +1,15 @@
+// Package pickup provides the domain model of warehouse pickup slots.
+//
+// The package includes:
+//   - Slot: The aggregate root with the time window, the capacity and the booked orders
+//
+// Key business rules:
+//   - A slot is a non-empty time window with a capacity between 1 and MaxCapacity
+//   - Every booked order takes one place; a full slot takes no more orders
+//   - Orders can only be booked until the slot ends, and at most once per slot
+//   - The capacity can be changed but never below the number of bookings
+//   - Couriers wait for the slot to start before leaving the warehouse with the order
+//
+// The package follows Domain-Driven Design principles, providing rich domain
+// behavior, encapsulation, and validation to ensure business rules are enforced.
+package pickup
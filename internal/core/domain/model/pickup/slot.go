@@ -0,0 +1,272 @@
+package pickup
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// MaxCapacity is the maximum number of couriers one slot can take.
+const MaxCapacity = 1000
+
+var (
+	// ErrSlotIsNotConstructed indicates that a Slot was not properly initialized
+	// through the NewSlot or RestoreSlot constructor functions.
+	ErrSlotIsNotConstructed = errors.New("Slot must be created via NewSlot or RestoreSlot constructor")
+
+	// ErrSlotIsFull is returned when an order is booked into a slot without free capacity.
+	ErrSlotIsFull = errors.New("pickup slot is full")
+
+	// ErrSlotHasEnded is returned when an order is booked into a slot that is already over.
+	ErrSlotHasEnded = errors.New("pickup slot has ended")
+
+	// ErrOrderIsAlreadyBooked is returned when an order is booked into the same slot twice.
+	ErrOrderIsAlreadyBooked = errors.New("order is already booked into the pickup slot")
+
+	// ErrOrderIsNotBooked is returned when releasing an order that is not booked into the slot.
+	ErrOrderIsNotBooked = errors.New("order is not booked into the pickup slot")
+
+	// ErrCapacityIsBelowBookings is returned when the capacity of a slot is reduced
+	// below the number of orders already booked into it.
+	ErrCapacityIsBelowBookings = errors.New("pickup slot capacity is below its bookings")
+)
+
+// Slot is a time window in which couriers collect orders at the warehouse.
+// The capacity limits how many couriers the warehouse serves in the window,
+// so they do not crowd at the pickup counter.
+//
+// Key business rules:
+//   - Must be constructed through NewSlot or RestoreSlot constructors
+//   - The window must not be empty: startsAt is before endsAt
+//   - Capacity is between 1 and MaxCapacity
+//   - Every booked order takes one place; a slot never holds more bookings than its capacity
+//   - Orders can only be booked until the slot ends
+//   - Releasing a booking frees its place; the remaining bookings keep their order
+//   - Capacity can be changed but never below the number of bookings
+type Slot struct {
+	// id uniquely identifies the slot
+	id kernel.UUID
+
+	// startsAt is when the warehouse starts handing out orders of the slot
+	startsAt time.Time
+
+	// endsAt is when the slot is over
+	endsAt time.Time
+
+	// capacity is the number of orders the slot takes
+	capacity int
+
+	// bookings holds the booked orders in booking order
+	bookings []kernel.UUID
+
+	// guard ensures the entity was properly initialized
+	guard guard.ConstructorGuard
+}
+
+// NewSlot creates a pickup slot without bookings.
+//
+// Example:
+//
+//	slot, err := pickup.NewSlot(
+//	    kernel.NewUUID(),
+//	    time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC),
+//	    time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC),
+//	    5,
+//	)
+//	if err != nil {
+//	    return fmt.Errorf("invalid pickup slot: %w", err)
+//	}
+func NewSlot(id kernel.UUID, startsAt, endsAt time.Time, capacity int) (*Slot, error) {
+	slot := &Slot{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := errs.JoinFields(
+		errs.Field("id", slot.setID(id)),
+		errs.Field("startsAt", slot.setStartsAt(startsAt)),
+		errs.Field("endsAt", slot.setEndsAt(startsAt, endsAt)),
+		errs.Field("capacity", slot.setCapacity(capacity)),
+	); err != nil {
+		return nil, err
+	}
+
+	return slot, nil
+}
+
+// RestoreSlot recreates a slot from persistence with its bookings in booking order.
+// It enforces the same rules as NewSlot and never accepts more bookings than the capacity.
+func RestoreSlot(id kernel.UUID, startsAt, endsAt time.Time, capacity int, bookings []kernel.UUID) (*Slot, error) {
+	slot, err := NewSlot(id, startsAt, endsAt, capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, orderID := range bookings {
+		if err := slot.book(orderID); err != nil {
+			return nil, err
+		}
+	}
+
+	return slot, nil
+}
+
+// Validate ensures the Slot instance was properly constructed through NewSlot or RestoreSlot.
+func (s *Slot) Validate() error {
+	if s == nil {
+		return ErrSlotIsNotConstructed
+	}
+
+	return s.guard.Validate(ErrSlotIsNotConstructed)
+}
+
+// ID returns the slot's unique identifier.
+func (s *Slot) ID() kernel.UUID {
+	return s.id
+}
+
+// StartsAt returns when the warehouse starts handing out orders of the slot.
+func (s *Slot) StartsAt() time.Time {
+	return s.startsAt
+}
+
+// EndsAt returns when the slot is over.
+func (s *Slot) EndsAt() time.Time {
+	return s.endsAt
+}
+
+// Capacity returns the number of orders the slot takes.
+func (s *Slot) Capacity() int {
+	return s.capacity
+}
+
+// Bookings returns a copy of the booked orders in booking order.
+func (s *Slot) Bookings() []kernel.UUID {
+	bookings := make([]kernel.UUID, len(s.bookings))
+	copy(bookings, s.bookings)
+	return bookings
+}
+
+// Remaining returns the number of orders the slot still takes.
+func (s *Slot) Remaining() int {
+	return s.capacity - len(s.bookings)
+}
+
+// HasEnded reports whether the slot is over at now.
+func (s *Slot) HasEnded(now time.Time) bool {
+	return !now.Before(s.endsAt)
+}
+
+// IsBooked reports whether the order is booked into the slot.
+func (s *Slot) IsBooked(orderID kernel.UUID) bool {
+	for _, booked := range s.bookings {
+		if booked.IsEqual(orderID) {
+			return true
+		}
+	}
+	return false
+}
+
+// Book takes a place in the slot for the order.
+// Returns ErrSlotHasEnded, ErrSlotIsFull or ErrOrderIsAlreadyBooked.
+//
+// Example:
+//
+//	if err := slot.Book(order.ID(), time.Now()); errors.Is(err, pickup.ErrSlotIsFull) {
+//	    // try the next slot
+//	}
+func (s *Slot) Book(orderID kernel.UUID, now time.Time) error {
+	if s.HasEnded(now) {
+		return ErrSlotHasEnded
+	}
+	return s.book(orderID)
+}
+
+// Release frees the place of the order, for example when the order is cancelled.
+// Returns ErrOrderIsNotBooked if the order is not booked into the slot.
+func (s *Slot) Release(orderID kernel.UUID) error {
+	i := slices.IndexFunc(s.bookings, orderID.IsEqual)
+	if i < 0 {
+		return ErrOrderIsNotBooked
+	}
+
+	s.bookings = slices.Delete(s.bookings, i, i+1)
+	return nil
+}
+
+// ChangeCapacity sets the number of orders the slot takes.
+// Returns ErrCapacityIsBelowBookings if more orders are already booked.
+func (s *Slot) ChangeCapacity(capacity int) error {
+	if capacity < len(s.bookings) {
+		return fmt.Errorf("%w: %d orders are booked, capacity %d", ErrCapacityIsBelowBookings, len(s.bookings), capacity)
+	}
+	return s.setCapacity(capacity)
+}
+
+// WaitTurns returns how many courier turns of the given length remain at now until
+// the slot starts, rounded up; 0 once the slot has started.
+func (s *Slot) WaitTurns(now time.Time, turn time.Duration) int {
+	wait := s.startsAt.Sub(now)
+	if wait <= 0 || turn <= 0 {
+		return 0
+	}
+	return int(math.Ceil(float64(wait) / float64(turn)))
+}
+
+func (s *Slot) book(orderID kernel.UUID) error {
+	if err := orderID.Validate(); err != nil {
+		return err
+	}
+	if s.IsBooked(orderID) {
+		return ErrOrderIsAlreadyBooked
+	}
+	if s.Remaining() <= 0 {
+		return ErrSlotIsFull
+	}
+
+	s.bookings = append(s.bookings, orderID)
+	return nil
+}
+
+func (s *Slot) setID(id kernel.UUID) error {
+	if err := id.Validate(); err != nil {
+		return err
+	}
+	s.id = id
+	return nil
+}
+
+func (s *Slot) setStartsAt(startsAt time.Time) error {
+	if startsAt.IsZero() {
+		return errs.NewValueIsRequiredError("startsAt")
+	}
+	s.startsAt = startsAt
+	return nil
+}
+
+func (s *Slot) setEndsAt(startsAt, endsAt time.Time) error {
+	if endsAt.IsZero() {
+		return errs.NewValueIsRequiredError("endsAt")
+	}
+	if !startsAt.IsZero() && !startsAt.Before(endsAt) {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"endsAt is invalid",
+			fmt.Errorf("end %s must be after start %s", endsAt.Format(time.RFC3339), startsAt.Format(time.RFC3339)),
+		)
+	}
+	s.endsAt = endsAt
+	return nil
+}
+
+func (s *Slot) setCapacity(capacity int) error {
+	if capacity < 1 || capacity > MaxCapacity {
+		return errs.NewValueIsOutOfRangeError("capacity", capacity, 1, MaxCapacity)
+	}
+	s.capacity = capacity
+	return nil
+}
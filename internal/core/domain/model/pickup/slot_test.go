@@ -0,0 +1,172 @@
+package pickup_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/pickup"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	startsAt = time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	endsAt   = startsAt.Add(30 * time.Minute)
+)
+
+func newSlot(t *testing.T, capacity int) *pickup.Slot {
+	t.Helper()
+	slot, err := pickup.NewSlot(kernel.NewUUID(), startsAt, endsAt, capacity)
+	require.NoError(t, err)
+	return slot
+}
+
+func TestNewSlot(t *testing.T) {
+	t.Run("should create empty slot", func(t *testing.T) {
+		id := kernel.NewUUID()
+
+		slot, err := pickup.NewSlot(id, startsAt, endsAt, 3)
+
+		require.NoError(t, err)
+		require.NoError(t, slot.Validate())
+		assert.True(t, slot.ID().IsEqual(id))
+		assert.Equal(t, startsAt, slot.StartsAt())
+		assert.Equal(t, endsAt, slot.EndsAt())
+		assert.Equal(t, 3, slot.Capacity())
+		assert.Equal(t, 3, slot.Remaining())
+		assert.Empty(t, slot.Bookings())
+	})
+
+	t.Run("should report every invalid field", func(t *testing.T) {
+		slot, err := pickup.NewSlot(kernel.UUID{}, endsAt, startsAt, 0)
+
+		require.ErrorIs(t, err, errs.ErrValidationFailed)
+		assert.Nil(t, slot)
+		var validation *errs.ValidationErrors
+		require.ErrorAs(t, err, &validation)
+		fields := make([]string, 0, len(validation.Fields))
+		for _, field := range validation.Fields {
+			fields = append(fields, field.Field)
+		}
+		assert.Equal(t, []string{"id", "endsAt", "capacity"}, fields)
+	})
+
+	t.Run("should reject capacity above the maximum", func(t *testing.T) {
+		_, err := pickup.NewSlot(kernel.NewUUID(), startsAt, endsAt, pickup.MaxCapacity+1)
+
+		require.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+	})
+
+	t.Run("nil slot should be invalid", func(t *testing.T) {
+		var slot *pickup.Slot
+
+		require.ErrorIs(t, slot.Validate(), pickup.ErrSlotIsNotConstructed)
+	})
+}
+
+func TestRestoreSlot(t *testing.T) {
+	first, second := kernel.NewUUID(), kernel.NewUUID()
+
+	slot, err := pickup.RestoreSlot(kernel.NewUUID(), startsAt, endsAt, 2, []kernel.UUID{first, second})
+
+	require.NoError(t, err)
+	assert.Equal(t, []kernel.UUID{first, second}, slot.Bookings())
+	assert.Equal(t, 0, slot.Remaining())
+
+	_, err = pickup.RestoreSlot(kernel.NewUUID(), startsAt, endsAt, 1, []kernel.UUID{first, second})
+	require.ErrorIs(t, err, pickup.ErrSlotIsFull)
+}
+
+func TestSlot_Book(t *testing.T) {
+	before := startsAt.Add(-time.Hour)
+
+	t.Run("should book until the slot is full", func(t *testing.T) {
+		slot := newSlot(t, 1)
+		orderID := kernel.NewUUID()
+
+		require.NoError(t, slot.Book(orderID, before))
+
+		assert.True(t, slot.IsBooked(orderID))
+		assert.Equal(t, 0, slot.Remaining())
+		require.ErrorIs(t, slot.Book(kernel.NewUUID(), before), pickup.ErrSlotIsFull)
+	})
+
+	t.Run("should refuse booking an order twice", func(t *testing.T) {
+		slot := newSlot(t, 2)
+		orderID := kernel.NewUUID()
+		require.NoError(t, slot.Book(orderID, before))
+
+		require.ErrorIs(t, slot.Book(orderID, before), pickup.ErrOrderIsAlreadyBooked)
+		assert.Len(t, slot.Bookings(), 1)
+	})
+
+	t.Run("should accept bookings while the slot runs", func(t *testing.T) {
+		slot := newSlot(t, 2)
+
+		require.NoError(t, slot.Book(kernel.NewUUID(), startsAt.Add(10*time.Minute)))
+	})
+
+	t.Run("should refuse bookings once the slot ended", func(t *testing.T) {
+		slot := newSlot(t, 2)
+
+		require.ErrorIs(t, slot.Book(kernel.NewUUID(), endsAt), pickup.ErrSlotHasEnded)
+		assert.True(t, slot.HasEnded(endsAt))
+	})
+
+	t.Run("should reject an empty order id", func(t *testing.T) {
+		slot := newSlot(t, 2)
+
+		require.Error(t, slot.Book(kernel.UUID{}, before))
+	})
+}
+
+func TestSlot_Release(t *testing.T) {
+	t.Run("should free the place and keep the other bookings in order", func(t *testing.T) {
+		slot := newSlot(t, 3)
+		first, second, third := kernel.NewUUID(), kernel.NewUUID(), kernel.NewUUID()
+		for _, orderID := range []kernel.UUID{first, second, third} {
+			require.NoError(t, slot.Book(orderID, startsAt))
+		}
+
+		require.NoError(t, slot.Release(second))
+
+		assert.False(t, slot.IsBooked(second))
+		assert.Equal(t, []kernel.UUID{first, third}, slot.Bookings())
+		assert.Equal(t, 1, slot.Remaining())
+		require.NoError(t, slot.Book(second, startsAt))
+	})
+
+	t.Run("should refuse an order that is not booked", func(t *testing.T) {
+		slot := newSlot(t, 1)
+
+		require.ErrorIs(t, slot.Release(kernel.NewUUID()), pickup.ErrOrderIsNotBooked)
+	})
+}
+
+func TestSlot_ChangeCapacity(t *testing.T) {
+	slot := newSlot(t, 3)
+	require.NoError(t, slot.Book(kernel.NewUUID(), startsAt))
+	require.NoError(t, slot.Book(kernel.NewUUID(), startsAt))
+
+	require.ErrorIs(t, slot.ChangeCapacity(1), pickup.ErrCapacityIsBelowBookings)
+	require.ErrorIs(t, slot.ChangeCapacity(0), pickup.ErrCapacityIsBelowBookings)
+
+	require.NoError(t, slot.ChangeCapacity(2))
+	assert.Equal(t, 2, slot.Capacity())
+	assert.Equal(t, 0, slot.Remaining())
+
+	require.NoError(t, slot.ChangeCapacity(10))
+	assert.Equal(t, 8, slot.Remaining())
+}
+
+func TestSlot_WaitTurns(t *testing.T) {
+	slot := newSlot(t, 1)
+
+	assert.Equal(t, 90, slot.WaitTurns(startsAt.Add(-90*time.Second), time.Second))
+	assert.Equal(t, 2, slot.WaitTurns(startsAt.Add(-1500*time.Millisecond), time.Second))
+	assert.Equal(t, 0, slot.WaitTurns(startsAt, time.Second))
+	assert.Equal(t, 0, slot.WaitTurns(startsAt.Add(time.Minute), time.Second))
+}
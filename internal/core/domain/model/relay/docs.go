@@ -0,0 +1,15 @@
+// Package relay provides the domain model of relay delivery, in which couriers pass an order
+// on to each other on the way to the customer.
+//
+// The package includes:
+//   - Handover: The record of an order moving from one courier's storage place to another's
+//   - Holder: A storage place of a courier that holds the order
+//
+// Key business rules:
+//   - Handovers are immutable and only ever appended
+//   - An order is handed over between two different couriers, from a storage place to a storage place
+//   - The handover happens where both couriers are
+//
+// The package follows Domain-Driven Design principles, providing rich domain
+// behavior, encapsulation, and validation to ensure business rules are enforced.
+package relay
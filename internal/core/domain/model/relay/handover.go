@@ -0,0 +1,168 @@
+package relay
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	// ErrHandoverIsNotConstructed indicates that a Handover was not properly
+	// initialized through the NewHandover constructor function.
+	ErrHandoverIsNotConstructed = errors.New("Handover must be created via NewHandover constructor")
+
+	// ErrHandoverToSameCourier is returned when a courier would hand an order over to themselves.
+	ErrHandoverToSameCourier = errors.New("order cannot be handed over to the courier who carries it")
+)
+
+// Holder is a storage place of a courier that holds an order.
+type Holder struct {
+	courierID      kernel.UUID
+	storagePlaceID kernel.UUID
+}
+
+// NewHolder creates a holder from the IDs of the courier and their storage place.
+func NewHolder(courierID, storagePlaceID kernel.UUID) (Holder, error) {
+	if err := errs.JoinFields(
+		errs.Field("courierId", courierID.Validate()),
+		errs.Field("storagePlaceId", storagePlaceID.Validate()),
+	); err != nil {
+		return Holder{}, err
+	}
+
+	return Holder{courierID: courierID, storagePlaceID: storagePlaceID}, nil
+}
+
+// CourierID returns the courier holding the order.
+func (h Holder) CourierID() kernel.UUID {
+	return h.courierID
+}
+
+// StoragePlaceID returns the storage place of the courier the order is in.
+func (h Holder) StoragePlaceID() kernel.UUID {
+	return h.storagePlaceID
+}
+
+// validate reports a holder that was not created through NewHolder.
+func (h Holder) validate() error {
+	return errors.Join(h.courierID.Validate(), h.storagePlaceID.Validate())
+}
+
+// Handover records an order moving from one courier's storage place to another's on the way
+// to the customer. Handovers are immutable.
+//
+// Key business rules:
+//   - Must be constructed through NewHandover constructor
+//   - The order moves between two different couriers
+//   - Happens at a location of the grid where both couriers are
+type Handover struct {
+	// id uniquely identifies the handover
+	id kernel.UUID
+
+	// orderID is the order handed over
+	orderID kernel.UUID
+
+	// from is where the order was before the handover
+	from Holder
+
+	// to is where the order is after the handover
+	to Holder
+
+	// location is where the couriers met
+	location kernel.Location
+
+	// handedOverAt is when the order changed hands
+	handedOverAt time.Time
+
+	// guard ensures the handover was created via NewHandover
+	guard guard.ConstructorGuard
+}
+
+// NewHandover creates a handover record. Used by the relay service and by repositories
+// restoring stored handovers.
+//
+// Example:
+//
+//	from, _ := relay.NewHolder(giverID, bagID)
+//	to, _ := relay.NewHolder(receiverID, backpackID)
+//	handover, err := relay.NewHandover(kernel.NewUUID(), orderID, from, to, location, time.Now())
+//	if err != nil {
+//	    return fmt.Errorf("invalid handover: %w", err)
+//	}
+func NewHandover(
+	id kernel.UUID,
+	orderID kernel.UUID,
+	from Holder,
+	to Holder,
+	location kernel.Location,
+	handedOverAt time.Time,
+) (*Handover, error) {
+	var fields errs.ValidationErrors
+	fields.Add("id", id.Validate())
+	fields.Add("orderId", orderID.Validate())
+	fromErr := from.validate()
+	fields.Add("from", fromErr)
+	fields.Add("to", to.validate())
+	if fromErr == nil && from.courierID.IsEqual(to.courierID) {
+		fields.Add("to", ErrHandoverToSameCourier)
+	}
+	fields.Add("location", location.Validate())
+	if handedOverAt.IsZero() {
+		fields.Add("handedOverAt", errs.NewValueIsRequiredError("handedOverAt"))
+	}
+	if err := fields.Err(); err != nil {
+		return nil, err
+	}
+
+	return &Handover{
+		id:           id,
+		orderID:      orderID,
+		from:         from,
+		to:           to,
+		location:     location,
+		handedOverAt: handedOverAt,
+		guard:        guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the Handover instance was properly constructed through NewHandover.
+func (h *Handover) Validate() error {
+	if h == nil {
+		return ErrHandoverIsNotConstructed
+	}
+
+	return h.guard.Validate(ErrHandoverIsNotConstructed)
+}
+
+// ID returns the handover's unique identifier.
+func (h *Handover) ID() kernel.UUID {
+	return h.id
+}
+
+// OrderID returns the order handed over.
+func (h *Handover) OrderID() kernel.UUID {
+	return h.orderID
+}
+
+// From returns the courier and storage place that held the order before the handover.
+func (h *Handover) From() Holder {
+	return h.from
+}
+
+// To returns the courier and storage place that hold the order after the handover.
+func (h *Handover) To() Holder {
+	return h.to
+}
+
+// Location returns where the couriers met.
+func (h *Handover) Location() kernel.Location {
+	return h.location
+}
+
+// HandedOverAt returns when the order changed hands.
+func (h *Handover) HandedOverAt() time.Time {
+	return h.handedOverAt
+}
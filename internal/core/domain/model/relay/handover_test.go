@@ -0,0 +1,87 @@
+package relay_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/relay"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var handedOverAt = time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+func newHolder(t *testing.T, courierID kernel.UUID) relay.Holder {
+	t.Helper()
+	holder, err := relay.NewHolder(courierID, kernel.NewUUID())
+	require.NoError(t, err)
+	return holder
+}
+
+func TestNewHolder(t *testing.T) {
+	courierID, storagePlaceID := kernel.NewUUID(), kernel.NewUUID()
+
+	holder, err := relay.NewHolder(courierID, storagePlaceID)
+
+	require.NoError(t, err)
+	assert.True(t, holder.CourierID().IsEqual(courierID))
+	assert.True(t, holder.StoragePlaceID().IsEqual(storagePlaceID))
+
+	_, err = relay.NewHolder(kernel.UUID{}, kernel.UUID{})
+	require.ErrorIs(t, err, errs.ErrValidationFailed)
+}
+
+func TestNewHandover(t *testing.T) {
+	location, err := kernel.NewLocation(3, 4)
+	require.NoError(t, err)
+
+	t.Run("should create handover", func(t *testing.T) {
+		id, orderID := kernel.NewUUID(), kernel.NewUUID()
+		from, to := newHolder(t, kernel.NewUUID()), newHolder(t, kernel.NewUUID())
+
+		handover, err := relay.NewHandover(id, orderID, from, to, location, handedOverAt)
+
+		require.NoError(t, err)
+		require.NoError(t, handover.Validate())
+		assert.True(t, handover.ID().IsEqual(id))
+		assert.True(t, handover.OrderID().IsEqual(orderID))
+		assert.Equal(t, from, handover.From())
+		assert.Equal(t, to, handover.To())
+		assert.Equal(t, location, handover.Location())
+		assert.Equal(t, handedOverAt, handover.HandedOverAt())
+	})
+
+	t.Run("should refuse handover to the same courier", func(t *testing.T) {
+		courierID := kernel.NewUUID()
+
+		handover, err := relay.NewHandover(kernel.NewUUID(), kernel.NewUUID(),
+			newHolder(t, courierID), newHolder(t, courierID), location, handedOverAt)
+
+		require.ErrorIs(t, err, relay.ErrHandoverToSameCourier)
+		assert.Nil(t, handover)
+	})
+
+	t.Run("should report every invalid field", func(t *testing.T) {
+		handover, err := relay.NewHandover(
+			kernel.UUID{}, kernel.UUID{}, relay.Holder{}, relay.Holder{}, kernel.Location{}, time.Time{})
+
+		require.ErrorIs(t, err, errs.ErrValidationFailed)
+		assert.Nil(t, handover)
+		var validation *errs.ValidationErrors
+		require.ErrorAs(t, err, &validation)
+		fields := make([]string, 0, len(validation.Fields))
+		for _, field := range validation.Fields {
+			fields = append(fields, field.Field)
+		}
+		assert.Equal(t, []string{"id", "orderId", "from", "to", "location", "handedOverAt"}, fields)
+	})
+
+	t.Run("nil handover should be invalid", func(t *testing.T) {
+		var handover *relay.Handover
+
+		require.ErrorIs(t, handover.Validate(), relay.ErrHandoverIsNotConstructed)
+	})
+}
@@ -0,0 +1,230 @@
+package sla
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// ErrComplianceIsNotConstructed indicates that a Compliance was not created through Measure
+// or RestoreCompliance.
+var ErrComplianceIsNotConstructed = errors.New("Compliance must be created via Measure or RestoreCompliance")
+
+// Delivery is a completed order as far as service levels are concerned: where it was delivered,
+// with which tier, and when it was created and completed.
+type Delivery struct {
+	Location    kernel.Location
+	Tier        order.DeliveryTier
+	CreatedAt   time.Time
+	CompletedAt time.Time
+}
+
+// Duration returns how long the delivery took from creation to completion.
+func (d Delivery) Duration() time.Duration {
+	return d.CompletedAt.Sub(d.CreatedAt)
+}
+
+// Day returns the start of the UTC day the moment falls on. Compliance is measured per UTC day.
+func Day(moment time.Time) time.Time {
+	return moment.UTC().Truncate(24 * time.Hour)
+}
+
+// Compliance is how the deliveries completed on one day met the target of a district.
+//
+// Key business rules:
+//   - Keeps the delivery time and goal of the target it was measured against
+//   - The day starts at midnight UTC
+//   - At most every delivery is on time
+type Compliance struct {
+	district     string
+	tier         order.DeliveryTier
+	deliveryTime time.Duration
+	goal         int
+	day          time.Time
+	delivered    int
+	onTime       int
+
+	guard guard.ConstructorGuard
+}
+
+// Measure computes the compliance of every target on the day from the deliveries completed on it.
+// Deliveries completed on other days are ignored, and targets without deliveries on the day are
+// reported with none.
+//
+// Example:
+//
+//	day := sla.Day(time.Now().AddDate(0, 0, -1))
+//	compliance, err := sla.Measure(targets, day, deliveries)
+//	for _, c := range compliance {
+//	    fmt.Printf("%s: %.1f%% on time\n", c.District(), c.Percentage())
+//	}
+func Measure(targets []*Target, day time.Time, deliveries []Delivery) ([]Compliance, error) {
+	if day.IsZero() || !Day(day).Equal(day) {
+		return nil, errs.NewValueIsInvalidErrorWithCause(
+			"day is invalid",
+			fmt.Errorf("%s is not the start of a UTC day", day),
+		)
+	}
+
+	compliance := make([]Compliance, 0, len(targets))
+	for _, target := range targets {
+		if err := target.Validate(); err != nil {
+			return nil, err
+		}
+
+		measured := Compliance{
+			district:     target.district,
+			tier:         target.tier,
+			deliveryTime: target.deliveryTime,
+			goal:         target.goal,
+			day:          day.UTC(),
+			guard:        guard.NewConstructorGuard(),
+		}
+		for _, delivery := range deliveries {
+			if !Day(delivery.CompletedAt).Equal(measured.day) {
+				continue
+			}
+
+			covers, err := target.Covers(delivery)
+			if err != nil {
+				return nil, err
+			}
+			if !covers {
+				continue
+			}
+
+			measured.delivered++
+			if target.IsOnTime(delivery) {
+				measured.onTime++
+			}
+		}
+		compliance = append(compliance, measured)
+	}
+
+	return compliance, nil
+}
+
+// RestoreCompliance recreates the compliance of a day from persistence with validation.
+// Used by repositories.
+func RestoreCompliance(
+	district string,
+	tier order.DeliveryTier,
+	deliveryTime time.Duration,
+	goal int,
+	day time.Time,
+	delivered int,
+	onTime int,
+) (Compliance, error) {
+	// The target rules apply to the values the compliance was measured against
+	target := &Target{}
+	var validation errs.ValidationErrors
+	if err := target.setDistrict(district); err != nil {
+		validation.Add("district", err)
+	}
+	if err := target.setTier(tier); err != nil {
+		validation.Add("deliveryTier", err)
+	}
+	if err := target.setDeliveryTime(deliveryTime); err != nil {
+		validation.Add("deliveryTime", err)
+	}
+	if err := target.setGoal(goal); err != nil {
+		validation.Add("goal", err)
+	}
+	if day.IsZero() || !Day(day).Equal(day) {
+		validation.Add("day", errs.NewValueIsInvalidErrorWithCause(
+			"day is invalid",
+			fmt.Errorf("%s is not the start of a UTC day", day),
+		))
+	}
+	if delivered < 0 {
+		validation.Add("delivered", errs.NewValueIsInvalidErrorWithCause(
+			"delivered is invalid",
+			fmt.Errorf("%d is negative", delivered),
+		))
+	}
+	if onTime < 0 || onTime > delivered {
+		validation.Add("onTime", errs.NewValueIsOutOfRangeError("onTime", onTime, 0, delivered))
+	}
+	if err := validation.Err(); err != nil {
+		return Compliance{}, err
+	}
+
+	return Compliance{
+		district:     target.district,
+		tier:         tier,
+		deliveryTime: deliveryTime,
+		goal:         goal,
+		day:          day.UTC(),
+		delivered:    delivered,
+		onTime:       onTime,
+		guard:        guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the Compliance was created through Measure or RestoreCompliance.
+func (c Compliance) Validate() error {
+	return c.guard.Validate(ErrComplianceIsNotConstructed)
+}
+
+// District returns the name of the district the compliance was measured for.
+func (c Compliance) District() string {
+	return c.district
+}
+
+// Tier returns the delivery tier the compliance was measured for.
+func (c Compliance) Tier() order.DeliveryTier {
+	return c.tier
+}
+
+// DeliveryTime returns the delivery time of the target on the day.
+func (c Compliance) DeliveryTime() time.Duration {
+	return c.deliveryTime
+}
+
+// Goal returns the goal of the target on the day.
+func (c Compliance) Goal() int {
+	return c.goal
+}
+
+// Day returns the start of the UTC day the compliance was measured on.
+func (c Compliance) Day() time.Time {
+	return c.day
+}
+
+// Delivered returns the number of deliveries that counted towards the target.
+func (c Compliance) Delivered() int {
+	return c.delivered
+}
+
+// OnTime returns the number of deliveries completed within the delivery time.
+func (c Compliance) OnTime() int {
+	return c.onTime
+}
+
+// Percentage returns the share of on-time deliveries in percent, 100 for a day without deliveries.
+func (c Compliance) Percentage() float64 {
+	return Percentage(c.delivered, c.onTime)
+}
+
+// IsMet reports whether the share of on-time deliveries reached the goal.
+func (c Compliance) IsMet() bool {
+	return IsMet(c.goal, c.delivered, c.onTime)
+}
+
+// Percentage returns the share of on-time deliveries in percent, 100 when there were none.
+func Percentage(delivered int, onTime int) float64 {
+	if delivered == 0 {
+		return 100
+	}
+	return float64(onTime) * 100 / float64(delivered)
+}
+
+// IsMet reports whether the share of on-time deliveries reaches the goal, for a day or a whole period.
+func IsMet(goal int, delivered int, onTime int) bool {
+	return onTime*100 >= goal*delivered
+}
@@ -0,0 +1,108 @@
+package sla_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/sla"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func delivery(x, y kernel.Coordinate, tier order.DeliveryTier, completedAt time.Time, took time.Duration) sla.Delivery {
+	location, _ := kernel.NewLocation(x, y)
+	return sla.Delivery{Location: location, Tier: tier, CreatedAt: completedAt.Add(-took), CompletedAt: completedAt}
+}
+
+func TestMeasure(t *testing.T) {
+	day := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	noon := day.Add(12 * time.Hour)
+	express := target(t, "North", order.ExpressDelivery, 45*time.Minute, 75)
+	economy := target(t, "North", order.EconomyDelivery, 3*time.Hour, 90)
+
+	t.Run("should count on-time deliveries per target", func(t *testing.T) {
+		compliance, err := sla.Measure([]*sla.Target{express, economy}, day, []sla.Delivery{
+			delivery(2, 2, order.ExpressDelivery, noon, 30*time.Minute),
+			delivery(3, 3, order.ExpressDelivery, noon, 45*time.Minute),
+			delivery(4, 4, order.ExpressDelivery, noon, 46*time.Minute),
+			delivery(1, 1, order.ExpressDelivery, noon, 20*time.Minute),
+			delivery(2, 2, order.EconomyDelivery, noon, 2*time.Hour),
+			delivery(9, 9, order.ExpressDelivery, noon, 2*time.Hour),
+			delivery(2, 2, order.ExpressDelivery, day.Add(-time.Minute), 2*time.Hour),
+			delivery(2, 2, order.ExpressDelivery, day.Add(24*time.Hour), 2*time.Hour),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, compliance, 2)
+
+		require.NoError(t, compliance[0].Validate())
+		assert.Equal(t, "North", compliance[0].District())
+		assert.Equal(t, order.ExpressDelivery, compliance[0].Tier())
+		assert.Equal(t, day, compliance[0].Day())
+		assert.Equal(t, 4, compliance[0].Delivered())
+		assert.Equal(t, 3, compliance[0].OnTime())
+		assert.InDelta(t, 75.0, compliance[0].Percentage(), 0.001)
+		assert.True(t, compliance[0].IsMet())
+
+		assert.Equal(t, order.EconomyDelivery, compliance[1].Tier())
+		assert.Equal(t, 1, compliance[1].Delivered())
+		assert.Equal(t, 1, compliance[1].OnTime())
+	})
+
+	t.Run("should miss goal below it", func(t *testing.T) {
+		compliance, err := sla.Measure([]*sla.Target{express}, day, []sla.Delivery{
+			delivery(2, 2, order.ExpressDelivery, noon, 30*time.Minute),
+			delivery(3, 3, order.ExpressDelivery, noon, time.Hour),
+		})
+
+		require.NoError(t, err)
+		assert.InDelta(t, 50.0, compliance[0].Percentage(), 0.001)
+		assert.False(t, compliance[0].IsMet())
+	})
+
+	t.Run("should meet target without deliveries", func(t *testing.T) {
+		compliance, err := sla.Measure([]*sla.Target{express}, day, nil)
+
+		require.NoError(t, err)
+		require.Len(t, compliance, 1)
+		assert.Zero(t, compliance[0].Delivered())
+		assert.InDelta(t, 100.0, compliance[0].Percentage(), 0.001)
+		assert.True(t, compliance[0].IsMet())
+	})
+
+	t.Run("should refuse day not starting at midnight UTC", func(t *testing.T) {
+		_, err := sla.Measure([]*sla.Target{express}, noon, nil)
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+}
+
+func TestRestoreCompliance(t *testing.T) {
+	day := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should restore compliance", func(t *testing.T) {
+		c, err := sla.RestoreCompliance("North", order.ExpressDelivery, 45*time.Minute, 95, day, 20, 19)
+
+		require.NoError(t, err)
+		require.NoError(t, c.Validate())
+		assert.Equal(t, 45*time.Minute, c.DeliveryTime())
+		assert.Equal(t, 95, c.Goal())
+		assert.InDelta(t, 95.0, c.Percentage(), 0.001)
+		assert.True(t, c.IsMet())
+	})
+
+	t.Run("should refuse more on-time deliveries than deliveries", func(t *testing.T) {
+		_, err := sla.RestoreCompliance("North", order.ExpressDelivery, 45*time.Minute, 95, day, 2, 3)
+
+		require.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		var c sla.Compliance
+		require.ErrorIs(t, c.Validate(), sla.ErrComplianceIsNotConstructed)
+	})
+}
@@ -0,0 +1,20 @@
+// Package sla provides the domain model of delivery-time service level agreements: the share of
+// orders a tenant has contracted to deliver within a given time to each district of the city.
+//
+// The package includes:
+//   - Target: The delivery time and goal agreed for one delivery tier in one district
+//   - Delivery: A completed order with the moments it was created and completed
+//   - Compliance: How the deliveries completed on one day met a target
+//
+// Key business rules:
+//   - A district has at most one target per delivery tier; districts of different names may overlap
+//   - A delivery counts towards every target whose district contains its location and whose tier it was ordered with
+//   - A delivery is on time when it was completed within the target's delivery time of its creation
+//   - A day meets the target when the share of on-time deliveries reaches the goal; days without
+//     deliveries meet every target
+//   - Compliance keeps the delivery time and goal it was measured against, so changing a target
+//     does not rewrite the history of the district
+//
+// The package follows Domain-Driven Design principles, providing rich domain
+// behavior, encapsulation, and validation to ensure business rules are enforced.
+package sla
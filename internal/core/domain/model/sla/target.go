@@ -0,0 +1,236 @@
+package sla
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+const (
+	// MaxDistrictLength is the maximum number of characters in the name of a district.
+	MaxDistrictLength = 100
+
+	// MaxDeliveryTime is the longest delivery time a target may promise.
+	MaxDeliveryTime = 24 * time.Hour
+)
+
+var (
+	// ErrTargetIsNotConstructed indicates that a Target was not properly
+	// initialized through the NewTarget constructor function.
+	ErrTargetIsNotConstructed = errors.New("Target must be created via NewTarget constructor")
+
+	// ErrTargetIsDuplicated is returned when a district has more than one target for a delivery tier.
+	ErrTargetIsDuplicated = errors.New("district already has a target for the delivery tier")
+)
+
+// Target is the delivery time agreed for the orders of one delivery tier delivered to a district,
+// and the share of them that must arrive within it.
+//
+// Key business rules:
+//   - Must be constructed through NewTarget
+//   - The district is named, with a name of at most MaxDistrictLength characters
+//   - The delivery time is a whole number of minutes, at most MaxDeliveryTime
+//   - The goal is a percentage between 1 and 100
+type Target struct {
+	// id uniquely identifies the target
+	id kernel.UUID
+
+	// district is the name operations know the district by
+	district string
+
+	// zone is the part of the grid the district covers
+	zone kernel.Zone
+
+	// tier is the delivery tier the target applies to
+	tier order.DeliveryTier
+
+	// deliveryTime is the longest time an on-time delivery takes from creation to completion
+	deliveryTime time.Duration
+
+	// goal is the percentage of deliveries that must be on time
+	goal int
+
+	// guard ensures the entity was properly initialized
+	guard guard.ConstructorGuard
+}
+
+// NewTarget creates a target. Used by the admin API and by repositories restoring stored targets.
+//
+// Example:
+//
+//	from, _ := kernel.NewLocation(1, 1)
+//	to, _ := kernel.NewLocation(4, 5)
+//	zone, _ := kernel.NewZone(from, to)
+//	target, err := sla.NewTarget(kernel.NewUUID(), "Центральный", zone, order.ExpressDelivery, 45*time.Minute, 95)
+func NewTarget(
+	id kernel.UUID,
+	district string,
+	zone kernel.Zone,
+	tier order.DeliveryTier,
+	deliveryTime time.Duration,
+	goal int,
+) (*Target, error) {
+	target := &Target{
+		guard: guard.NewConstructorGuard(),
+	}
+
+	if err := errs.JoinFields(
+		errs.Field("id", target.setID(id)),
+		errs.Field("district", target.setDistrict(district)),
+		errs.Field("zone", target.setZone(zone)),
+		errs.Field("deliveryTier", target.setTier(tier)),
+		errs.Field("deliveryTime", target.setDeliveryTime(deliveryTime)),
+		errs.Field("goal", target.setGoal(goal)),
+	); err != nil {
+		return nil, err
+	}
+
+	return target, nil
+}
+
+// Validate ensures the Target instance was properly constructed through NewTarget.
+func (t *Target) Validate() error {
+	if t == nil {
+		return ErrTargetIsNotConstructed
+	}
+
+	return t.guard.Validate(ErrTargetIsNotConstructed)
+}
+
+// ID returns the target's unique identifier.
+func (t *Target) ID() kernel.UUID {
+	return t.id
+}
+
+// District returns the name of the district.
+func (t *Target) District() string {
+	return t.district
+}
+
+// Zone returns the part of the grid the district covers.
+func (t *Target) Zone() kernel.Zone {
+	return t.zone
+}
+
+// Tier returns the delivery tier the target applies to.
+func (t *Target) Tier() order.DeliveryTier {
+	return t.tier
+}
+
+// DeliveryTime returns the longest time an on-time delivery takes.
+func (t *Target) DeliveryTime() time.Duration {
+	return t.deliveryTime
+}
+
+// Goal returns the percentage of deliveries that must be on time.
+func (t *Target) Goal() int {
+	return t.goal
+}
+
+// Covers reports whether the delivery counts towards the target: it was ordered with the target's
+// tier and delivered within the district, borders included.
+func (t *Target) Covers(delivery Delivery) (bool, error) {
+	if delivery.Tier != t.tier {
+		return false, nil
+	}
+	return t.zone.Contains(delivery.Location)
+}
+
+// IsOnTime reports whether the delivery was completed within the target's delivery time.
+func (t *Target) IsOnTime(delivery Delivery) bool {
+	return delivery.Duration() <= t.deliveryTime
+}
+
+// CheckTargets ensures no district has more than one target for a delivery tier.
+// Returns ErrTargetIsDuplicated naming the first duplicate.
+func CheckTargets(targets []*Target) error {
+	type key struct {
+		district string
+		tier     order.DeliveryTier
+	}
+
+	seen := make(map[key]bool, len(targets))
+	for _, target := range targets {
+		if err := target.Validate(); err != nil {
+			return err
+		}
+
+		k := key{district: strings.ToLower(target.district), tier: target.tier}
+		if seen[k] {
+			return fmt.Errorf("%w: %s, %s", ErrTargetIsDuplicated, target.district, target.tier)
+		}
+		seen[k] = true
+	}
+	return nil
+}
+
+func (t *Target) setID(id kernel.UUID) error {
+	if err := id.Validate(); err != nil {
+		return err
+	}
+
+	t.id = id
+	return nil
+}
+
+func (t *Target) setDistrict(district string) error {
+	district = strings.TrimSpace(district)
+	if district == "" {
+		return errs.NewValueIsRequiredError("district")
+	}
+	if utf8.RuneCountInString(district) > MaxDistrictLength {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"district is invalid",
+			fmt.Errorf("must be at most %d characters", MaxDistrictLength),
+		)
+	}
+
+	t.district = district
+	return nil
+}
+
+func (t *Target) setZone(zone kernel.Zone) error {
+	if err := zone.Validate(); err != nil {
+		return err
+	}
+
+	t.zone = zone
+	return nil
+}
+
+func (t *Target) setTier(tier order.DeliveryTier) error {
+	if err := tier.Validate(); err != nil {
+		return err
+	}
+
+	t.tier = tier
+	return nil
+}
+
+func (t *Target) setDeliveryTime(deliveryTime time.Duration) error {
+	if deliveryTime <= 0 || deliveryTime > MaxDeliveryTime || deliveryTime%time.Minute != 0 {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"delivery time is invalid",
+			fmt.Errorf("%s must be a whole number of minutes up to %s", deliveryTime, MaxDeliveryTime),
+		)
+	}
+
+	t.deliveryTime = deliveryTime
+	return nil
+}
+
+func (t *Target) setGoal(goal int) error {
+	if goal < 1 || goal > 100 {
+		return errs.NewValueIsOutOfRangeError("goal", goal, 1, 100)
+	}
+
+	t.goal = goal
+	return nil
+}
@@ -0,0 +1,118 @@
+package sla_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/sla"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func zone(fromX, fromY, toX, toY kernel.Coordinate) kernel.Zone {
+	from, _ := kernel.NewLocation(fromX, fromY)
+	to, _ := kernel.NewLocation(toX, toY)
+	z, _ := kernel.NewZone(from, to)
+	return z
+}
+
+func target(t *testing.T, district string, tier order.DeliveryTier, deliveryTime time.Duration, goal int) *sla.Target {
+	t.Helper()
+	target, err := sla.NewTarget(kernel.NewUUID(), district, zone(1, 1, 4, 4), tier, deliveryTime, goal)
+	require.NoError(t, err)
+	return target
+}
+
+func TestNewTarget(t *testing.T) {
+	t.Run("should create target", func(t *testing.T) {
+		id := kernel.NewUUID()
+		target, err := sla.NewTarget(id, " Центральный ", zone(1, 1, 4, 5), order.ExpressDelivery, 45*time.Minute, 95)
+
+		require.NoError(t, err)
+		require.NoError(t, target.Validate())
+		assert.Equal(t, id, target.ID())
+		assert.Equal(t, "Центральный", target.District())
+		assert.Equal(t, "Zone(1,1-4,5)", target.Zone().String())
+		assert.Equal(t, order.ExpressDelivery, target.Tier())
+		assert.Equal(t, 45*time.Minute, target.DeliveryTime())
+		assert.Equal(t, 95, target.Goal())
+	})
+
+	tests := []struct {
+		name         string
+		district     string
+		tier         order.DeliveryTier
+		deliveryTime time.Duration
+		goal         int
+		err          error
+	}{
+		{"blank district", " ", order.ExpressDelivery, time.Hour, 90, errs.ErrValueIsRequired},
+		{"unknown tier", "North", order.UnknownDeliveryTier, time.Hour, 90, errs.ErrValueIsInvalid},
+		{"no delivery time", "North", order.ExpressDelivery, 0, 90, errs.ErrValueIsInvalid},
+		{"delivery time in seconds", "North", order.ExpressDelivery, 90 * time.Second, 90, errs.ErrValueIsInvalid},
+		{"delivery time over a day", "North", order.ExpressDelivery, 25 * time.Hour, 90, errs.ErrValueIsInvalid},
+		{"zero goal", "North", order.ExpressDelivery, time.Hour, 0, errs.ErrValueIsOutOfRange},
+		{"goal over 100", "North", order.ExpressDelivery, time.Hour, 101, errs.ErrValueIsOutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run("should refuse "+tt.name, func(t *testing.T) {
+			_, err := sla.NewTarget(kernel.NewUUID(), tt.district, zone(1, 1, 4, 4), tt.tier, tt.deliveryTime, tt.goal)
+
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		var target *sla.Target
+		require.ErrorIs(t, target.Validate(), sla.ErrTargetIsNotConstructed)
+	})
+}
+
+func TestTarget_Covers(t *testing.T) {
+	express := target(t, "North", order.ExpressDelivery, time.Hour, 90)
+	inside, _ := kernel.NewLocation(4, 4)
+	outside, _ := kernel.NewLocation(5, 4)
+
+	tests := []struct {
+		name     string
+		delivery sla.Delivery
+		covers   bool
+	}{
+		{"delivery on the district border", sla.Delivery{Location: inside, Tier: order.ExpressDelivery}, true},
+		{"delivery outside the district", sla.Delivery{Location: outside, Tier: order.ExpressDelivery}, false},
+		{"delivery of another tier", sla.Delivery{Location: inside, Tier: order.EconomyDelivery}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			covers, err := express.Covers(tt.delivery)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.covers, covers)
+		})
+	}
+}
+
+func TestCheckTargets(t *testing.T) {
+	t.Run("should accept one target per district and tier", func(t *testing.T) {
+		err := sla.CheckTargets([]*sla.Target{
+			target(t, "North", order.ExpressDelivery, time.Hour, 90),
+			target(t, "North", order.EconomyDelivery, 3*time.Hour, 80),
+			target(t, "South", order.ExpressDelivery, time.Hour, 90),
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should refuse second target of district and tier", func(t *testing.T) {
+		err := sla.CheckTargets([]*sla.Target{
+			target(t, "North", order.ExpressDelivery, time.Hour, 90),
+			target(t, "north", order.ExpressDelivery, 2*time.Hour, 95),
+		})
+
+		require.ErrorIs(t, err, sla.ErrTargetIsDuplicated)
+	})
+}
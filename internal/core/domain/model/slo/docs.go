@@ -0,0 +1,22 @@
+// Package slo provides the domain model of the service level objectives operations hold the
+// dispatcher to: how quickly waiting orders get a courier and how quickly assigned orders are delivered.
+//
+// The package includes:
+//   - Stage: The part of an order's life an objective measures
+//   - Objective: The latency threshold, goal and alerting burn rate of one stage
+//   - Measurement: How the orders that went through a stage in a rolling window met its objective
+//
+// Key business rules:
+//   - The assignment stage runs from the creation of an order to its first assignment
+//   - The delivery stage runs from the latest assignment of an order to its completion, so an order
+//     handed over to another courier is measured from the handover
+//   - An order is within the objective when the stage took at most the threshold
+//   - The burn rate is the share of orders outside the threshold divided by the error budget, the share
+//     the goal allows; a burn rate of 1 spends the budget exactly as fast as the goal permits
+//   - A stage burns its budget once the burn rate reaches the objective's alert burn rate;
+//     windows without orders never burn
+//   - Percentiles are nearest-rank percentiles of the measured latencies
+//
+// The package follows Domain-Driven Design principles, providing rich domain
+// behavior, encapsulation, and validation to ensure business rules are enforced.
+package slo
@@ -0,0 +1,139 @@
+package slo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// ErrMeasurementIsNotConstructed indicates that a Measurement was not created through Measure.
+var ErrMeasurementIsNotConstructed = errors.New("Measurement must be created via Measure")
+
+// Percentiles are the nearest-rank percentiles of the latencies of a stage.
+// All of them are zero when no order went through the stage.
+type Percentiles struct {
+	P50 time.Duration
+	P90 time.Duration
+	P95 time.Duration
+	P99 time.Duration
+}
+
+// Measurement is how the orders that went through a stage in a window met its objective.
+//
+// Key business rules:
+//   - Keeps the objective it was measured against
+//   - At most every order is within the threshold
+//   - A window without orders meets the objective and does not burn the error budget
+type Measurement struct {
+	objective   Objective
+	orders      int
+	within      int
+	percentiles Percentiles
+
+	guard guard.ConstructorGuard
+}
+
+// Measure computes how the latencies of the orders that went through the objective's stage met it.
+// Returns an error if the objective was not constructed or a latency is negative.
+//
+// Example:
+//
+//	measurement, err := slo.Measure(objective, latencies)
+//	if measurement.IsBurning() {
+//	    fmt.Printf("%s burns its budget %.1fx\n", measurement.Objective().Stage(), measurement.BurnRate())
+//	}
+func Measure(objective Objective, latencies []time.Duration) (Measurement, error) {
+	if err := objective.Validate(); err != nil {
+		return Measurement{}, err
+	}
+
+	sorted := slices.Clone(latencies)
+	slices.Sort(sorted)
+	if len(sorted) > 0 && sorted[0] < 0 {
+		return Measurement{}, errs.NewValueIsInvalidErrorWithCause(
+			"latency is invalid",
+			fmt.Errorf("%s is negative", sorted[0]),
+		)
+	}
+
+	within, _ := slices.BinarySearch(sorted, objective.threshold+1)
+	return Measurement{
+		objective: objective,
+		orders:    len(sorted),
+		within:    within,
+		percentiles: Percentiles{
+			P50: percentile(sorted, 50),
+			P90: percentile(sorted, 90),
+			P95: percentile(sorted, 95),
+			P99: percentile(sorted, 99),
+		},
+		guard: guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the Measurement was created through Measure.
+func (m Measurement) Validate() error {
+	return m.guard.Validate(ErrMeasurementIsNotConstructed)
+}
+
+// Objective returns the objective the stage was measured against.
+func (m Measurement) Objective() Objective {
+	return m.objective
+}
+
+// Orders returns the number of orders that went through the stage.
+func (m Measurement) Orders() int {
+	return m.orders
+}
+
+// Within returns the number of orders that went through the stage within the threshold.
+func (m Measurement) Within() int {
+	return m.within
+}
+
+// Percentiles returns the percentiles of the latencies of the stage.
+func (m Measurement) Percentiles() Percentiles {
+	return m.percentiles
+}
+
+// Compliance returns the share of orders within the threshold in percent, 100 when there were none.
+func (m Measurement) Compliance() float64 {
+	if m.orders == 0 {
+		return 100
+	}
+	return float64(m.within) * 100 / float64(m.orders)
+}
+
+// IsMet reports whether the share of orders within the threshold reached the goal.
+func (m Measurement) IsMet() bool {
+	return m.Compliance() >= m.objective.goal
+}
+
+// BurnRate returns how many times faster than the goal allows the stage spends its error budget:
+// the share of orders outside the threshold divided by the error budget. Returns 0 when there were no orders.
+func (m Measurement) BurnRate() float64 {
+	if m.orders == 0 {
+		return 0
+	}
+	outside := float64(m.orders-m.within) / float64(m.orders)
+	return outside / m.objective.ErrorBudget()
+}
+
+// IsBurning reports whether the burn rate reached the objective's alert burn rate.
+func (m Measurement) IsBurning() bool {
+	return m.orders > 0 && m.BurnRate() >= m.objective.alertBurnRate
+}
+
+// percentile returns the nearest-rank percentile of the sorted latencies, zero when there are none.
+func percentile(sorted []time.Duration, rank float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(math.Ceil(rank/100*float64(len(sorted)))) - 1
+	return sorted[max(i, 0)]
+}
@@ -0,0 +1,101 @@
+package slo_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/slo"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func minutes(values ...int) []time.Duration {
+	latencies := make([]time.Duration, len(values))
+	for i, value := range values {
+		latencies[i] = time.Duration(value) * time.Minute
+	}
+	return latencies
+}
+
+func TestMeasure(t *testing.T) {
+	objective, err := slo.NewObjective(slo.AssignmentStage, 5*time.Minute, 90, 2)
+	require.NoError(t, err)
+
+	t.Run("should compute percentiles and compliance", func(t *testing.T) {
+		measurement, err := slo.Measure(objective, minutes(10, 1, 2, 3, 4, 5, 6, 7, 8, 9))
+
+		require.NoError(t, err)
+		require.NoError(t, measurement.Validate())
+		assert.Equal(t, 10, measurement.Orders())
+		assert.Equal(t, 5, measurement.Within())
+		assert.Equal(t, slo.Percentiles{
+			P50: 5 * time.Minute,
+			P90: 9 * time.Minute,
+			P95: 10 * time.Minute,
+			P99: 10 * time.Minute,
+		}, measurement.Percentiles())
+		assert.InDelta(t, 50.0, measurement.Compliance(), 0.001)
+		assert.False(t, measurement.IsMet())
+		assert.InDelta(t, 5.0, measurement.BurnRate(), 0.001)
+		assert.True(t, measurement.IsBurning())
+	})
+
+	t.Run("should not burn below alert burn rate", func(t *testing.T) {
+		// One order of twenty outside the threshold spends the 10% budget at half the allowed rate
+		latencies := minutes(1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 30)
+
+		measurement, err := slo.Measure(objective, latencies)
+
+		require.NoError(t, err)
+		assert.Equal(t, 19, measurement.Within())
+		assert.True(t, measurement.IsMet())
+		assert.InDelta(t, 0.5, measurement.BurnRate(), 0.001)
+		assert.False(t, measurement.IsBurning())
+	})
+
+	t.Run("should meet objective without orders", func(t *testing.T) {
+		measurement, err := slo.Measure(objective, nil)
+
+		require.NoError(t, err)
+		assert.Zero(t, measurement.Orders())
+		assert.Equal(t, slo.Percentiles{}, measurement.Percentiles())
+		assert.InDelta(t, 100.0, measurement.Compliance(), 0.001)
+		assert.True(t, measurement.IsMet())
+		assert.Zero(t, measurement.BurnRate())
+		assert.False(t, measurement.IsBurning())
+	})
+
+	t.Run("should count latency equal to threshold as within", func(t *testing.T) {
+		measurement, err := slo.Measure(objective, minutes(5))
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, measurement.Within())
+	})
+
+	t.Run("should not reorder latencies of caller", func(t *testing.T) {
+		latencies := minutes(3, 1, 2)
+
+		_, err := slo.Measure(objective, latencies)
+
+		require.NoError(t, err)
+		assert.Equal(t, minutes(3, 1, 2), latencies)
+	})
+
+	t.Run("should reject negative latency", func(t *testing.T) {
+		_, err := slo.Measure(objective, []time.Duration{time.Minute, -time.Second})
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should reject objective not constructed", func(t *testing.T) {
+		_, err := slo.Measure(slo.Objective{}, minutes(1))
+
+		require.ErrorIs(t, err, slo.ErrObjectiveIsNotConstructed)
+	})
+
+	t.Run("should fail validation when not constructed", func(t *testing.T) {
+		require.ErrorIs(t, slo.Measurement{}.Validate(), slo.ErrMeasurementIsNotConstructed)
+	})
+}
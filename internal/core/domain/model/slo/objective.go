@@ -0,0 +1,104 @@
+package slo
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// ErrObjectiveIsNotConstructed indicates that an Objective was not properly initialized
+// through the NewObjective constructor.
+var ErrObjectiveIsNotConstructed = errors.New("Objective must be created via NewObjective constructor")
+
+// Objective is the latency a stage is held to: the share of orders, the goal, that must go through
+// the stage within the threshold, and the burn rate at which operations are alerted.
+//
+// Key business rules:
+//   - Must be constructed through NewObjective
+//   - The threshold is positive
+//   - The goal is a percentage above 0 and below 100, so some error budget always remains
+//   - The alert burn rate is positive
+type Objective struct {
+	stage         Stage
+	threshold     time.Duration
+	goal          float64
+	alertBurnRate float64
+
+	guard guard.ConstructorGuard
+}
+
+// NewObjective creates an objective with validation.
+//
+// Example:
+//
+//	// 95% of orders get a courier within 5 minutes; alert when the budget burns twice as fast
+//	objective, err := slo.NewObjective(slo.AssignmentStage, 5*time.Minute, 95, 2)
+func NewObjective(stage Stage, threshold time.Duration, goal float64, alertBurnRate float64) (Objective, error) {
+	var validation errs.ValidationErrors
+	if err := stage.Validate(); err != nil {
+		validation.Add("stage", err)
+	}
+	if threshold <= 0 {
+		validation.Add("threshold", errs.NewValueIsInvalidErrorWithCause(
+			"SLO threshold is invalid",
+			fmt.Errorf("%s must be positive", threshold),
+		))
+	}
+	if goal <= 0 || goal >= 100 {
+		validation.Add("goal", errs.NewValueIsInvalidErrorWithCause(
+			"SLO goal is invalid",
+			fmt.Errorf("%g is not above 0 and below 100", goal),
+		))
+	}
+	if alertBurnRate <= 0 {
+		validation.Add("alertBurnRate", errs.NewValueIsInvalidErrorWithCause(
+			"SLO alert burn rate is invalid",
+			fmt.Errorf("%g must be positive", alertBurnRate),
+		))
+	}
+	if err := validation.Err(); err != nil {
+		return Objective{}, err
+	}
+
+	return Objective{
+		stage:         stage,
+		threshold:     threshold,
+		goal:          goal,
+		alertBurnRate: alertBurnRate,
+		guard:         guard.NewConstructorGuard(),
+	}, nil
+}
+
+// Validate ensures the Objective was properly constructed.
+// Returns ErrObjectiveIsNotConstructed if validation fails.
+func (o Objective) Validate() error {
+	return o.guard.Validate(ErrObjectiveIsNotConstructed)
+}
+
+// Stage returns the stage the objective measures.
+func (o Objective) Stage() Stage {
+	return o.stage
+}
+
+// Threshold returns the longest an order may spend in the stage to be within the objective.
+func (o Objective) Threshold() time.Duration {
+	return o.threshold
+}
+
+// Goal returns the percentage of orders that must go through the stage within the threshold.
+func (o Objective) Goal() float64 {
+	return o.goal
+}
+
+// AlertBurnRate returns the burn rate from which the stage burns its error budget.
+func (o Objective) AlertBurnRate() float64 {
+	return o.alertBurnRate
+}
+
+// ErrorBudget returns the share of orders, from 0 to 1, the goal allows outside the threshold.
+func (o Objective) ErrorBudget() float64 {
+	return (100 - o.goal) / 100
+}
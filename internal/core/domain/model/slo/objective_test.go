@@ -0,0 +1,60 @@
+package slo_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/slo"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewObjective(t *testing.T) {
+	t.Run("should create valid objective", func(t *testing.T) {
+		objective, err := slo.NewObjective(slo.AssignmentStage, 5*time.Minute, 95, 2)
+
+		require.NoError(t, err)
+		require.NoError(t, objective.Validate())
+		assert.Equal(t, slo.AssignmentStage, objective.Stage())
+		assert.Equal(t, 5*time.Minute, objective.Threshold())
+		assert.InDelta(t, 95.0, objective.Goal(), 0.001)
+		assert.InDelta(t, 2.0, objective.AlertBurnRate(), 0.001)
+		assert.InDelta(t, 0.05, objective.ErrorBudget(), 0.0001)
+	})
+
+	t.Run("should reject invalid values", func(t *testing.T) {
+		cases := map[string]struct {
+			stage     slo.Stage
+			threshold time.Duration
+			goal      float64
+			burnRate  float64
+		}{
+			"unknown stage":      {slo.UnknownStage, time.Minute, 95, 2},
+			"zero threshold":     {slo.DeliveryStage, 0, 95, 2},
+			"zero goal":          {slo.DeliveryStage, time.Minute, 0, 2},
+			"full goal":          {slo.DeliveryStage, time.Minute, 100, 2},
+			"zero burn rate":     {slo.DeliveryStage, time.Minute, 95, 0},
+			"negative burn rate": {slo.DeliveryStage, time.Minute, 95, -1},
+		}
+
+		for name, tc := range cases {
+			t.Run(name, func(t *testing.T) {
+				_, err := slo.NewObjective(tc.stage, tc.threshold, tc.goal, tc.burnRate)
+
+				require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+			})
+		}
+	})
+
+	t.Run("should fail validation when not constructed", func(t *testing.T) {
+		require.ErrorIs(t, slo.Objective{}.Validate(), slo.ErrObjectiveIsNotConstructed)
+	})
+}
+
+func TestStage_String(t *testing.T) {
+	assert.Equal(t, "assignment", slo.AssignmentStage.String())
+	assert.Equal(t, "delivery", slo.DeliveryStage.String())
+	assert.Equal(t, "unknown", slo.UnknownStage.String())
+}
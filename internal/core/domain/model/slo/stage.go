@@ -0,0 +1,51 @@
+package slo
+
+import (
+	"fmt"
+
+	"delivery/internal/pkg/errs"
+)
+
+// Stage is the part of an order's life an objective measures.
+type Stage int
+
+const (
+	// UnknownStage represents an invalid or undefined stage.
+	// This value (0) helps catch uninitialized Stage values.
+	UnknownStage Stage = iota
+
+	// AssignmentStage runs from the creation of an order to its first assignment to a courier.
+	AssignmentStage
+
+	// DeliveryStage runs from the latest assignment of an order to its completion.
+	DeliveryStage
+)
+
+// getValidStageStrings returns a map of valid Stage values to their string representations.
+func getValidStageStrings() map[Stage]string {
+	//nolint:exhaustive // UnknownStage is intentionally excluded as it's invalid
+	return map[Stage]string{
+		AssignmentStage: "assignment",
+		DeliveryStage:   "delivery",
+	}
+}
+
+// Validate checks if the Stage value is valid.
+func (s Stage) Validate() error {
+	if _, ok := getValidStageStrings()[s]; !ok {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"SLO stage is invalid",
+			fmt.Errorf("%d is not a valid stage", s),
+		)
+	}
+	return nil
+}
+
+// String returns the name of the stage.
+// Returns "unknown" for invalid stage values.
+func (s Stage) String() string {
+	if str, ok := getValidStageStrings()[s]; ok {
+		return str
+	}
+	return "unknown"
+}
@@ -0,0 +1,22 @@
+// Package surge provides the domain model of the peak-hour surge mode: a temporary state of
+// the fleet in which dispatch relaxes its soft limits and off-shift couriers are invited to
+// join for a surge bonus.
+//
+// The package includes:
+//   - Setting: How dispatchers set the surge mode (auto, on or off)
+//   - Source: Whether a dispatcher or the backlog toggled the mode
+//   - Policy: The backlog that activates the mode and the relaxed limits while it is active
+//   - Mode: The current surge state
+//   - Toggle: The audit record of a change of the mode
+//
+// Key business rules:
+//   - A fleet starts in the auto setting with the surge mode off
+//   - In the auto setting the mode activates once the backlog reaches the policy's threshold and
+//     deactivates once it falls below half of it
+//   - The on and off settings force the mode regardless of the backlog until set back to auto
+//   - Every manual change is audited with the dispatcher's reason; automatic toggles are audited
+//     with the backlog that caused them
+//
+// The package follows Domain-Driven Design principles, providing rich domain
+// behavior, encapsulation, and validation to ensure business rules are enforced.
+package surge
@@ -0,0 +1,85 @@
+package surge
+
+import (
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+)
+
+// Mode is the surge state of the fleet: how dispatchers set it and whether it is active.
+// It is the state left behind by the latest toggle; before the first one the mode is
+// DefaultMode.
+type Mode struct {
+	setting Setting
+	active  bool
+}
+
+// DefaultMode returns the mode of a fleet whose surge mode was never toggled:
+// set to Auto and inactive.
+func DefaultMode() Mode {
+	return Mode{setting: Auto}
+}
+
+// Setting returns how dispatchers set the surge mode.
+func (m Mode) Setting() Setting {
+	return m.setting
+}
+
+// Active reports whether the surge mode is on.
+func (m Mode) Active() bool {
+	return m.active
+}
+
+// Change sets the surge mode by hand and returns the audit record of the change.
+// ForcedOn and ForcedOff switch the mode on or off; Auto hands it back to the backlog,
+// which is evaluated under the policy right away. Every manual change is recorded,
+// even one that leaves the mode as it was, since the reason belongs in the audit.
+//
+// Example:
+//
+//	toggle, err := mode.Change(kernel.NewUUID(), surge.ForcedOn, queued, policy, "Stadium concert", time.Now())
+//	if err == nil && toggle.Activated() {
+//	    notifyOffShiftCouriers()
+//	}
+func (m Mode) Change(
+	id kernel.UUID,
+	setting Setting,
+	backlog int,
+	policy Policy,
+	reason string,
+	at time.Time,
+) (Toggle, error) {
+	if err := policy.Validate(); err != nil {
+		return Toggle{}, err
+	}
+
+	active := setting == ForcedOn
+	if setting == Auto {
+		active = policy.activates(m.active, backlog)
+	}
+
+	return RestoreToggle(id, Manual, setting, m.active, active, backlog, reason, at)
+}
+
+// Observe evaluates the backlog under the policy while the mode is set to Auto and returns
+// the audit record with true when the backlog switched the mode on or off.
+// A forced setting is never switched by the backlog.
+func (m Mode) Observe(id kernel.UUID, backlog int, policy Policy, at time.Time) (Toggle, bool, error) {
+	if err := policy.Validate(); err != nil {
+		return Toggle{}, false, err
+	}
+	if m.setting != Auto {
+		return Toggle{}, false, nil
+	}
+
+	active := policy.activates(m.active, backlog)
+	if active == m.active {
+		return Toggle{}, false, nil
+	}
+
+	toggle, err := RestoreToggle(id, Automatic, Auto, m.active, active, backlog, "", at)
+	if err != nil {
+		return Toggle{}, false, err
+	}
+	return toggle, true, nil
+}
@@ -0,0 +1,196 @@
+package surge_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/surge"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPolicy(t *testing.T) {
+	t.Run("should create policy", func(t *testing.T) {
+		policy, err := surge.NewPolicy(200, 15*time.Minute)
+
+		require.NoError(t, err)
+		require.NoError(t, policy.Validate())
+		assert.Equal(t, 200, policy.Backlog())
+		assert.Equal(t, 15*time.Minute, policy.MaintenanceWarning())
+	})
+
+	t.Run("should fail with non-positive backlog and negative warning", func(t *testing.T) {
+		_, err := surge.NewPolicy(0, -time.Minute)
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+		var validation *errs.ValidationErrors
+		require.ErrorAs(t, err, &validation)
+		assert.Len(t, validation.Fields, 2)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, surge.Policy{}.Validate(), surge.ErrPolicyIsNotConstructed)
+	})
+}
+
+func TestParseSetting(t *testing.T) {
+	for name, expected := range map[string]surge.Setting{"auto": surge.Auto, "on": surge.ForcedOn, "off": surge.ForcedOff} {
+		setting, err := surge.ParseSetting(name)
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, setting)
+		assert.Equal(t, name, setting.String())
+	}
+
+	_, err := surge.ParseSetting("maybe")
+	require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+}
+
+func TestMode_Change(t *testing.T) {
+	policy, _ := surge.NewPolicy(100, 15*time.Minute)
+	now := time.Date(2026, 10, 15, 18, 0, 0, 0, time.UTC)
+
+	t.Run("should force surge on with the reason", func(t *testing.T) {
+		toggle, err := surge.DefaultMode().Change(kernel.NewUUID(), surge.ForcedOn, 10, policy, " Stadium concert ", now)
+
+		require.NoError(t, err)
+		assert.Equal(t, surge.Manual, toggle.Source())
+		assert.True(t, toggle.Activated())
+		assert.Equal(t, "Stadium concert", toggle.Reason())
+		assert.Equal(t, 10, toggle.Backlog())
+		assert.Equal(t, now, toggle.At())
+		assert.Equal(t, surge.ForcedOn, toggle.Mode().Setting())
+	})
+
+	t.Run("should force surge off regardless of the backlog", func(t *testing.T) {
+		active, _ := surge.DefaultMode().Change(kernel.NewUUID(), surge.ForcedOn, 150, policy, "Rain", now)
+
+		toggle, err := active.Mode().Change(kernel.NewUUID(), surge.ForcedOff, 150, policy, "Budget spent", now)
+
+		require.NoError(t, err)
+		assert.True(t, toggle.WasActive())
+		assert.False(t, toggle.Active())
+		assert.False(t, toggle.Activated())
+	})
+
+	t.Run("should evaluate backlog when set back to auto", func(t *testing.T) {
+		forced, _ := surge.DefaultMode().Change(kernel.NewUUID(), surge.ForcedOn, 0, policy, "Rain", now)
+
+		keeps, err := forced.Mode().Change(kernel.NewUUID(), surge.Auto, 50, policy, "Rain stopped", now)
+		require.NoError(t, err)
+		assert.True(t, keeps.Active(), "half of the threshold keeps an active surge on")
+
+		ends, err := forced.Mode().Change(kernel.NewUUID(), surge.Auto, 49, policy, "Rain stopped", now)
+		require.NoError(t, err)
+		assert.False(t, ends.Active())
+	})
+
+	t.Run("should record change that keeps the mode", func(t *testing.T) {
+		toggle, err := surge.DefaultMode().Change(kernel.NewUUID(), surge.ForcedOff, 0, policy, "No budget today", now)
+
+		require.NoError(t, err)
+		assert.False(t, toggle.WasActive())
+		assert.False(t, toggle.Active())
+	})
+
+	t.Run("should require a reason", func(t *testing.T) {
+		_, err := surge.DefaultMode().Change(kernel.NewUUID(), surge.ForcedOn, 0, policy, "  ", now)
+
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+	})
+
+	t.Run("should reject too long reason", func(t *testing.T) {
+		reason := strings.Repeat("x", surge.MaxReasonLength+1)
+
+		_, err := surge.DefaultMode().Change(kernel.NewUUID(), surge.ForcedOn, 0, policy, reason, now)
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should reject unknown setting", func(t *testing.T) {
+		_, err := surge.DefaultMode().Change(kernel.NewUUID(), surge.UnknownSetting, 0, policy, "Rain", now)
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should fail with policy not created via constructor", func(t *testing.T) {
+		_, err := surge.DefaultMode().Change(kernel.NewUUID(), surge.ForcedOn, 0, surge.Policy{}, "Rain", now)
+
+		require.ErrorIs(t, err, surge.ErrPolicyIsNotConstructed)
+	})
+}
+
+func TestMode_Observe(t *testing.T) {
+	policy, _ := surge.NewPolicy(100, 15*time.Minute)
+	now := time.Date(2026, 10, 15, 18, 0, 0, 0, time.UTC)
+
+	t.Run("should activate at the threshold", func(t *testing.T) {
+		_, switched, err := surge.DefaultMode().Observe(kernel.NewUUID(), 99, policy, now)
+		require.NoError(t, err)
+		assert.False(t, switched)
+
+		toggle, switched, err := surge.DefaultMode().Observe(kernel.NewUUID(), 100, policy, now)
+		require.NoError(t, err)
+		assert.True(t, switched)
+		assert.Equal(t, surge.Automatic, toggle.Source())
+		assert.True(t, toggle.Activated())
+		assert.Empty(t, toggle.Reason())
+	})
+
+	t.Run("should deactivate below half of the threshold", func(t *testing.T) {
+		active, _, _ := surge.DefaultMode().Observe(kernel.NewUUID(), 100, policy, now)
+
+		_, switched, err := active.Mode().Observe(kernel.NewUUID(), 50, policy, now)
+		require.NoError(t, err)
+		assert.False(t, switched)
+
+		toggle, switched, err := active.Mode().Observe(kernel.NewUUID(), 49, policy, now)
+		require.NoError(t, err)
+		assert.True(t, switched)
+		assert.False(t, toggle.Active())
+	})
+
+	t.Run("should leave forced setting alone", func(t *testing.T) {
+		forced, _ := surge.DefaultMode().Change(kernel.NewUUID(), surge.ForcedOff, 0, policy, "No budget today", now)
+
+		_, switched, err := forced.Mode().Observe(kernel.NewUUID(), 500, policy, now)
+
+		require.NoError(t, err)
+		assert.False(t, switched)
+	})
+}
+
+func TestRestoreToggle(t *testing.T) {
+	now := time.Date(2026, 10, 15, 18, 0, 0, 0, time.UTC)
+
+	t.Run("should restore toggle", func(t *testing.T) {
+		id := kernel.NewUUID()
+
+		toggle, err := surge.RestoreToggle(id, surge.Automatic, surge.Auto, false, true, 120, "", now)
+
+		require.NoError(t, err)
+		require.NoError(t, toggle.Validate())
+		assert.Equal(t, id, toggle.ID())
+		assert.True(t, toggle.Mode().Active())
+	})
+
+	t.Run("should reject forced setting contradicting the mode", func(t *testing.T) {
+		_, err := surge.RestoreToggle(kernel.NewUUID(), surge.Manual, surge.ForcedOff, false, true, 0, "Rain", now)
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should reject automatic toggle that keeps the mode", func(t *testing.T) {
+		_, err := surge.RestoreToggle(kernel.NewUUID(), surge.Automatic, surge.Auto, true, true, 120, "", now)
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should reject zero value", func(t *testing.T) {
+		require.ErrorIs(t, surge.Toggle{}.Validate(), surge.ErrToggleIsNotConstructed)
+	})
+}
@@ -0,0 +1,80 @@
+package surge
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// ErrPolicyIsNotConstructed indicates that a Policy was not properly initialized
+// through the NewPolicy constructor.
+var ErrPolicyIsNotConstructed = errors.New("Policy must be created via NewPolicy constructor")
+
+// Policy decides when the backlog switches the surge mode while it is set to Auto, and how
+// dispatch relaxes while the surge mode is active.
+//
+// Key business rules:
+//   - Must be constructed through NewPolicy
+//   - The backlog is positive; the surge mode activates once that many orders wait for a courier
+//   - It deactivates once fewer than half of them wait, so the mode does not flap around the threshold
+//   - The maintenance warning is non-negative; it replaces the regular warning while the surge is active
+type Policy struct {
+	backlog            int
+	maintenanceWarning time.Duration
+
+	guard guard.ConstructorGuard
+}
+
+// NewPolicy creates a policy with validation.
+//
+// Example:
+//
+//	policy, err := surge.NewPolicy(200, 15*time.Minute) // surge at 200 waiting orders
+func NewPolicy(backlog int, maintenanceWarning time.Duration) (Policy, error) {
+	var validation errs.ValidationErrors
+	if backlog <= 0 {
+		validation.Add("backlog", errs.NewValueIsInvalidErrorWithCause(
+			"surge backlog is invalid",
+			fmt.Errorf("%d must be positive", backlog),
+		))
+	}
+	if maintenanceWarning < 0 {
+		validation.Add("maintenanceWarning", errs.NewValueIsInvalidErrorWithCause(
+			"surge maintenance warning is invalid",
+			fmt.Errorf("%s is negative", maintenanceWarning),
+		))
+	}
+	if err := validation.Err(); err != nil {
+		return Policy{}, err
+	}
+
+	return Policy{backlog: backlog, maintenanceWarning: maintenanceWarning, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the Policy was properly constructed.
+// Returns ErrPolicyIsNotConstructed if validation fails.
+func (p Policy) Validate() error {
+	return p.guard.Validate(ErrPolicyIsNotConstructed)
+}
+
+// Backlog returns the number of waiting orders that activates the surge mode.
+func (p Policy) Backlog() int {
+	return p.backlog
+}
+
+// MaintenanceWarning returns how long before a vehicle maintenance window couriers stop
+// receiving orders while the surge mode is active.
+func (p Policy) MaintenanceWarning() time.Duration {
+	return p.maintenanceWarning
+}
+
+// activates reports whether the backlog keeps or puts the surge mode on, given whether it is active.
+func (p Policy) activates(active bool, backlog int) bool {
+	if active {
+		return backlog*2 >= p.backlog
+	}
+	return backlog >= p.backlog
+}
@@ -0,0 +1,125 @@
+package surge
+
+import (
+	"fmt"
+
+	"delivery/internal/pkg/errs"
+)
+
+// Setting is how dispatchers configured the surge mode.
+type Setting int
+
+const (
+	// UnknownSetting represents an invalid or undefined setting.
+	// This value (0) helps catch uninitialized Setting values.
+	UnknownSetting Setting = iota
+
+	// Auto lets the order backlog switch the surge mode on and off.
+	Auto
+
+	// ForcedOn keeps the surge mode on regardless of the backlog.
+	ForcedOn
+
+	// ForcedOff keeps the surge mode off regardless of the backlog.
+	ForcedOff
+)
+
+// getValidSettingStrings returns a map of valid Setting values to their string representations.
+func getValidSettingStrings() map[Setting]string {
+	//nolint:exhaustive // UnknownSetting is intentionally excluded as it's invalid
+	return map[Setting]string{
+		Auto:      "auto",
+		ForcedOn:  "on",
+		ForcedOff: "off",
+	}
+}
+
+// ParseSetting returns the setting with the given name.
+func ParseSetting(value string) (Setting, error) {
+	for setting, name := range getValidSettingStrings() {
+		if name == value {
+			return setting, nil
+		}
+	}
+	return UnknownSetting, errs.NewValueIsInvalidErrorWithCause(
+		"surge setting is invalid",
+		fmt.Errorf("%q is not one of auto, on or off", value),
+	)
+}
+
+// Validate checks if the Setting value is valid.
+func (s Setting) Validate() error {
+	if _, ok := getValidSettingStrings()[s]; !ok {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"surge setting is invalid",
+			fmt.Errorf("%d is not a valid setting", s),
+		)
+	}
+	return nil
+}
+
+// String returns the name of the setting.
+// Returns "unknown" for invalid setting values.
+func (s Setting) String() string {
+	if str, ok := getValidSettingStrings()[s]; ok {
+		return str
+	}
+	return "unknown"
+}
+
+// Source tells what toggled the surge mode.
+type Source int
+
+const (
+	// UnknownSource represents an invalid or undefined source.
+	// This value (0) helps catch uninitialized Source values.
+	UnknownSource Source = iota
+
+	// Manual toggles are made by dispatchers.
+	Manual
+
+	// Automatic toggles are made by the backlog while the setting is Auto.
+	Automatic
+)
+
+// getValidSourceStrings returns a map of valid Source values to their string representations.
+func getValidSourceStrings() map[Source]string {
+	//nolint:exhaustive // UnknownSource is intentionally excluded as it's invalid
+	return map[Source]string{
+		Manual:    "manual",
+		Automatic: "automatic",
+	}
+}
+
+// ParseSource returns the source with the given name.
+func ParseSource(value string) (Source, error) {
+	for source, name := range getValidSourceStrings() {
+		if name == value {
+			return source, nil
+		}
+	}
+	return UnknownSource, errs.NewValueIsInvalidErrorWithCause(
+		"surge toggle source is invalid",
+		fmt.Errorf("%q is not one of manual or automatic", value),
+	)
+}
+
+// Validate checks if the Source value is valid.
+func (s Source) Validate() error {
+	if _, ok := getValidSourceStrings()[s]; !ok {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"surge toggle source is invalid",
+			fmt.Errorf("%d is not a valid source", s),
+		)
+	}
+	return nil
+}
+
+// String returns the name of the source.
+// Returns "unknown" for invalid source values.
+func (s Source) String() string {
+	if str, ok := getValidSourceStrings()[s]; ok {
+		return str
+	}
+	return "unknown"
+}
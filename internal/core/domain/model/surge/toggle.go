@@ -0,0 +1,184 @@
+package surge
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// MaxReasonLength is the maximum number of characters in the reason of a manual toggle.
+const MaxReasonLength = 280
+
+// ErrToggleIsNotConstructed indicates that a Toggle was not created through Mode.Change,
+// Mode.Observe or RestoreToggle.
+var ErrToggleIsNotConstructed = errors.New("Toggle must be created via Mode.Change, Mode.Observe or RestoreToggle")
+
+// Toggle is the audit record of a change of the surge mode: who changed it, to what, why,
+// and how many orders were waiting at the time.
+//
+// Key business rules:
+//   - Manual toggles carry the dispatcher's reason, which is not blank and at most MaxReasonLength characters
+//   - Automatic toggles only happen while the setting is Auto and always switch the mode on or off
+//   - A forced setting determines whether the mode is active
+type Toggle struct {
+	id        kernel.UUID
+	source    Source
+	setting   Setting
+	wasActive bool
+	active    bool
+	backlog   int
+	reason    string
+	at        time.Time
+
+	guard guard.ConstructorGuard
+}
+
+// RestoreToggle recreates a toggle from persistence with validation.
+// Used by repositories.
+func RestoreToggle(
+	id kernel.UUID,
+	source Source,
+	setting Setting,
+	wasActive bool,
+	active bool,
+	backlog int,
+	reason string,
+	at time.Time,
+) (Toggle, error) {
+	toggle := Toggle{
+		id:        id,
+		source:    source,
+		setting:   setting,
+		wasActive: wasActive,
+		active:    active,
+		backlog:   backlog,
+		at:        at,
+		guard:     guard.NewConstructorGuard(),
+	}
+
+	var validation errs.ValidationErrors
+	if err := id.Validate(); err != nil {
+		validation.Add("id", err)
+	}
+	if err := source.Validate(); err != nil {
+		validation.Add("source", err)
+	}
+	if err := setting.Validate(); err != nil {
+		validation.Add("setting", err)
+	} else if err = checkActive(setting, active); err != nil {
+		validation.Add("active", err)
+	}
+	if backlog < 0 {
+		validation.Add("backlog", errs.NewValueIsInvalidErrorWithCause(
+			"surge backlog is invalid",
+			fmt.Errorf("%d is negative", backlog),
+		))
+	}
+	if source == Manual {
+		if err := toggle.setReason(reason); err != nil {
+			validation.Add("reason", err)
+		}
+	}
+	if source == Automatic && (setting != Auto || wasActive == active) {
+		validation.Add("source", errs.NewValueIsInvalidErrorWithCause(
+			"surge toggle source is invalid",
+			errors.New("automatic toggles switch the mode in the auto setting"),
+		))
+	}
+	if at.IsZero() {
+		validation.Add("at", errs.NewValueIsRequiredError("toggle time"))
+	}
+	if err := validation.Err(); err != nil {
+		return Toggle{}, err
+	}
+
+	return toggle, nil
+}
+
+// Validate ensures the Toggle was properly constructed.
+// Returns ErrToggleIsNotConstructed if validation fails.
+func (t Toggle) Validate() error {
+	return t.guard.Validate(ErrToggleIsNotConstructed)
+}
+
+// ID returns the toggle's unique identifier.
+func (t Toggle) ID() kernel.UUID {
+	return t.id
+}
+
+// Source returns what toggled the surge mode.
+func (t Toggle) Source() Source {
+	return t.source
+}
+
+// Setting returns the setting after the toggle.
+func (t Toggle) Setting() Setting {
+	return t.setting
+}
+
+// WasActive reports whether the surge mode was active before the toggle.
+func (t Toggle) WasActive() bool {
+	return t.wasActive
+}
+
+// Active reports whether the surge mode is active after the toggle.
+func (t Toggle) Active() bool {
+	return t.active
+}
+
+// Activated reports whether the toggle switched the surge mode on.
+func (t Toggle) Activated() bool {
+	return t.active && !t.wasActive
+}
+
+// Backlog returns the number of orders that waited for a courier at the toggle.
+func (t Toggle) Backlog() int {
+	return t.backlog
+}
+
+// Reason returns why the dispatcher toggled the surge mode; empty for automatic toggles.
+func (t Toggle) Reason() string {
+	return t.reason
+}
+
+// At returns when the surge mode was toggled.
+func (t Toggle) At() time.Time {
+	return t.at
+}
+
+// Mode returns the surge mode the toggle left behind.
+func (t Toggle) Mode() Mode {
+	return Mode{setting: t.setting, active: t.active}
+}
+
+func (t *Toggle) setReason(reason string) error {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return errs.NewValueIsRequiredError("reason")
+	}
+	if length := utf8.RuneCountInString(reason); length > MaxReasonLength {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"surge toggle reason is invalid",
+			fmt.Errorf("%d characters exceed the limit of %d", length, MaxReasonLength),
+		)
+	}
+	t.reason = reason
+	return nil
+}
+
+// checkActive ensures a forced setting agrees with whether the mode is active.
+func checkActive(setting Setting, active bool) error {
+	if (setting == ForcedOn && !active) || (setting == ForcedOff && active) {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"surge mode is invalid",
+			fmt.Errorf("setting %s contradicts active %t", setting, active),
+		)
+	}
+	return nil
+}
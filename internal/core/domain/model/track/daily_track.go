@@ -0,0 +1,120 @@
+package track
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// ErrDailyTrackIsNotConstructed indicates that a DailyTrack was not created through Summarize.
+var ErrDailyTrackIsNotConstructed = errors.New("DailyTrack must be created via Summarize")
+
+// DailyTrack is how much a courier moved on one UTC day according to the recorded points.
+//
+// Key business rules:
+//   - Covers the points recorded on its day only
+//   - The distance is the sum of the grid distances between consecutive points
+//   - A day without points has no first or last point
+type DailyTrack struct {
+	courierID kernel.UUID
+	day       time.Time
+	points    int
+	distance  int
+	firstAt   time.Time
+	lastAt    time.Time
+
+	guard guard.ConstructorGuard
+}
+
+// Summarize computes the track of the courier on the day from the courier's points. Points
+// recorded on other days are ignored, and points recorded at the same moment count once, so
+// the history of the day can be summarized again whenever late points arrive.
+//
+// Example:
+//
+//	day := track.Day(time.Now())
+//	daily, err := track.Summarize(courierID, day, points)
+//	fmt.Printf("%d cells in %d points\n", daily.Distance(), daily.Points())
+func Summarize(courierID kernel.UUID, day time.Time, points []Point) (DailyTrack, error) {
+	if err := errs.JoinFields(
+		errs.Field("courierId", courierID.Validate()),
+		errs.Field("day", validateDay(day)),
+	); err != nil {
+		return DailyTrack{}, err
+	}
+
+	daily := DailyTrack{courierID: courierID, day: day.UTC(), guard: guard.NewConstructorGuard()}
+	var previous *Point
+	for _, point := range Deduplicate(points) {
+		if err := point.Validate(); err != nil {
+			return DailyTrack{}, err
+		}
+		if !Day(point.recordedAt).Equal(daily.day) {
+			continue
+		}
+
+		if previous == nil {
+			daily.firstAt = point.recordedAt
+		} else {
+			distance, err := previous.location.Distance(point.location)
+			if err != nil {
+				return DailyTrack{}, err
+			}
+			daily.distance += distance
+		}
+		daily.points++
+		daily.lastAt = point.recordedAt
+		previous = &point
+	}
+
+	return daily, nil
+}
+
+// Validate ensures the DailyTrack was created through Summarize.
+func (t DailyTrack) Validate() error {
+	return t.guard.Validate(ErrDailyTrackIsNotConstructed)
+}
+
+// CourierID returns the courier who moved.
+func (t DailyTrack) CourierID() kernel.UUID {
+	return t.courierID
+}
+
+// Day returns the start of the UTC day the track covers.
+func (t DailyTrack) Day() time.Time {
+	return t.day
+}
+
+// Points returns the number of points recorded on the day.
+func (t DailyTrack) Points() int {
+	return t.points
+}
+
+// Distance returns the number of grid cells the courier moved between the points of the day.
+func (t DailyTrack) Distance() int {
+	return t.distance
+}
+
+// FirstAt returns when the first point of the day was recorded, zero for a day without points.
+func (t DailyTrack) FirstAt() time.Time {
+	return t.firstAt
+}
+
+// LastAt returns when the last point of the day was recorded, zero for a day without points.
+func (t DailyTrack) LastAt() time.Time {
+	return t.lastAt
+}
+
+func validateDay(day time.Time) error {
+	if day.IsZero() || !Day(day).Equal(day) {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"day is invalid",
+			fmt.Errorf("%s is not the start of a UTC day", day),
+		)
+	}
+	return nil
+}
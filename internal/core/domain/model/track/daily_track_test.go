@@ -0,0 +1,57 @@
+package track_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/track"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarize(t *testing.T) {
+	courierID := kernel.NewUUID()
+	day := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should sum distances between points of the day in time order", func(t *testing.T) {
+		daily, err := track.Summarize(courierID, day, []track.Point{
+			point(t, 4, 4, day.Add(9*time.Hour)),
+			point(t, 1, 1, day.Add(8*time.Hour)),
+			point(t, 4, 1, day.Add(8*time.Hour+30*time.Minute)),
+			point(t, 9, 9, day.Add(8*time.Hour+30*time.Minute)),
+			point(t, 10, 10, day.Add(-time.Hour)),
+			point(t, 10, 10, day.Add(25*time.Hour)),
+		})
+
+		require.NoError(t, err)
+		require.NoError(t, daily.Validate())
+		assert.Equal(t, courierID, daily.CourierID())
+		assert.Equal(t, day, daily.Day())
+		assert.Equal(t, 3, daily.Points())
+		assert.Equal(t, 6, daily.Distance())
+		assert.Equal(t, day.Add(8*time.Hour), daily.FirstAt())
+		assert.Equal(t, day.Add(9*time.Hour), daily.LastAt())
+	})
+
+	t.Run("should summarize day without points", func(t *testing.T) {
+		daily, err := track.Summarize(courierID, day, nil)
+
+		require.NoError(t, err)
+		assert.Zero(t, daily.Points())
+		assert.Zero(t, daily.Distance())
+		assert.True(t, daily.FirstAt().IsZero())
+	})
+
+	t.Run("should refuse day not starting at midnight UTC", func(t *testing.T) {
+		_, err := track.Summarize(courierID, day.Add(time.Hour), nil)
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, track.DailyTrack{}.Validate(), track.ErrDailyTrackIsNotConstructed)
+	})
+}
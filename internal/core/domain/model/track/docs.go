@@ -0,0 +1,17 @@
+// Package track provides the domain model of courier location history: the grid positions
+// courier apps record along the way, possibly uploaded in batches long after they were taken,
+// and the daily track of a courier summarized from them.
+//
+// The package includes:
+//   - Point: A position a courier app recorded at some moment
+//   - DailyTrack: How much a courier moved on a UTC day
+//
+// Key business rules:
+//   - A courier has at most one point per moment; a point recorded again is a duplicate
+//   - Points are ordered by the time they were recorded, not by the time they arrived
+//   - A daily track covers the points recorded on one UTC day; its distance is the number of
+//     grid cells between consecutive points
+//
+// The package follows Domain-Driven Design principles, providing rich domain
+// behavior, encapsulation, and validation to ensure business rules are enforced.
+package track
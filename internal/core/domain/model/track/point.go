@@ -0,0 +1,98 @@
+package track
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// MaxBatchPoints is the largest number of points a courier app may upload at once.
+const MaxBatchPoints = 1000
+
+// ErrPointIsNotConstructed indicates that a Point was not properly initialized
+// through the NewPoint constructor.
+var ErrPointIsNotConstructed = errors.New("Point must be created via NewPoint constructor")
+
+// Point is a grid position a courier app recorded.
+//
+// Key business rules:
+//   - Must be constructed through NewPoint
+//   - The location is on the grid
+//   - Has the time the app recorded it, which may lie far in the past for points synced after
+//     the app was offline
+type Point struct {
+	location   kernel.Location
+	recordedAt time.Time
+
+	guard guard.ConstructorGuard
+}
+
+// NewPoint creates a point with validation.
+//
+// Example:
+//
+//	location, _ := kernel.NewLocation(3, 4)
+//	point, err := track.NewPoint(location, time.Now())
+func NewPoint(location kernel.Location, recordedAt time.Time) (Point, error) {
+	var validation errs.ValidationErrors
+	if err := location.Validate(); err != nil {
+		validation.Add("location", err)
+	}
+	if recordedAt.IsZero() {
+		validation.Add("recordedAt", errs.NewValueIsRequiredError("recordedAt"))
+	}
+	if err := validation.Err(); err != nil {
+		return Point{}, err
+	}
+
+	return Point{location: location, recordedAt: recordedAt.UTC(), guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the Point was properly constructed.
+// Returns ErrPointIsNotConstructed if validation fails.
+func (p Point) Validate() error {
+	return p.guard.Validate(ErrPointIsNotConstructed)
+}
+
+// Location returns the recorded grid position.
+func (p Point) Location() kernel.Location {
+	return p.location
+}
+
+// RecordedAt returns when the app recorded the point, in UTC.
+func (p Point) RecordedAt() time.Time {
+	return p.recordedAt
+}
+
+// Deduplicate orders the points by the time they were recorded and keeps the first of the
+// points recorded at the same moment, as apps resend points they are unsure were delivered.
+// The given slice is left unchanged.
+func Deduplicate(points []Point) []Point {
+	ordered := slices.Clone(points)
+	slices.SortStableFunc(ordered, func(a, b Point) int {
+		return a.recordedAt.Compare(b.recordedAt)
+	})
+
+	return slices.CompactFunc(ordered, func(a, b Point) bool {
+		return a.recordedAt.Equal(b.recordedAt)
+	})
+}
+
+// Days returns the distinct UTC days the points were recorded on, earliest first.
+func Days(points []Point) []time.Time {
+	days := make([]time.Time, 0, 1)
+	for _, point := range points {
+		days = append(days, Day(point.recordedAt))
+	}
+	slices.SortFunc(days, time.Time.Compare)
+	return slices.CompactFunc(days, time.Time.Equal)
+}
+
+// Day returns the start of the UTC day the moment falls on. Tracks are summarized per UTC day.
+func Day(moment time.Time) time.Time {
+	return moment.UTC().Truncate(24 * time.Hour)
+}
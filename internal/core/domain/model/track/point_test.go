@@ -0,0 +1,75 @@
+package track_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/track"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func point(t *testing.T, x, y kernel.Coordinate, recordedAt time.Time) track.Point {
+	t.Helper()
+	location, err := kernel.NewLocation(x, y)
+	require.NoError(t, err)
+	p, err := track.NewPoint(location, recordedAt)
+	require.NoError(t, err)
+	return p
+}
+
+func TestNewPoint(t *testing.T) {
+	t.Run("should create point in UTC", func(t *testing.T) {
+		location, _ := kernel.NewLocation(3, 4)
+		recordedAt := time.Date(2025, 3, 14, 15, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+
+		p, err := track.NewPoint(location, recordedAt)
+
+		require.NoError(t, err)
+		require.NoError(t, p.Validate())
+		assert.Equal(t, location, p.Location())
+		assert.Equal(t, time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC), p.RecordedAt())
+	})
+
+	t.Run("should refuse invalid values", func(t *testing.T) {
+		_, err := track.NewPoint(kernel.Location{}, time.Time{})
+
+		var validation *errs.ValidationErrors
+		require.ErrorAs(t, err, &validation)
+		require.Len(t, validation.Fields, 2)
+		assert.Equal(t, "location", validation.Fields[0].Field)
+		assert.Equal(t, "recordedAt", validation.Fields[1].Field)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, track.Point{}.Validate(), track.ErrPointIsNotConstructed)
+	})
+}
+
+func TestDeduplicate(t *testing.T) {
+	noon := time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC)
+	first := point(t, 1, 1, noon.Add(time.Minute))
+	second := point(t, 2, 1, noon)
+	resent := point(t, 5, 5, noon)
+	points := []track.Point{first, second, resent}
+
+	deduplicated := track.Deduplicate(points)
+
+	assert.Equal(t, []track.Point{second, first}, deduplicated)
+	assert.Equal(t, []track.Point{first, second, resent}, points)
+}
+
+func TestDays(t *testing.T) {
+	day := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+
+	days := track.Days([]track.Point{
+		point(t, 1, 1, day.Add(30*time.Hour)),
+		point(t, 1, 1, day.Add(time.Hour)),
+		point(t, 1, 1, day.Add(23*time.Hour)),
+	})
+
+	assert.Equal(t, []time.Time{day, day.AddDate(0, 0, 1)}, days)
+}
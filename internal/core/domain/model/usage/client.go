@@ -0,0 +1,82 @@
+package usage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// maxAPIKeyLength bounds the API keys clients may present.
+const maxAPIKeyLength = 256
+
+// ErrClientIsNotConstructed indicates that a Client was not properly initialized
+// through the NewClient or ClientFromFingerprint constructors.
+var ErrClientIsNotConstructed = errors.New("Client must be created via NewClient constructor")
+
+// Client is a partner client of the API. It is identified by the SHA-256 fingerprint
+// of its API key, so usage can be accounted per key without storing the key itself.
+//
+// Key business rules:
+//   - Must be constructed through NewClient or ClientFromFingerprint
+//   - The fingerprint is a lowercase hex string of 64 characters
+type Client struct {
+	// fingerprint is the hex-encoded SHA-256 of the API key
+	fingerprint string
+
+	// guard ensures the client was created via a constructor
+	guard guard.ConstructorGuard
+}
+
+// NewClient identifies the client presenting the API key.
+//
+// Example:
+//
+//	client, err := usage.NewClient(request.Header.Get("X-API-Key"))
+func NewClient(apiKey string) (Client, error) {
+	if strings.TrimSpace(apiKey) == "" {
+		return Client{}, errs.NewValueIsRequiredError("api key")
+	}
+	if len(apiKey) > maxAPIKeyLength {
+		return Client{}, errs.NewValueIsInvalidErrorWithCause(
+			"api key",
+			fmt.Errorf("must be at most %d characters", maxAPIKeyLength),
+		)
+	}
+
+	sum := sha256.Sum256([]byte(apiKey))
+	return Client{fingerprint: hex.EncodeToString(sum[:]), guard: guard.NewConstructorGuard()}, nil
+}
+
+// ClientFromFingerprint restores a client from a fingerprint read from persistent storage.
+func ClientFromFingerprint(fingerprint string) (Client, error) {
+	raw, err := hex.DecodeString(fingerprint)
+	if err != nil || len(raw) != sha256.Size || hex.EncodeToString(raw) != fingerprint {
+		return Client{}, errs.NewValueIsInvalidErrorWithCause(
+			"client fingerprint",
+			fmt.Errorf("%q is not a %d-byte lowercase hex string", fingerprint, sha256.Size),
+		)
+	}
+
+	return Client{fingerprint: fingerprint, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the Client was properly constructed.
+// Returns ErrClientIsNotConstructed if the client was created directly.
+func (c Client) Validate() error {
+	return c.guard.Validate(ErrClientIsNotConstructed)
+}
+
+// Fingerprint returns the hex-encoded SHA-256 of the client's API key.
+func (c Client) Fingerprint() string {
+	return c.fingerprint
+}
+
+// String returns the client's fingerprint.
+func (c Client) String() string {
+	return c.fingerprint
+}
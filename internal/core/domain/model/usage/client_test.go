@@ -0,0 +1,63 @@
+package usage_test
+
+import (
+	"strings"
+	"testing"
+
+	"delivery/internal/core/domain/model/usage"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClient(t *testing.T) {
+	t.Run("should identify client by key fingerprint", func(t *testing.T) {
+		client, err := usage.NewClient("partner-secret")
+
+		require.NoError(t, err)
+		require.NoError(t, client.Validate())
+		assert.Len(t, client.Fingerprint(), 64)
+		assert.NotContains(t, client.Fingerprint(), "partner-secret")
+
+		same, _ := usage.NewClient("partner-secret")
+		other, _ := usage.NewClient("other-secret")
+		assert.Equal(t, client.Fingerprint(), same.Fingerprint())
+		assert.NotEqual(t, client.Fingerprint(), other.Fingerprint())
+	})
+
+	t.Run("should require key", func(t *testing.T) {
+		_, err := usage.NewClient("  ")
+
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+	})
+
+	t.Run("should refuse overlong key", func(t *testing.T) {
+		_, err := usage.NewClient(strings.Repeat("k", 257))
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, usage.Client{}.Validate(), usage.ErrClientIsNotConstructed)
+	})
+}
+
+func TestClientFromFingerprint(t *testing.T) {
+	client, _ := usage.NewClient("partner-secret")
+
+	t.Run("should restore client", func(t *testing.T) {
+		restored, err := usage.ClientFromFingerprint(client.Fingerprint())
+
+		require.NoError(t, err)
+		assert.Equal(t, client.Fingerprint(), restored.Fingerprint())
+	})
+
+	t.Run("should refuse malformed fingerprints", func(t *testing.T) {
+		for _, fingerprint := range []string{"", "partner-secret", strings.ToUpper(client.Fingerprint())} {
+			_, err := usage.ClientFromFingerprint(fingerprint)
+
+			require.ErrorIs(t, err, errs.ErrValueIsInvalid, fingerprint)
+		}
+	})
+}
@@ -0,0 +1,18 @@
+// Package usage provides the domain model of API usage accounting: what every partner
+// client consumes each month and the monthly quotas that bound it, as the basis of partner billing.
+//
+// The package includes:
+//   - Client: A partner client, identified by the fingerprint of its API key
+//   - Metric: A billable kind of usage such as requests or orders created
+//   - Period: The calendar month usage is accounted and billed for
+//   - Quota: The monthly limit of every metric
+//
+// Key business rules:
+//   - API keys are never stored; clients are recognized by a SHA-256 fingerprint of the key
+//   - Usage is accounted per client, calendar month (UTC) and metric
+//   - A zero limit leaves a metric unlimited
+//   - Usage that would take a metric past its limit is refused until the next month
+//
+// The package follows Domain-Driven Design principles, providing rich domain
+// behavior, encapsulation, and validation to ensure business rules are enforced.
+package usage
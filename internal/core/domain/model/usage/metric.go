@@ -0,0 +1,60 @@
+package usage
+
+import (
+	"fmt"
+
+	"delivery/internal/pkg/errs"
+)
+
+// Metric is a billable kind of API usage.
+type Metric int
+
+const (
+	// UnknownMetric represents an invalid or undefined metric.
+	// This value (0) helps catch uninitialized Metric values.
+	UnknownMetric Metric = iota
+
+	// Requests counts every API request the client sends.
+	Requests
+
+	// OrdersCreated counts the orders the client creates, one by one or by upload.
+	OrdersCreated
+
+	// WebhooksConsumed counts the payment webhook events the client delivers.
+	WebhooksConsumed
+)
+
+// Metrics returns every valid metric in reporting order.
+func Metrics() []Metric {
+	return []Metric{Requests, OrdersCreated, WebhooksConsumed}
+}
+
+// getValidMetricStrings returns a map of valid Metric values to their string representations.
+func getValidMetricStrings() map[Metric]string {
+	//nolint:exhaustive // UnknownMetric is intentionally excluded as it's invalid
+	return map[Metric]string{
+		Requests:         "requests",
+		OrdersCreated:    "orders_created",
+		WebhooksConsumed: "webhooks_consumed",
+	}
+}
+
+// Validate checks if the Metric value is valid.
+func (m Metric) Validate() error {
+	if _, ok := getValidMetricStrings()[m]; !ok {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"usage metric is invalid",
+			fmt.Errorf("%d is not a valid usage metric", m),
+		)
+	}
+	return nil
+}
+
+// String returns the name of the metric.
+// Returns "unknown" for invalid metric values.
+func (m Metric) String() string {
+	if str, ok := getValidMetricStrings()[m]; ok {
+		return str
+	}
+	return "unknown"
+}
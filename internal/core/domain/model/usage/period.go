@@ -0,0 +1,76 @@
+package usage
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+// periodLayout is the textual form of a period, e.g. "2025-03".
+const periodLayout = "2006-01"
+
+// ErrPeriodIsNotConstructed indicates that a Period was not properly initialized
+// through the PeriodOf or ParsePeriod constructors.
+var ErrPeriodIsNotConstructed = errors.New("Period must be created via PeriodOf constructor")
+
+// Period is the calendar month, in UTC, that usage is accounted and billed for.
+//
+// Key business rules:
+//   - Must be constructed through PeriodOf or ParsePeriod
+//   - Starts at midnight UTC of the month's first day and ends where the next month starts
+type Period struct {
+	// start is the first instant of the month
+	start time.Time
+
+	// guard ensures the period was created via a constructor
+	guard guard.ConstructorGuard
+}
+
+// PeriodOf returns the month the instant falls in.
+//
+// Example:
+//
+//	period := usage.PeriodOf(time.Now())
+func PeriodOf(t time.Time) Period {
+	t = t.UTC()
+	return Period{
+		start: time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC),
+		guard: guard.NewConstructorGuard(),
+	}
+}
+
+// ParsePeriod parses a month in the "2006-01" form.
+func ParsePeriod(s string) (Period, error) {
+	start, err := time.Parse(periodLayout, s)
+	if err != nil {
+		return Period{}, errs.NewValueIsInvalidErrorWithCause(
+			"period",
+			fmt.Errorf("%q is not a month in the YYYY-MM form", s),
+		)
+	}
+	return PeriodOf(start), nil
+}
+
+// Validate ensures the Period was properly constructed.
+// Returns ErrPeriodIsNotConstructed if the period was created directly.
+func (p Period) Validate() error {
+	return p.guard.Validate(ErrPeriodIsNotConstructed)
+}
+
+// Start returns the first instant of the month.
+func (p Period) Start() time.Time {
+	return p.start
+}
+
+// End returns the first instant of the next month, when the quotas reset.
+func (p Period) End() time.Time {
+	return p.start.AddDate(0, 1, 0)
+}
+
+// String returns the month in the "2006-01" form.
+func (p Period) String() string {
+	return p.start.Format(periodLayout)
+}
@@ -0,0 +1,51 @@
+package usage_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/usage"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeriodOf(t *testing.T) {
+	t.Run("should span the calendar month in UTC", func(t *testing.T) {
+		moscow := time.FixedZone("MSK", 3*60*60)
+		period := usage.PeriodOf(time.Date(2025, 4, 1, 1, 30, 0, 0, moscow))
+
+		require.NoError(t, period.Validate())
+		assert.Equal(t, "2025-03", period.String())
+		assert.Equal(t, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), period.Start())
+		assert.Equal(t, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), period.End())
+	})
+
+	t.Run("should roll over the year", func(t *testing.T) {
+		period := usage.PeriodOf(time.Date(2025, 12, 31, 23, 0, 0, 0, time.UTC))
+
+		assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), period.End())
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, usage.Period{}.Validate(), usage.ErrPeriodIsNotConstructed)
+	})
+}
+
+func TestParsePeriod(t *testing.T) {
+	t.Run("should parse month", func(t *testing.T) {
+		period, err := usage.ParsePeriod("2025-03")
+
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), period.Start())
+	})
+
+	t.Run("should refuse malformed months", func(t *testing.T) {
+		for _, s := range []string{"", "2025-13", "2025-03-01", "March"} {
+			_, err := usage.ParsePeriod(s)
+
+			require.ErrorIs(t, err, errs.ErrValueIsInvalid, s)
+		}
+	})
+}
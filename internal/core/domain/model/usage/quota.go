@@ -0,0 +1,100 @@
+package usage
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/guard"
+)
+
+var (
+	// ErrQuotaIsNotConstructed indicates that a Quota was not properly initialized
+	// through the NewQuota constructor.
+	ErrQuotaIsNotConstructed = errors.New("Quota must be created via NewQuota constructor")
+
+	// ErrQuotaIsExceeded is returned when usage would take a metric past its monthly limit.
+	ErrQuotaIsExceeded = errors.New("monthly quota is exceeded")
+)
+
+// QuotaExceededError tells the client which quota it ran into and when it resets.
+// It matches ErrQuotaIsExceeded with errors.Is.
+type QuotaExceededError struct {
+	Metric   Metric
+	Limit    int
+	Used     int
+	ResetsAt time.Time
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("%s: %d of %d %s used, resets at %s",
+		ErrQuotaIsExceeded, e.Used, e.Limit, e.Metric, e.ResetsAt.Format(time.RFC3339))
+}
+
+func (e *QuotaExceededError) Unwrap() error {
+	return ErrQuotaIsExceeded
+}
+
+// Quota is the monthly limit of every metric a client may consume.
+//
+// Key business rules:
+//   - Must be constructed through NewQuota
+//   - Limits are not negative; a zero limit leaves the metric unlimited
+type Quota struct {
+	// limits holds the monthly limit of every metric
+	limits map[Metric]int
+
+	// guard ensures the quota was created via NewQuota
+	guard guard.ConstructorGuard
+}
+
+// NewQuota creates a monthly quota with validation.
+//
+// Example:
+//
+//	quota, err := usage.NewQuota(100_000, 5_000, 0) // webhooks are unlimited
+func NewQuota(requests, ordersCreated, webhooksConsumed int) (Quota, error) {
+	limits := map[Metric]int{
+		Requests:         requests,
+		OrdersCreated:    ordersCreated,
+		WebhooksConsumed: webhooksConsumed,
+	}
+
+	var validation errs.ValidationErrors
+	for _, metric := range Metrics() {
+		if limits[metric] < 0 {
+			validation.Add(metric.String(), errs.NewValueIsInvalidErrorWithCause(
+				"quota",
+				fmt.Errorf("%d must not be negative", limits[metric]),
+			))
+		}
+	}
+	if err := validation.Err(); err != nil {
+		return Quota{}, err
+	}
+
+	return Quota{limits: limits, guard: guard.NewConstructorGuard()}, nil
+}
+
+// Validate ensures the Quota was properly constructed.
+// Returns ErrQuotaIsNotConstructed if the quota was created directly.
+func (q Quota) Validate() error {
+	return q.guard.Validate(ErrQuotaIsNotConstructed)
+}
+
+// Limit returns the monthly limit of the metric; zero means unlimited.
+func (q Quota) Limit(metric Metric) int {
+	return q.limits[metric]
+}
+
+// Check reports whether amount more of the metric fits the quota, given the amount already
+// used in the period. It returns a *QuotaExceededError when it does not.
+func (q Quota) Check(metric Metric, used, amount int, period Period) error {
+	limit := q.Limit(metric)
+	if limit == 0 || used+amount <= limit {
+		return nil
+	}
+
+	return &QuotaExceededError{Metric: metric, Limit: limit, Used: used, ResetsAt: period.End()}
+}
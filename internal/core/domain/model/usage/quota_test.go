@@ -0,0 +1,68 @@
+package usage_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/usage"
+	"delivery/internal/pkg/errs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewQuota(t *testing.T) {
+	t.Run("should create quota", func(t *testing.T) {
+		quota, err := usage.NewQuota(1000, 50, 0)
+
+		require.NoError(t, err)
+		require.NoError(t, quota.Validate())
+		assert.Equal(t, 1000, quota.Limit(usage.Requests))
+		assert.Equal(t, 50, quota.Limit(usage.OrdersCreated))
+		assert.Zero(t, quota.Limit(usage.WebhooksConsumed))
+	})
+
+	t.Run("should refuse negative limits", func(t *testing.T) {
+		_, err := usage.NewQuota(-1, 50, -5)
+
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+		var validation *errs.ValidationErrors
+		require.ErrorAs(t, err, &validation)
+		assert.Len(t, validation.Fields, 2)
+		assert.Equal(t, "requests", validation.Fields[0].Field)
+		assert.Equal(t, "webhooks_consumed", validation.Fields[1].Field)
+	})
+
+	t.Run("should fail when not created via constructor", func(t *testing.T) {
+		require.ErrorIs(t, usage.Quota{}.Validate(), usage.ErrQuotaIsNotConstructed)
+	})
+}
+
+func TestQuota_Check(t *testing.T) {
+	quota, _ := usage.NewQuota(0, 10, 0)
+	period := usage.PeriodOf(time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC))
+
+	t.Run("should allow usage up to the limit", func(t *testing.T) {
+		assert.NoError(t, quota.Check(usage.OrdersCreated, 7, 3, period))
+	})
+
+	t.Run("should refuse usage past the limit", func(t *testing.T) {
+		err := quota.Check(usage.OrdersCreated, 9, 2, period)
+
+		require.ErrorIs(t, err, usage.ErrQuotaIsExceeded)
+		var exceeded *usage.QuotaExceededError
+		require.True(t, errors.As(err, &exceeded))
+		assert.Equal(t, usage.OrdersCreated, exceeded.Metric)
+		assert.Equal(t, 10, exceeded.Limit)
+		assert.Equal(t, 9, exceeded.Used)
+		assert.Equal(t, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), exceeded.ResetsAt)
+		assert.Equal(t,
+			"monthly quota is exceeded: 9 of 10 orders_created used, resets at 2025-04-01T00:00:00Z",
+			err.Error())
+	})
+
+	t.Run("should leave metrics without limit unlimited", func(t *testing.T) {
+		assert.NoError(t, quota.Check(usage.Requests, 1_000_000, 1, period))
+	})
+}
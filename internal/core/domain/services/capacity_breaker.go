@@ -0,0 +1,81 @@
+package services
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrCapacityRatioIsInvalid is returned when the capacity breaker is configured with a non-positive ratio.
+var ErrCapacityRatioIsInvalid = errors.New("max orders per free courier must be greater than 0")
+
+// CapacityAssessment describes the fleet load at a point in time and whether it exceeds the limit.
+type CapacityAssessment struct {
+	// QueuedOrders is the number of orders waiting for a courier.
+	QueuedOrders int
+	// FreeCouriers is the number of couriers available for dispatch.
+	FreeCouriers int
+	// Ratio is the number of queued orders per free courier (+Inf when no courier is free).
+	Ratio float64
+	// MaxRatio is the configured limit.
+	MaxRatio float64
+	// Exceeded reports whether Ratio is above MaxRatio.
+	Exceeded bool
+}
+
+// CapacityBreaker is a domain service that decides whether the fleet can take more orders.
+//
+// Business rules:
+//   - The load is measured as queued orders per free courier
+//   - The breaker trips when the load exceeds the configured maximum
+//   - Without free couriers any queued order trips the breaker
+//   - An empty queue never trips the breaker
+//
+// Example usage:
+//
+//	breaker, _ := NewCapacityBreaker(5)
+//	assessment := breaker.Evaluate(queuedOrders, freeCouriers)
+//	if assessment.Exceeded {
+//	    // Throttle order intake
+//	}
+type CapacityBreaker struct {
+	maxRatio float64
+}
+
+// NewCapacityBreaker creates a breaker that trips above maxRatio queued orders per free courier.
+//
+// Returns:
+//   - CapacityBreaker: A configured breaker
+//   - error: ErrCapacityRatioIsInvalid if maxRatio is not positive
+func NewCapacityBreaker(maxRatio float64) (CapacityBreaker, error) {
+	if maxRatio <= 0 || math.IsNaN(maxRatio) || math.IsInf(maxRatio, 0) {
+		return CapacityBreaker{}, ErrCapacityRatioIsInvalid
+	}
+
+	return CapacityBreaker{maxRatio: maxRatio}, nil
+}
+
+// MaxRatio returns the configured maximum of queued orders per free courier.
+func (b CapacityBreaker) MaxRatio() float64 {
+	return b.maxRatio
+}
+
+// Evaluate assesses the given fleet load against the configured limit.
+func (b CapacityBreaker) Evaluate(queuedOrders int, freeCouriers int) CapacityAssessment {
+	assessment := CapacityAssessment{
+		QueuedOrders: queuedOrders,
+		FreeCouriers: freeCouriers,
+		MaxRatio:     b.maxRatio,
+	}
+
+	switch {
+	case queuedOrders <= 0:
+		assessment.Ratio = 0
+	case freeCouriers <= 0:
+		assessment.Ratio = math.Inf(1)
+	default:
+		assessment.Ratio = float64(queuedOrders) / float64(freeCouriers)
+	}
+
+	assessment.Exceeded = assessment.Ratio > b.maxRatio
+	return assessment
+}
@@ -0,0 +1,59 @@
+package services_test
+
+import (
+	"math"
+	"testing"
+
+	"delivery/internal/core/domain/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCapacityBreaker(t *testing.T) {
+	t.Run("should create breaker with positive ratio", func(t *testing.T) {
+		breaker, err := services.NewCapacityBreaker(2.5)
+
+		require.NoError(t, err)
+		assert.InDelta(t, 2.5, breaker.MaxRatio(), 0)
+	})
+
+	t.Run("should reject invalid ratios", func(t *testing.T) {
+		for _, ratio := range []float64{0, -1, math.NaN(), math.Inf(1)} {
+			_, err := services.NewCapacityBreaker(ratio)
+
+			require.ErrorIs(t, err, services.ErrCapacityRatioIsInvalid)
+		}
+	})
+}
+
+func TestCapacityBreaker_Evaluate(t *testing.T) {
+	breaker, err := services.NewCapacityBreaker(3)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		queuedOrders int
+		freeCouriers int
+		ratio        float64
+		exceeded     bool
+	}{
+		{"empty queue", 0, 0, 0, false},
+		{"below limit", 4, 2, 2, false},
+		{"at limit", 6, 2, 3, false},
+		{"above limit", 7, 2, 3.5, true},
+		{"no free couriers", 1, 0, math.Inf(1), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assessment := breaker.Evaluate(tt.queuedOrders, tt.freeCouriers)
+
+			assert.Equal(t, tt.queuedOrders, assessment.QueuedOrders)
+			assert.Equal(t, tt.freeCouriers, assessment.FreeCouriers)
+			assert.Equal(t, tt.ratio, assessment.Ratio) //nolint:testifylint // exact values are expected
+			assert.InDelta(t, 3, assessment.MaxRatio, 0)
+			assert.Equal(t, tt.exceeded, assessment.Exceeded)
+		})
+	}
+}
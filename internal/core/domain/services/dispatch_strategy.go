@@ -0,0 +1,335 @@
+package services
+
+import (
+	"errors"
+	"math"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/order"
+)
+
+// bestFitWastePenalty is how many turns of travel one unit of unused storage volume is worth
+// to BestFitStrategy. It keeps large storage places free for large orders without sending
+// a far-away courier to save a little space.
+const bestFitWastePenalty = 0.1
+
+// leastLoadedPlacePenalty is how many turns of travel one occupied storage place is worth
+// to LeastLoadedStrategy. It is longer than any trip across the grid, so the travel time only
+// decides between couriers carrying the same number of orders.
+const leastLoadedPlacePenalty = 1000
+
+// Names of the factors strategies break their scores down into.
+const (
+	// TravelTimeFactor is the time in turns the courier needs to reach the order's location,
+	// calling at the pickup and the waypoints of its route on the way.
+	TravelTimeFactor = "travel_time"
+
+	// StorageWasteFactor is the storage volume the order would leave unused.
+	StorageWasteFactor = "storage_waste"
+
+	// DistanceFactor is the number of grid cells between the courier and the order's next stop.
+	DistanceFactor = "distance"
+
+	// OccupiedPlacesFactor is the number of storage places the courier already carries orders in.
+	OccupiedPlacesFactor = "occupied_places"
+
+	// RemainingVolumeFactor is the storage volume the courier still has free for orders.
+	RemainingVolumeFactor = "remaining_volume"
+)
+
+// ErrDispatchWeightsAreInvalid is returned when dispatch weights are negative, not finite,
+// or all zero.
+var ErrDispatchWeightsAreInvalid = errors.New("dispatch weights must be non-negative and not all zero")
+
+// ScoreFactor is one weighted component of a dispatch score.
+type ScoreFactor struct {
+	Name   string
+	Value  float64
+	Weight float64
+}
+
+// Contribution returns the part of the score the factor accounts for.
+func (f ScoreFactor) Contribution() float64 {
+	return f.Value * f.Weight
+}
+
+// ScoreBreakdown explains a dispatch score as the sum of its weighted factors.
+// Only the factors a strategy actually weighs are listed.
+type ScoreBreakdown struct {
+	Factors []ScoreFactor
+}
+
+// Score returns the sum of the factor contributions.
+func (b ScoreBreakdown) Score() float64 {
+	var score float64
+	for _, factor := range b.Factors {
+		score += factor.Contribution()
+	}
+	return score
+}
+
+// DispatchStrategy scores a courier that is able to take an order; OrderDispatcher assigns
+// the order to the courier with the lowest score. Strategies only rank couriers, capacity
+// checks stay with the dispatcher.
+type DispatchStrategy interface {
+	// Name identifies the strategy in logs and rollout flags.
+	Name() string
+
+	// Score rates how well the courier suits the order; lower is better.
+	Score(order *order.Order, courier *courier.Courier) (float64, error)
+
+	// Explain breaks the score of the courier down into its factors.
+	// The breakdown's Score equals the result of Score.
+	Explain(order *order.Order, courier *courier.Courier) (ScoreBreakdown, error)
+}
+
+// FastestDeliveryStrategy prefers the courier that reaches the order first.
+// It is the strategy OrderDispatcher uses by default.
+type FastestDeliveryStrategy struct{}
+
+// Name returns "fastest-delivery".
+func (FastestDeliveryStrategy) Name() string {
+	return "fastest-delivery"
+}
+
+// Score returns the time in turns the courier needs to reach the order through its remaining stops.
+func (FastestDeliveryStrategy) Score(order *order.Order, courier *courier.Courier) (float64, error) {
+	return courier.CalculateTimeAlongStops(order.RemainingStops())
+}
+
+// Explain returns the travel time as the only factor.
+func (s FastestDeliveryStrategy) Explain(order *order.Order, courier *courier.Courier) (ScoreBreakdown, error) {
+	travelTime, err := s.Score(order, courier)
+	if err != nil {
+		return ScoreBreakdown{}, err
+	}
+
+	return ScoreBreakdown{Factors: []ScoreFactor{
+		{Name: TravelTimeFactor, Value: travelTime, Weight: 1},
+	}}, nil
+}
+
+// BestFitStrategy prefers couriers whose storage place fits the order tightly, trading a
+// little travel time for keeping large storage places free for large orders.
+// The score is the travel time plus bestFitWastePenalty per unit of unused volume
+// in the storage place the courier would put the order into.
+type BestFitStrategy struct{}
+
+// Name returns "best-fit".
+func (BestFitStrategy) Name() string {
+	return "best-fit"
+}
+
+// Score returns the travel time penalized by the storage volume the order would leave unused.
+func (s BestFitStrategy) Score(order *order.Order, courier *courier.Courier) (float64, error) {
+	breakdown, err := s.Explain(order, courier)
+	if err != nil {
+		return 0, err
+	}
+	return breakdown.Score(), nil
+}
+
+// Explain returns the travel time and the unused storage volume weighted by bestFitWastePenalty.
+func (BestFitStrategy) Explain(order *order.Order, courier *courier.Courier) (ScoreBreakdown, error) {
+	travelTime, err := courier.CalculateTimeAlongStops(order.RemainingStops())
+	if err != nil {
+		return ScoreBreakdown{}, err
+	}
+
+	var waste int
+	// Couriers store orders in the first storage place that fits, see Courier.TakeOrder
+	for _, storagePlace := range courier.StoragePlaces() {
+		canStore, storeErr := storagePlace.CanStore(order.Volume())
+		if storeErr != nil {
+			return ScoreBreakdown{}, storeErr
+		}
+		if canStore {
+			waste = storagePlace.TotalVolume() - order.Volume()
+			break
+		}
+	}
+
+	return ScoreBreakdown{Factors: []ScoreFactor{
+		{Name: TravelTimeFactor, Value: travelTime, Weight: 1},
+		{Name: StorageWasteFactor, Value: float64(waste), Weight: bestFitWastePenalty},
+	}}, nil
+}
+
+// GreedyNearestStrategy prefers the courier closest to the order on the grid, ignoring speed
+// and storage. It is the cheap strategy the dispatcher falls back to under load.
+type GreedyNearestStrategy struct{}
+
+// Name returns "greedy-nearest".
+func (GreedyNearestStrategy) Name() string {
+	return "greedy-nearest"
+}
+
+// Score returns the grid distance between the courier and the next stop of the order,
+// which is its pickup for an order with a route.
+func (GreedyNearestStrategy) Score(order *order.Order, courier *courier.Courier) (float64, error) {
+	distance, err := courier.Location().Distance(order.NextStop())
+	if err != nil {
+		return 0, err
+	}
+	return float64(distance), nil
+}
+
+// Explain returns the grid distance as the only factor.
+func (s GreedyNearestStrategy) Explain(order *order.Order, courier *courier.Courier) (ScoreBreakdown, error) {
+	distance, err := s.Score(order, courier)
+	if err != nil {
+		return ScoreBreakdown{}, err
+	}
+
+	return ScoreBreakdown{Factors: []ScoreFactor{
+		{Name: DistanceFactor, Value: distance, Weight: 1},
+	}}, nil
+}
+
+// LeastLoadedStrategy prefers the courier carrying the fewest orders and, among equally
+// loaded couriers, the one that reaches the order first. It spreads the orders of a dispatch
+// pass over the fleet instead of piling them up on the courier closest to the warehouse.
+// Storage places out of service do not count as occupied.
+type LeastLoadedStrategy struct{}
+
+// Name returns "least-loaded".
+func (LeastLoadedStrategy) Name() string {
+	return "least-loaded"
+}
+
+// Score returns the travel time plus leastLoadedPlacePenalty per occupied storage place.
+func (s LeastLoadedStrategy) Score(order *order.Order, courier *courier.Courier) (float64, error) {
+	breakdown, err := s.Explain(order, courier)
+	if err != nil {
+		return 0, err
+	}
+	return breakdown.Score(), nil
+}
+
+// Explain returns the occupied storage places weighted by leastLoadedPlacePenalty and the travel time.
+func (LeastLoadedStrategy) Explain(order *order.Order, courier *courier.Courier) (ScoreBreakdown, error) {
+	travelTime, err := courier.CalculateTimeAlongStops(order.RemainingStops())
+	if err != nil {
+		return ScoreBreakdown{}, err
+	}
+
+	var occupied int
+	for _, storagePlace := range courier.StoragePlaces() {
+		if !storagePlace.IsOutOfService() && storagePlace.OrderID() != nil {
+			occupied++
+		}
+	}
+
+	return ScoreBreakdown{Factors: []ScoreFactor{
+		{Name: OccupiedPlacesFactor, Value: float64(occupied), Weight: leastLoadedPlacePenalty},
+		{Name: TravelTimeFactor, Value: travelTime, Weight: 1},
+	}}, nil
+}
+
+// DispatchWeights are the weights WeightedStrategy combines its factors with.
+type DispatchWeights struct {
+	travelTime      float64
+	occupiedPlaces  float64
+	remainingVolume float64
+}
+
+// NewDispatchWeights creates weights for the travel time in turns, every occupied storage place
+// and every unit of remaining storage volume.
+//
+// Returns ErrDispatchWeightsAreInvalid if a weight is negative or not finite, or all are zero.
+func NewDispatchWeights(travelTime, occupiedPlaces, remainingVolume float64) (DispatchWeights, error) {
+	weights := []float64{travelTime, occupiedPlaces, remainingVolume}
+	allZero := true
+	for _, weight := range weights {
+		if weight < 0 || math.IsNaN(weight) || math.IsInf(weight, 0) {
+			return DispatchWeights{}, ErrDispatchWeightsAreInvalid
+		}
+		if weight != 0 {
+			allZero = false
+		}
+	}
+	if allZero {
+		return DispatchWeights{}, ErrDispatchWeightsAreInvalid
+	}
+
+	return DispatchWeights{
+		travelTime:      travelTime,
+		occupiedPlaces:  occupiedPlaces,
+		remainingVolume: remainingVolume,
+	}, nil
+}
+
+// TravelTime returns the weight of one turn of travel.
+func (w DispatchWeights) TravelTime() float64 {
+	return w.travelTime
+}
+
+// OccupiedPlaces returns the weight of one occupied storage place.
+func (w DispatchWeights) OccupiedPlaces() float64 {
+	return w.occupiedPlaces
+}
+
+// RemainingVolume returns the weight of one unit of remaining storage volume.
+func (w DispatchWeights) RemainingVolume() float64 {
+	return w.remainingVolume
+}
+
+// ConsidersLoad reports whether the weights take the load of the courier into account.
+// Weights that only weigh the travel time rank couriers like FastestDeliveryStrategy.
+func (w DispatchWeights) ConsidersLoad() bool {
+	return w.occupiedPlaces != 0 || w.remainingVolume != 0
+}
+
+// WeightedStrategy balances the travel time against the load of the courier, so orders on the
+// far side of the grid are not left waiting while nearby couriers pile up orders. The score is
+// the travel time and the occupied storage places weighted up, minus the remaining storage volume
+// weighted down: a loaded courier loses orders to a free one that is a little farther away.
+// Storage places out of service count neither as occupied nor as remaining volume.
+type WeightedStrategy struct {
+	weights DispatchWeights
+}
+
+// NewWeightedStrategy creates a strategy combining its factors with weights.
+func NewWeightedStrategy(weights DispatchWeights) WeightedStrategy {
+	return WeightedStrategy{weights: weights}
+}
+
+// Name returns "weighted".
+func (WeightedStrategy) Name() string {
+	return "weighted"
+}
+
+// Score returns the weighted sum of the travel time, the occupied places and the remaining volume.
+func (s WeightedStrategy) Score(order *order.Order, courier *courier.Courier) (float64, error) {
+	breakdown, err := s.Explain(order, courier)
+	if err != nil {
+		return 0, err
+	}
+	return breakdown.Score(), nil
+}
+
+// Explain returns the travel time, the occupied places and the remaining volume of the courier
+// before it takes the order. The remaining volume has a negative weight, as it lowers the score.
+func (s WeightedStrategy) Explain(order *order.Order, courier *courier.Courier) (ScoreBreakdown, error) {
+	travelTime, err := courier.CalculateTimeAlongStops(order.RemainingStops())
+	if err != nil {
+		return ScoreBreakdown{}, err
+	}
+
+	var occupied, remaining int
+	for _, storagePlace := range courier.StoragePlaces() {
+		switch {
+		case storagePlace.IsOutOfService():
+		case storagePlace.OrderID() != nil:
+			occupied++
+		default:
+			remaining += storagePlace.TotalVolume()
+		}
+	}
+
+	return ScoreBreakdown{Factors: []ScoreFactor{
+		{Name: TravelTimeFactor, Value: travelTime, Weight: s.weights.travelTime},
+		{Name: OccupiedPlacesFactor, Value: float64(occupied), Weight: s.weights.occupiedPlaces},
+		{Name: RemainingVolumeFactor, Value: float64(remaining), Weight: -s.weights.remainingVolume},
+	}}, nil
+}
@@ -0,0 +1,285 @@
+package services_test
+
+import (
+	"math"
+	"testing"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCourierWithTrunk creates a courier with the default bag and an additional trunk.
+func newCourierWithTrunk(t *testing.T, x, y kernel.Coordinate, trunkVolume int) *courier.Courier {
+	t.Helper()
+
+	location, err := kernel.NewLocation(x, y)
+	require.NoError(t, err)
+	c, err := courier.NewCourier(kernel.NewUUID(), "Courier", 1, location)
+	require.NoError(t, err)
+	require.NoError(t, c.AddStoragePlace("Trunk", trunkVolume))
+	return c
+}
+
+func TestDispatchStrategies(t *testing.T) {
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	newOrder := func() *order.Order {
+		o, err := order.NewOrder(kernel.NewUUID(), orderLocation, 20)
+		require.NoError(t, err)
+		return o
+	}
+
+	// Near courier wastes 80 units of a large trunk, far courier wastes 5
+	near := newCourierWithTrunk(t, 5, 6, 100)
+	far := newCourierWithTrunk(t, 5, 8, 25)
+
+	t.Run("fastest delivery scores travel time", func(t *testing.T) {
+		score, err := services.FastestDeliveryStrategy{}.Score(newOrder(), near)
+
+		require.NoError(t, err)
+		assert.InDelta(t, 1.0, score, 0.0001)
+	})
+
+	t.Run("best fit adds the unused storage volume", func(t *testing.T) {
+		score, err := services.BestFitStrategy{}.Score(newOrder(), near)
+
+		require.NoError(t, err)
+		assert.InDelta(t, 9.0, score, 0.0001)
+	})
+
+	t.Run("best fit explains travel time and storage waste", func(t *testing.T) {
+		breakdown, err := services.BestFitStrategy{}.Explain(newOrder(), near)
+
+		require.NoError(t, err)
+		require.Len(t, breakdown.Factors, 2)
+		assert.Equal(t, services.TravelTimeFactor, breakdown.Factors[0].Name)
+		assert.InDelta(t, 1.0, breakdown.Factors[0].Contribution(), 0.0001)
+		assert.Equal(t, services.StorageWasteFactor, breakdown.Factors[1].Name)
+		assert.InDelta(t, 80.0, breakdown.Factors[1].Value, 0.0001)
+		assert.InDelta(t, 9.0, breakdown.Score(), 0.0001)
+	})
+
+	t.Run("strategies pick different couriers", func(t *testing.T) {
+		couriers := []*courier.Courier{near, far}
+
+		fastest, _, err := services.NewOrderDispatcher().Select(newOrder(), couriers)
+		require.NoError(t, err)
+		assert.True(t, fastest.IsEqual(near))
+
+		bestFit, score, err := services.NewOrderDispatcherWithStrategy(services.BestFitStrategy{}).
+			Select(newOrder(), couriers)
+		require.NoError(t, err)
+		assert.True(t, bestFit.IsEqual(far))
+		assert.InDelta(t, 3.5, score, 0.0001)
+	})
+
+	t.Run("dispatch explains every candidate", func(t *testing.T) {
+		o := newOrder()
+		full := newCourierWithTrunk(t, 5, 5, 25)
+		require.NoError(t, full.TakeOrder(newOrder()))
+
+		assigned, explanation, err := services.NewOrderDispatcherWithStrategy(services.BestFitStrategy{}).
+			DispatchExplained(o, []*courier.Courier{near, full, far})
+
+		require.NoError(t, err)
+		assert.True(t, assigned.IsEqual(far))
+		assert.Equal(t, "best-fit", explanation.Strategy)
+		assert.Equal(t, far.ID(), explanation.CourierID)
+		assert.InDelta(t, 3.5, explanation.Score, 0.0001)
+		require.Len(t, explanation.Candidates, 2)
+		assert.Equal(t, near.ID(), explanation.Candidates[0].CourierID)
+		assert.InDelta(t, 9.0, explanation.Candidates[0].Breakdown.Score(), 0.0001)
+		assert.Equal(t, far.ID(), explanation.Candidates[1].CourierID)
+	})
+
+	t.Run("greedy nearest ignores courier speed", func(t *testing.T) {
+		slowLocation, _ := kernel.NewLocation(5, 7)
+		slow, err := courier.NewCourier(kernel.NewUUID(), "Slow", 1, slowLocation)
+		require.NoError(t, err)
+		fastLocation, _ := kernel.NewLocation(5, 8)
+		fast, err := courier.NewCourier(kernel.NewUUID(), "Fast", 3, fastLocation)
+		require.NoError(t, err)
+		require.NoError(t, slow.AddStoragePlace("Trunk", 25))
+		require.NoError(t, fast.AddStoragePlace("Trunk", 25))
+		couriers := []*courier.Courier{slow, fast}
+
+		fastest, _, err := services.NewOrderDispatcher().Select(newOrder(), couriers)
+		require.NoError(t, err)
+		assert.True(t, fastest.IsEqual(fast))
+
+		nearest, score, err := services.NewOrderDispatcherWithStrategy(services.GreedyNearestStrategy{}).
+			Select(newOrder(), couriers)
+		require.NoError(t, err)
+		assert.True(t, nearest.IsEqual(slow))
+		assert.InDelta(t, 2.0, score, 0.0001)
+
+		breakdown, err := services.GreedyNearestStrategy{}.Explain(newOrder(), slow)
+		require.NoError(t, err)
+		require.Len(t, breakdown.Factors, 1)
+		assert.Equal(t, services.DistanceFactor, breakdown.Factors[0].Name)
+	})
+
+	t.Run("select leaves order and couriers unchanged", func(t *testing.T) {
+		o := newOrder()
+
+		_, _, err := services.NewOrderDispatcher().Select(o, []*courier.Courier{near})
+
+		require.NoError(t, err)
+		assert.Equal(t, order.Created, o.Status())
+		canTake, err := near.CanTakeOrder(o)
+		require.NoError(t, err)
+		assert.True(t, canTake)
+	})
+
+	t.Run("orders with a route are reached through the pickup", func(t *testing.T) {
+		pickup, _ := kernel.NewLocation(5, 1)
+		route, err := order.NewRoute(pickup, orderLocation)
+		require.NoError(t, err)
+		routed, err := order.NewOrderWithRoute(kernel.NewUUID(), route, 20)
+		require.NoError(t, err)
+		atPickup := newCourierWithTrunk(t, 5, 2, 100)
+
+		// near: 5,6 -> 5,1 -> 5,5 is 5 + 4 cells; atPickup: 5,2 -> 5,1 -> 5,5 is 1 + 4 cells
+		score, err := services.FastestDeliveryStrategy{}.Score(routed, near)
+		require.NoError(t, err)
+		assert.InDelta(t, 9.0, score, 0.0001)
+
+		selected, score, err := services.NewOrderDispatcher().Select(routed, []*courier.Courier{near, atPickup})
+		require.NoError(t, err)
+		assert.True(t, selected.IsEqual(atPickup))
+		assert.InDelta(t, 5.0, score, 0.0001)
+
+		distance, err := services.GreedyNearestStrategy{}.Score(routed, atPickup)
+		require.NoError(t, err)
+		assert.InDelta(t, 1.0, distance, 0.0001)
+	})
+
+	t.Run("default dispatcher uses fastest delivery", func(t *testing.T) {
+		assert.Equal(t, "fastest-delivery", services.OrderDispatcher{}.Strategy().Name())
+		assert.Equal(t, "best-fit",
+			services.NewOrderDispatcherWithStrategy(services.BestFitStrategy{}).Strategy().Name())
+	})
+}
+
+func TestWeightedStrategy(t *testing.T) {
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	newOrder := func(volume int) *order.Order {
+		o, err := order.NewOrder(kernel.NewUUID(), orderLocation, volume)
+		require.NoError(t, err)
+		return o
+	}
+
+	// Near courier carries an order in its trunk, far courier carries nothing
+	loaded := newCourierWithTrunk(t, 5, 6, 25)
+	require.NoError(t, loaded.TakeOrder(newOrder(20)))
+	free := newCourierWithTrunk(t, 5, 8, 25)
+
+	weights, err := services.NewDispatchWeights(1, 5, 0.1)
+	require.NoError(t, err)
+	strategy := services.NewWeightedStrategy(weights)
+
+	t.Run("explains travel time, occupied places and remaining volume", func(t *testing.T) {
+		breakdown, err := strategy.Explain(newOrder(5), loaded)
+
+		require.NoError(t, err)
+		require.Len(t, breakdown.Factors, 3)
+		assert.Equal(t, services.TravelTimeFactor, breakdown.Factors[0].Name)
+		assert.InDelta(t, 1.0, breakdown.Factors[0].Contribution(), 0.0001)
+		assert.Equal(t, services.OccupiedPlacesFactor, breakdown.Factors[1].Name)
+		assert.InDelta(t, 1.0, breakdown.Factors[1].Value, 0.0001)
+		assert.Equal(t, services.RemainingVolumeFactor, breakdown.Factors[2].Name)
+		assert.InDelta(t, 10.0, breakdown.Factors[2].Value, 0.0001)
+		assert.InDelta(t, -1.0, breakdown.Factors[2].Contribution(), 0.0001)
+		assert.InDelta(t, 5.0, breakdown.Score(), 0.0001)
+	})
+
+	t.Run("prefers a free courier a little farther away", func(t *testing.T) {
+		couriers := []*courier.Courier{loaded, free}
+
+		fastest, _, err := services.NewOrderDispatcher().Select(newOrder(5), couriers)
+		require.NoError(t, err)
+		assert.True(t, fastest.IsEqual(loaded))
+
+		weighted, score, err := services.NewOrderDispatcherWithStrategy(strategy).Select(newOrder(5), couriers)
+		require.NoError(t, err)
+		assert.True(t, weighted.IsEqual(free))
+		assert.InDelta(t, -0.5, score, 0.0001)
+	})
+
+	t.Run("ranks like fastest delivery without load weights", func(t *testing.T) {
+		travelOnly, err := services.NewDispatchWeights(1, 0, 0)
+		require.NoError(t, err)
+		assert.False(t, travelOnly.ConsidersLoad())
+
+		selected, _, err := services.NewOrderDispatcherWithStrategy(services.NewWeightedStrategy(travelOnly)).
+			Select(newOrder(5), []*courier.Courier{loaded, free})
+		require.NoError(t, err)
+		assert.True(t, selected.IsEqual(loaded))
+	})
+}
+
+func TestNewDispatchWeights_Invalid(t *testing.T) {
+	tests := map[string][3]float64{
+		"negative weight": {1, -1, 0},
+		"all zero":        {0, 0, 0},
+		"not a number":    {math.NaN(), 1, 1},
+		"infinite weight": {1, math.Inf(1), 0},
+	}
+
+	for name, weights := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := services.NewDispatchWeights(weights[0], weights[1], weights[2])
+
+			require.ErrorIs(t, err, services.ErrDispatchWeightsAreInvalid)
+		})
+	}
+}
+
+func TestLeastLoadedStrategy(t *testing.T) {
+	orderLocation, _ := kernel.NewLocation(5, 5)
+	newOrder := func(volume int) *order.Order {
+		o, err := order.NewOrder(kernel.NewUUID(), orderLocation, volume)
+		require.NoError(t, err)
+		return o
+	}
+
+	// Near courier carries an order in its trunk, far courier carries nothing
+	loaded := newCourierWithTrunk(t, 5, 6, 25)
+	require.NoError(t, loaded.TakeOrder(newOrder(20)))
+	free := newCourierWithTrunk(t, 5, 9, 25)
+
+	t.Run("explains occupied places and travel time", func(t *testing.T) {
+		breakdown, err := services.LeastLoadedStrategy{}.Explain(newOrder(5), loaded)
+
+		require.NoError(t, err)
+		require.Len(t, breakdown.Factors, 2)
+		assert.Equal(t, services.OccupiedPlacesFactor, breakdown.Factors[0].Name)
+		assert.InDelta(t, 1.0, breakdown.Factors[0].Value, 0.0001)
+		assert.Equal(t, services.TravelTimeFactor, breakdown.Factors[1].Name)
+		assert.InDelta(t, 1.0, breakdown.Factors[1].Contribution(), 0.0001)
+		assert.InDelta(t, 1001.0, breakdown.Score(), 0.0001)
+	})
+
+	t.Run("prefers the free courier however far away", func(t *testing.T) {
+		selected, score, err := services.NewOrderDispatcherWithStrategy(services.LeastLoadedStrategy{}).
+			Select(newOrder(5), []*courier.Courier{loaded, free})
+
+		require.NoError(t, err)
+		assert.True(t, selected.IsEqual(free))
+		assert.InDelta(t, 4.0, score, 0.0001)
+	})
+
+	t.Run("prefers the nearest among equally loaded couriers", func(t *testing.T) {
+		nearFree := newCourierWithTrunk(t, 5, 7, 25)
+
+		selected, _, err := services.NewOrderDispatcherWithStrategy(services.LeastLoadedStrategy{}).
+			Select(newOrder(5), []*courier.Courier{free, nearFree})
+
+		require.NoError(t, err)
+		assert.True(t, selected.IsEqual(nearFree))
+	})
+}
@@ -4,6 +4,11 @@
 //
 // The package includes:
 //   - OrderDispatcher: A domain service for finding and assigning couriers to orders
+//   - DispatchStrategy: Interchangeable courier ranking used by OrderDispatcher
+//   - AssignmentExplanation: The per-factor score breakdown behind a dispatch decision
+//   - CapacityBreaker: A domain service that detects when demand outgrows the free fleet
+//   - FleetSimulator: A domain service that replays order arrivals against a fleet to project its service
+//   - OrderRelay: A domain service that hands an order over between couriers on the way (relay delivery)
 //
 // Domain services coordinate between aggregates, implementing business logic that
 // spans multiple bounded contexts following Domain-Driven Design principles.
@@ -0,0 +1,209 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+)
+
+// SimulationTick is the simulated time of a courier turn: couriers make one move per tick,
+// as they do in the courier movement job.
+const SimulationTick = time.Second
+
+// simulatedOrderVolume is the volume of a simulated order; it fits any storage place.
+const simulatedOrderVolume = 1
+
+// ErrSimulationPeriodIsInvalid is returned when the simulated period does not end after it starts.
+var ErrSimulationPeriodIsInvalid = errors.New("simulation period must end after it starts")
+
+// Arrival is an order coming in at a moment, to be delivered to a location.
+type Arrival struct {
+	At       time.Time
+	Location kernel.Location
+}
+
+// SimulationOutcome summarizes how a fleet coped with the arrivals of a period.
+type SimulationOutcome struct {
+	// Couriers is the size of the simulated fleet.
+	Couriers int
+	// Orders is the number of orders that came in during the period.
+	Orders int
+	// Delivered is the number of those orders delivered, during the period or while draining after it.
+	Delivered int
+	// AverageDeliveryTime is the mean time from arrival to delivery of the delivered orders.
+	AverageDeliveryTime time.Duration
+	// AverageBacklog is the mean number of orders waiting for a courier over the ticks of the period.
+	AverageBacklog float64
+	// PeakBacklog is the largest number of orders waiting for a courier during the period.
+	PeakBacklog int
+}
+
+// Undelivered returns the number of orders the fleet did not deliver, even while draining.
+func (o SimulationOutcome) Undelivered() int {
+	return o.Orders - o.Delivered
+}
+
+// FleetSimulator is a domain service that replays order arrivals against a fleet, tick by tick,
+// with the same dispatching and movement rules as the live service.
+//
+// Business rules:
+//   - Every tick, waiting orders are dispatched first come, first served to couriers without orders
+//   - Couriers move towards their order once per tick and deliver it on arrival
+//   - A simulated courier carries one order at a time
+//   - After the period the fleet keeps delivering the orders left for at most another period
+//
+// Example usage:
+//
+//	simulator := NewFleetSimulator(NewOrderDispatcher())
+//	outcome, err := simulator.Simulate(fleet, arrivals, from, to)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(outcome.AverageDeliveryTime, outcome.PeakBacklog)
+type FleetSimulator struct {
+	dispatcher OrderDispatcher
+}
+
+// NewFleetSimulator creates a simulator that assigns orders with the given dispatcher.
+func NewFleetSimulator(dispatcher OrderDispatcher) FleetSimulator {
+	return FleetSimulator{dispatcher: dispatcher}
+}
+
+// simulatedDelivery is an order on its way with a courier.
+type simulatedDelivery struct {
+	order     *order.Order
+	courier   *courier.Courier
+	arrivedAt time.Time
+}
+
+// queuedOrder is an order waiting for a courier.
+type queuedOrder struct {
+	order     *order.Order
+	arrivedAt time.Time
+}
+
+// Simulate replays the arrivals within [from, to) against the fleet. The couriers are moved and
+// loaded by the simulation, so the caller passes couriers of its own that carry no orders.
+//
+// Returns ErrSimulationPeriodIsInvalid if the period is empty, or a validation error for an
+// invalid courier or arrival.
+func (s FleetSimulator) Simulate(
+	fleet []*courier.Courier,
+	arrivals []Arrival,
+	from time.Time,
+	to time.Time,
+) (SimulationOutcome, error) {
+	if !from.Before(to) {
+		return SimulationOutcome{}, ErrSimulationPeriodIsInvalid
+	}
+
+	incoming := make([]Arrival, 0, len(arrivals))
+	for _, arrival := range arrivals {
+		if !arrival.At.Before(from) && arrival.At.Before(to) {
+			incoming = append(incoming, arrival)
+		}
+	}
+	slices.SortStableFunc(incoming, func(a, b Arrival) int { return a.At.Compare(b.At) })
+
+	var (
+		outcome     = SimulationOutcome{Couriers: len(fleet), Orders: len(incoming)}
+		free        = slices.Clone(fleet)
+		queue       []queuedOrder
+		enRoute     []simulatedDelivery
+		next        int
+		backlog     int64
+		deliverySum time.Duration
+		drainUntil  = to.Add(to.Sub(from))
+	)
+
+	for now := from; now.Before(drainUntil); now = now.Add(SimulationTick) {
+		if len(queue) == 0 && len(enRoute) == 0 {
+			if next == len(incoming) {
+				break
+			}
+			// Nothing happens until the next arrival, and an empty queue adds nothing to the backlog
+			if skip := incoming[next].At.Sub(now).Truncate(SimulationTick); skip > 0 {
+				now = now.Add(skip)
+			}
+		}
+
+		for ; next < len(incoming) && !incoming[next].At.After(now); next++ {
+			o, err := order.NewOrder(kernel.NewUUID(), incoming[next].Location, simulatedOrderVolume)
+			if err != nil {
+				return SimulationOutcome{}, fmt.Errorf("arrival at %s: %w", incoming[next].At.Format(time.RFC3339), err)
+			}
+			queue = append(queue, queuedOrder{order: o, arrivedAt: incoming[next].At})
+		}
+
+		for len(queue) > 0 && len(free) > 0 {
+			c, err := s.dispatcher.Dispatch(queue[0].order, free)
+			if errors.Is(err, ErrCourierNotFound) {
+				break
+			}
+			if err != nil {
+				return SimulationOutcome{}, err
+			}
+
+			enRoute = append(enRoute, simulatedDelivery{order: queue[0].order, courier: c, arrivedAt: queue[0].arrivedAt})
+			queue = queue[1:]
+			free = slices.DeleteFunc(free, func(candidate *courier.Courier) bool { return candidate == c })
+		}
+
+		if now.Before(to) {
+			backlog += int64(len(queue))
+			outcome.PeakBacklog = max(outcome.PeakBacklog, len(queue))
+		}
+
+		deliveredAt := now.Add(SimulationTick)
+		moving := enRoute[:0]
+		for _, d := range enRoute {
+			delivered, err := moveSimulated(d)
+			if err != nil {
+				return SimulationOutcome{}, err
+			}
+			if !delivered {
+				moving = append(moving, d)
+				continue
+			}
+
+			free = append(free, d.courier)
+			outcome.Delivered++
+			deliverySum += deliveredAt.Sub(d.arrivedAt)
+		}
+		enRoute = moving
+	}
+
+	if outcome.Delivered > 0 {
+		outcome.AverageDeliveryTime = deliverySum / time.Duration(outcome.Delivered)
+	}
+	if ticks := int64(to.Sub(from) / SimulationTick); ticks > 0 {
+		outcome.AverageBacklog = float64(backlog) / float64(ticks)
+	}
+	return outcome, nil
+}
+
+// moveSimulated makes the courier's turn towards the order and delivers it on arrival.
+// Reports whether the order was delivered.
+func moveSimulated(d simulatedDelivery) (bool, error) {
+	if err := d.courier.Move(d.order.NextStop()); err != nil {
+		return false, err
+	}
+
+	arrived, err := d.order.ReachStop(d.courier.Location())
+	if err != nil || !arrived {
+		return false, err
+	}
+
+	if err = d.order.Complete(); err != nil {
+		return false, err
+	}
+	if err = d.courier.CompleteOrder(d.order.ID()); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,101 @@
+package services_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSimulatedCourier(t *testing.T, x, y kernel.Coordinate, speed int) *courier.Courier {
+	t.Helper()
+	location, err := kernel.NewLocation(x, y)
+	require.NoError(t, err)
+	c, err := courier.NewCourier(kernel.NewUUID(), "Courier", speed, location)
+	require.NoError(t, err)
+	return c
+}
+
+func newArrival(t *testing.T, at time.Time, x, y kernel.Coordinate) services.Arrival {
+	t.Helper()
+	location, err := kernel.NewLocation(x, y)
+	require.NoError(t, err)
+	return services.Arrival{At: at, Location: location}
+}
+
+func TestFleetSimulator_Simulate(t *testing.T) {
+	simulator := services.NewFleetSimulator(services.NewOrderDispatcher())
+	from := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	to := from.Add(time.Minute)
+
+	t.Run("should deliver orders with the travel time of the courier", func(t *testing.T) {
+		fleet := []*courier.Courier{newSimulatedCourier(t, 1, 1, 1)}
+		arrivals := []services.Arrival{newArrival(t, from.Add(10*time.Second), 4, 1)}
+
+		outcome, err := simulator.Simulate(fleet, arrivals, from, to)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, outcome.Couriers)
+		assert.Equal(t, 1, outcome.Orders)
+		assert.Equal(t, 1, outcome.Delivered)
+		assert.Equal(t, 3*time.Second, outcome.AverageDeliveryTime)
+		assert.Zero(t, outcome.PeakBacklog)
+		assert.Zero(t, outcome.Undelivered())
+	})
+
+	t.Run("should queue orders while every courier is busy", func(t *testing.T) {
+		fleet := []*courier.Courier{newSimulatedCourier(t, 1, 1, 1)}
+		arrivals := []services.Arrival{
+			newArrival(t, from, 3, 1),
+			newArrival(t, from, 1, 1),
+		}
+
+		outcome, err := simulator.Simulate(fleet, arrivals, from, to)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, outcome.Delivered)
+		assert.Equal(t, 1, outcome.PeakBacklog)
+		assert.Positive(t, outcome.AverageBacklog)
+	})
+
+	t.Run("should deliver faster and queue less with more couriers", func(t *testing.T) {
+		arrivals := make([]services.Arrival, 0, 10)
+		for i := range 10 {
+			arrivals = append(arrivals, newArrival(t, from.Add(time.Duration(i)*time.Second), 10, 10))
+		}
+
+		baseline, err := simulator.Simulate(
+			[]*courier.Courier{newSimulatedCourier(t, 1, 1, 2)}, arrivals, from, to)
+		require.NoError(t, err)
+		projected, err := simulator.Simulate(
+			[]*courier.Courier{newSimulatedCourier(t, 1, 1, 2), newSimulatedCourier(t, 9, 9, 2)}, arrivals, from, to)
+		require.NoError(t, err)
+
+		assert.Less(t, projected.AverageDeliveryTime, baseline.AverageDeliveryTime)
+		assert.Less(t, projected.AverageBacklog, baseline.AverageBacklog)
+	})
+
+	t.Run("should leave orders undelivered without couriers", func(t *testing.T) {
+		arrivals := []services.Arrival{newArrival(t, from, 3, 3), newArrival(t, to, 3, 3)}
+
+		outcome, err := simulator.Simulate(nil, arrivals, from, to)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, outcome.Orders, "arrivals outside the period are ignored")
+		assert.Equal(t, 1, outcome.Undelivered())
+		assert.Equal(t, 1, outcome.PeakBacklog)
+		assert.InDelta(t, 1, outcome.AverageBacklog, 0)
+		assert.Zero(t, outcome.AverageDeliveryTime)
+	})
+
+	t.Run("should reject an empty period", func(t *testing.T) {
+		_, err := simulator.Simulate(nil, nil, from, from)
+
+		require.ErrorIs(t, err, services.ErrSimulationPeriodIsInvalid)
+	})
+}
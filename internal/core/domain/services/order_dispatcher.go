@@ -5,6 +5,7 @@ import (
 	"math"
 
 	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
 )
 
@@ -13,8 +14,25 @@ import (
 // can accommodate the order due to capacity constraints or availability issues.
 var ErrCourierNotFound = errors.New("courier not found")
 
+// CandidateScore is the score breakdown of a courier that is able to take the order.
+type CandidateScore struct {
+	CourierID kernel.UUID
+	Breakdown ScoreBreakdown
+}
+
+// AssignmentExplanation tells why the dispatcher selected a courier: the strategy it ranked
+// with and the score breakdown of every courier that could take the order, in the order the
+// couriers were considered. Couriers without room for the order are not listed.
+type AssignmentExplanation struct {
+	Strategy   string
+	CourierID  kernel.UUID
+	Score      float64
+	Candidates []CandidateScore
+}
+
 // OrderDispatcher is a domain service responsible for finding and assigning the optimal courier
-// for a delivery order based on shortest delivery time.
+// for a delivery order. Couriers are ranked by a DispatchStrategy, by default the shortest
+// delivery time (FastestDeliveryStrategy).
 //
 // Key responsibilities:
 //   - Validating orders before dispatch
@@ -43,7 +61,9 @@ var ErrCourierNotFound = errors.New("courier not found")
 //	    return
 //	}
 //	// Order successfully assigned to assignedCourier
-type OrderDispatcher struct{}
+type OrderDispatcher struct {
+	strategy DispatchStrategy
+}
 
 // NewOrderDispatcher creates a new OrderDispatcher instance.
 //
@@ -53,6 +73,38 @@ func NewOrderDispatcher() OrderDispatcher {
 	return OrderDispatcher{}
 }
 
+// NewOrderDispatcherWithStrategy creates an OrderDispatcher that ranks couriers with the given strategy.
+func NewOrderDispatcherWithStrategy(strategy DispatchStrategy) OrderDispatcher {
+	return OrderDispatcher{strategy: strategy}
+}
+
+// Strategy returns the strategy the dispatcher ranks couriers with.
+//
+//nolint:ireturn // strategies are interchangeable by design
+func (o OrderDispatcher) Strategy() DispatchStrategy {
+	if o.strategy == nil {
+		return FastestDeliveryStrategy{}
+	}
+	return o.strategy
+}
+
+// Select finds the courier the dispatcher would assign the order to, together with its score,
+// without changing the order or any courier. Use it to compare strategies side by side.
+//
+// Returns ErrCourierNotFound if none of the couriers can take the order.
+func (o OrderDispatcher) Select(order *order.Order, couriers []*courier.Courier) (*courier.Courier, float64, error) {
+	if err := order.Validate(); err != nil {
+		return nil, 0, err
+	}
+
+	bestCourier, explanation, err := o.findBestCourier(order, couriers)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return bestCourier, explanation.Score, nil
+}
+
 // Dispatch finds the optimal courier for a given order and executes the assignment workflow.
 //
 // Parameters:
@@ -69,28 +121,38 @@ func NewOrderDispatcher() OrderDispatcher {
 //   - Selects courier with minimum delivery time
 //   - Assigns order to selected courier atomically
 func (o OrderDispatcher) Dispatch(order *order.Order, couriers []*courier.Courier) (*courier.Courier, error) {
+	bestCourier, _, err := o.DispatchExplained(order, couriers)
+	return bestCourier, err
+}
+
+// DispatchExplained assigns the order like Dispatch and also returns the score breakdown
+// of every candidate, so the decision can be explained later.
+func (o OrderDispatcher) DispatchExplained(
+	order *order.Order,
+	couriers []*courier.Courier,
+) (*courier.Courier, AssignmentExplanation, error) {
 	if err := order.Validate(); err != nil {
-		return nil, err
+		return nil, AssignmentExplanation{}, err
 	}
 
 	if err := order.ValidateAssign(); err != nil {
-		return nil, err
+		return nil, AssignmentExplanation{}, err
 	}
 
-	bestCourier, err := o.findBestCourier(order, couriers)
+	bestCourier, explanation, err := o.findBestCourier(order, couriers)
 	if err != nil {
-		return nil, err
+		return nil, AssignmentExplanation{}, err
 	}
 
 	if err = bestCourier.TakeOrder(order); err != nil {
-		return nil, err
+		return nil, AssignmentExplanation{}, err
 	}
 
 	if err = order.Assign(bestCourier.ID()); err != nil {
-		return nil, err
+		return nil, AssignmentExplanation{}, err
 	}
 
-	return bestCourier, nil
+	return bestCourier, explanation, nil
 }
 
 // findBestCourier searches through the provided couriers to find the optimal one for the given order.
@@ -100,48 +162,57 @@ func (o OrderDispatcher) Dispatch(order *order.Order, couriers []*courier.Courie
 //   - couriers: Slice of available couriers to evaluate
 //
 // Returns:
-//   - *courier.Courier: The best courier based on delivery time
+//   - *courier.Courier: The best courier according to the strategy
+//   - AssignmentExplanation: The score breakdown of every courier able to take the order
 //   - error: ErrCourierNotFound if no suitable courier exists, or validation errors
 //
 // Selection criteria:
 //   - Validates courier construction
 //   - Checks courier capacity for the order
-//   - Optimizes for minimum delivery time
+//   - Optimizes for minimum strategy score (delivery time by default)
 //   - Returns first courier in case of ties
-func (o OrderDispatcher) findBestCourier(order *order.Order, couriers []*courier.Courier) (*courier.Courier, error) {
+func (o OrderDispatcher) findBestCourier(
+	order *order.Order,
+	couriers []*courier.Courier,
+) (*courier.Courier, AssignmentExplanation, error) {
 	var (
+		strategy    = o.Strategy()
 		bestCourier *courier.Courier
-		bestTime    = math.MaxFloat64
+		bestScore   = math.MaxFloat64
+		explanation = AssignmentExplanation{Strategy: strategy.Name()}
 	)
 
 	for _, c := range couriers {
 		if err := c.Validate(); err != nil {
-			return nil, err
+			return nil, AssignmentExplanation{}, err
 		}
 
 		freeCourier, err := c.CanTakeOrder(order)
 		if err != nil {
-			return nil, err
+			return nil, AssignmentExplanation{}, err
 		}
 
 		if !freeCourier {
 			continue
 		}
 
-		tm, err := c.CalculateTimeToLocation(order.Location())
+		breakdown, err := strategy.Explain(order, c)
 		if err != nil {
-			return nil, err
+			return nil, AssignmentExplanation{}, err
 		}
+		explanation.Candidates = append(explanation.Candidates, CandidateScore{CourierID: c.ID(), Breakdown: breakdown})
 
-		if tm < bestTime {
-			bestTime = tm
+		if score := breakdown.Score(); score < bestScore {
+			bestScore = score
 			bestCourier = c
 		}
 	}
 
 	if bestCourier == nil {
-		return nil, ErrCourierNotFound
+		return nil, AssignmentExplanation{}, ErrCourierNotFound
 	}
 
-	return bestCourier, nil
+	explanation.CourierID = bestCourier.ID()
+	explanation.Score = bestScore
+	return bestCourier, explanation, nil
 }
@@ -0,0 +1,131 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/relay"
+)
+
+var (
+	// ErrOrderIsNotCarriedByCourier is returned when the courier handing an order over does not carry it.
+	ErrOrderIsNotCarriedByCourier = errors.New("order is not carried by the courier")
+
+	// ErrCouriersAreApart is returned when the couriers of a handover are not at the same location.
+	ErrCouriersAreApart = errors.New("couriers must be at the same location to hand an order over")
+
+	// ErrCourierCannotReceiveOrder is returned when the receiving courier is not working:
+	// deactivated, off shift or on a break.
+	ErrCourierCannotReceiveOrder = errors.New("courier cannot receive orders")
+)
+
+// OrderRelay is a domain service that hands an order over from the courier carrying it to
+// another courier on the way to the customer (relay delivery).
+//
+// Business rules:
+//   - Only an assigned order can be handed over, by the courier who carries it
+//   - The couriers meet: both are at the same location
+//   - The receiving courier is another courier who is Available or Busy
+//   - The order goes into the storage place the receiving courier chose, which must be able to
+//     hold it; insured orders only go to insured couriers
+//   - The order is assigned to the receiving courier and its estimated arrival is cleared
+//
+// Example usage:
+//
+//	relayService := NewOrderRelay()
+//	handover, err := relayService.HandOver(o, giver, receiver, backpackID, time.Now())
+//	if errors.Is(err, ErrCouriersAreApart) {
+//	    // The couriers have not met yet
+//	}
+type OrderRelay struct{}
+
+// NewOrderRelay creates a new OrderRelay instance.
+func NewOrderRelay() OrderRelay {
+	return OrderRelay{}
+}
+
+// HandOver moves the order from the storage place of the giving courier into the given storage
+// place of the receiving courier and assigns the order to the receiving courier. Returns the
+// handover record, stamped with now and the location where the couriers met.
+//
+// Returns order.ErrOrderIsNotAssigned for an order no courier is delivering,
+// ErrOrderIsNotCarriedByCourier if the giver does not carry the order,
+// relay.ErrHandoverToSameCourier if giver and receiver are the same courier,
+// ErrCourierCannotReceiveOrder if the receiver is not working, ErrCouriersAreApart if the couriers
+// are not at the same location, or the error of courier.TakeOrderInto if the storage place cannot
+// hold the order. The aggregates are left unchanged on all of these errors.
+func (OrderRelay) HandOver(
+	o *order.Order,
+	giver *courier.Courier,
+	receiver *courier.Courier,
+	storagePlaceID kernel.UUID,
+	now time.Time,
+) (*relay.Handover, error) {
+	if err := errors.Join(o.Validate(), giver.Validate(), receiver.Validate()); err != nil {
+		return nil, err
+	}
+
+	if o.Status() != order.Assigned || o.Courier() == nil {
+		return nil, order.ErrOrderIsNotAssigned
+	}
+	if !o.Courier().IsEqual(giver.ID()) {
+		return nil, ErrOrderIsNotCarriedByCourier
+	}
+	fromStoragePlaceID, ok := storagePlaceOf(giver, o.ID())
+	if !ok {
+		return nil, ErrOrderIsNotCarriedByCourier
+	}
+
+	if giver.ID().IsEqual(receiver.ID()) {
+		return nil, relay.ErrHandoverToSameCourier
+	}
+	if receiver.IsDeactivated() || (receiver.Status() != courier.Available && receiver.Status() != courier.Busy) {
+		return nil, ErrCourierCannotReceiveOrder
+	}
+
+	met, err := giver.Location().IsEqual(receiver.Location())
+	if err != nil {
+		return nil, err
+	}
+	if !met {
+		return nil, ErrCouriersAreApart
+	}
+
+	from, err := relay.NewHolder(giver.ID(), fromStoragePlaceID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := relay.NewHolder(receiver.ID(), storagePlaceID)
+	if err != nil {
+		return nil, err
+	}
+	handover, err := relay.NewHandover(kernel.NewUUID(), o.ID(), from, to, giver.Location(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = receiver.TakeOrderInto(o, storagePlaceID); err != nil {
+		return nil, err
+	}
+	if err = giver.ReleaseOrder(o.ID()); err != nil {
+		return nil, err
+	}
+	if err = o.Assign(receiver.ID()); err != nil {
+		return nil, err
+	}
+
+	return handover, nil
+}
+
+// storagePlaceOf returns the storage place of the courier that holds the order.
+func storagePlaceOf(c *courier.Courier, orderID kernel.UUID) (kernel.UUID, bool) {
+	for _, storagePlace := range c.StoragePlaces() {
+		if storagePlace.OrderID() != nil && storagePlace.OrderID().IsEqual(orderID) {
+			return storagePlace.ID(), true
+		}
+	}
+	return kernel.UUID{}, false
+}
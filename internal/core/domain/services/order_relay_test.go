@@ -0,0 +1,182 @@
+package services_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+	"delivery/internal/core/domain/model/relay"
+	"delivery/internal/core/domain/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRelayCourier creates a courier on shift at the location.
+func newRelayCourier(t *testing.T, name string, x, y kernel.Coordinate) *courier.Courier {
+	t.Helper()
+	location, err := kernel.NewLocation(x, y)
+	require.NoError(t, err)
+	c, err := courier.NewCourier(kernel.NewUUID(), name, 2, location)
+	require.NoError(t, err)
+	limit, err := courier.NewWorkingHoursLimit(8*time.Hour, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, c.StartShift(time.Now(), limit))
+	return c
+}
+
+// newCarriedOrder creates an order carried by the courier.
+func newCarriedOrder(t *testing.T, c *courier.Courier) *order.Order {
+	t.Helper()
+	location, err := kernel.NewLocation(9, 9)
+	require.NoError(t, err)
+	o, err := order.NewOrder(kernel.NewUUID(), location, 5)
+	require.NoError(t, err)
+	require.NoError(t, o.Assign(c.ID()))
+	require.NoError(t, c.TakeOrder(o))
+	return o
+}
+
+func TestOrderRelay_HandOver(t *testing.T) {
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	relayService := services.NewOrderRelay()
+
+	t.Run("should move the order into the chosen storage place", func(t *testing.T) {
+		giver := newRelayCourier(t, "Alice", 4, 4)
+		receiver := newRelayCourier(t, "Bob", 4, 4)
+		require.NoError(t, receiver.AddStoragePlace("Backpack", 20))
+		backpack := receiver.StoragePlaces()[1]
+		o := newCarriedOrder(t, giver)
+		bag := giver.StoragePlaces()[0]
+
+		handover, err := relayService.HandOver(o, giver, receiver, backpack.ID(), now)
+
+		require.NoError(t, err)
+		require.NoError(t, handover.Validate())
+		assert.True(t, handover.OrderID().IsEqual(o.ID()))
+		assert.True(t, handover.From().CourierID().IsEqual(giver.ID()))
+		assert.True(t, handover.From().StoragePlaceID().IsEqual(bag.ID()))
+		assert.True(t, handover.To().CourierID().IsEqual(receiver.ID()))
+		assert.True(t, handover.To().StoragePlaceID().IsEqual(backpack.ID()))
+		assert.Equal(t, giver.Location(), handover.Location())
+		assert.Equal(t, now, handover.HandedOverAt())
+
+		assert.True(t, o.Courier().IsEqual(receiver.ID()))
+		assert.Equal(t, order.Assigned, o.Status())
+		assert.Nil(t, bag.OrderID())
+		assert.Equal(t, courier.Available, giver.Status())
+		require.NotNil(t, backpack.OrderID())
+		assert.True(t, backpack.OrderID().IsEqual(o.ID()))
+		assert.Equal(t, courier.Busy, receiver.Status())
+	})
+
+	t.Run("should refuse a handover that breaks a rule", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			prepare func(giver, receiver *courier.Courier, o *order.Order) (*courier.Courier, kernel.UUID)
+			wantErr error
+		}{
+			{
+				name: "couriers are apart",
+				prepare: func(_, _ *courier.Courier, _ *order.Order) (*courier.Courier, kernel.UUID) {
+					apart := newRelayCourier(t, "Carol", 1, 1)
+					return apart, apart.StoragePlaces()[0].ID()
+				},
+				wantErr: services.ErrCouriersAreApart,
+			},
+			{
+				name: "receiver is on a break",
+				prepare: func(_, receiver *courier.Courier, _ *order.Order) (*courier.Courier, kernel.UUID) {
+					require.NoError(t, receiver.StartBreak())
+					return receiver, receiver.StoragePlaces()[0].ID()
+				},
+				wantErr: services.ErrCourierCannotReceiveOrder,
+			},
+			{
+				name: "receiver is the giver",
+				prepare: func(giver, _ *courier.Courier, _ *order.Order) (*courier.Courier, kernel.UUID) {
+					return giver, giver.StoragePlaces()[0].ID()
+				},
+				wantErr: relay.ErrHandoverToSameCourier,
+			},
+			{
+				name: "storage place is occupied",
+				prepare: func(_, receiver *courier.Courier, _ *order.Order) (*courier.Courier, kernel.UUID) {
+					newCarriedOrder(t, receiver)
+					return receiver, receiver.StoragePlaces()[0].ID()
+				},
+				wantErr: courier.ErrCannotStoreOrderInThisStoragePlace,
+			},
+			{
+				name: "storage place belongs to another courier",
+				prepare: func(giver, receiver *courier.Courier, _ *order.Order) (*courier.Courier, kernel.UUID) {
+					return receiver, giver.StoragePlaces()[0].ID()
+				},
+				wantErr: courier.ErrStoragePlaceNotFound,
+			},
+			{
+				name: "order is delivered",
+				prepare: func(giver, receiver *courier.Courier, o *order.Order) (*courier.Courier, kernel.UUID) {
+					require.NoError(t, o.Complete())
+					require.NoError(t, giver.CompleteOrder(o.ID()))
+					return receiver, receiver.StoragePlaces()[0].ID()
+				},
+				wantErr: order.ErrOrderIsNotAssigned,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				giver := newRelayCourier(t, "Alice", 4, 4)
+				receiver := newRelayCourier(t, "Bob", 4, 4)
+				o := newCarriedOrder(t, giver)
+				to, storagePlaceID := tt.prepare(giver, receiver, o)
+				status := o.Status()
+
+				handover, err := relayService.HandOver(o, giver, to, storagePlaceID, now)
+
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, handover)
+				assert.Equal(t, status, o.Status())
+				assert.True(t, o.Courier().IsEqual(giver.ID()))
+			})
+		}
+	})
+
+	t.Run("should refuse an order the giver does not carry", func(t *testing.T) {
+		giver := newRelayCourier(t, "Alice", 4, 4)
+		receiver := newRelayCourier(t, "Bob", 4, 4)
+		o := newCarriedOrder(t, receiver)
+		require.NoError(t, receiver.AddStoragePlace("Backpack", 20))
+
+		_, err := relayService.HandOver(o, giver, receiver, receiver.StoragePlaces()[1].ID(), now)
+
+		require.ErrorIs(t, err, services.ErrOrderIsNotCarriedByCourier)
+	})
+
+	t.Run("should only hand insured orders to insured couriers", func(t *testing.T) {
+		threshold, err := order.NewInsuranceThreshold(100_000)
+		require.NoError(t, err)
+		giver := newRelayCourier(t, "Alice", 4, 4)
+		giver.Insure()
+		receiver := newRelayCourier(t, "Bob", 4, 4)
+		location, err := kernel.NewLocation(9, 9)
+		require.NoError(t, err)
+		o, err := order.NewOrder(kernel.NewUUID(), location, 5)
+		require.NoError(t, err)
+		require.NoError(t, o.DeclareValue(250_000, threshold))
+		require.NoError(t, o.Assign(giver.ID()))
+		require.NoError(t, giver.TakeOrder(o))
+		require.NoError(t, o.ConfirmPickup(now))
+
+		_, err = relayService.HandOver(o, giver, receiver, receiver.StoragePlaces()[0].ID(), now)
+		require.ErrorIs(t, err, courier.ErrCourierIsNotInsured)
+
+		receiver.Insure()
+		_, err = relayService.HandOver(o, giver, receiver, receiver.StoragePlaces()[0].ID(), now)
+		require.NoError(t, err)
+		assert.Nil(t, o.PickupConfirmedAt(), "the receiving courier confirms the pickup again")
+	})
+}
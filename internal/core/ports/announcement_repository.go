@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/announcement"
+)
+
+// AnnouncementRepository defines the persistence contract for announcement aggregates.
+// Announcements are read back through queries, so the repository only writes.
+type AnnouncementRepository interface {
+	// Add persists a new announcement with its recipients.
+	Add(ctx context.Context, announcement *announcement.Announcement) error
+
+	// Update persists the delivery states of an existing announcement.
+	Update(ctx context.Context, announcement *announcement.Announcement) error
+}
@@ -0,0 +1,34 @@
+package ports
+
+import (
+	"context"
+	"time"
+)
+
+// SignedUpload is a presigned request a client uploads a file with directly to the blob storage,
+// so file contents never pass through the service.
+type SignedUpload struct {
+	// URL is where the file is uploaded to
+	URL string
+
+	// Method is the HTTP method of the upload, PUT for every backend
+	Method string
+
+	// Headers must be sent with the upload exactly as given; they are part of the signature
+	Headers map[string]string
+
+	// ExpiresAt is when the URL stops being accepted
+	ExpiresAt time.Time
+}
+
+// BlobStorage stores uploaded files such as delivery photos and courier documents.
+// Backends are interchangeable: a local directory, S3 or an S3-compatible store like MinIO.
+type BlobStorage interface {
+	// SignUpload allows a single upload of a file of exactly size bytes and the content type
+	// under key until expiresAt.
+	SignUpload(ctx context.Context, key string, contentType string, size int64, expiresAt time.Time) (SignedUpload, error)
+
+	// Delete removes the file stored under key. Deleting a file that was never uploaded
+	// is not an error.
+	Delete(ctx context.Context, key string) error
+}
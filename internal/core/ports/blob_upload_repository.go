@@ -0,0 +1,28 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/blob"
+	"delivery/internal/core/domain/model/kernel"
+)
+
+// BlobUploadRepository defines the persistence contract for the uploads issued for the blob storage.
+// Uploads are shared by all tenants, like the storage itself, so the cleanup sees every orphan.
+type BlobUploadRepository interface {
+	// Add persists a new upload.
+	Add(ctx context.Context, upload *blob.Upload) error
+
+	// Get retrieves an upload by ID. Returns errs.ErrObjectNotFound if it does not exist.
+	Get(ctx context.Context, id kernel.UUID) (*blob.Upload, error)
+
+	// Update persists the attachment of an existing upload.
+	Update(ctx context.Context, upload *blob.Upload) error
+
+	// GetOrphaned retrieves at most limit unattached uploads issued before the cutoff, oldest first.
+	GetOrphaned(ctx context.Context, issuedBefore time.Time, limit int) ([]*blob.Upload, error)
+
+	// Delete removes an upload. Deleting a missing upload is not an error.
+	Delete(ctx context.Context, id kernel.UUID) error
+}
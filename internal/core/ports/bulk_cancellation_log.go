@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+)
+
+// BulkCancellation is the audit entry of a batch of orders cancelled in bulk. The batches of one
+// bulk cancellation share its ID and are numbered from 1.
+type BulkCancellation struct {
+	ID          kernel.UUID
+	Batch       int
+	Reason      string
+	Filter      OrderFilter
+	OrderIDs    []kernel.UUID
+	CancelledAt time.Time
+}
+
+// BulkCancellationLog defines the persistence contract for the audit log of bulk cancellations.
+type BulkCancellationLog interface {
+	// Record appends the audit entry of a batch to the log.
+	Record(ctx context.Context, cancellation BulkCancellation) error
+}
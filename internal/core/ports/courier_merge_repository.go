@@ -0,0 +1,33 @@
+package ports
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/courier"
+	"delivery/internal/core/domain/model/kernel"
+)
+
+// MergedCourierRecords reports how many records of a duplicate courier a merge moved to the
+// courier it was merged into.
+type MergedCourierRecords struct {
+	Orders                 int
+	StoragePlaces          int
+	Earnings               int
+	Handovers              int
+	AssignmentExplanations int
+	AnnouncementDeliveries int
+	Substitutions          int
+	LocationPoints         int
+	DailyTracks            int
+	DeviceReadings         int
+	VerificationAttempts   int
+}
+
+// CourierMergeRepository defines the persistence contract for merging duplicate courier records.
+type CourierMergeRepository interface {
+	// Merge moves the orders, storage places, earnings and history of the duplicate to the
+	// survivor and deletes the duplicate. Records the survivor already has for the same moment
+	// or announcement are dropped; daily tracks of the same day are added up.
+	// Returns errs.ErrConcurrencyConflict if the duplicate was updated since it was loaded.
+	Merge(ctx context.Context, duplicate *courier.Courier, survivorID kernel.UUID) (MergedCourierRecords, error)
+}
@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+)
+
+// CourierNotification is a message pushed to a courier's device.
+type CourierNotification struct {
+	// ID identifies the notification so devices can deduplicate redeliveries.
+	ID kernel.UUID
+	// Text is the message shown to the courier.
+	Text string
+	// SentAt is the moment the notification was sent.
+	SentAt time.Time
+}
+
+// CourierNotifier delivers notifications to couriers, for example through the courier app's push channel.
+type CourierNotifier interface {
+	// NotifyCourier sends the notification to the courier and returns an error
+	// if the notification channel did not accept it.
+	NotifyCourier(ctx context.Context, courierID kernel.UUID, notification CourierNotification) error
+}
@@ -16,26 +16,40 @@ import (
 type CourierRepository interface {
 	// Add persists a new courier aggregate to storage.
 	// The courier must be valid and not already exist in the repository.
+	// Returns courier.ErrExternalIDIsAlreadyRegistered if another courier is linked to its external ID.
 	Add(ctx context.Context, courier *courier.Courier) error
 
 	// Update persists changes to an existing courier aggregate.
 	// The courier must exist in the repository and be valid.
+	// Returns errs.ErrConcurrencyConflict if another transaction updated the courier since it was loaded.
 	Update(ctx context.Context, courier *courier.Courier) error
 
 	// Get retrieves a courier aggregate by its unique identifier.
 	// Returns the complete courier with all storage places and their current state.
 	Get(ctx context.Context, id kernel.UUID) (*courier.Courier, error)
 
+	// GetByExternalID retrieves the courier linked to an external system ID.
+	// Returns an ObjectNotFoundError if no courier is linked to it.
+	GetByExternalID(ctx context.Context, externalID courier.ExternalID) (*courier.Courier, error)
+
+	// GetByIDs retrieves the couriers with the given IDs in one round trip, ordered by ID.
+	// IDs without a courier are skipped, so callers that need all of them compare the result.
+	GetByIDs(ctx context.Context, ids []kernel.UUID) ([]*courier.Courier, error)
+
 	// GetAllFree retrieves all couriers that are not currently assigned to active orders.
-	// A courier is considered free if they are not assigned to any order in Assigned status.
+	// A courier is considered free if they are Available (on shift, not on a break), carry no order
+	// and are not assigned to any order in Assigned status.
 	// Couriers with Created orders (not yet assigned) or Completed orders (finished deliveries)
-	// are considered available for new assignments.
+	// are considered available for new assignments, unless their vehicle is in maintenance or they are absent.
 	//
 	// Business Rules:
+	//   - Offline or OnBreak couriers: Unavailable (off shift or not taking orders)
 	//   - Couriers without any orders: Available
 	//   - Couriers with Created orders: Available (orders not assigned yet)
 	//   - Couriers with Assigned orders: Unavailable (actively working)
 	//   - Couriers with Completed orders: Available (work finished)
+	//   - Couriers in a maintenance window: Unavailable (vehicle in maintenance)
+	//   - Absent couriers: Unavailable (their substitute takes over)
 	//
 	// Example:
 	//   freeCouriers, err := repo.GetAllFree(ctx)
@@ -46,4 +60,21 @@ type CourierRepository interface {
 	//       fmt.Printf("Available: %s\n", courier.Name())
 	//   }
 	GetAllFree(ctx context.Context) ([]*courier.Courier, error)
+
+	// GetAllWithFreeStorage retrieves the couriers who can take another order: like GetAllFree,
+	// except that couriers carrying or assigned to orders are included as long as one of their
+	// storage places in service is empty.
+	GetAllWithFreeStorage(ctx context.Context) ([]*courier.Courier, error)
+
+	// GetAllOnShift retrieves all couriers with a running shift who are not deactivated,
+	// whether or not they are busy with an order.
+	GetAllOnShift(ctx context.Context) ([]*courier.Courier, error)
+
+	// GetAllOffShift retrieves all couriers without a running shift who are neither deactivated
+	// nor absent, that is, the couriers who could start a shift now.
+	GetAllOffShift(ctx context.Context) ([]*courier.Courier, error)
+
+	// GetAllSubstitutedBy retrieves all couriers with an absence that is not over yet and names
+	// the given courier as the substitute.
+	GetAllSubstitutedBy(ctx context.Context, substituteID kernel.UUID) ([]*courier.Courier, error)
 }
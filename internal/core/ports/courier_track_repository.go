@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/track"
+)
+
+// CourierTrackRepository defines the persistence contract for the location history of couriers
+// and the daily tracks summarized from it.
+type CourierTrackRepository interface {
+	// Merge stores the courier's points that are not in the history yet and returns how many
+	// were stored; points recorded at a moment the history already has are skipped.
+	// Returns an ObjectNotFoundError if the courier does not exist.
+	Merge(ctx context.Context, courierID kernel.UUID, points []track.Point) (int, error)
+
+	// GetDay retrieves the courier's points recorded on the UTC day, oldest first.
+	GetDay(ctx context.Context, courierID kernel.UUID, day time.Time) ([]track.Point, error)
+
+	// SaveDailyTracks stores the daily tracks, replacing those of the same courier and day.
+	SaveDailyTracks(ctx context.Context, tracks []track.DailyTrack) error
+}
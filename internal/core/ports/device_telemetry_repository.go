@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/device"
+	"delivery/internal/core/domain/model/kernel"
+)
+
+// DeviceTelemetryRepository defines the persistence contract for the rolling window of
+// device readings courier apps report.
+type DeviceTelemetryRepository interface {
+	// Add stores the reading and discards the courier's readings taken before windowStart.
+	// Returns an ObjectNotFoundError if the courier does not exist.
+	Add(ctx context.Context, reading device.Reading, windowStart time.Time) error
+
+	// GetSince retrieves the readings of the given couriers taken at or after since.
+	GetSince(ctx context.Context, since time.Time, courierIDs ...kernel.UUID) ([]device.Reading, error)
+}
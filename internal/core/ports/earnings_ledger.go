@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/earnings"
+)
+
+// EarningsLedger defines the persistence contract for the couriers' earnings ledger.
+// Entries are only ever appended; payouts read them back through queries.
+type EarningsLedger interface {
+	// Record appends an entry to the ledger.
+	// Returns earnings.ErrEntryIsAlreadyRecorded if the order already yielded an entry of the same kind.
+	Record(ctx context.Context, entry *earnings.Entry) error
+}
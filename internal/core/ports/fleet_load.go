@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+)
+
+// FleetLoad is a snapshot of order demand versus courier supply.
+type FleetLoad struct {
+	// QueuedOrders is the number of orders in Created status waiting for a courier,
+	// excluding orders held for fraud review.
+	QueuedOrders int
+	// FreeCouriers is the number of couriers available for dispatch.
+	FreeCouriers int
+}
+
+// FleetLoadReader provides the current fleet load for capacity decisions.
+type FleetLoadReader interface {
+	GetFleetLoad(ctx context.Context) (FleetLoad, error)
+}
@@ -0,0 +1,39 @@
+package ports
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/kernel"
+)
+
+// FraudVerdict is the outcome of a fraud check, ordered by severity.
+type FraudVerdict int
+
+const (
+	// FraudVerdictClear lets the order through.
+	FraudVerdictClear FraudVerdict = iota
+	// FraudVerdictReview accepts the order but holds it for manual review.
+	FraudVerdictReview
+	// FraudVerdictReject refuses the order.
+	FraudVerdictReject
+)
+
+// OrderFraudCheck describes an incoming order for fraud screening.
+type OrderFraudCheck struct {
+	OrderID kernel.UUID
+	Street  string
+	Volume  int
+}
+
+// FraudAssessment is a checker's decision about an incoming order.
+type FraudAssessment struct {
+	Verdict FraudVerdict
+	// Reason explains a Review or Reject verdict to operators.
+	Reason string
+}
+
+// FraudChecker screens incoming orders, for example against a local blacklist
+// or an external anti-fraud service.
+type FraudChecker interface {
+	CheckOrder(ctx context.Context, check OrderFraudCheck) (FraudAssessment, error)
+}
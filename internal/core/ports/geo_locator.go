@@ -0,0 +1,30 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"delivery/internal/core/domain/model/kernel"
+)
+
+// ErrAddressIsUnresolved is returned by a GeoLocator that could resolve the address neither
+// through the geo service nor from previously resolved addresses. Orders to such an address
+// are accepted but held for manual review instead of being refused.
+var ErrAddressIsUnresolved = errors.New("address is unresolved")
+
+// GeoLocator resolves delivery addresses to locations on the city grid,
+// for example through the geo service.
+type GeoLocator interface {
+	// Locate returns the location of the street address.
+	Locate(ctx context.Context, street string) (kernel.Location, error)
+}
+
+// ResolvedAddressCache keeps the locations of addresses resolved before, so an address
+// can still be located while the geo service is unavailable.
+// Addresses are passed normalized, so different spellings of an address share an entry.
+type ResolvedAddressCache interface {
+	// Get returns the cached location of the address and whether there is one.
+	Get(ctx context.Context, address string) (kernel.Location, bool, error)
+	// Put stores the location of the address, replacing the previous one.
+	Put(ctx context.Context, address string, location kernel.Location) error
+}
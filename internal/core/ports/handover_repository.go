@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/relay"
+)
+
+// HandoverRepository defines the persistence contract for the records of orders handed over
+// between couriers. Handovers are only ever appended.
+type HandoverRepository interface {
+	// Add persists a new handover.
+	Add(ctx context.Context, handover *relay.Handover) error
+}
@@ -0,0 +1,33 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/identity"
+	"delivery/internal/core/domain/model/kernel"
+)
+
+// IdentityVerification is a verifier's decision about a courier's proof of identity.
+type IdentityVerification struct {
+	Verified bool
+	// Reason explains a rejected proof to operators; it is never shown to the courier.
+	Reason string
+}
+
+// IdentityVerifier checks that the courier presenting a proof is who they claim to be,
+// for example against a PIN store or a device attestation service.
+// An error means the proof could not be checked, not that it was rejected.
+type IdentityVerifier interface {
+	Verify(ctx context.Context, courierID kernel.UUID, proof identity.Proof) (IdentityVerification, error)
+}
+
+// VerificationAttemptRepository defines the persistence contract for the audit trail of
+// couriers' identity verification attempts.
+type VerificationAttemptRepository interface {
+	// Add stores the attempt. It is stored even when the change the attempt was made for fails.
+	Add(ctx context.Context, attempt identity.Attempt) error
+
+	// GetSince retrieves the courier's attempts made at or after since.
+	GetSince(ctx context.Context, courierID kernel.UUID, since time.Time) ([]identity.Attempt, error)
+}
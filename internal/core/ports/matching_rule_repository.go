@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/matching"
+)
+
+// MatchingRuleRepository defines the persistence contract for dispatch matching rules.
+// Rules are configured as a whole, so the repository replaces them all at once.
+type MatchingRuleRepository interface {
+	// ReplaceRules discards the stored rules and persists the given ones instead.
+	ReplaceRules(ctx context.Context, rules []*matching.Rule) error
+
+	// GetRules retrieves the stored rules ordered by name.
+	GetRules(ctx context.Context) ([]*matching.Rule, error)
+}
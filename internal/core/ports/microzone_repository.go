@@ -0,0 +1,29 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/microzone"
+	"delivery/internal/core/domain/model/sla"
+)
+
+// MicrozoneRepository defines the persistence contract for microzones.
+// Microzones are recomputed as a whole, so the repository replaces them all at once.
+type MicrozoneRepository interface {
+	// ReplaceAll discards the stored microzones and persists the given ones instead.
+	ReplaceAll(ctx context.Context, microzones []*microzone.Microzone) error
+
+	// GetAll retrieves the stored microzones, busiest first.
+	GetAll(ctx context.Context) ([]*microzone.Microzone, error)
+}
+
+// DeliveryHistoryReader provides the delivery history microzones and SLA compliance are computed from.
+type DeliveryHistoryReader interface {
+	// GetDeliveryPoints counts the completed deliveries per location, leaving out synthetic test data.
+	GetDeliveryPoints(ctx context.Context) ([]microzone.DeliveryPoint, error)
+
+	// GetCompletedDeliveries returns the deliveries completed within [from, to),
+	// leaving out synthetic test data.
+	GetCompletedDeliveries(ctx context.Context, from time.Time, to time.Time) ([]sla.Delivery, error)
+}
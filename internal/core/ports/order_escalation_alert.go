@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/escalation"
+	"delivery/internal/core/domain/model/kernel"
+)
+
+// OrderEscalation describes an urgent order that reached an escalation tier while waiting for a courier.
+type OrderEscalation struct {
+	OrderID kernel.UUID
+	Tier    int
+	Tiers   int
+	// Waited is how long the order has at least waited, the waiting time of the tier.
+	Waited time.Duration
+	// Relaxations are the dispatch constraints lifted for the order from now on.
+	Relaxations []escalation.Relaxation
+}
+
+// OrderEscalationAlert notifies operations of urgent orders left waiting for a courier, for example
+// through a paging webhook. An error means the notification was not delivered.
+type OrderEscalationAlert interface {
+	NotifyOrderEscalation(ctx context.Context, escalated OrderEscalation) error
+}
@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/escalation"
+	"delivery/internal/core/domain/model/kernel"
+)
+
+// OrderEscalationRepository defines the persistence contract for the escalation state of urgent
+// orders waiting for a courier. The background job escalating the orders and the dispatcher
+// relaxing their constraints share it, so they agree on the tier of an order across processes.
+type OrderEscalationRepository interface {
+	// Get retrieves the escalation of the order.
+	// Returns an ObjectNotFoundError if the order has not escalated.
+	Get(ctx context.Context, orderID kernel.UUID) (escalation.Escalation, error)
+
+	// Save stores the escalation, replacing the previous one of its order.
+	Save(ctx context.Context, escalated escalation.Escalation) error
+}
@@ -2,32 +2,77 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"delivery/internal/core/domain/model/kernel"
 	"delivery/internal/core/domain/model/order"
 )
 
+// OrderFilter selects the orders in a status, optionally only those delivered within a zone,
+// those created before a moment and those of a priority.
+type OrderFilter struct {
+	Status        order.Status
+	Zone          *kernel.Zone
+	CreatedBefore *time.Time
+	Priority      *order.Priority
+}
+
 // OrderRepository defines the persistence contract for order aggregates.
 // Provides methods for storing, retrieving, and querying order entities
 // based on their status and assignment state.
 type OrderRepository interface {
 	// Add persists a new order aggregate to storage.
 	// The order must be valid and not already exist in the repository.
+	// Returns order.ErrExternalReferenceIsAlreadyRegistered if another order refers to its marketplace order
+	// and order.ErrBasketIsAlreadyOrdered if another order was created from its basket.
 	Add(ctx context.Context, aggregate *order.Order) error
 
 	// Update persists changes to an existing order aggregate.
 	// The order must exist in the repository and be valid.
+	// Returns errs.ErrConcurrencyConflict if another transaction updated the order since it was loaded.
 	Update(ctx context.Context, aggregate *order.Order) error
 
 	// Get retrieves an order aggregate by its unique identifier.
 	// Returns the complete order with its current status and assignment.
 	Get(ctx context.Context, id kernel.UUID) (*order.Order, error)
 
-	// GetFirstInCreatedStatus retrieves the first order in Created status.
-	// Used for order assignment workflows to find pending orders.
-	GetFirstInCreatedStatus(ctx context.Context) (*order.Order, error)
+	// GetByTrackingToken retrieves the order shared with a customer through the tracking token.
+	GetByTrackingToken(ctx context.Context, token order.TrackingToken) (*order.Order, error)
+
+	// GetByBasketID retrieves the order created from the basket.
+	// Returns an ObjectNotFoundError if no order was created from it.
+	GetByBasketID(ctx context.Context, basketID kernel.UUID) (*order.Order, error)
+
+	// GetNextDispatchable retrieves the pending order to assign next: the order in Created status
+	// with the most urgent priority, created first among equally urgent orders.
+	// Returns an ObjectNotFoundError if no order is waiting for dispatch.
+	GetNextDispatchable(ctx context.Context) (*order.Order, error)
+
+	// GetDispatchable retrieves at most limit pending orders in the order GetNextDispatchable
+	// would return them one by one, most urgent first.
+	GetDispatchable(ctx context.Context, limit int) ([]*order.Order, error)
+
+	// GetIDsMatching retrieves the IDs of at most limit orders matching the filter, earliest created first.
+	GetIDsMatching(ctx context.Context, filter OrderFilter, limit int) ([]kernel.UUID, error)
+
+	// GetAllAwaitingBatch retrieves all economy orders whose batching window was not released yet,
+	// earliest closing first.
+	GetAllAwaitingBatch(ctx context.Context) ([]*order.Order, error)
 
 	// GetAllInAssignedStatus retrieves all orders currently assigned to couriers.
 	// Returns orders that are in progress but not yet completed.
 	GetAllInAssignedStatus(ctx context.Context) ([]*order.Order, error)
+
+	// ArchiveCompleted moves at most limit orders completed before the cutoff, earliest completed
+	// first, to the archive and returns how many were moved. Archived orders are no longer returned
+	// by the other methods, except GetArchived and GetAllArchivedCompletedBetween.
+	ArchiveCompleted(ctx context.Context, completedBefore time.Time, limit int) (int, error)
+
+	// GetArchived retrieves an archived order by its unique identifier.
+	// Returns an ObjectNotFoundError if no archived order has the ID.
+	GetArchived(ctx context.Context, id kernel.UUID) (*order.Order, error)
+
+	// GetAllArchivedCompletedBetween retrieves the archived orders completed within [from, to),
+	// earliest completed first.
+	GetAllArchivedCompletedBetween(ctx context.Context, from, to time.Time) ([]*order.Order, error)
 }
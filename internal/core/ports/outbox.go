@@ -0,0 +1,61 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+)
+
+// OutboxMessage is an integration event stored in the transactional outbox.
+// Messages are immutable once written; their ID is stable and is used by downstream
+// consumers to deduplicate redeliveries.
+type OutboxMessage struct {
+	// ID uniquely identifies the message across deliveries and replays.
+	ID kernel.UUID
+	// AggregateID is the ordering key; events of one aggregate are delivered in order.
+	AggregateID string
+	// EventType is the name of the integration event (e.g. "OrderCompleted").
+	EventType string
+	// Payload is the serialized event body.
+	Payload []byte
+	// OccurredAt is the moment the event was recorded.
+	OccurredAt time.Time
+	// TraceParent is the W3C traceparent of the operation that recorded the message,
+	// empty if it was not traced.
+	TraceParent string
+}
+
+// OutboxCursor identifies a position in the outbox stream.
+// Messages are ordered by OccurredAt and then by ID, so that messages recorded
+// at the same instant have a deterministic order.
+type OutboxCursor struct {
+	OccurredAt time.Time
+	// AfterID is the ID of the last processed message at OccurredAt (nil to include all).
+	AfterID *kernel.UUID
+}
+
+// OutboxRepository defines the read contract for the transactional outbox.
+type OutboxRepository interface {
+	// GetSince returns up to limit messages positioned after the cursor,
+	// ordered by occurrence time and ID.
+	GetSince(ctx context.Context, cursor OutboxCursor, limit int) ([]OutboxMessage, error)
+}
+
+// OutboxQueue defines the contract the relay drains the transactional outbox through.
+// A message stays queued until it is marked processed, so a message published but not
+// marked is published again: delivery is at least once.
+type OutboxQueue interface {
+	// GetUnprocessed returns up to limit messages not published yet, ordered by occurrence time and ID.
+	GetUnprocessed(ctx context.Context, limit int) ([]OutboxMessage, error)
+
+	// MarkProcessed records that the message was published.
+	MarkProcessed(ctx context.Context, id kernel.UUID, processedAt time.Time) error
+}
+
+// EventPublisher delivers outbox messages to the message broker.
+type EventPublisher interface {
+	// Publish sends the message synchronously, using AggregateID as the partition key
+	// and propagating the message ID so consumers can deduplicate.
+	Publish(ctx context.Context, message OutboxMessage) error
+}
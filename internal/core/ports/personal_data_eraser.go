@@ -0,0 +1,57 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/order"
+)
+
+// PersonalDataRecords counts the records holding personal data of customers, by kind.
+// An erasure reports the records it anonymized; a verification reports those still left.
+type PersonalDataRecords struct {
+	// TrackingTokens are the links shared with the customer to follow the delivery
+	TrackingTokens int
+	// ExternalReferences are the marketplace orders and deep links to them
+	ExternalReferences int
+	// Messages are the texts of the order threads
+	Messages int
+	// PaymentReferences are the references of the payment provider
+	PaymentReferences int
+	// ChangeSnapshots are the change log entries that recorded an external reference
+	ChangeSnapshots int
+	// EventTombstones are the integration events published to remove the orders from compacted topics
+	EventTombstones int
+}
+
+// Total returns the number of records of all kinds.
+func (r PersonalDataRecords) Total() int {
+	return r.TrackingTokens + r.ExternalReferences + r.Messages +
+		r.PaymentReferences + r.ChangeSnapshots + r.EventTombstones
+}
+
+// PersonalDataErasure is the audit entry of an erasure request.
+type PersonalDataErasure struct {
+	ID               kernel.UUID
+	RequestReference string
+	OrderIDs         []kernel.UUID
+	Erased           PersonalDataRecords
+	ErasedAt         time.Time
+}
+
+// PersonalDataEraser defines the contract for anonymizing the personal data of customers.
+// Data that only feeds aggregate statistics, such as the delivery grid cell, the volume and the
+// status transitions of an order, is kept.
+type PersonalDataEraser interface {
+	// Erase anonymizes the personal data of the orders and queues a tombstone event for each of them.
+	// Returns errs.ErrConcurrencyConflict if an order was updated since it was loaded.
+	Erase(ctx context.Context, orders []*order.Order) (PersonalDataRecords, error)
+
+	// Remaining counts the records of the orders that still hold personal data; the event
+	// tombstones are not counted.
+	Remaining(ctx context.Context, orderIDs []kernel.UUID) (PersonalDataRecords, error)
+
+	// RecordErasure stores the audit entry of an erasure.
+	RecordErasure(ctx context.Context, erasure PersonalDataErasure) error
+}
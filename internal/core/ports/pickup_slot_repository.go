@@ -0,0 +1,34 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/kernel"
+	"delivery/internal/core/domain/model/pickup"
+)
+
+// PickupSlotRepository defines the persistence contract for warehouse pickup slot aggregates.
+type PickupSlotRepository interface {
+	// Add persists a new pickup slot.
+	Add(ctx context.Context, aggregate *pickup.Slot) error
+
+	// Update persists the capacity and the bookings of an existing pickup slot.
+	Update(ctx context.Context, aggregate *pickup.Slot) error
+
+	// Get retrieves a pickup slot by its unique identifier.
+	// Returns errs.ErrObjectNotFound if the slot does not exist.
+	Get(ctx context.Context, id kernel.UUID) (*pickup.Slot, error)
+
+	// GetFirstAvailable retrieves the earliest starting slot that has not ended at now
+	// and still has free capacity, locking it against concurrent bookings.
+	// Returns errs.ErrObjectNotFound if every slot is full or over.
+	GetFirstAvailable(ctx context.Context, now time.Time) (*pickup.Slot, error)
+
+	// GetByOrder retrieves the slot the order is booked into.
+	// Returns errs.ErrObjectNotFound if the order has no booking.
+	GetByOrder(ctx context.Context, orderID kernel.UUID) (*pickup.Slot, error)
+
+	// GetNotStarted retrieves the slots that start after now, ordered by start.
+	GetNotStarted(ctx context.Context, now time.Time) ([]*pickup.Slot, error)
+}
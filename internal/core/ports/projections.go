@@ -0,0 +1,10 @@
+package ports
+
+import "context"
+
+// ProjectionUpdater keeps the read-model projections built from the change log up to date.
+type ProjectionUpdater interface {
+	// ProjectNext projects up to batchSize of the changes recorded after the checkpoint of every
+	// projection, each projection in its own transaction, and returns the number of changes projected.
+	ProjectNext(ctx context.Context, batchSize int) (int, error)
+}
@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+	"time"
+)
+
+// ReadCache keeps serialized read models for a short time, so hot queries such as the public
+// tracking view do not reach the database on every request. Backends are interchangeable:
+// memory of the instance, or a shared store like Redis that every instance reads and invalidates.
+// Entries are a best-effort copy of the database; a lost entry is read from the database again.
+type ReadCache interface {
+	// Get returns the value stored under key. Reports false if the key is missing or expired.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+
+	// Set stores the value under key until ttl elapses, replacing any previous value.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+
+	// Delete removes the keys. Deleting a missing key is not an error.
+	Delete(ctx context.Context, keys ...string) error
+}
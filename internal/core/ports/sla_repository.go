@@ -0,0 +1,23 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/sla"
+)
+
+// SLARepository defines the persistence contract for SLA targets and their daily compliance.
+// Targets are configured as a whole, so the repository replaces them all at once.
+type SLARepository interface {
+	// ReplaceTargets discards the stored targets and persists the given ones instead.
+	// The compliance measured against the previous targets is kept.
+	ReplaceTargets(ctx context.Context, targets []*sla.Target) error
+
+	// GetTargets retrieves the stored targets ordered by district and delivery tier.
+	GetTargets(ctx context.Context) ([]*sla.Target, error)
+
+	// SaveCompliance replaces the compliance stored for the day with the given one,
+	// so measuring a day again does not count it twice.
+	SaveCompliance(ctx context.Context, day time.Time, compliance []sla.Compliance) error
+}
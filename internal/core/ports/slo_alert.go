@@ -0,0 +1,29 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"delivery/internal/core/domain/model/slo"
+)
+
+// SLOBurn describes a stage whose error budget started or stopped burning faster than its
+// objective's alert burn rate allows, as measured over the rolling window.
+type SLOBurn struct {
+	Stage         slo.Stage
+	BurnRate      float64
+	AlertBurnRate float64
+	// Compliance is the percentage of orders that went through the stage within the threshold.
+	Compliance float64
+	Goal       float64
+	Orders     int
+	Window     time.Duration
+	// Resolved is set when the burn rate fell back below the alert burn rate.
+	Resolved bool
+}
+
+// SLOAlert notifies operations of stages burning their error budget, for example through
+// a paging webhook. An error means the notification was not delivered.
+type SLOAlert interface {
+	NotifySLOBurn(ctx context.Context, burn SLOBurn) error
+}
@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/surge"
+)
+
+// SurgeRepository defines the persistence contract for the audit trail of surge mode toggles.
+// The current surge mode is the one left behind by the latest toggle, so the API and the
+// background jobs agree on it even when they run in separate processes.
+type SurgeRepository interface {
+	// GetMode retrieves the surge mode left behind by the latest toggle,
+	// or surge.DefaultMode if the surge mode was never toggled.
+	GetMode(ctx context.Context) (surge.Mode, error)
+
+	// AddToggle appends the toggle to the audit trail.
+	AddToggle(ctx context.Context, toggle surge.Toggle) error
+}
@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+	"time"
+)
+
+// SyntheticDataPurge reports how many synthetic aggregates a purge removed.
+type SyntheticDataPurge struct {
+	Orders   int
+	Couriers int
+}
+
+// SyntheticDataJanitor removes test data created by requests marked as synthetic.
+type SyntheticDataJanitor interface {
+	// PurgeSyntheticData deletes synthetic orders and couriers created before the cutoff,
+	// together with their messages, items and storage places. Couriers still delivering
+	// an order that is kept are skipped until a later purge.
+	PurgeSyntheticData(ctx context.Context, createdBefore time.Time) (SyntheticDataPurge, error)
+}
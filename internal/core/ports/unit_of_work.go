@@ -12,8 +12,14 @@ type UnitOfWorkFactory interface {
 
 // UnitOfWork represents a business transaction boundary.
 // It provides transaction control and tracks aggregate changes.
-// Client code must explicitly manage transaction lifecycle.
+// Client code either runs its work through Do or manages the transaction lifecycle explicitly.
 type UnitOfWork interface {
+	// Do runs fn within a transaction: it begins one, commits it when fn succeeds and
+	// rolls it back when fn returns an error or panics. The panic is re-raised after the rollback.
+	// Returns the error of fn, or of Begin or Commit when they fail.
+	// Within an open transaction Do joins it and leaves the commit to whoever began it.
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+
 	// Begin starts a new database transaction.
 	Begin(ctx context.Context) error
 
@@ -32,4 +38,28 @@ type UnitOfWork interface {
 	// OrderRepository returns an OrderRepository instance bound to the current transaction.
 	// Repository will use the transaction started by Begin().
 	OrderRepository() OrderRepository
+
+	// PickupSlotRepository returns a PickupSlotRepository instance bound to the current transaction.
+	// Repository will use the transaction started by Begin().
+	PickupSlotRepository() PickupSlotRepository
+
+	// EarningsLedger returns the couriers' EarningsLedger bound to the current transaction.
+	// Ledger will use the transaction started by Begin().
+	EarningsLedger() EarningsLedger
+
+	// HandoverRepository returns a HandoverRepository instance bound to the current transaction.
+	// Repository will use the transaction started by Begin().
+	HandoverRepository() HandoverRepository
+
+	// CourierMergeRepository returns a CourierMergeRepository instance bound to the current transaction.
+	// Repository will use the transaction started by Begin().
+	CourierMergeRepository() CourierMergeRepository
+
+	// PersonalDataEraser returns a PersonalDataEraser bound to the current transaction.
+	// Eraser will use the transaction started by Begin().
+	PersonalDataEraser() PersonalDataEraser
+
+	// BulkCancellationLog returns the audit log of bulk cancellations bound to the current transaction.
+	// Log will use the transaction started by Begin().
+	BulkCancellationLog() BulkCancellationLog
 }
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"delivery/internal/core/domain/model/usage"
+)
+
+// UsageLedger defines the persistence contract for API usage accounting.
+// Usage is accounted per client, period and metric.
+type UsageLedger interface {
+	// Used returns how much of the metric the client consumed in the period, zero if nothing.
+	Used(ctx context.Context, client usage.Client, period usage.Period, metric usage.Metric) (int, error)
+
+	// Add accounts amount more of the metric to the client in the period.
+	Add(ctx context.Context, client usage.Client, period usage.Period, metric usage.Metric, amount int) error
+}
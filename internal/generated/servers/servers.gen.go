@@ -10,405 +10,9342 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
 	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for AnnouncementDeliveryStatus.
+const (
+	AnnouncementDeliveryStatusDelivered AnnouncementDeliveryStatus = "delivered"
+	AnnouncementDeliveryStatusFailed    AnnouncementDeliveryStatus = "failed"
+	AnnouncementDeliveryStatusPending   AnnouncementDeliveryStatus = "pending"
+)
+
+// Defines values for BadgeKind.
+const (
+	BadgeKindDeliveries   BadgeKind = "deliveries"
+	BadgeKindFiveStarWeek BadgeKind = "five-star-week"
+)
+
+// Defines values for BlobUploadRequestPurpose.
+const (
+	CourierDocument BlobUploadRequestPurpose = "courier-document"
+	DeliveryPhoto   BlobUploadRequestPurpose = "delivery-photo"
+)
+
+// Defines values for ChangeAggregateType.
+const (
+	ChangeAggregateTypeCourier ChangeAggregateType = "courier"
+	ChangeAggregateTypeOrder   ChangeAggregateType = "order"
+)
+
+// Defines values for Connectivity.
+const (
+	ConnectivityGood    Connectivity = "good"
+	ConnectivityOffline Connectivity = "offline"
+	ConnectivityPoor    Connectivity = "poor"
+)
+
+// Defines values for CourierAvailability.
+const (
+	Busy     CourierAvailability = "busy"
+	Free     CourierAvailability = "free"
+	OffShift CourierAvailability = "off_shift"
+)
+
+// Defines values for DeactivationReason.
+const (
+	DeactivationReasonOffboarded DeactivationReason = "offboarded"
+	DeactivationReasonOffline    DeactivationReason = "offline"
+)
+
+// Defines values for DeliveryTier.
+const (
+	Economy DeliveryTier = "economy"
+	Express DeliveryTier = "express"
+)
+
+// Defines values for HandoverStep.
+const (
+	Delivery HandoverStep = "delivery"
+	Pickup   HandoverStep = "pickup"
+)
+
+// Defines values for IdentityVerificationMethod.
+const (
+	DeviceAttestation IdentityVerificationMethod = "device_attestation"
+	Pin               IdentityVerificationMethod = "pin"
+)
+
+// Defines values for Language.
+const (
+	En Language = "en"
+	Ru Language = "ru"
+)
+
+// Defines values for ListOrdersParamsSort.
+const (
+	ListOrdersParamsSortCreatedAt      ListOrdersParamsSort = "createdAt"
+	ListOrdersParamsSortMinusCreatedAt ListOrdersParamsSort = "-createdAt"
+	ListOrdersParamsSortMinusPriority  ListOrdersParamsSort = "-priority"
+	ListOrdersParamsSortPriority       ListOrdersParamsSort = "priority"
+)
+
+// Defines values for MaintenanceStatus.
+const (
+	Active      MaintenanceStatus = "active"
+	Approaching MaintenanceStatus = "approaching"
+	Finished    MaintenanceStatus = "finished"
+	Scheduled   MaintenanceStatus = "scheduled"
+)
+
+// Defines values for MessageSender.
+const (
+	MessageSenderCourier    MessageSender = "courier"
+	MessageSenderDispatcher MessageSender = "dispatcher"
+)
+
+// Defines values for OrderPriority.
+const (
+	High   OrderPriority = "high"
+	Low    OrderPriority = "low"
+	Normal OrderPriority = "normal"
+	Urgent OrderPriority = "urgent"
+)
+
+// Defines values for OrderStatus.
+const (
+	Assigned  OrderStatus = "assigned"
+	Cancelled OrderStatus = "cancelled"
+	Completed OrderStatus = "completed"
+	Created   OrderStatus = "created"
+)
+
+// Defines values for PaymentMethod.
+const (
+	CashOnDelivery PaymentMethod = "cashOnDelivery"
+	Online         PaymentMethod = "online"
+)
+
+// Defines values for PaymentStatus.
+const (
+	PaymentStatusPaid     PaymentStatus = "paid"
+	PaymentStatusPending  PaymentStatus = "pending"
+	PaymentStatusRefunded PaymentStatus = "refunded"
+)
+
+// Defines values for SurgeSetting.
+const (
+	Auto SurgeSetting = "auto"
+	Off  SurgeSetting = "off"
+	On   SurgeSetting = "on"
+)
+
+// Defines values for SurgeToggleSource.
+const (
+	Automatic SurgeToggleSource = "automatic"
+	Manual    SurgeToggleSource = "manual"
+)
+
+// Defines values for TemperatureClass.
+const (
+	Ambient TemperatureClass = "ambient"
+	Chilled TemperatureClass = "chilled"
+	Frozen  TemperatureClass = "frozen"
+)
+
+// Defines values for VehicleType.
+const (
+	Bicycle VehicleType = "bicycle"
+	Car     VehicleType = "car"
+	Scooter VehicleType = "scooter"
+)
+
+// Defines values for Weekday.
+const (
+	Friday    Weekday = "friday"
+	Monday    Weekday = "monday"
+	Saturday  Weekday = "saturday"
+	Sunday    Weekday = "sunday"
+	Thursday  Weekday = "thursday"
+	Tuesday   Weekday = "tuesday"
+	Wednesday Weekday = "wednesday"
+)
+
+// Defines values for WorkingHoursStatus.
+const (
+	ApproachingLimit WorkingHoursStatus = "approaching_limit"
+	LimitReached     WorkingHoursStatus = "limit_reached"
+	WithinLimit      WorkingHoursStatus = "within_limit"
+)
+
+// APIUsage Потребление API клиентом за месяц
+type APIUsage struct {
+	// Client Отпечаток SHA-256 API-ключа клиента
+	Client string `json:"client"`
+
+	// Month Месяц в формате YYYY-MM
+	Month string `json:"month"`
+
+	// OrdersCreated Потребление одного показателя и его месячная квота
+	OrdersCreated APIUsageMetric `json:"ordersCreated"`
+
+	// Requests Потребление одного показателя и его месячная квота
+	Requests APIUsageMetric `json:"requests"`
+
+	// ResetsAt Время обнуления месячных квот
+	ResetsAt time.Time `json:"resetsAt"`
+
+	// WebhooksConsumed Потребление одного показателя и его месячная квота
+	WebhooksConsumed APIUsageMetric `json:"webhooksConsumed"`
+}
+
+// APIUsageMetric Потребление одного показателя и его месячная квота
+type APIUsageMetric struct {
+	// Limit Месячная квота, 0 - без ограничений
+	Limit int `json:"limit"`
+
+	// Remaining Остаток квоты; отсутствует, если квота не ограничена
+	Remaining *int `json:"remaining,omitempty"`
+
+	// Used Потреблено за месяц
+	Used int `json:"used"`
+}
+
+// Announcement defines model for Announcement.
+type Announcement struct {
+	// Delivered Число доставленных уведомлений
+	Delivered int `json:"delivered"`
+
+	// Deliveries Состояние доставки каждому получателю
+	Deliveries []AnnouncementDelivery `json:"deliveries"`
+
+	// Failed Число недоставленных уведомлений
+	Failed int `json:"failed"`
+
+	// Id Идентификатор объявления
+	Id openapi_types.UUID `json:"id"`
+
+	// Pending Число уведомлений, ожидающих отправки
+	Pending int `json:"pending"`
+
+	// PostedAt Момент рассылки
+	PostedAt time.Time `json:"postedAt"`
+
+	// Text Текст объявления
+	Text string `json:"text"`
+
+	// Zone Прямоугольная область сетки, заданная двумя противоположными углами включительно
+	Zone *Zone `json:"zone,omitempty"`
+}
+
+// AnnouncementDelivery defines model for AnnouncementDelivery.
+type AnnouncementDelivery struct {
+	// ChangedAt Момент последнего изменения статуса
+	ChangedAt time.Time `json:"changedAt"`
+
+	// CourierId Идентификатор курьера
+	CourierId openapi_types.UUID `json:"courierId"`
+
+	// Failure Причина неудачной доставки
+	Failure *string `json:"failure,omitempty"`
+
+	// Status Статус доставки объявления курьеру
+	Status AnnouncementDeliveryStatus `json:"status"`
+}
+
+// AnnouncementDeliveryStatus Статус доставки объявления курьеру
+type AnnouncementDeliveryStatus string
+
+// AssignmentCandidate defines model for AssignmentCandidate.
+type AssignmentCandidate struct {
+	// CourierId Идентификатор курьера
+	CourierId openapi_types.UUID `json:"courierId"`
+	Factors   []ScoreFactor      `json:"factors"`
+
+	// Score Оценка курьера, сумма вкладов факторов
+	Score float64 `json:"score"`
+
+	// Selected Заказ назначен этому курьеру
+	Selected bool `json:"selected"`
+}
+
+// AssignmentExplanation defines model for AssignmentExplanation.
+type AssignmentExplanation struct {
+	// Candidates Курьеры, которые могли взять заказ, в порядке рассмотрения
+	Candidates []AssignmentCandidate `json:"candidates"`
+
+	// CourierId Идентификатор назначенного курьера
+	CourierId openapi_types.UUID `json:"courierId"`
+
+	// ExplainedAt Момент назначения
+	ExplainedAt time.Time `json:"explainedAt"`
+
+	// Score Оценка назначенного курьера (меньше — лучше)
+	Score float64 `json:"score"`
+
+	// Strategy Стратегия, по которой ранжировались курьеры
+	Strategy string `json:"strategy"`
+}
+
+// BadgeKind Чем заслуживается значок - числом доставок (deliveries) или числом оценок в 5 звезд за неделю без оценок ниже (five-star-week)
+type BadgeKind string
+
+// BadgeRule Правило, по которому курьер получает значок
+type BadgeRule struct {
+	Kind BadgeKind `json:"kind"`
+
+	// Name Название значка
+	Name string `json:"name"`
+
+	// Threshold Сколько доставок или оценок в 5 звезд нужно для значка
+	Threshold int `json:"threshold"`
+}
+
+// BlobUpload defines model for BlobUpload.
+type BlobUpload struct {
+	// ExpiresAt Время, после которого ссылка перестает действовать
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Headers Заголовки, которые нужно передать при загрузке без изменений, они входят в подпись
+	Headers map[string]string `json:"headers"`
+
+	// Id Идентификатор загрузки
+	Id openapi_types.UUID `json:"id"`
+
+	// Key Ключ, под которым файл хранится в хранилище
+	Key string `json:"key"`
+
+	// Method HTTP-метод загрузки
+	Method string `json:"method"`
+
+	// Url Подписанная ссылка для загрузки файла
+	Url string `json:"url"`
+}
+
+// BlobUploadRequest defines model for BlobUploadRequest.
+type BlobUploadRequest struct {
+	// ContentType MIME-тип файла
+	ContentType string `json:"contentType"`
+
+	// OwnerId Идентификатор заказа или курьера, к которому относится файл
+	OwnerId openapi_types.UUID `json:"ownerId"`
+
+	// Purpose Назначение файла. delivery-photo - фото доставки (JPEG, PNG или WebP до 10 МиБ), владелец - заказ; courier-document - документ курьера (JPEG, PNG или PDF до 20 МиБ), владелец - курьер
+	Purpose BlobUploadRequestPurpose `json:"purpose"`
+
+	// Size Точный размер файла в байтах
+	Size int64 `json:"size"`
+}
+
+// BlobUploadRequestPurpose Назначение файла. delivery-photo - фото доставки (JPEG, PNG или WebP до 10 МиБ), владелец - заказ; courier-document - документ курьера (JPEG, PNG или PDF до 20 МиБ), владелец - курьер
+type BlobUploadRequestPurpose string
+
+// BulkCancellation Сводка массовой отмены заказов
+type BulkCancellation struct {
+	// Batches Число транзакций, в которых отменены заказы
+	Batches int `json:"batches"`
+
+	// Cancelled Число отмененных заказов
+	Cancelled int `json:"cancelled"`
+
+	// Id Идентификатор массовой отмены в журнале аудита
+	Id openapi_types.UUID `json:"id"`
+
+	// LimitReached Отмена остановлена лимитом, под фильтр могут подходить и другие заказы
+	LimitReached bool `json:"limitReached"`
+
+	// OrderIds Идентификаторы отмененных заказов, начиная с самых старых
+	OrderIds []openapi_types.UUID `json:"orderIds"`
+
+	// Reason Причина отмены
+	Reason string `json:"reason"`
+}
+
+// BulkCancellationRequest defines model for BulkCancellationRequest.
+type BulkCancellationRequest struct {
+	// CreatedBefore Отменяются только заказы, созданные раньше этого времени
+	CreatedBefore *time.Time `json:"createdBefore,omitempty"`
+
+	// Limit Максимальное число отменяемых заказов, от 1 до 1000
+	Limit *int `json:"limit,omitempty"`
+
+	// Reason Причина отмены для журнала аудита, до 255 символов
+	Reason string `json:"reason"`
+
+	// Status Статус заказа
+	Status *OrderStatus `json:"status,omitempty"`
+
+	// Zone Прямоугольная область сетки, заданная двумя противоположными углами включительно
+	Zone *Zone `json:"zone,omitempty"`
+}
+
+// Change defines model for Change.
+type Change struct {
+	// AggregateId Идентификатор заказа или курьера
+	AggregateId openapi_types.UUID `json:"aggregateId"`
+
+	// AggregateType Тип измененного объекта
+	AggregateType ChangeAggregateType `json:"aggregateType"`
+
+	// ChangedAt Время фиксации изменения
+	ChangedAt time.Time `json:"changedAt"`
+
+	// Courier Состояние курьера после изменения
+	Courier *CourierSnapshot `json:"courier,omitempty"`
+
+	// Cursor Курсор изменения
+	Cursor int64 `json:"cursor"`
+
+	// Order Состояние заказа после изменения
+	Order *OrderSnapshot `json:"order,omitempty"`
+
+	// Version Версия объекта после изменения; версии каждого объекта растут с 1
+	Version int64 `json:"version"`
+}
+
+// ChangeAggregateType Тип измененного объекта
+type ChangeAggregateType string
+
+// ChangeFeed defines model for ChangeFeed.
+type ChangeFeed struct {
+	// Changes Изменения в порядке фиксации
+	Changes []Change `json:"changes"`
+
+	// NextCursor Курсор для следующего запроса. Совпадает с since, если новых изменений нет
+	NextCursor int64 `json:"nextCursor"`
+}
+
+// Connectivity Качество связи устройства
+type Connectivity string
+
 // Courier defines model for Courier.
 type Courier struct {
+	// Absences Текущее и предстоящие отсутствия курьера, начиная с самого раннего
+	Absences []CourierAbsence `json:"absences"`
+
+	// ExternalId Идентификатор курьера в HR-системе
+	ExternalId *string `json:"externalId,omitempty"`
+
 	// Id Идентификатор
-	Id       openapi_types.UUID `json:"id"`
-	Location Location           `json:"location"`
+	Id openapi_types.UUID `json:"id"`
+
+	// Insured Курьер застрахован и может доставлять заказы с объявленной ценностью от порога страхования
+	Insured  bool     `json:"insured"`
+	Location Location `json:"location"`
 
 	// Name Имя
 	Name string `json:"name"`
+
+	// StoragePlaces Места хранения курьера по имени
+	StoragePlaces []StoragePlace `json:"storagePlaces"`
+
+	// SubstitutingFor Отсутствующие сейчас курьеры, которых замещает курьер
+	SubstitutingFor []openapi_types.UUID `json:"substitutingFor"`
 }
 
-// Error defines model for Error.
-type Error struct {
-	// Code Код ошибки
-	Code int32 `json:"code"`
+// CourierAbsence defines model for CourierAbsence.
+type CourierAbsence struct {
+	// EndsAt Окончание отсутствия
+	EndsAt time.Time `json:"endsAt"`
 
-	// Message Текст ошибки
-	Message string `json:"message"`
+	// Id Идентификатор отсутствия
+	Id openapi_types.UUID `json:"id"`
+
+	// StartsAt Начало отсутствия
+	StartsAt time.Time `json:"startsAt"`
+
+	// SubstituteId Идентификатор курьера-заместителя
+	SubstituteId openapi_types.UUID `json:"substituteId"`
 }
 
-// Location defines model for Location.
-type Location struct {
-	// X X
-	X int `json:"x"`
+// CourierAbsencePlan defines model for CourierAbsencePlan.
+type CourierAbsencePlan struct {
+	// EndsAt Окончание отсутствия, позже начала
+	EndsAt time.Time `json:"endsAt"`
 
-	// Y Y
-	Y int `json:"y"`
+	// StartsAt Начало отсутствия
+	StartsAt time.Time `json:"startsAt"`
+
+	// SubstituteId Идентификатор курьера-заместителя
+	SubstituteId openapi_types.UUID `json:"substituteId"`
 }
 
-// NewCourier defines model for NewCourier.
-type NewCourier struct {
-	// Name Имя
-	Name string `json:"name"`
+// CourierAvailability Доступность курьера — свободен для заказов, занят на смене (везет заказы, на перерыве, на паузе или на проверке) или не на смене
+type CourierAvailability string
 
-	// Speed Скорость
-	Speed int `json:"speed"`
+// CourierAvailabilityChange defines model for CourierAvailabilityChange.
+type CourierAvailabilityChange struct {
+	// Availability Доступность курьера — свободен для заказов, занят на смене (везет заказы, на перерыве, на паузе или на проверке) или не на смене
+	Availability CourierAvailability `json:"availability"`
+
+	// ChangedAt Время фиксации изменения
+	ChangedAt time.Time `json:"changedAt"`
+
+	// CourierId Идентификатор курьера
+	CourierId openapi_types.UUID `json:"courierId"`
+
+	// Cursor Курсор изменения
+	Cursor int64 `json:"cursor"`
+
+	// Version Версия доступности курьера после изменения; версии каждого курьера растут с 1
+	Version int64 `json:"version"`
 }
 
-// Order defines model for Order.
-type Order struct {
-	// Id Идентификатор
-	Id       openapi_types.UUID `json:"id"`
-	Location Location           `json:"location"`
+// CourierAvailabilityFeed defines model for CourierAvailabilityFeed.
+type CourierAvailabilityFeed struct {
+	// Changes Изменения доступности в порядке фиксации
+	Changes []CourierAvailabilityChange `json:"changes"`
+
+	// NextCursor Курсор для следующего запроса. Совпадает с since, если новых изменений нет
+	NextCursor int64 `json:"nextCursor"`
 }
 
-// CreateCourierJSONRequestBody defines body for CreateCourier for application/json ContentType.
-type CreateCourierJSONRequestBody = NewCourier
+// CourierDailyTrack Перемещение курьера за день UTC по всем записанным позициям
+type CourierDailyTrack struct {
+	// Day День UTC в формате YYYY-MM-DD
+	Day string `json:"day"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Получить всех курьеров
-	// (GET /api/v1/couriers)
-	GetCouriers(ctx echo.Context) error
-	// Добавить курьера
-	// (POST /api/v1/couriers)
-	CreateCourier(ctx echo.Context) error
-	// Создать заказ
-	// (POST /api/v1/orders)
-	CreateOrder(ctx echo.Context) error
-	// Получить все незавершенные заказы
-	// (GET /api/v1/orders/active)
-	GetOrders(ctx echo.Context) error
+	// Distance Пройденное расстояние в клетках сетки между последовательными позициями
+	Distance int `json:"distance"`
+
+	// FirstAt Время первой позиции за день
+	FirstAt time.Time `json:"firstAt"`
+
+	// LastAt Время последней позиции за день
+	LastAt time.Time `json:"lastAt"`
+
+	// Points Число позиций за день
+	Points int `json:"points"`
 }
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+// CourierDeactivation defines model for CourierDeactivation.
+type CourierDeactivation struct {
+	// Reason Причина вывода курьера из работы
+	Reason DeactivationReason `json:"reason"`
 }
 
-// GetCouriers converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCouriers(ctx echo.Context) error {
-	var err error
+// CourierDeactivationResult defines model for CourierDeactivationResult.
+type CourierDeactivationResult struct {
+	// Reassigned Заказы, переданные другим курьерам
+	Reassigned []OrderReassignment `json:"reassigned"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCouriers(ctx)
-	return err
+	// Requeued Заказы, возвращенные в очередь на назначение
+	Requeued []openapi_types.UUID `json:"requeued"`
 }
 
-// CreateCourier converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateCourier(ctx echo.Context) error {
-	var err error
+// CourierDeviceHealth defines model for CourierDeviceHealth.
+type CourierDeviceHealth struct {
+	// CourierId Идентификатор курьера
+	CourierId openapi_types.UUID `json:"courierId"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateCourier(ctx)
-	return err
+	// CourierName Имя курьера
+	CourierName string `json:"courierName"`
+
+	// Health Состояние устройства курьера по показаниям за скользящее окно
+	Health DeviceHealth `json:"health"`
 }
 
-// CreateOrder converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateOrder(ctx echo.Context) error {
-	var err error
+// CourierImportReport defines model for CourierImportReport.
+type CourierImportReport struct {
+	// Created Количество созданных курьеров
+	Created int `json:"created"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateOrder(ctx)
-	return err
+	// Existing Количество строк с уже зарегистрированным externalId
+	Existing int `json:"existing"`
+
+	// Failed Количество некорректных строк
+	Failed int `json:"failed"`
+
+	// Rows Результаты по строкам пакета
+	Rows []CourierImportResult `json:"rows"`
 }
 
-// GetOrders converts echo context to params.
-func (w *ServerInterfaceWrapper) GetOrders(ctx echo.Context) error {
-	var err error
+// CourierImportResult defines model for CourierImportResult.
+type CourierImportResult struct {
+	// CourierId Идентификатор созданного или ранее зарегистрированного курьера
+	CourierId *openapi_types.UUID `json:"courierId,omitempty"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetOrders(ctx)
-	return err
+	// Error Причина, по которой строка некорректна
+	Error *string `json:"error,omitempty"`
+
+	// Existing Курьер с этим externalId уже был зарегистрирован
+	Existing *bool `json:"existing,omitempty"`
+
+	// Row Номер строки в пакете, для CSV — номер строки файла
+	Row int `json:"row"`
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+// CourierImportRow defines model for CourierImportRow.
+type CourierImportRow struct {
+	// ExternalId Идентификатор курьера в HR-системе, повторное создание с ним не создает дубликат
+	ExternalId *string `json:"externalId,omitempty"`
+
+	// Language Язык строк, адресованных курьеру
+	Language *Language `json:"language,omitempty"`
+
+	// Name Имя
+	Name string `json:"name"`
+
+	// Speed Скорость
+	Speed int `json:"speed"`
 }
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
+// CourierInsurance defines model for CourierInsurance.
+type CourierInsurance struct {
+	// Insured Курьер застрахован
+	Insured bool `json:"insured"`
 }
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+// CourierLocationBatch defines model for CourierLocationBatch.
+type CourierLocationBatch struct {
+	// Points Позиции в любом порядке
+	Points []CourierLocationPoint `json:"points"`
+}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
-	}
+// CourierLocationImport defines model for CourierLocationImport.
+type CourierLocationImport struct {
+	// Days Дневные треки за затронутые дни, начиная с самого раннего
+	Days []CourierDailyTrack `json:"days"`
 
-	router.GET(baseURL+"/api/v1/couriers", wrapper.GetCouriers)
-	router.POST(baseURL+"/api/v1/couriers", wrapper.CreateCourier)
-	router.POST(baseURL+"/api/v1/orders", wrapper.CreateOrder)
-	router.GET(baseURL+"/api/v1/orders/active", wrapper.GetOrders)
+	// Duplicates Число повторно переданных позиций
+	Duplicates int `json:"duplicates"`
+
+	// Imported Число позиций, добавленных в историю
+	Imported int `json:"imported"`
+
+	// Received Число полученных позиций
+	Received int `json:"received"`
+}
+
+// CourierLocationPoint Позиция курьера на сетке, записанная приложением
+type CourierLocationPoint struct {
+	Location Location `json:"location"`
+
+	// RecordedAt Время записи позиции, не позже текущего
+	RecordedAt time.Time `json:"recordedAt"`
+}
+
+// CourierLocationReport Текущая позиция курьера на сетке, определенная приложением по GPS
+type CourierLocationReport struct {
+	Location Location `json:"location"`
+
+	// RecordedAt Время определения позиции, не позже текущего. По умолчанию - время получения
+	RecordedAt *time.Time `json:"recordedAt,omitempty"`
+}
+
+// CourierMerge defines model for CourierMerge.
+type CourierMerge struct {
+	// AnnouncementDeliveries Количество доставленных объявлений, перенесенных от дубля
+	AnnouncementDeliveries int `json:"announcementDeliveries"`
+
+	// AssignmentExplanations Количество объяснений назначений, перенесенных от дубля
+	AssignmentExplanations int `json:"assignmentExplanations"`
+
+	// CourierId Идентификатор курьера, который остается
+	CourierId openapi_types.UUID `json:"courierId"`
+
+	// DailyTracks Количество дневных треков, перенесенных от дубля
+	DailyTracks int `json:"dailyTracks"`
+
+	// DeviceReadings Количество показаний устройства, перенесенных от дубля
+	DeviceReadings int `json:"deviceReadings"`
+
+	// DryRun Объединение было пробным и откачено
+	DryRun bool `json:"dryRun"`
+
+	// Earnings Количество начислений, перенесенных от дубля
+	Earnings int `json:"earnings"`
+
+	// Handovers Количество передач заказов, перенесенных от дубля
+	Handovers int `json:"handovers"`
+
+	// LocationPoints Количество точек трека, перенесенных от дубля
+	LocationPoints int `json:"locationPoints"`
+
+	// Orders Количество заказов, перенесенных от дубля
+	Orders int `json:"orders"`
+
+	// StoragePlaces Количество мест хранения, перенесенных от дубля
+	StoragePlaces int `json:"storagePlaces"`
+
+	// Substitutions Количество отсутствий других курьеров, в которых курьер стал заменой вместо дубля
+	Substitutions int `json:"substitutions"`
+
+	// VerificationAttempts Количество попыток подтверждения личности, перенесенных от дубля
+	VerificationAttempts int `json:"verificationAttempts"`
+}
+
+// CourierMergeRequest defines model for CourierMergeRequest.
+type CourierMergeRequest struct {
+	// DryRun Только показать, что будет перенесено, ничего не изменяя
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// DuplicateId Идентификатор дубля, который объединяется с курьером и удаляется
+	DuplicateId openapi_types.UUID `json:"duplicateId"`
+}
+
+// CourierProfile defines model for CourierProfile.
+type CourierProfile struct {
+	// Phone Телефон в формате E.164, например +79123456789
+	Phone *string `json:"phone,omitempty"`
+
+	// PhotoUrl Абсолютная http(s) ссылка на фото курьера
+	PhotoUrl *string `json:"photoUrl,omitempty"`
+
+	// VehiclePlate Госномер транспорта курьера
+	VehiclePlate *string `json:"vehiclePlate,omitempty"`
+
+	// VehicleType Вид транспорта курьера
+	VehicleType *VehicleType `json:"vehicleType,omitempty"`
+}
+
+// CourierSchedule defines model for CourierSchedule.
+type CourierSchedule struct {
+	// Id Идентификатор расписания, новый при каждой замене
+	Id openapi_types.UUID `json:"id"`
+
+	// Windows Окна расписания по дням недели начиная с воскресенья, затем по началу
+	Windows []CourierScheduleWindow `json:"windows"`
+}
+
+// CourierScheduleChange defines model for CourierScheduleChange.
+type CourierScheduleChange struct {
+	// Windows Окна расписания, хотя бы одно
+	Windows []CourierScheduleWindow `json:"windows"`
+}
+
+// CourierScheduleWindow defines model for CourierScheduleWindow.
+type CourierScheduleWindow struct {
+	// End Окончание окна в UTC, ЧЧ:ММ, позже начала; 24:00 означает конец дня
+	End string `json:"end"`
+
+	// Start Начало окна в UTC, ЧЧ:ММ
+	Start string `json:"start"`
+
+	// Weekday День недели
+	Weekday Weekday `json:"weekday"`
+}
+
+// CourierBadge defines model for CourierBadge.
+type CourierBadge struct {
+	// AwardedAt Время, когда значок заработан
+	AwardedAt time.Time `json:"awardedAt"`
+
+	// Name Название значка
+	Name string `json:"name"`
+}
+
+// CourierBreak defines model for CourierBreak.
+type CourierBreak struct {
+	// OnBreak Курьер на перерыве
+	OnBreak bool `json:"onBreak"`
+}
+
+// CourierShift defines model for CourierShift.
+type CourierShift struct {
+	// OnShift Курьер на смене
+	OnShift bool `json:"onShift"`
+
+	// Verification Подтверждение личности курьера
+	Verification *IdentityProof `json:"verification,omitempty"`
+}
+
+// CourierSnapshot Состояние курьера после изменения
+type CourierSnapshot struct {
+	// Deactivated Курьер выведен из работы
+	Deactivated bool `json:"deactivated"`
+
+	// Insured Курьер застрахован
+	Insured  bool     `json:"insured"`
+	Location Location `json:"location"`
+
+	// Name Имя
+	Name string `json:"name"`
+
+	// Paused Курьер на перерыве
+	Paused bool `json:"paused"`
+
+	// Speed Скорость
+	Speed int `json:"speed"`
+}
+
+// CourierStats defines model for CourierStats.
+type CourierStats struct {
+	// AverageRating Средняя оценка. Отсутствует, если заказы курьера еще не оценивали
+	AverageRating *float64 `json:"averageRating,omitempty"`
+
+	// Badges Заработанные значки в порядке получения
+	Badges []CourierBadge `json:"badges"`
+
+	// Deliveries Число доставленных заказов
+	Deliveries int `json:"deliveries"`
+
+	// Ratings Число оцененных заказов
+	Ratings int `json:"ratings"`
+}
+
+// CourierWorkingHours defines model for CourierWorkingHours.
+type CourierWorkingHours struct {
+	// CourierId Идентификатор курьера
+	CourierId openapi_types.UUID `json:"courierId"`
+
+	// LimitMinutes Дневной лимит рабочего времени в минутах
+	LimitMinutes int `json:"limitMinutes"`
+
+	// Name Имя курьера
+	Name string `json:"name"`
+
+	// OnShift Курьер на смене
+	OnShift bool `json:"onShift"`
+
+	// Status Положение относительно дневного лимита рабочего времени
+	Status WorkingHoursStatus `json:"status"`
+
+	// WorkedMinutes Отработано минут за текущие сутки, включая идущую смену
+	WorkedMinutes int `json:"workedMinutes"`
+}
+
+// DeactivationReason Причина вывода курьера из работы
+type DeactivationReason string
+
+// DeliveryTier Тариф доставки (по умолчанию экспресс)
+type DeliveryTier string
+
+// DeviceHealth Состояние устройства курьера по показаниям за скользящее окно
+type DeviceHealth struct {
+	// Battery Заряд батареи в процентах по последним показаниям
+	Battery *int `json:"battery,omitempty"`
+
+	// Charging Устройство заряжается по последним показаниям
+	Charging *bool `json:"charging,omitempty"`
+
+	// Connectivity Качество связи устройства
+	Connectivity *Connectivity `json:"connectivity,omitempty"`
+
+	// Degraded Курьер не получает заказы из-за состояния устройства
+	Degraded bool `json:"degraded"`
+
+	// LastSeenAt Время последних показаний
+	LastSeenAt *time.Time `json:"lastSeenAt,omitempty"`
+
+	// LowBattery Батарея разряжена и не заряжается
+	LowBattery bool `json:"lowBattery"`
+
+	// PoorConnectivity Устройство не в сети или плохая связь не менее чем в половине показаний за окно
+	PoorConnectivity bool `json:"poorConnectivity"`
+
+	// Reported За окно получены показания устройства. Без показаний остальные поля не заполнены, а курьер получает заказы
+	Reported bool `json:"reported"`
+}
+
+// DeviceTelemetry defines model for DeviceTelemetry.
+type DeviceTelemetry struct {
+	// Battery Заряд батареи в процентах
+	Battery int `json:"battery"`
+
+	// Charging Устройство заряжается
+	Charging bool `json:"charging"`
+
+	// Connectivity Качество связи устройства
+	Connectivity Connectivity `json:"connectivity"`
+
+	// RecordedAt Время снятия показаний (по умолчанию время получения)
+	RecordedAt *time.Time `json:"recordedAt,omitempty"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	// Code Код ошибки
+	Code int32 `json:"code"`
+
+	// Fields Ошибки валидации отдельных полей
+	Fields *[]FieldError `json:"fields,omitempty"`
+
+	// Message Текст ошибки
+	Message string `json:"message"`
+}
+
+// EstimatedArrival defines model for EstimatedArrival.
+type EstimatedArrival struct {
+	// CalculatedAt Время расчёта прогноза
+	CalculatedAt time.Time `json:"calculatedAt"`
+
+	// Turns Оставшееся время до доставки в ходах курьера
+	Turns int `json:"turns"`
+}
+
+// ExternalReference Заказ на маркетплейсе, который выполняет заказ доставки. Заказ маркетплейса связан не более чем с одним заказом доставки
+type ExternalReference struct {
+	// DeepLink Ссылка на заказ на маркетплейсе (http или https)
+	DeepLink *string `json:"deepLink,omitempty"`
+
+	// Marketplace Маркетплейс в нижнем регистре, например ozon
+	Marketplace string `json:"marketplace"`
+
+	// OrderId Идентификатор заказа на маркетплейсе
+	OrderId string `json:"orderId"`
+}
+
+// Language Язык строк, адресованных курьеру
+type Language string
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field Путь к полю, вложенные поля разделяются точкой
+	Field string `json:"field"`
+
+	// Message Текст ошибки поля
+	Message string `json:"message"`
+}
+
+// FleetSimulationOutcome Результат проигрывания заказов периода на парке курьеров
+type FleetSimulationOutcome struct {
+	// AverageBacklog Среднее число заказов, ожидающих курьера
+	AverageBacklog float64 `json:"averageBacklog"`
+
+	// AverageDeliverySeconds Среднее время от поступления до доставки заказа в секундах
+	AverageDeliverySeconds float64 `json:"averageDeliverySeconds"`
+
+	// Couriers Число курьеров в парке
+	Couriers int `json:"couriers"`
+
+	// Delivered Число доставленных из них заказов, в том числе после окончания периода
+	Delivered int `json:"delivered"`
+
+	// Orders Число заказов, поступивших за период
+	Orders int `json:"orders"`
+
+	// PeakBacklog Наибольшее число заказов, ожидавших курьера
+	PeakBacklog int `json:"peakBacklog"`
+
+	// Undelivered Число заказов, которые парк не успел доставить
+	Undelivered int `json:"undelivered"`
+}
+
+// FleetWhatIf defines model for FleetWhatIf.
+type FleetWhatIf struct {
+	// AddedCouriers Число добавленных курьеров
+	AddedCouriers int `json:"addedCouriers"`
+
+	// BacklogImprovement На сколько сократится среднее число заказов, ожидающих курьера
+	BacklogImprovement float64 `json:"backlogImprovement"`
+
+	// Baseline Результат проигрывания заказов периода на парке курьеров
+	Baseline FleetSimulationOutcome `json:"baseline"`
+
+	// DeliveryImprovementSeconds На сколько секунд сократится среднее время доставки; отрицательное, если возрастет
+	DeliveryImprovementSeconds float64 `json:"deliveryImprovementSeconds"`
+
+	// From Начало проигранного периода
+	From time.Time `json:"from"`
+
+	// Projected Результат проигрывания заказов периода на парке курьеров
+	Projected FleetSimulationOutcome `json:"projected"`
+
+	// Speed Скорость добавленных курьеров
+	Speed int `json:"speed"`
+
+	// To Конец проигранного периода
+	To time.Time `json:"to"`
+
+	// Zone Прямоугольная область сетки, заданная двумя противоположными углами включительно
+	Zone Zone `json:"zone"`
+}
+
+// FleetWhatIfRequest defines model for FleetWhatIfRequest.
+type FleetWhatIfRequest struct {
+	// Couriers Число добавляемых курьеров, от 1 до 100
+	Couriers int `json:"couriers"`
+
+	// Hours Число последних часов, заказы которых проигрываются, от 1 до 168
+	Hours *int `json:"hours,omitempty"`
+
+	// Speed Скорость добавляемых курьеров
+	Speed int `json:"speed"`
+
+	// Zone Прямоугольная область сетки, заданная двумя противоположными углами включительно
+	Zone *Zone `json:"zone,omitempty"`
+}
+
+// HandoverConfirmation defines model for HandoverConfirmation.
+type HandoverConfirmation struct {
+	// Step Передача застрахованного заказа: забор курьером на складе или вручение клиенту
+	Step HandoverStep `json:"step"`
+}
+
+// HandoverStep Передача застрахованного заказа: забор курьером на складе или вручение клиенту
+type HandoverStep string
+
+// IdentityProof Подтверждение личности курьера
+type IdentityProof struct {
+	// Method Способ подтверждения личности курьера
+	Method IdentityVerificationMethod `json:"method"`
+
+	// Secret PIN-код из 4–8 цифр или токен аттестации устройства
+	Secret string `json:"secret"`
+}
+
+// IdentityVerificationMethod Способ подтверждения личности курьера
+type IdentityVerificationMethod string
+
+// LinkedOrder Заказ доставки, связанный с заказом маркетплейса
+type LinkedOrder struct {
+	// CourierId Назначенный курьер. Отсутствует, если курьер не назначен
+	CourierId *openapi_types.UUID `json:"courierId,omitempty"`
+
+	// ExternalReference Заказ на маркетплейсе, который выполняет заказ доставки. Заказ маркетплейса связан не более чем с одним заказом доставки
+	ExternalReference ExternalReference `json:"externalReference"`
+
+	// Id Идентификатор заказа доставки
+	Id openapi_types.UUID `json:"id"`
+
+	// Status Статус заказа
+	Status OrderStatus `json:"status"`
+}
+
+// Location defines model for Location.
+type Location struct {
+	// X X
+	X int `json:"x"`
+
+	// Y Y
+	Y int `json:"y"`
+}
+
+// MaintenanceStatus Состояние окна обслуживания
+type MaintenanceStatus string
+
+// MaintenanceWindow defines model for MaintenanceWindow.
+type MaintenanceWindow struct {
+	// EndsAt Окончание обслуживания
+	EndsAt time.Time `json:"endsAt"`
+
+	// Id Идентификатор окна обслуживания
+	Id openapi_types.UUID `json:"id"`
+
+	// StartsAt Начало обслуживания
+	StartsAt time.Time `json:"startsAt"`
+
+	// Status Состояние окна обслуживания
+	Status MaintenanceStatus `json:"status"`
+}
+
+// MaintenanceWindowSchedule defines model for MaintenanceWindowSchedule.
+type MaintenanceWindowSchedule struct {
+	// EndsAt Окончание обслуживания, позже начала
+	EndsAt time.Time `json:"endsAt"`
+
+	// StartsAt Начало обслуживания
+	StartsAt time.Time `json:"startsAt"`
+}
+
+// MatchingRule Правило подбора курьеров, которое допускает к заказам только курьеров с подходящим транспортом или термоконтейнером
+type MatchingRule struct {
+	// Condition Заказы, к которым применяется правило. Должны выполняться все заданные критерии; правило без критериев применяется ко всем заказам
+	Condition MatchingRuleCondition `json:"condition"`
+
+	// Id Идентификатор правила
+	Id openapi_types.UUID `json:"id"`
+
+	// Name Название правила
+	Name string `json:"name"`
+
+	// ThermalStorage Заказ должен ехать в термоконтейнере
+	ThermalStorage bool `json:"thermalStorage"`
+
+	// VehicleTypes Допустимые виды транспорта; пустой список допускает любой транспорт
+	VehicleTypes []VehicleType `json:"vehicleTypes"`
+}
+
+// MatchingRuleCondition Заказы, к которым применяется правило. Должны выполняться все заданные критерии; правило без критериев применяется ко всем заказам
+type MatchingRuleCondition struct {
+	// TemperatureClass Температурный режим содержимого заказа (по умолчанию обычный)
+	TemperatureClass *TemperatureClass `json:"temperatureClass,omitempty"`
+
+	// VolumeAbove Объем заказа, который должен быть превышен
+	VolumeAbove *int `json:"volumeAbove,omitempty"`
+
+	// Zone Прямоугольная область сетки, заданная двумя противоположными углами включительно
+	Zone *Zone `json:"zone,omitempty"`
+}
+
+// MatchingRulesChange defines model for MatchingRulesChange.
+type MatchingRulesChange struct {
+	// Rules Правила подбора с различными названиями
+	Rules []NewMatchingRule `json:"rules"`
+}
+
+// MessageSender Автор сообщения
+type MessageSender string
+
+// Microzone Область плотного спроса, в которой сосредоточены доставки
+type Microzone struct {
+	// Bounds Прямоугольная область сетки, заданная двумя противоположными углами включительно
+	Bounds   Zone     `json:"bounds"`
+	Centroid Location `json:"centroid"`
+
+	// ComputedAt Время вычисления микрозоны
+	ComputedAt time.Time `json:"computedAt"`
+
+	// Deliveries Число завершенных доставок внутри микрозоны
+	Deliveries int `json:"deliveries"`
+
+	// Id Идентификатор микрозоны
+	Id openapi_types.UUID `json:"id"`
+}
+
+// MicrozoneRecomputation defines model for MicrozoneRecomputation.
+type MicrozoneRecomputation struct {
+	// Microzones Число найденных микрозон
+	Microzones int `json:"microzones"`
+}
+
+// NewAnnouncement defines model for NewAnnouncement.
+type NewAnnouncement struct {
+	// Text Текст объявления, не длиннее 280 символов
+	Text string `json:"text"`
+
+	// Zone Прямоугольная область сетки, заданная двумя противоположными углами включительно
+	Zone *Zone `json:"zone,omitempty"`
+}
+
+// NewCourier defines model for NewCourier.
+type NewCourier struct {
+	// ExternalId Идентификатор курьера в HR-системе, повторное создание с ним не создает дубликат
+	ExternalId *string `json:"externalId,omitempty"`
+
+	// Language Язык строк, адресованных курьеру
+	Language *Language `json:"language,omitempty"`
+
+	// Name Имя
+	Name string `json:"name"`
+
+	// Speed Скорость
+	Speed int `json:"speed"`
+}
+
+// NewMatchingRule defines model for NewMatchingRule.
+type NewMatchingRule struct {
+	// Condition Заказы, к которым применяется правило. Должны выполняться все заданные критерии; правило без критериев применяется ко всем заказам
+	Condition MatchingRuleCondition `json:"condition"`
+
+	// Name Название правила, уникальное без учета регистра
+	Name string `json:"name"`
+
+	// ThermalStorage Заказ должен ехать в термоконтейнере
+	ThermalStorage *bool `json:"thermalStorage,omitempty"`
+
+	// VehicleTypes Допустимые виды транспорта; пустой список допускает любой транспорт
+	VehicleTypes *[]VehicleType `json:"vehicleTypes,omitempty"`
+}
+
+// NewOrder defines model for NewOrder.
+type NewOrder struct {
+	// DeclaredValue Объявленная ценность содержимого в копейках. Заказы от порога страхования доставляют только застрахованные курьеры с подтверждением забора и вручения
+	DeclaredValue *int `json:"declaredValue,omitempty"`
+
+	// DeliveryTier Тариф доставки (по умолчанию экспресс)
+	DeliveryTier *DeliveryTier `json:"deliveryTier,omitempty"`
+
+	// ExternalReference Заказ на маркетплейсе, который выполняет заказ доставки. Заказ маркетплейса связан не более чем с одним заказом доставки
+	ExternalReference *ExternalReference `json:"externalReference,omitempty"`
+
+	// Items Позиции заказа
+	Items []OrderItem `json:"items"`
+
+	// PaymentMethod Способ оплаты заказа (по умолчанию оплата при получении)
+	PaymentMethod *PaymentMethod `json:"paymentMethod,omitempty"`
+
+	// Priority Приоритет распределения заказа (по умолчанию обычный). Ожидающие заказы назначаются курьерам начиная с самых срочных, при равном приоритете — начиная с самых ранних
+	Priority *OrderPriority `json:"priority,omitempty"`
+
+	// Street Улица доставки
+	Street string `json:"street"`
+
+	// TemperatureClass Температурный режим содержимого заказа (по умолчанию обычный)
+	TemperatureClass *TemperatureClass `json:"temperatureClass,omitempty"`
+}
+
+// NewOrderMessage defines model for NewOrderMessage.
+type NewOrderMessage struct {
+	// Sender Автор сообщения
+	Sender MessageSender `json:"sender"`
+
+	// Text Текст сообщения
+	Text string `json:"text"`
+}
+
+// NewPickupSlot defines model for NewPickupSlot.
+type NewPickupSlot struct {
+	// Capacity Число заказов, которые склад выдает в слоте
+	Capacity int `json:"capacity"`
+
+	// EndsAt Окончание слота, позже начала
+	EndsAt time.Time `json:"endsAt"`
+
+	// StartsAt Начало выдачи заказов слота
+	StartsAt time.Time `json:"startsAt"`
+}
+
+// NewRating defines model for NewRating.
+type NewRating struct {
+	// Stars Оценка доставки в звездах
+	Stars int `json:"stars"`
+}
+
+// NewSLATarget Цель SLA по времени доставки для тарифа доставки в районе
+type NewSLATarget struct {
+	// DeliveryMinutes Время доставки в минутах от создания заказа до его завершения, не более суток
+	DeliveryMinutes int `json:"deliveryMinutes"`
+
+	// DeliveryTier Тариф доставки (по умолчанию экспресс)
+	DeliveryTier DeliveryTier `json:"deliveryTier"`
+
+	// District Название района
+	District string `json:"district"`
+
+	// Goal Доля заказов в процентах, которые должны быть доставлены за это время
+	Goal int `json:"goal"`
+
+	// Zone Прямоугольная область сетки, заданная двумя противоположными углами включительно
+	Zone Zone `json:"zone"`
+}
+
+// Order defines model for Order.
+type Order struct {
+	// BatchClosesAt Когда закроется окно группировки и эконом-заказ поступит в распределение. Отсутствует для экспресс-заказов и после закрытия окна
+	BatchClosesAt *time.Time `json:"batchClosesAt,omitempty"`
+
+	// DeclaredValue Объявленная ценность содержимого в копейках (0, если не объявлена)
+	DeclaredValue int `json:"declaredValue"`
+
+	// DeliveryConfirmedAt Когда курьер подтвердил вручение застрахованного заказа. Отсутствует до подтверждения
+	DeliveryConfirmedAt *time.Time `json:"deliveryConfirmedAt,omitempty"`
+
+	// DeliveryTier Тариф доставки (по умолчанию экспресс)
+	DeliveryTier DeliveryTier `json:"deliveryTier"`
+
+	// Id Идентификатор
+	Id openapi_types.UUID `json:"id"`
+
+	// InsuranceRequired Заказ застрахован, курьер подтверждает его забор и вручение
+	InsuranceRequired bool `json:"insuranceRequired"`
+
+	// Items Позиции заказа (пусто для заказов, созданных без позиций)
+	Items    []OrderItem `json:"items"`
+	Location Location    `json:"location"`
+
+	// PaymentMethod Способ оплаты заказа (по умолчанию оплата при получении)
+	PaymentMethod PaymentMethod `json:"paymentMethod"`
+
+	// PaymentStatus Статус оплаты заказа
+	PaymentStatus PaymentStatus `json:"paymentStatus"`
+
+	// PickupConfirmedAt Когда курьер подтвердил забор застрахованного заказа. Отсутствует до подтверждения
+	PickupConfirmedAt *time.Time `json:"pickupConfirmedAt,omitempty"`
+
+	// RouteDeviatedAt Когда заказ отмечен для диспетчера, так как курьер отклонился от маршрута. Отсутствует, если отклонения не было
+	RouteDeviatedAt *time.Time `json:"routeDeviatedAt,omitempty"`
+
+	// Volume Объем
+	Volume int `json:"volume"`
+}
+
+// OrderEta defines model for OrderEta.
+type OrderEta struct {
+	// ArrivesAt Ожидаемое время доставки
+	ArrivesAt time.Time `json:"arrivesAt"`
+
+	// CalculatedAt Время расчёта прогноза
+	CalculatedAt time.Time `json:"calculatedAt"`
+
+	// CourierId Идентификатор курьера, который везет заказ
+	CourierId openapi_types.UUID `json:"courierId"`
+
+	// OrderId Идентификатор заказа
+	OrderId openapi_types.UUID `json:"orderId"`
+
+	// Turns Оставшееся время до доставки в ходах курьера
+	Turns int `json:"turns"`
+}
+
+// OrderHistoryEntry defines model for OrderHistoryEntry.
+type OrderHistoryEntry struct {
+	// ChangedAt Время перехода
+	ChangedAt time.Time `json:"changedAt"`
+
+	// CourierId Курьер, у которого заказ после перехода. Отсутствует, если курьер не назначен
+	CourierId *openapi_types.UUID `json:"courierId,omitempty"`
+
+	// Status Статус заказа
+	Status OrderStatus `json:"status"`
+}
+
+// OrderItem defines model for OrderItem.
+type OrderItem struct {
+	// Quantity Количество
+	Quantity int `json:"quantity"`
+
+	// Sku Артикул
+	Sku string `json:"sku"`
+
+	// UnitVolume Объем единицы товара
+	UnitVolume int `json:"unitVolume"`
+}
+
+// OrderMessage defines model for OrderMessage.
+type OrderMessage struct {
+	// Id Идентификатор
+	Id openapi_types.UUID `json:"id"`
+
+	// Sender Автор сообщения
+	Sender MessageSender `json:"sender"`
+
+	// SentAt Время отправки
+	SentAt time.Time `json:"sentAt"`
+
+	// Text Текст сообщения
+	Text string `json:"text"`
+}
+
+// OrderPage Страница заказов
+type OrderPage struct {
+	// Limit Наибольшее число заказов на странице
+	Limit int `json:"limit"`
+
+	// Offset Сколько заказов пропущено от начала выборки
+	Offset int `json:"offset"`
+
+	// Orders Заказы страницы
+	Orders []OrderSummary `json:"orders"`
+
+	// Total Сколько всего заказов подходит под фильтр
+	Total int64 `json:"total"`
+}
+
+// OrderPriority Приоритет распределения заказа (по умолчанию обычный). Ожидающие заказы назначаются курьерам начиная с самых срочных, при равном приоритете — начиная с самых ранних
+type OrderPriority string
+
+// OrderReassignment defines model for OrderReassignment.
+type OrderReassignment struct {
+	// CourierId Идентификатор нового курьера
+	CourierId openapi_types.UUID `json:"courierId"`
+
+	// OrderId Идентификатор заказа
+	OrderId openapi_types.UUID `json:"orderId"`
+}
+
+// OrderSnapshot Состояние заказа после изменения
+type OrderSnapshot struct {
+	// CourierId Назначенный курьер. Отсутствует, если курьер не назначен
+	CourierId *openapi_types.UUID `json:"courierId,omitempty"`
+
+	// DeclaredValue Объявленная ценность содержимого в копейках (0, если не объявлена)
+	DeclaredValue int `json:"declaredValue"`
+
+	// DeliveryTier Тариф доставки (по умолчанию экспресс)
+	DeliveryTier DeliveryTier `json:"deliveryTier"`
+
+	// ExternalReference Заказ на маркетплейсе, который выполняет заказ доставки. Заказ маркетплейса связан не более чем с одним заказом доставки
+	ExternalReference *ExternalReference `json:"externalReference,omitempty"`
+
+	// InsuranceRequired Заказ застрахован
+	InsuranceRequired bool     `json:"insuranceRequired"`
+	Location          Location `json:"location"`
+
+	// RatingStars Оценка доставки в звездах. Отсутствует, если заказ не оценен
+	RatingStars *int `json:"ratingStars,omitempty"`
+
+	// Status Статус заказа
+	Status OrderStatus `json:"status"`
+
+	// Volume Объем
+	Volume int `json:"volume"`
+}
+
+// OrderState defines model for OrderState.
+type OrderState struct {
+	// ChangedAt Время изменения, после которого заказ находился в этом состоянии
+	ChangedAt time.Time `json:"changedAt"`
+
+	// CourierId Курьер, у которого был заказ. Отсутствует, если курьер не был назначен
+	CourierId *openapi_types.UUID `json:"courierId,omitempty"`
+
+	// Id Идентификатор заказа
+	Id       openapi_types.UUID `json:"id"`
+	Location Location           `json:"location"`
+
+	// Status Статус заказа
+	Status OrderStatus `json:"status"`
+}
+
+// OrderStatus Статус заказа
+type OrderStatus string
+
+// OrderSummary defines model for OrderSummary.
+type OrderSummary struct {
+	// CompletedAt Когда заказ доставлен. Отсутствует для недоставленных заказов
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+
+	// CourierId Курьер, назначенный на заказ. Отсутствует, пока заказ не назначен
+	CourierId *openapi_types.UUID `json:"courierId,omitempty"`
+
+	// CreatedAt Когда заказ создан
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DeliveryTier Тариф доставки (по умолчанию экспресс)
+	DeliveryTier DeliveryTier `json:"deliveryTier"`
+
+	// Id Идентификатор
+	Id       openapi_types.UUID `json:"id"`
+	Location Location           `json:"location"`
+
+	// PaymentStatus Статус оплаты заказа
+	PaymentStatus PaymentStatus `json:"paymentStatus"`
+
+	// Priority Приоритет распределения заказа (по умолчанию обычный). Ожидающие заказы назначаются курьерам начиная с самых срочных, при равном приоритете — начиная с самых ранних
+	Priority OrderPriority `json:"priority"`
+
+	// Status Статус заказа
+	Status OrderStatus `json:"status"`
+
+	// Volume Объем
+	Volume int `json:"volume"`
+}
+
+// OrderThread defines model for OrderThread.
+type OrderThread struct {
+	// Closed Переписка закрыта (заказ завершен)
+	Closed   bool           `json:"closed"`
+	Messages []OrderMessage `json:"messages"`
+}
+
+// OrderTransfer defines model for OrderTransfer.
+type OrderTransfer struct {
+	EstimatedArrival EstimatedArrival `json:"estimatedArrival"`
+
+	// FromCourierId Идентификатор передавшего курьера
+	FromCourierId openapi_types.UUID `json:"fromCourierId"`
+
+	// FromStoragePlaceId Место хранения, в котором заказ был до передачи
+	FromStoragePlaceId openapi_types.UUID `json:"fromStoragePlaceId"`
+
+	// HandedOverAt Время передачи
+	HandedOverAt time.Time `json:"handedOverAt"`
+
+	// Id Идентификатор передачи
+	Id       openapi_types.UUID `json:"id"`
+	Location Location           `json:"location"`
+
+	// OrderId Идентификатор заказа
+	OrderId openapi_types.UUID `json:"orderId"`
+
+	// ToCourierId Идентификатор принявшего курьера
+	ToCourierId openapi_types.UUID `json:"toCourierId"`
+
+	// ToStoragePlaceId Место хранения, в котором заказ находится после передачи
+	ToStoragePlaceId openapi_types.UUID `json:"toStoragePlaceId"`
+}
+
+// OrderTransferRequest defines model for OrderTransferRequest.
+type OrderTransferRequest struct {
+	// CourierId Идентификатор принимающего курьера
+	CourierId openapi_types.UUID `json:"courierId"`
+
+	// StoragePlaceId Идентификатор места хранения принимающего курьера, в которое перекладывается заказ
+	StoragePlaceId openapi_types.UUID `json:"storagePlaceId"`
+}
+
+// OrderUnderReview defines model for OrderUnderReview.
+type OrderUnderReview struct {
+	// Id Идентификатор
+	Id       openapi_types.UUID `json:"id"`
+	Location Location           `json:"location"`
+
+	// Reason Причина задержки
+	Reason string `json:"reason"`
+
+	// Volume Объем заказа
+	Volume int `json:"volume"`
+}
+
+// OrderUploadReport defines model for OrderUploadReport.
+type OrderUploadReport struct {
+	// Created Количество созданных заказов
+	Created int `json:"created"`
+
+	// Failed Количество строк, по которым заказ не создан
+	Failed int `json:"failed"`
+
+	// Rows Результаты по строкам файла
+	Rows []OrderUploadRow `json:"rows"`
+}
+
+// OrderUploadRow defines model for OrderUploadRow.
+type OrderUploadRow struct {
+	// Error Причина, по которой заказ не создан
+	Error *string `json:"error,omitempty"`
+
+	// OrderId Идентификатор созданного заказа
+	OrderId *openapi_types.UUID `json:"orderId,omitempty"`
+
+	// Row Номер строки в файле
+	Row int `json:"row"`
+
+	// UnderReview Заказ создан, но задержан антифрод-проверкой
+	UnderReview *bool `json:"underReview,omitempty"`
+}
+
+// NewTip defines model for NewTip.
+type NewTip struct {
+	// Amount Сумма чаевых в минимальных единицах валюты
+	Amount int `json:"amount"`
+}
+
+// PaymentEvent defines model for PaymentEvent.
+type PaymentEvent struct {
+	// EventId Идентификатор события у платежного провайдера
+	EventId string `json:"eventId"`
+
+	// OccurredAt Время события
+	OccurredAt time.Time `json:"occurredAt"`
+
+	// OrderId Идентификатор заказа
+	OrderId openapi_types.UUID `json:"orderId"`
+
+	// Status Статус оплаты заказа
+	Status PaymentStatus `json:"status"`
+}
+
+// PaymentMethod Способ оплаты заказа (по умолчанию оплата при получении)
+type PaymentMethod string
+
+// PaymentStatus Статус оплаты заказа
+type PaymentStatus string
+
+// PersonalDataErasure defines model for PersonalDataErasure.
+type PersonalDataErasure struct {
+	Erased PersonalDataRecords `json:"erased"`
+
+	// ErasedAt Время стирания
+	ErasedAt time.Time `json:"erasedAt"`
+
+	// Id Идентификатор записи аудита
+	Id openapi_types.UUID `json:"id"`
+
+	// OrderIds Идентификаторы стертых заказов
+	OrderIds  []openapi_types.UUID `json:"orderIds"`
+	Remaining PersonalDataRecords  `json:"remaining"`
+
+	// RequestReference Ссылка на запрос субъекта данных
+	RequestReference string `json:"requestReference"`
+}
+
+// PersonalDataErasureRequest defines model for PersonalDataErasureRequest.
+type PersonalDataErasureRequest struct {
+	// OrderIds Идентификаторы заказов клиента, не больше 100
+	OrderIds []openapi_types.UUID `json:"orderIds"`
+
+	// RequestReference Ссылка на запрос субъекта данных, например номер обращения, до 128 символов
+	RequestReference string `json:"requestReference"`
+}
+
+// PersonalDataRecords defines model for PersonalDataRecords.
+type PersonalDataRecords struct {
+	// ChangeSnapshots Количество записей журнала изменений с внешними ссылками
+	ChangeSnapshots int `json:"changeSnapshots"`
+
+	// EventTombstones Количество tombstone-событий, опубликованных для заказов
+	EventTombstones int `json:"eventTombstones"`
+
+	// ExternalReferences Количество внешних ссылок на заказы маркетплейсов
+	ExternalReferences int `json:"externalReferences"`
+
+	// Messages Количество сообщений
+	Messages int `json:"messages"`
+
+	// PaymentReferences Количество ссылок платежного провайдера
+	PaymentReferences int `json:"paymentReferences"`
+
+	// TrackingTokens Количество ссылок на отслеживание
+	TrackingTokens int `json:"trackingTokens"`
+}
+
+// PickupSlot defines model for PickupSlot.
+type PickupSlot struct {
+	// Booked Число забронированных мест
+	Booked int `json:"booked"`
+
+	// Capacity Число заказов, которые склад выдает в слоте
+	Capacity int `json:"capacity"`
+
+	// EndsAt Окончание слота
+	EndsAt time.Time `json:"endsAt"`
+
+	// Id Идентификатор слота выдачи
+	Id openapi_types.UUID `json:"id"`
+
+	// Remaining Число свободных мест
+	Remaining int `json:"remaining"`
+
+	// StartsAt Начало выдачи заказов слота
+	StartsAt time.Time `json:"startsAt"`
+}
+
+// PickupSlotCapacity defines model for PickupSlotCapacity.
+type PickupSlotCapacity struct {
+	// Capacity Число заказов, которые склад выдает в слоте
+	Capacity int `json:"capacity"`
+}
+
+// Rating defines model for Rating.
+type Rating struct {
+	// RatedAt Время, когда заказ оценен
+	RatedAt time.Time `json:"ratedAt"`
+
+	// Stars Оценка доставки в звездах
+	Stars int `json:"stars"`
+}
+
+// Rollout defines model for Rollout.
+type Rollout struct {
+	// Flag Название флага
+	Flag string `json:"flag"`
+
+	// Percentage Текущая доля включения в процентах
+	Percentage int `json:"percentage"`
+}
+
+// RolloutChange defines model for RolloutChange.
+type RolloutChange struct {
+	// Percentage Доля решений в процентах, принимаемых новым алгоритмом
+	Percentage int `json:"percentage"`
+}
+
+// SLAComplianceComputation defines model for SLAComplianceComputation.
+type SLAComplianceComputation struct {
+	// Day День UTC в формате YYYY-MM-DD, не позже сегодняшнего
+	Day string `json:"day"`
+}
+
+// SLAComplianceComputationResult defines model for SLAComplianceComputationResult.
+type SLAComplianceComputationResult struct {
+	// Day День UTC в формате YYYY-MM-DD
+	Day string `json:"day"`
+
+	// Missed Число целей, не достигнутых за день
+	Missed int `json:"missed"`
+
+	// Targets Число целей, для которых вычислено соблюдение
+	Targets int `json:"targets"`
+}
+
+// SLADistrictReport Соблюдение SLA районом для тарифа доставки за период. Время доставки и цель взяты за последний день периода, с ней же сравнивается соблюдение за весь период
+type SLADistrictReport struct {
+	// Compliance Доля доставленных вовремя заказов в процентах; 100, если заказов не было
+	Compliance float64 `json:"compliance"`
+
+	// Delivered Число заказов, доставленных в район за период
+	Delivered int `json:"delivered"`
+
+	// DeliveryMinutes Время доставки в минутах
+	DeliveryMinutes int `json:"deliveryMinutes"`
+
+	// DeliveryTier Тариф доставки (по умолчанию экспресс)
+	DeliveryTier DeliveryTier `json:"deliveryTier"`
+
+	// District Название района
+	District string `json:"district"`
+
+	// Goal Цель в процентах доставленных вовремя заказов
+	Goal int `json:"goal"`
+
+	// Met Цель достигнута за период
+	Met bool `json:"met"`
+
+	// OnTime Число заказов, доставленных вовремя
+	OnTime int `json:"onTime"`
+
+	// Trend Соблюдение SLA по дням периода, начиная с самого раннего
+	Trend []SLAReportDay `json:"trend"`
+}
+
+// SLAReport defines model for SLAReport.
+type SLAReport struct {
+	Districts []SLADistrictReport `json:"districts"`
+
+	// From Первый день периода в формате YYYY-MM-DD
+	From string `json:"from"`
+
+	// To Последний день периода в формате YYYY-MM-DD
+	To string `json:"to"`
+}
+
+// SLAReportDay Соблюдение SLA районом за день по цели, действовавшей в этот день
+type SLAReportDay struct {
+	// Compliance Доля доставленных вовремя заказов в процентах; 100, если заказов не было
+	Compliance float64 `json:"compliance"`
+
+	// Day День UTC в формате YYYY-MM-DD
+	Day string `json:"day"`
+
+	// Delivered Число заказов, доставленных в район за день
+	Delivered int `json:"delivered"`
+
+	// Goal Цель в процентах доставленных вовремя заказов
+	Goal int `json:"goal"`
+
+	// Met Цель достигнута за день
+	Met bool `json:"met"`
+
+	// OnTime Число заказов, доставленных вовремя
+	OnTime int `json:"onTime"`
+}
+
+// SLATarget Цель SLA по времени доставки для тарифа доставки в районе
+type SLATarget struct {
+	// DeliveryMinutes Время доставки в минутах от создания заказа до его завершения
+	DeliveryMinutes int `json:"deliveryMinutes"`
+
+	// DeliveryTier Тариф доставки (по умолчанию экспресс)
+	DeliveryTier DeliveryTier `json:"deliveryTier"`
+
+	// District Название района
+	District string `json:"district"`
+
+	// Goal Доля заказов в процентах, которые должны быть доставлены за это время
+	Goal int `json:"goal"`
+
+	// Id Идентификатор цели
+	Id openapi_types.UUID `json:"id"`
+
+	// Zone Прямоугольная область сетки, заданная двумя противоположными углами включительно
+	Zone Zone `json:"zone"`
+}
+
+// SLATargetsChange defines model for SLATargetsChange.
+type SLATargetsChange struct {
+	// Targets Цели SLA, не более одной для тарифа в районе
+	Targets []NewSLATarget `json:"targets"`
+}
+
+// SLOPercentiles Процентили задержки этапа в секундах по методу ближайшего ранга; 0, если заказов не было
+type SLOPercentiles struct {
+	P50 float64 `json:"p50"`
+	P90 float64 `json:"p90"`
+	P95 float64 `json:"p95"`
+	P99 float64 `json:"p99"`
+}
+
+// SLOStage Соблюдение SLO заказами, завершившими этап за окно
+type SLOStage struct {
+	// AlertBurnRate Скорость расходования бюджета ошибок, с которой отправляется оповещение
+	AlertBurnRate float64 `json:"alertBurnRate"`
+
+	// BurnRate Скорость расходования бюджета ошибок; при 1 бюджет расходуется ровно с допустимой целью скоростью
+	BurnRate float64 `json:"burnRate"`
+
+	// Burning Скорость расходования достигла порога оповещения
+	Burning bool `json:"burning"`
+
+	// Compliance Доля заказов, уложившихся в порог, в процентах; 100, если заказов не было
+	Compliance float64 `json:"compliance"`
+
+	// Goal Цель в процентах заказов, уложившихся в порог
+	Goal float64 `json:"goal"`
+
+	// Met Цель достигнута
+	Met bool `json:"met"`
+
+	// Orders Число заказов, завершивших этап за окно
+	Orders int `json:"orders"`
+
+	// Percentiles Процентили задержки этапа в секундах по методу ближайшего ранга; 0, если заказов не было
+	Percentiles SLOPercentiles `json:"percentiles"`
+
+	// Stage Этап заказа, assignment или delivery
+	Stage string `json:"stage"`
+
+	// ThresholdSeconds Наибольшая задержка этапа в секундах, укладывающаяся в SLO
+	ThresholdSeconds int `json:"thresholdSeconds"`
+
+	// Within Число заказов, уложившихся в порог
+	Within int `json:"within"`
+}
+
+// SLOStatus defines model for SLOStatus.
+type SLOStatus struct {
+	// MeasuredAt Конец окна
+	MeasuredAt time.Time `json:"measuredAt"`
+
+	// QueueDepth Число заказов, ожидающих курьера, без заказов на проверке
+	QueueDepth int `json:"queueDepth"`
+
+	// Stages Этапы в порядке назначение, доставка
+	Stages []SLOStage `json:"stages"`
+
+	// WindowSeconds Длительность скользящего окна в секундах
+	WindowSeconds int `json:"windowSeconds"`
+}
+
+// ScoreFactor defines model for ScoreFactor.
+type ScoreFactor struct {
+	// Contribution Вклад фактора в оценку (значение, умноженное на вес)
+	Contribution float64 `json:"contribution"`
+
+	// Name Фактор оценки (travel_time, storage_waste, distance, occupied_places, remaining_volume)
+	Name string `json:"name"`
+
+	// Value Значение фактора
+	Value float64 `json:"value"`
+
+	// Weight Вес фактора
+	Weight float64 `json:"weight"`
+}
+
+// SharedTracking defines model for SharedTracking.
+type SharedTracking struct {
+	// BatchClosesAt Когда эконом-заказ поступит в распределение; раньше этого времени доставка не обещается. Отсутствует для экспресс-заказов и после закрытия окна группировки
+	BatchClosesAt *time.Time `json:"batchClosesAt,omitempty"`
+
+	// CourierFirstName Имя курьера без фамилии. Отсутствует, если курьер не назначен или заказ доставлен
+	CourierFirstName *string `json:"courierFirstName,omitempty"`
+
+	// CourierLocation Примерное положение курьера (центр квадрата сетки). Отсутствует, если курьер не назначен или заказ доставлен
+	CourierLocation *Location `json:"courierLocation,omitempty"`
+
+	// CourierPhotoUrl Ссылка на фото курьера, если оно загружено
+	CourierPhotoUrl *string `json:"courierPhotoUrl,omitempty"`
+
+	// Eta Оставшееся время доставки в тактах
+	Eta *int `json:"eta,omitempty"`
+
+	// Status Статус заказа
+	Status OrderStatus `json:"status"`
+}
+
+// StoragePlace defines model for StoragePlace.
+type StoragePlace struct {
+	// Id Идентификатор
+	Id openapi_types.UUID `json:"id"`
+
+	// Name Название
+	Name string `json:"name"`
+
+	// OrderId Заказ в месте хранения. Отсутствует, если место свободно
+	OrderId *openapi_types.UUID `json:"orderId,omitempty"`
+
+	// OutOfService Место хранения выведено из эксплуатации
+	OutOfService bool `json:"outOfService"`
+
+	// Thermal Термоконтейнер, в котором охлажденные и замороженные заказы сохраняют температуру
+	Thermal bool `json:"thermal"`
+
+	// TotalVolume Вместимость
+	TotalVolume int `json:"totalVolume"`
+}
+
+// StoragePlaceMaintenance defines model for StoragePlaceMaintenance.
+type StoragePlaceMaintenance struct {
+	// OutOfService Место хранения выведено из эксплуатации
+	OutOfService bool `json:"outOfService"`
+}
+
+// SurgeMode defines model for SurgeMode.
+type SurgeMode struct {
+	// Active Режим часа пик включен
+	Active bool `json:"active"`
+
+	// Setting Настройка режима часа пик. auto - режим включается и выключается по очереди заказов, on и off - режим включен или выключен вручную
+	Setting SurgeSetting `json:"setting"`
+
+	// Toggles Последние переключения режима, начиная с самого позднего
+	Toggles []SurgeToggle `json:"toggles"`
+}
+
+// SurgeModeChange defines model for SurgeModeChange.
+type SurgeModeChange struct {
+	// Reason Причина изменения для журнала
+	Reason string `json:"reason"`
+
+	// Setting Настройка режима часа пик. auto - режим включается и выключается по очереди заказов, on и off - режим включен или выключен вручную
+	Setting SurgeSetting `json:"setting"`
+}
+
+// SurgeModeChangeResult defines model for SurgeModeChangeResult.
+type SurgeModeChangeResult struct {
+	// Invited Число курьеров вне смены, получивших приглашение выйти на смену за надбавку
+	Invited int `json:"invited"`
+
+	// Toggle Запись журнала переключений режима часа пик
+	Toggle SurgeToggle `json:"toggle"`
+
+	// Uninvited Число курьеров вне смены, которым не удалось отправить приглашение
+	Uninvited int `json:"uninvited"`
+}
+
+// SurgeSetting Настройка режима часа пик. auto - режим включается и выключается по очереди заказов, on и off - режим включен или выключен вручную
+type SurgeSetting string
+
+// SurgeToggle Запись журнала переключений режима часа пик
+type SurgeToggle struct {
+	// Active Режим включен после переключения
+	Active bool `json:"active"`
+
+	// Backlog Число заказов в очереди в момент переключения
+	Backlog int `json:"backlog"`
+
+	// Id Идентификатор записи
+	Id openapi_types.UUID `json:"id"`
+
+	// Reason Причина изменения. Отсутствует у автоматических переключений
+	Reason *string `json:"reason,omitempty"`
+
+	// Setting Настройка режима часа пик. auto - режим включается и выключается по очереди заказов, on и off - режим включен или выключен вручную
+	Setting SurgeSetting `json:"setting"`
+
+	// Source Кто переключил режим часа пик. manual - диспетчер, automatic - очередь заказов
+	Source SurgeToggleSource `json:"source"`
+
+	// ToggledAt Время переключения
+	ToggledAt time.Time `json:"toggledAt"`
+
+	// WasActive Режим был включен до переключения
+	WasActive bool `json:"wasActive"`
+}
+
+// SurgeToggleSource Кто переключил режим часа пик. manual - диспетчер, automatic - очередь заказов
+type SurgeToggleSource string
+
+// SyntheticDataPurge defines model for SyntheticDataPurge.
+type SyntheticDataPurge struct {
+	// OlderThanSeconds Минимальный возраст удаляемых данных в секундах; 0 удаляет все тестовые данные
+	OlderThanSeconds int `json:"olderThanSeconds"`
+}
+
+// SyntheticDataPurgeResult defines model for SyntheticDataPurgeResult.
+type SyntheticDataPurgeResult struct {
+	// Couriers Количество удаленных курьеров
+	Couriers int `json:"couriers"`
+
+	// Orders Количество удаленных заказов
+	Orders int `json:"orders"`
+}
+
+// TemperatureClass Температурный режим содержимого заказа (по умолчанию обычный)
+type TemperatureClass string
+
+// Tip defines model for Tip.
+type Tip struct {
+	// Amount Сумма чаевых в минимальных единицах валюты
+	Amount int `json:"amount"`
+
+	// TippedAt Время, когда чаевые оставлены
+	TippedAt time.Time `json:"tippedAt"`
+}
+
+// TrackingLink defines model for TrackingLink.
+type TrackingLink struct {
+	// Path Путь публичного метода отслеживания
+	Path string `json:"path"`
+
+	// Token Токен отслеживания
+	Token string `json:"token"`
+}
+
+// VehicleType Вид транспорта курьера
+type VehicleType string
+
+// Weekday День недели
+type Weekday string
+
+// WorkingHoursStatus Положение относительно дневного лимита рабочего времени
+type WorkingHoursStatus string
+
+// Zone Прямоугольная область сетки, заданная двумя противоположными углами включительно
+type Zone struct {
+	From Location `json:"from"`
+	To   Location `json:"to"`
+}
+
+// GetPayoutExportParams defines parameters for GetPayoutExport.
+type GetPayoutExportParams struct {
+	// From Начало периода
+	From time.Time `form:"from" json:"from"`
+
+	// To Конец периода
+	To time.Time `form:"to" json:"to"`
+}
+
+// GetCouriersParams defines parameters for GetCouriers.
+type GetCouriersParams struct {
+	// Fields Поля ответа через запятую, вложенные поля в скобках, например id,name,storagePlaces(id,orderId). Без параметра возвращаются все поля
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// ImportCouriersJSONBody defines parameters for ImportCouriers.
+type ImportCouriersJSONBody = []CourierImportRow
+
+// ListOrdersParams defines parameters for ListOrders.
+type ListOrdersParams struct {
+	// Status Статусы заказов через повтор параметра, например status=created&status=assigned. Без параметра возвращаются заказы во всех статусах
+	Status *[]OrderStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Sort Порядок заказов (по умолчанию createdAt, сначала самые ранние). Знак минус сортирует в обратном порядке, заказы с равным значением упорядочены по идентификатору
+	Sort *ListOrdersParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit Наибольшее число заказов на странице (по умолчанию 50)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Сколько заказов пропустить от начала выборки (по умолчанию 0)
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListOrdersParamsSort defines parameters for ListOrders.
+type ListOrdersParamsSort string
+
+// GetOrdersParams defines parameters for GetOrders.
+type GetOrdersParams struct {
+	// Fields Поля ответа через запятую, вложенные поля в скобках, например id,location,items(sku). Без параметра возвращаются все поля
+	Fields *string `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// UploadOrdersMultipartBody defines parameters for UploadOrders.
+type UploadOrdersMultipartBody struct {
+	// File Файл с заказами (.csv или .xlsx)
+	File openapi_types.File `json:"file"`
+}
+
+// GetOrderParams defines parameters for GetOrder.
+type GetOrderParams struct {
+	// AsOf Момент, на который нужно состояние заказа. По умолчанию текущий
+	AsOf *time.Time `form:"asOf,omitempty" json:"asOf,omitempty"`
+}
+
+// ReceivePaymentEventParams defines parameters for ReceivePaymentEvent.
+type ReceivePaymentEventParams struct {
+	// XPaymentSignature HMAC-SHA256 тела запроса с секретом PAYMENT_WEBHOOK_SECRET в шестнадцатеричном виде
+	XPaymentSignature *string `json:"X-Payment-Signature,omitempty"`
+}
+
+// GetSLAReportParams defines parameters for GetSLAReport.
+type GetSLAReportParams struct {
+	// From Первый день периода в формате YYYY-MM-DD (по умолчанию за 30 дней до последнего)
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Последний день периода в формате YYYY-MM-DD включительно (по умолчанию вчерашний, по UTC)
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// TipOrderParams defines parameters for TipOrder.
+type TipOrderParams struct {
+	// IdempotencyKey Ключ идемпотентности, выбранный клиентом для этих чаевых
+	IdempotencyKey string `json:"Idempotency-Key"`
+}
+
+// GetChangesParams defines parameters for GetChanges.
+type GetChangesParams struct {
+	// Since Курсор последнего полученного изменения (по умолчанию 0, с начала журнала)
+	Since *int64 `form:"since,omitempty" json:"since,omitempty"`
+
+	// Limit Наибольшее число изменений на странице (по умолчанию 100)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetCourierAvailabilityFeedParams defines parameters for GetCourierAvailabilityFeed.
+type GetCourierAvailabilityFeedParams struct {
+	// Since Курсор последнего полученного изменения (по умолчанию 0, с начала журнала)
+	Since *int64 `form:"since,omitempty" json:"since,omitempty"`
+
+	// Limit Наибольшее число изменений на странице (по умолчанию 100)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetAPIUsageParams defines parameters for GetAPIUsage.
+type GetAPIUsageParams struct {
+	// Month Месяц в формате YYYY-MM (по умолчанию текущий, по UTC)
+	Month *string `form:"month,omitempty" json:"month,omitempty"`
+}
+
+// GetOwnAPIUsageParams defines parameters for GetOwnAPIUsage.
+type GetOwnAPIUsageParams struct {
+	// Month Месяц в формате YYYY-MM (по умолчанию текущий, по UTC)
+	Month *string `form:"month,omitempty" json:"month,omitempty"`
+}
+
+// BroadcastAnnouncementJSONRequestBody defines body for BroadcastAnnouncement for application/json ContentType.
+type BroadcastAnnouncementJSONRequestBody = NewAnnouncement
+
+// PlanCourierAbsenceJSONRequestBody defines body for PlanCourierAbsence for application/json ContentType.
+type PlanCourierAbsenceJSONRequestBody = CourierAbsencePlan
+
+// DeactivateCourierJSONRequestBody defines body for DeactivateCourier for application/json ContentType.
+type DeactivateCourierJSONRequestBody = CourierDeactivation
+
+// SetCourierInsuranceJSONRequestBody defines body for SetCourierInsurance for application/json ContentType.
+type SetCourierInsuranceJSONRequestBody = CourierInsurance
+
+// ScheduleCourierMaintenanceJSONRequestBody defines body for ScheduleCourierMaintenance for application/json ContentType.
+type ScheduleCourierMaintenanceJSONRequestBody = MaintenanceWindowSchedule
+
+// RescheduleCourierMaintenanceJSONRequestBody defines body for RescheduleCourierMaintenance for application/json ContentType.
+type RescheduleCourierMaintenanceJSONRequestBody = MaintenanceWindowSchedule
+
+// MergeCouriersJSONRequestBody defines body for MergeCouriers for application/json ContentType.
+type MergeCouriersJSONRequestBody = CourierMergeRequest
+
+// UpdateCourierProfileJSONRequestBody defines body for UpdateCourierProfile for application/json ContentType.
+type UpdateCourierProfileJSONRequestBody = CourierProfile
+
+// SetCourierScheduleJSONRequestBody defines body for SetCourierSchedule for application/json ContentType.
+type SetCourierScheduleJSONRequestBody = CourierScheduleChange
+
+// SetStoragePlaceMaintenanceJSONRequestBody defines body for SetStoragePlaceMaintenance for application/json ContentType.
+type SetStoragePlaceMaintenanceJSONRequestBody = StoragePlaceMaintenance
+
+// ReplaceMatchingRulesJSONRequestBody defines body for ReplaceMatchingRules for application/json ContentType.
+type ReplaceMatchingRulesJSONRequestBody = MatchingRulesChange
+
+// AnalyzeFleetWhatIfJSONRequestBody defines body for AnalyzeFleetWhatIf for application/json ContentType.
+type AnalyzeFleetWhatIfJSONRequestBody = FleetWhatIfRequest
+
+// ErasePersonalDataJSONRequestBody defines body for ErasePersonalData for application/json ContentType.
+type ErasePersonalDataJSONRequestBody = PersonalDataErasureRequest
+
+// CreatePickupSlotJSONRequestBody defines body for CreatePickupSlot for application/json ContentType.
+type CreatePickupSlotJSONRequestBody = NewPickupSlot
+
+// ChangePickupSlotCapacityJSONRequestBody defines body for ChangePickupSlotCapacity for application/json ContentType.
+type ChangePickupSlotCapacityJSONRequestBody = PickupSlotCapacity
+
+// SetRolloutPercentageJSONRequestBody defines body for SetRolloutPercentage for application/json ContentType.
+type SetRolloutPercentageJSONRequestBody = RolloutChange
+
+// ComputeSLAComplianceJSONRequestBody defines body for ComputeSLACompliance for application/json ContentType.
+type ComputeSLAComplianceJSONRequestBody = SLAComplianceComputation
+
+// ReplaceSLATargetsJSONRequestBody defines body for ReplaceSLATargets for application/json ContentType.
+type ReplaceSLATargetsJSONRequestBody = SLATargetsChange
+
+// ChangeSurgeModeJSONRequestBody defines body for ChangeSurgeMode for application/json ContentType.
+type ChangeSurgeModeJSONRequestBody = SurgeModeChange
+
+// PurgeSyntheticDataJSONRequestBody defines body for PurgeSyntheticData for application/json ContentType.
+type PurgeSyntheticDataJSONRequestBody = SyntheticDataPurge
+
+// CreateCourierJSONRequestBody defines body for CreateCourier for application/json ContentType.
+type CreateCourierJSONRequestBody = NewCourier
+
+// ImportCouriersJSONRequestBody defines body for ImportCouriers for application/json ContentType.
+type ImportCouriersJSONRequestBody = ImportCouriersJSONBody
+
+// RecordDeviceTelemetryJSONRequestBody defines body for RecordDeviceTelemetry for application/json ContentType.
+type RecordDeviceTelemetryJSONRequestBody = DeviceTelemetry
+
+// UpdateCourierLocationJSONRequestBody defines body for UpdateCourierLocation for application/json ContentType.
+type UpdateCourierLocationJSONRequestBody = CourierLocationReport
+
+// ImportCourierLocationsJSONRequestBody defines body for ImportCourierLocations for application/json ContentType.
+type ImportCourierLocationsJSONRequestBody = CourierLocationBatch
+
+// SetCourierBreakJSONRequestBody defines body for SetCourierBreak for application/json ContentType.
+type SetCourierBreakJSONRequestBody = CourierBreak
+
+// SetCourierShiftJSONRequestBody defines body for SetCourierShift for application/json ContentType.
+type SetCourierShiftJSONRequestBody = CourierShift
+
+// CreateOrderJSONRequestBody defines body for CreateOrder for application/json ContentType.
+type CreateOrderJSONRequestBody = NewOrder
+
+// UploadOrdersMultipartRequestBody defines body for UploadOrders for multipart/form-data ContentType.
+type UploadOrdersMultipartRequestBody UploadOrdersMultipartBody
+
+// BulkCancelOrdersJSONRequestBody defines body for BulkCancelOrders for application/json ContentType.
+type BulkCancelOrdersJSONRequestBody = BulkCancellationRequest
+
+// ConfirmOrderHandoverJSONRequestBody defines body for ConfirmOrderHandover for application/json ContentType.
+type ConfirmOrderHandoverJSONRequestBody = HandoverConfirmation
+
+// PostOrderMessageJSONRequestBody defines body for PostOrderMessage for application/json ContentType.
+type PostOrderMessageJSONRequestBody = NewOrderMessage
+
+// TransferOrderJSONRequestBody defines body for TransferOrder for application/json ContentType.
+type TransferOrderJSONRequestBody = OrderTransferRequest
+
+// ReceivePaymentEventJSONRequestBody defines body for ReceivePaymentEvent for application/json ContentType.
+type ReceivePaymentEventJSONRequestBody = PaymentEvent
+
+// RateOrderJSONRequestBody defines body for RateOrder for application/json ContentType.
+type RateOrderJSONRequestBody = NewRating
+
+// TipOrderJSONRequestBody defines body for TipOrder for application/json ContentType.
+type TipOrderJSONRequestBody = NewTip
+
+// IssueBlobUploadJSONRequestBody defines body for IssueBlobUpload for application/json ContentType.
+type IssueBlobUploadJSONRequestBody = BlobUploadRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Получить историю объявлений
+	// (GET /api/v1/admin/announcements)
+	GetAnnouncements(ctx echo.Context) error
+	// Разослать объявление курьерам
+	// (POST /api/v1/admin/announcements)
+	BroadcastAnnouncement(ctx echo.Context) error
+	// Получить правила значков курьеров
+	// (GET /api/v1/admin/badge-rules)
+	GetBadgeRules(ctx echo.Context) error
+	// Получить состояние устройств курьеров
+	// (GET /api/v1/admin/couriers/device-health)
+	GetDeviceHealth(ctx echo.Context) error
+	// Получить отчет о рабочем времени курьеров
+	// (GET /api/v1/admin/couriers/working-hours)
+	GetCourierWorkingHours(ctx echo.Context) error
+	// Запланировать отсутствие курьера
+	// (POST /api/v1/admin/couriers/{courierId}/absences)
+	PlanCourierAbsence(ctx echo.Context, courierId openapi_types.UUID) error
+	// Отменить отсутствие курьера
+	// (DELETE /api/v1/admin/couriers/{courierId}/absences/{absenceId})
+	CancelCourierAbsence(ctx echo.Context, courierId openapi_types.UUID, absenceId openapi_types.UUID) error
+	// Вывести курьера из работы
+	// (POST /api/v1/admin/couriers/{courierId}/deactivation)
+	DeactivateCourier(ctx echo.Context, courierId openapi_types.UUID) error
+	// Застраховать курьера или отозвать страховку
+	// (PUT /api/v1/admin/couriers/{courierId}/insurance)
+	SetCourierInsurance(ctx echo.Context, courierId openapi_types.UUID) error
+	// Получить календарь обслуживания транспорта курьера
+	// (GET /api/v1/admin/couriers/{courierId}/maintenance-windows)
+	GetCourierMaintenanceWindows(ctx echo.Context, courierId openapi_types.UUID) error
+	// Запланировать обслуживание транспорта курьера
+	// (POST /api/v1/admin/couriers/{courierId}/maintenance-windows)
+	ScheduleCourierMaintenance(ctx echo.Context, courierId openapi_types.UUID) error
+	// Отменить обслуживание транспорта курьера
+	// (DELETE /api/v1/admin/couriers/{courierId}/maintenance-windows/{windowId})
+	CancelCourierMaintenance(ctx echo.Context, courierId openapi_types.UUID, windowId openapi_types.UUID) error
+	// Перенести обслуживание транспорта курьера
+	// (PUT /api/v1/admin/couriers/{courierId}/maintenance-windows/{windowId})
+	RescheduleCourierMaintenance(ctx echo.Context, courierId openapi_types.UUID, windowId openapi_types.UUID) error
+	// Объединить дубль курьера с курьером
+	// (POST /api/v1/admin/couriers/{courierId}/merges)
+	MergeCouriers(ctx echo.Context, courierId openapi_types.UUID) error
+	// Изменить профиль курьера
+	// (PUT /api/v1/admin/couriers/{courierId}/profile)
+	UpdateCourierProfile(ctx echo.Context, courierId openapi_types.UUID) error
+	// Снять отметку о проверке курьера
+	// (DELETE /api/v1/admin/couriers/{courierId}/review-flag)
+	ClearCourierReviewFlag(ctx echo.Context, courierId openapi_types.UUID) error
+	// Удалить расписание курьера
+	// (DELETE /api/v1/admin/couriers/{courierId}/schedule)
+	ClearCourierSchedule(ctx echo.Context, courierId openapi_types.UUID) error
+	// Задать расписание курьера
+	// (PUT /api/v1/admin/couriers/{courierId}/schedule)
+	SetCourierSchedule(ctx echo.Context, courierId openapi_types.UUID) error
+	// Изменить состояние обслуживания места хранения
+	// (PUT /api/v1/admin/couriers/{courierId}/storage-places/{storagePlaceId}/maintenance)
+	SetStoragePlaceMaintenance(ctx echo.Context, courierId openapi_types.UUID, storagePlaceId openapi_types.UUID) error
+	// Получить правила подбора курьеров
+	// (GET /api/v1/admin/dispatch/matching-rules)
+	GetMatchingRules(ctx echo.Context) error
+	// Заменить правила подбора курьеров
+	// (PUT /api/v1/admin/dispatch/matching-rules)
+	ReplaceMatchingRules(ctx echo.Context) error
+	// Оценить эффект добавления курьеров
+	// (POST /api/v1/admin/fleet/what-if)
+	AnalyzeFleetWhatIf(ctx echo.Context) error
+	// Получить микрозоны плотного спроса
+	// (GET /api/v1/admin/microzones)
+	GetMicrozones(ctx echo.Context) error
+	// Пересчитать микрозоны
+	// (POST /api/v1/admin/microzones/recompute)
+	RecomputeMicrozones(ctx echo.Context) error
+	// Получить очередь заказов на проверке
+	// (GET /api/v1/admin/orders/review-queue)
+	GetOrderReviewQueue(ctx echo.Context) error
+	// Одобрить заказ после проверки
+	// (POST /api/v1/admin/orders/{orderId}/review-approval)
+	ApproveOrderReview(ctx echo.Context, orderId openapi_types.UUID) error
+	// Выгрузить выплаты курьерам
+	// (GET /api/v1/admin/payouts)
+	GetPayoutExport(ctx echo.Context, params GetPayoutExportParams) error
+	// Стереть персональные данные клиента
+	// (POST /api/v1/admin/personal-data-erasures)
+	ErasePersonalData(ctx echo.Context) error
+	// Получить слоты выдачи на складе
+	// (GET /api/v1/admin/pickup-slots)
+	GetPickupSlots(ctx echo.Context) error
+	// Создать слот выдачи на складе
+	// (POST /api/v1/admin/pickup-slots)
+	CreatePickupSlot(ctx echo.Context) error
+	// Изменить вместимость слота выдачи
+	// (PUT /api/v1/admin/pickup-slots/{slotId}/capacity)
+	ChangePickupSlotCapacity(ctx echo.Context, slotId openapi_types.UUID) error
+	// Изменить долю поэтапного включения
+	// (PUT /api/v1/admin/rollouts/{flag})
+	SetRolloutPercentage(ctx echo.Context, flag string) error
+	// Вычислить соблюдение SLA за день
+	// (POST /api/v1/admin/sla/compliance)
+	ComputeSLACompliance(ctx echo.Context) error
+	// Получить цели SLA
+	// (GET /api/v1/admin/sla/targets)
+	GetSLATargets(ctx echo.Context) error
+	// Заменить цели SLA
+	// (PUT /api/v1/admin/sla/targets)
+	ReplaceSLATargets(ctx echo.Context) error
+	// Получить режим часа пик
+	// (GET /api/v1/admin/surge)
+	GetSurgeMode(ctx echo.Context) error
+	// Изменить режим часа пик
+	// (PUT /api/v1/admin/surge)
+	ChangeSurgeMode(ctx echo.Context) error
+	// Удалить тестовые данные
+	// (POST /api/v1/admin/synthetic-data/purge)
+	PurgeSyntheticData(ctx echo.Context) error
+	// Получить всех курьеров
+	// (GET /api/v1/couriers)
+	GetCouriers(ctx echo.Context, params GetCouriersParams) error
+	// Добавить курьера
+	// (POST /api/v1/couriers)
+	CreateCourier(ctx echo.Context) error
+	// Добавить курьеров пакетом
+	// (POST /api/v1/couriers/import)
+	ImportCouriers(ctx echo.Context) error
+	// Передать показания устройства курьера
+	// (POST /api/v1/couriers/{courierId}/device-telemetry)
+	RecordDeviceTelemetry(ctx echo.Context, courierId openapi_types.UUID) error
+	// Передать текущую позицию курьера
+	// (PUT /api/v1/couriers/{courierId}/location)
+	UpdateCourierLocation(ctx echo.Context, courierId openapi_types.UUID) error
+	// Загрузить пакет позиций курьера
+	// (POST /api/v1/couriers/{courierId}/locations/batch)
+	ImportCourierLocations(ctx echo.Context, courierId openapi_types.UUID) error
+	// Начать или завершить перерыв курьера
+	// (PUT /api/v1/couriers/{courierId}/break)
+	SetCourierBreak(ctx echo.Context, courierId openapi_types.UUID) error
+	// Начать или завершить смену курьера
+	// (PUT /api/v1/couriers/{courierId}/shift)
+	SetCourierShift(ctx echo.Context, courierId openapi_types.UUID) error
+	// Получить статистику и значки курьера
+	// (GET /api/v1/couriers/{courierId}/stats)
+	GetCourierStats(ctx echo.Context, courierId openapi_types.UUID) error
+	// Получить заказы постранично
+	// (GET /api/v1/orders)
+	ListOrders(ctx echo.Context, params ListOrdersParams) error
+	// Создать заказ
+	// (POST /api/v1/orders)
+	CreateOrder(ctx echo.Context) error
+	// Получить все незавершенные заказы
+	// (GET /api/v1/orders/active)
+	GetOrders(ctx echo.Context, params GetOrdersParams) error
+	// Найти заказ по заказу маркетплейса
+	// (GET /api/v1/orders/by-external/{marketplace}/{externalId})
+	GetOrderByExternalReference(ctx echo.Context, marketplace string, externalId string) error
+	// Массово отменить заказы по фильтру
+	// (POST /api/v1/orders/cancel-bulk)
+	BulkCancelOrders(ctx echo.Context) error
+	// Загрузить заказы из файла
+	// (POST /api/v1/orders/upload)
+	UploadOrders(ctx echo.Context) error
+	// Получить состояние заказа на момент времени
+	// (GET /api/v1/orders/{orderId})
+	GetOrder(ctx echo.Context, orderId openapi_types.UUID, params GetOrderParams) error
+	// Отменить заказ
+	// (POST /api/v1/orders/{orderId}/cancellation)
+	CancelOrder(ctx echo.Context, orderId openapi_types.UUID) error
+	// Получить объяснение назначения курьера
+	// (GET /api/v1/orders/{orderId}/assignment-explanation)
+	GetAssignmentExplanation(ctx echo.Context, orderId openapi_types.UUID) error
+	// Получить прогноз времени доставки
+	// (GET /api/v1/orders/{orderId}/eta)
+	GetOrderEta(ctx echo.Context, orderId openapi_types.UUID) error
+	// Подтвердить передачу застрахованного заказа
+	// (POST /api/v1/orders/{orderId}/handover-confirmations)
+	ConfirmOrderHandover(ctx echo.Context, orderId openapi_types.UUID) error
+	// Получить историю заказа
+	// (GET /api/v1/orders/{orderId}/history)
+	GetOrderHistory(ctx echo.Context, orderId openapi_types.UUID) error
+	// Получить переписку по заказу
+	// (GET /api/v1/orders/{orderId}/messages)
+	GetOrderMessages(ctx echo.Context, orderId openapi_types.UUID) error
+	// Отправить сообщение по заказу
+	// (POST /api/v1/orders/{orderId}/messages)
+	PostOrderMessage(ctx echo.Context, orderId openapi_types.UUID) error
+	// Пересчитать прогноз времени доставки
+	// (POST /api/v1/orders/{orderId}/recalculate-eta)
+	RecalculateOrderEta(ctx echo.Context, orderId openapi_types.UUID) error
+	// Поделиться отслеживанием заказа
+	// (POST /api/v1/orders/{orderId}/tracking-link)
+	ShareOrderTracking(ctx echo.Context, orderId openapi_types.UUID) error
+	// Передать заказ другому курьеру
+	// (POST /api/v1/orders/{orderId}/transfers)
+	TransferOrder(ctx echo.Context, orderId openapi_types.UUID) error
+	// Принять событие оплаты
+	// (POST /api/v1/payments/webhook)
+	ReceivePaymentEvent(ctx echo.Context, params ReceivePaymentEventParams) error
+	// Получить отчет о соблюдении SLA
+	// (GET /api/v1/reports/sla)
+	GetSLAReport(ctx echo.Context, params GetSLAReportParams) error
+	// Получить состояние SLO назначения и доставки
+	// (GET /api/v1/stats/slo)
+	GetSLOStatus(ctx echo.Context) error
+	// Отследить заказ по ссылке
+	// (GET /api/v1/tracking/{trackingToken})
+	GetSharedTracking(ctx echo.Context, trackingToken string) error
+	// Оценить доставку
+	// (POST /api/v1/tracking/{trackingToken}/rating)
+	RateOrder(ctx echo.Context, trackingToken string) error
+	// Оставить чаевые курьеру
+	// (POST /api/v1/tracking/{trackingToken}/tip)
+	TipOrder(ctx echo.Context, trackingToken string, params TipOrderParams) error
+	// Получить ссылку для загрузки файла
+	// (POST /api/v1/uploads)
+	IssueBlobUpload(ctx echo.Context) error
+	// Получить ленту изменений
+	// (GET /api/v1/sync/changes)
+	GetChanges(ctx echo.Context, params GetChangesParams) error
+	// Получить ленту доступности курьеров
+	// (GET /api/v1/sync/courier-availability)
+	GetCourierAvailabilityFeed(ctx echo.Context, params GetCourierAvailabilityFeedParams) error
+	// Получить потребление API клиентами
+	// (GET /api/v1/admin/api-usage)
+	GetAPIUsage(ctx echo.Context, params GetAPIUsageParams) error
+	// Получить свое потребление API
+	// (GET /api/v1/usage)
+	GetOwnAPIUsage(ctx echo.Context, params GetOwnAPIUsageParams) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetAnnouncements converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAnnouncements(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAnnouncements(ctx)
+	return err
+}
+
+// BroadcastAnnouncement converts echo context to params.
+func (w *ServerInterfaceWrapper) BroadcastAnnouncement(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BroadcastAnnouncement(ctx)
+	return err
+}
+
+// GetBadgeRules converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBadgeRules(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBadgeRules(ctx)
+	return err
+}
+
+// GetDeviceHealth converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDeviceHealth(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDeviceHealth(ctx)
+	return err
+}
+
+// GetCourierWorkingHours converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCourierWorkingHours(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCourierWorkingHours(ctx)
+	return err
+}
+
+// PlanCourierAbsence converts echo context to params.
+func (w *ServerInterfaceWrapper) PlanCourierAbsence(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PlanCourierAbsence(ctx, courierId)
+	return err
+}
+
+// CancelCourierAbsence converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelCourierAbsence(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// ------------- Path parameter "absenceId" -------------
+	var absenceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "absenceId", ctx.Param("absenceId"), &absenceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter absenceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CancelCourierAbsence(ctx, courierId, absenceId)
+	return err
+}
+
+// DeactivateCourier converts echo context to params.
+func (w *ServerInterfaceWrapper) DeactivateCourier(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeactivateCourier(ctx, courierId)
+	return err
+}
+
+// SetCourierInsurance converts echo context to params.
+func (w *ServerInterfaceWrapper) SetCourierInsurance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetCourierInsurance(ctx, courierId)
+	return err
+}
+
+// GetCourierMaintenanceWindows converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCourierMaintenanceWindows(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCourierMaintenanceWindows(ctx, courierId)
+	return err
+}
+
+// ScheduleCourierMaintenance converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleCourierMaintenance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduleCourierMaintenance(ctx, courierId)
+	return err
+}
+
+// CancelCourierMaintenance converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelCourierMaintenance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// ------------- Path parameter "windowId" -------------
+	var windowId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "windowId", ctx.Param("windowId"), &windowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter windowId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CancelCourierMaintenance(ctx, courierId, windowId)
+	return err
+}
+
+// RescheduleCourierMaintenance converts echo context to params.
+func (w *ServerInterfaceWrapper) RescheduleCourierMaintenance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// ------------- Path parameter "windowId" -------------
+	var windowId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "windowId", ctx.Param("windowId"), &windowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter windowId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RescheduleCourierMaintenance(ctx, courierId, windowId)
+	return err
+}
+
+// MergeCouriers converts echo context to params.
+func (w *ServerInterfaceWrapper) MergeCouriers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.MergeCouriers(ctx, courierId)
+	return err
+}
+
+// UpdateCourierProfile converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateCourierProfile(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateCourierProfile(ctx, courierId)
+	return err
+}
+
+// ClearCourierReviewFlag converts echo context to params.
+func (w *ServerInterfaceWrapper) ClearCourierReviewFlag(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ClearCourierReviewFlag(ctx, courierId)
+	return err
+}
+
+// ClearCourierSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) ClearCourierSchedule(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ClearCourierSchedule(ctx, courierId)
+	return err
+}
+
+// SetCourierSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) SetCourierSchedule(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetCourierSchedule(ctx, courierId)
+	return err
+}
+
+// SetStoragePlaceMaintenance converts echo context to params.
+func (w *ServerInterfaceWrapper) SetStoragePlaceMaintenance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// ------------- Path parameter "storagePlaceId" -------------
+	var storagePlaceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "storagePlaceId", ctx.Param("storagePlaceId"), &storagePlaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter storagePlaceId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetStoragePlaceMaintenance(ctx, courierId, storagePlaceId)
+	return err
+}
+
+// GetMatchingRules converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMatchingRules(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMatchingRules(ctx)
+	return err
+}
+
+// ReplaceMatchingRules converts echo context to params.
+func (w *ServerInterfaceWrapper) ReplaceMatchingRules(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReplaceMatchingRules(ctx)
+	return err
+}
+
+// AnalyzeFleetWhatIf converts echo context to params.
+func (w *ServerInterfaceWrapper) AnalyzeFleetWhatIf(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AnalyzeFleetWhatIf(ctx)
+	return err
+}
+
+// GetMicrozones converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMicrozones(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMicrozones(ctx)
+	return err
+}
+
+// RecomputeMicrozones converts echo context to params.
+func (w *ServerInterfaceWrapper) RecomputeMicrozones(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RecomputeMicrozones(ctx)
+	return err
+}
+
+// GetOrderReviewQueue converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrderReviewQueue(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrderReviewQueue(ctx)
+	return err
+}
+
+// ApproveOrderReview converts echo context to params.
+func (w *ServerInterfaceWrapper) ApproveOrderReview(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApproveOrderReview(ctx, orderId)
+	return err
+}
+
+// GetPayoutExport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPayoutExport(ctx echo.Context) error {
+	var err error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPayoutExportParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPayoutExport(ctx, params)
+	return err
+}
+
+// ErasePersonalData converts echo context to params.
+func (w *ServerInterfaceWrapper) ErasePersonalData(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ErasePersonalData(ctx)
+	return err
+}
+
+// GetPickupSlots converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPickupSlots(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPickupSlots(ctx)
+	return err
+}
+
+// CreatePickupSlot converts echo context to params.
+func (w *ServerInterfaceWrapper) CreatePickupSlot(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreatePickupSlot(ctx)
+	return err
+}
+
+// ChangePickupSlotCapacity converts echo context to params.
+func (w *ServerInterfaceWrapper) ChangePickupSlotCapacity(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "slotId" -------------
+	var slotId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slotId", ctx.Param("slotId"), &slotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter slotId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ChangePickupSlotCapacity(ctx, slotId)
+	return err
+}
+
+// SetRolloutPercentage converts echo context to params.
+func (w *ServerInterfaceWrapper) SetRolloutPercentage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "flag" -------------
+	var flag string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "flag", ctx.Param("flag"), &flag, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter flag: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetRolloutPercentage(ctx, flag)
+	return err
+}
+
+// ComputeSLACompliance converts echo context to params.
+func (w *ServerInterfaceWrapper) ComputeSLACompliance(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ComputeSLACompliance(ctx)
+	return err
+}
+
+// GetSLATargets converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSLATargets(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSLATargets(ctx)
+	return err
+}
+
+// ReplaceSLATargets converts echo context to params.
+func (w *ServerInterfaceWrapper) ReplaceSLATargets(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReplaceSLATargets(ctx)
+	return err
+}
+
+// GetSurgeMode converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSurgeMode(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSurgeMode(ctx)
+	return err
+}
+
+// ChangeSurgeMode converts echo context to params.
+func (w *ServerInterfaceWrapper) ChangeSurgeMode(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ChangeSurgeMode(ctx)
+	return err
+}
+
+// PurgeSyntheticData converts echo context to params.
+func (w *ServerInterfaceWrapper) PurgeSyntheticData(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PurgeSyntheticData(ctx)
+	return err
+}
+
+// GetCouriers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCouriers(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCouriersParams
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCouriers(ctx, params)
+	return err
+}
+
+// CreateCourier converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateCourier(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateCourier(ctx)
+	return err
+}
+
+// ImportCouriers converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportCouriers(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ImportCouriers(ctx)
+	return err
+}
+
+// RecordDeviceTelemetry converts echo context to params.
+func (w *ServerInterfaceWrapper) RecordDeviceTelemetry(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RecordDeviceTelemetry(ctx, courierId)
+	return err
+}
+
+// UpdateCourierLocation converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateCourierLocation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateCourierLocation(ctx, courierId)
+	return err
+}
+
+// ImportCourierLocations converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportCourierLocations(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ImportCourierLocations(ctx, courierId)
+	return err
+}
+
+// SetCourierBreak converts echo context to params.
+func (w *ServerInterfaceWrapper) SetCourierBreak(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetCourierBreak(ctx, courierId)
+	return err
+}
+
+// SetCourierShift converts echo context to params.
+func (w *ServerInterfaceWrapper) SetCourierShift(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetCourierShift(ctx, courierId)
+	return err
+}
+
+// GetCourierStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCourierStats(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "courierId" -------------
+	var courierId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "courierId", ctx.Param("courierId"), &courierId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter courierId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCourierStats(ctx, courierId)
+	return err
+}
+
+// ListOrders converts echo context to params.
+func (w *ServerInterfaceWrapper) ListOrders(ctx echo.Context) error {
+	var err error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOrdersParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListOrders(ctx, params)
+	return err
+}
+
+// CreateOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateOrder(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateOrder(ctx)
+	return err
+}
+
+// GetOrders converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrders(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrdersParams
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrders(ctx, params)
+	return err
+}
+
+// GetOrderByExternalReference converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrderByExternalReference(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "marketplace" -------------
+	var marketplace string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "marketplace", ctx.Param("marketplace"), &marketplace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter marketplace: %s", err))
+	}
+
+	// ------------- Path parameter "externalId" -------------
+	var externalId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "externalId", ctx.Param("externalId"), &externalId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter externalId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrderByExternalReference(ctx, marketplace, externalId)
+	return err
+}
+
+// BulkCancelOrders converts echo context to params.
+func (w *ServerInterfaceWrapper) BulkCancelOrders(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BulkCancelOrders(ctx)
+	return err
+}
+
+// UploadOrders converts echo context to params.
+func (w *ServerInterfaceWrapper) UploadOrders(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UploadOrders(ctx)
+	return err
+}
+
+// GetOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrderParams
+	// ------------- Optional query parameter "asOf" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "asOf", ctx.QueryParams(), &params.AsOf)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asOf: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrder(ctx, orderId, params)
+	return err
+}
+
+// CancelOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelOrder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CancelOrder(ctx, orderId)
+	return err
+}
+
+// GetAssignmentExplanation converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAssignmentExplanation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAssignmentExplanation(ctx, orderId)
+	return err
+}
+
+// GetOrderEta converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrderEta(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrderEta(ctx, orderId)
+	return err
+}
+
+// ConfirmOrderHandover converts echo context to params.
+func (w *ServerInterfaceWrapper) ConfirmOrderHandover(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ConfirmOrderHandover(ctx, orderId)
+	return err
+}
+
+// GetOrderHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrderHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrderHistory(ctx, orderId)
+	return err
+}
+
+// GetOrderMessages converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrderMessages(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOrderMessages(ctx, orderId)
+	return err
+}
+
+// PostOrderMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) PostOrderMessage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostOrderMessage(ctx, orderId)
+	return err
+}
+
+// RecalculateOrderEta converts echo context to params.
+func (w *ServerInterfaceWrapper) RecalculateOrderEta(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RecalculateOrderEta(ctx, orderId)
+	return err
+}
+
+// ShareOrderTracking converts echo context to params.
+func (w *ServerInterfaceWrapper) ShareOrderTracking(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ShareOrderTracking(ctx, orderId)
+	return err
+}
+
+// TransferOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) TransferOrder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TransferOrder(ctx, orderId)
+	return err
+}
+
+// ReceivePaymentEvent converts echo context to params.
+func (w *ServerInterfaceWrapper) ReceivePaymentEvent(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReceivePaymentEventParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "X-Payment-Signature" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Payment-Signature")]; found {
+		var XPaymentSignature string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Payment-Signature, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Payment-Signature", valueList[0], &XPaymentSignature, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Payment-Signature: %s", err))
+		}
+
+		params.XPaymentSignature = &XPaymentSignature
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReceivePaymentEvent(ctx, params)
+	return err
+}
+
+// GetSLAReport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSLAReport(ctx echo.Context) error {
+	var err error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSLAReportParams
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSLAReport(ctx, params)
+	return err
+}
+
+// GetSLOStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSLOStatus(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSLOStatus(ctx)
+	return err
+}
+
+// GetSharedTracking converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSharedTracking(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "trackingToken" -------------
+	var trackingToken string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "trackingToken", ctx.Param("trackingToken"), &trackingToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter trackingToken: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSharedTracking(ctx, trackingToken)
+	return err
+}
+
+// RateOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) RateOrder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "trackingToken" -------------
+	var trackingToken string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "trackingToken", ctx.Param("trackingToken"), &trackingToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter trackingToken: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RateOrder(ctx, trackingToken)
+	return err
+}
+
+// TipOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) TipOrder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "trackingToken" -------------
+	var trackingToken string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "trackingToken", ctx.Param("trackingToken"), &trackingToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter trackingToken: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TipOrderParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = IdempotencyKey
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter Idempotency-Key is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TipOrder(ctx, trackingToken, params)
+	return err
+}
+
+// IssueBlobUpload converts echo context to params.
+func (w *ServerInterfaceWrapper) IssueBlobUpload(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.IssueBlobUpload(ctx)
+	return err
+}
+
+// GetChanges converts echo context to params.
+func (w *ServerInterfaceWrapper) GetChanges(ctx echo.Context) error {
+	var err error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetChangesParams
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetChanges(ctx, params)
+	return err
+}
+
+// GetCourierAvailabilityFeed converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCourierAvailabilityFeed(ctx echo.Context) error {
+	var err error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCourierAvailabilityFeedParams
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCourierAvailabilityFeed(ctx, params)
+	return err
+}
+
+// GetAPIUsage converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAPIUsage(ctx echo.Context) error {
+	var err error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAPIUsageParams
+	// ------------- Optional query parameter "month" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "month", ctx.QueryParams(), &params.Month)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter month: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAPIUsage(ctx, params)
+	return err
+}
+
+// GetOwnAPIUsage converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOwnAPIUsage(ctx echo.Context) error {
+	var err error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOwnAPIUsageParams
+	// ------------- Optional query parameter "month" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "month", ctx.QueryParams(), &params.Month)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter month: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOwnAPIUsage(ctx, params)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/api/v1/admin/announcements", wrapper.GetAnnouncements)
+	router.POST(baseURL+"/api/v1/admin/announcements", wrapper.BroadcastAnnouncement)
+	router.GET(baseURL+"/api/v1/admin/badge-rules", wrapper.GetBadgeRules)
+	router.GET(baseURL+"/api/v1/admin/couriers/device-health", wrapper.GetDeviceHealth)
+	router.GET(baseURL+"/api/v1/admin/couriers/working-hours", wrapper.GetCourierWorkingHours)
+	router.POST(baseURL+"/api/v1/admin/couriers/:courierId/absences", wrapper.PlanCourierAbsence)
+	router.DELETE(baseURL+"/api/v1/admin/couriers/:courierId/absences/:absenceId", wrapper.CancelCourierAbsence)
+	router.POST(baseURL+"/api/v1/admin/couriers/:courierId/deactivation", wrapper.DeactivateCourier)
+	router.PUT(baseURL+"/api/v1/admin/couriers/:courierId/insurance", wrapper.SetCourierInsurance)
+	router.GET(baseURL+"/api/v1/admin/couriers/:courierId/maintenance-windows", wrapper.GetCourierMaintenanceWindows)
+	router.POST(baseURL+"/api/v1/admin/couriers/:courierId/maintenance-windows", wrapper.ScheduleCourierMaintenance)
+	router.DELETE(baseURL+"/api/v1/admin/couriers/:courierId/maintenance-windows/:windowId", wrapper.CancelCourierMaintenance)
+	router.PUT(baseURL+"/api/v1/admin/couriers/:courierId/maintenance-windows/:windowId", wrapper.RescheduleCourierMaintenance)
+	router.POST(baseURL+"/api/v1/admin/couriers/:courierId/merges", wrapper.MergeCouriers)
+	router.PUT(baseURL+"/api/v1/admin/couriers/:courierId/profile", wrapper.UpdateCourierProfile)
+	router.DELETE(baseURL+"/api/v1/admin/couriers/:courierId/review-flag", wrapper.ClearCourierReviewFlag)
+	router.DELETE(baseURL+"/api/v1/admin/couriers/:courierId/schedule", wrapper.ClearCourierSchedule)
+	router.PUT(baseURL+"/api/v1/admin/couriers/:courierId/schedule", wrapper.SetCourierSchedule)
+	router.PUT(baseURL+"/api/v1/admin/couriers/:courierId/storage-places/:storagePlaceId/maintenance", wrapper.SetStoragePlaceMaintenance)
+	router.GET(baseURL+"/api/v1/admin/dispatch/matching-rules", wrapper.GetMatchingRules)
+	router.PUT(baseURL+"/api/v1/admin/dispatch/matching-rules", wrapper.ReplaceMatchingRules)
+	router.POST(baseURL+"/api/v1/admin/fleet/what-if", wrapper.AnalyzeFleetWhatIf)
+	router.GET(baseURL+"/api/v1/admin/microzones", wrapper.GetMicrozones)
+	router.POST(baseURL+"/api/v1/admin/microzones/recompute", wrapper.RecomputeMicrozones)
+	router.GET(baseURL+"/api/v1/admin/orders/review-queue", wrapper.GetOrderReviewQueue)
+	router.POST(baseURL+"/api/v1/admin/orders/:orderId/review-approval", wrapper.ApproveOrderReview)
+	router.GET(baseURL+"/api/v1/admin/payouts", wrapper.GetPayoutExport)
+	router.POST(baseURL+"/api/v1/admin/personal-data-erasures", wrapper.ErasePersonalData)
+	router.GET(baseURL+"/api/v1/admin/pickup-slots", wrapper.GetPickupSlots)
+	router.POST(baseURL+"/api/v1/admin/pickup-slots", wrapper.CreatePickupSlot)
+	router.PUT(baseURL+"/api/v1/admin/pickup-slots/:slotId/capacity", wrapper.ChangePickupSlotCapacity)
+	router.PUT(baseURL+"/api/v1/admin/rollouts/:flag", wrapper.SetRolloutPercentage)
+	router.POST(baseURL+"/api/v1/admin/sla/compliance", wrapper.ComputeSLACompliance)
+	router.GET(baseURL+"/api/v1/admin/sla/targets", wrapper.GetSLATargets)
+	router.PUT(baseURL+"/api/v1/admin/sla/targets", wrapper.ReplaceSLATargets)
+	router.GET(baseURL+"/api/v1/admin/surge", wrapper.GetSurgeMode)
+	router.PUT(baseURL+"/api/v1/admin/surge", wrapper.ChangeSurgeMode)
+	router.POST(baseURL+"/api/v1/admin/synthetic-data/purge", wrapper.PurgeSyntheticData)
+	router.GET(baseURL+"/api/v1/couriers", wrapper.GetCouriers)
+	router.POST(baseURL+"/api/v1/couriers", wrapper.CreateCourier)
+	router.POST(baseURL+"/api/v1/couriers/import", wrapper.ImportCouriers)
+	router.POST(baseURL+"/api/v1/couriers/:courierId/device-telemetry", wrapper.RecordDeviceTelemetry)
+	router.PUT(baseURL+"/api/v1/couriers/:courierId/location", wrapper.UpdateCourierLocation)
+	router.POST(baseURL+"/api/v1/couriers/:courierId/locations/batch", wrapper.ImportCourierLocations)
+	router.PUT(baseURL+"/api/v1/couriers/:courierId/break", wrapper.SetCourierBreak)
+	router.PUT(baseURL+"/api/v1/couriers/:courierId/shift", wrapper.SetCourierShift)
+	router.GET(baseURL+"/api/v1/couriers/:courierId/stats", wrapper.GetCourierStats)
+	router.GET(baseURL+"/api/v1/orders", wrapper.ListOrders)
+	router.POST(baseURL+"/api/v1/orders", wrapper.CreateOrder)
+	router.GET(baseURL+"/api/v1/orders/active", wrapper.GetOrders)
+	router.GET(baseURL+"/api/v1/orders/by-external/:marketplace/:externalId", wrapper.GetOrderByExternalReference)
+	router.POST(baseURL+"/api/v1/orders/cancel-bulk", wrapper.BulkCancelOrders)
+	router.POST(baseURL+"/api/v1/orders/upload", wrapper.UploadOrders)
+	router.GET(baseURL+"/api/v1/orders/:orderId", wrapper.GetOrder)
+	router.POST(baseURL+"/api/v1/orders/:orderId/cancellation", wrapper.CancelOrder)
+	router.GET(baseURL+"/api/v1/orders/:orderId/assignment-explanation", wrapper.GetAssignmentExplanation)
+	router.GET(baseURL+"/api/v1/orders/:orderId/eta", wrapper.GetOrderEta)
+	router.POST(baseURL+"/api/v1/orders/:orderId/handover-confirmations", wrapper.ConfirmOrderHandover)
+	router.GET(baseURL+"/api/v1/orders/:orderId/history", wrapper.GetOrderHistory)
+	router.GET(baseURL+"/api/v1/orders/:orderId/messages", wrapper.GetOrderMessages)
+	router.POST(baseURL+"/api/v1/orders/:orderId/messages", wrapper.PostOrderMessage)
+	router.POST(baseURL+"/api/v1/orders/:orderId/recalculate-eta", wrapper.RecalculateOrderEta)
+	router.POST(baseURL+"/api/v1/orders/:orderId/tracking-link", wrapper.ShareOrderTracking)
+	router.POST(baseURL+"/api/v1/orders/:orderId/transfers", wrapper.TransferOrder)
+	router.POST(baseURL+"/api/v1/payments/webhook", wrapper.ReceivePaymentEvent)
+	router.GET(baseURL+"/api/v1/reports/sla", wrapper.GetSLAReport)
+	router.GET(baseURL+"/api/v1/stats/slo", wrapper.GetSLOStatus)
+	router.GET(baseURL+"/api/v1/tracking/:trackingToken", wrapper.GetSharedTracking)
+	router.POST(baseURL+"/api/v1/tracking/:trackingToken/rating", wrapper.RateOrder)
+	router.POST(baseURL+"/api/v1/tracking/:trackingToken/tip", wrapper.TipOrder)
+	router.POST(baseURL+"/api/v1/uploads", wrapper.IssueBlobUpload)
+	router.GET(baseURL+"/api/v1/sync/changes", wrapper.GetChanges)
+	router.GET(baseURL+"/api/v1/sync/courier-availability", wrapper.GetCourierAvailabilityFeed)
+	router.GET(baseURL+"/api/v1/admin/api-usage", wrapper.GetAPIUsage)
+	router.GET(baseURL+"/api/v1/usage", wrapper.GetOwnAPIUsage)
+
+}
+
+type GetAnnouncementsRequestObject struct {
+}
+
+type GetAnnouncementsResponseObject interface {
+	VisitGetAnnouncementsResponse(w http.ResponseWriter) error
+}
+
+type GetAnnouncements200JSONResponse []Announcement
+
+func (response GetAnnouncements200JSONResponse) VisitGetAnnouncementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnnouncementsdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetAnnouncementsdefaultJSONResponse) VisitGetAnnouncementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type BroadcastAnnouncementRequestObject struct {
+	Body *BroadcastAnnouncementJSONRequestBody
+}
+
+type BroadcastAnnouncementResponseObject interface {
+	VisitBroadcastAnnouncementResponse(w http.ResponseWriter) error
+}
+
+type BroadcastAnnouncement201JSONResponse Announcement
+
+func (response BroadcastAnnouncement201JSONResponse) VisitBroadcastAnnouncementResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BroadcastAnnouncement400JSONResponse Error
+
+func (response BroadcastAnnouncement400JSONResponse) VisitBroadcastAnnouncementResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BroadcastAnnouncementdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response BroadcastAnnouncementdefaultJSONResponse) VisitBroadcastAnnouncementResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetBadgeRulesRequestObject struct {
+}
+
+type GetBadgeRulesResponseObject interface {
+	VisitGetBadgeRulesResponse(w http.ResponseWriter) error
+}
+
+type GetBadgeRules200JSONResponse []BadgeRule
+
+func (response GetBadgeRules200JSONResponse) VisitGetBadgeRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBadgeRulesdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetBadgeRulesdefaultJSONResponse) VisitGetBadgeRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetDeviceHealthRequestObject struct {
+}
+
+type GetDeviceHealthResponseObject interface {
+	VisitGetDeviceHealthResponse(w http.ResponseWriter) error
+}
+
+type GetDeviceHealth200JSONResponse []CourierDeviceHealth
+
+func (response GetDeviceHealth200JSONResponse) VisitGetDeviceHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeviceHealthdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetDeviceHealthdefaultJSONResponse) VisitGetDeviceHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCourierWorkingHoursRequestObject struct {
+}
+
+type GetCourierWorkingHoursResponseObject interface {
+	VisitGetCourierWorkingHoursResponse(w http.ResponseWriter) error
+}
+
+type GetCourierWorkingHours200JSONResponse []CourierWorkingHours
+
+func (response GetCourierWorkingHours200JSONResponse) VisitGetCourierWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCourierWorkingHoursdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetCourierWorkingHoursdefaultJSONResponse) VisitGetCourierWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PlanCourierAbsenceRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+	Body      *PlanCourierAbsenceJSONRequestBody
+}
+
+type PlanCourierAbsenceResponseObject interface {
+	VisitPlanCourierAbsenceResponse(w http.ResponseWriter) error
+}
+
+type PlanCourierAbsence201JSONResponse CourierAbsence
+
+func (response PlanCourierAbsence201JSONResponse) VisitPlanCourierAbsenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlanCourierAbsence400JSONResponse Error
+
+func (response PlanCourierAbsence400JSONResponse) VisitPlanCourierAbsenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlanCourierAbsence404JSONResponse Error
+
+func (response PlanCourierAbsence404JSONResponse) VisitPlanCourierAbsenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlanCourierAbsence409JSONResponse Error
+
+func (response PlanCourierAbsence409JSONResponse) VisitPlanCourierAbsenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlanCourierAbsencedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response PlanCourierAbsencedefaultJSONResponse) VisitPlanCourierAbsenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CancelCourierAbsenceRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+	AbsenceId openapi_types.UUID `json:"absenceId"`
+}
+
+type CancelCourierAbsenceResponseObject interface {
+	VisitCancelCourierAbsenceResponse(w http.ResponseWriter) error
+}
+
+type CancelCourierAbsence204Response struct {
+}
+
+func (response CancelCourierAbsence204Response) VisitCancelCourierAbsenceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type CancelCourierAbsence404JSONResponse Error
+
+func (response CancelCourierAbsence404JSONResponse) VisitCancelCourierAbsenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelCourierAbsencedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response CancelCourierAbsencedefaultJSONResponse) VisitCancelCourierAbsenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeactivateCourierRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+	Body      *DeactivateCourierJSONRequestBody
+}
+
+type DeactivateCourierResponseObject interface {
+	VisitDeactivateCourierResponse(w http.ResponseWriter) error
+}
+
+type DeactivateCourier200JSONResponse CourierDeactivationResult
+
+func (response DeactivateCourier200JSONResponse) VisitDeactivateCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeactivateCourier400JSONResponse Error
+
+func (response DeactivateCourier400JSONResponse) VisitDeactivateCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeactivateCourier404JSONResponse Error
+
+func (response DeactivateCourier404JSONResponse) VisitDeactivateCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeactivateCourier409JSONResponse Error
+
+func (response DeactivateCourier409JSONResponse) VisitDeactivateCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeactivateCourierdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response DeactivateCourierdefaultJSONResponse) VisitDeactivateCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetCourierInsuranceRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+	Body      *SetCourierInsuranceJSONRequestBody
+}
+
+type SetCourierInsuranceResponseObject interface {
+	VisitSetCourierInsuranceResponse(w http.ResponseWriter) error
+}
+
+type SetCourierInsurance204Response struct {
+}
+
+func (response SetCourierInsurance204Response) VisitSetCourierInsuranceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type SetCourierInsurance400JSONResponse Error
+
+func (response SetCourierInsurance400JSONResponse) VisitSetCourierInsuranceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCourierInsurance404JSONResponse Error
+
+func (response SetCourierInsurance404JSONResponse) VisitSetCourierInsuranceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCourierInsurancedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response SetCourierInsurancedefaultJSONResponse) VisitSetCourierInsuranceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCourierMaintenanceWindowsRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+}
+
+type GetCourierMaintenanceWindowsResponseObject interface {
+	VisitGetCourierMaintenanceWindowsResponse(w http.ResponseWriter) error
+}
+
+type GetCourierMaintenanceWindows200JSONResponse []MaintenanceWindow
+
+func (response GetCourierMaintenanceWindows200JSONResponse) VisitGetCourierMaintenanceWindowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCourierMaintenanceWindows404JSONResponse Error
+
+func (response GetCourierMaintenanceWindows404JSONResponse) VisitGetCourierMaintenanceWindowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCourierMaintenanceWindowsdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetCourierMaintenanceWindowsdefaultJSONResponse) VisitGetCourierMaintenanceWindowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ScheduleCourierMaintenanceRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+	Body      *ScheduleCourierMaintenanceJSONRequestBody
+}
+
+type ScheduleCourierMaintenanceResponseObject interface {
+	VisitScheduleCourierMaintenanceResponse(w http.ResponseWriter) error
+}
+
+type ScheduleCourierMaintenance201JSONResponse MaintenanceWindow
+
+func (response ScheduleCourierMaintenance201JSONResponse) VisitScheduleCourierMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScheduleCourierMaintenance400JSONResponse Error
+
+func (response ScheduleCourierMaintenance400JSONResponse) VisitScheduleCourierMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScheduleCourierMaintenance404JSONResponse Error
+
+func (response ScheduleCourierMaintenance404JSONResponse) VisitScheduleCourierMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScheduleCourierMaintenance409JSONResponse Error
+
+func (response ScheduleCourierMaintenance409JSONResponse) VisitScheduleCourierMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScheduleCourierMaintenancedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ScheduleCourierMaintenancedefaultJSONResponse) VisitScheduleCourierMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CancelCourierMaintenanceRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+	WindowId  openapi_types.UUID `json:"windowId"`
+}
+
+type CancelCourierMaintenanceResponseObject interface {
+	VisitCancelCourierMaintenanceResponse(w http.ResponseWriter) error
+}
+
+type CancelCourierMaintenance204Response struct {
+}
+
+func (response CancelCourierMaintenance204Response) VisitCancelCourierMaintenanceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type CancelCourierMaintenance404JSONResponse Error
+
+func (response CancelCourierMaintenance404JSONResponse) VisitCancelCourierMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelCourierMaintenancedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response CancelCourierMaintenancedefaultJSONResponse) VisitCancelCourierMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RescheduleCourierMaintenanceRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+	WindowId  openapi_types.UUID `json:"windowId"`
+	Body      *RescheduleCourierMaintenanceJSONRequestBody
+}
+
+type RescheduleCourierMaintenanceResponseObject interface {
+	VisitRescheduleCourierMaintenanceResponse(w http.ResponseWriter) error
+}
+
+type RescheduleCourierMaintenance200JSONResponse MaintenanceWindow
+
+func (response RescheduleCourierMaintenance200JSONResponse) VisitRescheduleCourierMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RescheduleCourierMaintenance400JSONResponse Error
+
+func (response RescheduleCourierMaintenance400JSONResponse) VisitRescheduleCourierMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RescheduleCourierMaintenance404JSONResponse Error
+
+func (response RescheduleCourierMaintenance404JSONResponse) VisitRescheduleCourierMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RescheduleCourierMaintenance409JSONResponse Error
+
+func (response RescheduleCourierMaintenance409JSONResponse) VisitRescheduleCourierMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RescheduleCourierMaintenancedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response RescheduleCourierMaintenancedefaultJSONResponse) VisitRescheduleCourierMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type MergeCouriersRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+	Body      *MergeCouriersJSONRequestBody
+}
+
+type MergeCouriersResponseObject interface {
+	VisitMergeCouriersResponse(w http.ResponseWriter) error
+}
+
+type MergeCouriers200JSONResponse CourierMerge
+
+func (response MergeCouriers200JSONResponse) VisitMergeCouriersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeCouriers400JSONResponse Error
+
+func (response MergeCouriers400JSONResponse) VisitMergeCouriersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeCouriers404JSONResponse Error
+
+func (response MergeCouriers404JSONResponse) VisitMergeCouriersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeCouriers409JSONResponse Error
+
+func (response MergeCouriers409JSONResponse) VisitMergeCouriersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeCouriersdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response MergeCouriersdefaultJSONResponse) VisitMergeCouriersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateCourierProfileRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+	Body      *UpdateCourierProfileJSONRequestBody
+}
+
+type UpdateCourierProfileResponseObject interface {
+	VisitUpdateCourierProfileResponse(w http.ResponseWriter) error
+}
+
+type UpdateCourierProfile200JSONResponse CourierProfile
+
+func (response UpdateCourierProfile200JSONResponse) VisitUpdateCourierProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCourierProfile400JSONResponse Error
+
+func (response UpdateCourierProfile400JSONResponse) VisitUpdateCourierProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCourierProfile404JSONResponse Error
+
+func (response UpdateCourierProfile404JSONResponse) VisitUpdateCourierProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCourierProfiledefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response UpdateCourierProfiledefaultJSONResponse) VisitUpdateCourierProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ClearCourierReviewFlagRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+}
+
+type ClearCourierReviewFlagResponseObject interface {
+	VisitClearCourierReviewFlagResponse(w http.ResponseWriter) error
+}
+
+type ClearCourierReviewFlag204Response struct {
+}
+
+func (response ClearCourierReviewFlag204Response) VisitClearCourierReviewFlagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ClearCourierReviewFlag404JSONResponse Error
+
+func (response ClearCourierReviewFlag404JSONResponse) VisitClearCourierReviewFlagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClearCourierReviewFlagdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ClearCourierReviewFlagdefaultJSONResponse) VisitClearCourierReviewFlagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ClearCourierScheduleRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+}
+
+type ClearCourierScheduleResponseObject interface {
+	VisitClearCourierScheduleResponse(w http.ResponseWriter) error
+}
+
+type ClearCourierSchedule204Response struct {
+}
+
+func (response ClearCourierSchedule204Response) VisitClearCourierScheduleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ClearCourierSchedule404JSONResponse Error
+
+func (response ClearCourierSchedule404JSONResponse) VisitClearCourierScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClearCourierScheduledefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ClearCourierScheduledefaultJSONResponse) VisitClearCourierScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetCourierScheduleRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+	Body      *SetCourierScheduleJSONRequestBody
+}
+
+type SetCourierScheduleResponseObject interface {
+	VisitSetCourierScheduleResponse(w http.ResponseWriter) error
+}
+
+type SetCourierSchedule200JSONResponse CourierSchedule
+
+func (response SetCourierSchedule200JSONResponse) VisitSetCourierScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCourierSchedule400JSONResponse Error
+
+func (response SetCourierSchedule400JSONResponse) VisitSetCourierScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCourierSchedule404JSONResponse Error
+
+func (response SetCourierSchedule404JSONResponse) VisitSetCourierScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCourierScheduledefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response SetCourierScheduledefaultJSONResponse) VisitSetCourierScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetStoragePlaceMaintenanceRequestObject struct {
+	CourierId      openapi_types.UUID `json:"courierId"`
+	StoragePlaceId openapi_types.UUID `json:"storagePlaceId"`
+	Body           *SetStoragePlaceMaintenanceJSONRequestBody
+}
+
+type SetStoragePlaceMaintenanceResponseObject interface {
+	VisitSetStoragePlaceMaintenanceResponse(w http.ResponseWriter) error
+}
+
+type SetStoragePlaceMaintenance204Response struct {
+}
+
+func (response SetStoragePlaceMaintenance204Response) VisitSetStoragePlaceMaintenanceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type SetStoragePlaceMaintenance400JSONResponse Error
+
+func (response SetStoragePlaceMaintenance400JSONResponse) VisitSetStoragePlaceMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetStoragePlaceMaintenance404JSONResponse Error
+
+func (response SetStoragePlaceMaintenance404JSONResponse) VisitSetStoragePlaceMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetStoragePlaceMaintenance409JSONResponse Error
+
+func (response SetStoragePlaceMaintenance409JSONResponse) VisitSetStoragePlaceMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetStoragePlaceMaintenancedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response SetStoragePlaceMaintenancedefaultJSONResponse) VisitSetStoragePlaceMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetMatchingRulesRequestObject struct {
+}
+
+type GetMatchingRulesResponseObject interface {
+	VisitGetMatchingRulesResponse(w http.ResponseWriter) error
+}
+
+type GetMatchingRules200JSONResponse []MatchingRule
+
+func (response GetMatchingRules200JSONResponse) VisitGetMatchingRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMatchingRulesdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetMatchingRulesdefaultJSONResponse) VisitGetMatchingRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReplaceMatchingRulesRequestObject struct {
+	Body *ReplaceMatchingRulesJSONRequestBody
+}
+
+type ReplaceMatchingRulesResponseObject interface {
+	VisitReplaceMatchingRulesResponse(w http.ResponseWriter) error
+}
+
+type ReplaceMatchingRules200JSONResponse []MatchingRule
+
+func (response ReplaceMatchingRules200JSONResponse) VisitReplaceMatchingRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceMatchingRules400JSONResponse Error
+
+func (response ReplaceMatchingRules400JSONResponse) VisitReplaceMatchingRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceMatchingRulesdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ReplaceMatchingRulesdefaultJSONResponse) VisitReplaceMatchingRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AnalyzeFleetWhatIfRequestObject struct {
+	Body *AnalyzeFleetWhatIfJSONRequestBody
+}
+
+type AnalyzeFleetWhatIfResponseObject interface {
+	VisitAnalyzeFleetWhatIfResponse(w http.ResponseWriter) error
+}
+
+type AnalyzeFleetWhatIf200JSONResponse FleetWhatIf
+
+func (response AnalyzeFleetWhatIf200JSONResponse) VisitAnalyzeFleetWhatIfResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AnalyzeFleetWhatIf400JSONResponse Error
+
+func (response AnalyzeFleetWhatIf400JSONResponse) VisitAnalyzeFleetWhatIfResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AnalyzeFleetWhatIfdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response AnalyzeFleetWhatIfdefaultJSONResponse) VisitAnalyzeFleetWhatIfResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetMicrozonesRequestObject struct {
+}
+
+type GetMicrozonesResponseObject interface {
+	VisitGetMicrozonesResponse(w http.ResponseWriter) error
+}
+
+type GetMicrozones200JSONResponse []Microzone
+
+func (response GetMicrozones200JSONResponse) VisitGetMicrozonesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMicrozonesdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetMicrozonesdefaultJSONResponse) VisitGetMicrozonesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RecomputeMicrozonesRequestObject struct {
+}
+
+type RecomputeMicrozonesResponseObject interface {
+	VisitRecomputeMicrozonesResponse(w http.ResponseWriter) error
+}
+
+type RecomputeMicrozones200JSONResponse MicrozoneRecomputation
+
+func (response RecomputeMicrozones200JSONResponse) VisitRecomputeMicrozonesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecomputeMicrozonesdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response RecomputeMicrozonesdefaultJSONResponse) VisitRecomputeMicrozonesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrderReviewQueueRequestObject struct {
+}
+
+type GetOrderReviewQueueResponseObject interface {
+	VisitGetOrderReviewQueueResponse(w http.ResponseWriter) error
+}
+
+type GetOrderReviewQueue200JSONResponse []OrderUnderReview
+
+func (response GetOrderReviewQueue200JSONResponse) VisitGetOrderReviewQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderReviewQueuedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetOrderReviewQueuedefaultJSONResponse) VisitGetOrderReviewQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ApproveOrderReviewRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+}
+
+type ApproveOrderReviewResponseObject interface {
+	VisitApproveOrderReviewResponse(w http.ResponseWriter) error
+}
+
+type ApproveOrderReview204Response struct {
+}
+
+func (response ApproveOrderReview204Response) VisitApproveOrderReviewResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ApproveOrderReview400JSONResponse Error
+
+func (response ApproveOrderReview400JSONResponse) VisitApproveOrderReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveOrderReview404JSONResponse Error
+
+func (response ApproveOrderReview404JSONResponse) VisitApproveOrderReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveOrderReview409JSONResponse Error
+
+func (response ApproveOrderReview409JSONResponse) VisitApproveOrderReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveOrderReviewdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ApproveOrderReviewdefaultJSONResponse) VisitApproveOrderReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetPayoutExportRequestObject struct {
+	Params GetPayoutExportParams
+}
+
+type GetPayoutExportResponseObject interface {
+	VisitGetPayoutExportResponse(w http.ResponseWriter) error
+}
+
+type GetPayoutExport200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetPayoutExport200TextcsvResponse) VisitGetPayoutExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetPayoutExport400JSONResponse Error
+
+func (response GetPayoutExport400JSONResponse) VisitGetPayoutExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPayoutExportdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetPayoutExportdefaultJSONResponse) VisitGetPayoutExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ErasePersonalDataRequestObject struct {
+	Body *ErasePersonalDataJSONRequestBody
+}
+
+type ErasePersonalDataResponseObject interface {
+	VisitErasePersonalDataResponse(w http.ResponseWriter) error
+}
+
+type ErasePersonalData200JSONResponse PersonalDataErasure
+
+func (response ErasePersonalData200JSONResponse) VisitErasePersonalDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ErasePersonalData400JSONResponse Error
+
+func (response ErasePersonalData400JSONResponse) VisitErasePersonalDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ErasePersonalData404JSONResponse Error
+
+func (response ErasePersonalData404JSONResponse) VisitErasePersonalDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ErasePersonalData409JSONResponse Error
+
+func (response ErasePersonalData409JSONResponse) VisitErasePersonalDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ErasePersonalDatadefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ErasePersonalDatadefaultJSONResponse) VisitErasePersonalDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetPickupSlotsRequestObject struct {
+}
+
+type GetPickupSlotsResponseObject interface {
+	VisitGetPickupSlotsResponse(w http.ResponseWriter) error
+}
+
+type GetPickupSlots200JSONResponse []PickupSlot
+
+func (response GetPickupSlots200JSONResponse) VisitGetPickupSlotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPickupSlotsdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetPickupSlotsdefaultJSONResponse) VisitGetPickupSlotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreatePickupSlotRequestObject struct {
+	Body *CreatePickupSlotJSONRequestBody
+}
+
+type CreatePickupSlotResponseObject interface {
+	VisitCreatePickupSlotResponse(w http.ResponseWriter) error
+}
+
+type CreatePickupSlot201JSONResponse PickupSlot
+
+func (response CreatePickupSlot201JSONResponse) VisitCreatePickupSlotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePickupSlot400JSONResponse Error
+
+func (response CreatePickupSlot400JSONResponse) VisitCreatePickupSlotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePickupSlotdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response CreatePickupSlotdefaultJSONResponse) VisitCreatePickupSlotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ChangePickupSlotCapacityRequestObject struct {
+	SlotId openapi_types.UUID `json:"slotId"`
+	Body   *ChangePickupSlotCapacityJSONRequestBody
+}
+
+type ChangePickupSlotCapacityResponseObject interface {
+	VisitChangePickupSlotCapacityResponse(w http.ResponseWriter) error
+}
+
+type ChangePickupSlotCapacity200JSONResponse PickupSlot
+
+func (response ChangePickupSlotCapacity200JSONResponse) VisitChangePickupSlotCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangePickupSlotCapacity400JSONResponse Error
+
+func (response ChangePickupSlotCapacity400JSONResponse) VisitChangePickupSlotCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangePickupSlotCapacity404JSONResponse Error
+
+func (response ChangePickupSlotCapacity404JSONResponse) VisitChangePickupSlotCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangePickupSlotCapacity409JSONResponse Error
+
+func (response ChangePickupSlotCapacity409JSONResponse) VisitChangePickupSlotCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangePickupSlotCapacitydefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ChangePickupSlotCapacitydefaultJSONResponse) VisitChangePickupSlotCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetRolloutPercentageRequestObject struct {
+	Flag string `json:"flag"`
+	Body *SetRolloutPercentageJSONRequestBody
+}
+
+type SetRolloutPercentageResponseObject interface {
+	VisitSetRolloutPercentageResponse(w http.ResponseWriter) error
+}
+
+type SetRolloutPercentage200JSONResponse Rollout
+
+func (response SetRolloutPercentage200JSONResponse) VisitSetRolloutPercentageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetRolloutPercentage400JSONResponse Error
+
+func (response SetRolloutPercentage400JSONResponse) VisitSetRolloutPercentageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetRolloutPercentage404JSONResponse Error
+
+func (response SetRolloutPercentage404JSONResponse) VisitSetRolloutPercentageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetRolloutPercentagedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response SetRolloutPercentagedefaultJSONResponse) VisitSetRolloutPercentageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ComputeSLAComplianceRequestObject struct {
+	Body *ComputeSLAComplianceJSONRequestBody
+}
+
+type ComputeSLAComplianceResponseObject interface {
+	VisitComputeSLAComplianceResponse(w http.ResponseWriter) error
+}
+
+type ComputeSLACompliance200JSONResponse SLAComplianceComputationResult
+
+func (response ComputeSLACompliance200JSONResponse) VisitComputeSLAComplianceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ComputeSLACompliance400JSONResponse Error
+
+func (response ComputeSLACompliance400JSONResponse) VisitComputeSLAComplianceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ComputeSLACompliancedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ComputeSLACompliancedefaultJSONResponse) VisitComputeSLAComplianceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSLATargetsRequestObject struct {
+}
+
+type GetSLATargetsResponseObject interface {
+	VisitGetSLATargetsResponse(w http.ResponseWriter) error
+}
+
+type GetSLATargets200JSONResponse []SLATarget
+
+func (response GetSLATargets200JSONResponse) VisitGetSLATargetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSLATargetsdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetSLATargetsdefaultJSONResponse) VisitGetSLATargetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReplaceSLATargetsRequestObject struct {
+	Body *ReplaceSLATargetsJSONRequestBody
+}
+
+type ReplaceSLATargetsResponseObject interface {
+	VisitReplaceSLATargetsResponse(w http.ResponseWriter) error
+}
+
+type ReplaceSLATargets200JSONResponse []SLATarget
+
+func (response ReplaceSLATargets200JSONResponse) VisitReplaceSLATargetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceSLATargets400JSONResponse Error
+
+func (response ReplaceSLATargets400JSONResponse) VisitReplaceSLATargetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceSLATargetsdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ReplaceSLATargetsdefaultJSONResponse) VisitReplaceSLATargetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSurgeModeRequestObject struct {
+}
+
+type GetSurgeModeResponseObject interface {
+	VisitGetSurgeModeResponse(w http.ResponseWriter) error
+}
+
+type GetSurgeMode200JSONResponse SurgeMode
+
+func (response GetSurgeMode200JSONResponse) VisitGetSurgeModeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSurgeModedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetSurgeModedefaultJSONResponse) VisitGetSurgeModeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ChangeSurgeModeRequestObject struct {
+	Body *ChangeSurgeModeJSONRequestBody
+}
+
+type ChangeSurgeModeResponseObject interface {
+	VisitChangeSurgeModeResponse(w http.ResponseWriter) error
+}
+
+type ChangeSurgeMode200JSONResponse SurgeModeChangeResult
+
+func (response ChangeSurgeMode200JSONResponse) VisitChangeSurgeModeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeSurgeMode400JSONResponse Error
+
+func (response ChangeSurgeMode400JSONResponse) VisitChangeSurgeModeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeSurgeModedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ChangeSurgeModedefaultJSONResponse) VisitChangeSurgeModeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PurgeSyntheticDataRequestObject struct {
+	Body *PurgeSyntheticDataJSONRequestBody
+}
+
+type PurgeSyntheticDataResponseObject interface {
+	VisitPurgeSyntheticDataResponse(w http.ResponseWriter) error
+}
+
+type PurgeSyntheticData200JSONResponse SyntheticDataPurgeResult
+
+func (response PurgeSyntheticData200JSONResponse) VisitPurgeSyntheticDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeSyntheticData400JSONResponse Error
+
+func (response PurgeSyntheticData400JSONResponse) VisitPurgeSyntheticDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeSyntheticDatadefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response PurgeSyntheticDatadefaultJSONResponse) VisitPurgeSyntheticDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCouriersRequestObject struct {
+	Params GetCouriersParams
+}
+
+type GetCouriersResponseObject interface {
+	VisitGetCouriersResponse(w http.ResponseWriter) error
+}
+
+type GetCouriers200JSONResponse []Courier
+
+func (response GetCouriers200JSONResponse) VisitGetCouriersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCouriers400JSONResponse Error
+
+func (response GetCouriers400JSONResponse) VisitGetCouriersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCouriersdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetCouriersdefaultJSONResponse) VisitGetCouriersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateCourierRequestObject struct {
+	Body *CreateCourierJSONRequestBody
+}
+
+type CreateCourierResponseObject interface {
+	VisitCreateCourierResponse(w http.ResponseWriter) error
+}
+
+type CreateCourier200JSONResponse Courier
+
+func (response CreateCourier200JSONResponse) VisitCreateCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCourier201JSONResponse Courier
+
+func (response CreateCourier201JSONResponse) VisitCreateCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCourier400JSONResponse Error
+
+func (response CreateCourier400JSONResponse) VisitCreateCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCourier409JSONResponse Error
+
+func (response CreateCourier409JSONResponse) VisitCreateCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCourierdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response CreateCourierdefaultJSONResponse) VisitCreateCourierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ImportCouriersRequestObject struct {
+	JSONBody *ImportCouriersJSONRequestBody
+	Body     io.Reader
+}
+
+type ImportCouriersResponseObject interface {
+	VisitImportCouriersResponse(w http.ResponseWriter) error
+}
+
+type ImportCouriers200JSONResponse CourierImportReport
+
+func (response ImportCouriers200JSONResponse) VisitImportCouriersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportCouriers400JSONResponse Error
+
+func (response ImportCouriers400JSONResponse) VisitImportCouriersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportCouriers422JSONResponse CourierImportReport
+
+func (response ImportCouriers422JSONResponse) VisitImportCouriersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportCouriersdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ImportCouriersdefaultJSONResponse) VisitImportCouriersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RecordDeviceTelemetryRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+	Body      *RecordDeviceTelemetryJSONRequestBody
+}
+
+type RecordDeviceTelemetryResponseObject interface {
+	VisitRecordDeviceTelemetryResponse(w http.ResponseWriter) error
+}
+
+type RecordDeviceTelemetry200JSONResponse DeviceHealth
+
+func (response RecordDeviceTelemetry200JSONResponse) VisitRecordDeviceTelemetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordDeviceTelemetry400JSONResponse Error
+
+func (response RecordDeviceTelemetry400JSONResponse) VisitRecordDeviceTelemetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordDeviceTelemetry404JSONResponse Error
+
+func (response RecordDeviceTelemetry404JSONResponse) VisitRecordDeviceTelemetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordDeviceTelemetrydefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response RecordDeviceTelemetrydefaultJSONResponse) VisitRecordDeviceTelemetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateCourierLocationRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+	Body      *UpdateCourierLocationJSONRequestBody
+}
+
+type UpdateCourierLocationResponseObject interface {
+	VisitUpdateCourierLocationResponse(w http.ResponseWriter) error
+}
+
+type UpdateCourierLocation204Response struct {
+}
+
+func (response UpdateCourierLocation204Response) VisitUpdateCourierLocationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type UpdateCourierLocation400JSONResponse Error
+
+func (response UpdateCourierLocation400JSONResponse) VisitUpdateCourierLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCourierLocation404JSONResponse Error
+
+func (response UpdateCourierLocation404JSONResponse) VisitUpdateCourierLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCourierLocationdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response UpdateCourierLocationdefaultJSONResponse) VisitUpdateCourierLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ImportCourierLocationsRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+	Body      *ImportCourierLocationsJSONRequestBody
+}
+
+type ImportCourierLocationsResponseObject interface {
+	VisitImportCourierLocationsResponse(w http.ResponseWriter) error
+}
+
+type ImportCourierLocations200JSONResponse CourierLocationImport
+
+func (response ImportCourierLocations200JSONResponse) VisitImportCourierLocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportCourierLocations400JSONResponse Error
+
+func (response ImportCourierLocations400JSONResponse) VisitImportCourierLocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportCourierLocations404JSONResponse Error
+
+func (response ImportCourierLocations404JSONResponse) VisitImportCourierLocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportCourierLocationsdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ImportCourierLocationsdefaultJSONResponse) VisitImportCourierLocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetCourierBreakRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+	Body      *SetCourierBreakJSONRequestBody
+}
+
+type SetCourierBreakResponseObject interface {
+	VisitSetCourierBreakResponse(w http.ResponseWriter) error
+}
+
+type SetCourierBreak204Response struct {
+}
+
+func (response SetCourierBreak204Response) VisitSetCourierBreakResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type SetCourierBreak400JSONResponse Error
+
+func (response SetCourierBreak400JSONResponse) VisitSetCourierBreakResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCourierBreak404JSONResponse Error
+
+func (response SetCourierBreak404JSONResponse) VisitSetCourierBreakResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCourierBreak409JSONResponse Error
+
+func (response SetCourierBreak409JSONResponse) VisitSetCourierBreakResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCourierBreakdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response SetCourierBreakdefaultJSONResponse) VisitSetCourierBreakResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetCourierShiftRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+	Body      *SetCourierShiftJSONRequestBody
+}
+
+type SetCourierShiftResponseObject interface {
+	VisitSetCourierShiftResponse(w http.ResponseWriter) error
+}
+
+type SetCourierShift204Response struct {
+}
+
+func (response SetCourierShift204Response) VisitSetCourierShiftResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type SetCourierShift400JSONResponse Error
+
+func (response SetCourierShift400JSONResponse) VisitSetCourierShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCourierShift404JSONResponse Error
+
+func (response SetCourierShift404JSONResponse) VisitSetCourierShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCourierShift409JSONResponse Error
+
+func (response SetCourierShift409JSONResponse) VisitSetCourierShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCourierShiftdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response SetCourierShiftdefaultJSONResponse) VisitSetCourierShiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCourierStatsRequestObject struct {
+	CourierId openapi_types.UUID `json:"courierId"`
+}
+
+type GetCourierStatsResponseObject interface {
+	VisitGetCourierStatsResponse(w http.ResponseWriter) error
+}
+
+type GetCourierStats200JSONResponse CourierStats
+
+func (response GetCourierStats200JSONResponse) VisitGetCourierStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCourierStats400JSONResponse Error
+
+func (response GetCourierStats400JSONResponse) VisitGetCourierStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCourierStats404JSONResponse Error
+
+func (response GetCourierStats404JSONResponse) VisitGetCourierStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCourierStatsdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetCourierStatsdefaultJSONResponse) VisitGetCourierStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListOrdersRequestObject struct {
+	Params ListOrdersParams
+}
+
+type ListOrdersResponseObject interface {
+	VisitListOrdersResponse(w http.ResponseWriter) error
+}
+
+type ListOrders200JSONResponse OrderPage
+
+func (response ListOrders200JSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrders400JSONResponse Error
+
+func (response ListOrders400JSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrdersdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ListOrdersdefaultJSONResponse) VisitListOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateOrderRequestObject struct {
+	Body *CreateOrderJSONRequestBody
+}
+
+type CreateOrderResponseObject interface {
+	VisitCreateOrderResponse(w http.ResponseWriter) error
+}
+
+type CreateOrder201ResponseHeaders struct {
+	Warning string
+}
+
+type CreateOrder201Response struct {
+	Headers CreateOrder201ResponseHeaders
+}
+
+func (response CreateOrder201Response) VisitCreateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Warning", fmt.Sprint(response.Headers.Warning))
+	w.WriteHeader(201)
+	return nil
+}
+
+type CreateOrder400JSONResponse Error
+
+func (response CreateOrder400JSONResponse) VisitCreateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrder403JSONResponse Error
+
+func (response CreateOrder403JSONResponse) VisitCreateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrder409JSONResponse Error
+
+func (response CreateOrder409JSONResponse) VisitCreateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrder429JSONResponse Error
+
+func (response CreateOrder429JSONResponse) VisitCreateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrderdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response CreateOrderdefaultJSONResponse) VisitCreateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrdersRequestObject struct {
+	Params GetOrdersParams
+}
+
+type GetOrdersResponseObject interface {
+	VisitGetOrdersResponse(w http.ResponseWriter) error
+}
+
+type GetOrders200JSONResponse []Order
+
+func (response GetOrders200JSONResponse) VisitGetOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrders400JSONResponse Error
+
+func (response GetOrders400JSONResponse) VisitGetOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrdersdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetOrdersdefaultJSONResponse) VisitGetOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrderByExternalReferenceRequestObject struct {
+	Marketplace string `json:"marketplace"`
+	ExternalId  string `json:"externalId"`
+}
+
+type GetOrderByExternalReferenceResponseObject interface {
+	VisitGetOrderByExternalReferenceResponse(w http.ResponseWriter) error
+}
+
+type GetOrderByExternalReference200JSONResponse LinkedOrder
+
+func (response GetOrderByExternalReference200JSONResponse) VisitGetOrderByExternalReferenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderByExternalReference400JSONResponse Error
+
+func (response GetOrderByExternalReference400JSONResponse) VisitGetOrderByExternalReferenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderByExternalReference404JSONResponse Error
+
+func (response GetOrderByExternalReference404JSONResponse) VisitGetOrderByExternalReferenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderByExternalReferencedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetOrderByExternalReferencedefaultJSONResponse) VisitGetOrderByExternalReferenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type BulkCancelOrdersRequestObject struct {
+	Body *BulkCancelOrdersJSONRequestBody
+}
+
+type BulkCancelOrdersResponseObject interface {
+	VisitBulkCancelOrdersResponse(w http.ResponseWriter) error
+}
+
+type BulkCancelOrders200JSONResponse BulkCancellation
+
+func (response BulkCancelOrders200JSONResponse) VisitBulkCancelOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BulkCancelOrders400JSONResponse Error
+
+func (response BulkCancelOrders400JSONResponse) VisitBulkCancelOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BulkCancelOrdersdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response BulkCancelOrdersdefaultJSONResponse) VisitBulkCancelOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UploadOrdersRequestObject struct {
+	Body *multipart.Reader
+}
+
+type UploadOrdersResponseObject interface {
+	VisitUploadOrdersResponse(w http.ResponseWriter) error
+}
+
+type UploadOrders200JSONResponse OrderUploadReport
+
+func (response UploadOrders200JSONResponse) VisitUploadOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadOrders400JSONResponse Error
+
+func (response UploadOrders400JSONResponse) VisitUploadOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadOrders429JSONResponse Error
+
+func (response UploadOrders429JSONResponse) VisitUploadOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadOrdersdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response UploadOrdersdefaultJSONResponse) VisitUploadOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrderRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+	Params  GetOrderParams
+}
+
+type GetOrderResponseObject interface {
+	VisitGetOrderResponse(w http.ResponseWriter) error
+}
+
+type GetOrder200JSONResponse OrderState
+
+func (response GetOrder200JSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrder400JSONResponse Error
+
+func (response GetOrder400JSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrder404JSONResponse Error
+
+func (response GetOrder404JSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetOrderdefaultJSONResponse) VisitGetOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CancelOrderRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+}
+
+type CancelOrderResponseObject interface {
+	VisitCancelOrderResponse(w http.ResponseWriter) error
+}
+
+type CancelOrder204Response struct {
+}
+
+func (response CancelOrder204Response) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type CancelOrder400JSONResponse Error
+
+func (response CancelOrder400JSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrder404JSONResponse Error
+
+func (response CancelOrder404JSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrder409JSONResponse Error
+
+func (response CancelOrder409JSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOrderdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response CancelOrderdefaultJSONResponse) VisitCancelOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetAssignmentExplanationRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+}
+
+type GetAssignmentExplanationResponseObject interface {
+	VisitGetAssignmentExplanationResponse(w http.ResponseWriter) error
+}
+
+type GetAssignmentExplanation200JSONResponse AssignmentExplanation
+
+func (response GetAssignmentExplanation200JSONResponse) VisitGetAssignmentExplanationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssignmentExplanation404JSONResponse Error
+
+func (response GetAssignmentExplanation404JSONResponse) VisitGetAssignmentExplanationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssignmentExplanationdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetAssignmentExplanationdefaultJSONResponse) VisitGetAssignmentExplanationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrderEtaRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+}
+
+type GetOrderEtaResponseObject interface {
+	VisitGetOrderEtaResponse(w http.ResponseWriter) error
+}
+
+type GetOrderEta200JSONResponse OrderEta
+
+func (response GetOrderEta200JSONResponse) VisitGetOrderEtaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderEta400JSONResponse Error
+
+func (response GetOrderEta400JSONResponse) VisitGetOrderEtaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderEta404JSONResponse Error
+
+func (response GetOrderEta404JSONResponse) VisitGetOrderEtaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderEta409JSONResponse Error
+
+func (response GetOrderEta409JSONResponse) VisitGetOrderEtaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderEtadefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetOrderEtadefaultJSONResponse) VisitGetOrderEtaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ConfirmOrderHandoverRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+	Body    *ConfirmOrderHandoverJSONRequestBody
+}
+
+type ConfirmOrderHandoverResponseObject interface {
+	VisitConfirmOrderHandoverResponse(w http.ResponseWriter) error
+}
+
+type ConfirmOrderHandover204Response struct {
+}
+
+func (response ConfirmOrderHandover204Response) VisitConfirmOrderHandoverResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ConfirmOrderHandover400JSONResponse Error
+
+func (response ConfirmOrderHandover400JSONResponse) VisitConfirmOrderHandoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmOrderHandover404JSONResponse Error
+
+func (response ConfirmOrderHandover404JSONResponse) VisitConfirmOrderHandoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmOrderHandover409JSONResponse Error
+
+func (response ConfirmOrderHandover409JSONResponse) VisitConfirmOrderHandoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmOrderHandoverdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ConfirmOrderHandoverdefaultJSONResponse) VisitConfirmOrderHandoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrderHistoryRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+}
+
+type GetOrderHistoryResponseObject interface {
+	VisitGetOrderHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetOrderHistory200JSONResponse []OrderHistoryEntry
+
+func (response GetOrderHistory200JSONResponse) VisitGetOrderHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderHistory400JSONResponse Error
+
+func (response GetOrderHistory400JSONResponse) VisitGetOrderHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderHistory404JSONResponse Error
+
+func (response GetOrderHistory404JSONResponse) VisitGetOrderHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderHistorydefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetOrderHistorydefaultJSONResponse) VisitGetOrderHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOrderMessagesRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+}
+
+type GetOrderMessagesResponseObject interface {
+	VisitGetOrderMessagesResponse(w http.ResponseWriter) error
+}
+
+type GetOrderMessages200JSONResponse OrderThread
+
+func (response GetOrderMessages200JSONResponse) VisitGetOrderMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderMessages400JSONResponse Error
+
+func (response GetOrderMessages400JSONResponse) VisitGetOrderMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderMessages404JSONResponse Error
+
+func (response GetOrderMessages404JSONResponse) VisitGetOrderMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrderMessagesdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetOrderMessagesdefaultJSONResponse) VisitGetOrderMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostOrderMessageRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+	Body    *PostOrderMessageJSONRequestBody
+}
+
+type PostOrderMessageResponseObject interface {
+	VisitPostOrderMessageResponse(w http.ResponseWriter) error
+}
+
+type PostOrderMessage201Response struct {
+}
+
+func (response PostOrderMessage201Response) VisitPostOrderMessageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type PostOrderMessage400JSONResponse Error
+
+func (response PostOrderMessage400JSONResponse) VisitPostOrderMessageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOrderMessage404JSONResponse Error
+
+func (response PostOrderMessage404JSONResponse) VisitPostOrderMessageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOrderMessage409JSONResponse Error
+
+func (response PostOrderMessage409JSONResponse) VisitPostOrderMessageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOrderMessagedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response PostOrderMessagedefaultJSONResponse) VisitPostOrderMessageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RecalculateOrderEtaRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+}
+
+type RecalculateOrderEtaResponseObject interface {
+	VisitRecalculateOrderEtaResponse(w http.ResponseWriter) error
+}
+
+type RecalculateOrderEta200JSONResponse EstimatedArrival
+
+func (response RecalculateOrderEta200JSONResponse) VisitRecalculateOrderEtaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecalculateOrderEta400JSONResponse Error
+
+func (response RecalculateOrderEta400JSONResponse) VisitRecalculateOrderEtaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecalculateOrderEta404JSONResponse Error
+
+func (response RecalculateOrderEta404JSONResponse) VisitRecalculateOrderEtaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecalculateOrderEta409JSONResponse Error
+
+func (response RecalculateOrderEta409JSONResponse) VisitRecalculateOrderEtaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecalculateOrderEtadefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response RecalculateOrderEtadefaultJSONResponse) VisitRecalculateOrderEtaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ShareOrderTrackingRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+}
+
+type ShareOrderTrackingResponseObject interface {
+	VisitShareOrderTrackingResponse(w http.ResponseWriter) error
+}
+
+type ShareOrderTracking201JSONResponse TrackingLink
+
+func (response ShareOrderTracking201JSONResponse) VisitShareOrderTrackingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ShareOrderTracking400JSONResponse Error
+
+func (response ShareOrderTracking400JSONResponse) VisitShareOrderTrackingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ShareOrderTracking404JSONResponse Error
+
+func (response ShareOrderTracking404JSONResponse) VisitShareOrderTrackingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ShareOrderTracking409JSONResponse Error
+
+func (response ShareOrderTracking409JSONResponse) VisitShareOrderTrackingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ShareOrderTrackingdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ShareOrderTrackingdefaultJSONResponse) VisitShareOrderTrackingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type TransferOrderRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+	Body    *TransferOrderJSONRequestBody
+}
+
+type TransferOrderResponseObject interface {
+	VisitTransferOrderResponse(w http.ResponseWriter) error
+}
+
+type TransferOrder201JSONResponse OrderTransfer
+
+func (response TransferOrder201JSONResponse) VisitTransferOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransferOrder400JSONResponse Error
+
+func (response TransferOrder400JSONResponse) VisitTransferOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransferOrder404JSONResponse Error
+
+func (response TransferOrder404JSONResponse) VisitTransferOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransferOrder409JSONResponse Error
+
+func (response TransferOrder409JSONResponse) VisitTransferOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransferOrderdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response TransferOrderdefaultJSONResponse) VisitTransferOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReceivePaymentEventRequestObject struct {
+	Params ReceivePaymentEventParams
+	Body   *ReceivePaymentEventJSONRequestBody
+}
+
+type ReceivePaymentEventResponseObject interface {
+	VisitReceivePaymentEventResponse(w http.ResponseWriter) error
+}
+
+type ReceivePaymentEvent204Response struct {
+}
+
+func (response ReceivePaymentEvent204Response) VisitReceivePaymentEventResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ReceivePaymentEvent400JSONResponse Error
+
+func (response ReceivePaymentEvent400JSONResponse) VisitReceivePaymentEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReceivePaymentEvent401JSONResponse Error
+
+func (response ReceivePaymentEvent401JSONResponse) VisitReceivePaymentEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReceivePaymentEvent404JSONResponse Error
+
+func (response ReceivePaymentEvent404JSONResponse) VisitReceivePaymentEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReceivePaymentEvent409JSONResponse Error
+
+func (response ReceivePaymentEvent409JSONResponse) VisitReceivePaymentEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReceivePaymentEventdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ReceivePaymentEventdefaultJSONResponse) VisitReceivePaymentEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSLAReportRequestObject struct {
+	Params GetSLAReportParams
+}
+
+type GetSLAReportResponseObject interface {
+	VisitGetSLAReportResponse(w http.ResponseWriter) error
+}
+
+type GetSLAReport200JSONResponse SLAReport
+
+func (response GetSLAReport200JSONResponse) VisitGetSLAReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSLAReport400JSONResponse Error
+
+func (response GetSLAReport400JSONResponse) VisitGetSLAReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSLAReportdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetSLAReportdefaultJSONResponse) VisitGetSLAReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSLOStatusRequestObject struct {
+}
+
+type GetSLOStatusResponseObject interface {
+	VisitGetSLOStatusResponse(w http.ResponseWriter) error
+}
+
+type GetSLOStatus200JSONResponse SLOStatus
+
+func (response GetSLOStatus200JSONResponse) VisitGetSLOStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSLOStatusdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetSLOStatusdefaultJSONResponse) VisitGetSLOStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSharedTrackingRequestObject struct {
+	TrackingToken string `json:"trackingToken"`
+}
+
+type GetSharedTrackingResponseObject interface {
+	VisitGetSharedTrackingResponse(w http.ResponseWriter) error
+}
+
+type GetSharedTracking200JSONResponse SharedTracking
+
+func (response GetSharedTracking200JSONResponse) VisitGetSharedTrackingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedTracking400JSONResponse Error
+
+func (response GetSharedTracking400JSONResponse) VisitGetSharedTrackingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedTracking404JSONResponse Error
+
+func (response GetSharedTracking404JSONResponse) VisitGetSharedTrackingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedTrackingdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetSharedTrackingdefaultJSONResponse) VisitGetSharedTrackingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RateOrderRequestObject struct {
+	TrackingToken string `json:"trackingToken"`
+	Body          *RateOrderJSONRequestBody
+}
+
+type RateOrderResponseObject interface {
+	VisitRateOrderResponse(w http.ResponseWriter) error
+}
+
+type RateOrder200JSONResponse Rating
+
+func (response RateOrder200JSONResponse) VisitRateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RateOrder201JSONResponse Rating
+
+func (response RateOrder201JSONResponse) VisitRateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RateOrder400JSONResponse Error
+
+func (response RateOrder400JSONResponse) VisitRateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RateOrder404JSONResponse Error
+
+func (response RateOrder404JSONResponse) VisitRateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RateOrder409JSONResponse Error
+
+func (response RateOrder409JSONResponse) VisitRateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RateOrderdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response RateOrderdefaultJSONResponse) VisitRateOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type TipOrderRequestObject struct {
+	TrackingToken string `json:"trackingToken"`
+	Params        TipOrderParams
+	Body          *TipOrderJSONRequestBody
+}
+
+type TipOrderResponseObject interface {
+	VisitTipOrderResponse(w http.ResponseWriter) error
+}
+
+type TipOrder200JSONResponse Tip
+
+func (response TipOrder200JSONResponse) VisitTipOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TipOrder201JSONResponse Tip
+
+func (response TipOrder201JSONResponse) VisitTipOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TipOrder400JSONResponse Error
+
+func (response TipOrder400JSONResponse) VisitTipOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TipOrder404JSONResponse Error
+
+func (response TipOrder404JSONResponse) VisitTipOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TipOrder409JSONResponse Error
+
+func (response TipOrder409JSONResponse) VisitTipOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TipOrderdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response TipOrderdefaultJSONResponse) VisitTipOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type IssueBlobUploadRequestObject struct {
+	Body *IssueBlobUploadJSONRequestBody
+}
+
+type IssueBlobUploadResponseObject interface {
+	VisitIssueBlobUploadResponse(w http.ResponseWriter) error
+}
+
+type IssueBlobUpload201JSONResponse BlobUpload
+
+func (response IssueBlobUpload201JSONResponse) VisitIssueBlobUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueBlobUpload400JSONResponse Error
+
+func (response IssueBlobUpload400JSONResponse) VisitIssueBlobUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueBlobUpload503JSONResponse Error
+
+func (response IssueBlobUpload503JSONResponse) VisitIssueBlobUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueBlobUploaddefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response IssueBlobUploaddefaultJSONResponse) VisitIssueBlobUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetChangesRequestObject struct {
+	Params GetChangesParams
+}
+
+type GetChangesResponseObject interface {
+	VisitGetChangesResponse(w http.ResponseWriter) error
+}
+
+type GetChanges200JSONResponse ChangeFeed
+
+func (response GetChanges200JSONResponse) VisitGetChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChanges400JSONResponse Error
+
+func (response GetChanges400JSONResponse) VisitGetChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChangesdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetChangesdefaultJSONResponse) VisitGetChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCourierAvailabilityFeedRequestObject struct {
+	Params GetCourierAvailabilityFeedParams
+}
+
+type GetCourierAvailabilityFeedResponseObject interface {
+	VisitGetCourierAvailabilityFeedResponse(w http.ResponseWriter) error
+}
+
+type GetCourierAvailabilityFeed200JSONResponse CourierAvailabilityFeed
+
+func (response GetCourierAvailabilityFeed200JSONResponse) VisitGetCourierAvailabilityFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCourierAvailabilityFeed400JSONResponse Error
+
+func (response GetCourierAvailabilityFeed400JSONResponse) VisitGetCourierAvailabilityFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCourierAvailabilityFeeddefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetCourierAvailabilityFeeddefaultJSONResponse) VisitGetCourierAvailabilityFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetAPIUsageRequestObject struct {
+	Params GetAPIUsageParams
+}
+
+type GetAPIUsageResponseObject interface {
+	VisitGetAPIUsageResponse(w http.ResponseWriter) error
+}
+
+type GetAPIUsage200JSONResponse []APIUsage
+
+func (response GetAPIUsage200JSONResponse) VisitGetAPIUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIUsage400JSONResponse Error
+
+func (response GetAPIUsage400JSONResponse) VisitGetAPIUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIUsagedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetAPIUsagedefaultJSONResponse) VisitGetAPIUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOwnAPIUsageRequestObject struct {
+	Params GetOwnAPIUsageParams
+}
+
+type GetOwnAPIUsageResponseObject interface {
+	VisitGetOwnAPIUsageResponse(w http.ResponseWriter) error
+}
+
+type GetOwnAPIUsage200JSONResponse APIUsage
+
+func (response GetOwnAPIUsage200JSONResponse) VisitGetOwnAPIUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOwnAPIUsage400JSONResponse Error
+
+func (response GetOwnAPIUsage400JSONResponse) VisitGetOwnAPIUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOwnAPIUsage401JSONResponse Error
+
+func (response GetOwnAPIUsage401JSONResponse) VisitGetOwnAPIUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOwnAPIUsagedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetOwnAPIUsagedefaultJSONResponse) VisitGetOwnAPIUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Получить историю объявлений
+	// (GET /api/v1/admin/announcements)
+	GetAnnouncements(ctx context.Context, request GetAnnouncementsRequestObject) (GetAnnouncementsResponseObject, error)
+	// Разослать объявление курьерам
+	// (POST /api/v1/admin/announcements)
+	BroadcastAnnouncement(ctx context.Context, request BroadcastAnnouncementRequestObject) (BroadcastAnnouncementResponseObject, error)
+	// Получить правила значков курьеров
+	// (GET /api/v1/admin/badge-rules)
+	GetBadgeRules(ctx context.Context, request GetBadgeRulesRequestObject) (GetBadgeRulesResponseObject, error)
+	// Получить состояние устройств курьеров
+	// (GET /api/v1/admin/couriers/device-health)
+	GetDeviceHealth(ctx context.Context, request GetDeviceHealthRequestObject) (GetDeviceHealthResponseObject, error)
+	// Получить отчет о рабочем времени курьеров
+	// (GET /api/v1/admin/couriers/working-hours)
+	GetCourierWorkingHours(ctx context.Context, request GetCourierWorkingHoursRequestObject) (GetCourierWorkingHoursResponseObject, error)
+	// Запланировать отсутствие курьера
+	// (POST /api/v1/admin/couriers/{courierId}/absences)
+	PlanCourierAbsence(ctx context.Context, request PlanCourierAbsenceRequestObject) (PlanCourierAbsenceResponseObject, error)
+	// Отменить отсутствие курьера
+	// (DELETE /api/v1/admin/couriers/{courierId}/absences/{absenceId})
+	CancelCourierAbsence(ctx context.Context, request CancelCourierAbsenceRequestObject) (CancelCourierAbsenceResponseObject, error)
+	// Вывести курьера из работы
+	// (POST /api/v1/admin/couriers/{courierId}/deactivation)
+	DeactivateCourier(ctx context.Context, request DeactivateCourierRequestObject) (DeactivateCourierResponseObject, error)
+	// Застраховать курьера или отозвать страховку
+	// (PUT /api/v1/admin/couriers/{courierId}/insurance)
+	SetCourierInsurance(ctx context.Context, request SetCourierInsuranceRequestObject) (SetCourierInsuranceResponseObject, error)
+	// Получить календарь обслуживания транспорта курьера
+	// (GET /api/v1/admin/couriers/{courierId}/maintenance-windows)
+	GetCourierMaintenanceWindows(ctx context.Context, request GetCourierMaintenanceWindowsRequestObject) (GetCourierMaintenanceWindowsResponseObject, error)
+	// Запланировать обслуживание транспорта курьера
+	// (POST /api/v1/admin/couriers/{courierId}/maintenance-windows)
+	ScheduleCourierMaintenance(ctx context.Context, request ScheduleCourierMaintenanceRequestObject) (ScheduleCourierMaintenanceResponseObject, error)
+	// Отменить обслуживание транспорта курьера
+	// (DELETE /api/v1/admin/couriers/{courierId}/maintenance-windows/{windowId})
+	CancelCourierMaintenance(ctx context.Context, request CancelCourierMaintenanceRequestObject) (CancelCourierMaintenanceResponseObject, error)
+	// Перенести обслуживание транспорта курьера
+	// (PUT /api/v1/admin/couriers/{courierId}/maintenance-windows/{windowId})
+	RescheduleCourierMaintenance(ctx context.Context, request RescheduleCourierMaintenanceRequestObject) (RescheduleCourierMaintenanceResponseObject, error)
+	// Объединить дубль курьера с курьером
+	// (POST /api/v1/admin/couriers/{courierId}/merges)
+	MergeCouriers(ctx context.Context, request MergeCouriersRequestObject) (MergeCouriersResponseObject, error)
+	// Изменить профиль курьера
+	// (PUT /api/v1/admin/couriers/{courierId}/profile)
+	UpdateCourierProfile(ctx context.Context, request UpdateCourierProfileRequestObject) (UpdateCourierProfileResponseObject, error)
+	// Снять отметку о проверке курьера
+	// (DELETE /api/v1/admin/couriers/{courierId}/review-flag)
+	ClearCourierReviewFlag(ctx context.Context, request ClearCourierReviewFlagRequestObject) (ClearCourierReviewFlagResponseObject, error)
+	// Удалить расписание курьера
+	// (DELETE /api/v1/admin/couriers/{courierId}/schedule)
+	ClearCourierSchedule(ctx context.Context, request ClearCourierScheduleRequestObject) (ClearCourierScheduleResponseObject, error)
+	// Задать расписание курьера
+	// (PUT /api/v1/admin/couriers/{courierId}/schedule)
+	SetCourierSchedule(ctx context.Context, request SetCourierScheduleRequestObject) (SetCourierScheduleResponseObject, error)
+	// Изменить состояние обслуживания места хранения
+	// (PUT /api/v1/admin/couriers/{courierId}/storage-places/{storagePlaceId}/maintenance)
+	SetStoragePlaceMaintenance(ctx context.Context, request SetStoragePlaceMaintenanceRequestObject) (SetStoragePlaceMaintenanceResponseObject, error)
+	// Получить правила подбора курьеров
+	// (GET /api/v1/admin/dispatch/matching-rules)
+	GetMatchingRules(ctx context.Context, request GetMatchingRulesRequestObject) (GetMatchingRulesResponseObject, error)
+	// Заменить правила подбора курьеров
+	// (PUT /api/v1/admin/dispatch/matching-rules)
+	ReplaceMatchingRules(ctx context.Context, request ReplaceMatchingRulesRequestObject) (ReplaceMatchingRulesResponseObject, error)
+	// Оценить эффект добавления курьеров
+	// (POST /api/v1/admin/fleet/what-if)
+	AnalyzeFleetWhatIf(ctx context.Context, request AnalyzeFleetWhatIfRequestObject) (AnalyzeFleetWhatIfResponseObject, error)
+	// Получить микрозоны плотного спроса
+	// (GET /api/v1/admin/microzones)
+	GetMicrozones(ctx context.Context, request GetMicrozonesRequestObject) (GetMicrozonesResponseObject, error)
+	// Пересчитать микрозоны
+	// (POST /api/v1/admin/microzones/recompute)
+	RecomputeMicrozones(ctx context.Context, request RecomputeMicrozonesRequestObject) (RecomputeMicrozonesResponseObject, error)
+	// Получить очередь заказов на проверке
+	// (GET /api/v1/admin/orders/review-queue)
+	GetOrderReviewQueue(ctx context.Context, request GetOrderReviewQueueRequestObject) (GetOrderReviewQueueResponseObject, error)
+	// Одобрить заказ после проверки
+	// (POST /api/v1/admin/orders/{orderId}/review-approval)
+	ApproveOrderReview(ctx context.Context, request ApproveOrderReviewRequestObject) (ApproveOrderReviewResponseObject, error)
+	// Выгрузить выплаты курьерам
+	// (GET /api/v1/admin/payouts)
+	GetPayoutExport(ctx context.Context, request GetPayoutExportRequestObject) (GetPayoutExportResponseObject, error)
+	// Стереть персональные данные клиента
+	// (POST /api/v1/admin/personal-data-erasures)
+	ErasePersonalData(ctx context.Context, request ErasePersonalDataRequestObject) (ErasePersonalDataResponseObject, error)
+	// Получить слоты выдачи на складе
+	// (GET /api/v1/admin/pickup-slots)
+	GetPickupSlots(ctx context.Context, request GetPickupSlotsRequestObject) (GetPickupSlotsResponseObject, error)
+	// Создать слот выдачи на складе
+	// (POST /api/v1/admin/pickup-slots)
+	CreatePickupSlot(ctx context.Context, request CreatePickupSlotRequestObject) (CreatePickupSlotResponseObject, error)
+	// Изменить вместимость слота выдачи
+	// (PUT /api/v1/admin/pickup-slots/{slotId}/capacity)
+	ChangePickupSlotCapacity(ctx context.Context, request ChangePickupSlotCapacityRequestObject) (ChangePickupSlotCapacityResponseObject, error)
+	// Изменить долю поэтапного включения
+	// (PUT /api/v1/admin/rollouts/{flag})
+	SetRolloutPercentage(ctx context.Context, request SetRolloutPercentageRequestObject) (SetRolloutPercentageResponseObject, error)
+	// Вычислить соблюдение SLA за день
+	// (POST /api/v1/admin/sla/compliance)
+	ComputeSLACompliance(ctx context.Context, request ComputeSLAComplianceRequestObject) (ComputeSLAComplianceResponseObject, error)
+	// Получить цели SLA
+	// (GET /api/v1/admin/sla/targets)
+	GetSLATargets(ctx context.Context, request GetSLATargetsRequestObject) (GetSLATargetsResponseObject, error)
+	// Заменить цели SLA
+	// (PUT /api/v1/admin/sla/targets)
+	ReplaceSLATargets(ctx context.Context, request ReplaceSLATargetsRequestObject) (ReplaceSLATargetsResponseObject, error)
+	// Получить режим часа пик
+	// (GET /api/v1/admin/surge)
+	GetSurgeMode(ctx context.Context, request GetSurgeModeRequestObject) (GetSurgeModeResponseObject, error)
+	// Изменить режим часа пик
+	// (PUT /api/v1/admin/surge)
+	ChangeSurgeMode(ctx context.Context, request ChangeSurgeModeRequestObject) (ChangeSurgeModeResponseObject, error)
+	// Удалить тестовые данные
+	// (POST /api/v1/admin/synthetic-data/purge)
+	PurgeSyntheticData(ctx context.Context, request PurgeSyntheticDataRequestObject) (PurgeSyntheticDataResponseObject, error)
+	// Получить всех курьеров
+	// (GET /api/v1/couriers)
+	GetCouriers(ctx context.Context, request GetCouriersRequestObject) (GetCouriersResponseObject, error)
+	// Добавить курьера
+	// (POST /api/v1/couriers)
+	CreateCourier(ctx context.Context, request CreateCourierRequestObject) (CreateCourierResponseObject, error)
+	// Добавить курьеров пакетом
+	// (POST /api/v1/couriers/import)
+	ImportCouriers(ctx context.Context, request ImportCouriersRequestObject) (ImportCouriersResponseObject, error)
+	// Передать показания устройства курьера
+	// (POST /api/v1/couriers/{courierId}/device-telemetry)
+	RecordDeviceTelemetry(ctx context.Context, request RecordDeviceTelemetryRequestObject) (RecordDeviceTelemetryResponseObject, error)
+	// Передать текущую позицию курьера
+	// (PUT /api/v1/couriers/{courierId}/location)
+	UpdateCourierLocation(ctx context.Context, request UpdateCourierLocationRequestObject) (UpdateCourierLocationResponseObject, error)
+	// Загрузить пакет позиций курьера
+	// (POST /api/v1/couriers/{courierId}/locations/batch)
+	ImportCourierLocations(ctx context.Context, request ImportCourierLocationsRequestObject) (ImportCourierLocationsResponseObject, error)
+	// Начать или завершить перерыв курьера
+	// (PUT /api/v1/couriers/{courierId}/break)
+	SetCourierBreak(ctx context.Context, request SetCourierBreakRequestObject) (SetCourierBreakResponseObject, error)
+	// Начать или завершить смену курьера
+	// (PUT /api/v1/couriers/{courierId}/shift)
+	SetCourierShift(ctx context.Context, request SetCourierShiftRequestObject) (SetCourierShiftResponseObject, error)
+	// Получить статистику и значки курьера
+	// (GET /api/v1/couriers/{courierId}/stats)
+	GetCourierStats(ctx context.Context, request GetCourierStatsRequestObject) (GetCourierStatsResponseObject, error)
+	// Получить заказы постранично
+	// (GET /api/v1/orders)
+	ListOrders(ctx context.Context, request ListOrdersRequestObject) (ListOrdersResponseObject, error)
+	// Создать заказ
+	// (POST /api/v1/orders)
+	CreateOrder(ctx context.Context, request CreateOrderRequestObject) (CreateOrderResponseObject, error)
+	// Получить все незавершенные заказы
+	// (GET /api/v1/orders/active)
+	GetOrders(ctx context.Context, request GetOrdersRequestObject) (GetOrdersResponseObject, error)
+	// Найти заказ по заказу маркетплейса
+	// (GET /api/v1/orders/by-external/{marketplace}/{externalId})
+	GetOrderByExternalReference(ctx context.Context, request GetOrderByExternalReferenceRequestObject) (GetOrderByExternalReferenceResponseObject, error)
+	// Массово отменить заказы по фильтру
+	// (POST /api/v1/orders/cancel-bulk)
+	BulkCancelOrders(ctx context.Context, request BulkCancelOrdersRequestObject) (BulkCancelOrdersResponseObject, error)
+	// Загрузить заказы из файла
+	// (POST /api/v1/orders/upload)
+	UploadOrders(ctx context.Context, request UploadOrdersRequestObject) (UploadOrdersResponseObject, error)
+	// Получить состояние заказа на момент времени
+	// (GET /api/v1/orders/{orderId})
+	GetOrder(ctx context.Context, request GetOrderRequestObject) (GetOrderResponseObject, error)
+	// Отменить заказ
+	// (POST /api/v1/orders/{orderId}/cancellation)
+	CancelOrder(ctx context.Context, request CancelOrderRequestObject) (CancelOrderResponseObject, error)
+	// Получить объяснение назначения курьера
+	// (GET /api/v1/orders/{orderId}/assignment-explanation)
+	GetAssignmentExplanation(ctx context.Context, request GetAssignmentExplanationRequestObject) (GetAssignmentExplanationResponseObject, error)
+	// Получить прогноз времени доставки
+	// (GET /api/v1/orders/{orderId}/eta)
+	GetOrderEta(ctx context.Context, request GetOrderEtaRequestObject) (GetOrderEtaResponseObject, error)
+	// Подтвердить передачу застрахованного заказа
+	// (POST /api/v1/orders/{orderId}/handover-confirmations)
+	ConfirmOrderHandover(ctx context.Context, request ConfirmOrderHandoverRequestObject) (ConfirmOrderHandoverResponseObject, error)
+	// Получить историю заказа
+	// (GET /api/v1/orders/{orderId}/history)
+	GetOrderHistory(ctx context.Context, request GetOrderHistoryRequestObject) (GetOrderHistoryResponseObject, error)
+	// Получить переписку по заказу
+	// (GET /api/v1/orders/{orderId}/messages)
+	GetOrderMessages(ctx context.Context, request GetOrderMessagesRequestObject) (GetOrderMessagesResponseObject, error)
+	// Отправить сообщение по заказу
+	// (POST /api/v1/orders/{orderId}/messages)
+	PostOrderMessage(ctx context.Context, request PostOrderMessageRequestObject) (PostOrderMessageResponseObject, error)
+	// Пересчитать прогноз времени доставки
+	// (POST /api/v1/orders/{orderId}/recalculate-eta)
+	RecalculateOrderEta(ctx context.Context, request RecalculateOrderEtaRequestObject) (RecalculateOrderEtaResponseObject, error)
+	// Поделиться отслеживанием заказа
+	// (POST /api/v1/orders/{orderId}/tracking-link)
+	ShareOrderTracking(ctx context.Context, request ShareOrderTrackingRequestObject) (ShareOrderTrackingResponseObject, error)
+	// Передать заказ другому курьеру
+	// (POST /api/v1/orders/{orderId}/transfers)
+	TransferOrder(ctx context.Context, request TransferOrderRequestObject) (TransferOrderResponseObject, error)
+	// Принять событие оплаты
+	// (POST /api/v1/payments/webhook)
+	ReceivePaymentEvent(ctx context.Context, request ReceivePaymentEventRequestObject) (ReceivePaymentEventResponseObject, error)
+	// Получить отчет о соблюдении SLA
+	// (GET /api/v1/reports/sla)
+	GetSLAReport(ctx context.Context, request GetSLAReportRequestObject) (GetSLAReportResponseObject, error)
+	// Получить состояние SLO назначения и доставки
+	// (GET /api/v1/stats/slo)
+	GetSLOStatus(ctx context.Context, request GetSLOStatusRequestObject) (GetSLOStatusResponseObject, error)
+	// Отследить заказ по ссылке
+	// (GET /api/v1/tracking/{trackingToken})
+	GetSharedTracking(ctx context.Context, request GetSharedTrackingRequestObject) (GetSharedTrackingResponseObject, error)
+	// Оценить доставку
+	// (POST /api/v1/tracking/{trackingToken}/rating)
+	RateOrder(ctx context.Context, request RateOrderRequestObject) (RateOrderResponseObject, error)
+	// Оставить чаевые курьеру
+	// (POST /api/v1/tracking/{trackingToken}/tip)
+	TipOrder(ctx context.Context, request TipOrderRequestObject) (TipOrderResponseObject, error)
+	// Получить ссылку для загрузки файла
+	// (POST /api/v1/uploads)
+	IssueBlobUpload(ctx context.Context, request IssueBlobUploadRequestObject) (IssueBlobUploadResponseObject, error)
+	// Получить ленту изменений
+	// (GET /api/v1/sync/changes)
+	GetChanges(ctx context.Context, request GetChangesRequestObject) (GetChangesResponseObject, error)
+	// Получить ленту доступности курьеров
+	// (GET /api/v1/sync/courier-availability)
+	GetCourierAvailabilityFeed(ctx context.Context, request GetCourierAvailabilityFeedRequestObject) (GetCourierAvailabilityFeedResponseObject, error)
+	// Получить потребление API клиентами
+	// (GET /api/v1/admin/api-usage)
+	GetAPIUsage(ctx context.Context, request GetAPIUsageRequestObject) (GetAPIUsageResponseObject, error)
+	// Получить свое потребление API
+	// (GET /api/v1/usage)
+	GetOwnAPIUsage(ctx context.Context, request GetOwnAPIUsageRequestObject) (GetOwnAPIUsageResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetAnnouncements operation middleware
+func (sh *strictHandler) GetAnnouncements(ctx echo.Context) error {
+	var request GetAnnouncementsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAnnouncements(ctx.Request().Context(), request.(GetAnnouncementsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAnnouncements")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAnnouncementsResponseObject); ok {
+		return validResponse.VisitGetAnnouncementsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// BroadcastAnnouncement operation middleware
+func (sh *strictHandler) BroadcastAnnouncement(ctx echo.Context) error {
+	var request BroadcastAnnouncementRequestObject
+
+	var body BroadcastAnnouncementJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.BroadcastAnnouncement(ctx.Request().Context(), request.(BroadcastAnnouncementRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BroadcastAnnouncement")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(BroadcastAnnouncementResponseObject); ok {
+		return validResponse.VisitBroadcastAnnouncementResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetBadgeRules operation middleware
+func (sh *strictHandler) GetBadgeRules(ctx echo.Context) error {
+	var request GetBadgeRulesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBadgeRules(ctx.Request().Context(), request.(GetBadgeRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBadgeRules")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetBadgeRulesResponseObject); ok {
+		return validResponse.VisitGetBadgeRulesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetDeviceHealth operation middleware
+func (sh *strictHandler) GetDeviceHealth(ctx echo.Context) error {
+	var request GetDeviceHealthRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDeviceHealth(ctx.Request().Context(), request.(GetDeviceHealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDeviceHealth")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetDeviceHealthResponseObject); ok {
+		return validResponse.VisitGetDeviceHealthResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCourierWorkingHours operation middleware
+func (sh *strictHandler) GetCourierWorkingHours(ctx echo.Context) error {
+	var request GetCourierWorkingHoursRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCourierWorkingHours(ctx.Request().Context(), request.(GetCourierWorkingHoursRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCourierWorkingHours")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCourierWorkingHoursResponseObject); ok {
+		return validResponse.VisitGetCourierWorkingHoursResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PlanCourierAbsence operation middleware
+func (sh *strictHandler) PlanCourierAbsence(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request PlanCourierAbsenceRequestObject
+
+	request.CourierId = courierId
+
+	var body PlanCourierAbsenceJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PlanCourierAbsence(ctx.Request().Context(), request.(PlanCourierAbsenceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PlanCourierAbsence")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PlanCourierAbsenceResponseObject); ok {
+		return validResponse.VisitPlanCourierAbsenceResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CancelCourierAbsence operation middleware
+func (sh *strictHandler) CancelCourierAbsence(ctx echo.Context, courierId openapi_types.UUID, absenceId openapi_types.UUID) error {
+	var request CancelCourierAbsenceRequestObject
+
+	request.CourierId = courierId
+	request.AbsenceId = absenceId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelCourierAbsence(ctx.Request().Context(), request.(CancelCourierAbsenceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelCourierAbsence")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CancelCourierAbsenceResponseObject); ok {
+		return validResponse.VisitCancelCourierAbsenceResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeactivateCourier operation middleware
+func (sh *strictHandler) DeactivateCourier(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request DeactivateCourierRequestObject
+
+	request.CourierId = courierId
+
+	var body DeactivateCourierJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeactivateCourier(ctx.Request().Context(), request.(DeactivateCourierRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeactivateCourier")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeactivateCourierResponseObject); ok {
+		return validResponse.VisitDeactivateCourierResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SetCourierInsurance operation middleware
+func (sh *strictHandler) SetCourierInsurance(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request SetCourierInsuranceRequestObject
+
+	request.CourierId = courierId
+
+	var body SetCourierInsuranceJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SetCourierInsurance(ctx.Request().Context(), request.(SetCourierInsuranceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetCourierInsurance")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetCourierInsuranceResponseObject); ok {
+		return validResponse.VisitSetCourierInsuranceResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCourierMaintenanceWindows operation middleware
+func (sh *strictHandler) GetCourierMaintenanceWindows(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request GetCourierMaintenanceWindowsRequestObject
+
+	request.CourierId = courierId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCourierMaintenanceWindows(ctx.Request().Context(), request.(GetCourierMaintenanceWindowsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCourierMaintenanceWindows")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCourierMaintenanceWindowsResponseObject); ok {
+		return validResponse.VisitGetCourierMaintenanceWindowsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ScheduleCourierMaintenance operation middleware
+func (sh *strictHandler) ScheduleCourierMaintenance(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request ScheduleCourierMaintenanceRequestObject
+
+	request.CourierId = courierId
+
+	var body ScheduleCourierMaintenanceJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ScheduleCourierMaintenance(ctx.Request().Context(), request.(ScheduleCourierMaintenanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ScheduleCourierMaintenance")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ScheduleCourierMaintenanceResponseObject); ok {
+		return validResponse.VisitScheduleCourierMaintenanceResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CancelCourierMaintenance operation middleware
+func (sh *strictHandler) CancelCourierMaintenance(ctx echo.Context, courierId openapi_types.UUID, windowId openapi_types.UUID) error {
+	var request CancelCourierMaintenanceRequestObject
+
+	request.CourierId = courierId
+	request.WindowId = windowId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelCourierMaintenance(ctx.Request().Context(), request.(CancelCourierMaintenanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelCourierMaintenance")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CancelCourierMaintenanceResponseObject); ok {
+		return validResponse.VisitCancelCourierMaintenanceResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RescheduleCourierMaintenance operation middleware
+func (sh *strictHandler) RescheduleCourierMaintenance(ctx echo.Context, courierId openapi_types.UUID, windowId openapi_types.UUID) error {
+	var request RescheduleCourierMaintenanceRequestObject
+
+	request.CourierId = courierId
+	request.WindowId = windowId
+
+	var body RescheduleCourierMaintenanceJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RescheduleCourierMaintenance(ctx.Request().Context(), request.(RescheduleCourierMaintenanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RescheduleCourierMaintenance")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RescheduleCourierMaintenanceResponseObject); ok {
+		return validResponse.VisitRescheduleCourierMaintenanceResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// MergeCouriers operation middleware
+func (sh *strictHandler) MergeCouriers(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request MergeCouriersRequestObject
+
+	request.CourierId = courierId
+
+	var body MergeCouriersJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MergeCouriers(ctx.Request().Context(), request.(MergeCouriersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MergeCouriers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(MergeCouriersResponseObject); ok {
+		return validResponse.VisitMergeCouriersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UpdateCourierProfile operation middleware
+func (sh *strictHandler) UpdateCourierProfile(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request UpdateCourierProfileRequestObject
+
+	request.CourierId = courierId
+
+	var body UpdateCourierProfileJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCourierProfile(ctx.Request().Context(), request.(UpdateCourierProfileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCourierProfile")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UpdateCourierProfileResponseObject); ok {
+		return validResponse.VisitUpdateCourierProfileResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ClearCourierReviewFlag operation middleware
+func (sh *strictHandler) ClearCourierReviewFlag(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request ClearCourierReviewFlagRequestObject
+
+	request.CourierId = courierId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ClearCourierReviewFlag(ctx.Request().Context(), request.(ClearCourierReviewFlagRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ClearCourierReviewFlag")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ClearCourierReviewFlagResponseObject); ok {
+		return validResponse.VisitClearCourierReviewFlagResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ClearCourierSchedule operation middleware
+func (sh *strictHandler) ClearCourierSchedule(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request ClearCourierScheduleRequestObject
+
+	request.CourierId = courierId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ClearCourierSchedule(ctx.Request().Context(), request.(ClearCourierScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ClearCourierSchedule")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ClearCourierScheduleResponseObject); ok {
+		return validResponse.VisitClearCourierScheduleResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SetCourierSchedule operation middleware
+func (sh *strictHandler) SetCourierSchedule(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request SetCourierScheduleRequestObject
+
+	request.CourierId = courierId
+
+	var body SetCourierScheduleJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SetCourierSchedule(ctx.Request().Context(), request.(SetCourierScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetCourierSchedule")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetCourierScheduleResponseObject); ok {
+		return validResponse.VisitSetCourierScheduleResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SetStoragePlaceMaintenance operation middleware
+func (sh *strictHandler) SetStoragePlaceMaintenance(ctx echo.Context, courierId openapi_types.UUID, storagePlaceId openapi_types.UUID) error {
+	var request SetStoragePlaceMaintenanceRequestObject
+
+	request.CourierId = courierId
+	request.StoragePlaceId = storagePlaceId
+
+	var body SetStoragePlaceMaintenanceJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SetStoragePlaceMaintenance(ctx.Request().Context(), request.(SetStoragePlaceMaintenanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetStoragePlaceMaintenance")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetStoragePlaceMaintenanceResponseObject); ok {
+		return validResponse.VisitSetStoragePlaceMaintenanceResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetMatchingRules operation middleware
+func (sh *strictHandler) GetMatchingRules(ctx echo.Context) error {
+	var request GetMatchingRulesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMatchingRules(ctx.Request().Context(), request.(GetMatchingRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMatchingRules")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetMatchingRulesResponseObject); ok {
+		return validResponse.VisitGetMatchingRulesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ReplaceMatchingRules operation middleware
+func (sh *strictHandler) ReplaceMatchingRules(ctx echo.Context) error {
+	var request ReplaceMatchingRulesRequestObject
+
+	var body ReplaceMatchingRulesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReplaceMatchingRules(ctx.Request().Context(), request.(ReplaceMatchingRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReplaceMatchingRules")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ReplaceMatchingRulesResponseObject); ok {
+		return validResponse.VisitReplaceMatchingRulesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AnalyzeFleetWhatIf operation middleware
+func (sh *strictHandler) AnalyzeFleetWhatIf(ctx echo.Context) error {
+	var request AnalyzeFleetWhatIfRequestObject
+
+	var body AnalyzeFleetWhatIfJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AnalyzeFleetWhatIf(ctx.Request().Context(), request.(AnalyzeFleetWhatIfRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AnalyzeFleetWhatIf")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AnalyzeFleetWhatIfResponseObject); ok {
+		return validResponse.VisitAnalyzeFleetWhatIfResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetMicrozones operation middleware
+func (sh *strictHandler) GetMicrozones(ctx echo.Context) error {
+	var request GetMicrozonesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMicrozones(ctx.Request().Context(), request.(GetMicrozonesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMicrozones")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetMicrozonesResponseObject); ok {
+		return validResponse.VisitGetMicrozonesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RecomputeMicrozones operation middleware
+func (sh *strictHandler) RecomputeMicrozones(ctx echo.Context) error {
+	var request RecomputeMicrozonesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RecomputeMicrozones(ctx.Request().Context(), request.(RecomputeMicrozonesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RecomputeMicrozones")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RecomputeMicrozonesResponseObject); ok {
+		return validResponse.VisitRecomputeMicrozonesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOrderReviewQueue operation middleware
+func (sh *strictHandler) GetOrderReviewQueue(ctx echo.Context) error {
+	var request GetOrderReviewQueueRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrderReviewQueue(ctx.Request().Context(), request.(GetOrderReviewQueueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrderReviewQueue")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetOrderReviewQueueResponseObject); ok {
+		return validResponse.VisitGetOrderReviewQueueResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ApproveOrderReview operation middleware
+func (sh *strictHandler) ApproveOrderReview(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request ApproveOrderReviewRequestObject
+
+	request.OrderId = orderId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ApproveOrderReview(ctx.Request().Context(), request.(ApproveOrderReviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApproveOrderReview")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ApproveOrderReviewResponseObject); ok {
+		return validResponse.VisitApproveOrderReviewResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetPayoutExport operation middleware
+func (sh *strictHandler) GetPayoutExport(ctx echo.Context, params GetPayoutExportParams) error {
+	var request GetPayoutExportRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPayoutExport(ctx.Request().Context(), request.(GetPayoutExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPayoutExport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetPayoutExportResponseObject); ok {
+		return validResponse.VisitGetPayoutExportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ErasePersonalData operation middleware
+func (sh *strictHandler) ErasePersonalData(ctx echo.Context) error {
+	var request ErasePersonalDataRequestObject
+
+	var body ErasePersonalDataJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ErasePersonalData(ctx.Request().Context(), request.(ErasePersonalDataRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ErasePersonalData")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ErasePersonalDataResponseObject); ok {
+		return validResponse.VisitErasePersonalDataResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetPickupSlots operation middleware
+func (sh *strictHandler) GetPickupSlots(ctx echo.Context) error {
+	var request GetPickupSlotsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPickupSlots(ctx.Request().Context(), request.(GetPickupSlotsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPickupSlots")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetPickupSlotsResponseObject); ok {
+		return validResponse.VisitGetPickupSlotsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreatePickupSlot operation middleware
+func (sh *strictHandler) CreatePickupSlot(ctx echo.Context) error {
+	var request CreatePickupSlotRequestObject
+
+	var body CreatePickupSlotJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePickupSlot(ctx.Request().Context(), request.(CreatePickupSlotRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePickupSlot")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreatePickupSlotResponseObject); ok {
+		return validResponse.VisitCreatePickupSlotResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ChangePickupSlotCapacity operation middleware
+func (sh *strictHandler) ChangePickupSlotCapacity(ctx echo.Context, slotId openapi_types.UUID) error {
+	var request ChangePickupSlotCapacityRequestObject
+
+	request.SlotId = slotId
+
+	var body ChangePickupSlotCapacityJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ChangePickupSlotCapacity(ctx.Request().Context(), request.(ChangePickupSlotCapacityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ChangePickupSlotCapacity")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ChangePickupSlotCapacityResponseObject); ok {
+		return validResponse.VisitChangePickupSlotCapacityResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SetRolloutPercentage operation middleware
+func (sh *strictHandler) SetRolloutPercentage(ctx echo.Context, flag string) error {
+	var request SetRolloutPercentageRequestObject
+
+	request.Flag = flag
+
+	var body SetRolloutPercentageJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SetRolloutPercentage(ctx.Request().Context(), request.(SetRolloutPercentageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetRolloutPercentage")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetRolloutPercentageResponseObject); ok {
+		return validResponse.VisitSetRolloutPercentageResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ComputeSLACompliance operation middleware
+func (sh *strictHandler) ComputeSLACompliance(ctx echo.Context) error {
+	var request ComputeSLAComplianceRequestObject
+
+	var body ComputeSLAComplianceJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ComputeSLACompliance(ctx.Request().Context(), request.(ComputeSLAComplianceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ComputeSLACompliance")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ComputeSLAComplianceResponseObject); ok {
+		return validResponse.VisitComputeSLAComplianceResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetSLATargets operation middleware
+func (sh *strictHandler) GetSLATargets(ctx echo.Context) error {
+	var request GetSLATargetsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSLATargets(ctx.Request().Context(), request.(GetSLATargetsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSLATargets")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetSLATargetsResponseObject); ok {
+		return validResponse.VisitGetSLATargetsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ReplaceSLATargets operation middleware
+func (sh *strictHandler) ReplaceSLATargets(ctx echo.Context) error {
+	var request ReplaceSLATargetsRequestObject
+
+	var body ReplaceSLATargetsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReplaceSLATargets(ctx.Request().Context(), request.(ReplaceSLATargetsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReplaceSLATargets")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ReplaceSLATargetsResponseObject); ok {
+		return validResponse.VisitReplaceSLATargetsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetSurgeMode operation middleware
+func (sh *strictHandler) GetSurgeMode(ctx echo.Context) error {
+	var request GetSurgeModeRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSurgeMode(ctx.Request().Context(), request.(GetSurgeModeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSurgeMode")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetSurgeModeResponseObject); ok {
+		return validResponse.VisitGetSurgeModeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ChangeSurgeMode operation middleware
+func (sh *strictHandler) ChangeSurgeMode(ctx echo.Context) error {
+	var request ChangeSurgeModeRequestObject
+
+	var body ChangeSurgeModeJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ChangeSurgeMode(ctx.Request().Context(), request.(ChangeSurgeModeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ChangeSurgeMode")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ChangeSurgeModeResponseObject); ok {
+		return validResponse.VisitChangeSurgeModeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PurgeSyntheticData operation middleware
+func (sh *strictHandler) PurgeSyntheticData(ctx echo.Context) error {
+	var request PurgeSyntheticDataRequestObject
+
+	var body PurgeSyntheticDataJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PurgeSyntheticData(ctx.Request().Context(), request.(PurgeSyntheticDataRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PurgeSyntheticData")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PurgeSyntheticDataResponseObject); ok {
+		return validResponse.VisitPurgeSyntheticDataResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCouriers operation middleware
+func (sh *strictHandler) GetCouriers(ctx echo.Context, params GetCouriersParams) error {
+	var request GetCouriersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCouriers(ctx.Request().Context(), request.(GetCouriersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCouriers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCouriersResponseObject); ok {
+		return validResponse.VisitGetCouriersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateCourier operation middleware
+func (sh *strictHandler) CreateCourier(ctx echo.Context) error {
+	var request CreateCourierRequestObject
+
+	var body CreateCourierJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCourier(ctx.Request().Context(), request.(CreateCourierRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCourier")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreateCourierResponseObject); ok {
+		return validResponse.VisitCreateCourierResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ImportCouriers operation middleware
+func (sh *strictHandler) ImportCouriers(ctx echo.Context) error {
+	var request ImportCouriersRequestObject
+
+	if strings.HasPrefix(ctx.Request().Header.Get("Content-Type"), "application/json") {
+
+		var body ImportCouriersJSONRequestBody
+		if err := ctx.Bind(&body); err != nil {
+			return err
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(ctx.Request().Header.Get("Content-Type"), "text/csv") {
+		request.Body = ctx.Request().Body
+	}
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportCouriers(ctx.Request().Context(), request.(ImportCouriersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportCouriers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ImportCouriersResponseObject); ok {
+		return validResponse.VisitImportCouriersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RecordDeviceTelemetry operation middleware
+func (sh *strictHandler) RecordDeviceTelemetry(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request RecordDeviceTelemetryRequestObject
+
+	request.CourierId = courierId
+
+	var body RecordDeviceTelemetryJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RecordDeviceTelemetry(ctx.Request().Context(), request.(RecordDeviceTelemetryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RecordDeviceTelemetry")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RecordDeviceTelemetryResponseObject); ok {
+		return validResponse.VisitRecordDeviceTelemetryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UpdateCourierLocation operation middleware
+func (sh *strictHandler) UpdateCourierLocation(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request UpdateCourierLocationRequestObject
+
+	request.CourierId = courierId
+
+	var body UpdateCourierLocationJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCourierLocation(ctx.Request().Context(), request.(UpdateCourierLocationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCourierLocation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UpdateCourierLocationResponseObject); ok {
+		return validResponse.VisitUpdateCourierLocationResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ImportCourierLocations operation middleware
+func (sh *strictHandler) ImportCourierLocations(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request ImportCourierLocationsRequestObject
+
+	request.CourierId = courierId
+
+	var body ImportCourierLocationsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportCourierLocations(ctx.Request().Context(), request.(ImportCourierLocationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportCourierLocations")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ImportCourierLocationsResponseObject); ok {
+		return validResponse.VisitImportCourierLocationsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SetCourierBreak operation middleware
+func (sh *strictHandler) SetCourierBreak(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request SetCourierBreakRequestObject
+
+	request.CourierId = courierId
+
+	var body SetCourierBreakJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SetCourierBreak(ctx.Request().Context(), request.(SetCourierBreakRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetCourierBreak")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetCourierBreakResponseObject); ok {
+		return validResponse.VisitSetCourierBreakResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SetCourierShift operation middleware
+func (sh *strictHandler) SetCourierShift(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request SetCourierShiftRequestObject
+
+	request.CourierId = courierId
+
+	var body SetCourierShiftJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SetCourierShift(ctx.Request().Context(), request.(SetCourierShiftRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetCourierShift")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetCourierShiftResponseObject); ok {
+		return validResponse.VisitSetCourierShiftResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCourierStats operation middleware
+func (sh *strictHandler) GetCourierStats(ctx echo.Context, courierId openapi_types.UUID) error {
+	var request GetCourierStatsRequestObject
+
+	request.CourierId = courierId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCourierStats(ctx.Request().Context(), request.(GetCourierStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCourierStats")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCourierStatsResponseObject); ok {
+		return validResponse.VisitGetCourierStatsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListOrders operation middleware
+func (sh *strictHandler) ListOrders(ctx echo.Context, params ListOrdersParams) error {
+	var request ListOrdersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOrders(ctx.Request().Context(), request.(ListOrdersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOrders")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListOrdersResponseObject); ok {
+		return validResponse.VisitListOrdersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateOrder operation middleware
+func (sh *strictHandler) CreateOrder(ctx echo.Context) error {
+	var request CreateOrderRequestObject
+
+	var body CreateOrderJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateOrder(ctx.Request().Context(), request.(CreateOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateOrder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreateOrderResponseObject); ok {
+		return validResponse.VisitCreateOrderResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOrders operation middleware
+func (sh *strictHandler) GetOrders(ctx echo.Context, params GetOrdersParams) error {
+	var request GetOrdersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrders(ctx.Request().Context(), request.(GetOrdersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrders")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetOrdersResponseObject); ok {
+		return validResponse.VisitGetOrdersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOrderByExternalReference operation middleware
+func (sh *strictHandler) GetOrderByExternalReference(ctx echo.Context, marketplace string, externalId string) error {
+	var request GetOrderByExternalReferenceRequestObject
+
+	request.Marketplace = marketplace
+	request.ExternalId = externalId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrderByExternalReference(ctx.Request().Context(), request.(GetOrderByExternalReferenceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrderByExternalReference")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetOrderByExternalReferenceResponseObject); ok {
+		return validResponse.VisitGetOrderByExternalReferenceResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// BulkCancelOrders operation middleware
+func (sh *strictHandler) BulkCancelOrders(ctx echo.Context) error {
+	var request BulkCancelOrdersRequestObject
+
+	var body BulkCancelOrdersJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.BulkCancelOrders(ctx.Request().Context(), request.(BulkCancelOrdersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BulkCancelOrders")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(BulkCancelOrdersResponseObject); ok {
+		return validResponse.VisitBulkCancelOrdersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UploadOrders operation middleware
+func (sh *strictHandler) UploadOrders(ctx echo.Context) error {
+	var request UploadOrdersRequestObject
+
+	if reader, err := ctx.Request().MultipartReader(); err != nil {
+		return err
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UploadOrders(ctx.Request().Context(), request.(UploadOrdersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UploadOrders")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UploadOrdersResponseObject); ok {
+		return validResponse.VisitUploadOrdersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOrder operation middleware
+func (sh *strictHandler) GetOrder(ctx echo.Context, orderId openapi_types.UUID, params GetOrderParams) error {
+	var request GetOrderRequestObject
+
+	request.OrderId = orderId
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrder(ctx.Request().Context(), request.(GetOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetOrderResponseObject); ok {
+		return validResponse.VisitGetOrderResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CancelOrder operation middleware
+func (sh *strictHandler) CancelOrder(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request CancelOrderRequestObject
+
+	request.OrderId = orderId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelOrder(ctx.Request().Context(), request.(CancelOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelOrder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CancelOrderResponseObject); ok {
+		return validResponse.VisitCancelOrderResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAssignmentExplanation operation middleware
+func (sh *strictHandler) GetAssignmentExplanation(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request GetAssignmentExplanationRequestObject
+
+	request.OrderId = orderId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAssignmentExplanation(ctx.Request().Context(), request.(GetAssignmentExplanationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAssignmentExplanation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAssignmentExplanationResponseObject); ok {
+		return validResponse.VisitGetAssignmentExplanationResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOrderEta operation middleware
+func (sh *strictHandler) GetOrderEta(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request GetOrderEtaRequestObject
+
+	request.OrderId = orderId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrderEta(ctx.Request().Context(), request.(GetOrderEtaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrderEta")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetOrderEtaResponseObject); ok {
+		return validResponse.VisitGetOrderEtaResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ConfirmOrderHandover operation middleware
+func (sh *strictHandler) ConfirmOrderHandover(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request ConfirmOrderHandoverRequestObject
+
+	request.OrderId = orderId
+
+	var body ConfirmOrderHandoverJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ConfirmOrderHandover(ctx.Request().Context(), request.(ConfirmOrderHandoverRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ConfirmOrderHandover")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ConfirmOrderHandoverResponseObject); ok {
+		return validResponse.VisitConfirmOrderHandoverResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOrderHistory operation middleware
+func (sh *strictHandler) GetOrderHistory(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request GetOrderHistoryRequestObject
+
+	request.OrderId = orderId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrderHistory(ctx.Request().Context(), request.(GetOrderHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrderHistory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetOrderHistoryResponseObject); ok {
+		return validResponse.VisitGetOrderHistoryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOrderMessages operation middleware
+func (sh *strictHandler) GetOrderMessages(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request GetOrderMessagesRequestObject
+
+	request.OrderId = orderId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrderMessages(ctx.Request().Context(), request.(GetOrderMessagesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrderMessages")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetOrderMessagesResponseObject); ok {
+		return validResponse.VisitGetOrderMessagesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostOrderMessage operation middleware
+func (sh *strictHandler) PostOrderMessage(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request PostOrderMessageRequestObject
+
+	request.OrderId = orderId
+
+	var body PostOrderMessageJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrderMessage(ctx.Request().Context(), request.(PostOrderMessageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrderMessage")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostOrderMessageResponseObject); ok {
+		return validResponse.VisitPostOrderMessageResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RecalculateOrderEta operation middleware
+func (sh *strictHandler) RecalculateOrderEta(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request RecalculateOrderEtaRequestObject
+
+	request.OrderId = orderId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RecalculateOrderEta(ctx.Request().Context(), request.(RecalculateOrderEtaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RecalculateOrderEta")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RecalculateOrderEtaResponseObject); ok {
+		return validResponse.VisitRecalculateOrderEtaResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ShareOrderTracking operation middleware
+func (sh *strictHandler) ShareOrderTracking(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request ShareOrderTrackingRequestObject
+
+	request.OrderId = orderId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ShareOrderTracking(ctx.Request().Context(), request.(ShareOrderTrackingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ShareOrderTracking")
+	}
+
+	response, err := handler(ctx, request)
 
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ShareOrderTrackingResponseObject); ok {
+		return validResponse.VisitShareOrderTrackingResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetCouriersRequestObject struct {
-}
+// TransferOrder operation middleware
+func (sh *strictHandler) TransferOrder(ctx echo.Context, orderId openapi_types.UUID) error {
+	var request TransferOrderRequestObject
 
-type GetCouriersResponseObject interface {
-	VisitGetCouriersResponse(w http.ResponseWriter) error
-}
+	request.OrderId = orderId
 
-type GetCouriers200JSONResponse []Courier
+	var body TransferOrderJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-func (response GetCouriers200JSONResponse) VisitGetCouriersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TransferOrder(ctx.Request().Context(), request.(TransferOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TransferOrder")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type GetCouriersdefaultJSONResponse struct {
-	Body       Error
-	StatusCode int
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TransferOrderResponseObject); ok {
+		return validResponse.VisitTransferOrderResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-func (response GetCouriersdefaultJSONResponse) VisitGetCouriersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(response.StatusCode)
+// ReceivePaymentEvent operation middleware
+func (sh *strictHandler) ReceivePaymentEvent(ctx echo.Context, params ReceivePaymentEventParams) error {
+	var request ReceivePaymentEventRequestObject
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	request.Params = params
 
-type CreateCourierRequestObject struct {
-	Body *CreateCourierJSONRequestBody
-}
+	var body ReceivePaymentEventJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type CreateCourierResponseObject interface {
-	VisitCreateCourierResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReceivePaymentEvent(ctx.Request().Context(), request.(ReceivePaymentEventRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReceivePaymentEvent")
+	}
 
-type CreateCourier201Response struct {
-}
+	response, err := handler(ctx, request)
 
-func (response CreateCourier201Response) VisitCreateCourierResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ReceivePaymentEventResponseObject); ok {
+		return validResponse.VisitReceivePaymentEventResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type CreateCourier400JSONResponse Error
-
-func (response CreateCourier400JSONResponse) VisitCreateCourierResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetSLAReport operation middleware
+func (sh *strictHandler) GetSLAReport(ctx echo.Context, params GetSLAReportParams) error {
+	var request GetSLAReportRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type CreateCourier409JSONResponse Error
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSLAReport(ctx.Request().Context(), request.(GetSLAReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSLAReport")
+	}
 
-func (response CreateCourier409JSONResponse) VisitCreateCourierResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetSLAReportResponseObject); ok {
+		return validResponse.VisitGetSLAReportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type CreateCourierdefaultJSONResponse struct {
-	Body       Error
-	StatusCode int
-}
+// GetSLOStatus operation middleware
+func (sh *strictHandler) GetSLOStatus(ctx echo.Context) error {
+	var request GetSLOStatusRequestObject
 
-func (response CreateCourierdefaultJSONResponse) VisitCreateCourierResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(response.StatusCode)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSLOStatus(ctx.Request().Context(), request.(GetSLOStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSLOStatus")
+	}
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	response, err := handler(ctx, request)
 
-type CreateOrderRequestObject struct {
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetSLOStatusResponseObject); ok {
+		return validResponse.VisitGetSLOStatusResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type CreateOrderResponseObject interface {
-	VisitCreateOrderResponse(w http.ResponseWriter) error
-}
+// GetSharedTracking operation middleware
+func (sh *strictHandler) GetSharedTracking(ctx echo.Context, trackingToken string) error {
+	var request GetSharedTrackingRequestObject
 
-type CreateOrder201Response struct {
-}
+	request.TrackingToken = trackingToken
 
-func (response CreateOrder201Response) VisitCreateOrderResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSharedTracking(ctx.Request().Context(), request.(GetSharedTrackingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSharedTracking")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetSharedTrackingResponseObject); ok {
+		return validResponse.VisitGetSharedTrackingResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type CreateOrderdefaultJSONResponse struct {
-	Body       Error
-	StatusCode int
-}
+// RateOrder operation middleware
+func (sh *strictHandler) RateOrder(ctx echo.Context, trackingToken string) error {
+	var request RateOrderRequestObject
 
-func (response CreateOrderdefaultJSONResponse) VisitCreateOrderResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(response.StatusCode)
+	request.TrackingToken = trackingToken
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	var body RateOrderJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type GetOrdersRequestObject struct {
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RateOrder(ctx.Request().Context(), request.(RateOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RateOrder")
+	}
 
-type GetOrdersResponseObject interface {
-	VisitGetOrdersResponse(w http.ResponseWriter) error
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RateOrderResponseObject); ok {
+		return validResponse.VisitRateOrderResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetOrders200JSONResponse []Order
+// TipOrder operation middleware
+func (sh *strictHandler) TipOrder(ctx echo.Context, trackingToken string, params TipOrderParams) error {
+	var request TipOrderRequestObject
 
-func (response GetOrders200JSONResponse) VisitGetOrdersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.TrackingToken = trackingToken
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body TipOrderJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type GetOrdersdefaultJSONResponse struct {
-	Body       Error
-	StatusCode int
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TipOrder(ctx.Request().Context(), request.(TipOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TipOrder")
+	}
 
-func (response GetOrdersdefaultJSONResponse) VisitGetOrdersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(response.StatusCode)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response.Body)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TipOrderResponseObject); ok {
+		return validResponse.VisitTipOrderResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Получить всех курьеров
-	// (GET /api/v1/couriers)
-	GetCouriers(ctx context.Context, request GetCouriersRequestObject) (GetCouriersResponseObject, error)
-	// Добавить курьера
-	// (POST /api/v1/couriers)
-	CreateCourier(ctx context.Context, request CreateCourierRequestObject) (CreateCourierResponseObject, error)
-	// Создать заказ
-	// (POST /api/v1/orders)
-	CreateOrder(ctx context.Context, request CreateOrderRequestObject) (CreateOrderResponseObject, error)
-	// Получить все незавершенные заказы
-	// (GET /api/v1/orders/active)
-	GetOrders(ctx context.Context, request GetOrdersRequestObject) (GetOrdersResponseObject, error)
-}
+// IssueBlobUpload operation middleware
+func (sh *strictHandler) IssueBlobUpload(ctx echo.Context) error {
+	var request IssueBlobUploadRequestObject
 
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+	var body IssueBlobUploadJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.IssueBlobUpload(ctx.Request().Context(), request.(IssueBlobUploadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "IssueBlobUpload")
+	}
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(IssueBlobUploadResponseObject); ok {
+		return validResponse.VisitIssueBlobUploadResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// GetCouriers operation middleware
-func (sh *strictHandler) GetCouriers(ctx echo.Context) error {
-	var request GetCouriersRequestObject
+// GetChanges operation middleware
+func (sh *strictHandler) GetChanges(ctx echo.Context, params GetChangesParams) error {
+	var request GetChangesRequestObject
+
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCouriers(ctx.Request().Context(), request.(GetCouriersRequestObject))
+		return sh.ssi.GetChanges(ctx.Request().Context(), request.(GetChangesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCouriers")
+		handler = middleware(handler, "GetChanges")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetCouriersResponseObject); ok {
-		return validResponse.VisitGetCouriersResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetChangesResponseObject); ok {
+		return validResponse.VisitGetChangesResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// CreateCourier operation middleware
-func (sh *strictHandler) CreateCourier(ctx echo.Context) error {
-	var request CreateCourierRequestObject
+// GetCourierAvailabilityFeed operation middleware
+func (sh *strictHandler) GetCourierAvailabilityFeed(ctx echo.Context, params GetCourierAvailabilityFeedParams) error {
+	var request GetCourierAvailabilityFeedRequestObject
 
-	var body CreateCourierJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateCourier(ctx.Request().Context(), request.(CreateCourierRequestObject))
+		return sh.ssi.GetCourierAvailabilityFeed(ctx.Request().Context(), request.(GetCourierAvailabilityFeedRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateCourier")
+		handler = middleware(handler, "GetCourierAvailabilityFeed")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(CreateCourierResponseObject); ok {
-		return validResponse.VisitCreateCourierResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetCourierAvailabilityFeedResponseObject); ok {
+		return validResponse.VisitGetCourierAvailabilityFeedResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// CreateOrder operation middleware
-func (sh *strictHandler) CreateOrder(ctx echo.Context) error {
-	var request CreateOrderRequestObject
+// GetAPIUsage operation middleware
+func (sh *strictHandler) GetAPIUsage(ctx echo.Context, params GetAPIUsageParams) error {
+	var request GetAPIUsageRequestObject
+
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateOrder(ctx.Request().Context(), request.(CreateOrderRequestObject))
+		return sh.ssi.GetAPIUsage(ctx.Request().Context(), request.(GetAPIUsageRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateOrder")
+		handler = middleware(handler, "GetAPIUsage")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(CreateOrderResponseObject); ok {
-		return validResponse.VisitCreateOrderResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetAPIUsageResponseObject); ok {
+		return validResponse.VisitGetAPIUsageResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetOrders operation middleware
-func (sh *strictHandler) GetOrders(ctx echo.Context) error {
-	var request GetOrdersRequestObject
+// GetOwnAPIUsage operation middleware
+func (sh *strictHandler) GetOwnAPIUsage(ctx echo.Context, params GetOwnAPIUsageParams) error {
+	var request GetOwnAPIUsageRequestObject
+
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetOrders(ctx.Request().Context(), request.(GetOrdersRequestObject))
+		return sh.ssi.GetOwnAPIUsage(ctx.Request().Context(), request.(GetOwnAPIUsageRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetOrders")
+		handler = middleware(handler, "GetOwnAPIUsage")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetOrdersResponseObject); ok {
-		return validResponse.VisitGetOrdersResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetOwnAPIUsageResponseObject); ok {
+		return validResponse.VisitGetOwnAPIUsageResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
@@ -418,21 +9355,444 @@ func (sh *strictHandler) GetOrders(ctx echo.Context) error {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xVT28bRRT/KqsHx1Vtt1zYIwEhpIgeuIAQh8V+caby7iyz47RWtFLsQBspUXPhgHoo",
-	"KnyBjcnKi0Pcr/DeN0IzEyduPHFcEVURF8u7O/Pm9++92YW2TDKZYqpziHYhb29jEtu/G7KvBCrzN1My",
-	"Q6UF2g+iY347mLeVyLSQKURAv9EpVXTOI6r5Z6ppSiWPaMZ7EMKWVEmsIYJ+X3QgBD3IECLItRJpF4oQ",
-	"erIdu0K78LHCLYjgo8YVsMYFqsbmfF0RQhon6MXxDx8vn1GEoPCnvlDYgeh7sDBshYXDf7jcJX98gm1t",
-	"TvlCKemRoC07vsNf0YxOA5rxAdV0QlOqF9mLVD96eAVNpBq7qMwpCeZ53PVV/IMqmvKQR9erruZn8V3V",
-	"9THbXND8XXLPlnF8a4qJVCT9BKKmj8JgedN3t2y6hvkZmCo+qF/j0xvDeEsMEpFuYtrV2xC1PMHLM0Rf",
-	"mt/Q1GSXZkZ6Plok0rqVyEWuXG0fn8eqc1/7ytcnKxrErBfplvQAf80jGlPFL6ikyuR3QmXA+/zCPU15",
-	"n/f4iCqjMo3DwPDkIb01n+2iParNHn5ONb80n60ZVNKYZjQN330z5X0jgNA9A++bp3G3iyr4HHtiB9UA",
-	"QthBlTtkrQfNB02jjswwjTMBETyyr0LIYr1trWjEmWjstBptFzv7rovaQ/N3mtHEQjrjY0ftrX0wTGsT",
-	"noDGPKSKf1kiDRaDsuJ+1YEIvkS9MT/RGJFnMs1dOB42m27wpBpTCyTOsp5wzjSe5M5kZ6gNk8Ykv833",
-	"eVcVl8bGSsUD5+s1on9emHNA53xIf5t55AwegV28Ffd7+r0grkLm5q4Px+vLMVjauOb9JInVYO7FesIX",
-	"IWQyX9PPU5rRiU3ZRdnFauWSiRsKY41zaV0/Ya4/k53BncmzMBF9Gr26AgjFUpBaHtqr3f3kPcP3n50N",
-	"aEwlnVFNp24CUO1wfPrBcfCha2g6N3OYaj4O6MSOpnMzsAI6oxn9ZSdzfW864dfVkTWr5yNOmsvIJmPt",
-	"juChfWWsscUnVBoQNAl4GPBzquiMj/hlwCOjkL26bNtR6QS8oWXctXgHcb0XFry5QSOP+I24rcUO3sEl",
-	"E9iUTqzzFe/xgc2s0ahagMCHvpvnsQvCh7h3nNP/61tnbSeKoij+DQAA//8JDQamhg0AAA==",
+	"H4sIAAAAAAACA+29bXNb15Eu+ldQulO3pDqgRcmyJ7HrfJAlOdaJZOuKcpycSca1SWySiECAgxfJistV",
+	"EmlZ9pUjnfj4VlK+jj2ezJ18OjUQREggRYJ/gfwL55fctbp7vffaewMEKdJmPsQiCey9Xnp19+p++umP",
+	"T8w1lpYb9bTebp144+MTrbnFdCmBf56/dvn9VrKQyn9X0tZcs7rcrjbqJ944sfP9znB3ZffuTn/nyc4L",
+	"8f9bO4Odfkl8obSzIX4xkL/aXdkZ7myWdp7vdEs7mzv93Xu7j3c/O1E+sdxsLKfNdjWFt8zVquLdzDu+",
+	"Ew/YFl97sNOFR22UZt45P3X2tdfle6bke3YfyT+6r+yKF7TvLItBn2i1m9X6wolPyieWGvX2IvOKb9Wo",
+	"Sju90u6nYlJ3xUjl6/ql34j/TV29yj2u0aykzdaFZpq004p87D8003nxif/jtFnL07SQp9UqXk3F9+fk",
+	"15vpv3TSFi73qN9spe3WeW61voLd2Nx9XBJL9UQsxaraGPkrtfwPxB8e7t6XS9aTWyhmN99oLiXiiScq",
+	"YjZT7epSyk35djq72GjcbF1o1FudpdFnTdOuNuVX/0ltutoZa2bW8vgLzYzid3qojdnfp3NtOVTv1UWF",
+	"VyzbmvjncOfpzrAkBE8I3E5XCq+UBiFrYhUHJfEv+LO1nuIDj/V6gvi58l2rLlXbGbIXPqJcmi5NlcTg",
+	"+jvP5bCeirF2YScf0GjXzRZV6+10IW2idCwl1brcMO4w3ZOPpoOk3rX78M0S/Pfe7ir8/8pOTwhOf3el",
+	"XJLDk+fKGlhJvLzPjajLjqfTQjnJXf4hoyT8x3kCBM8u0+KyUlCvNzr1uXSJlIu7KZW0Vr2VNtnx/V1M",
+	"S85cjGpNDBXWTawADhWPj1ijnvhxTSo4LUL8ptCb9HudV/1Azx/uPlZSaL9yA1e/u/MMX7W7ioL5QuzW",
+	"Ay2Yj8R7q+10KV+fWEtyEYd1Rw6RBp00mwn8PJ9Ua3krs4XT3+vqVLnX/EV8FZX5QOjkgVwBWKO7oNp2",
+	"/2+xWD2j3GwV1ulUK5z2Wk7rFf5cmCnxoy7Ldz4T/1oTg3i0+4X4+H04MjvbcAZgk9ipLTdaQmmd54++",
+	"fAdMsSSfIlbx3u5D8VJ8VjGN3E4/4p79b+K5G3JbYosVPOgPQkzyROe/y8/4ZxDXWg7Dmm3ZOltalMwO",
+	"OAci79xqIQ3O79xiUl8osLryuMD+9kG5k/YeCHUDn9AGUmlHcbDugTYrtgdzjY6YSPPyiFK8IV5zd/dL",
+	"oe3uui+Lya9cxk6TdcTEI6QWHkgtDMdSyLGU1Qdgy9YDhcI9vtVO2p2x1McMfjMw73pd9MPL1p4V3fcZ",
+	"PS5fb5rdYjQmI/fOmu+uitGk9c6SHGogmLbc/o5ZrPOtVnWhLod5IRFflfLByOeBCcZcu9GEVxYyATNz",
+	"jWb6NnyJ0/wt+WfWe/gMVnIDvG17kGV5dFbFadqUf+qBK94FJQoOdVd8GuYmf+Ecq0ZntmadKbEbs6g3",
+	"W2lNiARrf/4snyedMino0jfbAkEXIyvt/hGvG9JE+ltNr5htNGppUs8WVlgAaxBmiVmh1bJw6aPlWlJP",
+	"cKSBNChB4WT5G2u0D8vS3g9xyYRF6EufSHqk4If1dp4L52hl90twl3AlyvLmAlrurpD4NfHLvjYp8rvk",
+	"aSnlX8xPYCScEZYxZdzbOeNyjyz8qVzzar2IGfBf6vkNmUq+0KEoNqvSSRrSl7ufi43633e/LqEzJ388",
+	"VfB8tJtitAt3eLUIe78Chk7MsQyiYckUmARy3oVXg+dS/PRCukFSsOyz8zBcjUw9T+Myp8jeoLJ9Criz",
+	"9FZSWUh/Wa3zjmefIgnSmAt9Iz0yOe4+3Foel/Tay9vNVEkaRHTr5NdsAyH/ftK4IKekMyAPl/uNIW0t",
+	"3JV6pdfk86Vz+Hxnja4q6P+C+21uava3pPf4TOzxyXnxqilhBJtTt9P05inL9FiekNh552Os5YEVut6p",
+	"xfwAOT85myG37b5mdG4Sch2dNQxusTdpY7L0htlBMdh6ssSN869wTnp4e5T6Tb10g4/ctBebaWuxUauw",
+	"t6cNmMKX8r/hNtPOZm/mFgjTFn7/hS1JG+yt1jsAMMsyro49WFbAa43Z95drjaQSWghxUsQjcyI7Zcud",
+	"dTdXahrrEtEtQeDsLlynV9T2SnFdxzs+nnppSgprwcU0kbEYOb6kUqnKwSW1a84kgu8w5vup3DF4vXDV",
+	"QmtnbQfNAJxZsHnywjVA0/dUWnbxL2nt1OnznHq6um2B4dy9L6M70ngqcykeu4067wSzVaNeS90xDYoY",
+	"r5spp8G/waBmmcbors8melTrOy9Ku/d1BIYUYM/6HajzL3b6bCg0bS82mOm9c+PGtSmIwKzgm8M5Bc/q",
+	"NGt8fEetLgwHw1uueOqz5rxDz4/TBdzlUy4iDkNPzEhq2TpV2efxOkYcOTdenPt6+wZ81Z/o1ctXL01J",
+	"adjZdgeefpQsLddAbSwlC+np3y+nC2wY+XZ9dPdJe35yGUnDeR45Bvg81S9/3ALtoURGjblQAKXTFDf8",
+	"uEK3PCvpgOrVeKVERu7O1PJio92QtvlTHFp4cTv5365d+kW5dO3dX6iZfZDOXoPPlc5Ml4RHN9j50ynp",
+	"8dIloy814e5nMliql+XNEjklU5XGXEc6sfLP8kKyAfcUcgg9tyx487WLb+OLz+a82HpQaNpp1ie0s6wH",
+	"xRr4VvUPKRvSGWLgXvluoOiEMJh1BrX2RP4EN+P79p4K2/X6uXxTprbYyGXZkX8aHnuSOrWb4qowl9Zq",
+	"+gYU2OoeqIUNjPPi/WQIv1xH4cSteWhLON4Z3SM5m7SFu9HKDuWtkCaERwnzj9ag56hTFcfTNsN5t+38",
+	"WkG9OZxlXnTUea5Kufjz2ns0NHsdxXSfSeGEuwm4DF2IDQ0oTZF76CG4fj1NxHpXIvk5nGS3pE/yFgzm",
+	"hfq1zMttwhuFGtJWDeYh/Da5T3TDlfkH+jMaa1BTX0LOZQ0sxFPlLzI7pK/2lDK6XGmNspJytfK3rFwi",
+	"NTfQJq0EJm4To94YQgTJsm/aucvs36ubadJiz5AX67M2u5i1pAdba2QLdFkfLW/ni5z4uAXF3N1b6Xzs",
+	"Eq0m8Vg4PmSYVmy33tpwGWwSf3oufULcJIp26Bs1hYHg5t1DvxmtUmE/10rXzSedmvjnmenp6XIYXZCa",
+	"5R6IdxcGKyW/b90j7Q16DA48K1LiQ6UzysZNT0cSeyNLhHaxbBXQdVRAmQzca6+VaCI95ZyPHyR+T4qW",
+	"igrvIbdAU+Zk7wLEkUNRSxYWmumC2NuJu1RFlKV+u3IWs6aMUzjvfOWTcmZWw+T3cfhS84BlGzD5jFET",
+	"GLnjxY/N1JNlcb+FXZjrNFuNZjSeeQ+XNnNkMceEFFQxUbOGJByuFu99fAXxrHuYCYD0AGTJVvCmrK/U",
+	"wWjflC4ffdXNxYKGcZ9EYdcVMGXCNpwZwwmjVfXFqewIt5lpXlKFkzPOv5RXGG/2W+wkLRcX98iIEOfQ",
+	"4vvfTtNKLIXH22k/PcfEuL1DUDS2TcqDMbv19KP2hUJCjZpVpRXFX2RemFKLUpfIQIWUKXH7kRl+IULb",
+	"cGvAOIyQjFZVWE4bYQFeE9qHIIyBSb2VcYSJVtiZGysmjXpd/LN6q9rmgxJwuVOhIznzntiI5/LCvgoS",
+	"D3Fl+rsjI/PztWo9hfwwiPVCo8Fn1S4YReRp9dlWKlarFU11r8Li98FT3MaIkcJUyHQ9AFZchEuQEARb",
+	"GPHtVHTtLsUyYJ8LSxvO6jzOgZM6sTFps57U9pQplOfjnetToODuQfR/kw/+jHbBKGL2qvVWh4fRfGPH",
+	"mp+TapQq8j7lHLZgyzYBX0EBSgtPEmS6hE8js71+chez3BTi3cInCGfwEXpWqDVgD7ulcAQuJMK6RdQa",
+	"c/omm7XBV9Tn4hHvv0ijzTtUjWaykF6rJbx4f6vCtyayx2WzyYaVwINTvm6xZLA1ADYb3JlttavtTlsM",
+	"+G1WLX7nQ8cQIiP18z2INkuU0j0vq1QOruHP4aD1xVcpVO0GVca9SnF3IArY6/31t6FsFE64AEbceS3q",
+	"HPYwxl+v8AH+7yCPsQWJmK2oxirs042Mqcp+V2ytZa4qgkX9K6jSrr4FjT0VvQHpnvTjlJawe/BZAnTm",
+	"z5KTID3vstpRb5z5siFkrT5x+aDoynPIOpI161JEuuBq//R2dE+beSup1pLZao33mr4mW7QqtkXbJV9z",
+	"SwSAdKcAsz3EyTvZETtU8BwCHY8Jz1ACgAc4iqWTlNCkNK4VMMHAgMoGyiyS+KT5dRdSL3197cVfEyxA",
+	"fkf42qesv/b9V1vO3nwzlVs+22nJpIxw/T5sLVbn21nunr2E0Wu9t8xF/C37Ky/zXr3v+K99vYUXvE+v",
+	"hYIeRFD2dM12nzTRa7YNYDGXakfkcu/YocxN4MIbWdTJ3YOjB/D4aszsLkBbGN10O2lW8hQL+rtiDdbk",
+	"ObCBSnAtkp79E6yv2NkqrGAmAq/hwSxmUlkL0kyTm+GCNOr6D1nXQc4o5QM11cMzhnVRiPOdG81k7iYL",
+	"TOirlABIJS2Rp6ieIz4BUHql929coNtVD240myTGBtwAuAzyvAbyDIqjuRlkLSsJ7yJYb4kVoE1dvMgJ",
+	"QKUqfBe6ZzBZAXEpxkmovIQqM3CKTXpYOycxH9IK3YdrG/wAN/O+1MJUdKJR9AQdAofrS5w/hF7cFYgU",
+	"RcxXm612Xv0aCgalNK3nEvpH707xnE5S5KVuocCEXr3cqFJhZTxbbL9nPXhPjiaTkqVfY4mFWWs9/6xz",
+	"kyYy/hfBL5v8U5ZJsZ9xHb8xSlYnHMj1tAUJOG44EqGcjREH79cCkemEoZVP3vROP5zcQvYTkg/XaSAA",
+	"6GAzuf/SSTsFhtkDCejBIf1CJaDpgA4h9opxzS9LqswjAN1MLk5iLa81hcw9u1WdS99JkxoW2b6cUgh6",
+	"z7sZUbjwqRzMkWaRLerWjLNA0fag9MMzlvKyeFWzfT2V/x9NprMh+iGA/rwwvZMrh7Jfa/4xFEr6kdAh",
+	"fLUc/xpKAGxA0HwV4w/o2SAMHT9gwObKalqxb9ZWxMoQuVGAzt4A1/guJau2NCwDh8en1hu3Oe38r/I6",
+	"LUuoJUgFAKgP0Q8wj5PaAq7Q0g+n3NgorrfaatBxeWdyTpdA692xiutgEgWkilen4x1QV7rUVY3A9Cpa",
+	"nS8I4xd/NJvsdcSBRETqH6w9ZAWHVw1Z58JybuUh+CPAQm0J1wfjiQTB5iwLm5IQm8w6/EMFBjSzUhdF",
+	"JZr9srqZXZj5FVadbPHf4wC4Mc9DDihf6HDUPtp9v5JeFATt0W6T72uLKiYnsD5jE0Na1t8xDyX26glA",
+	"1+DdvEdZX+gQNUZmfkh9bpz80HKaRsse8B5NCP5iRQr4vKwdk8mNhM1d7CXNl3+xK5BVUYm2tyRGLRxf",
+	"1M3+3vXhe7Le6hHEWje9UMqI6lsN6Jp8MwDrk48u4/cRMLZUraufc5Q7Db7A7PFMMXQGyZ0We7+Uyq2n",
+	"gHJYCrih7zLAbQFHfwui+egcy5Oxv+lw65LOOM2VznKtOhcplvRuTdY5Z3x96fA4dysedAtrmofodZ6D",
+	"yDnAW7vMB1K+BnTJlhr9UQTON5dWbxV5I5Zn9YtPJ3Dl6U3WNJ0VLqPoFBA9lPPMA8YkpilZgDGFfjmI",
+	"niDzCVT2APLwmbrOMDGUcXLyYgEa+RE6e1gD795fprSHya2BrVG4E5T+IrEAb2usDLQ1yAIbYS4HUThM",
+	"lwIao2zMUIFnsLghZ3PQr/rFtZkD3aVwjANvpkX265WSFNoSVIPII0Zp1d1Hspyj50SErANYPPsT2+iM",
+	"vb2aNtnwcsiJwPPIsDeiGD1LyI8AGk2pzy2Ivdsft3wi21OxNFrC1cEXHiiNR7zViez7IY69DnISgQiv",
+	"YnHdFD5QJXKRq0tF278R9lJbcnmnVZYc88J7WZQKRDKup4kkwSg8HIsdi/aLAwLudWjNO9c7dRYPgYjU",
+	"NXBPdBwfblYwOhjGExWbHyAwfkOL0pC9X6VJsz7KGpCDREHjSQjoYlKvNG5RkW2xbTAlsg9CvMBexlKz",
+	"zX7RAcG5kGu8YUR0r1KAFHBFV2SSS5CHz2MHQJCUALK317FoJNooetXH6QAdkAp+M+FAtkZuw4ltgK57",
+	"oSF7imSop7E4w7ypSCM2X0XpOt8W14bl9kh6Z1sMi1jssFxshaAqzyjhBT4BflVnzve0/BnxXdJRWkxD",
+	"OKFWK/b5jlrMcszm+yIQnFDXrASKPbLueT5JtK4rqpz/za7dclgUd78sl3YfoIg8gTKkPiJ0/X0Zgg+H",
+	"3IJPKbZrZf+Fp8CDdvW9ZlQTrzefMe+2rZF1XKpG7Z5/esDSIPmWfFRxh8BP6FmzyNiea83GfLXGOI3L",
+	"i1RzxVwPZKr3U3nfZ9LNl1458/o5vPiTz4/xwf/yjz8/c/bVc6+9/o8/+zmb4ZQVzu+zTAD/QyzePZCH",
+	"RxhWFQu32G4vn2yd8vgA4DqiC8PjweBm9QREWq6k9QWZpjk7fe5nzJhupYvVuZo8hG1uKf4n5HqtECjV",
+	"C4tfYkhoJeC5cl975mz8pUXqv35lffSTT+KbPCMLMDvcLo8I8sX0v3XzRpNE4BpIdiO7hoFerdtKvl/E",
+	"r71dFfqNTadIBOuWxm65w6AQ/Rocr02LWYfwiG4UCqhI74m9uavUxe6XMBVF1EpXUwOABQqwUcJTatE/",
+	"gOkUg5Wrqf8ufy9jOMfxVk+o1Ptg6B+DA6xZbPd3ziNMl57IoZ0LQp1p9kJjvX/jgtjpv+/8/Y2db3e+",
+	"jQKe3yydPffG9HQJ/qh+j3UFwAgjmRtQ3hyyjjP/+AbU3y4nbZmeEL/559/+tvLx2U/ewP/8QxQznQuY",
+	"jk3Bef/0z8d4vySLInBR1jZ/QB8LNpJ+ryYCMOjMbQVIL4P70n/Iw3052OHQiNtuSt6sLlfEj9X2HWEL",
+	"G/MMXGzGwx+Hs1HVo4WoeUdAtIYAMIVryU+i9BAPp8Dg4tklAxKMsB5MOD9zMAVQy0mEIXocrOB+5cuc",
+	"WiEacdnZzkJVQbIkvsWB21N5W7ieRBLLP9D9HpxeQ2W2AQDa7/KIu+3aOV96AQKpCL3psQPFBViMiXBW",
+	"gmJbPLjJhbUqOJNBo3JYZjbiOooVQ5Qul1PKCp4W4PsuwN3ShC1s5fHCfDYqK4x/O7CvhOqdeisyBPCD",
+	"RvOm+PA74qfWywNpAbvG1Wq9w6f3dMIS/U/NHmMUoL4TOuweIEybcEVb1QRI4R7V94YNm5yRK8alYW+Z",
+	"odS4LX6bVuJr+B3dZszhG1prg6lfKyWCeAhYNmTk65nOGtDzAGD8X0gov56VzapbJEpCCtUduScMZnn1",
+	"8nDSzIBMc2lR0KAOEX/vqUHGulrF67MNgMJjORNUsnPFTIqu+gYVsAcX7y6M51OG8QwxZWEmavePUNSx",
+	"TRyO92za0PSj5WbagtjSXKPeWLoTGZQLysx1b7gIPl9o7EX/8dr2HKVeMXI+h+r7vnHghxyTV5vI3Tn7",
+	"Ic0CcJohrZJ4FtkMMOWfqXYvlBX3AdwDhcr3BspnhhaT5gJvgP8WLMqQwFviac9sBtqxBmHphDmPhCHb",
+	"3lmfBfu20EwqRXypPsv7arkJ4kBMqc10RETev3m2B8Z7TFrtmTStjwa6HyiEg5NaKo7yb9x+KypSfzJy",
+	"JCcCLHq0h0RVpuobmc1l5ygZLXKIM1jh2UJcNyXfB7q4clu6CMIrx0AHMmwg4Ltf0pcLIJiCIAfRmCKX",
+	"6sDsrJuWQ6CPOYEMrDCNwl/+bH3Xc84IDusKNisfwk/9ExK0MqNT2lBVkmjpfGw2g6ZJFH3CQHXzeYyz",
+	"KOICiIzGxVgCxGyvdcp4wyT17Y20li6lba5lxaTUHUYiq0vSGJwhoBn+NL1Pum3i6qog3kPGaGW5s0F5",
+	"uOITtZ6ZKI5T48E4ZrVk6BX1FoGTiksKpey725U0kvNak6ficzG/Jz6TsNjUV89GqqrSWoX3BfWTSupu",
+	"B9ligkNKD3HNVHIp/SsV8nrRu9fb8uU4T+bmtSQ8Fb6FnN2oxplwHuV8JT1hnssuekvsqLyan282hatY",
+	"4xoy1OY6taSdL4JYNvdg909EFkZkMlsQWyxOrdDuNOuteFsuIbCfS+1OVM5Getc4nlzgekZ/1k/iFoBt",
+	"41DK7hqwy0go7evpfNpM+VJDtyMHMpLeJdT5NsqRNHRhXk365KTYMVdmqe1gxsKGWC/i39E1tFRALbSF",
+	"1OAozNpsIoOQ9s3sy/cm1yrHD+Kly1eq9ZtsoMbLZtnTyVqa0kmZEVNegPx361ShHNdSIm5T7WUg7+HI",
+	"g5i3gUHBxgRbuBxuCUKfSf81/gCRL2s8r56NtUTcE/Fi1iK5A3j9XJ6SsNfGjI0Tckt7BVoC1Cp7vVwl",
+	"Lg9lXh4RN7TCSHq+DJE2o6Z1eU4hGDa0sbw2VftoulO/MFeJ4syytejbtTRtzwjPAhle3+u0hfJPi9RK",
+	"KTU5AG73h4Zqy4t2qbDuQN3NNSnJXYwHhtVqbPT0rWTuZq2RGT5VOkBH4gIa1rDJWzS4FY+I0oB0/yp5",
+	"Qa+0cgdma3zNWqbYH144pBCMNXBOEV4sgOt8Cw1EsZFTuCYnfultiCozwv3Kan84bqNFiM+oq6G/Z70S",
+	"tZg1ODwnKzN0k4mmtJwEbjS82d/jwmNv1wBsuRqv80a+TWCa3IwLsORzGKAZQ4bjomJsRpHnHpRPdOoF",
+	"d8l/m9e8gySBiqpWIVXdl2Axe3cHxXIwWh4taJXdoM0ec/TclX0N4S53VOt9sJi0L88z2ZqKuLRcKHRS",
+	"IvUhRepvZ3F4l5fEy2/p/qWhYNjhtg1V77tBDaB0k4d7B6wCZ5NWCpHSvGsDb16MyrhjLUBckfLroBVg",
+	"oUXxHG5bu1J73LtQXWCxXsi6QjvTBgX8ipnI56SJL9Z8s7GUBx6wbKlbMuvrsoKEFM3G73V/vfE2qGCi",
+	"dU+HoN2I3I8JvjHhVRmbpxx2EIZb9tSDSSDDw62TYW9CprizuiBHa2X0shlRb9ms9SFQ2OWt5/HsKteo",
+	"qfTPnitnV7x50WDkD7Xo70w22wUpBw6ncrK9kb7+Mx5ePYZEZywP+46xRWzOlyhOAt4hbPGFRn2+KiWe",
+	"JXJptdPlvDGoJ83Iz4Z8ieKXWe+foTdEqJewM203gkQxp9jyad/An56EGechwgXJAFBnHh1P70mgu9WU",
+	"CD4ywKuo2wG2Onezs2ydRDaf5mKNIl2vQiy6fLGHRQ/NqrtJpkNXEezTryy81FX8JjRRnWumjN9w7fK7",
+	"U2As19C1Pve/7/7Pn5WgRvBTZAoE/gbA1yP8SAK3VxQTMcUNs/NAsTu56s9FY+OkKGNS3OFEhSFO5Cil",
+	"AOHyG0Goa+D8hzLcK/PQbuWekYcrFgOAN7D/lCoK6FAUs4LMWsjKC3AYLBIUT3c4YtnswA/8y6v1m2nl",
+	"PdUlIR6U89yZshMkUy2l7gWBsEiILRDVLNiI3xgMX2ZPtwiEaSNIX3qFgcUaxTKRzKzDFYY+x2wKqO/l",
+	"YWixCMHyqA1XInzF2H87XAXuCF6xgH/uZn8UTv/XJ/LST0y+6zc5X/Im8dEJ+RRuqFcT+Z26ZKyIdwoP",
+	"YZwajTuE8gS7nawCnakz2CIQM9wxl8VyJHOLmPkBFAqyrNWrrcVIr3BrhBk46OKsz9EB7xMzeP5KTY4m",
+	"fI9zK3ZaQpEpzvEdByUF2xyv25jAdh8g0fee9iSXaptfyjYcsSJtjlUjV3ANfbCSF6ECIiBw37chMrWh",
+	"SwIcRS2DiU73MT/iKY2l1aWOeoxsciVEWBKmHKo+lFqpkKQMJKzDXRbcWMaw1rHHbr5Am/W6oL80xlnf",
+	"tla2EJSzKAOt/2CmxXMqXlWjbhS5/ox4zDN0TfuAloF7WS97iWOlBroKiwejkrAgmRcRMgIg8iFbMvZm",
+	"SX8eacaoVAdqVRnRU0xE68zTimbfnUqyERpgGAHz1iHYj7xTesEW1UyWyw2/nbFJNDq1lY7ISLqOr2nX",
+	"sa2lnTmGXi+UNL+nAEMO2ecGBO9WKCo0eNN7um4f7X6ur6A3zOg2AjpgrTyCcyzLbNOmMBzN9EItaeVu",
+	"5w3/81JMG7XOUnp+VlyvM8gQ3KGECXfn5DyRNdSqp3Yf+LU/J2c6250bKYiRKTatWB1cU/4xR/V3fdUv",
+	"+akwyUq3PUVLvGWrI01MXOhovZvedqxRLm8qDJw9LphmnRF2j72w/Q9FZIXhaml2v7BKIJQ3qjqtAcvv",
+	"MjTr5NuuXa3ONRt/4MuAvwNqu64KaCHqcEVHXgh0DBztHh2AUmpDHT4fasIH6jiZDZ+YbXQoiJ8vPkJD",
+	"id82G9XKKBVI8s+dfFQPCLzD3SF/uQlM+nfBtRpSV9Vi/lTR8pLnsDTyov+5HQ+31w1sRY8I2e4iDXc4",
+	"rEm0Ds6YbPFmOLSl1m6V3RIVa0fYk6Ek9XqKn4zcPZfU53JWeAvoK9fs1fVmmp96tN7FDVmohfMWR0M4",
+	"1ra4aOdgNnwCJirGBg/1BaB5MTN19mfThXqzjh1bhrFGZhltyXfk6Ds97JAwcbqQ/2C4PXPeWLxyUVvn",
+	"M3tn/fTtG5Mx2uslZKw7QrkEuVvYPquzMjlqGNNXDVdt9JrP0TA9fXzZeHmXjeCeERFBHcT2oZZztUQ8",
+	"6ldJrRN1e502kFA34bWBRN2wRjmBgaYvJb8G8Cnr2PrCxphSP/gi3SPDrpWPZLmi38Gcy3Wp+4nVGtEK",
+	"LzCZJOXj63iHn+VSuibDga94tWrZLPfWZycXRleSls3Ua11mRurJIDl3ATwZp9+Vddx3pO2+WijTds35",
+	"MEAoqo1mgbIHGM819WGIuzVTLiu38ze4tnzGJwpyjMfer5hBoA5GqRY969xeNQhVL9GsrzqZdsO5F8Fc",
+	"clwn9n5kKf3XqCAmvmD+ZPHd5UxP6BpkiGdqDQ5WkSwnc3z5V2HknMlfY3hDuzI9bKUlP9v36n6ms52B",
+	"8iiRZv2S7sHFlmme8hrmw4LNcCYVaS6bbYpssWFg8BETSZMv3NBMDGyBBvg3fXRL3Zqt10by4vD9kUHP",
+	"XDl/I2kusCrlPxCqVhKf0R2snIr5YNDUu21FlStHZgbOwzoisZgKCTQZ8fL0r6JYu7CIH62w6/+7KPIu",
+	"gaKtFnP2Bdu6WFmlIFjtjh1BzJE6dy73SO3FeMrGTM3qXLuAO2ytcCGfdqGR1CIu5QsGdM8VFYZg3jU7",
+	"5qrjhQFOW/4VqoX/iNRtGkoZL1M8M1F0lF5XjbNzdqkciCStV+RI3aguM7jfJXHdZ0mCoPhwU84fqk9V",
+	"p0IlyOBydu3yOsVai3jS+1SVB2i1h76Gz1s1byVolNzEIk72rLy3Xag1WimvsL+x2xZ2KYqi4+C6Mvgp",
+	"UIpuwwUEe5jAaR4AiwGI8XBnc8ouiHIA82ToiNzMJ/je6cdgIlpjeVwJU77AD5yqAJoJsIgOzDy6I0T7",
+	"DvhqUjo57XbE7IchpO6prPKLOwQLjERGrX0OKqvNTUQK7gsGV1ccype1k8MMDNeogdixFPRoEdQiGdKq",
+	"6qdyXZ/SrHADu47lzC15ZlxFywISVDLEQPLUYSPfx2ThtQo9xJpVc83HnhgeAN3D4tRYlzv/PjcOW9ke",
+	"74D4i5lCqJNrzoflt+FCMcFDaW37ITqOzYawuJIYIVpp7VsYPAnSEZHVpw+cduhrcJ3aBgP0QNHxgy+y",
+	"gbylG95KIev7C7BAYpXIasn5Ab5x93M4HSvFuNTsh+mU0ZbFO194VTCfm5XKzc9RVD1iOnqmOku+dPvy",
+	"yjhJtk3jVFfUrbjUThiPSVbep7H7p6pR64PVyyzAKd5x/aBL+vennQReGt16+CK2Zq/110XecYhIDNR0",
+	"3Q7uLLVB2RLGqBC/U5W87Xcu1VnqFtMAvkDf4r6a1kQkyaJvknkRNx3vKnTHy/XGcmBQ672DljVe2ax6",
+	"dNvAHwi26186CVQRFOsqENy68q6qrZsdDsMBaZkBFB6+GDnn2KlX27/KtQvO9RHyQtSNm5JfI4SVbsrq",
+	"Ar1QzgCiqx2N9k7edR4zfiy+ltvoWx4flW8cxcCMF5sugKFwQ9B6EtFtuMYnLn9QeT2VSfCZRb0+XZJ8",
+	"ca8V56r8ynk1TwTQmJ9vpe1YkttJljkMEaDotql71xa1NXFC0xhLxqTYRqTvfLSq3875ufOAeEzxq8lM",
+	"Z2kpad7hbiftRpsN0HkzR0jhU24RDOAYwyXwixIcK2DdcA+VmPfr5wraUAB8wviIj/OE3qq4AFr5L455",
+	"k5ofQh10NK7jx3HjZF5DCD8ipm/9lLRkbqG6Duqo1K1ttnQhaNDpPdriUrWNvgvYNri7llV/BNIbW9Q/",
+	"1J+tGAp11814sipYHkBeQIH7ao3bEp0r91DuxWJ1YVGq5eaCW/Nr9FHYgX5SrL7QFWLsxsz774kW8AWj",
+	"sjsa47uTaBiJ7/2IVMYdxVjmS4QuTCCgNzG2fST/nplIfnIkMnmPNL7PYirHuglMJjaiLxBcfGRycQ85",
+	"6HTsu2KgQcqOgsm65W2ROK2ZoFaP8m/AcOepscEB3UNNY3sc6Hg6jJ4yhiqr7m/wY5wjOukKXkugC1yO",
+	"4zWxSNMmQ/j+MuhSg2ZKvS3Qv0grhOWupfjrOXlIarVI3avjETNuCT1nhJBwkHjOywpSH6mC/RwmfECC",
+	"7rVk4l1iyIwTQmS3odYd42DQXhZfbDt3c0RTb3tICI2b0hkfFPiS7GRUt2hjqecU2E0/nWBkLKqObiyK",
+	"z1QYbSDxB5UMzhjENW9YKATI3csr43PH2bKhP6dYP4v4LjFoVfhqr+JeeZBnmon1mvhiCCejNc8WVzDk",
+	"xZmOqv95ohW7MN7dz2opTPH88W6BcggzVitUdhzf6p6xTLtcr/Rs09GF5CasBV2Qi1FryE6saeU9IdAF",
+	"Y/rcwydKtTDGJMbRcQeSJmqMK3sQTpG1tnuRvHZjH+XO8r2tvh9e0qX4JnIa2YQz3GPMnil3tZnJO4rd",
+	"EftyqGpy1VUeudyYO44wOYjmjbvrrbw9z6yFJHqrQByKjjAUG0saCNpNRMgaPjdCejejtZI37+gOvl+H",
+	"aOGtanr7IPI3YwU0CrZzwhJ/jDWxHQOKuEe+VhsXb0GDjq/7cq2RVK5DkxHm2NA1q1j7cw5Tld+Ybj6p",
+	"1kZ4hUVZBqhxj7HBu494VwWmLR7f0jbgDKeGMtYAkIzlU4BCvxixCIhWvUAnW3PRpXWiIedtKEvlpGjk",
+	"s+Q3WFfTajl3XfcWZHfFh8GDFTnYTZx4ENHWrbTN/g2oyThuYD9KPW2ppWgs1R479q129QC0fOiqacMA",
+	"1qYoeYj4NY9lP9oOSMyP23u67126xSZaUvnrcbbjiYYhy5jaNjAzyFrSZxahLU2CCtu5ZuQ/y8UXNObm",
+	"Os1mkY471pgKO7sH4VW2xrmWRzNFhhSPds5ZogwBKMZGOVRbSeURhbKcave7uhm717toZ2C3AZxLWovv",
+	"1VUoBfonRjsUXvNDG1kRwdjgbabMtF5BHrzlBLZLbIk8ynw08FraFDYyqV1M2smlZiJ75XLKM2nlk1Lb",
+	"j7oOXaQgHIJfzhVuEMO7+06bh03LZNBiIPlTV/G2MFL2tDXKGwm6AMGPFd4t0KYz//rkgReaYt2rdaqN",
+	"G2NrmnhlyGoixPfOIRoaKNcir20DT4flABW7UgVjsNZZCc8Je6qWRLG6IBTo6M1ozA31ISAWeTH4EKam",
+	"DVE6RMK9l33ez31iGgshiIKQ2uKLMkv6hXUPB77usz8rwH8SdPSL73bebirBjaT1FHqgVdCdtlRBX3p5",
+	"z+SlEVbiBTWftZOAxMXbAx6Jz+nSOZALq9ed+KuYal9px240lmbFhZBnyeHG11ZfmLIt/846dIPYtnhU",
+	"PM5iruaDH5afRi+8ctYqIBwH12CI/UW6HuSHJyyOjcoOAxe9d1nAPtuPtDu5oJEdearu3EbwAZmuCc1k",
+	"TraMvtG4mdbHez/yvcogBSyjRfzJuvA+n5A7AFYArPXnFq0cnLVQutljnFGkL7z9m0Wa2zyBdd7SdYxd",
+	"m0YKVozvH3yAHAATKvTfL+fHvMEp8S90tbQ9jegySgJzaMIAbfzy9+YQ8BFEKYW13JSVhNqrkC3kFyyZ",
+	"OxKMFH7gJYuQIcbG0Myv78GpsEVlNl6pOJnFRNgfCrE8lPX82DVp1GqNDqPc5mvJQhFWgU9hQ5/yJLzi",
+	"eZLCL6vdoIRgd6meB+2/nK+sXX9g4uWRjsU584cpOIPIWIEYcWjmFL42rRj7ihwC6q14MgQn7G/6vAAy",
+	"FgOhUm081djfTWJxLt6Z2VuBnKnPXDl/QaJmqhJ2cyGLKbGS3GGnL2f3Zen9GxcoKjcE/jLwNEq/Ef+b",
+	"unp16uLFstUjHklgFCgdtO1jcMjgZ7DdsiWyfP4///a3lY/PfTIl/3NW/ecfchWjHOsos72etqCN0GTn",
+	"zLberLZaOQ4DSAx4moa7kVqMyGpCAPU81G0A0V5+yXttwOHSKv42cr+9Hkg+qSk5rk+A+m0trAGPiaJc",
+	"TTMovRaRjbpI/BsmxRHCqv0xACmNxXJCzXcL0M/4LRVfKWUSygxo3YC1T9wUHuugVtBtCjmSUWLcJmJl",
+	"IpzEGxwSyKgygIGT0+PWm97WAxflS78fJAOMA6HPonOJgtp6oJ30ahSgfXlThg1YkK+q7onUN0c72o3Z",
+	"STI+J0tOCnbUnCwH0YnDQwRUlPjnP7TIM1s+pvxE7tFZzFOhPiSPLLKFFk6rUb9RZfO3Y4mQPa3ItVm4",
+	"5COoLszggUGEMiBXW8SqfxTDtqr/UWa0UFpTvBY17MXkTm5S0+JEKkaG5PZWpdUv2/oIN1stVcQWxNLc",
+	"ajzFcXehYWFClpHumYgb7Cnae06lj+wPsO0ovy9qQUZ8XUaLSbOSmVtwkXWIilpi22GhrDx6H4My/l41",
+	"fcMQCYK11k0VAoKwlb/z4zNxE/c2D8BoZvmfR9aMBJN6WSaE9aBH0qyR43xM75hD73gE3bOXzsu45+4R",
+	"yhwUieqOTeyIvSQmyO6oj1O09Uz8Iv4fOGF53EJCUQyOII6LO2XBoSrafsYogDyPT42cn/d71zC6VI13",
+	"1jFSNzBm0kJZoiDJZGaXgsC6xzoeZdBBm5CAk2QFqyXKHUps1rqGc5Pz+1Ty149glb1A32vTToo7bqqX",
+	"f178k68V/uTPC33SD/C9JvPzckD4MnxQZL9mIlFM3oN7z+tCBX6arSsH4KNRNpn2kUwokXgGa5zUxL/f",
+	"6jTr16nKNaczNrFqITbe4cd/AqN9Rj0bxBc+J36RDQyseEBIm57lhd12a4itQcQvvrBiWQU2bHZ/Z/Gm",
+	"gmudcT7lPAvr+jBGhJlFDM9ZnRqw7wM2aKCY1e4jzMNYI9x9VHzOfCat+JRtv0u13NLdEMLtsE2L5YcV",
+	"c/h99shVSEc/U5K7e1/VOZtBlA/K3x/PPR5jRsWGM7q3zPvHMUacDP+Y0SkSmxHTKAxEwrVD2REAx2ph",
+	"Mo5Viv/Ler3pf2d4UVQTzoqBSjJtYZppa7FRq8yksmNJqwAxUlfJrbGS3RwrCWLglINARYl4khIGMWt2",
+	"4W5X24vV+ki7VVDi8jOTCyCG/gLp+41rKcqGVojGzIaRZs3nlapypSNuGAnB6vUHSwGFFy9ylmCiz0bn",
+	"ev6XTtpJL6bLElc9ykkZuhRFAcNhWbPhMnxaHm69H8M18AgmOg7QKpO2WpjRNfmgsC59AD233Jtat3hM",
+	"khwVJjZ3G/sgR0/T1wBDWkGt5TLJKFKs59Bcl65+uhV1eKryhdjaRX9kZVt09Kqy0jfXaKZvJ3PtRpNt",
+	"lSVkZrYT6YP6lUZTQD3EBjk8MB0NTBBTgpJmb3cALL4FEkWkO7SRlFQ6VcxsRFpx/bsZjjUSoS1PtpvJ",
+	"rbT2oTwZ5RKVmX14O2m1xY/yWibPc7kkUfPL1bTy4bKsQGuVSxq/8iHWSJ1i67MidEN/difvrVaxid5O",
+	"qwuLLERErtYYj+QbW90ixhh6XdkVAVaAFiXTzA1CyO2FjX8yvPpv0m2MAMTEIPM0N5bUNbRN4P3p/OcB",
+	"EfVHGg6MyuLxdrXZar+b0bcvaFhIDeg+xTsW3G4HE2HqKtmX7hjhScZUrljVlkmt9p7Q2P9UtO7yd2We",
+	"ww+Q2UrbbBP4+pnJartrc1I5v0BxDLeINZA8qKq9B9cmsUenDnC5zPJcW2y0G+83a0Ug7RC3X+EKfA0T",
+	"uCk/Q0HEVXFuE+K93H6lyJU9On9yEKVdIS1GzYUye75NjJOX1WpWAfJBVBYXayk5WpWaxdnWM+Xg/aAc",
+	"vIj0bhpuAQep6slHrPyn035vfiZt3qqy9+U4cQHCNHuk40FAB1JZkcqVZWR4GrGfA3slpN6YPAAw1u6S",
+	"ZUwY7t4Hf+eZacULtIklClDhB5/Zf3vuELHKJ5B10h0VpUHCtCqWqomjx09D0plGyZS/0jsE4RbdWbVI",
+	"ATiZfvsF3paZRcw7K1cT+aq6Cox4RUMvTwz8kkl7JOycJEXp1UaFmYXwlatsz/p/pcKCTWibJNER0sQM",
+	"sPW1BSllt7eVttsFytFgXDP0WZCKhYVI+NtL47vUDQ68FX6JDJf5QA8CUa6NDPWQI78Bw82N+6vFKKvF",
+	"NhPN3KxYBqQoB0NAX6hBiU6Jk1uofPZn0yzV9xj7GV2GDD4Gb/IxMGm1fqvazkvF284BJu9U2f4mZt/K",
+	"dvWuiZURsBgCqhqG3MdTuy51kmLUpuesUlBtC+JMT9AFsPWejWJCkRlNujr1ic3XY4qAv69CXvcF6Nkv",
+	"3eD+ADOX7IIUKDTCGZT1dtlTie7+jJE1phZEUResYyjPOuu+nnqllHTajdKU9SFbdVksLwPcW+Yv28hl",
+	"/kBzBg2CMFKjLh/QmJ+Pvsn2hu33wO9V9ycZBn5kVW/LsQMYAbm+2ZptW0x4hwnKGuUOekWNrO6UWY2M",
+	"9QzTT/mmw18FhofJU9+sOZkV1/FaY2GE8B4FbZyNA7dxSIdhpcAIxk++2+XlxcqqxlTp0eu81ErytgKE",
+	"szAuKiiUtxPMBbMicGJS2l98Tdzs5kbRdTP4Ba0mizZzYXawWJThdtI6X0CIicjOk2WH1y5fitl6M5xw",
+	"2bKNZkiWu6Dk316YqP501pLjQF3hBi47lFnqK9ClS0m9k9SEjgtbfJVB0Yrlrs7Jv1unTmoeH1qmFBw+",
+	"UM5SfZnXcXfqwlsXf5WV39fk/BhPvAbUlUk9Hsr+Nuz0iZ2chPdH2dUVbQllRltVEzlETmFc+83StPM1",
+	"iOfJD8FlCC8AoPT71qOwKq943VEwP3bvg4WKOU8UcSlceqz8Awtr57kao7XzKPqSPFBirFWGnh+3TDeY",
+	"TuxMJZ13iyV5sQ9IhE1/nHYZttlfmq1K9iJZYF2tIdfVfLPxh7TOno6X3AmX8W+ry8sjVX+akSBfvQeQ",
+	"G6+Yl1bAGg4rChTlv1Kt32QqFRM2n/g9WFrwiYl5QW6j2n2NrYoW6HMNh6Rav5nWWVGU0RwwN4WfFjjh",
+	"8tFlnA+3DL9KF6tztfQG/J4JxQxkRox67oDiH0IXrW5IAKmEeLY6d2cOHP/WXKPRBgzgXNJkJfiDNL2Z",
+	"DdYmknAFZlQvaXXqCOFdatA/2p20hf+6nVbq6t/txU6T/jnfrOI/WvL8u4WN1ogaTSkV7wgt0oqSMX0f",
+	"BtvlvQlypG7OtETBhZ6RkhcI88K4+13qCvpAZVGdvI41YUzVf6gaACXLQl4ToSXqC/p38N8PhTMpfCue",
+	"5um/E9IzdDNlmYwYxirUkeLYu5jQeQL3Pp36pTRBWQErurrliPhBup+bipNzCAG8HsGQcLmkxgOI2yre",
+	"J+EHy7Vy1i64cqhqkqJ0lVgSUuzT0YqO8NB8An1G5htsngCbsT5QxfgA8AV6MPmTZzixVsPrmDqApiSf",
+	"oaWw8wrI+OhkGjDMUG3LG+CJmdvJgtDDJYtvTPynhSM788r0K9Ngl5fTerJcFb96FX6FqgGW97T4/elb",
+	"Z04nFeGdnE7qdaFG51KJz4E/8yj3ryCC1iNuIJr1dhCve206bCJD5TieP0nwgvVydo8mVegNkJ17YU+N",
+	"PhasemmZDYp4i1sRsvmp+E+XBE9ewhvgIoj5yUzEiV+k7fPOUkhBaQlBaqFQnp2eVvACIh4UZ7NWRbk6",
+	"/Xu62KHAFS6ust/IRBg/CTKCf6NF/Fz5tkOSxBVE288n5AwWHmcmoznQaHLj+I5QlxKjIv/cUh0mSGli",
+	"sA1t6IA27K7xijzxAEBao9VmL2hdzYpDUhc+oK9atm3yvcV0dAw7RskYDRhw3fBNk0kD0EXobd3MZVWD",
+	"ebcUsPw5gpgwosQcC9DsDqXqZCT0LWEJKnNJy5FTw6n2VqNyZ2I7/25625VNRgZMv8VwSyjghkvVVdBW",
+	"leEdnLC1cLvZST8JTtuZic0ldyLfMQIF0vOc9FsXzJT44rkRlcCeDxf54oRgw0zRYTno/2qvEB519mh6",
+	"JxIe49mg5epURzU0HdH+rMBxe2K98Py1y/p8YQB8G5qMr2CaFINjoCosJj9TbolJyccSo9izM9D3zJ8e",
+	"aAcHEBZASERgf8q9SIvl1q8NkPfyAd0deq+UxBVZv1+mWlHi8K5hNUuk6PQK6H3UDxKfNvPO+amzr71e",
+	"gjifmPKUiWyXVfYLxkceF6UBTC4XHs+awWuX328h1nQ5aSZLaRtu+P8USX7iSkXKLOO3Y1BzSGWDNHfy",
+	"c+/fuHAKMgni8UKpgXODMAN5AQDQoNEb80mtlZYtCY+xoHD0J787EPOuVnLvpv1Y82S5GJmKwKLrBNLG",
+	"UP/MJpWFdKrZoaz0KBpIpdAw46KgURtEFern6gaqc4Wd4BqULrz3/vXLl65/+Nb5i7+49OH1969cmuEg",
+	"wwMVL1uz4gLB8X1LTuY6zOUgZFy/7kfrv460x4x0qUjl6UoqsRtTi2lSw6hTcUGztGWfSOm9DqlYuYRC",
+	"hQHXEgHT5OWcj+iScaEWZ+TlDpBK4zm6zT4EvK/LSgAPbSR0qPqraZxiCbGLCFx1nABp+8zPUD6LEHxv",
+	"JETHr18Z+NvaSgrr3AS+hbRS+q8lsAzc2bgIO/AObsBBnA7qCOO890d6TgrK5Ajn5TbG6KYWZZAufl6+",
+	"V0Jj+rqUbdHdYHDUxj1c946J1/+3RJYFw3fShLygs2H7L8BEtYohs9JJ6cWU4f7TN/CjA4ojBiJPEmjH",
+	"Ow9S8p33/lgtxJCCWvIm7O7RZih5xeX/Y91e6JPTyWxLMxlHQiXf0011AFBoHI2LGmDx4gQFWpUnpqyZ",
+	"zOE5KnVVIjSU6eWuo54Gw0liLMEKXzk8B8wwPEi/RXVIQRDzfDrZYmRd/xdca89+CV66WtbJbx3YkYFZ",
+	"fsyPQoCFqnNYMwfRq0HYsvAtmDA29qjMv+dLw8zjw8qBSUKmgGxdQ/Ezepd64hf0BxfiOPQBIllNvFwt",
+	"ca2W1Om4nkcxy736ZYBiRhoI3PQgaaUvenZPLTdIZN/18vp0/W5/4mLuMsmFY5XHVzmyP4jIx4HGxbwt",
+	"P7p30nPT5w5gHN/Y+soqe2EOuS6VWadj8hCH+fMDWS5G5XtqyqLJLMnqIEjTDbBcIPi2/DXFzKHARmX9",
+	"PpcuTTlrDVTUG+4JFj4SFVdMUwwCD4zVmZY2pMo2mgenrQ+N64BwTW2nkebBOBHZtnpUX+H0x/Qv8Uvi",
+	"jEpZ8o3vgDe5DyyKBf0GtJjk1gBmcY1up5w1UtFPCHHKfAeXN/ENbM+yhWDQHeuvhdFqk+uUsARGvqyR",
+	"J+bauFqyagJcq3gB+qpPzi4emOkr78FaU1CJGZsWpb2bZceenWPkMc/svCx1zx4LXtkfCm1jTuhg0jqm",
+	"kgJu1VCf83eSr+BOTl16gxMOBVHmjD98Q8EqlRpxb+ZebZo++s7NxCROKPEb9KhgorIvmFyueVDPg7uW",
+	"sxvVDq2XPiPmCXUr2HaQ3m51nbLKgSa6qNY6JW10JNTQ/nrgF235446Fh6nvaUFk5K6A2z29nxMgDO+x",
+	"Bz6CSvbV7sF52PYwlBfi1HoyAnZYLALqY3KMcvVxUWNQrbc6TV0/2+FRyRBFFdpulXX21kK64Q2u/0+I",
+	"MdgyTG1bhr4GkEYKI6Co0igajVgfi1/NmHc7iOx/XIxYeL1/1tk7+zGheQr5Qjc4uYHeNFi4PopzOqMD",
+	"u5f12h8bBbMW3LH4wd1NDtucZwXGcVaPNbSloQ/LJdw/wugeB+pQq4UhUlpQ3NVXC0X15JJhGphC8qtR",
+	"8QaaeEdqwXtAIuAUChTB8I/YF6Ec4qCwD2SIxJVBARwgdQ3V6QLInHtdcpSm1zXJGIJADiSftp9Yk2Qo",
+	"iepz1qwOpUO86Lj+v1TEG6qsWVPgUoypH0M/kSqxSCE+oJ06Mvp1v1N7wdpMBN90rJ+i+cUNXTS3BkjG",
+	"L/d0/jPQ1lwKUUEv9vBGLiWoFNk4acAt8Jq6lDd7imlzQ07vKB0d+gZdo2CjBmyNsWoq3zQKbPzcX+ir",
+	"yTqdTi0NNctP2WULlIhaptyEGi+HB5o6YzTg8d39KNzdv1ParHhGDL+xs1nm8l/aSbQ8KvWsssaz+fks",
+	"demzVNuRyFBxJw+K4AvYnLE95NMf4z9GT2JNxnJlprnIWOwttZWdejpq9mK09FPOfYYfqBKIn3IqKk+4",
+	"j1Zeai+apRwJOX6v00MEs5yMSiDGZwM36DuOLZS7rCBsQlsLDR8HM/IAwQzAsespWk265SqE62nraDuR",
+	"R0op/Cic3eljZ/dQQcXGVdjHfvFhCcooa6KzZwfhD6fNhUzQN9QuKwYf5fmuIWtNENImzPS2oRV3e/5t",
+	"qU6tPVUeoToqQR33E0ilIVfQU2iIcM9HU2y+4Ydr4kgJE4fWjdIHJB0a0oWVq34/lRUiNYO+4iaObOhM",
+	"bMqBx2Y9HsdA4dYkAGnWtdfQZf4iev2vEKfCsK/KQBIWfBBjsgGR6OZ0SkKIWf8z4q+H9m/irbQPQC+I",
+	"wHzvsRYRgmZvMk1EhlCIIi9tJpepbiw8Z4FxSqgfE/zDRtigRthiUpFXpYheUGxck3JGvPTputlmg5I5",
+	"ollKWLDr+HxWC30dOb7oMhJ3zJbJboDI2NRkJDSFUprT+zK9YxdhDBfBUtsvL0L2tT0ImzmlzBtoqSZo",
+	"/FjuqeuiZbqOEoRUJKfuO4iOcEx/Nn6m7HCtqkPQE4rwOT5PanEiUNhCzodBTNEA9vyd61NwJ7yHVzX5",
+	"gBfYU0ypwIGn3KGLU5DECBSwUEyH5pbtjI5u2jHXgLPlRZ2U5WZjvlrLAP/8GeHYxnKhpyi3JRzJG7rz",
+	"Rxkv0sI1kL8BuShJJSd2bgspfqUDwzhbwpT+NYoN31adDofgenyRkbl5f7liQJfXaJbHMBu1EjHYZWxn",
+	"X4Y1yhrrsT06apnxv2iNbHEzRMWtoPpqpreq6e2p+VqykJlg+EFzpyI4MPDOFAoFCXoASIOs78gb5vUS",
+	"dDzcVegwS8BHhMpjexf4ji6osvDwqrmoy7N6zxmj18rF+NCQ8KZmCH1UqUGXLXkfUdd0a2YEoxR/GQBq",
+	"cbT8vXjoD8g+ZMrg1YMH3JoSMcQWUk9gUbJO8/dLtlkJ0yi1NGmSHrgOm/y23OOjieM5EsmJQ6s4SOhU",
+	"5wvnlPoHc0w1oiL0mTrkbw6JuOoICG5tl+VLc6lSdOe74IuUeogePF1Z07MImnUYDpwr193PPE06fH18",
+	"ln56Z4lkWNG+5MtwPEUXXAw0CzTSoRAR42hnRMJZmS8hEWDGCXEpQXvYsxujn1a6XxyVN+1LoGIINbE2",
+	"Fe97/8aFKJ5MFqM9zuWQgFzjEDwIxOkaIIKiAdPvs1iZKX8mWWPeQGTc2XNvTE+X0MJOT8t/K95el54B",
+	"hpVRbHD0zv2+XYI0qg/7d8WTKl1WW7+Mu1Bm0vHwX4a8VFo3jsA8VtgBpGtNFS8UUdcFHR7soTiF/aZP",
+	"f9yyeip6WK54aOh7Kq0Y2p1VnBq1zYwWi1z9WqTBolXmC+10VYcHxaOVjcgSKjDWMvJHB7+IZ+z4Ibr7",
+	"fhhVdmzr2CqxgMVuDyCM43qx0bMgWef95RLr2GtmsrFmdE8oMSeb2wPf31M4WINDGz5jKBvj8JQMtRAa",
+	"jEq1JZTE3KIwAm3oXjIJdt1t8KERhdBlumzwfKQ66q8I49Sc2B4QV2m8B0ega7/xp8Khm72TI9wXR3ou",
+	"Fom/gEM53Nl8s4SJcrj3rZe0VzSUuUS225v7Osgy6b5v/kgUxsZBgcinR65ePpkhQGEUsirCdOjEd5jy",
+	"cSIiGfDN3HyI6TKaR1/+9wMNab0j4/6Usbr2Pk/8KvUyju0xv7t3YQnSPCOoj8AczdfStH369mLSnqrO",
+	"Z1GowhMGO08BdNRj46jPaQRe5yPspSk/sOWxA/unr4ttjTxsBVUr96kFF2VD1gDcY7pImL4TblMNhZJb",
+	"sxrVIAUyJMjoKtXTzWvK0e41QFo0wNgSVISHKDekO75Lk+eIiBkij0F2i+VAH52vJ7U7f0jfljv3gdi4",
+	"y/P7pI2sN2SCssxOqM6h3s0ZkDAqm+DIEHx43alpP9D4j72Ix6ppj6Urn9kO9B93PxXXZ3nUV8LDGoQo",
+	"eOW0VJ1rNv4gBjeqeyzbeW7AY+FUI2DzhaIWRyqHe5QivweQyh70IzV4W/IjNlTvP7cznfgIoVAhZkJN",
+	"4bdUy1y/ZV1mGzf5lKeAH39mniI8qG+5OaiKbXQf4RC5tA7P0Hui8Djw5oaevFnWA/EH1Ot+tD78iOKW",
+	"Kemnm6kcZweTpFE2AkYQYqLvdMhA8kCCLq8Z5J/qS2Hg4b6tUqLcx+NMplC48PeIKMBuBGtLqkwod8s2",
+	"tbO5pQyo/yV7+vRdmiv7okWanCwXEmH13ji73pGQX0d6VBFzIDmMnGKTaQUMEj5BJ81uP+FFsLf9o+OW",
+	"RKCjhk2lLWBiV4VjYXRrUx4uYYilGQ6qiNh6+qSaIx2B3pPTQQDM/wVzOQh9CC99v67f/CNu/mBRg/qE",
+	"dZSncgEmcYn7GP5rgdKg/++tpJahJUNYmt2dPJQqW/o2qFECXWZKBlyln1GCS8AzFGzZz5wYU5yAhFdB",
+	"EzrzMI3UEsS9pE2s+fEZCVrFQ4Aa+SkF8/9sRObllS8wg8BaLziumhc4PJKHBrQPtwioXHNNh31OveFz",
+	"Pe2WkzuNTmZHZyGwyiMnr6pXujDzK3il1YVk6BXIoV57bvLvcJ+1O19imcfuinDv/8b78Ezdn9Rcsht3",
+	"tE04Gr92gzY23kw8sH/XYCkufST7g+Xqnb9q8qihR0IXaUxJLcQLaBpZTDDVri6lhTK032CkRnbXLDKM",
+	"dmPvg8hnj2unH7VPz7VuuafAf04g8VKsANxLjbC2QKSlU0z57Q+rlbL+t5xRudSuLrfg/z9Mlhqdelv8",
+	"u9EWlvA4IhFS+uI5fq50hnUGoTQqtwGvODFioEltSkhHMiWOT6vTzCw7/gHs8l3DqIaNdaHbMx5F9Gmd",
+	"yhunFSddxZ5TPbK8ga1CFzWqWtrAD+kHQLTA7ZiLHW3vgssyIFbOAYLp7UpzbB6Cfbat15nrG2ki5b07",
+	"0V5nzG8QJy/wX+oMkZXdMR2uycwA4T4oObdEHKOvW+g5qIiq/2VnIJuglDfgnrkNT1yHBe+VnQunGcyK",
+	"as8N2H/xSWlWvlAt1zHAbL+SBAaGam64mphknZxGuONKa/RMShXuN2FvnBjxOrdCGXNGuGOv9Mtk/mYC",
+	"mTni7NxAJkL4fruxNNtqi1NjN1B3/VXIy/0nuKXreHS9UHTZ1O1twj64xeKURVuBElpl6hwKapuz38wM",
+	"zZ/6Nj0U0v9YSKE87Hv65CjUtjHlpsLcFGSzRwsDcF0TjdvCYgqHUJVi/M5OyajIKjpDSsIg6emjV80f",
+	"vix5xxRYEidf/h4Y7ktCC6XXSDFdFHppn+L/9isuoebLygP8oEW2ax1TrVMGWfrjQIP+zLyiDaNM80JH",
+	"PNXt4fgW8/JuMbLDVd9VYhYThUZNQdGydyCDgmVf+Rye8hxKPfRVO++R3QnOqanO3ewsT7VqjfaomRVa",
+	"9C0DspChwgdUMUfs0QjkGKIrItUez1eic72QZZGSlJMr0fTbws0AjhV9VRpSY2YqZgLNP2B73BBYi70N",
+	"wbLMwKocRCDQvO9H3PmYlwN/4zOYl7+DerS7TvcJfOposgWgJKdRq8pUO2bpCTgdW8ZdtvF96OUgH/ym",
+	"zQa/qQdFPcg92cMYtWGLl2FJ3Y8jLChrpuIuaonH/tj3d9Pbtghm860NIsM3E+8eKNFwzsh/IBGxWZyO",
+	"L8hB7SetjbORuUc1056c/lj+Rwbq55LlZK7avhMvcdCoW1X1qdV5wGzltYwxA9KjVYE6cxCBXJ0TWTiy",
+	"0ng+R1sFdXUmDWZ6LegBdTXgUI7L0hF2n5ss84JgPiO0F9TijB/tNwfP2bFIKQJsymEsQWDWJAp5RA6z",
+	"Ceih6YPSQ8dpkFAnv8Trw1e2nY6e5aFu9o4dV0jcyiXVuX4rRxgPLy1I3tlxVUmo6puNWk1mT05/LFlB",
+	"PslmNtJaGdpRSCIPKyOPSfsBnY8VyhUbiNVANYU0WVwFmVJdKTZLIMxPKUS1gn6ZUPt/CWCZLuzcIu80",
+	"9OSAd4HgIfZc1kM3dEkOhZxcNfnrHlYMcpVx13G1rqXNObGXyUJaJMliCjGkBfoUzNxTBa39I9x0t00c",
+	"0mR7MkvR5pHfI679D0jb04rkYdvxbK0pQipukgen4mnMx/o9Zxz/jqJ6lFiSlGIqerZChdiqJTC8WlWX",
+	"80Yb/nr5FLibSMq5R6pll1i3mSvnKYeMft+XVjrIA5tK/ac6wKOqoi9YIX+M5Nuge3lXhWofMYpN+bri",
+	"qD4fpoektBFcH4PqQ8y8nFw46Ayw3wWE+omhXjALvU/1sPY7LuRA/b7GoZdpvzxW1p6/37rOidvzA1Vo",
+	"sVke+W67hyXtrMXeVJPmH/SIcmknzYV01DitqufDt2wXKUOhNN1dUNtD9LFId3TBvfoUfxMkDfOKS80j",
+	"0csyD2TwcL9I22LIN2jOBxGF1a/70QZhLVkoXkDqShBUeaoIvBGPvZaNqu87BaP6zaOXijIGijl84pdo",
+	"9ngDZSwR04Zej65Mx8rW+pvIBj/U2SY4FJR0jtWVeuK+LzaNXlCootQRl5dSQDrJE3lsmTKqR13NEFqf",
+	"juQrHy0/uKU7CA8lyoQ0fsimQK1q4WJPFaJ4xR1IUOWAqyPVt3DPKXeeldvCFyAYz019JmuB5MSvNirp",
+	"ftaUmJf8WOyM3gZ/Q+NW5yu9mV11cXhBBUHeX+JPB9cGCz+w9E3zlbNVvMJvQdQboZEeAE3WBt5NWBHT",
+	"LBlZZbqAwVPAK7YyOI4p8pBIgMADDwnFWJwkaoExCO6k8nTZR8kvu+0hFJDqqbEyzfR4faSYIgbiKMgM",
+	"yOdmtHIL1rE62i7JJqwg3u/XqKpzg2vVB8bGPUr7YNzU84tFlDz1hBR01uoN3JXvMkCug72pudM7vqDt",
+	"C9lPhuIKjeKdensxbVfnABF8elnZyEjUx6O1XVEUToq+w8btFCrUfIMpORh4dXRB66K+i1NEgLkqiHpK",
+	"0PMeevGlX0/N6DlKiNwbJSnl5BarxOiqDjBtWTWuMKANn3cUSvs8mJZqNh+42Awoo6ygoDr0Hu1LcE1u",
+	"hx7+PmIjnXfAW3nSfyoYAVpl1MjYXOEeEnsfrDIJxnysT/aB9jfrjDsKRXFH7q2CVl3NQ04nCbq1MAnq",
+	"2IfUhYEDXLhp1femZQhJB7rzlC5TaucxoMYfQaOcF1g0ybQd6RExC66yhPgFTdmqlTLUwNjMiq2T4rdU",
+	"2HhKaJ4/4Zu33aOHIuT4gxYGm0iscCSxcqZqWqu0nBM7n9Ra+Vm0/b4v024dmdvyX8UGDUCuUS63lO89",
+	"xBK+XomW+tBWNsfOXAaMkTnKhhWFHutzd/8nkGdvcK0fCBJMQOfn5hTVhJ/YEUcDilGoDJk+4hv7bun8",
+	"3Fy63J66Qt8pncSA7Srcll/A3Qv4AEvNzinqetEjR4RiZbangebc6fGbfiQ0Rz2pXa7wtzEv1macFnVb",
+	"55teBCU1EQilOhn7hp/URy+bwvPEAXBY59KIyq35IwI/7H0hYJsPlJwkVjNrhEclgX5MpFpIVX6dqdNY",
+	"9+d0dQnqj7PobkbTnXAp2oYLRB9jPYwrofq3ImWeH1Qh92FDkWcHbfFURMbhmPhvM++9OwWRpXvy03IY",
+	"4WUNIlyq7jfQyXQ1w5YLO0+ECMpvYeFvazlNKyWMyPShVg/a/Jkqb+LAMzbAnHP/SqYbpjxGI6PYA+3Q",
+	"kPrb5OwHbYmtbXRm3MklFaiLewM4AWE1JZGB+Mhjas6K38N2TOpKimsGtH8Y4QPHo0vgB2Y8mCYdYpNA",
+	"Zx+xIFPrqdK5s2fxAYo8BNpUDdUhkREzShWp+OA6JEGJusYapYQs/6B/giu6hTsOC3pt9LGr1b1ZYaRv",
+	"zZSOSqnhmA5x30eyyoHtvQzn2bpDjGt8R/F+8aXXGxydTnnc6nz3yLyMzhQ0rRQ05FGwn9Rr9ImhFVNq",
+	"GLrdiLNy4EvkqR3nlDJKgQoMzDEsB1F1T2UQ2/rhN8ChYeQtst3MYlZ40jfjON+/um3sdQbHxqvZXAi4",
+	"hL5l7Pq8r353L6erCRaD9XO76hH5uW7KR49R8ZC+rV97shgE+Fg23dwOccgO1Nu4onSvKaECibNtCZ2i",
+	"d29Kbv6mRO1ce7gyppyMOgEjCkHZwEgP4rJVF5nRv+gt2OXj5kW4DgXbX3ibd9z2Yv/bBh3MRYwdhqWd",
+	"nDx1RnfuQ2MViLuJCLRDDT2wyssjGjrfUlTSW9W5dKqd1lKhQpp3cujE3auTarlIOSXAdayaXC0WZfic",
+	"5l1x4wDHWGIP5Y2mS+DCPqnsDQyA0bexKhcuTYNTUZ5vDq3CjmRbwUmcYVMluoplU5kh5PT6qkPXMLiN",
+	"UWs+8VE5l2cajrbuzgp4h2n/kGL2vsLawbxkOXFoFtH7d42CrGjcvS9heXbSH5cE2GdMN9wXFrm5uzTR",
+	"TXJIanwqGJnJI753jCXyXRfmGs3KRRCpG1qifsIGyl8K3tMtcoYO9B6Dw34nTWpipY8raH4MXfO+1yCo",
+	"rjIbY6jufHtSa+CcMnrkBWbEYCgQC4Zov4HcSPkjf+fALtth33GKF5qMJfWB2qbpY7yK+BCHpV9cm/Gv",
+	"LQpPtgnEKS7ka9ssI0RwuPFSvmMoeexocR/YyGarjYVU/WX3AY8d5n37utJzyb0e+SOltApGuhAr2bND",
+	"cX0mK+UWLWmwG5qqCF+5HE2YWArWBsFb7tROeqkmp8Chr/hgaAiaNUxd93Q3kZ5Hen6KWxxdHxUsZWC3",
+	"3l+umMTTFSXBxxcrtRSZcRpHdP2Jj3O38h5J9cTyLkzhqGPzcujNyygqvbhRaZ2elX2qRr+j0KuDVkMB",
+	"RwlvPYxSIiZdSsPoi0nJUpimgh6TVZBU2gIED3AWmGJ5UqJmcEFV1sAlGuZqwhyFCZPNUsowyX3SwuaC",
+	"bccr+6eiSEDPUhGekrDcA95WQeoMlxJ63hJYaytqsdTLxGL/mwXV1NgVZ6MDV6Nf8ukHsjMnSmO2jq2H",
+	"Xou34MxmB/mdU7r+MpI2arS4nce3nh9Nr3CPTXo7InMjG6XWYnW+PYkci12l4aPJ7Big/TmLDMtOJziJ",
+	"CNLXQ8rDI/89sOa6lwMQoU25PuKF/4/JTNjwiqDHQBe+hkQDmCMp61DRliad7zpVLBvuLWu4s0bn5y6k",
+	"2GmdnMeKaV+7/O4UROXWEGNA6yjXRKF2TX8v7g77ptNrYEuXDsn120C0rfzlKjHoECnYNlish6plwJbV",
+	"vVP/ZQPDycZ4bmHF0QoQnQ3tCtiMNM4MCNKxvcB1KJbGMWL1I0/gvHoA4/h/vRPHukHheQW98JNLM/1A",
+	"R71rtzNGHY24s0DL9wmzpEscY+rXpKEeRPqQDqg5210wYzjrsz8/IBq4gZgL4bw2DawoX3XykgN3e8+E",
+	"HLHUW9RwF3Ae2snI9CAW0abX4y+81ZpEklW0AQQ/Q2r5qdrYuu1xnOIzFGzk/P8h6ARgQfV0MyL0EfqE",
+	"9utjl9ihUykL1eVBdwVsqLtCk0L6dgX1UQ3Fbe5vCOs5ma7w9esZVTIzsP5Hxubu79UHF+P4xvPjCMT5",
+	"bN7+wSXaROt4Z2kvbOU3sX6R5IpraDCpf0ilyxMm6TtRp2wSEZHdwEQxo92DINmKRtNuULn9dxrey0B7",
+	"qV9MP5OxGG0VtFiD/P9A2bM1Z3wqKIbQZYu6H/sLPrWnOVTLsEVN35+i7FJYtOSsxmfQ0sjXW1eqLWx7",
+	"ma+yfjDLRe3d7W4vVpGfFSlk6u4Y7L00WZ3Wf52DIp3K/0k/Jq1WdaGeVsar4XMkg9o7EGmRs++79yP1",
+	"fTiK7Pq+4g0+Z/BpDBiZk3sVp5X2113nKKSeFu98u4w4Qeee3KUyatMxYacvEfh/hq3YwGavMup5zzkB",
+	"ivS0V6JWe10qz8MTRKPEVKm93gq90lO0hFYHTBDnTZYljM5wCZQwaxyBV4LdLOxYl7FVab2zJKT6hF4n",
+	"8fEp+4flZrXRRAruKf3v3xVpQCdJWgc2RXCWJijZpQjqZPbj+/ra9KnInGvVpWrOpJeSj6pLct6vTU+X",
+	"TyxV6/jTGT2rqjAmC1CKVWb6pZpQRjgLK/AO+0QtXlfcGA0ENhD8La1BdJbRSTbm51tp3izVvKaZee2n",
+	"kwMn+1qykB6XzE+4ojbfro9UW2tVvni9QqWqQvpTCZXTYT+7mGYAGZ7vTCs0u20w6TkJ94SefJvSOFmN",
+	"0/Cw9G2uKYoKg/q1hgEpvBfg921NASYO28zBRV47hVa/Eo01tzsKlzX8xmtUhyIYtKljQzBryq9RvRFX",
+	"xWD/F4Lapfaf8lt3Damo33Qw28DnYoR8GzJ+yqEaGEIv+Wiw6o+oWcNd4FuxATWGgMgA9TG3KtZ0DSqv",
+	"EENJ+2K3hmGjo1gKDEd3/wqB8fGZHauKtkcprlXKJxbTRPnUHyTNujRYkUS2FFa6FBO3uR3st3vpGoyE",
+	"qZGE/ZF0iZ9bFZJOJnbLcD4OiXJRx2KQxRz55U+qtiAfph/NpWklrUhLkFGT9ZMLoVotzqzwPwLMg57l",
+	"biZFbsHJ+WbSqXzYTH+fzrXl6r6M3mx8T86uVWyOaIcuqFFZmSjVxlMMK9mOx+bBxSW/9+QZ2QNDeZY3",
+	"YSPPwdngRfxwtvwxS81c1E8nc+3qrXQC7DQYe+MYzF2iJy7IVvCu+hKIaBSSqAyXwpOtm52fJPsMWb5j",
+	"7pmD5J4pfKKYYz17Z0oVip/+WLzmZtoGIuJPTn9sCsg/GeXY28wIGFgD8Yfl1WWnEOFEPknL2whoyLed",
+	"qyfiE7oxgyIdQ/Foye38BFvy2aGeflSfvHXnEs30ejqfNlNsKJCtYb7lRsBohsYfAO/LBPGttR6pFUt5",
+	"lGSCfVfJXDp+jEYCRu8Ws0+X7ivV+s20EnewjxMLhXv0HpaEKKMIwnMf8SE5lTYnG1nUpmY7tZsZgN7v",
+	"oEOU1aXE45j04/X6nuzF66NUMj2tlbdV1FRfUSFhMrA7KXbtgIDqkPoEuxC7OF1bq4mVOUlRzFIsuEZp",
+	"ZhVPPwXR2qGuYnAV7sBHBricXgAKy2zKi4OTU7xfVsnXgLQfZrmhV0p/BGMnawYD4OivbUorUQ2+nO6Z",
+	"6WmMOIgnrBneGzmQgCNGvP++xctMRST0yOJ8zE5neIacuYQ3XCfjRBkkv98YTthuLgdBXS/Y+qZ1M7fG",
+	"u4XEq0g22aVG9hQ6kU3bqL+P6XdvVt9ab8UWAyi7rgfzRgXq9G03XyVaG5Ie0+45MLNviXN4AY6k9t73",
+	"I+xiXlOj0pJ4c/rvPVZnZ8MckTVt5pw9OVAcsT+zCI6mR9RVZOTvwcntoUiaGT08jj57Fuhbe7E8CeeC",
+	"0Y6w7K5yFqizXGskldE4zLB4bxuZchRNCObPnA7u2F2av7fLEQLrwKdg6WUKRlKLkQX49ZWZX0NlCPYp",
+	"7FKne82JZX0rzHL7dF8hLdkbJeEApmm7XLrVqHWW0hxqsn6pktaqt8S19u1mY6msf7rRKJ28/vaF0quv",
+	"vvrzU56+dim8IuQrji3YGZZtcq5gXhgQEHoU9hN5sEJ6LouTiynxk3tdQLUtCUmviltF+7RE5gBhuCvZ",
+	"y0355HYVNch8tZYykvPvuEeaLs6mpTn5ylzrltrtVz6qtT6S0VSNA5qt1hMIIgRXCkuZ/RO+2GQ+G7My",
+	"dBhp8Rcdy4EqSFh83IcjxI0VqkTrBB4HOve7XCNDaXIq/WPilP5kRGxlSB8SXspd/nwUTmheS9CfKcfn",
+	"Lwcw6oFhsRoGPr2EWIZjcHk9oIwZawKNHi2EryybZplivPIja1ZmD7tf6fINeS+4rwxb4FO9WaL+v4om",
+	"ZdUaCxY82mxyfSWluu2B3U9YOvslv+sLknyRiwy5r2hMaC8ITmt/+bAKidKe0JtlJiSlJaZMwR6vCeUW",
+	"JFwQFZcpllg/yl0mnd6h65HodNJ6bz47Nq1nJ8vjp9rVpfSAAaoalZUeR5HGjyJpCDuwRKj7rFixF07c",
+	"oJCWO8RA1wIq3NHXbggl05qcxrDMkhj2VPrRci2pa5KTwvH2VYKFrGAIgPB0m9Sbyexd1wbilVQvRdQH",
+	"lgJ3ACVCE3wVY2onTewh/43jHJLDlgOVRKBWxbf4ZQg+8Ju/EZti10MVDjhVfl4v7iVrbQ+7at9Pvcev",
+	"yDgq8DCqHhci9QLCUYc2dQcgMdlb2+4KFwh2yH2SqU7m7DjROMHv8IjaC+z0bZLftwt7LVidKWCzZ1P2",
+	"KnWHFo+pVadrfFm/O42KwKvqHQTmuQFkMDyIye96jbYp7i41CNh/CqqllRAlZuKVR1BdnIt09LVwRNqJ",
+	"/0n7M4OwuP0lFoD+OTg/wXHTQw728FDouO+isdNsrZViKIz3eL4j70JfT138p0vF7+kujgXZaz8H0U3Q",
+	"JhgpZBrvaYXkM7sEyhk5pAFptIIpvz41w7PJ2ZCgBy6uTyFp9NyQiLsNpnUGymiSOI7hEvS3++n6NnoR",
+	"jm90R14Dhv5c3+u7ITMvh9W123bPtldr7wXoslXjYlKvNG6lzSkxvfmqPFxAAhV37b4pxIXynAqz8dyr",
+	"+grAO/Tc7h5htNKAFgz1tWEV0LXhyHtlUldwmfxaFUJE6vb1uHz2exQIIEoRH3qmLUHwxk1Qz1vhe9ZM",
+	"S0arrsnrAeBvDmEYTIWB18XguYXn91xI3C9QSu/QJh4N9Tz53Lya/wVLhqOkj4G8Qjr7scM4J+93/pIc",
+	"s+3/RKwCq7D0oAsYjrKj/+IkNBazirMU9o0YkV8Q8zxU9ijUe+75oehgQc2fY6OqsgPrndEyY5pUETF2",
+	"Dz1Lswl1a2timH6hviLzt/1uAjANMePWxbCi9LtVxbMKF/zFovJ9zPGMFCMV6clsIWDowgSYG64cIFWJ",
+	"NVX8xGOGhHkLiU16WIj4wI4KhdRiErUrF0E2BI9eCd6hnfmxXwuK1ybQilyqA2v+EalTOAYU78EZ99i7",
+	"C2u1pbTVShbSPVKUKKWLpLcbAaMT5ccHJGUrVKXUDTHQ0UN+VQ30J3/5v7HYTJPK8TH+MR5j5iAFJ2Sk",
+	"htuOS6jdx+Aoyr9JWdmmWn+TFNbMPwpTU2iQbsc3v2hr4JDXWG3u1CP1vSe30v1ao+Xoh5/q5VPV46tl",
+	"iPCAurs5+fL8Yy3zMu6O34enJ6wtsstyuocqmZKvdEIdmOXTNNO5pDbXqUnUFSVdogoz0qRlxOjm5HIq",
+	"u3/KzqloAjcng2JCk9lJlOtmZY6TKVJkW+3qErBjNZvVW0nt2Kk6Tqq8jMYvSgHp7mKTS620m8ncTXGS",
+	"pmrV+s3RinW2QzJMWTf4Al2+NU0+QYGgZ5S85vw7u7gHSwW/z6nBY9tUrsgXI5uKGUrIDb+YNFG/3aDJ",
+	"H0Eld2ZigqkWQRaVHyu4o+DPFcDIHK4wPLYFhIZ896JKISCWy1Vc9dY8MX1l+29uxtcAwAgaY3tkfQaw",
+	"a7iYOHCwanorucTA4Hn53tLJ3T9S9P5TQMRQ6z5XS3dPBf1vAT78AnnrsN4cIuf2OvZMpfg6pHShLtqh",
+	"IjV3Z8pXM5XdUGWvswRDassUhx/aXcB2H5nFC2nS3VxlBrTHaXVFVdijediZDRVpu4uNPDAYN0jUjhAU",
+	"cvIRBGUwYSVy68qdRfb7/5QssERc0IpGHyY/uzzN73R9+wnbQB/KTC5/lu7wjaYqxX8ZdwALF+nhJ109",
+	"TKAbTJP2AT39AhSWIpvIk/YtRTutukCoTkyYVs9dL0TzIA21/nQILj2svZBtbqsMQ+qY++XkzhIM5XY6",
+	"u9ho3BypKaULdPdIYFViP6CBRR7VJxbnqwXt8isow0JBqzEkZ9+dQRFjjN0kMsr3jdEe1eZYNYVxGif6",
+	"9QdYb3bt/G+uXnr3xocfXHrrnffe++WHM5cuXL90o4yv1aTcdKHCVpHkb0APTjlS1cl6TffnlKzDTNQo",
+	"rd5Kr+GWXbolxS7HSL5z9fyFqZl3zp997XU1nq4/HuTUkRGzuxpJzM8J0AKfa/o66eR8hvtEFCfEPNTD",
+	"VVamF5lijfH99RRNYWqmulBP2p1mOga54OQtr7OwscD9GOI+JlzMf5vVQHh4uIzhmQOJrevjQezvIa2w",
+	"DcsiPNKB9vY6FJdWT2y2oBB+1YBIt52WDw7eaYgXHcXE/fDwmDoj+yozYU3RGbFl25pAzyFeXUtG51EQ",
+	"A5Hcjms6+TFz5Tw6F+uAxSJsmJU1HWi8FfRGE0u8WXr/xgWHTRwZh6mf5iaYn6FuL0VfKrMNKyy6UGsY",
+	"2AUHWdCAm3kVGRiC0QN5BvWJsK+EVECL7a0l+cYD8iRIk0GmeA12Zr1EpvNLDhwilofoUPL5coESCENc",
+	"9ERnDUHTAOZN/GaTHIHfiP9NXb06dfFivLMDjPvVadWaDh4/DEt6hZ6OtYCYbzaWsm2RuEdKmkrx2X/+",
+	"7W8rH5/7ZEr+56z6zz8UYk/4PkTtjbMQVnfTgaE7ElOOr1BPQX5klNFwakhBja1JuzHxFdnPXJIRxONG",
+	"GROuISaUiqTi43TkQOpIRwNDf0ChfxsjonXRuUZw9AYa9LxKZSHzQ6f7Bpv/WDPN5Hum9WPw7FMlLvR1",
+	"koJboTqJDHDNr+Mwj3+ualsU/8xj1c+dWkzIWhAE3obKHLuIECPSfd2Fw7CegfKbufJe2ctbS+MJbS8o",
+	"xDm0E0VP4B3PiMAcVD92GtoAG5PRbmwI4eU1dS+XQ/I7QP8A9nsFr/UgRXJgA48zWhFN2qHFaOthMDrv",
+	"zZjWWfumUNRLRlcoR4VjRMwxIsM5ec7Wnfrc6bnFpJ6JXWUPuYd0d88qsSw5coS9oAycDf92TxVw9bxG",
+	"YXSA4JIP3dc+w5g3ZjX78FXq7jlg1gS6jFJrnQ2iITPvDgZPjLo9OCUD1QPtHqkaYHoFsnIhJvdhLlvw",
+	"DOh8Bi1pkJ5A2mjocYPmek3x18gz9QT4/X+ZzN9MOGJcbz2mS1a0Hh6ELIz19KP2hU6z1WiyvIPUJgxI",
+	"gUtKz8EQKeJmBQ/YzqQkC3le4DdmsBGV6jV/Veo6FJt4py/cbKdJmFVusdONuTytan0uLUgqVa23Xz93",
+	"opzdGWz0Rm5MHchozdzOTE+km5t4TF47t33t7Qri9HaaVo7duQm7cy905jQUNkbHY5PdqeRWUq0ls9Wa",
+	"bFm4Z4Xv8fcpbJ2v9U9a3C1rRO7yHLObxD/X1+XC9IZT+2QqCoyY/B3TlgIu9ASutPs805OHpI6ItR37",
+	"KYEYmbaYzGshLXIIrMCbJYzUP2PtImTspQeLTXs1L7gbpodgCvVxPm9JGB7/ihwF2D3kjBfPfiKs6OrO",
+	"RkZvbPsxoD+OLdKxRZpct/FAvI7N076Zp2JWwrFZCm55+mP1rxuNm2l9pN5CHr4IxXoFmbkp9ArtNBUE",
+	"kugD2cbl0O/YjkXolPYm5SmsZlAanm5Ml13AZ/dB6GMfBA3bCTOiLFchQDMrhWGZ/6YmHcWZ8jAbZ+0P",
+	"TVcfb/LHUMychJKW726Y1zpcJSzaZA9Cek9sZqOm0i+kLU7LU4ONTUfprRBqDevFGVBtRW1Ko3fOsUOw",
+	"ivG5ldIZjDG+VsK2cjLw6bT6NU/0QHqYgyJm1TedTiwcPIA6Az/D0Sv+1SGSnjH5M0LLbvtoWej7/sh6",
+	"BoMav251zD2UWmlfigavo6DxIq8WvMvp9oPrfJAxxhAprbYYulWvYAemZ/LYlSeKJyy6bqEodo81OaPJ",
+	"DxwhmEn4qgAdnFDRpfcBytQhsUBZ+ruYxWlXlw/O3Fi0lNJXhVbvSHsWgO/XQl3OUPcI6/N3+yHPMTyB",
+	"qAOr7Sz+xUA1Icnkx1C+zzNKBtWn0uAQQkGI3yac+RUC+1nXhiyLFdqrh3gL1nMKQevV5cNqrsI7/je4",
+	"TjlrVPZKFdAF9mrHVOUZsBFB+Eyv0u79GPTvciUVR00cz7k7U79M72TORlz4r6T1BbEUb7x+7iAL+MWO",
+	"RtSnjGlsYndne6oHZ4JjQ7MPXUSWIf9Eu0V9vgoemUnb7CKTCEd/bK6Pjrl+4NkAzaEeF85DZMQLW0XH",
+	"omMbvqwqua9kp0wLhunAvfFmZNfTlnVzuKFGqLtqmBrlQZbgmbJk1FhKt4qVwXW8dfVM3QNWVsitnEJ8",
+	"2QogThgGV6re6gfuTFnvNlBdyN0HSCkoSWofyORFbJneDisKbLpUMxXpLDwOHA/QZNvUUxN7hGw6PFfQ",
+	"tteCB595rQSgS7nWEsxPLeWgBa/N5a0E3OkQRWCZFyjNA1URKDsZAi8etX0t6z6ptsMDG0kZIdNFBPtD",
+	"UT/EsCrL9TMut1qd9K1aYxZ7z+1XY1P9gqzSs78GHVGgHboi6pX7tPtZmTbI3x6799lBlp5Za5erbamo",
+	"/rnVyW0D2xU/JEzGobk+lonDEXrQq3QNSjAcSGi2h0ZfQW5fYMVTiPbRG4YHO7JpYoSvTb96ABP//wJt",
+	"pUcxJApLzKUpZs0+1M0cXtwVw9vgi1ikMWAH2KRG5TwdYikdpF9VQuH8tcuBL18ugRCsa6/Qq73UhDZ2",
+	"U9Z+6ddT4mHSjy+XNI+q1He7n9l5CnW9cwpRH8tqIYQ1bQDuaYVlHLxdF294vxCh2Lfm3THMdDw16Xaa",
+	"y8dELwmhWhwPFn3QeGi9gEc5z3EQFUZ/xZC1I/auzEsx1jJ/mOGdPZ1N5BWAfMUn/z/cxFKM26ACAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,97 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/tenant"
+
+	"github.com/robfig/cron/v3"
+)
+
+// absenceHandoverSchedule runs the handover every ten seconds.
+const absenceHandoverSchedule = "*/10 * * * * *"
+
+// absenceHandoverInterval is the interval of absenceHandoverSchedule.
+const absenceHandoverInterval = 10 * time.Second
+
+// AbsenceHandoverJob manages the scheduled handover of orders from absent couriers to their substitutes.
+// Runs every ten seconds, so orders pass to the substitute shortly after an absence starts.
+type AbsenceHandoverJob struct {
+	handler   commands.HandOverAbsentCourierOrdersCommandHandler
+	tenants   Tenants
+	cron      *cron.Cron
+	heartbeat *Heartbeat
+	logger    *slog.Logger
+}
+
+// NewAbsenceHandoverJob creates a new job for handing over the orders of absent couriers.
+func NewAbsenceHandoverJob(
+	handler commands.HandOverAbsentCourierOrdersCommandHandler,
+	tenants Tenants,
+	logger *slog.Logger,
+) *AbsenceHandoverJob {
+	return &AbsenceHandoverJob{
+		handler:   handler,
+		tenants:   tenants,
+		heartbeat: NewHeartbeat(),
+		logger:    logger.With("component", "absence_handover_job"),
+	}
+}
+
+// Name returns "absence_handover_job".
+func (j *AbsenceHandoverJob) Name() string {
+	return "absence_handover_job"
+}
+
+// Interval returns ten seconds, the job's tick interval.
+func (j *AbsenceHandoverJob) Interval() time.Duration {
+	return absenceHandoverInterval
+}
+
+// Heartbeat returns the heartbeat beaten by every completed tick.
+func (j *AbsenceHandoverJob) Heartbeat() *Heartbeat {
+	return j.heartbeat
+}
+
+// Start begins the absence handover job to run every ten seconds.
+func (j *AbsenceHandoverJob) Start() error {
+	cmd := commands.NewHandOverAbsentCourierOrdersCommand()
+
+	j.cron = cron.New(cron.WithSeconds())
+	_, err := j.cron.AddFunc(absenceHandoverSchedule, func() {
+		defer j.heartbeat.Beat()
+
+		j.tenants.each(j.heartbeat.Context(), func(ctx context.Context, id tenant.ID) {
+			started := time.Now()
+			report, handleErr := j.handler.Handle(ctx, cmd)
+			observeCommand("hand_over_absent_courier_orders", started, handleErr)
+			if handleErr != nil {
+				j.logger.ErrorContext(ctx, "Absence handover job failed", "tenant", id.String(), "error", handleErr)
+				return
+			}
+			if len(report.HandedOver) > 0 || len(report.Kept) > 0 {
+				j.logger.InfoContext(ctx, "Absent courier orders handed over", "tenant", id.String(),
+					"handed_over", len(report.HandedOver), "kept", len(report.Kept))
+			}
+		})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	j.cron.Start()
+	j.logger.InfoContext(context.Background(), "Absence handover job started (running every 10 seconds)")
+	return nil
+}
+
+// Stop stops the absence handover job.
+// Returns a context that is done once the tick running at the time has finished.
+func (j *AbsenceHandoverJob) Stop() context.Context {
+	stopped := j.cron.Stop()
+	j.logger.InfoContext(context.Background(), "Absence handover job stopped")
+	return stopped
+}
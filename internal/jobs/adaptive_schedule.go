@@ -0,0 +1,95 @@
+package jobs
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// ErrTickBoundsAreInvalid is returned when the adaptive tick bounds are not positive
+// or the minimum interval exceeds the maximum.
+var ErrTickBoundsAreInvalid = errors.New("tick bounds are invalid")
+
+// TickBounds limits how fast and how slow an adaptive job may tick.
+type TickBounds struct {
+	min time.Duration
+	max time.Duration
+}
+
+// NewTickBounds creates tick bounds from the shortest and longest allowed intervals.
+// Both intervals must be positive and minInterval must not exceed maxInterval.
+func NewTickBounds(minInterval, maxInterval time.Duration) (TickBounds, error) {
+	if minInterval <= 0 || maxInterval < minInterval {
+		return TickBounds{}, fmt.Errorf("%w: min %s, max %s", ErrTickBoundsAreInvalid, minInterval, maxInterval)
+	}
+
+	return TickBounds{min: minInterval, max: maxInterval}, nil
+}
+
+// Min returns the shortest allowed interval between ticks.
+func (b TickBounds) Min() time.Duration {
+	return b.min
+}
+
+// Max returns the longest allowed interval between ticks.
+func (b TickBounds) Max() time.Duration {
+	return b.max
+}
+
+// AdaptiveSchedule is a cron.Schedule whose interval follows the size of a backlog.
+// An empty backlog slows the job down to the maximum interval; every queued item
+// shortens it proportionally (max / (backlog + 1)) down to the minimum interval.
+//
+// The schedule is safe for concurrent use: cron reads it from its scheduler goroutine
+// while the job adapts it from the job goroutine. A new interval takes effect from
+// the next scheduled tick.
+//
+// Example:
+//
+//	bounds, _ := NewTickBounds(200*time.Millisecond, 2*time.Second)
+//	schedule := NewAdaptiveSchedule(bounds)
+//	c.Schedule(schedule, cron.FuncJob(func() {
+//	    // ... do work, then
+//	    schedule.Adapt(queuedOrders)
+//	}))
+type AdaptiveSchedule struct {
+	bounds   TickBounds
+	interval atomic.Int64
+}
+
+// NewAdaptiveSchedule creates a schedule that starts at the maximum interval.
+func NewAdaptiveSchedule(bounds TickBounds) *AdaptiveSchedule {
+	schedule := &AdaptiveSchedule{bounds: bounds}
+	schedule.interval.Store(int64(bounds.Max()))
+	return schedule
+}
+
+// Next returns the time of the tick following t.
+func (s *AdaptiveSchedule) Next(t time.Time) time.Time {
+	return t.Add(s.Interval())
+}
+
+// Interval returns the current interval between ticks.
+func (s *AdaptiveSchedule) Interval() time.Duration {
+	return time.Duration(s.interval.Load())
+}
+
+// Adapt recalculates the interval for the given backlog size and returns it.
+// Negative backlogs are treated as empty.
+func (s *AdaptiveSchedule) Adapt(backlog int) time.Duration {
+	interval := s.bounds.Max() / time.Duration(max(backlog, 0)+1)
+	interval = min(max(interval, s.bounds.Min()), s.bounds.Max())
+
+	s.interval.Store(int64(interval))
+	return interval
+}
+
+// Restore sets the interval to a previously observed one, clamped to the bounds, and returns it.
+// It lets a restarted job resume at the pace it had instead of starting at the maximum interval.
+func (s *AdaptiveSchedule) Restore(interval time.Duration) time.Duration {
+	interval = min(max(interval, s.bounds.Min()), s.bounds.Max())
+
+	s.interval.Store(int64(interval))
+	return interval
+}
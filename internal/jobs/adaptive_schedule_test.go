@@ -0,0 +1,96 @@
+package jobs_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/jobs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTickBounds_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		min, max time.Duration
+	}{
+		{"zero min", 0, time.Second},
+		{"negative min", -time.Second, time.Second},
+		{"min above max", 2 * time.Second, time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := jobs.NewTickBounds(tt.min, tt.max)
+
+			require.ErrorIs(t, err, jobs.ErrTickBoundsAreInvalid)
+		})
+	}
+}
+
+func TestAdaptiveSchedule_Adapt(t *testing.T) {
+	bounds, err := jobs.NewTickBounds(200*time.Millisecond, 2*time.Second)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		backlog  int
+		expected time.Duration
+	}{
+		{"idle slows down to max", 0, 2 * time.Second},
+		{"negative backlog treated as idle", -3, 2 * time.Second},
+		{"small backlog speeds up proportionally", 3, 500 * time.Millisecond},
+		{"large backlog is capped at min", 100, 200 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := jobs.NewAdaptiveSchedule(bounds)
+
+			interval := schedule.Adapt(tt.backlog)
+
+			assert.Equal(t, tt.expected, interval)
+			assert.Equal(t, tt.expected, schedule.Interval())
+		})
+	}
+}
+
+func TestAdaptiveSchedule_Next(t *testing.T) {
+	bounds, err := jobs.NewTickBounds(100*time.Millisecond, time.Second)
+	require.NoError(t, err)
+	schedule := jobs.NewAdaptiveSchedule(bounds)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, now.Add(time.Second), schedule.Next(now))
+
+	schedule.Adapt(4)
+
+	assert.Equal(t, now.Add(200*time.Millisecond), schedule.Next(now))
+}
+
+func TestAdaptiveSchedule_Restore(t *testing.T) {
+	bounds, err := jobs.NewTickBounds(200*time.Millisecond, 2*time.Second)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		interval time.Duration
+		expected time.Duration
+	}{
+		{"within bounds", 500 * time.Millisecond, 500 * time.Millisecond},
+		{"below min is clamped", time.Millisecond, 200 * time.Millisecond},
+		{"above max is clamped", time.Minute, 2 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := jobs.NewAdaptiveSchedule(bounds)
+
+			interval := schedule.Restore(tt.interval)
+
+			assert.Equal(t, tt.expected, interval)
+			assert.Equal(t, tt.expected, schedule.Interval())
+		})
+	}
+}
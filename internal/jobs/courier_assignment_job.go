@@ -3,56 +3,243 @@ package jobs
 import (
 	"context"
 	"errors"
+	"expvar"
+	"fmt"
 	"log/slog"
+	"time"
 
 	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/domain/services"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/metrics"
+	"delivery/internal/pkg/tenant"
 
 	"github.com/robfig/cron/v3"
 )
 
+// assignmentTickRate exposes the current courier assignment frequency on /debug/vars.
+var assignmentTickRate = expvar.NewFloat("courier_assignment_ticks_per_second")
+
+// dispatchMode exposes the current dispatch mode on /debug/vars.
+var dispatchMode = expvar.NewString("dispatch_mode")
+
+// dispatchModeSwitches counts dispatch mode switches by the mode switched to.
+var dispatchModeSwitches = expvar.NewMap("dispatch_mode_switches")
+
+// dispatchAttempts counts the assignment ticks by outcome on /metrics.
+var dispatchAttempts = metrics.NewCounterVec(
+	"dispatch_attempts_total",
+	"Courier assignment attempts by outcome: assigned, no_order, no_courier, no_pickup_slot or failed.",
+	"outcome",
+)
+
 // CourierAssignmentJob manages the scheduled assignment of couriers to orders.
-// Runs every second to match pending orders with available couriers.
+// Its tick frequency adapts to the number of orders waiting in Created status:
+// the job speeds up while orders accumulate and slows down when idle, within the configured bounds.
+// With a dispatch degradation, the latency of every tick and the remaining backlog switch the
+// dispatcher between full and greedy mode.
 type CourierAssignmentJob struct {
-	handler commands.AssignCourierCommandHandler
-	cron    *cron.Cron
-	logger  *slog.Logger
+	handler     commands.AssignCourierCommandHandler
+	loadReader  ports.FleetLoadReader
+	schedule    *AdaptiveSchedule
+	degradation *commands.DispatchDegradation
+	tenants     Tenants
+	cron        *cron.Cron
+	heartbeat   *Heartbeat
+	logger      *slog.Logger
 }
 
 // NewCourierAssignmentJob creates a new job for assigning couriers.
-// Uses AssignCourierCommandHandler to process courier assignments every second.
-func NewCourierAssignmentJob(handler commands.AssignCourierCommandHandler, logger *slog.Logger) *CourierAssignmentJob {
+// Uses AssignCourierCommandHandler to process courier assignments and the fleet load reader
+// to measure the order backlog after every tick. The degradation should be the one the handler
+// dispatches with; nil keeps the dispatcher in full mode. Every tick dispatches for each of the tenants.
+func NewCourierAssignmentJob(
+	handler commands.AssignCourierCommandHandler,
+	loadReader ports.FleetLoadReader,
+	bounds TickBounds,
+	degradation *commands.DispatchDegradation,
+	tenants Tenants,
+	logger *slog.Logger,
+) *CourierAssignmentJob {
 	return &CourierAssignmentJob{
-		handler: handler,
-		cron:    cron.New(cron.WithSeconds()),
-		logger:  logger.With("component", "courier_assignment_job"),
+		handler:     handler,
+		loadReader:  loadReader,
+		schedule:    NewAdaptiveSchedule(bounds),
+		degradation: degradation,
+		tenants:     tenants,
+		heartbeat:   NewHeartbeat(),
+		logger:      logger.With("component", "courier_assignment_job"),
 	}
 }
 
-// Start begins the courier assignment job to run every second.
-func (j *CourierAssignmentJob) Start() error {
-	_, err := j.cron.AddFunc("* * * * * *", func() {
-		ctx := context.Background()
-		cmd := commands.NewAssignCourierCommand()
-
-		if err := j.handler.Handle(ctx, cmd); err != nil {
-			// Only log errors that are not expected business scenarios
-			if !errors.Is(err, commands.ErrNoOrderFound) && !errors.Is(err, commands.ErrNoFreeCouriersFound) {
-				j.logger.ErrorContext(ctx, "Courier assignment job failed", "error", err)
-			}
-		}
-	})
+// Name returns "courier_assignment_job".
+func (j *CourierAssignmentJob) Name() string {
+	return "courier_assignment_job"
+}
+
+// Interval returns the slowest interval the job may tick at.
+func (j *CourierAssignmentJob) Interval() time.Duration {
+	return j.schedule.bounds.Max()
+}
 
-	if err != nil {
-		return err
+// Heartbeat returns the heartbeat beaten by every completed tick.
+func (j *CourierAssignmentJob) Heartbeat() *Heartbeat {
+	return j.heartbeat
+}
+
+// Start begins the courier assignment job at the slowest frequency.
+// Ticks are skipped while the previous one is still running.
+func (j *CourierAssignmentJob) Start() error {
+	job := cron.NewChain(cron.SkipIfStillRunning(cron.DiscardLogger)).Then(cron.FuncJob(j.tick))
+	j.cron = cron.New()
+	j.cron.Schedule(j.schedule, job)
+	assignmentTickRate.Set(ticksPerSecond(j.schedule.Interval()))
+	if j.degradation != nil {
+		dispatchMode.Set(string(j.degradation.Mode()))
 	}
 
 	j.cron.Start()
-	j.logger.InfoContext(context.Background(), "Courier assignment job started (running every second)")
+	j.logger.InfoContext(context.Background(), "Courier assignment job started",
+		"min_interval", j.schedule.bounds.Min().String(),
+		"max_interval", j.schedule.bounds.Max().String())
 	return nil
 }
 
 // Stop stops the courier assignment job.
-func (j *CourierAssignmentJob) Stop() {
-	j.cron.Stop()
+// Returns a context that is done once the tick running at the time has finished.
+func (j *CourierAssignmentJob) Stop() context.Context {
+	stopped := j.cron.Stop()
 	j.logger.InfoContext(context.Background(), "Courier assignment job stopped")
+	return stopped
+}
+
+// CurrentInterval returns the interval the job is currently ticking at.
+func (j *CourierAssignmentJob) CurrentInterval() time.Duration {
+	return j.schedule.Interval()
+}
+
+// ExportState captures the job's dispatch state so that a replacement instance can resume from it.
+func (j *CourierAssignmentJob) ExportState(now time.Time) DispatcherState {
+	return DispatcherState{
+		AssignmentInterval: j.schedule.Interval(),
+		ExportedAt:         now,
+	}
+}
+
+// ImportState resumes from a state exported by a previous instance.
+// Returns ErrDispatcherStateIsStale, leaving the job untouched, when the state is older
+// than DispatcherStateMaxAge. The restored interval is clamped to the configured bounds.
+func (j *CourierAssignmentJob) ImportState(state DispatcherState, now time.Time) error {
+	if age := now.Sub(state.ExportedAt); age > DispatcherStateMaxAge {
+		return fmt.Errorf("%w: exported %s ago", ErrDispatcherStateIsStale, age.Truncate(time.Second))
+	}
+
+	interval := j.schedule.Restore(state.AssignmentInterval)
+	assignmentTickRate.Set(ticksPerSecond(interval))
+
+	j.logger.InfoContext(context.Background(), "Courier assignment state imported",
+		"interval", interval.String(),
+		"exported_at", state.ExportedAt.Format(time.RFC3339))
+	return nil
+}
+
+// tick assigns one order of every tenant and adapts the frequency and the dispatch mode
+// to the backlog all tenants leave together.
+func (j *CourierAssignmentJob) tick() {
+	ctx := j.heartbeat.Context()
+	defer j.heartbeat.Beat()
+
+	var latency time.Duration
+	backlog, measured := 0, true
+	j.tenants.each(ctx, func(ctx context.Context, id tenant.ID) {
+		latency += j.dispatch(ctx, id)
+
+		load, err := j.loadReader.GetFleetLoad(ctx)
+		if err != nil {
+			j.logger.ErrorContext(ctx, "Courier assignment backlog check failed, keeping frequency",
+				"tenant", id.String(), "error", err)
+			measured = false
+			return
+		}
+		backlog += load.QueuedOrders
+	})
+	if !measured || ctx.Err() != nil {
+		return
+	}
+
+	previous := j.schedule.Interval()
+	interval := j.schedule.Adapt(backlog)
+	assignmentTickRate.Set(ticksPerSecond(interval))
+
+	if interval != previous {
+		j.logger.DebugContext(ctx, "Courier assignment frequency changed",
+			"queued_orders", backlog,
+			"interval", interval.String())
+	}
+
+	j.observePressure(ctx, commands.DispatchPressure{Latency: latency, Backlog: backlog})
+}
+
+// dispatch assigns one order of the tenant ctx is scoped to and returns how long it took.
+func (j *CourierAssignmentJob) dispatch(ctx context.Context, id tenant.ID) time.Duration {
+	cmd := commands.NewAssignCourierCommand()
+
+	started := time.Now()
+	err := j.handler.Handle(ctx, cmd)
+	latency := time.Since(started)
+
+	// Only errors that are not expected business scenarios are failures
+	var failure error
+	outcome := dispatchOutcome(err)
+	if outcome == dispatchFailed {
+		failure = err
+		j.logger.ErrorContext(ctx, "Courier assignment job failed", "tenant", id.String(), "error", err)
+	}
+	dispatchAttempts.Inc(outcome)
+	observeCommand("assign_courier", started, failure)
+	return latency
+}
+
+// dispatchFailed is the outcome of an assignment that failed unexpectedly.
+const dispatchFailed = "failed"
+
+// dispatchOutcome classifies the result of an assignment; expected business scenarios,
+// such as an empty queue, are not failures.
+func dispatchOutcome(err error) string {
+	switch {
+	case err == nil:
+		return "assigned"
+	case errors.Is(err, commands.ErrNoOrderFound):
+		return "no_order"
+	case errors.Is(err, commands.ErrNoFreeCouriersFound), errors.Is(err, services.ErrCourierNotFound):
+		return "no_courier"
+	case errors.Is(err, commands.ErrNoPickupSlotAvailable):
+		return "no_pickup_slot"
+	default:
+		return dispatchFailed
+	}
+}
+
+// observePressure feeds the pressure of the last tick to the dispatch degradation and reports mode switches.
+func (j *CourierAssignmentJob) observePressure(ctx context.Context, pressure commands.DispatchPressure) {
+	if j.degradation == nil {
+		return
+	}
+
+	change, switched := j.degradation.Observe(pressure)
+	if !switched {
+		return
+	}
+
+	dispatchMode.Set(string(change.To))
+	dispatchModeSwitches.Add(string(change.To), 1)
+	j.logger.WarnContext(ctx, "Dispatch mode switched",
+		"from", string(change.From),
+		"to", string(change.To),
+		"latency", change.Pressure.Latency.String(),
+		"queued_orders", change.Pressure.Backlog)
+}
+
+func ticksPerSecond(interval time.Duration) float64 {
+	return float64(time.Second) / float64(interval)
 }
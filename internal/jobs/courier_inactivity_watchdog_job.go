@@ -0,0 +1,95 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/tenant"
+
+	"github.com/robfig/cron/v3"
+)
+
+// CourierInactivityWatchdogJob manages the scheduled detection of stalled couriers.
+// Runs every second to unassign orders from couriers that stopped moving.
+type CourierInactivityWatchdogJob struct {
+	handler        commands.UnassignInactiveCouriersCommandHandler
+	thresholdTicks int
+	tenants        Tenants
+	cron           *cron.Cron
+	heartbeat      *Heartbeat
+	logger         *slog.Logger
+}
+
+// NewCourierInactivityWatchdogJob creates a new job for detecting inactive couriers.
+// thresholdTicks is the number of consecutive ticks without movement that triggers reassignment.
+func NewCourierInactivityWatchdogJob(
+	handler commands.UnassignInactiveCouriersCommandHandler,
+	thresholdTicks int,
+	tenants Tenants,
+	logger *slog.Logger,
+) *CourierInactivityWatchdogJob {
+	return &CourierInactivityWatchdogJob{
+		handler:        handler,
+		thresholdTicks: thresholdTicks,
+		tenants:        tenants,
+		heartbeat:      NewHeartbeat(),
+		logger:         logger.With("component", "courier_inactivity_watchdog_job"),
+	}
+}
+
+// Name returns "courier_inactivity_watchdog_job".
+func (j *CourierInactivityWatchdogJob) Name() string {
+	return "courier_inactivity_watchdog_job"
+}
+
+// Interval returns one second, the job's tick interval.
+func (j *CourierInactivityWatchdogJob) Interval() time.Duration {
+	return time.Second
+}
+
+// Heartbeat returns the heartbeat beaten by every completed tick.
+func (j *CourierInactivityWatchdogJob) Heartbeat() *Heartbeat {
+	return j.heartbeat
+}
+
+// Start begins the courier inactivity watchdog job to run every second.
+// Returns an error if the configured threshold is invalid.
+func (j *CourierInactivityWatchdogJob) Start() error {
+	cmd, err := commands.NewUnassignInactiveCouriersCommand(j.thresholdTicks)
+	if err != nil {
+		return err
+	}
+
+	j.cron = cron.New(cron.WithSeconds())
+	_, err = j.cron.AddFunc("* * * * * *", func() {
+		defer j.heartbeat.Beat()
+
+		j.tenants.each(j.heartbeat.Context(), func(ctx context.Context, id tenant.ID) {
+			started := time.Now()
+			handleErr := j.handler.Handle(ctx, cmd)
+			observeCommand("unassign_inactive_couriers", started, handleErr)
+			if handleErr != nil {
+				j.logger.ErrorContext(ctx, "Courier inactivity watchdog job failed", "tenant", id.String(), "error", handleErr)
+			}
+		})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	j.cron.Start()
+	j.logger.InfoContext(context.Background(), "Courier inactivity watchdog job started (running every second)",
+		"threshold_ticks", j.thresholdTicks)
+	return nil
+}
+
+// Stop stops the courier inactivity watchdog job.
+// Returns a context that is done once the tick running at the time has finished.
+func (j *CourierInactivityWatchdogJob) Stop() context.Context {
+	stopped := j.cron.Stop()
+	j.logger.InfoContext(context.Background(), "Courier inactivity watchdog job stopped")
+	return stopped
+}
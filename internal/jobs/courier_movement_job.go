@@ -3,39 +3,73 @@ package jobs
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/tenant"
 
 	"github.com/robfig/cron/v3"
 )
 
+// CourierMovementInterval is the wall-clock length of a courier turn: couriers make one move per tick.
+const CourierMovementInterval = time.Second
+
 // CourierMovementJob manages the scheduled movement of couriers.
 // Runs every second to update courier positions and complete deliveries.
 type CourierMovementJob struct {
-	handler commands.MoveCouriersCommandHandler
-	cron    *cron.Cron
-	logger  *slog.Logger
+	handler   commands.MoveCouriersCommandHandler
+	tenants   Tenants
+	cron      *cron.Cron
+	heartbeat *Heartbeat
+	logger    *slog.Logger
 }
 
 // NewCourierMovementJob creates a new job for moving couriers.
 // Uses MoveCouriersCommandHandler to process courier movements every second.
-func NewCourierMovementJob(handler commands.MoveCouriersCommandHandler, logger *slog.Logger) *CourierMovementJob {
+func NewCourierMovementJob(
+	handler commands.MoveCouriersCommandHandler,
+	tenants Tenants,
+	logger *slog.Logger,
+) *CourierMovementJob {
 	return &CourierMovementJob{
-		handler: handler,
-		cron:    cron.New(cron.WithSeconds()),
-		logger:  logger.With("component", "courier_movement_job"),
+		handler:   handler,
+		tenants:   tenants,
+		heartbeat: NewHeartbeat(),
+		logger:    logger.With("component", "courier_movement_job"),
 	}
 }
 
+// Name returns "courier_movement_job".
+func (j *CourierMovementJob) Name() string {
+	return "courier_movement_job"
+}
+
+// Interval returns CourierMovementInterval, the job's tick interval.
+func (j *CourierMovementJob) Interval() time.Duration {
+	return CourierMovementInterval
+}
+
+// Heartbeat returns the heartbeat beaten by every completed tick.
+func (j *CourierMovementJob) Heartbeat() *Heartbeat {
+	return j.heartbeat
+}
+
 // Start begins the courier movement job to run every second.
 func (j *CourierMovementJob) Start() error {
+	j.cron = cron.New(cron.WithSeconds())
 	_, err := j.cron.AddFunc("* * * * * *", func() {
-		ctx := context.Background()
-		cmd := commands.NewMoveCouriersCommand()
+		defer j.heartbeat.Beat()
+
+		j.tenants.each(j.heartbeat.Context(), func(ctx context.Context, id tenant.ID) {
+			cmd := commands.NewMoveCouriersCommand()
 
-		if err := j.handler.Handle(ctx, cmd); err != nil {
-			j.logger.ErrorContext(ctx, "Courier movement job failed", "error", err)
-		}
+			started := time.Now()
+			handleErr := j.handler.Handle(ctx, cmd)
+			observeCommand("move_couriers", started, handleErr)
+			if handleErr != nil {
+				j.logger.ErrorContext(ctx, "Courier movement job failed", "tenant", id.String(), "error", handleErr)
+			}
+		})
 	})
 
 	if err != nil {
@@ -48,7 +82,9 @@ func (j *CourierMovementJob) Start() error {
 }
 
 // Stop stops the courier movement job.
-func (j *CourierMovementJob) Stop() {
-	j.cron.Stop()
+// Returns a context that is done once the tick running at the time has finished.
+func (j *CourierMovementJob) Stop() context.Context {
+	stopped := j.cron.Stop()
 	j.logger.InfoContext(context.Background(), "Courier movement job stopped")
+	return stopped
 }
@@ -0,0 +1,72 @@
+package jobs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DispatcherStateMaxAge is how long an exported dispatcher state stays usable.
+// Older state describes a backlog that has long changed and is discarded on import.
+const DispatcherStateMaxAge = 5 * time.Minute
+
+// ErrDispatcherStateIsStale is returned when importing a state older than DispatcherStateMaxAge.
+var ErrDispatcherStateIsStale = errors.New("dispatcher state is stale")
+
+// DispatcherState is the in-memory dispatch state a restarted instance can resume from.
+// Dispatch itself reads couriers and orders from the database on every tick, so the only
+// state worth carrying over is the pace the assignment job had adapted to.
+type DispatcherState struct {
+	// AssignmentInterval is the interval the assignment job was ticking at.
+	AssignmentInterval time.Duration `json:"assignment_interval"`
+	// ExportedAt is when the state was captured.
+	ExportedAt time.Time `json:"exported_at"`
+}
+
+// SaveDispatcherState writes the state to path as JSON.
+// The file is replaced atomically, so a crash while saving never leaves a truncated state behind.
+func SaveDispatcherState(path string, state DispatcherState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("encode dispatcher state: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("save dispatcher state: %w", err)
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("save dispatcher state: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("save dispatcher state: %w", err)
+	}
+
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("save dispatcher state: %w", err)
+	}
+	return nil
+}
+
+// LoadDispatcherState reads a state written by SaveDispatcherState.
+// Returns an error wrapping os.ErrNotExist when no state was saved yet.
+func LoadDispatcherState(path string) (DispatcherState, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return DispatcherState{}, fmt.Errorf("load dispatcher state: %w", err)
+	}
+
+	var state DispatcherState
+	if err = json.Unmarshal(data, &state); err != nil {
+		return DispatcherState{}, fmt.Errorf("decode dispatcher state: %w", err)
+	}
+	return state, nil
+}
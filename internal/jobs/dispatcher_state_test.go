@@ -0,0 +1,79 @@
+package jobs_test
+
+import (
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/jobs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAssignmentJob(t *testing.T) *jobs.CourierAssignmentJob {
+	t.Helper()
+	bounds, err := jobs.NewTickBounds(200*time.Millisecond, 2*time.Second)
+	require.NoError(t, err)
+
+	return jobs.NewCourierAssignmentJob(commands.AssignCourierCommandHandler{}, nil, bounds, nil, jobs.Tenants{}, slog.Default())
+}
+
+func TestDispatcherState_SaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dispatcher-state.json")
+	state := jobs.DispatcherState{
+		AssignmentInterval: 400 * time.Millisecond,
+		ExportedAt:         time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	require.NoError(t, jobs.SaveDispatcherState(path, state))
+	loaded, err := jobs.LoadDispatcherState(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, state.AssignmentInterval, loaded.AssignmentInterval)
+	assert.True(t, state.ExportedAt.Equal(loaded.ExportedAt))
+
+	// Saving again replaces the file and leaves no temporary files behind
+	require.NoError(t, jobs.SaveDispatcherState(path, state))
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestLoadDispatcherState_Missing(t *testing.T) {
+	_, err := jobs.LoadDispatcherState(filepath.Join(t.TempDir(), "missing.json"))
+
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestCourierAssignmentJob_ExportImportState(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should resume at the exported interval", func(t *testing.T) {
+		previous := newTestAssignmentJob(t)
+		require.NoError(t, previous.ImportState(jobs.DispatcherState{
+			AssignmentInterval: 250 * time.Millisecond,
+			ExportedAt:         now,
+		}, now))
+		state := previous.ExportState(now)
+
+		restarted := newTestAssignmentJob(t)
+		err := restarted.ImportState(state, now.Add(time.Minute))
+
+		require.NoError(t, err)
+		assert.Equal(t, 250*time.Millisecond, restarted.CurrentInterval())
+	})
+
+	t.Run("should reject stale state", func(t *testing.T) {
+		job := newTestAssignmentJob(t)
+		state := jobs.DispatcherState{AssignmentInterval: 250 * time.Millisecond, ExportedAt: now}
+
+		err := job.ImportState(state, now.Add(jobs.DispatcherStateMaxAge+time.Second))
+
+		require.ErrorIs(t, err, jobs.ErrDispatcherStateIsStale)
+		assert.Equal(t, 2*time.Second, job.CurrentInterval())
+	})
+}
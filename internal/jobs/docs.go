@@ -5,32 +5,173 @@
 //
 // # Available Jobs
 //
-// 1. CourierAssignmentJob - Runs every second to assign pending orders to available couriers
+// 1. CourierAssignmentJob - Assigns pending orders to available couriers at an adaptive frequency
 // 2. CourierMovementJob - Runs every second to move couriers toward their destinations and complete deliveries
+// 3. CourierInactivityWatchdogJob - Runs every second to unassign orders from couriers that stopped moving
+// 4. SyntheticDataJanitorJob - Runs every ten minutes to purge test data older than its TTL (optional)
+// 5. ShiftEndHandoverJob - Runs every ten seconds to hand over orders that would outlast the shift (optional)
+// 6. OrderBatchingJob - Runs every ten seconds to release economy orders whose batching window has closed
+// 7. AbsenceHandoverJob - Runs every ten seconds to hand over the orders of absent couriers to their substitutes
+// 8. MicrozoneClusteringJob - Runs nightly to recompute dense-demand microzones from the delivery history
+// 9. SurgeModeJob - Runs every thirty seconds to switch the surge mode by the backlog while it is set to auto
+// 10. SLAComplianceJob - Runs nightly to measure the deliveries of the day before against the SLA targets
+// 11. OutboxRelayJob - Runs every second to publish the transactional outbox to Kafka (optional)
+// 12. OrphanedBlobJanitorJob - Runs hourly to remove uploaded files no feature attached (optional)
+// 13. SLOMonitorJob - Runs every minute to expose the assignment and delivery latency objectives and alert on burn rates
+// 14. ProjectionJob - Runs every five seconds to project new changes into the read models such as the order history
+// 15. OrderArchivalJob - Runs nightly to move long completed orders to the order archive (optional)
+// 16. OrderLifecycleWatchdogJob - Runs every minute to cancel orders left in Created beyond their tenant's timeout (optional)
+// 17. UrgentOrderEscalationJob - Runs every minute to escalate urgent orders left waiting for a courier (optional)
 //
 // # Usage
 //
 // Jobs are managed through JobManager which provides a unified interface:
 //
 //	// Create job manager with required handlers
-//	jobManager := jobs.NewJobManager(moveCouriersHandler, assignCourierHandler, logger)
+//	jobManager := jobs.NewJobManager(
+//		moveCouriersHandler,
+//		assignCourierHandler,
+//		unassignInactiveCouriersHandler,
+//		inactivityThresholdTicks,
+//		fleetLoadReader,
+//		assignmentTickBounds,
+//		dispatchDegradation, // nil keeps the dispatcher in full mode
+//		releaseOrderBatchesHandler,
+//		handOverAbsentCourierOrdersHandler,
+//		recomputeMicrozonesHandler,
+//		observeSurgeDemandHandler,
+//		computeSLAComplianceHandler,
+//		sloStatusHandler,
+//		sloAlert, // nil when burning objectives are only logged
+//		purgeSyntheticDataHandler,
+//		syntheticDataTTL, // 0 disables the janitor
+//		handOverShiftEndOrdersHandler, // nil disables the shift end handover
+//		relayOutboxHandler, // nil when no message broker is configured
+//		purgeOrphanedBlobsHandler, // nil when no blob storage is configured
+//		orphanedBlobTTL,
+//		updateProjectionsHandler, // nil disables the projection updates
+//		archiveCompletedOrdersHandler,
+//		orderArchiveAfterDays, // 0 disables the archival
+//		bulkCancelOrdersHandler,
+//		orderTimeout, // 0 disables the order lifecycle watchdog unless a tenant overrides it
+//		orderTimeouts,
+//		escalateStaleUrgentOrdersHandler, // nil disables the escalation of urgent orders
+//		stallThreshold,
+//		stallAlert, // nil when stalls are only logged
+//		tenants, // the default tenant followed by the others
+//		logger,
+//	)
 //
 //	// Start all jobs
 //	if err := jobManager.StartAll(); err != nil {
 //		log.Fatal("Failed to start jobs:", err)
 //	}
 //
-//	// Stop all jobs when shutting down
-//	defer jobManager.StopAll()
+//	// Stop all jobs when shutting down, giving running ticks up to 10 seconds to finish
+//	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+//	defer cancel()
+//	if err := jobManager.StopAll(ctx); err != nil {
+//		log.Printf("Jobs stopped with ticks still running: %v", err)
+//	}
 //
 // # Scheduling
 //
-// Both jobs use the cron expression "* * * * * *" which means they run every second.
-// This frequency ensures real-time responsiveness for order processing and courier movement.
+// Movement and watchdog jobs use the cron expression "* * * * * *" which means they run every second.
+// This frequency ensures real-time responsiveness for courier movement.
+//
+// The assignment job uses an AdaptiveSchedule instead: after every tick it reads the number of
+// orders in Created status and shortens its interval to max / (queued + 1), bounded by TickBounds.
+// With no backlog it ticks at the maximum interval. The current rate is published as the expvar
+// "courier_assignment_ticks_per_second" on /debug/vars.
+//
+// With a dispatch degradation the assignment job also times every tick. Once the latency or the
+// backlog reaches its threshold, the dispatcher falls back to the greedy nearest-courier strategy
+// and skips dispatch experiments; it returns to full mode once both are below half their threshold.
+// The mode is published as "dispatch_mode" and the switches as "dispatch_mode_switches".
+//
+// Every job times the command it runs on a tick in the "command_duration_seconds" histogram on
+// /metrics, and the assignment job counts its ticks by outcome in "dispatch_attempts_total".
+//
+// The synthetic data janitor uses "0 */10 * * * *" and only runs when a TTL is configured.
+//
+// The order batching job uses "*/10 * * * * *" as well; batching windows are usually much longer,
+// so a batch enters dispatch within ten seconds of its window closing.
+//
+// The absence handover uses "*/10 * * * * *" as well, so orders pass to the substitute within
+// ten seconds of an absence starting.
+//
+// The shift end handover uses "*/10 * * * * *" and only runs when the drain-and-handover mode is enabled.
+//
+// The microzone clustering job uses "0 0 3 * * *", recomputing the microzones at night when demand is
+// lowest.
+//
+// The surge mode job uses "*/30 * * * * *". It only switches the surge mode of the tenants whose
+// dispatchers left it set to auto.
+//
+// The SLA compliance job uses "0 30 3 * * *" and measures the UTC day before, which is over by then
+// in every time zone.
+//
+// The outbox relay job uses "* * * * * *" and only runs when a Kafka broker is configured. The outbox
+// is shared by all tenants, so it publishes the events of every tenant. Each tick publishes at most a
+// batch of the oldest messages and marks them processed once Kafka acknowledged them, so delivery
+// is at least once and consumers deduplicate by the message-id header.
+//
+// The orphaned blob janitor uses "0 0 * * * *" and only runs when a blob storage is configured.
+// Uploads are shared by all tenants like the storage, so it removes the orphaned files of every tenant.
+//
+// The SLO monitor job uses "0 * * * * *" and measures the orders of the default tenant that left the
+// assignment or delivery stage within the rolling SLO window. It sets the "order_stage_latency_seconds",
+// "order_stage_slo_compliance_percent", "slo_burn_rate" and "order_queue_depth" gauges on /metrics, and
+// notifies the optional SLOAlert once when a stage starts burning its error budget and once when it stops.
+//
+// The projection job uses "*/5 * * * * *" and projects at most a batch of changes of every tenant into
+// each projection per tick.
+//
+// The order archival job uses "0 0 4 * * *" and only runs when the number of days after which completed
+// orders are archived is configured.
+//
+// The order lifecycle watchdog job uses "0 * * * * *" and only runs when an order timeout is configured,
+// for all tenants or some of them. Every tick cancels in bulk, with an audit entry per batch, the orders
+// of each tenant still in Created beyond the tenant's timeout, at most a bulk cancellation limit of them;
+// the rest are left to the next tick. It counts the cancelled orders by tenant in
+// "timed_out_orders_cancelled_total" on /metrics.
+//
+// The urgent order escalation job uses "0 * * * * *" and only runs when escalation tiers are configured.
+// Every tick raises the urgent orders of each tenant still in Created to the highest tier their waiting
+// time reaches and notifies ops of each new tier. It counts the escalations by tenant and tier in
+// "urgent_orders_escalated_total" on /metrics.
+//
+// # Tenants
+//
+// Row-level security confines every transaction to a single tenant, so a job working on tenant
+// data runs its command once per tenant on every tick: for the default tenant first and then for
+// the tenants listed in TENANTS, each with its own context from tenant.WithID. A tenant whose
+// command fails is logged with the tenant and does not keep the others from their turn. The
+// assignment job dispatches one order of every tenant per tick and adapts its frequency to the
+// backlog of all tenants together. The outbox relay and the orphaned blob janitor work on data
+// shared by all tenants, and the SLO monitor measures the default tenant only.
+//
+// # Liveness
+//
+// Every job beats a Heartbeat when it completes a tick and runs its ticks with the heartbeat's
+// context. The LivenessWatchdog started by JobManager checks the heartbeats every second: a job
+// that has not completed a tick for more than StallThreshold times its interval (the maximum
+// interval for the adaptive assignment job) is considered wedged. The watchdog cancels the
+// context of the wedged tick, logs a goroutine dump, stops and starts the job again, increments
+// the "job_restarts" expvar for the job and notifies the optional StallAlert.
+//
+// # Warm Restart
+//
+// Dispatch reads couriers and orders from the database on every tick and keeps no caches,
+// so the adaptive assignment interval is the only in-memory state a restart loses. JobManager
+// exports it as a DispatcherState after StopAll and imports it before StartAll, letting a
+// replacement instance tick at the pace the backlog needs right away. SaveDispatcherState and
+// LoadDispatcherState keep it in a JSON file; states older than DispatcherStateMaxAge are discarded.
 //
 // # Error Handling
 //
 // - Assignment job ignores expected business errors (no orders, no couriers)
 // - Movement job logs all errors as they indicate system issues
+// - Inactivity watchdog logs all errors; couriers on a break are never unassigned
 // - Failed job starts will stop any already running jobs
 package jobs
@@ -1,50 +1,156 @@
 package jobs
 
 import (
-	"fmt"
+	"context"
 	"log/slog"
+	"slices"
+	"time"
 
 	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/tenant"
 )
 
 // JobManager coordinates all scheduled jobs in the application.
 // Provides a unified interface to start and stop all background jobs.
 type JobManager struct {
-	courierMovementJob   *CourierMovementJob
 	courierAssignmentJob *CourierAssignmentJob
+	// jobs lists the configured jobs in the order they are started
+	jobs             []SupervisedJob
+	livenessWatchdog *LivenessWatchdog
 }
 
 // NewJobManager creates a new job manager with all required jobs.
 // Takes command handlers as dependencies to wire up the job execution.
+// A syntheticDataTTL of 0 disables the synthetic data janitor, and a nil handOverShiftEndOrdersHandler
+// disables the shift end handover. A nil relayOutboxHandler leaves the outbox unpublished, for instances
+// without a message broker. A nil dispatchDegradation keeps the dispatcher in full mode.
+// A nil purgeOrphanedBlobsHandler disables the orphaned blob janitor, for instances without a blob storage.
+// The SLO monitor reports stages burning their error budget to sloAlert, which may be nil.
+// A nil updateProjectionsHandler leaves the read-model projections to the backfill-projection command.
+// An orderArchiveAfterDays of 0 keeps completed orders in the orders table.
+// An orderTimeout of 0 without orderTimeouts of any tenant leaves orders waiting in Created indefinitely.
+// A nil escalateStaleUrgentOrdersHandler disables the escalation of urgent orders waiting for a courier.
+// Jobs silent for longer than the stall threshold are restarted and reported to stallAlert, which may be nil.
+// Jobs working on tenant data run for each of the tenants; the outbox relay, the orphaned blob janitor
+// and the SLO monitor work on shared data or the default tenant only.
 func NewJobManager(
 	moveCouriersHandler commands.MoveCouriersCommandHandler,
 	assignCourierHandler commands.AssignCourierCommandHandler,
+	unassignInactiveCouriersHandler commands.UnassignInactiveCouriersCommandHandler,
+	inactivityThresholdTicks int,
+	fleetLoadReader ports.FleetLoadReader,
+	assignmentTickBounds TickBounds,
+	dispatchDegradation *commands.DispatchDegradation,
+	releaseOrderBatchesHandler commands.ReleaseOrderBatchesCommandHandler,
+	handOverAbsentCourierOrdersHandler commands.HandOverAbsentCourierOrdersCommandHandler,
+	recomputeMicrozonesHandler commands.RecomputeMicrozonesCommandHandler,
+	observeSurgeDemandHandler commands.ObserveSurgeDemandCommandHandler,
+	computeSLAComplianceHandler commands.ComputeSLAComplianceCommandHandler,
+	sloStatusHandler queries.GetSLOStatusQueryHandler,
+	sloAlert ports.SLOAlert,
+	purgeSyntheticDataHandler commands.PurgeSyntheticDataCommandHandler,
+	syntheticDataTTL time.Duration,
+	handOverShiftEndOrdersHandler *commands.HandOverShiftEndOrdersCommandHandler,
+	relayOutboxHandler *commands.RelayOutboxCommandHandler,
+	purgeOrphanedBlobsHandler *commands.PurgeOrphanedBlobsCommandHandler,
+	orphanedBlobTTL time.Duration,
+	updateProjectionsHandler *commands.UpdateProjectionsCommandHandler,
+	archiveCompletedOrdersHandler commands.ArchiveCompletedOrdersCommandHandler,
+	orderArchiveAfterDays int,
+	bulkCancelOrdersHandler commands.BulkCancelOrdersCommandHandler,
+	orderTimeout time.Duration,
+	orderTimeouts tenant.Durations,
+	escalateStaleUrgentOrdersHandler *commands.EscalateStaleUrgentOrdersCommandHandler,
+	stallThreshold StallThreshold,
+	stallAlert StallAlert,
+	tenants Tenants,
 	logger *slog.Logger,
 ) *JobManager {
-	return &JobManager{
-		courierMovementJob:   NewCourierMovementJob(moveCouriersHandler, logger),
-		courierAssignmentJob: NewCourierAssignmentJob(assignCourierHandler, logger),
+	jm := &JobManager{
+		courierAssignmentJob: NewCourierAssignmentJob(
+			assignCourierHandler, fleetLoadReader, assignmentTickBounds, dispatchDegradation, tenants, logger,
+		),
 	}
+
+	jm.jobs = []SupervisedJob{
+		jm.courierAssignmentJob,
+		NewCourierMovementJob(moveCouriersHandler, tenants, logger),
+		NewCourierInactivityWatchdogJob(unassignInactiveCouriersHandler, inactivityThresholdTicks, tenants, logger),
+		NewOrderBatchingJob(releaseOrderBatchesHandler, tenants, logger),
+		NewAbsenceHandoverJob(handOverAbsentCourierOrdersHandler, tenants, logger),
+		NewMicrozoneClusteringJob(recomputeMicrozonesHandler, tenants, logger),
+		NewSurgeModeJob(observeSurgeDemandHandler, tenants, logger),
+		NewSLAComplianceJob(computeSLAComplianceHandler, tenants, logger),
+		NewSLOMonitorJob(sloStatusHandler, sloAlert, logger),
+	}
+	if syntheticDataTTL > 0 {
+		jm.jobs = append(jm.jobs, NewSyntheticDataJanitorJob(
+			purgeSyntheticDataHandler, syntheticDataTTL, tenants, logger,
+		))
+	}
+	if handOverShiftEndOrdersHandler != nil {
+		jm.jobs = append(jm.jobs, NewShiftEndHandoverJob(*handOverShiftEndOrdersHandler, tenants, logger))
+	}
+	if relayOutboxHandler != nil {
+		jm.jobs = append(jm.jobs, NewOutboxRelayJob(*relayOutboxHandler, logger))
+	}
+	if purgeOrphanedBlobsHandler != nil {
+		jm.jobs = append(jm.jobs, NewOrphanedBlobJanitorJob(*purgeOrphanedBlobsHandler, orphanedBlobTTL, logger))
+	}
+	if updateProjectionsHandler != nil {
+		jm.jobs = append(jm.jobs, NewProjectionJob(*updateProjectionsHandler, tenants, logger))
+	}
+	if orderArchiveAfterDays > 0 {
+		jm.jobs = append(jm.jobs, NewOrderArchivalJob(
+			archiveCompletedOrdersHandler, orderArchiveAfterDays, tenants, logger,
+		))
+	}
+	if orderTimeout > 0 || len(orderTimeouts.Tenants()) > 0 {
+		jm.jobs = append(jm.jobs, NewOrderLifecycleWatchdogJob(
+			bulkCancelOrdersHandler, orderTimeout, orderTimeouts, tenants, logger,
+		))
+	}
+	if escalateStaleUrgentOrdersHandler != nil {
+		jm.jobs = append(jm.jobs, NewUrgentOrderEscalationJob(*escalateStaleUrgentOrdersHandler, tenants, logger))
+	}
+
+	jm.livenessWatchdog = NewLivenessWatchdog(stallThreshold, stallAlert, logger, jm.jobs...)
+	return jm
 }
 
 // StartAll starts all scheduled jobs.
-// Returns an error if any job fails to start.
+// Returns an error if any job fails to start; the jobs started before it are stopped again.
 func (jm *JobManager) StartAll() error {
-	if err := jm.courierAssignmentJob.Start(); err != nil {
-		return fmt.Errorf("failed to start courier assignment job: %w", err)
-	}
-
-	if err := jm.courierMovementJob.Start(); err != nil {
-		// Stop already started jobs if this one fails
-		jm.courierAssignmentJob.Stop()
-		return fmt.Errorf("failed to start courier movement job: %w", err)
+	if err := StartJobs(jm.jobs...); err != nil {
+		return err
 	}
 
+	jm.livenessWatchdog.Start()
 	return nil
 }
 
-// StopAll stops all scheduled jobs gracefully.
-func (jm *JobManager) StopAll() {
-	jm.courierMovementJob.Stop()
-	jm.courierAssignmentJob.Stop()
+// StopAll stops all scheduled jobs, the last started first, and waits for their running ticks
+// to finish, so no transaction of a job is cut off by the process exiting.
+// The liveness watchdog is stopped first so that it does not restart the jobs being stopped.
+// Ticks still running when ctx is done are canceled, see StopJobs.
+func (jm *JobManager) StopAll(ctx context.Context) error {
+	jm.livenessWatchdog.Stop()
+
+	stopping := slices.Clone(jm.jobs)
+	slices.Reverse(stopping)
+	return StopJobs(ctx, stopping...)
+}
+
+// ExportDispatcherState captures the in-memory dispatch state for a warm restart.
+// Call it after StopAll so that the state no longer changes.
+func (jm *JobManager) ExportDispatcherState(now time.Time) DispatcherState {
+	return jm.courierAssignmentJob.ExportState(now)
+}
+
+// ImportDispatcherState resumes dispatch from a state exported by a previous instance.
+// Call it before StartAll; a stale state is rejected with ErrDispatcherStateIsStale.
+func (jm *JobManager) ImportDispatcherState(state DispatcherState, now time.Time) error {
+	return jm.courierAssignmentJob.ImportState(state, now)
 }
@@ -0,0 +1,239 @@
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"runtime/pprof"
+	"sync"
+	"time"
+)
+
+// livenessCheckInterval is how often the watchdog compares the jobs' heartbeats with their intervals.
+const livenessCheckInterval = time.Second
+
+// jobRestarts counts the restarts of stalled jobs by job name on /debug/vars.
+var jobRestarts = expvar.NewMap("job_restarts")
+
+// ErrStallThresholdIsInvalid is returned when the stall factor is lower than 2:
+// a job that is merely late by a tick must not be restarted.
+var ErrStallThresholdIsInvalid = errors.New("stall threshold is invalid")
+
+// StallThreshold is how many of its intervals a job may go without completing a tick
+// before the watchdog considers it wedged.
+type StallThreshold struct {
+	factor int
+}
+
+// NewStallThreshold creates a threshold of factor intervals; factor must be at least 2.
+func NewStallThreshold(factor int) (StallThreshold, error) {
+	if factor < 2 {
+		return StallThreshold{}, fmt.Errorf("%w: factor %d is lower than 2", ErrStallThresholdIsInvalid, factor)
+	}
+
+	return StallThreshold{factor: factor}, nil
+}
+
+// Factor returns the number of intervals a job may stay silent.
+func (t StallThreshold) Factor() int {
+	return t.factor
+}
+
+// Heartbeat records when a job last completed a tick and carries the context its ticks run with.
+// The watchdog cancels the context when it restarts the job, so a tick blocked on the database
+// or another context-aware call is released.
+type Heartbeat struct {
+	mu     sync.Mutex
+	ctx    context.Context
+	cancel context.CancelFunc
+	last   time.Time
+}
+
+// NewHeartbeat creates a heartbeat that counts its creation as the last beat.
+func NewHeartbeat() *Heartbeat {
+	h := &Heartbeat{}
+	h.renew(time.Now())
+	return h
+}
+
+// Context returns the context ticks must run with.
+func (h *Heartbeat) Context() context.Context {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.ctx
+}
+
+// Beat records that a tick completed, whether or not it succeeded.
+func (h *Heartbeat) Beat() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.last = time.Now()
+}
+
+// LastBeat returns when the last tick completed.
+func (h *Heartbeat) LastBeat() time.Time {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.last
+}
+
+// renew cancels the context of the running ticks and counts now as a beat.
+func (h *Heartbeat) renew(now time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.cancel != nil {
+		h.cancel()
+	}
+	h.ctx, h.cancel = context.WithCancel(context.Background())
+	h.last = now
+}
+
+// SupervisedJob is a background job the LivenessWatchdog can restart.
+// Start must be callable again after Stop, and ticks must beat the job's heartbeat.
+type SupervisedJob interface {
+	// Name identifies the job in logs and metrics.
+	Name() string
+
+	// Interval is the longest time the job normally takes between two ticks.
+	Interval() time.Duration
+
+	// Heartbeat returns the heartbeat the job's ticks beat.
+	Heartbeat() *Heartbeat
+
+	Start() error
+
+	// Stop stops scheduling ticks and returns a context that is done once the running tick has finished.
+	Stop() context.Context
+}
+
+// JobStall describes a job the watchdog found wedged and restarted.
+// Err is set when the job failed to start again.
+type JobStall struct {
+	Job      string
+	Interval time.Duration
+	Silence  time.Duration
+	Err      error
+}
+
+// StallAlert is notified of every restarted job, typically to page the on-call engineer.
+type StallAlert interface {
+	JobStalled(ctx context.Context, stall JobStall)
+}
+
+// LivenessWatchdog restarts background jobs that stopped completing ticks.
+// A job that has not beaten its heartbeat for more than the threshold's factor of its interval
+// is restarted: the context of its wedged tick is canceled, the goroutines are dumped to the
+// log for diagnostics, and the job is stopped and started again. Restarts are counted in the
+// "job_restarts" expvar and reported to the optional StallAlert.
+type LivenessWatchdog struct {
+	jobs      []SupervisedJob
+	threshold StallThreshold
+	alert     StallAlert
+	logger    *slog.Logger
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewLivenessWatchdog creates a watchdog over the given jobs. alert may be nil.
+func NewLivenessWatchdog(
+	threshold StallThreshold,
+	alert StallAlert,
+	logger *slog.Logger,
+	jobs ...SupervisedJob,
+) *LivenessWatchdog {
+	return &LivenessWatchdog{
+		jobs:      jobs,
+		threshold: threshold,
+		alert:     alert,
+		logger:    logger.With("component", "liveness_watchdog"),
+	}
+}
+
+// Start checks the jobs every second until Stop is called.
+func (w *LivenessWatchdog) Start() {
+	w.stop = make(chan struct{})
+	w.done = make(chan struct{})
+
+	go func() {
+		defer close(w.done)
+
+		ticker := time.NewTicker(livenessCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-w.stop:
+				return
+			case now := <-ticker.C:
+				w.Check(now)
+			}
+		}
+	}()
+
+	w.logger.InfoContext(context.Background(), "Liveness watchdog started",
+		"stall_factor", w.threshold.Factor())
+}
+
+// Stop ends the checks and waits for a running check, including a restart, to finish.
+func (w *LivenessWatchdog) Stop() {
+	if w.stop == nil {
+		return
+	}
+
+	close(w.stop)
+	<-w.done
+	w.stop = nil
+	w.logger.InfoContext(context.Background(), "Liveness watchdog stopped")
+}
+
+// Check restarts every job that has been silent for longer than the threshold at now.
+func (w *LivenessWatchdog) Check(now time.Time) {
+	for _, job := range w.jobs {
+		silence := now.Sub(job.Heartbeat().LastBeat())
+		if silence > time.Duration(w.threshold.Factor())*job.Interval() {
+			w.restart(job, silence, now)
+		}
+	}
+}
+
+// restart releases the wedged tick of the job and starts the job afresh.
+func (w *LivenessWatchdog) restart(job SupervisedJob, silence time.Duration, now time.Time) {
+	ctx := context.Background()
+
+	w.logger.ErrorContext(ctx, "Background job stalled, restarting",
+		"job", job.Name(),
+		"interval", job.Interval().String(),
+		"silence", silence.String(),
+		"goroutines", goroutineDump())
+
+	job.Heartbeat().renew(now)
+	job.Stop()
+	err := job.Start()
+	if err != nil {
+		w.logger.ErrorContext(ctx, "Stalled job failed to restart", "job", job.Name(), "error", err)
+	}
+
+	jobRestarts.Add(job.Name(), 1)
+	if w.alert != nil {
+		w.alert.JobStalled(ctx, JobStall{
+			Job:      job.Name(),
+			Interval: job.Interval(),
+			Silence:  silence,
+			Err:      err,
+		})
+	}
+}
+
+// goroutineDump returns the stacks of all goroutines in the format of an unrecovered panic.
+func goroutineDump() string {
+	var dump bytes.Buffer
+	if err := pprof.Lookup("goroutine").WriteTo(&dump, 2); err != nil {
+		return "goroutine dump failed: " + err.Error()
+	}
+	return dump.String()
+}
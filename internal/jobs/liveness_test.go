@@ -0,0 +1,114 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"delivery/internal/jobs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeJob is a supervised job that records restarts instead of scheduling ticks.
+type fakeJob struct {
+	heartbeat *jobs.Heartbeat
+	starts    int
+	stops     int
+	startErr  error
+	// running is returned by Stop while a tick is running; nil for an idle job.
+	running context.Context
+}
+
+func (j *fakeJob) Name() string               { return "fake_job" }
+func (j *fakeJob) Interval() time.Duration    { return time.Second }
+func (j *fakeJob) Heartbeat() *jobs.Heartbeat { return j.heartbeat }
+func (j *fakeJob) Start() error               { j.starts++; return j.startErr }
+func (j *fakeJob) Stop() context.Context {
+	j.stops++
+	if j.running != nil {
+		return j.running
+	}
+	stopped, cancel := context.WithCancel(context.Background())
+	cancel()
+	return stopped
+}
+
+type recordingStallAlert struct {
+	stalls []jobs.JobStall
+}
+
+func (a *recordingStallAlert) JobStalled(_ context.Context, stall jobs.JobStall) {
+	a.stalls = append(a.stalls, stall)
+}
+
+func TestNewStallThreshold(t *testing.T) {
+	threshold, err := jobs.NewStallThreshold(3)
+	require.NoError(t, err)
+	assert.Equal(t, 3, threshold.Factor())
+
+	_, err = jobs.NewStallThreshold(1)
+	require.ErrorIs(t, err, jobs.ErrStallThresholdIsInvalid)
+}
+
+func TestLivenessWatchdog_Check(t *testing.T) {
+	threshold, err := jobs.NewStallThreshold(3)
+	require.NoError(t, err)
+
+	t.Run("should leave a job that beats in time alone", func(t *testing.T) {
+		job := &fakeJob{heartbeat: jobs.NewHeartbeat()}
+		alert := &recordingStallAlert{}
+		watchdog := jobs.NewLivenessWatchdog(threshold, alert, slog.Default(), job)
+
+		watchdog.Check(job.heartbeat.LastBeat().Add(3 * time.Second))
+
+		assert.Zero(t, job.starts)
+		assert.Empty(t, alert.stalls)
+	})
+
+	t.Run("should restart a silent job and cancel its ticks", func(t *testing.T) {
+		job := &fakeJob{heartbeat: jobs.NewHeartbeat()}
+		alert := &recordingStallAlert{}
+		watchdog := jobs.NewLivenessWatchdog(threshold, alert, slog.Default(), job)
+		wedgedTick := job.heartbeat.Context()
+		now := job.heartbeat.LastBeat().Add(4 * time.Second)
+
+		watchdog.Check(now)
+
+		assert.Equal(t, 1, job.stops)
+		assert.Equal(t, 1, job.starts)
+		require.ErrorIs(t, wedgedTick.Err(), context.Canceled)
+		require.NoError(t, job.heartbeat.Context().Err())
+		assert.Equal(t, now, job.heartbeat.LastBeat())
+		require.Len(t, alert.stalls, 1)
+		assert.Equal(t, "fake_job", alert.stalls[0].Job)
+		assert.Equal(t, 4*time.Second, alert.stalls[0].Silence)
+
+		// The restart counts as a beat, so the job gets a full threshold to recover
+		watchdog.Check(now.Add(3 * time.Second))
+		assert.Equal(t, 1, job.starts)
+	})
+
+	t.Run("should report a job that fails to start again", func(t *testing.T) {
+		job := &fakeJob{heartbeat: jobs.NewHeartbeat(), startErr: errors.New("boom")}
+		alert := &recordingStallAlert{}
+		watchdog := jobs.NewLivenessWatchdog(threshold, alert, slog.Default(), job)
+
+		watchdog.Check(job.heartbeat.LastBeat().Add(time.Minute))
+
+		require.Len(t, alert.stalls, 1)
+		require.EqualError(t, alert.stalls[0].Err, "boom")
+	})
+
+	t.Run("should work without an alert", func(t *testing.T) {
+		job := &fakeJob{heartbeat: jobs.NewHeartbeat()}
+		watchdog := jobs.NewLivenessWatchdog(threshold, nil, slog.Default(), job)
+
+		watchdog.Check(job.heartbeat.LastBeat().Add(time.Minute))
+
+		assert.Equal(t, 1, job.starts)
+	})
+}
@@ -0,0 +1,24 @@
+package jobs
+
+import (
+	"time"
+
+	"delivery/internal/pkg/metrics"
+)
+
+// commandDuration measures the commands the jobs run on every tick.
+var commandDuration = metrics.NewHistogramVec(
+	"command_duration_seconds",
+	"Duration of the commands run by background jobs by command and outcome.",
+	metrics.DefaultDurationBuckets,
+	"command", "outcome",
+)
+
+// observeCommand records the duration of a command started at started, failed if err is set.
+func observeCommand(command string, started time.Time, err error) {
+	outcome := "success"
+	if err != nil {
+		outcome = "error"
+	}
+	commandDuration.ObserveSince(started, command, outcome)
+}
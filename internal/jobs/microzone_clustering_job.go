@@ -0,0 +1,94 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/tenant"
+
+	"github.com/robfig/cron/v3"
+)
+
+// microzoneClusteringSchedule recomputes the microzones every night at 03:00, when demand is lowest.
+const microzoneClusteringSchedule = "0 0 3 * * *"
+
+// microzoneClusteringInterval is the interval of microzoneClusteringSchedule.
+const microzoneClusteringInterval = 24 * time.Hour
+
+// MicrozoneClusteringJob manages the nightly recomputation of dense-demand microzones.
+// Runs every night to cluster the delivery history and replace the stored microzones.
+type MicrozoneClusteringJob struct {
+	handler   commands.RecomputeMicrozonesCommandHandler
+	tenants   Tenants
+	cron      *cron.Cron
+	heartbeat *Heartbeat
+	logger    *slog.Logger
+}
+
+// NewMicrozoneClusteringJob creates a new job for recomputing microzones.
+func NewMicrozoneClusteringJob(
+	handler commands.RecomputeMicrozonesCommandHandler,
+	tenants Tenants,
+	logger *slog.Logger,
+) *MicrozoneClusteringJob {
+	return &MicrozoneClusteringJob{
+		handler:   handler,
+		tenants:   tenants,
+		heartbeat: NewHeartbeat(),
+		logger:    logger.With("component", "microzone_clustering_job"),
+	}
+}
+
+// Name returns "microzone_clustering_job".
+func (j *MicrozoneClusteringJob) Name() string {
+	return "microzone_clustering_job"
+}
+
+// Interval returns a day, the job's tick interval.
+func (j *MicrozoneClusteringJob) Interval() time.Duration {
+	return microzoneClusteringInterval
+}
+
+// Heartbeat returns the heartbeat beaten by every completed tick.
+func (j *MicrozoneClusteringJob) Heartbeat() *Heartbeat {
+	return j.heartbeat
+}
+
+// Start begins the microzone clustering job to run every night at 03:00.
+func (j *MicrozoneClusteringJob) Start() error {
+	cmd := commands.NewRecomputeMicrozonesCommand()
+
+	j.cron = cron.New(cron.WithSeconds())
+	_, err := j.cron.AddFunc(microzoneClusteringSchedule, func() {
+		defer j.heartbeat.Beat()
+
+		j.tenants.each(j.heartbeat.Context(), func(ctx context.Context, id tenant.ID) {
+			started := time.Now()
+			computed, handleErr := j.handler.Handle(ctx, cmd)
+			observeCommand("recompute_microzones", started, handleErr)
+			if handleErr != nil {
+				j.logger.ErrorContext(ctx, "Microzone clustering job failed", "tenant", id.String(), "error", handleErr)
+				return
+			}
+			j.logger.InfoContext(ctx, "Microzones recomputed", "tenant", id.String(), "microzones", computed)
+		})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	j.cron.Start()
+	j.logger.InfoContext(context.Background(), "Microzone clustering job started (running nightly at 03:00)")
+	return nil
+}
+
+// Stop stops the microzone clustering job.
+// Returns a context that is done once the tick running at the time has finished.
+func (j *MicrozoneClusteringJob) Stop() context.Context {
+	stopped := j.cron.Stop()
+	j.logger.InfoContext(context.Background(), "Microzone clustering job stopped")
+	return stopped
+}
@@ -0,0 +1,107 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/tenant"
+
+	"github.com/robfig/cron/v3"
+)
+
+// orderArchivalSchedule archives completed orders every night at 04:00, after the SLA compliance
+// of the day before was computed.
+const orderArchivalSchedule = "0 0 4 * * *"
+
+// orderArchivalInterval is the interval of orderArchivalSchedule.
+const orderArchivalInterval = 24 * time.Hour
+
+// OrderArchivalJob manages the nightly archival of completed orders.
+// Runs every night to move the orders completed more than the configured number of days ago
+// out of the orders table.
+type OrderArchivalJob struct {
+	handler       commands.ArchiveCompletedOrdersCommandHandler
+	olderThanDays int
+	tenants       Tenants
+	cron          *cron.Cron
+	heartbeat     *Heartbeat
+	logger        *slog.Logger
+}
+
+// NewOrderArchivalJob creates a new job for archiving completed orders.
+// olderThanDays is how many days after their completion orders are archived.
+func NewOrderArchivalJob(
+	handler commands.ArchiveCompletedOrdersCommandHandler,
+	olderThanDays int,
+	tenants Tenants,
+	logger *slog.Logger,
+) *OrderArchivalJob {
+	return &OrderArchivalJob{
+		handler:       handler,
+		olderThanDays: olderThanDays,
+		tenants:       tenants,
+		heartbeat:     NewHeartbeat(),
+		logger:        logger.With("component", "order_archival_job"),
+	}
+}
+
+// Name returns "order_archival_job".
+func (j *OrderArchivalJob) Name() string {
+	return "order_archival_job"
+}
+
+// Interval returns a day, the job's tick interval.
+func (j *OrderArchivalJob) Interval() time.Duration {
+	return orderArchivalInterval
+}
+
+// Heartbeat returns the heartbeat beaten by every completed tick.
+func (j *OrderArchivalJob) Heartbeat() *Heartbeat {
+	return j.heartbeat
+}
+
+// Start begins the order archival job to run every night at 04:00.
+// Returns an error if the configured number of days is invalid.
+func (j *OrderArchivalJob) Start() error {
+	cmd, err := commands.NewArchiveCompletedOrdersCommand(j.olderThanDays)
+	if err != nil {
+		return err
+	}
+
+	j.cron = cron.New(cron.WithSeconds())
+	_, err = j.cron.AddFunc(orderArchivalSchedule, func() {
+		defer j.heartbeat.Beat()
+
+		j.tenants.each(j.heartbeat.Context(), func(ctx context.Context, id tenant.ID) {
+			started := time.Now()
+			archived, handleErr := j.handler.Handle(ctx, cmd)
+			observeCommand("archive_completed_orders", started, handleErr)
+			if handleErr != nil {
+				j.logger.ErrorContext(ctx, "Order archival job failed", "tenant", id.String(), "archived", archived, "error", handleErr)
+				return
+			}
+			if archived > 0 {
+				j.logger.InfoContext(ctx, "Completed orders archived", "tenant", id.String(), "archived", archived)
+			}
+		})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	j.cron.Start()
+	j.logger.InfoContext(context.Background(), "Order archival job started (running nightly at 04:00)",
+		"older_than_days", j.olderThanDays)
+	return nil
+}
+
+// Stop stops the order archival job.
+// Returns a context that is done once the tick running at the time has finished.
+func (j *OrderArchivalJob) Stop() context.Context {
+	stopped := j.cron.Stop()
+	j.logger.InfoContext(context.Background(), "Order archival job stopped")
+	return stopped
+}
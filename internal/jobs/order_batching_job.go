@@ -0,0 +1,96 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/tenant"
+
+	"github.com/robfig/cron/v3"
+)
+
+// orderBatchingSchedule checks for closed batches every ten seconds.
+const orderBatchingSchedule = "*/10 * * * * *"
+
+// orderBatchingInterval is the interval of orderBatchingSchedule.
+const orderBatchingInterval = 10 * time.Second
+
+// OrderBatchingJob manages the scheduled release of economy order batches into dispatch.
+// Runs every ten seconds, so a batch enters dispatch at most ten seconds after its window closes.
+type OrderBatchingJob struct {
+	handler   commands.ReleaseOrderBatchesCommandHandler
+	tenants   Tenants
+	cron      *cron.Cron
+	heartbeat *Heartbeat
+	logger    *slog.Logger
+}
+
+// NewOrderBatchingJob creates a new job for releasing economy order batches.
+func NewOrderBatchingJob(
+	handler commands.ReleaseOrderBatchesCommandHandler,
+	tenants Tenants,
+	logger *slog.Logger,
+) *OrderBatchingJob {
+	return &OrderBatchingJob{
+		handler:   handler,
+		tenants:   tenants,
+		heartbeat: NewHeartbeat(),
+		logger:    logger.With("component", "order_batching_job"),
+	}
+}
+
+// Name returns "order_batching_job".
+func (j *OrderBatchingJob) Name() string {
+	return "order_batching_job"
+}
+
+// Interval returns ten seconds, the job's tick interval.
+func (j *OrderBatchingJob) Interval() time.Duration {
+	return orderBatchingInterval
+}
+
+// Heartbeat returns the heartbeat beaten by every completed tick.
+func (j *OrderBatchingJob) Heartbeat() *Heartbeat {
+	return j.heartbeat
+}
+
+// Start begins the order batching job to run every ten seconds.
+func (j *OrderBatchingJob) Start() error {
+	cmd := commands.NewReleaseOrderBatchesCommand()
+
+	j.cron = cron.New(cron.WithSeconds())
+	_, err := j.cron.AddFunc(orderBatchingSchedule, func() {
+		defer j.heartbeat.Beat()
+
+		j.tenants.each(j.heartbeat.Context(), func(ctx context.Context, id tenant.ID) {
+			started := time.Now()
+			released, handleErr := j.handler.Handle(ctx, cmd)
+			observeCommand("release_order_batches", started, handleErr)
+			if handleErr != nil {
+				j.logger.ErrorContext(ctx, "Order batching job failed", "tenant", id.String(), "error", handleErr)
+				return
+			}
+			if released > 0 {
+				j.logger.InfoContext(ctx, "Economy order batch released", "tenant", id.String(), "orders", released)
+			}
+		})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	j.cron.Start()
+	j.logger.InfoContext(context.Background(), "Order batching job started (running every 10 seconds)")
+	return nil
+}
+
+// Stop stops the order batching job.
+// Returns a context that is done once the tick running at the time has finished.
+func (j *OrderBatchingJob) Stop() context.Context {
+	stopped := j.cron.Stop()
+	j.logger.InfoContext(context.Background(), "Order batching job stopped")
+	return stopped
+}
@@ -0,0 +1,127 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/metrics"
+	"delivery/internal/pkg/tenant"
+
+	"github.com/robfig/cron/v3"
+)
+
+// orderLifecycleWatchdogSchedule runs the watchdog at the start of every minute.
+const orderLifecycleWatchdogSchedule = "0 * * * * *"
+
+// orderLifecycleWatchdogInterval is the interval of orderLifecycleWatchdogSchedule.
+const orderLifecycleWatchdogInterval = time.Minute
+
+// timedOutOrders counts the orders cancelled for waiting in Created too long on /metrics.
+var timedOutOrders = metrics.NewCounterVec(
+	"timed_out_orders_cancelled_total",
+	"Orders cancelled by the order lifecycle watchdog for not being dispatched in time, by tenant.",
+	"tenant",
+)
+
+// OrderLifecycleWatchdogJob manages the scheduled cancellation of orders stuck in Created.
+// Runs every minute to cancel the orders no courier was assigned to within the order timeout
+// of their tenant. The orders are cancelled in bulk, so each cancellation is audited and the
+// customers learn the reason from the OrderCancelled events.
+type OrderLifecycleWatchdogJob struct {
+	handler   commands.BulkCancelOrdersCommandHandler
+	timeout   time.Duration
+	timeouts  tenant.Durations
+	tenants   Tenants
+	cron      *cron.Cron
+	heartbeat *Heartbeat
+	logger    *slog.Logger
+}
+
+// NewOrderLifecycleWatchdogJob creates a new job for cancelling timed out orders.
+// timeout is how long orders may wait in Created, 0 for no limit; timeouts override it by tenant.
+func NewOrderLifecycleWatchdogJob(
+	handler commands.BulkCancelOrdersCommandHandler,
+	timeout time.Duration,
+	timeouts tenant.Durations,
+	tenants Tenants,
+	logger *slog.Logger,
+) *OrderLifecycleWatchdogJob {
+	return &OrderLifecycleWatchdogJob{
+		handler:   handler,
+		timeout:   timeout,
+		timeouts:  timeouts,
+		tenants:   tenants,
+		heartbeat: NewHeartbeat(),
+		logger:    logger.With("component", "order_lifecycle_watchdog_job"),
+	}
+}
+
+// Name returns "order_lifecycle_watchdog_job".
+func (j *OrderLifecycleWatchdogJob) Name() string {
+	return "order_lifecycle_watchdog_job"
+}
+
+// Interval returns one minute, the job's tick interval.
+func (j *OrderLifecycleWatchdogJob) Interval() time.Duration {
+	return orderLifecycleWatchdogInterval
+}
+
+// Heartbeat returns the heartbeat beaten by every completed tick.
+func (j *OrderLifecycleWatchdogJob) Heartbeat() *Heartbeat {
+	return j.heartbeat
+}
+
+// Start begins the order lifecycle watchdog job to run every minute.
+// Returns an error if the job cannot be scheduled.
+func (j *OrderLifecycleWatchdogJob) Start() error {
+	j.cron = cron.New(cron.WithSeconds())
+	_, err := j.cron.AddFunc(orderLifecycleWatchdogSchedule, func() {
+		defer j.heartbeat.Beat()
+
+		j.tenants.each(j.heartbeat.Context(), func(ctx context.Context, id tenant.ID) {
+			timeout := j.timeouts.Get(id, j.timeout)
+			if timeout <= 0 {
+				return
+			}
+
+			cmd, cmdErr := commands.NewCancelTimedOutOrdersCommand(timeout, time.Now())
+			if cmdErr != nil {
+				j.logger.ErrorContext(ctx, "Order lifecycle watchdog job failed", "tenant", id.String(), "error", cmdErr)
+				return
+			}
+
+			started := time.Now()
+			report, handleErr := j.handler.Handle(ctx, cmd)
+			observeCommand("cancel_timed_out_orders", started, handleErr)
+			timedOutOrders.Add(float64(len(report.OrderIDs)), id.String())
+			if handleErr != nil {
+				j.logger.ErrorContext(ctx, "Order lifecycle watchdog job failed", "tenant", id.String(),
+					"cancelled", len(report.OrderIDs), "error", handleErr)
+				return
+			}
+			if len(report.OrderIDs) > 0 {
+				j.logger.InfoContext(ctx, "Timed out orders cancelled", "tenant", id.String(),
+					"cancelled", len(report.OrderIDs), "timeout", timeout.String(), "bulk_cancellation_id", report.ID.String())
+			}
+		})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	j.cron.Start()
+	j.logger.InfoContext(context.Background(), "Order lifecycle watchdog job started (running every minute)",
+		"timeout", j.timeout.String(), "tenant_timeouts", len(j.timeouts.Tenants()))
+	return nil
+}
+
+// Stop stops the order lifecycle watchdog job.
+// Returns a context that is done once the tick running at the time has finished.
+func (j *OrderLifecycleWatchdogJob) Stop() context.Context {
+	stopped := j.cron.Stop()
+	j.logger.InfoContext(context.Background(), "Order lifecycle watchdog job stopped")
+	return stopped
+}
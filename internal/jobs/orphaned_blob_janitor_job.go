@@ -0,0 +1,100 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/robfig/cron/v3"
+)
+
+// orphanedBlobJanitorSchedule runs the janitor at the start of every hour.
+const orphanedBlobJanitorSchedule = "0 0 * * * *"
+
+// orphanedBlobJanitorInterval is the interval of orphanedBlobJanitorSchedule.
+const orphanedBlobJanitorInterval = time.Hour
+
+// OrphanedBlobJanitorJob manages the scheduled removal of uploaded files no feature attached.
+// Runs every hour to purge the uploads older than the TTL together with their files.
+type OrphanedBlobJanitorJob struct {
+	handler   commands.PurgeOrphanedBlobsCommandHandler
+	ttl       time.Duration
+	cron      *cron.Cron
+	heartbeat *Heartbeat
+	logger    *slog.Logger
+}
+
+// NewOrphanedBlobJanitorJob creates a new job for purging orphaned blobs.
+// ttl is how long an upload may stay unattached before the job removes it.
+func NewOrphanedBlobJanitorJob(
+	handler commands.PurgeOrphanedBlobsCommandHandler,
+	ttl time.Duration,
+	logger *slog.Logger,
+) *OrphanedBlobJanitorJob {
+	return &OrphanedBlobJanitorJob{
+		handler:   handler,
+		ttl:       ttl,
+		heartbeat: NewHeartbeat(),
+		logger:    logger.With("component", "orphaned_blob_janitor_job"),
+	}
+}
+
+// Name returns "orphaned_blob_janitor_job".
+func (j *OrphanedBlobJanitorJob) Name() string {
+	return "orphaned_blob_janitor_job"
+}
+
+// Interval returns one hour, the job's tick interval.
+func (j *OrphanedBlobJanitorJob) Interval() time.Duration {
+	return orphanedBlobJanitorInterval
+}
+
+// Heartbeat returns the heartbeat beaten by every completed tick.
+func (j *OrphanedBlobJanitorJob) Heartbeat() *Heartbeat {
+	return j.heartbeat
+}
+
+// Start begins the orphaned blob janitor job to run every hour.
+// Returns an error if the configured TTL is invalid.
+func (j *OrphanedBlobJanitorJob) Start() error {
+	cmd, err := commands.NewPurgeOrphanedBlobsCommand(j.ttl)
+	if err != nil {
+		return err
+	}
+
+	j.cron = cron.New(cron.WithSeconds())
+	_, err = j.cron.AddFunc(orphanedBlobJanitorSchedule, func() {
+		ctx := j.heartbeat.Context()
+		defer j.heartbeat.Beat()
+
+		started := time.Now()
+		removed, handleErr := j.handler.Handle(ctx, cmd)
+		observeCommand("purge_orphaned_blobs", started, handleErr)
+		if handleErr != nil {
+			j.logger.ErrorContext(ctx, "Orphaned blob janitor job failed", "removed", removed, "error", handleErr)
+			return
+		}
+		if removed > 0 {
+			j.logger.InfoContext(ctx, "Orphaned blobs purged", "removed", removed)
+		}
+	})
+
+	if err != nil {
+		return err
+	}
+
+	j.cron.Start()
+	j.logger.InfoContext(context.Background(), "Orphaned blob janitor job started (running every hour)",
+		"ttl", j.ttl.String())
+	return nil
+}
+
+// Stop stops the orphaned blob janitor job.
+// Returns a context that is done once the tick running at the time has finished.
+func (j *OrphanedBlobJanitorJob) Stop() context.Context {
+	stopped := j.cron.Stop()
+	j.logger.InfoContext(context.Background(), "Orphaned blob janitor job stopped")
+	return stopped
+}
@@ -0,0 +1,100 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+
+	"github.com/robfig/cron/v3"
+)
+
+// outboxRelaySchedule publishes the outbox every second.
+const outboxRelaySchedule = "* * * * * *"
+
+// outboxRelayInterval is the interval of outboxRelaySchedule.
+const outboxRelayInterval = time.Second
+
+// outboxRelayBatchSize is the maximum number of outbox messages published by a tick.
+// It bounds the tick well below the stall threshold while a backlog drains.
+const outboxRelayBatchSize = 200
+
+// OutboxRelayJob manages the publishing of the transactional outbox to the message broker.
+// Runs every second, so an event reaches the broker about a second after the change that raised it
+// was committed. Ticks due while the previous one is still publishing are skipped, so messages are never
+// published by two ticks at once.
+type OutboxRelayJob struct {
+	handler   commands.RelayOutboxCommandHandler
+	cron      *cron.Cron
+	heartbeat *Heartbeat
+	logger    *slog.Logger
+}
+
+// NewOutboxRelayJob creates a new job for relaying the outbox.
+func NewOutboxRelayJob(
+	handler commands.RelayOutboxCommandHandler,
+	logger *slog.Logger,
+) *OutboxRelayJob {
+	return &OutboxRelayJob{
+		handler:   handler,
+		heartbeat: NewHeartbeat(),
+		logger:    logger.With("component", "outbox_relay_job"),
+	}
+}
+
+// Name returns "outbox_relay_job".
+func (j *OutboxRelayJob) Name() string {
+	return "outbox_relay_job"
+}
+
+// Interval returns a second, the job's tick interval.
+func (j *OutboxRelayJob) Interval() time.Duration {
+	return outboxRelayInterval
+}
+
+// Heartbeat returns the heartbeat beaten by every completed tick.
+func (j *OutboxRelayJob) Heartbeat() *Heartbeat {
+	return j.heartbeat
+}
+
+// Start begins the outbox relay job to run every second.
+func (j *OutboxRelayJob) Start() error {
+	cmd, err := commands.NewRelayOutboxCommand(outboxRelayBatchSize)
+	if err != nil {
+		return err
+	}
+
+	j.cron = cron.New(cron.WithSeconds(), cron.WithChain(cron.SkipIfStillRunning(cron.DiscardLogger)))
+	_, err = j.cron.AddFunc(outboxRelaySchedule, func() {
+		ctx := j.heartbeat.Context()
+		defer j.heartbeat.Beat()
+
+		started := time.Now()
+		published, handleErr := j.handler.Handle(ctx, cmd)
+		observeCommand("relay_outbox", started, handleErr)
+		if handleErr != nil {
+			j.logger.ErrorContext(ctx, "Outbox relay job failed", "published", published, "error", handleErr)
+			return
+		}
+		if published > 0 {
+			j.logger.DebugContext(ctx, "Outbox messages published", "messages", published)
+		}
+	})
+
+	if err != nil {
+		return err
+	}
+
+	j.cron.Start()
+	j.logger.InfoContext(context.Background(), "Outbox relay job started (running every second)")
+	return nil
+}
+
+// Stop stops the outbox relay job.
+// Returns a context that is done once the tick running at the time has finished.
+func (j *OutboxRelayJob) Stop() context.Context {
+	stopped := j.cron.Stop()
+	j.logger.InfoContext(context.Background(), "Outbox relay job stopped")
+	return stopped
+}
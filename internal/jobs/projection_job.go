@@ -0,0 +1,105 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/tenant"
+
+	"github.com/robfig/cron/v3"
+)
+
+// projectionSchedule updates the read-model projections every five seconds.
+const projectionSchedule = "*/5 * * * * *"
+
+// projectionInterval is the interval of projectionSchedule.
+const projectionInterval = 5 * time.Second
+
+// projectionBatchSize is the maximum number of changes a tick projects into each projection.
+// It bounds the tick well below the stall threshold while a backlog drains; projections far
+// behind the change log are backfilled with the backfill-projection command instead.
+const projectionBatchSize = 500
+
+// ProjectionJob manages the updates of the read-model projections, such as the order history.
+// Runs every five seconds, so a change shows up in the projections within about five seconds of
+// being committed. Ticks due while the previous one is still projecting are skipped.
+type ProjectionJob struct {
+	handler   commands.UpdateProjectionsCommandHandler
+	tenants   Tenants
+	cron      *cron.Cron
+	heartbeat *Heartbeat
+	logger    *slog.Logger
+}
+
+// NewProjectionJob creates a new job for updating the projections.
+func NewProjectionJob(
+	handler commands.UpdateProjectionsCommandHandler,
+	tenants Tenants,
+	logger *slog.Logger,
+) *ProjectionJob {
+	return &ProjectionJob{
+		handler:   handler,
+		tenants:   tenants,
+		heartbeat: NewHeartbeat(),
+		logger:    logger.With("component", "projection_job"),
+	}
+}
+
+// Name returns "projection_job".
+func (j *ProjectionJob) Name() string {
+	return "projection_job"
+}
+
+// Interval returns five seconds, the job's tick interval.
+func (j *ProjectionJob) Interval() time.Duration {
+	return projectionInterval
+}
+
+// Heartbeat returns the heartbeat beaten by every completed tick.
+func (j *ProjectionJob) Heartbeat() *Heartbeat {
+	return j.heartbeat
+}
+
+// Start begins the projection job to run every five seconds.
+func (j *ProjectionJob) Start() error {
+	cmd, err := commands.NewUpdateProjectionsCommand(projectionBatchSize)
+	if err != nil {
+		return err
+	}
+
+	j.cron = cron.New(cron.WithSeconds(), cron.WithChain(cron.SkipIfStillRunning(cron.DiscardLogger)))
+	_, err = j.cron.AddFunc(projectionSchedule, func() {
+		defer j.heartbeat.Beat()
+
+		j.tenants.each(j.heartbeat.Context(), func(ctx context.Context, id tenant.ID) {
+			started := time.Now()
+			projected, handleErr := j.handler.Handle(ctx, cmd)
+			observeCommand("update_projections", started, handleErr)
+			if handleErr != nil {
+				j.logger.ErrorContext(ctx, "Projection job failed", "tenant", id.String(), "projected", projected, "error", handleErr)
+				return
+			}
+			if projected > 0 {
+				j.logger.DebugContext(ctx, "Changes projected", "tenant", id.String(), "changes", projected)
+			}
+		})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	j.cron.Start()
+	j.logger.InfoContext(context.Background(), "Projection job started (running every 5 seconds)")
+	return nil
+}
+
+// Stop stops the projection job.
+// Returns a context that is done once the tick running at the time has finished.
+func (j *ProjectionJob) Stop() context.Context {
+	stopped := j.cron.Stop()
+	j.logger.InfoContext(context.Background(), "Projection job stopped")
+	return stopped
+}
@@ -0,0 +1,101 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/tenant"
+
+	"github.com/robfig/cron/v3"
+)
+
+// shiftEndHandoverSchedule runs the handover every ten seconds.
+const shiftEndHandoverSchedule = "*/10 * * * * *"
+
+// shiftEndHandoverInterval is the interval of shiftEndHandoverSchedule.
+const shiftEndHandoverInterval = 10 * time.Second
+
+// ShiftEndHandoverJob manages the scheduled handover of orders from couriers whose shift is ending.
+// Runs every ten seconds to reassign orders that would not be delivered before the shift ends.
+type ShiftEndHandoverJob struct {
+	handler   commands.HandOverShiftEndOrdersCommandHandler
+	tenants   Tenants
+	cron      *cron.Cron
+	heartbeat *Heartbeat
+	logger    *slog.Logger
+}
+
+// NewShiftEndHandoverJob creates a new job for handing over orders at shift end.
+func NewShiftEndHandoverJob(
+	handler commands.HandOverShiftEndOrdersCommandHandler,
+	tenants Tenants,
+	logger *slog.Logger,
+) *ShiftEndHandoverJob {
+	return &ShiftEndHandoverJob{
+		handler:   handler,
+		tenants:   tenants,
+		heartbeat: NewHeartbeat(),
+		logger:    logger.With("component", "shift_end_handover_job"),
+	}
+}
+
+// Name returns "shift_end_handover_job".
+func (j *ShiftEndHandoverJob) Name() string {
+	return "shift_end_handover_job"
+}
+
+// Interval returns ten seconds, the job's tick interval.
+func (j *ShiftEndHandoverJob) Interval() time.Duration {
+	return shiftEndHandoverInterval
+}
+
+// Heartbeat returns the heartbeat beaten by every completed tick.
+func (j *ShiftEndHandoverJob) Heartbeat() *Heartbeat {
+	return j.heartbeat
+}
+
+// Start begins the shift end handover job to run every ten seconds.
+// Deliveries are estimated with CourierMovementInterval as the length of a courier turn.
+func (j *ShiftEndHandoverJob) Start() error {
+	cmd, err := commands.NewHandOverShiftEndOrdersCommand(CourierMovementInterval)
+	if err != nil {
+		return err
+	}
+
+	j.cron = cron.New(cron.WithSeconds())
+	_, err = j.cron.AddFunc(shiftEndHandoverSchedule, func() {
+		defer j.heartbeat.Beat()
+
+		j.tenants.each(j.heartbeat.Context(), func(ctx context.Context, id tenant.ID) {
+			started := time.Now()
+			report, handleErr := j.handler.Handle(ctx, cmd)
+			observeCommand("hand_over_shift_end_orders", started, handleErr)
+			if handleErr != nil {
+				j.logger.ErrorContext(ctx, "Shift end handover job failed", "tenant", id.String(), "error", handleErr)
+				return
+			}
+			if len(report.HandedOver) > 0 || len(report.Kept) > 0 {
+				j.logger.InfoContext(ctx, "Shift end orders handed over", "tenant", id.String(),
+					"handed_over", len(report.HandedOver), "kept", len(report.Kept))
+			}
+		})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	j.cron.Start()
+	j.logger.InfoContext(context.Background(), "Shift end handover job started (running every 10 seconds)")
+	return nil
+}
+
+// Stop stops the shift end handover job.
+// Returns a context that is done once the tick running at the time has finished.
+func (j *ShiftEndHandoverJob) Stop() context.Context {
+	stopped := j.cron.Stop()
+	j.logger.InfoContext(context.Background(), "Shift end handover job stopped")
+	return stopped
+}
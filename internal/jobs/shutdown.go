@@ -0,0 +1,55 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ErrJobsNotDrained is returned when ticks of stopped jobs were still running at the shutdown deadline.
+var ErrJobsNotDrained = errors.New("jobs not drained")
+
+// StartJobs starts the jobs in the given order. When a job fails to start, the jobs started
+// before it are stopped again, the last started first, and the error names the failed job.
+func StartJobs(jobs ...SupervisedJob) error {
+	for i, job := range jobs {
+		if err := job.Start(); err != nil {
+			for _, started := range slices.Backward(jobs[:i]) {
+				started.Stop()
+			}
+			return fmt.Errorf("failed to start %s: %w", job.Name(), err)
+		}
+	}
+	return nil
+}
+
+// StopJobs stops the jobs in the given order and waits until none of their ticks is running.
+// The jobs stop scheduling right away, so a slow tick of one job does not let the others tick again.
+// Ticks still running when ctx is done have their heartbeat context canceled, which rolls back the
+// transaction they hold instead of leaving it to the process exit, and ErrJobsNotDrained names their jobs.
+func StopJobs(ctx context.Context, jobs ...SupervisedJob) error {
+	stopped := make([]context.Context, len(jobs))
+	for i, job := range jobs {
+		stopped[i] = job.Stop()
+	}
+
+	var running []string
+	for i, job := range jobs {
+		select {
+		case <-stopped[i].Done():
+		case <-ctx.Done():
+			if stopped[i].Err() == nil {
+				job.Heartbeat().renew(time.Now())
+				running = append(running, job.Name())
+			}
+		}
+	}
+
+	if len(running) > 0 {
+		return fmt.Errorf("%w: %s still running", ErrJobsNotDrained, strings.Join(running, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"delivery/internal/jobs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartJobs(t *testing.T) {
+	t.Run("should start all jobs", func(t *testing.T) {
+		first := &fakeJob{heartbeat: jobs.NewHeartbeat()}
+		second := &fakeJob{heartbeat: jobs.NewHeartbeat()}
+
+		require.NoError(t, jobs.StartJobs(first, second))
+
+		assert.Equal(t, 1, first.starts)
+		assert.Equal(t, 1, second.starts)
+		assert.Zero(t, first.stops+second.stops)
+	})
+
+	t.Run("should stop the started jobs when one fails to start", func(t *testing.T) {
+		startErr := errors.New("scheduler unavailable")
+		first := &fakeJob{heartbeat: jobs.NewHeartbeat()}
+		second := &fakeJob{heartbeat: jobs.NewHeartbeat()}
+		failing := &fakeJob{heartbeat: jobs.NewHeartbeat(), startErr: startErr}
+		last := &fakeJob{heartbeat: jobs.NewHeartbeat()}
+
+		err := jobs.StartJobs(first, second, failing, last)
+
+		require.ErrorIs(t, err, startErr)
+		assert.Contains(t, err.Error(), "fake_job")
+		assert.Equal(t, 1, first.stops)
+		assert.Equal(t, 1, second.stops)
+		assert.Zero(t, failing.stops)
+		assert.Zero(t, last.starts)
+	})
+}
+
+func TestStopJobs(t *testing.T) {
+	t.Run("should wait for the running ticks to finish", func(t *testing.T) {
+		idle := &fakeJob{heartbeat: jobs.NewHeartbeat()}
+		tick, finish := context.WithCancel(context.Background())
+		busy := &fakeJob{heartbeat: jobs.NewHeartbeat(), running: tick}
+		time.AfterFunc(10*time.Millisecond, finish)
+
+		err := jobs.StopJobs(context.Background(), idle, busy)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, idle.stops)
+		assert.Equal(t, 1, busy.stops)
+		require.ErrorIs(t, tick.Err(), context.Canceled)
+		require.NoError(t, busy.heartbeat.Context().Err())
+	})
+
+	t.Run("should cancel the ticks still running at the deadline", func(t *testing.T) {
+		idle := &fakeJob{heartbeat: jobs.NewHeartbeat()}
+		wedged := &fakeJob{heartbeat: jobs.NewHeartbeat(), running: context.Background()}
+		wedgedTick := wedged.heartbeat.Context()
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err := jobs.StopJobs(ctx, idle, wedged)
+
+		require.ErrorIs(t, err, jobs.ErrJobsNotDrained)
+		assert.Contains(t, err.Error(), "fake_job")
+		assert.Equal(t, 1, idle.stops)
+		assert.Equal(t, 1, wedged.stops)
+		require.ErrorIs(t, wedgedTick.Err(), context.Canceled)
+		require.NoError(t, idle.heartbeat.Context().Err())
+	})
+}
@@ -0,0 +1,112 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/tenant"
+
+	"github.com/robfig/cron/v3"
+)
+
+// slaComplianceSchedule computes the SLA compliance of the day before every night at 03:30,
+// after the microzone clustering.
+const slaComplianceSchedule = "0 30 3 * * *"
+
+// slaComplianceInterval is the interval of slaComplianceSchedule.
+const slaComplianceInterval = 24 * time.Hour
+
+// SLAComplianceJob manages the nightly computation of the SLA compliance of the districts.
+// Runs every night to measure the deliveries completed the UTC day before against the SLA targets.
+type SLAComplianceJob struct {
+	handler   commands.ComputeSLAComplianceCommandHandler
+	tenants   Tenants
+	cron      *cron.Cron
+	heartbeat *Heartbeat
+	logger    *slog.Logger
+}
+
+// NewSLAComplianceJob creates a new job for computing the SLA compliance.
+func NewSLAComplianceJob(
+	handler commands.ComputeSLAComplianceCommandHandler,
+	tenants Tenants,
+	logger *slog.Logger,
+) *SLAComplianceJob {
+	return &SLAComplianceJob{
+		handler:   handler,
+		tenants:   tenants,
+		heartbeat: NewHeartbeat(),
+		logger:    logger.With("component", "sla_compliance_job"),
+	}
+}
+
+// Name returns "sla_compliance_job".
+func (j *SLAComplianceJob) Name() string {
+	return "sla_compliance_job"
+}
+
+// Interval returns a day, the job's tick interval.
+func (j *SLAComplianceJob) Interval() time.Duration {
+	return slaComplianceInterval
+}
+
+// Heartbeat returns the heartbeat beaten by every completed tick.
+func (j *SLAComplianceJob) Heartbeat() *Heartbeat {
+	return j.heartbeat
+}
+
+// Start begins the SLA compliance job to run every night at 03:30.
+func (j *SLAComplianceJob) Start() error {
+	j.cron = cron.New(cron.WithSeconds())
+	_, err := j.cron.AddFunc(slaComplianceSchedule, func() {
+		ctx := j.heartbeat.Context()
+		defer j.heartbeat.Beat()
+
+		// The UTC day before the current one is always over, whatever the local time zone
+		cmd, cmdErr := commands.NewComputeSLAComplianceCommand(time.Now().UTC().AddDate(0, 0, -1))
+		if cmdErr != nil {
+			j.logger.ErrorContext(ctx, "SLA compliance job failed", "error", cmdErr)
+			return
+		}
+
+		j.tenants.each(ctx, func(ctx context.Context, id tenant.ID) {
+			started := time.Now()
+			compliance, handleErr := j.handler.Handle(ctx, cmd)
+			observeCommand("compute_sla_compliance", started, handleErr)
+			if handleErr != nil {
+				j.logger.ErrorContext(ctx, "SLA compliance job failed", "tenant", id.String(), "error", handleErr)
+				return
+			}
+
+			missed := 0
+			for _, c := range compliance {
+				if !c.IsMet() {
+					missed++
+				}
+			}
+			j.logger.InfoContext(ctx, "SLA compliance computed",
+				"tenant", id.String(),
+				"day", cmd.Day().Format(time.DateOnly),
+				"targets", len(compliance),
+				"missed", missed)
+		})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	j.cron.Start()
+	j.logger.InfoContext(context.Background(), "SLA compliance job started (running nightly at 03:30)")
+	return nil
+}
+
+// Stop stops the SLA compliance job.
+// Returns a context that is done once the tick running at the time has finished.
+func (j *SLAComplianceJob) Stop() context.Context {
+	stopped := j.cron.Stop()
+	j.logger.InfoContext(context.Background(), "SLA compliance job stopped")
+	return stopped
+}
@@ -0,0 +1,184 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/domain/model/slo"
+	"delivery/internal/core/ports"
+	"delivery/internal/pkg/metrics"
+
+	"github.com/robfig/cron/v3"
+)
+
+// sloMonitorSchedule measures the service level objectives every minute.
+const sloMonitorSchedule = "0 * * * * *"
+
+// sloMonitorInterval is the interval of sloMonitorSchedule.
+const sloMonitorInterval = time.Minute
+
+// orderStageLatency exposes the latency percentiles of the order stages on /metrics.
+var orderStageLatency = metrics.NewGaugeVec(
+	"order_stage_latency_seconds",
+	"Latency percentiles of the orders that left a stage in the SLO window, by stage and quantile.",
+	"stage", "quantile",
+)
+
+// orderStageCompliance exposes the share of orders within the SLO threshold on /metrics.
+var orderStageCompliance = metrics.NewGaugeVec(
+	"order_stage_slo_compliance_percent",
+	"Percentage of the orders that left a stage in the SLO window within its threshold, by stage.",
+	"stage",
+)
+
+// sloBurnRate exposes the error budget burn rate of the order stages on /metrics.
+var sloBurnRate = metrics.NewGaugeVec(
+	"slo_burn_rate",
+	"Error budget burn rate of the order stages over the SLO window, by stage; 1 spends it as fast as the goal allows.",
+	"stage",
+)
+
+// orderQueueDepth exposes the number of orders waiting for a courier on /metrics.
+var orderQueueDepth = metrics.NewGaugeVec(
+	"order_queue_depth",
+	"Orders waiting for a courier, excluding orders held for review.",
+)
+
+// SLOMonitorJob measures the assignment and delivery latency objectives every minute, exposes the
+// results on /metrics and notifies the optional SLO alert when a stage starts or stops burning its
+// error budget faster than the alert burn rate. A stage is reported once per burn, not on every tick.
+type SLOMonitorJob struct {
+	handler   queries.GetSLOStatusQueryHandler
+	alert     ports.SLOAlert
+	burning   map[slo.Stage]bool
+	cron      *cron.Cron
+	heartbeat *Heartbeat
+	logger    *slog.Logger
+}
+
+// NewSLOMonitorJob creates a new job monitoring the service level objectives.
+// A nil alert only logs the burning stages.
+func NewSLOMonitorJob(
+	handler queries.GetSLOStatusQueryHandler,
+	alert ports.SLOAlert,
+	logger *slog.Logger,
+) *SLOMonitorJob {
+	return &SLOMonitorJob{
+		handler:   handler,
+		alert:     alert,
+		burning:   make(map[slo.Stage]bool),
+		heartbeat: NewHeartbeat(),
+		logger:    logger.With("component", "slo_monitor_job"),
+	}
+}
+
+// Name returns "slo_monitor_job".
+func (j *SLOMonitorJob) Name() string {
+	return "slo_monitor_job"
+}
+
+// Interval returns a minute, the job's tick interval.
+func (j *SLOMonitorJob) Interval() time.Duration {
+	return sloMonitorInterval
+}
+
+// Heartbeat returns the heartbeat beaten by every completed tick.
+func (j *SLOMonitorJob) Heartbeat() *Heartbeat {
+	return j.heartbeat
+}
+
+// Start begins the SLO monitor job to run every minute.
+func (j *SLOMonitorJob) Start() error {
+	query := queries.NewGetSLOStatusQuery()
+
+	j.cron = cron.New(cron.WithSeconds())
+	_, err := j.cron.AddFunc(sloMonitorSchedule, func() {
+		ctx := j.heartbeat.Context()
+		defer j.heartbeat.Beat()
+
+		started := time.Now()
+		status, handleErr := j.handler.Handle(ctx, query)
+		observeCommand("get_slo_status", started, handleErr)
+		if handleErr != nil {
+			j.logger.ErrorContext(ctx, "SLO monitor job failed", "error", handleErr)
+			return
+		}
+
+		orderQueueDepth.Set(float64(status.QueueDepth))
+		for _, measurement := range status.Measurements {
+			j.observe(ctx, status.Window, measurement)
+		}
+	})
+
+	if err != nil {
+		return err
+	}
+
+	j.cron.Start()
+	j.logger.InfoContext(context.Background(), "SLO monitor job started (running every minute)")
+	return nil
+}
+
+// Stop stops the SLO monitor job.
+// Returns a context that is done once the tick running at the time has finished.
+func (j *SLOMonitorJob) Stop() context.Context {
+	stopped := j.cron.Stop()
+	j.logger.InfoContext(context.Background(), "SLO monitor job stopped")
+	return stopped
+}
+
+// observe exposes the measurement and notifies the alert when the stage started or stopped burning.
+func (j *SLOMonitorJob) observe(ctx context.Context, window time.Duration, measurement slo.Measurement) {
+	objective := measurement.Objective()
+	stage := objective.Stage().String()
+	percentiles := measurement.Percentiles()
+	for quantile, latency := range map[float64]time.Duration{
+		0.5:  percentiles.P50,
+		0.9:  percentiles.P90,
+		0.95: percentiles.P95,
+		0.99: percentiles.P99,
+	} {
+		orderStageLatency.Set(latency.Seconds(), stage, strconv.FormatFloat(quantile, 'g', -1, 64))
+	}
+	orderStageCompliance.Set(measurement.Compliance(), stage)
+	sloBurnRate.Set(measurement.BurnRate(), stage)
+
+	burning := measurement.IsBurning()
+	if burning == j.burning[objective.Stage()] {
+		return
+	}
+
+	burn := ports.SLOBurn{
+		Stage:         objective.Stage(),
+		BurnRate:      measurement.BurnRate(),
+		AlertBurnRate: objective.AlertBurnRate(),
+		Compliance:    measurement.Compliance(),
+		Goal:          objective.Goal(),
+		Orders:        measurement.Orders(),
+		Window:        window,
+		Resolved:      !burning,
+	}
+	if burning {
+		j.logger.WarnContext(ctx, "SLO error budget burning",
+			"stage", stage,
+			"burn_rate", burn.BurnRate,
+			"compliance", burn.Compliance,
+			"orders", burn.Orders)
+	} else {
+		j.logger.InfoContext(ctx, "SLO error budget no longer burning",
+			"stage", stage,
+			"burn_rate", burn.BurnRate)
+	}
+
+	if j.alert != nil {
+		if err := j.alert.NotifySLOBurn(ctx, burn); err != nil {
+			// The state is kept, so the alert is retried on the next tick
+			j.logger.ErrorContext(ctx, "SLO alert failed", "stage", stage, "error", err)
+			return
+		}
+	}
+	j.burning[objective.Stage()] = burning
+}
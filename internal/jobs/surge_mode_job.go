@@ -0,0 +1,102 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/tenant"
+
+	"github.com/robfig/cron/v3"
+)
+
+// surgeModeSchedule observes the backlog for the surge mode every thirty seconds.
+const surgeModeSchedule = "*/30 * * * * *"
+
+// surgeModeInterval is the interval of surgeModeSchedule.
+const surgeModeInterval = 30 * time.Second
+
+// SurgeModeJob lets the order backlog switch the surge mode on and off while it is set to auto.
+// Runs every thirty seconds, often enough to react to a peak and rarely enough that a surge
+// started by a short spike is not ended by the next tick.
+type SurgeModeJob struct {
+	handler   commands.ObserveSurgeDemandCommandHandler
+	tenants   Tenants
+	cron      *cron.Cron
+	heartbeat *Heartbeat
+	logger    *slog.Logger
+}
+
+// NewSurgeModeJob creates a new job for the automatic surge mode.
+func NewSurgeModeJob(
+	handler commands.ObserveSurgeDemandCommandHandler,
+	tenants Tenants,
+	logger *slog.Logger,
+) *SurgeModeJob {
+	return &SurgeModeJob{
+		handler:   handler,
+		tenants:   tenants,
+		heartbeat: NewHeartbeat(),
+		logger:    logger.With("component", "surge_mode_job"),
+	}
+}
+
+// Name returns "surge_mode_job".
+func (j *SurgeModeJob) Name() string {
+	return "surge_mode_job"
+}
+
+// Interval returns thirty seconds, the job's tick interval.
+func (j *SurgeModeJob) Interval() time.Duration {
+	return surgeModeInterval
+}
+
+// Heartbeat returns the heartbeat beaten by every completed tick.
+func (j *SurgeModeJob) Heartbeat() *Heartbeat {
+	return j.heartbeat
+}
+
+// Start begins the surge mode job to run every thirty seconds.
+func (j *SurgeModeJob) Start() error {
+	cmd := commands.NewObserveSurgeDemandCommand()
+
+	j.cron = cron.New(cron.WithSeconds())
+	_, err := j.cron.AddFunc(surgeModeSchedule, func() {
+		defer j.heartbeat.Beat()
+
+		j.tenants.each(j.heartbeat.Context(), func(ctx context.Context, id tenant.ID) {
+			started := time.Now()
+			change, handleErr := j.handler.Handle(ctx, cmd)
+			observeCommand("observe_surge_demand", started, handleErr)
+			if handleErr != nil {
+				j.logger.ErrorContext(ctx, "Surge mode job failed", "tenant", id.String(), "error", handleErr)
+				return
+			}
+			if change != nil {
+				j.logger.InfoContext(ctx, "Surge mode switched by backlog", "tenant", id.String(),
+					"active", change.Toggle.Active(),
+					"backlog", change.Toggle.Backlog(),
+					"invited", change.Invited,
+					"uninvited", change.Uninvited,
+				)
+			}
+		})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	j.cron.Start()
+	j.logger.InfoContext(context.Background(), "Surge mode job started (running every 30 seconds)")
+	return nil
+}
+
+// Stop stops the surge mode job.
+// Returns a context that is done once the tick running at the time has finished.
+func (j *SurgeModeJob) Stop() context.Context {
+	stopped := j.cron.Stop()
+	j.logger.InfoContext(context.Background(), "Surge mode job stopped")
+	return stopped
+}
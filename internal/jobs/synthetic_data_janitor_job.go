@@ -0,0 +1,105 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/tenant"
+
+	"github.com/robfig/cron/v3"
+)
+
+// syntheticDataJanitorSchedule runs the janitor at the start of every tenth minute.
+const syntheticDataJanitorSchedule = "0 */10 * * * *"
+
+// syntheticDataJanitorInterval is the interval of syntheticDataJanitorSchedule.
+const syntheticDataJanitorInterval = 10 * time.Minute
+
+// SyntheticDataJanitorJob manages the scheduled removal of expired test data.
+// Runs every ten minutes to purge synthetic couriers and orders older than the TTL.
+type SyntheticDataJanitorJob struct {
+	handler   commands.PurgeSyntheticDataCommandHandler
+	ttl       time.Duration
+	tenants   Tenants
+	cron      *cron.Cron
+	heartbeat *Heartbeat
+	logger    *slog.Logger
+}
+
+// NewSyntheticDataJanitorJob creates a new job for purging synthetic data.
+// ttl is how long synthetic data is kept before the job removes it.
+func NewSyntheticDataJanitorJob(
+	handler commands.PurgeSyntheticDataCommandHandler,
+	ttl time.Duration,
+	tenants Tenants,
+	logger *slog.Logger,
+) *SyntheticDataJanitorJob {
+	return &SyntheticDataJanitorJob{
+		handler:   handler,
+		ttl:       ttl,
+		tenants:   tenants,
+		heartbeat: NewHeartbeat(),
+		logger:    logger.With("component", "synthetic_data_janitor_job"),
+	}
+}
+
+// Name returns "synthetic_data_janitor_job".
+func (j *SyntheticDataJanitorJob) Name() string {
+	return "synthetic_data_janitor_job"
+}
+
+// Interval returns ten minutes, the job's tick interval.
+func (j *SyntheticDataJanitorJob) Interval() time.Duration {
+	return syntheticDataJanitorInterval
+}
+
+// Heartbeat returns the heartbeat beaten by every completed tick.
+func (j *SyntheticDataJanitorJob) Heartbeat() *Heartbeat {
+	return j.heartbeat
+}
+
+// Start begins the synthetic data janitor job to run every ten minutes.
+// Returns an error if the configured TTL is invalid.
+func (j *SyntheticDataJanitorJob) Start() error {
+	cmd, err := commands.NewPurgeSyntheticDataCommand(j.ttl)
+	if err != nil {
+		return err
+	}
+
+	j.cron = cron.New(cron.WithSeconds())
+	_, err = j.cron.AddFunc(syntheticDataJanitorSchedule, func() {
+		defer j.heartbeat.Beat()
+
+		j.tenants.each(j.heartbeat.Context(), func(ctx context.Context, id tenant.ID) {
+			started := time.Now()
+			purge, handleErr := j.handler.Handle(ctx, cmd)
+			observeCommand("purge_synthetic_data", started, handleErr)
+			if handleErr != nil {
+				j.logger.ErrorContext(ctx, "Synthetic data janitor job failed", "tenant", id.String(), "error", handleErr)
+				return
+			}
+			if purge.Orders > 0 || purge.Couriers > 0 {
+				j.logger.InfoContext(ctx, "Synthetic data purged", "tenant", id.String(), "orders", purge.Orders, "couriers", purge.Couriers)
+			}
+		})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	j.cron.Start()
+	j.logger.InfoContext(context.Background(), "Synthetic data janitor job started (running every 10 minutes)",
+		"ttl", j.ttl.String())
+	return nil
+}
+
+// Stop stops the synthetic data janitor job.
+// Returns a context that is done once the tick running at the time has finished.
+func (j *SyntheticDataJanitorJob) Stop() context.Context {
+	stopped := j.cron.Stop()
+	j.logger.InfoContext(context.Background(), "Synthetic data janitor job stopped")
+	return stopped
+}
@@ -0,0 +1,58 @@
+package jobs
+
+import (
+	"context"
+	"slices"
+
+	"delivery/internal/pkg/tenant"
+)
+
+// Tenants lists the tenants the tenant-scoped jobs act for.
+// Row-level security confines every transaction to a single tenant, so these jobs
+// run their command once per tenant on every tick.
+type Tenants struct {
+	ids []tenant.ID
+}
+
+// NewTenants creates the tenant list of the default tenant followed by the others.
+// Duplicates, including the default tenant among the others, are kept once.
+func NewTenants(defaultTenant tenant.ID, others ...tenant.ID) (Tenants, error) {
+	if err := defaultTenant.Validate(); err != nil {
+		return Tenants{}, err
+	}
+
+	ids := []tenant.ID{defaultTenant}
+	for _, id := range others {
+		if err := id.Validate(); err != nil {
+			return Tenants{}, err
+		}
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return Tenants{ids: ids}, nil
+}
+
+// IDs returns the tenants in the order the jobs visit them.
+func (t Tenants) IDs() []tenant.ID {
+	return slices.Clone(t.ids)
+}
+
+// each runs fn for every tenant in turn with ctx scoped to it. A tenant failing does not keep
+// the ones after it from their turn, but a canceled ctx, for example on shutdown or when the
+// liveness watchdog restarts the job, ends the tick. Without tenants fn runs once with ctx
+// unchanged, acting for the default tenant.
+func (t Tenants) each(ctx context.Context, fn func(ctx context.Context, id tenant.ID)) {
+	if len(t.ids) == 0 {
+		id, _ := tenant.FromContext(ctx)
+		fn(ctx, id)
+		return
+	}
+
+	for _, id := range t.ids {
+		if ctx.Err() != nil {
+			return
+		}
+		fn(tenant.WithID(ctx, id), id)
+	}
+}
@@ -0,0 +1,131 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"delivery/internal/core/application/usecases/queries"
+	"delivery/internal/core/ports"
+
+	"github.com/robfig/cron/v3"
+)
+
+// trackingCacheInvalidationSchedule reads the new outbox messages every second.
+const trackingCacheInvalidationSchedule = "* * * * * *"
+
+// trackingCacheInvalidationInterval is the interval of trackingCacheInvalidationSchedule.
+const trackingCacheInvalidationInterval = time.Second
+
+// trackingCacheInvalidationBatchSize is the maximum number of outbox messages a tick reads.
+// Ticks keep reading batches until the outbox is drained.
+const trackingCacheInvalidationBatchSize = 500
+
+// TrackingCacheInvalidationJob drops the cached tracking views of orders changed by order events.
+// It tails the outbox from the moment it starts, so it runs in every instance serving the API,
+// next to the cache it invalidates. Messages of other aggregates match no cached view and are ignored.
+// An event committed after later ones were read is missed; its view is served for at most the cache TTL.
+type TrackingCacheInvalidationJob struct {
+	outbox    ports.OutboxRepository
+	cache     *queries.SharedTrackingCache
+	cursor    ports.OutboxCursor
+	cron      *cron.Cron
+	heartbeat *Heartbeat
+	logger    *slog.Logger
+}
+
+// NewTrackingCacheInvalidationJob creates a new job invalidating cache on the outbox messages.
+func NewTrackingCacheInvalidationJob(
+	outbox ports.OutboxRepository,
+	cache *queries.SharedTrackingCache,
+	logger *slog.Logger,
+) *TrackingCacheInvalidationJob {
+	return &TrackingCacheInvalidationJob{
+		outbox:    outbox,
+		cache:     cache,
+		heartbeat: NewHeartbeat(),
+		logger:    logger.With("component", "tracking_cache_invalidation_job"),
+	}
+}
+
+// Name returns "tracking_cache_invalidation_job".
+func (j *TrackingCacheInvalidationJob) Name() string {
+	return "tracking_cache_invalidation_job"
+}
+
+// Interval returns one second, the job's tick interval.
+func (j *TrackingCacheInvalidationJob) Interval() time.Duration {
+	return trackingCacheInvalidationInterval
+}
+
+// Heartbeat returns the heartbeat beaten by every completed tick.
+func (j *TrackingCacheInvalidationJob) Heartbeat() *Heartbeat {
+	return j.heartbeat
+}
+
+// Start begins the invalidation job to run every second, reading the messages recorded from now on.
+func (j *TrackingCacheInvalidationJob) Start() error {
+	j.cursor = ports.OutboxCursor{OccurredAt: time.Now()}
+
+	j.cron = cron.New(cron.WithSeconds(), cron.WithChain(cron.SkipIfStillRunning(cron.DiscardLogger)))
+	_, err := j.cron.AddFunc(trackingCacheInvalidationSchedule, func() {
+		ctx := j.heartbeat.Context()
+		defer j.heartbeat.Beat()
+
+		invalidated, invalidateErr := j.invalidate(ctx)
+		if invalidateErr != nil {
+			j.logger.ErrorContext(ctx, "Tracking cache invalidation failed",
+				"invalidated", invalidated, "error", invalidateErr)
+			return
+		}
+		if invalidated > 0 {
+			j.logger.DebugContext(ctx, "Tracking cache invalidated", "messages", invalidated)
+		}
+	})
+
+	if err != nil {
+		return err
+	}
+
+	j.cron.Start()
+	j.logger.InfoContext(context.Background(), "Tracking cache invalidation job started (running every second)")
+	return nil
+}
+
+// Stop stops the invalidation job.
+// Returns a context that is done once the tick running at the time has finished.
+func (j *TrackingCacheInvalidationJob) Stop() context.Context {
+	stopped := j.cron.Stop()
+	j.logger.InfoContext(context.Background(), "Tracking cache invalidation job stopped")
+	return stopped
+}
+
+// invalidate drops the views of the aggregates of the messages recorded since the cursor and
+// advances the cursor past them. Returns the number of messages read.
+func (j *TrackingCacheInvalidationJob) invalidate(ctx context.Context) (int, error) {
+	read := 0
+	for {
+		messages, err := j.outbox.GetSince(ctx, j.cursor, trackingCacheInvalidationBatchSize)
+		if err != nil {
+			return read, err
+		}
+		if len(messages) == 0 {
+			return read, nil
+		}
+
+		aggregateIDs := make([]string, 0, len(messages))
+		for _, message := range messages {
+			aggregateIDs = append(aggregateIDs, message.AggregateID)
+		}
+		if err = j.cache.Invalidate(ctx, aggregateIDs...); err != nil {
+			return read, err
+		}
+
+		last := messages[len(messages)-1]
+		j.cursor = ports.OutboxCursor{OccurredAt: last.OccurredAt, AfterID: &last.ID}
+		read += len(messages)
+		if len(messages) < trackingCacheInvalidationBatchSize {
+			return read, nil
+		}
+	}
+}
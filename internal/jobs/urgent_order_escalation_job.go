@@ -0,0 +1,109 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"delivery/internal/core/application/usecases/commands"
+	"delivery/internal/pkg/metrics"
+	"delivery/internal/pkg/tenant"
+
+	"github.com/robfig/cron/v3"
+)
+
+// urgentOrderEscalationSchedule escalates stale urgent orders at the start of every minute.
+const urgentOrderEscalationSchedule = "0 * * * * *"
+
+// urgentOrderEscalationInterval is the interval of urgentOrderEscalationSchedule.
+const urgentOrderEscalationInterval = time.Minute
+
+// escalatedUrgentOrders counts the urgent orders reaching an escalation tier on /metrics.
+var escalatedUrgentOrders = metrics.NewCounterVec(
+	"urgent_orders_escalated_total",
+	"Urgent orders that reached an escalation tier while waiting for a courier, by tenant and tier.",
+	"tenant", "tier",
+)
+
+// UrgentOrderEscalationJob manages the scheduled escalation of urgent orders left waiting for a courier.
+// Runs every minute for each tenant, so an order reaches a tier at most a minute after its waiting time.
+type UrgentOrderEscalationJob struct {
+	handler   commands.EscalateStaleUrgentOrdersCommandHandler
+	tenants   Tenants
+	cron      *cron.Cron
+	heartbeat *Heartbeat
+	logger    *slog.Logger
+}
+
+// NewUrgentOrderEscalationJob creates a new job for escalating stale urgent orders.
+func NewUrgentOrderEscalationJob(
+	handler commands.EscalateStaleUrgentOrdersCommandHandler,
+	tenants Tenants,
+	logger *slog.Logger,
+) *UrgentOrderEscalationJob {
+	return &UrgentOrderEscalationJob{
+		handler:   handler,
+		tenants:   tenants,
+		heartbeat: NewHeartbeat(),
+		logger:    logger.With("component", "urgent_order_escalation_job"),
+	}
+}
+
+// Name returns "urgent_order_escalation_job".
+func (j *UrgentOrderEscalationJob) Name() string {
+	return "urgent_order_escalation_job"
+}
+
+// Interval returns one minute, the job's tick interval.
+func (j *UrgentOrderEscalationJob) Interval() time.Duration {
+	return urgentOrderEscalationInterval
+}
+
+// Heartbeat returns the heartbeat beaten by every completed tick.
+func (j *UrgentOrderEscalationJob) Heartbeat() *Heartbeat {
+	return j.heartbeat
+}
+
+// Start begins the urgent order escalation job to run every minute.
+// Returns an error if the job cannot be scheduled.
+func (j *UrgentOrderEscalationJob) Start() error {
+	cmd := commands.NewEscalateStaleUrgentOrdersCommand()
+
+	j.cron = cron.New(cron.WithSeconds())
+	_, err := j.cron.AddFunc(urgentOrderEscalationSchedule, func() {
+		defer j.heartbeat.Beat()
+
+		j.tenants.each(j.heartbeat.Context(), func(ctx context.Context, id tenant.ID) {
+			started := time.Now()
+			escalated, handleErr := j.handler.Handle(ctx, cmd)
+			observeCommand("escalate_stale_urgent_orders", started, handleErr)
+
+			for _, e := range escalated {
+				escalatedUrgentOrders.Inc(id.String(), strconv.Itoa(e.Tier()))
+				j.logger.WarnContext(ctx, "Urgent order escalated", "tenant", id.String(),
+					"order_id", e.OrderID().String(), "tier", e.Tier())
+			}
+			if handleErr != nil {
+				j.logger.ErrorContext(ctx, "Urgent order escalation job failed", "tenant", id.String(),
+					"escalated", len(escalated), "error", handleErr)
+			}
+		})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	j.cron.Start()
+	j.logger.InfoContext(context.Background(), "Urgent order escalation job started (running every minute)")
+	return nil
+}
+
+// Stop stops the urgent order escalation job.
+// Returns a context that is done once the tick running at the time has finished.
+func (j *UrgentOrderEscalationJob) Stop() context.Context {
+	stopped := j.cron.Stop()
+	j.logger.InfoContext(context.Background(), "Urgent order escalation job stopped")
+	return stopped
+}
@@ -0,0 +1,172 @@
+// Package catalog describes the commands, queries and integration events of the service in a
+// machine-readable form, by reflecting over the Go types that define them. The catalog is served
+// on the admin endpoint and committed next to the OpenAPI spec, so a change to a message type
+// shows up as a change to the catalog and cannot drift from the published contracts unnoticed.
+//
+// Commands and queries are described by their handlers: the message is the last parameter of
+// Handle and its fields are the message's exported getters; a result other than the error is
+// described as well. Events are described by their JSON payload types.
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrNotAHandler is returned for a type without a Handle(ctx, message) method returning an error last.
+var ErrNotAHandler = errors.New("type is not a command or query handler")
+
+var (
+	contextType = reflect.TypeFor[context.Context]()
+	errorType   = reflect.TypeFor[error]()
+	timeType    = reflect.TypeFor[time.Time]()
+)
+
+// Catalog lists the messages the service accepts and emits, each kind sorted by name.
+type Catalog struct {
+	Commands []Message `json:"commands"`
+	Queries  []Message `json:"queries"`
+	Events   []Message `json:"events"`
+}
+
+// Message describes a command, query or event.
+type Message struct {
+	Name   string  `json:"name"`
+	Fields []Field `json:"fields"`
+	Result *Shape  `json:"result,omitempty"`
+}
+
+// Shape describes the type of a result; the fields of structs, or of the structs
+// a pointer or slice refers to, are listed one level deep.
+type Shape struct {
+	Type   string  `json:"type"`
+	Fields []Field `json:"fields,omitempty"`
+}
+
+// Field is a field of a message or result.
+type Field struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Optional bool   `json:"optional,omitempty"`
+}
+
+// Handlers describes the messages of the given command or query handlers.
+// Handlers are passed as pointers, so methods with either receiver are found.
+func Handlers(handlers ...any) ([]Message, error) {
+	messages := make([]Message, 0, len(handlers))
+	for _, handler := range handlers {
+		message, err := describeHandler(reflect.TypeOf(handler))
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+
+	sortMessages(messages)
+	return messages, nil
+}
+
+// Events describes integration events by their payloads, keyed by event type.
+func Events(payloads map[string]any) []Message {
+	messages := make([]Message, 0, len(payloads))
+	for name, payload := range payloads {
+		messages = append(messages, Message{Name: name, Fields: structFields(reflect.TypeOf(payload))})
+	}
+
+	sortMessages(messages)
+	return messages
+}
+
+func describeHandler(t reflect.Type) (Message, error) {
+	handle, ok := t.MethodByName("Handle")
+	if !ok {
+		return Message{}, fmt.Errorf("%w: %s has no Handle method", ErrNotAHandler, t)
+	}
+
+	// In(0) is the receiver.
+	signature := handle.Type
+	if signature.NumIn() != 3 || signature.In(1) != contextType ||
+		signature.NumOut() == 0 || signature.NumOut() > 2 || signature.Out(signature.NumOut()-1) != errorType {
+		return Message{}, fmt.Errorf("%w: %s.Handle has signature %s", ErrNotAHandler, t, signature)
+	}
+
+	messageType := signature.In(2)
+	message := Message{Name: messageType.Name(), Fields: getterFields(messageType)}
+	if signature.NumOut() == 2 {
+		message.Result = describeShape(signature.Out(0))
+	}
+	return message, nil
+}
+
+// getterFields lists the exported getters of a message, which keeps its fields unexported.
+func getterFields(t reflect.Type) []Field {
+	fields := make([]Field, 0)
+	for i := range t.NumMethod() {
+		method := t.Method(i)
+		if method.Name == "Validate" || method.Name == "String" ||
+			method.Type.NumIn() != 1 || method.Type.NumOut() != 1 {
+			continue
+		}
+
+		out := method.Type.Out(0)
+		fields = append(fields, Field{Name: method.Name, Type: out.String(), Optional: out.Kind() == reflect.Pointer})
+	}
+	return fields
+}
+
+func describeShape(t reflect.Type) *Shape {
+	element := t
+	for element.Kind() == reflect.Pointer || element.Kind() == reflect.Slice {
+		element = element.Elem()
+	}
+
+	shape := &Shape{Type: t.String()}
+	if element.Kind() == reflect.Struct && element != timeType {
+		shape.Fields = structFields(element)
+	}
+	return shape
+}
+
+// structFields lists the exported fields of a struct under their JSON names, if tagged.
+// Fields tagged omitempty and pointers are optional.
+func structFields(t reflect.Type) []Field {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	fields := make([]Field, 0)
+	if t.Kind() != reflect.Struct {
+		return fields
+	}
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		fields = append(fields, Field{
+			Name:     name,
+			Type:     field.Type.String(),
+			Optional: strings.Contains(options, "omitempty") || field.Type.Kind() == reflect.Pointer,
+		})
+	}
+	return fields
+}
+
+func sortMessages(messages []Message) {
+	sort.Slice(messages, func(i, j int) bool { return messages[i].Name < messages[j].Name })
+}
@@ -0,0 +1,92 @@
+package catalog_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"delivery/internal/pkg/catalog"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type RenameCommand struct {
+	name   string
+	parent *string
+}
+
+func (c RenameCommand) Name() string               { return c.name }
+func (c RenameCommand) Parent() *string            { return c.parent }
+func (c RenameCommand) Validate() error            { return nil }
+func (c RenameCommand) IsEqual(RenameCommand) bool { return false }
+
+type RenameCommandHandler struct{}
+
+func (h *RenameCommandHandler) Handle(context.Context, RenameCommand) error { return nil }
+
+type ListQuery struct{}
+
+type ListQueryResponse struct {
+	ID      string
+	Created time.Time
+	secret  string
+}
+
+type ListQueryHandler struct{}
+
+func (h ListQueryHandler) Handle(context.Context, ListQuery) ([]ListQueryResponse, error) {
+	return nil, nil
+}
+
+type NotAHandler struct{}
+
+func (NotAHandler) Handle(RenameCommand) error { return nil }
+
+func TestHandlers(t *testing.T) {
+	t.Run("should describe messages by their getters and results", func(t *testing.T) {
+		messages, err := catalog.Handlers(new(RenameCommandHandler), new(ListQueryHandler))
+
+		require.NoError(t, err)
+		assert.Equal(t, []catalog.Message{
+			{Name: "ListQuery", Fields: []catalog.Field{}, Result: &catalog.Shape{
+				Type: "[]catalog_test.ListQueryResponse",
+				Fields: []catalog.Field{
+					{Name: "ID", Type: "string"},
+					{Name: "Created", Type: "time.Time"},
+				},
+			}},
+			{Name: "RenameCommand", Fields: []catalog.Field{
+				{Name: "Name", Type: "string"},
+				{Name: "Parent", Type: "*string", Optional: true},
+			}},
+		}, messages)
+	})
+
+	t.Run("should refuse types that are not handlers", func(t *testing.T) {
+		_, err := catalog.Handlers(new(NotAHandler))
+		require.ErrorIs(t, err, catalog.ErrNotAHandler)
+
+		_, err = catalog.Handlers(new(RenameCommand))
+		require.ErrorIs(t, err, catalog.ErrNotAHandler)
+	})
+}
+
+func TestEvents(t *testing.T) {
+	type payload struct {
+		OrderID string   `json:"orderId"`
+		Ratio   *float64 `json:"ratio,omitempty"`
+		Note    string   `json:"note,omitempty"`
+		Skipped string   `json:"-"`
+	}
+
+	messages := catalog.Events(map[string]any{"OrderShipped": payload{}, "OrderLost": payload{}})
+
+	require.Len(t, messages, 2)
+	assert.Equal(t, "OrderLost", messages[0].Name)
+	assert.Equal(t, []catalog.Field{
+		{Name: "orderId", Type: "string"},
+		{Name: "ratio", Type: "*float64", Optional: true},
+		{Name: "note", Type: "string", Optional: true},
+	}, messages[1].Fields)
+}
@@ -0,0 +1,312 @@
+// Package diagnostics collects what is needed to triage an incident without access to the logs:
+// the errors handlers and jobs reported recently, the build the instance runs, a fingerprint of
+// its configuration and the status of the services it depends on.
+// Recent errors are kept in memory in a fixed-size ring, so the oldest are dropped first and
+// nothing survives a restart. Messages are sanitized before they are stored: credentials and
+// e-mail addresses are masked and long messages are cut.
+package diagnostics
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// maxMessageLength is the number of characters of an error message kept in the ring.
+	maxMessageLength = 512
+
+	// redacted replaces sensitive parts of error messages.
+	redacted = "[REDACTED]"
+
+	// fingerprintLength is the number of hex characters of a configuration fingerprint.
+	fingerprintLength = 16
+
+	// StatusUp and StatusDown report whether a dependency answered its check.
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+var (
+	// secretPattern matches key=value pairs of credentials, as in Postgres connection strings.
+	secretPattern = regexp.MustCompile(`(?i)\b(password|passwd|pwd|secret|token|api_?key)=\S+`)
+
+	// userInfoPattern matches credentials embedded in URLs.
+	userInfoPattern = regexp.MustCompile(`://[^/\s:@]+:[^/\s@]+@`)
+
+	// emailPattern matches e-mail addresses of customers and couriers.
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+)
+
+// Sanitize masks credentials and e-mail addresses in an error message, folds it into
+// a single line and cuts it to maxMessageLength characters.
+func Sanitize(message string) string {
+	message = secretPattern.ReplaceAllString(message, "$1="+redacted)
+	message = userInfoPattern.ReplaceAllString(message, "://"+redacted+"@")
+	message = emailPattern.ReplaceAllString(message, redacted)
+	message = strings.Join(strings.Fields(message), " ")
+
+	if utf8.RuneCountInString(message) <= maxMessageLength {
+		return message
+	}
+	return string([]rune(message)[:maxMessageLength]) + "…"
+}
+
+// ErrorEntry is an error reported by a handler or a job.
+type ErrorEntry struct {
+	At      time.Time `json:"at"`
+	Source  string    `json:"source"`
+	Message string    `json:"message"`
+}
+
+// ErrorRing keeps the most recent errors. It is safe for concurrent use.
+type ErrorRing struct {
+	mu      sync.Mutex
+	entries []ErrorEntry
+	next    int
+	full    bool
+}
+
+// NewErrorRing creates a ring that keeps the given number of errors, at least one.
+func NewErrorRing(capacity int) *ErrorRing {
+	return &ErrorRing{entries: make([]ErrorEntry, max(capacity, 1))}
+}
+
+// Record stores an error, replacing the oldest one when the ring is full.
+// The message is sanitized before it is stored.
+func (r *ErrorRing) Record(at time.Time, source, message string) {
+	entry := ErrorEntry{At: at, Source: source, Message: Sanitize(message)}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries[r.next] = entry
+	r.next = (r.next + 1) % len(r.entries)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// Recent returns the stored errors, newest first.
+func (r *ErrorRing) Recent() []ErrorEntry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	count := r.next
+	if r.full {
+		count = len(r.entries)
+	}
+
+	recent := make([]ErrorEntry, 0, count)
+	for i := 1; i <= count; i++ {
+		recent = append(recent, r.entries[(r.next-i+len(r.entries))%len(r.entries)])
+	}
+	return recent
+}
+
+// Handler is a slog.Handler that records error-level log records in an ErrorRing and passes
+// every record on to the wrapped handler. The log message becomes the source of the entry and
+// the "error" attribute, when present, its message.
+type Handler struct {
+	next  slog.Handler
+	ring  *ErrorRing
+	attrs []slog.Attr
+}
+
+// NewHandler wraps next so that error-level records are also recorded in ring.
+//
+// Example:
+//
+//	logger := slog.New(diagnostics.NewHandler(slog.Default().Handler(), ring))
+func NewHandler(next slog.Handler, ring *ErrorRing) *Handler {
+	return &Handler{next: next, ring: ring}
+}
+
+// Enabled reports whether the wrapped handler handles records of the given level.
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= slog.LevelError || h.next.Enabled(ctx, level)
+}
+
+// Handle records error-level records and passes the record to the wrapped handler.
+func (h *Handler) Handle(ctx context.Context, record slog.Record) error {
+	if record.Level >= slog.LevelError {
+		h.ring.Record(record.Time, record.Message, h.errorMessage(record))
+	}
+	if !h.next.Enabled(ctx, record.Level) {
+		return nil
+	}
+	return h.next.Handle(ctx, record)
+}
+
+// WithAttrs returns a handler whose records carry the given attributes.
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &Handler{
+		next:  h.next.WithAttrs(attrs),
+		ring:  h.ring,
+		attrs: append(append([]slog.Attr{}, h.attrs...), attrs...),
+	}
+}
+
+// WithGroup returns a handler that qualifies the attributes of its records with the group name.
+func (h *Handler) WithGroup(name string) slog.Handler {
+	return &Handler{next: h.next.WithGroup(name), ring: h.ring, attrs: h.attrs}
+}
+
+func (h *Handler) errorMessage(record slog.Record) string {
+	message := ""
+	record.Attrs(func(attr slog.Attr) bool {
+		if attr.Key == "error" {
+			message = attr.Value.String()
+			return false
+		}
+		return true
+	})
+	if message != "" {
+		return message
+	}
+
+	for _, attr := range h.attrs {
+		if attr.Key == "error" {
+			return attr.Value.String()
+		}
+	}
+	return record.Message
+}
+
+// BuildInfo describes the binary the instance runs.
+type BuildInfo struct {
+	GoVersion    string `json:"goVersion"`
+	Module       string `json:"module"`
+	Version      string `json:"version"`
+	Revision     string `json:"revision,omitempty"`
+	RevisionTime string `json:"revisionTime,omitempty"`
+	Modified     bool   `json:"modified"`
+}
+
+// ReadBuildInfo returns the build information embedded by the Go toolchain.
+// The revision is only known for binaries built from a version control checkout.
+func ReadBuildInfo() BuildInfo {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return BuildInfo{}
+	}
+
+	build := BuildInfo{
+		GoVersion: info.GoVersion,
+		Module:    info.Main.Path,
+		Version:   info.Main.Version,
+	}
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			build.Revision = setting.Value
+		case "vcs.time":
+			build.RevisionTime = setting.Value
+		case "vcs.modified":
+			build.Modified = setting.Value == "true"
+		}
+	}
+	return build
+}
+
+// ConfigFingerprint hashes a configuration, so instances can be compared without
+// revealing its values. Equal configurations have equal fingerprints.
+func ConfigFingerprint(config any) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%+v", config)))
+	return hex.EncodeToString(sum[:])[:fingerprintLength]
+}
+
+// Dependency is a service the instance needs. Check returns an error when the service
+// does not answer; it must honor the deadline of ctx.
+type Dependency struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// DependencyStatus is the outcome of a dependency check.
+type DependencyStatus struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Latency string `json:"latency"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Report is the diagnostics of an instance at a point in time.
+type Report struct {
+	GeneratedAt       time.Time          `json:"generatedAt"`
+	Build             BuildInfo          `json:"build"`
+	ConfigFingerprint string             `json:"configFingerprint"`
+	Dependencies      []DependencyStatus `json:"dependencies"`
+	RecentErrors      []ErrorEntry       `json:"recentErrors"`
+}
+
+// Reporter assembles diagnostics reports.
+type Reporter struct {
+	build        BuildInfo
+	fingerprint  string
+	errors       *ErrorRing
+	dependencies []Dependency
+	checkTimeout time.Duration
+}
+
+// NewReporter creates a reporter that checks every dependency within checkTimeout.
+func NewReporter(
+	build BuildInfo, fingerprint string, errors *ErrorRing, checkTimeout time.Duration, dependencies ...Dependency,
+) *Reporter {
+	return &Reporter{
+		build:        build,
+		fingerprint:  fingerprint,
+		errors:       errors,
+		dependencies: dependencies,
+		checkTimeout: checkTimeout,
+	}
+}
+
+// Report checks the dependencies concurrently and returns the diagnostics of the instance.
+func (r *Reporter) Report(ctx context.Context) Report {
+	statuses := make([]DependencyStatus, len(r.dependencies))
+
+	var wg sync.WaitGroup
+	for i, dependency := range r.dependencies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			statuses[i] = r.check(ctx, dependency)
+		}()
+	}
+	wg.Wait()
+
+	return Report{
+		GeneratedAt:       time.Now(),
+		Build:             r.build,
+		ConfigFingerprint: r.fingerprint,
+		Dependencies:      statuses,
+		RecentErrors:      r.errors.Recent(),
+	}
+}
+
+func (r *Reporter) check(ctx context.Context, dependency Dependency) DependencyStatus {
+	ctx, cancel := context.WithTimeout(ctx, r.checkTimeout)
+	defer cancel()
+
+	started := time.Now()
+	err := dependency.Check(ctx)
+	status := DependencyStatus{
+		Name:    dependency.Name,
+		Status:  StatusUp,
+		Latency: time.Since(started).Round(time.Millisecond).String(),
+	}
+	if err != nil {
+		status.Status = StatusDown
+		status.Error = Sanitize(err.Error())
+	}
+	return status
+}
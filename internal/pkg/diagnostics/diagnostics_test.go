@@ -0,0 +1,138 @@
+package diagnostics_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"delivery/internal/pkg/diagnostics"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanitize(t *testing.T) {
+	t.Run("masks credentials and e-mail addresses", func(t *testing.T) {
+		message := diagnostics.Sanitize(
+			"connect host=db user=app password=s3cret: dial postgres://app:s3cret@db:5432/delivery for ivan@example.com",
+		)
+
+		assert.NotContains(t, message, "s3cret")
+		assert.NotContains(t, message, "ivan@example.com")
+		assert.Contains(t, message, "password=[REDACTED]")
+		assert.Contains(t, message, "postgres://[REDACTED]@db:5432/delivery")
+	})
+
+	t.Run("folds lines and cuts long messages", func(t *testing.T) {
+		assert.Equal(t, "first second", diagnostics.Sanitize("first\n\tsecond\n"))
+
+		message := diagnostics.Sanitize(strings.Repeat("x", 1000))
+		assert.Equal(t, strings.Repeat("x", 512)+"…", message)
+	})
+}
+
+func TestErrorRing(t *testing.T) {
+	at := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("returns errors newest first", func(t *testing.T) {
+		ring := diagnostics.NewErrorRing(3)
+		ring.Record(at, "job", "first")
+		ring.Record(at.Add(time.Second), "job", "second")
+
+		recent := ring.Recent()
+
+		require.Len(t, recent, 2)
+		assert.Equal(t, "second", recent[0].Message)
+		assert.Equal(t, "first", recent[1].Message)
+	})
+
+	t.Run("drops the oldest errors when full", func(t *testing.T) {
+		ring := diagnostics.NewErrorRing(2)
+		for _, message := range []string{"first", "second", "third"} {
+			ring.Record(at, "job", message)
+		}
+
+		recent := ring.Recent()
+
+		require.Len(t, recent, 2)
+		assert.Equal(t, "third", recent[0].Message)
+		assert.Equal(t, "second", recent[1].Message)
+	})
+
+	t.Run("sanitizes recorded messages", func(t *testing.T) {
+		ring := diagnostics.NewErrorRing(1)
+		ring.Record(at, "job", "password=s3cret")
+
+		assert.Equal(t, "password=[REDACTED]", ring.Recent()[0].Message)
+	})
+}
+
+func TestHandler(t *testing.T) {
+	t.Run("records errors and passes every record on", func(t *testing.T) {
+		var out bytes.Buffer
+		ring := diagnostics.NewErrorRing(10)
+		logger := slog.New(diagnostics.NewHandler(slog.NewTextHandler(&out, nil), ring))
+
+		logger.Info("Courier assigned")
+		logger.Error("Courier movement job failed", "error", errors.New("connection refused"))
+
+		recent := ring.Recent()
+		require.Len(t, recent, 1)
+		assert.Equal(t, "Courier movement job failed", recent[0].Source)
+		assert.Equal(t, "connection refused", recent[0].Message)
+		assert.Contains(t, out.String(), "Courier assigned")
+		assert.Contains(t, out.String(), "Courier movement job failed")
+	})
+
+	t.Run("records errors the wrapped handler filters out", func(t *testing.T) {
+		ring := diagnostics.NewErrorRing(10)
+		quiet := slog.NewTextHandler(&bytes.Buffer{}, &slog.HandlerOptions{Level: slog.Level(100)})
+		logger := slog.New(diagnostics.NewHandler(quiet, ring)).With("error", "disk full")
+
+		logger.Error("Synthetic data janitor job failed")
+
+		recent := ring.Recent()
+		require.Len(t, recent, 1)
+		assert.Equal(t, "disk full", recent[0].Message)
+	})
+}
+
+func TestReporter(t *testing.T) {
+	ring := diagnostics.NewErrorRing(10)
+	ring.Record(time.Now(), "job", "failed")
+	reporter := diagnostics.NewReporter(
+		diagnostics.BuildInfo{Module: "delivery"},
+		diagnostics.ConfigFingerprint(struct{ Port string }{Port: "8082"}),
+		ring,
+		50*time.Millisecond,
+		diagnostics.Dependency{Name: "postgres", Check: func(context.Context) error { return nil }},
+		diagnostics.Dependency{Name: "fraud-service", Check: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}},
+	)
+
+	report := reporter.Report(context.Background())
+
+	assert.Equal(t, "delivery", report.Build.Module)
+	assert.Len(t, report.ConfigFingerprint, 16)
+	require.Len(t, report.Dependencies, 2)
+	assert.Equal(t, diagnostics.StatusUp, report.Dependencies[0].Status)
+	assert.Equal(t, diagnostics.StatusDown, report.Dependencies[1].Status)
+	assert.Contains(t, report.Dependencies[1].Error, "deadline exceeded")
+	assert.Len(t, report.RecentErrors, 1)
+}
+
+func TestConfigFingerprint(t *testing.T) {
+	type config struct{ Port, Password string }
+
+	same := diagnostics.ConfigFingerprint(config{Port: "8082", Password: "secret"})
+
+	assert.Equal(t, same, diagnostics.ConfigFingerprint(config{Port: "8082", Password: "secret"}))
+	assert.NotEqual(t, same, diagnostics.ConfigFingerprint(config{Port: "8082", Password: "other"}))
+	assert.NotContains(t, same, "secret")
+}
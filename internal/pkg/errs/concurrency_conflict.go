@@ -0,0 +1,32 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrConcurrencyConflict = errors.New("concurrency conflict")
+
+// ConcurrencyConflictError reports that an aggregate was changed by another transaction since it was
+// loaded. The operation can be retried on a freshly loaded aggregate.
+type ConcurrencyConflictError struct {
+	ParamName string
+	ID        any
+	Version   int
+}
+
+func NewConcurrencyConflictError(paramName string, id any, version int) *ConcurrencyConflictError {
+	return &ConcurrencyConflictError{
+		ParamName: paramName,
+		ID:        id,
+		Version:   version,
+	}
+}
+
+func (e *ConcurrencyConflictError) Error() string {
+	return fmt.Sprintf("%s: %s %s is no longer at version %d", ErrConcurrencyConflict, e.ParamName, e.ID, e.Version)
+}
+
+func (e *ConcurrencyConflictError) Unwrap() error {
+	return ErrConcurrencyConflict
+}
@@ -6,6 +6,9 @@
 //   - ValueIsRequiredError: For when a required value is missing
 //   - ValueIsInvalidError: For when a value is invalid
 //   - ObjectNotFoundError: For when an object cannot be found
+//   - QueryTimeoutError: For when the database cancels a statement that ran too long
+//   - ConcurrencyConflictError: For when another transaction changed an aggregate first
+//   - ValidationErrors: For collecting the field-scoped failures of a constructor
 //   - Other specialized error types for specific validation failures
 //
 // Each error type follows a consistent pattern:
@@ -138,6 +138,92 @@ func TestVersionIsInvalidError(t *testing.T) {
 	})
 }
 
+func TestQueryTimeoutError(t *testing.T) {
+	t.Run("NewQueryTimeoutError", func(t *testing.T) {
+		err := errs.NewQueryTimeoutError("query")
+
+		assert.Equal(t, "query", err.Workload)
+		require.NoError(t, err.Cause)
+		assert.Equal(t, "query timed out: query", err.Error())
+		assert.Equal(t, errs.ErrQueryTimeout, err.Unwrap())
+	})
+
+	t.Run("NewQueryTimeoutErrorWithCause", func(t *testing.T) {
+		cause := errors.New("canceling statement due to statement timeout")
+		err := errs.NewQueryTimeoutErrorWithCause("command", cause)
+
+		assert.Equal(t, "command", err.Workload)
+		assert.Equal(t, cause, err.Cause)
+		assert.Equal(t, "query timed out: command (cause: canceling statement due to statement timeout)", err.Error())
+		assert.Equal(t, errs.ErrQueryTimeout, err.Unwrap())
+	})
+}
+
+func TestConcurrencyConflictError(t *testing.T) {
+	err := errs.NewConcurrencyConflictError("order", "42", 3)
+
+	assert.Equal(t, "order", err.ParamName)
+	assert.Equal(t, "42", err.ID)
+	assert.Equal(t, 3, err.Version)
+	assert.Equal(t, "concurrency conflict: order 42 is no longer at version 3", err.Error())
+	require.ErrorIs(t, err, errs.ErrConcurrencyConflict)
+}
+
+func TestValidationErrors(t *testing.T) {
+	t.Run("JoinFields returns nil when every field is valid", func(t *testing.T) {
+		require.NoError(t, errs.JoinFields(errs.Field("name", nil), errs.Field("speed", nil)))
+	})
+
+	t.Run("JoinFields keeps failed fields in order", func(t *testing.T) {
+		err := errs.JoinFields(
+			errs.Field("name", errs.NewValueIsRequiredError("name")),
+			errs.Field("id", nil),
+			errs.Field("speed", errs.NewValueIsOutOfRangeError("speed", 0, 1, 10)),
+		)
+
+		var validation *errs.ValidationErrors
+		require.ErrorAs(t, err, &validation)
+		require.Len(t, validation.Fields, 2)
+		assert.Equal(t, "name", validation.Fields[0].Field)
+		assert.Equal(t, "speed", validation.Fields[1].Field)
+		assert.Equal(t,
+			"value is required: name\nvalue is invalid: 0 is speed, min value is 1, max value is 10",
+			err.Error())
+	})
+
+	t.Run("errors.Is and errors.As reach the field errors", func(t *testing.T) {
+		err := errs.JoinFields(errs.Field("email", errs.NewValueIsInvalidError("email")))
+
+		require.ErrorIs(t, err, errs.ErrValidationFailed)
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+		assert.NotErrorIs(t, err, errs.ErrValueIsRequired)
+
+		var invalid *errs.ValueIsInvalidError
+		require.ErrorAs(t, err, &invalid)
+		assert.Equal(t, "email", invalid.ParamName)
+	})
+
+	t.Run("nested errors are merged with prefixed paths", func(t *testing.T) {
+		location := errs.JoinFields(errs.Field("x", errs.NewValueIsInvalidError("x")))
+
+		var validation errs.ValidationErrors
+		validation.Add("location", location)
+		validation.Merge(errs.JoinFields(errs.Field("volume", errs.NewValueIsInvalidError("volume"))))
+		validation.Merge(errors.New("plain"))
+
+		require.Error(t, validation.Err())
+		assert.Equal(t, "location.x", validation.Fields[0].Field)
+		assert.Equal(t, "volume", validation.Fields[1].Field)
+		assert.Empty(t, validation.Fields[2].Field)
+	})
+
+	t.Run("Err of empty collection is nil", func(t *testing.T) {
+		var validation errs.ValidationErrors
+
+		require.NoError(t, validation.Err())
+	})
+}
+
 func TestSentinelErrors(t *testing.T) {
 	t.Run("sentinel errors are defined", func(t *testing.T) {
 		require.Error(t, errs.ErrObjectNotFound)
@@ -145,6 +231,7 @@ func TestSentinelErrors(t *testing.T) {
 		require.Error(t, errs.ErrValueIsOutOfRange)
 		require.Error(t, errs.ErrValueIsRequired)
 		require.Error(t, errs.ErrVersionIsInvalid)
+		require.Error(t, errs.ErrQueryTimeout)
 	})
 
 	t.Run("error messages match expectations", func(t *testing.T) {
@@ -153,6 +240,7 @@ func TestSentinelErrors(t *testing.T) {
 		assert.Equal(t, "value is out of range", errs.ErrValueIsOutOfRange.Error())
 		assert.Equal(t, "value is required", errs.ErrValueIsRequired.Error())
 		assert.Equal(t, "version is invalid", errs.ErrVersionIsInvalid.Error())
+		assert.Equal(t, "query timed out", errs.ErrQueryTimeout.Error())
 	})
 }
 
@@ -0,0 +1,37 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrQueryTimeout = errors.New("query timed out")
+
+type QueryTimeoutError struct {
+	Workload string
+	Cause    error
+}
+
+func NewQueryTimeoutErrorWithCause(workload string, cause error) *QueryTimeoutError {
+	return &QueryTimeoutError{
+		Workload: workload,
+		Cause:    cause,
+	}
+}
+
+func NewQueryTimeoutError(workload string) *QueryTimeoutError {
+	return &QueryTimeoutError{
+		Workload: workload,
+	}
+}
+
+func (e *QueryTimeoutError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %s (cause: %v)", ErrQueryTimeout, e.Workload, e.Cause)
+	}
+	return fmt.Sprintf("%s: %s", ErrQueryTimeout, e.Workload)
+}
+
+func (e *QueryTimeoutError) Unwrap() error {
+	return ErrQueryTimeout
+}
@@ -0,0 +1,116 @@
+package errs
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrValidationFailed = errors.New("validation failed")
+
+// FieldError is the validation failure of a single field.
+// Field is the path of the field, nested fields are separated by dots ("location.x").
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func (e FieldError) Error() string {
+	return e.Err.Error()
+}
+
+func (e FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Field scopes err to the named field; a nil err means the field is valid.
+// Pass the result to JoinFields or ValidationErrors.Add.
+func Field(name string, err error) FieldError {
+	return FieldError{Field: name, Err: err}
+}
+
+// ValidationErrors collects the field errors of a constructor, so callers can report every
+// invalid field at once. errors.Is and errors.As see each collected error as well as
+// ErrValidationFailed; Error() keeps the messages of errors.Join, one per line.
+type ValidationErrors struct {
+	Fields []FieldError
+}
+
+// JoinFields returns a *ValidationErrors with the failed fields, or nil if every field is valid.
+//
+// Example:
+//
+//	if err := errs.JoinFields(
+//	    errs.Field("name", c.setName(name)),
+//	    errs.Field("speed", c.setSpeed(speed)),
+//	); err != nil {
+//	    return nil, err
+//	}
+func JoinFields(fields ...FieldError) error {
+	var validation ValidationErrors
+	for _, field := range fields {
+		validation.Add(field.Field, field.Err)
+	}
+	return validation.Err()
+}
+
+// Add records err for the field, ignoring nil errors. The field errors of a nested
+// ValidationErrors are merged with their paths prefixed by field.
+func (e *ValidationErrors) Add(field string, err error) {
+	if err == nil {
+		return
+	}
+
+	var nested *ValidationErrors
+	if errors.As(err, &nested) {
+		for _, inner := range nested.Fields {
+			e.Fields = append(e.Fields, FieldError{Field: joinFieldPath(field, inner.Field), Err: inner.Err})
+		}
+		return
+	}
+
+	e.Fields = append(e.Fields, FieldError{Field: field, Err: err})
+}
+
+// Merge adds the field errors of err, keeping their paths; any other error is recorded without a field.
+func (e *ValidationErrors) Merge(err error) {
+	e.Add("", err)
+}
+
+// Err returns e if it holds any field error, nil otherwise.
+func (e *ValidationErrors) Err() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e *ValidationErrors) Error() string {
+	messages := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		messages[i] = field.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+func (e *ValidationErrors) Is(target error) bool {
+	return target == ErrValidationFailed
+}
+
+func (e *ValidationErrors) Unwrap() []error {
+	unwrapped := make([]error, len(e.Fields))
+	for i, field := range e.Fields {
+		unwrapped[i] = field.Err
+	}
+	return unwrapped
+}
+
+func joinFieldPath(parent string, child string) string {
+	switch {
+	case parent == "":
+		return child
+	case child == "":
+		return parent
+	default:
+		return parent + "." + child
+	}
+}
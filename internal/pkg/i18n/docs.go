@@ -0,0 +1,14 @@
+// Package i18n provides localization of user-facing strings for the delivery application.
+// It holds the message catalogs for every supported language and resolves the language
+// to use for a request or a courier.
+//
+// The package is built around a few concepts:
+//   - Language: a supported language identified by its ISO 639-1 code (ru, en)
+//   - MessageKey: a stable identifier of a translatable string
+//   - Translate: looks a key up in the catalog and formats it with arguments
+//   - FromAcceptLanguage: negotiates a Language from an HTTP Accept-Language header
+//
+// Lookups never fail: a key missing in the requested language falls back to the
+// DefaultLanguage catalog and finally to the key itself, so a gap in a catalog
+// degrades to English rather than to an error.
+package i18n
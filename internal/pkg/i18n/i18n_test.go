@@ -0,0 +1,87 @@
+package i18n_test
+
+import (
+	"strings"
+	"testing"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/i18n"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLanguage(t *testing.T) {
+	t.Run("supported tags", func(t *testing.T) {
+		for tag, expected := range map[string]i18n.Language{
+			"ru":    i18n.Russian,
+			"en":    i18n.English,
+			"EN-us": i18n.English,
+			" ru ":  i18n.Russian,
+		} {
+			lang, err := i18n.ParseLanguage(tag)
+			require.NoError(t, err, tag)
+			assert.Equal(t, expected, lang, tag)
+		}
+	})
+
+	t.Run("unsupported tag", func(t *testing.T) {
+		_, err := i18n.ParseLanguage("de")
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+}
+
+func TestFromAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected i18n.Language
+	}{
+		{name: "empty header uses fallback", header: "", expected: i18n.English},
+		{name: "single language", header: "ru-RU", expected: i18n.Russian},
+		{name: "first of equal quality wins", header: "en, ru", expected: i18n.English},
+		{name: "quality values are honoured", header: "en;q=0.3, ru;q=0.9", expected: i18n.Russian},
+		{name: "unsupported languages are skipped", header: "de, fr;q=0.9, ru;q=0.5", expected: i18n.Russian},
+		{name: "nothing supported uses fallback", header: "de, fr", expected: i18n.English},
+		{name: "malformed quality is skipped", header: "ru;q=abc, en;q=0.1", expected: i18n.English},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, i18n.FromAcceptLanguage(tt.header, i18n.English))
+		})
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	t.Run("formats arguments", func(t *testing.T) {
+		assert.Equal(t, "Invalid identifier: bad uuid", i18n.Translate(i18n.English, i18n.InvalidIdentifier, "bad uuid"))
+		assert.Equal(t, "Некорректный идентификатор: bad uuid",
+			i18n.Translate(i18n.Russian, i18n.InvalidIdentifier, "bad uuid"))
+	})
+
+	t.Run("courier-facing strings", func(t *testing.T) {
+		assert.Equal(t, "Сумка", i18n.Translate(i18n.Russian, i18n.DefaultBagName))
+		assert.Equal(t, "Bag", i18n.Translate(i18n.English, i18n.DefaultBagName))
+	})
+
+	t.Run("unsupported language falls back to default", func(t *testing.T) {
+		assert.Equal(t, "Bag", i18n.Translate(i18n.Language("de"), i18n.DefaultBagName))
+	})
+
+	t.Run("unknown key is returned as is", func(t *testing.T) {
+		assert.Equal(t, "no.such.key", i18n.Translate(i18n.Russian, i18n.MessageKey("no.such.key")))
+	})
+
+	t.Run("russian catalog has no english leftovers", func(t *testing.T) {
+		for _, key := range []i18n.MessageKey{
+			i18n.InvalidRequestBody,
+			i18n.StoragePlaceIsOccupied,
+			i18n.FailedToRetrieveTracking,
+		} {
+			ru := i18n.Translate(i18n.Russian, key)
+			assert.NotEqual(t, i18n.Translate(i18n.English, key), ru, key)
+			assert.False(t, strings.ContainsAny(ru, "abcdefghijklmnopqrstuvwxyz"), key)
+		}
+	})
+}
@@ -0,0 +1,96 @@
+package i18n
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"delivery/internal/pkg/errs"
+)
+
+// Language is a supported language identified by its ISO 639-1 code.
+type Language string
+
+const (
+	// Russian is the language couriers are onboarded with by default.
+	Russian Language = "ru"
+	// English is the language of API messages when the client expresses no preference.
+	English Language = "en"
+)
+
+// DefaultLanguage is used when no supported language can be negotiated.
+const DefaultLanguage = English
+
+// getSupportedLanguages returns the set of languages that have a message catalog.
+func getSupportedLanguages() map[Language]struct{} {
+	return map[Language]struct{}{
+		Russian: {},
+		English: {},
+	}
+}
+
+// ParseLanguage converts a language tag such as "ru" or "en-US" to a supported Language.
+// Only the primary subtag is considered and matching is case-insensitive.
+// Returns a ValueIsInvalidError for unsupported languages.
+func ParseLanguage(tag string) (Language, error) {
+	primary, _, _ := strings.Cut(strings.TrimSpace(tag), "-")
+	lang := Language(strings.ToLower(primary))
+
+	if err := lang.Validate(); err != nil {
+		return "", err
+	}
+	return lang, nil
+}
+
+// Validate checks if the Language has a message catalog.
+func (l Language) Validate() error {
+	if _, ok := getSupportedLanguages()[l]; !ok {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"language is invalid",
+			fmt.Errorf("%q is not a supported language", string(l)),
+		)
+	}
+	return nil
+}
+
+// String returns the ISO 639-1 code of the language.
+func (l Language) String() string {
+	return string(l)
+}
+
+// FromAcceptLanguage picks the most preferred supported language from an HTTP
+// Accept-Language header, honouring quality values. Returns fallback if the header
+// is empty or names no supported language.
+//
+// Example:
+//
+//	FromAcceptLanguage("de, ru;q=0.8, en;q=0.5", i18n.English) // i18n.Russian
+func FromAcceptLanguage(header string, fallback Language) Language {
+	best := fallback
+	bestQuality := 0.0
+
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+
+		lang, err := ParseLanguage(tag)
+		if err != nil {
+			continue
+		}
+
+		quality := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, parseErr := strconv.ParseFloat(value, 64)
+			if parseErr != nil {
+				continue
+			}
+			quality = parsed
+		}
+
+		if quality > bestQuality {
+			best = lang
+			bestQuality = quality
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,507 @@
+package i18n
+
+import "fmt"
+
+// MessageKey identifies a translatable string. Keys are stable and never shown to users.
+type MessageKey string
+
+// Courier-facing strings.
+const (
+	// DefaultBagName names the storage bag every new courier starts with.
+	DefaultBagName MessageKey = "courier.default_bag_name"
+
+	// SurgeBonusInvitation invites off-shift couriers to start a shift while the surge mode is on.
+	SurgeBonusInvitation MessageKey = "courier.surge_bonus_invitation"
+)
+
+// API error messages. Keys ending in "_detail" take the underlying error text as their only argument.
+const (
+	InvalidRequestBody              MessageKey = "api.invalid_request_body"
+	InvalidIdentifier               MessageKey = "api.invalid_identifier_detail"
+	InvalidCourierData              MessageKey = "api.invalid_courier_data_detail"
+	InvalidOrderData                MessageKey = "api.invalid_order_data_detail"
+	InvalidMaintenanceRequest       MessageKey = "api.invalid_maintenance_request_detail"
+	InvalidDeactivationRequest      MessageKey = "api.invalid_deactivation_request_detail"
+	InvalidCourierProfile           MessageKey = "api.invalid_courier_profile_detail"
+	InvalidReviewApproval           MessageKey = "api.invalid_review_approval_detail"
+	InvalidThreadRequest            MessageKey = "api.invalid_thread_request_detail"
+	InvalidMessage                  MessageKey = "api.invalid_message_detail"
+	InvalidTrackingRequest          MessageKey = "api.invalid_tracking_request_detail"
+	InvalidETARequest               MessageKey = "api.invalid_eta_request_detail"
+	InvalidOrderStateRequest        MessageKey = "api.invalid_order_state_request_detail"
+	InvalidTrackingToken            MessageKey = "api.invalid_tracking_token"
+	InvalidTenant                   MessageKey = "api.invalid_tenant_detail"
+	InvalidTenantToken              MessageKey = "api.invalid_tenant_token"
+	TenantTokenIsRequired           MessageKey = "api.tenant_token_is_required"
+	TenantDoesNotMatchToken         MessageKey = "api.tenant_does_not_match_token"
+	InvalidSyntheticDataHeader      MessageKey = "api.invalid_synthetic_data_header_detail"
+	InvalidSyntheticDataPurge       MessageKey = "api.invalid_synthetic_data_purge_detail"
+	InvalidRolloutChange            MessageKey = "api.invalid_rollout_change_detail"
+	InvalidOrderUpload              MessageKey = "api.invalid_order_upload_detail"
+	InvalidCourierImport            MessageKey = "api.invalid_courier_import_detail"
+	InvalidShiftRequest             MessageKey = "api.invalid_shift_request_detail"
+	InvalidAnnouncement             MessageKey = "api.invalid_announcement_detail"
+	InvalidPickupSlot               MessageKey = "api.invalid_pickup_slot_detail"
+	InvalidTip                      MessageKey = "api.invalid_tip_detail"
+	InvalidRating                   MessageKey = "api.invalid_rating_detail"
+	InvalidPayoutPeriod             MessageKey = "api.invalid_payout_period_detail"
+	InvalidFieldSelection           MessageKey = "api.invalid_field_selection_detail"
+	InvalidPaymentEvent             MessageKey = "api.invalid_payment_event_detail"
+	InvalidPaymentSignature         MessageKey = "api.invalid_payment_signature"
+	InvalidMaintenanceWindow        MessageKey = "api.invalid_maintenance_window_detail"
+	InvalidChangeFeedRequest        MessageKey = "api.invalid_change_feed_request_detail"
+	InvalidOrdersPageRequest        MessageKey = "api.invalid_orders_page_request_detail"
+	InvalidAbsence                  MessageKey = "api.invalid_absence_detail"
+	InvalidHandoverConfirmation     MessageKey = "api.invalid_handover_confirmation_detail"
+	InvalidOrderCancellation        MessageKey = "api.invalid_order_cancellation_detail"
+	InvalidBulkCancellation         MessageKey = "api.invalid_bulk_cancellation_detail"
+	InvalidAPIKey                   MessageKey = "api.invalid_api_key_detail"
+	InvalidUsagePeriod              MessageKey = "api.invalid_usage_period_detail"
+	InvalidDeviceTelemetry          MessageKey = "api.invalid_device_telemetry_detail"
+	InvalidExternalReference        MessageKey = "api.invalid_external_reference_detail"
+	InvalidSurgeChange              MessageKey = "api.invalid_surge_change_detail"
+	InvalidSLATargets               MessageKey = "api.invalid_sla_targets_detail"
+	InvalidMatchingRules            MessageKey = "api.invalid_matching_rules_detail"
+	InvalidSLADay                   MessageKey = "api.invalid_sla_day_detail"
+	InvalidSLAReportPeriod          MessageKey = "api.invalid_sla_report_period_detail"
+	InvalidCourierLocations         MessageKey = "api.invalid_courier_locations_detail"
+	InvalidCourierLocation          MessageKey = "api.invalid_courier_location_detail"
+	InvalidWhatIfRequest            MessageKey = "api.invalid_what_if_request_detail"
+	InvalidOrderTransfer            MessageKey = "api.invalid_order_transfer_detail"
+	InvalidBlobUpload               MessageKey = "api.invalid_blob_upload_detail"
+	InvalidCourierMerge             MessageKey = "api.invalid_courier_merge_detail"
+	InvalidCourierSchedule          MessageKey = "api.invalid_courier_schedule_detail"
+	InvalidAvailabilityFeedRequest  MessageKey = "api.invalid_availability_feed_request_detail"
+	InvalidPersonalDataErasure      MessageKey = "api.invalid_personal_data_erasure_detail"
+	BlobStorageIsNotConfigured      MessageKey = "api.blob_storage_is_not_configured"
+	APIKeyIsRequired                MessageKey = "api.api_key_is_required"
+	APIQuotaExceeded                MessageKey = "api.api_quota_exceeded"
+	StoragePlaceIsOccupied          MessageKey = "api.storage_place_is_occupied"
+	DailyWorkingHoursExceeded       MessageKey = "api.daily_working_hours_exceeded"
+	IdentityProofIsRequired         MessageKey = "api.identity_proof_is_required"
+	IdentityVerificationFailed      MessageKey = "api.identity_verification_failed"
+	TooManyVerificationAttempts     MessageKey = "api.too_many_verification_attempts"
+	PickupSlotCapacityBelowBookings MessageKey = "api.pickup_slot_capacity_below_bookings"
+	OrderThreadIsClosed             MessageKey = "api.order_thread_is_closed"
+	OrderTrackingIsClosed           MessageKey = "api.order_tracking_is_closed"
+	OrderIsNotAssigned              MessageKey = "api.order_is_not_assigned"
+	OrderIsNotDelivered             MessageKey = "api.order_is_not_delivered"
+	OrderIsAlreadyTipped            MessageKey = "api.order_is_already_tipped"
+	OrderIsAlreadyRated             MessageKey = "api.order_is_already_rated"
+	ExternalOrderIsAlreadyLinked    MessageKey = "api.external_order_is_already_linked"
+	TrackingLinkNotFound            MessageKey = "api.tracking_link_not_found"
+	FailedToRetrieveCouriers        MessageKey = "api.failed_to_retrieve_couriers"
+	FailedToGenerateCourierLocation MessageKey = "api.failed_to_generate_courier_location"
+	FailedToCreateCourier           MessageKey = "api.failed_to_create_courier"
+	FailedToCheckFleetCapacity      MessageKey = "api.failed_to_check_fleet_capacity"
+	FailedToCreateOrder             MessageKey = "api.failed_to_create_order"
+	FailedToRetrieveOrders          MessageKey = "api.failed_to_retrieve_orders"
+	FailedToChangeMaintenance       MessageKey = "api.failed_to_change_maintenance"
+	FailedToDeactivateCourier       MessageKey = "api.failed_to_deactivate_courier"
+	FailedToUpdateCourierProfile    MessageKey = "api.failed_to_update_courier_profile"
+	FailedToRetrieveReviewQueue     MessageKey = "api.failed_to_retrieve_review_queue"
+	FailedToApproveOrderReview      MessageKey = "api.failed_to_approve_order_review"
+	FailedToRetrieveOrderMessages   MessageKey = "api.failed_to_retrieve_order_messages"
+	FailedToPostOrderMessage        MessageKey = "api.failed_to_post_order_message"
+	FailedToShareOrderTracking      MessageKey = "api.failed_to_share_order_tracking"
+	FailedToRecalculateETA          MessageKey = "api.failed_to_recalculate_eta"
+	FailedToRetrieveTracking        MessageKey = "api.failed_to_retrieve_tracking"
+	FailedToPurgeSyntheticData      MessageKey = "api.failed_to_purge_synthetic_data"
+	FailedToChangeRollout           MessageKey = "api.failed_to_change_rollout"
+	FailedToImportOrders            MessageKey = "api.failed_to_import_orders"
+	FailedToImportCouriers          MessageKey = "api.failed_to_import_couriers"
+	FailedToChangeShift             MessageKey = "api.failed_to_change_shift"
+	FailedToRetrieveWorkingHours    MessageKey = "api.failed_to_retrieve_working_hours"
+	FailedToExplainAssignment       MessageKey = "api.failed_to_explain_assignment"
+	FailedToBroadcastAnnouncement   MessageKey = "api.failed_to_broadcast_announcement"
+	FailedToRetrieveAnnouncements   MessageKey = "api.failed_to_retrieve_announcements"
+	FailedToCreatePickupSlot        MessageKey = "api.failed_to_create_pickup_slot"
+	FailedToChangePickupSlot        MessageKey = "api.failed_to_change_pickup_slot"
+	FailedToRetrievePickupSlots     MessageKey = "api.failed_to_retrieve_pickup_slots"
+	FailedToTipOrder                MessageKey = "api.failed_to_tip_order"
+	FailedToRateOrder               MessageKey = "api.failed_to_rate_order"
+	FailedToExportPayouts           MessageKey = "api.failed_to_export_payouts"
+	FailedToUpdatePayment           MessageKey = "api.failed_to_update_payment"
+	FailedToScheduleMaintenance     MessageKey = "api.failed_to_schedule_maintenance"
+	FailedToCancelMaintenance       MessageKey = "api.failed_to_cancel_maintenance"
+	FailedToRetrieveMaintenance     MessageKey = "api.failed_to_retrieve_maintenance"
+	FailedToRetrieveChanges         MessageKey = "api.failed_to_retrieve_changes"
+	FailedToPlanAbsence             MessageKey = "api.failed_to_plan_absence"
+	FailedToCancelAbsence           MessageKey = "api.failed_to_cancel_absence"
+	FailedToChangeInsurance         MessageKey = "api.failed_to_change_insurance"
+	FailedToConfirmHandover         MessageKey = "api.failed_to_confirm_handover"
+	FailedToCancelOrder             MessageKey = "api.failed_to_cancel_order"
+	FailedToRetrieveMicrozones      MessageKey = "api.failed_to_retrieve_microzones"
+	FailedToRecomputeMicrozones     MessageKey = "api.failed_to_recompute_microzones"
+	FailedToMeterAPIUsage           MessageKey = "api.failed_to_meter_api_usage"
+	FailedToRetrieveAPIUsage        MessageKey = "api.failed_to_retrieve_api_usage"
+	FailedToRecordDeviceTelemetry   MessageKey = "api.failed_to_record_device_telemetry"
+	FailedToRetrieveDeviceHealth    MessageKey = "api.failed_to_retrieve_device_health"
+	FailedToRetrieveLinkedOrder     MessageKey = "api.failed_to_retrieve_linked_order"
+	FailedToChangeSurgeMode         MessageKey = "api.failed_to_change_surge_mode"
+	FailedToRetrieveSurgeMode       MessageKey = "api.failed_to_retrieve_surge_mode"
+	FailedToRetrieveSLATargets      MessageKey = "api.failed_to_retrieve_sla_targets"
+	FailedToReplaceSLATargets       MessageKey = "api.failed_to_replace_sla_targets"
+	FailedToRetrieveMatchingRules   MessageKey = "api.failed_to_retrieve_matching_rules"
+	FailedToRetrieveBadgeRules      MessageKey = "api.failed_to_retrieve_badge_rules"
+	FailedToRetrieveCourierStats    MessageKey = "api.failed_to_retrieve_courier_stats"
+	FailedToReplaceMatchingRules    MessageKey = "api.failed_to_replace_matching_rules"
+	FailedToComputeSLACompliance    MessageKey = "api.failed_to_compute_sla_compliance"
+	FailedToRetrieveSLAReport       MessageKey = "api.failed_to_retrieve_sla_report"
+	FailedToImportCourierLocations  MessageKey = "api.failed_to_import_courier_locations"
+	FailedToUpdateCourierLocation   MessageKey = "api.failed_to_update_courier_location"
+	FailedToAnalyzeFleet            MessageKey = "api.failed_to_analyze_fleet"
+	FailedToTransferOrder           MessageKey = "api.failed_to_transfer_order"
+	FailedToIssueBlobUpload         MessageKey = "api.failed_to_issue_blob_upload"
+	FailedToRetrieveSLOStatus       MessageKey = "api.failed_to_retrieve_slo_status"
+	FailedToMergeCouriers           MessageKey = "api.failed_to_merge_couriers"
+	FailedToEstimateETA             MessageKey = "api.failed_to_estimate_eta"
+	FailedToSetCourierSchedule      MessageKey = "api.failed_to_set_courier_schedule"
+	FailedToClearCourierSchedule    MessageKey = "api.failed_to_clear_courier_schedule"
+	FailedToRetrieveAvailability    MessageKey = "api.failed_to_retrieve_availability"
+	FailedToRetrieveOrderHistory    MessageKey = "api.failed_to_retrieve_order_history"
+	FailedToRetrieveOrderState      MessageKey = "api.failed_to_retrieve_order_state"
+	FailedToErasePersonalData       MessageKey = "api.failed_to_erase_personal_data"
+	FailedToClearCourierReviewFlag  MessageKey = "api.failed_to_clear_courier_review_flag"
+	FailedToChangeBreak             MessageKey = "api.failed_to_change_break"
+	FailedToCancelOrdersInBulk      MessageKey = "api.failed_to_cancel_orders_in_bulk"
+)
+
+// getCatalogs returns the message catalogs of all supported languages.
+// Every key must be present in the DefaultLanguage catalog.
+func getCatalogs() map[Language]map[MessageKey]string {
+	return map[Language]map[MessageKey]string{
+		English: {
+			DefaultBagName:       "Bag",
+			SurgeBonusInvitation: "Peak hours: orders are waiting for couriers. Start a shift now to earn the surge bonus.",
+
+			InvalidRequestBody:              "Invalid request body",
+			InvalidIdentifier:               "Invalid identifier: %s",
+			InvalidCourierData:              "Invalid courier data: %s",
+			InvalidOrderData:                "Invalid order data: %s",
+			InvalidMaintenanceRequest:       "Invalid maintenance request: %s",
+			InvalidDeactivationRequest:      "Invalid deactivation request: %s",
+			InvalidCourierProfile:           "Invalid courier profile: %s",
+			InvalidReviewApproval:           "Invalid review approval: %s",
+			InvalidThreadRequest:            "Invalid thread request: %s",
+			InvalidMessage:                  "Invalid message: %s",
+			InvalidTrackingRequest:          "Invalid tracking request: %s",
+			InvalidETARequest:               "Invalid ETA request: %s",
+			InvalidOrderStateRequest:        "Invalid order state request: %s",
+			InvalidTrackingToken:            "Invalid tracking token",
+			InvalidTenant:                   "Invalid tenant: %s",
+			InvalidTenantToken:              "Tenant token is invalid",
+			TenantTokenIsRequired:           "Tenant %s requires its token in the Authorization header",
+			TenantDoesNotMatchToken:         "The token does not authenticate tenant %s",
+			InvalidSyntheticDataHeader:      "Invalid X-Synthetic-Data header, expected true or false: %s",
+			InvalidSyntheticDataPurge:       "Invalid synthetic data purge request: %s",
+			InvalidRolloutChange:            "Invalid rollout change: %s",
+			InvalidOrderUpload:              "Invalid order upload: %s",
+			InvalidCourierImport:            "Invalid courier import: %s",
+			InvalidShiftRequest:             "Invalid shift request: %s",
+			InvalidAnnouncement:             "Invalid announcement: %s",
+			InvalidPickupSlot:               "Invalid pickup slot: %s",
+			InvalidTip:                      "Invalid tip: %s",
+			InvalidRating:                   "Invalid rating: %s",
+			InvalidPayoutPeriod:             "Invalid payout period: %s",
+			InvalidFieldSelection:           "Invalid fields parameter: %s",
+			InvalidPaymentEvent:             "Invalid payment event: %s",
+			InvalidPaymentSignature:         "Payment event signature is missing or invalid",
+			InvalidMaintenanceWindow:        "Invalid maintenance window: %s",
+			InvalidChangeFeedRequest:        "Invalid change feed request: %s",
+			InvalidOrdersPageRequest:        "Invalid orders page request: %s",
+			InvalidAbsence:                  "Invalid absence: %s",
+			InvalidHandoverConfirmation:     "Invalid handover confirmation: %s",
+			InvalidOrderCancellation:        "Invalid order cancellation: %s",
+			InvalidBulkCancellation:         "Invalid bulk cancellation: %s",
+			InvalidAPIKey:                   "Invalid X-API-Key header: %s",
+			InvalidUsagePeriod:              "Invalid usage month: %s",
+			InvalidDeviceTelemetry:          "Invalid device telemetry: %s",
+			InvalidExternalReference:        "Invalid external reference: %s",
+			InvalidSurgeChange:              "Invalid surge mode change: %s",
+			InvalidSLATargets:               "Invalid SLA targets: %s",
+			InvalidMatchingRules:            "Invalid matching rules: %s",
+			InvalidSLADay:                   "Invalid SLA compliance day: %s",
+			InvalidSLAReportPeriod:          "Invalid SLA report period: %s",
+			InvalidCourierLocations:         "Invalid courier locations: %s",
+			InvalidCourierLocation:          "Invalid courier location: %s",
+			InvalidWhatIfRequest:            "Invalid what-if request: %s",
+			InvalidOrderTransfer:            "Invalid order transfer: %s",
+			InvalidBlobUpload:               "Invalid upload: %s",
+			InvalidCourierMerge:             "Invalid courier merge: %s",
+			InvalidCourierSchedule:          "Invalid courier schedule: %s",
+			InvalidAvailabilityFeedRequest:  "Invalid courier availability feed request: %s",
+			InvalidPersonalDataErasure:      "Invalid personal data erasure: %s",
+			BlobStorageIsNotConfigured:      "File uploads are not configured on this instance",
+			APIKeyIsRequired:                "X-API-Key header is required",
+			APIQuotaExceeded:                "Monthly %s quota of %d is used up, it resets at %s",
+			StoragePlaceIsOccupied:          "Storage place holds an order and cannot be taken out of service",
+			DailyWorkingHoursExceeded:       "Courier has already worked the daily working hours limit",
+			IdentityProofIsRequired:         "Courier must verify their identity to start a shift",
+			IdentityVerificationFailed:      "Courier identity could not be verified",
+			TooManyVerificationAttempts:     "Too many failed identity verification attempts, retry after %s",
+			PickupSlotCapacityBelowBookings: "More orders are booked into the pickup slot than the new capacity",
+			OrderThreadIsClosed:             "Order is completed, its thread is closed",
+			OrderTrackingIsClosed:           "Order is completed, tracking is closed",
+			OrderIsNotAssigned:              "Order is not assigned to a courier",
+			OrderIsNotDelivered:             "Order is not delivered yet",
+			OrderIsAlreadyTipped:            "Order is already tipped",
+			OrderIsAlreadyRated:             "Order is already rated differently",
+			ExternalOrderIsAlreadyLinked:    "The marketplace order is already linked to another order",
+			TrackingLinkNotFound:            "Tracking link not found",
+			FailedToRetrieveCouriers:        "Failed to retrieve couriers",
+			FailedToGenerateCourierLocation: "Failed to generate courier location",
+			FailedToCreateCourier:           "Failed to create courier",
+			FailedToCheckFleetCapacity:      "Failed to check fleet capacity",
+			FailedToCreateOrder:             "Failed to create order",
+			FailedToRetrieveOrders:          "Failed to retrieve orders",
+			FailedToChangeMaintenance:       "Failed to change storage place maintenance state",
+			FailedToDeactivateCourier:       "Failed to deactivate courier",
+			FailedToUpdateCourierProfile:    "Failed to update courier profile",
+			FailedToRetrieveReviewQueue:     "Failed to retrieve review queue",
+			FailedToApproveOrderReview:      "Failed to approve order review",
+			FailedToRetrieveOrderMessages:   "Failed to retrieve order messages",
+			FailedToPostOrderMessage:        "Failed to post order message",
+			FailedToShareOrderTracking:      "Failed to share order tracking",
+			FailedToRecalculateETA:          "Failed to recalculate ETA",
+			FailedToRetrieveTracking:        "Failed to retrieve tracking",
+			FailedToPurgeSyntheticData:      "Failed to purge synthetic data",
+			FailedToChangeRollout:           "Failed to change rollout",
+			FailedToImportOrders:            "Failed to import orders",
+			FailedToImportCouriers:          "Failed to import couriers",
+			FailedToChangeShift:             "Failed to change courier shift",
+			FailedToRetrieveWorkingHours:    "Failed to retrieve working hours",
+			FailedToExplainAssignment:       "Failed to retrieve assignment explanation",
+			FailedToBroadcastAnnouncement:   "Failed to broadcast announcement",
+			FailedToRetrieveAnnouncements:   "Failed to retrieve announcements",
+			FailedToCreatePickupSlot:        "Failed to create pickup slot",
+			FailedToChangePickupSlot:        "Failed to change pickup slot",
+			FailedToRetrievePickupSlots:     "Failed to retrieve pickup slots",
+			FailedToTipOrder:                "Failed to tip order",
+			FailedToRateOrder:               "Failed to rate order",
+			FailedToExportPayouts:           "Failed to export payouts",
+			FailedToUpdatePayment:           "Failed to update order payment",
+			FailedToScheduleMaintenance:     "Failed to schedule vehicle maintenance",
+			FailedToCancelMaintenance:       "Failed to cancel vehicle maintenance",
+			FailedToRetrieveMaintenance:     "Failed to retrieve vehicle maintenance windows",
+			FailedToRetrieveChanges:         "Failed to retrieve changes",
+			FailedToPlanAbsence:             "Failed to plan courier absence",
+			FailedToCancelAbsence:           "Failed to cancel courier absence",
+			FailedToChangeInsurance:         "Failed to change courier insurance",
+			FailedToConfirmHandover:         "Failed to confirm order handover",
+			FailedToCancelOrder:             "Failed to cancel order",
+			FailedToRetrieveMicrozones:      "Failed to retrieve microzones",
+			FailedToRecomputeMicrozones:     "Failed to recompute microzones",
+			FailedToMeterAPIUsage:           "Failed to account API usage",
+			FailedToRetrieveAPIUsage:        "Failed to retrieve API usage",
+			FailedToRecordDeviceTelemetry:   "Failed to record device telemetry",
+			FailedToRetrieveDeviceHealth:    "Failed to retrieve device health",
+			FailedToRetrieveLinkedOrder:     "Failed to retrieve the linked order",
+			FailedToChangeSurgeMode:         "Failed to change the surge mode",
+			FailedToRetrieveSurgeMode:       "Failed to retrieve the surge mode",
+			FailedToRetrieveSLATargets:      "Failed to retrieve SLA targets",
+			FailedToReplaceSLATargets:       "Failed to replace SLA targets",
+			FailedToRetrieveMatchingRules:   "Failed to retrieve matching rules",
+			FailedToRetrieveBadgeRules:      "Failed to retrieve badge rules",
+			FailedToRetrieveCourierStats:    "Failed to retrieve courier stats",
+			FailedToReplaceMatchingRules:    "Failed to replace matching rules",
+			FailedToComputeSLACompliance:    "Failed to compute SLA compliance",
+			FailedToRetrieveSLAReport:       "Failed to retrieve the SLA report",
+			FailedToImportCourierLocations:  "Failed to import courier locations",
+			FailedToUpdateCourierLocation:   "Failed to update courier location",
+			FailedToAnalyzeFleet:            "Failed to analyze the fleet",
+			FailedToTransferOrder:           "Failed to transfer the order",
+			FailedToIssueBlobUpload:         "Failed to issue the upload",
+			FailedToRetrieveSLOStatus:       "Failed to retrieve the SLO status",
+			FailedToMergeCouriers:           "Failed to merge the couriers",
+			FailedToEstimateETA:             "Failed to estimate the delivery time",
+			FailedToSetCourierSchedule:      "Failed to set the courier schedule",
+			FailedToClearCourierSchedule:    "Failed to clear the courier schedule",
+			FailedToRetrieveAvailability:    "Failed to retrieve courier availability",
+			FailedToRetrieveOrderHistory:    "Failed to retrieve the order history",
+			FailedToRetrieveOrderState:      "Failed to retrieve the order state",
+			FailedToErasePersonalData:       "Failed to erase the personal data",
+			FailedToClearCourierReviewFlag:  "Failed to clear the courier review flag",
+			FailedToChangeBreak:             "Failed to change courier break",
+			FailedToCancelOrdersInBulk:      "Failed to cancel the orders in bulk",
+		},
+		Russian: {
+			DefaultBagName:       "Сумка",
+			SurgeBonusInvitation: "Час пик: заказы ждут курьеров. Начните смену сейчас и получите бонус за работу в час пик.",
+
+			InvalidRequestBody:              "Некорректное тело запроса",
+			InvalidIdentifier:               "Некорректный идентификатор: %s",
+			InvalidCourierData:              "Некорректные данные курьера: %s",
+			InvalidOrderData:                "Некорректные данные заказа: %s",
+			InvalidMaintenanceRequest:       "Некорректный запрос на обслуживание: %s",
+			InvalidDeactivationRequest:      "Некорректный запрос на вывод из работы: %s",
+			InvalidCourierProfile:           "Некорректный профиль курьера: %s",
+			InvalidReviewApproval:           "Некорректное одобрение проверки: %s",
+			InvalidThreadRequest:            "Некорректный запрос переписки: %s",
+			InvalidMessage:                  "Некорректное сообщение: %s",
+			InvalidTrackingRequest:          "Некорректный запрос отслеживания: %s",
+			InvalidETARequest:               "Некорректный запрос прогноза доставки: %s",
+			InvalidOrderStateRequest:        "Некорректный запрос состояния заказа: %s",
+			InvalidTrackingToken:            "Некорректный токен отслеживания",
+			InvalidTenant:                   "Некорректный арендатор: %s",
+			InvalidTenantToken:              "Неверный токен арендатора",
+			TenantTokenIsRequired:           "Для арендатора %s требуется его токен в заголовке Authorization",
+			TenantDoesNotMatchToken:         "Токен не принадлежит арендатору %s",
+			InvalidSyntheticDataHeader:      "Некорректный заголовок X-Synthetic-Data, ожидается true или false: %s",
+			InvalidSyntheticDataPurge:       "Некорректный запрос очистки тестовых данных: %s",
+			InvalidRolloutChange:            "Некорректное изменение поэтапного включения: %s",
+			InvalidOrderUpload:              "Некорректный файл с заказами: %s",
+			InvalidCourierImport:            "Некорректный пакет курьеров: %s",
+			InvalidShiftRequest:             "Некорректный запрос смены: %s",
+			InvalidAnnouncement:             "Некорректное объявление: %s",
+			InvalidPickupSlot:               "Некорректный слот выдачи: %s",
+			InvalidTip:                      "Некорректные чаевые: %s",
+			InvalidRating:                   "Некорректная оценка: %s",
+			InvalidPayoutPeriod:             "Некорректный период выплат: %s",
+			InvalidFieldSelection:           "Некорректный параметр fields: %s",
+			InvalidPaymentEvent:             "Некорректное событие оплаты: %s",
+			InvalidPaymentSignature:         "Подпись события оплаты отсутствует или неверна",
+			InvalidMaintenanceWindow:        "Некорректное окно обслуживания: %s",
+			InvalidChangeFeedRequest:        "Некорректный запрос ленты изменений: %s",
+			InvalidOrdersPageRequest:        "Некорректный запрос страницы заказов: %s",
+			InvalidAbsence:                  "Некорректное отсутствие: %s",
+			InvalidHandoverConfirmation:     "Некорректное подтверждение передачи заказа: %s",
+			InvalidOrderCancellation:        "Некорректная отмена заказа: %s",
+			InvalidBulkCancellation:         "Некорректная массовая отмена заказов: %s",
+			InvalidAPIKey:                   "Некорректный заголовок X-API-Key: %s",
+			InvalidUsagePeriod:              "Некорректный месяц потребления: %s",
+			InvalidDeviceTelemetry:          "Некорректные показания устройства: %s",
+			InvalidExternalReference:        "Некорректная ссылка на заказ маркетплейса: %s",
+			InvalidSurgeChange:              "Некорректное изменение режима часа пик: %s",
+			InvalidSLATargets:               "Некорректные цели SLA: %s",
+			InvalidMatchingRules:            "Некорректные правила подбора курьеров: %s",
+			InvalidSLADay:                   "Некорректный день соблюдения SLA: %s",
+			InvalidSLAReportPeriod:          "Некорректный период отчета SLA: %s",
+			InvalidCourierLocations:         "Некорректные позиции курьера: %s",
+			InvalidCourierLocation:          "Некорректная позиция курьера: %s",
+			InvalidWhatIfRequest:            "Некорректный запрос оценки парка: %s",
+			InvalidOrderTransfer:            "Некорректная передача заказа: %s",
+			InvalidBlobUpload:               "Некорректная загрузка файла: %s",
+			InvalidCourierMerge:             "Некорректное объединение курьеров: %s",
+			InvalidCourierSchedule:          "Некорректное расписание курьера: %s",
+			InvalidAvailabilityFeedRequest:  "Некорректный запрос ленты доступности курьеров: %s",
+			InvalidPersonalDataErasure:      "Некорректный запрос на стирание персональных данных: %s",
+			BlobStorageIsNotConfigured:      "Загрузка файлов на этом экземпляре не настроена",
+			APIKeyIsRequired:                "Требуется заголовок X-API-Key",
+			APIQuotaExceeded:                "Месячная квота %s (%d) исчерпана, она обновится %s",
+			StoragePlaceIsOccupied:          "В месте хранения лежит заказ, его нельзя вывести из эксплуатации",
+			DailyWorkingHoursExceeded:       "Курьер уже отработал дневной лимит рабочего времени",
+			IdentityProofIsRequired:         "Для начала смены курьер должен подтвердить личность",
+			IdentityVerificationFailed:      "Не удалось подтвердить личность курьера",
+			TooManyVerificationAttempts:     "Слишком много неудачных попыток подтверждения личности, повторите после %s",
+			PickupSlotCapacityBelowBookings: "В слоте выдачи забронировано больше заказов, чем новая вместимость",
+			OrderThreadIsClosed:             "Заказ завершен, переписка закрыта",
+			OrderTrackingIsClosed:           "Заказ завершен, отслеживание закрыто",
+			OrderIsNotAssigned:              "Заказ не назначен курьеру",
+			OrderIsNotDelivered:             "Заказ еще не доставлен",
+			OrderIsAlreadyTipped:            "Чаевые за заказ уже оставлены",
+			OrderIsAlreadyRated:             "Заказ уже оценен иначе",
+			ExternalOrderIsAlreadyLinked:    "Заказ маркетплейса уже связан с другим заказом",
+			TrackingLinkNotFound:            "Ссылка для отслеживания не найдена",
+			FailedToRetrieveCouriers:        "Не удалось получить курьеров",
+			FailedToGenerateCourierLocation: "Не удалось определить местоположение курьера",
+			FailedToCreateCourier:           "Не удалось создать курьера",
+			FailedToCheckFleetCapacity:      "Не удалось проверить пропускную способность флота",
+			FailedToCreateOrder:             "Не удалось создать заказ",
+			FailedToRetrieveOrders:          "Не удалось получить заказы",
+			FailedToChangeMaintenance:       "Не удалось изменить состояние обслуживания места хранения",
+			FailedToDeactivateCourier:       "Не удалось вывести курьера из работы",
+			FailedToUpdateCourierProfile:    "Не удалось изменить профиль курьера",
+			FailedToRetrieveReviewQueue:     "Не удалось получить очередь проверки",
+			FailedToApproveOrderReview:      "Не удалось одобрить заказ",
+			FailedToRetrieveOrderMessages:   "Не удалось получить сообщения заказа",
+			FailedToPostOrderMessage:        "Не удалось отправить сообщение",
+			FailedToShareOrderTracking:      "Не удалось создать ссылку для отслеживания",
+			FailedToRecalculateETA:          "Не удалось пересчитать прогноз доставки",
+			FailedToRetrieveTracking:        "Не удалось получить данные отслеживания",
+			FailedToPurgeSyntheticData:      "Не удалось удалить тестовые данные",
+			FailedToChangeRollout:           "Не удалось изменить поэтапное включение",
+			FailedToImportOrders:            "Не удалось загрузить заказы",
+			FailedToImportCouriers:          "Не удалось добавить курьеров",
+			FailedToChangeShift:             "Не удалось изменить смену курьера",
+			FailedToRetrieveWorkingHours:    "Не удалось получить отчет о рабочем времени",
+			FailedToExplainAssignment:       "Не удалось получить объяснение назначения курьера",
+			FailedToBroadcastAnnouncement:   "Не удалось разослать объявление",
+			FailedToRetrieveAnnouncements:   "Не удалось получить историю объявлений",
+			FailedToCreatePickupSlot:        "Не удалось создать слот выдачи",
+			FailedToChangePickupSlot:        "Не удалось изменить слот выдачи",
+			FailedToRetrievePickupSlots:     "Не удалось получить слоты выдачи",
+			FailedToTipOrder:                "Не удалось оставить чаевые",
+			FailedToRateOrder:               "Не удалось оценить доставку",
+			FailedToExportPayouts:           "Не удалось выгрузить выплаты",
+			FailedToUpdatePayment:           "Не удалось обновить оплату заказа",
+			FailedToScheduleMaintenance:     "Не удалось запланировать обслуживание транспорта",
+			FailedToCancelMaintenance:       "Не удалось отменить обслуживание транспорта",
+			FailedToRetrieveMaintenance:     "Не удалось получить окна обслуживания транспорта",
+			FailedToRetrieveChanges:         "Не удалось получить ленту изменений",
+			FailedToPlanAbsence:             "Не удалось запланировать отсутствие курьера",
+			FailedToCancelAbsence:           "Не удалось отменить отсутствие курьера",
+			FailedToChangeInsurance:         "Не удалось изменить страховку курьера",
+			FailedToConfirmHandover:         "Не удалось подтвердить передачу заказа",
+			FailedToCancelOrder:             "Не удалось отменить заказ",
+			FailedToRetrieveMicrozones:      "Не удалось получить микрозоны",
+			FailedToRecomputeMicrozones:     "Не удалось пересчитать микрозоны",
+			FailedToMeterAPIUsage:           "Не удалось учесть потребление API",
+			FailedToRetrieveAPIUsage:        "Не удалось получить потребление API",
+			FailedToRecordDeviceTelemetry:   "Не удалось сохранить показания устройства",
+			FailedToRetrieveDeviceHealth:    "Не удалось получить состояние устройств",
+			FailedToRetrieveLinkedOrder:     "Не удалось получить связанный заказ",
+			FailedToChangeSurgeMode:         "Не удалось изменить режим часа пик",
+			FailedToRetrieveSurgeMode:       "Не удалось получить режим часа пик",
+			FailedToRetrieveSLATargets:      "Не удалось получить цели SLA",
+			FailedToReplaceSLATargets:       "Не удалось заменить цели SLA",
+			FailedToRetrieveMatchingRules:   "Не удалось получить правила подбора курьеров",
+			FailedToRetrieveBadgeRules:      "Не удалось получить правила значков курьеров",
+			FailedToRetrieveCourierStats:    "Не удалось получить статистику курьера",
+			FailedToReplaceMatchingRules:    "Не удалось заменить правила подбора курьеров",
+			FailedToComputeSLACompliance:    "Не удалось вычислить соблюдение SLA",
+			FailedToRetrieveSLAReport:       "Не удалось получить отчет SLA",
+			FailedToImportCourierLocations:  "Не удалось загрузить позиции курьера",
+			FailedToUpdateCourierLocation:   "Не удалось обновить позицию курьера",
+			FailedToAnalyzeFleet:            "Не удалось оценить парк курьеров",
+			FailedToTransferOrder:           "Не удалось передать заказ курьеру",
+			FailedToIssueBlobUpload:         "Не удалось выдать ссылку для загрузки файла",
+			FailedToRetrieveSLOStatus:       "Не удалось получить состояние SLO",
+			FailedToMergeCouriers:           "Не удалось объединить курьеров",
+			FailedToEstimateETA:             "Не удалось оценить время доставки",
+			FailedToSetCourierSchedule:      "Не удалось задать расписание курьера",
+			FailedToClearCourierSchedule:    "Не удалось удалить расписание курьера",
+			FailedToRetrieveAvailability:    "Не удалось получить доступность курьеров",
+			FailedToRetrieveOrderHistory:    "Не удалось получить историю заказа",
+			FailedToRetrieveOrderState:      "Не удалось получить состояние заказа",
+			FailedToErasePersonalData:       "Не удалось стереть персональные данные",
+			FailedToClearCourierReviewFlag:  "Не удалось снять отметку о проверке курьера",
+			FailedToChangeBreak:             "Не удалось изменить перерыв курьера",
+			FailedToCancelOrdersInBulk:      "Не удалось массово отменить заказы",
+		},
+	}
+}
+
+// Translate returns the message for key in the given language, formatted with args.
+// Falls back to the DefaultLanguage catalog and then to the key itself,
+// so it never fails and never returns an empty string.
+//
+// Example:
+//
+//	i18n.Translate(i18n.Russian, i18n.InvalidIdentifier, err.Error())
+func Translate(lang Language, key MessageKey, args ...any) string {
+	catalogs := getCatalogs()
+
+	format, ok := catalogs[lang][key]
+	if !ok {
+		format, ok = catalogs[DefaultLanguage][key]
+	}
+	if !ok {
+		return string(key)
+	}
+
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
@@ -0,0 +1,75 @@
+package metrics
+
+import "bufio"
+
+// CounterVec is a family of counters partitioned by labels. Counters only go up.
+type CounterVec struct {
+	family[float64]
+}
+
+// NewCounterVec creates a counter family in the default registry.
+// It panics if the name is already registered.
+func NewCounterVec(name, help string, labels ...string) *CounterVec {
+	return Default.NewCounterVec(name, help, labels...)
+}
+
+// NewCounterVec creates a counter family in the registry.
+// It panics if the name is already registered.
+func (r *Registry) NewCounterVec(name, help string, labels ...string) *CounterVec {
+	c := &CounterVec{family: newFamily[float64](name, help, "counter", labels)}
+	r.register(c)
+	return c
+}
+
+// Inc adds one to the counter of the label values.
+func (c *CounterVec) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+// Add adds delta to the counter of the label values. Negative deltas are ignored.
+func (c *CounterVec) Add(delta float64, labelValues ...string) {
+	if delta < 0 {
+		return
+	}
+	c.with(labelValues, zero, func(value *float64) { *value += delta })
+}
+
+func (c *CounterVec) write(w *bufio.Writer) {
+	c.each(w, func(labels string, value float64) {
+		writeSample(w, c.metricName, labels, "", value)
+	})
+}
+
+// GaugeVec is a family of gauges partitioned by labels. Gauges go up and down.
+type GaugeVec struct {
+	family[float64]
+}
+
+// NewGaugeVec creates a gauge family in the default registry.
+// It panics if the name is already registered.
+func NewGaugeVec(name, help string, labels ...string) *GaugeVec {
+	return Default.NewGaugeVec(name, help, labels...)
+}
+
+// NewGaugeVec creates a gauge family in the registry.
+// It panics if the name is already registered.
+func (r *Registry) NewGaugeVec(name, help string, labels ...string) *GaugeVec {
+	g := &GaugeVec{family: newFamily[float64](name, help, "gauge", labels)}
+	r.register(g)
+	return g
+}
+
+// Set sets the gauge of the label values.
+func (g *GaugeVec) Set(value float64, labelValues ...string) {
+	g.with(labelValues, zero, func(current *float64) { *current = value })
+}
+
+func (g *GaugeVec) write(w *bufio.Writer) {
+	g.each(w, func(labels string, value float64) {
+		writeSample(w, g.metricName, labels, "", value)
+	})
+}
+
+func zero() float64 {
+	return 0
+}
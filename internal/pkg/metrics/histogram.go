@@ -0,0 +1,70 @@
+package metrics
+
+import (
+	"bufio"
+	"math"
+	"slices"
+	"time"
+)
+
+// HistogramVec is a family of histograms partitioned by labels. Every histogram counts the
+// observations per bucket and sums them, so Prometheus can compute quantiles and averages.
+type HistogramVec struct {
+	family[histogram]
+	buckets []float64
+}
+
+type histogram struct {
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// NewHistogramVec creates a histogram family in the default registry, with the upper bounds
+// of its buckets in any order. It panics if the name is already registered.
+func NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	return Default.NewHistogramVec(name, help, buckets, labels...)
+}
+
+// NewHistogramVec creates a histogram family in the registry, with the upper bounds of its
+// buckets in any order. It panics if the name is already registered.
+func (r *Registry) NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	sorted := slices.Clone(buckets)
+	slices.Sort(sorted)
+	h := &HistogramVec{
+		family:  newFamily[histogram](name, help, "histogram", labels),
+		buckets: slices.Compact(sorted),
+	}
+	r.register(h)
+	return h
+}
+
+// Observe records value in the histogram of the label values.
+func (h *HistogramVec) Observe(value float64, labelValues ...string) {
+	create := func() histogram { return histogram{counts: make([]uint64, len(h.buckets))} }
+	h.with(labelValues, create, func(state *histogram) {
+		for i, bound := range h.buckets {
+			if value <= bound {
+				state.counts[i]++
+			}
+		}
+		state.count++
+		state.sum += value
+	})
+}
+
+// ObserveSince records the time elapsed since started, in seconds.
+func (h *HistogramVec) ObserveSince(started time.Time, labelValues ...string) {
+	h.Observe(time.Since(started).Seconds(), labelValues...)
+}
+
+func (h *HistogramVec) write(w *bufio.Writer) {
+	h.each(w, func(labels string, state histogram) {
+		for i, bound := range h.buckets {
+			writeSample(w, h.metricName+"_bucket", labels, `le="`+formatValue(bound)+`"`, float64(state.counts[i]))
+		}
+		writeSample(w, h.metricName+"_bucket", labels, `le="`+formatValue(math.Inf(1))+`"`, float64(state.count))
+		writeSample(w, h.metricName+"_sum", labels, "", state.sum)
+		writeSample(w, h.metricName+"_count", labels, "", float64(state.count))
+	})
+}
@@ -0,0 +1,217 @@
+// Package metrics exposes the application's metrics to Prometheus in the text exposition format.
+// Like expvar variables, metrics are package variables declared next to the code they measure;
+// they register in the default registry when created, and Handler serves all of them on /metrics.
+//
+// Counters, gauges and histograms are partitioned by labels. Label values must come from a small
+// fixed set, such as route patterns or outcomes, never from identifiers: every combination is a
+// separate series that lives until the process exits.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// contentType is the media type of the Prometheus text exposition format.
+const contentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// DefaultDurationBuckets are the upper bounds, in seconds, of the duration histograms:
+// from a fast database statement to a request close to its timeout.
+var DefaultDurationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Default is the registry the metrics created by NewCounterVec, NewGaugeVec and
+// NewHistogramVec register in.
+var Default = NewRegistry()
+
+// Registry holds metrics by name and writes them in the text exposition format.
+type Registry struct {
+	mu      sync.RWMutex
+	metrics map[string]metric
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{metrics: make(map[string]metric)}
+}
+
+// metric is a family of series of one name.
+type metric interface {
+	name() string
+	write(w *bufio.Writer)
+}
+
+// register adds the metric; like expvar.Publish, it panics if the name is already taken.
+func (r *Registry) register(m metric) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, taken := r.metrics[m.name()]; taken {
+		panic("metrics: reuse of metric name " + m.name())
+	}
+	r.metrics[m.name()] = m
+}
+
+// WriteTo writes all metrics sorted by name.
+func (r *Registry) WriteTo(w io.Writer) (int64, error) {
+	r.mu.RLock()
+	names := make([]string, 0, len(r.metrics))
+	for name := range r.metrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	metrics := make([]metric, len(names))
+	for i, name := range names {
+		metrics[i] = r.metrics[name]
+	}
+	r.mu.RUnlock()
+
+	counter := &countingWriter{w: w}
+	buffered := bufio.NewWriter(counter)
+	for _, m := range metrics {
+		m.write(buffered)
+	}
+	err := buffered.Flush()
+	return counter.n, err
+}
+
+// Handler serves the metrics of the registry.
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		_, _ = r.WriteTo(w)
+	})
+}
+
+// Handler serves the metrics of the default registry.
+func Handler() http.Handler {
+	return Default.Handler()
+}
+
+// family is the state shared by metrics of every type: the name, the help text and the
+// series by their label values.
+type family[S any] struct {
+	metricName string
+	help       string
+	kind       string
+	labels     []string
+
+	mu     sync.Mutex
+	series map[string]*labeled[S]
+}
+
+type labeled[S any] struct {
+	values []string
+	state  S
+}
+
+func newFamily[S any](name, help, kind string, labels []string) family[S] {
+	return family[S]{
+		metricName: name,
+		help:       help,
+		kind:       kind,
+		labels:     labels,
+		series:     make(map[string]*labeled[S]),
+	}
+}
+
+func (f *family[S]) name() string {
+	return f.metricName
+}
+
+// with runs update on the series of the label values, creating it with create on first use.
+// It panics if the number of values does not match the labels of the metric.
+func (f *family[S]) with(values []string, create func() S, update func(state *S)) {
+	if len(values) != len(f.labels) {
+		panic(fmt.Sprintf("metrics: %s takes %d label values, got %d", f.metricName, len(f.labels), len(values)))
+	}
+
+	key := strings.Join(values, "\xff")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	series, ok := f.series[key]
+	if !ok {
+		series = &labeled[S]{values: slices.Clone(values), state: create()}
+		f.series[key] = series
+	}
+	update(&series.state)
+}
+
+// each writes the header of the metric and runs sample for every series in label order.
+func (f *family[S]) each(w *bufio.Writer, sample func(labels string, state S)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	fmt.Fprintf(w, "# HELP %s %s\n", f.metricName, escapeHelp(f.help))
+	fmt.Fprintf(w, "# TYPE %s %s\n", f.metricName, f.kind)
+
+	keys := make([]string, 0, len(f.series))
+	for key := range f.series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		series := f.series[key]
+		sample(formatLabels(f.labels, series.values), series.state)
+	}
+}
+
+// formatLabels renders label pairs as the inside of a label set, without braces.
+func formatLabels(names []string, values []string) string {
+	pairs := make([]string, len(names))
+	for i, name := range names {
+		pairs[i] = name + `="` + escapeLabelValue(values[i]) + `"`
+	}
+	return strings.Join(pairs, ",")
+}
+
+// writeSample writes one sample line, adding extra to the labels of the series.
+func writeSample(w *bufio.Writer, name string, labels string, extra string, value float64) {
+	switch {
+	case labels != "" && extra != "":
+		labels = "{" + labels + "," + extra + "}"
+	case labels != "" || extra != "":
+		labels = "{" + labels + extra + "}"
+	}
+	fmt.Fprintf(w, "%s%s %s\n", name, labels, formatValue(value))
+}
+
+func formatValue(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+}
+
+func escapeHelp(help string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(help)
+}
+
+func escapeLabelValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`).Replace(value)
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
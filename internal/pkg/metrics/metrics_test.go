@@ -0,0 +1,109 @@
+package metrics_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"delivery/internal/pkg/metrics"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func exposition(t *testing.T, registry *metrics.Registry) string {
+	t.Helper()
+
+	var out strings.Builder
+	_, err := registry.WriteTo(&out)
+	require.NoError(t, err)
+	return out.String()
+}
+
+func TestRegistry_WriteTo_Counter(t *testing.T) {
+	registry := metrics.NewRegistry()
+	dispatches := registry.NewCounterVec("dispatch_attempts_total", "Dispatch attempts by outcome.", "outcome")
+
+	dispatches.Inc("assigned")
+	dispatches.Add(2, "assigned")
+	dispatches.Inc("failed")
+	dispatches.Add(-1, "failed")
+
+	assert.Equal(t, `# HELP dispatch_attempts_total Dispatch attempts by outcome.
+# TYPE dispatch_attempts_total counter
+dispatch_attempts_total{outcome="assigned"} 3
+dispatch_attempts_total{outcome="failed"} 1
+`, exposition(t, registry))
+}
+
+func TestRegistry_WriteTo_Gauge(t *testing.T) {
+	registry := metrics.NewRegistry()
+	backlog := registry.NewGaugeVec("queued_orders", "Orders waiting for a courier.")
+
+	backlog.Set(7)
+	backlog.Set(4)
+
+	assert.Equal(t, `# HELP queued_orders Orders waiting for a courier.
+# TYPE queued_orders gauge
+queued_orders 4
+`, exposition(t, registry))
+}
+
+func TestRegistry_WriteTo_Histogram(t *testing.T) {
+	registry := metrics.NewRegistry()
+	durations := registry.NewHistogramVec("request_duration_seconds", "Request durations.", []float64{1, 0.1}, "route")
+
+	durations.Observe(0.05, "/orders")
+	durations.Observe(0.5, "/orders")
+	durations.Observe(3, "/orders")
+
+	assert.Equal(t, `# HELP request_duration_seconds Request durations.
+# TYPE request_duration_seconds histogram
+request_duration_seconds_bucket{route="/orders",le="0.1"} 1
+request_duration_seconds_bucket{route="/orders",le="1"} 2
+request_duration_seconds_bucket{route="/orders",le="+Inf"} 3
+request_duration_seconds_sum{route="/orders"} 3.55
+request_duration_seconds_count{route="/orders"} 3
+`, exposition(t, registry))
+}
+
+func TestRegistry_WriteTo_SortsAndEscapes(t *testing.T) {
+	registry := metrics.NewRegistry()
+	registry.NewCounterVec("b_total", "Second.", "value").Inc("quote \" and \\ and\nnewline")
+	registry.NewCounterVec("a_total", "First\nline.").Inc()
+
+	assert.Equal(t, `# HELP a_total First\nline.
+# TYPE a_total counter
+a_total 1
+# HELP b_total Second.
+# TYPE b_total counter
+b_total{value="quote \" and \\ and\nnewline"} 1
+`, exposition(t, registry))
+}
+
+func TestRegistry_DuplicateName(t *testing.T) {
+	registry := metrics.NewRegistry()
+	registry.NewCounterVec("requests_total", "Requests.")
+
+	assert.Panics(t, func() { registry.NewGaugeVec("requests_total", "Requests.") })
+}
+
+func TestCounterVec_WrongLabelCount(t *testing.T) {
+	registry := metrics.NewRegistry()
+	counter := registry.NewCounterVec("requests_total", "Requests.", "method", "route")
+
+	assert.Panics(t, func() { counter.Inc("GET") })
+}
+
+func TestRegistry_Handler(t *testing.T) {
+	registry := metrics.NewRegistry()
+	registry.NewCounterVec("requests_total", "Requests.").Inc()
+
+	recorder := httptest.NewRecorder()
+	registry.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", recorder.Header().Get("Content-Type"))
+	assert.Contains(t, recorder.Body.String(), "requests_total 1\n")
+}
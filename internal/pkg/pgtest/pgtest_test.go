@@ -0,0 +1,41 @@
+package pgtest_test
+
+import (
+	"context"
+	"testing"
+
+	"delivery/internal/pkg/pgtest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type itemDTO struct {
+	ID   int `gorm:"primaryKey"`
+	Name string
+}
+
+func TestTemplate_DatabasesAreIsolatedClones(t *testing.T) {
+	template, err := pgtest.StartTemplate(context.Background(), func(db *gorm.DB) error {
+		if err := db.AutoMigrate(&itemDTO{}); err != nil {
+			return err
+		}
+		return db.Create(&itemDTO{ID: 1, Name: "seed"}).Error
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, template.Terminate(context.Background()))
+	})
+
+	first := template.NewDatabase(t)
+	second := template.NewDatabase(t)
+
+	require.NoError(t, first.Create(&itemDTO{ID: 2, Name: "first only"}).Error)
+
+	var firstCount, secondCount int64
+	require.NoError(t, first.Model(&itemDTO{}).Count(&firstCount).Error)
+	require.NoError(t, second.Model(&itemDTO{}).Count(&secondCount).Error)
+	assert.Equal(t, int64(2), firstCount, "the clone keeps the template rows")
+	assert.Equal(t, int64(1), secondCount, "rows written to one clone stay out of the others")
+}
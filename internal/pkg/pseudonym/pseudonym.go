@@ -0,0 +1,86 @@
+// Package pseudonym replaces personal data with deterministic pseudonyms, so copies of
+// production data can be used in staging without exposing the people behind them.
+// Pseudonyms are derived from keyed HMAC-SHA256 digests: under one key the same value always
+// maps to the same pseudonym, which keeps references between rows and across runs intact,
+// while the original value can be neither recovered nor guessed without the key.
+package pseudonym
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"delivery/internal/pkg/errs"
+)
+
+// MinKeyLength is the shortest key accepted, in bytes.
+const MinKeyLength = 16
+
+// Pseudonymizer derives pseudonyms under a secret key. Every pseudonym is derived for a kind
+// of value, such as "name" or "phone", so equal values of different kinds are unrelated.
+type Pseudonymizer struct {
+	key []byte
+}
+
+// New creates a pseudonymizer with the secret key of at least MinKeyLength bytes.
+func New(key string) (Pseudonymizer, error) {
+	if key == "" {
+		return Pseudonymizer{}, errs.NewValueIsRequiredError("pseudonymization key")
+	}
+	if len(key) < MinKeyLength {
+		return Pseudonymizer{}, errs.NewValueIsInvalidErrorWithCause(
+			"pseudonymization key is invalid",
+			fmt.Errorf("%d bytes are fewer than %d", len(key), MinKeyLength),
+		)
+	}
+	return Pseudonymizer{key: []byte(key)}, nil
+}
+
+// Hex returns a lowercase hex pseudonym of length characters, at most 64.
+func (p Pseudonymizer) Hex(kind, value string, length int) string {
+	return hex.EncodeToString(p.digest(kind, value))[:min(length, sha256.Size*2)]
+}
+
+// Digits returns a pseudonym of length decimal digits.
+func (p Pseudonymizer) Digits(kind, value string, length int) string {
+	var digits strings.Builder
+	for i := 0; digits.Len() < length; i++ {
+		for _, b := range p.digest(kind, fmt.Sprintf("%s#%d", value, i)) {
+			if digits.Len() == length {
+				break
+			}
+			digits.WriteByte('0' + b%10)
+		}
+	}
+	return digits.String()
+}
+
+// Pick returns one of the options as the pseudonym; options must not be empty.
+func (p Pseudonymizer) Pick(kind, value string, options []string) string {
+	return options[p.number(kind, value)%uint64(len(options))]
+}
+
+// Offset returns a pseudonymous offset from -radius to radius inclusive.
+func (p Pseudonymizer) Offset(kind, value string, radius int) int {
+	if radius <= 0 {
+		return 0
+	}
+	return int(p.number(kind, value)%uint64(2*radius+1)) - radius //nolint:gosec // bounded by radius
+}
+
+// number interprets the first eight bytes of the digest as an unsigned number.
+func (p Pseudonymizer) number(kind, value string) uint64 {
+	return binary.BigEndian.Uint64(p.digest(kind, value))
+}
+
+// digest computes the keyed digest of the value of the kind.
+func (p Pseudonymizer) digest(kind, value string) []byte {
+	mac := hmac.New(sha256.New, p.key)
+	_, _ = mac.Write([]byte(kind))
+	_, _ = mac.Write([]byte{0})
+	_, _ = mac.Write([]byte(value))
+	return mac.Sum(nil)
+}
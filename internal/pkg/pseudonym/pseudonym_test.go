@@ -0,0 +1,80 @@
+package pseudonym_test
+
+import (
+	"strconv"
+	"testing"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/pseudonym"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testKey = "staging-copy-test-key"
+
+func TestNew(t *testing.T) {
+	t.Run("key is required", func(t *testing.T) {
+		_, err := pseudonym.New("")
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+	})
+
+	t.Run("rejects short keys", func(t *testing.T) {
+		_, err := pseudonym.New("short")
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+}
+
+func TestPseudonymizer(t *testing.T) {
+	p, err := pseudonym.New(testKey)
+	require.NoError(t, err)
+	other, err := pseudonym.New("another-staging-copy-key")
+	require.NoError(t, err)
+
+	t.Run("same value and kind give the same pseudonym", func(t *testing.T) {
+		assert.Equal(t, p.Hex("name", "Alice", 16), p.Hex("name", "Alice", 16))
+		assert.NotEqual(t, p.Hex("name", "Alice", 16), p.Hex("name", "Bob", 16))
+		assert.NotEqual(t, p.Hex("name", "Alice", 16), p.Hex("phone", "Alice", 16))
+	})
+
+	t.Run("pseudonyms depend on the key", func(t *testing.T) {
+		assert.NotEqual(t, p.Hex("name", "Alice", 16), other.Hex("name", "Alice", 16))
+	})
+
+	t.Run("hex is cut to the length", func(t *testing.T) {
+		assert.Len(t, p.Hex("token", "abc", 32), 32)
+		assert.Len(t, p.Hex("token", "abc", 100), 64)
+	})
+
+	t.Run("digits are decimal and of any length", func(t *testing.T) {
+		for _, length := range []int{1, 7, 40} {
+			digits := p.Digits("phone", "+79123456789", length)
+			assert.Len(t, digits, length)
+			for _, r := range digits {
+				assert.True(t, r >= '0' && r <= '9', digits)
+			}
+		}
+		assert.Equal(t, p.Digits("phone", "+79123456789", 7), p.Digits("phone", "+79123456789", 7))
+	})
+
+	t.Run("pick chooses one of the options", func(t *testing.T) {
+		options := []string{"Anna", "Boris", "Vera"}
+		seen := make(map[string]bool)
+		for i := range 100 {
+			seen[p.Pick("name", strconv.Itoa(i), options)] = true
+		}
+		assert.Len(t, seen, len(options))
+	})
+
+	t.Run("offsets stay within the radius", func(t *testing.T) {
+		seen := make(map[int]bool)
+		for i := range 100 {
+			offset := p.Offset("x", strconv.Itoa(i), 1)
+			assert.GreaterOrEqual(t, offset, -1)
+			assert.LessOrEqual(t, offset, 1)
+			seen[offset] = true
+		}
+		assert.Len(t, seen, 3)
+		assert.Zero(t, p.Offset("x", "1", 0))
+	})
+}
@@ -0,0 +1,102 @@
+// Package querycost keeps expensive statements from starving the rest of the database workload.
+// Query handlers and command handlers run under separate Postgres statement timeouts, so a
+// runaway report cannot hold connections and locks the transactional workload needs.
+// Statements canceled by a timeout surface as errs.QueryTimeoutError and are counted per
+// workload in the "statement_timeouts" expvar on /debug/vars.
+package querycost
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"strconv"
+	"time"
+
+	"delivery/internal/pkg/errs"
+
+	"gorm.io/gorm"
+)
+
+// SessionSetting is the Postgres run-time setting that limits how long a statement may run.
+// It is set per transaction with set_config(..., true).
+const SessionSetting = "statement_timeout"
+
+// queryCanceledState is the SQLSTATE Postgres reports for a statement canceled by statement_timeout.
+const queryCanceledState = "57014"
+
+// budgetKey stores the Budget in the settings of a GORM session.
+const budgetKey = "querycost:budget"
+
+// timeouts counts canceled statements per workload.
+var timeouts = expvar.NewMap("statement_timeouts")
+
+// Workload names the part of the application a statement timeout applies to.
+type Workload string
+
+const (
+	// QueryWorkload covers the read models served by query handlers.
+	QueryWorkload Workload = "query"
+
+	// CommandWorkload covers the transactions run by command handlers.
+	CommandWorkload Workload = "command"
+)
+
+// Budget is the time every statement of a workload may take.
+type Budget struct {
+	Workload Workload
+	Timeout  time.Duration
+}
+
+// SessionValue formats the timeout for SessionSetting, in milliseconds.
+func (b Budget) SessionValue() string {
+	return strconv.FormatInt(b.Timeout.Milliseconds(), 10)
+}
+
+// WithBudget returns a GORM session whose transactions run under the given statement timeout.
+// A non-positive timeout leaves db unlimited.
+func WithBudget(db *gorm.DB, workload Workload, timeout time.Duration) *gorm.DB {
+	if timeout <= 0 {
+		return db
+	}
+	return db.Set(budgetKey, Budget{Workload: workload, Timeout: timeout}).Session(&gorm.Session{})
+}
+
+// BudgetOf returns the budget the session was created with by WithBudget.
+func BudgetOf(db *gorm.DB) (Budget, bool) {
+	value, ok := db.Get(budgetKey)
+	if !ok {
+		return Budget{}, false
+	}
+	budget, ok := value.(Budget)
+	return budget, ok
+}
+
+// Translate turns a statement canceled by the timeout into errs.QueryTimeoutError and counts it.
+// Postgres reports statements canceled because ctx ended, for example when the client went away,
+// the same way, so those return the error of ctx and are not counted.
+// Other errors, including already translated ones, are returned unchanged.
+func Translate(ctx context.Context, err error, workload Workload) error {
+	if err == nil || errors.Is(err, errs.ErrQueryTimeout) {
+		return err
+	}
+
+	var stateErr interface{ SQLState() string }
+	if !errors.As(err, &stateErr) || stateErr.SQLState() != queryCanceledState {
+		return err
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+
+	timeouts.Add(string(workload), 1)
+	return errs.NewQueryTimeoutErrorWithCause(string(workload), err)
+}
+
+// TimeoutCount returns how many statements of the workload were canceled by the timeout.
+func TimeoutCount(workload Workload) int64 {
+	counter, ok := timeouts.Get(string(workload)).(*expvar.Int)
+	if !ok {
+		return 0
+	}
+	return counter.Value()
+}
@@ -0,0 +1,87 @@
+package querycost_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/querycost"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type stateError struct {
+	state string
+}
+
+func (e stateError) Error() string    { return "pq: " + e.state }
+func (e stateError) SQLState() string { return e.state }
+
+func TestTranslate(t *testing.T) {
+	t.Run("canceled statement becomes a query timeout and is counted", func(t *testing.T) {
+		before := querycost.TimeoutCount(querycost.QueryWorkload)
+		cause := stateError{state: "57014"}
+
+		err := querycost.Translate(t.Context(), cause, querycost.QueryWorkload)
+
+		require.ErrorIs(t, err, errs.ErrQueryTimeout)
+		var timeoutErr *errs.QueryTimeoutError
+		require.ErrorAs(t, err, &timeoutErr)
+		assert.Equal(t, "query", timeoutErr.Workload)
+		assert.Equal(t, cause, timeoutErr.Cause)
+		assert.Equal(t, before+1, querycost.TimeoutCount(querycost.QueryWorkload))
+	})
+
+	t.Run("translated error is not counted twice", func(t *testing.T) {
+		err := querycost.Translate(t.Context(), stateError{state: "57014"}, querycost.CommandWorkload)
+		before := querycost.TimeoutCount(querycost.CommandWorkload)
+
+		assert.Equal(t, err, querycost.Translate(t.Context(), err, querycost.CommandWorkload))
+		assert.Equal(t, before, querycost.TimeoutCount(querycost.CommandWorkload))
+	})
+
+	t.Run("statement canceled with its context reports the context error", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		before := querycost.TimeoutCount(querycost.QueryWorkload)
+
+		err := querycost.Translate(ctx, stateError{state: "57014"}, querycost.QueryWorkload)
+
+		require.ErrorIs(t, err, context.Canceled)
+		require.NotErrorIs(t, err, errs.ErrQueryTimeout)
+		assert.Equal(t, before, querycost.TimeoutCount(querycost.QueryWorkload))
+	})
+
+	t.Run("other errors are returned unchanged", func(t *testing.T) {
+		uniqueViolation := stateError{state: "23505"}
+		plain := errors.New("connection refused")
+
+		assert.Equal(t, uniqueViolation, querycost.Translate(t.Context(), uniqueViolation, querycost.QueryWorkload))
+		assert.Equal(t, plain, querycost.Translate(t.Context(), plain, querycost.QueryWorkload))
+		assert.NoError(t, querycost.Translate(t.Context(), nil, querycost.QueryWorkload))
+	})
+}
+
+func TestBudget(t *testing.T) {
+	t.Run("session carries the budget", func(t *testing.T) {
+		db := querycost.WithBudget(&gorm.DB{Config: &gorm.Config{}, Statement: &gorm.Statement{}},
+			querycost.QueryWorkload, 1500*time.Millisecond)
+
+		budget, ok := querycost.BudgetOf(db)
+		require.True(t, ok)
+		assert.Equal(t, querycost.QueryWorkload, budget.Workload)
+		assert.Equal(t, "1500", budget.SessionValue())
+	})
+
+	t.Run("non-positive timeout leaves the session unlimited", func(t *testing.T) {
+		db := querycost.WithBudget(&gorm.DB{Config: &gorm.Config{}, Statement: &gorm.Statement{}},
+			querycost.CommandWorkload, 0)
+
+		_, ok := querycost.BudgetOf(db)
+		assert.False(t, ok)
+	})
+}
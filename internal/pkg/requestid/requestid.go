@@ -0,0 +1,88 @@
+// Package requestid carries the ID correlating the log records of a request through context.Context.
+// The HTTP adapter accepts the ID of the caller or generates one, and every record logged
+// with the request's context carries it as the "request_id" attribute.
+package requestid
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// LogAttribute names the attribute the request ID is logged with.
+const LogAttribute = "request_id"
+
+// maxLength bounds IDs accepted from callers, so a header cannot bloat every log record.
+const maxLength = 128
+
+type contextKey struct{}
+
+// New generates a request ID.
+func New() string {
+	return uuid.NewString()
+}
+
+// IsValid reports whether an ID received from a caller can be used: 1-128 printable ASCII
+// characters without spaces.
+func IsValid(id string) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+	for i := range len(id) {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// WithID returns a copy of ctx that carries the request ID.
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the request ID carried by ctx, or an empty string outside of requests.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// LogHandler is a slog.Handler that adds the request ID of the record's context to the record.
+type LogHandler struct {
+	next slog.Handler
+}
+
+// NewLogHandler wraps next so that records logged with a request's context carry its ID.
+//
+// Example:
+//
+//	logger := slog.New(requestid.NewLogHandler(slog.Default().Handler()))
+//	logger.InfoContext(ctx, "Order created") // ... request_id=4f1c...
+func NewLogHandler(next slog.Handler) *LogHandler {
+	return &LogHandler{next: next}
+}
+
+// Enabled reports whether the wrapped handler handles records of the given level.
+func (h *LogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle adds the request ID, when ctx carries one, and passes the record to the wrapped handler.
+func (h *LogHandler) Handle(ctx context.Context, record slog.Record) error {
+	if id := FromContext(ctx); id != "" {
+		record = record.Clone()
+		record.AddAttrs(slog.String(LogAttribute, id))
+	}
+	return h.next.Handle(ctx, record)
+}
+
+// WithAttrs returns a handler whose records carry the given attributes.
+func (h *LogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &LogHandler{next: h.next.WithAttrs(attrs)}
+}
+
+// WithGroup returns a handler that qualifies the attributes of its records with the group name.
+func (h *LogHandler) WithGroup(name string) slog.Handler {
+	return &LogHandler{next: h.next.WithGroup(name)}
+}
@@ -0,0 +1,46 @@
+package requestid_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"delivery/internal/pkg/requestid"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContext(t *testing.T) {
+	t.Run("plain context has no ID", func(t *testing.T) {
+		assert.Empty(t, requestid.FromContext(context.Background()))
+	})
+
+	t.Run("ID is carried", func(t *testing.T) {
+		ctx := requestid.WithID(context.Background(), "req-42")
+
+		assert.Equal(t, "req-42", requestid.FromContext(ctx))
+	})
+}
+
+func TestIsValid(t *testing.T) {
+	assert.True(t, requestid.IsValid(requestid.New()))
+	assert.True(t, requestid.IsValid("gateway:7f3a-11"))
+	assert.False(t, requestid.IsValid(""))
+	assert.False(t, requestid.IsValid("two words"))
+	assert.False(t, requestid.IsValid("line\nbreak"))
+	assert.False(t, requestid.IsValid(strings.Repeat("r", 129)))
+}
+
+func TestLogHandler(t *testing.T) {
+	var out bytes.Buffer
+	logger := slog.New(requestid.NewLogHandler(slog.NewTextHandler(&out, nil))).With("job", "assignment")
+
+	logger.InfoContext(requestid.WithID(context.Background(), "req-42"), "Order assigned")
+	logger.InfoContext(context.Background(), "Tick completed")
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Contains(t, lines[0], "job=assignment request_id=req-42")
+	assert.NotContains(t, lines[1], "request_id")
+}
@@ -0,0 +1,96 @@
+package rollout_test
+
+import (
+	"strconv"
+	"testing"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/rollout"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPercentage(t *testing.T) {
+	t.Run("accepts 0 to 100", func(t *testing.T) {
+		for _, value := range []int{0, 42, 100} {
+			percentage, err := rollout.NewPercentage(value)
+			require.NoError(t, err)
+			assert.Equal(t, rollout.Percentage(value), percentage)
+		}
+	})
+
+	t.Run("rejects values out of range", func(t *testing.T) {
+		for _, value := range []int{-1, 101} {
+			_, err := rollout.NewPercentage(value)
+			require.ErrorIs(t, err, errs.ErrValueIsOutOfRange)
+		}
+	})
+}
+
+func TestFlag(t *testing.T) {
+	t.Run("name is required", func(t *testing.T) {
+		_, err := rollout.NewFlag("", 10)
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+	})
+
+	t.Run("0 and 100 percent are absolute", func(t *testing.T) {
+		off, err := rollout.NewFlag("experiment", 0)
+		require.NoError(t, err)
+		on, err := rollout.NewFlag("experiment", 100)
+		require.NoError(t, err)
+
+		for i := range 100 {
+			assert.False(t, off.Enabled(strconv.Itoa(i)))
+			assert.True(t, on.Enabled(strconv.Itoa(i)))
+		}
+	})
+
+	t.Run("enables roughly the configured share of keys", func(t *testing.T) {
+		flag, err := rollout.NewFlag("experiment", 30)
+		require.NoError(t, err)
+
+		enabled := 0
+		for i := range 10000 {
+			if flag.Enabled(strconv.Itoa(i)) {
+				enabled++
+			}
+		}
+		assert.InDelta(t, 3000, enabled, 300)
+	})
+
+	t.Run("raising the percentage keeps enabled keys enabled", func(t *testing.T) {
+		flag, err := rollout.NewFlag("experiment", 20)
+		require.NoError(t, err)
+
+		var enabledBefore []string
+		for i := range 1000 {
+			if key := strconv.Itoa(i); flag.Enabled(key) {
+				enabledBefore = append(enabledBefore, key)
+			}
+		}
+
+		flag.SetPercentage(50)
+		assert.Equal(t, rollout.Percentage(50), flag.Percentage())
+		for _, key := range enabledBefore {
+			assert.True(t, flag.Enabled(key))
+		}
+	})
+}
+
+func TestRegistry(t *testing.T) {
+	flag, err := rollout.NewFlag("experiment", 10)
+	require.NoError(t, err)
+	registry := rollout.NewRegistry(flag)
+
+	t.Run("finds registered flags", func(t *testing.T) {
+		found, findErr := registry.Flag("experiment")
+		require.NoError(t, findErr)
+		assert.Same(t, flag, found)
+	})
+
+	t.Run("unknown flag is not found", func(t *testing.T) {
+		_, findErr := registry.Flag("unknown")
+		require.ErrorIs(t, findErr, errs.ErrObjectNotFound)
+	})
+}
@@ -0,0 +1,23 @@
+// Package synthetic marks operations that create test data through context.Context.
+// Integration suites running against shared environments flag their requests so that
+// every courier and order they create can later be recognized and purged.
+package synthetic
+
+import "context"
+
+// SessionSetting is the Postgres run-time setting the synthetic_at column defaults read.
+// It is set to "on" per transaction with set_config(..., true) for marked operations.
+const SessionSetting = "app.synthetic"
+
+type contextKey struct{}
+
+// WithMarker returns a copy of ctx that marks the data it creates as synthetic.
+func WithMarker(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKey{}, true)
+}
+
+// IsMarked reports whether ctx carries the synthetic data marker.
+func IsMarked(ctx context.Context) bool {
+	marked, _ := ctx.Value(contextKey{}).(bool)
+	return marked
+}
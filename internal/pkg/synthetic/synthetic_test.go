@@ -0,0 +1,20 @@
+package synthetic_test
+
+import (
+	"context"
+	"testing"
+
+	"delivery/internal/pkg/synthetic"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarker(t *testing.T) {
+	t.Run("plain context is not marked", func(t *testing.T) {
+		assert.False(t, synthetic.IsMarked(context.Background()))
+	})
+
+	t.Run("marked context is recognized", func(t *testing.T) {
+		assert.True(t, synthetic.IsMarked(synthetic.WithMarker(context.Background())))
+	})
+}
@@ -0,0 +1,75 @@
+package tenant
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"slices"
+	"strings"
+
+	"delivery/internal/pkg/errs"
+)
+
+// minTokenLength keeps tenant tokens long enough not to be guessed.
+const minTokenLength = 16
+
+// Credentials authenticate tenants by the secret token each of them is issued.
+// Only the SHA-256 digests of the tokens are kept, so they do not linger in memory
+// and lookups take the same time whatever the token shares with a valid one.
+type Credentials struct {
+	tenants map[[sha256.Size]byte]ID
+}
+
+// ParseCredentials parses comma-separated "tenant:token" pairs, such as "acme:3f9c...,globex:a71e...".
+// A tenant may hold several tokens to rotate them; a token must not be shared by two tenants.
+func ParseCredentials(spec string) (Credentials, error) {
+	credentials := Credentials{tenants: make(map[[sha256.Size]byte]ID)}
+	for i, pair := range strings.Split(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		value, token, found := strings.Cut(pair, ":")
+		if !found || len(token) < minTokenLength {
+			return Credentials{}, errs.NewValueIsInvalidErrorWithCause(
+				"tenant credentials are invalid",
+				fmt.Errorf("pair %d must be a tenant and a token of at least %d characters", i+1, minTokenLength),
+			)
+		}
+		id, err := NewID(value)
+		if err != nil {
+			return Credentials{}, err
+		}
+
+		digest := sha256.Sum256([]byte(token))
+		if owner, taken := credentials.tenants[digest]; taken && owner != id {
+			return Credentials{}, errs.NewValueIsInvalidErrorWithCause(
+				"tenant credentials are invalid",
+				fmt.Errorf("tenants %q and %q share a token", owner, id),
+			)
+		}
+		credentials.tenants[digest] = id
+	}
+	return credentials, nil
+}
+
+// Authenticate returns the tenant the token was issued to.
+// Reports false if the token belongs to no tenant.
+func (c Credentials) Authenticate(token string) (ID, bool) {
+	id, ok := c.tenants[sha256.Sum256([]byte(token))]
+	return id, ok
+}
+
+// Tenants returns the tenants holding a token, each once and in order.
+func (c Credentials) Tenants() []ID {
+	seen := make(map[ID]bool)
+	var ids []ID
+	for _, id := range c.tenants {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
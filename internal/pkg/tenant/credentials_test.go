@@ -0,0 +1,53 @@
+package tenant_test
+
+import (
+	"testing"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tenant"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCredentials(t *testing.T) {
+	t.Run("authenticates the tenant a token was issued to", func(t *testing.T) {
+		credentials, err := tenant.ParseCredentials(
+			"acme:acme-token-0123456789, globex:globex-token-0123456789,acme:acme-rotated-0123456789",
+		)
+		require.NoError(t, err)
+
+		id, ok := credentials.Authenticate("acme-rotated-0123456789")
+		require.True(t, ok)
+		assert.Equal(t, tenant.ID("acme"), id)
+
+		id, ok = credentials.Authenticate("globex-token-0123456789")
+		require.True(t, ok)
+		assert.Equal(t, tenant.ID("globex"), id)
+
+		_, ok = credentials.Authenticate("acme-token-012345678")
+		assert.False(t, ok)
+		assert.Equal(t, []tenant.ID{"acme", "globex"}, credentials.Tenants())
+	})
+
+	t.Run("authenticates nobody without tokens", func(t *testing.T) {
+		credentials, err := tenant.ParseCredentials("")
+		require.NoError(t, err)
+
+		_, ok := credentials.Authenticate("")
+		assert.False(t, ok)
+		assert.Empty(t, credentials.Tenants())
+	})
+
+	t.Run("rejects malformed pairs", func(t *testing.T) {
+		for _, spec := range []string{
+			"acme",
+			"acme:short",
+			"Acme:acme-token-0123456789",
+			"acme:shared-token-0123456789,globex:shared-token-0123456789",
+		} {
+			_, err := tenant.ParseCredentials(spec)
+			require.ErrorIs(t, err, errs.ErrValueIsInvalid, spec)
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package tenant
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"delivery/internal/pkg/errs"
+)
+
+// Durations override a duration setting for some tenants, such as the time orders may wait
+// for a courier. Tenants without an override use the setting of the service.
+type Durations struct {
+	tenants map[ID]time.Duration
+}
+
+// ParseDurations parses comma-separated "tenant:duration" pairs, such as "acme:12h,globex:90m".
+// Durations must be positive, and a tenant is given one duration at most.
+func ParseDurations(spec string) (Durations, error) {
+	durations := Durations{tenants: make(map[ID]time.Duration)}
+	for i, pair := range strings.Split(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		value, text, found := strings.Cut(pair, ":")
+		duration, err := time.ParseDuration(strings.TrimSpace(text))
+		if !found || err != nil || duration <= 0 {
+			return Durations{}, errs.NewValueIsInvalidErrorWithCause(
+				"tenant durations are invalid",
+				fmt.Errorf("pair %d must be a tenant and a positive duration such as 12h", i+1),
+			)
+		}
+		id, err := NewID(strings.TrimSpace(value))
+		if err != nil {
+			return Durations{}, err
+		}
+
+		if _, taken := durations.tenants[id]; taken {
+			return Durations{}, errs.NewValueIsInvalidErrorWithCause(
+				"tenant durations are invalid",
+				fmt.Errorf("tenant %q is given more than one duration", id),
+			)
+		}
+		durations.tenants[id] = duration
+	}
+	return durations, nil
+}
+
+// Get returns the duration of the tenant, or fallback if the tenant has no override.
+func (d Durations) Get(id ID, fallback time.Duration) time.Duration {
+	if duration, ok := d.tenants[id]; ok {
+		return duration
+	}
+	return fallback
+}
+
+// Tenants returns the tenants with an override, in order.
+func (d Durations) Tenants() []ID {
+	ids := make([]ID, 0, len(d.tenants))
+	for id := range d.tenants {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
@@ -0,0 +1,46 @@
+package tenant_test
+
+import (
+	"testing"
+	"time"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tenant"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDurations(t *testing.T) {
+	t.Run("overrides the duration of the listed tenants", func(t *testing.T) {
+		durations, err := tenant.ParseDurations("globex:90m, acme:12h")
+		require.NoError(t, err)
+
+		assert.Equal(t, 12*time.Hour, durations.Get("acme", 24*time.Hour))
+		assert.Equal(t, 90*time.Minute, durations.Get("globex", 24*time.Hour))
+		assert.Equal(t, 24*time.Hour, durations.Get("initech", 24*time.Hour))
+		assert.Equal(t, []tenant.ID{"acme", "globex"}, durations.Tenants())
+	})
+
+	t.Run("overrides nothing without pairs", func(t *testing.T) {
+		durations, err := tenant.ParseDurations("")
+		require.NoError(t, err)
+
+		assert.Equal(t, time.Hour, durations.Get("acme", time.Hour))
+		assert.Empty(t, durations.Tenants())
+	})
+
+	t.Run("rejects malformed pairs", func(t *testing.T) {
+		for _, spec := range []string{
+			"acme",
+			"acme:tomorrow",
+			"acme:0s",
+			"acme:-1h",
+			"Acme:12h",
+			"acme:12h,acme:24h",
+		} {
+			_, err := tenant.ParseDurations(spec)
+			require.ErrorIs(t, err, errs.ErrValueIsInvalid, spec)
+		}
+	})
+}
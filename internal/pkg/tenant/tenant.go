@@ -0,0 +1,92 @@
+// Package tenant carries the tenant an operation acts for through context.Context.
+// Adapters read it to scope storage access; row-level security in Postgres keeps
+// every tenant's rows invisible to the others, even for hand-written queries.
+package tenant
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"delivery/internal/pkg/errs"
+)
+
+// SessionSetting is the Postgres run-time setting the row-level security policies
+// read the current tenant from. It is set per transaction with set_config(..., true).
+const SessionSetting = "app.tenant_id"
+
+// maxIDLength matches the width of the tenant_id columns.
+const maxIDLength = 64
+
+// idPattern restricts tenant IDs to characters that are safe in SQL literals and headers.
+var idPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// ID identifies a tenant, for example "default" or "acme-logistics".
+type ID string
+
+// NewID validates a tenant identifier: 1-64 lowercase letters, digits, '-' or '_',
+// starting with a letter or digit.
+func NewID(value string) (ID, error) {
+	id := ID(value)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// ParseIDs parses a comma-separated list of tenant identifiers, such as "acme,globex".
+// Blank entries are skipped and duplicates are kept once, in the order of their first occurrence.
+func ParseIDs(spec string) ([]ID, error) {
+	var ids []ID
+	seen := make(map[ID]bool)
+	for _, value := range strings.Split(spec, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		id, err := NewID(value)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// Validate checks that the ID is well-formed.
+func (id ID) Validate() error {
+	if id == "" {
+		return errs.NewValueIsRequiredError("tenant id")
+	}
+	if len(id) > maxIDLength || !idPattern.MatchString(string(id)) {
+		return errs.NewValueIsInvalidErrorWithCause(
+			"tenant id is invalid",
+			fmt.Errorf("%q must be up to %d lowercase letters, digits, '-' or '_'", string(id), maxIDLength),
+		)
+	}
+	return nil
+}
+
+// String returns the tenant identifier.
+func (id ID) String() string {
+	return string(id)
+}
+
+type contextKey struct{}
+
+// WithID returns a copy of ctx that carries the tenant.
+func WithID(ctx context.Context, id ID) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the tenant carried by ctx.
+// Reports false if no tenant was set, in which case storage falls back to the default tenant.
+func FromContext(ctx context.Context) (ID, bool) {
+	id, ok := ctx.Value(contextKey{}).(ID)
+	return id, ok
+}
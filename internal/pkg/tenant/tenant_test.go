@@ -0,0 +1,64 @@
+package tenant_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"delivery/internal/pkg/errs"
+	"delivery/internal/pkg/tenant"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewID(t *testing.T) {
+	t.Run("accepts well-formed ids", func(t *testing.T) {
+		for _, value := range []string{"default", "acme-logistics", "tenant_42", "7", strings.Repeat("a", 64)} {
+			id, err := tenant.NewID(value)
+			require.NoError(t, err, value)
+			assert.Equal(t, value, id.String())
+		}
+	})
+
+	t.Run("requires a value", func(t *testing.T) {
+		_, err := tenant.NewID("")
+		require.ErrorIs(t, err, errs.ErrValueIsRequired)
+	})
+
+	t.Run("rejects malformed ids", func(t *testing.T) {
+		for _, value := range []string{"Acme", "-acme", "acme corp", "acme';--", strings.Repeat("a", 65)} {
+			_, err := tenant.NewID(value)
+			require.ErrorIs(t, err, errs.ErrValueIsInvalid, value)
+		}
+	})
+}
+
+func TestParseIDs(t *testing.T) {
+	t.Run("parses a list", func(t *testing.T) {
+		ids, err := tenant.ParseIDs(" acme, globex,,acme ")
+		require.NoError(t, err)
+		assert.Equal(t, []tenant.ID{"acme", "globex"}, ids)
+	})
+
+	t.Run("accepts an empty list", func(t *testing.T) {
+		ids, err := tenant.ParseIDs("")
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+	})
+
+	t.Run("rejects malformed ids", func(t *testing.T) {
+		_, err := tenant.ParseIDs("acme,Globex")
+		require.ErrorIs(t, err, errs.ErrValueIsInvalid)
+	})
+}
+
+func TestContext(t *testing.T) {
+	_, ok := tenant.FromContext(context.Background())
+	assert.False(t, ok)
+
+	ctx := tenant.WithID(context.Background(), tenant.ID("acme"))
+	id, ok := tenant.FromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, tenant.ID("acme"), id)
+}
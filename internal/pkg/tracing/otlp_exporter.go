@@ -0,0 +1,282 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// tracesPath is the path of the OTLP/HTTP traces endpoint under the collector's base URL.
+const tracesPath = "/v1/traces"
+
+// exportTimeout bounds one export request, so a collector that is down delays no more than
+// one batch of spans.
+const exportTimeout = 10 * time.Second
+
+// OTLPExporter sends spans to an OpenTelemetry collector over OTLP/HTTP with JSON encoding.
+type OTLPExporter struct {
+	url    string
+	client *http.Client
+}
+
+// NewOTLPExporter creates an exporter sending spans to the collector at endpoint, the base URL
+// the collector serves OTLP/HTTP on.
+// Returns an error if endpoint is not an absolute http or https URL.
+func NewOTLPExporter(endpoint string) (*OTLPExporter, error) {
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OTLP endpoint: %w", err)
+	}
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid OTLP endpoint %q: an http or https URL is required", endpoint)
+	}
+
+	return &OTLPExporter{
+		url:    parsed.JoinPath(tracesPath).String(),
+		client: &http.Client{Timeout: exportTimeout},
+	}, nil
+}
+
+// ExportSpans sends the spans in one request. The batching span processor logs a returned
+// error through the OpenTelemetry error handler and drops the spans.
+func (e *OTLPExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if len(spans) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(encodeSpans(spans))
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := e.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = response.Body.Close() }()
+	_, _ = io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("OTLP collector responded %s", response.Status)
+	}
+	return nil
+}
+
+// Shutdown does nothing: every export completes before ExportSpans returns.
+func (e *OTLPExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+// The types below are the JSON encoding of the OTLP ExportTraceServiceRequest message:
+// IDs are hex strings and 64-bit integers are decimal strings.
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Events            []otlpEvent     `json:"events,omitempty"`
+	Links             []otlpLink      `json:"links,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpEvent struct {
+	TimeUnixNano string          `json:"timeUnixNano"`
+	Name         string          `json:"name"`
+	Attributes   []otlpAttribute `json:"attributes,omitempty"`
+}
+
+type otlpLink struct {
+	TraceID    string          `json:"traceId"`
+	SpanID     string          `json:"spanId"`
+	Attributes []otlpAttribute `json:"attributes,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string     `json:"stringValue,omitempty"`
+	BoolValue   *bool       `json:"boolValue,omitempty"`
+	IntValue    *string     `json:"intValue,omitempty"`
+	DoubleValue *float64    `json:"doubleValue,omitempty"`
+	ArrayValue  *otlpValues `json:"arrayValue,omitempty"`
+}
+
+type otlpValues struct {
+	Values []otlpValue `json:"values"`
+}
+
+// OTLP status codes, which are numbered unlike the codes of the OpenTelemetry API.
+const (
+	otlpStatusUnset = 0
+	otlpStatusOK    = 1
+	otlpStatusError = 2
+)
+
+// encodeSpans groups the spans by resource and instrumentation scope.
+func encodeSpans(spans []sdktrace.ReadOnlySpan) otlpRequest {
+	var request otlpRequest
+	resources := make(map[attribute.Distinct]int)
+	scopes := make(map[attribute.Distinct]map[otlpScope]int)
+	for _, span := range spans {
+		resourceKey := span.Resource().Equivalent()
+		r, ok := resources[resourceKey]
+		if !ok {
+			r = len(request.ResourceSpans)
+			resources[resourceKey] = r
+			scopes[resourceKey] = make(map[otlpScope]int)
+			request.ResourceSpans = append(request.ResourceSpans, otlpResourceSpans{
+				Resource: otlpResource{Attributes: encodeAttributes(span.Resource().Attributes())},
+			})
+		}
+
+		scope := otlpScope{Name: span.InstrumentationScope().Name, Version: span.InstrumentationScope().Version}
+		s, ok := scopes[resourceKey][scope]
+		if !ok {
+			s = len(request.ResourceSpans[r].ScopeSpans)
+			scopes[resourceKey][scope] = s
+			request.ResourceSpans[r].ScopeSpans = append(request.ResourceSpans[r].ScopeSpans, otlpScopeSpans{Scope: scope})
+		}
+
+		scopeSpans := &request.ResourceSpans[r].ScopeSpans[s]
+		scopeSpans.Spans = append(scopeSpans.Spans, encodeSpan(span))
+	}
+	return request
+}
+
+func encodeSpan(span sdktrace.ReadOnlySpan) otlpSpan {
+	encoded := otlpSpan{
+		TraceID:           span.SpanContext().TraceID().String(),
+		SpanID:            span.SpanContext().SpanID().String(),
+		Name:              span.Name(),
+		Kind:              int(span.SpanKind()),
+		StartTimeUnixNano: unixNano(span.StartTime()),
+		EndTimeUnixNano:   unixNano(span.EndTime()),
+		Attributes:        encodeAttributes(span.Attributes()),
+		Status:            encodeStatus(span.Status()),
+	}
+	if span.Parent().IsValid() {
+		encoded.ParentSpanID = span.Parent().SpanID().String()
+	}
+	for _, event := range span.Events() {
+		encoded.Events = append(encoded.Events, otlpEvent{
+			TimeUnixNano: unixNano(event.Time),
+			Name:         event.Name,
+			Attributes:   encodeAttributes(event.Attributes),
+		})
+	}
+	for _, link := range span.Links() {
+		encoded.Links = append(encoded.Links, otlpLink{
+			TraceID:    link.SpanContext.TraceID().String(),
+			SpanID:     link.SpanContext.SpanID().String(),
+			Attributes: encodeAttributes(link.Attributes),
+		})
+	}
+	return encoded
+}
+
+func encodeStatus(status sdktrace.Status) otlpStatus {
+	switch status.Code {
+	case codes.Error:
+		return otlpStatus{Code: otlpStatusError, Message: status.Description}
+	case codes.Ok:
+		return otlpStatus{Code: otlpStatusOK}
+	default:
+		return otlpStatus{Code: otlpStatusUnset}
+	}
+}
+
+func encodeAttributes(attributes []attribute.KeyValue) []otlpAttribute {
+	encoded := make([]otlpAttribute, 0, len(attributes))
+	for _, kv := range attributes {
+		encoded = append(encoded, otlpAttribute{Key: string(kv.Key), Value: encodeValue(kv.Value)})
+	}
+	return encoded
+}
+
+func encodeValue(value attribute.Value) otlpValue {
+	switch value.Type() {
+	case attribute.BOOL:
+		return otlpValue{BoolValue: ptr(value.AsBool())}
+	case attribute.INT64:
+		return otlpValue{IntValue: ptr(strconv.FormatInt(value.AsInt64(), 10))}
+	case attribute.FLOAT64:
+		return otlpValue{DoubleValue: ptr(value.AsFloat64())}
+	case attribute.BOOLSLICE:
+		return encodeSlice(value.AsBoolSlice(), attribute.BoolValue)
+	case attribute.INT64SLICE:
+		return encodeSlice(value.AsInt64Slice(), attribute.Int64Value)
+	case attribute.FLOAT64SLICE:
+		return encodeSlice(value.AsFloat64Slice(), attribute.Float64Value)
+	case attribute.STRINGSLICE:
+		return encodeSlice(value.AsStringSlice(), attribute.StringValue)
+	default:
+		return otlpValue{StringValue: ptr(value.Emit())}
+	}
+}
+
+func encodeSlice[T any](values []T, toValue func(T) attribute.Value) otlpValue {
+	encoded := otlpValues{Values: make([]otlpValue, 0, len(values))}
+	for _, v := range values {
+		encoded.Values = append(encoded.Values, encodeValue(toValue(v)))
+	}
+	return otlpValue{ArrayValue: &encoded}
+}
+
+func unixNano(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
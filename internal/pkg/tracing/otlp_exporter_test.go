@@ -0,0 +1,118 @@
+package tracing_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"delivery/internal/pkg/tracing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func recordSpans(t *testing.T) []sdktrace.ReadOnlySpan {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(recorder),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", "delivery"))),
+	)
+	tracer := provider.Tracer("delivery")
+
+	ctx, parent := tracer.Start(context.Background(), "AssignCourierCommand")
+	_, child := tracer.Start(ctx, "query couriers",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("db.sql.table", "couriers"), attribute.Int64("db.rows", 3)),
+	)
+	child.SetStatus(codes.Error, "timeout")
+	child.End()
+	parent.End()
+
+	return recorder.Ended()
+}
+
+func TestOTLPExporter_ExportSpans(t *testing.T) {
+	var (
+		path        string
+		contentType string
+		body        map[string]any
+	)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		contentType = r.Header.Get("Content-Type")
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+	}))
+	defer collector.Close()
+
+	exporter, err := tracing.NewOTLPExporter(collector.URL)
+	require.NoError(t, err)
+	spans := recordSpans(t)
+
+	require.NoError(t, exporter.ExportSpans(context.Background(), spans))
+
+	assert.Equal(t, "/v1/traces", path)
+	assert.Equal(t, "application/json", contentType)
+
+	resourceSpans := body["resourceSpans"].([]any)
+	require.Len(t, resourceSpans, 1)
+	resourceSpan := resourceSpans[0].(map[string]any)
+	assert.Equal(t, map[string]any{
+		"attributes": []any{
+			map[string]any{"key": "service.name", "value": map[string]any{"stringValue": "delivery"}},
+		},
+	}, resourceSpan["resource"])
+
+	scopeSpans := resourceSpan["scopeSpans"].([]any)
+	require.Len(t, scopeSpans, 1)
+	scopeSpan := scopeSpans[0].(map[string]any)
+	assert.Equal(t, map[string]any{"name": "delivery"}, scopeSpan["scope"])
+
+	encoded := scopeSpan["spans"].([]any)
+	require.Len(t, encoded, 2)
+	child := encoded[0].(map[string]any)
+	assert.Equal(t, "query couriers", child["name"])
+	assert.Equal(t, spans[0].SpanContext().TraceID().String(), child["traceId"])
+	assert.Equal(t, spans[1].SpanContext().SpanID().String(), child["parentSpanId"])
+	assert.InDelta(t, 3, child["kind"], 0)
+	assert.Equal(t, map[string]any{"code": float64(2), "message": "timeout"}, child["status"])
+	assert.Equal(t, []any{
+		map[string]any{"key": "db.sql.table", "value": map[string]any{"stringValue": "couriers"}},
+		map[string]any{"key": "db.rows", "value": map[string]any{"intValue": "3"}},
+	}, child["attributes"])
+
+	parent := encoded[1].(map[string]any)
+	assert.NotContains(t, parent, "parentSpanId")
+	assert.Equal(t, map[string]any{"code": float64(0)}, parent["status"])
+}
+
+func TestOTLPExporter_ExportSpans_CollectorFails(t *testing.T) {
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer collector.Close()
+
+	exporter, err := tracing.NewOTLPExporter(collector.URL)
+	require.NoError(t, err)
+
+	err = exporter.ExportSpans(context.Background(), recordSpans(t))
+
+	assert.ErrorContains(t, err, "503")
+}
+
+func TestNewOTLPExporter_InvalidEndpoint(t *testing.T) {
+	for _, endpoint := range []string{"", "collector:4318", "ftp://collector", "http://"} {
+		_, err := tracing.NewOTLPExporter(endpoint)
+
+		assert.Error(t, err, endpoint)
+	}
+}
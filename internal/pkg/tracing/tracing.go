@@ -0,0 +1,133 @@
+// Package tracing records OpenTelemetry traces of the application: the spans of HTTP requests,
+// command and query handlers, units of work and database statements, and the publishing of
+// integration events. Spans are exported over OTLP/HTTP to the collector set up by Setup.
+//
+// Trace context crosses process boundaries in W3C traceparent headers: it is read from incoming
+// HTTP requests and written to the Kafka messages the outbox relay publishes. Messages are
+// published after their transaction commits, so the traceparent of the operation recording a
+// message is stored with it in the outbox.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName names the tracer of the application's spans.
+const instrumentationName = "delivery"
+
+// traceParentHeader is the W3C header carrying the trace and the parent span.
+const traceParentHeader = "traceparent"
+
+var ErrSampleRatioIsInvalid = errors.New("trace sample ratio must be between 0 and 1")
+
+// Config configures the export of spans.
+type Config struct {
+	// Endpoint is the base URL of the OTLP/HTTP collector, such as "http://otel-collector:4318".
+	// Spans are not recorded without it, but trace context is still propagated.
+	Endpoint string
+	// ServiceName is the service.name resource attribute of the spans.
+	ServiceName string
+	// SampleRatio is the share of traces started by this service that are recorded.
+	// Traces started by callers are recorded as they decided.
+	SampleRatio float64
+}
+
+// Setup installs the W3C trace context propagator and, if an endpoint is configured, a tracer
+// provider exporting spans in batches to it. The returned shutdown flushes the pending spans.
+// Returns ErrSampleRatioIsInvalid if the sample ratio is out of [0, 1].
+//
+// Example:
+//
+//	shutdown, err := tracing.Setup(tracing.Config{Endpoint: "http://localhost:4318", ServiceName: "delivery", SampleRatio: 1})
+//	if err != nil {
+//	    return err
+//	}
+//	defer shutdown(context.Background())
+func Setup(config Config) (func(ctx context.Context) error, error) {
+	if config.SampleRatio < 0 || config.SampleRatio > 1 {
+		return nil, fmt.Errorf("%w: %v", ErrSampleRatioIsInvalid, config.SampleRatio)
+	}
+
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+	if config.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := NewOTLPExporter(config.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", config.ServiceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Start starts a span named name as a child of the span of ctx, if any.
+// The caller must end the span, usually with a deferred span.End().
+// Without a tracer provider the span is not recorded and carries the span context of ctx,
+// so ctx is returned as it is and tracing costs no context allocation.
+//
+// Example:
+//
+//	ctx, span := tracing.Start(ctx, "AssignCourierCommand")
+//	defer span.End()
+func Start(ctx context.Context, name string, options ...trace.SpanStartOption) (context.Context, trace.Span) {
+	spanCtx, span := otel.Tracer(instrumentationName).Start(ctx, name, options...)
+	if !span.IsRecording() && span.SpanContext().Equal(trace.SpanContextFromContext(ctx)) {
+		return ctx, span
+	}
+	return spanCtx, span
+}
+
+// RecordError marks the span as failed with err; it does nothing if err is nil.
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// TraceParent returns the W3C traceparent of the span of ctx, or "" if ctx carries no span.
+func TraceParent(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	return carrier.Get(traceParentHeader)
+}
+
+// WithTraceParent returns a copy of ctx whose parent span is the remote span of the W3C
+// traceparent. Returns ctx unchanged if traceParent is empty or malformed.
+func WithTraceParent(ctx context.Context, traceParent string) context.Context {
+	if traceParent == "" {
+		return ctx
+	}
+	return propagation.TraceContext{}.Extract(ctx, propagation.MapCarrier{traceParentHeader: traceParent})
+}
+
+// Inject writes the trace context of ctx to carrier with the propagator installed by Setup.
+func Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+}
+
+// Extract returns a copy of ctx carrying the trace context read from carrier with the
+// propagator installed by Setup.
+func Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, carrier)
+}
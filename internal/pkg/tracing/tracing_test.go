@@ -0,0 +1,82 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"delivery/internal/pkg/tracing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const traceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func TestTraceParent_WithoutSpan(t *testing.T) {
+	assert.Empty(t, tracing.TraceParent(context.Background()))
+}
+
+func TestWithTraceParent_RoundTrip(t *testing.T) {
+	ctx := tracing.WithTraceParent(context.Background(), traceParent)
+
+	spanContext := trace.SpanContextFromContext(ctx)
+	assert.True(t, spanContext.IsRemote())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spanContext.TraceID().String())
+	assert.Equal(t, traceParent, tracing.TraceParent(ctx))
+}
+
+func TestWithTraceParent_Malformed(t *testing.T) {
+	for _, value := range []string{"", "00-not-a-trace-01"} {
+		ctx := tracing.WithTraceParent(context.Background(), value)
+
+		assert.False(t, trace.SpanContextFromContext(ctx).IsValid(), value)
+	}
+}
+
+func TestStart_WithoutTracerProvider(t *testing.T) {
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "value")
+
+	spanCtx, span := tracing.Start(ctx, "AssignCourierCommand")
+	defer span.End()
+
+	assert.Equal(t, ctx, spanCtx)
+	assert.False(t, span.IsRecording())
+}
+
+func TestRecordError(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	_, failed := tracer.Start(context.Background(), "failed")
+	tracing.RecordError(failed, errors.New("no courier is available"))
+	failed.End()
+	_, succeeded := tracer.Start(context.Background(), "succeeded")
+	tracing.RecordError(succeeded, nil)
+	succeeded.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, "no courier is available", spans[0].Status().Description)
+	assert.Len(t, spans[0].Events(), 1)
+	assert.Equal(t, codes.Unset, spans[1].Status().Code)
+	assert.Empty(t, spans[1].Events())
+}
+
+func TestSetup_InvalidSampleRatio(t *testing.T) {
+	_, err := tracing.Setup(tracing.Config{ServiceName: "delivery", SampleRatio: 1.5})
+
+	assert.ErrorIs(t, err, tracing.ErrSampleRatioIsInvalid)
+}
+
+func TestSetup_InvalidEndpoint(t *testing.T) {
+	_, err := tracing.Setup(tracing.Config{Endpoint: "collector:4318", ServiceName: "delivery", SampleRatio: 1})
+
+	assert.Error(t, err)
+}